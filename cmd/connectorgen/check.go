@@ -0,0 +1,341 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// goPackage holds the top-level declarations of a Go service package.
+type goPackage struct {
+	// names holds every top-level type, function, variable and constant.
+	names map[string]bool
+	// fields holds the field names of each struct type.
+	fields map[string]map[string]bool
+	// methods holds the method names of each type.
+	methods map[string]map[string]bool
+}
+
+func (p *goPackage) hasMember(typ, name string) bool {
+	return p.fields[typ][name] || p.methods[typ][name]
+}
+
+var packageCache = map[string]*goPackage{}
+
+// loadPackage parses the declarations of the Go package in dir. It returns nil
+// if there is no Go package in dir.
+func loadPackage(dir string) (*goPackage, error) {
+	if p, ok := packageCache[dir]; ok {
+		return p, nil
+	}
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		packageCache[dir] = nil
+		return nil, nil
+	}
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed) == 0 {
+		packageCache[dir] = nil
+		return nil, nil
+	}
+
+	p := &goPackage{
+		names:   map[string]bool{},
+		fields:  map[string]map[string]bool{},
+		methods: map[string]map[string]bool{},
+	}
+	for _, pkg := range parsed {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				switch d := decl.(type) {
+				case *ast.FuncDecl:
+					if d.Recv == nil {
+						p.names[d.Name.Name] = true
+						continue
+					}
+					typ := receiverType(d.Recv.List[0].Type)
+					if p.methods[typ] == nil {
+						p.methods[typ] = map[string]bool{}
+					}
+					p.methods[typ][d.Name.Name] = true
+				case *ast.GenDecl:
+					for _, spec := range d.Specs {
+						switch s := spec.(type) {
+						case *ast.TypeSpec:
+							p.names[s.Name.Name] = true
+							if st, ok := s.Type.(*ast.StructType); ok {
+								p.fields[s.Name.Name] = structFields(st)
+							}
+						case *ast.ValueSpec:
+							for _, n := range s.Names {
+								p.names[n.Name] = true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	packageCache[dir] = p
+	return p, nil
+}
+
+func receiverType(e ast.Expr) string {
+	if s, ok := e.(*ast.StarExpr); ok {
+		e = s.X
+	}
+	if i, ok := e.(*ast.Ident); ok {
+		return i.Name
+	}
+	return ""
+}
+
+func structFields(st *ast.StructType) map[string]bool {
+	fields := map[string]bool{}
+	for _, f := range st.Fields.List {
+		for _, n := range f.Names {
+			fields[n.Name] = true
+		}
+		if len(f.Names) == 0 {
+			fields[receiverType(f.Type)] = true
+		}
+	}
+	return fields
+}
+
+// problem is a reference in a Python server to Go code which does not exist.
+type problem struct {
+	// pos is where the reference is, e.g. "python/services/tpu/node_server.go:23".
+	pos string
+	// code is the missing Go code, relative to services/google: a service
+	// package, e.g. "tpu", or a declaration in one, e.g. "compute.Address" or
+	// "compute.Client.ApplyAddress".
+	code string
+	msg  string
+}
+
+func (p problem) String() string {
+	return p.pos + ": " + p.msg
+}
+
+// typeRef is a type declared in an imported Go service package.
+type typeRef struct {
+	pkg  string
+	name string
+}
+
+// checkPackage returns a problem for every reference in the servers of the
+// python/services package pkg to Go code which does not exist.
+func checkPackage(root, pkg string) ([]problem, error) {
+	files, err := filepath.Glob(filepath.Join(root, "python", "services", filepath.FromSlash(pkg), "*_server.go"))
+	if err != nil {
+		return nil, err
+	}
+	var problems []problem
+	for _, file := range files {
+		ps, err := checkFile(root, file)
+		if err != nil {
+			return nil, err
+		}
+		problems = append(problems, ps...)
+	}
+	return problems, nil
+}
+
+func checkFile(root, file string) ([]problem, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []problem
+	report := func(pos token.Pos, code, format string, args ...interface{}) {
+		p := fset.Position(pos)
+		rel, err := filepath.Rel(root, p.Filename)
+		if err != nil {
+			rel = p.Filename
+		}
+		problems = append(problems, problem{
+			pos:  fmt.Sprintf("%s:%d", filepath.ToSlash(rel), p.Line),
+			code: code,
+			msg:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	// Service packages imported by the server, and their paths relative to
+	// services/google, by the name they are referred to.
+	imports := map[string]*goPackage{}
+	importPaths := map[string]string{}
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(importPath, modulePath+"/services/google/") {
+			continue
+		}
+		rel := strings.TrimPrefix(importPath, modulePath+"/")
+		name := path.Base(rel)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		p, err := loadPackage(filepath.Join(root, filepath.FromSlash(rel)))
+		if err != nil {
+			return nil, err
+		}
+		if p == nil {
+			report(spec.Pos(), strings.TrimPrefix(rel, "services/google/"), "service package %s is not implemented", rel)
+			continue
+		}
+		imports[name] = p
+		importPaths[name] = strings.TrimPrefix(rel, "services/google/")
+	}
+	if len(imports) == 0 {
+		return problems, nil
+	}
+
+	resolve := func(e ast.Expr) *typeRef {
+		if s, ok := e.(*ast.StarExpr); ok {
+			e = s.X
+		}
+		sel, ok := e.(*ast.SelectorExpr)
+		if !ok {
+			return nil
+		}
+		x, ok := sel.X.(*ast.Ident)
+		if !ok || imports[x.Name] == nil {
+			return nil
+		}
+		return &typeRef{pkg: x.Name, name: sel.Sel.Name}
+	}
+
+	// The service type returned by each function in the file, used to type
+	// variables assigned from calls such as createConfigInstance.
+	returns := map[string]*typeRef{}
+	for _, decl := range f.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok && fd.Recv == nil && fd.Type.Results != nil && len(fd.Type.Results.List) > 0 {
+			if t := resolve(fd.Type.Results.List[0].Type); t != nil {
+				returns[fd.Name.Name] = t
+			}
+		}
+	}
+
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Body == nil {
+			continue
+		}
+		vars := map[string]*typeRef{}
+		for _, field := range fd.Type.Params.List {
+			if t := resolve(field.Type); t != nil {
+				for _, n := range field.Names {
+					vars[n.Name] = t
+				}
+			}
+		}
+
+		ast.Inspect(fd.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.AssignStmt:
+				if len(n.Rhs) != 1 {
+					return true
+				}
+				var t *typeRef
+				switch rhs := n.Rhs[0].(type) {
+				case *ast.UnaryExpr:
+					if cl, ok := rhs.X.(*ast.CompositeLit); ok {
+						t = resolve(cl.Type)
+					}
+				case *ast.CompositeLit:
+					t = resolve(rhs.Type)
+				case *ast.CallExpr:
+					if fn, ok := rhs.Fun.(*ast.Ident); ok {
+						t = returns[fn.Name]
+					}
+				}
+				if id, ok := n.Lhs[0].(*ast.Ident); ok && t != nil {
+					vars[id.Name] = t
+				}
+			case *ast.CompositeLit:
+				t := resolve(n.Type)
+				if t == nil {
+					return true
+				}
+				p := imports[t.pkg]
+				if p.fields[t.name] == nil {
+					return true
+				}
+				for _, elt := range n.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if !ok {
+						continue
+					}
+					if k, ok := kv.Key.(*ast.Ident); ok && !p.fields[t.name][k.Name] {
+						report(k.Pos(), importPaths[t.pkg]+"."+t.name+"."+k.Name, "%s.%s has no field %s", t.pkg, t.name, k.Name)
+					}
+				}
+			case *ast.SelectorExpr:
+				x, ok := n.X.(*ast.Ident)
+				if !ok {
+					return true
+				}
+				if p := imports[x.Name]; p != nil {
+					if !p.names[n.Sel.Name] {
+						report(n.Sel.Pos(), importPaths[x.Name]+"."+n.Sel.Name, "%s.%s is not implemented", x.Name, n.Sel.Name)
+					}
+					return true
+				}
+				if t := vars[x.Name]; t != nil && imports[t.pkg].names[t.name] && !imports[t.pkg].hasMember(t.name, n.Sel.Name) {
+					report(n.Sel.Pos(), importPaths[t.pkg]+"."+t.name+"."+n.Sel.Name, "%s.%s has no field or method %s", t.pkg, t.name, n.Sel.Name)
+				}
+			}
+			return true
+		})
+	}
+
+	// A missing type is referenced many times in the same file; report each
+	// problem once.
+	sort.Slice(problems, func(i, j int) bool { return problems[i].String() < problems[j].String() })
+	return dedupe(problems), nil
+}
+
+// dedupe drops the problems of a file which repeat the message of an earlier
+// one; problems are reported per line.
+func dedupe(ps []problem) []problem {
+	var out []problem
+	seen := map[string]bool{}
+	for _, p := range ps {
+		if seen[p.msg] {
+			continue
+		}
+		seen[p.msg] = true
+		out = append(out, p)
+	}
+	return out
+}
@@ -0,0 +1,433 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command connectorgen generates the server registration package of the
+// Python connector, which registers the gRPC server of every package under
+// python/services that has a register.go.
+//
+// Before generating, it checks that everything the Python servers reference
+// in the Go client library exists: service packages, types, struct fields and
+// client methods. Any missing reference fails generation, unless the missing
+// Go code is listed in pendingGoCode.
+//
+// Run it from the root of the repository:
+//
+//	go run ./cmd/connectorgen
+//
+// With -check, the registration package is compared against the generated
+// output instead of being written, and the command fails if it is stale.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const modulePath = "github.com/GoogleCloudPlatform/declarative-resource-client-library"
+
+// pendingGoCode lists the Go code which servers under python/services
+// reference but which is not implemented yet: service packages, e.g. "tpu",
+// and resources of existing packages, e.g. "compute.Address", which also
+// stand for their client methods and other types. Paths are relative to
+// services/google. Packages whose servers reference this code are left out
+// of the registration package; a reference to any other missing Go code fails
+// generation. An entry must be removed once its Go code exists.
+var pendingGoCode = map[string]bool{
+	// TODO(magic-modules-eng): generate the accesscontextmanager service package.
+	"accesscontextmanager": true,
+	// TODO(magic-modules-eng): generate these apigee resources.
+	"apigee.Attachment":                       true,
+	"apigee.Envgroup":                         true,
+	"apigee.EnvironmentGroup":                 true,
+	"apigee.EnvironmentGroupAttachment":       true,
+	"apigee.Instance":                         true,
+	"apigee/alpha.Attachment":                 true,
+	"apigee/alpha.Envgroup":                   true,
+	"apigee/alpha.EnvironmentGroup":           true,
+	"apigee/alpha.EnvironmentGroupAttachment": true,
+	"apigee/alpha.Instance":                   true,
+	"apigee/beta.Attachment":                  true,
+	"apigee/beta.Envgroup":                    true,
+	"apigee/beta.EnvironmentGroup":            true,
+	"apigee/beta.EnvironmentGroupAttachment":  true,
+	"apigee/beta.Instance":                    true,
+	// TODO(magic-modules-eng): generate the appengine service package.
+	"appengine": true,
+	// TODO(magic-modules-eng): generate the bigqueryconnection service package.
+	"bigqueryconnection": true,
+	// TODO(magic-modules-eng): generate the bigqueryreservation CapacityCommitment resource.
+	"bigqueryreservation.CapacityCommitment":       true,
+	"bigqueryreservation/alpha.CapacityCommitment": true,
+	"bigqueryreservation/beta.CapacityCommitment":  true,
+	// TODO(magic-modules-eng): generate the cloudbilling service package.
+	"cloudbilling": true,
+	// TODO(magic-modules-eng): generate the cloudbuild BuildTrigger resource.
+	"cloudbuild.BuildTrigger":      true,
+	"cloudbuild/beta.BuildTrigger": true,
+	// TODO(magic-modules-eng): generate the cloudfunctions CloudFunction resource.
+	"cloudfunctions.CloudFunction": true,
+	// TODO(magic-modules-eng): generate the composer service packages.
+	"composer":      true,
+	"composer/beta": true,
+	// TODO(magic-modules-eng): generate these compute resources.
+	"compute.Address":                    true,
+	"compute.Autoscaler":                 true,
+	"compute.BackendBucket":              true,
+	"compute.BackendService":             true,
+	"compute.Disk":                       true,
+	"compute.Firewall":                   true,
+	"compute.HealthCheck":                true,
+	"compute.HttpHealthCheck":            true,
+	"compute.HttpsHealthCheck":           true,
+	"compute.Image":                      true,
+	"compute.InstanceTemplate":           true,
+	"compute.Interconnect":               true,
+	"compute.ManagedSslCertificate":      true,
+	"compute.NetworkEndpoint":            true,
+	"compute.NetworkEndpointGroup":       true,
+	"compute.Reservation":                true,
+	"compute.Router":                     true,
+	"compute.RouterPeer":                 true,
+	"compute.Snapshot":                   true,
+	"compute.SslCertificate":             true,
+	"compute.SslPolicy":                  true,
+	"compute.TargetHttpProxy":            true,
+	"compute.TargetPool":                 true,
+	"compute.TargetSslProxy":             true,
+	"compute.TargetVpnGateway":           true,
+	"compute.UrlMap":                     true,
+	"compute.VpnGateway":                 true,
+	"compute/alpha.Interconnect":         true,
+	"compute/beta.Address":               true,
+	"compute/beta.Autoscaler":            true,
+	"compute/beta.BackendBucket":         true,
+	"compute/beta.BackendService":        true,
+	"compute/beta.Disk":                  true,
+	"compute/beta.Firewall":              true,
+	"compute/beta.HealthCheck":           true,
+	"compute/beta.HttpHealthCheck":       true,
+	"compute/beta.HttpsHealthCheck":      true,
+	"compute/beta.Image":                 true,
+	"compute/beta.InstanceTemplate":      true,
+	"compute/beta.Interconnect":          true,
+	"compute/beta.ManagedSslCertificate": true,
+	"compute/beta.NetworkEndpoint":       true,
+	"compute/beta.NetworkEndpointGroup":  true,
+	"compute/beta.Reservation":           true,
+	"compute/beta.Router":                true,
+	"compute/beta.RouterPeer":            true,
+	"compute/beta.Snapshot":              true,
+	"compute/beta.SslCertificate":        true,
+	"compute/beta.SslPolicy":             true,
+	"compute/beta.TargetHttpProxy":       true,
+	"compute/beta.TargetPool":            true,
+	"compute/beta.TargetSslProxy":        true,
+	"compute/beta.TargetVpnGateway":      true,
+	"compute/beta.UrlMap":                true,
+	"compute/beta.VpnGateway":            true,
+	// TODO(magic-modules-eng): generate the container service packages.
+	"container":      true,
+	"container/beta": true,
+	// TODO(magic-modules-eng): generate these containeraws resources.
+	"containeraws/alpha.AwsCluster":  true,
+	"containeraws/alpha.AwsNodePool": true,
+	// TODO(magic-modules-eng): generate these containerazure resources.
+	"containerazure/alpha.AzureCluster":  true,
+	"containerazure/alpha.AzureNodePool": true,
+	// TODO(magic-modules-eng): generate the dataproc Job resource.
+	"dataproc.Job":      true,
+	"dataproc/beta.Job": true,
+	// TODO(magic-modules-eng): generate the file service packages.
+	"file":      true,
+	"file/beta": true,
+	// TODO(magic-modules-eng): generate the gkemulticloud service packages.
+	"gkemulticloud":       true,
+	"gkemulticloud/alpha": true,
+	"gkemulticloud/beta":  true,
+	// TODO(magic-modules-eng): generate the healthcare service packages.
+	"healthcare":       true,
+	"healthcare/alpha": true,
+	"healthcare/beta":  true,
+	// TODO(magic-modules-eng): generate the krmapihosting service package.
+	"krmapihosting/alpha": true,
+	// TODO(magic-modules-eng): generate the monitoring AlertPolicy resource.
+	"monitoring.AlertPolicy": true,
+	// TODO(magic-modules-eng): generate these networkservices resources.
+	"networkservices/alpha.EndpointConfigSelector": true,
+	"networkservices/alpha.HttpFilter":             true,
+	"networkservices/beta.EndpointConfigSelector":  true,
+	// TODO(magic-modules-eng): generate the osconfig PatchDeployment resource.
+	"osconfig.PatchDeployment":       true,
+	"osconfig/alpha.PatchDeployment": true,
+	"osconfig/beta.PatchDeployment":  true,
+	// TODO(magic-modules-eng): generate the pubsub Subscription resource.
+	"pubsub.Subscription": true,
+	// TODO(magic-modules-eng): generate the pubsublite service package.
+	"pubsublite": true,
+	// TODO(magic-modules-eng): generate the runtimeconfig service packages.
+	"runtimeconfig":      true,
+	"runtimeconfig/beta": true,
+	// TODO(magic-modules-eng): generate the servicemanagement service packages.
+	"servicemanagement":       true,
+	"servicemanagement/alpha": true,
+	"servicemanagement/beta":  true,
+	// TODO(magic-modules-eng): generate the servicenetworking service package.
+	"servicenetworking": true,
+	// TODO(magic-modules-eng): generate the serviceusage service package.
+	"serviceusage": true,
+	// TODO(magic-modules-eng): generate the sourcerepo service package.
+	"sourcerepo": true,
+	// TODO(magic-modules-eng): generate the tier2 service package.
+	"tier2/alpha": true,
+	// TODO(magic-modules-eng): generate the tpu service package.
+	"tpu": true,
+	// TODO(magic-modules-eng): generate the vertex service packages.
+	"vertex":       true,
+	"vertex/alpha": true,
+	"vertex/beta":  true,
+	// TODO(magic-modules-eng): generate the vertexai service packages.
+	"vertexai":       true,
+	"vertexai/alpha": true,
+	"vertexai/beta":  true,
+	// TODO(magic-modules-eng): generate the vmwareengine service package.
+	"vmwareengine/alpha": true,
+}
+
+// registrationDir is where the registration package is written, relative to
+// the python directory. It is imported by the connector as
+// connector/server_registration.
+const registrationDir = "connector/server_registration"
+
+const header = `// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+`
+
+func main() {
+	root := flag.String("root", ".", "root of the repository")
+	check := flag.Bool("check", false, "fail if the registration package is out of date instead of writing it")
+	flag.Parse()
+
+	if err := run(*root, *check); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(root string, check bool) error {
+	pythonDir := filepath.Join(root, "python")
+	pkgs, err := connectorPackages(filepath.Join(pythonDir, "services"))
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+	var registered []string
+	referenced := map[string]bool{}
+	for _, pkg := range pkgs {
+		ps, err := checkPackage(root, pkg)
+		if err != nil {
+			return err
+		}
+		pending := false
+		for _, p := range ps {
+			if code := pendingEntry(p.code); code != "" {
+				referenced[code] = true
+				pending = true
+				continue
+			}
+			problems = append(problems, p.String())
+		}
+		if !pending && len(ps) == 0 {
+			registered = append(registered, pkg)
+		}
+	}
+	for code := range pendingGoCode {
+		if !referenced[code] {
+			problems = append(problems, fmt.Sprintf("%s: listed in pendingGoCode but no server references it as missing, remove it", code))
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("python servers reference unimplemented Go code:\n\t%s", strings.Join(problems, "\n\t"))
+	}
+
+	files := map[string][]byte{
+		"BUILD": buildFile(registered),
+	}
+	src, err := format.Source(registerFile(registered))
+	if err != nil {
+		return fmt.Errorf("formatting register.go: %w", err)
+	}
+	files["register.go"] = src
+
+	dir := filepath.Join(pythonDir, registrationDir)
+	if check {
+		for name, want := range files {
+			got, err := ioutil.ReadFile(filepath.Join(dir, name))
+			if err != nil || !bytes.Equal(got, want) {
+				return fmt.Errorf("%s is out of date, run go run ./cmd/connectorgen", filepath.Join(dir, name))
+			}
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for name, b := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), b, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// connectorPackages returns the packages under dir which register gRPC
+// servers, as slash-separated paths relative to dir.
+func connectorPackages(dir string) ([]string, error) {
+	var pkgs []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || info.Name() != "register.go" {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		pkgs = append(pkgs, filepath.ToSlash(rel))
+		return nil
+	})
+	sort.Strings(pkgs)
+	return pkgs, err
+}
+
+// alias returns the import alias of a connector package, e.g. redis_beta for
+// redis/beta.
+func alias(pkg string) string {
+	return strings.ReplaceAll(pkg, "/", "_")
+}
+
+func registerFile(pkgs []string) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString(`// Code generated by connectorgen. DO NOT EDIT.
+
+// Package server_registration registers the gRPC server of every DCL service
+// available through the Python connector.
+package server_registration
+
+import (
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	connectorpb "` + modulePath + `/python/proto/connector_go_proto"
+`)
+	for _, pkg := range pkgs {
+		fmt.Fprintf(&b, "\t%s_connector %q\n", alias(pkg), modulePath+"/python/services/"+pkg)
+	}
+	b.WriteString(`)
+
+// InitializeServer registers every service with grpcServer. It must be called
+// before the server handles any requests.
+func InitializeServer(grpcServer *grpc.Server) *connectorpb.InitializeResponse {
+`)
+	for _, pkg := range pkgs {
+		fmt.Fprintf(&b, "\t%s_connector.RegisterServers(grpcServer)\n", alias(pkg))
+	}
+	b.WriteString(`
+	return &connectorpb.InitializeResponse{
+		Status: &statuspb.Status{
+			Code: int32(codes.OK),
+		},
+	}
+}
+`)
+	return b.Bytes()
+}
+
+func buildFile(pkgs []string) []byte {
+	var b bytes.Buffer
+	b.WriteString(`# Code generated by connectorgen. DO NOT EDIT.
+
+load("@io_bazel_rules_go//go:def.bzl", "go_library")
+
+go_library(
+    name = "server_registration",
+    srcs = ["register.go"],
+    importpath = "` + modulePath + `/connector/server_registration",
+    visibility = ["//visibility:public"],
+    deps = [
+        "//proto/connector:connector_go_proto",
+`)
+	for _, pkg := range pkgs {
+		fmt.Fprintf(&b, "        \"//services/%s:%s_connector\",\n", pkg, alias(pkg))
+	}
+	b.WriteString(`        "@org_golang_google_genproto//googleapis/rpc/status:go_default_library",
+        "@org_golang_google_grpc//:go_default_library",
+        "@org_golang_google_grpc//codes:go_default_library",
+    ],
+)
+`)
+	return b.Bytes()
+}
+
+// pendingEntry returns the entry of pendingGoCode which covers the missing Go
+// code, or "" if there is none. A resource covers the types whose names begin
+// with its name and the methods of Client for it, e.g. "compute.Address"
+// covers "compute.AddressMaxPage" and "compute.Client.ApplyAddress".
+func pendingEntry(code string) string {
+	pkg, decl := code, ""
+	if i := strings.Index(code, "."); i >= 0 {
+		pkg, decl = code[:i], code[i+1:]
+	}
+	if pendingGoCode[pkg] {
+		return pkg
+	}
+	if strings.HasPrefix(decl, "Client.") {
+		decl = strings.TrimPrefix(decl, "Client.")
+		for _, verb := range []string{"Apply", "Get", "DeleteAll", "Delete", "Diff", "List"} {
+			if strings.HasPrefix(decl, verb) {
+				decl = strings.TrimPrefix(decl, verb)
+				break
+			}
+		}
+	}
+	for i := len(decl); i > 0; i-- {
+		if pendingGoCode[pkg+"."+decl[:i]] {
+			return pkg + "." + decl[:i]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPendingEntry(t *testing.T) {
+	tests := []struct {
+		code string
+		want string
+	}{
+		{code: "tpu", want: "tpu"},
+		{code: "tpu.Node", want: "tpu"},
+		{code: "compute.Address", want: "compute.Address"},
+		{code: "compute.AddressMaxPage", want: "compute.Address"},
+		{code: "compute.Client.ApplyAddress", want: "compute.Address"},
+		{code: "compute.Client.ListAddressWithMaxResults", want: "compute.Address"},
+		{code: "compute/beta.Client.DeleteAllAddress", want: "compute/beta.Address"},
+		// Missing code of implemented packages and resources is not pending.
+		{code: "compute"},
+		{code: "compute.Network"},
+		{code: "compute.Client.ApplyNetwork"},
+		{code: "compute.Network.Name"},
+		{code: "compute/alpha.Address"},
+		{code: "redis.Instance"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.code, func(t *testing.T) {
+			if got := pendingEntry(tc.code); got != tc.want {
+				t.Errorf("pendingEntry(%q) = %q, want %q", tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRunMissingGoCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		server  string
+		wantErr string
+	}{
+		{
+			name: "pending package",
+			server: `package tpu_connector
+
+import tpupb "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/tpu"
+
+var _ = tpupb.Node{}
+`,
+		},
+		{
+			name: "missing package",
+			server: `package tpu_connector
+
+import redispb "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/redis/v2"
+
+var _ = redispb.Instance{}
+`,
+			wantErr: "service package services/google/redis/v2 is not implemented",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			root := t.TempDir()
+			dir := filepath.Join(root, "python", "services", "tpu")
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			for name, src := range map[string]string{"register.go": "package tpu_connector\n", "node_server.go": tc.server} {
+				if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := run(root, true)
+			if err == nil || !strings.Contains(err.Error(), "python servers reference unimplemented Go code") {
+				t.Fatalf("run() error = %v, want the problems of the servers", err)
+			}
+			if got := strings.Contains(err.Error(), "python/services/tpu/node_server.go"); got != (tc.wantErr != "") {
+				t.Errorf("run() error = %v, want a problem in node_server.go %v", err, tc.wantErr != "")
+			}
+			if tc.wantErr != "" && !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("run() error = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
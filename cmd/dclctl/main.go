@@ -0,0 +1,548 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Command dclctl gets, applies, diffs, deletes, lists, and exports DCL
+// resources described by JSON or YAML manifests. It uses the unstructured
+// client, so it runs exactly the same reconciliation logic as any other
+// DCL caller.
+//
+// A manifest is a ServiceTypeVersion header followed by the resource object:
+//
+//	service: storage
+//	type: Bucket
+//	version: ga
+//	object:
+//	  project: my-project
+//	  name: my-bucket
+//	  location: US
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl/celpolicy"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google"
+	"gopkg.in/yaml.v2"
+)
+
+const usage = `Usage: dclctl <command> [flags]
+
+Commands:
+  get     -f FILE                  print the live state of each resource in FILE
+  apply   -f FILE                  create or update each resource in FILE
+  diff    -f FILE                  report which resources in FILE differ from their live state
+  drift   -f FILE                  report the field-level drift of each resource in FILE as JSON or SARIF
+  delete  -f FILE                  delete each resource in FILE
+  list    -f FILE                  list every resource sharing the parent of each resource in FILE
+  export  -f FILE                  print each resource in FILE as a manifest that can be applied
+  export  -parent PARENT -types T  print every resource of the given types under PARENT as manifests
+  schema  SERVICE TYPE VERSION     print the schema of a resource type
+
+Run "dclctl <command> -h" for the flags of a command.
+Credentials are read from --credentials-file, or from Application Default Credentials.
+`
+
+// errHasDiff is returned by diff when at least one resource differs from its live state.
+var errHasDiff = errors.New("resources differ from their live state")
+
+type command struct {
+	run func(ctx context.Context, c *dcl.Config, f *commonFlags, args []string) error
+	// register adds command-specific flags to the flag set.
+	register func(fs *flag.FlagSet)
+}
+
+var commands = map[string]*command{
+	"get":    {run: runGet},
+	"apply":  {run: runApply, register: registerLifecycleFlags},
+	"diff":   {run: runDiff},
+	"drift":  {run: runDrift, register: registerDriftFlags},
+	"delete": {run: runDelete},
+	"list":   {run: runList},
+	"export": {run: runExport, register: registerExportFlags},
+	"schema": {run: runSchema},
+}
+
+// commonFlags holds the flags shared by every command.
+type commonFlags struct {
+	file            string
+	output          string
+	config          string
+	credentialsFile string
+	userAgent       string
+	billingProject  string
+	basePath        string
+	endpoints       paramFlag
+	universeDomain  string
+	ignoreFields    string
+	timeout         time.Duration
+	verbose         bool
+}
+
+func (f *commonFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.file, "f", "", `manifest file to read, or "-" for stdin`)
+	fs.StringVar(&f.output, "o", "yaml", "output format: yaml or json, or sarif for drift")
+	fs.StringVar(&f.config, "config", "", "YAML or JSON DCL config file; DCL_ environment variables and flags take precedence over it")
+	fs.StringVar(&f.credentialsFile, "credentials-file", "", "service account or refresh token JSON credentials file; defaults to Application Default Credentials")
+	fs.StringVar(&f.userAgent, "user-agent", "", `user agent to prepend to the DCL user agent (default "dclctl")`)
+	fs.StringVar(&f.billingProject, "billing-project", "", "project to bill for API calls; sets X-Goog-User-Project")
+	fs.StringVar(&f.basePath, "base-path", "", "override the base path of every API call")
+	fs.Var(&f.endpoints, "endpoint", "SERVICE=URL endpoint of a service or host, e.g. pubsub=http://localhost:8085; may be repeated")
+	fs.StringVar(&f.universeDomain, "universe-domain", "", "domain to send requests for googleapis.com hosts to")
+	fs.StringVar(&f.ignoreFields, "ignore-fields", "", "comma-separated field paths whose changes are ignored by apply, diff, and drift, e.g. targetSize,metadata.ssh-keys")
+	fs.DurationVar(&f.timeout, "timeout", 0, "override the timeout of each operation")
+	fs.BoolVar(&f.verbose, "v", false, "log every request and response")
+}
+
+// configOptions returns the ConfigOptions described by the flags, the config file, and
+// the environment, in increasing order of precedence: dclctl's defaults, the config file,
+// the environment, and the flags.
+func (f *commonFlags) configOptions() ([]dcl.ConfigOption, error) {
+	opts := []dcl.ConfigOption{
+		dcl.WithUserAgent("dclctl"),
+		dcl.WithLogger(dcl.DefaultLogger(dcl.Warning)),
+	}
+	fileOpts, err := dcl.LoadConfigOptions(f.config)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, fileOpts...)
+	if f.userAgent != "" {
+		opts = append(opts, dcl.WithUserAgent(f.userAgent))
+	}
+	if f.verbose {
+		opts = append(opts, dcl.WithLogger(dcl.DefaultLogger(dcl.LoggerInfo)))
+	}
+	if f.credentialsFile != "" {
+		opts = append(opts, dcl.WithCredentialsFile(f.credentialsFile))
+	}
+	if f.billingProject != "" {
+		opts = append(opts, dcl.WithBillingProject(f.billingProject), dcl.WithUserProjectOverride())
+	}
+	if f.basePath != "" {
+		opts = append(opts, dcl.WithBasePath(f.basePath))
+	}
+	for service, endpoints := range f.endpoints {
+		opts = append(opts, dcl.WithEndpoint(service, endpoints[len(endpoints)-1]))
+	}
+	if f.universeDomain != "" {
+		opts = append(opts, dcl.WithUniverseDomain(f.universeDomain))
+	}
+	if f.timeout != 0 {
+		opts = append(opts, dcl.WithTimeout(f.timeout))
+	}
+	return opts, nil
+}
+
+// ignoreOptions returns the ApplyOptions described by the -ignore-fields flag.
+func (f *commonFlags) ignoreOptions() []dcl.ApplyOption {
+	if paths := splitList(f.ignoreFields); len(paths) > 0 {
+		return []dcl.ApplyOption{dcl.WithIgnoredFieldPaths(paths...)}
+	}
+	return nil
+}
+
+// lifecycleFlags maps apply flags onto dcl.LifecycleParams.
+var lifecycleFlags = []struct {
+	name  string
+	param dcl.LifecycleParam
+	help  string
+	set   bool
+}{
+	{name: "block-destruction", param: dcl.BlockDestruction, help: "fail rather than delete and recreate a resource"},
+	{name: "block-acquire", param: dcl.BlockAcquire, help: "fail if a resource already exists"},
+	{name: "block-creation", param: dcl.BlockCreation, help: "fail if a resource does not exist"},
+	{name: "block-modification", param: dcl.BlockModification, help: "fail if a resource is not in the desired state"},
+	{name: "ignore-if-missing", param: dcl.IgnoreIfMissing, help: "do nothing if a resource does not exist"},
+}
+
+// policyFile is the file of CEL rules which applied resources must satisfy.
+var policyFile string
+
+func registerLifecycleFlags(fs *flag.FlagSet) {
+	for i := range lifecycleFlags {
+		fs.BoolVar(&lifecycleFlags[i].set, lifecycleFlags[i].name, false, lifecycleFlags[i].help)
+	}
+	fs.StringVar(&policyFile, "policy", "", "YAML file of CEL rules which every resource must satisfy before it is applied")
+}
+
+func applyOptions() ([]dcl.ApplyOption, error) {
+	var opts []dcl.ApplyOption
+	for _, lf := range lifecycleFlags {
+		if lf.set {
+			opts = append(opts, dcl.WithLifecycleParam(lf.param))
+		}
+	}
+	if policyFile != "" {
+		v, err := readPolicy(policyFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithApplyPolicyValidators(v))
+	}
+	return opts, nil
+}
+
+// readPolicy compiles the rules in a policy file of the form
+//
+//	rules:
+//	- name: cost-center-label
+//	  expression: has(resource.labels) && "cost-center" in resource.labels
+//	  message: resources must have a cost-center label
+//	  fieldPath: labels
+func readPolicy(path string) (dcl.PolicyValidator, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var p struct {
+		Rules []celpolicy.Rule `yaml:"rules"`
+	}
+	if err := yaml.UnmarshalStrict(b, &p); err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %w", path, err)
+	}
+	return celpolicy.NewValidator(p.Rules...)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	name := os.Args[1]
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "dclctl: unknown command %q\n\n%s", name, usage)
+		os.Exit(2)
+	}
+
+	var f commonFlags
+	fs := flag.NewFlagSet("dclctl "+name, flag.ExitOnError)
+	f.register(fs)
+	if cmd.register != nil {
+		cmd.register(fs)
+	}
+	fs.Parse(os.Args[2:])
+
+	ctx := context.Background()
+	opts, err := f.configOptions()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dclctl %s: %v\n", name, err)
+		os.Exit(2)
+	}
+	c := dcl.NewConfig(opts...)
+	if err := cmd.run(ctx, c, &f, fs.Args()); err != nil {
+		if errors.Is(err, errHasDiff) {
+			os.Exit(1)
+		}
+		fmt.Fprintf(os.Stderr, "dclctl %s: %v\n", name, err)
+		os.Exit(2)
+	}
+}
+
+// readInput returns the resources in the manifest file named by the -f flag.
+func readInput(f *commonFlags) ([]*unstructured.Resource, error) {
+	if f.file == "" {
+		return nil, errors.New("a manifest file must be provided with -f")
+	}
+	var b []byte
+	var err error
+	if f.file == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(f.file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeManifests(b)
+}
+
+func runGet(ctx context.Context, c *dcl.Config, f *commonFlags, _ []string) error {
+	rs, err := readInput(f)
+	if err != nil {
+		return err
+	}
+	var out []*unstructured.Resource
+	for _, r := range rs {
+		if r, err = resolve(ctx, c, r, rs); err != nil {
+			return err
+		}
+		live, err := unstructured.Get(ctx, c, r)
+		if err != nil {
+			return describe(r, err)
+		}
+		out = append(out, live)
+	}
+	return writeManifests(os.Stdout, f.output, out)
+}
+
+func runApply(ctx context.Context, c *dcl.Config, f *commonFlags, _ []string) error {
+	rs, err := readInput(f)
+	if err != nil {
+		return err
+	}
+	opts, err := applyOptions()
+	if err != nil {
+		return err
+	}
+	opts = append(opts, f.ignoreOptions()...)
+	var out []*unstructured.Resource
+	for _, r := range rs {
+		if r, err = resolve(ctx, c, r, rs); err != nil {
+			return err
+		}
+		applied, err := unstructured.Apply(ctx, c, r, opts...)
+		if err != nil {
+			return describe(r, err)
+		}
+		out = append(out, applied)
+	}
+	return writeManifests(os.Stdout, f.output, out)
+}
+
+func runDiff(ctx context.Context, c *dcl.Config, f *commonFlags, _ []string) error {
+	rs, err := readInput(f)
+	if err != nil {
+		return err
+	}
+	anyDiff := false
+	for _, r := range rs {
+		if r, err = resolve(ctx, c, r, rs); err != nil {
+			return err
+		}
+		hasDiff, err := unstructured.HasDiff(ctx, c, r, f.ignoreOptions()...)
+		if err != nil {
+			return describe(r, err)
+		}
+		status := "up to date"
+		if hasDiff {
+			status = "differs"
+			anyDiff = true
+		}
+		fmt.Fprintf(os.Stdout, "%s: %s\n", name(r), status)
+	}
+	if anyDiff {
+		return errHasDiff
+	}
+	return nil
+}
+
+func runDrift(ctx context.Context, c *dcl.Config, f *commonFlags, _ []string) error {
+	rs, err := readInput(f)
+	if err != nil {
+		return err
+	}
+	report, err := unstructured.ScanDrift(ctx, c, rs, unstructured.DriftOptions{
+		Parallelism:      driftFlags.parallelism,
+		DetectUnexpected: driftFlags.unexpected,
+		ApplyOptions:     f.ignoreOptions(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := writeDriftReport(os.Stdout, f.output, report); err != nil {
+		return err
+	}
+	if report.HasDrift() {
+		return errHasDiff
+	}
+	return nil
+}
+
+func runDelete(ctx context.Context, c *dcl.Config, f *commonFlags, _ []string) error {
+	rs, err := readInput(f)
+	if err != nil {
+		return err
+	}
+	for _, r := range rs {
+		if r, err = resolve(ctx, c, r, rs); err != nil {
+			return err
+		}
+		if err := unstructured.Delete(ctx, c, r); err != nil {
+			return describe(r, err)
+		}
+		fmt.Fprintf(os.Stdout, "%s: deleted\n", name(r))
+	}
+	return nil
+}
+
+func runList(ctx context.Context, c *dcl.Config, f *commonFlags, _ []string) error {
+	rs, err := readInput(f)
+	if err != nil {
+		return err
+	}
+	var out []*unstructured.Resource
+	for _, r := range rs {
+		l, err := unstructured.List(ctx, c, r)
+		if err != nil {
+			return describe(r, err)
+		}
+		out = append(out, l...)
+	}
+	return writeManifests(os.Stdout, f.output, out)
+}
+
+func runExport(ctx context.Context, c *dcl.Config, f *commonFlags, _ []string) error {
+	if exportFlags.parent != "" {
+		opts := unstructured.ExportOptions{
+			Parent:    exportFlags.parent,
+			Locations: splitList(exportFlags.locations),
+			Params:    exportFlags.params,
+		}
+		for _, t := range splitList(exportFlags.types) {
+			stv, err := parseSTV(t)
+			if err != nil {
+				return err
+			}
+			opts.Types = append(opts.Types, stv)
+		}
+		rs, err := unstructured.Export(ctx, c, opts)
+		if err != nil {
+			return err
+		}
+		return writeManifests(os.Stdout, f.output, rs)
+	}
+
+	rs, err := readInput(f)
+	if err != nil {
+		return err
+	}
+	var live []*unstructured.Resource
+	for _, r := range rs {
+		if r, err = resolve(ctx, c, r, rs); err != nil {
+			return err
+		}
+		l, err := unstructured.Get(ctx, c, r)
+		if err != nil {
+			return describe(r, err)
+		}
+		live = append(live, l)
+	}
+	out, err := unstructured.ExportResources(ctx, c, live)
+	if err != nil {
+		return err
+	}
+	return writeManifests(os.Stdout, f.output, out)
+}
+
+func runSchema(_ context.Context, _ *dcl.Config, f *commonFlags, args []string) error {
+	if len(args) != 3 {
+		return errors.New("usage: dclctl schema SERVICE TYPE VERSION")
+	}
+	r := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: args[0],
+			Type:    args[1],
+			Version: args[2],
+		},
+	}
+	s, err := unstructured.Schema(r)
+	if err != nil {
+		return err
+	}
+	return writeSchema(os.Stdout, f.output, s)
+}
+
+// driftFlags holds the flags of the drift command.
+var driftFlags struct {
+	parallelism int
+	unexpected  bool
+}
+
+func registerDriftFlags(fs *flag.FlagSet) {
+	fs.IntVar(&driftFlags.parallelism, "parallelism", 0, "number of resources to read concurrently")
+	fs.BoolVar(&driftFlags.unexpected, "unexpected", false, "also report live resources which share a parent with a resource in -f but are not in -f")
+}
+
+// exportFlags holds the flags of the export command.
+var exportFlags struct {
+	parent    string
+	types     string
+	locations string
+	params    paramFlag
+}
+
+func registerExportFlags(fs *flag.FlagSet) {
+	fs.StringVar(&exportFlags.parent, "parent", "", "export every resource under this project, folder, or organization instead of the resources in -f, e.g. projects/my-project")
+	fs.StringVar(&exportFlags.types, "types", "", "comma-separated resource types to export with -parent, e.g. storage.Bucket.ga,compute.Network.ga")
+	fs.StringVar(&exportFlags.locations, "locations", "", "comma-separated locations, regions, or zones to export with -parent")
+	fs.Var(&exportFlags.params, "param", "NAME=VALUE value of another list parameter for -parent; may be repeated")
+}
+
+// paramFlag collects repeated NAME=VALUE flags.
+type paramFlag map[string][]string
+
+func (p *paramFlag) String() string {
+	return fmt.Sprint(map[string][]string(*p))
+}
+
+func (p *paramFlag) Set(v string) error {
+	kv := strings.SplitN(v, "=", 2)
+	if len(kv) != 2 {
+		return fmt.Errorf("expected NAME=VALUE, got %q", v)
+	}
+	if *p == nil {
+		*p = make(paramFlag)
+	}
+	(*p)[kv[0]] = append((*p)[kv[0]], kv[1])
+	return nil
+}
+
+func splitList(s string) []string {
+	var l []string
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			l = append(l, e)
+		}
+	}
+	return l
+}
+
+// parseSTV parses a resource type of the form SERVICE.TYPE.VERSION.
+func parseSTV(s string) (unstructured.ServiceTypeVersion, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return unstructured.ServiceTypeVersion{}, fmt.Errorf("resource type %q must have the form SERVICE.TYPE.VERSION", s)
+	}
+	return unstructured.ServiceTypeVersion{Service: parts[0], Type: parts[1], Version: parts[2]}, nil
+}
+
+// name returns a human-readable name for r, used in command output.
+func name(r *unstructured.Resource) string {
+	if id, err := unstructured.ID(r); err == nil {
+		return fmt.Sprintf("%s %s", r.STV.String(), id)
+	}
+	return r.STV.String()
+}
+
+// resolve replaces the {{ref:...}} tokens in r with values from the resources in rs.
+func resolve(ctx context.Context, c *dcl.Config, r *unstructured.Resource, rs []*unstructured.Resource) (*unstructured.Resource, error) {
+	resolved, err := unstructured.ResolveReferences(ctx, c, r, rs)
+	if err != nil {
+		return r, describe(r, err)
+	}
+	return resolved, nil
+}
+
+func describe(r *unstructured.Resource, err error) error {
+	return fmt.Errorf("%s: %w", name(r), err)
+}
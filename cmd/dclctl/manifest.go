@@ -0,0 +1,192 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+	"gopkg.in/yaml.v2"
+)
+
+// yamlSeparator splits a YAML stream into documents.
+var yamlSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+// decodeManifests parses one or more manifests from b. JSON input may be a
+// single manifest or an array of manifests; YAML input may contain several
+// documents separated by "---".
+func decodeManifests(b []byte) ([]*unstructured.Resource, error) {
+	trimmed := bytes.TrimSpace(b)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var docs []json.RawMessage
+		if err := json.Unmarshal(trimmed, &docs); err != nil {
+			return nil, err
+		}
+		var rs []*unstructured.Resource
+		for _, d := range docs {
+			r, err := unstructured.ParseManifest(d)
+			if err != nil {
+				return nil, err
+			}
+			rs = append(rs, r)
+		}
+		return rs, nil
+	}
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		r, err := unstructured.ParseManifest(trimmed)
+		if err != nil {
+			return nil, err
+		}
+		return []*unstructured.Resource{r}, nil
+	}
+
+	var rs []*unstructured.Resource
+	for _, doc := range yamlSeparator.Split(string(b), -1) {
+		if len(bytes.TrimSpace([]byte(doc))) == 0 {
+			continue
+		}
+		var v interface{}
+		if err := yaml.Unmarshal([]byte(doc), &v); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML manifest: %w", err)
+		}
+		j, err := json.Marshal(jsonCompatible(v))
+		if err != nil {
+			return nil, err
+		}
+		r, err := unstructured.ParseManifest(j)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+// jsonCompatible converts the map[interface{}]interface{} values produced by
+// the YAML decoder into map[string]interface{} so they can be encoded as JSON.
+func jsonCompatible(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			m[fmt.Sprint(k)] = jsonCompatible(e)
+		}
+		return m
+	case []interface{}:
+		for i, e := range t {
+			t[i] = jsonCompatible(e)
+		}
+		return t
+	}
+	return v
+}
+
+// writeManifests writes rs to w in the given format.
+func writeManifests(w io.Writer, format string, rs []*unstructured.Resource) error {
+	switch format {
+	case "json":
+		var ms []*unstructured.Manifest
+		for _, r := range rs {
+			ms = append(ms, unstructured.ResourceToManifest(r))
+		}
+		var v interface{} = ms
+		if len(ms) == 1 {
+			v = ms[0]
+		}
+		b, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", b)
+		return err
+	case "yaml":
+		for i, r := range rs {
+			if i > 0 {
+				if _, err := fmt.Fprintln(w, "---"); err != nil {
+					return err
+				}
+			}
+			b, err := yaml.Marshal(yaml.MapSlice{
+				{Key: "service", Value: r.STV.Service},
+				{Key: "type", Value: r.STV.Type},
+				{Key: "version", Value: r.STV.Version},
+				{Key: "object", Value: r.Object},
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := w.Write(b); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown output format %q", format)
+}
+
+// writeDriftReport writes report to w in the given format. The yaml format
+// is the JSON report converted to YAML.
+func writeDriftReport(w io.Writer, format string, report *unstructured.DriftReport) error {
+	var b []byte
+	var err error
+	switch format {
+	case "sarif":
+		b, err = report.SARIF()
+	case "json", "yaml":
+		b, err = report.JSON()
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+	if err != nil {
+		return err
+	}
+	if format == "yaml" {
+		var v yaml.MapSlice
+		if err := yaml.Unmarshal(b, &v); err != nil {
+			return err
+		}
+		if b, err = yaml.Marshal(v); err != nil {
+			return err
+		}
+	} else {
+		b = append(b, '\n')
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// writeSchema writes the OpenAPI form of s to w in the given format.
+func writeSchema(w io.Writer, format string, s *dcl.Schema) error {
+	b, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if format == "json" {
+		var v interface{}
+		if err := yaml.Unmarshal(b, &v); err != nil {
+			return err
+		}
+		if b, err = json.MarshalIndent(jsonCompatible(v), "", "  "); err != nil {
+			return err
+		}
+		b = append(b, '\n')
+	}
+	_, err = w.Write(b)
+	return err
+}
@@ -0,0 +1,296 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/api/googleapi"
+)
+
+// Phase is the step of a resource's lifecycle in which an error occurred.
+type Phase string
+
+// Phases of a resource's lifecycle.
+const (
+	PhaseRead   Phase = "read"
+	PhaseCreate Phase = "create"
+	PhaseUpdate Phase = "update"
+	PhaseDelete Phase = "delete"
+)
+
+// ApplyPhase returns PhaseCreate if an apply is creating its resource and PhaseUpdate
+// otherwise.
+func ApplyPhase(create bool) Phase {
+	if create {
+		return PhaseCreate
+	}
+	return PhaseUpdate
+}
+
+// ResourceError is an error which occurred while reading or changing a resource. It
+// wraps the underlying error, usually a *googleapi.Error or an *OperationError, so that
+// errors.Is and errors.As see through it.
+type ResourceError struct {
+	Resource ServiceTypeVersion
+	// ID is the ID of the resource, or empty if it could not be determined.
+	ID    string
+	Phase Phase
+	Err   error
+}
+
+func (e *ResourceError) Error() string {
+	if e.ID == "" {
+		return fmt.Sprintf("failed to %s %s: %v", e.Phase, e.Resource.Type, e.Err)
+	}
+	return fmt.Sprintf("failed to %s %s %q: %v", e.Phase, e.Resource.Type, e.ID, e.Err)
+}
+
+func (e *ResourceError) Unwrap() error {
+	return e.Err
+}
+
+// resourceWithID is a resource which can report its ID.
+type resourceWithID interface {
+	Resource
+	ID() (string, error)
+}
+
+// NewResourceError wraps err with the type, ID, and lifecycle phase of r. It returns nil
+// if err is nil and returns err as it is if it is already a *ResourceError.
+func NewResourceError(r Resource, phase Phase, err error) error {
+	if err == nil {
+		return nil
+	}
+	var re *ResourceError
+	if errors.As(err, &re) {
+		return err
+	}
+	e := &ResourceError{Resource: r.Describe(), Phase: phase, Err: err}
+	if rid, ok := r.(resourceWithID); ok {
+		// The ID is informational, so resources whose ID cannot be built are still reported.
+		e.ID, _ = rid.ID()
+	}
+	return e
+}
+
+// OperationError is returned when a long-running operation finishes with an error.
+type OperationError struct {
+	// Operation is the name or self link of the operation.
+	Operation string
+	// Code is the HTTP status code equivalent to the error, or 0 if it is unknown.
+	Code int
+	// Status is the canonical status of the error, e.g. "RESOURCE_EXHAUSTED", or the
+	// API-specific error code if the API does not report a canonical status.
+	Status string
+	// Message describes the error.
+	Message string
+	// Details are the google.rpc error details of the error, as decoded from JSON.
+	Details []interface{}
+}
+
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("operation received error: %s", e.Message)
+}
+
+// rpcCodes maps google.rpc.Code values to their names and HTTP status codes.
+var rpcCodes = map[int]struct {
+	status string
+	http   int
+}{
+	1:  {"CANCELLED", 499},
+	2:  {"UNKNOWN", 500},
+	3:  {"INVALID_ARGUMENT", 400},
+	4:  {"DEADLINE_EXCEEDED", 504},
+	5:  {"NOT_FOUND", 404},
+	6:  {"ALREADY_EXISTS", 409},
+	7:  {"PERMISSION_DENIED", 403},
+	8:  {"RESOURCE_EXHAUSTED", 429},
+	9:  {"FAILED_PRECONDITION", 400},
+	10: {"ABORTED", 409},
+	11: {"OUT_OF_RANGE", 400},
+	12: {"UNIMPLEMENTED", 501},
+	13: {"INTERNAL", 500},
+	14: {"UNAVAILABLE", 503},
+	15: {"DATA_LOSS", 500},
+	16: {"UNAUTHENTICATED", 401},
+}
+
+// NewRPCOperationError returns the OperationError for an operation which failed with a
+// google.rpc.Status.
+func NewRPCOperationError(operation string, code int, message string, details []interface{}) *OperationError {
+	e := &OperationError{
+		Operation: operation,
+		Message:   message,
+		Details:   details,
+	}
+	if c, ok := rpcCodes[code]; ok {
+		e.Code = c.http
+		e.Status = c.status
+	}
+	return e
+}
+
+// NewStatusOperationError returns the OperationError for an operation which failed with
+// the name of a google.rpc.Code, e.g. "NOT_FOUND". A status which is not the name of a
+// code is kept as the Status of the error, which then has no HTTP status code.
+func NewStatusOperationError(operation, status, message string) *OperationError {
+	for code, c := range rpcCodes {
+		if c.status == status {
+			return NewRPCOperationError(operation, code, message, nil)
+		}
+	}
+	return &OperationError{
+		Operation: operation,
+		Status:    status,
+		Message:   message,
+	}
+}
+
+// ErrorInfo describes the cause of an error, from google.rpc.ErrorInfo.
+type ErrorInfo struct {
+	Reason   string
+	Domain   string
+	Metadata map[string]string
+}
+
+// QuotaViolation is a quota check which failed, from google.rpc.QuotaFailure.
+type QuotaViolation struct {
+	Subject     string
+	Description string
+}
+
+// FieldViolation is an invalid field of a request, from google.rpc.BadRequest.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// PreconditionViolation is a precondition which failed, from google.rpc.PreconditionFailure.
+type PreconditionViolation struct {
+	Type        string
+	Subject     string
+	Description string
+}
+
+// ErrorDetails are the google.rpc error details of an error returned by a GCP API.
+type ErrorDetails struct {
+	ErrorInfo              []ErrorInfo
+	QuotaViolations        []QuotaViolation
+	FieldViolations        []FieldViolation
+	PreconditionViolations []PreconditionViolation
+}
+
+// Details returns the google.rpc error details of err, which may wrap a *googleapi.Error
+// or an *OperationError. It returns false if err has no details it recognizes.
+func Details(err error) (*ErrorDetails, bool) {
+	var raw []interface{}
+	var gerr *googleapi.Error
+	var oerr *OperationError
+	if errors.As(err, &gerr) {
+		raw = gerr.Details
+	} else if errors.As(err, &oerr) {
+		raw = oerr.Details
+	}
+	d := &ErrorDetails{}
+	found := false
+	for _, r := range raw {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch m["@type"] {
+		case "type.googleapis.com/google.rpc.ErrorInfo":
+			ei := ErrorInfo{Reason: detailString(m, "reason"), Domain: detailString(m, "domain")}
+			if md, ok := m["metadata"].(map[string]interface{}); ok {
+				ei.Metadata = make(map[string]string, len(md))
+				for k, v := range md {
+					ei.Metadata[k] = fmt.Sprint(v)
+				}
+			}
+			d.ErrorInfo = append(d.ErrorInfo, ei)
+		case "type.googleapis.com/google.rpc.QuotaFailure":
+			for _, v := range detailList(m, "violations") {
+				d.QuotaViolations = append(d.QuotaViolations, QuotaViolation{
+					Subject:     detailString(v, "subject"),
+					Description: detailString(v, "description"),
+				})
+			}
+		case "type.googleapis.com/google.rpc.BadRequest":
+			for _, v := range detailList(m, "fieldViolations") {
+				d.FieldViolations = append(d.FieldViolations, FieldViolation{
+					Field:       detailString(v, "field"),
+					Description: detailString(v, "description"),
+				})
+			}
+		case "type.googleapis.com/google.rpc.PreconditionFailure":
+			for _, v := range detailList(m, "violations") {
+				d.PreconditionViolations = append(d.PreconditionViolations, PreconditionViolation{
+					Type:        detailString(v, "type"),
+					Subject:     detailString(v, "subject"),
+					Description: detailString(v, "description"),
+				})
+			}
+		default:
+			continue
+		}
+		found = true
+	}
+	return d, found
+}
+
+// ErrorReason returns the reason of the first google.rpc.ErrorInfo detail of err, e.g.
+// "SERVICE_DISABLED", or an empty string if it has none.
+func ErrorReason(err error) string {
+	if d, ok := Details(err); ok && len(d.ErrorInfo) > 0 {
+		return d.ErrorInfo[0].Reason
+	}
+	return ""
+}
+
+// ErrorCode returns the HTTP status code of err, which may wrap a *googleapi.Error or an
+// *OperationError, or be the error of a call resumed from an async token, or 0 if it has
+// none.
+func ErrorCode(err error) int {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
+		return gerr.Code
+	}
+	var oerr *OperationError
+	if errors.As(err, &oerr) {
+		return oerr.Code
+	}
+	var aerr *asyncError
+	if errors.As(err, &aerr) {
+		return aerr.Code
+	}
+	return 0
+}
+
+func detailString(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+func detailList(m map[string]interface{}, key string) []map[string]interface{} {
+	l, _ := m[key].([]interface{})
+	var result []map[string]interface{}
+	for _, e := range l {
+		if em, ok := e.(map[string]interface{}); ok {
+			result = append(result, em)
+		}
+	}
+	return result
+}
@@ -0,0 +1,420 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+)
+
+const asyncCallKey ReqCtxKey = "AsyncCallKey"
+
+// ErrStepsRemaining is returned by the Wait of a handle resumed from a token once its
+// operation has finished, if its call had steps left after the operation. Those steps can
+// only be resumed by the handle the call was started with; applying the resource again
+// completes them.
+var ErrStepsRemaining = errors.New("the operation finished, but the steps of the call after it were not completed")
+
+// ErrCancelUnsupported is returned when cancelling an operation which its API cannot cancel.
+var ErrCancelUnsupported = errors.New("operation cannot be cancelled")
+
+// errOperationDeferred unwinds an asynchronous call once it has deferred an operation.
+var errOperationDeferred = errors.New("operation deferred to an async handle")
+
+// PendingOperation is a long-running operation which an AsyncHandle waits on.
+type PendingOperation interface {
+	// Poll fetches the operation once and returns true if it has finished, along with
+	// its error if it failed.
+	Poll(ctx context.Context) (bool, error)
+	// Cancel asks the API to cancel the operation. It returns ErrCancelUnsupported if
+	// the API cannot cancel operations of its type.
+	Cancel(ctx context.Context) error
+}
+
+// PendingOperationFactory recreates a PendingOperation from the state it was deferred with.
+type PendingOperationFactory func(c *Config, state json.RawMessage) (PendingOperation, error)
+
+var (
+	pendingOperationTypesMu sync.RWMutex
+	pendingOperationTypes   = map[string]PendingOperationFactory{}
+)
+
+// RegisterPendingOperation registers the factory which recreates deferred operations of
+// type typ from the tokens of their handles.
+func RegisterPendingOperation(typ string, f PendingOperationFactory) {
+	pendingOperationTypesMu.Lock()
+	defer pendingOperationTypesMu.Unlock()
+	pendingOperationTypes[typ] = f
+}
+
+// asyncExchange is a request sent by an asynchronous call, and the result it got.
+type asyncExchange struct {
+	verb, url string
+	details   *RetryDetails
+	body      []byte
+	err       error
+}
+
+// result returns a copy of the recorded result, whose response body can be read again.
+func (e *asyncExchange) result() (*RetryDetails, error) {
+	if e.details == nil || e.details.Response == nil {
+		return e.details, e.err
+	}
+	res := *e.details.Response
+	res.Body = ioutil.NopCloser(bytes.NewReader(e.body))
+	return &RetryDetails{Request: e.details.Request, Response: &res}, e.err
+}
+
+// asyncRun is one run of the function an asynchronous call was started with. A run
+// replays the requests of the runs before it, in order, and sends the requests after them,
+// so that it reaches the operation the last run deferred in the same state and carries on
+// from there instead of repeating the requests which started it.
+type asyncRun struct {
+	mu        sync.Mutex
+	exchanges []*asyncExchange
+	// replayed is the number of exchanges the run has replayed, out of the recorded ones
+	// which it started with.
+	replayed, recorded int
+	// waited is the number of operations the earlier runs reached, which have finished,
+	// and started the number of operations this run has reached.
+	waited, started int
+	typ             string
+	state           json.RawMessage
+	op              PendingOperation
+}
+
+func asyncRunFromContext(ctx context.Context) (*asyncRun, bool) {
+	r, ok := ctx.Value(asyncCallKey).(*asyncRun)
+	return r, ok
+}
+
+// replaying returns true if the run has recorded requests left to replay.
+func (r *asyncRun) replaying() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.replayed < r.recorded
+}
+
+// send replays the next recorded request of the run, or sends the request with send and
+// records it once the recorded ones have all been replayed.
+func (r *asyncRun) send(ctx context.Context, verb, url string, send func() (*RetryDetails, error)) (*RetryDetails, error) {
+	r.mu.Lock()
+	if r.op != nil {
+		r.mu.Unlock()
+		return nil, errOperationDeferred
+	}
+	if r.replayed < r.recorded {
+		e := r.exchanges[r.replayed]
+		r.replayed++
+		r.mu.Unlock()
+		if e.verb != verb || e.url != url {
+			return nil, fmt.Errorf("cannot resume async call: it sent %s %s where it sent %s %s before", verb, url, e.verb, e.url)
+		}
+		return e.result()
+	}
+	r.mu.Unlock()
+
+	details, err := send()
+	if ctx.Err() != nil {
+		// A request cut short by its context is sent again when the call is resumed.
+		return details, err
+	}
+	e := &asyncExchange{verb: verb, url: url, details: details, err: err}
+	if details != nil && details.Response != nil {
+		b, rerr := ioutil.ReadAll(details.Response.Body)
+		details.Response.Body.Close()
+		if rerr != nil {
+			return nil, rerr
+		}
+		e.body = b
+		details.Response.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	r.mu.Lock()
+	r.exchanges = append(r.exchanges, e)
+	r.mu.Unlock()
+	return details, err
+}
+
+// DeferOperation hands op to the AsyncHandle of the asynchronous call made with ctx, if
+// ctx belongs to one, and returns an error which the caller must return so that the call
+// unwinds. The handle resumes the call once op has finished, and DeferOperation returns
+// nil when the resumed call reaches op again, so that the caller waits on op as usual,
+// finds it finished, and the rest of the call continues from there. state must marshal
+// to JSON which the factory registered for typ can recreate op from.
+//
+// Operations whose Wait does not call DeferOperation are waited on within the call, by
+// StartAsync or by the Poll or Wait of its handle.
+func DeferOperation(ctx context.Context, typ string, state interface{}, op PendingOperation) error {
+	r, ok := asyncRunFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.op != nil {
+		return errOperationDeferred
+	}
+	r.started++
+	if r.started <= r.waited || r.replayed < r.recorded {
+		// The operation has finished.
+		return nil
+	}
+	b, err := json.Marshal(state)
+	if err != nil {
+		// An operation which cannot be resumed from a token is waited on synchronously.
+		return nil
+	}
+	r.typ, r.state, r.op = typ, b, op
+	return errOperationDeferred
+}
+
+// AsyncHandle tracks a call started by an asynchronous Apply or Delete.
+type AsyncHandle struct {
+	resource ServiceTypeVersion
+	config   *Config
+	// run is the function the call was started with, or nil if the handle was resumed
+	// from a token.
+	run func(ctx context.Context) error
+
+	// pollMu serializes Poll, opMu serializes calls to op, and mu guards the fields below.
+	pollMu sync.Mutex
+	opMu   sync.Mutex
+	mu     sync.Mutex
+	// typ, state and op are the operation the call is waiting for, if any.
+	typ   string
+	state json.RawMessage
+	op    PendingOperation
+	// exchanges and waited are what the next run of the call starts from.
+	exchanges []*asyncExchange
+	waited    int
+	done      bool
+	err       error
+}
+
+// StartAsync calls run with a context in which the first long-running operation run
+// starts is deferred rather than waited on, which ends run, and returns a handle for that
+// operation. If run starts no such operation, it has finished by the time StartAsync
+// returns and so has the handle. Otherwise the handle's Poll and Wait run it again once
+// the operation has finished, replaying the requests it made before the operation, so that
+// it carries on with the steps after it, and the result of run is that of Wait. Nothing is
+// left running and ctx is not used once StartAsync returns.
+//
+// Only operations which can be resumed from a token are deferred: those of other kinds,
+// such as Cloud SQL, Cloud DNS, Datastore and Knative operations, are waited on by run as
+// they would be in a synchronous call, by StartAsync or by the Poll and Wait of the handle.
+func StartAsync(ctx context.Context, c *Config, r Resource, run func(ctx context.Context) error) (*AsyncHandle, error) {
+	h := &AsyncHandle{resource: r.Describe(), config: c, run: run}
+	if _, err := h.runCall(ctx); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// runCall runs the handle's call from where it got to, and returns true if it finished.
+// A call cut short by the end of ctx is not finished, and can be run again.
+func (h *AsyncHandle) runCall(ctx context.Context) (bool, error) {
+	h.mu.Lock()
+	r := &asyncRun{exchanges: h.exchanges, recorded: len(h.exchanges), waited: h.waited}
+	h.mu.Unlock()
+	err := h.run(context.WithValue(ctx, asyncCallKey, r))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.exchanges = r.exchanges
+	if r.op != nil {
+		h.config.Logger.InfoWithContextf(ctx, "Deferred %s operation for %s", r.typ, h.resource.Type)
+		h.typ, h.state, h.op, h.waited = r.typ, r.state, r.op, r.started
+		return false, nil
+	}
+	if err != nil && ctx.Err() != nil {
+		return false, err
+	}
+	h.done, h.err = true, err
+	return true, err
+}
+
+// Resource returns the type of the resource the handle's call was made for.
+func (h *AsyncHandle) Resource() ServiceTypeVersion {
+	return h.resource
+}
+
+// Done returns true if the handle's call has finished, successfully or not.
+func (h *AsyncHandle) Done() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.done
+}
+
+// Poll fetches the handle's operation once and returns true if the call has finished. If
+// the operation has finished, Poll runs the steps of the call after it, up to the next
+// operation the call defers.
+func (h *AsyncHandle) Poll(ctx context.Context) (bool, error) {
+	h.pollMu.Lock()
+	defer h.pollMu.Unlock()
+	h.mu.Lock()
+	op, done, err := h.op, h.done, h.err
+	h.mu.Unlock()
+	if done {
+		return true, err
+	}
+
+	ctx = ContextWithServiceTypeVersion(ctx, h.resource)
+	if op != nil {
+		h.opMu.Lock()
+		opDone, err := op.Poll(ctx)
+		h.opMu.Unlock()
+		if !opDone {
+			return false, nil
+		}
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		h.mu.Lock()
+		h.op = nil
+		if h.run == nil {
+			// The steps of a call resumed from a token after its operation are lost.
+			if err == nil {
+				err = ErrStepsRemaining
+			}
+			h.done, h.err = true, err
+		}
+		h.mu.Unlock()
+		if h.run == nil {
+			return true, err
+		}
+	}
+	// The call waits on the finished operation again, and fails itself if it failed.
+	return h.runCall(ctx)
+}
+
+// Wait polls the handle until its call has finished, and returns the result of the call.
+// If ctx ends first, Wait returns its error and the handle may be waited on again.
+func (h *AsyncHandle) Wait(ctx context.Context) error {
+	if h.Done() {
+		return h.result()
+	}
+	ctx = ContextWithServiceTypeVersion(ctx, h.resource)
+	return Do(ctx, func(ctx context.Context) (*RetryDetails, error) {
+		done, err := h.Poll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !done {
+			return nil, OperationNotDone{}
+		}
+		return nil, nil
+	}, h.config.RetryProviderFor(ctx))
+}
+
+func (h *AsyncHandle) result() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.err
+}
+
+// Cancel asks the API to cancel the operation the handle is waiting for. It does nothing
+// if there is none.
+func (h *AsyncHandle) Cancel(ctx context.Context) error {
+	h.mu.Lock()
+	op := h.op
+	h.mu.Unlock()
+	if op == nil {
+		return nil
+	}
+	h.opMu.Lock()
+	defer h.opMu.Unlock()
+	return op.Cancel(ctx)
+}
+
+// asyncToken is the serialized form of an AsyncHandle.
+type asyncToken struct {
+	Resource ServiceTypeVersion `json:"resource"`
+	// Done is true if the call has finished, and Error is its error if it failed.
+	Done  bool        `json:"done,omitempty"`
+	Error *asyncError `json:"error,omitempty"`
+	// OperationDone is true if the operation has finished but the steps of the call after
+	// it have not.
+	OperationDone bool            `json:"operationDone,omitempty"`
+	OperationType string          `json:"operationType,omitempty"`
+	Operation     json.RawMessage `json:"operation,omitempty"`
+}
+
+// asyncError is the error of a call recreated from the token of its handle.
+type asyncError struct {
+	Message string `json:"message"`
+	// Code is the HTTP status code of the error, as returned by ErrorCode, or 0. HTTP is
+	// true if the error was an HTTP response rather than a failed operation.
+	Code int  `json:"code,omitempty"`
+	HTTP bool `json:"http,omitempty"`
+}
+
+func (e *asyncError) Error() string {
+	return e.Message
+}
+
+// Token returns a serialized form of the handle, from which ResumeAsync recreates it.
+func (h *AsyncHandle) Token() ([]byte, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	t := asyncToken{Resource: h.resource, Done: h.done, OperationDone: !h.done && h.op == nil}
+	if h.done && h.err != nil {
+		_, isHTTP := httpErrorCode(h.err)
+		t.Error = &asyncError{Message: h.err.Error(), Code: ErrorCode(h.err), HTTP: isHTTP}
+	}
+	if h.op != nil {
+		t.OperationType, t.Operation = h.typ, h.state
+	}
+	return json.Marshal(t)
+}
+
+// ResumeAsync recreates a handle from its token. The resumed handle waits for its
+// operation only: if the call it was created for had steps left after the operation,
+// its Wait returns ErrStepsRemaining once the operation has finished.
+func ResumeAsync(c *Config, token []byte) (*AsyncHandle, error) {
+	var t asyncToken
+	if err := json.Unmarshal(token, &t); err != nil {
+		return nil, fmt.Errorf("invalid async token: %w", err)
+	}
+	h := &AsyncHandle{resource: t.Resource, config: c, typ: t.OperationType, state: t.Operation}
+	switch {
+	case t.Done:
+		h.done = true
+		if t.Error != nil {
+			h.err = t.Error
+		}
+		return h, nil
+	case t.OperationDone:
+		h.done, h.err = true, ErrStepsRemaining
+		return h, nil
+	}
+	pendingOperationTypesMu.RLock()
+	f, ok := pendingOperationTypes[t.OperationType]
+	pendingOperationTypesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown operation type %q in async token", t.OperationType)
+	}
+	op, err := f(c, t.Operation)
+	if err != nil {
+		return nil, err
+	}
+	h.op = op
+	return h, nil
+}
@@ -0,0 +1,371 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+const fakeOperationType = "FakeOperation"
+
+// fakeOperation is a PendingOperation which finishes after a number of polls.
+type fakeOperation struct {
+	mu    sync.Mutex
+	polls int
+	err   error
+}
+
+func (op *fakeOperation) Poll(_ context.Context) (bool, error) {
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	op.polls--
+	if op.polls > 0 {
+		return false, nil
+	}
+	return true, op.err
+}
+
+func (op *fakeOperation) Cancel(_ context.Context) error {
+	return ErrCancelUnsupported
+}
+
+type fakeOperationState struct {
+	Polls int `json:"polls"`
+}
+
+func init() {
+	RegisterPendingOperation(fakeOperationType, func(_ *Config, state json.RawMessage) (PendingOperation, error) {
+		var s fakeOperationState
+		if err := json.Unmarshal(state, &s); err != nil {
+			return nil, err
+		}
+		return &fakeOperation{polls: s.Polls}, nil
+	})
+}
+
+// immediateRetry retries without waiting, so that handles poll their operations quickly.
+type immediateRetry struct{}
+
+func (immediateRetry) New() Retry {
+	return immediateRetry{}
+}
+
+func (immediateRetry) RetryAfter(_ *RetryDetails) time.Duration {
+	return 0
+}
+
+type asyncTestResource struct{}
+
+func (r *asyncTestResource) Describe() ServiceTypeVersion {
+	return ServiceTypeVersion{Service: "test", Type: "Resource", Version: "ga"}
+}
+
+// asyncTestServer is an API in which POST creates a resource with a generated name.
+type asyncTestServer struct {
+	mu      sync.Mutex
+	created map[string]bool
+	posts   int
+}
+
+func (s *asyncTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.Method {
+	case "POST":
+		s.posts++
+		name := fmt.Sprintf("things/%d", s.posts)
+		s.created[name] = true
+		fmt.Fprintf(w, `{"name": %q}`, name)
+	case "GET":
+		if !s.created[strings.TrimPrefix(r.URL.Path, "/")] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}
+}
+
+func newAsyncTestServer(t *testing.T) (*asyncTestServer, *Config) {
+	s := &asyncTestServer{created: map[string]bool{}}
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	return s, NewConfig(WithRetryProvider(immediateRetry{}), WithBasePath(srv.URL+"/"), WithHTTPClient(srv.Client()))
+}
+
+// asyncTestCall is a call which creates a resource with a generated name once for each of
+// its operations, waits for the operation, and then gets the last resource it created.
+type asyncTestCall struct {
+	c   *Config
+	ops []*fakeOperation
+	// afterOp, if set, is called once the call has waited for an operation.
+	afterOp    func()
+	runs       int
+	stepsAfter int
+	err        error
+}
+
+func (c *asyncTestCall) run(ctx context.Context) error {
+	c.runs++
+	var name string
+	for _, op := range c.ops {
+		resp, err := SendRequest(ctx, c.c, "POST", c.c.BasePath+"things", nil, nil)
+		if err != nil {
+			return err
+		}
+		var created struct {
+			Name string `json:"name"`
+		}
+		if err := ParseResponse(resp.Response, &created); err != nil {
+			return err
+		}
+		name = created.Name
+		if err := DeferOperation(ctx, fakeOperationType, fakeOperationState{Polls: op.polls}, op); err != nil {
+			return err
+		}
+		if err := Do(ctx, func(ctx context.Context) (*RetryDetails, error) {
+			done, err := op.Poll(ctx)
+			if !done {
+				return nil, OperationNotDone{}
+			}
+			return nil, err
+		}, immediateRetry{}); err != nil {
+			return err
+		}
+		if c.afterOp != nil {
+			c.afterOp()
+		}
+	}
+	if name != "" {
+		if _, err := SendRequest(ctx, c.c, "GET", c.c.BasePath+name, nil, nil); err != nil {
+			return err
+		}
+	}
+	c.stepsAfter++
+	return c.err
+}
+
+func TestAsyncHandle(t *testing.T) {
+	tests := []struct {
+		name     string
+		ops      []*fakeOperation
+		err      error
+		wantErr  error
+		wantRuns int
+	}{
+		{
+			name:     "no operation",
+			wantRuns: 1,
+		},
+		{
+			name:     "operation",
+			ops:      []*fakeOperation{{polls: 3}},
+			wantRuns: 2,
+		},
+		{
+			name:     "operations",
+			ops:      []*fakeOperation{{polls: 3}, {polls: 2}},
+			wantRuns: 3,
+		},
+		{
+			name:     "failed operation",
+			ops:      []*fakeOperation{{polls: 2, err: &googleapi.Error{Code: 409}}},
+			wantErr:  &googleapi.Error{Code: 409},
+			wantRuns: 2,
+		},
+		{
+			name:     "failed step after operation",
+			ops:      []*fakeOperation{{polls: 2}},
+			err:      errors.New("get failed"),
+			wantErr:  errors.New("get failed"),
+			wantRuns: 2,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, c := newAsyncTestServer(t)
+			call := &asyncTestCall{c: c, ops: tc.ops, err: tc.err}
+			// The handle must not depend on the context it was started with.
+			ctx, cancel := context.WithCancel(context.Background())
+			h, err := StartAsync(ctx, c, &asyncTestResource{}, call.run)
+			cancel()
+			if err != nil && len(tc.ops) > 0 {
+				t.Fatalf("StartAsync() returned error: %v", err)
+			}
+			if err == nil {
+				err = h.Wait(context.Background())
+			}
+			if (err != nil) != (tc.wantErr != nil) || (err != nil && err.Error() != tc.wantErr.Error()) {
+				t.Errorf("Wait() error = %v, want %v", err, tc.wantErr)
+			}
+			if h != nil && !h.Done() {
+				t.Errorf("Done() = false after Wait()")
+			}
+			if call.runs != tc.wantRuns {
+				t.Errorf("call ran %d times, want %d", call.runs, tc.wantRuns)
+			}
+			if s.posts != len(tc.ops) {
+				t.Errorf("call created %d resources, want %d", s.posts, len(tc.ops))
+			}
+			if tc.wantErr == nil && call.stepsAfter != 1 {
+				t.Errorf("steps after the operations ran %d times, want 1", call.stepsAfter)
+			}
+		})
+	}
+}
+
+func TestAsyncHandleWaitContextEnds(t *testing.T) {
+	s, c := newAsyncTestServer(t)
+	call := &asyncTestCall{c: c, ops: []*fakeOperation{{polls: 2}}}
+	h, err := StartAsync(context.Background(), c, &asyncTestResource{}, call.run)
+	if err != nil {
+		t.Fatalf("StartAsync() returned error: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	call.afterOp = cancel
+	if err := h.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Wait() error = %v, want %v", err, context.Canceled)
+	}
+	if h.Done() {
+		t.Errorf("Done() = true after Wait() was cancelled")
+	}
+	call.afterOp = nil
+	if err := h.Wait(context.Background()); err != nil {
+		t.Errorf("second Wait() returned error: %v", err)
+	}
+	if s.posts != 1 {
+		t.Errorf("call created %d resources, want 1", s.posts)
+	}
+}
+
+func TestAsyncHandleDiverged(t *testing.T) {
+	_, c := newAsyncTestServer(t)
+	runs := 0
+	op := &fakeOperation{polls: 2}
+	run := func(ctx context.Context) error {
+		runs++
+		if _, err := SendRequest(ctx, c, "POST", fmt.Sprintf("%sthings/run%d", c.BasePath, runs), nil, nil); err != nil {
+			return err
+		}
+		return DeferOperation(ctx, fakeOperationType, fakeOperationState{Polls: op.polls}, op)
+	}
+	h, err := StartAsync(context.Background(), c, &asyncTestResource{}, run)
+	if err != nil {
+		t.Fatalf("StartAsync() returned error: %v", err)
+	}
+	if err := h.Wait(context.Background()); err == nil || !strings.Contains(err.Error(), "cannot resume async call") {
+		t.Errorf("Wait() error = %v, want an error for the diverged call", err)
+	}
+}
+
+func TestAsyncHandleStartError(t *testing.T) {
+	_, c := newAsyncTestServer(t)
+	call := &asyncTestCall{c: c, err: errors.New("create failed")}
+	if _, err := StartAsync(context.Background(), c, &asyncTestResource{}, call.run); err == nil {
+		t.Errorf("StartAsync() returned no error, want %v", call.err)
+	}
+}
+
+func TestAsyncHandleToken(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  []*fakeOperation
+		// cancel, if set, ends the context of the call once it has waited for its
+		// operation, which leaves the steps after it to do.
+		cancel   bool
+		wait     bool
+		wantDone bool
+		wantErr  error
+		wantCode int
+	}{
+		{
+			name: "pending operation",
+			ops:  []*fakeOperation{{polls: 3}},
+			// The resumed handle cannot run the steps after the operation.
+			wantErr: ErrStepsRemaining,
+		},
+		{
+			name:     "finished operation with steps left",
+			ops:      []*fakeOperation{{polls: 1}},
+			cancel:   true,
+			wantDone: true,
+			wantErr:  ErrStepsRemaining,
+		},
+		{
+			name:     "finished call",
+			ops:      []*fakeOperation{{polls: 2}},
+			wait:     true,
+			wantDone: true,
+		},
+		{
+			name:     "failed call",
+			ops:      []*fakeOperation{{polls: 2, err: &googleapi.Error{Code: 409, Message: "conflict"}}},
+			wait:     true,
+			wantDone: true,
+			wantErr:  errors.New("googleapi: Error 409: conflict"),
+			wantCode: 409,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			_, c := newAsyncTestServer(t)
+			call := &asyncTestCall{c: c, ops: tc.ops}
+			h, err := StartAsync(ctx, c, &asyncTestResource{}, call.run)
+			if err != nil {
+				t.Fatalf("StartAsync() returned error: %v", err)
+			}
+			if tc.cancel {
+				pollCtx, cancel := context.WithCancel(ctx)
+				call.afterOp = cancel
+				if _, err := h.Poll(pollCtx); !errors.Is(err, context.Canceled) {
+					t.Fatalf("Poll() error = %v, want %v", err, context.Canceled)
+				}
+				call.afterOp = nil
+			}
+			if tc.wait {
+				h.Wait(ctx)
+			}
+			token, err := h.Token()
+			if err != nil {
+				t.Fatalf("Token() returned error: %v", err)
+			}
+
+			resumed, err := ResumeAsync(c, token)
+			if err != nil {
+				t.Fatalf("ResumeAsync() returned error: %v", err)
+			}
+			if got := resumed.Done(); got != tc.wantDone {
+				t.Errorf("resumed Done() = %v, want %v", got, tc.wantDone)
+			}
+			err = resumed.Wait(ctx)
+			if (err != nil) != (tc.wantErr != nil) || (err != nil && err.Error() != tc.wantErr.Error()) {
+				t.Errorf("resumed Wait() error = %v, want %v", err, tc.wantErr)
+			}
+			if got := ErrorCode(err); got != tc.wantCode {
+				t.Errorf("ErrorCode() of resumed Wait() error = %d, want %d", got, tc.wantCode)
+			}
+		})
+	}
+}
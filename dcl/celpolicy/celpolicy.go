@@ -0,0 +1,142 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package celpolicy implements dcl.PolicyValidator with rules written in the Common
+// Expression Language (https://github.com/google/cel-spec).
+package celpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+// Rule is a CEL expression which every resource it applies to must satisfy.
+//
+// The expression has access to these variables:
+//
+//	resource      map(string, dyn)  the unstructured form of the desired resource
+//	service       string            the service of the resource, e.g. "storage"
+//	resourceType  string            the type of the resource, e.g. "Bucket"
+//	version       string            the DCL version of the resource, e.g. "beta"
+//
+// Unset fields are absent from resource, so expressions should test them with has(),
+// e.g. `has(resource.labels) && "cost-center" in resource.labels`.
+type Rule struct {
+	// Name identifies the rule in violations.
+	Name string `json:"name" yaml:"name"`
+	// Expression must evaluate to true for resources which satisfy the rule.
+	Expression string `json:"expression" yaml:"expression"`
+	// Message describes a violation of the rule.
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+	// FieldPath is the field reported in violations of the rule, if any.
+	FieldPath string `json:"fieldPath,omitempty" yaml:"fieldPath,omitempty"`
+	// Resources restricts the rule to resources of the given types, each written as
+	// "service.Type", e.g. "storage.Bucket". A rule without resources applies to all.
+	Resources []string `json:"resources,omitempty" yaml:"resources,omitempty"`
+}
+
+// Validator evaluates a set of compiled rules.
+type Validator struct {
+	rules []compiledRule
+}
+
+type compiledRule struct {
+	Rule
+	program cel.Program
+}
+
+// NewValidator compiles the given rules. It returns an error if a rule has no name or
+// its expression does not compile to a bool.
+func NewValidator(rules ...Rule) (*Validator, error) {
+	env, err := cel.NewEnv(cel.Declarations(
+		decls.NewVar("resource", decls.NewMapType(decls.String, decls.Dyn)),
+		decls.NewVar("service", decls.String),
+		decls.NewVar("resourceType", decls.String),
+		decls.NewVar("version", decls.String),
+	))
+	if err != nil {
+		return nil, err
+	}
+	v := &Validator{}
+	for _, r := range rules {
+		if r.Name == "" {
+			return nil, fmt.Errorf("rule %q has no name", r.Expression)
+		}
+		ast, iss := env.Compile(r.Expression)
+		if iss.Err() != nil {
+			return nil, fmt.Errorf("rule %q: %w", r.Name, iss.Err())
+		}
+		if !proto.Equal(ast.ResultType(), decls.Bool) && !proto.Equal(ast.ResultType(), decls.Dyn) {
+			return nil, fmt.Errorf("rule %q: expression must evaluate to a bool", r.Name)
+		}
+		prg, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", r.Name, err)
+		}
+		v.rules = append(v.rules, compiledRule{Rule: r, program: prg})
+	}
+	return v, nil
+}
+
+// Validate evaluates every rule which applies to r and returns a violation for each one
+// which does not evaluate to true.
+func (v *Validator) Validate(ctx context.Context, r dcl.Resource, u map[string]interface{}) ([]dcl.PolicyViolation, error) {
+	stv := r.Describe()
+	vars := map[string]interface{}{
+		"resource":     u,
+		"service":      stv.Service,
+		"resourceType": stv.Type,
+		"version":      stv.Version,
+	}
+	var violations []dcl.PolicyViolation
+	for _, rule := range v.rules {
+		if !rule.appliesTo(stv) {
+			continue
+		}
+		out, _, err := rule.program.Eval(vars)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", rule.Name, err)
+		}
+		if out != types.True {
+			if _, ok := out.(types.Bool); !ok {
+				return nil, fmt.Errorf("rule %q: expression returned %v, not a bool", rule.Name, out)
+			}
+			violations = append(violations, dcl.PolicyViolation{
+				Rule:      rule.Name,
+				FieldPath: rule.FieldPath,
+				Message:   rule.Message,
+			})
+		}
+	}
+	return violations, nil
+}
+
+func (r *compiledRule) appliesTo(stv dcl.ServiceTypeVersion) bool {
+	if len(r.Resources) == 0 {
+		return true
+	}
+	for _, res := range r.Resources {
+		if res == stv.Service+"."+stv.Type {
+			return true
+		}
+	}
+	return false
+}
@@ -52,6 +52,16 @@ type Config struct {
 	BasePath            string
 	billingProject      string
 	userOverrideProject bool
+	endpoints           map[string]string
+	universeDomain      string
+	interceptors        []Interceptor
+	policyValidators    []PolicyValidator
+	defaultLabels       map[string]string
+	progressFuncs       []OperationProgressFunc
+	// resourceTimeouts and resourceRetryProviders are keyed by "service.Type".
+	resourceTimeouts       map[string]time.Duration
+	resourceRetryProviders map[string]RetryProvider
+	clients                *httpClientCache
 }
 
 // Retryability holds the details for one error code to determine if it is retyable.
@@ -106,6 +116,7 @@ func NewConfig(o ...ConfigOption) *Config {
 			logger: DefaultLogger(LoggerInfo),
 		},
 		RetryProvider: &BackoffRetryProvider{},
+		clients:       newHTTPClientCache(),
 	}
 
 	for _, opt := range o {
@@ -115,20 +126,46 @@ func NewConfig(o ...ConfigOption) *Config {
 	return c
 }
 
-// Clone returns a copy of an existing Config with optional new values.
+// Clone returns a copy of an existing Config with optional new values. The maps, lists,
+// and retry providers of the copy are its own, so that changing them in either Config
+// leaves the other unchanged.
 func (c *Config) Clone(o ...ConfigOption) *Config {
 	result := &Config{
-		RetryProvider:       c.RetryProvider,
-		codeRetryability:    c.codeRetryability,
+		RetryProvider:       cloneRetryProvider(c.RetryProvider),
+		codeRetryability:    make(map[int]Retryability, len(c.codeRetryability)),
 		timeout:             c.timeout,
-		clientOptions:       c.clientOptions,
+		clientOptions:       append([]option.ClientOption(nil), c.clientOptions...),
 		userAgent:           c.userAgent,
 		contentType:         c.contentType,
-		queryParams:         c.queryParams,
+		queryParams:         cloneStringMap(c.queryParams),
 		Logger:              c.Logger,
 		BasePath:            c.BasePath,
 		billingProject:      c.billingProject,
 		userOverrideProject: c.userOverrideProject,
+		endpoints:           cloneStringMap(c.endpoints),
+		universeDomain:      c.universeDomain,
+		interceptors:        append([]Interceptor(nil), c.interceptors...),
+		policyValidators:    append([]PolicyValidator(nil), c.policyValidators...),
+		defaultLabels:       cloneStringMap(c.defaultLabels),
+		progressFuncs:       append([]OperationProgressFunc(nil), c.progressFuncs...),
+		// The cached HTTP clients are shared until an option changes the credentials or logger.
+		clients: c.clients,
+	}
+
+	for code, r := range c.codeRetryability {
+		result.codeRetryability[code] = r
+	}
+	if c.resourceTimeouts != nil {
+		result.resourceTimeouts = make(map[string]time.Duration, len(c.resourceTimeouts))
+		for k, v := range c.resourceTimeouts {
+			result.resourceTimeouts[k] = v
+		}
+	}
+	if c.resourceRetryProviders != nil {
+		result.resourceRetryProviders = make(map[string]RetryProvider, len(c.resourceRetryProviders))
+		for k, v := range c.resourceRetryProviders {
+			result.resourceRetryProviders[k] = cloneRetryProvider(v)
+		}
 	}
 
 	if c.header != nil {
@@ -142,6 +179,29 @@ func (c *Config) Clone(o ...ConfigOption) *Config {
 	return result
 }
 
+// cloneStringMap returns a copy of m, or nil if m is nil.
+func cloneStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	c := make(map[string]string, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// cloneRetryProvider returns a copy of r if it is a BackoffRetryProvider, whose intervals
+// may be changed in place. Other providers are returned as they are, since their state
+// is not known.
+func cloneRetryProvider(r RetryProvider) RetryProvider {
+	if b, ok := r.(*BackoffRetryProvider); ok && b != nil {
+		c := *b
+		return &c
+	}
+	return r
+}
+
 // TimeoutOr returns a timeout for this config. If WithTimeout() was called, that timeout
 // is used; if WithTimeout() was not called and a value is provided with `t`, that is used.
 // Otherwise the default timeout is returned;
@@ -154,6 +214,66 @@ func (c *Config) TimeoutOr(t time.Duration) time.Duration {
 	return defaultTimeout
 }
 
+// TimeoutFor returns the timeout for calls made for resources of the given type. If a
+// timeout was set for the type with WithResourceTimeout, it is used; otherwise the timeout
+// is TimeoutOr(t).
+func (c *Config) TimeoutFor(stv ServiceTypeVersion, t time.Duration) time.Duration {
+	if to, ok := c.resourceTimeouts[resourceKey(stv)]; ok {
+		return to
+	}
+	return c.TimeoutOr(t)
+}
+
+// RetryProviderFor returns the RetryProvider for requests made with ctx. If one was set
+// with WithResourceRetryProvider for the resource ctx is for, it is used; otherwise the
+// RetryProvider of the Config is.
+func (c *Config) RetryProviderFor(ctx context.Context) RetryProvider {
+	if rp, ok := c.resourceRetryProvider(ctx); ok {
+		return rp
+	}
+	return c.RetryProvider
+}
+
+func (c *Config) resourceRetryProvider(ctx context.Context) (RetryProvider, bool) {
+	stv, ok := ServiceTypeVersionFromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	rp, ok := c.resourceRetryProviders[resourceKey(stv)]
+	return rp, ok
+}
+
+// resourceKey returns the key of a resource type in the resource overrides of a Config.
+func resourceKey(stv ServiceTypeVersion) string {
+	return stv.Service + "." + stv.Type
+}
+
+// WithResourceTimeout overrides the timeout of calls made for resources of one type,
+// written as "service.Type", e.g. "dataproc.Cluster". It takes precedence over WithTimeout.
+func WithResourceTimeout(resource string, to time.Duration) ConfigOption {
+	return func(c *Config) {
+		timeouts := make(map[string]time.Duration, len(c.resourceTimeouts)+1)
+		for k, v := range c.resourceTimeouts {
+			timeouts[k] = v
+		}
+		timeouts[resource] = to
+		c.resourceTimeouts = timeouts
+	}
+}
+
+// WithResourceRetryProvider overrides the RetryProvider of requests made for resources of
+// one type, written as "service.Type", e.g. "dataproc.Cluster".
+func WithResourceRetryProvider(resource string, r RetryProvider) ConfigOption {
+	return func(c *Config) {
+		providers := make(map[string]RetryProvider, len(c.resourceRetryProviders)+1)
+		for k, v := range c.resourceRetryProviders {
+			providers[k] = v
+		}
+		providers[resource] = r
+		c.resourceRetryProviders = providers
+	}
+}
+
 type loggingTransport struct {
 	underlyingTransport http.RoundTripper
 	logger              ContextLogger
@@ -181,7 +301,9 @@ func (t loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 			if shouldLogRequest {
 				t.logger.InfoWithContextf(req.Context(), "Google API Response: (id %s) \n-----------[RESPONSE]----------\n%s\n-------[END RESPONSE]--------", randString, strings.ReplaceAll(respDumpStr, "\r\n", "\n"))
 			} else if resp.StatusCode >= 400 || strings.Contains(respDumpStr, "error") {
-				t.logger.InfoWithContextf(req.Context(), "Google API Request: (id %s)\n-----------[REQUEST]----------\n%s\n-------[END REQUEST]--------", randString, strings.ReplaceAll(string(reqDump), "\r\n", "\n"))
+				// Requests that are not logged may hold secrets in their headers
+				// or body, so only their method and URL are logged.
+				t.logger.InfoWithContextf(req.Context(), "Google API Request: (id %s)\n-----------[REQUEST]----------\n%s %s\n-------[END REQUEST]--------", randString, req.Method, req.URL)
 				t.logger.InfoWithContextf(req.Context(), "Google API Response: (id %s) \n-----------[RESPONSE]----------\n%s\n-------[END RESPONSE]--------", randString, strings.ReplaceAll(respDumpStr, "\r\n", "\n"))
 			}
 		} else {
@@ -200,8 +322,10 @@ type ApplyOption interface {
 
 // ApplyOpts refers to options that are taken in the apply function.
 type ApplyOpts struct {
-	params    []LifecycleParam
-	stateHint Resource
+	params            []LifecycleParam
+	stateHint         Resource
+	policyValidators  []PolicyValidator
+	ignoredFieldPaths []string
 }
 
 type lifecycleParamOption struct {
@@ -293,6 +417,7 @@ func WithTimeout(to time.Duration) ConfigOption {
 func WithLogger(l Logger) ConfigOption {
 	return func(c *Config) {
 		c.Logger.logger = l
+		c.clients = newHTTPClientCache()
 	}
 }
 
@@ -300,6 +425,7 @@ func WithLogger(l Logger) ConfigOption {
 func WithContextLogger(l ContextLogger) ConfigOption {
 	return func(c *Config) {
 		c.Logger = l
+		c.clients = newHTTPClientCache()
 	}
 }
 
@@ -346,6 +472,7 @@ func WithQueryParams(ps map[string]string) ConfigOption {
 func WithAPIKey(apiKey string) ConfigOption {
 	return func(c *Config) {
 		c.clientOptions = append(c.clientOptions, option.WithAPIKey(apiKey))
+		c.clients = newHTTPClientCache()
 	}
 }
 
@@ -353,6 +480,7 @@ func WithAPIKey(apiKey string) ConfigOption {
 func WithClientCertSource(s option.ClientCertSource) ConfigOption {
 	return func(c *Config) {
 		c.clientOptions = append(c.clientOptions, option.WithClientCertSource(s))
+		c.clients = newHTTPClientCache()
 	}
 }
 
@@ -360,6 +488,7 @@ func WithClientCertSource(s option.ClientCertSource) ConfigOption {
 func WithCredentials(creds *google.Credentials) ConfigOption {
 	return func(c *Config) {
 		c.clientOptions = append(c.clientOptions, option.WithCredentials(creds))
+		c.clients = newHTTPClientCache()
 	}
 }
 
@@ -367,6 +496,7 @@ func WithCredentials(creds *google.Credentials) ConfigOption {
 func WithCredentialsFile(filename string) ConfigOption {
 	return func(c *Config) {
 		c.clientOptions = append(c.clientOptions, option.WithCredentialsFile(filename))
+		c.clients = newHTTPClientCache()
 	}
 }
 
@@ -374,6 +504,7 @@ func WithCredentialsFile(filename string) ConfigOption {
 func WithCredentialsJSON(p []byte) ConfigOption {
 	return func(c *Config) {
 		c.clientOptions = append(c.clientOptions, option.WithCredentialsJSON(p))
+		c.clients = newHTTPClientCache()
 	}
 }
 
@@ -382,6 +513,7 @@ func WithCredentialsJSON(p []byte) ConfigOption {
 func WithHTTPClient(client *http.Client) ConfigOption {
 	return func(c *Config) {
 		c.clientOptions = append(c.clientOptions, option.WithHTTPClient(client))
+		c.clients = newHTTPClientCache()
 	}
 }
 
@@ -0,0 +1,355 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Environment variables read by ConfigFile.ApplyEnv. Each overrides the field of the
+// ConfigFile with the same name.
+const (
+	EnvCredentialsFile     = "DCL_CREDENTIALS_FILE"
+	EnvUserAgent           = "DCL_USER_AGENT"
+	EnvBillingProject      = "DCL_BILLING_PROJECT"
+	EnvUserProjectOverride = "DCL_USER_PROJECT_OVERRIDE"
+	EnvBasePath            = "DCL_BASE_PATH"
+	EnvUniverseDomain      = "DCL_UNIVERSE_DOMAIN"
+	// EnvEndpoints is a comma-separated list of SERVICE=URL pairs.
+	EnvEndpoints = "DCL_ENDPOINTS"
+	EnvTimeout   = "DCL_TIMEOUT"
+	EnvLogLevel  = "DCL_LOG_LEVEL"
+)
+
+// ConfigFile is the declarative form of a Config, as read from YAML or JSON.
+type ConfigFile struct {
+	// Credentials are used to authenticate requests. Application Default Credentials are
+	// used if they are not set.
+	Credentials *CredentialsConfig `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+	UserAgent   string             `json:"userAgent,omitempty" yaml:"userAgent,omitempty"`
+	// BillingProject is sent as X-Goog-User-Project if UserProjectOverride is true.
+	BillingProject      string            `json:"billingProject,omitempty" yaml:"billingProject,omitempty"`
+	UserProjectOverride bool              `json:"userProjectOverride,omitempty" yaml:"userProjectOverride,omitempty"`
+	BasePath            string            `json:"basePath,omitempty" yaml:"basePath,omitempty"`
+	UniverseDomain      string            `json:"universeDomain,omitempty" yaml:"universeDomain,omitempty"`
+	Endpoints           map[string]string `json:"endpoints,omitempty" yaml:"endpoints,omitempty"`
+	Headers             map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// Timeout is a duration such as "20m" which overrides the timeout of every call.
+	Timeout string       `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	Retry   *RetryConfig `json:"retry,omitempty" yaml:"retry,omitempty"`
+	// LogLevel is one of "fatal", "error", "warning", or "info".
+	LogLevel string `json:"logLevel,omitempty" yaml:"logLevel,omitempty"`
+	// Resources overrides the timeout and retry policy for resource types, each written
+	// as "service.Type", e.g. "dataproc.Cluster".
+	Resources map[string]ResourceConfig `json:"resources,omitempty" yaml:"resources,omitempty"`
+}
+
+// CredentialsConfig describes the source of credentials. At most one field may be set.
+type CredentialsConfig struct {
+	// File is the path of a service account or refresh token JSON credentials file.
+	File string `json:"file,omitempty" yaml:"file,omitempty"`
+	// JSON is the content of a service account or refresh token JSON credentials file.
+	JSON   string `json:"json,omitempty" yaml:"json,omitempty"`
+	APIKey string `json:"apiKey,omitempty" yaml:"apiKey,omitempty"`
+}
+
+// RetryConfig describes the retry policy of requests and operation polls.
+type RetryConfig struct {
+	// InitialInterval and MaxInterval are durations such as "1s" which bound the
+	// exponential backoff between retries.
+	InitialInterval string `json:"initialInterval,omitempty" yaml:"initialInterval,omitempty"`
+	MaxInterval     string `json:"maxInterval,omitempty" yaml:"maxInterval,omitempty"`
+	// Codes adds or overrides the retryability of HTTP error codes.
+	Codes map[string]CodeRetryConfig `json:"codes,omitempty" yaml:"codes,omitempty"`
+}
+
+// CodeRetryConfig is the declarative form of a Retryability.
+type CodeRetryConfig struct {
+	Retryable bool   `json:"retryable" yaml:"retryable"`
+	Pattern   string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	Timeout   string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+// ResourceConfig overrides the timeout and retry policy of calls for one resource type.
+type ResourceConfig struct {
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// Retry overrides the backoff intervals of the resource. Retryable codes can only be
+	// set for a whole Config.
+	Retry *RetryConfig `json:"retry,omitempty" yaml:"retry,omitempty"`
+}
+
+// ParseConfigFile parses a ConfigFile from YAML or JSON.
+func ParseConfigFile(b []byte) (*ConfigFile, error) {
+	var f ConfigFile
+	// JSON is a subset of YAML, so both are read by the YAML decoder.
+	if err := yaml.UnmarshalStrict(b, &f); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return &f, nil
+}
+
+// ApplyEnv overrides the fields of f with the DCL_ environment variables returned by
+// lookup, e.g. os.LookupEnv.
+func (f *ConfigFile) ApplyEnv(lookup func(string) (string, bool)) error {
+	strs := map[string]*string{
+		EnvUserAgent:      &f.UserAgent,
+		EnvBillingProject: &f.BillingProject,
+		EnvBasePath:       &f.BasePath,
+		EnvUniverseDomain: &f.UniverseDomain,
+		EnvTimeout:        &f.Timeout,
+		EnvLogLevel:       &f.LogLevel,
+	}
+	for env, field := range strs {
+		if v, ok := lookup(env); ok {
+			*field = v
+		}
+	}
+	if v, ok := lookup(EnvCredentialsFile); ok {
+		f.Credentials = &CredentialsConfig{File: v}
+	}
+	if v, ok := lookup(EnvUserProjectOverride); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", EnvUserProjectOverride, err)
+		}
+		f.UserProjectOverride = b
+	}
+	if v, ok := lookup(EnvEndpoints); ok {
+		endpoints := make(map[string]string, len(f.Endpoints))
+		for k, e := range f.Endpoints {
+			endpoints[k] = e
+		}
+		for _, pair := range strings.Split(v, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			service, endpoint, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("invalid %s: %q is not SERVICE=URL", EnvEndpoints, pair)
+			}
+			endpoints[service] = endpoint
+		}
+		f.Endpoints = endpoints
+	}
+	return nil
+}
+
+// Options returns the ConfigOptions described by f.
+func (f *ConfigFile) Options() ([]ConfigOption, error) {
+	var opts []ConfigOption
+	if cr := f.Credentials; cr != nil {
+		switch {
+		case (cr.File != "") && (cr.JSON != "" || cr.APIKey != ""), cr.JSON != "" && cr.APIKey != "":
+			return nil, fmt.Errorf("invalid config: at most one source of credentials may be set")
+		case cr.File != "":
+			opts = append(opts, WithCredentialsFile(cr.File))
+		case cr.JSON != "":
+			opts = append(opts, WithCredentialsJSON([]byte(cr.JSON)))
+		case cr.APIKey != "":
+			opts = append(opts, WithAPIKey(cr.APIKey))
+		}
+	}
+	if f.UserAgent != "" {
+		opts = append(opts, WithUserAgent(f.UserAgent))
+	}
+	if f.BillingProject != "" {
+		opts = append(opts, WithBillingProject(f.BillingProject))
+	}
+	if f.UserProjectOverride {
+		opts = append(opts, WithUserProjectOverride())
+	}
+	if f.BasePath != "" {
+		opts = append(opts, WithBasePath(f.BasePath))
+	}
+	if f.UniverseDomain != "" {
+		opts = append(opts, WithUniverseDomain(f.UniverseDomain))
+	}
+	if len(f.Endpoints) > 0 {
+		opts = append(opts, WithEndpoints(f.Endpoints))
+	}
+	for h, v := range f.Headers {
+		opts = append(opts, WithHeader(h, v))
+	}
+	if f.Timeout != "" {
+		to, err := parseConfigDuration("timeout", f.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithTimeout(to))
+	}
+	if f.Retry != nil {
+		rp, err := f.Retry.retryProvider()
+		if err != nil {
+			return nil, err
+		}
+		if rp != nil {
+			opts = append(opts, WithRetryProvider(rp))
+		}
+		cr, err := f.Retry.codeRetryability()
+		if err != nil {
+			return nil, err
+		}
+		if len(cr) > 0 {
+			opts = append(opts, WithCodeRetryability(cr))
+		}
+	}
+	if f.LogLevel != "" {
+		level, err := parseLoggerLevel(f.LogLevel)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithLogger(DefaultLogger(level)))
+	}
+	for resource, rc := range f.Resources {
+		if !strings.Contains(resource, ".") {
+			return nil, fmt.Errorf("invalid config: resource %q is not of the form service.Type", resource)
+		}
+		if rc.Timeout != "" {
+			to, err := parseConfigDuration("timeout of "+resource, rc.Timeout)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, WithResourceTimeout(resource, to))
+		}
+		if rc.Retry != nil {
+			if len(rc.Retry.Codes) > 0 {
+				return nil, fmt.Errorf("invalid config: retry codes cannot be set for resource %q", resource)
+			}
+			rp, err := rc.Retry.retryProvider()
+			if err != nil {
+				return nil, err
+			}
+			if rp != nil {
+				opts = append(opts, WithResourceRetryProvider(resource, rp))
+			}
+		}
+	}
+	return opts, nil
+}
+
+// retryProvider returns the RetryProvider described by r, or nil if r does not set
+// backoff intervals.
+func (r *RetryConfig) retryProvider() (RetryProvider, error) {
+	if r.InitialInterval == "" && r.MaxInterval == "" {
+		return nil, nil
+	}
+	rp := &BackoffRetryProvider{}
+	var err error
+	if r.InitialInterval != "" {
+		if rp.InitialInterval, err = parseConfigDuration("retry.initialInterval", r.InitialInterval); err != nil {
+			return nil, err
+		}
+	}
+	if r.MaxInterval != "" {
+		if rp.MaxInterval, err = parseConfigDuration("retry.maxInterval", r.MaxInterval); err != nil {
+			return nil, err
+		}
+	}
+	return rp, nil
+}
+
+func (r *RetryConfig) codeRetryability() (map[int]Retryability, error) {
+	cr := make(map[int]Retryability, len(r.Codes))
+	for code, c := range r.Codes {
+		n, err := strconv.Atoi(code)
+		if err != nil {
+			return nil, fmt.Errorf("invalid config: retry code %q is not a number", code)
+		}
+		if c.Retryable {
+			// WithCodeRetryability panics on invalid patterns.
+			if _, err := regexp.Compile(c.Pattern); err != nil {
+				return nil, fmt.Errorf("invalid config: pattern of retry code %d: %w", n, err)
+			}
+		}
+		var to time.Duration
+		if c.Timeout != "" {
+			if to, err = parseConfigDuration(fmt.Sprintf("timeout of retry code %d", n), c.Timeout); err != nil {
+				return nil, err
+			}
+		}
+		cr[n] = Retryability{Retryable: c.Retryable, Pattern: c.Pattern, Timeout: to}
+	}
+	return cr, nil
+}
+
+func parseConfigDuration(field, s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid config: %s: %w", field, err)
+	}
+	return d, nil
+}
+
+func parseLoggerLevel(s string) (LoggerLevel, error) {
+	switch strings.ToLower(s) {
+	case "fatal":
+		return Fatal, nil
+	case "error":
+		return Error, nil
+	case "warning":
+		return Warning, nil
+	case "info":
+		return LoggerInfo, nil
+	}
+	return 0, fmt.Errorf("invalid config: unknown log level %q", s)
+}
+
+// LoadConfigOptions returns the ConfigOptions described by the ConfigFile at path, if
+// path is not empty, with the DCL_ environment variables applied on top of it.
+func LoadConfigOptions(path string) ([]ConfigOption, error) {
+	f := &ConfigFile{}
+	if path != "" {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if f, err = ParseConfigFile(b); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	if err := f.ApplyEnv(os.LookupEnv); err != nil {
+		return nil, err
+	}
+	return f.Options()
+}
+
+// LoadedConfig is a Config loaded by LoadConfig. It cannot be changed once loaded: Config
+// returns a new copy of it each time, which the caller may change without affecting the
+// LoadedConfig or the other copies.
+type LoadedConfig struct {
+	c *Config
+}
+
+// Config returns a copy of the loaded Config, with opts applied to the copy.
+func (l LoadedConfig) Config(opts ...ConfigOption) *Config {
+	return l.c.Clone(opts...)
+}
+
+// LoadConfig loads a Config with the options returned by LoadConfigOptions for path.
+// opts are applied last, so they take precedence over the file and the environment.
+func LoadConfig(path string, opts ...ConfigOption) (LoadedConfig, error) {
+	fileOpts, err := LoadConfigOptions(path)
+	if err != nil {
+		return LoadedConfig{}, err
+	}
+	return LoadedConfig{c: NewConfig(append(fileOpts, opts...)...)}, nil
+}
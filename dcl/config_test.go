@@ -0,0 +1,157 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func cloneTestConfig() *Config {
+	interceptor := func(ctx context.Context, info *RequestInfo, req *http.Request, next Invoker) (*http.Response, error) {
+		return next(ctx, req)
+	}
+	validator := PolicyValidatorFunc(func(ctx context.Context, r Resource, u map[string]interface{}) ([]PolicyViolation, error) {
+		return nil, nil
+	})
+	return NewConfig(
+		WithRetryProvider(&BackoffRetryProvider{InitialInterval: time.Second}),
+		WithQueryParams(map[string]string{"alt": "json"}),
+		WithHeader("X-Test", "a"),
+		WithEndpoint("pubsub", "https://pubsub.example.com/v1/"),
+		WithInterceptors(interceptor),
+		WithPolicyValidators(validator),
+		WithDefaultLabels(map[string]string{"env": "prod"}),
+		WithOperationProgress(func(ctx context.Context, p OperationProgress) {}),
+		WithResourceTimeout("pubsub.Topic", time.Minute),
+		WithResourceRetryProvider("pubsub.Topic", &BackoffRetryProvider{InitialInterval: time.Second}),
+	)
+}
+
+func TestCloneIsIndependent(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		check  func(c *Config) bool
+	}{
+		{
+			name:   "RetryProvider",
+			modify: func(c *Config) { c.RetryProvider.(*BackoffRetryProvider).InitialInterval = time.Hour },
+			check:  func(c *Config) bool { return c.RetryProvider.(*BackoffRetryProvider).InitialInterval == time.Second },
+		},
+		{
+			name:   "codeRetryability",
+			modify: func(c *Config) { c.codeRetryability[404] = Retryability{Retryable: true} },
+			check:  func(c *Config) bool { return !c.codeRetryability[404].Retryable },
+		},
+		{
+			name:   "header",
+			modify: func(c *Config) { c.header.Set("X-Test", "b") },
+			check:  func(c *Config) bool { return c.header.Get("X-Test") == "a" },
+		},
+		{
+			name:   "queryParams",
+			modify: func(c *Config) { c.queryParams["alt"] = "proto" },
+			check:  func(c *Config) bool { return c.queryParams["alt"] == "json" },
+		},
+		{
+			name:   "endpoints",
+			modify: func(c *Config) { c.endpoints["pubsub"] = "http://localhost:8085/v1/" },
+			check:  func(c *Config) bool { return c.endpoints["pubsub"] == "https://pubsub.example.com/v1/" },
+		},
+		{
+			name:   "interceptors",
+			modify: func(c *Config) { c.interceptors[0] = nil },
+			check:  func(c *Config) bool { return c.interceptors[0] != nil },
+		},
+		{
+			name:   "policyValidators",
+			modify: func(c *Config) { c.policyValidators[0] = nil },
+			check:  func(c *Config) bool { return c.policyValidators[0] != nil },
+		},
+		{
+			name:   "defaultLabels",
+			modify: func(c *Config) { c.defaultLabels["env"] = "dev" },
+			check:  func(c *Config) bool { return c.defaultLabels["env"] == "prod" },
+		},
+		{
+			name:   "progressFuncs",
+			modify: func(c *Config) { c.progressFuncs[0] = nil },
+			check:  func(c *Config) bool { return c.progressFuncs[0] != nil },
+		},
+		{
+			name:   "resourceTimeouts",
+			modify: func(c *Config) { c.resourceTimeouts["pubsub.Topic"] = time.Hour },
+			check:  func(c *Config) bool { return c.resourceTimeouts["pubsub.Topic"] == time.Minute },
+		},
+		{
+			name:   "resourceRetryProviders",
+			modify: func(c *Config) { c.resourceRetryProviders["pubsub.Topic"] = immediateRetry{} },
+			check: func(c *Config) bool {
+				_, ok := c.resourceRetryProviders["pubsub.Topic"].(*BackoffRetryProvider)
+				return ok
+			},
+		},
+		{
+			name: "resourceRetryProviders value",
+			modify: func(c *Config) {
+				c.resourceRetryProviders["pubsub.Topic"].(*BackoffRetryProvider).MaxInterval = time.Hour
+			},
+			check: func(c *Config) bool {
+				return c.resourceRetryProviders["pubsub.Topic"].(*BackoffRetryProvider).MaxInterval == 0
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			original := cloneTestConfig()
+			tc.modify(original.Clone())
+			if !tc.check(original) {
+				t.Errorf("changing the %s of a clone changed the original", tc.name)
+			}
+			clone := original.Clone()
+			tc.modify(original)
+			if !tc.check(clone) {
+				t.Errorf("changing the %s of the original changed a clone", tc.name)
+			}
+		})
+	}
+}
+
+func TestLoadedConfigIsImmutable(t *testing.T) {
+	l, err := LoadConfig("", WithBasePath("https://example.com/"), WithRetryProvider(&BackoffRetryProvider{InitialInterval: time.Second}))
+	if err != nil {
+		t.Fatalf("LoadConfig() returned error: %v", err)
+	}
+	c := l.Config()
+	c.BasePath = "https://changed.example.com/"
+	c.RetryProvider.(*BackoffRetryProvider).InitialInterval = time.Minute
+	WithCodeRetryability(map[int]Retryability{404: {Retryable: true}})(c)
+
+	got := l.Config()
+	if got.BasePath != "https://example.com/" {
+		t.Errorf("BasePath = %q after changing a copy, want %q", got.BasePath, "https://example.com/")
+	}
+	if i := got.RetryProvider.(*BackoffRetryProvider).InitialInterval; i != time.Second {
+		t.Errorf("InitialInterval = %v after changing a copy, want %v", i, time.Second)
+	}
+	if got.codeRetryability[404].Retryable {
+		t.Errorf("404 is retryable after changing a copy")
+	}
+	if o := l.Config(WithBasePath("https://override.example.com/")); o.BasePath != "https://override.example.com/" {
+		t.Errorf("Config() with an option: BasePath = %q, want %q", o.BasePath, "https://override.example.com/")
+	}
+}
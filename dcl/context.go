@@ -67,3 +67,10 @@ func ContextWithRequestID(ctx context.Context) context.Context {
 	}
 	return context.WithValue(ctx, APIRequestIDKey, CreateAPIRequestID())
 }
+
+// DetachContext returns a context which carries the values of ctx, such as its
+// request ID, but is never cancelled, for work shared by several callers that
+// must not end when one of them does.
+func DetachContext(ctx context.Context) context.Context {
+	return detachedContext{parent: ctx}
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// defaultUniverseDomain is the domain which hosts the default endpoints of Google APIs.
+const defaultUniverseDomain = "googleapis.com"
+
+// WithEndpoint routes every request for a service to the given endpoint, leaving other
+// services at their defaults. The service is either a host such as
+// "us-central1-aiplatform.googleapis.com" or the name of a service such as "pubsub",
+// which matches the host "pubsub.googleapis.com". The endpoint replaces the scheme and
+// host of the request URL, and its path, if any, is prepended to the request path.
+//
+// Endpoints apply to every request sent with the Config, including the URLs built by
+// the generated clients, fixed URLs such as the one used by FetchProjectInfo, and the
+// URLs of long-running operations. Credentials are not sent to endpoints with an "http"
+// scheme, such as local emulators.
+func WithEndpoint(service, endpoint string) ConfigOption {
+	return func(c *Config) {
+		endpoints := make(map[string]string, len(c.endpoints)+1)
+		for k, v := range c.endpoints {
+			endpoints[k] = v
+		}
+		endpoints[service] = endpoint
+		c.endpoints = endpoints
+	}
+}
+
+// WithEndpoints routes requests for each service in the map to its endpoint, as
+// described in WithEndpoint.
+func WithEndpoints(endpoints map[string]string) ConfigOption {
+	return func(c *Config) {
+		for service, endpoint := range endpoints {
+			WithEndpoint(service, endpoint)(c)
+		}
+	}
+}
+
+// WithUniverseDomain sends requests for hosts under "googleapis.com" to the same host
+// under the given domain instead, e.g. "pubsub.googleapis.com" to "pubsub.example.com".
+// Endpoints set with WithEndpoint take precedence.
+func WithUniverseDomain(domain string) ConfigOption {
+	return func(c *Config) {
+		c.universeDomain = strings.Trim(domain, ".")
+	}
+}
+
+// EndpointURL returns the URL which a request for u is sent to after the endpoints and
+// universe domain of c are applied.
+func (c *Config) EndpointURL(u string) (string, error) {
+	u, _, err := c.resolveEndpoint(u)
+	return u, err
+}
+
+// resolveEndpoint returns the URL which a request for u is sent to, and true if it was
+// routed to an endpoint set with WithEndpoint.
+func (c *Config) resolveEndpoint(u string) (string, bool, error) {
+	if len(c.endpoints) == 0 && (c.universeDomain == "" || c.universeDomain == defaultUniverseDomain) {
+		return u, false, nil
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "", false, err
+	}
+	host := parsed.Hostname()
+	if endpoint, ok := c.endpointFor(host); ok {
+		e, err := url.Parse(endpoint)
+		if err != nil {
+			return "", false, fmt.Errorf("invalid endpoint %q for host %q: %w", endpoint, host, err)
+		}
+		if e.Scheme == "" || e.Host == "" {
+			return "", false, fmt.Errorf("endpoint %q for host %q must include a scheme and host", endpoint, host)
+		}
+		parsed.Scheme = e.Scheme
+		parsed.Host = e.Host
+		if p := strings.TrimSuffix(e.Path, "/"); p != "" {
+			parsed.Path = p + "/" + strings.TrimPrefix(parsed.Path, "/")
+			parsed.RawPath = ""
+		}
+		return parsed.String(), true, nil
+	}
+	if c.universeDomain != "" && strings.HasSuffix(host, "."+defaultUniverseDomain) {
+		parsed.Host = strings.TrimSuffix(host, defaultUniverseDomain) + c.universeDomain
+		if port := parsed.Port(); port != "" {
+			parsed.Host += ":" + port
+		}
+		return parsed.String(), false, nil
+	}
+	return u, false, nil
+}
+
+// endpointFor returns the endpoint set for host, looking it up first by the host and
+// then by the name of the service it serves.
+func (c *Config) endpointFor(host string) (string, bool) {
+	if e, ok := c.endpoints[host]; ok {
+		return e, true
+	}
+	if service := strings.TrimSuffix(host, "."+defaultUniverseDomain); service != host && !strings.Contains(service, ".") {
+		e, ok := c.endpoints[service]
+		return e, ok
+	}
+	return "", false
+}
@@ -14,6 +14,7 @@
 package dcl
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -33,19 +34,34 @@ func (e NotFoundError) Error() string {
 	return fmt.Sprintf("not found: %s", e.Cause)
 }
 
-// HasCode returns true if the given error is an HTTP response with the given code.
+func (e NotFoundError) Unwrap() error {
+	return e.Cause
+}
+
+// HasCode returns true if the given error is, or wraps, an HTTP response with the given
+// code. Failed operations are not HTTP responses, even if their status maps to the code:
+// a delete operation which failed with NOT_FOUND has not deleted anything.
 func HasCode(err error, code int) bool {
-	if gerr, ok := err.(*googleapi.Error); ok {
-		if gerr.Code == code {
-			return true
-		}
+	c, ok := httpErrorCode(err)
+	return ok && c == code
+}
+
+// httpErrorCode returns the status code of the HTTP response err is or wraps, if any.
+func httpErrorCode(err error) (int, bool) {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
+		return gerr.Code, true
 	}
-	return false
+	var aerr *asyncError
+	if errors.As(err, &aerr) && aerr.HTTP {
+		return aerr.Code, true
+	}
+	return 0, false
 }
 
-// IsNotFound returns true if the given error is a NotFoundError or is an HTTP 404.
+// IsNotFound returns true if the given error is, or wraps, a NotFoundError or an HTTP 404.
 func IsNotFound(err error) bool {
-	if _, ok := err.(NotFoundError); ok {
+	if errors.As(err, &NotFoundError{}) {
 		return true
 	}
 	return HasCode(err, 404)
@@ -89,7 +105,8 @@ func IsRetryableGoogleError(gerr *googleapi.Error, retryability Retryability, st
 
 // IsRetryableHTTPError returns true if the error is retryable - in GCP that's a 500, 502, 503, or 429.
 func IsRetryableHTTPError(err error, retryability map[int]Retryability, start time.Time) bool {
-	if gerr, ok := err.(*googleapi.Error); ok {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
 		rtblt, ok := retryability[gerr.Code]
 		return ok && IsRetryableGoogleError(gerr, rtblt, start)
 	}
@@ -98,19 +115,20 @@ func IsRetryableHTTPError(err error, retryability map[int]Retryability, start ti
 
 // IsNonRetryableHTTPError returns true if we know that the error is not retryable - in GCP that's a 400, 403, 404, or 409.
 func IsNonRetryableHTTPError(err error, retryability map[int]Retryability, start time.Time) bool {
-	if gerr, ok := err.(*googleapi.Error); ok {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
 		rtblt, ok := retryability[gerr.Code]
 		return ok && !IsRetryableGoogleError(gerr, rtblt, start)
 	}
 	return false
 }
 
-// IsConflictError returns true if the error has conflict error code 409.
+// IsConflictError returns true if the error is, or wraps, an HTTP 409 response to a request.
+// Long-running operations which failed with an equivalent status, such as ABORTED, are not
+// conflicts: the request which started them succeeded, and they may not be safe to retry.
 func IsConflictError(err error) bool {
-	if gerr, ok := err.(*googleapi.Error); ok {
-		return gerr.Code == 409
-	}
-	return false
+	var gerr *googleapi.Error
+	return errors.As(err, &gerr) && gerr.Code == 409
 }
 
 // ApplyInfeasibleError is returned when lifecycle directives prevent an Apply from proceeding.
@@ -144,6 +162,10 @@ func (e OperationNotDone) Error() string {
 	return "operation not done."
 }
 
+func (e OperationNotDone) Unwrap() error {
+	return e.Err
+}
+
 // AttemptToIndexNilArray is returned when GetMapEntry is called with a path that includes an array
 // index and that array is unset in the map.
 type AttemptToIndexNilArray struct {
@@ -0,0 +1,68 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+)
+
+func TestIsConflictError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil"},
+		{name: "HTTP 409", err: &googleapi.Error{Code: 409}, want: true},
+		{name: "wrapped HTTP 409", err: fmt.Errorf("failed to update: %w", &googleapi.Error{Code: 409}), want: true},
+		{name: "HTTP 400", err: &googleapi.Error{Code: 400}},
+		{name: "aborted operation", err: NewRPCOperationError("operations/1", 10, "aborted", nil)},
+		{name: "operation already exists", err: NewRPCOperationError("operations/1", 6, "already exists", nil)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsConflictError(tc.err); got != tc.want {
+				t.Errorf("IsConflictError(%v) = %v, want %v", tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil"},
+		{name: "HTTP 404", err: &googleapi.Error{Code: 404}, want: true},
+		{name: "wrapped HTTP 404", err: fmt.Errorf("failed to get: %w", &googleapi.Error{Code: 404}), want: true},
+		{name: "NotFoundError", err: NotFoundError{Cause: fmt.Errorf("gone")}, want: true},
+		{name: "HTTP 403", err: &googleapi.Error{Code: 403}},
+		// A failed operation is not a missing resource, whatever its status maps to.
+		{name: "not found operation", err: NewRPCOperationError("operations/1", 5, "not found", nil)},
+		{name: "resumed HTTP 404", err: &asyncError{Message: "not found", Code: 404, HTTP: true}, want: true},
+		{name: "resumed not found operation", err: &asyncError{Message: "not found", Code: 404}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsNotFound(tc.err); got != tc.want {
+				t.Errorf("IsNotFound(%v) = %v, want %v", tc.err, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/option/internaloption"
+	apihttp "google.golang.org/api/transport/http"
+)
+
+// mtlsModeEnv is the environment variable which decides whether requests are sent to
+// the default or the mTLS endpoint of an API.
+const mtlsModeEnv = "GOOGLE_API_USE_MTLS"
+
+// plainHTTPMode is the key of the unauthenticated client used for plain HTTP endpoints.
+const plainHTTPMode = "plain-http"
+
+// httpClientCache holds the authenticated HTTP clients built for a Config, so that
+// credential discovery, token sources, and TLS connections are shared by every
+// request made with it rather than rebuilt for each one. Configs share a cache
+// until an option changes their credentials or logger.
+type httpClientCache struct {
+	mu sync.Mutex
+	// clients is keyed by the value of mtlsModeEnv when the client was built.
+	clients map[string]*cachedHTTPClient
+}
+
+// cachedHTTPClient is an authenticated HTTP client and the endpoint kind it sends requests to.
+type cachedHTTPClient struct {
+	client *http.Client
+	// mtls is true if requests to default endpoints are sent to their mTLS variant. It is
+	// decided when the client is built, from the mTLS mode and the client certificate,
+	// independently of the endpoint of the request which built it.
+	mtls bool
+}
+
+// The endpoints with which the mTLS decision of a cached client is resolved. They only
+// identify which of the two the client library picked and are never sent requests.
+const (
+	mtlsProbeEndpoint     = "https://dcl-probe.googleapis.com/"
+	mtlsProbeMTLSEndpoint = "https://dcl-probe.mtls.googleapis.com/"
+)
+
+func newHTTPClientCache() *httpClientCache {
+	return &httpClientCache{clients: make(map[string]*cachedHTTPClient)}
+}
+
+// httpClient returns the authenticated HTTP client for c and the URL to send a request
+// for u to, which is either u or its mTLS variant. If mtls is u, the endpoint was set by the
+// user and is used as it is.
+func (c *Config) httpClient(ctx context.Context, u, mtls string) (*http.Client, string, error) {
+	if c.clients == nil {
+		// Configs which were not built by NewConfig have nowhere to cache the client.
+		if isPlainHTTP(u) {
+			return c.newPlainHTTPClient(), u, nil
+		}
+		return c.newHTTPClient(ctx, u, mtls)
+	}
+
+	c.clients.mu.Lock()
+	defer c.clients.mu.Unlock()
+	if isPlainHTTP(u) {
+		cc, ok := c.clients.clients[plainHTTPMode]
+		if !ok {
+			cc = &cachedHTTPClient{client: c.newPlainHTTPClient()}
+			c.clients.clients[plainHTTPMode] = cc
+		}
+		return cc.client, u, nil
+	}
+	mode := os.Getenv(mtlsModeEnv)
+	cc, ok := c.clients.clients[mode]
+	if !ok {
+		// The client outlives this request, so it must not be bound to its context. It is
+		// built for the probe endpoints rather than this request's, which may be overridden.
+		client, endpoint, err := c.newHTTPClient(detachedContext{ctx}, mtlsProbeEndpoint, mtlsProbeMTLSEndpoint)
+		if err != nil {
+			return nil, "", err
+		}
+		cc = &cachedHTTPClient{client: client, mtls: endpoint == mtlsProbeMTLSEndpoint}
+		c.clients.clients[mode] = cc
+	}
+	if cc.mtls {
+		return cc.client, mtls, nil
+	}
+	return cc.client, u, nil
+}
+
+// newHTTPClient builds an authenticated HTTP client for c which logs every request, and
+// returns it along with the URL to send a request for u to.
+func (c *Config) newHTTPClient(ctx context.Context, u, mtls string) (*http.Client, string, error) {
+	options := []option.ClientOption{
+		option.WithScopes(Scopes...),
+		internaloption.WithDefaultEndpoint(u),
+		internaloption.WithDefaultMTLSEndpoint(mtls),
+	}
+	for _, o := range c.clientOptions {
+		options = append(options, o)
+	}
+
+	httpClient, endpoint, err := apihttp.NewClient(ctx, options...)
+	if err != nil {
+		return nil, "", err
+	}
+	if endpoint == "" {
+		endpoint = u
+	}
+
+	if _, ok := httpClient.Transport.(loggingTransport); !ok {
+		// In cases where the config has been created using WithHTTPClient() we want to
+		// replace the default transport with our logging transport only once.
+		httpClient = &http.Client{
+			Transport: loggingTransport{
+				underlyingTransport: httpClient.Transport,
+				logger:              c.Logger,
+			},
+			CheckRedirect: httpClient.CheckRedirect,
+			Jar:           httpClient.Jar,
+			Timeout:       httpClient.Timeout,
+		}
+	}
+	return httpClient, endpoint, nil
+}
+
+// newPlainHTTPClient builds an HTTP client for c which logs every request but does not
+// authenticate them, for endpoints such as local emulators which are served over plain
+// HTTP, so that credentials are never sent unencrypted.
+func (c *Config) newPlainHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: loggingTransport{
+			underlyingTransport: http.DefaultTransport,
+			logger:              c.Logger,
+		},
+	}
+}
+
+// isPlainHTTP returns true if u is sent over plain HTTP.
+func isPlainHTTP(u string) bool {
+	return strings.HasPrefix(strings.ToLower(u), "http://")
+}
+
+// detachedContext carries the values of its parent but is never cancelled, so that token
+// sources built for a cached client keep working after the request which built it ends.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (d detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (d detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (d detachedContext) Err() error {
+	return nil
+}
+
+func (d detachedContext) Value(key interface{}) interface{} {
+	return d.parent.Value(key)
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+func testCredentials() *google.Credentials {
+	return &google.Credentials{TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"})}
+}
+
+func TestHTTPClientMTLSEndpoint(t *testing.T) {
+	const (
+		defaultEndpoint = "https://pubsub.googleapis.com/v1/projects/p/topics"
+		mtlsEndpoint    = "https://pubsub.mtls.googleapis.com/v1/projects/p/topics"
+		userEndpoint    = "https://pubsub.example.com/v1/projects/p/topics"
+	)
+	tests := []struct {
+		mode string
+		want string
+	}{
+		{mode: "never", want: defaultEndpoint},
+		{mode: "always", want: mtlsEndpoint},
+	}
+	for _, tc := range tests {
+		t.Run(tc.mode, func(t *testing.T) {
+			t.Setenv(mtlsModeEnv, tc.mode)
+			c := NewConfig(WithCredentials(testCredentials()))
+			ctx := context.Background()
+
+			// The first request goes to an endpoint set by the user, which is used as it is
+			// and must not decide where later requests for default endpoints go.
+			_, got, err := c.httpClient(ctx, userEndpoint, userEndpoint)
+			if err != nil {
+				t.Fatalf("httpClient() returned error: %v", err)
+			}
+			if got != userEndpoint {
+				t.Errorf("httpClient() endpoint = %q, want %q", got, userEndpoint)
+			}
+			_, got, err = c.httpClient(ctx, defaultEndpoint, mtlsEndpoint)
+			if err != nil {
+				t.Fatalf("httpClient() returned error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("httpClient() endpoint = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+// BenchmarkListThenGet lists a collection and then gets one of its items, which is how
+// resources are looked up, with and without the HTTP client cache of the Config.
+func BenchmarkListThenGet(b *testing.B) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v1/projects/p/topics" {
+			w.Write([]byte(`{"topics":[{"name":"projects/p/topics/t"}]}`))
+			return
+		}
+		w.Write([]byte(`{"name":"projects/p/topics/t"}`))
+	}))
+	defer srv.Close()
+
+	// The clients built by the client library start from http.DefaultTransport, which
+	// must trust the test server.
+	defaultTransport := http.DefaultTransport
+	http.DefaultTransport = srv.Client().Transport.(*http.Transport).Clone()
+	defer func() { http.DefaultTransport = defaultTransport }()
+
+	benchmarks := []struct {
+		name   string
+		cached bool
+	}{
+		{name: "cached", cached: true},
+		{name: "uncached"},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			c := NewConfig(WithCredentials(testCredentials()))
+			if !bm.cached {
+				c.clients = nil
+			}
+			ctx := context.Background()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for _, u := range []string{srv.URL + "/v1/projects/p/topics", srv.URL + "/v1/projects/p/topics/t"} {
+					resp, err := SendRequest(ctx, c, "GET", u, nil, nil)
+					if err != nil {
+						b.Fatalf("SendRequest(%q) returned error: %v", u, err)
+					}
+					ioutil.ReadAll(resp.Response.Body)
+					resp.Response.Body.Close()
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type ignoredFieldPaths struct {
+	paths []string
+}
+
+func (i ignoredFieldPaths) Apply(o *ApplyOpts) {
+	o.ignoredFieldPaths = append(o.ignoredFieldPaths, i.paths...)
+}
+
+// WithIgnoredFieldPaths ignores changes to fields which are managed outside of the DCL,
+// such as an instance group's target size set by an autoscaler. Paths use the dotted
+// format of FieldDiff.FieldName, e.g. "TargetSize" or "NetworkInterfaces[0].Network",
+// and may use the JSON names of fields instead, e.g. "targetSize". The segment after a
+// map field is a key, as in "metadata.ssh-keys". A path through a list without an
+// index applies to every element.
+//
+// If the resource exists, its ignored fields keep their current values: they are not
+// diffed and are never part of an update request or mask. Ignored fields are still sent
+// when the resource is created.
+func WithIgnoredFieldPaths(paths ...string) ApplyOption {
+	return ignoredFieldPaths{paths: paths}
+}
+
+// FetchIgnoredFieldPaths returns the field paths ignored with WithIgnoredFieldPaths.
+func FetchIgnoredFieldPaths(c []ApplyOption) []string {
+	var o ApplyOpts
+	for _, p := range c {
+		p.Apply(&o)
+	}
+	return o.ignoredFieldPaths
+}
+
+// CopyIgnoredFields returns a copy of desired in which each field ignored by opts has its
+// value in actual, so that it is not diffed. desired itself is never modified: objects,
+// lists, and maps on the path to an ignored field are copied before they are changed.
+// It returns an error if an ignored path does not name a field of the resource.
+func CopyIgnoredFields[T any](desired, actual *T, opts []ApplyOption) (*T, error) {
+	paths := FetchIgnoredFieldPaths(opts)
+	if len(paths) == 0 || desired == nil || actual == nil {
+		return desired, nil
+	}
+	c := *desired
+	d, a := reflect.ValueOf(&c).Elem(), reflect.ValueOf(actual).Elem()
+	for _, p := range paths {
+		if p == "" {
+			return nil, fmt.Errorf("ignored field path is empty")
+		}
+		if err := copyIgnoredField(d, a, strings.Split(p, ".")); err != nil {
+			return nil, fmt.Errorf("invalid ignored field path %q for %s: %w", p, d.Type().Name(), err)
+		}
+	}
+	return &c, nil
+}
+
+// RemoveIgnoredFieldDiffs returns the diffs which are not of a field ignored by opts or
+// nested within one, so that ignored fields are never part of an update request or mask.
+func RemoveIgnoredFieldDiffs(ds []*FieldDiff, opts []ApplyOption) []*FieldDiff {
+	paths := FetchIgnoredFieldPaths(opts)
+	if len(paths) == 0 {
+		return ds
+	}
+	var kept []*FieldDiff
+	for _, d := range ds {
+		if !fieldNameIgnored(d.FieldName, paths) {
+			kept = append(kept, d)
+		}
+	}
+	return kept
+}
+
+// fieldNameIgnored returns true if the field named fieldName, in the format of
+// FieldDiff.FieldName, is one of paths or is nested within one of them.
+func fieldNameIgnored(fieldName string, paths []string) bool {
+	names := strings.Split(fieldName, ".")
+	for _, p := range paths {
+		segments := strings.Split(p, ".")
+		if len(segments) > len(names) {
+			continue
+		}
+		matches := true
+		for i, s := range segments {
+			name, index, err := parsePathSegment(s)
+			if err != nil {
+				matches = false
+				break
+			}
+			fname, findex, err := parsePathSegment(names[i])
+			if err != nil || !strings.EqualFold(name, fname) || (index >= 0 && index != findex) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// copyIgnoredField sets the field at path in the struct d to its value in the struct a.
+// d must be settable and not shared with the desired state passed to CopyIgnoredFields.
+func copyIgnoredField(d, a reflect.Value, path []string) error {
+	name, index, err := parsePathSegment(path[0])
+	if err != nil {
+		return err
+	}
+	f, ok := structFieldByPathName(d.Type(), name)
+	if !ok {
+		return fmt.Errorf("%s has no field %q", d.Type().Name(), name)
+	}
+	df, af := d.FieldByIndex(f.Index), a.FieldByIndex(f.Index)
+	if index >= 0 {
+		if df.Kind() != reflect.Slice {
+			return fmt.Errorf("field %q is not a list", name)
+		}
+		if index >= df.Len() || index >= af.Len() {
+			return nil
+		}
+		if len(path) > 1 {
+			copySlice(df)
+		}
+		df, af = df.Index(index), af.Index(index)
+	}
+	return copyIgnoredValue(df, af, path[1:])
+}
+
+// copyIgnoredValue sets the value at path within d to its value within a. d must be
+// settable; anything it refers to is copied before it is changed.
+func copyIgnoredValue(d, a reflect.Value, path []string) error {
+	if len(path) == 0 {
+		d.Set(a)
+		return nil
+	}
+	switch d.Kind() {
+	case reflect.Ptr:
+		zero := reflect.New(d.Type().Elem()).Elem()
+		if d.IsNil() {
+			// An unset object is not diffed, so only the rest of the path is validated.
+			return copyIgnoredValue(zero, zero, path)
+		}
+		c := reflect.New(d.Type().Elem())
+		c.Elem().Set(d.Elem())
+		d.Set(c)
+		if a.IsNil() {
+			return copyIgnoredValue(d.Elem(), zero, path)
+		}
+		return copyIgnoredValue(d.Elem(), a.Elem(), path)
+	case reflect.Struct:
+		return copyIgnoredField(d, a, path)
+	case reflect.Slice:
+		if d.Len() > 0 && a.Len() > 0 {
+			copySlice(d)
+		}
+		for i := 0; i < d.Len() && i < a.Len(); i++ {
+			if err := copyIgnoredValue(d.Index(i), a.Index(i), path); err != nil {
+				return err
+			}
+		}
+		if d.Len() == 0 || a.Len() == 0 {
+			// Validate the rest of the path against the element type.
+			e := reflect.New(d.Type().Elem()).Elem()
+			return copyIgnoredValue(e, e, path)
+		}
+		return nil
+	case reflect.Map:
+		if len(path) > 1 {
+			return fmt.Errorf("map keys cannot be followed by field names")
+		}
+		k := reflect.ValueOf(path[0])
+		if d.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("map keys must be strings")
+		}
+		k = k.Convert(d.Type().Key())
+		v := a.MapIndex(k)
+		if a.IsNil() || !v.IsValid() {
+			if d.IsNil() || !d.MapIndex(k).IsValid() {
+				return nil
+			}
+			v = reflect.Value{}
+		}
+		m := reflect.MakeMapWithSize(d.Type(), d.Len()+1)
+		iter := d.MapRange()
+		for iter.Next() {
+			m.SetMapIndex(iter.Key(), iter.Value())
+		}
+		m.SetMapIndex(k, v)
+		d.Set(m)
+		return nil
+	}
+	return fmt.Errorf("%q is not an object, list, or map", path[0])
+}
+
+// copySlice replaces the settable slice d with a copy of it, so that its elements can be
+// changed without changing the original.
+func copySlice(d reflect.Value) {
+	c := reflect.MakeSlice(d.Type(), d.Len(), d.Len())
+	reflect.Copy(c, d)
+	d.Set(c)
+}
+
+// parsePathSegment splits a segment such as "disks[0]" into its name and index. The index
+// is -1 if the segment has none.
+func parsePathSegment(s string) (string, int, error) {
+	i := strings.Index(s, "[")
+	if i < 0 {
+		return s, -1, nil
+	}
+	if !strings.HasSuffix(s, "]") {
+		return "", 0, fmt.Errorf("malformed segment %q", s)
+	}
+	index, err := strconv.Atoi(s[i+1 : len(s)-1])
+	if err != nil || index < 0 {
+		return "", 0, fmt.Errorf("malformed index in segment %q", s)
+	}
+	return s[:i], index, nil
+}
+
+// structFieldByPathName returns the field of t whose Go or JSON name is name, ignoring case.
+func structFieldByPathName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+		if strings.EqualFold(f.Name, name) || (jsonName != "" && jsonName != "-" && strings.EqualFold(jsonName, name)) {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
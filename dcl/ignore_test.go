@@ -0,0 +1,197 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type ignoreTestDisk struct {
+	Name *string `json:"name"`
+	Size *int64  `json:"sizeGb"`
+}
+
+type ignoreTestPolicy struct {
+	MinReplicas *int64 `json:"minReplicas"`
+	MaxReplicas *int64 `json:"maxReplicas"`
+}
+
+type ignoreTestResource struct {
+	Name       *string           `json:"name"`
+	TargetSize *int64            `json:"targetSize"`
+	Metadata   map[string]string `json:"metadata"`
+	Disks      []ignoreTestDisk  `json:"disks"`
+	Policy     *ignoreTestPolicy `json:"autoscalingPolicy"`
+}
+
+func ignoreTestDesired() *ignoreTestResource {
+	return &ignoreTestResource{
+		Name:       String("r"),
+		TargetSize: Int64(1),
+		Metadata:   map[string]string{"a": "1", "ssh-keys": "desired"},
+		Disks:      []ignoreTestDisk{{Name: String("d0"), Size: Int64(10)}, {Name: String("d1"), Size: Int64(10)}},
+		Policy:     &ignoreTestPolicy{MinReplicas: Int64(1), MaxReplicas: Int64(5)},
+	}
+}
+
+func ignoreTestActual() *ignoreTestResource {
+	return &ignoreTestResource{
+		Name:       String("r"),
+		TargetSize: Int64(7),
+		Metadata:   map[string]string{"a": "1", "ssh-keys": "actual"},
+		Disks:      []ignoreTestDisk{{Name: String("d0"), Size: Int64(20)}, {Name: String("d1"), Size: Int64(30)}},
+		Policy:     &ignoreTestPolicy{MinReplicas: Int64(2), MaxReplicas: Int64(9)},
+	}
+}
+
+func TestCopyIgnoredFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		paths   []string
+		want    func(r *ignoreTestResource)
+		wantErr bool
+	}{
+		{
+			name: "no paths",
+			want: func(r *ignoreTestResource) {},
+		},
+		{
+			name:  "top-level field",
+			paths: []string{"TargetSize"},
+			want:  func(r *ignoreTestResource) { r.TargetSize = Int64(7) },
+		},
+		{
+			name:  "JSON name",
+			paths: []string{"targetSize"},
+			want:  func(r *ignoreTestResource) { r.TargetSize = Int64(7) },
+		},
+		{
+			name:  "nested field",
+			paths: []string{"autoscalingPolicy.maxReplicas"},
+			want:  func(r *ignoreTestResource) { r.Policy.MaxReplicas = Int64(9) },
+		},
+		{
+			name:  "map key",
+			paths: []string{"metadata.ssh-keys"},
+			want:  func(r *ignoreTestResource) { r.Metadata["ssh-keys"] = "actual" },
+		},
+		{
+			name:  "list element",
+			paths: []string{"Disks[1].Size"},
+			want:  func(r *ignoreTestResource) { r.Disks[1].Size = Int64(30) },
+		},
+		{
+			name:  "every list element",
+			paths: []string{"disks.sizeGb"},
+			want: func(r *ignoreTestResource) {
+				r.Disks[0].Size = Int64(20)
+				r.Disks[1].Size = Int64(30)
+			},
+		},
+		{
+			name:    "unknown field",
+			paths:   []string{"Autoscaler"},
+			wantErr: true,
+		},
+		{
+			name:    "field after map key",
+			paths:   []string{"metadata.ssh-keys.value"},
+			wantErr: true,
+		},
+		{
+			name:    "empty path",
+			paths:   []string{""},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			desired := ignoreTestDesired()
+			got, err := CopyIgnoredFields(desired, ignoreTestActual(), []ApplyOption{WithIgnoredFieldPaths(tc.paths...)})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("CopyIgnoredFields() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(ignoreTestDesired(), desired); diff != "" {
+				t.Errorf("CopyIgnoredFields() modified desired (-want +got):\n%s", diff)
+			}
+			if tc.wantErr {
+				return
+			}
+			want := ignoreTestDesired()
+			tc.want(want)
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("CopyIgnoredFields() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveIgnoredFieldDiffs(t *testing.T) {
+	ds := []*FieldDiff{
+		{FieldName: "Name"},
+		{FieldName: "TargetSize"},
+		{FieldName: "Metadata"},
+		{FieldName: "Disks[0].Size"},
+		{FieldName: "Disks[1].Size"},
+		{FieldName: "Policy.MaxReplicas"},
+	}
+	tests := []struct {
+		name  string
+		paths []string
+		want  []string
+	}{
+		{
+			name: "no paths",
+			want: []string{"Name", "TargetSize", "Metadata", "Disks[0].Size", "Disks[1].Size", "Policy.MaxReplicas"},
+		},
+		{
+			name:  "top-level field",
+			paths: []string{"targetSize"},
+			want:  []string{"Name", "Metadata", "Disks[0].Size", "Disks[1].Size", "Policy.MaxReplicas"},
+		},
+		{
+			name:  "nested fields",
+			paths: []string{"Policy"},
+			want:  []string{"Name", "TargetSize", "Metadata", "Disks[0].Size", "Disks[1].Size"},
+		},
+		{
+			name:  "list element",
+			paths: []string{"Disks[1].Size"},
+			want:  []string{"Name", "TargetSize", "Metadata", "Disks[0].Size", "Policy.MaxReplicas"},
+		},
+		{
+			name:  "every list element",
+			paths: []string{"Disks.Size"},
+			want:  []string{"Name", "TargetSize", "Metadata", "Policy.MaxReplicas"},
+		},
+		{
+			name:  "map key keeps diff of whole map",
+			paths: []string{"Metadata.ssh-keys"},
+			want:  []string{"Name", "TargetSize", "Metadata", "Disks[0].Size", "Disks[1].Size", "Policy.MaxReplicas"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, d := range RemoveIgnoredFieldDiffs(ds, []ApplyOption{WithIgnoredFieldPaths(tc.paths...)}) {
+				got = append(got, d.FieldName)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("RemoveIgnoredFieldDiffs() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
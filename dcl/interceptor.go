@@ -0,0 +1,142 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"context"
+	"net/http"
+)
+
+// Keys used in context Value to describe the calls made by the DCL.
+const (
+	serviceTypeVersionKey ReqCtxKey = "ServiceTypeVersionKey"
+	callKindKey           ReqCtxKey = "CallKindKey"
+)
+
+// CallKind describes the purpose of a request sent by the DCL.
+type CallKind int
+
+const (
+	// CallRead is a request which reads state without changing it.
+	CallRead CallKind = iota
+	// CallMutation is a request which creates, updates, or deletes state.
+	CallMutation
+	// CallPoll is a request which checks the progress of a long-running operation.
+	CallPoll
+)
+
+func (k CallKind) String() string {
+	switch k {
+	case CallRead:
+		return "read"
+	case CallMutation:
+		return "mutation"
+	case CallPoll:
+		return "poll"
+	}
+	return "unknown"
+}
+
+// RequestInfo describes a request to an Interceptor.
+type RequestInfo struct {
+	// STV is the resource the request is made for, or the zero value if it is unknown.
+	STV ServiceTypeVersion
+	// RequestID is the APIRequestID of the DCL call which sent the request.
+	RequestID string
+	// Verb is the HTTP method of the request.
+	Verb string
+	// Kind is the purpose of the request.
+	Kind CallKind
+	// Attempt is 1 for the first attempt of the request and increases with every retry.
+	Attempt int
+}
+
+// Invoker sends a request, either to the next Interceptor or to the API.
+type Invoker func(ctx context.Context, req *http.Request) (*http.Response, error)
+
+// Interceptor is called for every attempt of every request sent with a Config. It may
+// inspect and modify req before passing it to next, inspect the response next returns,
+// or return a response or error of its own without calling next. Responses returned by
+// an Interceptor are checked for HTTP errors and retried like those returned by the API.
+type Interceptor func(ctx context.Context, info *RequestInfo, req *http.Request, next Invoker) (*http.Response, error)
+
+// WithInterceptors adds interceptors to the requests sent with a Config. Interceptors are
+// called in the order they are added, so the first one added sees each request first
+// and each response last.
+func WithInterceptors(i ...Interceptor) ConfigOption {
+	return func(c *Config) {
+		c.interceptors = append(append([]Interceptor(nil), c.interceptors...), i...)
+	}
+}
+
+// ContextWithServiceTypeVersion returns a context which records that its requests are
+// made for the given resource.
+func ContextWithServiceTypeVersion(ctx context.Context, stv ServiceTypeVersion) context.Context {
+	return context.WithValue(ctx, serviceTypeVersionKey, stv)
+}
+
+// ServiceTypeVersionFromContext returns the resource which requests made with ctx are
+// made for, if it is known.
+func ServiceTypeVersionFromContext(ctx context.Context) (ServiceTypeVersion, bool) {
+	stv, ok := ctx.Value(serviceTypeVersionKey).(ServiceTypeVersion)
+	return stv, ok
+}
+
+// ContextWithCallKind returns a context whose requests are of the given kind. Requests
+// whose kind is not set are reads if they are GET or HEAD requests and mutations otherwise.
+func ContextWithCallKind(ctx context.Context, kind CallKind) context.Context {
+	return context.WithValue(ctx, callKindKey, kind)
+}
+
+func callKind(ctx context.Context, verb string) CallKind {
+	if kind, ok := ctx.Value(callKindKey).(CallKind); ok {
+		return kind
+	}
+	if verb == http.MethodGet || verb == http.MethodHead {
+		return CallRead
+	}
+	return CallMutation
+}
+
+// invoker returns the Invoker which sends requests for c through its interceptors to
+// the API with httpClient.
+func (c *Config) invoker(ctx context.Context, verb string, httpClient *http.Client) func(req *http.Request) (*http.Response, error) {
+	send := Invoker(func(_ context.Context, req *http.Request) (*http.Response, error) {
+		return httpClient.Do(req)
+	})
+	if len(c.interceptors) == 0 {
+		return func(req *http.Request) (*http.Response, error) {
+			return send(req.Context(), req)
+		}
+	}
+	stv, _ := ServiceTypeVersionFromContext(ctx)
+	info := RequestInfo{
+		STV:       stv,
+		RequestID: APIRequestID(ctx),
+		Verb:      verb,
+		Kind:      callKind(ctx, verb),
+	}
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := c.interceptors[i], send
+		send = func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			// Each interceptor gets its own copy, so that changes to it are not seen by the others.
+			ri := info
+			return interceptor(ctx, &ri, req, next)
+		}
+	}
+	return func(req *http.Request) (*http.Response, error) {
+		info.Attempt++
+		return send(req.Context(), req)
+	}
+}
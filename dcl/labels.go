@@ -0,0 +1,70 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import "strings"
+
+// ServerLabelPrefixes are the prefixes of labels which GCP adds to resources itself.
+// They are ignored when diffing the labels field of a resource.
+var ServerLabelPrefixes = []string{"goog-"}
+
+// WithDefaultLabels sets labels which are merged into the labels field (x-dcl-labels) of
+// every resource applied with a Config, on both create and update. Labels set on the
+// resource take precedence over the defaults. If a resource does not set its labels,
+// the defaults are merged into its existing labels instead.
+func WithDefaultLabels(labels map[string]string) ConfigOption {
+	return func(c *Config) {
+		defaults := make(map[string]string, len(c.defaultLabels)+len(labels))
+		for k, v := range c.defaultLabels {
+			defaults[k] = v
+		}
+		for k, v := range labels {
+			defaults[k] = v
+		}
+		c.defaultLabels = defaults
+	}
+}
+
+// MergeDefaultLabels returns the labels a resource should have given the default labels
+// of c, the labels desired by the user, and the labels the resource has now, which are
+// nil if it does not exist. Labels added by GCP are always kept from the current labels,
+// so that update requests do not remove them. If c has no default labels, desired is
+// returned as it is. Neither desired nor current is modified.
+func MergeDefaultLabels(c *Config, desired, current map[string]string) map[string]string {
+	if len(c.defaultLabels) == 0 {
+		return desired
+	}
+	merged := make(map[string]string)
+	for k, v := range current {
+		if desired == nil || isServerLabel(k) {
+			merged[k] = v
+		}
+	}
+	for k, v := range c.defaultLabels {
+		merged[k] = v
+	}
+	for k, v := range desired {
+		merged[k] = v
+	}
+	return merged
+}
+
+func isServerLabel(k string) bool {
+	for _, p := range ServerLabelPrefixes {
+		if strings.HasPrefix(k, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMergeDefaultLabels(t *testing.T) {
+	defaults := map[string]string{"env": "prod", "team": "infra"}
+	tests := []struct {
+		name     string
+		defaults map[string]string
+		desired  map[string]string
+		current  map[string]string
+		want     map[string]string
+	}{
+		{
+			name:    "no defaults",
+			desired: map[string]string{"a": "1"},
+			current: map[string]string{"b": "2", "goog-managed": "x"},
+			want:    map[string]string{"a": "1"},
+		},
+		{
+			name:     "create without labels",
+			defaults: defaults,
+			want:     map[string]string{"env": "prod", "team": "infra"},
+		},
+		{
+			name:     "desired labels take precedence",
+			defaults: defaults,
+			desired:  map[string]string{"env": "dev", "a": "1"},
+			want:     map[string]string{"env": "dev", "team": "infra", "a": "1"},
+		},
+		{
+			name:     "unset labels keep current labels",
+			defaults: defaults,
+			current:  map[string]string{"b": "2", "team": "web", "goog-managed": "x"},
+			want:     map[string]string{"b": "2", "env": "prod", "team": "infra", "goog-managed": "x"},
+		},
+		{
+			name:     "set labels replace current labels but keep server labels",
+			defaults: defaults,
+			desired:  map[string]string{"a": "1"},
+			current:  map[string]string{"b": "2", "goog-managed": "x"},
+			want:     map[string]string{"a": "1", "env": "prod", "team": "infra", "goog-managed": "x"},
+		},
+		{
+			name:     "desired server label wins",
+			defaults: defaults,
+			desired:  map[string]string{"goog-managed": "y"},
+			current:  map[string]string{"goog-managed": "x"},
+			want:     map[string]string{"env": "prod", "team": "infra", "goog-managed": "y"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewConfig(WithDefaultLabels(tc.defaults))
+			desired := cloneStringMap(tc.desired)
+			current := cloneStringMap(tc.current)
+			got := MergeDefaultLabels(c, desired, current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("MergeDefaultLabels() diff (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.desired, desired); diff != "" {
+				t.Errorf("MergeDefaultLabels() modified desired labels (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.current, current); diff != "" {
+				t.Errorf("MergeDefaultLabels() modified current labels (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
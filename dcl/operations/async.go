@@ -0,0 +1,104 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package operations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+// Types of the operations which can be deferred to a dcl.AsyncHandle.
+const (
+	standardGCPOperationType = "StandardGCPOperation"
+	crmOperationType         = "CRMOperation"
+	computeOperationType     = "ComputeOperation"
+)
+
+func init() {
+	dcl.RegisterPendingOperation(standardGCPOperationType, func(c *dcl.Config, state json.RawMessage) (dcl.PendingOperation, error) {
+		var s pendingOperationState
+		if err := json.Unmarshal(state, &s); err != nil {
+			return nil, err
+		}
+		return &StandardGCPOperation{Name: s.Name, config: c, basePath: s.BasePath, verb: s.Verb, start: time.Now()}, nil
+	})
+	dcl.RegisterPendingOperation(crmOperationType, func(c *dcl.Config, state json.RawMessage) (dcl.PendingOperation, error) {
+		var s pendingOperationState
+		if err := json.Unmarshal(state, &s); err != nil {
+			return nil, err
+		}
+		return &CRMOperation{Name: s.Name, config: c, basePath: s.BasePath, verb: s.Verb, version: s.Version, start: time.Now()}, nil
+	})
+	dcl.RegisterPendingOperation(computeOperationType, func(c *dcl.Config, state json.RawMessage) (dcl.PendingOperation, error) {
+		var s pendingOperationState
+		if err := json.Unmarshal(state, &s); err != nil {
+			return nil, err
+		}
+		return &ComputeOperation{SelfLink: s.Name, config: c, start: time.Now()}, nil
+	})
+}
+
+// pendingOperationState is the state a deferred operation is recreated from.
+type pendingOperationState struct {
+	// Name is the name of the operation, or its self link if it has no name.
+	Name     string `json:"name"`
+	BasePath string `json:"basePath,omitempty"`
+	Verb     string `json:"verb,omitempty"`
+	Version  string `json:"version,omitempty"`
+}
+
+// pollOnce calls operate once and returns true if the operation it fetched has finished.
+func pollOnce(ctx context.Context, operate func(context.Context) (*dcl.RetryDetails, error)) (bool, error) {
+	_, err := operate(ctx)
+	if _, ok := err.(dcl.OperationNotDone); ok {
+		return false, nil
+	}
+	return true, err
+}
+
+// Poll fetches the operation once and returns true if it has finished.
+func (op *StandardGCPOperation) Poll(ctx context.Context) (bool, error) {
+	return pollOnce(ctx, op.operate)
+}
+
+// Cancel asks the API to cancel the operation.
+func (op *StandardGCPOperation) Cancel(ctx context.Context) error {
+	u := dcl.URL(op.Name+":cancel", op.basePath, op.config.BasePath, nil)
+	_, err := dcl.SendRequest(ctx, op.config, "POST", u, &bytes.Buffer{}, op.config.RetryProvider)
+	return err
+}
+
+// Poll fetches the operation once and returns true if it has finished.
+func (op *CRMOperation) Poll(ctx context.Context) (bool, error) {
+	return pollOnce(ctx, op.operate)
+}
+
+// Cancel returns dcl.ErrCancelUnsupported, since CRM operations cannot be cancelled.
+func (op *CRMOperation) Cancel(_ context.Context) error {
+	return dcl.ErrCancelUnsupported
+}
+
+// Poll fetches the operation once and returns true if it has finished.
+func (op *ComputeOperation) Poll(ctx context.Context) (bool, error) {
+	return pollOnce(ctx, op.operate)
+}
+
+// Cancel returns dcl.ErrCancelUnsupported, since GCE operations cannot be cancelled.
+func (op *ComputeOperation) Cancel(_ context.Context) error {
+	return dcl.ErrCancelUnsupported
+}
@@ -33,9 +33,15 @@ type ComputeOperation struct {
 	Status     string                 `json:"status"`
 	TargetLink string                 `json:"targetLink"`
 	TargetID   string                 `json:"targetId"`
+	// Progress is the approximate percentage of the operation which is complete.
+	Progress      int    `json:"progress"`
+	StatusMessage string `json:"statusMessage"`
+	// HTTPErrorStatusCode is the HTTP status code of a failed operation.
+	HTTPErrorStatusCode int `json:"httpErrorStatusCode"`
 	// other irrelevant fields omitted
 
 	config *dcl.Config
+	start  time.Time
 }
 
 // ComputeOperationError is the GCE operation's Error body.
@@ -61,23 +67,67 @@ func (e *ComputeOperationError) String() string {
 	return b.String()
 }
 
+// status returns the code of the first error in e, e.g. "QUOTA_EXCEEDED".
+func (e *ComputeOperationError) status() string {
+	for _, err := range e.Errors {
+		if err.Code != "" {
+			return err.Code
+		}
+	}
+	return ""
+}
+
+// details converts the error details of e, which GCE reports in its own format, into
+// google.rpc error details.
+func (e *ComputeOperationError) details() []interface{} {
+	var details []interface{}
+	for _, err := range e.Errors {
+		for _, d := range err.ErrorDetails {
+			if ei, ok := d["errorInfo"].(map[string]interface{}); ok {
+				details = append(details, map[string]interface{}{
+					"@type":    "type.googleapis.com/google.rpc.ErrorInfo",
+					"reason":   ei["reason"],
+					"domain":   ei["domain"],
+					"metadata": ei["metadatas"],
+				})
+			}
+			if qi, ok := d["quotaInfo"].(map[string]interface{}); ok {
+				details = append(details, map[string]interface{}{
+					"@type": "type.googleapis.com/google.rpc.QuotaFailure",
+					"violations": []interface{}{map[string]interface{}{
+						"subject":     qi["metricName"],
+						"description": fmt.Sprintf("limit %v of %v exceeded", qi["limit"], qi["limitName"]),
+					}},
+				})
+			}
+		}
+	}
+	return details
+}
+
 // ComputeOperationErrorError is a singular error in a GCE operation.
 type ComputeOperationErrorError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	Code         string                   `json:"code"`
+	Message      string                   `json:"message"`
+	ErrorDetails []map[string]interface{} `json:"errorDetails"`
 }
 
 // Wait waits for an ComputeOperation to complete by fetching the operation until it completes.
 func (op *ComputeOperation) Wait(ctx context.Context, c *dcl.Config, _, _ string) error {
 	c.Logger.Infof("Waiting on operation: %v", op)
 	op.config = c
+	op.start = time.Now()
+	// In an asynchronous call, this hands the operation to its handle and ends the call.
+	if err := dcl.DeferOperation(ctx, computeOperationType, pendingOperationState{Name: op.SelfLink}, op); err != nil {
+		return err
+	}
 
-	err := dcl.Do(ctx, op.operate, c.RetryProvider)
+	err := dcl.Do(ctx, op.operate, c.RetryProviderFor(ctx))
 	c.Logger.Infof("Completed operation: %v", op)
 	return err
 }
 
-func (op *ComputeOperation) handleResponse(resp *dcl.RetryDetails, err error) (*dcl.RetryDetails, error) {
+func (op *ComputeOperation) handleResponse(ctx context.Context, resp *dcl.RetryDetails, err error) (*dcl.RetryDetails, error) {
 	if err != nil {
 		if dcl.IsRetryableRequestError(op.config, err, false, time.Now()) {
 			return nil, dcl.OperationNotDone{}
@@ -88,13 +138,27 @@ func (op *ComputeOperation) handleResponse(resp *dcl.RetryDetails, err error) (*
 	if err := dcl.ParseResponse(resp.Response, op); err != nil {
 		return nil, err
 	}
+	dcl.ReportOperationProgress(ctx, op.config, dcl.OperationProgress{
+		Operation: op.SelfLink,
+		Done:      op.Status == "DONE",
+		Percent:   op.Progress,
+		Stage:     op.Status,
+		Message:   op.StatusMessage,
+		Elapsed:   time.Since(op.start),
+	})
 
 	if op.Status != "DONE" {
 		return nil, dcl.OperationNotDone{}
 	}
 
 	if op.Error != nil {
-		return nil, fmt.Errorf("operation received error: %v", op.Error)
+		return nil, &dcl.OperationError{
+			Operation: op.SelfLink,
+			Code:      op.HTTPErrorStatusCode,
+			Status:    op.Error.status(),
+			Message:   strings.TrimSpace(op.Error.String()),
+			Details:   op.Error.details(),
+		}
 	}
 
 	return resp, nil
@@ -107,7 +171,8 @@ func (op *ComputeOperation) FirstResponse() (map[string]interface{}, bool) {
 }
 
 func (op *ComputeOperation) operate(ctx context.Context) (*dcl.RetryDetails, error) {
-	return op.handleResponse(dcl.SendRequest(ctx, op.config, "GET", op.SelfLink, &bytes.Buffer{}, nil))
+	resp, err := dcl.SendRequest(dcl.ContextWithCallKind(ctx, dcl.CallPoll), op.config, "GET", op.SelfLink, &bytes.Buffer{}, nil)
+	return op.handleResponse(ctx, resp, err)
 }
 
 // ComputeGlobalOrganizationOperation can be parsed from the returned API operation and waited on.
@@ -120,12 +185,14 @@ type ComputeGlobalOrganizationOperation struct {
 func (op *ComputeGlobalOrganizationOperation) Wait(ctx context.Context, c *dcl.Config, parent *string) error {
 	c.Logger.Infof("Waiting on: %v", op)
 	op.BaseOperation.config = c
+	op.BaseOperation.start = time.Now()
 
 	op.Parent = *parent
 
-	return dcl.Do(ctx, op.operate, c.RetryProvider)
+	return dcl.Do(ctx, op.operate, c.RetryProviderFor(ctx))
 }
 
 func (op *ComputeGlobalOrganizationOperation) operate(ctx context.Context) (*dcl.RetryDetails, error) {
-	return op.BaseOperation.handleResponse(dcl.SendRequest(ctx, op.BaseOperation.config, "GET", op.BaseOperation.SelfLink+"?parentId="+op.Parent, &bytes.Buffer{}, nil))
+	resp, err := dcl.SendRequest(dcl.ContextWithCallKind(ctx, dcl.CallPoll), op.BaseOperation.config, "GET", op.BaseOperation.SelfLink+"?parentId="+op.Parent, &bytes.Buffer{}, nil)
+	return op.BaseOperation.handleResponse(ctx, resp, err)
 }
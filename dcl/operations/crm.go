@@ -37,6 +37,7 @@ type CRMOperation struct {
 	basePath string
 	verb     string
 	version  string
+	start    time.Time
 
 	response map[string]interface{}
 }
@@ -46,6 +47,7 @@ type CRMOperationError struct {
 	Code    int                       `json:"code"`
 	Message string                    `json:"message"`
 	Errors  []*CRMOperationErrorError `json:"errors"`
+	Details []interface{}             `json:"details"`
 }
 
 // String formats the CRMOperationError as an error string.
@@ -97,14 +99,19 @@ func (op *CRMOperation) Wait(ctx context.Context, c *dcl.Config, basePath, verb
 		return nil
 	}
 
-	err := dcl.Do(ctx, op.operate, c.RetryProvider)
+	op.start = time.Now()
+	// In an asynchronous call, this hands the operation to its handle and ends the call.
+	if err := dcl.DeferOperation(ctx, crmOperationType, pendingOperationState{Name: op.Name, BasePath: basePath, Verb: verb, Version: op.version}, op); err != nil {
+		return err
+	}
+	err := dcl.Do(ctx, op.operate, c.RetryProviderFor(ctx))
 	c.Logger.Infof("Completed operation: %v", op)
 	return err
 }
 
 func (op *CRMOperation) operate(ctx context.Context) (*dcl.RetryDetails, error) {
 	u := dcl.URL(op.version+"/"+op.Name, op.basePath, op.config.BasePath, nil)
-	resp, err := dcl.SendRequest(ctx, op.config, op.verb, u, &bytes.Buffer{}, nil)
+	resp, err := dcl.SendRequest(dcl.ContextWithCallKind(ctx, dcl.CallPoll), op.config, op.verb, u, &bytes.Buffer{}, nil)
 	if err != nil {
 		if dcl.IsRetryableRequestError(op.config, err, false, time.Now()) {
 			return nil, dcl.OperationNotDone{}
@@ -115,13 +122,14 @@ func (op *CRMOperation) operate(ctx context.Context) (*dcl.RetryDetails, error)
 	if err := dcl.ParseResponse(resp.Response, op); err != nil {
 		return nil, err
 	}
+	reportProgress(ctx, op.config, op.start, op.Name, op.Done, op.Metadata)
 
 	if !op.Done {
 		return nil, dcl.OperationNotDone{}
 	}
 
 	if op.Error != nil {
-		return nil, fmt.Errorf("operation received error: %+v", op.Error)
+		return nil, dcl.NewRPCOperationError(op.Name, op.Error.Code, strings.TrimSpace(op.Error.String()), op.Error.Details)
 	}
 
 	if len(op.response) == 0 && len(op.Response) > 0 {
@@ -16,7 +16,6 @@ package operations
 import (
 	"bytes"
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
@@ -29,31 +28,77 @@ type DatastoreOperation struct {
 	Metadata *DatastoreOperationMetadata `json:"metadata"`
 	Error    *DatastoreOperationError    `json:"error"`
 	config   *dcl.Config
+	start    time.Time
 }
 
-// DatastoreOperationMetadata is an error in a datastore operation.
+// DatastoreOperationMetadata is the metadata of a datastore index operation.
 type DatastoreOperationMetadata struct {
-	IndexID string `json:"indexId"`
+	IndexID          string                            `json:"indexId"`
+	Common           *DatastoreOperationCommonMetadata `json:"common"`
+	ProgressEntities *DatastoreOperationProgress       `json:"progressEntities"`
+	ProgressBytes    *DatastoreOperationProgress       `json:"progressBytes"`
+}
+
+// DatastoreOperationCommonMetadata is the metadata common to all datastore operations.
+type DatastoreOperationCommonMetadata struct {
+	StartTime     string `json:"startTime"`
+	EndTime       string `json:"endTime"`
+	OperationType string `json:"operationType"`
+	State         string `json:"state"`
+}
+
+// DatastoreOperationProgress measures the work done by a datastore operation.
+type DatastoreOperationProgress struct {
+	WorkCompleted int64 `json:"workCompleted,string"`
+	WorkEstimated int64 `json:"workEstimated,string"`
+}
+
+// progress returns the metadata reported as the progress of the operation. Index builds
+// report the number of entities indexed so far, from which the percentage is derived.
+func (md *DatastoreOperationMetadata) progress() map[string]interface{} {
+	if md == nil {
+		return nil
+	}
+	m := map[string]interface{}{
+		"indexId": md.IndexID,
+	}
+	if md.Common != nil {
+		m["state"] = md.Common.State
+		m["operationType"] = md.Common.OperationType
+		m["startTime"] = md.Common.StartTime
+	}
+	if p := md.ProgressEntities; p != nil {
+		m["progressEntities"] = map[string]interface{}{
+			"workCompleted": p.WorkCompleted,
+			"workEstimated": p.WorkEstimated,
+		}
+		if p.WorkEstimated > 0 {
+			m["progressPercent"] = float64(p.WorkCompleted) * 100 / float64(p.WorkEstimated)
+		}
+	}
+	return m
 }
 
 // DatastoreOperationError is an error in a datastore operation.
 type DatastoreOperationError struct {
-	Code    int64  `json:"code"`
-	Message string `json:"message"`
+	Code    int64         `json:"code"`
+	Message string        `json:"message"`
+	Details []interface{} `json:"details"`
 }
 
 // Wait waits for an DatastoreOperation to complete by fetching the operation until it completes.
 func (op *DatastoreOperation) Wait(ctx context.Context, c *dcl.Config, _, _ string) error {
 	c.Logger.Infof("Waiting on operation: %v", op)
 	op.config = c
-	err := dcl.Do(ctx, op.operate, c.RetryProvider)
+	op.start = time.Now()
+	err := dcl.Do(ctx, op.operate, c.RetryProviderFor(ctx))
 	c.Logger.Infof("Completed operation: %v", op)
 	return err
 }
 
 func (op *DatastoreOperation) operate(ctx context.Context) (*dcl.RetryDetails, error) {
 	u := dcl.URL(op.Name, "https://datastore.googleapis.com/v1/", op.config.BasePath, nil)
-	resp, err := dcl.SendRequest(ctx, op.config, "GET", u, &bytes.Buffer{}, nil)
+	resp, err := dcl.SendRequest(dcl.ContextWithCallKind(ctx, dcl.CallPoll), op.config, "GET", u, &bytes.Buffer{}, nil)
 	if err != nil {
 		if dcl.IsRetryableRequestError(op.config, err, true, time.Now()) {
 			return nil, dcl.OperationNotDone{}
@@ -63,11 +108,12 @@ func (op *DatastoreOperation) operate(ctx context.Context) (*dcl.RetryDetails, e
 	if err := dcl.ParseResponse(resp.Response, op); err != nil {
 		return nil, err
 	}
+	reportProgress(ctx, op.config, op.start, op.Name, op.Done, op.Metadata.progress())
 	if !op.Done {
 		return nil, dcl.OperationNotDone{}
 	}
 	if op.Error != nil {
-		return nil, fmt.Errorf("operation received error: %+v", op.Error)
+		return nil, dcl.NewRPCOperationError(op.Name, int(op.Error.Code), op.Error.Message, op.Error.Details)
 	}
 	return resp, nil
 }
@@ -75,6 +121,9 @@ func (op *DatastoreOperation) operate(ctx context.Context) (*dcl.RetryDetails, e
 // FirstResponse returns the first response that this operation receives with the resource.
 // This response may contain special information.
 func (op *DatastoreOperation) FirstResponse() (map[string]interface{}, bool) {
+	if op.Metadata == nil {
+		return nil, false
+	}
 	return map[string]interface{}{
 		"indexId": op.Metadata.IndexID,
 	}, false
@@ -16,7 +16,6 @@ package operations
 import (
 	"bytes"
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
@@ -33,6 +32,7 @@ type DNSOperation struct {
 	// other irrelevant fields omitted
 
 	config *dcl.Config
+	start  time.Time
 }
 
 // Wait waits for an DNSOperation to complete by fetching the operation until it completes.
@@ -41,15 +41,21 @@ func (op *DNSOperation) Wait(ctx context.Context, c *dcl.Config, project, manage
 	op.config = c
 	op.ManagedZone = managedZone
 	op.Project = project
+	op.start = time.Now()
 
-	err := dcl.Do(ctx, op.operate, c.RetryProvider)
+	err := dcl.Do(ctx, op.operate, c.RetryProviderFor(ctx))
 	c.Logger.Infof("Completed operation: %v", op)
 	return err
 }
 
 func (op *DNSOperation) operate(ctx context.Context) (*dcl.RetryDetails, error) {
-	u := fmt.Sprintf("https://dns.googleapis.com/dns/v1/projects/%s/managedZones/%s/changes/%s", op.Project, op.ManagedZone, op.ID)
-	resp, err := dcl.SendRequest(ctx, op.config, "GET", u, &bytes.Buffer{}, nil)
+	params := map[string]interface{}{
+		"project":     op.Project,
+		"managedZone": op.ManagedZone,
+		"id":          op.ID,
+	}
+	u := dcl.URL("projects/{{project}}/managedZones/{{managedZone}}/changes/{{id}}", "https://dns.googleapis.com/dns/v1/", op.config.BasePath, params)
+	resp, err := dcl.SendRequest(dcl.ContextWithCallKind(ctx, dcl.CallPoll), op.config, "GET", u, &bytes.Buffer{}, nil)
 	if err != nil {
 		if dcl.IsRetryableRequestError(op.config, err, false, time.Now()) {
 			return nil, dcl.OperationNotDone{}
@@ -59,6 +65,13 @@ func (op *DNSOperation) operate(ctx context.Context) (*dcl.RetryDetails, error)
 	if err := dcl.ParseResponse(resp.Response, op); err != nil {
 		return nil, err
 	}
+	dcl.ReportOperationProgress(ctx, op.config, dcl.OperationProgress{
+		Operation: op.ID,
+		Done:      op.Status == "done",
+		Percent:   -1,
+		Stage:     op.Status,
+		Elapsed:   time.Since(op.start),
+	})
 	if op.Status != "done" {
 		return nil, dcl.OperationNotDone{}
 	}
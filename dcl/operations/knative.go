@@ -64,14 +64,14 @@ func (op *KNativeOperation) Wait(ctx context.Context, c *dcl.Config, basePath, v
 	}
 	op.location = location
 
-	err := dcl.Do(ctx, op.operate, c.RetryProvider)
+	err := dcl.Do(ctx, op.operate, c.RetryProviderFor(ctx))
 	c.Logger.Infof("Completed operation: %v", op)
 	return err
 }
 
 func (op *KNativeOperation) operate(ctx context.Context) (*dcl.RetryDetails, error) {
 	u := fmt.Sprintf("https://%s-run.googleapis.com/%s", op.location, op.Metadata.SelfLink)
-	resp, err := dcl.SendRequest(ctx, op.config, "GET", u, &bytes.Buffer{}, nil)
+	resp, err := dcl.SendRequest(dcl.ContextWithCallKind(ctx, dcl.CallPoll), op.config, "GET", u, &bytes.Buffer{}, nil)
 	if err != nil {
 		if dcl.IsRetryableRequestError(op.config, err, false, time.Now()) {
 			return nil, dcl.OperationNotDone{}
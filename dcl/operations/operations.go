@@ -31,11 +31,13 @@ type StandardGCPOperation struct {
 	Error    *StandardGCPOperationError `json:"error"`
 	Done     bool                       `json:"done"`
 	Response map[string]interface{}     `json:"response"`
+	Metadata map[string]interface{}     `json:"metadata"`
 	// other irrelevant fields omitted
 
 	config   *dcl.Config
 	basePath string
 	verb     string
+	start    time.Time
 
 	response map[string]interface{}
 }
@@ -64,6 +66,18 @@ func (e *StandardGCPOperationError) String() string {
 	return b.String()
 }
 
+// operationError returns the error of an operation named name which failed with e.
+func (e *StandardGCPOperationError) operationError(name string) *dcl.OperationError {
+	code, _ := e.Code.Int64()
+	var details []interface{}
+	for _, err := range append(e.Errors, &e.StandardGCPOperationErrorError) {
+		for _, d := range err.Details {
+			details = append(details, d)
+		}
+	}
+	return dcl.NewRPCOperationError(name, int(code), strings.TrimSpace(e.String()), details)
+}
+
 // StandardGCPOperationErrorError is a singular error in a GCP operation.
 type StandardGCPOperationErrorError struct {
 	Code    json.Number              `json:"code"`
@@ -86,14 +100,19 @@ func (op *StandardGCPOperation) Wait(ctx context.Context, c *dcl.Config, basePat
 		return nil
 	}
 
-	err := dcl.Do(ctx, op.operate, c.RetryProvider)
+	op.start = time.Now()
+	// In an asynchronous call, this hands the operation to its handle and ends the call.
+	if err := dcl.DeferOperation(ctx, standardGCPOperationType, pendingOperationState{Name: op.Name, BasePath: basePath, Verb: verb}, op); err != nil {
+		return err
+	}
+	err := dcl.Do(ctx, op.operate, c.RetryProviderFor(ctx))
 	c.Logger.Infof("Completed operation: %v", op)
 	return err
 }
 
 func (op *StandardGCPOperation) operate(ctx context.Context) (*dcl.RetryDetails, error) {
 	u := dcl.URL(op.Name, op.basePath, op.config.BasePath, nil)
-	resp, err := dcl.SendRequest(ctx, op.config, op.verb, u, &bytes.Buffer{}, nil)
+	resp, err := dcl.SendRequest(dcl.ContextWithCallKind(ctx, dcl.CallPoll), op.config, op.verb, u, &bytes.Buffer{}, nil)
 	if err != nil {
 		// Since we don't know when this operation started, we will assume the
 		// context's timeout applies to all request errors.
@@ -106,13 +125,14 @@ func (op *StandardGCPOperation) operate(ctx context.Context) (*dcl.RetryDetails,
 	if err := dcl.ParseResponse(resp.Response, op); err != nil {
 		return nil, err
 	}
+	reportProgress(ctx, op.config, op.start, op.Name, op.Done, op.Metadata)
 
 	if !op.Done {
 		return nil, dcl.OperationNotDone{}
 	}
 
 	if op.Error != nil {
-		return nil, fmt.Errorf("operation received error: %+v details: %v", op.Error, op.Response)
+		return nil, op.Error.operationError(op.Name)
 	}
 
 	if len(op.response) == 0 && len(op.Response) != 0 {
@@ -32,12 +32,12 @@ func (op *OSPolicyAssignmentDeleteOperation) Wait(ctx context.Context, c *dcl.Co
 	c.Logger.Infof("Waiting on: %q", op.Name)
 	op.config = c
 
-	return dcl.Do(ctx, op.operate, c.RetryProvider)
+	return dcl.Do(ctx, op.operate, c.RetryProviderFor(ctx))
 }
 
 func (op *OSPolicyAssignmentDeleteOperation) operate(ctx context.Context) (*dcl.RetryDetails, error) {
 	u := dcl.URL(op.Name, "https://osconfig.googleapis.com/v1alpha", op.config.BasePath, nil)
-	resp, err := dcl.SendRequest(ctx, op.config, "GET", u, &bytes.Buffer{}, nil)
+	resp, err := dcl.SendRequest(dcl.ContextWithCallKind(ctx, dcl.CallPoll), op.config, "GET", u, &bytes.Buffer{}, nil)
 	if dcl.IsNotFound(err) {
 		return nil, nil
 	}
@@ -0,0 +1,104 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+// Fields of google.longrunning.Operation metadata which report progress. Each API has its
+// own metadata type, so the names used by the common ones are checked in order.
+var (
+	percentFields = []string{"progressPercent", "progressPercentage", "percentComplete", "percentDone", "progress"}
+	stageFields   = []string{"stage", "currentStage", "state", "status"}
+	messageFields = []string{"statusMessage", "statusDetail", "details", "description"}
+)
+
+// metadataProgress returns the progress percentage, stage, and status message reported in
+// the metadata of an operation. The percentage is -1 if the metadata does not report one.
+func metadataProgress(md map[string]interface{}) (percent int, stage, message string) {
+	percent = -1
+	for _, f := range percentFields {
+		if p, ok := progressPercent(md[f]); ok {
+			percent = p
+			break
+		}
+	}
+	for _, f := range stageFields {
+		if s, ok := md[f].(string); ok && s != "" {
+			stage = s
+			break
+		}
+	}
+	for _, f := range messageFields {
+		if s, ok := md[f].(string); ok && s != "" {
+			message = s
+			break
+		}
+	}
+	// Some APIs, e.g. Dataproc, report the current state in a nested status message.
+	if status, ok := md["status"].(map[string]interface{}); ok {
+		p, s, m := metadataProgress(status)
+		if percent < 0 {
+			percent = p
+		}
+		if stage == "" {
+			stage = s
+		}
+		if message == "" {
+			message = m
+		}
+	}
+	if progress, ok := md["progress"].(map[string]interface{}); ok && percent < 0 {
+		percent, _, _ = metadataProgress(progress)
+	}
+	return percent, stage, message
+}
+
+func progressPercent(v interface{}) (int, bool) {
+	switch p := v.(type) {
+	case float64:
+		return int(p), true
+	case json.Number:
+		f, err := p.Float64()
+		return int(f), err == nil
+	case string:
+		f, err := strconv.ParseFloat(p, 64)
+		return int(f), err == nil
+	}
+	return 0, false
+}
+
+// reportProgress reports the state of the operation named name, which the DCL started
+// waiting on at start, if anything is listening for progress.
+func reportProgress(ctx context.Context, c *dcl.Config, start time.Time, name string, done bool, metadata map[string]interface{}) {
+	if !dcl.HasOperationProgress(ctx, c) {
+		return
+	}
+	percent, stage, message := metadataProgress(metadata)
+	dcl.ReportOperationProgress(ctx, c, dcl.OperationProgress{
+		Operation: name,
+		Done:      done,
+		Percent:   percent,
+		Stage:     stage,
+		Message:   message,
+		Metadata:  metadata,
+		Elapsed:   time.Since(start),
+	})
+}
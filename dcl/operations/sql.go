@@ -16,6 +16,8 @@ package operations
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	glog "github.com/golang/glog"
@@ -24,27 +26,76 @@ import (
 
 // SQLOperation can be parsed from the returned API operation and waited on.
 type SQLOperation struct {
-	ID         string `json:"id"`
-	SelfLink   string `json:"selfLink"`
-	Status     string `json:"status"`
-	TargetLink string `json:"targetLink"`
+	ID         string             `json:"id"`
+	SelfLink   string             `json:"selfLink"`
+	Status     string             `json:"status"`
+	TargetLink string             `json:"targetLink"`
+	Error      *SQLOperationError `json:"error"`
 	// other irrelevant fields omitted
 
 	config *dcl.Config
+	start  time.Time
+}
+
+// SQLOperationError is the Cloud SQL operation's Error body.
+type SQLOperationError struct {
+	Errors []*SQLOperationErrorError `json:"errors"`
+}
+
+// String formats the SQLOperationError as an error string.
+func (e *SQLOperationError) String() string {
+	if e == nil {
+		return "nil"
+	}
+	var b strings.Builder
+	for _, err := range e.Errors {
+		fmt.Fprintf(&b, "error code %q, message: %s\n", err.Code, err.Message)
+	}
+	return b.String()
+}
+
+// sqlErrorStatuses maps the error codes of Cloud SQL operations to the names of the
+// equivalent google.rpc.Code values. Cloud SQL also reports some errors with the names
+// themselves, which need no mapping.
+var sqlErrorStatuses = map[string]string{
+	"INTERNAL_ERROR": "INTERNAL",
+	"ERROR_RDBMS":    "FAILED_PRECONDITION",
+}
+
+// status returns the canonical status of the first error in e, e.g. "INTERNAL" for
+// "INTERNAL_ERROR", or its Cloud SQL code if it has no canonical equivalent.
+func (e *SQLOperationError) status() string {
+	for _, err := range e.Errors {
+		if err.Code == "" {
+			continue
+		}
+		if s, ok := sqlErrorStatuses[err.Code]; ok {
+			return s
+		}
+		return err.Code
+	}
+	return ""
+}
+
+// SQLOperationErrorError is a singular error in a Cloud SQL operation.
+type SQLOperationErrorError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
 // Wait waits for an Operation to complete by fetching the operation until it completes.
 func (op *SQLOperation) Wait(ctx context.Context, c *dcl.Config, _, _ string) error {
 	glog.Infof("Waiting on operation: %v", op)
 	op.config = c
+	op.start = time.Now()
 
-	err := dcl.Do(ctx, op.operate, c.RetryProvider)
+	err := dcl.Do(ctx, op.operate, c.RetryProviderFor(ctx))
 	c.Logger.Infof("Completed operation: %v", op)
 	return err
 }
 
 func (op *SQLOperation) operate(ctx context.Context) (*dcl.RetryDetails, error) {
-	resp, err := dcl.SendRequest(ctx, op.config, "GET", op.SelfLink, &bytes.Buffer{}, nil)
+	resp, err := dcl.SendRequest(dcl.ContextWithCallKind(ctx, dcl.CallPoll), op.config, "GET", op.SelfLink, &bytes.Buffer{}, nil)
 	if err != nil {
 		if dcl.IsRetryableRequestError(op.config, err, true, time.Now()) {
 			return nil, dcl.OperationNotDone{}
@@ -54,9 +105,19 @@ func (op *SQLOperation) operate(ctx context.Context) (*dcl.RetryDetails, error)
 	if err := dcl.ParseResponse(resp.Response, op); err != nil {
 		return nil, err
 	}
+	dcl.ReportOperationProgress(ctx, op.config, dcl.OperationProgress{
+		Operation: op.SelfLink,
+		Done:      op.Status == "DONE",
+		Percent:   -1,
+		Stage:     op.Status,
+		Elapsed:   time.Since(op.start),
+	})
 	if op.Status != "DONE" {
 		return nil, dcl.OperationNotDone{}
 	}
+	if op.Error != nil && len(op.Error.Errors) > 0 {
+		return nil, dcl.NewStatusOperationError(op.SelfLink, op.Error.status(), strings.TrimSpace(op.Error.String()))
+	}
 	return resp, nil
 }
 
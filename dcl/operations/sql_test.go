@@ -0,0 +1,89 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package operations
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func TestSQLOperationError(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus string
+		wantCode   int
+	}{
+		{
+			name:       "Cloud SQL code",
+			body:       `{"status":"DONE","error":{"errors":[{"code":"INTERNAL_ERROR","message":"boom"}]}}`,
+			wantStatus: "INTERNAL",
+			wantCode:   500,
+		},
+		{
+			name:       "database error",
+			body:       `{"status":"DONE","error":{"errors":[{"code":"ERROR_RDBMS","message":"role does not exist"}]}}`,
+			wantStatus: "FAILED_PRECONDITION",
+			wantCode:   400,
+		},
+		{
+			name:       "canonical code",
+			body:       `{"status":"DONE","error":{"errors":[{"code":"ALREADY_EXISTS","message":"exists"}]}}`,
+			wantStatus: "ALREADY_EXISTS",
+			wantCode:   409,
+		},
+		{
+			name:       "first error with a code",
+			body:       `{"status":"DONE","error":{"errors":[{"message":"no code"},{"code":"NOT_FOUND","message":"missing"}]}}`,
+			wantStatus: "NOT_FOUND",
+			wantCode:   404,
+		},
+		{
+			name:       "unknown code",
+			body:       `{"status":"DONE","error":{"errors":[{"code":"SOMETHING_NEW","message":"new"}]}}`,
+			wantStatus: "SOMETHING_NEW",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+			op := &SQLOperation{
+				SelfLink: srv.URL + "/operations/op",
+				config:   dcl.NewConfig(dcl.WithHTTPClient(srv.Client())),
+				start:    time.Now(),
+			}
+			_, err := op.operate(context.Background())
+			var oerr *dcl.OperationError
+			if !errors.As(err, &oerr) {
+				t.Fatalf("operate() error = %v, want an OperationError", err)
+			}
+			if oerr.Status != tc.wantStatus {
+				t.Errorf("operate() error status = %q, want %q", oerr.Status, tc.wantStatus)
+			}
+			if got := dcl.ErrorCode(err); got != tc.wantCode {
+				t.Errorf("ErrorCode() = %d, want %d", got, tc.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PolicyValidator checks a desired resource against organization rules before it is
+// applied. Validators run after the resource passes its own validation and before any
+// request is sent.
+type PolicyValidator interface {
+	// Validate returns the rules violated by r. u is the unstructured form of r: a map
+	// from the JSON names of its fields to their values, with unset fields omitted.
+	Validate(ctx context.Context, r Resource, u map[string]interface{}) ([]PolicyViolation, error)
+}
+
+// PolicyValidatorFunc is a function which implements PolicyValidator.
+type PolicyValidatorFunc func(ctx context.Context, r Resource, u map[string]interface{}) ([]PolicyViolation, error)
+
+// Validate calls f.
+func (f PolicyValidatorFunc) Validate(ctx context.Context, r Resource, u map[string]interface{}) ([]PolicyViolation, error) {
+	return f(ctx, r, u)
+}
+
+// PolicyViolation is a rule violated by a desired resource.
+type PolicyViolation struct {
+	// Rule is the name of the violated rule.
+	Rule string
+	// FieldPath is the path of the offending field, e.g. "labels.cost-center", or empty
+	// if the rule applies to the resource as a whole.
+	FieldPath string
+	// Message describes the violation.
+	Message string
+}
+
+func (v PolicyViolation) String() string {
+	var b strings.Builder
+	b.WriteString(v.Rule)
+	if v.FieldPath != "" {
+		fmt.Fprintf(&b, " (%s)", v.FieldPath)
+	}
+	if v.Message != "" {
+		fmt.Fprintf(&b, ": %s", v.Message)
+	}
+	return b.String()
+}
+
+// PolicyViolationError is returned by Apply when the desired resource violates the rules
+// of a PolicyValidator. No request is sent for the resource.
+type PolicyViolationError struct {
+	Resource   ServiceTypeVersion
+	Violations []PolicyViolation
+}
+
+func (e PolicyViolationError) Error() string {
+	v := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		v[i] = violation.String()
+	}
+	return fmt.Sprintf("%s.%s violates policy: %s", e.Resource.Service, e.Resource.Type, strings.Join(v, "; "))
+}
+
+// WithPolicyValidators adds validators which every resource applied with a Config must
+// pass.
+func WithPolicyValidators(v ...PolicyValidator) ConfigOption {
+	return func(c *Config) {
+		c.policyValidators = append(append([]PolicyValidator(nil), c.policyValidators...), v...)
+	}
+}
+
+type policyValidatorsOption struct {
+	validators []PolicyValidator
+}
+
+func (p policyValidatorsOption) Apply(o *ApplyOpts) {
+	o.policyValidators = append(o.policyValidators, p.validators...)
+}
+
+// WithApplyPolicyValidators adds validators which the applied resource must pass, in
+// addition to those of the Config.
+func WithApplyPolicyValidators(v ...PolicyValidator) ApplyOption {
+	return policyValidatorsOption{validators: v}
+}
+
+// FetchPolicyValidators returns the validators added to an apply with WithApplyPolicyValidators.
+func FetchPolicyValidators(c []ApplyOption) []PolicyValidator {
+	var o ApplyOpts
+	for _, p := range c {
+		p.Apply(&o)
+	}
+	return o.policyValidators
+}
+
+// ValidatePolicies runs the policy validators of c and opts against r. It returns a
+// PolicyViolationError if r violates any of their rules.
+func ValidatePolicies(ctx context.Context, c *Config, r Resource, opts []ApplyOption) error {
+	validators := append(append([]PolicyValidator(nil), c.policyValidators...), FetchPolicyValidators(opts)...)
+	if len(validators) == 0 {
+		return nil
+	}
+	u, err := unstructuredForm(r)
+	if err != nil {
+		return fmt.Errorf("failed to convert %s.%s for policy validation: %w", r.Describe().Service, r.Describe().Type, err)
+	}
+	var violations []PolicyViolation
+	for _, v := range validators {
+		vs, err := v.Validate(ctx, r, u)
+		if err != nil {
+			return fmt.Errorf("policy validation failed: %w", err)
+		}
+		violations = append(violations, vs...)
+	}
+	if len(violations) > 0 {
+		return PolicyViolationError{Resource: r.Describe(), Violations: violations}
+	}
+	return nil
+}
+
+// unstructuredForm returns the JSON representation of r as a map, omitting unset fields.
+// Whole numbers are returned as int64 and all other numbers as float64.
+func unstructuredForm(r Resource) (map[string]interface{}, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var m map[string]interface{}
+	if err := d.Decode(&m); err != nil {
+		return nil, err
+	}
+	u, _ := pruneUnstructured(m).(map[string]interface{})
+	if u == nil {
+		u = map[string]interface{}{}
+	}
+	return u, nil
+}
+
+// pruneUnstructured removes null values from v and converts its json.Numbers.
+func pruneUnstructured(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if e == nil {
+				delete(v, k)
+				continue
+			}
+			v[k] = pruneUnstructured(e)
+		}
+		return v
+	case []interface{}:
+		for i, e := range v {
+			v[i] = pruneUnstructured(e)
+		}
+		return v
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return v
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dcl
+
+import (
+	"context"
+	"time"
+)
+
+const operationProgressKey ReqCtxKey = "OperationProgressKey"
+
+// OperationProgress is the state of a long-running operation after it has been polled.
+type OperationProgress struct {
+	// Operation is the name or self link of the operation.
+	Operation string
+	// Resource is the resource the operation was started for, or the zero value if it is unknown.
+	Resource ServiceTypeVersion
+	// Done is true if the operation has finished, successfully or not.
+	Done bool
+	// Percent is the progress of the operation from 0 to 100, or -1 if the API does not report it.
+	Percent int
+	// Stage is the API's name for the current step or status of the operation, e.g. "RUNNING".
+	Stage string
+	// Message is the API's description of the current status of the operation.
+	Message string
+	// Metadata is the metadata of the operation as decoded from JSON, if it has any.
+	Metadata map[string]interface{}
+	// Elapsed is the time since the DCL started waiting on the operation.
+	Elapsed time.Duration
+}
+
+// OperationProgressFunc is called with the state of a long-running operation every time
+// it is polled. It is called synchronously between polls, so it should return quickly.
+type OperationProgressFunc func(ctx context.Context, p OperationProgress)
+
+// WithOperationProgress adds functions which are called every time a long-running
+// operation started with a Config is polled.
+func WithOperationProgress(f ...OperationProgressFunc) ConfigOption {
+	return func(c *Config) {
+		c.progressFuncs = append(append([]OperationProgressFunc(nil), c.progressFuncs...), f...)
+	}
+}
+
+// ContextWithOperationProgress returns a context whose long-running operations call f
+// every time they are polled, in addition to the functions of their Config.
+func ContextWithOperationProgress(ctx context.Context, f OperationProgressFunc) context.Context {
+	fs, _ := ctx.Value(operationProgressKey).([]OperationProgressFunc)
+	return context.WithValue(ctx, operationProgressKey, append(append([]OperationProgressFunc(nil), fs...), f))
+}
+
+// HasOperationProgress returns true if polls of long-running operations made with c and
+// ctx are reported to any function.
+func HasOperationProgress(ctx context.Context, c *Config) bool {
+	if len(c.progressFuncs) > 0 {
+		return true
+	}
+	fs, _ := ctx.Value(operationProgressKey).([]OperationProgressFunc)
+	return len(fs) > 0
+}
+
+// ReportOperationProgress calls the progress functions of c and then those of ctx with p.
+// The resource of p is filled in from ctx if it is not set.
+func ReportOperationProgress(ctx context.Context, c *Config, p OperationProgress) {
+	if p.Resource == (ServiceTypeVersion{}) {
+		p.Resource, _ = ServiceTypeVersionFromContext(ctx)
+	}
+	fs, _ := ctx.Value(operationProgressKey).([]OperationProgressFunc)
+	for _, f := range append(append([]OperationProgressFunc(nil), c.progressFuncs...), fs...) {
+		f(ctx, p)
+	}
+}
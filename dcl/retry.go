@@ -110,17 +110,32 @@ func (n *Backoff) RetryAfter(_ *RetryDetails) time.Duration {
 }
 
 // BackoffRetryProvider is a default RetryProvider that returns a Backoff.
-type BackoffRetryProvider struct{}
+type BackoffRetryProvider struct {
+	// InitialInterval and MaxInterval override the intervals of the Backoff if set.
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+}
 
 // New returns an initialized Retry.
 func (r *BackoffRetryProvider) New() Retry {
-	return NewBackoff()
+	if r.InitialInterval == 0 && r.MaxInterval == 0 {
+		return NewBackoff()
+	}
+	initial, max := BackoffInitialInterval, BackoffMaxInterval
+	if r.InitialInterval > 0 {
+		initial = r.InitialInterval
+	}
+	if r.MaxInterval > 0 {
+		max = r.MaxInterval
+	}
+	return NewBackoffWithOptions(initial, max)
 }
 
 // Do performs op as a retryable operation, using retry to determine when and if to retry.
 // Do will only continue if a OperationNotDone{} is returned. If op() returns another error
 // or no error, Do will finish.
 // OperationNotDone{} may have an error inside of it, indicating that it's a retryable error.
+// If ctx is done before op finishes, Do returns ctx.Err().
 func Do(ctx context.Context, op Operation, retryProvider RetryProvider) error {
 	retry := retryProvider.New()
 	for {
@@ -139,13 +154,17 @@ func Do(ctx context.Context, op Operation, retryProvider RetryProvider) error {
 			}
 			return OperationNotDone{}
 		}
+		if r, ok := asyncRunFromContext(ctx); ok && r.replaying() {
+			// A resumed asynchronous call has already waited for what it replays.
+			continue
+		}
 
 		t := time.NewTimer(w)
 		select {
 		case <-ctx.Done():
 			t.Stop()
 			glog.Info("retryable operation canceled by context")
-			return OperationNotDone{}
+			return ctx.Err()
 		case <-t.C:
 		}
 	}
@@ -26,9 +26,6 @@ import (
 	"time"
 
 	"google.golang.org/api/googleapi"
-	"google.golang.org/api/option"
-	"google.golang.org/api/option/internaloption"
-	apihttp "google.golang.org/api/transport/http"
 )
 
 // SendRequest applies the credentials in the provided Config to a request with the specified
@@ -37,6 +34,15 @@ import (
 // optional; if supplied HTTP errors that are deemed temporary will be retried according
 // to the policy implemented by the retry.
 func SendRequest(ctx context.Context, c *Config, verb, url string, body *bytes.Buffer, retryProvider RetryProvider) (*RetryDetails, error) {
+	if r, ok := asyncRunFromContext(ctx); ok {
+		return r.send(ctx, verb, url, func() (*RetryDetails, error) {
+			return sendRequest(ctx, c, verb, url, body, retryProvider)
+		})
+	}
+	return sendRequest(ctx, c, verb, url, body, retryProvider)
+}
+
+func sendRequest(ctx context.Context, c *Config, verb, url string, body *bytes.Buffer, retryProvider RetryProvider) (*RetryDetails, error) {
 	hdrs := http.Header{}
 	for h, v := range c.header {
 		for _, s := range v {
@@ -46,7 +52,11 @@ func SendRequest(ctx context.Context, c *Config, verb, url string, body *bytes.B
 	hdrs.Set("User-Agent", c.UserAgent())
 	hdrs.Set("Content-Type", c.contentType)
 
-	u, err := AddQueryParams(url, c.queryParams)
+	u, overridden, err := c.resolveEndpoint(url)
+	if err != nil {
+		return nil, err
+	}
+	u, err = AddQueryParams(u, c.queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -56,41 +66,19 @@ func SendRequest(ctx context.Context, c *Config, verb, url string, body *bytes.B
 		hdrs.Set("X-Goog-User-Project", billingProject)
 	}
 
-	mtls, err := GetMTLSEndpoint(u)
-	if err != nil {
-		return nil, err
-	}
-
-	options := []option.ClientOption{
-		option.WithScopes(Scopes...),
-		internaloption.WithDefaultEndpoint(u),
-		internaloption.WithDefaultMTLSEndpoint(mtls),
-	}
-	for _, o := range c.clientOptions {
-		options = append(options, o)
+	// Endpoints set by the user are used as they are, whatever the mTLS mode.
+	mtls := u
+	if !overridden {
+		mtls, err = GetMTLSEndpoint(u)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	httpClient, endpoint, err := apihttp.NewClient(ctx, options...)
+	httpClient, u, err := c.httpClient(ctx, u, mtls)
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		u = endpoint
-	}
-
-	if _, ok := httpClient.Transport.(loggingTransport); !ok {
-		// In cases where the config has been created using WithHTTPClient() we want to
-		// replace the default transport with our logging transport only once.
-		httpClient = &http.Client{
-			Transport: loggingTransport{
-				underlyingTransport: httpClient.Transport,
-				logger:              c.Logger,
-			},
-			CheckRedirect: httpClient.CheckRedirect,
-			Jar:           httpClient.Jar,
-			Timeout:       httpClient.Timeout,
-		}
-	}
 
 	if body == nil {
 		// A nil value indicates an empty request body.
@@ -102,10 +90,11 @@ func SendRequest(ctx context.Context, c *Config, verb, url string, body *bytes.B
 		return nil, err
 	}
 	req.Header = hdrs
+	do := c.invoker(ctx, verb, httpClient)
 
 	var res *http.Response
 	if retryProvider == nil {
-		res, err = httpClient.Do(req)
+		res, err = do(req)
 		if err != nil {
 			return nil, err
 		}
@@ -119,12 +108,16 @@ func SendRequest(ctx context.Context, c *Config, verb, url string, body *bytes.B
 		return &RetryDetails{Request: req, Response: res}, nil
 	}
 
+	// Requests which are retried use the retry policy of the resource they are made for, if it has one.
+	if rp, ok := c.resourceRetryProvider(ctx); ok {
+		retryProvider = rp
+	}
 	// The start time of request retries is used to determine if an HTTP error is still retryable.
 	start := time.Now()
 	err = Do(ctx, func(ctx context.Context) (*RetryDetails, error) {
 		// Reset req body before http call.
 		req.Body = ioutil.NopCloser(bytes.NewReader(bodyBytes))
-		res, err = httpClient.Do(req)
+		res, err = do(req)
 		if err != nil {
 			return nil, err
 		}
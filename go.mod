@@ -6,15 +6,19 @@ require (
 	bitbucket.org/creachadair/stringset v0.0.8
 	github.com/cenkalti/backoff v2.2.1+incompatible
 	github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b
+	github.com/google/cel-go v0.6.0
 	github.com/google/go-cmp v0.5.8
 	github.com/google/go-cpy v0.0.0-20211218193943-a9c933c06932
 	github.com/kylelemons/godebug v1.1.0
 	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
 	google.golang.org/api v0.29.0
+	google.golang.org/protobuf v1.25.0
+	gopkg.in/yaml.v2 v2.4.0
 )
 
 require (
 	cloud.google.com/go v0.61.0 // indirect
+	github.com/antlr/antlr4 v0.0.0-20200503195918-621b933c7a7f // indirect
 	github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e // indirect
 	github.com/golang/protobuf v1.4.2 // indirect
 	go.opencensus.io v0.22.4 // indirect
@@ -24,5 +28,4 @@ require (
 	google.golang.org/appengine v1.6.6 // indirect
 	google.golang.org/genproto v0.0.0-20200711021454-869866162049 // indirect
 	google.golang.org/grpc v1.32.0 // indirect
-	google.golang.org/protobuf v1.25.0 // indirect
 )
@@ -25,15 +25,16 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	conreg "github.com/GoogleCloudPlatform/declarative-resource-client-library/connector/server_registration"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	connectorpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/connector_go_proto"
 )
 
 const (
 	// CredentialsMetadataKey contains the user-provided GCP credentials.
-	CredentialsMetadataKey = "X-Call-Credentials"
+	CredentialsMetadataKey = serverconfig.CredentialsMetadataKey
 
 	// UserAgentMetadataKey is an optional value used to override the default when making GCP API calls.
-	UserAgentMetadataKey = "User-Agent"
+	UserAgentMetadataKey = serverconfig.UserAgentMetadataKey
 )
 
 // Set by InitializeServer().
@@ -67,6 +68,8 @@ func (b *BodyWriter) Flush() {}
 // InitializeServer prepares the server for future RPC requests. It must be called before
 // attempting to response to any requests.
 func InitializeServer() *connectorpb.InitializeResponse {
+	// The in-process server only serves its own process.
+	serverconfig.AllowServerCredentials(true)
 	grpcServer = grpc.NewServer()
 	return conreg.InitializeServer(grpcServer)
 }
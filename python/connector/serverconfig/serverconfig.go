@@ -0,0 +1,164 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package serverconfig holds the request handling shared by the gRPC servers
+// under python/services: building a DCL config from the caller's credentials,
+// converting lifecycle directives, paging through lists and converting diffs.
+package serverconfig
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	sdkpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/sdk_go_proto"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// CredentialsMetadataKey is the request metadata key holding the caller's
+	// service account or refresh token JSON credentials.
+	CredentialsMetadataKey = "X-Call-Credentials"
+
+	// UserAgentMetadataKey is the request metadata key holding the user agent
+	// to send with GCP API calls.
+	UserAgentMetadataKey = "User-Agent"
+)
+
+// serverCredentials reports whether requests may use the credentials of the
+// server process. It is set by AllowServerCredentials.
+var serverCredentials bool
+
+// AllowServerCredentials sets whether NewConfig may serve requests without
+// CredentialsMetadataKey metadata with the credentials of the server process:
+// the service account file named by the request or the application default
+// credentials. It is off by default, so that a network server does not lend
+// its own credentials to its callers, and must be set before serving.
+func AllowServerCredentials(allow bool) {
+	serverCredentials = allow
+}
+
+// NewConfig returns the DCL config used to serve a request. Credentials are
+// taken from the CredentialsMetadataKey metadata of the request. If the
+// request has none and AllowServerCredentials is set, they are taken from
+// serviceAccountFile, and then from the application default credentials of
+// the server. The user agent is taken from the UserAgentMetadataKey metadata,
+// if present.
+func NewConfig(ctx context.Context, serviceAccountFile string) (*dcl.Config, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var opts []dcl.ConfigOption
+	if ua := firstValue(md, UserAgentMetadataKey); ua != "" {
+		opts = append(opts, dcl.WithUserAgent(ua))
+	}
+	switch creds := firstValue(md, CredentialsMetadataKey); {
+	case creds != "":
+		opts = append(opts, dcl.WithCredentialsJSON([]byte(creds)))
+	case !serverCredentials:
+		return nil, status.Errorf(codes.Unauthenticated, "no credentials in the %s metadata of the request", CredentialsMetadataKey)
+	case serviceAccountFile != "":
+		opts = append(opts, dcl.WithCredentialsFile(serviceAccountFile))
+	default:
+		creds, err := google.FindDefaultCredentials(ctx, dcl.Scopes...)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "no credentials in the request and no application default credentials: %v", err)
+		}
+		opts = append(opts, dcl.WithCredentials(creds))
+	}
+	return dcl.NewConfig(opts...), nil
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if vs := md.Get(key); len(vs) > 0 {
+		return vs[0]
+	}
+	return ""
+}
+
+var lifecycleParams = map[sdkpb.LifecycleDirective]dcl.LifecycleParam{
+	sdkpb.LifecycleDirective_BLOCK_DESTRUCTION:  dcl.BlockDestruction,
+	sdkpb.LifecycleDirective_BLOCK_ACQUIRE:      dcl.BlockAcquire,
+	sdkpb.LifecycleDirective_BLOCK_CREATION:     dcl.BlockCreation,
+	sdkpb.LifecycleDirective_BLOCK_MODIFICATION: dcl.BlockModification,
+	sdkpb.LifecycleDirective_IGNORE_IF_MISSING:  dcl.IgnoreIfMissing,
+	sdkpb.LifecycleDirective_NOOP_ON_DESTROY:    dcl.NoopOnDestroy,
+}
+
+// ApplyOptions converts the lifecycle directives of a request to the options
+// of the matching DCL lifecycle params.
+func ApplyOptions(directives []sdkpb.LifecycleDirective) ([]dcl.ApplyOption, error) {
+	var opts []dcl.ApplyOption
+	for _, d := range directives {
+		p, ok := lifecycleParams[d]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported lifecycle directive %v", d)
+		}
+		opts = append(opts, dcl.WithLifecycleParam(p))
+	}
+	return opts, nil
+}
+
+// ListPages reads the pages of a DCL list requested by a List request, calling
+// read for each page that belongs in the response. The list must start at the
+// page token of the request. hasNext, nextToken and next report whether the
+// list has another page, return the API's token for it and fetch it.
+//
+// If pageSize is zero, every page is read. Otherwise a single page is read and
+// the returned token, if not empty, requests the page after it.
+func ListPages(pageSize int32, hasNext func() bool, nextToken func() string, next func() error, read func()) (string, error) {
+	if pageSize < 0 {
+		return "", status.Errorf(codes.InvalidArgument, "negative page size %d", pageSize)
+	}
+	for {
+		read()
+		if !hasNext() {
+			return "", nil
+		}
+		if pageSize > 0 {
+			return nextToken(), nil
+		}
+		if err := next(); err != nil {
+			return "", err
+		}
+	}
+}
+
+// FieldDiffsToProto converts the field diffs returned by a DCL Diff method to
+// their proto representation.
+func FieldDiffsToProto(diffs []*dcl.FieldDiff) []*sdkpb.FieldDiff {
+	var protos []*sdkpb.FieldDiff
+	for _, d := range diffs {
+		p := &sdkpb.FieldDiff{
+			FieldName:           d.FieldName,
+			Message:             d.Message,
+			ResultingOperations: d.ResultingOperation,
+		}
+		if d.Desired != nil {
+			p.Desired = dcl.SprintResourceCompact(d.Desired)
+		}
+		if d.Actual != nil {
+			p.Actual = dcl.SprintResourceCompact(d.Actual)
+		}
+		for _, v := range d.ToAdd {
+			p.ToAdd = append(p.ToAdd, dcl.SprintResourceCompact(v))
+		}
+		for _, v := range d.ToRemove {
+			p.ToRemove = append(p.ToRemove, dcl.SprintResourceCompact(v))
+		}
+		protos = append(protos, p)
+	}
+	return protos
+}
@@ -0,0 +1,180 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serverconfig
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewConfigCredentials(t *testing.T) {
+	defer AllowServerCredentials(false)
+	tests := []struct {
+		name               string
+		allow              bool
+		creds              string
+		serviceAccountFile string
+		wantCode           codes.Code
+	}{
+		{
+			name:     "request credentials",
+			creds:    `{"type":"service_account"}`,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "request credentials with server credentials",
+			allow:    true,
+			creds:    `{"type":"service_account"}`,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "no credentials",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:               "service account file",
+			serviceAccountFile: "/etc/dcl/sa.json",
+			wantCode:           codes.Unauthenticated,
+		},
+		{
+			name:               "service account file with server credentials",
+			allow:              true,
+			serviceAccountFile: "/etc/dcl/sa.json",
+			wantCode:           codes.OK,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			AllowServerCredentials(tc.allow)
+			ctx := context.Background()
+			if tc.creds != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(CredentialsMetadataKey, tc.creds))
+			}
+			c, err := NewConfig(ctx, tc.serviceAccountFile)
+			if code := status.Code(err); code != tc.wantCode {
+				t.Fatalf("NewConfig() error = %v, want code %v", err, tc.wantCode)
+			}
+			if (c != nil) != (tc.wantCode == codes.OK) {
+				t.Errorf("NewConfig() = %v, want a config %v", c, tc.wantCode == codes.OK)
+			}
+		})
+	}
+}
+
+// fakeList is a DCL list of pages whose page tokens are their indexes.
+type fakeList struct {
+	pages   [][]string
+	page    int
+	nextErr error
+}
+
+func (l *fakeList) HasNext() bool { return l.page+1 < len(l.pages) }
+
+func (l *fakeList) NextPageToken() string {
+	if !l.HasNext() {
+		return ""
+	}
+	return string(rune('0' + l.page + 1))
+}
+
+func (l *fakeList) Next() error {
+	if l.nextErr != nil {
+		return l.nextErr
+	}
+	l.page++
+	return nil
+}
+
+func TestListPages(t *testing.T) {
+	nextErr := errors.New("next failed")
+	tests := []struct {
+		name      string
+		pageSize  int32
+		list      *fakeList
+		want      []string
+		wantToken string
+		wantErr   error
+		wantCode  codes.Code
+	}{
+		{
+			name:     "all pages",
+			list:     &fakeList{pages: [][]string{{"a", "b"}, {"c"}, {"d"}}},
+			want:     []string{"a", "b", "c", "d"},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "first page",
+			pageSize:  2,
+			list:      &fakeList{pages: [][]string{{"a", "b"}, {"c"}, {"d"}}},
+			want:      []string{"a", "b"},
+			wantToken: "1",
+			wantCode:  codes.OK,
+		},
+		{
+			name:      "page from a token",
+			pageSize:  2,
+			list:      &fakeList{pages: [][]string{{"a", "b"}, {"c"}, {"d"}}, page: 1},
+			want:      []string{"c"},
+			wantToken: "2",
+			wantCode:  codes.OK,
+		},
+		{
+			name:     "last page",
+			pageSize: 2,
+			list:     &fakeList{pages: [][]string{{"a", "b"}, {"c"}, {"d"}}, page: 2},
+			want:     []string{"d"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "negative page size",
+			pageSize: -1,
+			list:     &fakeList{pages: [][]string{{"a"}}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "next fails",
+			list:     &fakeList{pages: [][]string{{"a"}, {"b"}}, nextErr: nextErr},
+			want:     []string{"a"},
+			wantErr:  nextErr,
+			wantCode: codes.Unknown,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			token, err := ListPages(tc.pageSize, tc.list.HasNext, tc.list.NextPageToken, tc.list.Next, func() {
+				got = append(got, tc.list.pages[tc.list.page]...)
+			})
+			if code := status.Code(err); code != tc.wantCode {
+				t.Fatalf("ListPages() error = %v, want code %v", err, tc.wantCode)
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Errorf("ListPages() error = %v, want %v", err, tc.wantErr)
+			}
+			if token != tc.wantToken {
+				t.Errorf("ListPages() token = %q, want %q", token, tc.wantToken)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ListPages() read diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
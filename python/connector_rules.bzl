@@ -12,6 +12,7 @@ def connector(name, resources):
         importpath = "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/services/%s" % name,
         visibility = ["//visibility:public"],
         deps = [
+            "//connector/serverconfig",
             "//proto:empty_go_proto",
             "//proto/%s:%s_go_proto" % (name, pkg),
             "@core_dcl//dcl:go_default_library",
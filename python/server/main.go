@@ -0,0 +1,167 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command dcl-grpc-server serves the DCL gRPC services, the same ones the
+// Python connector exposes in-process, over TCP or a Unix socket so that they
+// can be used from any language with an ordinary gRPC client.
+//
+// The server supports gRPC reflection and the standard health checking
+// protocol. It serves plaintext by default; with --tls_cert and --tls_key it
+// serves TLS, and with --tls_client_ca it also requires clients to present a
+// certificate signed by that CA (mutual TLS).
+//
+// Callers send the credentials for GCP API calls in the X-Call-Credentials
+// request metadata, and requests without them are rejected. The server does
+// not authenticate its callers, so it only uses its own credentials, a service
+// account file named by the request or the application default credentials,
+// with --allow_server_credentials. Set it only when every caller that can
+// reach the server may act as the server, e.g. over a Unix socket or with
+// mutual TLS.
+//
+//	dcl-grpc-server --address=localhost:8070
+//	dcl-grpc-server --unix_socket=/run/dcl.sock
+//	dcl-grpc-server --address=:8443 --tls_cert=server.pem --tls_key=server.key --tls_client_ca=ca.pem
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	glog "github.com/golang/glog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	conreg "github.com/GoogleCloudPlatform/declarative-resource-client-library/connector/server_registration"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
+)
+
+var (
+	address     = flag.String("address", "localhost:8070", "TCP address to listen on; ignored if --unix_socket is set")
+	unixSocket  = flag.String("unix_socket", "", "path of a Unix socket to listen on instead of TCP")
+	tlsCert     = flag.String("tls_cert", "", "PEM file with the server certificate; enables TLS")
+	tlsKey      = flag.String("tls_key", "", "PEM file with the private key of --tls_cert")
+	tlsClientCA = flag.String("tls_client_ca", "", "PEM file with the CA certificates that client certificates must be signed by; enables mutual TLS")
+
+	allowServerCredentials = flag.Bool("allow_server_credentials", false, "serve requests without X-Call-Credentials metadata with the credentials of the server")
+)
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		glog.Exit(err)
+	}
+}
+
+func run() error {
+	var opts []grpc.ServerOption
+	tc, err := tlsConfig(*tlsCert, *tlsKey, *tlsClientCA)
+	if err != nil {
+		return err
+	}
+	if tc != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tc)))
+	}
+
+	serverconfig.AllowServerCredentials(*allowServerCredentials)
+	s := grpc.NewServer(opts...)
+	if resp := conreg.InitializeServer(s); resp.GetStatus().GetCode() != int32(codes.OK) {
+		return fmt.Errorf("registering services: %s", resp.GetStatus().GetMessage())
+	}
+
+	// Every registered service is reported as serving, as is the server as a
+	// whole under the empty service name.
+	hs := health.NewServer()
+	for name := range s.GetServiceInfo() {
+		hs.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(s, hs)
+	reflection.Register(s)
+
+	lis, err := listen(*address, *unixSocket)
+	if err != nil {
+		return err
+	}
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		glog.Infof("Received %v, shutting down", <-sig)
+		hs.Shutdown()
+		s.GracefulStop()
+	}()
+
+	glog.Infof("Serving %d services on %s", len(s.GetServiceInfo()), lis.Addr())
+	return s.Serve(lis)
+}
+
+// listen listens on the Unix socket at path if it is set, and on the TCP
+// address addr otherwise.
+func listen(addr, path string) (net.Listener, error) {
+	if path == "" {
+		return net.Listen("tcp", addr)
+	}
+	// A socket left behind by a previous server would make Listen fail.
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return net.Listen("unix", path)
+}
+
+// tlsConfig returns the TLS configuration for the given files, or nil if TLS
+// is not enabled.
+func tlsConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	if certFile == "" && keyFile == "" {
+		if clientCAFile != "" {
+			return nil, errors.New("--tls_client_ca requires --tls_cert and --tls_key")
+		}
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("--tls_cert and --tls_key must be set together")
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading server certificate: %w", err)
+	}
+	tc := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile == "" {
+		return tc, nil
+	}
+
+	pem, err := ioutil.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading client CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", clientCAFile)
+	}
+	tc.ClientCAs = pool
+	tc.ClientAuth = tls.RequireAndVerifyClientCert
+	return tc, nil
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	accesscontextmanagerpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/accesscontextmanager/accesscontextmanager_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/accesscontextmanager"
@@ -295,7 +296,11 @@ func AccessLevelToProto(resource *accesscontextmanager.AccessLevel) *accessconte
 // ApplyAccessLevel handles the gRPC request by passing it to the underlying AccessLevel Apply() method.
 func (s *AccessLevelServer) applyAccessLevel(ctx context.Context, c *accesscontextmanager.Client, request *accesscontextmanagerpb.ApplyAccesscontextmanagerAccessLevelRequest) (*accesscontextmanagerpb.AccesscontextmanagerAccessLevel, error) {
 	p := ProtoToAccessLevel(request.GetResource())
-	res, err := c.ApplyAccessLevel(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAccessLevel(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -312,6 +317,55 @@ func (s *AccessLevelServer) ApplyAccesscontextmanagerAccessLevel(ctx context.Con
 	return s.applyAccessLevel(ctx, cl, request)
 }
 
+// GetAccesscontextmanagerAccessLevel handles the gRPC request by passing it to the underlying AccessLevel Get() method.
+func (s *AccessLevelServer) GetAccesscontextmanagerAccessLevel(ctx context.Context, request *accesscontextmanagerpb.GetAccesscontextmanagerAccessLevelRequest) (*accesscontextmanagerpb.AccesscontextmanagerAccessLevel, error) {
+	cl, err := createConfigAccessLevel(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAccessLevel(ctx, ProtoToAccessLevel(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AccessLevelToProto(res), nil
+}
+
+// HasDiffAccesscontextmanagerAccessLevel handles the gRPC request by passing it to the underlying AccessLevel Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AccessLevelServer) HasDiffAccesscontextmanagerAccessLevel(ctx context.Context, request *accesscontextmanagerpb.HasDiffAccesscontextmanagerAccessLevelRequest) (*accesscontextmanagerpb.HasDiffAccesscontextmanagerAccessLevelResponse, error) {
+	cl, err := createConfigAccessLevel(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAccessLevel(ctx, ProtoToAccessLevel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &accesscontextmanagerpb.HasDiffAccesscontextmanagerAccessLevelResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanAccesscontextmanagerAccessLevel handles the gRPC request by passing it to the underlying AccessLevel Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AccessLevelServer) PlanAccesscontextmanagerAccessLevel(ctx context.Context, request *accesscontextmanagerpb.PlanAccesscontextmanagerAccessLevelRequest) (*accesscontextmanagerpb.PlanAccesscontextmanagerAccessLevelResponse, error) {
+	cl, err := createConfigAccessLevel(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAccessLevel(ctx, ProtoToAccessLevel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &accesscontextmanagerpb.PlanAccesscontextmanagerAccessLevelResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAccessLevel handles the gRPC request by passing it to the underlying AccessLevel Delete() method.
 func (s *AccessLevelServer) DeleteAccesscontextmanagerAccessLevel(ctx context.Context, request *accesscontextmanagerpb.DeleteAccesscontextmanagerAccessLevelRequest) (*emptypb.Empty, error) {
 
@@ -330,20 +384,32 @@ func (s *AccessLevelServer) ListAccesscontextmanagerAccessLevel(ctx context.Cont
 		return nil, err
 	}
 
-	resources, err := cl.ListAccessLevel(ctx, request.Policy)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = accesscontextmanager.AccessLevelMaxPage
+	}
+	resources, err := cl.ListAccessLevelWithPageToken(ctx, request.Policy, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*accesscontextmanagerpb.AccesscontextmanagerAccessLevel
-	for _, r := range resources.Items {
-		rp := AccessLevelToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AccessLevelToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &accesscontextmanagerpb.ListAccesscontextmanagerAccessLevelResponse{Items: protos}, nil
+	return &accesscontextmanagerpb.ListAccesscontextmanagerAccessLevelResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAccessLevel(ctx context.Context, service_account_file string) (*accesscontextmanager.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return accesscontextmanager.NewClient(conf), nil
 }
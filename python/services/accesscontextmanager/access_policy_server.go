@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	accesscontextmanagerpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/accesscontextmanager/accesscontextmanager_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/accesscontextmanager"
@@ -53,7 +54,11 @@ func AccessPolicyToProto(resource *accesscontextmanager.AccessPolicy) *accesscon
 // ApplyAccessPolicy handles the gRPC request by passing it to the underlying AccessPolicy Apply() method.
 func (s *AccessPolicyServer) applyAccessPolicy(ctx context.Context, c *accesscontextmanager.Client, request *accesscontextmanagerpb.ApplyAccesscontextmanagerAccessPolicyRequest) (*accesscontextmanagerpb.AccesscontextmanagerAccessPolicy, error) {
 	p := ProtoToAccessPolicy(request.GetResource())
-	res, err := c.ApplyAccessPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAccessPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +75,55 @@ func (s *AccessPolicyServer) ApplyAccesscontextmanagerAccessPolicy(ctx context.C
 	return s.applyAccessPolicy(ctx, cl, request)
 }
 
+// GetAccesscontextmanagerAccessPolicy handles the gRPC request by passing it to the underlying AccessPolicy Get() method.
+func (s *AccessPolicyServer) GetAccesscontextmanagerAccessPolicy(ctx context.Context, request *accesscontextmanagerpb.GetAccesscontextmanagerAccessPolicyRequest) (*accesscontextmanagerpb.AccesscontextmanagerAccessPolicy, error) {
+	cl, err := createConfigAccessPolicy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAccessPolicy(ctx, ProtoToAccessPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AccessPolicyToProto(res), nil
+}
+
+// HasDiffAccesscontextmanagerAccessPolicy handles the gRPC request by passing it to the underlying AccessPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AccessPolicyServer) HasDiffAccesscontextmanagerAccessPolicy(ctx context.Context, request *accesscontextmanagerpb.HasDiffAccesscontextmanagerAccessPolicyRequest) (*accesscontextmanagerpb.HasDiffAccesscontextmanagerAccessPolicyResponse, error) {
+	cl, err := createConfigAccessPolicy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAccessPolicy(ctx, ProtoToAccessPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &accesscontextmanagerpb.HasDiffAccesscontextmanagerAccessPolicyResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanAccesscontextmanagerAccessPolicy handles the gRPC request by passing it to the underlying AccessPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AccessPolicyServer) PlanAccesscontextmanagerAccessPolicy(ctx context.Context, request *accesscontextmanagerpb.PlanAccesscontextmanagerAccessPolicyRequest) (*accesscontextmanagerpb.PlanAccesscontextmanagerAccessPolicyResponse, error) {
+	cl, err := createConfigAccessPolicy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAccessPolicy(ctx, ProtoToAccessPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &accesscontextmanagerpb.PlanAccesscontextmanagerAccessPolicyResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAccessPolicy handles the gRPC request by passing it to the underlying AccessPolicy Delete() method.
 func (s *AccessPolicyServer) DeleteAccesscontextmanagerAccessPolicy(ctx context.Context, request *accesscontextmanagerpb.DeleteAccesscontextmanagerAccessPolicyRequest) (*emptypb.Empty, error) {
 
@@ -88,20 +142,32 @@ func (s *AccessPolicyServer) ListAccesscontextmanagerAccessPolicy(ctx context.Co
 		return nil, err
 	}
 
-	resources, err := cl.ListAccessPolicy(ctx, request.Parent)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = accesscontextmanager.AccessPolicyMaxPage
+	}
+	resources, err := cl.ListAccessPolicyWithPageToken(ctx, request.Parent, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*accesscontextmanagerpb.AccesscontextmanagerAccessPolicy
-	for _, r := range resources.Items {
-		rp := AccessPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AccessPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &accesscontextmanagerpb.ListAccesscontextmanagerAccessPolicyResponse{Items: protos}, nil
+	return &accesscontextmanagerpb.ListAccesscontextmanagerAccessPolicyResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAccessPolicy(ctx context.Context, service_account_file string) (*accesscontextmanager.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return accesscontextmanager.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	accesscontextmanagerpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/accesscontextmanager/accesscontextmanager_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/accesscontextmanager"
@@ -222,7 +223,11 @@ func ServicePerimeterToProto(resource *accesscontextmanager.ServicePerimeter) *a
 // ApplyServicePerimeter handles the gRPC request by passing it to the underlying ServicePerimeter Apply() method.
 func (s *ServicePerimeterServer) applyServicePerimeter(ctx context.Context, c *accesscontextmanager.Client, request *accesscontextmanagerpb.ApplyAccesscontextmanagerServicePerimeterRequest) (*accesscontextmanagerpb.AccesscontextmanagerServicePerimeter, error) {
 	p := ProtoToServicePerimeter(request.GetResource())
-	res, err := c.ApplyServicePerimeter(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyServicePerimeter(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -239,6 +244,55 @@ func (s *ServicePerimeterServer) ApplyAccesscontextmanagerServicePerimeter(ctx c
 	return s.applyServicePerimeter(ctx, cl, request)
 }
 
+// GetAccesscontextmanagerServicePerimeter handles the gRPC request by passing it to the underlying ServicePerimeter Get() method.
+func (s *ServicePerimeterServer) GetAccesscontextmanagerServicePerimeter(ctx context.Context, request *accesscontextmanagerpb.GetAccesscontextmanagerServicePerimeterRequest) (*accesscontextmanagerpb.AccesscontextmanagerServicePerimeter, error) {
+	cl, err := createConfigServicePerimeter(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetServicePerimeter(ctx, ProtoToServicePerimeter(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServicePerimeterToProto(res), nil
+}
+
+// HasDiffAccesscontextmanagerServicePerimeter handles the gRPC request by passing it to the underlying ServicePerimeter Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServicePerimeterServer) HasDiffAccesscontextmanagerServicePerimeter(ctx context.Context, request *accesscontextmanagerpb.HasDiffAccesscontextmanagerServicePerimeterRequest) (*accesscontextmanagerpb.HasDiffAccesscontextmanagerServicePerimeterResponse, error) {
+	cl, err := createConfigServicePerimeter(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServicePerimeter(ctx, ProtoToServicePerimeter(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &accesscontextmanagerpb.HasDiffAccesscontextmanagerServicePerimeterResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanAccesscontextmanagerServicePerimeter handles the gRPC request by passing it to the underlying ServicePerimeter Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServicePerimeterServer) PlanAccesscontextmanagerServicePerimeter(ctx context.Context, request *accesscontextmanagerpb.PlanAccesscontextmanagerServicePerimeterRequest) (*accesscontextmanagerpb.PlanAccesscontextmanagerServicePerimeterResponse, error) {
+	cl, err := createConfigServicePerimeter(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServicePerimeter(ctx, ProtoToServicePerimeter(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &accesscontextmanagerpb.PlanAccesscontextmanagerServicePerimeterResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteServicePerimeter handles the gRPC request by passing it to the underlying ServicePerimeter Delete() method.
 func (s *ServicePerimeterServer) DeleteAccesscontextmanagerServicePerimeter(ctx context.Context, request *accesscontextmanagerpb.DeleteAccesscontextmanagerServicePerimeterRequest) (*emptypb.Empty, error) {
 
@@ -257,20 +311,32 @@ func (s *ServicePerimeterServer) ListAccesscontextmanagerServicePerimeter(ctx co
 		return nil, err
 	}
 
-	resources, err := cl.ListServicePerimeter(ctx, request.Policy)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = accesscontextmanager.ServicePerimeterMaxPage
+	}
+	resources, err := cl.ListServicePerimeterWithPageToken(ctx, request.Policy, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*accesscontextmanagerpb.AccesscontextmanagerServicePerimeter
-	for _, r := range resources.Items {
-		rp := ServicePerimeterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServicePerimeterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &accesscontextmanagerpb.ListAccesscontextmanagerServicePerimeterResponse{Items: protos}, nil
+	return &accesscontextmanagerpb.ListAccesscontextmanagerServicePerimeterResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigServicePerimeter(ctx context.Context, service_account_file string) (*accesscontextmanager.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return accesscontextmanager.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/alpha/apigee_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee/alpha"
@@ -49,7 +50,11 @@ func AttachmentToProto(resource *alpha.Attachment) *alphapb.ApigeeAlphaAttachmen
 // applyAttachment handles the gRPC request by passing it to the underlying Attachment Apply() method.
 func (s *AttachmentServer) applyAttachment(ctx context.Context, c *alpha.Client, request *alphapb.ApplyApigeeAlphaAttachmentRequest) (*alphapb.ApigeeAlphaAttachment, error) {
 	p := ProtoToAttachment(request.GetResource())
-	res, err := c.ApplyAttachment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAttachment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +71,60 @@ func (s *AttachmentServer) ApplyApigeeAlphaAttachment(ctx context.Context, reque
 	return s.applyAttachment(ctx, cl, request)
 }
 
+// GetApigeeAlphaAttachment handles the gRPC request by passing it to the underlying Attachment Get() method.
+func (s *AttachmentServer) GetApigeeAlphaAttachment(ctx context.Context, request *alphapb.GetApigeeAlphaAttachmentRequest) (*alphapb.ApigeeAlphaAttachment, error) {
+	cl, err := createConfigAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAttachment(ctx, ProtoToAttachment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AttachmentToProto(res), nil
+}
+
+// HasDiffApigeeAlphaAttachment handles the gRPC request by passing it to the underlying Attachment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AttachmentServer) HasDiffApigeeAlphaAttachment(ctx context.Context, request *alphapb.HasDiffApigeeAlphaAttachmentRequest) (*alphapb.HasDiffApigeeAlphaAttachmentResponse, error) {
+	cl, err := createConfigAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAttachment(ctx, ProtoToAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffApigeeAlphaAttachmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeAlphaAttachment handles the gRPC request by passing it to the underlying Attachment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AttachmentServer) PlanApigeeAlphaAttachment(ctx context.Context, request *alphapb.PlanApigeeAlphaAttachmentRequest) (*alphapb.PlanApigeeAlphaAttachmentResponse, error) {
+	cl, err := createConfigAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAttachment(ctx, ProtoToAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanApigeeAlphaAttachmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAttachment handles the gRPC request by passing it to the underlying Attachment Delete() method.
 func (s *AttachmentServer) DeleteApigeeAlphaAttachment(ctx context.Context, request *alphapb.DeleteApigeeAlphaAttachmentRequest) (*emptypb.Empty, error) {
 
@@ -84,22 +143,35 @@ func (s *AttachmentServer) ListApigeeAlphaAttachment(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListAttachment(ctx, request.GetEnvgroup())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.AttachmentMaxPage
+	}
+	resources, err := cl.ListAttachmentWithPageToken(ctx, request.GetEnvgroup(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ApigeeAlphaAttachment
-	for _, r := range resources.Items {
-		rp := AttachmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AttachmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListApigeeAlphaAttachmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAttachment(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/alpha/apigee_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee/alpha"
@@ -82,7 +83,11 @@ func EnvgroupToProto(resource *alpha.Envgroup) *alphapb.ApigeeAlphaEnvgroup {
 // applyEnvgroup handles the gRPC request by passing it to the underlying Envgroup Apply() method.
 func (s *EnvgroupServer) applyEnvgroup(ctx context.Context, c *alpha.Client, request *alphapb.ApplyApigeeAlphaEnvgroupRequest) (*alphapb.ApigeeAlphaEnvgroup, error) {
 	p := ProtoToEnvgroup(request.GetResource())
-	res, err := c.ApplyEnvgroup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEnvgroup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +104,60 @@ func (s *EnvgroupServer) ApplyApigeeAlphaEnvgroup(ctx context.Context, request *
 	return s.applyEnvgroup(ctx, cl, request)
 }
 
+// GetApigeeAlphaEnvgroup handles the gRPC request by passing it to the underlying Envgroup Get() method.
+func (s *EnvgroupServer) GetApigeeAlphaEnvgroup(ctx context.Context, request *alphapb.GetApigeeAlphaEnvgroupRequest) (*alphapb.ApigeeAlphaEnvgroup, error) {
+	cl, err := createConfigEnvgroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEnvgroup(ctx, ProtoToEnvgroup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EnvgroupToProto(res), nil
+}
+
+// HasDiffApigeeAlphaEnvgroup handles the gRPC request by passing it to the underlying Envgroup Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EnvgroupServer) HasDiffApigeeAlphaEnvgroup(ctx context.Context, request *alphapb.HasDiffApigeeAlphaEnvgroupRequest) (*alphapb.HasDiffApigeeAlphaEnvgroupResponse, error) {
+	cl, err := createConfigEnvgroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvgroup(ctx, ProtoToEnvgroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffApigeeAlphaEnvgroupResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeAlphaEnvgroup handles the gRPC request by passing it to the underlying Envgroup Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EnvgroupServer) PlanApigeeAlphaEnvgroup(ctx context.Context, request *alphapb.PlanApigeeAlphaEnvgroupRequest) (*alphapb.PlanApigeeAlphaEnvgroupResponse, error) {
+	cl, err := createConfigEnvgroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvgroup(ctx, ProtoToEnvgroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanApigeeAlphaEnvgroupResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEnvgroup handles the gRPC request by passing it to the underlying Envgroup Delete() method.
 func (s *EnvgroupServer) DeleteApigeeAlphaEnvgroup(ctx context.Context, request *alphapb.DeleteApigeeAlphaEnvgroupRequest) (*emptypb.Empty, error) {
 
@@ -117,22 +176,35 @@ func (s *EnvgroupServer) ListApigeeAlphaEnvgroup(ctx context.Context, request *a
 		return nil, err
 	}
 
-	resources, err := cl.ListEnvgroup(ctx, request.GetApigeeOrganization())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.EnvgroupMaxPage
+	}
+	resources, err := cl.ListEnvgroupWithPageToken(ctx, request.GetApigeeOrganization(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ApigeeAlphaEnvgroup
-	for _, r := range resources.Items {
-		rp := EnvgroupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EnvgroupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListApigeeAlphaEnvgroupResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEnvgroup(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/alpha/apigee_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee/alpha"
@@ -49,7 +50,11 @@ func EnvironmentGroupAttachmentToProto(resource *alpha.EnvironmentGroupAttachmen
 // applyEnvironmentGroupAttachment handles the gRPC request by passing it to the underlying EnvironmentGroupAttachment Apply() method.
 func (s *EnvironmentGroupAttachmentServer) applyEnvironmentGroupAttachment(ctx context.Context, c *alpha.Client, request *alphapb.ApplyApigeeAlphaEnvironmentGroupAttachmentRequest) (*alphapb.ApigeeAlphaEnvironmentGroupAttachment, error) {
 	p := ProtoToEnvironmentGroupAttachment(request.GetResource())
-	res, err := c.ApplyEnvironmentGroupAttachment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEnvironmentGroupAttachment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +71,60 @@ func (s *EnvironmentGroupAttachmentServer) ApplyApigeeAlphaEnvironmentGroupAttac
 	return s.applyEnvironmentGroupAttachment(ctx, cl, request)
 }
 
+// GetApigeeAlphaEnvironmentGroupAttachment handles the gRPC request by passing it to the underlying EnvironmentGroupAttachment Get() method.
+func (s *EnvironmentGroupAttachmentServer) GetApigeeAlphaEnvironmentGroupAttachment(ctx context.Context, request *alphapb.GetApigeeAlphaEnvironmentGroupAttachmentRequest) (*alphapb.ApigeeAlphaEnvironmentGroupAttachment, error) {
+	cl, err := createConfigEnvironmentGroupAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEnvironmentGroupAttachment(ctx, ProtoToEnvironmentGroupAttachment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EnvironmentGroupAttachmentToProto(res), nil
+}
+
+// HasDiffApigeeAlphaEnvironmentGroupAttachment handles the gRPC request by passing it to the underlying EnvironmentGroupAttachment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EnvironmentGroupAttachmentServer) HasDiffApigeeAlphaEnvironmentGroupAttachment(ctx context.Context, request *alphapb.HasDiffApigeeAlphaEnvironmentGroupAttachmentRequest) (*alphapb.HasDiffApigeeAlphaEnvironmentGroupAttachmentResponse, error) {
+	cl, err := createConfigEnvironmentGroupAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironmentGroupAttachment(ctx, ProtoToEnvironmentGroupAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffApigeeAlphaEnvironmentGroupAttachmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeAlphaEnvironmentGroupAttachment handles the gRPC request by passing it to the underlying EnvironmentGroupAttachment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EnvironmentGroupAttachmentServer) PlanApigeeAlphaEnvironmentGroupAttachment(ctx context.Context, request *alphapb.PlanApigeeAlphaEnvironmentGroupAttachmentRequest) (*alphapb.PlanApigeeAlphaEnvironmentGroupAttachmentResponse, error) {
+	cl, err := createConfigEnvironmentGroupAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironmentGroupAttachment(ctx, ProtoToEnvironmentGroupAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanApigeeAlphaEnvironmentGroupAttachmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEnvironmentGroupAttachment handles the gRPC request by passing it to the underlying EnvironmentGroupAttachment Delete() method.
 func (s *EnvironmentGroupAttachmentServer) DeleteApigeeAlphaEnvironmentGroupAttachment(ctx context.Context, request *alphapb.DeleteApigeeAlphaEnvironmentGroupAttachmentRequest) (*emptypb.Empty, error) {
 
@@ -84,22 +143,35 @@ func (s *EnvironmentGroupAttachmentServer) ListApigeeAlphaEnvironmentGroupAttach
 		return nil, err
 	}
 
-	resources, err := cl.ListEnvironmentGroupAttachment(ctx, request.GetEnvgroup())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.EnvironmentGroupAttachmentMaxPage
+	}
+	resources, err := cl.ListEnvironmentGroupAttachmentWithPageToken(ctx, request.GetEnvgroup(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ApigeeAlphaEnvironmentGroupAttachment
-	for _, r := range resources.Items {
-		rp := EnvironmentGroupAttachmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EnvironmentGroupAttachmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListApigeeAlphaEnvironmentGroupAttachmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEnvironmentGroupAttachment(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
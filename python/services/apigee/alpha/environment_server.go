@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/alpha/apigee_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee/alpha"
@@ -83,7 +84,11 @@ func EnvironmentToProto(resource *alpha.Environment) *alphapb.ApigeeAlphaEnviron
 // applyEnvironment handles the gRPC request by passing it to the underlying Environment Apply() method.
 func (s *EnvironmentServer) applyEnvironment(ctx context.Context, c *alpha.Client, request *alphapb.ApplyApigeeAlphaEnvironmentRequest) (*alphapb.ApigeeAlphaEnvironment, error) {
 	p := ProtoToEnvironment(request.GetResource())
-	res, err := c.ApplyEnvironment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEnvironment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +105,60 @@ func (s *EnvironmentServer) ApplyApigeeAlphaEnvironment(ctx context.Context, req
 	return s.applyEnvironment(ctx, cl, request)
 }
 
+// GetApigeeAlphaEnvironment handles the gRPC request by passing it to the underlying Environment Get() method.
+func (s *EnvironmentServer) GetApigeeAlphaEnvironment(ctx context.Context, request *alphapb.GetApigeeAlphaEnvironmentRequest) (*alphapb.ApigeeAlphaEnvironment, error) {
+	cl, err := createConfigEnvironment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEnvironment(ctx, ProtoToEnvironment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EnvironmentToProto(res), nil
+}
+
+// HasDiffApigeeAlphaEnvironment handles the gRPC request by passing it to the underlying Environment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EnvironmentServer) HasDiffApigeeAlphaEnvironment(ctx context.Context, request *alphapb.HasDiffApigeeAlphaEnvironmentRequest) (*alphapb.HasDiffApigeeAlphaEnvironmentResponse, error) {
+	cl, err := createConfigEnvironment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironment(ctx, ProtoToEnvironment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffApigeeAlphaEnvironmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeAlphaEnvironment handles the gRPC request by passing it to the underlying Environment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EnvironmentServer) PlanApigeeAlphaEnvironment(ctx context.Context, request *alphapb.PlanApigeeAlphaEnvironmentRequest) (*alphapb.PlanApigeeAlphaEnvironmentResponse, error) {
+	cl, err := createConfigEnvironment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironment(ctx, ProtoToEnvironment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanApigeeAlphaEnvironmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEnvironment handles the gRPC request by passing it to the underlying Environment Delete() method.
 func (s *EnvironmentServer) DeleteApigeeAlphaEnvironment(ctx context.Context, request *alphapb.DeleteApigeeAlphaEnvironmentRequest) (*emptypb.Empty, error) {
 
@@ -118,22 +177,35 @@ func (s *EnvironmentServer) ListApigeeAlphaEnvironment(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListEnvironment(ctx, request.GetApigeeOrganization())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.EnvironmentMaxPage
+	}
+	resources, err := cl.ListEnvironmentWithPageToken(ctx, request.GetApigeeOrganization(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ApigeeAlphaEnvironment
-	for _, r := range resources.Items {
-		rp := EnvironmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EnvironmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListApigeeAlphaEnvironmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEnvironment(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/alpha/apigee_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee/alpha"
@@ -245,7 +246,11 @@ func OrganizationToProto(resource *alpha.Organization) *alphapb.ApigeeAlphaOrgan
 // applyOrganization handles the gRPC request by passing it to the underlying Organization Apply() method.
 func (s *OrganizationServer) applyOrganization(ctx context.Context, c *alpha.Client, request *alphapb.ApplyApigeeAlphaOrganizationRequest) (*alphapb.ApigeeAlphaOrganization, error) {
 	p := ProtoToOrganization(request.GetResource())
-	res, err := c.ApplyOrganization(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyOrganization(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -262,6 +267,60 @@ func (s *OrganizationServer) ApplyApigeeAlphaOrganization(ctx context.Context, r
 	return s.applyOrganization(ctx, cl, request)
 }
 
+// GetApigeeAlphaOrganization handles the gRPC request by passing it to the underlying Organization Get() method.
+func (s *OrganizationServer) GetApigeeAlphaOrganization(ctx context.Context, request *alphapb.GetApigeeAlphaOrganizationRequest) (*alphapb.ApigeeAlphaOrganization, error) {
+	cl, err := createConfigOrganization(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetOrganization(ctx, ProtoToOrganization(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return OrganizationToProto(res), nil
+}
+
+// HasDiffApigeeAlphaOrganization handles the gRPC request by passing it to the underlying Organization Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *OrganizationServer) HasDiffApigeeAlphaOrganization(ctx context.Context, request *alphapb.HasDiffApigeeAlphaOrganizationRequest) (*alphapb.HasDiffApigeeAlphaOrganizationResponse, error) {
+	cl, err := createConfigOrganization(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffOrganization(ctx, ProtoToOrganization(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffApigeeAlphaOrganizationResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeAlphaOrganization handles the gRPC request by passing it to the underlying Organization Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *OrganizationServer) PlanApigeeAlphaOrganization(ctx context.Context, request *alphapb.PlanApigeeAlphaOrganizationRequest) (*alphapb.PlanApigeeAlphaOrganizationResponse, error) {
+	cl, err := createConfigOrganization(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffOrganization(ctx, ProtoToOrganization(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanApigeeAlphaOrganizationResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteOrganization handles the gRPC request by passing it to the underlying Organization Delete() method.
 func (s *OrganizationServer) DeleteApigeeAlphaOrganization(ctx context.Context, request *alphapb.DeleteApigeeAlphaOrganizationRequest) (*emptypb.Empty, error) {
 
@@ -280,22 +339,35 @@ func (s *OrganizationServer) ListApigeeAlphaOrganization(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListOrganization(ctx)
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.OrganizationMaxPage
+	}
+	resources, err := cl.ListOrganizationWithPageToken(ctx, pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ApigeeAlphaOrganization
-	for _, r := range resources.Items {
-		rp := OrganizationToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, OrganizationToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListApigeeAlphaOrganizationResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigOrganization(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
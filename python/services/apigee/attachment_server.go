@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	apigeepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/apigee_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee"
@@ -49,7 +50,11 @@ func AttachmentToProto(resource *apigee.Attachment) *apigeepb.ApigeeAttachment {
 // applyAttachment handles the gRPC request by passing it to the underlying Attachment Apply() method.
 func (s *AttachmentServer) applyAttachment(ctx context.Context, c *apigee.Client, request *apigeepb.ApplyApigeeAttachmentRequest) (*apigeepb.ApigeeAttachment, error) {
 	p := ProtoToAttachment(request.GetResource())
-	res, err := c.ApplyAttachment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAttachment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +71,60 @@ func (s *AttachmentServer) ApplyApigeeAttachment(ctx context.Context, request *a
 	return s.applyAttachment(ctx, cl, request)
 }
 
+// GetApigeeAttachment handles the gRPC request by passing it to the underlying Attachment Get() method.
+func (s *AttachmentServer) GetApigeeAttachment(ctx context.Context, request *apigeepb.GetApigeeAttachmentRequest) (*apigeepb.ApigeeAttachment, error) {
+	cl, err := createConfigAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAttachment(ctx, ProtoToAttachment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AttachmentToProto(res), nil
+}
+
+// HasDiffApigeeAttachment handles the gRPC request by passing it to the underlying Attachment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AttachmentServer) HasDiffApigeeAttachment(ctx context.Context, request *apigeepb.HasDiffApigeeAttachmentRequest) (*apigeepb.HasDiffApigeeAttachmentResponse, error) {
+	cl, err := createConfigAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAttachment(ctx, ProtoToAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &apigeepb.HasDiffApigeeAttachmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeAttachment handles the gRPC request by passing it to the underlying Attachment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AttachmentServer) PlanApigeeAttachment(ctx context.Context, request *apigeepb.PlanApigeeAttachmentRequest) (*apigeepb.PlanApigeeAttachmentResponse, error) {
+	cl, err := createConfigAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAttachment(ctx, ProtoToAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &apigeepb.PlanApigeeAttachmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAttachment handles the gRPC request by passing it to the underlying Attachment Delete() method.
 func (s *AttachmentServer) DeleteApigeeAttachment(ctx context.Context, request *apigeepb.DeleteApigeeAttachmentRequest) (*emptypb.Empty, error) {
 
@@ -84,22 +143,35 @@ func (s *AttachmentServer) ListApigeeAttachment(ctx context.Context, request *ap
 		return nil, err
 	}
 
-	resources, err := cl.ListAttachment(ctx, request.GetEnvgroup())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = apigee.AttachmentMaxPage
+	}
+	resources, err := cl.ListAttachmentWithPageToken(ctx, request.GetEnvgroup(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*apigeepb.ApigeeAttachment
-	for _, r := range resources.Items {
-		rp := AttachmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AttachmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &apigeepb.ListApigeeAttachmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAttachment(ctx context.Context, service_account_file string) (*apigee.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return apigee.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/beta/apigee_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee/beta"
@@ -49,7 +50,11 @@ func AttachmentToProto(resource *beta.Attachment) *betapb.ApigeeBetaAttachment {
 // applyAttachment handles the gRPC request by passing it to the underlying Attachment Apply() method.
 func (s *AttachmentServer) applyAttachment(ctx context.Context, c *beta.Client, request *betapb.ApplyApigeeBetaAttachmentRequest) (*betapb.ApigeeBetaAttachment, error) {
 	p := ProtoToAttachment(request.GetResource())
-	res, err := c.ApplyAttachment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAttachment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +71,60 @@ func (s *AttachmentServer) ApplyApigeeBetaAttachment(ctx context.Context, reques
 	return s.applyAttachment(ctx, cl, request)
 }
 
+// GetApigeeBetaAttachment handles the gRPC request by passing it to the underlying Attachment Get() method.
+func (s *AttachmentServer) GetApigeeBetaAttachment(ctx context.Context, request *betapb.GetApigeeBetaAttachmentRequest) (*betapb.ApigeeBetaAttachment, error) {
+	cl, err := createConfigAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAttachment(ctx, ProtoToAttachment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AttachmentToProto(res), nil
+}
+
+// HasDiffApigeeBetaAttachment handles the gRPC request by passing it to the underlying Attachment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AttachmentServer) HasDiffApigeeBetaAttachment(ctx context.Context, request *betapb.HasDiffApigeeBetaAttachmentRequest) (*betapb.HasDiffApigeeBetaAttachmentResponse, error) {
+	cl, err := createConfigAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAttachment(ctx, ProtoToAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffApigeeBetaAttachmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeBetaAttachment handles the gRPC request by passing it to the underlying Attachment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AttachmentServer) PlanApigeeBetaAttachment(ctx context.Context, request *betapb.PlanApigeeBetaAttachmentRequest) (*betapb.PlanApigeeBetaAttachmentResponse, error) {
+	cl, err := createConfigAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAttachment(ctx, ProtoToAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanApigeeBetaAttachmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAttachment handles the gRPC request by passing it to the underlying Attachment Delete() method.
 func (s *AttachmentServer) DeleteApigeeBetaAttachment(ctx context.Context, request *betapb.DeleteApigeeBetaAttachmentRequest) (*emptypb.Empty, error) {
 
@@ -84,22 +143,35 @@ func (s *AttachmentServer) ListApigeeBetaAttachment(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListAttachment(ctx, request.GetEnvgroup())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.AttachmentMaxPage
+	}
+	resources, err := cl.ListAttachmentWithPageToken(ctx, request.GetEnvgroup(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ApigeeBetaAttachment
-	for _, r := range resources.Items {
-		rp := AttachmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AttachmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListApigeeBetaAttachmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAttachment(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
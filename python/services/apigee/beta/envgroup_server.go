@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/beta/apigee_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee/beta"
@@ -82,7 +83,11 @@ func EnvgroupToProto(resource *beta.Envgroup) *betapb.ApigeeBetaEnvgroup {
 // applyEnvgroup handles the gRPC request by passing it to the underlying Envgroup Apply() method.
 func (s *EnvgroupServer) applyEnvgroup(ctx context.Context, c *beta.Client, request *betapb.ApplyApigeeBetaEnvgroupRequest) (*betapb.ApigeeBetaEnvgroup, error) {
 	p := ProtoToEnvgroup(request.GetResource())
-	res, err := c.ApplyEnvgroup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEnvgroup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +104,60 @@ func (s *EnvgroupServer) ApplyApigeeBetaEnvgroup(ctx context.Context, request *b
 	return s.applyEnvgroup(ctx, cl, request)
 }
 
+// GetApigeeBetaEnvgroup handles the gRPC request by passing it to the underlying Envgroup Get() method.
+func (s *EnvgroupServer) GetApigeeBetaEnvgroup(ctx context.Context, request *betapb.GetApigeeBetaEnvgroupRequest) (*betapb.ApigeeBetaEnvgroup, error) {
+	cl, err := createConfigEnvgroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEnvgroup(ctx, ProtoToEnvgroup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EnvgroupToProto(res), nil
+}
+
+// HasDiffApigeeBetaEnvgroup handles the gRPC request by passing it to the underlying Envgroup Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EnvgroupServer) HasDiffApigeeBetaEnvgroup(ctx context.Context, request *betapb.HasDiffApigeeBetaEnvgroupRequest) (*betapb.HasDiffApigeeBetaEnvgroupResponse, error) {
+	cl, err := createConfigEnvgroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvgroup(ctx, ProtoToEnvgroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffApigeeBetaEnvgroupResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeBetaEnvgroup handles the gRPC request by passing it to the underlying Envgroup Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EnvgroupServer) PlanApigeeBetaEnvgroup(ctx context.Context, request *betapb.PlanApigeeBetaEnvgroupRequest) (*betapb.PlanApigeeBetaEnvgroupResponse, error) {
+	cl, err := createConfigEnvgroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvgroup(ctx, ProtoToEnvgroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanApigeeBetaEnvgroupResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEnvgroup handles the gRPC request by passing it to the underlying Envgroup Delete() method.
 func (s *EnvgroupServer) DeleteApigeeBetaEnvgroup(ctx context.Context, request *betapb.DeleteApigeeBetaEnvgroupRequest) (*emptypb.Empty, error) {
 
@@ -117,22 +176,35 @@ func (s *EnvgroupServer) ListApigeeBetaEnvgroup(ctx context.Context, request *be
 		return nil, err
 	}
 
-	resources, err := cl.ListEnvgroup(ctx, request.GetApigeeOrganization())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.EnvgroupMaxPage
+	}
+	resources, err := cl.ListEnvgroupWithPageToken(ctx, request.GetApigeeOrganization(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ApigeeBetaEnvgroup
-	for _, r := range resources.Items {
-		rp := EnvgroupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EnvgroupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListApigeeBetaEnvgroupResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEnvgroup(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
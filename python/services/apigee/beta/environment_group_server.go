@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/beta/apigee_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee/beta"
@@ -82,7 +83,11 @@ func EnvironmentGroupToProto(resource *beta.EnvironmentGroup) *betapb.ApigeeBeta
 // applyEnvironmentGroup handles the gRPC request by passing it to the underlying EnvironmentGroup Apply() method.
 func (s *EnvironmentGroupServer) applyEnvironmentGroup(ctx context.Context, c *beta.Client, request *betapb.ApplyApigeeBetaEnvironmentGroupRequest) (*betapb.ApigeeBetaEnvironmentGroup, error) {
 	p := ProtoToEnvironmentGroup(request.GetResource())
-	res, err := c.ApplyEnvironmentGroup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEnvironmentGroup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +104,60 @@ func (s *EnvironmentGroupServer) ApplyApigeeBetaEnvironmentGroup(ctx context.Con
 	return s.applyEnvironmentGroup(ctx, cl, request)
 }
 
+// GetApigeeBetaEnvironmentGroup handles the gRPC request by passing it to the underlying EnvironmentGroup Get() method.
+func (s *EnvironmentGroupServer) GetApigeeBetaEnvironmentGroup(ctx context.Context, request *betapb.GetApigeeBetaEnvironmentGroupRequest) (*betapb.ApigeeBetaEnvironmentGroup, error) {
+	cl, err := createConfigEnvironmentGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEnvironmentGroup(ctx, ProtoToEnvironmentGroup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EnvironmentGroupToProto(res), nil
+}
+
+// HasDiffApigeeBetaEnvironmentGroup handles the gRPC request by passing it to the underlying EnvironmentGroup Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EnvironmentGroupServer) HasDiffApigeeBetaEnvironmentGroup(ctx context.Context, request *betapb.HasDiffApigeeBetaEnvironmentGroupRequest) (*betapb.HasDiffApigeeBetaEnvironmentGroupResponse, error) {
+	cl, err := createConfigEnvironmentGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironmentGroup(ctx, ProtoToEnvironmentGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffApigeeBetaEnvironmentGroupResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeBetaEnvironmentGroup handles the gRPC request by passing it to the underlying EnvironmentGroup Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EnvironmentGroupServer) PlanApigeeBetaEnvironmentGroup(ctx context.Context, request *betapb.PlanApigeeBetaEnvironmentGroupRequest) (*betapb.PlanApigeeBetaEnvironmentGroupResponse, error) {
+	cl, err := createConfigEnvironmentGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironmentGroup(ctx, ProtoToEnvironmentGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanApigeeBetaEnvironmentGroupResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEnvironmentGroup handles the gRPC request by passing it to the underlying EnvironmentGroup Delete() method.
 func (s *EnvironmentGroupServer) DeleteApigeeBetaEnvironmentGroup(ctx context.Context, request *betapb.DeleteApigeeBetaEnvironmentGroupRequest) (*emptypb.Empty, error) {
 
@@ -117,22 +176,35 @@ func (s *EnvironmentGroupServer) ListApigeeBetaEnvironmentGroup(ctx context.Cont
 		return nil, err
 	}
 
-	resources, err := cl.ListEnvironmentGroup(ctx, request.GetApigeeOrganization())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.EnvironmentGroupMaxPage
+	}
+	resources, err := cl.ListEnvironmentGroupWithPageToken(ctx, request.GetApigeeOrganization(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ApigeeBetaEnvironmentGroup
-	for _, r := range resources.Items {
-		rp := EnvironmentGroupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EnvironmentGroupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListApigeeBetaEnvironmentGroupResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEnvironmentGroup(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
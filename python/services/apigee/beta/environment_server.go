@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/beta/apigee_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee/beta"
@@ -83,7 +84,11 @@ func EnvironmentToProto(resource *beta.Environment) *betapb.ApigeeBetaEnvironmen
 // applyEnvironment handles the gRPC request by passing it to the underlying Environment Apply() method.
 func (s *EnvironmentServer) applyEnvironment(ctx context.Context, c *beta.Client, request *betapb.ApplyApigeeBetaEnvironmentRequest) (*betapb.ApigeeBetaEnvironment, error) {
 	p := ProtoToEnvironment(request.GetResource())
-	res, err := c.ApplyEnvironment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEnvironment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +105,60 @@ func (s *EnvironmentServer) ApplyApigeeBetaEnvironment(ctx context.Context, requ
 	return s.applyEnvironment(ctx, cl, request)
 }
 
+// GetApigeeBetaEnvironment handles the gRPC request by passing it to the underlying Environment Get() method.
+func (s *EnvironmentServer) GetApigeeBetaEnvironment(ctx context.Context, request *betapb.GetApigeeBetaEnvironmentRequest) (*betapb.ApigeeBetaEnvironment, error) {
+	cl, err := createConfigEnvironment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEnvironment(ctx, ProtoToEnvironment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EnvironmentToProto(res), nil
+}
+
+// HasDiffApigeeBetaEnvironment handles the gRPC request by passing it to the underlying Environment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EnvironmentServer) HasDiffApigeeBetaEnvironment(ctx context.Context, request *betapb.HasDiffApigeeBetaEnvironmentRequest) (*betapb.HasDiffApigeeBetaEnvironmentResponse, error) {
+	cl, err := createConfigEnvironment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironment(ctx, ProtoToEnvironment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffApigeeBetaEnvironmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeBetaEnvironment handles the gRPC request by passing it to the underlying Environment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EnvironmentServer) PlanApigeeBetaEnvironment(ctx context.Context, request *betapb.PlanApigeeBetaEnvironmentRequest) (*betapb.PlanApigeeBetaEnvironmentResponse, error) {
+	cl, err := createConfigEnvironment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironment(ctx, ProtoToEnvironment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanApigeeBetaEnvironmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEnvironment handles the gRPC request by passing it to the underlying Environment Delete() method.
 func (s *EnvironmentServer) DeleteApigeeBetaEnvironment(ctx context.Context, request *betapb.DeleteApigeeBetaEnvironmentRequest) (*emptypb.Empty, error) {
 
@@ -118,22 +177,35 @@ func (s *EnvironmentServer) ListApigeeBetaEnvironment(ctx context.Context, reque
 		return nil, err
 	}
 
-	resources, err := cl.ListEnvironment(ctx, request.GetApigeeOrganization())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.EnvironmentMaxPage
+	}
+	resources, err := cl.ListEnvironmentWithPageToken(ctx, request.GetApigeeOrganization(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ApigeeBetaEnvironment
-	for _, r := range resources.Items {
-		rp := EnvironmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EnvironmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListApigeeBetaEnvironmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEnvironment(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/beta/apigee_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee/beta"
@@ -111,7 +112,11 @@ func InstanceToProto(resource *beta.Instance) *betapb.ApigeeBetaInstance {
 // applyInstance handles the gRPC request by passing it to the underlying Instance Apply() method.
 func (s *InstanceServer) applyInstance(ctx context.Context, c *beta.Client, request *betapb.ApplyApigeeBetaInstanceRequest) (*betapb.ApigeeBetaInstance, error) {
 	p := ProtoToInstance(request.GetResource())
-	res, err := c.ApplyInstance(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstance(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -128,6 +133,60 @@ func (s *InstanceServer) ApplyApigeeBetaInstance(ctx context.Context, request *b
 	return s.applyInstance(ctx, cl, request)
 }
 
+// GetApigeeBetaInstance handles the gRPC request by passing it to the underlying Instance Get() method.
+func (s *InstanceServer) GetApigeeBetaInstance(ctx context.Context, request *betapb.GetApigeeBetaInstanceRequest) (*betapb.ApigeeBetaInstance, error) {
+	cl, err := createConfigInstance(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstance(ctx, ProtoToInstance(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToProto(res), nil
+}
+
+// HasDiffApigeeBetaInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceServer) HasDiffApigeeBetaInstance(ctx context.Context, request *betapb.HasDiffApigeeBetaInstanceRequest) (*betapb.HasDiffApigeeBetaInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffApigeeBetaInstanceResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeBetaInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceServer) PlanApigeeBetaInstance(ctx context.Context, request *betapb.PlanApigeeBetaInstanceRequest) (*betapb.PlanApigeeBetaInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanApigeeBetaInstanceResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInstance handles the gRPC request by passing it to the underlying Instance Delete() method.
 func (s *InstanceServer) DeleteApigeeBetaInstance(ctx context.Context, request *betapb.DeleteApigeeBetaInstanceRequest) (*emptypb.Empty, error) {
 
@@ -146,22 +205,35 @@ func (s *InstanceServer) ListApigeeBetaInstance(ctx context.Context, request *be
 		return nil, err
 	}
 
-	resources, err := cl.ListInstance(ctx, request.GetApigeeOrganization())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.InstanceMaxPage
+	}
+	resources, err := cl.ListInstanceWithPageToken(ctx, request.GetApigeeOrganization(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ApigeeBetaInstance
-	for _, r := range resources.Items {
-		rp := InstanceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListApigeeBetaInstanceResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInstance(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/beta/apigee_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee/beta"
@@ -245,7 +246,11 @@ func OrganizationToProto(resource *beta.Organization) *betapb.ApigeeBetaOrganiza
 // applyOrganization handles the gRPC request by passing it to the underlying Organization Apply() method.
 func (s *OrganizationServer) applyOrganization(ctx context.Context, c *beta.Client, request *betapb.ApplyApigeeBetaOrganizationRequest) (*betapb.ApigeeBetaOrganization, error) {
 	p := ProtoToOrganization(request.GetResource())
-	res, err := c.ApplyOrganization(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyOrganization(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -262,6 +267,60 @@ func (s *OrganizationServer) ApplyApigeeBetaOrganization(ctx context.Context, re
 	return s.applyOrganization(ctx, cl, request)
 }
 
+// GetApigeeBetaOrganization handles the gRPC request by passing it to the underlying Organization Get() method.
+func (s *OrganizationServer) GetApigeeBetaOrganization(ctx context.Context, request *betapb.GetApigeeBetaOrganizationRequest) (*betapb.ApigeeBetaOrganization, error) {
+	cl, err := createConfigOrganization(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetOrganization(ctx, ProtoToOrganization(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return OrganizationToProto(res), nil
+}
+
+// HasDiffApigeeBetaOrganization handles the gRPC request by passing it to the underlying Organization Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *OrganizationServer) HasDiffApigeeBetaOrganization(ctx context.Context, request *betapb.HasDiffApigeeBetaOrganizationRequest) (*betapb.HasDiffApigeeBetaOrganizationResponse, error) {
+	cl, err := createConfigOrganization(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffOrganization(ctx, ProtoToOrganization(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffApigeeBetaOrganizationResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeBetaOrganization handles the gRPC request by passing it to the underlying Organization Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *OrganizationServer) PlanApigeeBetaOrganization(ctx context.Context, request *betapb.PlanApigeeBetaOrganizationRequest) (*betapb.PlanApigeeBetaOrganizationResponse, error) {
+	cl, err := createConfigOrganization(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffOrganization(ctx, ProtoToOrganization(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanApigeeBetaOrganizationResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteOrganization handles the gRPC request by passing it to the underlying Organization Delete() method.
 func (s *OrganizationServer) DeleteApigeeBetaOrganization(ctx context.Context, request *betapb.DeleteApigeeBetaOrganizationRequest) (*emptypb.Empty, error) {
 
@@ -280,22 +339,35 @@ func (s *OrganizationServer) ListApigeeBetaOrganization(ctx context.Context, req
 		return nil, err
 	}
 
-	resources, err := cl.ListOrganization(ctx)
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.OrganizationMaxPage
+	}
+	resources, err := cl.ListOrganizationWithPageToken(ctx, pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ApigeeBetaOrganization
-	for _, r := range resources.Items {
-		rp := OrganizationToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, OrganizationToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListApigeeBetaOrganizationResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigOrganization(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
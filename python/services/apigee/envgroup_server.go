@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	apigeepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/apigee_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee"
@@ -82,7 +83,11 @@ func EnvgroupToProto(resource *apigee.Envgroup) *apigeepb.ApigeeEnvgroup {
 // applyEnvgroup handles the gRPC request by passing it to the underlying Envgroup Apply() method.
 func (s *EnvgroupServer) applyEnvgroup(ctx context.Context, c *apigee.Client, request *apigeepb.ApplyApigeeEnvgroupRequest) (*apigeepb.ApigeeEnvgroup, error) {
 	p := ProtoToEnvgroup(request.GetResource())
-	res, err := c.ApplyEnvgroup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEnvgroup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +104,60 @@ func (s *EnvgroupServer) ApplyApigeeEnvgroup(ctx context.Context, request *apige
 	return s.applyEnvgroup(ctx, cl, request)
 }
 
+// GetApigeeEnvgroup handles the gRPC request by passing it to the underlying Envgroup Get() method.
+func (s *EnvgroupServer) GetApigeeEnvgroup(ctx context.Context, request *apigeepb.GetApigeeEnvgroupRequest) (*apigeepb.ApigeeEnvgroup, error) {
+	cl, err := createConfigEnvgroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEnvgroup(ctx, ProtoToEnvgroup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EnvgroupToProto(res), nil
+}
+
+// HasDiffApigeeEnvgroup handles the gRPC request by passing it to the underlying Envgroup Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EnvgroupServer) HasDiffApigeeEnvgroup(ctx context.Context, request *apigeepb.HasDiffApigeeEnvgroupRequest) (*apigeepb.HasDiffApigeeEnvgroupResponse, error) {
+	cl, err := createConfigEnvgroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvgroup(ctx, ProtoToEnvgroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &apigeepb.HasDiffApigeeEnvgroupResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeEnvgroup handles the gRPC request by passing it to the underlying Envgroup Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EnvgroupServer) PlanApigeeEnvgroup(ctx context.Context, request *apigeepb.PlanApigeeEnvgroupRequest) (*apigeepb.PlanApigeeEnvgroupResponse, error) {
+	cl, err := createConfigEnvgroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvgroup(ctx, ProtoToEnvgroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &apigeepb.PlanApigeeEnvgroupResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEnvgroup handles the gRPC request by passing it to the underlying Envgroup Delete() method.
 func (s *EnvgroupServer) DeleteApigeeEnvgroup(ctx context.Context, request *apigeepb.DeleteApigeeEnvgroupRequest) (*emptypb.Empty, error) {
 
@@ -117,22 +176,35 @@ func (s *EnvgroupServer) ListApigeeEnvgroup(ctx context.Context, request *apigee
 		return nil, err
 	}
 
-	resources, err := cl.ListEnvgroup(ctx, request.GetApigeeOrganization())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = apigee.EnvgroupMaxPage
+	}
+	resources, err := cl.ListEnvgroupWithPageToken(ctx, request.GetApigeeOrganization(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*apigeepb.ApigeeEnvgroup
-	for _, r := range resources.Items {
-		rp := EnvgroupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EnvgroupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &apigeepb.ListApigeeEnvgroupResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEnvgroup(ctx context.Context, service_account_file string) (*apigee.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return apigee.NewClient(conf), nil
 }
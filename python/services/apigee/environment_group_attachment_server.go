@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	apigeepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/apigee_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee"
@@ -49,7 +50,11 @@ func EnvironmentGroupAttachmentToProto(resource *apigee.EnvironmentGroupAttachme
 // applyEnvironmentGroupAttachment handles the gRPC request by passing it to the underlying EnvironmentGroupAttachment Apply() method.
 func (s *EnvironmentGroupAttachmentServer) applyEnvironmentGroupAttachment(ctx context.Context, c *apigee.Client, request *apigeepb.ApplyApigeeEnvironmentGroupAttachmentRequest) (*apigeepb.ApigeeEnvironmentGroupAttachment, error) {
 	p := ProtoToEnvironmentGroupAttachment(request.GetResource())
-	res, err := c.ApplyEnvironmentGroupAttachment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEnvironmentGroupAttachment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +71,60 @@ func (s *EnvironmentGroupAttachmentServer) ApplyApigeeEnvironmentGroupAttachment
 	return s.applyEnvironmentGroupAttachment(ctx, cl, request)
 }
 
+// GetApigeeEnvironmentGroupAttachment handles the gRPC request by passing it to the underlying EnvironmentGroupAttachment Get() method.
+func (s *EnvironmentGroupAttachmentServer) GetApigeeEnvironmentGroupAttachment(ctx context.Context, request *apigeepb.GetApigeeEnvironmentGroupAttachmentRequest) (*apigeepb.ApigeeEnvironmentGroupAttachment, error) {
+	cl, err := createConfigEnvironmentGroupAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEnvironmentGroupAttachment(ctx, ProtoToEnvironmentGroupAttachment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EnvironmentGroupAttachmentToProto(res), nil
+}
+
+// HasDiffApigeeEnvironmentGroupAttachment handles the gRPC request by passing it to the underlying EnvironmentGroupAttachment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EnvironmentGroupAttachmentServer) HasDiffApigeeEnvironmentGroupAttachment(ctx context.Context, request *apigeepb.HasDiffApigeeEnvironmentGroupAttachmentRequest) (*apigeepb.HasDiffApigeeEnvironmentGroupAttachmentResponse, error) {
+	cl, err := createConfigEnvironmentGroupAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironmentGroupAttachment(ctx, ProtoToEnvironmentGroupAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &apigeepb.HasDiffApigeeEnvironmentGroupAttachmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeEnvironmentGroupAttachment handles the gRPC request by passing it to the underlying EnvironmentGroupAttachment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EnvironmentGroupAttachmentServer) PlanApigeeEnvironmentGroupAttachment(ctx context.Context, request *apigeepb.PlanApigeeEnvironmentGroupAttachmentRequest) (*apigeepb.PlanApigeeEnvironmentGroupAttachmentResponse, error) {
+	cl, err := createConfigEnvironmentGroupAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironmentGroupAttachment(ctx, ProtoToEnvironmentGroupAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &apigeepb.PlanApigeeEnvironmentGroupAttachmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEnvironmentGroupAttachment handles the gRPC request by passing it to the underlying EnvironmentGroupAttachment Delete() method.
 func (s *EnvironmentGroupAttachmentServer) DeleteApigeeEnvironmentGroupAttachment(ctx context.Context, request *apigeepb.DeleteApigeeEnvironmentGroupAttachmentRequest) (*emptypb.Empty, error) {
 
@@ -84,22 +143,35 @@ func (s *EnvironmentGroupAttachmentServer) ListApigeeEnvironmentGroupAttachment(
 		return nil, err
 	}
 
-	resources, err := cl.ListEnvironmentGroupAttachment(ctx, request.GetEnvgroup())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = apigee.EnvironmentGroupAttachmentMaxPage
+	}
+	resources, err := cl.ListEnvironmentGroupAttachmentWithPageToken(ctx, request.GetEnvgroup(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*apigeepb.ApigeeEnvironmentGroupAttachment
-	for _, r := range resources.Items {
-		rp := EnvironmentGroupAttachmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EnvironmentGroupAttachmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &apigeepb.ListApigeeEnvironmentGroupAttachmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEnvironmentGroupAttachment(ctx context.Context, service_account_file string) (*apigee.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return apigee.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	apigeepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/apigee_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee"
@@ -82,7 +83,11 @@ func EnvironmentGroupToProto(resource *apigee.EnvironmentGroup) *apigeepb.Apigee
 // applyEnvironmentGroup handles the gRPC request by passing it to the underlying EnvironmentGroup Apply() method.
 func (s *EnvironmentGroupServer) applyEnvironmentGroup(ctx context.Context, c *apigee.Client, request *apigeepb.ApplyApigeeEnvironmentGroupRequest) (*apigeepb.ApigeeEnvironmentGroup, error) {
 	p := ProtoToEnvironmentGroup(request.GetResource())
-	res, err := c.ApplyEnvironmentGroup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEnvironmentGroup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +104,60 @@ func (s *EnvironmentGroupServer) ApplyApigeeEnvironmentGroup(ctx context.Context
 	return s.applyEnvironmentGroup(ctx, cl, request)
 }
 
+// GetApigeeEnvironmentGroup handles the gRPC request by passing it to the underlying EnvironmentGroup Get() method.
+func (s *EnvironmentGroupServer) GetApigeeEnvironmentGroup(ctx context.Context, request *apigeepb.GetApigeeEnvironmentGroupRequest) (*apigeepb.ApigeeEnvironmentGroup, error) {
+	cl, err := createConfigEnvironmentGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEnvironmentGroup(ctx, ProtoToEnvironmentGroup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EnvironmentGroupToProto(res), nil
+}
+
+// HasDiffApigeeEnvironmentGroup handles the gRPC request by passing it to the underlying EnvironmentGroup Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EnvironmentGroupServer) HasDiffApigeeEnvironmentGroup(ctx context.Context, request *apigeepb.HasDiffApigeeEnvironmentGroupRequest) (*apigeepb.HasDiffApigeeEnvironmentGroupResponse, error) {
+	cl, err := createConfigEnvironmentGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironmentGroup(ctx, ProtoToEnvironmentGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &apigeepb.HasDiffApigeeEnvironmentGroupResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeEnvironmentGroup handles the gRPC request by passing it to the underlying EnvironmentGroup Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EnvironmentGroupServer) PlanApigeeEnvironmentGroup(ctx context.Context, request *apigeepb.PlanApigeeEnvironmentGroupRequest) (*apigeepb.PlanApigeeEnvironmentGroupResponse, error) {
+	cl, err := createConfigEnvironmentGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironmentGroup(ctx, ProtoToEnvironmentGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &apigeepb.PlanApigeeEnvironmentGroupResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEnvironmentGroup handles the gRPC request by passing it to the underlying EnvironmentGroup Delete() method.
 func (s *EnvironmentGroupServer) DeleteApigeeEnvironmentGroup(ctx context.Context, request *apigeepb.DeleteApigeeEnvironmentGroupRequest) (*emptypb.Empty, error) {
 
@@ -117,22 +176,35 @@ func (s *EnvironmentGroupServer) ListApigeeEnvironmentGroup(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListEnvironmentGroup(ctx, request.GetApigeeOrganization())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = apigee.EnvironmentGroupMaxPage
+	}
+	resources, err := cl.ListEnvironmentGroupWithPageToken(ctx, request.GetApigeeOrganization(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*apigeepb.ApigeeEnvironmentGroup
-	for _, r := range resources.Items {
-		rp := EnvironmentGroupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EnvironmentGroupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &apigeepb.ListApigeeEnvironmentGroupResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEnvironmentGroup(ctx context.Context, service_account_file string) (*apigee.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return apigee.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	apigeepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apigee/apigee_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apigee"
@@ -111,7 +112,11 @@ func InstanceToProto(resource *apigee.Instance) *apigeepb.ApigeeInstance {
 // applyInstance handles the gRPC request by passing it to the underlying Instance Apply() method.
 func (s *InstanceServer) applyInstance(ctx context.Context, c *apigee.Client, request *apigeepb.ApplyApigeeInstanceRequest) (*apigeepb.ApigeeInstance, error) {
 	p := ProtoToInstance(request.GetResource())
-	res, err := c.ApplyInstance(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstance(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -128,6 +133,60 @@ func (s *InstanceServer) ApplyApigeeInstance(ctx context.Context, request *apige
 	return s.applyInstance(ctx, cl, request)
 }
 
+// GetApigeeInstance handles the gRPC request by passing it to the underlying Instance Get() method.
+func (s *InstanceServer) GetApigeeInstance(ctx context.Context, request *apigeepb.GetApigeeInstanceRequest) (*apigeepb.ApigeeInstance, error) {
+	cl, err := createConfigInstance(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstance(ctx, ProtoToInstance(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToProto(res), nil
+}
+
+// HasDiffApigeeInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceServer) HasDiffApigeeInstance(ctx context.Context, request *apigeepb.HasDiffApigeeInstanceRequest) (*apigeepb.HasDiffApigeeInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &apigeepb.HasDiffApigeeInstanceResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApigeeInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceServer) PlanApigeeInstance(ctx context.Context, request *apigeepb.PlanApigeeInstanceRequest) (*apigeepb.PlanApigeeInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &apigeepb.PlanApigeeInstanceResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInstance handles the gRPC request by passing it to the underlying Instance Delete() method.
 func (s *InstanceServer) DeleteApigeeInstance(ctx context.Context, request *apigeepb.DeleteApigeeInstanceRequest) (*emptypb.Empty, error) {
 
@@ -146,22 +205,35 @@ func (s *InstanceServer) ListApigeeInstance(ctx context.Context, request *apigee
 		return nil, err
 	}
 
-	resources, err := cl.ListInstance(ctx, request.GetApigeeOrganization())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = apigee.InstanceMaxPage
+	}
+	resources, err := cl.ListInstanceWithPageToken(ctx, request.GetApigeeOrganization(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*apigeepb.ApigeeInstance
-	for _, r := range resources.Items {
-		rp := InstanceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &apigeepb.ListApigeeInstanceResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInstance(ctx context.Context, service_account_file string) (*apigee.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return apigee.NewClient(conf), nil
 }
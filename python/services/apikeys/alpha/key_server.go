@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/apikeys/alpha/apikeys_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/apikeys/alpha"
@@ -244,7 +245,11 @@ func KeyToProto(resource *alpha.Key) *alphapb.ApikeysAlphaKey {
 // applyKey handles the gRPC request by passing it to the underlying Key Apply() method.
 func (s *KeyServer) applyKey(ctx context.Context, c *alpha.Client, request *alphapb.ApplyApikeysAlphaKeyRequest) (*alphapb.ApikeysAlphaKey, error) {
 	p := ProtoToKey(request.GetResource())
-	res, err := c.ApplyKey(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyKey(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -261,6 +266,60 @@ func (s *KeyServer) ApplyApikeysAlphaKey(ctx context.Context, request *alphapb.A
 	return s.applyKey(ctx, cl, request)
 }
 
+// GetApikeysAlphaKey handles the gRPC request by passing it to the underlying Key Get() method.
+func (s *KeyServer) GetApikeysAlphaKey(ctx context.Context, request *alphapb.GetApikeysAlphaKeyRequest) (*alphapb.ApikeysAlphaKey, error) {
+	cl, err := createConfigKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetKey(ctx, ProtoToKey(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return KeyToProto(res), nil
+}
+
+// HasDiffApikeysAlphaKey handles the gRPC request by passing it to the underlying Key Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *KeyServer) HasDiffApikeysAlphaKey(ctx context.Context, request *alphapb.HasDiffApikeysAlphaKeyRequest) (*alphapb.HasDiffApikeysAlphaKeyResponse, error) {
+	cl, err := createConfigKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKey(ctx, ProtoToKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffApikeysAlphaKeyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanApikeysAlphaKey handles the gRPC request by passing it to the underlying Key Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *KeyServer) PlanApikeysAlphaKey(ctx context.Context, request *alphapb.PlanApikeysAlphaKeyRequest) (*alphapb.PlanApikeysAlphaKeyResponse, error) {
+	cl, err := createConfigKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKey(ctx, ProtoToKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanApikeysAlphaKeyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteKey handles the gRPC request by passing it to the underlying Key Delete() method.
 func (s *KeyServer) DeleteApikeysAlphaKey(ctx context.Context, request *alphapb.DeleteApikeysAlphaKeyRequest) (*emptypb.Empty, error) {
 
@@ -279,22 +338,35 @@ func (s *KeyServer) ListApikeysAlphaKey(ctx context.Context, request *alphapb.Li
 		return nil, err
 	}
 
-	resources, err := cl.ListKey(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.KeyMaxPage
+	}
+	resources, err := cl.ListKeyWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ApikeysAlphaKey
-	for _, r := range resources.Items {
-		rp := KeyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, KeyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListApikeysAlphaKeyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigKey(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -18,6 +18,7 @@ import (
 	"errors"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	appenginepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/appengine/appengine_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/appengine"
@@ -198,7 +199,11 @@ func ApplicationToProto(resource *appengine.Application) *appenginepb.AppengineA
 // ApplyApplication handles the gRPC request by passing it to the underlying Application Apply() method.
 func (s *ApplicationServer) applyApplication(ctx context.Context, c *appengine.Client, request *appenginepb.ApplyAppengineApplicationRequest) (*appenginepb.AppengineApplication, error) {
 	p := ProtoToApplication(request.GetResource())
-	res, err := c.ApplyApplication(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyApplication(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +220,55 @@ func (s *ApplicationServer) ApplyAppengineApplication(ctx context.Context, reque
 	return s.applyApplication(ctx, cl, request)
 }
 
+// GetAppengineApplication handles the gRPC request by passing it to the underlying Application Get() method.
+func (s *ApplicationServer) GetAppengineApplication(ctx context.Context, request *appenginepb.GetAppengineApplicationRequest) (*appenginepb.AppengineApplication, error) {
+	cl, err := createConfigApplication(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetApplication(ctx, ProtoToApplication(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ApplicationToProto(res), nil
+}
+
+// HasDiffAppengineApplication handles the gRPC request by passing it to the underlying Application Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ApplicationServer) HasDiffAppengineApplication(ctx context.Context, request *appenginepb.HasDiffAppengineApplicationRequest) (*appenginepb.HasDiffAppengineApplicationResponse, error) {
+	cl, err := createConfigApplication(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffApplication(ctx, ProtoToApplication(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &appenginepb.HasDiffAppengineApplicationResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanAppengineApplication handles the gRPC request by passing it to the underlying Application Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ApplicationServer) PlanAppengineApplication(ctx context.Context, request *appenginepb.PlanAppengineApplicationRequest) (*appenginepb.PlanAppengineApplicationResponse, error) {
+	cl, err := createConfigApplication(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffApplication(ctx, ProtoToApplication(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &appenginepb.PlanAppengineApplicationResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteApplication handles the gRPC request by passing it to the underlying Application Delete() method.
 func (s *ApplicationServer) DeleteAppengineApplication(ctx context.Context, request *appenginepb.DeleteAppengineApplicationRequest) (*emptypb.Empty, error) {
 
@@ -228,7 +282,9 @@ func (s *ApplicationServer) ListAppengineApplication(_ context.Context, _ *appen
 }
 
 func createConfigApplication(ctx context.Context, service_account_file string) (*appengine.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return appengine.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	appenginepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/appengine/appengine_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/appengine"
@@ -155,7 +156,11 @@ func DomainMappingToProto(resource *appengine.DomainMapping) *appenginepb.Appeng
 // ApplyDomainMapping handles the gRPC request by passing it to the underlying DomainMapping Apply() method.
 func (s *DomainMappingServer) applyDomainMapping(ctx context.Context, c *appengine.Client, request *appenginepb.ApplyAppengineDomainMappingRequest) (*appenginepb.AppengineDomainMapping, error) {
 	p := ProtoToDomainMapping(request.GetResource())
-	res, err := c.ApplyDomainMapping(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDomainMapping(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +177,55 @@ func (s *DomainMappingServer) ApplyAppengineDomainMapping(ctx context.Context, r
 	return s.applyDomainMapping(ctx, cl, request)
 }
 
+// GetAppengineDomainMapping handles the gRPC request by passing it to the underlying DomainMapping Get() method.
+func (s *DomainMappingServer) GetAppengineDomainMapping(ctx context.Context, request *appenginepb.GetAppengineDomainMappingRequest) (*appenginepb.AppengineDomainMapping, error) {
+	cl, err := createConfigDomainMapping(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDomainMapping(ctx, ProtoToDomainMapping(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DomainMappingToProto(res), nil
+}
+
+// HasDiffAppengineDomainMapping handles the gRPC request by passing it to the underlying DomainMapping Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DomainMappingServer) HasDiffAppengineDomainMapping(ctx context.Context, request *appenginepb.HasDiffAppengineDomainMappingRequest) (*appenginepb.HasDiffAppengineDomainMappingResponse, error) {
+	cl, err := createConfigDomainMapping(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDomainMapping(ctx, ProtoToDomainMapping(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &appenginepb.HasDiffAppengineDomainMappingResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanAppengineDomainMapping handles the gRPC request by passing it to the underlying DomainMapping Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DomainMappingServer) PlanAppengineDomainMapping(ctx context.Context, request *appenginepb.PlanAppengineDomainMappingRequest) (*appenginepb.PlanAppengineDomainMappingResponse, error) {
+	cl, err := createConfigDomainMapping(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDomainMapping(ctx, ProtoToDomainMapping(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &appenginepb.PlanAppengineDomainMappingResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteDomainMapping handles the gRPC request by passing it to the underlying DomainMapping Delete() method.
 func (s *DomainMappingServer) DeleteAppengineDomainMapping(ctx context.Context, request *appenginepb.DeleteAppengineDomainMappingRequest) (*emptypb.Empty, error) {
 
@@ -190,20 +244,32 @@ func (s *DomainMappingServer) ListAppengineDomainMapping(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListDomainMapping(ctx, request.App)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = appengine.DomainMappingMaxPage
+	}
+	resources, err := cl.ListDomainMappingWithPageToken(ctx, request.App, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*appenginepb.AppengineDomainMapping
-	for _, r := range resources.Items {
-		rp := DomainMappingToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DomainMappingToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &appenginepb.ListAppengineDomainMappingResponse{Items: protos}, nil
+	return &appenginepb.ListAppengineDomainMappingResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigDomainMapping(ctx context.Context, service_account_file string) (*appengine.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return appengine.NewClient(conf), nil
 }
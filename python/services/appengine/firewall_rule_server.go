@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	appenginepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/appengine/appengine_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/appengine"
@@ -76,7 +77,11 @@ func FirewallRuleToProto(resource *appengine.FirewallRule) *appenginepb.Appengin
 // ApplyFirewallRule handles the gRPC request by passing it to the underlying FirewallRule Apply() method.
 func (s *FirewallRuleServer) applyFirewallRule(ctx context.Context, c *appengine.Client, request *appenginepb.ApplyAppengineFirewallRuleRequest) (*appenginepb.AppengineFirewallRule, error) {
 	p := ProtoToFirewallRule(request.GetResource())
-	res, err := c.ApplyFirewallRule(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFirewallRule(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +98,55 @@ func (s *FirewallRuleServer) ApplyAppengineFirewallRule(ctx context.Context, req
 	return s.applyFirewallRule(ctx, cl, request)
 }
 
+// GetAppengineFirewallRule handles the gRPC request by passing it to the underlying FirewallRule Get() method.
+func (s *FirewallRuleServer) GetAppengineFirewallRule(ctx context.Context, request *appenginepb.GetAppengineFirewallRuleRequest) (*appenginepb.AppengineFirewallRule, error) {
+	cl, err := createConfigFirewallRule(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFirewallRule(ctx, ProtoToFirewallRule(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FirewallRuleToProto(res), nil
+}
+
+// HasDiffAppengineFirewallRule handles the gRPC request by passing it to the underlying FirewallRule Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FirewallRuleServer) HasDiffAppengineFirewallRule(ctx context.Context, request *appenginepb.HasDiffAppengineFirewallRuleRequest) (*appenginepb.HasDiffAppengineFirewallRuleResponse, error) {
+	cl, err := createConfigFirewallRule(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallRule(ctx, ProtoToFirewallRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &appenginepb.HasDiffAppengineFirewallRuleResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanAppengineFirewallRule handles the gRPC request by passing it to the underlying FirewallRule Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FirewallRuleServer) PlanAppengineFirewallRule(ctx context.Context, request *appenginepb.PlanAppengineFirewallRuleRequest) (*appenginepb.PlanAppengineFirewallRuleResponse, error) {
+	cl, err := createConfigFirewallRule(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallRule(ctx, ProtoToFirewallRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &appenginepb.PlanAppengineFirewallRuleResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteFirewallRule handles the gRPC request by passing it to the underlying FirewallRule Delete() method.
 func (s *FirewallRuleServer) DeleteAppengineFirewallRule(ctx context.Context, request *appenginepb.DeleteAppengineFirewallRuleRequest) (*emptypb.Empty, error) {
 
@@ -111,20 +165,32 @@ func (s *FirewallRuleServer) ListAppengineFirewallRule(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListFirewallRule(ctx, request.App)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = appengine.FirewallRuleMaxPage
+	}
+	resources, err := cl.ListFirewallRuleWithPageToken(ctx, request.App, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*appenginepb.AppengineFirewallRule
-	for _, r := range resources.Items {
-		rp := FirewallRuleToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FirewallRuleToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &appenginepb.ListAppengineFirewallRuleResponse{Items: protos}, nil
+	return &appenginepb.ListAppengineFirewallRuleResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigFirewallRule(ctx context.Context, service_account_file string) (*appengine.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return appengine.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	appenginepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/appengine/appengine_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/appengine"
@@ -1149,7 +1150,11 @@ func VersionToProto(resource *appengine.Version) *appenginepb.AppengineVersion {
 // ApplyVersion handles the gRPC request by passing it to the underlying Version Apply() method.
 func (s *VersionServer) applyVersion(ctx context.Context, c *appengine.Client, request *appenginepb.ApplyAppengineVersionRequest) (*appenginepb.AppengineVersion, error) {
 	p := ProtoToVersion(request.GetResource())
-	res, err := c.ApplyVersion(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyVersion(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1166,6 +1171,55 @@ func (s *VersionServer) ApplyAppengineVersion(ctx context.Context, request *appe
 	return s.applyVersion(ctx, cl, request)
 }
 
+// GetAppengineVersion handles the gRPC request by passing it to the underlying Version Get() method.
+func (s *VersionServer) GetAppengineVersion(ctx context.Context, request *appenginepb.GetAppengineVersionRequest) (*appenginepb.AppengineVersion, error) {
+	cl, err := createConfigVersion(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetVersion(ctx, ProtoToVersion(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return VersionToProto(res), nil
+}
+
+// HasDiffAppengineVersion handles the gRPC request by passing it to the underlying Version Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *VersionServer) HasDiffAppengineVersion(ctx context.Context, request *appenginepb.HasDiffAppengineVersionRequest) (*appenginepb.HasDiffAppengineVersionResponse, error) {
+	cl, err := createConfigVersion(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVersion(ctx, ProtoToVersion(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &appenginepb.HasDiffAppengineVersionResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanAppengineVersion handles the gRPC request by passing it to the underlying Version Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *VersionServer) PlanAppengineVersion(ctx context.Context, request *appenginepb.PlanAppengineVersionRequest) (*appenginepb.PlanAppengineVersionResponse, error) {
+	cl, err := createConfigVersion(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVersion(ctx, ProtoToVersion(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &appenginepb.PlanAppengineVersionResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteVersion handles the gRPC request by passing it to the underlying Version Delete() method.
 func (s *VersionServer) DeleteAppengineVersion(ctx context.Context, request *appenginepb.DeleteAppengineVersionRequest) (*emptypb.Empty, error) {
 
@@ -1184,20 +1238,32 @@ func (s *VersionServer) ListAppengineVersion(ctx context.Context, request *appen
 		return nil, err
 	}
 
-	resources, err := cl.ListVersion(ctx, request.App, request.Service)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = appengine.VersionMaxPage
+	}
+	resources, err := cl.ListVersionWithPageToken(ctx, request.App, request.Service, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*appenginepb.AppengineVersion
-	for _, r := range resources.Items {
-		rp := VersionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, VersionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &appenginepb.ListAppengineVersionResponse{Items: protos}, nil
+	return &appenginepb.ListAppengineVersionResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigVersion(ctx context.Context, service_account_file string) (*appengine.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return appengine.NewClient(conf), nil
 }
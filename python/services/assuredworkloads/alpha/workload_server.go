@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/assuredworkloads/alpha/assuredworkloads_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/assuredworkloads/alpha"
@@ -218,7 +219,11 @@ func WorkloadToProto(resource *alpha.Workload) *alphapb.AssuredworkloadsAlphaWor
 // applyWorkload handles the gRPC request by passing it to the underlying Workload Apply() method.
 func (s *WorkloadServer) applyWorkload(ctx context.Context, c *alpha.Client, request *alphapb.ApplyAssuredworkloadsAlphaWorkloadRequest) (*alphapb.AssuredworkloadsAlphaWorkload, error) {
 	p := ProtoToWorkload(request.GetResource())
-	res, err := c.ApplyWorkload(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkload(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +240,60 @@ func (s *WorkloadServer) ApplyAssuredworkloadsAlphaWorkload(ctx context.Context,
 	return s.applyWorkload(ctx, cl, request)
 }
 
+// GetAssuredworkloadsAlphaWorkload handles the gRPC request by passing it to the underlying Workload Get() method.
+func (s *WorkloadServer) GetAssuredworkloadsAlphaWorkload(ctx context.Context, request *alphapb.GetAssuredworkloadsAlphaWorkloadRequest) (*alphapb.AssuredworkloadsAlphaWorkload, error) {
+	cl, err := createConfigWorkload(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkload(ctx, ProtoToWorkload(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkloadToProto(res), nil
+}
+
+// HasDiffAssuredworkloadsAlphaWorkload handles the gRPC request by passing it to the underlying Workload Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkloadServer) HasDiffAssuredworkloadsAlphaWorkload(ctx context.Context, request *alphapb.HasDiffAssuredworkloadsAlphaWorkloadRequest) (*alphapb.HasDiffAssuredworkloadsAlphaWorkloadResponse, error) {
+	cl, err := createConfigWorkload(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkload(ctx, ProtoToWorkload(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffAssuredworkloadsAlphaWorkloadResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanAssuredworkloadsAlphaWorkload handles the gRPC request by passing it to the underlying Workload Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkloadServer) PlanAssuredworkloadsAlphaWorkload(ctx context.Context, request *alphapb.PlanAssuredworkloadsAlphaWorkloadRequest) (*alphapb.PlanAssuredworkloadsAlphaWorkloadResponse, error) {
+	cl, err := createConfigWorkload(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkload(ctx, ProtoToWorkload(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanAssuredworkloadsAlphaWorkloadResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkload handles the gRPC request by passing it to the underlying Workload Delete() method.
 func (s *WorkloadServer) DeleteAssuredworkloadsAlphaWorkload(ctx context.Context, request *alphapb.DeleteAssuredworkloadsAlphaWorkloadRequest) (*emptypb.Empty, error) {
 
@@ -253,22 +312,35 @@ func (s *WorkloadServer) ListAssuredworkloadsAlphaWorkload(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkload(ctx, request.GetOrganization(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.WorkloadMaxPage
+	}
+	resources, err := cl.ListWorkloadWithPageToken(ctx, request.GetOrganization(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.AssuredworkloadsAlphaWorkload
-	for _, r := range resources.Items {
-		rp := WorkloadToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkloadToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListAssuredworkloadsAlphaWorkloadResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkload(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
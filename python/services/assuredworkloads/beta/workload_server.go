@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/assuredworkloads/beta/assuredworkloads_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/assuredworkloads/beta"
@@ -218,7 +219,11 @@ func WorkloadToProto(resource *beta.Workload) *betapb.AssuredworkloadsBetaWorklo
 // applyWorkload handles the gRPC request by passing it to the underlying Workload Apply() method.
 func (s *WorkloadServer) applyWorkload(ctx context.Context, c *beta.Client, request *betapb.ApplyAssuredworkloadsBetaWorkloadRequest) (*betapb.AssuredworkloadsBetaWorkload, error) {
 	p := ProtoToWorkload(request.GetResource())
-	res, err := c.ApplyWorkload(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkload(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +240,60 @@ func (s *WorkloadServer) ApplyAssuredworkloadsBetaWorkload(ctx context.Context,
 	return s.applyWorkload(ctx, cl, request)
 }
 
+// GetAssuredworkloadsBetaWorkload handles the gRPC request by passing it to the underlying Workload Get() method.
+func (s *WorkloadServer) GetAssuredworkloadsBetaWorkload(ctx context.Context, request *betapb.GetAssuredworkloadsBetaWorkloadRequest) (*betapb.AssuredworkloadsBetaWorkload, error) {
+	cl, err := createConfigWorkload(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkload(ctx, ProtoToWorkload(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkloadToProto(res), nil
+}
+
+// HasDiffAssuredworkloadsBetaWorkload handles the gRPC request by passing it to the underlying Workload Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkloadServer) HasDiffAssuredworkloadsBetaWorkload(ctx context.Context, request *betapb.HasDiffAssuredworkloadsBetaWorkloadRequest) (*betapb.HasDiffAssuredworkloadsBetaWorkloadResponse, error) {
+	cl, err := createConfigWorkload(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkload(ctx, ProtoToWorkload(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffAssuredworkloadsBetaWorkloadResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanAssuredworkloadsBetaWorkload handles the gRPC request by passing it to the underlying Workload Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkloadServer) PlanAssuredworkloadsBetaWorkload(ctx context.Context, request *betapb.PlanAssuredworkloadsBetaWorkloadRequest) (*betapb.PlanAssuredworkloadsBetaWorkloadResponse, error) {
+	cl, err := createConfigWorkload(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkload(ctx, ProtoToWorkload(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanAssuredworkloadsBetaWorkloadResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkload handles the gRPC request by passing it to the underlying Workload Delete() method.
 func (s *WorkloadServer) DeleteAssuredworkloadsBetaWorkload(ctx context.Context, request *betapb.DeleteAssuredworkloadsBetaWorkloadRequest) (*emptypb.Empty, error) {
 
@@ -253,22 +312,35 @@ func (s *WorkloadServer) ListAssuredworkloadsBetaWorkload(ctx context.Context, r
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkload(ctx, request.GetOrganization(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.WorkloadMaxPage
+	}
+	resources, err := cl.ListWorkloadWithPageToken(ctx, request.GetOrganization(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.AssuredworkloadsBetaWorkload
-	for _, r := range resources.Items {
-		rp := WorkloadToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkloadToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListAssuredworkloadsBetaWorkloadResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkload(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
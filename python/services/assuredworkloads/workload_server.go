@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	assuredworkloadspb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/assuredworkloads/assuredworkloads_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/assuredworkloads"
@@ -218,7 +219,11 @@ func WorkloadToProto(resource *assuredworkloads.Workload) *assuredworkloadspb.As
 // applyWorkload handles the gRPC request by passing it to the underlying Workload Apply() method.
 func (s *WorkloadServer) applyWorkload(ctx context.Context, c *assuredworkloads.Client, request *assuredworkloadspb.ApplyAssuredworkloadsWorkloadRequest) (*assuredworkloadspb.AssuredworkloadsWorkload, error) {
 	p := ProtoToWorkload(request.GetResource())
-	res, err := c.ApplyWorkload(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkload(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +240,60 @@ func (s *WorkloadServer) ApplyAssuredworkloadsWorkload(ctx context.Context, requ
 	return s.applyWorkload(ctx, cl, request)
 }
 
+// GetAssuredworkloadsWorkload handles the gRPC request by passing it to the underlying Workload Get() method.
+func (s *WorkloadServer) GetAssuredworkloadsWorkload(ctx context.Context, request *assuredworkloadspb.GetAssuredworkloadsWorkloadRequest) (*assuredworkloadspb.AssuredworkloadsWorkload, error) {
+	cl, err := createConfigWorkload(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkload(ctx, ProtoToWorkload(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkloadToProto(res), nil
+}
+
+// HasDiffAssuredworkloadsWorkload handles the gRPC request by passing it to the underlying Workload Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkloadServer) HasDiffAssuredworkloadsWorkload(ctx context.Context, request *assuredworkloadspb.HasDiffAssuredworkloadsWorkloadRequest) (*assuredworkloadspb.HasDiffAssuredworkloadsWorkloadResponse, error) {
+	cl, err := createConfigWorkload(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkload(ctx, ProtoToWorkload(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &assuredworkloadspb.HasDiffAssuredworkloadsWorkloadResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanAssuredworkloadsWorkload handles the gRPC request by passing it to the underlying Workload Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkloadServer) PlanAssuredworkloadsWorkload(ctx context.Context, request *assuredworkloadspb.PlanAssuredworkloadsWorkloadRequest) (*assuredworkloadspb.PlanAssuredworkloadsWorkloadResponse, error) {
+	cl, err := createConfigWorkload(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkload(ctx, ProtoToWorkload(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &assuredworkloadspb.PlanAssuredworkloadsWorkloadResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkload handles the gRPC request by passing it to the underlying Workload Delete() method.
 func (s *WorkloadServer) DeleteAssuredworkloadsWorkload(ctx context.Context, request *assuredworkloadspb.DeleteAssuredworkloadsWorkloadRequest) (*emptypb.Empty, error) {
 
@@ -253,22 +312,35 @@ func (s *WorkloadServer) ListAssuredworkloadsWorkload(ctx context.Context, reque
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkload(ctx, request.GetOrganization(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = assuredworkloads.WorkloadMaxPage
+	}
+	resources, err := cl.ListWorkloadWithPageToken(ctx, request.GetOrganization(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*assuredworkloadspb.AssuredworkloadsWorkload
-	for _, r := range resources.Items {
-		rp := WorkloadToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkloadToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &assuredworkloadspb.ListAssuredworkloadsWorkloadResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkload(ctx context.Context, service_account_file string) (*assuredworkloads.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return assuredworkloads.NewClient(conf), nil
 }
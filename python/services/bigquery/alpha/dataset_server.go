@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigquery/alpha/bigquery_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigquery/alpha"
@@ -188,7 +189,11 @@ func DatasetToProto(resource *alpha.Dataset) *alphapb.BigqueryAlphaDataset {
 // applyDataset handles the gRPC request by passing it to the underlying Dataset Apply() method.
 func (s *DatasetServer) applyDataset(ctx context.Context, c *alpha.Client, request *alphapb.ApplyBigqueryAlphaDatasetRequest) (*alphapb.BigqueryAlphaDataset, error) {
 	p := ProtoToDataset(request.GetResource())
-	res, err := c.ApplyDataset(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDataset(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -205,6 +210,60 @@ func (s *DatasetServer) ApplyBigqueryAlphaDataset(ctx context.Context, request *
 	return s.applyDataset(ctx, cl, request)
 }
 
+// GetBigqueryAlphaDataset handles the gRPC request by passing it to the underlying Dataset Get() method.
+func (s *DatasetServer) GetBigqueryAlphaDataset(ctx context.Context, request *alphapb.GetBigqueryAlphaDatasetRequest) (*alphapb.BigqueryAlphaDataset, error) {
+	cl, err := createConfigDataset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDataset(ctx, ProtoToDataset(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DatasetToProto(res), nil
+}
+
+// HasDiffBigqueryAlphaDataset handles the gRPC request by passing it to the underlying Dataset Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DatasetServer) HasDiffBigqueryAlphaDataset(ctx context.Context, request *alphapb.HasDiffBigqueryAlphaDatasetRequest) (*alphapb.HasDiffBigqueryAlphaDatasetResponse, error) {
+	cl, err := createConfigDataset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDataset(ctx, ProtoToDataset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffBigqueryAlphaDatasetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryAlphaDataset handles the gRPC request by passing it to the underlying Dataset Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DatasetServer) PlanBigqueryAlphaDataset(ctx context.Context, request *alphapb.PlanBigqueryAlphaDatasetRequest) (*alphapb.PlanBigqueryAlphaDatasetResponse, error) {
+	cl, err := createConfigDataset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDataset(ctx, ProtoToDataset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanBigqueryAlphaDatasetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDataset handles the gRPC request by passing it to the underlying Dataset Delete() method.
 func (s *DatasetServer) DeleteBigqueryAlphaDataset(ctx context.Context, request *alphapb.DeleteBigqueryAlphaDatasetRequest) (*emptypb.Empty, error) {
 
@@ -223,22 +282,35 @@ func (s *DatasetServer) ListBigqueryAlphaDataset(ctx context.Context, request *a
 		return nil, err
 	}
 
-	resources, err := cl.ListDataset(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.DatasetMaxPage
+	}
+	resources, err := cl.ListDatasetWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.BigqueryAlphaDataset
-	for _, r := range resources.Items {
-		rp := DatasetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DatasetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListBigqueryAlphaDatasetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDataset(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigquery/alpha/bigquery_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigquery/alpha"
@@ -322,7 +323,11 @@ func RoutineToProto(resource *alpha.Routine) *alphapb.BigqueryAlphaRoutine {
 // applyRoutine handles the gRPC request by passing it to the underlying Routine Apply() method.
 func (s *RoutineServer) applyRoutine(ctx context.Context, c *alpha.Client, request *alphapb.ApplyBigqueryAlphaRoutineRequest) (*alphapb.BigqueryAlphaRoutine, error) {
 	p := ProtoToRoutine(request.GetResource())
-	res, err := c.ApplyRoutine(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRoutine(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -339,6 +344,60 @@ func (s *RoutineServer) ApplyBigqueryAlphaRoutine(ctx context.Context, request *
 	return s.applyRoutine(ctx, cl, request)
 }
 
+// GetBigqueryAlphaRoutine handles the gRPC request by passing it to the underlying Routine Get() method.
+func (s *RoutineServer) GetBigqueryAlphaRoutine(ctx context.Context, request *alphapb.GetBigqueryAlphaRoutineRequest) (*alphapb.BigqueryAlphaRoutine, error) {
+	cl, err := createConfigRoutine(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRoutine(ctx, ProtoToRoutine(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RoutineToProto(res), nil
+}
+
+// HasDiffBigqueryAlphaRoutine handles the gRPC request by passing it to the underlying Routine Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RoutineServer) HasDiffBigqueryAlphaRoutine(ctx context.Context, request *alphapb.HasDiffBigqueryAlphaRoutineRequest) (*alphapb.HasDiffBigqueryAlphaRoutineResponse, error) {
+	cl, err := createConfigRoutine(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRoutine(ctx, ProtoToRoutine(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffBigqueryAlphaRoutineResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryAlphaRoutine handles the gRPC request by passing it to the underlying Routine Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RoutineServer) PlanBigqueryAlphaRoutine(ctx context.Context, request *alphapb.PlanBigqueryAlphaRoutineRequest) (*alphapb.PlanBigqueryAlphaRoutineResponse, error) {
+	cl, err := createConfigRoutine(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRoutine(ctx, ProtoToRoutine(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanBigqueryAlphaRoutineResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRoutine handles the gRPC request by passing it to the underlying Routine Delete() method.
 func (s *RoutineServer) DeleteBigqueryAlphaRoutine(ctx context.Context, request *alphapb.DeleteBigqueryAlphaRoutineRequest) (*emptypb.Empty, error) {
 
@@ -357,22 +416,35 @@ func (s *RoutineServer) ListBigqueryAlphaRoutine(ctx context.Context, request *a
 		return nil, err
 	}
 
-	resources, err := cl.ListRoutine(ctx, request.GetProject(), request.GetDataset())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.RoutineMaxPage
+	}
+	resources, err := cl.ListRoutineWithPageToken(ctx, request.GetProject(), request.GetDataset(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.BigqueryAlphaRoutine
-	for _, r := range resources.Items {
-		rp := RoutineToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RoutineToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListBigqueryAlphaRoutineResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRoutine(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigquery/alpha/bigquery_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigquery/alpha"
@@ -1105,7 +1106,11 @@ func TableToProto(resource *alpha.Table) *alphapb.BigqueryAlphaTable {
 // applyTable handles the gRPC request by passing it to the underlying Table Apply() method.
 func (s *TableServer) applyTable(ctx context.Context, c *alpha.Client, request *alphapb.ApplyBigqueryAlphaTableRequest) (*alphapb.BigqueryAlphaTable, error) {
 	p := ProtoToTable(request.GetResource())
-	res, err := c.ApplyTable(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTable(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1122,6 +1127,60 @@ func (s *TableServer) ApplyBigqueryAlphaTable(ctx context.Context, request *alph
 	return s.applyTable(ctx, cl, request)
 }
 
+// GetBigqueryAlphaTable handles the gRPC request by passing it to the underlying Table Get() method.
+func (s *TableServer) GetBigqueryAlphaTable(ctx context.Context, request *alphapb.GetBigqueryAlphaTableRequest) (*alphapb.BigqueryAlphaTable, error) {
+	cl, err := createConfigTable(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTable(ctx, ProtoToTable(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TableToProto(res), nil
+}
+
+// HasDiffBigqueryAlphaTable handles the gRPC request by passing it to the underlying Table Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TableServer) HasDiffBigqueryAlphaTable(ctx context.Context, request *alphapb.HasDiffBigqueryAlphaTableRequest) (*alphapb.HasDiffBigqueryAlphaTableResponse, error) {
+	cl, err := createConfigTable(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTable(ctx, ProtoToTable(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffBigqueryAlphaTableResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryAlphaTable handles the gRPC request by passing it to the underlying Table Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TableServer) PlanBigqueryAlphaTable(ctx context.Context, request *alphapb.PlanBigqueryAlphaTableRequest) (*alphapb.PlanBigqueryAlphaTableResponse, error) {
+	cl, err := createConfigTable(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTable(ctx, ProtoToTable(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanBigqueryAlphaTableResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTable handles the gRPC request by passing it to the underlying Table Delete() method.
 func (s *TableServer) DeleteBigqueryAlphaTable(ctx context.Context, request *alphapb.DeleteBigqueryAlphaTableRequest) (*emptypb.Empty, error) {
 
@@ -1140,22 +1199,35 @@ func (s *TableServer) ListBigqueryAlphaTable(ctx context.Context, request *alpha
 		return nil, err
 	}
 
-	resources, err := cl.ListTable(ctx, request.GetProject(), request.GetDataset())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.TableMaxPage
+	}
+	resources, err := cl.ListTableWithPageToken(ctx, request.GetProject(), request.GetDataset(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.BigqueryAlphaTable
-	for _, r := range resources.Items {
-		rp := TableToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TableToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListBigqueryAlphaTableResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTable(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigquery/beta/bigquery_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigquery/beta"
@@ -188,7 +189,11 @@ func DatasetToProto(resource *beta.Dataset) *betapb.BigqueryBetaDataset {
 // applyDataset handles the gRPC request by passing it to the underlying Dataset Apply() method.
 func (s *DatasetServer) applyDataset(ctx context.Context, c *beta.Client, request *betapb.ApplyBigqueryBetaDatasetRequest) (*betapb.BigqueryBetaDataset, error) {
 	p := ProtoToDataset(request.GetResource())
-	res, err := c.ApplyDataset(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDataset(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -205,6 +210,60 @@ func (s *DatasetServer) ApplyBigqueryBetaDataset(ctx context.Context, request *b
 	return s.applyDataset(ctx, cl, request)
 }
 
+// GetBigqueryBetaDataset handles the gRPC request by passing it to the underlying Dataset Get() method.
+func (s *DatasetServer) GetBigqueryBetaDataset(ctx context.Context, request *betapb.GetBigqueryBetaDatasetRequest) (*betapb.BigqueryBetaDataset, error) {
+	cl, err := createConfigDataset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDataset(ctx, ProtoToDataset(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DatasetToProto(res), nil
+}
+
+// HasDiffBigqueryBetaDataset handles the gRPC request by passing it to the underlying Dataset Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DatasetServer) HasDiffBigqueryBetaDataset(ctx context.Context, request *betapb.HasDiffBigqueryBetaDatasetRequest) (*betapb.HasDiffBigqueryBetaDatasetResponse, error) {
+	cl, err := createConfigDataset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDataset(ctx, ProtoToDataset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffBigqueryBetaDatasetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryBetaDataset handles the gRPC request by passing it to the underlying Dataset Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DatasetServer) PlanBigqueryBetaDataset(ctx context.Context, request *betapb.PlanBigqueryBetaDatasetRequest) (*betapb.PlanBigqueryBetaDatasetResponse, error) {
+	cl, err := createConfigDataset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDataset(ctx, ProtoToDataset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanBigqueryBetaDatasetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDataset handles the gRPC request by passing it to the underlying Dataset Delete() method.
 func (s *DatasetServer) DeleteBigqueryBetaDataset(ctx context.Context, request *betapb.DeleteBigqueryBetaDatasetRequest) (*emptypb.Empty, error) {
 
@@ -223,22 +282,35 @@ func (s *DatasetServer) ListBigqueryBetaDataset(ctx context.Context, request *be
 		return nil, err
 	}
 
-	resources, err := cl.ListDataset(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.DatasetMaxPage
+	}
+	resources, err := cl.ListDatasetWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.BigqueryBetaDataset
-	for _, r := range resources.Items {
-		rp := DatasetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DatasetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListBigqueryBetaDatasetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDataset(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
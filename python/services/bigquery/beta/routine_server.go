@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigquery/beta/bigquery_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigquery/beta"
@@ -322,7 +323,11 @@ func RoutineToProto(resource *beta.Routine) *betapb.BigqueryBetaRoutine {
 // applyRoutine handles the gRPC request by passing it to the underlying Routine Apply() method.
 func (s *RoutineServer) applyRoutine(ctx context.Context, c *beta.Client, request *betapb.ApplyBigqueryBetaRoutineRequest) (*betapb.BigqueryBetaRoutine, error) {
 	p := ProtoToRoutine(request.GetResource())
-	res, err := c.ApplyRoutine(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRoutine(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -339,6 +344,60 @@ func (s *RoutineServer) ApplyBigqueryBetaRoutine(ctx context.Context, request *b
 	return s.applyRoutine(ctx, cl, request)
 }
 
+// GetBigqueryBetaRoutine handles the gRPC request by passing it to the underlying Routine Get() method.
+func (s *RoutineServer) GetBigqueryBetaRoutine(ctx context.Context, request *betapb.GetBigqueryBetaRoutineRequest) (*betapb.BigqueryBetaRoutine, error) {
+	cl, err := createConfigRoutine(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRoutine(ctx, ProtoToRoutine(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RoutineToProto(res), nil
+}
+
+// HasDiffBigqueryBetaRoutine handles the gRPC request by passing it to the underlying Routine Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RoutineServer) HasDiffBigqueryBetaRoutine(ctx context.Context, request *betapb.HasDiffBigqueryBetaRoutineRequest) (*betapb.HasDiffBigqueryBetaRoutineResponse, error) {
+	cl, err := createConfigRoutine(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRoutine(ctx, ProtoToRoutine(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffBigqueryBetaRoutineResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryBetaRoutine handles the gRPC request by passing it to the underlying Routine Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RoutineServer) PlanBigqueryBetaRoutine(ctx context.Context, request *betapb.PlanBigqueryBetaRoutineRequest) (*betapb.PlanBigqueryBetaRoutineResponse, error) {
+	cl, err := createConfigRoutine(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRoutine(ctx, ProtoToRoutine(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanBigqueryBetaRoutineResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRoutine handles the gRPC request by passing it to the underlying Routine Delete() method.
 func (s *RoutineServer) DeleteBigqueryBetaRoutine(ctx context.Context, request *betapb.DeleteBigqueryBetaRoutineRequest) (*emptypb.Empty, error) {
 
@@ -357,22 +416,35 @@ func (s *RoutineServer) ListBigqueryBetaRoutine(ctx context.Context, request *be
 		return nil, err
 	}
 
-	resources, err := cl.ListRoutine(ctx, request.GetProject(), request.GetDataset())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.RoutineMaxPage
+	}
+	resources, err := cl.ListRoutineWithPageToken(ctx, request.GetProject(), request.GetDataset(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.BigqueryBetaRoutine
-	for _, r := range resources.Items {
-		rp := RoutineToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RoutineToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListBigqueryBetaRoutineResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRoutine(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	bigquerypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigquery/bigquery_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigquery"
@@ -322,7 +323,11 @@ func RoutineToProto(resource *bigquery.Routine) *bigquerypb.BigqueryRoutine {
 // applyRoutine handles the gRPC request by passing it to the underlying Routine Apply() method.
 func (s *RoutineServer) applyRoutine(ctx context.Context, c *bigquery.Client, request *bigquerypb.ApplyBigqueryRoutineRequest) (*bigquerypb.BigqueryRoutine, error) {
 	p := ProtoToRoutine(request.GetResource())
-	res, err := c.ApplyRoutine(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRoutine(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -339,6 +344,60 @@ func (s *RoutineServer) ApplyBigqueryRoutine(ctx context.Context, request *bigqu
 	return s.applyRoutine(ctx, cl, request)
 }
 
+// GetBigqueryRoutine handles the gRPC request by passing it to the underlying Routine Get() method.
+func (s *RoutineServer) GetBigqueryRoutine(ctx context.Context, request *bigquerypb.GetBigqueryRoutineRequest) (*bigquerypb.BigqueryRoutine, error) {
+	cl, err := createConfigRoutine(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRoutine(ctx, ProtoToRoutine(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RoutineToProto(res), nil
+}
+
+// HasDiffBigqueryRoutine handles the gRPC request by passing it to the underlying Routine Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RoutineServer) HasDiffBigqueryRoutine(ctx context.Context, request *bigquerypb.HasDiffBigqueryRoutineRequest) (*bigquerypb.HasDiffBigqueryRoutineResponse, error) {
+	cl, err := createConfigRoutine(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRoutine(ctx, ProtoToRoutine(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &bigquerypb.HasDiffBigqueryRoutineResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryRoutine handles the gRPC request by passing it to the underlying Routine Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RoutineServer) PlanBigqueryRoutine(ctx context.Context, request *bigquerypb.PlanBigqueryRoutineRequest) (*bigquerypb.PlanBigqueryRoutineResponse, error) {
+	cl, err := createConfigRoutine(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRoutine(ctx, ProtoToRoutine(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &bigquerypb.PlanBigqueryRoutineResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRoutine handles the gRPC request by passing it to the underlying Routine Delete() method.
 func (s *RoutineServer) DeleteBigqueryRoutine(ctx context.Context, request *bigquerypb.DeleteBigqueryRoutineRequest) (*emptypb.Empty, error) {
 
@@ -357,22 +416,35 @@ func (s *RoutineServer) ListBigqueryRoutine(ctx context.Context, request *bigque
 		return nil, err
 	}
 
-	resources, err := cl.ListRoutine(ctx, request.GetProject(), request.GetDataset())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = bigquery.RoutineMaxPage
+	}
+	resources, err := cl.ListRoutineWithPageToken(ctx, request.GetProject(), request.GetDataset(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*bigquerypb.BigqueryRoutine
-	for _, r := range resources.Items {
-		rp := RoutineToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RoutineToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &bigquerypb.ListBigqueryRoutineResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRoutine(ctx context.Context, service_account_file string) (*bigquery.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return bigquery.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	bigquerypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigquery/bigquery_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigquery"
@@ -1105,7 +1106,11 @@ func TableToProto(resource *bigquery.Table) *bigquerypb.BigqueryTable {
 // applyTable handles the gRPC request by passing it to the underlying Table Apply() method.
 func (s *TableServer) applyTable(ctx context.Context, c *bigquery.Client, request *bigquerypb.ApplyBigqueryTableRequest) (*bigquerypb.BigqueryTable, error) {
 	p := ProtoToTable(request.GetResource())
-	res, err := c.ApplyTable(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTable(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1122,6 +1127,60 @@ func (s *TableServer) ApplyBigqueryTable(ctx context.Context, request *bigqueryp
 	return s.applyTable(ctx, cl, request)
 }
 
+// GetBigqueryTable handles the gRPC request by passing it to the underlying Table Get() method.
+func (s *TableServer) GetBigqueryTable(ctx context.Context, request *bigquerypb.GetBigqueryTableRequest) (*bigquerypb.BigqueryTable, error) {
+	cl, err := createConfigTable(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTable(ctx, ProtoToTable(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TableToProto(res), nil
+}
+
+// HasDiffBigqueryTable handles the gRPC request by passing it to the underlying Table Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TableServer) HasDiffBigqueryTable(ctx context.Context, request *bigquerypb.HasDiffBigqueryTableRequest) (*bigquerypb.HasDiffBigqueryTableResponse, error) {
+	cl, err := createConfigTable(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTable(ctx, ProtoToTable(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &bigquerypb.HasDiffBigqueryTableResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryTable handles the gRPC request by passing it to the underlying Table Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TableServer) PlanBigqueryTable(ctx context.Context, request *bigquerypb.PlanBigqueryTableRequest) (*bigquerypb.PlanBigqueryTableResponse, error) {
+	cl, err := createConfigTable(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTable(ctx, ProtoToTable(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &bigquerypb.PlanBigqueryTableResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTable handles the gRPC request by passing it to the underlying Table Delete() method.
 func (s *TableServer) DeleteBigqueryTable(ctx context.Context, request *bigquerypb.DeleteBigqueryTableRequest) (*emptypb.Empty, error) {
 
@@ -1140,22 +1199,35 @@ func (s *TableServer) ListBigqueryTable(ctx context.Context, request *bigquerypb
 		return nil, err
 	}
 
-	resources, err := cl.ListTable(ctx, request.GetProject(), request.GetDataset())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = bigquery.TableMaxPage
+	}
+	resources, err := cl.ListTableWithPageToken(ctx, request.GetProject(), request.GetDataset(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*bigquerypb.BigqueryTable
-	for _, r := range resources.Items {
-		rp := TableToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TableToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &bigquerypb.ListBigqueryTableResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTable(ctx context.Context, service_account_file string) (*bigquery.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return bigquery.NewClient(conf), nil
 }
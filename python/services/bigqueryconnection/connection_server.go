@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	bigqueryconnectionpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigqueryconnection/bigqueryconnection_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigqueryconnection"
@@ -136,7 +137,11 @@ func ConnectionToProto(resource *bigqueryconnection.Connection) *bigqueryconnect
 // ApplyConnection handles the gRPC request by passing it to the underlying Connection Apply() method.
 func (s *ConnectionServer) applyConnection(ctx context.Context, c *bigqueryconnection.Client, request *bigqueryconnectionpb.ApplyBigqueryconnectionConnectionRequest) (*bigqueryconnectionpb.BigqueryconnectionConnection, error) {
 	p := ProtoToConnection(request.GetResource())
-	res, err := c.ApplyConnection(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyConnection(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -153,6 +158,55 @@ func (s *ConnectionServer) ApplyBigqueryconnectionConnection(ctx context.Context
 	return s.applyConnection(ctx, cl, request)
 }
 
+// GetBigqueryconnectionConnection handles the gRPC request by passing it to the underlying Connection Get() method.
+func (s *ConnectionServer) GetBigqueryconnectionConnection(ctx context.Context, request *bigqueryconnectionpb.GetBigqueryconnectionConnectionRequest) (*bigqueryconnectionpb.BigqueryconnectionConnection, error) {
+	cl, err := createConfigConnection(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetConnection(ctx, ProtoToConnection(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ConnectionToProto(res), nil
+}
+
+// HasDiffBigqueryconnectionConnection handles the gRPC request by passing it to the underlying Connection Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ConnectionServer) HasDiffBigqueryconnectionConnection(ctx context.Context, request *bigqueryconnectionpb.HasDiffBigqueryconnectionConnectionRequest) (*bigqueryconnectionpb.HasDiffBigqueryconnectionConnectionResponse, error) {
+	cl, err := createConfigConnection(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConnection(ctx, ProtoToConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &bigqueryconnectionpb.HasDiffBigqueryconnectionConnectionResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanBigqueryconnectionConnection handles the gRPC request by passing it to the underlying Connection Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ConnectionServer) PlanBigqueryconnectionConnection(ctx context.Context, request *bigqueryconnectionpb.PlanBigqueryconnectionConnectionRequest) (*bigqueryconnectionpb.PlanBigqueryconnectionConnectionResponse, error) {
+	cl, err := createConfigConnection(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConnection(ctx, ProtoToConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &bigqueryconnectionpb.PlanBigqueryconnectionConnectionResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteConnection handles the gRPC request by passing it to the underlying Connection Delete() method.
 func (s *ConnectionServer) DeleteBigqueryconnectionConnection(ctx context.Context, request *bigqueryconnectionpb.DeleteBigqueryconnectionConnectionRequest) (*emptypb.Empty, error) {
 
@@ -171,20 +225,32 @@ func (s *ConnectionServer) ListBigqueryconnectionConnection(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListConnection(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = bigqueryconnection.ConnectionMaxPage
+	}
+	resources, err := cl.ListConnectionWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*bigqueryconnectionpb.BigqueryconnectionConnection
-	for _, r := range resources.Items {
-		rp := ConnectionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ConnectionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &bigqueryconnectionpb.ListBigqueryconnectionConnectionResponse{Items: protos}, nil
+	return &bigqueryconnectionpb.ListBigqueryconnectionConnectionResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigConnection(ctx context.Context, service_account_file string) (*bigqueryconnection.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return bigqueryconnection.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigqueryreservation/alpha/bigqueryreservation_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigqueryreservation/alpha"
@@ -101,7 +102,11 @@ func AssignmentToProto(resource *alpha.Assignment) *alphapb.BigqueryreservationA
 // applyAssignment handles the gRPC request by passing it to the underlying Assignment Apply() method.
 func (s *AssignmentServer) applyAssignment(ctx context.Context, c *alpha.Client, request *alphapb.ApplyBigqueryreservationAlphaAssignmentRequest) (*alphapb.BigqueryreservationAlphaAssignment, error) {
 	p := ProtoToAssignment(request.GetResource())
-	res, err := c.ApplyAssignment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAssignment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +123,60 @@ func (s *AssignmentServer) ApplyBigqueryreservationAlphaAssignment(ctx context.C
 	return s.applyAssignment(ctx, cl, request)
 }
 
+// GetBigqueryreservationAlphaAssignment handles the gRPC request by passing it to the underlying Assignment Get() method.
+func (s *AssignmentServer) GetBigqueryreservationAlphaAssignment(ctx context.Context, request *alphapb.GetBigqueryreservationAlphaAssignmentRequest) (*alphapb.BigqueryreservationAlphaAssignment, error) {
+	cl, err := createConfigAssignment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAssignment(ctx, ProtoToAssignment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AssignmentToProto(res), nil
+}
+
+// HasDiffBigqueryreservationAlphaAssignment handles the gRPC request by passing it to the underlying Assignment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AssignmentServer) HasDiffBigqueryreservationAlphaAssignment(ctx context.Context, request *alphapb.HasDiffBigqueryreservationAlphaAssignmentRequest) (*alphapb.HasDiffBigqueryreservationAlphaAssignmentResponse, error) {
+	cl, err := createConfigAssignment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAssignment(ctx, ProtoToAssignment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffBigqueryreservationAlphaAssignmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryreservationAlphaAssignment handles the gRPC request by passing it to the underlying Assignment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AssignmentServer) PlanBigqueryreservationAlphaAssignment(ctx context.Context, request *alphapb.PlanBigqueryreservationAlphaAssignmentRequest) (*alphapb.PlanBigqueryreservationAlphaAssignmentResponse, error) {
+	cl, err := createConfigAssignment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAssignment(ctx, ProtoToAssignment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanBigqueryreservationAlphaAssignmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAssignment handles the gRPC request by passing it to the underlying Assignment Delete() method.
 func (s *AssignmentServer) DeleteBigqueryreservationAlphaAssignment(ctx context.Context, request *alphapb.DeleteBigqueryreservationAlphaAssignmentRequest) (*emptypb.Empty, error) {
 
@@ -136,22 +195,35 @@ func (s *AssignmentServer) ListBigqueryreservationAlphaAssignment(ctx context.Co
 		return nil, err
 	}
 
-	resources, err := cl.ListAssignment(ctx, request.GetProject(), request.GetLocation(), request.GetReservation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.AssignmentMaxPage
+	}
+	resources, err := cl.ListAssignmentWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetReservation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.BigqueryreservationAlphaAssignment
-	for _, r := range resources.Items {
-		rp := AssignmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AssignmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListBigqueryreservationAlphaAssignmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAssignment(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigqueryreservation/alpha/bigqueryreservation_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigqueryreservation/alpha"
@@ -184,7 +185,11 @@ func CapacityCommitmentToProto(resource *alpha.CapacityCommitment) *alphapb.Bigq
 // applyCapacityCommitment handles the gRPC request by passing it to the underlying CapacityCommitment Apply() method.
 func (s *CapacityCommitmentServer) applyCapacityCommitment(ctx context.Context, c *alpha.Client, request *alphapb.ApplyBigqueryreservationAlphaCapacityCommitmentRequest) (*alphapb.BigqueryreservationAlphaCapacityCommitment, error) {
 	p := ProtoToCapacityCommitment(request.GetResource())
-	res, err := c.ApplyCapacityCommitment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCapacityCommitment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -201,6 +206,60 @@ func (s *CapacityCommitmentServer) ApplyBigqueryreservationAlphaCapacityCommitme
 	return s.applyCapacityCommitment(ctx, cl, request)
 }
 
+// GetBigqueryreservationAlphaCapacityCommitment handles the gRPC request by passing it to the underlying CapacityCommitment Get() method.
+func (s *CapacityCommitmentServer) GetBigqueryreservationAlphaCapacityCommitment(ctx context.Context, request *alphapb.GetBigqueryreservationAlphaCapacityCommitmentRequest) (*alphapb.BigqueryreservationAlphaCapacityCommitment, error) {
+	cl, err := createConfigCapacityCommitment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCapacityCommitment(ctx, ProtoToCapacityCommitment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CapacityCommitmentToProto(res), nil
+}
+
+// HasDiffBigqueryreservationAlphaCapacityCommitment handles the gRPC request by passing it to the underlying CapacityCommitment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CapacityCommitmentServer) HasDiffBigqueryreservationAlphaCapacityCommitment(ctx context.Context, request *alphapb.HasDiffBigqueryreservationAlphaCapacityCommitmentRequest) (*alphapb.HasDiffBigqueryreservationAlphaCapacityCommitmentResponse, error) {
+	cl, err := createConfigCapacityCommitment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCapacityCommitment(ctx, ProtoToCapacityCommitment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffBigqueryreservationAlphaCapacityCommitmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryreservationAlphaCapacityCommitment handles the gRPC request by passing it to the underlying CapacityCommitment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CapacityCommitmentServer) PlanBigqueryreservationAlphaCapacityCommitment(ctx context.Context, request *alphapb.PlanBigqueryreservationAlphaCapacityCommitmentRequest) (*alphapb.PlanBigqueryreservationAlphaCapacityCommitmentResponse, error) {
+	cl, err := createConfigCapacityCommitment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCapacityCommitment(ctx, ProtoToCapacityCommitment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanBigqueryreservationAlphaCapacityCommitmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCapacityCommitment handles the gRPC request by passing it to the underlying CapacityCommitment Delete() method.
 func (s *CapacityCommitmentServer) DeleteBigqueryreservationAlphaCapacityCommitment(ctx context.Context, request *alphapb.DeleteBigqueryreservationAlphaCapacityCommitmentRequest) (*emptypb.Empty, error) {
 
@@ -219,22 +278,35 @@ func (s *CapacityCommitmentServer) ListBigqueryreservationAlphaCapacityCommitmen
 		return nil, err
 	}
 
-	resources, err := cl.ListCapacityCommitment(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.CapacityCommitmentMaxPage
+	}
+	resources, err := cl.ListCapacityCommitmentWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.BigqueryreservationAlphaCapacityCommitment
-	for _, r := range resources.Items {
-		rp := CapacityCommitmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CapacityCommitmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListBigqueryreservationAlphaCapacityCommitmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCapacityCommitment(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigqueryreservation/beta/bigqueryreservation_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigqueryreservation/beta"
@@ -101,7 +102,11 @@ func AssignmentToProto(resource *beta.Assignment) *betapb.BigqueryreservationBet
 // applyAssignment handles the gRPC request by passing it to the underlying Assignment Apply() method.
 func (s *AssignmentServer) applyAssignment(ctx context.Context, c *beta.Client, request *betapb.ApplyBigqueryreservationBetaAssignmentRequest) (*betapb.BigqueryreservationBetaAssignment, error) {
 	p := ProtoToAssignment(request.GetResource())
-	res, err := c.ApplyAssignment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAssignment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +123,60 @@ func (s *AssignmentServer) ApplyBigqueryreservationBetaAssignment(ctx context.Co
 	return s.applyAssignment(ctx, cl, request)
 }
 
+// GetBigqueryreservationBetaAssignment handles the gRPC request by passing it to the underlying Assignment Get() method.
+func (s *AssignmentServer) GetBigqueryreservationBetaAssignment(ctx context.Context, request *betapb.GetBigqueryreservationBetaAssignmentRequest) (*betapb.BigqueryreservationBetaAssignment, error) {
+	cl, err := createConfigAssignment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAssignment(ctx, ProtoToAssignment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AssignmentToProto(res), nil
+}
+
+// HasDiffBigqueryreservationBetaAssignment handles the gRPC request by passing it to the underlying Assignment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AssignmentServer) HasDiffBigqueryreservationBetaAssignment(ctx context.Context, request *betapb.HasDiffBigqueryreservationBetaAssignmentRequest) (*betapb.HasDiffBigqueryreservationBetaAssignmentResponse, error) {
+	cl, err := createConfigAssignment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAssignment(ctx, ProtoToAssignment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffBigqueryreservationBetaAssignmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryreservationBetaAssignment handles the gRPC request by passing it to the underlying Assignment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AssignmentServer) PlanBigqueryreservationBetaAssignment(ctx context.Context, request *betapb.PlanBigqueryreservationBetaAssignmentRequest) (*betapb.PlanBigqueryreservationBetaAssignmentResponse, error) {
+	cl, err := createConfigAssignment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAssignment(ctx, ProtoToAssignment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanBigqueryreservationBetaAssignmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAssignment handles the gRPC request by passing it to the underlying Assignment Delete() method.
 func (s *AssignmentServer) DeleteBigqueryreservationBetaAssignment(ctx context.Context, request *betapb.DeleteBigqueryreservationBetaAssignmentRequest) (*emptypb.Empty, error) {
 
@@ -136,22 +195,35 @@ func (s *AssignmentServer) ListBigqueryreservationBetaAssignment(ctx context.Con
 		return nil, err
 	}
 
-	resources, err := cl.ListAssignment(ctx, request.GetProject(), request.GetLocation(), request.GetReservation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.AssignmentMaxPage
+	}
+	resources, err := cl.ListAssignmentWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetReservation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.BigqueryreservationBetaAssignment
-	for _, r := range resources.Items {
-		rp := AssignmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AssignmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListBigqueryreservationBetaAssignmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAssignment(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigqueryreservation/beta/bigqueryreservation_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigqueryreservation/beta"
@@ -57,7 +58,11 @@ func ReservationToProto(resource *beta.Reservation) *betapb.BigqueryreservationB
 // applyReservation handles the gRPC request by passing it to the underlying Reservation Apply() method.
 func (s *ReservationServer) applyReservation(ctx context.Context, c *beta.Client, request *betapb.ApplyBigqueryreservationBetaReservationRequest) (*betapb.BigqueryreservationBetaReservation, error) {
 	p := ProtoToReservation(request.GetResource())
-	res, err := c.ApplyReservation(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyReservation(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +79,60 @@ func (s *ReservationServer) ApplyBigqueryreservationBetaReservation(ctx context.
 	return s.applyReservation(ctx, cl, request)
 }
 
+// GetBigqueryreservationBetaReservation handles the gRPC request by passing it to the underlying Reservation Get() method.
+func (s *ReservationServer) GetBigqueryreservationBetaReservation(ctx context.Context, request *betapb.GetBigqueryreservationBetaReservationRequest) (*betapb.BigqueryreservationBetaReservation, error) {
+	cl, err := createConfigReservation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetReservation(ctx, ProtoToReservation(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ReservationToProto(res), nil
+}
+
+// HasDiffBigqueryreservationBetaReservation handles the gRPC request by passing it to the underlying Reservation Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ReservationServer) HasDiffBigqueryreservationBetaReservation(ctx context.Context, request *betapb.HasDiffBigqueryreservationBetaReservationRequest) (*betapb.HasDiffBigqueryreservationBetaReservationResponse, error) {
+	cl, err := createConfigReservation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffReservation(ctx, ProtoToReservation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffBigqueryreservationBetaReservationResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryreservationBetaReservation handles the gRPC request by passing it to the underlying Reservation Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ReservationServer) PlanBigqueryreservationBetaReservation(ctx context.Context, request *betapb.PlanBigqueryreservationBetaReservationRequest) (*betapb.PlanBigqueryreservationBetaReservationResponse, error) {
+	cl, err := createConfigReservation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffReservation(ctx, ProtoToReservation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanBigqueryreservationBetaReservationResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteReservation handles the gRPC request by passing it to the underlying Reservation Delete() method.
 func (s *ReservationServer) DeleteBigqueryreservationBetaReservation(ctx context.Context, request *betapb.DeleteBigqueryreservationBetaReservationRequest) (*emptypb.Empty, error) {
 
@@ -92,22 +151,35 @@ func (s *ReservationServer) ListBigqueryreservationBetaReservation(ctx context.C
 		return nil, err
 	}
 
-	resources, err := cl.ListReservation(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ReservationMaxPage
+	}
+	resources, err := cl.ListReservationWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.BigqueryreservationBetaReservation
-	for _, r := range resources.Items {
-		rp := ReservationToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ReservationToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListBigqueryreservationBetaReservationResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigReservation(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
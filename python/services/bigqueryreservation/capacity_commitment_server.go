@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	bigqueryreservationpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigqueryreservation/bigqueryreservation_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigqueryreservation"
@@ -184,7 +185,11 @@ func CapacityCommitmentToProto(resource *bigqueryreservation.CapacityCommitment)
 // applyCapacityCommitment handles the gRPC request by passing it to the underlying CapacityCommitment Apply() method.
 func (s *CapacityCommitmentServer) applyCapacityCommitment(ctx context.Context, c *bigqueryreservation.Client, request *bigqueryreservationpb.ApplyBigqueryreservationCapacityCommitmentRequest) (*bigqueryreservationpb.BigqueryreservationCapacityCommitment, error) {
 	p := ProtoToCapacityCommitment(request.GetResource())
-	res, err := c.ApplyCapacityCommitment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCapacityCommitment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -201,6 +206,60 @@ func (s *CapacityCommitmentServer) ApplyBigqueryreservationCapacityCommitment(ct
 	return s.applyCapacityCommitment(ctx, cl, request)
 }
 
+// GetBigqueryreservationCapacityCommitment handles the gRPC request by passing it to the underlying CapacityCommitment Get() method.
+func (s *CapacityCommitmentServer) GetBigqueryreservationCapacityCommitment(ctx context.Context, request *bigqueryreservationpb.GetBigqueryreservationCapacityCommitmentRequest) (*bigqueryreservationpb.BigqueryreservationCapacityCommitment, error) {
+	cl, err := createConfigCapacityCommitment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCapacityCommitment(ctx, ProtoToCapacityCommitment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CapacityCommitmentToProto(res), nil
+}
+
+// HasDiffBigqueryreservationCapacityCommitment handles the gRPC request by passing it to the underlying CapacityCommitment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CapacityCommitmentServer) HasDiffBigqueryreservationCapacityCommitment(ctx context.Context, request *bigqueryreservationpb.HasDiffBigqueryreservationCapacityCommitmentRequest) (*bigqueryreservationpb.HasDiffBigqueryreservationCapacityCommitmentResponse, error) {
+	cl, err := createConfigCapacityCommitment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCapacityCommitment(ctx, ProtoToCapacityCommitment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &bigqueryreservationpb.HasDiffBigqueryreservationCapacityCommitmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryreservationCapacityCommitment handles the gRPC request by passing it to the underlying CapacityCommitment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CapacityCommitmentServer) PlanBigqueryreservationCapacityCommitment(ctx context.Context, request *bigqueryreservationpb.PlanBigqueryreservationCapacityCommitmentRequest) (*bigqueryreservationpb.PlanBigqueryreservationCapacityCommitmentResponse, error) {
+	cl, err := createConfigCapacityCommitment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCapacityCommitment(ctx, ProtoToCapacityCommitment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &bigqueryreservationpb.PlanBigqueryreservationCapacityCommitmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCapacityCommitment handles the gRPC request by passing it to the underlying CapacityCommitment Delete() method.
 func (s *CapacityCommitmentServer) DeleteBigqueryreservationCapacityCommitment(ctx context.Context, request *bigqueryreservationpb.DeleteBigqueryreservationCapacityCommitmentRequest) (*emptypb.Empty, error) {
 
@@ -219,22 +278,35 @@ func (s *CapacityCommitmentServer) ListBigqueryreservationCapacityCommitment(ctx
 		return nil, err
 	}
 
-	resources, err := cl.ListCapacityCommitment(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = bigqueryreservation.CapacityCommitmentMaxPage
+	}
+	resources, err := cl.ListCapacityCommitmentWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*bigqueryreservationpb.BigqueryreservationCapacityCommitment
-	for _, r := range resources.Items {
-		rp := CapacityCommitmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CapacityCommitmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &bigqueryreservationpb.ListBigqueryreservationCapacityCommitmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCapacityCommitment(ctx context.Context, service_account_file string) (*bigqueryreservation.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return bigqueryreservation.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	bigqueryreservationpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/bigqueryreservation/bigqueryreservation_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/bigqueryreservation"
@@ -55,7 +56,11 @@ func ReservationToProto(resource *bigqueryreservation.Reservation) *bigqueryrese
 // applyReservation handles the gRPC request by passing it to the underlying Reservation Apply() method.
 func (s *ReservationServer) applyReservation(ctx context.Context, c *bigqueryreservation.Client, request *bigqueryreservationpb.ApplyBigqueryreservationReservationRequest) (*bigqueryreservationpb.BigqueryreservationReservation, error) {
 	p := ProtoToReservation(request.GetResource())
-	res, err := c.ApplyReservation(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyReservation(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,60 @@ func (s *ReservationServer) ApplyBigqueryreservationReservation(ctx context.Cont
 	return s.applyReservation(ctx, cl, request)
 }
 
+// GetBigqueryreservationReservation handles the gRPC request by passing it to the underlying Reservation Get() method.
+func (s *ReservationServer) GetBigqueryreservationReservation(ctx context.Context, request *bigqueryreservationpb.GetBigqueryreservationReservationRequest) (*bigqueryreservationpb.BigqueryreservationReservation, error) {
+	cl, err := createConfigReservation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetReservation(ctx, ProtoToReservation(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ReservationToProto(res), nil
+}
+
+// HasDiffBigqueryreservationReservation handles the gRPC request by passing it to the underlying Reservation Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ReservationServer) HasDiffBigqueryreservationReservation(ctx context.Context, request *bigqueryreservationpb.HasDiffBigqueryreservationReservationRequest) (*bigqueryreservationpb.HasDiffBigqueryreservationReservationResponse, error) {
+	cl, err := createConfigReservation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffReservation(ctx, ProtoToReservation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &bigqueryreservationpb.HasDiffBigqueryreservationReservationResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBigqueryreservationReservation handles the gRPC request by passing it to the underlying Reservation Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ReservationServer) PlanBigqueryreservationReservation(ctx context.Context, request *bigqueryreservationpb.PlanBigqueryreservationReservationRequest) (*bigqueryreservationpb.PlanBigqueryreservationReservationResponse, error) {
+	cl, err := createConfigReservation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffReservation(ctx, ProtoToReservation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &bigqueryreservationpb.PlanBigqueryreservationReservationResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteReservation handles the gRPC request by passing it to the underlying Reservation Delete() method.
 func (s *ReservationServer) DeleteBigqueryreservationReservation(ctx context.Context, request *bigqueryreservationpb.DeleteBigqueryreservationReservationRequest) (*emptypb.Empty, error) {
 
@@ -90,22 +149,35 @@ func (s *ReservationServer) ListBigqueryreservationReservation(ctx context.Conte
 		return nil, err
 	}
 
-	resources, err := cl.ListReservation(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = bigqueryreservation.ReservationMaxPage
+	}
+	resources, err := cl.ListReservationWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*bigqueryreservationpb.BigqueryreservationReservation
-	for _, r := range resources.Items {
-		rp := ReservationToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ReservationToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &bigqueryreservationpb.ListBigqueryreservationReservationResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigReservation(ctx context.Context, service_account_file string) (*bigqueryreservation.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return bigqueryreservation.NewClient(conf), nil
 }
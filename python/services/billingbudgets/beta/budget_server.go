@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/billingbudgets/beta/billingbudgets_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/billingbudgets/beta"
@@ -415,7 +416,11 @@ func BudgetToProto(resource *beta.Budget) *betapb.BillingbudgetsBetaBudget {
 // applyBudget handles the gRPC request by passing it to the underlying Budget Apply() method.
 func (s *BudgetServer) applyBudget(ctx context.Context, c *beta.Client, request *betapb.ApplyBillingbudgetsBetaBudgetRequest) (*betapb.BillingbudgetsBetaBudget, error) {
 	p := ProtoToBudget(request.GetResource())
-	res, err := c.ApplyBudget(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBudget(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -432,6 +437,60 @@ func (s *BudgetServer) ApplyBillingbudgetsBetaBudget(ctx context.Context, reques
 	return s.applyBudget(ctx, cl, request)
 }
 
+// GetBillingbudgetsBetaBudget handles the gRPC request by passing it to the underlying Budget Get() method.
+func (s *BudgetServer) GetBillingbudgetsBetaBudget(ctx context.Context, request *betapb.GetBillingbudgetsBetaBudgetRequest) (*betapb.BillingbudgetsBetaBudget, error) {
+	cl, err := createConfigBudget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBudget(ctx, ProtoToBudget(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BudgetToProto(res), nil
+}
+
+// HasDiffBillingbudgetsBetaBudget handles the gRPC request by passing it to the underlying Budget Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BudgetServer) HasDiffBillingbudgetsBetaBudget(ctx context.Context, request *betapb.HasDiffBillingbudgetsBetaBudgetRequest) (*betapb.HasDiffBillingbudgetsBetaBudgetResponse, error) {
+	cl, err := createConfigBudget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBudget(ctx, ProtoToBudget(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffBillingbudgetsBetaBudgetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBillingbudgetsBetaBudget handles the gRPC request by passing it to the underlying Budget Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BudgetServer) PlanBillingbudgetsBetaBudget(ctx context.Context, request *betapb.PlanBillingbudgetsBetaBudgetRequest) (*betapb.PlanBillingbudgetsBetaBudgetResponse, error) {
+	cl, err := createConfigBudget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBudget(ctx, ProtoToBudget(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanBillingbudgetsBetaBudgetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteBudget handles the gRPC request by passing it to the underlying Budget Delete() method.
 func (s *BudgetServer) DeleteBillingbudgetsBetaBudget(ctx context.Context, request *betapb.DeleteBillingbudgetsBetaBudgetRequest) (*emptypb.Empty, error) {
 
@@ -450,22 +509,35 @@ func (s *BudgetServer) ListBillingbudgetsBetaBudget(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListBudget(ctx, request.GetBillingAccount())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.BudgetMaxPage
+	}
+	resources, err := cl.ListBudgetWithPageToken(ctx, request.GetBillingAccount(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.BillingbudgetsBetaBudget
-	for _, r := range resources.Items {
-		rp := BudgetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BudgetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListBillingbudgetsBetaBudgetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigBudget(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	billingbudgetspb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/billingbudgets/billingbudgets_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/billingbudgets"
@@ -415,7 +416,11 @@ func BudgetToProto(resource *billingbudgets.Budget) *billingbudgetspb.Billingbud
 // applyBudget handles the gRPC request by passing it to the underlying Budget Apply() method.
 func (s *BudgetServer) applyBudget(ctx context.Context, c *billingbudgets.Client, request *billingbudgetspb.ApplyBillingbudgetsBudgetRequest) (*billingbudgetspb.BillingbudgetsBudget, error) {
 	p := ProtoToBudget(request.GetResource())
-	res, err := c.ApplyBudget(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBudget(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -432,6 +437,60 @@ func (s *BudgetServer) ApplyBillingbudgetsBudget(ctx context.Context, request *b
 	return s.applyBudget(ctx, cl, request)
 }
 
+// GetBillingbudgetsBudget handles the gRPC request by passing it to the underlying Budget Get() method.
+func (s *BudgetServer) GetBillingbudgetsBudget(ctx context.Context, request *billingbudgetspb.GetBillingbudgetsBudgetRequest) (*billingbudgetspb.BillingbudgetsBudget, error) {
+	cl, err := createConfigBudget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBudget(ctx, ProtoToBudget(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BudgetToProto(res), nil
+}
+
+// HasDiffBillingbudgetsBudget handles the gRPC request by passing it to the underlying Budget Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BudgetServer) HasDiffBillingbudgetsBudget(ctx context.Context, request *billingbudgetspb.HasDiffBillingbudgetsBudgetRequest) (*billingbudgetspb.HasDiffBillingbudgetsBudgetResponse, error) {
+	cl, err := createConfigBudget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBudget(ctx, ProtoToBudget(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &billingbudgetspb.HasDiffBillingbudgetsBudgetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBillingbudgetsBudget handles the gRPC request by passing it to the underlying Budget Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BudgetServer) PlanBillingbudgetsBudget(ctx context.Context, request *billingbudgetspb.PlanBillingbudgetsBudgetRequest) (*billingbudgetspb.PlanBillingbudgetsBudgetResponse, error) {
+	cl, err := createConfigBudget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBudget(ctx, ProtoToBudget(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &billingbudgetspb.PlanBillingbudgetsBudgetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteBudget handles the gRPC request by passing it to the underlying Budget Delete() method.
 func (s *BudgetServer) DeleteBillingbudgetsBudget(ctx context.Context, request *billingbudgetspb.DeleteBillingbudgetsBudgetRequest) (*emptypb.Empty, error) {
 
@@ -450,22 +509,35 @@ func (s *BudgetServer) ListBillingbudgetsBudget(ctx context.Context, request *bi
 		return nil, err
 	}
 
-	resources, err := cl.ListBudget(ctx, request.GetBillingAccount())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = billingbudgets.BudgetMaxPage
+	}
+	resources, err := cl.ListBudgetWithPageToken(ctx, request.GetBillingAccount(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*billingbudgetspb.BillingbudgetsBudget
-	for _, r := range resources.Items {
-		rp := BudgetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BudgetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &billingbudgetspb.ListBillingbudgetsBudgetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigBudget(ctx context.Context, service_account_file string) (*billingbudgets.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return billingbudgets.NewClient(conf), nil
 }
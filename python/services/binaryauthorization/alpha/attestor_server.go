@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/binaryauthorization/alpha/binaryauthorization_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/binaryauthorization/alpha"
@@ -155,7 +156,11 @@ func AttestorToProto(resource *alpha.Attestor) *alphapb.BinaryauthorizationAlpha
 // applyAttestor handles the gRPC request by passing it to the underlying Attestor Apply() method.
 func (s *AttestorServer) applyAttestor(ctx context.Context, c *alpha.Client, request *alphapb.ApplyBinaryauthorizationAlphaAttestorRequest) (*alphapb.BinaryauthorizationAlphaAttestor, error) {
 	p := ProtoToAttestor(request.GetResource())
-	res, err := c.ApplyAttestor(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAttestor(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +177,60 @@ func (s *AttestorServer) ApplyBinaryauthorizationAlphaAttestor(ctx context.Conte
 	return s.applyAttestor(ctx, cl, request)
 }
 
+// GetBinaryauthorizationAlphaAttestor handles the gRPC request by passing it to the underlying Attestor Get() method.
+func (s *AttestorServer) GetBinaryauthorizationAlphaAttestor(ctx context.Context, request *alphapb.GetBinaryauthorizationAlphaAttestorRequest) (*alphapb.BinaryauthorizationAlphaAttestor, error) {
+	cl, err := createConfigAttestor(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAttestor(ctx, ProtoToAttestor(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AttestorToProto(res), nil
+}
+
+// HasDiffBinaryauthorizationAlphaAttestor handles the gRPC request by passing it to the underlying Attestor Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AttestorServer) HasDiffBinaryauthorizationAlphaAttestor(ctx context.Context, request *alphapb.HasDiffBinaryauthorizationAlphaAttestorRequest) (*alphapb.HasDiffBinaryauthorizationAlphaAttestorResponse, error) {
+	cl, err := createConfigAttestor(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAttestor(ctx, ProtoToAttestor(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffBinaryauthorizationAlphaAttestorResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBinaryauthorizationAlphaAttestor handles the gRPC request by passing it to the underlying Attestor Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AttestorServer) PlanBinaryauthorizationAlphaAttestor(ctx context.Context, request *alphapb.PlanBinaryauthorizationAlphaAttestorRequest) (*alphapb.PlanBinaryauthorizationAlphaAttestorResponse, error) {
+	cl, err := createConfigAttestor(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAttestor(ctx, ProtoToAttestor(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanBinaryauthorizationAlphaAttestorResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAttestor handles the gRPC request by passing it to the underlying Attestor Delete() method.
 func (s *AttestorServer) DeleteBinaryauthorizationAlphaAttestor(ctx context.Context, request *alphapb.DeleteBinaryauthorizationAlphaAttestorRequest) (*emptypb.Empty, error) {
 
@@ -190,22 +249,35 @@ func (s *AttestorServer) ListBinaryauthorizationAlphaAttestor(ctx context.Contex
 		return nil, err
 	}
 
-	resources, err := cl.ListAttestor(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.AttestorMaxPage
+	}
+	resources, err := cl.ListAttestorWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.BinaryauthorizationAlphaAttestor
-	for _, r := range resources.Items {
-		rp := AttestorToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AttestorToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListBinaryauthorizationAlphaAttestorResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAttestor(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/binaryauthorization/alpha/binaryauthorization_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/binaryauthorization/alpha"
@@ -511,7 +512,11 @@ func PolicyToProto(resource *alpha.Policy) *alphapb.BinaryauthorizationAlphaPoli
 // applyPolicy handles the gRPC request by passing it to the underlying Policy Apply() method.
 func (s *PolicyServer) applyPolicy(ctx context.Context, c *alpha.Client, request *alphapb.ApplyBinaryauthorizationAlphaPolicyRequest) (*alphapb.BinaryauthorizationAlphaPolicy, error) {
 	p := ProtoToPolicy(request.GetResource())
-	res, err := c.ApplyPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -528,6 +533,60 @@ func (s *PolicyServer) ApplyBinaryauthorizationAlphaPolicy(ctx context.Context,
 	return s.applyPolicy(ctx, cl, request)
 }
 
+// GetBinaryauthorizationAlphaPolicy handles the gRPC request by passing it to the underlying Policy Get() method.
+func (s *PolicyServer) GetBinaryauthorizationAlphaPolicy(ctx context.Context, request *alphapb.GetBinaryauthorizationAlphaPolicyRequest) (*alphapb.BinaryauthorizationAlphaPolicy, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetPolicy(ctx, ProtoToPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return PolicyToProto(res), nil
+}
+
+// HasDiffBinaryauthorizationAlphaPolicy handles the gRPC request by passing it to the underlying Policy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *PolicyServer) HasDiffBinaryauthorizationAlphaPolicy(ctx context.Context, request *alphapb.HasDiffBinaryauthorizationAlphaPolicyRequest) (*alphapb.HasDiffBinaryauthorizationAlphaPolicyResponse, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPolicy(ctx, ProtoToPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffBinaryauthorizationAlphaPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBinaryauthorizationAlphaPolicy handles the gRPC request by passing it to the underlying Policy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *PolicyServer) PlanBinaryauthorizationAlphaPolicy(ctx context.Context, request *alphapb.PlanBinaryauthorizationAlphaPolicyRequest) (*alphapb.PlanBinaryauthorizationAlphaPolicyResponse, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPolicy(ctx, ProtoToPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanBinaryauthorizationAlphaPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeletePolicy handles the gRPC request by passing it to the underlying Policy Delete() method.
 func (s *PolicyServer) DeleteBinaryauthorizationAlphaPolicy(ctx context.Context, request *alphapb.DeleteBinaryauthorizationAlphaPolicyRequest) (*emptypb.Empty, error) {
 
@@ -541,7 +600,9 @@ func (s *PolicyServer) ListBinaryauthorizationAlphaPolicy(_ context.Context, _ *
 }
 
 func createConfigPolicy(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	binaryauthorizationpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/binaryauthorization/binaryauthorization_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/binaryauthorization"
@@ -155,7 +156,11 @@ func AttestorToProto(resource *binaryauthorization.Attestor) *binaryauthorizatio
 // applyAttestor handles the gRPC request by passing it to the underlying Attestor Apply() method.
 func (s *AttestorServer) applyAttestor(ctx context.Context, c *binaryauthorization.Client, request *binaryauthorizationpb.ApplyBinaryauthorizationAttestorRequest) (*binaryauthorizationpb.BinaryauthorizationAttestor, error) {
 	p := ProtoToAttestor(request.GetResource())
-	res, err := c.ApplyAttestor(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAttestor(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +177,60 @@ func (s *AttestorServer) ApplyBinaryauthorizationAttestor(ctx context.Context, r
 	return s.applyAttestor(ctx, cl, request)
 }
 
+// GetBinaryauthorizationAttestor handles the gRPC request by passing it to the underlying Attestor Get() method.
+func (s *AttestorServer) GetBinaryauthorizationAttestor(ctx context.Context, request *binaryauthorizationpb.GetBinaryauthorizationAttestorRequest) (*binaryauthorizationpb.BinaryauthorizationAttestor, error) {
+	cl, err := createConfigAttestor(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAttestor(ctx, ProtoToAttestor(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AttestorToProto(res), nil
+}
+
+// HasDiffBinaryauthorizationAttestor handles the gRPC request by passing it to the underlying Attestor Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AttestorServer) HasDiffBinaryauthorizationAttestor(ctx context.Context, request *binaryauthorizationpb.HasDiffBinaryauthorizationAttestorRequest) (*binaryauthorizationpb.HasDiffBinaryauthorizationAttestorResponse, error) {
+	cl, err := createConfigAttestor(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAttestor(ctx, ProtoToAttestor(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &binaryauthorizationpb.HasDiffBinaryauthorizationAttestorResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBinaryauthorizationAttestor handles the gRPC request by passing it to the underlying Attestor Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AttestorServer) PlanBinaryauthorizationAttestor(ctx context.Context, request *binaryauthorizationpb.PlanBinaryauthorizationAttestorRequest) (*binaryauthorizationpb.PlanBinaryauthorizationAttestorResponse, error) {
+	cl, err := createConfigAttestor(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAttestor(ctx, ProtoToAttestor(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &binaryauthorizationpb.PlanBinaryauthorizationAttestorResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAttestor handles the gRPC request by passing it to the underlying Attestor Delete() method.
 func (s *AttestorServer) DeleteBinaryauthorizationAttestor(ctx context.Context, request *binaryauthorizationpb.DeleteBinaryauthorizationAttestorRequest) (*emptypb.Empty, error) {
 
@@ -190,22 +249,35 @@ func (s *AttestorServer) ListBinaryauthorizationAttestor(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListAttestor(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = binaryauthorization.AttestorMaxPage
+	}
+	resources, err := cl.ListAttestorWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*binaryauthorizationpb.BinaryauthorizationAttestor
-	for _, r := range resources.Items {
-		rp := AttestorToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AttestorToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &binaryauthorizationpb.ListBinaryauthorizationAttestorResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAttestor(ctx context.Context, service_account_file string) (*binaryauthorization.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return binaryauthorization.NewClient(conf), nil
 }
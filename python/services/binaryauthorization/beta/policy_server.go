@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/binaryauthorization/beta/binaryauthorization_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/binaryauthorization/beta"
@@ -511,7 +512,11 @@ func PolicyToProto(resource *beta.Policy) *betapb.BinaryauthorizationBetaPolicy
 // applyPolicy handles the gRPC request by passing it to the underlying Policy Apply() method.
 func (s *PolicyServer) applyPolicy(ctx context.Context, c *beta.Client, request *betapb.ApplyBinaryauthorizationBetaPolicyRequest) (*betapb.BinaryauthorizationBetaPolicy, error) {
 	p := ProtoToPolicy(request.GetResource())
-	res, err := c.ApplyPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -528,6 +533,60 @@ func (s *PolicyServer) ApplyBinaryauthorizationBetaPolicy(ctx context.Context, r
 	return s.applyPolicy(ctx, cl, request)
 }
 
+// GetBinaryauthorizationBetaPolicy handles the gRPC request by passing it to the underlying Policy Get() method.
+func (s *PolicyServer) GetBinaryauthorizationBetaPolicy(ctx context.Context, request *betapb.GetBinaryauthorizationBetaPolicyRequest) (*betapb.BinaryauthorizationBetaPolicy, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetPolicy(ctx, ProtoToPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return PolicyToProto(res), nil
+}
+
+// HasDiffBinaryauthorizationBetaPolicy handles the gRPC request by passing it to the underlying Policy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *PolicyServer) HasDiffBinaryauthorizationBetaPolicy(ctx context.Context, request *betapb.HasDiffBinaryauthorizationBetaPolicyRequest) (*betapb.HasDiffBinaryauthorizationBetaPolicyResponse, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPolicy(ctx, ProtoToPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffBinaryauthorizationBetaPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBinaryauthorizationBetaPolicy handles the gRPC request by passing it to the underlying Policy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *PolicyServer) PlanBinaryauthorizationBetaPolicy(ctx context.Context, request *betapb.PlanBinaryauthorizationBetaPolicyRequest) (*betapb.PlanBinaryauthorizationBetaPolicyResponse, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPolicy(ctx, ProtoToPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanBinaryauthorizationBetaPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeletePolicy handles the gRPC request by passing it to the underlying Policy Delete() method.
 func (s *PolicyServer) DeleteBinaryauthorizationBetaPolicy(ctx context.Context, request *betapb.DeleteBinaryauthorizationBetaPolicyRequest) (*emptypb.Empty, error) {
 
@@ -541,7 +600,9 @@ func (s *PolicyServer) ListBinaryauthorizationBetaPolicy(_ context.Context, _ *b
 }
 
 func createConfigPolicy(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	binaryauthorizationpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/binaryauthorization/binaryauthorization_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/binaryauthorization"
@@ -511,7 +512,11 @@ func PolicyToProto(resource *binaryauthorization.Policy) *binaryauthorizationpb.
 // applyPolicy handles the gRPC request by passing it to the underlying Policy Apply() method.
 func (s *PolicyServer) applyPolicy(ctx context.Context, c *binaryauthorization.Client, request *binaryauthorizationpb.ApplyBinaryauthorizationPolicyRequest) (*binaryauthorizationpb.BinaryauthorizationPolicy, error) {
 	p := ProtoToPolicy(request.GetResource())
-	res, err := c.ApplyPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -528,6 +533,60 @@ func (s *PolicyServer) ApplyBinaryauthorizationPolicy(ctx context.Context, reque
 	return s.applyPolicy(ctx, cl, request)
 }
 
+// GetBinaryauthorizationPolicy handles the gRPC request by passing it to the underlying Policy Get() method.
+func (s *PolicyServer) GetBinaryauthorizationPolicy(ctx context.Context, request *binaryauthorizationpb.GetBinaryauthorizationPolicyRequest) (*binaryauthorizationpb.BinaryauthorizationPolicy, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetPolicy(ctx, ProtoToPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return PolicyToProto(res), nil
+}
+
+// HasDiffBinaryauthorizationPolicy handles the gRPC request by passing it to the underlying Policy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *PolicyServer) HasDiffBinaryauthorizationPolicy(ctx context.Context, request *binaryauthorizationpb.HasDiffBinaryauthorizationPolicyRequest) (*binaryauthorizationpb.HasDiffBinaryauthorizationPolicyResponse, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPolicy(ctx, ProtoToPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &binaryauthorizationpb.HasDiffBinaryauthorizationPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanBinaryauthorizationPolicy handles the gRPC request by passing it to the underlying Policy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *PolicyServer) PlanBinaryauthorizationPolicy(ctx context.Context, request *binaryauthorizationpb.PlanBinaryauthorizationPolicyRequest) (*binaryauthorizationpb.PlanBinaryauthorizationPolicyResponse, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPolicy(ctx, ProtoToPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &binaryauthorizationpb.PlanBinaryauthorizationPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeletePolicy handles the gRPC request by passing it to the underlying Policy Delete() method.
 func (s *PolicyServer) DeleteBinaryauthorizationPolicy(ctx context.Context, request *binaryauthorizationpb.DeleteBinaryauthorizationPolicyRequest) (*emptypb.Empty, error) {
 
@@ -541,7 +600,9 @@ func (s *PolicyServer) ListBinaryauthorizationPolicy(_ context.Context, _ *binar
 }
 
 func createConfigPolicy(ctx context.Context, service_account_file string) (*binaryauthorization.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return binaryauthorization.NewClient(conf), nil
 }
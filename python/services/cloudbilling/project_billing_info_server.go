@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	cloudbillingpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudbilling/cloudbilling_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudbilling"
@@ -49,7 +50,11 @@ func ProjectBillingInfoToProto(resource *cloudbilling.ProjectBillingInfo) *cloud
 // ApplyProjectBillingInfo handles the gRPC request by passing it to the underlying ProjectBillingInfo Apply() method.
 func (s *ProjectBillingInfoServer) applyProjectBillingInfo(ctx context.Context, c *cloudbilling.Client, request *cloudbillingpb.ApplyCloudbillingProjectBillingInfoRequest) (*cloudbillingpb.CloudbillingProjectBillingInfo, error) {
 	p := ProtoToProjectBillingInfo(request.GetResource())
-	res, err := c.ApplyProjectBillingInfo(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyProjectBillingInfo(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +71,55 @@ func (s *ProjectBillingInfoServer) ApplyCloudbillingProjectBillingInfo(ctx conte
 	return s.applyProjectBillingInfo(ctx, cl, request)
 }
 
+// GetCloudbillingProjectBillingInfo handles the gRPC request by passing it to the underlying ProjectBillingInfo Get() method.
+func (s *ProjectBillingInfoServer) GetCloudbillingProjectBillingInfo(ctx context.Context, request *cloudbillingpb.GetCloudbillingProjectBillingInfoRequest) (*cloudbillingpb.CloudbillingProjectBillingInfo, error) {
+	cl, err := createConfigProjectBillingInfo(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetProjectBillingInfo(ctx, ProtoToProjectBillingInfo(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ProjectBillingInfoToProto(res), nil
+}
+
+// HasDiffCloudbillingProjectBillingInfo handles the gRPC request by passing it to the underlying ProjectBillingInfo Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ProjectBillingInfoServer) HasDiffCloudbillingProjectBillingInfo(ctx context.Context, request *cloudbillingpb.HasDiffCloudbillingProjectBillingInfoRequest) (*cloudbillingpb.HasDiffCloudbillingProjectBillingInfoResponse, error) {
+	cl, err := createConfigProjectBillingInfo(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffProjectBillingInfo(ctx, ProtoToProjectBillingInfo(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &cloudbillingpb.HasDiffCloudbillingProjectBillingInfoResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanCloudbillingProjectBillingInfo handles the gRPC request by passing it to the underlying ProjectBillingInfo Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ProjectBillingInfoServer) PlanCloudbillingProjectBillingInfo(ctx context.Context, request *cloudbillingpb.PlanCloudbillingProjectBillingInfoRequest) (*cloudbillingpb.PlanCloudbillingProjectBillingInfoResponse, error) {
+	cl, err := createConfigProjectBillingInfo(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffProjectBillingInfo(ctx, ProtoToProjectBillingInfo(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &cloudbillingpb.PlanCloudbillingProjectBillingInfoResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteProjectBillingInfo handles the gRPC request by passing it to the underlying ProjectBillingInfo Delete() method.
 func (s *ProjectBillingInfoServer) DeleteCloudbillingProjectBillingInfo(ctx context.Context, request *cloudbillingpb.DeleteCloudbillingProjectBillingInfoRequest) (*emptypb.Empty, error) {
 
@@ -83,7 +137,9 @@ func (s *ProjectBillingInfoServer) ListCloudbillingProjectBillingInfo(_ context.
 }
 
 func createConfigProjectBillingInfo(ctx context.Context, service_account_file string) (*cloudbilling.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return cloudbilling.NewClient(conf), nil
 }
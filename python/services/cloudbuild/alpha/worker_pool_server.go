@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudbuild/alpha/cloudbuild_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudbuild/alpha"
@@ -233,7 +234,11 @@ func WorkerPoolToProto(resource *alpha.WorkerPool) *alphapb.CloudbuildAlphaWorke
 // applyWorkerPool handles the gRPC request by passing it to the underlying WorkerPool Apply() method.
 func (s *WorkerPoolServer) applyWorkerPool(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudbuildAlphaWorkerPoolRequest) (*alphapb.CloudbuildAlphaWorkerPool, error) {
 	p := ProtoToWorkerPool(request.GetResource())
-	res, err := c.ApplyWorkerPool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkerPool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -250,6 +255,60 @@ func (s *WorkerPoolServer) ApplyCloudbuildAlphaWorkerPool(ctx context.Context, r
 	return s.applyWorkerPool(ctx, cl, request)
 }
 
+// GetCloudbuildAlphaWorkerPool handles the gRPC request by passing it to the underlying WorkerPool Get() method.
+func (s *WorkerPoolServer) GetCloudbuildAlphaWorkerPool(ctx context.Context, request *alphapb.GetCloudbuildAlphaWorkerPoolRequest) (*alphapb.CloudbuildAlphaWorkerPool, error) {
+	cl, err := createConfigWorkerPool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkerPool(ctx, ProtoToWorkerPool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkerPoolToProto(res), nil
+}
+
+// HasDiffCloudbuildAlphaWorkerPool handles the gRPC request by passing it to the underlying WorkerPool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkerPoolServer) HasDiffCloudbuildAlphaWorkerPool(ctx context.Context, request *alphapb.HasDiffCloudbuildAlphaWorkerPoolRequest) (*alphapb.HasDiffCloudbuildAlphaWorkerPoolResponse, error) {
+	cl, err := createConfigWorkerPool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkerPool(ctx, ProtoToWorkerPool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudbuildAlphaWorkerPoolResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudbuildAlphaWorkerPool handles the gRPC request by passing it to the underlying WorkerPool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkerPoolServer) PlanCloudbuildAlphaWorkerPool(ctx context.Context, request *alphapb.PlanCloudbuildAlphaWorkerPoolRequest) (*alphapb.PlanCloudbuildAlphaWorkerPoolResponse, error) {
+	cl, err := createConfigWorkerPool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkerPool(ctx, ProtoToWorkerPool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudbuildAlphaWorkerPoolResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkerPool handles the gRPC request by passing it to the underlying WorkerPool Delete() method.
 func (s *WorkerPoolServer) DeleteCloudbuildAlphaWorkerPool(ctx context.Context, request *alphapb.DeleteCloudbuildAlphaWorkerPoolRequest) (*emptypb.Empty, error) {
 
@@ -268,22 +327,35 @@ func (s *WorkerPoolServer) ListCloudbuildAlphaWorkerPool(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkerPool(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.WorkerPoolMaxPage
+	}
+	resources, err := cl.ListWorkerPoolWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.CloudbuildAlphaWorkerPool
-	for _, r := range resources.Items {
-		rp := WorkerPoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkerPoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListCloudbuildAlphaWorkerPoolResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkerPool(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
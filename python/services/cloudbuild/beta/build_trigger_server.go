@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudbuild/beta/cloudbuild_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudbuild/beta"
@@ -549,7 +550,11 @@ func BuildTriggerToProto(resource *beta.BuildTrigger) *betapb.CloudbuildBetaBuil
 // ApplyBuildTrigger handles the gRPC request by passing it to the underlying BuildTrigger Apply() method.
 func (s *BuildTriggerServer) applyBuildTrigger(ctx context.Context, c *beta.Client, request *betapb.ApplyCloudbuildBetaBuildTriggerRequest) (*betapb.CloudbuildBetaBuildTrigger, error) {
 	p := ProtoToBuildTrigger(request.GetResource())
-	res, err := c.ApplyBuildTrigger(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBuildTrigger(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -566,6 +571,55 @@ func (s *BuildTriggerServer) ApplyCloudbuildBetaBuildTrigger(ctx context.Context
 	return s.applyBuildTrigger(ctx, cl, request)
 }
 
+// GetCloudbuildBetaBuildTrigger handles the gRPC request by passing it to the underlying BuildTrigger Get() method.
+func (s *BuildTriggerServer) GetCloudbuildBetaBuildTrigger(ctx context.Context, request *betapb.GetCloudbuildBetaBuildTriggerRequest) (*betapb.CloudbuildBetaBuildTrigger, error) {
+	cl, err := createConfigBuildTrigger(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBuildTrigger(ctx, ProtoToBuildTrigger(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BuildTriggerToProto(res), nil
+}
+
+// HasDiffCloudbuildBetaBuildTrigger handles the gRPC request by passing it to the underlying BuildTrigger Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BuildTriggerServer) HasDiffCloudbuildBetaBuildTrigger(ctx context.Context, request *betapb.HasDiffCloudbuildBetaBuildTriggerRequest) (*betapb.HasDiffCloudbuildBetaBuildTriggerResponse, error) {
+	cl, err := createConfigBuildTrigger(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBuildTrigger(ctx, ProtoToBuildTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffCloudbuildBetaBuildTriggerResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanCloudbuildBetaBuildTrigger handles the gRPC request by passing it to the underlying BuildTrigger Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BuildTriggerServer) PlanCloudbuildBetaBuildTrigger(ctx context.Context, request *betapb.PlanCloudbuildBetaBuildTriggerRequest) (*betapb.PlanCloudbuildBetaBuildTriggerResponse, error) {
+	cl, err := createConfigBuildTrigger(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBuildTrigger(ctx, ProtoToBuildTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanCloudbuildBetaBuildTriggerResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteBuildTrigger handles the gRPC request by passing it to the underlying BuildTrigger Delete() method.
 func (s *BuildTriggerServer) DeleteCloudbuildBetaBuildTrigger(ctx context.Context, request *betapb.DeleteCloudbuildBetaBuildTriggerRequest) (*emptypb.Empty, error) {
 
@@ -584,20 +638,32 @@ func (s *BuildTriggerServer) ListCloudbuildBetaBuildTrigger(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListBuildTrigger(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.BuildTriggerMaxPage
+	}
+	resources, err := cl.ListBuildTriggerWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.CloudbuildBetaBuildTrigger
-	for _, r := range resources.Items {
-		rp := BuildTriggerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BuildTriggerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListCloudbuildBetaBuildTriggerResponse{Items: protos}, nil
+	return &betapb.ListCloudbuildBetaBuildTriggerResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigBuildTrigger(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	cloudbuildpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudbuild/cloudbuild_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudbuild"
@@ -549,7 +550,11 @@ func BuildTriggerToProto(resource *cloudbuild.BuildTrigger) *cloudbuildpb.Cloudb
 // ApplyBuildTrigger handles the gRPC request by passing it to the underlying BuildTrigger Apply() method.
 func (s *BuildTriggerServer) applyBuildTrigger(ctx context.Context, c *cloudbuild.Client, request *cloudbuildpb.ApplyCloudbuildBuildTriggerRequest) (*cloudbuildpb.CloudbuildBuildTrigger, error) {
 	p := ProtoToBuildTrigger(request.GetResource())
-	res, err := c.ApplyBuildTrigger(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBuildTrigger(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -566,6 +571,55 @@ func (s *BuildTriggerServer) ApplyCloudbuildBuildTrigger(ctx context.Context, re
 	return s.applyBuildTrigger(ctx, cl, request)
 }
 
+// GetCloudbuildBuildTrigger handles the gRPC request by passing it to the underlying BuildTrigger Get() method.
+func (s *BuildTriggerServer) GetCloudbuildBuildTrigger(ctx context.Context, request *cloudbuildpb.GetCloudbuildBuildTriggerRequest) (*cloudbuildpb.CloudbuildBuildTrigger, error) {
+	cl, err := createConfigBuildTrigger(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBuildTrigger(ctx, ProtoToBuildTrigger(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BuildTriggerToProto(res), nil
+}
+
+// HasDiffCloudbuildBuildTrigger handles the gRPC request by passing it to the underlying BuildTrigger Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BuildTriggerServer) HasDiffCloudbuildBuildTrigger(ctx context.Context, request *cloudbuildpb.HasDiffCloudbuildBuildTriggerRequest) (*cloudbuildpb.HasDiffCloudbuildBuildTriggerResponse, error) {
+	cl, err := createConfigBuildTrigger(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBuildTrigger(ctx, ProtoToBuildTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &cloudbuildpb.HasDiffCloudbuildBuildTriggerResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanCloudbuildBuildTrigger handles the gRPC request by passing it to the underlying BuildTrigger Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BuildTriggerServer) PlanCloudbuildBuildTrigger(ctx context.Context, request *cloudbuildpb.PlanCloudbuildBuildTriggerRequest) (*cloudbuildpb.PlanCloudbuildBuildTriggerResponse, error) {
+	cl, err := createConfigBuildTrigger(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBuildTrigger(ctx, ProtoToBuildTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &cloudbuildpb.PlanCloudbuildBuildTriggerResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteBuildTrigger handles the gRPC request by passing it to the underlying BuildTrigger Delete() method.
 func (s *BuildTriggerServer) DeleteCloudbuildBuildTrigger(ctx context.Context, request *cloudbuildpb.DeleteCloudbuildBuildTriggerRequest) (*emptypb.Empty, error) {
 
@@ -584,20 +638,32 @@ func (s *BuildTriggerServer) ListCloudbuildBuildTrigger(ctx context.Context, req
 		return nil, err
 	}
 
-	resources, err := cl.ListBuildTrigger(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = cloudbuild.BuildTriggerMaxPage
+	}
+	resources, err := cl.ListBuildTriggerWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*cloudbuildpb.CloudbuildBuildTrigger
-	for _, r := range resources.Items {
-		rp := BuildTriggerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BuildTriggerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &cloudbuildpb.ListCloudbuildBuildTriggerResponse{Items: protos}, nil
+	return &cloudbuildpb.ListCloudbuildBuildTriggerResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigBuildTrigger(ctx context.Context, service_account_file string) (*cloudbuild.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return cloudbuild.NewClient(conf), nil
 }
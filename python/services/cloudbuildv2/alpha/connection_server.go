@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudbuildv2/alpha/cloudbuildv2_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudbuildv2/alpha"
@@ -218,7 +219,11 @@ func ConnectionToProto(resource *alpha.Connection) *alphapb.Cloudbuildv2AlphaCon
 // applyConnection handles the gRPC request by passing it to the underlying Connection Apply() method.
 func (s *ConnectionServer) applyConnection(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudbuildv2AlphaConnectionRequest) (*alphapb.Cloudbuildv2AlphaConnection, error) {
 	p := ProtoToConnection(request.GetResource())
-	res, err := c.ApplyConnection(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyConnection(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +240,60 @@ func (s *ConnectionServer) ApplyCloudbuildv2AlphaConnection(ctx context.Context,
 	return s.applyConnection(ctx, cl, request)
 }
 
+// GetCloudbuildv2AlphaConnection handles the gRPC request by passing it to the underlying Connection Get() method.
+func (s *ConnectionServer) GetCloudbuildv2AlphaConnection(ctx context.Context, request *alphapb.GetCloudbuildv2AlphaConnectionRequest) (*alphapb.Cloudbuildv2AlphaConnection, error) {
+	cl, err := createConfigConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetConnection(ctx, ProtoToConnection(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ConnectionToProto(res), nil
+}
+
+// HasDiffCloudbuildv2AlphaConnection handles the gRPC request by passing it to the underlying Connection Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ConnectionServer) HasDiffCloudbuildv2AlphaConnection(ctx context.Context, request *alphapb.HasDiffCloudbuildv2AlphaConnectionRequest) (*alphapb.HasDiffCloudbuildv2AlphaConnectionResponse, error) {
+	cl, err := createConfigConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConnection(ctx, ProtoToConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudbuildv2AlphaConnectionResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudbuildv2AlphaConnection handles the gRPC request by passing it to the underlying Connection Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ConnectionServer) PlanCloudbuildv2AlphaConnection(ctx context.Context, request *alphapb.PlanCloudbuildv2AlphaConnectionRequest) (*alphapb.PlanCloudbuildv2AlphaConnectionResponse, error) {
+	cl, err := createConfigConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConnection(ctx, ProtoToConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudbuildv2AlphaConnectionResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteConnection handles the gRPC request by passing it to the underlying Connection Delete() method.
 func (s *ConnectionServer) DeleteCloudbuildv2AlphaConnection(ctx context.Context, request *alphapb.DeleteCloudbuildv2AlphaConnectionRequest) (*emptypb.Empty, error) {
 
@@ -253,22 +312,35 @@ func (s *ConnectionServer) ListCloudbuildv2AlphaConnection(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListConnection(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ConnectionMaxPage
+	}
+	resources, err := cl.ListConnectionWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.Cloudbuildv2AlphaConnection
-	for _, r := range resources.Items {
-		rp := ConnectionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ConnectionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListCloudbuildv2AlphaConnectionResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigConnection(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
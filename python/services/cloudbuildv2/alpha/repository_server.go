@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudbuildv2/alpha/cloudbuildv2_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudbuildv2/alpha"
@@ -62,7 +63,11 @@ func RepositoryToProto(resource *alpha.Repository) *alphapb.Cloudbuildv2AlphaRep
 // applyRepository handles the gRPC request by passing it to the underlying Repository Apply() method.
 func (s *RepositoryServer) applyRepository(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudbuildv2AlphaRepositoryRequest) (*alphapb.Cloudbuildv2AlphaRepository, error) {
 	p := ProtoToRepository(request.GetResource())
-	res, err := c.ApplyRepository(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRepository(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +84,60 @@ func (s *RepositoryServer) ApplyCloudbuildv2AlphaRepository(ctx context.Context,
 	return s.applyRepository(ctx, cl, request)
 }
 
+// GetCloudbuildv2AlphaRepository handles the gRPC request by passing it to the underlying Repository Get() method.
+func (s *RepositoryServer) GetCloudbuildv2AlphaRepository(ctx context.Context, request *alphapb.GetCloudbuildv2AlphaRepositoryRequest) (*alphapb.Cloudbuildv2AlphaRepository, error) {
+	cl, err := createConfigRepository(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRepository(ctx, ProtoToRepository(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RepositoryToProto(res), nil
+}
+
+// HasDiffCloudbuildv2AlphaRepository handles the gRPC request by passing it to the underlying Repository Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RepositoryServer) HasDiffCloudbuildv2AlphaRepository(ctx context.Context, request *alphapb.HasDiffCloudbuildv2AlphaRepositoryRequest) (*alphapb.HasDiffCloudbuildv2AlphaRepositoryResponse, error) {
+	cl, err := createConfigRepository(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRepository(ctx, ProtoToRepository(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudbuildv2AlphaRepositoryResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudbuildv2AlphaRepository handles the gRPC request by passing it to the underlying Repository Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RepositoryServer) PlanCloudbuildv2AlphaRepository(ctx context.Context, request *alphapb.PlanCloudbuildv2AlphaRepositoryRequest) (*alphapb.PlanCloudbuildv2AlphaRepositoryResponse, error) {
+	cl, err := createConfigRepository(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRepository(ctx, ProtoToRepository(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudbuildv2AlphaRepositoryResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRepository handles the gRPC request by passing it to the underlying Repository Delete() method.
 func (s *RepositoryServer) DeleteCloudbuildv2AlphaRepository(ctx context.Context, request *alphapb.DeleteCloudbuildv2AlphaRepositoryRequest) (*emptypb.Empty, error) {
 
@@ -97,22 +156,35 @@ func (s *RepositoryServer) ListCloudbuildv2AlphaRepository(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListRepository(ctx, request.GetProject(), request.GetLocation(), request.GetConnection())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.RepositoryMaxPage
+	}
+	resources, err := cl.ListRepositoryWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetConnection(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.Cloudbuildv2AlphaRepository
-	for _, r := range resources.Items {
-		rp := RepositoryToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RepositoryToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListCloudbuildv2AlphaRepositoryResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRepository(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudbuildv2/beta/cloudbuildv2_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudbuildv2/beta"
@@ -218,7 +219,11 @@ func ConnectionToProto(resource *beta.Connection) *betapb.Cloudbuildv2BetaConnec
 // applyConnection handles the gRPC request by passing it to the underlying Connection Apply() method.
 func (s *ConnectionServer) applyConnection(ctx context.Context, c *beta.Client, request *betapb.ApplyCloudbuildv2BetaConnectionRequest) (*betapb.Cloudbuildv2BetaConnection, error) {
 	p := ProtoToConnection(request.GetResource())
-	res, err := c.ApplyConnection(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyConnection(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +240,60 @@ func (s *ConnectionServer) ApplyCloudbuildv2BetaConnection(ctx context.Context,
 	return s.applyConnection(ctx, cl, request)
 }
 
+// GetCloudbuildv2BetaConnection handles the gRPC request by passing it to the underlying Connection Get() method.
+func (s *ConnectionServer) GetCloudbuildv2BetaConnection(ctx context.Context, request *betapb.GetCloudbuildv2BetaConnectionRequest) (*betapb.Cloudbuildv2BetaConnection, error) {
+	cl, err := createConfigConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetConnection(ctx, ProtoToConnection(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ConnectionToProto(res), nil
+}
+
+// HasDiffCloudbuildv2BetaConnection handles the gRPC request by passing it to the underlying Connection Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ConnectionServer) HasDiffCloudbuildv2BetaConnection(ctx context.Context, request *betapb.HasDiffCloudbuildv2BetaConnectionRequest) (*betapb.HasDiffCloudbuildv2BetaConnectionResponse, error) {
+	cl, err := createConfigConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConnection(ctx, ProtoToConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffCloudbuildv2BetaConnectionResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudbuildv2BetaConnection handles the gRPC request by passing it to the underlying Connection Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ConnectionServer) PlanCloudbuildv2BetaConnection(ctx context.Context, request *betapb.PlanCloudbuildv2BetaConnectionRequest) (*betapb.PlanCloudbuildv2BetaConnectionResponse, error) {
+	cl, err := createConfigConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConnection(ctx, ProtoToConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanCloudbuildv2BetaConnectionResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteConnection handles the gRPC request by passing it to the underlying Connection Delete() method.
 func (s *ConnectionServer) DeleteCloudbuildv2BetaConnection(ctx context.Context, request *betapb.DeleteCloudbuildv2BetaConnectionRequest) (*emptypb.Empty, error) {
 
@@ -253,22 +312,35 @@ func (s *ConnectionServer) ListCloudbuildv2BetaConnection(ctx context.Context, r
 		return nil, err
 	}
 
-	resources, err := cl.ListConnection(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ConnectionMaxPage
+	}
+	resources, err := cl.ListConnectionWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.Cloudbuildv2BetaConnection
-	for _, r := range resources.Items {
-		rp := ConnectionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ConnectionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListCloudbuildv2BetaConnectionResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigConnection(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
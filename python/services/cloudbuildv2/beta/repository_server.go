@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudbuildv2/beta/cloudbuildv2_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudbuildv2/beta"
@@ -62,7 +63,11 @@ func RepositoryToProto(resource *beta.Repository) *betapb.Cloudbuildv2BetaReposi
 // applyRepository handles the gRPC request by passing it to the underlying Repository Apply() method.
 func (s *RepositoryServer) applyRepository(ctx context.Context, c *beta.Client, request *betapb.ApplyCloudbuildv2BetaRepositoryRequest) (*betapb.Cloudbuildv2BetaRepository, error) {
 	p := ProtoToRepository(request.GetResource())
-	res, err := c.ApplyRepository(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRepository(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +84,60 @@ func (s *RepositoryServer) ApplyCloudbuildv2BetaRepository(ctx context.Context,
 	return s.applyRepository(ctx, cl, request)
 }
 
+// GetCloudbuildv2BetaRepository handles the gRPC request by passing it to the underlying Repository Get() method.
+func (s *RepositoryServer) GetCloudbuildv2BetaRepository(ctx context.Context, request *betapb.GetCloudbuildv2BetaRepositoryRequest) (*betapb.Cloudbuildv2BetaRepository, error) {
+	cl, err := createConfigRepository(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRepository(ctx, ProtoToRepository(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RepositoryToProto(res), nil
+}
+
+// HasDiffCloudbuildv2BetaRepository handles the gRPC request by passing it to the underlying Repository Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RepositoryServer) HasDiffCloudbuildv2BetaRepository(ctx context.Context, request *betapb.HasDiffCloudbuildv2BetaRepositoryRequest) (*betapb.HasDiffCloudbuildv2BetaRepositoryResponse, error) {
+	cl, err := createConfigRepository(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRepository(ctx, ProtoToRepository(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffCloudbuildv2BetaRepositoryResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudbuildv2BetaRepository handles the gRPC request by passing it to the underlying Repository Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RepositoryServer) PlanCloudbuildv2BetaRepository(ctx context.Context, request *betapb.PlanCloudbuildv2BetaRepositoryRequest) (*betapb.PlanCloudbuildv2BetaRepositoryResponse, error) {
+	cl, err := createConfigRepository(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRepository(ctx, ProtoToRepository(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanCloudbuildv2BetaRepositoryResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRepository handles the gRPC request by passing it to the underlying Repository Delete() method.
 func (s *RepositoryServer) DeleteCloudbuildv2BetaRepository(ctx context.Context, request *betapb.DeleteCloudbuildv2BetaRepositoryRequest) (*emptypb.Empty, error) {
 
@@ -97,22 +156,35 @@ func (s *RepositoryServer) ListCloudbuildv2BetaRepository(ctx context.Context, r
 		return nil, err
 	}
 
-	resources, err := cl.ListRepository(ctx, request.GetProject(), request.GetLocation(), request.GetConnection())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.RepositoryMaxPage
+	}
+	resources, err := cl.ListRepositoryWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetConnection(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.Cloudbuildv2BetaRepository
-	for _, r := range resources.Items {
-		rp := RepositoryToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RepositoryToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListCloudbuildv2BetaRepositoryResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRepository(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/clouddeploy/alpha/clouddeploy_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/clouddeploy/alpha"
@@ -208,7 +209,11 @@ func TargetToProto(resource *alpha.Target) *alphapb.ClouddeployAlphaTarget {
 // applyTarget handles the gRPC request by passing it to the underlying Target Apply() method.
 func (s *TargetServer) applyTarget(ctx context.Context, c *alpha.Client, request *alphapb.ApplyClouddeployAlphaTargetRequest) (*alphapb.ClouddeployAlphaTarget, error) {
 	p := ProtoToTarget(request.GetResource())
-	res, err := c.ApplyTarget(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTarget(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -225,6 +230,60 @@ func (s *TargetServer) ApplyClouddeployAlphaTarget(ctx context.Context, request
 	return s.applyTarget(ctx, cl, request)
 }
 
+// GetClouddeployAlphaTarget handles the gRPC request by passing it to the underlying Target Get() method.
+func (s *TargetServer) GetClouddeployAlphaTarget(ctx context.Context, request *alphapb.GetClouddeployAlphaTargetRequest) (*alphapb.ClouddeployAlphaTarget, error) {
+	cl, err := createConfigTarget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTarget(ctx, ProtoToTarget(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TargetToProto(res), nil
+}
+
+// HasDiffClouddeployAlphaTarget handles the gRPC request by passing it to the underlying Target Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TargetServer) HasDiffClouddeployAlphaTarget(ctx context.Context, request *alphapb.HasDiffClouddeployAlphaTargetRequest) (*alphapb.HasDiffClouddeployAlphaTargetResponse, error) {
+	cl, err := createConfigTarget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTarget(ctx, ProtoToTarget(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffClouddeployAlphaTargetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanClouddeployAlphaTarget handles the gRPC request by passing it to the underlying Target Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TargetServer) PlanClouddeployAlphaTarget(ctx context.Context, request *alphapb.PlanClouddeployAlphaTargetRequest) (*alphapb.PlanClouddeployAlphaTargetResponse, error) {
+	cl, err := createConfigTarget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTarget(ctx, ProtoToTarget(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanClouddeployAlphaTargetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTarget handles the gRPC request by passing it to the underlying Target Delete() method.
 func (s *TargetServer) DeleteClouddeployAlphaTarget(ctx context.Context, request *alphapb.DeleteClouddeployAlphaTargetRequest) (*emptypb.Empty, error) {
 
@@ -243,22 +302,35 @@ func (s *TargetServer) ListClouddeployAlphaTarget(ctx context.Context, request *
 		return nil, err
 	}
 
-	resources, err := cl.ListTarget(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.TargetMaxPage
+	}
+	resources, err := cl.ListTargetWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ClouddeployAlphaTarget
-	for _, r := range resources.Items {
-		rp := TargetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TargetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListClouddeployAlphaTargetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTarget(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
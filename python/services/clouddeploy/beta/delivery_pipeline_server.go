@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/clouddeploy/beta/clouddeploy_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/clouddeploy/beta"
@@ -249,7 +250,11 @@ func DeliveryPipelineToProto(resource *beta.DeliveryPipeline) *betapb.Clouddeplo
 // applyDeliveryPipeline handles the gRPC request by passing it to the underlying DeliveryPipeline Apply() method.
 func (s *DeliveryPipelineServer) applyDeliveryPipeline(ctx context.Context, c *beta.Client, request *betapb.ApplyClouddeployBetaDeliveryPipelineRequest) (*betapb.ClouddeployBetaDeliveryPipeline, error) {
 	p := ProtoToDeliveryPipeline(request.GetResource())
-	res, err := c.ApplyDeliveryPipeline(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDeliveryPipeline(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -266,6 +271,60 @@ func (s *DeliveryPipelineServer) ApplyClouddeployBetaDeliveryPipeline(ctx contex
 	return s.applyDeliveryPipeline(ctx, cl, request)
 }
 
+// GetClouddeployBetaDeliveryPipeline handles the gRPC request by passing it to the underlying DeliveryPipeline Get() method.
+func (s *DeliveryPipelineServer) GetClouddeployBetaDeliveryPipeline(ctx context.Context, request *betapb.GetClouddeployBetaDeliveryPipelineRequest) (*betapb.ClouddeployBetaDeliveryPipeline, error) {
+	cl, err := createConfigDeliveryPipeline(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDeliveryPipeline(ctx, ProtoToDeliveryPipeline(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DeliveryPipelineToProto(res), nil
+}
+
+// HasDiffClouddeployBetaDeliveryPipeline handles the gRPC request by passing it to the underlying DeliveryPipeline Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DeliveryPipelineServer) HasDiffClouddeployBetaDeliveryPipeline(ctx context.Context, request *betapb.HasDiffClouddeployBetaDeliveryPipelineRequest) (*betapb.HasDiffClouddeployBetaDeliveryPipelineResponse, error) {
+	cl, err := createConfigDeliveryPipeline(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDeliveryPipeline(ctx, ProtoToDeliveryPipeline(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffClouddeployBetaDeliveryPipelineResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanClouddeployBetaDeliveryPipeline handles the gRPC request by passing it to the underlying DeliveryPipeline Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DeliveryPipelineServer) PlanClouddeployBetaDeliveryPipeline(ctx context.Context, request *betapb.PlanClouddeployBetaDeliveryPipelineRequest) (*betapb.PlanClouddeployBetaDeliveryPipelineResponse, error) {
+	cl, err := createConfigDeliveryPipeline(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDeliveryPipeline(ctx, ProtoToDeliveryPipeline(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanClouddeployBetaDeliveryPipelineResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDeliveryPipeline handles the gRPC request by passing it to the underlying DeliveryPipeline Delete() method.
 func (s *DeliveryPipelineServer) DeleteClouddeployBetaDeliveryPipeline(ctx context.Context, request *betapb.DeleteClouddeployBetaDeliveryPipelineRequest) (*emptypb.Empty, error) {
 
@@ -284,22 +343,35 @@ func (s *DeliveryPipelineServer) ListClouddeployBetaDeliveryPipeline(ctx context
 		return nil, err
 	}
 
-	resources, err := cl.ListDeliveryPipeline(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.DeliveryPipelineMaxPage
+	}
+	resources, err := cl.ListDeliveryPipelineWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ClouddeployBetaDeliveryPipeline
-	for _, r := range resources.Items {
-		rp := DeliveryPipelineToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DeliveryPipelineToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListClouddeployBetaDeliveryPipelineResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDeliveryPipeline(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
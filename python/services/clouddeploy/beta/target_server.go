@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/clouddeploy/beta/clouddeploy_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/clouddeploy/beta"
@@ -208,7 +209,11 @@ func TargetToProto(resource *beta.Target) *betapb.ClouddeployBetaTarget {
 // applyTarget handles the gRPC request by passing it to the underlying Target Apply() method.
 func (s *TargetServer) applyTarget(ctx context.Context, c *beta.Client, request *betapb.ApplyClouddeployBetaTargetRequest) (*betapb.ClouddeployBetaTarget, error) {
 	p := ProtoToTarget(request.GetResource())
-	res, err := c.ApplyTarget(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTarget(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -225,6 +230,60 @@ func (s *TargetServer) ApplyClouddeployBetaTarget(ctx context.Context, request *
 	return s.applyTarget(ctx, cl, request)
 }
 
+// GetClouddeployBetaTarget handles the gRPC request by passing it to the underlying Target Get() method.
+func (s *TargetServer) GetClouddeployBetaTarget(ctx context.Context, request *betapb.GetClouddeployBetaTargetRequest) (*betapb.ClouddeployBetaTarget, error) {
+	cl, err := createConfigTarget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTarget(ctx, ProtoToTarget(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TargetToProto(res), nil
+}
+
+// HasDiffClouddeployBetaTarget handles the gRPC request by passing it to the underlying Target Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TargetServer) HasDiffClouddeployBetaTarget(ctx context.Context, request *betapb.HasDiffClouddeployBetaTargetRequest) (*betapb.HasDiffClouddeployBetaTargetResponse, error) {
+	cl, err := createConfigTarget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTarget(ctx, ProtoToTarget(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffClouddeployBetaTargetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanClouddeployBetaTarget handles the gRPC request by passing it to the underlying Target Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TargetServer) PlanClouddeployBetaTarget(ctx context.Context, request *betapb.PlanClouddeployBetaTargetRequest) (*betapb.PlanClouddeployBetaTargetResponse, error) {
+	cl, err := createConfigTarget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTarget(ctx, ProtoToTarget(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanClouddeployBetaTargetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTarget handles the gRPC request by passing it to the underlying Target Delete() method.
 func (s *TargetServer) DeleteClouddeployBetaTarget(ctx context.Context, request *betapb.DeleteClouddeployBetaTargetRequest) (*emptypb.Empty, error) {
 
@@ -243,22 +302,35 @@ func (s *TargetServer) ListClouddeployBetaTarget(ctx context.Context, request *b
 		return nil, err
 	}
 
-	resources, err := cl.ListTarget(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.TargetMaxPage
+	}
+	resources, err := cl.ListTargetWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ClouddeployBetaTarget
-	for _, r := range resources.Items {
-		rp := TargetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TargetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListClouddeployBetaTargetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTarget(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	clouddeploypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/clouddeploy/clouddeploy_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/clouddeploy"
@@ -205,7 +206,11 @@ func DeliveryPipelineToProto(resource *clouddeploy.DeliveryPipeline) *clouddeplo
 // applyDeliveryPipeline handles the gRPC request by passing it to the underlying DeliveryPipeline Apply() method.
 func (s *DeliveryPipelineServer) applyDeliveryPipeline(ctx context.Context, c *clouddeploy.Client, request *clouddeploypb.ApplyClouddeployDeliveryPipelineRequest) (*clouddeploypb.ClouddeployDeliveryPipeline, error) {
 	p := ProtoToDeliveryPipeline(request.GetResource())
-	res, err := c.ApplyDeliveryPipeline(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDeliveryPipeline(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -222,6 +227,60 @@ func (s *DeliveryPipelineServer) ApplyClouddeployDeliveryPipeline(ctx context.Co
 	return s.applyDeliveryPipeline(ctx, cl, request)
 }
 
+// GetClouddeployDeliveryPipeline handles the gRPC request by passing it to the underlying DeliveryPipeline Get() method.
+func (s *DeliveryPipelineServer) GetClouddeployDeliveryPipeline(ctx context.Context, request *clouddeploypb.GetClouddeployDeliveryPipelineRequest) (*clouddeploypb.ClouddeployDeliveryPipeline, error) {
+	cl, err := createConfigDeliveryPipeline(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDeliveryPipeline(ctx, ProtoToDeliveryPipeline(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DeliveryPipelineToProto(res), nil
+}
+
+// HasDiffClouddeployDeliveryPipeline handles the gRPC request by passing it to the underlying DeliveryPipeline Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DeliveryPipelineServer) HasDiffClouddeployDeliveryPipeline(ctx context.Context, request *clouddeploypb.HasDiffClouddeployDeliveryPipelineRequest) (*clouddeploypb.HasDiffClouddeployDeliveryPipelineResponse, error) {
+	cl, err := createConfigDeliveryPipeline(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDeliveryPipeline(ctx, ProtoToDeliveryPipeline(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &clouddeploypb.HasDiffClouddeployDeliveryPipelineResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanClouddeployDeliveryPipeline handles the gRPC request by passing it to the underlying DeliveryPipeline Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DeliveryPipelineServer) PlanClouddeployDeliveryPipeline(ctx context.Context, request *clouddeploypb.PlanClouddeployDeliveryPipelineRequest) (*clouddeploypb.PlanClouddeployDeliveryPipelineResponse, error) {
+	cl, err := createConfigDeliveryPipeline(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDeliveryPipeline(ctx, ProtoToDeliveryPipeline(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &clouddeploypb.PlanClouddeployDeliveryPipelineResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDeliveryPipeline handles the gRPC request by passing it to the underlying DeliveryPipeline Delete() method.
 func (s *DeliveryPipelineServer) DeleteClouddeployDeliveryPipeline(ctx context.Context, request *clouddeploypb.DeleteClouddeployDeliveryPipelineRequest) (*emptypb.Empty, error) {
 
@@ -240,22 +299,35 @@ func (s *DeliveryPipelineServer) ListClouddeployDeliveryPipeline(ctx context.Con
 		return nil, err
 	}
 
-	resources, err := cl.ListDeliveryPipeline(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = clouddeploy.DeliveryPipelineMaxPage
+	}
+	resources, err := cl.ListDeliveryPipelineWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*clouddeploypb.ClouddeployDeliveryPipeline
-	for _, r := range resources.Items {
-		rp := DeliveryPipelineToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DeliveryPipelineToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &clouddeploypb.ListClouddeployDeliveryPipelineResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDeliveryPipeline(ctx context.Context, service_account_file string) (*clouddeploy.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return clouddeploy.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	clouddeploypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/clouddeploy/clouddeploy_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/clouddeploy"
@@ -185,7 +186,11 @@ func TargetToProto(resource *clouddeploy.Target) *clouddeploypb.ClouddeployTarge
 // applyTarget handles the gRPC request by passing it to the underlying Target Apply() method.
 func (s *TargetServer) applyTarget(ctx context.Context, c *clouddeploy.Client, request *clouddeploypb.ApplyClouddeployTargetRequest) (*clouddeploypb.ClouddeployTarget, error) {
 	p := ProtoToTarget(request.GetResource())
-	res, err := c.ApplyTarget(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTarget(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -202,6 +207,60 @@ func (s *TargetServer) ApplyClouddeployTarget(ctx context.Context, request *clou
 	return s.applyTarget(ctx, cl, request)
 }
 
+// GetClouddeployTarget handles the gRPC request by passing it to the underlying Target Get() method.
+func (s *TargetServer) GetClouddeployTarget(ctx context.Context, request *clouddeploypb.GetClouddeployTargetRequest) (*clouddeploypb.ClouddeployTarget, error) {
+	cl, err := createConfigTarget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTarget(ctx, ProtoToTarget(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TargetToProto(res), nil
+}
+
+// HasDiffClouddeployTarget handles the gRPC request by passing it to the underlying Target Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TargetServer) HasDiffClouddeployTarget(ctx context.Context, request *clouddeploypb.HasDiffClouddeployTargetRequest) (*clouddeploypb.HasDiffClouddeployTargetResponse, error) {
+	cl, err := createConfigTarget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTarget(ctx, ProtoToTarget(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &clouddeploypb.HasDiffClouddeployTargetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanClouddeployTarget handles the gRPC request by passing it to the underlying Target Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TargetServer) PlanClouddeployTarget(ctx context.Context, request *clouddeploypb.PlanClouddeployTargetRequest) (*clouddeploypb.PlanClouddeployTargetResponse, error) {
+	cl, err := createConfigTarget(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTarget(ctx, ProtoToTarget(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &clouddeploypb.PlanClouddeployTargetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTarget handles the gRPC request by passing it to the underlying Target Delete() method.
 func (s *TargetServer) DeleteClouddeployTarget(ctx context.Context, request *clouddeploypb.DeleteClouddeployTargetRequest) (*emptypb.Empty, error) {
 
@@ -220,22 +279,35 @@ func (s *TargetServer) ListClouddeployTarget(ctx context.Context, request *cloud
 		return nil, err
 	}
 
-	resources, err := cl.ListTarget(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = clouddeploy.TargetMaxPage
+	}
+	resources, err := cl.ListTargetWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*clouddeploypb.ClouddeployTarget
-	for _, r := range resources.Items {
-		rp := TargetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TargetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &clouddeploypb.ListClouddeployTargetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTarget(ctx context.Context, service_account_file string) (*clouddeploy.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return clouddeploy.NewClient(conf), nil
 }
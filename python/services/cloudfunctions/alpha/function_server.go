@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudfunctions/alpha/cloudfunctions_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudfunctions/alpha"
@@ -256,7 +257,11 @@ func FunctionToProto(resource *alpha.Function) *alphapb.CloudfunctionsAlphaFunct
 // applyFunction handles the gRPC request by passing it to the underlying Function Apply() method.
 func (s *FunctionServer) applyFunction(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudfunctionsAlphaFunctionRequest) (*alphapb.CloudfunctionsAlphaFunction, error) {
 	p := ProtoToFunction(request.GetResource())
-	res, err := c.ApplyFunction(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFunction(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -273,6 +278,60 @@ func (s *FunctionServer) ApplyCloudfunctionsAlphaFunction(ctx context.Context, r
 	return s.applyFunction(ctx, cl, request)
 }
 
+// GetCloudfunctionsAlphaFunction handles the gRPC request by passing it to the underlying Function Get() method.
+func (s *FunctionServer) GetCloudfunctionsAlphaFunction(ctx context.Context, request *alphapb.GetCloudfunctionsAlphaFunctionRequest) (*alphapb.CloudfunctionsAlphaFunction, error) {
+	cl, err := createConfigFunction(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFunction(ctx, ProtoToFunction(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FunctionToProto(res), nil
+}
+
+// HasDiffCloudfunctionsAlphaFunction handles the gRPC request by passing it to the underlying Function Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FunctionServer) HasDiffCloudfunctionsAlphaFunction(ctx context.Context, request *alphapb.HasDiffCloudfunctionsAlphaFunctionRequest) (*alphapb.HasDiffCloudfunctionsAlphaFunctionResponse, error) {
+	cl, err := createConfigFunction(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFunction(ctx, ProtoToFunction(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudfunctionsAlphaFunctionResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudfunctionsAlphaFunction handles the gRPC request by passing it to the underlying Function Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FunctionServer) PlanCloudfunctionsAlphaFunction(ctx context.Context, request *alphapb.PlanCloudfunctionsAlphaFunctionRequest) (*alphapb.PlanCloudfunctionsAlphaFunctionResponse, error) {
+	cl, err := createConfigFunction(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFunction(ctx, ProtoToFunction(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudfunctionsAlphaFunctionResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFunction handles the gRPC request by passing it to the underlying Function Delete() method.
 func (s *FunctionServer) DeleteCloudfunctionsAlphaFunction(ctx context.Context, request *alphapb.DeleteCloudfunctionsAlphaFunctionRequest) (*emptypb.Empty, error) {
 
@@ -291,22 +350,35 @@ func (s *FunctionServer) ListCloudfunctionsAlphaFunction(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListFunction(ctx, request.GetProject(), request.GetRegion())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.FunctionMaxPage
+	}
+	resources, err := cl.ListFunctionWithPageToken(ctx, request.GetProject(), request.GetRegion(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.CloudfunctionsAlphaFunction
-	for _, r := range resources.Items {
-		rp := FunctionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FunctionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListCloudfunctionsAlphaFunctionResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFunction(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	cloudfunctionspb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudfunctions/cloudfunctions_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudfunctions"
@@ -228,7 +229,11 @@ func CloudFunctionToProto(resource *cloudfunctions.CloudFunction) *cloudfunction
 // ApplyCloudFunction handles the gRPC request by passing it to the underlying CloudFunction Apply() method.
 func (s *CloudFunctionServer) applyCloudFunction(ctx context.Context, c *cloudfunctions.Client, request *cloudfunctionspb.ApplyCloudfunctionsCloudFunctionRequest) (*cloudfunctionspb.CloudfunctionsCloudFunction, error) {
 	p := ProtoToCloudFunction(request.GetResource())
-	res, err := c.ApplyCloudFunction(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCloudFunction(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -245,6 +250,55 @@ func (s *CloudFunctionServer) ApplyCloudfunctionsCloudFunction(ctx context.Conte
 	return s.applyCloudFunction(ctx, cl, request)
 }
 
+// GetCloudfunctionsCloudFunction handles the gRPC request by passing it to the underlying CloudFunction Get() method.
+func (s *CloudFunctionServer) GetCloudfunctionsCloudFunction(ctx context.Context, request *cloudfunctionspb.GetCloudfunctionsCloudFunctionRequest) (*cloudfunctionspb.CloudfunctionsCloudFunction, error) {
+	cl, err := createConfigCloudFunction(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCloudFunction(ctx, ProtoToCloudFunction(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CloudFunctionToProto(res), nil
+}
+
+// HasDiffCloudfunctionsCloudFunction handles the gRPC request by passing it to the underlying CloudFunction Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CloudFunctionServer) HasDiffCloudfunctionsCloudFunction(ctx context.Context, request *cloudfunctionspb.HasDiffCloudfunctionsCloudFunctionRequest) (*cloudfunctionspb.HasDiffCloudfunctionsCloudFunctionResponse, error) {
+	cl, err := createConfigCloudFunction(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCloudFunction(ctx, ProtoToCloudFunction(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &cloudfunctionspb.HasDiffCloudfunctionsCloudFunctionResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanCloudfunctionsCloudFunction handles the gRPC request by passing it to the underlying CloudFunction Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CloudFunctionServer) PlanCloudfunctionsCloudFunction(ctx context.Context, request *cloudfunctionspb.PlanCloudfunctionsCloudFunctionRequest) (*cloudfunctionspb.PlanCloudfunctionsCloudFunctionResponse, error) {
+	cl, err := createConfigCloudFunction(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCloudFunction(ctx, ProtoToCloudFunction(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &cloudfunctionspb.PlanCloudfunctionsCloudFunctionResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteCloudFunction handles the gRPC request by passing it to the underlying CloudFunction Delete() method.
 func (s *CloudFunctionServer) DeleteCloudfunctionsCloudFunction(ctx context.Context, request *cloudfunctionspb.DeleteCloudfunctionsCloudFunctionRequest) (*emptypb.Empty, error) {
 	cl, err := createConfigCloudFunction(ctx, request.ServiceAccountFile)
@@ -261,20 +315,32 @@ func (s *CloudFunctionServer) ListCloudfunctionsCloudFunction(ctx context.Contex
 		return nil, err
 	}
 
-	resources, err := cl.ListCloudFunction(ctx, request.Project, request.Region)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = cloudfunctions.CloudFunctionMaxPage
+	}
+	resources, err := cl.ListCloudFunctionWithPageToken(ctx, request.Project, request.Region, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*cloudfunctionspb.CloudfunctionsCloudFunction
-	for _, r := range resources.Items {
-		rp := CloudFunctionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CloudFunctionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &cloudfunctionspb.ListCloudfunctionsCloudFunctionResponse{Items: protos}, nil
+	return &cloudfunctionspb.ListCloudfunctionsCloudFunctionResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigCloudFunction(ctx context.Context, service_account_file string) (*cloudfunctions.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return cloudfunctions.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudidentity/alpha/cloudidentity_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudidentity/alpha"
@@ -353,7 +354,11 @@ func GroupToProto(resource *alpha.Group) *alphapb.CloudidentityAlphaGroup {
 // applyGroup handles the gRPC request by passing it to the underlying Group Apply() method.
 func (s *GroupServer) applyGroup(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudidentityAlphaGroupRequest) (*alphapb.CloudidentityAlphaGroup, error) {
 	p := ProtoToGroup(request.GetResource())
-	res, err := c.ApplyGroup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGroup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -370,6 +375,60 @@ func (s *GroupServer) ApplyCloudidentityAlphaGroup(ctx context.Context, request
 	return s.applyGroup(ctx, cl, request)
 }
 
+// GetCloudidentityAlphaGroup handles the gRPC request by passing it to the underlying Group Get() method.
+func (s *GroupServer) GetCloudidentityAlphaGroup(ctx context.Context, request *alphapb.GetCloudidentityAlphaGroupRequest) (*alphapb.CloudidentityAlphaGroup, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGroup(ctx, ProtoToGroup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GroupToProto(res), nil
+}
+
+// HasDiffCloudidentityAlphaGroup handles the gRPC request by passing it to the underlying Group Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GroupServer) HasDiffCloudidentityAlphaGroup(ctx context.Context, request *alphapb.HasDiffCloudidentityAlphaGroupRequest) (*alphapb.HasDiffCloudidentityAlphaGroupResponse, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGroup(ctx, ProtoToGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudidentityAlphaGroupResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudidentityAlphaGroup handles the gRPC request by passing it to the underlying Group Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GroupServer) PlanCloudidentityAlphaGroup(ctx context.Context, request *alphapb.PlanCloudidentityAlphaGroupRequest) (*alphapb.PlanCloudidentityAlphaGroupResponse, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGroup(ctx, ProtoToGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudidentityAlphaGroupResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGroup handles the gRPC request by passing it to the underlying Group Delete() method.
 func (s *GroupServer) DeleteCloudidentityAlphaGroup(ctx context.Context, request *alphapb.DeleteCloudidentityAlphaGroupRequest) (*emptypb.Empty, error) {
 
@@ -388,22 +447,35 @@ func (s *GroupServer) ListCloudidentityAlphaGroup(ctx context.Context, request *
 		return nil, err
 	}
 
-	resources, err := cl.ListGroup(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.GroupMaxPage
+	}
+	resources, err := cl.ListGroupWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.CloudidentityAlphaGroup
-	for _, r := range resources.Items {
-		rp := GroupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, GroupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListCloudidentityAlphaGroupResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigGroup(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudidentity/alpha/cloudidentity_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudidentity/alpha"
@@ -295,7 +296,11 @@ func MembershipToProto(resource *alpha.Membership) *alphapb.CloudidentityAlphaMe
 // applyMembership handles the gRPC request by passing it to the underlying Membership Apply() method.
 func (s *MembershipServer) applyMembership(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudidentityAlphaMembershipRequest) (*alphapb.CloudidentityAlphaMembership, error) {
 	p := ProtoToMembership(request.GetResource())
-	res, err := c.ApplyMembership(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMembership(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -312,6 +317,60 @@ func (s *MembershipServer) ApplyCloudidentityAlphaMembership(ctx context.Context
 	return s.applyMembership(ctx, cl, request)
 }
 
+// GetCloudidentityAlphaMembership handles the gRPC request by passing it to the underlying Membership Get() method.
+func (s *MembershipServer) GetCloudidentityAlphaMembership(ctx context.Context, request *alphapb.GetCloudidentityAlphaMembershipRequest) (*alphapb.CloudidentityAlphaMembership, error) {
+	cl, err := createConfigMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMembership(ctx, ProtoToMembership(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MembershipToProto(res), nil
+}
+
+// HasDiffCloudidentityAlphaMembership handles the gRPC request by passing it to the underlying Membership Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MembershipServer) HasDiffCloudidentityAlphaMembership(ctx context.Context, request *alphapb.HasDiffCloudidentityAlphaMembershipRequest) (*alphapb.HasDiffCloudidentityAlphaMembershipResponse, error) {
+	cl, err := createConfigMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMembership(ctx, ProtoToMembership(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudidentityAlphaMembershipResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudidentityAlphaMembership handles the gRPC request by passing it to the underlying Membership Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MembershipServer) PlanCloudidentityAlphaMembership(ctx context.Context, request *alphapb.PlanCloudidentityAlphaMembershipRequest) (*alphapb.PlanCloudidentityAlphaMembershipResponse, error) {
+	cl, err := createConfigMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMembership(ctx, ProtoToMembership(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudidentityAlphaMembershipResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMembership handles the gRPC request by passing it to the underlying Membership Delete() method.
 func (s *MembershipServer) DeleteCloudidentityAlphaMembership(ctx context.Context, request *alphapb.DeleteCloudidentityAlphaMembershipRequest) (*emptypb.Empty, error) {
 
@@ -330,22 +389,35 @@ func (s *MembershipServer) ListCloudidentityAlphaMembership(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListMembership(ctx, request.GetGroup())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.MembershipMaxPage
+	}
+	resources, err := cl.ListMembershipWithPageToken(ctx, request.GetGroup(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.CloudidentityAlphaMembership
-	for _, r := range resources.Items {
-		rp := MembershipToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MembershipToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListCloudidentityAlphaMembershipResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMembership(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
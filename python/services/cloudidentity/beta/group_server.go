@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudidentity/beta/cloudidentity_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudidentity/beta"
@@ -353,7 +354,11 @@ func GroupToProto(resource *beta.Group) *betapb.CloudidentityBetaGroup {
 // applyGroup handles the gRPC request by passing it to the underlying Group Apply() method.
 func (s *GroupServer) applyGroup(ctx context.Context, c *beta.Client, request *betapb.ApplyCloudidentityBetaGroupRequest) (*betapb.CloudidentityBetaGroup, error) {
 	p := ProtoToGroup(request.GetResource())
-	res, err := c.ApplyGroup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGroup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -370,6 +375,60 @@ func (s *GroupServer) ApplyCloudidentityBetaGroup(ctx context.Context, request *
 	return s.applyGroup(ctx, cl, request)
 }
 
+// GetCloudidentityBetaGroup handles the gRPC request by passing it to the underlying Group Get() method.
+func (s *GroupServer) GetCloudidentityBetaGroup(ctx context.Context, request *betapb.GetCloudidentityBetaGroupRequest) (*betapb.CloudidentityBetaGroup, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGroup(ctx, ProtoToGroup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GroupToProto(res), nil
+}
+
+// HasDiffCloudidentityBetaGroup handles the gRPC request by passing it to the underlying Group Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GroupServer) HasDiffCloudidentityBetaGroup(ctx context.Context, request *betapb.HasDiffCloudidentityBetaGroupRequest) (*betapb.HasDiffCloudidentityBetaGroupResponse, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGroup(ctx, ProtoToGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffCloudidentityBetaGroupResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudidentityBetaGroup handles the gRPC request by passing it to the underlying Group Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GroupServer) PlanCloudidentityBetaGroup(ctx context.Context, request *betapb.PlanCloudidentityBetaGroupRequest) (*betapb.PlanCloudidentityBetaGroupResponse, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGroup(ctx, ProtoToGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanCloudidentityBetaGroupResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGroup handles the gRPC request by passing it to the underlying Group Delete() method.
 func (s *GroupServer) DeleteCloudidentityBetaGroup(ctx context.Context, request *betapb.DeleteCloudidentityBetaGroupRequest) (*emptypb.Empty, error) {
 
@@ -388,22 +447,35 @@ func (s *GroupServer) ListCloudidentityBetaGroup(ctx context.Context, request *b
 		return nil, err
 	}
 
-	resources, err := cl.ListGroup(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.GroupMaxPage
+	}
+	resources, err := cl.ListGroupWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.CloudidentityBetaGroup
-	for _, r := range resources.Items {
-		rp := GroupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, GroupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListCloudidentityBetaGroupResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigGroup(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	cloudidentitypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudidentity/cloudidentity_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudidentity"
@@ -320,7 +321,11 @@ func GroupToProto(resource *cloudidentity.Group) *cloudidentitypb.CloudidentityG
 // applyGroup handles the gRPC request by passing it to the underlying Group Apply() method.
 func (s *GroupServer) applyGroup(ctx context.Context, c *cloudidentity.Client, request *cloudidentitypb.ApplyCloudidentityGroupRequest) (*cloudidentitypb.CloudidentityGroup, error) {
 	p := ProtoToGroup(request.GetResource())
-	res, err := c.ApplyGroup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGroup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -337,6 +342,60 @@ func (s *GroupServer) ApplyCloudidentityGroup(ctx context.Context, request *clou
 	return s.applyGroup(ctx, cl, request)
 }
 
+// GetCloudidentityGroup handles the gRPC request by passing it to the underlying Group Get() method.
+func (s *GroupServer) GetCloudidentityGroup(ctx context.Context, request *cloudidentitypb.GetCloudidentityGroupRequest) (*cloudidentitypb.CloudidentityGroup, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGroup(ctx, ProtoToGroup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GroupToProto(res), nil
+}
+
+// HasDiffCloudidentityGroup handles the gRPC request by passing it to the underlying Group Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GroupServer) HasDiffCloudidentityGroup(ctx context.Context, request *cloudidentitypb.HasDiffCloudidentityGroupRequest) (*cloudidentitypb.HasDiffCloudidentityGroupResponse, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGroup(ctx, ProtoToGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudidentitypb.HasDiffCloudidentityGroupResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudidentityGroup handles the gRPC request by passing it to the underlying Group Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GroupServer) PlanCloudidentityGroup(ctx context.Context, request *cloudidentitypb.PlanCloudidentityGroupRequest) (*cloudidentitypb.PlanCloudidentityGroupResponse, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGroup(ctx, ProtoToGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudidentitypb.PlanCloudidentityGroupResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGroup handles the gRPC request by passing it to the underlying Group Delete() method.
 func (s *GroupServer) DeleteCloudidentityGroup(ctx context.Context, request *cloudidentitypb.DeleteCloudidentityGroupRequest) (*emptypb.Empty, error) {
 
@@ -355,22 +414,35 @@ func (s *GroupServer) ListCloudidentityGroup(ctx context.Context, request *cloud
 		return nil, err
 	}
 
-	resources, err := cl.ListGroup(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = cloudidentity.GroupMaxPage
+	}
+	resources, err := cl.ListGroupWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*cloudidentitypb.CloudidentityGroup
-	for _, r := range resources.Items {
-		rp := GroupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, GroupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &cloudidentitypb.ListCloudidentityGroupResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigGroup(ctx context.Context, service_account_file string) (*cloudidentity.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return cloudidentity.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	cloudidentitypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudidentity/cloudidentity_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudidentity"
@@ -270,7 +271,11 @@ func MembershipToProto(resource *cloudidentity.Membership) *cloudidentitypb.Clou
 // applyMembership handles the gRPC request by passing it to the underlying Membership Apply() method.
 func (s *MembershipServer) applyMembership(ctx context.Context, c *cloudidentity.Client, request *cloudidentitypb.ApplyCloudidentityMembershipRequest) (*cloudidentitypb.CloudidentityMembership, error) {
 	p := ProtoToMembership(request.GetResource())
-	res, err := c.ApplyMembership(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMembership(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -287,6 +292,60 @@ func (s *MembershipServer) ApplyCloudidentityMembership(ctx context.Context, req
 	return s.applyMembership(ctx, cl, request)
 }
 
+// GetCloudidentityMembership handles the gRPC request by passing it to the underlying Membership Get() method.
+func (s *MembershipServer) GetCloudidentityMembership(ctx context.Context, request *cloudidentitypb.GetCloudidentityMembershipRequest) (*cloudidentitypb.CloudidentityMembership, error) {
+	cl, err := createConfigMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMembership(ctx, ProtoToMembership(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MembershipToProto(res), nil
+}
+
+// HasDiffCloudidentityMembership handles the gRPC request by passing it to the underlying Membership Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MembershipServer) HasDiffCloudidentityMembership(ctx context.Context, request *cloudidentitypb.HasDiffCloudidentityMembershipRequest) (*cloudidentitypb.HasDiffCloudidentityMembershipResponse, error) {
+	cl, err := createConfigMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMembership(ctx, ProtoToMembership(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudidentitypb.HasDiffCloudidentityMembershipResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudidentityMembership handles the gRPC request by passing it to the underlying Membership Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MembershipServer) PlanCloudidentityMembership(ctx context.Context, request *cloudidentitypb.PlanCloudidentityMembershipRequest) (*cloudidentitypb.PlanCloudidentityMembershipResponse, error) {
+	cl, err := createConfigMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMembership(ctx, ProtoToMembership(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudidentitypb.PlanCloudidentityMembershipResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMembership handles the gRPC request by passing it to the underlying Membership Delete() method.
 func (s *MembershipServer) DeleteCloudidentityMembership(ctx context.Context, request *cloudidentitypb.DeleteCloudidentityMembershipRequest) (*emptypb.Empty, error) {
 
@@ -305,22 +364,35 @@ func (s *MembershipServer) ListCloudidentityMembership(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListMembership(ctx, request.GetGroup())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = cloudidentity.MembershipMaxPage
+	}
+	resources, err := cl.ListMembershipWithPageToken(ctx, request.GetGroup(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*cloudidentitypb.CloudidentityMembership
-	for _, r := range resources.Items {
-		rp := MembershipToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MembershipToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &cloudidentitypb.ListCloudidentityMembershipResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMembership(ctx context.Context, service_account_file string) (*cloudidentity.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return cloudidentity.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudkms/alpha/cloudkms_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudkms/alpha"
@@ -390,7 +391,11 @@ func CryptoKeyToProto(resource *alpha.CryptoKey) *alphapb.CloudkmsAlphaCryptoKey
 // applyCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Apply() method.
 func (s *CryptoKeyServer) applyCryptoKey(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudkmsAlphaCryptoKeyRequest) (*alphapb.CloudkmsAlphaCryptoKey, error) {
 	p := ProtoToCryptoKey(request.GetResource())
-	res, err := c.ApplyCryptoKey(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCryptoKey(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -407,6 +412,60 @@ func (s *CryptoKeyServer) ApplyCloudkmsAlphaCryptoKey(ctx context.Context, reque
 	return s.applyCryptoKey(ctx, cl, request)
 }
 
+// GetCloudkmsAlphaCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Get() method.
+func (s *CryptoKeyServer) GetCloudkmsAlphaCryptoKey(ctx context.Context, request *alphapb.GetCloudkmsAlphaCryptoKeyRequest) (*alphapb.CloudkmsAlphaCryptoKey, error) {
+	cl, err := createConfigCryptoKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCryptoKey(ctx, ProtoToCryptoKey(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CryptoKeyToProto(res), nil
+}
+
+// HasDiffCloudkmsAlphaCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CryptoKeyServer) HasDiffCloudkmsAlphaCryptoKey(ctx context.Context, request *alphapb.HasDiffCloudkmsAlphaCryptoKeyRequest) (*alphapb.HasDiffCloudkmsAlphaCryptoKeyResponse, error) {
+	cl, err := createConfigCryptoKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCryptoKey(ctx, ProtoToCryptoKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudkmsAlphaCryptoKeyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudkmsAlphaCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CryptoKeyServer) PlanCloudkmsAlphaCryptoKey(ctx context.Context, request *alphapb.PlanCloudkmsAlphaCryptoKeyRequest) (*alphapb.PlanCloudkmsAlphaCryptoKeyResponse, error) {
+	cl, err := createConfigCryptoKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCryptoKey(ctx, ProtoToCryptoKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudkmsAlphaCryptoKeyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Delete() method.
 func (s *CryptoKeyServer) DeleteCloudkmsAlphaCryptoKey(ctx context.Context, request *alphapb.DeleteCloudkmsAlphaCryptoKeyRequest) (*emptypb.Empty, error) {
 
@@ -421,22 +480,35 @@ func (s *CryptoKeyServer) ListCloudkmsAlphaCryptoKey(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListCryptoKey(ctx, request.GetProject(), request.GetLocation(), request.GetKeyRing())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.CryptoKeyMaxPage
+	}
+	resources, err := cl.ListCryptoKeyWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetKeyRing(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.CloudkmsAlphaCryptoKey
-	for _, r := range resources.Items {
-		rp := CryptoKeyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CryptoKeyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListCloudkmsAlphaCryptoKeyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCryptoKey(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudkms/alpha/cloudkms_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudkms/alpha"
@@ -136,7 +137,11 @@ func EkmConnectionToProto(resource *alpha.EkmConnection) *alphapb.CloudkmsAlphaE
 // applyEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Apply() method.
 func (s *EkmConnectionServer) applyEkmConnection(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudkmsAlphaEkmConnectionRequest) (*alphapb.CloudkmsAlphaEkmConnection, error) {
 	p := ProtoToEkmConnection(request.GetResource())
-	res, err := c.ApplyEkmConnection(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEkmConnection(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -153,6 +158,60 @@ func (s *EkmConnectionServer) ApplyCloudkmsAlphaEkmConnection(ctx context.Contex
 	return s.applyEkmConnection(ctx, cl, request)
 }
 
+// GetCloudkmsAlphaEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Get() method.
+func (s *EkmConnectionServer) GetCloudkmsAlphaEkmConnection(ctx context.Context, request *alphapb.GetCloudkmsAlphaEkmConnectionRequest) (*alphapb.CloudkmsAlphaEkmConnection, error) {
+	cl, err := createConfigEkmConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEkmConnection(ctx, ProtoToEkmConnection(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EkmConnectionToProto(res), nil
+}
+
+// HasDiffCloudkmsAlphaEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EkmConnectionServer) HasDiffCloudkmsAlphaEkmConnection(ctx context.Context, request *alphapb.HasDiffCloudkmsAlphaEkmConnectionRequest) (*alphapb.HasDiffCloudkmsAlphaEkmConnectionResponse, error) {
+	cl, err := createConfigEkmConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEkmConnection(ctx, ProtoToEkmConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudkmsAlphaEkmConnectionResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudkmsAlphaEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EkmConnectionServer) PlanCloudkmsAlphaEkmConnection(ctx context.Context, request *alphapb.PlanCloudkmsAlphaEkmConnectionRequest) (*alphapb.PlanCloudkmsAlphaEkmConnectionResponse, error) {
+	cl, err := createConfigEkmConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEkmConnection(ctx, ProtoToEkmConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudkmsAlphaEkmConnectionResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Delete() method.
 func (s *EkmConnectionServer) DeleteCloudkmsAlphaEkmConnection(ctx context.Context, request *alphapb.DeleteCloudkmsAlphaEkmConnectionRequest) (*emptypb.Empty, error) {
 
@@ -167,22 +226,35 @@ func (s *EkmConnectionServer) ListCloudkmsAlphaEkmConnection(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListEkmConnection(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.EkmConnectionMaxPage
+	}
+	resources, err := cl.ListEkmConnectionWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.CloudkmsAlphaEkmConnection
-	for _, r := range resources.Items {
-		rp := EkmConnectionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EkmConnectionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListCloudkmsAlphaEkmConnectionResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEkmConnection(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudkms/alpha/cloudkms_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudkms/alpha"
@@ -50,7 +51,11 @@ func KeyRingToProto(resource *alpha.KeyRing) *alphapb.CloudkmsAlphaKeyRing {
 // applyKeyRing handles the gRPC request by passing it to the underlying KeyRing Apply() method.
 func (s *KeyRingServer) applyKeyRing(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudkmsAlphaKeyRingRequest) (*alphapb.CloudkmsAlphaKeyRing, error) {
 	p := ProtoToKeyRing(request.GetResource())
-	res, err := c.ApplyKeyRing(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyKeyRing(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +72,60 @@ func (s *KeyRingServer) ApplyCloudkmsAlphaKeyRing(ctx context.Context, request *
 	return s.applyKeyRing(ctx, cl, request)
 }
 
+// GetCloudkmsAlphaKeyRing handles the gRPC request by passing it to the underlying KeyRing Get() method.
+func (s *KeyRingServer) GetCloudkmsAlphaKeyRing(ctx context.Context, request *alphapb.GetCloudkmsAlphaKeyRingRequest) (*alphapb.CloudkmsAlphaKeyRing, error) {
+	cl, err := createConfigKeyRing(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetKeyRing(ctx, ProtoToKeyRing(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return KeyRingToProto(res), nil
+}
+
+// HasDiffCloudkmsAlphaKeyRing handles the gRPC request by passing it to the underlying KeyRing Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *KeyRingServer) HasDiffCloudkmsAlphaKeyRing(ctx context.Context, request *alphapb.HasDiffCloudkmsAlphaKeyRingRequest) (*alphapb.HasDiffCloudkmsAlphaKeyRingResponse, error) {
+	cl, err := createConfigKeyRing(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKeyRing(ctx, ProtoToKeyRing(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudkmsAlphaKeyRingResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudkmsAlphaKeyRing handles the gRPC request by passing it to the underlying KeyRing Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *KeyRingServer) PlanCloudkmsAlphaKeyRing(ctx context.Context, request *alphapb.PlanCloudkmsAlphaKeyRingRequest) (*alphapb.PlanCloudkmsAlphaKeyRingResponse, error) {
+	cl, err := createConfigKeyRing(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKeyRing(ctx, ProtoToKeyRing(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudkmsAlphaKeyRingResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteKeyRing handles the gRPC request by passing it to the underlying KeyRing Delete() method.
 func (s *KeyRingServer) DeleteCloudkmsAlphaKeyRing(ctx context.Context, request *alphapb.DeleteCloudkmsAlphaKeyRingRequest) (*emptypb.Empty, error) {
 
@@ -81,22 +140,35 @@ func (s *KeyRingServer) ListCloudkmsAlphaKeyRing(ctx context.Context, request *a
 		return nil, err
 	}
 
-	resources, err := cl.ListKeyRing(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.KeyRingMaxPage
+	}
+	resources, err := cl.ListKeyRingWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.CloudkmsAlphaKeyRing
-	for _, r := range resources.Items {
-		rp := KeyRingToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, KeyRingToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListCloudkmsAlphaKeyRingResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigKeyRing(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudkms/beta/cloudkms_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudkms/beta"
@@ -390,7 +391,11 @@ func CryptoKeyToProto(resource *beta.CryptoKey) *betapb.CloudkmsBetaCryptoKey {
 // applyCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Apply() method.
 func (s *CryptoKeyServer) applyCryptoKey(ctx context.Context, c *beta.Client, request *betapb.ApplyCloudkmsBetaCryptoKeyRequest) (*betapb.CloudkmsBetaCryptoKey, error) {
 	p := ProtoToCryptoKey(request.GetResource())
-	res, err := c.ApplyCryptoKey(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCryptoKey(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -407,6 +412,60 @@ func (s *CryptoKeyServer) ApplyCloudkmsBetaCryptoKey(ctx context.Context, reques
 	return s.applyCryptoKey(ctx, cl, request)
 }
 
+// GetCloudkmsBetaCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Get() method.
+func (s *CryptoKeyServer) GetCloudkmsBetaCryptoKey(ctx context.Context, request *betapb.GetCloudkmsBetaCryptoKeyRequest) (*betapb.CloudkmsBetaCryptoKey, error) {
+	cl, err := createConfigCryptoKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCryptoKey(ctx, ProtoToCryptoKey(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CryptoKeyToProto(res), nil
+}
+
+// HasDiffCloudkmsBetaCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CryptoKeyServer) HasDiffCloudkmsBetaCryptoKey(ctx context.Context, request *betapb.HasDiffCloudkmsBetaCryptoKeyRequest) (*betapb.HasDiffCloudkmsBetaCryptoKeyResponse, error) {
+	cl, err := createConfigCryptoKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCryptoKey(ctx, ProtoToCryptoKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffCloudkmsBetaCryptoKeyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudkmsBetaCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CryptoKeyServer) PlanCloudkmsBetaCryptoKey(ctx context.Context, request *betapb.PlanCloudkmsBetaCryptoKeyRequest) (*betapb.PlanCloudkmsBetaCryptoKeyResponse, error) {
+	cl, err := createConfigCryptoKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCryptoKey(ctx, ProtoToCryptoKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanCloudkmsBetaCryptoKeyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Delete() method.
 func (s *CryptoKeyServer) DeleteCloudkmsBetaCryptoKey(ctx context.Context, request *betapb.DeleteCloudkmsBetaCryptoKeyRequest) (*emptypb.Empty, error) {
 
@@ -421,22 +480,35 @@ func (s *CryptoKeyServer) ListCloudkmsBetaCryptoKey(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListCryptoKey(ctx, request.GetProject(), request.GetLocation(), request.GetKeyRing())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.CryptoKeyMaxPage
+	}
+	resources, err := cl.ListCryptoKeyWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetKeyRing(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.CloudkmsBetaCryptoKey
-	for _, r := range resources.Items {
-		rp := CryptoKeyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CryptoKeyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListCloudkmsBetaCryptoKeyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCryptoKey(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudkms/beta/cloudkms_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudkms/beta"
@@ -136,7 +137,11 @@ func EkmConnectionToProto(resource *beta.EkmConnection) *betapb.CloudkmsBetaEkmC
 // applyEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Apply() method.
 func (s *EkmConnectionServer) applyEkmConnection(ctx context.Context, c *beta.Client, request *betapb.ApplyCloudkmsBetaEkmConnectionRequest) (*betapb.CloudkmsBetaEkmConnection, error) {
 	p := ProtoToEkmConnection(request.GetResource())
-	res, err := c.ApplyEkmConnection(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEkmConnection(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -153,6 +158,60 @@ func (s *EkmConnectionServer) ApplyCloudkmsBetaEkmConnection(ctx context.Context
 	return s.applyEkmConnection(ctx, cl, request)
 }
 
+// GetCloudkmsBetaEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Get() method.
+func (s *EkmConnectionServer) GetCloudkmsBetaEkmConnection(ctx context.Context, request *betapb.GetCloudkmsBetaEkmConnectionRequest) (*betapb.CloudkmsBetaEkmConnection, error) {
+	cl, err := createConfigEkmConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEkmConnection(ctx, ProtoToEkmConnection(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EkmConnectionToProto(res), nil
+}
+
+// HasDiffCloudkmsBetaEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EkmConnectionServer) HasDiffCloudkmsBetaEkmConnection(ctx context.Context, request *betapb.HasDiffCloudkmsBetaEkmConnectionRequest) (*betapb.HasDiffCloudkmsBetaEkmConnectionResponse, error) {
+	cl, err := createConfigEkmConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEkmConnection(ctx, ProtoToEkmConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffCloudkmsBetaEkmConnectionResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudkmsBetaEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EkmConnectionServer) PlanCloudkmsBetaEkmConnection(ctx context.Context, request *betapb.PlanCloudkmsBetaEkmConnectionRequest) (*betapb.PlanCloudkmsBetaEkmConnectionResponse, error) {
+	cl, err := createConfigEkmConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEkmConnection(ctx, ProtoToEkmConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanCloudkmsBetaEkmConnectionResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Delete() method.
 func (s *EkmConnectionServer) DeleteCloudkmsBetaEkmConnection(ctx context.Context, request *betapb.DeleteCloudkmsBetaEkmConnectionRequest) (*emptypb.Empty, error) {
 
@@ -167,22 +226,35 @@ func (s *EkmConnectionServer) ListCloudkmsBetaEkmConnection(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListEkmConnection(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.EkmConnectionMaxPage
+	}
+	resources, err := cl.ListEkmConnectionWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.CloudkmsBetaEkmConnection
-	for _, r := range resources.Items {
-		rp := EkmConnectionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EkmConnectionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListCloudkmsBetaEkmConnectionResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEkmConnection(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudkms/beta/cloudkms_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudkms/beta"
@@ -50,7 +51,11 @@ func KeyRingToProto(resource *beta.KeyRing) *betapb.CloudkmsBetaKeyRing {
 // applyKeyRing handles the gRPC request by passing it to the underlying KeyRing Apply() method.
 func (s *KeyRingServer) applyKeyRing(ctx context.Context, c *beta.Client, request *betapb.ApplyCloudkmsBetaKeyRingRequest) (*betapb.CloudkmsBetaKeyRing, error) {
 	p := ProtoToKeyRing(request.GetResource())
-	res, err := c.ApplyKeyRing(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyKeyRing(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +72,60 @@ func (s *KeyRingServer) ApplyCloudkmsBetaKeyRing(ctx context.Context, request *b
 	return s.applyKeyRing(ctx, cl, request)
 }
 
+// GetCloudkmsBetaKeyRing handles the gRPC request by passing it to the underlying KeyRing Get() method.
+func (s *KeyRingServer) GetCloudkmsBetaKeyRing(ctx context.Context, request *betapb.GetCloudkmsBetaKeyRingRequest) (*betapb.CloudkmsBetaKeyRing, error) {
+	cl, err := createConfigKeyRing(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetKeyRing(ctx, ProtoToKeyRing(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return KeyRingToProto(res), nil
+}
+
+// HasDiffCloudkmsBetaKeyRing handles the gRPC request by passing it to the underlying KeyRing Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *KeyRingServer) HasDiffCloudkmsBetaKeyRing(ctx context.Context, request *betapb.HasDiffCloudkmsBetaKeyRingRequest) (*betapb.HasDiffCloudkmsBetaKeyRingResponse, error) {
+	cl, err := createConfigKeyRing(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKeyRing(ctx, ProtoToKeyRing(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffCloudkmsBetaKeyRingResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudkmsBetaKeyRing handles the gRPC request by passing it to the underlying KeyRing Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *KeyRingServer) PlanCloudkmsBetaKeyRing(ctx context.Context, request *betapb.PlanCloudkmsBetaKeyRingRequest) (*betapb.PlanCloudkmsBetaKeyRingResponse, error) {
+	cl, err := createConfigKeyRing(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKeyRing(ctx, ProtoToKeyRing(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanCloudkmsBetaKeyRingResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteKeyRing handles the gRPC request by passing it to the underlying KeyRing Delete() method.
 func (s *KeyRingServer) DeleteCloudkmsBetaKeyRing(ctx context.Context, request *betapb.DeleteCloudkmsBetaKeyRingRequest) (*emptypb.Empty, error) {
 
@@ -81,22 +140,35 @@ func (s *KeyRingServer) ListCloudkmsBetaKeyRing(ctx context.Context, request *be
 		return nil, err
 	}
 
-	resources, err := cl.ListKeyRing(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.KeyRingMaxPage
+	}
+	resources, err := cl.ListKeyRingWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.CloudkmsBetaKeyRing
-	for _, r := range resources.Items {
-		rp := KeyRingToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, KeyRingToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListCloudkmsBetaKeyRingResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigKeyRing(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	cloudkmspb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudkms/cloudkms_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudkms"
@@ -390,7 +391,11 @@ func CryptoKeyToProto(resource *cloudkms.CryptoKey) *cloudkmspb.CloudkmsCryptoKe
 // applyCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Apply() method.
 func (s *CryptoKeyServer) applyCryptoKey(ctx context.Context, c *cloudkms.Client, request *cloudkmspb.ApplyCloudkmsCryptoKeyRequest) (*cloudkmspb.CloudkmsCryptoKey, error) {
 	p := ProtoToCryptoKey(request.GetResource())
-	res, err := c.ApplyCryptoKey(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCryptoKey(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -407,6 +412,60 @@ func (s *CryptoKeyServer) ApplyCloudkmsCryptoKey(ctx context.Context, request *c
 	return s.applyCryptoKey(ctx, cl, request)
 }
 
+// GetCloudkmsCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Get() method.
+func (s *CryptoKeyServer) GetCloudkmsCryptoKey(ctx context.Context, request *cloudkmspb.GetCloudkmsCryptoKeyRequest) (*cloudkmspb.CloudkmsCryptoKey, error) {
+	cl, err := createConfigCryptoKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCryptoKey(ctx, ProtoToCryptoKey(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CryptoKeyToProto(res), nil
+}
+
+// HasDiffCloudkmsCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CryptoKeyServer) HasDiffCloudkmsCryptoKey(ctx context.Context, request *cloudkmspb.HasDiffCloudkmsCryptoKeyRequest) (*cloudkmspb.HasDiffCloudkmsCryptoKeyResponse, error) {
+	cl, err := createConfigCryptoKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCryptoKey(ctx, ProtoToCryptoKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudkmspb.HasDiffCloudkmsCryptoKeyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudkmsCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CryptoKeyServer) PlanCloudkmsCryptoKey(ctx context.Context, request *cloudkmspb.PlanCloudkmsCryptoKeyRequest) (*cloudkmspb.PlanCloudkmsCryptoKeyResponse, error) {
+	cl, err := createConfigCryptoKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCryptoKey(ctx, ProtoToCryptoKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudkmspb.PlanCloudkmsCryptoKeyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCryptoKey handles the gRPC request by passing it to the underlying CryptoKey Delete() method.
 func (s *CryptoKeyServer) DeleteCloudkmsCryptoKey(ctx context.Context, request *cloudkmspb.DeleteCloudkmsCryptoKeyRequest) (*emptypb.Empty, error) {
 
@@ -421,22 +480,35 @@ func (s *CryptoKeyServer) ListCloudkmsCryptoKey(ctx context.Context, request *cl
 		return nil, err
 	}
 
-	resources, err := cl.ListCryptoKey(ctx, request.GetProject(), request.GetLocation(), request.GetKeyRing())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = cloudkms.CryptoKeyMaxPage
+	}
+	resources, err := cl.ListCryptoKeyWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetKeyRing(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*cloudkmspb.CloudkmsCryptoKey
-	for _, r := range resources.Items {
-		rp := CryptoKeyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CryptoKeyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &cloudkmspb.ListCloudkmsCryptoKeyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCryptoKey(ctx context.Context, service_account_file string) (*cloudkms.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return cloudkms.NewClient(conf), nil
 }
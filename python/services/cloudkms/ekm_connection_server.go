@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	cloudkmspb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudkms/cloudkms_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudkms"
@@ -136,7 +137,11 @@ func EkmConnectionToProto(resource *cloudkms.EkmConnection) *cloudkmspb.Cloudkms
 // applyEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Apply() method.
 func (s *EkmConnectionServer) applyEkmConnection(ctx context.Context, c *cloudkms.Client, request *cloudkmspb.ApplyCloudkmsEkmConnectionRequest) (*cloudkmspb.CloudkmsEkmConnection, error) {
 	p := ProtoToEkmConnection(request.GetResource())
-	res, err := c.ApplyEkmConnection(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEkmConnection(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -153,6 +158,60 @@ func (s *EkmConnectionServer) ApplyCloudkmsEkmConnection(ctx context.Context, re
 	return s.applyEkmConnection(ctx, cl, request)
 }
 
+// GetCloudkmsEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Get() method.
+func (s *EkmConnectionServer) GetCloudkmsEkmConnection(ctx context.Context, request *cloudkmspb.GetCloudkmsEkmConnectionRequest) (*cloudkmspb.CloudkmsEkmConnection, error) {
+	cl, err := createConfigEkmConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEkmConnection(ctx, ProtoToEkmConnection(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EkmConnectionToProto(res), nil
+}
+
+// HasDiffCloudkmsEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EkmConnectionServer) HasDiffCloudkmsEkmConnection(ctx context.Context, request *cloudkmspb.HasDiffCloudkmsEkmConnectionRequest) (*cloudkmspb.HasDiffCloudkmsEkmConnectionResponse, error) {
+	cl, err := createConfigEkmConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEkmConnection(ctx, ProtoToEkmConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudkmspb.HasDiffCloudkmsEkmConnectionResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudkmsEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EkmConnectionServer) PlanCloudkmsEkmConnection(ctx context.Context, request *cloudkmspb.PlanCloudkmsEkmConnectionRequest) (*cloudkmspb.PlanCloudkmsEkmConnectionResponse, error) {
+	cl, err := createConfigEkmConnection(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEkmConnection(ctx, ProtoToEkmConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudkmspb.PlanCloudkmsEkmConnectionResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEkmConnection handles the gRPC request by passing it to the underlying EkmConnection Delete() method.
 func (s *EkmConnectionServer) DeleteCloudkmsEkmConnection(ctx context.Context, request *cloudkmspb.DeleteCloudkmsEkmConnectionRequest) (*emptypb.Empty, error) {
 
@@ -167,22 +226,35 @@ func (s *EkmConnectionServer) ListCloudkmsEkmConnection(ctx context.Context, req
 		return nil, err
 	}
 
-	resources, err := cl.ListEkmConnection(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = cloudkms.EkmConnectionMaxPage
+	}
+	resources, err := cl.ListEkmConnectionWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*cloudkmspb.CloudkmsEkmConnection
-	for _, r := range resources.Items {
-		rp := EkmConnectionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EkmConnectionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &cloudkmspb.ListCloudkmsEkmConnectionResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEkmConnection(ctx context.Context, service_account_file string) (*cloudkms.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return cloudkms.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	cloudkmspb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudkms/cloudkms_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudkms"
@@ -50,7 +51,11 @@ func KeyRingToProto(resource *cloudkms.KeyRing) *cloudkmspb.CloudkmsKeyRing {
 // applyKeyRing handles the gRPC request by passing it to the underlying KeyRing Apply() method.
 func (s *KeyRingServer) applyKeyRing(ctx context.Context, c *cloudkms.Client, request *cloudkmspb.ApplyCloudkmsKeyRingRequest) (*cloudkmspb.CloudkmsKeyRing, error) {
 	p := ProtoToKeyRing(request.GetResource())
-	res, err := c.ApplyKeyRing(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyKeyRing(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +72,60 @@ func (s *KeyRingServer) ApplyCloudkmsKeyRing(ctx context.Context, request *cloud
 	return s.applyKeyRing(ctx, cl, request)
 }
 
+// GetCloudkmsKeyRing handles the gRPC request by passing it to the underlying KeyRing Get() method.
+func (s *KeyRingServer) GetCloudkmsKeyRing(ctx context.Context, request *cloudkmspb.GetCloudkmsKeyRingRequest) (*cloudkmspb.CloudkmsKeyRing, error) {
+	cl, err := createConfigKeyRing(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetKeyRing(ctx, ProtoToKeyRing(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return KeyRingToProto(res), nil
+}
+
+// HasDiffCloudkmsKeyRing handles the gRPC request by passing it to the underlying KeyRing Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *KeyRingServer) HasDiffCloudkmsKeyRing(ctx context.Context, request *cloudkmspb.HasDiffCloudkmsKeyRingRequest) (*cloudkmspb.HasDiffCloudkmsKeyRingResponse, error) {
+	cl, err := createConfigKeyRing(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKeyRing(ctx, ProtoToKeyRing(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudkmspb.HasDiffCloudkmsKeyRingResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudkmsKeyRing handles the gRPC request by passing it to the underlying KeyRing Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *KeyRingServer) PlanCloudkmsKeyRing(ctx context.Context, request *cloudkmspb.PlanCloudkmsKeyRingRequest) (*cloudkmspb.PlanCloudkmsKeyRingResponse, error) {
+	cl, err := createConfigKeyRing(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKeyRing(ctx, ProtoToKeyRing(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudkmspb.PlanCloudkmsKeyRingResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteKeyRing handles the gRPC request by passing it to the underlying KeyRing Delete() method.
 func (s *KeyRingServer) DeleteCloudkmsKeyRing(ctx context.Context, request *cloudkmspb.DeleteCloudkmsKeyRingRequest) (*emptypb.Empty, error) {
 
@@ -81,22 +140,35 @@ func (s *KeyRingServer) ListCloudkmsKeyRing(ctx context.Context, request *cloudk
 		return nil, err
 	}
 
-	resources, err := cl.ListKeyRing(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = cloudkms.KeyRingMaxPage
+	}
+	resources, err := cl.ListKeyRingWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*cloudkmspb.CloudkmsKeyRing
-	for _, r := range resources.Items {
-		rp := KeyRingToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, KeyRingToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &cloudkmspb.ListCloudkmsKeyRingResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigKeyRing(ctx context.Context, service_account_file string) (*cloudkms.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return cloudkms.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudresourcemanager/alpha/cloudresourcemanager_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudresourcemanager/alpha"
@@ -80,7 +81,11 @@ func FolderToProto(resource *alpha.Folder) *alphapb.CloudresourcemanagerAlphaFol
 // applyFolder handles the gRPC request by passing it to the underlying Folder Apply() method.
 func (s *FolderServer) applyFolder(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudresourcemanagerAlphaFolderRequest) (*alphapb.CloudresourcemanagerAlphaFolder, error) {
 	p := ProtoToFolder(request.GetResource())
-	res, err := c.ApplyFolder(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFolder(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +102,60 @@ func (s *FolderServer) ApplyCloudresourcemanagerAlphaFolder(ctx context.Context,
 	return s.applyFolder(ctx, cl, request)
 }
 
+// GetCloudresourcemanagerAlphaFolder handles the gRPC request by passing it to the underlying Folder Get() method.
+func (s *FolderServer) GetCloudresourcemanagerAlphaFolder(ctx context.Context, request *alphapb.GetCloudresourcemanagerAlphaFolderRequest) (*alphapb.CloudresourcemanagerAlphaFolder, error) {
+	cl, err := createConfigFolder(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFolder(ctx, ProtoToFolder(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FolderToProto(res), nil
+}
+
+// HasDiffCloudresourcemanagerAlphaFolder handles the gRPC request by passing it to the underlying Folder Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FolderServer) HasDiffCloudresourcemanagerAlphaFolder(ctx context.Context, request *alphapb.HasDiffCloudresourcemanagerAlphaFolderRequest) (*alphapb.HasDiffCloudresourcemanagerAlphaFolderResponse, error) {
+	cl, err := createConfigFolder(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFolder(ctx, ProtoToFolder(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudresourcemanagerAlphaFolderResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudresourcemanagerAlphaFolder handles the gRPC request by passing it to the underlying Folder Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FolderServer) PlanCloudresourcemanagerAlphaFolder(ctx context.Context, request *alphapb.PlanCloudresourcemanagerAlphaFolderRequest) (*alphapb.PlanCloudresourcemanagerAlphaFolderResponse, error) {
+	cl, err := createConfigFolder(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFolder(ctx, ProtoToFolder(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudresourcemanagerAlphaFolderResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFolder handles the gRPC request by passing it to the underlying Folder Delete() method.
 func (s *FolderServer) DeleteCloudresourcemanagerAlphaFolder(ctx context.Context, request *alphapb.DeleteCloudresourcemanagerAlphaFolderRequest) (*emptypb.Empty, error) {
 
@@ -115,22 +174,35 @@ func (s *FolderServer) ListCloudresourcemanagerAlphaFolder(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListFolder(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.FolderMaxPage
+	}
+	resources, err := cl.ListFolderWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.CloudresourcemanagerAlphaFolder
-	for _, r := range resources.Items {
-		rp := FolderToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FolderToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListCloudresourcemanagerAlphaFolderResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFolder(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
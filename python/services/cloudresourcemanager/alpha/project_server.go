@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudresourcemanager/alpha/cloudresourcemanager_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudresourcemanager/alpha"
@@ -79,7 +80,11 @@ func ProjectToProto(resource *alpha.Project) *alphapb.CloudresourcemanagerAlphaP
 // applyProject handles the gRPC request by passing it to the underlying Project Apply() method.
 func (s *ProjectServer) applyProject(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudresourcemanagerAlphaProjectRequest) (*alphapb.CloudresourcemanagerAlphaProject, error) {
 	p := ProtoToProject(request.GetResource())
-	res, err := c.ApplyProject(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyProject(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +101,60 @@ func (s *ProjectServer) ApplyCloudresourcemanagerAlphaProject(ctx context.Contex
 	return s.applyProject(ctx, cl, request)
 }
 
+// GetCloudresourcemanagerAlphaProject handles the gRPC request by passing it to the underlying Project Get() method.
+func (s *ProjectServer) GetCloudresourcemanagerAlphaProject(ctx context.Context, request *alphapb.GetCloudresourcemanagerAlphaProjectRequest) (*alphapb.CloudresourcemanagerAlphaProject, error) {
+	cl, err := createConfigProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetProject(ctx, ProtoToProject(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ProjectToProto(res), nil
+}
+
+// HasDiffCloudresourcemanagerAlphaProject handles the gRPC request by passing it to the underlying Project Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ProjectServer) HasDiffCloudresourcemanagerAlphaProject(ctx context.Context, request *alphapb.HasDiffCloudresourcemanagerAlphaProjectRequest) (*alphapb.HasDiffCloudresourcemanagerAlphaProjectResponse, error) {
+	cl, err := createConfigProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffProject(ctx, ProtoToProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudresourcemanagerAlphaProjectResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudresourcemanagerAlphaProject handles the gRPC request by passing it to the underlying Project Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ProjectServer) PlanCloudresourcemanagerAlphaProject(ctx context.Context, request *alphapb.PlanCloudresourcemanagerAlphaProjectRequest) (*alphapb.PlanCloudresourcemanagerAlphaProjectResponse, error) {
+	cl, err := createConfigProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffProject(ctx, ProtoToProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudresourcemanagerAlphaProjectResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteProject handles the gRPC request by passing it to the underlying Project Delete() method.
 func (s *ProjectServer) DeleteCloudresourcemanagerAlphaProject(ctx context.Context, request *alphapb.DeleteCloudresourcemanagerAlphaProjectRequest) (*emptypb.Empty, error) {
 
@@ -114,22 +173,35 @@ func (s *ProjectServer) ListCloudresourcemanagerAlphaProject(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListProject(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ProjectMaxPage
+	}
+	resources, err := cl.ListProjectWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.CloudresourcemanagerAlphaProject
-	for _, r := range resources.Items {
-		rp := ProjectToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ProjectToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListCloudresourcemanagerAlphaProjectResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigProject(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
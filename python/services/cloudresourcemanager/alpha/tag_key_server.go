@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudresourcemanager/alpha/cloudresourcemanager_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudresourcemanager/alpha"
@@ -87,7 +88,11 @@ func TagKeyToProto(resource *alpha.TagKey) *alphapb.CloudresourcemanagerAlphaTag
 // applyTagKey handles the gRPC request by passing it to the underlying TagKey Apply() method.
 func (s *TagKeyServer) applyTagKey(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudresourcemanagerAlphaTagKeyRequest) (*alphapb.CloudresourcemanagerAlphaTagKey, error) {
 	p := ProtoToTagKey(request.GetResource())
-	res, err := c.ApplyTagKey(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTagKey(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +109,60 @@ func (s *TagKeyServer) ApplyCloudresourcemanagerAlphaTagKey(ctx context.Context,
 	return s.applyTagKey(ctx, cl, request)
 }
 
+// GetCloudresourcemanagerAlphaTagKey handles the gRPC request by passing it to the underlying TagKey Get() method.
+func (s *TagKeyServer) GetCloudresourcemanagerAlphaTagKey(ctx context.Context, request *alphapb.GetCloudresourcemanagerAlphaTagKeyRequest) (*alphapb.CloudresourcemanagerAlphaTagKey, error) {
+	cl, err := createConfigTagKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTagKey(ctx, ProtoToTagKey(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TagKeyToProto(res), nil
+}
+
+// HasDiffCloudresourcemanagerAlphaTagKey handles the gRPC request by passing it to the underlying TagKey Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TagKeyServer) HasDiffCloudresourcemanagerAlphaTagKey(ctx context.Context, request *alphapb.HasDiffCloudresourcemanagerAlphaTagKeyRequest) (*alphapb.HasDiffCloudresourcemanagerAlphaTagKeyResponse, error) {
+	cl, err := createConfigTagKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTagKey(ctx, ProtoToTagKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudresourcemanagerAlphaTagKeyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudresourcemanagerAlphaTagKey handles the gRPC request by passing it to the underlying TagKey Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TagKeyServer) PlanCloudresourcemanagerAlphaTagKey(ctx context.Context, request *alphapb.PlanCloudresourcemanagerAlphaTagKeyRequest) (*alphapb.PlanCloudresourcemanagerAlphaTagKeyResponse, error) {
+	cl, err := createConfigTagKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTagKey(ctx, ProtoToTagKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudresourcemanagerAlphaTagKeyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTagKey handles the gRPC request by passing it to the underlying TagKey Delete() method.
 func (s *TagKeyServer) DeleteCloudresourcemanagerAlphaTagKey(ctx context.Context, request *alphapb.DeleteCloudresourcemanagerAlphaTagKeyRequest) (*emptypb.Empty, error) {
 
@@ -121,7 +180,9 @@ func (s *TagKeyServer) ListCloudresourcemanagerAlphaTagKey(_ context.Context, _
 }
 
 func createConfigTagKey(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
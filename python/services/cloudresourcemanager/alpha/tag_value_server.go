@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudresourcemanager/alpha/cloudresourcemanager_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudresourcemanager/alpha"
@@ -57,7 +58,11 @@ func TagValueToProto(resource *alpha.TagValue) *alphapb.CloudresourcemanagerAlph
 // applyTagValue handles the gRPC request by passing it to the underlying TagValue Apply() method.
 func (s *TagValueServer) applyTagValue(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudresourcemanagerAlphaTagValueRequest) (*alphapb.CloudresourcemanagerAlphaTagValue, error) {
 	p := ProtoToTagValue(request.GetResource())
-	res, err := c.ApplyTagValue(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTagValue(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +79,60 @@ func (s *TagValueServer) ApplyCloudresourcemanagerAlphaTagValue(ctx context.Cont
 	return s.applyTagValue(ctx, cl, request)
 }
 
+// GetCloudresourcemanagerAlphaTagValue handles the gRPC request by passing it to the underlying TagValue Get() method.
+func (s *TagValueServer) GetCloudresourcemanagerAlphaTagValue(ctx context.Context, request *alphapb.GetCloudresourcemanagerAlphaTagValueRequest) (*alphapb.CloudresourcemanagerAlphaTagValue, error) {
+	cl, err := createConfigTagValue(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTagValue(ctx, ProtoToTagValue(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TagValueToProto(res), nil
+}
+
+// HasDiffCloudresourcemanagerAlphaTagValue handles the gRPC request by passing it to the underlying TagValue Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TagValueServer) HasDiffCloudresourcemanagerAlphaTagValue(ctx context.Context, request *alphapb.HasDiffCloudresourcemanagerAlphaTagValueRequest) (*alphapb.HasDiffCloudresourcemanagerAlphaTagValueResponse, error) {
+	cl, err := createConfigTagValue(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTagValue(ctx, ProtoToTagValue(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudresourcemanagerAlphaTagValueResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudresourcemanagerAlphaTagValue handles the gRPC request by passing it to the underlying TagValue Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TagValueServer) PlanCloudresourcemanagerAlphaTagValue(ctx context.Context, request *alphapb.PlanCloudresourcemanagerAlphaTagValueRequest) (*alphapb.PlanCloudresourcemanagerAlphaTagValueResponse, error) {
+	cl, err := createConfigTagValue(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTagValue(ctx, ProtoToTagValue(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudresourcemanagerAlphaTagValueResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTagValue handles the gRPC request by passing it to the underlying TagValue Delete() method.
 func (s *TagValueServer) DeleteCloudresourcemanagerAlphaTagValue(ctx context.Context, request *alphapb.DeleteCloudresourcemanagerAlphaTagValueRequest) (*emptypb.Empty, error) {
 
@@ -91,7 +150,9 @@ func (s *TagValueServer) ListCloudresourcemanagerAlphaTagValue(_ context.Context
 }
 
 func createConfigTagValue(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
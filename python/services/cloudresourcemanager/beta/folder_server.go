@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudresourcemanager/beta/cloudresourcemanager_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudresourcemanager/beta"
@@ -80,7 +81,11 @@ func FolderToProto(resource *beta.Folder) *betapb.CloudresourcemanagerBetaFolder
 // applyFolder handles the gRPC request by passing it to the underlying Folder Apply() method.
 func (s *FolderServer) applyFolder(ctx context.Context, c *beta.Client, request *betapb.ApplyCloudresourcemanagerBetaFolderRequest) (*betapb.CloudresourcemanagerBetaFolder, error) {
 	p := ProtoToFolder(request.GetResource())
-	res, err := c.ApplyFolder(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFolder(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +102,60 @@ func (s *FolderServer) ApplyCloudresourcemanagerBetaFolder(ctx context.Context,
 	return s.applyFolder(ctx, cl, request)
 }
 
+// GetCloudresourcemanagerBetaFolder handles the gRPC request by passing it to the underlying Folder Get() method.
+func (s *FolderServer) GetCloudresourcemanagerBetaFolder(ctx context.Context, request *betapb.GetCloudresourcemanagerBetaFolderRequest) (*betapb.CloudresourcemanagerBetaFolder, error) {
+	cl, err := createConfigFolder(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFolder(ctx, ProtoToFolder(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FolderToProto(res), nil
+}
+
+// HasDiffCloudresourcemanagerBetaFolder handles the gRPC request by passing it to the underlying Folder Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FolderServer) HasDiffCloudresourcemanagerBetaFolder(ctx context.Context, request *betapb.HasDiffCloudresourcemanagerBetaFolderRequest) (*betapb.HasDiffCloudresourcemanagerBetaFolderResponse, error) {
+	cl, err := createConfigFolder(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFolder(ctx, ProtoToFolder(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffCloudresourcemanagerBetaFolderResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudresourcemanagerBetaFolder handles the gRPC request by passing it to the underlying Folder Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FolderServer) PlanCloudresourcemanagerBetaFolder(ctx context.Context, request *betapb.PlanCloudresourcemanagerBetaFolderRequest) (*betapb.PlanCloudresourcemanagerBetaFolderResponse, error) {
+	cl, err := createConfigFolder(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFolder(ctx, ProtoToFolder(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanCloudresourcemanagerBetaFolderResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFolder handles the gRPC request by passing it to the underlying Folder Delete() method.
 func (s *FolderServer) DeleteCloudresourcemanagerBetaFolder(ctx context.Context, request *betapb.DeleteCloudresourcemanagerBetaFolderRequest) (*emptypb.Empty, error) {
 
@@ -115,22 +174,35 @@ func (s *FolderServer) ListCloudresourcemanagerBetaFolder(ctx context.Context, r
 		return nil, err
 	}
 
-	resources, err := cl.ListFolder(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.FolderMaxPage
+	}
+	resources, err := cl.ListFolderWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.CloudresourcemanagerBetaFolder
-	for _, r := range resources.Items {
-		rp := FolderToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FolderToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListCloudresourcemanagerBetaFolderResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFolder(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudresourcemanager/beta/cloudresourcemanager_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudresourcemanager/beta"
@@ -79,7 +80,11 @@ func ProjectToProto(resource *beta.Project) *betapb.CloudresourcemanagerBetaProj
 // applyProject handles the gRPC request by passing it to the underlying Project Apply() method.
 func (s *ProjectServer) applyProject(ctx context.Context, c *beta.Client, request *betapb.ApplyCloudresourcemanagerBetaProjectRequest) (*betapb.CloudresourcemanagerBetaProject, error) {
 	p := ProtoToProject(request.GetResource())
-	res, err := c.ApplyProject(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyProject(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +101,60 @@ func (s *ProjectServer) ApplyCloudresourcemanagerBetaProject(ctx context.Context
 	return s.applyProject(ctx, cl, request)
 }
 
+// GetCloudresourcemanagerBetaProject handles the gRPC request by passing it to the underlying Project Get() method.
+func (s *ProjectServer) GetCloudresourcemanagerBetaProject(ctx context.Context, request *betapb.GetCloudresourcemanagerBetaProjectRequest) (*betapb.CloudresourcemanagerBetaProject, error) {
+	cl, err := createConfigProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetProject(ctx, ProtoToProject(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ProjectToProto(res), nil
+}
+
+// HasDiffCloudresourcemanagerBetaProject handles the gRPC request by passing it to the underlying Project Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ProjectServer) HasDiffCloudresourcemanagerBetaProject(ctx context.Context, request *betapb.HasDiffCloudresourcemanagerBetaProjectRequest) (*betapb.HasDiffCloudresourcemanagerBetaProjectResponse, error) {
+	cl, err := createConfigProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffProject(ctx, ProtoToProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffCloudresourcemanagerBetaProjectResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudresourcemanagerBetaProject handles the gRPC request by passing it to the underlying Project Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ProjectServer) PlanCloudresourcemanagerBetaProject(ctx context.Context, request *betapb.PlanCloudresourcemanagerBetaProjectRequest) (*betapb.PlanCloudresourcemanagerBetaProjectResponse, error) {
+	cl, err := createConfigProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffProject(ctx, ProtoToProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanCloudresourcemanagerBetaProjectResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteProject handles the gRPC request by passing it to the underlying Project Delete() method.
 func (s *ProjectServer) DeleteCloudresourcemanagerBetaProject(ctx context.Context, request *betapb.DeleteCloudresourcemanagerBetaProjectRequest) (*emptypb.Empty, error) {
 
@@ -114,22 +173,35 @@ func (s *ProjectServer) ListCloudresourcemanagerBetaProject(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListProject(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ProjectMaxPage
+	}
+	resources, err := cl.ListProjectWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.CloudresourcemanagerBetaProject
-	for _, r := range resources.Items {
-		rp := ProjectToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ProjectToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListCloudresourcemanagerBetaProjectResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigProject(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
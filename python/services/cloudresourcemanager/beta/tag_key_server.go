@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudresourcemanager/beta/cloudresourcemanager_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudresourcemanager/beta"
@@ -87,7 +88,11 @@ func TagKeyToProto(resource *beta.TagKey) *betapb.CloudresourcemanagerBetaTagKey
 // applyTagKey handles the gRPC request by passing it to the underlying TagKey Apply() method.
 func (s *TagKeyServer) applyTagKey(ctx context.Context, c *beta.Client, request *betapb.ApplyCloudresourcemanagerBetaTagKeyRequest) (*betapb.CloudresourcemanagerBetaTagKey, error) {
 	p := ProtoToTagKey(request.GetResource())
-	res, err := c.ApplyTagKey(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTagKey(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +109,60 @@ func (s *TagKeyServer) ApplyCloudresourcemanagerBetaTagKey(ctx context.Context,
 	return s.applyTagKey(ctx, cl, request)
 }
 
+// GetCloudresourcemanagerBetaTagKey handles the gRPC request by passing it to the underlying TagKey Get() method.
+func (s *TagKeyServer) GetCloudresourcemanagerBetaTagKey(ctx context.Context, request *betapb.GetCloudresourcemanagerBetaTagKeyRequest) (*betapb.CloudresourcemanagerBetaTagKey, error) {
+	cl, err := createConfigTagKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTagKey(ctx, ProtoToTagKey(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TagKeyToProto(res), nil
+}
+
+// HasDiffCloudresourcemanagerBetaTagKey handles the gRPC request by passing it to the underlying TagKey Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TagKeyServer) HasDiffCloudresourcemanagerBetaTagKey(ctx context.Context, request *betapb.HasDiffCloudresourcemanagerBetaTagKeyRequest) (*betapb.HasDiffCloudresourcemanagerBetaTagKeyResponse, error) {
+	cl, err := createConfigTagKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTagKey(ctx, ProtoToTagKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffCloudresourcemanagerBetaTagKeyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudresourcemanagerBetaTagKey handles the gRPC request by passing it to the underlying TagKey Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TagKeyServer) PlanCloudresourcemanagerBetaTagKey(ctx context.Context, request *betapb.PlanCloudresourcemanagerBetaTagKeyRequest) (*betapb.PlanCloudresourcemanagerBetaTagKeyResponse, error) {
+	cl, err := createConfigTagKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTagKey(ctx, ProtoToTagKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanCloudresourcemanagerBetaTagKeyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTagKey handles the gRPC request by passing it to the underlying TagKey Delete() method.
 func (s *TagKeyServer) DeleteCloudresourcemanagerBetaTagKey(ctx context.Context, request *betapb.DeleteCloudresourcemanagerBetaTagKeyRequest) (*emptypb.Empty, error) {
 
@@ -121,7 +180,9 @@ func (s *TagKeyServer) ListCloudresourcemanagerBetaTagKey(_ context.Context, _ *
 }
 
 func createConfigTagKey(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudresourcemanager/beta/cloudresourcemanager_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudresourcemanager/beta"
@@ -57,7 +58,11 @@ func TagValueToProto(resource *beta.TagValue) *betapb.CloudresourcemanagerBetaTa
 // applyTagValue handles the gRPC request by passing it to the underlying TagValue Apply() method.
 func (s *TagValueServer) applyTagValue(ctx context.Context, c *beta.Client, request *betapb.ApplyCloudresourcemanagerBetaTagValueRequest) (*betapb.CloudresourcemanagerBetaTagValue, error) {
 	p := ProtoToTagValue(request.GetResource())
-	res, err := c.ApplyTagValue(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTagValue(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +79,60 @@ func (s *TagValueServer) ApplyCloudresourcemanagerBetaTagValue(ctx context.Conte
 	return s.applyTagValue(ctx, cl, request)
 }
 
+// GetCloudresourcemanagerBetaTagValue handles the gRPC request by passing it to the underlying TagValue Get() method.
+func (s *TagValueServer) GetCloudresourcemanagerBetaTagValue(ctx context.Context, request *betapb.GetCloudresourcemanagerBetaTagValueRequest) (*betapb.CloudresourcemanagerBetaTagValue, error) {
+	cl, err := createConfigTagValue(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTagValue(ctx, ProtoToTagValue(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TagValueToProto(res), nil
+}
+
+// HasDiffCloudresourcemanagerBetaTagValue handles the gRPC request by passing it to the underlying TagValue Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TagValueServer) HasDiffCloudresourcemanagerBetaTagValue(ctx context.Context, request *betapb.HasDiffCloudresourcemanagerBetaTagValueRequest) (*betapb.HasDiffCloudresourcemanagerBetaTagValueResponse, error) {
+	cl, err := createConfigTagValue(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTagValue(ctx, ProtoToTagValue(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffCloudresourcemanagerBetaTagValueResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudresourcemanagerBetaTagValue handles the gRPC request by passing it to the underlying TagValue Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TagValueServer) PlanCloudresourcemanagerBetaTagValue(ctx context.Context, request *betapb.PlanCloudresourcemanagerBetaTagValueRequest) (*betapb.PlanCloudresourcemanagerBetaTagValueResponse, error) {
+	cl, err := createConfigTagValue(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTagValue(ctx, ProtoToTagValue(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanCloudresourcemanagerBetaTagValueResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTagValue handles the gRPC request by passing it to the underlying TagValue Delete() method.
 func (s *TagValueServer) DeleteCloudresourcemanagerBetaTagValue(ctx context.Context, request *betapb.DeleteCloudresourcemanagerBetaTagValueRequest) (*emptypb.Empty, error) {
 
@@ -91,7 +150,9 @@ func (s *TagValueServer) ListCloudresourcemanagerBetaTagValue(_ context.Context,
 }
 
 func createConfigTagValue(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	cloudresourcemanagerpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudresourcemanager/cloudresourcemanager_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudresourcemanager"
@@ -79,7 +80,11 @@ func ProjectToProto(resource *cloudresourcemanager.Project) *cloudresourcemanage
 // applyProject handles the gRPC request by passing it to the underlying Project Apply() method.
 func (s *ProjectServer) applyProject(ctx context.Context, c *cloudresourcemanager.Client, request *cloudresourcemanagerpb.ApplyCloudresourcemanagerProjectRequest) (*cloudresourcemanagerpb.CloudresourcemanagerProject, error) {
 	p := ProtoToProject(request.GetResource())
-	res, err := c.ApplyProject(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyProject(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +101,60 @@ func (s *ProjectServer) ApplyCloudresourcemanagerProject(ctx context.Context, re
 	return s.applyProject(ctx, cl, request)
 }
 
+// GetCloudresourcemanagerProject handles the gRPC request by passing it to the underlying Project Get() method.
+func (s *ProjectServer) GetCloudresourcemanagerProject(ctx context.Context, request *cloudresourcemanagerpb.GetCloudresourcemanagerProjectRequest) (*cloudresourcemanagerpb.CloudresourcemanagerProject, error) {
+	cl, err := createConfigProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetProject(ctx, ProtoToProject(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ProjectToProto(res), nil
+}
+
+// HasDiffCloudresourcemanagerProject handles the gRPC request by passing it to the underlying Project Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ProjectServer) HasDiffCloudresourcemanagerProject(ctx context.Context, request *cloudresourcemanagerpb.HasDiffCloudresourcemanagerProjectRequest) (*cloudresourcemanagerpb.HasDiffCloudresourcemanagerProjectResponse, error) {
+	cl, err := createConfigProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffProject(ctx, ProtoToProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudresourcemanagerpb.HasDiffCloudresourcemanagerProjectResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudresourcemanagerProject handles the gRPC request by passing it to the underlying Project Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ProjectServer) PlanCloudresourcemanagerProject(ctx context.Context, request *cloudresourcemanagerpb.PlanCloudresourcemanagerProjectRequest) (*cloudresourcemanagerpb.PlanCloudresourcemanagerProjectResponse, error) {
+	cl, err := createConfigProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffProject(ctx, ProtoToProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudresourcemanagerpb.PlanCloudresourcemanagerProjectResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteProject handles the gRPC request by passing it to the underlying Project Delete() method.
 func (s *ProjectServer) DeleteCloudresourcemanagerProject(ctx context.Context, request *cloudresourcemanagerpb.DeleteCloudresourcemanagerProjectRequest) (*emptypb.Empty, error) {
 
@@ -114,22 +173,35 @@ func (s *ProjectServer) ListCloudresourcemanagerProject(ctx context.Context, req
 		return nil, err
 	}
 
-	resources, err := cl.ListProject(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = cloudresourcemanager.ProjectMaxPage
+	}
+	resources, err := cl.ListProjectWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*cloudresourcemanagerpb.CloudresourcemanagerProject
-	for _, r := range resources.Items {
-		rp := ProjectToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ProjectToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &cloudresourcemanagerpb.ListCloudresourcemanagerProjectResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigProject(ctx context.Context, service_account_file string) (*cloudresourcemanager.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return cloudresourcemanager.NewClient(conf), nil
 }
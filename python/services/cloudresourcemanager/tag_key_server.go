@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	cloudresourcemanagerpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudresourcemanager/cloudresourcemanager_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudresourcemanager"
@@ -87,7 +88,11 @@ func TagKeyToProto(resource *cloudresourcemanager.TagKey) *cloudresourcemanagerp
 // applyTagKey handles the gRPC request by passing it to the underlying TagKey Apply() method.
 func (s *TagKeyServer) applyTagKey(ctx context.Context, c *cloudresourcemanager.Client, request *cloudresourcemanagerpb.ApplyCloudresourcemanagerTagKeyRequest) (*cloudresourcemanagerpb.CloudresourcemanagerTagKey, error) {
 	p := ProtoToTagKey(request.GetResource())
-	res, err := c.ApplyTagKey(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTagKey(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +109,60 @@ func (s *TagKeyServer) ApplyCloudresourcemanagerTagKey(ctx context.Context, requ
 	return s.applyTagKey(ctx, cl, request)
 }
 
+// GetCloudresourcemanagerTagKey handles the gRPC request by passing it to the underlying TagKey Get() method.
+func (s *TagKeyServer) GetCloudresourcemanagerTagKey(ctx context.Context, request *cloudresourcemanagerpb.GetCloudresourcemanagerTagKeyRequest) (*cloudresourcemanagerpb.CloudresourcemanagerTagKey, error) {
+	cl, err := createConfigTagKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTagKey(ctx, ProtoToTagKey(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TagKeyToProto(res), nil
+}
+
+// HasDiffCloudresourcemanagerTagKey handles the gRPC request by passing it to the underlying TagKey Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TagKeyServer) HasDiffCloudresourcemanagerTagKey(ctx context.Context, request *cloudresourcemanagerpb.HasDiffCloudresourcemanagerTagKeyRequest) (*cloudresourcemanagerpb.HasDiffCloudresourcemanagerTagKeyResponse, error) {
+	cl, err := createConfigTagKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTagKey(ctx, ProtoToTagKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudresourcemanagerpb.HasDiffCloudresourcemanagerTagKeyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudresourcemanagerTagKey handles the gRPC request by passing it to the underlying TagKey Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TagKeyServer) PlanCloudresourcemanagerTagKey(ctx context.Context, request *cloudresourcemanagerpb.PlanCloudresourcemanagerTagKeyRequest) (*cloudresourcemanagerpb.PlanCloudresourcemanagerTagKeyResponse, error) {
+	cl, err := createConfigTagKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTagKey(ctx, ProtoToTagKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudresourcemanagerpb.PlanCloudresourcemanagerTagKeyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTagKey handles the gRPC request by passing it to the underlying TagKey Delete() method.
 func (s *TagKeyServer) DeleteCloudresourcemanagerTagKey(ctx context.Context, request *cloudresourcemanagerpb.DeleteCloudresourcemanagerTagKeyRequest) (*emptypb.Empty, error) {
 
@@ -121,7 +180,9 @@ func (s *TagKeyServer) ListCloudresourcemanagerTagKey(_ context.Context, _ *clou
 }
 
 func createConfigTagKey(ctx context.Context, service_account_file string) (*cloudresourcemanager.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return cloudresourcemanager.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	cloudresourcemanagerpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudresourcemanager/cloudresourcemanager_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudresourcemanager"
@@ -57,7 +58,11 @@ func TagValueToProto(resource *cloudresourcemanager.TagValue) *cloudresourcemana
 // applyTagValue handles the gRPC request by passing it to the underlying TagValue Apply() method.
 func (s *TagValueServer) applyTagValue(ctx context.Context, c *cloudresourcemanager.Client, request *cloudresourcemanagerpb.ApplyCloudresourcemanagerTagValueRequest) (*cloudresourcemanagerpb.CloudresourcemanagerTagValue, error) {
 	p := ProtoToTagValue(request.GetResource())
-	res, err := c.ApplyTagValue(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTagValue(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +79,60 @@ func (s *TagValueServer) ApplyCloudresourcemanagerTagValue(ctx context.Context,
 	return s.applyTagValue(ctx, cl, request)
 }
 
+// GetCloudresourcemanagerTagValue handles the gRPC request by passing it to the underlying TagValue Get() method.
+func (s *TagValueServer) GetCloudresourcemanagerTagValue(ctx context.Context, request *cloudresourcemanagerpb.GetCloudresourcemanagerTagValueRequest) (*cloudresourcemanagerpb.CloudresourcemanagerTagValue, error) {
+	cl, err := createConfigTagValue(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTagValue(ctx, ProtoToTagValue(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TagValueToProto(res), nil
+}
+
+// HasDiffCloudresourcemanagerTagValue handles the gRPC request by passing it to the underlying TagValue Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TagValueServer) HasDiffCloudresourcemanagerTagValue(ctx context.Context, request *cloudresourcemanagerpb.HasDiffCloudresourcemanagerTagValueRequest) (*cloudresourcemanagerpb.HasDiffCloudresourcemanagerTagValueResponse, error) {
+	cl, err := createConfigTagValue(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTagValue(ctx, ProtoToTagValue(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudresourcemanagerpb.HasDiffCloudresourcemanagerTagValueResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudresourcemanagerTagValue handles the gRPC request by passing it to the underlying TagValue Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TagValueServer) PlanCloudresourcemanagerTagValue(ctx context.Context, request *cloudresourcemanagerpb.PlanCloudresourcemanagerTagValueRequest) (*cloudresourcemanagerpb.PlanCloudresourcemanagerTagValueResponse, error) {
+	cl, err := createConfigTagValue(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTagValue(ctx, ProtoToTagValue(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudresourcemanagerpb.PlanCloudresourcemanagerTagValueResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTagValue handles the gRPC request by passing it to the underlying TagValue Delete() method.
 func (s *TagValueServer) DeleteCloudresourcemanagerTagValue(ctx context.Context, request *cloudresourcemanagerpb.DeleteCloudresourcemanagerTagValueRequest) (*emptypb.Empty, error) {
 
@@ -91,7 +150,9 @@ func (s *TagValueServer) ListCloudresourcemanagerTagValue(_ context.Context, _ *
 }
 
 func createConfigTagValue(ctx context.Context, service_account_file string) (*cloudresourcemanager.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return cloudresourcemanager.NewClient(conf), nil
 }
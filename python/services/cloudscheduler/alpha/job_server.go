@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudscheduler/alpha/cloudscheduler_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudscheduler/alpha"
@@ -392,7 +393,11 @@ func JobToProto(resource *alpha.Job) *alphapb.CloudschedulerAlphaJob {
 // applyJob handles the gRPC request by passing it to the underlying Job Apply() method.
 func (s *JobServer) applyJob(ctx context.Context, c *alpha.Client, request *alphapb.ApplyCloudschedulerAlphaJobRequest) (*alphapb.CloudschedulerAlphaJob, error) {
 	p := ProtoToJob(request.GetResource())
-	res, err := c.ApplyJob(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyJob(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -409,6 +414,60 @@ func (s *JobServer) ApplyCloudschedulerAlphaJob(ctx context.Context, request *al
 	return s.applyJob(ctx, cl, request)
 }
 
+// GetCloudschedulerAlphaJob handles the gRPC request by passing it to the underlying Job Get() method.
+func (s *JobServer) GetCloudschedulerAlphaJob(ctx context.Context, request *alphapb.GetCloudschedulerAlphaJobRequest) (*alphapb.CloudschedulerAlphaJob, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetJob(ctx, ProtoToJob(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return JobToProto(res), nil
+}
+
+// HasDiffCloudschedulerAlphaJob handles the gRPC request by passing it to the underlying Job Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *JobServer) HasDiffCloudschedulerAlphaJob(ctx context.Context, request *alphapb.HasDiffCloudschedulerAlphaJobRequest) (*alphapb.HasDiffCloudschedulerAlphaJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJob(ctx, ProtoToJob(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffCloudschedulerAlphaJobResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudschedulerAlphaJob handles the gRPC request by passing it to the underlying Job Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *JobServer) PlanCloudschedulerAlphaJob(ctx context.Context, request *alphapb.PlanCloudschedulerAlphaJobRequest) (*alphapb.PlanCloudschedulerAlphaJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJob(ctx, ProtoToJob(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanCloudschedulerAlphaJobResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteJob handles the gRPC request by passing it to the underlying Job Delete() method.
 func (s *JobServer) DeleteCloudschedulerAlphaJob(ctx context.Context, request *alphapb.DeleteCloudschedulerAlphaJobRequest) (*emptypb.Empty, error) {
 
@@ -427,22 +486,35 @@ func (s *JobServer) ListCloudschedulerAlphaJob(ctx context.Context, request *alp
 		return nil, err
 	}
 
-	resources, err := cl.ListJob(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.JobMaxPage
+	}
+	resources, err := cl.ListJobWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.CloudschedulerAlphaJob
-	for _, r := range resources.Items {
-		rp := JobToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, JobToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListCloudschedulerAlphaJobResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigJob(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	cloudschedulerpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/cloudscheduler/cloudscheduler_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/cloudscheduler"
@@ -392,7 +393,11 @@ func JobToProto(resource *cloudscheduler.Job) *cloudschedulerpb.CloudschedulerJo
 // applyJob handles the gRPC request by passing it to the underlying Job Apply() method.
 func (s *JobServer) applyJob(ctx context.Context, c *cloudscheduler.Client, request *cloudschedulerpb.ApplyCloudschedulerJobRequest) (*cloudschedulerpb.CloudschedulerJob, error) {
 	p := ProtoToJob(request.GetResource())
-	res, err := c.ApplyJob(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyJob(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -409,6 +414,60 @@ func (s *JobServer) ApplyCloudschedulerJob(ctx context.Context, request *cloudsc
 	return s.applyJob(ctx, cl, request)
 }
 
+// GetCloudschedulerJob handles the gRPC request by passing it to the underlying Job Get() method.
+func (s *JobServer) GetCloudschedulerJob(ctx context.Context, request *cloudschedulerpb.GetCloudschedulerJobRequest) (*cloudschedulerpb.CloudschedulerJob, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetJob(ctx, ProtoToJob(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return JobToProto(res), nil
+}
+
+// HasDiffCloudschedulerJob handles the gRPC request by passing it to the underlying Job Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *JobServer) HasDiffCloudschedulerJob(ctx context.Context, request *cloudschedulerpb.HasDiffCloudschedulerJobRequest) (*cloudschedulerpb.HasDiffCloudschedulerJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJob(ctx, ProtoToJob(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudschedulerpb.HasDiffCloudschedulerJobResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanCloudschedulerJob handles the gRPC request by passing it to the underlying Job Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *JobServer) PlanCloudschedulerJob(ctx context.Context, request *cloudschedulerpb.PlanCloudschedulerJobRequest) (*cloudschedulerpb.PlanCloudschedulerJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJob(ctx, ProtoToJob(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &cloudschedulerpb.PlanCloudschedulerJobResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteJob handles the gRPC request by passing it to the underlying Job Delete() method.
 func (s *JobServer) DeleteCloudschedulerJob(ctx context.Context, request *cloudschedulerpb.DeleteCloudschedulerJobRequest) (*emptypb.Empty, error) {
 
@@ -427,22 +486,35 @@ func (s *JobServer) ListCloudschedulerJob(ctx context.Context, request *cloudsch
 		return nil, err
 	}
 
-	resources, err := cl.ListJob(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = cloudscheduler.JobMaxPage
+	}
+	resources, err := cl.ListJobWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*cloudschedulerpb.CloudschedulerJob
-	for _, r := range resources.Items {
-		rp := JobToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, JobToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &cloudschedulerpb.ListCloudschedulerJobResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigJob(ctx context.Context, service_account_file string) (*cloudscheduler.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return cloudscheduler.NewClient(conf), nil
 }
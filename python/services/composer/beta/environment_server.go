@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/composer/beta/composer_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/composer/beta"
@@ -436,7 +437,11 @@ func EnvironmentToProto(resource *beta.Environment) *betapb.ComposerBetaEnvironm
 // ApplyEnvironment handles the gRPC request by passing it to the underlying Environment Apply() method.
 func (s *EnvironmentServer) applyEnvironment(ctx context.Context, c *beta.Client, request *betapb.ApplyComposerBetaEnvironmentRequest) (*betapb.ComposerBetaEnvironment, error) {
 	p := ProtoToEnvironment(request.GetResource())
-	res, err := c.ApplyEnvironment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEnvironment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -453,6 +458,55 @@ func (s *EnvironmentServer) ApplyComposerBetaEnvironment(ctx context.Context, re
 	return s.applyEnvironment(ctx, cl, request)
 }
 
+// GetComposerBetaEnvironment handles the gRPC request by passing it to the underlying Environment Get() method.
+func (s *EnvironmentServer) GetComposerBetaEnvironment(ctx context.Context, request *betapb.GetComposerBetaEnvironmentRequest) (*betapb.ComposerBetaEnvironment, error) {
+	cl, err := createConfigEnvironment(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEnvironment(ctx, ProtoToEnvironment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EnvironmentToProto(res), nil
+}
+
+// HasDiffComposerBetaEnvironment handles the gRPC request by passing it to the underlying Environment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EnvironmentServer) HasDiffComposerBetaEnvironment(ctx context.Context, request *betapb.HasDiffComposerBetaEnvironmentRequest) (*betapb.HasDiffComposerBetaEnvironmentResponse, error) {
+	cl, err := createConfigEnvironment(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironment(ctx, ProtoToEnvironment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComposerBetaEnvironmentResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComposerBetaEnvironment handles the gRPC request by passing it to the underlying Environment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EnvironmentServer) PlanComposerBetaEnvironment(ctx context.Context, request *betapb.PlanComposerBetaEnvironmentRequest) (*betapb.PlanComposerBetaEnvironmentResponse, error) {
+	cl, err := createConfigEnvironment(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironment(ctx, ProtoToEnvironment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComposerBetaEnvironmentResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteEnvironment handles the gRPC request by passing it to the underlying Environment Delete() method.
 func (s *EnvironmentServer) DeleteComposerBetaEnvironment(ctx context.Context, request *betapb.DeleteComposerBetaEnvironmentRequest) (*emptypb.Empty, error) {
 
@@ -471,20 +525,32 @@ func (s *EnvironmentServer) ListComposerBetaEnvironment(ctx context.Context, req
 		return nil, err
 	}
 
-	resources, err := cl.ListEnvironment(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.EnvironmentMaxPage
+	}
+	resources, err := cl.ListEnvironmentWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComposerBetaEnvironment
-	for _, r := range resources.Items {
-		rp := EnvironmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EnvironmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComposerBetaEnvironmentResponse{Items: protos}, nil
+	return &betapb.ListComposerBetaEnvironmentResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigEnvironment(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
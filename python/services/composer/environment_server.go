@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	composerpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/composer/composer_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/composer"
@@ -406,7 +407,11 @@ func EnvironmentToProto(resource *composer.Environment) *composerpb.ComposerEnvi
 // ApplyEnvironment handles the gRPC request by passing it to the underlying Environment Apply() method.
 func (s *EnvironmentServer) applyEnvironment(ctx context.Context, c *composer.Client, request *composerpb.ApplyComposerEnvironmentRequest) (*composerpb.ComposerEnvironment, error) {
 	p := ProtoToEnvironment(request.GetResource())
-	res, err := c.ApplyEnvironment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEnvironment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -423,6 +428,55 @@ func (s *EnvironmentServer) ApplyComposerEnvironment(ctx context.Context, reques
 	return s.applyEnvironment(ctx, cl, request)
 }
 
+// GetComposerEnvironment handles the gRPC request by passing it to the underlying Environment Get() method.
+func (s *EnvironmentServer) GetComposerEnvironment(ctx context.Context, request *composerpb.GetComposerEnvironmentRequest) (*composerpb.ComposerEnvironment, error) {
+	cl, err := createConfigEnvironment(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEnvironment(ctx, ProtoToEnvironment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EnvironmentToProto(res), nil
+}
+
+// HasDiffComposerEnvironment handles the gRPC request by passing it to the underlying Environment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EnvironmentServer) HasDiffComposerEnvironment(ctx context.Context, request *composerpb.HasDiffComposerEnvironmentRequest) (*composerpb.HasDiffComposerEnvironmentResponse, error) {
+	cl, err := createConfigEnvironment(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironment(ctx, ProtoToEnvironment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &composerpb.HasDiffComposerEnvironmentResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComposerEnvironment handles the gRPC request by passing it to the underlying Environment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EnvironmentServer) PlanComposerEnvironment(ctx context.Context, request *composerpb.PlanComposerEnvironmentRequest) (*composerpb.PlanComposerEnvironmentResponse, error) {
+	cl, err := createConfigEnvironment(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEnvironment(ctx, ProtoToEnvironment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &composerpb.PlanComposerEnvironmentResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteEnvironment handles the gRPC request by passing it to the underlying Environment Delete() method.
 func (s *EnvironmentServer) DeleteComposerEnvironment(ctx context.Context, request *composerpb.DeleteComposerEnvironmentRequest) (*emptypb.Empty, error) {
 
@@ -441,20 +495,32 @@ func (s *EnvironmentServer) ListComposerEnvironment(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListEnvironment(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = composer.EnvironmentMaxPage
+	}
+	resources, err := cl.ListEnvironmentWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*composerpb.ComposerEnvironment
-	for _, r := range resources.Items {
-		rp := EnvironmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EnvironmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &composerpb.ListComposerEnvironmentResponse{Items: protos}, nil
+	return &composerpb.ListComposerEnvironmentResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigEnvironment(ctx context.Context, service_account_file string) (*composer.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return composer.NewClient(conf), nil
 }
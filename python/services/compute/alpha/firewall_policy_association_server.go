@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -49,7 +50,11 @@ func FirewallPolicyAssociationToProto(resource *alpha.FirewallPolicyAssociation)
 // applyFirewallPolicyAssociation handles the gRPC request by passing it to the underlying FirewallPolicyAssociation Apply() method.
 func (s *FirewallPolicyAssociationServer) applyFirewallPolicyAssociation(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaFirewallPolicyAssociationRequest) (*alphapb.ComputeAlphaFirewallPolicyAssociation, error) {
 	p := ProtoToFirewallPolicyAssociation(request.GetResource())
-	res, err := c.ApplyFirewallPolicyAssociation(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFirewallPolicyAssociation(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +71,60 @@ func (s *FirewallPolicyAssociationServer) ApplyComputeAlphaFirewallPolicyAssocia
 	return s.applyFirewallPolicyAssociation(ctx, cl, request)
 }
 
+// GetComputeAlphaFirewallPolicyAssociation handles the gRPC request by passing it to the underlying FirewallPolicyAssociation Get() method.
+func (s *FirewallPolicyAssociationServer) GetComputeAlphaFirewallPolicyAssociation(ctx context.Context, request *alphapb.GetComputeAlphaFirewallPolicyAssociationRequest) (*alphapb.ComputeAlphaFirewallPolicyAssociation, error) {
+	cl, err := createConfigFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFirewallPolicyAssociation(ctx, ProtoToFirewallPolicyAssociation(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FirewallPolicyAssociationToProto(res), nil
+}
+
+// HasDiffComputeAlphaFirewallPolicyAssociation handles the gRPC request by passing it to the underlying FirewallPolicyAssociation Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FirewallPolicyAssociationServer) HasDiffComputeAlphaFirewallPolicyAssociation(ctx context.Context, request *alphapb.HasDiffComputeAlphaFirewallPolicyAssociationRequest) (*alphapb.HasDiffComputeAlphaFirewallPolicyAssociationResponse, error) {
+	cl, err := createConfigFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicyAssociation(ctx, ProtoToFirewallPolicyAssociation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaFirewallPolicyAssociationResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaFirewallPolicyAssociation handles the gRPC request by passing it to the underlying FirewallPolicyAssociation Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FirewallPolicyAssociationServer) PlanComputeAlphaFirewallPolicyAssociation(ctx context.Context, request *alphapb.PlanComputeAlphaFirewallPolicyAssociationRequest) (*alphapb.PlanComputeAlphaFirewallPolicyAssociationResponse, error) {
+	cl, err := createConfigFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicyAssociation(ctx, ProtoToFirewallPolicyAssociation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaFirewallPolicyAssociationResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFirewallPolicyAssociation handles the gRPC request by passing it to the underlying FirewallPolicyAssociation Delete() method.
 func (s *FirewallPolicyAssociationServer) DeleteComputeAlphaFirewallPolicyAssociation(ctx context.Context, request *alphapb.DeleteComputeAlphaFirewallPolicyAssociationRequest) (*emptypb.Empty, error) {
 
@@ -84,22 +143,35 @@ func (s *FirewallPolicyAssociationServer) ListComputeAlphaFirewallPolicyAssociat
 		return nil, err
 	}
 
-	resources, err := cl.ListFirewallPolicyAssociation(ctx, request.GetFirewallPolicy())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.FirewallPolicyAssociationMaxPage
+	}
+	resources, err := cl.ListFirewallPolicyAssociationWithPageToken(ctx, request.GetFirewallPolicy(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaFirewallPolicyAssociation
-	for _, r := range resources.Items {
-		rp := FirewallPolicyAssociationToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FirewallPolicyAssociationToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaFirewallPolicyAssociationResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFirewallPolicyAssociation(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
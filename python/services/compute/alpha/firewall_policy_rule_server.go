@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -171,7 +172,11 @@ func FirewallPolicyRuleToProto(resource *alpha.FirewallPolicyRule) *alphapb.Comp
 // applyFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Apply() method.
 func (s *FirewallPolicyRuleServer) applyFirewallPolicyRule(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaFirewallPolicyRuleRequest) (*alphapb.ComputeAlphaFirewallPolicyRule, error) {
 	p := ProtoToFirewallPolicyRule(request.GetResource())
-	res, err := c.ApplyFirewallPolicyRule(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFirewallPolicyRule(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +193,60 @@ func (s *FirewallPolicyRuleServer) ApplyComputeAlphaFirewallPolicyRule(ctx conte
 	return s.applyFirewallPolicyRule(ctx, cl, request)
 }
 
+// GetComputeAlphaFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Get() method.
+func (s *FirewallPolicyRuleServer) GetComputeAlphaFirewallPolicyRule(ctx context.Context, request *alphapb.GetComputeAlphaFirewallPolicyRuleRequest) (*alphapb.ComputeAlphaFirewallPolicyRule, error) {
+	cl, err := createConfigFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFirewallPolicyRule(ctx, ProtoToFirewallPolicyRule(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FirewallPolicyRuleToProto(res), nil
+}
+
+// HasDiffComputeAlphaFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FirewallPolicyRuleServer) HasDiffComputeAlphaFirewallPolicyRule(ctx context.Context, request *alphapb.HasDiffComputeAlphaFirewallPolicyRuleRequest) (*alphapb.HasDiffComputeAlphaFirewallPolicyRuleResponse, error) {
+	cl, err := createConfigFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicyRule(ctx, ProtoToFirewallPolicyRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaFirewallPolicyRuleResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FirewallPolicyRuleServer) PlanComputeAlphaFirewallPolicyRule(ctx context.Context, request *alphapb.PlanComputeAlphaFirewallPolicyRuleRequest) (*alphapb.PlanComputeAlphaFirewallPolicyRuleResponse, error) {
+	cl, err := createConfigFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicyRule(ctx, ProtoToFirewallPolicyRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaFirewallPolicyRuleResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Delete() method.
 func (s *FirewallPolicyRuleServer) DeleteComputeAlphaFirewallPolicyRule(ctx context.Context, request *alphapb.DeleteComputeAlphaFirewallPolicyRuleRequest) (*emptypb.Empty, error) {
 
@@ -206,22 +265,35 @@ func (s *FirewallPolicyRuleServer) ListComputeAlphaFirewallPolicyRule(ctx contex
 		return nil, err
 	}
 
-	resources, err := cl.ListFirewallPolicyRule(ctx, request.GetFirewallPolicy())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.FirewallPolicyRuleMaxPage
+	}
+	resources, err := cl.ListFirewallPolicyRuleWithPageToken(ctx, request.GetFirewallPolicy(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaFirewallPolicyRule
-	for _, r := range resources.Items {
-		rp := FirewallPolicyRuleToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FirewallPolicyRuleToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaFirewallPolicyRuleResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFirewallPolicyRule(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
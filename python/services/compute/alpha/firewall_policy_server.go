@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -61,7 +62,11 @@ func FirewallPolicyToProto(resource *alpha.FirewallPolicy) *alphapb.ComputeAlpha
 // applyFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Apply() method.
 func (s *FirewallPolicyServer) applyFirewallPolicy(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaFirewallPolicyRequest) (*alphapb.ComputeAlphaFirewallPolicy, error) {
 	p := ProtoToFirewallPolicy(request.GetResource())
-	res, err := c.ApplyFirewallPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFirewallPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +83,60 @@ func (s *FirewallPolicyServer) ApplyComputeAlphaFirewallPolicy(ctx context.Conte
 	return s.applyFirewallPolicy(ctx, cl, request)
 }
 
+// GetComputeAlphaFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Get() method.
+func (s *FirewallPolicyServer) GetComputeAlphaFirewallPolicy(ctx context.Context, request *alphapb.GetComputeAlphaFirewallPolicyRequest) (*alphapb.ComputeAlphaFirewallPolicy, error) {
+	cl, err := createConfigFirewallPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFirewallPolicy(ctx, ProtoToFirewallPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FirewallPolicyToProto(res), nil
+}
+
+// HasDiffComputeAlphaFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FirewallPolicyServer) HasDiffComputeAlphaFirewallPolicy(ctx context.Context, request *alphapb.HasDiffComputeAlphaFirewallPolicyRequest) (*alphapb.HasDiffComputeAlphaFirewallPolicyResponse, error) {
+	cl, err := createConfigFirewallPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicy(ctx, ProtoToFirewallPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaFirewallPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FirewallPolicyServer) PlanComputeAlphaFirewallPolicy(ctx context.Context, request *alphapb.PlanComputeAlphaFirewallPolicyRequest) (*alphapb.PlanComputeAlphaFirewallPolicyResponse, error) {
+	cl, err := createConfigFirewallPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicy(ctx, ProtoToFirewallPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaFirewallPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Delete() method.
 func (s *FirewallPolicyServer) DeleteComputeAlphaFirewallPolicy(ctx context.Context, request *alphapb.DeleteComputeAlphaFirewallPolicyRequest) (*emptypb.Empty, error) {
 
@@ -96,22 +155,35 @@ func (s *FirewallPolicyServer) ListComputeAlphaFirewallPolicy(ctx context.Contex
 		return nil, err
 	}
 
-	resources, err := cl.ListFirewallPolicy(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.FirewallPolicyMaxPage
+	}
+	resources, err := cl.ListFirewallPolicyWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaFirewallPolicy
-	for _, r := range resources.Items {
-		rp := FirewallPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FirewallPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaFirewallPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFirewallPolicy(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
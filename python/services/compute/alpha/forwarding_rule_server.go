@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -333,7 +334,11 @@ func ForwardingRuleToProto(resource *alpha.ForwardingRule) *alphapb.ComputeAlpha
 // applyForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Apply() method.
 func (s *ForwardingRuleServer) applyForwardingRule(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaForwardingRuleRequest) (*alphapb.ComputeAlphaForwardingRule, error) {
 	p := ProtoToForwardingRule(request.GetResource())
-	res, err := c.ApplyForwardingRule(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyForwardingRule(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -350,6 +355,60 @@ func (s *ForwardingRuleServer) ApplyComputeAlphaForwardingRule(ctx context.Conte
 	return s.applyForwardingRule(ctx, cl, request)
 }
 
+// GetComputeAlphaForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Get() method.
+func (s *ForwardingRuleServer) GetComputeAlphaForwardingRule(ctx context.Context, request *alphapb.GetComputeAlphaForwardingRuleRequest) (*alphapb.ComputeAlphaForwardingRule, error) {
+	cl, err := createConfigForwardingRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetForwardingRule(ctx, ProtoToForwardingRule(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ForwardingRuleToProto(res), nil
+}
+
+// HasDiffComputeAlphaForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ForwardingRuleServer) HasDiffComputeAlphaForwardingRule(ctx context.Context, request *alphapb.HasDiffComputeAlphaForwardingRuleRequest) (*alphapb.HasDiffComputeAlphaForwardingRuleResponse, error) {
+	cl, err := createConfigForwardingRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffForwardingRule(ctx, ProtoToForwardingRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaForwardingRuleResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ForwardingRuleServer) PlanComputeAlphaForwardingRule(ctx context.Context, request *alphapb.PlanComputeAlphaForwardingRuleRequest) (*alphapb.PlanComputeAlphaForwardingRuleResponse, error) {
+	cl, err := createConfigForwardingRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffForwardingRule(ctx, ProtoToForwardingRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaForwardingRuleResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Delete() method.
 func (s *ForwardingRuleServer) DeleteComputeAlphaForwardingRule(ctx context.Context, request *alphapb.DeleteComputeAlphaForwardingRuleRequest) (*emptypb.Empty, error) {
 
@@ -368,22 +427,35 @@ func (s *ForwardingRuleServer) ListComputeAlphaForwardingRule(ctx context.Contex
 		return nil, err
 	}
 
-	resources, err := cl.ListForwardingRule(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ForwardingRuleMaxPage
+	}
+	resources, err := cl.ListForwardingRuleWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaForwardingRule
-	for _, r := range resources.Items {
-		rp := ForwardingRuleToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ForwardingRuleToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaForwardingRuleResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigForwardingRule(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -824,7 +825,11 @@ func InstanceGroupManagerToProto(resource *alpha.InstanceGroupManager) *alphapb.
 // applyInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Apply() method.
 func (s *InstanceGroupManagerServer) applyInstanceGroupManager(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaInstanceGroupManagerRequest) (*alphapb.ComputeAlphaInstanceGroupManager, error) {
 	p := ProtoToInstanceGroupManager(request.GetResource())
-	res, err := c.ApplyInstanceGroupManager(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstanceGroupManager(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -841,6 +846,60 @@ func (s *InstanceGroupManagerServer) ApplyComputeAlphaInstanceGroupManager(ctx c
 	return s.applyInstanceGroupManager(ctx, cl, request)
 }
 
+// GetComputeAlphaInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Get() method.
+func (s *InstanceGroupManagerServer) GetComputeAlphaInstanceGroupManager(ctx context.Context, request *alphapb.GetComputeAlphaInstanceGroupManagerRequest) (*alphapb.ComputeAlphaInstanceGroupManager, error) {
+	cl, err := createConfigInstanceGroupManager(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstanceGroupManager(ctx, ProtoToInstanceGroupManager(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceGroupManagerToProto(res), nil
+}
+
+// HasDiffComputeAlphaInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceGroupManagerServer) HasDiffComputeAlphaInstanceGroupManager(ctx context.Context, request *alphapb.HasDiffComputeAlphaInstanceGroupManagerRequest) (*alphapb.HasDiffComputeAlphaInstanceGroupManagerResponse, error) {
+	cl, err := createConfigInstanceGroupManager(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstanceGroupManager(ctx, ProtoToInstanceGroupManager(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaInstanceGroupManagerResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceGroupManagerServer) PlanComputeAlphaInstanceGroupManager(ctx context.Context, request *alphapb.PlanComputeAlphaInstanceGroupManagerRequest) (*alphapb.PlanComputeAlphaInstanceGroupManagerResponse, error) {
+	cl, err := createConfigInstanceGroupManager(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstanceGroupManager(ctx, ProtoToInstanceGroupManager(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaInstanceGroupManagerResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Delete() method.
 func (s *InstanceGroupManagerServer) DeleteComputeAlphaInstanceGroupManager(ctx context.Context, request *alphapb.DeleteComputeAlphaInstanceGroupManagerRequest) (*emptypb.Empty, error) {
 
@@ -859,22 +918,35 @@ func (s *InstanceGroupManagerServer) ListComputeAlphaInstanceGroupManager(ctx co
 		return nil, err
 	}
 
-	resources, err := cl.ListInstanceGroupManager(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.InstanceGroupManagerMaxPage
+	}
+	resources, err := cl.ListInstanceGroupManagerWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaInstanceGroupManager
-	for _, r := range resources.Items {
-		rp := InstanceGroupManagerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceGroupManagerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaInstanceGroupManagerResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInstanceGroupManager(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -671,7 +672,11 @@ func InstanceToProto(resource *alpha.Instance) *alphapb.ComputeAlphaInstance {
 // applyInstance handles the gRPC request by passing it to the underlying Instance Apply() method.
 func (s *InstanceServer) applyInstance(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaInstanceRequest) (*alphapb.ComputeAlphaInstance, error) {
 	p := ProtoToInstance(request.GetResource())
-	res, err := c.ApplyInstance(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstance(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -688,6 +693,60 @@ func (s *InstanceServer) ApplyComputeAlphaInstance(ctx context.Context, request
 	return s.applyInstance(ctx, cl, request)
 }
 
+// GetComputeAlphaInstance handles the gRPC request by passing it to the underlying Instance Get() method.
+func (s *InstanceServer) GetComputeAlphaInstance(ctx context.Context, request *alphapb.GetComputeAlphaInstanceRequest) (*alphapb.ComputeAlphaInstance, error) {
+	cl, err := createConfigInstance(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstance(ctx, ProtoToInstance(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToProto(res), nil
+}
+
+// HasDiffComputeAlphaInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceServer) HasDiffComputeAlphaInstance(ctx context.Context, request *alphapb.HasDiffComputeAlphaInstanceRequest) (*alphapb.HasDiffComputeAlphaInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaInstanceResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceServer) PlanComputeAlphaInstance(ctx context.Context, request *alphapb.PlanComputeAlphaInstanceRequest) (*alphapb.PlanComputeAlphaInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaInstanceResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInstance handles the gRPC request by passing it to the underlying Instance Delete() method.
 func (s *InstanceServer) DeleteComputeAlphaInstance(ctx context.Context, request *alphapb.DeleteComputeAlphaInstanceRequest) (*emptypb.Empty, error) {
 
@@ -706,22 +765,35 @@ func (s *InstanceServer) ListComputeAlphaInstance(ctx context.Context, request *
 		return nil, err
 	}
 
-	resources, err := cl.ListInstance(ctx, request.GetProject(), request.GetZone())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.InstanceMaxPage
+	}
+	resources, err := cl.ListInstanceWithPageToken(ctx, request.GetProject(), request.GetZone(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaInstance
-	for _, r := range resources.Items {
-		rp := InstanceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaInstanceResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInstance(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -298,7 +299,11 @@ func InterconnectAttachmentToProto(resource *alpha.InterconnectAttachment) *alph
 // applyInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Apply() method.
 func (s *InterconnectAttachmentServer) applyInterconnectAttachment(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaInterconnectAttachmentRequest) (*alphapb.ComputeAlphaInterconnectAttachment, error) {
 	p := ProtoToInterconnectAttachment(request.GetResource())
-	res, err := c.ApplyInterconnectAttachment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInterconnectAttachment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -315,6 +320,60 @@ func (s *InterconnectAttachmentServer) ApplyComputeAlphaInterconnectAttachment(c
 	return s.applyInterconnectAttachment(ctx, cl, request)
 }
 
+// GetComputeAlphaInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Get() method.
+func (s *InterconnectAttachmentServer) GetComputeAlphaInterconnectAttachment(ctx context.Context, request *alphapb.GetComputeAlphaInterconnectAttachmentRequest) (*alphapb.ComputeAlphaInterconnectAttachment, error) {
+	cl, err := createConfigInterconnectAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInterconnectAttachment(ctx, ProtoToInterconnectAttachment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InterconnectAttachmentToProto(res), nil
+}
+
+// HasDiffComputeAlphaInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InterconnectAttachmentServer) HasDiffComputeAlphaInterconnectAttachment(ctx context.Context, request *alphapb.HasDiffComputeAlphaInterconnectAttachmentRequest) (*alphapb.HasDiffComputeAlphaInterconnectAttachmentResponse, error) {
+	cl, err := createConfigInterconnectAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInterconnectAttachment(ctx, ProtoToInterconnectAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaInterconnectAttachmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InterconnectAttachmentServer) PlanComputeAlphaInterconnectAttachment(ctx context.Context, request *alphapb.PlanComputeAlphaInterconnectAttachmentRequest) (*alphapb.PlanComputeAlphaInterconnectAttachmentResponse, error) {
+	cl, err := createConfigInterconnectAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInterconnectAttachment(ctx, ProtoToInterconnectAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaInterconnectAttachmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Delete() method.
 func (s *InterconnectAttachmentServer) DeleteComputeAlphaInterconnectAttachment(ctx context.Context, request *alphapb.DeleteComputeAlphaInterconnectAttachmentRequest) (*emptypb.Empty, error) {
 
@@ -333,22 +392,35 @@ func (s *InterconnectAttachmentServer) ListComputeAlphaInterconnectAttachment(ct
 		return nil, err
 	}
 
-	resources, err := cl.ListInterconnectAttachment(ctx, request.GetProject(), request.GetRegion())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.InterconnectAttachmentMaxPage
+	}
+	resources, err := cl.ListInterconnectAttachmentWithPageToken(ctx, request.GetProject(), request.GetRegion(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaInterconnectAttachment
-	for _, r := range resources.Items {
-		rp := InterconnectAttachmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InterconnectAttachmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaInterconnectAttachmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInterconnectAttachment(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -328,7 +329,11 @@ func InterconnectToProto(resource *alpha.Interconnect) *alphapb.ComputeAlphaInte
 // applyInterconnect handles the gRPC request by passing it to the underlying Interconnect Apply() method.
 func (s *InterconnectServer) applyInterconnect(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaInterconnectRequest) (*alphapb.ComputeAlphaInterconnect, error) {
 	p := ProtoToInterconnect(request.GetResource())
-	res, err := c.ApplyInterconnect(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInterconnect(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -345,6 +350,60 @@ func (s *InterconnectServer) ApplyComputeAlphaInterconnect(ctx context.Context,
 	return s.applyInterconnect(ctx, cl, request)
 }
 
+// GetComputeAlphaInterconnect handles the gRPC request by passing it to the underlying Interconnect Get() method.
+func (s *InterconnectServer) GetComputeAlphaInterconnect(ctx context.Context, request *alphapb.GetComputeAlphaInterconnectRequest) (*alphapb.ComputeAlphaInterconnect, error) {
+	cl, err := createConfigInterconnect(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInterconnect(ctx, ProtoToInterconnect(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InterconnectToProto(res), nil
+}
+
+// HasDiffComputeAlphaInterconnect handles the gRPC request by passing it to the underlying Interconnect Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InterconnectServer) HasDiffComputeAlphaInterconnect(ctx context.Context, request *alphapb.HasDiffComputeAlphaInterconnectRequest) (*alphapb.HasDiffComputeAlphaInterconnectResponse, error) {
+	cl, err := createConfigInterconnect(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInterconnect(ctx, ProtoToInterconnect(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaInterconnectResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaInterconnect handles the gRPC request by passing it to the underlying Interconnect Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InterconnectServer) PlanComputeAlphaInterconnect(ctx context.Context, request *alphapb.PlanComputeAlphaInterconnectRequest) (*alphapb.PlanComputeAlphaInterconnectResponse, error) {
+	cl, err := createConfigInterconnect(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInterconnect(ctx, ProtoToInterconnect(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaInterconnectResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInterconnect handles the gRPC request by passing it to the underlying Interconnect Delete() method.
 func (s *InterconnectServer) DeleteComputeAlphaInterconnect(ctx context.Context, request *alphapb.DeleteComputeAlphaInterconnectRequest) (*emptypb.Empty, error) {
 
@@ -363,22 +422,35 @@ func (s *InterconnectServer) ListComputeAlphaInterconnect(ctx context.Context, r
 		return nil, err
 	}
 
-	resources, err := cl.ListInterconnect(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.InterconnectMaxPage
+	}
+	resources, err := cl.ListInterconnectWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaInterconnect
-	for _, r := range resources.Items {
-		rp := InterconnectToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InterconnectToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaInterconnectResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInterconnect(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -53,7 +54,11 @@ func NetworkFirewallPolicyAssociationToProto(resource *alpha.NetworkFirewallPoli
 // applyNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Apply() method.
 func (s *NetworkFirewallPolicyAssociationServer) applyNetworkFirewallPolicyAssociation(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaNetworkFirewallPolicyAssociationRequest) (*alphapb.ComputeAlphaNetworkFirewallPolicyAssociation, error) {
 	p := ProtoToNetworkFirewallPolicyAssociation(request.GetResource())
-	res, err := c.ApplyNetworkFirewallPolicyAssociation(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNetworkFirewallPolicyAssociation(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +75,60 @@ func (s *NetworkFirewallPolicyAssociationServer) ApplyComputeAlphaNetworkFirewal
 	return s.applyNetworkFirewallPolicyAssociation(ctx, cl, request)
 }
 
+// GetComputeAlphaNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Get() method.
+func (s *NetworkFirewallPolicyAssociationServer) GetComputeAlphaNetworkFirewallPolicyAssociation(ctx context.Context, request *alphapb.GetComputeAlphaNetworkFirewallPolicyAssociationRequest) (*alphapb.ComputeAlphaNetworkFirewallPolicyAssociation, error) {
+	cl, err := createConfigNetworkFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNetworkFirewallPolicyAssociation(ctx, ProtoToNetworkFirewallPolicyAssociation(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NetworkFirewallPolicyAssociationToProto(res), nil
+}
+
+// HasDiffComputeAlphaNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NetworkFirewallPolicyAssociationServer) HasDiffComputeAlphaNetworkFirewallPolicyAssociation(ctx context.Context, request *alphapb.HasDiffComputeAlphaNetworkFirewallPolicyAssociationRequest) (*alphapb.HasDiffComputeAlphaNetworkFirewallPolicyAssociationResponse, error) {
+	cl, err := createConfigNetworkFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkFirewallPolicyAssociation(ctx, ProtoToNetworkFirewallPolicyAssociation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaNetworkFirewallPolicyAssociationResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NetworkFirewallPolicyAssociationServer) PlanComputeAlphaNetworkFirewallPolicyAssociation(ctx context.Context, request *alphapb.PlanComputeAlphaNetworkFirewallPolicyAssociationRequest) (*alphapb.PlanComputeAlphaNetworkFirewallPolicyAssociationResponse, error) {
+	cl, err := createConfigNetworkFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkFirewallPolicyAssociation(ctx, ProtoToNetworkFirewallPolicyAssociation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaNetworkFirewallPolicyAssociationResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Delete() method.
 func (s *NetworkFirewallPolicyAssociationServer) DeleteComputeAlphaNetworkFirewallPolicyAssociation(ctx context.Context, request *alphapb.DeleteComputeAlphaNetworkFirewallPolicyAssociationRequest) (*emptypb.Empty, error) {
 
@@ -88,22 +147,35 @@ func (s *NetworkFirewallPolicyAssociationServer) ListComputeAlphaNetworkFirewall
 		return nil, err
 	}
 
-	resources, err := cl.ListNetworkFirewallPolicyAssociation(ctx, request.GetProject(), request.GetLocation(), request.GetFirewallPolicy())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.NetworkFirewallPolicyAssociationMaxPage
+	}
+	resources, err := cl.ListNetworkFirewallPolicyAssociationWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetFirewallPolicy(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaNetworkFirewallPolicyAssociation
-	for _, r := range resources.Items {
-		rp := NetworkFirewallPolicyAssociationToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NetworkFirewallPolicyAssociationToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaNetworkFirewallPolicyAssociationResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNetworkFirewallPolicyAssociation(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
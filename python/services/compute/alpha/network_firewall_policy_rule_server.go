@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -277,7 +278,11 @@ func NetworkFirewallPolicyRuleToProto(resource *alpha.NetworkFirewallPolicyRule)
 // applyNetworkFirewallPolicyRule handles the gRPC request by passing it to the underlying NetworkFirewallPolicyRule Apply() method.
 func (s *NetworkFirewallPolicyRuleServer) applyNetworkFirewallPolicyRule(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaNetworkFirewallPolicyRuleRequest) (*alphapb.ComputeAlphaNetworkFirewallPolicyRule, error) {
 	p := ProtoToNetworkFirewallPolicyRule(request.GetResource())
-	res, err := c.ApplyNetworkFirewallPolicyRule(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNetworkFirewallPolicyRule(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -294,6 +299,60 @@ func (s *NetworkFirewallPolicyRuleServer) ApplyComputeAlphaNetworkFirewallPolicy
 	return s.applyNetworkFirewallPolicyRule(ctx, cl, request)
 }
 
+// GetComputeAlphaNetworkFirewallPolicyRule handles the gRPC request by passing it to the underlying NetworkFirewallPolicyRule Get() method.
+func (s *NetworkFirewallPolicyRuleServer) GetComputeAlphaNetworkFirewallPolicyRule(ctx context.Context, request *alphapb.GetComputeAlphaNetworkFirewallPolicyRuleRequest) (*alphapb.ComputeAlphaNetworkFirewallPolicyRule, error) {
+	cl, err := createConfigNetworkFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNetworkFirewallPolicyRule(ctx, ProtoToNetworkFirewallPolicyRule(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NetworkFirewallPolicyRuleToProto(res), nil
+}
+
+// HasDiffComputeAlphaNetworkFirewallPolicyRule handles the gRPC request by passing it to the underlying NetworkFirewallPolicyRule Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NetworkFirewallPolicyRuleServer) HasDiffComputeAlphaNetworkFirewallPolicyRule(ctx context.Context, request *alphapb.HasDiffComputeAlphaNetworkFirewallPolicyRuleRequest) (*alphapb.HasDiffComputeAlphaNetworkFirewallPolicyRuleResponse, error) {
+	cl, err := createConfigNetworkFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkFirewallPolicyRule(ctx, ProtoToNetworkFirewallPolicyRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaNetworkFirewallPolicyRuleResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaNetworkFirewallPolicyRule handles the gRPC request by passing it to the underlying NetworkFirewallPolicyRule Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NetworkFirewallPolicyRuleServer) PlanComputeAlphaNetworkFirewallPolicyRule(ctx context.Context, request *alphapb.PlanComputeAlphaNetworkFirewallPolicyRuleRequest) (*alphapb.PlanComputeAlphaNetworkFirewallPolicyRuleResponse, error) {
+	cl, err := createConfigNetworkFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkFirewallPolicyRule(ctx, ProtoToNetworkFirewallPolicyRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaNetworkFirewallPolicyRuleResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNetworkFirewallPolicyRule handles the gRPC request by passing it to the underlying NetworkFirewallPolicyRule Delete() method.
 func (s *NetworkFirewallPolicyRuleServer) DeleteComputeAlphaNetworkFirewallPolicyRule(ctx context.Context, request *alphapb.DeleteComputeAlphaNetworkFirewallPolicyRuleRequest) (*emptypb.Empty, error) {
 
@@ -312,22 +371,35 @@ func (s *NetworkFirewallPolicyRuleServer) ListComputeAlphaNetworkFirewallPolicyR
 		return nil, err
 	}
 
-	resources, err := cl.ListNetworkFirewallPolicyRule(ctx, request.GetProject(), request.GetLocation(), request.GetFirewallPolicy())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.NetworkFirewallPolicyRuleMaxPage
+	}
+	resources, err := cl.ListNetworkFirewallPolicyRuleWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetFirewallPolicy(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaNetworkFirewallPolicyRule
-	for _, r := range resources.Items {
-		rp := NetworkFirewallPolicyRuleToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NetworkFirewallPolicyRuleToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaNetworkFirewallPolicyRuleResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNetworkFirewallPolicyRule(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -103,7 +104,11 @@ func NetworkToProto(resource *alpha.Network) *alphapb.ComputeAlphaNetwork {
 // applyNetwork handles the gRPC request by passing it to the underlying Network Apply() method.
 func (s *NetworkServer) applyNetwork(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaNetworkRequest) (*alphapb.ComputeAlphaNetwork, error) {
 	p := ProtoToNetwork(request.GetResource())
-	res, err := c.ApplyNetwork(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNetwork(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +125,60 @@ func (s *NetworkServer) ApplyComputeAlphaNetwork(ctx context.Context, request *a
 	return s.applyNetwork(ctx, cl, request)
 }
 
+// GetComputeAlphaNetwork handles the gRPC request by passing it to the underlying Network Get() method.
+func (s *NetworkServer) GetComputeAlphaNetwork(ctx context.Context, request *alphapb.GetComputeAlphaNetworkRequest) (*alphapb.ComputeAlphaNetwork, error) {
+	cl, err := createConfigNetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNetwork(ctx, ProtoToNetwork(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NetworkToProto(res), nil
+}
+
+// HasDiffComputeAlphaNetwork handles the gRPC request by passing it to the underlying Network Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NetworkServer) HasDiffComputeAlphaNetwork(ctx context.Context, request *alphapb.HasDiffComputeAlphaNetworkRequest) (*alphapb.HasDiffComputeAlphaNetworkResponse, error) {
+	cl, err := createConfigNetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetwork(ctx, ProtoToNetwork(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaNetworkResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaNetwork handles the gRPC request by passing it to the underlying Network Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NetworkServer) PlanComputeAlphaNetwork(ctx context.Context, request *alphapb.PlanComputeAlphaNetworkRequest) (*alphapb.PlanComputeAlphaNetworkResponse, error) {
+	cl, err := createConfigNetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetwork(ctx, ProtoToNetwork(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaNetworkResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNetwork handles the gRPC request by passing it to the underlying Network Delete() method.
 func (s *NetworkServer) DeleteComputeAlphaNetwork(ctx context.Context, request *alphapb.DeleteComputeAlphaNetworkRequest) (*emptypb.Empty, error) {
 
@@ -138,22 +197,35 @@ func (s *NetworkServer) ListComputeAlphaNetwork(ctx context.Context, request *al
 		return nil, err
 	}
 
-	resources, err := cl.ListNetwork(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.NetworkMaxPage
+	}
+	resources, err := cl.ListNetworkWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaNetwork
-	for _, r := range resources.Items {
-		rp := NetworkToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NetworkToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaNetworkResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNetwork(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -284,7 +285,11 @@ func PacketMirroringToProto(resource *alpha.PacketMirroring) *alphapb.ComputeAlp
 // applyPacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Apply() method.
 func (s *PacketMirroringServer) applyPacketMirroring(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaPacketMirroringRequest) (*alphapb.ComputeAlphaPacketMirroring, error) {
 	p := ProtoToPacketMirroring(request.GetResource())
-	res, err := c.ApplyPacketMirroring(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyPacketMirroring(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -301,6 +306,60 @@ func (s *PacketMirroringServer) ApplyComputeAlphaPacketMirroring(ctx context.Con
 	return s.applyPacketMirroring(ctx, cl, request)
 }
 
+// GetComputeAlphaPacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Get() method.
+func (s *PacketMirroringServer) GetComputeAlphaPacketMirroring(ctx context.Context, request *alphapb.GetComputeAlphaPacketMirroringRequest) (*alphapb.ComputeAlphaPacketMirroring, error) {
+	cl, err := createConfigPacketMirroring(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetPacketMirroring(ctx, ProtoToPacketMirroring(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return PacketMirroringToProto(res), nil
+}
+
+// HasDiffComputeAlphaPacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *PacketMirroringServer) HasDiffComputeAlphaPacketMirroring(ctx context.Context, request *alphapb.HasDiffComputeAlphaPacketMirroringRequest) (*alphapb.HasDiffComputeAlphaPacketMirroringResponse, error) {
+	cl, err := createConfigPacketMirroring(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPacketMirroring(ctx, ProtoToPacketMirroring(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaPacketMirroringResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaPacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *PacketMirroringServer) PlanComputeAlphaPacketMirroring(ctx context.Context, request *alphapb.PlanComputeAlphaPacketMirroringRequest) (*alphapb.PlanComputeAlphaPacketMirroringResponse, error) {
+	cl, err := createConfigPacketMirroring(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPacketMirroring(ctx, ProtoToPacketMirroring(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaPacketMirroringResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeletePacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Delete() method.
 func (s *PacketMirroringServer) DeleteComputeAlphaPacketMirroring(ctx context.Context, request *alphapb.DeleteComputeAlphaPacketMirroringRequest) (*emptypb.Empty, error) {
 
@@ -319,22 +378,35 @@ func (s *PacketMirroringServer) ListComputeAlphaPacketMirroring(ctx context.Cont
 		return nil, err
 	}
 
-	resources, err := cl.ListPacketMirroring(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.PacketMirroringMaxPage
+	}
+	resources, err := cl.ListPacketMirroringWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaPacketMirroring
-	for _, r := range resources.Items {
-		rp := PacketMirroringToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, PacketMirroringToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaPacketMirroringResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigPacketMirroring(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
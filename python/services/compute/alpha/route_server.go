@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -138,7 +139,11 @@ func RouteToProto(resource *alpha.Route) *alphapb.ComputeAlphaRoute {
 // applyRoute handles the gRPC request by passing it to the underlying Route Apply() method.
 func (s *RouteServer) applyRoute(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaRouteRequest) (*alphapb.ComputeAlphaRoute, error) {
 	p := ProtoToRoute(request.GetResource())
-	res, err := c.ApplyRoute(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRoute(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -155,6 +160,60 @@ func (s *RouteServer) ApplyComputeAlphaRoute(ctx context.Context, request *alpha
 	return s.applyRoute(ctx, cl, request)
 }
 
+// GetComputeAlphaRoute handles the gRPC request by passing it to the underlying Route Get() method.
+func (s *RouteServer) GetComputeAlphaRoute(ctx context.Context, request *alphapb.GetComputeAlphaRouteRequest) (*alphapb.ComputeAlphaRoute, error) {
+	cl, err := createConfigRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRoute(ctx, ProtoToRoute(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RouteToProto(res), nil
+}
+
+// HasDiffComputeAlphaRoute handles the gRPC request by passing it to the underlying Route Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RouteServer) HasDiffComputeAlphaRoute(ctx context.Context, request *alphapb.HasDiffComputeAlphaRouteRequest) (*alphapb.HasDiffComputeAlphaRouteResponse, error) {
+	cl, err := createConfigRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRoute(ctx, ProtoToRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaRouteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaRoute handles the gRPC request by passing it to the underlying Route Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RouteServer) PlanComputeAlphaRoute(ctx context.Context, request *alphapb.PlanComputeAlphaRouteRequest) (*alphapb.PlanComputeAlphaRouteResponse, error) {
+	cl, err := createConfigRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRoute(ctx, ProtoToRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaRouteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRoute handles the gRPC request by passing it to the underlying Route Delete() method.
 func (s *RouteServer) DeleteComputeAlphaRoute(ctx context.Context, request *alphapb.DeleteComputeAlphaRouteRequest) (*emptypb.Empty, error) {
 
@@ -173,22 +232,35 @@ func (s *RouteServer) ListComputeAlphaRoute(ctx context.Context, request *alphap
 		return nil, err
 	}
 
-	resources, err := cl.ListRoute(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.RouteMaxPage
+	}
+	resources, err := cl.ListRouteWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaRoute
-	for _, r := range resources.Items {
-		rp := RouteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RouteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaRouteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRoute(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -214,7 +215,11 @@ func ServiceAttachmentToProto(resource *alpha.ServiceAttachment) *alphapb.Comput
 // applyServiceAttachment handles the gRPC request by passing it to the underlying ServiceAttachment Apply() method.
 func (s *ServiceAttachmentServer) applyServiceAttachment(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaServiceAttachmentRequest) (*alphapb.ComputeAlphaServiceAttachment, error) {
 	p := ProtoToServiceAttachment(request.GetResource())
-	res, err := c.ApplyServiceAttachment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyServiceAttachment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +236,60 @@ func (s *ServiceAttachmentServer) ApplyComputeAlphaServiceAttachment(ctx context
 	return s.applyServiceAttachment(ctx, cl, request)
 }
 
+// GetComputeAlphaServiceAttachment handles the gRPC request by passing it to the underlying ServiceAttachment Get() method.
+func (s *ServiceAttachmentServer) GetComputeAlphaServiceAttachment(ctx context.Context, request *alphapb.GetComputeAlphaServiceAttachmentRequest) (*alphapb.ComputeAlphaServiceAttachment, error) {
+	cl, err := createConfigServiceAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetServiceAttachment(ctx, ProtoToServiceAttachment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceAttachmentToProto(res), nil
+}
+
+// HasDiffComputeAlphaServiceAttachment handles the gRPC request by passing it to the underlying ServiceAttachment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceAttachmentServer) HasDiffComputeAlphaServiceAttachment(ctx context.Context, request *alphapb.HasDiffComputeAlphaServiceAttachmentRequest) (*alphapb.HasDiffComputeAlphaServiceAttachmentResponse, error) {
+	cl, err := createConfigServiceAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceAttachment(ctx, ProtoToServiceAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaServiceAttachmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaServiceAttachment handles the gRPC request by passing it to the underlying ServiceAttachment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceAttachmentServer) PlanComputeAlphaServiceAttachment(ctx context.Context, request *alphapb.PlanComputeAlphaServiceAttachmentRequest) (*alphapb.PlanComputeAlphaServiceAttachmentResponse, error) {
+	cl, err := createConfigServiceAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceAttachment(ctx, ProtoToServiceAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaServiceAttachmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteServiceAttachment handles the gRPC request by passing it to the underlying ServiceAttachment Delete() method.
 func (s *ServiceAttachmentServer) DeleteComputeAlphaServiceAttachment(ctx context.Context, request *alphapb.DeleteComputeAlphaServiceAttachmentRequest) (*emptypb.Empty, error) {
 
@@ -249,22 +308,35 @@ func (s *ServiceAttachmentServer) ListComputeAlphaServiceAttachment(ctx context.
 		return nil, err
 	}
 
-	resources, err := cl.ListServiceAttachment(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ServiceAttachmentMaxPage
+	}
+	resources, err := cl.ListServiceAttachmentWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaServiceAttachment
-	for _, r := range resources.Items {
-		rp := ServiceAttachmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceAttachmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaServiceAttachmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigServiceAttachment(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
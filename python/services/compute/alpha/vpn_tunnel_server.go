@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/alpha/compute_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/alpha"
@@ -123,7 +124,11 @@ func VpnTunnelToProto(resource *alpha.VpnTunnel) *alphapb.ComputeAlphaVpnTunnel
 // applyVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Apply() method.
 func (s *VpnTunnelServer) applyVpnTunnel(ctx context.Context, c *alpha.Client, request *alphapb.ApplyComputeAlphaVpnTunnelRequest) (*alphapb.ComputeAlphaVpnTunnel, error) {
 	p := ProtoToVpnTunnel(request.GetResource())
-	res, err := c.ApplyVpnTunnel(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyVpnTunnel(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +145,60 @@ func (s *VpnTunnelServer) ApplyComputeAlphaVpnTunnel(ctx context.Context, reques
 	return s.applyVpnTunnel(ctx, cl, request)
 }
 
+// GetComputeAlphaVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Get() method.
+func (s *VpnTunnelServer) GetComputeAlphaVpnTunnel(ctx context.Context, request *alphapb.GetComputeAlphaVpnTunnelRequest) (*alphapb.ComputeAlphaVpnTunnel, error) {
+	cl, err := createConfigVpnTunnel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetVpnTunnel(ctx, ProtoToVpnTunnel(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return VpnTunnelToProto(res), nil
+}
+
+// HasDiffComputeAlphaVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *VpnTunnelServer) HasDiffComputeAlphaVpnTunnel(ctx context.Context, request *alphapb.HasDiffComputeAlphaVpnTunnelRequest) (*alphapb.HasDiffComputeAlphaVpnTunnelResponse, error) {
+	cl, err := createConfigVpnTunnel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVpnTunnel(ctx, ProtoToVpnTunnel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffComputeAlphaVpnTunnelResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeAlphaVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *VpnTunnelServer) PlanComputeAlphaVpnTunnel(ctx context.Context, request *alphapb.PlanComputeAlphaVpnTunnelRequest) (*alphapb.PlanComputeAlphaVpnTunnelResponse, error) {
+	cl, err := createConfigVpnTunnel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVpnTunnel(ctx, ProtoToVpnTunnel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanComputeAlphaVpnTunnelResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Delete() method.
 func (s *VpnTunnelServer) DeleteComputeAlphaVpnTunnel(ctx context.Context, request *alphapb.DeleteComputeAlphaVpnTunnelRequest) (*emptypb.Empty, error) {
 
@@ -158,22 +217,35 @@ func (s *VpnTunnelServer) ListComputeAlphaVpnTunnel(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListVpnTunnel(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.VpnTunnelMaxPage
+	}
+	resources, err := cl.ListVpnTunnelWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ComputeAlphaVpnTunnel
-	for _, r := range resources.Items {
-		rp := VpnTunnelToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, VpnTunnelToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListComputeAlphaVpnTunnelResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigVpnTunnel(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
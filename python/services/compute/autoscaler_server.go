@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -353,7 +354,11 @@ func AutoscalerToProto(resource *compute.Autoscaler) *computepb.ComputeAutoscale
 // ApplyAutoscaler handles the gRPC request by passing it to the underlying Autoscaler Apply() method.
 func (s *AutoscalerServer) applyAutoscaler(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeAutoscalerRequest) (*computepb.ComputeAutoscaler, error) {
 	p := ProtoToAutoscaler(request.GetResource())
-	res, err := c.ApplyAutoscaler(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAutoscaler(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -370,6 +375,55 @@ func (s *AutoscalerServer) ApplyComputeAutoscaler(ctx context.Context, request *
 	return s.applyAutoscaler(ctx, cl, request)
 }
 
+// GetComputeAutoscaler handles the gRPC request by passing it to the underlying Autoscaler Get() method.
+func (s *AutoscalerServer) GetComputeAutoscaler(ctx context.Context, request *computepb.GetComputeAutoscalerRequest) (*computepb.ComputeAutoscaler, error) {
+	cl, err := createConfigAutoscaler(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAutoscaler(ctx, ProtoToAutoscaler(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AutoscalerToProto(res), nil
+}
+
+// HasDiffComputeAutoscaler handles the gRPC request by passing it to the underlying Autoscaler Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AutoscalerServer) HasDiffComputeAutoscaler(ctx context.Context, request *computepb.HasDiffComputeAutoscalerRequest) (*computepb.HasDiffComputeAutoscalerResponse, error) {
+	cl, err := createConfigAutoscaler(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAutoscaler(ctx, ProtoToAutoscaler(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeAutoscalerResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeAutoscaler handles the gRPC request by passing it to the underlying Autoscaler Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AutoscalerServer) PlanComputeAutoscaler(ctx context.Context, request *computepb.PlanComputeAutoscalerRequest) (*computepb.PlanComputeAutoscalerResponse, error) {
+	cl, err := createConfigAutoscaler(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAutoscaler(ctx, ProtoToAutoscaler(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeAutoscalerResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAutoscaler handles the gRPC request by passing it to the underlying Autoscaler Delete() method.
 func (s *AutoscalerServer) DeleteComputeAutoscaler(ctx context.Context, request *computepb.DeleteComputeAutoscalerRequest) (*emptypb.Empty, error) {
 
@@ -388,20 +442,32 @@ func (s *AutoscalerServer) ListComputeAutoscaler(ctx context.Context, request *c
 		return nil, err
 	}
 
-	resources, err := cl.ListAutoscaler(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.AutoscalerMaxPage
+	}
+	resources, err := cl.ListAutoscalerWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeAutoscaler
-	for _, r := range resources.Items {
-		rp := AutoscalerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AutoscalerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeAutoscalerResponse{Items: protos}, nil
+	return &computepb.ListComputeAutoscalerResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAutoscaler(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
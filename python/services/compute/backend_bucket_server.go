@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -85,7 +86,11 @@ func BackendBucketToProto(resource *compute.BackendBucket) *computepb.ComputeBac
 // ApplyBackendBucket handles the gRPC request by passing it to the underlying BackendBucket Apply() method.
 func (s *BackendBucketServer) applyBackendBucket(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeBackendBucketRequest) (*computepb.ComputeBackendBucket, error) {
 	p := ProtoToBackendBucket(request.GetResource())
-	res, err := c.ApplyBackendBucket(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBackendBucket(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +107,55 @@ func (s *BackendBucketServer) ApplyComputeBackendBucket(ctx context.Context, req
 	return s.applyBackendBucket(ctx, cl, request)
 }
 
+// GetComputeBackendBucket handles the gRPC request by passing it to the underlying BackendBucket Get() method.
+func (s *BackendBucketServer) GetComputeBackendBucket(ctx context.Context, request *computepb.GetComputeBackendBucketRequest) (*computepb.ComputeBackendBucket, error) {
+	cl, err := createConfigBackendBucket(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBackendBucket(ctx, ProtoToBackendBucket(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BackendBucketToProto(res), nil
+}
+
+// HasDiffComputeBackendBucket handles the gRPC request by passing it to the underlying BackendBucket Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BackendBucketServer) HasDiffComputeBackendBucket(ctx context.Context, request *computepb.HasDiffComputeBackendBucketRequest) (*computepb.HasDiffComputeBackendBucketResponse, error) {
+	cl, err := createConfigBackendBucket(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackendBucket(ctx, ProtoToBackendBucket(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeBackendBucketResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBackendBucket handles the gRPC request by passing it to the underlying BackendBucket Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BackendBucketServer) PlanComputeBackendBucket(ctx context.Context, request *computepb.PlanComputeBackendBucketRequest) (*computepb.PlanComputeBackendBucketResponse, error) {
+	cl, err := createConfigBackendBucket(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackendBucket(ctx, ProtoToBackendBucket(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeBackendBucketResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteBackendBucket handles the gRPC request by passing it to the underlying BackendBucket Delete() method.
 func (s *BackendBucketServer) DeleteComputeBackendBucket(ctx context.Context, request *computepb.DeleteComputeBackendBucketRequest) (*emptypb.Empty, error) {
 
@@ -120,20 +174,32 @@ func (s *BackendBucketServer) ListComputeBackendBucket(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListBackendBucket(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.BackendBucketMaxPage
+	}
+	resources, err := cl.ListBackendBucketWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeBackendBucket
-	for _, r := range resources.Items {
-		rp := BackendBucketToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BackendBucketToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeBackendBucketResponse{Items: protos}, nil
+	return &computepb.ListComputeBackendBucketResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigBackendBucket(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -807,7 +808,11 @@ func BackendServiceToProto(resource *compute.BackendService) *computepb.ComputeB
 // ApplyBackendService handles the gRPC request by passing it to the underlying BackendService Apply() method.
 func (s *BackendServiceServer) applyBackendService(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeBackendServiceRequest) (*computepb.ComputeBackendService, error) {
 	p := ProtoToBackendService(request.GetResource())
-	res, err := c.ApplyBackendService(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBackendService(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -824,6 +829,55 @@ func (s *BackendServiceServer) ApplyComputeBackendService(ctx context.Context, r
 	return s.applyBackendService(ctx, cl, request)
 }
 
+// GetComputeBackendService handles the gRPC request by passing it to the underlying BackendService Get() method.
+func (s *BackendServiceServer) GetComputeBackendService(ctx context.Context, request *computepb.GetComputeBackendServiceRequest) (*computepb.ComputeBackendService, error) {
+	cl, err := createConfigBackendService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBackendService(ctx, ProtoToBackendService(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BackendServiceToProto(res), nil
+}
+
+// HasDiffComputeBackendService handles the gRPC request by passing it to the underlying BackendService Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BackendServiceServer) HasDiffComputeBackendService(ctx context.Context, request *computepb.HasDiffComputeBackendServiceRequest) (*computepb.HasDiffComputeBackendServiceResponse, error) {
+	cl, err := createConfigBackendService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackendService(ctx, ProtoToBackendService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeBackendServiceResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBackendService handles the gRPC request by passing it to the underlying BackendService Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BackendServiceServer) PlanComputeBackendService(ctx context.Context, request *computepb.PlanComputeBackendServiceRequest) (*computepb.PlanComputeBackendServiceResponse, error) {
+	cl, err := createConfigBackendService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackendService(ctx, ProtoToBackendService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeBackendServiceResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteBackendService handles the gRPC request by passing it to the underlying BackendService Delete() method.
 func (s *BackendServiceServer) DeleteComputeBackendService(ctx context.Context, request *computepb.DeleteComputeBackendServiceRequest) (*emptypb.Empty, error) {
 
@@ -842,20 +896,32 @@ func (s *BackendServiceServer) ListComputeBackendService(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListBackendService(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.BackendServiceMaxPage
+	}
+	resources, err := cl.ListBackendServiceWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeBackendService
-	for _, r := range resources.Items {
-		rp := BackendServiceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BackendServiceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeBackendServiceResponse{Items: protos}, nil
+	return &computepb.ListComputeBackendServiceResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigBackendService(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
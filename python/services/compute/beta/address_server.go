@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -200,7 +201,11 @@ func AddressToProto(resource *beta.Address) *betapb.ComputeBetaAddress {
 // ApplyAddress handles the gRPC request by passing it to the underlying Address Apply() method.
 func (s *AddressServer) applyAddress(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaAddressRequest) (*betapb.ComputeBetaAddress, error) {
 	p := ProtoToAddress(request.GetResource())
-	res, err := c.ApplyAddress(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAddress(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -217,6 +222,55 @@ func (s *AddressServer) ApplyComputeBetaAddress(ctx context.Context, request *be
 	return s.applyAddress(ctx, cl, request)
 }
 
+// GetComputeBetaAddress handles the gRPC request by passing it to the underlying Address Get() method.
+func (s *AddressServer) GetComputeBetaAddress(ctx context.Context, request *betapb.GetComputeBetaAddressRequest) (*betapb.ComputeBetaAddress, error) {
+	cl, err := createConfigAddress(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAddress(ctx, ProtoToAddress(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AddressToProto(res), nil
+}
+
+// HasDiffComputeBetaAddress handles the gRPC request by passing it to the underlying Address Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AddressServer) HasDiffComputeBetaAddress(ctx context.Context, request *betapb.HasDiffComputeBetaAddressRequest) (*betapb.HasDiffComputeBetaAddressResponse, error) {
+	cl, err := createConfigAddress(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAddress(ctx, ProtoToAddress(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaAddressResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaAddress handles the gRPC request by passing it to the underlying Address Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AddressServer) PlanComputeBetaAddress(ctx context.Context, request *betapb.PlanComputeBetaAddressRequest) (*betapb.PlanComputeBetaAddressResponse, error) {
+	cl, err := createConfigAddress(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAddress(ctx, ProtoToAddress(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaAddressResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAddress handles the gRPC request by passing it to the underlying Address Delete() method.
 func (s *AddressServer) DeleteComputeBetaAddress(ctx context.Context, request *betapb.DeleteComputeBetaAddressRequest) (*emptypb.Empty, error) {
 
@@ -235,20 +289,32 @@ func (s *AddressServer) ListComputeBetaAddress(ctx context.Context, request *bet
 		return nil, err
 	}
 
-	resources, err := cl.ListAddress(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.AddressMaxPage
+	}
+	resources, err := cl.ListAddressWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaAddress
-	for _, r := range resources.Items {
-		rp := AddressToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AddressToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaAddressResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaAddressResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAddress(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -357,7 +358,11 @@ func AutoscalerToProto(resource *beta.Autoscaler) *betapb.ComputeBetaAutoscaler
 // ApplyAutoscaler handles the gRPC request by passing it to the underlying Autoscaler Apply() method.
 func (s *AutoscalerServer) applyAutoscaler(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaAutoscalerRequest) (*betapb.ComputeBetaAutoscaler, error) {
 	p := ProtoToAutoscaler(request.GetResource())
-	res, err := c.ApplyAutoscaler(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAutoscaler(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -374,6 +379,55 @@ func (s *AutoscalerServer) ApplyComputeBetaAutoscaler(ctx context.Context, reque
 	return s.applyAutoscaler(ctx, cl, request)
 }
 
+// GetComputeBetaAutoscaler handles the gRPC request by passing it to the underlying Autoscaler Get() method.
+func (s *AutoscalerServer) GetComputeBetaAutoscaler(ctx context.Context, request *betapb.GetComputeBetaAutoscalerRequest) (*betapb.ComputeBetaAutoscaler, error) {
+	cl, err := createConfigAutoscaler(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAutoscaler(ctx, ProtoToAutoscaler(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AutoscalerToProto(res), nil
+}
+
+// HasDiffComputeBetaAutoscaler handles the gRPC request by passing it to the underlying Autoscaler Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AutoscalerServer) HasDiffComputeBetaAutoscaler(ctx context.Context, request *betapb.HasDiffComputeBetaAutoscalerRequest) (*betapb.HasDiffComputeBetaAutoscalerResponse, error) {
+	cl, err := createConfigAutoscaler(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAutoscaler(ctx, ProtoToAutoscaler(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaAutoscalerResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaAutoscaler handles the gRPC request by passing it to the underlying Autoscaler Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AutoscalerServer) PlanComputeBetaAutoscaler(ctx context.Context, request *betapb.PlanComputeBetaAutoscalerRequest) (*betapb.PlanComputeBetaAutoscalerResponse, error) {
+	cl, err := createConfigAutoscaler(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAutoscaler(ctx, ProtoToAutoscaler(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaAutoscalerResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAutoscaler handles the gRPC request by passing it to the underlying Autoscaler Delete() method.
 func (s *AutoscalerServer) DeleteComputeBetaAutoscaler(ctx context.Context, request *betapb.DeleteComputeBetaAutoscalerRequest) (*emptypb.Empty, error) {
 
@@ -392,20 +446,32 @@ func (s *AutoscalerServer) ListComputeBetaAutoscaler(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListAutoscaler(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.AutoscalerMaxPage
+	}
+	resources, err := cl.ListAutoscalerWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaAutoscaler
-	for _, r := range resources.Items {
-		rp := AutoscalerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AutoscalerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaAutoscalerResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaAutoscalerResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAutoscaler(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -85,7 +86,11 @@ func BackendBucketToProto(resource *beta.BackendBucket) *betapb.ComputeBetaBacke
 // ApplyBackendBucket handles the gRPC request by passing it to the underlying BackendBucket Apply() method.
 func (s *BackendBucketServer) applyBackendBucket(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaBackendBucketRequest) (*betapb.ComputeBetaBackendBucket, error) {
 	p := ProtoToBackendBucket(request.GetResource())
-	res, err := c.ApplyBackendBucket(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBackendBucket(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +107,55 @@ func (s *BackendBucketServer) ApplyComputeBetaBackendBucket(ctx context.Context,
 	return s.applyBackendBucket(ctx, cl, request)
 }
 
+// GetComputeBetaBackendBucket handles the gRPC request by passing it to the underlying BackendBucket Get() method.
+func (s *BackendBucketServer) GetComputeBetaBackendBucket(ctx context.Context, request *betapb.GetComputeBetaBackendBucketRequest) (*betapb.ComputeBetaBackendBucket, error) {
+	cl, err := createConfigBackendBucket(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBackendBucket(ctx, ProtoToBackendBucket(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BackendBucketToProto(res), nil
+}
+
+// HasDiffComputeBetaBackendBucket handles the gRPC request by passing it to the underlying BackendBucket Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BackendBucketServer) HasDiffComputeBetaBackendBucket(ctx context.Context, request *betapb.HasDiffComputeBetaBackendBucketRequest) (*betapb.HasDiffComputeBetaBackendBucketResponse, error) {
+	cl, err := createConfigBackendBucket(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackendBucket(ctx, ProtoToBackendBucket(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaBackendBucketResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaBackendBucket handles the gRPC request by passing it to the underlying BackendBucket Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BackendBucketServer) PlanComputeBetaBackendBucket(ctx context.Context, request *betapb.PlanComputeBetaBackendBucketRequest) (*betapb.PlanComputeBetaBackendBucketResponse, error) {
+	cl, err := createConfigBackendBucket(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackendBucket(ctx, ProtoToBackendBucket(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaBackendBucketResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteBackendBucket handles the gRPC request by passing it to the underlying BackendBucket Delete() method.
 func (s *BackendBucketServer) DeleteComputeBetaBackendBucket(ctx context.Context, request *betapb.DeleteComputeBetaBackendBucketRequest) (*emptypb.Empty, error) {
 
@@ -120,20 +174,32 @@ func (s *BackendBucketServer) ListComputeBetaBackendBucket(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListBackendBucket(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.BackendBucketMaxPage
+	}
+	resources, err := cl.ListBackendBucketWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaBackendBucket
-	for _, r := range resources.Items {
-		rp := BackendBucketToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BackendBucketToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaBackendBucketResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaBackendBucketResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigBackendBucket(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
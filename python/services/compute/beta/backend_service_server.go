@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -954,7 +955,11 @@ func BackendServiceToProto(resource *beta.BackendService) *betapb.ComputeBetaBac
 // ApplyBackendService handles the gRPC request by passing it to the underlying BackendService Apply() method.
 func (s *BackendServiceServer) applyBackendService(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaBackendServiceRequest) (*betapb.ComputeBetaBackendService, error) {
 	p := ProtoToBackendService(request.GetResource())
-	res, err := c.ApplyBackendService(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBackendService(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -971,6 +976,55 @@ func (s *BackendServiceServer) ApplyComputeBetaBackendService(ctx context.Contex
 	return s.applyBackendService(ctx, cl, request)
 }
 
+// GetComputeBetaBackendService handles the gRPC request by passing it to the underlying BackendService Get() method.
+func (s *BackendServiceServer) GetComputeBetaBackendService(ctx context.Context, request *betapb.GetComputeBetaBackendServiceRequest) (*betapb.ComputeBetaBackendService, error) {
+	cl, err := createConfigBackendService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBackendService(ctx, ProtoToBackendService(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BackendServiceToProto(res), nil
+}
+
+// HasDiffComputeBetaBackendService handles the gRPC request by passing it to the underlying BackendService Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BackendServiceServer) HasDiffComputeBetaBackendService(ctx context.Context, request *betapb.HasDiffComputeBetaBackendServiceRequest) (*betapb.HasDiffComputeBetaBackendServiceResponse, error) {
+	cl, err := createConfigBackendService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackendService(ctx, ProtoToBackendService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaBackendServiceResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaBackendService handles the gRPC request by passing it to the underlying BackendService Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BackendServiceServer) PlanComputeBetaBackendService(ctx context.Context, request *betapb.PlanComputeBetaBackendServiceRequest) (*betapb.PlanComputeBetaBackendServiceResponse, error) {
+	cl, err := createConfigBackendService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackendService(ctx, ProtoToBackendService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaBackendServiceResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteBackendService handles the gRPC request by passing it to the underlying BackendService Delete() method.
 func (s *BackendServiceServer) DeleteComputeBetaBackendService(ctx context.Context, request *betapb.DeleteComputeBetaBackendServiceRequest) (*emptypb.Empty, error) {
 
@@ -989,20 +1043,32 @@ func (s *BackendServiceServer) ListComputeBetaBackendService(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListBackendService(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.BackendServiceMaxPage
+	}
+	resources, err := cl.ListBackendServiceWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaBackendService
-	for _, r := range resources.Items {
-		rp := BackendServiceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BackendServiceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaBackendServiceResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaBackendServiceResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigBackendService(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
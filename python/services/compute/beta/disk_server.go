@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -346,7 +347,11 @@ func DiskToProto(resource *beta.Disk) *betapb.ComputeBetaDisk {
 // ApplyDisk handles the gRPC request by passing it to the underlying Disk Apply() method.
 func (s *DiskServer) applyDisk(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaDiskRequest) (*betapb.ComputeBetaDisk, error) {
 	p := ProtoToDisk(request.GetResource())
-	res, err := c.ApplyDisk(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDisk(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -363,6 +368,55 @@ func (s *DiskServer) ApplyComputeBetaDisk(ctx context.Context, request *betapb.A
 	return s.applyDisk(ctx, cl, request)
 }
 
+// GetComputeBetaDisk handles the gRPC request by passing it to the underlying Disk Get() method.
+func (s *DiskServer) GetComputeBetaDisk(ctx context.Context, request *betapb.GetComputeBetaDiskRequest) (*betapb.ComputeBetaDisk, error) {
+	cl, err := createConfigDisk(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDisk(ctx, ProtoToDisk(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DiskToProto(res), nil
+}
+
+// HasDiffComputeBetaDisk handles the gRPC request by passing it to the underlying Disk Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DiskServer) HasDiffComputeBetaDisk(ctx context.Context, request *betapb.HasDiffComputeBetaDiskRequest) (*betapb.HasDiffComputeBetaDiskResponse, error) {
+	cl, err := createConfigDisk(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDisk(ctx, ProtoToDisk(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaDiskResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaDisk handles the gRPC request by passing it to the underlying Disk Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DiskServer) PlanComputeBetaDisk(ctx context.Context, request *betapb.PlanComputeBetaDiskRequest) (*betapb.PlanComputeBetaDiskResponse, error) {
+	cl, err := createConfigDisk(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDisk(ctx, ProtoToDisk(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaDiskResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteDisk handles the gRPC request by passing it to the underlying Disk Delete() method.
 func (s *DiskServer) DeleteComputeBetaDisk(ctx context.Context, request *betapb.DeleteComputeBetaDiskRequest) (*emptypb.Empty, error) {
 
@@ -381,20 +435,32 @@ func (s *DiskServer) ListComputeBetaDisk(ctx context.Context, request *betapb.Li
 		return nil, err
 	}
 
-	resources, err := cl.ListDisk(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.DiskMaxPage
+	}
+	resources, err := cl.ListDiskWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaDisk
-	for _, r := range resources.Items {
-		rp := DiskToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DiskToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaDiskResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaDiskResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigDisk(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -49,7 +50,11 @@ func FirewallPolicyAssociationToProto(resource *beta.FirewallPolicyAssociation)
 // applyFirewallPolicyAssociation handles the gRPC request by passing it to the underlying FirewallPolicyAssociation Apply() method.
 func (s *FirewallPolicyAssociationServer) applyFirewallPolicyAssociation(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaFirewallPolicyAssociationRequest) (*betapb.ComputeBetaFirewallPolicyAssociation, error) {
 	p := ProtoToFirewallPolicyAssociation(request.GetResource())
-	res, err := c.ApplyFirewallPolicyAssociation(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFirewallPolicyAssociation(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +71,60 @@ func (s *FirewallPolicyAssociationServer) ApplyComputeBetaFirewallPolicyAssociat
 	return s.applyFirewallPolicyAssociation(ctx, cl, request)
 }
 
+// GetComputeBetaFirewallPolicyAssociation handles the gRPC request by passing it to the underlying FirewallPolicyAssociation Get() method.
+func (s *FirewallPolicyAssociationServer) GetComputeBetaFirewallPolicyAssociation(ctx context.Context, request *betapb.GetComputeBetaFirewallPolicyAssociationRequest) (*betapb.ComputeBetaFirewallPolicyAssociation, error) {
+	cl, err := createConfigFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFirewallPolicyAssociation(ctx, ProtoToFirewallPolicyAssociation(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FirewallPolicyAssociationToProto(res), nil
+}
+
+// HasDiffComputeBetaFirewallPolicyAssociation handles the gRPC request by passing it to the underlying FirewallPolicyAssociation Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FirewallPolicyAssociationServer) HasDiffComputeBetaFirewallPolicyAssociation(ctx context.Context, request *betapb.HasDiffComputeBetaFirewallPolicyAssociationRequest) (*betapb.HasDiffComputeBetaFirewallPolicyAssociationResponse, error) {
+	cl, err := createConfigFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicyAssociation(ctx, ProtoToFirewallPolicyAssociation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaFirewallPolicyAssociationResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaFirewallPolicyAssociation handles the gRPC request by passing it to the underlying FirewallPolicyAssociation Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FirewallPolicyAssociationServer) PlanComputeBetaFirewallPolicyAssociation(ctx context.Context, request *betapb.PlanComputeBetaFirewallPolicyAssociationRequest) (*betapb.PlanComputeBetaFirewallPolicyAssociationResponse, error) {
+	cl, err := createConfigFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicyAssociation(ctx, ProtoToFirewallPolicyAssociation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaFirewallPolicyAssociationResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFirewallPolicyAssociation handles the gRPC request by passing it to the underlying FirewallPolicyAssociation Delete() method.
 func (s *FirewallPolicyAssociationServer) DeleteComputeBetaFirewallPolicyAssociation(ctx context.Context, request *betapb.DeleteComputeBetaFirewallPolicyAssociationRequest) (*emptypb.Empty, error) {
 
@@ -84,22 +143,35 @@ func (s *FirewallPolicyAssociationServer) ListComputeBetaFirewallPolicyAssociati
 		return nil, err
 	}
 
-	resources, err := cl.ListFirewallPolicyAssociation(ctx, request.GetFirewallPolicy())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.FirewallPolicyAssociationMaxPage
+	}
+	resources, err := cl.ListFirewallPolicyAssociationWithPageToken(ctx, request.GetFirewallPolicy(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaFirewallPolicyAssociation
-	for _, r := range resources.Items {
-		rp := FirewallPolicyAssociationToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FirewallPolicyAssociationToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaFirewallPolicyAssociationResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFirewallPolicyAssociation(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
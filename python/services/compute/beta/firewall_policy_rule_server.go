@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -171,7 +172,11 @@ func FirewallPolicyRuleToProto(resource *beta.FirewallPolicyRule) *betapb.Comput
 // applyFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Apply() method.
 func (s *FirewallPolicyRuleServer) applyFirewallPolicyRule(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaFirewallPolicyRuleRequest) (*betapb.ComputeBetaFirewallPolicyRule, error) {
 	p := ProtoToFirewallPolicyRule(request.GetResource())
-	res, err := c.ApplyFirewallPolicyRule(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFirewallPolicyRule(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +193,60 @@ func (s *FirewallPolicyRuleServer) ApplyComputeBetaFirewallPolicyRule(ctx contex
 	return s.applyFirewallPolicyRule(ctx, cl, request)
 }
 
+// GetComputeBetaFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Get() method.
+func (s *FirewallPolicyRuleServer) GetComputeBetaFirewallPolicyRule(ctx context.Context, request *betapb.GetComputeBetaFirewallPolicyRuleRequest) (*betapb.ComputeBetaFirewallPolicyRule, error) {
+	cl, err := createConfigFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFirewallPolicyRule(ctx, ProtoToFirewallPolicyRule(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FirewallPolicyRuleToProto(res), nil
+}
+
+// HasDiffComputeBetaFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FirewallPolicyRuleServer) HasDiffComputeBetaFirewallPolicyRule(ctx context.Context, request *betapb.HasDiffComputeBetaFirewallPolicyRuleRequest) (*betapb.HasDiffComputeBetaFirewallPolicyRuleResponse, error) {
+	cl, err := createConfigFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicyRule(ctx, ProtoToFirewallPolicyRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaFirewallPolicyRuleResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FirewallPolicyRuleServer) PlanComputeBetaFirewallPolicyRule(ctx context.Context, request *betapb.PlanComputeBetaFirewallPolicyRuleRequest) (*betapb.PlanComputeBetaFirewallPolicyRuleResponse, error) {
+	cl, err := createConfigFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicyRule(ctx, ProtoToFirewallPolicyRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaFirewallPolicyRuleResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Delete() method.
 func (s *FirewallPolicyRuleServer) DeleteComputeBetaFirewallPolicyRule(ctx context.Context, request *betapb.DeleteComputeBetaFirewallPolicyRuleRequest) (*emptypb.Empty, error) {
 
@@ -206,22 +265,35 @@ func (s *FirewallPolicyRuleServer) ListComputeBetaFirewallPolicyRule(ctx context
 		return nil, err
 	}
 
-	resources, err := cl.ListFirewallPolicyRule(ctx, request.GetFirewallPolicy())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.FirewallPolicyRuleMaxPage
+	}
+	resources, err := cl.ListFirewallPolicyRuleWithPageToken(ctx, request.GetFirewallPolicy(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaFirewallPolicyRule
-	for _, r := range resources.Items {
-		rp := FirewallPolicyRuleToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FirewallPolicyRuleToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaFirewallPolicyRuleResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFirewallPolicyRule(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
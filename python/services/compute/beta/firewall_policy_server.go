@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -61,7 +62,11 @@ func FirewallPolicyToProto(resource *beta.FirewallPolicy) *betapb.ComputeBetaFir
 // applyFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Apply() method.
 func (s *FirewallPolicyServer) applyFirewallPolicy(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaFirewallPolicyRequest) (*betapb.ComputeBetaFirewallPolicy, error) {
 	p := ProtoToFirewallPolicy(request.GetResource())
-	res, err := c.ApplyFirewallPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFirewallPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +83,60 @@ func (s *FirewallPolicyServer) ApplyComputeBetaFirewallPolicy(ctx context.Contex
 	return s.applyFirewallPolicy(ctx, cl, request)
 }
 
+// GetComputeBetaFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Get() method.
+func (s *FirewallPolicyServer) GetComputeBetaFirewallPolicy(ctx context.Context, request *betapb.GetComputeBetaFirewallPolicyRequest) (*betapb.ComputeBetaFirewallPolicy, error) {
+	cl, err := createConfigFirewallPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFirewallPolicy(ctx, ProtoToFirewallPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FirewallPolicyToProto(res), nil
+}
+
+// HasDiffComputeBetaFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FirewallPolicyServer) HasDiffComputeBetaFirewallPolicy(ctx context.Context, request *betapb.HasDiffComputeBetaFirewallPolicyRequest) (*betapb.HasDiffComputeBetaFirewallPolicyResponse, error) {
+	cl, err := createConfigFirewallPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicy(ctx, ProtoToFirewallPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaFirewallPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FirewallPolicyServer) PlanComputeBetaFirewallPolicy(ctx context.Context, request *betapb.PlanComputeBetaFirewallPolicyRequest) (*betapb.PlanComputeBetaFirewallPolicyResponse, error) {
+	cl, err := createConfigFirewallPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicy(ctx, ProtoToFirewallPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaFirewallPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Delete() method.
 func (s *FirewallPolicyServer) DeleteComputeBetaFirewallPolicy(ctx context.Context, request *betapb.DeleteComputeBetaFirewallPolicyRequest) (*emptypb.Empty, error) {
 
@@ -96,22 +155,35 @@ func (s *FirewallPolicyServer) ListComputeBetaFirewallPolicy(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListFirewallPolicy(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.FirewallPolicyMaxPage
+	}
+	resources, err := cl.ListFirewallPolicyWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaFirewallPolicy
-	for _, r := range resources.Items {
-		rp := FirewallPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FirewallPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaFirewallPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFirewallPolicy(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
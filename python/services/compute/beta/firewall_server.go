@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -226,7 +227,11 @@ func FirewallToProto(resource *beta.Firewall) *betapb.ComputeBetaFirewall {
 // ApplyFirewall handles the gRPC request by passing it to the underlying Firewall Apply() method.
 func (s *FirewallServer) applyFirewall(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaFirewallRequest) (*betapb.ComputeBetaFirewall, error) {
 	p := ProtoToFirewall(request.GetResource())
-	res, err := c.ApplyFirewall(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFirewall(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -243,6 +248,55 @@ func (s *FirewallServer) ApplyComputeBetaFirewall(ctx context.Context, request *
 	return s.applyFirewall(ctx, cl, request)
 }
 
+// GetComputeBetaFirewall handles the gRPC request by passing it to the underlying Firewall Get() method.
+func (s *FirewallServer) GetComputeBetaFirewall(ctx context.Context, request *betapb.GetComputeBetaFirewallRequest) (*betapb.ComputeBetaFirewall, error) {
+	cl, err := createConfigFirewall(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFirewall(ctx, ProtoToFirewall(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FirewallToProto(res), nil
+}
+
+// HasDiffComputeBetaFirewall handles the gRPC request by passing it to the underlying Firewall Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FirewallServer) HasDiffComputeBetaFirewall(ctx context.Context, request *betapb.HasDiffComputeBetaFirewallRequest) (*betapb.HasDiffComputeBetaFirewallResponse, error) {
+	cl, err := createConfigFirewall(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewall(ctx, ProtoToFirewall(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaFirewallResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaFirewall handles the gRPC request by passing it to the underlying Firewall Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FirewallServer) PlanComputeBetaFirewall(ctx context.Context, request *betapb.PlanComputeBetaFirewallRequest) (*betapb.PlanComputeBetaFirewallResponse, error) {
+	cl, err := createConfigFirewall(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewall(ctx, ProtoToFirewall(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaFirewallResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteFirewall handles the gRPC request by passing it to the underlying Firewall Delete() method.
 func (s *FirewallServer) DeleteComputeBetaFirewall(ctx context.Context, request *betapb.DeleteComputeBetaFirewallRequest) (*emptypb.Empty, error) {
 
@@ -261,20 +315,32 @@ func (s *FirewallServer) ListComputeBetaFirewall(ctx context.Context, request *b
 		return nil, err
 	}
 
-	resources, err := cl.ListFirewall(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.FirewallMaxPage
+	}
+	resources, err := cl.ListFirewallWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaFirewall
-	for _, r := range resources.Items {
-		rp := FirewallToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FirewallToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaFirewallResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaFirewallResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigFirewall(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
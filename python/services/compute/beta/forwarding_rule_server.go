@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -333,7 +334,11 @@ func ForwardingRuleToProto(resource *beta.ForwardingRule) *betapb.ComputeBetaFor
 // applyForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Apply() method.
 func (s *ForwardingRuleServer) applyForwardingRule(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaForwardingRuleRequest) (*betapb.ComputeBetaForwardingRule, error) {
 	p := ProtoToForwardingRule(request.GetResource())
-	res, err := c.ApplyForwardingRule(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyForwardingRule(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -350,6 +355,60 @@ func (s *ForwardingRuleServer) ApplyComputeBetaForwardingRule(ctx context.Contex
 	return s.applyForwardingRule(ctx, cl, request)
 }
 
+// GetComputeBetaForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Get() method.
+func (s *ForwardingRuleServer) GetComputeBetaForwardingRule(ctx context.Context, request *betapb.GetComputeBetaForwardingRuleRequest) (*betapb.ComputeBetaForwardingRule, error) {
+	cl, err := createConfigForwardingRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetForwardingRule(ctx, ProtoToForwardingRule(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ForwardingRuleToProto(res), nil
+}
+
+// HasDiffComputeBetaForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ForwardingRuleServer) HasDiffComputeBetaForwardingRule(ctx context.Context, request *betapb.HasDiffComputeBetaForwardingRuleRequest) (*betapb.HasDiffComputeBetaForwardingRuleResponse, error) {
+	cl, err := createConfigForwardingRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffForwardingRule(ctx, ProtoToForwardingRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaForwardingRuleResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ForwardingRuleServer) PlanComputeBetaForwardingRule(ctx context.Context, request *betapb.PlanComputeBetaForwardingRuleRequest) (*betapb.PlanComputeBetaForwardingRuleResponse, error) {
+	cl, err := createConfigForwardingRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffForwardingRule(ctx, ProtoToForwardingRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaForwardingRuleResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Delete() method.
 func (s *ForwardingRuleServer) DeleteComputeBetaForwardingRule(ctx context.Context, request *betapb.DeleteComputeBetaForwardingRuleRequest) (*emptypb.Empty, error) {
 
@@ -368,22 +427,35 @@ func (s *ForwardingRuleServer) ListComputeBetaForwardingRule(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListForwardingRule(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ForwardingRuleMaxPage
+	}
+	resources, err := cl.ListForwardingRuleWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaForwardingRule
-	for _, r := range resources.Items {
-		rp := ForwardingRuleToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ForwardingRuleToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaForwardingRuleResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigForwardingRule(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -494,7 +495,11 @@ func HealthCheckToProto(resource *beta.HealthCheck) *betapb.ComputeBetaHealthChe
 // ApplyHealthCheck handles the gRPC request by passing it to the underlying HealthCheck Apply() method.
 func (s *HealthCheckServer) applyHealthCheck(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaHealthCheckRequest) (*betapb.ComputeBetaHealthCheck, error) {
 	p := ProtoToHealthCheck(request.GetResource())
-	res, err := c.ApplyHealthCheck(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyHealthCheck(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -511,6 +516,55 @@ func (s *HealthCheckServer) ApplyComputeBetaHealthCheck(ctx context.Context, req
 	return s.applyHealthCheck(ctx, cl, request)
 }
 
+// GetComputeBetaHealthCheck handles the gRPC request by passing it to the underlying HealthCheck Get() method.
+func (s *HealthCheckServer) GetComputeBetaHealthCheck(ctx context.Context, request *betapb.GetComputeBetaHealthCheckRequest) (*betapb.ComputeBetaHealthCheck, error) {
+	cl, err := createConfigHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetHealthCheck(ctx, ProtoToHealthCheck(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return HealthCheckToProto(res), nil
+}
+
+// HasDiffComputeBetaHealthCheck handles the gRPC request by passing it to the underlying HealthCheck Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *HealthCheckServer) HasDiffComputeBetaHealthCheck(ctx context.Context, request *betapb.HasDiffComputeBetaHealthCheckRequest) (*betapb.HasDiffComputeBetaHealthCheckResponse, error) {
+	cl, err := createConfigHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHealthCheck(ctx, ProtoToHealthCheck(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaHealthCheckResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaHealthCheck handles the gRPC request by passing it to the underlying HealthCheck Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *HealthCheckServer) PlanComputeBetaHealthCheck(ctx context.Context, request *betapb.PlanComputeBetaHealthCheckRequest) (*betapb.PlanComputeBetaHealthCheckResponse, error) {
+	cl, err := createConfigHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHealthCheck(ctx, ProtoToHealthCheck(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaHealthCheckResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteHealthCheck handles the gRPC request by passing it to the underlying HealthCheck Delete() method.
 func (s *HealthCheckServer) DeleteComputeBetaHealthCheck(ctx context.Context, request *betapb.DeleteComputeBetaHealthCheckRequest) (*emptypb.Empty, error) {
 
@@ -529,20 +583,32 @@ func (s *HealthCheckServer) ListComputeBetaHealthCheck(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListHealthCheck(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.HealthCheckMaxPage
+	}
+	resources, err := cl.ListHealthCheckWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaHealthCheck
-	for _, r := range resources.Items {
-		rp := HealthCheckToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, HealthCheckToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaHealthCheckResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaHealthCheckResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigHealthCheck(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
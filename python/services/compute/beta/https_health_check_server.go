@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -67,7 +68,11 @@ func HttpsHealthCheckToProto(resource *beta.HttpsHealthCheck) *betapb.ComputeBet
 // ApplyHttpsHealthCheck handles the gRPC request by passing it to the underlying HttpsHealthCheck Apply() method.
 func (s *HttpsHealthCheckServer) applyHttpsHealthCheck(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaHttpsHealthCheckRequest) (*betapb.ComputeBetaHttpsHealthCheck, error) {
 	p := ProtoToHttpsHealthCheck(request.GetResource())
-	res, err := c.ApplyHttpsHealthCheck(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyHttpsHealthCheck(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +89,55 @@ func (s *HttpsHealthCheckServer) ApplyComputeBetaHttpsHealthCheck(ctx context.Co
 	return s.applyHttpsHealthCheck(ctx, cl, request)
 }
 
+// GetComputeBetaHttpsHealthCheck handles the gRPC request by passing it to the underlying HttpsHealthCheck Get() method.
+func (s *HttpsHealthCheckServer) GetComputeBetaHttpsHealthCheck(ctx context.Context, request *betapb.GetComputeBetaHttpsHealthCheckRequest) (*betapb.ComputeBetaHttpsHealthCheck, error) {
+	cl, err := createConfigHttpsHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetHttpsHealthCheck(ctx, ProtoToHttpsHealthCheck(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return HttpsHealthCheckToProto(res), nil
+}
+
+// HasDiffComputeBetaHttpsHealthCheck handles the gRPC request by passing it to the underlying HttpsHealthCheck Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *HttpsHealthCheckServer) HasDiffComputeBetaHttpsHealthCheck(ctx context.Context, request *betapb.HasDiffComputeBetaHttpsHealthCheckRequest) (*betapb.HasDiffComputeBetaHttpsHealthCheckResponse, error) {
+	cl, err := createConfigHttpsHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHttpsHealthCheck(ctx, ProtoToHttpsHealthCheck(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaHttpsHealthCheckResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaHttpsHealthCheck handles the gRPC request by passing it to the underlying HttpsHealthCheck Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *HttpsHealthCheckServer) PlanComputeBetaHttpsHealthCheck(ctx context.Context, request *betapb.PlanComputeBetaHttpsHealthCheckRequest) (*betapb.PlanComputeBetaHttpsHealthCheckResponse, error) {
+	cl, err := createConfigHttpsHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHttpsHealthCheck(ctx, ProtoToHttpsHealthCheck(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaHttpsHealthCheckResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteHttpsHealthCheck handles the gRPC request by passing it to the underlying HttpsHealthCheck Delete() method.
 func (s *HttpsHealthCheckServer) DeleteComputeBetaHttpsHealthCheck(ctx context.Context, request *betapb.DeleteComputeBetaHttpsHealthCheckRequest) (*emptypb.Empty, error) {
 
@@ -102,20 +156,32 @@ func (s *HttpsHealthCheckServer) ListComputeBetaHttpsHealthCheck(ctx context.Con
 		return nil, err
 	}
 
-	resources, err := cl.ListHttpsHealthCheck(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.HttpsHealthCheckMaxPage
+	}
+	resources, err := cl.ListHttpsHealthCheckWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaHttpsHealthCheck
-	for _, r := range resources.Items {
-		rp := HttpsHealthCheckToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, HttpsHealthCheckToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaHttpsHealthCheckResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaHttpsHealthCheckResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigHttpsHealthCheck(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -638,7 +639,11 @@ func ImageToProto(resource *beta.Image) *betapb.ComputeBetaImage {
 // ApplyImage handles the gRPC request by passing it to the underlying Image Apply() method.
 func (s *ImageServer) applyImage(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaImageRequest) (*betapb.ComputeBetaImage, error) {
 	p := ProtoToImage(request.GetResource())
-	res, err := c.ApplyImage(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyImage(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -655,6 +660,55 @@ func (s *ImageServer) ApplyComputeBetaImage(ctx context.Context, request *betapb
 	return s.applyImage(ctx, cl, request)
 }
 
+// GetComputeBetaImage handles the gRPC request by passing it to the underlying Image Get() method.
+func (s *ImageServer) GetComputeBetaImage(ctx context.Context, request *betapb.GetComputeBetaImageRequest) (*betapb.ComputeBetaImage, error) {
+	cl, err := createConfigImage(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetImage(ctx, ProtoToImage(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ImageToProto(res), nil
+}
+
+// HasDiffComputeBetaImage handles the gRPC request by passing it to the underlying Image Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ImageServer) HasDiffComputeBetaImage(ctx context.Context, request *betapb.HasDiffComputeBetaImageRequest) (*betapb.HasDiffComputeBetaImageResponse, error) {
+	cl, err := createConfigImage(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffImage(ctx, ProtoToImage(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaImageResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaImage handles the gRPC request by passing it to the underlying Image Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ImageServer) PlanComputeBetaImage(ctx context.Context, request *betapb.PlanComputeBetaImageRequest) (*betapb.PlanComputeBetaImageResponse, error) {
+	cl, err := createConfigImage(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffImage(ctx, ProtoToImage(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaImageResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteImage handles the gRPC request by passing it to the underlying Image Delete() method.
 func (s *ImageServer) DeleteComputeBetaImage(ctx context.Context, request *betapb.DeleteComputeBetaImageRequest) (*emptypb.Empty, error) {
 
@@ -673,20 +727,32 @@ func (s *ImageServer) ListComputeBetaImage(ctx context.Context, request *betapb.
 		return nil, err
 	}
 
-	resources, err := cl.ListImage(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.ImageMaxPage
+	}
+	resources, err := cl.ListImageWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaImage
-	for _, r := range resources.Items {
-		rp := ImageToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ImageToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaImageResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaImageResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigImage(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -824,7 +825,11 @@ func InstanceGroupManagerToProto(resource *beta.InstanceGroupManager) *betapb.Co
 // applyInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Apply() method.
 func (s *InstanceGroupManagerServer) applyInstanceGroupManager(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaInstanceGroupManagerRequest) (*betapb.ComputeBetaInstanceGroupManager, error) {
 	p := ProtoToInstanceGroupManager(request.GetResource())
-	res, err := c.ApplyInstanceGroupManager(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstanceGroupManager(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -841,6 +846,60 @@ func (s *InstanceGroupManagerServer) ApplyComputeBetaInstanceGroupManager(ctx co
 	return s.applyInstanceGroupManager(ctx, cl, request)
 }
 
+// GetComputeBetaInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Get() method.
+func (s *InstanceGroupManagerServer) GetComputeBetaInstanceGroupManager(ctx context.Context, request *betapb.GetComputeBetaInstanceGroupManagerRequest) (*betapb.ComputeBetaInstanceGroupManager, error) {
+	cl, err := createConfigInstanceGroupManager(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstanceGroupManager(ctx, ProtoToInstanceGroupManager(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceGroupManagerToProto(res), nil
+}
+
+// HasDiffComputeBetaInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceGroupManagerServer) HasDiffComputeBetaInstanceGroupManager(ctx context.Context, request *betapb.HasDiffComputeBetaInstanceGroupManagerRequest) (*betapb.HasDiffComputeBetaInstanceGroupManagerResponse, error) {
+	cl, err := createConfigInstanceGroupManager(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstanceGroupManager(ctx, ProtoToInstanceGroupManager(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaInstanceGroupManagerResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceGroupManagerServer) PlanComputeBetaInstanceGroupManager(ctx context.Context, request *betapb.PlanComputeBetaInstanceGroupManagerRequest) (*betapb.PlanComputeBetaInstanceGroupManagerResponse, error) {
+	cl, err := createConfigInstanceGroupManager(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstanceGroupManager(ctx, ProtoToInstanceGroupManager(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaInstanceGroupManagerResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Delete() method.
 func (s *InstanceGroupManagerServer) DeleteComputeBetaInstanceGroupManager(ctx context.Context, request *betapb.DeleteComputeBetaInstanceGroupManagerRequest) (*emptypb.Empty, error) {
 
@@ -859,22 +918,35 @@ func (s *InstanceGroupManagerServer) ListComputeBetaInstanceGroupManager(ctx con
 		return nil, err
 	}
 
-	resources, err := cl.ListInstanceGroupManager(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.InstanceGroupManagerMaxPage
+	}
+	resources, err := cl.ListInstanceGroupManagerWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaInstanceGroupManager
-	for _, r := range resources.Items {
-		rp := InstanceGroupManagerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceGroupManagerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaInstanceGroupManagerResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInstanceGroupManager(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -671,7 +672,11 @@ func InstanceToProto(resource *beta.Instance) *betapb.ComputeBetaInstance {
 // applyInstance handles the gRPC request by passing it to the underlying Instance Apply() method.
 func (s *InstanceServer) applyInstance(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaInstanceRequest) (*betapb.ComputeBetaInstance, error) {
 	p := ProtoToInstance(request.GetResource())
-	res, err := c.ApplyInstance(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstance(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -688,6 +693,60 @@ func (s *InstanceServer) ApplyComputeBetaInstance(ctx context.Context, request *
 	return s.applyInstance(ctx, cl, request)
 }
 
+// GetComputeBetaInstance handles the gRPC request by passing it to the underlying Instance Get() method.
+func (s *InstanceServer) GetComputeBetaInstance(ctx context.Context, request *betapb.GetComputeBetaInstanceRequest) (*betapb.ComputeBetaInstance, error) {
+	cl, err := createConfigInstance(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstance(ctx, ProtoToInstance(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToProto(res), nil
+}
+
+// HasDiffComputeBetaInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceServer) HasDiffComputeBetaInstance(ctx context.Context, request *betapb.HasDiffComputeBetaInstanceRequest) (*betapb.HasDiffComputeBetaInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaInstanceResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceServer) PlanComputeBetaInstance(ctx context.Context, request *betapb.PlanComputeBetaInstanceRequest) (*betapb.PlanComputeBetaInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaInstanceResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInstance handles the gRPC request by passing it to the underlying Instance Delete() method.
 func (s *InstanceServer) DeleteComputeBetaInstance(ctx context.Context, request *betapb.DeleteComputeBetaInstanceRequest) (*emptypb.Empty, error) {
 
@@ -706,22 +765,35 @@ func (s *InstanceServer) ListComputeBetaInstance(ctx context.Context, request *b
 		return nil, err
 	}
 
-	resources, err := cl.ListInstance(ctx, request.GetProject(), request.GetZone())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.InstanceMaxPage
+	}
+	resources, err := cl.ListInstanceWithPageToken(ctx, request.GetProject(), request.GetZone(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaInstance
-	for _, r := range resources.Items {
-		rp := InstanceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaInstanceResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInstance(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
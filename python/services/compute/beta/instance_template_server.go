@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -725,7 +726,11 @@ func InstanceTemplateToProto(resource *beta.InstanceTemplate) *betapb.ComputeBet
 // ApplyInstanceTemplate handles the gRPC request by passing it to the underlying InstanceTemplate Apply() method.
 func (s *InstanceTemplateServer) applyInstanceTemplate(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaInstanceTemplateRequest) (*betapb.ComputeBetaInstanceTemplate, error) {
 	p := ProtoToInstanceTemplate(request.GetResource())
-	res, err := c.ApplyInstanceTemplate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstanceTemplate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -742,6 +747,55 @@ func (s *InstanceTemplateServer) ApplyComputeBetaInstanceTemplate(ctx context.Co
 	return s.applyInstanceTemplate(ctx, cl, request)
 }
 
+// GetComputeBetaInstanceTemplate handles the gRPC request by passing it to the underlying InstanceTemplate Get() method.
+func (s *InstanceTemplateServer) GetComputeBetaInstanceTemplate(ctx context.Context, request *betapb.GetComputeBetaInstanceTemplateRequest) (*betapb.ComputeBetaInstanceTemplate, error) {
+	cl, err := createConfigInstanceTemplate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstanceTemplate(ctx, ProtoToInstanceTemplate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceTemplateToProto(res), nil
+}
+
+// HasDiffComputeBetaInstanceTemplate handles the gRPC request by passing it to the underlying InstanceTemplate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceTemplateServer) HasDiffComputeBetaInstanceTemplate(ctx context.Context, request *betapb.HasDiffComputeBetaInstanceTemplateRequest) (*betapb.HasDiffComputeBetaInstanceTemplateResponse, error) {
+	cl, err := createConfigInstanceTemplate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstanceTemplate(ctx, ProtoToInstanceTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaInstanceTemplateResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaInstanceTemplate handles the gRPC request by passing it to the underlying InstanceTemplate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceTemplateServer) PlanComputeBetaInstanceTemplate(ctx context.Context, request *betapb.PlanComputeBetaInstanceTemplateRequest) (*betapb.PlanComputeBetaInstanceTemplateResponse, error) {
+	cl, err := createConfigInstanceTemplate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstanceTemplate(ctx, ProtoToInstanceTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaInstanceTemplateResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteInstanceTemplate handles the gRPC request by passing it to the underlying InstanceTemplate Delete() method.
 func (s *InstanceTemplateServer) DeleteComputeBetaInstanceTemplate(ctx context.Context, request *betapb.DeleteComputeBetaInstanceTemplateRequest) (*emptypb.Empty, error) {
 
@@ -760,20 +814,32 @@ func (s *InstanceTemplateServer) ListComputeBetaInstanceTemplate(ctx context.Con
 		return nil, err
 	}
 
-	resources, err := cl.ListInstanceTemplate(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.InstanceTemplateMaxPage
+	}
+	resources, err := cl.ListInstanceTemplateWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaInstanceTemplate
-	for _, r := range resources.Items {
-		rp := InstanceTemplateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceTemplateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaInstanceTemplateResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaInstanceTemplateResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigInstanceTemplate(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -298,7 +299,11 @@ func InterconnectAttachmentToProto(resource *beta.InterconnectAttachment) *betap
 // applyInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Apply() method.
 func (s *InterconnectAttachmentServer) applyInterconnectAttachment(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaInterconnectAttachmentRequest) (*betapb.ComputeBetaInterconnectAttachment, error) {
 	p := ProtoToInterconnectAttachment(request.GetResource())
-	res, err := c.ApplyInterconnectAttachment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInterconnectAttachment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -315,6 +320,60 @@ func (s *InterconnectAttachmentServer) ApplyComputeBetaInterconnectAttachment(ct
 	return s.applyInterconnectAttachment(ctx, cl, request)
 }
 
+// GetComputeBetaInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Get() method.
+func (s *InterconnectAttachmentServer) GetComputeBetaInterconnectAttachment(ctx context.Context, request *betapb.GetComputeBetaInterconnectAttachmentRequest) (*betapb.ComputeBetaInterconnectAttachment, error) {
+	cl, err := createConfigInterconnectAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInterconnectAttachment(ctx, ProtoToInterconnectAttachment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InterconnectAttachmentToProto(res), nil
+}
+
+// HasDiffComputeBetaInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InterconnectAttachmentServer) HasDiffComputeBetaInterconnectAttachment(ctx context.Context, request *betapb.HasDiffComputeBetaInterconnectAttachmentRequest) (*betapb.HasDiffComputeBetaInterconnectAttachmentResponse, error) {
+	cl, err := createConfigInterconnectAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInterconnectAttachment(ctx, ProtoToInterconnectAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaInterconnectAttachmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InterconnectAttachmentServer) PlanComputeBetaInterconnectAttachment(ctx context.Context, request *betapb.PlanComputeBetaInterconnectAttachmentRequest) (*betapb.PlanComputeBetaInterconnectAttachmentResponse, error) {
+	cl, err := createConfigInterconnectAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInterconnectAttachment(ctx, ProtoToInterconnectAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaInterconnectAttachmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Delete() method.
 func (s *InterconnectAttachmentServer) DeleteComputeBetaInterconnectAttachment(ctx context.Context, request *betapb.DeleteComputeBetaInterconnectAttachmentRequest) (*emptypb.Empty, error) {
 
@@ -333,22 +392,35 @@ func (s *InterconnectAttachmentServer) ListComputeBetaInterconnectAttachment(ctx
 		return nil, err
 	}
 
-	resources, err := cl.ListInterconnectAttachment(ctx, request.GetProject(), request.GetRegion())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.InterconnectAttachmentMaxPage
+	}
+	resources, err := cl.ListInterconnectAttachmentWithPageToken(ctx, request.GetProject(), request.GetRegion(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaInterconnectAttachment
-	for _, r := range resources.Items {
-		rp := InterconnectAttachmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InterconnectAttachmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaInterconnectAttachmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInterconnectAttachment(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
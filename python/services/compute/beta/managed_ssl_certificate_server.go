@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -168,7 +169,11 @@ func ManagedSslCertificateToProto(resource *beta.ManagedSslCertificate) *betapb.
 // ApplyManagedSslCertificate handles the gRPC request by passing it to the underlying ManagedSslCertificate Apply() method.
 func (s *ManagedSslCertificateServer) applyManagedSslCertificate(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaManagedSslCertificateRequest) (*betapb.ComputeBetaManagedSslCertificate, error) {
 	p := ProtoToManagedSslCertificate(request.GetResource())
-	res, err := c.ApplyManagedSslCertificate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyManagedSslCertificate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +190,55 @@ func (s *ManagedSslCertificateServer) ApplyComputeBetaManagedSslCertificate(ctx
 	return s.applyManagedSslCertificate(ctx, cl, request)
 }
 
+// GetComputeBetaManagedSslCertificate handles the gRPC request by passing it to the underlying ManagedSslCertificate Get() method.
+func (s *ManagedSslCertificateServer) GetComputeBetaManagedSslCertificate(ctx context.Context, request *betapb.GetComputeBetaManagedSslCertificateRequest) (*betapb.ComputeBetaManagedSslCertificate, error) {
+	cl, err := createConfigManagedSslCertificate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetManagedSslCertificate(ctx, ProtoToManagedSslCertificate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ManagedSslCertificateToProto(res), nil
+}
+
+// HasDiffComputeBetaManagedSslCertificate handles the gRPC request by passing it to the underlying ManagedSslCertificate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ManagedSslCertificateServer) HasDiffComputeBetaManagedSslCertificate(ctx context.Context, request *betapb.HasDiffComputeBetaManagedSslCertificateRequest) (*betapb.HasDiffComputeBetaManagedSslCertificateResponse, error) {
+	cl, err := createConfigManagedSslCertificate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffManagedSslCertificate(ctx, ProtoToManagedSslCertificate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaManagedSslCertificateResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaManagedSslCertificate handles the gRPC request by passing it to the underlying ManagedSslCertificate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ManagedSslCertificateServer) PlanComputeBetaManagedSslCertificate(ctx context.Context, request *betapb.PlanComputeBetaManagedSslCertificateRequest) (*betapb.PlanComputeBetaManagedSslCertificateResponse, error) {
+	cl, err := createConfigManagedSslCertificate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffManagedSslCertificate(ctx, ProtoToManagedSslCertificate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaManagedSslCertificateResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteManagedSslCertificate handles the gRPC request by passing it to the underlying ManagedSslCertificate Delete() method.
 func (s *ManagedSslCertificateServer) DeleteComputeBetaManagedSslCertificate(ctx context.Context, request *betapb.DeleteComputeBetaManagedSslCertificateRequest) (*emptypb.Empty, error) {
 
@@ -203,20 +257,32 @@ func (s *ManagedSslCertificateServer) ListComputeBetaManagedSslCertificate(ctx c
 		return nil, err
 	}
 
-	resources, err := cl.ListManagedSslCertificate(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.ManagedSslCertificateMaxPage
+	}
+	resources, err := cl.ListManagedSslCertificateWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaManagedSslCertificate
-	for _, r := range resources.Items {
-		rp := ManagedSslCertificateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ManagedSslCertificateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaManagedSslCertificateResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaManagedSslCertificateResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigManagedSslCertificate(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
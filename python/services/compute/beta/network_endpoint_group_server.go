@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -172,7 +173,11 @@ func NetworkEndpointGroupToProto(resource *beta.NetworkEndpointGroup) *betapb.Co
 // ApplyNetworkEndpointGroup handles the gRPC request by passing it to the underlying NetworkEndpointGroup Apply() method.
 func (s *NetworkEndpointGroupServer) applyNetworkEndpointGroup(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaNetworkEndpointGroupRequest) (*betapb.ComputeBetaNetworkEndpointGroup, error) {
 	p := ProtoToNetworkEndpointGroup(request.GetResource())
-	res, err := c.ApplyNetworkEndpointGroup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNetworkEndpointGroup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +194,55 @@ func (s *NetworkEndpointGroupServer) ApplyComputeBetaNetworkEndpointGroup(ctx co
 	return s.applyNetworkEndpointGroup(ctx, cl, request)
 }
 
+// GetComputeBetaNetworkEndpointGroup handles the gRPC request by passing it to the underlying NetworkEndpointGroup Get() method.
+func (s *NetworkEndpointGroupServer) GetComputeBetaNetworkEndpointGroup(ctx context.Context, request *betapb.GetComputeBetaNetworkEndpointGroupRequest) (*betapb.ComputeBetaNetworkEndpointGroup, error) {
+	cl, err := createConfigNetworkEndpointGroup(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNetworkEndpointGroup(ctx, ProtoToNetworkEndpointGroup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NetworkEndpointGroupToProto(res), nil
+}
+
+// HasDiffComputeBetaNetworkEndpointGroup handles the gRPC request by passing it to the underlying NetworkEndpointGroup Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NetworkEndpointGroupServer) HasDiffComputeBetaNetworkEndpointGroup(ctx context.Context, request *betapb.HasDiffComputeBetaNetworkEndpointGroupRequest) (*betapb.HasDiffComputeBetaNetworkEndpointGroupResponse, error) {
+	cl, err := createConfigNetworkEndpointGroup(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkEndpointGroup(ctx, ProtoToNetworkEndpointGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaNetworkEndpointGroupResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaNetworkEndpointGroup handles the gRPC request by passing it to the underlying NetworkEndpointGroup Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NetworkEndpointGroupServer) PlanComputeBetaNetworkEndpointGroup(ctx context.Context, request *betapb.PlanComputeBetaNetworkEndpointGroupRequest) (*betapb.PlanComputeBetaNetworkEndpointGroupResponse, error) {
+	cl, err := createConfigNetworkEndpointGroup(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkEndpointGroup(ctx, ProtoToNetworkEndpointGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaNetworkEndpointGroupResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteNetworkEndpointGroup handles the gRPC request by passing it to the underlying NetworkEndpointGroup Delete() method.
 func (s *NetworkEndpointGroupServer) DeleteComputeBetaNetworkEndpointGroup(ctx context.Context, request *betapb.DeleteComputeBetaNetworkEndpointGroupRequest) (*emptypb.Empty, error) {
 
@@ -207,20 +261,32 @@ func (s *NetworkEndpointGroupServer) ListComputeBetaNetworkEndpointGroup(ctx con
 		return nil, err
 	}
 
-	resources, err := cl.ListNetworkEndpointGroup(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.NetworkEndpointGroupMaxPage
+	}
+	resources, err := cl.ListNetworkEndpointGroupWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaNetworkEndpointGroup
-	for _, r := range resources.Items {
-		rp := NetworkEndpointGroupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NetworkEndpointGroupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaNetworkEndpointGroupResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaNetworkEndpointGroupResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigNetworkEndpointGroup(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -57,7 +58,11 @@ func NetworkEndpointToProto(resource *beta.NetworkEndpoint) *betapb.ComputeBetaN
 // ApplyNetworkEndpoint handles the gRPC request by passing it to the underlying NetworkEndpoint Apply() method.
 func (s *NetworkEndpointServer) applyNetworkEndpoint(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaNetworkEndpointRequest) (*betapb.ComputeBetaNetworkEndpoint, error) {
 	p := ProtoToNetworkEndpoint(request.GetResource())
-	res, err := c.ApplyNetworkEndpoint(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNetworkEndpoint(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +79,55 @@ func (s *NetworkEndpointServer) ApplyComputeBetaNetworkEndpoint(ctx context.Cont
 	return s.applyNetworkEndpoint(ctx, cl, request)
 }
 
+// GetComputeBetaNetworkEndpoint handles the gRPC request by passing it to the underlying NetworkEndpoint Get() method.
+func (s *NetworkEndpointServer) GetComputeBetaNetworkEndpoint(ctx context.Context, request *betapb.GetComputeBetaNetworkEndpointRequest) (*betapb.ComputeBetaNetworkEndpoint, error) {
+	cl, err := createConfigNetworkEndpoint(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNetworkEndpoint(ctx, ProtoToNetworkEndpoint(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NetworkEndpointToProto(res), nil
+}
+
+// HasDiffComputeBetaNetworkEndpoint handles the gRPC request by passing it to the underlying NetworkEndpoint Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NetworkEndpointServer) HasDiffComputeBetaNetworkEndpoint(ctx context.Context, request *betapb.HasDiffComputeBetaNetworkEndpointRequest) (*betapb.HasDiffComputeBetaNetworkEndpointResponse, error) {
+	cl, err := createConfigNetworkEndpoint(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkEndpoint(ctx, ProtoToNetworkEndpoint(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaNetworkEndpointResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaNetworkEndpoint handles the gRPC request by passing it to the underlying NetworkEndpoint Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NetworkEndpointServer) PlanComputeBetaNetworkEndpoint(ctx context.Context, request *betapb.PlanComputeBetaNetworkEndpointRequest) (*betapb.PlanComputeBetaNetworkEndpointResponse, error) {
+	cl, err := createConfigNetworkEndpoint(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkEndpoint(ctx, ProtoToNetworkEndpoint(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaNetworkEndpointResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteNetworkEndpoint handles the gRPC request by passing it to the underlying NetworkEndpoint Delete() method.
 func (s *NetworkEndpointServer) DeleteComputeBetaNetworkEndpoint(ctx context.Context, request *betapb.DeleteComputeBetaNetworkEndpointRequest) (*emptypb.Empty, error) {
 
@@ -92,20 +146,32 @@ func (s *NetworkEndpointServer) ListComputeBetaNetworkEndpoint(ctx context.Conte
 		return nil, err
 	}
 
-	resources, err := cl.ListNetworkEndpoint(ctx, request.Project, request.Location, request.Group)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.NetworkEndpointMaxPage
+	}
+	resources, err := cl.ListNetworkEndpointWithPageToken(ctx, request.Project, request.Location, request.Group, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaNetworkEndpoint
-	for _, r := range resources.Items {
-		rp := NetworkEndpointToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NetworkEndpointToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaNetworkEndpointResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaNetworkEndpointResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigNetworkEndpoint(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -53,7 +54,11 @@ func NetworkFirewallPolicyAssociationToProto(resource *beta.NetworkFirewallPolic
 // applyNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Apply() method.
 func (s *NetworkFirewallPolicyAssociationServer) applyNetworkFirewallPolicyAssociation(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaNetworkFirewallPolicyAssociationRequest) (*betapb.ComputeBetaNetworkFirewallPolicyAssociation, error) {
 	p := ProtoToNetworkFirewallPolicyAssociation(request.GetResource())
-	res, err := c.ApplyNetworkFirewallPolicyAssociation(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNetworkFirewallPolicyAssociation(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +75,60 @@ func (s *NetworkFirewallPolicyAssociationServer) ApplyComputeBetaNetworkFirewall
 	return s.applyNetworkFirewallPolicyAssociation(ctx, cl, request)
 }
 
+// GetComputeBetaNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Get() method.
+func (s *NetworkFirewallPolicyAssociationServer) GetComputeBetaNetworkFirewallPolicyAssociation(ctx context.Context, request *betapb.GetComputeBetaNetworkFirewallPolicyAssociationRequest) (*betapb.ComputeBetaNetworkFirewallPolicyAssociation, error) {
+	cl, err := createConfigNetworkFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNetworkFirewallPolicyAssociation(ctx, ProtoToNetworkFirewallPolicyAssociation(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NetworkFirewallPolicyAssociationToProto(res), nil
+}
+
+// HasDiffComputeBetaNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NetworkFirewallPolicyAssociationServer) HasDiffComputeBetaNetworkFirewallPolicyAssociation(ctx context.Context, request *betapb.HasDiffComputeBetaNetworkFirewallPolicyAssociationRequest) (*betapb.HasDiffComputeBetaNetworkFirewallPolicyAssociationResponse, error) {
+	cl, err := createConfigNetworkFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkFirewallPolicyAssociation(ctx, ProtoToNetworkFirewallPolicyAssociation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaNetworkFirewallPolicyAssociationResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NetworkFirewallPolicyAssociationServer) PlanComputeBetaNetworkFirewallPolicyAssociation(ctx context.Context, request *betapb.PlanComputeBetaNetworkFirewallPolicyAssociationRequest) (*betapb.PlanComputeBetaNetworkFirewallPolicyAssociationResponse, error) {
+	cl, err := createConfigNetworkFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkFirewallPolicyAssociation(ctx, ProtoToNetworkFirewallPolicyAssociation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaNetworkFirewallPolicyAssociationResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Delete() method.
 func (s *NetworkFirewallPolicyAssociationServer) DeleteComputeBetaNetworkFirewallPolicyAssociation(ctx context.Context, request *betapb.DeleteComputeBetaNetworkFirewallPolicyAssociationRequest) (*emptypb.Empty, error) {
 
@@ -88,22 +147,35 @@ func (s *NetworkFirewallPolicyAssociationServer) ListComputeBetaNetworkFirewallP
 		return nil, err
 	}
 
-	resources, err := cl.ListNetworkFirewallPolicyAssociation(ctx, request.GetProject(), request.GetLocation(), request.GetFirewallPolicy())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.NetworkFirewallPolicyAssociationMaxPage
+	}
+	resources, err := cl.ListNetworkFirewallPolicyAssociationWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetFirewallPolicy(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaNetworkFirewallPolicyAssociation
-	for _, r := range resources.Items {
-		rp := NetworkFirewallPolicyAssociationToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NetworkFirewallPolicyAssociationToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaNetworkFirewallPolicyAssociationResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNetworkFirewallPolicyAssociation(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -277,7 +278,11 @@ func NetworkFirewallPolicyRuleToProto(resource *beta.NetworkFirewallPolicyRule)
 // applyNetworkFirewallPolicyRule handles the gRPC request by passing it to the underlying NetworkFirewallPolicyRule Apply() method.
 func (s *NetworkFirewallPolicyRuleServer) applyNetworkFirewallPolicyRule(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaNetworkFirewallPolicyRuleRequest) (*betapb.ComputeBetaNetworkFirewallPolicyRule, error) {
 	p := ProtoToNetworkFirewallPolicyRule(request.GetResource())
-	res, err := c.ApplyNetworkFirewallPolicyRule(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNetworkFirewallPolicyRule(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -294,6 +299,60 @@ func (s *NetworkFirewallPolicyRuleServer) ApplyComputeBetaNetworkFirewallPolicyR
 	return s.applyNetworkFirewallPolicyRule(ctx, cl, request)
 }
 
+// GetComputeBetaNetworkFirewallPolicyRule handles the gRPC request by passing it to the underlying NetworkFirewallPolicyRule Get() method.
+func (s *NetworkFirewallPolicyRuleServer) GetComputeBetaNetworkFirewallPolicyRule(ctx context.Context, request *betapb.GetComputeBetaNetworkFirewallPolicyRuleRequest) (*betapb.ComputeBetaNetworkFirewallPolicyRule, error) {
+	cl, err := createConfigNetworkFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNetworkFirewallPolicyRule(ctx, ProtoToNetworkFirewallPolicyRule(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NetworkFirewallPolicyRuleToProto(res), nil
+}
+
+// HasDiffComputeBetaNetworkFirewallPolicyRule handles the gRPC request by passing it to the underlying NetworkFirewallPolicyRule Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NetworkFirewallPolicyRuleServer) HasDiffComputeBetaNetworkFirewallPolicyRule(ctx context.Context, request *betapb.HasDiffComputeBetaNetworkFirewallPolicyRuleRequest) (*betapb.HasDiffComputeBetaNetworkFirewallPolicyRuleResponse, error) {
+	cl, err := createConfigNetworkFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkFirewallPolicyRule(ctx, ProtoToNetworkFirewallPolicyRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaNetworkFirewallPolicyRuleResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaNetworkFirewallPolicyRule handles the gRPC request by passing it to the underlying NetworkFirewallPolicyRule Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NetworkFirewallPolicyRuleServer) PlanComputeBetaNetworkFirewallPolicyRule(ctx context.Context, request *betapb.PlanComputeBetaNetworkFirewallPolicyRuleRequest) (*betapb.PlanComputeBetaNetworkFirewallPolicyRuleResponse, error) {
+	cl, err := createConfigNetworkFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkFirewallPolicyRule(ctx, ProtoToNetworkFirewallPolicyRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaNetworkFirewallPolicyRuleResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNetworkFirewallPolicyRule handles the gRPC request by passing it to the underlying NetworkFirewallPolicyRule Delete() method.
 func (s *NetworkFirewallPolicyRuleServer) DeleteComputeBetaNetworkFirewallPolicyRule(ctx context.Context, request *betapb.DeleteComputeBetaNetworkFirewallPolicyRuleRequest) (*emptypb.Empty, error) {
 
@@ -312,22 +371,35 @@ func (s *NetworkFirewallPolicyRuleServer) ListComputeBetaNetworkFirewallPolicyRu
 		return nil, err
 	}
 
-	resources, err := cl.ListNetworkFirewallPolicyRule(ctx, request.GetProject(), request.GetLocation(), request.GetFirewallPolicy())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.NetworkFirewallPolicyRuleMaxPage
+	}
+	resources, err := cl.ListNetworkFirewallPolicyRuleWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetFirewallPolicy(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaNetworkFirewallPolicyRule
-	for _, r := range resources.Items {
-		rp := NetworkFirewallPolicyRuleToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NetworkFirewallPolicyRuleToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaNetworkFirewallPolicyRuleResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNetworkFirewallPolicyRule(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -103,7 +104,11 @@ func NetworkToProto(resource *beta.Network) *betapb.ComputeBetaNetwork {
 // applyNetwork handles the gRPC request by passing it to the underlying Network Apply() method.
 func (s *NetworkServer) applyNetwork(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaNetworkRequest) (*betapb.ComputeBetaNetwork, error) {
 	p := ProtoToNetwork(request.GetResource())
-	res, err := c.ApplyNetwork(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNetwork(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +125,60 @@ func (s *NetworkServer) ApplyComputeBetaNetwork(ctx context.Context, request *be
 	return s.applyNetwork(ctx, cl, request)
 }
 
+// GetComputeBetaNetwork handles the gRPC request by passing it to the underlying Network Get() method.
+func (s *NetworkServer) GetComputeBetaNetwork(ctx context.Context, request *betapb.GetComputeBetaNetworkRequest) (*betapb.ComputeBetaNetwork, error) {
+	cl, err := createConfigNetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNetwork(ctx, ProtoToNetwork(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NetworkToProto(res), nil
+}
+
+// HasDiffComputeBetaNetwork handles the gRPC request by passing it to the underlying Network Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NetworkServer) HasDiffComputeBetaNetwork(ctx context.Context, request *betapb.HasDiffComputeBetaNetworkRequest) (*betapb.HasDiffComputeBetaNetworkResponse, error) {
+	cl, err := createConfigNetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetwork(ctx, ProtoToNetwork(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaNetworkResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaNetwork handles the gRPC request by passing it to the underlying Network Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NetworkServer) PlanComputeBetaNetwork(ctx context.Context, request *betapb.PlanComputeBetaNetworkRequest) (*betapb.PlanComputeBetaNetworkResponse, error) {
+	cl, err := createConfigNetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetwork(ctx, ProtoToNetwork(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaNetworkResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNetwork handles the gRPC request by passing it to the underlying Network Delete() method.
 func (s *NetworkServer) DeleteComputeBetaNetwork(ctx context.Context, request *betapb.DeleteComputeBetaNetworkRequest) (*emptypb.Empty, error) {
 
@@ -138,22 +197,35 @@ func (s *NetworkServer) ListComputeBetaNetwork(ctx context.Context, request *bet
 		return nil, err
 	}
 
-	resources, err := cl.ListNetwork(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.NetworkMaxPage
+	}
+	resources, err := cl.ListNetworkWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaNetwork
-	for _, r := range resources.Items {
-		rp := NetworkToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NetworkToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaNetworkResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNetwork(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -284,7 +285,11 @@ func PacketMirroringToProto(resource *beta.PacketMirroring) *betapb.ComputeBetaP
 // applyPacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Apply() method.
 func (s *PacketMirroringServer) applyPacketMirroring(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaPacketMirroringRequest) (*betapb.ComputeBetaPacketMirroring, error) {
 	p := ProtoToPacketMirroring(request.GetResource())
-	res, err := c.ApplyPacketMirroring(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyPacketMirroring(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -301,6 +306,60 @@ func (s *PacketMirroringServer) ApplyComputeBetaPacketMirroring(ctx context.Cont
 	return s.applyPacketMirroring(ctx, cl, request)
 }
 
+// GetComputeBetaPacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Get() method.
+func (s *PacketMirroringServer) GetComputeBetaPacketMirroring(ctx context.Context, request *betapb.GetComputeBetaPacketMirroringRequest) (*betapb.ComputeBetaPacketMirroring, error) {
+	cl, err := createConfigPacketMirroring(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetPacketMirroring(ctx, ProtoToPacketMirroring(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return PacketMirroringToProto(res), nil
+}
+
+// HasDiffComputeBetaPacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *PacketMirroringServer) HasDiffComputeBetaPacketMirroring(ctx context.Context, request *betapb.HasDiffComputeBetaPacketMirroringRequest) (*betapb.HasDiffComputeBetaPacketMirroringResponse, error) {
+	cl, err := createConfigPacketMirroring(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPacketMirroring(ctx, ProtoToPacketMirroring(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaPacketMirroringResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaPacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *PacketMirroringServer) PlanComputeBetaPacketMirroring(ctx context.Context, request *betapb.PlanComputeBetaPacketMirroringRequest) (*betapb.PlanComputeBetaPacketMirroringResponse, error) {
+	cl, err := createConfigPacketMirroring(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPacketMirroring(ctx, ProtoToPacketMirroring(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaPacketMirroringResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeletePacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Delete() method.
 func (s *PacketMirroringServer) DeleteComputeBetaPacketMirroring(ctx context.Context, request *betapb.DeleteComputeBetaPacketMirroringRequest) (*emptypb.Empty, error) {
 
@@ -319,22 +378,35 @@ func (s *PacketMirroringServer) ListComputeBetaPacketMirroring(ctx context.Conte
 		return nil, err
 	}
 
-	resources, err := cl.ListPacketMirroring(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.PacketMirroringMaxPage
+	}
+	resources, err := cl.ListPacketMirroringWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaPacketMirroring
-	for _, r := range resources.Items {
-		rp := PacketMirroringToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, PacketMirroringToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaPacketMirroringResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigPacketMirroring(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
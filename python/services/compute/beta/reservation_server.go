@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -219,7 +220,11 @@ func ReservationToProto(resource *beta.Reservation) *betapb.ComputeBetaReservati
 // ApplyReservation handles the gRPC request by passing it to the underlying Reservation Apply() method.
 func (s *ReservationServer) applyReservation(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaReservationRequest) (*betapb.ComputeBetaReservation, error) {
 	p := ProtoToReservation(request.GetResource())
-	res, err := c.ApplyReservation(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyReservation(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -236,6 +241,55 @@ func (s *ReservationServer) ApplyComputeBetaReservation(ctx context.Context, req
 	return s.applyReservation(ctx, cl, request)
 }
 
+// GetComputeBetaReservation handles the gRPC request by passing it to the underlying Reservation Get() method.
+func (s *ReservationServer) GetComputeBetaReservation(ctx context.Context, request *betapb.GetComputeBetaReservationRequest) (*betapb.ComputeBetaReservation, error) {
+	cl, err := createConfigReservation(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetReservation(ctx, ProtoToReservation(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ReservationToProto(res), nil
+}
+
+// HasDiffComputeBetaReservation handles the gRPC request by passing it to the underlying Reservation Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ReservationServer) HasDiffComputeBetaReservation(ctx context.Context, request *betapb.HasDiffComputeBetaReservationRequest) (*betapb.HasDiffComputeBetaReservationResponse, error) {
+	cl, err := createConfigReservation(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffReservation(ctx, ProtoToReservation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaReservationResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaReservation handles the gRPC request by passing it to the underlying Reservation Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ReservationServer) PlanComputeBetaReservation(ctx context.Context, request *betapb.PlanComputeBetaReservationRequest) (*betapb.PlanComputeBetaReservationResponse, error) {
+	cl, err := createConfigReservation(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffReservation(ctx, ProtoToReservation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaReservationResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteReservation handles the gRPC request by passing it to the underlying Reservation Delete() method.
 func (s *ReservationServer) DeleteComputeBetaReservation(ctx context.Context, request *betapb.DeleteComputeBetaReservationRequest) (*emptypb.Empty, error) {
 
@@ -254,20 +308,32 @@ func (s *ReservationServer) ListComputeBetaReservation(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListReservation(ctx, request.Project, request.Zone)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.ReservationMaxPage
+	}
+	resources, err := cl.ListReservationWithPageToken(ctx, request.Project, request.Zone, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaReservation
-	for _, r := range resources.Items {
-		rp := ReservationToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ReservationToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaReservationResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaReservationResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigReservation(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
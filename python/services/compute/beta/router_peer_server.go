@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -103,7 +104,11 @@ func RouterPeerToProto(resource *beta.RouterPeer) *betapb.ComputeBetaRouterPeer
 // ApplyRouterPeer handles the gRPC request by passing it to the underlying RouterPeer Apply() method.
 func (s *RouterPeerServer) applyRouterPeer(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaRouterPeerRequest) (*betapb.ComputeBetaRouterPeer, error) {
 	p := ProtoToRouterPeer(request.GetResource())
-	res, err := c.ApplyRouterPeer(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRouterPeer(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +125,55 @@ func (s *RouterPeerServer) ApplyComputeBetaRouterPeer(ctx context.Context, reque
 	return s.applyRouterPeer(ctx, cl, request)
 }
 
+// GetComputeBetaRouterPeer handles the gRPC request by passing it to the underlying RouterPeer Get() method.
+func (s *RouterPeerServer) GetComputeBetaRouterPeer(ctx context.Context, request *betapb.GetComputeBetaRouterPeerRequest) (*betapb.ComputeBetaRouterPeer, error) {
+	cl, err := createConfigRouterPeer(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRouterPeer(ctx, ProtoToRouterPeer(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RouterPeerToProto(res), nil
+}
+
+// HasDiffComputeBetaRouterPeer handles the gRPC request by passing it to the underlying RouterPeer Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RouterPeerServer) HasDiffComputeBetaRouterPeer(ctx context.Context, request *betapb.HasDiffComputeBetaRouterPeerRequest) (*betapb.HasDiffComputeBetaRouterPeerResponse, error) {
+	cl, err := createConfigRouterPeer(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRouterPeer(ctx, ProtoToRouterPeer(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaRouterPeerResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaRouterPeer handles the gRPC request by passing it to the underlying RouterPeer Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RouterPeerServer) PlanComputeBetaRouterPeer(ctx context.Context, request *betapb.PlanComputeBetaRouterPeerRequest) (*betapb.PlanComputeBetaRouterPeerResponse, error) {
+	cl, err := createConfigRouterPeer(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRouterPeer(ctx, ProtoToRouterPeer(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaRouterPeerResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteRouterPeer handles the gRPC request by passing it to the underlying RouterPeer Delete() method.
 func (s *RouterPeerServer) DeleteComputeBetaRouterPeer(ctx context.Context, request *betapb.DeleteComputeBetaRouterPeerRequest) (*emptypb.Empty, error) {
 
@@ -138,20 +192,32 @@ func (s *RouterPeerServer) ListComputeBetaRouterPeer(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListRouterPeer(ctx, request.Project, request.Region)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.RouterPeerMaxPage
+	}
+	resources, err := cl.ListRouterPeerWithPageToken(ctx, request.Project, request.Region, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaRouterPeer
-	for _, r := range resources.Items {
-		rp := RouterPeerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RouterPeerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaRouterPeerResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaRouterPeerResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigRouterPeer(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
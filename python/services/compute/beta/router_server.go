@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -485,7 +486,11 @@ func RouterToProto(resource *beta.Router) *betapb.ComputeBetaRouter {
 // ApplyRouter handles the gRPC request by passing it to the underlying Router Apply() method.
 func (s *RouterServer) applyRouter(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaRouterRequest) (*betapb.ComputeBetaRouter, error) {
 	p := ProtoToRouter(request.GetResource())
-	res, err := c.ApplyRouter(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRouter(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -502,6 +507,55 @@ func (s *RouterServer) ApplyComputeBetaRouter(ctx context.Context, request *beta
 	return s.applyRouter(ctx, cl, request)
 }
 
+// GetComputeBetaRouter handles the gRPC request by passing it to the underlying Router Get() method.
+func (s *RouterServer) GetComputeBetaRouter(ctx context.Context, request *betapb.GetComputeBetaRouterRequest) (*betapb.ComputeBetaRouter, error) {
+	cl, err := createConfigRouter(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRouter(ctx, ProtoToRouter(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RouterToProto(res), nil
+}
+
+// HasDiffComputeBetaRouter handles the gRPC request by passing it to the underlying Router Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RouterServer) HasDiffComputeBetaRouter(ctx context.Context, request *betapb.HasDiffComputeBetaRouterRequest) (*betapb.HasDiffComputeBetaRouterResponse, error) {
+	cl, err := createConfigRouter(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRouter(ctx, ProtoToRouter(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaRouterResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaRouter handles the gRPC request by passing it to the underlying Router Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RouterServer) PlanComputeBetaRouter(ctx context.Context, request *betapb.PlanComputeBetaRouterRequest) (*betapb.PlanComputeBetaRouterResponse, error) {
+	cl, err := createConfigRouter(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRouter(ctx, ProtoToRouter(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaRouterResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteRouter handles the gRPC request by passing it to the underlying Router Delete() method.
 func (s *RouterServer) DeleteComputeBetaRouter(ctx context.Context, request *betapb.DeleteComputeBetaRouterRequest) (*emptypb.Empty, error) {
 
@@ -520,20 +574,32 @@ func (s *RouterServer) ListComputeBetaRouter(ctx context.Context, request *betap
 		return nil, err
 	}
 
-	resources, err := cl.ListRouter(ctx, request.Project, request.Region)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.RouterMaxPage
+	}
+	resources, err := cl.ListRouterWithPageToken(ctx, request.Project, request.Region, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaRouter
-	for _, r := range resources.Items {
-		rp := RouterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RouterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaRouterResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaRouterResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigRouter(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -117,7 +118,11 @@ func SnapshotToProto(resource *beta.Snapshot) *betapb.ComputeBetaSnapshot {
 // ApplySnapshot handles the gRPC request by passing it to the underlying Snapshot Apply() method.
 func (s *SnapshotServer) applySnapshot(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaSnapshotRequest) (*betapb.ComputeBetaSnapshot, error) {
 	p := ProtoToSnapshot(request.GetResource())
-	res, err := c.ApplySnapshot(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySnapshot(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -134,6 +139,55 @@ func (s *SnapshotServer) ApplyComputeBetaSnapshot(ctx context.Context, request *
 	return s.applySnapshot(ctx, cl, request)
 }
 
+// GetComputeBetaSnapshot handles the gRPC request by passing it to the underlying Snapshot Get() method.
+func (s *SnapshotServer) GetComputeBetaSnapshot(ctx context.Context, request *betapb.GetComputeBetaSnapshotRequest) (*betapb.ComputeBetaSnapshot, error) {
+	cl, err := createConfigSnapshot(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSnapshot(ctx, ProtoToSnapshot(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SnapshotToProto(res), nil
+}
+
+// HasDiffComputeBetaSnapshot handles the gRPC request by passing it to the underlying Snapshot Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SnapshotServer) HasDiffComputeBetaSnapshot(ctx context.Context, request *betapb.HasDiffComputeBetaSnapshotRequest) (*betapb.HasDiffComputeBetaSnapshotResponse, error) {
+	cl, err := createConfigSnapshot(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSnapshot(ctx, ProtoToSnapshot(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaSnapshotResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaSnapshot handles the gRPC request by passing it to the underlying Snapshot Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SnapshotServer) PlanComputeBetaSnapshot(ctx context.Context, request *betapb.PlanComputeBetaSnapshotRequest) (*betapb.PlanComputeBetaSnapshotResponse, error) {
+	cl, err := createConfigSnapshot(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSnapshot(ctx, ProtoToSnapshot(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaSnapshotResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteSnapshot handles the gRPC request by passing it to the underlying Snapshot Delete() method.
 func (s *SnapshotServer) DeleteComputeBetaSnapshot(ctx context.Context, request *betapb.DeleteComputeBetaSnapshotRequest) (*emptypb.Empty, error) {
 
@@ -152,20 +206,32 @@ func (s *SnapshotServer) ListComputeBetaSnapshot(ctx context.Context, request *b
 		return nil, err
 	}
 
-	resources, err := cl.ListSnapshot(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.SnapshotMaxPage
+	}
+	resources, err := cl.ListSnapshotWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaSnapshot
-	for _, r := range resources.Items {
-		rp := SnapshotToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SnapshotToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaSnapshotResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaSnapshotResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigSnapshot(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -118,7 +119,11 @@ func SslCertificateToProto(resource *beta.SslCertificate) *betapb.ComputeBetaSsl
 // ApplySslCertificate handles the gRPC request by passing it to the underlying SslCertificate Apply() method.
 func (s *SslCertificateServer) applySslCertificate(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaSslCertificateRequest) (*betapb.ComputeBetaSslCertificate, error) {
 	p := ProtoToSslCertificate(request.GetResource())
-	res, err := c.ApplySslCertificate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySslCertificate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +140,55 @@ func (s *SslCertificateServer) ApplyComputeBetaSslCertificate(ctx context.Contex
 	return s.applySslCertificate(ctx, cl, request)
 }
 
+// GetComputeBetaSslCertificate handles the gRPC request by passing it to the underlying SslCertificate Get() method.
+func (s *SslCertificateServer) GetComputeBetaSslCertificate(ctx context.Context, request *betapb.GetComputeBetaSslCertificateRequest) (*betapb.ComputeBetaSslCertificate, error) {
+	cl, err := createConfigSslCertificate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSslCertificate(ctx, ProtoToSslCertificate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SslCertificateToProto(res), nil
+}
+
+// HasDiffComputeBetaSslCertificate handles the gRPC request by passing it to the underlying SslCertificate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SslCertificateServer) HasDiffComputeBetaSslCertificate(ctx context.Context, request *betapb.HasDiffComputeBetaSslCertificateRequest) (*betapb.HasDiffComputeBetaSslCertificateResponse, error) {
+	cl, err := createConfigSslCertificate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSslCertificate(ctx, ProtoToSslCertificate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaSslCertificateResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaSslCertificate handles the gRPC request by passing it to the underlying SslCertificate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SslCertificateServer) PlanComputeBetaSslCertificate(ctx context.Context, request *betapb.PlanComputeBetaSslCertificateRequest) (*betapb.PlanComputeBetaSslCertificateResponse, error) {
+	cl, err := createConfigSslCertificate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSslCertificate(ctx, ProtoToSslCertificate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaSslCertificateResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteSslCertificate handles the gRPC request by passing it to the underlying SslCertificate Delete() method.
 func (s *SslCertificateServer) DeleteComputeBetaSslCertificate(ctx context.Context, request *betapb.DeleteComputeBetaSslCertificateRequest) (*emptypb.Empty, error) {
 
@@ -153,20 +207,32 @@ func (s *SslCertificateServer) ListComputeBetaSslCertificate(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListSslCertificate(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.SslCertificateMaxPage
+	}
+	resources, err := cl.ListSslCertificateWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaSslCertificate
-	for _, r := range resources.Items {
-		rp := SslCertificateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SslCertificateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaSslCertificateResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaSslCertificateResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigSslCertificate(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -175,7 +176,11 @@ func SslPolicyToProto(resource *beta.SslPolicy) *betapb.ComputeBetaSslPolicy {
 // ApplySslPolicy handles the gRPC request by passing it to the underlying SslPolicy Apply() method.
 func (s *SslPolicyServer) applySslPolicy(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaSslPolicyRequest) (*betapb.ComputeBetaSslPolicy, error) {
 	p := ProtoToSslPolicy(request.GetResource())
-	res, err := c.ApplySslPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySslPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +197,55 @@ func (s *SslPolicyServer) ApplyComputeBetaSslPolicy(ctx context.Context, request
 	return s.applySslPolicy(ctx, cl, request)
 }
 
+// GetComputeBetaSslPolicy handles the gRPC request by passing it to the underlying SslPolicy Get() method.
+func (s *SslPolicyServer) GetComputeBetaSslPolicy(ctx context.Context, request *betapb.GetComputeBetaSslPolicyRequest) (*betapb.ComputeBetaSslPolicy, error) {
+	cl, err := createConfigSslPolicy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSslPolicy(ctx, ProtoToSslPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SslPolicyToProto(res), nil
+}
+
+// HasDiffComputeBetaSslPolicy handles the gRPC request by passing it to the underlying SslPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SslPolicyServer) HasDiffComputeBetaSslPolicy(ctx context.Context, request *betapb.HasDiffComputeBetaSslPolicyRequest) (*betapb.HasDiffComputeBetaSslPolicyResponse, error) {
+	cl, err := createConfigSslPolicy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSslPolicy(ctx, ProtoToSslPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaSslPolicyResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaSslPolicy handles the gRPC request by passing it to the underlying SslPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SslPolicyServer) PlanComputeBetaSslPolicy(ctx context.Context, request *betapb.PlanComputeBetaSslPolicyRequest) (*betapb.PlanComputeBetaSslPolicyResponse, error) {
+	cl, err := createConfigSslPolicy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSslPolicy(ctx, ProtoToSslPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaSslPolicyResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteSslPolicy handles the gRPC request by passing it to the underlying SslPolicy Delete() method.
 func (s *SslPolicyServer) DeleteComputeBetaSslPolicy(ctx context.Context, request *betapb.DeleteComputeBetaSslPolicyRequest) (*emptypb.Empty, error) {
 
@@ -210,20 +264,32 @@ func (s *SslPolicyServer) ListComputeBetaSslPolicy(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListSslPolicy(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.SslPolicyMaxPage
+	}
+	resources, err := cl.ListSslPolicyWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaSslPolicy
-	for _, r := range resources.Items {
-		rp := SslPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SslPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaSslPolicyResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaSslPolicyResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigSslPolicy(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
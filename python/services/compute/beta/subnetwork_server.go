@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -219,7 +220,11 @@ func SubnetworkToProto(resource *beta.Subnetwork) *betapb.ComputeBetaSubnetwork
 // applySubnetwork handles the gRPC request by passing it to the underlying Subnetwork Apply() method.
 func (s *SubnetworkServer) applySubnetwork(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaSubnetworkRequest) (*betapb.ComputeBetaSubnetwork, error) {
 	p := ProtoToSubnetwork(request.GetResource())
-	res, err := c.ApplySubnetwork(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySubnetwork(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -236,6 +241,60 @@ func (s *SubnetworkServer) ApplyComputeBetaSubnetwork(ctx context.Context, reque
 	return s.applySubnetwork(ctx, cl, request)
 }
 
+// GetComputeBetaSubnetwork handles the gRPC request by passing it to the underlying Subnetwork Get() method.
+func (s *SubnetworkServer) GetComputeBetaSubnetwork(ctx context.Context, request *betapb.GetComputeBetaSubnetworkRequest) (*betapb.ComputeBetaSubnetwork, error) {
+	cl, err := createConfigSubnetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSubnetwork(ctx, ProtoToSubnetwork(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SubnetworkToProto(res), nil
+}
+
+// HasDiffComputeBetaSubnetwork handles the gRPC request by passing it to the underlying Subnetwork Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SubnetworkServer) HasDiffComputeBetaSubnetwork(ctx context.Context, request *betapb.HasDiffComputeBetaSubnetworkRequest) (*betapb.HasDiffComputeBetaSubnetworkResponse, error) {
+	cl, err := createConfigSubnetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSubnetwork(ctx, ProtoToSubnetwork(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaSubnetworkResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaSubnetwork handles the gRPC request by passing it to the underlying Subnetwork Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SubnetworkServer) PlanComputeBetaSubnetwork(ctx context.Context, request *betapb.PlanComputeBetaSubnetworkRequest) (*betapb.PlanComputeBetaSubnetworkResponse, error) {
+	cl, err := createConfigSubnetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSubnetwork(ctx, ProtoToSubnetwork(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaSubnetworkResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteSubnetwork handles the gRPC request by passing it to the underlying Subnetwork Delete() method.
 func (s *SubnetworkServer) DeleteComputeBetaSubnetwork(ctx context.Context, request *betapb.DeleteComputeBetaSubnetworkRequest) (*emptypb.Empty, error) {
 
@@ -254,22 +313,35 @@ func (s *SubnetworkServer) ListComputeBetaSubnetwork(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListSubnetwork(ctx, request.GetProject(), request.GetRegion())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.SubnetworkMaxPage
+	}
+	resources, err := cl.ListSubnetworkWithPageToken(ctx, request.GetProject(), request.GetRegion(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaSubnetwork
-	for _, r := range resources.Items {
-		rp := SubnetworkToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SubnetworkToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaSubnetworkResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigSubnetwork(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -55,7 +56,11 @@ func TargetHttpProxyToProto(resource *beta.TargetHttpProxy) *betapb.ComputeBetaT
 // ApplyTargetHttpProxy handles the gRPC request by passing it to the underlying TargetHttpProxy Apply() method.
 func (s *TargetHttpProxyServer) applyTargetHttpProxy(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaTargetHttpProxyRequest) (*betapb.ComputeBetaTargetHttpProxy, error) {
 	p := ProtoToTargetHttpProxy(request.GetResource())
-	res, err := c.ApplyTargetHttpProxy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTargetHttpProxy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,55 @@ func (s *TargetHttpProxyServer) ApplyComputeBetaTargetHttpProxy(ctx context.Cont
 	return s.applyTargetHttpProxy(ctx, cl, request)
 }
 
+// GetComputeBetaTargetHttpProxy handles the gRPC request by passing it to the underlying TargetHttpProxy Get() method.
+func (s *TargetHttpProxyServer) GetComputeBetaTargetHttpProxy(ctx context.Context, request *betapb.GetComputeBetaTargetHttpProxyRequest) (*betapb.ComputeBetaTargetHttpProxy, error) {
+	cl, err := createConfigTargetHttpProxy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTargetHttpProxy(ctx, ProtoToTargetHttpProxy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TargetHttpProxyToProto(res), nil
+}
+
+// HasDiffComputeBetaTargetHttpProxy handles the gRPC request by passing it to the underlying TargetHttpProxy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TargetHttpProxyServer) HasDiffComputeBetaTargetHttpProxy(ctx context.Context, request *betapb.HasDiffComputeBetaTargetHttpProxyRequest) (*betapb.HasDiffComputeBetaTargetHttpProxyResponse, error) {
+	cl, err := createConfigTargetHttpProxy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTargetHttpProxy(ctx, ProtoToTargetHttpProxy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaTargetHttpProxyResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaTargetHttpProxy handles the gRPC request by passing it to the underlying TargetHttpProxy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TargetHttpProxyServer) PlanComputeBetaTargetHttpProxy(ctx context.Context, request *betapb.PlanComputeBetaTargetHttpProxyRequest) (*betapb.PlanComputeBetaTargetHttpProxyResponse, error) {
+	cl, err := createConfigTargetHttpProxy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTargetHttpProxy(ctx, ProtoToTargetHttpProxy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaTargetHttpProxyResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteTargetHttpProxy handles the gRPC request by passing it to the underlying TargetHttpProxy Delete() method.
 func (s *TargetHttpProxyServer) DeleteComputeBetaTargetHttpProxy(ctx context.Context, request *betapb.DeleteComputeBetaTargetHttpProxyRequest) (*emptypb.Empty, error) {
 
@@ -90,20 +144,32 @@ func (s *TargetHttpProxyServer) ListComputeBetaTargetHttpProxy(ctx context.Conte
 		return nil, err
 	}
 
-	resources, err := cl.ListTargetHttpProxy(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.TargetHttpProxyMaxPage
+	}
+	resources, err := cl.ListTargetHttpProxyWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaTargetHttpProxy
-	for _, r := range resources.Items {
-		rp := TargetHttpProxyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TargetHttpProxyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaTargetHttpProxyResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaTargetHttpProxyResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigTargetHttpProxy(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
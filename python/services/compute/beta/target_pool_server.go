@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -94,7 +95,11 @@ func TargetPoolToProto(resource *beta.TargetPool) *betapb.ComputeBetaTargetPool
 // ApplyTargetPool handles the gRPC request by passing it to the underlying TargetPool Apply() method.
 func (s *TargetPoolServer) applyTargetPool(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaTargetPoolRequest) (*betapb.ComputeBetaTargetPool, error) {
 	p := ProtoToTargetPool(request.GetResource())
-	res, err := c.ApplyTargetPool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTargetPool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +116,55 @@ func (s *TargetPoolServer) ApplyComputeBetaTargetPool(ctx context.Context, reque
 	return s.applyTargetPool(ctx, cl, request)
 }
 
+// GetComputeBetaTargetPool handles the gRPC request by passing it to the underlying TargetPool Get() method.
+func (s *TargetPoolServer) GetComputeBetaTargetPool(ctx context.Context, request *betapb.GetComputeBetaTargetPoolRequest) (*betapb.ComputeBetaTargetPool, error) {
+	cl, err := createConfigTargetPool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTargetPool(ctx, ProtoToTargetPool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TargetPoolToProto(res), nil
+}
+
+// HasDiffComputeBetaTargetPool handles the gRPC request by passing it to the underlying TargetPool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TargetPoolServer) HasDiffComputeBetaTargetPool(ctx context.Context, request *betapb.HasDiffComputeBetaTargetPoolRequest) (*betapb.HasDiffComputeBetaTargetPoolResponse, error) {
+	cl, err := createConfigTargetPool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTargetPool(ctx, ProtoToTargetPool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaTargetPoolResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaTargetPool handles the gRPC request by passing it to the underlying TargetPool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TargetPoolServer) PlanComputeBetaTargetPool(ctx context.Context, request *betapb.PlanComputeBetaTargetPoolRequest) (*betapb.PlanComputeBetaTargetPoolResponse, error) {
+	cl, err := createConfigTargetPool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTargetPool(ctx, ProtoToTargetPool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaTargetPoolResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteTargetPool handles the gRPC request by passing it to the underlying TargetPool Delete() method.
 func (s *TargetPoolServer) DeleteComputeBetaTargetPool(ctx context.Context, request *betapb.DeleteComputeBetaTargetPoolRequest) (*emptypb.Empty, error) {
 
@@ -129,20 +183,32 @@ func (s *TargetPoolServer) ListComputeBetaTargetPool(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListTargetPool(ctx, request.Project, request.Region)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.TargetPoolMaxPage
+	}
+	resources, err := cl.ListTargetPoolWithPageToken(ctx, request.Project, request.Region, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaTargetPool
-	for _, r := range resources.Items {
-		rp := TargetPoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TargetPoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaTargetPoolResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaTargetPoolResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigTargetPool(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
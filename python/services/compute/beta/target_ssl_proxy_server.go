@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -88,7 +89,11 @@ func TargetSslProxyToProto(resource *beta.TargetSslProxy) *betapb.ComputeBetaTar
 // ApplyTargetSslProxy handles the gRPC request by passing it to the underlying TargetSslProxy Apply() method.
 func (s *TargetSslProxyServer) applyTargetSslProxy(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaTargetSslProxyRequest) (*betapb.ComputeBetaTargetSslProxy, error) {
 	p := ProtoToTargetSslProxy(request.GetResource())
-	res, err := c.ApplyTargetSslProxy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTargetSslProxy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +110,55 @@ func (s *TargetSslProxyServer) ApplyComputeBetaTargetSslProxy(ctx context.Contex
 	return s.applyTargetSslProxy(ctx, cl, request)
 }
 
+// GetComputeBetaTargetSslProxy handles the gRPC request by passing it to the underlying TargetSslProxy Get() method.
+func (s *TargetSslProxyServer) GetComputeBetaTargetSslProxy(ctx context.Context, request *betapb.GetComputeBetaTargetSslProxyRequest) (*betapb.ComputeBetaTargetSslProxy, error) {
+	cl, err := createConfigTargetSslProxy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTargetSslProxy(ctx, ProtoToTargetSslProxy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TargetSslProxyToProto(res), nil
+}
+
+// HasDiffComputeBetaTargetSslProxy handles the gRPC request by passing it to the underlying TargetSslProxy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TargetSslProxyServer) HasDiffComputeBetaTargetSslProxy(ctx context.Context, request *betapb.HasDiffComputeBetaTargetSslProxyRequest) (*betapb.HasDiffComputeBetaTargetSslProxyResponse, error) {
+	cl, err := createConfigTargetSslProxy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTargetSslProxy(ctx, ProtoToTargetSslProxy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaTargetSslProxyResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaTargetSslProxy handles the gRPC request by passing it to the underlying TargetSslProxy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TargetSslProxyServer) PlanComputeBetaTargetSslProxy(ctx context.Context, request *betapb.PlanComputeBetaTargetSslProxyRequest) (*betapb.PlanComputeBetaTargetSslProxyResponse, error) {
+	cl, err := createConfigTargetSslProxy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTargetSslProxy(ctx, ProtoToTargetSslProxy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaTargetSslProxyResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteTargetSslProxy handles the gRPC request by passing it to the underlying TargetSslProxy Delete() method.
 func (s *TargetSslProxyServer) DeleteComputeBetaTargetSslProxy(ctx context.Context, request *betapb.DeleteComputeBetaTargetSslProxyRequest) (*emptypb.Empty, error) {
 
@@ -123,20 +177,32 @@ func (s *TargetSslProxyServer) ListComputeBetaTargetSslProxy(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListTargetSslProxy(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.TargetSslProxyMaxPage
+	}
+	resources, err := cl.ListTargetSslProxyWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaTargetSslProxy
-	for _, r := range resources.Items {
-		rp := TargetSslProxyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TargetSslProxyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaTargetSslProxyResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaTargetSslProxyResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigTargetSslProxy(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -94,7 +95,11 @@ func TargetVpnGatewayToProto(resource *beta.TargetVpnGateway) *betapb.ComputeBet
 // ApplyTargetVpnGateway handles the gRPC request by passing it to the underlying TargetVpnGateway Apply() method.
 func (s *TargetVpnGatewayServer) applyTargetVpnGateway(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaTargetVpnGatewayRequest) (*betapb.ComputeBetaTargetVpnGateway, error) {
 	p := ProtoToTargetVpnGateway(request.GetResource())
-	res, err := c.ApplyTargetVpnGateway(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTargetVpnGateway(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +116,55 @@ func (s *TargetVpnGatewayServer) ApplyComputeBetaTargetVpnGateway(ctx context.Co
 	return s.applyTargetVpnGateway(ctx, cl, request)
 }
 
+// GetComputeBetaTargetVpnGateway handles the gRPC request by passing it to the underlying TargetVpnGateway Get() method.
+func (s *TargetVpnGatewayServer) GetComputeBetaTargetVpnGateway(ctx context.Context, request *betapb.GetComputeBetaTargetVpnGatewayRequest) (*betapb.ComputeBetaTargetVpnGateway, error) {
+	cl, err := createConfigTargetVpnGateway(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTargetVpnGateway(ctx, ProtoToTargetVpnGateway(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TargetVpnGatewayToProto(res), nil
+}
+
+// HasDiffComputeBetaTargetVpnGateway handles the gRPC request by passing it to the underlying TargetVpnGateway Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TargetVpnGatewayServer) HasDiffComputeBetaTargetVpnGateway(ctx context.Context, request *betapb.HasDiffComputeBetaTargetVpnGatewayRequest) (*betapb.HasDiffComputeBetaTargetVpnGatewayResponse, error) {
+	cl, err := createConfigTargetVpnGateway(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTargetVpnGateway(ctx, ProtoToTargetVpnGateway(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaTargetVpnGatewayResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaTargetVpnGateway handles the gRPC request by passing it to the underlying TargetVpnGateway Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TargetVpnGatewayServer) PlanComputeBetaTargetVpnGateway(ctx context.Context, request *betapb.PlanComputeBetaTargetVpnGatewayRequest) (*betapb.PlanComputeBetaTargetVpnGatewayResponse, error) {
+	cl, err := createConfigTargetVpnGateway(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTargetVpnGateway(ctx, ProtoToTargetVpnGateway(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaTargetVpnGatewayResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteTargetVpnGateway handles the gRPC request by passing it to the underlying TargetVpnGateway Delete() method.
 func (s *TargetVpnGatewayServer) DeleteComputeBetaTargetVpnGateway(ctx context.Context, request *betapb.DeleteComputeBetaTargetVpnGatewayRequest) (*emptypb.Empty, error) {
 
@@ -129,20 +183,32 @@ func (s *TargetVpnGatewayServer) ListComputeBetaTargetVpnGateway(ctx context.Con
 		return nil, err
 	}
 
-	resources, err := cl.ListTargetVpnGateway(ctx, request.Project, request.Region)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.TargetVpnGatewayMaxPage
+	}
+	resources, err := cl.ListTargetVpnGatewayWithPageToken(ctx, request.Project, request.Region, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaTargetVpnGateway
-	for _, r := range resources.Items {
-		rp := TargetVpnGatewayToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TargetVpnGatewayToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaTargetVpnGatewayResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaTargetVpnGatewayResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigTargetVpnGateway(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
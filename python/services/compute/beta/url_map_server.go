@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -1794,7 +1795,11 @@ func UrlMapToProto(resource *beta.UrlMap) *betapb.ComputeBetaUrlMap {
 // ApplyUrlMap handles the gRPC request by passing it to the underlying UrlMap Apply() method.
 func (s *UrlMapServer) applyUrlMap(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaUrlMapRequest) (*betapb.ComputeBetaUrlMap, error) {
 	p := ProtoToUrlMap(request.GetResource())
-	res, err := c.ApplyUrlMap(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyUrlMap(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1811,6 +1816,55 @@ func (s *UrlMapServer) ApplyComputeBetaUrlMap(ctx context.Context, request *beta
 	return s.applyUrlMap(ctx, cl, request)
 }
 
+// GetComputeBetaUrlMap handles the gRPC request by passing it to the underlying UrlMap Get() method.
+func (s *UrlMapServer) GetComputeBetaUrlMap(ctx context.Context, request *betapb.GetComputeBetaUrlMapRequest) (*betapb.ComputeBetaUrlMap, error) {
+	cl, err := createConfigUrlMap(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetUrlMap(ctx, ProtoToUrlMap(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return UrlMapToProto(res), nil
+}
+
+// HasDiffComputeBetaUrlMap handles the gRPC request by passing it to the underlying UrlMap Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *UrlMapServer) HasDiffComputeBetaUrlMap(ctx context.Context, request *betapb.HasDiffComputeBetaUrlMapRequest) (*betapb.HasDiffComputeBetaUrlMapResponse, error) {
+	cl, err := createConfigUrlMap(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUrlMap(ctx, ProtoToUrlMap(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaUrlMapResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaUrlMap handles the gRPC request by passing it to the underlying UrlMap Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *UrlMapServer) PlanComputeBetaUrlMap(ctx context.Context, request *betapb.PlanComputeBetaUrlMapRequest) (*betapb.PlanComputeBetaUrlMapResponse, error) {
+	cl, err := createConfigUrlMap(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUrlMap(ctx, ProtoToUrlMap(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaUrlMapResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteUrlMap handles the gRPC request by passing it to the underlying UrlMap Delete() method.
 func (s *UrlMapServer) DeleteComputeBetaUrlMap(ctx context.Context, request *betapb.DeleteComputeBetaUrlMapRequest) (*emptypb.Empty, error) {
 
@@ -1829,20 +1883,32 @@ func (s *UrlMapServer) ListComputeBetaUrlMap(ctx context.Context, request *betap
 		return nil, err
 	}
 
-	resources, err := cl.ListUrlMap(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.UrlMapMaxPage
+	}
+	resources, err := cl.ListUrlMapWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaUrlMap
-	for _, r := range resources.Items {
-		rp := UrlMapToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, UrlMapToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaUrlMapResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaUrlMapResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigUrlMap(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -87,7 +88,11 @@ func VpnGatewayToProto(resource *beta.VpnGateway) *betapb.ComputeBetaVpnGateway
 // ApplyVpnGateway handles the gRPC request by passing it to the underlying VpnGateway Apply() method.
 func (s *VpnGatewayServer) applyVpnGateway(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaVpnGatewayRequest) (*betapb.ComputeBetaVpnGateway, error) {
 	p := ProtoToVpnGateway(request.GetResource())
-	res, err := c.ApplyVpnGateway(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyVpnGateway(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +109,55 @@ func (s *VpnGatewayServer) ApplyComputeBetaVpnGateway(ctx context.Context, reque
 	return s.applyVpnGateway(ctx, cl, request)
 }
 
+// GetComputeBetaVpnGateway handles the gRPC request by passing it to the underlying VpnGateway Get() method.
+func (s *VpnGatewayServer) GetComputeBetaVpnGateway(ctx context.Context, request *betapb.GetComputeBetaVpnGatewayRequest) (*betapb.ComputeBetaVpnGateway, error) {
+	cl, err := createConfigVpnGateway(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetVpnGateway(ctx, ProtoToVpnGateway(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return VpnGatewayToProto(res), nil
+}
+
+// HasDiffComputeBetaVpnGateway handles the gRPC request by passing it to the underlying VpnGateway Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *VpnGatewayServer) HasDiffComputeBetaVpnGateway(ctx context.Context, request *betapb.HasDiffComputeBetaVpnGatewayRequest) (*betapb.HasDiffComputeBetaVpnGatewayResponse, error) {
+	cl, err := createConfigVpnGateway(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVpnGateway(ctx, ProtoToVpnGateway(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffComputeBetaVpnGatewayResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeBetaVpnGateway handles the gRPC request by passing it to the underlying VpnGateway Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *VpnGatewayServer) PlanComputeBetaVpnGateway(ctx context.Context, request *betapb.PlanComputeBetaVpnGatewayRequest) (*betapb.PlanComputeBetaVpnGatewayResponse, error) {
+	cl, err := createConfigVpnGateway(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVpnGateway(ctx, ProtoToVpnGateway(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanComputeBetaVpnGatewayResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteVpnGateway handles the gRPC request by passing it to the underlying VpnGateway Delete() method.
 func (s *VpnGatewayServer) DeleteComputeBetaVpnGateway(ctx context.Context, request *betapb.DeleteComputeBetaVpnGatewayRequest) (*emptypb.Empty, error) {
 
@@ -122,20 +176,32 @@ func (s *VpnGatewayServer) ListComputeBetaVpnGateway(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListVpnGateway(ctx, request.Project, request.Region)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.VpnGatewayMaxPage
+	}
+	resources, err := cl.ListVpnGatewayWithPageToken(ctx, request.Project, request.Region, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaVpnGateway
-	for _, r := range resources.Items {
-		rp := VpnGatewayToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, VpnGatewayToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListComputeBetaVpnGatewayResponse{Items: protos}, nil
+	return &betapb.ListComputeBetaVpnGatewayResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigVpnGateway(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/beta/compute_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute/beta"
@@ -123,7 +124,11 @@ func VpnTunnelToProto(resource *beta.VpnTunnel) *betapb.ComputeBetaVpnTunnel {
 // applyVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Apply() method.
 func (s *VpnTunnelServer) applyVpnTunnel(ctx context.Context, c *beta.Client, request *betapb.ApplyComputeBetaVpnTunnelRequest) (*betapb.ComputeBetaVpnTunnel, error) {
 	p := ProtoToVpnTunnel(request.GetResource())
-	res, err := c.ApplyVpnTunnel(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyVpnTunnel(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +145,60 @@ func (s *VpnTunnelServer) ApplyComputeBetaVpnTunnel(ctx context.Context, request
 	return s.applyVpnTunnel(ctx, cl, request)
 }
 
+// GetComputeBetaVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Get() method.
+func (s *VpnTunnelServer) GetComputeBetaVpnTunnel(ctx context.Context, request *betapb.GetComputeBetaVpnTunnelRequest) (*betapb.ComputeBetaVpnTunnel, error) {
+	cl, err := createConfigVpnTunnel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetVpnTunnel(ctx, ProtoToVpnTunnel(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return VpnTunnelToProto(res), nil
+}
+
+// HasDiffComputeBetaVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *VpnTunnelServer) HasDiffComputeBetaVpnTunnel(ctx context.Context, request *betapb.HasDiffComputeBetaVpnTunnelRequest) (*betapb.HasDiffComputeBetaVpnTunnelResponse, error) {
+	cl, err := createConfigVpnTunnel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVpnTunnel(ctx, ProtoToVpnTunnel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffComputeBetaVpnTunnelResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeBetaVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *VpnTunnelServer) PlanComputeBetaVpnTunnel(ctx context.Context, request *betapb.PlanComputeBetaVpnTunnelRequest) (*betapb.PlanComputeBetaVpnTunnelResponse, error) {
+	cl, err := createConfigVpnTunnel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVpnTunnel(ctx, ProtoToVpnTunnel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanComputeBetaVpnTunnelResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Delete() method.
 func (s *VpnTunnelServer) DeleteComputeBetaVpnTunnel(ctx context.Context, request *betapb.DeleteComputeBetaVpnTunnelRequest) (*emptypb.Empty, error) {
 
@@ -158,22 +217,35 @@ func (s *VpnTunnelServer) ListComputeBetaVpnTunnel(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListVpnTunnel(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.VpnTunnelMaxPage
+	}
+	resources, err := cl.ListVpnTunnelWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ComputeBetaVpnTunnel
-	for _, r := range resources.Items {
-		rp := VpnTunnelToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, VpnTunnelToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListComputeBetaVpnTunnelResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigVpnTunnel(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -171,7 +172,11 @@ func FirewallPolicyRuleToProto(resource *compute.FirewallPolicyRule) *computepb.
 // applyFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Apply() method.
 func (s *FirewallPolicyRuleServer) applyFirewallPolicyRule(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeFirewallPolicyRuleRequest) (*computepb.ComputeFirewallPolicyRule, error) {
 	p := ProtoToFirewallPolicyRule(request.GetResource())
-	res, err := c.ApplyFirewallPolicyRule(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFirewallPolicyRule(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +193,60 @@ func (s *FirewallPolicyRuleServer) ApplyComputeFirewallPolicyRule(ctx context.Co
 	return s.applyFirewallPolicyRule(ctx, cl, request)
 }
 
+// GetComputeFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Get() method.
+func (s *FirewallPolicyRuleServer) GetComputeFirewallPolicyRule(ctx context.Context, request *computepb.GetComputeFirewallPolicyRuleRequest) (*computepb.ComputeFirewallPolicyRule, error) {
+	cl, err := createConfigFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFirewallPolicyRule(ctx, ProtoToFirewallPolicyRule(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FirewallPolicyRuleToProto(res), nil
+}
+
+// HasDiffComputeFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FirewallPolicyRuleServer) HasDiffComputeFirewallPolicyRule(ctx context.Context, request *computepb.HasDiffComputeFirewallPolicyRuleRequest) (*computepb.HasDiffComputeFirewallPolicyRuleResponse, error) {
+	cl, err := createConfigFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicyRule(ctx, ProtoToFirewallPolicyRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.HasDiffComputeFirewallPolicyRuleResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FirewallPolicyRuleServer) PlanComputeFirewallPolicyRule(ctx context.Context, request *computepb.PlanComputeFirewallPolicyRuleRequest) (*computepb.PlanComputeFirewallPolicyRuleResponse, error) {
+	cl, err := createConfigFirewallPolicyRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicyRule(ctx, ProtoToFirewallPolicyRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.PlanComputeFirewallPolicyRuleResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFirewallPolicyRule handles the gRPC request by passing it to the underlying FirewallPolicyRule Delete() method.
 func (s *FirewallPolicyRuleServer) DeleteComputeFirewallPolicyRule(ctx context.Context, request *computepb.DeleteComputeFirewallPolicyRuleRequest) (*emptypb.Empty, error) {
 
@@ -206,22 +265,35 @@ func (s *FirewallPolicyRuleServer) ListComputeFirewallPolicyRule(ctx context.Con
 		return nil, err
 	}
 
-	resources, err := cl.ListFirewallPolicyRule(ctx, request.GetFirewallPolicy())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = compute.FirewallPolicyRuleMaxPage
+	}
+	resources, err := cl.ListFirewallPolicyRuleWithPageToken(ctx, request.GetFirewallPolicy(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeFirewallPolicyRule
-	for _, r := range resources.Items {
-		rp := FirewallPolicyRuleToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FirewallPolicyRuleToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &computepb.ListComputeFirewallPolicyRuleResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFirewallPolicyRule(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
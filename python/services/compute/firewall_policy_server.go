@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -61,7 +62,11 @@ func FirewallPolicyToProto(resource *compute.FirewallPolicy) *computepb.ComputeF
 // applyFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Apply() method.
 func (s *FirewallPolicyServer) applyFirewallPolicy(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeFirewallPolicyRequest) (*computepb.ComputeFirewallPolicy, error) {
 	p := ProtoToFirewallPolicy(request.GetResource())
-	res, err := c.ApplyFirewallPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFirewallPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +83,60 @@ func (s *FirewallPolicyServer) ApplyComputeFirewallPolicy(ctx context.Context, r
 	return s.applyFirewallPolicy(ctx, cl, request)
 }
 
+// GetComputeFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Get() method.
+func (s *FirewallPolicyServer) GetComputeFirewallPolicy(ctx context.Context, request *computepb.GetComputeFirewallPolicyRequest) (*computepb.ComputeFirewallPolicy, error) {
+	cl, err := createConfigFirewallPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFirewallPolicy(ctx, ProtoToFirewallPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FirewallPolicyToProto(res), nil
+}
+
+// HasDiffComputeFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FirewallPolicyServer) HasDiffComputeFirewallPolicy(ctx context.Context, request *computepb.HasDiffComputeFirewallPolicyRequest) (*computepb.HasDiffComputeFirewallPolicyResponse, error) {
+	cl, err := createConfigFirewallPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicy(ctx, ProtoToFirewallPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.HasDiffComputeFirewallPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FirewallPolicyServer) PlanComputeFirewallPolicy(ctx context.Context, request *computepb.PlanComputeFirewallPolicyRequest) (*computepb.PlanComputeFirewallPolicyResponse, error) {
+	cl, err := createConfigFirewallPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirewallPolicy(ctx, ProtoToFirewallPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.PlanComputeFirewallPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFirewallPolicy handles the gRPC request by passing it to the underlying FirewallPolicy Delete() method.
 func (s *FirewallPolicyServer) DeleteComputeFirewallPolicy(ctx context.Context, request *computepb.DeleteComputeFirewallPolicyRequest) (*emptypb.Empty, error) {
 
@@ -96,22 +155,35 @@ func (s *FirewallPolicyServer) ListComputeFirewallPolicy(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListFirewallPolicy(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = compute.FirewallPolicyMaxPage
+	}
+	resources, err := cl.ListFirewallPolicyWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeFirewallPolicy
-	for _, r := range resources.Items {
-		rp := FirewallPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FirewallPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &computepb.ListComputeFirewallPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFirewallPolicy(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
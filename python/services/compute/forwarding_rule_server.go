@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -333,7 +334,11 @@ func ForwardingRuleToProto(resource *compute.ForwardingRule) *computepb.ComputeF
 // applyForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Apply() method.
 func (s *ForwardingRuleServer) applyForwardingRule(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeForwardingRuleRequest) (*computepb.ComputeForwardingRule, error) {
 	p := ProtoToForwardingRule(request.GetResource())
-	res, err := c.ApplyForwardingRule(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyForwardingRule(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -350,6 +355,60 @@ func (s *ForwardingRuleServer) ApplyComputeForwardingRule(ctx context.Context, r
 	return s.applyForwardingRule(ctx, cl, request)
 }
 
+// GetComputeForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Get() method.
+func (s *ForwardingRuleServer) GetComputeForwardingRule(ctx context.Context, request *computepb.GetComputeForwardingRuleRequest) (*computepb.ComputeForwardingRule, error) {
+	cl, err := createConfigForwardingRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetForwardingRule(ctx, ProtoToForwardingRule(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ForwardingRuleToProto(res), nil
+}
+
+// HasDiffComputeForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ForwardingRuleServer) HasDiffComputeForwardingRule(ctx context.Context, request *computepb.HasDiffComputeForwardingRuleRequest) (*computepb.HasDiffComputeForwardingRuleResponse, error) {
+	cl, err := createConfigForwardingRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffForwardingRule(ctx, ProtoToForwardingRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.HasDiffComputeForwardingRuleResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ForwardingRuleServer) PlanComputeForwardingRule(ctx context.Context, request *computepb.PlanComputeForwardingRuleRequest) (*computepb.PlanComputeForwardingRuleResponse, error) {
+	cl, err := createConfigForwardingRule(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffForwardingRule(ctx, ProtoToForwardingRule(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.PlanComputeForwardingRuleResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteForwardingRule handles the gRPC request by passing it to the underlying ForwardingRule Delete() method.
 func (s *ForwardingRuleServer) DeleteComputeForwardingRule(ctx context.Context, request *computepb.DeleteComputeForwardingRuleRequest) (*emptypb.Empty, error) {
 
@@ -368,22 +427,35 @@ func (s *ForwardingRuleServer) ListComputeForwardingRule(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListForwardingRule(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = compute.ForwardingRuleMaxPage
+	}
+	resources, err := cl.ListForwardingRuleWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeForwardingRule
-	for _, r := range resources.Items {
-		rp := ForwardingRuleToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ForwardingRuleToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &computepb.ListComputeForwardingRuleResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigForwardingRule(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -494,7 +495,11 @@ func HealthCheckToProto(resource *compute.HealthCheck) *computepb.ComputeHealthC
 // ApplyHealthCheck handles the gRPC request by passing it to the underlying HealthCheck Apply() method.
 func (s *HealthCheckServer) applyHealthCheck(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeHealthCheckRequest) (*computepb.ComputeHealthCheck, error) {
 	p := ProtoToHealthCheck(request.GetResource())
-	res, err := c.ApplyHealthCheck(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyHealthCheck(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -511,6 +516,55 @@ func (s *HealthCheckServer) ApplyComputeHealthCheck(ctx context.Context, request
 	return s.applyHealthCheck(ctx, cl, request)
 }
 
+// GetComputeHealthCheck handles the gRPC request by passing it to the underlying HealthCheck Get() method.
+func (s *HealthCheckServer) GetComputeHealthCheck(ctx context.Context, request *computepb.GetComputeHealthCheckRequest) (*computepb.ComputeHealthCheck, error) {
+	cl, err := createConfigHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetHealthCheck(ctx, ProtoToHealthCheck(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return HealthCheckToProto(res), nil
+}
+
+// HasDiffComputeHealthCheck handles the gRPC request by passing it to the underlying HealthCheck Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *HealthCheckServer) HasDiffComputeHealthCheck(ctx context.Context, request *computepb.HasDiffComputeHealthCheckRequest) (*computepb.HasDiffComputeHealthCheckResponse, error) {
+	cl, err := createConfigHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHealthCheck(ctx, ProtoToHealthCheck(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeHealthCheckResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeHealthCheck handles the gRPC request by passing it to the underlying HealthCheck Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *HealthCheckServer) PlanComputeHealthCheck(ctx context.Context, request *computepb.PlanComputeHealthCheckRequest) (*computepb.PlanComputeHealthCheckResponse, error) {
+	cl, err := createConfigHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHealthCheck(ctx, ProtoToHealthCheck(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeHealthCheckResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteHealthCheck handles the gRPC request by passing it to the underlying HealthCheck Delete() method.
 func (s *HealthCheckServer) DeleteComputeHealthCheck(ctx context.Context, request *computepb.DeleteComputeHealthCheckRequest) (*emptypb.Empty, error) {
 
@@ -529,20 +583,32 @@ func (s *HealthCheckServer) ListComputeHealthCheck(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListHealthCheck(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.HealthCheckMaxPage
+	}
+	resources, err := cl.ListHealthCheckWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeHealthCheck
-	for _, r := range resources.Items {
-		rp := HealthCheckToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, HealthCheckToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeHealthCheckResponse{Items: protos}, nil
+	return &computepb.ListComputeHealthCheckResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigHealthCheck(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
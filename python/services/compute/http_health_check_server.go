@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -67,7 +68,11 @@ func HttpHealthCheckToProto(resource *compute.HttpHealthCheck) *computepb.Comput
 // ApplyHttpHealthCheck handles the gRPC request by passing it to the underlying HttpHealthCheck Apply() method.
 func (s *HttpHealthCheckServer) applyHttpHealthCheck(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeHttpHealthCheckRequest) (*computepb.ComputeHttpHealthCheck, error) {
 	p := ProtoToHttpHealthCheck(request.GetResource())
-	res, err := c.ApplyHttpHealthCheck(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyHttpHealthCheck(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +89,55 @@ func (s *HttpHealthCheckServer) ApplyComputeHttpHealthCheck(ctx context.Context,
 	return s.applyHttpHealthCheck(ctx, cl, request)
 }
 
+// GetComputeHttpHealthCheck handles the gRPC request by passing it to the underlying HttpHealthCheck Get() method.
+func (s *HttpHealthCheckServer) GetComputeHttpHealthCheck(ctx context.Context, request *computepb.GetComputeHttpHealthCheckRequest) (*computepb.ComputeHttpHealthCheck, error) {
+	cl, err := createConfigHttpHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetHttpHealthCheck(ctx, ProtoToHttpHealthCheck(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return HttpHealthCheckToProto(res), nil
+}
+
+// HasDiffComputeHttpHealthCheck handles the gRPC request by passing it to the underlying HttpHealthCheck Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *HttpHealthCheckServer) HasDiffComputeHttpHealthCheck(ctx context.Context, request *computepb.HasDiffComputeHttpHealthCheckRequest) (*computepb.HasDiffComputeHttpHealthCheckResponse, error) {
+	cl, err := createConfigHttpHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHttpHealthCheck(ctx, ProtoToHttpHealthCheck(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeHttpHealthCheckResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeHttpHealthCheck handles the gRPC request by passing it to the underlying HttpHealthCheck Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *HttpHealthCheckServer) PlanComputeHttpHealthCheck(ctx context.Context, request *computepb.PlanComputeHttpHealthCheckRequest) (*computepb.PlanComputeHttpHealthCheckResponse, error) {
+	cl, err := createConfigHttpHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHttpHealthCheck(ctx, ProtoToHttpHealthCheck(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeHttpHealthCheckResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteHttpHealthCheck handles the gRPC request by passing it to the underlying HttpHealthCheck Delete() method.
 func (s *HttpHealthCheckServer) DeleteComputeHttpHealthCheck(ctx context.Context, request *computepb.DeleteComputeHttpHealthCheckRequest) (*emptypb.Empty, error) {
 
@@ -102,20 +156,32 @@ func (s *HttpHealthCheckServer) ListComputeHttpHealthCheck(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListHttpHealthCheck(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.HttpHealthCheckMaxPage
+	}
+	resources, err := cl.ListHttpHealthCheckWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeHttpHealthCheck
-	for _, r := range resources.Items {
-		rp := HttpHealthCheckToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, HttpHealthCheckToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeHttpHealthCheckResponse{Items: protos}, nil
+	return &computepb.ListComputeHttpHealthCheckResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigHttpHealthCheck(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -67,7 +68,11 @@ func HttpsHealthCheckToProto(resource *compute.HttpsHealthCheck) *computepb.Comp
 // ApplyHttpsHealthCheck handles the gRPC request by passing it to the underlying HttpsHealthCheck Apply() method.
 func (s *HttpsHealthCheckServer) applyHttpsHealthCheck(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeHttpsHealthCheckRequest) (*computepb.ComputeHttpsHealthCheck, error) {
 	p := ProtoToHttpsHealthCheck(request.GetResource())
-	res, err := c.ApplyHttpsHealthCheck(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyHttpsHealthCheck(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +89,55 @@ func (s *HttpsHealthCheckServer) ApplyComputeHttpsHealthCheck(ctx context.Contex
 	return s.applyHttpsHealthCheck(ctx, cl, request)
 }
 
+// GetComputeHttpsHealthCheck handles the gRPC request by passing it to the underlying HttpsHealthCheck Get() method.
+func (s *HttpsHealthCheckServer) GetComputeHttpsHealthCheck(ctx context.Context, request *computepb.GetComputeHttpsHealthCheckRequest) (*computepb.ComputeHttpsHealthCheck, error) {
+	cl, err := createConfigHttpsHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetHttpsHealthCheck(ctx, ProtoToHttpsHealthCheck(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return HttpsHealthCheckToProto(res), nil
+}
+
+// HasDiffComputeHttpsHealthCheck handles the gRPC request by passing it to the underlying HttpsHealthCheck Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *HttpsHealthCheckServer) HasDiffComputeHttpsHealthCheck(ctx context.Context, request *computepb.HasDiffComputeHttpsHealthCheckRequest) (*computepb.HasDiffComputeHttpsHealthCheckResponse, error) {
+	cl, err := createConfigHttpsHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHttpsHealthCheck(ctx, ProtoToHttpsHealthCheck(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeHttpsHealthCheckResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeHttpsHealthCheck handles the gRPC request by passing it to the underlying HttpsHealthCheck Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *HttpsHealthCheckServer) PlanComputeHttpsHealthCheck(ctx context.Context, request *computepb.PlanComputeHttpsHealthCheckRequest) (*computepb.PlanComputeHttpsHealthCheckResponse, error) {
+	cl, err := createConfigHttpsHealthCheck(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHttpsHealthCheck(ctx, ProtoToHttpsHealthCheck(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeHttpsHealthCheckResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteHttpsHealthCheck handles the gRPC request by passing it to the underlying HttpsHealthCheck Delete() method.
 func (s *HttpsHealthCheckServer) DeleteComputeHttpsHealthCheck(ctx context.Context, request *computepb.DeleteComputeHttpsHealthCheckRequest) (*emptypb.Empty, error) {
 
@@ -102,20 +156,32 @@ func (s *HttpsHealthCheckServer) ListComputeHttpsHealthCheck(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListHttpsHealthCheck(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.HttpsHealthCheckMaxPage
+	}
+	resources, err := cl.ListHttpsHealthCheckWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeHttpsHealthCheck
-	for _, r := range resources.Items {
-		rp := HttpsHealthCheckToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, HttpsHealthCheckToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeHttpsHealthCheckResponse{Items: protos}, nil
+	return &computepb.ListComputeHttpsHealthCheckResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigHttpsHealthCheck(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -638,7 +639,11 @@ func ImageToProto(resource *compute.Image) *computepb.ComputeImage {
 // ApplyImage handles the gRPC request by passing it to the underlying Image Apply() method.
 func (s *ImageServer) applyImage(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeImageRequest) (*computepb.ComputeImage, error) {
 	p := ProtoToImage(request.GetResource())
-	res, err := c.ApplyImage(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyImage(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -655,6 +660,55 @@ func (s *ImageServer) ApplyComputeImage(ctx context.Context, request *computepb.
 	return s.applyImage(ctx, cl, request)
 }
 
+// GetComputeImage handles the gRPC request by passing it to the underlying Image Get() method.
+func (s *ImageServer) GetComputeImage(ctx context.Context, request *computepb.GetComputeImageRequest) (*computepb.ComputeImage, error) {
+	cl, err := createConfigImage(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetImage(ctx, ProtoToImage(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ImageToProto(res), nil
+}
+
+// HasDiffComputeImage handles the gRPC request by passing it to the underlying Image Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ImageServer) HasDiffComputeImage(ctx context.Context, request *computepb.HasDiffComputeImageRequest) (*computepb.HasDiffComputeImageResponse, error) {
+	cl, err := createConfigImage(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffImage(ctx, ProtoToImage(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeImageResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeImage handles the gRPC request by passing it to the underlying Image Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ImageServer) PlanComputeImage(ctx context.Context, request *computepb.PlanComputeImageRequest) (*computepb.PlanComputeImageResponse, error) {
+	cl, err := createConfigImage(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffImage(ctx, ProtoToImage(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeImageResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteImage handles the gRPC request by passing it to the underlying Image Delete() method.
 func (s *ImageServer) DeleteComputeImage(ctx context.Context, request *computepb.DeleteComputeImageRequest) (*emptypb.Empty, error) {
 
@@ -673,20 +727,32 @@ func (s *ImageServer) ListComputeImage(ctx context.Context, request *computepb.L
 		return nil, err
 	}
 
-	resources, err := cl.ListImage(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.ImageMaxPage
+	}
+	resources, err := cl.ListImageWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeImage
-	for _, r := range resources.Items {
-		rp := ImageToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ImageToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeImageResponse{Items: protos}, nil
+	return &computepb.ListComputeImageResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigImage(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
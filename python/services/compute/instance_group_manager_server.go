@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -670,7 +671,11 @@ func InstanceGroupManagerToProto(resource *compute.InstanceGroupManager) *comput
 // applyInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Apply() method.
 func (s *InstanceGroupManagerServer) applyInstanceGroupManager(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeInstanceGroupManagerRequest) (*computepb.ComputeInstanceGroupManager, error) {
 	p := ProtoToInstanceGroupManager(request.GetResource())
-	res, err := c.ApplyInstanceGroupManager(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstanceGroupManager(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -687,6 +692,60 @@ func (s *InstanceGroupManagerServer) ApplyComputeInstanceGroupManager(ctx contex
 	return s.applyInstanceGroupManager(ctx, cl, request)
 }
 
+// GetComputeInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Get() method.
+func (s *InstanceGroupManagerServer) GetComputeInstanceGroupManager(ctx context.Context, request *computepb.GetComputeInstanceGroupManagerRequest) (*computepb.ComputeInstanceGroupManager, error) {
+	cl, err := createConfigInstanceGroupManager(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstanceGroupManager(ctx, ProtoToInstanceGroupManager(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceGroupManagerToProto(res), nil
+}
+
+// HasDiffComputeInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceGroupManagerServer) HasDiffComputeInstanceGroupManager(ctx context.Context, request *computepb.HasDiffComputeInstanceGroupManagerRequest) (*computepb.HasDiffComputeInstanceGroupManagerResponse, error) {
+	cl, err := createConfigInstanceGroupManager(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstanceGroupManager(ctx, ProtoToInstanceGroupManager(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.HasDiffComputeInstanceGroupManagerResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceGroupManagerServer) PlanComputeInstanceGroupManager(ctx context.Context, request *computepb.PlanComputeInstanceGroupManagerRequest) (*computepb.PlanComputeInstanceGroupManagerResponse, error) {
+	cl, err := createConfigInstanceGroupManager(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstanceGroupManager(ctx, ProtoToInstanceGroupManager(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.PlanComputeInstanceGroupManagerResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInstanceGroupManager handles the gRPC request by passing it to the underlying InstanceGroupManager Delete() method.
 func (s *InstanceGroupManagerServer) DeleteComputeInstanceGroupManager(ctx context.Context, request *computepb.DeleteComputeInstanceGroupManagerRequest) (*emptypb.Empty, error) {
 
@@ -705,22 +764,35 @@ func (s *InstanceGroupManagerServer) ListComputeInstanceGroupManager(ctx context
 		return nil, err
 	}
 
-	resources, err := cl.ListInstanceGroupManager(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = compute.InstanceGroupManagerMaxPage
+	}
+	resources, err := cl.ListInstanceGroupManagerWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeInstanceGroupManager
-	for _, r := range resources.Items {
-		rp := InstanceGroupManagerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceGroupManagerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &computepb.ListComputeInstanceGroupManagerResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInstanceGroupManager(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
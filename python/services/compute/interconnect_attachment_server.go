@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -291,7 +292,11 @@ func InterconnectAttachmentToProto(resource *compute.InterconnectAttachment) *co
 // applyInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Apply() method.
 func (s *InterconnectAttachmentServer) applyInterconnectAttachment(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeInterconnectAttachmentRequest) (*computepb.ComputeInterconnectAttachment, error) {
 	p := ProtoToInterconnectAttachment(request.GetResource())
-	res, err := c.ApplyInterconnectAttachment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInterconnectAttachment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -308,6 +313,60 @@ func (s *InterconnectAttachmentServer) ApplyComputeInterconnectAttachment(ctx co
 	return s.applyInterconnectAttachment(ctx, cl, request)
 }
 
+// GetComputeInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Get() method.
+func (s *InterconnectAttachmentServer) GetComputeInterconnectAttachment(ctx context.Context, request *computepb.GetComputeInterconnectAttachmentRequest) (*computepb.ComputeInterconnectAttachment, error) {
+	cl, err := createConfigInterconnectAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInterconnectAttachment(ctx, ProtoToInterconnectAttachment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InterconnectAttachmentToProto(res), nil
+}
+
+// HasDiffComputeInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InterconnectAttachmentServer) HasDiffComputeInterconnectAttachment(ctx context.Context, request *computepb.HasDiffComputeInterconnectAttachmentRequest) (*computepb.HasDiffComputeInterconnectAttachmentResponse, error) {
+	cl, err := createConfigInterconnectAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInterconnectAttachment(ctx, ProtoToInterconnectAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.HasDiffComputeInterconnectAttachmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InterconnectAttachmentServer) PlanComputeInterconnectAttachment(ctx context.Context, request *computepb.PlanComputeInterconnectAttachmentRequest) (*computepb.PlanComputeInterconnectAttachmentResponse, error) {
+	cl, err := createConfigInterconnectAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInterconnectAttachment(ctx, ProtoToInterconnectAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.PlanComputeInterconnectAttachmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInterconnectAttachment handles the gRPC request by passing it to the underlying InterconnectAttachment Delete() method.
 func (s *InterconnectAttachmentServer) DeleteComputeInterconnectAttachment(ctx context.Context, request *computepb.DeleteComputeInterconnectAttachmentRequest) (*emptypb.Empty, error) {
 
@@ -326,22 +385,35 @@ func (s *InterconnectAttachmentServer) ListComputeInterconnectAttachment(ctx con
 		return nil, err
 	}
 
-	resources, err := cl.ListInterconnectAttachment(ctx, request.GetProject(), request.GetRegion())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = compute.InterconnectAttachmentMaxPage
+	}
+	resources, err := cl.ListInterconnectAttachmentWithPageToken(ctx, request.GetProject(), request.GetRegion(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeInterconnectAttachment
-	for _, r := range resources.Items {
-		rp := InterconnectAttachmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InterconnectAttachmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &computepb.ListComputeInterconnectAttachmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInterconnectAttachment(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
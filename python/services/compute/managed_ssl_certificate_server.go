@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -168,7 +169,11 @@ func ManagedSslCertificateToProto(resource *compute.ManagedSslCertificate) *comp
 // ApplyManagedSslCertificate handles the gRPC request by passing it to the underlying ManagedSslCertificate Apply() method.
 func (s *ManagedSslCertificateServer) applyManagedSslCertificate(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeManagedSslCertificateRequest) (*computepb.ComputeManagedSslCertificate, error) {
 	p := ProtoToManagedSslCertificate(request.GetResource())
-	res, err := c.ApplyManagedSslCertificate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyManagedSslCertificate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +190,55 @@ func (s *ManagedSslCertificateServer) ApplyComputeManagedSslCertificate(ctx cont
 	return s.applyManagedSslCertificate(ctx, cl, request)
 }
 
+// GetComputeManagedSslCertificate handles the gRPC request by passing it to the underlying ManagedSslCertificate Get() method.
+func (s *ManagedSslCertificateServer) GetComputeManagedSslCertificate(ctx context.Context, request *computepb.GetComputeManagedSslCertificateRequest) (*computepb.ComputeManagedSslCertificate, error) {
+	cl, err := createConfigManagedSslCertificate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetManagedSslCertificate(ctx, ProtoToManagedSslCertificate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ManagedSslCertificateToProto(res), nil
+}
+
+// HasDiffComputeManagedSslCertificate handles the gRPC request by passing it to the underlying ManagedSslCertificate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ManagedSslCertificateServer) HasDiffComputeManagedSslCertificate(ctx context.Context, request *computepb.HasDiffComputeManagedSslCertificateRequest) (*computepb.HasDiffComputeManagedSslCertificateResponse, error) {
+	cl, err := createConfigManagedSslCertificate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffManagedSslCertificate(ctx, ProtoToManagedSslCertificate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeManagedSslCertificateResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeManagedSslCertificate handles the gRPC request by passing it to the underlying ManagedSslCertificate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ManagedSslCertificateServer) PlanComputeManagedSslCertificate(ctx context.Context, request *computepb.PlanComputeManagedSslCertificateRequest) (*computepb.PlanComputeManagedSslCertificateResponse, error) {
+	cl, err := createConfigManagedSslCertificate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffManagedSslCertificate(ctx, ProtoToManagedSslCertificate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeManagedSslCertificateResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteManagedSslCertificate handles the gRPC request by passing it to the underlying ManagedSslCertificate Delete() method.
 func (s *ManagedSslCertificateServer) DeleteComputeManagedSslCertificate(ctx context.Context, request *computepb.DeleteComputeManagedSslCertificateRequest) (*emptypb.Empty, error) {
 
@@ -203,20 +257,32 @@ func (s *ManagedSslCertificateServer) ListComputeManagedSslCertificate(ctx conte
 		return nil, err
 	}
 
-	resources, err := cl.ListManagedSslCertificate(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.ManagedSslCertificateMaxPage
+	}
+	resources, err := cl.ListManagedSslCertificateWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeManagedSslCertificate
-	for _, r := range resources.Items {
-		rp := ManagedSslCertificateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ManagedSslCertificateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeManagedSslCertificateResponse{Items: protos}, nil
+	return &computepb.ListComputeManagedSslCertificateResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigManagedSslCertificate(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -57,7 +58,11 @@ func NetworkEndpointToProto(resource *compute.NetworkEndpoint) *computepb.Comput
 // ApplyNetworkEndpoint handles the gRPC request by passing it to the underlying NetworkEndpoint Apply() method.
 func (s *NetworkEndpointServer) applyNetworkEndpoint(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeNetworkEndpointRequest) (*computepb.ComputeNetworkEndpoint, error) {
 	p := ProtoToNetworkEndpoint(request.GetResource())
-	res, err := c.ApplyNetworkEndpoint(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNetworkEndpoint(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +79,55 @@ func (s *NetworkEndpointServer) ApplyComputeNetworkEndpoint(ctx context.Context,
 	return s.applyNetworkEndpoint(ctx, cl, request)
 }
 
+// GetComputeNetworkEndpoint handles the gRPC request by passing it to the underlying NetworkEndpoint Get() method.
+func (s *NetworkEndpointServer) GetComputeNetworkEndpoint(ctx context.Context, request *computepb.GetComputeNetworkEndpointRequest) (*computepb.ComputeNetworkEndpoint, error) {
+	cl, err := createConfigNetworkEndpoint(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNetworkEndpoint(ctx, ProtoToNetworkEndpoint(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NetworkEndpointToProto(res), nil
+}
+
+// HasDiffComputeNetworkEndpoint handles the gRPC request by passing it to the underlying NetworkEndpoint Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NetworkEndpointServer) HasDiffComputeNetworkEndpoint(ctx context.Context, request *computepb.HasDiffComputeNetworkEndpointRequest) (*computepb.HasDiffComputeNetworkEndpointResponse, error) {
+	cl, err := createConfigNetworkEndpoint(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkEndpoint(ctx, ProtoToNetworkEndpoint(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeNetworkEndpointResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeNetworkEndpoint handles the gRPC request by passing it to the underlying NetworkEndpoint Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NetworkEndpointServer) PlanComputeNetworkEndpoint(ctx context.Context, request *computepb.PlanComputeNetworkEndpointRequest) (*computepb.PlanComputeNetworkEndpointResponse, error) {
+	cl, err := createConfigNetworkEndpoint(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkEndpoint(ctx, ProtoToNetworkEndpoint(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeNetworkEndpointResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteNetworkEndpoint handles the gRPC request by passing it to the underlying NetworkEndpoint Delete() method.
 func (s *NetworkEndpointServer) DeleteComputeNetworkEndpoint(ctx context.Context, request *computepb.DeleteComputeNetworkEndpointRequest) (*emptypb.Empty, error) {
 
@@ -92,20 +146,32 @@ func (s *NetworkEndpointServer) ListComputeNetworkEndpoint(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListNetworkEndpoint(ctx, request.Project, request.Location, request.Group)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.NetworkEndpointMaxPage
+	}
+	resources, err := cl.ListNetworkEndpointWithPageToken(ctx, request.Project, request.Location, request.Group, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeNetworkEndpoint
-	for _, r := range resources.Items {
-		rp := NetworkEndpointToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NetworkEndpointToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeNetworkEndpointResponse{Items: protos}, nil
+	return &computepb.ListComputeNetworkEndpointResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigNetworkEndpoint(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -53,7 +54,11 @@ func NetworkFirewallPolicyAssociationToProto(resource *compute.NetworkFirewallPo
 // applyNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Apply() method.
 func (s *NetworkFirewallPolicyAssociationServer) applyNetworkFirewallPolicyAssociation(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeNetworkFirewallPolicyAssociationRequest) (*computepb.ComputeNetworkFirewallPolicyAssociation, error) {
 	p := ProtoToNetworkFirewallPolicyAssociation(request.GetResource())
-	res, err := c.ApplyNetworkFirewallPolicyAssociation(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNetworkFirewallPolicyAssociation(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +75,60 @@ func (s *NetworkFirewallPolicyAssociationServer) ApplyComputeNetworkFirewallPoli
 	return s.applyNetworkFirewallPolicyAssociation(ctx, cl, request)
 }
 
+// GetComputeNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Get() method.
+func (s *NetworkFirewallPolicyAssociationServer) GetComputeNetworkFirewallPolicyAssociation(ctx context.Context, request *computepb.GetComputeNetworkFirewallPolicyAssociationRequest) (*computepb.ComputeNetworkFirewallPolicyAssociation, error) {
+	cl, err := createConfigNetworkFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNetworkFirewallPolicyAssociation(ctx, ProtoToNetworkFirewallPolicyAssociation(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NetworkFirewallPolicyAssociationToProto(res), nil
+}
+
+// HasDiffComputeNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NetworkFirewallPolicyAssociationServer) HasDiffComputeNetworkFirewallPolicyAssociation(ctx context.Context, request *computepb.HasDiffComputeNetworkFirewallPolicyAssociationRequest) (*computepb.HasDiffComputeNetworkFirewallPolicyAssociationResponse, error) {
+	cl, err := createConfigNetworkFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkFirewallPolicyAssociation(ctx, ProtoToNetworkFirewallPolicyAssociation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.HasDiffComputeNetworkFirewallPolicyAssociationResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NetworkFirewallPolicyAssociationServer) PlanComputeNetworkFirewallPolicyAssociation(ctx context.Context, request *computepb.PlanComputeNetworkFirewallPolicyAssociationRequest) (*computepb.PlanComputeNetworkFirewallPolicyAssociationResponse, error) {
+	cl, err := createConfigNetworkFirewallPolicyAssociation(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkFirewallPolicyAssociation(ctx, ProtoToNetworkFirewallPolicyAssociation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.PlanComputeNetworkFirewallPolicyAssociationResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNetworkFirewallPolicyAssociation handles the gRPC request by passing it to the underlying NetworkFirewallPolicyAssociation Delete() method.
 func (s *NetworkFirewallPolicyAssociationServer) DeleteComputeNetworkFirewallPolicyAssociation(ctx context.Context, request *computepb.DeleteComputeNetworkFirewallPolicyAssociationRequest) (*emptypb.Empty, error) {
 
@@ -88,22 +147,35 @@ func (s *NetworkFirewallPolicyAssociationServer) ListComputeNetworkFirewallPolic
 		return nil, err
 	}
 
-	resources, err := cl.ListNetworkFirewallPolicyAssociation(ctx, request.GetProject(), request.GetLocation(), request.GetFirewallPolicy())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = compute.NetworkFirewallPolicyAssociationMaxPage
+	}
+	resources, err := cl.ListNetworkFirewallPolicyAssociationWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetFirewallPolicy(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeNetworkFirewallPolicyAssociation
-	for _, r := range resources.Items {
-		rp := NetworkFirewallPolicyAssociationToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NetworkFirewallPolicyAssociationToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &computepb.ListComputeNetworkFirewallPolicyAssociationResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNetworkFirewallPolicyAssociation(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -63,7 +64,11 @@ func NetworkFirewallPolicyToProto(resource *compute.NetworkFirewallPolicy) *comp
 // applyNetworkFirewallPolicy handles the gRPC request by passing it to the underlying NetworkFirewallPolicy Apply() method.
 func (s *NetworkFirewallPolicyServer) applyNetworkFirewallPolicy(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeNetworkFirewallPolicyRequest) (*computepb.ComputeNetworkFirewallPolicy, error) {
 	p := ProtoToNetworkFirewallPolicy(request.GetResource())
-	res, err := c.ApplyNetworkFirewallPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNetworkFirewallPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +85,60 @@ func (s *NetworkFirewallPolicyServer) ApplyComputeNetworkFirewallPolicy(ctx cont
 	return s.applyNetworkFirewallPolicy(ctx, cl, request)
 }
 
+// GetComputeNetworkFirewallPolicy handles the gRPC request by passing it to the underlying NetworkFirewallPolicy Get() method.
+func (s *NetworkFirewallPolicyServer) GetComputeNetworkFirewallPolicy(ctx context.Context, request *computepb.GetComputeNetworkFirewallPolicyRequest) (*computepb.ComputeNetworkFirewallPolicy, error) {
+	cl, err := createConfigNetworkFirewallPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNetworkFirewallPolicy(ctx, ProtoToNetworkFirewallPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NetworkFirewallPolicyToProto(res), nil
+}
+
+// HasDiffComputeNetworkFirewallPolicy handles the gRPC request by passing it to the underlying NetworkFirewallPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NetworkFirewallPolicyServer) HasDiffComputeNetworkFirewallPolicy(ctx context.Context, request *computepb.HasDiffComputeNetworkFirewallPolicyRequest) (*computepb.HasDiffComputeNetworkFirewallPolicyResponse, error) {
+	cl, err := createConfigNetworkFirewallPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkFirewallPolicy(ctx, ProtoToNetworkFirewallPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.HasDiffComputeNetworkFirewallPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeNetworkFirewallPolicy handles the gRPC request by passing it to the underlying NetworkFirewallPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NetworkFirewallPolicyServer) PlanComputeNetworkFirewallPolicy(ctx context.Context, request *computepb.PlanComputeNetworkFirewallPolicyRequest) (*computepb.PlanComputeNetworkFirewallPolicyResponse, error) {
+	cl, err := createConfigNetworkFirewallPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNetworkFirewallPolicy(ctx, ProtoToNetworkFirewallPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.PlanComputeNetworkFirewallPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNetworkFirewallPolicy handles the gRPC request by passing it to the underlying NetworkFirewallPolicy Delete() method.
 func (s *NetworkFirewallPolicyServer) DeleteComputeNetworkFirewallPolicy(ctx context.Context, request *computepb.DeleteComputeNetworkFirewallPolicyRequest) (*emptypb.Empty, error) {
 
@@ -98,22 +157,35 @@ func (s *NetworkFirewallPolicyServer) ListComputeNetworkFirewallPolicy(ctx conte
 		return nil, err
 	}
 
-	resources, err := cl.ListNetworkFirewallPolicy(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = compute.NetworkFirewallPolicyMaxPage
+	}
+	resources, err := cl.ListNetworkFirewallPolicyWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeNetworkFirewallPolicy
-	for _, r := range resources.Items {
-		rp := NetworkFirewallPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NetworkFirewallPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &computepb.ListComputeNetworkFirewallPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNetworkFirewallPolicy(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
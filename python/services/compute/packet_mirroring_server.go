@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -284,7 +285,11 @@ func PacketMirroringToProto(resource *compute.PacketMirroring) *computepb.Comput
 // applyPacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Apply() method.
 func (s *PacketMirroringServer) applyPacketMirroring(ctx context.Context, c *compute.Client, request *computepb.ApplyComputePacketMirroringRequest) (*computepb.ComputePacketMirroring, error) {
 	p := ProtoToPacketMirroring(request.GetResource())
-	res, err := c.ApplyPacketMirroring(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyPacketMirroring(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -301,6 +306,60 @@ func (s *PacketMirroringServer) ApplyComputePacketMirroring(ctx context.Context,
 	return s.applyPacketMirroring(ctx, cl, request)
 }
 
+// GetComputePacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Get() method.
+func (s *PacketMirroringServer) GetComputePacketMirroring(ctx context.Context, request *computepb.GetComputePacketMirroringRequest) (*computepb.ComputePacketMirroring, error) {
+	cl, err := createConfigPacketMirroring(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetPacketMirroring(ctx, ProtoToPacketMirroring(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return PacketMirroringToProto(res), nil
+}
+
+// HasDiffComputePacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *PacketMirroringServer) HasDiffComputePacketMirroring(ctx context.Context, request *computepb.HasDiffComputePacketMirroringRequest) (*computepb.HasDiffComputePacketMirroringResponse, error) {
+	cl, err := createConfigPacketMirroring(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPacketMirroring(ctx, ProtoToPacketMirroring(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.HasDiffComputePacketMirroringResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputePacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *PacketMirroringServer) PlanComputePacketMirroring(ctx context.Context, request *computepb.PlanComputePacketMirroringRequest) (*computepb.PlanComputePacketMirroringResponse, error) {
+	cl, err := createConfigPacketMirroring(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPacketMirroring(ctx, ProtoToPacketMirroring(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.PlanComputePacketMirroringResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeletePacketMirroring handles the gRPC request by passing it to the underlying PacketMirroring Delete() method.
 func (s *PacketMirroringServer) DeleteComputePacketMirroring(ctx context.Context, request *computepb.DeleteComputePacketMirroringRequest) (*emptypb.Empty, error) {
 
@@ -319,22 +378,35 @@ func (s *PacketMirroringServer) ListComputePacketMirroring(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListPacketMirroring(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = compute.PacketMirroringMaxPage
+	}
+	resources, err := cl.ListPacketMirroringWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputePacketMirroring
-	for _, r := range resources.Items {
-		rp := PacketMirroringToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, PacketMirroringToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &computepb.ListComputePacketMirroringResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigPacketMirroring(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
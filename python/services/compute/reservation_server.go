@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -219,7 +220,11 @@ func ReservationToProto(resource *compute.Reservation) *computepb.ComputeReserva
 // ApplyReservation handles the gRPC request by passing it to the underlying Reservation Apply() method.
 func (s *ReservationServer) applyReservation(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeReservationRequest) (*computepb.ComputeReservation, error) {
 	p := ProtoToReservation(request.GetResource())
-	res, err := c.ApplyReservation(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyReservation(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -236,6 +241,55 @@ func (s *ReservationServer) ApplyComputeReservation(ctx context.Context, request
 	return s.applyReservation(ctx, cl, request)
 }
 
+// GetComputeReservation handles the gRPC request by passing it to the underlying Reservation Get() method.
+func (s *ReservationServer) GetComputeReservation(ctx context.Context, request *computepb.GetComputeReservationRequest) (*computepb.ComputeReservation, error) {
+	cl, err := createConfigReservation(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetReservation(ctx, ProtoToReservation(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ReservationToProto(res), nil
+}
+
+// HasDiffComputeReservation handles the gRPC request by passing it to the underlying Reservation Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ReservationServer) HasDiffComputeReservation(ctx context.Context, request *computepb.HasDiffComputeReservationRequest) (*computepb.HasDiffComputeReservationResponse, error) {
+	cl, err := createConfigReservation(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffReservation(ctx, ProtoToReservation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeReservationResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeReservation handles the gRPC request by passing it to the underlying Reservation Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ReservationServer) PlanComputeReservation(ctx context.Context, request *computepb.PlanComputeReservationRequest) (*computepb.PlanComputeReservationResponse, error) {
+	cl, err := createConfigReservation(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffReservation(ctx, ProtoToReservation(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeReservationResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteReservation handles the gRPC request by passing it to the underlying Reservation Delete() method.
 func (s *ReservationServer) DeleteComputeReservation(ctx context.Context, request *computepb.DeleteComputeReservationRequest) (*emptypb.Empty, error) {
 
@@ -254,20 +308,32 @@ func (s *ReservationServer) ListComputeReservation(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListReservation(ctx, request.Project, request.Zone)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.ReservationMaxPage
+	}
+	resources, err := cl.ListReservationWithPageToken(ctx, request.Project, request.Zone, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeReservation
-	for _, r := range resources.Items {
-		rp := ReservationToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ReservationToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeReservationResponse{Items: protos}, nil
+	return &computepb.ListComputeReservationResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigReservation(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -138,7 +139,11 @@ func RouteToProto(resource *compute.Route) *computepb.ComputeRoute {
 // applyRoute handles the gRPC request by passing it to the underlying Route Apply() method.
 func (s *RouteServer) applyRoute(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeRouteRequest) (*computepb.ComputeRoute, error) {
 	p := ProtoToRoute(request.GetResource())
-	res, err := c.ApplyRoute(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRoute(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -155,6 +160,60 @@ func (s *RouteServer) ApplyComputeRoute(ctx context.Context, request *computepb.
 	return s.applyRoute(ctx, cl, request)
 }
 
+// GetComputeRoute handles the gRPC request by passing it to the underlying Route Get() method.
+func (s *RouteServer) GetComputeRoute(ctx context.Context, request *computepb.GetComputeRouteRequest) (*computepb.ComputeRoute, error) {
+	cl, err := createConfigRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRoute(ctx, ProtoToRoute(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RouteToProto(res), nil
+}
+
+// HasDiffComputeRoute handles the gRPC request by passing it to the underlying Route Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RouteServer) HasDiffComputeRoute(ctx context.Context, request *computepb.HasDiffComputeRouteRequest) (*computepb.HasDiffComputeRouteResponse, error) {
+	cl, err := createConfigRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRoute(ctx, ProtoToRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.HasDiffComputeRouteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeRoute handles the gRPC request by passing it to the underlying Route Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RouteServer) PlanComputeRoute(ctx context.Context, request *computepb.PlanComputeRouteRequest) (*computepb.PlanComputeRouteResponse, error) {
+	cl, err := createConfigRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRoute(ctx, ProtoToRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.PlanComputeRouteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRoute handles the gRPC request by passing it to the underlying Route Delete() method.
 func (s *RouteServer) DeleteComputeRoute(ctx context.Context, request *computepb.DeleteComputeRouteRequest) (*emptypb.Empty, error) {
 
@@ -173,22 +232,35 @@ func (s *RouteServer) ListComputeRoute(ctx context.Context, request *computepb.L
 		return nil, err
 	}
 
-	resources, err := cl.ListRoute(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = compute.RouteMaxPage
+	}
+	resources, err := cl.ListRouteWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeRoute
-	for _, r := range resources.Items {
-		rp := RouteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RouteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &computepb.ListComputeRouteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRoute(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -103,7 +104,11 @@ func RouterPeerToProto(resource *compute.RouterPeer) *computepb.ComputeRouterPee
 // ApplyRouterPeer handles the gRPC request by passing it to the underlying RouterPeer Apply() method.
 func (s *RouterPeerServer) applyRouterPeer(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeRouterPeerRequest) (*computepb.ComputeRouterPeer, error) {
 	p := ProtoToRouterPeer(request.GetResource())
-	res, err := c.ApplyRouterPeer(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRouterPeer(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +125,55 @@ func (s *RouterPeerServer) ApplyComputeRouterPeer(ctx context.Context, request *
 	return s.applyRouterPeer(ctx, cl, request)
 }
 
+// GetComputeRouterPeer handles the gRPC request by passing it to the underlying RouterPeer Get() method.
+func (s *RouterPeerServer) GetComputeRouterPeer(ctx context.Context, request *computepb.GetComputeRouterPeerRequest) (*computepb.ComputeRouterPeer, error) {
+	cl, err := createConfigRouterPeer(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRouterPeer(ctx, ProtoToRouterPeer(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RouterPeerToProto(res), nil
+}
+
+// HasDiffComputeRouterPeer handles the gRPC request by passing it to the underlying RouterPeer Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RouterPeerServer) HasDiffComputeRouterPeer(ctx context.Context, request *computepb.HasDiffComputeRouterPeerRequest) (*computepb.HasDiffComputeRouterPeerResponse, error) {
+	cl, err := createConfigRouterPeer(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRouterPeer(ctx, ProtoToRouterPeer(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeRouterPeerResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeRouterPeer handles the gRPC request by passing it to the underlying RouterPeer Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RouterPeerServer) PlanComputeRouterPeer(ctx context.Context, request *computepb.PlanComputeRouterPeerRequest) (*computepb.PlanComputeRouterPeerResponse, error) {
+	cl, err := createConfigRouterPeer(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRouterPeer(ctx, ProtoToRouterPeer(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeRouterPeerResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteRouterPeer handles the gRPC request by passing it to the underlying RouterPeer Delete() method.
 func (s *RouterPeerServer) DeleteComputeRouterPeer(ctx context.Context, request *computepb.DeleteComputeRouterPeerRequest) (*emptypb.Empty, error) {
 
@@ -138,20 +192,32 @@ func (s *RouterPeerServer) ListComputeRouterPeer(ctx context.Context, request *c
 		return nil, err
 	}
 
-	resources, err := cl.ListRouterPeer(ctx, request.Project, request.Region)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.RouterPeerMaxPage
+	}
+	resources, err := cl.ListRouterPeerWithPageToken(ctx, request.Project, request.Region, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeRouterPeer
-	for _, r := range resources.Items {
-		rp := RouterPeerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RouterPeerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeRouterPeerResponse{Items: protos}, nil
+	return &computepb.ListComputeRouterPeerResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigRouterPeer(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -485,7 +486,11 @@ func RouterToProto(resource *compute.Router) *computepb.ComputeRouter {
 // ApplyRouter handles the gRPC request by passing it to the underlying Router Apply() method.
 func (s *RouterServer) applyRouter(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeRouterRequest) (*computepb.ComputeRouter, error) {
 	p := ProtoToRouter(request.GetResource())
-	res, err := c.ApplyRouter(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRouter(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -502,6 +507,55 @@ func (s *RouterServer) ApplyComputeRouter(ctx context.Context, request *computep
 	return s.applyRouter(ctx, cl, request)
 }
 
+// GetComputeRouter handles the gRPC request by passing it to the underlying Router Get() method.
+func (s *RouterServer) GetComputeRouter(ctx context.Context, request *computepb.GetComputeRouterRequest) (*computepb.ComputeRouter, error) {
+	cl, err := createConfigRouter(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRouter(ctx, ProtoToRouter(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RouterToProto(res), nil
+}
+
+// HasDiffComputeRouter handles the gRPC request by passing it to the underlying Router Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RouterServer) HasDiffComputeRouter(ctx context.Context, request *computepb.HasDiffComputeRouterRequest) (*computepb.HasDiffComputeRouterResponse, error) {
+	cl, err := createConfigRouter(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRouter(ctx, ProtoToRouter(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeRouterResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeRouter handles the gRPC request by passing it to the underlying Router Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RouterServer) PlanComputeRouter(ctx context.Context, request *computepb.PlanComputeRouterRequest) (*computepb.PlanComputeRouterResponse, error) {
+	cl, err := createConfigRouter(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRouter(ctx, ProtoToRouter(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeRouterResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteRouter handles the gRPC request by passing it to the underlying Router Delete() method.
 func (s *RouterServer) DeleteComputeRouter(ctx context.Context, request *computepb.DeleteComputeRouterRequest) (*emptypb.Empty, error) {
 
@@ -520,20 +574,32 @@ func (s *RouterServer) ListComputeRouter(ctx context.Context, request *computepb
 		return nil, err
 	}
 
-	resources, err := cl.ListRouter(ctx, request.Project, request.Region)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.RouterMaxPage
+	}
+	resources, err := cl.ListRouterWithPageToken(ctx, request.Project, request.Region, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeRouter
-	for _, r := range resources.Items {
-		rp := RouterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RouterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeRouterResponse{Items: protos}, nil
+	return &computepb.ListComputeRouterResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigRouter(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -214,7 +215,11 @@ func ServiceAttachmentToProto(resource *compute.ServiceAttachment) *computepb.Co
 // applyServiceAttachment handles the gRPC request by passing it to the underlying ServiceAttachment Apply() method.
 func (s *ServiceAttachmentServer) applyServiceAttachment(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeServiceAttachmentRequest) (*computepb.ComputeServiceAttachment, error) {
 	p := ProtoToServiceAttachment(request.GetResource())
-	res, err := c.ApplyServiceAttachment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyServiceAttachment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +236,60 @@ func (s *ServiceAttachmentServer) ApplyComputeServiceAttachment(ctx context.Cont
 	return s.applyServiceAttachment(ctx, cl, request)
 }
 
+// GetComputeServiceAttachment handles the gRPC request by passing it to the underlying ServiceAttachment Get() method.
+func (s *ServiceAttachmentServer) GetComputeServiceAttachment(ctx context.Context, request *computepb.GetComputeServiceAttachmentRequest) (*computepb.ComputeServiceAttachment, error) {
+	cl, err := createConfigServiceAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetServiceAttachment(ctx, ProtoToServiceAttachment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceAttachmentToProto(res), nil
+}
+
+// HasDiffComputeServiceAttachment handles the gRPC request by passing it to the underlying ServiceAttachment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceAttachmentServer) HasDiffComputeServiceAttachment(ctx context.Context, request *computepb.HasDiffComputeServiceAttachmentRequest) (*computepb.HasDiffComputeServiceAttachmentResponse, error) {
+	cl, err := createConfigServiceAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceAttachment(ctx, ProtoToServiceAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.HasDiffComputeServiceAttachmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeServiceAttachment handles the gRPC request by passing it to the underlying ServiceAttachment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceAttachmentServer) PlanComputeServiceAttachment(ctx context.Context, request *computepb.PlanComputeServiceAttachmentRequest) (*computepb.PlanComputeServiceAttachmentResponse, error) {
+	cl, err := createConfigServiceAttachment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceAttachment(ctx, ProtoToServiceAttachment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.PlanComputeServiceAttachmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteServiceAttachment handles the gRPC request by passing it to the underlying ServiceAttachment Delete() method.
 func (s *ServiceAttachmentServer) DeleteComputeServiceAttachment(ctx context.Context, request *computepb.DeleteComputeServiceAttachmentRequest) (*emptypb.Empty, error) {
 
@@ -249,22 +308,35 @@ func (s *ServiceAttachmentServer) ListComputeServiceAttachment(ctx context.Conte
 		return nil, err
 	}
 
-	resources, err := cl.ListServiceAttachment(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = compute.ServiceAttachmentMaxPage
+	}
+	resources, err := cl.ListServiceAttachmentWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeServiceAttachment
-	for _, r := range resources.Items {
-		rp := ServiceAttachmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceAttachmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &computepb.ListComputeServiceAttachmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigServiceAttachment(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -117,7 +118,11 @@ func SnapshotToProto(resource *compute.Snapshot) *computepb.ComputeSnapshot {
 // ApplySnapshot handles the gRPC request by passing it to the underlying Snapshot Apply() method.
 func (s *SnapshotServer) applySnapshot(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeSnapshotRequest) (*computepb.ComputeSnapshot, error) {
 	p := ProtoToSnapshot(request.GetResource())
-	res, err := c.ApplySnapshot(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySnapshot(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -134,6 +139,55 @@ func (s *SnapshotServer) ApplyComputeSnapshot(ctx context.Context, request *comp
 	return s.applySnapshot(ctx, cl, request)
 }
 
+// GetComputeSnapshot handles the gRPC request by passing it to the underlying Snapshot Get() method.
+func (s *SnapshotServer) GetComputeSnapshot(ctx context.Context, request *computepb.GetComputeSnapshotRequest) (*computepb.ComputeSnapshot, error) {
+	cl, err := createConfigSnapshot(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSnapshot(ctx, ProtoToSnapshot(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SnapshotToProto(res), nil
+}
+
+// HasDiffComputeSnapshot handles the gRPC request by passing it to the underlying Snapshot Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SnapshotServer) HasDiffComputeSnapshot(ctx context.Context, request *computepb.HasDiffComputeSnapshotRequest) (*computepb.HasDiffComputeSnapshotResponse, error) {
+	cl, err := createConfigSnapshot(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSnapshot(ctx, ProtoToSnapshot(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeSnapshotResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeSnapshot handles the gRPC request by passing it to the underlying Snapshot Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SnapshotServer) PlanComputeSnapshot(ctx context.Context, request *computepb.PlanComputeSnapshotRequest) (*computepb.PlanComputeSnapshotResponse, error) {
+	cl, err := createConfigSnapshot(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSnapshot(ctx, ProtoToSnapshot(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeSnapshotResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteSnapshot handles the gRPC request by passing it to the underlying Snapshot Delete() method.
 func (s *SnapshotServer) DeleteComputeSnapshot(ctx context.Context, request *computepb.DeleteComputeSnapshotRequest) (*emptypb.Empty, error) {
 
@@ -152,20 +206,32 @@ func (s *SnapshotServer) ListComputeSnapshot(ctx context.Context, request *compu
 		return nil, err
 	}
 
-	resources, err := cl.ListSnapshot(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.SnapshotMaxPage
+	}
+	resources, err := cl.ListSnapshotWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeSnapshot
-	for _, r := range resources.Items {
-		rp := SnapshotToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SnapshotToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeSnapshotResponse{Items: protos}, nil
+	return &computepb.ListComputeSnapshotResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigSnapshot(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -118,7 +119,11 @@ func SslCertificateToProto(resource *compute.SslCertificate) *computepb.ComputeS
 // ApplySslCertificate handles the gRPC request by passing it to the underlying SslCertificate Apply() method.
 func (s *SslCertificateServer) applySslCertificate(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeSslCertificateRequest) (*computepb.ComputeSslCertificate, error) {
 	p := ProtoToSslCertificate(request.GetResource())
-	res, err := c.ApplySslCertificate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySslCertificate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +140,55 @@ func (s *SslCertificateServer) ApplyComputeSslCertificate(ctx context.Context, r
 	return s.applySslCertificate(ctx, cl, request)
 }
 
+// GetComputeSslCertificate handles the gRPC request by passing it to the underlying SslCertificate Get() method.
+func (s *SslCertificateServer) GetComputeSslCertificate(ctx context.Context, request *computepb.GetComputeSslCertificateRequest) (*computepb.ComputeSslCertificate, error) {
+	cl, err := createConfigSslCertificate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSslCertificate(ctx, ProtoToSslCertificate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SslCertificateToProto(res), nil
+}
+
+// HasDiffComputeSslCertificate handles the gRPC request by passing it to the underlying SslCertificate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SslCertificateServer) HasDiffComputeSslCertificate(ctx context.Context, request *computepb.HasDiffComputeSslCertificateRequest) (*computepb.HasDiffComputeSslCertificateResponse, error) {
+	cl, err := createConfigSslCertificate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSslCertificate(ctx, ProtoToSslCertificate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeSslCertificateResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeSslCertificate handles the gRPC request by passing it to the underlying SslCertificate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SslCertificateServer) PlanComputeSslCertificate(ctx context.Context, request *computepb.PlanComputeSslCertificateRequest) (*computepb.PlanComputeSslCertificateResponse, error) {
+	cl, err := createConfigSslCertificate(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSslCertificate(ctx, ProtoToSslCertificate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeSslCertificateResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteSslCertificate handles the gRPC request by passing it to the underlying SslCertificate Delete() method.
 func (s *SslCertificateServer) DeleteComputeSslCertificate(ctx context.Context, request *computepb.DeleteComputeSslCertificateRequest) (*emptypb.Empty, error) {
 
@@ -153,20 +207,32 @@ func (s *SslCertificateServer) ListComputeSslCertificate(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListSslCertificate(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.SslCertificateMaxPage
+	}
+	resources, err := cl.ListSslCertificateWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeSslCertificate
-	for _, r := range resources.Items {
-		rp := SslCertificateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SslCertificateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeSslCertificateResponse{Items: protos}, nil
+	return &computepb.ListComputeSslCertificateResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigSslCertificate(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -175,7 +176,11 @@ func SslPolicyToProto(resource *compute.SslPolicy) *computepb.ComputeSslPolicy {
 // ApplySslPolicy handles the gRPC request by passing it to the underlying SslPolicy Apply() method.
 func (s *SslPolicyServer) applySslPolicy(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeSslPolicyRequest) (*computepb.ComputeSslPolicy, error) {
 	p := ProtoToSslPolicy(request.GetResource())
-	res, err := c.ApplySslPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySslPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +197,55 @@ func (s *SslPolicyServer) ApplyComputeSslPolicy(ctx context.Context, request *co
 	return s.applySslPolicy(ctx, cl, request)
 }
 
+// GetComputeSslPolicy handles the gRPC request by passing it to the underlying SslPolicy Get() method.
+func (s *SslPolicyServer) GetComputeSslPolicy(ctx context.Context, request *computepb.GetComputeSslPolicyRequest) (*computepb.ComputeSslPolicy, error) {
+	cl, err := createConfigSslPolicy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSslPolicy(ctx, ProtoToSslPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SslPolicyToProto(res), nil
+}
+
+// HasDiffComputeSslPolicy handles the gRPC request by passing it to the underlying SslPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SslPolicyServer) HasDiffComputeSslPolicy(ctx context.Context, request *computepb.HasDiffComputeSslPolicyRequest) (*computepb.HasDiffComputeSslPolicyResponse, error) {
+	cl, err := createConfigSslPolicy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSslPolicy(ctx, ProtoToSslPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeSslPolicyResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeSslPolicy handles the gRPC request by passing it to the underlying SslPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SslPolicyServer) PlanComputeSslPolicy(ctx context.Context, request *computepb.PlanComputeSslPolicyRequest) (*computepb.PlanComputeSslPolicyResponse, error) {
+	cl, err := createConfigSslPolicy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSslPolicy(ctx, ProtoToSslPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeSslPolicyResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteSslPolicy handles the gRPC request by passing it to the underlying SslPolicy Delete() method.
 func (s *SslPolicyServer) DeleteComputeSslPolicy(ctx context.Context, request *computepb.DeleteComputeSslPolicyRequest) (*emptypb.Empty, error) {
 
@@ -210,20 +264,32 @@ func (s *SslPolicyServer) ListComputeSslPolicy(ctx context.Context, request *com
 		return nil, err
 	}
 
-	resources, err := cl.ListSslPolicy(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.SslPolicyMaxPage
+	}
+	resources, err := cl.ListSslPolicyWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeSslPolicy
-	for _, r := range resources.Items {
-		rp := SslPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SslPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeSslPolicyResponse{Items: protos}, nil
+	return &computepb.ListComputeSslPolicyResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigSslPolicy(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -219,7 +220,11 @@ func SubnetworkToProto(resource *compute.Subnetwork) *computepb.ComputeSubnetwor
 // applySubnetwork handles the gRPC request by passing it to the underlying Subnetwork Apply() method.
 func (s *SubnetworkServer) applySubnetwork(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeSubnetworkRequest) (*computepb.ComputeSubnetwork, error) {
 	p := ProtoToSubnetwork(request.GetResource())
-	res, err := c.ApplySubnetwork(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySubnetwork(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -236,6 +241,60 @@ func (s *SubnetworkServer) ApplyComputeSubnetwork(ctx context.Context, request *
 	return s.applySubnetwork(ctx, cl, request)
 }
 
+// GetComputeSubnetwork handles the gRPC request by passing it to the underlying Subnetwork Get() method.
+func (s *SubnetworkServer) GetComputeSubnetwork(ctx context.Context, request *computepb.GetComputeSubnetworkRequest) (*computepb.ComputeSubnetwork, error) {
+	cl, err := createConfigSubnetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSubnetwork(ctx, ProtoToSubnetwork(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SubnetworkToProto(res), nil
+}
+
+// HasDiffComputeSubnetwork handles the gRPC request by passing it to the underlying Subnetwork Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SubnetworkServer) HasDiffComputeSubnetwork(ctx context.Context, request *computepb.HasDiffComputeSubnetworkRequest) (*computepb.HasDiffComputeSubnetworkResponse, error) {
+	cl, err := createConfigSubnetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSubnetwork(ctx, ProtoToSubnetwork(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.HasDiffComputeSubnetworkResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeSubnetwork handles the gRPC request by passing it to the underlying Subnetwork Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SubnetworkServer) PlanComputeSubnetwork(ctx context.Context, request *computepb.PlanComputeSubnetworkRequest) (*computepb.PlanComputeSubnetworkResponse, error) {
+	cl, err := createConfigSubnetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSubnetwork(ctx, ProtoToSubnetwork(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.PlanComputeSubnetworkResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteSubnetwork handles the gRPC request by passing it to the underlying Subnetwork Delete() method.
 func (s *SubnetworkServer) DeleteComputeSubnetwork(ctx context.Context, request *computepb.DeleteComputeSubnetworkRequest) (*emptypb.Empty, error) {
 
@@ -254,22 +313,35 @@ func (s *SubnetworkServer) ListComputeSubnetwork(ctx context.Context, request *c
 		return nil, err
 	}
 
-	resources, err := cl.ListSubnetwork(ctx, request.GetProject(), request.GetRegion())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = compute.SubnetworkMaxPage
+	}
+	resources, err := cl.ListSubnetworkWithPageToken(ctx, request.GetProject(), request.GetRegion(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeSubnetwork
-	for _, r := range resources.Items {
-		rp := SubnetworkToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SubnetworkToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &computepb.ListComputeSubnetworkResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigSubnetwork(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
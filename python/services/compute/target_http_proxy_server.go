@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -55,7 +56,11 @@ func TargetHttpProxyToProto(resource *compute.TargetHttpProxy) *computepb.Comput
 // ApplyTargetHttpProxy handles the gRPC request by passing it to the underlying TargetHttpProxy Apply() method.
 func (s *TargetHttpProxyServer) applyTargetHttpProxy(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeTargetHttpProxyRequest) (*computepb.ComputeTargetHttpProxy, error) {
 	p := ProtoToTargetHttpProxy(request.GetResource())
-	res, err := c.ApplyTargetHttpProxy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTargetHttpProxy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,55 @@ func (s *TargetHttpProxyServer) ApplyComputeTargetHttpProxy(ctx context.Context,
 	return s.applyTargetHttpProxy(ctx, cl, request)
 }
 
+// GetComputeTargetHttpProxy handles the gRPC request by passing it to the underlying TargetHttpProxy Get() method.
+func (s *TargetHttpProxyServer) GetComputeTargetHttpProxy(ctx context.Context, request *computepb.GetComputeTargetHttpProxyRequest) (*computepb.ComputeTargetHttpProxy, error) {
+	cl, err := createConfigTargetHttpProxy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTargetHttpProxy(ctx, ProtoToTargetHttpProxy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TargetHttpProxyToProto(res), nil
+}
+
+// HasDiffComputeTargetHttpProxy handles the gRPC request by passing it to the underlying TargetHttpProxy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TargetHttpProxyServer) HasDiffComputeTargetHttpProxy(ctx context.Context, request *computepb.HasDiffComputeTargetHttpProxyRequest) (*computepb.HasDiffComputeTargetHttpProxyResponse, error) {
+	cl, err := createConfigTargetHttpProxy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTargetHttpProxy(ctx, ProtoToTargetHttpProxy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeTargetHttpProxyResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeTargetHttpProxy handles the gRPC request by passing it to the underlying TargetHttpProxy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TargetHttpProxyServer) PlanComputeTargetHttpProxy(ctx context.Context, request *computepb.PlanComputeTargetHttpProxyRequest) (*computepb.PlanComputeTargetHttpProxyResponse, error) {
+	cl, err := createConfigTargetHttpProxy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTargetHttpProxy(ctx, ProtoToTargetHttpProxy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeTargetHttpProxyResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteTargetHttpProxy handles the gRPC request by passing it to the underlying TargetHttpProxy Delete() method.
 func (s *TargetHttpProxyServer) DeleteComputeTargetHttpProxy(ctx context.Context, request *computepb.DeleteComputeTargetHttpProxyRequest) (*emptypb.Empty, error) {
 
@@ -90,20 +144,32 @@ func (s *TargetHttpProxyServer) ListComputeTargetHttpProxy(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListTargetHttpProxy(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.TargetHttpProxyMaxPage
+	}
+	resources, err := cl.ListTargetHttpProxyWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeTargetHttpProxy
-	for _, r := range resources.Items {
-		rp := TargetHttpProxyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TargetHttpProxyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeTargetHttpProxyResponse{Items: protos}, nil
+	return &computepb.ListComputeTargetHttpProxyResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigTargetHttpProxy(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -94,7 +95,11 @@ func TargetPoolToProto(resource *compute.TargetPool) *computepb.ComputeTargetPoo
 // ApplyTargetPool handles the gRPC request by passing it to the underlying TargetPool Apply() method.
 func (s *TargetPoolServer) applyTargetPool(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeTargetPoolRequest) (*computepb.ComputeTargetPool, error) {
 	p := ProtoToTargetPool(request.GetResource())
-	res, err := c.ApplyTargetPool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTargetPool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +116,55 @@ func (s *TargetPoolServer) ApplyComputeTargetPool(ctx context.Context, request *
 	return s.applyTargetPool(ctx, cl, request)
 }
 
+// GetComputeTargetPool handles the gRPC request by passing it to the underlying TargetPool Get() method.
+func (s *TargetPoolServer) GetComputeTargetPool(ctx context.Context, request *computepb.GetComputeTargetPoolRequest) (*computepb.ComputeTargetPool, error) {
+	cl, err := createConfigTargetPool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTargetPool(ctx, ProtoToTargetPool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TargetPoolToProto(res), nil
+}
+
+// HasDiffComputeTargetPool handles the gRPC request by passing it to the underlying TargetPool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TargetPoolServer) HasDiffComputeTargetPool(ctx context.Context, request *computepb.HasDiffComputeTargetPoolRequest) (*computepb.HasDiffComputeTargetPoolResponse, error) {
+	cl, err := createConfigTargetPool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTargetPool(ctx, ProtoToTargetPool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeTargetPoolResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeTargetPool handles the gRPC request by passing it to the underlying TargetPool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TargetPoolServer) PlanComputeTargetPool(ctx context.Context, request *computepb.PlanComputeTargetPoolRequest) (*computepb.PlanComputeTargetPoolResponse, error) {
+	cl, err := createConfigTargetPool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTargetPool(ctx, ProtoToTargetPool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeTargetPoolResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteTargetPool handles the gRPC request by passing it to the underlying TargetPool Delete() method.
 func (s *TargetPoolServer) DeleteComputeTargetPool(ctx context.Context, request *computepb.DeleteComputeTargetPoolRequest) (*emptypb.Empty, error) {
 
@@ -129,20 +183,32 @@ func (s *TargetPoolServer) ListComputeTargetPool(ctx context.Context, request *c
 		return nil, err
 	}
 
-	resources, err := cl.ListTargetPool(ctx, request.Project, request.Region)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.TargetPoolMaxPage
+	}
+	resources, err := cl.ListTargetPoolWithPageToken(ctx, request.Project, request.Region, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeTargetPool
-	for _, r := range resources.Items {
-		rp := TargetPoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TargetPoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeTargetPoolResponse{Items: protos}, nil
+	return &computepb.ListComputeTargetPoolResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigTargetPool(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
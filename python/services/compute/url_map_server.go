@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -1794,7 +1795,11 @@ func UrlMapToProto(resource *compute.UrlMap) *computepb.ComputeUrlMap {
 // ApplyUrlMap handles the gRPC request by passing it to the underlying UrlMap Apply() method.
 func (s *UrlMapServer) applyUrlMap(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeUrlMapRequest) (*computepb.ComputeUrlMap, error) {
 	p := ProtoToUrlMap(request.GetResource())
-	res, err := c.ApplyUrlMap(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyUrlMap(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1811,6 +1816,55 @@ func (s *UrlMapServer) ApplyComputeUrlMap(ctx context.Context, request *computep
 	return s.applyUrlMap(ctx, cl, request)
 }
 
+// GetComputeUrlMap handles the gRPC request by passing it to the underlying UrlMap Get() method.
+func (s *UrlMapServer) GetComputeUrlMap(ctx context.Context, request *computepb.GetComputeUrlMapRequest) (*computepb.ComputeUrlMap, error) {
+	cl, err := createConfigUrlMap(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetUrlMap(ctx, ProtoToUrlMap(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return UrlMapToProto(res), nil
+}
+
+// HasDiffComputeUrlMap handles the gRPC request by passing it to the underlying UrlMap Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *UrlMapServer) HasDiffComputeUrlMap(ctx context.Context, request *computepb.HasDiffComputeUrlMapRequest) (*computepb.HasDiffComputeUrlMapResponse, error) {
+	cl, err := createConfigUrlMap(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUrlMap(ctx, ProtoToUrlMap(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeUrlMapResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeUrlMap handles the gRPC request by passing it to the underlying UrlMap Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *UrlMapServer) PlanComputeUrlMap(ctx context.Context, request *computepb.PlanComputeUrlMapRequest) (*computepb.PlanComputeUrlMapResponse, error) {
+	cl, err := createConfigUrlMap(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUrlMap(ctx, ProtoToUrlMap(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeUrlMapResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteUrlMap handles the gRPC request by passing it to the underlying UrlMap Delete() method.
 func (s *UrlMapServer) DeleteComputeUrlMap(ctx context.Context, request *computepb.DeleteComputeUrlMapRequest) (*emptypb.Empty, error) {
 
@@ -1829,20 +1883,32 @@ func (s *UrlMapServer) ListComputeUrlMap(ctx context.Context, request *computepb
 		return nil, err
 	}
 
-	resources, err := cl.ListUrlMap(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.UrlMapMaxPage
+	}
+	resources, err := cl.ListUrlMapWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeUrlMap
-	for _, r := range resources.Items {
-		rp := UrlMapToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, UrlMapToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeUrlMapResponse{Items: protos}, nil
+	return &computepb.ListComputeUrlMapResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigUrlMap(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -87,7 +88,11 @@ func VpnGatewayToProto(resource *compute.VpnGateway) *computepb.ComputeVpnGatewa
 // ApplyVpnGateway handles the gRPC request by passing it to the underlying VpnGateway Apply() method.
 func (s *VpnGatewayServer) applyVpnGateway(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeVpnGatewayRequest) (*computepb.ComputeVpnGateway, error) {
 	p := ProtoToVpnGateway(request.GetResource())
-	res, err := c.ApplyVpnGateway(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyVpnGateway(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +109,55 @@ func (s *VpnGatewayServer) ApplyComputeVpnGateway(ctx context.Context, request *
 	return s.applyVpnGateway(ctx, cl, request)
 }
 
+// GetComputeVpnGateway handles the gRPC request by passing it to the underlying VpnGateway Get() method.
+func (s *VpnGatewayServer) GetComputeVpnGateway(ctx context.Context, request *computepb.GetComputeVpnGatewayRequest) (*computepb.ComputeVpnGateway, error) {
+	cl, err := createConfigVpnGateway(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetVpnGateway(ctx, ProtoToVpnGateway(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return VpnGatewayToProto(res), nil
+}
+
+// HasDiffComputeVpnGateway handles the gRPC request by passing it to the underlying VpnGateway Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *VpnGatewayServer) HasDiffComputeVpnGateway(ctx context.Context, request *computepb.HasDiffComputeVpnGatewayRequest) (*computepb.HasDiffComputeVpnGatewayResponse, error) {
+	cl, err := createConfigVpnGateway(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVpnGateway(ctx, ProtoToVpnGateway(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.HasDiffComputeVpnGatewayResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanComputeVpnGateway handles the gRPC request by passing it to the underlying VpnGateway Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *VpnGatewayServer) PlanComputeVpnGateway(ctx context.Context, request *computepb.PlanComputeVpnGatewayRequest) (*computepb.PlanComputeVpnGatewayResponse, error) {
+	cl, err := createConfigVpnGateway(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVpnGateway(ctx, ProtoToVpnGateway(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &computepb.PlanComputeVpnGatewayResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteVpnGateway handles the gRPC request by passing it to the underlying VpnGateway Delete() method.
 func (s *VpnGatewayServer) DeleteComputeVpnGateway(ctx context.Context, request *computepb.DeleteComputeVpnGatewayRequest) (*emptypb.Empty, error) {
 
@@ -122,20 +176,32 @@ func (s *VpnGatewayServer) ListComputeVpnGateway(ctx context.Context, request *c
 		return nil, err
 	}
 
-	resources, err := cl.ListVpnGateway(ctx, request.Project, request.Region)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = compute.VpnGatewayMaxPage
+	}
+	resources, err := cl.ListVpnGatewayWithPageToken(ctx, request.Project, request.Region, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeVpnGateway
-	for _, r := range resources.Items {
-		rp := VpnGatewayToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, VpnGatewayToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &computepb.ListComputeVpnGatewayResponse{Items: protos}, nil
+	return &computepb.ListComputeVpnGatewayResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigVpnGateway(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	computepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/compute/compute_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/compute"
@@ -118,7 +119,11 @@ func VpnTunnelToProto(resource *compute.VpnTunnel) *computepb.ComputeVpnTunnel {
 // applyVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Apply() method.
 func (s *VpnTunnelServer) applyVpnTunnel(ctx context.Context, c *compute.Client, request *computepb.ApplyComputeVpnTunnelRequest) (*computepb.ComputeVpnTunnel, error) {
 	p := ProtoToVpnTunnel(request.GetResource())
-	res, err := c.ApplyVpnTunnel(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyVpnTunnel(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +140,60 @@ func (s *VpnTunnelServer) ApplyComputeVpnTunnel(ctx context.Context, request *co
 	return s.applyVpnTunnel(ctx, cl, request)
 }
 
+// GetComputeVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Get() method.
+func (s *VpnTunnelServer) GetComputeVpnTunnel(ctx context.Context, request *computepb.GetComputeVpnTunnelRequest) (*computepb.ComputeVpnTunnel, error) {
+	cl, err := createConfigVpnTunnel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetVpnTunnel(ctx, ProtoToVpnTunnel(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return VpnTunnelToProto(res), nil
+}
+
+// HasDiffComputeVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *VpnTunnelServer) HasDiffComputeVpnTunnel(ctx context.Context, request *computepb.HasDiffComputeVpnTunnelRequest) (*computepb.HasDiffComputeVpnTunnelResponse, error) {
+	cl, err := createConfigVpnTunnel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVpnTunnel(ctx, ProtoToVpnTunnel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.HasDiffComputeVpnTunnelResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanComputeVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *VpnTunnelServer) PlanComputeVpnTunnel(ctx context.Context, request *computepb.PlanComputeVpnTunnelRequest) (*computepb.PlanComputeVpnTunnelResponse, error) {
+	cl, err := createConfigVpnTunnel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVpnTunnel(ctx, ProtoToVpnTunnel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &computepb.PlanComputeVpnTunnelResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteVpnTunnel handles the gRPC request by passing it to the underlying VpnTunnel Delete() method.
 func (s *VpnTunnelServer) DeleteComputeVpnTunnel(ctx context.Context, request *computepb.DeleteComputeVpnTunnelRequest) (*emptypb.Empty, error) {
 
@@ -153,22 +212,35 @@ func (s *VpnTunnelServer) ListComputeVpnTunnel(ctx context.Context, request *com
 		return nil, err
 	}
 
-	resources, err := cl.ListVpnTunnel(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = compute.VpnTunnelMaxPage
+	}
+	resources, err := cl.ListVpnTunnelWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*computepb.ComputeVpnTunnel
-	for _, r := range resources.Items {
-		rp := VpnTunnelToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, VpnTunnelToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &computepb.ListComputeVpnTunnelResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigVpnTunnel(ctx context.Context, service_account_file string) (*compute.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return compute.NewClient(conf), nil
 }
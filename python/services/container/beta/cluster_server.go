@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/container/beta/container_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/container/beta"
@@ -2932,7 +2933,11 @@ func ClusterToProto(resource *beta.Cluster) *betapb.ContainerBetaCluster {
 // ApplyCluster handles the gRPC request by passing it to the underlying Cluster Apply() method.
 func (s *ClusterServer) applyCluster(ctx context.Context, c *beta.Client, request *betapb.ApplyContainerBetaClusterRequest) (*betapb.ContainerBetaCluster, error) {
 	p := ProtoToCluster(request.GetResource())
-	res, err := c.ApplyCluster(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCluster(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2949,6 +2954,55 @@ func (s *ClusterServer) ApplyContainerBetaCluster(ctx context.Context, request *
 	return s.applyCluster(ctx, cl, request)
 }
 
+// GetContainerBetaCluster handles the gRPC request by passing it to the underlying Cluster Get() method.
+func (s *ClusterServer) GetContainerBetaCluster(ctx context.Context, request *betapb.GetContainerBetaClusterRequest) (*betapb.ContainerBetaCluster, error) {
+	cl, err := createConfigCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCluster(ctx, ProtoToCluster(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ClusterToProto(res), nil
+}
+
+// HasDiffContainerBetaCluster handles the gRPC request by passing it to the underlying Cluster Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ClusterServer) HasDiffContainerBetaCluster(ctx context.Context, request *betapb.HasDiffContainerBetaClusterRequest) (*betapb.HasDiffContainerBetaClusterResponse, error) {
+	cl, err := createConfigCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCluster(ctx, ProtoToCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffContainerBetaClusterResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanContainerBetaCluster handles the gRPC request by passing it to the underlying Cluster Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ClusterServer) PlanContainerBetaCluster(ctx context.Context, request *betapb.PlanContainerBetaClusterRequest) (*betapb.PlanContainerBetaClusterResponse, error) {
+	cl, err := createConfigCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCluster(ctx, ProtoToCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanContainerBetaClusterResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteCluster handles the gRPC request by passing it to the underlying Cluster Delete() method.
 func (s *ClusterServer) DeleteContainerBetaCluster(ctx context.Context, request *betapb.DeleteContainerBetaClusterRequest) (*emptypb.Empty, error) {
 
@@ -2967,20 +3021,32 @@ func (s *ClusterServer) ListContainerBetaCluster(ctx context.Context, request *b
 		return nil, err
 	}
 
-	resources, err := cl.ListCluster(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.ClusterMaxPage
+	}
+	resources, err := cl.ListClusterWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ContainerBetaCluster
-	for _, r := range resources.Items {
-		rp := ClusterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ClusterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListContainerBetaClusterResponse{Items: protos}, nil
+	return &betapb.ListContainerBetaClusterResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigCluster(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
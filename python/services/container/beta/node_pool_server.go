@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/container/beta/container_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/container/beta"
@@ -500,7 +501,11 @@ func NodePoolToProto(resource *beta.NodePool) *betapb.ContainerBetaNodePool {
 // ApplyNodePool handles the gRPC request by passing it to the underlying NodePool Apply() method.
 func (s *NodePoolServer) applyNodePool(ctx context.Context, c *beta.Client, request *betapb.ApplyContainerBetaNodePoolRequest) (*betapb.ContainerBetaNodePool, error) {
 	p := ProtoToNodePool(request.GetResource())
-	res, err := c.ApplyNodePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNodePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -517,6 +522,55 @@ func (s *NodePoolServer) ApplyContainerBetaNodePool(ctx context.Context, request
 	return s.applyNodePool(ctx, cl, request)
 }
 
+// GetContainerBetaNodePool handles the gRPC request by passing it to the underlying NodePool Get() method.
+func (s *NodePoolServer) GetContainerBetaNodePool(ctx context.Context, request *betapb.GetContainerBetaNodePoolRequest) (*betapb.ContainerBetaNodePool, error) {
+	cl, err := createConfigNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNodePool(ctx, ProtoToNodePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NodePoolToProto(res), nil
+}
+
+// HasDiffContainerBetaNodePool handles the gRPC request by passing it to the underlying NodePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NodePoolServer) HasDiffContainerBetaNodePool(ctx context.Context, request *betapb.HasDiffContainerBetaNodePoolRequest) (*betapb.HasDiffContainerBetaNodePoolResponse, error) {
+	cl, err := createConfigNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNodePool(ctx, ProtoToNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffContainerBetaNodePoolResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanContainerBetaNodePool handles the gRPC request by passing it to the underlying NodePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NodePoolServer) PlanContainerBetaNodePool(ctx context.Context, request *betapb.PlanContainerBetaNodePoolRequest) (*betapb.PlanContainerBetaNodePoolResponse, error) {
+	cl, err := createConfigNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNodePool(ctx, ProtoToNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanContainerBetaNodePoolResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteNodePool handles the gRPC request by passing it to the underlying NodePool Delete() method.
 func (s *NodePoolServer) DeleteContainerBetaNodePool(ctx context.Context, request *betapb.DeleteContainerBetaNodePoolRequest) (*emptypb.Empty, error) {
 
@@ -535,20 +589,32 @@ func (s *NodePoolServer) ListContainerBetaNodePool(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListNodePool(ctx, request.Project, request.Location, request.Cluster)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.NodePoolMaxPage
+	}
+	resources, err := cl.ListNodePoolWithPageToken(ctx, request.Project, request.Location, request.Cluster, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ContainerBetaNodePool
-	for _, r := range resources.Items {
-		rp := NodePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NodePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListContainerBetaNodePoolResponse{Items: protos}, nil
+	return &betapb.ListContainerBetaNodePoolResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigNodePool(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	containerpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/container/container_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/container"
@@ -2548,7 +2549,11 @@ func ClusterToProto(resource *container.Cluster) *containerpb.ContainerCluster {
 // ApplyCluster handles the gRPC request by passing it to the underlying Cluster Apply() method.
 func (s *ClusterServer) applyCluster(ctx context.Context, c *container.Client, request *containerpb.ApplyContainerClusterRequest) (*containerpb.ContainerCluster, error) {
 	p := ProtoToCluster(request.GetResource())
-	res, err := c.ApplyCluster(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCluster(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2565,6 +2570,55 @@ func (s *ClusterServer) ApplyContainerCluster(ctx context.Context, request *cont
 	return s.applyCluster(ctx, cl, request)
 }
 
+// GetContainerCluster handles the gRPC request by passing it to the underlying Cluster Get() method.
+func (s *ClusterServer) GetContainerCluster(ctx context.Context, request *containerpb.GetContainerClusterRequest) (*containerpb.ContainerCluster, error) {
+	cl, err := createConfigCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCluster(ctx, ProtoToCluster(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ClusterToProto(res), nil
+}
+
+// HasDiffContainerCluster handles the gRPC request by passing it to the underlying Cluster Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ClusterServer) HasDiffContainerCluster(ctx context.Context, request *containerpb.HasDiffContainerClusterRequest) (*containerpb.HasDiffContainerClusterResponse, error) {
+	cl, err := createConfigCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCluster(ctx, ProtoToCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &containerpb.HasDiffContainerClusterResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanContainerCluster handles the gRPC request by passing it to the underlying Cluster Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ClusterServer) PlanContainerCluster(ctx context.Context, request *containerpb.PlanContainerClusterRequest) (*containerpb.PlanContainerClusterResponse, error) {
+	cl, err := createConfigCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCluster(ctx, ProtoToCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &containerpb.PlanContainerClusterResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteCluster handles the gRPC request by passing it to the underlying Cluster Delete() method.
 func (s *ClusterServer) DeleteContainerCluster(ctx context.Context, request *containerpb.DeleteContainerClusterRequest) (*emptypb.Empty, error) {
 
@@ -2583,20 +2637,32 @@ func (s *ClusterServer) ListContainerCluster(ctx context.Context, request *conta
 		return nil, err
 	}
 
-	resources, err := cl.ListCluster(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = container.ClusterMaxPage
+	}
+	resources, err := cl.ListClusterWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*containerpb.ContainerCluster
-	for _, r := range resources.Items {
-		rp := ClusterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ClusterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &containerpb.ListContainerClusterResponse{Items: protos}, nil
+	return &containerpb.ListContainerClusterResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigCluster(ctx context.Context, service_account_file string) (*container.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return container.NewClient(conf), nil
 }
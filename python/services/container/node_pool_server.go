@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	containerpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/container/container_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/container"
@@ -500,7 +501,11 @@ func NodePoolToProto(resource *container.NodePool) *containerpb.ContainerNodePoo
 // ApplyNodePool handles the gRPC request by passing it to the underlying NodePool Apply() method.
 func (s *NodePoolServer) applyNodePool(ctx context.Context, c *container.Client, request *containerpb.ApplyContainerNodePoolRequest) (*containerpb.ContainerNodePool, error) {
 	p := ProtoToNodePool(request.GetResource())
-	res, err := c.ApplyNodePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNodePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -517,6 +522,55 @@ func (s *NodePoolServer) ApplyContainerNodePool(ctx context.Context, request *co
 	return s.applyNodePool(ctx, cl, request)
 }
 
+// GetContainerNodePool handles the gRPC request by passing it to the underlying NodePool Get() method.
+func (s *NodePoolServer) GetContainerNodePool(ctx context.Context, request *containerpb.GetContainerNodePoolRequest) (*containerpb.ContainerNodePool, error) {
+	cl, err := createConfigNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNodePool(ctx, ProtoToNodePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NodePoolToProto(res), nil
+}
+
+// HasDiffContainerNodePool handles the gRPC request by passing it to the underlying NodePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NodePoolServer) HasDiffContainerNodePool(ctx context.Context, request *containerpb.HasDiffContainerNodePoolRequest) (*containerpb.HasDiffContainerNodePoolResponse, error) {
+	cl, err := createConfigNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNodePool(ctx, ProtoToNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &containerpb.HasDiffContainerNodePoolResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanContainerNodePool handles the gRPC request by passing it to the underlying NodePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NodePoolServer) PlanContainerNodePool(ctx context.Context, request *containerpb.PlanContainerNodePoolRequest) (*containerpb.PlanContainerNodePoolResponse, error) {
+	cl, err := createConfigNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNodePool(ctx, ProtoToNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &containerpb.PlanContainerNodePoolResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteNodePool handles the gRPC request by passing it to the underlying NodePool Delete() method.
 func (s *NodePoolServer) DeleteContainerNodePool(ctx context.Context, request *containerpb.DeleteContainerNodePoolRequest) (*emptypb.Empty, error) {
 
@@ -535,20 +589,32 @@ func (s *NodePoolServer) ListContainerNodePool(ctx context.Context, request *con
 		return nil, err
 	}
 
-	resources, err := cl.ListNodePool(ctx, request.Project, request.Location, request.Cluster)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = container.NodePoolMaxPage
+	}
+	resources, err := cl.ListNodePoolWithPageToken(ctx, request.Project, request.Location, request.Cluster, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*containerpb.ContainerNodePool
-	for _, r := range resources.Items {
-		rp := NodePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NodePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &containerpb.ListContainerNodePoolResponse{Items: protos}, nil
+	return &containerpb.ListContainerNodePoolResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigNodePool(ctx context.Context, service_account_file string) (*container.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return container.NewClient(conf), nil
 }
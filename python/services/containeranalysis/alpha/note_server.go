@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containeranalysis/alpha/containeranalysis_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containeranalysis/alpha"
@@ -1028,7 +1029,11 @@ func NoteToProto(resource *alpha.Note) *alphapb.ContaineranalysisAlphaNote {
 // applyNote handles the gRPC request by passing it to the underlying Note Apply() method.
 func (s *NoteServer) applyNote(ctx context.Context, c *alpha.Client, request *alphapb.ApplyContaineranalysisAlphaNoteRequest) (*alphapb.ContaineranalysisAlphaNote, error) {
 	p := ProtoToNote(request.GetResource())
-	res, err := c.ApplyNote(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNote(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1045,6 +1050,60 @@ func (s *NoteServer) ApplyContaineranalysisAlphaNote(ctx context.Context, reques
 	return s.applyNote(ctx, cl, request)
 }
 
+// GetContaineranalysisAlphaNote handles the gRPC request by passing it to the underlying Note Get() method.
+func (s *NoteServer) GetContaineranalysisAlphaNote(ctx context.Context, request *alphapb.GetContaineranalysisAlphaNoteRequest) (*alphapb.ContaineranalysisAlphaNote, error) {
+	cl, err := createConfigNote(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNote(ctx, ProtoToNote(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NoteToProto(res), nil
+}
+
+// HasDiffContaineranalysisAlphaNote handles the gRPC request by passing it to the underlying Note Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NoteServer) HasDiffContaineranalysisAlphaNote(ctx context.Context, request *alphapb.HasDiffContaineranalysisAlphaNoteRequest) (*alphapb.HasDiffContaineranalysisAlphaNoteResponse, error) {
+	cl, err := createConfigNote(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNote(ctx, ProtoToNote(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffContaineranalysisAlphaNoteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanContaineranalysisAlphaNote handles the gRPC request by passing it to the underlying Note Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NoteServer) PlanContaineranalysisAlphaNote(ctx context.Context, request *alphapb.PlanContaineranalysisAlphaNoteRequest) (*alphapb.PlanContaineranalysisAlphaNoteResponse, error) {
+	cl, err := createConfigNote(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNote(ctx, ProtoToNote(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanContaineranalysisAlphaNoteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNote handles the gRPC request by passing it to the underlying Note Delete() method.
 func (s *NoteServer) DeleteContaineranalysisAlphaNote(ctx context.Context, request *alphapb.DeleteContaineranalysisAlphaNoteRequest) (*emptypb.Empty, error) {
 
@@ -1063,22 +1122,35 @@ func (s *NoteServer) ListContaineranalysisAlphaNote(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListNote(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.NoteMaxPage
+	}
+	resources, err := cl.ListNoteWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ContaineranalysisAlphaNote
-	for _, r := range resources.Items {
-		rp := NoteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NoteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListContaineranalysisAlphaNoteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNote(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
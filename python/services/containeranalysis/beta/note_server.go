@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containeranalysis/beta/containeranalysis_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containeranalysis/beta"
@@ -1028,7 +1029,11 @@ func NoteToProto(resource *beta.Note) *betapb.ContaineranalysisBetaNote {
 // applyNote handles the gRPC request by passing it to the underlying Note Apply() method.
 func (s *NoteServer) applyNote(ctx context.Context, c *beta.Client, request *betapb.ApplyContaineranalysisBetaNoteRequest) (*betapb.ContaineranalysisBetaNote, error) {
 	p := ProtoToNote(request.GetResource())
-	res, err := c.ApplyNote(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNote(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1045,6 +1050,60 @@ func (s *NoteServer) ApplyContaineranalysisBetaNote(ctx context.Context, request
 	return s.applyNote(ctx, cl, request)
 }
 
+// GetContaineranalysisBetaNote handles the gRPC request by passing it to the underlying Note Get() method.
+func (s *NoteServer) GetContaineranalysisBetaNote(ctx context.Context, request *betapb.GetContaineranalysisBetaNoteRequest) (*betapb.ContaineranalysisBetaNote, error) {
+	cl, err := createConfigNote(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNote(ctx, ProtoToNote(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NoteToProto(res), nil
+}
+
+// HasDiffContaineranalysisBetaNote handles the gRPC request by passing it to the underlying Note Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NoteServer) HasDiffContaineranalysisBetaNote(ctx context.Context, request *betapb.HasDiffContaineranalysisBetaNoteRequest) (*betapb.HasDiffContaineranalysisBetaNoteResponse, error) {
+	cl, err := createConfigNote(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNote(ctx, ProtoToNote(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffContaineranalysisBetaNoteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanContaineranalysisBetaNote handles the gRPC request by passing it to the underlying Note Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NoteServer) PlanContaineranalysisBetaNote(ctx context.Context, request *betapb.PlanContaineranalysisBetaNoteRequest) (*betapb.PlanContaineranalysisBetaNoteResponse, error) {
+	cl, err := createConfigNote(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNote(ctx, ProtoToNote(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanContaineranalysisBetaNoteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNote handles the gRPC request by passing it to the underlying Note Delete() method.
 func (s *NoteServer) DeleteContaineranalysisBetaNote(ctx context.Context, request *betapb.DeleteContaineranalysisBetaNoteRequest) (*emptypb.Empty, error) {
 
@@ -1063,22 +1122,35 @@ func (s *NoteServer) ListContaineranalysisBetaNote(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListNote(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.NoteMaxPage
+	}
+	resources, err := cl.ListNoteWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ContaineranalysisBetaNote
-	for _, r := range resources.Items {
-		rp := NoteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NoteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListContaineranalysisBetaNoteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNote(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	containeranalysispb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containeranalysis/containeranalysis_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containeranalysis"
@@ -976,7 +977,11 @@ func NoteToProto(resource *containeranalysis.Note) *containeranalysispb.Containe
 // applyNote handles the gRPC request by passing it to the underlying Note Apply() method.
 func (s *NoteServer) applyNote(ctx context.Context, c *containeranalysis.Client, request *containeranalysispb.ApplyContaineranalysisNoteRequest) (*containeranalysispb.ContaineranalysisNote, error) {
 	p := ProtoToNote(request.GetResource())
-	res, err := c.ApplyNote(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNote(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -993,6 +998,60 @@ func (s *NoteServer) ApplyContaineranalysisNote(ctx context.Context, request *co
 	return s.applyNote(ctx, cl, request)
 }
 
+// GetContaineranalysisNote handles the gRPC request by passing it to the underlying Note Get() method.
+func (s *NoteServer) GetContaineranalysisNote(ctx context.Context, request *containeranalysispb.GetContaineranalysisNoteRequest) (*containeranalysispb.ContaineranalysisNote, error) {
+	cl, err := createConfigNote(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNote(ctx, ProtoToNote(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NoteToProto(res), nil
+}
+
+// HasDiffContaineranalysisNote handles the gRPC request by passing it to the underlying Note Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NoteServer) HasDiffContaineranalysisNote(ctx context.Context, request *containeranalysispb.HasDiffContaineranalysisNoteRequest) (*containeranalysispb.HasDiffContaineranalysisNoteResponse, error) {
+	cl, err := createConfigNote(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNote(ctx, ProtoToNote(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &containeranalysispb.HasDiffContaineranalysisNoteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanContaineranalysisNote handles the gRPC request by passing it to the underlying Note Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NoteServer) PlanContaineranalysisNote(ctx context.Context, request *containeranalysispb.PlanContaineranalysisNoteRequest) (*containeranalysispb.PlanContaineranalysisNoteResponse, error) {
+	cl, err := createConfigNote(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNote(ctx, ProtoToNote(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &containeranalysispb.PlanContaineranalysisNoteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNote handles the gRPC request by passing it to the underlying Note Delete() method.
 func (s *NoteServer) DeleteContaineranalysisNote(ctx context.Context, request *containeranalysispb.DeleteContaineranalysisNoteRequest) (*emptypb.Empty, error) {
 
@@ -1011,22 +1070,35 @@ func (s *NoteServer) ListContaineranalysisNote(ctx context.Context, request *con
 		return nil, err
 	}
 
-	resources, err := cl.ListNote(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = containeranalysis.NoteMaxPage
+	}
+	resources, err := cl.ListNoteWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*containeranalysispb.ContaineranalysisNote
-	for _, r := range resources.Items {
-		rp := NoteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NoteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &containeranalysispb.ListContaineranalysisNoteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNote(ctx context.Context, service_account_file string) (*containeranalysis.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return containeranalysis.NewClient(conf), nil
 }
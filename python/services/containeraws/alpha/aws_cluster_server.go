@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containeraws/alpha/containeraws_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containeraws/alpha"
@@ -432,7 +433,11 @@ func AwsClusterToProto(resource *alpha.AwsCluster) *alphapb.ContainerawsAlphaAws
 // ApplyAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Apply() method.
 func (s *AwsClusterServer) applyAwsCluster(ctx context.Context, c *alpha.Client, request *alphapb.ApplyContainerawsAlphaAwsClusterRequest) (*alphapb.ContainerawsAlphaAwsCluster, error) {
 	p := ProtoToAwsCluster(request.GetResource())
-	res, err := c.ApplyAwsCluster(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAwsCluster(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -449,6 +454,55 @@ func (s *AwsClusterServer) ApplyContainerawsAlphaAwsCluster(ctx context.Context,
 	return s.applyAwsCluster(ctx, cl, request)
 }
 
+// GetContainerawsAlphaAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Get() method.
+func (s *AwsClusterServer) GetContainerawsAlphaAwsCluster(ctx context.Context, request *alphapb.GetContainerawsAlphaAwsClusterRequest) (*alphapb.ContainerawsAlphaAwsCluster, error) {
+	cl, err := createConfigAwsCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAwsCluster(ctx, ProtoToAwsCluster(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AwsClusterToProto(res), nil
+}
+
+// HasDiffContainerawsAlphaAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AwsClusterServer) HasDiffContainerawsAlphaAwsCluster(ctx context.Context, request *alphapb.HasDiffContainerawsAlphaAwsClusterRequest) (*alphapb.HasDiffContainerawsAlphaAwsClusterResponse, error) {
+	cl, err := createConfigAwsCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAwsCluster(ctx, ProtoToAwsCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.HasDiffContainerawsAlphaAwsClusterResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanContainerawsAlphaAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AwsClusterServer) PlanContainerawsAlphaAwsCluster(ctx context.Context, request *alphapb.PlanContainerawsAlphaAwsClusterRequest) (*alphapb.PlanContainerawsAlphaAwsClusterResponse, error) {
+	cl, err := createConfigAwsCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAwsCluster(ctx, ProtoToAwsCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.PlanContainerawsAlphaAwsClusterResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Delete() method.
 func (s *AwsClusterServer) DeleteContainerawsAlphaAwsCluster(ctx context.Context, request *alphapb.DeleteContainerawsAlphaAwsClusterRequest) (*emptypb.Empty, error) {
 
@@ -467,20 +521,32 @@ func (s *AwsClusterServer) ListContainerawsAlphaAwsCluster(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListAwsCluster(ctx, ProtoToAwsCluster(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = alpha.AwsClusterMaxPage
+	}
+	resources, err := cl.ListAwsClusterWithPageToken(ctx, ProtoToAwsCluster(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ContainerawsAlphaAwsCluster
-	for _, r := range resources.Items {
-		rp := AwsClusterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AwsClusterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &alphapb.ListContainerawsAlphaAwsClusterResponse{Items: protos}, nil
+	return &alphapb.ListContainerawsAlphaAwsClusterResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAwsCluster(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containeraws/alpha/containeraws_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containeraws/alpha"
@@ -312,7 +313,11 @@ func AwsNodePoolToProto(resource *alpha.AwsNodePool) *alphapb.ContainerawsAlphaA
 // ApplyAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Apply() method.
 func (s *AwsNodePoolServer) applyAwsNodePool(ctx context.Context, c *alpha.Client, request *alphapb.ApplyContainerawsAlphaAwsNodePoolRequest) (*alphapb.ContainerawsAlphaAwsNodePool, error) {
 	p := ProtoToAwsNodePool(request.GetResource())
-	res, err := c.ApplyAwsNodePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAwsNodePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -329,6 +334,55 @@ func (s *AwsNodePoolServer) ApplyContainerawsAlphaAwsNodePool(ctx context.Contex
 	return s.applyAwsNodePool(ctx, cl, request)
 }
 
+// GetContainerawsAlphaAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Get() method.
+func (s *AwsNodePoolServer) GetContainerawsAlphaAwsNodePool(ctx context.Context, request *alphapb.GetContainerawsAlphaAwsNodePoolRequest) (*alphapb.ContainerawsAlphaAwsNodePool, error) {
+	cl, err := createConfigAwsNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAwsNodePool(ctx, ProtoToAwsNodePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AwsNodePoolToProto(res), nil
+}
+
+// HasDiffContainerawsAlphaAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AwsNodePoolServer) HasDiffContainerawsAlphaAwsNodePool(ctx context.Context, request *alphapb.HasDiffContainerawsAlphaAwsNodePoolRequest) (*alphapb.HasDiffContainerawsAlphaAwsNodePoolResponse, error) {
+	cl, err := createConfigAwsNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAwsNodePool(ctx, ProtoToAwsNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.HasDiffContainerawsAlphaAwsNodePoolResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanContainerawsAlphaAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AwsNodePoolServer) PlanContainerawsAlphaAwsNodePool(ctx context.Context, request *alphapb.PlanContainerawsAlphaAwsNodePoolRequest) (*alphapb.PlanContainerawsAlphaAwsNodePoolResponse, error) {
+	cl, err := createConfigAwsNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAwsNodePool(ctx, ProtoToAwsNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.PlanContainerawsAlphaAwsNodePoolResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Delete() method.
 func (s *AwsNodePoolServer) DeleteContainerawsAlphaAwsNodePool(ctx context.Context, request *alphapb.DeleteContainerawsAlphaAwsNodePoolRequest) (*emptypb.Empty, error) {
 
@@ -347,20 +401,32 @@ func (s *AwsNodePoolServer) ListContainerawsAlphaAwsNodePool(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListAwsNodePool(ctx, ProtoToAwsNodePool(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = alpha.AwsNodePoolMaxPage
+	}
+	resources, err := cl.ListAwsNodePoolWithPageToken(ctx, ProtoToAwsNodePool(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ContainerawsAlphaAwsNodePool
-	for _, r := range resources.Items {
-		rp := AwsNodePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AwsNodePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &alphapb.ListContainerawsAlphaAwsNodePoolResponse{Items: protos}, nil
+	return &alphapb.ListContainerawsAlphaAwsNodePoolResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAwsNodePool(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containeraws/alpha/containeraws_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containeraws/alpha"
@@ -442,7 +443,11 @@ func NodePoolToProto(resource *alpha.NodePool) *alphapb.ContainerawsAlphaNodePoo
 // applyNodePool handles the gRPC request by passing it to the underlying NodePool Apply() method.
 func (s *NodePoolServer) applyNodePool(ctx context.Context, c *alpha.Client, request *alphapb.ApplyContainerawsAlphaNodePoolRequest) (*alphapb.ContainerawsAlphaNodePool, error) {
 	p := ProtoToNodePool(request.GetResource())
-	res, err := c.ApplyNodePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNodePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -459,6 +464,60 @@ func (s *NodePoolServer) ApplyContainerawsAlphaNodePool(ctx context.Context, req
 	return s.applyNodePool(ctx, cl, request)
 }
 
+// GetContainerawsAlphaNodePool handles the gRPC request by passing it to the underlying NodePool Get() method.
+func (s *NodePoolServer) GetContainerawsAlphaNodePool(ctx context.Context, request *alphapb.GetContainerawsAlphaNodePoolRequest) (*alphapb.ContainerawsAlphaNodePool, error) {
+	cl, err := createConfigNodePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNodePool(ctx, ProtoToNodePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NodePoolToProto(res), nil
+}
+
+// HasDiffContainerawsAlphaNodePool handles the gRPC request by passing it to the underlying NodePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NodePoolServer) HasDiffContainerawsAlphaNodePool(ctx context.Context, request *alphapb.HasDiffContainerawsAlphaNodePoolRequest) (*alphapb.HasDiffContainerawsAlphaNodePoolResponse, error) {
+	cl, err := createConfigNodePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNodePool(ctx, ProtoToNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffContainerawsAlphaNodePoolResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanContainerawsAlphaNodePool handles the gRPC request by passing it to the underlying NodePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NodePoolServer) PlanContainerawsAlphaNodePool(ctx context.Context, request *alphapb.PlanContainerawsAlphaNodePoolRequest) (*alphapb.PlanContainerawsAlphaNodePoolResponse, error) {
+	cl, err := createConfigNodePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNodePool(ctx, ProtoToNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanContainerawsAlphaNodePoolResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNodePool handles the gRPC request by passing it to the underlying NodePool Delete() method.
 func (s *NodePoolServer) DeleteContainerawsAlphaNodePool(ctx context.Context, request *alphapb.DeleteContainerawsAlphaNodePoolRequest) (*emptypb.Empty, error) {
 
@@ -477,22 +536,35 @@ func (s *NodePoolServer) ListContainerawsAlphaNodePool(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListNodePool(ctx, request.GetProject(), request.GetLocation(), request.GetCluster())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.NodePoolMaxPage
+	}
+	resources, err := cl.ListNodePoolWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetCluster(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ContainerawsAlphaNodePool
-	for _, r := range resources.Items {
-		rp := NodePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NodePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListContainerawsAlphaNodePoolResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNodePool(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
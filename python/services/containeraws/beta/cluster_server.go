@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containeraws/beta/containeraws_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containeraws/beta"
@@ -666,7 +667,11 @@ func ClusterToProto(resource *beta.Cluster) *betapb.ContainerawsBetaCluster {
 // applyCluster handles the gRPC request by passing it to the underlying Cluster Apply() method.
 func (s *ClusterServer) applyCluster(ctx context.Context, c *beta.Client, request *betapb.ApplyContainerawsBetaClusterRequest) (*betapb.ContainerawsBetaCluster, error) {
 	p := ProtoToCluster(request.GetResource())
-	res, err := c.ApplyCluster(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCluster(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -683,6 +688,60 @@ func (s *ClusterServer) ApplyContainerawsBetaCluster(ctx context.Context, reques
 	return s.applyCluster(ctx, cl, request)
 }
 
+// GetContainerawsBetaCluster handles the gRPC request by passing it to the underlying Cluster Get() method.
+func (s *ClusterServer) GetContainerawsBetaCluster(ctx context.Context, request *betapb.GetContainerawsBetaClusterRequest) (*betapb.ContainerawsBetaCluster, error) {
+	cl, err := createConfigCluster(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCluster(ctx, ProtoToCluster(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ClusterToProto(res), nil
+}
+
+// HasDiffContainerawsBetaCluster handles the gRPC request by passing it to the underlying Cluster Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ClusterServer) HasDiffContainerawsBetaCluster(ctx context.Context, request *betapb.HasDiffContainerawsBetaClusterRequest) (*betapb.HasDiffContainerawsBetaClusterResponse, error) {
+	cl, err := createConfigCluster(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCluster(ctx, ProtoToCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffContainerawsBetaClusterResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanContainerawsBetaCluster handles the gRPC request by passing it to the underlying Cluster Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ClusterServer) PlanContainerawsBetaCluster(ctx context.Context, request *betapb.PlanContainerawsBetaClusterRequest) (*betapb.PlanContainerawsBetaClusterResponse, error) {
+	cl, err := createConfigCluster(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCluster(ctx, ProtoToCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanContainerawsBetaClusterResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCluster handles the gRPC request by passing it to the underlying Cluster Delete() method.
 func (s *ClusterServer) DeleteContainerawsBetaCluster(ctx context.Context, request *betapb.DeleteContainerawsBetaClusterRequest) (*emptypb.Empty, error) {
 
@@ -701,22 +760,35 @@ func (s *ClusterServer) ListContainerawsBetaCluster(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListCluster(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ClusterMaxPage
+	}
+	resources, err := cl.ListClusterWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ContainerawsBetaCluster
-	for _, r := range resources.Items {
-		rp := ClusterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ClusterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListContainerawsBetaClusterResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCluster(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containeraws/beta/containeraws_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containeraws/beta"
@@ -442,7 +443,11 @@ func NodePoolToProto(resource *beta.NodePool) *betapb.ContainerawsBetaNodePool {
 // applyNodePool handles the gRPC request by passing it to the underlying NodePool Apply() method.
 func (s *NodePoolServer) applyNodePool(ctx context.Context, c *beta.Client, request *betapb.ApplyContainerawsBetaNodePoolRequest) (*betapb.ContainerawsBetaNodePool, error) {
 	p := ProtoToNodePool(request.GetResource())
-	res, err := c.ApplyNodePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNodePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -459,6 +464,60 @@ func (s *NodePoolServer) ApplyContainerawsBetaNodePool(ctx context.Context, requ
 	return s.applyNodePool(ctx, cl, request)
 }
 
+// GetContainerawsBetaNodePool handles the gRPC request by passing it to the underlying NodePool Get() method.
+func (s *NodePoolServer) GetContainerawsBetaNodePool(ctx context.Context, request *betapb.GetContainerawsBetaNodePoolRequest) (*betapb.ContainerawsBetaNodePool, error) {
+	cl, err := createConfigNodePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNodePool(ctx, ProtoToNodePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NodePoolToProto(res), nil
+}
+
+// HasDiffContainerawsBetaNodePool handles the gRPC request by passing it to the underlying NodePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NodePoolServer) HasDiffContainerawsBetaNodePool(ctx context.Context, request *betapb.HasDiffContainerawsBetaNodePoolRequest) (*betapb.HasDiffContainerawsBetaNodePoolResponse, error) {
+	cl, err := createConfigNodePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNodePool(ctx, ProtoToNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffContainerawsBetaNodePoolResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanContainerawsBetaNodePool handles the gRPC request by passing it to the underlying NodePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NodePoolServer) PlanContainerawsBetaNodePool(ctx context.Context, request *betapb.PlanContainerawsBetaNodePoolRequest) (*betapb.PlanContainerawsBetaNodePoolResponse, error) {
+	cl, err := createConfigNodePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNodePool(ctx, ProtoToNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanContainerawsBetaNodePoolResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNodePool handles the gRPC request by passing it to the underlying NodePool Delete() method.
 func (s *NodePoolServer) DeleteContainerawsBetaNodePool(ctx context.Context, request *betapb.DeleteContainerawsBetaNodePoolRequest) (*emptypb.Empty, error) {
 
@@ -477,22 +536,35 @@ func (s *NodePoolServer) ListContainerawsBetaNodePool(ctx context.Context, reque
 		return nil, err
 	}
 
-	resources, err := cl.ListNodePool(ctx, request.GetProject(), request.GetLocation(), request.GetCluster())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.NodePoolMaxPage
+	}
+	resources, err := cl.ListNodePoolWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetCluster(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ContainerawsBetaNodePool
-	for _, r := range resources.Items {
-		rp := NodePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NodePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListContainerawsBetaNodePoolResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNodePool(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
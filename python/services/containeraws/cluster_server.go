@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	containerawspb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containeraws/containeraws_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containeraws"
@@ -504,7 +505,11 @@ func ClusterToProto(resource *containeraws.Cluster) *containerawspb.Containeraws
 // applyCluster handles the gRPC request by passing it to the underlying Cluster Apply() method.
 func (s *ClusterServer) applyCluster(ctx context.Context, c *containeraws.Client, request *containerawspb.ApplyContainerawsClusterRequest) (*containerawspb.ContainerawsCluster, error) {
 	p := ProtoToCluster(request.GetResource())
-	res, err := c.ApplyCluster(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCluster(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -521,6 +526,60 @@ func (s *ClusterServer) ApplyContainerawsCluster(ctx context.Context, request *c
 	return s.applyCluster(ctx, cl, request)
 }
 
+// GetContainerawsCluster handles the gRPC request by passing it to the underlying Cluster Get() method.
+func (s *ClusterServer) GetContainerawsCluster(ctx context.Context, request *containerawspb.GetContainerawsClusterRequest) (*containerawspb.ContainerawsCluster, error) {
+	cl, err := createConfigCluster(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCluster(ctx, ProtoToCluster(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ClusterToProto(res), nil
+}
+
+// HasDiffContainerawsCluster handles the gRPC request by passing it to the underlying Cluster Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ClusterServer) HasDiffContainerawsCluster(ctx context.Context, request *containerawspb.HasDiffContainerawsClusterRequest) (*containerawspb.HasDiffContainerawsClusterResponse, error) {
+	cl, err := createConfigCluster(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCluster(ctx, ProtoToCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &containerawspb.HasDiffContainerawsClusterResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanContainerawsCluster handles the gRPC request by passing it to the underlying Cluster Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ClusterServer) PlanContainerawsCluster(ctx context.Context, request *containerawspb.PlanContainerawsClusterRequest) (*containerawspb.PlanContainerawsClusterResponse, error) {
+	cl, err := createConfigCluster(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCluster(ctx, ProtoToCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &containerawspb.PlanContainerawsClusterResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCluster handles the gRPC request by passing it to the underlying Cluster Delete() method.
 func (s *ClusterServer) DeleteContainerawsCluster(ctx context.Context, request *containerawspb.DeleteContainerawsClusterRequest) (*emptypb.Empty, error) {
 
@@ -539,22 +598,35 @@ func (s *ClusterServer) ListContainerawsCluster(ctx context.Context, request *co
 		return nil, err
 	}
 
-	resources, err := cl.ListCluster(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = containeraws.ClusterMaxPage
+	}
+	resources, err := cl.ListClusterWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*containerawspb.ContainerawsCluster
-	for _, r := range resources.Items {
-		rp := ClusterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ClusterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &containerawspb.ListContainerawsClusterResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCluster(ctx context.Context, service_account_file string) (*containeraws.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return containeraws.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	containerawspb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containeraws/containeraws_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containeraws"
@@ -394,7 +395,11 @@ func NodePoolToProto(resource *containeraws.NodePool) *containerawspb.Containera
 // applyNodePool handles the gRPC request by passing it to the underlying NodePool Apply() method.
 func (s *NodePoolServer) applyNodePool(ctx context.Context, c *containeraws.Client, request *containerawspb.ApplyContainerawsNodePoolRequest) (*containerawspb.ContainerawsNodePool, error) {
 	p := ProtoToNodePool(request.GetResource())
-	res, err := c.ApplyNodePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNodePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -411,6 +416,60 @@ func (s *NodePoolServer) ApplyContainerawsNodePool(ctx context.Context, request
 	return s.applyNodePool(ctx, cl, request)
 }
 
+// GetContainerawsNodePool handles the gRPC request by passing it to the underlying NodePool Get() method.
+func (s *NodePoolServer) GetContainerawsNodePool(ctx context.Context, request *containerawspb.GetContainerawsNodePoolRequest) (*containerawspb.ContainerawsNodePool, error) {
+	cl, err := createConfigNodePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNodePool(ctx, ProtoToNodePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NodePoolToProto(res), nil
+}
+
+// HasDiffContainerawsNodePool handles the gRPC request by passing it to the underlying NodePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NodePoolServer) HasDiffContainerawsNodePool(ctx context.Context, request *containerawspb.HasDiffContainerawsNodePoolRequest) (*containerawspb.HasDiffContainerawsNodePoolResponse, error) {
+	cl, err := createConfigNodePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNodePool(ctx, ProtoToNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &containerawspb.HasDiffContainerawsNodePoolResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanContainerawsNodePool handles the gRPC request by passing it to the underlying NodePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NodePoolServer) PlanContainerawsNodePool(ctx context.Context, request *containerawspb.PlanContainerawsNodePoolRequest) (*containerawspb.PlanContainerawsNodePoolResponse, error) {
+	cl, err := createConfigNodePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNodePool(ctx, ProtoToNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &containerawspb.PlanContainerawsNodePoolResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNodePool handles the gRPC request by passing it to the underlying NodePool Delete() method.
 func (s *NodePoolServer) DeleteContainerawsNodePool(ctx context.Context, request *containerawspb.DeleteContainerawsNodePoolRequest) (*emptypb.Empty, error) {
 
@@ -429,22 +488,35 @@ func (s *NodePoolServer) ListContainerawsNodePool(ctx context.Context, request *
 		return nil, err
 	}
 
-	resources, err := cl.ListNodePool(ctx, request.GetProject(), request.GetLocation(), request.GetCluster())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = containeraws.NodePoolMaxPage
+	}
+	resources, err := cl.ListNodePoolWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetCluster(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*containerawspb.ContainerawsNodePool
-	for _, r := range resources.Items {
-		rp := NodePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NodePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &containerawspb.ListContainerawsNodePoolResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNodePool(ctx context.Context, service_account_file string) (*containeraws.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return containeraws.NewClient(conf), nil
 }
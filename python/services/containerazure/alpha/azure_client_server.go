@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containerazure/alpha/containerazure_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containerazure/alpha"
@@ -57,7 +58,11 @@ func ClientToProto(resource *alpha.AzureClient) *alphapb.ContainerazureAlphaClie
 // applyClient handles the gRPC request by passing it to the underlying Client Apply() method.
 func (s *ClientServer) applyClient(ctx context.Context, c *alpha.Client, request *alphapb.ApplyContainerazureAlphaClientRequest) (*alphapb.ContainerazureAlphaClient, error) {
 	p := ProtoToClient(request.GetResource())
-	res, err := c.ApplyClient(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyClient(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +79,60 @@ func (s *ClientServer) ApplyContainerazureAlphaClient(ctx context.Context, reque
 	return s.applyClient(ctx, cl, request)
 }
 
+// GetContainerazureAlphaClient handles the gRPC request by passing it to the underlying Client Get() method.
+func (s *ClientServer) GetContainerazureAlphaClient(ctx context.Context, request *alphapb.GetContainerazureAlphaClientRequest) (*alphapb.ContainerazureAlphaClient, error) {
+	cl, err := createConfigClient(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetClient(ctx, ProtoToClient(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ClientToProto(res), nil
+}
+
+// HasDiffContainerazureAlphaClient handles the gRPC request by passing it to the underlying Client Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ClientServer) HasDiffContainerazureAlphaClient(ctx context.Context, request *alphapb.HasDiffContainerazureAlphaClientRequest) (*alphapb.HasDiffContainerazureAlphaClientResponse, error) {
+	cl, err := createConfigClient(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffClient(ctx, ProtoToClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffContainerazureAlphaClientResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanContainerazureAlphaClient handles the gRPC request by passing it to the underlying Client Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ClientServer) PlanContainerazureAlphaClient(ctx context.Context, request *alphapb.PlanContainerazureAlphaClientRequest) (*alphapb.PlanContainerazureAlphaClientResponse, error) {
+	cl, err := createConfigClient(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffClient(ctx, ProtoToClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanContainerazureAlphaClientResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteClient handles the gRPC request by passing it to the underlying Client Delete() method.
 func (s *ClientServer) DeleteContainerazureAlphaClient(ctx context.Context, request *alphapb.DeleteContainerazureAlphaClientRequest) (*emptypb.Empty, error) {
 
@@ -92,22 +151,35 @@ func (s *ClientServer) ListContainerazureAlphaClient(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListClient(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ClientMaxPage
+	}
+	resources, err := cl.ListClientWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ContainerazureAlphaClient
-	for _, r := range resources.Items {
-		rp := ClientToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ClientToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListContainerazureAlphaClientResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigClient(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containerazure/alpha/containerazure_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containerazure/alpha"
@@ -336,7 +337,11 @@ func AzureClusterToProto(resource *alpha.AzureCluster) *alphapb.ContainerazureAl
 // ApplyAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Apply() method.
 func (s *AzureClusterServer) applyAzureCluster(ctx context.Context, c *alpha.Client, request *alphapb.ApplyContainerazureAlphaAzureClusterRequest) (*alphapb.ContainerazureAlphaAzureCluster, error) {
 	p := ProtoToAzureCluster(request.GetResource())
-	res, err := c.ApplyAzureCluster(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAzureCluster(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -353,6 +358,55 @@ func (s *AzureClusterServer) ApplyContainerazureAlphaAzureCluster(ctx context.Co
 	return s.applyAzureCluster(ctx, cl, request)
 }
 
+// GetContainerazureAlphaAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Get() method.
+func (s *AzureClusterServer) GetContainerazureAlphaAzureCluster(ctx context.Context, request *alphapb.GetContainerazureAlphaAzureClusterRequest) (*alphapb.ContainerazureAlphaAzureCluster, error) {
+	cl, err := createConfigAzureCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAzureCluster(ctx, ProtoToAzureCluster(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AzureClusterToProto(res), nil
+}
+
+// HasDiffContainerazureAlphaAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AzureClusterServer) HasDiffContainerazureAlphaAzureCluster(ctx context.Context, request *alphapb.HasDiffContainerazureAlphaAzureClusterRequest) (*alphapb.HasDiffContainerazureAlphaAzureClusterResponse, error) {
+	cl, err := createConfigAzureCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureCluster(ctx, ProtoToAzureCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.HasDiffContainerazureAlphaAzureClusterResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanContainerazureAlphaAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AzureClusterServer) PlanContainerazureAlphaAzureCluster(ctx context.Context, request *alphapb.PlanContainerazureAlphaAzureClusterRequest) (*alphapb.PlanContainerazureAlphaAzureClusterResponse, error) {
+	cl, err := createConfigAzureCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureCluster(ctx, ProtoToAzureCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.PlanContainerazureAlphaAzureClusterResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Delete() method.
 func (s *AzureClusterServer) DeleteContainerazureAlphaAzureCluster(ctx context.Context, request *alphapb.DeleteContainerazureAlphaAzureClusterRequest) (*emptypb.Empty, error) {
 
@@ -371,20 +425,32 @@ func (s *AzureClusterServer) ListContainerazureAlphaAzureCluster(ctx context.Con
 		return nil, err
 	}
 
-	resources, err := cl.ListAzureCluster(ctx, ProtoToAzureCluster(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = alpha.AzureClusterMaxPage
+	}
+	resources, err := cl.ListAzureClusterWithPageToken(ctx, ProtoToAzureCluster(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ContainerazureAlphaAzureCluster
-	for _, r := range resources.Items {
-		rp := AzureClusterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AzureClusterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &alphapb.ListContainerazureAlphaAzureClusterResponse{Items: protos}, nil
+	return &alphapb.ListContainerazureAlphaAzureClusterResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAzureCluster(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containerazure/alpha/containerazure_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containerazure/alpha"
@@ -218,7 +219,11 @@ func AzureNodePoolToProto(resource *alpha.AzureNodePool) *alphapb.Containerazure
 // ApplyAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Apply() method.
 func (s *AzureNodePoolServer) applyAzureNodePool(ctx context.Context, c *alpha.Client, request *alphapb.ApplyContainerazureAlphaAzureNodePoolRequest) (*alphapb.ContainerazureAlphaAzureNodePool, error) {
 	p := ProtoToAzureNodePool(request.GetResource())
-	res, err := c.ApplyAzureNodePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAzureNodePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +240,55 @@ func (s *AzureNodePoolServer) ApplyContainerazureAlphaAzureNodePool(ctx context.
 	return s.applyAzureNodePool(ctx, cl, request)
 }
 
+// GetContainerazureAlphaAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Get() method.
+func (s *AzureNodePoolServer) GetContainerazureAlphaAzureNodePool(ctx context.Context, request *alphapb.GetContainerazureAlphaAzureNodePoolRequest) (*alphapb.ContainerazureAlphaAzureNodePool, error) {
+	cl, err := createConfigAzureNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAzureNodePool(ctx, ProtoToAzureNodePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AzureNodePoolToProto(res), nil
+}
+
+// HasDiffContainerazureAlphaAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AzureNodePoolServer) HasDiffContainerazureAlphaAzureNodePool(ctx context.Context, request *alphapb.HasDiffContainerazureAlphaAzureNodePoolRequest) (*alphapb.HasDiffContainerazureAlphaAzureNodePoolResponse, error) {
+	cl, err := createConfigAzureNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureNodePool(ctx, ProtoToAzureNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.HasDiffContainerazureAlphaAzureNodePoolResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanContainerazureAlphaAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AzureNodePoolServer) PlanContainerazureAlphaAzureNodePool(ctx context.Context, request *alphapb.PlanContainerazureAlphaAzureNodePoolRequest) (*alphapb.PlanContainerazureAlphaAzureNodePoolResponse, error) {
+	cl, err := createConfigAzureNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureNodePool(ctx, ProtoToAzureNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.PlanContainerazureAlphaAzureNodePoolResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Delete() method.
 func (s *AzureNodePoolServer) DeleteContainerazureAlphaAzureNodePool(ctx context.Context, request *alphapb.DeleteContainerazureAlphaAzureNodePoolRequest) (*emptypb.Empty, error) {
 
@@ -253,20 +307,32 @@ func (s *AzureNodePoolServer) ListContainerazureAlphaAzureNodePool(ctx context.C
 		return nil, err
 	}
 
-	resources, err := cl.ListAzureNodePool(ctx, ProtoToAzureNodePool(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = alpha.AzureNodePoolMaxPage
+	}
+	resources, err := cl.ListAzureNodePoolWithPageToken(ctx, ProtoToAzureNodePool(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ContainerazureAlphaAzureNodePool
-	for _, r := range resources.Items {
-		rp := AzureNodePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AzureNodePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &alphapb.ListContainerazureAlphaAzureNodePoolResponse{Items: protos}, nil
+	return &alphapb.ListContainerazureAlphaAzureNodePoolResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAzureNodePool(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	containerazurepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/containerazure/containerazure_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/containerazure"
@@ -57,7 +58,11 @@ func ClientToProto(resource *containerazure.AzureClient) *containerazurepb.Conta
 // applyClient handles the gRPC request by passing it to the underlying Client Apply() method.
 func (s *ClientServer) applyClient(ctx context.Context, c *containerazure.Client, request *containerazurepb.ApplyContainerazureClientRequest) (*containerazurepb.ContainerazureClient, error) {
 	p := ProtoToClient(request.GetResource())
-	res, err := c.ApplyClient(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyClient(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +79,60 @@ func (s *ClientServer) ApplyContainerazureClient(ctx context.Context, request *c
 	return s.applyClient(ctx, cl, request)
 }
 
+// GetContainerazureClient handles the gRPC request by passing it to the underlying Client Get() method.
+func (s *ClientServer) GetContainerazureClient(ctx context.Context, request *containerazurepb.GetContainerazureClientRequest) (*containerazurepb.ContainerazureClient, error) {
+	cl, err := createConfigClient(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetClient(ctx, ProtoToClient(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ClientToProto(res), nil
+}
+
+// HasDiffContainerazureClient handles the gRPC request by passing it to the underlying AzureClient Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ClientServer) HasDiffContainerazureClient(ctx context.Context, request *containerazurepb.HasDiffContainerazureClientRequest) (*containerazurepb.HasDiffContainerazureClientResponse, error) {
+	cl, err := createConfigClient(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffClient(ctx, ProtoToClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &containerazurepb.HasDiffContainerazureClientResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanContainerazureClient handles the gRPC request by passing it to the underlying AzureClient Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ClientServer) PlanContainerazureClient(ctx context.Context, request *containerazurepb.PlanContainerazureClientRequest) (*containerazurepb.PlanContainerazureClientResponse, error) {
+	cl, err := createConfigClient(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffClient(ctx, ProtoToClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &containerazurepb.PlanContainerazureClientResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteClient handles the gRPC request by passing it to the underlying Client Delete() method.
 func (s *ClientServer) DeleteContainerazureClient(ctx context.Context, request *containerazurepb.DeleteContainerazureClientRequest) (*emptypb.Empty, error) {
 
@@ -92,22 +151,35 @@ func (s *ClientServer) ListContainerazureClient(ctx context.Context, request *co
 		return nil, err
 	}
 
-	resources, err := cl.ListClient(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = containerazure.ClientMaxPage
+	}
+	resources, err := cl.ListClientWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*containerazurepb.ContainerazureClient
-	for _, r := range resources.Items {
-		rp := ClientToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ClientToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &containerazurepb.ListContainerazureClientResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigClient(ctx context.Context, service_account_file string) (*containerazure.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return containerazure.NewClient(conf), nil
 }
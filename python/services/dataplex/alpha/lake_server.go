@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dataplex/alpha/dataplex_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataplex/alpha"
@@ -191,7 +192,11 @@ func LakeToProto(resource *alpha.Lake) *alphapb.DataplexAlphaLake {
 // applyLake handles the gRPC request by passing it to the underlying Lake Apply() method.
 func (s *LakeServer) applyLake(ctx context.Context, c *alpha.Client, request *alphapb.ApplyDataplexAlphaLakeRequest) (*alphapb.DataplexAlphaLake, error) {
 	p := ProtoToLake(request.GetResource())
-	res, err := c.ApplyLake(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyLake(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -208,6 +213,60 @@ func (s *LakeServer) ApplyDataplexAlphaLake(ctx context.Context, request *alphap
 	return s.applyLake(ctx, cl, request)
 }
 
+// GetDataplexAlphaLake handles the gRPC request by passing it to the underlying Lake Get() method.
+func (s *LakeServer) GetDataplexAlphaLake(ctx context.Context, request *alphapb.GetDataplexAlphaLakeRequest) (*alphapb.DataplexAlphaLake, error) {
+	cl, err := createConfigLake(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetLake(ctx, ProtoToLake(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return LakeToProto(res), nil
+}
+
+// HasDiffDataplexAlphaLake handles the gRPC request by passing it to the underlying Lake Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *LakeServer) HasDiffDataplexAlphaLake(ctx context.Context, request *alphapb.HasDiffDataplexAlphaLakeRequest) (*alphapb.HasDiffDataplexAlphaLakeResponse, error) {
+	cl, err := createConfigLake(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLake(ctx, ProtoToLake(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffDataplexAlphaLakeResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDataplexAlphaLake handles the gRPC request by passing it to the underlying Lake Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *LakeServer) PlanDataplexAlphaLake(ctx context.Context, request *alphapb.PlanDataplexAlphaLakeRequest) (*alphapb.PlanDataplexAlphaLakeResponse, error) {
+	cl, err := createConfigLake(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLake(ctx, ProtoToLake(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanDataplexAlphaLakeResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteLake handles the gRPC request by passing it to the underlying Lake Delete() method.
 func (s *LakeServer) DeleteDataplexAlphaLake(ctx context.Context, request *alphapb.DeleteDataplexAlphaLakeRequest) (*emptypb.Empty, error) {
 
@@ -226,22 +285,35 @@ func (s *LakeServer) ListDataplexAlphaLake(ctx context.Context, request *alphapb
 		return nil, err
 	}
 
-	resources, err := cl.ListLake(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.LakeMaxPage
+	}
+	resources, err := cl.ListLakeWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.DataplexAlphaLake
-	for _, r := range resources.Items {
-		rp := LakeToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, LakeToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListDataplexAlphaLakeResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigLake(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dataplex/alpha/dataplex_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataplex/alpha"
@@ -282,7 +283,11 @@ func ZoneToProto(resource *alpha.Zone) *alphapb.DataplexAlphaZone {
 // applyZone handles the gRPC request by passing it to the underlying Zone Apply() method.
 func (s *ZoneServer) applyZone(ctx context.Context, c *alpha.Client, request *alphapb.ApplyDataplexAlphaZoneRequest) (*alphapb.DataplexAlphaZone, error) {
 	p := ProtoToZone(request.GetResource())
-	res, err := c.ApplyZone(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyZone(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -299,6 +304,60 @@ func (s *ZoneServer) ApplyDataplexAlphaZone(ctx context.Context, request *alphap
 	return s.applyZone(ctx, cl, request)
 }
 
+// GetDataplexAlphaZone handles the gRPC request by passing it to the underlying Zone Get() method.
+func (s *ZoneServer) GetDataplexAlphaZone(ctx context.Context, request *alphapb.GetDataplexAlphaZoneRequest) (*alphapb.DataplexAlphaZone, error) {
+	cl, err := createConfigZone(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetZone(ctx, ProtoToZone(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ZoneToProto(res), nil
+}
+
+// HasDiffDataplexAlphaZone handles the gRPC request by passing it to the underlying Zone Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ZoneServer) HasDiffDataplexAlphaZone(ctx context.Context, request *alphapb.HasDiffDataplexAlphaZoneRequest) (*alphapb.HasDiffDataplexAlphaZoneResponse, error) {
+	cl, err := createConfigZone(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffZone(ctx, ProtoToZone(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffDataplexAlphaZoneResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDataplexAlphaZone handles the gRPC request by passing it to the underlying Zone Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ZoneServer) PlanDataplexAlphaZone(ctx context.Context, request *alphapb.PlanDataplexAlphaZoneRequest) (*alphapb.PlanDataplexAlphaZoneResponse, error) {
+	cl, err := createConfigZone(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffZone(ctx, ProtoToZone(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanDataplexAlphaZoneResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteZone handles the gRPC request by passing it to the underlying Zone Delete() method.
 func (s *ZoneServer) DeleteDataplexAlphaZone(ctx context.Context, request *alphapb.DeleteDataplexAlphaZoneRequest) (*emptypb.Empty, error) {
 
@@ -317,22 +376,35 @@ func (s *ZoneServer) ListDataplexAlphaZone(ctx context.Context, request *alphapb
 		return nil, err
 	}
 
-	resources, err := cl.ListZone(ctx, request.GetProject(), request.GetLocation(), request.GetLake())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ZoneMaxPage
+	}
+	resources, err := cl.ListZoneWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetLake(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.DataplexAlphaZone
-	for _, r := range resources.Items {
-		rp := ZoneToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ZoneToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListDataplexAlphaZoneResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigZone(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	dataplexpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dataplex/dataplex_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataplex"
@@ -417,7 +418,11 @@ func AssetToProto(resource *dataplex.Asset) *dataplexpb.DataplexAsset {
 // applyAsset handles the gRPC request by passing it to the underlying Asset Apply() method.
 func (s *AssetServer) applyAsset(ctx context.Context, c *dataplex.Client, request *dataplexpb.ApplyDataplexAssetRequest) (*dataplexpb.DataplexAsset, error) {
 	p := ProtoToAsset(request.GetResource())
-	res, err := c.ApplyAsset(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAsset(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -434,6 +439,60 @@ func (s *AssetServer) ApplyDataplexAsset(ctx context.Context, request *dataplexp
 	return s.applyAsset(ctx, cl, request)
 }
 
+// GetDataplexAsset handles the gRPC request by passing it to the underlying Asset Get() method.
+func (s *AssetServer) GetDataplexAsset(ctx context.Context, request *dataplexpb.GetDataplexAssetRequest) (*dataplexpb.DataplexAsset, error) {
+	cl, err := createConfigAsset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAsset(ctx, ProtoToAsset(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AssetToProto(res), nil
+}
+
+// HasDiffDataplexAsset handles the gRPC request by passing it to the underlying Asset Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AssetServer) HasDiffDataplexAsset(ctx context.Context, request *dataplexpb.HasDiffDataplexAssetRequest) (*dataplexpb.HasDiffDataplexAssetResponse, error) {
+	cl, err := createConfigAsset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAsset(ctx, ProtoToAsset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &dataplexpb.HasDiffDataplexAssetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDataplexAsset handles the gRPC request by passing it to the underlying Asset Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AssetServer) PlanDataplexAsset(ctx context.Context, request *dataplexpb.PlanDataplexAssetRequest) (*dataplexpb.PlanDataplexAssetResponse, error) {
+	cl, err := createConfigAsset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAsset(ctx, ProtoToAsset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &dataplexpb.PlanDataplexAssetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAsset handles the gRPC request by passing it to the underlying Asset Delete() method.
 func (s *AssetServer) DeleteDataplexAsset(ctx context.Context, request *dataplexpb.DeleteDataplexAssetRequest) (*emptypb.Empty, error) {
 
@@ -452,22 +511,35 @@ func (s *AssetServer) ListDataplexAsset(ctx context.Context, request *dataplexpb
 		return nil, err
 	}
 
-	resources, err := cl.ListAsset(ctx, request.GetProject(), request.GetLocation(), request.GetDataplexZone(), request.GetLake())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = dataplex.AssetMaxPage
+	}
+	resources, err := cl.ListAssetWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetDataplexZone(), request.GetLake(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*dataplexpb.DataplexAsset
-	for _, r := range resources.Items {
-		rp := AssetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AssetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &dataplexpb.ListDataplexAssetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAsset(ctx context.Context, service_account_file string) (*dataplex.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return dataplex.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dataplex/beta/dataplex_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataplex/beta"
@@ -417,7 +418,11 @@ func AssetToProto(resource *beta.Asset) *betapb.DataplexBetaAsset {
 // applyAsset handles the gRPC request by passing it to the underlying Asset Apply() method.
 func (s *AssetServer) applyAsset(ctx context.Context, c *beta.Client, request *betapb.ApplyDataplexBetaAssetRequest) (*betapb.DataplexBetaAsset, error) {
 	p := ProtoToAsset(request.GetResource())
-	res, err := c.ApplyAsset(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAsset(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -434,6 +439,60 @@ func (s *AssetServer) ApplyDataplexBetaAsset(ctx context.Context, request *betap
 	return s.applyAsset(ctx, cl, request)
 }
 
+// GetDataplexBetaAsset handles the gRPC request by passing it to the underlying Asset Get() method.
+func (s *AssetServer) GetDataplexBetaAsset(ctx context.Context, request *betapb.GetDataplexBetaAssetRequest) (*betapb.DataplexBetaAsset, error) {
+	cl, err := createConfigAsset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAsset(ctx, ProtoToAsset(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AssetToProto(res), nil
+}
+
+// HasDiffDataplexBetaAsset handles the gRPC request by passing it to the underlying Asset Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AssetServer) HasDiffDataplexBetaAsset(ctx context.Context, request *betapb.HasDiffDataplexBetaAssetRequest) (*betapb.HasDiffDataplexBetaAssetResponse, error) {
+	cl, err := createConfigAsset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAsset(ctx, ProtoToAsset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffDataplexBetaAssetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDataplexBetaAsset handles the gRPC request by passing it to the underlying Asset Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AssetServer) PlanDataplexBetaAsset(ctx context.Context, request *betapb.PlanDataplexBetaAssetRequest) (*betapb.PlanDataplexBetaAssetResponse, error) {
+	cl, err := createConfigAsset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAsset(ctx, ProtoToAsset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanDataplexBetaAssetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAsset handles the gRPC request by passing it to the underlying Asset Delete() method.
 func (s *AssetServer) DeleteDataplexBetaAsset(ctx context.Context, request *betapb.DeleteDataplexBetaAssetRequest) (*emptypb.Empty, error) {
 
@@ -452,22 +511,35 @@ func (s *AssetServer) ListDataplexBetaAsset(ctx context.Context, request *betapb
 		return nil, err
 	}
 
-	resources, err := cl.ListAsset(ctx, request.GetProject(), request.GetLocation(), request.GetDataplexZone(), request.GetLake())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.AssetMaxPage
+	}
+	resources, err := cl.ListAssetWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetDataplexZone(), request.GetLake(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.DataplexBetaAsset
-	for _, r := range resources.Items {
-		rp := AssetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AssetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListDataplexBetaAssetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAsset(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
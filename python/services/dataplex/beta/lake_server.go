@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dataplex/beta/dataplex_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataplex/beta"
@@ -191,7 +192,11 @@ func LakeToProto(resource *beta.Lake) *betapb.DataplexBetaLake {
 // applyLake handles the gRPC request by passing it to the underlying Lake Apply() method.
 func (s *LakeServer) applyLake(ctx context.Context, c *beta.Client, request *betapb.ApplyDataplexBetaLakeRequest) (*betapb.DataplexBetaLake, error) {
 	p := ProtoToLake(request.GetResource())
-	res, err := c.ApplyLake(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyLake(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -208,6 +213,60 @@ func (s *LakeServer) ApplyDataplexBetaLake(ctx context.Context, request *betapb.
 	return s.applyLake(ctx, cl, request)
 }
 
+// GetDataplexBetaLake handles the gRPC request by passing it to the underlying Lake Get() method.
+func (s *LakeServer) GetDataplexBetaLake(ctx context.Context, request *betapb.GetDataplexBetaLakeRequest) (*betapb.DataplexBetaLake, error) {
+	cl, err := createConfigLake(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetLake(ctx, ProtoToLake(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return LakeToProto(res), nil
+}
+
+// HasDiffDataplexBetaLake handles the gRPC request by passing it to the underlying Lake Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *LakeServer) HasDiffDataplexBetaLake(ctx context.Context, request *betapb.HasDiffDataplexBetaLakeRequest) (*betapb.HasDiffDataplexBetaLakeResponse, error) {
+	cl, err := createConfigLake(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLake(ctx, ProtoToLake(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffDataplexBetaLakeResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDataplexBetaLake handles the gRPC request by passing it to the underlying Lake Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *LakeServer) PlanDataplexBetaLake(ctx context.Context, request *betapb.PlanDataplexBetaLakeRequest) (*betapb.PlanDataplexBetaLakeResponse, error) {
+	cl, err := createConfigLake(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLake(ctx, ProtoToLake(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanDataplexBetaLakeResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteLake handles the gRPC request by passing it to the underlying Lake Delete() method.
 func (s *LakeServer) DeleteDataplexBetaLake(ctx context.Context, request *betapb.DeleteDataplexBetaLakeRequest) (*emptypb.Empty, error) {
 
@@ -226,22 +285,35 @@ func (s *LakeServer) ListDataplexBetaLake(ctx context.Context, request *betapb.L
 		return nil, err
 	}
 
-	resources, err := cl.ListLake(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.LakeMaxPage
+	}
+	resources, err := cl.ListLakeWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.DataplexBetaLake
-	for _, r := range resources.Items {
-		rp := LakeToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, LakeToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListDataplexBetaLakeResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigLake(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dataproc/alpha/dataproc_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataproc/alpha"
@@ -155,7 +156,11 @@ func AutoscalingPolicyToProto(resource *alpha.AutoscalingPolicy) *alphapb.Datapr
 // applyAutoscalingPolicy handles the gRPC request by passing it to the underlying AutoscalingPolicy Apply() method.
 func (s *AutoscalingPolicyServer) applyAutoscalingPolicy(ctx context.Context, c *alpha.Client, request *alphapb.ApplyDataprocAlphaAutoscalingPolicyRequest) (*alphapb.DataprocAlphaAutoscalingPolicy, error) {
 	p := ProtoToAutoscalingPolicy(request.GetResource())
-	res, err := c.ApplyAutoscalingPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAutoscalingPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +177,60 @@ func (s *AutoscalingPolicyServer) ApplyDataprocAlphaAutoscalingPolicy(ctx contex
 	return s.applyAutoscalingPolicy(ctx, cl, request)
 }
 
+// GetDataprocAlphaAutoscalingPolicy handles the gRPC request by passing it to the underlying AutoscalingPolicy Get() method.
+func (s *AutoscalingPolicyServer) GetDataprocAlphaAutoscalingPolicy(ctx context.Context, request *alphapb.GetDataprocAlphaAutoscalingPolicyRequest) (*alphapb.DataprocAlphaAutoscalingPolicy, error) {
+	cl, err := createConfigAutoscalingPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAutoscalingPolicy(ctx, ProtoToAutoscalingPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AutoscalingPolicyToProto(res), nil
+}
+
+// HasDiffDataprocAlphaAutoscalingPolicy handles the gRPC request by passing it to the underlying AutoscalingPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AutoscalingPolicyServer) HasDiffDataprocAlphaAutoscalingPolicy(ctx context.Context, request *alphapb.HasDiffDataprocAlphaAutoscalingPolicyRequest) (*alphapb.HasDiffDataprocAlphaAutoscalingPolicyResponse, error) {
+	cl, err := createConfigAutoscalingPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAutoscalingPolicy(ctx, ProtoToAutoscalingPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffDataprocAlphaAutoscalingPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDataprocAlphaAutoscalingPolicy handles the gRPC request by passing it to the underlying AutoscalingPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AutoscalingPolicyServer) PlanDataprocAlphaAutoscalingPolicy(ctx context.Context, request *alphapb.PlanDataprocAlphaAutoscalingPolicyRequest) (*alphapb.PlanDataprocAlphaAutoscalingPolicyResponse, error) {
+	cl, err := createConfigAutoscalingPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAutoscalingPolicy(ctx, ProtoToAutoscalingPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanDataprocAlphaAutoscalingPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAutoscalingPolicy handles the gRPC request by passing it to the underlying AutoscalingPolicy Delete() method.
 func (s *AutoscalingPolicyServer) DeleteDataprocAlphaAutoscalingPolicy(ctx context.Context, request *alphapb.DeleteDataprocAlphaAutoscalingPolicyRequest) (*emptypb.Empty, error) {
 
@@ -190,22 +249,35 @@ func (s *AutoscalingPolicyServer) ListDataprocAlphaAutoscalingPolicy(ctx context
 		return nil, err
 	}
 
-	resources, err := cl.ListAutoscalingPolicy(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.AutoscalingPolicyMaxPage
+	}
+	resources, err := cl.ListAutoscalingPolicyWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.DataprocAlphaAutoscalingPolicy
-	for _, r := range resources.Items {
-		rp := AutoscalingPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AutoscalingPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListDataprocAlphaAutoscalingPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAutoscalingPolicy(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dataproc/alpha/dataproc_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataproc/alpha"
@@ -2001,7 +2002,11 @@ func WorkflowTemplateToProto(resource *alpha.WorkflowTemplate) *alphapb.Dataproc
 // applyWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Apply() method.
 func (s *WorkflowTemplateServer) applyWorkflowTemplate(ctx context.Context, c *alpha.Client, request *alphapb.ApplyDataprocAlphaWorkflowTemplateRequest) (*alphapb.DataprocAlphaWorkflowTemplate, error) {
 	p := ProtoToWorkflowTemplate(request.GetResource())
-	res, err := c.ApplyWorkflowTemplate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkflowTemplate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2018,6 +2023,60 @@ func (s *WorkflowTemplateServer) ApplyDataprocAlphaWorkflowTemplate(ctx context.
 	return s.applyWorkflowTemplate(ctx, cl, request)
 }
 
+// GetDataprocAlphaWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Get() method.
+func (s *WorkflowTemplateServer) GetDataprocAlphaWorkflowTemplate(ctx context.Context, request *alphapb.GetDataprocAlphaWorkflowTemplateRequest) (*alphapb.DataprocAlphaWorkflowTemplate, error) {
+	cl, err := createConfigWorkflowTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkflowTemplate(ctx, ProtoToWorkflowTemplate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkflowTemplateToProto(res), nil
+}
+
+// HasDiffDataprocAlphaWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkflowTemplateServer) HasDiffDataprocAlphaWorkflowTemplate(ctx context.Context, request *alphapb.HasDiffDataprocAlphaWorkflowTemplateRequest) (*alphapb.HasDiffDataprocAlphaWorkflowTemplateResponse, error) {
+	cl, err := createConfigWorkflowTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkflowTemplate(ctx, ProtoToWorkflowTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffDataprocAlphaWorkflowTemplateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDataprocAlphaWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkflowTemplateServer) PlanDataprocAlphaWorkflowTemplate(ctx context.Context, request *alphapb.PlanDataprocAlphaWorkflowTemplateRequest) (*alphapb.PlanDataprocAlphaWorkflowTemplateResponse, error) {
+	cl, err := createConfigWorkflowTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkflowTemplate(ctx, ProtoToWorkflowTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanDataprocAlphaWorkflowTemplateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Delete() method.
 func (s *WorkflowTemplateServer) DeleteDataprocAlphaWorkflowTemplate(ctx context.Context, request *alphapb.DeleteDataprocAlphaWorkflowTemplateRequest) (*emptypb.Empty, error) {
 
@@ -2036,22 +2095,35 @@ func (s *WorkflowTemplateServer) ListDataprocAlphaWorkflowTemplate(ctx context.C
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkflowTemplate(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.WorkflowTemplateMaxPage
+	}
+	resources, err := cl.ListWorkflowTemplateWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.DataprocAlphaWorkflowTemplate
-	for _, r := range resources.Items {
-		rp := WorkflowTemplateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkflowTemplateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListDataprocAlphaWorkflowTemplateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkflowTemplate(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
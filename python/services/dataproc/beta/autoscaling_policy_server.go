@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dataproc/beta/dataproc_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataproc/beta"
@@ -155,7 +156,11 @@ func AutoscalingPolicyToProto(resource *beta.AutoscalingPolicy) *betapb.Dataproc
 // applyAutoscalingPolicy handles the gRPC request by passing it to the underlying AutoscalingPolicy Apply() method.
 func (s *AutoscalingPolicyServer) applyAutoscalingPolicy(ctx context.Context, c *beta.Client, request *betapb.ApplyDataprocBetaAutoscalingPolicyRequest) (*betapb.DataprocBetaAutoscalingPolicy, error) {
 	p := ProtoToAutoscalingPolicy(request.GetResource())
-	res, err := c.ApplyAutoscalingPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAutoscalingPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +177,60 @@ func (s *AutoscalingPolicyServer) ApplyDataprocBetaAutoscalingPolicy(ctx context
 	return s.applyAutoscalingPolicy(ctx, cl, request)
 }
 
+// GetDataprocBetaAutoscalingPolicy handles the gRPC request by passing it to the underlying AutoscalingPolicy Get() method.
+func (s *AutoscalingPolicyServer) GetDataprocBetaAutoscalingPolicy(ctx context.Context, request *betapb.GetDataprocBetaAutoscalingPolicyRequest) (*betapb.DataprocBetaAutoscalingPolicy, error) {
+	cl, err := createConfigAutoscalingPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAutoscalingPolicy(ctx, ProtoToAutoscalingPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AutoscalingPolicyToProto(res), nil
+}
+
+// HasDiffDataprocBetaAutoscalingPolicy handles the gRPC request by passing it to the underlying AutoscalingPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AutoscalingPolicyServer) HasDiffDataprocBetaAutoscalingPolicy(ctx context.Context, request *betapb.HasDiffDataprocBetaAutoscalingPolicyRequest) (*betapb.HasDiffDataprocBetaAutoscalingPolicyResponse, error) {
+	cl, err := createConfigAutoscalingPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAutoscalingPolicy(ctx, ProtoToAutoscalingPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffDataprocBetaAutoscalingPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDataprocBetaAutoscalingPolicy handles the gRPC request by passing it to the underlying AutoscalingPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AutoscalingPolicyServer) PlanDataprocBetaAutoscalingPolicy(ctx context.Context, request *betapb.PlanDataprocBetaAutoscalingPolicyRequest) (*betapb.PlanDataprocBetaAutoscalingPolicyResponse, error) {
+	cl, err := createConfigAutoscalingPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAutoscalingPolicy(ctx, ProtoToAutoscalingPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanDataprocBetaAutoscalingPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAutoscalingPolicy handles the gRPC request by passing it to the underlying AutoscalingPolicy Delete() method.
 func (s *AutoscalingPolicyServer) DeleteDataprocBetaAutoscalingPolicy(ctx context.Context, request *betapb.DeleteDataprocBetaAutoscalingPolicyRequest) (*emptypb.Empty, error) {
 
@@ -190,22 +249,35 @@ func (s *AutoscalingPolicyServer) ListDataprocBetaAutoscalingPolicy(ctx context.
 		return nil, err
 	}
 
-	resources, err := cl.ListAutoscalingPolicy(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.AutoscalingPolicyMaxPage
+	}
+	resources, err := cl.ListAutoscalingPolicyWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.DataprocBetaAutoscalingPolicy
-	for _, r := range resources.Items {
-		rp := AutoscalingPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AutoscalingPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListDataprocBetaAutoscalingPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAutoscalingPolicy(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
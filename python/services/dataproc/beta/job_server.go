@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dataproc/beta/dataproc_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataproc/beta"
@@ -992,7 +993,11 @@ func JobToProto(resource *beta.Job) *betapb.DataprocBetaJob {
 // ApplyJob handles the gRPC request by passing it to the underlying Job Apply() method.
 func (s *JobServer) applyJob(ctx context.Context, c *beta.Client, request *betapb.ApplyDataprocBetaJobRequest) (*betapb.DataprocBetaJob, error) {
 	p := ProtoToJob(request.GetResource())
-	res, err := c.ApplyJob(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyJob(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1009,6 +1014,55 @@ func (s *JobServer) ApplyDataprocBetaJob(ctx context.Context, request *betapb.Ap
 	return s.applyJob(ctx, cl, request)
 }
 
+// GetDataprocBetaJob handles the gRPC request by passing it to the underlying Job Get() method.
+func (s *JobServer) GetDataprocBetaJob(ctx context.Context, request *betapb.GetDataprocBetaJobRequest) (*betapb.DataprocBetaJob, error) {
+	cl, err := createConfigJob(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetJob(ctx, ProtoToJob(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return JobToProto(res), nil
+}
+
+// HasDiffDataprocBetaJob handles the gRPC request by passing it to the underlying Job Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *JobServer) HasDiffDataprocBetaJob(ctx context.Context, request *betapb.HasDiffDataprocBetaJobRequest) (*betapb.HasDiffDataprocBetaJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJob(ctx, ProtoToJob(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffDataprocBetaJobResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanDataprocBetaJob handles the gRPC request by passing it to the underlying Job Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *JobServer) PlanDataprocBetaJob(ctx context.Context, request *betapb.PlanDataprocBetaJobRequest) (*betapb.PlanDataprocBetaJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJob(ctx, ProtoToJob(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanDataprocBetaJobResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteJob handles the gRPC request by passing it to the underlying Job Delete() method.
 func (s *JobServer) DeleteDataprocBetaJob(ctx context.Context, request *betapb.DeleteDataprocBetaJobRequest) (*emptypb.Empty, error) {
 
@@ -1027,20 +1081,32 @@ func (s *JobServer) ListDataprocBetaJob(ctx context.Context, request *betapb.Lis
 		return nil, err
 	}
 
-	resources, err := cl.ListJob(ctx, request.Project, request.Region)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.JobMaxPage
+	}
+	resources, err := cl.ListJobWithPageToken(ctx, request.Project, request.Region, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.DataprocBetaJob
-	for _, r := range resources.Items {
-		rp := JobToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, JobToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListDataprocBetaJobResponse{Items: protos}, nil
+	return &betapb.ListDataprocBetaJobResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigJob(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
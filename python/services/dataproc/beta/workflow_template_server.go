@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dataproc/beta/dataproc_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataproc/beta"
@@ -2001,7 +2002,11 @@ func WorkflowTemplateToProto(resource *beta.WorkflowTemplate) *betapb.DataprocBe
 // applyWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Apply() method.
 func (s *WorkflowTemplateServer) applyWorkflowTemplate(ctx context.Context, c *beta.Client, request *betapb.ApplyDataprocBetaWorkflowTemplateRequest) (*betapb.DataprocBetaWorkflowTemplate, error) {
 	p := ProtoToWorkflowTemplate(request.GetResource())
-	res, err := c.ApplyWorkflowTemplate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkflowTemplate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2018,6 +2023,60 @@ func (s *WorkflowTemplateServer) ApplyDataprocBetaWorkflowTemplate(ctx context.C
 	return s.applyWorkflowTemplate(ctx, cl, request)
 }
 
+// GetDataprocBetaWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Get() method.
+func (s *WorkflowTemplateServer) GetDataprocBetaWorkflowTemplate(ctx context.Context, request *betapb.GetDataprocBetaWorkflowTemplateRequest) (*betapb.DataprocBetaWorkflowTemplate, error) {
+	cl, err := createConfigWorkflowTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkflowTemplate(ctx, ProtoToWorkflowTemplate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkflowTemplateToProto(res), nil
+}
+
+// HasDiffDataprocBetaWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkflowTemplateServer) HasDiffDataprocBetaWorkflowTemplate(ctx context.Context, request *betapb.HasDiffDataprocBetaWorkflowTemplateRequest) (*betapb.HasDiffDataprocBetaWorkflowTemplateResponse, error) {
+	cl, err := createConfigWorkflowTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkflowTemplate(ctx, ProtoToWorkflowTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffDataprocBetaWorkflowTemplateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDataprocBetaWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkflowTemplateServer) PlanDataprocBetaWorkflowTemplate(ctx context.Context, request *betapb.PlanDataprocBetaWorkflowTemplateRequest) (*betapb.PlanDataprocBetaWorkflowTemplateResponse, error) {
+	cl, err := createConfigWorkflowTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkflowTemplate(ctx, ProtoToWorkflowTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanDataprocBetaWorkflowTemplateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Delete() method.
 func (s *WorkflowTemplateServer) DeleteDataprocBetaWorkflowTemplate(ctx context.Context, request *betapb.DeleteDataprocBetaWorkflowTemplateRequest) (*emptypb.Empty, error) {
 
@@ -2036,22 +2095,35 @@ func (s *WorkflowTemplateServer) ListDataprocBetaWorkflowTemplate(ctx context.Co
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkflowTemplate(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.WorkflowTemplateMaxPage
+	}
+	resources, err := cl.ListWorkflowTemplateWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.DataprocBetaWorkflowTemplate
-	for _, r := range resources.Items {
-		rp := WorkflowTemplateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkflowTemplateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListDataprocBetaWorkflowTemplateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkflowTemplate(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	dataprocpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dataproc/dataproc_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataproc"
@@ -990,7 +991,11 @@ func JobToProto(resource *dataproc.Job) *dataprocpb.DataprocJob {
 // ApplyJob handles the gRPC request by passing it to the underlying Job Apply() method.
 func (s *JobServer) applyJob(ctx context.Context, c *dataproc.Client, request *dataprocpb.ApplyDataprocJobRequest) (*dataprocpb.DataprocJob, error) {
 	p := ProtoToJob(request.GetResource())
-	res, err := c.ApplyJob(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyJob(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1007,6 +1012,55 @@ func (s *JobServer) ApplyDataprocJob(ctx context.Context, request *dataprocpb.Ap
 	return s.applyJob(ctx, cl, request)
 }
 
+// GetDataprocJob handles the gRPC request by passing it to the underlying Job Get() method.
+func (s *JobServer) GetDataprocJob(ctx context.Context, request *dataprocpb.GetDataprocJobRequest) (*dataprocpb.DataprocJob, error) {
+	cl, err := createConfigJob(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetJob(ctx, ProtoToJob(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return JobToProto(res), nil
+}
+
+// HasDiffDataprocJob handles the gRPC request by passing it to the underlying Job Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *JobServer) HasDiffDataprocJob(ctx context.Context, request *dataprocpb.HasDiffDataprocJobRequest) (*dataprocpb.HasDiffDataprocJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJob(ctx, ProtoToJob(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &dataprocpb.HasDiffDataprocJobResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanDataprocJob handles the gRPC request by passing it to the underlying Job Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *JobServer) PlanDataprocJob(ctx context.Context, request *dataprocpb.PlanDataprocJobRequest) (*dataprocpb.PlanDataprocJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJob(ctx, ProtoToJob(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &dataprocpb.PlanDataprocJobResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteJob handles the gRPC request by passing it to the underlying Job Delete() method.
 func (s *JobServer) DeleteDataprocJob(ctx context.Context, request *dataprocpb.DeleteDataprocJobRequest) (*emptypb.Empty, error) {
 
@@ -1025,20 +1079,32 @@ func (s *JobServer) ListDataprocJob(ctx context.Context, request *dataprocpb.Lis
 		return nil, err
 	}
 
-	resources, err := cl.ListJob(ctx, request.Project, request.Region)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = dataproc.JobMaxPage
+	}
+	resources, err := cl.ListJobWithPageToken(ctx, request.Project, request.Region, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*dataprocpb.DataprocJob
-	for _, r := range resources.Items {
-		rp := JobToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, JobToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &dataprocpb.ListDataprocJobResponse{Items: protos}, nil
+	return &dataprocpb.ListDataprocJobResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigJob(ctx context.Context, service_account_file string) (*dataproc.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return dataproc.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	dataprocpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dataproc/dataproc_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataproc"
@@ -1932,7 +1933,11 @@ func WorkflowTemplateToProto(resource *dataproc.WorkflowTemplate) *dataprocpb.Da
 // applyWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Apply() method.
 func (s *WorkflowTemplateServer) applyWorkflowTemplate(ctx context.Context, c *dataproc.Client, request *dataprocpb.ApplyDataprocWorkflowTemplateRequest) (*dataprocpb.DataprocWorkflowTemplate, error) {
 	p := ProtoToWorkflowTemplate(request.GetResource())
-	res, err := c.ApplyWorkflowTemplate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkflowTemplate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1949,6 +1954,60 @@ func (s *WorkflowTemplateServer) ApplyDataprocWorkflowTemplate(ctx context.Conte
 	return s.applyWorkflowTemplate(ctx, cl, request)
 }
 
+// GetDataprocWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Get() method.
+func (s *WorkflowTemplateServer) GetDataprocWorkflowTemplate(ctx context.Context, request *dataprocpb.GetDataprocWorkflowTemplateRequest) (*dataprocpb.DataprocWorkflowTemplate, error) {
+	cl, err := createConfigWorkflowTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkflowTemplate(ctx, ProtoToWorkflowTemplate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkflowTemplateToProto(res), nil
+}
+
+// HasDiffDataprocWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkflowTemplateServer) HasDiffDataprocWorkflowTemplate(ctx context.Context, request *dataprocpb.HasDiffDataprocWorkflowTemplateRequest) (*dataprocpb.HasDiffDataprocWorkflowTemplateResponse, error) {
+	cl, err := createConfigWorkflowTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkflowTemplate(ctx, ProtoToWorkflowTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &dataprocpb.HasDiffDataprocWorkflowTemplateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDataprocWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkflowTemplateServer) PlanDataprocWorkflowTemplate(ctx context.Context, request *dataprocpb.PlanDataprocWorkflowTemplateRequest) (*dataprocpb.PlanDataprocWorkflowTemplateResponse, error) {
+	cl, err := createConfigWorkflowTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkflowTemplate(ctx, ProtoToWorkflowTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &dataprocpb.PlanDataprocWorkflowTemplateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkflowTemplate handles the gRPC request by passing it to the underlying WorkflowTemplate Delete() method.
 func (s *WorkflowTemplateServer) DeleteDataprocWorkflowTemplate(ctx context.Context, request *dataprocpb.DeleteDataprocWorkflowTemplateRequest) (*emptypb.Empty, error) {
 
@@ -1967,22 +2026,35 @@ func (s *WorkflowTemplateServer) ListDataprocWorkflowTemplate(ctx context.Contex
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkflowTemplate(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = dataproc.WorkflowTemplateMaxPage
+	}
+	resources, err := cl.ListWorkflowTemplateWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*dataprocpb.DataprocWorkflowTemplate
-	for _, r := range resources.Items {
-		rp := WorkflowTemplateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkflowTemplateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &dataprocpb.ListDataprocWorkflowTemplateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkflowTemplate(ctx context.Context, service_account_file string) (*dataproc.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return dataproc.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	datastorepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/datastore/datastore_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/datastore"
@@ -152,7 +153,11 @@ func IndexToProto(resource *datastore.Index) *datastorepb.DatastoreIndex {
 // ApplyIndex handles the gRPC request by passing it to the underlying Index Apply() method.
 func (s *IndexServer) applyIndex(ctx context.Context, c *datastore.Client, request *datastorepb.ApplyDatastoreIndexRequest) (*datastorepb.DatastoreIndex, error) {
 	p := ProtoToIndex(request.GetResource())
-	res, err := c.ApplyIndex(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyIndex(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -169,6 +174,55 @@ func (s *IndexServer) ApplyDatastoreIndex(ctx context.Context, request *datastor
 	return s.applyIndex(ctx, cl, request)
 }
 
+// GetDatastoreIndex handles the gRPC request by passing it to the underlying Index Get() method.
+func (s *IndexServer) GetDatastoreIndex(ctx context.Context, request *datastorepb.GetDatastoreIndexRequest) (*datastorepb.DatastoreIndex, error) {
+	cl, err := createConfigIndex(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetIndex(ctx, ProtoToIndex(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return IndexToProto(res), nil
+}
+
+// HasDiffDatastoreIndex handles the gRPC request by passing it to the underlying Index Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *IndexServer) HasDiffDatastoreIndex(ctx context.Context, request *datastorepb.HasDiffDatastoreIndexRequest) (*datastorepb.HasDiffDatastoreIndexResponse, error) {
+	cl, err := createConfigIndex(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffIndex(ctx, ProtoToIndex(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &datastorepb.HasDiffDatastoreIndexResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanDatastoreIndex handles the gRPC request by passing it to the underlying Index Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *IndexServer) PlanDatastoreIndex(ctx context.Context, request *datastorepb.PlanDatastoreIndexRequest) (*datastorepb.PlanDatastoreIndexResponse, error) {
+	cl, err := createConfigIndex(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffIndex(ctx, ProtoToIndex(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &datastorepb.PlanDatastoreIndexResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteIndex handles the gRPC request by passing it to the underlying Index Delete() method.
 func (s *IndexServer) DeleteDatastoreIndex(ctx context.Context, request *datastorepb.DeleteDatastoreIndexRequest) (*emptypb.Empty, error) {
 
@@ -187,20 +241,32 @@ func (s *IndexServer) ListDatastoreIndex(ctx context.Context, request *datastore
 		return nil, err
 	}
 
-	resources, err := cl.ListIndex(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = datastore.IndexMaxPage
+	}
+	resources, err := cl.ListIndexWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*datastorepb.DatastoreIndex
-	for _, r := range resources.Items {
-		rp := IndexToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, IndexToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &datastorepb.ListDatastoreIndexResponse{Items: protos}, nil
+	return &datastorepb.ListDatastoreIndexResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigIndex(ctx context.Context, service_account_file string) (*datastore.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return datastore.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dlp/alpha/dlp_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dlp/alpha"
@@ -5595,7 +5596,11 @@ func DeidentifyTemplateToProto(resource *alpha.DeidentifyTemplate) *alphapb.DlpA
 // applyDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Apply() method.
 func (s *DeidentifyTemplateServer) applyDeidentifyTemplate(ctx context.Context, c *alpha.Client, request *alphapb.ApplyDlpAlphaDeidentifyTemplateRequest) (*alphapb.DlpAlphaDeidentifyTemplate, error) {
 	p := ProtoToDeidentifyTemplate(request.GetResource())
-	res, err := c.ApplyDeidentifyTemplate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDeidentifyTemplate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5612,6 +5617,60 @@ func (s *DeidentifyTemplateServer) ApplyDlpAlphaDeidentifyTemplate(ctx context.C
 	return s.applyDeidentifyTemplate(ctx, cl, request)
 }
 
+// GetDlpAlphaDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Get() method.
+func (s *DeidentifyTemplateServer) GetDlpAlphaDeidentifyTemplate(ctx context.Context, request *alphapb.GetDlpAlphaDeidentifyTemplateRequest) (*alphapb.DlpAlphaDeidentifyTemplate, error) {
+	cl, err := createConfigDeidentifyTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDeidentifyTemplate(ctx, ProtoToDeidentifyTemplate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DeidentifyTemplateToProto(res), nil
+}
+
+// HasDiffDlpAlphaDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DeidentifyTemplateServer) HasDiffDlpAlphaDeidentifyTemplate(ctx context.Context, request *alphapb.HasDiffDlpAlphaDeidentifyTemplateRequest) (*alphapb.HasDiffDlpAlphaDeidentifyTemplateResponse, error) {
+	cl, err := createConfigDeidentifyTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDeidentifyTemplate(ctx, ProtoToDeidentifyTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffDlpAlphaDeidentifyTemplateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDlpAlphaDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DeidentifyTemplateServer) PlanDlpAlphaDeidentifyTemplate(ctx context.Context, request *alphapb.PlanDlpAlphaDeidentifyTemplateRequest) (*alphapb.PlanDlpAlphaDeidentifyTemplateResponse, error) {
+	cl, err := createConfigDeidentifyTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDeidentifyTemplate(ctx, ProtoToDeidentifyTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanDlpAlphaDeidentifyTemplateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Delete() method.
 func (s *DeidentifyTemplateServer) DeleteDlpAlphaDeidentifyTemplate(ctx context.Context, request *alphapb.DeleteDlpAlphaDeidentifyTemplateRequest) (*emptypb.Empty, error) {
 
@@ -5630,22 +5689,35 @@ func (s *DeidentifyTemplateServer) ListDlpAlphaDeidentifyTemplate(ctx context.Co
 		return nil, err
 	}
 
-	resources, err := cl.ListDeidentifyTemplate(ctx, request.GetLocation(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.DeidentifyTemplateMaxPage
+	}
+	resources, err := cl.ListDeidentifyTemplateWithPageToken(ctx, request.GetLocation(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.DlpAlphaDeidentifyTemplate
-	for _, r := range resources.Items {
-		rp := DeidentifyTemplateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DeidentifyTemplateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListDlpAlphaDeidentifyTemplateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDeidentifyTemplate(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
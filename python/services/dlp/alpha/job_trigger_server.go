@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dlp/alpha/dlp_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dlp/alpha"
@@ -2015,7 +2016,11 @@ func JobTriggerToProto(resource *alpha.JobTrigger) *alphapb.DlpAlphaJobTrigger {
 // applyJobTrigger handles the gRPC request by passing it to the underlying JobTrigger Apply() method.
 func (s *JobTriggerServer) applyJobTrigger(ctx context.Context, c *alpha.Client, request *alphapb.ApplyDlpAlphaJobTriggerRequest) (*alphapb.DlpAlphaJobTrigger, error) {
 	p := ProtoToJobTrigger(request.GetResource())
-	res, err := c.ApplyJobTrigger(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyJobTrigger(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2032,6 +2037,60 @@ func (s *JobTriggerServer) ApplyDlpAlphaJobTrigger(ctx context.Context, request
 	return s.applyJobTrigger(ctx, cl, request)
 }
 
+// GetDlpAlphaJobTrigger handles the gRPC request by passing it to the underlying JobTrigger Get() method.
+func (s *JobTriggerServer) GetDlpAlphaJobTrigger(ctx context.Context, request *alphapb.GetDlpAlphaJobTriggerRequest) (*alphapb.DlpAlphaJobTrigger, error) {
+	cl, err := createConfigJobTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetJobTrigger(ctx, ProtoToJobTrigger(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return JobTriggerToProto(res), nil
+}
+
+// HasDiffDlpAlphaJobTrigger handles the gRPC request by passing it to the underlying JobTrigger Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *JobTriggerServer) HasDiffDlpAlphaJobTrigger(ctx context.Context, request *alphapb.HasDiffDlpAlphaJobTriggerRequest) (*alphapb.HasDiffDlpAlphaJobTriggerResponse, error) {
+	cl, err := createConfigJobTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJobTrigger(ctx, ProtoToJobTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffDlpAlphaJobTriggerResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDlpAlphaJobTrigger handles the gRPC request by passing it to the underlying JobTrigger Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *JobTriggerServer) PlanDlpAlphaJobTrigger(ctx context.Context, request *alphapb.PlanDlpAlphaJobTriggerRequest) (*alphapb.PlanDlpAlphaJobTriggerResponse, error) {
+	cl, err := createConfigJobTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJobTrigger(ctx, ProtoToJobTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanDlpAlphaJobTriggerResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteJobTrigger handles the gRPC request by passing it to the underlying JobTrigger Delete() method.
 func (s *JobTriggerServer) DeleteDlpAlphaJobTrigger(ctx context.Context, request *alphapb.DeleteDlpAlphaJobTriggerRequest) (*emptypb.Empty, error) {
 
@@ -2050,22 +2109,35 @@ func (s *JobTriggerServer) ListDlpAlphaJobTrigger(ctx context.Context, request *
 		return nil, err
 	}
 
-	resources, err := cl.ListJobTrigger(ctx, request.GetLocation(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.JobTriggerMaxPage
+	}
+	resources, err := cl.ListJobTriggerWithPageToken(ctx, request.GetLocation(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.DlpAlphaJobTrigger
-	for _, r := range resources.Items {
-		rp := JobTriggerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, JobTriggerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListDlpAlphaJobTriggerResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigJobTrigger(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dlp/alpha/dlp_alpha_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dlp/alpha"
@@ -292,7 +293,11 @@ func StoredInfoTypeToProto(resource *alpha.StoredInfoType) *alphapb.DlpAlphaStor
 // applyStoredInfoType handles the gRPC request by passing it to the underlying StoredInfoType Apply() method.
 func (s *StoredInfoTypeServer) applyStoredInfoType(ctx context.Context, c *alpha.Client, request *alphapb.ApplyDlpAlphaStoredInfoTypeRequest) (*alphapb.DlpAlphaStoredInfoType, error) {
 	p := ProtoToStoredInfoType(request.GetResource())
-	res, err := c.ApplyStoredInfoType(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyStoredInfoType(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -309,6 +314,60 @@ func (s *StoredInfoTypeServer) ApplyDlpAlphaStoredInfoType(ctx context.Context,
 	return s.applyStoredInfoType(ctx, cl, request)
 }
 
+// GetDlpAlphaStoredInfoType handles the gRPC request by passing it to the underlying StoredInfoType Get() method.
+func (s *StoredInfoTypeServer) GetDlpAlphaStoredInfoType(ctx context.Context, request *alphapb.GetDlpAlphaStoredInfoTypeRequest) (*alphapb.DlpAlphaStoredInfoType, error) {
+	cl, err := createConfigStoredInfoType(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetStoredInfoType(ctx, ProtoToStoredInfoType(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return StoredInfoTypeToProto(res), nil
+}
+
+// HasDiffDlpAlphaStoredInfoType handles the gRPC request by passing it to the underlying StoredInfoType Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *StoredInfoTypeServer) HasDiffDlpAlphaStoredInfoType(ctx context.Context, request *alphapb.HasDiffDlpAlphaStoredInfoTypeRequest) (*alphapb.HasDiffDlpAlphaStoredInfoTypeResponse, error) {
+	cl, err := createConfigStoredInfoType(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffStoredInfoType(ctx, ProtoToStoredInfoType(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffDlpAlphaStoredInfoTypeResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDlpAlphaStoredInfoType handles the gRPC request by passing it to the underlying StoredInfoType Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *StoredInfoTypeServer) PlanDlpAlphaStoredInfoType(ctx context.Context, request *alphapb.PlanDlpAlphaStoredInfoTypeRequest) (*alphapb.PlanDlpAlphaStoredInfoTypeResponse, error) {
+	cl, err := createConfigStoredInfoType(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffStoredInfoType(ctx, ProtoToStoredInfoType(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanDlpAlphaStoredInfoTypeResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteStoredInfoType handles the gRPC request by passing it to the underlying StoredInfoType Delete() method.
 func (s *StoredInfoTypeServer) DeleteDlpAlphaStoredInfoType(ctx context.Context, request *alphapb.DeleteDlpAlphaStoredInfoTypeRequest) (*emptypb.Empty, error) {
 
@@ -327,22 +386,35 @@ func (s *StoredInfoTypeServer) ListDlpAlphaStoredInfoType(ctx context.Context, r
 		return nil, err
 	}
 
-	resources, err := cl.ListStoredInfoType(ctx, request.GetLocation(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.StoredInfoTypeMaxPage
+	}
+	resources, err := cl.ListStoredInfoTypeWithPageToken(ctx, request.GetLocation(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.DlpAlphaStoredInfoType
-	for _, r := range resources.Items {
-		rp := StoredInfoTypeToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, StoredInfoTypeToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListDlpAlphaStoredInfoTypeResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigStoredInfoType(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
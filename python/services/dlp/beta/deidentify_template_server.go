@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dlp/beta/dlp_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dlp/beta"
@@ -5595,7 +5596,11 @@ func DeidentifyTemplateToProto(resource *beta.DeidentifyTemplate) *betapb.DlpBet
 // applyDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Apply() method.
 func (s *DeidentifyTemplateServer) applyDeidentifyTemplate(ctx context.Context, c *beta.Client, request *betapb.ApplyDlpBetaDeidentifyTemplateRequest) (*betapb.DlpBetaDeidentifyTemplate, error) {
 	p := ProtoToDeidentifyTemplate(request.GetResource())
-	res, err := c.ApplyDeidentifyTemplate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDeidentifyTemplate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5612,6 +5617,60 @@ func (s *DeidentifyTemplateServer) ApplyDlpBetaDeidentifyTemplate(ctx context.Co
 	return s.applyDeidentifyTemplate(ctx, cl, request)
 }
 
+// GetDlpBetaDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Get() method.
+func (s *DeidentifyTemplateServer) GetDlpBetaDeidentifyTemplate(ctx context.Context, request *betapb.GetDlpBetaDeidentifyTemplateRequest) (*betapb.DlpBetaDeidentifyTemplate, error) {
+	cl, err := createConfigDeidentifyTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDeidentifyTemplate(ctx, ProtoToDeidentifyTemplate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DeidentifyTemplateToProto(res), nil
+}
+
+// HasDiffDlpBetaDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DeidentifyTemplateServer) HasDiffDlpBetaDeidentifyTemplate(ctx context.Context, request *betapb.HasDiffDlpBetaDeidentifyTemplateRequest) (*betapb.HasDiffDlpBetaDeidentifyTemplateResponse, error) {
+	cl, err := createConfigDeidentifyTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDeidentifyTemplate(ctx, ProtoToDeidentifyTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffDlpBetaDeidentifyTemplateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDlpBetaDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DeidentifyTemplateServer) PlanDlpBetaDeidentifyTemplate(ctx context.Context, request *betapb.PlanDlpBetaDeidentifyTemplateRequest) (*betapb.PlanDlpBetaDeidentifyTemplateResponse, error) {
+	cl, err := createConfigDeidentifyTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDeidentifyTemplate(ctx, ProtoToDeidentifyTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanDlpBetaDeidentifyTemplateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Delete() method.
 func (s *DeidentifyTemplateServer) DeleteDlpBetaDeidentifyTemplate(ctx context.Context, request *betapb.DeleteDlpBetaDeidentifyTemplateRequest) (*emptypb.Empty, error) {
 
@@ -5630,22 +5689,35 @@ func (s *DeidentifyTemplateServer) ListDlpBetaDeidentifyTemplate(ctx context.Con
 		return nil, err
 	}
 
-	resources, err := cl.ListDeidentifyTemplate(ctx, request.GetLocation(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.DeidentifyTemplateMaxPage
+	}
+	resources, err := cl.ListDeidentifyTemplateWithPageToken(ctx, request.GetLocation(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.DlpBetaDeidentifyTemplate
-	for _, r := range resources.Items {
-		rp := DeidentifyTemplateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DeidentifyTemplateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListDlpBetaDeidentifyTemplateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDeidentifyTemplate(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
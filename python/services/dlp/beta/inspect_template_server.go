@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dlp/beta/dlp_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dlp/beta"
@@ -897,7 +898,11 @@ func InspectTemplateToProto(resource *beta.InspectTemplate) *betapb.DlpBetaInspe
 // applyInspectTemplate handles the gRPC request by passing it to the underlying InspectTemplate Apply() method.
 func (s *InspectTemplateServer) applyInspectTemplate(ctx context.Context, c *beta.Client, request *betapb.ApplyDlpBetaInspectTemplateRequest) (*betapb.DlpBetaInspectTemplate, error) {
 	p := ProtoToInspectTemplate(request.GetResource())
-	res, err := c.ApplyInspectTemplate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInspectTemplate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -914,6 +919,60 @@ func (s *InspectTemplateServer) ApplyDlpBetaInspectTemplate(ctx context.Context,
 	return s.applyInspectTemplate(ctx, cl, request)
 }
 
+// GetDlpBetaInspectTemplate handles the gRPC request by passing it to the underlying InspectTemplate Get() method.
+func (s *InspectTemplateServer) GetDlpBetaInspectTemplate(ctx context.Context, request *betapb.GetDlpBetaInspectTemplateRequest) (*betapb.DlpBetaInspectTemplate, error) {
+	cl, err := createConfigInspectTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInspectTemplate(ctx, ProtoToInspectTemplate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InspectTemplateToProto(res), nil
+}
+
+// HasDiffDlpBetaInspectTemplate handles the gRPC request by passing it to the underlying InspectTemplate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InspectTemplateServer) HasDiffDlpBetaInspectTemplate(ctx context.Context, request *betapb.HasDiffDlpBetaInspectTemplateRequest) (*betapb.HasDiffDlpBetaInspectTemplateResponse, error) {
+	cl, err := createConfigInspectTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInspectTemplate(ctx, ProtoToInspectTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffDlpBetaInspectTemplateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDlpBetaInspectTemplate handles the gRPC request by passing it to the underlying InspectTemplate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InspectTemplateServer) PlanDlpBetaInspectTemplate(ctx context.Context, request *betapb.PlanDlpBetaInspectTemplateRequest) (*betapb.PlanDlpBetaInspectTemplateResponse, error) {
+	cl, err := createConfigInspectTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInspectTemplate(ctx, ProtoToInspectTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanDlpBetaInspectTemplateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInspectTemplate handles the gRPC request by passing it to the underlying InspectTemplate Delete() method.
 func (s *InspectTemplateServer) DeleteDlpBetaInspectTemplate(ctx context.Context, request *betapb.DeleteDlpBetaInspectTemplateRequest) (*emptypb.Empty, error) {
 
@@ -932,22 +991,35 @@ func (s *InspectTemplateServer) ListDlpBetaInspectTemplate(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListInspectTemplate(ctx, request.GetLocation(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.InspectTemplateMaxPage
+	}
+	resources, err := cl.ListInspectTemplateWithPageToken(ctx, request.GetLocation(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.DlpBetaInspectTemplate
-	for _, r := range resources.Items {
-		rp := InspectTemplateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InspectTemplateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListDlpBetaInspectTemplateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInspectTemplate(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dlp/beta/dlp_beta_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dlp/beta"
@@ -2015,7 +2016,11 @@ func JobTriggerToProto(resource *beta.JobTrigger) *betapb.DlpBetaJobTrigger {
 // applyJobTrigger handles the gRPC request by passing it to the underlying JobTrigger Apply() method.
 func (s *JobTriggerServer) applyJobTrigger(ctx context.Context, c *beta.Client, request *betapb.ApplyDlpBetaJobTriggerRequest) (*betapb.DlpBetaJobTrigger, error) {
 	p := ProtoToJobTrigger(request.GetResource())
-	res, err := c.ApplyJobTrigger(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyJobTrigger(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2032,6 +2037,60 @@ func (s *JobTriggerServer) ApplyDlpBetaJobTrigger(ctx context.Context, request *
 	return s.applyJobTrigger(ctx, cl, request)
 }
 
+// GetDlpBetaJobTrigger handles the gRPC request by passing it to the underlying JobTrigger Get() method.
+func (s *JobTriggerServer) GetDlpBetaJobTrigger(ctx context.Context, request *betapb.GetDlpBetaJobTriggerRequest) (*betapb.DlpBetaJobTrigger, error) {
+	cl, err := createConfigJobTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetJobTrigger(ctx, ProtoToJobTrigger(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return JobTriggerToProto(res), nil
+}
+
+// HasDiffDlpBetaJobTrigger handles the gRPC request by passing it to the underlying JobTrigger Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *JobTriggerServer) HasDiffDlpBetaJobTrigger(ctx context.Context, request *betapb.HasDiffDlpBetaJobTriggerRequest) (*betapb.HasDiffDlpBetaJobTriggerResponse, error) {
+	cl, err := createConfigJobTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJobTrigger(ctx, ProtoToJobTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffDlpBetaJobTriggerResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDlpBetaJobTrigger handles the gRPC request by passing it to the underlying JobTrigger Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *JobTriggerServer) PlanDlpBetaJobTrigger(ctx context.Context, request *betapb.PlanDlpBetaJobTriggerRequest) (*betapb.PlanDlpBetaJobTriggerResponse, error) {
+	cl, err := createConfigJobTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJobTrigger(ctx, ProtoToJobTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanDlpBetaJobTriggerResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteJobTrigger handles the gRPC request by passing it to the underlying JobTrigger Delete() method.
 func (s *JobTriggerServer) DeleteDlpBetaJobTrigger(ctx context.Context, request *betapb.DeleteDlpBetaJobTriggerRequest) (*emptypb.Empty, error) {
 
@@ -2050,22 +2109,35 @@ func (s *JobTriggerServer) ListDlpBetaJobTrigger(ctx context.Context, request *b
 		return nil, err
 	}
 
-	resources, err := cl.ListJobTrigger(ctx, request.GetLocation(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.JobTriggerMaxPage
+	}
+	resources, err := cl.ListJobTriggerWithPageToken(ctx, request.GetLocation(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.DlpBetaJobTrigger
-	for _, r := range resources.Items {
-		rp := JobTriggerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, JobTriggerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListDlpBetaJobTriggerResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigJobTrigger(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
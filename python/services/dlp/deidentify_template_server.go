@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	dlppb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dlp/dlp_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dlp"
@@ -5595,7 +5596,11 @@ func DeidentifyTemplateToProto(resource *dlp.DeidentifyTemplate) *dlppb.DlpDeide
 // applyDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Apply() method.
 func (s *DeidentifyTemplateServer) applyDeidentifyTemplate(ctx context.Context, c *dlp.Client, request *dlppb.ApplyDlpDeidentifyTemplateRequest) (*dlppb.DlpDeidentifyTemplate, error) {
 	p := ProtoToDeidentifyTemplate(request.GetResource())
-	res, err := c.ApplyDeidentifyTemplate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDeidentifyTemplate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5612,6 +5617,60 @@ func (s *DeidentifyTemplateServer) ApplyDlpDeidentifyTemplate(ctx context.Contex
 	return s.applyDeidentifyTemplate(ctx, cl, request)
 }
 
+// GetDlpDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Get() method.
+func (s *DeidentifyTemplateServer) GetDlpDeidentifyTemplate(ctx context.Context, request *dlppb.GetDlpDeidentifyTemplateRequest) (*dlppb.DlpDeidentifyTemplate, error) {
+	cl, err := createConfigDeidentifyTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDeidentifyTemplate(ctx, ProtoToDeidentifyTemplate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DeidentifyTemplateToProto(res), nil
+}
+
+// HasDiffDlpDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DeidentifyTemplateServer) HasDiffDlpDeidentifyTemplate(ctx context.Context, request *dlppb.HasDiffDlpDeidentifyTemplateRequest) (*dlppb.HasDiffDlpDeidentifyTemplateResponse, error) {
+	cl, err := createConfigDeidentifyTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDeidentifyTemplate(ctx, ProtoToDeidentifyTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &dlppb.HasDiffDlpDeidentifyTemplateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDlpDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DeidentifyTemplateServer) PlanDlpDeidentifyTemplate(ctx context.Context, request *dlppb.PlanDlpDeidentifyTemplateRequest) (*dlppb.PlanDlpDeidentifyTemplateResponse, error) {
+	cl, err := createConfigDeidentifyTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDeidentifyTemplate(ctx, ProtoToDeidentifyTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &dlppb.PlanDlpDeidentifyTemplateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDeidentifyTemplate handles the gRPC request by passing it to the underlying DeidentifyTemplate Delete() method.
 func (s *DeidentifyTemplateServer) DeleteDlpDeidentifyTemplate(ctx context.Context, request *dlppb.DeleteDlpDeidentifyTemplateRequest) (*emptypb.Empty, error) {
 
@@ -5630,22 +5689,35 @@ func (s *DeidentifyTemplateServer) ListDlpDeidentifyTemplate(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListDeidentifyTemplate(ctx, request.GetLocation(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = dlp.DeidentifyTemplateMaxPage
+	}
+	resources, err := cl.ListDeidentifyTemplateWithPageToken(ctx, request.GetLocation(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*dlppb.DlpDeidentifyTemplate
-	for _, r := range resources.Items {
-		rp := DeidentifyTemplateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DeidentifyTemplateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &dlppb.ListDlpDeidentifyTemplateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDeidentifyTemplate(ctx context.Context, service_account_file string) (*dlp.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return dlp.NewClient(conf), nil
 }
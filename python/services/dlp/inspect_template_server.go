@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	dlppb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dlp/dlp_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dlp"
@@ -897,7 +898,11 @@ func InspectTemplateToProto(resource *dlp.InspectTemplate) *dlppb.DlpInspectTemp
 // applyInspectTemplate handles the gRPC request by passing it to the underlying InspectTemplate Apply() method.
 func (s *InspectTemplateServer) applyInspectTemplate(ctx context.Context, c *dlp.Client, request *dlppb.ApplyDlpInspectTemplateRequest) (*dlppb.DlpInspectTemplate, error) {
 	p := ProtoToInspectTemplate(request.GetResource())
-	res, err := c.ApplyInspectTemplate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInspectTemplate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -914,6 +919,60 @@ func (s *InspectTemplateServer) ApplyDlpInspectTemplate(ctx context.Context, req
 	return s.applyInspectTemplate(ctx, cl, request)
 }
 
+// GetDlpInspectTemplate handles the gRPC request by passing it to the underlying InspectTemplate Get() method.
+func (s *InspectTemplateServer) GetDlpInspectTemplate(ctx context.Context, request *dlppb.GetDlpInspectTemplateRequest) (*dlppb.DlpInspectTemplate, error) {
+	cl, err := createConfigInspectTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInspectTemplate(ctx, ProtoToInspectTemplate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InspectTemplateToProto(res), nil
+}
+
+// HasDiffDlpInspectTemplate handles the gRPC request by passing it to the underlying InspectTemplate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InspectTemplateServer) HasDiffDlpInspectTemplate(ctx context.Context, request *dlppb.HasDiffDlpInspectTemplateRequest) (*dlppb.HasDiffDlpInspectTemplateResponse, error) {
+	cl, err := createConfigInspectTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInspectTemplate(ctx, ProtoToInspectTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &dlppb.HasDiffDlpInspectTemplateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDlpInspectTemplate handles the gRPC request by passing it to the underlying InspectTemplate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InspectTemplateServer) PlanDlpInspectTemplate(ctx context.Context, request *dlppb.PlanDlpInspectTemplateRequest) (*dlppb.PlanDlpInspectTemplateResponse, error) {
+	cl, err := createConfigInspectTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInspectTemplate(ctx, ProtoToInspectTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &dlppb.PlanDlpInspectTemplateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteInspectTemplate handles the gRPC request by passing it to the underlying InspectTemplate Delete() method.
 func (s *InspectTemplateServer) DeleteDlpInspectTemplate(ctx context.Context, request *dlppb.DeleteDlpInspectTemplateRequest) (*emptypb.Empty, error) {
 
@@ -932,22 +991,35 @@ func (s *InspectTemplateServer) ListDlpInspectTemplate(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListInspectTemplate(ctx, request.GetLocation(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = dlp.InspectTemplateMaxPage
+	}
+	resources, err := cl.ListInspectTemplateWithPageToken(ctx, request.GetLocation(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*dlppb.DlpInspectTemplate
-	for _, r := range resources.Items {
-		rp := InspectTemplateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InspectTemplateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &dlppb.ListDlpInspectTemplateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigInspectTemplate(ctx context.Context, service_account_file string) (*dlp.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return dlp.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	dlppb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dlp/dlp_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dlp"
@@ -292,7 +293,11 @@ func StoredInfoTypeToProto(resource *dlp.StoredInfoType) *dlppb.DlpStoredInfoTyp
 // applyStoredInfoType handles the gRPC request by passing it to the underlying StoredInfoType Apply() method.
 func (s *StoredInfoTypeServer) applyStoredInfoType(ctx context.Context, c *dlp.Client, request *dlppb.ApplyDlpStoredInfoTypeRequest) (*dlppb.DlpStoredInfoType, error) {
 	p := ProtoToStoredInfoType(request.GetResource())
-	res, err := c.ApplyStoredInfoType(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyStoredInfoType(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -309,6 +314,60 @@ func (s *StoredInfoTypeServer) ApplyDlpStoredInfoType(ctx context.Context, reque
 	return s.applyStoredInfoType(ctx, cl, request)
 }
 
+// GetDlpStoredInfoType handles the gRPC request by passing it to the underlying StoredInfoType Get() method.
+func (s *StoredInfoTypeServer) GetDlpStoredInfoType(ctx context.Context, request *dlppb.GetDlpStoredInfoTypeRequest) (*dlppb.DlpStoredInfoType, error) {
+	cl, err := createConfigStoredInfoType(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetStoredInfoType(ctx, ProtoToStoredInfoType(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return StoredInfoTypeToProto(res), nil
+}
+
+// HasDiffDlpStoredInfoType handles the gRPC request by passing it to the underlying StoredInfoType Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *StoredInfoTypeServer) HasDiffDlpStoredInfoType(ctx context.Context, request *dlppb.HasDiffDlpStoredInfoTypeRequest) (*dlppb.HasDiffDlpStoredInfoTypeResponse, error) {
+	cl, err := createConfigStoredInfoType(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffStoredInfoType(ctx, ProtoToStoredInfoType(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &dlppb.HasDiffDlpStoredInfoTypeResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanDlpStoredInfoType handles the gRPC request by passing it to the underlying StoredInfoType Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *StoredInfoTypeServer) PlanDlpStoredInfoType(ctx context.Context, request *dlppb.PlanDlpStoredInfoTypeRequest) (*dlppb.PlanDlpStoredInfoTypeResponse, error) {
+	cl, err := createConfigStoredInfoType(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffStoredInfoType(ctx, ProtoToStoredInfoType(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &dlppb.PlanDlpStoredInfoTypeResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteStoredInfoType handles the gRPC request by passing it to the underlying StoredInfoType Delete() method.
 func (s *StoredInfoTypeServer) DeleteDlpStoredInfoType(ctx context.Context, request *dlppb.DeleteDlpStoredInfoTypeRequest) (*emptypb.Empty, error) {
 
@@ -327,22 +386,35 @@ func (s *StoredInfoTypeServer) ListDlpStoredInfoType(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListStoredInfoType(ctx, request.GetLocation(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = dlp.StoredInfoTypeMaxPage
+	}
+	resources, err := cl.ListStoredInfoTypeWithPageToken(ctx, request.GetLocation(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*dlppb.DlpStoredInfoType
-	for _, r := range resources.Items {
-		rp := StoredInfoTypeToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, StoredInfoTypeToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &dlppb.ListDlpStoredInfoTypeResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigStoredInfoType(ctx context.Context, service_account_file string) (*dlp.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return dlp.NewClient(conf), nil
 }
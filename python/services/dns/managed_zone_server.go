@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	dnspb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dns/dns_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dns"
@@ -405,7 +406,11 @@ func ManagedZoneToProto(resource *dns.ManagedZone) *dnspb.DnsManagedZone {
 // ApplyManagedZone handles the gRPC request by passing it to the underlying ManagedZone Apply() method.
 func (s *ManagedZoneServer) applyManagedZone(ctx context.Context, c *dns.Client, request *dnspb.ApplyDnsManagedZoneRequest) (*dnspb.DnsManagedZone, error) {
 	p := ProtoToManagedZone(request.GetResource())
-	res, err := c.ApplyManagedZone(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyManagedZone(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -422,6 +427,55 @@ func (s *ManagedZoneServer) ApplyDnsManagedZone(ctx context.Context, request *dn
 	return s.applyManagedZone(ctx, cl, request)
 }
 
+// GetDnsManagedZone handles the gRPC request by passing it to the underlying ManagedZone Get() method.
+func (s *ManagedZoneServer) GetDnsManagedZone(ctx context.Context, request *dnspb.GetDnsManagedZoneRequest) (*dnspb.DnsManagedZone, error) {
+	cl, err := createConfigManagedZone(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetManagedZone(ctx, ProtoToManagedZone(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ManagedZoneToProto(res), nil
+}
+
+// HasDiffDnsManagedZone handles the gRPC request by passing it to the underlying ManagedZone Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ManagedZoneServer) HasDiffDnsManagedZone(ctx context.Context, request *dnspb.HasDiffDnsManagedZoneRequest) (*dnspb.HasDiffDnsManagedZoneResponse, error) {
+	cl, err := createConfigManagedZone(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffManagedZone(ctx, ProtoToManagedZone(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &dnspb.HasDiffDnsManagedZoneResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanDnsManagedZone handles the gRPC request by passing it to the underlying ManagedZone Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ManagedZoneServer) PlanDnsManagedZone(ctx context.Context, request *dnspb.PlanDnsManagedZoneRequest) (*dnspb.PlanDnsManagedZoneResponse, error) {
+	cl, err := createConfigManagedZone(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffManagedZone(ctx, ProtoToManagedZone(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &dnspb.PlanDnsManagedZoneResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteManagedZone handles the gRPC request by passing it to the underlying ManagedZone Delete() method.
 func (s *ManagedZoneServer) DeleteDnsManagedZone(ctx context.Context, request *dnspb.DeleteDnsManagedZoneRequest) (*emptypb.Empty, error) {
 
@@ -440,20 +494,32 @@ func (s *ManagedZoneServer) ListDnsManagedZone(ctx context.Context, request *dns
 		return nil, err
 	}
 
-	resources, err := cl.ListManagedZone(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = dns.ManagedZoneMaxPage
+	}
+	resources, err := cl.ListManagedZoneWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*dnspb.DnsManagedZone
-	for _, r := range resources.Items {
-		rp := ManagedZoneToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ManagedZoneToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &dnspb.ListDnsManagedZoneResponse{Items: protos}, nil
+	return &dnspb.ListDnsManagedZoneResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigManagedZone(ctx context.Context, service_account_file string) (*dns.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return dns.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	dnspb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/dns/dns_go_proto"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dns"
@@ -59,7 +60,11 @@ func ResourceRecordSetToProto(resource *dns.ResourceRecordSet) *dnspb.DnsResourc
 // ApplyResourceRecordSet handles the gRPC request by passing it to the underlying ResourceRecordSet Apply() method.
 func (s *ResourceRecordSetServer) applyResourceRecordSet(ctx context.Context, c *dns.Client, request *dnspb.ApplyDnsResourceRecordSetRequest) (*dnspb.DnsResourceRecordSet, error) {
 	p := ProtoToResourceRecordSet(request.GetResource())
-	res, err := c.ApplyResourceRecordSet(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyResourceRecordSet(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +81,55 @@ func (s *ResourceRecordSetServer) ApplyDnsResourceRecordSet(ctx context.Context,
 	return s.applyResourceRecordSet(ctx, cl, request)
 }
 
+// GetDnsResourceRecordSet handles the gRPC request by passing it to the underlying ResourceRecordSet Get() method.
+func (s *ResourceRecordSetServer) GetDnsResourceRecordSet(ctx context.Context, request *dnspb.GetDnsResourceRecordSetRequest) (*dnspb.DnsResourceRecordSet, error) {
+	cl, err := createConfigResourceRecordSet(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetResourceRecordSet(ctx, ProtoToResourceRecordSet(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ResourceRecordSetToProto(res), nil
+}
+
+// HasDiffDnsResourceRecordSet handles the gRPC request by passing it to the underlying ResourceRecordSet Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ResourceRecordSetServer) HasDiffDnsResourceRecordSet(ctx context.Context, request *dnspb.HasDiffDnsResourceRecordSetRequest) (*dnspb.HasDiffDnsResourceRecordSetResponse, error) {
+	cl, err := createConfigResourceRecordSet(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffResourceRecordSet(ctx, ProtoToResourceRecordSet(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &dnspb.HasDiffDnsResourceRecordSetResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanDnsResourceRecordSet handles the gRPC request by passing it to the underlying ResourceRecordSet Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ResourceRecordSetServer) PlanDnsResourceRecordSet(ctx context.Context, request *dnspb.PlanDnsResourceRecordSetRequest) (*dnspb.PlanDnsResourceRecordSetResponse, error) {
+	cl, err := createConfigResourceRecordSet(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffResourceRecordSet(ctx, ProtoToResourceRecordSet(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &dnspb.PlanDnsResourceRecordSetResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteResourceRecordSet handles the gRPC request by passing it to the underlying ResourceRecordSet Delete() method.
 func (s *ResourceRecordSetServer) DeleteDnsResourceRecordSet(ctx context.Context, request *dnspb.DeleteDnsResourceRecordSetRequest) (*emptypb.Empty, error) {
 
@@ -94,20 +148,32 @@ func (s *ResourceRecordSetServer) ListDnsResourceRecordSet(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListResourceRecordSet(ctx, request.Project, request.ManagedZone)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = dns.ResourceRecordSetMaxPage
+	}
+	resources, err := cl.ListResourceRecordSetWithPageToken(ctx, request.Project, request.ManagedZone, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*dnspb.DnsResourceRecordSet
-	for _, r := range resources.Items {
-		rp := ResourceRecordSetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ResourceRecordSetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &dnspb.ListDnsResourceRecordSetResponse{Items: protos}, nil
+	return &dnspb.ListDnsResourceRecordSetResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigResourceRecordSet(ctx context.Context, service_account_file string) (*dns.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return dns.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/eventarc/alpha/eventarc_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/eventarc/alpha"
@@ -86,7 +87,11 @@ func ChannelToProto(resource *alpha.Channel) *alphapb.EventarcAlphaChannel {
 // applyChannel handles the gRPC request by passing it to the underlying Channel Apply() method.
 func (s *ChannelServer) applyChannel(ctx context.Context, c *alpha.Client, request *alphapb.ApplyEventarcAlphaChannelRequest) (*alphapb.EventarcAlphaChannel, error) {
 	p := ProtoToChannel(request.GetResource())
-	res, err := c.ApplyChannel(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyChannel(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +108,60 @@ func (s *ChannelServer) ApplyEventarcAlphaChannel(ctx context.Context, request *
 	return s.applyChannel(ctx, cl, request)
 }
 
+// GetEventarcAlphaChannel handles the gRPC request by passing it to the underlying Channel Get() method.
+func (s *ChannelServer) GetEventarcAlphaChannel(ctx context.Context, request *alphapb.GetEventarcAlphaChannelRequest) (*alphapb.EventarcAlphaChannel, error) {
+	cl, err := createConfigChannel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetChannel(ctx, ProtoToChannel(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ChannelToProto(res), nil
+}
+
+// HasDiffEventarcAlphaChannel handles the gRPC request by passing it to the underlying Channel Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ChannelServer) HasDiffEventarcAlphaChannel(ctx context.Context, request *alphapb.HasDiffEventarcAlphaChannelRequest) (*alphapb.HasDiffEventarcAlphaChannelResponse, error) {
+	cl, err := createConfigChannel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffChannel(ctx, ProtoToChannel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffEventarcAlphaChannelResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanEventarcAlphaChannel handles the gRPC request by passing it to the underlying Channel Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ChannelServer) PlanEventarcAlphaChannel(ctx context.Context, request *alphapb.PlanEventarcAlphaChannelRequest) (*alphapb.PlanEventarcAlphaChannelResponse, error) {
+	cl, err := createConfigChannel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffChannel(ctx, ProtoToChannel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanEventarcAlphaChannelResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteChannel handles the gRPC request by passing it to the underlying Channel Delete() method.
 func (s *ChannelServer) DeleteEventarcAlphaChannel(ctx context.Context, request *alphapb.DeleteEventarcAlphaChannelRequest) (*emptypb.Empty, error) {
 
@@ -121,22 +180,35 @@ func (s *ChannelServer) ListEventarcAlphaChannel(ctx context.Context, request *a
 		return nil, err
 	}
 
-	resources, err := cl.ListChannel(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ChannelMaxPage
+	}
+	resources, err := cl.ListChannelWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.EventarcAlphaChannel
-	for _, r := range resources.Items {
-		rp := ChannelToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ChannelToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListEventarcAlphaChannelResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigChannel(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/eventarc/alpha/eventarc_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/eventarc/alpha"
@@ -51,7 +52,11 @@ func GoogleChannelConfigToProto(resource *alpha.GoogleChannelConfig) *alphapb.Ev
 // applyGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Apply() method.
 func (s *GoogleChannelConfigServer) applyGoogleChannelConfig(ctx context.Context, c *alpha.Client, request *alphapb.ApplyEventarcAlphaGoogleChannelConfigRequest) (*alphapb.EventarcAlphaGoogleChannelConfig, error) {
 	p := ProtoToGoogleChannelConfig(request.GetResource())
-	res, err := c.ApplyGoogleChannelConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGoogleChannelConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +73,60 @@ func (s *GoogleChannelConfigServer) ApplyEventarcAlphaGoogleChannelConfig(ctx co
 	return s.applyGoogleChannelConfig(ctx, cl, request)
 }
 
+// GetEventarcAlphaGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Get() method.
+func (s *GoogleChannelConfigServer) GetEventarcAlphaGoogleChannelConfig(ctx context.Context, request *alphapb.GetEventarcAlphaGoogleChannelConfigRequest) (*alphapb.EventarcAlphaGoogleChannelConfig, error) {
+	cl, err := createConfigGoogleChannelConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGoogleChannelConfig(ctx, ProtoToGoogleChannelConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GoogleChannelConfigToProto(res), nil
+}
+
+// HasDiffEventarcAlphaGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GoogleChannelConfigServer) HasDiffEventarcAlphaGoogleChannelConfig(ctx context.Context, request *alphapb.HasDiffEventarcAlphaGoogleChannelConfigRequest) (*alphapb.HasDiffEventarcAlphaGoogleChannelConfigResponse, error) {
+	cl, err := createConfigGoogleChannelConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGoogleChannelConfig(ctx, ProtoToGoogleChannelConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffEventarcAlphaGoogleChannelConfigResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanEventarcAlphaGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GoogleChannelConfigServer) PlanEventarcAlphaGoogleChannelConfig(ctx context.Context, request *alphapb.PlanEventarcAlphaGoogleChannelConfigRequest) (*alphapb.PlanEventarcAlphaGoogleChannelConfigResponse, error) {
+	cl, err := createConfigGoogleChannelConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGoogleChannelConfig(ctx, ProtoToGoogleChannelConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanEventarcAlphaGoogleChannelConfigResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Delete() method.
 func (s *GoogleChannelConfigServer) DeleteEventarcAlphaGoogleChannelConfig(ctx context.Context, request *alphapb.DeleteEventarcAlphaGoogleChannelConfigRequest) (*emptypb.Empty, error) {
 
@@ -85,7 +144,9 @@ func (s *GoogleChannelConfigServer) ListEventarcAlphaGoogleChannelConfig(_ conte
 }
 
 func createConfigGoogleChannelConfig(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/eventarc/alpha/eventarc_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/eventarc/alpha"
@@ -231,7 +232,11 @@ func TriggerToProto(resource *alpha.Trigger) *alphapb.EventarcAlphaTrigger {
 // applyTrigger handles the gRPC request by passing it to the underlying Trigger Apply() method.
 func (s *TriggerServer) applyTrigger(ctx context.Context, c *alpha.Client, request *alphapb.ApplyEventarcAlphaTriggerRequest) (*alphapb.EventarcAlphaTrigger, error) {
 	p := ProtoToTrigger(request.GetResource())
-	res, err := c.ApplyTrigger(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTrigger(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -248,6 +253,60 @@ func (s *TriggerServer) ApplyEventarcAlphaTrigger(ctx context.Context, request *
 	return s.applyTrigger(ctx, cl, request)
 }
 
+// GetEventarcAlphaTrigger handles the gRPC request by passing it to the underlying Trigger Get() method.
+func (s *TriggerServer) GetEventarcAlphaTrigger(ctx context.Context, request *alphapb.GetEventarcAlphaTriggerRequest) (*alphapb.EventarcAlphaTrigger, error) {
+	cl, err := createConfigTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTrigger(ctx, ProtoToTrigger(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TriggerToProto(res), nil
+}
+
+// HasDiffEventarcAlphaTrigger handles the gRPC request by passing it to the underlying Trigger Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TriggerServer) HasDiffEventarcAlphaTrigger(ctx context.Context, request *alphapb.HasDiffEventarcAlphaTriggerRequest) (*alphapb.HasDiffEventarcAlphaTriggerResponse, error) {
+	cl, err := createConfigTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTrigger(ctx, ProtoToTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffEventarcAlphaTriggerResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanEventarcAlphaTrigger handles the gRPC request by passing it to the underlying Trigger Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TriggerServer) PlanEventarcAlphaTrigger(ctx context.Context, request *alphapb.PlanEventarcAlphaTriggerRequest) (*alphapb.PlanEventarcAlphaTriggerResponse, error) {
+	cl, err := createConfigTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTrigger(ctx, ProtoToTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanEventarcAlphaTriggerResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTrigger handles the gRPC request by passing it to the underlying Trigger Delete() method.
 func (s *TriggerServer) DeleteEventarcAlphaTrigger(ctx context.Context, request *alphapb.DeleteEventarcAlphaTriggerRequest) (*emptypb.Empty, error) {
 
@@ -266,22 +325,35 @@ func (s *TriggerServer) ListEventarcAlphaTrigger(ctx context.Context, request *a
 		return nil, err
 	}
 
-	resources, err := cl.ListTrigger(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.TriggerMaxPage
+	}
+	resources, err := cl.ListTriggerWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.EventarcAlphaTrigger
-	for _, r := range resources.Items {
-		rp := TriggerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TriggerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListEventarcAlphaTriggerResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTrigger(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/eventarc/beta/eventarc_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/eventarc/beta"
@@ -86,7 +87,11 @@ func ChannelToProto(resource *beta.Channel) *betapb.EventarcBetaChannel {
 // applyChannel handles the gRPC request by passing it to the underlying Channel Apply() method.
 func (s *ChannelServer) applyChannel(ctx context.Context, c *beta.Client, request *betapb.ApplyEventarcBetaChannelRequest) (*betapb.EventarcBetaChannel, error) {
 	p := ProtoToChannel(request.GetResource())
-	res, err := c.ApplyChannel(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyChannel(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +108,60 @@ func (s *ChannelServer) ApplyEventarcBetaChannel(ctx context.Context, request *b
 	return s.applyChannel(ctx, cl, request)
 }
 
+// GetEventarcBetaChannel handles the gRPC request by passing it to the underlying Channel Get() method.
+func (s *ChannelServer) GetEventarcBetaChannel(ctx context.Context, request *betapb.GetEventarcBetaChannelRequest) (*betapb.EventarcBetaChannel, error) {
+	cl, err := createConfigChannel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetChannel(ctx, ProtoToChannel(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ChannelToProto(res), nil
+}
+
+// HasDiffEventarcBetaChannel handles the gRPC request by passing it to the underlying Channel Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ChannelServer) HasDiffEventarcBetaChannel(ctx context.Context, request *betapb.HasDiffEventarcBetaChannelRequest) (*betapb.HasDiffEventarcBetaChannelResponse, error) {
+	cl, err := createConfigChannel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffChannel(ctx, ProtoToChannel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffEventarcBetaChannelResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanEventarcBetaChannel handles the gRPC request by passing it to the underlying Channel Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ChannelServer) PlanEventarcBetaChannel(ctx context.Context, request *betapb.PlanEventarcBetaChannelRequest) (*betapb.PlanEventarcBetaChannelResponse, error) {
+	cl, err := createConfigChannel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffChannel(ctx, ProtoToChannel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanEventarcBetaChannelResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteChannel handles the gRPC request by passing it to the underlying Channel Delete() method.
 func (s *ChannelServer) DeleteEventarcBetaChannel(ctx context.Context, request *betapb.DeleteEventarcBetaChannelRequest) (*emptypb.Empty, error) {
 
@@ -121,22 +180,35 @@ func (s *ChannelServer) ListEventarcBetaChannel(ctx context.Context, request *be
 		return nil, err
 	}
 
-	resources, err := cl.ListChannel(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ChannelMaxPage
+	}
+	resources, err := cl.ListChannelWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.EventarcBetaChannel
-	for _, r := range resources.Items {
-		rp := ChannelToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ChannelToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListEventarcBetaChannelResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigChannel(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/eventarc/beta/eventarc_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/eventarc/beta"
@@ -51,7 +52,11 @@ func GoogleChannelConfigToProto(resource *beta.GoogleChannelConfig) *betapb.Even
 // applyGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Apply() method.
 func (s *GoogleChannelConfigServer) applyGoogleChannelConfig(ctx context.Context, c *beta.Client, request *betapb.ApplyEventarcBetaGoogleChannelConfigRequest) (*betapb.EventarcBetaGoogleChannelConfig, error) {
 	p := ProtoToGoogleChannelConfig(request.GetResource())
-	res, err := c.ApplyGoogleChannelConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGoogleChannelConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +73,60 @@ func (s *GoogleChannelConfigServer) ApplyEventarcBetaGoogleChannelConfig(ctx con
 	return s.applyGoogleChannelConfig(ctx, cl, request)
 }
 
+// GetEventarcBetaGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Get() method.
+func (s *GoogleChannelConfigServer) GetEventarcBetaGoogleChannelConfig(ctx context.Context, request *betapb.GetEventarcBetaGoogleChannelConfigRequest) (*betapb.EventarcBetaGoogleChannelConfig, error) {
+	cl, err := createConfigGoogleChannelConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGoogleChannelConfig(ctx, ProtoToGoogleChannelConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GoogleChannelConfigToProto(res), nil
+}
+
+// HasDiffEventarcBetaGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GoogleChannelConfigServer) HasDiffEventarcBetaGoogleChannelConfig(ctx context.Context, request *betapb.HasDiffEventarcBetaGoogleChannelConfigRequest) (*betapb.HasDiffEventarcBetaGoogleChannelConfigResponse, error) {
+	cl, err := createConfigGoogleChannelConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGoogleChannelConfig(ctx, ProtoToGoogleChannelConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffEventarcBetaGoogleChannelConfigResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanEventarcBetaGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GoogleChannelConfigServer) PlanEventarcBetaGoogleChannelConfig(ctx context.Context, request *betapb.PlanEventarcBetaGoogleChannelConfigRequest) (*betapb.PlanEventarcBetaGoogleChannelConfigResponse, error) {
+	cl, err := createConfigGoogleChannelConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGoogleChannelConfig(ctx, ProtoToGoogleChannelConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanEventarcBetaGoogleChannelConfigResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Delete() method.
 func (s *GoogleChannelConfigServer) DeleteEventarcBetaGoogleChannelConfig(ctx context.Context, request *betapb.DeleteEventarcBetaGoogleChannelConfigRequest) (*emptypb.Empty, error) {
 
@@ -85,7 +144,9 @@ func (s *GoogleChannelConfigServer) ListEventarcBetaGoogleChannelConfig(_ contex
 }
 
 func createConfigGoogleChannelConfig(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/eventarc/beta/eventarc_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/eventarc/beta"
@@ -231,7 +232,11 @@ func TriggerToProto(resource *beta.Trigger) *betapb.EventarcBetaTrigger {
 // applyTrigger handles the gRPC request by passing it to the underlying Trigger Apply() method.
 func (s *TriggerServer) applyTrigger(ctx context.Context, c *beta.Client, request *betapb.ApplyEventarcBetaTriggerRequest) (*betapb.EventarcBetaTrigger, error) {
 	p := ProtoToTrigger(request.GetResource())
-	res, err := c.ApplyTrigger(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTrigger(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -248,6 +253,60 @@ func (s *TriggerServer) ApplyEventarcBetaTrigger(ctx context.Context, request *b
 	return s.applyTrigger(ctx, cl, request)
 }
 
+// GetEventarcBetaTrigger handles the gRPC request by passing it to the underlying Trigger Get() method.
+func (s *TriggerServer) GetEventarcBetaTrigger(ctx context.Context, request *betapb.GetEventarcBetaTriggerRequest) (*betapb.EventarcBetaTrigger, error) {
+	cl, err := createConfigTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTrigger(ctx, ProtoToTrigger(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TriggerToProto(res), nil
+}
+
+// HasDiffEventarcBetaTrigger handles the gRPC request by passing it to the underlying Trigger Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TriggerServer) HasDiffEventarcBetaTrigger(ctx context.Context, request *betapb.HasDiffEventarcBetaTriggerRequest) (*betapb.HasDiffEventarcBetaTriggerResponse, error) {
+	cl, err := createConfigTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTrigger(ctx, ProtoToTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffEventarcBetaTriggerResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanEventarcBetaTrigger handles the gRPC request by passing it to the underlying Trigger Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TriggerServer) PlanEventarcBetaTrigger(ctx context.Context, request *betapb.PlanEventarcBetaTriggerRequest) (*betapb.PlanEventarcBetaTriggerResponse, error) {
+	cl, err := createConfigTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTrigger(ctx, ProtoToTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanEventarcBetaTriggerResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTrigger handles the gRPC request by passing it to the underlying Trigger Delete() method.
 func (s *TriggerServer) DeleteEventarcBetaTrigger(ctx context.Context, request *betapb.DeleteEventarcBetaTriggerRequest) (*emptypb.Empty, error) {
 
@@ -266,22 +325,35 @@ func (s *TriggerServer) ListEventarcBetaTrigger(ctx context.Context, request *be
 		return nil, err
 	}
 
-	resources, err := cl.ListTrigger(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.TriggerMaxPage
+	}
+	resources, err := cl.ListTriggerWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.EventarcBetaTrigger
-	for _, r := range resources.Items {
-		rp := TriggerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TriggerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListEventarcBetaTriggerResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTrigger(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	eventarcpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/eventarc/eventarc_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/eventarc"
@@ -51,7 +52,11 @@ func GoogleChannelConfigToProto(resource *eventarc.GoogleChannelConfig) *eventar
 // applyGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Apply() method.
 func (s *GoogleChannelConfigServer) applyGoogleChannelConfig(ctx context.Context, c *eventarc.Client, request *eventarcpb.ApplyEventarcGoogleChannelConfigRequest) (*eventarcpb.EventarcGoogleChannelConfig, error) {
 	p := ProtoToGoogleChannelConfig(request.GetResource())
-	res, err := c.ApplyGoogleChannelConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGoogleChannelConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +73,60 @@ func (s *GoogleChannelConfigServer) ApplyEventarcGoogleChannelConfig(ctx context
 	return s.applyGoogleChannelConfig(ctx, cl, request)
 }
 
+// GetEventarcGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Get() method.
+func (s *GoogleChannelConfigServer) GetEventarcGoogleChannelConfig(ctx context.Context, request *eventarcpb.GetEventarcGoogleChannelConfigRequest) (*eventarcpb.EventarcGoogleChannelConfig, error) {
+	cl, err := createConfigGoogleChannelConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGoogleChannelConfig(ctx, ProtoToGoogleChannelConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GoogleChannelConfigToProto(res), nil
+}
+
+// HasDiffEventarcGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GoogleChannelConfigServer) HasDiffEventarcGoogleChannelConfig(ctx context.Context, request *eventarcpb.HasDiffEventarcGoogleChannelConfigRequest) (*eventarcpb.HasDiffEventarcGoogleChannelConfigResponse, error) {
+	cl, err := createConfigGoogleChannelConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGoogleChannelConfig(ctx, ProtoToGoogleChannelConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &eventarcpb.HasDiffEventarcGoogleChannelConfigResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanEventarcGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GoogleChannelConfigServer) PlanEventarcGoogleChannelConfig(ctx context.Context, request *eventarcpb.PlanEventarcGoogleChannelConfigRequest) (*eventarcpb.PlanEventarcGoogleChannelConfigResponse, error) {
+	cl, err := createConfigGoogleChannelConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGoogleChannelConfig(ctx, ProtoToGoogleChannelConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &eventarcpb.PlanEventarcGoogleChannelConfigResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGoogleChannelConfig handles the gRPC request by passing it to the underlying GoogleChannelConfig Delete() method.
 func (s *GoogleChannelConfigServer) DeleteEventarcGoogleChannelConfig(ctx context.Context, request *eventarcpb.DeleteEventarcGoogleChannelConfigRequest) (*emptypb.Empty, error) {
 
@@ -85,7 +144,9 @@ func (s *GoogleChannelConfigServer) ListEventarcGoogleChannelConfig(_ context.Co
 }
 
 func createConfigGoogleChannelConfig(ctx context.Context, service_account_file string) (*eventarc.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return eventarc.NewClient(conf), nil
 }
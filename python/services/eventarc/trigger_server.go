@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	eventarcpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/eventarc/eventarc_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/eventarc"
@@ -231,7 +232,11 @@ func TriggerToProto(resource *eventarc.Trigger) *eventarcpb.EventarcTrigger {
 // applyTrigger handles the gRPC request by passing it to the underlying Trigger Apply() method.
 func (s *TriggerServer) applyTrigger(ctx context.Context, c *eventarc.Client, request *eventarcpb.ApplyEventarcTriggerRequest) (*eventarcpb.EventarcTrigger, error) {
 	p := ProtoToTrigger(request.GetResource())
-	res, err := c.ApplyTrigger(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTrigger(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -248,6 +253,60 @@ func (s *TriggerServer) ApplyEventarcTrigger(ctx context.Context, request *event
 	return s.applyTrigger(ctx, cl, request)
 }
 
+// GetEventarcTrigger handles the gRPC request by passing it to the underlying Trigger Get() method.
+func (s *TriggerServer) GetEventarcTrigger(ctx context.Context, request *eventarcpb.GetEventarcTriggerRequest) (*eventarcpb.EventarcTrigger, error) {
+	cl, err := createConfigTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTrigger(ctx, ProtoToTrigger(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TriggerToProto(res), nil
+}
+
+// HasDiffEventarcTrigger handles the gRPC request by passing it to the underlying Trigger Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TriggerServer) HasDiffEventarcTrigger(ctx context.Context, request *eventarcpb.HasDiffEventarcTriggerRequest) (*eventarcpb.HasDiffEventarcTriggerResponse, error) {
+	cl, err := createConfigTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTrigger(ctx, ProtoToTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &eventarcpb.HasDiffEventarcTriggerResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanEventarcTrigger handles the gRPC request by passing it to the underlying Trigger Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TriggerServer) PlanEventarcTrigger(ctx context.Context, request *eventarcpb.PlanEventarcTriggerRequest) (*eventarcpb.PlanEventarcTriggerResponse, error) {
+	cl, err := createConfigTrigger(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTrigger(ctx, ProtoToTrigger(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &eventarcpb.PlanEventarcTriggerResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTrigger handles the gRPC request by passing it to the underlying Trigger Delete() method.
 func (s *TriggerServer) DeleteEventarcTrigger(ctx context.Context, request *eventarcpb.DeleteEventarcTriggerRequest) (*emptypb.Empty, error) {
 
@@ -266,22 +325,35 @@ func (s *TriggerServer) ListEventarcTrigger(ctx context.Context, request *eventa
 		return nil, err
 	}
 
-	resources, err := cl.ListTrigger(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = eventarc.TriggerMaxPage
+	}
+	resources, err := cl.ListTriggerWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*eventarcpb.EventarcTrigger
-	for _, r := range resources.Items {
-		rp := TriggerToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TriggerToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &eventarcpb.ListEventarcTriggerResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTrigger(ctx context.Context, service_account_file string) (*eventarc.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return eventarc.NewClient(conf), nil
 }
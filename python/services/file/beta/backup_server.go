@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/file/beta/file_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/file/beta"
@@ -113,7 +114,11 @@ func BackupToProto(resource *beta.Backup) *betapb.FileBetaBackup {
 // ApplyBackup handles the gRPC request by passing it to the underlying Backup Apply() method.
 func (s *BackupServer) applyBackup(ctx context.Context, c *beta.Client, request *betapb.ApplyFileBetaBackupRequest) (*betapb.FileBetaBackup, error) {
 	p := ProtoToBackup(request.GetResource())
-	res, err := c.ApplyBackup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBackup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +135,55 @@ func (s *BackupServer) ApplyFileBetaBackup(ctx context.Context, request *betapb.
 	return s.applyBackup(ctx, cl, request)
 }
 
+// GetFileBetaBackup handles the gRPC request by passing it to the underlying Backup Get() method.
+func (s *BackupServer) GetFileBetaBackup(ctx context.Context, request *betapb.GetFileBetaBackupRequest) (*betapb.FileBetaBackup, error) {
+	cl, err := createConfigBackup(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBackup(ctx, ProtoToBackup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BackupToProto(res), nil
+}
+
+// HasDiffFileBetaBackup handles the gRPC request by passing it to the underlying Backup Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BackupServer) HasDiffFileBetaBackup(ctx context.Context, request *betapb.HasDiffFileBetaBackupRequest) (*betapb.HasDiffFileBetaBackupResponse, error) {
+	cl, err := createConfigBackup(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackup(ctx, ProtoToBackup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffFileBetaBackupResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanFileBetaBackup handles the gRPC request by passing it to the underlying Backup Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BackupServer) PlanFileBetaBackup(ctx context.Context, request *betapb.PlanFileBetaBackupRequest) (*betapb.PlanFileBetaBackupResponse, error) {
+	cl, err := createConfigBackup(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackup(ctx, ProtoToBackup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanFileBetaBackupResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteBackup handles the gRPC request by passing it to the underlying Backup Delete() method.
 func (s *BackupServer) DeleteFileBetaBackup(ctx context.Context, request *betapb.DeleteFileBetaBackupRequest) (*emptypb.Empty, error) {
 
@@ -148,20 +202,32 @@ func (s *BackupServer) ListFileBetaBackup(ctx context.Context, request *betapb.L
 		return nil, err
 	}
 
-	resources, err := cl.ListBackup(ctx, ProtoToBackup(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.BackupMaxPage
+	}
+	resources, err := cl.ListBackupWithPageToken(ctx, ProtoToBackup(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.FileBetaBackup
-	for _, r := range resources.Items {
-		rp := BackupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BackupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListFileBetaBackupResponse{Items: protos}, nil
+	return &betapb.ListFileBetaBackupResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigBackup(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
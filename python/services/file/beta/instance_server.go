@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/file/beta/file_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/file/beta"
@@ -290,7 +291,11 @@ func InstanceToProto(resource *beta.Instance) *betapb.FileBetaInstance {
 // ApplyInstance handles the gRPC request by passing it to the underlying Instance Apply() method.
 func (s *InstanceServer) applyInstance(ctx context.Context, c *beta.Client, request *betapb.ApplyFileBetaInstanceRequest) (*betapb.FileBetaInstance, error) {
 	p := ProtoToInstance(request.GetResource())
-	res, err := c.ApplyInstance(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstance(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -307,6 +312,55 @@ func (s *InstanceServer) ApplyFileBetaInstance(ctx context.Context, request *bet
 	return s.applyInstance(ctx, cl, request)
 }
 
+// GetFileBetaInstance handles the gRPC request by passing it to the underlying Instance Get() method.
+func (s *InstanceServer) GetFileBetaInstance(ctx context.Context, request *betapb.GetFileBetaInstanceRequest) (*betapb.FileBetaInstance, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstance(ctx, ProtoToInstance(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToProto(res), nil
+}
+
+// HasDiffFileBetaInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceServer) HasDiffFileBetaInstance(ctx context.Context, request *betapb.HasDiffFileBetaInstanceRequest) (*betapb.HasDiffFileBetaInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffFileBetaInstanceResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanFileBetaInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceServer) PlanFileBetaInstance(ctx context.Context, request *betapb.PlanFileBetaInstanceRequest) (*betapb.PlanFileBetaInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanFileBetaInstanceResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteInstance handles the gRPC request by passing it to the underlying Instance Delete() method.
 func (s *InstanceServer) DeleteFileBetaInstance(ctx context.Context, request *betapb.DeleteFileBetaInstanceRequest) (*emptypb.Empty, error) {
 
@@ -325,20 +379,32 @@ func (s *InstanceServer) ListFileBetaInstance(ctx context.Context, request *beta
 		return nil, err
 	}
 
-	resources, err := cl.ListInstance(ctx, ProtoToInstance(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.InstanceMaxPage
+	}
+	resources, err := cl.ListInstanceWithPageToken(ctx, ProtoToInstance(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.FileBetaInstance
-	for _, r := range resources.Items {
-		rp := InstanceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListFileBetaInstanceResponse{Items: protos}, nil
+	return &betapb.ListFileBetaInstanceResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigInstance(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
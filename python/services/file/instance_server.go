@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	filepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/file/file_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/file"
@@ -290,7 +291,11 @@ func InstanceToProto(resource *file.Instance) *filepb.FileInstance {
 // ApplyInstance handles the gRPC request by passing it to the underlying Instance Apply() method.
 func (s *InstanceServer) applyInstance(ctx context.Context, c *file.Client, request *filepb.ApplyFileInstanceRequest) (*filepb.FileInstance, error) {
 	p := ProtoToInstance(request.GetResource())
-	res, err := c.ApplyInstance(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstance(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -307,6 +312,55 @@ func (s *InstanceServer) ApplyFileInstance(ctx context.Context, request *filepb.
 	return s.applyInstance(ctx, cl, request)
 }
 
+// GetFileInstance handles the gRPC request by passing it to the underlying Instance Get() method.
+func (s *InstanceServer) GetFileInstance(ctx context.Context, request *filepb.GetFileInstanceRequest) (*filepb.FileInstance, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstance(ctx, ProtoToInstance(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToProto(res), nil
+}
+
+// HasDiffFileInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceServer) HasDiffFileInstance(ctx context.Context, request *filepb.HasDiffFileInstanceRequest) (*filepb.HasDiffFileInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &filepb.HasDiffFileInstanceResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanFileInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceServer) PlanFileInstance(ctx context.Context, request *filepb.PlanFileInstanceRequest) (*filepb.PlanFileInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &filepb.PlanFileInstanceResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteInstance handles the gRPC request by passing it to the underlying Instance Delete() method.
 func (s *InstanceServer) DeleteFileInstance(ctx context.Context, request *filepb.DeleteFileInstanceRequest) (*emptypb.Empty, error) {
 
@@ -325,20 +379,32 @@ func (s *InstanceServer) ListFileInstance(ctx context.Context, request *filepb.L
 		return nil, err
 	}
 
-	resources, err := cl.ListInstance(ctx, ProtoToInstance(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = file.InstanceMaxPage
+	}
+	resources, err := cl.ListInstanceWithPageToken(ctx, ProtoToInstance(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*filepb.FileInstance
-	for _, r := range resources.Items {
-		rp := InstanceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &filepb.ListFileInstanceResponse{Items: protos}, nil
+	return &filepb.ListFileInstanceResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigInstance(ctx context.Context, service_account_file string) (*file.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return file.NewClient(conf), nil
 }
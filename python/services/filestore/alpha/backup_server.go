@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/filestore/alpha/filestore_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/filestore/alpha"
@@ -116,7 +117,11 @@ func BackupToProto(resource *alpha.Backup) *alphapb.FilestoreAlphaBackup {
 // applyBackup handles the gRPC request by passing it to the underlying Backup Apply() method.
 func (s *BackupServer) applyBackup(ctx context.Context, c *alpha.Client, request *alphapb.ApplyFilestoreAlphaBackupRequest) (*alphapb.FilestoreAlphaBackup, error) {
 	p := ProtoToBackup(request.GetResource())
-	res, err := c.ApplyBackup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBackup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +138,60 @@ func (s *BackupServer) ApplyFilestoreAlphaBackup(ctx context.Context, request *a
 	return s.applyBackup(ctx, cl, request)
 }
 
+// GetFilestoreAlphaBackup handles the gRPC request by passing it to the underlying Backup Get() method.
+func (s *BackupServer) GetFilestoreAlphaBackup(ctx context.Context, request *alphapb.GetFilestoreAlphaBackupRequest) (*alphapb.FilestoreAlphaBackup, error) {
+	cl, err := createConfigBackup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBackup(ctx, ProtoToBackup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BackupToProto(res), nil
+}
+
+// HasDiffFilestoreAlphaBackup handles the gRPC request by passing it to the underlying Backup Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BackupServer) HasDiffFilestoreAlphaBackup(ctx context.Context, request *alphapb.HasDiffFilestoreAlphaBackupRequest) (*alphapb.HasDiffFilestoreAlphaBackupResponse, error) {
+	cl, err := createConfigBackup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackup(ctx, ProtoToBackup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffFilestoreAlphaBackupResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanFilestoreAlphaBackup handles the gRPC request by passing it to the underlying Backup Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BackupServer) PlanFilestoreAlphaBackup(ctx context.Context, request *alphapb.PlanFilestoreAlphaBackupRequest) (*alphapb.PlanFilestoreAlphaBackupResponse, error) {
+	cl, err := createConfigBackup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackup(ctx, ProtoToBackup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanFilestoreAlphaBackupResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteBackup handles the gRPC request by passing it to the underlying Backup Delete() method.
 func (s *BackupServer) DeleteFilestoreAlphaBackup(ctx context.Context, request *alphapb.DeleteFilestoreAlphaBackupRequest) (*emptypb.Empty, error) {
 
@@ -151,22 +210,35 @@ func (s *BackupServer) ListFilestoreAlphaBackup(ctx context.Context, request *al
 		return nil, err
 	}
 
-	resources, err := cl.ListBackup(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.BackupMaxPage
+	}
+	resources, err := cl.ListBackupWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.FilestoreAlphaBackup
-	for _, r := range resources.Items {
-		rp := BackupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BackupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListFilestoreAlphaBackupResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigBackup(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
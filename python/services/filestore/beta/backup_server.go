@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/filestore/beta/filestore_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/filestore/beta"
@@ -116,7 +117,11 @@ func BackupToProto(resource *beta.Backup) *betapb.FilestoreBetaBackup {
 // applyBackup handles the gRPC request by passing it to the underlying Backup Apply() method.
 func (s *BackupServer) applyBackup(ctx context.Context, c *beta.Client, request *betapb.ApplyFilestoreBetaBackupRequest) (*betapb.FilestoreBetaBackup, error) {
 	p := ProtoToBackup(request.GetResource())
-	res, err := c.ApplyBackup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBackup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +138,60 @@ func (s *BackupServer) ApplyFilestoreBetaBackup(ctx context.Context, request *be
 	return s.applyBackup(ctx, cl, request)
 }
 
+// GetFilestoreBetaBackup handles the gRPC request by passing it to the underlying Backup Get() method.
+func (s *BackupServer) GetFilestoreBetaBackup(ctx context.Context, request *betapb.GetFilestoreBetaBackupRequest) (*betapb.FilestoreBetaBackup, error) {
+	cl, err := createConfigBackup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBackup(ctx, ProtoToBackup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BackupToProto(res), nil
+}
+
+// HasDiffFilestoreBetaBackup handles the gRPC request by passing it to the underlying Backup Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BackupServer) HasDiffFilestoreBetaBackup(ctx context.Context, request *betapb.HasDiffFilestoreBetaBackupRequest) (*betapb.HasDiffFilestoreBetaBackupResponse, error) {
+	cl, err := createConfigBackup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackup(ctx, ProtoToBackup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffFilestoreBetaBackupResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanFilestoreBetaBackup handles the gRPC request by passing it to the underlying Backup Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BackupServer) PlanFilestoreBetaBackup(ctx context.Context, request *betapb.PlanFilestoreBetaBackupRequest) (*betapb.PlanFilestoreBetaBackupResponse, error) {
+	cl, err := createConfigBackup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBackup(ctx, ProtoToBackup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanFilestoreBetaBackupResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteBackup handles the gRPC request by passing it to the underlying Backup Delete() method.
 func (s *BackupServer) DeleteFilestoreBetaBackup(ctx context.Context, request *betapb.DeleteFilestoreBetaBackupRequest) (*emptypb.Empty, error) {
 
@@ -151,22 +210,35 @@ func (s *BackupServer) ListFilestoreBetaBackup(ctx context.Context, request *bet
 		return nil, err
 	}
 
-	resources, err := cl.ListBackup(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.BackupMaxPage
+	}
+	resources, err := cl.ListBackupWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.FilestoreBetaBackup
-	for _, r := range resources.Items {
-		rp := BackupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BackupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListFilestoreBetaBackupResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigBackup(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/firebase/alpha/firebase_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/firebase/alpha"
@@ -55,7 +56,11 @@ func AndroidAppToProto(resource *alpha.AndroidApp) *alphapb.FirebaseAlphaAndroid
 // applyAndroidApp handles the gRPC request by passing it to the underlying AndroidApp Apply() method.
 func (s *AndroidAppServer) applyAndroidApp(ctx context.Context, c *alpha.Client, request *alphapb.ApplyFirebaseAlphaAndroidAppRequest) (*alphapb.FirebaseAlphaAndroidApp, error) {
 	p := ProtoToAndroidApp(request.GetResource())
-	res, err := c.ApplyAndroidApp(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAndroidApp(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,60 @@ func (s *AndroidAppServer) ApplyFirebaseAlphaAndroidApp(ctx context.Context, req
 	return s.applyAndroidApp(ctx, cl, request)
 }
 
+// GetFirebaseAlphaAndroidApp handles the gRPC request by passing it to the underlying AndroidApp Get() method.
+func (s *AndroidAppServer) GetFirebaseAlphaAndroidApp(ctx context.Context, request *alphapb.GetFirebaseAlphaAndroidAppRequest) (*alphapb.FirebaseAlphaAndroidApp, error) {
+	cl, err := createConfigAndroidApp(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAndroidApp(ctx, ProtoToAndroidApp(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AndroidAppToProto(res), nil
+}
+
+// HasDiffFirebaseAlphaAndroidApp handles the gRPC request by passing it to the underlying AndroidApp Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AndroidAppServer) HasDiffFirebaseAlphaAndroidApp(ctx context.Context, request *alphapb.HasDiffFirebaseAlphaAndroidAppRequest) (*alphapb.HasDiffFirebaseAlphaAndroidAppResponse, error) {
+	cl, err := createConfigAndroidApp(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAndroidApp(ctx, ProtoToAndroidApp(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffFirebaseAlphaAndroidAppResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanFirebaseAlphaAndroidApp handles the gRPC request by passing it to the underlying AndroidApp Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AndroidAppServer) PlanFirebaseAlphaAndroidApp(ctx context.Context, request *alphapb.PlanFirebaseAlphaAndroidAppRequest) (*alphapb.PlanFirebaseAlphaAndroidAppResponse, error) {
+	cl, err := createConfigAndroidApp(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAndroidApp(ctx, ProtoToAndroidApp(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanFirebaseAlphaAndroidAppResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAndroidApp handles the gRPC request by passing it to the underlying AndroidApp Delete() method.
 func (s *AndroidAppServer) DeleteFirebaseAlphaAndroidApp(ctx context.Context, request *alphapb.DeleteFirebaseAlphaAndroidAppRequest) (*emptypb.Empty, error) {
 
@@ -90,22 +149,35 @@ func (s *AndroidAppServer) ListFirebaseAlphaAndroidApp(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListAndroidApp(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.AndroidAppMaxPage
+	}
+	resources, err := cl.ListAndroidAppWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.FirebaseAlphaAndroidApp
-	for _, r := range resources.Items {
-		rp := AndroidAppToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AndroidAppToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListFirebaseAlphaAndroidAppResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAndroidApp(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
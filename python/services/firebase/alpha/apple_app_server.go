@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/firebase/alpha/firebase_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/firebase/alpha"
@@ -59,7 +60,11 @@ func AppleAppToProto(resource *alpha.AppleApp) *alphapb.FirebaseAlphaAppleApp {
 // applyAppleApp handles the gRPC request by passing it to the underlying AppleApp Apply() method.
 func (s *AppleAppServer) applyAppleApp(ctx context.Context, c *alpha.Client, request *alphapb.ApplyFirebaseAlphaAppleAppRequest) (*alphapb.FirebaseAlphaAppleApp, error) {
 	p := ProtoToAppleApp(request.GetResource())
-	res, err := c.ApplyAppleApp(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAppleApp(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +81,60 @@ func (s *AppleAppServer) ApplyFirebaseAlphaAppleApp(ctx context.Context, request
 	return s.applyAppleApp(ctx, cl, request)
 }
 
+// GetFirebaseAlphaAppleApp handles the gRPC request by passing it to the underlying AppleApp Get() method.
+func (s *AppleAppServer) GetFirebaseAlphaAppleApp(ctx context.Context, request *alphapb.GetFirebaseAlphaAppleAppRequest) (*alphapb.FirebaseAlphaAppleApp, error) {
+	cl, err := createConfigAppleApp(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAppleApp(ctx, ProtoToAppleApp(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AppleAppToProto(res), nil
+}
+
+// HasDiffFirebaseAlphaAppleApp handles the gRPC request by passing it to the underlying AppleApp Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AppleAppServer) HasDiffFirebaseAlphaAppleApp(ctx context.Context, request *alphapb.HasDiffFirebaseAlphaAppleAppRequest) (*alphapb.HasDiffFirebaseAlphaAppleAppResponse, error) {
+	cl, err := createConfigAppleApp(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAppleApp(ctx, ProtoToAppleApp(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffFirebaseAlphaAppleAppResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanFirebaseAlphaAppleApp handles the gRPC request by passing it to the underlying AppleApp Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AppleAppServer) PlanFirebaseAlphaAppleApp(ctx context.Context, request *alphapb.PlanFirebaseAlphaAppleAppRequest) (*alphapb.PlanFirebaseAlphaAppleAppResponse, error) {
+	cl, err := createConfigAppleApp(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAppleApp(ctx, ProtoToAppleApp(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanFirebaseAlphaAppleAppResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAppleApp handles the gRPC request by passing it to the underlying AppleApp Delete() method.
 func (s *AppleAppServer) DeleteFirebaseAlphaAppleApp(ctx context.Context, request *alphapb.DeleteFirebaseAlphaAppleAppRequest) (*emptypb.Empty, error) {
 
@@ -94,22 +153,35 @@ func (s *AppleAppServer) ListFirebaseAlphaAppleApp(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListAppleApp(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.AppleAppMaxPage
+	}
+	resources, err := cl.ListAppleAppWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.FirebaseAlphaAppleApp
-	for _, r := range resources.Items {
-		rp := AppleAppToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AppleAppToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListFirebaseAlphaAppleAppResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAppleApp(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/firebase/beta/firebase_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/firebase/beta"
@@ -55,7 +56,11 @@ func AndroidAppToProto(resource *beta.AndroidApp) *betapb.FirebaseBetaAndroidApp
 // applyAndroidApp handles the gRPC request by passing it to the underlying AndroidApp Apply() method.
 func (s *AndroidAppServer) applyAndroidApp(ctx context.Context, c *beta.Client, request *betapb.ApplyFirebaseBetaAndroidAppRequest) (*betapb.FirebaseBetaAndroidApp, error) {
 	p := ProtoToAndroidApp(request.GetResource())
-	res, err := c.ApplyAndroidApp(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAndroidApp(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,60 @@ func (s *AndroidAppServer) ApplyFirebaseBetaAndroidApp(ctx context.Context, requ
 	return s.applyAndroidApp(ctx, cl, request)
 }
 
+// GetFirebaseBetaAndroidApp handles the gRPC request by passing it to the underlying AndroidApp Get() method.
+func (s *AndroidAppServer) GetFirebaseBetaAndroidApp(ctx context.Context, request *betapb.GetFirebaseBetaAndroidAppRequest) (*betapb.FirebaseBetaAndroidApp, error) {
+	cl, err := createConfigAndroidApp(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAndroidApp(ctx, ProtoToAndroidApp(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AndroidAppToProto(res), nil
+}
+
+// HasDiffFirebaseBetaAndroidApp handles the gRPC request by passing it to the underlying AndroidApp Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AndroidAppServer) HasDiffFirebaseBetaAndroidApp(ctx context.Context, request *betapb.HasDiffFirebaseBetaAndroidAppRequest) (*betapb.HasDiffFirebaseBetaAndroidAppResponse, error) {
+	cl, err := createConfigAndroidApp(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAndroidApp(ctx, ProtoToAndroidApp(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffFirebaseBetaAndroidAppResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanFirebaseBetaAndroidApp handles the gRPC request by passing it to the underlying AndroidApp Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AndroidAppServer) PlanFirebaseBetaAndroidApp(ctx context.Context, request *betapb.PlanFirebaseBetaAndroidAppRequest) (*betapb.PlanFirebaseBetaAndroidAppResponse, error) {
+	cl, err := createConfigAndroidApp(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAndroidApp(ctx, ProtoToAndroidApp(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanFirebaseBetaAndroidAppResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAndroidApp handles the gRPC request by passing it to the underlying AndroidApp Delete() method.
 func (s *AndroidAppServer) DeleteFirebaseBetaAndroidApp(ctx context.Context, request *betapb.DeleteFirebaseBetaAndroidAppRequest) (*emptypb.Empty, error) {
 
@@ -90,22 +149,35 @@ func (s *AndroidAppServer) ListFirebaseBetaAndroidApp(ctx context.Context, reque
 		return nil, err
 	}
 
-	resources, err := cl.ListAndroidApp(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.AndroidAppMaxPage
+	}
+	resources, err := cl.ListAndroidAppWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.FirebaseBetaAndroidApp
-	for _, r := range resources.Items {
-		rp := AndroidAppToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AndroidAppToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListFirebaseBetaAndroidAppResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAndroidApp(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/firebase/beta/firebase_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/firebase/beta"
@@ -109,7 +110,11 @@ func FirebaseProjectToProto(resource *beta.FirebaseProject) *betapb.FirebaseBeta
 // applyFirebaseProject handles the gRPC request by passing it to the underlying FirebaseProject Apply() method.
 func (s *FirebaseProjectServer) applyFirebaseProject(ctx context.Context, c *beta.Client, request *betapb.ApplyFirebaseBetaFirebaseProjectRequest) (*betapb.FirebaseBetaFirebaseProject, error) {
 	p := ProtoToFirebaseProject(request.GetResource())
-	res, err := c.ApplyFirebaseProject(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFirebaseProject(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +131,60 @@ func (s *FirebaseProjectServer) ApplyFirebaseBetaFirebaseProject(ctx context.Con
 	return s.applyFirebaseProject(ctx, cl, request)
 }
 
+// GetFirebaseBetaFirebaseProject handles the gRPC request by passing it to the underlying FirebaseProject Get() method.
+func (s *FirebaseProjectServer) GetFirebaseBetaFirebaseProject(ctx context.Context, request *betapb.GetFirebaseBetaFirebaseProjectRequest) (*betapb.FirebaseBetaFirebaseProject, error) {
+	cl, err := createConfigFirebaseProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFirebaseProject(ctx, ProtoToFirebaseProject(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FirebaseProjectToProto(res), nil
+}
+
+// HasDiffFirebaseBetaFirebaseProject handles the gRPC request by passing it to the underlying FirebaseProject Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FirebaseProjectServer) HasDiffFirebaseBetaFirebaseProject(ctx context.Context, request *betapb.HasDiffFirebaseBetaFirebaseProjectRequest) (*betapb.HasDiffFirebaseBetaFirebaseProjectResponse, error) {
+	cl, err := createConfigFirebaseProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirebaseProject(ctx, ProtoToFirebaseProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffFirebaseBetaFirebaseProjectResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanFirebaseBetaFirebaseProject handles the gRPC request by passing it to the underlying FirebaseProject Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FirebaseProjectServer) PlanFirebaseBetaFirebaseProject(ctx context.Context, request *betapb.PlanFirebaseBetaFirebaseProjectRequest) (*betapb.PlanFirebaseBetaFirebaseProjectResponse, error) {
+	cl, err := createConfigFirebaseProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFirebaseProject(ctx, ProtoToFirebaseProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanFirebaseBetaFirebaseProjectResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFirebaseProject handles the gRPC request by passing it to the underlying FirebaseProject Delete() method.
 func (s *FirebaseProjectServer) DeleteFirebaseBetaFirebaseProject(ctx context.Context, request *betapb.DeleteFirebaseBetaFirebaseProjectRequest) (*emptypb.Empty, error) {
 
@@ -140,22 +199,35 @@ func (s *FirebaseProjectServer) ListFirebaseBetaFirebaseProject(ctx context.Cont
 		return nil, err
 	}
 
-	resources, err := cl.ListFirebaseProject(ctx)
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.FirebaseProjectMaxPage
+	}
+	resources, err := cl.ListFirebaseProjectWithPageToken(ctx, pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.FirebaseBetaFirebaseProject
-	for _, r := range resources.Items {
-		rp := FirebaseProjectToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FirebaseProjectToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListFirebaseBetaFirebaseProjectResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFirebaseProject(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
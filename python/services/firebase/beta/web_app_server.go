@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/firebase/beta/firebase_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/firebase/beta"
@@ -63,7 +64,11 @@ func WebAppToProto(resource *beta.WebApp) *betapb.FirebaseBetaWebApp {
 // applyWebApp handles the gRPC request by passing it to the underlying WebApp Apply() method.
 func (s *WebAppServer) applyWebApp(ctx context.Context, c *beta.Client, request *betapb.ApplyFirebaseBetaWebAppRequest) (*betapb.FirebaseBetaWebApp, error) {
 	p := ProtoToWebApp(request.GetResource())
-	res, err := c.ApplyWebApp(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWebApp(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +85,60 @@ func (s *WebAppServer) ApplyFirebaseBetaWebApp(ctx context.Context, request *bet
 	return s.applyWebApp(ctx, cl, request)
 }
 
+// GetFirebaseBetaWebApp handles the gRPC request by passing it to the underlying WebApp Get() method.
+func (s *WebAppServer) GetFirebaseBetaWebApp(ctx context.Context, request *betapb.GetFirebaseBetaWebAppRequest) (*betapb.FirebaseBetaWebApp, error) {
+	cl, err := createConfigWebApp(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWebApp(ctx, ProtoToWebApp(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WebAppToProto(res), nil
+}
+
+// HasDiffFirebaseBetaWebApp handles the gRPC request by passing it to the underlying WebApp Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WebAppServer) HasDiffFirebaseBetaWebApp(ctx context.Context, request *betapb.HasDiffFirebaseBetaWebAppRequest) (*betapb.HasDiffFirebaseBetaWebAppResponse, error) {
+	cl, err := createConfigWebApp(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWebApp(ctx, ProtoToWebApp(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffFirebaseBetaWebAppResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanFirebaseBetaWebApp handles the gRPC request by passing it to the underlying WebApp Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WebAppServer) PlanFirebaseBetaWebApp(ctx context.Context, request *betapb.PlanFirebaseBetaWebAppRequest) (*betapb.PlanFirebaseBetaWebAppResponse, error) {
+	cl, err := createConfigWebApp(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWebApp(ctx, ProtoToWebApp(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanFirebaseBetaWebAppResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWebApp handles the gRPC request by passing it to the underlying WebApp Delete() method.
 func (s *WebAppServer) DeleteFirebaseBetaWebApp(ctx context.Context, request *betapb.DeleteFirebaseBetaWebAppRequest) (*emptypb.Empty, error) {
 
@@ -98,22 +157,35 @@ func (s *WebAppServer) ListFirebaseBetaWebApp(ctx context.Context, request *beta
 		return nil, err
 	}
 
-	resources, err := cl.ListWebApp(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.WebAppMaxPage
+	}
+	resources, err := cl.ListWebAppWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.FirebaseBetaWebApp
-	for _, r := range resources.Items {
-		rp := WebAppToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WebAppToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListFirebaseBetaWebAppResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWebApp(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
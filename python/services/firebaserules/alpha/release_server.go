@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/firebaserules/alpha/firebaserules_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/firebaserules/alpha"
@@ -53,7 +54,11 @@ func ReleaseToProto(resource *alpha.Release) *alphapb.FirebaserulesAlphaRelease
 // applyRelease handles the gRPC request by passing it to the underlying Release Apply() method.
 func (s *ReleaseServer) applyRelease(ctx context.Context, c *alpha.Client, request *alphapb.ApplyFirebaserulesAlphaReleaseRequest) (*alphapb.FirebaserulesAlphaRelease, error) {
 	p := ProtoToRelease(request.GetResource())
-	res, err := c.ApplyRelease(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRelease(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +75,60 @@ func (s *ReleaseServer) ApplyFirebaserulesAlphaRelease(ctx context.Context, requ
 	return s.applyRelease(ctx, cl, request)
 }
 
+// GetFirebaserulesAlphaRelease handles the gRPC request by passing it to the underlying Release Get() method.
+func (s *ReleaseServer) GetFirebaserulesAlphaRelease(ctx context.Context, request *alphapb.GetFirebaserulesAlphaReleaseRequest) (*alphapb.FirebaserulesAlphaRelease, error) {
+	cl, err := createConfigRelease(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRelease(ctx, ProtoToRelease(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ReleaseToProto(res), nil
+}
+
+// HasDiffFirebaserulesAlphaRelease handles the gRPC request by passing it to the underlying Release Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ReleaseServer) HasDiffFirebaserulesAlphaRelease(ctx context.Context, request *alphapb.HasDiffFirebaserulesAlphaReleaseRequest) (*alphapb.HasDiffFirebaserulesAlphaReleaseResponse, error) {
+	cl, err := createConfigRelease(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRelease(ctx, ProtoToRelease(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffFirebaserulesAlphaReleaseResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanFirebaserulesAlphaRelease handles the gRPC request by passing it to the underlying Release Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ReleaseServer) PlanFirebaserulesAlphaRelease(ctx context.Context, request *alphapb.PlanFirebaserulesAlphaReleaseRequest) (*alphapb.PlanFirebaserulesAlphaReleaseResponse, error) {
+	cl, err := createConfigRelease(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRelease(ctx, ProtoToRelease(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanFirebaserulesAlphaReleaseResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRelease handles the gRPC request by passing it to the underlying Release Delete() method.
 func (s *ReleaseServer) DeleteFirebaserulesAlphaRelease(ctx context.Context, request *alphapb.DeleteFirebaserulesAlphaReleaseRequest) (*emptypb.Empty, error) {
 
@@ -88,22 +147,35 @@ func (s *ReleaseServer) ListFirebaserulesAlphaRelease(ctx context.Context, reque
 		return nil, err
 	}
 
-	resources, err := cl.ListRelease(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ReleaseMaxPage
+	}
+	resources, err := cl.ListReleaseWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.FirebaserulesAlphaRelease
-	for _, r := range resources.Items {
-		rp := ReleaseToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ReleaseToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListFirebaserulesAlphaReleaseResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRelease(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
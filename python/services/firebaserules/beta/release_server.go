@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/firebaserules/beta/firebaserules_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/firebaserules/beta"
@@ -53,7 +54,11 @@ func ReleaseToProto(resource *beta.Release) *betapb.FirebaserulesBetaRelease {
 // applyRelease handles the gRPC request by passing it to the underlying Release Apply() method.
 func (s *ReleaseServer) applyRelease(ctx context.Context, c *beta.Client, request *betapb.ApplyFirebaserulesBetaReleaseRequest) (*betapb.FirebaserulesBetaRelease, error) {
 	p := ProtoToRelease(request.GetResource())
-	res, err := c.ApplyRelease(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRelease(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +75,60 @@ func (s *ReleaseServer) ApplyFirebaserulesBetaRelease(ctx context.Context, reque
 	return s.applyRelease(ctx, cl, request)
 }
 
+// GetFirebaserulesBetaRelease handles the gRPC request by passing it to the underlying Release Get() method.
+func (s *ReleaseServer) GetFirebaserulesBetaRelease(ctx context.Context, request *betapb.GetFirebaserulesBetaReleaseRequest) (*betapb.FirebaserulesBetaRelease, error) {
+	cl, err := createConfigRelease(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRelease(ctx, ProtoToRelease(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ReleaseToProto(res), nil
+}
+
+// HasDiffFirebaserulesBetaRelease handles the gRPC request by passing it to the underlying Release Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ReleaseServer) HasDiffFirebaserulesBetaRelease(ctx context.Context, request *betapb.HasDiffFirebaserulesBetaReleaseRequest) (*betapb.HasDiffFirebaserulesBetaReleaseResponse, error) {
+	cl, err := createConfigRelease(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRelease(ctx, ProtoToRelease(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffFirebaserulesBetaReleaseResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanFirebaserulesBetaRelease handles the gRPC request by passing it to the underlying Release Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ReleaseServer) PlanFirebaserulesBetaRelease(ctx context.Context, request *betapb.PlanFirebaserulesBetaReleaseRequest) (*betapb.PlanFirebaserulesBetaReleaseResponse, error) {
+	cl, err := createConfigRelease(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRelease(ctx, ProtoToRelease(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanFirebaserulesBetaReleaseResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRelease handles the gRPC request by passing it to the underlying Release Delete() method.
 func (s *ReleaseServer) DeleteFirebaserulesBetaRelease(ctx context.Context, request *betapb.DeleteFirebaserulesBetaReleaseRequest) (*emptypb.Empty, error) {
 
@@ -88,22 +147,35 @@ func (s *ReleaseServer) ListFirebaserulesBetaRelease(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListRelease(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ReleaseMaxPage
+	}
+	resources, err := cl.ListReleaseWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.FirebaserulesBetaRelease
-	for _, r := range resources.Items {
-		rp := ReleaseToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ReleaseToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListFirebaserulesBetaReleaseResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRelease(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/firebaserules/beta/firebaserules_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/firebaserules/beta"
@@ -154,7 +155,11 @@ func RulesetToProto(resource *beta.Ruleset) *betapb.FirebaserulesBetaRuleset {
 // applyRuleset handles the gRPC request by passing it to the underlying Ruleset Apply() method.
 func (s *RulesetServer) applyRuleset(ctx context.Context, c *beta.Client, request *betapb.ApplyFirebaserulesBetaRulesetRequest) (*betapb.FirebaserulesBetaRuleset, error) {
 	p := ProtoToRuleset(request.GetResource())
-	res, err := c.ApplyRuleset(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRuleset(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -171,6 +176,60 @@ func (s *RulesetServer) ApplyFirebaserulesBetaRuleset(ctx context.Context, reque
 	return s.applyRuleset(ctx, cl, request)
 }
 
+// GetFirebaserulesBetaRuleset handles the gRPC request by passing it to the underlying Ruleset Get() method.
+func (s *RulesetServer) GetFirebaserulesBetaRuleset(ctx context.Context, request *betapb.GetFirebaserulesBetaRulesetRequest) (*betapb.FirebaserulesBetaRuleset, error) {
+	cl, err := createConfigRuleset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRuleset(ctx, ProtoToRuleset(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RulesetToProto(res), nil
+}
+
+// HasDiffFirebaserulesBetaRuleset handles the gRPC request by passing it to the underlying Ruleset Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RulesetServer) HasDiffFirebaserulesBetaRuleset(ctx context.Context, request *betapb.HasDiffFirebaserulesBetaRulesetRequest) (*betapb.HasDiffFirebaserulesBetaRulesetResponse, error) {
+	cl, err := createConfigRuleset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRuleset(ctx, ProtoToRuleset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffFirebaserulesBetaRulesetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanFirebaserulesBetaRuleset handles the gRPC request by passing it to the underlying Ruleset Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RulesetServer) PlanFirebaserulesBetaRuleset(ctx context.Context, request *betapb.PlanFirebaserulesBetaRulesetRequest) (*betapb.PlanFirebaserulesBetaRulesetResponse, error) {
+	cl, err := createConfigRuleset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRuleset(ctx, ProtoToRuleset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanFirebaserulesBetaRulesetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRuleset handles the gRPC request by passing it to the underlying Ruleset Delete() method.
 func (s *RulesetServer) DeleteFirebaserulesBetaRuleset(ctx context.Context, request *betapb.DeleteFirebaserulesBetaRulesetRequest) (*emptypb.Empty, error) {
 
@@ -189,22 +248,35 @@ func (s *RulesetServer) ListFirebaserulesBetaRuleset(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListRuleset(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.RulesetMaxPage
+	}
+	resources, err := cl.ListRulesetWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.FirebaserulesBetaRuleset
-	for _, r := range resources.Items {
-		rp := RulesetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RulesetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListFirebaserulesBetaRulesetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRuleset(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	firebaserulespb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/firebaserules/firebaserules_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/firebaserules"
@@ -53,7 +54,11 @@ func ReleaseToProto(resource *firebaserules.Release) *firebaserulespb.Firebaseru
 // applyRelease handles the gRPC request by passing it to the underlying Release Apply() method.
 func (s *ReleaseServer) applyRelease(ctx context.Context, c *firebaserules.Client, request *firebaserulespb.ApplyFirebaserulesReleaseRequest) (*firebaserulespb.FirebaserulesRelease, error) {
 	p := ProtoToRelease(request.GetResource())
-	res, err := c.ApplyRelease(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRelease(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +75,60 @@ func (s *ReleaseServer) ApplyFirebaserulesRelease(ctx context.Context, request *
 	return s.applyRelease(ctx, cl, request)
 }
 
+// GetFirebaserulesRelease handles the gRPC request by passing it to the underlying Release Get() method.
+func (s *ReleaseServer) GetFirebaserulesRelease(ctx context.Context, request *firebaserulespb.GetFirebaserulesReleaseRequest) (*firebaserulespb.FirebaserulesRelease, error) {
+	cl, err := createConfigRelease(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRelease(ctx, ProtoToRelease(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ReleaseToProto(res), nil
+}
+
+// HasDiffFirebaserulesRelease handles the gRPC request by passing it to the underlying Release Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ReleaseServer) HasDiffFirebaserulesRelease(ctx context.Context, request *firebaserulespb.HasDiffFirebaserulesReleaseRequest) (*firebaserulespb.HasDiffFirebaserulesReleaseResponse, error) {
+	cl, err := createConfigRelease(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRelease(ctx, ProtoToRelease(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &firebaserulespb.HasDiffFirebaserulesReleaseResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanFirebaserulesRelease handles the gRPC request by passing it to the underlying Release Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ReleaseServer) PlanFirebaserulesRelease(ctx context.Context, request *firebaserulespb.PlanFirebaserulesReleaseRequest) (*firebaserulespb.PlanFirebaserulesReleaseResponse, error) {
+	cl, err := createConfigRelease(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRelease(ctx, ProtoToRelease(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &firebaserulespb.PlanFirebaserulesReleaseResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRelease handles the gRPC request by passing it to the underlying Release Delete() method.
 func (s *ReleaseServer) DeleteFirebaserulesRelease(ctx context.Context, request *firebaserulespb.DeleteFirebaserulesReleaseRequest) (*emptypb.Empty, error) {
 
@@ -88,22 +147,35 @@ func (s *ReleaseServer) ListFirebaserulesRelease(ctx context.Context, request *f
 		return nil, err
 	}
 
-	resources, err := cl.ListRelease(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = firebaserules.ReleaseMaxPage
+	}
+	resources, err := cl.ListReleaseWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*firebaserulespb.FirebaserulesRelease
-	for _, r := range resources.Items {
-		rp := ReleaseToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ReleaseToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &firebaserulespb.ListFirebaserulesReleaseResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRelease(ctx context.Context, service_account_file string) (*firebaserules.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return firebaserules.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	firebaserulespb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/firebaserules/firebaserules_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/firebaserules"
@@ -154,7 +155,11 @@ func RulesetToProto(resource *firebaserules.Ruleset) *firebaserulespb.Firebaseru
 // applyRuleset handles the gRPC request by passing it to the underlying Ruleset Apply() method.
 func (s *RulesetServer) applyRuleset(ctx context.Context, c *firebaserules.Client, request *firebaserulespb.ApplyFirebaserulesRulesetRequest) (*firebaserulespb.FirebaserulesRuleset, error) {
 	p := ProtoToRuleset(request.GetResource())
-	res, err := c.ApplyRuleset(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRuleset(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -171,6 +176,60 @@ func (s *RulesetServer) ApplyFirebaserulesRuleset(ctx context.Context, request *
 	return s.applyRuleset(ctx, cl, request)
 }
 
+// GetFirebaserulesRuleset handles the gRPC request by passing it to the underlying Ruleset Get() method.
+func (s *RulesetServer) GetFirebaserulesRuleset(ctx context.Context, request *firebaserulespb.GetFirebaserulesRulesetRequest) (*firebaserulespb.FirebaserulesRuleset, error) {
+	cl, err := createConfigRuleset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRuleset(ctx, ProtoToRuleset(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RulesetToProto(res), nil
+}
+
+// HasDiffFirebaserulesRuleset handles the gRPC request by passing it to the underlying Ruleset Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RulesetServer) HasDiffFirebaserulesRuleset(ctx context.Context, request *firebaserulespb.HasDiffFirebaserulesRulesetRequest) (*firebaserulespb.HasDiffFirebaserulesRulesetResponse, error) {
+	cl, err := createConfigRuleset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRuleset(ctx, ProtoToRuleset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &firebaserulespb.HasDiffFirebaserulesRulesetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanFirebaserulesRuleset handles the gRPC request by passing it to the underlying Ruleset Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RulesetServer) PlanFirebaserulesRuleset(ctx context.Context, request *firebaserulespb.PlanFirebaserulesRulesetRequest) (*firebaserulespb.PlanFirebaserulesRulesetResponse, error) {
+	cl, err := createConfigRuleset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRuleset(ctx, ProtoToRuleset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &firebaserulespb.PlanFirebaserulesRulesetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRuleset handles the gRPC request by passing it to the underlying Ruleset Delete() method.
 func (s *RulesetServer) DeleteFirebaserulesRuleset(ctx context.Context, request *firebaserulespb.DeleteFirebaserulesRulesetRequest) (*emptypb.Empty, error) {
 
@@ -189,22 +248,35 @@ func (s *RulesetServer) ListFirebaserulesRuleset(ctx context.Context, request *f
 		return nil, err
 	}
 
-	resources, err := cl.ListRuleset(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = firebaserules.RulesetMaxPage
+	}
+	resources, err := cl.ListRulesetWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*firebaserulespb.FirebaserulesRuleset
-	for _, r := range resources.Items {
-		rp := RulesetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RulesetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &firebaserulespb.ListFirebaserulesRulesetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRuleset(ctx context.Context, service_account_file string) (*firebaserules.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return firebaserules.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gameservices/alpha/gameservices_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gameservices/alpha"
@@ -60,7 +61,11 @@ func RealmToProto(resource *alpha.Realm) *alphapb.GameservicesAlphaRealm {
 // applyRealm handles the gRPC request by passing it to the underlying Realm Apply() method.
 func (s *RealmServer) applyRealm(ctx context.Context, c *alpha.Client, request *alphapb.ApplyGameservicesAlphaRealmRequest) (*alphapb.GameservicesAlphaRealm, error) {
 	p := ProtoToRealm(request.GetResource())
-	res, err := c.ApplyRealm(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRealm(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +82,60 @@ func (s *RealmServer) ApplyGameservicesAlphaRealm(ctx context.Context, request *
 	return s.applyRealm(ctx, cl, request)
 }
 
+// GetGameservicesAlphaRealm handles the gRPC request by passing it to the underlying Realm Get() method.
+func (s *RealmServer) GetGameservicesAlphaRealm(ctx context.Context, request *alphapb.GetGameservicesAlphaRealmRequest) (*alphapb.GameservicesAlphaRealm, error) {
+	cl, err := createConfigRealm(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRealm(ctx, ProtoToRealm(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RealmToProto(res), nil
+}
+
+// HasDiffGameservicesAlphaRealm handles the gRPC request by passing it to the underlying Realm Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RealmServer) HasDiffGameservicesAlphaRealm(ctx context.Context, request *alphapb.HasDiffGameservicesAlphaRealmRequest) (*alphapb.HasDiffGameservicesAlphaRealmResponse, error) {
+	cl, err := createConfigRealm(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRealm(ctx, ProtoToRealm(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffGameservicesAlphaRealmResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanGameservicesAlphaRealm handles the gRPC request by passing it to the underlying Realm Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RealmServer) PlanGameservicesAlphaRealm(ctx context.Context, request *alphapb.PlanGameservicesAlphaRealmRequest) (*alphapb.PlanGameservicesAlphaRealmResponse, error) {
+	cl, err := createConfigRealm(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRealm(ctx, ProtoToRealm(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanGameservicesAlphaRealmResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRealm handles the gRPC request by passing it to the underlying Realm Delete() method.
 func (s *RealmServer) DeleteGameservicesAlphaRealm(ctx context.Context, request *alphapb.DeleteGameservicesAlphaRealmRequest) (*emptypb.Empty, error) {
 
@@ -95,22 +154,35 @@ func (s *RealmServer) ListGameservicesAlphaRealm(ctx context.Context, request *a
 		return nil, err
 	}
 
-	resources, err := cl.ListRealm(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.RealmMaxPage
+	}
+	resources, err := cl.ListRealmWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.GameservicesAlphaRealm
-	for _, r := range resources.Items {
-		rp := RealmToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RealmToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListGameservicesAlphaRealmResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRealm(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
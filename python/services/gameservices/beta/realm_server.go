@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gameservices/beta/gameservices_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gameservices/beta"
@@ -60,7 +61,11 @@ func RealmToProto(resource *beta.Realm) *betapb.GameservicesBetaRealm {
 // applyRealm handles the gRPC request by passing it to the underlying Realm Apply() method.
 func (s *RealmServer) applyRealm(ctx context.Context, c *beta.Client, request *betapb.ApplyGameservicesBetaRealmRequest) (*betapb.GameservicesBetaRealm, error) {
 	p := ProtoToRealm(request.GetResource())
-	res, err := c.ApplyRealm(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRealm(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +82,60 @@ func (s *RealmServer) ApplyGameservicesBetaRealm(ctx context.Context, request *b
 	return s.applyRealm(ctx, cl, request)
 }
 
+// GetGameservicesBetaRealm handles the gRPC request by passing it to the underlying Realm Get() method.
+func (s *RealmServer) GetGameservicesBetaRealm(ctx context.Context, request *betapb.GetGameservicesBetaRealmRequest) (*betapb.GameservicesBetaRealm, error) {
+	cl, err := createConfigRealm(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRealm(ctx, ProtoToRealm(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RealmToProto(res), nil
+}
+
+// HasDiffGameservicesBetaRealm handles the gRPC request by passing it to the underlying Realm Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RealmServer) HasDiffGameservicesBetaRealm(ctx context.Context, request *betapb.HasDiffGameservicesBetaRealmRequest) (*betapb.HasDiffGameservicesBetaRealmResponse, error) {
+	cl, err := createConfigRealm(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRealm(ctx, ProtoToRealm(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffGameservicesBetaRealmResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanGameservicesBetaRealm handles the gRPC request by passing it to the underlying Realm Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RealmServer) PlanGameservicesBetaRealm(ctx context.Context, request *betapb.PlanGameservicesBetaRealmRequest) (*betapb.PlanGameservicesBetaRealmResponse, error) {
+	cl, err := createConfigRealm(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRealm(ctx, ProtoToRealm(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanGameservicesBetaRealmResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRealm handles the gRPC request by passing it to the underlying Realm Delete() method.
 func (s *RealmServer) DeleteGameservicesBetaRealm(ctx context.Context, request *betapb.DeleteGameservicesBetaRealmRequest) (*emptypb.Empty, error) {
 
@@ -95,22 +154,35 @@ func (s *RealmServer) ListGameservicesBetaRealm(ctx context.Context, request *be
 		return nil, err
 	}
 
-	resources, err := cl.ListRealm(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.RealmMaxPage
+	}
+	resources, err := cl.ListRealmWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.GameservicesBetaRealm
-	for _, r := range resources.Items {
-		rp := RealmToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RealmToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListGameservicesBetaRealmResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRealm(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
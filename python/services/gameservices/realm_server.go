@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	gameservicespb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gameservices/gameservices_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gameservices"
@@ -60,7 +61,11 @@ func RealmToProto(resource *gameservices.Realm) *gameservicespb.GameservicesReal
 // applyRealm handles the gRPC request by passing it to the underlying Realm Apply() method.
 func (s *RealmServer) applyRealm(ctx context.Context, c *gameservices.Client, request *gameservicespb.ApplyGameservicesRealmRequest) (*gameservicespb.GameservicesRealm, error) {
 	p := ProtoToRealm(request.GetResource())
-	res, err := c.ApplyRealm(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRealm(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +82,60 @@ func (s *RealmServer) ApplyGameservicesRealm(ctx context.Context, request *games
 	return s.applyRealm(ctx, cl, request)
 }
 
+// GetGameservicesRealm handles the gRPC request by passing it to the underlying Realm Get() method.
+func (s *RealmServer) GetGameservicesRealm(ctx context.Context, request *gameservicespb.GetGameservicesRealmRequest) (*gameservicespb.GameservicesRealm, error) {
+	cl, err := createConfigRealm(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRealm(ctx, ProtoToRealm(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RealmToProto(res), nil
+}
+
+// HasDiffGameservicesRealm handles the gRPC request by passing it to the underlying Realm Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RealmServer) HasDiffGameservicesRealm(ctx context.Context, request *gameservicespb.HasDiffGameservicesRealmRequest) (*gameservicespb.HasDiffGameservicesRealmResponse, error) {
+	cl, err := createConfigRealm(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRealm(ctx, ProtoToRealm(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &gameservicespb.HasDiffGameservicesRealmResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanGameservicesRealm handles the gRPC request by passing it to the underlying Realm Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RealmServer) PlanGameservicesRealm(ctx context.Context, request *gameservicespb.PlanGameservicesRealmRequest) (*gameservicespb.PlanGameservicesRealmResponse, error) {
+	cl, err := createConfigRealm(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRealm(ctx, ProtoToRealm(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &gameservicespb.PlanGameservicesRealmResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRealm handles the gRPC request by passing it to the underlying Realm Delete() method.
 func (s *RealmServer) DeleteGameservicesRealm(ctx context.Context, request *gameservicespb.DeleteGameservicesRealmRequest) (*emptypb.Empty, error) {
 
@@ -95,22 +154,35 @@ func (s *RealmServer) ListGameservicesRealm(ctx context.Context, request *gamese
 		return nil, err
 	}
 
-	resources, err := cl.ListRealm(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = gameservices.RealmMaxPage
+	}
+	resources, err := cl.ListRealmWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*gameservicespb.GameservicesRealm
-	for _, r := range resources.Items {
-		rp := RealmToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RealmToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &gameservicespb.ListGameservicesRealmResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRealm(ctx context.Context, service_account_file string) (*gameservices.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return gameservices.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkehub/alpha/gkehub_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkehub/alpha"
@@ -378,7 +379,11 @@ func FeatureMembershipToProto(resource *alpha.FeatureMembership) *alphapb.Gkehub
 // applyFeatureMembership handles the gRPC request by passing it to the underlying FeatureMembership Apply() method.
 func (s *FeatureMembershipServer) applyFeatureMembership(ctx context.Context, c *alpha.Client, request *alphapb.ApplyGkehubAlphaFeatureMembershipRequest) (*alphapb.GkehubAlphaFeatureMembership, error) {
 	p := ProtoToFeatureMembership(request.GetResource())
-	res, err := c.ApplyFeatureMembership(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFeatureMembership(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -395,6 +400,60 @@ func (s *FeatureMembershipServer) ApplyGkehubAlphaFeatureMembership(ctx context.
 	return s.applyFeatureMembership(ctx, cl, request)
 }
 
+// GetGkehubAlphaFeatureMembership handles the gRPC request by passing it to the underlying FeatureMembership Get() method.
+func (s *FeatureMembershipServer) GetGkehubAlphaFeatureMembership(ctx context.Context, request *alphapb.GetGkehubAlphaFeatureMembershipRequest) (*alphapb.GkehubAlphaFeatureMembership, error) {
+	cl, err := createConfigFeatureMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFeatureMembership(ctx, ProtoToFeatureMembership(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FeatureMembershipToProto(res), nil
+}
+
+// HasDiffGkehubAlphaFeatureMembership handles the gRPC request by passing it to the underlying FeatureMembership Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FeatureMembershipServer) HasDiffGkehubAlphaFeatureMembership(ctx context.Context, request *alphapb.HasDiffGkehubAlphaFeatureMembershipRequest) (*alphapb.HasDiffGkehubAlphaFeatureMembershipResponse, error) {
+	cl, err := createConfigFeatureMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFeatureMembership(ctx, ProtoToFeatureMembership(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffGkehubAlphaFeatureMembershipResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanGkehubAlphaFeatureMembership handles the gRPC request by passing it to the underlying FeatureMembership Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FeatureMembershipServer) PlanGkehubAlphaFeatureMembership(ctx context.Context, request *alphapb.PlanGkehubAlphaFeatureMembershipRequest) (*alphapb.PlanGkehubAlphaFeatureMembershipResponse, error) {
+	cl, err := createConfigFeatureMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFeatureMembership(ctx, ProtoToFeatureMembership(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanGkehubAlphaFeatureMembershipResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFeatureMembership handles the gRPC request by passing it to the underlying FeatureMembership Delete() method.
 func (s *FeatureMembershipServer) DeleteGkehubAlphaFeatureMembership(ctx context.Context, request *alphapb.DeleteGkehubAlphaFeatureMembershipRequest) (*emptypb.Empty, error) {
 
@@ -413,22 +472,32 @@ func (s *FeatureMembershipServer) ListGkehubAlphaFeatureMembership(ctx context.C
 		return nil, err
 	}
 
+	// Feature memberships are listed in a single page.
 	resources, err := cl.ListFeatureMembership(ctx, request.GetProject(), request.GetLocation(), request.GetFeature())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.GkehubAlphaFeatureMembership
-	for _, r := range resources.Items {
-		rp := FeatureMembershipToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FeatureMembershipToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListGkehubAlphaFeatureMembershipResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFeatureMembership(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
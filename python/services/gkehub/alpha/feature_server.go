@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkehub/alpha/gkehub_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkehub/alpha"
@@ -384,7 +385,11 @@ func FeatureToProto(resource *alpha.Feature) *alphapb.GkehubAlphaFeature {
 // applyFeature handles the gRPC request by passing it to the underlying Feature Apply() method.
 func (s *FeatureServer) applyFeature(ctx context.Context, c *alpha.Client, request *alphapb.ApplyGkehubAlphaFeatureRequest) (*alphapb.GkehubAlphaFeature, error) {
 	p := ProtoToFeature(request.GetResource())
-	res, err := c.ApplyFeature(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFeature(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -401,6 +406,60 @@ func (s *FeatureServer) ApplyGkehubAlphaFeature(ctx context.Context, request *al
 	return s.applyFeature(ctx, cl, request)
 }
 
+// GetGkehubAlphaFeature handles the gRPC request by passing it to the underlying Feature Get() method.
+func (s *FeatureServer) GetGkehubAlphaFeature(ctx context.Context, request *alphapb.GetGkehubAlphaFeatureRequest) (*alphapb.GkehubAlphaFeature, error) {
+	cl, err := createConfigFeature(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFeature(ctx, ProtoToFeature(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FeatureToProto(res), nil
+}
+
+// HasDiffGkehubAlphaFeature handles the gRPC request by passing it to the underlying Feature Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FeatureServer) HasDiffGkehubAlphaFeature(ctx context.Context, request *alphapb.HasDiffGkehubAlphaFeatureRequest) (*alphapb.HasDiffGkehubAlphaFeatureResponse, error) {
+	cl, err := createConfigFeature(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFeature(ctx, ProtoToFeature(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffGkehubAlphaFeatureResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanGkehubAlphaFeature handles the gRPC request by passing it to the underlying Feature Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FeatureServer) PlanGkehubAlphaFeature(ctx context.Context, request *alphapb.PlanGkehubAlphaFeatureRequest) (*alphapb.PlanGkehubAlphaFeatureResponse, error) {
+	cl, err := createConfigFeature(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFeature(ctx, ProtoToFeature(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanGkehubAlphaFeatureResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFeature handles the gRPC request by passing it to the underlying Feature Delete() method.
 func (s *FeatureServer) DeleteGkehubAlphaFeature(ctx context.Context, request *alphapb.DeleteGkehubAlphaFeatureRequest) (*emptypb.Empty, error) {
 
@@ -419,22 +478,35 @@ func (s *FeatureServer) ListGkehubAlphaFeature(ctx context.Context, request *alp
 		return nil, err
 	}
 
-	resources, err := cl.ListFeature(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.FeatureMaxPage
+	}
+	resources, err := cl.ListFeatureWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.GkehubAlphaFeature
-	for _, r := range resources.Items {
-		rp := FeatureToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FeatureToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListGkehubAlphaFeatureResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFeature(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
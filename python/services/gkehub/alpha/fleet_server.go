@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkehub/alpha/gkehub_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkehub/alpha"
@@ -57,7 +58,11 @@ func FleetToProto(resource *alpha.Fleet) *alphapb.GkehubAlphaFleet {
 // applyFleet handles the gRPC request by passing it to the underlying Fleet Apply() method.
 func (s *FleetServer) applyFleet(ctx context.Context, c *alpha.Client, request *alphapb.ApplyGkehubAlphaFleetRequest) (*alphapb.GkehubAlphaFleet, error) {
 	p := ProtoToFleet(request.GetResource())
-	res, err := c.ApplyFleet(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFleet(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +79,60 @@ func (s *FleetServer) ApplyGkehubAlphaFleet(ctx context.Context, request *alphap
 	return s.applyFleet(ctx, cl, request)
 }
 
+// GetGkehubAlphaFleet handles the gRPC request by passing it to the underlying Fleet Get() method.
+func (s *FleetServer) GetGkehubAlphaFleet(ctx context.Context, request *alphapb.GetGkehubAlphaFleetRequest) (*alphapb.GkehubAlphaFleet, error) {
+	cl, err := createConfigFleet(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFleet(ctx, ProtoToFleet(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FleetToProto(res), nil
+}
+
+// HasDiffGkehubAlphaFleet handles the gRPC request by passing it to the underlying Fleet Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FleetServer) HasDiffGkehubAlphaFleet(ctx context.Context, request *alphapb.HasDiffGkehubAlphaFleetRequest) (*alphapb.HasDiffGkehubAlphaFleetResponse, error) {
+	cl, err := createConfigFleet(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFleet(ctx, ProtoToFleet(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffGkehubAlphaFleetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanGkehubAlphaFleet handles the gRPC request by passing it to the underlying Fleet Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FleetServer) PlanGkehubAlphaFleet(ctx context.Context, request *alphapb.PlanGkehubAlphaFleetRequest) (*alphapb.PlanGkehubAlphaFleetResponse, error) {
+	cl, err := createConfigFleet(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFleet(ctx, ProtoToFleet(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanGkehubAlphaFleetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFleet handles the gRPC request by passing it to the underlying Fleet Delete() method.
 func (s *FleetServer) DeleteGkehubAlphaFleet(ctx context.Context, request *alphapb.DeleteGkehubAlphaFleetRequest) (*emptypb.Empty, error) {
 
@@ -91,7 +150,9 @@ func (s *FleetServer) ListGkehubAlphaFleet(_ context.Context, _ *alphapb.ListGke
 }
 
 func createConfigFleet(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkehub/alpha/gkehub_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkehub/alpha"
@@ -351,7 +352,11 @@ func MembershipToProto(resource *alpha.Membership) *alphapb.GkehubAlphaMembershi
 // applyMembership handles the gRPC request by passing it to the underlying Membership Apply() method.
 func (s *MembershipServer) applyMembership(ctx context.Context, c *alpha.Client, request *alphapb.ApplyGkehubAlphaMembershipRequest) (*alphapb.GkehubAlphaMembership, error) {
 	p := ProtoToMembership(request.GetResource())
-	res, err := c.ApplyMembership(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMembership(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -368,6 +373,60 @@ func (s *MembershipServer) ApplyGkehubAlphaMembership(ctx context.Context, reque
 	return s.applyMembership(ctx, cl, request)
 }
 
+// GetGkehubAlphaMembership handles the gRPC request by passing it to the underlying Membership Get() method.
+func (s *MembershipServer) GetGkehubAlphaMembership(ctx context.Context, request *alphapb.GetGkehubAlphaMembershipRequest) (*alphapb.GkehubAlphaMembership, error) {
+	cl, err := createConfigMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMembership(ctx, ProtoToMembership(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MembershipToProto(res), nil
+}
+
+// HasDiffGkehubAlphaMembership handles the gRPC request by passing it to the underlying Membership Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MembershipServer) HasDiffGkehubAlphaMembership(ctx context.Context, request *alphapb.HasDiffGkehubAlphaMembershipRequest) (*alphapb.HasDiffGkehubAlphaMembershipResponse, error) {
+	cl, err := createConfigMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMembership(ctx, ProtoToMembership(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffGkehubAlphaMembershipResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanGkehubAlphaMembership handles the gRPC request by passing it to the underlying Membership Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MembershipServer) PlanGkehubAlphaMembership(ctx context.Context, request *alphapb.PlanGkehubAlphaMembershipRequest) (*alphapb.PlanGkehubAlphaMembershipResponse, error) {
+	cl, err := createConfigMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMembership(ctx, ProtoToMembership(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanGkehubAlphaMembershipResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMembership handles the gRPC request by passing it to the underlying Membership Delete() method.
 func (s *MembershipServer) DeleteGkehubAlphaMembership(ctx context.Context, request *alphapb.DeleteGkehubAlphaMembershipRequest) (*emptypb.Empty, error) {
 
@@ -386,22 +445,35 @@ func (s *MembershipServer) ListGkehubAlphaMembership(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListMembership(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.MembershipMaxPage
+	}
+	resources, err := cl.ListMembershipWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.GkehubAlphaMembership
-	for _, r := range resources.Items {
-		rp := MembershipToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MembershipToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListGkehubAlphaMembershipResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMembership(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkehub/beta/gkehub_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkehub/beta"
@@ -378,7 +379,11 @@ func FeatureMembershipToProto(resource *beta.FeatureMembership) *betapb.GkehubBe
 // applyFeatureMembership handles the gRPC request by passing it to the underlying FeatureMembership Apply() method.
 func (s *FeatureMembershipServer) applyFeatureMembership(ctx context.Context, c *beta.Client, request *betapb.ApplyGkehubBetaFeatureMembershipRequest) (*betapb.GkehubBetaFeatureMembership, error) {
 	p := ProtoToFeatureMembership(request.GetResource())
-	res, err := c.ApplyFeatureMembership(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFeatureMembership(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -395,6 +400,60 @@ func (s *FeatureMembershipServer) ApplyGkehubBetaFeatureMembership(ctx context.C
 	return s.applyFeatureMembership(ctx, cl, request)
 }
 
+// GetGkehubBetaFeatureMembership handles the gRPC request by passing it to the underlying FeatureMembership Get() method.
+func (s *FeatureMembershipServer) GetGkehubBetaFeatureMembership(ctx context.Context, request *betapb.GetGkehubBetaFeatureMembershipRequest) (*betapb.GkehubBetaFeatureMembership, error) {
+	cl, err := createConfigFeatureMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFeatureMembership(ctx, ProtoToFeatureMembership(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FeatureMembershipToProto(res), nil
+}
+
+// HasDiffGkehubBetaFeatureMembership handles the gRPC request by passing it to the underlying FeatureMembership Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FeatureMembershipServer) HasDiffGkehubBetaFeatureMembership(ctx context.Context, request *betapb.HasDiffGkehubBetaFeatureMembershipRequest) (*betapb.HasDiffGkehubBetaFeatureMembershipResponse, error) {
+	cl, err := createConfigFeatureMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFeatureMembership(ctx, ProtoToFeatureMembership(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffGkehubBetaFeatureMembershipResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanGkehubBetaFeatureMembership handles the gRPC request by passing it to the underlying FeatureMembership Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FeatureMembershipServer) PlanGkehubBetaFeatureMembership(ctx context.Context, request *betapb.PlanGkehubBetaFeatureMembershipRequest) (*betapb.PlanGkehubBetaFeatureMembershipResponse, error) {
+	cl, err := createConfigFeatureMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFeatureMembership(ctx, ProtoToFeatureMembership(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanGkehubBetaFeatureMembershipResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFeatureMembership handles the gRPC request by passing it to the underlying FeatureMembership Delete() method.
 func (s *FeatureMembershipServer) DeleteGkehubBetaFeatureMembership(ctx context.Context, request *betapb.DeleteGkehubBetaFeatureMembershipRequest) (*emptypb.Empty, error) {
 
@@ -413,22 +472,32 @@ func (s *FeatureMembershipServer) ListGkehubBetaFeatureMembership(ctx context.Co
 		return nil, err
 	}
 
+	// Feature memberships are listed in a single page.
 	resources, err := cl.ListFeatureMembership(ctx, request.GetProject(), request.GetLocation(), request.GetFeature())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.GkehubBetaFeatureMembership
-	for _, r := range resources.Items {
-		rp := FeatureMembershipToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FeatureMembershipToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListGkehubBetaFeatureMembershipResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFeatureMembership(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
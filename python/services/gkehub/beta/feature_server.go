@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkehub/beta/gkehub_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkehub/beta"
@@ -221,7 +222,11 @@ func FeatureToProto(resource *beta.Feature) *betapb.GkehubBetaFeature {
 // applyFeature handles the gRPC request by passing it to the underlying Feature Apply() method.
 func (s *FeatureServer) applyFeature(ctx context.Context, c *beta.Client, request *betapb.ApplyGkehubBetaFeatureRequest) (*betapb.GkehubBetaFeature, error) {
 	p := ProtoToFeature(request.GetResource())
-	res, err := c.ApplyFeature(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFeature(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -238,6 +243,60 @@ func (s *FeatureServer) ApplyGkehubBetaFeature(ctx context.Context, request *bet
 	return s.applyFeature(ctx, cl, request)
 }
 
+// GetGkehubBetaFeature handles the gRPC request by passing it to the underlying Feature Get() method.
+func (s *FeatureServer) GetGkehubBetaFeature(ctx context.Context, request *betapb.GetGkehubBetaFeatureRequest) (*betapb.GkehubBetaFeature, error) {
+	cl, err := createConfigFeature(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFeature(ctx, ProtoToFeature(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FeatureToProto(res), nil
+}
+
+// HasDiffGkehubBetaFeature handles the gRPC request by passing it to the underlying Feature Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FeatureServer) HasDiffGkehubBetaFeature(ctx context.Context, request *betapb.HasDiffGkehubBetaFeatureRequest) (*betapb.HasDiffGkehubBetaFeatureResponse, error) {
+	cl, err := createConfigFeature(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFeature(ctx, ProtoToFeature(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffGkehubBetaFeatureResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanGkehubBetaFeature handles the gRPC request by passing it to the underlying Feature Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FeatureServer) PlanGkehubBetaFeature(ctx context.Context, request *betapb.PlanGkehubBetaFeatureRequest) (*betapb.PlanGkehubBetaFeatureResponse, error) {
+	cl, err := createConfigFeature(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFeature(ctx, ProtoToFeature(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanGkehubBetaFeatureResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFeature handles the gRPC request by passing it to the underlying Feature Delete() method.
 func (s *FeatureServer) DeleteGkehubBetaFeature(ctx context.Context, request *betapb.DeleteGkehubBetaFeatureRequest) (*emptypb.Empty, error) {
 
@@ -256,22 +315,35 @@ func (s *FeatureServer) ListGkehubBetaFeature(ctx context.Context, request *beta
 		return nil, err
 	}
 
-	resources, err := cl.ListFeature(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.FeatureMaxPage
+	}
+	resources, err := cl.ListFeatureWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.GkehubBetaFeature
-	for _, r := range resources.Items {
-		rp := FeatureToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FeatureToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListGkehubBetaFeatureResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFeature(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkehub/beta/gkehub_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkehub/beta"
@@ -351,7 +352,11 @@ func MembershipToProto(resource *beta.Membership) *betapb.GkehubBetaMembership {
 // applyMembership handles the gRPC request by passing it to the underlying Membership Apply() method.
 func (s *MembershipServer) applyMembership(ctx context.Context, c *beta.Client, request *betapb.ApplyGkehubBetaMembershipRequest) (*betapb.GkehubBetaMembership, error) {
 	p := ProtoToMembership(request.GetResource())
-	res, err := c.ApplyMembership(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMembership(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -368,6 +373,60 @@ func (s *MembershipServer) ApplyGkehubBetaMembership(ctx context.Context, reques
 	return s.applyMembership(ctx, cl, request)
 }
 
+// GetGkehubBetaMembership handles the gRPC request by passing it to the underlying Membership Get() method.
+func (s *MembershipServer) GetGkehubBetaMembership(ctx context.Context, request *betapb.GetGkehubBetaMembershipRequest) (*betapb.GkehubBetaMembership, error) {
+	cl, err := createConfigMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMembership(ctx, ProtoToMembership(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MembershipToProto(res), nil
+}
+
+// HasDiffGkehubBetaMembership handles the gRPC request by passing it to the underlying Membership Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MembershipServer) HasDiffGkehubBetaMembership(ctx context.Context, request *betapb.HasDiffGkehubBetaMembershipRequest) (*betapb.HasDiffGkehubBetaMembershipResponse, error) {
+	cl, err := createConfigMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMembership(ctx, ProtoToMembership(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffGkehubBetaMembershipResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanGkehubBetaMembership handles the gRPC request by passing it to the underlying Membership Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MembershipServer) PlanGkehubBetaMembership(ctx context.Context, request *betapb.PlanGkehubBetaMembershipRequest) (*betapb.PlanGkehubBetaMembershipResponse, error) {
+	cl, err := createConfigMembership(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMembership(ctx, ProtoToMembership(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanGkehubBetaMembershipResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMembership handles the gRPC request by passing it to the underlying Membership Delete() method.
 func (s *MembershipServer) DeleteGkehubBetaMembership(ctx context.Context, request *betapb.DeleteGkehubBetaMembershipRequest) (*emptypb.Empty, error) {
 
@@ -386,22 +445,35 @@ func (s *MembershipServer) ListGkehubBetaMembership(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListMembership(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.MembershipMaxPage
+	}
+	resources, err := cl.ListMembershipWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.GkehubBetaMembership
-	for _, r := range resources.Items {
-		rp := MembershipToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MembershipToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListGkehubBetaMembershipResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMembership(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
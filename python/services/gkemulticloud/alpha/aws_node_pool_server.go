@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkemulticloud/alpha/gkemulticloud_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkemulticloud/alpha"
@@ -312,7 +313,11 @@ func AwsNodePoolToProto(resource *alpha.AwsNodePool) *alphapb.GkemulticloudAlpha
 // ApplyAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Apply() method.
 func (s *AwsNodePoolServer) applyAwsNodePool(ctx context.Context, c *alpha.Client, request *alphapb.ApplyGkemulticloudAlphaAwsNodePoolRequest) (*alphapb.GkemulticloudAlphaAwsNodePool, error) {
 	p := ProtoToAwsNodePool(request.GetResource())
-	res, err := c.ApplyAwsNodePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAwsNodePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -329,6 +334,55 @@ func (s *AwsNodePoolServer) ApplyGkemulticloudAlphaAwsNodePool(ctx context.Conte
 	return s.applyAwsNodePool(ctx, cl, request)
 }
 
+// GetGkemulticloudAlphaAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Get() method.
+func (s *AwsNodePoolServer) GetGkemulticloudAlphaAwsNodePool(ctx context.Context, request *alphapb.GetGkemulticloudAlphaAwsNodePoolRequest) (*alphapb.GkemulticloudAlphaAwsNodePool, error) {
+	cl, err := createConfigAwsNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAwsNodePool(ctx, ProtoToAwsNodePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AwsNodePoolToProto(res), nil
+}
+
+// HasDiffGkemulticloudAlphaAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AwsNodePoolServer) HasDiffGkemulticloudAlphaAwsNodePool(ctx context.Context, request *alphapb.HasDiffGkemulticloudAlphaAwsNodePoolRequest) (*alphapb.HasDiffGkemulticloudAlphaAwsNodePoolResponse, error) {
+	cl, err := createConfigAwsNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAwsNodePool(ctx, ProtoToAwsNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.HasDiffGkemulticloudAlphaAwsNodePoolResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanGkemulticloudAlphaAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AwsNodePoolServer) PlanGkemulticloudAlphaAwsNodePool(ctx context.Context, request *alphapb.PlanGkemulticloudAlphaAwsNodePoolRequest) (*alphapb.PlanGkemulticloudAlphaAwsNodePoolResponse, error) {
+	cl, err := createConfigAwsNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAwsNodePool(ctx, ProtoToAwsNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.PlanGkemulticloudAlphaAwsNodePoolResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Delete() method.
 func (s *AwsNodePoolServer) DeleteGkemulticloudAlphaAwsNodePool(ctx context.Context, request *alphapb.DeleteGkemulticloudAlphaAwsNodePoolRequest) (*emptypb.Empty, error) {
 
@@ -347,20 +401,32 @@ func (s *AwsNodePoolServer) ListGkemulticloudAlphaAwsNodePool(ctx context.Contex
 		return nil, err
 	}
 
-	resources, err := cl.ListAwsNodePool(ctx, ProtoToAwsNodePool(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = alpha.AwsNodePoolMaxPage
+	}
+	resources, err := cl.ListAwsNodePoolWithPageToken(ctx, ProtoToAwsNodePool(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.GkemulticloudAlphaAwsNodePool
-	for _, r := range resources.Items {
-		rp := AwsNodePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AwsNodePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &alphapb.ListGkemulticloudAlphaAwsNodePoolResponse{Items: protos}, nil
+	return &alphapb.ListGkemulticloudAlphaAwsNodePoolResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAwsNodePool(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkemulticloud/alpha/gkemulticloud_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkemulticloud/alpha"
@@ -59,7 +60,11 @@ func AzureClientToProto(resource *alpha.AzureClient) *alphapb.GkemulticloudAlpha
 // ApplyAzureClient handles the gRPC request by passing it to the underlying AzureClient Apply() method.
 func (s *AzureClientServer) applyAzureClient(ctx context.Context, c *alpha.Client, request *alphapb.ApplyGkemulticloudAlphaAzureClientRequest) (*alphapb.GkemulticloudAlphaAzureClient, error) {
 	p := ProtoToAzureClient(request.GetResource())
-	res, err := c.ApplyAzureClient(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAzureClient(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +81,55 @@ func (s *AzureClientServer) ApplyGkemulticloudAlphaAzureClient(ctx context.Conte
 	return s.applyAzureClient(ctx, cl, request)
 }
 
+// GetGkemulticloudAlphaAzureClient handles the gRPC request by passing it to the underlying AzureClient Get() method.
+func (s *AzureClientServer) GetGkemulticloudAlphaAzureClient(ctx context.Context, request *alphapb.GetGkemulticloudAlphaAzureClientRequest) (*alphapb.GkemulticloudAlphaAzureClient, error) {
+	cl, err := createConfigAzureClient(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAzureClient(ctx, ProtoToAzureClient(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AzureClientToProto(res), nil
+}
+
+// HasDiffGkemulticloudAlphaAzureClient handles the gRPC request by passing it to the underlying AzureClient Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AzureClientServer) HasDiffGkemulticloudAlphaAzureClient(ctx context.Context, request *alphapb.HasDiffGkemulticloudAlphaAzureClientRequest) (*alphapb.HasDiffGkemulticloudAlphaAzureClientResponse, error) {
+	cl, err := createConfigAzureClient(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureClient(ctx, ProtoToAzureClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.HasDiffGkemulticloudAlphaAzureClientResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanGkemulticloudAlphaAzureClient handles the gRPC request by passing it to the underlying AzureClient Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AzureClientServer) PlanGkemulticloudAlphaAzureClient(ctx context.Context, request *alphapb.PlanGkemulticloudAlphaAzureClientRequest) (*alphapb.PlanGkemulticloudAlphaAzureClientResponse, error) {
+	cl, err := createConfigAzureClient(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureClient(ctx, ProtoToAzureClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.PlanGkemulticloudAlphaAzureClientResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAzureClient handles the gRPC request by passing it to the underlying AzureClient Delete() method.
 func (s *AzureClientServer) DeleteGkemulticloudAlphaAzureClient(ctx context.Context, request *alphapb.DeleteGkemulticloudAlphaAzureClientRequest) (*emptypb.Empty, error) {
 
@@ -94,20 +148,32 @@ func (s *AzureClientServer) ListGkemulticloudAlphaAzureClient(ctx context.Contex
 		return nil, err
 	}
 
-	resources, err := cl.ListAzureClient(ctx, ProtoToAzureClient(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = alpha.AzureClientMaxPage
+	}
+	resources, err := cl.ListAzureClientWithPageToken(ctx, ProtoToAzureClient(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.GkemulticloudAlphaAzureClient
-	for _, r := range resources.Items {
-		rp := AzureClientToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AzureClientToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &alphapb.ListGkemulticloudAlphaAzureClientResponse{Items: protos}, nil
+	return &alphapb.ListGkemulticloudAlphaAzureClientResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAzureClient(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	gkemulticloudpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkemulticloud/gkemulticloud_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkemulticloud"
@@ -432,7 +433,11 @@ func AwsClusterToProto(resource *gkemulticloud.AwsCluster) *gkemulticloudpb.Gkem
 // ApplyAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Apply() method.
 func (s *AwsClusterServer) applyAwsCluster(ctx context.Context, c *gkemulticloud.Client, request *gkemulticloudpb.ApplyGkemulticloudAwsClusterRequest) (*gkemulticloudpb.GkemulticloudAwsCluster, error) {
 	p := ProtoToAwsCluster(request.GetResource())
-	res, err := c.ApplyAwsCluster(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAwsCluster(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -449,6 +454,55 @@ func (s *AwsClusterServer) ApplyGkemulticloudAwsCluster(ctx context.Context, req
 	return s.applyAwsCluster(ctx, cl, request)
 }
 
+// GetGkemulticloudAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Get() method.
+func (s *AwsClusterServer) GetGkemulticloudAwsCluster(ctx context.Context, request *gkemulticloudpb.GetGkemulticloudAwsClusterRequest) (*gkemulticloudpb.GkemulticloudAwsCluster, error) {
+	cl, err := createConfigAwsCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAwsCluster(ctx, ProtoToAwsCluster(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AwsClusterToProto(res), nil
+}
+
+// HasDiffGkemulticloudAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AwsClusterServer) HasDiffGkemulticloudAwsCluster(ctx context.Context, request *gkemulticloudpb.HasDiffGkemulticloudAwsClusterRequest) (*gkemulticloudpb.HasDiffGkemulticloudAwsClusterResponse, error) {
+	cl, err := createConfigAwsCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAwsCluster(ctx, ProtoToAwsCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &gkemulticloudpb.HasDiffGkemulticloudAwsClusterResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanGkemulticloudAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AwsClusterServer) PlanGkemulticloudAwsCluster(ctx context.Context, request *gkemulticloudpb.PlanGkemulticloudAwsClusterRequest) (*gkemulticloudpb.PlanGkemulticloudAwsClusterResponse, error) {
+	cl, err := createConfigAwsCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAwsCluster(ctx, ProtoToAwsCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &gkemulticloudpb.PlanGkemulticloudAwsClusterResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Delete() method.
 func (s *AwsClusterServer) DeleteGkemulticloudAwsCluster(ctx context.Context, request *gkemulticloudpb.DeleteGkemulticloudAwsClusterRequest) (*emptypb.Empty, error) {
 
@@ -467,20 +521,32 @@ func (s *AwsClusterServer) ListGkemulticloudAwsCluster(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListAwsCluster(ctx, ProtoToAwsCluster(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = gkemulticloud.AwsClusterMaxPage
+	}
+	resources, err := cl.ListAwsClusterWithPageToken(ctx, ProtoToAwsCluster(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*gkemulticloudpb.GkemulticloudAwsCluster
-	for _, r := range resources.Items {
-		rp := AwsClusterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AwsClusterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &gkemulticloudpb.ListGkemulticloudAwsClusterResponse{Items: protos}, nil
+	return &gkemulticloudpb.ListGkemulticloudAwsClusterResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAwsCluster(ctx context.Context, service_account_file string) (*gkemulticloud.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return gkemulticloud.NewClient(conf), nil
 }
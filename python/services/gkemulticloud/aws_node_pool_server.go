@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	gkemulticloudpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkemulticloud/gkemulticloud_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkemulticloud"
@@ -312,7 +313,11 @@ func AwsNodePoolToProto(resource *gkemulticloud.AwsNodePool) *gkemulticloudpb.Gk
 // ApplyAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Apply() method.
 func (s *AwsNodePoolServer) applyAwsNodePool(ctx context.Context, c *gkemulticloud.Client, request *gkemulticloudpb.ApplyGkemulticloudAwsNodePoolRequest) (*gkemulticloudpb.GkemulticloudAwsNodePool, error) {
 	p := ProtoToAwsNodePool(request.GetResource())
-	res, err := c.ApplyAwsNodePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAwsNodePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -329,6 +334,55 @@ func (s *AwsNodePoolServer) ApplyGkemulticloudAwsNodePool(ctx context.Context, r
 	return s.applyAwsNodePool(ctx, cl, request)
 }
 
+// GetGkemulticloudAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Get() method.
+func (s *AwsNodePoolServer) GetGkemulticloudAwsNodePool(ctx context.Context, request *gkemulticloudpb.GetGkemulticloudAwsNodePoolRequest) (*gkemulticloudpb.GkemulticloudAwsNodePool, error) {
+	cl, err := createConfigAwsNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAwsNodePool(ctx, ProtoToAwsNodePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AwsNodePoolToProto(res), nil
+}
+
+// HasDiffGkemulticloudAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AwsNodePoolServer) HasDiffGkemulticloudAwsNodePool(ctx context.Context, request *gkemulticloudpb.HasDiffGkemulticloudAwsNodePoolRequest) (*gkemulticloudpb.HasDiffGkemulticloudAwsNodePoolResponse, error) {
+	cl, err := createConfigAwsNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAwsNodePool(ctx, ProtoToAwsNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &gkemulticloudpb.HasDiffGkemulticloudAwsNodePoolResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanGkemulticloudAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AwsNodePoolServer) PlanGkemulticloudAwsNodePool(ctx context.Context, request *gkemulticloudpb.PlanGkemulticloudAwsNodePoolRequest) (*gkemulticloudpb.PlanGkemulticloudAwsNodePoolResponse, error) {
+	cl, err := createConfigAwsNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAwsNodePool(ctx, ProtoToAwsNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &gkemulticloudpb.PlanGkemulticloudAwsNodePoolResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAwsNodePool handles the gRPC request by passing it to the underlying AwsNodePool Delete() method.
 func (s *AwsNodePoolServer) DeleteGkemulticloudAwsNodePool(ctx context.Context, request *gkemulticloudpb.DeleteGkemulticloudAwsNodePoolRequest) (*emptypb.Empty, error) {
 
@@ -347,20 +401,32 @@ func (s *AwsNodePoolServer) ListGkemulticloudAwsNodePool(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListAwsNodePool(ctx, ProtoToAwsNodePool(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = gkemulticloud.AwsNodePoolMaxPage
+	}
+	resources, err := cl.ListAwsNodePoolWithPageToken(ctx, ProtoToAwsNodePool(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*gkemulticloudpb.GkemulticloudAwsNodePool
-	for _, r := range resources.Items {
-		rp := AwsNodePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AwsNodePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &gkemulticloudpb.ListGkemulticloudAwsNodePoolResponse{Items: protos}, nil
+	return &gkemulticloudpb.ListGkemulticloudAwsNodePoolResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAwsNodePool(ctx context.Context, service_account_file string) (*gkemulticloud.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return gkemulticloud.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	gkemulticloudpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkemulticloud/gkemulticloud_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkemulticloud"
@@ -59,7 +60,11 @@ func AzureClientToProto(resource *gkemulticloud.AzureClient) *gkemulticloudpb.Gk
 // ApplyAzureClient handles the gRPC request by passing it to the underlying AzureClient Apply() method.
 func (s *AzureClientServer) applyAzureClient(ctx context.Context, c *gkemulticloud.Client, request *gkemulticloudpb.ApplyGkemulticloudAzureClientRequest) (*gkemulticloudpb.GkemulticloudAzureClient, error) {
 	p := ProtoToAzureClient(request.GetResource())
-	res, err := c.ApplyAzureClient(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAzureClient(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +81,55 @@ func (s *AzureClientServer) ApplyGkemulticloudAzureClient(ctx context.Context, r
 	return s.applyAzureClient(ctx, cl, request)
 }
 
+// GetGkemulticloudAzureClient handles the gRPC request by passing it to the underlying AzureClient Get() method.
+func (s *AzureClientServer) GetGkemulticloudAzureClient(ctx context.Context, request *gkemulticloudpb.GetGkemulticloudAzureClientRequest) (*gkemulticloudpb.GkemulticloudAzureClient, error) {
+	cl, err := createConfigAzureClient(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAzureClient(ctx, ProtoToAzureClient(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AzureClientToProto(res), nil
+}
+
+// HasDiffGkemulticloudAzureClient handles the gRPC request by passing it to the underlying AzureClient Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AzureClientServer) HasDiffGkemulticloudAzureClient(ctx context.Context, request *gkemulticloudpb.HasDiffGkemulticloudAzureClientRequest) (*gkemulticloudpb.HasDiffGkemulticloudAzureClientResponse, error) {
+	cl, err := createConfigAzureClient(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureClient(ctx, ProtoToAzureClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &gkemulticloudpb.HasDiffGkemulticloudAzureClientResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanGkemulticloudAzureClient handles the gRPC request by passing it to the underlying AzureClient Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AzureClientServer) PlanGkemulticloudAzureClient(ctx context.Context, request *gkemulticloudpb.PlanGkemulticloudAzureClientRequest) (*gkemulticloudpb.PlanGkemulticloudAzureClientResponse, error) {
+	cl, err := createConfigAzureClient(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureClient(ctx, ProtoToAzureClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &gkemulticloudpb.PlanGkemulticloudAzureClientResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAzureClient handles the gRPC request by passing it to the underlying AzureClient Delete() method.
 func (s *AzureClientServer) DeleteGkemulticloudAzureClient(ctx context.Context, request *gkemulticloudpb.DeleteGkemulticloudAzureClientRequest) (*emptypb.Empty, error) {
 
@@ -94,20 +148,32 @@ func (s *AzureClientServer) ListGkemulticloudAzureClient(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListAzureClient(ctx, ProtoToAzureClient(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = gkemulticloud.AzureClientMaxPage
+	}
+	resources, err := cl.ListAzureClientWithPageToken(ctx, ProtoToAzureClient(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*gkemulticloudpb.GkemulticloudAzureClient
-	for _, r := range resources.Items {
-		rp := AzureClientToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AzureClientToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &gkemulticloudpb.ListGkemulticloudAzureClientResponse{Items: protos}, nil
+	return &gkemulticloudpb.ListGkemulticloudAzureClientResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAzureClient(ctx context.Context, service_account_file string) (*gkemulticloud.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return gkemulticloud.NewClient(conf), nil
 }
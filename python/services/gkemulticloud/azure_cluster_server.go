@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	gkemulticloudpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkemulticloud/gkemulticloud_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkemulticloud"
@@ -336,7 +337,11 @@ func AzureClusterToProto(resource *gkemulticloud.AzureCluster) *gkemulticloudpb.
 // ApplyAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Apply() method.
 func (s *AzureClusterServer) applyAzureCluster(ctx context.Context, c *gkemulticloud.Client, request *gkemulticloudpb.ApplyGkemulticloudAzureClusterRequest) (*gkemulticloudpb.GkemulticloudAzureCluster, error) {
 	p := ProtoToAzureCluster(request.GetResource())
-	res, err := c.ApplyAzureCluster(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAzureCluster(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -353,6 +358,55 @@ func (s *AzureClusterServer) ApplyGkemulticloudAzureCluster(ctx context.Context,
 	return s.applyAzureCluster(ctx, cl, request)
 }
 
+// GetGkemulticloudAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Get() method.
+func (s *AzureClusterServer) GetGkemulticloudAzureCluster(ctx context.Context, request *gkemulticloudpb.GetGkemulticloudAzureClusterRequest) (*gkemulticloudpb.GkemulticloudAzureCluster, error) {
+	cl, err := createConfigAzureCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAzureCluster(ctx, ProtoToAzureCluster(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AzureClusterToProto(res), nil
+}
+
+// HasDiffGkemulticloudAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AzureClusterServer) HasDiffGkemulticloudAzureCluster(ctx context.Context, request *gkemulticloudpb.HasDiffGkemulticloudAzureClusterRequest) (*gkemulticloudpb.HasDiffGkemulticloudAzureClusterResponse, error) {
+	cl, err := createConfigAzureCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureCluster(ctx, ProtoToAzureCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &gkemulticloudpb.HasDiffGkemulticloudAzureClusterResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanGkemulticloudAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AzureClusterServer) PlanGkemulticloudAzureCluster(ctx context.Context, request *gkemulticloudpb.PlanGkemulticloudAzureClusterRequest) (*gkemulticloudpb.PlanGkemulticloudAzureClusterResponse, error) {
+	cl, err := createConfigAzureCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureCluster(ctx, ProtoToAzureCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &gkemulticloudpb.PlanGkemulticloudAzureClusterResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Delete() method.
 func (s *AzureClusterServer) DeleteGkemulticloudAzureCluster(ctx context.Context, request *gkemulticloudpb.DeleteGkemulticloudAzureClusterRequest) (*emptypb.Empty, error) {
 
@@ -371,20 +425,32 @@ func (s *AzureClusterServer) ListGkemulticloudAzureCluster(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListAzureCluster(ctx, ProtoToAzureCluster(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = gkemulticloud.AzureClusterMaxPage
+	}
+	resources, err := cl.ListAzureClusterWithPageToken(ctx, ProtoToAzureCluster(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*gkemulticloudpb.GkemulticloudAzureCluster
-	for _, r := range resources.Items {
-		rp := AzureClusterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AzureClusterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &gkemulticloudpb.ListGkemulticloudAzureClusterResponse{Items: protos}, nil
+	return &gkemulticloudpb.ListGkemulticloudAzureClusterResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAzureCluster(ctx context.Context, service_account_file string) (*gkemulticloud.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return gkemulticloud.NewClient(conf), nil
 }
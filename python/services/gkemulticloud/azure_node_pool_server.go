@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	gkemulticloudpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkemulticloud/gkemulticloud_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkemulticloud"
@@ -218,7 +219,11 @@ func AzureNodePoolToProto(resource *gkemulticloud.AzureNodePool) *gkemulticloudp
 // ApplyAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Apply() method.
 func (s *AzureNodePoolServer) applyAzureNodePool(ctx context.Context, c *gkemulticloud.Client, request *gkemulticloudpb.ApplyGkemulticloudAzureNodePoolRequest) (*gkemulticloudpb.GkemulticloudAzureNodePool, error) {
 	p := ProtoToAzureNodePool(request.GetResource())
-	res, err := c.ApplyAzureNodePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAzureNodePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +240,55 @@ func (s *AzureNodePoolServer) ApplyGkemulticloudAzureNodePool(ctx context.Contex
 	return s.applyAzureNodePool(ctx, cl, request)
 }
 
+// GetGkemulticloudAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Get() method.
+func (s *AzureNodePoolServer) GetGkemulticloudAzureNodePool(ctx context.Context, request *gkemulticloudpb.GetGkemulticloudAzureNodePoolRequest) (*gkemulticloudpb.GkemulticloudAzureNodePool, error) {
+	cl, err := createConfigAzureNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAzureNodePool(ctx, ProtoToAzureNodePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AzureNodePoolToProto(res), nil
+}
+
+// HasDiffGkemulticloudAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AzureNodePoolServer) HasDiffGkemulticloudAzureNodePool(ctx context.Context, request *gkemulticloudpb.HasDiffGkemulticloudAzureNodePoolRequest) (*gkemulticloudpb.HasDiffGkemulticloudAzureNodePoolResponse, error) {
+	cl, err := createConfigAzureNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureNodePool(ctx, ProtoToAzureNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &gkemulticloudpb.HasDiffGkemulticloudAzureNodePoolResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanGkemulticloudAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AzureNodePoolServer) PlanGkemulticloudAzureNodePool(ctx context.Context, request *gkemulticloudpb.PlanGkemulticloudAzureNodePoolRequest) (*gkemulticloudpb.PlanGkemulticloudAzureNodePoolResponse, error) {
+	cl, err := createConfigAzureNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureNodePool(ctx, ProtoToAzureNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &gkemulticloudpb.PlanGkemulticloudAzureNodePoolResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Delete() method.
 func (s *AzureNodePoolServer) DeleteGkemulticloudAzureNodePool(ctx context.Context, request *gkemulticloudpb.DeleteGkemulticloudAzureNodePoolRequest) (*emptypb.Empty, error) {
 
@@ -253,20 +307,32 @@ func (s *AzureNodePoolServer) ListGkemulticloudAzureNodePool(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListAzureNodePool(ctx, ProtoToAzureNodePool(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = gkemulticloud.AzureNodePoolMaxPage
+	}
+	resources, err := cl.ListAzureNodePoolWithPageToken(ctx, ProtoToAzureNodePool(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*gkemulticloudpb.GkemulticloudAzureNodePool
-	for _, r := range resources.Items {
-		rp := AzureNodePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AzureNodePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &gkemulticloudpb.ListGkemulticloudAzureNodePoolResponse{Items: protos}, nil
+	return &gkemulticloudpb.ListGkemulticloudAzureNodePoolResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAzureNodePool(ctx context.Context, service_account_file string) (*gkemulticloud.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return gkemulticloud.NewClient(conf), nil
 }
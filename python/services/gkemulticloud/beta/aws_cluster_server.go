@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkemulticloud/beta/gkemulticloud_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkemulticloud/beta"
@@ -432,7 +433,11 @@ func AwsClusterToProto(resource *beta.AwsCluster) *betapb.GkemulticloudBetaAwsCl
 // ApplyAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Apply() method.
 func (s *AwsClusterServer) applyAwsCluster(ctx context.Context, c *beta.Client, request *betapb.ApplyGkemulticloudBetaAwsClusterRequest) (*betapb.GkemulticloudBetaAwsCluster, error) {
 	p := ProtoToAwsCluster(request.GetResource())
-	res, err := c.ApplyAwsCluster(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAwsCluster(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -449,6 +454,55 @@ func (s *AwsClusterServer) ApplyGkemulticloudBetaAwsCluster(ctx context.Context,
 	return s.applyAwsCluster(ctx, cl, request)
 }
 
+// GetGkemulticloudBetaAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Get() method.
+func (s *AwsClusterServer) GetGkemulticloudBetaAwsCluster(ctx context.Context, request *betapb.GetGkemulticloudBetaAwsClusterRequest) (*betapb.GkemulticloudBetaAwsCluster, error) {
+	cl, err := createConfigAwsCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAwsCluster(ctx, ProtoToAwsCluster(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AwsClusterToProto(res), nil
+}
+
+// HasDiffGkemulticloudBetaAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AwsClusterServer) HasDiffGkemulticloudBetaAwsCluster(ctx context.Context, request *betapb.HasDiffGkemulticloudBetaAwsClusterRequest) (*betapb.HasDiffGkemulticloudBetaAwsClusterResponse, error) {
+	cl, err := createConfigAwsCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAwsCluster(ctx, ProtoToAwsCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffGkemulticloudBetaAwsClusterResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanGkemulticloudBetaAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AwsClusterServer) PlanGkemulticloudBetaAwsCluster(ctx context.Context, request *betapb.PlanGkemulticloudBetaAwsClusterRequest) (*betapb.PlanGkemulticloudBetaAwsClusterResponse, error) {
+	cl, err := createConfigAwsCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAwsCluster(ctx, ProtoToAwsCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanGkemulticloudBetaAwsClusterResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAwsCluster handles the gRPC request by passing it to the underlying AwsCluster Delete() method.
 func (s *AwsClusterServer) DeleteGkemulticloudBetaAwsCluster(ctx context.Context, request *betapb.DeleteGkemulticloudBetaAwsClusterRequest) (*emptypb.Empty, error) {
 
@@ -467,20 +521,32 @@ func (s *AwsClusterServer) ListGkemulticloudBetaAwsCluster(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListAwsCluster(ctx, ProtoToAwsCluster(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.AwsClusterMaxPage
+	}
+	resources, err := cl.ListAwsClusterWithPageToken(ctx, ProtoToAwsCluster(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.GkemulticloudBetaAwsCluster
-	for _, r := range resources.Items {
-		rp := AwsClusterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AwsClusterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListGkemulticloudBetaAwsClusterResponse{Items: protos}, nil
+	return &betapb.ListGkemulticloudBetaAwsClusterResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAwsCluster(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkemulticloud/beta/gkemulticloud_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkemulticloud/beta"
@@ -59,7 +60,11 @@ func AzureClientToProto(resource *beta.AzureClient) *betapb.GkemulticloudBetaAzu
 // ApplyAzureClient handles the gRPC request by passing it to the underlying AzureClient Apply() method.
 func (s *AzureClientServer) applyAzureClient(ctx context.Context, c *beta.Client, request *betapb.ApplyGkemulticloudBetaAzureClientRequest) (*betapb.GkemulticloudBetaAzureClient, error) {
 	p := ProtoToAzureClient(request.GetResource())
-	res, err := c.ApplyAzureClient(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAzureClient(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +81,55 @@ func (s *AzureClientServer) ApplyGkemulticloudBetaAzureClient(ctx context.Contex
 	return s.applyAzureClient(ctx, cl, request)
 }
 
+// GetGkemulticloudBetaAzureClient handles the gRPC request by passing it to the underlying AzureClient Get() method.
+func (s *AzureClientServer) GetGkemulticloudBetaAzureClient(ctx context.Context, request *betapb.GetGkemulticloudBetaAzureClientRequest) (*betapb.GkemulticloudBetaAzureClient, error) {
+	cl, err := createConfigAzureClient(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAzureClient(ctx, ProtoToAzureClient(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AzureClientToProto(res), nil
+}
+
+// HasDiffGkemulticloudBetaAzureClient handles the gRPC request by passing it to the underlying AzureClient Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AzureClientServer) HasDiffGkemulticloudBetaAzureClient(ctx context.Context, request *betapb.HasDiffGkemulticloudBetaAzureClientRequest) (*betapb.HasDiffGkemulticloudBetaAzureClientResponse, error) {
+	cl, err := createConfigAzureClient(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureClient(ctx, ProtoToAzureClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffGkemulticloudBetaAzureClientResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanGkemulticloudBetaAzureClient handles the gRPC request by passing it to the underlying AzureClient Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AzureClientServer) PlanGkemulticloudBetaAzureClient(ctx context.Context, request *betapb.PlanGkemulticloudBetaAzureClientRequest) (*betapb.PlanGkemulticloudBetaAzureClientResponse, error) {
+	cl, err := createConfigAzureClient(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureClient(ctx, ProtoToAzureClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanGkemulticloudBetaAzureClientResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAzureClient handles the gRPC request by passing it to the underlying AzureClient Delete() method.
 func (s *AzureClientServer) DeleteGkemulticloudBetaAzureClient(ctx context.Context, request *betapb.DeleteGkemulticloudBetaAzureClientRequest) (*emptypb.Empty, error) {
 
@@ -94,20 +148,32 @@ func (s *AzureClientServer) ListGkemulticloudBetaAzureClient(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListAzureClient(ctx, ProtoToAzureClient(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.AzureClientMaxPage
+	}
+	resources, err := cl.ListAzureClientWithPageToken(ctx, ProtoToAzureClient(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.GkemulticloudBetaAzureClient
-	for _, r := range resources.Items {
-		rp := AzureClientToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AzureClientToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListGkemulticloudBetaAzureClientResponse{Items: protos}, nil
+	return &betapb.ListGkemulticloudBetaAzureClientResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAzureClient(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkemulticloud/beta/gkemulticloud_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkemulticloud/beta"
@@ -336,7 +337,11 @@ func AzureClusterToProto(resource *beta.AzureCluster) *betapb.GkemulticloudBetaA
 // ApplyAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Apply() method.
 func (s *AzureClusterServer) applyAzureCluster(ctx context.Context, c *beta.Client, request *betapb.ApplyGkemulticloudBetaAzureClusterRequest) (*betapb.GkemulticloudBetaAzureCluster, error) {
 	p := ProtoToAzureCluster(request.GetResource())
-	res, err := c.ApplyAzureCluster(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAzureCluster(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -353,6 +358,55 @@ func (s *AzureClusterServer) ApplyGkemulticloudBetaAzureCluster(ctx context.Cont
 	return s.applyAzureCluster(ctx, cl, request)
 }
 
+// GetGkemulticloudBetaAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Get() method.
+func (s *AzureClusterServer) GetGkemulticloudBetaAzureCluster(ctx context.Context, request *betapb.GetGkemulticloudBetaAzureClusterRequest) (*betapb.GkemulticloudBetaAzureCluster, error) {
+	cl, err := createConfigAzureCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAzureCluster(ctx, ProtoToAzureCluster(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AzureClusterToProto(res), nil
+}
+
+// HasDiffGkemulticloudBetaAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AzureClusterServer) HasDiffGkemulticloudBetaAzureCluster(ctx context.Context, request *betapb.HasDiffGkemulticloudBetaAzureClusterRequest) (*betapb.HasDiffGkemulticloudBetaAzureClusterResponse, error) {
+	cl, err := createConfigAzureCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureCluster(ctx, ProtoToAzureCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffGkemulticloudBetaAzureClusterResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanGkemulticloudBetaAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AzureClusterServer) PlanGkemulticloudBetaAzureCluster(ctx context.Context, request *betapb.PlanGkemulticloudBetaAzureClusterRequest) (*betapb.PlanGkemulticloudBetaAzureClusterResponse, error) {
+	cl, err := createConfigAzureCluster(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureCluster(ctx, ProtoToAzureCluster(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanGkemulticloudBetaAzureClusterResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAzureCluster handles the gRPC request by passing it to the underlying AzureCluster Delete() method.
 func (s *AzureClusterServer) DeleteGkemulticloudBetaAzureCluster(ctx context.Context, request *betapb.DeleteGkemulticloudBetaAzureClusterRequest) (*emptypb.Empty, error) {
 
@@ -371,20 +425,32 @@ func (s *AzureClusterServer) ListGkemulticloudBetaAzureCluster(ctx context.Conte
 		return nil, err
 	}
 
-	resources, err := cl.ListAzureCluster(ctx, ProtoToAzureCluster(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.AzureClusterMaxPage
+	}
+	resources, err := cl.ListAzureClusterWithPageToken(ctx, ProtoToAzureCluster(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.GkemulticloudBetaAzureCluster
-	for _, r := range resources.Items {
-		rp := AzureClusterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AzureClusterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListGkemulticloudBetaAzureClusterResponse{Items: protos}, nil
+	return &betapb.ListGkemulticloudBetaAzureClusterResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAzureCluster(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
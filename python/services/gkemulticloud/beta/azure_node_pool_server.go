@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/gkemulticloud/beta/gkemulticloud_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/gkemulticloud/beta"
@@ -218,7 +219,11 @@ func AzureNodePoolToProto(resource *beta.AzureNodePool) *betapb.GkemulticloudBet
 // ApplyAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Apply() method.
 func (s *AzureNodePoolServer) applyAzureNodePool(ctx context.Context, c *beta.Client, request *betapb.ApplyGkemulticloudBetaAzureNodePoolRequest) (*betapb.GkemulticloudBetaAzureNodePool, error) {
 	p := ProtoToAzureNodePool(request.GetResource())
-	res, err := c.ApplyAzureNodePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAzureNodePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +240,55 @@ func (s *AzureNodePoolServer) ApplyGkemulticloudBetaAzureNodePool(ctx context.Co
 	return s.applyAzureNodePool(ctx, cl, request)
 }
 
+// GetGkemulticloudBetaAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Get() method.
+func (s *AzureNodePoolServer) GetGkemulticloudBetaAzureNodePool(ctx context.Context, request *betapb.GetGkemulticloudBetaAzureNodePoolRequest) (*betapb.GkemulticloudBetaAzureNodePool, error) {
+	cl, err := createConfigAzureNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAzureNodePool(ctx, ProtoToAzureNodePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AzureNodePoolToProto(res), nil
+}
+
+// HasDiffGkemulticloudBetaAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AzureNodePoolServer) HasDiffGkemulticloudBetaAzureNodePool(ctx context.Context, request *betapb.HasDiffGkemulticloudBetaAzureNodePoolRequest) (*betapb.HasDiffGkemulticloudBetaAzureNodePoolResponse, error) {
+	cl, err := createConfigAzureNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureNodePool(ctx, ProtoToAzureNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffGkemulticloudBetaAzureNodePoolResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanGkemulticloudBetaAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AzureNodePoolServer) PlanGkemulticloudBetaAzureNodePool(ctx context.Context, request *betapb.PlanGkemulticloudBetaAzureNodePoolRequest) (*betapb.PlanGkemulticloudBetaAzureNodePoolResponse, error) {
+	cl, err := createConfigAzureNodePool(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAzureNodePool(ctx, ProtoToAzureNodePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanGkemulticloudBetaAzureNodePoolResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAzureNodePool handles the gRPC request by passing it to the underlying AzureNodePool Delete() method.
 func (s *AzureNodePoolServer) DeleteGkemulticloudBetaAzureNodePool(ctx context.Context, request *betapb.DeleteGkemulticloudBetaAzureNodePoolRequest) (*emptypb.Empty, error) {
 
@@ -253,20 +307,32 @@ func (s *AzureNodePoolServer) ListGkemulticloudBetaAzureNodePool(ctx context.Con
 		return nil, err
 	}
 
-	resources, err := cl.ListAzureNodePool(ctx, ProtoToAzureNodePool(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.AzureNodePoolMaxPage
+	}
+	resources, err := cl.ListAzureNodePoolWithPageToken(ctx, ProtoToAzureNodePool(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.GkemulticloudBetaAzureNodePool
-	for _, r := range resources.Items {
-		rp := AzureNodePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AzureNodePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListGkemulticloudBetaAzureNodePoolResponse{Items: protos}, nil
+	return &betapb.ListGkemulticloudBetaAzureNodePoolResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAzureNodePool(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/healthcare/alpha/healthcare_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/healthcare/alpha"
@@ -49,7 +50,11 @@ func DatasetToProto(resource *alpha.Dataset) *alphapb.HealthcareAlphaDataset {
 // applyDataset handles the gRPC request by passing it to the underlying Dataset Apply() method.
 func (s *DatasetServer) applyDataset(ctx context.Context, c *alpha.Client, request *alphapb.ApplyHealthcareAlphaDatasetRequest) (*alphapb.HealthcareAlphaDataset, error) {
 	p := ProtoToDataset(request.GetResource())
-	res, err := c.ApplyDataset(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDataset(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +71,60 @@ func (s *DatasetServer) ApplyHealthcareAlphaDataset(ctx context.Context, request
 	return s.applyDataset(ctx, cl, request)
 }
 
+// GetHealthcareAlphaDataset handles the gRPC request by passing it to the underlying Dataset Get() method.
+func (s *DatasetServer) GetHealthcareAlphaDataset(ctx context.Context, request *alphapb.GetHealthcareAlphaDatasetRequest) (*alphapb.HealthcareAlphaDataset, error) {
+	cl, err := createConfigDataset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDataset(ctx, ProtoToDataset(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DatasetToProto(res), nil
+}
+
+// HasDiffHealthcareAlphaDataset handles the gRPC request by passing it to the underlying Dataset Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DatasetServer) HasDiffHealthcareAlphaDataset(ctx context.Context, request *alphapb.HasDiffHealthcareAlphaDatasetRequest) (*alphapb.HasDiffHealthcareAlphaDatasetResponse, error) {
+	cl, err := createConfigDataset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDataset(ctx, ProtoToDataset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffHealthcareAlphaDatasetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanHealthcareAlphaDataset handles the gRPC request by passing it to the underlying Dataset Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DatasetServer) PlanHealthcareAlphaDataset(ctx context.Context, request *alphapb.PlanHealthcareAlphaDatasetRequest) (*alphapb.PlanHealthcareAlphaDatasetResponse, error) {
+	cl, err := createConfigDataset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDataset(ctx, ProtoToDataset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanHealthcareAlphaDatasetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDataset handles the gRPC request by passing it to the underlying Dataset Delete() method.
 func (s *DatasetServer) DeleteHealthcareAlphaDataset(ctx context.Context, request *alphapb.DeleteHealthcareAlphaDatasetRequest) (*emptypb.Empty, error) {
 
@@ -84,22 +143,35 @@ func (s *DatasetServer) ListHealthcareAlphaDataset(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListDataset(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.DatasetMaxPage
+	}
+	resources, err := cl.ListDatasetWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.HealthcareAlphaDataset
-	for _, r := range resources.Items {
-		rp := DatasetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DatasetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListHealthcareAlphaDatasetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDataset(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/healthcare/alpha/healthcare_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/healthcare/alpha"
@@ -280,7 +281,11 @@ func FhirStoreToProto(resource *alpha.FhirStore) *alphapb.HealthcareAlphaFhirSto
 // applyFhirStore handles the gRPC request by passing it to the underlying FhirStore Apply() method.
 func (s *FhirStoreServer) applyFhirStore(ctx context.Context, c *alpha.Client, request *alphapb.ApplyHealthcareAlphaFhirStoreRequest) (*alphapb.HealthcareAlphaFhirStore, error) {
 	p := ProtoToFhirStore(request.GetResource())
-	res, err := c.ApplyFhirStore(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFhirStore(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -297,6 +302,60 @@ func (s *FhirStoreServer) ApplyHealthcareAlphaFhirStore(ctx context.Context, req
 	return s.applyFhirStore(ctx, cl, request)
 }
 
+// GetHealthcareAlphaFhirStore handles the gRPC request by passing it to the underlying FhirStore Get() method.
+func (s *FhirStoreServer) GetHealthcareAlphaFhirStore(ctx context.Context, request *alphapb.GetHealthcareAlphaFhirStoreRequest) (*alphapb.HealthcareAlphaFhirStore, error) {
+	cl, err := createConfigFhirStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFhirStore(ctx, ProtoToFhirStore(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FhirStoreToProto(res), nil
+}
+
+// HasDiffHealthcareAlphaFhirStore handles the gRPC request by passing it to the underlying FhirStore Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FhirStoreServer) HasDiffHealthcareAlphaFhirStore(ctx context.Context, request *alphapb.HasDiffHealthcareAlphaFhirStoreRequest) (*alphapb.HasDiffHealthcareAlphaFhirStoreResponse, error) {
+	cl, err := createConfigFhirStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFhirStore(ctx, ProtoToFhirStore(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffHealthcareAlphaFhirStoreResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanHealthcareAlphaFhirStore handles the gRPC request by passing it to the underlying FhirStore Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FhirStoreServer) PlanHealthcareAlphaFhirStore(ctx context.Context, request *alphapb.PlanHealthcareAlphaFhirStoreRequest) (*alphapb.PlanHealthcareAlphaFhirStoreResponse, error) {
+	cl, err := createConfigFhirStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFhirStore(ctx, ProtoToFhirStore(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanHealthcareAlphaFhirStoreResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFhirStore handles the gRPC request by passing it to the underlying FhirStore Delete() method.
 func (s *FhirStoreServer) DeleteHealthcareAlphaFhirStore(ctx context.Context, request *alphapb.DeleteHealthcareAlphaFhirStoreRequest) (*emptypb.Empty, error) {
 
@@ -315,22 +374,35 @@ func (s *FhirStoreServer) ListHealthcareAlphaFhirStore(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListFhirStore(ctx, request.GetProject(), request.GetLocation(), request.GetDataset())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.FhirStoreMaxPage
+	}
+	resources, err := cl.ListFhirStoreWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetDataset(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.HealthcareAlphaFhirStore
-	for _, r := range resources.Items {
-		rp := FhirStoreToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FhirStoreToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListHealthcareAlphaFhirStoreResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFhirStore(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
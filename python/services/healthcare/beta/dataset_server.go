@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/healthcare/beta/healthcare_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/healthcare/beta"
@@ -49,7 +50,11 @@ func DatasetToProto(resource *beta.Dataset) *betapb.HealthcareBetaDataset {
 // applyDataset handles the gRPC request by passing it to the underlying Dataset Apply() method.
 func (s *DatasetServer) applyDataset(ctx context.Context, c *beta.Client, request *betapb.ApplyHealthcareBetaDatasetRequest) (*betapb.HealthcareBetaDataset, error) {
 	p := ProtoToDataset(request.GetResource())
-	res, err := c.ApplyDataset(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDataset(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +71,60 @@ func (s *DatasetServer) ApplyHealthcareBetaDataset(ctx context.Context, request
 	return s.applyDataset(ctx, cl, request)
 }
 
+// GetHealthcareBetaDataset handles the gRPC request by passing it to the underlying Dataset Get() method.
+func (s *DatasetServer) GetHealthcareBetaDataset(ctx context.Context, request *betapb.GetHealthcareBetaDatasetRequest) (*betapb.HealthcareBetaDataset, error) {
+	cl, err := createConfigDataset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDataset(ctx, ProtoToDataset(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DatasetToProto(res), nil
+}
+
+// HasDiffHealthcareBetaDataset handles the gRPC request by passing it to the underlying Dataset Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DatasetServer) HasDiffHealthcareBetaDataset(ctx context.Context, request *betapb.HasDiffHealthcareBetaDatasetRequest) (*betapb.HasDiffHealthcareBetaDatasetResponse, error) {
+	cl, err := createConfigDataset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDataset(ctx, ProtoToDataset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffHealthcareBetaDatasetResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanHealthcareBetaDataset handles the gRPC request by passing it to the underlying Dataset Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DatasetServer) PlanHealthcareBetaDataset(ctx context.Context, request *betapb.PlanHealthcareBetaDatasetRequest) (*betapb.PlanHealthcareBetaDatasetResponse, error) {
+	cl, err := createConfigDataset(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDataset(ctx, ProtoToDataset(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanHealthcareBetaDatasetResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDataset handles the gRPC request by passing it to the underlying Dataset Delete() method.
 func (s *DatasetServer) DeleteHealthcareBetaDataset(ctx context.Context, request *betapb.DeleteHealthcareBetaDatasetRequest) (*emptypb.Empty, error) {
 
@@ -84,22 +143,35 @@ func (s *DatasetServer) ListHealthcareBetaDataset(ctx context.Context, request *
 		return nil, err
 	}
 
-	resources, err := cl.ListDataset(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.DatasetMaxPage
+	}
+	resources, err := cl.ListDatasetWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.HealthcareBetaDataset
-	for _, r := range resources.Items {
-		rp := DatasetToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DatasetToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListHealthcareBetaDatasetResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDataset(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
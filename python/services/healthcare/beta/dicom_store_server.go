@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/healthcare/beta/healthcare_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/healthcare/beta"
@@ -77,7 +78,11 @@ func DicomStoreToProto(resource *beta.DicomStore) *betapb.HealthcareBetaDicomSto
 // applyDicomStore handles the gRPC request by passing it to the underlying DicomStore Apply() method.
 func (s *DicomStoreServer) applyDicomStore(ctx context.Context, c *beta.Client, request *betapb.ApplyHealthcareBetaDicomStoreRequest) (*betapb.HealthcareBetaDicomStore, error) {
 	p := ProtoToDicomStore(request.GetResource())
-	res, err := c.ApplyDicomStore(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDicomStore(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +99,60 @@ func (s *DicomStoreServer) ApplyHealthcareBetaDicomStore(ctx context.Context, re
 	return s.applyDicomStore(ctx, cl, request)
 }
 
+// GetHealthcareBetaDicomStore handles the gRPC request by passing it to the underlying DicomStore Get() method.
+func (s *DicomStoreServer) GetHealthcareBetaDicomStore(ctx context.Context, request *betapb.GetHealthcareBetaDicomStoreRequest) (*betapb.HealthcareBetaDicomStore, error) {
+	cl, err := createConfigDicomStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDicomStore(ctx, ProtoToDicomStore(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DicomStoreToProto(res), nil
+}
+
+// HasDiffHealthcareBetaDicomStore handles the gRPC request by passing it to the underlying DicomStore Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DicomStoreServer) HasDiffHealthcareBetaDicomStore(ctx context.Context, request *betapb.HasDiffHealthcareBetaDicomStoreRequest) (*betapb.HasDiffHealthcareBetaDicomStoreResponse, error) {
+	cl, err := createConfigDicomStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDicomStore(ctx, ProtoToDicomStore(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffHealthcareBetaDicomStoreResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanHealthcareBetaDicomStore handles the gRPC request by passing it to the underlying DicomStore Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DicomStoreServer) PlanHealthcareBetaDicomStore(ctx context.Context, request *betapb.PlanHealthcareBetaDicomStoreRequest) (*betapb.PlanHealthcareBetaDicomStoreResponse, error) {
+	cl, err := createConfigDicomStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDicomStore(ctx, ProtoToDicomStore(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanHealthcareBetaDicomStoreResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDicomStore handles the gRPC request by passing it to the underlying DicomStore Delete() method.
 func (s *DicomStoreServer) DeleteHealthcareBetaDicomStore(ctx context.Context, request *betapb.DeleteHealthcareBetaDicomStoreRequest) (*emptypb.Empty, error) {
 
@@ -112,22 +171,35 @@ func (s *DicomStoreServer) ListHealthcareBetaDicomStore(ctx context.Context, req
 		return nil, err
 	}
 
-	resources, err := cl.ListDicomStore(ctx, request.GetProject(), request.GetLocation(), request.GetDataset())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.DicomStoreMaxPage
+	}
+	resources, err := cl.ListDicomStoreWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetDataset(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.HealthcareBetaDicomStore
-	for _, r := range resources.Items {
-		rp := DicomStoreToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DicomStoreToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListHealthcareBetaDicomStoreResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDicomStore(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
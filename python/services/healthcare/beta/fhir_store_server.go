@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/healthcare/beta/healthcare_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/healthcare/beta"
@@ -280,7 +281,11 @@ func FhirStoreToProto(resource *beta.FhirStore) *betapb.HealthcareBetaFhirStore
 // applyFhirStore handles the gRPC request by passing it to the underlying FhirStore Apply() method.
 func (s *FhirStoreServer) applyFhirStore(ctx context.Context, c *beta.Client, request *betapb.ApplyHealthcareBetaFhirStoreRequest) (*betapb.HealthcareBetaFhirStore, error) {
 	p := ProtoToFhirStore(request.GetResource())
-	res, err := c.ApplyFhirStore(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyFhirStore(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -297,6 +302,60 @@ func (s *FhirStoreServer) ApplyHealthcareBetaFhirStore(ctx context.Context, requ
 	return s.applyFhirStore(ctx, cl, request)
 }
 
+// GetHealthcareBetaFhirStore handles the gRPC request by passing it to the underlying FhirStore Get() method.
+func (s *FhirStoreServer) GetHealthcareBetaFhirStore(ctx context.Context, request *betapb.GetHealthcareBetaFhirStoreRequest) (*betapb.HealthcareBetaFhirStore, error) {
+	cl, err := createConfigFhirStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetFhirStore(ctx, ProtoToFhirStore(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return FhirStoreToProto(res), nil
+}
+
+// HasDiffHealthcareBetaFhirStore handles the gRPC request by passing it to the underlying FhirStore Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *FhirStoreServer) HasDiffHealthcareBetaFhirStore(ctx context.Context, request *betapb.HasDiffHealthcareBetaFhirStoreRequest) (*betapb.HasDiffHealthcareBetaFhirStoreResponse, error) {
+	cl, err := createConfigFhirStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFhirStore(ctx, ProtoToFhirStore(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffHealthcareBetaFhirStoreResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanHealthcareBetaFhirStore handles the gRPC request by passing it to the underlying FhirStore Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *FhirStoreServer) PlanHealthcareBetaFhirStore(ctx context.Context, request *betapb.PlanHealthcareBetaFhirStoreRequest) (*betapb.PlanHealthcareBetaFhirStoreResponse, error) {
+	cl, err := createConfigFhirStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffFhirStore(ctx, ProtoToFhirStore(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanHealthcareBetaFhirStoreResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteFhirStore handles the gRPC request by passing it to the underlying FhirStore Delete() method.
 func (s *FhirStoreServer) DeleteHealthcareBetaFhirStore(ctx context.Context, request *betapb.DeleteHealthcareBetaFhirStoreRequest) (*emptypb.Empty, error) {
 
@@ -315,22 +374,35 @@ func (s *FhirStoreServer) ListHealthcareBetaFhirStore(ctx context.Context, reque
 		return nil, err
 	}
 
-	resources, err := cl.ListFhirStore(ctx, request.GetProject(), request.GetLocation(), request.GetDataset())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.FhirStoreMaxPage
+	}
+	resources, err := cl.ListFhirStoreWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetDataset(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.HealthcareBetaFhirStore
-	for _, r := range resources.Items {
-		rp := FhirStoreToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, FhirStoreToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListHealthcareBetaFhirStoreResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigFhirStore(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	healthcarepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/healthcare/healthcare_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/healthcare"
@@ -77,7 +78,11 @@ func DicomStoreToProto(resource *healthcare.DicomStore) *healthcarepb.Healthcare
 // applyDicomStore handles the gRPC request by passing it to the underlying DicomStore Apply() method.
 func (s *DicomStoreServer) applyDicomStore(ctx context.Context, c *healthcare.Client, request *healthcarepb.ApplyHealthcareDicomStoreRequest) (*healthcarepb.HealthcareDicomStore, error) {
 	p := ProtoToDicomStore(request.GetResource())
-	res, err := c.ApplyDicomStore(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDicomStore(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +99,60 @@ func (s *DicomStoreServer) ApplyHealthcareDicomStore(ctx context.Context, reques
 	return s.applyDicomStore(ctx, cl, request)
 }
 
+// GetHealthcareDicomStore handles the gRPC request by passing it to the underlying DicomStore Get() method.
+func (s *DicomStoreServer) GetHealthcareDicomStore(ctx context.Context, request *healthcarepb.GetHealthcareDicomStoreRequest) (*healthcarepb.HealthcareDicomStore, error) {
+	cl, err := createConfigDicomStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDicomStore(ctx, ProtoToDicomStore(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DicomStoreToProto(res), nil
+}
+
+// HasDiffHealthcareDicomStore handles the gRPC request by passing it to the underlying DicomStore Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DicomStoreServer) HasDiffHealthcareDicomStore(ctx context.Context, request *healthcarepb.HasDiffHealthcareDicomStoreRequest) (*healthcarepb.HasDiffHealthcareDicomStoreResponse, error) {
+	cl, err := createConfigDicomStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDicomStore(ctx, ProtoToDicomStore(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &healthcarepb.HasDiffHealthcareDicomStoreResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanHealthcareDicomStore handles the gRPC request by passing it to the underlying DicomStore Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DicomStoreServer) PlanHealthcareDicomStore(ctx context.Context, request *healthcarepb.PlanHealthcareDicomStoreRequest) (*healthcarepb.PlanHealthcareDicomStoreResponse, error) {
+	cl, err := createConfigDicomStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDicomStore(ctx, ProtoToDicomStore(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &healthcarepb.PlanHealthcareDicomStoreResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDicomStore handles the gRPC request by passing it to the underlying DicomStore Delete() method.
 func (s *DicomStoreServer) DeleteHealthcareDicomStore(ctx context.Context, request *healthcarepb.DeleteHealthcareDicomStoreRequest) (*emptypb.Empty, error) {
 
@@ -112,22 +171,35 @@ func (s *DicomStoreServer) ListHealthcareDicomStore(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListDicomStore(ctx, request.GetProject(), request.GetLocation(), request.GetDataset())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = healthcare.DicomStoreMaxPage
+	}
+	resources, err := cl.ListDicomStoreWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetDataset(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*healthcarepb.HealthcareDicomStore
-	for _, r := range resources.Items {
-		rp := DicomStoreToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DicomStoreToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &healthcarepb.ListHealthcareDicomStoreResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDicomStore(ctx context.Context, service_account_file string) (*healthcare.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return healthcare.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iam/alpha/iam_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iam/alpha"
@@ -125,7 +126,11 @@ func RoleToProto(resource *alpha.Role) *alphapb.IamAlphaRole {
 // applyRole handles the gRPC request by passing it to the underlying Role Apply() method.
 func (s *RoleServer) applyRole(ctx context.Context, c *alpha.Client, request *alphapb.ApplyIamAlphaRoleRequest) (*alphapb.IamAlphaRole, error) {
 	p := ProtoToRole(request.GetResource())
-	res, err := c.ApplyRole(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRole(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -142,6 +147,60 @@ func (s *RoleServer) ApplyIamAlphaRole(ctx context.Context, request *alphapb.App
 	return s.applyRole(ctx, cl, request)
 }
 
+// GetIamAlphaRole handles the gRPC request by passing it to the underlying Role Get() method.
+func (s *RoleServer) GetIamAlphaRole(ctx context.Context, request *alphapb.GetIamAlphaRoleRequest) (*alphapb.IamAlphaRole, error) {
+	cl, err := createConfigRole(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRole(ctx, ProtoToRole(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RoleToProto(res), nil
+}
+
+// HasDiffIamAlphaRole handles the gRPC request by passing it to the underlying Role Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RoleServer) HasDiffIamAlphaRole(ctx context.Context, request *alphapb.HasDiffIamAlphaRoleRequest) (*alphapb.HasDiffIamAlphaRoleResponse, error) {
+	cl, err := createConfigRole(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRole(ctx, ProtoToRole(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffIamAlphaRoleResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIamAlphaRole handles the gRPC request by passing it to the underlying Role Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RoleServer) PlanIamAlphaRole(ctx context.Context, request *alphapb.PlanIamAlphaRoleRequest) (*alphapb.PlanIamAlphaRoleResponse, error) {
+	cl, err := createConfigRole(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRole(ctx, ProtoToRole(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanIamAlphaRoleResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRole handles the gRPC request by passing it to the underlying Role Delete() method.
 func (s *RoleServer) DeleteIamAlphaRole(ctx context.Context, request *alphapb.DeleteIamAlphaRoleRequest) (*emptypb.Empty, error) {
 
@@ -160,22 +219,35 @@ func (s *RoleServer) ListIamAlphaRole(ctx context.Context, request *alphapb.List
 		return nil, err
 	}
 
-	resources, err := cl.ListRole(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.RoleMaxPage
+	}
+	resources, err := cl.ListRoleWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.IamAlphaRole
-	for _, r := range resources.Items {
-		rp := RoleToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RoleToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListIamAlphaRoleResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRole(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
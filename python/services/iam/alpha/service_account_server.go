@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iam/alpha/iam_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iam/alpha"
@@ -106,7 +107,11 @@ func ServiceAccountToProto(resource *alpha.ServiceAccount) *alphapb.IamAlphaServ
 // applyServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Apply() method.
 func (s *ServiceAccountServer) applyServiceAccount(ctx context.Context, c *alpha.Client, request *alphapb.ApplyIamAlphaServiceAccountRequest) (*alphapb.IamAlphaServiceAccount, error) {
 	p := ProtoToServiceAccount(request.GetResource())
-	res, err := c.ApplyServiceAccount(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyServiceAccount(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +128,60 @@ func (s *ServiceAccountServer) ApplyIamAlphaServiceAccount(ctx context.Context,
 	return s.applyServiceAccount(ctx, cl, request)
 }
 
+// GetIamAlphaServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Get() method.
+func (s *ServiceAccountServer) GetIamAlphaServiceAccount(ctx context.Context, request *alphapb.GetIamAlphaServiceAccountRequest) (*alphapb.IamAlphaServiceAccount, error) {
+	cl, err := createConfigServiceAccount(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetServiceAccount(ctx, ProtoToServiceAccount(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceAccountToProto(res), nil
+}
+
+// HasDiffIamAlphaServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceAccountServer) HasDiffIamAlphaServiceAccount(ctx context.Context, request *alphapb.HasDiffIamAlphaServiceAccountRequest) (*alphapb.HasDiffIamAlphaServiceAccountResponse, error) {
+	cl, err := createConfigServiceAccount(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceAccount(ctx, ProtoToServiceAccount(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffIamAlphaServiceAccountResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIamAlphaServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceAccountServer) PlanIamAlphaServiceAccount(ctx context.Context, request *alphapb.PlanIamAlphaServiceAccountRequest) (*alphapb.PlanIamAlphaServiceAccountResponse, error) {
+	cl, err := createConfigServiceAccount(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceAccount(ctx, ProtoToServiceAccount(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanIamAlphaServiceAccountResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Delete() method.
 func (s *ServiceAccountServer) DeleteIamAlphaServiceAccount(ctx context.Context, request *alphapb.DeleteIamAlphaServiceAccountRequest) (*emptypb.Empty, error) {
 
@@ -141,22 +200,35 @@ func (s *ServiceAccountServer) ListIamAlphaServiceAccount(ctx context.Context, r
 		return nil, err
 	}
 
-	resources, err := cl.ListServiceAccount(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ServiceAccountMaxPage
+	}
+	resources, err := cl.ListServiceAccountWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.IamAlphaServiceAccount
-	for _, r := range resources.Items {
-		rp := ServiceAccountToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceAccountToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListIamAlphaServiceAccountResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigServiceAccount(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
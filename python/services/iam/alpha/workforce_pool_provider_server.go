@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iam/alpha/iam_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iam/alpha"
@@ -133,7 +134,11 @@ func WorkforcePoolProviderToProto(resource *alpha.WorkforcePoolProvider) *alphap
 // applyWorkforcePoolProvider handles the gRPC request by passing it to the underlying WorkforcePoolProvider Apply() method.
 func (s *WorkforcePoolProviderServer) applyWorkforcePoolProvider(ctx context.Context, c *alpha.Client, request *alphapb.ApplyIamAlphaWorkforcePoolProviderRequest) (*alphapb.IamAlphaWorkforcePoolProvider, error) {
 	p := ProtoToWorkforcePoolProvider(request.GetResource())
-	res, err := c.ApplyWorkforcePoolProvider(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkforcePoolProvider(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -150,6 +155,60 @@ func (s *WorkforcePoolProviderServer) ApplyIamAlphaWorkforcePoolProvider(ctx con
 	return s.applyWorkforcePoolProvider(ctx, cl, request)
 }
 
+// GetIamAlphaWorkforcePoolProvider handles the gRPC request by passing it to the underlying WorkforcePoolProvider Get() method.
+func (s *WorkforcePoolProviderServer) GetIamAlphaWorkforcePoolProvider(ctx context.Context, request *alphapb.GetIamAlphaWorkforcePoolProviderRequest) (*alphapb.IamAlphaWorkforcePoolProvider, error) {
+	cl, err := createConfigWorkforcePoolProvider(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkforcePoolProvider(ctx, ProtoToWorkforcePoolProvider(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkforcePoolProviderToProto(res), nil
+}
+
+// HasDiffIamAlphaWorkforcePoolProvider handles the gRPC request by passing it to the underlying WorkforcePoolProvider Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkforcePoolProviderServer) HasDiffIamAlphaWorkforcePoolProvider(ctx context.Context, request *alphapb.HasDiffIamAlphaWorkforcePoolProviderRequest) (*alphapb.HasDiffIamAlphaWorkforcePoolProviderResponse, error) {
+	cl, err := createConfigWorkforcePoolProvider(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkforcePoolProvider(ctx, ProtoToWorkforcePoolProvider(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffIamAlphaWorkforcePoolProviderResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIamAlphaWorkforcePoolProvider handles the gRPC request by passing it to the underlying WorkforcePoolProvider Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkforcePoolProviderServer) PlanIamAlphaWorkforcePoolProvider(ctx context.Context, request *alphapb.PlanIamAlphaWorkforcePoolProviderRequest) (*alphapb.PlanIamAlphaWorkforcePoolProviderResponse, error) {
+	cl, err := createConfigWorkforcePoolProvider(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkforcePoolProvider(ctx, ProtoToWorkforcePoolProvider(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanIamAlphaWorkforcePoolProviderResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkforcePoolProvider handles the gRPC request by passing it to the underlying WorkforcePoolProvider Delete() method.
 func (s *WorkforcePoolProviderServer) DeleteIamAlphaWorkforcePoolProvider(ctx context.Context, request *alphapb.DeleteIamAlphaWorkforcePoolProviderRequest) (*emptypb.Empty, error) {
 
@@ -168,22 +227,35 @@ func (s *WorkforcePoolProviderServer) ListIamAlphaWorkforcePoolProvider(ctx cont
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkforcePoolProvider(ctx, request.GetLocation(), request.GetWorkforcePool())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.WorkforcePoolProviderMaxPage
+	}
+	resources, err := cl.ListWorkforcePoolProviderWithPageToken(ctx, request.GetLocation(), request.GetWorkforcePool(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.IamAlphaWorkforcePoolProvider
-	for _, r := range resources.Items {
-		rp := WorkforcePoolProviderToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkforcePoolProviderToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListIamAlphaWorkforcePoolProviderResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkforcePoolProvider(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
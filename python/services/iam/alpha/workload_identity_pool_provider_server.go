@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iam/alpha/iam_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iam/alpha"
@@ -149,7 +150,11 @@ func WorkloadIdentityPoolProviderToProto(resource *alpha.WorkloadIdentityPoolPro
 // applyWorkloadIdentityPoolProvider handles the gRPC request by passing it to the underlying WorkloadIdentityPoolProvider Apply() method.
 func (s *WorkloadIdentityPoolProviderServer) applyWorkloadIdentityPoolProvider(ctx context.Context, c *alpha.Client, request *alphapb.ApplyIamAlphaWorkloadIdentityPoolProviderRequest) (*alphapb.IamAlphaWorkloadIdentityPoolProvider, error) {
 	p := ProtoToWorkloadIdentityPoolProvider(request.GetResource())
-	res, err := c.ApplyWorkloadIdentityPoolProvider(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkloadIdentityPoolProvider(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +171,60 @@ func (s *WorkloadIdentityPoolProviderServer) ApplyIamAlphaWorkloadIdentityPoolPr
 	return s.applyWorkloadIdentityPoolProvider(ctx, cl, request)
 }
 
+// GetIamAlphaWorkloadIdentityPoolProvider handles the gRPC request by passing it to the underlying WorkloadIdentityPoolProvider Get() method.
+func (s *WorkloadIdentityPoolProviderServer) GetIamAlphaWorkloadIdentityPoolProvider(ctx context.Context, request *alphapb.GetIamAlphaWorkloadIdentityPoolProviderRequest) (*alphapb.IamAlphaWorkloadIdentityPoolProvider, error) {
+	cl, err := createConfigWorkloadIdentityPoolProvider(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkloadIdentityPoolProvider(ctx, ProtoToWorkloadIdentityPoolProvider(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkloadIdentityPoolProviderToProto(res), nil
+}
+
+// HasDiffIamAlphaWorkloadIdentityPoolProvider handles the gRPC request by passing it to the underlying WorkloadIdentityPoolProvider Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkloadIdentityPoolProviderServer) HasDiffIamAlphaWorkloadIdentityPoolProvider(ctx context.Context, request *alphapb.HasDiffIamAlphaWorkloadIdentityPoolProviderRequest) (*alphapb.HasDiffIamAlphaWorkloadIdentityPoolProviderResponse, error) {
+	cl, err := createConfigWorkloadIdentityPoolProvider(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkloadIdentityPoolProvider(ctx, ProtoToWorkloadIdentityPoolProvider(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffIamAlphaWorkloadIdentityPoolProviderResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIamAlphaWorkloadIdentityPoolProvider handles the gRPC request by passing it to the underlying WorkloadIdentityPoolProvider Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkloadIdentityPoolProviderServer) PlanIamAlphaWorkloadIdentityPoolProvider(ctx context.Context, request *alphapb.PlanIamAlphaWorkloadIdentityPoolProviderRequest) (*alphapb.PlanIamAlphaWorkloadIdentityPoolProviderResponse, error) {
+	cl, err := createConfigWorkloadIdentityPoolProvider(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkloadIdentityPoolProvider(ctx, ProtoToWorkloadIdentityPoolProvider(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanIamAlphaWorkloadIdentityPoolProviderResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkloadIdentityPoolProvider handles the gRPC request by passing it to the underlying WorkloadIdentityPoolProvider Delete() method.
 func (s *WorkloadIdentityPoolProviderServer) DeleteIamAlphaWorkloadIdentityPoolProvider(ctx context.Context, request *alphapb.DeleteIamAlphaWorkloadIdentityPoolProviderRequest) (*emptypb.Empty, error) {
 
@@ -184,22 +243,35 @@ func (s *WorkloadIdentityPoolProviderServer) ListIamAlphaWorkloadIdentityPoolPro
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkloadIdentityPoolProvider(ctx, request.GetProject(), request.GetLocation(), request.GetWorkloadIdentityPool())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.WorkloadIdentityPoolProviderMaxPage
+	}
+	resources, err := cl.ListWorkloadIdentityPoolProviderWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetWorkloadIdentityPool(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.IamAlphaWorkloadIdentityPoolProvider
-	for _, r := range resources.Items {
-		rp := WorkloadIdentityPoolProviderToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkloadIdentityPoolProviderToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListIamAlphaWorkloadIdentityPoolProviderResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkloadIdentityPoolProvider(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
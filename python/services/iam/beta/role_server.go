@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iam/beta/iam_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iam/beta"
@@ -125,7 +126,11 @@ func RoleToProto(resource *beta.Role) *betapb.IamBetaRole {
 // applyRole handles the gRPC request by passing it to the underlying Role Apply() method.
 func (s *RoleServer) applyRole(ctx context.Context, c *beta.Client, request *betapb.ApplyIamBetaRoleRequest) (*betapb.IamBetaRole, error) {
 	p := ProtoToRole(request.GetResource())
-	res, err := c.ApplyRole(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRole(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -142,6 +147,60 @@ func (s *RoleServer) ApplyIamBetaRole(ctx context.Context, request *betapb.Apply
 	return s.applyRole(ctx, cl, request)
 }
 
+// GetIamBetaRole handles the gRPC request by passing it to the underlying Role Get() method.
+func (s *RoleServer) GetIamBetaRole(ctx context.Context, request *betapb.GetIamBetaRoleRequest) (*betapb.IamBetaRole, error) {
+	cl, err := createConfigRole(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRole(ctx, ProtoToRole(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RoleToProto(res), nil
+}
+
+// HasDiffIamBetaRole handles the gRPC request by passing it to the underlying Role Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RoleServer) HasDiffIamBetaRole(ctx context.Context, request *betapb.HasDiffIamBetaRoleRequest) (*betapb.HasDiffIamBetaRoleResponse, error) {
+	cl, err := createConfigRole(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRole(ctx, ProtoToRole(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffIamBetaRoleResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIamBetaRole handles the gRPC request by passing it to the underlying Role Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RoleServer) PlanIamBetaRole(ctx context.Context, request *betapb.PlanIamBetaRoleRequest) (*betapb.PlanIamBetaRoleResponse, error) {
+	cl, err := createConfigRole(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRole(ctx, ProtoToRole(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanIamBetaRoleResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRole handles the gRPC request by passing it to the underlying Role Delete() method.
 func (s *RoleServer) DeleteIamBetaRole(ctx context.Context, request *betapb.DeleteIamBetaRoleRequest) (*emptypb.Empty, error) {
 
@@ -160,22 +219,35 @@ func (s *RoleServer) ListIamBetaRole(ctx context.Context, request *betapb.ListIa
 		return nil, err
 	}
 
-	resources, err := cl.ListRole(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.RoleMaxPage
+	}
+	resources, err := cl.ListRoleWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.IamBetaRole
-	for _, r := range resources.Items {
-		rp := RoleToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RoleToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListIamBetaRoleResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRole(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
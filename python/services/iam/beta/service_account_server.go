@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iam/beta/iam_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iam/beta"
@@ -106,7 +107,11 @@ func ServiceAccountToProto(resource *beta.ServiceAccount) *betapb.IamBetaService
 // applyServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Apply() method.
 func (s *ServiceAccountServer) applyServiceAccount(ctx context.Context, c *beta.Client, request *betapb.ApplyIamBetaServiceAccountRequest) (*betapb.IamBetaServiceAccount, error) {
 	p := ProtoToServiceAccount(request.GetResource())
-	res, err := c.ApplyServiceAccount(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyServiceAccount(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +128,60 @@ func (s *ServiceAccountServer) ApplyIamBetaServiceAccount(ctx context.Context, r
 	return s.applyServiceAccount(ctx, cl, request)
 }
 
+// GetIamBetaServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Get() method.
+func (s *ServiceAccountServer) GetIamBetaServiceAccount(ctx context.Context, request *betapb.GetIamBetaServiceAccountRequest) (*betapb.IamBetaServiceAccount, error) {
+	cl, err := createConfigServiceAccount(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetServiceAccount(ctx, ProtoToServiceAccount(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceAccountToProto(res), nil
+}
+
+// HasDiffIamBetaServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceAccountServer) HasDiffIamBetaServiceAccount(ctx context.Context, request *betapb.HasDiffIamBetaServiceAccountRequest) (*betapb.HasDiffIamBetaServiceAccountResponse, error) {
+	cl, err := createConfigServiceAccount(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceAccount(ctx, ProtoToServiceAccount(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffIamBetaServiceAccountResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIamBetaServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceAccountServer) PlanIamBetaServiceAccount(ctx context.Context, request *betapb.PlanIamBetaServiceAccountRequest) (*betapb.PlanIamBetaServiceAccountResponse, error) {
+	cl, err := createConfigServiceAccount(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceAccount(ctx, ProtoToServiceAccount(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanIamBetaServiceAccountResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Delete() method.
 func (s *ServiceAccountServer) DeleteIamBetaServiceAccount(ctx context.Context, request *betapb.DeleteIamBetaServiceAccountRequest) (*emptypb.Empty, error) {
 
@@ -141,22 +200,35 @@ func (s *ServiceAccountServer) ListIamBetaServiceAccount(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListServiceAccount(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ServiceAccountMaxPage
+	}
+	resources, err := cl.ListServiceAccountWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.IamBetaServiceAccount
-	for _, r := range resources.Items {
-		rp := ServiceAccountToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceAccountToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListIamBetaServiceAccountResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigServiceAccount(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
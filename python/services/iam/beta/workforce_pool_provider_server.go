@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iam/beta/iam_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iam/beta"
@@ -133,7 +134,11 @@ func WorkforcePoolProviderToProto(resource *beta.WorkforcePoolProvider) *betapb.
 // applyWorkforcePoolProvider handles the gRPC request by passing it to the underlying WorkforcePoolProvider Apply() method.
 func (s *WorkforcePoolProviderServer) applyWorkforcePoolProvider(ctx context.Context, c *beta.Client, request *betapb.ApplyIamBetaWorkforcePoolProviderRequest) (*betapb.IamBetaWorkforcePoolProvider, error) {
 	p := ProtoToWorkforcePoolProvider(request.GetResource())
-	res, err := c.ApplyWorkforcePoolProvider(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkforcePoolProvider(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -150,6 +155,60 @@ func (s *WorkforcePoolProviderServer) ApplyIamBetaWorkforcePoolProvider(ctx cont
 	return s.applyWorkforcePoolProvider(ctx, cl, request)
 }
 
+// GetIamBetaWorkforcePoolProvider handles the gRPC request by passing it to the underlying WorkforcePoolProvider Get() method.
+func (s *WorkforcePoolProviderServer) GetIamBetaWorkforcePoolProvider(ctx context.Context, request *betapb.GetIamBetaWorkforcePoolProviderRequest) (*betapb.IamBetaWorkforcePoolProvider, error) {
+	cl, err := createConfigWorkforcePoolProvider(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkforcePoolProvider(ctx, ProtoToWorkforcePoolProvider(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkforcePoolProviderToProto(res), nil
+}
+
+// HasDiffIamBetaWorkforcePoolProvider handles the gRPC request by passing it to the underlying WorkforcePoolProvider Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkforcePoolProviderServer) HasDiffIamBetaWorkforcePoolProvider(ctx context.Context, request *betapb.HasDiffIamBetaWorkforcePoolProviderRequest) (*betapb.HasDiffIamBetaWorkforcePoolProviderResponse, error) {
+	cl, err := createConfigWorkforcePoolProvider(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkforcePoolProvider(ctx, ProtoToWorkforcePoolProvider(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffIamBetaWorkforcePoolProviderResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIamBetaWorkforcePoolProvider handles the gRPC request by passing it to the underlying WorkforcePoolProvider Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkforcePoolProviderServer) PlanIamBetaWorkforcePoolProvider(ctx context.Context, request *betapb.PlanIamBetaWorkforcePoolProviderRequest) (*betapb.PlanIamBetaWorkforcePoolProviderResponse, error) {
+	cl, err := createConfigWorkforcePoolProvider(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkforcePoolProvider(ctx, ProtoToWorkforcePoolProvider(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanIamBetaWorkforcePoolProviderResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkforcePoolProvider handles the gRPC request by passing it to the underlying WorkforcePoolProvider Delete() method.
 func (s *WorkforcePoolProviderServer) DeleteIamBetaWorkforcePoolProvider(ctx context.Context, request *betapb.DeleteIamBetaWorkforcePoolProviderRequest) (*emptypb.Empty, error) {
 
@@ -168,22 +227,35 @@ func (s *WorkforcePoolProviderServer) ListIamBetaWorkforcePoolProvider(ctx conte
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkforcePoolProvider(ctx, request.GetLocation(), request.GetWorkforcePool())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.WorkforcePoolProviderMaxPage
+	}
+	resources, err := cl.ListWorkforcePoolProviderWithPageToken(ctx, request.GetLocation(), request.GetWorkforcePool(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.IamBetaWorkforcePoolProvider
-	for _, r := range resources.Items {
-		rp := WorkforcePoolProviderToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkforcePoolProviderToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListIamBetaWorkforcePoolProviderResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkforcePoolProvider(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iam/beta/iam_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iam/beta"
@@ -82,7 +83,11 @@ func WorkforcePoolToProto(resource *beta.WorkforcePool) *betapb.IamBetaWorkforce
 // applyWorkforcePool handles the gRPC request by passing it to the underlying WorkforcePool Apply() method.
 func (s *WorkforcePoolServer) applyWorkforcePool(ctx context.Context, c *beta.Client, request *betapb.ApplyIamBetaWorkforcePoolRequest) (*betapb.IamBetaWorkforcePool, error) {
 	p := ProtoToWorkforcePool(request.GetResource())
-	res, err := c.ApplyWorkforcePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkforcePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +104,60 @@ func (s *WorkforcePoolServer) ApplyIamBetaWorkforcePool(ctx context.Context, req
 	return s.applyWorkforcePool(ctx, cl, request)
 }
 
+// GetIamBetaWorkforcePool handles the gRPC request by passing it to the underlying WorkforcePool Get() method.
+func (s *WorkforcePoolServer) GetIamBetaWorkforcePool(ctx context.Context, request *betapb.GetIamBetaWorkforcePoolRequest) (*betapb.IamBetaWorkforcePool, error) {
+	cl, err := createConfigWorkforcePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkforcePool(ctx, ProtoToWorkforcePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkforcePoolToProto(res), nil
+}
+
+// HasDiffIamBetaWorkforcePool handles the gRPC request by passing it to the underlying WorkforcePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkforcePoolServer) HasDiffIamBetaWorkforcePool(ctx context.Context, request *betapb.HasDiffIamBetaWorkforcePoolRequest) (*betapb.HasDiffIamBetaWorkforcePoolResponse, error) {
+	cl, err := createConfigWorkforcePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkforcePool(ctx, ProtoToWorkforcePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffIamBetaWorkforcePoolResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIamBetaWorkforcePool handles the gRPC request by passing it to the underlying WorkforcePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkforcePoolServer) PlanIamBetaWorkforcePool(ctx context.Context, request *betapb.PlanIamBetaWorkforcePoolRequest) (*betapb.PlanIamBetaWorkforcePoolResponse, error) {
+	cl, err := createConfigWorkforcePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkforcePool(ctx, ProtoToWorkforcePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanIamBetaWorkforcePoolResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkforcePool handles the gRPC request by passing it to the underlying WorkforcePool Delete() method.
 func (s *WorkforcePoolServer) DeleteIamBetaWorkforcePool(ctx context.Context, request *betapb.DeleteIamBetaWorkforcePoolRequest) (*emptypb.Empty, error) {
 
@@ -117,22 +176,35 @@ func (s *WorkforcePoolServer) ListIamBetaWorkforcePool(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkforcePool(ctx, request.GetLocation(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.WorkforcePoolMaxPage
+	}
+	resources, err := cl.ListWorkforcePoolWithPageToken(ctx, request.GetLocation(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.IamBetaWorkforcePool
-	for _, r := range resources.Items {
-		rp := WorkforcePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkforcePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListIamBetaWorkforcePoolResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkforcePool(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
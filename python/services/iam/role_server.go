@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	iampb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iam/iam_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iam"
@@ -125,7 +126,11 @@ func RoleToProto(resource *iam.Role) *iampb.IamRole {
 // applyRole handles the gRPC request by passing it to the underlying Role Apply() method.
 func (s *RoleServer) applyRole(ctx context.Context, c *iam.Client, request *iampb.ApplyIamRoleRequest) (*iampb.IamRole, error) {
 	p := ProtoToRole(request.GetResource())
-	res, err := c.ApplyRole(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRole(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -142,6 +147,60 @@ func (s *RoleServer) ApplyIamRole(ctx context.Context, request *iampb.ApplyIamRo
 	return s.applyRole(ctx, cl, request)
 }
 
+// GetIamRole handles the gRPC request by passing it to the underlying Role Get() method.
+func (s *RoleServer) GetIamRole(ctx context.Context, request *iampb.GetIamRoleRequest) (*iampb.IamRole, error) {
+	cl, err := createConfigRole(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRole(ctx, ProtoToRole(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RoleToProto(res), nil
+}
+
+// HasDiffIamRole handles the gRPC request by passing it to the underlying Role Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RoleServer) HasDiffIamRole(ctx context.Context, request *iampb.HasDiffIamRoleRequest) (*iampb.HasDiffIamRoleResponse, error) {
+	cl, err := createConfigRole(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRole(ctx, ProtoToRole(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &iampb.HasDiffIamRoleResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIamRole handles the gRPC request by passing it to the underlying Role Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RoleServer) PlanIamRole(ctx context.Context, request *iampb.PlanIamRoleRequest) (*iampb.PlanIamRoleResponse, error) {
+	cl, err := createConfigRole(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRole(ctx, ProtoToRole(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &iampb.PlanIamRoleResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteRole handles the gRPC request by passing it to the underlying Role Delete() method.
 func (s *RoleServer) DeleteIamRole(ctx context.Context, request *iampb.DeleteIamRoleRequest) (*emptypb.Empty, error) {
 
@@ -160,22 +219,35 @@ func (s *RoleServer) ListIamRole(ctx context.Context, request *iampb.ListIamRole
 		return nil, err
 	}
 
-	resources, err := cl.ListRole(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = iam.RoleMaxPage
+	}
+	resources, err := cl.ListRoleWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*iampb.IamRole
-	for _, r := range resources.Items {
-		rp := RoleToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RoleToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &iampb.ListIamRoleResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigRole(ctx context.Context, service_account_file string) (*iam.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return iam.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	iampb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iam/iam_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iam"
@@ -106,7 +107,11 @@ func ServiceAccountToProto(resource *iam.ServiceAccount) *iampb.IamServiceAccoun
 // applyServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Apply() method.
 func (s *ServiceAccountServer) applyServiceAccount(ctx context.Context, c *iam.Client, request *iampb.ApplyIamServiceAccountRequest) (*iampb.IamServiceAccount, error) {
 	p := ProtoToServiceAccount(request.GetResource())
-	res, err := c.ApplyServiceAccount(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyServiceAccount(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +128,60 @@ func (s *ServiceAccountServer) ApplyIamServiceAccount(ctx context.Context, reque
 	return s.applyServiceAccount(ctx, cl, request)
 }
 
+// GetIamServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Get() method.
+func (s *ServiceAccountServer) GetIamServiceAccount(ctx context.Context, request *iampb.GetIamServiceAccountRequest) (*iampb.IamServiceAccount, error) {
+	cl, err := createConfigServiceAccount(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetServiceAccount(ctx, ProtoToServiceAccount(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceAccountToProto(res), nil
+}
+
+// HasDiffIamServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceAccountServer) HasDiffIamServiceAccount(ctx context.Context, request *iampb.HasDiffIamServiceAccountRequest) (*iampb.HasDiffIamServiceAccountResponse, error) {
+	cl, err := createConfigServiceAccount(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceAccount(ctx, ProtoToServiceAccount(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &iampb.HasDiffIamServiceAccountResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIamServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceAccountServer) PlanIamServiceAccount(ctx context.Context, request *iampb.PlanIamServiceAccountRequest) (*iampb.PlanIamServiceAccountResponse, error) {
+	cl, err := createConfigServiceAccount(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceAccount(ctx, ProtoToServiceAccount(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &iampb.PlanIamServiceAccountResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteServiceAccount handles the gRPC request by passing it to the underlying ServiceAccount Delete() method.
 func (s *ServiceAccountServer) DeleteIamServiceAccount(ctx context.Context, request *iampb.DeleteIamServiceAccountRequest) (*emptypb.Empty, error) {
 
@@ -141,22 +200,35 @@ func (s *ServiceAccountServer) ListIamServiceAccount(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListServiceAccount(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = iam.ServiceAccountMaxPage
+	}
+	resources, err := cl.ListServiceAccountWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*iampb.IamServiceAccount
-	for _, r := range resources.Items {
-		rp := ServiceAccountToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceAccountToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &iampb.ListIamServiceAccountResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigServiceAccount(ctx context.Context, service_account_file string) (*iam.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return iam.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	iampb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iam/iam_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iam"
@@ -82,7 +83,11 @@ func WorkforcePoolToProto(resource *iam.WorkforcePool) *iampb.IamWorkforcePool {
 // applyWorkforcePool handles the gRPC request by passing it to the underlying WorkforcePool Apply() method.
 func (s *WorkforcePoolServer) applyWorkforcePool(ctx context.Context, c *iam.Client, request *iampb.ApplyIamWorkforcePoolRequest) (*iampb.IamWorkforcePool, error) {
 	p := ProtoToWorkforcePool(request.GetResource())
-	res, err := c.ApplyWorkforcePool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkforcePool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +104,60 @@ func (s *WorkforcePoolServer) ApplyIamWorkforcePool(ctx context.Context, request
 	return s.applyWorkforcePool(ctx, cl, request)
 }
 
+// GetIamWorkforcePool handles the gRPC request by passing it to the underlying WorkforcePool Get() method.
+func (s *WorkforcePoolServer) GetIamWorkforcePool(ctx context.Context, request *iampb.GetIamWorkforcePoolRequest) (*iampb.IamWorkforcePool, error) {
+	cl, err := createConfigWorkforcePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkforcePool(ctx, ProtoToWorkforcePool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkforcePoolToProto(res), nil
+}
+
+// HasDiffIamWorkforcePool handles the gRPC request by passing it to the underlying WorkforcePool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkforcePoolServer) HasDiffIamWorkforcePool(ctx context.Context, request *iampb.HasDiffIamWorkforcePoolRequest) (*iampb.HasDiffIamWorkforcePoolResponse, error) {
+	cl, err := createConfigWorkforcePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkforcePool(ctx, ProtoToWorkforcePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &iampb.HasDiffIamWorkforcePoolResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIamWorkforcePool handles the gRPC request by passing it to the underlying WorkforcePool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkforcePoolServer) PlanIamWorkforcePool(ctx context.Context, request *iampb.PlanIamWorkforcePoolRequest) (*iampb.PlanIamWorkforcePoolResponse, error) {
+	cl, err := createConfigWorkforcePool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkforcePool(ctx, ProtoToWorkforcePool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &iampb.PlanIamWorkforcePoolResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkforcePool handles the gRPC request by passing it to the underlying WorkforcePool Delete() method.
 func (s *WorkforcePoolServer) DeleteIamWorkforcePool(ctx context.Context, request *iampb.DeleteIamWorkforcePoolRequest) (*emptypb.Empty, error) {
 
@@ -117,22 +176,35 @@ func (s *WorkforcePoolServer) ListIamWorkforcePool(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkforcePool(ctx, request.GetLocation(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = iam.WorkforcePoolMaxPage
+	}
+	resources, err := cl.ListWorkforcePoolWithPageToken(ctx, request.GetLocation(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*iampb.IamWorkforcePool
-	for _, r := range resources.Items {
-		rp := WorkforcePoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkforcePoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &iampb.ListIamWorkforcePoolResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkforcePool(ctx context.Context, service_account_file string) (*iam.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return iam.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	iampb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iam/iam_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iam"
@@ -78,7 +79,11 @@ func WorkloadIdentityPoolToProto(resource *iam.WorkloadIdentityPool) *iampb.IamW
 // applyWorkloadIdentityPool handles the gRPC request by passing it to the underlying WorkloadIdentityPool Apply() method.
 func (s *WorkloadIdentityPoolServer) applyWorkloadIdentityPool(ctx context.Context, c *iam.Client, request *iampb.ApplyIamWorkloadIdentityPoolRequest) (*iampb.IamWorkloadIdentityPool, error) {
 	p := ProtoToWorkloadIdentityPool(request.GetResource())
-	res, err := c.ApplyWorkloadIdentityPool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyWorkloadIdentityPool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +100,60 @@ func (s *WorkloadIdentityPoolServer) ApplyIamWorkloadIdentityPool(ctx context.Co
 	return s.applyWorkloadIdentityPool(ctx, cl, request)
 }
 
+// GetIamWorkloadIdentityPool handles the gRPC request by passing it to the underlying WorkloadIdentityPool Get() method.
+func (s *WorkloadIdentityPoolServer) GetIamWorkloadIdentityPool(ctx context.Context, request *iampb.GetIamWorkloadIdentityPoolRequest) (*iampb.IamWorkloadIdentityPool, error) {
+	cl, err := createConfigWorkloadIdentityPool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetWorkloadIdentityPool(ctx, ProtoToWorkloadIdentityPool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return WorkloadIdentityPoolToProto(res), nil
+}
+
+// HasDiffIamWorkloadIdentityPool handles the gRPC request by passing it to the underlying WorkloadIdentityPool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *WorkloadIdentityPoolServer) HasDiffIamWorkloadIdentityPool(ctx context.Context, request *iampb.HasDiffIamWorkloadIdentityPoolRequest) (*iampb.HasDiffIamWorkloadIdentityPoolResponse, error) {
+	cl, err := createConfigWorkloadIdentityPool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkloadIdentityPool(ctx, ProtoToWorkloadIdentityPool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &iampb.HasDiffIamWorkloadIdentityPoolResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIamWorkloadIdentityPool handles the gRPC request by passing it to the underlying WorkloadIdentityPool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *WorkloadIdentityPoolServer) PlanIamWorkloadIdentityPool(ctx context.Context, request *iampb.PlanIamWorkloadIdentityPoolRequest) (*iampb.PlanIamWorkloadIdentityPoolResponse, error) {
+	cl, err := createConfigWorkloadIdentityPool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffWorkloadIdentityPool(ctx, ProtoToWorkloadIdentityPool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &iampb.PlanIamWorkloadIdentityPoolResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteWorkloadIdentityPool handles the gRPC request by passing it to the underlying WorkloadIdentityPool Delete() method.
 func (s *WorkloadIdentityPoolServer) DeleteIamWorkloadIdentityPool(ctx context.Context, request *iampb.DeleteIamWorkloadIdentityPoolRequest) (*emptypb.Empty, error) {
 
@@ -113,22 +172,35 @@ func (s *WorkloadIdentityPoolServer) ListIamWorkloadIdentityPool(ctx context.Con
 		return nil, err
 	}
 
-	resources, err := cl.ListWorkloadIdentityPool(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = iam.WorkloadIdentityPoolMaxPage
+	}
+	resources, err := cl.ListWorkloadIdentityPoolWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*iampb.IamWorkloadIdentityPool
-	for _, r := range resources.Items {
-		rp := WorkloadIdentityPoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, WorkloadIdentityPoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &iampb.ListIamWorkloadIdentityPoolResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigWorkloadIdentityPool(ctx context.Context, service_account_file string) (*iam.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return iam.NewClient(conf), nil
 }
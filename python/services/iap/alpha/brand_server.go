@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iap/alpha/iap_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iap/alpha"
@@ -52,7 +53,11 @@ func BrandToProto(resource *alpha.Brand) *alphapb.IapAlphaBrand {
 // applyBrand handles the gRPC request by passing it to the underlying Brand Apply() method.
 func (s *BrandServer) applyBrand(ctx context.Context, c *alpha.Client, request *alphapb.ApplyIapAlphaBrandRequest) (*alphapb.IapAlphaBrand, error) {
 	p := ProtoToBrand(request.GetResource())
-	res, err := c.ApplyBrand(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBrand(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +74,60 @@ func (s *BrandServer) ApplyIapAlphaBrand(ctx context.Context, request *alphapb.A
 	return s.applyBrand(ctx, cl, request)
 }
 
+// GetIapAlphaBrand handles the gRPC request by passing it to the underlying Brand Get() method.
+func (s *BrandServer) GetIapAlphaBrand(ctx context.Context, request *alphapb.GetIapAlphaBrandRequest) (*alphapb.IapAlphaBrand, error) {
+	cl, err := createConfigBrand(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBrand(ctx, ProtoToBrand(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BrandToProto(res), nil
+}
+
+// HasDiffIapAlphaBrand handles the gRPC request by passing it to the underlying Brand Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BrandServer) HasDiffIapAlphaBrand(ctx context.Context, request *alphapb.HasDiffIapAlphaBrandRequest) (*alphapb.HasDiffIapAlphaBrandResponse, error) {
+	cl, err := createConfigBrand(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBrand(ctx, ProtoToBrand(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffIapAlphaBrandResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIapAlphaBrand handles the gRPC request by passing it to the underlying Brand Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BrandServer) PlanIapAlphaBrand(ctx context.Context, request *alphapb.PlanIapAlphaBrandRequest) (*alphapb.PlanIapAlphaBrandResponse, error) {
+	cl, err := createConfigBrand(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBrand(ctx, ProtoToBrand(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanIapAlphaBrandResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteBrand handles the gRPC request by passing it to the underlying Brand Delete() method.
 func (s *BrandServer) DeleteIapAlphaBrand(ctx context.Context, request *alphapb.DeleteIapAlphaBrandRequest) (*emptypb.Empty, error) {
 
@@ -83,22 +142,35 @@ func (s *BrandServer) ListIapAlphaBrand(ctx context.Context, request *alphapb.Li
 		return nil, err
 	}
 
-	resources, err := cl.ListBrand(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.BrandMaxPage
+	}
+	resources, err := cl.ListBrandWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.IapAlphaBrand
-	for _, r := range resources.Items {
-		rp := BrandToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BrandToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListIapAlphaBrandResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigBrand(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iap/alpha/iap_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iap/alpha"
@@ -51,7 +52,11 @@ func IdentityAwareProxyClientToProto(resource *alpha.IdentityAwareProxyClient) *
 // applyIdentityAwareProxyClient handles the gRPC request by passing it to the underlying IdentityAwareProxyClient Apply() method.
 func (s *IdentityAwareProxyClientServer) applyIdentityAwareProxyClient(ctx context.Context, c *alpha.Client, request *alphapb.ApplyIapAlphaIdentityAwareProxyClientRequest) (*alphapb.IapAlphaIdentityAwareProxyClient, error) {
 	p := ProtoToIdentityAwareProxyClient(request.GetResource())
-	res, err := c.ApplyIdentityAwareProxyClient(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyIdentityAwareProxyClient(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +73,60 @@ func (s *IdentityAwareProxyClientServer) ApplyIapAlphaIdentityAwareProxyClient(c
 	return s.applyIdentityAwareProxyClient(ctx, cl, request)
 }
 
+// GetIapAlphaIdentityAwareProxyClient handles the gRPC request by passing it to the underlying IdentityAwareProxyClient Get() method.
+func (s *IdentityAwareProxyClientServer) GetIapAlphaIdentityAwareProxyClient(ctx context.Context, request *alphapb.GetIapAlphaIdentityAwareProxyClientRequest) (*alphapb.IapAlphaIdentityAwareProxyClient, error) {
+	cl, err := createConfigIdentityAwareProxyClient(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetIdentityAwareProxyClient(ctx, ProtoToIdentityAwareProxyClient(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return IdentityAwareProxyClientToProto(res), nil
+}
+
+// HasDiffIapAlphaIdentityAwareProxyClient handles the gRPC request by passing it to the underlying IdentityAwareProxyClient Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *IdentityAwareProxyClientServer) HasDiffIapAlphaIdentityAwareProxyClient(ctx context.Context, request *alphapb.HasDiffIapAlphaIdentityAwareProxyClientRequest) (*alphapb.HasDiffIapAlphaIdentityAwareProxyClientResponse, error) {
+	cl, err := createConfigIdentityAwareProxyClient(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffIdentityAwareProxyClient(ctx, ProtoToIdentityAwareProxyClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffIapAlphaIdentityAwareProxyClientResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIapAlphaIdentityAwareProxyClient handles the gRPC request by passing it to the underlying IdentityAwareProxyClient Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *IdentityAwareProxyClientServer) PlanIapAlphaIdentityAwareProxyClient(ctx context.Context, request *alphapb.PlanIapAlphaIdentityAwareProxyClientRequest) (*alphapb.PlanIapAlphaIdentityAwareProxyClientResponse, error) {
+	cl, err := createConfigIdentityAwareProxyClient(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffIdentityAwareProxyClient(ctx, ProtoToIdentityAwareProxyClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanIapAlphaIdentityAwareProxyClientResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteIdentityAwareProxyClient handles the gRPC request by passing it to the underlying IdentityAwareProxyClient Delete() method.
 func (s *IdentityAwareProxyClientServer) DeleteIapAlphaIdentityAwareProxyClient(ctx context.Context, request *alphapb.DeleteIapAlphaIdentityAwareProxyClientRequest) (*emptypb.Empty, error) {
 
@@ -86,22 +145,35 @@ func (s *IdentityAwareProxyClientServer) ListIapAlphaIdentityAwareProxyClient(ct
 		return nil, err
 	}
 
-	resources, err := cl.ListIdentityAwareProxyClient(ctx, request.GetProject(), request.GetBrand())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.IdentityAwareProxyClientMaxPage
+	}
+	resources, err := cl.ListIdentityAwareProxyClientWithPageToken(ctx, request.GetProject(), request.GetBrand(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.IapAlphaIdentityAwareProxyClient
-	for _, r := range resources.Items {
-		rp := IdentityAwareProxyClientToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, IdentityAwareProxyClientToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListIapAlphaIdentityAwareProxyClientResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigIdentityAwareProxyClient(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
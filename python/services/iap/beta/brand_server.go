@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iap/beta/iap_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iap/beta"
@@ -52,7 +53,11 @@ func BrandToProto(resource *beta.Brand) *betapb.IapBetaBrand {
 // applyBrand handles the gRPC request by passing it to the underlying Brand Apply() method.
 func (s *BrandServer) applyBrand(ctx context.Context, c *beta.Client, request *betapb.ApplyIapBetaBrandRequest) (*betapb.IapBetaBrand, error) {
 	p := ProtoToBrand(request.GetResource())
-	res, err := c.ApplyBrand(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBrand(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +74,60 @@ func (s *BrandServer) ApplyIapBetaBrand(ctx context.Context, request *betapb.App
 	return s.applyBrand(ctx, cl, request)
 }
 
+// GetIapBetaBrand handles the gRPC request by passing it to the underlying Brand Get() method.
+func (s *BrandServer) GetIapBetaBrand(ctx context.Context, request *betapb.GetIapBetaBrandRequest) (*betapb.IapBetaBrand, error) {
+	cl, err := createConfigBrand(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBrand(ctx, ProtoToBrand(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BrandToProto(res), nil
+}
+
+// HasDiffIapBetaBrand handles the gRPC request by passing it to the underlying Brand Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BrandServer) HasDiffIapBetaBrand(ctx context.Context, request *betapb.HasDiffIapBetaBrandRequest) (*betapb.HasDiffIapBetaBrandResponse, error) {
+	cl, err := createConfigBrand(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBrand(ctx, ProtoToBrand(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffIapBetaBrandResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIapBetaBrand handles the gRPC request by passing it to the underlying Brand Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BrandServer) PlanIapBetaBrand(ctx context.Context, request *betapb.PlanIapBetaBrandRequest) (*betapb.PlanIapBetaBrandResponse, error) {
+	cl, err := createConfigBrand(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBrand(ctx, ProtoToBrand(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanIapBetaBrandResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteBrand handles the gRPC request by passing it to the underlying Brand Delete() method.
 func (s *BrandServer) DeleteIapBetaBrand(ctx context.Context, request *betapb.DeleteIapBetaBrandRequest) (*emptypb.Empty, error) {
 
@@ -83,22 +142,35 @@ func (s *BrandServer) ListIapBetaBrand(ctx context.Context, request *betapb.List
 		return nil, err
 	}
 
-	resources, err := cl.ListBrand(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.BrandMaxPage
+	}
+	resources, err := cl.ListBrandWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.IapBetaBrand
-	for _, r := range resources.Items {
-		rp := BrandToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BrandToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListIapBetaBrandResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigBrand(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
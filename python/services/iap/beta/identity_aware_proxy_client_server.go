@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iap/beta/iap_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iap/beta"
@@ -51,7 +52,11 @@ func IdentityAwareProxyClientToProto(resource *beta.IdentityAwareProxyClient) *b
 // applyIdentityAwareProxyClient handles the gRPC request by passing it to the underlying IdentityAwareProxyClient Apply() method.
 func (s *IdentityAwareProxyClientServer) applyIdentityAwareProxyClient(ctx context.Context, c *beta.Client, request *betapb.ApplyIapBetaIdentityAwareProxyClientRequest) (*betapb.IapBetaIdentityAwareProxyClient, error) {
 	p := ProtoToIdentityAwareProxyClient(request.GetResource())
-	res, err := c.ApplyIdentityAwareProxyClient(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyIdentityAwareProxyClient(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +73,60 @@ func (s *IdentityAwareProxyClientServer) ApplyIapBetaIdentityAwareProxyClient(ct
 	return s.applyIdentityAwareProxyClient(ctx, cl, request)
 }
 
+// GetIapBetaIdentityAwareProxyClient handles the gRPC request by passing it to the underlying IdentityAwareProxyClient Get() method.
+func (s *IdentityAwareProxyClientServer) GetIapBetaIdentityAwareProxyClient(ctx context.Context, request *betapb.GetIapBetaIdentityAwareProxyClientRequest) (*betapb.IapBetaIdentityAwareProxyClient, error) {
+	cl, err := createConfigIdentityAwareProxyClient(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetIdentityAwareProxyClient(ctx, ProtoToIdentityAwareProxyClient(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return IdentityAwareProxyClientToProto(res), nil
+}
+
+// HasDiffIapBetaIdentityAwareProxyClient handles the gRPC request by passing it to the underlying IdentityAwareProxyClient Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *IdentityAwareProxyClientServer) HasDiffIapBetaIdentityAwareProxyClient(ctx context.Context, request *betapb.HasDiffIapBetaIdentityAwareProxyClientRequest) (*betapb.HasDiffIapBetaIdentityAwareProxyClientResponse, error) {
+	cl, err := createConfigIdentityAwareProxyClient(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffIdentityAwareProxyClient(ctx, ProtoToIdentityAwareProxyClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffIapBetaIdentityAwareProxyClientResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIapBetaIdentityAwareProxyClient handles the gRPC request by passing it to the underlying IdentityAwareProxyClient Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *IdentityAwareProxyClientServer) PlanIapBetaIdentityAwareProxyClient(ctx context.Context, request *betapb.PlanIapBetaIdentityAwareProxyClientRequest) (*betapb.PlanIapBetaIdentityAwareProxyClientResponse, error) {
+	cl, err := createConfigIdentityAwareProxyClient(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffIdentityAwareProxyClient(ctx, ProtoToIdentityAwareProxyClient(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanIapBetaIdentityAwareProxyClientResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteIdentityAwareProxyClient handles the gRPC request by passing it to the underlying IdentityAwareProxyClient Delete() method.
 func (s *IdentityAwareProxyClientServer) DeleteIapBetaIdentityAwareProxyClient(ctx context.Context, request *betapb.DeleteIapBetaIdentityAwareProxyClientRequest) (*emptypb.Empty, error) {
 
@@ -86,22 +145,35 @@ func (s *IdentityAwareProxyClientServer) ListIapBetaIdentityAwareProxyClient(ctx
 		return nil, err
 	}
 
-	resources, err := cl.ListIdentityAwareProxyClient(ctx, request.GetProject(), request.GetBrand())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.IdentityAwareProxyClientMaxPage
+	}
+	resources, err := cl.ListIdentityAwareProxyClientWithPageToken(ctx, request.GetProject(), request.GetBrand(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.IapBetaIdentityAwareProxyClient
-	for _, r := range resources.Items {
-		rp := IdentityAwareProxyClientToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, IdentityAwareProxyClientToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListIapBetaIdentityAwareProxyClientResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigIdentityAwareProxyClient(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
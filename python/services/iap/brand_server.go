@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	iappb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/iap/iap_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/iap"
@@ -52,7 +53,11 @@ func BrandToProto(resource *iap.Brand) *iappb.IapBrand {
 // applyBrand handles the gRPC request by passing it to the underlying Brand Apply() method.
 func (s *BrandServer) applyBrand(ctx context.Context, c *iap.Client, request *iappb.ApplyIapBrandRequest) (*iappb.IapBrand, error) {
 	p := ProtoToBrand(request.GetResource())
-	res, err := c.ApplyBrand(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBrand(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +74,60 @@ func (s *BrandServer) ApplyIapBrand(ctx context.Context, request *iappb.ApplyIap
 	return s.applyBrand(ctx, cl, request)
 }
 
+// GetIapBrand handles the gRPC request by passing it to the underlying Brand Get() method.
+func (s *BrandServer) GetIapBrand(ctx context.Context, request *iappb.GetIapBrandRequest) (*iappb.IapBrand, error) {
+	cl, err := createConfigBrand(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBrand(ctx, ProtoToBrand(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BrandToProto(res), nil
+}
+
+// HasDiffIapBrand handles the gRPC request by passing it to the underlying Brand Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BrandServer) HasDiffIapBrand(ctx context.Context, request *iappb.HasDiffIapBrandRequest) (*iappb.HasDiffIapBrandResponse, error) {
+	cl, err := createConfigBrand(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBrand(ctx, ProtoToBrand(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &iappb.HasDiffIapBrandResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIapBrand handles the gRPC request by passing it to the underlying Brand Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BrandServer) PlanIapBrand(ctx context.Context, request *iappb.PlanIapBrandRequest) (*iappb.PlanIapBrandResponse, error) {
+	cl, err := createConfigBrand(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBrand(ctx, ProtoToBrand(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &iappb.PlanIapBrandResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteBrand handles the gRPC request by passing it to the underlying Brand Delete() method.
 func (s *BrandServer) DeleteIapBrand(ctx context.Context, request *iappb.DeleteIapBrandRequest) (*emptypb.Empty, error) {
 
@@ -83,22 +142,35 @@ func (s *BrandServer) ListIapBrand(ctx context.Context, request *iappb.ListIapBr
 		return nil, err
 	}
 
-	resources, err := cl.ListBrand(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = iap.BrandMaxPage
+	}
+	resources, err := cl.ListBrandWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*iappb.IapBrand
-	for _, r := range resources.Items {
-		rp := BrandToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BrandToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &iappb.ListIapBrandResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigBrand(ctx context.Context, service_account_file string) (*iap.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return iap.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/identitytoolkit/alpha/identitytoolkit_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/identitytoolkit/alpha"
@@ -1004,7 +1005,11 @@ func ConfigToProto(resource *alpha.Config) *alphapb.IdentitytoolkitAlphaConfig {
 // applyConfig handles the gRPC request by passing it to the underlying Config Apply() method.
 func (s *ConfigServer) applyConfig(ctx context.Context, c *alpha.Client, request *alphapb.ApplyIdentitytoolkitAlphaConfigRequest) (*alphapb.IdentitytoolkitAlphaConfig, error) {
 	p := ProtoToConfig(request.GetResource())
-	res, err := c.ApplyConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1021,6 +1026,60 @@ func (s *ConfigServer) ApplyIdentitytoolkitAlphaConfig(ctx context.Context, requ
 	return s.applyConfig(ctx, cl, request)
 }
 
+// GetIdentitytoolkitAlphaConfig handles the gRPC request by passing it to the underlying Config Get() method.
+func (s *ConfigServer) GetIdentitytoolkitAlphaConfig(ctx context.Context, request *alphapb.GetIdentitytoolkitAlphaConfigRequest) (*alphapb.IdentitytoolkitAlphaConfig, error) {
+	cl, err := createConfigConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetConfig(ctx, ProtoToConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ConfigToProto(res), nil
+}
+
+// HasDiffIdentitytoolkitAlphaConfig handles the gRPC request by passing it to the underlying Config Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ConfigServer) HasDiffIdentitytoolkitAlphaConfig(ctx context.Context, request *alphapb.HasDiffIdentitytoolkitAlphaConfigRequest) (*alphapb.HasDiffIdentitytoolkitAlphaConfigResponse, error) {
+	cl, err := createConfigConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConfig(ctx, ProtoToConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffIdentitytoolkitAlphaConfigResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIdentitytoolkitAlphaConfig handles the gRPC request by passing it to the underlying Config Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ConfigServer) PlanIdentitytoolkitAlphaConfig(ctx context.Context, request *alphapb.PlanIdentitytoolkitAlphaConfigRequest) (*alphapb.PlanIdentitytoolkitAlphaConfigResponse, error) {
+	cl, err := createConfigConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConfig(ctx, ProtoToConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanIdentitytoolkitAlphaConfigResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteConfig handles the gRPC request by passing it to the underlying Config Delete() method.
 func (s *ConfigServer) DeleteIdentitytoolkitAlphaConfig(ctx context.Context, request *alphapb.DeleteIdentitytoolkitAlphaConfigRequest) (*emptypb.Empty, error) {
 
@@ -1034,7 +1093,9 @@ func (s *ConfigServer) ListIdentitytoolkitAlphaConfig(_ context.Context, _ *alph
 }
 
 func createConfigConfig(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
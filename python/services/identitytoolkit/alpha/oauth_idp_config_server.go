@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/identitytoolkit/alpha/identitytoolkit_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/identitytoolkit/alpha"
@@ -82,7 +83,11 @@ func OAuthIdpConfigToProto(resource *alpha.OAuthIdpConfig) *alphapb.Identitytool
 // applyOAuthIdpConfig handles the gRPC request by passing it to the underlying OAuthIdpConfig Apply() method.
 func (s *OAuthIdpConfigServer) applyOAuthIdpConfig(ctx context.Context, c *alpha.Client, request *alphapb.ApplyIdentitytoolkitAlphaOAuthIdpConfigRequest) (*alphapb.IdentitytoolkitAlphaOAuthIdpConfig, error) {
 	p := ProtoToOAuthIdpConfig(request.GetResource())
-	res, err := c.ApplyOAuthIdpConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyOAuthIdpConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +104,60 @@ func (s *OAuthIdpConfigServer) ApplyIdentitytoolkitAlphaOAuthIdpConfig(ctx conte
 	return s.applyOAuthIdpConfig(ctx, cl, request)
 }
 
+// GetIdentitytoolkitAlphaOAuthIdpConfig handles the gRPC request by passing it to the underlying OAuthIdpConfig Get() method.
+func (s *OAuthIdpConfigServer) GetIdentitytoolkitAlphaOAuthIdpConfig(ctx context.Context, request *alphapb.GetIdentitytoolkitAlphaOAuthIdpConfigRequest) (*alphapb.IdentitytoolkitAlphaOAuthIdpConfig, error) {
+	cl, err := createConfigOAuthIdpConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetOAuthIdpConfig(ctx, ProtoToOAuthIdpConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return OAuthIdpConfigToProto(res), nil
+}
+
+// HasDiffIdentitytoolkitAlphaOAuthIdpConfig handles the gRPC request by passing it to the underlying OAuthIdpConfig Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *OAuthIdpConfigServer) HasDiffIdentitytoolkitAlphaOAuthIdpConfig(ctx context.Context, request *alphapb.HasDiffIdentitytoolkitAlphaOAuthIdpConfigRequest) (*alphapb.HasDiffIdentitytoolkitAlphaOAuthIdpConfigResponse, error) {
+	cl, err := createConfigOAuthIdpConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffOAuthIdpConfig(ctx, ProtoToOAuthIdpConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffIdentitytoolkitAlphaOAuthIdpConfigResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIdentitytoolkitAlphaOAuthIdpConfig handles the gRPC request by passing it to the underlying OAuthIdpConfig Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *OAuthIdpConfigServer) PlanIdentitytoolkitAlphaOAuthIdpConfig(ctx context.Context, request *alphapb.PlanIdentitytoolkitAlphaOAuthIdpConfigRequest) (*alphapb.PlanIdentitytoolkitAlphaOAuthIdpConfigResponse, error) {
+	cl, err := createConfigOAuthIdpConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffOAuthIdpConfig(ctx, ProtoToOAuthIdpConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanIdentitytoolkitAlphaOAuthIdpConfigResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteOAuthIdpConfig handles the gRPC request by passing it to the underlying OAuthIdpConfig Delete() method.
 func (s *OAuthIdpConfigServer) DeleteIdentitytoolkitAlphaOAuthIdpConfig(ctx context.Context, request *alphapb.DeleteIdentitytoolkitAlphaOAuthIdpConfigRequest) (*emptypb.Empty, error) {
 
@@ -117,22 +176,35 @@ func (s *OAuthIdpConfigServer) ListIdentitytoolkitAlphaOAuthIdpConfig(ctx contex
 		return nil, err
 	}
 
-	resources, err := cl.ListOAuthIdpConfig(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.OAuthIdpConfigMaxPage
+	}
+	resources, err := cl.ListOAuthIdpConfigWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.IdentitytoolkitAlphaOAuthIdpConfig
-	for _, r := range resources.Items {
-		rp := OAuthIdpConfigToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, OAuthIdpConfigToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListIdentitytoolkitAlphaOAuthIdpConfigResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigOAuthIdpConfig(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/identitytoolkit/alpha/identitytoolkit_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/identitytoolkit/alpha"
@@ -84,7 +85,11 @@ func TenantOAuthIdpConfigToProto(resource *alpha.TenantOAuthIdpConfig) *alphapb.
 // applyTenantOAuthIdpConfig handles the gRPC request by passing it to the underlying TenantOAuthIdpConfig Apply() method.
 func (s *TenantOAuthIdpConfigServer) applyTenantOAuthIdpConfig(ctx context.Context, c *alpha.Client, request *alphapb.ApplyIdentitytoolkitAlphaTenantOAuthIdpConfigRequest) (*alphapb.IdentitytoolkitAlphaTenantOAuthIdpConfig, error) {
 	p := ProtoToTenantOAuthIdpConfig(request.GetResource())
-	res, err := c.ApplyTenantOAuthIdpConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTenantOAuthIdpConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +106,60 @@ func (s *TenantOAuthIdpConfigServer) ApplyIdentitytoolkitAlphaTenantOAuthIdpConf
 	return s.applyTenantOAuthIdpConfig(ctx, cl, request)
 }
 
+// GetIdentitytoolkitAlphaTenantOAuthIdpConfig handles the gRPC request by passing it to the underlying TenantOAuthIdpConfig Get() method.
+func (s *TenantOAuthIdpConfigServer) GetIdentitytoolkitAlphaTenantOAuthIdpConfig(ctx context.Context, request *alphapb.GetIdentitytoolkitAlphaTenantOAuthIdpConfigRequest) (*alphapb.IdentitytoolkitAlphaTenantOAuthIdpConfig, error) {
+	cl, err := createConfigTenantOAuthIdpConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTenantOAuthIdpConfig(ctx, ProtoToTenantOAuthIdpConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TenantOAuthIdpConfigToProto(res), nil
+}
+
+// HasDiffIdentitytoolkitAlphaTenantOAuthIdpConfig handles the gRPC request by passing it to the underlying TenantOAuthIdpConfig Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TenantOAuthIdpConfigServer) HasDiffIdentitytoolkitAlphaTenantOAuthIdpConfig(ctx context.Context, request *alphapb.HasDiffIdentitytoolkitAlphaTenantOAuthIdpConfigRequest) (*alphapb.HasDiffIdentitytoolkitAlphaTenantOAuthIdpConfigResponse, error) {
+	cl, err := createConfigTenantOAuthIdpConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTenantOAuthIdpConfig(ctx, ProtoToTenantOAuthIdpConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffIdentitytoolkitAlphaTenantOAuthIdpConfigResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIdentitytoolkitAlphaTenantOAuthIdpConfig handles the gRPC request by passing it to the underlying TenantOAuthIdpConfig Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TenantOAuthIdpConfigServer) PlanIdentitytoolkitAlphaTenantOAuthIdpConfig(ctx context.Context, request *alphapb.PlanIdentitytoolkitAlphaTenantOAuthIdpConfigRequest) (*alphapb.PlanIdentitytoolkitAlphaTenantOAuthIdpConfigResponse, error) {
+	cl, err := createConfigTenantOAuthIdpConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTenantOAuthIdpConfig(ctx, ProtoToTenantOAuthIdpConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanIdentitytoolkitAlphaTenantOAuthIdpConfigResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTenantOAuthIdpConfig handles the gRPC request by passing it to the underlying TenantOAuthIdpConfig Delete() method.
 func (s *TenantOAuthIdpConfigServer) DeleteIdentitytoolkitAlphaTenantOAuthIdpConfig(ctx context.Context, request *alphapb.DeleteIdentitytoolkitAlphaTenantOAuthIdpConfigRequest) (*emptypb.Empty, error) {
 
@@ -119,22 +178,35 @@ func (s *TenantOAuthIdpConfigServer) ListIdentitytoolkitAlphaTenantOAuthIdpConfi
 		return nil, err
 	}
 
-	resources, err := cl.ListTenantOAuthIdpConfig(ctx, request.GetProject(), request.GetTenant())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.TenantOAuthIdpConfigMaxPage
+	}
+	resources, err := cl.ListTenantOAuthIdpConfigWithPageToken(ctx, request.GetProject(), request.GetTenant(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.IdentitytoolkitAlphaTenantOAuthIdpConfig
-	for _, r := range resources.Items {
-		rp := TenantOAuthIdpConfigToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TenantOAuthIdpConfigToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListIdentitytoolkitAlphaTenantOAuthIdpConfigResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTenantOAuthIdpConfig(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/identitytoolkit/alpha/identitytoolkit_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/identitytoolkit/alpha"
@@ -137,7 +138,11 @@ func TenantToProto(resource *alpha.Tenant) *alphapb.IdentitytoolkitAlphaTenant {
 // applyTenant handles the gRPC request by passing it to the underlying Tenant Apply() method.
 func (s *TenantServer) applyTenant(ctx context.Context, c *alpha.Client, request *alphapb.ApplyIdentitytoolkitAlphaTenantRequest) (*alphapb.IdentitytoolkitAlphaTenant, error) {
 	p := ProtoToTenant(request.GetResource())
-	res, err := c.ApplyTenant(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTenant(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +159,60 @@ func (s *TenantServer) ApplyIdentitytoolkitAlphaTenant(ctx context.Context, requ
 	return s.applyTenant(ctx, cl, request)
 }
 
+// GetIdentitytoolkitAlphaTenant handles the gRPC request by passing it to the underlying Tenant Get() method.
+func (s *TenantServer) GetIdentitytoolkitAlphaTenant(ctx context.Context, request *alphapb.GetIdentitytoolkitAlphaTenantRequest) (*alphapb.IdentitytoolkitAlphaTenant, error) {
+	cl, err := createConfigTenant(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTenant(ctx, ProtoToTenant(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TenantToProto(res), nil
+}
+
+// HasDiffIdentitytoolkitAlphaTenant handles the gRPC request by passing it to the underlying Tenant Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TenantServer) HasDiffIdentitytoolkitAlphaTenant(ctx context.Context, request *alphapb.HasDiffIdentitytoolkitAlphaTenantRequest) (*alphapb.HasDiffIdentitytoolkitAlphaTenantResponse, error) {
+	cl, err := createConfigTenant(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTenant(ctx, ProtoToTenant(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffIdentitytoolkitAlphaTenantResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIdentitytoolkitAlphaTenant handles the gRPC request by passing it to the underlying Tenant Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TenantServer) PlanIdentitytoolkitAlphaTenant(ctx context.Context, request *alphapb.PlanIdentitytoolkitAlphaTenantRequest) (*alphapb.PlanIdentitytoolkitAlphaTenantResponse, error) {
+	cl, err := createConfigTenant(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTenant(ctx, ProtoToTenant(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanIdentitytoolkitAlphaTenantResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTenant handles the gRPC request by passing it to the underlying Tenant Delete() method.
 func (s *TenantServer) DeleteIdentitytoolkitAlphaTenant(ctx context.Context, request *alphapb.DeleteIdentitytoolkitAlphaTenantRequest) (*emptypb.Empty, error) {
 
@@ -172,22 +231,35 @@ func (s *TenantServer) ListIdentitytoolkitAlphaTenant(ctx context.Context, reque
 		return nil, err
 	}
 
-	resources, err := cl.ListTenant(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.TenantMaxPage
+	}
+	resources, err := cl.ListTenantWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.IdentitytoolkitAlphaTenant
-	for _, r := range resources.Items {
-		rp := TenantToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TenantToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListIdentitytoolkitAlphaTenantResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTenant(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/identitytoolkit/beta/identitytoolkit_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/identitytoolkit/beta"
@@ -1004,7 +1005,11 @@ func ConfigToProto(resource *beta.Config) *betapb.IdentitytoolkitBetaConfig {
 // applyConfig handles the gRPC request by passing it to the underlying Config Apply() method.
 func (s *ConfigServer) applyConfig(ctx context.Context, c *beta.Client, request *betapb.ApplyIdentitytoolkitBetaConfigRequest) (*betapb.IdentitytoolkitBetaConfig, error) {
 	p := ProtoToConfig(request.GetResource())
-	res, err := c.ApplyConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1021,6 +1026,60 @@ func (s *ConfigServer) ApplyIdentitytoolkitBetaConfig(ctx context.Context, reque
 	return s.applyConfig(ctx, cl, request)
 }
 
+// GetIdentitytoolkitBetaConfig handles the gRPC request by passing it to the underlying Config Get() method.
+func (s *ConfigServer) GetIdentitytoolkitBetaConfig(ctx context.Context, request *betapb.GetIdentitytoolkitBetaConfigRequest) (*betapb.IdentitytoolkitBetaConfig, error) {
+	cl, err := createConfigConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetConfig(ctx, ProtoToConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ConfigToProto(res), nil
+}
+
+// HasDiffIdentitytoolkitBetaConfig handles the gRPC request by passing it to the underlying Config Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ConfigServer) HasDiffIdentitytoolkitBetaConfig(ctx context.Context, request *betapb.HasDiffIdentitytoolkitBetaConfigRequest) (*betapb.HasDiffIdentitytoolkitBetaConfigResponse, error) {
+	cl, err := createConfigConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConfig(ctx, ProtoToConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffIdentitytoolkitBetaConfigResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIdentitytoolkitBetaConfig handles the gRPC request by passing it to the underlying Config Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ConfigServer) PlanIdentitytoolkitBetaConfig(ctx context.Context, request *betapb.PlanIdentitytoolkitBetaConfigRequest) (*betapb.PlanIdentitytoolkitBetaConfigResponse, error) {
+	cl, err := createConfigConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConfig(ctx, ProtoToConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanIdentitytoolkitBetaConfigResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteConfig handles the gRPC request by passing it to the underlying Config Delete() method.
 func (s *ConfigServer) DeleteIdentitytoolkitBetaConfig(ctx context.Context, request *betapb.DeleteIdentitytoolkitBetaConfigRequest) (*emptypb.Empty, error) {
 
@@ -1034,7 +1093,9 @@ func (s *ConfigServer) ListIdentitytoolkitBetaConfig(_ context.Context, _ *betap
 }
 
 func createConfigConfig(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
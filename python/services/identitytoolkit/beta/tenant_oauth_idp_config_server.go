@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/identitytoolkit/beta/identitytoolkit_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/identitytoolkit/beta"
@@ -84,7 +85,11 @@ func TenantOAuthIdpConfigToProto(resource *beta.TenantOAuthIdpConfig) *betapb.Id
 // applyTenantOAuthIdpConfig handles the gRPC request by passing it to the underlying TenantOAuthIdpConfig Apply() method.
 func (s *TenantOAuthIdpConfigServer) applyTenantOAuthIdpConfig(ctx context.Context, c *beta.Client, request *betapb.ApplyIdentitytoolkitBetaTenantOAuthIdpConfigRequest) (*betapb.IdentitytoolkitBetaTenantOAuthIdpConfig, error) {
 	p := ProtoToTenantOAuthIdpConfig(request.GetResource())
-	res, err := c.ApplyTenantOAuthIdpConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTenantOAuthIdpConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +106,60 @@ func (s *TenantOAuthIdpConfigServer) ApplyIdentitytoolkitBetaTenantOAuthIdpConfi
 	return s.applyTenantOAuthIdpConfig(ctx, cl, request)
 }
 
+// GetIdentitytoolkitBetaTenantOAuthIdpConfig handles the gRPC request by passing it to the underlying TenantOAuthIdpConfig Get() method.
+func (s *TenantOAuthIdpConfigServer) GetIdentitytoolkitBetaTenantOAuthIdpConfig(ctx context.Context, request *betapb.GetIdentitytoolkitBetaTenantOAuthIdpConfigRequest) (*betapb.IdentitytoolkitBetaTenantOAuthIdpConfig, error) {
+	cl, err := createConfigTenantOAuthIdpConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTenantOAuthIdpConfig(ctx, ProtoToTenantOAuthIdpConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TenantOAuthIdpConfigToProto(res), nil
+}
+
+// HasDiffIdentitytoolkitBetaTenantOAuthIdpConfig handles the gRPC request by passing it to the underlying TenantOAuthIdpConfig Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TenantOAuthIdpConfigServer) HasDiffIdentitytoolkitBetaTenantOAuthIdpConfig(ctx context.Context, request *betapb.HasDiffIdentitytoolkitBetaTenantOAuthIdpConfigRequest) (*betapb.HasDiffIdentitytoolkitBetaTenantOAuthIdpConfigResponse, error) {
+	cl, err := createConfigTenantOAuthIdpConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTenantOAuthIdpConfig(ctx, ProtoToTenantOAuthIdpConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffIdentitytoolkitBetaTenantOAuthIdpConfigResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIdentitytoolkitBetaTenantOAuthIdpConfig handles the gRPC request by passing it to the underlying TenantOAuthIdpConfig Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TenantOAuthIdpConfigServer) PlanIdentitytoolkitBetaTenantOAuthIdpConfig(ctx context.Context, request *betapb.PlanIdentitytoolkitBetaTenantOAuthIdpConfigRequest) (*betapb.PlanIdentitytoolkitBetaTenantOAuthIdpConfigResponse, error) {
+	cl, err := createConfigTenantOAuthIdpConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTenantOAuthIdpConfig(ctx, ProtoToTenantOAuthIdpConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanIdentitytoolkitBetaTenantOAuthIdpConfigResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTenantOAuthIdpConfig handles the gRPC request by passing it to the underlying TenantOAuthIdpConfig Delete() method.
 func (s *TenantOAuthIdpConfigServer) DeleteIdentitytoolkitBetaTenantOAuthIdpConfig(ctx context.Context, request *betapb.DeleteIdentitytoolkitBetaTenantOAuthIdpConfigRequest) (*emptypb.Empty, error) {
 
@@ -119,22 +178,35 @@ func (s *TenantOAuthIdpConfigServer) ListIdentitytoolkitBetaTenantOAuthIdpConfig
 		return nil, err
 	}
 
-	resources, err := cl.ListTenantOAuthIdpConfig(ctx, request.GetProject(), request.GetTenant())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.TenantOAuthIdpConfigMaxPage
+	}
+	resources, err := cl.ListTenantOAuthIdpConfigWithPageToken(ctx, request.GetProject(), request.GetTenant(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.IdentitytoolkitBetaTenantOAuthIdpConfig
-	for _, r := range resources.Items {
-		rp := TenantOAuthIdpConfigToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TenantOAuthIdpConfigToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListIdentitytoolkitBetaTenantOAuthIdpConfigResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTenantOAuthIdpConfig(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/identitytoolkit/beta/identitytoolkit_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/identitytoolkit/beta"
@@ -137,7 +138,11 @@ func TenantToProto(resource *beta.Tenant) *betapb.IdentitytoolkitBetaTenant {
 // applyTenant handles the gRPC request by passing it to the underlying Tenant Apply() method.
 func (s *TenantServer) applyTenant(ctx context.Context, c *beta.Client, request *betapb.ApplyIdentitytoolkitBetaTenantRequest) (*betapb.IdentitytoolkitBetaTenant, error) {
 	p := ProtoToTenant(request.GetResource())
-	res, err := c.ApplyTenant(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTenant(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +159,60 @@ func (s *TenantServer) ApplyIdentitytoolkitBetaTenant(ctx context.Context, reque
 	return s.applyTenant(ctx, cl, request)
 }
 
+// GetIdentitytoolkitBetaTenant handles the gRPC request by passing it to the underlying Tenant Get() method.
+func (s *TenantServer) GetIdentitytoolkitBetaTenant(ctx context.Context, request *betapb.GetIdentitytoolkitBetaTenantRequest) (*betapb.IdentitytoolkitBetaTenant, error) {
+	cl, err := createConfigTenant(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTenant(ctx, ProtoToTenant(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TenantToProto(res), nil
+}
+
+// HasDiffIdentitytoolkitBetaTenant handles the gRPC request by passing it to the underlying Tenant Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TenantServer) HasDiffIdentitytoolkitBetaTenant(ctx context.Context, request *betapb.HasDiffIdentitytoolkitBetaTenantRequest) (*betapb.HasDiffIdentitytoolkitBetaTenantResponse, error) {
+	cl, err := createConfigTenant(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTenant(ctx, ProtoToTenant(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffIdentitytoolkitBetaTenantResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIdentitytoolkitBetaTenant handles the gRPC request by passing it to the underlying Tenant Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TenantServer) PlanIdentitytoolkitBetaTenant(ctx context.Context, request *betapb.PlanIdentitytoolkitBetaTenantRequest) (*betapb.PlanIdentitytoolkitBetaTenantResponse, error) {
+	cl, err := createConfigTenant(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTenant(ctx, ProtoToTenant(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanIdentitytoolkitBetaTenantResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTenant handles the gRPC request by passing it to the underlying Tenant Delete() method.
 func (s *TenantServer) DeleteIdentitytoolkitBetaTenant(ctx context.Context, request *betapb.DeleteIdentitytoolkitBetaTenantRequest) (*emptypb.Empty, error) {
 
@@ -172,22 +231,35 @@ func (s *TenantServer) ListIdentitytoolkitBetaTenant(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListTenant(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.TenantMaxPage
+	}
+	resources, err := cl.ListTenantWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.IdentitytoolkitBetaTenant
-	for _, r := range resources.Items {
-		rp := TenantToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TenantToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListIdentitytoolkitBetaTenantResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTenant(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	identitytoolkitpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/identitytoolkit/identitytoolkit_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/identitytoolkit"
@@ -1004,7 +1005,11 @@ func ConfigToProto(resource *identitytoolkit.Config) *identitytoolkitpb.Identity
 // applyConfig handles the gRPC request by passing it to the underlying Config Apply() method.
 func (s *ConfigServer) applyConfig(ctx context.Context, c *identitytoolkit.Client, request *identitytoolkitpb.ApplyIdentitytoolkitConfigRequest) (*identitytoolkitpb.IdentitytoolkitConfig, error) {
 	p := ProtoToConfig(request.GetResource())
-	res, err := c.ApplyConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1021,6 +1026,60 @@ func (s *ConfigServer) ApplyIdentitytoolkitConfig(ctx context.Context, request *
 	return s.applyConfig(ctx, cl, request)
 }
 
+// GetIdentitytoolkitConfig handles the gRPC request by passing it to the underlying Config Get() method.
+func (s *ConfigServer) GetIdentitytoolkitConfig(ctx context.Context, request *identitytoolkitpb.GetIdentitytoolkitConfigRequest) (*identitytoolkitpb.IdentitytoolkitConfig, error) {
+	cl, err := createConfigConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetConfig(ctx, ProtoToConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ConfigToProto(res), nil
+}
+
+// HasDiffIdentitytoolkitConfig handles the gRPC request by passing it to the underlying Config Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ConfigServer) HasDiffIdentitytoolkitConfig(ctx context.Context, request *identitytoolkitpb.HasDiffIdentitytoolkitConfigRequest) (*identitytoolkitpb.HasDiffIdentitytoolkitConfigResponse, error) {
+	cl, err := createConfigConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConfig(ctx, ProtoToConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &identitytoolkitpb.HasDiffIdentitytoolkitConfigResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIdentitytoolkitConfig handles the gRPC request by passing it to the underlying Config Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ConfigServer) PlanIdentitytoolkitConfig(ctx context.Context, request *identitytoolkitpb.PlanIdentitytoolkitConfigRequest) (*identitytoolkitpb.PlanIdentitytoolkitConfigResponse, error) {
+	cl, err := createConfigConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConfig(ctx, ProtoToConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &identitytoolkitpb.PlanIdentitytoolkitConfigResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteConfig handles the gRPC request by passing it to the underlying Config Delete() method.
 func (s *ConfigServer) DeleteIdentitytoolkitConfig(ctx context.Context, request *identitytoolkitpb.DeleteIdentitytoolkitConfigRequest) (*emptypb.Empty, error) {
 
@@ -1034,7 +1093,9 @@ func (s *ConfigServer) ListIdentitytoolkitConfig(_ context.Context, _ *identityt
 }
 
 func createConfigConfig(ctx context.Context, service_account_file string) (*identitytoolkit.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return identitytoolkit.NewClient(conf), nil
 }
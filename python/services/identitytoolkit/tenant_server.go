@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	identitytoolkitpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/identitytoolkit/identitytoolkit_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/identitytoolkit"
@@ -137,7 +138,11 @@ func TenantToProto(resource *identitytoolkit.Tenant) *identitytoolkitpb.Identity
 // applyTenant handles the gRPC request by passing it to the underlying Tenant Apply() method.
 func (s *TenantServer) applyTenant(ctx context.Context, c *identitytoolkit.Client, request *identitytoolkitpb.ApplyIdentitytoolkitTenantRequest) (*identitytoolkitpb.IdentitytoolkitTenant, error) {
 	p := ProtoToTenant(request.GetResource())
-	res, err := c.ApplyTenant(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTenant(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +159,60 @@ func (s *TenantServer) ApplyIdentitytoolkitTenant(ctx context.Context, request *
 	return s.applyTenant(ctx, cl, request)
 }
 
+// GetIdentitytoolkitTenant handles the gRPC request by passing it to the underlying Tenant Get() method.
+func (s *TenantServer) GetIdentitytoolkitTenant(ctx context.Context, request *identitytoolkitpb.GetIdentitytoolkitTenantRequest) (*identitytoolkitpb.IdentitytoolkitTenant, error) {
+	cl, err := createConfigTenant(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTenant(ctx, ProtoToTenant(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TenantToProto(res), nil
+}
+
+// HasDiffIdentitytoolkitTenant handles the gRPC request by passing it to the underlying Tenant Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TenantServer) HasDiffIdentitytoolkitTenant(ctx context.Context, request *identitytoolkitpb.HasDiffIdentitytoolkitTenantRequest) (*identitytoolkitpb.HasDiffIdentitytoolkitTenantResponse, error) {
+	cl, err := createConfigTenant(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTenant(ctx, ProtoToTenant(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &identitytoolkitpb.HasDiffIdentitytoolkitTenantResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanIdentitytoolkitTenant handles the gRPC request by passing it to the underlying Tenant Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TenantServer) PlanIdentitytoolkitTenant(ctx context.Context, request *identitytoolkitpb.PlanIdentitytoolkitTenantRequest) (*identitytoolkitpb.PlanIdentitytoolkitTenantResponse, error) {
+	cl, err := createConfigTenant(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTenant(ctx, ProtoToTenant(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &identitytoolkitpb.PlanIdentitytoolkitTenantResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTenant handles the gRPC request by passing it to the underlying Tenant Delete() method.
 func (s *TenantServer) DeleteIdentitytoolkitTenant(ctx context.Context, request *identitytoolkitpb.DeleteIdentitytoolkitTenantRequest) (*emptypb.Empty, error) {
 
@@ -172,22 +231,35 @@ func (s *TenantServer) ListIdentitytoolkitTenant(ctx context.Context, request *i
 		return nil, err
 	}
 
-	resources, err := cl.ListTenant(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = identitytoolkit.TenantMaxPage
+	}
+	resources, err := cl.ListTenantWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*identitytoolkitpb.IdentitytoolkitTenant
-	for _, r := range resources.Items {
-		rp := TenantToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TenantToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &identitytoolkitpb.ListIdentitytoolkitTenantResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTenant(ctx context.Context, service_account_file string) (*identitytoolkit.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return identitytoolkit.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/krmapihosting/alpha/krmapihosting_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/krmapihosting/alpha"
@@ -182,7 +183,11 @@ func KrmApiHostToProto(resource *alpha.KrmApiHost) *alphapb.KrmapihostingAlphaKr
 // ApplyKrmApiHost handles the gRPC request by passing it to the underlying KrmApiHost Apply() method.
 func (s *KrmApiHostServer) applyKrmApiHost(ctx context.Context, c *alpha.Client, request *alphapb.ApplyKrmapihostingAlphaKrmApiHostRequest) (*alphapb.KrmapihostingAlphaKrmApiHost, error) {
 	p := ProtoToKrmApiHost(request.GetResource())
-	res, err := c.ApplyKrmApiHost(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyKrmApiHost(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +204,55 @@ func (s *KrmApiHostServer) ApplyKrmapihostingAlphaKrmApiHost(ctx context.Context
 	return s.applyKrmApiHost(ctx, cl, request)
 }
 
+// GetKrmapihostingAlphaKrmApiHost handles the gRPC request by passing it to the underlying KrmApiHost Get() method.
+func (s *KrmApiHostServer) GetKrmapihostingAlphaKrmApiHost(ctx context.Context, request *alphapb.GetKrmapihostingAlphaKrmApiHostRequest) (*alphapb.KrmapihostingAlphaKrmApiHost, error) {
+	cl, err := createConfigKrmApiHost(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetKrmApiHost(ctx, ProtoToKrmApiHost(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return KrmApiHostToProto(res), nil
+}
+
+// HasDiffKrmapihostingAlphaKrmApiHost handles the gRPC request by passing it to the underlying KrmApiHost Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *KrmApiHostServer) HasDiffKrmapihostingAlphaKrmApiHost(ctx context.Context, request *alphapb.HasDiffKrmapihostingAlphaKrmApiHostRequest) (*alphapb.HasDiffKrmapihostingAlphaKrmApiHostResponse, error) {
+	cl, err := createConfigKrmApiHost(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKrmApiHost(ctx, ProtoToKrmApiHost(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.HasDiffKrmapihostingAlphaKrmApiHostResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanKrmapihostingAlphaKrmApiHost handles the gRPC request by passing it to the underlying KrmApiHost Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *KrmApiHostServer) PlanKrmapihostingAlphaKrmApiHost(ctx context.Context, request *alphapb.PlanKrmapihostingAlphaKrmApiHostRequest) (*alphapb.PlanKrmapihostingAlphaKrmApiHostResponse, error) {
+	cl, err := createConfigKrmApiHost(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKrmApiHost(ctx, ProtoToKrmApiHost(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.PlanKrmapihostingAlphaKrmApiHostResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteKrmApiHost handles the gRPC request by passing it to the underlying KrmApiHost Delete() method.
 func (s *KrmApiHostServer) DeleteKrmapihostingAlphaKrmApiHost(ctx context.Context, request *alphapb.DeleteKrmapihostingAlphaKrmApiHostRequest) (*emptypb.Empty, error) {
 
@@ -217,20 +271,32 @@ func (s *KrmApiHostServer) ListKrmapihostingAlphaKrmApiHost(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListKrmApiHost(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = alpha.KrmApiHostMaxPage
+	}
+	resources, err := cl.ListKrmApiHostWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.KrmapihostingAlphaKrmApiHost
-	for _, r := range resources.Items {
-		rp := KrmApiHostToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, KrmApiHostToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &alphapb.ListKrmapihostingAlphaKrmApiHostResponse{Items: protos}, nil
+	return &alphapb.ListKrmapihostingAlphaKrmApiHostResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigKrmApiHost(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/logging/alpha/logging_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/logging/alpha"
@@ -55,7 +56,11 @@ func LogExclusionToProto(resource *alpha.LogExclusion) *alphapb.LoggingAlphaLogE
 // applyLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Apply() method.
 func (s *LogExclusionServer) applyLogExclusion(ctx context.Context, c *alpha.Client, request *alphapb.ApplyLoggingAlphaLogExclusionRequest) (*alphapb.LoggingAlphaLogExclusion, error) {
 	p := ProtoToLogExclusion(request.GetResource())
-	res, err := c.ApplyLogExclusion(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyLogExclusion(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,60 @@ func (s *LogExclusionServer) ApplyLoggingAlphaLogExclusion(ctx context.Context,
 	return s.applyLogExclusion(ctx, cl, request)
 }
 
+// GetLoggingAlphaLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Get() method.
+func (s *LogExclusionServer) GetLoggingAlphaLogExclusion(ctx context.Context, request *alphapb.GetLoggingAlphaLogExclusionRequest) (*alphapb.LoggingAlphaLogExclusion, error) {
+	cl, err := createConfigLogExclusion(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetLogExclusion(ctx, ProtoToLogExclusion(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return LogExclusionToProto(res), nil
+}
+
+// HasDiffLoggingAlphaLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *LogExclusionServer) HasDiffLoggingAlphaLogExclusion(ctx context.Context, request *alphapb.HasDiffLoggingAlphaLogExclusionRequest) (*alphapb.HasDiffLoggingAlphaLogExclusionResponse, error) {
+	cl, err := createConfigLogExclusion(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogExclusion(ctx, ProtoToLogExclusion(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffLoggingAlphaLogExclusionResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanLoggingAlphaLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *LogExclusionServer) PlanLoggingAlphaLogExclusion(ctx context.Context, request *alphapb.PlanLoggingAlphaLogExclusionRequest) (*alphapb.PlanLoggingAlphaLogExclusionResponse, error) {
+	cl, err := createConfigLogExclusion(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogExclusion(ctx, ProtoToLogExclusion(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanLoggingAlphaLogExclusionResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Delete() method.
 func (s *LogExclusionServer) DeleteLoggingAlphaLogExclusion(ctx context.Context, request *alphapb.DeleteLoggingAlphaLogExclusionRequest) (*emptypb.Empty, error) {
 
@@ -90,22 +149,35 @@ func (s *LogExclusionServer) ListLoggingAlphaLogExclusion(ctx context.Context, r
 		return nil, err
 	}
 
-	resources, err := cl.ListLogExclusion(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.LogExclusionMaxPage
+	}
+	resources, err := cl.ListLogExclusionWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.LoggingAlphaLogExclusion
-	for _, r := range resources.Items {
-		rp := LogExclusionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, LogExclusionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListLoggingAlphaLogExclusionResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigLogExclusion(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
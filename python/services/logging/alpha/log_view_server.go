@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/logging/alpha/logging_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/logging/alpha"
@@ -57,7 +58,11 @@ func LogViewToProto(resource *alpha.LogView) *alphapb.LoggingAlphaLogView {
 // applyLogView handles the gRPC request by passing it to the underlying LogView Apply() method.
 func (s *LogViewServer) applyLogView(ctx context.Context, c *alpha.Client, request *alphapb.ApplyLoggingAlphaLogViewRequest) (*alphapb.LoggingAlphaLogView, error) {
 	p := ProtoToLogView(request.GetResource())
-	res, err := c.ApplyLogView(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyLogView(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +79,60 @@ func (s *LogViewServer) ApplyLoggingAlphaLogView(ctx context.Context, request *a
 	return s.applyLogView(ctx, cl, request)
 }
 
+// GetLoggingAlphaLogView handles the gRPC request by passing it to the underlying LogView Get() method.
+func (s *LogViewServer) GetLoggingAlphaLogView(ctx context.Context, request *alphapb.GetLoggingAlphaLogViewRequest) (*alphapb.LoggingAlphaLogView, error) {
+	cl, err := createConfigLogView(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetLogView(ctx, ProtoToLogView(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return LogViewToProto(res), nil
+}
+
+// HasDiffLoggingAlphaLogView handles the gRPC request by passing it to the underlying LogView Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *LogViewServer) HasDiffLoggingAlphaLogView(ctx context.Context, request *alphapb.HasDiffLoggingAlphaLogViewRequest) (*alphapb.HasDiffLoggingAlphaLogViewResponse, error) {
+	cl, err := createConfigLogView(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogView(ctx, ProtoToLogView(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffLoggingAlphaLogViewResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanLoggingAlphaLogView handles the gRPC request by passing it to the underlying LogView Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *LogViewServer) PlanLoggingAlphaLogView(ctx context.Context, request *alphapb.PlanLoggingAlphaLogViewRequest) (*alphapb.PlanLoggingAlphaLogViewResponse, error) {
+	cl, err := createConfigLogView(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogView(ctx, ProtoToLogView(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanLoggingAlphaLogViewResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteLogView handles the gRPC request by passing it to the underlying LogView Delete() method.
 func (s *LogViewServer) DeleteLoggingAlphaLogView(ctx context.Context, request *alphapb.DeleteLoggingAlphaLogViewRequest) (*emptypb.Empty, error) {
 
@@ -92,22 +151,35 @@ func (s *LogViewServer) ListLoggingAlphaLogView(ctx context.Context, request *al
 		return nil, err
 	}
 
-	resources, err := cl.ListLogView(ctx, request.GetLocation(), request.GetBucket(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.LogViewMaxPage
+	}
+	resources, err := cl.ListLogViewWithPageToken(ctx, request.GetLocation(), request.GetBucket(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.LoggingAlphaLogView
-	for _, r := range resources.Items {
-		rp := LogViewToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, LogViewToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListLoggingAlphaLogViewResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigLogView(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/logging/beta/logging_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/logging/beta"
@@ -82,7 +83,11 @@ func LogBucketToProto(resource *beta.LogBucket) *betapb.LoggingBetaLogBucket {
 // applyLogBucket handles the gRPC request by passing it to the underlying LogBucket Apply() method.
 func (s *LogBucketServer) applyLogBucket(ctx context.Context, c *beta.Client, request *betapb.ApplyLoggingBetaLogBucketRequest) (*betapb.LoggingBetaLogBucket, error) {
 	p := ProtoToLogBucket(request.GetResource())
-	res, err := c.ApplyLogBucket(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyLogBucket(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +104,60 @@ func (s *LogBucketServer) ApplyLoggingBetaLogBucket(ctx context.Context, request
 	return s.applyLogBucket(ctx, cl, request)
 }
 
+// GetLoggingBetaLogBucket handles the gRPC request by passing it to the underlying LogBucket Get() method.
+func (s *LogBucketServer) GetLoggingBetaLogBucket(ctx context.Context, request *betapb.GetLoggingBetaLogBucketRequest) (*betapb.LoggingBetaLogBucket, error) {
+	cl, err := createConfigLogBucket(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetLogBucket(ctx, ProtoToLogBucket(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return LogBucketToProto(res), nil
+}
+
+// HasDiffLoggingBetaLogBucket handles the gRPC request by passing it to the underlying LogBucket Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *LogBucketServer) HasDiffLoggingBetaLogBucket(ctx context.Context, request *betapb.HasDiffLoggingBetaLogBucketRequest) (*betapb.HasDiffLoggingBetaLogBucketResponse, error) {
+	cl, err := createConfigLogBucket(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogBucket(ctx, ProtoToLogBucket(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffLoggingBetaLogBucketResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanLoggingBetaLogBucket handles the gRPC request by passing it to the underlying LogBucket Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *LogBucketServer) PlanLoggingBetaLogBucket(ctx context.Context, request *betapb.PlanLoggingBetaLogBucketRequest) (*betapb.PlanLoggingBetaLogBucketResponse, error) {
+	cl, err := createConfigLogBucket(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogBucket(ctx, ProtoToLogBucket(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanLoggingBetaLogBucketResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteLogBucket handles the gRPC request by passing it to the underlying LogBucket Delete() method.
 func (s *LogBucketServer) DeleteLoggingBetaLogBucket(ctx context.Context, request *betapb.DeleteLoggingBetaLogBucketRequest) (*emptypb.Empty, error) {
 
@@ -117,22 +176,35 @@ func (s *LogBucketServer) ListLoggingBetaLogBucket(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListLogBucket(ctx, request.GetLocation(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.LogBucketMaxPage
+	}
+	resources, err := cl.ListLogBucketWithPageToken(ctx, request.GetLocation(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.LoggingBetaLogBucket
-	for _, r := range resources.Items {
-		rp := LogBucketToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, LogBucketToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListLoggingBetaLogBucketResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigLogBucket(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
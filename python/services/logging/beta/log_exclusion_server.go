@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/logging/beta/logging_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/logging/beta"
@@ -55,7 +56,11 @@ func LogExclusionToProto(resource *beta.LogExclusion) *betapb.LoggingBetaLogExcl
 // applyLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Apply() method.
 func (s *LogExclusionServer) applyLogExclusion(ctx context.Context, c *beta.Client, request *betapb.ApplyLoggingBetaLogExclusionRequest) (*betapb.LoggingBetaLogExclusion, error) {
 	p := ProtoToLogExclusion(request.GetResource())
-	res, err := c.ApplyLogExclusion(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyLogExclusion(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,60 @@ func (s *LogExclusionServer) ApplyLoggingBetaLogExclusion(ctx context.Context, r
 	return s.applyLogExclusion(ctx, cl, request)
 }
 
+// GetLoggingBetaLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Get() method.
+func (s *LogExclusionServer) GetLoggingBetaLogExclusion(ctx context.Context, request *betapb.GetLoggingBetaLogExclusionRequest) (*betapb.LoggingBetaLogExclusion, error) {
+	cl, err := createConfigLogExclusion(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetLogExclusion(ctx, ProtoToLogExclusion(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return LogExclusionToProto(res), nil
+}
+
+// HasDiffLoggingBetaLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *LogExclusionServer) HasDiffLoggingBetaLogExclusion(ctx context.Context, request *betapb.HasDiffLoggingBetaLogExclusionRequest) (*betapb.HasDiffLoggingBetaLogExclusionResponse, error) {
+	cl, err := createConfigLogExclusion(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogExclusion(ctx, ProtoToLogExclusion(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffLoggingBetaLogExclusionResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanLoggingBetaLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *LogExclusionServer) PlanLoggingBetaLogExclusion(ctx context.Context, request *betapb.PlanLoggingBetaLogExclusionRequest) (*betapb.PlanLoggingBetaLogExclusionResponse, error) {
+	cl, err := createConfigLogExclusion(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogExclusion(ctx, ProtoToLogExclusion(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanLoggingBetaLogExclusionResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Delete() method.
 func (s *LogExclusionServer) DeleteLoggingBetaLogExclusion(ctx context.Context, request *betapb.DeleteLoggingBetaLogExclusionRequest) (*emptypb.Empty, error) {
 
@@ -90,22 +149,35 @@ func (s *LogExclusionServer) ListLoggingBetaLogExclusion(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListLogExclusion(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.LogExclusionMaxPage
+	}
+	resources, err := cl.ListLogExclusionWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.LoggingBetaLogExclusion
-	for _, r := range resources.Items {
-		rp := LogExclusionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, LogExclusionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListLoggingBetaLogExclusionResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigLogExclusion(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/logging/beta/logging_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/logging/beta"
@@ -360,7 +361,11 @@ func LogMetricToProto(resource *beta.LogMetric) *betapb.LoggingBetaLogMetric {
 // applyLogMetric handles the gRPC request by passing it to the underlying LogMetric Apply() method.
 func (s *LogMetricServer) applyLogMetric(ctx context.Context, c *beta.Client, request *betapb.ApplyLoggingBetaLogMetricRequest) (*betapb.LoggingBetaLogMetric, error) {
 	p := ProtoToLogMetric(request.GetResource())
-	res, err := c.ApplyLogMetric(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyLogMetric(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -377,6 +382,60 @@ func (s *LogMetricServer) ApplyLoggingBetaLogMetric(ctx context.Context, request
 	return s.applyLogMetric(ctx, cl, request)
 }
 
+// GetLoggingBetaLogMetric handles the gRPC request by passing it to the underlying LogMetric Get() method.
+func (s *LogMetricServer) GetLoggingBetaLogMetric(ctx context.Context, request *betapb.GetLoggingBetaLogMetricRequest) (*betapb.LoggingBetaLogMetric, error) {
+	cl, err := createConfigLogMetric(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetLogMetric(ctx, ProtoToLogMetric(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return LogMetricToProto(res), nil
+}
+
+// HasDiffLoggingBetaLogMetric handles the gRPC request by passing it to the underlying LogMetric Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *LogMetricServer) HasDiffLoggingBetaLogMetric(ctx context.Context, request *betapb.HasDiffLoggingBetaLogMetricRequest) (*betapb.HasDiffLoggingBetaLogMetricResponse, error) {
+	cl, err := createConfigLogMetric(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogMetric(ctx, ProtoToLogMetric(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffLoggingBetaLogMetricResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanLoggingBetaLogMetric handles the gRPC request by passing it to the underlying LogMetric Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *LogMetricServer) PlanLoggingBetaLogMetric(ctx context.Context, request *betapb.PlanLoggingBetaLogMetricRequest) (*betapb.PlanLoggingBetaLogMetricResponse, error) {
+	cl, err := createConfigLogMetric(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogMetric(ctx, ProtoToLogMetric(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanLoggingBetaLogMetricResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteLogMetric handles the gRPC request by passing it to the underlying LogMetric Delete() method.
 func (s *LogMetricServer) DeleteLoggingBetaLogMetric(ctx context.Context, request *betapb.DeleteLoggingBetaLogMetricRequest) (*emptypb.Empty, error) {
 
@@ -395,22 +454,35 @@ func (s *LogMetricServer) ListLoggingBetaLogMetric(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListLogMetric(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.LogMetricMaxPage
+	}
+	resources, err := cl.ListLogMetricWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.LoggingBetaLogMetric
-	for _, r := range resources.Items {
-		rp := LogMetricToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, LogMetricToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListLoggingBetaLogMetricResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigLogMetric(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	loggingpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/logging/logging_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/logging"
@@ -55,7 +56,11 @@ func LogExclusionToProto(resource *logging.LogExclusion) *loggingpb.LoggingLogEx
 // applyLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Apply() method.
 func (s *LogExclusionServer) applyLogExclusion(ctx context.Context, c *logging.Client, request *loggingpb.ApplyLoggingLogExclusionRequest) (*loggingpb.LoggingLogExclusion, error) {
 	p := ProtoToLogExclusion(request.GetResource())
-	res, err := c.ApplyLogExclusion(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyLogExclusion(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,60 @@ func (s *LogExclusionServer) ApplyLoggingLogExclusion(ctx context.Context, reque
 	return s.applyLogExclusion(ctx, cl, request)
 }
 
+// GetLoggingLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Get() method.
+func (s *LogExclusionServer) GetLoggingLogExclusion(ctx context.Context, request *loggingpb.GetLoggingLogExclusionRequest) (*loggingpb.LoggingLogExclusion, error) {
+	cl, err := createConfigLogExclusion(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetLogExclusion(ctx, ProtoToLogExclusion(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return LogExclusionToProto(res), nil
+}
+
+// HasDiffLoggingLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *LogExclusionServer) HasDiffLoggingLogExclusion(ctx context.Context, request *loggingpb.HasDiffLoggingLogExclusionRequest) (*loggingpb.HasDiffLoggingLogExclusionResponse, error) {
+	cl, err := createConfigLogExclusion(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogExclusion(ctx, ProtoToLogExclusion(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &loggingpb.HasDiffLoggingLogExclusionResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanLoggingLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *LogExclusionServer) PlanLoggingLogExclusion(ctx context.Context, request *loggingpb.PlanLoggingLogExclusionRequest) (*loggingpb.PlanLoggingLogExclusionResponse, error) {
+	cl, err := createConfigLogExclusion(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogExclusion(ctx, ProtoToLogExclusion(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &loggingpb.PlanLoggingLogExclusionResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteLogExclusion handles the gRPC request by passing it to the underlying LogExclusion Delete() method.
 func (s *LogExclusionServer) DeleteLoggingLogExclusion(ctx context.Context, request *loggingpb.DeleteLoggingLogExclusionRequest) (*emptypb.Empty, error) {
 
@@ -90,22 +149,35 @@ func (s *LogExclusionServer) ListLoggingLogExclusion(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListLogExclusion(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = logging.LogExclusionMaxPage
+	}
+	resources, err := cl.ListLogExclusionWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*loggingpb.LoggingLogExclusion
-	for _, r := range resources.Items {
-		rp := LogExclusionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, LogExclusionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &loggingpb.ListLoggingLogExclusionResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigLogExclusion(ctx context.Context, service_account_file string) (*logging.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return logging.NewClient(conf), nil
 }
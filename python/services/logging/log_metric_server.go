@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	loggingpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/logging/logging_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/logging"
@@ -360,7 +361,11 @@ func LogMetricToProto(resource *logging.LogMetric) *loggingpb.LoggingLogMetric {
 // applyLogMetric handles the gRPC request by passing it to the underlying LogMetric Apply() method.
 func (s *LogMetricServer) applyLogMetric(ctx context.Context, c *logging.Client, request *loggingpb.ApplyLoggingLogMetricRequest) (*loggingpb.LoggingLogMetric, error) {
 	p := ProtoToLogMetric(request.GetResource())
-	res, err := c.ApplyLogMetric(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyLogMetric(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -377,6 +382,60 @@ func (s *LogMetricServer) ApplyLoggingLogMetric(ctx context.Context, request *lo
 	return s.applyLogMetric(ctx, cl, request)
 }
 
+// GetLoggingLogMetric handles the gRPC request by passing it to the underlying LogMetric Get() method.
+func (s *LogMetricServer) GetLoggingLogMetric(ctx context.Context, request *loggingpb.GetLoggingLogMetricRequest) (*loggingpb.LoggingLogMetric, error) {
+	cl, err := createConfigLogMetric(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetLogMetric(ctx, ProtoToLogMetric(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return LogMetricToProto(res), nil
+}
+
+// HasDiffLoggingLogMetric handles the gRPC request by passing it to the underlying LogMetric Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *LogMetricServer) HasDiffLoggingLogMetric(ctx context.Context, request *loggingpb.HasDiffLoggingLogMetricRequest) (*loggingpb.HasDiffLoggingLogMetricResponse, error) {
+	cl, err := createConfigLogMetric(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogMetric(ctx, ProtoToLogMetric(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &loggingpb.HasDiffLoggingLogMetricResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanLoggingLogMetric handles the gRPC request by passing it to the underlying LogMetric Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *LogMetricServer) PlanLoggingLogMetric(ctx context.Context, request *loggingpb.PlanLoggingLogMetricRequest) (*loggingpb.PlanLoggingLogMetricResponse, error) {
+	cl, err := createConfigLogMetric(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogMetric(ctx, ProtoToLogMetric(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &loggingpb.PlanLoggingLogMetricResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteLogMetric handles the gRPC request by passing it to the underlying LogMetric Delete() method.
 func (s *LogMetricServer) DeleteLoggingLogMetric(ctx context.Context, request *loggingpb.DeleteLoggingLogMetricRequest) (*emptypb.Empty, error) {
 
@@ -395,22 +454,35 @@ func (s *LogMetricServer) ListLoggingLogMetric(ctx context.Context, request *log
 		return nil, err
 	}
 
-	resources, err := cl.ListLogMetric(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = logging.LogMetricMaxPage
+	}
+	resources, err := cl.ListLogMetricWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*loggingpb.LoggingLogMetric
-	for _, r := range resources.Items {
-		rp := LogMetricToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, LogMetricToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &loggingpb.ListLoggingLogMetricResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigLogMetric(ctx context.Context, service_account_file string) (*logging.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return logging.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	loggingpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/logging/logging_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/logging"
@@ -57,7 +58,11 @@ func LogViewToProto(resource *logging.LogView) *loggingpb.LoggingLogView {
 // applyLogView handles the gRPC request by passing it to the underlying LogView Apply() method.
 func (s *LogViewServer) applyLogView(ctx context.Context, c *logging.Client, request *loggingpb.ApplyLoggingLogViewRequest) (*loggingpb.LoggingLogView, error) {
 	p := ProtoToLogView(request.GetResource())
-	res, err := c.ApplyLogView(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyLogView(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +79,60 @@ func (s *LogViewServer) ApplyLoggingLogView(ctx context.Context, request *loggin
 	return s.applyLogView(ctx, cl, request)
 }
 
+// GetLoggingLogView handles the gRPC request by passing it to the underlying LogView Get() method.
+func (s *LogViewServer) GetLoggingLogView(ctx context.Context, request *loggingpb.GetLoggingLogViewRequest) (*loggingpb.LoggingLogView, error) {
+	cl, err := createConfigLogView(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetLogView(ctx, ProtoToLogView(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return LogViewToProto(res), nil
+}
+
+// HasDiffLoggingLogView handles the gRPC request by passing it to the underlying LogView Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *LogViewServer) HasDiffLoggingLogView(ctx context.Context, request *loggingpb.HasDiffLoggingLogViewRequest) (*loggingpb.HasDiffLoggingLogViewResponse, error) {
+	cl, err := createConfigLogView(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogView(ctx, ProtoToLogView(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &loggingpb.HasDiffLoggingLogViewResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanLoggingLogView handles the gRPC request by passing it to the underlying LogView Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *LogViewServer) PlanLoggingLogView(ctx context.Context, request *loggingpb.PlanLoggingLogViewRequest) (*loggingpb.PlanLoggingLogViewResponse, error) {
+	cl, err := createConfigLogView(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffLogView(ctx, ProtoToLogView(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &loggingpb.PlanLoggingLogViewResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteLogView handles the gRPC request by passing it to the underlying LogView Delete() method.
 func (s *LogViewServer) DeleteLoggingLogView(ctx context.Context, request *loggingpb.DeleteLoggingLogViewRequest) (*emptypb.Empty, error) {
 
@@ -92,22 +151,35 @@ func (s *LogViewServer) ListLoggingLogView(ctx context.Context, request *logging
 		return nil, err
 	}
 
-	resources, err := cl.ListLogView(ctx, request.GetLocation(), request.GetBucket(), request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = logging.LogViewMaxPage
+	}
+	resources, err := cl.ListLogViewWithPageToken(ctx, request.GetLocation(), request.GetBucket(), request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*loggingpb.LoggingLogView
-	for _, r := range resources.Items {
-		rp := LogViewToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, LogViewToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &loggingpb.ListLoggingLogViewResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigLogView(ctx context.Context, service_account_file string) (*logging.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return logging.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	monitoringpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/monitoring_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring"
@@ -2049,7 +2050,11 @@ func AlertPolicyToProto(resource *monitoring.AlertPolicy) *monitoringpb.Monitori
 // ApplyAlertPolicy handles the gRPC request by passing it to the underlying AlertPolicy Apply() method.
 func (s *AlertPolicyServer) applyAlertPolicy(ctx context.Context, c *monitoring.Client, request *monitoringpb.ApplyMonitoringAlertPolicyRequest) (*monitoringpb.MonitoringAlertPolicy, error) {
 	p := ProtoToAlertPolicy(request.GetResource())
-	res, err := c.ApplyAlertPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAlertPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2066,6 +2071,55 @@ func (s *AlertPolicyServer) ApplyMonitoringAlertPolicy(ctx context.Context, requ
 	return s.applyAlertPolicy(ctx, cl, request)
 }
 
+// GetMonitoringAlertPolicy handles the gRPC request by passing it to the underlying AlertPolicy Get() method.
+func (s *AlertPolicyServer) GetMonitoringAlertPolicy(ctx context.Context, request *monitoringpb.GetMonitoringAlertPolicyRequest) (*monitoringpb.MonitoringAlertPolicy, error) {
+	cl, err := createConfigAlertPolicy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAlertPolicy(ctx, ProtoToAlertPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AlertPolicyToProto(res), nil
+}
+
+// HasDiffMonitoringAlertPolicy handles the gRPC request by passing it to the underlying AlertPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AlertPolicyServer) HasDiffMonitoringAlertPolicy(ctx context.Context, request *monitoringpb.HasDiffMonitoringAlertPolicyRequest) (*monitoringpb.HasDiffMonitoringAlertPolicyResponse, error) {
+	cl, err := createConfigAlertPolicy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAlertPolicy(ctx, ProtoToAlertPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &monitoringpb.HasDiffMonitoringAlertPolicyResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanMonitoringAlertPolicy handles the gRPC request by passing it to the underlying AlertPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AlertPolicyServer) PlanMonitoringAlertPolicy(ctx context.Context, request *monitoringpb.PlanMonitoringAlertPolicyRequest) (*monitoringpb.PlanMonitoringAlertPolicyResponse, error) {
+	cl, err := createConfigAlertPolicy(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAlertPolicy(ctx, ProtoToAlertPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &monitoringpb.PlanMonitoringAlertPolicyResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteAlertPolicy handles the gRPC request by passing it to the underlying AlertPolicy Delete() method.
 func (s *AlertPolicyServer) DeleteMonitoringAlertPolicy(ctx context.Context, request *monitoringpb.DeleteMonitoringAlertPolicyRequest) (*emptypb.Empty, error) {
 
@@ -2084,20 +2138,32 @@ func (s *AlertPolicyServer) ListMonitoringAlertPolicy(ctx context.Context, reque
 		return nil, err
 	}
 
-	resources, err := cl.ListAlertPolicy(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = monitoring.AlertPolicyMaxPage
+	}
+	resources, err := cl.ListAlertPolicyWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*monitoringpb.MonitoringAlertPolicy
-	for _, r := range resources.Items {
-		rp := AlertPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AlertPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &monitoringpb.ListMonitoringAlertPolicyResponse{Items: protos}, nil
+	return &monitoringpb.ListMonitoringAlertPolicyResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigAlertPolicy(ctx context.Context, service_account_file string) (*monitoring.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return monitoring.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/alpha/monitoring_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/alpha"
@@ -7970,7 +7971,11 @@ func DashboardToProto(resource *alpha.Dashboard) *alphapb.MonitoringAlphaDashboa
 // applyDashboard handles the gRPC request by passing it to the underlying Dashboard Apply() method.
 func (s *DashboardServer) applyDashboard(ctx context.Context, c *alpha.Client, request *alphapb.ApplyMonitoringAlphaDashboardRequest) (*alphapb.MonitoringAlphaDashboard, error) {
 	p := ProtoToDashboard(request.GetResource())
-	res, err := c.ApplyDashboard(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDashboard(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7987,6 +7992,60 @@ func (s *DashboardServer) ApplyMonitoringAlphaDashboard(ctx context.Context, req
 	return s.applyDashboard(ctx, cl, request)
 }
 
+// GetMonitoringAlphaDashboard handles the gRPC request by passing it to the underlying Dashboard Get() method.
+func (s *DashboardServer) GetMonitoringAlphaDashboard(ctx context.Context, request *alphapb.GetMonitoringAlphaDashboardRequest) (*alphapb.MonitoringAlphaDashboard, error) {
+	cl, err := createConfigDashboard(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDashboard(ctx, ProtoToDashboard(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DashboardToProto(res), nil
+}
+
+// HasDiffMonitoringAlphaDashboard handles the gRPC request by passing it to the underlying Dashboard Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DashboardServer) HasDiffMonitoringAlphaDashboard(ctx context.Context, request *alphapb.HasDiffMonitoringAlphaDashboardRequest) (*alphapb.HasDiffMonitoringAlphaDashboardResponse, error) {
+	cl, err := createConfigDashboard(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDashboard(ctx, ProtoToDashboard(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffMonitoringAlphaDashboardResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringAlphaDashboard handles the gRPC request by passing it to the underlying Dashboard Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DashboardServer) PlanMonitoringAlphaDashboard(ctx context.Context, request *alphapb.PlanMonitoringAlphaDashboardRequest) (*alphapb.PlanMonitoringAlphaDashboardResponse, error) {
+	cl, err := createConfigDashboard(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDashboard(ctx, ProtoToDashboard(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanMonitoringAlphaDashboardResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDashboard handles the gRPC request by passing it to the underlying Dashboard Delete() method.
 func (s *DashboardServer) DeleteMonitoringAlphaDashboard(ctx context.Context, request *alphapb.DeleteMonitoringAlphaDashboardRequest) (*emptypb.Empty, error) {
 
@@ -8005,22 +8064,35 @@ func (s *DashboardServer) ListMonitoringAlphaDashboard(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListDashboard(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.DashboardMaxPage
+	}
+	resources, err := cl.ListDashboardWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.MonitoringAlphaDashboard
-	for _, r := range resources.Items {
-		rp := DashboardToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DashboardToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListMonitoringAlphaDashboardResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDashboard(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/alpha/monitoring_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/alpha"
@@ -53,7 +54,11 @@ func GroupToProto(resource *alpha.Group) *alphapb.MonitoringAlphaGroup {
 // applyGroup handles the gRPC request by passing it to the underlying Group Apply() method.
 func (s *GroupServer) applyGroup(ctx context.Context, c *alpha.Client, request *alphapb.ApplyMonitoringAlphaGroupRequest) (*alphapb.MonitoringAlphaGroup, error) {
 	p := ProtoToGroup(request.GetResource())
-	res, err := c.ApplyGroup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGroup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +75,60 @@ func (s *GroupServer) ApplyMonitoringAlphaGroup(ctx context.Context, request *al
 	return s.applyGroup(ctx, cl, request)
 }
 
+// GetMonitoringAlphaGroup handles the gRPC request by passing it to the underlying Group Get() method.
+func (s *GroupServer) GetMonitoringAlphaGroup(ctx context.Context, request *alphapb.GetMonitoringAlphaGroupRequest) (*alphapb.MonitoringAlphaGroup, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGroup(ctx, ProtoToGroup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GroupToProto(res), nil
+}
+
+// HasDiffMonitoringAlphaGroup handles the gRPC request by passing it to the underlying Group Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GroupServer) HasDiffMonitoringAlphaGroup(ctx context.Context, request *alphapb.HasDiffMonitoringAlphaGroupRequest) (*alphapb.HasDiffMonitoringAlphaGroupResponse, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGroup(ctx, ProtoToGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffMonitoringAlphaGroupResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringAlphaGroup handles the gRPC request by passing it to the underlying Group Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GroupServer) PlanMonitoringAlphaGroup(ctx context.Context, request *alphapb.PlanMonitoringAlphaGroupRequest) (*alphapb.PlanMonitoringAlphaGroupResponse, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGroup(ctx, ProtoToGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanMonitoringAlphaGroupResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGroup handles the gRPC request by passing it to the underlying Group Delete() method.
 func (s *GroupServer) DeleteMonitoringAlphaGroup(ctx context.Context, request *alphapb.DeleteMonitoringAlphaGroupRequest) (*emptypb.Empty, error) {
 
@@ -88,22 +147,35 @@ func (s *GroupServer) ListMonitoringAlphaGroup(ctx context.Context, request *alp
 		return nil, err
 	}
 
-	resources, err := cl.ListGroup(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.GroupMaxPage
+	}
+	resources, err := cl.ListGroupWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.MonitoringAlphaGroup
-	for _, r := range resources.Items {
-		rp := GroupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, GroupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListMonitoringAlphaGroupResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigGroup(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
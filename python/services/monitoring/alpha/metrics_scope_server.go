@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/alpha/monitoring_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/alpha"
@@ -79,7 +80,11 @@ func MetricsScopeToProto(resource *alpha.MetricsScope) *alphapb.MonitoringAlphaM
 // applyMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Apply() method.
 func (s *MetricsScopeServer) applyMetricsScope(ctx context.Context, c *alpha.Client, request *alphapb.ApplyMonitoringAlphaMetricsScopeRequest) (*alphapb.MonitoringAlphaMetricsScope, error) {
 	p := ProtoToMetricsScope(request.GetResource())
-	res, err := c.ApplyMetricsScope(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMetricsScope(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +101,60 @@ func (s *MetricsScopeServer) ApplyMonitoringAlphaMetricsScope(ctx context.Contex
 	return s.applyMetricsScope(ctx, cl, request)
 }
 
+// GetMonitoringAlphaMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Get() method.
+func (s *MetricsScopeServer) GetMonitoringAlphaMetricsScope(ctx context.Context, request *alphapb.GetMonitoringAlphaMetricsScopeRequest) (*alphapb.MonitoringAlphaMetricsScope, error) {
+	cl, err := createConfigMetricsScope(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMetricsScope(ctx, ProtoToMetricsScope(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MetricsScopeToProto(res), nil
+}
+
+// HasDiffMonitoringAlphaMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MetricsScopeServer) HasDiffMonitoringAlphaMetricsScope(ctx context.Context, request *alphapb.HasDiffMonitoringAlphaMetricsScopeRequest) (*alphapb.HasDiffMonitoringAlphaMetricsScopeResponse, error) {
+	cl, err := createConfigMetricsScope(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetricsScope(ctx, ProtoToMetricsScope(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffMonitoringAlphaMetricsScopeResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringAlphaMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MetricsScopeServer) PlanMonitoringAlphaMetricsScope(ctx context.Context, request *alphapb.PlanMonitoringAlphaMetricsScopeRequest) (*alphapb.PlanMonitoringAlphaMetricsScopeResponse, error) {
+	cl, err := createConfigMetricsScope(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetricsScope(ctx, ProtoToMetricsScope(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanMonitoringAlphaMetricsScopeResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Delete() method.
 func (s *MetricsScopeServer) DeleteMonitoringAlphaMetricsScope(ctx context.Context, request *alphapb.DeleteMonitoringAlphaMetricsScopeRequest) (*emptypb.Empty, error) {
 
@@ -109,7 +168,9 @@ func (s *MetricsScopeServer) ListMonitoringAlphaMetricsScope(_ context.Context,
 }
 
 func createConfigMetricsScope(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
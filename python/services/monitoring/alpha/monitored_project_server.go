@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/alpha/monitoring_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/alpha"
@@ -47,7 +48,11 @@ func MonitoredProjectToProto(resource *alpha.MonitoredProject) *alphapb.Monitori
 // applyMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Apply() method.
 func (s *MonitoredProjectServer) applyMonitoredProject(ctx context.Context, c *alpha.Client, request *alphapb.ApplyMonitoringAlphaMonitoredProjectRequest) (*alphapb.MonitoringAlphaMonitoredProject, error) {
 	p := ProtoToMonitoredProject(request.GetResource())
-	res, err := c.ApplyMonitoredProject(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMonitoredProject(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +69,60 @@ func (s *MonitoredProjectServer) ApplyMonitoringAlphaMonitoredProject(ctx contex
 	return s.applyMonitoredProject(ctx, cl, request)
 }
 
+// GetMonitoringAlphaMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Get() method.
+func (s *MonitoredProjectServer) GetMonitoringAlphaMonitoredProject(ctx context.Context, request *alphapb.GetMonitoringAlphaMonitoredProjectRequest) (*alphapb.MonitoringAlphaMonitoredProject, error) {
+	cl, err := createConfigMonitoredProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMonitoredProject(ctx, ProtoToMonitoredProject(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MonitoredProjectToProto(res), nil
+}
+
+// HasDiffMonitoringAlphaMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MonitoredProjectServer) HasDiffMonitoringAlphaMonitoredProject(ctx context.Context, request *alphapb.HasDiffMonitoringAlphaMonitoredProjectRequest) (*alphapb.HasDiffMonitoringAlphaMonitoredProjectResponse, error) {
+	cl, err := createConfigMonitoredProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMonitoredProject(ctx, ProtoToMonitoredProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffMonitoringAlphaMonitoredProjectResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringAlphaMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MonitoredProjectServer) PlanMonitoringAlphaMonitoredProject(ctx context.Context, request *alphapb.PlanMonitoringAlphaMonitoredProjectRequest) (*alphapb.PlanMonitoringAlphaMonitoredProjectResponse, error) {
+	cl, err := createConfigMonitoredProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMonitoredProject(ctx, ProtoToMonitoredProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanMonitoringAlphaMonitoredProjectResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Delete() method.
 func (s *MonitoredProjectServer) DeleteMonitoringAlphaMonitoredProject(ctx context.Context, request *alphapb.DeleteMonitoringAlphaMonitoredProjectRequest) (*emptypb.Empty, error) {
 
@@ -82,22 +141,35 @@ func (s *MonitoredProjectServer) ListMonitoringAlphaMonitoredProject(ctx context
 		return nil, err
 	}
 
-	resources, err := cl.ListMonitoredProject(ctx, request.GetMetricsScope())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.MonitoredProjectMaxPage
+	}
+	resources, err := cl.ListMonitoredProjectWithPageToken(ctx, request.GetMetricsScope(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.MonitoringAlphaMonitoredProject
-	for _, r := range resources.Items {
-		rp := MonitoredProjectToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MonitoredProjectToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListMonitoringAlphaMonitoredProjectResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMonitoredProject(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/alpha/monitoring_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/alpha"
@@ -808,7 +809,11 @@ func ServiceLevelObjectiveToProto(resource *alpha.ServiceLevelObjective) *alphap
 // applyServiceLevelObjective handles the gRPC request by passing it to the underlying ServiceLevelObjective Apply() method.
 func (s *ServiceLevelObjectiveServer) applyServiceLevelObjective(ctx context.Context, c *alpha.Client, request *alphapb.ApplyMonitoringAlphaServiceLevelObjectiveRequest) (*alphapb.MonitoringAlphaServiceLevelObjective, error) {
 	p := ProtoToServiceLevelObjective(request.GetResource())
-	res, err := c.ApplyServiceLevelObjective(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyServiceLevelObjective(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -825,6 +830,60 @@ func (s *ServiceLevelObjectiveServer) ApplyMonitoringAlphaServiceLevelObjective(
 	return s.applyServiceLevelObjective(ctx, cl, request)
 }
 
+// GetMonitoringAlphaServiceLevelObjective handles the gRPC request by passing it to the underlying ServiceLevelObjective Get() method.
+func (s *ServiceLevelObjectiveServer) GetMonitoringAlphaServiceLevelObjective(ctx context.Context, request *alphapb.GetMonitoringAlphaServiceLevelObjectiveRequest) (*alphapb.MonitoringAlphaServiceLevelObjective, error) {
+	cl, err := createConfigServiceLevelObjective(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetServiceLevelObjective(ctx, ProtoToServiceLevelObjective(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceLevelObjectiveToProto(res), nil
+}
+
+// HasDiffMonitoringAlphaServiceLevelObjective handles the gRPC request by passing it to the underlying ServiceLevelObjective Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceLevelObjectiveServer) HasDiffMonitoringAlphaServiceLevelObjective(ctx context.Context, request *alphapb.HasDiffMonitoringAlphaServiceLevelObjectiveRequest) (*alphapb.HasDiffMonitoringAlphaServiceLevelObjectiveResponse, error) {
+	cl, err := createConfigServiceLevelObjective(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceLevelObjective(ctx, ProtoToServiceLevelObjective(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffMonitoringAlphaServiceLevelObjectiveResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringAlphaServiceLevelObjective handles the gRPC request by passing it to the underlying ServiceLevelObjective Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceLevelObjectiveServer) PlanMonitoringAlphaServiceLevelObjective(ctx context.Context, request *alphapb.PlanMonitoringAlphaServiceLevelObjectiveRequest) (*alphapb.PlanMonitoringAlphaServiceLevelObjectiveResponse, error) {
+	cl, err := createConfigServiceLevelObjective(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceLevelObjective(ctx, ProtoToServiceLevelObjective(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanMonitoringAlphaServiceLevelObjectiveResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteServiceLevelObjective handles the gRPC request by passing it to the underlying ServiceLevelObjective Delete() method.
 func (s *ServiceLevelObjectiveServer) DeleteMonitoringAlphaServiceLevelObjective(ctx context.Context, request *alphapb.DeleteMonitoringAlphaServiceLevelObjectiveRequest) (*emptypb.Empty, error) {
 
@@ -843,22 +902,35 @@ func (s *ServiceLevelObjectiveServer) ListMonitoringAlphaServiceLevelObjective(c
 		return nil, err
 	}
 
-	resources, err := cl.ListServiceLevelObjective(ctx, request.GetProject(), request.GetService())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ServiceLevelObjectiveMaxPage
+	}
+	resources, err := cl.ListServiceLevelObjectiveWithPageToken(ctx, request.GetProject(), request.GetService(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.MonitoringAlphaServiceLevelObjective
-	for _, r := range resources.Items {
-		rp := ServiceLevelObjectiveToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceLevelObjectiveToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListMonitoringAlphaServiceLevelObjectiveResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigServiceLevelObjective(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
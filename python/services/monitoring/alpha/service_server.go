@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/alpha/monitoring_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/alpha"
@@ -95,7 +96,11 @@ func ServiceToProto(resource *alpha.Service) *alphapb.MonitoringAlphaService {
 // applyService handles the gRPC request by passing it to the underlying Service Apply() method.
 func (s *ServiceServer) applyService(ctx context.Context, c *alpha.Client, request *alphapb.ApplyMonitoringAlphaServiceRequest) (*alphapb.MonitoringAlphaService, error) {
 	p := ProtoToService(request.GetResource())
-	res, err := c.ApplyService(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyService(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +117,60 @@ func (s *ServiceServer) ApplyMonitoringAlphaService(ctx context.Context, request
 	return s.applyService(ctx, cl, request)
 }
 
+// GetMonitoringAlphaService handles the gRPC request by passing it to the underlying Service Get() method.
+func (s *ServiceServer) GetMonitoringAlphaService(ctx context.Context, request *alphapb.GetMonitoringAlphaServiceRequest) (*alphapb.MonitoringAlphaService, error) {
+	cl, err := createConfigService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetService(ctx, ProtoToService(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceToProto(res), nil
+}
+
+// HasDiffMonitoringAlphaService handles the gRPC request by passing it to the underlying Service Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceServer) HasDiffMonitoringAlphaService(ctx context.Context, request *alphapb.HasDiffMonitoringAlphaServiceRequest) (*alphapb.HasDiffMonitoringAlphaServiceResponse, error) {
+	cl, err := createConfigService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffService(ctx, ProtoToService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffMonitoringAlphaServiceResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringAlphaService handles the gRPC request by passing it to the underlying Service Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceServer) PlanMonitoringAlphaService(ctx context.Context, request *alphapb.PlanMonitoringAlphaServiceRequest) (*alphapb.PlanMonitoringAlphaServiceResponse, error) {
+	cl, err := createConfigService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffService(ctx, ProtoToService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanMonitoringAlphaServiceResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteService handles the gRPC request by passing it to the underlying Service Delete() method.
 func (s *ServiceServer) DeleteMonitoringAlphaService(ctx context.Context, request *alphapb.DeleteMonitoringAlphaServiceRequest) (*emptypb.Empty, error) {
 
@@ -130,22 +189,35 @@ func (s *ServiceServer) ListMonitoringAlphaService(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListService(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ServiceMaxPage
+	}
+	resources, err := cl.ListServiceWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.MonitoringAlphaService
-	for _, r := range resources.Items {
-		rp := ServiceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListMonitoringAlphaServiceResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigService(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/alpha/monitoring_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/alpha"
@@ -325,7 +326,11 @@ func UptimeCheckConfigToProto(resource *alpha.UptimeCheckConfig) *alphapb.Monito
 // applyUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Apply() method.
 func (s *UptimeCheckConfigServer) applyUptimeCheckConfig(ctx context.Context, c *alpha.Client, request *alphapb.ApplyMonitoringAlphaUptimeCheckConfigRequest) (*alphapb.MonitoringAlphaUptimeCheckConfig, error) {
 	p := ProtoToUptimeCheckConfig(request.GetResource())
-	res, err := c.ApplyUptimeCheckConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyUptimeCheckConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -342,6 +347,60 @@ func (s *UptimeCheckConfigServer) ApplyMonitoringAlphaUptimeCheckConfig(ctx cont
 	return s.applyUptimeCheckConfig(ctx, cl, request)
 }
 
+// GetMonitoringAlphaUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Get() method.
+func (s *UptimeCheckConfigServer) GetMonitoringAlphaUptimeCheckConfig(ctx context.Context, request *alphapb.GetMonitoringAlphaUptimeCheckConfigRequest) (*alphapb.MonitoringAlphaUptimeCheckConfig, error) {
+	cl, err := createConfigUptimeCheckConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetUptimeCheckConfig(ctx, ProtoToUptimeCheckConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return UptimeCheckConfigToProto(res), nil
+}
+
+// HasDiffMonitoringAlphaUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *UptimeCheckConfigServer) HasDiffMonitoringAlphaUptimeCheckConfig(ctx context.Context, request *alphapb.HasDiffMonitoringAlphaUptimeCheckConfigRequest) (*alphapb.HasDiffMonitoringAlphaUptimeCheckConfigResponse, error) {
+	cl, err := createConfigUptimeCheckConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUptimeCheckConfig(ctx, ProtoToUptimeCheckConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffMonitoringAlphaUptimeCheckConfigResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringAlphaUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *UptimeCheckConfigServer) PlanMonitoringAlphaUptimeCheckConfig(ctx context.Context, request *alphapb.PlanMonitoringAlphaUptimeCheckConfigRequest) (*alphapb.PlanMonitoringAlphaUptimeCheckConfigResponse, error) {
+	cl, err := createConfigUptimeCheckConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUptimeCheckConfig(ctx, ProtoToUptimeCheckConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanMonitoringAlphaUptimeCheckConfigResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Delete() method.
 func (s *UptimeCheckConfigServer) DeleteMonitoringAlphaUptimeCheckConfig(ctx context.Context, request *alphapb.DeleteMonitoringAlphaUptimeCheckConfigRequest) (*emptypb.Empty, error) {
 
@@ -360,22 +419,35 @@ func (s *UptimeCheckConfigServer) ListMonitoringAlphaUptimeCheckConfig(ctx conte
 		return nil, err
 	}
 
-	resources, err := cl.ListUptimeCheckConfig(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.UptimeCheckConfigMaxPage
+	}
+	resources, err := cl.ListUptimeCheckConfigWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.MonitoringAlphaUptimeCheckConfig
-	for _, r := range resources.Items {
-		rp := UptimeCheckConfigToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, UptimeCheckConfigToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListMonitoringAlphaUptimeCheckConfigResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigUptimeCheckConfig(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
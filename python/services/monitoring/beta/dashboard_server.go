@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/beta/monitoring_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/beta"
@@ -7970,7 +7971,11 @@ func DashboardToProto(resource *beta.Dashboard) *betapb.MonitoringBetaDashboard
 // applyDashboard handles the gRPC request by passing it to the underlying Dashboard Apply() method.
 func (s *DashboardServer) applyDashboard(ctx context.Context, c *beta.Client, request *betapb.ApplyMonitoringBetaDashboardRequest) (*betapb.MonitoringBetaDashboard, error) {
 	p := ProtoToDashboard(request.GetResource())
-	res, err := c.ApplyDashboard(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDashboard(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7987,6 +7992,60 @@ func (s *DashboardServer) ApplyMonitoringBetaDashboard(ctx context.Context, requ
 	return s.applyDashboard(ctx, cl, request)
 }
 
+// GetMonitoringBetaDashboard handles the gRPC request by passing it to the underlying Dashboard Get() method.
+func (s *DashboardServer) GetMonitoringBetaDashboard(ctx context.Context, request *betapb.GetMonitoringBetaDashboardRequest) (*betapb.MonitoringBetaDashboard, error) {
+	cl, err := createConfigDashboard(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDashboard(ctx, ProtoToDashboard(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DashboardToProto(res), nil
+}
+
+// HasDiffMonitoringBetaDashboard handles the gRPC request by passing it to the underlying Dashboard Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DashboardServer) HasDiffMonitoringBetaDashboard(ctx context.Context, request *betapb.HasDiffMonitoringBetaDashboardRequest) (*betapb.HasDiffMonitoringBetaDashboardResponse, error) {
+	cl, err := createConfigDashboard(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDashboard(ctx, ProtoToDashboard(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffMonitoringBetaDashboardResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringBetaDashboard handles the gRPC request by passing it to the underlying Dashboard Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DashboardServer) PlanMonitoringBetaDashboard(ctx context.Context, request *betapb.PlanMonitoringBetaDashboardRequest) (*betapb.PlanMonitoringBetaDashboardResponse, error) {
+	cl, err := createConfigDashboard(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDashboard(ctx, ProtoToDashboard(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanMonitoringBetaDashboardResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDashboard handles the gRPC request by passing it to the underlying Dashboard Delete() method.
 func (s *DashboardServer) DeleteMonitoringBetaDashboard(ctx context.Context, request *betapb.DeleteMonitoringBetaDashboardRequest) (*emptypb.Empty, error) {
 
@@ -8005,22 +8064,35 @@ func (s *DashboardServer) ListMonitoringBetaDashboard(ctx context.Context, reque
 		return nil, err
 	}
 
-	resources, err := cl.ListDashboard(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.DashboardMaxPage
+	}
+	resources, err := cl.ListDashboardWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.MonitoringBetaDashboard
-	for _, r := range resources.Items {
-		rp := DashboardToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DashboardToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListMonitoringBetaDashboardResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDashboard(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
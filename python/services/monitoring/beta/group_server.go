@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/beta/monitoring_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/beta"
@@ -53,7 +54,11 @@ func GroupToProto(resource *beta.Group) *betapb.MonitoringBetaGroup {
 // applyGroup handles the gRPC request by passing it to the underlying Group Apply() method.
 func (s *GroupServer) applyGroup(ctx context.Context, c *beta.Client, request *betapb.ApplyMonitoringBetaGroupRequest) (*betapb.MonitoringBetaGroup, error) {
 	p := ProtoToGroup(request.GetResource())
-	res, err := c.ApplyGroup(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGroup(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +75,60 @@ func (s *GroupServer) ApplyMonitoringBetaGroup(ctx context.Context, request *bet
 	return s.applyGroup(ctx, cl, request)
 }
 
+// GetMonitoringBetaGroup handles the gRPC request by passing it to the underlying Group Get() method.
+func (s *GroupServer) GetMonitoringBetaGroup(ctx context.Context, request *betapb.GetMonitoringBetaGroupRequest) (*betapb.MonitoringBetaGroup, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGroup(ctx, ProtoToGroup(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GroupToProto(res), nil
+}
+
+// HasDiffMonitoringBetaGroup handles the gRPC request by passing it to the underlying Group Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GroupServer) HasDiffMonitoringBetaGroup(ctx context.Context, request *betapb.HasDiffMonitoringBetaGroupRequest) (*betapb.HasDiffMonitoringBetaGroupResponse, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGroup(ctx, ProtoToGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffMonitoringBetaGroupResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringBetaGroup handles the gRPC request by passing it to the underlying Group Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GroupServer) PlanMonitoringBetaGroup(ctx context.Context, request *betapb.PlanMonitoringBetaGroupRequest) (*betapb.PlanMonitoringBetaGroupResponse, error) {
+	cl, err := createConfigGroup(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGroup(ctx, ProtoToGroup(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanMonitoringBetaGroupResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGroup handles the gRPC request by passing it to the underlying Group Delete() method.
 func (s *GroupServer) DeleteMonitoringBetaGroup(ctx context.Context, request *betapb.DeleteMonitoringBetaGroupRequest) (*emptypb.Empty, error) {
 
@@ -88,22 +147,35 @@ func (s *GroupServer) ListMonitoringBetaGroup(ctx context.Context, request *beta
 		return nil, err
 	}
 
-	resources, err := cl.ListGroup(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.GroupMaxPage
+	}
+	resources, err := cl.ListGroupWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.MonitoringBetaGroup
-	for _, r := range resources.Items {
-		rp := GroupToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, GroupToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListMonitoringBetaGroupResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigGroup(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/beta/monitoring_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/beta"
@@ -242,7 +243,11 @@ func MetricDescriptorToProto(resource *beta.MetricDescriptor) *betapb.Monitoring
 // applyMetricDescriptor handles the gRPC request by passing it to the underlying MetricDescriptor Apply() method.
 func (s *MetricDescriptorServer) applyMetricDescriptor(ctx context.Context, c *beta.Client, request *betapb.ApplyMonitoringBetaMetricDescriptorRequest) (*betapb.MonitoringBetaMetricDescriptor, error) {
 	p := ProtoToMetricDescriptor(request.GetResource())
-	res, err := c.ApplyMetricDescriptor(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMetricDescriptor(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -259,6 +264,60 @@ func (s *MetricDescriptorServer) ApplyMonitoringBetaMetricDescriptor(ctx context
 	return s.applyMetricDescriptor(ctx, cl, request)
 }
 
+// GetMonitoringBetaMetricDescriptor handles the gRPC request by passing it to the underlying MetricDescriptor Get() method.
+func (s *MetricDescriptorServer) GetMonitoringBetaMetricDescriptor(ctx context.Context, request *betapb.GetMonitoringBetaMetricDescriptorRequest) (*betapb.MonitoringBetaMetricDescriptor, error) {
+	cl, err := createConfigMetricDescriptor(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMetricDescriptor(ctx, ProtoToMetricDescriptor(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MetricDescriptorToProto(res), nil
+}
+
+// HasDiffMonitoringBetaMetricDescriptor handles the gRPC request by passing it to the underlying MetricDescriptor Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MetricDescriptorServer) HasDiffMonitoringBetaMetricDescriptor(ctx context.Context, request *betapb.HasDiffMonitoringBetaMetricDescriptorRequest) (*betapb.HasDiffMonitoringBetaMetricDescriptorResponse, error) {
+	cl, err := createConfigMetricDescriptor(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetricDescriptor(ctx, ProtoToMetricDescriptor(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffMonitoringBetaMetricDescriptorResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringBetaMetricDescriptor handles the gRPC request by passing it to the underlying MetricDescriptor Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MetricDescriptorServer) PlanMonitoringBetaMetricDescriptor(ctx context.Context, request *betapb.PlanMonitoringBetaMetricDescriptorRequest) (*betapb.PlanMonitoringBetaMetricDescriptorResponse, error) {
+	cl, err := createConfigMetricDescriptor(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetricDescriptor(ctx, ProtoToMetricDescriptor(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanMonitoringBetaMetricDescriptorResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMetricDescriptor handles the gRPC request by passing it to the underlying MetricDescriptor Delete() method.
 func (s *MetricDescriptorServer) DeleteMonitoringBetaMetricDescriptor(ctx context.Context, request *betapb.DeleteMonitoringBetaMetricDescriptorRequest) (*emptypb.Empty, error) {
 
@@ -277,22 +336,35 @@ func (s *MetricDescriptorServer) ListMonitoringBetaMetricDescriptor(ctx context.
 		return nil, err
 	}
 
-	resources, err := cl.ListMetricDescriptor(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.MetricDescriptorMaxPage
+	}
+	resources, err := cl.ListMetricDescriptorWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.MonitoringBetaMetricDescriptor
-	for _, r := range resources.Items {
-		rp := MetricDescriptorToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MetricDescriptorToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListMonitoringBetaMetricDescriptorResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMetricDescriptor(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
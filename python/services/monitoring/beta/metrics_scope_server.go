@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/beta/monitoring_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/beta"
@@ -79,7 +80,11 @@ func MetricsScopeToProto(resource *beta.MetricsScope) *betapb.MonitoringBetaMetr
 // applyMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Apply() method.
 func (s *MetricsScopeServer) applyMetricsScope(ctx context.Context, c *beta.Client, request *betapb.ApplyMonitoringBetaMetricsScopeRequest) (*betapb.MonitoringBetaMetricsScope, error) {
 	p := ProtoToMetricsScope(request.GetResource())
-	res, err := c.ApplyMetricsScope(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMetricsScope(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +101,60 @@ func (s *MetricsScopeServer) ApplyMonitoringBetaMetricsScope(ctx context.Context
 	return s.applyMetricsScope(ctx, cl, request)
 }
 
+// GetMonitoringBetaMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Get() method.
+func (s *MetricsScopeServer) GetMonitoringBetaMetricsScope(ctx context.Context, request *betapb.GetMonitoringBetaMetricsScopeRequest) (*betapb.MonitoringBetaMetricsScope, error) {
+	cl, err := createConfigMetricsScope(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMetricsScope(ctx, ProtoToMetricsScope(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MetricsScopeToProto(res), nil
+}
+
+// HasDiffMonitoringBetaMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MetricsScopeServer) HasDiffMonitoringBetaMetricsScope(ctx context.Context, request *betapb.HasDiffMonitoringBetaMetricsScopeRequest) (*betapb.HasDiffMonitoringBetaMetricsScopeResponse, error) {
+	cl, err := createConfigMetricsScope(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetricsScope(ctx, ProtoToMetricsScope(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffMonitoringBetaMetricsScopeResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringBetaMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MetricsScopeServer) PlanMonitoringBetaMetricsScope(ctx context.Context, request *betapb.PlanMonitoringBetaMetricsScopeRequest) (*betapb.PlanMonitoringBetaMetricsScopeResponse, error) {
+	cl, err := createConfigMetricsScope(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetricsScope(ctx, ProtoToMetricsScope(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanMonitoringBetaMetricsScopeResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Delete() method.
 func (s *MetricsScopeServer) DeleteMonitoringBetaMetricsScope(ctx context.Context, request *betapb.DeleteMonitoringBetaMetricsScopeRequest) (*emptypb.Empty, error) {
 
@@ -109,7 +168,9 @@ func (s *MetricsScopeServer) ListMonitoringBetaMetricsScope(_ context.Context, _
 }
 
 func createConfigMetricsScope(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/beta/monitoring_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/beta"
@@ -47,7 +48,11 @@ func MonitoredProjectToProto(resource *beta.MonitoredProject) *betapb.Monitoring
 // applyMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Apply() method.
 func (s *MonitoredProjectServer) applyMonitoredProject(ctx context.Context, c *beta.Client, request *betapb.ApplyMonitoringBetaMonitoredProjectRequest) (*betapb.MonitoringBetaMonitoredProject, error) {
 	p := ProtoToMonitoredProject(request.GetResource())
-	res, err := c.ApplyMonitoredProject(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMonitoredProject(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +69,60 @@ func (s *MonitoredProjectServer) ApplyMonitoringBetaMonitoredProject(ctx context
 	return s.applyMonitoredProject(ctx, cl, request)
 }
 
+// GetMonitoringBetaMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Get() method.
+func (s *MonitoredProjectServer) GetMonitoringBetaMonitoredProject(ctx context.Context, request *betapb.GetMonitoringBetaMonitoredProjectRequest) (*betapb.MonitoringBetaMonitoredProject, error) {
+	cl, err := createConfigMonitoredProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMonitoredProject(ctx, ProtoToMonitoredProject(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MonitoredProjectToProto(res), nil
+}
+
+// HasDiffMonitoringBetaMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MonitoredProjectServer) HasDiffMonitoringBetaMonitoredProject(ctx context.Context, request *betapb.HasDiffMonitoringBetaMonitoredProjectRequest) (*betapb.HasDiffMonitoringBetaMonitoredProjectResponse, error) {
+	cl, err := createConfigMonitoredProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMonitoredProject(ctx, ProtoToMonitoredProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffMonitoringBetaMonitoredProjectResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringBetaMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MonitoredProjectServer) PlanMonitoringBetaMonitoredProject(ctx context.Context, request *betapb.PlanMonitoringBetaMonitoredProjectRequest) (*betapb.PlanMonitoringBetaMonitoredProjectResponse, error) {
+	cl, err := createConfigMonitoredProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMonitoredProject(ctx, ProtoToMonitoredProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanMonitoringBetaMonitoredProjectResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Delete() method.
 func (s *MonitoredProjectServer) DeleteMonitoringBetaMonitoredProject(ctx context.Context, request *betapb.DeleteMonitoringBetaMonitoredProjectRequest) (*emptypb.Empty, error) {
 
@@ -82,22 +141,35 @@ func (s *MonitoredProjectServer) ListMonitoringBetaMonitoredProject(ctx context.
 		return nil, err
 	}
 
-	resources, err := cl.ListMonitoredProject(ctx, request.GetMetricsScope())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.MonitoredProjectMaxPage
+	}
+	resources, err := cl.ListMonitoredProjectWithPageToken(ctx, request.GetMetricsScope(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.MonitoringBetaMonitoredProject
-	for _, r := range resources.Items {
-		rp := MonitoredProjectToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MonitoredProjectToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListMonitoringBetaMonitoredProjectResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMonitoredProject(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/beta/monitoring_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/beta"
@@ -88,7 +89,11 @@ func NotificationChannelToProto(resource *beta.NotificationChannel) *betapb.Moni
 // applyNotificationChannel handles the gRPC request by passing it to the underlying NotificationChannel Apply() method.
 func (s *NotificationChannelServer) applyNotificationChannel(ctx context.Context, c *beta.Client, request *betapb.ApplyMonitoringBetaNotificationChannelRequest) (*betapb.MonitoringBetaNotificationChannel, error) {
 	p := ProtoToNotificationChannel(request.GetResource())
-	res, err := c.ApplyNotificationChannel(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNotificationChannel(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +110,60 @@ func (s *NotificationChannelServer) ApplyMonitoringBetaNotificationChannel(ctx c
 	return s.applyNotificationChannel(ctx, cl, request)
 }
 
+// GetMonitoringBetaNotificationChannel handles the gRPC request by passing it to the underlying NotificationChannel Get() method.
+func (s *NotificationChannelServer) GetMonitoringBetaNotificationChannel(ctx context.Context, request *betapb.GetMonitoringBetaNotificationChannelRequest) (*betapb.MonitoringBetaNotificationChannel, error) {
+	cl, err := createConfigNotificationChannel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNotificationChannel(ctx, ProtoToNotificationChannel(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NotificationChannelToProto(res), nil
+}
+
+// HasDiffMonitoringBetaNotificationChannel handles the gRPC request by passing it to the underlying NotificationChannel Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NotificationChannelServer) HasDiffMonitoringBetaNotificationChannel(ctx context.Context, request *betapb.HasDiffMonitoringBetaNotificationChannelRequest) (*betapb.HasDiffMonitoringBetaNotificationChannelResponse, error) {
+	cl, err := createConfigNotificationChannel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNotificationChannel(ctx, ProtoToNotificationChannel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffMonitoringBetaNotificationChannelResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringBetaNotificationChannel handles the gRPC request by passing it to the underlying NotificationChannel Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NotificationChannelServer) PlanMonitoringBetaNotificationChannel(ctx context.Context, request *betapb.PlanMonitoringBetaNotificationChannelRequest) (*betapb.PlanMonitoringBetaNotificationChannelResponse, error) {
+	cl, err := createConfigNotificationChannel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNotificationChannel(ctx, ProtoToNotificationChannel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanMonitoringBetaNotificationChannelResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteNotificationChannel handles the gRPC request by passing it to the underlying NotificationChannel Delete() method.
 func (s *NotificationChannelServer) DeleteMonitoringBetaNotificationChannel(ctx context.Context, request *betapb.DeleteMonitoringBetaNotificationChannelRequest) (*emptypb.Empty, error) {
 
@@ -123,22 +182,35 @@ func (s *NotificationChannelServer) ListMonitoringBetaNotificationChannel(ctx co
 		return nil, err
 	}
 
-	resources, err := cl.ListNotificationChannel(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.NotificationChannelMaxPage
+	}
+	resources, err := cl.ListNotificationChannelWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.MonitoringBetaNotificationChannel
-	for _, r := range resources.Items {
-		rp := NotificationChannelToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NotificationChannelToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListMonitoringBetaNotificationChannelResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigNotificationChannel(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/beta/monitoring_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring/beta"
@@ -325,7 +326,11 @@ func UptimeCheckConfigToProto(resource *beta.UptimeCheckConfig) *betapb.Monitori
 // applyUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Apply() method.
 func (s *UptimeCheckConfigServer) applyUptimeCheckConfig(ctx context.Context, c *beta.Client, request *betapb.ApplyMonitoringBetaUptimeCheckConfigRequest) (*betapb.MonitoringBetaUptimeCheckConfig, error) {
 	p := ProtoToUptimeCheckConfig(request.GetResource())
-	res, err := c.ApplyUptimeCheckConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyUptimeCheckConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -342,6 +347,60 @@ func (s *UptimeCheckConfigServer) ApplyMonitoringBetaUptimeCheckConfig(ctx conte
 	return s.applyUptimeCheckConfig(ctx, cl, request)
 }
 
+// GetMonitoringBetaUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Get() method.
+func (s *UptimeCheckConfigServer) GetMonitoringBetaUptimeCheckConfig(ctx context.Context, request *betapb.GetMonitoringBetaUptimeCheckConfigRequest) (*betapb.MonitoringBetaUptimeCheckConfig, error) {
+	cl, err := createConfigUptimeCheckConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetUptimeCheckConfig(ctx, ProtoToUptimeCheckConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return UptimeCheckConfigToProto(res), nil
+}
+
+// HasDiffMonitoringBetaUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *UptimeCheckConfigServer) HasDiffMonitoringBetaUptimeCheckConfig(ctx context.Context, request *betapb.HasDiffMonitoringBetaUptimeCheckConfigRequest) (*betapb.HasDiffMonitoringBetaUptimeCheckConfigResponse, error) {
+	cl, err := createConfigUptimeCheckConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUptimeCheckConfig(ctx, ProtoToUptimeCheckConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffMonitoringBetaUptimeCheckConfigResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringBetaUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *UptimeCheckConfigServer) PlanMonitoringBetaUptimeCheckConfig(ctx context.Context, request *betapb.PlanMonitoringBetaUptimeCheckConfigRequest) (*betapb.PlanMonitoringBetaUptimeCheckConfigResponse, error) {
+	cl, err := createConfigUptimeCheckConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUptimeCheckConfig(ctx, ProtoToUptimeCheckConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanMonitoringBetaUptimeCheckConfigResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Delete() method.
 func (s *UptimeCheckConfigServer) DeleteMonitoringBetaUptimeCheckConfig(ctx context.Context, request *betapb.DeleteMonitoringBetaUptimeCheckConfigRequest) (*emptypb.Empty, error) {
 
@@ -360,22 +419,35 @@ func (s *UptimeCheckConfigServer) ListMonitoringBetaUptimeCheckConfig(ctx contex
 		return nil, err
 	}
 
-	resources, err := cl.ListUptimeCheckConfig(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.UptimeCheckConfigMaxPage
+	}
+	resources, err := cl.ListUptimeCheckConfigWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.MonitoringBetaUptimeCheckConfig
-	for _, r := range resources.Items {
-		rp := UptimeCheckConfigToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, UptimeCheckConfigToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListMonitoringBetaUptimeCheckConfigResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigUptimeCheckConfig(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	monitoringpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/monitoring_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring"
@@ -7970,7 +7971,11 @@ func DashboardToProto(resource *monitoring.Dashboard) *monitoringpb.MonitoringDa
 // applyDashboard handles the gRPC request by passing it to the underlying Dashboard Apply() method.
 func (s *DashboardServer) applyDashboard(ctx context.Context, c *monitoring.Client, request *monitoringpb.ApplyMonitoringDashboardRequest) (*monitoringpb.MonitoringDashboard, error) {
 	p := ProtoToDashboard(request.GetResource())
-	res, err := c.ApplyDashboard(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDashboard(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7987,6 +7992,60 @@ func (s *DashboardServer) ApplyMonitoringDashboard(ctx context.Context, request
 	return s.applyDashboard(ctx, cl, request)
 }
 
+// GetMonitoringDashboard handles the gRPC request by passing it to the underlying Dashboard Get() method.
+func (s *DashboardServer) GetMonitoringDashboard(ctx context.Context, request *monitoringpb.GetMonitoringDashboardRequest) (*monitoringpb.MonitoringDashboard, error) {
+	cl, err := createConfigDashboard(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDashboard(ctx, ProtoToDashboard(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DashboardToProto(res), nil
+}
+
+// HasDiffMonitoringDashboard handles the gRPC request by passing it to the underlying Dashboard Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DashboardServer) HasDiffMonitoringDashboard(ctx context.Context, request *monitoringpb.HasDiffMonitoringDashboardRequest) (*monitoringpb.HasDiffMonitoringDashboardResponse, error) {
+	cl, err := createConfigDashboard(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDashboard(ctx, ProtoToDashboard(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.HasDiffMonitoringDashboardResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringDashboard handles the gRPC request by passing it to the underlying Dashboard Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DashboardServer) PlanMonitoringDashboard(ctx context.Context, request *monitoringpb.PlanMonitoringDashboardRequest) (*monitoringpb.PlanMonitoringDashboardResponse, error) {
+	cl, err := createConfigDashboard(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDashboard(ctx, ProtoToDashboard(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.PlanMonitoringDashboardResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteDashboard handles the gRPC request by passing it to the underlying Dashboard Delete() method.
 func (s *DashboardServer) DeleteMonitoringDashboard(ctx context.Context, request *monitoringpb.DeleteMonitoringDashboardRequest) (*emptypb.Empty, error) {
 
@@ -8005,22 +8064,35 @@ func (s *DashboardServer) ListMonitoringDashboard(ctx context.Context, request *
 		return nil, err
 	}
 
-	resources, err := cl.ListDashboard(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = monitoring.DashboardMaxPage
+	}
+	resources, err := cl.ListDashboardWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*monitoringpb.MonitoringDashboard
-	for _, r := range resources.Items {
-		rp := DashboardToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DashboardToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &monitoringpb.ListMonitoringDashboardResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigDashboard(ctx context.Context, service_account_file string) (*monitoring.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return monitoring.NewClient(conf), nil
 }
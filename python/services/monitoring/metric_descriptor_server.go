@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	monitoringpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/monitoring_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring"
@@ -242,7 +243,11 @@ func MetricDescriptorToProto(resource *monitoring.MetricDescriptor) *monitoringp
 // applyMetricDescriptor handles the gRPC request by passing it to the underlying MetricDescriptor Apply() method.
 func (s *MetricDescriptorServer) applyMetricDescriptor(ctx context.Context, c *monitoring.Client, request *monitoringpb.ApplyMonitoringMetricDescriptorRequest) (*monitoringpb.MonitoringMetricDescriptor, error) {
 	p := ProtoToMetricDescriptor(request.GetResource())
-	res, err := c.ApplyMetricDescriptor(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMetricDescriptor(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -259,6 +264,60 @@ func (s *MetricDescriptorServer) ApplyMonitoringMetricDescriptor(ctx context.Con
 	return s.applyMetricDescriptor(ctx, cl, request)
 }
 
+// GetMonitoringMetricDescriptor handles the gRPC request by passing it to the underlying MetricDescriptor Get() method.
+func (s *MetricDescriptorServer) GetMonitoringMetricDescriptor(ctx context.Context, request *monitoringpb.GetMonitoringMetricDescriptorRequest) (*monitoringpb.MonitoringMetricDescriptor, error) {
+	cl, err := createConfigMetricDescriptor(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMetricDescriptor(ctx, ProtoToMetricDescriptor(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MetricDescriptorToProto(res), nil
+}
+
+// HasDiffMonitoringMetricDescriptor handles the gRPC request by passing it to the underlying MetricDescriptor Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MetricDescriptorServer) HasDiffMonitoringMetricDescriptor(ctx context.Context, request *monitoringpb.HasDiffMonitoringMetricDescriptorRequest) (*monitoringpb.HasDiffMonitoringMetricDescriptorResponse, error) {
+	cl, err := createConfigMetricDescriptor(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetricDescriptor(ctx, ProtoToMetricDescriptor(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.HasDiffMonitoringMetricDescriptorResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringMetricDescriptor handles the gRPC request by passing it to the underlying MetricDescriptor Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MetricDescriptorServer) PlanMonitoringMetricDescriptor(ctx context.Context, request *monitoringpb.PlanMonitoringMetricDescriptorRequest) (*monitoringpb.PlanMonitoringMetricDescriptorResponse, error) {
+	cl, err := createConfigMetricDescriptor(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetricDescriptor(ctx, ProtoToMetricDescriptor(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.PlanMonitoringMetricDescriptorResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMetricDescriptor handles the gRPC request by passing it to the underlying MetricDescriptor Delete() method.
 func (s *MetricDescriptorServer) DeleteMonitoringMetricDescriptor(ctx context.Context, request *monitoringpb.DeleteMonitoringMetricDescriptorRequest) (*emptypb.Empty, error) {
 
@@ -277,22 +336,35 @@ func (s *MetricDescriptorServer) ListMonitoringMetricDescriptor(ctx context.Cont
 		return nil, err
 	}
 
-	resources, err := cl.ListMetricDescriptor(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = monitoring.MetricDescriptorMaxPage
+	}
+	resources, err := cl.ListMetricDescriptorWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*monitoringpb.MonitoringMetricDescriptor
-	for _, r := range resources.Items {
-		rp := MetricDescriptorToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MetricDescriptorToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &monitoringpb.ListMonitoringMetricDescriptorResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMetricDescriptor(ctx context.Context, service_account_file string) (*monitoring.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return monitoring.NewClient(conf), nil
 }
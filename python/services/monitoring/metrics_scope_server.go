@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	monitoringpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/monitoring_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring"
@@ -79,7 +80,11 @@ func MetricsScopeToProto(resource *monitoring.MetricsScope) *monitoringpb.Monito
 // applyMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Apply() method.
 func (s *MetricsScopeServer) applyMetricsScope(ctx context.Context, c *monitoring.Client, request *monitoringpb.ApplyMonitoringMetricsScopeRequest) (*monitoringpb.MonitoringMetricsScope, error) {
 	p := ProtoToMetricsScope(request.GetResource())
-	res, err := c.ApplyMetricsScope(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMetricsScope(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +101,60 @@ func (s *MetricsScopeServer) ApplyMonitoringMetricsScope(ctx context.Context, re
 	return s.applyMetricsScope(ctx, cl, request)
 }
 
+// GetMonitoringMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Get() method.
+func (s *MetricsScopeServer) GetMonitoringMetricsScope(ctx context.Context, request *monitoringpb.GetMonitoringMetricsScopeRequest) (*monitoringpb.MonitoringMetricsScope, error) {
+	cl, err := createConfigMetricsScope(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMetricsScope(ctx, ProtoToMetricsScope(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MetricsScopeToProto(res), nil
+}
+
+// HasDiffMonitoringMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MetricsScopeServer) HasDiffMonitoringMetricsScope(ctx context.Context, request *monitoringpb.HasDiffMonitoringMetricsScopeRequest) (*monitoringpb.HasDiffMonitoringMetricsScopeResponse, error) {
+	cl, err := createConfigMetricsScope(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetricsScope(ctx, ProtoToMetricsScope(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.HasDiffMonitoringMetricsScopeResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MetricsScopeServer) PlanMonitoringMetricsScope(ctx context.Context, request *monitoringpb.PlanMonitoringMetricsScopeRequest) (*monitoringpb.PlanMonitoringMetricsScopeResponse, error) {
+	cl, err := createConfigMetricsScope(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetricsScope(ctx, ProtoToMetricsScope(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.PlanMonitoringMetricsScopeResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMetricsScope handles the gRPC request by passing it to the underlying MetricsScope Delete() method.
 func (s *MetricsScopeServer) DeleteMonitoringMetricsScope(ctx context.Context, request *monitoringpb.DeleteMonitoringMetricsScopeRequest) (*emptypb.Empty, error) {
 
@@ -109,7 +168,9 @@ func (s *MetricsScopeServer) ListMonitoringMetricsScope(_ context.Context, _ *mo
 }
 
 func createConfigMetricsScope(ctx context.Context, service_account_file string) (*monitoring.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return monitoring.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	monitoringpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/monitoring_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring"
@@ -47,7 +48,11 @@ func MonitoredProjectToProto(resource *monitoring.MonitoredProject) *monitoringp
 // applyMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Apply() method.
 func (s *MonitoredProjectServer) applyMonitoredProject(ctx context.Context, c *monitoring.Client, request *monitoringpb.ApplyMonitoringMonitoredProjectRequest) (*monitoringpb.MonitoringMonitoredProject, error) {
 	p := ProtoToMonitoredProject(request.GetResource())
-	res, err := c.ApplyMonitoredProject(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMonitoredProject(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +69,60 @@ func (s *MonitoredProjectServer) ApplyMonitoringMonitoredProject(ctx context.Con
 	return s.applyMonitoredProject(ctx, cl, request)
 }
 
+// GetMonitoringMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Get() method.
+func (s *MonitoredProjectServer) GetMonitoringMonitoredProject(ctx context.Context, request *monitoringpb.GetMonitoringMonitoredProjectRequest) (*monitoringpb.MonitoringMonitoredProject, error) {
+	cl, err := createConfigMonitoredProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMonitoredProject(ctx, ProtoToMonitoredProject(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MonitoredProjectToProto(res), nil
+}
+
+// HasDiffMonitoringMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MonitoredProjectServer) HasDiffMonitoringMonitoredProject(ctx context.Context, request *monitoringpb.HasDiffMonitoringMonitoredProjectRequest) (*monitoringpb.HasDiffMonitoringMonitoredProjectResponse, error) {
+	cl, err := createConfigMonitoredProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMonitoredProject(ctx, ProtoToMonitoredProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.HasDiffMonitoringMonitoredProjectResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MonitoredProjectServer) PlanMonitoringMonitoredProject(ctx context.Context, request *monitoringpb.PlanMonitoringMonitoredProjectRequest) (*monitoringpb.PlanMonitoringMonitoredProjectResponse, error) {
+	cl, err := createConfigMonitoredProject(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMonitoredProject(ctx, ProtoToMonitoredProject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.PlanMonitoringMonitoredProjectResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMonitoredProject handles the gRPC request by passing it to the underlying MonitoredProject Delete() method.
 func (s *MonitoredProjectServer) DeleteMonitoringMonitoredProject(ctx context.Context, request *monitoringpb.DeleteMonitoringMonitoredProjectRequest) (*emptypb.Empty, error) {
 
@@ -82,22 +141,35 @@ func (s *MonitoredProjectServer) ListMonitoringMonitoredProject(ctx context.Cont
 		return nil, err
 	}
 
-	resources, err := cl.ListMonitoredProject(ctx, request.GetMetricsScope())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = monitoring.MonitoredProjectMaxPage
+	}
+	resources, err := cl.ListMonitoredProjectWithPageToken(ctx, request.GetMetricsScope(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*monitoringpb.MonitoringMonitoredProject
-	for _, r := range resources.Items {
-		rp := MonitoredProjectToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MonitoredProjectToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &monitoringpb.ListMonitoringMonitoredProjectResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMonitoredProject(ctx context.Context, service_account_file string) (*monitoring.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return monitoring.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	monitoringpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/monitoring_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring"
@@ -808,7 +809,11 @@ func ServiceLevelObjectiveToProto(resource *monitoring.ServiceLevelObjective) *m
 // applyServiceLevelObjective handles the gRPC request by passing it to the underlying ServiceLevelObjective Apply() method.
 func (s *ServiceLevelObjectiveServer) applyServiceLevelObjective(ctx context.Context, c *monitoring.Client, request *monitoringpb.ApplyMonitoringServiceLevelObjectiveRequest) (*monitoringpb.MonitoringServiceLevelObjective, error) {
 	p := ProtoToServiceLevelObjective(request.GetResource())
-	res, err := c.ApplyServiceLevelObjective(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyServiceLevelObjective(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -825,6 +830,60 @@ func (s *ServiceLevelObjectiveServer) ApplyMonitoringServiceLevelObjective(ctx c
 	return s.applyServiceLevelObjective(ctx, cl, request)
 }
 
+// GetMonitoringServiceLevelObjective handles the gRPC request by passing it to the underlying ServiceLevelObjective Get() method.
+func (s *ServiceLevelObjectiveServer) GetMonitoringServiceLevelObjective(ctx context.Context, request *monitoringpb.GetMonitoringServiceLevelObjectiveRequest) (*monitoringpb.MonitoringServiceLevelObjective, error) {
+	cl, err := createConfigServiceLevelObjective(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetServiceLevelObjective(ctx, ProtoToServiceLevelObjective(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceLevelObjectiveToProto(res), nil
+}
+
+// HasDiffMonitoringServiceLevelObjective handles the gRPC request by passing it to the underlying ServiceLevelObjective Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceLevelObjectiveServer) HasDiffMonitoringServiceLevelObjective(ctx context.Context, request *monitoringpb.HasDiffMonitoringServiceLevelObjectiveRequest) (*monitoringpb.HasDiffMonitoringServiceLevelObjectiveResponse, error) {
+	cl, err := createConfigServiceLevelObjective(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceLevelObjective(ctx, ProtoToServiceLevelObjective(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.HasDiffMonitoringServiceLevelObjectiveResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringServiceLevelObjective handles the gRPC request by passing it to the underlying ServiceLevelObjective Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceLevelObjectiveServer) PlanMonitoringServiceLevelObjective(ctx context.Context, request *monitoringpb.PlanMonitoringServiceLevelObjectiveRequest) (*monitoringpb.PlanMonitoringServiceLevelObjectiveResponse, error) {
+	cl, err := createConfigServiceLevelObjective(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceLevelObjective(ctx, ProtoToServiceLevelObjective(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.PlanMonitoringServiceLevelObjectiveResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteServiceLevelObjective handles the gRPC request by passing it to the underlying ServiceLevelObjective Delete() method.
 func (s *ServiceLevelObjectiveServer) DeleteMonitoringServiceLevelObjective(ctx context.Context, request *monitoringpb.DeleteMonitoringServiceLevelObjectiveRequest) (*emptypb.Empty, error) {
 
@@ -843,22 +902,35 @@ func (s *ServiceLevelObjectiveServer) ListMonitoringServiceLevelObjective(ctx co
 		return nil, err
 	}
 
-	resources, err := cl.ListServiceLevelObjective(ctx, request.GetProject(), request.GetService())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = monitoring.ServiceLevelObjectiveMaxPage
+	}
+	resources, err := cl.ListServiceLevelObjectiveWithPageToken(ctx, request.GetProject(), request.GetService(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*monitoringpb.MonitoringServiceLevelObjective
-	for _, r := range resources.Items {
-		rp := ServiceLevelObjectiveToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceLevelObjectiveToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &monitoringpb.ListMonitoringServiceLevelObjectiveResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigServiceLevelObjective(ctx context.Context, service_account_file string) (*monitoring.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return monitoring.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	monitoringpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/monitoring_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring"
@@ -95,7 +96,11 @@ func ServiceToProto(resource *monitoring.Service) *monitoringpb.MonitoringServic
 // applyService handles the gRPC request by passing it to the underlying Service Apply() method.
 func (s *ServiceServer) applyService(ctx context.Context, c *monitoring.Client, request *monitoringpb.ApplyMonitoringServiceRequest) (*monitoringpb.MonitoringService, error) {
 	p := ProtoToService(request.GetResource())
-	res, err := c.ApplyService(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyService(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +117,60 @@ func (s *ServiceServer) ApplyMonitoringService(ctx context.Context, request *mon
 	return s.applyService(ctx, cl, request)
 }
 
+// GetMonitoringService handles the gRPC request by passing it to the underlying Service Get() method.
+func (s *ServiceServer) GetMonitoringService(ctx context.Context, request *monitoringpb.GetMonitoringServiceRequest) (*monitoringpb.MonitoringService, error) {
+	cl, err := createConfigService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetService(ctx, ProtoToService(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceToProto(res), nil
+}
+
+// HasDiffMonitoringService handles the gRPC request by passing it to the underlying Service Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceServer) HasDiffMonitoringService(ctx context.Context, request *monitoringpb.HasDiffMonitoringServiceRequest) (*monitoringpb.HasDiffMonitoringServiceResponse, error) {
+	cl, err := createConfigService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffService(ctx, ProtoToService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.HasDiffMonitoringServiceResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringService handles the gRPC request by passing it to the underlying Service Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceServer) PlanMonitoringService(ctx context.Context, request *monitoringpb.PlanMonitoringServiceRequest) (*monitoringpb.PlanMonitoringServiceResponse, error) {
+	cl, err := createConfigService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffService(ctx, ProtoToService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.PlanMonitoringServiceResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteService handles the gRPC request by passing it to the underlying Service Delete() method.
 func (s *ServiceServer) DeleteMonitoringService(ctx context.Context, request *monitoringpb.DeleteMonitoringServiceRequest) (*emptypb.Empty, error) {
 
@@ -130,22 +189,35 @@ func (s *ServiceServer) ListMonitoringService(ctx context.Context, request *moni
 		return nil, err
 	}
 
-	resources, err := cl.ListService(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = monitoring.ServiceMaxPage
+	}
+	resources, err := cl.ListServiceWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*monitoringpb.MonitoringService
-	for _, r := range resources.Items {
-		rp := ServiceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &monitoringpb.ListMonitoringServiceResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigService(ctx context.Context, service_account_file string) (*monitoring.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return monitoring.NewClient(conf), nil
 }
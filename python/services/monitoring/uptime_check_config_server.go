@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	monitoringpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/monitoring/monitoring_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/monitoring"
@@ -325,7 +326,11 @@ func UptimeCheckConfigToProto(resource *monitoring.UptimeCheckConfig) *monitorin
 // applyUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Apply() method.
 func (s *UptimeCheckConfigServer) applyUptimeCheckConfig(ctx context.Context, c *monitoring.Client, request *monitoringpb.ApplyMonitoringUptimeCheckConfigRequest) (*monitoringpb.MonitoringUptimeCheckConfig, error) {
 	p := ProtoToUptimeCheckConfig(request.GetResource())
-	res, err := c.ApplyUptimeCheckConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyUptimeCheckConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -342,6 +347,60 @@ func (s *UptimeCheckConfigServer) ApplyMonitoringUptimeCheckConfig(ctx context.C
 	return s.applyUptimeCheckConfig(ctx, cl, request)
 }
 
+// GetMonitoringUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Get() method.
+func (s *UptimeCheckConfigServer) GetMonitoringUptimeCheckConfig(ctx context.Context, request *monitoringpb.GetMonitoringUptimeCheckConfigRequest) (*monitoringpb.MonitoringUptimeCheckConfig, error) {
+	cl, err := createConfigUptimeCheckConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetUptimeCheckConfig(ctx, ProtoToUptimeCheckConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return UptimeCheckConfigToProto(res), nil
+}
+
+// HasDiffMonitoringUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *UptimeCheckConfigServer) HasDiffMonitoringUptimeCheckConfig(ctx context.Context, request *monitoringpb.HasDiffMonitoringUptimeCheckConfigRequest) (*monitoringpb.HasDiffMonitoringUptimeCheckConfigResponse, error) {
+	cl, err := createConfigUptimeCheckConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUptimeCheckConfig(ctx, ProtoToUptimeCheckConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.HasDiffMonitoringUptimeCheckConfigResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanMonitoringUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *UptimeCheckConfigServer) PlanMonitoringUptimeCheckConfig(ctx context.Context, request *monitoringpb.PlanMonitoringUptimeCheckConfigRequest) (*monitoringpb.PlanMonitoringUptimeCheckConfigResponse, error) {
+	cl, err := createConfigUptimeCheckConfig(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUptimeCheckConfig(ctx, ProtoToUptimeCheckConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &monitoringpb.PlanMonitoringUptimeCheckConfigResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteUptimeCheckConfig handles the gRPC request by passing it to the underlying UptimeCheckConfig Delete() method.
 func (s *UptimeCheckConfigServer) DeleteMonitoringUptimeCheckConfig(ctx context.Context, request *monitoringpb.DeleteMonitoringUptimeCheckConfigRequest) (*emptypb.Empty, error) {
 
@@ -360,22 +419,35 @@ func (s *UptimeCheckConfigServer) ListMonitoringUptimeCheckConfig(ctx context.Co
 		return nil, err
 	}
 
-	resources, err := cl.ListUptimeCheckConfig(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = monitoring.UptimeCheckConfigMaxPage
+	}
+	resources, err := cl.ListUptimeCheckConfigWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*monitoringpb.MonitoringUptimeCheckConfig
-	for _, r := range resources.Items {
-		rp := UptimeCheckConfigToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, UptimeCheckConfigToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &monitoringpb.ListMonitoringUptimeCheckConfigResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigUptimeCheckConfig(ctx context.Context, service_account_file string) (*monitoring.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return monitoring.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkconnectivity/alpha/networkconnectivity_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkconnectivity/alpha"
@@ -112,7 +113,11 @@ func HubToProto(resource *alpha.Hub) *alphapb.NetworkconnectivityAlphaHub {
 // applyHub handles the gRPC request by passing it to the underlying Hub Apply() method.
 func (s *HubServer) applyHub(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworkconnectivityAlphaHubRequest) (*alphapb.NetworkconnectivityAlphaHub, error) {
 	p := ProtoToHub(request.GetResource())
-	res, err := c.ApplyHub(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyHub(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +134,60 @@ func (s *HubServer) ApplyNetworkconnectivityAlphaHub(ctx context.Context, reques
 	return s.applyHub(ctx, cl, request)
 }
 
+// GetNetworkconnectivityAlphaHub handles the gRPC request by passing it to the underlying Hub Get() method.
+func (s *HubServer) GetNetworkconnectivityAlphaHub(ctx context.Context, request *alphapb.GetNetworkconnectivityAlphaHubRequest) (*alphapb.NetworkconnectivityAlphaHub, error) {
+	cl, err := createConfigHub(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetHub(ctx, ProtoToHub(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return HubToProto(res), nil
+}
+
+// HasDiffNetworkconnectivityAlphaHub handles the gRPC request by passing it to the underlying Hub Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *HubServer) HasDiffNetworkconnectivityAlphaHub(ctx context.Context, request *alphapb.HasDiffNetworkconnectivityAlphaHubRequest) (*alphapb.HasDiffNetworkconnectivityAlphaHubResponse, error) {
+	cl, err := createConfigHub(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHub(ctx, ProtoToHub(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffNetworkconnectivityAlphaHubResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkconnectivityAlphaHub handles the gRPC request by passing it to the underlying Hub Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *HubServer) PlanNetworkconnectivityAlphaHub(ctx context.Context, request *alphapb.PlanNetworkconnectivityAlphaHubRequest) (*alphapb.PlanNetworkconnectivityAlphaHubResponse, error) {
+	cl, err := createConfigHub(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHub(ctx, ProtoToHub(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanNetworkconnectivityAlphaHubResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteHub handles the gRPC request by passing it to the underlying Hub Delete() method.
 func (s *HubServer) DeleteNetworkconnectivityAlphaHub(ctx context.Context, request *alphapb.DeleteNetworkconnectivityAlphaHubRequest) (*emptypb.Empty, error) {
 
@@ -147,22 +206,35 @@ func (s *HubServer) ListNetworkconnectivityAlphaHub(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListHub(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.HubMaxPage
+	}
+	resources, err := cl.ListHubWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworkconnectivityAlphaHub
-	for _, r := range resources.Items {
-		rp := HubToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, HubToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListNetworkconnectivityAlphaHubResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigHub(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkconnectivity/alpha/networkconnectivity_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkconnectivity/alpha"
@@ -203,7 +204,11 @@ func SpokeToProto(resource *alpha.Spoke) *alphapb.NetworkconnectivityAlphaSpoke
 // applySpoke handles the gRPC request by passing it to the underlying Spoke Apply() method.
 func (s *SpokeServer) applySpoke(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworkconnectivityAlphaSpokeRequest) (*alphapb.NetworkconnectivityAlphaSpoke, error) {
 	p := ProtoToSpoke(request.GetResource())
-	res, err := c.ApplySpoke(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySpoke(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -220,6 +225,60 @@ func (s *SpokeServer) ApplyNetworkconnectivityAlphaSpoke(ctx context.Context, re
 	return s.applySpoke(ctx, cl, request)
 }
 
+// GetNetworkconnectivityAlphaSpoke handles the gRPC request by passing it to the underlying Spoke Get() method.
+func (s *SpokeServer) GetNetworkconnectivityAlphaSpoke(ctx context.Context, request *alphapb.GetNetworkconnectivityAlphaSpokeRequest) (*alphapb.NetworkconnectivityAlphaSpoke, error) {
+	cl, err := createConfigSpoke(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSpoke(ctx, ProtoToSpoke(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SpokeToProto(res), nil
+}
+
+// HasDiffNetworkconnectivityAlphaSpoke handles the gRPC request by passing it to the underlying Spoke Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SpokeServer) HasDiffNetworkconnectivityAlphaSpoke(ctx context.Context, request *alphapb.HasDiffNetworkconnectivityAlphaSpokeRequest) (*alphapb.HasDiffNetworkconnectivityAlphaSpokeResponse, error) {
+	cl, err := createConfigSpoke(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSpoke(ctx, ProtoToSpoke(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffNetworkconnectivityAlphaSpokeResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkconnectivityAlphaSpoke handles the gRPC request by passing it to the underlying Spoke Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SpokeServer) PlanNetworkconnectivityAlphaSpoke(ctx context.Context, request *alphapb.PlanNetworkconnectivityAlphaSpokeRequest) (*alphapb.PlanNetworkconnectivityAlphaSpokeResponse, error) {
+	cl, err := createConfigSpoke(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSpoke(ctx, ProtoToSpoke(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanNetworkconnectivityAlphaSpokeResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteSpoke handles the gRPC request by passing it to the underlying Spoke Delete() method.
 func (s *SpokeServer) DeleteNetworkconnectivityAlphaSpoke(ctx context.Context, request *alphapb.DeleteNetworkconnectivityAlphaSpokeRequest) (*emptypb.Empty, error) {
 
@@ -238,22 +297,35 @@ func (s *SpokeServer) ListNetworkconnectivityAlphaSpoke(ctx context.Context, req
 		return nil, err
 	}
 
-	resources, err := cl.ListSpoke(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.SpokeMaxPage
+	}
+	resources, err := cl.ListSpokeWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworkconnectivityAlphaSpoke
-	for _, r := range resources.Items {
-		rp := SpokeToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SpokeToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListNetworkconnectivityAlphaSpokeResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigSpoke(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkconnectivity/beta/networkconnectivity_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkconnectivity/beta"
@@ -203,7 +204,11 @@ func SpokeToProto(resource *beta.Spoke) *betapb.NetworkconnectivityBetaSpoke {
 // applySpoke handles the gRPC request by passing it to the underlying Spoke Apply() method.
 func (s *SpokeServer) applySpoke(ctx context.Context, c *beta.Client, request *betapb.ApplyNetworkconnectivityBetaSpokeRequest) (*betapb.NetworkconnectivityBetaSpoke, error) {
 	p := ProtoToSpoke(request.GetResource())
-	res, err := c.ApplySpoke(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySpoke(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -220,6 +225,60 @@ func (s *SpokeServer) ApplyNetworkconnectivityBetaSpoke(ctx context.Context, req
 	return s.applySpoke(ctx, cl, request)
 }
 
+// GetNetworkconnectivityBetaSpoke handles the gRPC request by passing it to the underlying Spoke Get() method.
+func (s *SpokeServer) GetNetworkconnectivityBetaSpoke(ctx context.Context, request *betapb.GetNetworkconnectivityBetaSpokeRequest) (*betapb.NetworkconnectivityBetaSpoke, error) {
+	cl, err := createConfigSpoke(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSpoke(ctx, ProtoToSpoke(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SpokeToProto(res), nil
+}
+
+// HasDiffNetworkconnectivityBetaSpoke handles the gRPC request by passing it to the underlying Spoke Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SpokeServer) HasDiffNetworkconnectivityBetaSpoke(ctx context.Context, request *betapb.HasDiffNetworkconnectivityBetaSpokeRequest) (*betapb.HasDiffNetworkconnectivityBetaSpokeResponse, error) {
+	cl, err := createConfigSpoke(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSpoke(ctx, ProtoToSpoke(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffNetworkconnectivityBetaSpokeResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkconnectivityBetaSpoke handles the gRPC request by passing it to the underlying Spoke Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SpokeServer) PlanNetworkconnectivityBetaSpoke(ctx context.Context, request *betapb.PlanNetworkconnectivityBetaSpokeRequest) (*betapb.PlanNetworkconnectivityBetaSpokeResponse, error) {
+	cl, err := createConfigSpoke(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSpoke(ctx, ProtoToSpoke(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanNetworkconnectivityBetaSpokeResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteSpoke handles the gRPC request by passing it to the underlying Spoke Delete() method.
 func (s *SpokeServer) DeleteNetworkconnectivityBetaSpoke(ctx context.Context, request *betapb.DeleteNetworkconnectivityBetaSpokeRequest) (*emptypb.Empty, error) {
 
@@ -238,22 +297,35 @@ func (s *SpokeServer) ListNetworkconnectivityBetaSpoke(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListSpoke(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.SpokeMaxPage
+	}
+	resources, err := cl.ListSpokeWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.NetworkconnectivityBetaSpoke
-	for _, r := range resources.Items {
-		rp := SpokeToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SpokeToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListNetworkconnectivityBetaSpokeResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigSpoke(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
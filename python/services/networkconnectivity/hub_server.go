@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	networkconnectivitypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkconnectivity/networkconnectivity_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkconnectivity"
@@ -112,7 +113,11 @@ func HubToProto(resource *networkconnectivity.Hub) *networkconnectivitypb.Networ
 // applyHub handles the gRPC request by passing it to the underlying Hub Apply() method.
 func (s *HubServer) applyHub(ctx context.Context, c *networkconnectivity.Client, request *networkconnectivitypb.ApplyNetworkconnectivityHubRequest) (*networkconnectivitypb.NetworkconnectivityHub, error) {
 	p := ProtoToHub(request.GetResource())
-	res, err := c.ApplyHub(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyHub(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +134,60 @@ func (s *HubServer) ApplyNetworkconnectivityHub(ctx context.Context, request *ne
 	return s.applyHub(ctx, cl, request)
 }
 
+// GetNetworkconnectivityHub handles the gRPC request by passing it to the underlying Hub Get() method.
+func (s *HubServer) GetNetworkconnectivityHub(ctx context.Context, request *networkconnectivitypb.GetNetworkconnectivityHubRequest) (*networkconnectivitypb.NetworkconnectivityHub, error) {
+	cl, err := createConfigHub(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetHub(ctx, ProtoToHub(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return HubToProto(res), nil
+}
+
+// HasDiffNetworkconnectivityHub handles the gRPC request by passing it to the underlying Hub Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *HubServer) HasDiffNetworkconnectivityHub(ctx context.Context, request *networkconnectivitypb.HasDiffNetworkconnectivityHubRequest) (*networkconnectivitypb.HasDiffNetworkconnectivityHubResponse, error) {
+	cl, err := createConfigHub(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHub(ctx, ProtoToHub(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &networkconnectivitypb.HasDiffNetworkconnectivityHubResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkconnectivityHub handles the gRPC request by passing it to the underlying Hub Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *HubServer) PlanNetworkconnectivityHub(ctx context.Context, request *networkconnectivitypb.PlanNetworkconnectivityHubRequest) (*networkconnectivitypb.PlanNetworkconnectivityHubResponse, error) {
+	cl, err := createConfigHub(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHub(ctx, ProtoToHub(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &networkconnectivitypb.PlanNetworkconnectivityHubResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteHub handles the gRPC request by passing it to the underlying Hub Delete() method.
 func (s *HubServer) DeleteNetworkconnectivityHub(ctx context.Context, request *networkconnectivitypb.DeleteNetworkconnectivityHubRequest) (*emptypb.Empty, error) {
 
@@ -147,22 +206,35 @@ func (s *HubServer) ListNetworkconnectivityHub(ctx context.Context, request *net
 		return nil, err
 	}
 
-	resources, err := cl.ListHub(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = networkconnectivity.HubMaxPage
+	}
+	resources, err := cl.ListHubWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*networkconnectivitypb.NetworkconnectivityHub
-	for _, r := range resources.Items {
-		rp := HubToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, HubToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &networkconnectivitypb.ListNetworkconnectivityHubResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigHub(ctx context.Context, service_account_file string) (*networkconnectivity.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return networkconnectivity.NewClient(conf), nil
 }
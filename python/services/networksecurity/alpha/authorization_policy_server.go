@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networksecurity/alpha/networksecurity_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networksecurity/alpha"
@@ -227,7 +228,11 @@ func AuthorizationPolicyToProto(resource *alpha.AuthorizationPolicy) *alphapb.Ne
 // applyAuthorizationPolicy handles the gRPC request by passing it to the underlying AuthorizationPolicy Apply() method.
 func (s *AuthorizationPolicyServer) applyAuthorizationPolicy(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworksecurityAlphaAuthorizationPolicyRequest) (*alphapb.NetworksecurityAlphaAuthorizationPolicy, error) {
 	p := ProtoToAuthorizationPolicy(request.GetResource())
-	res, err := c.ApplyAuthorizationPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyAuthorizationPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -244,6 +249,60 @@ func (s *AuthorizationPolicyServer) ApplyNetworksecurityAlphaAuthorizationPolicy
 	return s.applyAuthorizationPolicy(ctx, cl, request)
 }
 
+// GetNetworksecurityAlphaAuthorizationPolicy handles the gRPC request by passing it to the underlying AuthorizationPolicy Get() method.
+func (s *AuthorizationPolicyServer) GetNetworksecurityAlphaAuthorizationPolicy(ctx context.Context, request *alphapb.GetNetworksecurityAlphaAuthorizationPolicyRequest) (*alphapb.NetworksecurityAlphaAuthorizationPolicy, error) {
+	cl, err := createConfigAuthorizationPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetAuthorizationPolicy(ctx, ProtoToAuthorizationPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return AuthorizationPolicyToProto(res), nil
+}
+
+// HasDiffNetworksecurityAlphaAuthorizationPolicy handles the gRPC request by passing it to the underlying AuthorizationPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *AuthorizationPolicyServer) HasDiffNetworksecurityAlphaAuthorizationPolicy(ctx context.Context, request *alphapb.HasDiffNetworksecurityAlphaAuthorizationPolicyRequest) (*alphapb.HasDiffNetworksecurityAlphaAuthorizationPolicyResponse, error) {
+	cl, err := createConfigAuthorizationPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAuthorizationPolicy(ctx, ProtoToAuthorizationPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffNetworksecurityAlphaAuthorizationPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworksecurityAlphaAuthorizationPolicy handles the gRPC request by passing it to the underlying AuthorizationPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *AuthorizationPolicyServer) PlanNetworksecurityAlphaAuthorizationPolicy(ctx context.Context, request *alphapb.PlanNetworksecurityAlphaAuthorizationPolicyRequest) (*alphapb.PlanNetworksecurityAlphaAuthorizationPolicyResponse, error) {
+	cl, err := createConfigAuthorizationPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffAuthorizationPolicy(ctx, ProtoToAuthorizationPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanNetworksecurityAlphaAuthorizationPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteAuthorizationPolicy handles the gRPC request by passing it to the underlying AuthorizationPolicy Delete() method.
 func (s *AuthorizationPolicyServer) DeleteNetworksecurityAlphaAuthorizationPolicy(ctx context.Context, request *alphapb.DeleteNetworksecurityAlphaAuthorizationPolicyRequest) (*emptypb.Empty, error) {
 
@@ -262,22 +321,35 @@ func (s *AuthorizationPolicyServer) ListNetworksecurityAlphaAuthorizationPolicy(
 		return nil, err
 	}
 
-	resources, err := cl.ListAuthorizationPolicy(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.AuthorizationPolicyMaxPage
+	}
+	resources, err := cl.ListAuthorizationPolicyWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworksecurityAlphaAuthorizationPolicy
-	for _, r := range resources.Items {
-		rp := AuthorizationPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, AuthorizationPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListNetworksecurityAlphaAuthorizationPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigAuthorizationPolicy(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
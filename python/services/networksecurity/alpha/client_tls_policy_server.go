@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networksecurity/alpha/networksecurity_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networksecurity/alpha"
@@ -227,7 +228,11 @@ func ClientTlsPolicyToProto(resource *alpha.ClientTlsPolicy) *alphapb.Networksec
 // applyClientTlsPolicy handles the gRPC request by passing it to the underlying ClientTlsPolicy Apply() method.
 func (s *ClientTlsPolicyServer) applyClientTlsPolicy(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworksecurityAlphaClientTlsPolicyRequest) (*alphapb.NetworksecurityAlphaClientTlsPolicy, error) {
 	p := ProtoToClientTlsPolicy(request.GetResource())
-	res, err := c.ApplyClientTlsPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyClientTlsPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -244,6 +249,60 @@ func (s *ClientTlsPolicyServer) ApplyNetworksecurityAlphaClientTlsPolicy(ctx con
 	return s.applyClientTlsPolicy(ctx, cl, request)
 }
 
+// GetNetworksecurityAlphaClientTlsPolicy handles the gRPC request by passing it to the underlying ClientTlsPolicy Get() method.
+func (s *ClientTlsPolicyServer) GetNetworksecurityAlphaClientTlsPolicy(ctx context.Context, request *alphapb.GetNetworksecurityAlphaClientTlsPolicyRequest) (*alphapb.NetworksecurityAlphaClientTlsPolicy, error) {
+	cl, err := createConfigClientTlsPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetClientTlsPolicy(ctx, ProtoToClientTlsPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ClientTlsPolicyToProto(res), nil
+}
+
+// HasDiffNetworksecurityAlphaClientTlsPolicy handles the gRPC request by passing it to the underlying ClientTlsPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ClientTlsPolicyServer) HasDiffNetworksecurityAlphaClientTlsPolicy(ctx context.Context, request *alphapb.HasDiffNetworksecurityAlphaClientTlsPolicyRequest) (*alphapb.HasDiffNetworksecurityAlphaClientTlsPolicyResponse, error) {
+	cl, err := createConfigClientTlsPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffClientTlsPolicy(ctx, ProtoToClientTlsPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffNetworksecurityAlphaClientTlsPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworksecurityAlphaClientTlsPolicy handles the gRPC request by passing it to the underlying ClientTlsPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ClientTlsPolicyServer) PlanNetworksecurityAlphaClientTlsPolicy(ctx context.Context, request *alphapb.PlanNetworksecurityAlphaClientTlsPolicyRequest) (*alphapb.PlanNetworksecurityAlphaClientTlsPolicyResponse, error) {
+	cl, err := createConfigClientTlsPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffClientTlsPolicy(ctx, ProtoToClientTlsPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanNetworksecurityAlphaClientTlsPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteClientTlsPolicy handles the gRPC request by passing it to the underlying ClientTlsPolicy Delete() method.
 func (s *ClientTlsPolicyServer) DeleteNetworksecurityAlphaClientTlsPolicy(ctx context.Context, request *alphapb.DeleteNetworksecurityAlphaClientTlsPolicyRequest) (*emptypb.Empty, error) {
 
@@ -262,22 +321,35 @@ func (s *ClientTlsPolicyServer) ListNetworksecurityAlphaClientTlsPolicy(ctx cont
 		return nil, err
 	}
 
-	resources, err := cl.ListClientTlsPolicy(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ClientTlsPolicyMaxPage
+	}
+	resources, err := cl.ListClientTlsPolicyWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworksecurityAlphaClientTlsPolicy
-	for _, r := range resources.Items {
-		rp := ClientTlsPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ClientTlsPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListNetworksecurityAlphaClientTlsPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigClientTlsPolicy(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networksecurity/alpha/networksecurity_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networksecurity/alpha"
@@ -247,7 +248,11 @@ func ServerTlsPolicyToProto(resource *alpha.ServerTlsPolicy) *alphapb.Networksec
 // applyServerTlsPolicy handles the gRPC request by passing it to the underlying ServerTlsPolicy Apply() method.
 func (s *ServerTlsPolicyServer) applyServerTlsPolicy(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworksecurityAlphaServerTlsPolicyRequest) (*alphapb.NetworksecurityAlphaServerTlsPolicy, error) {
 	p := ProtoToServerTlsPolicy(request.GetResource())
-	res, err := c.ApplyServerTlsPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyServerTlsPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -264,6 +269,60 @@ func (s *ServerTlsPolicyServer) ApplyNetworksecurityAlphaServerTlsPolicy(ctx con
 	return s.applyServerTlsPolicy(ctx, cl, request)
 }
 
+// GetNetworksecurityAlphaServerTlsPolicy handles the gRPC request by passing it to the underlying ServerTlsPolicy Get() method.
+func (s *ServerTlsPolicyServer) GetNetworksecurityAlphaServerTlsPolicy(ctx context.Context, request *alphapb.GetNetworksecurityAlphaServerTlsPolicyRequest) (*alphapb.NetworksecurityAlphaServerTlsPolicy, error) {
+	cl, err := createConfigServerTlsPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetServerTlsPolicy(ctx, ProtoToServerTlsPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServerTlsPolicyToProto(res), nil
+}
+
+// HasDiffNetworksecurityAlphaServerTlsPolicy handles the gRPC request by passing it to the underlying ServerTlsPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServerTlsPolicyServer) HasDiffNetworksecurityAlphaServerTlsPolicy(ctx context.Context, request *alphapb.HasDiffNetworksecurityAlphaServerTlsPolicyRequest) (*alphapb.HasDiffNetworksecurityAlphaServerTlsPolicyResponse, error) {
+	cl, err := createConfigServerTlsPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServerTlsPolicy(ctx, ProtoToServerTlsPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffNetworksecurityAlphaServerTlsPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworksecurityAlphaServerTlsPolicy handles the gRPC request by passing it to the underlying ServerTlsPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServerTlsPolicyServer) PlanNetworksecurityAlphaServerTlsPolicy(ctx context.Context, request *alphapb.PlanNetworksecurityAlphaServerTlsPolicyRequest) (*alphapb.PlanNetworksecurityAlphaServerTlsPolicyResponse, error) {
+	cl, err := createConfigServerTlsPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServerTlsPolicy(ctx, ProtoToServerTlsPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanNetworksecurityAlphaServerTlsPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteServerTlsPolicy handles the gRPC request by passing it to the underlying ServerTlsPolicy Delete() method.
 func (s *ServerTlsPolicyServer) DeleteNetworksecurityAlphaServerTlsPolicy(ctx context.Context, request *alphapb.DeleteNetworksecurityAlphaServerTlsPolicyRequest) (*emptypb.Empty, error) {
 
@@ -282,22 +341,35 @@ func (s *ServerTlsPolicyServer) ListNetworksecurityAlphaServerTlsPolicy(ctx cont
 		return nil, err
 	}
 
-	resources, err := cl.ListServerTlsPolicy(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ServerTlsPolicyMaxPage
+	}
+	resources, err := cl.ListServerTlsPolicyWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworksecurityAlphaServerTlsPolicy
-	for _, r := range resources.Items {
-		rp := ServerTlsPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServerTlsPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListNetworksecurityAlphaServerTlsPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigServerTlsPolicy(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
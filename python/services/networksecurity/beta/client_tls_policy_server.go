@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networksecurity/beta/networksecurity_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networksecurity/beta"
@@ -200,7 +201,11 @@ func ClientTlsPolicyToProto(resource *beta.ClientTlsPolicy) *betapb.Networksecur
 // applyClientTlsPolicy handles the gRPC request by passing it to the underlying ClientTlsPolicy Apply() method.
 func (s *ClientTlsPolicyServer) applyClientTlsPolicy(ctx context.Context, c *beta.Client, request *betapb.ApplyNetworksecurityBetaClientTlsPolicyRequest) (*betapb.NetworksecurityBetaClientTlsPolicy, error) {
 	p := ProtoToClientTlsPolicy(request.GetResource())
-	res, err := c.ApplyClientTlsPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyClientTlsPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -217,6 +222,60 @@ func (s *ClientTlsPolicyServer) ApplyNetworksecurityBetaClientTlsPolicy(ctx cont
 	return s.applyClientTlsPolicy(ctx, cl, request)
 }
 
+// GetNetworksecurityBetaClientTlsPolicy handles the gRPC request by passing it to the underlying ClientTlsPolicy Get() method.
+func (s *ClientTlsPolicyServer) GetNetworksecurityBetaClientTlsPolicy(ctx context.Context, request *betapb.GetNetworksecurityBetaClientTlsPolicyRequest) (*betapb.NetworksecurityBetaClientTlsPolicy, error) {
+	cl, err := createConfigClientTlsPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetClientTlsPolicy(ctx, ProtoToClientTlsPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ClientTlsPolicyToProto(res), nil
+}
+
+// HasDiffNetworksecurityBetaClientTlsPolicy handles the gRPC request by passing it to the underlying ClientTlsPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ClientTlsPolicyServer) HasDiffNetworksecurityBetaClientTlsPolicy(ctx context.Context, request *betapb.HasDiffNetworksecurityBetaClientTlsPolicyRequest) (*betapb.HasDiffNetworksecurityBetaClientTlsPolicyResponse, error) {
+	cl, err := createConfigClientTlsPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffClientTlsPolicy(ctx, ProtoToClientTlsPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffNetworksecurityBetaClientTlsPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworksecurityBetaClientTlsPolicy handles the gRPC request by passing it to the underlying ClientTlsPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ClientTlsPolicyServer) PlanNetworksecurityBetaClientTlsPolicy(ctx context.Context, request *betapb.PlanNetworksecurityBetaClientTlsPolicyRequest) (*betapb.PlanNetworksecurityBetaClientTlsPolicyResponse, error) {
+	cl, err := createConfigClientTlsPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffClientTlsPolicy(ctx, ProtoToClientTlsPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanNetworksecurityBetaClientTlsPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteClientTlsPolicy handles the gRPC request by passing it to the underlying ClientTlsPolicy Delete() method.
 func (s *ClientTlsPolicyServer) DeleteNetworksecurityBetaClientTlsPolicy(ctx context.Context, request *betapb.DeleteNetworksecurityBetaClientTlsPolicyRequest) (*emptypb.Empty, error) {
 
@@ -235,22 +294,35 @@ func (s *ClientTlsPolicyServer) ListNetworksecurityBetaClientTlsPolicy(ctx conte
 		return nil, err
 	}
 
-	resources, err := cl.ListClientTlsPolicy(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ClientTlsPolicyMaxPage
+	}
+	resources, err := cl.ListClientTlsPolicyWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.NetworksecurityBetaClientTlsPolicy
-	for _, r := range resources.Items {
-		rp := ClientTlsPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ClientTlsPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListNetworksecurityBetaClientTlsPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigClientTlsPolicy(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
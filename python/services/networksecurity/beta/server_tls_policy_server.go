@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networksecurity/beta/networksecurity_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networksecurity/beta"
@@ -220,7 +221,11 @@ func ServerTlsPolicyToProto(resource *beta.ServerTlsPolicy) *betapb.Networksecur
 // applyServerTlsPolicy handles the gRPC request by passing it to the underlying ServerTlsPolicy Apply() method.
 func (s *ServerTlsPolicyServer) applyServerTlsPolicy(ctx context.Context, c *beta.Client, request *betapb.ApplyNetworksecurityBetaServerTlsPolicyRequest) (*betapb.NetworksecurityBetaServerTlsPolicy, error) {
 	p := ProtoToServerTlsPolicy(request.GetResource())
-	res, err := c.ApplyServerTlsPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyServerTlsPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -237,6 +242,60 @@ func (s *ServerTlsPolicyServer) ApplyNetworksecurityBetaServerTlsPolicy(ctx cont
 	return s.applyServerTlsPolicy(ctx, cl, request)
 }
 
+// GetNetworksecurityBetaServerTlsPolicy handles the gRPC request by passing it to the underlying ServerTlsPolicy Get() method.
+func (s *ServerTlsPolicyServer) GetNetworksecurityBetaServerTlsPolicy(ctx context.Context, request *betapb.GetNetworksecurityBetaServerTlsPolicyRequest) (*betapb.NetworksecurityBetaServerTlsPolicy, error) {
+	cl, err := createConfigServerTlsPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetServerTlsPolicy(ctx, ProtoToServerTlsPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServerTlsPolicyToProto(res), nil
+}
+
+// HasDiffNetworksecurityBetaServerTlsPolicy handles the gRPC request by passing it to the underlying ServerTlsPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServerTlsPolicyServer) HasDiffNetworksecurityBetaServerTlsPolicy(ctx context.Context, request *betapb.HasDiffNetworksecurityBetaServerTlsPolicyRequest) (*betapb.HasDiffNetworksecurityBetaServerTlsPolicyResponse, error) {
+	cl, err := createConfigServerTlsPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServerTlsPolicy(ctx, ProtoToServerTlsPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffNetworksecurityBetaServerTlsPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworksecurityBetaServerTlsPolicy handles the gRPC request by passing it to the underlying ServerTlsPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServerTlsPolicyServer) PlanNetworksecurityBetaServerTlsPolicy(ctx context.Context, request *betapb.PlanNetworksecurityBetaServerTlsPolicyRequest) (*betapb.PlanNetworksecurityBetaServerTlsPolicyResponse, error) {
+	cl, err := createConfigServerTlsPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServerTlsPolicy(ctx, ProtoToServerTlsPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanNetworksecurityBetaServerTlsPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteServerTlsPolicy handles the gRPC request by passing it to the underlying ServerTlsPolicy Delete() method.
 func (s *ServerTlsPolicyServer) DeleteNetworksecurityBetaServerTlsPolicy(ctx context.Context, request *betapb.DeleteNetworksecurityBetaServerTlsPolicyRequest) (*emptypb.Empty, error) {
 
@@ -255,22 +314,35 @@ func (s *ServerTlsPolicyServer) ListNetworksecurityBetaServerTlsPolicy(ctx conte
 		return nil, err
 	}
 
-	resources, err := cl.ListServerTlsPolicy(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ServerTlsPolicyMaxPage
+	}
+	resources, err := cl.ListServerTlsPolicyWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.NetworksecurityBetaServerTlsPolicy
-	for _, r := range resources.Items {
-		rp := ServerTlsPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServerTlsPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListNetworksecurityBetaServerTlsPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigServerTlsPolicy(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/alpha/networkservices_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/alpha"
@@ -237,7 +238,11 @@ func EndpointConfigSelectorToProto(resource *alpha.EndpointConfigSelector) *alph
 // ApplyEndpointConfigSelector handles the gRPC request by passing it to the underlying EndpointConfigSelector Apply() method.
 func (s *EndpointConfigSelectorServer) applyEndpointConfigSelector(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworkservicesAlphaEndpointConfigSelectorRequest) (*alphapb.NetworkservicesAlphaEndpointConfigSelector, error) {
 	p := ProtoToEndpointConfigSelector(request.GetResource())
-	res, err := c.ApplyEndpointConfigSelector(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEndpointConfigSelector(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -254,6 +259,55 @@ func (s *EndpointConfigSelectorServer) ApplyNetworkservicesAlphaEndpointConfigSe
 	return s.applyEndpointConfigSelector(ctx, cl, request)
 }
 
+// GetNetworkservicesAlphaEndpointConfigSelector handles the gRPC request by passing it to the underlying EndpointConfigSelector Get() method.
+func (s *EndpointConfigSelectorServer) GetNetworkservicesAlphaEndpointConfigSelector(ctx context.Context, request *alphapb.GetNetworkservicesAlphaEndpointConfigSelectorRequest) (*alphapb.NetworkservicesAlphaEndpointConfigSelector, error) {
+	cl, err := createConfigEndpointConfigSelector(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEndpointConfigSelector(ctx, ProtoToEndpointConfigSelector(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointConfigSelectorToProto(res), nil
+}
+
+// HasDiffNetworkservicesAlphaEndpointConfigSelector handles the gRPC request by passing it to the underlying EndpointConfigSelector Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EndpointConfigSelectorServer) HasDiffNetworkservicesAlphaEndpointConfigSelector(ctx context.Context, request *alphapb.HasDiffNetworkservicesAlphaEndpointConfigSelectorRequest) (*alphapb.HasDiffNetworkservicesAlphaEndpointConfigSelectorResponse, error) {
+	cl, err := createConfigEndpointConfigSelector(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointConfigSelector(ctx, ProtoToEndpointConfigSelector(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.HasDiffNetworkservicesAlphaEndpointConfigSelectorResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanNetworkservicesAlphaEndpointConfigSelector handles the gRPC request by passing it to the underlying EndpointConfigSelector Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EndpointConfigSelectorServer) PlanNetworkservicesAlphaEndpointConfigSelector(ctx context.Context, request *alphapb.PlanNetworkservicesAlphaEndpointConfigSelectorRequest) (*alphapb.PlanNetworkservicesAlphaEndpointConfigSelectorResponse, error) {
+	cl, err := createConfigEndpointConfigSelector(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointConfigSelector(ctx, ProtoToEndpointConfigSelector(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &alphapb.PlanNetworkservicesAlphaEndpointConfigSelectorResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteEndpointConfigSelector handles the gRPC request by passing it to the underlying EndpointConfigSelector Delete() method.
 func (s *EndpointConfigSelectorServer) DeleteNetworkservicesAlphaEndpointConfigSelector(ctx context.Context, request *alphapb.DeleteNetworkservicesAlphaEndpointConfigSelectorRequest) (*emptypb.Empty, error) {
 
@@ -272,20 +326,32 @@ func (s *EndpointConfigSelectorServer) ListNetworkservicesAlphaEndpointConfigSel
 		return nil, err
 	}
 
-	resources, err := cl.ListEndpointConfigSelector(ctx, ProtoToEndpointConfigSelector(request.GetResource()))
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = alpha.EndpointConfigSelectorMaxPage
+	}
+	resources, err := cl.ListEndpointConfigSelectorWithPageToken(ctx, ProtoToEndpointConfigSelector(request.GetResource()), pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworkservicesAlphaEndpointConfigSelector
-	for _, r := range resources.Items {
-		rp := EndpointConfigSelectorToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EndpointConfigSelectorToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &alphapb.ListNetworkservicesAlphaEndpointConfigSelectorResponse{Items: protos}, nil
+	return &alphapb.ListNetworkservicesAlphaEndpointConfigSelectorResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigEndpointConfigSelector(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/alpha/networkservices_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/alpha"
@@ -105,7 +106,11 @@ func GatewayToProto(resource *alpha.Gateway) *alphapb.NetworkservicesAlphaGatewa
 // applyGateway handles the gRPC request by passing it to the underlying Gateway Apply() method.
 func (s *GatewayServer) applyGateway(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworkservicesAlphaGatewayRequest) (*alphapb.NetworkservicesAlphaGateway, error) {
 	p := ProtoToGateway(request.GetResource())
-	res, err := c.ApplyGateway(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGateway(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +127,60 @@ func (s *GatewayServer) ApplyNetworkservicesAlphaGateway(ctx context.Context, re
 	return s.applyGateway(ctx, cl, request)
 }
 
+// GetNetworkservicesAlphaGateway handles the gRPC request by passing it to the underlying Gateway Get() method.
+func (s *GatewayServer) GetNetworkservicesAlphaGateway(ctx context.Context, request *alphapb.GetNetworkservicesAlphaGatewayRequest) (*alphapb.NetworkservicesAlphaGateway, error) {
+	cl, err := createConfigGateway(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGateway(ctx, ProtoToGateway(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GatewayToProto(res), nil
+}
+
+// HasDiffNetworkservicesAlphaGateway handles the gRPC request by passing it to the underlying Gateway Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GatewayServer) HasDiffNetworkservicesAlphaGateway(ctx context.Context, request *alphapb.HasDiffNetworkservicesAlphaGatewayRequest) (*alphapb.HasDiffNetworkservicesAlphaGatewayResponse, error) {
+	cl, err := createConfigGateway(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGateway(ctx, ProtoToGateway(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffNetworkservicesAlphaGatewayResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesAlphaGateway handles the gRPC request by passing it to the underlying Gateway Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GatewayServer) PlanNetworkservicesAlphaGateway(ctx context.Context, request *alphapb.PlanNetworkservicesAlphaGatewayRequest) (*alphapb.PlanNetworkservicesAlphaGatewayResponse, error) {
+	cl, err := createConfigGateway(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGateway(ctx, ProtoToGateway(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanNetworkservicesAlphaGatewayResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGateway handles the gRPC request by passing it to the underlying Gateway Delete() method.
 func (s *GatewayServer) DeleteNetworkservicesAlphaGateway(ctx context.Context, request *alphapb.DeleteNetworkservicesAlphaGatewayRequest) (*emptypb.Empty, error) {
 
@@ -140,22 +199,35 @@ func (s *GatewayServer) ListNetworkservicesAlphaGateway(ctx context.Context, req
 		return nil, err
 	}
 
-	resources, err := cl.ListGateway(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.GatewayMaxPage
+	}
+	resources, err := cl.ListGatewayWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworkservicesAlphaGateway
-	for _, r := range resources.Items {
-		rp := GatewayToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, GatewayToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListNetworkservicesAlphaGatewayResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigGateway(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/alpha/networkservices_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/alpha"
@@ -402,7 +403,11 @@ func GrpcRouteToProto(resource *alpha.GrpcRoute) *alphapb.NetworkservicesAlphaGr
 // applyGrpcRoute handles the gRPC request by passing it to the underlying GrpcRoute Apply() method.
 func (s *GrpcRouteServer) applyGrpcRoute(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworkservicesAlphaGrpcRouteRequest) (*alphapb.NetworkservicesAlphaGrpcRoute, error) {
 	p := ProtoToGrpcRoute(request.GetResource())
-	res, err := c.ApplyGrpcRoute(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGrpcRoute(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -419,6 +424,60 @@ func (s *GrpcRouteServer) ApplyNetworkservicesAlphaGrpcRoute(ctx context.Context
 	return s.applyGrpcRoute(ctx, cl, request)
 }
 
+// GetNetworkservicesAlphaGrpcRoute handles the gRPC request by passing it to the underlying GrpcRoute Get() method.
+func (s *GrpcRouteServer) GetNetworkservicesAlphaGrpcRoute(ctx context.Context, request *alphapb.GetNetworkservicesAlphaGrpcRouteRequest) (*alphapb.NetworkservicesAlphaGrpcRoute, error) {
+	cl, err := createConfigGrpcRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGrpcRoute(ctx, ProtoToGrpcRoute(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GrpcRouteToProto(res), nil
+}
+
+// HasDiffNetworkservicesAlphaGrpcRoute handles the gRPC request by passing it to the underlying GrpcRoute Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GrpcRouteServer) HasDiffNetworkservicesAlphaGrpcRoute(ctx context.Context, request *alphapb.HasDiffNetworkservicesAlphaGrpcRouteRequest) (*alphapb.HasDiffNetworkservicesAlphaGrpcRouteResponse, error) {
+	cl, err := createConfigGrpcRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGrpcRoute(ctx, ProtoToGrpcRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffNetworkservicesAlphaGrpcRouteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesAlphaGrpcRoute handles the gRPC request by passing it to the underlying GrpcRoute Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GrpcRouteServer) PlanNetworkservicesAlphaGrpcRoute(ctx context.Context, request *alphapb.PlanNetworkservicesAlphaGrpcRouteRequest) (*alphapb.PlanNetworkservicesAlphaGrpcRouteResponse, error) {
+	cl, err := createConfigGrpcRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGrpcRoute(ctx, ProtoToGrpcRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanNetworkservicesAlphaGrpcRouteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGrpcRoute handles the gRPC request by passing it to the underlying GrpcRoute Delete() method.
 func (s *GrpcRouteServer) DeleteNetworkservicesAlphaGrpcRoute(ctx context.Context, request *alphapb.DeleteNetworkservicesAlphaGrpcRouteRequest) (*emptypb.Empty, error) {
 
@@ -437,22 +496,35 @@ func (s *GrpcRouteServer) ListNetworkservicesAlphaGrpcRoute(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListGrpcRoute(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.GrpcRouteMaxPage
+	}
+	resources, err := cl.ListGrpcRouteWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworkservicesAlphaGrpcRoute
-	for _, r := range resources.Items {
-		rp := GrpcRouteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, GrpcRouteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListNetworkservicesAlphaGrpcRouteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigGrpcRoute(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
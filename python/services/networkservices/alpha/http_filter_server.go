@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/alpha/networkservices_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/alpha"
@@ -64,7 +65,11 @@ func HttpFilterToProto(resource *alpha.HttpFilter) *alphapb.NetworkservicesAlpha
 // applyHttpFilter handles the gRPC request by passing it to the underlying HttpFilter Apply() method.
 func (s *HttpFilterServer) applyHttpFilter(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworkservicesAlphaHttpFilterRequest) (*alphapb.NetworkservicesAlphaHttpFilter, error) {
 	p := ProtoToHttpFilter(request.GetResource())
-	res, err := c.ApplyHttpFilter(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyHttpFilter(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +86,60 @@ func (s *HttpFilterServer) ApplyNetworkservicesAlphaHttpFilter(ctx context.Conte
 	return s.applyHttpFilter(ctx, cl, request)
 }
 
+// GetNetworkservicesAlphaHttpFilter handles the gRPC request by passing it to the underlying HttpFilter Get() method.
+func (s *HttpFilterServer) GetNetworkservicesAlphaHttpFilter(ctx context.Context, request *alphapb.GetNetworkservicesAlphaHttpFilterRequest) (*alphapb.NetworkservicesAlphaHttpFilter, error) {
+	cl, err := createConfigHttpFilter(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetHttpFilter(ctx, ProtoToHttpFilter(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return HttpFilterToProto(res), nil
+}
+
+// HasDiffNetworkservicesAlphaHttpFilter handles the gRPC request by passing it to the underlying HttpFilter Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *HttpFilterServer) HasDiffNetworkservicesAlphaHttpFilter(ctx context.Context, request *alphapb.HasDiffNetworkservicesAlphaHttpFilterRequest) (*alphapb.HasDiffNetworkservicesAlphaHttpFilterResponse, error) {
+	cl, err := createConfigHttpFilter(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHttpFilter(ctx, ProtoToHttpFilter(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffNetworkservicesAlphaHttpFilterResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesAlphaHttpFilter handles the gRPC request by passing it to the underlying HttpFilter Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *HttpFilterServer) PlanNetworkservicesAlphaHttpFilter(ctx context.Context, request *alphapb.PlanNetworkservicesAlphaHttpFilterRequest) (*alphapb.PlanNetworkservicesAlphaHttpFilterResponse, error) {
+	cl, err := createConfigHttpFilter(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHttpFilter(ctx, ProtoToHttpFilter(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanNetworkservicesAlphaHttpFilterResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteHttpFilter handles the gRPC request by passing it to the underlying HttpFilter Delete() method.
 func (s *HttpFilterServer) DeleteNetworkservicesAlphaHttpFilter(ctx context.Context, request *alphapb.DeleteNetworkservicesAlphaHttpFilterRequest) (*emptypb.Empty, error) {
 
@@ -99,22 +158,35 @@ func (s *HttpFilterServer) ListNetworkservicesAlphaHttpFilter(ctx context.Contex
 		return nil, err
 	}
 
-	resources, err := cl.ListHttpFilter(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.HttpFilterMaxPage
+	}
+	resources, err := cl.ListHttpFilterWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworkservicesAlphaHttpFilter
-	for _, r := range resources.Items {
-		rp := HttpFilterToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, HttpFilterToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListNetworkservicesAlphaHttpFilterResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigHttpFilter(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/alpha/networkservices_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/alpha"
@@ -677,7 +678,11 @@ func HttpRouteToProto(resource *alpha.HttpRoute) *alphapb.NetworkservicesAlphaHt
 // applyHttpRoute handles the gRPC request by passing it to the underlying HttpRoute Apply() method.
 func (s *HttpRouteServer) applyHttpRoute(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworkservicesAlphaHttpRouteRequest) (*alphapb.NetworkservicesAlphaHttpRoute, error) {
 	p := ProtoToHttpRoute(request.GetResource())
-	res, err := c.ApplyHttpRoute(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyHttpRoute(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -694,6 +699,60 @@ func (s *HttpRouteServer) ApplyNetworkservicesAlphaHttpRoute(ctx context.Context
 	return s.applyHttpRoute(ctx, cl, request)
 }
 
+// GetNetworkservicesAlphaHttpRoute handles the gRPC request by passing it to the underlying HttpRoute Get() method.
+func (s *HttpRouteServer) GetNetworkservicesAlphaHttpRoute(ctx context.Context, request *alphapb.GetNetworkservicesAlphaHttpRouteRequest) (*alphapb.NetworkservicesAlphaHttpRoute, error) {
+	cl, err := createConfigHttpRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetHttpRoute(ctx, ProtoToHttpRoute(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return HttpRouteToProto(res), nil
+}
+
+// HasDiffNetworkservicesAlphaHttpRoute handles the gRPC request by passing it to the underlying HttpRoute Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *HttpRouteServer) HasDiffNetworkservicesAlphaHttpRoute(ctx context.Context, request *alphapb.HasDiffNetworkservicesAlphaHttpRouteRequest) (*alphapb.HasDiffNetworkservicesAlphaHttpRouteResponse, error) {
+	cl, err := createConfigHttpRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHttpRoute(ctx, ProtoToHttpRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffNetworkservicesAlphaHttpRouteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesAlphaHttpRoute handles the gRPC request by passing it to the underlying HttpRoute Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *HttpRouteServer) PlanNetworkservicesAlphaHttpRoute(ctx context.Context, request *alphapb.PlanNetworkservicesAlphaHttpRouteRequest) (*alphapb.PlanNetworkservicesAlphaHttpRouteResponse, error) {
+	cl, err := createConfigHttpRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHttpRoute(ctx, ProtoToHttpRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanNetworkservicesAlphaHttpRouteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteHttpRoute handles the gRPC request by passing it to the underlying HttpRoute Delete() method.
 func (s *HttpRouteServer) DeleteNetworkservicesAlphaHttpRoute(ctx context.Context, request *alphapb.DeleteNetworkservicesAlphaHttpRouteRequest) (*emptypb.Empty, error) {
 
@@ -712,22 +771,35 @@ func (s *HttpRouteServer) ListNetworkservicesAlphaHttpRoute(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListHttpRoute(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.HttpRouteMaxPage
+	}
+	resources, err := cl.ListHttpRouteWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworkservicesAlphaHttpRoute
-	for _, r := range resources.Items {
-		rp := HttpRouteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, HttpRouteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListNetworkservicesAlphaHttpRouteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigHttpRoute(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/alpha/networkservices_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/alpha"
@@ -62,7 +63,11 @@ func MeshToProto(resource *alpha.Mesh) *alphapb.NetworkservicesAlphaMesh {
 // applyMesh handles the gRPC request by passing it to the underlying Mesh Apply() method.
 func (s *MeshServer) applyMesh(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworkservicesAlphaMeshRequest) (*alphapb.NetworkservicesAlphaMesh, error) {
 	p := ProtoToMesh(request.GetResource())
-	res, err := c.ApplyMesh(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMesh(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +84,60 @@ func (s *MeshServer) ApplyNetworkservicesAlphaMesh(ctx context.Context, request
 	return s.applyMesh(ctx, cl, request)
 }
 
+// GetNetworkservicesAlphaMesh handles the gRPC request by passing it to the underlying Mesh Get() method.
+func (s *MeshServer) GetNetworkservicesAlphaMesh(ctx context.Context, request *alphapb.GetNetworkservicesAlphaMeshRequest) (*alphapb.NetworkservicesAlphaMesh, error) {
+	cl, err := createConfigMesh(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMesh(ctx, ProtoToMesh(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MeshToProto(res), nil
+}
+
+// HasDiffNetworkservicesAlphaMesh handles the gRPC request by passing it to the underlying Mesh Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MeshServer) HasDiffNetworkservicesAlphaMesh(ctx context.Context, request *alphapb.HasDiffNetworkservicesAlphaMeshRequest) (*alphapb.HasDiffNetworkservicesAlphaMeshResponse, error) {
+	cl, err := createConfigMesh(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMesh(ctx, ProtoToMesh(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffNetworkservicesAlphaMeshResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesAlphaMesh handles the gRPC request by passing it to the underlying Mesh Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MeshServer) PlanNetworkservicesAlphaMesh(ctx context.Context, request *alphapb.PlanNetworkservicesAlphaMeshRequest) (*alphapb.PlanNetworkservicesAlphaMeshResponse, error) {
+	cl, err := createConfigMesh(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMesh(ctx, ProtoToMesh(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanNetworkservicesAlphaMeshResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMesh handles the gRPC request by passing it to the underlying Mesh Delete() method.
 func (s *MeshServer) DeleteNetworkservicesAlphaMesh(ctx context.Context, request *alphapb.DeleteNetworkservicesAlphaMeshRequest) (*emptypb.Empty, error) {
 
@@ -97,22 +156,35 @@ func (s *MeshServer) ListNetworkservicesAlphaMesh(ctx context.Context, request *
 		return nil, err
 	}
 
-	resources, err := cl.ListMesh(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.MeshMaxPage
+	}
+	resources, err := cl.ListMeshWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworkservicesAlphaMesh
-	for _, r := range resources.Items {
-		rp := MeshToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MeshToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListNetworkservicesAlphaMeshResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMesh(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/alpha/networkservices_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/alpha"
@@ -188,7 +189,11 @@ func TcpRouteToProto(resource *alpha.TcpRoute) *alphapb.NetworkservicesAlphaTcpR
 // applyTcpRoute handles the gRPC request by passing it to the underlying TcpRoute Apply() method.
 func (s *TcpRouteServer) applyTcpRoute(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworkservicesAlphaTcpRouteRequest) (*alphapb.NetworkservicesAlphaTcpRoute, error) {
 	p := ProtoToTcpRoute(request.GetResource())
-	res, err := c.ApplyTcpRoute(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTcpRoute(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -205,6 +210,60 @@ func (s *TcpRouteServer) ApplyNetworkservicesAlphaTcpRoute(ctx context.Context,
 	return s.applyTcpRoute(ctx, cl, request)
 }
 
+// GetNetworkservicesAlphaTcpRoute handles the gRPC request by passing it to the underlying TcpRoute Get() method.
+func (s *TcpRouteServer) GetNetworkservicesAlphaTcpRoute(ctx context.Context, request *alphapb.GetNetworkservicesAlphaTcpRouteRequest) (*alphapb.NetworkservicesAlphaTcpRoute, error) {
+	cl, err := createConfigTcpRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTcpRoute(ctx, ProtoToTcpRoute(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TcpRouteToProto(res), nil
+}
+
+// HasDiffNetworkservicesAlphaTcpRoute handles the gRPC request by passing it to the underlying TcpRoute Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TcpRouteServer) HasDiffNetworkservicesAlphaTcpRoute(ctx context.Context, request *alphapb.HasDiffNetworkservicesAlphaTcpRouteRequest) (*alphapb.HasDiffNetworkservicesAlphaTcpRouteResponse, error) {
+	cl, err := createConfigTcpRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTcpRoute(ctx, ProtoToTcpRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffNetworkservicesAlphaTcpRouteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesAlphaTcpRoute handles the gRPC request by passing it to the underlying TcpRoute Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TcpRouteServer) PlanNetworkservicesAlphaTcpRoute(ctx context.Context, request *alphapb.PlanNetworkservicesAlphaTcpRouteRequest) (*alphapb.PlanNetworkservicesAlphaTcpRouteResponse, error) {
+	cl, err := createConfigTcpRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTcpRoute(ctx, ProtoToTcpRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanNetworkservicesAlphaTcpRouteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTcpRoute handles the gRPC request by passing it to the underlying TcpRoute Delete() method.
 func (s *TcpRouteServer) DeleteNetworkservicesAlphaTcpRoute(ctx context.Context, request *alphapb.DeleteNetworkservicesAlphaTcpRouteRequest) (*emptypb.Empty, error) {
 
@@ -223,22 +282,35 @@ func (s *TcpRouteServer) ListNetworkservicesAlphaTcpRoute(ctx context.Context, r
 		return nil, err
 	}
 
-	resources, err := cl.ListTcpRoute(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.TcpRouteMaxPage
+	}
+	resources, err := cl.ListTcpRouteWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworkservicesAlphaTcpRoute
-	for _, r := range resources.Items {
-		rp := TcpRouteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TcpRouteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListNetworkservicesAlphaTcpRouteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTcpRoute(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/alpha/networkservices_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/alpha"
@@ -191,7 +192,11 @@ func TlsRouteToProto(resource *alpha.TlsRoute) *alphapb.NetworkservicesAlphaTlsR
 // applyTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Apply() method.
 func (s *TlsRouteServer) applyTlsRoute(ctx context.Context, c *alpha.Client, request *alphapb.ApplyNetworkservicesAlphaTlsRouteRequest) (*alphapb.NetworkservicesAlphaTlsRoute, error) {
 	p := ProtoToTlsRoute(request.GetResource())
-	res, err := c.ApplyTlsRoute(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTlsRoute(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -208,6 +213,60 @@ func (s *TlsRouteServer) ApplyNetworkservicesAlphaTlsRoute(ctx context.Context,
 	return s.applyTlsRoute(ctx, cl, request)
 }
 
+// GetNetworkservicesAlphaTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Get() method.
+func (s *TlsRouteServer) GetNetworkservicesAlphaTlsRoute(ctx context.Context, request *alphapb.GetNetworkservicesAlphaTlsRouteRequest) (*alphapb.NetworkservicesAlphaTlsRoute, error) {
+	cl, err := createConfigTlsRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTlsRoute(ctx, ProtoToTlsRoute(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TlsRouteToProto(res), nil
+}
+
+// HasDiffNetworkservicesAlphaTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TlsRouteServer) HasDiffNetworkservicesAlphaTlsRoute(ctx context.Context, request *alphapb.HasDiffNetworkservicesAlphaTlsRouteRequest) (*alphapb.HasDiffNetworkservicesAlphaTlsRouteResponse, error) {
+	cl, err := createConfigTlsRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTlsRoute(ctx, ProtoToTlsRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffNetworkservicesAlphaTlsRouteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesAlphaTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TlsRouteServer) PlanNetworkservicesAlphaTlsRoute(ctx context.Context, request *alphapb.PlanNetworkservicesAlphaTlsRouteRequest) (*alphapb.PlanNetworkservicesAlphaTlsRouteResponse, error) {
+	cl, err := createConfigTlsRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTlsRoute(ctx, ProtoToTlsRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanNetworkservicesAlphaTlsRouteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Delete() method.
 func (s *TlsRouteServer) DeleteNetworkservicesAlphaTlsRoute(ctx context.Context, request *alphapb.DeleteNetworkservicesAlphaTlsRouteRequest) (*emptypb.Empty, error) {
 
@@ -226,22 +285,35 @@ func (s *TlsRouteServer) ListNetworkservicesAlphaTlsRoute(ctx context.Context, r
 		return nil, err
 	}
 
-	resources, err := cl.ListTlsRoute(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.TlsRouteMaxPage
+	}
+	resources, err := cl.ListTlsRouteWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.NetworkservicesAlphaTlsRoute
-	for _, r := range resources.Items {
-		rp := TlsRouteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TlsRouteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListNetworkservicesAlphaTlsRouteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTlsRoute(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
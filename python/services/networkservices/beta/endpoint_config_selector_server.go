@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/beta/networkservices_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/beta"
@@ -237,7 +238,11 @@ func EndpointConfigSelectorToProto(resource *beta.EndpointConfigSelector) *betap
 // ApplyEndpointConfigSelector handles the gRPC request by passing it to the underlying EndpointConfigSelector Apply() method.
 func (s *EndpointConfigSelectorServer) applyEndpointConfigSelector(ctx context.Context, c *beta.Client, request *betapb.ApplyNetworkservicesBetaEndpointConfigSelectorRequest) (*betapb.NetworkservicesBetaEndpointConfigSelector, error) {
 	p := ProtoToEndpointConfigSelector(request.GetResource())
-	res, err := c.ApplyEndpointConfigSelector(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEndpointConfigSelector(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -254,6 +259,55 @@ func (s *EndpointConfigSelectorServer) ApplyNetworkservicesBetaEndpointConfigSel
 	return s.applyEndpointConfigSelector(ctx, cl, request)
 }
 
+// GetNetworkservicesBetaEndpointConfigSelector handles the gRPC request by passing it to the underlying EndpointConfigSelector Get() method.
+func (s *EndpointConfigSelectorServer) GetNetworkservicesBetaEndpointConfigSelector(ctx context.Context, request *betapb.GetNetworkservicesBetaEndpointConfigSelectorRequest) (*betapb.NetworkservicesBetaEndpointConfigSelector, error) {
+	cl, err := createConfigEndpointConfigSelector(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEndpointConfigSelector(ctx, ProtoToEndpointConfigSelector(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointConfigSelectorToProto(res), nil
+}
+
+// HasDiffNetworkservicesBetaEndpointConfigSelector handles the gRPC request by passing it to the underlying EndpointConfigSelector Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EndpointConfigSelectorServer) HasDiffNetworkservicesBetaEndpointConfigSelector(ctx context.Context, request *betapb.HasDiffNetworkservicesBetaEndpointConfigSelectorRequest) (*betapb.HasDiffNetworkservicesBetaEndpointConfigSelectorResponse, error) {
+	cl, err := createConfigEndpointConfigSelector(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointConfigSelector(ctx, ProtoToEndpointConfigSelector(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffNetworkservicesBetaEndpointConfigSelectorResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanNetworkservicesBetaEndpointConfigSelector handles the gRPC request by passing it to the underlying EndpointConfigSelector Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EndpointConfigSelectorServer) PlanNetworkservicesBetaEndpointConfigSelector(ctx context.Context, request *betapb.PlanNetworkservicesBetaEndpointConfigSelectorRequest) (*betapb.PlanNetworkservicesBetaEndpointConfigSelectorResponse, error) {
+	cl, err := createConfigEndpointConfigSelector(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointConfigSelector(ctx, ProtoToEndpointConfigSelector(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanNetworkservicesBetaEndpointConfigSelectorResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteEndpointConfigSelector handles the gRPC request by passing it to the underlying EndpointConfigSelector Delete() method.
 func (s *EndpointConfigSelectorServer) DeleteNetworkservicesBetaEndpointConfigSelector(ctx context.Context, request *betapb.DeleteNetworkservicesBetaEndpointConfigSelectorRequest) (*emptypb.Empty, error) {
 
@@ -272,20 +326,32 @@ func (s *EndpointConfigSelectorServer) ListNetworkservicesBetaEndpointConfigSele
 		return nil, err
 	}
 
-	resources, err := cl.ListEndpointConfigSelector(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.EndpointConfigSelectorMaxPage
+	}
+	resources, err := cl.ListEndpointConfigSelectorWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.NetworkservicesBetaEndpointConfigSelector
-	for _, r := range resources.Items {
-		rp := EndpointConfigSelectorToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EndpointConfigSelectorToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListNetworkservicesBetaEndpointConfigSelectorResponse{Items: protos}, nil
+	return &betapb.ListNetworkservicesBetaEndpointConfigSelectorResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigEndpointConfigSelector(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/beta/networkservices_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/beta"
@@ -215,7 +216,11 @@ func EndpointPolicyToProto(resource *beta.EndpointPolicy) *betapb.Networkservice
 // applyEndpointPolicy handles the gRPC request by passing it to the underlying EndpointPolicy Apply() method.
 func (s *EndpointPolicyServer) applyEndpointPolicy(ctx context.Context, c *beta.Client, request *betapb.ApplyNetworkservicesBetaEndpointPolicyRequest) (*betapb.NetworkservicesBetaEndpointPolicy, error) {
 	p := ProtoToEndpointPolicy(request.GetResource())
-	res, err := c.ApplyEndpointPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEndpointPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -232,6 +237,60 @@ func (s *EndpointPolicyServer) ApplyNetworkservicesBetaEndpointPolicy(ctx contex
 	return s.applyEndpointPolicy(ctx, cl, request)
 }
 
+// GetNetworkservicesBetaEndpointPolicy handles the gRPC request by passing it to the underlying EndpointPolicy Get() method.
+func (s *EndpointPolicyServer) GetNetworkservicesBetaEndpointPolicy(ctx context.Context, request *betapb.GetNetworkservicesBetaEndpointPolicyRequest) (*betapb.NetworkservicesBetaEndpointPolicy, error) {
+	cl, err := createConfigEndpointPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEndpointPolicy(ctx, ProtoToEndpointPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointPolicyToProto(res), nil
+}
+
+// HasDiffNetworkservicesBetaEndpointPolicy handles the gRPC request by passing it to the underlying EndpointPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EndpointPolicyServer) HasDiffNetworkservicesBetaEndpointPolicy(ctx context.Context, request *betapb.HasDiffNetworkservicesBetaEndpointPolicyRequest) (*betapb.HasDiffNetworkservicesBetaEndpointPolicyResponse, error) {
+	cl, err := createConfigEndpointPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointPolicy(ctx, ProtoToEndpointPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffNetworkservicesBetaEndpointPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesBetaEndpointPolicy handles the gRPC request by passing it to the underlying EndpointPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EndpointPolicyServer) PlanNetworkservicesBetaEndpointPolicy(ctx context.Context, request *betapb.PlanNetworkservicesBetaEndpointPolicyRequest) (*betapb.PlanNetworkservicesBetaEndpointPolicyResponse, error) {
+	cl, err := createConfigEndpointPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointPolicy(ctx, ProtoToEndpointPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanNetworkservicesBetaEndpointPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEndpointPolicy handles the gRPC request by passing it to the underlying EndpointPolicy Delete() method.
 func (s *EndpointPolicyServer) DeleteNetworkservicesBetaEndpointPolicy(ctx context.Context, request *betapb.DeleteNetworkservicesBetaEndpointPolicyRequest) (*emptypb.Empty, error) {
 
@@ -250,22 +309,35 @@ func (s *EndpointPolicyServer) ListNetworkservicesBetaEndpointPolicy(ctx context
 		return nil, err
 	}
 
-	resources, err := cl.ListEndpointPolicy(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.EndpointPolicyMaxPage
+	}
+	resources, err := cl.ListEndpointPolicyWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.NetworkservicesBetaEndpointPolicy
-	for _, r := range resources.Items {
-		rp := EndpointPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EndpointPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListNetworkservicesBetaEndpointPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEndpointPolicy(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
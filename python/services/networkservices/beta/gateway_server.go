@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/beta/networkservices_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/beta"
@@ -105,7 +106,11 @@ func GatewayToProto(resource *beta.Gateway) *betapb.NetworkservicesBetaGateway {
 // applyGateway handles the gRPC request by passing it to the underlying Gateway Apply() method.
 func (s *GatewayServer) applyGateway(ctx context.Context, c *beta.Client, request *betapb.ApplyNetworkservicesBetaGatewayRequest) (*betapb.NetworkservicesBetaGateway, error) {
 	p := ProtoToGateway(request.GetResource())
-	res, err := c.ApplyGateway(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGateway(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +127,60 @@ func (s *GatewayServer) ApplyNetworkservicesBetaGateway(ctx context.Context, req
 	return s.applyGateway(ctx, cl, request)
 }
 
+// GetNetworkservicesBetaGateway handles the gRPC request by passing it to the underlying Gateway Get() method.
+func (s *GatewayServer) GetNetworkservicesBetaGateway(ctx context.Context, request *betapb.GetNetworkservicesBetaGatewayRequest) (*betapb.NetworkservicesBetaGateway, error) {
+	cl, err := createConfigGateway(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGateway(ctx, ProtoToGateway(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GatewayToProto(res), nil
+}
+
+// HasDiffNetworkservicesBetaGateway handles the gRPC request by passing it to the underlying Gateway Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GatewayServer) HasDiffNetworkservicesBetaGateway(ctx context.Context, request *betapb.HasDiffNetworkservicesBetaGatewayRequest) (*betapb.HasDiffNetworkservicesBetaGatewayResponse, error) {
+	cl, err := createConfigGateway(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGateway(ctx, ProtoToGateway(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffNetworkservicesBetaGatewayResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesBetaGateway handles the gRPC request by passing it to the underlying Gateway Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GatewayServer) PlanNetworkservicesBetaGateway(ctx context.Context, request *betapb.PlanNetworkservicesBetaGatewayRequest) (*betapb.PlanNetworkservicesBetaGatewayResponse, error) {
+	cl, err := createConfigGateway(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGateway(ctx, ProtoToGateway(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanNetworkservicesBetaGatewayResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGateway handles the gRPC request by passing it to the underlying Gateway Delete() method.
 func (s *GatewayServer) DeleteNetworkservicesBetaGateway(ctx context.Context, request *betapb.DeleteNetworkservicesBetaGatewayRequest) (*emptypb.Empty, error) {
 
@@ -140,22 +199,35 @@ func (s *GatewayServer) ListNetworkservicesBetaGateway(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListGateway(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.GatewayMaxPage
+	}
+	resources, err := cl.ListGatewayWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.NetworkservicesBetaGateway
-	for _, r := range resources.Items {
-		rp := GatewayToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, GatewayToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListNetworkservicesBetaGatewayResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigGateway(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
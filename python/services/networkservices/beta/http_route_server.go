@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/beta/networkservices_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/beta"
@@ -677,7 +678,11 @@ func HttpRouteToProto(resource *beta.HttpRoute) *betapb.NetworkservicesBetaHttpR
 // applyHttpRoute handles the gRPC request by passing it to the underlying HttpRoute Apply() method.
 func (s *HttpRouteServer) applyHttpRoute(ctx context.Context, c *beta.Client, request *betapb.ApplyNetworkservicesBetaHttpRouteRequest) (*betapb.NetworkservicesBetaHttpRoute, error) {
 	p := ProtoToHttpRoute(request.GetResource())
-	res, err := c.ApplyHttpRoute(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyHttpRoute(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -694,6 +699,60 @@ func (s *HttpRouteServer) ApplyNetworkservicesBetaHttpRoute(ctx context.Context,
 	return s.applyHttpRoute(ctx, cl, request)
 }
 
+// GetNetworkservicesBetaHttpRoute handles the gRPC request by passing it to the underlying HttpRoute Get() method.
+func (s *HttpRouteServer) GetNetworkservicesBetaHttpRoute(ctx context.Context, request *betapb.GetNetworkservicesBetaHttpRouteRequest) (*betapb.NetworkservicesBetaHttpRoute, error) {
+	cl, err := createConfigHttpRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetHttpRoute(ctx, ProtoToHttpRoute(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return HttpRouteToProto(res), nil
+}
+
+// HasDiffNetworkservicesBetaHttpRoute handles the gRPC request by passing it to the underlying HttpRoute Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *HttpRouteServer) HasDiffNetworkservicesBetaHttpRoute(ctx context.Context, request *betapb.HasDiffNetworkservicesBetaHttpRouteRequest) (*betapb.HasDiffNetworkservicesBetaHttpRouteResponse, error) {
+	cl, err := createConfigHttpRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHttpRoute(ctx, ProtoToHttpRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffNetworkservicesBetaHttpRouteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesBetaHttpRoute handles the gRPC request by passing it to the underlying HttpRoute Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *HttpRouteServer) PlanNetworkservicesBetaHttpRoute(ctx context.Context, request *betapb.PlanNetworkservicesBetaHttpRouteRequest) (*betapb.PlanNetworkservicesBetaHttpRouteResponse, error) {
+	cl, err := createConfigHttpRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHttpRoute(ctx, ProtoToHttpRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanNetworkservicesBetaHttpRouteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteHttpRoute handles the gRPC request by passing it to the underlying HttpRoute Delete() method.
 func (s *HttpRouteServer) DeleteNetworkservicesBetaHttpRoute(ctx context.Context, request *betapb.DeleteNetworkservicesBetaHttpRouteRequest) (*emptypb.Empty, error) {
 
@@ -712,22 +771,35 @@ func (s *HttpRouteServer) ListNetworkservicesBetaHttpRoute(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListHttpRoute(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.HttpRouteMaxPage
+	}
+	resources, err := cl.ListHttpRouteWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.NetworkservicesBetaHttpRoute
-	for _, r := range resources.Items {
-		rp := HttpRouteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, HttpRouteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListNetworkservicesBetaHttpRouteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigHttpRoute(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
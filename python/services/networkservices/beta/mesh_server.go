@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/beta/networkservices_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/beta"
@@ -62,7 +63,11 @@ func MeshToProto(resource *beta.Mesh) *betapb.NetworkservicesBetaMesh {
 // applyMesh handles the gRPC request by passing it to the underlying Mesh Apply() method.
 func (s *MeshServer) applyMesh(ctx context.Context, c *beta.Client, request *betapb.ApplyNetworkservicesBetaMeshRequest) (*betapb.NetworkservicesBetaMesh, error) {
 	p := ProtoToMesh(request.GetResource())
-	res, err := c.ApplyMesh(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMesh(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +84,60 @@ func (s *MeshServer) ApplyNetworkservicesBetaMesh(ctx context.Context, request *
 	return s.applyMesh(ctx, cl, request)
 }
 
+// GetNetworkservicesBetaMesh handles the gRPC request by passing it to the underlying Mesh Get() method.
+func (s *MeshServer) GetNetworkservicesBetaMesh(ctx context.Context, request *betapb.GetNetworkservicesBetaMeshRequest) (*betapb.NetworkservicesBetaMesh, error) {
+	cl, err := createConfigMesh(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMesh(ctx, ProtoToMesh(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MeshToProto(res), nil
+}
+
+// HasDiffNetworkservicesBetaMesh handles the gRPC request by passing it to the underlying Mesh Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MeshServer) HasDiffNetworkservicesBetaMesh(ctx context.Context, request *betapb.HasDiffNetworkservicesBetaMeshRequest) (*betapb.HasDiffNetworkservicesBetaMeshResponse, error) {
+	cl, err := createConfigMesh(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMesh(ctx, ProtoToMesh(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffNetworkservicesBetaMeshResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesBetaMesh handles the gRPC request by passing it to the underlying Mesh Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MeshServer) PlanNetworkservicesBetaMesh(ctx context.Context, request *betapb.PlanNetworkservicesBetaMeshRequest) (*betapb.PlanNetworkservicesBetaMeshResponse, error) {
+	cl, err := createConfigMesh(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMesh(ctx, ProtoToMesh(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanNetworkservicesBetaMeshResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMesh handles the gRPC request by passing it to the underlying Mesh Delete() method.
 func (s *MeshServer) DeleteNetworkservicesBetaMesh(ctx context.Context, request *betapb.DeleteNetworkservicesBetaMeshRequest) (*emptypb.Empty, error) {
 
@@ -97,22 +156,35 @@ func (s *MeshServer) ListNetworkservicesBetaMesh(ctx context.Context, request *b
 		return nil, err
 	}
 
-	resources, err := cl.ListMesh(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.MeshMaxPage
+	}
+	resources, err := cl.ListMeshWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.NetworkservicesBetaMesh
-	for _, r := range resources.Items {
-		rp := MeshToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MeshToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListNetworkservicesBetaMeshResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMesh(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
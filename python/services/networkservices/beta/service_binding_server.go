@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/beta/networkservices_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/beta"
@@ -60,7 +61,11 @@ func ServiceBindingToProto(resource *beta.ServiceBinding) *betapb.Networkservice
 // applyServiceBinding handles the gRPC request by passing it to the underlying ServiceBinding Apply() method.
 func (s *ServiceBindingServer) applyServiceBinding(ctx context.Context, c *beta.Client, request *betapb.ApplyNetworkservicesBetaServiceBindingRequest) (*betapb.NetworkservicesBetaServiceBinding, error) {
 	p := ProtoToServiceBinding(request.GetResource())
-	res, err := c.ApplyServiceBinding(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyServiceBinding(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +82,60 @@ func (s *ServiceBindingServer) ApplyNetworkservicesBetaServiceBinding(ctx contex
 	return s.applyServiceBinding(ctx, cl, request)
 }
 
+// GetNetworkservicesBetaServiceBinding handles the gRPC request by passing it to the underlying ServiceBinding Get() method.
+func (s *ServiceBindingServer) GetNetworkservicesBetaServiceBinding(ctx context.Context, request *betapb.GetNetworkservicesBetaServiceBindingRequest) (*betapb.NetworkservicesBetaServiceBinding, error) {
+	cl, err := createConfigServiceBinding(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetServiceBinding(ctx, ProtoToServiceBinding(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceBindingToProto(res), nil
+}
+
+// HasDiffNetworkservicesBetaServiceBinding handles the gRPC request by passing it to the underlying ServiceBinding Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceBindingServer) HasDiffNetworkservicesBetaServiceBinding(ctx context.Context, request *betapb.HasDiffNetworkservicesBetaServiceBindingRequest) (*betapb.HasDiffNetworkservicesBetaServiceBindingResponse, error) {
+	cl, err := createConfigServiceBinding(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceBinding(ctx, ProtoToServiceBinding(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffNetworkservicesBetaServiceBindingResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesBetaServiceBinding handles the gRPC request by passing it to the underlying ServiceBinding Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceBindingServer) PlanNetworkservicesBetaServiceBinding(ctx context.Context, request *betapb.PlanNetworkservicesBetaServiceBindingRequest) (*betapb.PlanNetworkservicesBetaServiceBindingResponse, error) {
+	cl, err := createConfigServiceBinding(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceBinding(ctx, ProtoToServiceBinding(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanNetworkservicesBetaServiceBindingResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteServiceBinding handles the gRPC request by passing it to the underlying ServiceBinding Delete() method.
 func (s *ServiceBindingServer) DeleteNetworkservicesBetaServiceBinding(ctx context.Context, request *betapb.DeleteNetworkservicesBetaServiceBindingRequest) (*emptypb.Empty, error) {
 
@@ -95,22 +154,35 @@ func (s *ServiceBindingServer) ListNetworkservicesBetaServiceBinding(ctx context
 		return nil, err
 	}
 
-	resources, err := cl.ListServiceBinding(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ServiceBindingMaxPage
+	}
+	resources, err := cl.ListServiceBindingWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.NetworkservicesBetaServiceBinding
-	for _, r := range resources.Items {
-		rp := ServiceBindingToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceBindingToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListNetworkservicesBetaServiceBindingResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigServiceBinding(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/beta/networkservices_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices/beta"
@@ -191,7 +192,11 @@ func TlsRouteToProto(resource *beta.TlsRoute) *betapb.NetworkservicesBetaTlsRout
 // applyTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Apply() method.
 func (s *TlsRouteServer) applyTlsRoute(ctx context.Context, c *beta.Client, request *betapb.ApplyNetworkservicesBetaTlsRouteRequest) (*betapb.NetworkservicesBetaTlsRoute, error) {
 	p := ProtoToTlsRoute(request.GetResource())
-	res, err := c.ApplyTlsRoute(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTlsRoute(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -208,6 +213,60 @@ func (s *TlsRouteServer) ApplyNetworkservicesBetaTlsRoute(ctx context.Context, r
 	return s.applyTlsRoute(ctx, cl, request)
 }
 
+// GetNetworkservicesBetaTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Get() method.
+func (s *TlsRouteServer) GetNetworkservicesBetaTlsRoute(ctx context.Context, request *betapb.GetNetworkservicesBetaTlsRouteRequest) (*betapb.NetworkservicesBetaTlsRoute, error) {
+	cl, err := createConfigTlsRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTlsRoute(ctx, ProtoToTlsRoute(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TlsRouteToProto(res), nil
+}
+
+// HasDiffNetworkservicesBetaTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TlsRouteServer) HasDiffNetworkservicesBetaTlsRoute(ctx context.Context, request *betapb.HasDiffNetworkservicesBetaTlsRouteRequest) (*betapb.HasDiffNetworkservicesBetaTlsRouteResponse, error) {
+	cl, err := createConfigTlsRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTlsRoute(ctx, ProtoToTlsRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffNetworkservicesBetaTlsRouteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesBetaTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TlsRouteServer) PlanNetworkservicesBetaTlsRoute(ctx context.Context, request *betapb.PlanNetworkservicesBetaTlsRouteRequest) (*betapb.PlanNetworkservicesBetaTlsRouteResponse, error) {
+	cl, err := createConfigTlsRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTlsRoute(ctx, ProtoToTlsRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanNetworkservicesBetaTlsRouteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Delete() method.
 func (s *TlsRouteServer) DeleteNetworkservicesBetaTlsRoute(ctx context.Context, request *betapb.DeleteNetworkservicesBetaTlsRouteRequest) (*emptypb.Empty, error) {
 
@@ -226,22 +285,35 @@ func (s *TlsRouteServer) ListNetworkservicesBetaTlsRoute(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListTlsRoute(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.TlsRouteMaxPage
+	}
+	resources, err := cl.ListTlsRouteWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.NetworkservicesBetaTlsRoute
-	for _, r := range resources.Items {
-		rp := TlsRouteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TlsRouteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListNetworkservicesBetaTlsRouteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTlsRoute(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	networkservicespb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/networkservices_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices"
@@ -105,7 +106,11 @@ func GatewayToProto(resource *networkservices.Gateway) *networkservicespb.Networ
 // applyGateway handles the gRPC request by passing it to the underlying Gateway Apply() method.
 func (s *GatewayServer) applyGateway(ctx context.Context, c *networkservices.Client, request *networkservicespb.ApplyNetworkservicesGatewayRequest) (*networkservicespb.NetworkservicesGateway, error) {
 	p := ProtoToGateway(request.GetResource())
-	res, err := c.ApplyGateway(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGateway(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +127,60 @@ func (s *GatewayServer) ApplyNetworkservicesGateway(ctx context.Context, request
 	return s.applyGateway(ctx, cl, request)
 }
 
+// GetNetworkservicesGateway handles the gRPC request by passing it to the underlying Gateway Get() method.
+func (s *GatewayServer) GetNetworkservicesGateway(ctx context.Context, request *networkservicespb.GetNetworkservicesGatewayRequest) (*networkservicespb.NetworkservicesGateway, error) {
+	cl, err := createConfigGateway(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGateway(ctx, ProtoToGateway(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GatewayToProto(res), nil
+}
+
+// HasDiffNetworkservicesGateway handles the gRPC request by passing it to the underlying Gateway Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GatewayServer) HasDiffNetworkservicesGateway(ctx context.Context, request *networkservicespb.HasDiffNetworkservicesGatewayRequest) (*networkservicespb.HasDiffNetworkservicesGatewayResponse, error) {
+	cl, err := createConfigGateway(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGateway(ctx, ProtoToGateway(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &networkservicespb.HasDiffNetworkservicesGatewayResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesGateway handles the gRPC request by passing it to the underlying Gateway Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GatewayServer) PlanNetworkservicesGateway(ctx context.Context, request *networkservicespb.PlanNetworkservicesGatewayRequest) (*networkservicespb.PlanNetworkservicesGatewayResponse, error) {
+	cl, err := createConfigGateway(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGateway(ctx, ProtoToGateway(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &networkservicespb.PlanNetworkservicesGatewayResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGateway handles the gRPC request by passing it to the underlying Gateway Delete() method.
 func (s *GatewayServer) DeleteNetworkservicesGateway(ctx context.Context, request *networkservicespb.DeleteNetworkservicesGatewayRequest) (*emptypb.Empty, error) {
 
@@ -140,22 +199,35 @@ func (s *GatewayServer) ListNetworkservicesGateway(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListGateway(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = networkservices.GatewayMaxPage
+	}
+	resources, err := cl.ListGatewayWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*networkservicespb.NetworkservicesGateway
-	for _, r := range resources.Items {
-		rp := GatewayToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, GatewayToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &networkservicespb.ListNetworkservicesGatewayResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigGateway(ctx context.Context, service_account_file string) (*networkservices.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return networkservices.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	networkservicespb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/networkservices_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices"
@@ -62,7 +63,11 @@ func MeshToProto(resource *networkservices.Mesh) *networkservicespb.Networkservi
 // applyMesh handles the gRPC request by passing it to the underlying Mesh Apply() method.
 func (s *MeshServer) applyMesh(ctx context.Context, c *networkservices.Client, request *networkservicespb.ApplyNetworkservicesMeshRequest) (*networkservicespb.NetworkservicesMesh, error) {
 	p := ProtoToMesh(request.GetResource())
-	res, err := c.ApplyMesh(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMesh(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +84,60 @@ func (s *MeshServer) ApplyNetworkservicesMesh(ctx context.Context, request *netw
 	return s.applyMesh(ctx, cl, request)
 }
 
+// GetNetworkservicesMesh handles the gRPC request by passing it to the underlying Mesh Get() method.
+func (s *MeshServer) GetNetworkservicesMesh(ctx context.Context, request *networkservicespb.GetNetworkservicesMeshRequest) (*networkservicespb.NetworkservicesMesh, error) {
+	cl, err := createConfigMesh(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMesh(ctx, ProtoToMesh(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MeshToProto(res), nil
+}
+
+// HasDiffNetworkservicesMesh handles the gRPC request by passing it to the underlying Mesh Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MeshServer) HasDiffNetworkservicesMesh(ctx context.Context, request *networkservicespb.HasDiffNetworkservicesMeshRequest) (*networkservicespb.HasDiffNetworkservicesMeshResponse, error) {
+	cl, err := createConfigMesh(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMesh(ctx, ProtoToMesh(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &networkservicespb.HasDiffNetworkservicesMeshResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesMesh handles the gRPC request by passing it to the underlying Mesh Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MeshServer) PlanNetworkservicesMesh(ctx context.Context, request *networkservicespb.PlanNetworkservicesMeshRequest) (*networkservicespb.PlanNetworkservicesMeshResponse, error) {
+	cl, err := createConfigMesh(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMesh(ctx, ProtoToMesh(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &networkservicespb.PlanNetworkservicesMeshResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMesh handles the gRPC request by passing it to the underlying Mesh Delete() method.
 func (s *MeshServer) DeleteNetworkservicesMesh(ctx context.Context, request *networkservicespb.DeleteNetworkservicesMeshRequest) (*emptypb.Empty, error) {
 
@@ -97,22 +156,35 @@ func (s *MeshServer) ListNetworkservicesMesh(ctx context.Context, request *netwo
 		return nil, err
 	}
 
-	resources, err := cl.ListMesh(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = networkservices.MeshMaxPage
+	}
+	resources, err := cl.ListMeshWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*networkservicespb.NetworkservicesMesh
-	for _, r := range resources.Items {
-		rp := MeshToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MeshToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &networkservicespb.ListNetworkservicesMeshResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMesh(ctx context.Context, service_account_file string) (*networkservices.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return networkservices.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	networkservicespb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/networkservices_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices"
@@ -188,7 +189,11 @@ func TcpRouteToProto(resource *networkservices.TcpRoute) *networkservicespb.Netw
 // applyTcpRoute handles the gRPC request by passing it to the underlying TcpRoute Apply() method.
 func (s *TcpRouteServer) applyTcpRoute(ctx context.Context, c *networkservices.Client, request *networkservicespb.ApplyNetworkservicesTcpRouteRequest) (*networkservicespb.NetworkservicesTcpRoute, error) {
 	p := ProtoToTcpRoute(request.GetResource())
-	res, err := c.ApplyTcpRoute(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTcpRoute(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -205,6 +210,60 @@ func (s *TcpRouteServer) ApplyNetworkservicesTcpRoute(ctx context.Context, reque
 	return s.applyTcpRoute(ctx, cl, request)
 }
 
+// GetNetworkservicesTcpRoute handles the gRPC request by passing it to the underlying TcpRoute Get() method.
+func (s *TcpRouteServer) GetNetworkservicesTcpRoute(ctx context.Context, request *networkservicespb.GetNetworkservicesTcpRouteRequest) (*networkservicespb.NetworkservicesTcpRoute, error) {
+	cl, err := createConfigTcpRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTcpRoute(ctx, ProtoToTcpRoute(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TcpRouteToProto(res), nil
+}
+
+// HasDiffNetworkservicesTcpRoute handles the gRPC request by passing it to the underlying TcpRoute Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TcpRouteServer) HasDiffNetworkservicesTcpRoute(ctx context.Context, request *networkservicespb.HasDiffNetworkservicesTcpRouteRequest) (*networkservicespb.HasDiffNetworkservicesTcpRouteResponse, error) {
+	cl, err := createConfigTcpRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTcpRoute(ctx, ProtoToTcpRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &networkservicespb.HasDiffNetworkservicesTcpRouteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesTcpRoute handles the gRPC request by passing it to the underlying TcpRoute Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TcpRouteServer) PlanNetworkservicesTcpRoute(ctx context.Context, request *networkservicespb.PlanNetworkservicesTcpRouteRequest) (*networkservicespb.PlanNetworkservicesTcpRouteResponse, error) {
+	cl, err := createConfigTcpRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTcpRoute(ctx, ProtoToTcpRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &networkservicespb.PlanNetworkservicesTcpRouteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTcpRoute handles the gRPC request by passing it to the underlying TcpRoute Delete() method.
 func (s *TcpRouteServer) DeleteNetworkservicesTcpRoute(ctx context.Context, request *networkservicespb.DeleteNetworkservicesTcpRouteRequest) (*emptypb.Empty, error) {
 
@@ -223,22 +282,35 @@ func (s *TcpRouteServer) ListNetworkservicesTcpRoute(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListTcpRoute(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = networkservices.TcpRouteMaxPage
+	}
+	resources, err := cl.ListTcpRouteWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*networkservicespb.NetworkservicesTcpRoute
-	for _, r := range resources.Items {
-		rp := TcpRouteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TcpRouteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &networkservicespb.ListNetworkservicesTcpRouteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTcpRoute(ctx context.Context, service_account_file string) (*networkservices.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return networkservices.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	networkservicespb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/networkservices/networkservices_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/networkservices"
@@ -191,7 +192,11 @@ func TlsRouteToProto(resource *networkservices.TlsRoute) *networkservicespb.Netw
 // applyTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Apply() method.
 func (s *TlsRouteServer) applyTlsRoute(ctx context.Context, c *networkservices.Client, request *networkservicespb.ApplyNetworkservicesTlsRouteRequest) (*networkservicespb.NetworkservicesTlsRoute, error) {
 	p := ProtoToTlsRoute(request.GetResource())
-	res, err := c.ApplyTlsRoute(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTlsRoute(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -208,6 +213,60 @@ func (s *TlsRouteServer) ApplyNetworkservicesTlsRoute(ctx context.Context, reque
 	return s.applyTlsRoute(ctx, cl, request)
 }
 
+// GetNetworkservicesTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Get() method.
+func (s *TlsRouteServer) GetNetworkservicesTlsRoute(ctx context.Context, request *networkservicespb.GetNetworkservicesTlsRouteRequest) (*networkservicespb.NetworkservicesTlsRoute, error) {
+	cl, err := createConfigTlsRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTlsRoute(ctx, ProtoToTlsRoute(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TlsRouteToProto(res), nil
+}
+
+// HasDiffNetworkservicesTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TlsRouteServer) HasDiffNetworkservicesTlsRoute(ctx context.Context, request *networkservicespb.HasDiffNetworkservicesTlsRouteRequest) (*networkservicespb.HasDiffNetworkservicesTlsRouteResponse, error) {
+	cl, err := createConfigTlsRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTlsRoute(ctx, ProtoToTlsRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &networkservicespb.HasDiffNetworkservicesTlsRouteResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanNetworkservicesTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TlsRouteServer) PlanNetworkservicesTlsRoute(ctx context.Context, request *networkservicespb.PlanNetworkservicesTlsRouteRequest) (*networkservicespb.PlanNetworkservicesTlsRouteResponse, error) {
+	cl, err := createConfigTlsRoute(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTlsRoute(ctx, ProtoToTlsRoute(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &networkservicespb.PlanNetworkservicesTlsRouteResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTlsRoute handles the gRPC request by passing it to the underlying TlsRoute Delete() method.
 func (s *TlsRouteServer) DeleteNetworkservicesTlsRoute(ctx context.Context, request *networkservicespb.DeleteNetworkservicesTlsRouteRequest) (*emptypb.Empty, error) {
 
@@ -226,22 +285,35 @@ func (s *TlsRouteServer) ListNetworkservicesTlsRoute(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListTlsRoute(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = networkservices.TlsRouteMaxPage
+	}
+	resources, err := cl.ListTlsRouteWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*networkservicespb.NetworkservicesTlsRoute
-	for _, r := range resources.Items {
-		rp := TlsRouteToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TlsRouteToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &networkservicespb.ListNetworkservicesTlsRouteResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTlsRoute(ctx context.Context, service_account_file string) (*networkservices.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return networkservices.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/orgpolicy/alpha/orgpolicy_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/orgpolicy/alpha"
@@ -172,7 +173,11 @@ func PolicyToProto(resource *alpha.Policy) *alphapb.OrgpolicyAlphaPolicy {
 // applyPolicy handles the gRPC request by passing it to the underlying Policy Apply() method.
 func (s *PolicyServer) applyPolicy(ctx context.Context, c *alpha.Client, request *alphapb.ApplyOrgpolicyAlphaPolicyRequest) (*alphapb.OrgpolicyAlphaPolicy, error) {
 	p := ProtoToPolicy(request.GetResource())
-	res, err := c.ApplyPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +194,60 @@ func (s *PolicyServer) ApplyOrgpolicyAlphaPolicy(ctx context.Context, request *a
 	return s.applyPolicy(ctx, cl, request)
 }
 
+// GetOrgpolicyAlphaPolicy handles the gRPC request by passing it to the underlying Policy Get() method.
+func (s *PolicyServer) GetOrgpolicyAlphaPolicy(ctx context.Context, request *alphapb.GetOrgpolicyAlphaPolicyRequest) (*alphapb.OrgpolicyAlphaPolicy, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetPolicy(ctx, ProtoToPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return PolicyToProto(res), nil
+}
+
+// HasDiffOrgpolicyAlphaPolicy handles the gRPC request by passing it to the underlying Policy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *PolicyServer) HasDiffOrgpolicyAlphaPolicy(ctx context.Context, request *alphapb.HasDiffOrgpolicyAlphaPolicyRequest) (*alphapb.HasDiffOrgpolicyAlphaPolicyResponse, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPolicy(ctx, ProtoToPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffOrgpolicyAlphaPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanOrgpolicyAlphaPolicy handles the gRPC request by passing it to the underlying Policy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *PolicyServer) PlanOrgpolicyAlphaPolicy(ctx context.Context, request *alphapb.PlanOrgpolicyAlphaPolicyRequest) (*alphapb.PlanOrgpolicyAlphaPolicyResponse, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPolicy(ctx, ProtoToPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanOrgpolicyAlphaPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeletePolicy handles the gRPC request by passing it to the underlying Policy Delete() method.
 func (s *PolicyServer) DeleteOrgpolicyAlphaPolicy(ctx context.Context, request *alphapb.DeleteOrgpolicyAlphaPolicyRequest) (*emptypb.Empty, error) {
 
@@ -207,22 +266,35 @@ func (s *PolicyServer) ListOrgpolicyAlphaPolicy(ctx context.Context, request *al
 		return nil, err
 	}
 
-	resources, err := cl.ListPolicy(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.PolicyMaxPage
+	}
+	resources, err := cl.ListPolicyWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.OrgpolicyAlphaPolicy
-	for _, r := range resources.Items {
-		rp := PolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, PolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListOrgpolicyAlphaPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigPolicy(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/orgpolicy/beta/orgpolicy_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/orgpolicy/beta"
@@ -172,7 +173,11 @@ func PolicyToProto(resource *beta.Policy) *betapb.OrgpolicyBetaPolicy {
 // applyPolicy handles the gRPC request by passing it to the underlying Policy Apply() method.
 func (s *PolicyServer) applyPolicy(ctx context.Context, c *beta.Client, request *betapb.ApplyOrgpolicyBetaPolicyRequest) (*betapb.OrgpolicyBetaPolicy, error) {
 	p := ProtoToPolicy(request.GetResource())
-	res, err := c.ApplyPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +194,60 @@ func (s *PolicyServer) ApplyOrgpolicyBetaPolicy(ctx context.Context, request *be
 	return s.applyPolicy(ctx, cl, request)
 }
 
+// GetOrgpolicyBetaPolicy handles the gRPC request by passing it to the underlying Policy Get() method.
+func (s *PolicyServer) GetOrgpolicyBetaPolicy(ctx context.Context, request *betapb.GetOrgpolicyBetaPolicyRequest) (*betapb.OrgpolicyBetaPolicy, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetPolicy(ctx, ProtoToPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return PolicyToProto(res), nil
+}
+
+// HasDiffOrgpolicyBetaPolicy handles the gRPC request by passing it to the underlying Policy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *PolicyServer) HasDiffOrgpolicyBetaPolicy(ctx context.Context, request *betapb.HasDiffOrgpolicyBetaPolicyRequest) (*betapb.HasDiffOrgpolicyBetaPolicyResponse, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPolicy(ctx, ProtoToPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffOrgpolicyBetaPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanOrgpolicyBetaPolicy handles the gRPC request by passing it to the underlying Policy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *PolicyServer) PlanOrgpolicyBetaPolicy(ctx context.Context, request *betapb.PlanOrgpolicyBetaPolicyRequest) (*betapb.PlanOrgpolicyBetaPolicyResponse, error) {
+	cl, err := createConfigPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPolicy(ctx, ProtoToPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanOrgpolicyBetaPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeletePolicy handles the gRPC request by passing it to the underlying Policy Delete() method.
 func (s *PolicyServer) DeleteOrgpolicyBetaPolicy(ctx context.Context, request *betapb.DeleteOrgpolicyBetaPolicyRequest) (*emptypb.Empty, error) {
 
@@ -207,22 +266,35 @@ func (s *PolicyServer) ListOrgpolicyBetaPolicy(ctx context.Context, request *bet
 		return nil, err
 	}
 
-	resources, err := cl.ListPolicy(ctx, request.GetParent())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.PolicyMaxPage
+	}
+	resources, err := cl.ListPolicyWithPageToken(ctx, request.GetParent(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.OrgpolicyBetaPolicy
-	for _, r := range resources.Items {
-		rp := PolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, PolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListOrgpolicyBetaPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigPolicy(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/osconfig/alpha/osconfig_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/osconfig/alpha"
@@ -1137,7 +1138,11 @@ func GuestPolicyToProto(resource *alpha.GuestPolicy) *alphapb.OsconfigAlphaGuest
 // applyGuestPolicy handles the gRPC request by passing it to the underlying GuestPolicy Apply() method.
 func (s *GuestPolicyServer) applyGuestPolicy(ctx context.Context, c *alpha.Client, request *alphapb.ApplyOsconfigAlphaGuestPolicyRequest) (*alphapb.OsconfigAlphaGuestPolicy, error) {
 	p := ProtoToGuestPolicy(request.GetResource())
-	res, err := c.ApplyGuestPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGuestPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1154,6 +1159,60 @@ func (s *GuestPolicyServer) ApplyOsconfigAlphaGuestPolicy(ctx context.Context, r
 	return s.applyGuestPolicy(ctx, cl, request)
 }
 
+// GetOsconfigAlphaGuestPolicy handles the gRPC request by passing it to the underlying GuestPolicy Get() method.
+func (s *GuestPolicyServer) GetOsconfigAlphaGuestPolicy(ctx context.Context, request *alphapb.GetOsconfigAlphaGuestPolicyRequest) (*alphapb.OsconfigAlphaGuestPolicy, error) {
+	cl, err := createConfigGuestPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGuestPolicy(ctx, ProtoToGuestPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GuestPolicyToProto(res), nil
+}
+
+// HasDiffOsconfigAlphaGuestPolicy handles the gRPC request by passing it to the underlying GuestPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GuestPolicyServer) HasDiffOsconfigAlphaGuestPolicy(ctx context.Context, request *alphapb.HasDiffOsconfigAlphaGuestPolicyRequest) (*alphapb.HasDiffOsconfigAlphaGuestPolicyResponse, error) {
+	cl, err := createConfigGuestPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGuestPolicy(ctx, ProtoToGuestPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffOsconfigAlphaGuestPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanOsconfigAlphaGuestPolicy handles the gRPC request by passing it to the underlying GuestPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GuestPolicyServer) PlanOsconfigAlphaGuestPolicy(ctx context.Context, request *alphapb.PlanOsconfigAlphaGuestPolicyRequest) (*alphapb.PlanOsconfigAlphaGuestPolicyResponse, error) {
+	cl, err := createConfigGuestPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGuestPolicy(ctx, ProtoToGuestPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanOsconfigAlphaGuestPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGuestPolicy handles the gRPC request by passing it to the underlying GuestPolicy Delete() method.
 func (s *GuestPolicyServer) DeleteOsconfigAlphaGuestPolicy(ctx context.Context, request *alphapb.DeleteOsconfigAlphaGuestPolicyRequest) (*emptypb.Empty, error) {
 
@@ -1172,22 +1231,35 @@ func (s *GuestPolicyServer) ListOsconfigAlphaGuestPolicy(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListGuestPolicy(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.GuestPolicyMaxPage
+	}
+	resources, err := cl.ListGuestPolicyWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.OsconfigAlphaGuestPolicy
-	for _, r := range resources.Items {
-		rp := GuestPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, GuestPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListOsconfigAlphaGuestPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigGuestPolicy(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
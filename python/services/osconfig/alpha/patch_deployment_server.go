@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/osconfig/alpha/osconfig_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/osconfig/alpha"
@@ -1142,7 +1143,11 @@ func PatchDeploymentToProto(resource *alpha.PatchDeployment) *alphapb.OsconfigAl
 // applyPatchDeployment handles the gRPC request by passing it to the underlying PatchDeployment Apply() method.
 func (s *PatchDeploymentServer) applyPatchDeployment(ctx context.Context, c *alpha.Client, request *alphapb.ApplyOsconfigAlphaPatchDeploymentRequest) (*alphapb.OsconfigAlphaPatchDeployment, error) {
 	p := ProtoToPatchDeployment(request.GetResource())
-	res, err := c.ApplyPatchDeployment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyPatchDeployment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1159,6 +1164,60 @@ func (s *PatchDeploymentServer) ApplyOsconfigAlphaPatchDeployment(ctx context.Co
 	return s.applyPatchDeployment(ctx, cl, request)
 }
 
+// GetOsconfigAlphaPatchDeployment handles the gRPC request by passing it to the underlying PatchDeployment Get() method.
+func (s *PatchDeploymentServer) GetOsconfigAlphaPatchDeployment(ctx context.Context, request *alphapb.GetOsconfigAlphaPatchDeploymentRequest) (*alphapb.OsconfigAlphaPatchDeployment, error) {
+	cl, err := createConfigPatchDeployment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetPatchDeployment(ctx, ProtoToPatchDeployment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return PatchDeploymentToProto(res), nil
+}
+
+// HasDiffOsconfigAlphaPatchDeployment handles the gRPC request by passing it to the underlying PatchDeployment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *PatchDeploymentServer) HasDiffOsconfigAlphaPatchDeployment(ctx context.Context, request *alphapb.HasDiffOsconfigAlphaPatchDeploymentRequest) (*alphapb.HasDiffOsconfigAlphaPatchDeploymentResponse, error) {
+	cl, err := createConfigPatchDeployment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPatchDeployment(ctx, ProtoToPatchDeployment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffOsconfigAlphaPatchDeploymentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanOsconfigAlphaPatchDeployment handles the gRPC request by passing it to the underlying PatchDeployment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *PatchDeploymentServer) PlanOsconfigAlphaPatchDeployment(ctx context.Context, request *alphapb.PlanOsconfigAlphaPatchDeploymentRequest) (*alphapb.PlanOsconfigAlphaPatchDeploymentResponse, error) {
+	cl, err := createConfigPatchDeployment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPatchDeployment(ctx, ProtoToPatchDeployment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanOsconfigAlphaPatchDeploymentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeletePatchDeployment handles the gRPC request by passing it to the underlying PatchDeployment Delete() method.
 func (s *PatchDeploymentServer) DeleteOsconfigAlphaPatchDeployment(ctx context.Context, request *alphapb.DeleteOsconfigAlphaPatchDeploymentRequest) (*emptypb.Empty, error) {
 
@@ -1177,22 +1236,35 @@ func (s *PatchDeploymentServer) ListOsconfigAlphaPatchDeployment(ctx context.Con
 		return nil, err
 	}
 
-	resources, err := cl.ListPatchDeployment(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.PatchDeploymentMaxPage
+	}
+	resources, err := cl.ListPatchDeploymentWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.OsconfigAlphaPatchDeployment
-	for _, r := range resources.Items {
-		rp := PatchDeploymentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, PatchDeploymentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListOsconfigAlphaPatchDeploymentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigPatchDeployment(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/osconfig/beta/osconfig_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/osconfig/beta"
@@ -1137,7 +1138,11 @@ func GuestPolicyToProto(resource *beta.GuestPolicy) *betapb.OsconfigBetaGuestPol
 // applyGuestPolicy handles the gRPC request by passing it to the underlying GuestPolicy Apply() method.
 func (s *GuestPolicyServer) applyGuestPolicy(ctx context.Context, c *beta.Client, request *betapb.ApplyOsconfigBetaGuestPolicyRequest) (*betapb.OsconfigBetaGuestPolicy, error) {
 	p := ProtoToGuestPolicy(request.GetResource())
-	res, err := c.ApplyGuestPolicy(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyGuestPolicy(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1154,6 +1159,60 @@ func (s *GuestPolicyServer) ApplyOsconfigBetaGuestPolicy(ctx context.Context, re
 	return s.applyGuestPolicy(ctx, cl, request)
 }
 
+// GetOsconfigBetaGuestPolicy handles the gRPC request by passing it to the underlying GuestPolicy Get() method.
+func (s *GuestPolicyServer) GetOsconfigBetaGuestPolicy(ctx context.Context, request *betapb.GetOsconfigBetaGuestPolicyRequest) (*betapb.OsconfigBetaGuestPolicy, error) {
+	cl, err := createConfigGuestPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetGuestPolicy(ctx, ProtoToGuestPolicy(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return GuestPolicyToProto(res), nil
+}
+
+// HasDiffOsconfigBetaGuestPolicy handles the gRPC request by passing it to the underlying GuestPolicy Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *GuestPolicyServer) HasDiffOsconfigBetaGuestPolicy(ctx context.Context, request *betapb.HasDiffOsconfigBetaGuestPolicyRequest) (*betapb.HasDiffOsconfigBetaGuestPolicyResponse, error) {
+	cl, err := createConfigGuestPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGuestPolicy(ctx, ProtoToGuestPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffOsconfigBetaGuestPolicyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanOsconfigBetaGuestPolicy handles the gRPC request by passing it to the underlying GuestPolicy Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *GuestPolicyServer) PlanOsconfigBetaGuestPolicy(ctx context.Context, request *betapb.PlanOsconfigBetaGuestPolicyRequest) (*betapb.PlanOsconfigBetaGuestPolicyResponse, error) {
+	cl, err := createConfigGuestPolicy(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffGuestPolicy(ctx, ProtoToGuestPolicy(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanOsconfigBetaGuestPolicyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteGuestPolicy handles the gRPC request by passing it to the underlying GuestPolicy Delete() method.
 func (s *GuestPolicyServer) DeleteOsconfigBetaGuestPolicy(ctx context.Context, request *betapb.DeleteOsconfigBetaGuestPolicyRequest) (*emptypb.Empty, error) {
 
@@ -1172,22 +1231,35 @@ func (s *GuestPolicyServer) ListOsconfigBetaGuestPolicy(ctx context.Context, req
 		return nil, err
 	}
 
-	resources, err := cl.ListGuestPolicy(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.GuestPolicyMaxPage
+	}
+	resources, err := cl.ListGuestPolicyWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.OsconfigBetaGuestPolicy
-	for _, r := range resources.Items {
-		rp := GuestPolicyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, GuestPolicyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListOsconfigBetaGuestPolicyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigGuestPolicy(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/osconfig/beta/osconfig_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/osconfig/beta"
@@ -1119,7 +1120,11 @@ func PatchDeploymentToProto(resource *beta.PatchDeployment) *betapb.OsconfigBeta
 // applyPatchDeployment handles the gRPC request by passing it to the underlying PatchDeployment Apply() method.
 func (s *PatchDeploymentServer) applyPatchDeployment(ctx context.Context, c *beta.Client, request *betapb.ApplyOsconfigBetaPatchDeploymentRequest) (*betapb.OsconfigBetaPatchDeployment, error) {
 	p := ProtoToPatchDeployment(request.GetResource())
-	res, err := c.ApplyPatchDeployment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyPatchDeployment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1136,6 +1141,60 @@ func (s *PatchDeploymentServer) ApplyOsconfigBetaPatchDeployment(ctx context.Con
 	return s.applyPatchDeployment(ctx, cl, request)
 }
 
+// GetOsconfigBetaPatchDeployment handles the gRPC request by passing it to the underlying PatchDeployment Get() method.
+func (s *PatchDeploymentServer) GetOsconfigBetaPatchDeployment(ctx context.Context, request *betapb.GetOsconfigBetaPatchDeploymentRequest) (*betapb.OsconfigBetaPatchDeployment, error) {
+	cl, err := createConfigPatchDeployment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetPatchDeployment(ctx, ProtoToPatchDeployment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return PatchDeploymentToProto(res), nil
+}
+
+// HasDiffOsconfigBetaPatchDeployment handles the gRPC request by passing it to the underlying PatchDeployment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *PatchDeploymentServer) HasDiffOsconfigBetaPatchDeployment(ctx context.Context, request *betapb.HasDiffOsconfigBetaPatchDeploymentRequest) (*betapb.HasDiffOsconfigBetaPatchDeploymentResponse, error) {
+	cl, err := createConfigPatchDeployment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPatchDeployment(ctx, ProtoToPatchDeployment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffOsconfigBetaPatchDeploymentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanOsconfigBetaPatchDeployment handles the gRPC request by passing it to the underlying PatchDeployment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *PatchDeploymentServer) PlanOsconfigBetaPatchDeployment(ctx context.Context, request *betapb.PlanOsconfigBetaPatchDeploymentRequest) (*betapb.PlanOsconfigBetaPatchDeploymentResponse, error) {
+	cl, err := createConfigPatchDeployment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPatchDeployment(ctx, ProtoToPatchDeployment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanOsconfigBetaPatchDeploymentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeletePatchDeployment handles the gRPC request by passing it to the underlying PatchDeployment Delete() method.
 func (s *PatchDeploymentServer) DeleteOsconfigBetaPatchDeployment(ctx context.Context, request *betapb.DeleteOsconfigBetaPatchDeploymentRequest) (*emptypb.Empty, error) {
 
@@ -1154,22 +1213,35 @@ func (s *PatchDeploymentServer) ListOsconfigBetaPatchDeployment(ctx context.Cont
 		return nil, err
 	}
 
-	resources, err := cl.ListPatchDeployment(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.PatchDeploymentMaxPage
+	}
+	resources, err := cl.ListPatchDeploymentWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.OsconfigBetaPatchDeployment
-	for _, r := range resources.Items {
-		rp := PatchDeploymentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, PatchDeploymentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListOsconfigBetaPatchDeploymentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigPatchDeployment(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	osconfigpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/osconfig/osconfig_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/osconfig"
@@ -1438,7 +1439,11 @@ func OSPolicyAssignmentToProto(resource *osconfig.OSPolicyAssignment) *osconfigp
 // applyOSPolicyAssignment handles the gRPC request by passing it to the underlying OSPolicyAssignment Apply() method.
 func (s *OSPolicyAssignmentServer) applyOSPolicyAssignment(ctx context.Context, c *osconfig.Client, request *osconfigpb.ApplyOsconfigOSPolicyAssignmentRequest) (*osconfigpb.OsconfigOSPolicyAssignment, error) {
 	p := ProtoToOSPolicyAssignment(request.GetResource())
-	res, err := c.ApplyOSPolicyAssignment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyOSPolicyAssignment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1455,6 +1460,60 @@ func (s *OSPolicyAssignmentServer) ApplyOsconfigOSPolicyAssignment(ctx context.C
 	return s.applyOSPolicyAssignment(ctx, cl, request)
 }
 
+// GetOsconfigOSPolicyAssignment handles the gRPC request by passing it to the underlying OSPolicyAssignment Get() method.
+func (s *OSPolicyAssignmentServer) GetOsconfigOSPolicyAssignment(ctx context.Context, request *osconfigpb.GetOsconfigOSPolicyAssignmentRequest) (*osconfigpb.OsconfigOSPolicyAssignment, error) {
+	cl, err := createConfigOSPolicyAssignment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetOSPolicyAssignment(ctx, ProtoToOSPolicyAssignment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return OSPolicyAssignmentToProto(res), nil
+}
+
+// HasDiffOsconfigOSPolicyAssignment handles the gRPC request by passing it to the underlying OSPolicyAssignment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *OSPolicyAssignmentServer) HasDiffOsconfigOSPolicyAssignment(ctx context.Context, request *osconfigpb.HasDiffOsconfigOSPolicyAssignmentRequest) (*osconfigpb.HasDiffOsconfigOSPolicyAssignmentResponse, error) {
+	cl, err := createConfigOSPolicyAssignment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffOSPolicyAssignment(ctx, ProtoToOSPolicyAssignment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &osconfigpb.HasDiffOsconfigOSPolicyAssignmentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanOsconfigOSPolicyAssignment handles the gRPC request by passing it to the underlying OSPolicyAssignment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *OSPolicyAssignmentServer) PlanOsconfigOSPolicyAssignment(ctx context.Context, request *osconfigpb.PlanOsconfigOSPolicyAssignmentRequest) (*osconfigpb.PlanOsconfigOSPolicyAssignmentResponse, error) {
+	cl, err := createConfigOSPolicyAssignment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffOSPolicyAssignment(ctx, ProtoToOSPolicyAssignment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &osconfigpb.PlanOsconfigOSPolicyAssignmentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteOSPolicyAssignment handles the gRPC request by passing it to the underlying OSPolicyAssignment Delete() method.
 func (s *OSPolicyAssignmentServer) DeleteOsconfigOSPolicyAssignment(ctx context.Context, request *osconfigpb.DeleteOsconfigOSPolicyAssignmentRequest) (*emptypb.Empty, error) {
 
@@ -1473,22 +1532,35 @@ func (s *OSPolicyAssignmentServer) ListOsconfigOSPolicyAssignment(ctx context.Co
 		return nil, err
 	}
 
-	resources, err := cl.ListOSPolicyAssignment(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = osconfig.OSPolicyAssignmentMaxPage
+	}
+	resources, err := cl.ListOSPolicyAssignmentWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*osconfigpb.OsconfigOSPolicyAssignment
-	for _, r := range resources.Items {
-		rp := OSPolicyAssignmentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, OSPolicyAssignmentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &osconfigpb.ListOsconfigOSPolicyAssignmentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigOSPolicyAssignment(ctx context.Context, service_account_file string) (*osconfig.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return osconfig.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/privateca/alpha/privateca_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/privateca/alpha"
@@ -661,7 +662,11 @@ func CaPoolToProto(resource *alpha.CaPool) *alphapb.PrivatecaAlphaCaPool {
 // applyCaPool handles the gRPC request by passing it to the underlying CaPool Apply() method.
 func (s *CaPoolServer) applyCaPool(ctx context.Context, c *alpha.Client, request *alphapb.ApplyPrivatecaAlphaCaPoolRequest) (*alphapb.PrivatecaAlphaCaPool, error) {
 	p := ProtoToCaPool(request.GetResource())
-	res, err := c.ApplyCaPool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCaPool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -678,6 +683,60 @@ func (s *CaPoolServer) ApplyPrivatecaAlphaCaPool(ctx context.Context, request *a
 	return s.applyCaPool(ctx, cl, request)
 }
 
+// GetPrivatecaAlphaCaPool handles the gRPC request by passing it to the underlying CaPool Get() method.
+func (s *CaPoolServer) GetPrivatecaAlphaCaPool(ctx context.Context, request *alphapb.GetPrivatecaAlphaCaPoolRequest) (*alphapb.PrivatecaAlphaCaPool, error) {
+	cl, err := createConfigCaPool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCaPool(ctx, ProtoToCaPool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CaPoolToProto(res), nil
+}
+
+// HasDiffPrivatecaAlphaCaPool handles the gRPC request by passing it to the underlying CaPool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CaPoolServer) HasDiffPrivatecaAlphaCaPool(ctx context.Context, request *alphapb.HasDiffPrivatecaAlphaCaPoolRequest) (*alphapb.HasDiffPrivatecaAlphaCaPoolResponse, error) {
+	cl, err := createConfigCaPool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCaPool(ctx, ProtoToCaPool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffPrivatecaAlphaCaPoolResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanPrivatecaAlphaCaPool handles the gRPC request by passing it to the underlying CaPool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CaPoolServer) PlanPrivatecaAlphaCaPool(ctx context.Context, request *alphapb.PlanPrivatecaAlphaCaPoolRequest) (*alphapb.PlanPrivatecaAlphaCaPoolResponse, error) {
+	cl, err := createConfigCaPool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCaPool(ctx, ProtoToCaPool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanPrivatecaAlphaCaPoolResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCaPool handles the gRPC request by passing it to the underlying CaPool Delete() method.
 func (s *CaPoolServer) DeletePrivatecaAlphaCaPool(ctx context.Context, request *alphapb.DeletePrivatecaAlphaCaPoolRequest) (*emptypb.Empty, error) {
 
@@ -696,22 +755,35 @@ func (s *CaPoolServer) ListPrivatecaAlphaCaPool(ctx context.Context, request *al
 		return nil, err
 	}
 
-	resources, err := cl.ListCaPool(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.CaPoolMaxPage
+	}
+	resources, err := cl.ListCaPoolWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.PrivatecaAlphaCaPool
-	for _, r := range resources.Items {
-		rp := CaPoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CaPoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListPrivatecaAlphaCaPoolResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCaPool(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/privateca/alpha/privateca_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/privateca/alpha"
@@ -471,7 +472,11 @@ func CertificateTemplateToProto(resource *alpha.CertificateTemplate) *alphapb.Pr
 // applyCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Apply() method.
 func (s *CertificateTemplateServer) applyCertificateTemplate(ctx context.Context, c *alpha.Client, request *alphapb.ApplyPrivatecaAlphaCertificateTemplateRequest) (*alphapb.PrivatecaAlphaCertificateTemplate, error) {
 	p := ProtoToCertificateTemplate(request.GetResource())
-	res, err := c.ApplyCertificateTemplate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCertificateTemplate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -488,6 +493,60 @@ func (s *CertificateTemplateServer) ApplyPrivatecaAlphaCertificateTemplate(ctx c
 	return s.applyCertificateTemplate(ctx, cl, request)
 }
 
+// GetPrivatecaAlphaCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Get() method.
+func (s *CertificateTemplateServer) GetPrivatecaAlphaCertificateTemplate(ctx context.Context, request *alphapb.GetPrivatecaAlphaCertificateTemplateRequest) (*alphapb.PrivatecaAlphaCertificateTemplate, error) {
+	cl, err := createConfigCertificateTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCertificateTemplate(ctx, ProtoToCertificateTemplate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CertificateTemplateToProto(res), nil
+}
+
+// HasDiffPrivatecaAlphaCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CertificateTemplateServer) HasDiffPrivatecaAlphaCertificateTemplate(ctx context.Context, request *alphapb.HasDiffPrivatecaAlphaCertificateTemplateRequest) (*alphapb.HasDiffPrivatecaAlphaCertificateTemplateResponse, error) {
+	cl, err := createConfigCertificateTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCertificateTemplate(ctx, ProtoToCertificateTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffPrivatecaAlphaCertificateTemplateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanPrivatecaAlphaCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CertificateTemplateServer) PlanPrivatecaAlphaCertificateTemplate(ctx context.Context, request *alphapb.PlanPrivatecaAlphaCertificateTemplateRequest) (*alphapb.PlanPrivatecaAlphaCertificateTemplateResponse, error) {
+	cl, err := createConfigCertificateTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCertificateTemplate(ctx, ProtoToCertificateTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanPrivatecaAlphaCertificateTemplateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Delete() method.
 func (s *CertificateTemplateServer) DeletePrivatecaAlphaCertificateTemplate(ctx context.Context, request *alphapb.DeletePrivatecaAlphaCertificateTemplateRequest) (*emptypb.Empty, error) {
 
@@ -506,22 +565,35 @@ func (s *CertificateTemplateServer) ListPrivatecaAlphaCertificateTemplate(ctx co
 		return nil, err
 	}
 
-	resources, err := cl.ListCertificateTemplate(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.CertificateTemplateMaxPage
+	}
+	resources, err := cl.ListCertificateTemplateWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.PrivatecaAlphaCertificateTemplate
-	for _, r := range resources.Items {
-		rp := CertificateTemplateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CertificateTemplateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListPrivatecaAlphaCertificateTemplateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCertificateTemplate(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/privateca/beta/privateca_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/privateca/beta"
@@ -1402,7 +1403,11 @@ func CertificateAuthorityToProto(resource *beta.CertificateAuthority) *betapb.Pr
 // applyCertificateAuthority handles the gRPC request by passing it to the underlying CertificateAuthority Apply() method.
 func (s *CertificateAuthorityServer) applyCertificateAuthority(ctx context.Context, c *beta.Client, request *betapb.ApplyPrivatecaBetaCertificateAuthorityRequest) (*betapb.PrivatecaBetaCertificateAuthority, error) {
 	p := ProtoToCertificateAuthority(request.GetResource())
-	res, err := c.ApplyCertificateAuthority(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCertificateAuthority(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1419,6 +1424,60 @@ func (s *CertificateAuthorityServer) ApplyPrivatecaBetaCertificateAuthority(ctx
 	return s.applyCertificateAuthority(ctx, cl, request)
 }
 
+// GetPrivatecaBetaCertificateAuthority handles the gRPC request by passing it to the underlying CertificateAuthority Get() method.
+func (s *CertificateAuthorityServer) GetPrivatecaBetaCertificateAuthority(ctx context.Context, request *betapb.GetPrivatecaBetaCertificateAuthorityRequest) (*betapb.PrivatecaBetaCertificateAuthority, error) {
+	cl, err := createConfigCertificateAuthority(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCertificateAuthority(ctx, ProtoToCertificateAuthority(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CertificateAuthorityToProto(res), nil
+}
+
+// HasDiffPrivatecaBetaCertificateAuthority handles the gRPC request by passing it to the underlying CertificateAuthority Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CertificateAuthorityServer) HasDiffPrivatecaBetaCertificateAuthority(ctx context.Context, request *betapb.HasDiffPrivatecaBetaCertificateAuthorityRequest) (*betapb.HasDiffPrivatecaBetaCertificateAuthorityResponse, error) {
+	cl, err := createConfigCertificateAuthority(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCertificateAuthority(ctx, ProtoToCertificateAuthority(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffPrivatecaBetaCertificateAuthorityResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanPrivatecaBetaCertificateAuthority handles the gRPC request by passing it to the underlying CertificateAuthority Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CertificateAuthorityServer) PlanPrivatecaBetaCertificateAuthority(ctx context.Context, request *betapb.PlanPrivatecaBetaCertificateAuthorityRequest) (*betapb.PlanPrivatecaBetaCertificateAuthorityResponse, error) {
+	cl, err := createConfigCertificateAuthority(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCertificateAuthority(ctx, ProtoToCertificateAuthority(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanPrivatecaBetaCertificateAuthorityResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCertificateAuthority handles the gRPC request by passing it to the underlying CertificateAuthority Delete() method.
 func (s *CertificateAuthorityServer) DeletePrivatecaBetaCertificateAuthority(ctx context.Context, request *betapb.DeletePrivatecaBetaCertificateAuthorityRequest) (*emptypb.Empty, error) {
 
@@ -1437,22 +1496,35 @@ func (s *CertificateAuthorityServer) ListPrivatecaBetaCertificateAuthority(ctx c
 		return nil, err
 	}
 
-	resources, err := cl.ListCertificateAuthority(ctx, request.GetProject(), request.GetLocation(), request.GetCaPool())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.CertificateAuthorityMaxPage
+	}
+	resources, err := cl.ListCertificateAuthorityWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetCaPool(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.PrivatecaBetaCertificateAuthority
-	for _, r := range resources.Items {
-		rp := CertificateAuthorityToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CertificateAuthorityToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListPrivatecaBetaCertificateAuthorityResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCertificateAuthority(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/privateca/beta/privateca_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/privateca/beta"
@@ -1213,7 +1214,11 @@ func CertificateToProto(resource *beta.Certificate) *betapb.PrivatecaBetaCertifi
 // applyCertificate handles the gRPC request by passing it to the underlying Certificate Apply() method.
 func (s *CertificateServer) applyCertificate(ctx context.Context, c *beta.Client, request *betapb.ApplyPrivatecaBetaCertificateRequest) (*betapb.PrivatecaBetaCertificate, error) {
 	p := ProtoToCertificate(request.GetResource())
-	res, err := c.ApplyCertificate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCertificate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1230,6 +1235,60 @@ func (s *CertificateServer) ApplyPrivatecaBetaCertificate(ctx context.Context, r
 	return s.applyCertificate(ctx, cl, request)
 }
 
+// GetPrivatecaBetaCertificate handles the gRPC request by passing it to the underlying Certificate Get() method.
+func (s *CertificateServer) GetPrivatecaBetaCertificate(ctx context.Context, request *betapb.GetPrivatecaBetaCertificateRequest) (*betapb.PrivatecaBetaCertificate, error) {
+	cl, err := createConfigCertificate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCertificate(ctx, ProtoToCertificate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CertificateToProto(res), nil
+}
+
+// HasDiffPrivatecaBetaCertificate handles the gRPC request by passing it to the underlying Certificate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CertificateServer) HasDiffPrivatecaBetaCertificate(ctx context.Context, request *betapb.HasDiffPrivatecaBetaCertificateRequest) (*betapb.HasDiffPrivatecaBetaCertificateResponse, error) {
+	cl, err := createConfigCertificate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCertificate(ctx, ProtoToCertificate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffPrivatecaBetaCertificateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanPrivatecaBetaCertificate handles the gRPC request by passing it to the underlying Certificate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CertificateServer) PlanPrivatecaBetaCertificate(ctx context.Context, request *betapb.PlanPrivatecaBetaCertificateRequest) (*betapb.PlanPrivatecaBetaCertificateResponse, error) {
+	cl, err := createConfigCertificate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCertificate(ctx, ProtoToCertificate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanPrivatecaBetaCertificateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCertificate handles the gRPC request by passing it to the underlying Certificate Delete() method.
 func (s *CertificateServer) DeletePrivatecaBetaCertificate(ctx context.Context, request *betapb.DeletePrivatecaBetaCertificateRequest) (*emptypb.Empty, error) {
 
@@ -1248,22 +1307,35 @@ func (s *CertificateServer) ListPrivatecaBetaCertificate(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListCertificate(ctx, request.GetProject(), request.GetLocation(), request.GetCaPool())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.CertificateMaxPage
+	}
+	resources, err := cl.ListCertificateWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetCaPool(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.PrivatecaBetaCertificate
-	for _, r := range resources.Items {
-		rp := CertificateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CertificateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListPrivatecaBetaCertificateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCertificate(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/privateca/beta/privateca_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/privateca/beta"
@@ -471,7 +472,11 @@ func CertificateTemplateToProto(resource *beta.CertificateTemplate) *betapb.Priv
 // applyCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Apply() method.
 func (s *CertificateTemplateServer) applyCertificateTemplate(ctx context.Context, c *beta.Client, request *betapb.ApplyPrivatecaBetaCertificateTemplateRequest) (*betapb.PrivatecaBetaCertificateTemplate, error) {
 	p := ProtoToCertificateTemplate(request.GetResource())
-	res, err := c.ApplyCertificateTemplate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCertificateTemplate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -488,6 +493,60 @@ func (s *CertificateTemplateServer) ApplyPrivatecaBetaCertificateTemplate(ctx co
 	return s.applyCertificateTemplate(ctx, cl, request)
 }
 
+// GetPrivatecaBetaCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Get() method.
+func (s *CertificateTemplateServer) GetPrivatecaBetaCertificateTemplate(ctx context.Context, request *betapb.GetPrivatecaBetaCertificateTemplateRequest) (*betapb.PrivatecaBetaCertificateTemplate, error) {
+	cl, err := createConfigCertificateTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCertificateTemplate(ctx, ProtoToCertificateTemplate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CertificateTemplateToProto(res), nil
+}
+
+// HasDiffPrivatecaBetaCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CertificateTemplateServer) HasDiffPrivatecaBetaCertificateTemplate(ctx context.Context, request *betapb.HasDiffPrivatecaBetaCertificateTemplateRequest) (*betapb.HasDiffPrivatecaBetaCertificateTemplateResponse, error) {
+	cl, err := createConfigCertificateTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCertificateTemplate(ctx, ProtoToCertificateTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffPrivatecaBetaCertificateTemplateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanPrivatecaBetaCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CertificateTemplateServer) PlanPrivatecaBetaCertificateTemplate(ctx context.Context, request *betapb.PlanPrivatecaBetaCertificateTemplateRequest) (*betapb.PlanPrivatecaBetaCertificateTemplateResponse, error) {
+	cl, err := createConfigCertificateTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCertificateTemplate(ctx, ProtoToCertificateTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanPrivatecaBetaCertificateTemplateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Delete() method.
 func (s *CertificateTemplateServer) DeletePrivatecaBetaCertificateTemplate(ctx context.Context, request *betapb.DeletePrivatecaBetaCertificateTemplateRequest) (*emptypb.Empty, error) {
 
@@ -506,22 +565,35 @@ func (s *CertificateTemplateServer) ListPrivatecaBetaCertificateTemplate(ctx con
 		return nil, err
 	}
 
-	resources, err := cl.ListCertificateTemplate(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.CertificateTemplateMaxPage
+	}
+	resources, err := cl.ListCertificateTemplateWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.PrivatecaBetaCertificateTemplate
-	for _, r := range resources.Items {
-		rp := CertificateTemplateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CertificateTemplateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListPrivatecaBetaCertificateTemplateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCertificateTemplate(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
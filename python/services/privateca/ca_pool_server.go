@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	privatecapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/privateca/privateca_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/privateca"
@@ -661,7 +662,11 @@ func CaPoolToProto(resource *privateca.CaPool) *privatecapb.PrivatecaCaPool {
 // applyCaPool handles the gRPC request by passing it to the underlying CaPool Apply() method.
 func (s *CaPoolServer) applyCaPool(ctx context.Context, c *privateca.Client, request *privatecapb.ApplyPrivatecaCaPoolRequest) (*privatecapb.PrivatecaCaPool, error) {
 	p := ProtoToCaPool(request.GetResource())
-	res, err := c.ApplyCaPool(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCaPool(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -678,6 +683,60 @@ func (s *CaPoolServer) ApplyPrivatecaCaPool(ctx context.Context, request *privat
 	return s.applyCaPool(ctx, cl, request)
 }
 
+// GetPrivatecaCaPool handles the gRPC request by passing it to the underlying CaPool Get() method.
+func (s *CaPoolServer) GetPrivatecaCaPool(ctx context.Context, request *privatecapb.GetPrivatecaCaPoolRequest) (*privatecapb.PrivatecaCaPool, error) {
+	cl, err := createConfigCaPool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCaPool(ctx, ProtoToCaPool(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CaPoolToProto(res), nil
+}
+
+// HasDiffPrivatecaCaPool handles the gRPC request by passing it to the underlying CaPool Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CaPoolServer) HasDiffPrivatecaCaPool(ctx context.Context, request *privatecapb.HasDiffPrivatecaCaPoolRequest) (*privatecapb.HasDiffPrivatecaCaPoolResponse, error) {
+	cl, err := createConfigCaPool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCaPool(ctx, ProtoToCaPool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &privatecapb.HasDiffPrivatecaCaPoolResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanPrivatecaCaPool handles the gRPC request by passing it to the underlying CaPool Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CaPoolServer) PlanPrivatecaCaPool(ctx context.Context, request *privatecapb.PlanPrivatecaCaPoolRequest) (*privatecapb.PlanPrivatecaCaPoolResponse, error) {
+	cl, err := createConfigCaPool(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCaPool(ctx, ProtoToCaPool(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &privatecapb.PlanPrivatecaCaPoolResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCaPool handles the gRPC request by passing it to the underlying CaPool Delete() method.
 func (s *CaPoolServer) DeletePrivatecaCaPool(ctx context.Context, request *privatecapb.DeletePrivatecaCaPoolRequest) (*emptypb.Empty, error) {
 
@@ -696,22 +755,35 @@ func (s *CaPoolServer) ListPrivatecaCaPool(ctx context.Context, request *private
 		return nil, err
 	}
 
-	resources, err := cl.ListCaPool(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = privateca.CaPoolMaxPage
+	}
+	resources, err := cl.ListCaPoolWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*privatecapb.PrivatecaCaPool
-	for _, r := range resources.Items {
-		rp := CaPoolToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CaPoolToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &privatecapb.ListPrivatecaCaPoolResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCaPool(ctx context.Context, service_account_file string) (*privateca.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return privateca.NewClient(conf), nil
 }
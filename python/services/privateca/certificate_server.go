@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	privatecapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/privateca/privateca_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/privateca"
@@ -1213,7 +1214,11 @@ func CertificateToProto(resource *privateca.Certificate) *privatecapb.PrivatecaC
 // applyCertificate handles the gRPC request by passing it to the underlying Certificate Apply() method.
 func (s *CertificateServer) applyCertificate(ctx context.Context, c *privateca.Client, request *privatecapb.ApplyPrivatecaCertificateRequest) (*privatecapb.PrivatecaCertificate, error) {
 	p := ProtoToCertificate(request.GetResource())
-	res, err := c.ApplyCertificate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCertificate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1230,6 +1235,60 @@ func (s *CertificateServer) ApplyPrivatecaCertificate(ctx context.Context, reque
 	return s.applyCertificate(ctx, cl, request)
 }
 
+// GetPrivatecaCertificate handles the gRPC request by passing it to the underlying Certificate Get() method.
+func (s *CertificateServer) GetPrivatecaCertificate(ctx context.Context, request *privatecapb.GetPrivatecaCertificateRequest) (*privatecapb.PrivatecaCertificate, error) {
+	cl, err := createConfigCertificate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCertificate(ctx, ProtoToCertificate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CertificateToProto(res), nil
+}
+
+// HasDiffPrivatecaCertificate handles the gRPC request by passing it to the underlying Certificate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CertificateServer) HasDiffPrivatecaCertificate(ctx context.Context, request *privatecapb.HasDiffPrivatecaCertificateRequest) (*privatecapb.HasDiffPrivatecaCertificateResponse, error) {
+	cl, err := createConfigCertificate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCertificate(ctx, ProtoToCertificate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &privatecapb.HasDiffPrivatecaCertificateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanPrivatecaCertificate handles the gRPC request by passing it to the underlying Certificate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CertificateServer) PlanPrivatecaCertificate(ctx context.Context, request *privatecapb.PlanPrivatecaCertificateRequest) (*privatecapb.PlanPrivatecaCertificateResponse, error) {
+	cl, err := createConfigCertificate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCertificate(ctx, ProtoToCertificate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &privatecapb.PlanPrivatecaCertificateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCertificate handles the gRPC request by passing it to the underlying Certificate Delete() method.
 func (s *CertificateServer) DeletePrivatecaCertificate(ctx context.Context, request *privatecapb.DeletePrivatecaCertificateRequest) (*emptypb.Empty, error) {
 
@@ -1248,22 +1307,35 @@ func (s *CertificateServer) ListPrivatecaCertificate(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListCertificate(ctx, request.GetProject(), request.GetLocation(), request.GetCaPool())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = privateca.CertificateMaxPage
+	}
+	resources, err := cl.ListCertificateWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetCaPool(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*privatecapb.PrivatecaCertificate
-	for _, r := range resources.Items {
-		rp := CertificateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CertificateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &privatecapb.ListPrivatecaCertificateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCertificate(ctx context.Context, service_account_file string) (*privateca.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return privateca.NewClient(conf), nil
 }
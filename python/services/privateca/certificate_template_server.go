@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	privatecapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/privateca/privateca_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/privateca"
@@ -471,7 +472,11 @@ func CertificateTemplateToProto(resource *privateca.CertificateTemplate) *privat
 // applyCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Apply() method.
 func (s *CertificateTemplateServer) applyCertificateTemplate(ctx context.Context, c *privateca.Client, request *privatecapb.ApplyPrivatecaCertificateTemplateRequest) (*privatecapb.PrivatecaCertificateTemplate, error) {
 	p := ProtoToCertificateTemplate(request.GetResource())
-	res, err := c.ApplyCertificateTemplate(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyCertificateTemplate(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -488,6 +493,60 @@ func (s *CertificateTemplateServer) ApplyPrivatecaCertificateTemplate(ctx contex
 	return s.applyCertificateTemplate(ctx, cl, request)
 }
 
+// GetPrivatecaCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Get() method.
+func (s *CertificateTemplateServer) GetPrivatecaCertificateTemplate(ctx context.Context, request *privatecapb.GetPrivatecaCertificateTemplateRequest) (*privatecapb.PrivatecaCertificateTemplate, error) {
+	cl, err := createConfigCertificateTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetCertificateTemplate(ctx, ProtoToCertificateTemplate(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return CertificateTemplateToProto(res), nil
+}
+
+// HasDiffPrivatecaCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *CertificateTemplateServer) HasDiffPrivatecaCertificateTemplate(ctx context.Context, request *privatecapb.HasDiffPrivatecaCertificateTemplateRequest) (*privatecapb.HasDiffPrivatecaCertificateTemplateResponse, error) {
+	cl, err := createConfigCertificateTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCertificateTemplate(ctx, ProtoToCertificateTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &privatecapb.HasDiffPrivatecaCertificateTemplateResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanPrivatecaCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *CertificateTemplateServer) PlanPrivatecaCertificateTemplate(ctx context.Context, request *privatecapb.PlanPrivatecaCertificateTemplateRequest) (*privatecapb.PlanPrivatecaCertificateTemplateResponse, error) {
+	cl, err := createConfigCertificateTemplate(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffCertificateTemplate(ctx, ProtoToCertificateTemplate(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &privatecapb.PlanPrivatecaCertificateTemplateResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteCertificateTemplate handles the gRPC request by passing it to the underlying CertificateTemplate Delete() method.
 func (s *CertificateTemplateServer) DeletePrivatecaCertificateTemplate(ctx context.Context, request *privatecapb.DeletePrivatecaCertificateTemplateRequest) (*emptypb.Empty, error) {
 
@@ -506,22 +565,35 @@ func (s *CertificateTemplateServer) ListPrivatecaCertificateTemplate(ctx context
 		return nil, err
 	}
 
-	resources, err := cl.ListCertificateTemplate(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = privateca.CertificateTemplateMaxPage
+	}
+	resources, err := cl.ListCertificateTemplateWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*privatecapb.PrivatecaCertificateTemplate
-	for _, r := range resources.Items {
-		rp := CertificateTemplateToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, CertificateTemplateToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &privatecapb.ListPrivatecaCertificateTemplateResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigCertificateTemplate(ctx context.Context, service_account_file string) (*privateca.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return privateca.NewClient(conf), nil
 }
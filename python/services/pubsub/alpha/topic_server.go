@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/pubsub/alpha/pubsub_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/pubsub/alpha"
@@ -80,7 +81,11 @@ func TopicToProto(resource *alpha.Topic) *alphapb.PubsubAlphaTopic {
 // applyTopic handles the gRPC request by passing it to the underlying Topic Apply() method.
 func (s *TopicServer) applyTopic(ctx context.Context, c *alpha.Client, request *alphapb.ApplyPubsubAlphaTopicRequest) (*alphapb.PubsubAlphaTopic, error) {
 	p := ProtoToTopic(request.GetResource())
-	res, err := c.ApplyTopic(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTopic(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +102,60 @@ func (s *TopicServer) ApplyPubsubAlphaTopic(ctx context.Context, request *alphap
 	return s.applyTopic(ctx, cl, request)
 }
 
+// GetPubsubAlphaTopic handles the gRPC request by passing it to the underlying Topic Get() method.
+func (s *TopicServer) GetPubsubAlphaTopic(ctx context.Context, request *alphapb.GetPubsubAlphaTopicRequest) (*alphapb.PubsubAlphaTopic, error) {
+	cl, err := createConfigTopic(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTopic(ctx, ProtoToTopic(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TopicToProto(res), nil
+}
+
+// HasDiffPubsubAlphaTopic handles the gRPC request by passing it to the underlying Topic Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TopicServer) HasDiffPubsubAlphaTopic(ctx context.Context, request *alphapb.HasDiffPubsubAlphaTopicRequest) (*alphapb.HasDiffPubsubAlphaTopicResponse, error) {
+	cl, err := createConfigTopic(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTopic(ctx, ProtoToTopic(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffPubsubAlphaTopicResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanPubsubAlphaTopic handles the gRPC request by passing it to the underlying Topic Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TopicServer) PlanPubsubAlphaTopic(ctx context.Context, request *alphapb.PlanPubsubAlphaTopicRequest) (*alphapb.PlanPubsubAlphaTopicResponse, error) {
+	cl, err := createConfigTopic(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTopic(ctx, ProtoToTopic(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanPubsubAlphaTopicResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTopic handles the gRPC request by passing it to the underlying Topic Delete() method.
 func (s *TopicServer) DeletePubsubAlphaTopic(ctx context.Context, request *alphapb.DeletePubsubAlphaTopicRequest) (*emptypb.Empty, error) {
 
@@ -115,22 +174,35 @@ func (s *TopicServer) ListPubsubAlphaTopic(ctx context.Context, request *alphapb
 		return nil, err
 	}
 
-	resources, err := cl.ListTopic(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.TopicMaxPage
+	}
+	resources, err := cl.ListTopicWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.PubsubAlphaTopic
-	for _, r := range resources.Items {
-		rp := TopicToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TopicToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListPubsubAlphaTopicResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTopic(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/pubsub/beta/pubsub_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/pubsub/beta"
@@ -80,7 +81,11 @@ func TopicToProto(resource *beta.Topic) *betapb.PubsubBetaTopic {
 // applyTopic handles the gRPC request by passing it to the underlying Topic Apply() method.
 func (s *TopicServer) applyTopic(ctx context.Context, c *beta.Client, request *betapb.ApplyPubsubBetaTopicRequest) (*betapb.PubsubBetaTopic, error) {
 	p := ProtoToTopic(request.GetResource())
-	res, err := c.ApplyTopic(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTopic(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +102,60 @@ func (s *TopicServer) ApplyPubsubBetaTopic(ctx context.Context, request *betapb.
 	return s.applyTopic(ctx, cl, request)
 }
 
+// GetPubsubBetaTopic handles the gRPC request by passing it to the underlying Topic Get() method.
+func (s *TopicServer) GetPubsubBetaTopic(ctx context.Context, request *betapb.GetPubsubBetaTopicRequest) (*betapb.PubsubBetaTopic, error) {
+	cl, err := createConfigTopic(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTopic(ctx, ProtoToTopic(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TopicToProto(res), nil
+}
+
+// HasDiffPubsubBetaTopic handles the gRPC request by passing it to the underlying Topic Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TopicServer) HasDiffPubsubBetaTopic(ctx context.Context, request *betapb.HasDiffPubsubBetaTopicRequest) (*betapb.HasDiffPubsubBetaTopicResponse, error) {
+	cl, err := createConfigTopic(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTopic(ctx, ProtoToTopic(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffPubsubBetaTopicResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanPubsubBetaTopic handles the gRPC request by passing it to the underlying Topic Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TopicServer) PlanPubsubBetaTopic(ctx context.Context, request *betapb.PlanPubsubBetaTopicRequest) (*betapb.PlanPubsubBetaTopicResponse, error) {
+	cl, err := createConfigTopic(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTopic(ctx, ProtoToTopic(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanPubsubBetaTopicResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTopic handles the gRPC request by passing it to the underlying Topic Delete() method.
 func (s *TopicServer) DeletePubsubBetaTopic(ctx context.Context, request *betapb.DeletePubsubBetaTopicRequest) (*emptypb.Empty, error) {
 
@@ -115,22 +174,35 @@ func (s *TopicServer) ListPubsubBetaTopic(ctx context.Context, request *betapb.L
 		return nil, err
 	}
 
-	resources, err := cl.ListTopic(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.TopicMaxPage
+	}
+	resources, err := cl.ListTopicWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.PubsubBetaTopic
-	for _, r := range resources.Items {
-		rp := TopicToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TopicToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListPubsubBetaTopicResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTopic(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
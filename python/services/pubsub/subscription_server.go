@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	pubsubpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/pubsub/pubsub_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/pubsub"
@@ -159,7 +160,11 @@ func SubscriptionToProto(resource *pubsub.Subscription) *pubsubpb.PubsubSubscrip
 // ApplySubscription handles the gRPC request by passing it to the underlying Subscription Apply() method.
 func (s *SubscriptionServer) applySubscription(ctx context.Context, c *pubsub.Client, request *pubsubpb.ApplyPubsubSubscriptionRequest) (*pubsubpb.PubsubSubscription, error) {
 	p := ProtoToSubscription(request.GetResource())
-	res, err := c.ApplySubscription(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySubscription(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -176,6 +181,55 @@ func (s *SubscriptionServer) ApplyPubsubSubscription(ctx context.Context, reques
 	return s.applySubscription(ctx, cl, request)
 }
 
+// GetPubsubSubscription handles the gRPC request by passing it to the underlying Subscription Get() method.
+func (s *SubscriptionServer) GetPubsubSubscription(ctx context.Context, request *pubsubpb.GetPubsubSubscriptionRequest) (*pubsubpb.PubsubSubscription, error) {
+	cl, err := createConfigSubscription(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSubscription(ctx, ProtoToSubscription(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SubscriptionToProto(res), nil
+}
+
+// HasDiffPubsubSubscription handles the gRPC request by passing it to the underlying Subscription Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SubscriptionServer) HasDiffPubsubSubscription(ctx context.Context, request *pubsubpb.HasDiffPubsubSubscriptionRequest) (*pubsubpb.HasDiffPubsubSubscriptionResponse, error) {
+	cl, err := createConfigSubscription(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSubscription(ctx, ProtoToSubscription(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &pubsubpb.HasDiffPubsubSubscriptionResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanPubsubSubscription handles the gRPC request by passing it to the underlying Subscription Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SubscriptionServer) PlanPubsubSubscription(ctx context.Context, request *pubsubpb.PlanPubsubSubscriptionRequest) (*pubsubpb.PlanPubsubSubscriptionResponse, error) {
+	cl, err := createConfigSubscription(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSubscription(ctx, ProtoToSubscription(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &pubsubpb.PlanPubsubSubscriptionResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteSubscription handles the gRPC request by passing it to the underlying Subscription Delete() method.
 func (s *SubscriptionServer) DeletePubsubSubscription(ctx context.Context, request *pubsubpb.DeletePubsubSubscriptionRequest) (*emptypb.Empty, error) {
 
@@ -194,20 +248,32 @@ func (s *SubscriptionServer) ListPubsubSubscription(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListSubscription(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = pubsub.SubscriptionMaxPage
+	}
+	resources, err := cl.ListSubscriptionWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*pubsubpb.PubsubSubscription
-	for _, r := range resources.Items {
-		rp := SubscriptionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SubscriptionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &pubsubpb.ListPubsubSubscriptionResponse{Items: protos}, nil
+	return &pubsubpb.ListPubsubSubscriptionResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigSubscription(ctx context.Context, service_account_file string) (*pubsub.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return pubsub.NewClient(conf), nil
 }
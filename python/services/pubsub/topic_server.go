@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	pubsubpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/pubsub/pubsub_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/pubsub"
@@ -80,7 +81,11 @@ func TopicToProto(resource *pubsub.Topic) *pubsubpb.PubsubTopic {
 // applyTopic handles the gRPC request by passing it to the underlying Topic Apply() method.
 func (s *TopicServer) applyTopic(ctx context.Context, c *pubsub.Client, request *pubsubpb.ApplyPubsubTopicRequest) (*pubsubpb.PubsubTopic, error) {
 	p := ProtoToTopic(request.GetResource())
-	res, err := c.ApplyTopic(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTopic(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +102,60 @@ func (s *TopicServer) ApplyPubsubTopic(ctx context.Context, request *pubsubpb.Ap
 	return s.applyTopic(ctx, cl, request)
 }
 
+// GetPubsubTopic handles the gRPC request by passing it to the underlying Topic Get() method.
+func (s *TopicServer) GetPubsubTopic(ctx context.Context, request *pubsubpb.GetPubsubTopicRequest) (*pubsubpb.PubsubTopic, error) {
+	cl, err := createConfigTopic(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTopic(ctx, ProtoToTopic(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TopicToProto(res), nil
+}
+
+// HasDiffPubsubTopic handles the gRPC request by passing it to the underlying Topic Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TopicServer) HasDiffPubsubTopic(ctx context.Context, request *pubsubpb.HasDiffPubsubTopicRequest) (*pubsubpb.HasDiffPubsubTopicResponse, error) {
+	cl, err := createConfigTopic(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTopic(ctx, ProtoToTopic(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &pubsubpb.HasDiffPubsubTopicResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanPubsubTopic handles the gRPC request by passing it to the underlying Topic Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TopicServer) PlanPubsubTopic(ctx context.Context, request *pubsubpb.PlanPubsubTopicRequest) (*pubsubpb.PlanPubsubTopicResponse, error) {
+	cl, err := createConfigTopic(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTopic(ctx, ProtoToTopic(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &pubsubpb.PlanPubsubTopicResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteTopic handles the gRPC request by passing it to the underlying Topic Delete() method.
 func (s *TopicServer) DeletePubsubTopic(ctx context.Context, request *pubsubpb.DeletePubsubTopicRequest) (*emptypb.Empty, error) {
 
@@ -115,22 +174,35 @@ func (s *TopicServer) ListPubsubTopic(ctx context.Context, request *pubsubpb.Lis
 		return nil, err
 	}
 
-	resources, err := cl.ListTopic(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = pubsub.TopicMaxPage
+	}
+	resources, err := cl.ListTopicWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*pubsubpb.PubsubTopic
-	for _, r := range resources.Items {
-		rp := TopicToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TopicToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &pubsubpb.ListPubsubTopicResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigTopic(ctx context.Context, service_account_file string) (*pubsub.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return pubsub.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	pubsublitepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/pubsublite/pubsublite_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/pubsublite"
@@ -98,7 +99,11 @@ func SubscriptionToProto(resource *pubsublite.Subscription) *pubsublitepb.Pubsub
 // ApplySubscription handles the gRPC request by passing it to the underlying Subscription Apply() method.
 func (s *SubscriptionServer) applySubscription(ctx context.Context, c *pubsublite.Client, request *pubsublitepb.ApplyPubsubliteSubscriptionRequest) (*pubsublitepb.PubsubliteSubscription, error) {
 	p := ProtoToSubscription(request.GetResource())
-	res, err := c.ApplySubscription(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySubscription(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -115,6 +120,55 @@ func (s *SubscriptionServer) ApplyPubsubliteSubscription(ctx context.Context, re
 	return s.applySubscription(ctx, cl, request)
 }
 
+// GetPubsubliteSubscription handles the gRPC request by passing it to the underlying Subscription Get() method.
+func (s *SubscriptionServer) GetPubsubliteSubscription(ctx context.Context, request *pubsublitepb.GetPubsubliteSubscriptionRequest) (*pubsublitepb.PubsubliteSubscription, error) {
+	cl, err := createConfigSubscription(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSubscription(ctx, ProtoToSubscription(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SubscriptionToProto(res), nil
+}
+
+// HasDiffPubsubliteSubscription handles the gRPC request by passing it to the underlying Subscription Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SubscriptionServer) HasDiffPubsubliteSubscription(ctx context.Context, request *pubsublitepb.HasDiffPubsubliteSubscriptionRequest) (*pubsublitepb.HasDiffPubsubliteSubscriptionResponse, error) {
+	cl, err := createConfigSubscription(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSubscription(ctx, ProtoToSubscription(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &pubsublitepb.HasDiffPubsubliteSubscriptionResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanPubsubliteSubscription handles the gRPC request by passing it to the underlying Subscription Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SubscriptionServer) PlanPubsubliteSubscription(ctx context.Context, request *pubsublitepb.PlanPubsubliteSubscriptionRequest) (*pubsublitepb.PlanPubsubliteSubscriptionResponse, error) {
+	cl, err := createConfigSubscription(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSubscription(ctx, ProtoToSubscription(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &pubsublitepb.PlanPubsubliteSubscriptionResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteSubscription handles the gRPC request by passing it to the underlying Subscription Delete() method.
 func (s *SubscriptionServer) DeletePubsubliteSubscription(ctx context.Context, request *pubsublitepb.DeletePubsubliteSubscriptionRequest) (*emptypb.Empty, error) {
 
@@ -133,20 +187,32 @@ func (s *SubscriptionServer) ListPubsubliteSubscription(ctx context.Context, req
 		return nil, err
 	}
 
-	resources, err := cl.ListSubscription(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = pubsublite.SubscriptionMaxPage
+	}
+	resources, err := cl.ListSubscriptionWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*pubsublitepb.PubsubliteSubscription
-	for _, r := range resources.Items {
-		rp := SubscriptionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SubscriptionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &pubsublitepb.ListPubsubliteSubscriptionResponse{Items: protos}, nil
+	return &pubsublitepb.ListPubsubliteSubscriptionResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigSubscription(ctx context.Context, service_account_file string) (*pubsublite.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return pubsublite.NewClient(conf), nil
 }
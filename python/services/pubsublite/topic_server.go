@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	pubsublitepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/pubsublite/pubsublite_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/pubsublite"
@@ -125,7 +126,11 @@ func TopicToProto(resource *pubsublite.Topic) *pubsublitepb.PubsubliteTopic {
 // ApplyTopic handles the gRPC request by passing it to the underlying Topic Apply() method.
 func (s *TopicServer) applyTopic(ctx context.Context, c *pubsublite.Client, request *pubsublitepb.ApplyPubsubliteTopicRequest) (*pubsublitepb.PubsubliteTopic, error) {
 	p := ProtoToTopic(request.GetResource())
-	res, err := c.ApplyTopic(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyTopic(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -142,6 +147,55 @@ func (s *TopicServer) ApplyPubsubliteTopic(ctx context.Context, request *pubsubl
 	return s.applyTopic(ctx, cl, request)
 }
 
+// GetPubsubliteTopic handles the gRPC request by passing it to the underlying Topic Get() method.
+func (s *TopicServer) GetPubsubliteTopic(ctx context.Context, request *pubsublitepb.GetPubsubliteTopicRequest) (*pubsublitepb.PubsubliteTopic, error) {
+	cl, err := createConfigTopic(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetTopic(ctx, ProtoToTopic(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return TopicToProto(res), nil
+}
+
+// HasDiffPubsubliteTopic handles the gRPC request by passing it to the underlying Topic Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *TopicServer) HasDiffPubsubliteTopic(ctx context.Context, request *pubsublitepb.HasDiffPubsubliteTopicRequest) (*pubsublitepb.HasDiffPubsubliteTopicResponse, error) {
+	cl, err := createConfigTopic(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTopic(ctx, ProtoToTopic(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &pubsublitepb.HasDiffPubsubliteTopicResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanPubsubliteTopic handles the gRPC request by passing it to the underlying Topic Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *TopicServer) PlanPubsubliteTopic(ctx context.Context, request *pubsublitepb.PlanPubsubliteTopicRequest) (*pubsublitepb.PlanPubsubliteTopicResponse, error) {
+	cl, err := createConfigTopic(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffTopic(ctx, ProtoToTopic(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &pubsublitepb.PlanPubsubliteTopicResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteTopic handles the gRPC request by passing it to the underlying Topic Delete() method.
 func (s *TopicServer) DeletePubsubliteTopic(ctx context.Context, request *pubsublitepb.DeletePubsubliteTopicRequest) (*emptypb.Empty, error) {
 
@@ -160,20 +214,32 @@ func (s *TopicServer) ListPubsubliteTopic(ctx context.Context, request *pubsubli
 		return nil, err
 	}
 
-	resources, err := cl.ListTopic(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = pubsublite.TopicMaxPage
+	}
+	resources, err := cl.ListTopicWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*pubsublitepb.PubsubliteTopic
-	for _, r := range resources.Items {
-		rp := TopicToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, TopicToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &pubsublitepb.ListPubsubliteTopicResponse{Items: protos}, nil
+	return &pubsublitepb.ListPubsubliteTopicResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigTopic(ctx context.Context, service_account_file string) (*pubsublite.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return pubsublite.NewClient(conf), nil
 }
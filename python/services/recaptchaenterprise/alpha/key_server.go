@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/recaptchaenterprise/alpha/recaptchaenterprise_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/recaptchaenterprise/alpha"
@@ -247,7 +248,11 @@ func KeyToProto(resource *alpha.Key) *alphapb.RecaptchaenterpriseAlphaKey {
 // applyKey handles the gRPC request by passing it to the underlying Key Apply() method.
 func (s *KeyServer) applyKey(ctx context.Context, c *alpha.Client, request *alphapb.ApplyRecaptchaenterpriseAlphaKeyRequest) (*alphapb.RecaptchaenterpriseAlphaKey, error) {
 	p := ProtoToKey(request.GetResource())
-	res, err := c.ApplyKey(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyKey(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -264,6 +269,60 @@ func (s *KeyServer) ApplyRecaptchaenterpriseAlphaKey(ctx context.Context, reques
 	return s.applyKey(ctx, cl, request)
 }
 
+// GetRecaptchaenterpriseAlphaKey handles the gRPC request by passing it to the underlying Key Get() method.
+func (s *KeyServer) GetRecaptchaenterpriseAlphaKey(ctx context.Context, request *alphapb.GetRecaptchaenterpriseAlphaKeyRequest) (*alphapb.RecaptchaenterpriseAlphaKey, error) {
+	cl, err := createConfigKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetKey(ctx, ProtoToKey(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return KeyToProto(res), nil
+}
+
+// HasDiffRecaptchaenterpriseAlphaKey handles the gRPC request by passing it to the underlying Key Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *KeyServer) HasDiffRecaptchaenterpriseAlphaKey(ctx context.Context, request *alphapb.HasDiffRecaptchaenterpriseAlphaKeyRequest) (*alphapb.HasDiffRecaptchaenterpriseAlphaKeyResponse, error) {
+	cl, err := createConfigKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKey(ctx, ProtoToKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffRecaptchaenterpriseAlphaKeyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanRecaptchaenterpriseAlphaKey handles the gRPC request by passing it to the underlying Key Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *KeyServer) PlanRecaptchaenterpriseAlphaKey(ctx context.Context, request *alphapb.PlanRecaptchaenterpriseAlphaKeyRequest) (*alphapb.PlanRecaptchaenterpriseAlphaKeyResponse, error) {
+	cl, err := createConfigKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKey(ctx, ProtoToKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanRecaptchaenterpriseAlphaKeyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteKey handles the gRPC request by passing it to the underlying Key Delete() method.
 func (s *KeyServer) DeleteRecaptchaenterpriseAlphaKey(ctx context.Context, request *alphapb.DeleteRecaptchaenterpriseAlphaKeyRequest) (*emptypb.Empty, error) {
 
@@ -282,22 +341,35 @@ func (s *KeyServer) ListRecaptchaenterpriseAlphaKey(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListKey(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.KeyMaxPage
+	}
+	resources, err := cl.ListKeyWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.RecaptchaenterpriseAlphaKey
-	for _, r := range resources.Items {
-		rp := KeyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, KeyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListRecaptchaenterpriseAlphaKeyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigKey(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
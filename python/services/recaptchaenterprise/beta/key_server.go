@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/recaptchaenterprise/beta/recaptchaenterprise_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/recaptchaenterprise/beta"
@@ -247,7 +248,11 @@ func KeyToProto(resource *beta.Key) *betapb.RecaptchaenterpriseBetaKey {
 // applyKey handles the gRPC request by passing it to the underlying Key Apply() method.
 func (s *KeyServer) applyKey(ctx context.Context, c *beta.Client, request *betapb.ApplyRecaptchaenterpriseBetaKeyRequest) (*betapb.RecaptchaenterpriseBetaKey, error) {
 	p := ProtoToKey(request.GetResource())
-	res, err := c.ApplyKey(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyKey(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -264,6 +269,60 @@ func (s *KeyServer) ApplyRecaptchaenterpriseBetaKey(ctx context.Context, request
 	return s.applyKey(ctx, cl, request)
 }
 
+// GetRecaptchaenterpriseBetaKey handles the gRPC request by passing it to the underlying Key Get() method.
+func (s *KeyServer) GetRecaptchaenterpriseBetaKey(ctx context.Context, request *betapb.GetRecaptchaenterpriseBetaKeyRequest) (*betapb.RecaptchaenterpriseBetaKey, error) {
+	cl, err := createConfigKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetKey(ctx, ProtoToKey(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return KeyToProto(res), nil
+}
+
+// HasDiffRecaptchaenterpriseBetaKey handles the gRPC request by passing it to the underlying Key Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *KeyServer) HasDiffRecaptchaenterpriseBetaKey(ctx context.Context, request *betapb.HasDiffRecaptchaenterpriseBetaKeyRequest) (*betapb.HasDiffRecaptchaenterpriseBetaKeyResponse, error) {
+	cl, err := createConfigKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKey(ctx, ProtoToKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffRecaptchaenterpriseBetaKeyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanRecaptchaenterpriseBetaKey handles the gRPC request by passing it to the underlying Key Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *KeyServer) PlanRecaptchaenterpriseBetaKey(ctx context.Context, request *betapb.PlanRecaptchaenterpriseBetaKeyRequest) (*betapb.PlanRecaptchaenterpriseBetaKeyResponse, error) {
+	cl, err := createConfigKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKey(ctx, ProtoToKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanRecaptchaenterpriseBetaKeyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteKey handles the gRPC request by passing it to the underlying Key Delete() method.
 func (s *KeyServer) DeleteRecaptchaenterpriseBetaKey(ctx context.Context, request *betapb.DeleteRecaptchaenterpriseBetaKeyRequest) (*emptypb.Empty, error) {
 
@@ -282,22 +341,35 @@ func (s *KeyServer) ListRecaptchaenterpriseBetaKey(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListKey(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.KeyMaxPage
+	}
+	resources, err := cl.ListKeyWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.RecaptchaenterpriseBetaKey
-	for _, r := range resources.Items {
-		rp := KeyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, KeyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListRecaptchaenterpriseBetaKeyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigKey(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
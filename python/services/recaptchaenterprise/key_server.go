@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	recaptchaenterprisepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/recaptchaenterprise/recaptchaenterprise_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/recaptchaenterprise"
@@ -247,7 +248,11 @@ func KeyToProto(resource *recaptchaenterprise.Key) *recaptchaenterprisepb.Recapt
 // applyKey handles the gRPC request by passing it to the underlying Key Apply() method.
 func (s *KeyServer) applyKey(ctx context.Context, c *recaptchaenterprise.Client, request *recaptchaenterprisepb.ApplyRecaptchaenterpriseKeyRequest) (*recaptchaenterprisepb.RecaptchaenterpriseKey, error) {
 	p := ProtoToKey(request.GetResource())
-	res, err := c.ApplyKey(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyKey(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -264,6 +269,60 @@ func (s *KeyServer) ApplyRecaptchaenterpriseKey(ctx context.Context, request *re
 	return s.applyKey(ctx, cl, request)
 }
 
+// GetRecaptchaenterpriseKey handles the gRPC request by passing it to the underlying Key Get() method.
+func (s *KeyServer) GetRecaptchaenterpriseKey(ctx context.Context, request *recaptchaenterprisepb.GetRecaptchaenterpriseKeyRequest) (*recaptchaenterprisepb.RecaptchaenterpriseKey, error) {
+	cl, err := createConfigKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetKey(ctx, ProtoToKey(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return KeyToProto(res), nil
+}
+
+// HasDiffRecaptchaenterpriseKey handles the gRPC request by passing it to the underlying Key Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *KeyServer) HasDiffRecaptchaenterpriseKey(ctx context.Context, request *recaptchaenterprisepb.HasDiffRecaptchaenterpriseKeyRequest) (*recaptchaenterprisepb.HasDiffRecaptchaenterpriseKeyResponse, error) {
+	cl, err := createConfigKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKey(ctx, ProtoToKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &recaptchaenterprisepb.HasDiffRecaptchaenterpriseKeyResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanRecaptchaenterpriseKey handles the gRPC request by passing it to the underlying Key Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *KeyServer) PlanRecaptchaenterpriseKey(ctx context.Context, request *recaptchaenterprisepb.PlanRecaptchaenterpriseKeyRequest) (*recaptchaenterprisepb.PlanRecaptchaenterpriseKeyResponse, error) {
+	cl, err := createConfigKey(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffKey(ctx, ProtoToKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &recaptchaenterprisepb.PlanRecaptchaenterpriseKeyResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteKey handles the gRPC request by passing it to the underlying Key Delete() method.
 func (s *KeyServer) DeleteRecaptchaenterpriseKey(ctx context.Context, request *recaptchaenterprisepb.DeleteRecaptchaenterpriseKeyRequest) (*emptypb.Empty, error) {
 
@@ -282,22 +341,35 @@ func (s *KeyServer) ListRecaptchaenterpriseKey(ctx context.Context, request *rec
 		return nil, err
 	}
 
-	resources, err := cl.ListKey(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = recaptchaenterprise.KeyMaxPage
+	}
+	resources, err := cl.ListKeyWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*recaptchaenterprisepb.RecaptchaenterpriseKey
-	for _, r := range resources.Items {
-		rp := KeyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, KeyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &recaptchaenterprisepb.ListRecaptchaenterpriseKeyResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigKey(ctx context.Context, service_account_file string) (*recaptchaenterprise.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return recaptchaenterprise.NewClient(conf), nil
 }
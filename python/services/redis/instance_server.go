@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	redispb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/redis/redis_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/redis"
@@ -354,7 +355,11 @@ func InstanceToProto(resource *redis.Instance) *redispb.RedisInstance {
 // ApplyInstance handles the gRPC request by passing it to the underlying Instance Apply() method.
 func (s *InstanceServer) applyInstance(ctx context.Context, c *redis.Client, request *redispb.ApplyRedisInstanceRequest) (*redispb.RedisInstance, error) {
 	p := ProtoToInstance(request.GetResource())
-	res, err := c.ApplyInstance(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstance(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -371,6 +376,55 @@ func (s *InstanceServer) ApplyRedisInstance(ctx context.Context, request *redisp
 	return s.applyInstance(ctx, cl, request)
 }
 
+// GetRedisInstance handles the gRPC request by passing it to the underlying Instance Get() method.
+func (s *InstanceServer) GetRedisInstance(ctx context.Context, request *redispb.GetRedisInstanceRequest) (*redispb.RedisInstance, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstance(ctx, ProtoToInstance(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToProto(res), nil
+}
+
+// HasDiffRedisInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceServer) HasDiffRedisInstance(ctx context.Context, request *redispb.HasDiffRedisInstanceRequest) (*redispb.HasDiffRedisInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &redispb.HasDiffRedisInstanceResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanRedisInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceServer) PlanRedisInstance(ctx context.Context, request *redispb.PlanRedisInstanceRequest) (*redispb.PlanRedisInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &redispb.PlanRedisInstanceResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteInstance handles the gRPC request by passing it to the underlying Instance Delete() method.
 func (s *InstanceServer) DeleteRedisInstance(ctx context.Context, request *redispb.DeleteRedisInstanceRequest) (*emptypb.Empty, error) {
 
@@ -389,20 +443,32 @@ func (s *InstanceServer) ListRedisInstance(ctx context.Context, request *redispb
 		return nil, err
 	}
 
-	resources, err := cl.ListInstance(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = redis.InstanceMaxPage
+	}
+	resources, err := cl.ListInstanceWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*redispb.RedisInstance
-	for _, r := range resources.Items {
-		rp := InstanceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &redispb.ListRedisInstanceResponse{Items: protos}, nil
+	return &redispb.ListRedisInstanceResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigInstance(ctx context.Context, service_account_file string) (*redis.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return redis.NewClient(conf), nil
 }
@@ -0,0 +1,1139 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package server
+
+import (
+	"context"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
+	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
+	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/run/beta/run_beta_go_proto"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/run/beta"
+)
+
+// JobServer implements the gRPC interface for Job.
+type JobServer struct{}
+
+// ProtoToJobLaunchStageEnum converts a JobLaunchStageEnum enum from its proto representation.
+func ProtoToRunBetaJobLaunchStageEnum(e betapb.RunBetaJobLaunchStageEnum) *beta.JobLaunchStageEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobLaunchStageEnum_name[int32(e)]; ok {
+		e := beta.JobLaunchStageEnum(n[len("RunBetaJobLaunchStageEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTemplateTemplateExecutionEnvironmentEnum converts a JobTemplateTemplateExecutionEnvironmentEnum enum from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateExecutionEnvironmentEnum(e betapb.RunBetaJobTemplateTemplateExecutionEnvironmentEnum) *beta.JobTemplateTemplateExecutionEnvironmentEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobTemplateTemplateExecutionEnvironmentEnum_name[int32(e)]; ok {
+		e := beta.JobTemplateTemplateExecutionEnvironmentEnum(n[len("RunBetaJobTemplateTemplateExecutionEnvironmentEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTemplateTemplateVPCAccessEgressEnum converts a JobTemplateTemplateVPCAccessEgressEnum enum from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateVPCAccessEgressEnum(e betapb.RunBetaJobTemplateTemplateVPCAccessEgressEnum) *beta.JobTemplateTemplateVPCAccessEgressEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobTemplateTemplateVPCAccessEgressEnum_name[int32(e)]; ok {
+		e := beta.JobTemplateTemplateVPCAccessEgressEnum(n[len("RunBetaJobTemplateTemplateVPCAccessEgressEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionStateEnum converts a JobTerminalConditionStateEnum enum from its proto representation.
+func ProtoToRunBetaJobTerminalConditionStateEnum(e betapb.RunBetaJobTerminalConditionStateEnum) *beta.JobTerminalConditionStateEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobTerminalConditionStateEnum_name[int32(e)]; ok {
+		e := beta.JobTerminalConditionStateEnum(n[len("RunBetaJobTerminalConditionStateEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionSeverityEnum converts a JobTerminalConditionSeverityEnum enum from its proto representation.
+func ProtoToRunBetaJobTerminalConditionSeverityEnum(e betapb.RunBetaJobTerminalConditionSeverityEnum) *beta.JobTerminalConditionSeverityEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobTerminalConditionSeverityEnum_name[int32(e)]; ok {
+		e := beta.JobTerminalConditionSeverityEnum(n[len("RunBetaJobTerminalConditionSeverityEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionReasonEnum converts a JobTerminalConditionReasonEnum enum from its proto representation.
+func ProtoToRunBetaJobTerminalConditionReasonEnum(e betapb.RunBetaJobTerminalConditionReasonEnum) *beta.JobTerminalConditionReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobTerminalConditionReasonEnum_name[int32(e)]; ok {
+		e := beta.JobTerminalConditionReasonEnum(n[len("RunBetaJobTerminalConditionReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionInternalReasonEnum converts a JobTerminalConditionInternalReasonEnum enum from its proto representation.
+func ProtoToRunBetaJobTerminalConditionInternalReasonEnum(e betapb.RunBetaJobTerminalConditionInternalReasonEnum) *beta.JobTerminalConditionInternalReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobTerminalConditionInternalReasonEnum_name[int32(e)]; ok {
+		e := beta.JobTerminalConditionInternalReasonEnum(n[len("RunBetaJobTerminalConditionInternalReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionDomainMappingReasonEnum converts a JobTerminalConditionDomainMappingReasonEnum enum from its proto representation.
+func ProtoToRunBetaJobTerminalConditionDomainMappingReasonEnum(e betapb.RunBetaJobTerminalConditionDomainMappingReasonEnum) *beta.JobTerminalConditionDomainMappingReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobTerminalConditionDomainMappingReasonEnum_name[int32(e)]; ok {
+		e := beta.JobTerminalConditionDomainMappingReasonEnum(n[len("RunBetaJobTerminalConditionDomainMappingReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionRevisionReasonEnum converts a JobTerminalConditionRevisionReasonEnum enum from its proto representation.
+func ProtoToRunBetaJobTerminalConditionRevisionReasonEnum(e betapb.RunBetaJobTerminalConditionRevisionReasonEnum) *beta.JobTerminalConditionRevisionReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobTerminalConditionRevisionReasonEnum_name[int32(e)]; ok {
+		e := beta.JobTerminalConditionRevisionReasonEnum(n[len("RunBetaJobTerminalConditionRevisionReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionExecutionReasonEnum converts a JobTerminalConditionExecutionReasonEnum enum from its proto representation.
+func ProtoToRunBetaJobTerminalConditionExecutionReasonEnum(e betapb.RunBetaJobTerminalConditionExecutionReasonEnum) *beta.JobTerminalConditionExecutionReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobTerminalConditionExecutionReasonEnum_name[int32(e)]; ok {
+		e := beta.JobTerminalConditionExecutionReasonEnum(n[len("RunBetaJobTerminalConditionExecutionReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobConditionsStateEnum converts a JobConditionsStateEnum enum from its proto representation.
+func ProtoToRunBetaJobConditionsStateEnum(e betapb.RunBetaJobConditionsStateEnum) *beta.JobConditionsStateEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobConditionsStateEnum_name[int32(e)]; ok {
+		e := beta.JobConditionsStateEnum(n[len("RunBetaJobConditionsStateEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobConditionsSeverityEnum converts a JobConditionsSeverityEnum enum from its proto representation.
+func ProtoToRunBetaJobConditionsSeverityEnum(e betapb.RunBetaJobConditionsSeverityEnum) *beta.JobConditionsSeverityEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobConditionsSeverityEnum_name[int32(e)]; ok {
+		e := beta.JobConditionsSeverityEnum(n[len("RunBetaJobConditionsSeverityEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobConditionsReasonEnum converts a JobConditionsReasonEnum enum from its proto representation.
+func ProtoToRunBetaJobConditionsReasonEnum(e betapb.RunBetaJobConditionsReasonEnum) *beta.JobConditionsReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobConditionsReasonEnum_name[int32(e)]; ok {
+		e := beta.JobConditionsReasonEnum(n[len("RunBetaJobConditionsReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobConditionsRevisionReasonEnum converts a JobConditionsRevisionReasonEnum enum from its proto representation.
+func ProtoToRunBetaJobConditionsRevisionReasonEnum(e betapb.RunBetaJobConditionsRevisionReasonEnum) *beta.JobConditionsRevisionReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobConditionsRevisionReasonEnum_name[int32(e)]; ok {
+		e := beta.JobConditionsRevisionReasonEnum(n[len("RunBetaJobConditionsRevisionReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobConditionsExecutionReasonEnum converts a JobConditionsExecutionReasonEnum enum from its proto representation.
+func ProtoToRunBetaJobConditionsExecutionReasonEnum(e betapb.RunBetaJobConditionsExecutionReasonEnum) *beta.JobConditionsExecutionReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := betapb.RunBetaJobConditionsExecutionReasonEnum_name[int32(e)]; ok {
+		e := beta.JobConditionsExecutionReasonEnum(n[len("RunBetaJobConditionsExecutionReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobBinaryAuthorization converts a JobBinaryAuthorization object from its proto representation.
+func ProtoToRunBetaJobBinaryAuthorization(p *betapb.RunBetaJobBinaryAuthorization) *beta.JobBinaryAuthorization {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobBinaryAuthorization{
+		UseDefault:              dcl.Bool(p.GetUseDefault()),
+		BreakglassJustification: dcl.StringOrNil(p.GetBreakglassJustification()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplate converts a JobTemplate object from its proto representation.
+func ProtoToRunBetaJobTemplate(p *betapb.RunBetaJobTemplate) *beta.JobTemplate {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplate{
+		Parallelism: dcl.Int64OrNil(p.GetParallelism()),
+		TaskCount:   dcl.Int64OrNil(p.GetTaskCount()),
+		Template:    ProtoToRunBetaJobTemplateTemplate(p.GetTemplate()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplate converts a JobTemplateTemplate object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplate(p *betapb.RunBetaJobTemplateTemplate) *beta.JobTemplateTemplate {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplate{
+		MaxRetries:           dcl.Int64OrNil(p.GetMaxRetries()),
+		Timeout:              dcl.StringOrNil(p.GetTimeout()),
+		ServiceAccount:       dcl.StringOrNil(p.GetServiceAccount()),
+		ExecutionEnvironment: ProtoToRunBetaJobTemplateTemplateExecutionEnvironmentEnum(p.GetExecutionEnvironment()),
+		EncryptionKey:        dcl.StringOrNil(p.GetEncryptionKey()),
+		VPCAccess:            ProtoToRunBetaJobTemplateTemplateVPCAccess(p.GetVpcAccess()),
+	}
+	for _, r := range p.GetContainers() {
+		obj.Containers = append(obj.Containers, *ProtoToRunBetaJobTemplateTemplateContainers(r))
+	}
+	for _, r := range p.GetVolumes() {
+		obj.Volumes = append(obj.Volumes, *ProtoToRunBetaJobTemplateTemplateVolumes(r))
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainers converts a JobTemplateTemplateContainers object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateContainers(p *betapb.RunBetaJobTemplateTemplateContainers) *beta.JobTemplateTemplateContainers {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplateContainers{
+		Name:      dcl.StringOrNil(p.GetName()),
+		Image:     dcl.StringOrNil(p.GetImage()),
+		Resources: ProtoToRunBetaJobTemplateTemplateContainersResources(p.GetResources()),
+	}
+	for _, r := range p.GetCommand() {
+		obj.Command = append(obj.Command, r)
+	}
+	for _, r := range p.GetArgs() {
+		obj.Args = append(obj.Args, r)
+	}
+	for _, r := range p.GetEnv() {
+		obj.Env = append(obj.Env, *ProtoToRunBetaJobTemplateTemplateContainersEnv(r))
+	}
+	for _, r := range p.GetPorts() {
+		obj.Ports = append(obj.Ports, *ProtoToRunBetaJobTemplateTemplateContainersPorts(r))
+	}
+	for _, r := range p.GetVolumeMounts() {
+		obj.VolumeMounts = append(obj.VolumeMounts, *ProtoToRunBetaJobTemplateTemplateContainersVolumeMounts(r))
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainersEnv converts a JobTemplateTemplateContainersEnv object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateContainersEnv(p *betapb.RunBetaJobTemplateTemplateContainersEnv) *beta.JobTemplateTemplateContainersEnv {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplateContainersEnv{
+		Name:        dcl.StringOrNil(p.GetName()),
+		Value:       dcl.StringOrNil(p.GetValue()),
+		ValueSource: ProtoToRunBetaJobTemplateTemplateContainersEnvValueSource(p.GetValueSource()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainersEnvValueSource converts a JobTemplateTemplateContainersEnvValueSource object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateContainersEnvValueSource(p *betapb.RunBetaJobTemplateTemplateContainersEnvValueSource) *beta.JobTemplateTemplateContainersEnvValueSource {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplateContainersEnvValueSource{
+		SecretKeyRef: ProtoToRunBetaJobTemplateTemplateContainersEnvValueSourceSecretKeyRef(p.GetSecretKeyRef()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainersEnvValueSourceSecretKeyRef converts a JobTemplateTemplateContainersEnvValueSourceSecretKeyRef object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateContainersEnvValueSourceSecretKeyRef(p *betapb.RunBetaJobTemplateTemplateContainersEnvValueSourceSecretKeyRef) *beta.JobTemplateTemplateContainersEnvValueSourceSecretKeyRef {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplateContainersEnvValueSourceSecretKeyRef{
+		Secret:  dcl.StringOrNil(p.GetSecret()),
+		Version: dcl.StringOrNil(p.GetVersion()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainersResources converts a JobTemplateTemplateContainersResources object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateContainersResources(p *betapb.RunBetaJobTemplateTemplateContainersResources) *beta.JobTemplateTemplateContainersResources {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplateContainersResources{
+		CpuIdle: dcl.Bool(p.GetCpuIdle()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainersPorts converts a JobTemplateTemplateContainersPorts object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateContainersPorts(p *betapb.RunBetaJobTemplateTemplateContainersPorts) *beta.JobTemplateTemplateContainersPorts {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplateContainersPorts{
+		Name:          dcl.StringOrNil(p.GetName()),
+		ContainerPort: dcl.Int64OrNil(p.GetContainerPort()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainersVolumeMounts converts a JobTemplateTemplateContainersVolumeMounts object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateContainersVolumeMounts(p *betapb.RunBetaJobTemplateTemplateContainersVolumeMounts) *beta.JobTemplateTemplateContainersVolumeMounts {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplateContainersVolumeMounts{
+		Name:      dcl.StringOrNil(p.GetName()),
+		MountPath: dcl.StringOrNil(p.GetMountPath()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateVolumes converts a JobTemplateTemplateVolumes object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateVolumes(p *betapb.RunBetaJobTemplateTemplateVolumes) *beta.JobTemplateTemplateVolumes {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplateVolumes{
+		Name:             dcl.StringOrNil(p.GetName()),
+		Secret:           ProtoToRunBetaJobTemplateTemplateVolumesSecret(p.GetSecret()),
+		CloudSqlInstance: ProtoToRunBetaJobTemplateTemplateVolumesCloudSqlInstance(p.GetCloudSqlInstance()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateVolumesSecret converts a JobTemplateTemplateVolumesSecret object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateVolumesSecret(p *betapb.RunBetaJobTemplateTemplateVolumesSecret) *beta.JobTemplateTemplateVolumesSecret {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplateVolumesSecret{
+		Secret:      dcl.StringOrNil(p.GetSecret()),
+		DefaultMode: dcl.Int64OrNil(p.GetDefaultMode()),
+	}
+	for _, r := range p.GetItems() {
+		obj.Items = append(obj.Items, *ProtoToRunBetaJobTemplateTemplateVolumesSecretItems(r))
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateVolumesSecretItems converts a JobTemplateTemplateVolumesSecretItems object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateVolumesSecretItems(p *betapb.RunBetaJobTemplateTemplateVolumesSecretItems) *beta.JobTemplateTemplateVolumesSecretItems {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplateVolumesSecretItems{
+		Path:    dcl.StringOrNil(p.GetPath()),
+		Version: dcl.StringOrNil(p.GetVersion()),
+		Mode:    dcl.Int64OrNil(p.GetMode()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateVolumesCloudSqlInstance converts a JobTemplateTemplateVolumesCloudSqlInstance object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateVolumesCloudSqlInstance(p *betapb.RunBetaJobTemplateTemplateVolumesCloudSqlInstance) *beta.JobTemplateTemplateVolumesCloudSqlInstance {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplateVolumesCloudSqlInstance{}
+	for _, r := range p.GetInstances() {
+		obj.Instances = append(obj.Instances, r)
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateVPCAccess converts a JobTemplateTemplateVPCAccess object from its proto representation.
+func ProtoToRunBetaJobTemplateTemplateVPCAccess(p *betapb.RunBetaJobTemplateTemplateVPCAccess) *beta.JobTemplateTemplateVPCAccess {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTemplateTemplateVPCAccess{
+		Connector: dcl.StringOrNil(p.GetConnector()),
+		Egress:    ProtoToRunBetaJobTemplateTemplateVPCAccessEgressEnum(p.GetEgress()),
+	}
+	return obj
+}
+
+// ProtoToJobTerminalCondition converts a JobTerminalCondition object from its proto representation.
+func ProtoToRunBetaJobTerminalCondition(p *betapb.RunBetaJobTerminalCondition) *beta.JobTerminalCondition {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobTerminalCondition{
+		Type:                dcl.StringOrNil(p.GetType()),
+		State:               ProtoToRunBetaJobTerminalConditionStateEnum(p.GetState()),
+		Message:             dcl.StringOrNil(p.GetMessage()),
+		LastTransitionTime:  dcl.StringOrNil(p.GetLastTransitionTime()),
+		Severity:            ProtoToRunBetaJobTerminalConditionSeverityEnum(p.GetSeverity()),
+		Reason:              ProtoToRunBetaJobTerminalConditionReasonEnum(p.GetReason()),
+		InternalReason:      ProtoToRunBetaJobTerminalConditionInternalReasonEnum(p.GetInternalReason()),
+		DomainMappingReason: ProtoToRunBetaJobTerminalConditionDomainMappingReasonEnum(p.GetDomainMappingReason()),
+		RevisionReason:      ProtoToRunBetaJobTerminalConditionRevisionReasonEnum(p.GetRevisionReason()),
+		ExecutionReason:     ProtoToRunBetaJobTerminalConditionExecutionReasonEnum(p.GetExecutionReason()),
+	}
+	return obj
+}
+
+// ProtoToJobConditions converts a JobConditions object from its proto representation.
+func ProtoToRunBetaJobConditions(p *betapb.RunBetaJobConditions) *beta.JobConditions {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobConditions{
+		Type:               dcl.StringOrNil(p.GetType()),
+		State:              ProtoToRunBetaJobConditionsStateEnum(p.GetState()),
+		Message:            dcl.StringOrNil(p.GetMessage()),
+		LastTransitionTime: dcl.StringOrNil(p.GetLastTransitionTime()),
+		Severity:           ProtoToRunBetaJobConditionsSeverityEnum(p.GetSeverity()),
+		Reason:             ProtoToRunBetaJobConditionsReasonEnum(p.GetReason()),
+		RevisionReason:     ProtoToRunBetaJobConditionsRevisionReasonEnum(p.GetRevisionReason()),
+		ExecutionReason:    ProtoToRunBetaJobConditionsExecutionReasonEnum(p.GetExecutionReason()),
+	}
+	return obj
+}
+
+// ProtoToJobLatestSucceededExecution converts a JobLatestSucceededExecution object from its proto representation.
+func ProtoToRunBetaJobLatestSucceededExecution(p *betapb.RunBetaJobLatestSucceededExecution) *beta.JobLatestSucceededExecution {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobLatestSucceededExecution{
+		Name:       dcl.StringOrNil(p.GetName()),
+		CreateTime: dcl.StringOrNil(p.GetCreateTime()),
+	}
+	return obj
+}
+
+// ProtoToJobLatestCreatedExecution converts a JobLatestCreatedExecution object from its proto representation.
+func ProtoToRunBetaJobLatestCreatedExecution(p *betapb.RunBetaJobLatestCreatedExecution) *beta.JobLatestCreatedExecution {
+	if p == nil {
+		return nil
+	}
+	obj := &beta.JobLatestCreatedExecution{
+		Name:       dcl.StringOrNil(p.GetName()),
+		CreateTime: dcl.StringOrNil(p.GetCreateTime()),
+	}
+	return obj
+}
+
+// ProtoToJob converts a Job resource from its proto representation.
+func ProtoToJob(p *betapb.RunBetaJob) *beta.Job {
+	obj := &beta.Job{
+		Name:                     dcl.StringOrNil(p.GetName()),
+		Uid:                      dcl.StringOrNil(p.GetUid()),
+		Generation:               dcl.Int64OrNil(p.GetGeneration()),
+		CreateTime:               dcl.StringOrNil(p.GetCreateTime()),
+		UpdateTime:               dcl.StringOrNil(p.GetUpdateTime()),
+		DeleteTime:               dcl.StringOrNil(p.GetDeleteTime()),
+		ExpireTime:               dcl.StringOrNil(p.GetExpireTime()),
+		Creator:                  dcl.StringOrNil(p.GetCreator()),
+		LastModifier:             dcl.StringOrNil(p.GetLastModifier()),
+		Client:                   dcl.StringOrNil(p.GetClient()),
+		ClientVersion:            dcl.StringOrNil(p.GetClientVersion()),
+		LaunchStage:              ProtoToRunBetaJobLaunchStageEnum(p.GetLaunchStage()),
+		BinaryAuthorization:      ProtoToRunBetaJobBinaryAuthorization(p.GetBinaryAuthorization()),
+		Template:                 ProtoToRunBetaJobTemplate(p.GetTemplate()),
+		ObservedGeneration:       dcl.Int64OrNil(p.GetObservedGeneration()),
+		TerminalCondition:        ProtoToRunBetaJobTerminalCondition(p.GetTerminalCondition()),
+		ExecutionCount:           dcl.Int64OrNil(p.GetExecutionCount()),
+		LatestSucceededExecution: ProtoToRunBetaJobLatestSucceededExecution(p.GetLatestSucceededExecution()),
+		LatestCreatedExecution:   ProtoToRunBetaJobLatestCreatedExecution(p.GetLatestCreatedExecution()),
+		Reconciling:              dcl.Bool(p.GetReconciling()),
+		Etag:                     dcl.StringOrNil(p.GetEtag()),
+		Project:                  dcl.StringOrNil(p.GetProject()),
+		Location:                 dcl.StringOrNil(p.GetLocation()),
+	}
+	for _, r := range p.GetConditions() {
+		obj.Conditions = append(obj.Conditions, *ProtoToRunBetaJobConditions(r))
+	}
+	return obj
+}
+
+// JobLaunchStageEnumToProto converts a JobLaunchStageEnum enum to its proto representation.
+func RunBetaJobLaunchStageEnumToProto(e *beta.JobLaunchStageEnum) betapb.RunBetaJobLaunchStageEnum {
+	if e == nil {
+		return betapb.RunBetaJobLaunchStageEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobLaunchStageEnum_value["JobLaunchStageEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobLaunchStageEnum(v)
+	}
+	return betapb.RunBetaJobLaunchStageEnum(0)
+}
+
+// JobTemplateTemplateExecutionEnvironmentEnumToProto converts a JobTemplateTemplateExecutionEnvironmentEnum enum to its proto representation.
+func RunBetaJobTemplateTemplateExecutionEnvironmentEnumToProto(e *beta.JobTemplateTemplateExecutionEnvironmentEnum) betapb.RunBetaJobTemplateTemplateExecutionEnvironmentEnum {
+	if e == nil {
+		return betapb.RunBetaJobTemplateTemplateExecutionEnvironmentEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobTemplateTemplateExecutionEnvironmentEnum_value["JobTemplateTemplateExecutionEnvironmentEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobTemplateTemplateExecutionEnvironmentEnum(v)
+	}
+	return betapb.RunBetaJobTemplateTemplateExecutionEnvironmentEnum(0)
+}
+
+// JobTemplateTemplateVPCAccessEgressEnumToProto converts a JobTemplateTemplateVPCAccessEgressEnum enum to its proto representation.
+func RunBetaJobTemplateTemplateVPCAccessEgressEnumToProto(e *beta.JobTemplateTemplateVPCAccessEgressEnum) betapb.RunBetaJobTemplateTemplateVPCAccessEgressEnum {
+	if e == nil {
+		return betapb.RunBetaJobTemplateTemplateVPCAccessEgressEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobTemplateTemplateVPCAccessEgressEnum_value["JobTemplateTemplateVPCAccessEgressEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobTemplateTemplateVPCAccessEgressEnum(v)
+	}
+	return betapb.RunBetaJobTemplateTemplateVPCAccessEgressEnum(0)
+}
+
+// JobTerminalConditionStateEnumToProto converts a JobTerminalConditionStateEnum enum to its proto representation.
+func RunBetaJobTerminalConditionStateEnumToProto(e *beta.JobTerminalConditionStateEnum) betapb.RunBetaJobTerminalConditionStateEnum {
+	if e == nil {
+		return betapb.RunBetaJobTerminalConditionStateEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobTerminalConditionStateEnum_value["JobTerminalConditionStateEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobTerminalConditionStateEnum(v)
+	}
+	return betapb.RunBetaJobTerminalConditionStateEnum(0)
+}
+
+// JobTerminalConditionSeverityEnumToProto converts a JobTerminalConditionSeverityEnum enum to its proto representation.
+func RunBetaJobTerminalConditionSeverityEnumToProto(e *beta.JobTerminalConditionSeverityEnum) betapb.RunBetaJobTerminalConditionSeverityEnum {
+	if e == nil {
+		return betapb.RunBetaJobTerminalConditionSeverityEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobTerminalConditionSeverityEnum_value["JobTerminalConditionSeverityEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobTerminalConditionSeverityEnum(v)
+	}
+	return betapb.RunBetaJobTerminalConditionSeverityEnum(0)
+}
+
+// JobTerminalConditionReasonEnumToProto converts a JobTerminalConditionReasonEnum enum to its proto representation.
+func RunBetaJobTerminalConditionReasonEnumToProto(e *beta.JobTerminalConditionReasonEnum) betapb.RunBetaJobTerminalConditionReasonEnum {
+	if e == nil {
+		return betapb.RunBetaJobTerminalConditionReasonEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobTerminalConditionReasonEnum_value["JobTerminalConditionReasonEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobTerminalConditionReasonEnum(v)
+	}
+	return betapb.RunBetaJobTerminalConditionReasonEnum(0)
+}
+
+// JobTerminalConditionInternalReasonEnumToProto converts a JobTerminalConditionInternalReasonEnum enum to its proto representation.
+func RunBetaJobTerminalConditionInternalReasonEnumToProto(e *beta.JobTerminalConditionInternalReasonEnum) betapb.RunBetaJobTerminalConditionInternalReasonEnum {
+	if e == nil {
+		return betapb.RunBetaJobTerminalConditionInternalReasonEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobTerminalConditionInternalReasonEnum_value["JobTerminalConditionInternalReasonEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobTerminalConditionInternalReasonEnum(v)
+	}
+	return betapb.RunBetaJobTerminalConditionInternalReasonEnum(0)
+}
+
+// JobTerminalConditionDomainMappingReasonEnumToProto converts a JobTerminalConditionDomainMappingReasonEnum enum to its proto representation.
+func RunBetaJobTerminalConditionDomainMappingReasonEnumToProto(e *beta.JobTerminalConditionDomainMappingReasonEnum) betapb.RunBetaJobTerminalConditionDomainMappingReasonEnum {
+	if e == nil {
+		return betapb.RunBetaJobTerminalConditionDomainMappingReasonEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobTerminalConditionDomainMappingReasonEnum_value["JobTerminalConditionDomainMappingReasonEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobTerminalConditionDomainMappingReasonEnum(v)
+	}
+	return betapb.RunBetaJobTerminalConditionDomainMappingReasonEnum(0)
+}
+
+// JobTerminalConditionRevisionReasonEnumToProto converts a JobTerminalConditionRevisionReasonEnum enum to its proto representation.
+func RunBetaJobTerminalConditionRevisionReasonEnumToProto(e *beta.JobTerminalConditionRevisionReasonEnum) betapb.RunBetaJobTerminalConditionRevisionReasonEnum {
+	if e == nil {
+		return betapb.RunBetaJobTerminalConditionRevisionReasonEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobTerminalConditionRevisionReasonEnum_value["JobTerminalConditionRevisionReasonEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobTerminalConditionRevisionReasonEnum(v)
+	}
+	return betapb.RunBetaJobTerminalConditionRevisionReasonEnum(0)
+}
+
+// JobTerminalConditionExecutionReasonEnumToProto converts a JobTerminalConditionExecutionReasonEnum enum to its proto representation.
+func RunBetaJobTerminalConditionExecutionReasonEnumToProto(e *beta.JobTerminalConditionExecutionReasonEnum) betapb.RunBetaJobTerminalConditionExecutionReasonEnum {
+	if e == nil {
+		return betapb.RunBetaJobTerminalConditionExecutionReasonEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobTerminalConditionExecutionReasonEnum_value["JobTerminalConditionExecutionReasonEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobTerminalConditionExecutionReasonEnum(v)
+	}
+	return betapb.RunBetaJobTerminalConditionExecutionReasonEnum(0)
+}
+
+// JobConditionsStateEnumToProto converts a JobConditionsStateEnum enum to its proto representation.
+func RunBetaJobConditionsStateEnumToProto(e *beta.JobConditionsStateEnum) betapb.RunBetaJobConditionsStateEnum {
+	if e == nil {
+		return betapb.RunBetaJobConditionsStateEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobConditionsStateEnum_value["JobConditionsStateEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobConditionsStateEnum(v)
+	}
+	return betapb.RunBetaJobConditionsStateEnum(0)
+}
+
+// JobConditionsSeverityEnumToProto converts a JobConditionsSeverityEnum enum to its proto representation.
+func RunBetaJobConditionsSeverityEnumToProto(e *beta.JobConditionsSeverityEnum) betapb.RunBetaJobConditionsSeverityEnum {
+	if e == nil {
+		return betapb.RunBetaJobConditionsSeverityEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobConditionsSeverityEnum_value["JobConditionsSeverityEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobConditionsSeverityEnum(v)
+	}
+	return betapb.RunBetaJobConditionsSeverityEnum(0)
+}
+
+// JobConditionsReasonEnumToProto converts a JobConditionsReasonEnum enum to its proto representation.
+func RunBetaJobConditionsReasonEnumToProto(e *beta.JobConditionsReasonEnum) betapb.RunBetaJobConditionsReasonEnum {
+	if e == nil {
+		return betapb.RunBetaJobConditionsReasonEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobConditionsReasonEnum_value["JobConditionsReasonEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobConditionsReasonEnum(v)
+	}
+	return betapb.RunBetaJobConditionsReasonEnum(0)
+}
+
+// JobConditionsRevisionReasonEnumToProto converts a JobConditionsRevisionReasonEnum enum to its proto representation.
+func RunBetaJobConditionsRevisionReasonEnumToProto(e *beta.JobConditionsRevisionReasonEnum) betapb.RunBetaJobConditionsRevisionReasonEnum {
+	if e == nil {
+		return betapb.RunBetaJobConditionsRevisionReasonEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobConditionsRevisionReasonEnum_value["JobConditionsRevisionReasonEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobConditionsRevisionReasonEnum(v)
+	}
+	return betapb.RunBetaJobConditionsRevisionReasonEnum(0)
+}
+
+// JobConditionsExecutionReasonEnumToProto converts a JobConditionsExecutionReasonEnum enum to its proto representation.
+func RunBetaJobConditionsExecutionReasonEnumToProto(e *beta.JobConditionsExecutionReasonEnum) betapb.RunBetaJobConditionsExecutionReasonEnum {
+	if e == nil {
+		return betapb.RunBetaJobConditionsExecutionReasonEnum(0)
+	}
+	if v, ok := betapb.RunBetaJobConditionsExecutionReasonEnum_value["JobConditionsExecutionReasonEnum"+string(*e)]; ok {
+		return betapb.RunBetaJobConditionsExecutionReasonEnum(v)
+	}
+	return betapb.RunBetaJobConditionsExecutionReasonEnum(0)
+}
+
+// JobBinaryAuthorizationToProto converts a JobBinaryAuthorization object to its proto representation.
+func RunBetaJobBinaryAuthorizationToProto(o *beta.JobBinaryAuthorization) *betapb.RunBetaJobBinaryAuthorization {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobBinaryAuthorization{}
+	p.SetUseDefault(dcl.ValueOrEmptyBool(o.UseDefault))
+	p.SetBreakglassJustification(dcl.ValueOrEmptyString(o.BreakglassJustification))
+	return p
+}
+
+// JobTemplateToProto converts a JobTemplate object to its proto representation.
+func RunBetaJobTemplateToProto(o *beta.JobTemplate) *betapb.RunBetaJobTemplate {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplate{}
+	p.SetParallelism(dcl.ValueOrEmptyInt64(o.Parallelism))
+	p.SetTaskCount(dcl.ValueOrEmptyInt64(o.TaskCount))
+	p.SetTemplate(RunBetaJobTemplateTemplateToProto(o.Template))
+	mLabels := make(map[string]string, len(o.Labels))
+	for k, r := range o.Labels {
+		mLabels[k] = r
+	}
+	p.SetLabels(mLabels)
+	mAnnotations := make(map[string]string, len(o.Annotations))
+	for k, r := range o.Annotations {
+		mAnnotations[k] = r
+	}
+	p.SetAnnotations(mAnnotations)
+	return p
+}
+
+// JobTemplateTemplateToProto converts a JobTemplateTemplate object to its proto representation.
+func RunBetaJobTemplateTemplateToProto(o *beta.JobTemplateTemplate) *betapb.RunBetaJobTemplateTemplate {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplate{}
+	p.SetMaxRetries(dcl.ValueOrEmptyInt64(o.MaxRetries))
+	p.SetTimeout(dcl.ValueOrEmptyString(o.Timeout))
+	p.SetServiceAccount(dcl.ValueOrEmptyString(o.ServiceAccount))
+	p.SetExecutionEnvironment(RunBetaJobTemplateTemplateExecutionEnvironmentEnumToProto(o.ExecutionEnvironment))
+	p.SetEncryptionKey(dcl.ValueOrEmptyString(o.EncryptionKey))
+	p.SetVpcAccess(RunBetaJobTemplateTemplateVPCAccessToProto(o.VPCAccess))
+	sContainers := make([]*betapb.RunBetaJobTemplateTemplateContainers, len(o.Containers))
+	for i, r := range o.Containers {
+		sContainers[i] = RunBetaJobTemplateTemplateContainersToProto(&r)
+	}
+	p.SetContainers(sContainers)
+	sVolumes := make([]*betapb.RunBetaJobTemplateTemplateVolumes, len(o.Volumes))
+	for i, r := range o.Volumes {
+		sVolumes[i] = RunBetaJobTemplateTemplateVolumesToProto(&r)
+	}
+	p.SetVolumes(sVolumes)
+	return p
+}
+
+// JobTemplateTemplateContainersToProto converts a JobTemplateTemplateContainers object to its proto representation.
+func RunBetaJobTemplateTemplateContainersToProto(o *beta.JobTemplateTemplateContainers) *betapb.RunBetaJobTemplateTemplateContainers {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplateContainers{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetImage(dcl.ValueOrEmptyString(o.Image))
+	p.SetResources(RunBetaJobTemplateTemplateContainersResourcesToProto(o.Resources))
+	sCommand := make([]string, len(o.Command))
+	for i, r := range o.Command {
+		sCommand[i] = r
+	}
+	p.SetCommand(sCommand)
+	sArgs := make([]string, len(o.Args))
+	for i, r := range o.Args {
+		sArgs[i] = r
+	}
+	p.SetArgs(sArgs)
+	sEnv := make([]*betapb.RunBetaJobTemplateTemplateContainersEnv, len(o.Env))
+	for i, r := range o.Env {
+		sEnv[i] = RunBetaJobTemplateTemplateContainersEnvToProto(&r)
+	}
+	p.SetEnv(sEnv)
+	sPorts := make([]*betapb.RunBetaJobTemplateTemplateContainersPorts, len(o.Ports))
+	for i, r := range o.Ports {
+		sPorts[i] = RunBetaJobTemplateTemplateContainersPortsToProto(&r)
+	}
+	p.SetPorts(sPorts)
+	sVolumeMounts := make([]*betapb.RunBetaJobTemplateTemplateContainersVolumeMounts, len(o.VolumeMounts))
+	for i, r := range o.VolumeMounts {
+		sVolumeMounts[i] = RunBetaJobTemplateTemplateContainersVolumeMountsToProto(&r)
+	}
+	p.SetVolumeMounts(sVolumeMounts)
+	return p
+}
+
+// JobTemplateTemplateContainersEnvToProto converts a JobTemplateTemplateContainersEnv object to its proto representation.
+func RunBetaJobTemplateTemplateContainersEnvToProto(o *beta.JobTemplateTemplateContainersEnv) *betapb.RunBetaJobTemplateTemplateContainersEnv {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplateContainersEnv{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetValue(dcl.ValueOrEmptyString(o.Value))
+	p.SetValueSource(RunBetaJobTemplateTemplateContainersEnvValueSourceToProto(o.ValueSource))
+	return p
+}
+
+// JobTemplateTemplateContainersEnvValueSourceToProto converts a JobTemplateTemplateContainersEnvValueSource object to its proto representation.
+func RunBetaJobTemplateTemplateContainersEnvValueSourceToProto(o *beta.JobTemplateTemplateContainersEnvValueSource) *betapb.RunBetaJobTemplateTemplateContainersEnvValueSource {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplateContainersEnvValueSource{}
+	p.SetSecretKeyRef(RunBetaJobTemplateTemplateContainersEnvValueSourceSecretKeyRefToProto(o.SecretKeyRef))
+	return p
+}
+
+// JobTemplateTemplateContainersEnvValueSourceSecretKeyRefToProto converts a JobTemplateTemplateContainersEnvValueSourceSecretKeyRef object to its proto representation.
+func RunBetaJobTemplateTemplateContainersEnvValueSourceSecretKeyRefToProto(o *beta.JobTemplateTemplateContainersEnvValueSourceSecretKeyRef) *betapb.RunBetaJobTemplateTemplateContainersEnvValueSourceSecretKeyRef {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplateContainersEnvValueSourceSecretKeyRef{}
+	p.SetSecret(dcl.ValueOrEmptyString(o.Secret))
+	p.SetVersion(dcl.ValueOrEmptyString(o.Version))
+	return p
+}
+
+// JobTemplateTemplateContainersResourcesToProto converts a JobTemplateTemplateContainersResources object to its proto representation.
+func RunBetaJobTemplateTemplateContainersResourcesToProto(o *beta.JobTemplateTemplateContainersResources) *betapb.RunBetaJobTemplateTemplateContainersResources {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplateContainersResources{}
+	p.SetCpuIdle(dcl.ValueOrEmptyBool(o.CpuIdle))
+	mLimits := make(map[string]string, len(o.Limits))
+	for k, r := range o.Limits {
+		mLimits[k] = r
+	}
+	p.SetLimits(mLimits)
+	return p
+}
+
+// JobTemplateTemplateContainersPortsToProto converts a JobTemplateTemplateContainersPorts object to its proto representation.
+func RunBetaJobTemplateTemplateContainersPortsToProto(o *beta.JobTemplateTemplateContainersPorts) *betapb.RunBetaJobTemplateTemplateContainersPorts {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplateContainersPorts{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetContainerPort(dcl.ValueOrEmptyInt64(o.ContainerPort))
+	return p
+}
+
+// JobTemplateTemplateContainersVolumeMountsToProto converts a JobTemplateTemplateContainersVolumeMounts object to its proto representation.
+func RunBetaJobTemplateTemplateContainersVolumeMountsToProto(o *beta.JobTemplateTemplateContainersVolumeMounts) *betapb.RunBetaJobTemplateTemplateContainersVolumeMounts {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplateContainersVolumeMounts{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetMountPath(dcl.ValueOrEmptyString(o.MountPath))
+	return p
+}
+
+// JobTemplateTemplateVolumesToProto converts a JobTemplateTemplateVolumes object to its proto representation.
+func RunBetaJobTemplateTemplateVolumesToProto(o *beta.JobTemplateTemplateVolumes) *betapb.RunBetaJobTemplateTemplateVolumes {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplateVolumes{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetSecret(RunBetaJobTemplateTemplateVolumesSecretToProto(o.Secret))
+	p.SetCloudSqlInstance(RunBetaJobTemplateTemplateVolumesCloudSqlInstanceToProto(o.CloudSqlInstance))
+	return p
+}
+
+// JobTemplateTemplateVolumesSecretToProto converts a JobTemplateTemplateVolumesSecret object to its proto representation.
+func RunBetaJobTemplateTemplateVolumesSecretToProto(o *beta.JobTemplateTemplateVolumesSecret) *betapb.RunBetaJobTemplateTemplateVolumesSecret {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplateVolumesSecret{}
+	p.SetSecret(dcl.ValueOrEmptyString(o.Secret))
+	p.SetDefaultMode(dcl.ValueOrEmptyInt64(o.DefaultMode))
+	sItems := make([]*betapb.RunBetaJobTemplateTemplateVolumesSecretItems, len(o.Items))
+	for i, r := range o.Items {
+		sItems[i] = RunBetaJobTemplateTemplateVolumesSecretItemsToProto(&r)
+	}
+	p.SetItems(sItems)
+	return p
+}
+
+// JobTemplateTemplateVolumesSecretItemsToProto converts a JobTemplateTemplateVolumesSecretItems object to its proto representation.
+func RunBetaJobTemplateTemplateVolumesSecretItemsToProto(o *beta.JobTemplateTemplateVolumesSecretItems) *betapb.RunBetaJobTemplateTemplateVolumesSecretItems {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplateVolumesSecretItems{}
+	p.SetPath(dcl.ValueOrEmptyString(o.Path))
+	p.SetVersion(dcl.ValueOrEmptyString(o.Version))
+	p.SetMode(dcl.ValueOrEmptyInt64(o.Mode))
+	return p
+}
+
+// JobTemplateTemplateVolumesCloudSqlInstanceToProto converts a JobTemplateTemplateVolumesCloudSqlInstance object to its proto representation.
+func RunBetaJobTemplateTemplateVolumesCloudSqlInstanceToProto(o *beta.JobTemplateTemplateVolumesCloudSqlInstance) *betapb.RunBetaJobTemplateTemplateVolumesCloudSqlInstance {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplateVolumesCloudSqlInstance{}
+	sInstances := make([]string, len(o.Instances))
+	for i, r := range o.Instances {
+		sInstances[i] = r
+	}
+	p.SetInstances(sInstances)
+	return p
+}
+
+// JobTemplateTemplateVPCAccessToProto converts a JobTemplateTemplateVPCAccess object to its proto representation.
+func RunBetaJobTemplateTemplateVPCAccessToProto(o *beta.JobTemplateTemplateVPCAccess) *betapb.RunBetaJobTemplateTemplateVPCAccess {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTemplateTemplateVPCAccess{}
+	p.SetConnector(dcl.ValueOrEmptyString(o.Connector))
+	p.SetEgress(RunBetaJobTemplateTemplateVPCAccessEgressEnumToProto(o.Egress))
+	return p
+}
+
+// JobTerminalConditionToProto converts a JobTerminalCondition object to its proto representation.
+func RunBetaJobTerminalConditionToProto(o *beta.JobTerminalCondition) *betapb.RunBetaJobTerminalCondition {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobTerminalCondition{}
+	p.SetType(dcl.ValueOrEmptyString(o.Type))
+	p.SetState(RunBetaJobTerminalConditionStateEnumToProto(o.State))
+	p.SetMessage(dcl.ValueOrEmptyString(o.Message))
+	p.SetLastTransitionTime(dcl.ValueOrEmptyString(o.LastTransitionTime))
+	p.SetSeverity(RunBetaJobTerminalConditionSeverityEnumToProto(o.Severity))
+	p.SetReason(RunBetaJobTerminalConditionReasonEnumToProto(o.Reason))
+	p.SetInternalReason(RunBetaJobTerminalConditionInternalReasonEnumToProto(o.InternalReason))
+	p.SetDomainMappingReason(RunBetaJobTerminalConditionDomainMappingReasonEnumToProto(o.DomainMappingReason))
+	p.SetRevisionReason(RunBetaJobTerminalConditionRevisionReasonEnumToProto(o.RevisionReason))
+	p.SetExecutionReason(RunBetaJobTerminalConditionExecutionReasonEnumToProto(o.ExecutionReason))
+	return p
+}
+
+// JobConditionsToProto converts a JobConditions object to its proto representation.
+func RunBetaJobConditionsToProto(o *beta.JobConditions) *betapb.RunBetaJobConditions {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobConditions{}
+	p.SetType(dcl.ValueOrEmptyString(o.Type))
+	p.SetState(RunBetaJobConditionsStateEnumToProto(o.State))
+	p.SetMessage(dcl.ValueOrEmptyString(o.Message))
+	p.SetLastTransitionTime(dcl.ValueOrEmptyString(o.LastTransitionTime))
+	p.SetSeverity(RunBetaJobConditionsSeverityEnumToProto(o.Severity))
+	p.SetReason(RunBetaJobConditionsReasonEnumToProto(o.Reason))
+	p.SetRevisionReason(RunBetaJobConditionsRevisionReasonEnumToProto(o.RevisionReason))
+	p.SetExecutionReason(RunBetaJobConditionsExecutionReasonEnumToProto(o.ExecutionReason))
+	return p
+}
+
+// JobLatestSucceededExecutionToProto converts a JobLatestSucceededExecution object to its proto representation.
+func RunBetaJobLatestSucceededExecutionToProto(o *beta.JobLatestSucceededExecution) *betapb.RunBetaJobLatestSucceededExecution {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobLatestSucceededExecution{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetCreateTime(dcl.ValueOrEmptyString(o.CreateTime))
+	return p
+}
+
+// JobLatestCreatedExecutionToProto converts a JobLatestCreatedExecution object to its proto representation.
+func RunBetaJobLatestCreatedExecutionToProto(o *beta.JobLatestCreatedExecution) *betapb.RunBetaJobLatestCreatedExecution {
+	if o == nil {
+		return nil
+	}
+	p := &betapb.RunBetaJobLatestCreatedExecution{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetCreateTime(dcl.ValueOrEmptyString(o.CreateTime))
+	return p
+}
+
+// JobToProto converts a Job resource to its proto representation.
+func JobToProto(resource *beta.Job) *betapb.RunBetaJob {
+	p := &betapb.RunBetaJob{}
+	p.SetName(dcl.ValueOrEmptyString(resource.Name))
+	p.SetUid(dcl.ValueOrEmptyString(resource.Uid))
+	p.SetGeneration(dcl.ValueOrEmptyInt64(resource.Generation))
+	p.SetCreateTime(dcl.ValueOrEmptyString(resource.CreateTime))
+	p.SetUpdateTime(dcl.ValueOrEmptyString(resource.UpdateTime))
+	p.SetDeleteTime(dcl.ValueOrEmptyString(resource.DeleteTime))
+	p.SetExpireTime(dcl.ValueOrEmptyString(resource.ExpireTime))
+	p.SetCreator(dcl.ValueOrEmptyString(resource.Creator))
+	p.SetLastModifier(dcl.ValueOrEmptyString(resource.LastModifier))
+	p.SetClient(dcl.ValueOrEmptyString(resource.Client))
+	p.SetClientVersion(dcl.ValueOrEmptyString(resource.ClientVersion))
+	p.SetLaunchStage(RunBetaJobLaunchStageEnumToProto(resource.LaunchStage))
+	p.SetBinaryAuthorization(RunBetaJobBinaryAuthorizationToProto(resource.BinaryAuthorization))
+	p.SetTemplate(RunBetaJobTemplateToProto(resource.Template))
+	p.SetObservedGeneration(dcl.ValueOrEmptyInt64(resource.ObservedGeneration))
+	p.SetTerminalCondition(RunBetaJobTerminalConditionToProto(resource.TerminalCondition))
+	p.SetExecutionCount(dcl.ValueOrEmptyInt64(resource.ExecutionCount))
+	p.SetLatestSucceededExecution(RunBetaJobLatestSucceededExecutionToProto(resource.LatestSucceededExecution))
+	p.SetLatestCreatedExecution(RunBetaJobLatestCreatedExecutionToProto(resource.LatestCreatedExecution))
+	p.SetReconciling(dcl.ValueOrEmptyBool(resource.Reconciling))
+	p.SetEtag(dcl.ValueOrEmptyString(resource.Etag))
+	p.SetProject(dcl.ValueOrEmptyString(resource.Project))
+	p.SetLocation(dcl.ValueOrEmptyString(resource.Location))
+	mLabels := make(map[string]string, len(resource.Labels))
+	for k, r := range resource.Labels {
+		mLabels[k] = r
+	}
+	p.SetLabels(mLabels)
+	mAnnotations := make(map[string]string, len(resource.Annotations))
+	for k, r := range resource.Annotations {
+		mAnnotations[k] = r
+	}
+	p.SetAnnotations(mAnnotations)
+	sConditions := make([]*betapb.RunBetaJobConditions, len(resource.Conditions))
+	for i, r := range resource.Conditions {
+		sConditions[i] = RunBetaJobConditionsToProto(&r)
+	}
+	p.SetConditions(sConditions)
+
+	return p
+}
+
+// applyJob handles the gRPC request by passing it to the underlying Job Apply() method.
+func (s *JobServer) applyJob(ctx context.Context, c *beta.Client, request *betapb.ApplyRunBetaJobRequest) (*betapb.RunBetaJob, error) {
+	p := ProtoToJob(request.GetResource())
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyJob(ctx, p, opts...)
+	if err != nil {
+		return nil, err
+	}
+	r := JobToProto(res)
+	return r, nil
+}
+
+// applyRunBetaJob handles the gRPC request by passing it to the underlying Job Apply() method.
+func (s *JobServer) ApplyRunBetaJob(ctx context.Context, request *betapb.ApplyRunBetaJobRequest) (*betapb.RunBetaJob, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	return s.applyJob(ctx, cl, request)
+}
+
+// GetRunBetaJob handles the gRPC request by passing it to the underlying Job Get() method.
+func (s *JobServer) GetRunBetaJob(ctx context.Context, request *betapb.GetRunBetaJobRequest) (*betapb.RunBetaJob, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetJob(ctx, ProtoToJob(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return JobToProto(res), nil
+}
+
+// HasDiffRunBetaJob handles the gRPC request by passing it to the underlying Job Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *JobServer) HasDiffRunBetaJob(ctx context.Context, request *betapb.HasDiffRunBetaJobRequest) (*betapb.HasDiffRunBetaJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJob(ctx, ProtoToJob(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffRunBetaJobResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanRunBetaJob handles the gRPC request by passing it to the underlying Job Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *JobServer) PlanRunBetaJob(ctx context.Context, request *betapb.PlanRunBetaJobRequest) (*betapb.PlanRunBetaJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJob(ctx, ProtoToJob(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanRunBetaJobResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
+// DeleteJob handles the gRPC request by passing it to the underlying Job Delete() method.
+func (s *JobServer) DeleteRunBetaJob(ctx context.Context, request *betapb.DeleteRunBetaJobRequest) (*emptypb.Empty, error) {
+
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, cl.DeleteJob(ctx, ProtoToJob(request.GetResource()))
+
+}
+
+// ListRunBetaJob handles the gRPC request by passing it to the underlying JobList() method.
+func (s *JobServer) ListRunBetaJob(ctx context.Context, request *betapb.ListRunBetaJobRequest) (*betapb.ListRunBetaJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.JobMaxPage
+	}
+	resources, err := cl.ListJobWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	var protos []*betapb.RunBetaJob
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, JobToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	p := &betapb.ListRunBetaJobResponse{}
+	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
+	return p, nil
+}
+
+func createConfigJob(ctx context.Context, service_account_file string) (*beta.Client, error) {
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
+	return beta.NewClient(conf), nil
+}
@@ -303,7 +303,6 @@ class ServiceTemplate(object):
         service_account: str = None,
         containers: list = None,
         volumes: list = None,
-        confidential: bool = None,
         execution_environment: str = None,
     ):
         self.revision = revision
@@ -316,7 +315,6 @@ class ServiceTemplate(object):
         self.service_account = service_account
         self.containers = containers
         self.volumes = volumes
-        self.confidential = confidential
         self.execution_environment = execution_environment
 
     @classmethod
@@ -355,13 +353,13 @@ class ServiceTemplate(object):
             )
         if ServiceTemplateVolumesArray.to_proto(resource.volumes):
             res.volumes.extend(ServiceTemplateVolumesArray.to_proto(resource.volumes))
-        if Primitive.to_proto(resource.confidential):
-            res.confidential = Primitive.to_proto(resource.confidential)
         if ServiceTemplateExecutionEnvironmentEnum.to_proto(
             resource.execution_environment
         ):
-            res.execution_environment = ServiceTemplateExecutionEnvironmentEnum.to_proto(
-                resource.execution_environment
+            res.execution_environment = (
+                ServiceTemplateExecutionEnvironmentEnum.to_proto(
+                    resource.execution_environment
+                )
             )
         return res
 
@@ -381,7 +379,6 @@ class ServiceTemplate(object):
             service_account=Primitive.from_proto(resource.service_account),
             containers=ServiceTemplateContainersArray.from_proto(resource.containers),
             volumes=ServiceTemplateVolumesArray.from_proto(resource.volumes),
-            confidential=Primitive.from_proto(resource.confidential),
             execution_environment=ServiceTemplateExecutionEnvironmentEnum.from_proto(
                 resource.execution_environment
             ),
@@ -979,8 +976,8 @@ class ServiceTemplateVolumesSecretItemsArray(object):
 
 
 class ServiceTemplateVolumesCloudSqlInstance(object):
-    def __init__(self, connections: list = None):
-        self.connections = connections
+    def __init__(self, instances: list = None):
+        self.instances = instances
 
     @classmethod
     def to_proto(self, resource):
@@ -988,8 +985,8 @@ class ServiceTemplateVolumesCloudSqlInstance(object):
             return None
 
         res = service_pb2.RunBetaServiceTemplateVolumesCloudSqlInstance()
-        if Primitive.to_proto(resource.connections):
-            res.connections.extend(Primitive.to_proto(resource.connections))
+        if Primitive.to_proto(resource.instances):
+            res.instances.extend(Primitive.to_proto(resource.instances))
         return res
 
     @classmethod
@@ -998,7 +995,7 @@ class ServiceTemplateVolumesCloudSqlInstance(object):
             return None
 
         return ServiceTemplateVolumesCloudSqlInstance(
-            connections=Primitive.from_proto(resource.connections),
+            instances=Primitive.from_proto(resource.instances),
         )
 
 
@@ -1077,8 +1074,6 @@ class ServiceTerminalCondition(object):
         last_transition_time: str = None,
         severity: str = None,
         reason: str = None,
-        internal_reason: str = None,
-        domain_mapping_reason: str = None,
         revision_reason: str = None,
         job_reason: str = None,
     ):
@@ -1088,8 +1083,6 @@ class ServiceTerminalCondition(object):
         self.last_transition_time = last_transition_time
         self.severity = severity
         self.reason = reason
-        self.internal_reason = internal_reason
-        self.domain_mapping_reason = domain_mapping_reason
         self.revision_reason = revision_reason
         self.job_reason = job_reason
 
@@ -1113,18 +1106,6 @@ class ServiceTerminalCondition(object):
             )
         if ServiceTerminalConditionReasonEnum.to_proto(resource.reason):
             res.reason = ServiceTerminalConditionReasonEnum.to_proto(resource.reason)
-        if ServiceTerminalConditionInternalReasonEnum.to_proto(
-            resource.internal_reason
-        ):
-            res.internal_reason = ServiceTerminalConditionInternalReasonEnum.to_proto(
-                resource.internal_reason
-            )
-        if ServiceTerminalConditionDomainMappingReasonEnum.to_proto(
-            resource.domain_mapping_reason
-        ):
-            res.domain_mapping_reason = ServiceTerminalConditionDomainMappingReasonEnum.to_proto(
-                resource.domain_mapping_reason
-            )
         if ServiceTerminalConditionRevisionReasonEnum.to_proto(
             resource.revision_reason
         ):
@@ -1149,12 +1130,6 @@ class ServiceTerminalCondition(object):
             last_transition_time=Primitive.from_proto(resource.last_transition_time),
             severity=ServiceTerminalConditionSeverityEnum.from_proto(resource.severity),
             reason=ServiceTerminalConditionReasonEnum.from_proto(resource.reason),
-            internal_reason=ServiceTerminalConditionInternalReasonEnum.from_proto(
-                resource.internal_reason
-            ),
-            domain_mapping_reason=ServiceTerminalConditionDomainMappingReasonEnum.from_proto(
-                resource.domain_mapping_reason
-            ),
             revision_reason=ServiceTerminalConditionRevisionReasonEnum.from_proto(
                 resource.revision_reason
             ),
@@ -1379,42 +1354,6 @@ class ServiceTerminalConditionReasonEnum(object):
         ]
 
 
-class ServiceTerminalConditionInternalReasonEnum(object):
-    @classmethod
-    def to_proto(self, resource):
-        if not resource:
-            return resource
-        return service_pb2.RunBetaServiceTerminalConditionInternalReasonEnum.Value(
-            "RunBetaServiceTerminalConditionInternalReasonEnum%s" % resource
-        )
-
-    @classmethod
-    def from_proto(self, resource):
-        if not resource:
-            return resource
-        return service_pb2.RunBetaServiceTerminalConditionInternalReasonEnum.Name(
-            resource
-        )[len("RunBetaServiceTerminalConditionInternalReasonEnum") :]
-
-
-class ServiceTerminalConditionDomainMappingReasonEnum(object):
-    @classmethod
-    def to_proto(self, resource):
-        if not resource:
-            return resource
-        return service_pb2.RunBetaServiceTerminalConditionDomainMappingReasonEnum.Value(
-            "RunBetaServiceTerminalConditionDomainMappingReasonEnum%s" % resource
-        )
-
-    @classmethod
-    def from_proto(self, resource):
-        if not resource:
-            return resource
-        return service_pb2.RunBetaServiceTerminalConditionDomainMappingReasonEnum.Name(
-            resource
-        )[len("RunBetaServiceTerminalConditionDomainMappingReasonEnum") :]
-
-
 class ServiceTerminalConditionRevisionReasonEnum(object):
     @classmethod
     def to_proto(self, resource):
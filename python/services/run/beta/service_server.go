@@ -16,12 +16,13 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/run/beta/run_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/run/beta"
 )
 
-// Server implements the gRPC interface for Service.
+// ServiceServer implements the gRPC interface for Service.
 type ServiceServer struct{}
 
 // ProtoToServiceIngressEnum converts a ServiceIngressEnum enum from its proto representation.
@@ -120,30 +121,6 @@ func ProtoToRunBetaServiceTerminalConditionReasonEnum(e betapb.RunBetaServiceTer
 	return nil
 }
 
-// ProtoToServiceTerminalConditionInternalReasonEnum converts a ServiceTerminalConditionInternalReasonEnum enum from its proto representation.
-func ProtoToRunBetaServiceTerminalConditionInternalReasonEnum(e betapb.RunBetaServiceTerminalConditionInternalReasonEnum) *beta.ServiceTerminalConditionInternalReasonEnum {
-	if e == 0 {
-		return nil
-	}
-	if n, ok := betapb.RunBetaServiceTerminalConditionInternalReasonEnum_name[int32(e)]; ok {
-		e := beta.ServiceTerminalConditionInternalReasonEnum(n[len("RunBetaServiceTerminalConditionInternalReasonEnum"):])
-		return &e
-	}
-	return nil
-}
-
-// ProtoToServiceTerminalConditionDomainMappingReasonEnum converts a ServiceTerminalConditionDomainMappingReasonEnum enum from its proto representation.
-func ProtoToRunBetaServiceTerminalConditionDomainMappingReasonEnum(e betapb.RunBetaServiceTerminalConditionDomainMappingReasonEnum) *beta.ServiceTerminalConditionDomainMappingReasonEnum {
-	if e == 0 {
-		return nil
-	}
-	if n, ok := betapb.RunBetaServiceTerminalConditionDomainMappingReasonEnum_name[int32(e)]; ok {
-		e := beta.ServiceTerminalConditionDomainMappingReasonEnum(n[len("RunBetaServiceTerminalConditionDomainMappingReasonEnum"):])
-		return &e
-	}
-	return nil
-}
-
 // ProtoToServiceTerminalConditionRevisionReasonEnum converts a ServiceTerminalConditionRevisionReasonEnum enum from its proto representation.
 func ProtoToRunBetaServiceTerminalConditionRevisionReasonEnum(e betapb.RunBetaServiceTerminalConditionRevisionReasonEnum) *beta.ServiceTerminalConditionRevisionReasonEnum {
 	if e == 0 {
@@ -204,7 +181,6 @@ func ProtoToRunBetaServiceTemplate(p *betapb.RunBetaServiceTemplate) *beta.Servi
 		ContainerConcurrency: dcl.Int64OrNil(p.GetContainerConcurrency()),
 		Timeout:              dcl.StringOrNil(p.GetTimeout()),
 		ServiceAccount:       dcl.StringOrNil(p.GetServiceAccount()),
-		Confidential:         dcl.Bool(p.GetConfidential()),
 		ExecutionEnvironment: ProtoToRunBetaServiceTemplateExecutionEnvironmentEnum(p.GetExecutionEnvironment()),
 	}
 	for _, r := range p.GetContainers() {
@@ -386,8 +362,8 @@ func ProtoToRunBetaServiceTemplateVolumesCloudSqlInstance(p *betapb.RunBetaServi
 		return nil
 	}
 	obj := &beta.ServiceTemplateVolumesCloudSqlInstance{}
-	for _, r := range p.GetConnections() {
-		obj.Connections = append(obj.Connections, r)
+	for _, r := range p.GetInstances() {
+		obj.Instances = append(obj.Instances, r)
 	}
 	return obj
 }
@@ -412,16 +388,14 @@ func ProtoToRunBetaServiceTerminalCondition(p *betapb.RunBetaServiceTerminalCond
 		return nil
 	}
 	obj := &beta.ServiceTerminalCondition{
-		Type:                dcl.StringOrNil(p.GetType()),
-		State:               ProtoToRunBetaServiceTerminalConditionStateEnum(p.GetState()),
-		Message:             dcl.StringOrNil(p.GetMessage()),
-		LastTransitionTime:  dcl.StringOrNil(p.GetLastTransitionTime()),
-		Severity:            ProtoToRunBetaServiceTerminalConditionSeverityEnum(p.GetSeverity()),
-		Reason:              ProtoToRunBetaServiceTerminalConditionReasonEnum(p.GetReason()),
-		InternalReason:      ProtoToRunBetaServiceTerminalConditionInternalReasonEnum(p.GetInternalReason()),
-		DomainMappingReason: ProtoToRunBetaServiceTerminalConditionDomainMappingReasonEnum(p.GetDomainMappingReason()),
-		RevisionReason:      ProtoToRunBetaServiceTerminalConditionRevisionReasonEnum(p.GetRevisionReason()),
-		JobReason:           ProtoToRunBetaServiceTerminalConditionJobReasonEnum(p.GetJobReason()),
+		Type:               dcl.StringOrNil(p.GetType()),
+		State:              ProtoToRunBetaServiceTerminalConditionStateEnum(p.GetState()),
+		Message:            dcl.StringOrNil(p.GetMessage()),
+		LastTransitionTime: dcl.StringOrNil(p.GetLastTransitionTime()),
+		Severity:           ProtoToRunBetaServiceTerminalConditionSeverityEnum(p.GetSeverity()),
+		Reason:             ProtoToRunBetaServiceTerminalConditionReasonEnum(p.GetReason()),
+		RevisionReason:     ProtoToRunBetaServiceTerminalConditionRevisionReasonEnum(p.GetRevisionReason()),
+		JobReason:          ProtoToRunBetaServiceTerminalConditionJobReasonEnum(p.GetJobReason()),
 	}
 	return obj
 }
@@ -566,28 +540,6 @@ func RunBetaServiceTerminalConditionReasonEnumToProto(e *beta.ServiceTerminalCon
 	return betapb.RunBetaServiceTerminalConditionReasonEnum(0)
 }
 
-// ServiceTerminalConditionInternalReasonEnumToProto converts a ServiceTerminalConditionInternalReasonEnum enum to its proto representation.
-func RunBetaServiceTerminalConditionInternalReasonEnumToProto(e *beta.ServiceTerminalConditionInternalReasonEnum) betapb.RunBetaServiceTerminalConditionInternalReasonEnum {
-	if e == nil {
-		return betapb.RunBetaServiceTerminalConditionInternalReasonEnum(0)
-	}
-	if v, ok := betapb.RunBetaServiceTerminalConditionInternalReasonEnum_value["ServiceTerminalConditionInternalReasonEnum"+string(*e)]; ok {
-		return betapb.RunBetaServiceTerminalConditionInternalReasonEnum(v)
-	}
-	return betapb.RunBetaServiceTerminalConditionInternalReasonEnum(0)
-}
-
-// ServiceTerminalConditionDomainMappingReasonEnumToProto converts a ServiceTerminalConditionDomainMappingReasonEnum enum to its proto representation.
-func RunBetaServiceTerminalConditionDomainMappingReasonEnumToProto(e *beta.ServiceTerminalConditionDomainMappingReasonEnum) betapb.RunBetaServiceTerminalConditionDomainMappingReasonEnum {
-	if e == nil {
-		return betapb.RunBetaServiceTerminalConditionDomainMappingReasonEnum(0)
-	}
-	if v, ok := betapb.RunBetaServiceTerminalConditionDomainMappingReasonEnum_value["ServiceTerminalConditionDomainMappingReasonEnum"+string(*e)]; ok {
-		return betapb.RunBetaServiceTerminalConditionDomainMappingReasonEnum(v)
-	}
-	return betapb.RunBetaServiceTerminalConditionDomainMappingReasonEnum(0)
-}
-
 // ServiceTerminalConditionRevisionReasonEnumToProto converts a ServiceTerminalConditionRevisionReasonEnum enum to its proto representation.
 func RunBetaServiceTerminalConditionRevisionReasonEnumToProto(e *beta.ServiceTerminalConditionRevisionReasonEnum) betapb.RunBetaServiceTerminalConditionRevisionReasonEnum {
 	if e == nil {
@@ -644,7 +596,6 @@ func RunBetaServiceTemplateToProto(o *beta.ServiceTemplate) *betapb.RunBetaServi
 	p.SetContainerConcurrency(dcl.ValueOrEmptyInt64(o.ContainerConcurrency))
 	p.SetTimeout(dcl.ValueOrEmptyString(o.Timeout))
 	p.SetServiceAccount(dcl.ValueOrEmptyString(o.ServiceAccount))
-	p.SetConfidential(dcl.ValueOrEmptyBool(o.Confidential))
 	p.SetExecutionEnvironment(RunBetaServiceTemplateExecutionEnvironmentEnumToProto(o.ExecutionEnvironment))
 	mLabels := make(map[string]string, len(o.Labels))
 	for k, r := range o.Labels {
@@ -844,11 +795,11 @@ func RunBetaServiceTemplateVolumesCloudSqlInstanceToProto(o *beta.ServiceTemplat
 		return nil
 	}
 	p := &betapb.RunBetaServiceTemplateVolumesCloudSqlInstance{}
-	sConnections := make([]string, len(o.Connections))
-	for i, r := range o.Connections {
-		sConnections[i] = r
+	sInstances := make([]string, len(o.Instances))
+	for i, r := range o.Instances {
+		sInstances[i] = r
 	}
-	p.SetConnections(sConnections)
+	p.SetInstances(sInstances)
 	return p
 }
 
@@ -877,8 +828,6 @@ func RunBetaServiceTerminalConditionToProto(o *beta.ServiceTerminalCondition) *b
 	p.SetLastTransitionTime(dcl.ValueOrEmptyString(o.LastTransitionTime))
 	p.SetSeverity(RunBetaServiceTerminalConditionSeverityEnumToProto(o.Severity))
 	p.SetReason(RunBetaServiceTerminalConditionReasonEnumToProto(o.Reason))
-	p.SetInternalReason(RunBetaServiceTerminalConditionInternalReasonEnumToProto(o.InternalReason))
-	p.SetDomainMappingReason(RunBetaServiceTerminalConditionDomainMappingReasonEnumToProto(o.DomainMappingReason))
 	p.SetRevisionReason(RunBetaServiceTerminalConditionRevisionReasonEnumToProto(o.RevisionReason))
 	p.SetJobReason(RunBetaServiceTerminalConditionJobReasonEnumToProto(o.JobReason))
 	return p
@@ -952,7 +901,11 @@ func ServiceToProto(resource *beta.Service) *betapb.RunBetaService {
 // applyService handles the gRPC request by passing it to the underlying Service Apply() method.
 func (s *ServiceServer) applyService(ctx context.Context, c *beta.Client, request *betapb.ApplyRunBetaServiceRequest) (*betapb.RunBetaService, error) {
 	p := ProtoToService(request.GetResource())
-	res, err := c.ApplyService(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyService(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -969,6 +922,60 @@ func (s *ServiceServer) ApplyRunBetaService(ctx context.Context, request *betapb
 	return s.applyService(ctx, cl, request)
 }
 
+// GetRunBetaService handles the gRPC request by passing it to the underlying Service Get() method.
+func (s *ServiceServer) GetRunBetaService(ctx context.Context, request *betapb.GetRunBetaServiceRequest) (*betapb.RunBetaService, error) {
+	cl, err := createConfigService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetService(ctx, ProtoToService(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceToProto(res), nil
+}
+
+// HasDiffRunBetaService handles the gRPC request by passing it to the underlying Service Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceServer) HasDiffRunBetaService(ctx context.Context, request *betapb.HasDiffRunBetaServiceRequest) (*betapb.HasDiffRunBetaServiceResponse, error) {
+	cl, err := createConfigService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffService(ctx, ProtoToService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffRunBetaServiceResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanRunBetaService handles the gRPC request by passing it to the underlying Service Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceServer) PlanRunBetaService(ctx context.Context, request *betapb.PlanRunBetaServiceRequest) (*betapb.PlanRunBetaServiceResponse, error) {
+	cl, err := createConfigService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffService(ctx, ProtoToService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanRunBetaServiceResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteService handles the gRPC request by passing it to the underlying Service Delete() method.
 func (s *ServiceServer) DeleteRunBetaService(ctx context.Context, request *betapb.DeleteRunBetaServiceRequest) (*emptypb.Empty, error) {
 
@@ -987,22 +994,35 @@ func (s *ServiceServer) ListRunBetaService(ctx context.Context, request *betapb.
 		return nil, err
 	}
 
-	resources, err := cl.ListService(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ServiceMaxPage
+	}
+	resources, err := cl.ListServiceWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.RunBetaService
-	for _, r := range resources.Items {
-		rp := ServiceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListRunBetaServiceResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigService(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
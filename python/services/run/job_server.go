@@ -0,0 +1,1139 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package server
+
+import (
+	"context"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
+	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
+	runpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/run/run_go_proto"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/run"
+)
+
+// JobServer implements the gRPC interface for Job.
+type JobServer struct{}
+
+// ProtoToJobLaunchStageEnum converts a JobLaunchStageEnum enum from its proto representation.
+func ProtoToRunJobLaunchStageEnum(e runpb.RunJobLaunchStageEnum) *run.JobLaunchStageEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobLaunchStageEnum_name[int32(e)]; ok {
+		e := run.JobLaunchStageEnum(n[len("RunJobLaunchStageEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTemplateTemplateExecutionEnvironmentEnum converts a JobTemplateTemplateExecutionEnvironmentEnum enum from its proto representation.
+func ProtoToRunJobTemplateTemplateExecutionEnvironmentEnum(e runpb.RunJobTemplateTemplateExecutionEnvironmentEnum) *run.JobTemplateTemplateExecutionEnvironmentEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobTemplateTemplateExecutionEnvironmentEnum_name[int32(e)]; ok {
+		e := run.JobTemplateTemplateExecutionEnvironmentEnum(n[len("RunJobTemplateTemplateExecutionEnvironmentEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTemplateTemplateVPCAccessEgressEnum converts a JobTemplateTemplateVPCAccessEgressEnum enum from its proto representation.
+func ProtoToRunJobTemplateTemplateVPCAccessEgressEnum(e runpb.RunJobTemplateTemplateVPCAccessEgressEnum) *run.JobTemplateTemplateVPCAccessEgressEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobTemplateTemplateVPCAccessEgressEnum_name[int32(e)]; ok {
+		e := run.JobTemplateTemplateVPCAccessEgressEnum(n[len("RunJobTemplateTemplateVPCAccessEgressEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionStateEnum converts a JobTerminalConditionStateEnum enum from its proto representation.
+func ProtoToRunJobTerminalConditionStateEnum(e runpb.RunJobTerminalConditionStateEnum) *run.JobTerminalConditionStateEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobTerminalConditionStateEnum_name[int32(e)]; ok {
+		e := run.JobTerminalConditionStateEnum(n[len("RunJobTerminalConditionStateEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionSeverityEnum converts a JobTerminalConditionSeverityEnum enum from its proto representation.
+func ProtoToRunJobTerminalConditionSeverityEnum(e runpb.RunJobTerminalConditionSeverityEnum) *run.JobTerminalConditionSeverityEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobTerminalConditionSeverityEnum_name[int32(e)]; ok {
+		e := run.JobTerminalConditionSeverityEnum(n[len("RunJobTerminalConditionSeverityEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionReasonEnum converts a JobTerminalConditionReasonEnum enum from its proto representation.
+func ProtoToRunJobTerminalConditionReasonEnum(e runpb.RunJobTerminalConditionReasonEnum) *run.JobTerminalConditionReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobTerminalConditionReasonEnum_name[int32(e)]; ok {
+		e := run.JobTerminalConditionReasonEnum(n[len("RunJobTerminalConditionReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionInternalReasonEnum converts a JobTerminalConditionInternalReasonEnum enum from its proto representation.
+func ProtoToRunJobTerminalConditionInternalReasonEnum(e runpb.RunJobTerminalConditionInternalReasonEnum) *run.JobTerminalConditionInternalReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobTerminalConditionInternalReasonEnum_name[int32(e)]; ok {
+		e := run.JobTerminalConditionInternalReasonEnum(n[len("RunJobTerminalConditionInternalReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionDomainMappingReasonEnum converts a JobTerminalConditionDomainMappingReasonEnum enum from its proto representation.
+func ProtoToRunJobTerminalConditionDomainMappingReasonEnum(e runpb.RunJobTerminalConditionDomainMappingReasonEnum) *run.JobTerminalConditionDomainMappingReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobTerminalConditionDomainMappingReasonEnum_name[int32(e)]; ok {
+		e := run.JobTerminalConditionDomainMappingReasonEnum(n[len("RunJobTerminalConditionDomainMappingReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionRevisionReasonEnum converts a JobTerminalConditionRevisionReasonEnum enum from its proto representation.
+func ProtoToRunJobTerminalConditionRevisionReasonEnum(e runpb.RunJobTerminalConditionRevisionReasonEnum) *run.JobTerminalConditionRevisionReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobTerminalConditionRevisionReasonEnum_name[int32(e)]; ok {
+		e := run.JobTerminalConditionRevisionReasonEnum(n[len("RunJobTerminalConditionRevisionReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobTerminalConditionExecutionReasonEnum converts a JobTerminalConditionExecutionReasonEnum enum from its proto representation.
+func ProtoToRunJobTerminalConditionExecutionReasonEnum(e runpb.RunJobTerminalConditionExecutionReasonEnum) *run.JobTerminalConditionExecutionReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobTerminalConditionExecutionReasonEnum_name[int32(e)]; ok {
+		e := run.JobTerminalConditionExecutionReasonEnum(n[len("RunJobTerminalConditionExecutionReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobConditionsStateEnum converts a JobConditionsStateEnum enum from its proto representation.
+func ProtoToRunJobConditionsStateEnum(e runpb.RunJobConditionsStateEnum) *run.JobConditionsStateEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobConditionsStateEnum_name[int32(e)]; ok {
+		e := run.JobConditionsStateEnum(n[len("RunJobConditionsStateEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobConditionsSeverityEnum converts a JobConditionsSeverityEnum enum from its proto representation.
+func ProtoToRunJobConditionsSeverityEnum(e runpb.RunJobConditionsSeverityEnum) *run.JobConditionsSeverityEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobConditionsSeverityEnum_name[int32(e)]; ok {
+		e := run.JobConditionsSeverityEnum(n[len("RunJobConditionsSeverityEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobConditionsReasonEnum converts a JobConditionsReasonEnum enum from its proto representation.
+func ProtoToRunJobConditionsReasonEnum(e runpb.RunJobConditionsReasonEnum) *run.JobConditionsReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobConditionsReasonEnum_name[int32(e)]; ok {
+		e := run.JobConditionsReasonEnum(n[len("RunJobConditionsReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobConditionsRevisionReasonEnum converts a JobConditionsRevisionReasonEnum enum from its proto representation.
+func ProtoToRunJobConditionsRevisionReasonEnum(e runpb.RunJobConditionsRevisionReasonEnum) *run.JobConditionsRevisionReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobConditionsRevisionReasonEnum_name[int32(e)]; ok {
+		e := run.JobConditionsRevisionReasonEnum(n[len("RunJobConditionsRevisionReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobConditionsExecutionReasonEnum converts a JobConditionsExecutionReasonEnum enum from its proto representation.
+func ProtoToRunJobConditionsExecutionReasonEnum(e runpb.RunJobConditionsExecutionReasonEnum) *run.JobConditionsExecutionReasonEnum {
+	if e == 0 {
+		return nil
+	}
+	if n, ok := runpb.RunJobConditionsExecutionReasonEnum_name[int32(e)]; ok {
+		e := run.JobConditionsExecutionReasonEnum(n[len("RunJobConditionsExecutionReasonEnum"):])
+		return &e
+	}
+	return nil
+}
+
+// ProtoToJobBinaryAuthorization converts a JobBinaryAuthorization object from its proto representation.
+func ProtoToRunJobBinaryAuthorization(p *runpb.RunJobBinaryAuthorization) *run.JobBinaryAuthorization {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobBinaryAuthorization{
+		UseDefault:              dcl.Bool(p.GetUseDefault()),
+		BreakglassJustification: dcl.StringOrNil(p.GetBreakglassJustification()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplate converts a JobTemplate object from its proto representation.
+func ProtoToRunJobTemplate(p *runpb.RunJobTemplate) *run.JobTemplate {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplate{
+		Parallelism: dcl.Int64OrNil(p.GetParallelism()),
+		TaskCount:   dcl.Int64OrNil(p.GetTaskCount()),
+		Template:    ProtoToRunJobTemplateTemplate(p.GetTemplate()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplate converts a JobTemplateTemplate object from its proto representation.
+func ProtoToRunJobTemplateTemplate(p *runpb.RunJobTemplateTemplate) *run.JobTemplateTemplate {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplate{
+		MaxRetries:           dcl.Int64OrNil(p.GetMaxRetries()),
+		Timeout:              dcl.StringOrNil(p.GetTimeout()),
+		ServiceAccount:       dcl.StringOrNil(p.GetServiceAccount()),
+		ExecutionEnvironment: ProtoToRunJobTemplateTemplateExecutionEnvironmentEnum(p.GetExecutionEnvironment()),
+		EncryptionKey:        dcl.StringOrNil(p.GetEncryptionKey()),
+		VPCAccess:            ProtoToRunJobTemplateTemplateVPCAccess(p.GetVpcAccess()),
+	}
+	for _, r := range p.GetContainers() {
+		obj.Containers = append(obj.Containers, *ProtoToRunJobTemplateTemplateContainers(r))
+	}
+	for _, r := range p.GetVolumes() {
+		obj.Volumes = append(obj.Volumes, *ProtoToRunJobTemplateTemplateVolumes(r))
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainers converts a JobTemplateTemplateContainers object from its proto representation.
+func ProtoToRunJobTemplateTemplateContainers(p *runpb.RunJobTemplateTemplateContainers) *run.JobTemplateTemplateContainers {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplateContainers{
+		Name:      dcl.StringOrNil(p.GetName()),
+		Image:     dcl.StringOrNil(p.GetImage()),
+		Resources: ProtoToRunJobTemplateTemplateContainersResources(p.GetResources()),
+	}
+	for _, r := range p.GetCommand() {
+		obj.Command = append(obj.Command, r)
+	}
+	for _, r := range p.GetArgs() {
+		obj.Args = append(obj.Args, r)
+	}
+	for _, r := range p.GetEnv() {
+		obj.Env = append(obj.Env, *ProtoToRunJobTemplateTemplateContainersEnv(r))
+	}
+	for _, r := range p.GetPorts() {
+		obj.Ports = append(obj.Ports, *ProtoToRunJobTemplateTemplateContainersPorts(r))
+	}
+	for _, r := range p.GetVolumeMounts() {
+		obj.VolumeMounts = append(obj.VolumeMounts, *ProtoToRunJobTemplateTemplateContainersVolumeMounts(r))
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainersEnv converts a JobTemplateTemplateContainersEnv object from its proto representation.
+func ProtoToRunJobTemplateTemplateContainersEnv(p *runpb.RunJobTemplateTemplateContainersEnv) *run.JobTemplateTemplateContainersEnv {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplateContainersEnv{
+		Name:        dcl.StringOrNil(p.GetName()),
+		Value:       dcl.StringOrNil(p.GetValue()),
+		ValueSource: ProtoToRunJobTemplateTemplateContainersEnvValueSource(p.GetValueSource()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainersEnvValueSource converts a JobTemplateTemplateContainersEnvValueSource object from its proto representation.
+func ProtoToRunJobTemplateTemplateContainersEnvValueSource(p *runpb.RunJobTemplateTemplateContainersEnvValueSource) *run.JobTemplateTemplateContainersEnvValueSource {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplateContainersEnvValueSource{
+		SecretKeyRef: ProtoToRunJobTemplateTemplateContainersEnvValueSourceSecretKeyRef(p.GetSecretKeyRef()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainersEnvValueSourceSecretKeyRef converts a JobTemplateTemplateContainersEnvValueSourceSecretKeyRef object from its proto representation.
+func ProtoToRunJobTemplateTemplateContainersEnvValueSourceSecretKeyRef(p *runpb.RunJobTemplateTemplateContainersEnvValueSourceSecretKeyRef) *run.JobTemplateTemplateContainersEnvValueSourceSecretKeyRef {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplateContainersEnvValueSourceSecretKeyRef{
+		Secret:  dcl.StringOrNil(p.GetSecret()),
+		Version: dcl.StringOrNil(p.GetVersion()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainersResources converts a JobTemplateTemplateContainersResources object from its proto representation.
+func ProtoToRunJobTemplateTemplateContainersResources(p *runpb.RunJobTemplateTemplateContainersResources) *run.JobTemplateTemplateContainersResources {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplateContainersResources{
+		CpuIdle: dcl.Bool(p.GetCpuIdle()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainersPorts converts a JobTemplateTemplateContainersPorts object from its proto representation.
+func ProtoToRunJobTemplateTemplateContainersPorts(p *runpb.RunJobTemplateTemplateContainersPorts) *run.JobTemplateTemplateContainersPorts {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplateContainersPorts{
+		Name:          dcl.StringOrNil(p.GetName()),
+		ContainerPort: dcl.Int64OrNil(p.GetContainerPort()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateContainersVolumeMounts converts a JobTemplateTemplateContainersVolumeMounts object from its proto representation.
+func ProtoToRunJobTemplateTemplateContainersVolumeMounts(p *runpb.RunJobTemplateTemplateContainersVolumeMounts) *run.JobTemplateTemplateContainersVolumeMounts {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplateContainersVolumeMounts{
+		Name:      dcl.StringOrNil(p.GetName()),
+		MountPath: dcl.StringOrNil(p.GetMountPath()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateVolumes converts a JobTemplateTemplateVolumes object from its proto representation.
+func ProtoToRunJobTemplateTemplateVolumes(p *runpb.RunJobTemplateTemplateVolumes) *run.JobTemplateTemplateVolumes {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplateVolumes{
+		Name:             dcl.StringOrNil(p.GetName()),
+		Secret:           ProtoToRunJobTemplateTemplateVolumesSecret(p.GetSecret()),
+		CloudSqlInstance: ProtoToRunJobTemplateTemplateVolumesCloudSqlInstance(p.GetCloudSqlInstance()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateVolumesSecret converts a JobTemplateTemplateVolumesSecret object from its proto representation.
+func ProtoToRunJobTemplateTemplateVolumesSecret(p *runpb.RunJobTemplateTemplateVolumesSecret) *run.JobTemplateTemplateVolumesSecret {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplateVolumesSecret{
+		Secret:      dcl.StringOrNil(p.GetSecret()),
+		DefaultMode: dcl.Int64OrNil(p.GetDefaultMode()),
+	}
+	for _, r := range p.GetItems() {
+		obj.Items = append(obj.Items, *ProtoToRunJobTemplateTemplateVolumesSecretItems(r))
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateVolumesSecretItems converts a JobTemplateTemplateVolumesSecretItems object from its proto representation.
+func ProtoToRunJobTemplateTemplateVolumesSecretItems(p *runpb.RunJobTemplateTemplateVolumesSecretItems) *run.JobTemplateTemplateVolumesSecretItems {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplateVolumesSecretItems{
+		Path:    dcl.StringOrNil(p.GetPath()),
+		Version: dcl.StringOrNil(p.GetVersion()),
+		Mode:    dcl.Int64OrNil(p.GetMode()),
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateVolumesCloudSqlInstance converts a JobTemplateTemplateVolumesCloudSqlInstance object from its proto representation.
+func ProtoToRunJobTemplateTemplateVolumesCloudSqlInstance(p *runpb.RunJobTemplateTemplateVolumesCloudSqlInstance) *run.JobTemplateTemplateVolumesCloudSqlInstance {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplateVolumesCloudSqlInstance{}
+	for _, r := range p.GetInstances() {
+		obj.Instances = append(obj.Instances, r)
+	}
+	return obj
+}
+
+// ProtoToJobTemplateTemplateVPCAccess converts a JobTemplateTemplateVPCAccess object from its proto representation.
+func ProtoToRunJobTemplateTemplateVPCAccess(p *runpb.RunJobTemplateTemplateVPCAccess) *run.JobTemplateTemplateVPCAccess {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTemplateTemplateVPCAccess{
+		Connector: dcl.StringOrNil(p.GetConnector()),
+		Egress:    ProtoToRunJobTemplateTemplateVPCAccessEgressEnum(p.GetEgress()),
+	}
+	return obj
+}
+
+// ProtoToJobTerminalCondition converts a JobTerminalCondition object from its proto representation.
+func ProtoToRunJobTerminalCondition(p *runpb.RunJobTerminalCondition) *run.JobTerminalCondition {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobTerminalCondition{
+		Type:                dcl.StringOrNil(p.GetType()),
+		State:               ProtoToRunJobTerminalConditionStateEnum(p.GetState()),
+		Message:             dcl.StringOrNil(p.GetMessage()),
+		LastTransitionTime:  dcl.StringOrNil(p.GetLastTransitionTime()),
+		Severity:            ProtoToRunJobTerminalConditionSeverityEnum(p.GetSeverity()),
+		Reason:              ProtoToRunJobTerminalConditionReasonEnum(p.GetReason()),
+		InternalReason:      ProtoToRunJobTerminalConditionInternalReasonEnum(p.GetInternalReason()),
+		DomainMappingReason: ProtoToRunJobTerminalConditionDomainMappingReasonEnum(p.GetDomainMappingReason()),
+		RevisionReason:      ProtoToRunJobTerminalConditionRevisionReasonEnum(p.GetRevisionReason()),
+		ExecutionReason:     ProtoToRunJobTerminalConditionExecutionReasonEnum(p.GetExecutionReason()),
+	}
+	return obj
+}
+
+// ProtoToJobConditions converts a JobConditions object from its proto representation.
+func ProtoToRunJobConditions(p *runpb.RunJobConditions) *run.JobConditions {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobConditions{
+		Type:               dcl.StringOrNil(p.GetType()),
+		State:              ProtoToRunJobConditionsStateEnum(p.GetState()),
+		Message:            dcl.StringOrNil(p.GetMessage()),
+		LastTransitionTime: dcl.StringOrNil(p.GetLastTransitionTime()),
+		Severity:           ProtoToRunJobConditionsSeverityEnum(p.GetSeverity()),
+		Reason:             ProtoToRunJobConditionsReasonEnum(p.GetReason()),
+		RevisionReason:     ProtoToRunJobConditionsRevisionReasonEnum(p.GetRevisionReason()),
+		ExecutionReason:    ProtoToRunJobConditionsExecutionReasonEnum(p.GetExecutionReason()),
+	}
+	return obj
+}
+
+// ProtoToJobLatestSucceededExecution converts a JobLatestSucceededExecution object from its proto representation.
+func ProtoToRunJobLatestSucceededExecution(p *runpb.RunJobLatestSucceededExecution) *run.JobLatestSucceededExecution {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobLatestSucceededExecution{
+		Name:       dcl.StringOrNil(p.GetName()),
+		CreateTime: dcl.StringOrNil(p.GetCreateTime()),
+	}
+	return obj
+}
+
+// ProtoToJobLatestCreatedExecution converts a JobLatestCreatedExecution object from its proto representation.
+func ProtoToRunJobLatestCreatedExecution(p *runpb.RunJobLatestCreatedExecution) *run.JobLatestCreatedExecution {
+	if p == nil {
+		return nil
+	}
+	obj := &run.JobLatestCreatedExecution{
+		Name:       dcl.StringOrNil(p.GetName()),
+		CreateTime: dcl.StringOrNil(p.GetCreateTime()),
+	}
+	return obj
+}
+
+// ProtoToJob converts a Job resource from its proto representation.
+func ProtoToJob(p *runpb.RunJob) *run.Job {
+	obj := &run.Job{
+		Name:                     dcl.StringOrNil(p.GetName()),
+		Uid:                      dcl.StringOrNil(p.GetUid()),
+		Generation:               dcl.Int64OrNil(p.GetGeneration()),
+		CreateTime:               dcl.StringOrNil(p.GetCreateTime()),
+		UpdateTime:               dcl.StringOrNil(p.GetUpdateTime()),
+		DeleteTime:               dcl.StringOrNil(p.GetDeleteTime()),
+		ExpireTime:               dcl.StringOrNil(p.GetExpireTime()),
+		Creator:                  dcl.StringOrNil(p.GetCreator()),
+		LastModifier:             dcl.StringOrNil(p.GetLastModifier()),
+		Client:                   dcl.StringOrNil(p.GetClient()),
+		ClientVersion:            dcl.StringOrNil(p.GetClientVersion()),
+		LaunchStage:              ProtoToRunJobLaunchStageEnum(p.GetLaunchStage()),
+		BinaryAuthorization:      ProtoToRunJobBinaryAuthorization(p.GetBinaryAuthorization()),
+		Template:                 ProtoToRunJobTemplate(p.GetTemplate()),
+		ObservedGeneration:       dcl.Int64OrNil(p.GetObservedGeneration()),
+		TerminalCondition:        ProtoToRunJobTerminalCondition(p.GetTerminalCondition()),
+		ExecutionCount:           dcl.Int64OrNil(p.GetExecutionCount()),
+		LatestSucceededExecution: ProtoToRunJobLatestSucceededExecution(p.GetLatestSucceededExecution()),
+		LatestCreatedExecution:   ProtoToRunJobLatestCreatedExecution(p.GetLatestCreatedExecution()),
+		Reconciling:              dcl.Bool(p.GetReconciling()),
+		Etag:                     dcl.StringOrNil(p.GetEtag()),
+		Project:                  dcl.StringOrNil(p.GetProject()),
+		Location:                 dcl.StringOrNil(p.GetLocation()),
+	}
+	for _, r := range p.GetConditions() {
+		obj.Conditions = append(obj.Conditions, *ProtoToRunJobConditions(r))
+	}
+	return obj
+}
+
+// JobLaunchStageEnumToProto converts a JobLaunchStageEnum enum to its proto representation.
+func RunJobLaunchStageEnumToProto(e *run.JobLaunchStageEnum) runpb.RunJobLaunchStageEnum {
+	if e == nil {
+		return runpb.RunJobLaunchStageEnum(0)
+	}
+	if v, ok := runpb.RunJobLaunchStageEnum_value["JobLaunchStageEnum"+string(*e)]; ok {
+		return runpb.RunJobLaunchStageEnum(v)
+	}
+	return runpb.RunJobLaunchStageEnum(0)
+}
+
+// JobTemplateTemplateExecutionEnvironmentEnumToProto converts a JobTemplateTemplateExecutionEnvironmentEnum enum to its proto representation.
+func RunJobTemplateTemplateExecutionEnvironmentEnumToProto(e *run.JobTemplateTemplateExecutionEnvironmentEnum) runpb.RunJobTemplateTemplateExecutionEnvironmentEnum {
+	if e == nil {
+		return runpb.RunJobTemplateTemplateExecutionEnvironmentEnum(0)
+	}
+	if v, ok := runpb.RunJobTemplateTemplateExecutionEnvironmentEnum_value["JobTemplateTemplateExecutionEnvironmentEnum"+string(*e)]; ok {
+		return runpb.RunJobTemplateTemplateExecutionEnvironmentEnum(v)
+	}
+	return runpb.RunJobTemplateTemplateExecutionEnvironmentEnum(0)
+}
+
+// JobTemplateTemplateVPCAccessEgressEnumToProto converts a JobTemplateTemplateVPCAccessEgressEnum enum to its proto representation.
+func RunJobTemplateTemplateVPCAccessEgressEnumToProto(e *run.JobTemplateTemplateVPCAccessEgressEnum) runpb.RunJobTemplateTemplateVPCAccessEgressEnum {
+	if e == nil {
+		return runpb.RunJobTemplateTemplateVPCAccessEgressEnum(0)
+	}
+	if v, ok := runpb.RunJobTemplateTemplateVPCAccessEgressEnum_value["JobTemplateTemplateVPCAccessEgressEnum"+string(*e)]; ok {
+		return runpb.RunJobTemplateTemplateVPCAccessEgressEnum(v)
+	}
+	return runpb.RunJobTemplateTemplateVPCAccessEgressEnum(0)
+}
+
+// JobTerminalConditionStateEnumToProto converts a JobTerminalConditionStateEnum enum to its proto representation.
+func RunJobTerminalConditionStateEnumToProto(e *run.JobTerminalConditionStateEnum) runpb.RunJobTerminalConditionStateEnum {
+	if e == nil {
+		return runpb.RunJobTerminalConditionStateEnum(0)
+	}
+	if v, ok := runpb.RunJobTerminalConditionStateEnum_value["JobTerminalConditionStateEnum"+string(*e)]; ok {
+		return runpb.RunJobTerminalConditionStateEnum(v)
+	}
+	return runpb.RunJobTerminalConditionStateEnum(0)
+}
+
+// JobTerminalConditionSeverityEnumToProto converts a JobTerminalConditionSeverityEnum enum to its proto representation.
+func RunJobTerminalConditionSeverityEnumToProto(e *run.JobTerminalConditionSeverityEnum) runpb.RunJobTerminalConditionSeverityEnum {
+	if e == nil {
+		return runpb.RunJobTerminalConditionSeverityEnum(0)
+	}
+	if v, ok := runpb.RunJobTerminalConditionSeverityEnum_value["JobTerminalConditionSeverityEnum"+string(*e)]; ok {
+		return runpb.RunJobTerminalConditionSeverityEnum(v)
+	}
+	return runpb.RunJobTerminalConditionSeverityEnum(0)
+}
+
+// JobTerminalConditionReasonEnumToProto converts a JobTerminalConditionReasonEnum enum to its proto representation.
+func RunJobTerminalConditionReasonEnumToProto(e *run.JobTerminalConditionReasonEnum) runpb.RunJobTerminalConditionReasonEnum {
+	if e == nil {
+		return runpb.RunJobTerminalConditionReasonEnum(0)
+	}
+	if v, ok := runpb.RunJobTerminalConditionReasonEnum_value["JobTerminalConditionReasonEnum"+string(*e)]; ok {
+		return runpb.RunJobTerminalConditionReasonEnum(v)
+	}
+	return runpb.RunJobTerminalConditionReasonEnum(0)
+}
+
+// JobTerminalConditionInternalReasonEnumToProto converts a JobTerminalConditionInternalReasonEnum enum to its proto representation.
+func RunJobTerminalConditionInternalReasonEnumToProto(e *run.JobTerminalConditionInternalReasonEnum) runpb.RunJobTerminalConditionInternalReasonEnum {
+	if e == nil {
+		return runpb.RunJobTerminalConditionInternalReasonEnum(0)
+	}
+	if v, ok := runpb.RunJobTerminalConditionInternalReasonEnum_value["JobTerminalConditionInternalReasonEnum"+string(*e)]; ok {
+		return runpb.RunJobTerminalConditionInternalReasonEnum(v)
+	}
+	return runpb.RunJobTerminalConditionInternalReasonEnum(0)
+}
+
+// JobTerminalConditionDomainMappingReasonEnumToProto converts a JobTerminalConditionDomainMappingReasonEnum enum to its proto representation.
+func RunJobTerminalConditionDomainMappingReasonEnumToProto(e *run.JobTerminalConditionDomainMappingReasonEnum) runpb.RunJobTerminalConditionDomainMappingReasonEnum {
+	if e == nil {
+		return runpb.RunJobTerminalConditionDomainMappingReasonEnum(0)
+	}
+	if v, ok := runpb.RunJobTerminalConditionDomainMappingReasonEnum_value["JobTerminalConditionDomainMappingReasonEnum"+string(*e)]; ok {
+		return runpb.RunJobTerminalConditionDomainMappingReasonEnum(v)
+	}
+	return runpb.RunJobTerminalConditionDomainMappingReasonEnum(0)
+}
+
+// JobTerminalConditionRevisionReasonEnumToProto converts a JobTerminalConditionRevisionReasonEnum enum to its proto representation.
+func RunJobTerminalConditionRevisionReasonEnumToProto(e *run.JobTerminalConditionRevisionReasonEnum) runpb.RunJobTerminalConditionRevisionReasonEnum {
+	if e == nil {
+		return runpb.RunJobTerminalConditionRevisionReasonEnum(0)
+	}
+	if v, ok := runpb.RunJobTerminalConditionRevisionReasonEnum_value["JobTerminalConditionRevisionReasonEnum"+string(*e)]; ok {
+		return runpb.RunJobTerminalConditionRevisionReasonEnum(v)
+	}
+	return runpb.RunJobTerminalConditionRevisionReasonEnum(0)
+}
+
+// JobTerminalConditionExecutionReasonEnumToProto converts a JobTerminalConditionExecutionReasonEnum enum to its proto representation.
+func RunJobTerminalConditionExecutionReasonEnumToProto(e *run.JobTerminalConditionExecutionReasonEnum) runpb.RunJobTerminalConditionExecutionReasonEnum {
+	if e == nil {
+		return runpb.RunJobTerminalConditionExecutionReasonEnum(0)
+	}
+	if v, ok := runpb.RunJobTerminalConditionExecutionReasonEnum_value["JobTerminalConditionExecutionReasonEnum"+string(*e)]; ok {
+		return runpb.RunJobTerminalConditionExecutionReasonEnum(v)
+	}
+	return runpb.RunJobTerminalConditionExecutionReasonEnum(0)
+}
+
+// JobConditionsStateEnumToProto converts a JobConditionsStateEnum enum to its proto representation.
+func RunJobConditionsStateEnumToProto(e *run.JobConditionsStateEnum) runpb.RunJobConditionsStateEnum {
+	if e == nil {
+		return runpb.RunJobConditionsStateEnum(0)
+	}
+	if v, ok := runpb.RunJobConditionsStateEnum_value["JobConditionsStateEnum"+string(*e)]; ok {
+		return runpb.RunJobConditionsStateEnum(v)
+	}
+	return runpb.RunJobConditionsStateEnum(0)
+}
+
+// JobConditionsSeverityEnumToProto converts a JobConditionsSeverityEnum enum to its proto representation.
+func RunJobConditionsSeverityEnumToProto(e *run.JobConditionsSeverityEnum) runpb.RunJobConditionsSeverityEnum {
+	if e == nil {
+		return runpb.RunJobConditionsSeverityEnum(0)
+	}
+	if v, ok := runpb.RunJobConditionsSeverityEnum_value["JobConditionsSeverityEnum"+string(*e)]; ok {
+		return runpb.RunJobConditionsSeverityEnum(v)
+	}
+	return runpb.RunJobConditionsSeverityEnum(0)
+}
+
+// JobConditionsReasonEnumToProto converts a JobConditionsReasonEnum enum to its proto representation.
+func RunJobConditionsReasonEnumToProto(e *run.JobConditionsReasonEnum) runpb.RunJobConditionsReasonEnum {
+	if e == nil {
+		return runpb.RunJobConditionsReasonEnum(0)
+	}
+	if v, ok := runpb.RunJobConditionsReasonEnum_value["JobConditionsReasonEnum"+string(*e)]; ok {
+		return runpb.RunJobConditionsReasonEnum(v)
+	}
+	return runpb.RunJobConditionsReasonEnum(0)
+}
+
+// JobConditionsRevisionReasonEnumToProto converts a JobConditionsRevisionReasonEnum enum to its proto representation.
+func RunJobConditionsRevisionReasonEnumToProto(e *run.JobConditionsRevisionReasonEnum) runpb.RunJobConditionsRevisionReasonEnum {
+	if e == nil {
+		return runpb.RunJobConditionsRevisionReasonEnum(0)
+	}
+	if v, ok := runpb.RunJobConditionsRevisionReasonEnum_value["JobConditionsRevisionReasonEnum"+string(*e)]; ok {
+		return runpb.RunJobConditionsRevisionReasonEnum(v)
+	}
+	return runpb.RunJobConditionsRevisionReasonEnum(0)
+}
+
+// JobConditionsExecutionReasonEnumToProto converts a JobConditionsExecutionReasonEnum enum to its proto representation.
+func RunJobConditionsExecutionReasonEnumToProto(e *run.JobConditionsExecutionReasonEnum) runpb.RunJobConditionsExecutionReasonEnum {
+	if e == nil {
+		return runpb.RunJobConditionsExecutionReasonEnum(0)
+	}
+	if v, ok := runpb.RunJobConditionsExecutionReasonEnum_value["JobConditionsExecutionReasonEnum"+string(*e)]; ok {
+		return runpb.RunJobConditionsExecutionReasonEnum(v)
+	}
+	return runpb.RunJobConditionsExecutionReasonEnum(0)
+}
+
+// JobBinaryAuthorizationToProto converts a JobBinaryAuthorization object to its proto representation.
+func RunJobBinaryAuthorizationToProto(o *run.JobBinaryAuthorization) *runpb.RunJobBinaryAuthorization {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobBinaryAuthorization{}
+	p.SetUseDefault(dcl.ValueOrEmptyBool(o.UseDefault))
+	p.SetBreakglassJustification(dcl.ValueOrEmptyString(o.BreakglassJustification))
+	return p
+}
+
+// JobTemplateToProto converts a JobTemplate object to its proto representation.
+func RunJobTemplateToProto(o *run.JobTemplate) *runpb.RunJobTemplate {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplate{}
+	p.SetParallelism(dcl.ValueOrEmptyInt64(o.Parallelism))
+	p.SetTaskCount(dcl.ValueOrEmptyInt64(o.TaskCount))
+	p.SetTemplate(RunJobTemplateTemplateToProto(o.Template))
+	mLabels := make(map[string]string, len(o.Labels))
+	for k, r := range o.Labels {
+		mLabels[k] = r
+	}
+	p.SetLabels(mLabels)
+	mAnnotations := make(map[string]string, len(o.Annotations))
+	for k, r := range o.Annotations {
+		mAnnotations[k] = r
+	}
+	p.SetAnnotations(mAnnotations)
+	return p
+}
+
+// JobTemplateTemplateToProto converts a JobTemplateTemplate object to its proto representation.
+func RunJobTemplateTemplateToProto(o *run.JobTemplateTemplate) *runpb.RunJobTemplateTemplate {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplate{}
+	p.SetMaxRetries(dcl.ValueOrEmptyInt64(o.MaxRetries))
+	p.SetTimeout(dcl.ValueOrEmptyString(o.Timeout))
+	p.SetServiceAccount(dcl.ValueOrEmptyString(o.ServiceAccount))
+	p.SetExecutionEnvironment(RunJobTemplateTemplateExecutionEnvironmentEnumToProto(o.ExecutionEnvironment))
+	p.SetEncryptionKey(dcl.ValueOrEmptyString(o.EncryptionKey))
+	p.SetVpcAccess(RunJobTemplateTemplateVPCAccessToProto(o.VPCAccess))
+	sContainers := make([]*runpb.RunJobTemplateTemplateContainers, len(o.Containers))
+	for i, r := range o.Containers {
+		sContainers[i] = RunJobTemplateTemplateContainersToProto(&r)
+	}
+	p.SetContainers(sContainers)
+	sVolumes := make([]*runpb.RunJobTemplateTemplateVolumes, len(o.Volumes))
+	for i, r := range o.Volumes {
+		sVolumes[i] = RunJobTemplateTemplateVolumesToProto(&r)
+	}
+	p.SetVolumes(sVolumes)
+	return p
+}
+
+// JobTemplateTemplateContainersToProto converts a JobTemplateTemplateContainers object to its proto representation.
+func RunJobTemplateTemplateContainersToProto(o *run.JobTemplateTemplateContainers) *runpb.RunJobTemplateTemplateContainers {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplateContainers{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetImage(dcl.ValueOrEmptyString(o.Image))
+	p.SetResources(RunJobTemplateTemplateContainersResourcesToProto(o.Resources))
+	sCommand := make([]string, len(o.Command))
+	for i, r := range o.Command {
+		sCommand[i] = r
+	}
+	p.SetCommand(sCommand)
+	sArgs := make([]string, len(o.Args))
+	for i, r := range o.Args {
+		sArgs[i] = r
+	}
+	p.SetArgs(sArgs)
+	sEnv := make([]*runpb.RunJobTemplateTemplateContainersEnv, len(o.Env))
+	for i, r := range o.Env {
+		sEnv[i] = RunJobTemplateTemplateContainersEnvToProto(&r)
+	}
+	p.SetEnv(sEnv)
+	sPorts := make([]*runpb.RunJobTemplateTemplateContainersPorts, len(o.Ports))
+	for i, r := range o.Ports {
+		sPorts[i] = RunJobTemplateTemplateContainersPortsToProto(&r)
+	}
+	p.SetPorts(sPorts)
+	sVolumeMounts := make([]*runpb.RunJobTemplateTemplateContainersVolumeMounts, len(o.VolumeMounts))
+	for i, r := range o.VolumeMounts {
+		sVolumeMounts[i] = RunJobTemplateTemplateContainersVolumeMountsToProto(&r)
+	}
+	p.SetVolumeMounts(sVolumeMounts)
+	return p
+}
+
+// JobTemplateTemplateContainersEnvToProto converts a JobTemplateTemplateContainersEnv object to its proto representation.
+func RunJobTemplateTemplateContainersEnvToProto(o *run.JobTemplateTemplateContainersEnv) *runpb.RunJobTemplateTemplateContainersEnv {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplateContainersEnv{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetValue(dcl.ValueOrEmptyString(o.Value))
+	p.SetValueSource(RunJobTemplateTemplateContainersEnvValueSourceToProto(o.ValueSource))
+	return p
+}
+
+// JobTemplateTemplateContainersEnvValueSourceToProto converts a JobTemplateTemplateContainersEnvValueSource object to its proto representation.
+func RunJobTemplateTemplateContainersEnvValueSourceToProto(o *run.JobTemplateTemplateContainersEnvValueSource) *runpb.RunJobTemplateTemplateContainersEnvValueSource {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplateContainersEnvValueSource{}
+	p.SetSecretKeyRef(RunJobTemplateTemplateContainersEnvValueSourceSecretKeyRefToProto(o.SecretKeyRef))
+	return p
+}
+
+// JobTemplateTemplateContainersEnvValueSourceSecretKeyRefToProto converts a JobTemplateTemplateContainersEnvValueSourceSecretKeyRef object to its proto representation.
+func RunJobTemplateTemplateContainersEnvValueSourceSecretKeyRefToProto(o *run.JobTemplateTemplateContainersEnvValueSourceSecretKeyRef) *runpb.RunJobTemplateTemplateContainersEnvValueSourceSecretKeyRef {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplateContainersEnvValueSourceSecretKeyRef{}
+	p.SetSecret(dcl.ValueOrEmptyString(o.Secret))
+	p.SetVersion(dcl.ValueOrEmptyString(o.Version))
+	return p
+}
+
+// JobTemplateTemplateContainersResourcesToProto converts a JobTemplateTemplateContainersResources object to its proto representation.
+func RunJobTemplateTemplateContainersResourcesToProto(o *run.JobTemplateTemplateContainersResources) *runpb.RunJobTemplateTemplateContainersResources {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplateContainersResources{}
+	p.SetCpuIdle(dcl.ValueOrEmptyBool(o.CpuIdle))
+	mLimits := make(map[string]string, len(o.Limits))
+	for k, r := range o.Limits {
+		mLimits[k] = r
+	}
+	p.SetLimits(mLimits)
+	return p
+}
+
+// JobTemplateTemplateContainersPortsToProto converts a JobTemplateTemplateContainersPorts object to its proto representation.
+func RunJobTemplateTemplateContainersPortsToProto(o *run.JobTemplateTemplateContainersPorts) *runpb.RunJobTemplateTemplateContainersPorts {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplateContainersPorts{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetContainerPort(dcl.ValueOrEmptyInt64(o.ContainerPort))
+	return p
+}
+
+// JobTemplateTemplateContainersVolumeMountsToProto converts a JobTemplateTemplateContainersVolumeMounts object to its proto representation.
+func RunJobTemplateTemplateContainersVolumeMountsToProto(o *run.JobTemplateTemplateContainersVolumeMounts) *runpb.RunJobTemplateTemplateContainersVolumeMounts {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplateContainersVolumeMounts{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetMountPath(dcl.ValueOrEmptyString(o.MountPath))
+	return p
+}
+
+// JobTemplateTemplateVolumesToProto converts a JobTemplateTemplateVolumes object to its proto representation.
+func RunJobTemplateTemplateVolumesToProto(o *run.JobTemplateTemplateVolumes) *runpb.RunJobTemplateTemplateVolumes {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplateVolumes{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetSecret(RunJobTemplateTemplateVolumesSecretToProto(o.Secret))
+	p.SetCloudSqlInstance(RunJobTemplateTemplateVolumesCloudSqlInstanceToProto(o.CloudSqlInstance))
+	return p
+}
+
+// JobTemplateTemplateVolumesSecretToProto converts a JobTemplateTemplateVolumesSecret object to its proto representation.
+func RunJobTemplateTemplateVolumesSecretToProto(o *run.JobTemplateTemplateVolumesSecret) *runpb.RunJobTemplateTemplateVolumesSecret {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplateVolumesSecret{}
+	p.SetSecret(dcl.ValueOrEmptyString(o.Secret))
+	p.SetDefaultMode(dcl.ValueOrEmptyInt64(o.DefaultMode))
+	sItems := make([]*runpb.RunJobTemplateTemplateVolumesSecretItems, len(o.Items))
+	for i, r := range o.Items {
+		sItems[i] = RunJobTemplateTemplateVolumesSecretItemsToProto(&r)
+	}
+	p.SetItems(sItems)
+	return p
+}
+
+// JobTemplateTemplateVolumesSecretItemsToProto converts a JobTemplateTemplateVolumesSecretItems object to its proto representation.
+func RunJobTemplateTemplateVolumesSecretItemsToProto(o *run.JobTemplateTemplateVolumesSecretItems) *runpb.RunJobTemplateTemplateVolumesSecretItems {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplateVolumesSecretItems{}
+	p.SetPath(dcl.ValueOrEmptyString(o.Path))
+	p.SetVersion(dcl.ValueOrEmptyString(o.Version))
+	p.SetMode(dcl.ValueOrEmptyInt64(o.Mode))
+	return p
+}
+
+// JobTemplateTemplateVolumesCloudSqlInstanceToProto converts a JobTemplateTemplateVolumesCloudSqlInstance object to its proto representation.
+func RunJobTemplateTemplateVolumesCloudSqlInstanceToProto(o *run.JobTemplateTemplateVolumesCloudSqlInstance) *runpb.RunJobTemplateTemplateVolumesCloudSqlInstance {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplateVolumesCloudSqlInstance{}
+	sInstances := make([]string, len(o.Instances))
+	for i, r := range o.Instances {
+		sInstances[i] = r
+	}
+	p.SetInstances(sInstances)
+	return p
+}
+
+// JobTemplateTemplateVPCAccessToProto converts a JobTemplateTemplateVPCAccess object to its proto representation.
+func RunJobTemplateTemplateVPCAccessToProto(o *run.JobTemplateTemplateVPCAccess) *runpb.RunJobTemplateTemplateVPCAccess {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTemplateTemplateVPCAccess{}
+	p.SetConnector(dcl.ValueOrEmptyString(o.Connector))
+	p.SetEgress(RunJobTemplateTemplateVPCAccessEgressEnumToProto(o.Egress))
+	return p
+}
+
+// JobTerminalConditionToProto converts a JobTerminalCondition object to its proto representation.
+func RunJobTerminalConditionToProto(o *run.JobTerminalCondition) *runpb.RunJobTerminalCondition {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobTerminalCondition{}
+	p.SetType(dcl.ValueOrEmptyString(o.Type))
+	p.SetState(RunJobTerminalConditionStateEnumToProto(o.State))
+	p.SetMessage(dcl.ValueOrEmptyString(o.Message))
+	p.SetLastTransitionTime(dcl.ValueOrEmptyString(o.LastTransitionTime))
+	p.SetSeverity(RunJobTerminalConditionSeverityEnumToProto(o.Severity))
+	p.SetReason(RunJobTerminalConditionReasonEnumToProto(o.Reason))
+	p.SetInternalReason(RunJobTerminalConditionInternalReasonEnumToProto(o.InternalReason))
+	p.SetDomainMappingReason(RunJobTerminalConditionDomainMappingReasonEnumToProto(o.DomainMappingReason))
+	p.SetRevisionReason(RunJobTerminalConditionRevisionReasonEnumToProto(o.RevisionReason))
+	p.SetExecutionReason(RunJobTerminalConditionExecutionReasonEnumToProto(o.ExecutionReason))
+	return p
+}
+
+// JobConditionsToProto converts a JobConditions object to its proto representation.
+func RunJobConditionsToProto(o *run.JobConditions) *runpb.RunJobConditions {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobConditions{}
+	p.SetType(dcl.ValueOrEmptyString(o.Type))
+	p.SetState(RunJobConditionsStateEnumToProto(o.State))
+	p.SetMessage(dcl.ValueOrEmptyString(o.Message))
+	p.SetLastTransitionTime(dcl.ValueOrEmptyString(o.LastTransitionTime))
+	p.SetSeverity(RunJobConditionsSeverityEnumToProto(o.Severity))
+	p.SetReason(RunJobConditionsReasonEnumToProto(o.Reason))
+	p.SetRevisionReason(RunJobConditionsRevisionReasonEnumToProto(o.RevisionReason))
+	p.SetExecutionReason(RunJobConditionsExecutionReasonEnumToProto(o.ExecutionReason))
+	return p
+}
+
+// JobLatestSucceededExecutionToProto converts a JobLatestSucceededExecution object to its proto representation.
+func RunJobLatestSucceededExecutionToProto(o *run.JobLatestSucceededExecution) *runpb.RunJobLatestSucceededExecution {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobLatestSucceededExecution{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetCreateTime(dcl.ValueOrEmptyString(o.CreateTime))
+	return p
+}
+
+// JobLatestCreatedExecutionToProto converts a JobLatestCreatedExecution object to its proto representation.
+func RunJobLatestCreatedExecutionToProto(o *run.JobLatestCreatedExecution) *runpb.RunJobLatestCreatedExecution {
+	if o == nil {
+		return nil
+	}
+	p := &runpb.RunJobLatestCreatedExecution{}
+	p.SetName(dcl.ValueOrEmptyString(o.Name))
+	p.SetCreateTime(dcl.ValueOrEmptyString(o.CreateTime))
+	return p
+}
+
+// JobToProto converts a Job resource to its proto representation.
+func JobToProto(resource *run.Job) *runpb.RunJob {
+	p := &runpb.RunJob{}
+	p.SetName(dcl.ValueOrEmptyString(resource.Name))
+	p.SetUid(dcl.ValueOrEmptyString(resource.Uid))
+	p.SetGeneration(dcl.ValueOrEmptyInt64(resource.Generation))
+	p.SetCreateTime(dcl.ValueOrEmptyString(resource.CreateTime))
+	p.SetUpdateTime(dcl.ValueOrEmptyString(resource.UpdateTime))
+	p.SetDeleteTime(dcl.ValueOrEmptyString(resource.DeleteTime))
+	p.SetExpireTime(dcl.ValueOrEmptyString(resource.ExpireTime))
+	p.SetCreator(dcl.ValueOrEmptyString(resource.Creator))
+	p.SetLastModifier(dcl.ValueOrEmptyString(resource.LastModifier))
+	p.SetClient(dcl.ValueOrEmptyString(resource.Client))
+	p.SetClientVersion(dcl.ValueOrEmptyString(resource.ClientVersion))
+	p.SetLaunchStage(RunJobLaunchStageEnumToProto(resource.LaunchStage))
+	p.SetBinaryAuthorization(RunJobBinaryAuthorizationToProto(resource.BinaryAuthorization))
+	p.SetTemplate(RunJobTemplateToProto(resource.Template))
+	p.SetObservedGeneration(dcl.ValueOrEmptyInt64(resource.ObservedGeneration))
+	p.SetTerminalCondition(RunJobTerminalConditionToProto(resource.TerminalCondition))
+	p.SetExecutionCount(dcl.ValueOrEmptyInt64(resource.ExecutionCount))
+	p.SetLatestSucceededExecution(RunJobLatestSucceededExecutionToProto(resource.LatestSucceededExecution))
+	p.SetLatestCreatedExecution(RunJobLatestCreatedExecutionToProto(resource.LatestCreatedExecution))
+	p.SetReconciling(dcl.ValueOrEmptyBool(resource.Reconciling))
+	p.SetEtag(dcl.ValueOrEmptyString(resource.Etag))
+	p.SetProject(dcl.ValueOrEmptyString(resource.Project))
+	p.SetLocation(dcl.ValueOrEmptyString(resource.Location))
+	mLabels := make(map[string]string, len(resource.Labels))
+	for k, r := range resource.Labels {
+		mLabels[k] = r
+	}
+	p.SetLabels(mLabels)
+	mAnnotations := make(map[string]string, len(resource.Annotations))
+	for k, r := range resource.Annotations {
+		mAnnotations[k] = r
+	}
+	p.SetAnnotations(mAnnotations)
+	sConditions := make([]*runpb.RunJobConditions, len(resource.Conditions))
+	for i, r := range resource.Conditions {
+		sConditions[i] = RunJobConditionsToProto(&r)
+	}
+	p.SetConditions(sConditions)
+
+	return p
+}
+
+// applyJob handles the gRPC request by passing it to the underlying Job Apply() method.
+func (s *JobServer) applyJob(ctx context.Context, c *run.Client, request *runpb.ApplyRunJobRequest) (*runpb.RunJob, error) {
+	p := ProtoToJob(request.GetResource())
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyJob(ctx, p, opts...)
+	if err != nil {
+		return nil, err
+	}
+	r := JobToProto(res)
+	return r, nil
+}
+
+// applyRunJob handles the gRPC request by passing it to the underlying Job Apply() method.
+func (s *JobServer) ApplyRunJob(ctx context.Context, request *runpb.ApplyRunJobRequest) (*runpb.RunJob, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	return s.applyJob(ctx, cl, request)
+}
+
+// GetRunJob handles the gRPC request by passing it to the underlying Job Get() method.
+func (s *JobServer) GetRunJob(ctx context.Context, request *runpb.GetRunJobRequest) (*runpb.RunJob, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetJob(ctx, ProtoToJob(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return JobToProto(res), nil
+}
+
+// HasDiffRunJob handles the gRPC request by passing it to the underlying Job Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *JobServer) HasDiffRunJob(ctx context.Context, request *runpb.HasDiffRunJobRequest) (*runpb.HasDiffRunJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJob(ctx, ProtoToJob(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &runpb.HasDiffRunJobResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanRunJob handles the gRPC request by passing it to the underlying Job Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *JobServer) PlanRunJob(ctx context.Context, request *runpb.PlanRunJobRequest) (*runpb.PlanRunJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffJob(ctx, ProtoToJob(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &runpb.PlanRunJobResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
+// DeleteJob handles the gRPC request by passing it to the underlying Job Delete() method.
+func (s *JobServer) DeleteRunJob(ctx context.Context, request *runpb.DeleteRunJobRequest) (*emptypb.Empty, error) {
+
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, cl.DeleteJob(ctx, ProtoToJob(request.GetResource()))
+
+}
+
+// ListRunJob handles the gRPC request by passing it to the underlying JobList() method.
+func (s *JobServer) ListRunJob(ctx context.Context, request *runpb.ListRunJobRequest) (*runpb.ListRunJobResponse, error) {
+	cl, err := createConfigJob(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = run.JobMaxPage
+	}
+	resources, err := cl.ListJobWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	var protos []*runpb.RunJob
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, JobToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	p := &runpb.ListRunJobResponse{}
+	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
+	return p, nil
+}
+
+func createConfigJob(ctx context.Context, service_account_file string) (*run.Client, error) {
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
+	return run.NewClient(conf), nil
+}
@@ -16,12 +16,13 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	runpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/run/run_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/run"
 )
 
-// Server implements the gRPC interface for Service.
+// ServiceServer implements the gRPC interface for Service.
 type ServiceServer struct{}
 
 // ProtoToServiceIngressEnum converts a ServiceIngressEnum enum from its proto representation.
@@ -120,30 +121,6 @@ func ProtoToRunServiceTerminalConditionReasonEnum(e runpb.RunServiceTerminalCond
 	return nil
 }
 
-// ProtoToServiceTerminalConditionInternalReasonEnum converts a ServiceTerminalConditionInternalReasonEnum enum from its proto representation.
-func ProtoToRunServiceTerminalConditionInternalReasonEnum(e runpb.RunServiceTerminalConditionInternalReasonEnum) *run.ServiceTerminalConditionInternalReasonEnum {
-	if e == 0 {
-		return nil
-	}
-	if n, ok := runpb.RunServiceTerminalConditionInternalReasonEnum_name[int32(e)]; ok {
-		e := run.ServiceTerminalConditionInternalReasonEnum(n[len("RunServiceTerminalConditionInternalReasonEnum"):])
-		return &e
-	}
-	return nil
-}
-
-// ProtoToServiceTerminalConditionDomainMappingReasonEnum converts a ServiceTerminalConditionDomainMappingReasonEnum enum from its proto representation.
-func ProtoToRunServiceTerminalConditionDomainMappingReasonEnum(e runpb.RunServiceTerminalConditionDomainMappingReasonEnum) *run.ServiceTerminalConditionDomainMappingReasonEnum {
-	if e == 0 {
-		return nil
-	}
-	if n, ok := runpb.RunServiceTerminalConditionDomainMappingReasonEnum_name[int32(e)]; ok {
-		e := run.ServiceTerminalConditionDomainMappingReasonEnum(n[len("RunServiceTerminalConditionDomainMappingReasonEnum"):])
-		return &e
-	}
-	return nil
-}
-
 // ProtoToServiceTerminalConditionRevisionReasonEnum converts a ServiceTerminalConditionRevisionReasonEnum enum from its proto representation.
 func ProtoToRunServiceTerminalConditionRevisionReasonEnum(e runpb.RunServiceTerminalConditionRevisionReasonEnum) *run.ServiceTerminalConditionRevisionReasonEnum {
 	if e == 0 {
@@ -204,7 +181,6 @@ func ProtoToRunServiceTemplate(p *runpb.RunServiceTemplate) *run.ServiceTemplate
 		ContainerConcurrency: dcl.Int64OrNil(p.GetContainerConcurrency()),
 		Timeout:              dcl.StringOrNil(p.GetTimeout()),
 		ServiceAccount:       dcl.StringOrNil(p.GetServiceAccount()),
-		Confidential:         dcl.Bool(p.GetConfidential()),
 		ExecutionEnvironment: ProtoToRunServiceTemplateExecutionEnvironmentEnum(p.GetExecutionEnvironment()),
 	}
 	for _, r := range p.GetContainers() {
@@ -386,8 +362,8 @@ func ProtoToRunServiceTemplateVolumesCloudSqlInstance(p *runpb.RunServiceTemplat
 		return nil
 	}
 	obj := &run.ServiceTemplateVolumesCloudSqlInstance{}
-	for _, r := range p.GetConnections() {
-		obj.Connections = append(obj.Connections, r)
+	for _, r := range p.GetInstances() {
+		obj.Instances = append(obj.Instances, r)
 	}
 	return obj
 }
@@ -412,16 +388,14 @@ func ProtoToRunServiceTerminalCondition(p *runpb.RunServiceTerminalCondition) *r
 		return nil
 	}
 	obj := &run.ServiceTerminalCondition{
-		Type:                dcl.StringOrNil(p.GetType()),
-		State:               ProtoToRunServiceTerminalConditionStateEnum(p.GetState()),
-		Message:             dcl.StringOrNil(p.GetMessage()),
-		LastTransitionTime:  dcl.StringOrNil(p.GetLastTransitionTime()),
-		Severity:            ProtoToRunServiceTerminalConditionSeverityEnum(p.GetSeverity()),
-		Reason:              ProtoToRunServiceTerminalConditionReasonEnum(p.GetReason()),
-		InternalReason:      ProtoToRunServiceTerminalConditionInternalReasonEnum(p.GetInternalReason()),
-		DomainMappingReason: ProtoToRunServiceTerminalConditionDomainMappingReasonEnum(p.GetDomainMappingReason()),
-		RevisionReason:      ProtoToRunServiceTerminalConditionRevisionReasonEnum(p.GetRevisionReason()),
-		JobReason:           ProtoToRunServiceTerminalConditionJobReasonEnum(p.GetJobReason()),
+		Type:               dcl.StringOrNil(p.GetType()),
+		State:              ProtoToRunServiceTerminalConditionStateEnum(p.GetState()),
+		Message:            dcl.StringOrNil(p.GetMessage()),
+		LastTransitionTime: dcl.StringOrNil(p.GetLastTransitionTime()),
+		Severity:           ProtoToRunServiceTerminalConditionSeverityEnum(p.GetSeverity()),
+		Reason:             ProtoToRunServiceTerminalConditionReasonEnum(p.GetReason()),
+		RevisionReason:     ProtoToRunServiceTerminalConditionRevisionReasonEnum(p.GetRevisionReason()),
+		JobReason:          ProtoToRunServiceTerminalConditionJobReasonEnum(p.GetJobReason()),
 	}
 	return obj
 }
@@ -566,28 +540,6 @@ func RunServiceTerminalConditionReasonEnumToProto(e *run.ServiceTerminalConditio
 	return runpb.RunServiceTerminalConditionReasonEnum(0)
 }
 
-// ServiceTerminalConditionInternalReasonEnumToProto converts a ServiceTerminalConditionInternalReasonEnum enum to its proto representation.
-func RunServiceTerminalConditionInternalReasonEnumToProto(e *run.ServiceTerminalConditionInternalReasonEnum) runpb.RunServiceTerminalConditionInternalReasonEnum {
-	if e == nil {
-		return runpb.RunServiceTerminalConditionInternalReasonEnum(0)
-	}
-	if v, ok := runpb.RunServiceTerminalConditionInternalReasonEnum_value["ServiceTerminalConditionInternalReasonEnum"+string(*e)]; ok {
-		return runpb.RunServiceTerminalConditionInternalReasonEnum(v)
-	}
-	return runpb.RunServiceTerminalConditionInternalReasonEnum(0)
-}
-
-// ServiceTerminalConditionDomainMappingReasonEnumToProto converts a ServiceTerminalConditionDomainMappingReasonEnum enum to its proto representation.
-func RunServiceTerminalConditionDomainMappingReasonEnumToProto(e *run.ServiceTerminalConditionDomainMappingReasonEnum) runpb.RunServiceTerminalConditionDomainMappingReasonEnum {
-	if e == nil {
-		return runpb.RunServiceTerminalConditionDomainMappingReasonEnum(0)
-	}
-	if v, ok := runpb.RunServiceTerminalConditionDomainMappingReasonEnum_value["ServiceTerminalConditionDomainMappingReasonEnum"+string(*e)]; ok {
-		return runpb.RunServiceTerminalConditionDomainMappingReasonEnum(v)
-	}
-	return runpb.RunServiceTerminalConditionDomainMappingReasonEnum(0)
-}
-
 // ServiceTerminalConditionRevisionReasonEnumToProto converts a ServiceTerminalConditionRevisionReasonEnum enum to its proto representation.
 func RunServiceTerminalConditionRevisionReasonEnumToProto(e *run.ServiceTerminalConditionRevisionReasonEnum) runpb.RunServiceTerminalConditionRevisionReasonEnum {
 	if e == nil {
@@ -644,7 +596,6 @@ func RunServiceTemplateToProto(o *run.ServiceTemplate) *runpb.RunServiceTemplate
 	p.SetContainerConcurrency(dcl.ValueOrEmptyInt64(o.ContainerConcurrency))
 	p.SetTimeout(dcl.ValueOrEmptyString(o.Timeout))
 	p.SetServiceAccount(dcl.ValueOrEmptyString(o.ServiceAccount))
-	p.SetConfidential(dcl.ValueOrEmptyBool(o.Confidential))
 	p.SetExecutionEnvironment(RunServiceTemplateExecutionEnvironmentEnumToProto(o.ExecutionEnvironment))
 	mLabels := make(map[string]string, len(o.Labels))
 	for k, r := range o.Labels {
@@ -844,11 +795,11 @@ func RunServiceTemplateVolumesCloudSqlInstanceToProto(o *run.ServiceTemplateVolu
 		return nil
 	}
 	p := &runpb.RunServiceTemplateVolumesCloudSqlInstance{}
-	sConnections := make([]string, len(o.Connections))
-	for i, r := range o.Connections {
-		sConnections[i] = r
+	sInstances := make([]string, len(o.Instances))
+	for i, r := range o.Instances {
+		sInstances[i] = r
 	}
-	p.SetConnections(sConnections)
+	p.SetInstances(sInstances)
 	return p
 }
 
@@ -877,8 +828,6 @@ func RunServiceTerminalConditionToProto(o *run.ServiceTerminalCondition) *runpb.
 	p.SetLastTransitionTime(dcl.ValueOrEmptyString(o.LastTransitionTime))
 	p.SetSeverity(RunServiceTerminalConditionSeverityEnumToProto(o.Severity))
 	p.SetReason(RunServiceTerminalConditionReasonEnumToProto(o.Reason))
-	p.SetInternalReason(RunServiceTerminalConditionInternalReasonEnumToProto(o.InternalReason))
-	p.SetDomainMappingReason(RunServiceTerminalConditionDomainMappingReasonEnumToProto(o.DomainMappingReason))
 	p.SetRevisionReason(RunServiceTerminalConditionRevisionReasonEnumToProto(o.RevisionReason))
 	p.SetJobReason(RunServiceTerminalConditionJobReasonEnumToProto(o.JobReason))
 	return p
@@ -952,7 +901,11 @@ func ServiceToProto(resource *run.Service) *runpb.RunService {
 // applyService handles the gRPC request by passing it to the underlying Service Apply() method.
 func (s *ServiceServer) applyService(ctx context.Context, c *run.Client, request *runpb.ApplyRunServiceRequest) (*runpb.RunService, error) {
 	p := ProtoToService(request.GetResource())
-	res, err := c.ApplyService(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyService(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -969,6 +922,60 @@ func (s *ServiceServer) ApplyRunService(ctx context.Context, request *runpb.Appl
 	return s.applyService(ctx, cl, request)
 }
 
+// GetRunService handles the gRPC request by passing it to the underlying Service Get() method.
+func (s *ServiceServer) GetRunService(ctx context.Context, request *runpb.GetRunServiceRequest) (*runpb.RunService, error) {
+	cl, err := createConfigService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetService(ctx, ProtoToService(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceToProto(res), nil
+}
+
+// HasDiffRunService handles the gRPC request by passing it to the underlying Service Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceServer) HasDiffRunService(ctx context.Context, request *runpb.HasDiffRunServiceRequest) (*runpb.HasDiffRunServiceResponse, error) {
+	cl, err := createConfigService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffService(ctx, ProtoToService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &runpb.HasDiffRunServiceResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanRunService handles the gRPC request by passing it to the underlying Service Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceServer) PlanRunService(ctx context.Context, request *runpb.PlanRunServiceRequest) (*runpb.PlanRunServiceResponse, error) {
+	cl, err := createConfigService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffService(ctx, ProtoToService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &runpb.PlanRunServiceResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteService handles the gRPC request by passing it to the underlying Service Delete() method.
 func (s *ServiceServer) DeleteRunService(ctx context.Context, request *runpb.DeleteRunServiceRequest) (*emptypb.Empty, error) {
 
@@ -987,22 +994,35 @@ func (s *ServiceServer) ListRunService(ctx context.Context, request *runpb.ListR
 		return nil, err
 	}
 
-	resources, err := cl.ListService(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = run.ServiceMaxPage
+	}
+	resources, err := cl.ListServiceWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*runpb.RunService
-	for _, r := range resources.Items {
-		rp := ServiceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &runpb.ListRunServiceResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigService(ctx context.Context, service_account_file string) (*run.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return run.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/runtimeconfig/beta/runtimeconfig_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/runtimeconfig/beta"
@@ -55,7 +56,11 @@ func VariableToProto(resource *beta.Variable) *betapb.RuntimeconfigBetaVariable
 // ApplyVariable handles the gRPC request by passing it to the underlying Variable Apply() method.
 func (s *VariableServer) applyVariable(ctx context.Context, c *beta.Client, request *betapb.ApplyRuntimeconfigBetaVariableRequest) (*betapb.RuntimeconfigBetaVariable, error) {
 	p := ProtoToVariable(request.GetResource())
-	res, err := c.ApplyVariable(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyVariable(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,55 @@ func (s *VariableServer) ApplyRuntimeconfigBetaVariable(ctx context.Context, req
 	return s.applyVariable(ctx, cl, request)
 }
 
+// GetRuntimeconfigBetaVariable handles the gRPC request by passing it to the underlying Variable Get() method.
+func (s *VariableServer) GetRuntimeconfigBetaVariable(ctx context.Context, request *betapb.GetRuntimeconfigBetaVariableRequest) (*betapb.RuntimeconfigBetaVariable, error) {
+	cl, err := createConfigVariable(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetVariable(ctx, ProtoToVariable(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return VariableToProto(res), nil
+}
+
+// HasDiffRuntimeconfigBetaVariable handles the gRPC request by passing it to the underlying Variable Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *VariableServer) HasDiffRuntimeconfigBetaVariable(ctx context.Context, request *betapb.HasDiffRuntimeconfigBetaVariableRequest) (*betapb.HasDiffRuntimeconfigBetaVariableResponse, error) {
+	cl, err := createConfigVariable(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVariable(ctx, ProtoToVariable(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffRuntimeconfigBetaVariableResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanRuntimeconfigBetaVariable handles the gRPC request by passing it to the underlying Variable Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *VariableServer) PlanRuntimeconfigBetaVariable(ctx context.Context, request *betapb.PlanRuntimeconfigBetaVariableRequest) (*betapb.PlanRuntimeconfigBetaVariableResponse, error) {
+	cl, err := createConfigVariable(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVariable(ctx, ProtoToVariable(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanRuntimeconfigBetaVariableResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteVariable handles the gRPC request by passing it to the underlying Variable Delete() method.
 func (s *VariableServer) DeleteRuntimeconfigBetaVariable(ctx context.Context, request *betapb.DeleteRuntimeconfigBetaVariableRequest) (*emptypb.Empty, error) {
 
@@ -90,20 +144,32 @@ func (s *VariableServer) ListRuntimeconfigBetaVariable(ctx context.Context, requ
 		return nil, err
 	}
 
-	resources, err := cl.ListVariable(ctx, request.Project, request.RuntimeConfig)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.VariableMaxPage
+	}
+	resources, err := cl.ListVariableWithPageToken(ctx, request.Project, request.RuntimeConfig, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.RuntimeconfigBetaVariable
-	for _, r := range resources.Items {
-		rp := VariableToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, VariableToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListRuntimeconfigBetaVariableResponse{Items: protos}, nil
+	return &betapb.ListRuntimeconfigBetaVariableResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigVariable(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
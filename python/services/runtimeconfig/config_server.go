@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	runtimeconfigpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/runtimeconfig/runtimeconfig_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/runtimeconfig"
@@ -49,7 +50,11 @@ func ConfigToProto(resource *runtimeconfig.Config) *runtimeconfigpb.Runtimeconfi
 // ApplyConfig handles the gRPC request by passing it to the underlying Config Apply() method.
 func (s *ConfigServer) applyConfig(ctx context.Context, c *runtimeconfig.Client, request *runtimeconfigpb.ApplyRuntimeconfigConfigRequest) (*runtimeconfigpb.RuntimeconfigConfig, error) {
 	p := ProtoToConfig(request.GetResource())
-	res, err := c.ApplyConfig(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyConfig(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +71,55 @@ func (s *ConfigServer) ApplyRuntimeconfigConfig(ctx context.Context, request *ru
 	return s.applyConfig(ctx, cl, request)
 }
 
+// GetRuntimeconfigConfig handles the gRPC request by passing it to the underlying Config Get() method.
+func (s *ConfigServer) GetRuntimeconfigConfig(ctx context.Context, request *runtimeconfigpb.GetRuntimeconfigConfigRequest) (*runtimeconfigpb.RuntimeconfigConfig, error) {
+	cl, err := createConfigConfig(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetConfig(ctx, ProtoToConfig(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ConfigToProto(res), nil
+}
+
+// HasDiffRuntimeconfigConfig handles the gRPC request by passing it to the underlying Config Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ConfigServer) HasDiffRuntimeconfigConfig(ctx context.Context, request *runtimeconfigpb.HasDiffRuntimeconfigConfigRequest) (*runtimeconfigpb.HasDiffRuntimeconfigConfigResponse, error) {
+	cl, err := createConfigConfig(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConfig(ctx, ProtoToConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &runtimeconfigpb.HasDiffRuntimeconfigConfigResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanRuntimeconfigConfig handles the gRPC request by passing it to the underlying Config Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ConfigServer) PlanRuntimeconfigConfig(ctx context.Context, request *runtimeconfigpb.PlanRuntimeconfigConfigRequest) (*runtimeconfigpb.PlanRuntimeconfigConfigResponse, error) {
+	cl, err := createConfigConfig(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConfig(ctx, ProtoToConfig(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &runtimeconfigpb.PlanRuntimeconfigConfigResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteConfig handles the gRPC request by passing it to the underlying Config Delete() method.
 func (s *ConfigServer) DeleteRuntimeconfigConfig(ctx context.Context, request *runtimeconfigpb.DeleteRuntimeconfigConfigRequest) (*emptypb.Empty, error) {
 
@@ -84,20 +138,32 @@ func (s *ConfigServer) ListRuntimeconfigConfig(ctx context.Context, request *run
 		return nil, err
 	}
 
-	resources, err := cl.ListConfig(ctx, request.Project, request.Name)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = runtimeconfig.ConfigMaxPage
+	}
+	resources, err := cl.ListConfigWithPageToken(ctx, request.Project, request.Name, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*runtimeconfigpb.RuntimeconfigConfig
-	for _, r := range resources.Items {
-		rp := ConfigToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ConfigToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &runtimeconfigpb.ListRuntimeconfigConfigResponse{Items: protos}, nil
+	return &runtimeconfigpb.ListRuntimeconfigConfigResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigConfig(ctx context.Context, service_account_file string) (*runtimeconfig.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return runtimeconfig.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	runtimeconfigpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/runtimeconfig/runtimeconfig_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/runtimeconfig"
@@ -55,7 +56,11 @@ func VariableToProto(resource *runtimeconfig.Variable) *runtimeconfigpb.Runtimec
 // ApplyVariable handles the gRPC request by passing it to the underlying Variable Apply() method.
 func (s *VariableServer) applyVariable(ctx context.Context, c *runtimeconfig.Client, request *runtimeconfigpb.ApplyRuntimeconfigVariableRequest) (*runtimeconfigpb.RuntimeconfigVariable, error) {
 	p := ProtoToVariable(request.GetResource())
-	res, err := c.ApplyVariable(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyVariable(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,55 @@ func (s *VariableServer) ApplyRuntimeconfigVariable(ctx context.Context, request
 	return s.applyVariable(ctx, cl, request)
 }
 
+// GetRuntimeconfigVariable handles the gRPC request by passing it to the underlying Variable Get() method.
+func (s *VariableServer) GetRuntimeconfigVariable(ctx context.Context, request *runtimeconfigpb.GetRuntimeconfigVariableRequest) (*runtimeconfigpb.RuntimeconfigVariable, error) {
+	cl, err := createConfigVariable(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetVariable(ctx, ProtoToVariable(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return VariableToProto(res), nil
+}
+
+// HasDiffRuntimeconfigVariable handles the gRPC request by passing it to the underlying Variable Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *VariableServer) HasDiffRuntimeconfigVariable(ctx context.Context, request *runtimeconfigpb.HasDiffRuntimeconfigVariableRequest) (*runtimeconfigpb.HasDiffRuntimeconfigVariableResponse, error) {
+	cl, err := createConfigVariable(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVariable(ctx, ProtoToVariable(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &runtimeconfigpb.HasDiffRuntimeconfigVariableResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanRuntimeconfigVariable handles the gRPC request by passing it to the underlying Variable Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *VariableServer) PlanRuntimeconfigVariable(ctx context.Context, request *runtimeconfigpb.PlanRuntimeconfigVariableRequest) (*runtimeconfigpb.PlanRuntimeconfigVariableResponse, error) {
+	cl, err := createConfigVariable(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVariable(ctx, ProtoToVariable(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &runtimeconfigpb.PlanRuntimeconfigVariableResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteVariable handles the gRPC request by passing it to the underlying Variable Delete() method.
 func (s *VariableServer) DeleteRuntimeconfigVariable(ctx context.Context, request *runtimeconfigpb.DeleteRuntimeconfigVariableRequest) (*emptypb.Empty, error) {
 
@@ -90,20 +144,32 @@ func (s *VariableServer) ListRuntimeconfigVariable(ctx context.Context, request
 		return nil, err
 	}
 
-	resources, err := cl.ListVariable(ctx, request.Project, request.RuntimeConfig)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = runtimeconfig.VariableMaxPage
+	}
+	resources, err := cl.ListVariableWithPageToken(ctx, request.Project, request.RuntimeConfig, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*runtimeconfigpb.RuntimeconfigVariable
-	for _, r := range resources.Items {
-		rp := VariableToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, VariableToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &runtimeconfigpb.ListRuntimeconfigVariableResponse{Items: protos}, nil
+	return &runtimeconfigpb.ListRuntimeconfigVariableResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigVariable(ctx context.Context, service_account_file string) (*runtimeconfig.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return runtimeconfig.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/servicemanagement/alpha/servicemanagement_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/servicemanagement/alpha"
@@ -45,7 +46,11 @@ func ManagedServiceToProto(resource *alpha.ManagedService) *alphapb.Servicemanag
 // applyManagedService handles the gRPC request by passing it to the underlying ManagedService Apply() method.
 func (s *ManagedServiceServer) applyManagedService(ctx context.Context, c *alpha.Client, request *alphapb.ApplyServicemanagementAlphaManagedServiceRequest) (*alphapb.ServicemanagementAlphaManagedService, error) {
 	p := ProtoToManagedService(request.GetResource())
-	res, err := c.ApplyManagedService(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyManagedService(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +67,60 @@ func (s *ManagedServiceServer) ApplyServicemanagementAlphaManagedService(ctx con
 	return s.applyManagedService(ctx, cl, request)
 }
 
+// GetServicemanagementAlphaManagedService handles the gRPC request by passing it to the underlying ManagedService Get() method.
+func (s *ManagedServiceServer) GetServicemanagementAlphaManagedService(ctx context.Context, request *alphapb.GetServicemanagementAlphaManagedServiceRequest) (*alphapb.ServicemanagementAlphaManagedService, error) {
+	cl, err := createConfigManagedService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetManagedService(ctx, ProtoToManagedService(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ManagedServiceToProto(res), nil
+}
+
+// HasDiffServicemanagementAlphaManagedService handles the gRPC request by passing it to the underlying ManagedService Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ManagedServiceServer) HasDiffServicemanagementAlphaManagedService(ctx context.Context, request *alphapb.HasDiffServicemanagementAlphaManagedServiceRequest) (*alphapb.HasDiffServicemanagementAlphaManagedServiceResponse, error) {
+	cl, err := createConfigManagedService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffManagedService(ctx, ProtoToManagedService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffServicemanagementAlphaManagedServiceResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanServicemanagementAlphaManagedService handles the gRPC request by passing it to the underlying ManagedService Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ManagedServiceServer) PlanServicemanagementAlphaManagedService(ctx context.Context, request *alphapb.PlanServicemanagementAlphaManagedServiceRequest) (*alphapb.PlanServicemanagementAlphaManagedServiceResponse, error) {
+	cl, err := createConfigManagedService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffManagedService(ctx, ProtoToManagedService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanServicemanagementAlphaManagedServiceResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteManagedService handles the gRPC request by passing it to the underlying ManagedService Delete() method.
 func (s *ManagedServiceServer) DeleteServicemanagementAlphaManagedService(ctx context.Context, request *alphapb.DeleteServicemanagementAlphaManagedServiceRequest) (*emptypb.Empty, error) {
 
@@ -80,22 +139,35 @@ func (s *ManagedServiceServer) ListServicemanagementAlphaManagedService(ctx cont
 		return nil, err
 	}
 
-	resources, err := cl.ListManagedService(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ManagedServiceMaxPage
+	}
+	resources, err := cl.ListManagedServiceWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.ServicemanagementAlphaManagedService
-	for _, r := range resources.Items {
-		rp := ManagedServiceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ManagedServiceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListServicemanagementAlphaManagedServiceResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigManagedService(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
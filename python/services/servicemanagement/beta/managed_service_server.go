@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/servicemanagement/beta/servicemanagement_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/servicemanagement/beta"
@@ -45,7 +46,11 @@ func ManagedServiceToProto(resource *beta.ManagedService) *betapb.Servicemanagem
 // applyManagedService handles the gRPC request by passing it to the underlying ManagedService Apply() method.
 func (s *ManagedServiceServer) applyManagedService(ctx context.Context, c *beta.Client, request *betapb.ApplyServicemanagementBetaManagedServiceRequest) (*betapb.ServicemanagementBetaManagedService, error) {
 	p := ProtoToManagedService(request.GetResource())
-	res, err := c.ApplyManagedService(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyManagedService(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +67,60 @@ func (s *ManagedServiceServer) ApplyServicemanagementBetaManagedService(ctx cont
 	return s.applyManagedService(ctx, cl, request)
 }
 
+// GetServicemanagementBetaManagedService handles the gRPC request by passing it to the underlying ManagedService Get() method.
+func (s *ManagedServiceServer) GetServicemanagementBetaManagedService(ctx context.Context, request *betapb.GetServicemanagementBetaManagedServiceRequest) (*betapb.ServicemanagementBetaManagedService, error) {
+	cl, err := createConfigManagedService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetManagedService(ctx, ProtoToManagedService(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ManagedServiceToProto(res), nil
+}
+
+// HasDiffServicemanagementBetaManagedService handles the gRPC request by passing it to the underlying ManagedService Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ManagedServiceServer) HasDiffServicemanagementBetaManagedService(ctx context.Context, request *betapb.HasDiffServicemanagementBetaManagedServiceRequest) (*betapb.HasDiffServicemanagementBetaManagedServiceResponse, error) {
+	cl, err := createConfigManagedService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffManagedService(ctx, ProtoToManagedService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffServicemanagementBetaManagedServiceResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanServicemanagementBetaManagedService handles the gRPC request by passing it to the underlying ManagedService Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ManagedServiceServer) PlanServicemanagementBetaManagedService(ctx context.Context, request *betapb.PlanServicemanagementBetaManagedServiceRequest) (*betapb.PlanServicemanagementBetaManagedServiceResponse, error) {
+	cl, err := createConfigManagedService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffManagedService(ctx, ProtoToManagedService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanServicemanagementBetaManagedServiceResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteManagedService handles the gRPC request by passing it to the underlying ManagedService Delete() method.
 func (s *ManagedServiceServer) DeleteServicemanagementBetaManagedService(ctx context.Context, request *betapb.DeleteServicemanagementBetaManagedServiceRequest) (*emptypb.Empty, error) {
 
@@ -80,22 +139,35 @@ func (s *ManagedServiceServer) ListServicemanagementBetaManagedService(ctx conte
 		return nil, err
 	}
 
-	resources, err := cl.ListManagedService(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ManagedServiceMaxPage
+	}
+	resources, err := cl.ListManagedServiceWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.ServicemanagementBetaManagedService
-	for _, r := range resources.Items {
-		rp := ManagedServiceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ManagedServiceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListServicemanagementBetaManagedServiceResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigManagedService(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	servicemanagementpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/servicemanagement/servicemanagement_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/servicemanagement"
@@ -45,7 +46,11 @@ func ManagedServiceToProto(resource *servicemanagement.ManagedService) *servicem
 // applyManagedService handles the gRPC request by passing it to the underlying ManagedService Apply() method.
 func (s *ManagedServiceServer) applyManagedService(ctx context.Context, c *servicemanagement.Client, request *servicemanagementpb.ApplyServicemanagementManagedServiceRequest) (*servicemanagementpb.ServicemanagementManagedService, error) {
 	p := ProtoToManagedService(request.GetResource())
-	res, err := c.ApplyManagedService(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyManagedService(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +67,60 @@ func (s *ManagedServiceServer) ApplyServicemanagementManagedService(ctx context.
 	return s.applyManagedService(ctx, cl, request)
 }
 
+// GetServicemanagementManagedService handles the gRPC request by passing it to the underlying ManagedService Get() method.
+func (s *ManagedServiceServer) GetServicemanagementManagedService(ctx context.Context, request *servicemanagementpb.GetServicemanagementManagedServiceRequest) (*servicemanagementpb.ServicemanagementManagedService, error) {
+	cl, err := createConfigManagedService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetManagedService(ctx, ProtoToManagedService(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ManagedServiceToProto(res), nil
+}
+
+// HasDiffServicemanagementManagedService handles the gRPC request by passing it to the underlying ManagedService Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ManagedServiceServer) HasDiffServicemanagementManagedService(ctx context.Context, request *servicemanagementpb.HasDiffServicemanagementManagedServiceRequest) (*servicemanagementpb.HasDiffServicemanagementManagedServiceResponse, error) {
+	cl, err := createConfigManagedService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffManagedService(ctx, ProtoToManagedService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &servicemanagementpb.HasDiffServicemanagementManagedServiceResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanServicemanagementManagedService handles the gRPC request by passing it to the underlying ManagedService Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ManagedServiceServer) PlanServicemanagementManagedService(ctx context.Context, request *servicemanagementpb.PlanServicemanagementManagedServiceRequest) (*servicemanagementpb.PlanServicemanagementManagedServiceResponse, error) {
+	cl, err := createConfigManagedService(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffManagedService(ctx, ProtoToManagedService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &servicemanagementpb.PlanServicemanagementManagedServiceResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteManagedService handles the gRPC request by passing it to the underlying ManagedService Delete() method.
 func (s *ManagedServiceServer) DeleteServicemanagementManagedService(ctx context.Context, request *servicemanagementpb.DeleteServicemanagementManagedServiceRequest) (*emptypb.Empty, error) {
 
@@ -80,22 +139,35 @@ func (s *ManagedServiceServer) ListServicemanagementManagedService(ctx context.C
 		return nil, err
 	}
 
-	resources, err := cl.ListManagedService(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = servicemanagement.ManagedServiceMaxPage
+	}
+	resources, err := cl.ListManagedServiceWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*servicemanagementpb.ServicemanagementManagedService
-	for _, r := range resources.Items {
-		rp := ManagedServiceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ManagedServiceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &servicemanagementpb.ListServicemanagementManagedServiceResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigManagedService(ctx context.Context, service_account_file string) (*servicemanagement.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return servicemanagement.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	servicemanagementpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/servicemanagement/servicemanagement_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/servicemanagement"
@@ -47,7 +48,11 @@ func ServiceToProto(resource *servicemanagement.Service) *servicemanagementpb.Se
 // ApplyService handles the gRPC request by passing it to the underlying Service Apply() method.
 func (s *ServiceServer) applyService(ctx context.Context, c *servicemanagement.Client, request *servicemanagementpb.ApplyServicemanagementServiceRequest) (*servicemanagementpb.ServicemanagementService, error) {
 	p := ProtoToService(request.GetResource())
-	res, err := c.ApplyService(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyService(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +69,55 @@ func (s *ServiceServer) ApplyServicemanagementService(ctx context.Context, reque
 	return s.applyService(ctx, cl, request)
 }
 
+// GetServicemanagementService handles the gRPC request by passing it to the underlying Service Get() method.
+func (s *ServiceServer) GetServicemanagementService(ctx context.Context, request *servicemanagementpb.GetServicemanagementServiceRequest) (*servicemanagementpb.ServicemanagementService, error) {
+	cl, err := createConfigService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetService(ctx, ProtoToService(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceToProto(res), nil
+}
+
+// HasDiffServicemanagementService handles the gRPC request by passing it to the underlying Service Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceServer) HasDiffServicemanagementService(ctx context.Context, request *servicemanagementpb.HasDiffServicemanagementServiceRequest) (*servicemanagementpb.HasDiffServicemanagementServiceResponse, error) {
+	cl, err := createConfigService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffService(ctx, ProtoToService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &servicemanagementpb.HasDiffServicemanagementServiceResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanServicemanagementService handles the gRPC request by passing it to the underlying Service Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceServer) PlanServicemanagementService(ctx context.Context, request *servicemanagementpb.PlanServicemanagementServiceRequest) (*servicemanagementpb.PlanServicemanagementServiceResponse, error) {
+	cl, err := createConfigService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffService(ctx, ProtoToService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &servicemanagementpb.PlanServicemanagementServiceResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteService handles the gRPC request by passing it to the underlying Service Delete() method.
 func (s *ServiceServer) DeleteServicemanagementService(ctx context.Context, request *servicemanagementpb.DeleteServicemanagementServiceRequest) (*emptypb.Empty, error) {
 
@@ -82,20 +136,32 @@ func (s *ServiceServer) ListServicemanagementService(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListService(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = servicemanagement.ServiceMaxPage
+	}
+	resources, err := cl.ListServiceWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*servicemanagementpb.ServicemanagementService
-	for _, r := range resources.Items {
-		rp := ServiceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &servicemanagementpb.ListServicemanagementServiceResponse{Items: protos}, nil
+	return &servicemanagementpb.ListServicemanagementServiceResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigService(ctx context.Context, service_account_file string) (*servicemanagement.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return servicemanagement.NewClient(conf), nil
 }
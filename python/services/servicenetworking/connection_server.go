@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	servicenetworkingpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/servicenetworking/servicenetworking_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/servicenetworking"
@@ -57,7 +58,11 @@ func ConnectionToProto(resource *servicenetworking.Connection) *servicenetworkin
 // ApplyConnection handles the gRPC request by passing it to the underlying Connection Apply() method.
 func (s *ConnectionServer) applyConnection(ctx context.Context, c *servicenetworking.Client, request *servicenetworkingpb.ApplyServicenetworkingConnectionRequest) (*servicenetworkingpb.ServicenetworkingConnection, error) {
 	p := ProtoToConnection(request.GetResource())
-	res, err := c.ApplyConnection(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyConnection(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +79,55 @@ func (s *ConnectionServer) ApplyServicenetworkingConnection(ctx context.Context,
 	return s.applyConnection(ctx, cl, request)
 }
 
+// GetServicenetworkingConnection handles the gRPC request by passing it to the underlying Connection Get() method.
+func (s *ConnectionServer) GetServicenetworkingConnection(ctx context.Context, request *servicenetworkingpb.GetServicenetworkingConnectionRequest) (*servicenetworkingpb.ServicenetworkingConnection, error) {
+	cl, err := createConfigConnection(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetConnection(ctx, ProtoToConnection(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ConnectionToProto(res), nil
+}
+
+// HasDiffServicenetworkingConnection handles the gRPC request by passing it to the underlying Connection Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ConnectionServer) HasDiffServicenetworkingConnection(ctx context.Context, request *servicenetworkingpb.HasDiffServicenetworkingConnectionRequest) (*servicenetworkingpb.HasDiffServicenetworkingConnectionResponse, error) {
+	cl, err := createConfigConnection(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConnection(ctx, ProtoToConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &servicenetworkingpb.HasDiffServicenetworkingConnectionResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanServicenetworkingConnection handles the gRPC request by passing it to the underlying Connection Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ConnectionServer) PlanServicenetworkingConnection(ctx context.Context, request *servicenetworkingpb.PlanServicenetworkingConnectionRequest) (*servicenetworkingpb.PlanServicenetworkingConnectionResponse, error) {
+	cl, err := createConfigConnection(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConnection(ctx, ProtoToConnection(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &servicenetworkingpb.PlanServicenetworkingConnectionResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteConnection handles the gRPC request by passing it to the underlying Connection Delete() method.
 func (s *ConnectionServer) DeleteServicenetworkingConnection(ctx context.Context, request *servicenetworkingpb.DeleteServicenetworkingConnectionRequest) (*emptypb.Empty, error) {
 
@@ -92,20 +146,32 @@ func (s *ConnectionServer) ListServicenetworkingConnection(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListConnection(ctx, request.Project, request.Network, request.Service)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = servicenetworking.ConnectionMaxPage
+	}
+	resources, err := cl.ListConnectionWithPageToken(ctx, request.Project, request.Network, request.Service, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*servicenetworkingpb.ServicenetworkingConnection
-	for _, r := range resources.Items {
-		rp := ConnectionToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ConnectionToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &servicenetworkingpb.ListServicenetworkingConnectionResponse{Items: protos}, nil
+	return &servicenetworkingpb.ListServicenetworkingConnectionResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigConnection(ctx context.Context, service_account_file string) (*servicenetworking.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return servicenetworking.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	serviceusagepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/serviceusage/serviceusage_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/serviceusage"
@@ -72,7 +73,11 @@ func ServiceToProto(resource *serviceusage.Service) *serviceusagepb.Serviceusage
 // ApplyService handles the gRPC request by passing it to the underlying Service Apply() method.
 func (s *ServiceServer) applyService(ctx context.Context, c *serviceusage.Client, request *serviceusagepb.ApplyServiceusageServiceRequest) (*serviceusagepb.ServiceusageService, error) {
 	p := ProtoToService(request.GetResource())
-	res, err := c.ApplyService(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyService(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +94,55 @@ func (s *ServiceServer) ApplyServiceusageService(ctx context.Context, request *s
 	return s.applyService(ctx, cl, request)
 }
 
+// GetServiceusageService handles the gRPC request by passing it to the underlying Service Get() method.
+func (s *ServiceServer) GetServiceusageService(ctx context.Context, request *serviceusagepb.GetServiceusageServiceRequest) (*serviceusagepb.ServiceusageService, error) {
+	cl, err := createConfigService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetService(ctx, ProtoToService(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceToProto(res), nil
+}
+
+// HasDiffServiceusageService handles the gRPC request by passing it to the underlying Service Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceServer) HasDiffServiceusageService(ctx context.Context, request *serviceusagepb.HasDiffServiceusageServiceRequest) (*serviceusagepb.HasDiffServiceusageServiceResponse, error) {
+	cl, err := createConfigService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffService(ctx, ProtoToService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &serviceusagepb.HasDiffServiceusageServiceResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanServiceusageService handles the gRPC request by passing it to the underlying Service Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceServer) PlanServiceusageService(ctx context.Context, request *serviceusagepb.PlanServiceusageServiceRequest) (*serviceusagepb.PlanServiceusageServiceResponse, error) {
+	cl, err := createConfigService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffService(ctx, ProtoToService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &serviceusagepb.PlanServiceusageServiceResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteService handles the gRPC request by passing it to the underlying Service Delete() method.
 func (s *ServiceServer) DeleteServiceusageService(ctx context.Context, request *serviceusagepb.DeleteServiceusageServiceRequest) (*emptypb.Empty, error) {
 
@@ -107,20 +161,32 @@ func (s *ServiceServer) ListServiceusageService(ctx context.Context, request *se
 		return nil, err
 	}
 
-	resources, err := cl.ListService(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = serviceusage.ServiceMaxPage
+	}
+	resources, err := cl.ListServiceWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*serviceusagepb.ServiceusageService
-	for _, r := range resources.Items {
-		rp := ServiceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &serviceusagepb.ListServiceusageServiceResponse{Items: protos}, nil
+	return &serviceusagepb.ListServiceusageServiceResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigService(ctx context.Context, service_account_file string) (*serviceusage.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return serviceusage.NewClient(conf), nil
 }
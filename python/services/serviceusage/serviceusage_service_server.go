@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	serviceusagepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/serviceusage/serviceusage_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/serviceusage"
@@ -72,7 +73,11 @@ func ServiceusageServiceToProto(resource *serviceusage.ServiceusageService) *ser
 // ApplyServiceusageService handles the gRPC request by passing it to the underlying ServiceusageService Apply() method.
 func (s *ServiceusageServiceServer) applyServiceusageService(ctx context.Context, c *serviceusage.Client, request *serviceusagepb.ApplyServiceusageServiceusageServiceRequest) (*serviceusagepb.ServiceusageServiceusageService, error) {
 	p := ProtoToServiceusageService(request.GetResource())
-	res, err := c.ApplyServiceusageService(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyServiceusageService(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +94,55 @@ func (s *ServiceusageServiceServer) ApplyServiceusageServiceusageService(ctx con
 	return s.applyServiceusageService(ctx, cl, request)
 }
 
+// GetServiceusageServiceusageService handles the gRPC request by passing it to the underlying ServiceusageService Get() method.
+func (s *ServiceusageServiceServer) GetServiceusageServiceusageService(ctx context.Context, request *serviceusagepb.GetServiceusageServiceusageServiceRequest) (*serviceusagepb.ServiceusageServiceusageService, error) {
+	cl, err := createConfigServiceusageService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetServiceusageService(ctx, ProtoToServiceusageService(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ServiceusageServiceToProto(res), nil
+}
+
+// HasDiffServiceusageServiceusageService handles the gRPC request by passing it to the underlying ServiceusageService Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ServiceusageServiceServer) HasDiffServiceusageServiceusageService(ctx context.Context, request *serviceusagepb.HasDiffServiceusageServiceusageServiceRequest) (*serviceusagepb.HasDiffServiceusageServiceusageServiceResponse, error) {
+	cl, err := createConfigServiceusageService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceusageService(ctx, ProtoToServiceusageService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &serviceusagepb.HasDiffServiceusageServiceusageServiceResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanServiceusageServiceusageService handles the gRPC request by passing it to the underlying ServiceusageService Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ServiceusageServiceServer) PlanServiceusageServiceusageService(ctx context.Context, request *serviceusagepb.PlanServiceusageServiceusageServiceRequest) (*serviceusagepb.PlanServiceusageServiceusageServiceResponse, error) {
+	cl, err := createConfigServiceusageService(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffServiceusageService(ctx, ProtoToServiceusageService(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &serviceusagepb.PlanServiceusageServiceusageServiceResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteServiceusageService handles the gRPC request by passing it to the underlying ServiceusageService Delete() method.
 func (s *ServiceusageServiceServer) DeleteServiceusageServiceusageService(ctx context.Context, request *serviceusagepb.DeleteServiceusageServiceusageServiceRequest) (*emptypb.Empty, error) {
 
@@ -107,20 +161,32 @@ func (s *ServiceusageServiceServer) ListServiceusageServiceusageService(ctx cont
 		return nil, err
 	}
 
-	resources, err := cl.ListServiceusageService(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = serviceusage.ServiceusageServiceMaxPage
+	}
+	resources, err := cl.ListServiceusageServiceWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*serviceusagepb.ServiceusageServiceusageService
-	for _, r := range resources.Items {
-		rp := ServiceusageServiceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ServiceusageServiceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &serviceusagepb.ListServiceusageServiceusageServiceResponse{Items: protos}, nil
+	return &serviceusagepb.ListServiceusageServiceusageServiceResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigServiceusageService(ctx context.Context, service_account_file string) (*serviceusage.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return serviceusage.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	sourcerepopb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/sourcerepo/sourcerepo_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sourcerepo"
@@ -83,7 +84,11 @@ func RepoToProto(resource *sourcerepo.Repo) *sourcerepopb.SourcerepoRepo {
 // ApplyRepo handles the gRPC request by passing it to the underlying Repo Apply() method.
 func (s *RepoServer) applyRepo(ctx context.Context, c *sourcerepo.Client, request *sourcerepopb.ApplySourcerepoRepoRequest) (*sourcerepopb.SourcerepoRepo, error) {
 	p := ProtoToRepo(request.GetResource())
-	res, err := c.ApplyRepo(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyRepo(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +105,55 @@ func (s *RepoServer) ApplySourcerepoRepo(ctx context.Context, request *sourcerep
 	return s.applyRepo(ctx, cl, request)
 }
 
+// GetSourcerepoRepo handles the gRPC request by passing it to the underlying Repo Get() method.
+func (s *RepoServer) GetSourcerepoRepo(ctx context.Context, request *sourcerepopb.GetSourcerepoRepoRequest) (*sourcerepopb.SourcerepoRepo, error) {
+	cl, err := createConfigRepo(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetRepo(ctx, ProtoToRepo(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return RepoToProto(res), nil
+}
+
+// HasDiffSourcerepoRepo handles the gRPC request by passing it to the underlying Repo Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *RepoServer) HasDiffSourcerepoRepo(ctx context.Context, request *sourcerepopb.HasDiffSourcerepoRepoRequest) (*sourcerepopb.HasDiffSourcerepoRepoResponse, error) {
+	cl, err := createConfigRepo(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRepo(ctx, ProtoToRepo(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &sourcerepopb.HasDiffSourcerepoRepoResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanSourcerepoRepo handles the gRPC request by passing it to the underlying Repo Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *RepoServer) PlanSourcerepoRepo(ctx context.Context, request *sourcerepopb.PlanSourcerepoRepoRequest) (*sourcerepopb.PlanSourcerepoRepoResponse, error) {
+	cl, err := createConfigRepo(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffRepo(ctx, ProtoToRepo(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &sourcerepopb.PlanSourcerepoRepoResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteRepo handles the gRPC request by passing it to the underlying Repo Delete() method.
 func (s *RepoServer) DeleteSourcerepoRepo(ctx context.Context, request *sourcerepopb.DeleteSourcerepoRepoRequest) (*emptypb.Empty, error) {
 
@@ -118,20 +172,32 @@ func (s *RepoServer) ListSourcerepoRepo(ctx context.Context, request *sourcerepo
 		return nil, err
 	}
 
-	resources, err := cl.ListRepo(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = sourcerepo.RepoMaxPage
+	}
+	resources, err := cl.ListRepoWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*sourcerepopb.SourcerepoRepo
-	for _, r := range resources.Items {
-		rp := RepoToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, RepoToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &sourcerepopb.ListSourcerepoRepoResponse{Items: protos}, nil
+	return &sourcerepopb.ListSourcerepoRepoResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigRepo(ctx context.Context, service_account_file string) (*sourcerepo.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return sourcerepo.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	spannerpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/spanner/spanner_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/spanner"
@@ -80,7 +81,11 @@ func DatabaseToProto(resource *spanner.Database) *spannerpb.SpannerDatabase {
 // ApplyDatabase handles the gRPC request by passing it to the underlying Database Apply() method.
 func (s *DatabaseServer) applyDatabase(ctx context.Context, c *spanner.Client, request *spannerpb.ApplySpannerDatabaseRequest) (*spannerpb.SpannerDatabase, error) {
 	p := ProtoToDatabase(request.GetResource())
-	res, err := c.ApplyDatabase(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDatabase(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +102,55 @@ func (s *DatabaseServer) ApplySpannerDatabase(ctx context.Context, request *span
 	return s.applyDatabase(ctx, cl, request)
 }
 
+// GetSpannerDatabase handles the gRPC request by passing it to the underlying Database Get() method.
+func (s *DatabaseServer) GetSpannerDatabase(ctx context.Context, request *spannerpb.GetSpannerDatabaseRequest) (*spannerpb.SpannerDatabase, error) {
+	cl, err := createConfigDatabase(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDatabase(ctx, ProtoToDatabase(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DatabaseToProto(res), nil
+}
+
+// HasDiffSpannerDatabase handles the gRPC request by passing it to the underlying Database Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DatabaseServer) HasDiffSpannerDatabase(ctx context.Context, request *spannerpb.HasDiffSpannerDatabaseRequest) (*spannerpb.HasDiffSpannerDatabaseResponse, error) {
+	cl, err := createConfigDatabase(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDatabase(ctx, ProtoToDatabase(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &spannerpb.HasDiffSpannerDatabaseResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanSpannerDatabase handles the gRPC request by passing it to the underlying Database Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DatabaseServer) PlanSpannerDatabase(ctx context.Context, request *spannerpb.PlanSpannerDatabaseRequest) (*spannerpb.PlanSpannerDatabaseResponse, error) {
+	cl, err := createConfigDatabase(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDatabase(ctx, ProtoToDatabase(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &spannerpb.PlanSpannerDatabaseResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteDatabase handles the gRPC request by passing it to the underlying Database Delete() method.
 func (s *DatabaseServer) DeleteSpannerDatabase(ctx context.Context, request *spannerpb.DeleteSpannerDatabaseRequest) (*emptypb.Empty, error) {
 
@@ -115,20 +169,32 @@ func (s *DatabaseServer) ListSpannerDatabase(ctx context.Context, request *spann
 		return nil, err
 	}
 
-	resources, err := cl.ListDatabase(ctx, request.Project, request.Instance)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = spanner.DatabaseMaxPage
+	}
+	resources, err := cl.ListDatabaseWithPageToken(ctx, request.Project, request.Instance, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*spannerpb.SpannerDatabase
-	for _, r := range resources.Items {
-		rp := DatabaseToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DatabaseToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &spannerpb.ListSpannerDatabaseResponse{Items: protos}, nil
+	return &spannerpb.ListSpannerDatabaseResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigDatabase(ctx context.Context, service_account_file string) (*spanner.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return spanner.NewClient(conf), nil
 }
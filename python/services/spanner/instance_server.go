@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	spannerpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/spanner/spanner_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/spanner"
@@ -78,7 +79,11 @@ func InstanceToProto(resource *spanner.Instance) *spannerpb.SpannerInstance {
 // ApplyInstance handles the gRPC request by passing it to the underlying Instance Apply() method.
 func (s *InstanceServer) applyInstance(ctx context.Context, c *spanner.Client, request *spannerpb.ApplySpannerInstanceRequest) (*spannerpb.SpannerInstance, error) {
 	p := ProtoToInstance(request.GetResource())
-	res, err := c.ApplyInstance(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstance(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +100,55 @@ func (s *InstanceServer) ApplySpannerInstance(ctx context.Context, request *span
 	return s.applyInstance(ctx, cl, request)
 }
 
+// GetSpannerInstance handles the gRPC request by passing it to the underlying Instance Get() method.
+func (s *InstanceServer) GetSpannerInstance(ctx context.Context, request *spannerpb.GetSpannerInstanceRequest) (*spannerpb.SpannerInstance, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstance(ctx, ProtoToInstance(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToProto(res), nil
+}
+
+// HasDiffSpannerInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceServer) HasDiffSpannerInstance(ctx context.Context, request *spannerpb.HasDiffSpannerInstanceRequest) (*spannerpb.HasDiffSpannerInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &spannerpb.HasDiffSpannerInstanceResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanSpannerInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceServer) PlanSpannerInstance(ctx context.Context, request *spannerpb.PlanSpannerInstanceRequest) (*spannerpb.PlanSpannerInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &spannerpb.PlanSpannerInstanceResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteInstance handles the gRPC request by passing it to the underlying Instance Delete() method.
 func (s *InstanceServer) DeleteSpannerInstance(ctx context.Context, request *spannerpb.DeleteSpannerInstanceRequest) (*emptypb.Empty, error) {
 
@@ -113,20 +167,32 @@ func (s *InstanceServer) ListSpannerInstance(ctx context.Context, request *spann
 		return nil, err
 	}
 
-	resources, err := cl.ListInstance(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = spanner.InstanceMaxPage
+	}
+	resources, err := cl.ListInstanceWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*spannerpb.SpannerInstance
-	for _, r := range resources.Items {
-		rp := InstanceToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &spannerpb.ListSpannerInstanceResponse{Items: protos}, nil
+	return &spannerpb.ListSpannerInstanceResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigInstance(ctx context.Context, service_account_file string) (*spanner.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return spanner.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/sql/beta/sql_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql/beta"
@@ -55,7 +56,11 @@ func DatabaseToProto(resource *beta.Database) *betapb.SqlBetaDatabase {
 // ApplyDatabase handles the gRPC request by passing it to the underlying Database Apply() method.
 func (s *DatabaseServer) applyDatabase(ctx context.Context, c *beta.Client, request *betapb.ApplySqlBetaDatabaseRequest) (*betapb.SqlBetaDatabase, error) {
 	p := ProtoToDatabase(request.GetResource())
-	res, err := c.ApplyDatabase(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDatabase(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,55 @@ func (s *DatabaseServer) ApplySqlBetaDatabase(ctx context.Context, request *beta
 	return s.applyDatabase(ctx, cl, request)
 }
 
+// GetSqlBetaDatabase handles the gRPC request by passing it to the underlying Database Get() method.
+func (s *DatabaseServer) GetSqlBetaDatabase(ctx context.Context, request *betapb.GetSqlBetaDatabaseRequest) (*betapb.SqlBetaDatabase, error) {
+	cl, err := createConfigDatabase(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDatabase(ctx, ProtoToDatabase(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DatabaseToProto(res), nil
+}
+
+// HasDiffSqlBetaDatabase handles the gRPC request by passing it to the underlying Database Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DatabaseServer) HasDiffSqlBetaDatabase(ctx context.Context, request *betapb.HasDiffSqlBetaDatabaseRequest) (*betapb.HasDiffSqlBetaDatabaseResponse, error) {
+	cl, err := createConfigDatabase(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDatabase(ctx, ProtoToDatabase(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffSqlBetaDatabaseResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanSqlBetaDatabase handles the gRPC request by passing it to the underlying Database Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DatabaseServer) PlanSqlBetaDatabase(ctx context.Context, request *betapb.PlanSqlBetaDatabaseRequest) (*betapb.PlanSqlBetaDatabaseResponse, error) {
+	cl, err := createConfigDatabase(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDatabase(ctx, ProtoToDatabase(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanSqlBetaDatabaseResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteDatabase handles the gRPC request by passing it to the underlying Database Delete() method.
 func (s *DatabaseServer) DeleteSqlBetaDatabase(ctx context.Context, request *betapb.DeleteSqlBetaDatabaseRequest) (*emptypb.Empty, error) {
 
@@ -90,20 +144,32 @@ func (s *DatabaseServer) ListSqlBetaDatabase(ctx context.Context, request *betap
 		return nil, err
 	}
 
-	resources, err := cl.ListDatabase(ctx, request.Project, request.Instance)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.DatabaseMaxPage
+	}
+	resources, err := cl.ListDatabaseWithPageToken(ctx, request.Project, request.Instance, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.SqlBetaDatabase
-	for _, r := range resources.Items {
-		rp := DatabaseToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DatabaseToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListSqlBetaDatabaseResponse{Items: protos}, nil
+	return &betapb.ListSqlBetaDatabaseResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigDatabase(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
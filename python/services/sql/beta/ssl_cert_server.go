@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/sql/beta/sql_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql/beta"
@@ -59,7 +60,11 @@ func SslCertToProto(resource *beta.SslCert) *betapb.SqlBetaSslCert {
 // ApplySslCert handles the gRPC request by passing it to the underlying SslCert Apply() method.
 func (s *SslCertServer) applySslCert(ctx context.Context, c *beta.Client, request *betapb.ApplySqlBetaSslCertRequest) (*betapb.SqlBetaSslCert, error) {
 	p := ProtoToSslCert(request.GetResource())
-	res, err := c.ApplySslCert(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySslCert(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +81,55 @@ func (s *SslCertServer) ApplySqlBetaSslCert(ctx context.Context, request *betapb
 	return s.applySslCert(ctx, cl, request)
 }
 
+// GetSqlBetaSslCert handles the gRPC request by passing it to the underlying SslCert Get() method.
+func (s *SslCertServer) GetSqlBetaSslCert(ctx context.Context, request *betapb.GetSqlBetaSslCertRequest) (*betapb.SqlBetaSslCert, error) {
+	cl, err := createConfigSslCert(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSslCert(ctx, ProtoToSslCert(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SslCertToProto(res), nil
+}
+
+// HasDiffSqlBetaSslCert handles the gRPC request by passing it to the underlying SslCert Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SslCertServer) HasDiffSqlBetaSslCert(ctx context.Context, request *betapb.HasDiffSqlBetaSslCertRequest) (*betapb.HasDiffSqlBetaSslCertResponse, error) {
+	cl, err := createConfigSslCert(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSslCert(ctx, ProtoToSslCert(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffSqlBetaSslCertResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanSqlBetaSslCert handles the gRPC request by passing it to the underlying SslCert Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SslCertServer) PlanSqlBetaSslCert(ctx context.Context, request *betapb.PlanSqlBetaSslCertRequest) (*betapb.PlanSqlBetaSslCertResponse, error) {
+	cl, err := createConfigSslCert(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSslCert(ctx, ProtoToSslCert(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanSqlBetaSslCertResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteSslCert handles the gRPC request by passing it to the underlying SslCert Delete() method.
 func (s *SslCertServer) DeleteSqlBetaSslCert(ctx context.Context, request *betapb.DeleteSqlBetaSslCertRequest) (*emptypb.Empty, error) {
 
@@ -94,20 +148,32 @@ func (s *SslCertServer) ListSqlBetaSslCert(ctx context.Context, request *betapb.
 		return nil, err
 	}
 
-	resources, err := cl.ListSslCert(ctx, request.Project, request.Instance)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.SslCertMaxPage
+	}
+	resources, err := cl.ListSslCertWithPageToken(ctx, request.Project, request.Instance, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.SqlBetaSslCert
-	for _, r := range resources.Items {
-		rp := SslCertToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SslCertToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListSqlBetaSslCertResponse{Items: protos}, nil
+	return &betapb.ListSqlBetaSslCertResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigSslCert(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/sql/beta/sql_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql/beta"
@@ -110,7 +111,11 @@ func UserToProto(resource *beta.User) *betapb.SqlBetaUser {
 // ApplyUser handles the gRPC request by passing it to the underlying User Apply() method.
 func (s *UserServer) applyUser(ctx context.Context, c *beta.Client, request *betapb.ApplySqlBetaUserRequest) (*betapb.SqlBetaUser, error) {
 	p := ProtoToUser(request.GetResource())
-	res, err := c.ApplyUser(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyUser(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -127,6 +132,55 @@ func (s *UserServer) ApplySqlBetaUser(ctx context.Context, request *betapb.Apply
 	return s.applyUser(ctx, cl, request)
 }
 
+// GetSqlBetaUser handles the gRPC request by passing it to the underlying User Get() method.
+func (s *UserServer) GetSqlBetaUser(ctx context.Context, request *betapb.GetSqlBetaUserRequest) (*betapb.SqlBetaUser, error) {
+	cl, err := createConfigUser(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetUser(ctx, ProtoToUser(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return UserToProto(res), nil
+}
+
+// HasDiffSqlBetaUser handles the gRPC request by passing it to the underlying User Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *UserServer) HasDiffSqlBetaUser(ctx context.Context, request *betapb.HasDiffSqlBetaUserRequest) (*betapb.HasDiffSqlBetaUserResponse, error) {
+	cl, err := createConfigUser(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUser(ctx, ProtoToUser(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.HasDiffSqlBetaUserResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanSqlBetaUser handles the gRPC request by passing it to the underlying User Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *UserServer) PlanSqlBetaUser(ctx context.Context, request *betapb.PlanSqlBetaUserRequest) (*betapb.PlanSqlBetaUserResponse, error) {
+	cl, err := createConfigUser(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUser(ctx, ProtoToUser(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &betapb.PlanSqlBetaUserResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteUser handles the gRPC request by passing it to the underlying User Delete() method.
 func (s *UserServer) DeleteSqlBetaUser(ctx context.Context, request *betapb.DeleteSqlBetaUserRequest) (*emptypb.Empty, error) {
 
@@ -145,20 +199,32 @@ func (s *UserServer) ListSqlBetaUser(ctx context.Context, request *betapb.ListSq
 		return nil, err
 	}
 
-	resources, err := cl.ListUser(ctx, request.Project, request.Instance)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = beta.UserMaxPage
+	}
+	resources, err := cl.ListUserWithPageToken(ctx, request.Project, request.Instance, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.SqlBetaUser
-	for _, r := range resources.Items {
-		rp := UserToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, UserToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &betapb.ListSqlBetaUserResponse{Items: protos}, nil
+	return &betapb.ListSqlBetaUserResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigUser(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	sqlpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/sql/sql_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql"
@@ -55,7 +56,11 @@ func DatabaseToProto(resource *sql.Database) *sqlpb.SqlDatabase {
 // ApplyDatabase handles the gRPC request by passing it to the underlying Database Apply() method.
 func (s *DatabaseServer) applyDatabase(ctx context.Context, c *sql.Client, request *sqlpb.ApplySqlDatabaseRequest) (*sqlpb.SqlDatabase, error) {
 	p := ProtoToDatabase(request.GetResource())
-	res, err := c.ApplyDatabase(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDatabase(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +77,55 @@ func (s *DatabaseServer) ApplySqlDatabase(ctx context.Context, request *sqlpb.Ap
 	return s.applyDatabase(ctx, cl, request)
 }
 
+// GetSqlDatabase handles the gRPC request by passing it to the underlying Database Get() method.
+func (s *DatabaseServer) GetSqlDatabase(ctx context.Context, request *sqlpb.GetSqlDatabaseRequest) (*sqlpb.SqlDatabase, error) {
+	cl, err := createConfigDatabase(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDatabase(ctx, ProtoToDatabase(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DatabaseToProto(res), nil
+}
+
+// HasDiffSqlDatabase handles the gRPC request by passing it to the underlying Database Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DatabaseServer) HasDiffSqlDatabase(ctx context.Context, request *sqlpb.HasDiffSqlDatabaseRequest) (*sqlpb.HasDiffSqlDatabaseResponse, error) {
+	cl, err := createConfigDatabase(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDatabase(ctx, ProtoToDatabase(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &sqlpb.HasDiffSqlDatabaseResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanSqlDatabase handles the gRPC request by passing it to the underlying Database Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DatabaseServer) PlanSqlDatabase(ctx context.Context, request *sqlpb.PlanSqlDatabaseRequest) (*sqlpb.PlanSqlDatabaseResponse, error) {
+	cl, err := createConfigDatabase(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDatabase(ctx, ProtoToDatabase(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &sqlpb.PlanSqlDatabaseResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteDatabase handles the gRPC request by passing it to the underlying Database Delete() method.
 func (s *DatabaseServer) DeleteSqlDatabase(ctx context.Context, request *sqlpb.DeleteSqlDatabaseRequest) (*emptypb.Empty, error) {
 	cl, err := createConfigDatabase(ctx, request.ServiceAccountFile)
@@ -88,26 +142,30 @@ func (s *DatabaseServer) ListSqlDatabase(ctx context.Context, request *sqlpb.Lis
 		return nil, err
 	}
 
-	resources, err := cl.ListDatabase(ctx, request.Project, request.Instance)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = sql.DatabaseMaxPage
+	}
+	resources, err := cl.ListDatabaseWithPageToken(ctx, request.Project, request.Instance, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*sqlpb.SqlDatabase
-	for _, r := range resources.Items {
-		rp := DatabaseToProto(r)
-		protos = append(protos, rp)
-	}
-	return &sqlpb.ListSqlDatabaseResponse{Items: protos}, nil
-}
-
-func createConfigDatabase(ctx context.Context, service_account_file string) (*sql.Client, error) {
-
-	client, err := dcl.FromCredentialsFile(ctx, service_account_file)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DatabaseToProto(r))
+		}
+	})
 	if err != nil {
 		return nil, err
 	}
+	return &sqlpb.ListSqlDatabaseResponse{Items: protos, NextPageToken: nextPageToken}, nil
+}
 
-	conf := dcl.NewConfig(client, dcl.WithUserAgent("dcl-test"))
+func createConfigDatabase(ctx context.Context, service_account_file string) (*sql.Client, error) {
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	sqlpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/sql/sql_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql"
@@ -728,7 +729,11 @@ func InstanceToProto(resource *sql.Instance) *sqlpb.SqlInstance {
 // ApplyInstance handles the gRPC request by passing it to the underlying Instance Apply() method.
 func (s *InstanceServer) applyInstance(ctx context.Context, c *sql.Client, request *sqlpb.ApplySqlInstanceRequest) (*sqlpb.SqlInstance, error) {
 	p := ProtoToInstance(request.GetResource())
-	res, err := c.ApplyInstance(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyInstance(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -745,6 +750,55 @@ func (s *InstanceServer) ApplySqlInstance(ctx context.Context, request *sqlpb.Ap
 	return s.applyInstance(ctx, cl, request)
 }
 
+// GetSqlInstance handles the gRPC request by passing it to the underlying Instance Get() method.
+func (s *InstanceServer) GetSqlInstance(ctx context.Context, request *sqlpb.GetSqlInstanceRequest) (*sqlpb.SqlInstance, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetInstance(ctx, ProtoToInstance(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToProto(res), nil
+}
+
+// HasDiffSqlInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *InstanceServer) HasDiffSqlInstance(ctx context.Context, request *sqlpb.HasDiffSqlInstanceRequest) (*sqlpb.HasDiffSqlInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &sqlpb.HasDiffSqlInstanceResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanSqlInstance handles the gRPC request by passing it to the underlying Instance Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *InstanceServer) PlanSqlInstance(ctx context.Context, request *sqlpb.PlanSqlInstanceRequest) (*sqlpb.PlanSqlInstanceResponse, error) {
+	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffInstance(ctx, ProtoToInstance(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &sqlpb.PlanSqlInstanceResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteInstance handles the gRPC request by passing it to the underlying Instance Delete() method.
 func (s *InstanceServer) DeleteSqlInstance(ctx context.Context, request *sqlpb.DeleteSqlInstanceRequest) (*emptypb.Empty, error) {
 	cl, err := createConfigInstance(ctx, request.ServiceAccountFile)
@@ -761,26 +815,30 @@ func (s *InstanceServer) ListSqlInstance(ctx context.Context, request *sqlpb.Lis
 		return nil, err
 	}
 
-	resources, err := cl.ListInstance(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = sql.InstanceMaxPage
+	}
+	resources, err := cl.ListInstanceWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*sqlpb.SqlInstance
-	for _, r := range resources.Items {
-		rp := InstanceToProto(r)
-		protos = append(protos, rp)
-	}
-	return &sqlpb.ListSqlInstanceResponse{Items: protos}, nil
-}
-
-func createConfigInstance(ctx context.Context, service_account_file string) (*sql.Client, error) {
-
-	client, err := dcl.FromCredentialsFile(ctx, service_account_file)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, InstanceToProto(r))
+		}
+	})
 	if err != nil {
 		return nil, err
 	}
+	return &sqlpb.ListSqlInstanceResponse{Items: protos, NextPageToken: nextPageToken}, nil
+}
 
-	conf := dcl.NewConfig(client, dcl.WithUserAgent("dcl-test"))
+func createConfigInstance(ctx context.Context, service_account_file string) (*sql.Client, error) {
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
 	if err != nil {
 		return nil, err
 	}
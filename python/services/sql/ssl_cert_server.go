@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	sqlpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/sql/sql_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql"
@@ -59,7 +60,11 @@ func SslCertToProto(resource *sql.SslCert) *sqlpb.SqlSslCert {
 // ApplySslCert handles the gRPC request by passing it to the underlying SslCert Apply() method.
 func (s *SslCertServer) applySslCert(ctx context.Context, c *sql.Client, request *sqlpb.ApplySqlSslCertRequest) (*sqlpb.SqlSslCert, error) {
 	p := ProtoToSslCert(request.GetResource())
-	res, err := c.ApplySslCert(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplySslCert(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +81,55 @@ func (s *SslCertServer) ApplySqlSslCert(ctx context.Context, request *sqlpb.Appl
 	return s.applySslCert(ctx, cl, request)
 }
 
+// GetSqlSslCert handles the gRPC request by passing it to the underlying SslCert Get() method.
+func (s *SslCertServer) GetSqlSslCert(ctx context.Context, request *sqlpb.GetSqlSslCertRequest) (*sqlpb.SqlSslCert, error) {
+	cl, err := createConfigSslCert(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetSslCert(ctx, ProtoToSslCert(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return SslCertToProto(res), nil
+}
+
+// HasDiffSqlSslCert handles the gRPC request by passing it to the underlying SslCert Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *SslCertServer) HasDiffSqlSslCert(ctx context.Context, request *sqlpb.HasDiffSqlSslCertRequest) (*sqlpb.HasDiffSqlSslCertResponse, error) {
+	cl, err := createConfigSslCert(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSslCert(ctx, ProtoToSslCert(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &sqlpb.HasDiffSqlSslCertResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanSqlSslCert handles the gRPC request by passing it to the underlying SslCert Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *SslCertServer) PlanSqlSslCert(ctx context.Context, request *sqlpb.PlanSqlSslCertRequest) (*sqlpb.PlanSqlSslCertResponse, error) {
+	cl, err := createConfigSslCert(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffSslCert(ctx, ProtoToSslCert(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &sqlpb.PlanSqlSslCertResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteSslCert handles the gRPC request by passing it to the underlying SslCert Delete() method.
 func (s *SslCertServer) DeleteSqlSslCert(ctx context.Context, request *sqlpb.DeleteSqlSslCertRequest) (*emptypb.Empty, error) {
 	cl, err := createConfigSslCert(ctx, request.ServiceAccountFile)
@@ -92,26 +146,30 @@ func (s *SslCertServer) ListSqlSslCert(ctx context.Context, request *sqlpb.ListS
 		return nil, err
 	}
 
-	resources, err := cl.ListSslCert(ctx, request.Project, request.Instance)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = sql.SslCertMaxPage
+	}
+	resources, err := cl.ListSslCertWithPageToken(ctx, request.Project, request.Instance, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*sqlpb.SqlSslCert
-	for _, r := range resources.Items {
-		rp := SslCertToProto(r)
-		protos = append(protos, rp)
-	}
-	return &sqlpb.ListSqlSslCertResponse{Items: protos}, nil
-}
-
-func createConfigSslCert(ctx context.Context, service_account_file string) (*sql.Client, error) {
-
-	client, err := dcl.FromCredentialsFile(ctx, service_account_file)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, SslCertToProto(r))
+		}
+	})
 	if err != nil {
 		return nil, err
 	}
+	return &sqlpb.ListSqlSslCertResponse{Items: protos, NextPageToken: nextPageToken}, nil
+}
 
-	conf := dcl.NewConfig(client, dcl.WithUserAgent("dcl-test"))
+func createConfigSslCert(ctx context.Context, service_account_file string) (*sql.Client, error) {
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	sqlpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/sql/sql_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql"
@@ -110,7 +111,11 @@ func UserToProto(resource *sql.User) *sqlpb.SqlUser {
 // ApplyUser handles the gRPC request by passing it to the underlying User Apply() method.
 func (s *UserServer) applyUser(ctx context.Context, c *sql.Client, request *sqlpb.ApplySqlUserRequest) (*sqlpb.SqlUser, error) {
 	p := ProtoToUser(request.GetResource())
-	res, err := c.ApplyUser(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyUser(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -127,6 +132,55 @@ func (s *UserServer) ApplySqlUser(ctx context.Context, request *sqlpb.ApplySqlUs
 	return s.applyUser(ctx, cl, request)
 }
 
+// GetSqlUser handles the gRPC request by passing it to the underlying User Get() method.
+func (s *UserServer) GetSqlUser(ctx context.Context, request *sqlpb.GetSqlUserRequest) (*sqlpb.SqlUser, error) {
+	cl, err := createConfigUser(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetUser(ctx, ProtoToUser(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return UserToProto(res), nil
+}
+
+// HasDiffSqlUser handles the gRPC request by passing it to the underlying User Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *UserServer) HasDiffSqlUser(ctx context.Context, request *sqlpb.HasDiffSqlUserRequest) (*sqlpb.HasDiffSqlUserResponse, error) {
+	cl, err := createConfigUser(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUser(ctx, ProtoToUser(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &sqlpb.HasDiffSqlUserResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanSqlUser handles the gRPC request by passing it to the underlying User Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *UserServer) PlanSqlUser(ctx context.Context, request *sqlpb.PlanSqlUserRequest) (*sqlpb.PlanSqlUserResponse, error) {
+	cl, err := createConfigUser(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffUser(ctx, ProtoToUser(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &sqlpb.PlanSqlUserResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteUser handles the gRPC request by passing it to the underlying User Delete() method.
 func (s *UserServer) DeleteSqlUser(ctx context.Context, request *sqlpb.DeleteSqlUserRequest) (*emptypb.Empty, error) {
 	cl, err := createConfigUser(ctx, request.ServiceAccountFile)
@@ -143,26 +197,30 @@ func (s *UserServer) ListSqlUser(ctx context.Context, request *sqlpb.ListSqlUser
 		return nil, err
 	}
 
-	resources, err := cl.ListUser(ctx, request.Project, request.Instance)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = sql.UserMaxPage
+	}
+	resources, err := cl.ListUserWithPageToken(ctx, request.Project, request.Instance, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*sqlpb.SqlUser
-	for _, r := range resources.Items {
-		rp := UserToProto(r)
-		protos = append(protos, rp)
-	}
-	return &sqlpb.ListSqlUserResponse{Items: protos}, nil
-}
-
-func createConfigUser(ctx context.Context, service_account_file string) (*sql.Client, error) {
-
-	client, err := dcl.FromCredentialsFile(ctx, service_account_file)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, UserToProto(r))
+		}
+	})
 	if err != nil {
 		return nil, err
 	}
+	return &sqlpb.ListSqlUserResponse{Items: protos, NextPageToken: nextPageToken}, nil
+}
 
-	conf := dcl.NewConfig(client, dcl.WithUserAgent("dcl-test"))
+func createConfigUser(ctx context.Context, service_account_file string) (*sql.Client, error) {
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
 	if err != nil {
 		return nil, err
 	}
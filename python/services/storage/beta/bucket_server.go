@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/storage/beta/storage_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage/beta"
@@ -353,7 +354,11 @@ func BucketToProto(resource *beta.Bucket) *betapb.StorageBetaBucket {
 // applyBucket handles the gRPC request by passing it to the underlying Bucket Apply() method.
 func (s *BucketServer) applyBucket(ctx context.Context, c *beta.Client, request *betapb.ApplyStorageBetaBucketRequest) (*betapb.StorageBetaBucket, error) {
 	p := ProtoToBucket(request.GetResource())
-	res, err := c.ApplyBucket(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBucket(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -370,6 +375,60 @@ func (s *BucketServer) ApplyStorageBetaBucket(ctx context.Context, request *beta
 	return s.applyBucket(ctx, cl, request)
 }
 
+// GetStorageBetaBucket handles the gRPC request by passing it to the underlying Bucket Get() method.
+func (s *BucketServer) GetStorageBetaBucket(ctx context.Context, request *betapb.GetStorageBetaBucketRequest) (*betapb.StorageBetaBucket, error) {
+	cl, err := createConfigBucket(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBucket(ctx, ProtoToBucket(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BucketToProto(res), nil
+}
+
+// HasDiffStorageBetaBucket handles the gRPC request by passing it to the underlying Bucket Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BucketServer) HasDiffStorageBetaBucket(ctx context.Context, request *betapb.HasDiffStorageBetaBucketRequest) (*betapb.HasDiffStorageBetaBucketResponse, error) {
+	cl, err := createConfigBucket(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBucket(ctx, ProtoToBucket(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffStorageBetaBucketResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanStorageBetaBucket handles the gRPC request by passing it to the underlying Bucket Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BucketServer) PlanStorageBetaBucket(ctx context.Context, request *betapb.PlanStorageBetaBucketRequest) (*betapb.PlanStorageBetaBucketResponse, error) {
+	cl, err := createConfigBucket(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBucket(ctx, ProtoToBucket(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanStorageBetaBucketResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteBucket handles the gRPC request by passing it to the underlying Bucket Delete() method.
 func (s *BucketServer) DeleteStorageBetaBucket(ctx context.Context, request *betapb.DeleteStorageBetaBucketRequest) (*emptypb.Empty, error) {
 
@@ -388,22 +447,35 @@ func (s *BucketServer) ListStorageBetaBucket(ctx context.Context, request *betap
 		return nil, err
 	}
 
-	resources, err := cl.ListBucket(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.BucketMaxPage
+	}
+	resources, err := cl.ListBucketWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.StorageBetaBucket
-	for _, r := range resources.Items {
-		rp := BucketToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BucketToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListStorageBetaBucketResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigBucket(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	storagepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/storage/storage_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage"
@@ -353,7 +354,11 @@ func BucketToProto(resource *storage.Bucket) *storagepb.StorageBucket {
 // applyBucket handles the gRPC request by passing it to the underlying Bucket Apply() method.
 func (s *BucketServer) applyBucket(ctx context.Context, c *storage.Client, request *storagepb.ApplyStorageBucketRequest) (*storagepb.StorageBucket, error) {
 	p := ProtoToBucket(request.GetResource())
-	res, err := c.ApplyBucket(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyBucket(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -370,6 +375,60 @@ func (s *BucketServer) ApplyStorageBucket(ctx context.Context, request *storagep
 	return s.applyBucket(ctx, cl, request)
 }
 
+// GetStorageBucket handles the gRPC request by passing it to the underlying Bucket Get() method.
+func (s *BucketServer) GetStorageBucket(ctx context.Context, request *storagepb.GetStorageBucketRequest) (*storagepb.StorageBucket, error) {
+	cl, err := createConfigBucket(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetBucket(ctx, ProtoToBucket(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return BucketToProto(res), nil
+}
+
+// HasDiffStorageBucket handles the gRPC request by passing it to the underlying Bucket Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *BucketServer) HasDiffStorageBucket(ctx context.Context, request *storagepb.HasDiffStorageBucketRequest) (*storagepb.HasDiffStorageBucketResponse, error) {
+	cl, err := createConfigBucket(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBucket(ctx, ProtoToBucket(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &storagepb.HasDiffStorageBucketResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanStorageBucket handles the gRPC request by passing it to the underlying Bucket Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *BucketServer) PlanStorageBucket(ctx context.Context, request *storagepb.PlanStorageBucketRequest) (*storagepb.PlanStorageBucketResponse, error) {
+	cl, err := createConfigBucket(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffBucket(ctx, ProtoToBucket(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &storagepb.PlanStorageBucketResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteBucket handles the gRPC request by passing it to the underlying Bucket Delete() method.
 func (s *BucketServer) DeleteStorageBucket(ctx context.Context, request *storagepb.DeleteStorageBucketRequest) (*emptypb.Empty, error) {
 
@@ -388,22 +447,35 @@ func (s *BucketServer) ListStorageBucket(ctx context.Context, request *storagepb
 		return nil, err
 	}
 
-	resources, err := cl.ListBucket(ctx, request.GetProject())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = storage.BucketMaxPage
+	}
+	resources, err := cl.ListBucketWithPageToken(ctx, request.GetProject(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*storagepb.StorageBucket
-	for _, r := range resources.Items {
-		rp := BucketToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, BucketToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &storagepb.ListStorageBucketResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigBucket(ctx context.Context, service_account_file string) (*storage.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return storage.NewClient(conf), nil
 }
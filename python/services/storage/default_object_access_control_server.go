@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	storagepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/storage/storage_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage"
@@ -129,7 +130,11 @@ func DefaultObjectAccessControlToProto(resource *storage.DefaultObjectAccessCont
 // ApplyDefaultObjectAccessControl handles the gRPC request by passing it to the underlying DefaultObjectAccessControl Apply() method.
 func (s *DefaultObjectAccessControlServer) applyDefaultObjectAccessControl(ctx context.Context, c *storage.Client, request *storagepb.ApplyStorageDefaultObjectAccessControlRequest) (*storagepb.StorageDefaultObjectAccessControl, error) {
 	p := ProtoToDefaultObjectAccessControl(request.GetResource())
-	res, err := c.ApplyDefaultObjectAccessControl(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyDefaultObjectAccessControl(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -146,6 +151,55 @@ func (s *DefaultObjectAccessControlServer) ApplyStorageDefaultObjectAccessContro
 	return s.applyDefaultObjectAccessControl(ctx, cl, request)
 }
 
+// GetStorageDefaultObjectAccessControl handles the gRPC request by passing it to the underlying DefaultObjectAccessControl Get() method.
+func (s *DefaultObjectAccessControlServer) GetStorageDefaultObjectAccessControl(ctx context.Context, request *storagepb.GetStorageDefaultObjectAccessControlRequest) (*storagepb.StorageDefaultObjectAccessControl, error) {
+	cl, err := createConfigDefaultObjectAccessControl(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetDefaultObjectAccessControl(ctx, ProtoToDefaultObjectAccessControl(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return DefaultObjectAccessControlToProto(res), nil
+}
+
+// HasDiffStorageDefaultObjectAccessControl handles the gRPC request by passing it to the underlying DefaultObjectAccessControl Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *DefaultObjectAccessControlServer) HasDiffStorageDefaultObjectAccessControl(ctx context.Context, request *storagepb.HasDiffStorageDefaultObjectAccessControlRequest) (*storagepb.HasDiffStorageDefaultObjectAccessControlResponse, error) {
+	cl, err := createConfigDefaultObjectAccessControl(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDefaultObjectAccessControl(ctx, ProtoToDefaultObjectAccessControl(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &storagepb.HasDiffStorageDefaultObjectAccessControlResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanStorageDefaultObjectAccessControl handles the gRPC request by passing it to the underlying DefaultObjectAccessControl Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *DefaultObjectAccessControlServer) PlanStorageDefaultObjectAccessControl(ctx context.Context, request *storagepb.PlanStorageDefaultObjectAccessControlRequest) (*storagepb.PlanStorageDefaultObjectAccessControlResponse, error) {
+	cl, err := createConfigDefaultObjectAccessControl(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffDefaultObjectAccessControl(ctx, ProtoToDefaultObjectAccessControl(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &storagepb.PlanStorageDefaultObjectAccessControlResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteDefaultObjectAccessControl handles the gRPC request by passing it to the underlying DefaultObjectAccessControl Delete() method.
 func (s *DefaultObjectAccessControlServer) DeleteStorageDefaultObjectAccessControl(ctx context.Context, request *storagepb.DeleteStorageDefaultObjectAccessControlRequest) (*emptypb.Empty, error) {
 
@@ -164,20 +218,32 @@ func (s *DefaultObjectAccessControlServer) ListStorageDefaultObjectAccessControl
 		return nil, err
 	}
 
-	resources, err := cl.ListDefaultObjectAccessControl(ctx, request.Project, request.Bucket)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = storage.DefaultObjectAccessControlMaxPage
+	}
+	resources, err := cl.ListDefaultObjectAccessControlWithPageToken(ctx, request.Project, request.Bucket, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*storagepb.StorageDefaultObjectAccessControl
-	for _, r := range resources.Items {
-		rp := DefaultObjectAccessControlToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, DefaultObjectAccessControlToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &storagepb.ListStorageDefaultObjectAccessControlResponse{Items: protos}, nil
+	return &storagepb.ListStorageDefaultObjectAccessControlResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigDefaultObjectAccessControl(ctx context.Context, service_account_file string) (*storage.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return storage.NewClient(conf), nil
 }
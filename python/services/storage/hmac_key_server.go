@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	storagepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/storage/storage_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage"
@@ -80,7 +81,11 @@ func HmacKeyToProto(resource *storage.HmacKey) *storagepb.StorageHmacKey {
 // ApplyHmacKey handles the gRPC request by passing it to the underlying HmacKey Apply() method.
 func (s *HmacKeyServer) applyHmacKey(ctx context.Context, c *storage.Client, request *storagepb.ApplyStorageHmacKeyRequest) (*storagepb.StorageHmacKey, error) {
 	p := ProtoToHmacKey(request.GetResource())
-	res, err := c.ApplyHmacKey(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyHmacKey(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +102,55 @@ func (s *HmacKeyServer) ApplyStorageHmacKey(ctx context.Context, request *storag
 	return s.applyHmacKey(ctx, cl, request)
 }
 
+// GetStorageHmacKey handles the gRPC request by passing it to the underlying HmacKey Get() method.
+func (s *HmacKeyServer) GetStorageHmacKey(ctx context.Context, request *storagepb.GetStorageHmacKeyRequest) (*storagepb.StorageHmacKey, error) {
+	cl, err := createConfigHmacKey(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetHmacKey(ctx, ProtoToHmacKey(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return HmacKeyToProto(res), nil
+}
+
+// HasDiffStorageHmacKey handles the gRPC request by passing it to the underlying HmacKey Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *HmacKeyServer) HasDiffStorageHmacKey(ctx context.Context, request *storagepb.HasDiffStorageHmacKeyRequest) (*storagepb.HasDiffStorageHmacKeyResponse, error) {
+	cl, err := createConfigHmacKey(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHmacKey(ctx, ProtoToHmacKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &storagepb.HasDiffStorageHmacKeyResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanStorageHmacKey handles the gRPC request by passing it to the underlying HmacKey Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *HmacKeyServer) PlanStorageHmacKey(ctx context.Context, request *storagepb.PlanStorageHmacKeyRequest) (*storagepb.PlanStorageHmacKeyResponse, error) {
+	cl, err := createConfigHmacKey(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffHmacKey(ctx, ProtoToHmacKey(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &storagepb.PlanStorageHmacKeyResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteHmacKey handles the gRPC request by passing it to the underlying HmacKey Delete() method.
 func (s *HmacKeyServer) DeleteStorageHmacKey(ctx context.Context, request *storagepb.DeleteStorageHmacKeyRequest) (*emptypb.Empty, error) {
 
@@ -115,20 +169,32 @@ func (s *HmacKeyServer) ListStorageHmacKey(ctx context.Context, request *storage
 		return nil, err
 	}
 
-	resources, err := cl.ListHmacKey(ctx, request.Project)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = storage.HmacKeyMaxPage
+	}
+	resources, err := cl.ListHmacKeyWithPageToken(ctx, request.Project, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*storagepb.StorageHmacKey
-	for _, r := range resources.Items {
-		rp := HmacKeyToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, HmacKeyToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &storagepb.ListStorageHmacKeyResponse{Items: protos}, nil
+	return &storagepb.ListStorageHmacKeyResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigHmacKey(ctx context.Context, service_account_file string) (*storage.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return storage.NewClient(conf), nil
 }
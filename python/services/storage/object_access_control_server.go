@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	storagepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/storage/storage_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage"
@@ -135,7 +136,11 @@ func ObjectAccessControlToProto(resource *storage.ObjectAccessControl) *storagep
 // ApplyObjectAccessControl handles the gRPC request by passing it to the underlying ObjectAccessControl Apply() method.
 func (s *ObjectAccessControlServer) applyObjectAccessControl(ctx context.Context, c *storage.Client, request *storagepb.ApplyStorageObjectAccessControlRequest) (*storagepb.StorageObjectAccessControl, error) {
 	p := ProtoToObjectAccessControl(request.GetResource())
-	res, err := c.ApplyObjectAccessControl(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyObjectAccessControl(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -152,6 +157,55 @@ func (s *ObjectAccessControlServer) ApplyStorageObjectAccessControl(ctx context.
 	return s.applyObjectAccessControl(ctx, cl, request)
 }
 
+// GetStorageObjectAccessControl handles the gRPC request by passing it to the underlying ObjectAccessControl Get() method.
+func (s *ObjectAccessControlServer) GetStorageObjectAccessControl(ctx context.Context, request *storagepb.GetStorageObjectAccessControlRequest) (*storagepb.StorageObjectAccessControl, error) {
+	cl, err := createConfigObjectAccessControl(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetObjectAccessControl(ctx, ProtoToObjectAccessControl(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ObjectAccessControlToProto(res), nil
+}
+
+// HasDiffStorageObjectAccessControl handles the gRPC request by passing it to the underlying ObjectAccessControl Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ObjectAccessControlServer) HasDiffStorageObjectAccessControl(ctx context.Context, request *storagepb.HasDiffStorageObjectAccessControlRequest) (*storagepb.HasDiffStorageObjectAccessControlResponse, error) {
+	cl, err := createConfigObjectAccessControl(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffObjectAccessControl(ctx, ProtoToObjectAccessControl(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &storagepb.HasDiffStorageObjectAccessControlResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanStorageObjectAccessControl handles the gRPC request by passing it to the underlying ObjectAccessControl Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ObjectAccessControlServer) PlanStorageObjectAccessControl(ctx context.Context, request *storagepb.PlanStorageObjectAccessControlRequest) (*storagepb.PlanStorageObjectAccessControlResponse, error) {
+	cl, err := createConfigObjectAccessControl(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffObjectAccessControl(ctx, ProtoToObjectAccessControl(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &storagepb.PlanStorageObjectAccessControlResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteObjectAccessControl handles the gRPC request by passing it to the underlying ObjectAccessControl Delete() method.
 func (s *ObjectAccessControlServer) DeleteStorageObjectAccessControl(ctx context.Context, request *storagepb.DeleteStorageObjectAccessControlRequest) (*emptypb.Empty, error) {
 
@@ -170,20 +224,32 @@ func (s *ObjectAccessControlServer) ListStorageObjectAccessControl(ctx context.C
 		return nil, err
 	}
 
-	resources, err := cl.ListObjectAccessControl(ctx, request.Project, request.Bucket, request.Object)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = storage.ObjectAccessControlMaxPage
+	}
+	resources, err := cl.ListObjectAccessControlWithPageToken(ctx, request.Project, request.Bucket, request.Object, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*storagepb.StorageObjectAccessControl
-	for _, r := range resources.Items {
-		rp := ObjectAccessControlToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ObjectAccessControlToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &storagepb.ListStorageObjectAccessControlResponse{Items: protos}, nil
+	return &storagepb.ListStorageObjectAccessControlResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigObjectAccessControl(ctx context.Context, service_account_file string) (*storage.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return storage.NewClient(conf), nil
 }
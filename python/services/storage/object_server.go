@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	storagepb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/storage/storage_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage"
@@ -145,7 +146,11 @@ func ObjectToProto(resource *storage.Object) *storagepb.StorageObject {
 // ApplyObject handles the gRPC request by passing it to the underlying Object Apply() method.
 func (s *ObjectServer) applyObject(ctx context.Context, c *storage.Client, request *storagepb.ApplyStorageObjectRequest) (*storagepb.StorageObject, error) {
 	p := ProtoToObject(request.GetResource())
-	res, err := c.ApplyObject(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyObject(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +167,55 @@ func (s *ObjectServer) ApplyStorageObject(ctx context.Context, request *storagep
 	return s.applyObject(ctx, cl, request)
 }
 
+// GetStorageObject handles the gRPC request by passing it to the underlying Object Get() method.
+func (s *ObjectServer) GetStorageObject(ctx context.Context, request *storagepb.GetStorageObjectRequest) (*storagepb.StorageObject, error) {
+	cl, err := createConfigObject(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetObject(ctx, ProtoToObject(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ObjectToProto(res), nil
+}
+
+// HasDiffStorageObject handles the gRPC request by passing it to the underlying Object Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ObjectServer) HasDiffStorageObject(ctx context.Context, request *storagepb.HasDiffStorageObjectRequest) (*storagepb.HasDiffStorageObjectResponse, error) {
+	cl, err := createConfigObject(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffObject(ctx, ProtoToObject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &storagepb.HasDiffStorageObjectResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanStorageObject handles the gRPC request by passing it to the underlying Object Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ObjectServer) PlanStorageObject(ctx context.Context, request *storagepb.PlanStorageObjectRequest) (*storagepb.PlanStorageObjectResponse, error) {
+	cl, err := createConfigObject(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffObject(ctx, ProtoToObject(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &storagepb.PlanStorageObjectResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteObject handles the gRPC request by passing it to the underlying Object Delete() method.
 func (s *ObjectServer) DeleteStorageObject(ctx context.Context, request *storagepb.DeleteStorageObjectRequest) (*emptypb.Empty, error) {
 
@@ -180,20 +234,32 @@ func (s *ObjectServer) ListStorageObject(ctx context.Context, request *storagepb
 		return nil, err
 	}
 
-	resources, err := cl.ListObject(ctx, request.Bucket)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = storage.ObjectMaxPage
+	}
+	resources, err := cl.ListObjectWithPageToken(ctx, request.Bucket, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*storagepb.StorageObject
-	for _, r := range resources.Items {
-		rp := ObjectToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ObjectToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &storagepb.ListStorageObjectResponse{Items: protos}, nil
+	return &storagepb.ListStorageObjectResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigObject(ctx context.Context, service_account_file string) (*storage.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return storage.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	tpupb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/tpu/tpu_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/tpu"
@@ -282,7 +283,11 @@ func NodeToProto(resource *tpu.Node) *tpupb.TPUNode {
 // ApplyNode handles the gRPC request by passing it to the underlying Node Apply() method.
 func (s *NodeServer) applyNode(ctx context.Context, c *tpu.Client, request *tpupb.ApplyTPUNodeRequest) (*tpupb.TPUNode, error) {
 	p := ProtoToNode(request.GetResource())
-	res, err := c.ApplyNode(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyNode(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -299,6 +304,55 @@ func (s *NodeServer) ApplyTPUNode(ctx context.Context, request *tpupb.ApplyTPUNo
 	return s.applyNode(ctx, cl, request)
 }
 
+// GetTPUNode handles the gRPC request by passing it to the underlying Node Get() method.
+func (s *NodeServer) GetTPUNode(ctx context.Context, request *tpupb.GetTPUNodeRequest) (*tpupb.TPUNode, error) {
+	cl, err := createConfigNode(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetNode(ctx, ProtoToNode(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return NodeToProto(res), nil
+}
+
+// HasDiffTPUNode handles the gRPC request by passing it to the underlying Node Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *NodeServer) HasDiffTPUNode(ctx context.Context, request *tpupb.HasDiffTPUNodeRequest) (*tpupb.HasDiffTPUNodeResponse, error) {
+	cl, err := createConfigNode(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNode(ctx, ProtoToNode(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &tpupb.HasDiffTPUNodeResponse{HasDiff: err != nil || len(diffs) > 0}, nil
+}
+
+// PlanTPUNode handles the gRPC request by passing it to the underlying Node Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *NodeServer) PlanTPUNode(ctx context.Context, request *tpupb.PlanTPUNodeRequest) (*tpupb.PlanTPUNodeResponse, error) {
+	cl, err := createConfigNode(ctx, request.ServiceAccountFile)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffNode(ctx, ProtoToNode(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	return &tpupb.PlanTPUNodeResponse{Create: err != nil, Diffs: serverconfig.FieldDiffsToProto(diffs)}, nil
+}
+
 // DeleteNode handles the gRPC request by passing it to the underlying Node Delete() method.
 func (s *NodeServer) DeleteTPUNode(ctx context.Context, request *tpupb.DeleteTPUNodeRequest) (*emptypb.Empty, error) {
 
@@ -317,20 +371,32 @@ func (s *NodeServer) ListTPUNode(ctx context.Context, request *tpupb.ListTPUNode
 		return nil, err
 	}
 
-	resources, err := cl.ListNode(ctx, request.Project, request.Location)
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = tpu.NodeMaxPage
+	}
+	resources, err := cl.ListNodeWithPageToken(ctx, request.Project, request.Location, pageSize, request.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	var protos []*tpupb.TPUNode
-	for _, r := range resources.Items {
-		rp := NodeToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.PageSize, resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, NodeToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &tpupb.ListTPUNodeResponse{Items: protos}, nil
+	return &tpupb.ListTPUNodeResponse{Items: protos, NextPageToken: nextPageToken}, nil
 }
 
 func createConfigNode(ctx context.Context, service_account_file string) (*tpu.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return tpu.NewClient(conf), nil
 }
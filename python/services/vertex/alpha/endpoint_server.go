@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertex/alpha/vertex_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertex/alpha"
@@ -296,7 +297,11 @@ func EndpointToProto(resource *alpha.Endpoint) *alphapb.VertexAlphaEndpoint {
 // applyEndpoint handles the gRPC request by passing it to the underlying Endpoint Apply() method.
 func (s *EndpointServer) applyEndpoint(ctx context.Context, c *alpha.Client, request *alphapb.ApplyVertexAlphaEndpointRequest) (*alphapb.VertexAlphaEndpoint, error) {
 	p := ProtoToEndpoint(request.GetResource())
-	res, err := c.ApplyEndpoint(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEndpoint(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -313,6 +318,60 @@ func (s *EndpointServer) ApplyVertexAlphaEndpoint(ctx context.Context, request *
 	return s.applyEndpoint(ctx, cl, request)
 }
 
+// GetVertexAlphaEndpoint handles the gRPC request by passing it to the underlying Endpoint Get() method.
+func (s *EndpointServer) GetVertexAlphaEndpoint(ctx context.Context, request *alphapb.GetVertexAlphaEndpointRequest) (*alphapb.VertexAlphaEndpoint, error) {
+	cl, err := createConfigEndpoint(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEndpoint(ctx, ProtoToEndpoint(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointToProto(res), nil
+}
+
+// HasDiffVertexAlphaEndpoint handles the gRPC request by passing it to the underlying Endpoint Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EndpointServer) HasDiffVertexAlphaEndpoint(ctx context.Context, request *alphapb.HasDiffVertexAlphaEndpointRequest) (*alphapb.HasDiffVertexAlphaEndpointResponse, error) {
+	cl, err := createConfigEndpoint(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpoint(ctx, ProtoToEndpoint(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffVertexAlphaEndpointResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexAlphaEndpoint handles the gRPC request by passing it to the underlying Endpoint Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EndpointServer) PlanVertexAlphaEndpoint(ctx context.Context, request *alphapb.PlanVertexAlphaEndpointRequest) (*alphapb.PlanVertexAlphaEndpointResponse, error) {
+	cl, err := createConfigEndpoint(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpoint(ctx, ProtoToEndpoint(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanVertexAlphaEndpointResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEndpoint handles the gRPC request by passing it to the underlying Endpoint Delete() method.
 func (s *EndpointServer) DeleteVertexAlphaEndpoint(ctx context.Context, request *alphapb.DeleteVertexAlphaEndpointRequest) (*emptypb.Empty, error) {
 
@@ -331,22 +390,35 @@ func (s *EndpointServer) ListVertexAlphaEndpoint(ctx context.Context, request *a
 		return nil, err
 	}
 
-	resources, err := cl.ListEndpoint(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.EndpointMaxPage
+	}
+	resources, err := cl.ListEndpointWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.VertexAlphaEndpoint
-	for _, r := range resources.Items {
-		rp := EndpointToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EndpointToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListVertexAlphaEndpointResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEndpoint(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
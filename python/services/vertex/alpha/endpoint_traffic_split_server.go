@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertex/alpha/vertex_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertex/alpha"
@@ -81,7 +82,11 @@ func EndpointTrafficSplitToProto(resource *alpha.EndpointTrafficSplit) *alphapb.
 // applyEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Apply() method.
 func (s *EndpointTrafficSplitServer) applyEndpointTrafficSplit(ctx context.Context, c *alpha.Client, request *alphapb.ApplyVertexAlphaEndpointTrafficSplitRequest) (*alphapb.VertexAlphaEndpointTrafficSplit, error) {
 	p := ProtoToEndpointTrafficSplit(request.GetResource())
-	res, err := c.ApplyEndpointTrafficSplit(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEndpointTrafficSplit(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +103,60 @@ func (s *EndpointTrafficSplitServer) ApplyVertexAlphaEndpointTrafficSplit(ctx co
 	return s.applyEndpointTrafficSplit(ctx, cl, request)
 }
 
+// GetVertexAlphaEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Get() method.
+func (s *EndpointTrafficSplitServer) GetVertexAlphaEndpointTrafficSplit(ctx context.Context, request *alphapb.GetVertexAlphaEndpointTrafficSplitRequest) (*alphapb.VertexAlphaEndpointTrafficSplit, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointTrafficSplitToProto(res), nil
+}
+
+// HasDiffVertexAlphaEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EndpointTrafficSplitServer) HasDiffVertexAlphaEndpointTrafficSplit(ctx context.Context, request *alphapb.HasDiffVertexAlphaEndpointTrafficSplitRequest) (*alphapb.HasDiffVertexAlphaEndpointTrafficSplitResponse, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffVertexAlphaEndpointTrafficSplitResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexAlphaEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EndpointTrafficSplitServer) PlanVertexAlphaEndpointTrafficSplit(ctx context.Context, request *alphapb.PlanVertexAlphaEndpointTrafficSplitRequest) (*alphapb.PlanVertexAlphaEndpointTrafficSplitResponse, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanVertexAlphaEndpointTrafficSplitResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Delete() method.
 func (s *EndpointTrafficSplitServer) DeleteVertexAlphaEndpointTrafficSplit(ctx context.Context, request *alphapb.DeleteVertexAlphaEndpointTrafficSplitRequest) (*emptypb.Empty, error) {
 
@@ -111,7 +170,9 @@ func (s *EndpointTrafficSplitServer) ListVertexAlphaEndpointTrafficSplit(_ conte
 }
 
 func createConfigEndpointTrafficSplit(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
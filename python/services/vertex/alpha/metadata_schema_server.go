@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertex/alpha/vertex_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertex/alpha"
@@ -81,7 +82,11 @@ func MetadataSchemaToProto(resource *alpha.MetadataSchema) *alphapb.VertexAlphaM
 // applyMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Apply() method.
 func (s *MetadataSchemaServer) applyMetadataSchema(ctx context.Context, c *alpha.Client, request *alphapb.ApplyVertexAlphaMetadataSchemaRequest) (*alphapb.VertexAlphaMetadataSchema, error) {
 	p := ProtoToMetadataSchema(request.GetResource())
-	res, err := c.ApplyMetadataSchema(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMetadataSchema(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +103,60 @@ func (s *MetadataSchemaServer) ApplyVertexAlphaMetadataSchema(ctx context.Contex
 	return s.applyMetadataSchema(ctx, cl, request)
 }
 
+// GetVertexAlphaMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Get() method.
+func (s *MetadataSchemaServer) GetVertexAlphaMetadataSchema(ctx context.Context, request *alphapb.GetVertexAlphaMetadataSchemaRequest) (*alphapb.VertexAlphaMetadataSchema, error) {
+	cl, err := createConfigMetadataSchema(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMetadataSchema(ctx, ProtoToMetadataSchema(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MetadataSchemaToProto(res), nil
+}
+
+// HasDiffVertexAlphaMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MetadataSchemaServer) HasDiffVertexAlphaMetadataSchema(ctx context.Context, request *alphapb.HasDiffVertexAlphaMetadataSchemaRequest) (*alphapb.HasDiffVertexAlphaMetadataSchemaResponse, error) {
+	cl, err := createConfigMetadataSchema(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetadataSchema(ctx, ProtoToMetadataSchema(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffVertexAlphaMetadataSchemaResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexAlphaMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MetadataSchemaServer) PlanVertexAlphaMetadataSchema(ctx context.Context, request *alphapb.PlanVertexAlphaMetadataSchemaRequest) (*alphapb.PlanVertexAlphaMetadataSchemaResponse, error) {
+	cl, err := createConfigMetadataSchema(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetadataSchema(ctx, ProtoToMetadataSchema(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanVertexAlphaMetadataSchemaResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Delete() method.
 func (s *MetadataSchemaServer) DeleteVertexAlphaMetadataSchema(ctx context.Context, request *alphapb.DeleteVertexAlphaMetadataSchemaRequest) (*emptypb.Empty, error) {
 
@@ -112,22 +171,35 @@ func (s *MetadataSchemaServer) ListVertexAlphaMetadataSchema(ctx context.Context
 		return nil, err
 	}
 
-	resources, err := cl.ListMetadataSchema(ctx, request.GetProject(), request.GetLocation(), request.GetMetadataStore())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.MetadataSchemaMaxPage
+	}
+	resources, err := cl.ListMetadataSchemaWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetMetadataStore(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.VertexAlphaMetadataSchema
-	for _, r := range resources.Items {
-		rp := MetadataSchemaToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MetadataSchemaToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListVertexAlphaMetadataSchemaResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMetadataSchema(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
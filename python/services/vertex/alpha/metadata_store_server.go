@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertex/alpha/vertex_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertex/alpha"
@@ -99,7 +100,11 @@ func MetadataStoreToProto(resource *alpha.MetadataStore) *alphapb.VertexAlphaMet
 // applyMetadataStore handles the gRPC request by passing it to the underlying MetadataStore Apply() method.
 func (s *MetadataStoreServer) applyMetadataStore(ctx context.Context, c *alpha.Client, request *alphapb.ApplyVertexAlphaMetadataStoreRequest) (*alphapb.VertexAlphaMetadataStore, error) {
 	p := ProtoToMetadataStore(request.GetResource())
-	res, err := c.ApplyMetadataStore(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMetadataStore(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +121,60 @@ func (s *MetadataStoreServer) ApplyVertexAlphaMetadataStore(ctx context.Context,
 	return s.applyMetadataStore(ctx, cl, request)
 }
 
+// GetVertexAlphaMetadataStore handles the gRPC request by passing it to the underlying MetadataStore Get() method.
+func (s *MetadataStoreServer) GetVertexAlphaMetadataStore(ctx context.Context, request *alphapb.GetVertexAlphaMetadataStoreRequest) (*alphapb.VertexAlphaMetadataStore, error) {
+	cl, err := createConfigMetadataStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMetadataStore(ctx, ProtoToMetadataStore(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MetadataStoreToProto(res), nil
+}
+
+// HasDiffVertexAlphaMetadataStore handles the gRPC request by passing it to the underlying MetadataStore Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MetadataStoreServer) HasDiffVertexAlphaMetadataStore(ctx context.Context, request *alphapb.HasDiffVertexAlphaMetadataStoreRequest) (*alphapb.HasDiffVertexAlphaMetadataStoreResponse, error) {
+	cl, err := createConfigMetadataStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetadataStore(ctx, ProtoToMetadataStore(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffVertexAlphaMetadataStoreResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexAlphaMetadataStore handles the gRPC request by passing it to the underlying MetadataStore Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MetadataStoreServer) PlanVertexAlphaMetadataStore(ctx context.Context, request *alphapb.PlanVertexAlphaMetadataStoreRequest) (*alphapb.PlanVertexAlphaMetadataStoreResponse, error) {
+	cl, err := createConfigMetadataStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetadataStore(ctx, ProtoToMetadataStore(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanVertexAlphaMetadataStoreResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMetadataStore handles the gRPC request by passing it to the underlying MetadataStore Delete() method.
 func (s *MetadataStoreServer) DeleteVertexAlphaMetadataStore(ctx context.Context, request *alphapb.DeleteVertexAlphaMetadataStoreRequest) (*emptypb.Empty, error) {
 
@@ -134,22 +193,35 @@ func (s *MetadataStoreServer) ListVertexAlphaMetadataStore(ctx context.Context,
 		return nil, err
 	}
 
-	resources, err := cl.ListMetadataStore(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.MetadataStoreMaxPage
+	}
+	resources, err := cl.ListMetadataStoreWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.VertexAlphaMetadataStore
-	for _, r := range resources.Items {
-		rp := MetadataStoreToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MetadataStoreToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListVertexAlphaMetadataStoreResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMetadataStore(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
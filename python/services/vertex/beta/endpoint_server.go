@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertex/beta/vertex_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertex/beta"
@@ -296,7 +297,11 @@ func EndpointToProto(resource *beta.Endpoint) *betapb.VertexBetaEndpoint {
 // applyEndpoint handles the gRPC request by passing it to the underlying Endpoint Apply() method.
 func (s *EndpointServer) applyEndpoint(ctx context.Context, c *beta.Client, request *betapb.ApplyVertexBetaEndpointRequest) (*betapb.VertexBetaEndpoint, error) {
 	p := ProtoToEndpoint(request.GetResource())
-	res, err := c.ApplyEndpoint(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEndpoint(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -313,6 +318,60 @@ func (s *EndpointServer) ApplyVertexBetaEndpoint(ctx context.Context, request *b
 	return s.applyEndpoint(ctx, cl, request)
 }
 
+// GetVertexBetaEndpoint handles the gRPC request by passing it to the underlying Endpoint Get() method.
+func (s *EndpointServer) GetVertexBetaEndpoint(ctx context.Context, request *betapb.GetVertexBetaEndpointRequest) (*betapb.VertexBetaEndpoint, error) {
+	cl, err := createConfigEndpoint(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEndpoint(ctx, ProtoToEndpoint(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointToProto(res), nil
+}
+
+// HasDiffVertexBetaEndpoint handles the gRPC request by passing it to the underlying Endpoint Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EndpointServer) HasDiffVertexBetaEndpoint(ctx context.Context, request *betapb.HasDiffVertexBetaEndpointRequest) (*betapb.HasDiffVertexBetaEndpointResponse, error) {
+	cl, err := createConfigEndpoint(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpoint(ctx, ProtoToEndpoint(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffVertexBetaEndpointResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexBetaEndpoint handles the gRPC request by passing it to the underlying Endpoint Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EndpointServer) PlanVertexBetaEndpoint(ctx context.Context, request *betapb.PlanVertexBetaEndpointRequest) (*betapb.PlanVertexBetaEndpointResponse, error) {
+	cl, err := createConfigEndpoint(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpoint(ctx, ProtoToEndpoint(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanVertexBetaEndpointResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEndpoint handles the gRPC request by passing it to the underlying Endpoint Delete() method.
 func (s *EndpointServer) DeleteVertexBetaEndpoint(ctx context.Context, request *betapb.DeleteVertexBetaEndpointRequest) (*emptypb.Empty, error) {
 
@@ -331,22 +390,35 @@ func (s *EndpointServer) ListVertexBetaEndpoint(ctx context.Context, request *be
 		return nil, err
 	}
 
-	resources, err := cl.ListEndpoint(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.EndpointMaxPage
+	}
+	resources, err := cl.ListEndpointWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.VertexBetaEndpoint
-	for _, r := range resources.Items {
-		rp := EndpointToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EndpointToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListVertexBetaEndpointResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEndpoint(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertex/beta/vertex_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertex/beta"
@@ -81,7 +82,11 @@ func EndpointTrafficSplitToProto(resource *beta.EndpointTrafficSplit) *betapb.Ve
 // applyEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Apply() method.
 func (s *EndpointTrafficSplitServer) applyEndpointTrafficSplit(ctx context.Context, c *beta.Client, request *betapb.ApplyVertexBetaEndpointTrafficSplitRequest) (*betapb.VertexBetaEndpointTrafficSplit, error) {
 	p := ProtoToEndpointTrafficSplit(request.GetResource())
-	res, err := c.ApplyEndpointTrafficSplit(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEndpointTrafficSplit(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +103,60 @@ func (s *EndpointTrafficSplitServer) ApplyVertexBetaEndpointTrafficSplit(ctx con
 	return s.applyEndpointTrafficSplit(ctx, cl, request)
 }
 
+// GetVertexBetaEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Get() method.
+func (s *EndpointTrafficSplitServer) GetVertexBetaEndpointTrafficSplit(ctx context.Context, request *betapb.GetVertexBetaEndpointTrafficSplitRequest) (*betapb.VertexBetaEndpointTrafficSplit, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointTrafficSplitToProto(res), nil
+}
+
+// HasDiffVertexBetaEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EndpointTrafficSplitServer) HasDiffVertexBetaEndpointTrafficSplit(ctx context.Context, request *betapb.HasDiffVertexBetaEndpointTrafficSplitRequest) (*betapb.HasDiffVertexBetaEndpointTrafficSplitResponse, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffVertexBetaEndpointTrafficSplitResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexBetaEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EndpointTrafficSplitServer) PlanVertexBetaEndpointTrafficSplit(ctx context.Context, request *betapb.PlanVertexBetaEndpointTrafficSplitRequest) (*betapb.PlanVertexBetaEndpointTrafficSplitResponse, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanVertexBetaEndpointTrafficSplitResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Delete() method.
 func (s *EndpointTrafficSplitServer) DeleteVertexBetaEndpointTrafficSplit(ctx context.Context, request *betapb.DeleteVertexBetaEndpointTrafficSplitRequest) (*emptypb.Empty, error) {
 
@@ -111,7 +170,9 @@ func (s *EndpointTrafficSplitServer) ListVertexBetaEndpointTrafficSplit(_ contex
 }
 
 func createConfigEndpointTrafficSplit(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
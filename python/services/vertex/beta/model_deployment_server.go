@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertex/beta/vertex_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertex/beta"
@@ -99,7 +100,11 @@ func ModelDeploymentToProto(resource *beta.ModelDeployment) *betapb.VertexBetaMo
 // applyModelDeployment handles the gRPC request by passing it to the underlying ModelDeployment Apply() method.
 func (s *ModelDeploymentServer) applyModelDeployment(ctx context.Context, c *beta.Client, request *betapb.ApplyVertexBetaModelDeploymentRequest) (*betapb.VertexBetaModelDeployment, error) {
 	p := ProtoToModelDeployment(request.GetResource())
-	res, err := c.ApplyModelDeployment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyModelDeployment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +121,60 @@ func (s *ModelDeploymentServer) ApplyVertexBetaModelDeployment(ctx context.Conte
 	return s.applyModelDeployment(ctx, cl, request)
 }
 
+// GetVertexBetaModelDeployment handles the gRPC request by passing it to the underlying ModelDeployment Get() method.
+func (s *ModelDeploymentServer) GetVertexBetaModelDeployment(ctx context.Context, request *betapb.GetVertexBetaModelDeploymentRequest) (*betapb.VertexBetaModelDeployment, error) {
+	cl, err := createConfigModelDeployment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetModelDeployment(ctx, ProtoToModelDeployment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ModelDeploymentToProto(res), nil
+}
+
+// HasDiffVertexBetaModelDeployment handles the gRPC request by passing it to the underlying ModelDeployment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ModelDeploymentServer) HasDiffVertexBetaModelDeployment(ctx context.Context, request *betapb.HasDiffVertexBetaModelDeploymentRequest) (*betapb.HasDiffVertexBetaModelDeploymentResponse, error) {
+	cl, err := createConfigModelDeployment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffModelDeployment(ctx, ProtoToModelDeployment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffVertexBetaModelDeploymentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexBetaModelDeployment handles the gRPC request by passing it to the underlying ModelDeployment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ModelDeploymentServer) PlanVertexBetaModelDeployment(ctx context.Context, request *betapb.PlanVertexBetaModelDeploymentRequest) (*betapb.PlanVertexBetaModelDeploymentResponse, error) {
+	cl, err := createConfigModelDeployment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffModelDeployment(ctx, ProtoToModelDeployment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanVertexBetaModelDeploymentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteModelDeployment handles the gRPC request by passing it to the underlying ModelDeployment Delete() method.
 func (s *ModelDeploymentServer) DeleteVertexBetaModelDeployment(ctx context.Context, request *betapb.DeleteVertexBetaModelDeploymentRequest) (*emptypb.Empty, error) {
 
@@ -134,22 +193,35 @@ func (s *ModelDeploymentServer) ListVertexBetaModelDeployment(ctx context.Contex
 		return nil, err
 	}
 
-	resources, err := cl.ListModelDeployment(ctx, request.GetProject(), request.GetLocation(), request.GetEndpoint())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ModelDeploymentMaxPage
+	}
+	resources, err := cl.ListModelDeploymentWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetEndpoint(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.VertexBetaModelDeployment
-	for _, r := range resources.Items {
-		rp := ModelDeploymentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ModelDeploymentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListVertexBetaModelDeploymentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigModelDeployment(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
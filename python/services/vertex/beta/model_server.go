@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertex/beta/vertex_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertex/beta"
@@ -423,7 +424,11 @@ func ModelToProto(resource *beta.Model) *betapb.VertexBetaModel {
 // applyModel handles the gRPC request by passing it to the underlying Model Apply() method.
 func (s *ModelServer) applyModel(ctx context.Context, c *beta.Client, request *betapb.ApplyVertexBetaModelRequest) (*betapb.VertexBetaModel, error) {
 	p := ProtoToModel(request.GetResource())
-	res, err := c.ApplyModel(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyModel(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -440,6 +445,60 @@ func (s *ModelServer) ApplyVertexBetaModel(ctx context.Context, request *betapb.
 	return s.applyModel(ctx, cl, request)
 }
 
+// GetVertexBetaModel handles the gRPC request by passing it to the underlying Model Get() method.
+func (s *ModelServer) GetVertexBetaModel(ctx context.Context, request *betapb.GetVertexBetaModelRequest) (*betapb.VertexBetaModel, error) {
+	cl, err := createConfigModel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetModel(ctx, ProtoToModel(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ModelToProto(res), nil
+}
+
+// HasDiffVertexBetaModel handles the gRPC request by passing it to the underlying Model Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ModelServer) HasDiffVertexBetaModel(ctx context.Context, request *betapb.HasDiffVertexBetaModelRequest) (*betapb.HasDiffVertexBetaModelResponse, error) {
+	cl, err := createConfigModel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffModel(ctx, ProtoToModel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffVertexBetaModelResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexBetaModel handles the gRPC request by passing it to the underlying Model Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ModelServer) PlanVertexBetaModel(ctx context.Context, request *betapb.PlanVertexBetaModelRequest) (*betapb.PlanVertexBetaModelResponse, error) {
+	cl, err := createConfigModel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffModel(ctx, ProtoToModel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanVertexBetaModelResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteModel handles the gRPC request by passing it to the underlying Model Delete() method.
 func (s *ModelServer) DeleteVertexBetaModel(ctx context.Context, request *betapb.DeleteVertexBetaModelRequest) (*emptypb.Empty, error) {
 
@@ -458,22 +517,35 @@ func (s *ModelServer) ListVertexBetaModel(ctx context.Context, request *betapb.L
 		return nil, err
 	}
 
-	resources, err := cl.ListModel(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ModelMaxPage
+	}
+	resources, err := cl.ListModelWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.VertexBetaModel
-	for _, r := range resources.Items {
-		rp := ModelToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ModelToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListVertexBetaModelResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigModel(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
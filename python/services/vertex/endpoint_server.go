@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	vertexpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertex/vertex_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertex"
@@ -292,7 +293,11 @@ func EndpointToProto(resource *vertex.Endpoint) *vertexpb.VertexEndpoint {
 // applyEndpoint handles the gRPC request by passing it to the underlying Endpoint Apply() method.
 func (s *EndpointServer) applyEndpoint(ctx context.Context, c *vertex.Client, request *vertexpb.ApplyVertexEndpointRequest) (*vertexpb.VertexEndpoint, error) {
 	p := ProtoToEndpoint(request.GetResource())
-	res, err := c.ApplyEndpoint(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEndpoint(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -309,6 +314,60 @@ func (s *EndpointServer) ApplyVertexEndpoint(ctx context.Context, request *verte
 	return s.applyEndpoint(ctx, cl, request)
 }
 
+// GetVertexEndpoint handles the gRPC request by passing it to the underlying Endpoint Get() method.
+func (s *EndpointServer) GetVertexEndpoint(ctx context.Context, request *vertexpb.GetVertexEndpointRequest) (*vertexpb.VertexEndpoint, error) {
+	cl, err := createConfigEndpoint(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEndpoint(ctx, ProtoToEndpoint(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointToProto(res), nil
+}
+
+// HasDiffVertexEndpoint handles the gRPC request by passing it to the underlying Endpoint Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EndpointServer) HasDiffVertexEndpoint(ctx context.Context, request *vertexpb.HasDiffVertexEndpointRequest) (*vertexpb.HasDiffVertexEndpointResponse, error) {
+	cl, err := createConfigEndpoint(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpoint(ctx, ProtoToEndpoint(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &vertexpb.HasDiffVertexEndpointResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexEndpoint handles the gRPC request by passing it to the underlying Endpoint Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EndpointServer) PlanVertexEndpoint(ctx context.Context, request *vertexpb.PlanVertexEndpointRequest) (*vertexpb.PlanVertexEndpointResponse, error) {
+	cl, err := createConfigEndpoint(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpoint(ctx, ProtoToEndpoint(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &vertexpb.PlanVertexEndpointResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEndpoint handles the gRPC request by passing it to the underlying Endpoint Delete() method.
 func (s *EndpointServer) DeleteVertexEndpoint(ctx context.Context, request *vertexpb.DeleteVertexEndpointRequest) (*emptypb.Empty, error) {
 
@@ -327,22 +386,35 @@ func (s *EndpointServer) ListVertexEndpoint(ctx context.Context, request *vertex
 		return nil, err
 	}
 
-	resources, err := cl.ListEndpoint(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = vertex.EndpointMaxPage
+	}
+	resources, err := cl.ListEndpointWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*vertexpb.VertexEndpoint
-	for _, r := range resources.Items {
-		rp := EndpointToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EndpointToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &vertexpb.ListVertexEndpointResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEndpoint(ctx context.Context, service_account_file string) (*vertex.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return vertex.NewClient(conf), nil
 }
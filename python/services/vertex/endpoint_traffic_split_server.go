@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	vertexpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertex/vertex_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertex"
@@ -81,7 +82,11 @@ func EndpointTrafficSplitToProto(resource *vertex.EndpointTrafficSplit) *vertexp
 // applyEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Apply() method.
 func (s *EndpointTrafficSplitServer) applyEndpointTrafficSplit(ctx context.Context, c *vertex.Client, request *vertexpb.ApplyVertexEndpointTrafficSplitRequest) (*vertexpb.VertexEndpointTrafficSplit, error) {
 	p := ProtoToEndpointTrafficSplit(request.GetResource())
-	res, err := c.ApplyEndpointTrafficSplit(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEndpointTrafficSplit(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +103,60 @@ func (s *EndpointTrafficSplitServer) ApplyVertexEndpointTrafficSplit(ctx context
 	return s.applyEndpointTrafficSplit(ctx, cl, request)
 }
 
+// GetVertexEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Get() method.
+func (s *EndpointTrafficSplitServer) GetVertexEndpointTrafficSplit(ctx context.Context, request *vertexpb.GetVertexEndpointTrafficSplitRequest) (*vertexpb.VertexEndpointTrafficSplit, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointTrafficSplitToProto(res), nil
+}
+
+// HasDiffVertexEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EndpointTrafficSplitServer) HasDiffVertexEndpointTrafficSplit(ctx context.Context, request *vertexpb.HasDiffVertexEndpointTrafficSplitRequest) (*vertexpb.HasDiffVertexEndpointTrafficSplitResponse, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &vertexpb.HasDiffVertexEndpointTrafficSplitResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EndpointTrafficSplitServer) PlanVertexEndpointTrafficSplit(ctx context.Context, request *vertexpb.PlanVertexEndpointTrafficSplitRequest) (*vertexpb.PlanVertexEndpointTrafficSplitResponse, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &vertexpb.PlanVertexEndpointTrafficSplitResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Delete() method.
 func (s *EndpointTrafficSplitServer) DeleteVertexEndpointTrafficSplit(ctx context.Context, request *vertexpb.DeleteVertexEndpointTrafficSplitRequest) (*emptypb.Empty, error) {
 
@@ -111,7 +170,9 @@ func (s *EndpointTrafficSplitServer) ListVertexEndpointTrafficSplit(_ context.Co
 }
 
 func createConfigEndpointTrafficSplit(ctx context.Context, service_account_file string) (*vertex.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return vertex.NewClient(conf), nil
 }
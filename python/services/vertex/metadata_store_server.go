@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	vertexpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertex/vertex_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertex"
@@ -99,7 +100,11 @@ func MetadataStoreToProto(resource *vertex.MetadataStore) *vertexpb.VertexMetada
 // applyMetadataStore handles the gRPC request by passing it to the underlying MetadataStore Apply() method.
 func (s *MetadataStoreServer) applyMetadataStore(ctx context.Context, c *vertex.Client, request *vertexpb.ApplyVertexMetadataStoreRequest) (*vertexpb.VertexMetadataStore, error) {
 	p := ProtoToMetadataStore(request.GetResource())
-	res, err := c.ApplyMetadataStore(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMetadataStore(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +121,60 @@ func (s *MetadataStoreServer) ApplyVertexMetadataStore(ctx context.Context, requ
 	return s.applyMetadataStore(ctx, cl, request)
 }
 
+// GetVertexMetadataStore handles the gRPC request by passing it to the underlying MetadataStore Get() method.
+func (s *MetadataStoreServer) GetVertexMetadataStore(ctx context.Context, request *vertexpb.GetVertexMetadataStoreRequest) (*vertexpb.VertexMetadataStore, error) {
+	cl, err := createConfigMetadataStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMetadataStore(ctx, ProtoToMetadataStore(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MetadataStoreToProto(res), nil
+}
+
+// HasDiffVertexMetadataStore handles the gRPC request by passing it to the underlying MetadataStore Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MetadataStoreServer) HasDiffVertexMetadataStore(ctx context.Context, request *vertexpb.HasDiffVertexMetadataStoreRequest) (*vertexpb.HasDiffVertexMetadataStoreResponse, error) {
+	cl, err := createConfigMetadataStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetadataStore(ctx, ProtoToMetadataStore(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &vertexpb.HasDiffVertexMetadataStoreResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexMetadataStore handles the gRPC request by passing it to the underlying MetadataStore Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MetadataStoreServer) PlanVertexMetadataStore(ctx context.Context, request *vertexpb.PlanVertexMetadataStoreRequest) (*vertexpb.PlanVertexMetadataStoreResponse, error) {
+	cl, err := createConfigMetadataStore(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetadataStore(ctx, ProtoToMetadataStore(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &vertexpb.PlanVertexMetadataStoreResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMetadataStore handles the gRPC request by passing it to the underlying MetadataStore Delete() method.
 func (s *MetadataStoreServer) DeleteVertexMetadataStore(ctx context.Context, request *vertexpb.DeleteVertexMetadataStoreRequest) (*emptypb.Empty, error) {
 
@@ -134,22 +193,35 @@ func (s *MetadataStoreServer) ListVertexMetadataStore(ctx context.Context, reque
 		return nil, err
 	}
 
-	resources, err := cl.ListMetadataStore(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = vertex.MetadataStoreMaxPage
+	}
+	resources, err := cl.ListMetadataStoreWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*vertexpb.VertexMetadataStore
-	for _, r := range resources.Items {
-		rp := MetadataStoreToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MetadataStoreToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &vertexpb.ListVertexMetadataStoreResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMetadataStore(ctx context.Context, service_account_file string) (*vertex.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return vertex.NewClient(conf), nil
 }
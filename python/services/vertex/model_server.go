@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	vertexpb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertex/vertex_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertex"
@@ -361,7 +362,11 @@ func ModelToProto(resource *vertex.Model) *vertexpb.VertexModel {
 // applyModel handles the gRPC request by passing it to the underlying Model Apply() method.
 func (s *ModelServer) applyModel(ctx context.Context, c *vertex.Client, request *vertexpb.ApplyVertexModelRequest) (*vertexpb.VertexModel, error) {
 	p := ProtoToModel(request.GetResource())
-	res, err := c.ApplyModel(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyModel(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -378,6 +383,60 @@ func (s *ModelServer) ApplyVertexModel(ctx context.Context, request *vertexpb.Ap
 	return s.applyModel(ctx, cl, request)
 }
 
+// GetVertexModel handles the gRPC request by passing it to the underlying Model Get() method.
+func (s *ModelServer) GetVertexModel(ctx context.Context, request *vertexpb.GetVertexModelRequest) (*vertexpb.VertexModel, error) {
+	cl, err := createConfigModel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetModel(ctx, ProtoToModel(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ModelToProto(res), nil
+}
+
+// HasDiffVertexModel handles the gRPC request by passing it to the underlying Model Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ModelServer) HasDiffVertexModel(ctx context.Context, request *vertexpb.HasDiffVertexModelRequest) (*vertexpb.HasDiffVertexModelResponse, error) {
+	cl, err := createConfigModel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffModel(ctx, ProtoToModel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &vertexpb.HasDiffVertexModelResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexModel handles the gRPC request by passing it to the underlying Model Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ModelServer) PlanVertexModel(ctx context.Context, request *vertexpb.PlanVertexModelRequest) (*vertexpb.PlanVertexModelResponse, error) {
+	cl, err := createConfigModel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffModel(ctx, ProtoToModel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &vertexpb.PlanVertexModelResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteModel handles the gRPC request by passing it to the underlying Model Delete() method.
 func (s *ModelServer) DeleteVertexModel(ctx context.Context, request *vertexpb.DeleteVertexModelRequest) (*emptypb.Empty, error) {
 
@@ -396,22 +455,35 @@ func (s *ModelServer) ListVertexModel(ctx context.Context, request *vertexpb.Lis
 		return nil, err
 	}
 
-	resources, err := cl.ListModel(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = vertex.ModelMaxPage
+	}
+	resources, err := cl.ListModelWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*vertexpb.VertexModel
-	for _, r := range resources.Items {
-		rp := ModelToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ModelToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &vertexpb.ListVertexModelResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigModel(ctx context.Context, service_account_file string) (*vertex.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return vertex.NewClient(conf), nil
 }
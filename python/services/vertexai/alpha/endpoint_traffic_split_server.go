@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertexai/alpha/vertexai_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertexai/alpha"
@@ -80,7 +81,11 @@ func EndpointTrafficSplitToProto(resource *alpha.EndpointTrafficSplit) *alphapb.
 // applyEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Apply() method.
 func (s *EndpointTrafficSplitServer) applyEndpointTrafficSplit(ctx context.Context, c *alpha.Client, request *alphapb.ApplyVertexaiAlphaEndpointTrafficSplitRequest) (*alphapb.VertexaiAlphaEndpointTrafficSplit, error) {
 	p := ProtoToEndpointTrafficSplit(request.GetResource())
-	res, err := c.ApplyEndpointTrafficSplit(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEndpointTrafficSplit(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +102,60 @@ func (s *EndpointTrafficSplitServer) ApplyVertexaiAlphaEndpointTrafficSplit(ctx
 	return s.applyEndpointTrafficSplit(ctx, cl, request)
 }
 
+// GetVertexaiAlphaEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Get() method.
+func (s *EndpointTrafficSplitServer) GetVertexaiAlphaEndpointTrafficSplit(ctx context.Context, request *alphapb.GetVertexaiAlphaEndpointTrafficSplitRequest) (*alphapb.VertexaiAlphaEndpointTrafficSplit, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointTrafficSplitToProto(res), nil
+}
+
+// HasDiffVertexaiAlphaEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EndpointTrafficSplitServer) HasDiffVertexaiAlphaEndpointTrafficSplit(ctx context.Context, request *alphapb.HasDiffVertexaiAlphaEndpointTrafficSplitRequest) (*alphapb.HasDiffVertexaiAlphaEndpointTrafficSplitResponse, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffVertexaiAlphaEndpointTrafficSplitResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexaiAlphaEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EndpointTrafficSplitServer) PlanVertexaiAlphaEndpointTrafficSplit(ctx context.Context, request *alphapb.PlanVertexaiAlphaEndpointTrafficSplitRequest) (*alphapb.PlanVertexaiAlphaEndpointTrafficSplitResponse, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanVertexaiAlphaEndpointTrafficSplitResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Delete() method.
 func (s *EndpointTrafficSplitServer) DeleteVertexaiAlphaEndpointTrafficSplit(ctx context.Context, request *alphapb.DeleteVertexaiAlphaEndpointTrafficSplitRequest) (*emptypb.Empty, error) {
 
@@ -114,7 +173,9 @@ func (s *EndpointTrafficSplitServer) ListVertexaiAlphaEndpointTrafficSplit(_ con
 }
 
 func createConfigEndpointTrafficSplit(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
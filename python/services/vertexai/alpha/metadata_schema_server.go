@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertexai/alpha/vertexai_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertexai/alpha"
@@ -81,7 +82,11 @@ func MetadataSchemaToProto(resource *alpha.MetadataSchema) *alphapb.VertexaiAlph
 // applyMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Apply() method.
 func (s *MetadataSchemaServer) applyMetadataSchema(ctx context.Context, c *alpha.Client, request *alphapb.ApplyVertexaiAlphaMetadataSchemaRequest) (*alphapb.VertexaiAlphaMetadataSchema, error) {
 	p := ProtoToMetadataSchema(request.GetResource())
-	res, err := c.ApplyMetadataSchema(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMetadataSchema(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +103,60 @@ func (s *MetadataSchemaServer) ApplyVertexaiAlphaMetadataSchema(ctx context.Cont
 	return s.applyMetadataSchema(ctx, cl, request)
 }
 
+// GetVertexaiAlphaMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Get() method.
+func (s *MetadataSchemaServer) GetVertexaiAlphaMetadataSchema(ctx context.Context, request *alphapb.GetVertexaiAlphaMetadataSchemaRequest) (*alphapb.VertexaiAlphaMetadataSchema, error) {
+	cl, err := createConfigMetadataSchema(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMetadataSchema(ctx, ProtoToMetadataSchema(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MetadataSchemaToProto(res), nil
+}
+
+// HasDiffVertexaiAlphaMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MetadataSchemaServer) HasDiffVertexaiAlphaMetadataSchema(ctx context.Context, request *alphapb.HasDiffVertexaiAlphaMetadataSchemaRequest) (*alphapb.HasDiffVertexaiAlphaMetadataSchemaResponse, error) {
+	cl, err := createConfigMetadataSchema(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetadataSchema(ctx, ProtoToMetadataSchema(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffVertexaiAlphaMetadataSchemaResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexaiAlphaMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MetadataSchemaServer) PlanVertexaiAlphaMetadataSchema(ctx context.Context, request *alphapb.PlanVertexaiAlphaMetadataSchemaRequest) (*alphapb.PlanVertexaiAlphaMetadataSchemaResponse, error) {
+	cl, err := createConfigMetadataSchema(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetadataSchema(ctx, ProtoToMetadataSchema(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanVertexaiAlphaMetadataSchemaResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Delete() method.
 func (s *MetadataSchemaServer) DeleteVertexaiAlphaMetadataSchema(ctx context.Context, request *alphapb.DeleteVertexaiAlphaMetadataSchemaRequest) (*emptypb.Empty, error) {
 
@@ -112,22 +171,35 @@ func (s *MetadataSchemaServer) ListVertexaiAlphaMetadataSchema(ctx context.Conte
 		return nil, err
 	}
 
-	resources, err := cl.ListMetadataSchema(ctx, request.GetProject(), request.GetLocation(), request.GetMetadataStore())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.MetadataSchemaMaxPage
+	}
+	resources, err := cl.ListMetadataSchemaWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetMetadataStore(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.VertexaiAlphaMetadataSchema
-	for _, r := range resources.Items {
-		rp := MetadataSchemaToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MetadataSchemaToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListVertexaiAlphaMetadataSchemaResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMetadataSchema(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
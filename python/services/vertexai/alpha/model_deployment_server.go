@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertexai/alpha/vertexai_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertexai/alpha"
@@ -99,7 +100,11 @@ func ModelDeploymentToProto(resource *alpha.ModelDeployment) *alphapb.VertexaiAl
 // applyModelDeployment handles the gRPC request by passing it to the underlying ModelDeployment Apply() method.
 func (s *ModelDeploymentServer) applyModelDeployment(ctx context.Context, c *alpha.Client, request *alphapb.ApplyVertexaiAlphaModelDeploymentRequest) (*alphapb.VertexaiAlphaModelDeployment, error) {
 	p := ProtoToModelDeployment(request.GetResource())
-	res, err := c.ApplyModelDeployment(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyModelDeployment(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +121,60 @@ func (s *ModelDeploymentServer) ApplyVertexaiAlphaModelDeployment(ctx context.Co
 	return s.applyModelDeployment(ctx, cl, request)
 }
 
+// GetVertexaiAlphaModelDeployment handles the gRPC request by passing it to the underlying ModelDeployment Get() method.
+func (s *ModelDeploymentServer) GetVertexaiAlphaModelDeployment(ctx context.Context, request *alphapb.GetVertexaiAlphaModelDeploymentRequest) (*alphapb.VertexaiAlphaModelDeployment, error) {
+	cl, err := createConfigModelDeployment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetModelDeployment(ctx, ProtoToModelDeployment(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ModelDeploymentToProto(res), nil
+}
+
+// HasDiffVertexaiAlphaModelDeployment handles the gRPC request by passing it to the underlying ModelDeployment Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ModelDeploymentServer) HasDiffVertexaiAlphaModelDeployment(ctx context.Context, request *alphapb.HasDiffVertexaiAlphaModelDeploymentRequest) (*alphapb.HasDiffVertexaiAlphaModelDeploymentResponse, error) {
+	cl, err := createConfigModelDeployment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffModelDeployment(ctx, ProtoToModelDeployment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffVertexaiAlphaModelDeploymentResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexaiAlphaModelDeployment handles the gRPC request by passing it to the underlying ModelDeployment Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ModelDeploymentServer) PlanVertexaiAlphaModelDeployment(ctx context.Context, request *alphapb.PlanVertexaiAlphaModelDeploymentRequest) (*alphapb.PlanVertexaiAlphaModelDeploymentResponse, error) {
+	cl, err := createConfigModelDeployment(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffModelDeployment(ctx, ProtoToModelDeployment(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanVertexaiAlphaModelDeploymentResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteModelDeployment handles the gRPC request by passing it to the underlying ModelDeployment Delete() method.
 func (s *ModelDeploymentServer) DeleteVertexaiAlphaModelDeployment(ctx context.Context, request *alphapb.DeleteVertexaiAlphaModelDeploymentRequest) (*emptypb.Empty, error) {
 
@@ -134,22 +193,35 @@ func (s *ModelDeploymentServer) ListVertexaiAlphaModelDeployment(ctx context.Con
 		return nil, err
 	}
 
-	resources, err := cl.ListModelDeployment(ctx, request.GetProject(), request.GetLocation(), request.GetEndpoint())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.ModelDeploymentMaxPage
+	}
+	resources, err := cl.ListModelDeploymentWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetEndpoint(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.VertexaiAlphaModelDeployment
-	for _, r := range resources.Items {
-		rp := ModelDeploymentToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ModelDeploymentToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListVertexaiAlphaModelDeploymentResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigModelDeployment(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertexai/beta/vertexai_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertexai/beta"
@@ -294,7 +295,11 @@ func EndpointToProto(resource *beta.Endpoint) *betapb.VertexaiBetaEndpoint {
 // applyEndpoint handles the gRPC request by passing it to the underlying Endpoint Apply() method.
 func (s *EndpointServer) applyEndpoint(ctx context.Context, c *beta.Client, request *betapb.ApplyVertexaiBetaEndpointRequest) (*betapb.VertexaiBetaEndpoint, error) {
 	p := ProtoToEndpoint(request.GetResource())
-	res, err := c.ApplyEndpoint(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEndpoint(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -311,6 +316,60 @@ func (s *EndpointServer) ApplyVertexaiBetaEndpoint(ctx context.Context, request
 	return s.applyEndpoint(ctx, cl, request)
 }
 
+// GetVertexaiBetaEndpoint handles the gRPC request by passing it to the underlying Endpoint Get() method.
+func (s *EndpointServer) GetVertexaiBetaEndpoint(ctx context.Context, request *betapb.GetVertexaiBetaEndpointRequest) (*betapb.VertexaiBetaEndpoint, error) {
+	cl, err := createConfigEndpoint(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEndpoint(ctx, ProtoToEndpoint(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointToProto(res), nil
+}
+
+// HasDiffVertexaiBetaEndpoint handles the gRPC request by passing it to the underlying Endpoint Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EndpointServer) HasDiffVertexaiBetaEndpoint(ctx context.Context, request *betapb.HasDiffVertexaiBetaEndpointRequest) (*betapb.HasDiffVertexaiBetaEndpointResponse, error) {
+	cl, err := createConfigEndpoint(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpoint(ctx, ProtoToEndpoint(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffVertexaiBetaEndpointResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexaiBetaEndpoint handles the gRPC request by passing it to the underlying Endpoint Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EndpointServer) PlanVertexaiBetaEndpoint(ctx context.Context, request *betapb.PlanVertexaiBetaEndpointRequest) (*betapb.PlanVertexaiBetaEndpointResponse, error) {
+	cl, err := createConfigEndpoint(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpoint(ctx, ProtoToEndpoint(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanVertexaiBetaEndpointResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEndpoint handles the gRPC request by passing it to the underlying Endpoint Delete() method.
 func (s *EndpointServer) DeleteVertexaiBetaEndpoint(ctx context.Context, request *betapb.DeleteVertexaiBetaEndpointRequest) (*emptypb.Empty, error) {
 
@@ -329,22 +388,35 @@ func (s *EndpointServer) ListVertexaiBetaEndpoint(ctx context.Context, request *
 		return nil, err
 	}
 
-	resources, err := cl.ListEndpoint(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.EndpointMaxPage
+	}
+	resources, err := cl.ListEndpointWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.VertexaiBetaEndpoint
-	for _, r := range resources.Items {
-		rp := EndpointToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, EndpointToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListVertexaiBetaEndpointResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigEndpoint(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
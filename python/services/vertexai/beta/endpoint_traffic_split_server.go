@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertexai/beta/vertexai_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertexai/beta"
@@ -80,7 +81,11 @@ func EndpointTrafficSplitToProto(resource *beta.EndpointTrafficSplit) *betapb.Ve
 // applyEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Apply() method.
 func (s *EndpointTrafficSplitServer) applyEndpointTrafficSplit(ctx context.Context, c *beta.Client, request *betapb.ApplyVertexaiBetaEndpointTrafficSplitRequest) (*betapb.VertexaiBetaEndpointTrafficSplit, error) {
 	p := ProtoToEndpointTrafficSplit(request.GetResource())
-	res, err := c.ApplyEndpointTrafficSplit(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyEndpointTrafficSplit(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +102,60 @@ func (s *EndpointTrafficSplitServer) ApplyVertexaiBetaEndpointTrafficSplit(ctx c
 	return s.applyEndpointTrafficSplit(ctx, cl, request)
 }
 
+// GetVertexaiBetaEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Get() method.
+func (s *EndpointTrafficSplitServer) GetVertexaiBetaEndpointTrafficSplit(ctx context.Context, request *betapb.GetVertexaiBetaEndpointTrafficSplitRequest) (*betapb.VertexaiBetaEndpointTrafficSplit, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return EndpointTrafficSplitToProto(res), nil
+}
+
+// HasDiffVertexaiBetaEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *EndpointTrafficSplitServer) HasDiffVertexaiBetaEndpointTrafficSplit(ctx context.Context, request *betapb.HasDiffVertexaiBetaEndpointTrafficSplitRequest) (*betapb.HasDiffVertexaiBetaEndpointTrafficSplitResponse, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffVertexaiBetaEndpointTrafficSplitResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexaiBetaEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *EndpointTrafficSplitServer) PlanVertexaiBetaEndpointTrafficSplit(ctx context.Context, request *betapb.PlanVertexaiBetaEndpointTrafficSplitRequest) (*betapb.PlanVertexaiBetaEndpointTrafficSplitResponse, error) {
+	cl, err := createConfigEndpointTrafficSplit(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffEndpointTrafficSplit(ctx, ProtoToEndpointTrafficSplit(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanVertexaiBetaEndpointTrafficSplitResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteEndpointTrafficSplit handles the gRPC request by passing it to the underlying EndpointTrafficSplit Delete() method.
 func (s *EndpointTrafficSplitServer) DeleteVertexaiBetaEndpointTrafficSplit(ctx context.Context, request *betapb.DeleteVertexaiBetaEndpointTrafficSplitRequest) (*emptypb.Empty, error) {
 
@@ -114,7 +173,9 @@ func (s *EndpointTrafficSplitServer) ListVertexaiBetaEndpointTrafficSplit(_ cont
 }
 
 func createConfigEndpointTrafficSplit(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
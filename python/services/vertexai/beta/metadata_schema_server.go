@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertexai/beta/vertexai_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertexai/beta"
@@ -81,7 +82,11 @@ func MetadataSchemaToProto(resource *beta.MetadataSchema) *betapb.VertexaiBetaMe
 // applyMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Apply() method.
 func (s *MetadataSchemaServer) applyMetadataSchema(ctx context.Context, c *beta.Client, request *betapb.ApplyVertexaiBetaMetadataSchemaRequest) (*betapb.VertexaiBetaMetadataSchema, error) {
 	p := ProtoToMetadataSchema(request.GetResource())
-	res, err := c.ApplyMetadataSchema(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyMetadataSchema(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +103,60 @@ func (s *MetadataSchemaServer) ApplyVertexaiBetaMetadataSchema(ctx context.Conte
 	return s.applyMetadataSchema(ctx, cl, request)
 }
 
+// GetVertexaiBetaMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Get() method.
+func (s *MetadataSchemaServer) GetVertexaiBetaMetadataSchema(ctx context.Context, request *betapb.GetVertexaiBetaMetadataSchemaRequest) (*betapb.VertexaiBetaMetadataSchema, error) {
+	cl, err := createConfigMetadataSchema(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetMetadataSchema(ctx, ProtoToMetadataSchema(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return MetadataSchemaToProto(res), nil
+}
+
+// HasDiffVertexaiBetaMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *MetadataSchemaServer) HasDiffVertexaiBetaMetadataSchema(ctx context.Context, request *betapb.HasDiffVertexaiBetaMetadataSchemaRequest) (*betapb.HasDiffVertexaiBetaMetadataSchemaResponse, error) {
+	cl, err := createConfigMetadataSchema(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetadataSchema(ctx, ProtoToMetadataSchema(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffVertexaiBetaMetadataSchemaResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexaiBetaMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *MetadataSchemaServer) PlanVertexaiBetaMetadataSchema(ctx context.Context, request *betapb.PlanVertexaiBetaMetadataSchemaRequest) (*betapb.PlanVertexaiBetaMetadataSchemaResponse, error) {
+	cl, err := createConfigMetadataSchema(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffMetadataSchema(ctx, ProtoToMetadataSchema(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanVertexaiBetaMetadataSchemaResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteMetadataSchema handles the gRPC request by passing it to the underlying MetadataSchema Delete() method.
 func (s *MetadataSchemaServer) DeleteVertexaiBetaMetadataSchema(ctx context.Context, request *betapb.DeleteVertexaiBetaMetadataSchemaRequest) (*emptypb.Empty, error) {
 
@@ -112,22 +171,35 @@ func (s *MetadataSchemaServer) ListVertexaiBetaMetadataSchema(ctx context.Contex
 		return nil, err
 	}
 
-	resources, err := cl.ListMetadataSchema(ctx, request.GetProject(), request.GetLocation(), request.GetMetadataStore())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.MetadataSchemaMaxPage
+	}
+	resources, err := cl.ListMetadataSchemaWithPageToken(ctx, request.GetProject(), request.GetLocation(), request.GetMetadataStore(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.VertexaiBetaMetadataSchema
-	for _, r := range resources.Items {
-		rp := MetadataSchemaToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, MetadataSchemaToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListVertexaiBetaMetadataSchemaResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigMetadataSchema(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vertexai/beta/vertexai_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vertexai/beta"
@@ -369,7 +370,11 @@ func ModelToProto(resource *beta.Model) *betapb.VertexaiBetaModel {
 // applyModel handles the gRPC request by passing it to the underlying Model Apply() method.
 func (s *ModelServer) applyModel(ctx context.Context, c *beta.Client, request *betapb.ApplyVertexaiBetaModelRequest) (*betapb.VertexaiBetaModel, error) {
 	p := ProtoToModel(request.GetResource())
-	res, err := c.ApplyModel(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyModel(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -386,6 +391,60 @@ func (s *ModelServer) ApplyVertexaiBetaModel(ctx context.Context, request *betap
 	return s.applyModel(ctx, cl, request)
 }
 
+// GetVertexaiBetaModel handles the gRPC request by passing it to the underlying Model Get() method.
+func (s *ModelServer) GetVertexaiBetaModel(ctx context.Context, request *betapb.GetVertexaiBetaModelRequest) (*betapb.VertexaiBetaModel, error) {
+	cl, err := createConfigModel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetModel(ctx, ProtoToModel(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ModelToProto(res), nil
+}
+
+// HasDiffVertexaiBetaModel handles the gRPC request by passing it to the underlying Model Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ModelServer) HasDiffVertexaiBetaModel(ctx context.Context, request *betapb.HasDiffVertexaiBetaModelRequest) (*betapb.HasDiffVertexaiBetaModelResponse, error) {
+	cl, err := createConfigModel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffModel(ctx, ProtoToModel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffVertexaiBetaModelResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVertexaiBetaModel handles the gRPC request by passing it to the underlying Model Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ModelServer) PlanVertexaiBetaModel(ctx context.Context, request *betapb.PlanVertexaiBetaModelRequest) (*betapb.PlanVertexaiBetaModelResponse, error) {
+	cl, err := createConfigModel(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffModel(ctx, ProtoToModel(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanVertexaiBetaModelResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteModel handles the gRPC request by passing it to the underlying Model Delete() method.
 func (s *ModelServer) DeleteVertexaiBetaModel(ctx context.Context, request *betapb.DeleteVertexaiBetaModelRequest) (*emptypb.Empty, error) {
 
@@ -404,22 +463,35 @@ func (s *ModelServer) ListVertexaiBetaModel(ctx context.Context, request *betapb
 		return nil, err
 	}
 
-	resources, err := cl.ListModel(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ModelMaxPage
+	}
+	resources, err := cl.ListModelWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.VertexaiBetaModel
-	for _, r := range resources.Items {
-		rp := ModelToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ModelToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListVertexaiBetaModelResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigModel(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
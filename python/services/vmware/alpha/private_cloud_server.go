@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vmware/alpha/vmware_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vmware/alpha"
@@ -320,7 +321,11 @@ func PrivateCloudToProto(resource *alpha.PrivateCloud) *alphapb.VmwareAlphaPriva
 // applyPrivateCloud handles the gRPC request by passing it to the underlying PrivateCloud Apply() method.
 func (s *PrivateCloudServer) applyPrivateCloud(ctx context.Context, c *alpha.Client, request *alphapb.ApplyVmwareAlphaPrivateCloudRequest) (*alphapb.VmwareAlphaPrivateCloud, error) {
 	p := ProtoToPrivateCloud(request.GetResource())
-	res, err := c.ApplyPrivateCloud(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyPrivateCloud(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -337,6 +342,60 @@ func (s *PrivateCloudServer) ApplyVmwareAlphaPrivateCloud(ctx context.Context, r
 	return s.applyPrivateCloud(ctx, cl, request)
 }
 
+// GetVmwareAlphaPrivateCloud handles the gRPC request by passing it to the underlying PrivateCloud Get() method.
+func (s *PrivateCloudServer) GetVmwareAlphaPrivateCloud(ctx context.Context, request *alphapb.GetVmwareAlphaPrivateCloudRequest) (*alphapb.VmwareAlphaPrivateCloud, error) {
+	cl, err := createConfigPrivateCloud(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetPrivateCloud(ctx, ProtoToPrivateCloud(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return PrivateCloudToProto(res), nil
+}
+
+// HasDiffVmwareAlphaPrivateCloud handles the gRPC request by passing it to the underlying PrivateCloud Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *PrivateCloudServer) HasDiffVmwareAlphaPrivateCloud(ctx context.Context, request *alphapb.HasDiffVmwareAlphaPrivateCloudRequest) (*alphapb.HasDiffVmwareAlphaPrivateCloudResponse, error) {
+	cl, err := createConfigPrivateCloud(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPrivateCloud(ctx, ProtoToPrivateCloud(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffVmwareAlphaPrivateCloudResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVmwareAlphaPrivateCloud handles the gRPC request by passing it to the underlying PrivateCloud Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *PrivateCloudServer) PlanVmwareAlphaPrivateCloud(ctx context.Context, request *alphapb.PlanVmwareAlphaPrivateCloudRequest) (*alphapb.PlanVmwareAlphaPrivateCloudResponse, error) {
+	cl, err := createConfigPrivateCloud(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPrivateCloud(ctx, ProtoToPrivateCloud(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanVmwareAlphaPrivateCloudResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeletePrivateCloud handles the gRPC request by passing it to the underlying PrivateCloud Delete() method.
 func (s *PrivateCloudServer) DeleteVmwareAlphaPrivateCloud(ctx context.Context, request *alphapb.DeleteVmwareAlphaPrivateCloudRequest) (*emptypb.Empty, error) {
 
@@ -355,22 +414,35 @@ func (s *PrivateCloudServer) ListVmwareAlphaPrivateCloud(ctx context.Context, re
 		return nil, err
 	}
 
-	resources, err := cl.ListPrivateCloud(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.PrivateCloudMaxPage
+	}
+	resources, err := cl.ListPrivateCloudWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.VmwareAlphaPrivateCloud
-	for _, r := range resources.Items {
-		rp := PrivateCloudToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, PrivateCloudToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListVmwareAlphaPrivateCloudResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigPrivateCloud(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vmware/alpha/vmware_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vmware/alpha"
@@ -161,7 +162,11 @@ func VmwareEngineNetworkToProto(resource *alpha.VmwareEngineNetwork) *alphapb.Vm
 // applyVmwareEngineNetwork handles the gRPC request by passing it to the underlying VmwareEngineNetwork Apply() method.
 func (s *VmwareEngineNetworkServer) applyVmwareEngineNetwork(ctx context.Context, c *alpha.Client, request *alphapb.ApplyVmwareAlphaVmwareEngineNetworkRequest) (*alphapb.VmwareAlphaVmwareEngineNetwork, error) {
 	p := ProtoToVmwareEngineNetwork(request.GetResource())
-	res, err := c.ApplyVmwareEngineNetwork(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyVmwareEngineNetwork(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -178,6 +183,60 @@ func (s *VmwareEngineNetworkServer) ApplyVmwareAlphaVmwareEngineNetwork(ctx cont
 	return s.applyVmwareEngineNetwork(ctx, cl, request)
 }
 
+// GetVmwareAlphaVmwareEngineNetwork handles the gRPC request by passing it to the underlying VmwareEngineNetwork Get() method.
+func (s *VmwareEngineNetworkServer) GetVmwareAlphaVmwareEngineNetwork(ctx context.Context, request *alphapb.GetVmwareAlphaVmwareEngineNetworkRequest) (*alphapb.VmwareAlphaVmwareEngineNetwork, error) {
+	cl, err := createConfigVmwareEngineNetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetVmwareEngineNetwork(ctx, ProtoToVmwareEngineNetwork(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return VmwareEngineNetworkToProto(res), nil
+}
+
+// HasDiffVmwareAlphaVmwareEngineNetwork handles the gRPC request by passing it to the underlying VmwareEngineNetwork Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *VmwareEngineNetworkServer) HasDiffVmwareAlphaVmwareEngineNetwork(ctx context.Context, request *alphapb.HasDiffVmwareAlphaVmwareEngineNetworkRequest) (*alphapb.HasDiffVmwareAlphaVmwareEngineNetworkResponse, error) {
+	cl, err := createConfigVmwareEngineNetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVmwareEngineNetwork(ctx, ProtoToVmwareEngineNetwork(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffVmwareAlphaVmwareEngineNetworkResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVmwareAlphaVmwareEngineNetwork handles the gRPC request by passing it to the underlying VmwareEngineNetwork Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *VmwareEngineNetworkServer) PlanVmwareAlphaVmwareEngineNetwork(ctx context.Context, request *alphapb.PlanVmwareAlphaVmwareEngineNetworkRequest) (*alphapb.PlanVmwareAlphaVmwareEngineNetworkResponse, error) {
+	cl, err := createConfigVmwareEngineNetwork(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffVmwareEngineNetwork(ctx, ProtoToVmwareEngineNetwork(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanVmwareAlphaVmwareEngineNetworkResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteVmwareEngineNetwork handles the gRPC request by passing it to the underlying VmwareEngineNetwork Delete() method.
 func (s *VmwareEngineNetworkServer) DeleteVmwareAlphaVmwareEngineNetwork(ctx context.Context, request *alphapb.DeleteVmwareAlphaVmwareEngineNetworkRequest) (*emptypb.Empty, error) {
 
@@ -196,22 +255,35 @@ func (s *VmwareEngineNetworkServer) ListVmwareAlphaVmwareEngineNetwork(ctx conte
 		return nil, err
 	}
 
-	resources, err := cl.ListVmwareEngineNetwork(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.VmwareEngineNetworkMaxPage
+	}
+	resources, err := cl.ListVmwareEngineNetworkWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.VmwareAlphaVmwareEngineNetwork
-	for _, r := range resources.Items {
-		rp := VmwareEngineNetworkToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, VmwareEngineNetworkToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListVmwareAlphaVmwareEngineNetworkResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigVmwareEngineNetwork(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	alphapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vmwareengine/alpha/vmwareengine_alpha_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vmwareengine/alpha"
@@ -259,7 +260,11 @@ func PrivateCloudToProto(resource *alpha.PrivateCloud) *alphapb.VmwareengineAlph
 // applyPrivateCloud handles the gRPC request by passing it to the underlying PrivateCloud Apply() method.
 func (s *PrivateCloudServer) applyPrivateCloud(ctx context.Context, c *alpha.Client, request *alphapb.ApplyVmwareengineAlphaPrivateCloudRequest) (*alphapb.VmwareengineAlphaPrivateCloud, error) {
 	p := ProtoToPrivateCloud(request.GetResource())
-	res, err := c.ApplyPrivateCloud(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyPrivateCloud(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -276,6 +281,60 @@ func (s *PrivateCloudServer) ApplyVmwareengineAlphaPrivateCloud(ctx context.Cont
 	return s.applyPrivateCloud(ctx, cl, request)
 }
 
+// GetVmwareengineAlphaPrivateCloud handles the gRPC request by passing it to the underlying PrivateCloud Get() method.
+func (s *PrivateCloudServer) GetVmwareengineAlphaPrivateCloud(ctx context.Context, request *alphapb.GetVmwareengineAlphaPrivateCloudRequest) (*alphapb.VmwareengineAlphaPrivateCloud, error) {
+	cl, err := createConfigPrivateCloud(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetPrivateCloud(ctx, ProtoToPrivateCloud(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return PrivateCloudToProto(res), nil
+}
+
+// HasDiffVmwareengineAlphaPrivateCloud handles the gRPC request by passing it to the underlying PrivateCloud Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *PrivateCloudServer) HasDiffVmwareengineAlphaPrivateCloud(ctx context.Context, request *alphapb.HasDiffVmwareengineAlphaPrivateCloudRequest) (*alphapb.HasDiffVmwareengineAlphaPrivateCloudResponse, error) {
+	cl, err := createConfigPrivateCloud(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPrivateCloud(ctx, ProtoToPrivateCloud(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.HasDiffVmwareengineAlphaPrivateCloudResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVmwareengineAlphaPrivateCloud handles the gRPC request by passing it to the underlying PrivateCloud Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *PrivateCloudServer) PlanVmwareengineAlphaPrivateCloud(ctx context.Context, request *alphapb.PlanVmwareengineAlphaPrivateCloudRequest) (*alphapb.PlanVmwareengineAlphaPrivateCloudResponse, error) {
+	cl, err := createConfigPrivateCloud(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffPrivateCloud(ctx, ProtoToPrivateCloud(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &alphapb.PlanVmwareengineAlphaPrivateCloudResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeletePrivateCloud handles the gRPC request by passing it to the underlying PrivateCloud Delete() method.
 func (s *PrivateCloudServer) DeleteVmwareengineAlphaPrivateCloud(ctx context.Context, request *alphapb.DeleteVmwareengineAlphaPrivateCloudRequest) (*emptypb.Empty, error) {
 
@@ -294,22 +353,35 @@ func (s *PrivateCloudServer) ListVmwareengineAlphaPrivateCloud(ctx context.Conte
 		return nil, err
 	}
 
-	resources, err := cl.ListPrivateCloud(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = alpha.PrivateCloudMaxPage
+	}
+	resources, err := cl.ListPrivateCloudWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*alphapb.VmwareengineAlphaPrivateCloud
-	for _, r := range resources.Items {
-		rp := PrivateCloudToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, PrivateCloudToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &alphapb.ListVmwareengineAlphaPrivateCloudResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigPrivateCloud(ctx context.Context, service_account_file string) (*alpha.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return alpha.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	betapb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vpcaccess/beta/vpcaccess_beta_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vpcaccess/beta"
@@ -119,7 +120,11 @@ func ConnectorToProto(resource *beta.Connector) *betapb.VpcaccessBetaConnector {
 // applyConnector handles the gRPC request by passing it to the underlying Connector Apply() method.
 func (s *ConnectorServer) applyConnector(ctx context.Context, c *beta.Client, request *betapb.ApplyVpcaccessBetaConnectorRequest) (*betapb.VpcaccessBetaConnector, error) {
 	p := ProtoToConnector(request.GetResource())
-	res, err := c.ApplyConnector(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyConnector(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +141,60 @@ func (s *ConnectorServer) ApplyVpcaccessBetaConnector(ctx context.Context, reque
 	return s.applyConnector(ctx, cl, request)
 }
 
+// GetVpcaccessBetaConnector handles the gRPC request by passing it to the underlying Connector Get() method.
+func (s *ConnectorServer) GetVpcaccessBetaConnector(ctx context.Context, request *betapb.GetVpcaccessBetaConnectorRequest) (*betapb.VpcaccessBetaConnector, error) {
+	cl, err := createConfigConnector(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetConnector(ctx, ProtoToConnector(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ConnectorToProto(res), nil
+}
+
+// HasDiffVpcaccessBetaConnector handles the gRPC request by passing it to the underlying Connector Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ConnectorServer) HasDiffVpcaccessBetaConnector(ctx context.Context, request *betapb.HasDiffVpcaccessBetaConnectorRequest) (*betapb.HasDiffVpcaccessBetaConnectorResponse, error) {
+	cl, err := createConfigConnector(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConnector(ctx, ProtoToConnector(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.HasDiffVpcaccessBetaConnectorResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVpcaccessBetaConnector handles the gRPC request by passing it to the underlying Connector Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ConnectorServer) PlanVpcaccessBetaConnector(ctx context.Context, request *betapb.PlanVpcaccessBetaConnectorRequest) (*betapb.PlanVpcaccessBetaConnectorResponse, error) {
+	cl, err := createConfigConnector(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConnector(ctx, ProtoToConnector(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &betapb.PlanVpcaccessBetaConnectorResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteConnector handles the gRPC request by passing it to the underlying Connector Delete() method.
 func (s *ConnectorServer) DeleteVpcaccessBetaConnector(ctx context.Context, request *betapb.DeleteVpcaccessBetaConnectorRequest) (*emptypb.Empty, error) {
 
@@ -154,22 +213,35 @@ func (s *ConnectorServer) ListVpcaccessBetaConnector(ctx context.Context, reques
 		return nil, err
 	}
 
-	resources, err := cl.ListConnector(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = beta.ConnectorMaxPage
+	}
+	resources, err := cl.ListConnectorWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*betapb.VpcaccessBetaConnector
-	for _, r := range resources.Items {
-		rp := ConnectorToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ConnectorToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &betapb.ListVpcaccessBetaConnectorResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigConnector(ctx context.Context, service_account_file string) (*beta.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return beta.NewClient(conf), nil
 }
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/python/connector/serverconfig"
 	emptypb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/empty_go_proto"
 	vpcaccesspb "github.com/GoogleCloudPlatform/declarative-resource-client-library/python/proto/vpcaccess/vpcaccess_go_proto"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/vpcaccess"
@@ -119,7 +120,11 @@ func ConnectorToProto(resource *vpcaccess.Connector) *vpcaccesspb.VpcaccessConne
 // applyConnector handles the gRPC request by passing it to the underlying Connector Apply() method.
 func (s *ConnectorServer) applyConnector(ctx context.Context, c *vpcaccess.Client, request *vpcaccesspb.ApplyVpcaccessConnectorRequest) (*vpcaccesspb.VpcaccessConnector, error) {
 	p := ProtoToConnector(request.GetResource())
-	res, err := c.ApplyConnector(ctx, p)
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.ApplyConnector(ctx, p, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +141,60 @@ func (s *ConnectorServer) ApplyVpcaccessConnector(ctx context.Context, request *
 	return s.applyConnector(ctx, cl, request)
 }
 
+// GetVpcaccessConnector handles the gRPC request by passing it to the underlying Connector Get() method.
+func (s *ConnectorServer) GetVpcaccessConnector(ctx context.Context, request *vpcaccesspb.GetVpcaccessConnectorRequest) (*vpcaccesspb.VpcaccessConnector, error) {
+	cl, err := createConfigConnector(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.GetConnector(ctx, ProtoToConnector(request.GetResource()))
+	if err != nil {
+		return nil, err
+	}
+	return ConnectorToProto(res), nil
+}
+
+// HasDiffVpcaccessConnector handles the gRPC request by passing it to the underlying Connector Diff() method.
+// A resource which does not exist differs from any desired state.
+func (s *ConnectorServer) HasDiffVpcaccessConnector(ctx context.Context, request *vpcaccesspb.HasDiffVpcaccessConnectorRequest) (*vpcaccesspb.HasDiffVpcaccessConnectorResponse, error) {
+	cl, err := createConfigConnector(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConnector(ctx, ProtoToConnector(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &vpcaccesspb.HasDiffVpcaccessConnectorResponse{}
+	p.SetHasDiff(err != nil || len(diffs) > 0)
+	return p, nil
+}
+
+// PlanVpcaccessConnector handles the gRPC request by passing it to the underlying Connector Diff() method.
+// If the resource does not exist, the plan is to create it.
+func (s *ConnectorServer) PlanVpcaccessConnector(ctx context.Context, request *vpcaccesspb.PlanVpcaccessConnectorRequest) (*vpcaccesspb.PlanVpcaccessConnectorResponse, error) {
+	cl, err := createConfigConnector(ctx, request.GetServiceAccountFile())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := serverconfig.ApplyOptions(request.GetLifecycleDirectives())
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := cl.DiffConnector(ctx, ProtoToConnector(request.GetResource()), opts...)
+	if err != nil && !dcl.IsNotFound(err) {
+		return nil, err
+	}
+	p := &vpcaccesspb.PlanVpcaccessConnectorResponse{}
+	p.SetCreate(err != nil)
+	p.SetDiffs(serverconfig.FieldDiffsToProto(diffs))
+	return p, nil
+}
+
 // DeleteConnector handles the gRPC request by passing it to the underlying Connector Delete() method.
 func (s *ConnectorServer) DeleteVpcaccessConnector(ctx context.Context, request *vpcaccesspb.DeleteVpcaccessConnectorRequest) (*emptypb.Empty, error) {
 
@@ -154,22 +213,35 @@ func (s *ConnectorServer) ListVpcaccessConnector(ctx context.Context, request *v
 		return nil, err
 	}
 
-	resources, err := cl.ListConnector(ctx, request.GetProject(), request.GetLocation())
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = vpcaccess.ConnectorMaxPage
+	}
+	resources, err := cl.ListConnectorWithPageToken(ctx, request.GetProject(), request.GetLocation(), pageSize, request.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	var protos []*vpcaccesspb.VpcaccessConnector
-	for _, r := range resources.Items {
-		rp := ConnectorToProto(r)
-		protos = append(protos, rp)
+	nextPageToken, err := serverconfig.ListPages(request.GetPageSize(), resources.HasNext, resources.NextPageToken, func() error {
+		return resources.Next(ctx, cl)
+	}, func() {
+		for _, r := range resources.Items {
+			protos = append(protos, ConnectorToProto(r))
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 	p := &vpcaccesspb.ListVpcaccessConnectorResponse{}
 	p.SetItems(protos)
+	p.SetNextPageToken(nextPageToken)
 	return p, nil
 }
 
 func createConfigConnector(ctx context.Context, service_account_file string) (*vpcaccess.Client, error) {
-
-	conf := dcl.NewConfig(dcl.WithUserAgent("dcl-test"), dcl.WithCredentialsFile(service_account_file))
+	conf, err := serverconfig.NewConfig(ctx, service_account_file)
+	if err != nil {
+		return nil, err
+	}
 	return vpcaccess.NewClient(conf), nil
 }
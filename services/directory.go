@@ -82,9 +82,11 @@ import (
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataproc"
 	dataproc_alpha "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataproc/alpha"
 	dataproc_beta "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dataproc/beta"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/datastore"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dlp"
 	dlp_alpha "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dlp/alpha"
 	dlp_beta "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dlp/beta"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dns"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/eventarc"
 	eventarc_alpha "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/eventarc/alpha"
 	eventarc_beta "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/eventarc/beta"
@@ -139,7 +141,14 @@ import (
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/recaptchaenterprise"
 	recaptchaenterprise_alpha "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/recaptchaenterprise/alpha"
 	recaptchaenterprise_beta "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/recaptchaenterprise/beta"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/run"
 	run_alpha "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/run/alpha"
+	run_beta "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/run/beta"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/redis"
+	redis_beta "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/redis/beta"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/spanner"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql"
+	sql_beta "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql/beta"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage"
 	storage_alpha "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage/alpha"
 	storage_beta "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage/beta"
@@ -215,6 +224,10 @@ func Services() *Directory {
 	d.AddResource("ga", "billingbudgets", "Budget", billingbudgets.YAML_budget)
 	d.AddResource("ga", "bigquery", dcl.TitleToSnakeCase("Dataset"), bigquery.YAML_dataset)
 	d.AddResource("ga", "bigquery", "Dataset", bigquery.YAML_dataset)
+	d.AddResource("ga", "bigquery", dcl.TitleToSnakeCase("Routine"), bigquery.YAML_routine)
+	d.AddResource("ga", "bigquery", "Routine", bigquery.YAML_routine)
+	d.AddResource("ga", "bigquery", dcl.TitleToSnakeCase("Table"), bigquery.YAML_table)
+	d.AddResource("ga", "bigquery", "Table", bigquery.YAML_table)
 	d.AddResource("ga", "bigqueryreservation", dcl.TitleToSnakeCase("Assignment"), bigqueryreservation.YAML_assignment)
 	d.AddResource("ga", "bigqueryreservation", "Assignment", bigqueryreservation.YAML_assignment)
 	d.AddResource("ga", "bigqueryreservation", dcl.TitleToSnakeCase("Reservation"), bigqueryreservation.YAML_reservation)
@@ -307,6 +320,8 @@ func Services() *Directory {
 	d.AddResource("ga", "dataproc", "Cluster", dataproc.YAML_cluster)
 	d.AddResource("ga", "dataproc", dcl.TitleToSnakeCase("WorkflowTemplate"), dataproc.YAML_workflow_template)
 	d.AddResource("ga", "dataproc", "WorkflowTemplate", dataproc.YAML_workflow_template)
+	d.AddResource("ga", "datastore", dcl.TitleToSnakeCase("Index"), datastore.YAML_index)
+	d.AddResource("ga", "datastore", "Index", datastore.YAML_index)
 	d.AddResource("ga", "dlp", dcl.TitleToSnakeCase("DeidentifyTemplate"), dlp.YAML_deidentify_template)
 	d.AddResource("ga", "dlp", "DeidentifyTemplate", dlp.YAML_deidentify_template)
 	d.AddResource("ga", "dlp", dcl.TitleToSnakeCase("InspectTemplate"), dlp.YAML_inspect_template)
@@ -315,6 +330,10 @@ func Services() *Directory {
 	d.AddResource("ga", "dlp", "JobTrigger", dlp.YAML_job_trigger)
 	d.AddResource("ga", "dlp", dcl.TitleToSnakeCase("StoredInfoType"), dlp.YAML_stored_info_type)
 	d.AddResource("ga", "dlp", "StoredInfoType", dlp.YAML_stored_info_type)
+	d.AddResource("ga", "dns", dcl.TitleToSnakeCase("ManagedZone"), dns.YAML_managed_zone)
+	d.AddResource("ga", "dns", "ManagedZone", dns.YAML_managed_zone)
+	d.AddResource("ga", "dns", dcl.TitleToSnakeCase("ResourceRecordSet"), dns.YAML_resource_record_set)
+	d.AddResource("ga", "dns", "ResourceRecordSet", dns.YAML_resource_record_set)
 	d.AddResource("ga", "eventarc", dcl.TitleToSnakeCase("Trigger"), eventarc.YAML_trigger)
 	d.AddResource("ga", "eventarc", "Trigger", eventarc.YAML_trigger)
 	d.AddResource("ga", "eventarc", dcl.TitleToSnakeCase("Channel"), eventarc.YAML_channel)
@@ -401,8 +420,34 @@ func Services() *Directory {
 	d.AddResource("ga", "osconfig", "OSPolicyAssignment", osconfig.YAML_os_policy_assignment)
 	d.AddResource("ga", "pubsub", dcl.TitleToSnakeCase("Topic"), pubsub.YAML_topic)
 	d.AddResource("ga", "pubsub", "Topic", pubsub.YAML_topic)
+	d.AddResource("ga", "redis", dcl.TitleToSnakeCase("Instance"), redis.YAML_instance)
+	d.AddResource("ga", "redis", "Instance", redis.YAML_instance)
+	d.AddResource("ga", "run", dcl.TitleToSnakeCase("Service"), run.YAML_service)
+	d.AddResource("ga", "run", "Service", run.YAML_service)
+	d.AddResource("ga", "run", dcl.TitleToSnakeCase("Job"), run.YAML_job)
+	d.AddResource("ga", "run", "Job", run.YAML_job)
+	d.AddResource("ga", "spanner", dcl.TitleToSnakeCase("Instance"), spanner.YAML_instance)
+	d.AddResource("ga", "spanner", "Instance", spanner.YAML_instance)
+	d.AddResource("ga", "spanner", dcl.TitleToSnakeCase("Database"), spanner.YAML_database)
+	d.AddResource("ga", "spanner", "Database", spanner.YAML_database)
+	d.AddResource("ga", "sql", dcl.TitleToSnakeCase("Instance"), sql.YAML_instance)
+	d.AddResource("ga", "sql", "Instance", sql.YAML_instance)
+	d.AddResource("ga", "sql", dcl.TitleToSnakeCase("Database"), sql.YAML_database)
+	d.AddResource("ga", "sql", "Database", sql.YAML_database)
+	d.AddResource("ga", "sql", dcl.TitleToSnakeCase("User"), sql.YAML_user)
+	d.AddResource("ga", "sql", "User", sql.YAML_user)
+	d.AddResource("ga", "sql", dcl.TitleToSnakeCase("SslCert"), sql.YAML_ssl_cert)
+	d.AddResource("ga", "sql", "SslCert", sql.YAML_ssl_cert)
 	d.AddResource("ga", "storage", dcl.TitleToSnakeCase("Bucket"), storage.YAML_bucket)
 	d.AddResource("ga", "storage", "Bucket", storage.YAML_bucket)
+	d.AddResource("ga", "storage", dcl.TitleToSnakeCase("DefaultObjectAccessControl"), storage.YAML_default_object_access_control)
+	d.AddResource("ga", "storage", "DefaultObjectAccessControl", storage.YAML_default_object_access_control)
+	d.AddResource("ga", "storage", dcl.TitleToSnakeCase("HmacKey"), storage.YAML_hmac_key)
+	d.AddResource("ga", "storage", "HmacKey", storage.YAML_hmac_key)
+	d.AddResource("ga", "storage", dcl.TitleToSnakeCase("Object"), storage.YAML_object)
+	d.AddResource("ga", "storage", "Object", storage.YAML_object)
+	d.AddResource("ga", "storage", dcl.TitleToSnakeCase("ObjectAccessControl"), storage.YAML_object_access_control)
+	d.AddResource("ga", "storage", "ObjectAccessControl", storage.YAML_object_access_control)
 	d.AddResource("ga", "privateca", dcl.TitleToSnakeCase("CertificateTemplate"), privateca.YAML_certificate_template)
 	d.AddResource("ga", "privateca", "CertificateTemplate", privateca.YAML_certificate_template)
 	d.AddResource("ga", "privateca", dcl.TitleToSnakeCase("CaPool"), privateca.YAML_ca_pool)
@@ -427,6 +472,10 @@ func Services() *Directory {
 	d.AddResource("beta", "billingbudgets", "Budget", billingbudgets_beta.YAML_budget)
 	d.AddResource("beta", "bigquery", dcl.TitleToSnakeCase("Dataset"), bigquery_beta.YAML_dataset)
 	d.AddResource("beta", "bigquery", "Dataset", bigquery_beta.YAML_dataset)
+	d.AddResource("beta", "bigquery", dcl.TitleToSnakeCase("Routine"), bigquery_beta.YAML_routine)
+	d.AddResource("beta", "bigquery", "Routine", bigquery_beta.YAML_routine)
+	d.AddResource("beta", "bigquery", dcl.TitleToSnakeCase("Table"), bigquery_beta.YAML_table)
+	d.AddResource("beta", "bigquery", "Table", bigquery_beta.YAML_table)
 	d.AddResource("beta", "bigqueryreservation", dcl.TitleToSnakeCase("Assignment"), bigqueryreservation_beta.YAML_assignment)
 	d.AddResource("beta", "bigqueryreservation", "Assignment", bigqueryreservation_beta.YAML_assignment)
 	d.AddResource("beta", "bigqueryreservation", dcl.TitleToSnakeCase("Reservation"), bigqueryreservation_beta.YAML_reservation)
@@ -647,6 +696,20 @@ func Services() *Directory {
 	d.AddResource("beta", "osconfig", "GuestPolicy", osconfig_beta.YAML_guest_policy)
 	d.AddResource("beta", "pubsub", dcl.TitleToSnakeCase("Topic"), pubsub_beta.YAML_topic)
 	d.AddResource("beta", "pubsub", "Topic", pubsub_beta.YAML_topic)
+	d.AddResource("beta", "redis", dcl.TitleToSnakeCase("Instance"), redis_beta.YAML_instance)
+	d.AddResource("beta", "redis", "Instance", redis_beta.YAML_instance)
+	d.AddResource("beta", "run", dcl.TitleToSnakeCase("Service"), run_beta.YAML_service)
+	d.AddResource("beta", "run", "Service", run_beta.YAML_service)
+	d.AddResource("beta", "run", dcl.TitleToSnakeCase("Job"), run_beta.YAML_job)
+	d.AddResource("beta", "run", "Job", run_beta.YAML_job)
+	d.AddResource("beta", "sql", dcl.TitleToSnakeCase("Instance"), sql_beta.YAML_instance)
+	d.AddResource("beta", "sql", "Instance", sql_beta.YAML_instance)
+	d.AddResource("beta", "sql", dcl.TitleToSnakeCase("Database"), sql_beta.YAML_database)
+	d.AddResource("beta", "sql", "Database", sql_beta.YAML_database)
+	d.AddResource("beta", "sql", dcl.TitleToSnakeCase("User"), sql_beta.YAML_user)
+	d.AddResource("beta", "sql", "User", sql_beta.YAML_user)
+	d.AddResource("beta", "sql", dcl.TitleToSnakeCase("SslCert"), sql_beta.YAML_ssl_cert)
+	d.AddResource("beta", "sql", "SslCert", sql_beta.YAML_ssl_cert)
 	d.AddResource("beta", "storage", dcl.TitleToSnakeCase("Bucket"), storage_beta.YAML_bucket)
 	d.AddResource("beta", "storage", "Bucket", storage_beta.YAML_bucket)
 	d.AddResource("beta", "privateca", dcl.TitleToSnakeCase("CertificateTemplate"), privateca_beta.YAML_certificate_template)
@@ -673,6 +736,10 @@ func Services() *Directory {
 	d.AddResource("alpha", "billingbudgets", "Budget", billingbudgets_alpha.YAML_budget)
 	d.AddResource("alpha", "bigquery", dcl.TitleToSnakeCase("Dataset"), bigquery_alpha.YAML_dataset)
 	d.AddResource("alpha", "bigquery", "Dataset", bigquery_alpha.YAML_dataset)
+	d.AddResource("alpha", "bigquery", dcl.TitleToSnakeCase("Routine"), bigquery_alpha.YAML_routine)
+	d.AddResource("alpha", "bigquery", "Routine", bigquery_alpha.YAML_routine)
+	d.AddResource("alpha", "bigquery", dcl.TitleToSnakeCase("Table"), bigquery_alpha.YAML_table)
+	d.AddResource("alpha", "bigquery", "Table", bigquery_alpha.YAML_table)
 	d.AddResource("alpha", "bigqueryreservation", dcl.TitleToSnakeCase("Assignment"), bigqueryreservation_alpha.YAML_assignment)
 	d.AddResource("alpha", "bigqueryreservation", "Assignment", bigqueryreservation_alpha.YAML_assignment)
 	d.AddResource("alpha", "bigqueryreservation", dcl.TitleToSnakeCase("Reservation"), bigqueryreservation_alpha.YAML_reservation)
@@ -352,7 +352,8 @@ type OrganizationList struct {
 
 func (c *Client) GetOrganization(ctx context.Context, r *Organization) (*Organization, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Organization{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Organization{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -368,7 +369,7 @@ func (c *Client) GetOrganization(ctx context.Context, r *Organization) (*Organiz
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalOrganization(b, c, r)
 	if err != nil {
@@ -392,7 +393,8 @@ func (c *Client) GetOrganization(ctx context.Context, r *Organization) (*Organiz
 
 func (c *Client) DeleteOrganization(ctx context.Context, r *Organization) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(4800*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Organization{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Organization{}).Describe(), 4800*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -400,7 +402,13 @@ func (c *Client) DeleteOrganization(ctx context.Context, r *Organization) error
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Organization...")
 	deleteOp := deleteOrganizationOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteOrganizationAsync(ctx context.Context, r *Organization) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteOrganization(ctx, r)
+	})
 }
 
 // DeleteAllOrganization deletes all resources that the filter functions returns true on.
@@ -428,10 +436,11 @@ func (c *Client) DeleteAllOrganization(ctx context.Context, filter func(*Organiz
 }
 
 func (c *Client) ApplyOrganization(ctx context.Context, rawDesired *Organization, opts ...dcl.ApplyOption) (*Organization, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(4800*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Organization{}).Describe(), 4800*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Organization{}).Describe())
 	var resultNewState *Organization
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyOrganizationHelper(c, ctx, rawDesired, opts...)
@@ -449,6 +458,38 @@ func (c *Client) ApplyOrganization(ctx context.Context, rawDesired *Organization
 	return resultNewState, err
 }
 
+func (c *Client) ApplyOrganizationAsync(ctx context.Context, rawDesired *Organization, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyOrganization(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffOrganization returns the field-level differences between rawDesired and the
+// live Organization without modifying it. If the Organization does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffOrganization(ctx context.Context, rawDesired *Organization, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Organization{}).Describe(), 4800*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Organization{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractOrganizationFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.organizationDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Organization %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyOrganizationHelper(c *Client, ctx context.Context, rawDesired *Organization, opts ...dcl.ApplyOption) (*Organization, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyOrganization...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -457,6 +498,9 @@ func applyOrganizationHelper(c *Client, ctx context.Context, rawDesired *Organiz
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractOrganizationFields(rawDesired); err != nil {
 		return nil, err
@@ -519,7 +563,7 @@ func applyOrganizationHelper(c *Client, ctx context.Context, rawDesired *Organiz
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
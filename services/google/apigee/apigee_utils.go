@@ -107,9 +107,14 @@ func (l *OrganizationList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+// NextPageToken returns the API's token for the next page, or an empty string if there is none.
+func (l *OrganizationList) NextPageToken() string {
+	return l.nextToken
+}
+
 // Next advances the list to its next page.
 func (l *OrganizationList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Organization{}).Describe(), 0))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -126,7 +131,7 @@ func (l *OrganizationList) Next(ctx context.Context, c *Client) error {
 
 // ListOrganization returns a list of apigee organizations which the client has permission to access.
 func (c *Client) ListOrganization(ctx context.Context) (*OrganizationList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Organization{}).Describe(), 0))
 	defer cancel()
 
 	return c.ListOrganizationWithMaxResults(ctx, OrganizationMaxPage)
@@ -135,11 +140,17 @@ func (c *Client) ListOrganization(ctx context.Context) (*OrganizationList, error
 
 // ListOrganizationWithMaxResults returns a list of apigee organizations with the given page size.
 func (c *Client) ListOrganizationWithMaxResults(ctx context.Context, pageSize int32) (*OrganizationList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0))
+	return c.ListOrganizationWithPageToken(ctx, pageSize, "")
+}
+
+// ListOrganizationWithPageToken is ListOrganizationWithMaxResults, starting at the page which
+// pageToken, returned by NextPageToken, refers to.
+func (c *Client) ListOrganizationWithPageToken(ctx context.Context, pageSize int32, pageToken string) (*OrganizationList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Organization{}).Describe(), 0))
 	defer cancel()
 
 	r := &Organization{}
-	items, token, err := c.listOrganization(ctx, r, "", pageSize)
+	items, token, err := c.listOrganization(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -239,6 +250,11 @@ func (l *EnvironmentList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+// NextPageToken returns the API's token for the next page, or an empty string if there is none.
+func (l *EnvironmentList) NextPageToken() string {
+	return l.nextToken
+}
+
 // Next returns an error because environment list has no pages.
 func (l *EnvironmentList) Next(ctx context.Context, c *Client) error {
 	return fmt.Errorf("no next page")
@@ -246,7 +262,7 @@ func (l *EnvironmentList) Next(ctx context.Context, c *Client) error {
 
 // ListEnvironment returns an EnvironmentList containing all Environment resources in the given organization.
 func (c *Client) ListEnvironment(ctx context.Context, apigeeOrganization string) (*EnvironmentList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Environment{}).Describe(), 0))
 	defer cancel()
 
 	return c.ListEnvironmentWithMaxResults(ctx, apigeeOrganization, EnvironmentMaxPage)
@@ -255,11 +271,17 @@ func (c *Client) ListEnvironment(ctx context.Context, apigeeOrganization string)
 
 // ListEnvironmentWithMaxResults returns an EnvironmentList containing all Environment resources in the given organization.
 func (c *Client) ListEnvironmentWithMaxResults(ctx context.Context, apigeeOrganization string, pageSize int32) (*EnvironmentList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0))
+	return c.ListEnvironmentWithPageToken(ctx, apigeeOrganization, pageSize, "")
+}
+
+// ListEnvironmentWithPageToken is ListEnvironmentWithMaxResults, starting at the page which
+// pageToken, returned by NextPageToken, refers to.
+func (c *Client) ListEnvironmentWithPageToken(ctx context.Context, apigeeOrganization string, pageSize int32, pageToken string) (*EnvironmentList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Environment{}).Describe(), 0))
 	defer cancel()
 
 	r := &Environment{ApigeeOrganization: &apigeeOrganization}
-	items, token, err := c.listEnvironment(ctx, r, "", pageSize)
+	items, token, err := c.listEnvironment(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
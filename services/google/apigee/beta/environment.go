@@ -107,7 +107,8 @@ type EnvironmentList struct {
 
 func (c *Client) GetEnvironment(ctx context.Context, r *Environment) (*Environment, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Environment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Environment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -123,7 +124,7 @@ func (c *Client) GetEnvironment(ctx context.Context, r *Environment) (*Environme
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalEnvironment(b, c, r)
 	if err != nil {
@@ -148,7 +149,8 @@ func (c *Client) GetEnvironment(ctx context.Context, r *Environment) (*Environme
 
 func (c *Client) DeleteEnvironment(ctx context.Context, r *Environment) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Environment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Environment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -156,7 +158,13 @@ func (c *Client) DeleteEnvironment(ctx context.Context, r *Environment) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Environment...")
 	deleteOp := deleteEnvironmentOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteEnvironmentAsync(ctx context.Context, r *Environment) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteEnvironment(ctx, r)
+	})
 }
 
 // DeleteAllEnvironment deletes all resources that the filter functions returns true on.
@@ -184,10 +192,11 @@ func (c *Client) DeleteAllEnvironment(ctx context.Context, apigeeOrganization st
 }
 
 func (c *Client) ApplyEnvironment(ctx context.Context, rawDesired *Environment, opts ...dcl.ApplyOption) (*Environment, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Environment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Environment{}).Describe())
 	var resultNewState *Environment
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyEnvironmentHelper(c, ctx, rawDesired, opts...)
@@ -205,6 +214,38 @@ func (c *Client) ApplyEnvironment(ctx context.Context, rawDesired *Environment,
 	return resultNewState, err
 }
 
+func (c *Client) ApplyEnvironmentAsync(ctx context.Context, rawDesired *Environment, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyEnvironment(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffEnvironment returns the field-level differences between rawDesired and the
+// live Environment without modifying it. If the Environment does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffEnvironment(ctx context.Context, rawDesired *Environment, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Environment{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Environment{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractEnvironmentFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.environmentDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Environment %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyEnvironmentHelper(c *Client, ctx context.Context, rawDesired *Environment, opts ...dcl.ApplyOption) (*Environment, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyEnvironment...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -213,6 +254,9 @@ func applyEnvironmentHelper(c *Client, ctx context.Context, rawDesired *Environm
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractEnvironmentFields(rawDesired); err != nil {
 		return nil, err
@@ -271,7 +315,7 @@ func applyEnvironmentHelper(c *Client, ctx context.Context, rawDesired *Environm
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -391,7 +391,7 @@ func (c *Client) organizationDiffsForRawDesired(ctx context.Context, rawDesired
 	if rawInitial == nil {
 		if !dcl.IsNotFoundOrCode(err, 403) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Organization resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Organization resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Organization resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Organization resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -405,6 +405,12 @@ func (c *Client) organizationDiffsForRawDesired(ctx context.Context, rawDesired
 	if err := extractOrganizationFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeOrganizationInitialState(rawInitial, rawDesired)
@@ -422,7 +428,7 @@ func (c *Client) organizationDiffsForRawDesired(ctx context.Context, rawDesired
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffOrganization(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeOrganizationInitialState(rawInitial, rawDesired *Organization) (*Organization, error) {
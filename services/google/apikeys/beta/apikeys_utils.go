@@ -73,7 +73,7 @@ func (c *Client) getKeyRaw(ctx context.Context, r *Key) ([]byte, error) {
 }
 
 func (c *Client) GetKey(ctx context.Context, r *Key) (*Key, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(60*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 60*time.Second))
 	defer cancel()
 
 	b, err := c.getKeyRaw(ctx, r)
@@ -84,7 +84,7 @@ func (c *Client) GetKey(ctx context.Context, r *Key) (*Key, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalKey(b, c, r)
 	if err != nil {
@@ -418,8 +418,12 @@ func (l *KeyList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *KeyList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *KeyList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -436,7 +440,8 @@ func (l *KeyList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListKey(ctx context.Context, project string) (*KeyList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Key{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListKeyWithMaxResults(ctx, project, KeyMaxPage)
@@ -444,14 +449,18 @@ func (c *Client) ListKey(ctx context.Context, project string) (*KeyList, error)
 }
 
 func (c *Client) ListKeyWithMaxResults(ctx context.Context, project string, pageSize int32) (*KeyList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListKeyWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListKeyWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*KeyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Key{
 		Project: &project,
 	}
-	items, token, err := c.listKey(ctx, r, "", pageSize)
+	items, token, err := c.listKey(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -465,7 +474,8 @@ func (c *Client) ListKeyWithMaxResults(ctx context.Context, project string, page
 
 func (c *Client) DeleteKey(ctx context.Context, r *Key) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Key{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -473,7 +483,13 @@ func (c *Client) DeleteKey(ctx context.Context, r *Key) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Key...")
 	deleteOp := deleteKeyOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteKeyAsync(ctx context.Context, r *Key) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteKey(ctx, r)
+	})
 }
 
 // DeleteAllKey deletes all resources that the filter functions returns true on.
@@ -501,10 +517,11 @@ func (c *Client) DeleteAllKey(ctx context.Context, project string, filter func(*
 }
 
 func (c *Client) ApplyKey(ctx context.Context, rawDesired *Key, opts ...dcl.ApplyOption) (*Key, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Key{}).Describe())
 	var resultNewState *Key
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyKeyHelper(c, ctx, rawDesired, opts...)
@@ -522,6 +539,38 @@ func (c *Client) ApplyKey(ctx context.Context, rawDesired *Key, opts ...dcl.Appl
 	return resultNewState, err
 }
 
+func (c *Client) ApplyKeyAsync(ctx context.Context, rawDesired *Key, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyKey(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffKey returns the field-level differences between rawDesired and the
+// live Key without modifying it. If the Key does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffKey(ctx context.Context, rawDesired *Key, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Key{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractKeyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.keyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Key %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyKeyHelper(c *Client, ctx context.Context, rawDesired *Key, opts ...dcl.ApplyOption) (*Key, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyKey...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -530,6 +579,9 @@ func applyKeyHelper(c *Client, ctx context.Context, rawDesired *Key, opts ...dcl
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractKeyFields(rawDesired); err != nil {
 		return nil, err
@@ -588,7 +640,7 @@ func applyKeyHelper(c *Client, ctx context.Context, rawDesired *Key, opts ...dcl
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
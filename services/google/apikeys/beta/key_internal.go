@@ -411,7 +411,7 @@ func (c *Client) keyDiffsForRawDesired(ctx context.Context, rawDesired *Key, opt
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Key resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Key resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Key resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Key resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -425,6 +425,12 @@ func (c *Client) keyDiffsForRawDesired(ctx context.Context, rawDesired *Key, opt
 	if err := extractKeyFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeKeyInitialState(rawInitial, rawDesired)
@@ -442,7 +448,7 @@ func (c *Client) keyDiffsForRawDesired(ctx context.Context, rawDesired *Key, opt
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffKey(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeKeyInitialState(rawInitial, rawDesired *Key) (*Key, error) {
@@ -319,8 +319,12 @@ func (l *WorkloadList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *WorkloadList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *WorkloadList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Workload{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -337,7 +341,8 @@ func (l *WorkloadList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListWorkload(ctx context.Context, organization, location string) (*WorkloadList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Workload{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Workload{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListWorkloadWithMaxResults(ctx, organization, location, WorkloadMaxPage)
@@ -345,7 +350,11 @@ func (c *Client) ListWorkload(ctx context.Context, organization, location string
 }
 
 func (c *Client) ListWorkloadWithMaxResults(ctx context.Context, organization, location string, pageSize int32) (*WorkloadList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListWorkloadWithPageToken(ctx, organization, location, pageSize, "")
+}
+
+func (c *Client) ListWorkloadWithPageToken(ctx context.Context, organization, location string, pageSize int32, pageToken string) (*WorkloadList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Workload{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -353,7 +362,7 @@ func (c *Client) ListWorkloadWithMaxResults(ctx context.Context, organization, l
 		Organization: &organization,
 		Location:     &location,
 	}
-	items, token, err := c.listWorkload(ctx, r, "", pageSize)
+	items, token, err := c.listWorkload(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -367,7 +376,8 @@ func (c *Client) ListWorkloadWithMaxResults(ctx context.Context, organization, l
 
 func (c *Client) GetWorkload(ctx context.Context, r *Workload) (*Workload, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Workload{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Workload{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -383,7 +393,7 @@ func (c *Client) GetWorkload(ctx context.Context, r *Workload) (*Workload, error
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalWorkload(b, c, r)
 	if err != nil {
@@ -409,7 +419,8 @@ func (c *Client) GetWorkload(ctx context.Context, r *Workload) (*Workload, error
 
 func (c *Client) DeleteWorkload(ctx context.Context, r *Workload) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Workload{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Workload{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -417,7 +428,13 @@ func (c *Client) DeleteWorkload(ctx context.Context, r *Workload) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Workload...")
 	deleteOp := deleteWorkloadOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteWorkloadAsync(ctx context.Context, r *Workload) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteWorkload(ctx, r)
+	})
 }
 
 // DeleteAllWorkload deletes all resources that the filter functions returns true on.
@@ -445,10 +462,11 @@ func (c *Client) DeleteAllWorkload(ctx context.Context, organization, location s
 }
 
 func (c *Client) ApplyWorkload(ctx context.Context, rawDesired *Workload, opts ...dcl.ApplyOption) (*Workload, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Workload{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Workload{}).Describe())
 	var resultNewState *Workload
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyWorkloadHelper(c, ctx, rawDesired, opts...)
@@ -466,6 +484,38 @@ func (c *Client) ApplyWorkload(ctx context.Context, rawDesired *Workload, opts .
 	return resultNewState, err
 }
 
+func (c *Client) ApplyWorkloadAsync(ctx context.Context, rawDesired *Workload, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyWorkload(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffWorkload returns the field-level differences between rawDesired and the
+// live Workload without modifying it. If the Workload does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffWorkload(ctx context.Context, rawDesired *Workload, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Workload{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Workload{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractWorkloadFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.workloadDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Workload %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyWorkloadHelper(c *Client, ctx context.Context, rawDesired *Workload, opts ...dcl.ApplyOption) (*Workload, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyWorkload...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -474,6 +524,9 @@ func applyWorkloadHelper(c *Client, ctx context.Context, rawDesired *Workload, o
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractWorkloadFields(rawDesired); err != nil {
 		return nil, err
@@ -532,7 +585,7 @@ func applyWorkloadHelper(c *Client, ctx context.Context, rawDesired *Workload, o
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -546,6 +599,8 @@ func applyWorkloadDiff(c *Client, ctx context.Context, desired *Workload, rawDes
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -398,6 +398,14 @@ func (c *Client) getWorkloadRaw(ctx context.Context, r *Workload) ([]byte, error
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Workload) withDefaultLabels(c *dcl.Config, current map[string]string) *Workload {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) workloadDiffsForRawDesired(ctx context.Context, rawDesired *Workload, opts ...dcl.ApplyOption) (initial, desired *Workload, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -421,10 +429,16 @@ func (c *Client) workloadDiffsForRawDesired(ctx context.Context, rawDesired *Wor
 	}
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetWorkload(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Workload resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Workload resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Workload resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Workload resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -438,6 +452,12 @@ func (c *Client) workloadDiffsForRawDesired(ctx context.Context, rawDesired *Wor
 	if err := extractWorkloadFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeWorkloadInitialState(rawInitial, rawDesired)
@@ -455,7 +475,7 @@ func (c *Client) workloadDiffsForRawDesired(ctx context.Context, rawDesired *Wor
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffWorkload(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeWorkloadInitialState(rawInitial, rawDesired *Workload) (*Workload, error) {
@@ -1004,7 +1024,7 @@ func diffWorkload(c *Client, desired, actual *Workload, opts ...dcl.ApplyOption)
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateWorkloadUpdateWorkloadOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateWorkloadUpdateWorkloadOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -14,7 +14,12 @@
 // Package bigquery provices methods and types for managing bigquery GCP resources.
 package alpha
 
-import ()
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
 
 func equalsDatasetAccessRole(m, n *string) bool {
 	if m == nil && n == nil {
@@ -45,3 +50,159 @@ func canonicalizeDatasetAccessRole(m, n interface{}) bool {
 	nVal, _ := n.(*string)
 	return equalsDatasetAccessRole(mVal, nVal)
 }
+
+// tableSchemaUpdateOperation is the operation used to apply schema changes
+// which BigQuery allows on an existing table.
+const tableSchemaUpdateOperation = "updateTablePatchTableOperation"
+
+// tableFieldTypeAliases maps the standard SQL names of column types to the
+// legacy names that the API may return for them.
+var tableFieldTypeAliases = map[string]string{
+	"INT64":   "INTEGER",
+	"FLOAT64": "FLOAT",
+	"BOOL":    "BOOLEAN",
+	"STRUCT":  "RECORD",
+}
+
+func canonicalTableFieldType(t *string) string {
+	s := strings.ToUpper(dcl.ValueOrEmptyString(t))
+	if alias, ok := tableFieldTypeAliases[s]; ok {
+		return alias
+	}
+	return s
+}
+
+func canonicalTableFieldMode(m *string) string {
+	s := strings.ToUpper(dcl.ValueOrEmptyString(m))
+	if s == "" {
+		return "NULLABLE"
+	}
+	return s
+}
+
+// compareTableSchemaEvolution diffs two table schemas according to BigQuery's
+// schema evolution rules. Columns are matched by name, case-insensitively.
+// Adding a NULLABLE or REPEATED column, relaxing a REQUIRED column to NULLABLE
+// and changing a column's metadata can be done with a patch. Removing a column,
+// adding a REQUIRED column and changing a column's type or any other mode
+// change require the table to be recreated.
+func compareTableSchemaEvolution(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	desired, ok := d.(*TableSchema)
+	if !ok {
+		desiredNotPointer, ok := d.(TableSchema)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a TableSchema or *TableSchema", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*TableSchema)
+	if !ok {
+		actualNotPointer, ok := a.(TableSchema)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a TableSchema", a)
+		}
+		actual = &actualNotPointer
+	}
+	return compareTableSchemaFields(desired.Fields, actual.Fields, fn.AddNest("Fields"))
+}
+
+func compareTableSchemaFields(desired, actual []TableGooglecloudbigqueryv2Tablefieldschema, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+	actualByName := make(map[string]*TableGooglecloudbigqueryv2Tablefieldschema, len(actual))
+	for i := range actual {
+		actualByName[strings.ToLower(dcl.ValueOrEmptyString(actual[i].Name))] = &actual[i]
+	}
+	desiredNames := make(map[string]bool, len(desired))
+	for i := range desired {
+		des := &desired[i]
+		name := strings.ToLower(dcl.ValueOrEmptyString(des.Name))
+		desiredNames[name] = true
+		act, ok := actualByName[name]
+		if !ok {
+			diff := &dcl.FieldDiff{
+				FieldName:          fn.AddIndex(i).FieldName,
+				Desired:            des,
+				ResultingOperation: []string{tableSchemaUpdateOperation},
+			}
+			if canonicalTableFieldMode(des.Mode) == "REQUIRED" {
+				diff.Message = fmt.Sprintf("REQUIRED column %q cannot be added to an existing table", dcl.ValueOrEmptyString(des.Name))
+				diff.ResultingOperation = []string{"Recreate"}
+			}
+			diffs = append(diffs, diff)
+			continue
+		}
+		ds, err := compareTableSchemaField(des, act, fn.AddIndex(i))
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	for i := range actual {
+		if !desiredNames[strings.ToLower(dcl.ValueOrEmptyString(actual[i].Name))] {
+			diffs = append(diffs, &dcl.FieldDiff{
+				FieldName:          fn.AddIndex(i).FieldName,
+				Message:            fmt.Sprintf("column %q cannot be removed from an existing table", dcl.ValueOrEmptyString(actual[i].Name)),
+				Actual:             &actual[i],
+				ResultingOperation: []string{"Recreate"},
+			})
+		}
+	}
+	return diffs, nil
+}
+
+func compareTableSchemaField(desired, actual *TableGooglecloudbigqueryv2Tablefieldschema, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+	if desired.Type != nil && canonicalTableFieldType(desired.Type) != canonicalTableFieldType(actual.Type) {
+		diffs = append(diffs, &dcl.FieldDiff{
+			FieldName:          fn.AddNest("Type").FieldName,
+			Desired:            desired.Type,
+			Actual:             actual.Type,
+			ResultingOperation: []string{"Recreate"},
+		})
+	}
+	if dm, am := canonicalTableFieldMode(desired.Mode), canonicalTableFieldMode(actual.Mode); dm != am {
+		op := "Recreate"
+		if am == "REQUIRED" && dm == "NULLABLE" {
+			op = tableSchemaUpdateOperation
+		}
+		diffs = append(diffs, &dcl.FieldDiff{
+			FieldName:          fn.AddNest("Mode").FieldName,
+			Desired:            desired.Mode,
+			Actual:             actual.Mode,
+			ResultingOperation: []string{op},
+		})
+	}
+
+	info := dcl.DiffInfo{OperationSelector: dcl.TriggersOperation(tableSchemaUpdateOperation)}
+	for _, f := range []struct {
+		name string
+		d, a interface{}
+	}{
+		{name: "Description", d: desired.Description, a: actual.Description},
+		{name: "MaxLength", d: desired.MaxLength, a: actual.MaxLength},
+		{name: "Precision", d: desired.Precision, a: actual.Precision},
+		{name: "Scale", d: desired.Scale, a: actual.Scale},
+		{name: "Collation", d: desired.Collation, a: actual.Collation},
+		{name: "DefaultValueExpression", d: desired.DefaultValueExpression, a: actual.DefaultValueExpression},
+	} {
+		ds, err := dcl.Diff(f.d, f.a, info, fn.AddNest(f.name))
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	ds, err := dcl.Diff(desired.PolicyTags, actual.PolicyTags, dcl.DiffInfo{ObjectFunction: compareTableGooglecloudbigqueryv2TablefieldschemaPolicyTagsNewStyle, EmptyObject: EmptyTableGooglecloudbigqueryv2TablefieldschemaPolicyTags, OperationSelector: dcl.TriggersOperation(tableSchemaUpdateOperation)}, fn.AddNest("PolicyTags"))
+	if err != nil {
+		return nil, err
+	}
+	diffs = append(diffs, ds...)
+
+	if desired.Fields != nil {
+		ds, err := compareTableSchemaFields(desired.Fields, actual.Fields, fn.AddNest("Fields"))
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
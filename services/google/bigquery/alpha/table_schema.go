@@ -0,0 +1,1213 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package alpha
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLTableSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Bigquery/Table",
+			Description: "The Bigquery Table resource",
+			StructName:  "Table",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a Table",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "table",
+						Required:    true,
+						Description: "A full instance of a Table",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a Table",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "table",
+						Required:    true,
+						Description: "A full instance of a Table",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a Table",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "table",
+						Required:    true,
+						Description: "A full instance of a Table",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all Table",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "dataset",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many Table",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "dataset",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"Table": &dcl.Component{
+					Title:           "Table",
+					ID:              "projects/{{project}}/datasets/{{dataset}}/tables/{{name}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"name",
+							"dataset",
+							"project",
+						},
+						Properties: map[string]*dcl.Property{
+							"clustering": &dcl.Property{
+								Type:        "object",
+								GoName:      "Clustering",
+								GoType:      "TableClustering",
+								Description: "Clustering specification for the table. Must be specified with time-based partitioning, data in the table will be first partitioned and subsequently clustered.",
+								Properties: map[string]*dcl.Property{
+									"fields": &dcl.Property{
+										Type:        "array",
+										GoName:      "Fields",
+										Description: "One or more fields on which data should be clustered. Only top-level, non-repeated, simple-type fields are supported. When you cluster a table using multiple columns, the order of columns you specify is important. The order of the specified columns determines the sort order of the data.",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "string",
+											GoType: "string",
+										},
+									},
+								},
+							},
+							"creationTime": &dcl.Property{
+								Type:        "integer",
+								Format:      "int64",
+								GoName:      "CreationTime",
+								ReadOnly:    true,
+								Description: "Output only. The time when this table was created, in milliseconds since the epoch.",
+								Immutable:   true,
+							},
+							"dataset": &dcl.Property{
+								Type:        "string",
+								GoName:      "Dataset",
+								Description: "The ID of the dataset containing this table.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Bigquery/Dataset",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"defaultCollation": &dcl.Property{
+								Type:        "string",
+								GoName:      "DefaultCollation",
+								Description: "Defines the default collation specification of new STRING fields in the table. During table creation or update, if a STRING field is added to this table without explicit collation specified, then the table inherits the table default collation. A change to this field affects only fields added afterwards, and does not alter the existing fields. The following values are supported: 'und:ci' (undetermined locale, case insensitive) and '' (empty string, equivalent to case-sensitive behavior).",
+							},
+							"description": &dcl.Property{
+								Type:        "string",
+								GoName:      "Description",
+								Description: "A user-friendly description of this table.",
+							},
+							"encryptionConfiguration": &dcl.Property{
+								Type:        "object",
+								GoName:      "EncryptionConfiguration",
+								GoType:      "TableEncryptionConfiguration",
+								Description: "Custom encryption configuration (e.g., Cloud KMS keys).",
+								Properties: map[string]*dcl.Property{
+									"kmsKeyName": &dcl.Property{
+										Type:        "string",
+										GoName:      "KmsKeyName",
+										Description: "Describes the Cloud KMS encryption key that will be used to protect destination BigQuery table. The BigQuery Service Account associated with your project requires access to this encryption key.",
+									},
+								},
+							},
+							"etag": &dcl.Property{
+								Type:        "string",
+								GoName:      "Etag",
+								ReadOnly:    true,
+								Description: "Output only. A hash of the table metadata. Used to ensure there were no concurrent modifications to the resource when attempting an update. Not guaranteed to change when the table contents or the fields numRows, numBytes, numLongTermBytes or lastModifiedTime change.",
+								Immutable:   true,
+							},
+							"expirationTime": &dcl.Property{
+								Type:        "integer",
+								Format:      "int64",
+								GoName:      "ExpirationTime",
+								Description: "The time when this table expires, in milliseconds since the epoch. If not present, the table will persist indefinitely. Expired tables will be deleted and their storage reclaimed.",
+							},
+							"externalDataConfiguration": &dcl.Property{
+								Type:        "object",
+								GoName:      "ExternalDataConfiguration",
+								GoType:      "TableExternalDataConfiguration",
+								Description: "Describes the data format, location, and other properties of a table stored outside of BigQuery. By defining these properties, the data source can then be queried as if it were a standard BigQuery table.",
+								Properties: map[string]*dcl.Property{
+									"autodetect": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "Autodetect",
+										Description: "Try to detect schema and format options automatically. Any option specified explicitly will be honored.",
+									},
+									"avroOptions": &dcl.Property{
+										Type:        "object",
+										GoName:      "AvroOptions",
+										GoType:      "TableExternalDataConfigurationAvroOptions",
+										Description: "Additional properties to set if sourceFormat is set to AVRO.",
+										Properties: map[string]*dcl.Property{
+											"useAvroLogicalTypes": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "UseAvroLogicalTypes",
+												Description: "If sourceFormat is set to \"AVRO\", indicates whether to interpret logical types as the corresponding BigQuery data type (for example, TIMESTAMP), instead of using the raw type (for example, INTEGER).",
+											},
+										},
+									},
+									"bigtableOptions": &dcl.Property{
+										Type:        "object",
+										GoName:      "BigtableOptions",
+										GoType:      "TableExternalDataConfigurationBigtableOptions",
+										Description: "Additional options if sourceFormat is set to BIGTABLE.",
+										Properties: map[string]*dcl.Property{
+											"columnFamilies": &dcl.Property{
+												Type:        "array",
+												GoName:      "ColumnFamilies",
+												Description: "List of column families to expose in the table schema along with their types. This list restricts the column families that can be referenced in queries and specifies their value types.",
+												SendEmpty:   true,
+												ListType:    "list",
+												Items: &dcl.Property{
+													Type:   "object",
+													GoType: "TableExternalDataConfigurationBigtableOptionsColumnFamilies",
+													Properties: map[string]*dcl.Property{
+														"columns": &dcl.Property{
+															Type:        "array",
+															GoName:      "Columns",
+															Description: "Lists of columns that should be exposed as individual fields as opposed to a list of (column name, value) pairs.",
+															SendEmpty:   true,
+															ListType:    "list",
+															Items: &dcl.Property{
+																Type:   "object",
+																GoType: "TableExternalDataConfigurationBigtableOptionsColumnFamiliesColumns",
+																Properties: map[string]*dcl.Property{
+																	"encoding": &dcl.Property{
+																		Type:        "string",
+																		GoName:      "Encoding",
+																		Description: "The encoding of the values when the type is not STRING. Acceptable encoding values are: TEXT and BINARY.",
+																	},
+																	"fieldName": &dcl.Property{
+																		Type:        "string",
+																		GoName:      "FieldName",
+																		Description: "If the qualifier is not a valid BigQuery field identifier i.e. does not match a-zA-Z*, a valid identifier must be provided as the column field name and is used as field name in queries.",
+																	},
+																	"onlyReadLatest": &dcl.Property{
+																		Type:        "boolean",
+																		GoName:      "OnlyReadLatest",
+																		Description: "If this is set, only the latest version of value in this column are exposed.",
+																	},
+																	"qualifierEncoded": &dcl.Property{
+																		Type:        "string",
+																		GoName:      "QualifierEncoded",
+																		Description: "Qualifier of the column. Columns in the parent column family that has this exact qualifier are exposed as `.` field. If the qualifier is valid UTF-8 string, it can be specified in the qualifier_string field. Otherwise, a base-64 encoded value must be set to qualifier_encoded.",
+																	},
+																	"qualifierString": &dcl.Property{
+																		Type:        "string",
+																		GoName:      "QualifierString",
+																		Description: "Qualifier string.",
+																	},
+																	"type": &dcl.Property{
+																		Type:        "string",
+																		GoName:      "Type",
+																		Description: "The type to convert the value in cells of this column. The values are expected to be encoded using HBase Bytes.toBytes function when using the BINARY encoding value. Following BigQuery types are allowed (case-sensitive): BYTES, STRING, INTEGER, FLOAT, BOOLEAN and JSON. Default type is BYTES.",
+																	},
+																},
+															},
+														},
+														"encoding": &dcl.Property{
+															Type:        "string",
+															GoName:      "Encoding",
+															Description: "The encoding of the values when the type is not STRING. Acceptable encoding values are: TEXT and BINARY.",
+														},
+														"familyId": &dcl.Property{
+															Type:        "string",
+															GoName:      "FamilyId",
+															Description: "Identifier of the column family.",
+														},
+														"onlyReadLatest": &dcl.Property{
+															Type:        "boolean",
+															GoName:      "OnlyReadLatest",
+															Description: "If this is set only the latest version of value are exposed for all columns in this column family.",
+														},
+														"type": &dcl.Property{
+															Type:        "string",
+															GoName:      "Type",
+															Description: "The type to convert the value in cells of this column family. The values are expected to be encoded using HBase Bytes.toBytes function when using the BINARY encoding value. Following BigQuery types are allowed (case-sensitive): BYTES, STRING, INTEGER, FLOAT, BOOLEAN and JSON. Default type is BYTES.",
+														},
+													},
+												},
+											},
+											"ignoreUnspecifiedColumnFamilies": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "IgnoreUnspecifiedColumnFamilies",
+												Description: "If field is true, then the column families that are not specified in columnFamilies list are not exposed in the table schema. Otherwise, they are read with BYTES type values. The default value is false.",
+											},
+											"readRowkeyAsString": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "ReadRowkeyAsString",
+												Description: "If field is true, then the rowkey column families will be read and converted to string. Otherwise they are read with BYTES type values and users need to manually cast them with CAST if necessary. The default value is false.",
+											},
+										},
+									},
+									"compression": &dcl.Property{
+										Type:        "string",
+										GoName:      "Compression",
+										Description: "The compression type of the data source. Possible values include GZIP and NONE. The default value is NONE. This setting is ignored for Google Cloud Bigtable, Google Cloud Datastore backups, Avro, ORC and Parquet formats.",
+									},
+									"connectionId": &dcl.Property{
+										Type:        "string",
+										GoName:      "ConnectionId",
+										Description: "The connection specifying the credentials to be used to read external storage, such as Azure Blob, Cloud Storage, or S3. The connection_id can have the form \"..\" or \"projects//locations//connections/\".",
+									},
+									"csvOptions": &dcl.Property{
+										Type:        "object",
+										GoName:      "CsvOptions",
+										GoType:      "TableExternalDataConfigurationCsvOptions",
+										Description: "Additional properties to set if sourceFormat is set to CSV.",
+										Properties: map[string]*dcl.Property{
+											"allowJaggedRows": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "AllowJaggedRows",
+												Description: "Indicates if BigQuery should accept rows that are missing trailing optional columns. If true, BigQuery treats missing trailing columns as null values. If false, records with missing trailing columns are treated as bad records. The default value is false.",
+											},
+											"allowQuotedNewlines": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "AllowQuotedNewlines",
+												Description: "Indicates if BigQuery should allow quoted data sections that contain newline characters in a CSV file. The default value is false.",
+											},
+											"encoding": &dcl.Property{
+												Type:        "string",
+												GoName:      "Encoding",
+												Description: "The character encoding of the data. The supported values are UTF-8 or ISO-8859-1. The default value is UTF-8.",
+											},
+											"fieldDelimiter": &dcl.Property{
+												Type:        "string",
+												GoName:      "FieldDelimiter",
+												Description: "The separator character for fields in a CSV file. The separator is interpreted as a single byte. For files encoded in ISO-8859-1, any single character can be used as a separator. For files encoded in UTF-8, characters represented in decimal range 1-127 (U+0001-U+007F) can be used without any modification. The default value is comma (',').",
+											},
+											"quote": &dcl.Property{
+												Type:        "string",
+												GoName:      "Quote",
+												Description: "The value that is used to quote data sections in a CSV file. BigQuery converts the string to ISO-8859-1 encoding, and then uses the first byte of the encoded string to split the data in its raw, binary state. The default value is a double-quote ('\"'). If your data does not contain quoted sections, set the property value to an empty string.",
+											},
+											"skipLeadingRows": &dcl.Property{
+												Type:        "string",
+												GoName:      "SkipLeadingRows",
+												Description: "The number of rows at the top of a CSV file that BigQuery will skip when reading the data. The default value is 0. This property is useful if you have header rows in the file that should be skipped.",
+											},
+										},
+									},
+									"decimalTargetTypes": &dcl.Property{
+										Type:        "array",
+										GoName:      "DecimalTargetTypes",
+										Description: "Defines the list of possible SQL data types to which the source decimal values are converted. This list and the precision and the scale parameters of the decimal field determine the target type. In the order of NUMERIC, BIGNUMERIC, and STRING, a type is picked if it is in the specified list and if it supports the precision and the scale. STRING supports all precision and scale values. Possible values: DECIMAL_TARGET_TYPE_UNSPECIFIED, NUMERIC, BIGNUMERIC, STRING",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "string",
+											GoType: "TableExternalDataConfigurationDecimalTargetTypesEnum",
+											Enum: []string{
+												"DECIMAL_TARGET_TYPE_UNSPECIFIED",
+												"NUMERIC",
+												"BIGNUMERIC",
+												"STRING",
+											},
+										},
+									},
+									"googleSheetsOptions": &dcl.Property{
+										Type:        "object",
+										GoName:      "GoogleSheetsOptions",
+										GoType:      "TableExternalDataConfigurationGoogleSheetsOptions",
+										Description: "Additional options if sourceFormat is set to GOOGLE_SHEETS.",
+										Properties: map[string]*dcl.Property{
+											"range": &dcl.Property{
+												Type:        "string",
+												GoName:      "Range",
+												Description: "Range of a sheet to query from. Only used when non-empty. Typical format: sheet_name!top_left_cell_id:bottom_right_cell_id For example: sheet1!A1:B20",
+											},
+											"skipLeadingRows": &dcl.Property{
+												Type:        "string",
+												GoName:      "SkipLeadingRows",
+												Description: "The number of rows at the top of a sheet that BigQuery will skip when reading the data. The default value is 0.",
+											},
+										},
+									},
+									"hivePartitioningOptions": &dcl.Property{
+										Type:        "object",
+										GoName:      "HivePartitioningOptions",
+										GoType:      "TableExternalDataConfigurationHivePartitioningOptions",
+										Description: "When set, configures hive partitioning support. Not all storage formats support hive partitioning -- requesting hive partitioning on an unsupported format will lead to an error, as will providing an invalid specification.",
+										Properties: map[string]*dcl.Property{
+											"fields": &dcl.Property{
+												Type:        "array",
+												GoName:      "Fields",
+												Description: "For permanent external tables, this field is populated with the hive partition keys in the order they were inferred. The types of the partition keys can be deduced by checking the table schema (which will include the partition keys). Not every API will populate this field in the output.",
+												SendEmpty:   true,
+												ListType:    "list",
+												Items: &dcl.Property{
+													Type:   "string",
+													GoType: "string",
+												},
+											},
+											"mode": &dcl.Property{
+												Type:        "string",
+												GoName:      "Mode",
+												Description: "When set, what mode of hive partitioning to use when reading data. The following modes are supported: AUTO, STRINGS and CUSTOM.",
+											},
+											"requirePartitionFilter": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "RequirePartitionFilter",
+												Description: "If set to true, queries over this table require a partition filter that can be used for partition elimination to be specified.",
+											},
+											"sourceUriPrefix": &dcl.Property{
+												Type:        "string",
+												GoName:      "SourceUriPrefix",
+												Description: "When hive partition detection is requested, a common prefix for all source uris must be required. The prefix must end immediately before the partition key encoding begins.",
+											},
+										},
+									},
+									"ignoreUnknownValues": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "IgnoreUnknownValues",
+										Description: "Indicates if BigQuery should allow extra values that are not represented in the table schema. If true, the extra values are ignored. If false, records with extra columns are treated as bad records, and if there are too many bad records, an invalid error is returned in the job result. The default value is false.",
+									},
+									"jsonExtension": &dcl.Property{
+										Type:        "string",
+										GoName:      "JsonExtension",
+										GoType:      "TableExternalDataConfigurationJsonExtensionEnum",
+										Description: "Load option to be used together with source_format newline-delimited JSON to indicate that a variant of JSON is being loaded. To load newline-delimited GeoJSON, specify GEOJSON (and source_format must be set to NEWLINE_DELIMITED_JSON). Possible values: JSON_EXTENSION_UNSPECIFIED, GEOJSON",
+										Enum: []string{
+											"JSON_EXTENSION_UNSPECIFIED",
+											"GEOJSON",
+										},
+									},
+									"maxBadRecords": &dcl.Property{
+										Type:        "integer",
+										Format:      "int64",
+										GoName:      "MaxBadRecords",
+										Description: "The maximum number of bad records that BigQuery can ignore when reading data. If the number of bad records exceeds this value, an invalid error is returned in the job result. The default value is 0, which requires that all records are valid. This setting is ignored for Google Cloud Bigtable, Google Cloud Datastore backups, Avro, ORC and Parquet formats.",
+									},
+									"maxBadRecordsAlternative": &dcl.Property{
+										Type:        "array",
+										GoName:      "MaxBadRecordsAlternative",
+										ReadOnly:    true,
+										Description: "Output only. Deprecated.",
+										Immutable:   true,
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "integer",
+											Format: "int64",
+											GoType: "int64",
+										},
+									},
+									"parquetOptions": &dcl.Property{
+										Type:        "object",
+										GoName:      "ParquetOptions",
+										GoType:      "TableExternalDataConfigurationParquetOptions",
+										Description: "Additional properties to set if sourceFormat is set to PARQUET.",
+										Properties: map[string]*dcl.Property{
+											"enableListInference": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "EnableListInference",
+												Description: "Indicates whether to use schema inference specifically for Parquet LIST logical type.",
+											},
+											"enumAsString": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "EnumAsString",
+												Description: "Indicates whether to infer Parquet ENUM logical type as STRING instead of BYTES by default.",
+											},
+										},
+									},
+									"schema": &dcl.Property{
+										Type:        "object",
+										GoName:      "Schema",
+										GoType:      "TableExternalDataConfigurationSchema",
+										Description: "The schema for the data. Schema is required for CSV and JSON formats if autodetect is not on. Schema is disallowed for Google Cloud Bigtable, Cloud Datastore backups, Avro, ORC and Parquet formats.",
+										Properties: map[string]*dcl.Property{
+											"fields": &dcl.Property{
+												Type:        "array",
+												GoName:      "Fields",
+												Description: "Describes the fields in a table.",
+												SendEmpty:   true,
+												ListType:    "list",
+												Items: &dcl.Property{
+													Ref: "#/components/schemas/Table/properties/schema/properties/fields/items",
+												},
+											},
+										},
+									},
+									"sourceFormat": &dcl.Property{
+										Type:        "string",
+										GoName:      "SourceFormat",
+										Description: "The data format. For CSV files, specify \"CSV\". For Google sheets, specify \"GOOGLE_SHEETS\". For newline-delimited JSON, specify \"NEWLINE_DELIMITED_JSON\". For Avro files, specify \"AVRO\". For Google Cloud Datastore backups, specify \"DATASTORE_BACKUP\". For ORC files, specify \"ORC\". For Parquet files, specify \"PARQUET\". For Google Cloud Bigtable, specify \"BIGTABLE\".",
+									},
+									"sourceUris": &dcl.Property{
+										Type:        "array",
+										GoName:      "SourceUris",
+										Description: "The fully-qualified URIs that point to your data in Google Cloud. For Google Cloud Storage URIs: Each URI can contain one '*' wildcard character and it must come after the 'bucket' name. For Google Cloud Bigtable URIs: Exactly one URI can be specified and it has be a fully specified and valid HTTPS URL for a Google Cloud Bigtable table. For Google Cloud Datastore backups, exactly one URI can be specified. Also, the '*' wildcard character is not allowed.",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "string",
+											GoType: "string",
+										},
+									},
+									"valueConversionModes": &dcl.Property{
+										Type:        "object",
+										GoName:      "ValueConversionModes",
+										GoType:      "TableExternalDataConfigurationValueConversionModes",
+										Description: "Defines the options for converting values that are out of range for their target column types.",
+										Properties: map[string]*dcl.Property{
+											"numericTypeOutOfRangeConversionMode": &dcl.Property{
+												Type:        "string",
+												GoName:      "NumericTypeOutOfRangeConversionMode",
+												GoType:      "TableExternalDataConfigurationValueConversionModesNumericTypeOutOfRangeConversionModeEnum",
+												Description: "Out of range conversion mode for NUMERIC and BIGNUMERIC values. Possible values: NUMERIC_TYPE_VALUE_UNSPECIFIED, NUMERIC_TYPE_VALUE_REJECT, NUMERIC_TYPE_VALUE_ROUND",
+												Enum: []string{
+													"NUMERIC_TYPE_VALUE_UNSPECIFIED",
+													"NUMERIC_TYPE_VALUE_REJECT",
+													"NUMERIC_TYPE_VALUE_ROUND",
+												},
+											},
+											"temporalTypesOutOfRangeConversionMode": &dcl.Property{
+												Type:        "string",
+												GoName:      "TemporalTypesOutOfRangeConversionMode",
+												GoType:      "TableExternalDataConfigurationValueConversionModesTemporalTypesOutOfRangeConversionModeEnum",
+												Description: "Out of range conversion mode for TIMESTAMP, DATE, DATETIME and TIME values. Possible values: TEMPORAL_TYPES_VALUE_UNSPECIFIED, TEMPORAL_TYPES_VALUE_REJECT, TEMPORAL_TYPES_VALUE_CLAMP",
+												Enum: []string{
+													"TEMPORAL_TYPES_VALUE_UNSPECIFIED",
+													"TEMPORAL_TYPES_VALUE_REJECT",
+													"TEMPORAL_TYPES_VALUE_CLAMP",
+												},
+											},
+										},
+									},
+								},
+							},
+							"friendlyName": &dcl.Property{
+								Type:        "string",
+								GoName:      "FriendlyName",
+								Description: "A descriptive name for this table.",
+							},
+							"id": &dcl.Property{
+								Type:        "string",
+								GoName:      "Id",
+								ReadOnly:    true,
+								Description: "Output only. An opaque ID uniquely identifying the table.",
+								Immutable:   true,
+							},
+							"labels": &dcl.Property{
+								Type: "object",
+								AdditionalProperties: &dcl.Property{
+									Type: "string",
+								},
+								GoName:      "Labels",
+								Description: "The labels associated with this table. You can use these to organize and group your tables. Label keys and values can be no longer than 63 characters, can only contain lowercase letters, numeric characters, underscores and dashes. International characters are allowed. Label values are optional. Label keys must start with a letter and each label in the list must have a different key.",
+							},
+							"lastModifiedTime": &dcl.Property{
+								Type:        "integer",
+								Format:      "int64",
+								GoName:      "LastModifiedTime",
+								ReadOnly:    true,
+								Description: "Output only. The time when this table was last modified, in milliseconds since the epoch.",
+								Immutable:   true,
+							},
+							"location": &dcl.Property{
+								Type:        "string",
+								GoName:      "Location",
+								ReadOnly:    true,
+								Description: "Output only. The geographic location where the table resides. This value is inherited from the dataset.",
+								Immutable:   true,
+							},
+							"materializedView": &dcl.Property{
+								Type:        "object",
+								GoName:      "MaterializedView",
+								GoType:      "TableMaterializedView",
+								Description: "The materialized view definition.",
+								Properties: map[string]*dcl.Property{
+									"enableRefresh": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "EnableRefresh",
+										Description: "Enable automatic refresh of the materialized view when the base table is updated. The default value is \"true\".",
+									},
+									"lastRefreshTime": &dcl.Property{
+										Type:        "integer",
+										Format:      "int64",
+										GoName:      "LastRefreshTime",
+										ReadOnly:    true,
+										Description: "Output only. The time when this materialized view was last refreshed, in milliseconds since the epoch.",
+										Immutable:   true,
+									},
+									"query": &dcl.Property{
+										Type:        "string",
+										GoName:      "Query",
+										Description: "A query whose result is persisted.",
+									},
+									"refreshIntervalMs": &dcl.Property{
+										Type:        "integer",
+										Format:      "int64",
+										GoName:      "RefreshIntervalMs",
+										Description: "The maximum frequency at which this materialized view will be refreshed. The default value is \"1800000\" (30 minutes).",
+									},
+								},
+							},
+							"model": &dcl.Property{
+								Type:        "object",
+								GoName:      "Model",
+								GoType:      "TableModel",
+								ReadOnly:    true,
+								Description: "Output only. Deprecated.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"modelOptions": &dcl.Property{
+										Type:        "object",
+										GoName:      "ModelOptions",
+										GoType:      "TableModelModelOptions",
+										ReadOnly:    true,
+										Description: "Output only. Deprecated.",
+										Immutable:   true,
+										Properties: map[string]*dcl.Property{
+											"labels": &dcl.Property{
+												Type:        "array",
+												GoName:      "Labels",
+												ReadOnly:    true,
+												Description: "Output only. Deprecated.",
+												Immutable:   true,
+												SendEmpty:   true,
+												ListType:    "list",
+												Items: &dcl.Property{
+													Type:   "string",
+													GoType: "string",
+												},
+											},
+											"lossType": &dcl.Property{
+												Type:        "string",
+												GoName:      "LossType",
+												ReadOnly:    true,
+												Description: "Output only. Deprecated.",
+												Immutable:   true,
+											},
+											"modelType": &dcl.Property{
+												Type:        "string",
+												GoName:      "ModelType",
+												ReadOnly:    true,
+												Description: "Output only. Deprecated.",
+												Immutable:   true,
+											},
+										},
+									},
+									"trainingRuns": &dcl.Property{
+										Type:        "array",
+										GoName:      "TrainingRuns",
+										ReadOnly:    true,
+										Description: "Output only. Deprecated.",
+										Immutable:   true,
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "object",
+											GoType: "TableModelTrainingRuns",
+											Properties: map[string]*dcl.Property{
+												"iterationResults": &dcl.Property{
+													Type:        "array",
+													GoName:      "IterationResults",
+													ReadOnly:    true,
+													Description: "Output only. Deprecated.",
+													Immutable:   true,
+													SendEmpty:   true,
+													ListType:    "list",
+													Items: &dcl.Property{
+														Type:   "object",
+														GoType: "TableModelTrainingRunsIterationResults",
+														Properties: map[string]*dcl.Property{
+															"durationMs": &dcl.Property{
+																Type:        "string",
+																GoName:      "DurationMs",
+																ReadOnly:    true,
+																Description: "Output only. Deprecated.",
+																Immutable:   true,
+															},
+															"evalLoss": &dcl.Property{
+																Type:        "number",
+																Format:      "double",
+																GoName:      "EvalLoss",
+																ReadOnly:    true,
+																Description: "Output only. Deprecated.",
+																Immutable:   true,
+															},
+															"index": &dcl.Property{
+																Type:        "integer",
+																Format:      "int64",
+																GoName:      "Index",
+																ReadOnly:    true,
+																Description: "Output only. Deprecated.",
+																Immutable:   true,
+															},
+															"learnRate": &dcl.Property{
+																Type:        "number",
+																Format:      "double",
+																GoName:      "LearnRate",
+																ReadOnly:    true,
+																Description: "Output only. Deprecated.",
+																Immutable:   true,
+															},
+															"trainingLoss": &dcl.Property{
+																Type:        "number",
+																Format:      "double",
+																GoName:      "TrainingLoss",
+																ReadOnly:    true,
+																Description: "Output only. Deprecated.",
+																Immutable:   true,
+															},
+														},
+													},
+												},
+												"startTime": &dcl.Property{
+													Type:        "string",
+													GoName:      "StartTime",
+													ReadOnly:    true,
+													Description: "Output only. Deprecated.",
+													Immutable:   true,
+												},
+												"state": &dcl.Property{
+													Type:        "string",
+													GoName:      "State",
+													ReadOnly:    true,
+													Description: "Output only. Deprecated.",
+													Immutable:   true,
+												},
+												"trainingOptions": &dcl.Property{
+													Type:        "object",
+													GoName:      "TrainingOptions",
+													GoType:      "TableModelTrainingRunsTrainingOptions",
+													ReadOnly:    true,
+													Description: "Output only. Deprecated.",
+													Immutable:   true,
+													Properties: map[string]*dcl.Property{
+														"earlyStop": &dcl.Property{
+															Type:        "boolean",
+															GoName:      "EarlyStop",
+															ReadOnly:    true,
+															Description: "Output only. Deprecated.",
+															Immutable:   true,
+														},
+														"l1Reg": &dcl.Property{
+															Type:        "number",
+															Format:      "double",
+															GoName:      "L1Reg",
+															ReadOnly:    true,
+															Description: "Output only. Deprecated.",
+															Immutable:   true,
+														},
+														"l2Reg": &dcl.Property{
+															Type:        "number",
+															Format:      "double",
+															GoName:      "L2Reg",
+															ReadOnly:    true,
+															Description: "Output only. Deprecated.",
+															Immutable:   true,
+														},
+														"learnRate": &dcl.Property{
+															Type:        "number",
+															Format:      "double",
+															GoName:      "LearnRate",
+															ReadOnly:    true,
+															Description: "Output only. Deprecated.",
+															Immutable:   true,
+														},
+														"learnRateStrategy": &dcl.Property{
+															Type:        "string",
+															GoName:      "LearnRateStrategy",
+															ReadOnly:    true,
+															Description: "Output only. Deprecated.",
+															Immutable:   true,
+														},
+														"lineSearchInitLearnRate": &dcl.Property{
+															Type:        "number",
+															Format:      "double",
+															GoName:      "LineSearchInitLearnRate",
+															ReadOnly:    true,
+															Description: "Output only. Deprecated.",
+															Immutable:   true,
+														},
+														"maxIteration": &dcl.Property{
+															Type:        "integer",
+															Format:      "int64",
+															GoName:      "MaxIteration",
+															ReadOnly:    true,
+															Description: "Output only. Deprecated.",
+															Immutable:   true,
+														},
+														"minRelProgress": &dcl.Property{
+															Type:        "number",
+															Format:      "double",
+															GoName:      "MinRelProgress",
+															ReadOnly:    true,
+															Description: "Output only. Deprecated.",
+															Immutable:   true,
+														},
+														"warmStart": &dcl.Property{
+															Type:        "boolean",
+															GoName:      "WarmStart",
+															ReadOnly:    true,
+															Description: "Output only. Deprecated.",
+															Immutable:   true,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"name": &dcl.Property{
+								Type:        "string",
+								GoName:      "Name",
+								Description: "The ID of the table. The ID must contain only letters (a-z, A-Z), numbers (0-9), or underscores (_). The maximum length is 1,024 characters. Certain operations allow suffixing of the table ID with a partition decorator, such as `sample_table$20190123`.",
+								Immutable:   true,
+							},
+							"numBytes": &dcl.Property{
+								Type:        "string",
+								GoName:      "NumBytes",
+								ReadOnly:    true,
+								Description: "Output only. The size of this table in logical bytes, excluding any data in the streaming buffer.",
+								Immutable:   true,
+							},
+							"numLongTermBytes": &dcl.Property{
+								Type:        "string",
+								GoName:      "NumLongTermBytes",
+								ReadOnly:    true,
+								Description: "Output only. The number of logical bytes in the table that are considered \"long-term storage\".",
+								Immutable:   true,
+							},
+							"numPhysicalBytes": &dcl.Property{
+								Type:        "string",
+								GoName:      "NumPhysicalBytes",
+								ReadOnly:    true,
+								Description: "Output only. The physical size of this table in bytes. This includes storage used for time travel.",
+								Immutable:   true,
+							},
+							"numRows": &dcl.Property{
+								Type:        "integer",
+								Format:      "int64",
+								GoName:      "NumRows",
+								ReadOnly:    true,
+								Description: "Output only. The number of rows of data in this table, excluding any data in the streaming buffer.",
+								Immutable:   true,
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "The ID of the project containing this table.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"rangePartitioning": &dcl.Property{
+								Type:        "object",
+								GoName:      "RangePartitioning",
+								GoType:      "TableRangePartitioning",
+								Description: "If specified, configures range partitioning for this table.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"field": &dcl.Property{
+										Type:        "string",
+										GoName:      "Field",
+										Description: "The table is partitioned by this field. The field must be a top-level NULLABLE/REQUIRED field. The only supported type is INTEGER/INT64.",
+										Immutable:   true,
+									},
+									"range": &dcl.Property{
+										Type:        "object",
+										GoName:      "Range",
+										GoType:      "TableRangePartitioningRange",
+										Description: "Defines the ranges for range partitioning.",
+										Immutable:   true,
+										Properties: map[string]*dcl.Property{
+											"end": &dcl.Property{
+												Type:        "string",
+												GoName:      "End",
+												Description: "The end of range partitioning, exclusive.",
+												Immutable:   true,
+											},
+											"interval": &dcl.Property{
+												Type:        "string",
+												GoName:      "Interval",
+												Description: "The width of each interval.",
+												Immutable:   true,
+											},
+											"start": &dcl.Property{
+												Type:        "string",
+												GoName:      "Start",
+												Description: "The start of range partitioning, inclusive.",
+												Immutable:   true,
+											},
+										},
+									},
+								},
+							},
+							"requirePartitionFilter": &dcl.Property{
+								Type:        "boolean",
+								GoName:      "RequirePartitionFilter",
+								Description: "If set to true, queries over this table require a partition filter that can be used for partition elimination to be specified.",
+							},
+							"schema": &dcl.Property{
+								Type:        "object",
+								GoName:      "Schema",
+								GoType:      "TableSchema",
+								Description: "Describes the schema of this table. Columns may be added to an existing table as NULLABLE or REPEATED fields; removing a column, adding a REQUIRED column or changing the type of a column requires the table to be recreated.",
+								Properties: map[string]*dcl.Property{
+									"fields": &dcl.Property{
+										Type:        "array",
+										GoName:      "Fields",
+										Description: "Describes the fields in a table.",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "object",
+											GoType: "TableGooglecloudbigqueryv2Tablefieldschema",
+											Properties: map[string]*dcl.Property{
+												"categories": &dcl.Property{
+													Type:        "object",
+													GoName:      "Categories",
+													GoType:      "TableGooglecloudbigqueryv2TablefieldschemaCategories",
+													Description: "Deprecated.",
+													Properties: map[string]*dcl.Property{
+														"names": &dcl.Property{
+															Type:        "array",
+															GoName:      "Names",
+															Description: "Deprecated.",
+															SendEmpty:   true,
+															ListType:    "list",
+															Items: &dcl.Property{
+																Type:   "string",
+																GoType: "string",
+															},
+														},
+													},
+												},
+												"collation": &dcl.Property{
+													Type:        "string",
+													GoName:      "Collation",
+													Description: "Field collation can be set only when the type of field is STRING. The following values are supported: 'und:ci' (undetermined locale, case insensitive) and '' (empty string, equivalent to case-sensitive behavior).",
+												},
+												"defaultValueExpression": &dcl.Property{
+													Type:        "string",
+													GoName:      "DefaultValueExpression",
+													Description: "A SQL expression to specify the default value for this field.",
+												},
+												"description": &dcl.Property{
+													Type:        "string",
+													GoName:      "Description",
+													Description: "The field description. The maximum length is 1,024 characters.",
+												},
+												"fields": &dcl.Property{
+													Type:        "array",
+													GoName:      "Fields",
+													Description: "Describes the nested schema fields if the type property is set to RECORD.",
+													SendEmpty:   true,
+													ListType:    "list",
+													Items: &dcl.Property{
+														Ref: "#/components/schemas/Table/properties/schema/properties/fields/items",
+													},
+												},
+												"maxLength": &dcl.Property{
+													Type:        "integer",
+													Format:      "int64",
+													GoName:      "MaxLength",
+													Description: "Maximum length of values of this field for STRINGS or BYTES. If max_length is not specified, no maximum length constraint is imposed on this field.",
+												},
+												"mode": &dcl.Property{
+													Type:        "string",
+													GoName:      "Mode",
+													Description: "The field mode. Possible values include NULLABLE, REQUIRED and REPEATED. The default value is NULLABLE.",
+												},
+												"name": &dcl.Property{
+													Type:        "string",
+													GoName:      "Name",
+													Description: "The field name. The name must contain only letters (a-z, A-Z), numbers (0-9), or underscores (_), and must start with a letter or underscore. The maximum length is 300 characters.",
+												},
+												"nameAlternative": &dcl.Property{
+													Type:        "array",
+													GoName:      "NameAlternative",
+													ReadOnly:    true,
+													Description: "Output only. Deprecated.",
+													Immutable:   true,
+													SendEmpty:   true,
+													ListType:    "list",
+													Items: &dcl.Property{
+														Type:   "string",
+														GoType: "string",
+													},
+												},
+												"policyTags": &dcl.Property{
+													Type:        "object",
+													GoName:      "PolicyTags",
+													GoType:      "TableGooglecloudbigqueryv2TablefieldschemaPolicyTags",
+													Description: "The policy tags attached to this field, used for field-level access control. If not set, defaults to empty policy_tags.",
+													Properties: map[string]*dcl.Property{
+														"names": &dcl.Property{
+															Type:        "array",
+															GoName:      "Names",
+															Description: "A list of policy tag resource names. For example, \"projects/1/locations/eu/taxonomies/2/policyTags/3\". At most 1 policy tag is currently allowed.",
+															SendEmpty:   true,
+															ListType:    "list",
+															Items: &dcl.Property{
+																Type:   "string",
+																GoType: "string",
+															},
+														},
+													},
+												},
+												"precision": &dcl.Property{
+													Type:        "integer",
+													Format:      "int64",
+													GoName:      "Precision",
+													Description: "Precision (maximum number of total digits in base 10) for values of this field for NUMERIC or BIGNUMERIC. It is invalid to set precision or scale if type is not NUMERIC or BIGNUMERIC.",
+												},
+												"scale": &dcl.Property{
+													Type:        "integer",
+													Format:      "int64",
+													GoName:      "Scale",
+													Description: "Scale (maximum number of digits in the fractional part in base 10) for values of this field for NUMERIC or BIGNUMERIC. It is invalid to set precision or scale if type is not NUMERIC or BIGNUMERIC.",
+												},
+												"type": &dcl.Property{
+													Type:        "string",
+													GoName:      "Type",
+													Description: "The field data type. Possible values include STRING, BYTES, INTEGER, INT64 (same as INTEGER), FLOAT, FLOAT64 (same as FLOAT), NUMERIC, BIGNUMERIC, BOOLEAN, BOOL (same as BOOLEAN), TIMESTAMP, DATE, TIME, DATETIME, INTERVAL, RECORD (where RECORD indicates that the field contains a nested schema) or STRUCT (same as RECORD).",
+												},
+											},
+										},
+									},
+								},
+							},
+							"selfLink": &dcl.Property{
+								Type:        "string",
+								GoName:      "SelfLink",
+								ReadOnly:    true,
+								Description: "Output only. A URL that can be used to access this resource again.",
+								Immutable:   true,
+							},
+							"snapshotDefinition": &dcl.Property{
+								Type:        "object",
+								GoName:      "SnapshotDefinition",
+								GoType:      "TableSnapshotDefinition",
+								Description: "Contains information about the snapshot. This value is set via snapshot creation.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"dataset": &dcl.Property{
+										Type:        "string",
+										GoName:      "Dataset",
+										Description: "The ID of the dataset containing the base table.",
+										Immutable:   true,
+									},
+									"project": &dcl.Property{
+										Type:        "string",
+										GoName:      "Project",
+										Description: "The ID of the project containing the base table.",
+										Immutable:   true,
+									},
+									"snapshotTime": &dcl.Property{
+										Type:        "string",
+										GoName:      "SnapshotTime",
+										Description: "The time at which the base table was snapshot. This value is reported in the JSON response using RFC3339 format.",
+										Immutable:   true,
+									},
+									"table": &dcl.Property{
+										Type:        "string",
+										GoName:      "Table",
+										Description: "The ID of the base table.",
+										Immutable:   true,
+									},
+								},
+							},
+							"streamingBuffer": &dcl.Property{
+								Type:        "object",
+								GoName:      "StreamingBuffer",
+								GoType:      "TableStreamingBuffer",
+								ReadOnly:    true,
+								Description: "Output only. Contains information regarding this table's streaming buffer, if one is present. This field will be absent if the table is not being streamed to or if there is no data in the streaming buffer.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"estimatedBytes": &dcl.Property{
+										Type:        "integer",
+										Format:      "int64",
+										GoName:      "EstimatedBytes",
+										ReadOnly:    true,
+										Description: "Output only. A lower-bound estimate of the number of bytes currently in the streaming buffer.",
+										Immutable:   true,
+									},
+									"estimatedRows": &dcl.Property{
+										Type:        "integer",
+										Format:      "int64",
+										GoName:      "EstimatedRows",
+										ReadOnly:    true,
+										Description: "Output only. A lower-bound estimate of the number of rows currently in the streaming buffer.",
+										Immutable:   true,
+									},
+									"oldestEntryTime": &dcl.Property{
+										Type:        "integer",
+										Format:      "int64",
+										GoName:      "OldestEntryTime",
+										ReadOnly:    true,
+										Description: "Output only. Contains the timestamp of the oldest entry in the streaming buffer, in milliseconds since the epoch, if the streaming buffer is available.",
+										Immutable:   true,
+									},
+								},
+							},
+							"timePartitioning": &dcl.Property{
+								Type:        "object",
+								GoName:      "TimePartitioning",
+								GoType:      "TableTimePartitioning",
+								Description: "If specified, configures time-based partitioning for this table.",
+								Properties: map[string]*dcl.Property{
+									"expirationMs": &dcl.Property{
+										Type:        "string",
+										GoName:      "ExpirationMs",
+										Description: "Number of milliseconds for which to keep the storage for a partition. A wrapper is used here because 0 is an invalid value.",
+									},
+									"field": &dcl.Property{
+										Type:        "string",
+										GoName:      "Field",
+										Description: "If not set, the table is partitioned by pseudo column '_PARTITIONTIME'; if set, the table is partitioned by this field. The field must be a top-level TIMESTAMP or DATE field. Its mode must be NULLABLE or REQUIRED.",
+										Immutable:   true,
+									},
+									"type": &dcl.Property{
+										Type:        "string",
+										GoName:      "Type",
+										Description: "The supported types are DAY, HOUR, MONTH, and YEAR, which will generate one partition per day, hour, month, and year, respectively.",
+										Immutable:   true,
+									},
+								},
+							},
+							"type": &dcl.Property{
+								Type:        "string",
+								GoName:      "Type",
+								ReadOnly:    true,
+								Description: "Output only. Describes the table type. The following values are supported: TABLE, VIEW, SNAPSHOT, MATERIALIZED_VIEW and EXTERNAL. The default value is TABLE.",
+								Immutable:   true,
+							},
+							"view": &dcl.Property{
+								Type:        "object",
+								GoName:      "View",
+								GoType:      "TableView",
+								Description: "The view definition.",
+								Properties: map[string]*dcl.Property{
+									"query": &dcl.Property{
+										Type:        "string",
+										GoName:      "Query",
+										Description: "A query that BigQuery executes when the view is referenced.",
+									},
+									"useExplicitColumnNames": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "UseExplicitColumnNames",
+										Description: "True if the column names are explicitly specified. For example by using the 'CREATE VIEW v(c1, c2) AS ...' syntax. Can only be set using BigQuery's GoogleSQL.",
+									},
+									"useLegacySql": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "UseLegacySql",
+										Description: "Specifies whether to use BigQuery's legacy SQL for this view. The default value is true. If set to false, the view will use BigQuery's GoogleSQL.",
+									},
+									"userDefinedFunctionResources": &dcl.Property{
+										Type:        "array",
+										GoName:      "UserDefinedFunctionResources",
+										Description: "Describes user-defined function resources used in the query.",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "object",
+											GoType: "TableViewUserDefinedFunctionResources",
+											Properties: map[string]*dcl.Property{
+												"inlineCode": &dcl.Property{
+													Type:        "string",
+													GoName:      "InlineCode",
+													Description: "[Pick one] An inline resource that contains code for a user-defined function (UDF). Providing a inline code resource is equivalent to providing a URI for a file containing the same code.",
+												},
+												"inlineCodeAlternative": &dcl.Property{
+													Type:        "array",
+													GoName:      "InlineCodeAlternative",
+													ReadOnly:    true,
+													Description: "Output only. Deprecated.",
+													Immutable:   true,
+													SendEmpty:   true,
+													ListType:    "list",
+													Items: &dcl.Property{
+														Type:   "string",
+														GoType: "string",
+													},
+												},
+												"resourceUri": &dcl.Property{
+													Type:        "string",
+													GoName:      "ResourceUri",
+													Description: "[Pick one] A code resource to load from a Google Cloud Storage URI (gs://bucket/path).",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package beta
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/google/go-cmp/cmp"
+)
+
+func tableField(name, typ, mode string) TableGooglecloudbigqueryv2Tablefieldschema {
+	f := TableGooglecloudbigqueryv2Tablefieldschema{Name: dcl.String(name), Type: dcl.String(typ)}
+	if mode != "" {
+		f.Mode = dcl.String(mode)
+	}
+	return f
+}
+
+func TestCompareTableSchemaEvolution(t *testing.T) {
+	// Each diff is described by its field name and resulting operation.
+	type diff struct {
+		Field, Operation string
+	}
+	record := func(name string, fields ...TableGooglecloudbigqueryv2Tablefieldschema) TableGooglecloudbigqueryv2Tablefieldschema {
+		f := tableField(name, "RECORD", "")
+		f.Fields = fields
+		return f
+	}
+	tests := []struct {
+		name    string
+		desired []TableGooglecloudbigqueryv2Tablefieldschema
+		actual  []TableGooglecloudbigqueryv2Tablefieldschema
+		want    []diff
+	}{
+		{
+			name:    "same columns",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "REQUIRED")},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "REQUIRED")},
+		},
+		{
+			name:    "names match case-insensitively",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("ID", "INTEGER", ""), tableField("Name", "STRING", "")},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{tableField("name", "STRING", ""), tableField("id", "INTEGER", "")},
+		},
+		{
+			name:    "type aliases",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INT64", ""), tableField("ok", "bool", "")},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", ""), tableField("ok", "BOOLEAN", "")},
+		},
+		{
+			name:    "missing mode is NULLABLE",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "NULLABLE")},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "")},
+		},
+		{
+			name:    "add NULLABLE column",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", ""), tableField("name", "STRING", "")},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "")},
+			want:    []diff{{"Schema.Fields[1]", tableSchemaUpdateOperation}},
+		},
+		{
+			name:    "add REPEATED column",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", ""), tableField("tags", "STRING", "REPEATED")},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "")},
+			want:    []diff{{"Schema.Fields[1]", tableSchemaUpdateOperation}},
+		},
+		{
+			name:    "add REQUIRED column",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", ""), tableField("name", "STRING", "REQUIRED")},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "")},
+			want:    []diff{{"Schema.Fields[1]", "Recreate"}},
+		},
+		{
+			name:    "remove column",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "")},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", ""), tableField("name", "STRING", "")},
+			want:    []diff{{"Schema.Fields[1]", "Recreate"}},
+		},
+		{
+			name:    "change type",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "STRING", "")},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "")},
+			want:    []diff{{"Schema.Fields[0].Type", "Recreate"}},
+		},
+		{
+			name:    "relax REQUIRED to NULLABLE",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "NULLABLE")},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "REQUIRED")},
+			want:    []diff{{"Schema.Fields[0].Mode", tableSchemaUpdateOperation}},
+		},
+		{
+			name:    "tighten NULLABLE to REQUIRED",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "REQUIRED")},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "")},
+			want:    []diff{{"Schema.Fields[0].Mode", "Recreate"}},
+		},
+		{
+			name:    "change REQUIRED to REPEATED",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "REPEATED")},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "REQUIRED")},
+			want:    []diff{{"Schema.Fields[0].Mode", "Recreate"}},
+		},
+		{
+			name: "change description",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{func() TableGooglecloudbigqueryv2Tablefieldschema {
+				f := tableField("id", "INTEGER", "")
+				f.Description = dcl.String("the id")
+				return f
+			}()},
+			actual: []TableGooglecloudbigqueryv2Tablefieldschema{tableField("id", "INTEGER", "")},
+			want:   []diff{{"Schema.Fields[0].Description", tableSchemaUpdateOperation}},
+		},
+		{
+			name:    "add nested column",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{record("address", tableField("city", "STRING", ""), tableField("zip", "STRING", ""))},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{record("Address", tableField("City", "STRING", ""))},
+			want:    []diff{{"Schema.Fields[0].Fields[1]", tableSchemaUpdateOperation}},
+		},
+		{
+			name:    "remove nested column",
+			desired: []TableGooglecloudbigqueryv2Tablefieldschema{record("address", tableField("city", "STRING", ""))},
+			actual:  []TableGooglecloudbigqueryv2Tablefieldschema{record("address", tableField("city", "STRING", ""), tableField("zip", "STRING", ""))},
+			want:    []diff{{"Schema.Fields[0].Fields[1]", "Recreate"}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ds, err := compareTableSchemaEvolution(&TableSchema{Fields: tc.desired}, &TableSchema{Fields: tc.actual}, dcl.FieldName{FieldName: "Schema"})
+			if err != nil {
+				t.Fatalf("compareTableSchemaEvolution() returned error: %v", err)
+			}
+			var got []diff
+			for _, d := range ds {
+				for _, op := range d.ResultingOperation {
+					got = append(got, diff{d.FieldName, op})
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("compareTableSchemaEvolution() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
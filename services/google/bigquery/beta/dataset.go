@@ -316,8 +316,12 @@ func (l *DatasetList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *DatasetList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *DatasetList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dataset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -334,7 +338,8 @@ func (l *DatasetList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListDataset(ctx context.Context, project string) (*DatasetList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Dataset{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dataset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListDatasetWithMaxResults(ctx, project, DatasetMaxPage)
@@ -342,14 +347,18 @@ func (c *Client) ListDataset(ctx context.Context, project string) (*DatasetList,
 }
 
 func (c *Client) ListDatasetWithMaxResults(ctx context.Context, project string, pageSize int32) (*DatasetList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListDatasetWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListDatasetWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*DatasetList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dataset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Dataset{
 		Project: &project,
 	}
-	items, token, err := c.listDataset(ctx, r, "", pageSize)
+	items, token, err := c.listDataset(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -363,7 +372,8 @@ func (c *Client) ListDatasetWithMaxResults(ctx context.Context, project string,
 
 func (c *Client) GetDataset(ctx context.Context, r *Dataset) (*Dataset, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Dataset{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dataset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -379,7 +389,7 @@ func (c *Client) GetDataset(ctx context.Context, r *Dataset) (*Dataset, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalDataset(b, c, r)
 	if err != nil {
@@ -404,7 +414,8 @@ func (c *Client) GetDataset(ctx context.Context, r *Dataset) (*Dataset, error) {
 
 func (c *Client) DeleteDataset(ctx context.Context, r *Dataset) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Dataset{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dataset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -412,7 +423,13 @@ func (c *Client) DeleteDataset(ctx context.Context, r *Dataset) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Dataset...")
 	deleteOp := deleteDatasetOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteDatasetAsync(ctx context.Context, r *Dataset) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteDataset(ctx, r)
+	})
 }
 
 // DeleteAllDataset deletes all resources that the filter functions returns true on.
@@ -440,10 +457,11 @@ func (c *Client) DeleteAllDataset(ctx context.Context, project string, filter fu
 }
 
 func (c *Client) ApplyDataset(ctx context.Context, rawDesired *Dataset, opts ...dcl.ApplyOption) (*Dataset, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dataset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Dataset{}).Describe())
 	var resultNewState *Dataset
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyDatasetHelper(c, ctx, rawDesired, opts...)
@@ -461,6 +479,38 @@ func (c *Client) ApplyDataset(ctx context.Context, rawDesired *Dataset, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyDatasetAsync(ctx context.Context, rawDesired *Dataset, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyDataset(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffDataset returns the field-level differences between rawDesired and the
+// live Dataset without modifying it. If the Dataset does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffDataset(ctx context.Context, rawDesired *Dataset, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dataset{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Dataset{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractDatasetFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.datasetDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Dataset %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyDatasetHelper(c *Client, ctx context.Context, rawDesired *Dataset, opts ...dcl.ApplyOption) (*Dataset, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyDataset...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -469,6 +519,9 @@ func applyDatasetHelper(c *Client, ctx context.Context, rawDesired *Dataset, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractDatasetFields(rawDesired); err != nil {
 		return nil, err
@@ -527,7 +580,7 @@ func applyDatasetHelper(c *Client, ctx context.Context, rawDesired *Dataset, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -541,6 +594,8 @@ func applyDatasetDiff(c *Client, ctx context.Context, desired *Dataset, rawDesir
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
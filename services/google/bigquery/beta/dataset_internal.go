@@ -414,6 +414,14 @@ func (c *Client) getDatasetRaw(ctx context.Context, r *Dataset) ([]byte, error)
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Dataset) withDefaultLabels(c *dcl.Config, current map[string]string) *Dataset {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) datasetDiffsForRawDesired(ctx context.Context, rawDesired *Dataset, opts ...dcl.ApplyOption) (initial, desired *Dataset, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -431,10 +439,16 @@ func (c *Client) datasetDiffsForRawDesired(ctx context.Context, rawDesired *Data
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetDataset(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Dataset resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Dataset resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Dataset resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Dataset resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -448,6 +462,12 @@ func (c *Client) datasetDiffsForRawDesired(ctx context.Context, rawDesired *Data
 	if err := extractDatasetFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeDatasetInitialState(rawInitial, rawDesired)
@@ -465,7 +485,7 @@ func (c *Client) datasetDiffsForRawDesired(ctx context.Context, rawDesired *Data
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffDataset(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeDatasetInitialState(rawInitial, rawDesired *Dataset) (*Dataset, error) {
@@ -1330,7 +1350,7 @@ func diffDataset(c *Client, desired, actual *Dataset, opts ...dcl.ApplyOption) (
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateDatasetPatchDatasetOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateDatasetPatchDatasetOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
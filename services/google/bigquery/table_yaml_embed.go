@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package bigquery -var YAML_table blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/bigquery/table.yaml
+
+package bigquery
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/bigquery/table.yaml
+var YAML_table = []byte("info:\n  title: Bigquery/Table\n  description: The Bigquery Table resource\n  x-dcl-struct-name: Table\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a Table\n    parameters:\n    - name: table\n      required: true\n      description: A full instance of a Table\n  apply:\n    description: The function used to apply information about a Table\n    parameters:\n    - name: table\n      required: true\n      description: A full instance of a Table\n  delete:\n    description: The function used to delete a Table\n    parameters:\n    - name: table\n      required: true\n      description: A full instance of a Table\n  deleteAll:\n    description: The function used to delete all Table\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: dataset\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many Table\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: dataset\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    Table:\n      title: Table\n      x-dcl-id: projects/{{project}}/datasets/{{dataset}}/tables/{{name}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - name\n      - dataset\n      - project\n      properties:\n        clustering:\n          type: object\n          x-dcl-go-name: Clustering\n          x-dcl-go-type: TableClustering\n          description: Clustering specification for the table. Must be specified with\n            time-based partitioning, data in the table will be first partitioned and\n            subsequently clustered.\n          properties:\n            fields:\n              type: array\n              x-dcl-go-name: Fields\n              description: One or more fields on which data should be clustered. Only\n                top-level, non-repeated, simple-type fields are supported. When you\n                cluster a table using multiple columns, the order of columns you specify\n                is important. The order of the specified columns determines the sort\n                order of the data.\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: string\n                x-dcl-go-type: string\n        creationTime:\n          type: integer\n          format: int64\n          x-dcl-go-name: CreationTime\n          readOnly: true\n          description: Output only. The time when this table was created, in milliseconds\n            since the epoch.\n          x-kubernetes-immutable: true\n        dataset:\n          type: string\n          x-dcl-go-name: Dataset\n          description: The ID of the dataset containing this table.\n          x-dcl-references:\n          - resource: Bigquery/Dataset\n            field: name\n            parent: true\n        defaultCollation:\n          type: string\n          x-dcl-go-name: DefaultCollation\n          description: 'Defines the default collation specification of new STRING\n            fields in the table. During table creation or update, if a STRING field\n            is added to this table without explicit collation specified, then the\n            table inherits the table default collation. A change to this field affects\n            only fields added afterwards, and does not alter the existing fields.\n            The following values are supported: ''und:ci'' (undetermined locale, case\n            insensitive) and '''' (empty string, equivalent to case-sensitive behavior).'\n        description:\n          type: string\n          x-dcl-go-name: Description\n          description: A user-friendly description of this table.\n        encryptionConfiguration:\n          type: object\n          x-dcl-go-name: EncryptionConfiguration\n          x-dcl-go-type: TableEncryptionConfiguration\n          description: Custom encryption configuration (e.g., Cloud KMS keys).\n          properties:\n            kmsKeyName:\n              type: string\n              x-dcl-go-name: KmsKeyName\n              description: Describes the Cloud KMS encryption key that will be used\n                to protect destination BigQuery table. The BigQuery Service Account\n                associated with your project requires access to this encryption key.\n        etag:\n          type: string\n          x-dcl-go-name: Etag\n          readOnly: true\n          description: Output only. A hash of the table metadata. Used to ensure there\n            were no concurrent modifications to the resource when attempting an update.\n            Not guaranteed to change when the table contents or the fields numRows,\n            numBytes, numLongTermBytes or lastModifiedTime change.\n          x-kubernetes-immutable: true\n        expirationTime:\n          type: integer\n          format: int64\n          x-dcl-go-name: ExpirationTime\n          description: The time when this table expires, in milliseconds since the\n            epoch. If not present, the table will persist indefinitely. Expired tables\n            will be deleted and their storage reclaimed.\n        externalDataConfiguration:\n          type: object\n          x-dcl-go-name: ExternalDataConfiguration\n          x-dcl-go-type: TableExternalDataConfiguration\n          description: Describes the data format, location, and other properties of\n            a table stored outside of BigQuery. By defining these properties, the\n            data source can then be queried as if it were a standard BigQuery table.\n          properties:\n            autodetect:\n              type: boolean\n              x-dcl-go-name: Autodetect\n              description: Try to detect schema and format options automatically.\n                Any option specified explicitly will be honored.\n            avroOptions:\n              type: object\n              x-dcl-go-name: AvroOptions\n              x-dcl-go-type: TableExternalDataConfigurationAvroOptions\n              description: Additional properties to set if sourceFormat is set to\n                AVRO.\n              properties:\n                useAvroLogicalTypes:\n                  type: boolean\n                  x-dcl-go-name: UseAvroLogicalTypes\n                  description: If sourceFormat is set to \"AVRO\", indicates whether\n                    to interpret logical types as the corresponding BigQuery data\n                    type (for example, TIMESTAMP), instead of using the raw type (for\n                    example, INTEGER).\n            bigtableOptions:\n              type: object\n              x-dcl-go-name: BigtableOptions\n              x-dcl-go-type: TableExternalDataConfigurationBigtableOptions\n              description: Additional options if sourceFormat is set to BIGTABLE.\n              properties:\n                columnFamilies:\n                  type: array\n                  x-dcl-go-name: ColumnFamilies\n                  description: List of column families to expose in the table schema\n                    along with their types. This list restricts the column families\n                    that can be referenced in queries and specifies their value types.\n                  x-dcl-send-empty: true\n                  x-dcl-list-type: list\n                  items:\n                    type: object\n                    x-dcl-go-type: TableExternalDataConfigurationBigtableOptionsColumnFamilies\n                    properties:\n                      columns:\n                        type: array\n                        x-dcl-go-name: Columns\n                        description: Lists of columns that should be exposed as individual\n                          fields as opposed to a list of (column name, value) pairs.\n                        x-dcl-send-empty: true\n                        x-dcl-list-type: list\n                        items:\n                          type: object\n                          x-dcl-go-type: TableExternalDataConfigurationBigtableOptionsColumnFamiliesColumns\n                          properties:\n                            encoding:\n                              type: string\n                              x-dcl-go-name: Encoding\n                              description: 'The encoding of the values when the type\n                                is not STRING. Acceptable encoding values are: TEXT\n                                and BINARY.'\n                            fieldName:\n                              type: string\n                              x-dcl-go-name: FieldName\n                              description: If the qualifier is not a valid BigQuery\n                                field identifier i.e. does not match a-zA-Z*, a valid\n                                identifier must be provided as the column field name\n                                and is used as field name in queries.\n                            onlyReadLatest:\n                              type: boolean\n                              x-dcl-go-name: OnlyReadLatest\n                              description: If this is set, only the latest version\n                                of value in this column are exposed.\n                            qualifierEncoded:\n                              type: string\n                              x-dcl-go-name: QualifierEncoded\n                              description: Qualifier of the column. Columns in the\n                                parent column family that has this exact qualifier\n                                are exposed as `.` field. If the qualifier is valid\n                                UTF-8 string, it can be specified in the qualifier_string\n                                field. Otherwise, a base-64 encoded value must be\n                                set to qualifier_encoded.\n                            qualifierString:\n                              type: string\n                              x-dcl-go-name: QualifierString\n                              description: Qualifier string.\n                            type:\n                              type: string\n                              x-dcl-go-name: Type\n                              description: 'The type to convert the value in cells\n                                of this column. The values are expected to be encoded\n                                using HBase Bytes.toBytes function when using the\n                                BINARY encoding value. Following BigQuery types are\n                                allowed (case-sensitive): BYTES, STRING, INTEGER,\n                                FLOAT, BOOLEAN and JSON. Default type is BYTES.'\n                      encoding:\n                        type: string\n                        x-dcl-go-name: Encoding\n                        description: 'The encoding of the values when the type is\n                          not STRING. Acceptable encoding values are: TEXT and BINARY.'\n                      familyId:\n                        type: string\n                        x-dcl-go-name: FamilyId\n                        description: Identifier of the column family.\n                      onlyReadLatest:\n                        type: boolean\n                        x-dcl-go-name: OnlyReadLatest\n                        description: If this is set only the latest version of value\n                          are exposed for all columns in this column family.\n                      type:\n                        type: string\n                        x-dcl-go-name: Type\n                        description: 'The type to convert the value in cells of this\n                          column family. The values are expected to be encoded using\n                          HBase Bytes.toBytes function when using the BINARY encoding\n                          value. Following BigQuery types are allowed (case-sensitive):\n                          BYTES, STRING, INTEGER, FLOAT, BOOLEAN and JSON. Default\n                          type is BYTES.'\n                ignoreUnspecifiedColumnFamilies:\n                  type: boolean\n                  x-dcl-go-name: IgnoreUnspecifiedColumnFamilies\n                  description: If field is true, then the column families that are\n                    not specified in columnFamilies list are not exposed in the table\n                    schema. Otherwise, they are read with BYTES type values. The default\n                    value is false.\n                readRowkeyAsString:\n                  type: boolean\n                  x-dcl-go-name: ReadRowkeyAsString\n                  description: If field is true, then the rowkey column families will\n                    be read and converted to string. Otherwise they are read with\n                    BYTES type values and users need to manually cast them with CAST\n                    if necessary. The default value is false.\n            compression:\n              type: string\n              x-dcl-go-name: Compression\n              description: The compression type of the data source. Possible values\n                include GZIP and NONE. The default value is NONE. This setting is\n                ignored for Google Cloud Bigtable, Google Cloud Datastore backups,\n                Avro, ORC and Parquet formats.\n            connectionId:\n              type: string\n              x-dcl-go-name: ConnectionId\n              description: The connection specifying the credentials to be used to\n                read external storage, such as Azure Blob, Cloud Storage, or S3. The\n                connection_id can have the form \"..\" or \"projects//locations//connections/\".\n            csvOptions:\n              type: object\n              x-dcl-go-name: CsvOptions\n              x-dcl-go-type: TableExternalDataConfigurationCsvOptions\n              description: Additional properties to set if sourceFormat is set to\n                CSV.\n              properties:\n                allowJaggedRows:\n                  type: boolean\n                  x-dcl-go-name: AllowJaggedRows\n                  description: Indicates if BigQuery should accept rows that are missing\n                    trailing optional columns. If true, BigQuery treats missing trailing\n                    columns as null values. If false, records with missing trailing\n                    columns are treated as bad records. The default value is false.\n                allowQuotedNewlines:\n                  type: boolean\n                  x-dcl-go-name: AllowQuotedNewlines\n                  description: Indicates if BigQuery should allow quoted data sections\n                    that contain newline characters in a CSV file. The default value\n                    is false.\n                encoding:\n                  type: string\n                  x-dcl-go-name: Encoding\n                  description: The character encoding of the data. The supported values\n                    are UTF-8 or ISO-8859-1. The default value is UTF-8.\n                fieldDelimiter:\n                  type: string\n                  x-dcl-go-name: FieldDelimiter\n                  description: The separator character for fields in a CSV file. The\n                    separator is interpreted as a single byte. For files encoded in\n                    ISO-8859-1, any single character can be used as a separator. For\n                    files encoded in UTF-8, characters represented in decimal range\n                    1-127 (U+0001-U+007F) can be used without any modification. The\n                    default value is comma (',').\n                quote:\n                  type: string\n                  x-dcl-go-name: Quote\n                  description: The value that is used to quote data sections in a\n                    CSV file. BigQuery converts the string to ISO-8859-1 encoding,\n                    and then uses the first byte of the encoded string to split the\n                    data in its raw, binary state. The default value is a double-quote\n                    ('\"'). If your data does not contain quoted sections, set the\n                    property value to an empty string.\n                skipLeadingRows:\n                  type: string\n                  x-dcl-go-name: SkipLeadingRows\n                  description: The number of rows at the top of a CSV file that BigQuery\n                    will skip when reading the data. The default value is 0. This\n                    property is useful if you have header rows in the file that should\n                    be skipped.\n            decimalTargetTypes:\n              type: array\n              x-dcl-go-name: DecimalTargetTypes\n              description: 'Defines the list of possible SQL data types to which the\n                source decimal values are converted. This list and the precision and\n                the scale parameters of the decimal field determine the target type.\n                In the order of NUMERIC, BIGNUMERIC, and STRING, a type is picked\n                if it is in the specified list and if it supports the precision and\n                the scale. STRING supports all precision and scale values. Possible\n                values: DECIMAL_TARGET_TYPE_UNSPECIFIED, NUMERIC, BIGNUMERIC, STRING'\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: string\n                x-dcl-go-type: TableExternalDataConfigurationDecimalTargetTypesEnum\n                enum:\n                - DECIMAL_TARGET_TYPE_UNSPECIFIED\n                - NUMERIC\n                - BIGNUMERIC\n                - STRING\n            googleSheetsOptions:\n              type: object\n              x-dcl-go-name: GoogleSheetsOptions\n              x-dcl-go-type: TableExternalDataConfigurationGoogleSheetsOptions\n              description: Additional options if sourceFormat is set to GOOGLE_SHEETS.\n              properties:\n                range:\n                  type: string\n                  x-dcl-go-name: Range\n                  description: 'Range of a sheet to query from. Only used when non-empty.\n                    Typical format: sheet_name!top_left_cell_id:bottom_right_cell_id\n                    For example: sheet1!A1:B20'\n                skipLeadingRows:\n                  type: string\n                  x-dcl-go-name: SkipLeadingRows\n                  description: The number of rows at the top of a sheet that BigQuery\n                    will skip when reading the data. The default value is 0.\n            hivePartitioningOptions:\n              type: object\n              x-dcl-go-name: HivePartitioningOptions\n              x-dcl-go-type: TableExternalDataConfigurationHivePartitioningOptions\n              description: When set, configures hive partitioning support. Not all\n                storage formats support hive partitioning -- requesting hive partitioning\n                on an unsupported format will lead to an error, as will providing\n                an invalid specification.\n              properties:\n                fields:\n                  type: array\n                  x-dcl-go-name: Fields\n                  description: For permanent external tables, this field is populated\n                    with the hive partition keys in the order they were inferred.\n                    The types of the partition keys can be deduced by checking the\n                    table schema (which will include the partition keys). Not every\n                    API will populate this field in the output.\n                  x-dcl-send-empty: true\n                  x-dcl-list-type: list\n                  items:\n                    type: string\n                    x-dcl-go-type: string\n                mode:\n                  type: string\n                  x-dcl-go-name: Mode\n                  description: 'When set, what mode of hive partitioning to use when\n                    reading data. The following modes are supported: AUTO, STRINGS\n                    and CUSTOM.'\n                requirePartitionFilter:\n                  type: boolean\n                  x-dcl-go-name: RequirePartitionFilter\n                  description: If set to true, queries over this table require a partition\n                    filter that can be used for partition elimination to be specified.\n                sourceUriPrefix:\n                  type: string\n                  x-dcl-go-name: SourceUriPrefix\n                  description: When hive partition detection is requested, a common\n                    prefix for all source uris must be required. The prefix must end\n                    immediately before the partition key encoding begins.\n            ignoreUnknownValues:\n              type: boolean\n              x-dcl-go-name: IgnoreUnknownValues\n              description: Indicates if BigQuery should allow extra values that are\n                not represented in the table schema. If true, the extra values are\n                ignored. If false, records with extra columns are treated as bad records,\n                and if there are too many bad records, an invalid error is returned\n                in the job result. The default value is false.\n            jsonExtension:\n              type: string\n              x-dcl-go-name: JsonExtension\n              x-dcl-go-type: TableExternalDataConfigurationJsonExtensionEnum\n              description: 'Load option to be used together with source_format newline-delimited\n                JSON to indicate that a variant of JSON is being loaded. To load newline-delimited\n                GeoJSON, specify GEOJSON (and source_format must be set to NEWLINE_DELIMITED_JSON).\n                Possible values: JSON_EXTENSION_UNSPECIFIED, GEOJSON'\n              enum:\n              - JSON_EXTENSION_UNSPECIFIED\n              - GEOJSON\n            maxBadRecords:\n              type: integer\n              format: int64\n              x-dcl-go-name: MaxBadRecords\n              description: The maximum number of bad records that BigQuery can ignore\n                when reading data. If the number of bad records exceeds this value,\n                an invalid error is returned in the job result. The default value\n                is 0, which requires that all records are valid. This setting is ignored\n                for Google Cloud Bigtable, Google Cloud Datastore backups, Avro, ORC\n                and Parquet formats.\n            maxBadRecordsAlternative:\n              type: array\n              x-dcl-go-name: MaxBadRecordsAlternative\n              readOnly: true\n              description: Output only. Deprecated.\n              x-kubernetes-immutable: true\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: integer\n                format: int64\n                x-dcl-go-type: int64\n            parquetOptions:\n              type: object\n              x-dcl-go-name: ParquetOptions\n              x-dcl-go-type: TableExternalDataConfigurationParquetOptions\n              description: Additional properties to set if sourceFormat is set to\n                PARQUET.\n              properties:\n                enableListInference:\n                  type: boolean\n                  x-dcl-go-name: EnableListInference\n                  description: Indicates whether to use schema inference specifically\n                    for Parquet LIST logical type.\n                enumAsString:\n                  type: boolean\n                  x-dcl-go-name: EnumAsString\n                  description: Indicates whether to infer Parquet ENUM logical type\n                    as STRING instead of BYTES by default.\n            schema:\n              type: object\n              x-dcl-go-name: Schema\n              x-dcl-go-type: TableExternalDataConfigurationSchema\n              description: The schema for the data. Schema is required for CSV and\n                JSON formats if autodetect is not on. Schema is disallowed for Google\n                Cloud Bigtable, Cloud Datastore backups, Avro, ORC and Parquet formats.\n              properties:\n                fields:\n                  type: array\n                  x-dcl-go-name: Fields\n                  description: Describes the fields in a table.\n                  x-dcl-send-empty: true\n                  x-dcl-list-type: list\n                  items:\n                    $ref: '#/components/schemas/Table/properties/schema/properties/fields/items'\n            sourceFormat:\n              type: string\n              x-dcl-go-name: SourceFormat\n              description: The data format. For CSV files, specify \"CSV\". For Google\n                sheets, specify \"GOOGLE_SHEETS\". For newline-delimited JSON, specify\n                \"NEWLINE_DELIMITED_JSON\". For Avro files, specify \"AVRO\". For Google\n                Cloud Datastore backups, specify \"DATASTORE_BACKUP\". For ORC files,\n                specify \"ORC\". For Parquet files, specify \"PARQUET\". For Google Cloud\n                Bigtable, specify \"BIGTABLE\".\n            sourceUris:\n              type: array\n              x-dcl-go-name: SourceUris\n              description: 'The fully-qualified URIs that point to your data in Google\n                Cloud. For Google Cloud Storage URIs: Each URI can contain one ''*''\n                wildcard character and it must come after the ''bucket'' name. For\n                Google Cloud Bigtable URIs: Exactly one URI can be specified and it\n                has be a fully specified and valid HTTPS URL for a Google Cloud Bigtable\n                table. For Google Cloud Datastore backups, exactly one URI can be\n                specified. Also, the ''*'' wildcard character is not allowed.'\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: string\n                x-dcl-go-type: string\n            valueConversionModes:\n              type: object\n              x-dcl-go-name: ValueConversionModes\n              x-dcl-go-type: TableExternalDataConfigurationValueConversionModes\n              description: Defines the options for converting values that are out\n                of range for their target column types.\n              properties:\n                numericTypeOutOfRangeConversionMode:\n                  type: string\n                  x-dcl-go-name: NumericTypeOutOfRangeConversionMode\n                  x-dcl-go-type: TableExternalDataConfigurationValueConversionModesNumericTypeOutOfRangeConversionModeEnum\n                  description: 'Out of range conversion mode for NUMERIC and BIGNUMERIC\n                    values. Possible values: NUMERIC_TYPE_VALUE_UNSPECIFIED, NUMERIC_TYPE_VALUE_REJECT,\n                    NUMERIC_TYPE_VALUE_ROUND'\n                  enum:\n                  - NUMERIC_TYPE_VALUE_UNSPECIFIED\n                  - NUMERIC_TYPE_VALUE_REJECT\n                  - NUMERIC_TYPE_VALUE_ROUND\n                temporalTypesOutOfRangeConversionMode:\n                  type: string\n                  x-dcl-go-name: TemporalTypesOutOfRangeConversionMode\n                  x-dcl-go-type: TableExternalDataConfigurationValueConversionModesTemporalTypesOutOfRangeConversionModeEnum\n                  description: 'Out of range conversion mode for TIMESTAMP, DATE,\n                    DATETIME and TIME values. Possible values: TEMPORAL_TYPES_VALUE_UNSPECIFIED,\n                    TEMPORAL_TYPES_VALUE_REJECT, TEMPORAL_TYPES_VALUE_CLAMP'\n                  enum:\n                  - TEMPORAL_TYPES_VALUE_UNSPECIFIED\n                  - TEMPORAL_TYPES_VALUE_REJECT\n                  - TEMPORAL_TYPES_VALUE_CLAMP\n        friendlyName:\n          type: string\n          x-dcl-go-name: FriendlyName\n          description: A descriptive name for this table.\n        id:\n          type: string\n          x-dcl-go-name: Id\n          readOnly: true\n          description: Output only. An opaque ID uniquely identifying the table.\n          x-kubernetes-immutable: true\n        labels:\n          type: object\n          additionalProperties:\n            type: string\n          x-dcl-go-name: Labels\n          description: The labels associated with this table. You can use these to\n            organize and group your tables. Label keys and values can be no longer\n            than 63 characters, can only contain lowercase letters, numeric characters,\n            underscores and dashes. International characters are allowed. Label values\n            are optional. Label keys must start with a letter and each label in the\n            list must have a different key.\n        lastModifiedTime:\n          type: integer\n          format: int64\n          x-dcl-go-name: LastModifiedTime\n          readOnly: true\n          description: Output only. The time when this table was last modified, in\n            milliseconds since the epoch.\n          x-kubernetes-immutable: true\n        location:\n          type: string\n          x-dcl-go-name: Location\n          readOnly: true\n          description: Output only. The geographic location where the table resides.\n            This value is inherited from the dataset.\n          x-kubernetes-immutable: true\n        materializedView:\n          type: object\n          x-dcl-go-name: MaterializedView\n          x-dcl-go-type: TableMaterializedView\n          description: The materialized view definition.\n          properties:\n            enableRefresh:\n              type: boolean\n              x-dcl-go-name: EnableRefresh\n              description: Enable automatic refresh of the materialized view when\n                the base table is updated. The default value is \"true\".\n            lastRefreshTime:\n              type: integer\n              format: int64\n              x-dcl-go-name: LastRefreshTime\n              readOnly: true\n              description: Output only. The time when this materialized view was last\n                refreshed, in milliseconds since the epoch.\n              x-kubernetes-immutable: true\n            query:\n              type: string\n              x-dcl-go-name: Query\n              description: A query whose result is persisted.\n            refreshIntervalMs:\n              type: integer\n              format: int64\n              x-dcl-go-name: RefreshIntervalMs\n              description: The maximum frequency at which this materialized view will\n                be refreshed. The default value is \"1800000\" (30 minutes).\n        model:\n          type: object\n          x-dcl-go-name: Model\n          x-dcl-go-type: TableModel\n          readOnly: true\n          description: Output only. Deprecated.\n          x-kubernetes-immutable: true\n          properties:\n            modelOptions:\n              type: object\n              x-dcl-go-name: ModelOptions\n              x-dcl-go-type: TableModelModelOptions\n              readOnly: true\n              description: Output only. Deprecated.\n              x-kubernetes-immutable: true\n              properties:\n                labels:\n                  type: array\n                  x-dcl-go-name: Labels\n                  readOnly: true\n                  description: Output only. Deprecated.\n                  x-kubernetes-immutable: true\n                  x-dcl-send-empty: true\n                  x-dcl-list-type: list\n                  items:\n                    type: string\n                    x-dcl-go-type: string\n                lossType:\n                  type: string\n                  x-dcl-go-name: LossType\n                  readOnly: true\n                  description: Output only. Deprecated.\n                  x-kubernetes-immutable: true\n                modelType:\n                  type: string\n                  x-dcl-go-name: ModelType\n                  readOnly: true\n                  description: Output only. Deprecated.\n                  x-kubernetes-immutable: true\n            trainingRuns:\n              type: array\n              x-dcl-go-name: TrainingRuns\n              readOnly: true\n              description: Output only. Deprecated.\n              x-kubernetes-immutable: true\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: object\n                x-dcl-go-type: TableModelTrainingRuns\n                properties:\n                  iterationResults:\n                    type: array\n                    x-dcl-go-name: IterationResults\n                    readOnly: true\n                    description: Output only. Deprecated.\n                    x-kubernetes-immutable: true\n                    x-dcl-send-empty: true\n                    x-dcl-list-type: list\n                    items:\n                      type: object\n                      x-dcl-go-type: TableModelTrainingRunsIterationResults\n                      properties:\n                        durationMs:\n                          type: string\n                          x-dcl-go-name: DurationMs\n                          readOnly: true\n                          description: Output only. Deprecated.\n                          x-kubernetes-immutable: true\n                        evalLoss:\n                          type: number\n                          format: double\n                          x-dcl-go-name: EvalLoss\n                          readOnly: true\n                          description: Output only. Deprecated.\n                          x-kubernetes-immutable: true\n                        index:\n                          type: integer\n                          format: int64\n                          x-dcl-go-name: Index\n                          readOnly: true\n                          description: Output only. Deprecated.\n                          x-kubernetes-immutable: true\n                        learnRate:\n                          type: number\n                          format: double\n                          x-dcl-go-name: LearnRate\n                          readOnly: true\n                          description: Output only. Deprecated.\n                          x-kubernetes-immutable: true\n                        trainingLoss:\n                          type: number\n                          format: double\n                          x-dcl-go-name: TrainingLoss\n                          readOnly: true\n                          description: Output only. Deprecated.\n                          x-kubernetes-immutable: true\n                  startTime:\n                    type: string\n                    x-dcl-go-name: StartTime\n                    readOnly: true\n                    description: Output only. Deprecated.\n                    x-kubernetes-immutable: true\n                  state:\n                    type: string\n                    x-dcl-go-name: State\n                    readOnly: true\n                    description: Output only. Deprecated.\n                    x-kubernetes-immutable: true\n                  trainingOptions:\n                    type: object\n                    x-dcl-go-name: TrainingOptions\n                    x-dcl-go-type: TableModelTrainingRunsTrainingOptions\n                    readOnly: true\n                    description: Output only. Deprecated.\n                    x-kubernetes-immutable: true\n                    properties:\n                      earlyStop:\n                        type: boolean\n                        x-dcl-go-name: EarlyStop\n                        readOnly: true\n                        description: Output only. Deprecated.\n                        x-kubernetes-immutable: true\n                      l1Reg:\n                        type: number\n                        format: double\n                        x-dcl-go-name: L1Reg\n                        readOnly: true\n                        description: Output only. Deprecated.\n                        x-kubernetes-immutable: true\n                      l2Reg:\n                        type: number\n                        format: double\n                        x-dcl-go-name: L2Reg\n                        readOnly: true\n                        description: Output only. Deprecated.\n                        x-kubernetes-immutable: true\n                      learnRate:\n                        type: number\n                        format: double\n                        x-dcl-go-name: LearnRate\n                        readOnly: true\n                        description: Output only. Deprecated.\n                        x-kubernetes-immutable: true\n                      learnRateStrategy:\n                        type: string\n                        x-dcl-go-name: LearnRateStrategy\n                        readOnly: true\n                        description: Output only. Deprecated.\n                        x-kubernetes-immutable: true\n                      lineSearchInitLearnRate:\n                        type: number\n                        format: double\n                        x-dcl-go-name: LineSearchInitLearnRate\n                        readOnly: true\n                        description: Output only. Deprecated.\n                        x-kubernetes-immutable: true\n                      maxIteration:\n                        type: integer\n                        format: int64\n                        x-dcl-go-name: MaxIteration\n                        readOnly: true\n                        description: Output only. Deprecated.\n                        x-kubernetes-immutable: true\n                      minRelProgress:\n                        type: number\n                        format: double\n                        x-dcl-go-name: MinRelProgress\n                        readOnly: true\n                        description: Output only. Deprecated.\n                        x-kubernetes-immutable: true\n                      warmStart:\n                        type: boolean\n                        x-dcl-go-name: WarmStart\n                        readOnly: true\n                        description: Output only. Deprecated.\n                        x-kubernetes-immutable: true\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: The ID of the table. The ID must contain only letters (a-z,\n            A-Z), numbers (0-9), or underscores (_). The maximum length is 1,024 characters.\n            Certain operations allow suffixing of the table ID with a partition decorator,\n            such as `sample_table$20190123`.\n          x-kubernetes-immutable: true\n        numBytes:\n          type: string\n          x-dcl-go-name: NumBytes\n          readOnly: true\n          description: Output only. The size of this table in logical bytes, excluding\n            any data in the streaming buffer.\n          x-kubernetes-immutable: true\n        numLongTermBytes:\n          type: string\n          x-dcl-go-name: NumLongTermBytes\n          readOnly: true\n          description: Output only. The number of logical bytes in the table that\n            are considered \"long-term storage\".\n          x-kubernetes-immutable: true\n        numPhysicalBytes:\n          type: string\n          x-dcl-go-name: NumPhysicalBytes\n          readOnly: true\n          description: Output only. The physical size of this table in bytes. This\n            includes storage used for time travel.\n          x-kubernetes-immutable: true\n        numRows:\n          type: integer\n          format: int64\n          x-dcl-go-name: NumRows\n          readOnly: true\n          description: Output only. The number of rows of data in this table, excluding\n            any data in the streaming buffer.\n          x-kubernetes-immutable: true\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: The ID of the project containing this table.\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        rangePartitioning:\n          type: object\n          x-dcl-go-name: RangePartitioning\n          x-dcl-go-type: TableRangePartitioning\n          description: If specified, configures range partitioning for this table.\n          x-kubernetes-immutable: true\n          properties:\n            field:\n              type: string\n              x-dcl-go-name: Field\n              description: The table is partitioned by this field. The field must\n                be a top-level NULLABLE/REQUIRED field. The only supported type is\n                INTEGER/INT64.\n              x-kubernetes-immutable: true\n            range:\n              type: object\n              x-dcl-go-name: Range\n              x-dcl-go-type: TableRangePartitioningRange\n              description: Defines the ranges for range partitioning.\n              x-kubernetes-immutable: true\n              properties:\n                end:\n                  type: string\n                  x-dcl-go-name: End\n                  description: The end of range partitioning, exclusive.\n                  x-kubernetes-immutable: true\n                interval:\n                  type: string\n                  x-dcl-go-name: Interval\n                  description: The width of each interval.\n                  x-kubernetes-immutable: true\n                start:\n                  type: string\n                  x-dcl-go-name: Start\n                  description: The start of range partitioning, inclusive.\n                  x-kubernetes-immutable: true\n        requirePartitionFilter:\n          type: boolean\n          x-dcl-go-name: RequirePartitionFilter\n          description: If set to true, queries over this table require a partition\n            filter that can be used for partition elimination to be specified.\n        schema:\n          type: object\n          x-dcl-go-name: Schema\n          x-dcl-go-type: TableSchema\n          description: Describes the schema of this table. Columns may be added to\n            an existing table as NULLABLE or REPEATED fields; removing a column, adding\n            a REQUIRED column or changing the type of a column requires the table\n            to be recreated.\n          properties:\n            fields:\n              type: array\n              x-dcl-go-name: Fields\n              description: Describes the fields in a table.\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: object\n                x-dcl-go-type: TableGooglecloudbigqueryv2Tablefieldschema\n                properties:\n                  categories:\n                    type: object\n                    x-dcl-go-name: Categories\n                    x-dcl-go-type: TableGooglecloudbigqueryv2TablefieldschemaCategories\n                    description: Deprecated.\n                    properties:\n                      names:\n                        type: array\n                        x-dcl-go-name: Names\n                        description: Deprecated.\n                        x-dcl-send-empty: true\n                        x-dcl-list-type: list\n                        items:\n                          type: string\n                          x-dcl-go-type: string\n                  collation:\n                    type: string\n                    x-dcl-go-name: Collation\n                    description: 'Field collation can be set only when the type of\n                      field is STRING. The following values are supported: ''und:ci''\n                      (undetermined locale, case insensitive) and '''' (empty string,\n                      equivalent to case-sensitive behavior).'\n                  defaultValueExpression:\n                    type: string\n                    x-dcl-go-name: DefaultValueExpression\n                    description: A SQL expression to specify the default value for\n                      this field.\n                  description:\n                    type: string\n                    x-dcl-go-name: Description\n                    description: The field description. The maximum length is 1,024\n                      characters.\n                  fields:\n                    type: array\n                    x-dcl-go-name: Fields\n                    description: Describes the nested schema fields if the type property\n                      is set to RECORD.\n                    x-dcl-send-empty: true\n                    x-dcl-list-type: list\n                    items:\n                      $ref: '#/components/schemas/Table/properties/schema/properties/fields/items'\n                  maxLength:\n                    type: integer\n                    format: int64\n                    x-dcl-go-name: MaxLength\n                    description: Maximum length of values of this field for STRINGS\n                      or BYTES. If max_length is not specified, no maximum length\n                      constraint is imposed on this field.\n                  mode:\n                    type: string\n                    x-dcl-go-name: Mode\n                    description: The field mode. Possible values include NULLABLE,\n                      REQUIRED and REPEATED. The default value is NULLABLE.\n                  name:\n                    type: string\n                    x-dcl-go-name: Name\n                    description: The field name. The name must contain only letters\n                      (a-z, A-Z), numbers (0-9), or underscores (_), and must start\n                      with a letter or underscore. The maximum length is 300 characters.\n                  nameAlternative:\n                    type: array\n                    x-dcl-go-name: NameAlternative\n                    readOnly: true\n                    description: Output only. Deprecated.\n                    x-kubernetes-immutable: true\n                    x-dcl-send-empty: true\n                    x-dcl-list-type: list\n                    items:\n                      type: string\n                      x-dcl-go-type: string\n                  policyTags:\n                    type: object\n                    x-dcl-go-name: PolicyTags\n                    x-dcl-go-type: TableGooglecloudbigqueryv2TablefieldschemaPolicyTags\n                    description: The policy tags attached to this field, used for\n                      field-level access control. If not set, defaults to empty policy_tags.\n                    properties:\n                      names:\n                        type: array\n                        x-dcl-go-name: Names\n                        description: A list of policy tag resource names. For example,\n                          \"projects/1/locations/eu/taxonomies/2/policyTags/3\". At\n                          most 1 policy tag is currently allowed.\n                        x-dcl-send-empty: true\n                        x-dcl-list-type: list\n                        items:\n                          type: string\n                          x-dcl-go-type: string\n                  precision:\n                    type: integer\n                    format: int64\n                    x-dcl-go-name: Precision\n                    description: Precision (maximum number of total digits in base\n                      10) for values of this field for NUMERIC or BIGNUMERIC. It is\n                      invalid to set precision or scale if type is not NUMERIC or\n                      BIGNUMERIC.\n                  scale:\n                    type: integer\n                    format: int64\n                    x-dcl-go-name: Scale\n                    description: Scale (maximum number of digits in the fractional\n                      part in base 10) for values of this field for NUMERIC or BIGNUMERIC.\n                      It is invalid to set precision or scale if type is not NUMERIC\n                      or BIGNUMERIC.\n                  type:\n                    type: string\n                    x-dcl-go-name: Type\n                    description: The field data type. Possible values include STRING,\n                      BYTES, INTEGER, INT64 (same as INTEGER), FLOAT, FLOAT64 (same\n                      as FLOAT), NUMERIC, BIGNUMERIC, BOOLEAN, BOOL (same as BOOLEAN),\n                      TIMESTAMP, DATE, TIME, DATETIME, INTERVAL, RECORD (where RECORD\n                      indicates that the field contains a nested schema) or STRUCT\n                      (same as RECORD).\n        selfLink:\n          type: string\n          x-dcl-go-name: SelfLink\n          readOnly: true\n          description: Output only. A URL that can be used to access this resource\n            again.\n          x-kubernetes-immutable: true\n        snapshotDefinition:\n          type: object\n          x-dcl-go-name: SnapshotDefinition\n          x-dcl-go-type: TableSnapshotDefinition\n          description: Contains information about the snapshot. This value is set\n            via snapshot creation.\n          x-kubernetes-immutable: true\n          properties:\n            dataset:\n              type: string\n              x-dcl-go-name: Dataset\n              description: The ID of the dataset containing the base table.\n              x-kubernetes-immutable: true\n            project:\n              type: string\n              x-dcl-go-name: Project\n              description: The ID of the project containing the base table.\n              x-kubernetes-immutable: true\n            snapshotTime:\n              type: string\n              x-dcl-go-name: SnapshotTime\n              description: The time at which the base table was snapshot. This value\n                is reported in the JSON response using RFC3339 format.\n              x-kubernetes-immutable: true\n            table:\n              type: string\n              x-dcl-go-name: Table\n              description: The ID of the base table.\n              x-kubernetes-immutable: true\n        streamingBuffer:\n          type: object\n          x-dcl-go-name: StreamingBuffer\n          x-dcl-go-type: TableStreamingBuffer\n          readOnly: true\n          description: Output only. Contains information regarding this table's streaming\n            buffer, if one is present. This field will be absent if the table is not\n            being streamed to or if there is no data in the streaming buffer.\n          x-kubernetes-immutable: true\n          properties:\n            estimatedBytes:\n              type: integer\n              format: int64\n              x-dcl-go-name: EstimatedBytes\n              readOnly: true\n              description: Output only. A lower-bound estimate of the number of bytes\n                currently in the streaming buffer.\n              x-kubernetes-immutable: true\n            estimatedRows:\n              type: integer\n              format: int64\n              x-dcl-go-name: EstimatedRows\n              readOnly: true\n              description: Output only. A lower-bound estimate of the number of rows\n                currently in the streaming buffer.\n              x-kubernetes-immutable: true\n            oldestEntryTime:\n              type: integer\n              format: int64\n              x-dcl-go-name: OldestEntryTime\n              readOnly: true\n              description: Output only. Contains the timestamp of the oldest entry\n                in the streaming buffer, in milliseconds since the epoch, if the streaming\n                buffer is available.\n              x-kubernetes-immutable: true\n        timePartitioning:\n          type: object\n          x-dcl-go-name: TimePartitioning\n          x-dcl-go-type: TableTimePartitioning\n          description: If specified, configures time-based partitioning for this table.\n          properties:\n            expirationMs:\n              type: string\n              x-dcl-go-name: ExpirationMs\n              description: Number of milliseconds for which to keep the storage for\n                a partition. A wrapper is used here because 0 is an invalid value.\n            field:\n              type: string\n              x-dcl-go-name: Field\n              description: If not set, the table is partitioned by pseudo column '_PARTITIONTIME';\n                if set, the table is partitioned by this field. The field must be\n                a top-level TIMESTAMP or DATE field. Its mode must be NULLABLE or\n                REQUIRED.\n              x-kubernetes-immutable: true\n            type:\n              type: string\n              x-dcl-go-name: Type\n              description: The supported types are DAY, HOUR, MONTH, and YEAR, which\n                will generate one partition per day, hour, month, and year, respectively.\n              x-kubernetes-immutable: true\n        type:\n          type: string\n          x-dcl-go-name: Type\n          readOnly: true\n          description: 'Output only. Describes the table type. The following values\n            are supported: TABLE, VIEW, SNAPSHOT, MATERIALIZED_VIEW and EXTERNAL.\n            The default value is TABLE.'\n          x-kubernetes-immutable: true\n        view:\n          type: object\n          x-dcl-go-name: View\n          x-dcl-go-type: TableView\n          description: The view definition.\n          properties:\n            query:\n              type: string\n              x-dcl-go-name: Query\n              description: A query that BigQuery executes when the view is referenced.\n            useExplicitColumnNames:\n              type: boolean\n              x-dcl-go-name: UseExplicitColumnNames\n              description: True if the column names are explicitly specified. For\n                example by using the 'CREATE VIEW v(c1, c2) AS ...' syntax. Can only\n                be set using BigQuery's GoogleSQL.\n            useLegacySql:\n              type: boolean\n              x-dcl-go-name: UseLegacySql\n              description: Specifies whether to use BigQuery's legacy SQL for this\n                view. The default value is true. If set to false, the view will use\n                BigQuery's GoogleSQL.\n            userDefinedFunctionResources:\n              type: array\n              x-dcl-go-name: UserDefinedFunctionResources\n              description: Describes user-defined function resources used in the query.\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: object\n                x-dcl-go-type: TableViewUserDefinedFunctionResources\n                properties:\n                  inlineCode:\n                    type: string\n                    x-dcl-go-name: InlineCode\n                    description: '[Pick one] An inline resource that contains code\n                      for a user-defined function (UDF). Providing a inline code resource\n                      is equivalent to providing a URI for a file containing the same\n                      code.'\n                  inlineCodeAlternative:\n                    type: array\n                    x-dcl-go-name: InlineCodeAlternative\n                    readOnly: true\n                    description: Output only. Deprecated.\n                    x-kubernetes-immutable: true\n                    x-dcl-send-empty: true\n                    x-dcl-list-type: list\n                    items:\n                      type: string\n                      x-dcl-go-type: string\n                  resourceUri:\n                    type: string\n                    x-dcl-go-name: ResourceUri\n                    description: '[Pick one] A code resource to load from a Google\n                      Cloud Storage URI (gs://bucket/path).'\n")
+
+// 55254 bytes
+// MD5: 2c3893ef81303206be4758b6aab68790
@@ -133,8 +133,12 @@ func (l *AssignmentList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *AssignmentList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *AssignmentList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Assignment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -151,7 +155,8 @@ func (l *AssignmentList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListAssignment(ctx context.Context, project, location, reservation string) (*AssignmentList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Assignment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Assignment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListAssignmentWithMaxResults(ctx, project, location, reservation, AssignmentMaxPage)
@@ -159,7 +164,11 @@ func (c *Client) ListAssignment(ctx context.Context, project, location, reservat
 }
 
 func (c *Client) ListAssignmentWithMaxResults(ctx context.Context, project, location, reservation string, pageSize int32) (*AssignmentList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListAssignmentWithPageToken(ctx, project, location, reservation, pageSize, "")
+}
+
+func (c *Client) ListAssignmentWithPageToken(ctx context.Context, project, location, reservation string, pageSize int32, pageToken string) (*AssignmentList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Assignment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -168,7 +177,7 @@ func (c *Client) ListAssignmentWithMaxResults(ctx context.Context, project, loca
 		Location:    &location,
 		Reservation: &reservation,
 	}
-	items, token, err := c.listAssignment(ctx, r, "", pageSize)
+	items, token, err := c.listAssignment(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +191,8 @@ func (c *Client) ListAssignmentWithMaxResults(ctx context.Context, project, loca
 
 func (c *Client) GetAssignment(ctx context.Context, r *Assignment) (*Assignment, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Assignment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Assignment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -198,7 +208,7 @@ func (c *Client) GetAssignment(ctx context.Context, r *Assignment) (*Assignment,
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalAssignment(b, c, r)
 	if err != nil {
@@ -224,7 +234,8 @@ func (c *Client) GetAssignment(ctx context.Context, r *Assignment) (*Assignment,
 
 func (c *Client) DeleteAssignment(ctx context.Context, r *Assignment) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Assignment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Assignment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -232,7 +243,13 @@ func (c *Client) DeleteAssignment(ctx context.Context, r *Assignment) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Assignment...")
 	deleteOp := deleteAssignmentOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteAssignmentAsync(ctx context.Context, r *Assignment) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteAssignment(ctx, r)
+	})
 }
 
 // DeleteAllAssignment deletes all resources that the filter functions returns true on.
@@ -260,10 +277,11 @@ func (c *Client) DeleteAllAssignment(ctx context.Context, project, location, res
 }
 
 func (c *Client) ApplyAssignment(ctx context.Context, rawDesired *Assignment, opts ...dcl.ApplyOption) (*Assignment, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Assignment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Assignment{}).Describe())
 	var resultNewState *Assignment
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyAssignmentHelper(c, ctx, rawDesired, opts...)
@@ -281,6 +299,38 @@ func (c *Client) ApplyAssignment(ctx context.Context, rawDesired *Assignment, op
 	return resultNewState, err
 }
 
+func (c *Client) ApplyAssignmentAsync(ctx context.Context, rawDesired *Assignment, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyAssignment(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffAssignment returns the field-level differences between rawDesired and the
+// live Assignment without modifying it. If the Assignment does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffAssignment(ctx context.Context, rawDesired *Assignment, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Assignment{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Assignment{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractAssignmentFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.assignmentDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Assignment %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyAssignmentHelper(c *Client, ctx context.Context, rawDesired *Assignment, opts ...dcl.ApplyOption) (*Assignment, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyAssignment...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -289,6 +339,9 @@ func applyAssignmentHelper(c *Client, ctx context.Context, rawDesired *Assignmen
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractAssignmentFields(rawDesired); err != nil {
 		return nil, err
@@ -347,7 +400,7 @@ func applyAssignmentHelper(c *Client, ctx context.Context, rawDesired *Assignmen
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
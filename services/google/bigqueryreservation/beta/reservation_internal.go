@@ -359,7 +359,7 @@ func (c *Client) reservationDiffsForRawDesired(ctx context.Context, rawDesired *
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Reservation resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Reservation resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Reservation resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Reservation resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -373,6 +373,12 @@ func (c *Client) reservationDiffsForRawDesired(ctx context.Context, rawDesired *
 	if err := extractReservationFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeReservationInitialState(rawInitial, rawDesired)
@@ -390,7 +396,7 @@ func (c *Client) reservationDiffsForRawDesired(ctx context.Context, rawDesired *
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffReservation(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeReservationInitialState(rawInitial, rawDesired *Reservation) (*Reservation, error) {
@@ -79,8 +79,12 @@ func (l *ReservationList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ReservationList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ReservationList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Reservation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -97,7 +101,8 @@ func (l *ReservationList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListReservation(ctx context.Context, project, location string) (*ReservationList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Reservation{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Reservation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListReservationWithMaxResults(ctx, project, location, ReservationMaxPage)
@@ -105,7 +110,11 @@ func (c *Client) ListReservation(ctx context.Context, project, location string)
 }
 
 func (c *Client) ListReservationWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*ReservationList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListReservationWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListReservationWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*ReservationList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Reservation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -113,7 +122,7 @@ func (c *Client) ListReservationWithMaxResults(ctx context.Context, project, loc
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listReservation(ctx, r, "", pageSize)
+	items, token, err := c.listReservation(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +136,8 @@ func (c *Client) ListReservationWithMaxResults(ctx context.Context, project, loc
 
 func (c *Client) GetReservation(ctx context.Context, r *Reservation) (*Reservation, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Reservation{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Reservation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -143,7 +153,7 @@ func (c *Client) GetReservation(ctx context.Context, r *Reservation) (*Reservati
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalReservation(b, c, r)
 	if err != nil {
@@ -169,7 +179,8 @@ func (c *Client) GetReservation(ctx context.Context, r *Reservation) (*Reservati
 
 func (c *Client) DeleteReservation(ctx context.Context, r *Reservation) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Reservation{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Reservation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -177,7 +188,13 @@ func (c *Client) DeleteReservation(ctx context.Context, r *Reservation) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Reservation...")
 	deleteOp := deleteReservationOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteReservationAsync(ctx context.Context, r *Reservation) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteReservation(ctx, r)
+	})
 }
 
 // DeleteAllReservation deletes all resources that the filter functions returns true on.
@@ -205,10 +222,11 @@ func (c *Client) DeleteAllReservation(ctx context.Context, project, location str
 }
 
 func (c *Client) ApplyReservation(ctx context.Context, rawDesired *Reservation, opts ...dcl.ApplyOption) (*Reservation, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Reservation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Reservation{}).Describe())
 	var resultNewState *Reservation
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyReservationHelper(c, ctx, rawDesired, opts...)
@@ -226,6 +244,38 @@ func (c *Client) ApplyReservation(ctx context.Context, rawDesired *Reservation,
 	return resultNewState, err
 }
 
+func (c *Client) ApplyReservationAsync(ctx context.Context, rawDesired *Reservation, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyReservation(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffReservation returns the field-level differences between rawDesired and the
+// live Reservation without modifying it. If the Reservation does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffReservation(ctx context.Context, rawDesired *Reservation, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Reservation{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Reservation{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractReservationFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.reservationDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Reservation %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyReservationHelper(c *Client, ctx context.Context, rawDesired *Reservation, opts ...dcl.ApplyOption) (*Reservation, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyReservation...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -234,6 +284,9 @@ func applyReservationHelper(c *Client, ctx context.Context, rawDesired *Reservat
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractReservationFields(rawDesired); err != nil {
 		return nil, err
@@ -292,7 +345,7 @@ func applyReservationHelper(c *Client, ctx context.Context, rawDesired *Reservat
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -678,8 +678,12 @@ func (l *BudgetList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *BudgetList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *BudgetList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Budget{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -696,7 +700,8 @@ func (l *BudgetList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListBudget(ctx context.Context, billingAccount string) (*BudgetList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Budget{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Budget{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListBudgetWithMaxResults(ctx, billingAccount, BudgetMaxPage)
@@ -704,14 +709,18 @@ func (c *Client) ListBudget(ctx context.Context, billingAccount string) (*Budget
 }
 
 func (c *Client) ListBudgetWithMaxResults(ctx context.Context, billingAccount string, pageSize int32) (*BudgetList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListBudgetWithPageToken(ctx, billingAccount, pageSize, "")
+}
+
+func (c *Client) ListBudgetWithPageToken(ctx context.Context, billingAccount string, pageSize int32, pageToken string) (*BudgetList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Budget{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Budget{
 		BillingAccount: &billingAccount,
 	}
-	items, token, err := c.listBudget(ctx, r, "", pageSize)
+	items, token, err := c.listBudget(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -725,7 +734,8 @@ func (c *Client) ListBudgetWithMaxResults(ctx context.Context, billingAccount st
 
 func (c *Client) GetBudget(ctx context.Context, r *Budget) (*Budget, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Budget{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Budget{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -741,7 +751,7 @@ func (c *Client) GetBudget(ctx context.Context, r *Budget) (*Budget, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalBudget(b, c, r)
 	if err != nil {
@@ -766,7 +776,8 @@ func (c *Client) GetBudget(ctx context.Context, r *Budget) (*Budget, error) {
 
 func (c *Client) DeleteBudget(ctx context.Context, r *Budget) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Budget{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Budget{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -774,7 +785,13 @@ func (c *Client) DeleteBudget(ctx context.Context, r *Budget) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Budget...")
 	deleteOp := deleteBudgetOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteBudgetAsync(ctx context.Context, r *Budget) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteBudget(ctx, r)
+	})
 }
 
 // DeleteAllBudget deletes all resources that the filter functions returns true on.
@@ -802,10 +819,11 @@ func (c *Client) DeleteAllBudget(ctx context.Context, billingAccount string, fil
 }
 
 func (c *Client) ApplyBudget(ctx context.Context, rawDesired *Budget, opts ...dcl.ApplyOption) (*Budget, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Budget{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Budget{}).Describe())
 	var resultNewState *Budget
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyBudgetHelper(c, ctx, rawDesired, opts...)
@@ -823,6 +841,38 @@ func (c *Client) ApplyBudget(ctx context.Context, rawDesired *Budget, opts ...dc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyBudgetAsync(ctx context.Context, rawDesired *Budget, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyBudget(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffBudget returns the field-level differences between rawDesired and the
+// live Budget without modifying it. If the Budget does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffBudget(ctx context.Context, rawDesired *Budget, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Budget{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Budget{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractBudgetFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.budgetDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Budget %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyBudgetHelper(c *Client, ctx context.Context, rawDesired *Budget, opts ...dcl.ApplyOption) (*Budget, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyBudget...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -831,6 +881,9 @@ func applyBudgetHelper(c *Client, ctx context.Context, rawDesired *Budget, opts
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractBudgetFields(rawDesired); err != nil {
 		return nil, err
@@ -889,7 +942,7 @@ func applyBudgetHelper(c *Client, ctx context.Context, rawDesired *Budget, opts
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -405,7 +405,7 @@ func (c *Client) budgetDiffsForRawDesired(ctx context.Context, rawDesired *Budge
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Budget resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Budget resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Budget resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Budget resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -419,6 +419,12 @@ func (c *Client) budgetDiffsForRawDesired(ctx context.Context, rawDesired *Budge
 	if err := extractBudgetFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeBudgetInitialState(rawInitial, rawDesired)
@@ -436,7 +442,7 @@ func (c *Client) budgetDiffsForRawDesired(ctx context.Context, rawDesired *Budge
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffBudget(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeBudgetInitialState(rawInitial, rawDesired *Budget) (*Budget, error) {
@@ -260,8 +260,12 @@ func (l *AttestorList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *AttestorList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *AttestorList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Attestor{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -278,7 +282,8 @@ func (l *AttestorList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListAttestor(ctx context.Context, project string) (*AttestorList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Attestor{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Attestor{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListAttestorWithMaxResults(ctx, project, AttestorMaxPage)
@@ -286,14 +291,18 @@ func (c *Client) ListAttestor(ctx context.Context, project string) (*AttestorLis
 }
 
 func (c *Client) ListAttestorWithMaxResults(ctx context.Context, project string, pageSize int32) (*AttestorList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListAttestorWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListAttestorWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*AttestorList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Attestor{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Attestor{
 		Project: &project,
 	}
-	items, token, err := c.listAttestor(ctx, r, "", pageSize)
+	items, token, err := c.listAttestor(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -307,7 +316,8 @@ func (c *Client) ListAttestorWithMaxResults(ctx context.Context, project string,
 
 func (c *Client) GetAttestor(ctx context.Context, r *Attestor) (*Attestor, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Attestor{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Attestor{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -323,7 +333,7 @@ func (c *Client) GetAttestor(ctx context.Context, r *Attestor) (*Attestor, error
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalAttestor(b, c, r)
 	if err != nil {
@@ -348,7 +358,8 @@ func (c *Client) GetAttestor(ctx context.Context, r *Attestor) (*Attestor, error
 
 func (c *Client) DeleteAttestor(ctx context.Context, r *Attestor) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Attestor{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Attestor{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -356,7 +367,13 @@ func (c *Client) DeleteAttestor(ctx context.Context, r *Attestor) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Attestor...")
 	deleteOp := deleteAttestorOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteAttestorAsync(ctx context.Context, r *Attestor) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteAttestor(ctx, r)
+	})
 }
 
 // DeleteAllAttestor deletes all resources that the filter functions returns true on.
@@ -384,10 +401,11 @@ func (c *Client) DeleteAllAttestor(ctx context.Context, project string, filter f
 }
 
 func (c *Client) ApplyAttestor(ctx context.Context, rawDesired *Attestor, opts ...dcl.ApplyOption) (*Attestor, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Attestor{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Attestor{}).Describe())
 	var resultNewState *Attestor
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyAttestorHelper(c, ctx, rawDesired, opts...)
@@ -405,6 +423,38 @@ func (c *Client) ApplyAttestor(ctx context.Context, rawDesired *Attestor, opts .
 	return resultNewState, err
 }
 
+func (c *Client) ApplyAttestorAsync(ctx context.Context, rawDesired *Attestor, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyAttestor(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffAttestor returns the field-level differences between rawDesired and the
+// live Attestor without modifying it. If the Attestor does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffAttestor(ctx context.Context, rawDesired *Attestor, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Attestor{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Attestor{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractAttestorFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.attestorDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Attestor %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyAttestorHelper(c *Client, ctx context.Context, rawDesired *Attestor, opts ...dcl.ApplyOption) (*Attestor, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyAttestor...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -413,6 +463,9 @@ func applyAttestorHelper(c *Client, ctx context.Context, rawDesired *Attestor, o
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractAttestorFields(rawDesired); err != nil {
 		return nil, err
@@ -471,7 +524,7 @@ func applyAttestorHelper(c *Client, ctx context.Context, rawDesired *Attestor, o
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -367,7 +367,7 @@ func (c *Client) attestorDiffsForRawDesired(ctx context.Context, rawDesired *Att
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Attestor resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Attestor resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Attestor resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Attestor resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -381,6 +381,12 @@ func (c *Client) attestorDiffsForRawDesired(ctx context.Context, rawDesired *Att
 	if err := extractAttestorFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeAttestorInitialState(rawInitial, rawDesired)
@@ -398,7 +404,7 @@ func (c *Client) attestorDiffsForRawDesired(ctx context.Context, rawDesired *Att
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffAttestor(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeAttestorInitialState(rawInitial, rawDesired *Attestor) (*Attestor, error) {
@@ -689,7 +689,8 @@ type PolicyList struct {
 
 func (c *Client) GetPolicy(ctx context.Context, r *Policy) (*Policy, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Policy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Policy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -705,7 +706,7 @@ func (c *Client) GetPolicy(ctx context.Context, r *Policy) (*Policy, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalPolicy(b, c, r)
 	if err != nil {
@@ -728,10 +729,11 @@ func (c *Client) GetPolicy(ctx context.Context, r *Policy) (*Policy, error) {
 }
 
 func (c *Client) ApplyPolicy(ctx context.Context, rawDesired *Policy, opts ...dcl.ApplyOption) (*Policy, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Policy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Policy{}).Describe())
 	var resultNewState *Policy
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyPolicyHelper(c, ctx, rawDesired, opts...)
@@ -749,6 +751,38 @@ func (c *Client) ApplyPolicy(ctx context.Context, rawDesired *Policy, opts ...dc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyPolicyAsync(ctx context.Context, rawDesired *Policy, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyPolicy(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffPolicy returns the field-level differences between rawDesired and the
+// live Policy without modifying it. If the Policy does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffPolicy(ctx context.Context, rawDesired *Policy, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Policy{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Policy{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractPolicyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.policyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Policy %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyPolicyHelper(c *Client, ctx context.Context, rawDesired *Policy, opts ...dcl.ApplyOption) (*Policy, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyPolicy...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -757,6 +791,9 @@ func applyPolicyHelper(c *Client, ctx context.Context, rawDesired *Policy, opts
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractPolicyFields(rawDesired); err != nil {
 		return nil, err
@@ -801,7 +838,7 @@ func applyPolicyHelper(c *Client, ctx context.Context, rawDesired *Policy, opts
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.PhaseUpdate, err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -394,8 +394,12 @@ func (l *WorkerPoolList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *WorkerPoolList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *WorkerPoolList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkerPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -412,7 +416,8 @@ func (l *WorkerPoolList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListWorkerPool(ctx context.Context, project, location string) (*WorkerPoolList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkerPool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkerPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListWorkerPoolWithMaxResults(ctx, project, location, WorkerPoolMaxPage)
@@ -420,7 +425,11 @@ func (c *Client) ListWorkerPool(ctx context.Context, project, location string) (
 }
 
 func (c *Client) ListWorkerPoolWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*WorkerPoolList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListWorkerPoolWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListWorkerPoolWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*WorkerPoolList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkerPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -428,7 +437,7 @@ func (c *Client) ListWorkerPoolWithMaxResults(ctx context.Context, project, loca
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listWorkerPool(ctx, r, "", pageSize)
+	items, token, err := c.listWorkerPool(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -442,7 +451,8 @@ func (c *Client) ListWorkerPoolWithMaxResults(ctx context.Context, project, loca
 
 func (c *Client) GetWorkerPool(ctx context.Context, r *WorkerPool) (*WorkerPool, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkerPool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkerPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -458,7 +468,7 @@ func (c *Client) GetWorkerPool(ctx context.Context, r *WorkerPool) (*WorkerPool,
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalWorkerPool(b, c, r)
 	if err != nil {
@@ -484,7 +494,8 @@ func (c *Client) GetWorkerPool(ctx context.Context, r *WorkerPool) (*WorkerPool,
 
 func (c *Client) DeleteWorkerPool(ctx context.Context, r *WorkerPool) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkerPool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkerPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -492,7 +503,13 @@ func (c *Client) DeleteWorkerPool(ctx context.Context, r *WorkerPool) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting WorkerPool...")
 	deleteOp := deleteWorkerPoolOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteWorkerPoolAsync(ctx context.Context, r *WorkerPool) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteWorkerPool(ctx, r)
+	})
 }
 
 // DeleteAllWorkerPool deletes all resources that the filter functions returns true on.
@@ -520,10 +537,11 @@ func (c *Client) DeleteAllWorkerPool(ctx context.Context, project, location stri
 }
 
 func (c *Client) ApplyWorkerPool(ctx context.Context, rawDesired *WorkerPool, opts ...dcl.ApplyOption) (*WorkerPool, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkerPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkerPool{}).Describe())
 	var resultNewState *WorkerPool
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyWorkerPoolHelper(c, ctx, rawDesired, opts...)
@@ -541,6 +559,38 @@ func (c *Client) ApplyWorkerPool(ctx context.Context, rawDesired *WorkerPool, op
 	return resultNewState, err
 }
 
+func (c *Client) ApplyWorkerPoolAsync(ctx context.Context, rawDesired *WorkerPool, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyWorkerPool(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffWorkerPool returns the field-level differences between rawDesired and the
+// live WorkerPool without modifying it. If the WorkerPool does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffWorkerPool(ctx context.Context, rawDesired *WorkerPool, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkerPool{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkerPool{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractWorkerPoolFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.workerPoolDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("WorkerPool %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyWorkerPoolHelper(c *Client, ctx context.Context, rawDesired *WorkerPool, opts ...dcl.ApplyOption) (*WorkerPool, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyWorkerPool...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -549,6 +599,9 @@ func applyWorkerPoolHelper(c *Client, ctx context.Context, rawDesired *WorkerPoo
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractWorkerPoolFields(rawDesired); err != nil {
 		return nil, err
@@ -607,7 +660,7 @@ func applyWorkerPoolHelper(c *Client, ctx context.Context, rawDesired *WorkerPoo
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
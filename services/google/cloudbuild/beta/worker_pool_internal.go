@@ -457,7 +457,7 @@ func (c *Client) workerPoolDiffsForRawDesired(ctx context.Context, rawDesired *W
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a WorkerPool resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve WorkerPool resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve WorkerPool resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that WorkerPool resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -471,6 +471,12 @@ func (c *Client) workerPoolDiffsForRawDesired(ctx context.Context, rawDesired *W
 	if err := extractWorkerPoolFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeWorkerPoolInitialState(rawInitial, rawDesired)
@@ -488,7 +494,7 @@ func (c *Client) workerPoolDiffsForRawDesired(ctx context.Context, rawDesired *W
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffWorkerPool(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeWorkerPoolInitialState(rawInitial, rawDesired *WorkerPool) (*WorkerPool, error) {
@@ -381,8 +381,12 @@ func (l *ConnectionList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ConnectionList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ConnectionList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connection{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -399,7 +403,8 @@ func (l *ConnectionList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListConnection(ctx context.Context, project, location string) (*ConnectionList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Connection{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connection{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListConnectionWithMaxResults(ctx, project, location, ConnectionMaxPage)
@@ -407,7 +412,11 @@ func (c *Client) ListConnection(ctx context.Context, project, location string) (
 }
 
 func (c *Client) ListConnectionWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*ConnectionList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListConnectionWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListConnectionWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*ConnectionList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connection{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -415,7 +424,7 @@ func (c *Client) ListConnectionWithMaxResults(ctx context.Context, project, loca
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listConnection(ctx, r, "", pageSize)
+	items, token, err := c.listConnection(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -429,7 +438,8 @@ func (c *Client) ListConnectionWithMaxResults(ctx context.Context, project, loca
 
 func (c *Client) GetConnection(ctx context.Context, r *Connection) (*Connection, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Connection{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connection{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -445,7 +455,7 @@ func (c *Client) GetConnection(ctx context.Context, r *Connection) (*Connection,
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalConnection(b, c, r)
 	if err != nil {
@@ -471,7 +481,8 @@ func (c *Client) GetConnection(ctx context.Context, r *Connection) (*Connection,
 
 func (c *Client) DeleteConnection(ctx context.Context, r *Connection) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Connection{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connection{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -479,7 +490,13 @@ func (c *Client) DeleteConnection(ctx context.Context, r *Connection) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Connection...")
 	deleteOp := deleteConnectionOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteConnectionAsync(ctx context.Context, r *Connection) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteConnection(ctx, r)
+	})
 }
 
 // DeleteAllConnection deletes all resources that the filter functions returns true on.
@@ -507,10 +524,11 @@ func (c *Client) DeleteAllConnection(ctx context.Context, project, location stri
 }
 
 func (c *Client) ApplyConnection(ctx context.Context, rawDesired *Connection, opts ...dcl.ApplyOption) (*Connection, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connection{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Connection{}).Describe())
 	var resultNewState *Connection
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyConnectionHelper(c, ctx, rawDesired, opts...)
@@ -528,6 +546,38 @@ func (c *Client) ApplyConnection(ctx context.Context, rawDesired *Connection, op
 	return resultNewState, err
 }
 
+func (c *Client) ApplyConnectionAsync(ctx context.Context, rawDesired *Connection, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyConnection(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffConnection returns the field-level differences between rawDesired and the
+// live Connection without modifying it. If the Connection does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffConnection(ctx context.Context, rawDesired *Connection, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connection{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Connection{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractConnectionFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.connectionDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Connection %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyConnectionHelper(c *Client, ctx context.Context, rawDesired *Connection, opts ...dcl.ApplyOption) (*Connection, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyConnection...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -536,6 +586,9 @@ func applyConnectionHelper(c *Client, ctx context.Context, rawDesired *Connectio
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractConnectionFields(rawDesired); err != nil {
 		return nil, err
@@ -594,7 +647,7 @@ func applyConnectionHelper(c *Client, ctx context.Context, rawDesired *Connectio
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
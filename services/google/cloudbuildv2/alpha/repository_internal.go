@@ -306,7 +306,7 @@ func (c *Client) repositoryDiffsForRawDesired(ctx context.Context, rawDesired *R
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Repository resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Repository resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Repository resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Repository resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -320,6 +320,12 @@ func (c *Client) repositoryDiffsForRawDesired(ctx context.Context, rawDesired *R
 	if err := extractRepositoryFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeRepositoryInitialState(rawInitial, rawDesired)
@@ -337,7 +343,7 @@ func (c *Client) repositoryDiffsForRawDesired(ctx context.Context, rawDesired *R
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffRepository(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeRepositoryInitialState(rawInitial, rawDesired *Repository) (*Repository, error) {
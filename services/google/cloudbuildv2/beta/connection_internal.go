@@ -459,7 +459,7 @@ func (c *Client) connectionDiffsForRawDesired(ctx context.Context, rawDesired *C
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Connection resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Connection resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Connection resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Connection resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -473,6 +473,12 @@ func (c *Client) connectionDiffsForRawDesired(ctx context.Context, rawDesired *C
 	if err := extractConnectionFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeConnectionInitialState(rawInitial, rawDesired)
@@ -490,7 +496,7 @@ func (c *Client) connectionDiffsForRawDesired(ctx context.Context, rawDesired *C
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffConnection(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeConnectionInitialState(rawInitial, rawDesired *Connection) (*Connection, error) {
@@ -83,8 +83,12 @@ func (l *RepositoryList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *RepositoryList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *RepositoryList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Repository{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -101,7 +105,8 @@ func (l *RepositoryList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListRepository(ctx context.Context, project, location, connection string) (*RepositoryList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Repository{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Repository{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListRepositoryWithMaxResults(ctx, project, location, connection, RepositoryMaxPage)
@@ -109,7 +114,11 @@ func (c *Client) ListRepository(ctx context.Context, project, location, connecti
 }
 
 func (c *Client) ListRepositoryWithMaxResults(ctx context.Context, project, location, connection string, pageSize int32) (*RepositoryList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListRepositoryWithPageToken(ctx, project, location, connection, pageSize, "")
+}
+
+func (c *Client) ListRepositoryWithPageToken(ctx context.Context, project, location, connection string, pageSize int32, pageToken string) (*RepositoryList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Repository{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -118,7 +127,7 @@ func (c *Client) ListRepositoryWithMaxResults(ctx context.Context, project, loca
 		Location:   &location,
 		Connection: &connection,
 	}
-	items, token, err := c.listRepository(ctx, r, "", pageSize)
+	items, token, err := c.listRepository(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +141,8 @@ func (c *Client) ListRepositoryWithMaxResults(ctx context.Context, project, loca
 
 func (c *Client) GetRepository(ctx context.Context, r *Repository) (*Repository, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Repository{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Repository{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -148,7 +158,7 @@ func (c *Client) GetRepository(ctx context.Context, r *Repository) (*Repository,
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalRepository(b, c, r)
 	if err != nil {
@@ -175,7 +185,8 @@ func (c *Client) GetRepository(ctx context.Context, r *Repository) (*Repository,
 
 func (c *Client) DeleteRepository(ctx context.Context, r *Repository) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Repository{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Repository{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -183,7 +194,13 @@ func (c *Client) DeleteRepository(ctx context.Context, r *Repository) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Repository...")
 	deleteOp := deleteRepositoryOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteRepositoryAsync(ctx context.Context, r *Repository) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteRepository(ctx, r)
+	})
 }
 
 // DeleteAllRepository deletes all resources that the filter functions returns true on.
@@ -211,10 +228,11 @@ func (c *Client) DeleteAllRepository(ctx context.Context, project, location, con
 }
 
 func (c *Client) ApplyRepository(ctx context.Context, rawDesired *Repository, opts ...dcl.ApplyOption) (*Repository, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Repository{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Repository{}).Describe())
 	var resultNewState *Repository
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyRepositoryHelper(c, ctx, rawDesired, opts...)
@@ -232,6 +250,38 @@ func (c *Client) ApplyRepository(ctx context.Context, rawDesired *Repository, op
 	return resultNewState, err
 }
 
+func (c *Client) ApplyRepositoryAsync(ctx context.Context, rawDesired *Repository, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyRepository(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffRepository returns the field-level differences between rawDesired and the
+// live Repository without modifying it. If the Repository does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffRepository(ctx context.Context, rawDesired *Repository, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Repository{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Repository{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractRepositoryFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.repositoryDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Repository %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyRepositoryHelper(c *Client, ctx context.Context, rawDesired *Repository, opts ...dcl.ApplyOption) (*Repository, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyRepository...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -240,6 +290,9 @@ func applyRepositoryHelper(c *Client, ctx context.Context, rawDesired *Repositor
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractRepositoryFields(rawDesired); err != nil {
 		return nil, err
@@ -298,7 +351,7 @@ func applyRepositoryHelper(c *Client, ctx context.Context, rawDesired *Repositor
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -434,8 +434,12 @@ func (l *DeliveryPipelineList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *DeliveryPipelineList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *DeliveryPipelineList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeliveryPipeline{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -452,7 +456,8 @@ func (l *DeliveryPipelineList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListDeliveryPipeline(ctx context.Context, project, location string) (*DeliveryPipelineList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DeliveryPipeline{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeliveryPipeline{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListDeliveryPipelineWithMaxResults(ctx, project, location, DeliveryPipelineMaxPage)
@@ -460,7 +465,11 @@ func (c *Client) ListDeliveryPipeline(ctx context.Context, project, location str
 }
 
 func (c *Client) ListDeliveryPipelineWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*DeliveryPipelineList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListDeliveryPipelineWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListDeliveryPipelineWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*DeliveryPipelineList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeliveryPipeline{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -468,7 +477,7 @@ func (c *Client) ListDeliveryPipelineWithMaxResults(ctx context.Context, project
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listDeliveryPipeline(ctx, r, "", pageSize)
+	items, token, err := c.listDeliveryPipeline(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -482,7 +491,8 @@ func (c *Client) ListDeliveryPipelineWithMaxResults(ctx context.Context, project
 
 func (c *Client) GetDeliveryPipeline(ctx context.Context, r *DeliveryPipeline) (*DeliveryPipeline, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DeliveryPipeline{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeliveryPipeline{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -498,7 +508,7 @@ func (c *Client) GetDeliveryPipeline(ctx context.Context, r *DeliveryPipeline) (
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalDeliveryPipeline(b, c, r)
 	if err != nil {
@@ -524,7 +534,8 @@ func (c *Client) GetDeliveryPipeline(ctx context.Context, r *DeliveryPipeline) (
 
 func (c *Client) DeleteDeliveryPipeline(ctx context.Context, r *DeliveryPipeline) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DeliveryPipeline{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeliveryPipeline{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -532,7 +543,13 @@ func (c *Client) DeleteDeliveryPipeline(ctx context.Context, r *DeliveryPipeline
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting DeliveryPipeline...")
 	deleteOp := deleteDeliveryPipelineOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteDeliveryPipelineAsync(ctx context.Context, r *DeliveryPipeline) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteDeliveryPipeline(ctx, r)
+	})
 }
 
 // DeleteAllDeliveryPipeline deletes all resources that the filter functions returns true on.
@@ -560,10 +577,11 @@ func (c *Client) DeleteAllDeliveryPipeline(ctx context.Context, project, locatio
 }
 
 func (c *Client) ApplyDeliveryPipeline(ctx context.Context, rawDesired *DeliveryPipeline, opts ...dcl.ApplyOption) (*DeliveryPipeline, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeliveryPipeline{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DeliveryPipeline{}).Describe())
 	var resultNewState *DeliveryPipeline
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyDeliveryPipelineHelper(c, ctx, rawDesired, opts...)
@@ -581,6 +599,38 @@ func (c *Client) ApplyDeliveryPipeline(ctx context.Context, rawDesired *Delivery
 	return resultNewState, err
 }
 
+func (c *Client) ApplyDeliveryPipelineAsync(ctx context.Context, rawDesired *DeliveryPipeline, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyDeliveryPipeline(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffDeliveryPipeline returns the field-level differences between rawDesired and the
+// live DeliveryPipeline without modifying it. If the DeliveryPipeline does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffDeliveryPipeline(ctx context.Context, rawDesired *DeliveryPipeline, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeliveryPipeline{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DeliveryPipeline{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractDeliveryPipelineFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.deliveryPipelineDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("DeliveryPipeline %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyDeliveryPipelineHelper(c *Client, ctx context.Context, rawDesired *DeliveryPipeline, opts ...dcl.ApplyOption) (*DeliveryPipeline, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyDeliveryPipeline...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -589,6 +639,9 @@ func applyDeliveryPipelineHelper(c *Client, ctx context.Context, rawDesired *Del
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractDeliveryPipelineFields(rawDesired); err != nil {
 		return nil, err
@@ -647,7 +700,7 @@ func applyDeliveryPipelineHelper(c *Client, ctx context.Context, rawDesired *Del
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -492,7 +492,7 @@ func (c *Client) deliveryPipelineDiffsForRawDesired(ctx context.Context, rawDesi
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a DeliveryPipeline resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve DeliveryPipeline resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve DeliveryPipeline resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that DeliveryPipeline resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -506,6 +506,12 @@ func (c *Client) deliveryPipelineDiffsForRawDesired(ctx context.Context, rawDesi
 	if err := extractDeliveryPipelineFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeDeliveryPipelineInitialState(rawInitial, rawDesired)
@@ -523,7 +529,7 @@ func (c *Client) deliveryPipelineDiffsForRawDesired(ctx context.Context, rawDesi
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffDeliveryPipeline(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeDeliveryPipelineInitialState(rawInitial, rawDesired *DeliveryPipeline) (*DeliveryPipeline, error) {
@@ -326,8 +326,12 @@ func (l *TargetList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *TargetList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *TargetList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Target{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -344,7 +348,8 @@ func (l *TargetList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListTarget(ctx context.Context, project, location string) (*TargetList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Target{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Target{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListTargetWithMaxResults(ctx, project, location, TargetMaxPage)
@@ -352,7 +357,11 @@ func (c *Client) ListTarget(ctx context.Context, project, location string) (*Tar
 }
 
 func (c *Client) ListTargetWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*TargetList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListTargetWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListTargetWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*TargetList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Target{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -360,7 +369,7 @@ func (c *Client) ListTargetWithMaxResults(ctx context.Context, project, location
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listTarget(ctx, r, "", pageSize)
+	items, token, err := c.listTarget(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -374,7 +383,8 @@ func (c *Client) ListTargetWithMaxResults(ctx context.Context, project, location
 
 func (c *Client) GetTarget(ctx context.Context, r *Target) (*Target, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Target{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Target{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -390,7 +400,7 @@ func (c *Client) GetTarget(ctx context.Context, r *Target) (*Target, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalTarget(b, c, r)
 	if err != nil {
@@ -416,7 +426,8 @@ func (c *Client) GetTarget(ctx context.Context, r *Target) (*Target, error) {
 
 func (c *Client) DeleteTarget(ctx context.Context, r *Target) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Target{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Target{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -424,7 +435,13 @@ func (c *Client) DeleteTarget(ctx context.Context, r *Target) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Target...")
 	deleteOp := deleteTargetOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteTargetAsync(ctx context.Context, r *Target) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteTarget(ctx, r)
+	})
 }
 
 // DeleteAllTarget deletes all resources that the filter functions returns true on.
@@ -452,10 +469,11 @@ func (c *Client) DeleteAllTarget(ctx context.Context, project, location string,
 }
 
 func (c *Client) ApplyTarget(ctx context.Context, rawDesired *Target, opts ...dcl.ApplyOption) (*Target, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Target{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Target{}).Describe())
 	var resultNewState *Target
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyTargetHelper(c, ctx, rawDesired, opts...)
@@ -473,6 +491,38 @@ func (c *Client) ApplyTarget(ctx context.Context, rawDesired *Target, opts ...dc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyTargetAsync(ctx context.Context, rawDesired *Target, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyTarget(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffTarget returns the field-level differences between rawDesired and the
+// live Target without modifying it. If the Target does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffTarget(ctx context.Context, rawDesired *Target, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Target{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Target{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractTargetFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.targetDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Target %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyTargetHelper(c *Client, ctx context.Context, rawDesired *Target, opts ...dcl.ApplyOption) (*Target, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyTarget...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -481,6 +531,9 @@ func applyTargetHelper(c *Client, ctx context.Context, rawDesired *Target, opts
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractTargetFields(rawDesired); err != nil {
 		return nil, err
@@ -539,7 +592,7 @@ func applyTargetHelper(c *Client, ctx context.Context, rawDesired *Target, opts
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -469,7 +469,7 @@ func (c *Client) targetDiffsForRawDesired(ctx context.Context, rawDesired *Targe
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Target resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Target resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Target resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Target resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -483,6 +483,12 @@ func (c *Client) targetDiffsForRawDesired(ctx context.Context, rawDesired *Targe
 	if err := extractTargetFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeTargetInitialState(rawInitial, rawDesired)
@@ -500,7 +506,7 @@ func (c *Client) targetDiffsForRawDesired(ctx context.Context, rawDesired *Targe
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffTarget(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeTargetInitialState(rawInitial, rawDesired *Target) (*Target, error) {
@@ -373,8 +373,12 @@ func (l *FunctionList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *FunctionList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *FunctionList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Function{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -391,7 +395,8 @@ func (l *FunctionList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListFunction(ctx context.Context, project, region string) (*FunctionList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Function{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Function{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListFunctionWithMaxResults(ctx, project, region, FunctionMaxPage)
@@ -399,7 +404,11 @@ func (c *Client) ListFunction(ctx context.Context, project, region string) (*Fun
 }
 
 func (c *Client) ListFunctionWithMaxResults(ctx context.Context, project, region string, pageSize int32) (*FunctionList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListFunctionWithPageToken(ctx, project, region, pageSize, "")
+}
+
+func (c *Client) ListFunctionWithPageToken(ctx context.Context, project, region string, pageSize int32, pageToken string) (*FunctionList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Function{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -407,7 +416,7 @@ func (c *Client) ListFunctionWithMaxResults(ctx context.Context, project, region
 		Project: &project,
 		Region:  &region,
 	}
-	items, token, err := c.listFunction(ctx, r, "", pageSize)
+	items, token, err := c.listFunction(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -421,7 +430,8 @@ func (c *Client) ListFunctionWithMaxResults(ctx context.Context, project, region
 
 func (c *Client) GetFunction(ctx context.Context, r *Function) (*Function, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Function{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Function{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -437,7 +447,7 @@ func (c *Client) GetFunction(ctx context.Context, r *Function) (*Function, error
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalFunction(b, c, r)
 	if err != nil {
@@ -463,7 +473,8 @@ func (c *Client) GetFunction(ctx context.Context, r *Function) (*Function, error
 
 func (c *Client) DeleteFunction(ctx context.Context, r *Function) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Function{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Function{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -471,7 +482,13 @@ func (c *Client) DeleteFunction(ctx context.Context, r *Function) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Function...")
 	deleteOp := deleteFunctionOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteFunctionAsync(ctx context.Context, r *Function) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteFunction(ctx, r)
+	})
 }
 
 // DeleteAllFunction deletes all resources that the filter functions returns true on.
@@ -499,10 +516,11 @@ func (c *Client) DeleteAllFunction(ctx context.Context, project, region string,
 }
 
 func (c *Client) ApplyFunction(ctx context.Context, rawDesired *Function, opts ...dcl.ApplyOption) (*Function, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Function{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Function{}).Describe())
 	var resultNewState *Function
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyFunctionHelper(c, ctx, rawDesired, opts...)
@@ -520,6 +538,38 @@ func (c *Client) ApplyFunction(ctx context.Context, rawDesired *Function, opts .
 	return resultNewState, err
 }
 
+func (c *Client) ApplyFunctionAsync(ctx context.Context, rawDesired *Function, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyFunction(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffFunction returns the field-level differences between rawDesired and the
+// live Function without modifying it. If the Function does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffFunction(ctx context.Context, rawDesired *Function, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Function{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Function{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractFunctionFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.functionDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Function %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyFunctionHelper(c *Client, ctx context.Context, rawDesired *Function, opts ...dcl.ApplyOption) (*Function, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyFunction...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -528,6 +578,9 @@ func applyFunctionHelper(c *Client, ctx context.Context, rawDesired *Function, o
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractFunctionFields(rawDesired); err != nil {
 		return nil, err
@@ -586,7 +639,7 @@ func applyFunctionHelper(c *Client, ctx context.Context, rawDesired *Function, o
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -600,6 +653,8 @@ func applyFunctionDiff(c *Client, ctx context.Context, desired *Function, rawDes
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -454,6 +454,14 @@ func (c *Client) getFunctionRaw(ctx context.Context, r *Function) ([]byte, error
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Function) withDefaultLabels(c *dcl.Config, current map[string]string) *Function {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) functionDiffsForRawDesired(ctx context.Context, rawDesired *Function, opts ...dcl.ApplyOption) (initial, desired *Function, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -471,10 +479,16 @@ func (c *Client) functionDiffsForRawDesired(ctx context.Context, rawDesired *Fun
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetFunction(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Function resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Function resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Function resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Function resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -488,6 +502,12 @@ func (c *Client) functionDiffsForRawDesired(ctx context.Context, rawDesired *Fun
 	if err := extractFunctionFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeFunctionInitialState(rawInitial, rawDesired)
@@ -505,7 +525,7 @@ func (c *Client) functionDiffsForRawDesired(ctx context.Context, rawDesired *Fun
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffFunction(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeFunctionInitialState(rawInitial, rawDesired *Function) (*Function, error) {
@@ -1309,7 +1329,7 @@ func diffFunction(c *Client, desired, actual *Function, opts ...dcl.ApplyOption)
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateFunctionUpdateOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateFunctionUpdateOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -458,7 +458,7 @@ func (c *Client) groupDiffsForRawDesired(ctx context.Context, rawDesired *Group,
 	if rawInitial == nil {
 		if !dcl.IsNotFoundOrCode(err, 403) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Group resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Group resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Group resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Group resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -472,6 +472,12 @@ func (c *Client) groupDiffsForRawDesired(ctx context.Context, rawDesired *Group,
 	if err := extractGroupFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeGroupInitialState(rawInitial, rawDesired)
@@ -489,7 +495,7 @@ func (c *Client) groupDiffsForRawDesired(ctx context.Context, rawDesired *Group,
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffGroup(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeGroupInitialState(rawInitial, rawDesired *Group) (*Group, error) {
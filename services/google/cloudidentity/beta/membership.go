@@ -508,8 +508,12 @@ func (l *MembershipList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *MembershipList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *MembershipList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Membership{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -526,7 +530,8 @@ func (l *MembershipList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListMembership(ctx context.Context, group string) (*MembershipList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Membership{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Membership{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListMembershipWithMaxResults(ctx, group, MembershipMaxPage)
@@ -534,14 +539,18 @@ func (c *Client) ListMembership(ctx context.Context, group string) (*MembershipL
 }
 
 func (c *Client) ListMembershipWithMaxResults(ctx context.Context, group string, pageSize int32) (*MembershipList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListMembershipWithPageToken(ctx, group, pageSize, "")
+}
+
+func (c *Client) ListMembershipWithPageToken(ctx context.Context, group string, pageSize int32, pageToken string) (*MembershipList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Membership{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Membership{
 		Group: &group,
 	}
-	items, token, err := c.listMembership(ctx, r, "", pageSize)
+	items, token, err := c.listMembership(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -555,7 +564,8 @@ func (c *Client) ListMembershipWithMaxResults(ctx context.Context, group string,
 
 func (c *Client) GetMembership(ctx context.Context, r *Membership) (*Membership, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Membership{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Membership{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -571,7 +581,7 @@ func (c *Client) GetMembership(ctx context.Context, r *Membership) (*Membership,
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalMembership(b, c, r)
 	if err != nil {
@@ -596,7 +606,8 @@ func (c *Client) GetMembership(ctx context.Context, r *Membership) (*Membership,
 
 func (c *Client) DeleteMembership(ctx context.Context, r *Membership) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Membership{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Membership{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -604,7 +615,13 @@ func (c *Client) DeleteMembership(ctx context.Context, r *Membership) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Membership...")
 	deleteOp := deleteMembershipOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteMembershipAsync(ctx context.Context, r *Membership) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteMembership(ctx, r)
+	})
 }
 
 // DeleteAllMembership deletes all resources that the filter functions returns true on.
@@ -632,10 +649,11 @@ func (c *Client) DeleteAllMembership(ctx context.Context, group string, filter f
 }
 
 func (c *Client) ApplyMembership(ctx context.Context, rawDesired *Membership, opts ...dcl.ApplyOption) (*Membership, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Membership{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Membership{}).Describe())
 	var resultNewState *Membership
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyMembershipHelper(c, ctx, rawDesired, opts...)
@@ -653,6 +671,38 @@ func (c *Client) ApplyMembership(ctx context.Context, rawDesired *Membership, op
 	return resultNewState, err
 }
 
+func (c *Client) ApplyMembershipAsync(ctx context.Context, rawDesired *Membership, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyMembership(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffMembership returns the field-level differences between rawDesired and the
+// live Membership without modifying it. If the Membership does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffMembership(ctx context.Context, rawDesired *Membership, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Membership{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Membership{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractMembershipFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.membershipDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Membership %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyMembershipHelper(c *Client, ctx context.Context, rawDesired *Membership, opts ...dcl.ApplyOption) (*Membership, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyMembership...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -661,6 +711,9 @@ func applyMembershipHelper(c *Client, ctx context.Context, rawDesired *Membershi
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractMembershipFields(rawDesired); err != nil {
 		return nil, err
@@ -719,7 +772,7 @@ func applyMembershipHelper(c *Client, ctx context.Context, rawDesired *Membershi
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -423,7 +423,7 @@ func (c *Client) membershipDiffsForRawDesired(ctx context.Context, rawDesired *M
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Membership resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Membership resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Membership resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Membership resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -437,6 +437,12 @@ func (c *Client) membershipDiffsForRawDesired(ctx context.Context, rawDesired *M
 	if err := extractMembershipFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeMembershipInitialState(rawInitial, rawDesired)
@@ -454,7 +460,7 @@ func (c *Client) membershipDiffsForRawDesired(ctx context.Context, rawDesired *M
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffMembership(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeMembershipInitialState(rawInitial, rawDesired *Membership) (*Membership, error) {
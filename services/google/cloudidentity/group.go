@@ -520,8 +520,12 @@ func (l *GroupList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *GroupList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *GroupList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Group{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -538,7 +542,8 @@ func (l *GroupList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListGroup(ctx context.Context, parent string) (*GroupList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Group{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Group{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListGroupWithMaxResults(ctx, parent, GroupMaxPage)
@@ -546,14 +551,18 @@ func (c *Client) ListGroup(ctx context.Context, parent string) (*GroupList, erro
 }
 
 func (c *Client) ListGroupWithMaxResults(ctx context.Context, parent string, pageSize int32) (*GroupList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListGroupWithPageToken(ctx, parent, pageSize, "")
+}
+
+func (c *Client) ListGroupWithPageToken(ctx context.Context, parent string, pageSize int32, pageToken string) (*GroupList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Group{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Group{
 		Parent: &parent,
 	}
-	items, token, err := c.listGroup(ctx, r, "", pageSize)
+	items, token, err := c.listGroup(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -567,7 +576,8 @@ func (c *Client) ListGroupWithMaxResults(ctx context.Context, parent string, pag
 
 func (c *Client) GetGroup(ctx context.Context, r *Group) (*Group, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Group{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Group{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -583,7 +593,7 @@ func (c *Client) GetGroup(ctx context.Context, r *Group) (*Group, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalGroup(b, c, r)
 	if err != nil {
@@ -607,7 +617,8 @@ func (c *Client) GetGroup(ctx context.Context, r *Group) (*Group, error) {
 
 func (c *Client) DeleteGroup(ctx context.Context, r *Group) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Group{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Group{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -615,7 +626,13 @@ func (c *Client) DeleteGroup(ctx context.Context, r *Group) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Group...")
 	deleteOp := deleteGroupOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteGroupAsync(ctx context.Context, r *Group) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteGroup(ctx, r)
+	})
 }
 
 // DeleteAllGroup deletes all resources that the filter functions returns true on.
@@ -643,10 +660,11 @@ func (c *Client) DeleteAllGroup(ctx context.Context, parent string, filter func(
 }
 
 func (c *Client) ApplyGroup(ctx context.Context, rawDesired *Group, opts ...dcl.ApplyOption) (*Group, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Group{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Group{}).Describe())
 	var resultNewState *Group
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyGroupHelper(c, ctx, rawDesired, opts...)
@@ -664,6 +682,38 @@ func (c *Client) ApplyGroup(ctx context.Context, rawDesired *Group, opts ...dcl.
 	return resultNewState, err
 }
 
+func (c *Client) ApplyGroupAsync(ctx context.Context, rawDesired *Group, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyGroup(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffGroup returns the field-level differences between rawDesired and the
+// live Group without modifying it. If the Group does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffGroup(ctx context.Context, rawDesired *Group, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Group{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Group{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractGroupFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.groupDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Group %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyGroupHelper(c *Client, ctx context.Context, rawDesired *Group, opts ...dcl.ApplyOption) (*Group, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyGroup...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -672,6 +722,9 @@ func applyGroupHelper(c *Client, ctx context.Context, rawDesired *Group, opts ..
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractGroupFields(rawDesired); err != nil {
 		return nil, err
@@ -730,7 +783,7 @@ func applyGroupHelper(c *Client, ctx context.Context, rawDesired *Group, opts ..
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -567,8 +567,12 @@ func (l *CryptoKeyList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *CryptoKeyList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *CryptoKeyList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CryptoKey{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -585,7 +589,8 @@ func (l *CryptoKeyList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListCryptoKey(ctx context.Context, project, location, keyRing string) (*CryptoKeyList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CryptoKey{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CryptoKey{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListCryptoKeyWithMaxResults(ctx, project, location, keyRing, CryptoKeyMaxPage)
@@ -593,7 +598,11 @@ func (c *Client) ListCryptoKey(ctx context.Context, project, location, keyRing s
 }
 
 func (c *Client) ListCryptoKeyWithMaxResults(ctx context.Context, project, location, keyRing string, pageSize int32) (*CryptoKeyList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListCryptoKeyWithPageToken(ctx, project, location, keyRing, pageSize, "")
+}
+
+func (c *Client) ListCryptoKeyWithPageToken(ctx context.Context, project, location, keyRing string, pageSize int32, pageToken string) (*CryptoKeyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CryptoKey{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -602,7 +611,7 @@ func (c *Client) ListCryptoKeyWithMaxResults(ctx context.Context, project, locat
 		Location: &location,
 		KeyRing:  &keyRing,
 	}
-	items, token, err := c.listCryptoKey(ctx, r, "", pageSize)
+	items, token, err := c.listCryptoKey(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -616,7 +625,8 @@ func (c *Client) ListCryptoKeyWithMaxResults(ctx context.Context, project, locat
 
 func (c *Client) GetCryptoKey(ctx context.Context, r *CryptoKey) (*CryptoKey, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CryptoKey{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CryptoKey{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -632,7 +642,7 @@ func (c *Client) GetCryptoKey(ctx context.Context, r *CryptoKey) (*CryptoKey, er
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalCryptoKey(b, c, r)
 	if err != nil {
@@ -658,10 +668,11 @@ func (c *Client) GetCryptoKey(ctx context.Context, r *CryptoKey) (*CryptoKey, er
 }
 
 func (c *Client) ApplyCryptoKey(ctx context.Context, rawDesired *CryptoKey, opts ...dcl.ApplyOption) (*CryptoKey, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CryptoKey{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CryptoKey{}).Describe())
 	var resultNewState *CryptoKey
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyCryptoKeyHelper(c, ctx, rawDesired, opts...)
@@ -679,6 +690,38 @@ func (c *Client) ApplyCryptoKey(ctx context.Context, rawDesired *CryptoKey, opts
 	return resultNewState, err
 }
 
+func (c *Client) ApplyCryptoKeyAsync(ctx context.Context, rawDesired *CryptoKey, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyCryptoKey(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffCryptoKey returns the field-level differences between rawDesired and the
+// live CryptoKey without modifying it. If the CryptoKey does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffCryptoKey(ctx context.Context, rawDesired *CryptoKey, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CryptoKey{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CryptoKey{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractCryptoKeyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.cryptoKeyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("CryptoKey %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyCryptoKeyHelper(c *Client, ctx context.Context, rawDesired *CryptoKey, opts ...dcl.ApplyOption) (*CryptoKey, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyCryptoKey...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -687,6 +730,9 @@ func applyCryptoKeyHelper(c *Client, ctx context.Context, rawDesired *CryptoKey,
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractCryptoKeyFields(rawDesired); err != nil {
 		return nil, err
@@ -745,7 +791,7 @@ func applyCryptoKeyHelper(c *Client, ctx context.Context, rawDesired *CryptoKey,
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -759,6 +805,8 @@ func applyCryptoKeyDiff(c *Client, ctx context.Context, desired *CryptoKey, rawD
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
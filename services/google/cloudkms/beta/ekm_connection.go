@@ -205,8 +205,12 @@ func (l *EkmConnectionList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *EkmConnectionList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *EkmConnectionList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EkmConnection{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -223,7 +227,8 @@ func (l *EkmConnectionList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListEkmConnection(ctx context.Context, project, location string) (*EkmConnectionList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&EkmConnection{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EkmConnection{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListEkmConnectionWithMaxResults(ctx, project, location, EkmConnectionMaxPage)
@@ -231,7 +236,11 @@ func (c *Client) ListEkmConnection(ctx context.Context, project, location string
 }
 
 func (c *Client) ListEkmConnectionWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*EkmConnectionList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListEkmConnectionWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListEkmConnectionWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*EkmConnectionList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EkmConnection{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -239,7 +248,7 @@ func (c *Client) ListEkmConnectionWithMaxResults(ctx context.Context, project, l
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listEkmConnection(ctx, r, "", pageSize)
+	items, token, err := c.listEkmConnection(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -253,7 +262,8 @@ func (c *Client) ListEkmConnectionWithMaxResults(ctx context.Context, project, l
 
 func (c *Client) GetEkmConnection(ctx context.Context, r *EkmConnection) (*EkmConnection, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&EkmConnection{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EkmConnection{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -269,7 +279,7 @@ func (c *Client) GetEkmConnection(ctx context.Context, r *EkmConnection) (*EkmCo
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalEkmConnection(b, c, r)
 	if err != nil {
@@ -294,10 +304,11 @@ func (c *Client) GetEkmConnection(ctx context.Context, r *EkmConnection) (*EkmCo
 }
 
 func (c *Client) ApplyEkmConnection(ctx context.Context, rawDesired *EkmConnection, opts ...dcl.ApplyOption) (*EkmConnection, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EkmConnection{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&EkmConnection{}).Describe())
 	var resultNewState *EkmConnection
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyEkmConnectionHelper(c, ctx, rawDesired, opts...)
@@ -315,6 +326,38 @@ func (c *Client) ApplyEkmConnection(ctx context.Context, rawDesired *EkmConnecti
 	return resultNewState, err
 }
 
+func (c *Client) ApplyEkmConnectionAsync(ctx context.Context, rawDesired *EkmConnection, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyEkmConnection(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffEkmConnection returns the field-level differences between rawDesired and the
+// live EkmConnection without modifying it. If the EkmConnection does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffEkmConnection(ctx context.Context, rawDesired *EkmConnection, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EkmConnection{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&EkmConnection{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractEkmConnectionFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.ekmConnectionDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("EkmConnection %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyEkmConnectionHelper(c *Client, ctx context.Context, rawDesired *EkmConnection, opts ...dcl.ApplyOption) (*EkmConnection, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyEkmConnection...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -323,6 +366,9 @@ func applyEkmConnectionHelper(c *Client, ctx context.Context, rawDesired *EkmCon
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractEkmConnectionFields(rawDesired); err != nil {
 		return nil, err
@@ -381,7 +427,7 @@ func applyEkmConnectionHelper(c *Client, ctx context.Context, rawDesired *EkmCon
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
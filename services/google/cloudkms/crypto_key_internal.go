@@ -369,6 +369,14 @@ func (c *Client) getCryptoKeyRaw(ctx context.Context, r *CryptoKey) ([]byte, err
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *CryptoKey) withDefaultLabels(c *dcl.Config, current map[string]string) *CryptoKey {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) cryptoKeyDiffsForRawDesired(ctx context.Context, rawDesired *CryptoKey, opts ...dcl.ApplyOption) (initial, desired *CryptoKey, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -386,10 +394,16 @@ func (c *Client) cryptoKeyDiffsForRawDesired(ctx context.Context, rawDesired *Cr
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetCryptoKey(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a CryptoKey resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve CryptoKey resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve CryptoKey resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that CryptoKey resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -403,6 +417,12 @@ func (c *Client) cryptoKeyDiffsForRawDesired(ctx context.Context, rawDesired *Cr
 	if err := extractCryptoKeyFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeCryptoKeyInitialState(rawInitial, rawDesired)
@@ -420,7 +440,7 @@ func (c *Client) cryptoKeyDiffsForRawDesired(ctx context.Context, rawDesired *Cr
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffCryptoKey(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeCryptoKeyInitialState(rawInitial, rawDesired *CryptoKey) (*CryptoKey, error) {
@@ -1251,7 +1271,7 @@ func diffCryptoKey(c *Client, desired, actual *CryptoKey, opts ...dcl.ApplyOptio
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateCryptoKeyUpdateCryptoKeyOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateCryptoKeyUpdateCryptoKeyOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
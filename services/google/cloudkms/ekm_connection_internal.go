@@ -355,7 +355,7 @@ func (c *Client) ekmConnectionDiffsForRawDesired(ctx context.Context, rawDesired
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a EkmConnection resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve EkmConnection resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve EkmConnection resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that EkmConnection resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -369,6 +369,12 @@ func (c *Client) ekmConnectionDiffsForRawDesired(ctx context.Context, rawDesired
 	if err := extractEkmConnectionFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeEkmConnectionInitialState(rawInitial, rawDesired)
@@ -386,7 +392,7 @@ func (c *Client) ekmConnectionDiffsForRawDesired(ctx context.Context, rawDesired
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffEkmConnection(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeEkmConnectionInitialState(rawInitial, rawDesired *EkmConnection) (*EkmConnection, error) {
@@ -73,8 +73,12 @@ func (l *KeyRingList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *KeyRingList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *KeyRingList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&KeyRing{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -91,7 +95,8 @@ func (l *KeyRingList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListKeyRing(ctx context.Context, project, location string) (*KeyRingList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&KeyRing{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&KeyRing{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListKeyRingWithMaxResults(ctx, project, location, KeyRingMaxPage)
@@ -99,7 +104,11 @@ func (c *Client) ListKeyRing(ctx context.Context, project, location string) (*Ke
 }
 
 func (c *Client) ListKeyRingWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*KeyRingList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListKeyRingWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListKeyRingWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*KeyRingList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&KeyRing{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -107,7 +116,7 @@ func (c *Client) ListKeyRingWithMaxResults(ctx context.Context, project, locatio
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listKeyRing(ctx, r, "", pageSize)
+	items, token, err := c.listKeyRing(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +130,8 @@ func (c *Client) ListKeyRingWithMaxResults(ctx context.Context, project, locatio
 
 func (c *Client) GetKeyRing(ctx context.Context, r *KeyRing) (*KeyRing, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&KeyRing{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&KeyRing{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -137,7 +147,7 @@ func (c *Client) GetKeyRing(ctx context.Context, r *KeyRing) (*KeyRing, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalKeyRing(b, c, r)
 	if err != nil {
@@ -162,10 +172,11 @@ func (c *Client) GetKeyRing(ctx context.Context, r *KeyRing) (*KeyRing, error) {
 }
 
 func (c *Client) ApplyKeyRing(ctx context.Context, rawDesired *KeyRing, opts ...dcl.ApplyOption) (*KeyRing, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&KeyRing{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&KeyRing{}).Describe())
 	var resultNewState *KeyRing
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyKeyRingHelper(c, ctx, rawDesired, opts...)
@@ -183,6 +194,38 @@ func (c *Client) ApplyKeyRing(ctx context.Context, rawDesired *KeyRing, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyKeyRingAsync(ctx context.Context, rawDesired *KeyRing, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyKeyRing(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffKeyRing returns the field-level differences between rawDesired and the
+// live KeyRing without modifying it. If the KeyRing does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffKeyRing(ctx context.Context, rawDesired *KeyRing, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&KeyRing{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&KeyRing{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractKeyRingFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.keyRingDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("KeyRing %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyKeyRingHelper(c *Client, ctx context.Context, rawDesired *KeyRing, opts ...dcl.ApplyOption) (*KeyRing, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyKeyRing...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -191,6 +234,9 @@ func applyKeyRingHelper(c *Client, ctx context.Context, rawDesired *KeyRing, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractKeyRingFields(rawDesired); err != nil {
 		return nil, err
@@ -249,7 +295,7 @@ func applyKeyRingHelper(c *Client, ctx context.Context, rawDesired *KeyRing, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
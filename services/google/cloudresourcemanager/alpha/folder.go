@@ -109,8 +109,12 @@ func (l *FolderList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *FolderList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *FolderList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Folder{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -127,7 +131,8 @@ func (l *FolderList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListFolder(ctx context.Context, parent string) (*FolderList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Folder{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Folder{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListFolderWithMaxResults(ctx, parent, FolderMaxPage)
@@ -135,14 +140,18 @@ func (c *Client) ListFolder(ctx context.Context, parent string) (*FolderList, er
 }
 
 func (c *Client) ListFolderWithMaxResults(ctx context.Context, parent string, pageSize int32) (*FolderList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListFolderWithPageToken(ctx, parent, pageSize, "")
+}
+
+func (c *Client) ListFolderWithPageToken(ctx context.Context, parent string, pageSize int32, pageToken string) (*FolderList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Folder{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Folder{
 		Parent: &parent,
 	}
-	items, token, err := c.listFolder(ctx, r, "", pageSize)
+	items, token, err := c.listFolder(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +165,8 @@ func (c *Client) ListFolderWithMaxResults(ctx context.Context, parent string, pa
 
 func (c *Client) GetFolder(ctx context.Context, r *Folder) (*Folder, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Folder{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Folder{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -172,7 +182,7 @@ func (c *Client) GetFolder(ctx context.Context, r *Folder) (*Folder, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalFolder(b, c, r)
 	if err != nil {
@@ -196,7 +206,8 @@ func (c *Client) GetFolder(ctx context.Context, r *Folder) (*Folder, error) {
 
 func (c *Client) DeleteFolder(ctx context.Context, r *Folder) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Folder{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Folder{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -204,7 +215,13 @@ func (c *Client) DeleteFolder(ctx context.Context, r *Folder) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Folder...")
 	deleteOp := deleteFolderOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteFolderAsync(ctx context.Context, r *Folder) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteFolder(ctx, r)
+	})
 }
 
 // DeleteAllFolder deletes all resources that the filter functions returns true on.
@@ -232,10 +249,11 @@ func (c *Client) DeleteAllFolder(ctx context.Context, parent string, filter func
 }
 
 func (c *Client) ApplyFolder(ctx context.Context, rawDesired *Folder, opts ...dcl.ApplyOption) (*Folder, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Folder{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Folder{}).Describe())
 	var resultNewState *Folder
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyFolderHelper(c, ctx, rawDesired, opts...)
@@ -253,6 +271,38 @@ func (c *Client) ApplyFolder(ctx context.Context, rawDesired *Folder, opts ...dc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyFolderAsync(ctx context.Context, rawDesired *Folder, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyFolder(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffFolder returns the field-level differences between rawDesired and the
+// live Folder without modifying it. If the Folder does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffFolder(ctx context.Context, rawDesired *Folder, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Folder{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Folder{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractFolderFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.folderDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Folder %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyFolderHelper(c *Client, ctx context.Context, rawDesired *Folder, opts ...dcl.ApplyOption) (*Folder, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyFolder...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -261,6 +311,9 @@ func applyFolderHelper(c *Client, ctx context.Context, rawDesired *Folder, opts
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractFolderFields(rawDesired); err != nil {
 		return nil, err
@@ -319,7 +372,7 @@ func applyFolderHelper(c *Client, ctx context.Context, rawDesired *Folder, opts
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -78,7 +78,8 @@ type TagValueList struct {
 
 func (c *Client) GetTagValue(ctx context.Context, r *TagValue) (*TagValue, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TagValue{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TagValue{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -94,7 +95,7 @@ func (c *Client) GetTagValue(ctx context.Context, r *TagValue) (*TagValue, error
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalTagValue(b, c, r)
 	if err != nil {
@@ -118,7 +119,8 @@ func (c *Client) GetTagValue(ctx context.Context, r *TagValue) (*TagValue, error
 
 func (c *Client) DeleteTagValue(ctx context.Context, r *TagValue) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TagValue{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TagValue{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -126,14 +128,21 @@ func (c *Client) DeleteTagValue(ctx context.Context, r *TagValue) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting TagValue...")
 	deleteOp := deleteTagValueOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteTagValueAsync(ctx context.Context, r *TagValue) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteTagValue(ctx, r)
+	})
 }
 
 func (c *Client) ApplyTagValue(ctx context.Context, rawDesired *TagValue, opts ...dcl.ApplyOption) (*TagValue, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TagValue{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TagValue{}).Describe())
 	var resultNewState *TagValue
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyTagValueHelper(c, ctx, rawDesired, opts...)
@@ -151,6 +160,38 @@ func (c *Client) ApplyTagValue(ctx context.Context, rawDesired *TagValue, opts .
 	return resultNewState, err
 }
 
+func (c *Client) ApplyTagValueAsync(ctx context.Context, rawDesired *TagValue, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyTagValue(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffTagValue returns the field-level differences between rawDesired and the
+// live TagValue without modifying it. If the TagValue does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffTagValue(ctx context.Context, rawDesired *TagValue, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TagValue{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TagValue{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractTagValueFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.tagValueDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("TagValue %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyTagValueHelper(c *Client, ctx context.Context, rawDesired *TagValue, opts ...dcl.ApplyOption) (*TagValue, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyTagValue...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -159,6 +200,9 @@ func applyTagValueHelper(c *Client, ctx context.Context, rawDesired *TagValue, o
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractTagValueFields(rawDesired); err != nil {
 		return nil, err
@@ -217,7 +261,7 @@ func applyTagValueHelper(c *Client, ctx context.Context, rawDesired *TagValue, o
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
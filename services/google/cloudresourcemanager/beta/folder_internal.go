@@ -371,7 +371,7 @@ func (c *Client) folderDiffsForRawDesired(ctx context.Context, rawDesired *Folde
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Folder resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Folder resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Folder resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Folder resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -385,6 +385,12 @@ func (c *Client) folderDiffsForRawDesired(ctx context.Context, rawDesired *Folde
 	if err := extractFolderFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeFolderInitialState(rawInitial, rawDesired)
@@ -402,7 +408,7 @@ func (c *Client) folderDiffsForRawDesired(ctx context.Context, rawDesired *Folde
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffFolder(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeFolderInitialState(rawInitial, rawDesired *Folder) (*Folder, error) {
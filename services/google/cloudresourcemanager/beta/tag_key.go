@@ -109,7 +109,8 @@ type TagKeyList struct {
 
 func (c *Client) GetTagKey(ctx context.Context, r *TagKey) (*TagKey, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TagKey{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TagKey{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -125,7 +126,7 @@ func (c *Client) GetTagKey(ctx context.Context, r *TagKey) (*TagKey, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalTagKey(b, c, r)
 	if err != nil {
@@ -149,7 +150,8 @@ func (c *Client) GetTagKey(ctx context.Context, r *TagKey) (*TagKey, error) {
 
 func (c *Client) DeleteTagKey(ctx context.Context, r *TagKey) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TagKey{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TagKey{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -157,14 +159,21 @@ func (c *Client) DeleteTagKey(ctx context.Context, r *TagKey) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting TagKey...")
 	deleteOp := deleteTagKeyOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteTagKeyAsync(ctx context.Context, r *TagKey) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteTagKey(ctx, r)
+	})
 }
 
 func (c *Client) ApplyTagKey(ctx context.Context, rawDesired *TagKey, opts ...dcl.ApplyOption) (*TagKey, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TagKey{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TagKey{}).Describe())
 	var resultNewState *TagKey
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyTagKeyHelper(c, ctx, rawDesired, opts...)
@@ -182,6 +191,38 @@ func (c *Client) ApplyTagKey(ctx context.Context, rawDesired *TagKey, opts ...dc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyTagKeyAsync(ctx context.Context, rawDesired *TagKey, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyTagKey(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffTagKey returns the field-level differences between rawDesired and the
+// live TagKey without modifying it. If the TagKey does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffTagKey(ctx context.Context, rawDesired *TagKey, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TagKey{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TagKey{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractTagKeyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.tagKeyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("TagKey %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyTagKeyHelper(c *Client, ctx context.Context, rawDesired *TagKey, opts ...dcl.ApplyOption) (*TagKey, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyTagKey...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -190,6 +231,9 @@ func applyTagKeyHelper(c *Client, ctx context.Context, rawDesired *TagKey, opts
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractTagKeyFields(rawDesired); err != nil {
 		return nil, err
@@ -248,7 +292,7 @@ func applyTagKeyHelper(c *Client, ctx context.Context, rawDesired *TagKey, opts
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -332,7 +332,7 @@ func (c *Client) tagKeyDiffsForRawDesired(ctx context.Context, rawDesired *TagKe
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a TagKey resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve TagKey resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve TagKey resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that TagKey resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -346,6 +346,12 @@ func (c *Client) tagKeyDiffsForRawDesired(ctx context.Context, rawDesired *TagKe
 	if err := extractTagKeyFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeTagKeyInitialState(rawInitial, rawDesired)
@@ -363,7 +369,7 @@ func (c *Client) tagKeyDiffsForRawDesired(ctx context.Context, rawDesired *TagKe
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffTagKey(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeTagKeyInitialState(rawInitial, rawDesired *TagKey) (*TagKey, error) {
@@ -105,8 +105,12 @@ func (l *ProjectList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ProjectList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ProjectList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Project{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -123,7 +127,8 @@ func (l *ProjectList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListProject(ctx context.Context, parent string) (*ProjectList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Project{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Project{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListProjectWithMaxResults(ctx, parent, ProjectMaxPage)
@@ -131,14 +136,18 @@ func (c *Client) ListProject(ctx context.Context, parent string) (*ProjectList,
 }
 
 func (c *Client) ListProjectWithMaxResults(ctx context.Context, parent string, pageSize int32) (*ProjectList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListProjectWithPageToken(ctx, parent, pageSize, "")
+}
+
+func (c *Client) ListProjectWithPageToken(ctx context.Context, parent string, pageSize int32, pageToken string) (*ProjectList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Project{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Project{
 		Parent: &parent,
 	}
-	items, token, err := c.listProject(ctx, r, "", pageSize)
+	items, token, err := c.listProject(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +161,8 @@ func (c *Client) ListProjectWithMaxResults(ctx context.Context, parent string, p
 
 func (c *Client) GetProject(ctx context.Context, r *Project) (*Project, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Project{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Project{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -168,7 +178,7 @@ func (c *Client) GetProject(ctx context.Context, r *Project) (*Project, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalProject(b, c, r)
 	if err != nil {
@@ -192,7 +202,8 @@ func (c *Client) GetProject(ctx context.Context, r *Project) (*Project, error) {
 
 func (c *Client) DeleteProject(ctx context.Context, r *Project) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Project{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Project{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -200,7 +211,13 @@ func (c *Client) DeleteProject(ctx context.Context, r *Project) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Project...")
 	deleteOp := deleteProjectOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteProjectAsync(ctx context.Context, r *Project) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteProject(ctx, r)
+	})
 }
 
 // DeleteAllProject deletes all resources that the filter functions returns true on.
@@ -228,10 +245,11 @@ func (c *Client) DeleteAllProject(ctx context.Context, parent string, filter fun
 }
 
 func (c *Client) ApplyProject(ctx context.Context, rawDesired *Project, opts ...dcl.ApplyOption) (*Project, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Project{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Project{}).Describe())
 	var resultNewState *Project
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyProjectHelper(c, ctx, rawDesired, opts...)
@@ -249,6 +267,38 @@ func (c *Client) ApplyProject(ctx context.Context, rawDesired *Project, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyProjectAsync(ctx context.Context, rawDesired *Project, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyProject(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffProject returns the field-level differences between rawDesired and the
+// live Project without modifying it. If the Project does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffProject(ctx context.Context, rawDesired *Project, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Project{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Project{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractProjectFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.projectDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Project %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyProjectHelper(c *Client, ctx context.Context, rawDesired *Project, opts ...dcl.ApplyOption) (*Project, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyProject...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -257,6 +307,9 @@ func applyProjectHelper(c *Client, ctx context.Context, rawDesired *Project, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractProjectFields(rawDesired); err != nil {
 		return nil, err
@@ -315,7 +368,7 @@ func applyProjectHelper(c *Client, ctx context.Context, rawDesired *Project, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -329,6 +382,8 @@ func applyProjectDiff(c *Client, ctx context.Context, desired *Project, rawDesir
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
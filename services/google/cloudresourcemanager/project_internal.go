@@ -329,6 +329,14 @@ func (c *Client) getProjectRaw(ctx context.Context, r *Project) ([]byte, error)
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Project) withDefaultLabels(c *dcl.Config, current map[string]string) *Project {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) projectDiffsForRawDesired(ctx context.Context, rawDesired *Project, opts ...dcl.ApplyOption) (initial, desired *Project, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -346,10 +354,16 @@ func (c *Client) projectDiffsForRawDesired(ctx context.Context, rawDesired *Proj
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetProject(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFoundOrCode(err, 403) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Project resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Project resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Project resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Project resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -363,6 +377,12 @@ func (c *Client) projectDiffsForRawDesired(ctx context.Context, rawDesired *Proj
 	if err := extractProjectFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeProjectInitialState(rawInitial, rawDesired)
@@ -380,7 +400,7 @@ func (c *Client) projectDiffsForRawDesired(ctx context.Context, rawDesired *Proj
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffProject(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeProjectInitialState(rawInitial, rawDesired *Project) (*Project, error) {
@@ -491,7 +511,7 @@ func diffProject(c *Client, desired, actual *Project, opts ...dcl.ApplyOption) (
 	var fn dcl.FieldName
 	var newDiffs []*dcl.FieldDiff
 	// New style diffs.
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateProjectUpdateProjectOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateProjectUpdateProjectOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
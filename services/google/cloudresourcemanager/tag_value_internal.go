@@ -332,7 +332,7 @@ func (c *Client) tagValueDiffsForRawDesired(ctx context.Context, rawDesired *Tag
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a TagValue resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve TagValue resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve TagValue resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that TagValue resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -346,6 +346,12 @@ func (c *Client) tagValueDiffsForRawDesired(ctx context.Context, rawDesired *Tag
 	if err := extractTagValueFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeTagValueInitialState(rawInitial, rawDesired)
@@ -363,7 +369,7 @@ func (c *Client) tagValueDiffsForRawDesired(ctx context.Context, rawDesired *Tag
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffTagValue(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeTagValueInitialState(rawInitial, rawDesired *TagValue) (*TagValue, error) {
@@ -663,8 +663,12 @@ func (l *JobList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *JobList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *JobList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Job{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -681,7 +685,8 @@ func (l *JobList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListJob(ctx context.Context, project, location string) (*JobList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Job{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Job{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListJobWithMaxResults(ctx, project, location, JobMaxPage)
@@ -689,7 +694,11 @@ func (c *Client) ListJob(ctx context.Context, project, location string) (*JobLis
 }
 
 func (c *Client) ListJobWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*JobList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListJobWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListJobWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*JobList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Job{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -697,7 +706,7 @@ func (c *Client) ListJobWithMaxResults(ctx context.Context, project, location st
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listJob(ctx, r, "", pageSize)
+	items, token, err := c.listJob(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -711,7 +720,8 @@ func (c *Client) ListJobWithMaxResults(ctx context.Context, project, location st
 
 func (c *Client) GetJob(ctx context.Context, r *Job) (*Job, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Job{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Job{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -727,7 +737,7 @@ func (c *Client) GetJob(ctx context.Context, r *Job) (*Job, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalJob(b, c, r)
 	if err != nil {
@@ -753,7 +763,8 @@ func (c *Client) GetJob(ctx context.Context, r *Job) (*Job, error) {
 
 func (c *Client) DeleteJob(ctx context.Context, r *Job) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Job{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Job{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -761,7 +772,13 @@ func (c *Client) DeleteJob(ctx context.Context, r *Job) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Job...")
 	deleteOp := deleteJobOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteJobAsync(ctx context.Context, r *Job) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteJob(ctx, r)
+	})
 }
 
 // DeleteAllJob deletes all resources that the filter functions returns true on.
@@ -789,10 +806,11 @@ func (c *Client) DeleteAllJob(ctx context.Context, project, location string, fil
 }
 
 func (c *Client) ApplyJob(ctx context.Context, rawDesired *Job, opts ...dcl.ApplyOption) (*Job, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Job{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Job{}).Describe())
 	var resultNewState *Job
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyJobHelper(c, ctx, rawDesired, opts...)
@@ -810,6 +828,38 @@ func (c *Client) ApplyJob(ctx context.Context, rawDesired *Job, opts ...dcl.Appl
 	return resultNewState, err
 }
 
+func (c *Client) ApplyJobAsync(ctx context.Context, rawDesired *Job, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyJob(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffJob returns the field-level differences between rawDesired and the
+// live Job without modifying it. If the Job does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffJob(ctx context.Context, rawDesired *Job, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Job{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Job{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractJobFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.jobDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Job %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyJobHelper(c *Client, ctx context.Context, rawDesired *Job, opts ...dcl.ApplyOption) (*Job, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyJob...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -818,6 +868,9 @@ func applyJobHelper(c *Client, ctx context.Context, rawDesired *Job, opts ...dcl
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractJobFields(rawDesired); err != nil {
 		return nil, err
@@ -876,7 +929,7 @@ func applyJobHelper(c *Client, ctx context.Context, rawDesired *Job, opts ...dcl
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
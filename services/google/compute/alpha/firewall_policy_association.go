@@ -73,8 +73,12 @@ func (l *FirewallPolicyAssociationList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *FirewallPolicyAssociationList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *FirewallPolicyAssociationList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyAssociation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -91,7 +95,8 @@ func (l *FirewallPolicyAssociationList) Next(ctx context.Context, c *Client) err
 
 func (c *Client) ListFirewallPolicyAssociation(ctx context.Context, firewallPolicy string) (*FirewallPolicyAssociationList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicyAssociation{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyAssociation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListFirewallPolicyAssociationWithMaxResults(ctx, firewallPolicy, FirewallPolicyAssociationMaxPage)
@@ -99,14 +104,18 @@ func (c *Client) ListFirewallPolicyAssociation(ctx context.Context, firewallPoli
 }
 
 func (c *Client) ListFirewallPolicyAssociationWithMaxResults(ctx context.Context, firewallPolicy string, pageSize int32) (*FirewallPolicyAssociationList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListFirewallPolicyAssociationWithPageToken(ctx, firewallPolicy, pageSize, "")
+}
+
+func (c *Client) ListFirewallPolicyAssociationWithPageToken(ctx context.Context, firewallPolicy string, pageSize int32, pageToken string) (*FirewallPolicyAssociationList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyAssociation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &FirewallPolicyAssociation{
 		FirewallPolicy: &firewallPolicy,
 	}
-	items, token, err := c.listFirewallPolicyAssociation(ctx, r, "", pageSize)
+	items, token, err := c.listFirewallPolicyAssociation(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +129,8 @@ func (c *Client) ListFirewallPolicyAssociationWithMaxResults(ctx context.Context
 
 func (c *Client) GetFirewallPolicyAssociation(ctx context.Context, r *FirewallPolicyAssociation) (*FirewallPolicyAssociation, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicyAssociation{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyAssociation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -136,7 +146,7 @@ func (c *Client) GetFirewallPolicyAssociation(ctx context.Context, r *FirewallPo
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalFirewallPolicyAssociation(b, c, r)
 	if err != nil {
@@ -161,7 +171,8 @@ func (c *Client) GetFirewallPolicyAssociation(ctx context.Context, r *FirewallPo
 
 func (c *Client) DeleteFirewallPolicyAssociation(ctx context.Context, r *FirewallPolicyAssociation) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicyAssociation{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyAssociation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -169,7 +180,13 @@ func (c *Client) DeleteFirewallPolicyAssociation(ctx context.Context, r *Firewal
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting FirewallPolicyAssociation...")
 	deleteOp := deleteFirewallPolicyAssociationOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteFirewallPolicyAssociationAsync(ctx context.Context, r *FirewallPolicyAssociation) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteFirewallPolicyAssociation(ctx, r)
+	})
 }
 
 // DeleteAllFirewallPolicyAssociation deletes all resources that the filter functions returns true on.
@@ -197,10 +214,11 @@ func (c *Client) DeleteAllFirewallPolicyAssociation(ctx context.Context, firewal
 }
 
 func (c *Client) ApplyFirewallPolicyAssociation(ctx context.Context, rawDesired *FirewallPolicyAssociation, opts ...dcl.ApplyOption) (*FirewallPolicyAssociation, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyAssociation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicyAssociation{}).Describe())
 	var resultNewState *FirewallPolicyAssociation
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyFirewallPolicyAssociationHelper(c, ctx, rawDesired, opts...)
@@ -218,6 +236,38 @@ func (c *Client) ApplyFirewallPolicyAssociation(ctx context.Context, rawDesired
 	return resultNewState, err
 }
 
+func (c *Client) ApplyFirewallPolicyAssociationAsync(ctx context.Context, rawDesired *FirewallPolicyAssociation, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyFirewallPolicyAssociation(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffFirewallPolicyAssociation returns the field-level differences between rawDesired and the
+// live FirewallPolicyAssociation without modifying it. If the FirewallPolicyAssociation does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffFirewallPolicyAssociation(ctx context.Context, rawDesired *FirewallPolicyAssociation, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyAssociation{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicyAssociation{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractFirewallPolicyAssociationFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.firewallPolicyAssociationDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("FirewallPolicyAssociation %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyFirewallPolicyAssociationHelper(c *Client, ctx context.Context, rawDesired *FirewallPolicyAssociation, opts ...dcl.ApplyOption) (*FirewallPolicyAssociation, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyFirewallPolicyAssociation...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -226,6 +276,9 @@ func applyFirewallPolicyAssociationHelper(c *Client, ctx context.Context, rawDes
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractFirewallPolicyAssociationFields(rawDesired); err != nil {
 		return nil, err
@@ -284,7 +337,7 @@ func applyFirewallPolicyAssociationHelper(c *Client, ctx context.Context, rawDes
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
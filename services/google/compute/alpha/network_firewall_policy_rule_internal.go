@@ -459,7 +459,7 @@ func (c *Client) networkFirewallPolicyRuleDiffsForRawDesired(ctx context.Context
 	if rawInitial == nil {
 		if !dcl.IsNotFoundOrCode(err, 400) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a NetworkFirewallPolicyRule resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve NetworkFirewallPolicyRule resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve NetworkFirewallPolicyRule resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that NetworkFirewallPolicyRule resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -473,6 +473,12 @@ func (c *Client) networkFirewallPolicyRuleDiffsForRawDesired(ctx context.Context
 	if err := extractNetworkFirewallPolicyRuleFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeNetworkFirewallPolicyRuleInitialState(rawInitial, rawDesired)
@@ -490,7 +496,7 @@ func (c *Client) networkFirewallPolicyRuleDiffsForRawDesired(ctx context.Context
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffNetworkFirewallPolicyRule(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeNetworkFirewallPolicyRuleInitialState(rawInitial, rawDesired *NetworkFirewallPolicyRule) (*NetworkFirewallPolicyRule, error) {
@@ -446,7 +446,7 @@ func (c *Client) packetMirroringDiffsForRawDesired(ctx context.Context, rawDesir
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a PacketMirroring resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve PacketMirroring resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve PacketMirroring resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that PacketMirroring resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -460,6 +460,12 @@ func (c *Client) packetMirroringDiffsForRawDesired(ctx context.Context, rawDesir
 	if err := extractPacketMirroringFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizePacketMirroringInitialState(rawInitial, rawDesired)
@@ -477,7 +483,7 @@ func (c *Client) packetMirroringDiffsForRawDesired(ctx context.Context, rawDesir
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffPacketMirroring(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizePacketMirroringInitialState(rawInitial, rawDesired *PacketMirroring) (*PacketMirroring, error) {
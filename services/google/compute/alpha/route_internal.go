@@ -307,7 +307,7 @@ func (c *Client) routeDiffsForRawDesired(ctx context.Context, rawDesired *Route,
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Route resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Route resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Route resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Route resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -321,6 +321,12 @@ func (c *Client) routeDiffsForRawDesired(ctx context.Context, rawDesired *Route,
 	if err := extractRouteFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeRouteInitialState(rawInitial, rawDesired)
@@ -338,7 +344,7 @@ func (c *Client) routeDiffsForRawDesired(ctx context.Context, rawDesired *Route,
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffRoute(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeRouteInitialState(rawInitial, rawDesired *Route) (*Route, error) {
@@ -303,8 +303,12 @@ func (l *ServiceAttachmentList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ServiceAttachmentList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ServiceAttachmentList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAttachment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -321,7 +325,8 @@ func (l *ServiceAttachmentList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListServiceAttachment(ctx context.Context, project, location string) (*ServiceAttachmentList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceAttachment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAttachment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListServiceAttachmentWithMaxResults(ctx, project, location, ServiceAttachmentMaxPage)
@@ -329,7 +334,11 @@ func (c *Client) ListServiceAttachment(ctx context.Context, project, location st
 }
 
 func (c *Client) ListServiceAttachmentWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*ServiceAttachmentList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListServiceAttachmentWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListServiceAttachmentWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*ServiceAttachmentList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAttachment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -337,7 +346,7 @@ func (c *Client) ListServiceAttachmentWithMaxResults(ctx context.Context, projec
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listServiceAttachment(ctx, r, "", pageSize)
+	items, token, err := c.listServiceAttachment(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -351,7 +360,8 @@ func (c *Client) ListServiceAttachmentWithMaxResults(ctx context.Context, projec
 
 func (c *Client) GetServiceAttachment(ctx context.Context, r *ServiceAttachment) (*ServiceAttachment, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceAttachment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAttachment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -367,7 +377,7 @@ func (c *Client) GetServiceAttachment(ctx context.Context, r *ServiceAttachment)
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalServiceAttachment(b, c, r)
 	if err != nil {
@@ -393,7 +403,8 @@ func (c *Client) GetServiceAttachment(ctx context.Context, r *ServiceAttachment)
 
 func (c *Client) DeleteServiceAttachment(ctx context.Context, r *ServiceAttachment) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceAttachment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAttachment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -401,7 +412,13 @@ func (c *Client) DeleteServiceAttachment(ctx context.Context, r *ServiceAttachme
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting ServiceAttachment...")
 	deleteOp := deleteServiceAttachmentOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteServiceAttachmentAsync(ctx context.Context, r *ServiceAttachment) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteServiceAttachment(ctx, r)
+	})
 }
 
 // DeleteAllServiceAttachment deletes all resources that the filter functions returns true on.
@@ -429,10 +446,11 @@ func (c *Client) DeleteAllServiceAttachment(ctx context.Context, project, locati
 }
 
 func (c *Client) ApplyServiceAttachment(ctx context.Context, rawDesired *ServiceAttachment, opts ...dcl.ApplyOption) (*ServiceAttachment, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAttachment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceAttachment{}).Describe())
 	var resultNewState *ServiceAttachment
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyServiceAttachmentHelper(c, ctx, rawDesired, opts...)
@@ -450,6 +468,38 @@ func (c *Client) ApplyServiceAttachment(ctx context.Context, rawDesired *Service
 	return resultNewState, err
 }
 
+func (c *Client) ApplyServiceAttachmentAsync(ctx context.Context, rawDesired *ServiceAttachment, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyServiceAttachment(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffServiceAttachment returns the field-level differences between rawDesired and the
+// live ServiceAttachment without modifying it. If the ServiceAttachment does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffServiceAttachment(ctx context.Context, rawDesired *ServiceAttachment, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAttachment{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceAttachment{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractServiceAttachmentFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.serviceAttachmentDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("ServiceAttachment %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyServiceAttachmentHelper(c *Client, ctx context.Context, rawDesired *ServiceAttachment, opts ...dcl.ApplyOption) (*ServiceAttachment, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyServiceAttachment...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -458,6 +508,9 @@ func applyServiceAttachmentHelper(c *Client, ctx context.Context, rawDesired *Se
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractServiceAttachmentFields(rawDesired); err != nil {
 		return nil, err
@@ -516,7 +569,7 @@ func applyServiceAttachmentHelper(c *Client, ctx context.Context, rawDesired *Se
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
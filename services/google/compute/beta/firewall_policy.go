@@ -85,8 +85,12 @@ func (l *FirewallPolicyList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *FirewallPolicyList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *FirewallPolicyList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -103,7 +107,8 @@ func (l *FirewallPolicyList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListFirewallPolicy(ctx context.Context, parent string) (*FirewallPolicyList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListFirewallPolicyWithMaxResults(ctx, parent, FirewallPolicyMaxPage)
@@ -111,14 +116,18 @@ func (c *Client) ListFirewallPolicy(ctx context.Context, parent string) (*Firewa
 }
 
 func (c *Client) ListFirewallPolicyWithMaxResults(ctx context.Context, parent string, pageSize int32) (*FirewallPolicyList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListFirewallPolicyWithPageToken(ctx, parent, pageSize, "")
+}
+
+func (c *Client) ListFirewallPolicyWithPageToken(ctx context.Context, parent string, pageSize int32, pageToken string) (*FirewallPolicyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &FirewallPolicy{
 		Parent: &parent,
 	}
-	items, token, err := c.listFirewallPolicy(ctx, r, "", pageSize)
+	items, token, err := c.listFirewallPolicy(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +141,8 @@ func (c *Client) ListFirewallPolicyWithMaxResults(ctx context.Context, parent st
 
 func (c *Client) GetFirewallPolicy(ctx context.Context, r *FirewallPolicy) (*FirewallPolicy, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -148,7 +158,7 @@ func (c *Client) GetFirewallPolicy(ctx context.Context, r *FirewallPolicy) (*Fir
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalFirewallPolicy(b, c, r)
 	if err != nil {
@@ -172,7 +182,8 @@ func (c *Client) GetFirewallPolicy(ctx context.Context, r *FirewallPolicy) (*Fir
 
 func (c *Client) DeleteFirewallPolicy(ctx context.Context, r *FirewallPolicy) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -180,7 +191,13 @@ func (c *Client) DeleteFirewallPolicy(ctx context.Context, r *FirewallPolicy) er
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting FirewallPolicy...")
 	deleteOp := deleteFirewallPolicyOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteFirewallPolicyAsync(ctx context.Context, r *FirewallPolicy) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteFirewallPolicy(ctx, r)
+	})
 }
 
 // DeleteAllFirewallPolicy deletes all resources that the filter functions returns true on.
@@ -208,10 +225,11 @@ func (c *Client) DeleteAllFirewallPolicy(ctx context.Context, parent string, fil
 }
 
 func (c *Client) ApplyFirewallPolicy(ctx context.Context, rawDesired *FirewallPolicy, opts ...dcl.ApplyOption) (*FirewallPolicy, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicy{}).Describe())
 	var resultNewState *FirewallPolicy
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyFirewallPolicyHelper(c, ctx, rawDesired, opts...)
@@ -229,6 +247,38 @@ func (c *Client) ApplyFirewallPolicy(ctx context.Context, rawDesired *FirewallPo
 	return resultNewState, err
 }
 
+func (c *Client) ApplyFirewallPolicyAsync(ctx context.Context, rawDesired *FirewallPolicy, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyFirewallPolicy(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffFirewallPolicy returns the field-level differences between rawDesired and the
+// live FirewallPolicy without modifying it. If the FirewallPolicy does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffFirewallPolicy(ctx context.Context, rawDesired *FirewallPolicy, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicy{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicy{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractFirewallPolicyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.firewallPolicyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("FirewallPolicy %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyFirewallPolicyHelper(c *Client, ctx context.Context, rawDesired *FirewallPolicy, opts ...dcl.ApplyOption) (*FirewallPolicy, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyFirewallPolicy...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -237,6 +287,9 @@ func applyFirewallPolicyHelper(c *Client, ctx context.Context, rawDesired *Firew
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractFirewallPolicyFields(rawDesired); err != nil {
 		return nil, err
@@ -295,7 +348,7 @@ func applyFirewallPolicyHelper(c *Client, ctx context.Context, rawDesired *Firew
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -219,8 +219,12 @@ func (l *FirewallPolicyRuleList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *FirewallPolicyRuleList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *FirewallPolicyRuleList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -237,7 +241,8 @@ func (l *FirewallPolicyRuleList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListFirewallPolicyRule(ctx context.Context, firewallPolicy string) (*FirewallPolicyRuleList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicyRule{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListFirewallPolicyRuleWithMaxResults(ctx, firewallPolicy, FirewallPolicyRuleMaxPage)
@@ -245,14 +250,18 @@ func (c *Client) ListFirewallPolicyRule(ctx context.Context, firewallPolicy stri
 }
 
 func (c *Client) ListFirewallPolicyRuleWithMaxResults(ctx context.Context, firewallPolicy string, pageSize int32) (*FirewallPolicyRuleList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListFirewallPolicyRuleWithPageToken(ctx, firewallPolicy, pageSize, "")
+}
+
+func (c *Client) ListFirewallPolicyRuleWithPageToken(ctx context.Context, firewallPolicy string, pageSize int32, pageToken string) (*FirewallPolicyRuleList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &FirewallPolicyRule{
 		FirewallPolicy: &firewallPolicy,
 	}
-	items, token, err := c.listFirewallPolicyRule(ctx, r, "", pageSize)
+	items, token, err := c.listFirewallPolicyRule(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -266,7 +275,8 @@ func (c *Client) ListFirewallPolicyRuleWithMaxResults(ctx context.Context, firew
 
 func (c *Client) GetFirewallPolicyRule(ctx context.Context, r *FirewallPolicyRule) (*FirewallPolicyRule, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicyRule{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -282,7 +292,7 @@ func (c *Client) GetFirewallPolicyRule(ctx context.Context, r *FirewallPolicyRul
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalFirewallPolicyRule(b, c, r)
 	if err != nil {
@@ -307,7 +317,8 @@ func (c *Client) GetFirewallPolicyRule(ctx context.Context, r *FirewallPolicyRul
 
 func (c *Client) DeleteFirewallPolicyRule(ctx context.Context, r *FirewallPolicyRule) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicyRule{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -315,7 +326,13 @@ func (c *Client) DeleteFirewallPolicyRule(ctx context.Context, r *FirewallPolicy
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting FirewallPolicyRule...")
 	deleteOp := deleteFirewallPolicyRuleOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteFirewallPolicyRuleAsync(ctx context.Context, r *FirewallPolicyRule) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteFirewallPolicyRule(ctx, r)
+	})
 }
 
 // DeleteAllFirewallPolicyRule deletes all resources that the filter functions returns true on.
@@ -343,10 +360,11 @@ func (c *Client) DeleteAllFirewallPolicyRule(ctx context.Context, firewallPolicy
 }
 
 func (c *Client) ApplyFirewallPolicyRule(ctx context.Context, rawDesired *FirewallPolicyRule, opts ...dcl.ApplyOption) (*FirewallPolicyRule, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicyRule{}).Describe())
 	var resultNewState *FirewallPolicyRule
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyFirewallPolicyRuleHelper(c, ctx, rawDesired, opts...)
@@ -364,6 +382,38 @@ func (c *Client) ApplyFirewallPolicyRule(ctx context.Context, rawDesired *Firewa
 	return resultNewState, err
 }
 
+func (c *Client) ApplyFirewallPolicyRuleAsync(ctx context.Context, rawDesired *FirewallPolicyRule, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyFirewallPolicyRule(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffFirewallPolicyRule returns the field-level differences between rawDesired and the
+// live FirewallPolicyRule without modifying it. If the FirewallPolicyRule does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffFirewallPolicyRule(ctx context.Context, rawDesired *FirewallPolicyRule, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirewallPolicyRule{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirewallPolicyRule{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractFirewallPolicyRuleFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.firewallPolicyRuleDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("FirewallPolicyRule %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyFirewallPolicyRuleHelper(c *Client, ctx context.Context, rawDesired *FirewallPolicyRule, opts ...dcl.ApplyOption) (*FirewallPolicyRule, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyFirewallPolicyRule...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -372,6 +422,9 @@ func applyFirewallPolicyRuleHelper(c *Client, ctx context.Context, rawDesired *F
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractFirewallPolicyRuleFields(rawDesired); err != nil {
 		return nil, err
@@ -430,7 +483,7 @@ func applyFirewallPolicyRuleHelper(c *Client, ctx context.Context, rawDesired *F
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
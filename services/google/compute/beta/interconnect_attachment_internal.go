@@ -419,7 +419,7 @@ func (c *Client) interconnectAttachmentDiffsForRawDesired(ctx context.Context, r
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a InterconnectAttachment resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve InterconnectAttachment resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve InterconnectAttachment resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that InterconnectAttachment resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -433,6 +433,12 @@ func (c *Client) interconnectAttachmentDiffsForRawDesired(ctx context.Context, r
 	if err := extractInterconnectAttachmentFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeInterconnectAttachmentInitialState(rawInitial, rawDesired)
@@ -450,7 +456,7 @@ func (c *Client) interconnectAttachmentDiffsForRawDesired(ctx context.Context, r
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffInterconnectAttachment(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeInterconnectAttachmentInitialState(rawInitial, rawDesired *InterconnectAttachment) (*InterconnectAttachment, error) {
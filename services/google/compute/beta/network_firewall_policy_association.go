@@ -81,8 +81,12 @@ func (l *NetworkFirewallPolicyAssociationList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *NetworkFirewallPolicyAssociationList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *NetworkFirewallPolicyAssociationList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyAssociation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -99,7 +103,8 @@ func (l *NetworkFirewallPolicyAssociationList) Next(ctx context.Context, c *Clie
 
 func (c *Client) ListNetworkFirewallPolicyAssociation(ctx context.Context, project, location, firewallPolicy string) (*NetworkFirewallPolicyAssociationList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicyAssociation{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyAssociation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListNetworkFirewallPolicyAssociationWithMaxResults(ctx, project, location, firewallPolicy, NetworkFirewallPolicyAssociationMaxPage)
@@ -107,7 +112,11 @@ func (c *Client) ListNetworkFirewallPolicyAssociation(ctx context.Context, proje
 }
 
 func (c *Client) ListNetworkFirewallPolicyAssociationWithMaxResults(ctx context.Context, project, location, firewallPolicy string, pageSize int32) (*NetworkFirewallPolicyAssociationList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListNetworkFirewallPolicyAssociationWithPageToken(ctx, project, location, firewallPolicy, pageSize, "")
+}
+
+func (c *Client) ListNetworkFirewallPolicyAssociationWithPageToken(ctx context.Context, project, location, firewallPolicy string, pageSize int32, pageToken string) (*NetworkFirewallPolicyAssociationList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyAssociation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -116,7 +125,7 @@ func (c *Client) ListNetworkFirewallPolicyAssociationWithMaxResults(ctx context.
 		Location:       &location,
 		FirewallPolicy: &firewallPolicy,
 	}
-	items, token, err := c.listNetworkFirewallPolicyAssociation(ctx, r, "", pageSize)
+	items, token, err := c.listNetworkFirewallPolicyAssociation(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +139,8 @@ func (c *Client) ListNetworkFirewallPolicyAssociationWithMaxResults(ctx context.
 
 func (c *Client) GetNetworkFirewallPolicyAssociation(ctx context.Context, r *NetworkFirewallPolicyAssociation) (*NetworkFirewallPolicyAssociation, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicyAssociation{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyAssociation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -146,7 +156,7 @@ func (c *Client) GetNetworkFirewallPolicyAssociation(ctx context.Context, r *Net
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalNetworkFirewallPolicyAssociation(b, c, r)
 	if err != nil {
@@ -173,7 +183,8 @@ func (c *Client) GetNetworkFirewallPolicyAssociation(ctx context.Context, r *Net
 
 func (c *Client) DeleteNetworkFirewallPolicyAssociation(ctx context.Context, r *NetworkFirewallPolicyAssociation) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicyAssociation{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyAssociation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -181,7 +192,13 @@ func (c *Client) DeleteNetworkFirewallPolicyAssociation(ctx context.Context, r *
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting NetworkFirewallPolicyAssociation...")
 	deleteOp := deleteNetworkFirewallPolicyAssociationOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteNetworkFirewallPolicyAssociationAsync(ctx context.Context, r *NetworkFirewallPolicyAssociation) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteNetworkFirewallPolicyAssociation(ctx, r)
+	})
 }
 
 // DeleteAllNetworkFirewallPolicyAssociation deletes all resources that the filter functions returns true on.
@@ -209,10 +226,11 @@ func (c *Client) DeleteAllNetworkFirewallPolicyAssociation(ctx context.Context,
 }
 
 func (c *Client) ApplyNetworkFirewallPolicyAssociation(ctx context.Context, rawDesired *NetworkFirewallPolicyAssociation, opts ...dcl.ApplyOption) (*NetworkFirewallPolicyAssociation, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyAssociation{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicyAssociation{}).Describe())
 	var resultNewState *NetworkFirewallPolicyAssociation
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyNetworkFirewallPolicyAssociationHelper(c, ctx, rawDesired, opts...)
@@ -230,6 +248,38 @@ func (c *Client) ApplyNetworkFirewallPolicyAssociation(ctx context.Context, rawD
 	return resultNewState, err
 }
 
+func (c *Client) ApplyNetworkFirewallPolicyAssociationAsync(ctx context.Context, rawDesired *NetworkFirewallPolicyAssociation, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyNetworkFirewallPolicyAssociation(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffNetworkFirewallPolicyAssociation returns the field-level differences between rawDesired and the
+// live NetworkFirewallPolicyAssociation without modifying it. If the NetworkFirewallPolicyAssociation does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffNetworkFirewallPolicyAssociation(ctx context.Context, rawDesired *NetworkFirewallPolicyAssociation, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyAssociation{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicyAssociation{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractNetworkFirewallPolicyAssociationFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.networkFirewallPolicyAssociationDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("NetworkFirewallPolicyAssociation %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyNetworkFirewallPolicyAssociationHelper(c *Client, ctx context.Context, rawDesired *NetworkFirewallPolicyAssociation, opts ...dcl.ApplyOption) (*NetworkFirewallPolicyAssociation, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyNetworkFirewallPolicyAssociation...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -238,6 +288,9 @@ func applyNetworkFirewallPolicyAssociationHelper(c *Client, ctx context.Context,
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractNetworkFirewallPolicyAssociationFields(rawDesired); err != nil {
 		return nil, err
@@ -296,7 +349,7 @@ func applyNetworkFirewallPolicyAssociationHelper(c *Client, ctx context.Context,
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
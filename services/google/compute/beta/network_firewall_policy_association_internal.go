@@ -324,7 +324,7 @@ func (c *Client) networkFirewallPolicyAssociationDiffsForRawDesired(ctx context.
 	if rawInitial == nil {
 		if !dcl.IsNotFoundOrCode(err, 400) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a NetworkFirewallPolicyAssociation resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve NetworkFirewallPolicyAssociation resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve NetworkFirewallPolicyAssociation resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that NetworkFirewallPolicyAssociation resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -338,6 +338,12 @@ func (c *Client) networkFirewallPolicyAssociationDiffsForRawDesired(ctx context.
 	if err := extractNetworkFirewallPolicyAssociationFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeNetworkFirewallPolicyAssociationInitialState(rawInitial, rawDesired)
@@ -355,7 +361,7 @@ func (c *Client) networkFirewallPolicyAssociationDiffsForRawDesired(ctx context.
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffNetworkFirewallPolicyAssociation(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeNetworkFirewallPolicyAssociationInitialState(rawInitial, rawDesired *NetworkFirewallPolicyAssociation) (*NetworkFirewallPolicyAssociation, error) {
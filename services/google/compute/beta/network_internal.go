@@ -341,7 +341,7 @@ func (c *Client) networkDiffsForRawDesired(ctx context.Context, rawDesired *Netw
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Network resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Network resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Network resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Network resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -355,6 +355,12 @@ func (c *Client) networkDiffsForRawDesired(ctx context.Context, rawDesired *Netw
 	if err := extractNetworkFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeNetworkInitialState(rawInitial, rawDesired)
@@ -372,7 +378,7 @@ func (c *Client) networkDiffsForRawDesired(ctx context.Context, rawDesired *Netw
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffNetwork(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeNetworkInitialState(rawInitial, rawDesired *Network) (*Network, error) {
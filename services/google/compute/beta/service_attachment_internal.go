@@ -433,7 +433,7 @@ func (c *Client) serviceAttachmentDiffsForRawDesired(ctx context.Context, rawDes
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a ServiceAttachment resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve ServiceAttachment resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve ServiceAttachment resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that ServiceAttachment resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -447,6 +447,12 @@ func (c *Client) serviceAttachmentDiffsForRawDesired(ctx context.Context, rawDes
 	if err := extractServiceAttachmentFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeServiceAttachmentInitialState(rawInitial, rawDesired)
@@ -464,7 +470,7 @@ func (c *Client) serviceAttachmentDiffsForRawDesired(ctx context.Context, rawDes
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffServiceAttachment(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeServiceAttachmentInitialState(rawInitial, rawDesired *ServiceAttachment) (*ServiceAttachment, error) {
@@ -527,7 +527,7 @@ func (c *Client) subnetworkDiffsForRawDesired(ctx context.Context, rawDesired *S
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Subnetwork resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Subnetwork resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Subnetwork resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Subnetwork resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -541,6 +541,12 @@ func (c *Client) subnetworkDiffsForRawDesired(ctx context.Context, rawDesired *S
 	if err := extractSubnetworkFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeSubnetworkInitialState(rawInitial, rawDesired)
@@ -558,7 +564,7 @@ func (c *Client) subnetworkDiffsForRawDesired(ctx context.Context, rawDesired *S
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffSubnetwork(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeSubnetworkInitialState(rawInitial, rawDesired *Subnetwork) (*Subnetwork, error) {
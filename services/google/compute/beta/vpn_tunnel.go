@@ -136,8 +136,12 @@ func (l *VpnTunnelList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *VpnTunnelList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *VpnTunnelList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -154,7 +158,8 @@ func (l *VpnTunnelList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListVpnTunnel(ctx context.Context, project, location string) (*VpnTunnelList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VpnTunnel{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListVpnTunnelWithMaxResults(ctx, project, location, VpnTunnelMaxPage)
@@ -162,7 +167,11 @@ func (c *Client) ListVpnTunnel(ctx context.Context, project, location string) (*
 }
 
 func (c *Client) ListVpnTunnelWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*VpnTunnelList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListVpnTunnelWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListVpnTunnelWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*VpnTunnelList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -170,7 +179,7 @@ func (c *Client) ListVpnTunnelWithMaxResults(ctx context.Context, project, locat
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listVpnTunnel(ctx, r, "", pageSize)
+	items, token, err := c.listVpnTunnel(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -184,7 +193,8 @@ func (c *Client) ListVpnTunnelWithMaxResults(ctx context.Context, project, locat
 
 func (c *Client) GetVpnTunnel(ctx context.Context, r *VpnTunnel) (*VpnTunnel, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VpnTunnel{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -200,7 +210,7 @@ func (c *Client) GetVpnTunnel(ctx context.Context, r *VpnTunnel) (*VpnTunnel, er
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalVpnTunnel(b, c, r)
 	if err != nil {
@@ -229,7 +239,8 @@ func (c *Client) GetVpnTunnel(ctx context.Context, r *VpnTunnel) (*VpnTunnel, er
 
 func (c *Client) DeleteVpnTunnel(ctx context.Context, r *VpnTunnel) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VpnTunnel{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -237,7 +248,13 @@ func (c *Client) DeleteVpnTunnel(ctx context.Context, r *VpnTunnel) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting VpnTunnel...")
 	deleteOp := deleteVpnTunnelOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteVpnTunnelAsync(ctx context.Context, r *VpnTunnel) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteVpnTunnel(ctx, r)
+	})
 }
 
 // DeleteAllVpnTunnel deletes all resources that the filter functions returns true on.
@@ -265,10 +282,11 @@ func (c *Client) DeleteAllVpnTunnel(ctx context.Context, project, location strin
 }
 
 func (c *Client) ApplyVpnTunnel(ctx context.Context, rawDesired *VpnTunnel, opts ...dcl.ApplyOption) (*VpnTunnel, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VpnTunnel{}).Describe())
 	var resultNewState *VpnTunnel
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyVpnTunnelHelper(c, ctx, rawDesired, opts...)
@@ -286,6 +304,38 @@ func (c *Client) ApplyVpnTunnel(ctx context.Context, rawDesired *VpnTunnel, opts
 	return resultNewState, err
 }
 
+func (c *Client) ApplyVpnTunnelAsync(ctx context.Context, rawDesired *VpnTunnel, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyVpnTunnel(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffVpnTunnel returns the field-level differences between rawDesired and the
+// live VpnTunnel without modifying it. If the VpnTunnel does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffVpnTunnel(ctx context.Context, rawDesired *VpnTunnel, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VpnTunnel{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractVpnTunnelFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.vpnTunnelDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("VpnTunnel %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyVpnTunnelHelper(c *Client, ctx context.Context, rawDesired *VpnTunnel, opts ...dcl.ApplyOption) (*VpnTunnel, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyVpnTunnel...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -294,6 +344,9 @@ func applyVpnTunnelHelper(c *Client, ctx context.Context, rawDesired *VpnTunnel,
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractVpnTunnelFields(rawDesired); err != nil {
 		return nil, err
@@ -352,7 +405,7 @@ func applyVpnTunnelHelper(c *Client, ctx context.Context, rawDesired *VpnTunnel,
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -366,6 +419,8 @@ func applyVpnTunnelDiff(c *Client, ctx context.Context, desired *VpnTunnel, rawD
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -295,7 +295,7 @@ func (c *Client) firewallPolicyAssociationDiffsForRawDesired(ctx context.Context
 	if rawInitial == nil {
 		if !dcl.IsNotFoundOrCode(err, 400) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a FirewallPolicyAssociation resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve FirewallPolicyAssociation resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve FirewallPolicyAssociation resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that FirewallPolicyAssociation resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -309,6 +309,12 @@ func (c *Client) firewallPolicyAssociationDiffsForRawDesired(ctx context.Context
 	if err := extractFirewallPolicyAssociationFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeFirewallPolicyAssociationInitialState(rawInitial, rawDesired)
@@ -326,7 +332,7 @@ func (c *Client) firewallPolicyAssociationDiffsForRawDesired(ctx context.Context
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffFirewallPolicyAssociation(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeFirewallPolicyAssociationInitialState(rawInitial, rawDesired *FirewallPolicyAssociation) (*FirewallPolicyAssociation, error) {
@@ -438,8 +438,12 @@ func (l *ForwardingRuleList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ForwardingRuleList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ForwardingRuleList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ForwardingRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -456,6 +460,7 @@ func (l *ForwardingRuleList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListForwardingRule(ctx context.Context, project, location string) (*ForwardingRuleList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ForwardingRule{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		412: dcl.Retryability{
 			Retryable: false,
@@ -463,7 +468,7 @@ func (c *Client) ListForwardingRule(ctx context.Context, project, location strin
 			Timeout:   0,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ForwardingRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListForwardingRuleWithMaxResults(ctx, project, location, ForwardingRuleMaxPage)
@@ -471,7 +476,11 @@ func (c *Client) ListForwardingRule(ctx context.Context, project, location strin
 }
 
 func (c *Client) ListForwardingRuleWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*ForwardingRuleList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListForwardingRuleWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListForwardingRuleWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*ForwardingRuleList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ForwardingRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -479,7 +488,7 @@ func (c *Client) ListForwardingRuleWithMaxResults(ctx context.Context, project,
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listForwardingRule(ctx, r, "", pageSize)
+	items, token, err := c.listForwardingRule(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -493,6 +502,7 @@ func (c *Client) ListForwardingRuleWithMaxResults(ctx context.Context, project,
 
 func (c *Client) GetForwardingRule(ctx context.Context, r *ForwardingRule) (*ForwardingRule, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ForwardingRule{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		412: dcl.Retryability{
 			Retryable: false,
@@ -500,7 +510,7 @@ func (c *Client) GetForwardingRule(ctx context.Context, r *ForwardingRule) (*For
 			Timeout:   0,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ForwardingRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -516,7 +526,7 @@ func (c *Client) GetForwardingRule(ctx context.Context, r *ForwardingRule) (*For
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalForwardingRule(b, c, r)
 	if err != nil {
@@ -542,6 +552,7 @@ func (c *Client) GetForwardingRule(ctx context.Context, r *ForwardingRule) (*For
 
 func (c *Client) DeleteForwardingRule(ctx context.Context, r *ForwardingRule) error {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ForwardingRule{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		412: dcl.Retryability{
 			Retryable: false,
@@ -549,7 +560,7 @@ func (c *Client) DeleteForwardingRule(ctx context.Context, r *ForwardingRule) er
 			Timeout:   0,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ForwardingRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -557,7 +568,13 @@ func (c *Client) DeleteForwardingRule(ctx context.Context, r *ForwardingRule) er
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting ForwardingRule...")
 	deleteOp := deleteForwardingRuleOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteForwardingRuleAsync(ctx context.Context, r *ForwardingRule) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteForwardingRule(ctx, r)
+	})
 }
 
 // DeleteAllForwardingRule deletes all resources that the filter functions returns true on.
@@ -585,10 +602,11 @@ func (c *Client) DeleteAllForwardingRule(ctx context.Context, project, location
 }
 
 func (c *Client) ApplyForwardingRule(ctx context.Context, rawDesired *ForwardingRule, opts ...dcl.ApplyOption) (*ForwardingRule, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ForwardingRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ForwardingRule{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		412: dcl.Retryability{
 			Retryable: false,
@@ -613,6 +631,38 @@ func (c *Client) ApplyForwardingRule(ctx context.Context, rawDesired *Forwarding
 	return resultNewState, err
 }
 
+func (c *Client) ApplyForwardingRuleAsync(ctx context.Context, rawDesired *ForwardingRule, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyForwardingRule(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffForwardingRule returns the field-level differences between rawDesired and the
+// live ForwardingRule without modifying it. If the ForwardingRule does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffForwardingRule(ctx context.Context, rawDesired *ForwardingRule, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ForwardingRule{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ForwardingRule{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractForwardingRuleFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.forwardingRuleDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("ForwardingRule %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyForwardingRuleHelper(c *Client, ctx context.Context, rawDesired *ForwardingRule, opts ...dcl.ApplyOption) (*ForwardingRule, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyForwardingRule...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -621,6 +671,9 @@ func applyForwardingRuleHelper(c *Client, ctx context.Context, rawDesired *Forwa
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractForwardingRuleFields(rawDesired); err != nil {
 		return nil, err
@@ -683,7 +736,7 @@ func applyForwardingRuleHelper(c *Client, ctx context.Context, rawDesired *Forwa
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -697,6 +750,8 @@ func applyForwardingRuleDiff(c *Client, ctx context.Context, desired *Forwarding
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -549,6 +549,14 @@ func (c *Client) getForwardingRuleRaw(ctx context.Context, r *ForwardingRule) ([
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *ForwardingRule) withDefaultLabels(c *dcl.Config, current map[string]string) *ForwardingRule {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) forwardingRuleDiffsForRawDesired(ctx context.Context, rawDesired *ForwardingRule, opts ...dcl.ApplyOption) (initial, desired *ForwardingRule, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -566,10 +574,16 @@ func (c *Client) forwardingRuleDiffsForRawDesired(ctx context.Context, rawDesire
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetForwardingRule(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a ForwardingRule resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve ForwardingRule resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve ForwardingRule resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that ForwardingRule resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -583,6 +597,12 @@ func (c *Client) forwardingRuleDiffsForRawDesired(ctx context.Context, rawDesire
 	if err := extractForwardingRuleFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeForwardingRuleInitialState(rawInitial, rawDesired)
@@ -600,7 +620,7 @@ func (c *Client) forwardingRuleDiffsForRawDesired(ctx context.Context, rawDesire
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffForwardingRule(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeForwardingRuleInitialState(rawInitial, rawDesired *ForwardingRule) (*ForwardingRule, error) {
@@ -1317,7 +1337,7 @@ func diffForwardingRule(c *Client, desired, actual *ForwardingRule, opts ...dcl.
 	var fn dcl.FieldName
 	var newDiffs []*dcl.FieldDiff
 	// New style diffs.
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateForwardingRuleSetLabelsOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateForwardingRuleSetLabelsOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -1149,8 +1149,12 @@ func (l *InstanceGroupManagerList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *InstanceGroupManagerList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *InstanceGroupManagerList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InstanceGroupManager{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -1167,6 +1171,7 @@ func (l *InstanceGroupManagerList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListInstanceGroupManager(ctx context.Context, project, location string) (*InstanceGroupManagerList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InstanceGroupManager{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		412: dcl.Retryability{
 			Retryable: false,
@@ -1174,7 +1179,7 @@ func (c *Client) ListInstanceGroupManager(ctx context.Context, project, location
 			Timeout:   0,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InstanceGroupManager{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListInstanceGroupManagerWithMaxResults(ctx, project, location, InstanceGroupManagerMaxPage)
@@ -1182,7 +1187,11 @@ func (c *Client) ListInstanceGroupManager(ctx context.Context, project, location
 }
 
 func (c *Client) ListInstanceGroupManagerWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*InstanceGroupManagerList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListInstanceGroupManagerWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListInstanceGroupManagerWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*InstanceGroupManagerList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InstanceGroupManager{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -1190,7 +1199,7 @@ func (c *Client) ListInstanceGroupManagerWithMaxResults(ctx context.Context, pro
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listInstanceGroupManager(ctx, r, "", pageSize)
+	items, token, err := c.listInstanceGroupManager(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -1204,6 +1213,7 @@ func (c *Client) ListInstanceGroupManagerWithMaxResults(ctx context.Context, pro
 
 func (c *Client) GetInstanceGroupManager(ctx context.Context, r *InstanceGroupManager) (*InstanceGroupManager, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InstanceGroupManager{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		412: dcl.Retryability{
 			Retryable: false,
@@ -1211,7 +1221,7 @@ func (c *Client) GetInstanceGroupManager(ctx context.Context, r *InstanceGroupMa
 			Timeout:   0,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InstanceGroupManager{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -1227,7 +1237,7 @@ func (c *Client) GetInstanceGroupManager(ctx context.Context, r *InstanceGroupMa
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalInstanceGroupManager(b, c, r)
 	if err != nil {
@@ -1253,6 +1263,7 @@ func (c *Client) GetInstanceGroupManager(ctx context.Context, r *InstanceGroupMa
 
 func (c *Client) DeleteInstanceGroupManager(ctx context.Context, r *InstanceGroupManager) error {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InstanceGroupManager{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		412: dcl.Retryability{
 			Retryable: false,
@@ -1260,7 +1271,7 @@ func (c *Client) DeleteInstanceGroupManager(ctx context.Context, r *InstanceGrou
 			Timeout:   0,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InstanceGroupManager{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -1268,7 +1279,13 @@ func (c *Client) DeleteInstanceGroupManager(ctx context.Context, r *InstanceGrou
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting InstanceGroupManager...")
 	deleteOp := deleteInstanceGroupManagerOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteInstanceGroupManagerAsync(ctx context.Context, r *InstanceGroupManager) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteInstanceGroupManager(ctx, r)
+	})
 }
 
 // DeleteAllInstanceGroupManager deletes all resources that the filter functions returns true on.
@@ -1296,10 +1313,11 @@ func (c *Client) DeleteAllInstanceGroupManager(ctx context.Context, project, loc
 }
 
 func (c *Client) ApplyInstanceGroupManager(ctx context.Context, rawDesired *InstanceGroupManager, opts ...dcl.ApplyOption) (*InstanceGroupManager, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InstanceGroupManager{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InstanceGroupManager{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		412: dcl.Retryability{
 			Retryable: false,
@@ -1324,6 +1342,38 @@ func (c *Client) ApplyInstanceGroupManager(ctx context.Context, rawDesired *Inst
 	return resultNewState, err
 }
 
+func (c *Client) ApplyInstanceGroupManagerAsync(ctx context.Context, rawDesired *InstanceGroupManager, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyInstanceGroupManager(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffInstanceGroupManager returns the field-level differences between rawDesired and the
+// live InstanceGroupManager without modifying it. If the InstanceGroupManager does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffInstanceGroupManager(ctx context.Context, rawDesired *InstanceGroupManager, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InstanceGroupManager{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InstanceGroupManager{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractInstanceGroupManagerFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.instanceGroupManagerDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("InstanceGroupManager %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyInstanceGroupManagerHelper(c *Client, ctx context.Context, rawDesired *InstanceGroupManager, opts ...dcl.ApplyOption) (*InstanceGroupManager, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyInstanceGroupManager...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -1332,6 +1382,9 @@ func applyInstanceGroupManagerHelper(c *Client, ctx context.Context, rawDesired
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractInstanceGroupManagerFields(rawDesired); err != nil {
 		return nil, err
@@ -1390,7 +1443,7 @@ func applyInstanceGroupManagerHelper(c *Client, ctx context.Context, rawDesired
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
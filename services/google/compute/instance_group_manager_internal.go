@@ -714,7 +714,7 @@ func (c *Client) instanceGroupManagerDiffsForRawDesired(ctx context.Context, raw
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a InstanceGroupManager resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve InstanceGroupManager resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve InstanceGroupManager resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that InstanceGroupManager resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -728,6 +728,12 @@ func (c *Client) instanceGroupManagerDiffsForRawDesired(ctx context.Context, raw
 	if err := extractInstanceGroupManagerFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeInstanceGroupManagerInitialState(rawInitial, rawDesired)
@@ -745,7 +751,7 @@ func (c *Client) instanceGroupManagerDiffsForRawDesired(ctx context.Context, raw
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffInstanceGroupManager(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeInstanceGroupManagerInitialState(rawInitial, rawDesired *InstanceGroupManager) (*InstanceGroupManager, error) {
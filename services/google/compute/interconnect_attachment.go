@@ -381,8 +381,12 @@ func (l *InterconnectAttachmentList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *InterconnectAttachmentList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *InterconnectAttachmentList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InterconnectAttachment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -399,7 +403,8 @@ func (l *InterconnectAttachmentList) Next(ctx context.Context, c *Client) error
 
 func (c *Client) ListInterconnectAttachment(ctx context.Context, project, region string) (*InterconnectAttachmentList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InterconnectAttachment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InterconnectAttachment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListInterconnectAttachmentWithMaxResults(ctx, project, region, InterconnectAttachmentMaxPage)
@@ -407,7 +412,11 @@ func (c *Client) ListInterconnectAttachment(ctx context.Context, project, region
 }
 
 func (c *Client) ListInterconnectAttachmentWithMaxResults(ctx context.Context, project, region string, pageSize int32) (*InterconnectAttachmentList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListInterconnectAttachmentWithPageToken(ctx, project, region, pageSize, "")
+}
+
+func (c *Client) ListInterconnectAttachmentWithPageToken(ctx context.Context, project, region string, pageSize int32, pageToken string) (*InterconnectAttachmentList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InterconnectAttachment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -415,7 +424,7 @@ func (c *Client) ListInterconnectAttachmentWithMaxResults(ctx context.Context, p
 		Project: &project,
 		Region:  &region,
 	}
-	items, token, err := c.listInterconnectAttachment(ctx, r, "", pageSize)
+	items, token, err := c.listInterconnectAttachment(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -429,7 +438,8 @@ func (c *Client) ListInterconnectAttachmentWithMaxResults(ctx context.Context, p
 
 func (c *Client) GetInterconnectAttachment(ctx context.Context, r *InterconnectAttachment) (*InterconnectAttachment, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InterconnectAttachment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InterconnectAttachment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -445,7 +455,7 @@ func (c *Client) GetInterconnectAttachment(ctx context.Context, r *InterconnectA
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalInterconnectAttachment(b, c, r)
 	if err != nil {
@@ -471,7 +481,8 @@ func (c *Client) GetInterconnectAttachment(ctx context.Context, r *InterconnectA
 
 func (c *Client) DeleteInterconnectAttachment(ctx context.Context, r *InterconnectAttachment) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InterconnectAttachment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InterconnectAttachment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -479,7 +490,13 @@ func (c *Client) DeleteInterconnectAttachment(ctx context.Context, r *Interconne
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting InterconnectAttachment...")
 	deleteOp := deleteInterconnectAttachmentOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteInterconnectAttachmentAsync(ctx context.Context, r *InterconnectAttachment) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteInterconnectAttachment(ctx, r)
+	})
 }
 
 // DeleteAllInterconnectAttachment deletes all resources that the filter functions returns true on.
@@ -507,10 +524,11 @@ func (c *Client) DeleteAllInterconnectAttachment(ctx context.Context, project, r
 }
 
 func (c *Client) ApplyInterconnectAttachment(ctx context.Context, rawDesired *InterconnectAttachment, opts ...dcl.ApplyOption) (*InterconnectAttachment, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InterconnectAttachment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InterconnectAttachment{}).Describe())
 	var resultNewState *InterconnectAttachment
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyInterconnectAttachmentHelper(c, ctx, rawDesired, opts...)
@@ -528,6 +546,38 @@ func (c *Client) ApplyInterconnectAttachment(ctx context.Context, rawDesired *In
 	return resultNewState, err
 }
 
+func (c *Client) ApplyInterconnectAttachmentAsync(ctx context.Context, rawDesired *InterconnectAttachment, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyInterconnectAttachment(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffInterconnectAttachment returns the field-level differences between rawDesired and the
+// live InterconnectAttachment without modifying it. If the InterconnectAttachment does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffInterconnectAttachment(ctx context.Context, rawDesired *InterconnectAttachment, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InterconnectAttachment{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InterconnectAttachment{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractInterconnectAttachmentFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.interconnectAttachmentDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("InterconnectAttachment %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyInterconnectAttachmentHelper(c *Client, ctx context.Context, rawDesired *InterconnectAttachment, opts ...dcl.ApplyOption) (*InterconnectAttachment, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyInterconnectAttachment...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -536,6 +586,9 @@ func applyInterconnectAttachmentHelper(c *Client, ctx context.Context, rawDesire
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractInterconnectAttachmentFields(rawDesired); err != nil {
 		return nil, err
@@ -594,7 +647,7 @@ func applyInterconnectAttachmentHelper(c *Client, ctx context.Context, rawDesire
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -158,8 +158,12 @@ func (l *NetworkList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *NetworkList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *NetworkList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Network{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -176,7 +180,8 @@ func (l *NetworkList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListNetwork(ctx context.Context, project string) (*NetworkList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Network{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Network{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListNetworkWithMaxResults(ctx, project, NetworkMaxPage)
@@ -184,14 +189,18 @@ func (c *Client) ListNetwork(ctx context.Context, project string) (*NetworkList,
 }
 
 func (c *Client) ListNetworkWithMaxResults(ctx context.Context, project string, pageSize int32) (*NetworkList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListNetworkWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListNetworkWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*NetworkList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Network{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Network{
 		Project: &project,
 	}
-	items, token, err := c.listNetwork(ctx, r, "", pageSize)
+	items, token, err := c.listNetwork(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +214,8 @@ func (c *Client) ListNetworkWithMaxResults(ctx context.Context, project string,
 
 func (c *Client) GetNetwork(ctx context.Context, r *Network) (*Network, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Network{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Network{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -221,7 +231,7 @@ func (c *Client) GetNetwork(ctx context.Context, r *Network) (*Network, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalNetwork(b, c, r)
 	if err != nil {
@@ -249,7 +259,8 @@ func (c *Client) GetNetwork(ctx context.Context, r *Network) (*Network, error) {
 
 func (c *Client) DeleteNetwork(ctx context.Context, r *Network) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Network{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Network{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -257,7 +268,13 @@ func (c *Client) DeleteNetwork(ctx context.Context, r *Network) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Network...")
 	deleteOp := deleteNetworkOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteNetworkAsync(ctx context.Context, r *Network) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteNetwork(ctx, r)
+	})
 }
 
 // DeleteAllNetwork deletes all resources that the filter functions returns true on.
@@ -285,10 +302,11 @@ func (c *Client) DeleteAllNetwork(ctx context.Context, project string, filter fu
 }
 
 func (c *Client) ApplyNetwork(ctx context.Context, rawDesired *Network, opts ...dcl.ApplyOption) (*Network, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Network{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Network{}).Describe())
 	var resultNewState *Network
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyNetworkHelper(c, ctx, rawDesired, opts...)
@@ -306,6 +324,38 @@ func (c *Client) ApplyNetwork(ctx context.Context, rawDesired *Network, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyNetworkAsync(ctx context.Context, rawDesired *Network, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyNetwork(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffNetwork returns the field-level differences between rawDesired and the
+// live Network without modifying it. If the Network does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffNetwork(ctx context.Context, rawDesired *Network, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Network{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Network{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractNetworkFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.networkDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Network %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyNetworkHelper(c *Client, ctx context.Context, rawDesired *Network, opts ...dcl.ApplyOption) (*Network, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyNetwork...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -314,6 +364,9 @@ func applyNetworkHelper(c *Client, ctx context.Context, rawDesired *Network, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractNetworkFields(rawDesired); err != nil {
 		return nil, err
@@ -372,7 +425,7 @@ func applyNetworkHelper(c *Client, ctx context.Context, rawDesired *Network, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
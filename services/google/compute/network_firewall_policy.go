@@ -91,8 +91,12 @@ func (l *NetworkFirewallPolicyList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *NetworkFirewallPolicyList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *NetworkFirewallPolicyList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -109,7 +113,8 @@ func (l *NetworkFirewallPolicyList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListNetworkFirewallPolicy(ctx context.Context, project, location string) (*NetworkFirewallPolicyList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListNetworkFirewallPolicyWithMaxResults(ctx, project, location, NetworkFirewallPolicyMaxPage)
@@ -117,7 +122,11 @@ func (c *Client) ListNetworkFirewallPolicy(ctx context.Context, project, locatio
 }
 
 func (c *Client) ListNetworkFirewallPolicyWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*NetworkFirewallPolicyList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListNetworkFirewallPolicyWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListNetworkFirewallPolicyWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*NetworkFirewallPolicyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -125,7 +134,7 @@ func (c *Client) ListNetworkFirewallPolicyWithMaxResults(ctx context.Context, pr
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listNetworkFirewallPolicy(ctx, r, "", pageSize)
+	items, token, err := c.listNetworkFirewallPolicy(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +148,8 @@ func (c *Client) ListNetworkFirewallPolicyWithMaxResults(ctx context.Context, pr
 
 func (c *Client) GetNetworkFirewallPolicy(ctx context.Context, r *NetworkFirewallPolicy) (*NetworkFirewallPolicy, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -155,7 +165,7 @@ func (c *Client) GetNetworkFirewallPolicy(ctx context.Context, r *NetworkFirewal
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalNetworkFirewallPolicy(b, c, r)
 	if err != nil {
@@ -181,7 +191,8 @@ func (c *Client) GetNetworkFirewallPolicy(ctx context.Context, r *NetworkFirewal
 
 func (c *Client) DeleteNetworkFirewallPolicy(ctx context.Context, r *NetworkFirewallPolicy) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -189,7 +200,13 @@ func (c *Client) DeleteNetworkFirewallPolicy(ctx context.Context, r *NetworkFire
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting NetworkFirewallPolicy...")
 	deleteOp := deleteNetworkFirewallPolicyOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteNetworkFirewallPolicyAsync(ctx context.Context, r *NetworkFirewallPolicy) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteNetworkFirewallPolicy(ctx, r)
+	})
 }
 
 // DeleteAllNetworkFirewallPolicy deletes all resources that the filter functions returns true on.
@@ -217,10 +234,11 @@ func (c *Client) DeleteAllNetworkFirewallPolicy(ctx context.Context, project, lo
 }
 
 func (c *Client) ApplyNetworkFirewallPolicy(ctx context.Context, rawDesired *NetworkFirewallPolicy, opts ...dcl.ApplyOption) (*NetworkFirewallPolicy, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicy{}).Describe())
 	var resultNewState *NetworkFirewallPolicy
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyNetworkFirewallPolicyHelper(c, ctx, rawDesired, opts...)
@@ -238,6 +256,38 @@ func (c *Client) ApplyNetworkFirewallPolicy(ctx context.Context, rawDesired *Net
 	return resultNewState, err
 }
 
+func (c *Client) ApplyNetworkFirewallPolicyAsync(ctx context.Context, rawDesired *NetworkFirewallPolicy, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyNetworkFirewallPolicy(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffNetworkFirewallPolicy returns the field-level differences between rawDesired and the
+// live NetworkFirewallPolicy without modifying it. If the NetworkFirewallPolicy does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffNetworkFirewallPolicy(ctx context.Context, rawDesired *NetworkFirewallPolicy, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicy{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicy{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractNetworkFirewallPolicyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.networkFirewallPolicyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("NetworkFirewallPolicy %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyNetworkFirewallPolicyHelper(c *Client, ctx context.Context, rawDesired *NetworkFirewallPolicy, opts ...dcl.ApplyOption) (*NetworkFirewallPolicy, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyNetworkFirewallPolicy...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -246,6 +296,9 @@ func applyNetworkFirewallPolicyHelper(c *Client, ctx context.Context, rawDesired
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractNetworkFirewallPolicyFields(rawDesired); err != nil {
 		return nil, err
@@ -304,7 +357,7 @@ func applyNetworkFirewallPolicyHelper(c *Client, ctx context.Context, rawDesired
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -402,7 +402,7 @@ func (c *Client) networkFirewallPolicyDiffsForRawDesired(ctx context.Context, ra
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a NetworkFirewallPolicy resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve NetworkFirewallPolicy resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve NetworkFirewallPolicy resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that NetworkFirewallPolicy resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -416,6 +416,12 @@ func (c *Client) networkFirewallPolicyDiffsForRawDesired(ctx context.Context, ra
 	if err := extractNetworkFirewallPolicyFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeNetworkFirewallPolicyInitialState(rawInitial, rawDesired)
@@ -433,7 +439,7 @@ func (c *Client) networkFirewallPolicyDiffsForRawDesired(ctx context.Context, ra
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffNetworkFirewallPolicy(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeNetworkFirewallPolicyInitialState(rawInitial, rawDesired *NetworkFirewallPolicy) (*NetworkFirewallPolicy, error) {
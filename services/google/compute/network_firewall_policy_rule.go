@@ -384,8 +384,12 @@ func (l *NetworkFirewallPolicyRuleList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *NetworkFirewallPolicyRuleList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *NetworkFirewallPolicyRuleList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -402,7 +406,8 @@ func (l *NetworkFirewallPolicyRuleList) Next(ctx context.Context, c *Client) err
 
 func (c *Client) ListNetworkFirewallPolicyRule(ctx context.Context, project, location, firewallPolicy string) (*NetworkFirewallPolicyRuleList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicyRule{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListNetworkFirewallPolicyRuleWithMaxResults(ctx, project, location, firewallPolicy, NetworkFirewallPolicyRuleMaxPage)
@@ -410,7 +415,11 @@ func (c *Client) ListNetworkFirewallPolicyRule(ctx context.Context, project, loc
 }
 
 func (c *Client) ListNetworkFirewallPolicyRuleWithMaxResults(ctx context.Context, project, location, firewallPolicy string, pageSize int32) (*NetworkFirewallPolicyRuleList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListNetworkFirewallPolicyRuleWithPageToken(ctx, project, location, firewallPolicy, pageSize, "")
+}
+
+func (c *Client) ListNetworkFirewallPolicyRuleWithPageToken(ctx context.Context, project, location, firewallPolicy string, pageSize int32, pageToken string) (*NetworkFirewallPolicyRuleList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -419,7 +428,7 @@ func (c *Client) ListNetworkFirewallPolicyRuleWithMaxResults(ctx context.Context
 		Location:       &location,
 		FirewallPolicy: &firewallPolicy,
 	}
-	items, token, err := c.listNetworkFirewallPolicyRule(ctx, r, "", pageSize)
+	items, token, err := c.listNetworkFirewallPolicyRule(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -433,7 +442,8 @@ func (c *Client) ListNetworkFirewallPolicyRuleWithMaxResults(ctx context.Context
 
 func (c *Client) GetNetworkFirewallPolicyRule(ctx context.Context, r *NetworkFirewallPolicyRule) (*NetworkFirewallPolicyRule, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicyRule{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -449,7 +459,7 @@ func (c *Client) GetNetworkFirewallPolicyRule(ctx context.Context, r *NetworkFir
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalNetworkFirewallPolicyRule(b, c, r)
 	if err != nil {
@@ -476,7 +486,8 @@ func (c *Client) GetNetworkFirewallPolicyRule(ctx context.Context, r *NetworkFir
 
 func (c *Client) DeleteNetworkFirewallPolicyRule(ctx context.Context, r *NetworkFirewallPolicyRule) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicyRule{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -484,7 +495,13 @@ func (c *Client) DeleteNetworkFirewallPolicyRule(ctx context.Context, r *Network
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting NetworkFirewallPolicyRule...")
 	deleteOp := deleteNetworkFirewallPolicyRuleOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteNetworkFirewallPolicyRuleAsync(ctx context.Context, r *NetworkFirewallPolicyRule) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteNetworkFirewallPolicyRule(ctx, r)
+	})
 }
 
 // DeleteAllNetworkFirewallPolicyRule deletes all resources that the filter functions returns true on.
@@ -512,10 +529,11 @@ func (c *Client) DeleteAllNetworkFirewallPolicyRule(ctx context.Context, project
 }
 
 func (c *Client) ApplyNetworkFirewallPolicyRule(ctx context.Context, rawDesired *NetworkFirewallPolicyRule, opts ...dcl.ApplyOption) (*NetworkFirewallPolicyRule, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyRule{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicyRule{}).Describe())
 	var resultNewState *NetworkFirewallPolicyRule
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyNetworkFirewallPolicyRuleHelper(c, ctx, rawDesired, opts...)
@@ -533,6 +551,38 @@ func (c *Client) ApplyNetworkFirewallPolicyRule(ctx context.Context, rawDesired
 	return resultNewState, err
 }
 
+func (c *Client) ApplyNetworkFirewallPolicyRuleAsync(ctx context.Context, rawDesired *NetworkFirewallPolicyRule, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyNetworkFirewallPolicyRule(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffNetworkFirewallPolicyRule returns the field-level differences between rawDesired and the
+// live NetworkFirewallPolicyRule without modifying it. If the NetworkFirewallPolicyRule does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffNetworkFirewallPolicyRule(ctx context.Context, rawDesired *NetworkFirewallPolicyRule, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NetworkFirewallPolicyRule{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NetworkFirewallPolicyRule{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractNetworkFirewallPolicyRuleFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.networkFirewallPolicyRuleDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("NetworkFirewallPolicyRule %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyNetworkFirewallPolicyRuleHelper(c *Client, ctx context.Context, rawDesired *NetworkFirewallPolicyRule, opts ...dcl.ApplyOption) (*NetworkFirewallPolicyRule, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyNetworkFirewallPolicyRule...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -541,6 +591,9 @@ func applyNetworkFirewallPolicyRuleHelper(c *Client, ctx context.Context, rawDes
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractNetworkFirewallPolicyRuleFields(rawDesired); err != nil {
 		return nil, err
@@ -599,7 +652,7 @@ func applyNetworkFirewallPolicyRuleHelper(c *Client, ctx context.Context, rawDes
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
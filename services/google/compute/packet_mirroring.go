@@ -447,8 +447,12 @@ func (l *PacketMirroringList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *PacketMirroringList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *PacketMirroringList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PacketMirroring{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -465,7 +469,8 @@ func (l *PacketMirroringList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListPacketMirroring(ctx context.Context, project, location string) (*PacketMirroringList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&PacketMirroring{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PacketMirroring{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListPacketMirroringWithMaxResults(ctx, project, location, PacketMirroringMaxPage)
@@ -473,7 +478,11 @@ func (c *Client) ListPacketMirroring(ctx context.Context, project, location stri
 }
 
 func (c *Client) ListPacketMirroringWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*PacketMirroringList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListPacketMirroringWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListPacketMirroringWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*PacketMirroringList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PacketMirroring{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -481,7 +490,7 @@ func (c *Client) ListPacketMirroringWithMaxResults(ctx context.Context, project,
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listPacketMirroring(ctx, r, "", pageSize)
+	items, token, err := c.listPacketMirroring(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -495,7 +504,8 @@ func (c *Client) ListPacketMirroringWithMaxResults(ctx context.Context, project,
 
 func (c *Client) GetPacketMirroring(ctx context.Context, r *PacketMirroring) (*PacketMirroring, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&PacketMirroring{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PacketMirroring{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -511,7 +521,7 @@ func (c *Client) GetPacketMirroring(ctx context.Context, r *PacketMirroring) (*P
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalPacketMirroring(b, c, r)
 	if err != nil {
@@ -537,7 +547,8 @@ func (c *Client) GetPacketMirroring(ctx context.Context, r *PacketMirroring) (*P
 
 func (c *Client) DeletePacketMirroring(ctx context.Context, r *PacketMirroring) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&PacketMirroring{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PacketMirroring{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -545,7 +556,13 @@ func (c *Client) DeletePacketMirroring(ctx context.Context, r *PacketMirroring)
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting PacketMirroring...")
 	deleteOp := deletePacketMirroringOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeletePacketMirroringAsync(ctx context.Context, r *PacketMirroring) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeletePacketMirroring(ctx, r)
+	})
 }
 
 // DeleteAllPacketMirroring deletes all resources that the filter functions returns true on.
@@ -573,10 +590,11 @@ func (c *Client) DeleteAllPacketMirroring(ctx context.Context, project, location
 }
 
 func (c *Client) ApplyPacketMirroring(ctx context.Context, rawDesired *PacketMirroring, opts ...dcl.ApplyOption) (*PacketMirroring, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PacketMirroring{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&PacketMirroring{}).Describe())
 	var resultNewState *PacketMirroring
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyPacketMirroringHelper(c, ctx, rawDesired, opts...)
@@ -594,6 +612,38 @@ func (c *Client) ApplyPacketMirroring(ctx context.Context, rawDesired *PacketMir
 	return resultNewState, err
 }
 
+func (c *Client) ApplyPacketMirroringAsync(ctx context.Context, rawDesired *PacketMirroring, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyPacketMirroring(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffPacketMirroring returns the field-level differences between rawDesired and the
+// live PacketMirroring without modifying it. If the PacketMirroring does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffPacketMirroring(ctx context.Context, rawDesired *PacketMirroring, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PacketMirroring{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&PacketMirroring{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractPacketMirroringFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.packetMirroringDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("PacketMirroring %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyPacketMirroringHelper(c *Client, ctx context.Context, rawDesired *PacketMirroring, opts ...dcl.ApplyOption) (*PacketMirroring, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyPacketMirroring...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -602,6 +652,9 @@ func applyPacketMirroringHelper(c *Client, ctx context.Context, rawDesired *Pack
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractPacketMirroringFields(rawDesired); err != nil {
 		return nil, err
@@ -660,7 +713,7 @@ func applyPacketMirroringHelper(c *Client, ctx context.Context, rawDesired *Pack
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
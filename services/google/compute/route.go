@@ -180,8 +180,12 @@ func (l *RouteList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *RouteList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *RouteList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Route{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -198,7 +202,8 @@ func (l *RouteList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListRoute(ctx context.Context, project string) (*RouteList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Route{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Route{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListRouteWithMaxResults(ctx, project, RouteMaxPage)
@@ -206,14 +211,18 @@ func (c *Client) ListRoute(ctx context.Context, project string) (*RouteList, err
 }
 
 func (c *Client) ListRouteWithMaxResults(ctx context.Context, project string, pageSize int32) (*RouteList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListRouteWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListRouteWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*RouteList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Route{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Route{
 		Project: &project,
 	}
-	items, token, err := c.listRoute(ctx, r, "", pageSize)
+	items, token, err := c.listRoute(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -227,7 +236,8 @@ func (c *Client) ListRouteWithMaxResults(ctx context.Context, project string, pa
 
 func (c *Client) GetRoute(ctx context.Context, r *Route) (*Route, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Route{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Route{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -243,7 +253,7 @@ func (c *Client) GetRoute(ctx context.Context, r *Route) (*Route, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalRoute(b, c, r)
 	if err != nil {
@@ -271,7 +281,8 @@ func (c *Client) GetRoute(ctx context.Context, r *Route) (*Route, error) {
 
 func (c *Client) DeleteRoute(ctx context.Context, r *Route) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Route{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Route{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -279,7 +290,13 @@ func (c *Client) DeleteRoute(ctx context.Context, r *Route) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Route...")
 	deleteOp := deleteRouteOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteRouteAsync(ctx context.Context, r *Route) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteRoute(ctx, r)
+	})
 }
 
 // DeleteAllRoute deletes all resources that the filter functions returns true on.
@@ -307,10 +324,11 @@ func (c *Client) DeleteAllRoute(ctx context.Context, project string, filter func
 }
 
 func (c *Client) ApplyRoute(ctx context.Context, rawDesired *Route, opts ...dcl.ApplyOption) (*Route, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Route{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Route{}).Describe())
 	var resultNewState *Route
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyRouteHelper(c, ctx, rawDesired, opts...)
@@ -328,6 +346,38 @@ func (c *Client) ApplyRoute(ctx context.Context, rawDesired *Route, opts ...dcl.
 	return resultNewState, err
 }
 
+func (c *Client) ApplyRouteAsync(ctx context.Context, rawDesired *Route, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyRoute(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffRoute returns the field-level differences between rawDesired and the
+// live Route without modifying it. If the Route does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffRoute(ctx context.Context, rawDesired *Route, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Route{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Route{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractRouteFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.routeDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Route %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyRouteHelper(c *Client, ctx context.Context, rawDesired *Route, opts ...dcl.ApplyOption) (*Route, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyRoute...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -336,6 +386,9 @@ func applyRouteHelper(c *Client, ctx context.Context, rawDesired *Route, opts ..
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractRouteFields(rawDesired); err != nil {
 		return nil, err
@@ -394,7 +447,7 @@ func applyRouteHelper(c *Client, ctx context.Context, rawDesired *Route, opts ..
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
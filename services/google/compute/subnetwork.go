@@ -308,8 +308,12 @@ func (l *SubnetworkList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *SubnetworkList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *SubnetworkList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Subnetwork{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -326,6 +330,7 @@ func (l *SubnetworkList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListSubnetwork(ctx context.Context, project, region string) (*SubnetworkList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Subnetwork{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		412: dcl.Retryability{
 			Retryable: false,
@@ -333,7 +338,7 @@ func (c *Client) ListSubnetwork(ctx context.Context, project, region string) (*S
 			Timeout:   0,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Subnetwork{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListSubnetworkWithMaxResults(ctx, project, region, SubnetworkMaxPage)
@@ -341,7 +346,11 @@ func (c *Client) ListSubnetwork(ctx context.Context, project, region string) (*S
 }
 
 func (c *Client) ListSubnetworkWithMaxResults(ctx context.Context, project, region string, pageSize int32) (*SubnetworkList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListSubnetworkWithPageToken(ctx, project, region, pageSize, "")
+}
+
+func (c *Client) ListSubnetworkWithPageToken(ctx context.Context, project, region string, pageSize int32, pageToken string) (*SubnetworkList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Subnetwork{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -349,7 +358,7 @@ func (c *Client) ListSubnetworkWithMaxResults(ctx context.Context, project, regi
 		Project: &project,
 		Region:  &region,
 	}
-	items, token, err := c.listSubnetwork(ctx, r, "", pageSize)
+	items, token, err := c.listSubnetwork(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -363,6 +372,7 @@ func (c *Client) ListSubnetworkWithMaxResults(ctx context.Context, project, regi
 
 func (c *Client) GetSubnetwork(ctx context.Context, r *Subnetwork) (*Subnetwork, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Subnetwork{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		412: dcl.Retryability{
 			Retryable: false,
@@ -370,7 +380,7 @@ func (c *Client) GetSubnetwork(ctx context.Context, r *Subnetwork) (*Subnetwork,
 			Timeout:   0,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Subnetwork{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -386,7 +396,7 @@ func (c *Client) GetSubnetwork(ctx context.Context, r *Subnetwork) (*Subnetwork,
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalSubnetwork(b, c, r)
 	if err != nil {
@@ -412,6 +422,7 @@ func (c *Client) GetSubnetwork(ctx context.Context, r *Subnetwork) (*Subnetwork,
 
 func (c *Client) DeleteSubnetwork(ctx context.Context, r *Subnetwork) error {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Subnetwork{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		412: dcl.Retryability{
 			Retryable: false,
@@ -419,7 +430,7 @@ func (c *Client) DeleteSubnetwork(ctx context.Context, r *Subnetwork) error {
 			Timeout:   0,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Subnetwork{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -427,7 +438,13 @@ func (c *Client) DeleteSubnetwork(ctx context.Context, r *Subnetwork) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Subnetwork...")
 	deleteOp := deleteSubnetworkOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteSubnetworkAsync(ctx context.Context, r *Subnetwork) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteSubnetwork(ctx, r)
+	})
 }
 
 // DeleteAllSubnetwork deletes all resources that the filter functions returns true on.
@@ -455,10 +472,11 @@ func (c *Client) DeleteAllSubnetwork(ctx context.Context, project, region string
 }
 
 func (c *Client) ApplySubnetwork(ctx context.Context, rawDesired *Subnetwork, opts ...dcl.ApplyOption) (*Subnetwork, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Subnetwork{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Subnetwork{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		412: dcl.Retryability{
 			Retryable: false,
@@ -483,6 +501,38 @@ func (c *Client) ApplySubnetwork(ctx context.Context, rawDesired *Subnetwork, op
 	return resultNewState, err
 }
 
+func (c *Client) ApplySubnetworkAsync(ctx context.Context, rawDesired *Subnetwork, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplySubnetwork(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffSubnetwork returns the field-level differences between rawDesired and the
+// live Subnetwork without modifying it. If the Subnetwork does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffSubnetwork(ctx context.Context, rawDesired *Subnetwork, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Subnetwork{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Subnetwork{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractSubnetworkFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.subnetworkDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Subnetwork %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applySubnetworkHelper(c *Client, ctx context.Context, rawDesired *Subnetwork, opts ...dcl.ApplyOption) (*Subnetwork, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplySubnetwork...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -491,6 +541,9 @@ func applySubnetworkHelper(c *Client, ctx context.Context, rawDesired *Subnetwor
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractSubnetworkFields(rawDesired); err != nil {
 		return nil, err
@@ -549,7 +602,7 @@ func applySubnetworkHelper(c *Client, ctx context.Context, rawDesired *Subnetwor
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
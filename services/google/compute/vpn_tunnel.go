@@ -134,8 +134,12 @@ func (l *VpnTunnelList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *VpnTunnelList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *VpnTunnelList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -152,7 +156,8 @@ func (l *VpnTunnelList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListVpnTunnel(ctx context.Context, project, location string) (*VpnTunnelList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VpnTunnel{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListVpnTunnelWithMaxResults(ctx, project, location, VpnTunnelMaxPage)
@@ -160,7 +165,11 @@ func (c *Client) ListVpnTunnel(ctx context.Context, project, location string) (*
 }
 
 func (c *Client) ListVpnTunnelWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*VpnTunnelList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListVpnTunnelWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListVpnTunnelWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*VpnTunnelList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -168,7 +177,7 @@ func (c *Client) ListVpnTunnelWithMaxResults(ctx context.Context, project, locat
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listVpnTunnel(ctx, r, "", pageSize)
+	items, token, err := c.listVpnTunnel(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +191,8 @@ func (c *Client) ListVpnTunnelWithMaxResults(ctx context.Context, project, locat
 
 func (c *Client) GetVpnTunnel(ctx context.Context, r *VpnTunnel) (*VpnTunnel, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VpnTunnel{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -198,7 +208,7 @@ func (c *Client) GetVpnTunnel(ctx context.Context, r *VpnTunnel) (*VpnTunnel, er
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalVpnTunnel(b, c, r)
 	if err != nil {
@@ -227,7 +237,8 @@ func (c *Client) GetVpnTunnel(ctx context.Context, r *VpnTunnel) (*VpnTunnel, er
 
 func (c *Client) DeleteVpnTunnel(ctx context.Context, r *VpnTunnel) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VpnTunnel{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -235,7 +246,13 @@ func (c *Client) DeleteVpnTunnel(ctx context.Context, r *VpnTunnel) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting VpnTunnel...")
 	deleteOp := deleteVpnTunnelOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteVpnTunnelAsync(ctx context.Context, r *VpnTunnel) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteVpnTunnel(ctx, r)
+	})
 }
 
 // DeleteAllVpnTunnel deletes all resources that the filter functions returns true on.
@@ -263,10 +280,11 @@ func (c *Client) DeleteAllVpnTunnel(ctx context.Context, project, location strin
 }
 
 func (c *Client) ApplyVpnTunnel(ctx context.Context, rawDesired *VpnTunnel, opts ...dcl.ApplyOption) (*VpnTunnel, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VpnTunnel{}).Describe())
 	var resultNewState *VpnTunnel
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyVpnTunnelHelper(c, ctx, rawDesired, opts...)
@@ -284,6 +302,38 @@ func (c *Client) ApplyVpnTunnel(ctx context.Context, rawDesired *VpnTunnel, opts
 	return resultNewState, err
 }
 
+func (c *Client) ApplyVpnTunnelAsync(ctx context.Context, rawDesired *VpnTunnel, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyVpnTunnel(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffVpnTunnel returns the field-level differences between rawDesired and the
+// live VpnTunnel without modifying it. If the VpnTunnel does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffVpnTunnel(ctx context.Context, rawDesired *VpnTunnel, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VpnTunnel{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VpnTunnel{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractVpnTunnelFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.vpnTunnelDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("VpnTunnel %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyVpnTunnelHelper(c *Client, ctx context.Context, rawDesired *VpnTunnel, opts ...dcl.ApplyOption) (*VpnTunnel, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyVpnTunnel...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -292,6 +342,9 @@ func applyVpnTunnelHelper(c *Client, ctx context.Context, rawDesired *VpnTunnel,
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractVpnTunnelFields(rawDesired); err != nil {
 		return nil, err
@@ -350,7 +403,7 @@ func applyVpnTunnelHelper(c *Client, ctx context.Context, rawDesired *VpnTunnel,
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -300,7 +300,7 @@ func (c *Client) vpnTunnelDiffsForRawDesired(ctx context.Context, rawDesired *Vp
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a VpnTunnel resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve VpnTunnel resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve VpnTunnel resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that VpnTunnel resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -314,6 +314,12 @@ func (c *Client) vpnTunnelDiffsForRawDesired(ctx context.Context, rawDesired *Vp
 	if err := extractVpnTunnelFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeVpnTunnelInitialState(rawInitial, rawDesired)
@@ -331,7 +337,7 @@ func (c *Client) vpnTunnelDiffsForRawDesired(ctx context.Context, rawDesired *Vp
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffVpnTunnel(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeVpnTunnelInitialState(rawInitial, rawDesired *VpnTunnel) (*VpnTunnel, error) {
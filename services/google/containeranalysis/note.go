@@ -1546,8 +1546,12 @@ func (l *NoteList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *NoteList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *NoteList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Note{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -1564,7 +1568,8 @@ func (l *NoteList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListNote(ctx context.Context, project string) (*NoteList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Note{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Note{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListNoteWithMaxResults(ctx, project, NoteMaxPage)
@@ -1572,14 +1577,18 @@ func (c *Client) ListNote(ctx context.Context, project string) (*NoteList, error
 }
 
 func (c *Client) ListNoteWithMaxResults(ctx context.Context, project string, pageSize int32) (*NoteList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListNoteWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListNoteWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*NoteList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Note{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Note{
 		Project: &project,
 	}
-	items, token, err := c.listNote(ctx, r, "", pageSize)
+	items, token, err := c.listNote(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -1593,7 +1602,8 @@ func (c *Client) ListNoteWithMaxResults(ctx context.Context, project string, pag
 
 func (c *Client) GetNote(ctx context.Context, r *Note) (*Note, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Note{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Note{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -1609,7 +1619,7 @@ func (c *Client) GetNote(ctx context.Context, r *Note) (*Note, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalNote(b, c, r)
 	if err != nil {
@@ -1634,7 +1644,8 @@ func (c *Client) GetNote(ctx context.Context, r *Note) (*Note, error) {
 
 func (c *Client) DeleteNote(ctx context.Context, r *Note) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Note{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Note{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -1642,7 +1653,13 @@ func (c *Client) DeleteNote(ctx context.Context, r *Note) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Note...")
 	deleteOp := deleteNoteOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteNoteAsync(ctx context.Context, r *Note) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteNote(ctx, r)
+	})
 }
 
 // DeleteAllNote deletes all resources that the filter functions returns true on.
@@ -1670,10 +1687,11 @@ func (c *Client) DeleteAllNote(ctx context.Context, project string, filter func(
 }
 
 func (c *Client) ApplyNote(ctx context.Context, rawDesired *Note, opts ...dcl.ApplyOption) (*Note, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Note{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Note{}).Describe())
 	var resultNewState *Note
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyNoteHelper(c, ctx, rawDesired, opts...)
@@ -1691,6 +1709,38 @@ func (c *Client) ApplyNote(ctx context.Context, rawDesired *Note, opts ...dcl.Ap
 	return resultNewState, err
 }
 
+func (c *Client) ApplyNoteAsync(ctx context.Context, rawDesired *Note, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyNote(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffNote returns the field-level differences between rawDesired and the
+// live Note without modifying it. If the Note does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffNote(ctx context.Context, rawDesired *Note, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Note{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Note{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractNoteFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.noteDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Note %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyNoteHelper(c *Client, ctx context.Context, rawDesired *Note, opts ...dcl.ApplyOption) (*Note, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyNote...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -1699,6 +1749,9 @@ func applyNoteHelper(c *Client, ctx context.Context, rawDesired *Note, opts ...d
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractNoteFields(rawDesired); err != nil {
 		return nil, err
@@ -1757,7 +1810,7 @@ func applyNoteHelper(c *Client, ctx context.Context, rawDesired *Note, opts ...d
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
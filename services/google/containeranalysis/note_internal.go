@@ -576,7 +576,7 @@ func (c *Client) noteDiffsForRawDesired(ctx context.Context, rawDesired *Note, o
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Note resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Note resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Note resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Note resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -590,6 +590,12 @@ func (c *Client) noteDiffsForRawDesired(ctx context.Context, rawDesired *Note, o
 	if err := extractNoteFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeNoteInitialState(rawInitial, rawDesired)
@@ -607,7 +613,7 @@ func (c *Client) noteDiffsForRawDesired(ctx context.Context, rawDesired *Note, o
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffNote(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeNoteInitialState(rawInitial, rawDesired *Note) (*Note, error) {
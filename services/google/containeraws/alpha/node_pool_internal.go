@@ -547,7 +547,7 @@ func (c *Client) nodePoolDiffsForRawDesired(ctx context.Context, rawDesired *Nod
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a NodePool resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve NodePool resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve NodePool resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that NodePool resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -561,6 +561,12 @@ func (c *Client) nodePoolDiffsForRawDesired(ctx context.Context, rawDesired *Nod
 	if err := extractNodePoolFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeNodePoolInitialState(rawInitial, rawDesired)
@@ -578,7 +584,7 @@ func (c *Client) nodePoolDiffsForRawDesired(ctx context.Context, rawDesired *Nod
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffNodePool(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeNodePoolInitialState(rawInitial, rawDesired *NodePool) (*NodePool, error) {
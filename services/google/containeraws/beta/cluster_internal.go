@@ -628,7 +628,7 @@ func (c *Client) clusterDiffsForRawDesired(ctx context.Context, rawDesired *Clus
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Cluster resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Cluster resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Cluster resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Cluster resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -642,6 +642,12 @@ func (c *Client) clusterDiffsForRawDesired(ctx context.Context, rawDesired *Clus
 	if err := extractClusterFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeClusterInitialState(rawInitial, rawDesired)
@@ -659,7 +665,7 @@ func (c *Client) clusterDiffsForRawDesired(ctx context.Context, rawDesired *Clus
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffCluster(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeClusterInitialState(rawInitial, rawDesired *Cluster) (*Cluster, error) {
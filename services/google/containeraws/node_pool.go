@@ -648,8 +648,12 @@ func (l *NodePoolList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *NodePoolList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *NodePoolList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NodePool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -666,7 +670,8 @@ func (l *NodePoolList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListNodePool(ctx context.Context, project, location, cluster string) (*NodePoolList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NodePool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NodePool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListNodePoolWithMaxResults(ctx, project, location, cluster, NodePoolMaxPage)
@@ -674,7 +679,11 @@ func (c *Client) ListNodePool(ctx context.Context, project, location, cluster st
 }
 
 func (c *Client) ListNodePoolWithMaxResults(ctx context.Context, project, location, cluster string, pageSize int32) (*NodePoolList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListNodePoolWithPageToken(ctx, project, location, cluster, pageSize, "")
+}
+
+func (c *Client) ListNodePoolWithPageToken(ctx context.Context, project, location, cluster string, pageSize int32, pageToken string) (*NodePoolList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NodePool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -683,7 +692,7 @@ func (c *Client) ListNodePoolWithMaxResults(ctx context.Context, project, locati
 		Location: &location,
 		Cluster:  &cluster,
 	}
-	items, token, err := c.listNodePool(ctx, r, "", pageSize)
+	items, token, err := c.listNodePool(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -697,7 +706,8 @@ func (c *Client) ListNodePoolWithMaxResults(ctx context.Context, project, locati
 
 func (c *Client) GetNodePool(ctx context.Context, r *NodePool) (*NodePool, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NodePool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NodePool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -713,7 +723,7 @@ func (c *Client) GetNodePool(ctx context.Context, r *NodePool) (*NodePool, error
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalNodePool(b, c, r)
 	if err != nil {
@@ -740,7 +750,8 @@ func (c *Client) GetNodePool(ctx context.Context, r *NodePool) (*NodePool, error
 
 func (c *Client) DeleteNodePool(ctx context.Context, r *NodePool) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NodePool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NodePool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -748,7 +759,13 @@ func (c *Client) DeleteNodePool(ctx context.Context, r *NodePool) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting NodePool...")
 	deleteOp := deleteNodePoolOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteNodePoolAsync(ctx context.Context, r *NodePool) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteNodePool(ctx, r)
+	})
 }
 
 // DeleteAllNodePool deletes all resources that the filter functions returns true on.
@@ -776,10 +793,11 @@ func (c *Client) DeleteAllNodePool(ctx context.Context, project, location, clust
 }
 
 func (c *Client) ApplyNodePool(ctx context.Context, rawDesired *NodePool, opts ...dcl.ApplyOption) (*NodePool, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NodePool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NodePool{}).Describe())
 	var resultNewState *NodePool
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyNodePoolHelper(c, ctx, rawDesired, opts...)
@@ -797,6 +815,38 @@ func (c *Client) ApplyNodePool(ctx context.Context, rawDesired *NodePool, opts .
 	return resultNewState, err
 }
 
+func (c *Client) ApplyNodePoolAsync(ctx context.Context, rawDesired *NodePool, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyNodePool(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffNodePool returns the field-level differences between rawDesired and the
+// live NodePool without modifying it. If the NodePool does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffNodePool(ctx context.Context, rawDesired *NodePool, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NodePool{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NodePool{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractNodePoolFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.nodePoolDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("NodePool %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyNodePoolHelper(c *Client, ctx context.Context, rawDesired *NodePool, opts ...dcl.ApplyOption) (*NodePool, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyNodePool...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -805,6 +855,9 @@ func applyNodePoolHelper(c *Client, ctx context.Context, rawDesired *NodePool, o
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractNodePoolFields(rawDesired); err != nil {
 		return nil, err
@@ -863,7 +916,7 @@ func applyNodePoolHelper(c *Client, ctx context.Context, rawDesired *NodePool, o
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
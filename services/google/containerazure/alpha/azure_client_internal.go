@@ -309,7 +309,7 @@ func (c *Client) clientDiffsForRawDesired(ctx context.Context, rawDesired *Azure
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Client resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Client resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Client resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Client resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -323,6 +323,12 @@ func (c *Client) clientDiffsForRawDesired(ctx context.Context, rawDesired *Azure
 	if err := extractClientFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeClientInitialState(rawInitial, rawDesired)
@@ -340,7 +346,7 @@ func (c *Client) clientDiffsForRawDesired(ctx context.Context, rawDesired *Azure
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffClient(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeClientInitialState(rawInitial, rawDesired *AzureClient) (*AzureClient, error) {
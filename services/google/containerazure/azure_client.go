@@ -81,8 +81,12 @@ func (l *ClientList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ClientList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ClientList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AzureClient{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -99,7 +103,8 @@ func (l *ClientList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListClient(ctx context.Context, project, location string) (*ClientList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AzureClient{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AzureClient{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListClientWithMaxResults(ctx, project, location, ClientMaxPage)
@@ -107,7 +112,11 @@ func (c *Client) ListClient(ctx context.Context, project, location string) (*Cli
 }
 
 func (c *Client) ListClientWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*ClientList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListClientWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListClientWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*ClientList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AzureClient{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -115,7 +124,7 @@ func (c *Client) ListClientWithMaxResults(ctx context.Context, project, location
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listClient(ctx, r, "", pageSize)
+	items, token, err := c.listClient(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +138,8 @@ func (c *Client) ListClientWithMaxResults(ctx context.Context, project, location
 
 func (c *Client) GetClient(ctx context.Context, r *AzureClient) (*AzureClient, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AzureClient{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AzureClient{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -145,7 +155,7 @@ func (c *Client) GetClient(ctx context.Context, r *AzureClient) (*AzureClient, e
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalClient(b, c, r)
 	if err != nil {
@@ -171,7 +181,8 @@ func (c *Client) GetClient(ctx context.Context, r *AzureClient) (*AzureClient, e
 
 func (c *Client) DeleteClient(ctx context.Context, r *AzureClient) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AzureClient{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AzureClient{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -179,7 +190,13 @@ func (c *Client) DeleteClient(ctx context.Context, r *AzureClient) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Client...")
 	deleteOp := deleteClientOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteClientAsync(ctx context.Context, r *AzureClient) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteClient(ctx, r)
+	})
 }
 
 // DeleteAllClient deletes all resources that the filter functions returns true on.
@@ -207,10 +224,11 @@ func (c *Client) DeleteAllClient(ctx context.Context, project, location string,
 }
 
 func (c *Client) ApplyClient(ctx context.Context, rawDesired *AzureClient, opts ...dcl.ApplyOption) (*AzureClient, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AzureClient{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AzureClient{}).Describe())
 	var resultNewState *AzureClient
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyClientHelper(c, ctx, rawDesired, opts...)
@@ -228,6 +246,38 @@ func (c *Client) ApplyClient(ctx context.Context, rawDesired *AzureClient, opts
 	return resultNewState, err
 }
 
+func (c *Client) ApplyClientAsync(ctx context.Context, rawDesired *AzureClient, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyClient(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffClient returns the field-level differences between rawDesired and the
+// live AzureClient without modifying it. If the AzureClient does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffClient(ctx context.Context, rawDesired *AzureClient, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AzureClient{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AzureClient{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractClientFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.clientDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("AzureClient %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyClientHelper(c *Client, ctx context.Context, rawDesired *AzureClient, opts ...dcl.ApplyOption) (*AzureClient, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyClient...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -236,6 +286,9 @@ func applyClientHelper(c *Client, ctx context.Context, rawDesired *AzureClient,
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractClientFields(rawDesired); err != nil {
 		return nil, err
@@ -294,7 +347,7 @@ func applyClientHelper(c *Client, ctx context.Context, rawDesired *AzureClient,
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -734,8 +734,12 @@ func (l *ClusterList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ClusterList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ClusterList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Cluster{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -752,7 +756,8 @@ func (l *ClusterList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListCluster(ctx context.Context, project, location string) (*ClusterList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Cluster{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Cluster{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListClusterWithMaxResults(ctx, project, location, ClusterMaxPage)
@@ -760,7 +765,11 @@ func (c *Client) ListCluster(ctx context.Context, project, location string) (*Cl
 }
 
 func (c *Client) ListClusterWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*ClusterList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListClusterWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListClusterWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*ClusterList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Cluster{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -768,7 +777,7 @@ func (c *Client) ListClusterWithMaxResults(ctx context.Context, project, locatio
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listCluster(ctx, r, "", pageSize)
+	items, token, err := c.listCluster(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -782,7 +791,8 @@ func (c *Client) ListClusterWithMaxResults(ctx context.Context, project, locatio
 
 func (c *Client) GetCluster(ctx context.Context, r *Cluster) (*Cluster, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Cluster{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Cluster{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -798,7 +808,7 @@ func (c *Client) GetCluster(ctx context.Context, r *Cluster) (*Cluster, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalCluster(b, c, r)
 	if err != nil {
@@ -824,7 +834,8 @@ func (c *Client) GetCluster(ctx context.Context, r *Cluster) (*Cluster, error) {
 
 func (c *Client) DeleteCluster(ctx context.Context, r *Cluster) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Cluster{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Cluster{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -832,7 +843,13 @@ func (c *Client) DeleteCluster(ctx context.Context, r *Cluster) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Cluster...")
 	deleteOp := deleteClusterOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteClusterAsync(ctx context.Context, r *Cluster) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteCluster(ctx, r)
+	})
 }
 
 // DeleteAllCluster deletes all resources that the filter functions returns true on.
@@ -860,10 +877,11 @@ func (c *Client) DeleteAllCluster(ctx context.Context, project, location string,
 }
 
 func (c *Client) ApplyCluster(ctx context.Context, rawDesired *Cluster, opts ...dcl.ApplyOption) (*Cluster, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Cluster{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Cluster{}).Describe())
 	var resultNewState *Cluster
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyClusterHelper(c, ctx, rawDesired, opts...)
@@ -881,6 +899,38 @@ func (c *Client) ApplyCluster(ctx context.Context, rawDesired *Cluster, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyClusterAsync(ctx context.Context, rawDesired *Cluster, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyCluster(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffCluster returns the field-level differences between rawDesired and the
+// live Cluster without modifying it. If the Cluster does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffCluster(ctx context.Context, rawDesired *Cluster, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Cluster{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Cluster{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractClusterFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.clusterDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Cluster %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyClusterHelper(c *Client, ctx context.Context, rawDesired *Cluster, opts ...dcl.ApplyOption) (*Cluster, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyCluster...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -889,6 +939,9 @@ func applyClusterHelper(c *Client, ctx context.Context, rawDesired *Cluster, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractClusterFields(rawDesired); err != nil {
 		return nil, err
@@ -947,7 +1000,7 @@ func applyClusterHelper(c *Client, ctx context.Context, rawDesired *Cluster, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -272,8 +272,12 @@ func (l *InstanceList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *InstanceList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *InstanceList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -290,7 +294,8 @@ func (l *InstanceList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListInstance(ctx context.Context, project, location string) (*InstanceList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListInstanceWithMaxResults(ctx, project, location, InstanceMaxPage)
@@ -298,7 +303,11 @@ func (c *Client) ListInstance(ctx context.Context, project, location string) (*I
 }
 
 func (c *Client) ListInstanceWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*InstanceList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListInstanceWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListInstanceWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*InstanceList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -306,7 +315,7 @@ func (c *Client) ListInstanceWithMaxResults(ctx context.Context, project, locati
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listInstance(ctx, r, "", pageSize)
+	items, token, err := c.listInstance(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -320,7 +329,8 @@ func (c *Client) ListInstanceWithMaxResults(ctx context.Context, project, locati
 
 func (c *Client) GetInstance(ctx context.Context, r *Instance) (*Instance, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -336,7 +346,7 @@ func (c *Client) GetInstance(ctx context.Context, r *Instance) (*Instance, error
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalInstance(b, c, r)
 	if err != nil {
@@ -362,7 +372,8 @@ func (c *Client) GetInstance(ctx context.Context, r *Instance) (*Instance, error
 
 func (c *Client) DeleteInstance(ctx context.Context, r *Instance) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -370,7 +381,13 @@ func (c *Client) DeleteInstance(ctx context.Context, r *Instance) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Instance...")
 	deleteOp := deleteInstanceOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteInstanceAsync(ctx context.Context, r *Instance) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteInstance(ctx, r)
+	})
 }
 
 // DeleteAllInstance deletes all resources that the filter functions returns true on.
@@ -398,10 +415,11 @@ func (c *Client) DeleteAllInstance(ctx context.Context, project, location string
 }
 
 func (c *Client) ApplyInstance(ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) (*Instance, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
 	var resultNewState *Instance
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyInstanceHelper(c, ctx, rawDesired, opts...)
@@ -419,6 +437,38 @@ func (c *Client) ApplyInstance(ctx context.Context, rawDesired *Instance, opts .
 	return resultNewState, err
 }
 
+func (c *Client) ApplyInstanceAsync(ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyInstance(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffInstance returns the field-level differences between rawDesired and the
+// live Instance without modifying it. If the Instance does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffInstance(ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractInstanceFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.instanceDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Instance %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyInstanceHelper(c *Client, ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) (*Instance, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyInstance...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -427,6 +477,9 @@ func applyInstanceHelper(c *Client, ctx context.Context, rawDesired *Instance, o
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractInstanceFields(rawDesired); err != nil {
 		return nil, err
@@ -485,7 +538,7 @@ func applyInstanceHelper(c *Client, ctx context.Context, rawDesired *Instance, o
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -499,6 +552,8 @@ func applyInstanceDiff(c *Client, ctx context.Context, desired *Instance, rawDes
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -523,7 +523,7 @@ func (c *Client) assetDiffsForRawDesired(ctx context.Context, rawDesired *Asset,
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Asset resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Asset resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Asset resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Asset resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -537,6 +537,12 @@ func (c *Client) assetDiffsForRawDesired(ctx context.Context, rawDesired *Asset,
 	if err := extractAssetFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeAssetInitialState(rawInitial, rawDesired)
@@ -554,7 +560,7 @@ func (c *Client) assetDiffsForRawDesired(ctx context.Context, rawDesired *Asset,
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffAsset(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeAssetInitialState(rawInitial, rawDesired *Asset) (*Asset, error) {
@@ -671,8 +671,12 @@ func (l *AssetList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *AssetList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *AssetList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Asset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -689,7 +693,8 @@ func (l *AssetList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListAsset(ctx context.Context, project, location, dataplexZone, lake string) (*AssetList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Asset{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Asset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListAssetWithMaxResults(ctx, project, location, dataplexZone, lake, AssetMaxPage)
@@ -697,7 +702,11 @@ func (c *Client) ListAsset(ctx context.Context, project, location, dataplexZone,
 }
 
 func (c *Client) ListAssetWithMaxResults(ctx context.Context, project, location, dataplexZone, lake string, pageSize int32) (*AssetList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListAssetWithPageToken(ctx, project, location, dataplexZone, lake, pageSize, "")
+}
+
+func (c *Client) ListAssetWithPageToken(ctx context.Context, project, location, dataplexZone, lake string, pageSize int32, pageToken string) (*AssetList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Asset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -707,7 +716,7 @@ func (c *Client) ListAssetWithMaxResults(ctx context.Context, project, location,
 		DataplexZone: &dataplexZone,
 		Lake:         &lake,
 	}
-	items, token, err := c.listAsset(ctx, r, "", pageSize)
+	items, token, err := c.listAsset(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -721,7 +730,8 @@ func (c *Client) ListAssetWithMaxResults(ctx context.Context, project, location,
 
 func (c *Client) GetAsset(ctx context.Context, r *Asset) (*Asset, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Asset{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Asset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -737,7 +747,7 @@ func (c *Client) GetAsset(ctx context.Context, r *Asset) (*Asset, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalAsset(b, c, r)
 	if err != nil {
@@ -765,7 +775,8 @@ func (c *Client) GetAsset(ctx context.Context, r *Asset) (*Asset, error) {
 
 func (c *Client) DeleteAsset(ctx context.Context, r *Asset) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Asset{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Asset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -773,7 +784,13 @@ func (c *Client) DeleteAsset(ctx context.Context, r *Asset) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Asset...")
 	deleteOp := deleteAssetOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteAssetAsync(ctx context.Context, r *Asset) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteAsset(ctx, r)
+	})
 }
 
 // DeleteAllAsset deletes all resources that the filter functions returns true on.
@@ -801,10 +818,11 @@ func (c *Client) DeleteAllAsset(ctx context.Context, project, location, dataplex
 }
 
 func (c *Client) ApplyAsset(ctx context.Context, rawDesired *Asset, opts ...dcl.ApplyOption) (*Asset, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Asset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Asset{}).Describe())
 	var resultNewState *Asset
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyAssetHelper(c, ctx, rawDesired, opts...)
@@ -822,6 +840,38 @@ func (c *Client) ApplyAsset(ctx context.Context, rawDesired *Asset, opts ...dcl.
 	return resultNewState, err
 }
 
+func (c *Client) ApplyAssetAsync(ctx context.Context, rawDesired *Asset, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyAsset(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffAsset returns the field-level differences between rawDesired and the
+// live Asset without modifying it. If the Asset does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffAsset(ctx context.Context, rawDesired *Asset, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Asset{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Asset{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractAssetFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.assetDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Asset %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyAssetHelper(c *Client, ctx context.Context, rawDesired *Asset, opts ...dcl.ApplyOption) (*Asset, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyAsset...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -830,6 +880,9 @@ func applyAssetHelper(c *Client, ctx context.Context, rawDesired *Asset, opts ..
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractAssetFields(rawDesired); err != nil {
 		return nil, err
@@ -888,7 +941,7 @@ func applyAssetHelper(c *Client, ctx context.Context, rawDesired *Asset, opts ..
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
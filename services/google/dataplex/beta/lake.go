@@ -303,8 +303,12 @@ func (l *LakeList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *LakeList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *LakeList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Lake{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -321,7 +325,8 @@ func (l *LakeList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListLake(ctx context.Context, project, location string) (*LakeList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Lake{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Lake{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListLakeWithMaxResults(ctx, project, location, LakeMaxPage)
@@ -329,7 +334,11 @@ func (c *Client) ListLake(ctx context.Context, project, location string) (*LakeL
 }
 
 func (c *Client) ListLakeWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*LakeList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListLakeWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListLakeWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*LakeList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Lake{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -337,7 +346,7 @@ func (c *Client) ListLakeWithMaxResults(ctx context.Context, project, location s
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listLake(ctx, r, "", pageSize)
+	items, token, err := c.listLake(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -351,7 +360,8 @@ func (c *Client) ListLakeWithMaxResults(ctx context.Context, project, location s
 
 func (c *Client) GetLake(ctx context.Context, r *Lake) (*Lake, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Lake{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Lake{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -367,7 +377,7 @@ func (c *Client) GetLake(ctx context.Context, r *Lake) (*Lake, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalLake(b, c, r)
 	if err != nil {
@@ -393,7 +403,8 @@ func (c *Client) GetLake(ctx context.Context, r *Lake) (*Lake, error) {
 
 func (c *Client) DeleteLake(ctx context.Context, r *Lake) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Lake{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Lake{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -401,7 +412,13 @@ func (c *Client) DeleteLake(ctx context.Context, r *Lake) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Lake...")
 	deleteOp := deleteLakeOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteLakeAsync(ctx context.Context, r *Lake) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteLake(ctx, r)
+	})
 }
 
 // DeleteAllLake deletes all resources that the filter functions returns true on.
@@ -429,10 +446,11 @@ func (c *Client) DeleteAllLake(ctx context.Context, project, location string, fi
 }
 
 func (c *Client) ApplyLake(ctx context.Context, rawDesired *Lake, opts ...dcl.ApplyOption) (*Lake, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Lake{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Lake{}).Describe())
 	var resultNewState *Lake
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyLakeHelper(c, ctx, rawDesired, opts...)
@@ -450,6 +468,38 @@ func (c *Client) ApplyLake(ctx context.Context, rawDesired *Lake, opts ...dcl.Ap
 	return resultNewState, err
 }
 
+func (c *Client) ApplyLakeAsync(ctx context.Context, rawDesired *Lake, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyLake(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffLake returns the field-level differences between rawDesired and the
+// live Lake without modifying it. If the Lake does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffLake(ctx context.Context, rawDesired *Lake, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Lake{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Lake{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractLakeFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.lakeDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Lake %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyLakeHelper(c *Client, ctx context.Context, rawDesired *Lake, opts ...dcl.ApplyOption) (*Lake, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyLake...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -458,6 +508,9 @@ func applyLakeHelper(c *Client, ctx context.Context, rawDesired *Lake, opts ...d
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractLakeFields(rawDesired); err != nil {
 		return nil, err
@@ -516,7 +569,7 @@ func applyLakeHelper(c *Client, ctx context.Context, rawDesired *Lake, opts ...d
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
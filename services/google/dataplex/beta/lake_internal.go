@@ -450,7 +450,7 @@ func (c *Client) lakeDiffsForRawDesired(ctx context.Context, rawDesired *Lake, o
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Lake resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Lake resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Lake resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Lake resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -464,6 +464,12 @@ func (c *Client) lakeDiffsForRawDesired(ctx context.Context, rawDesired *Lake, o
 	if err := extractLakeFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeLakeInitialState(rawInitial, rawDesired)
@@ -481,7 +487,7 @@ func (c *Client) lakeDiffsForRawDesired(ctx context.Context, rawDesired *Lake, o
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffLake(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeLakeInitialState(rawInitial, rawDesired *Lake) (*Lake, error) {
@@ -484,7 +484,7 @@ func (c *Client) zoneDiffsForRawDesired(ctx context.Context, rawDesired *Zone, o
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Zone resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Zone resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Zone resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Zone resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -498,6 +498,12 @@ func (c *Client) zoneDiffsForRawDesired(ctx context.Context, rawDesired *Zone, o
 	if err := extractZoneFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeZoneInitialState(rawInitial, rawDesired)
@@ -515,7 +521,7 @@ func (c *Client) zoneDiffsForRawDesired(ctx context.Context, rawDesired *Zone, o
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffZone(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeZoneInitialState(rawInitial, rawDesired *Zone) (*Zone, error) {
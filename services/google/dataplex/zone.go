@@ -442,8 +442,12 @@ func (l *ZoneList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ZoneList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ZoneList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Zone{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -460,7 +464,8 @@ func (l *ZoneList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListZone(ctx context.Context, project, location, lake string) (*ZoneList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Zone{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Zone{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListZoneWithMaxResults(ctx, project, location, lake, ZoneMaxPage)
@@ -468,7 +473,11 @@ func (c *Client) ListZone(ctx context.Context, project, location, lake string) (
 }
 
 func (c *Client) ListZoneWithMaxResults(ctx context.Context, project, location, lake string, pageSize int32) (*ZoneList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListZoneWithPageToken(ctx, project, location, lake, pageSize, "")
+}
+
+func (c *Client) ListZoneWithPageToken(ctx context.Context, project, location, lake string, pageSize int32, pageToken string) (*ZoneList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Zone{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -477,7 +486,7 @@ func (c *Client) ListZoneWithMaxResults(ctx context.Context, project, location,
 		Location: &location,
 		Lake:     &lake,
 	}
-	items, token, err := c.listZone(ctx, r, "", pageSize)
+	items, token, err := c.listZone(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -491,7 +500,8 @@ func (c *Client) ListZoneWithMaxResults(ctx context.Context, project, location,
 
 func (c *Client) GetZone(ctx context.Context, r *Zone) (*Zone, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Zone{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Zone{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -507,7 +517,7 @@ func (c *Client) GetZone(ctx context.Context, r *Zone) (*Zone, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalZone(b, c, r)
 	if err != nil {
@@ -534,7 +544,8 @@ func (c *Client) GetZone(ctx context.Context, r *Zone) (*Zone, error) {
 
 func (c *Client) DeleteZone(ctx context.Context, r *Zone) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Zone{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Zone{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -542,7 +553,13 @@ func (c *Client) DeleteZone(ctx context.Context, r *Zone) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Zone...")
 	deleteOp := deleteZoneOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteZoneAsync(ctx context.Context, r *Zone) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteZone(ctx, r)
+	})
 }
 
 // DeleteAllZone deletes all resources that the filter functions returns true on.
@@ -570,10 +587,11 @@ func (c *Client) DeleteAllZone(ctx context.Context, project, location, lake stri
 }
 
 func (c *Client) ApplyZone(ctx context.Context, rawDesired *Zone, opts ...dcl.ApplyOption) (*Zone, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Zone{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Zone{}).Describe())
 	var resultNewState *Zone
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyZoneHelper(c, ctx, rawDesired, opts...)
@@ -591,6 +609,38 @@ func (c *Client) ApplyZone(ctx context.Context, rawDesired *Zone, opts ...dcl.Ap
 	return resultNewState, err
 }
 
+func (c *Client) ApplyZoneAsync(ctx context.Context, rawDesired *Zone, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyZone(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffZone returns the field-level differences between rawDesired and the
+// live Zone without modifying it. If the Zone does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffZone(ctx context.Context, rawDesired *Zone, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Zone{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Zone{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractZoneFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.zoneDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Zone %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyZoneHelper(c *Client, ctx context.Context, rawDesired *Zone, opts ...dcl.ApplyOption) (*Zone, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyZone...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -599,6 +649,9 @@ func applyZoneHelper(c *Client, ctx context.Context, rawDesired *Zone, opts ...d
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractZoneFields(rawDesired); err != nil {
 		return nil, err
@@ -657,7 +710,7 @@ func applyZoneHelper(c *Client, ctx context.Context, rawDesired *Zone, opts ...d
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -290,8 +290,12 @@ func (l *AutoscalingPolicyList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *AutoscalingPolicyList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *AutoscalingPolicyList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AutoscalingPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -308,7 +312,8 @@ func (l *AutoscalingPolicyList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListAutoscalingPolicy(ctx context.Context, project, location string) (*AutoscalingPolicyList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AutoscalingPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AutoscalingPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListAutoscalingPolicyWithMaxResults(ctx, project, location, AutoscalingPolicyMaxPage)
@@ -316,7 +321,11 @@ func (c *Client) ListAutoscalingPolicy(ctx context.Context, project, location st
 }
 
 func (c *Client) ListAutoscalingPolicyWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*AutoscalingPolicyList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListAutoscalingPolicyWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListAutoscalingPolicyWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*AutoscalingPolicyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AutoscalingPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -324,7 +333,7 @@ func (c *Client) ListAutoscalingPolicyWithMaxResults(ctx context.Context, projec
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listAutoscalingPolicy(ctx, r, "", pageSize)
+	items, token, err := c.listAutoscalingPolicy(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -338,7 +347,8 @@ func (c *Client) ListAutoscalingPolicyWithMaxResults(ctx context.Context, projec
 
 func (c *Client) GetAutoscalingPolicy(ctx context.Context, r *AutoscalingPolicy) (*AutoscalingPolicy, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AutoscalingPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AutoscalingPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -354,7 +364,7 @@ func (c *Client) GetAutoscalingPolicy(ctx context.Context, r *AutoscalingPolicy)
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalAutoscalingPolicy(b, c, r)
 	if err != nil {
@@ -380,7 +390,8 @@ func (c *Client) GetAutoscalingPolicy(ctx context.Context, r *AutoscalingPolicy)
 
 func (c *Client) DeleteAutoscalingPolicy(ctx context.Context, r *AutoscalingPolicy) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AutoscalingPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AutoscalingPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -388,7 +399,13 @@ func (c *Client) DeleteAutoscalingPolicy(ctx context.Context, r *AutoscalingPoli
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting AutoscalingPolicy...")
 	deleteOp := deleteAutoscalingPolicyOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteAutoscalingPolicyAsync(ctx context.Context, r *AutoscalingPolicy) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteAutoscalingPolicy(ctx, r)
+	})
 }
 
 // DeleteAllAutoscalingPolicy deletes all resources that the filter functions returns true on.
@@ -416,10 +433,11 @@ func (c *Client) DeleteAllAutoscalingPolicy(ctx context.Context, project, locati
 }
 
 func (c *Client) ApplyAutoscalingPolicy(ctx context.Context, rawDesired *AutoscalingPolicy, opts ...dcl.ApplyOption) (*AutoscalingPolicy, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AutoscalingPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AutoscalingPolicy{}).Describe())
 	var resultNewState *AutoscalingPolicy
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyAutoscalingPolicyHelper(c, ctx, rawDesired, opts...)
@@ -437,6 +455,38 @@ func (c *Client) ApplyAutoscalingPolicy(ctx context.Context, rawDesired *Autosca
 	return resultNewState, err
 }
 
+func (c *Client) ApplyAutoscalingPolicyAsync(ctx context.Context, rawDesired *AutoscalingPolicy, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyAutoscalingPolicy(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffAutoscalingPolicy returns the field-level differences between rawDesired and the
+// live AutoscalingPolicy without modifying it. If the AutoscalingPolicy does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffAutoscalingPolicy(ctx context.Context, rawDesired *AutoscalingPolicy, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AutoscalingPolicy{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AutoscalingPolicy{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractAutoscalingPolicyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.autoscalingPolicyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("AutoscalingPolicy %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyAutoscalingPolicyHelper(c *Client, ctx context.Context, rawDesired *AutoscalingPolicy, opts ...dcl.ApplyOption) (*AutoscalingPolicy, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyAutoscalingPolicy...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -445,6 +495,9 @@ func applyAutoscalingPolicyHelper(c *Client, ctx context.Context, rawDesired *Au
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractAutoscalingPolicyFields(rawDesired); err != nil {
 		return nil, err
@@ -503,7 +556,7 @@ func applyAutoscalingPolicyHelper(c *Client, ctx context.Context, rawDesired *Au
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
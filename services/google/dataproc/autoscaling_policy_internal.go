@@ -418,7 +418,7 @@ func (c *Client) autoscalingPolicyDiffsForRawDesired(ctx context.Context, rawDes
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a AutoscalingPolicy resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve AutoscalingPolicy resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve AutoscalingPolicy resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that AutoscalingPolicy resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -432,6 +432,12 @@ func (c *Client) autoscalingPolicyDiffsForRawDesired(ctx context.Context, rawDes
 	if err := extractAutoscalingPolicyFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeAutoscalingPolicyInitialState(rawInitial, rawDesired)
@@ -449,7 +455,7 @@ func (c *Client) autoscalingPolicyDiffsForRawDesired(ctx context.Context, rawDes
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffAutoscalingPolicy(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeAutoscalingPolicyInitialState(rawInitial, rawDesired *AutoscalingPolicy) (*AutoscalingPolicy, error) {
@@ -3154,8 +3154,12 @@ func (l *WorkflowTemplateList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *WorkflowTemplateList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *WorkflowTemplateList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkflowTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -3172,7 +3176,8 @@ func (l *WorkflowTemplateList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListWorkflowTemplate(ctx context.Context, project, location string) (*WorkflowTemplateList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkflowTemplate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkflowTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListWorkflowTemplateWithMaxResults(ctx, project, location, WorkflowTemplateMaxPage)
@@ -3180,7 +3185,11 @@ func (c *Client) ListWorkflowTemplate(ctx context.Context, project, location str
 }
 
 func (c *Client) ListWorkflowTemplateWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*WorkflowTemplateList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListWorkflowTemplateWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListWorkflowTemplateWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*WorkflowTemplateList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkflowTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -3188,7 +3197,7 @@ func (c *Client) ListWorkflowTemplateWithMaxResults(ctx context.Context, project
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listWorkflowTemplate(ctx, r, "", pageSize)
+	items, token, err := c.listWorkflowTemplate(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -3202,7 +3211,8 @@ func (c *Client) ListWorkflowTemplateWithMaxResults(ctx context.Context, project
 
 func (c *Client) GetWorkflowTemplate(ctx context.Context, r *WorkflowTemplate) (*WorkflowTemplate, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkflowTemplate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkflowTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -3218,7 +3228,7 @@ func (c *Client) GetWorkflowTemplate(ctx context.Context, r *WorkflowTemplate) (
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalWorkflowTemplate(b, c, r)
 	if err != nil {
@@ -3244,7 +3254,8 @@ func (c *Client) GetWorkflowTemplate(ctx context.Context, r *WorkflowTemplate) (
 
 func (c *Client) DeleteWorkflowTemplate(ctx context.Context, r *WorkflowTemplate) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkflowTemplate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkflowTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -3252,7 +3263,13 @@ func (c *Client) DeleteWorkflowTemplate(ctx context.Context, r *WorkflowTemplate
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting WorkflowTemplate...")
 	deleteOp := deleteWorkflowTemplateOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteWorkflowTemplateAsync(ctx context.Context, r *WorkflowTemplate) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteWorkflowTemplate(ctx, r)
+	})
 }
 
 // DeleteAllWorkflowTemplate deletes all resources that the filter functions returns true on.
@@ -3280,10 +3297,11 @@ func (c *Client) DeleteAllWorkflowTemplate(ctx context.Context, project, locatio
 }
 
 func (c *Client) ApplyWorkflowTemplate(ctx context.Context, rawDesired *WorkflowTemplate, opts ...dcl.ApplyOption) (*WorkflowTemplate, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkflowTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkflowTemplate{}).Describe())
 	var resultNewState *WorkflowTemplate
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyWorkflowTemplateHelper(c, ctx, rawDesired, opts...)
@@ -3301,6 +3319,38 @@ func (c *Client) ApplyWorkflowTemplate(ctx context.Context, rawDesired *Workflow
 	return resultNewState, err
 }
 
+func (c *Client) ApplyWorkflowTemplateAsync(ctx context.Context, rawDesired *WorkflowTemplate, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyWorkflowTemplate(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffWorkflowTemplate returns the field-level differences between rawDesired and the
+// live WorkflowTemplate without modifying it. If the WorkflowTemplate does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffWorkflowTemplate(ctx context.Context, rawDesired *WorkflowTemplate, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkflowTemplate{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkflowTemplate{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractWorkflowTemplateFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.workflowTemplateDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("WorkflowTemplate %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyWorkflowTemplateHelper(c *Client, ctx context.Context, rawDesired *WorkflowTemplate, opts ...dcl.ApplyOption) (*WorkflowTemplate, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyWorkflowTemplate...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -3309,6 +3359,9 @@ func applyWorkflowTemplateHelper(c *Client, ctx context.Context, rawDesired *Wor
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractWorkflowTemplateFields(rawDesired); err != nil {
 		return nil, err
@@ -3367,7 +3420,7 @@ func applyWorkflowTemplateHelper(c *Client, ctx context.Context, rawDesired *Wor
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -3381,6 +3434,8 @@ func applyWorkflowTemplateDiff(c *Client, ctx context.Context, desired *Workflow
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
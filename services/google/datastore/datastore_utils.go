@@ -0,0 +1,163 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package datastore contains handwritten support code for the datastore service.
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl/operations"
+)
+
+// do creates the index and waits for it to be built. Index IDs are generated
+// by the server, so the ID is read from the metadata of the create operation.
+func (op *createIndexOperation) do(ctx context.Context, r *Index, c *Client) error {
+	c.Config.Logger.InfoWithContextf(ctx, "Attempting to create %v", r)
+	u, err := r.createURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+	if r.IndexId != nil {
+		// Allowing creation to continue with IndexId set could result in an Index with the wrong IndexId.
+		return fmt.Errorf("server-generated parameter IndexId was specified by user as %v, should be unspecified", dcl.ValueOrEmptyString(r.IndexId))
+	}
+
+	req, err := r.marshal(c)
+	if err != nil {
+		return err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "POST", u, bytes.NewBuffer(req), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+
+	// wait for the index to be built.
+	var o operations.DatastoreOperation
+	if err := dcl.ParseResponse(resp.Response, &o); err != nil {
+		return err
+	}
+	if err := o.Wait(context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), c.Config, r.basePath(), "GET"); err != nil {
+		c.Config.Logger.Warningf("Creation failed after waiting for operation: %v", err)
+		return err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Successfully waited for operation")
+
+	// Include IndexId in URL substitution for initial GET request.
+	if o.Metadata != nil && o.Metadata.IndexID != "" {
+		r.IndexId = dcl.String(o.Metadata.IndexID)
+	}
+
+	if _, err := c.GetIndex(ctx, r); err != nil {
+		c.Config.Logger.WarningWithContextf(ctx, "get returned error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// getIndexRaw reads the index by its ID. An index without an ID is looked up
+// by its definition instead, so that an index is identified by its kind,
+// ancestor mode and properties rather than by the ID the server assigned it.
+func (c *Client) getIndexRaw(ctx context.Context, r *Index) ([]byte, error) {
+	if r.IndexId == nil {
+		return c.findIndexRaw(ctx, r)
+	}
+
+	u, err := r.getURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	b, err := ioutil.ReadAll(resp.Response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// findIndexRaw returns the first index of the project with the same
+// definition as r. Indexes which are being deleted are skipped, since they
+// cannot satisfy r and a new index with the same definition may be created.
+func (c *Client) findIndexRaw(ctx context.Context, r *Index) ([]byte, error) {
+	pageToken := ""
+	for {
+		b, err := c.listIndexRaw(ctx, r, pageToken, IndexMaxPage)
+		if err != nil {
+			return nil, err
+		}
+		var m listIndexOperation
+		if err := json.Unmarshal(b, &m); err != nil {
+			return nil, err
+		}
+		for _, v := range m.Indexes {
+			res, err := unmarshalMapIndex(v, c, r)
+			if err != nil {
+				return nil, err
+			}
+			if res.State != nil && *res.State == IndexStateEnum("DELETING") {
+				continue
+			}
+			if indexDefinitionsEqual(r, res) {
+				return json.Marshal(v)
+			}
+		}
+		if m.Token == "" {
+			return nil, dcl.NotFoundError{Cause: fmt.Errorf("no Index of kind %q with the same definition in project %q", dcl.ValueOrEmptyString(r.Kind), dcl.ValueOrEmptyString(r.Project))}
+		}
+		pageToken = m.Token
+	}
+}
+
+// indexDefinitionsEqual reports whether two indexes index the same kind with
+// the same ancestor mode and properties. The order of the properties is part
+// of an index's definition, since it determines the queries it can serve.
+func indexDefinitionsEqual(d, a *Index) bool {
+	if dcl.ValueOrEmptyString(d.Kind) != dcl.ValueOrEmptyString(a.Kind) {
+		return false
+	}
+	if indexAncestor(d) != indexAncestor(a) {
+		return false
+	}
+	if len(d.Properties) != len(a.Properties) {
+		return false
+	}
+	for i := range d.Properties {
+		if dcl.ValueOrEmptyString(d.Properties[i].Name) != dcl.ValueOrEmptyString(a.Properties[i].Name) {
+			return false
+		}
+		if dcl.ValueOrEmptyString(d.Properties[i].Direction) != dcl.ValueOrEmptyString(a.Properties[i].Direction) {
+			return false
+		}
+	}
+	return true
+}
+
+// indexAncestor returns the ancestor mode of the index, which the API
+// defaults to NONE.
+func indexAncestor(r *Index) IndexAncestorEnum {
+	if r.Ancestor == nil || *r.Ancestor == "" {
+		return IndexAncestorEnum("NONE")
+	}
+	return *r.Ancestor
+}
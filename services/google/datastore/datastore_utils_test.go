@@ -0,0 +1,111 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package datastore contains handwritten support code for the datastore service.
+package datastore
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func indexProperty(name, direction string) IndexProperties {
+	return IndexProperties{Name: dcl.String(name), Direction: IndexPropertiesDirectionEnumRef(direction)}
+}
+
+func TestIndexDefinitionsEqual(t *testing.T) {
+	index := func(ancestor string, properties ...IndexProperties) *Index {
+		r := &Index{Kind: dcl.String("Task"), Project: dcl.String("project"), Properties: properties}
+		if ancestor != "" {
+			r.Ancestor = IndexAncestorEnumRef(ancestor)
+		}
+		return r
+	}
+	tests := []struct {
+		name string
+		d, a *Index
+		want bool
+	}{
+		{
+			name: "same definition",
+			d:    index("ALL_ANCESTORS", indexProperty("done", "ASCENDING"), indexProperty("priority", "DESCENDING")),
+			a:    index("ALL_ANCESTORS", indexProperty("done", "ASCENDING"), indexProperty("priority", "DESCENDING")),
+			want: true,
+		},
+		{
+			name: "missing ancestor is NONE",
+			d:    index("", indexProperty("done", "ASCENDING")),
+			a:    index("NONE", indexProperty("done", "ASCENDING")),
+			want: true,
+		},
+		{
+			name: "empty ancestor is NONE",
+			d:    index("NONE", indexProperty("done", "ASCENDING")),
+			a:    &Index{Kind: dcl.String("Task"), Ancestor: IndexAncestorEnumRef(""), Properties: []IndexProperties{indexProperty("done", "ASCENDING")}},
+			want: true,
+		},
+		{
+			name: "id, project and state are not compared",
+			d:    index("", indexProperty("done", "ASCENDING")),
+			a: &Index{
+				Kind:       dcl.String("Task"),
+				IndexId:    dcl.String("CICAgJiUpoMK"),
+				Project:    dcl.String("other"),
+				State:      IndexStateEnumRef("READY"),
+				Properties: []IndexProperties{indexProperty("done", "ASCENDING")},
+			},
+			want: true,
+		},
+		{
+			name: "different kind",
+			d:    index("", indexProperty("done", "ASCENDING")),
+			a:    &Index{Kind: dcl.String("Event"), Properties: []IndexProperties{indexProperty("done", "ASCENDING")}},
+		},
+		{
+			name: "different ancestor",
+			d:    index("ALL_ANCESTORS", indexProperty("done", "ASCENDING")),
+			a:    index("", indexProperty("done", "ASCENDING")),
+		},
+		{
+			name: "different property name",
+			d:    index("", indexProperty("done", "ASCENDING")),
+			a:    index("", indexProperty("priority", "ASCENDING")),
+		},
+		{
+			name: "different direction",
+			d:    index("", indexProperty("done", "ASCENDING")),
+			a:    index("", indexProperty("done", "DESCENDING")),
+		},
+		{
+			name: "extra property",
+			d:    index("", indexProperty("done", "ASCENDING")),
+			a:    index("", indexProperty("done", "ASCENDING"), indexProperty("priority", "DESCENDING")),
+		},
+		{
+			name: "reordered properties",
+			d:    index("", indexProperty("done", "ASCENDING"), indexProperty("priority", "DESCENDING")),
+			a:    index("", indexProperty("priority", "DESCENDING"), indexProperty("done", "ASCENDING")),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := indexDefinitionsEqual(tc.d, tc.a); got != tc.want {
+				t.Errorf("indexDefinitionsEqual(%v, %v) = %v, want %v", tc.d, tc.a, got, tc.want)
+			}
+			if got := indexDefinitionsEqual(tc.a, tc.d); got != tc.want {
+				t.Errorf("indexDefinitionsEqual(%v, %v) = %v, want %v", tc.a, tc.d, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,553 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package datastore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+type Index struct {
+	Ancestor *IndexAncestorEnum `json:"ancestor"`
+	IndexId *string `json:"indexId"`
+	Kind *string `json:"kind"`
+	Project *string `json:"project"`
+	Properties []IndexProperties `json:"properties"`
+	State *IndexStateEnum `json:"state"`
+}
+
+func (r *Index) String() string {
+	return dcl.SprintResource(r)
+}
+
+// The enum IndexAncestorEnum.
+type IndexAncestorEnum string
+
+// IndexAncestorEnumRef returns a *IndexAncestorEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func IndexAncestorEnumRef(s string) *IndexAncestorEnum {
+	v := IndexAncestorEnum(s)
+	return &v
+}
+
+func (v IndexAncestorEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"NONE", "ALL_ANCESTORS"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "IndexAncestorEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum IndexPropertiesDirectionEnum.
+type IndexPropertiesDirectionEnum string
+
+// IndexPropertiesDirectionEnumRef returns a *IndexPropertiesDirectionEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func IndexPropertiesDirectionEnumRef(s string) *IndexPropertiesDirectionEnum {
+	v := IndexPropertiesDirectionEnum(s)
+	return &v
+}
+
+func (v IndexPropertiesDirectionEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"ASCENDING", "DESCENDING"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "IndexPropertiesDirectionEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum IndexStateEnum.
+type IndexStateEnum string
+
+// IndexStateEnumRef returns a *IndexStateEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func IndexStateEnumRef(s string) *IndexStateEnum {
+	v := IndexStateEnum(s)
+	return &v
+}
+
+func (v IndexStateEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"CREATING", "READY", "DELETING", "ERROR"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "IndexStateEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+type IndexProperties struct {
+	empty bool `json:"-"`
+	Name *string `json:"name"`
+	Direction *IndexPropertiesDirectionEnum `json:"direction"`
+}
+
+type jsonIndexProperties IndexProperties
+
+func (r *IndexProperties) UnmarshalJSON(data []byte) error {
+	var res jsonIndexProperties
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyIndexProperties
+	} else {
+
+		r.Name = res.Name
+
+		r.Direction = res.Direction
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this IndexProperties is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyIndexProperties *IndexProperties = &IndexProperties{empty: true}
+
+func (r *IndexProperties) Empty() bool {
+	return r.empty
+}
+
+func (r *IndexProperties) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *IndexProperties) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+// Describe returns a simple description of this resource to ensure that automated tools
+// can identify it.
+func (r *Index) Describe() dcl.ServiceTypeVersion {
+	return dcl.ServiceTypeVersion{
+		Service: "datastore",
+		Type:    "Index",
+		Version: "datastore",
+	}
+}
+
+func (r *Index) ID() (string, error) {
+	if err := extractIndexFields(r); err != nil {
+		return "", err
+	}
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"ancestor": dcl.ValueOrEmptyString(nr.Ancestor),
+		"index_id": dcl.ValueOrEmptyString(nr.IndexId),
+		"kind": dcl.ValueOrEmptyString(nr.Kind),
+		"project": dcl.ValueOrEmptyString(nr.Project),
+		"properties": dcl.ValueOrEmptyString(nr.Properties),
+		"state": dcl.ValueOrEmptyString(nr.State),
+	}
+	return dcl.Nprintf("projects/{{project}}/indexes/{{index_id}}", params), nil
+}
+
+const IndexMaxPage = -1
+
+type IndexList struct {
+	Items []*Index
+
+	nextToken string
+
+	pageSize int32
+
+	resource *Index
+}
+
+func (l *IndexList) HasNext() bool {
+	return l.nextToken != ""
+}
+
+func (l *IndexList) NextPageToken() string {
+	return l.nextToken
+}
+
+func (l *IndexList) Next(ctx context.Context, c *Client) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Index{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if !l.HasNext() {
+		return fmt.Errorf("no next page")
+	}
+	items, token, err := c.listIndex(ctx, l.resource, l.nextToken, l.pageSize)
+	if err != nil {
+		return err
+	}
+	l.Items = items
+	l.nextToken = token
+	return err
+}
+
+func (c *Client) ListIndex(ctx context.Context, project string) (*IndexList, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Index{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Index{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	return c.ListIndexWithMaxResults(ctx, project, IndexMaxPage)
+
+}
+
+func (c *Client) ListIndexWithMaxResults(ctx context.Context, project string, pageSize int32) (*IndexList, error) {
+	return c.ListIndexWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListIndexWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*IndexList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Index{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// Create a resource object so that we can use proper url normalization methods.
+	r := &Index{
+		Project: &project,
+	}
+	items, token, err := c.listIndex(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &IndexList{
+		Items:     items,
+		nextToken: token,
+		pageSize:  pageSize,
+		resource:  r,
+	}, nil
+}
+
+func (c *Client) GetIndex(ctx context.Context, r *Index) (*Index, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Index{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Index{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// This is *purposefully* supressing errors.
+	// This function is used with url-normalized values + not URL normalized values.
+	// URL Normalized values will throw unintentional errors, since those values are not of the proper parent form.
+	extractIndexFields(r)
+
+	b, err := c.getIndexRaw(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			return nil, &googleapi.Error{
+				Code:    404,
+				Message: err.Error(),
+			}
+		}
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
+	}
+	result, err := unmarshalIndex(b, c, r)
+	if err != nil {
+		return nil, err
+	}
+	result.Project = r.Project
+
+	c.Config.Logger.InfoWithContextf(ctx, "Retrieved raw result state: %v", result)
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with specified state: %v", r)
+	result, err = canonicalizeIndexNewState(c, result, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := postReadExtractIndexFields(result); err != nil {
+		return result, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created result state: %v", result)
+
+	return result, nil
+}
+
+func (c *Client) DeleteIndex(ctx context.Context, r *Index) error {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Index{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Index{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if r == nil {
+		return fmt.Errorf("Index resource is nil")
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Deleting Index...")
+	deleteOp := deleteIndexOperation{}
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteIndexAsync(ctx context.Context, r *Index) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteIndex(ctx, r)
+	})
+}
+
+// DeleteAllIndex deletes all resources that the filter functions returns true on.
+func (c *Client) DeleteAllIndex(ctx context.Context, project string, filter func(*Index) bool) error {
+	listObj, err := c.ListIndex(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	err = c.deleteAllIndex(ctx, filter, listObj.Items)
+	if err != nil {
+		return err
+	}
+	for listObj.HasNext() {
+		err = listObj.Next(ctx, c)
+		if err != nil {
+			return nil
+		}
+		err = c.deleteAllIndex(ctx, filter, listObj.Items)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) ApplyIndex(ctx context.Context, rawDesired *Index, opts ...dcl.ApplyOption) (*Index, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Index{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Index{}).Describe())
+	var resultNewState *Index
+	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		newState, err := applyIndexHelper(c, ctx, rawDesired, opts...)
+		resultNewState = newState
+		if err != nil {
+			// If the error is 409, there is conflict in resource update.
+			// Here we want to apply changes based on latest state.
+			if dcl.IsConflictError(err) {
+				return &dcl.RetryDetails{}, dcl.OperationNotDone{Err: err}
+			}
+			return nil, err
+		}
+		return nil, nil
+	}, c.Config.RetryProvider)
+	return resultNewState, err
+}
+
+func (c *Client) ApplyIndexAsync(ctx context.Context, rawDesired *Index, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyIndex(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffIndex returns the field-level differences between rawDesired and the
+// live Index without modifying it. If the Index does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffIndex(ctx context.Context, rawDesired *Index, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Index{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Index{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractIndexFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.indexDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Index %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
+func applyIndexHelper(c *Client, ctx context.Context, rawDesired *Index, opts ...dcl.ApplyOption) (*Index, error) {
+	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyIndex...")
+	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
+
+	// 1.1: Validation of user-specified fields in desired state.
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
+
+	if err := extractIndexFields(rawDesired); err != nil {
+		return nil, err
+	}
+
+	initial, desired, fieldDiffs, err := c.indexDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a diff: %w", err)
+	}
+
+	diffs, err := convertFieldDiffsToIndexDiffs(c.Config, fieldDiffs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(magic-modules-eng): 2.2 Feasibility check (all updates are feasible so far).
+
+	// 2.3: Lifecycle Directive Check
+	var create bool
+	lp := dcl.FetchLifecycleParams(opts)
+	if initial == nil {
+		if dcl.HasLifecycleParam(lp, dcl.BlockCreation) {
+			return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Creation blocked by lifecycle params: %#v.", desired)}
+		}
+		create = true
+	} else if dcl.HasLifecycleParam(lp, dcl.BlockAcquire) {
+		return nil, dcl.ApplyInfeasibleError{
+			Message: fmt.Sprintf("Resource already exists - apply blocked by lifecycle params: %#v.", initial),
+		}
+	} else {
+		for _, d := range diffs {
+			if d.RequiresRecreate {
+				return nil, dcl.ApplyInfeasibleError{
+					Message: fmt.Sprintf("infeasible update: (%v) would require recreation", d),
+				}
+			}
+			if dcl.HasLifecycleParam(lp, dcl.BlockModification) {
+				return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Modification blocked, diff (%v) unresolvable.", d)}
+			}
+		}
+	}
+
+	// 2.4 Imperative Request Planning
+	var ops []indexApiOperation
+	if create {
+		ops = append(ops, &createIndexOperation{})
+	} else {
+		for _, d := range diffs {
+			ops = append(ops, d.UpdateOp)
+		}
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created plan: %#v", ops)
+
+	// 2.5 Request Actuation
+	for _, op := range ops {
+		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
+		if err := op.do(ctx, desired, c); err != nil {
+			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
+		}
+		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
+	}
+	return applyIndexDiff(c, ctx, desired, rawDesired, ops, opts...)
+}
+
+func applyIndexDiff(c *Client, ctx context.Context, desired *Index, rawDesired *Index, ops []indexApiOperation, opts ...dcl.ApplyOption) (*Index, error) {
+	// 3.1, 3.2a Retrieval of raw new state & canonicalization with desired state
+	c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state...")
+	rawNew, err := c.GetIndex(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	// Get additional values from the first response.
+	// These values should be merged into the newState above.
+	if len(ops) > 0 {
+		lastOp := ops[len(ops)-1]
+		if o, ok := lastOp.(*createIndexOperation); ok {
+			if r, hasR := o.FirstResponse(); hasR {
+
+				c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state from operation...")
+
+				fullResp, err := unmarshalMapIndex(r, c, rawDesired)
+				if err != nil {
+					return nil, err
+				}
+
+				rawNew, err = canonicalizeIndexNewState(c, rawNew, fullResp)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with raw desired state: %v", rawDesired)
+	// 3.2b Canonicalization of raw new state using raw desired state
+	newState, err := canonicalizeIndexNewState(c, rawNew, rawDesired)
+	if err != nil {
+		return rawNew, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created canonical new state: %v", newState)
+	// 3.3 Comparison of the new state and raw desired state.
+	// TODO(magic-modules-eng): EVENTUALLY_CONSISTENT_UPDATE
+	newDesired, err := canonicalizeIndexDesiredState(rawDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if err := postReadExtractIndexFields(newState); err != nil {
+		return newState, err
+	}
+
+	// Need to ensure any transformations made here match acceptably in differ.
+	if err := postReadExtractIndexFields(newDesired); err != nil {
+		return newState, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Diffing using canonicalized desired state: %v", newDesired)
+	newDiffs, err := diffIndex(c, newDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if len(newDiffs) == 0 {
+		c.Config.Logger.InfoWithContext(ctx, "No diffs found. Apply was successful.")
+	} else {
+		c.Config.Logger.InfoWithContextf(ctx, "Found diffs: %v", newDiffs)
+		diffMessages := make([]string, len(newDiffs))
+		for i, d := range newDiffs {
+			diffMessages[i] = fmt.Sprintf("%v", d)
+		}
+		return newState, dcl.DiffAfterApplyError{Diffs: diffMessages}
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Done Apply.")
+	return newState, nil
+}
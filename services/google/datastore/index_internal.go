@@ -0,0 +1,1055 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl/operations"
+)
+
+func (r *Index) validate() error {
+
+	if err := dcl.Required(r, "kind"); err != nil {
+		return err
+	}
+	if err := dcl.Required(r, "properties"); err != nil {
+		return err
+	}
+	if err := dcl.RequiredParameter(r.Project, "Project"); err != nil {
+		return err
+	}
+	return nil
+}
+func (r *IndexProperties) validate() error {
+	if err := dcl.Required(r, "name"); err != nil {
+		return err
+	}
+	if err := dcl.Required(r, "direction"); err != nil {
+		return err
+	}
+	return nil
+}
+func (r *Index) basePath() string {
+	params := map[string]interface{}{}
+	return dcl.Nprintf("https://datastore.googleapis.com/v1/", params)
+}
+
+func (r *Index) getURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+		"indexId": dcl.ValueOrEmptyString(nr.IndexId),
+	}
+	return dcl.URL("projects/{{project}}/indexes/{{indexId}}", nr.basePath(), userBasePath, params), nil
+}
+
+func (r *Index) listURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+	}
+	return dcl.URL("projects/{{project}}/indexes", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *Index) createURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+	}
+	return dcl.URL("projects/{{project}}/indexes", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *Index) deleteURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+		"indexId": dcl.ValueOrEmptyString(nr.IndexId),
+	}
+	return dcl.URL("projects/{{project}}/indexes/{{indexId}}", nr.basePath(), userBasePath, params), nil
+}
+
+// indexApiOperation represents a mutable operation in the underlying REST
+// API such as Create, Update, or Delete.
+type indexApiOperation interface {
+	do(context.Context, *Index, *Client) error
+}
+
+func (c *Client) listIndexRaw(ctx context.Context, r *Index, pageToken string, pageSize int32) ([]byte, error) {
+	u, err := r.urlNormalized().listURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string)
+	if pageToken != "" {
+		m["pageToken"] = pageToken
+	}
+
+	if pageSize != IndexMaxPage {
+		m["pageSize"] = fmt.Sprintf("%v", pageSize)
+	}
+
+	u, err = dcl.AddQueryParams(u, m)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	return ioutil.ReadAll(resp.Response.Body)
+}
+
+type listIndexOperation struct {
+	Indexes []map[string]interface{} `json:"indexes"`
+	Token string                   `json:"nextPageToken"`
+}
+
+func (c *Client) listIndex(ctx context.Context, r *Index, pageToken string, pageSize int32) ([]*Index, string, error) {
+	b, err := c.listIndexRaw(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var m listIndexOperation
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, "", err
+	}
+
+	var l []*Index
+	for _, v := range m.Indexes {
+		res, err := unmarshalMapIndex(v, c, r)
+		if err != nil {
+			return nil, m.Token, err
+		}
+		res.Project = r.Project
+		l = append(l, res)
+	}
+
+	return l, m.Token, nil
+}
+
+func (c *Client) deleteAllIndex(ctx context.Context, f func(*Index) bool, resources []*Index) error {
+	var errors []string
+	for _, res := range resources {
+		if f(res) {
+			// We do not want deleteAll to fail on a deletion or else it will stop deleting other resources.
+			err := c.DeleteIndex(ctx, res)
+			if err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("%v", strings.Join(errors, "\n"))
+	} else {
+		return nil
+	}
+}
+
+type deleteIndexOperation struct{}
+
+func (op *deleteIndexOperation) do(ctx context.Context, r *Index, c *Client) error {
+	r, err := c.GetIndex(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			c.Config.Logger.InfoWithContextf(ctx, "Index not found, returning. Original error: %v", err)
+			return nil
+		}
+		c.Config.Logger.WarningWithContextf(ctx, "GetIndex checking for existence. error: %v", err)
+		return err
+	}
+
+	u, err := r.deleteURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	// Delete should never have a body
+	body := &bytes.Buffer{}
+	resp, err := dcl.SendRequest(ctx, c.Config, "DELETE", u, body, c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+
+	// wait for object to be deleted.
+	var o operations.DatastoreOperation
+	if err := dcl.ParseResponse(resp.Response, &o); err != nil {
+		return err
+	}
+	if err := o.Wait(context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), c.Config, r.basePath(), "GET"); err != nil {
+		return err
+	}
+
+	// We saw a race condition where for some successful delete operation, the Get calls returned resources for a short duration.
+	// This is the reason we are adding retry to handle that case.
+	retriesRemaining := 10
+	dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		_, err := c.GetIndex(ctx, r)
+		if dcl.IsNotFound(err) {
+			return nil, nil
+		}
+		if retriesRemaining > 0 {
+			retriesRemaining--
+			return &dcl.RetryDetails{}, dcl.OperationNotDone{}
+		}
+		return nil, dcl.NotDeletedError{ExistingResource: r}
+	}, c.Config.RetryProvider)
+	return nil
+}
+
+// Create operations are similar to Update operations, although they do not have
+// specific request objects. The Create request object is the json encoding of
+// the resource, which is modified by res.marshal to form the base request body.
+type createIndexOperation struct {
+	response map[string]interface{}
+}
+
+func (op *createIndexOperation) FirstResponse() (map[string]interface{}, bool) {
+	return op.response, len(op.response) > 0
+}
+
+func (c *Client) indexDiffsForRawDesired(ctx context.Context, rawDesired *Index, opts ...dcl.ApplyOption) (initial, desired *Index, diffs []*dcl.FieldDiff, err error) {
+	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
+	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
+	var fetchState *Index
+	if sh := dcl.FetchStateHint(opts); sh != nil {
+		if r, ok := sh.(*Index); !ok {
+			c.Config.Logger.WarningWithContextf(ctx, "Initial state hint was of the wrong type; expected Index, got %T", sh)
+		} else {
+			fetchState = r
+		}
+	}
+	if fetchState == nil {
+		fetchState = rawDesired
+	}
+
+	// 1.2: Retrieval of raw initial state from API
+	rawInitial, err := c.GetIndex(ctx, fetchState)
+	if rawInitial == nil {
+		if !dcl.IsNotFound(err) {
+			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Index resource already exists: %s", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Index resource: %w", err)
+		}
+		c.Config.Logger.InfoWithContext(ctx, "Found that Index resource did not exist.")
+		// Perform canonicalization to pick up defaults.
+		desired, err = canonicalizeIndexDesiredState(rawDesired, rawInitial)
+		return nil, desired, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Found initial state for Index: %v", rawInitial)
+	c.Config.Logger.InfoWithContextf(ctx, "Initial desired state for Index: %v", rawDesired)
+
+	// The Get call applies postReadExtract and so the result may contain fields that are not part of API version.
+	if err := extractIndexFields(rawInitial); err != nil {
+		return nil, nil, nil, err
+	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// 1.3: Canonicalize raw initial state into initial state.
+	initial, err = canonicalizeIndexInitialState(rawInitial, rawDesired)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized initial state for Index: %v", initial)
+
+	// 1.4: Canonicalize raw desired state into desired state.
+	desired, err = canonicalizeIndexDesiredState(rawDesired, rawInitial, opts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized desired state for Index: %v", desired)
+
+	// 2.1: Comparison of initial and desired state.
+	diffs, err = diffIndex(c, desired, initial, opts...)
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
+}
+
+func canonicalizeIndexInitialState(rawInitial, rawDesired *Index) (*Index, error) {
+	// TODO(magic-modules-eng): write canonicalizer once relevant traits are added.
+	return rawInitial, nil
+}
+
+/*
+* Canonicalizers
+*
+* These are responsible for converting either a user-specified config or a
+* GCP API response to a standard format that can be used for difference checking.
+* */
+
+func canonicalizeIndexDesiredState(rawDesired, rawInitial *Index, opts ...dcl.ApplyOption) (*Index, error) {
+
+	if rawInitial == nil {
+		// Since the initial state is empty, the desired state is all we have.
+		// We canonicalize the remaining nested objects with nil to pick up defaults.
+
+		return rawDesired, nil
+	}
+
+	canonicalDesired := &Index{}
+	if dcl.IsZeroValue(rawDesired.Ancestor) || (dcl.IsEmptyValueIndirect(rawDesired.Ancestor) && dcl.IsEmptyValueIndirect(rawInitial.Ancestor)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.Ancestor = rawInitial.Ancestor
+	} else {
+		canonicalDesired.Ancestor = rawDesired.Ancestor
+	}
+	if dcl.IsZeroValue(rawDesired.IndexId) || (dcl.IsEmptyValueIndirect(rawDesired.IndexId) && dcl.IsEmptyValueIndirect(rawInitial.IndexId)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.IndexId = rawInitial.IndexId
+	} else {
+		canonicalDesired.IndexId = rawDesired.IndexId
+	}
+	if dcl.StringCanonicalize(rawDesired.Kind, rawInitial.Kind) {
+		canonicalDesired.Kind = rawInitial.Kind
+	} else {
+		canonicalDesired.Kind = rawDesired.Kind
+	}
+	if dcl.NameToSelfLink(rawDesired.Project, rawInitial.Project) {
+		canonicalDesired.Project = rawInitial.Project
+	} else {
+		canonicalDesired.Project = rawDesired.Project
+	}
+	canonicalDesired.Properties = canonicalizeIndexPropertiesSlice(rawDesired.Properties, rawInitial.Properties, opts...)
+
+	return canonicalDesired, nil
+}
+
+func canonicalizeIndexNewState(c *Client, rawNew, rawDesired *Index) (*Index, error) {
+
+	if dcl.IsEmptyValueIndirect(rawNew.Ancestor) && dcl.IsEmptyValueIndirect(rawDesired.Ancestor) {
+		rawNew.Ancestor = rawDesired.Ancestor
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.IndexId) && dcl.IsEmptyValueIndirect(rawDesired.IndexId) {
+		rawNew.IndexId = rawDesired.IndexId
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Kind) && dcl.IsEmptyValueIndirect(rawDesired.Kind) {
+		rawNew.Kind = rawDesired.Kind
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Kind, rawNew.Kind) {
+			rawNew.Kind = rawDesired.Kind
+		}
+	}
+
+	rawNew.Project = rawDesired.Project
+
+	if dcl.IsEmptyValueIndirect(rawNew.Properties) && dcl.IsEmptyValueIndirect(rawDesired.Properties) {
+		rawNew.Properties = rawDesired.Properties
+	} else {
+		rawNew.Properties = canonicalizeNewIndexPropertiesSlice(c, rawDesired.Properties, rawNew.Properties)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.State) && dcl.IsEmptyValueIndirect(rawDesired.State) {
+		rawNew.State = rawDesired.State
+	} else {
+	}
+
+	return rawNew, nil
+}
+
+func canonicalizeIndexProperties(des, initial *IndexProperties, opts ...dcl.ApplyOption) *IndexProperties {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &IndexProperties{}
+
+	if dcl.StringCanonicalize(des.Name, initial.Name) || dcl.IsZeroValue(des.Name) {
+		cDes.Name = initial.Name
+	} else {
+		cDes.Name = des.Name
+	}
+	if dcl.IsZeroValue(des.Direction) || (dcl.IsEmptyValueIndirect(des.Direction) && dcl.IsEmptyValueIndirect(initial.Direction)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Direction = initial.Direction
+	} else {
+		cDes.Direction = des.Direction
+	}
+
+	return cDes
+}
+
+func canonicalizeIndexPropertiesSlice(des, initial []IndexProperties, opts ...dcl.ApplyOption) []IndexProperties {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]IndexProperties, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeIndexProperties(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]IndexProperties, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeIndexProperties(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewIndexProperties(c *Client, des, nw *IndexProperties) *IndexProperties {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for IndexProperties while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Name, nw.Name) {
+		nw.Name = des.Name
+	}
+
+	return nw
+}
+
+func canonicalizeNewIndexPropertiesSet(c *Client, des, nw []IndexProperties) []IndexProperties {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []IndexProperties
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareIndexPropertiesNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewIndexPropertiesSlice(c *Client, des, nw []IndexProperties) []IndexProperties {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []IndexProperties
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewIndexProperties(c, &d, &n))
+	}
+
+	return items
+}
+
+// The differ returns a list of diffs, along with a list of operations that should be taken
+// to remedy them. Right now, it does not attempt to consolidate operations - if several
+// fields can be fixed with a patch update, it will perform the patch several times.
+// Diffs on some fields will be ignored if the `desired` state has an empty (nil)
+// value. This empty value indicates that the user does not care about the state for
+// the field. Empty fields on the actual object will cause diffs.
+// TODO(magic-modules-eng): for efficiency in some resources, add batching.
+func diffIndex(c *Client, desired, actual *Index, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	if desired == nil || actual == nil {
+		return nil, fmt.Errorf("nil resource passed to diff - always a programming error: %#v, %#v", desired, actual)
+	}
+
+	c.Config.Logger.Infof("Diff function called with desired state: %v", desired)
+	c.Config.Logger.Infof("Diff function called with actual state: %v", actual)
+
+	var fn dcl.FieldName
+	var newDiffs []*dcl.FieldDiff
+	// New style diffs.
+	if ds, err := dcl.Diff(desired.Ancestor, actual.Ancestor, dcl.DiffInfo{ServerDefault: true, Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Ancestor")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.IndexId, actual.IndexId, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("IndexId")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Project, actual.Project, dcl.DiffInfo{Type: "ReferenceType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Project")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Properties, actual.Properties, dcl.DiffInfo{ObjectFunction: compareIndexPropertiesNewStyle, EmptyObject: EmptyIndexProperties, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Properties")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.State, actual.State, dcl.DiffInfo{OutputOnly: true, Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("State")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	return newDiffs, nil
+}
+func compareIndexPropertiesNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*IndexProperties)
+	if !ok {
+		desiredNotPointer, ok := d.(IndexProperties)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a IndexProperties or *IndexProperties", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*IndexProperties)
+	if !ok {
+		actualNotPointer, ok := a.(IndexProperties)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a IndexProperties", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Direction, actual.Direction, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Direction")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+// urlNormalized returns a copy of the resource struct with values normalized
+// for URL substitutions. For instance, it converts long-form self-links to
+// short-form so they can be substituted in.
+func (r *Index) urlNormalized() *Index {
+	normalized := dcl.Copy(*r).(Index)
+	normalized.IndexId = dcl.SelfLinkToName(r.IndexId)
+	normalized.Kind = dcl.SelfLinkToName(r.Kind)
+	normalized.Project = dcl.SelfLinkToName(r.Project)
+	return &normalized
+}
+
+func (r *Index) updateURL(userBasePath, updateName string) (string, error) {
+
+	return "", fmt.Errorf("unknown update name: %s", updateName)
+}
+
+// marshal encodes the Index resource into JSON for a Create request, and
+// performs transformations from the resource schema to the API schema if
+// necessary.
+func (r *Index) marshal(c *Client) ([]byte, error) {
+	m, err := expandIndex(c, r)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling Index: %w", err)
+	}
+
+	return json.Marshal(m)
+}
+
+// unmarshalIndex decodes JSON responses into the Index resource schema.
+func unmarshalIndex(b []byte, c *Client, res *Index) (*Index, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return unmarshalMapIndex(m, c, res)
+}
+
+func unmarshalMapIndex(m map[string]interface{}, c *Client, res *Index) (*Index, error) {
+
+	flattened := flattenIndex(c, m, res)
+	if flattened == nil {
+		return nil, fmt.Errorf("attempted to flatten empty json object")
+	}
+	return flattened, nil
+}
+
+// expandIndex expands Index into a JSON request object.
+func expandIndex(c *Client, f *Index) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	res := f
+	_ = res
+	if v := f.Ancestor; dcl.ValueShouldBeSent(v) {
+		m["ancestor"] = v
+	}
+	if v := f.Kind; dcl.ValueShouldBeSent(v) {
+		m["kind"] = v
+	}
+	if v, err := dcl.EmptyValue(); err != nil {
+		return nil, fmt.Errorf("error expanding Project into project: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["project"] = v
+	}
+	if v, err := expandIndexPropertiesSlice(c, f.Properties, res); err != nil {
+		return nil, fmt.Errorf("error expanding Properties into properties: %w", err)
+	} else if v != nil {
+		m["properties"] = v
+	}
+
+	return m, nil
+}
+
+// flattenIndex flattens Index from a JSON request object into the
+// Index type.
+func flattenIndex(c *Client, i interface{}, res *Index) *Index {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if len(m) == 0 {
+		return nil
+	}
+
+	resultRes := &Index{}
+	resultRes.Ancestor = flattenIndexAncestorEnum(m["ancestor"])
+	resultRes.IndexId = dcl.FlattenString(m["indexId"])
+	resultRes.Kind = dcl.FlattenString(m["kind"])
+	resultRes.Project = dcl.FlattenString(m["project"])
+	resultRes.Properties = flattenIndexPropertiesSlice(c, m["properties"], res)
+	resultRes.State = flattenIndexStateEnum(m["state"])
+
+	return resultRes
+}
+
+// expandIndexPropertiesMap expands the contents of IndexProperties into a JSON
+// request object.
+func expandIndexPropertiesMap(c *Client, f map[string]IndexProperties, res *Index) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandIndexProperties(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandIndexPropertiesSlice expands the contents of IndexProperties into a JSON
+// request object.
+func expandIndexPropertiesSlice(c *Client, f []IndexProperties, res *Index) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandIndexProperties(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenIndexPropertiesMap flattens the contents of IndexProperties from a JSON
+// response object.
+func flattenIndexPropertiesMap(c *Client, i interface{}, res *Index) map[string]IndexProperties {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]IndexProperties{}
+	}
+
+	if len(a) == 0 {
+		return map[string]IndexProperties{}
+	}
+
+	items := make(map[string]IndexProperties)
+	for k, item := range a {
+		items[k] = *flattenIndexProperties(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenIndexPropertiesSlice flattens the contents of IndexProperties from a JSON
+// response object.
+func flattenIndexPropertiesSlice(c *Client, i interface{}, res *Index) []IndexProperties {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []IndexProperties{}
+	}
+
+	if len(a) == 0 {
+		return []IndexProperties{}
+	}
+
+	items := make([]IndexProperties, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenIndexProperties(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandIndexProperties expands an instance of IndexProperties into a JSON
+// request object.
+func expandIndexProperties(c *Client, f *IndexProperties, res *Index) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Name; !dcl.IsEmptyValueIndirect(v) {
+		m["name"] = v
+	}
+	if v := f.Direction; !dcl.IsEmptyValueIndirect(v) {
+		m["direction"] = v
+	}
+
+	return m, nil
+}
+
+// flattenIndexProperties flattens an instance of IndexProperties from a JSON
+// response object.
+func flattenIndexProperties(c *Client, i interface{}, res *Index) *IndexProperties {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &IndexProperties{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyIndexProperties
+	}
+	r.Name = dcl.FlattenString(m["name"])
+	r.Direction = flattenIndexPropertiesDirectionEnum(m["direction"])
+
+	return r
+}
+
+// flattenIndexAncestorEnumMap flattens the contents of IndexAncestorEnum from a JSON
+// response object.
+func flattenIndexAncestorEnumMap(c *Client, i interface{}, res *Index) map[string]IndexAncestorEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]IndexAncestorEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]IndexAncestorEnum{}
+	}
+
+	items := make(map[string]IndexAncestorEnum)
+	for k, item := range a {
+		items[k] = *flattenIndexAncestorEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenIndexAncestorEnumSlice flattens the contents of IndexAncestorEnum from a JSON
+// response object.
+func flattenIndexAncestorEnumSlice(c *Client, i interface{}, res *Index) []IndexAncestorEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []IndexAncestorEnum{}
+	}
+
+	if len(a) == 0 {
+		return []IndexAncestorEnum{}
+	}
+
+	items := make([]IndexAncestorEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenIndexAncestorEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenIndexAncestorEnum asserts that an interface is a string, and returns a
+// pointer to a *IndexAncestorEnum with the same value as that string.
+func flattenIndexAncestorEnum(i interface{}) *IndexAncestorEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return IndexAncestorEnumRef(s)
+}
+
+// flattenIndexPropertiesDirectionEnumMap flattens the contents of IndexPropertiesDirectionEnum from a JSON
+// response object.
+func flattenIndexPropertiesDirectionEnumMap(c *Client, i interface{}, res *Index) map[string]IndexPropertiesDirectionEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]IndexPropertiesDirectionEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]IndexPropertiesDirectionEnum{}
+	}
+
+	items := make(map[string]IndexPropertiesDirectionEnum)
+	for k, item := range a {
+		items[k] = *flattenIndexPropertiesDirectionEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenIndexPropertiesDirectionEnumSlice flattens the contents of IndexPropertiesDirectionEnum from a JSON
+// response object.
+func flattenIndexPropertiesDirectionEnumSlice(c *Client, i interface{}, res *Index) []IndexPropertiesDirectionEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []IndexPropertiesDirectionEnum{}
+	}
+
+	if len(a) == 0 {
+		return []IndexPropertiesDirectionEnum{}
+	}
+
+	items := make([]IndexPropertiesDirectionEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenIndexPropertiesDirectionEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenIndexPropertiesDirectionEnum asserts that an interface is a string, and returns a
+// pointer to a *IndexPropertiesDirectionEnum with the same value as that string.
+func flattenIndexPropertiesDirectionEnum(i interface{}) *IndexPropertiesDirectionEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return IndexPropertiesDirectionEnumRef(s)
+}
+
+// flattenIndexStateEnumMap flattens the contents of IndexStateEnum from a JSON
+// response object.
+func flattenIndexStateEnumMap(c *Client, i interface{}, res *Index) map[string]IndexStateEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]IndexStateEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]IndexStateEnum{}
+	}
+
+	items := make(map[string]IndexStateEnum)
+	for k, item := range a {
+		items[k] = *flattenIndexStateEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenIndexStateEnumSlice flattens the contents of IndexStateEnum from a JSON
+// response object.
+func flattenIndexStateEnumSlice(c *Client, i interface{}, res *Index) []IndexStateEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []IndexStateEnum{}
+	}
+
+	if len(a) == 0 {
+		return []IndexStateEnum{}
+	}
+
+	items := make([]IndexStateEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenIndexStateEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenIndexStateEnum asserts that an interface is a string, and returns a
+// pointer to a *IndexStateEnum with the same value as that string.
+func flattenIndexStateEnum(i interface{}) *IndexStateEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return IndexStateEnumRef(s)
+}
+
+// This function returns a matcher that checks whether a serialized resource matches this resource
+// in its parameters (as defined by the fields in a Get, which definitionally define resource
+// identity).  This is useful in extracting the element from a List call.
+func (r *Index) matcher(c *Client) func([]byte) bool {
+	return func(b []byte) bool {
+		cr, err := unmarshalIndex(b, c, r)
+		if err != nil {
+			c.Config.Logger.Warning("failed to unmarshal provided resource in matcher.")
+			return false
+		}
+		nr := r.urlNormalized()
+		ncr := cr.urlNormalized()
+		c.Config.Logger.Infof("looking for %v\nin %v", nr, ncr)
+
+		if nr.Project == nil && ncr.Project == nil {
+			c.Config.Logger.Info("Both Project fields null - considering equal.")
+		} else if nr.Project == nil || ncr.Project == nil {
+			c.Config.Logger.Info("Only one Project field is null - considering unequal.")
+			return false
+		} else if *nr.Project != *ncr.Project {
+			return false
+		}
+		if nr.IndexId == nil && ncr.IndexId == nil {
+			c.Config.Logger.Info("Both IndexId fields null - considering equal.")
+		} else if nr.IndexId == nil || ncr.IndexId == nil {
+			c.Config.Logger.Info("Only one IndexId field is null - considering unequal.")
+			return false
+		} else if *nr.IndexId != *ncr.IndexId {
+			return false
+		}
+		return true
+	}
+}
+
+type indexDiff struct {
+	// The diff should include one or the other of RequiresRecreate or UpdateOp.
+	RequiresRecreate bool
+	UpdateOp         indexApiOperation
+	FieldName        string // used for error logging
+}
+
+func convertFieldDiffsToIndexDiffs(config *dcl.Config, fds []*dcl.FieldDiff, opts []dcl.ApplyOption) ([]indexDiff, error) {
+	opNamesToFieldDiffs := make(map[string][]*dcl.FieldDiff)
+	// Map each operation name to the field diffs associated with it.
+	for _, fd := range fds {
+		for _, ro := range fd.ResultingOperation {
+			if fieldDiffs, ok := opNamesToFieldDiffs[ro]; ok {
+				fieldDiffs = append(fieldDiffs, fd)
+				opNamesToFieldDiffs[ro] = fieldDiffs
+			} else {
+				config.Logger.Infof("%s required due to diff: %v", ro, fd)
+				opNamesToFieldDiffs[ro] = []*dcl.FieldDiff{fd}
+			}
+		}
+	}
+	var diffs []indexDiff
+	// For each operation name, create a indexDiff which contains the operation.
+	for opName, fieldDiffs := range opNamesToFieldDiffs {
+		// Use the first field diff's field name for logging required recreate error.
+		diff := indexDiff{FieldName: fieldDiffs[0].FieldName}
+		if opName == "Recreate" {
+			diff.RequiresRecreate = true
+		} else {
+			apiOp, err := convertOpNameToIndexApiOperation(opName, fieldDiffs, opts...)
+			if err != nil {
+				return diffs, err
+			}
+			diff.UpdateOp = apiOp
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+func convertOpNameToIndexApiOperation(opName string, fieldDiffs []*dcl.FieldDiff, opts ...dcl.ApplyOption) (indexApiOperation, error) {
+	switch opName {
+
+	default:
+		return nil, fmt.Errorf("no such operation with name: %v", opName)
+	}
+}
+
+func extractIndexFields(r *Index) error {
+	return nil
+}
+func extractIndexPropertiesFields(r *Index, o *IndexProperties) error {
+	return nil
+}
+
+func postReadExtractIndexFields(r *Index) error {
+	return nil
+}
+func postReadExtractIndexPropertiesFields(r *Index, o *IndexProperties) error {
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package datastore
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLIndexSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Datastore/Index",
+			Description: "The Datastore Index resource",
+			StructName:  "Index",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a Index",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "index",
+						Required:    true,
+						Description: "A full instance of a Index",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a Index",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "index",
+						Required:    true,
+						Description: "A full instance of a Index",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a Index",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "index",
+						Required:    true,
+						Description: "A full instance of a Index",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all Index",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many Index",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"Index": &dcl.Component{
+					Title:           "Index",
+					ID:              "projects/{{project}}/indexes/{{index_id}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"kind",
+							"properties",
+							"project",
+						},
+						Properties: map[string]*dcl.Property{
+							"ancestor": &dcl.Property{
+								Type: "string",
+								GoName: "Ancestor",
+								GoType: "IndexAncestorEnum",
+								Description: "The index's ancestor mode, which defaults to NONE. Possible values: NONE, ALL_ANCESTORS",
+								Immutable: true,
+								ServerDefault: true,
+								Enum: []string{
+									"NONE",
+									"ALL_ANCESTORS",
+								},
+							},
+							"indexId": &dcl.Property{
+								Type: "string",
+								GoName: "IndexId",
+								Description: "The resource ID of the index. If unset, the index is identified by its kind, ancestor mode and properties.",
+								ServerGeneratedParameter: true,
+							},
+							"kind": &dcl.Property{
+								Type: "string",
+								GoName: "Kind",
+								Description: "The entity kind to which this index applies.",
+								Immutable: true,
+							},
+							"project": &dcl.Property{
+								Type: "string",
+								GoName: "Project",
+								Description: "Project ID.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field: "name",
+										Parent: true,
+									},
+								},
+							},
+							"properties": &dcl.Property{
+								Type: "array",
+								GoName: "Properties",
+								Description: "An ordered sequence of property names and their index attributes.",
+								Immutable: true,
+								SendEmpty: true,
+								ListType: "list",
+								Items: &dcl.Property{
+									Type: "object",
+									GoType: "IndexProperties",
+									Required: []string{
+										"name",
+										"direction",
+									},
+									Properties: map[string]*dcl.Property{
+										"direction": &dcl.Property{
+											Type: "string",
+											GoName: "Direction",
+											GoType: "IndexPropertiesDirectionEnum",
+											Description: "The indexed property's direction. Possible values: ASCENDING, DESCENDING",
+											Immutable: true,
+											Enum: []string{
+												"ASCENDING",
+												"DESCENDING",
+											},
+										},
+										"name": &dcl.Property{
+											Type: "string",
+											GoName: "Name",
+											Description: "The property name to index.",
+											Immutable: true,
+										},
+									},
+								},
+							},
+							"state": &dcl.Property{
+								Type: "string",
+								GoName: "State",
+								GoType: "IndexStateEnum",
+								ReadOnly: true,
+								Description: "The state of the index. Possible values: CREATING, READY, DELETING, ERROR",
+								Immutable: true,
+								Enum: []string{
+									"CREATING",
+									"READY",
+									"DELETING",
+									"ERROR",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package datastore -var YAML_index blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/datastore/index.yaml
+
+package datastore
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/datastore/index.yaml
+var YAML_index = []byte("info:\n  title: Datastore/Index\n  description: The Datastore Index resource\n  x-dcl-struct-name: Index\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a Index\n    parameters:\n    - name: index\n      required: true\n      description: A full instance of a Index\n  apply:\n    description: The function used to apply information about a Index\n    parameters:\n    - name: index\n      required: true\n      description: A full instance of a Index\n  delete:\n    description: The function used to delete a Index\n    parameters:\n    - name: index\n      required: true\n      description: A full instance of a Index\n  deleteAll:\n    description: The function used to delete all Index\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many Index\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    Index:\n      title: Index\n      x-dcl-id: projects/{{project}}/indexes/{{index_id}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - kind\n      - properties\n      - project\n      properties:\n        ancestor:\n          type: string\n          x-dcl-go-name: Ancestor\n          x-dcl-go-type: IndexAncestorEnum\n          description: 'The index''s ancestor mode, which defaults to NONE. Possible\n            values: NONE, ALL_ANCESTORS'\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n          enum:\n          - NONE\n          - ALL_ANCESTORS\n        indexId:\n          type: string\n          x-dcl-go-name: IndexId\n          description: The resource ID of the index. If unset, the index is identified\n            by its kind, ancestor mode and properties.\n          x-dcl-server-generated-parameter: true\n        kind:\n          type: string\n          x-dcl-go-name: Kind\n          description: The entity kind to which this index applies.\n          x-kubernetes-immutable: true\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: Project ID.\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        properties:\n          type: array\n          x-dcl-go-name: Properties\n          description: An ordered sequence of property names and their index attributes.\n          x-kubernetes-immutable: true\n          x-dcl-send-empty: true\n          x-dcl-list-type: list\n          items:\n            type: object\n            x-dcl-go-type: IndexProperties\n            required:\n            - name\n            - direction\n            properties:\n              direction:\n                type: string\n                x-dcl-go-name: Direction\n                x-dcl-go-type: IndexPropertiesDirectionEnum\n                description: 'The indexed property''s direction. Possible values:\n                  ASCENDING, DESCENDING'\n                x-kubernetes-immutable: true\n                enum:\n                - ASCENDING\n                - DESCENDING\n              name:\n                type: string\n                x-dcl-go-name: Name\n                description: The property name to index.\n                x-kubernetes-immutable: true\n        state:\n          type: string\n          x-dcl-go-name: State\n          x-dcl-go-type: IndexStateEnum\n          readOnly: true\n          description: 'The state of the index. Possible values: CREATING, READY,\n            DELETING, ERROR'\n          x-kubernetes-immutable: true\n          enum:\n          - CREATING\n          - READY\n          - DELETING\n          - ERROR\n")
+
+// 3876 bytes
+// MD5: ad96a121a6a24b42126e099403d3fa4c
@@ -857,7 +857,7 @@ func (c *Client) jobTriggerDiffsForRawDesired(ctx context.Context, rawDesired *J
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a JobTrigger resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve JobTrigger resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve JobTrigger resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that JobTrigger resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -871,6 +871,12 @@ func (c *Client) jobTriggerDiffsForRawDesired(ctx context.Context, rawDesired *J
 	if err := extractJobTriggerFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeJobTriggerInitialState(rawInitial, rawDesired)
@@ -888,7 +894,7 @@ func (c *Client) jobTriggerDiffsForRawDesired(ctx context.Context, rawDesired *J
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffJobTrigger(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeJobTriggerInitialState(rawInitial, rawDesired *JobTrigger) (*JobTrigger, error) {
@@ -10775,8 +10775,12 @@ func (l *DeidentifyTemplateList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *DeidentifyTemplateList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *DeidentifyTemplateList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeidentifyTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -10793,7 +10797,8 @@ func (l *DeidentifyTemplateList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListDeidentifyTemplate(ctx context.Context, location, parent string) (*DeidentifyTemplateList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DeidentifyTemplate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeidentifyTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListDeidentifyTemplateWithMaxResults(ctx, location, parent, DeidentifyTemplateMaxPage)
@@ -10801,7 +10806,11 @@ func (c *Client) ListDeidentifyTemplate(ctx context.Context, location, parent st
 }
 
 func (c *Client) ListDeidentifyTemplateWithMaxResults(ctx context.Context, location, parent string, pageSize int32) (*DeidentifyTemplateList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListDeidentifyTemplateWithPageToken(ctx, location, parent, pageSize, "")
+}
+
+func (c *Client) ListDeidentifyTemplateWithPageToken(ctx context.Context, location, parent string, pageSize int32, pageToken string) (*DeidentifyTemplateList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeidentifyTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -10809,7 +10818,7 @@ func (c *Client) ListDeidentifyTemplateWithMaxResults(ctx context.Context, locat
 		Location: &location,
 		Parent:   &parent,
 	}
-	items, token, err := c.listDeidentifyTemplate(ctx, r, "", pageSize)
+	items, token, err := c.listDeidentifyTemplate(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -10823,7 +10832,8 @@ func (c *Client) ListDeidentifyTemplateWithMaxResults(ctx context.Context, locat
 
 func (c *Client) GetDeidentifyTemplate(ctx context.Context, r *DeidentifyTemplate) (*DeidentifyTemplate, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DeidentifyTemplate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeidentifyTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -10839,7 +10849,7 @@ func (c *Client) GetDeidentifyTemplate(ctx context.Context, r *DeidentifyTemplat
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalDeidentifyTemplate(b, c, r)
 	if err != nil {
@@ -10865,7 +10875,8 @@ func (c *Client) GetDeidentifyTemplate(ctx context.Context, r *DeidentifyTemplat
 
 func (c *Client) DeleteDeidentifyTemplate(ctx context.Context, r *DeidentifyTemplate) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DeidentifyTemplate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeidentifyTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -10873,7 +10884,13 @@ func (c *Client) DeleteDeidentifyTemplate(ctx context.Context, r *DeidentifyTemp
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting DeidentifyTemplate...")
 	deleteOp := deleteDeidentifyTemplateOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteDeidentifyTemplateAsync(ctx context.Context, r *DeidentifyTemplate) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteDeidentifyTemplate(ctx, r)
+	})
 }
 
 // DeleteAllDeidentifyTemplate deletes all resources that the filter functions returns true on.
@@ -10901,10 +10918,11 @@ func (c *Client) DeleteAllDeidentifyTemplate(ctx context.Context, location, pare
 }
 
 func (c *Client) ApplyDeidentifyTemplate(ctx context.Context, rawDesired *DeidentifyTemplate, opts ...dcl.ApplyOption) (*DeidentifyTemplate, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeidentifyTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DeidentifyTemplate{}).Describe())
 	var resultNewState *DeidentifyTemplate
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyDeidentifyTemplateHelper(c, ctx, rawDesired, opts...)
@@ -10922,6 +10940,38 @@ func (c *Client) ApplyDeidentifyTemplate(ctx context.Context, rawDesired *Deiden
 	return resultNewState, err
 }
 
+func (c *Client) ApplyDeidentifyTemplateAsync(ctx context.Context, rawDesired *DeidentifyTemplate, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyDeidentifyTemplate(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffDeidentifyTemplate returns the field-level differences between rawDesired and the
+// live DeidentifyTemplate without modifying it. If the DeidentifyTemplate does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffDeidentifyTemplate(ctx context.Context, rawDesired *DeidentifyTemplate, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DeidentifyTemplate{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DeidentifyTemplate{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractDeidentifyTemplateFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.deidentifyTemplateDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("DeidentifyTemplate %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyDeidentifyTemplateHelper(c *Client, ctx context.Context, rawDesired *DeidentifyTemplate, opts ...dcl.ApplyOption) (*DeidentifyTemplate, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyDeidentifyTemplate...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -10930,6 +10980,9 @@ func applyDeidentifyTemplateHelper(c *Client, ctx context.Context, rawDesired *D
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractDeidentifyTemplateFields(rawDesired); err != nil {
 		return nil, err
@@ -10988,7 +11041,7 @@ func applyDeidentifyTemplateHelper(c *Client, ctx context.Context, rawDesired *D
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
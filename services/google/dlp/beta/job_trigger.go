@@ -3664,8 +3664,12 @@ func (l *JobTriggerList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *JobTriggerList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *JobTriggerList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&JobTrigger{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -3682,7 +3686,8 @@ func (l *JobTriggerList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListJobTrigger(ctx context.Context, location, parent string) (*JobTriggerList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&JobTrigger{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&JobTrigger{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListJobTriggerWithMaxResults(ctx, location, parent, JobTriggerMaxPage)
@@ -3690,7 +3695,11 @@ func (c *Client) ListJobTrigger(ctx context.Context, location, parent string) (*
 }
 
 func (c *Client) ListJobTriggerWithMaxResults(ctx context.Context, location, parent string, pageSize int32) (*JobTriggerList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListJobTriggerWithPageToken(ctx, location, parent, pageSize, "")
+}
+
+func (c *Client) ListJobTriggerWithPageToken(ctx context.Context, location, parent string, pageSize int32, pageToken string) (*JobTriggerList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&JobTrigger{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -3698,7 +3707,7 @@ func (c *Client) ListJobTriggerWithMaxResults(ctx context.Context, location, par
 		Location: &location,
 		Parent:   &parent,
 	}
-	items, token, err := c.listJobTrigger(ctx, r, "", pageSize)
+	items, token, err := c.listJobTrigger(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -3712,7 +3721,8 @@ func (c *Client) ListJobTriggerWithMaxResults(ctx context.Context, location, par
 
 func (c *Client) GetJobTrigger(ctx context.Context, r *JobTrigger) (*JobTrigger, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&JobTrigger{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&JobTrigger{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -3728,7 +3738,7 @@ func (c *Client) GetJobTrigger(ctx context.Context, r *JobTrigger) (*JobTrigger,
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalJobTrigger(b, c, r)
 	if err != nil {
@@ -3754,7 +3764,8 @@ func (c *Client) GetJobTrigger(ctx context.Context, r *JobTrigger) (*JobTrigger,
 
 func (c *Client) DeleteJobTrigger(ctx context.Context, r *JobTrigger) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&JobTrigger{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&JobTrigger{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -3762,7 +3773,13 @@ func (c *Client) DeleteJobTrigger(ctx context.Context, r *JobTrigger) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting JobTrigger...")
 	deleteOp := deleteJobTriggerOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteJobTriggerAsync(ctx context.Context, r *JobTrigger) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteJobTrigger(ctx, r)
+	})
 }
 
 // DeleteAllJobTrigger deletes all resources that the filter functions returns true on.
@@ -3790,10 +3807,11 @@ func (c *Client) DeleteAllJobTrigger(ctx context.Context, location, parent strin
 }
 
 func (c *Client) ApplyJobTrigger(ctx context.Context, rawDesired *JobTrigger, opts ...dcl.ApplyOption) (*JobTrigger, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&JobTrigger{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&JobTrigger{}).Describe())
 	var resultNewState *JobTrigger
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyJobTriggerHelper(c, ctx, rawDesired, opts...)
@@ -3811,6 +3829,38 @@ func (c *Client) ApplyJobTrigger(ctx context.Context, rawDesired *JobTrigger, op
 	return resultNewState, err
 }
 
+func (c *Client) ApplyJobTriggerAsync(ctx context.Context, rawDesired *JobTrigger, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyJobTrigger(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffJobTrigger returns the field-level differences between rawDesired and the
+// live JobTrigger without modifying it. If the JobTrigger does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffJobTrigger(ctx context.Context, rawDesired *JobTrigger, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&JobTrigger{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&JobTrigger{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractJobTriggerFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.jobTriggerDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("JobTrigger %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyJobTriggerHelper(c *Client, ctx context.Context, rawDesired *JobTrigger, opts ...dcl.ApplyOption) (*JobTrigger, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyJobTrigger...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -3819,6 +3869,9 @@ func applyJobTriggerHelper(c *Client, ctx context.Context, rawDesired *JobTrigge
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractJobTriggerFields(rawDesired); err != nil {
 		return nil, err
@@ -3877,7 +3930,7 @@ func applyJobTriggerHelper(c *Client, ctx context.Context, rawDesired *JobTrigge
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
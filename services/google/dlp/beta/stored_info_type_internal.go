@@ -449,7 +449,7 @@ func (c *Client) storedInfoTypeDiffsForRawDesired(ctx context.Context, rawDesire
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a StoredInfoType resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve StoredInfoType resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve StoredInfoType resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that StoredInfoType resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -463,6 +463,12 @@ func (c *Client) storedInfoTypeDiffsForRawDesired(ctx context.Context, rawDesire
 	if err := extractStoredInfoTypeFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeStoredInfoTypeInitialState(rawInitial, rawDesired)
@@ -480,7 +486,7 @@ func (c *Client) storedInfoTypeDiffsForRawDesired(ctx context.Context, rawDesire
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffStoredInfoType(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func storedInfoTypeFromParent(r *StoredInfoType, parent map[string]interface{}) (map[string]interface{}, error) {
@@ -2153,7 +2153,7 @@ func (c *Client) deidentifyTemplateDiffsForRawDesired(ctx context.Context, rawDe
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a DeidentifyTemplate resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve DeidentifyTemplate resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve DeidentifyTemplate resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that DeidentifyTemplate resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -2167,6 +2167,12 @@ func (c *Client) deidentifyTemplateDiffsForRawDesired(ctx context.Context, rawDe
 	if err := extractDeidentifyTemplateFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeDeidentifyTemplateInitialState(rawInitial, rawDesired)
@@ -2184,7 +2190,7 @@ func (c *Client) deidentifyTemplateDiffsForRawDesired(ctx context.Context, rawDe
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffDeidentifyTemplate(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeDeidentifyTemplateInitialState(rawInitial, rawDesired *DeidentifyTemplate) (*DeidentifyTemplate, error) {
@@ -1583,8 +1583,12 @@ func (l *InspectTemplateList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *InspectTemplateList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *InspectTemplateList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InspectTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -1601,7 +1605,8 @@ func (l *InspectTemplateList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListInspectTemplate(ctx context.Context, location, parent string) (*InspectTemplateList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InspectTemplate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InspectTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListInspectTemplateWithMaxResults(ctx, location, parent, InspectTemplateMaxPage)
@@ -1609,7 +1614,11 @@ func (c *Client) ListInspectTemplate(ctx context.Context, location, parent strin
 }
 
 func (c *Client) ListInspectTemplateWithMaxResults(ctx context.Context, location, parent string, pageSize int32) (*InspectTemplateList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListInspectTemplateWithPageToken(ctx, location, parent, pageSize, "")
+}
+
+func (c *Client) ListInspectTemplateWithPageToken(ctx context.Context, location, parent string, pageSize int32, pageToken string) (*InspectTemplateList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InspectTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -1617,7 +1626,7 @@ func (c *Client) ListInspectTemplateWithMaxResults(ctx context.Context, location
 		Location: &location,
 		Parent:   &parent,
 	}
-	items, token, err := c.listInspectTemplate(ctx, r, "", pageSize)
+	items, token, err := c.listInspectTemplate(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -1631,7 +1640,8 @@ func (c *Client) ListInspectTemplateWithMaxResults(ctx context.Context, location
 
 func (c *Client) GetInspectTemplate(ctx context.Context, r *InspectTemplate) (*InspectTemplate, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InspectTemplate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InspectTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -1647,7 +1657,7 @@ func (c *Client) GetInspectTemplate(ctx context.Context, r *InspectTemplate) (*I
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalInspectTemplate(b, c, r)
 	if err != nil {
@@ -1673,7 +1683,8 @@ func (c *Client) GetInspectTemplate(ctx context.Context, r *InspectTemplate) (*I
 
 func (c *Client) DeleteInspectTemplate(ctx context.Context, r *InspectTemplate) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InspectTemplate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InspectTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -1681,7 +1692,13 @@ func (c *Client) DeleteInspectTemplate(ctx context.Context, r *InspectTemplate)
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting InspectTemplate...")
 	deleteOp := deleteInspectTemplateOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteInspectTemplateAsync(ctx context.Context, r *InspectTemplate) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteInspectTemplate(ctx, r)
+	})
 }
 
 // DeleteAllInspectTemplate deletes all resources that the filter functions returns true on.
@@ -1709,10 +1726,11 @@ func (c *Client) DeleteAllInspectTemplate(ctx context.Context, location, parent
 }
 
 func (c *Client) ApplyInspectTemplate(ctx context.Context, rawDesired *InspectTemplate, opts ...dcl.ApplyOption) (*InspectTemplate, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InspectTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InspectTemplate{}).Describe())
 	var resultNewState *InspectTemplate
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyInspectTemplateHelper(c, ctx, rawDesired, opts...)
@@ -1730,6 +1748,38 @@ func (c *Client) ApplyInspectTemplate(ctx context.Context, rawDesired *InspectTe
 	return resultNewState, err
 }
 
+func (c *Client) ApplyInspectTemplateAsync(ctx context.Context, rawDesired *InspectTemplate, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyInspectTemplate(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffInspectTemplate returns the field-level differences between rawDesired and the
+// live InspectTemplate without modifying it. If the InspectTemplate does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffInspectTemplate(ctx context.Context, rawDesired *InspectTemplate, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&InspectTemplate{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&InspectTemplate{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractInspectTemplateFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.inspectTemplateDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("InspectTemplate %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyInspectTemplateHelper(c *Client, ctx context.Context, rawDesired *InspectTemplate, opts ...dcl.ApplyOption) (*InspectTemplate, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyInspectTemplate...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -1738,6 +1788,9 @@ func applyInspectTemplateHelper(c *Client, ctx context.Context, rawDesired *Insp
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractInspectTemplateFields(rawDesired); err != nil {
 		return nil, err
@@ -1796,7 +1849,7 @@ func applyInspectTemplateHelper(c *Client, ctx context.Context, rawDesired *Insp
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -549,7 +549,7 @@ func (c *Client) inspectTemplateDiffsForRawDesired(ctx context.Context, rawDesir
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a InspectTemplate resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve InspectTemplate resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve InspectTemplate resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that InspectTemplate resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -563,6 +563,12 @@ func (c *Client) inspectTemplateDiffsForRawDesired(ctx context.Context, rawDesir
 	if err := extractInspectTemplateFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeInspectTemplateInitialState(rawInitial, rawDesired)
@@ -580,7 +586,7 @@ func (c *Client) inspectTemplateDiffsForRawDesired(ctx context.Context, rawDesir
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffInspectTemplate(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeInspectTemplateInitialState(rawInitial, rawDesired *InspectTemplate) (*InspectTemplate, error) {
@@ -568,8 +568,12 @@ func (l *StoredInfoTypeList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *StoredInfoTypeList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *StoredInfoTypeList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&StoredInfoType{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -586,7 +590,8 @@ func (l *StoredInfoTypeList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListStoredInfoType(ctx context.Context, location, parent string) (*StoredInfoTypeList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&StoredInfoType{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&StoredInfoType{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListStoredInfoTypeWithMaxResults(ctx, location, parent, StoredInfoTypeMaxPage)
@@ -594,7 +599,11 @@ func (c *Client) ListStoredInfoType(ctx context.Context, location, parent string
 }
 
 func (c *Client) ListStoredInfoTypeWithMaxResults(ctx context.Context, location, parent string, pageSize int32) (*StoredInfoTypeList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListStoredInfoTypeWithPageToken(ctx, location, parent, pageSize, "")
+}
+
+func (c *Client) ListStoredInfoTypeWithPageToken(ctx context.Context, location, parent string, pageSize int32, pageToken string) (*StoredInfoTypeList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&StoredInfoType{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -602,7 +611,7 @@ func (c *Client) ListStoredInfoTypeWithMaxResults(ctx context.Context, location,
 		Location: &location,
 		Parent:   &parent,
 	}
-	items, token, err := c.listStoredInfoType(ctx, r, "", pageSize)
+	items, token, err := c.listStoredInfoType(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -616,7 +625,8 @@ func (c *Client) ListStoredInfoTypeWithMaxResults(ctx context.Context, location,
 
 func (c *Client) GetStoredInfoType(ctx context.Context, r *StoredInfoType) (*StoredInfoType, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&StoredInfoType{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&StoredInfoType{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -632,7 +642,7 @@ func (c *Client) GetStoredInfoType(ctx context.Context, r *StoredInfoType) (*Sto
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	var m map[string]interface{}
 	if err := json.Unmarshal(b, &m); err != nil {
@@ -671,7 +681,8 @@ func (c *Client) GetStoredInfoType(ctx context.Context, r *StoredInfoType) (*Sto
 
 func (c *Client) DeleteStoredInfoType(ctx context.Context, r *StoredInfoType) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&StoredInfoType{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&StoredInfoType{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -679,7 +690,13 @@ func (c *Client) DeleteStoredInfoType(ctx context.Context, r *StoredInfoType) er
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting StoredInfoType...")
 	deleteOp := deleteStoredInfoTypeOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteStoredInfoTypeAsync(ctx context.Context, r *StoredInfoType) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteStoredInfoType(ctx, r)
+	})
 }
 
 // DeleteAllStoredInfoType deletes all resources that the filter functions returns true on.
@@ -707,10 +724,11 @@ func (c *Client) DeleteAllStoredInfoType(ctx context.Context, location, parent s
 }
 
 func (c *Client) ApplyStoredInfoType(ctx context.Context, rawDesired *StoredInfoType, opts ...dcl.ApplyOption) (*StoredInfoType, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&StoredInfoType{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&StoredInfoType{}).Describe())
 	var resultNewState *StoredInfoType
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyStoredInfoTypeHelper(c, ctx, rawDesired, opts...)
@@ -728,6 +746,38 @@ func (c *Client) ApplyStoredInfoType(ctx context.Context, rawDesired *StoredInfo
 	return resultNewState, err
 }
 
+func (c *Client) ApplyStoredInfoTypeAsync(ctx context.Context, rawDesired *StoredInfoType, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyStoredInfoType(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffStoredInfoType returns the field-level differences between rawDesired and the
+// live StoredInfoType without modifying it. If the StoredInfoType does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffStoredInfoType(ctx context.Context, rawDesired *StoredInfoType, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&StoredInfoType{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&StoredInfoType{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractStoredInfoTypeFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.storedInfoTypeDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("StoredInfoType %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyStoredInfoTypeHelper(c *Client, ctx context.Context, rawDesired *StoredInfoType, opts ...dcl.ApplyOption) (*StoredInfoType, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyStoredInfoType...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -736,6 +786,9 @@ func applyStoredInfoTypeHelper(c *Client, ctx context.Context, rawDesired *Store
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractStoredInfoTypeFields(rawDesired); err != nil {
 		return nil, err
@@ -794,7 +847,7 @@ func applyStoredInfoTypeHelper(c *Client, ctx context.Context, rawDesired *Store
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -0,0 +1,332 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package dns contains handwritten support code for the Cloud DNS service.
+package dns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl/operations"
+)
+
+// expandManagedZoneReverseLookup expands ReverseLookup into the empty
+// reverseLookupConfig object the API uses to mark a reverse lookup zone.
+func expandManagedZoneReverseLookup(_ *Client, f *bool, _ *ManagedZone) (map[string]interface{}, error) {
+	if f == nil || !*f {
+		return nil, nil
+	}
+	return map[string]interface{}{}, nil
+}
+
+// flattenManagedZoneReverseLookup reports whether the API returned a
+// reverseLookupConfig object.
+func flattenManagedZoneReverseLookup(_ *Client, i interface{}, _ *ManagedZone) *bool {
+	_, ok := i.(map[string]interface{})
+	return dcl.Bool(ok)
+}
+
+// changeBatchWindow is how long the first record set change for a managed zone
+// waits for others to join it before the batch is sent as a single Change.
+var changeBatchWindow = 100 * time.Millisecond
+
+// changeBatchKey identifies the managed zone a batch applies to. Changes made
+// through different configs are never batched together, since they may use
+// different credentials or endpoints.
+type changeBatchKey struct {
+	config      *dcl.Config
+	project     string
+	managedZone string
+}
+
+// zoneChanges holds the batch currently accepting record set changes for a
+// managed zone, and serializes the Changes sent to that zone.
+type zoneChanges struct {
+	pending *changeBatch
+	sending sync.Mutex
+	// users is the number of calls using the zone. The zone is forgotten when
+	// it drops to zero.
+	users int
+}
+
+// changeBatch is a set of record set changes that will be sent to the API as
+// one atomic Change.
+type changeBatch struct {
+	changes []*recordSetChange
+	// sent is closed when the batch stops accepting changes.
+	sent chan struct{}
+}
+
+// recordSetChange is the change one call makes to a record set, and its
+// result.
+type recordSetChange struct {
+	// record is the "name/type" of the record set. A Change may only touch
+	// each record set once.
+	record    string
+	additions []map[string]interface{}
+	deletions []map[string]interface{}
+	done      chan struct{}
+	err       error
+}
+
+var (
+	zoneChangesMu  sync.Mutex
+	allZoneChanges = make(map[changeBatchKey]*zoneChanges)
+)
+
+// touches reports whether the batch already changes the given record set.
+func (b *changeBatch) touches(record string) bool {
+	for _, ch := range b.changes {
+		if ch.record == record {
+			return true
+		}
+	}
+	return false
+}
+
+// remove takes ch out of the batch, and reports whether it was in it.
+func (b *changeBatch) remove(ch *recordSetChange) bool {
+	for i, other := range b.changes {
+		if other == ch {
+			b.changes = append(b.changes[:i], b.changes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// changeResourceRecordSets adds the given additions and deletions for r to
+// the pending Change for r's managed zone, and blocks until that Change is
+// done. Concurrent applies within a zone are coalesced this way so that they
+// reach the API as one Change instead of one Change per record set.
+//
+// If ctx is done before the Change is sent, the additions and deletions are
+// taken out of it and ctx's error is returned. Once the Change is sent, its
+// result is always waited for, since the records may already have changed.
+func (c *Client) changeResourceRecordSets(ctx context.Context, r *ResourceRecordSet, additions, deletions []map[string]interface{}) error {
+	nr := r.urlNormalized()
+	key := changeBatchKey{
+		config:      c.Config,
+		project:     dcl.ValueOrEmptyString(nr.Project),
+		managedZone: dcl.ValueOrEmptyString(nr.ManagedZone),
+	}
+	ch := &recordSetChange{
+		record:    fmt.Sprintf("%s/%s", dcl.ValueOrEmptyString(nr.DnsName), dcl.ValueOrEmptyString(nr.DnsType)),
+		additions: additions,
+		deletions: deletions,
+		done:      make(chan struct{}),
+	}
+
+	zoneChangesMu.Lock()
+	zc, ok := allZoneChanges[key]
+	if !ok {
+		zc = &zoneChanges{}
+		allZoneChanges[key] = zc
+	}
+	zc.users++
+	zoneChangesMu.Unlock()
+	defer func() {
+		zoneChangesMu.Lock()
+		defer zoneChangesMu.Unlock()
+		if zc.users--; zc.users == 0 {
+			delete(allZoneChanges, key)
+		}
+	}()
+
+	var b *changeBatch
+	for {
+		zoneChangesMu.Lock()
+		b = zc.pending
+		if b != nil && b.touches(ch.record) {
+			// The pending batch already touches this record set, so wait for it
+			// to be sent and join the next one.
+			zoneChangesMu.Unlock()
+			select {
+			case <-b.sent:
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if b == nil {
+			b = &changeBatch{sent: make(chan struct{})}
+			zc.pending = b
+			// The batch is sent with the values of the call that started it,
+			// but not its deadline, since other calls wait for it too.
+			sendCtx := dcl.DetachContext(ctx)
+			time.AfterFunc(changeBatchWindow, func() { c.sendChangeBatch(sendCtx, r, zc, b) })
+		}
+		b.changes = append(b.changes, ch)
+		zoneChangesMu.Unlock()
+		break
+	}
+
+	select {
+	case <-ch.done:
+		return ch.err
+	case <-ctx.Done():
+	}
+	zoneChangesMu.Lock()
+	removed := zc.pending == b && b.remove(ch)
+	zoneChangesMu.Unlock()
+	if removed {
+		return ctx.Err()
+	}
+	<-ch.done
+	return ch.err
+}
+
+// sendChangeBatch sends b as a single Change and waits for it to be done. If
+// the Change is rejected, its record set changes are sent again one at a
+// time, so that one bad change does not fail the others. The result of each
+// change is recorded for the call that made it.
+func (c *Client) sendChangeBatch(ctx context.Context, r *ResourceRecordSet, zc *zoneChanges, b *changeBatch) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ResourceRecordSet{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// Changes to a zone are sent one at a time, so that a batch's deletions are
+	// checked against the results of the batch before it.
+	zc.sending.Lock()
+	zoneChangesMu.Lock()
+	if zc.pending == b {
+		zc.pending = nil
+	}
+	close(b.sent)
+	changes := b.changes
+	zoneChangesMu.Unlock()
+
+	if len(changes) > 0 {
+		rejected, err := c.sendChange(ctx, r, changes)
+		if rejected && len(changes) > 1 {
+			c.Config.Logger.WarningWithContextf(ctx, "Change with %d record set changes was rejected, sending them one at a time: %v", len(changes), err)
+			for _, ch := range changes {
+				_, ch.err = c.sendChange(ctx, r, []*recordSetChange{ch})
+			}
+		} else {
+			for _, ch := range changes {
+				ch.err = err
+			}
+		}
+	}
+	zc.sending.Unlock()
+
+	for _, ch := range changes {
+		close(ch.done)
+	}
+}
+
+// sendChange sends the given record set changes as a single Change and waits
+// for it to be done. It reports whether the API rejected the Change, in which
+// case none of the changes were made.
+func (c *Client) sendChange(ctx context.Context, r *ResourceRecordSet, changes []*recordSetChange) (bool, error) {
+	var additions, deletions []map[string]interface{}
+	for _, ch := range changes {
+		additions = append(additions, ch.additions...)
+		deletions = append(deletions, ch.deletions...)
+	}
+	req := make(map[string]interface{})
+	if len(additions) > 0 {
+		req["additions"] = additions
+	}
+	if len(deletions) > 0 {
+		req["deletions"] = deletions
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return false, err
+	}
+
+	u, err := r.createURL(c.Config.BasePath)
+	if err != nil {
+		return false, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Sending change with %d additions and %d deletions", len(additions), len(deletions))
+	// A Change is rejected with a 412 when its deletions do not match the
+	// record sets in the zone, which sending it again cannot fix.
+	cfg := c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{412: {Retryable: false}}))
+	resp, err := dcl.SendRequest(ctx, cfg, "POST", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	if err != nil {
+		// Only a client error guarantees the Change was not made.
+		code := dcl.ErrorCode(err)
+		return code >= 400 && code < 500, err
+	}
+
+	var o operations.DNSOperation
+	if err := dcl.ParseResponse(resp.Response, &o); err != nil {
+		return false, err
+	}
+	nr := r.urlNormalized()
+	return false, o.Wait(context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), c.Config, dcl.ValueOrEmptyString(nr.Project), dcl.ValueOrEmptyString(nr.ManagedZone))
+}
+
+func (op *createResourceRecordSetOperation) do(ctx context.Context, r *ResourceRecordSet, c *Client) error {
+	c.Config.Logger.InfoWithContextf(ctx, "Attempting to create %v", r)
+	m, err := expandResourceRecordSet(c, r)
+	if err != nil {
+		return err
+	}
+	if err := c.changeResourceRecordSets(ctx, r, []map[string]interface{}{m}, nil); err != nil {
+		return err
+	}
+	op.response = m
+
+	if _, err := c.GetResourceRecordSet(ctx, r); err != nil {
+		c.Config.Logger.WarningWithContextf(ctx, "get returned error: %v", err)
+		return err
+	}
+	return nil
+}
+
+// do replaces the existing record set with the desired one. The deletion and
+// addition are sent in the same Change, so the record set is never absent.
+func (op *updateResourceRecordSetChangeOperation) do(ctx context.Context, r *ResourceRecordSet, c *Client) error {
+	current, err := c.GetResourceRecordSet(ctx, r)
+	if err != nil {
+		return err
+	}
+	deletion, err := expandResourceRecordSet(c, current)
+	if err != nil {
+		return err
+	}
+	addition, err := expandResourceRecordSet(c, r)
+	if err != nil {
+		return err
+	}
+	return c.changeResourceRecordSets(ctx, r, []map[string]interface{}{addition}, []map[string]interface{}{deletion})
+}
+
+func (op *deleteResourceRecordSetOperation) do(ctx context.Context, r *ResourceRecordSet, c *Client) error {
+	current, err := c.GetResourceRecordSet(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			c.Config.Logger.InfoWithContextf(ctx, "ResourceRecordSet not found, returning. Original error: %v", err)
+			return nil
+		}
+		c.Config.Logger.WarningWithContextf(ctx, "GetResourceRecordSet checking for existence. error: %v", err)
+		return err
+	}
+	deletion, err := expandResourceRecordSet(c, current)
+	if err != nil {
+		return err
+	}
+	if err := c.changeResourceRecordSets(ctx, r, nil, []map[string]interface{}{deletion}); err != nil {
+		return fmt.Errorf("failed to delete ResourceRecordSet: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/google/go-cmp/cmp"
+)
+
+// changeServer serves Changes for a managed zone, rejecting those that add a
+// record set named "bad.", and records the record set names of every Change
+// it receives.
+type changeServer struct {
+	mu      sync.Mutex
+	changes []string
+}
+
+func (s *changeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "GET" {
+		fmt.Fprint(w, `{"id":"1","status":"done"}`)
+		return
+	}
+	var req struct {
+		Additions []struct {
+			Name string `json:"name"`
+		} `json:"additions"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var names []string
+	for _, a := range req.Additions {
+		names = append(names, a.Name)
+	}
+	sort.Strings(names)
+	s.mu.Lock()
+	s.changes = append(s.changes, strings.Join(names, ","))
+	s.mu.Unlock()
+	for _, n := range names {
+		if n == "bad." {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			fmt.Fprint(w, `{"error":{"code":412,"message":"precondition not met"}}`)
+			return
+		}
+	}
+	fmt.Fprint(w, `{"id":"1","status":"pending"}`)
+}
+
+func newChangeTestClient(t *testing.T) (*Client, *changeServer) {
+	s := &changeServer{}
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	return NewClient(dcl.NewConfig(dcl.WithBasePath(srv.URL+"/"), dcl.WithHTTPClient(srv.Client()))), s
+}
+
+// addRecordSet adds a record set with the given name to the test zone.
+func addRecordSet(ctx context.Context, c *Client, name string) error {
+	r := &ResourceRecordSet{
+		Project:     dcl.String("project"),
+		ManagedZone: dcl.String("zone"),
+		DnsName:     dcl.String(name),
+		DnsType:     dcl.String("A"),
+	}
+	return c.changeResourceRecordSets(ctx, r, []map[string]interface{}{{"name": name, "type": "A"}}, nil)
+}
+
+func checkZoneChangesForgotten(t *testing.T) {
+	t.Helper()
+	zoneChangesMu.Lock()
+	defer zoneChangesMu.Unlock()
+	if len(allZoneChanges) != 0 {
+		t.Errorf("allZoneChanges has %d zones after every change is done, want 0", len(allZoneChanges))
+	}
+}
+
+func TestChangeResourceRecordSets(t *testing.T) {
+	tests := []struct {
+		name        string
+		records     []string
+		wantChanges []string
+		wantErrs    []string
+	}{
+		{
+			name:        "concurrent changes are batched",
+			records:     []string{"a.", "b.", "c."},
+			wantChanges: []string{"a.,b.,c."},
+		},
+		{
+			name:        "changes to the same record set are not batched",
+			records:     []string{"a.", "a."},
+			wantChanges: []string{"a.", "a."},
+		},
+		{
+			name:        "rejected batch is sent again one change at a time",
+			records:     []string{"a.", "bad.", "c."},
+			wantChanges: []string{"a.", "a.,bad.,c.", "bad.", "c."},
+			wantErrs:    []string{"bad."},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, s := newChangeTestClient(t)
+			errs := make([]error, len(tc.records))
+			var wg sync.WaitGroup
+			for i, name := range tc.records {
+				wg.Add(1)
+				go func(i int, name string) {
+					defer wg.Done()
+					errs[i] = addRecordSet(context.Background(), c, name)
+				}(i, name)
+			}
+			wg.Wait()
+
+			var gotErrs []string
+			for i, err := range errs {
+				if err != nil {
+					if dcl.ErrorCode(err) != http.StatusPreconditionFailed {
+						t.Errorf("adding %s returned error %v, want a 412 error", tc.records[i], err)
+					}
+					gotErrs = append(gotErrs, tc.records[i])
+				}
+			}
+			if diff := cmp.Diff(tc.wantErrs, gotErrs); diff != "" {
+				t.Errorf("failed changes diff (-want +got):\n%s", diff)
+			}
+			sort.Strings(s.changes)
+			if diff := cmp.Diff(tc.wantChanges, s.changes); diff != "" {
+				t.Errorf("sent Changes diff (-want +got):\n%s", diff)
+			}
+			checkZoneChangesForgotten(t)
+		})
+	}
+}
+
+func TestChangeResourceRecordSetsCancelled(t *testing.T) {
+	defer func(w time.Duration) { changeBatchWindow = w }(changeBatchWindow)
+	changeBatchWindow = 200 * time.Millisecond
+
+	tests := []struct {
+		name string
+		// cancelled is the index of the call whose context is cancelled while
+		// its batch waits to be sent.
+		cancelled int
+	}{
+		{name: "first call cancelled", cancelled: 0},
+		{name: "later call cancelled", cancelled: 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, s := newChangeTestClient(t)
+			records := []string{"a.", "b."}
+			errs := make([]error, len(records))
+			ctx, cancel := context.WithCancel(context.Background())
+			var wg sync.WaitGroup
+			for i, name := range records {
+				callCtx := context.Background()
+				if i == tc.cancelled {
+					callCtx = ctx
+				}
+				wg.Add(1)
+				go func(i int, name string) {
+					defer wg.Done()
+					errs[i] = addRecordSet(callCtx, c, name)
+				}(i, name)
+				// Start the calls in order.
+				time.Sleep(20 * time.Millisecond)
+			}
+			cancel()
+			wg.Wait()
+
+			for i, err := range errs {
+				if i == tc.cancelled {
+					if err != context.Canceled {
+						t.Errorf("cancelled call returned error %v, want %v", err, context.Canceled)
+					}
+				} else if err != nil {
+					t.Errorf("adding %s returned error %v, want nil", records[i], err)
+				}
+			}
+			want := []string{records[1-tc.cancelled]}
+			if diff := cmp.Diff(want, s.changes); diff != "" {
+				t.Errorf("sent Changes diff (-want +got):\n%s", diff)
+			}
+			checkZoneChangesForgotten(t)
+		})
+	}
+}
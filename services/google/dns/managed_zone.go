@@ -0,0 +1,987 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"google.golang.org/api/googleapi"
+)
+
+type ManagedZone struct {
+	Description             *string                             `json:"description"`
+	DnsName                 *string                             `json:"dnsName"`
+	DnssecConfig            *ManagedZoneDnssecConfig            `json:"dnssecConfig"`
+	Name                    *string                             `json:"name"`
+	NameServers             []string                            `json:"nameServers"`
+	Labels                  map[string]string                   `json:"labels"`
+	Visibility              *ManagedZoneVisibilityEnum          `json:"visibility"`
+	PrivateVisibilityConfig *ManagedZonePrivateVisibilityConfig `json:"privateVisibilityConfig"`
+	ForwardingConfig        *ManagedZoneForwardingConfig        `json:"forwardingConfig"`
+	ReverseLookup           *bool                               `json:"reverseLookup"`
+	PeeringConfig           *ManagedZonePeeringConfig           `json:"peeringConfig"`
+	Project                 *string                             `json:"project"`
+}
+
+func (r *ManagedZone) String() string {
+	return dcl.SprintResource(r)
+}
+
+// The enum ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum.
+type ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum string
+
+// ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnumRef returns a *ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnumRef(s string) *ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum {
+	v := ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum(s)
+	return &v
+}
+
+func (v ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"ecdsap256sha256", "ecdsap384sha384", "rsasha1", "rsasha256", "rsasha512"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum.
+type ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum string
+
+// ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnumRef returns a *ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnumRef(s string) *ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum {
+	v := ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum(s)
+	return &v
+}
+
+func (v ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"keySigning", "zoneSigning"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum ManagedZoneDnssecConfigNonExistenceEnum.
+type ManagedZoneDnssecConfigNonExistenceEnum string
+
+// ManagedZoneDnssecConfigNonExistenceEnumRef returns a *ManagedZoneDnssecConfigNonExistenceEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func ManagedZoneDnssecConfigNonExistenceEnumRef(s string) *ManagedZoneDnssecConfigNonExistenceEnum {
+	v := ManagedZoneDnssecConfigNonExistenceEnum(s)
+	return &v
+}
+
+func (v ManagedZoneDnssecConfigNonExistenceEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"nsec", "nsec3"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "ManagedZoneDnssecConfigNonExistenceEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum ManagedZoneDnssecConfigStateEnum.
+type ManagedZoneDnssecConfigStateEnum string
+
+// ManagedZoneDnssecConfigStateEnumRef returns a *ManagedZoneDnssecConfigStateEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func ManagedZoneDnssecConfigStateEnumRef(s string) *ManagedZoneDnssecConfigStateEnum {
+	v := ManagedZoneDnssecConfigStateEnum(s)
+	return &v
+}
+
+func (v ManagedZoneDnssecConfigStateEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"off", "on", "transfer"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "ManagedZoneDnssecConfigStateEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum.
+type ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum string
+
+// ManagedZoneForwardingConfigTargetNameServersForwardingPathEnumRef returns a *ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func ManagedZoneForwardingConfigTargetNameServersForwardingPathEnumRef(s string) *ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum {
+	v := ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum(s)
+	return &v
+}
+
+func (v ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"default", "private"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum ManagedZoneVisibilityEnum.
+type ManagedZoneVisibilityEnum string
+
+// ManagedZoneVisibilityEnumRef returns a *ManagedZoneVisibilityEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func ManagedZoneVisibilityEnumRef(s string) *ManagedZoneVisibilityEnum {
+	v := ManagedZoneVisibilityEnum(s)
+	return &v
+}
+
+func (v ManagedZoneVisibilityEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"private", "public"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "ManagedZoneVisibilityEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+type ManagedZoneDnssecConfig struct {
+	empty           bool                                     `json:"-"`
+	Kind            *string                                  `json:"kind"`
+	NonExistence    *ManagedZoneDnssecConfigNonExistenceEnum `json:"nonExistence"`
+	State           *ManagedZoneDnssecConfigStateEnum        `json:"state"`
+	DefaultKeySpecs []ManagedZoneDnssecConfigDefaultKeySpecs `json:"defaultKeySpecs"`
+}
+
+type jsonManagedZoneDnssecConfig ManagedZoneDnssecConfig
+
+func (r *ManagedZoneDnssecConfig) UnmarshalJSON(data []byte) error {
+	var res jsonManagedZoneDnssecConfig
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyManagedZoneDnssecConfig
+	} else {
+
+		r.Kind = res.Kind
+
+		r.NonExistence = res.NonExistence
+
+		r.State = res.State
+
+		r.DefaultKeySpecs = res.DefaultKeySpecs
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this ManagedZoneDnssecConfig is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyManagedZoneDnssecConfig *ManagedZoneDnssecConfig = &ManagedZoneDnssecConfig{empty: true}
+
+func (r *ManagedZoneDnssecConfig) Empty() bool {
+	return r.empty
+}
+
+func (r *ManagedZoneDnssecConfig) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *ManagedZoneDnssecConfig) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type ManagedZoneDnssecConfigDefaultKeySpecs struct {
+	empty     bool                                                 `json:"-"`
+	Algorithm *ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum `json:"algorithm"`
+	KeyLength *int64                                               `json:"keyLength"`
+	KeyType   *ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum   `json:"keyType"`
+	Kind      *string                                              `json:"kind"`
+}
+
+type jsonManagedZoneDnssecConfigDefaultKeySpecs ManagedZoneDnssecConfigDefaultKeySpecs
+
+func (r *ManagedZoneDnssecConfigDefaultKeySpecs) UnmarshalJSON(data []byte) error {
+	var res jsonManagedZoneDnssecConfigDefaultKeySpecs
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyManagedZoneDnssecConfigDefaultKeySpecs
+	} else {
+
+		r.Algorithm = res.Algorithm
+
+		r.KeyLength = res.KeyLength
+
+		r.KeyType = res.KeyType
+
+		r.Kind = res.Kind
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this ManagedZoneDnssecConfigDefaultKeySpecs is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyManagedZoneDnssecConfigDefaultKeySpecs *ManagedZoneDnssecConfigDefaultKeySpecs = &ManagedZoneDnssecConfigDefaultKeySpecs{empty: true}
+
+func (r *ManagedZoneDnssecConfigDefaultKeySpecs) Empty() bool {
+	return r.empty
+}
+
+func (r *ManagedZoneDnssecConfigDefaultKeySpecs) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *ManagedZoneDnssecConfigDefaultKeySpecs) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type ManagedZonePrivateVisibilityConfig struct {
+	empty    bool                                         `json:"-"`
+	Networks []ManagedZonePrivateVisibilityConfigNetworks `json:"networks"`
+}
+
+type jsonManagedZonePrivateVisibilityConfig ManagedZonePrivateVisibilityConfig
+
+func (r *ManagedZonePrivateVisibilityConfig) UnmarshalJSON(data []byte) error {
+	var res jsonManagedZonePrivateVisibilityConfig
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyManagedZonePrivateVisibilityConfig
+	} else {
+
+		r.Networks = res.Networks
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this ManagedZonePrivateVisibilityConfig is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyManagedZonePrivateVisibilityConfig *ManagedZonePrivateVisibilityConfig = &ManagedZonePrivateVisibilityConfig{empty: true}
+
+func (r *ManagedZonePrivateVisibilityConfig) Empty() bool {
+	return r.empty
+}
+
+func (r *ManagedZonePrivateVisibilityConfig) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *ManagedZonePrivateVisibilityConfig) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type ManagedZonePrivateVisibilityConfigNetworks struct {
+	empty      bool    `json:"-"`
+	NetworkUrl *string `json:"networkUrl"`
+}
+
+type jsonManagedZonePrivateVisibilityConfigNetworks ManagedZonePrivateVisibilityConfigNetworks
+
+func (r *ManagedZonePrivateVisibilityConfigNetworks) UnmarshalJSON(data []byte) error {
+	var res jsonManagedZonePrivateVisibilityConfigNetworks
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyManagedZonePrivateVisibilityConfigNetworks
+	} else {
+
+		r.NetworkUrl = res.NetworkUrl
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this ManagedZonePrivateVisibilityConfigNetworks is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyManagedZonePrivateVisibilityConfigNetworks *ManagedZonePrivateVisibilityConfigNetworks = &ManagedZonePrivateVisibilityConfigNetworks{empty: true}
+
+func (r *ManagedZonePrivateVisibilityConfigNetworks) Empty() bool {
+	return r.empty
+}
+
+func (r *ManagedZonePrivateVisibilityConfigNetworks) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *ManagedZonePrivateVisibilityConfigNetworks) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type ManagedZoneForwardingConfig struct {
+	empty             bool                                           `json:"-"`
+	TargetNameServers []ManagedZoneForwardingConfigTargetNameServers `json:"targetNameServers"`
+}
+
+type jsonManagedZoneForwardingConfig ManagedZoneForwardingConfig
+
+func (r *ManagedZoneForwardingConfig) UnmarshalJSON(data []byte) error {
+	var res jsonManagedZoneForwardingConfig
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyManagedZoneForwardingConfig
+	} else {
+
+		r.TargetNameServers = res.TargetNameServers
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this ManagedZoneForwardingConfig is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyManagedZoneForwardingConfig *ManagedZoneForwardingConfig = &ManagedZoneForwardingConfig{empty: true}
+
+func (r *ManagedZoneForwardingConfig) Empty() bool {
+	return r.empty
+}
+
+func (r *ManagedZoneForwardingConfig) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *ManagedZoneForwardingConfig) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type ManagedZoneForwardingConfigTargetNameServers struct {
+	empty          bool                                                            `json:"-"`
+	IPv4Address    *string                                                         `json:"ipv4Address"`
+	ForwardingPath *ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum `json:"forwardingPath"`
+}
+
+type jsonManagedZoneForwardingConfigTargetNameServers ManagedZoneForwardingConfigTargetNameServers
+
+func (r *ManagedZoneForwardingConfigTargetNameServers) UnmarshalJSON(data []byte) error {
+	var res jsonManagedZoneForwardingConfigTargetNameServers
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyManagedZoneForwardingConfigTargetNameServers
+	} else {
+
+		r.IPv4Address = res.IPv4Address
+
+		r.ForwardingPath = res.ForwardingPath
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this ManagedZoneForwardingConfigTargetNameServers is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyManagedZoneForwardingConfigTargetNameServers *ManagedZoneForwardingConfigTargetNameServers = &ManagedZoneForwardingConfigTargetNameServers{empty: true}
+
+func (r *ManagedZoneForwardingConfigTargetNameServers) Empty() bool {
+	return r.empty
+}
+
+func (r *ManagedZoneForwardingConfigTargetNameServers) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *ManagedZoneForwardingConfigTargetNameServers) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type ManagedZonePeeringConfig struct {
+	empty         bool                                   `json:"-"`
+	TargetNetwork *ManagedZonePeeringConfigTargetNetwork `json:"targetNetwork"`
+}
+
+type jsonManagedZonePeeringConfig ManagedZonePeeringConfig
+
+func (r *ManagedZonePeeringConfig) UnmarshalJSON(data []byte) error {
+	var res jsonManagedZonePeeringConfig
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyManagedZonePeeringConfig
+	} else {
+
+		r.TargetNetwork = res.TargetNetwork
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this ManagedZonePeeringConfig is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyManagedZonePeeringConfig *ManagedZonePeeringConfig = &ManagedZonePeeringConfig{empty: true}
+
+func (r *ManagedZonePeeringConfig) Empty() bool {
+	return r.empty
+}
+
+func (r *ManagedZonePeeringConfig) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *ManagedZonePeeringConfig) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type ManagedZonePeeringConfigTargetNetwork struct {
+	empty      bool    `json:"-"`
+	NetworkUrl *string `json:"networkUrl"`
+}
+
+type jsonManagedZonePeeringConfigTargetNetwork ManagedZonePeeringConfigTargetNetwork
+
+func (r *ManagedZonePeeringConfigTargetNetwork) UnmarshalJSON(data []byte) error {
+	var res jsonManagedZonePeeringConfigTargetNetwork
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyManagedZonePeeringConfigTargetNetwork
+	} else {
+
+		r.NetworkUrl = res.NetworkUrl
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this ManagedZonePeeringConfigTargetNetwork is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyManagedZonePeeringConfigTargetNetwork *ManagedZonePeeringConfigTargetNetwork = &ManagedZonePeeringConfigTargetNetwork{empty: true}
+
+func (r *ManagedZonePeeringConfigTargetNetwork) Empty() bool {
+	return r.empty
+}
+
+func (r *ManagedZonePeeringConfigTargetNetwork) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *ManagedZonePeeringConfigTargetNetwork) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+// Describe returns a simple description of this resource to ensure that automated tools
+// can identify it.
+func (r *ManagedZone) Describe() dcl.ServiceTypeVersion {
+	return dcl.ServiceTypeVersion{
+		Service: "dns",
+		Type:    "ManagedZone",
+		Version: "dns",
+	}
+}
+
+func (r *ManagedZone) ID() (string, error) {
+	if err := extractManagedZoneFields(r); err != nil {
+		return "", err
+	}
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"description":               dcl.ValueOrEmptyString(nr.Description),
+		"dns_name":                  dcl.ValueOrEmptyString(nr.DnsName),
+		"dnssec_config":             dcl.ValueOrEmptyString(nr.DnssecConfig),
+		"name":                      dcl.ValueOrEmptyString(nr.Name),
+		"name_servers":              dcl.ValueOrEmptyString(nr.NameServers),
+		"labels":                    dcl.ValueOrEmptyString(nr.Labels),
+		"visibility":                dcl.ValueOrEmptyString(nr.Visibility),
+		"private_visibility_config": dcl.ValueOrEmptyString(nr.PrivateVisibilityConfig),
+		"forwarding_config":         dcl.ValueOrEmptyString(nr.ForwardingConfig),
+		"reverse_lookup":            dcl.ValueOrEmptyString(nr.ReverseLookup),
+		"peering_config":            dcl.ValueOrEmptyString(nr.PeeringConfig),
+		"project":                   dcl.ValueOrEmptyString(nr.Project),
+	}
+	return dcl.Nprintf("projects/{{project}}/managedZones/{{name}}", params), nil
+}
+
+const ManagedZoneMaxPage = -1
+
+type ManagedZoneList struct {
+	Items []*ManagedZone
+
+	nextToken string
+
+	pageSize int32
+
+	resource *ManagedZone
+}
+
+func (l *ManagedZoneList) HasNext() bool {
+	return l.nextToken != ""
+}
+
+func (l *ManagedZoneList) NextPageToken() string {
+	return l.nextToken
+}
+
+func (l *ManagedZoneList) Next(ctx context.Context, c *Client) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ManagedZone{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if !l.HasNext() {
+		return fmt.Errorf("no next page")
+	}
+	items, token, err := c.listManagedZone(ctx, l.resource, l.nextToken, l.pageSize)
+	if err != nil {
+		return err
+	}
+	l.Items = items
+	l.nextToken = token
+	return err
+}
+
+func (c *Client) ListManagedZone(ctx context.Context, project string) (*ManagedZoneList, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ManagedZone{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ManagedZone{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	return c.ListManagedZoneWithMaxResults(ctx, project, ManagedZoneMaxPage)
+
+}
+
+func (c *Client) ListManagedZoneWithMaxResults(ctx context.Context, project string, pageSize int32) (*ManagedZoneList, error) {
+	return c.ListManagedZoneWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListManagedZoneWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*ManagedZoneList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ManagedZone{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// Create a resource object so that we can use proper url normalization methods.
+	r := &ManagedZone{
+		Project: &project,
+	}
+	items, token, err := c.listManagedZone(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagedZoneList{
+		Items:     items,
+		nextToken: token,
+		pageSize:  pageSize,
+		resource:  r,
+	}, nil
+}
+
+func (c *Client) GetManagedZone(ctx context.Context, r *ManagedZone) (*ManagedZone, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ManagedZone{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ManagedZone{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// This is *purposefully* supressing errors.
+	// This function is used with url-normalized values + not URL normalized values.
+	// URL Normalized values will throw unintentional errors, since those values are not of the proper parent form.
+	extractManagedZoneFields(r)
+
+	b, err := c.getManagedZoneRaw(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			return nil, &googleapi.Error{
+				Code:    404,
+				Message: err.Error(),
+			}
+		}
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
+	}
+	result, err := unmarshalManagedZone(b, c, r)
+	if err != nil {
+		return nil, err
+	}
+	result.Project = r.Project
+	result.Name = r.Name
+
+	c.Config.Logger.InfoWithContextf(ctx, "Retrieved raw result state: %v", result)
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with specified state: %v", r)
+	result, err = canonicalizeManagedZoneNewState(c, result, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := postReadExtractManagedZoneFields(result); err != nil {
+		return result, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created result state: %v", result)
+
+	return result, nil
+}
+
+func (c *Client) DeleteManagedZone(ctx context.Context, r *ManagedZone) error {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ManagedZone{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ManagedZone{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if r == nil {
+		return fmt.Errorf("ManagedZone resource is nil")
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Deleting ManagedZone...")
+	deleteOp := deleteManagedZoneOperation{}
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteManagedZoneAsync(ctx context.Context, r *ManagedZone) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteManagedZone(ctx, r)
+	})
+}
+
+// DeleteAllManagedZone deletes all resources that the filter functions returns true on.
+func (c *Client) DeleteAllManagedZone(ctx context.Context, project string, filter func(*ManagedZone) bool) error {
+	listObj, err := c.ListManagedZone(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	err = c.deleteAllManagedZone(ctx, filter, listObj.Items)
+	if err != nil {
+		return err
+	}
+	for listObj.HasNext() {
+		err = listObj.Next(ctx, c)
+		if err != nil {
+			return nil
+		}
+		err = c.deleteAllManagedZone(ctx, filter, listObj.Items)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) ApplyManagedZone(ctx context.Context, rawDesired *ManagedZone, opts ...dcl.ApplyOption) (*ManagedZone, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ManagedZone{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ManagedZone{}).Describe())
+	var resultNewState *ManagedZone
+	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		newState, err := applyManagedZoneHelper(c, ctx, rawDesired, opts...)
+		resultNewState = newState
+		if err != nil {
+			// If the error is 409, there is conflict in resource update.
+			// Here we want to apply changes based on latest state.
+			if dcl.IsConflictError(err) {
+				return &dcl.RetryDetails{}, dcl.OperationNotDone{Err: err}
+			}
+			return nil, err
+		}
+		return nil, nil
+	}, c.Config.RetryProvider)
+	return resultNewState, err
+}
+
+func (c *Client) ApplyManagedZoneAsync(ctx context.Context, rawDesired *ManagedZone, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyManagedZone(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffManagedZone returns the field-level differences between rawDesired and the
+// live ManagedZone without modifying it. If the ManagedZone does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffManagedZone(ctx context.Context, rawDesired *ManagedZone, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ManagedZone{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ManagedZone{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractManagedZoneFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.managedZoneDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("ManagedZone %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
+func applyManagedZoneHelper(c *Client, ctx context.Context, rawDesired *ManagedZone, opts ...dcl.ApplyOption) (*ManagedZone, error) {
+	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyManagedZone...")
+	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
+
+	// 1.1: Validation of user-specified fields in desired state.
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
+
+	if err := extractManagedZoneFields(rawDesired); err != nil {
+		return nil, err
+	}
+
+	initial, desired, fieldDiffs, err := c.managedZoneDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a diff: %w", err)
+	}
+
+	diffs, err := convertFieldDiffsToManagedZoneDiffs(c.Config, fieldDiffs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(magic-modules-eng): 2.2 Feasibility check (all updates are feasible so far).
+
+	// 2.3: Lifecycle Directive Check
+	var create bool
+	lp := dcl.FetchLifecycleParams(opts)
+	if initial == nil {
+		if dcl.HasLifecycleParam(lp, dcl.BlockCreation) {
+			return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Creation blocked by lifecycle params: %#v.", desired)}
+		}
+		create = true
+	} else if dcl.HasLifecycleParam(lp, dcl.BlockAcquire) {
+		return nil, dcl.ApplyInfeasibleError{
+			Message: fmt.Sprintf("Resource already exists - apply blocked by lifecycle params: %#v.", initial),
+		}
+	} else {
+		for _, d := range diffs {
+			if d.RequiresRecreate {
+				return nil, dcl.ApplyInfeasibleError{
+					Message: fmt.Sprintf("infeasible update: (%v) would require recreation", d),
+				}
+			}
+			if dcl.HasLifecycleParam(lp, dcl.BlockModification) {
+				return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Modification blocked, diff (%v) unresolvable.", d)}
+			}
+		}
+	}
+
+	// 2.4 Imperative Request Planning
+	var ops []managedZoneApiOperation
+	if create {
+		ops = append(ops, &createManagedZoneOperation{})
+	} else {
+		for _, d := range diffs {
+			ops = append(ops, d.UpdateOp)
+		}
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created plan: %#v", ops)
+
+	// 2.5 Request Actuation
+	for _, op := range ops {
+		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
+		if err := op.do(ctx, desired, c); err != nil {
+			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
+		}
+		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
+	}
+	return applyManagedZoneDiff(c, ctx, desired, rawDesired, ops, opts...)
+}
+
+func applyManagedZoneDiff(c *Client, ctx context.Context, desired *ManagedZone, rawDesired *ManagedZone, ops []managedZoneApiOperation, opts ...dcl.ApplyOption) (*ManagedZone, error) {
+	// 3.1, 3.2a Retrieval of raw new state & canonicalization with desired state
+	c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state...")
+	rawNew, err := c.GetManagedZone(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	// Get additional values from the first response.
+	// These values should be merged into the newState above.
+	if len(ops) > 0 {
+		lastOp := ops[len(ops)-1]
+		if o, ok := lastOp.(*createManagedZoneOperation); ok {
+			if r, hasR := o.FirstResponse(); hasR {
+
+				c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state from operation...")
+
+				fullResp, err := unmarshalMapManagedZone(r, c, rawDesired)
+				if err != nil {
+					return nil, err
+				}
+
+				rawNew, err = canonicalizeManagedZoneNewState(c, rawNew, fullResp)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with raw desired state: %v", rawDesired)
+	// 3.2b Canonicalization of raw new state using raw desired state
+	newState, err := canonicalizeManagedZoneNewState(c, rawNew, rawDesired)
+	if err != nil {
+		return rawNew, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created canonical new state: %v", newState)
+	// 3.3 Comparison of the new state and raw desired state.
+	// TODO(magic-modules-eng): EVENTUALLY_CONSISTENT_UPDATE
+	newDesired, err := canonicalizeManagedZoneDesiredState(rawDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if err := postReadExtractManagedZoneFields(newState); err != nil {
+		return newState, err
+	}
+
+	// Need to ensure any transformations made here match acceptably in differ.
+	if err := postReadExtractManagedZoneFields(newDesired); err != nil {
+		return newState, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Diffing using canonicalized desired state: %v", newDesired)
+	newDiffs, err := diffManagedZone(c, newDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if len(newDiffs) == 0 {
+		c.Config.Logger.InfoWithContext(ctx, "No diffs found. Apply was successful.")
+	} else {
+		c.Config.Logger.InfoWithContextf(ctx, "Found diffs: %v", newDiffs)
+		diffMessages := make([]string, len(newDiffs))
+		for i, d := range newDiffs {
+			diffMessages[i] = fmt.Sprintf("%v", d)
+		}
+		return newState, dcl.DiffAfterApplyError{Diffs: diffMessages}
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Done Apply.")
+	return newState, nil
+}
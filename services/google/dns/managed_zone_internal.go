@@ -0,0 +1,3582 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func (r *ManagedZone) validate() error {
+
+	if err := dcl.Required(r, "name"); err != nil {
+		return err
+	}
+	if err := dcl.Required(r, "dnsName"); err != nil {
+		return err
+	}
+	if err := dcl.RequiredParameter(r.Project, "Project"); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(r.DnssecConfig) {
+		if err := r.DnssecConfig.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.PrivateVisibilityConfig) {
+		if err := r.PrivateVisibilityConfig.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.ForwardingConfig) {
+		if err := r.ForwardingConfig.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.PeeringConfig) {
+		if err := r.PeeringConfig.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *ManagedZoneDnssecConfig) validate() error {
+	return nil
+}
+func (r *ManagedZoneDnssecConfigDefaultKeySpecs) validate() error {
+	return nil
+}
+func (r *ManagedZonePrivateVisibilityConfig) validate() error {
+	return nil
+}
+func (r *ManagedZonePrivateVisibilityConfigNetworks) validate() error {
+	if err := dcl.Required(r, "networkUrl"); err != nil {
+		return err
+	}
+	return nil
+}
+func (r *ManagedZoneForwardingConfig) validate() error {
+	return nil
+}
+func (r *ManagedZoneForwardingConfigTargetNameServers) validate() error {
+	if err := dcl.Required(r, "ipv4Address"); err != nil {
+		return err
+	}
+	return nil
+}
+func (r *ManagedZonePeeringConfig) validate() error {
+	if err := dcl.Required(r, "targetNetwork"); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(r.TargetNetwork) {
+		if err := r.TargetNetwork.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *ManagedZonePeeringConfigTargetNetwork) validate() error {
+	if err := dcl.Required(r, "networkUrl"); err != nil {
+		return err
+	}
+	return nil
+}
+func (r *ManagedZone) basePath() string {
+	params := map[string]interface{}{}
+	return dcl.Nprintf("https://dns.googleapis.com/dns/v1/", params)
+}
+
+func (r *ManagedZone) getURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+		"name":    dcl.ValueOrEmptyString(nr.Name),
+	}
+	return dcl.URL("projects/{{project}}/managedZones/{{name}}", nr.basePath(), userBasePath, params), nil
+}
+
+func (r *ManagedZone) listURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+	}
+	return dcl.URL("projects/{{project}}/managedZones", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *ManagedZone) createURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+	}
+	return dcl.URL("projects/{{project}}/managedZones", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *ManagedZone) deleteURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+		"name":    dcl.ValueOrEmptyString(nr.Name),
+	}
+	return dcl.URL("projects/{{project}}/managedZones/{{name}}", nr.basePath(), userBasePath, params), nil
+}
+
+// managedZoneApiOperation represents a mutable operation in the underlying REST
+// API such as Create, Update, or Delete.
+type managedZoneApiOperation interface {
+	do(context.Context, *ManagedZone, *Client) error
+}
+
+// newUpdateManagedZonePatchRequest creates a request for an
+// ManagedZone resource's Patch update type by filling in the update
+// fields based on the intended state of the resource.
+func newUpdateManagedZonePatchRequest(ctx context.Context, f *ManagedZone, c *Client) (map[string]interface{}, error) {
+	req := map[string]interface{}{}
+	res := f
+	_ = res
+
+	if v := f.Description; !dcl.IsEmptyValueIndirect(v) {
+		req["description"] = v
+	}
+	if v, err := expandManagedZoneDnssecConfig(c, f.DnssecConfig, res); err != nil {
+		return nil, fmt.Errorf("error expanding DnssecConfig into dnssecConfig: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		req["dnssecConfig"] = v
+	}
+	if v := f.Labels; !dcl.IsEmptyValueIndirect(v) {
+		req["labels"] = v
+	}
+	if v, err := expandManagedZonePrivateVisibilityConfig(c, f.PrivateVisibilityConfig, res); err != nil {
+		return nil, fmt.Errorf("error expanding PrivateVisibilityConfig into privateVisibilityConfig: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		req["privateVisibilityConfig"] = v
+	}
+	if v, err := expandManagedZoneForwardingConfig(c, f.ForwardingConfig, res); err != nil {
+		return nil, fmt.Errorf("error expanding ForwardingConfig into forwardingConfig: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		req["forwardingConfig"] = v
+	}
+	return req, nil
+}
+
+// marshalUpdateManagedZonePatchRequest converts the update into
+// the final JSON request body.
+func marshalUpdateManagedZonePatchRequest(c *Client, m map[string]interface{}) ([]byte, error) {
+
+	return json.Marshal(m)
+}
+
+type updateManagedZonePatchOperation struct {
+	// If the update operation has the REQUIRES_APPLY_OPTIONS trait, this will be populated.
+	// Usually it will be nil - this is to prevent us from accidentally depending on apply
+	// options, which should usually be unnecessary.
+	ApplyOptions []dcl.ApplyOption
+	FieldDiffs   []*dcl.FieldDiff
+}
+
+// do creates a request and sends it to the appropriate URL. In most operations,
+// do will transcribe a subset of the resource into a request object and send a
+// PUT request to a single URL.
+
+func (op *updateManagedZonePatchOperation) do(ctx context.Context, r *ManagedZone, c *Client) error {
+	_, err := c.GetManagedZone(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	u, err := r.updateURL(c.Config.BasePath, "Patch")
+	if err != nil {
+		return err
+	}
+
+	req, err := newUpdateManagedZonePatchRequest(ctx, r, c)
+	if err != nil {
+		return err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created update: %#v", req)
+	body, err := marshalUpdateManagedZonePatchRequest(c, req)
+	if err != nil {
+		return err
+	}
+	_, err = dcl.SendRequest(ctx, c.Config, "PATCH", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) listManagedZoneRaw(ctx context.Context, r *ManagedZone, pageToken string, pageSize int32) ([]byte, error) {
+	u, err := r.urlNormalized().listURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string)
+	if pageToken != "" {
+		m["pageToken"] = pageToken
+	}
+
+	if pageSize != ManagedZoneMaxPage {
+		m["pageSize"] = fmt.Sprintf("%v", pageSize)
+	}
+
+	u, err = dcl.AddQueryParams(u, m)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	return ioutil.ReadAll(resp.Response.Body)
+}
+
+type listManagedZoneOperation struct {
+	ManagedZones []map[string]interface{} `json:"managedZones"`
+	Token        string                   `json:"nextPageToken"`
+}
+
+func (c *Client) listManagedZone(ctx context.Context, r *ManagedZone, pageToken string, pageSize int32) ([]*ManagedZone, string, error) {
+	b, err := c.listManagedZoneRaw(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var m listManagedZoneOperation
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, "", err
+	}
+
+	var l []*ManagedZone
+	for _, v := range m.ManagedZones {
+		res, err := unmarshalMapManagedZone(v, c, r)
+		if err != nil {
+			return nil, m.Token, err
+		}
+		res.Project = r.Project
+		l = append(l, res)
+	}
+
+	return l, m.Token, nil
+}
+
+func (c *Client) deleteAllManagedZone(ctx context.Context, f func(*ManagedZone) bool, resources []*ManagedZone) error {
+	var errors []string
+	for _, res := range resources {
+		if f(res) {
+			// We do not want deleteAll to fail on a deletion or else it will stop deleting other resources.
+			err := c.DeleteManagedZone(ctx, res)
+			if err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("%v", strings.Join(errors, "\n"))
+	} else {
+		return nil
+	}
+}
+
+type deleteManagedZoneOperation struct{}
+
+func (op *deleteManagedZoneOperation) do(ctx context.Context, r *ManagedZone, c *Client) error {
+	r, err := c.GetManagedZone(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			c.Config.Logger.InfoWithContextf(ctx, "ManagedZone not found, returning. Original error: %v", err)
+			return nil
+		}
+		c.Config.Logger.WarningWithContextf(ctx, "GetManagedZone checking for existence. error: %v", err)
+		return err
+	}
+
+	u, err := r.deleteURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	// Delete should never have a body
+	body := &bytes.Buffer{}
+	_, err = dcl.SendRequest(ctx, c.Config, "DELETE", u, body, c.Config.RetryProvider)
+	if err != nil {
+		return fmt.Errorf("failed to delete ManagedZone: %w", err)
+	}
+
+	// We saw a race condition where for some successful delete operation, the Get calls returned resources for a short duration.
+	// This is the reason we are adding retry to handle that case.
+	retriesRemaining := 10
+	dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		_, err := c.GetManagedZone(ctx, r)
+		if dcl.IsNotFound(err) {
+			return nil, nil
+		}
+		if retriesRemaining > 0 {
+			retriesRemaining--
+			return &dcl.RetryDetails{}, dcl.OperationNotDone{}
+		}
+		return nil, dcl.NotDeletedError{ExistingResource: r}
+	}, c.Config.RetryProvider)
+	return nil
+}
+
+// Create operations are similar to Update operations, although they do not have
+// specific request objects. The Create request object is the json encoding of
+// the resource, which is modified by res.marshal to form the base request body.
+type createManagedZoneOperation struct {
+	response map[string]interface{}
+}
+
+func (op *createManagedZoneOperation) FirstResponse() (map[string]interface{}, bool) {
+	return op.response, len(op.response) > 0
+}
+
+func (op *createManagedZoneOperation) do(ctx context.Context, r *ManagedZone, c *Client) error {
+	c.Config.Logger.InfoWithContextf(ctx, "Attempting to create %v", r)
+	u, err := r.createURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	req, err := r.marshal(c)
+	if err != nil {
+		return err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "POST", u, bytes.NewBuffer(req), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+
+	o, err := dcl.ResponseBodyAsJSON(resp)
+	if err != nil {
+		return fmt.Errorf("error decoding response body into JSON: %w", err)
+	}
+	op.response = o
+
+	if _, err := c.GetManagedZone(ctx, r); err != nil {
+		c.Config.Logger.WarningWithContextf(ctx, "get returned error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) getManagedZoneRaw(ctx context.Context, r *ManagedZone) ([]byte, error) {
+
+	u, err := r.getURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	b, err := ioutil.ReadAll(resp.Response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (c *Client) managedZoneDiffsForRawDesired(ctx context.Context, rawDesired *ManagedZone, opts ...dcl.ApplyOption) (initial, desired *ManagedZone, diffs []*dcl.FieldDiff, err error) {
+	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
+	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
+	var fetchState *ManagedZone
+	if sh := dcl.FetchStateHint(opts); sh != nil {
+		if r, ok := sh.(*ManagedZone); !ok {
+			c.Config.Logger.WarningWithContextf(ctx, "Initial state hint was of the wrong type; expected ManagedZone, got %T", sh)
+		} else {
+			fetchState = r
+		}
+	}
+	if fetchState == nil {
+		fetchState = rawDesired
+	}
+
+	// 1.2: Retrieval of raw initial state from API
+	rawInitial, err := c.GetManagedZone(ctx, fetchState)
+	if rawInitial == nil {
+		if !dcl.IsNotFound(err) {
+			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a ManagedZone resource already exists: %s", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve ManagedZone resource: %w", err)
+		}
+		c.Config.Logger.InfoWithContext(ctx, "Found that ManagedZone resource did not exist.")
+		// Perform canonicalization to pick up defaults.
+		desired, err = canonicalizeManagedZoneDesiredState(rawDesired, rawInitial)
+		return nil, desired, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Found initial state for ManagedZone: %v", rawInitial)
+	c.Config.Logger.InfoWithContextf(ctx, "Initial desired state for ManagedZone: %v", rawDesired)
+
+	// The Get call applies postReadExtract and so the result may contain fields that are not part of API version.
+	if err := extractManagedZoneFields(rawInitial); err != nil {
+		return nil, nil, nil, err
+	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// 1.3: Canonicalize raw initial state into initial state.
+	initial, err = canonicalizeManagedZoneInitialState(rawInitial, rawDesired)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized initial state for ManagedZone: %v", initial)
+
+	// 1.4: Canonicalize raw desired state into desired state.
+	desired, err = canonicalizeManagedZoneDesiredState(rawDesired, rawInitial, opts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized desired state for ManagedZone: %v", desired)
+
+	// 2.1: Comparison of initial and desired state.
+	diffs, err = diffManagedZone(c, desired, initial, opts...)
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
+}
+
+func canonicalizeManagedZoneInitialState(rawInitial, rawDesired *ManagedZone) (*ManagedZone, error) {
+	// TODO(magic-modules-eng): write canonicalizer once relevant traits are added.
+	return rawInitial, nil
+}
+
+/*
+* Canonicalizers
+*
+* These are responsible for converting either a user-specified config or a
+* GCP API response to a standard format that can be used for difference checking.
+* */
+
+func canonicalizeManagedZoneDesiredState(rawDesired, rawInitial *ManagedZone, opts ...dcl.ApplyOption) (*ManagedZone, error) {
+
+	if rawInitial == nil {
+		// Since the initial state is empty, the desired state is all we have.
+		// We canonicalize the remaining nested objects with nil to pick up defaults.
+		rawDesired.DnssecConfig = canonicalizeManagedZoneDnssecConfig(rawDesired.DnssecConfig, nil, opts...)
+		rawDesired.PrivateVisibilityConfig = canonicalizeManagedZonePrivateVisibilityConfig(rawDesired.PrivateVisibilityConfig, nil, opts...)
+		rawDesired.ForwardingConfig = canonicalizeManagedZoneForwardingConfig(rawDesired.ForwardingConfig, nil, opts...)
+		rawDesired.PeeringConfig = canonicalizeManagedZonePeeringConfig(rawDesired.PeeringConfig, nil, opts...)
+
+		return rawDesired, nil
+	}
+
+	canonicalDesired := &ManagedZone{}
+	if dcl.StringCanonicalize(rawDesired.Description, rawInitial.Description) {
+		canonicalDesired.Description = rawInitial.Description
+	} else {
+		canonicalDesired.Description = rawDesired.Description
+	}
+	if dcl.StringCanonicalize(rawDesired.DnsName, rawInitial.DnsName) {
+		canonicalDesired.DnsName = rawInitial.DnsName
+	} else {
+		canonicalDesired.DnsName = rawDesired.DnsName
+	}
+	canonicalDesired.DnssecConfig = canonicalizeManagedZoneDnssecConfig(rawDesired.DnssecConfig, rawInitial.DnssecConfig, opts...)
+	if dcl.StringCanonicalize(rawDesired.Name, rawInitial.Name) {
+		canonicalDesired.Name = rawInitial.Name
+	} else {
+		canonicalDesired.Name = rawDesired.Name
+	}
+	if dcl.IsZeroValue(rawDesired.Labels) || (dcl.IsEmptyValueIndirect(rawDesired.Labels) && dcl.IsEmptyValueIndirect(rawInitial.Labels)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.Labels = rawInitial.Labels
+	} else {
+		canonicalDesired.Labels = rawDesired.Labels
+	}
+	if dcl.IsZeroValue(rawDesired.Visibility) || (dcl.IsEmptyValueIndirect(rawDesired.Visibility) && dcl.IsEmptyValueIndirect(rawInitial.Visibility)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.Visibility = rawInitial.Visibility
+	} else {
+		canonicalDesired.Visibility = rawDesired.Visibility
+	}
+	canonicalDesired.PrivateVisibilityConfig = canonicalizeManagedZonePrivateVisibilityConfig(rawDesired.PrivateVisibilityConfig, rawInitial.PrivateVisibilityConfig, opts...)
+	canonicalDesired.ForwardingConfig = canonicalizeManagedZoneForwardingConfig(rawDesired.ForwardingConfig, rawInitial.ForwardingConfig, opts...)
+	if dcl.BoolCanonicalize(rawDesired.ReverseLookup, rawInitial.ReverseLookup) {
+		canonicalDesired.ReverseLookup = rawInitial.ReverseLookup
+	} else {
+		canonicalDesired.ReverseLookup = rawDesired.ReverseLookup
+	}
+	canonicalDesired.PeeringConfig = canonicalizeManagedZonePeeringConfig(rawDesired.PeeringConfig, rawInitial.PeeringConfig, opts...)
+	if dcl.NameToSelfLink(rawDesired.Project, rawInitial.Project) {
+		canonicalDesired.Project = rawInitial.Project
+	} else {
+		canonicalDesired.Project = rawDesired.Project
+	}
+
+	return canonicalDesired, nil
+}
+
+func canonicalizeManagedZoneNewState(c *Client, rawNew, rawDesired *ManagedZone) (*ManagedZone, error) {
+
+	if dcl.IsEmptyValueIndirect(rawNew.Description) && dcl.IsEmptyValueIndirect(rawDesired.Description) {
+		rawNew.Description = rawDesired.Description
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Description, rawNew.Description) {
+			rawNew.Description = rawDesired.Description
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.DnsName) && dcl.IsEmptyValueIndirect(rawDesired.DnsName) {
+		rawNew.DnsName = rawDesired.DnsName
+	} else {
+		if dcl.StringCanonicalize(rawDesired.DnsName, rawNew.DnsName) {
+			rawNew.DnsName = rawDesired.DnsName
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.DnssecConfig) && dcl.IsEmptyValueIndirect(rawDesired.DnssecConfig) {
+		rawNew.DnssecConfig = rawDesired.DnssecConfig
+	} else {
+		rawNew.DnssecConfig = canonicalizeNewManagedZoneDnssecConfig(c, rawDesired.DnssecConfig, rawNew.DnssecConfig)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Name) && dcl.IsEmptyValueIndirect(rawDesired.Name) {
+		rawNew.Name = rawDesired.Name
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Name, rawNew.Name) {
+			rawNew.Name = rawDesired.Name
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.NameServers) && dcl.IsEmptyValueIndirect(rawDesired.NameServers) {
+		rawNew.NameServers = rawDesired.NameServers
+	} else {
+		if dcl.StringArrayCanonicalize(rawDesired.NameServers, rawNew.NameServers) {
+			rawNew.NameServers = rawDesired.NameServers
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Labels) && dcl.IsEmptyValueIndirect(rawDesired.Labels) {
+		rawNew.Labels = rawDesired.Labels
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Visibility) && dcl.IsEmptyValueIndirect(rawDesired.Visibility) {
+		rawNew.Visibility = rawDesired.Visibility
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.PrivateVisibilityConfig) && dcl.IsEmptyValueIndirect(rawDesired.PrivateVisibilityConfig) {
+		rawNew.PrivateVisibilityConfig = rawDesired.PrivateVisibilityConfig
+	} else {
+		rawNew.PrivateVisibilityConfig = canonicalizeNewManagedZonePrivateVisibilityConfig(c, rawDesired.PrivateVisibilityConfig, rawNew.PrivateVisibilityConfig)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ForwardingConfig) && dcl.IsEmptyValueIndirect(rawDesired.ForwardingConfig) {
+		rawNew.ForwardingConfig = rawDesired.ForwardingConfig
+	} else {
+		rawNew.ForwardingConfig = canonicalizeNewManagedZoneForwardingConfig(c, rawDesired.ForwardingConfig, rawNew.ForwardingConfig)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ReverseLookup) && dcl.IsEmptyValueIndirect(rawDesired.ReverseLookup) {
+		rawNew.ReverseLookup = rawDesired.ReverseLookup
+	} else {
+		if dcl.BoolCanonicalize(rawDesired.ReverseLookup, rawNew.ReverseLookup) {
+			rawNew.ReverseLookup = rawDesired.ReverseLookup
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.PeeringConfig) && dcl.IsEmptyValueIndirect(rawDesired.PeeringConfig) {
+		rawNew.PeeringConfig = rawDesired.PeeringConfig
+	} else {
+		rawNew.PeeringConfig = canonicalizeNewManagedZonePeeringConfig(c, rawDesired.PeeringConfig, rawNew.PeeringConfig)
+	}
+
+	rawNew.Project = rawDesired.Project
+
+	return rawNew, nil
+}
+
+func canonicalizeManagedZoneDnssecConfig(des, initial *ManagedZoneDnssecConfig, opts ...dcl.ApplyOption) *ManagedZoneDnssecConfig {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &ManagedZoneDnssecConfig{}
+
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+	if dcl.IsZeroValue(des.NonExistence) || (dcl.IsEmptyValueIndirect(des.NonExistence) && dcl.IsEmptyValueIndirect(initial.NonExistence)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.NonExistence = initial.NonExistence
+	} else {
+		cDes.NonExistence = des.NonExistence
+	}
+	if dcl.IsZeroValue(des.State) || (dcl.IsEmptyValueIndirect(des.State) && dcl.IsEmptyValueIndirect(initial.State)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.State = initial.State
+	} else {
+		cDes.State = des.State
+	}
+	cDes.DefaultKeySpecs = canonicalizeManagedZoneDnssecConfigDefaultKeySpecsSlice(des.DefaultKeySpecs, initial.DefaultKeySpecs, opts...)
+
+	return cDes
+}
+
+func canonicalizeManagedZoneDnssecConfigSlice(des, initial []ManagedZoneDnssecConfig, opts ...dcl.ApplyOption) []ManagedZoneDnssecConfig {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]ManagedZoneDnssecConfig, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeManagedZoneDnssecConfig(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]ManagedZoneDnssecConfig, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeManagedZoneDnssecConfig(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewManagedZoneDnssecConfig(c *Client, des, nw *ManagedZoneDnssecConfig) *ManagedZoneDnssecConfig {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for ManagedZoneDnssecConfig while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+	nw.DefaultKeySpecs = canonicalizeNewManagedZoneDnssecConfigDefaultKeySpecsSlice(c, des.DefaultKeySpecs, nw.DefaultKeySpecs)
+
+	return nw
+}
+
+func canonicalizeNewManagedZoneDnssecConfigSet(c *Client, des, nw []ManagedZoneDnssecConfig) []ManagedZoneDnssecConfig {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []ManagedZoneDnssecConfig
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareManagedZoneDnssecConfigNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewManagedZoneDnssecConfigSlice(c *Client, des, nw []ManagedZoneDnssecConfig) []ManagedZoneDnssecConfig {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []ManagedZoneDnssecConfig
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewManagedZoneDnssecConfig(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeManagedZoneDnssecConfigDefaultKeySpecs(des, initial *ManagedZoneDnssecConfigDefaultKeySpecs, opts ...dcl.ApplyOption) *ManagedZoneDnssecConfigDefaultKeySpecs {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &ManagedZoneDnssecConfigDefaultKeySpecs{}
+
+	if dcl.IsZeroValue(des.Algorithm) || (dcl.IsEmptyValueIndirect(des.Algorithm) && dcl.IsEmptyValueIndirect(initial.Algorithm)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Algorithm = initial.Algorithm
+	} else {
+		cDes.Algorithm = des.Algorithm
+	}
+	if dcl.IsZeroValue(des.KeyLength) || (dcl.IsEmptyValueIndirect(des.KeyLength) && dcl.IsEmptyValueIndirect(initial.KeyLength)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.KeyLength = initial.KeyLength
+	} else {
+		cDes.KeyLength = des.KeyLength
+	}
+	if dcl.IsZeroValue(des.KeyType) || (dcl.IsEmptyValueIndirect(des.KeyType) && dcl.IsEmptyValueIndirect(initial.KeyType)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.KeyType = initial.KeyType
+	} else {
+		cDes.KeyType = des.KeyType
+	}
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+
+	return cDes
+}
+
+func canonicalizeManagedZoneDnssecConfigDefaultKeySpecsSlice(des, initial []ManagedZoneDnssecConfigDefaultKeySpecs, opts ...dcl.ApplyOption) []ManagedZoneDnssecConfigDefaultKeySpecs {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]ManagedZoneDnssecConfigDefaultKeySpecs, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeManagedZoneDnssecConfigDefaultKeySpecs(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]ManagedZoneDnssecConfigDefaultKeySpecs, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeManagedZoneDnssecConfigDefaultKeySpecs(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewManagedZoneDnssecConfigDefaultKeySpecs(c *Client, des, nw *ManagedZoneDnssecConfigDefaultKeySpecs) *ManagedZoneDnssecConfigDefaultKeySpecs {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for ManagedZoneDnssecConfigDefaultKeySpecs while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+
+	return nw
+}
+
+func canonicalizeNewManagedZoneDnssecConfigDefaultKeySpecsSet(c *Client, des, nw []ManagedZoneDnssecConfigDefaultKeySpecs) []ManagedZoneDnssecConfigDefaultKeySpecs {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []ManagedZoneDnssecConfigDefaultKeySpecs
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareManagedZoneDnssecConfigDefaultKeySpecsNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewManagedZoneDnssecConfigDefaultKeySpecsSlice(c *Client, des, nw []ManagedZoneDnssecConfigDefaultKeySpecs) []ManagedZoneDnssecConfigDefaultKeySpecs {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []ManagedZoneDnssecConfigDefaultKeySpecs
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewManagedZoneDnssecConfigDefaultKeySpecs(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeManagedZonePrivateVisibilityConfig(des, initial *ManagedZonePrivateVisibilityConfig, opts ...dcl.ApplyOption) *ManagedZonePrivateVisibilityConfig {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &ManagedZonePrivateVisibilityConfig{}
+
+	cDes.Networks = canonicalizeManagedZonePrivateVisibilityConfigNetworksSlice(des.Networks, initial.Networks, opts...)
+
+	return cDes
+}
+
+func canonicalizeManagedZonePrivateVisibilityConfigSlice(des, initial []ManagedZonePrivateVisibilityConfig, opts ...dcl.ApplyOption) []ManagedZonePrivateVisibilityConfig {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]ManagedZonePrivateVisibilityConfig, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeManagedZonePrivateVisibilityConfig(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]ManagedZonePrivateVisibilityConfig, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeManagedZonePrivateVisibilityConfig(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewManagedZonePrivateVisibilityConfig(c *Client, des, nw *ManagedZonePrivateVisibilityConfig) *ManagedZonePrivateVisibilityConfig {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for ManagedZonePrivateVisibilityConfig while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	nw.Networks = canonicalizeNewManagedZonePrivateVisibilityConfigNetworksSlice(c, des.Networks, nw.Networks)
+
+	return nw
+}
+
+func canonicalizeNewManagedZonePrivateVisibilityConfigSet(c *Client, des, nw []ManagedZonePrivateVisibilityConfig) []ManagedZonePrivateVisibilityConfig {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []ManagedZonePrivateVisibilityConfig
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareManagedZonePrivateVisibilityConfigNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewManagedZonePrivateVisibilityConfigSlice(c *Client, des, nw []ManagedZonePrivateVisibilityConfig) []ManagedZonePrivateVisibilityConfig {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []ManagedZonePrivateVisibilityConfig
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewManagedZonePrivateVisibilityConfig(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeManagedZonePrivateVisibilityConfigNetworks(des, initial *ManagedZonePrivateVisibilityConfigNetworks, opts ...dcl.ApplyOption) *ManagedZonePrivateVisibilityConfigNetworks {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &ManagedZonePrivateVisibilityConfigNetworks{}
+
+	if dcl.StringCanonicalize(des.NetworkUrl, initial.NetworkUrl) || dcl.IsZeroValue(des.NetworkUrl) {
+		cDes.NetworkUrl = initial.NetworkUrl
+	} else {
+		cDes.NetworkUrl = des.NetworkUrl
+	}
+
+	return cDes
+}
+
+func canonicalizeManagedZonePrivateVisibilityConfigNetworksSlice(des, initial []ManagedZonePrivateVisibilityConfigNetworks, opts ...dcl.ApplyOption) []ManagedZonePrivateVisibilityConfigNetworks {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]ManagedZonePrivateVisibilityConfigNetworks, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeManagedZonePrivateVisibilityConfigNetworks(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]ManagedZonePrivateVisibilityConfigNetworks, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeManagedZonePrivateVisibilityConfigNetworks(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewManagedZonePrivateVisibilityConfigNetworks(c *Client, des, nw *ManagedZonePrivateVisibilityConfigNetworks) *ManagedZonePrivateVisibilityConfigNetworks {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for ManagedZonePrivateVisibilityConfigNetworks while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.NetworkUrl, nw.NetworkUrl) {
+		nw.NetworkUrl = des.NetworkUrl
+	}
+
+	return nw
+}
+
+func canonicalizeNewManagedZonePrivateVisibilityConfigNetworksSet(c *Client, des, nw []ManagedZonePrivateVisibilityConfigNetworks) []ManagedZonePrivateVisibilityConfigNetworks {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []ManagedZonePrivateVisibilityConfigNetworks
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareManagedZonePrivateVisibilityConfigNetworksNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewManagedZonePrivateVisibilityConfigNetworksSlice(c *Client, des, nw []ManagedZonePrivateVisibilityConfigNetworks) []ManagedZonePrivateVisibilityConfigNetworks {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []ManagedZonePrivateVisibilityConfigNetworks
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewManagedZonePrivateVisibilityConfigNetworks(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeManagedZoneForwardingConfig(des, initial *ManagedZoneForwardingConfig, opts ...dcl.ApplyOption) *ManagedZoneForwardingConfig {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &ManagedZoneForwardingConfig{}
+
+	cDes.TargetNameServers = canonicalizeManagedZoneForwardingConfigTargetNameServersSlice(des.TargetNameServers, initial.TargetNameServers, opts...)
+
+	return cDes
+}
+
+func canonicalizeManagedZoneForwardingConfigSlice(des, initial []ManagedZoneForwardingConfig, opts ...dcl.ApplyOption) []ManagedZoneForwardingConfig {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]ManagedZoneForwardingConfig, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeManagedZoneForwardingConfig(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]ManagedZoneForwardingConfig, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeManagedZoneForwardingConfig(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewManagedZoneForwardingConfig(c *Client, des, nw *ManagedZoneForwardingConfig) *ManagedZoneForwardingConfig {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for ManagedZoneForwardingConfig while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	nw.TargetNameServers = canonicalizeNewManagedZoneForwardingConfigTargetNameServersSlice(c, des.TargetNameServers, nw.TargetNameServers)
+
+	return nw
+}
+
+func canonicalizeNewManagedZoneForwardingConfigSet(c *Client, des, nw []ManagedZoneForwardingConfig) []ManagedZoneForwardingConfig {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []ManagedZoneForwardingConfig
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareManagedZoneForwardingConfigNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewManagedZoneForwardingConfigSlice(c *Client, des, nw []ManagedZoneForwardingConfig) []ManagedZoneForwardingConfig {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []ManagedZoneForwardingConfig
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewManagedZoneForwardingConfig(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeManagedZoneForwardingConfigTargetNameServers(des, initial *ManagedZoneForwardingConfigTargetNameServers, opts ...dcl.ApplyOption) *ManagedZoneForwardingConfigTargetNameServers {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &ManagedZoneForwardingConfigTargetNameServers{}
+
+	if dcl.StringCanonicalize(des.IPv4Address, initial.IPv4Address) || dcl.IsZeroValue(des.IPv4Address) {
+		cDes.IPv4Address = initial.IPv4Address
+	} else {
+		cDes.IPv4Address = des.IPv4Address
+	}
+	if dcl.IsZeroValue(des.ForwardingPath) || (dcl.IsEmptyValueIndirect(des.ForwardingPath) && dcl.IsEmptyValueIndirect(initial.ForwardingPath)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.ForwardingPath = initial.ForwardingPath
+	} else {
+		cDes.ForwardingPath = des.ForwardingPath
+	}
+
+	return cDes
+}
+
+func canonicalizeManagedZoneForwardingConfigTargetNameServersSlice(des, initial []ManagedZoneForwardingConfigTargetNameServers, opts ...dcl.ApplyOption) []ManagedZoneForwardingConfigTargetNameServers {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]ManagedZoneForwardingConfigTargetNameServers, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeManagedZoneForwardingConfigTargetNameServers(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]ManagedZoneForwardingConfigTargetNameServers, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeManagedZoneForwardingConfigTargetNameServers(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewManagedZoneForwardingConfigTargetNameServers(c *Client, des, nw *ManagedZoneForwardingConfigTargetNameServers) *ManagedZoneForwardingConfigTargetNameServers {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for ManagedZoneForwardingConfigTargetNameServers while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.IPv4Address, nw.IPv4Address) {
+		nw.IPv4Address = des.IPv4Address
+	}
+
+	return nw
+}
+
+func canonicalizeNewManagedZoneForwardingConfigTargetNameServersSet(c *Client, des, nw []ManagedZoneForwardingConfigTargetNameServers) []ManagedZoneForwardingConfigTargetNameServers {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []ManagedZoneForwardingConfigTargetNameServers
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareManagedZoneForwardingConfigTargetNameServersNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewManagedZoneForwardingConfigTargetNameServersSlice(c *Client, des, nw []ManagedZoneForwardingConfigTargetNameServers) []ManagedZoneForwardingConfigTargetNameServers {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []ManagedZoneForwardingConfigTargetNameServers
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewManagedZoneForwardingConfigTargetNameServers(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeManagedZonePeeringConfig(des, initial *ManagedZonePeeringConfig, opts ...dcl.ApplyOption) *ManagedZonePeeringConfig {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &ManagedZonePeeringConfig{}
+
+	cDes.TargetNetwork = canonicalizeManagedZonePeeringConfigTargetNetwork(des.TargetNetwork, initial.TargetNetwork, opts...)
+
+	return cDes
+}
+
+func canonicalizeManagedZonePeeringConfigSlice(des, initial []ManagedZonePeeringConfig, opts ...dcl.ApplyOption) []ManagedZonePeeringConfig {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]ManagedZonePeeringConfig, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeManagedZonePeeringConfig(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]ManagedZonePeeringConfig, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeManagedZonePeeringConfig(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewManagedZonePeeringConfig(c *Client, des, nw *ManagedZonePeeringConfig) *ManagedZonePeeringConfig {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for ManagedZonePeeringConfig while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	nw.TargetNetwork = canonicalizeNewManagedZonePeeringConfigTargetNetwork(c, des.TargetNetwork, nw.TargetNetwork)
+
+	return nw
+}
+
+func canonicalizeNewManagedZonePeeringConfigSet(c *Client, des, nw []ManagedZonePeeringConfig) []ManagedZonePeeringConfig {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []ManagedZonePeeringConfig
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareManagedZonePeeringConfigNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewManagedZonePeeringConfigSlice(c *Client, des, nw []ManagedZonePeeringConfig) []ManagedZonePeeringConfig {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []ManagedZonePeeringConfig
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewManagedZonePeeringConfig(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeManagedZonePeeringConfigTargetNetwork(des, initial *ManagedZonePeeringConfigTargetNetwork, opts ...dcl.ApplyOption) *ManagedZonePeeringConfigTargetNetwork {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &ManagedZonePeeringConfigTargetNetwork{}
+
+	if dcl.StringCanonicalize(des.NetworkUrl, initial.NetworkUrl) || dcl.IsZeroValue(des.NetworkUrl) {
+		cDes.NetworkUrl = initial.NetworkUrl
+	} else {
+		cDes.NetworkUrl = des.NetworkUrl
+	}
+
+	return cDes
+}
+
+func canonicalizeManagedZonePeeringConfigTargetNetworkSlice(des, initial []ManagedZonePeeringConfigTargetNetwork, opts ...dcl.ApplyOption) []ManagedZonePeeringConfigTargetNetwork {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]ManagedZonePeeringConfigTargetNetwork, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeManagedZonePeeringConfigTargetNetwork(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]ManagedZonePeeringConfigTargetNetwork, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeManagedZonePeeringConfigTargetNetwork(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewManagedZonePeeringConfigTargetNetwork(c *Client, des, nw *ManagedZonePeeringConfigTargetNetwork) *ManagedZonePeeringConfigTargetNetwork {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for ManagedZonePeeringConfigTargetNetwork while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.NetworkUrl, nw.NetworkUrl) {
+		nw.NetworkUrl = des.NetworkUrl
+	}
+
+	return nw
+}
+
+func canonicalizeNewManagedZonePeeringConfigTargetNetworkSet(c *Client, des, nw []ManagedZonePeeringConfigTargetNetwork) []ManagedZonePeeringConfigTargetNetwork {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []ManagedZonePeeringConfigTargetNetwork
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareManagedZonePeeringConfigTargetNetworkNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewManagedZonePeeringConfigTargetNetworkSlice(c *Client, des, nw []ManagedZonePeeringConfigTargetNetwork) []ManagedZonePeeringConfigTargetNetwork {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []ManagedZonePeeringConfigTargetNetwork
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewManagedZonePeeringConfigTargetNetwork(c, &d, &n))
+	}
+
+	return items
+}
+
+// The differ returns a list of diffs, along with a list of operations that should be taken
+// to remedy them. Right now, it does not attempt to consolidate operations - if several
+// fields can be fixed with a patch update, it will perform the patch several times.
+// Diffs on some fields will be ignored if the `desired` state has an empty (nil)
+// value. This empty value indicates that the user does not care about the state for
+// the field. Empty fields on the actual object will cause diffs.
+// TODO(magic-modules-eng): for efficiency in some resources, add batching.
+func diffManagedZone(c *Client, desired, actual *ManagedZone, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	if desired == nil || actual == nil {
+		return nil, fmt.Errorf("nil resource passed to diff - always a programming error: %#v, %#v", desired, actual)
+	}
+
+	c.Config.Logger.Infof("Diff function called with desired state: %v", desired)
+	c.Config.Logger.Infof("Diff function called with actual state: %v", actual)
+
+	var fn dcl.FieldName
+	var newDiffs []*dcl.FieldDiff
+	// New style diffs.
+	if ds, err := dcl.Diff(desired.Description, actual.Description, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("Description")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DnsName, actual.DnsName, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("DnsName")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DnssecConfig, actual.DnssecConfig, dcl.DiffInfo{ServerDefault: true, ObjectFunction: compareManagedZoneDnssecConfigNewStyle, EmptyObject: EmptyManagedZoneDnssecConfig, OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("DnssecConfig")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.NameServers, actual.NameServers, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("NameServers")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Visibility, actual.Visibility, dcl.DiffInfo{ServerDefault: true, Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Visibility")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.PrivateVisibilityConfig, actual.PrivateVisibilityConfig, dcl.DiffInfo{ObjectFunction: compareManagedZonePrivateVisibilityConfigNewStyle, EmptyObject: EmptyManagedZonePrivateVisibilityConfig, OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("PrivateVisibilityConfig")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ForwardingConfig, actual.ForwardingConfig, dcl.DiffInfo{ObjectFunction: compareManagedZoneForwardingConfigNewStyle, EmptyObject: EmptyManagedZoneForwardingConfig, OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("ForwardingConfig")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ReverseLookup, actual.ReverseLookup, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ReverseLookup")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.PeeringConfig, actual.PeeringConfig, dcl.DiffInfo{ObjectFunction: compareManagedZonePeeringConfigNewStyle, EmptyObject: EmptyManagedZonePeeringConfig, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("PeeringConfig")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Project, actual.Project, dcl.DiffInfo{Type: "ReferenceType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Project")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	return newDiffs, nil
+}
+func compareManagedZoneDnssecConfigNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*ManagedZoneDnssecConfig)
+	if !ok {
+		desiredNotPointer, ok := d.(ManagedZoneDnssecConfig)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZoneDnssecConfig or *ManagedZoneDnssecConfig", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*ManagedZoneDnssecConfig)
+	if !ok {
+		actualNotPointer, ok := a.(ManagedZoneDnssecConfig)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZoneDnssecConfig", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{ServerDefault: true, OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.NonExistence, actual.NonExistence, dcl.DiffInfo{ServerDefault: true, Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("NonExistence")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.State, actual.State, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("State")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DefaultKeySpecs, actual.DefaultKeySpecs, dcl.DiffInfo{ServerDefault: true, ObjectFunction: compareManagedZoneDnssecConfigDefaultKeySpecsNewStyle, EmptyObject: EmptyManagedZoneDnssecConfigDefaultKeySpecs, OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("DefaultKeySpecs")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareManagedZoneDnssecConfigDefaultKeySpecsNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*ManagedZoneDnssecConfigDefaultKeySpecs)
+	if !ok {
+		desiredNotPointer, ok := d.(ManagedZoneDnssecConfigDefaultKeySpecs)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZoneDnssecConfigDefaultKeySpecs or *ManagedZoneDnssecConfigDefaultKeySpecs", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*ManagedZoneDnssecConfigDefaultKeySpecs)
+	if !ok {
+		actualNotPointer, ok := a.(ManagedZoneDnssecConfigDefaultKeySpecs)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZoneDnssecConfigDefaultKeySpecs", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Algorithm, actual.Algorithm, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("Algorithm")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.KeyLength, actual.KeyLength, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("KeyLength")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.KeyType, actual.KeyType, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("KeyType")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{ServerDefault: true, OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareManagedZonePrivateVisibilityConfigNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*ManagedZonePrivateVisibilityConfig)
+	if !ok {
+		desiredNotPointer, ok := d.(ManagedZonePrivateVisibilityConfig)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZonePrivateVisibilityConfig or *ManagedZonePrivateVisibilityConfig", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*ManagedZonePrivateVisibilityConfig)
+	if !ok {
+		actualNotPointer, ok := a.(ManagedZonePrivateVisibilityConfig)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZonePrivateVisibilityConfig", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Networks, actual.Networks, dcl.DiffInfo{ObjectFunction: compareManagedZonePrivateVisibilityConfigNetworksNewStyle, EmptyObject: EmptyManagedZonePrivateVisibilityConfigNetworks, OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("Networks")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareManagedZonePrivateVisibilityConfigNetworksNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*ManagedZonePrivateVisibilityConfigNetworks)
+	if !ok {
+		desiredNotPointer, ok := d.(ManagedZonePrivateVisibilityConfigNetworks)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZonePrivateVisibilityConfigNetworks or *ManagedZonePrivateVisibilityConfigNetworks", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*ManagedZonePrivateVisibilityConfigNetworks)
+	if !ok {
+		actualNotPointer, ok := a.(ManagedZonePrivateVisibilityConfigNetworks)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZonePrivateVisibilityConfigNetworks", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.NetworkUrl, actual.NetworkUrl, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("NetworkUrl")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareManagedZoneForwardingConfigNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*ManagedZoneForwardingConfig)
+	if !ok {
+		desiredNotPointer, ok := d.(ManagedZoneForwardingConfig)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZoneForwardingConfig or *ManagedZoneForwardingConfig", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*ManagedZoneForwardingConfig)
+	if !ok {
+		actualNotPointer, ok := a.(ManagedZoneForwardingConfig)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZoneForwardingConfig", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.TargetNameServers, actual.TargetNameServers, dcl.DiffInfo{ObjectFunction: compareManagedZoneForwardingConfigTargetNameServersNewStyle, EmptyObject: EmptyManagedZoneForwardingConfigTargetNameServers, OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("TargetNameServers")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareManagedZoneForwardingConfigTargetNameServersNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*ManagedZoneForwardingConfigTargetNameServers)
+	if !ok {
+		desiredNotPointer, ok := d.(ManagedZoneForwardingConfigTargetNameServers)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZoneForwardingConfigTargetNameServers or *ManagedZoneForwardingConfigTargetNameServers", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*ManagedZoneForwardingConfigTargetNameServers)
+	if !ok {
+		actualNotPointer, ok := a.(ManagedZoneForwardingConfigTargetNameServers)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZoneForwardingConfigTargetNameServers", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.IPv4Address, actual.IPv4Address, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("IPv4Address")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ForwardingPath, actual.ForwardingPath, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateManagedZonePatchOperation")}, fn.AddNest("ForwardingPath")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareManagedZonePeeringConfigNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*ManagedZonePeeringConfig)
+	if !ok {
+		desiredNotPointer, ok := d.(ManagedZonePeeringConfig)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZonePeeringConfig or *ManagedZonePeeringConfig", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*ManagedZonePeeringConfig)
+	if !ok {
+		actualNotPointer, ok := a.(ManagedZonePeeringConfig)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZonePeeringConfig", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.TargetNetwork, actual.TargetNetwork, dcl.DiffInfo{ObjectFunction: compareManagedZonePeeringConfigTargetNetworkNewStyle, EmptyObject: EmptyManagedZonePeeringConfigTargetNetwork, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("TargetNetwork")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareManagedZonePeeringConfigTargetNetworkNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*ManagedZonePeeringConfigTargetNetwork)
+	if !ok {
+		desiredNotPointer, ok := d.(ManagedZonePeeringConfigTargetNetwork)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZonePeeringConfigTargetNetwork or *ManagedZonePeeringConfigTargetNetwork", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*ManagedZonePeeringConfigTargetNetwork)
+	if !ok {
+		actualNotPointer, ok := a.(ManagedZonePeeringConfigTargetNetwork)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ManagedZonePeeringConfigTargetNetwork", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.NetworkUrl, actual.NetworkUrl, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("NetworkUrl")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+// urlNormalized returns a copy of the resource struct with values normalized
+// for URL substitutions. For instance, it converts long-form self-links to
+// short-form so they can be substituted in.
+func (r *ManagedZone) urlNormalized() *ManagedZone {
+	normalized := dcl.Copy(*r).(ManagedZone)
+	normalized.Description = dcl.SelfLinkToName(r.Description)
+	normalized.DnsName = dcl.SelfLinkToName(r.DnsName)
+	normalized.Name = dcl.SelfLinkToName(r.Name)
+	normalized.Project = dcl.SelfLinkToName(r.Project)
+	return &normalized
+}
+
+func (r *ManagedZone) updateURL(userBasePath, updateName string) (string, error) {
+	nr := r.urlNormalized()
+	if updateName == "Patch" {
+		fields := map[string]interface{}{
+			"project": dcl.ValueOrEmptyString(nr.Project),
+			"name":    dcl.ValueOrEmptyString(nr.Name),
+		}
+		return dcl.URL("projects/{{project}}/managedZones/{{name}}", nr.basePath(), userBasePath, fields), nil
+
+	}
+
+	return "", fmt.Errorf("unknown update name: %s", updateName)
+}
+
+// marshal encodes the ManagedZone resource into JSON for a Create request, and
+// performs transformations from the resource schema to the API schema if
+// necessary.
+func (r *ManagedZone) marshal(c *Client) ([]byte, error) {
+	m, err := expandManagedZone(c, r)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling ManagedZone: %w", err)
+	}
+
+	return json.Marshal(m)
+}
+
+// unmarshalManagedZone decodes JSON responses into the ManagedZone resource schema.
+func unmarshalManagedZone(b []byte, c *Client, res *ManagedZone) (*ManagedZone, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return unmarshalMapManagedZone(m, c, res)
+}
+
+func unmarshalMapManagedZone(m map[string]interface{}, c *Client, res *ManagedZone) (*ManagedZone, error) {
+
+	flattened := flattenManagedZone(c, m, res)
+	if flattened == nil {
+		return nil, fmt.Errorf("attempted to flatten empty json object")
+	}
+	return flattened, nil
+}
+
+// expandManagedZone expands ManagedZone into a JSON request object.
+func expandManagedZone(c *Client, f *ManagedZone) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	res := f
+	_ = res
+	if v := f.Description; dcl.ValueShouldBeSent(v) {
+		m["description"] = v
+	}
+	if v := f.DnsName; dcl.ValueShouldBeSent(v) {
+		m["dnsName"] = v
+	}
+	if v, err := expandManagedZoneDnssecConfig(c, f.DnssecConfig, res); err != nil {
+		return nil, fmt.Errorf("error expanding DnssecConfig into dnssecConfig: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["dnssecConfig"] = v
+	}
+	if v := f.Name; dcl.ValueShouldBeSent(v) {
+		m["name"] = v
+	}
+	if v := f.Labels; dcl.ValueShouldBeSent(v) {
+		m["labels"] = v
+	}
+	if v := f.Visibility; dcl.ValueShouldBeSent(v) {
+		m["visibility"] = v
+	}
+	if v, err := expandManagedZonePrivateVisibilityConfig(c, f.PrivateVisibilityConfig, res); err != nil {
+		return nil, fmt.Errorf("error expanding PrivateVisibilityConfig into privateVisibilityConfig: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["privateVisibilityConfig"] = v
+	}
+	if v, err := expandManagedZoneForwardingConfig(c, f.ForwardingConfig, res); err != nil {
+		return nil, fmt.Errorf("error expanding ForwardingConfig into forwardingConfig: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["forwardingConfig"] = v
+	}
+	if v, err := expandManagedZoneReverseLookup(c, f.ReverseLookup, res); err != nil {
+		return nil, fmt.Errorf("error expanding ReverseLookup into reverseLookupConfig: %w", err)
+	} else if v != nil {
+		m["reverseLookupConfig"] = v
+	}
+	if v, err := expandManagedZonePeeringConfig(c, f.PeeringConfig, res); err != nil {
+		return nil, fmt.Errorf("error expanding PeeringConfig into peeringConfig: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["peeringConfig"] = v
+	}
+	if v, err := dcl.EmptyValue(); err != nil {
+		return nil, fmt.Errorf("error expanding Project into project: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["project"] = v
+	}
+
+	return m, nil
+}
+
+// flattenManagedZone flattens ManagedZone from a JSON request object into the
+// ManagedZone type.
+func flattenManagedZone(c *Client, i interface{}, res *ManagedZone) *ManagedZone {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if len(m) == 0 {
+		return nil
+	}
+
+	resultRes := &ManagedZone{}
+	resultRes.Description = dcl.FlattenString(m["description"])
+	resultRes.DnsName = dcl.FlattenString(m["dnsName"])
+	resultRes.DnssecConfig = flattenManagedZoneDnssecConfig(c, m["dnssecConfig"], res)
+	resultRes.Name = dcl.FlattenString(m["name"])
+	resultRes.NameServers = dcl.FlattenStringSlice(m["nameServers"])
+	resultRes.Labels = dcl.FlattenKeyValuePairs(m["labels"])
+	resultRes.Visibility = flattenManagedZoneVisibilityEnum(m["visibility"])
+	resultRes.PrivateVisibilityConfig = flattenManagedZonePrivateVisibilityConfig(c, m["privateVisibilityConfig"], res)
+	resultRes.ForwardingConfig = flattenManagedZoneForwardingConfig(c, m["forwardingConfig"], res)
+	resultRes.ReverseLookup = flattenManagedZoneReverseLookup(c, m["reverseLookupConfig"], res)
+	resultRes.PeeringConfig = flattenManagedZonePeeringConfig(c, m["peeringConfig"], res)
+	resultRes.Project = dcl.FlattenString(m["project"])
+
+	return resultRes
+}
+
+// expandManagedZoneDnssecConfigMap expands the contents of ManagedZoneDnssecConfig into a JSON
+// request object.
+func expandManagedZoneDnssecConfigMap(c *Client, f map[string]ManagedZoneDnssecConfig, res *ManagedZone) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandManagedZoneDnssecConfig(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandManagedZoneDnssecConfigSlice expands the contents of ManagedZoneDnssecConfig into a JSON
+// request object.
+func expandManagedZoneDnssecConfigSlice(c *Client, f []ManagedZoneDnssecConfig, res *ManagedZone) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandManagedZoneDnssecConfig(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenManagedZoneDnssecConfigMap flattens the contents of ManagedZoneDnssecConfig from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZoneDnssecConfig {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZoneDnssecConfig{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZoneDnssecConfig{}
+	}
+
+	items := make(map[string]ManagedZoneDnssecConfig)
+	for k, item := range a {
+		items[k] = *flattenManagedZoneDnssecConfig(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenManagedZoneDnssecConfigSlice flattens the contents of ManagedZoneDnssecConfig from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZoneDnssecConfig {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZoneDnssecConfig{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZoneDnssecConfig{}
+	}
+
+	items := make([]ManagedZoneDnssecConfig, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZoneDnssecConfig(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandManagedZoneDnssecConfig expands an instance of ManagedZoneDnssecConfig into a JSON
+// request object.
+func expandManagedZoneDnssecConfig(c *Client, f *ManagedZoneDnssecConfig, res *ManagedZone) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+	if v := f.NonExistence; !dcl.IsEmptyValueIndirect(v) {
+		m["nonExistence"] = v
+	}
+	if v := f.State; !dcl.IsEmptyValueIndirect(v) {
+		m["state"] = v
+	}
+	if v, err := expandManagedZoneDnssecConfigDefaultKeySpecsSlice(c, f.DefaultKeySpecs, res); err != nil {
+		return nil, fmt.Errorf("error expanding DefaultKeySpecs into defaultKeySpecs: %w", err)
+	} else if v != nil {
+		m["defaultKeySpecs"] = v
+	}
+
+	return m, nil
+}
+
+// flattenManagedZoneDnssecConfig flattens an instance of ManagedZoneDnssecConfig from a JSON
+// response object.
+func flattenManagedZoneDnssecConfig(c *Client, i interface{}, res *ManagedZone) *ManagedZoneDnssecConfig {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &ManagedZoneDnssecConfig{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyManagedZoneDnssecConfig
+	}
+	r.Kind = dcl.FlattenString(m["kind"])
+	r.NonExistence = flattenManagedZoneDnssecConfigNonExistenceEnum(m["nonExistence"])
+	r.State = flattenManagedZoneDnssecConfigStateEnum(m["state"])
+	r.DefaultKeySpecs = flattenManagedZoneDnssecConfigDefaultKeySpecsSlice(c, m["defaultKeySpecs"], res)
+
+	return r
+}
+
+// expandManagedZoneDnssecConfigDefaultKeySpecsMap expands the contents of ManagedZoneDnssecConfigDefaultKeySpecs into a JSON
+// request object.
+func expandManagedZoneDnssecConfigDefaultKeySpecsMap(c *Client, f map[string]ManagedZoneDnssecConfigDefaultKeySpecs, res *ManagedZone) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandManagedZoneDnssecConfigDefaultKeySpecs(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandManagedZoneDnssecConfigDefaultKeySpecsSlice expands the contents of ManagedZoneDnssecConfigDefaultKeySpecs into a JSON
+// request object.
+func expandManagedZoneDnssecConfigDefaultKeySpecsSlice(c *Client, f []ManagedZoneDnssecConfigDefaultKeySpecs, res *ManagedZone) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandManagedZoneDnssecConfigDefaultKeySpecs(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenManagedZoneDnssecConfigDefaultKeySpecsMap flattens the contents of ManagedZoneDnssecConfigDefaultKeySpecs from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigDefaultKeySpecsMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZoneDnssecConfigDefaultKeySpecs {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZoneDnssecConfigDefaultKeySpecs{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZoneDnssecConfigDefaultKeySpecs{}
+	}
+
+	items := make(map[string]ManagedZoneDnssecConfigDefaultKeySpecs)
+	for k, item := range a {
+		items[k] = *flattenManagedZoneDnssecConfigDefaultKeySpecs(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenManagedZoneDnssecConfigDefaultKeySpecsSlice flattens the contents of ManagedZoneDnssecConfigDefaultKeySpecs from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigDefaultKeySpecsSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZoneDnssecConfigDefaultKeySpecs {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZoneDnssecConfigDefaultKeySpecs{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZoneDnssecConfigDefaultKeySpecs{}
+	}
+
+	items := make([]ManagedZoneDnssecConfigDefaultKeySpecs, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZoneDnssecConfigDefaultKeySpecs(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandManagedZoneDnssecConfigDefaultKeySpecs expands an instance of ManagedZoneDnssecConfigDefaultKeySpecs into a JSON
+// request object.
+func expandManagedZoneDnssecConfigDefaultKeySpecs(c *Client, f *ManagedZoneDnssecConfigDefaultKeySpecs, res *ManagedZone) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Algorithm; !dcl.IsEmptyValueIndirect(v) {
+		m["algorithm"] = v
+	}
+	if v := f.KeyLength; !dcl.IsEmptyValueIndirect(v) {
+		m["keyLength"] = v
+	}
+	if v := f.KeyType; !dcl.IsEmptyValueIndirect(v) {
+		m["keyType"] = v
+	}
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+
+	return m, nil
+}
+
+// flattenManagedZoneDnssecConfigDefaultKeySpecs flattens an instance of ManagedZoneDnssecConfigDefaultKeySpecs from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigDefaultKeySpecs(c *Client, i interface{}, res *ManagedZone) *ManagedZoneDnssecConfigDefaultKeySpecs {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &ManagedZoneDnssecConfigDefaultKeySpecs{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyManagedZoneDnssecConfigDefaultKeySpecs
+	}
+	r.Algorithm = flattenManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum(m["algorithm"])
+	r.KeyLength = dcl.FlattenInteger(m["keyLength"])
+	r.KeyType = flattenManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum(m["keyType"])
+	r.Kind = dcl.FlattenString(m["kind"])
+
+	return r
+}
+
+// expandManagedZonePrivateVisibilityConfigMap expands the contents of ManagedZonePrivateVisibilityConfig into a JSON
+// request object.
+func expandManagedZonePrivateVisibilityConfigMap(c *Client, f map[string]ManagedZonePrivateVisibilityConfig, res *ManagedZone) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandManagedZonePrivateVisibilityConfig(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandManagedZonePrivateVisibilityConfigSlice expands the contents of ManagedZonePrivateVisibilityConfig into a JSON
+// request object.
+func expandManagedZonePrivateVisibilityConfigSlice(c *Client, f []ManagedZonePrivateVisibilityConfig, res *ManagedZone) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandManagedZonePrivateVisibilityConfig(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenManagedZonePrivateVisibilityConfigMap flattens the contents of ManagedZonePrivateVisibilityConfig from a JSON
+// response object.
+func flattenManagedZonePrivateVisibilityConfigMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZonePrivateVisibilityConfig {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZonePrivateVisibilityConfig{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZonePrivateVisibilityConfig{}
+	}
+
+	items := make(map[string]ManagedZonePrivateVisibilityConfig)
+	for k, item := range a {
+		items[k] = *flattenManagedZonePrivateVisibilityConfig(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenManagedZonePrivateVisibilityConfigSlice flattens the contents of ManagedZonePrivateVisibilityConfig from a JSON
+// response object.
+func flattenManagedZonePrivateVisibilityConfigSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZonePrivateVisibilityConfig {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZonePrivateVisibilityConfig{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZonePrivateVisibilityConfig{}
+	}
+
+	items := make([]ManagedZonePrivateVisibilityConfig, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZonePrivateVisibilityConfig(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandManagedZonePrivateVisibilityConfig expands an instance of ManagedZonePrivateVisibilityConfig into a JSON
+// request object.
+func expandManagedZonePrivateVisibilityConfig(c *Client, f *ManagedZonePrivateVisibilityConfig, res *ManagedZone) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v, err := expandManagedZonePrivateVisibilityConfigNetworksSlice(c, f.Networks, res); err != nil {
+		return nil, fmt.Errorf("error expanding Networks into networks: %w", err)
+	} else if v != nil {
+		m["networks"] = v
+	}
+
+	return m, nil
+}
+
+// flattenManagedZonePrivateVisibilityConfig flattens an instance of ManagedZonePrivateVisibilityConfig from a JSON
+// response object.
+func flattenManagedZonePrivateVisibilityConfig(c *Client, i interface{}, res *ManagedZone) *ManagedZonePrivateVisibilityConfig {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &ManagedZonePrivateVisibilityConfig{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyManagedZonePrivateVisibilityConfig
+	}
+	r.Networks = flattenManagedZonePrivateVisibilityConfigNetworksSlice(c, m["networks"], res)
+
+	return r
+}
+
+// expandManagedZonePrivateVisibilityConfigNetworksMap expands the contents of ManagedZonePrivateVisibilityConfigNetworks into a JSON
+// request object.
+func expandManagedZonePrivateVisibilityConfigNetworksMap(c *Client, f map[string]ManagedZonePrivateVisibilityConfigNetworks, res *ManagedZone) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandManagedZonePrivateVisibilityConfigNetworks(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandManagedZonePrivateVisibilityConfigNetworksSlice expands the contents of ManagedZonePrivateVisibilityConfigNetworks into a JSON
+// request object.
+func expandManagedZonePrivateVisibilityConfigNetworksSlice(c *Client, f []ManagedZonePrivateVisibilityConfigNetworks, res *ManagedZone) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandManagedZonePrivateVisibilityConfigNetworks(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenManagedZonePrivateVisibilityConfigNetworksMap flattens the contents of ManagedZonePrivateVisibilityConfigNetworks from a JSON
+// response object.
+func flattenManagedZonePrivateVisibilityConfigNetworksMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZonePrivateVisibilityConfigNetworks {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZonePrivateVisibilityConfigNetworks{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZonePrivateVisibilityConfigNetworks{}
+	}
+
+	items := make(map[string]ManagedZonePrivateVisibilityConfigNetworks)
+	for k, item := range a {
+		items[k] = *flattenManagedZonePrivateVisibilityConfigNetworks(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenManagedZonePrivateVisibilityConfigNetworksSlice flattens the contents of ManagedZonePrivateVisibilityConfigNetworks from a JSON
+// response object.
+func flattenManagedZonePrivateVisibilityConfigNetworksSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZonePrivateVisibilityConfigNetworks {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZonePrivateVisibilityConfigNetworks{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZonePrivateVisibilityConfigNetworks{}
+	}
+
+	items := make([]ManagedZonePrivateVisibilityConfigNetworks, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZonePrivateVisibilityConfigNetworks(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandManagedZonePrivateVisibilityConfigNetworks expands an instance of ManagedZonePrivateVisibilityConfigNetworks into a JSON
+// request object.
+func expandManagedZonePrivateVisibilityConfigNetworks(c *Client, f *ManagedZonePrivateVisibilityConfigNetworks, res *ManagedZone) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.NetworkUrl; !dcl.IsEmptyValueIndirect(v) {
+		m["networkUrl"] = v
+	}
+
+	return m, nil
+}
+
+// flattenManagedZonePrivateVisibilityConfigNetworks flattens an instance of ManagedZonePrivateVisibilityConfigNetworks from a JSON
+// response object.
+func flattenManagedZonePrivateVisibilityConfigNetworks(c *Client, i interface{}, res *ManagedZone) *ManagedZonePrivateVisibilityConfigNetworks {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &ManagedZonePrivateVisibilityConfigNetworks{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyManagedZonePrivateVisibilityConfigNetworks
+	}
+	r.NetworkUrl = dcl.FlattenString(m["networkUrl"])
+
+	return r
+}
+
+// expandManagedZoneForwardingConfigMap expands the contents of ManagedZoneForwardingConfig into a JSON
+// request object.
+func expandManagedZoneForwardingConfigMap(c *Client, f map[string]ManagedZoneForwardingConfig, res *ManagedZone) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandManagedZoneForwardingConfig(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandManagedZoneForwardingConfigSlice expands the contents of ManagedZoneForwardingConfig into a JSON
+// request object.
+func expandManagedZoneForwardingConfigSlice(c *Client, f []ManagedZoneForwardingConfig, res *ManagedZone) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandManagedZoneForwardingConfig(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenManagedZoneForwardingConfigMap flattens the contents of ManagedZoneForwardingConfig from a JSON
+// response object.
+func flattenManagedZoneForwardingConfigMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZoneForwardingConfig {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZoneForwardingConfig{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZoneForwardingConfig{}
+	}
+
+	items := make(map[string]ManagedZoneForwardingConfig)
+	for k, item := range a {
+		items[k] = *flattenManagedZoneForwardingConfig(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenManagedZoneForwardingConfigSlice flattens the contents of ManagedZoneForwardingConfig from a JSON
+// response object.
+func flattenManagedZoneForwardingConfigSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZoneForwardingConfig {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZoneForwardingConfig{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZoneForwardingConfig{}
+	}
+
+	items := make([]ManagedZoneForwardingConfig, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZoneForwardingConfig(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandManagedZoneForwardingConfig expands an instance of ManagedZoneForwardingConfig into a JSON
+// request object.
+func expandManagedZoneForwardingConfig(c *Client, f *ManagedZoneForwardingConfig, res *ManagedZone) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v, err := expandManagedZoneForwardingConfigTargetNameServersSlice(c, f.TargetNameServers, res); err != nil {
+		return nil, fmt.Errorf("error expanding TargetNameServers into targetNameServers: %w", err)
+	} else if v != nil {
+		m["targetNameServers"] = v
+	}
+
+	return m, nil
+}
+
+// flattenManagedZoneForwardingConfig flattens an instance of ManagedZoneForwardingConfig from a JSON
+// response object.
+func flattenManagedZoneForwardingConfig(c *Client, i interface{}, res *ManagedZone) *ManagedZoneForwardingConfig {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &ManagedZoneForwardingConfig{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyManagedZoneForwardingConfig
+	}
+	r.TargetNameServers = flattenManagedZoneForwardingConfigTargetNameServersSlice(c, m["targetNameServers"], res)
+
+	return r
+}
+
+// expandManagedZoneForwardingConfigTargetNameServersMap expands the contents of ManagedZoneForwardingConfigTargetNameServers into a JSON
+// request object.
+func expandManagedZoneForwardingConfigTargetNameServersMap(c *Client, f map[string]ManagedZoneForwardingConfigTargetNameServers, res *ManagedZone) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandManagedZoneForwardingConfigTargetNameServers(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandManagedZoneForwardingConfigTargetNameServersSlice expands the contents of ManagedZoneForwardingConfigTargetNameServers into a JSON
+// request object.
+func expandManagedZoneForwardingConfigTargetNameServersSlice(c *Client, f []ManagedZoneForwardingConfigTargetNameServers, res *ManagedZone) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandManagedZoneForwardingConfigTargetNameServers(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenManagedZoneForwardingConfigTargetNameServersMap flattens the contents of ManagedZoneForwardingConfigTargetNameServers from a JSON
+// response object.
+func flattenManagedZoneForwardingConfigTargetNameServersMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZoneForwardingConfigTargetNameServers {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZoneForwardingConfigTargetNameServers{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZoneForwardingConfigTargetNameServers{}
+	}
+
+	items := make(map[string]ManagedZoneForwardingConfigTargetNameServers)
+	for k, item := range a {
+		items[k] = *flattenManagedZoneForwardingConfigTargetNameServers(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenManagedZoneForwardingConfigTargetNameServersSlice flattens the contents of ManagedZoneForwardingConfigTargetNameServers from a JSON
+// response object.
+func flattenManagedZoneForwardingConfigTargetNameServersSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZoneForwardingConfigTargetNameServers {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZoneForwardingConfigTargetNameServers{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZoneForwardingConfigTargetNameServers{}
+	}
+
+	items := make([]ManagedZoneForwardingConfigTargetNameServers, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZoneForwardingConfigTargetNameServers(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandManagedZoneForwardingConfigTargetNameServers expands an instance of ManagedZoneForwardingConfigTargetNameServers into a JSON
+// request object.
+func expandManagedZoneForwardingConfigTargetNameServers(c *Client, f *ManagedZoneForwardingConfigTargetNameServers, res *ManagedZone) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.IPv4Address; !dcl.IsEmptyValueIndirect(v) {
+		m["ipv4Address"] = v
+	}
+	if v := f.ForwardingPath; !dcl.IsEmptyValueIndirect(v) {
+		m["forwardingPath"] = v
+	}
+
+	return m, nil
+}
+
+// flattenManagedZoneForwardingConfigTargetNameServers flattens an instance of ManagedZoneForwardingConfigTargetNameServers from a JSON
+// response object.
+func flattenManagedZoneForwardingConfigTargetNameServers(c *Client, i interface{}, res *ManagedZone) *ManagedZoneForwardingConfigTargetNameServers {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &ManagedZoneForwardingConfigTargetNameServers{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyManagedZoneForwardingConfigTargetNameServers
+	}
+	r.IPv4Address = dcl.FlattenString(m["ipv4Address"])
+	r.ForwardingPath = flattenManagedZoneForwardingConfigTargetNameServersForwardingPathEnum(m["forwardingPath"])
+
+	return r
+}
+
+// expandManagedZonePeeringConfigMap expands the contents of ManagedZonePeeringConfig into a JSON
+// request object.
+func expandManagedZonePeeringConfigMap(c *Client, f map[string]ManagedZonePeeringConfig, res *ManagedZone) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandManagedZonePeeringConfig(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandManagedZonePeeringConfigSlice expands the contents of ManagedZonePeeringConfig into a JSON
+// request object.
+func expandManagedZonePeeringConfigSlice(c *Client, f []ManagedZonePeeringConfig, res *ManagedZone) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandManagedZonePeeringConfig(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenManagedZonePeeringConfigMap flattens the contents of ManagedZonePeeringConfig from a JSON
+// response object.
+func flattenManagedZonePeeringConfigMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZonePeeringConfig {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZonePeeringConfig{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZonePeeringConfig{}
+	}
+
+	items := make(map[string]ManagedZonePeeringConfig)
+	for k, item := range a {
+		items[k] = *flattenManagedZonePeeringConfig(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenManagedZonePeeringConfigSlice flattens the contents of ManagedZonePeeringConfig from a JSON
+// response object.
+func flattenManagedZonePeeringConfigSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZonePeeringConfig {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZonePeeringConfig{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZonePeeringConfig{}
+	}
+
+	items := make([]ManagedZonePeeringConfig, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZonePeeringConfig(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandManagedZonePeeringConfig expands an instance of ManagedZonePeeringConfig into a JSON
+// request object.
+func expandManagedZonePeeringConfig(c *Client, f *ManagedZonePeeringConfig, res *ManagedZone) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v, err := expandManagedZonePeeringConfigTargetNetwork(c, f.TargetNetwork, res); err != nil {
+		return nil, fmt.Errorf("error expanding TargetNetwork into targetNetwork: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["targetNetwork"] = v
+	}
+
+	return m, nil
+}
+
+// flattenManagedZonePeeringConfig flattens an instance of ManagedZonePeeringConfig from a JSON
+// response object.
+func flattenManagedZonePeeringConfig(c *Client, i interface{}, res *ManagedZone) *ManagedZonePeeringConfig {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &ManagedZonePeeringConfig{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyManagedZonePeeringConfig
+	}
+	r.TargetNetwork = flattenManagedZonePeeringConfigTargetNetwork(c, m["targetNetwork"], res)
+
+	return r
+}
+
+// expandManagedZonePeeringConfigTargetNetworkMap expands the contents of ManagedZonePeeringConfigTargetNetwork into a JSON
+// request object.
+func expandManagedZonePeeringConfigTargetNetworkMap(c *Client, f map[string]ManagedZonePeeringConfigTargetNetwork, res *ManagedZone) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandManagedZonePeeringConfigTargetNetwork(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandManagedZonePeeringConfigTargetNetworkSlice expands the contents of ManagedZonePeeringConfigTargetNetwork into a JSON
+// request object.
+func expandManagedZonePeeringConfigTargetNetworkSlice(c *Client, f []ManagedZonePeeringConfigTargetNetwork, res *ManagedZone) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandManagedZonePeeringConfigTargetNetwork(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenManagedZonePeeringConfigTargetNetworkMap flattens the contents of ManagedZonePeeringConfigTargetNetwork from a JSON
+// response object.
+func flattenManagedZonePeeringConfigTargetNetworkMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZonePeeringConfigTargetNetwork {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZonePeeringConfigTargetNetwork{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZonePeeringConfigTargetNetwork{}
+	}
+
+	items := make(map[string]ManagedZonePeeringConfigTargetNetwork)
+	for k, item := range a {
+		items[k] = *flattenManagedZonePeeringConfigTargetNetwork(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenManagedZonePeeringConfigTargetNetworkSlice flattens the contents of ManagedZonePeeringConfigTargetNetwork from a JSON
+// response object.
+func flattenManagedZonePeeringConfigTargetNetworkSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZonePeeringConfigTargetNetwork {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZonePeeringConfigTargetNetwork{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZonePeeringConfigTargetNetwork{}
+	}
+
+	items := make([]ManagedZonePeeringConfigTargetNetwork, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZonePeeringConfigTargetNetwork(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandManagedZonePeeringConfigTargetNetwork expands an instance of ManagedZonePeeringConfigTargetNetwork into a JSON
+// request object.
+func expandManagedZonePeeringConfigTargetNetwork(c *Client, f *ManagedZonePeeringConfigTargetNetwork, res *ManagedZone) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.NetworkUrl; !dcl.IsEmptyValueIndirect(v) {
+		m["networkUrl"] = v
+	}
+
+	return m, nil
+}
+
+// flattenManagedZonePeeringConfigTargetNetwork flattens an instance of ManagedZonePeeringConfigTargetNetwork from a JSON
+// response object.
+func flattenManagedZonePeeringConfigTargetNetwork(c *Client, i interface{}, res *ManagedZone) *ManagedZonePeeringConfigTargetNetwork {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &ManagedZonePeeringConfigTargetNetwork{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyManagedZonePeeringConfigTargetNetwork
+	}
+	r.NetworkUrl = dcl.FlattenString(m["networkUrl"])
+
+	return r
+}
+
+// flattenManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnumMap flattens the contents of ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnumMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum{}
+	}
+
+	items := make(map[string]ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum)
+	for k, item := range a {
+		items[k] = *flattenManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnumSlice flattens the contents of ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnumSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum{}
+	}
+
+	items := make([]ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum asserts that an interface is a string, and returns a
+// pointer to a *ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum with the same value as that string.
+func flattenManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum(i interface{}) *ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnumRef(s)
+}
+
+// flattenManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnumMap flattens the contents of ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnumMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum{}
+	}
+
+	items := make(map[string]ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum)
+	for k, item := range a {
+		items[k] = *flattenManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnumSlice flattens the contents of ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnumSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum{}
+	}
+
+	items := make([]ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum asserts that an interface is a string, and returns a
+// pointer to a *ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum with the same value as that string.
+func flattenManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum(i interface{}) *ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnumRef(s)
+}
+
+// flattenManagedZoneDnssecConfigNonExistenceEnumMap flattens the contents of ManagedZoneDnssecConfigNonExistenceEnum from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigNonExistenceEnumMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZoneDnssecConfigNonExistenceEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZoneDnssecConfigNonExistenceEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZoneDnssecConfigNonExistenceEnum{}
+	}
+
+	items := make(map[string]ManagedZoneDnssecConfigNonExistenceEnum)
+	for k, item := range a {
+		items[k] = *flattenManagedZoneDnssecConfigNonExistenceEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenManagedZoneDnssecConfigNonExistenceEnumSlice flattens the contents of ManagedZoneDnssecConfigNonExistenceEnum from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigNonExistenceEnumSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZoneDnssecConfigNonExistenceEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZoneDnssecConfigNonExistenceEnum{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZoneDnssecConfigNonExistenceEnum{}
+	}
+
+	items := make([]ManagedZoneDnssecConfigNonExistenceEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZoneDnssecConfigNonExistenceEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenManagedZoneDnssecConfigNonExistenceEnum asserts that an interface is a string, and returns a
+// pointer to a *ManagedZoneDnssecConfigNonExistenceEnum with the same value as that string.
+func flattenManagedZoneDnssecConfigNonExistenceEnum(i interface{}) *ManagedZoneDnssecConfigNonExistenceEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return ManagedZoneDnssecConfigNonExistenceEnumRef(s)
+}
+
+// flattenManagedZoneDnssecConfigStateEnumMap flattens the contents of ManagedZoneDnssecConfigStateEnum from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigStateEnumMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZoneDnssecConfigStateEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZoneDnssecConfigStateEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZoneDnssecConfigStateEnum{}
+	}
+
+	items := make(map[string]ManagedZoneDnssecConfigStateEnum)
+	for k, item := range a {
+		items[k] = *flattenManagedZoneDnssecConfigStateEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenManagedZoneDnssecConfigStateEnumSlice flattens the contents of ManagedZoneDnssecConfigStateEnum from a JSON
+// response object.
+func flattenManagedZoneDnssecConfigStateEnumSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZoneDnssecConfigStateEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZoneDnssecConfigStateEnum{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZoneDnssecConfigStateEnum{}
+	}
+
+	items := make([]ManagedZoneDnssecConfigStateEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZoneDnssecConfigStateEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenManagedZoneDnssecConfigStateEnum asserts that an interface is a string, and returns a
+// pointer to a *ManagedZoneDnssecConfigStateEnum with the same value as that string.
+func flattenManagedZoneDnssecConfigStateEnum(i interface{}) *ManagedZoneDnssecConfigStateEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return ManagedZoneDnssecConfigStateEnumRef(s)
+}
+
+// flattenManagedZoneForwardingConfigTargetNameServersForwardingPathEnumMap flattens the contents of ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum from a JSON
+// response object.
+func flattenManagedZoneForwardingConfigTargetNameServersForwardingPathEnumMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum{}
+	}
+
+	items := make(map[string]ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum)
+	for k, item := range a {
+		items[k] = *flattenManagedZoneForwardingConfigTargetNameServersForwardingPathEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenManagedZoneForwardingConfigTargetNameServersForwardingPathEnumSlice flattens the contents of ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum from a JSON
+// response object.
+func flattenManagedZoneForwardingConfigTargetNameServersForwardingPathEnumSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum{}
+	}
+
+	items := make([]ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZoneForwardingConfigTargetNameServersForwardingPathEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenManagedZoneForwardingConfigTargetNameServersForwardingPathEnum asserts that an interface is a string, and returns a
+// pointer to a *ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum with the same value as that string.
+func flattenManagedZoneForwardingConfigTargetNameServersForwardingPathEnum(i interface{}) *ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return ManagedZoneForwardingConfigTargetNameServersForwardingPathEnumRef(s)
+}
+
+// flattenManagedZoneVisibilityEnumMap flattens the contents of ManagedZoneVisibilityEnum from a JSON
+// response object.
+func flattenManagedZoneVisibilityEnumMap(c *Client, i interface{}, res *ManagedZone) map[string]ManagedZoneVisibilityEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ManagedZoneVisibilityEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ManagedZoneVisibilityEnum{}
+	}
+
+	items := make(map[string]ManagedZoneVisibilityEnum)
+	for k, item := range a {
+		items[k] = *flattenManagedZoneVisibilityEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenManagedZoneVisibilityEnumSlice flattens the contents of ManagedZoneVisibilityEnum from a JSON
+// response object.
+func flattenManagedZoneVisibilityEnumSlice(c *Client, i interface{}, res *ManagedZone) []ManagedZoneVisibilityEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ManagedZoneVisibilityEnum{}
+	}
+
+	if len(a) == 0 {
+		return []ManagedZoneVisibilityEnum{}
+	}
+
+	items := make([]ManagedZoneVisibilityEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenManagedZoneVisibilityEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenManagedZoneVisibilityEnum asserts that an interface is a string, and returns a
+// pointer to a *ManagedZoneVisibilityEnum with the same value as that string.
+func flattenManagedZoneVisibilityEnum(i interface{}) *ManagedZoneVisibilityEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return ManagedZoneVisibilityEnumRef(s)
+}
+
+// This function returns a matcher that checks whether a serialized resource matches this resource
+// in its parameters (as defined by the fields in a Get, which definitionally define resource
+// identity).  This is useful in extracting the element from a List call.
+func (r *ManagedZone) matcher(c *Client) func([]byte) bool {
+	return func(b []byte) bool {
+		cr, err := unmarshalManagedZone(b, c, r)
+		if err != nil {
+			c.Config.Logger.Warning("failed to unmarshal provided resource in matcher.")
+			return false
+		}
+		nr := r.urlNormalized()
+		ncr := cr.urlNormalized()
+		c.Config.Logger.Infof("looking for %v\nin %v", nr, ncr)
+
+		if nr.Project == nil && ncr.Project == nil {
+			c.Config.Logger.Info("Both Project fields null - considering equal.")
+		} else if nr.Project == nil || ncr.Project == nil {
+			c.Config.Logger.Info("Only one Project field is null - considering unequal.")
+			return false
+		} else if *nr.Project != *ncr.Project {
+			return false
+		}
+		if nr.Name == nil && ncr.Name == nil {
+			c.Config.Logger.Info("Both Name fields null - considering equal.")
+		} else if nr.Name == nil || ncr.Name == nil {
+			c.Config.Logger.Info("Only one Name field is null - considering unequal.")
+			return false
+		} else if *nr.Name != *ncr.Name {
+			return false
+		}
+		return true
+	}
+}
+
+type managedZoneDiff struct {
+	// The diff should include one or the other of RequiresRecreate or UpdateOp.
+	RequiresRecreate bool
+	UpdateOp         managedZoneApiOperation
+	FieldName        string // used for error logging
+}
+
+func convertFieldDiffsToManagedZoneDiffs(config *dcl.Config, fds []*dcl.FieldDiff, opts []dcl.ApplyOption) ([]managedZoneDiff, error) {
+	opNamesToFieldDiffs := make(map[string][]*dcl.FieldDiff)
+	// Map each operation name to the field diffs associated with it.
+	for _, fd := range fds {
+		for _, ro := range fd.ResultingOperation {
+			if fieldDiffs, ok := opNamesToFieldDiffs[ro]; ok {
+				fieldDiffs = append(fieldDiffs, fd)
+				opNamesToFieldDiffs[ro] = fieldDiffs
+			} else {
+				config.Logger.Infof("%s required due to diff: %v", ro, fd)
+				opNamesToFieldDiffs[ro] = []*dcl.FieldDiff{fd}
+			}
+		}
+	}
+	var diffs []managedZoneDiff
+	// For each operation name, create a managedZoneDiff which contains the operation.
+	for opName, fieldDiffs := range opNamesToFieldDiffs {
+		// Use the first field diff's field name for logging required recreate error.
+		diff := managedZoneDiff{FieldName: fieldDiffs[0].FieldName}
+		if opName == "Recreate" {
+			diff.RequiresRecreate = true
+		} else {
+			apiOp, err := convertOpNameToManagedZoneApiOperation(opName, fieldDiffs, opts...)
+			if err != nil {
+				return diffs, err
+			}
+			diff.UpdateOp = apiOp
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+func convertOpNameToManagedZoneApiOperation(opName string, fieldDiffs []*dcl.FieldDiff, opts ...dcl.ApplyOption) (managedZoneApiOperation, error) {
+	switch opName {
+
+	case "updateManagedZonePatchOperation":
+		return &updateManagedZonePatchOperation{FieldDiffs: fieldDiffs}, nil
+
+	default:
+		return nil, fmt.Errorf("no such operation with name: %v", opName)
+	}
+}
+
+func extractManagedZoneFields(r *ManagedZone) error {
+	vDnssecConfig := r.DnssecConfig
+	if vDnssecConfig == nil {
+		// note: explicitly not the empty object.
+		vDnssecConfig = &ManagedZoneDnssecConfig{}
+	}
+	if err := extractManagedZoneDnssecConfigFields(r, vDnssecConfig); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vDnssecConfig) {
+		r.DnssecConfig = vDnssecConfig
+	}
+	vPrivateVisibilityConfig := r.PrivateVisibilityConfig
+	if vPrivateVisibilityConfig == nil {
+		// note: explicitly not the empty object.
+		vPrivateVisibilityConfig = &ManagedZonePrivateVisibilityConfig{}
+	}
+	if err := extractManagedZonePrivateVisibilityConfigFields(r, vPrivateVisibilityConfig); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vPrivateVisibilityConfig) {
+		r.PrivateVisibilityConfig = vPrivateVisibilityConfig
+	}
+	vForwardingConfig := r.ForwardingConfig
+	if vForwardingConfig == nil {
+		// note: explicitly not the empty object.
+		vForwardingConfig = &ManagedZoneForwardingConfig{}
+	}
+	if err := extractManagedZoneForwardingConfigFields(r, vForwardingConfig); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vForwardingConfig) {
+		r.ForwardingConfig = vForwardingConfig
+	}
+	vPeeringConfig := r.PeeringConfig
+	if vPeeringConfig == nil {
+		// note: explicitly not the empty object.
+		vPeeringConfig = &ManagedZonePeeringConfig{}
+	}
+	if err := extractManagedZonePeeringConfigFields(r, vPeeringConfig); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vPeeringConfig) {
+		r.PeeringConfig = vPeeringConfig
+	}
+	return nil
+}
+func extractManagedZoneDnssecConfigFields(r *ManagedZone, o *ManagedZoneDnssecConfig) error {
+	return nil
+}
+func extractManagedZoneDnssecConfigDefaultKeySpecsFields(r *ManagedZone, o *ManagedZoneDnssecConfigDefaultKeySpecs) error {
+	return nil
+}
+func extractManagedZonePrivateVisibilityConfigFields(r *ManagedZone, o *ManagedZonePrivateVisibilityConfig) error {
+	return nil
+}
+func extractManagedZonePrivateVisibilityConfigNetworksFields(r *ManagedZone, o *ManagedZonePrivateVisibilityConfigNetworks) error {
+	return nil
+}
+func extractManagedZoneForwardingConfigFields(r *ManagedZone, o *ManagedZoneForwardingConfig) error {
+	return nil
+}
+func extractManagedZoneForwardingConfigTargetNameServersFields(r *ManagedZone, o *ManagedZoneForwardingConfigTargetNameServers) error {
+	return nil
+}
+func extractManagedZonePeeringConfigFields(r *ManagedZone, o *ManagedZonePeeringConfig) error {
+	vTargetNetwork := o.TargetNetwork
+	if vTargetNetwork == nil {
+		// note: explicitly not the empty object.
+		vTargetNetwork = &ManagedZonePeeringConfigTargetNetwork{}
+	}
+	if err := extractManagedZonePeeringConfigTargetNetworkFields(r, vTargetNetwork); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vTargetNetwork) {
+		o.TargetNetwork = vTargetNetwork
+	}
+	return nil
+}
+func extractManagedZonePeeringConfigTargetNetworkFields(r *ManagedZone, o *ManagedZonePeeringConfigTargetNetwork) error {
+	return nil
+}
+
+func postReadExtractManagedZoneFields(r *ManagedZone) error {
+	vDnssecConfig := r.DnssecConfig
+	if vDnssecConfig == nil {
+		// note: explicitly not the empty object.
+		vDnssecConfig = &ManagedZoneDnssecConfig{}
+	}
+	if err := postReadExtractManagedZoneDnssecConfigFields(r, vDnssecConfig); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vDnssecConfig) {
+		r.DnssecConfig = vDnssecConfig
+	}
+	vPrivateVisibilityConfig := r.PrivateVisibilityConfig
+	if vPrivateVisibilityConfig == nil {
+		// note: explicitly not the empty object.
+		vPrivateVisibilityConfig = &ManagedZonePrivateVisibilityConfig{}
+	}
+	if err := postReadExtractManagedZonePrivateVisibilityConfigFields(r, vPrivateVisibilityConfig); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vPrivateVisibilityConfig) {
+		r.PrivateVisibilityConfig = vPrivateVisibilityConfig
+	}
+	vForwardingConfig := r.ForwardingConfig
+	if vForwardingConfig == nil {
+		// note: explicitly not the empty object.
+		vForwardingConfig = &ManagedZoneForwardingConfig{}
+	}
+	if err := postReadExtractManagedZoneForwardingConfigFields(r, vForwardingConfig); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vForwardingConfig) {
+		r.ForwardingConfig = vForwardingConfig
+	}
+	vPeeringConfig := r.PeeringConfig
+	if vPeeringConfig == nil {
+		// note: explicitly not the empty object.
+		vPeeringConfig = &ManagedZonePeeringConfig{}
+	}
+	if err := postReadExtractManagedZonePeeringConfigFields(r, vPeeringConfig); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vPeeringConfig) {
+		r.PeeringConfig = vPeeringConfig
+	}
+	return nil
+}
+func postReadExtractManagedZoneDnssecConfigFields(r *ManagedZone, o *ManagedZoneDnssecConfig) error {
+	return nil
+}
+func postReadExtractManagedZoneDnssecConfigDefaultKeySpecsFields(r *ManagedZone, o *ManagedZoneDnssecConfigDefaultKeySpecs) error {
+	return nil
+}
+func postReadExtractManagedZonePrivateVisibilityConfigFields(r *ManagedZone, o *ManagedZonePrivateVisibilityConfig) error {
+	return nil
+}
+func postReadExtractManagedZonePrivateVisibilityConfigNetworksFields(r *ManagedZone, o *ManagedZonePrivateVisibilityConfigNetworks) error {
+	return nil
+}
+func postReadExtractManagedZoneForwardingConfigFields(r *ManagedZone, o *ManagedZoneForwardingConfig) error {
+	return nil
+}
+func postReadExtractManagedZoneForwardingConfigTargetNameServersFields(r *ManagedZone, o *ManagedZoneForwardingConfigTargetNameServers) error {
+	return nil
+}
+func postReadExtractManagedZonePeeringConfigFields(r *ManagedZone, o *ManagedZonePeeringConfig) error {
+	vTargetNetwork := o.TargetNetwork
+	if vTargetNetwork == nil {
+		// note: explicitly not the empty object.
+		vTargetNetwork = &ManagedZonePeeringConfigTargetNetwork{}
+	}
+	if err := postReadExtractManagedZonePeeringConfigTargetNetworkFields(r, vTargetNetwork); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vTargetNetwork) {
+		o.TargetNetwork = vTargetNetwork
+	}
+	return nil
+}
+func postReadExtractManagedZonePeeringConfigTargetNetworkFields(r *ManagedZone, o *ManagedZonePeeringConfigTargetNetwork) error {
+	return nil
+}
@@ -0,0 +1,357 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dns
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLManagedZoneSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Dns/ManagedZone",
+			Description: "The Dns ManagedZone resource",
+			StructName:  "ManagedZone",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a ManagedZone",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "managedZone",
+						Required:    true,
+						Description: "A full instance of a ManagedZone",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a ManagedZone",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "managedZone",
+						Required:    true,
+						Description: "A full instance of a ManagedZone",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a ManagedZone",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "managedZone",
+						Required:    true,
+						Description: "A full instance of a ManagedZone",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all ManagedZone",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many ManagedZone",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"ManagedZone": &dcl.Component{
+					Title:           "ManagedZone",
+					ID:              "projects/{{project}}/managedZones/{{name}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"name",
+							"dnsName",
+							"project",
+						},
+						Properties: map[string]*dcl.Property{
+							"description": &dcl.Property{
+								Type:        "string",
+								GoName:      "Description",
+								Description: "A mutable string of at most 1024 characters associated with this resource for the user's convenience. Has no effect on the managed zone's function.",
+							},
+							"dnsName": &dcl.Property{
+								Type:        "string",
+								GoName:      "DnsName",
+								Description: "The DNS name of this managed zone, for instance \"example.com.\".",
+								Immutable:   true,
+							},
+							"dnssecConfig": &dcl.Property{
+								Type:          "object",
+								GoName:        "DnssecConfig",
+								GoType:        "ManagedZoneDnssecConfig",
+								Description:   "DNSSEC configuration.",
+								ServerDefault: true,
+								Properties: map[string]*dcl.Property{
+									"defaultKeySpecs": &dcl.Property{
+										Type:          "array",
+										GoName:        "DefaultKeySpecs",
+										Description:   "Specifies parameters for generating initial DnsKeys for this ManagedZone. Can only be changed while the state is OFF.",
+										ServerDefault: true,
+										SendEmpty:     true,
+										ListType:      "list",
+										Items: &dcl.Property{
+											Type:   "object",
+											GoType: "ManagedZoneDnssecConfigDefaultKeySpecs",
+											Properties: map[string]*dcl.Property{
+												"algorithm": &dcl.Property{
+													Type:        "string",
+													GoName:      "Algorithm",
+													GoType:      "ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum",
+													Description: "String mnemonic specifying the DNSSEC algorithm of this key. Possible values: ecdsap256sha256, ecdsap384sha384, rsasha1, rsasha256, rsasha512",
+													Enum: []string{
+														"ecdsap256sha256",
+														"ecdsap384sha384",
+														"rsasha1",
+														"rsasha256",
+														"rsasha512",
+													},
+												},
+												"keyLength": &dcl.Property{
+													Type:        "integer",
+													Format:      "int64",
+													GoName:      "KeyLength",
+													Description: "Length of the keys in bits.",
+												},
+												"keyType": &dcl.Property{
+													Type:        "string",
+													GoName:      "KeyType",
+													GoType:      "ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum",
+													Description: "Specifies whether this is a key signing key (KSK) or a zone signing key (ZSK). Key signing keys have the Secure Entry Point flag set and, when active, are only used to sign resource record sets of type DNSKEY. Zone signing keys do not have the Secure Entry Point flag set and are used to sign all other types of resource record sets. Possible values: keySigning, zoneSigning",
+													Enum: []string{
+														"keySigning",
+														"zoneSigning",
+													},
+												},
+												"kind": &dcl.Property{
+													Type:          "string",
+													GoName:        "Kind",
+													Description:   "Identifies what kind of resource this is. Value: the fixed string `dns#dnsKeySpec`.",
+													ServerDefault: true,
+												},
+											},
+										},
+									},
+									"kind": &dcl.Property{
+										Type:          "string",
+										GoName:        "Kind",
+										Description:   "Identifies what kind of resource this is. Value: the fixed string `dns#managedZoneDnsSecConfig`.",
+										ServerDefault: true,
+									},
+									"nonExistence": &dcl.Property{
+										Type:          "string",
+										GoName:        "NonExistence",
+										GoType:        "ManagedZoneDnssecConfigNonExistenceEnum",
+										Description:   "Specifies the mechanism for authenticated denial-of-existence responses. Can only be changed while the state is OFF. Possible values: nsec, nsec3",
+										ServerDefault: true,
+										Enum: []string{
+											"nsec",
+											"nsec3",
+										},
+									},
+									"state": &dcl.Property{
+										Type:        "string",
+										GoName:      "State",
+										GoType:      "ManagedZoneDnssecConfigStateEnum",
+										Description: "Specifies whether DNSSEC is enabled, and what mode it is in. Possible values: off, on, transfer",
+										Enum: []string{
+											"off",
+											"on",
+											"transfer",
+										},
+									},
+								},
+							},
+							"forwardingConfig": &dcl.Property{
+								Type:        "object",
+								GoName:      "ForwardingConfig",
+								GoType:      "ManagedZoneForwardingConfig",
+								Description: "The presence for this field indicates that outbound forwarding is enabled for this zone. The value of this field contains the set of destinations to forward to.",
+								Properties: map[string]*dcl.Property{
+									"targetNameServers": &dcl.Property{
+										Type:        "array",
+										GoName:      "TargetNameServers",
+										Description: "List of target name servers to forward to. Cloud DNS selects the best available name server if more than one target is given.",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "object",
+											GoType: "ManagedZoneForwardingConfigTargetNameServers",
+											Required: []string{
+												"ipv4Address",
+											},
+											Properties: map[string]*dcl.Property{
+												"forwardingPath": &dcl.Property{
+													Type:        "string",
+													GoName:      "ForwardingPath",
+													GoType:      "ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum",
+													Description: "Forwarding path for this NameServerTarget. If unset or set to DEFAULT, Cloud DNS makes forwarding decisions based on IP address ranges; that is, RFC1918 addresses go to the VPC network, non-RFC1918 addresses go to the internet. When set to PRIVATE, Cloud DNS always sends queries through the VPC network for this target. Possible values: default, private",
+													Enum: []string{
+														"default",
+														"private",
+													},
+												},
+												"ipv4Address": &dcl.Property{
+													Type:        "string",
+													GoName:      "IPv4Address",
+													Description: "IPv4 address of a target name server.",
+												},
+											},
+										},
+									},
+								},
+							},
+							"labels": &dcl.Property{
+								Type: "object",
+								AdditionalProperties: &dcl.Property{
+									Type: "string",
+								},
+								GoName:      "Labels",
+								Description: "User labels.",
+							},
+							"name": &dcl.Property{
+								Type:        "string",
+								GoName:      "Name",
+								Description: "User assigned name for this resource. Must be unique within the project. The name must be 1-63 characters long, must begin with a letter, end with a letter or digit, and only contain lowercase letters, digits or dashes.",
+								Immutable:   true,
+							},
+							"nameServers": &dcl.Property{
+								Type:        "array",
+								GoName:      "NameServers",
+								ReadOnly:    true,
+								Description: "Output only. Delegate your managed_zone to these virtual name servers; defined by the server.",
+								Immutable:   true,
+								SendEmpty:   true,
+								ListType:    "list",
+								Items: &dcl.Property{
+									Type:   "string",
+									GoType: "string",
+								},
+							},
+							"peeringConfig": &dcl.Property{
+								Type:        "object",
+								GoName:      "PeeringConfig",
+								GoType:      "ManagedZonePeeringConfig",
+								Description: "The presence of this field indicates that DNS Peering is enabled for this zone. The value of this field contains the network to peer with.",
+								Immutable:   true,
+								Required: []string{
+									"targetNetwork",
+								},
+								Properties: map[string]*dcl.Property{
+									"targetNetwork": &dcl.Property{
+										Type:        "object",
+										GoName:      "TargetNetwork",
+										GoType:      "ManagedZonePeeringConfigTargetNetwork",
+										Description: "The network with which to peer.",
+										Immutable:   true,
+										Required: []string{
+											"networkUrl",
+										},
+										Properties: map[string]*dcl.Property{
+											"networkUrl": &dcl.Property{
+												Type:        "string",
+												GoName:      "NetworkUrl",
+												Description: "The fully qualified URL of the VPC network to forward queries to. This should be formatted like `https://www.googleapis.com/compute/v1/projects/{project}/global/networks/{network}`",
+												Immutable:   true,
+											},
+										},
+									},
+								},
+							},
+							"privateVisibilityConfig": &dcl.Property{
+								Type:        "object",
+								GoName:      "PrivateVisibilityConfig",
+								GoType:      "ManagedZonePrivateVisibilityConfig",
+								Description: "For privately visible zones, the set of Virtual Private Cloud resources that the zone is visible from.",
+								Properties: map[string]*dcl.Property{
+									"networks": &dcl.Property{
+										Type:        "array",
+										GoName:      "Networks",
+										Description: "The list of VPC networks that can see this zone.",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "object",
+											GoType: "ManagedZonePrivateVisibilityConfigNetworks",
+											Required: []string{
+												"networkUrl",
+											},
+											Properties: map[string]*dcl.Property{
+												"networkUrl": &dcl.Property{
+													Type:        "string",
+													GoName:      "NetworkUrl",
+													Description: "The fully qualified URL of the VPC network to bind to. Format this URL like `https://www.googleapis.com/compute/v1/projects/{project}/global/networks/{network}`",
+												},
+											},
+										},
+									},
+								},
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "The project for the resource",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"reverseLookup": &dcl.Property{
+								Type:        "boolean",
+								GoName:      "ReverseLookup",
+								Description: "The presence of this field indicates that this is a managed reverse lookup zone and Cloud DNS resolves reverse lookup queries using automatically configured records for VPC resources. This only applies to networks listed under private_visibility_config.",
+								Immutable:   true,
+							},
+							"visibility": &dcl.Property{
+								Type:          "string",
+								GoName:        "Visibility",
+								GoType:        "ManagedZoneVisibilityEnum",
+								Description:   "The zone's visibility: public zones are exposed to the Internet, while private zones are visible only to Virtual Private Cloud resources. Possible values: private, public",
+								Immutable:     true,
+								ServerDefault: true,
+								Enum: []string{
+									"private",
+									"public",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
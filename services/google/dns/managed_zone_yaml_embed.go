@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package dns -var YAML_managed_zone blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/dns/managed_zone.yaml
+
+package dns
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/dns/managed_zone.yaml
+var YAML_managed_zone = []byte("info:\n  title: Dns/ManagedZone\n  description: The Dns ManagedZone resource\n  x-dcl-struct-name: ManagedZone\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a ManagedZone\n    parameters:\n    - name: managedZone\n      required: true\n      description: A full instance of a ManagedZone\n  apply:\n    description: The function used to apply information about a ManagedZone\n    parameters:\n    - name: managedZone\n      required: true\n      description: A full instance of a ManagedZone\n  delete:\n    description: The function used to delete a ManagedZone\n    parameters:\n    - name: managedZone\n      required: true\n      description: A full instance of a ManagedZone\n  deleteAll:\n    description: The function used to delete all ManagedZone\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many ManagedZone\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    ManagedZone:\n      title: ManagedZone\n      x-dcl-id: projects/{{project}}/managedZones/{{name}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - name\n      - dnsName\n      - project\n      properties:\n        description:\n          type: string\n          x-dcl-go-name: Description\n          description: A mutable string of at most 1024 characters associated with\n            this resource for the user's convenience. Has no effect on the managed\n            zone's function.\n        dnsName:\n          type: string\n          x-dcl-go-name: DnsName\n          description: The DNS name of this managed zone, for instance \"example.com.\".\n          x-kubernetes-immutable: true\n        dnssecConfig:\n          type: object\n          x-dcl-go-name: DnssecConfig\n          x-dcl-go-type: ManagedZoneDnssecConfig\n          description: DNSSEC configuration.\n          x-dcl-server-default: true\n          properties:\n            defaultKeySpecs:\n              type: array\n              x-dcl-go-name: DefaultKeySpecs\n              description: Specifies parameters for generating initial DnsKeys for\n                this ManagedZone. Can only be changed while the state is OFF.\n              x-dcl-server-default: true\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: object\n                x-dcl-go-type: ManagedZoneDnssecConfigDefaultKeySpecs\n                properties:\n                  algorithm:\n                    type: string\n                    x-dcl-go-name: Algorithm\n                    x-dcl-go-type: ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnum\n                    description: 'String mnemonic specifying the DNSSEC algorithm\n                      of this key. Possible values: ecdsap256sha256, ecdsap384sha384,\n                      rsasha1, rsasha256, rsasha512'\n                    enum:\n                    - ecdsap256sha256\n                    - ecdsap384sha384\n                    - rsasha1\n                    - rsasha256\n                    - rsasha512\n                  keyLength:\n                    type: integer\n                    format: int64\n                    x-dcl-go-name: KeyLength\n                    description: Length of the keys in bits.\n                  keyType:\n                    type: string\n                    x-dcl-go-name: KeyType\n                    x-dcl-go-type: ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnum\n                    description: 'Specifies whether this is a key signing key (KSK)\n                      or a zone signing key (ZSK). Key signing keys have the Secure\n                      Entry Point flag set and, when active, are only used to sign\n                      resource record sets of type DNSKEY. Zone signing keys do not\n                      have the Secure Entry Point flag set and are used to sign all\n                      other types of resource record sets. Possible values: keySigning,\n                      zoneSigning'\n                    enum:\n                    - keySigning\n                    - zoneSigning\n                  kind:\n                    type: string\n                    x-dcl-go-name: Kind\n                    description: 'Identifies what kind of resource this is. Value:\n                      the fixed string `dns#dnsKeySpec`.'\n                    x-dcl-server-default: true\n            kind:\n              type: string\n              x-dcl-go-name: Kind\n              description: 'Identifies what kind of resource this is. Value: the fixed\n                string `dns#managedZoneDnsSecConfig`.'\n              x-dcl-server-default: true\n            nonExistence:\n              type: string\n              x-dcl-go-name: NonExistence\n              x-dcl-go-type: ManagedZoneDnssecConfigNonExistenceEnum\n              description: 'Specifies the mechanism for authenticated denial-of-existence\n                responses. Can only be changed while the state is OFF. Possible values:\n                nsec, nsec3'\n              x-dcl-server-default: true\n              enum:\n              - nsec\n              - nsec3\n            state:\n              type: string\n              x-dcl-go-name: State\n              x-dcl-go-type: ManagedZoneDnssecConfigStateEnum\n              description: 'Specifies whether DNSSEC is enabled, and what mode it\n                is in. Possible values: off, on, transfer'\n              enum:\n              - 'off'\n              - 'on'\n              - transfer\n        forwardingConfig:\n          type: object\n          x-dcl-go-name: ForwardingConfig\n          x-dcl-go-type: ManagedZoneForwardingConfig\n          description: The presence for this field indicates that outbound forwarding\n            is enabled for this zone. The value of this field contains the set of\n            destinations to forward to.\n          properties:\n            targetNameServers:\n              type: array\n              x-dcl-go-name: TargetNameServers\n              description: List of target name servers to forward to. Cloud DNS selects\n                the best available name server if more than one target is given.\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: object\n                x-dcl-go-type: ManagedZoneForwardingConfigTargetNameServers\n                required:\n                - ipv4Address\n                properties:\n                  forwardingPath:\n                    type: string\n                    x-dcl-go-name: ForwardingPath\n                    x-dcl-go-type: ManagedZoneForwardingConfigTargetNameServersForwardingPathEnum\n                    description: 'Forwarding path for this NameServerTarget. If unset\n                      or set to DEFAULT, Cloud DNS makes forwarding decisions based\n                      on IP address ranges; that is, RFC1918 addresses go to the VPC\n                      network, non-RFC1918 addresses go to the internet. When set\n                      to PRIVATE, Cloud DNS always sends queries through the VPC network\n                      for this target. Possible values: default, private'\n                    enum:\n                    - default\n                    - private\n                  ipv4Address:\n                    type: string\n                    x-dcl-go-name: IPv4Address\n                    description: IPv4 address of a target name server.\n        labels:\n          type: object\n          additionalProperties:\n            type: string\n          x-dcl-go-name: Labels\n          description: User labels.\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: User assigned name for this resource. Must be unique within\n            the project. The name must be 1-63 characters long, must begin with a\n            letter, end with a letter or digit, and only contain lowercase letters,\n            digits or dashes.\n          x-kubernetes-immutable: true\n        nameServers:\n          type: array\n          x-dcl-go-name: NameServers\n          readOnly: true\n          description: Output only. Delegate your managed_zone to these virtual name\n            servers; defined by the server.\n          x-kubernetes-immutable: true\n          x-dcl-send-empty: true\n          x-dcl-list-type: list\n          items:\n            type: string\n            x-dcl-go-type: string\n        peeringConfig:\n          type: object\n          x-dcl-go-name: PeeringConfig\n          x-dcl-go-type: ManagedZonePeeringConfig\n          description: The presence of this field indicates that DNS Peering is enabled\n            for this zone. The value of this field contains the network to peer with.\n          x-kubernetes-immutable: true\n          required:\n          - targetNetwork\n          properties:\n            targetNetwork:\n              type: object\n              x-dcl-go-name: TargetNetwork\n              x-dcl-go-type: ManagedZonePeeringConfigTargetNetwork\n              description: The network with which to peer.\n              x-kubernetes-immutable: true\n              required:\n              - networkUrl\n              properties:\n                networkUrl:\n                  type: string\n                  x-dcl-go-name: NetworkUrl\n                  description: The fully qualified URL of the VPC network to forward\n                    queries to. This should be formatted like `https://www.googleapis.com/compute/v1/projects/{project}/global/networks/{network}`\n                  x-kubernetes-immutable: true\n        privateVisibilityConfig:\n          type: object\n          x-dcl-go-name: PrivateVisibilityConfig\n          x-dcl-go-type: ManagedZonePrivateVisibilityConfig\n          description: For privately visible zones, the set of Virtual Private Cloud\n            resources that the zone is visible from.\n          properties:\n            networks:\n              type: array\n              x-dcl-go-name: Networks\n              description: The list of VPC networks that can see this zone.\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: object\n                x-dcl-go-type: ManagedZonePrivateVisibilityConfigNetworks\n                required:\n                - networkUrl\n                properties:\n                  networkUrl:\n                    type: string\n                    x-dcl-go-name: NetworkUrl\n                    description: The fully qualified URL of the VPC network to bind\n                      to. Format this URL like `https://www.googleapis.com/compute/v1/projects/{project}/global/networks/{network}`\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: The project for the resource\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        reverseLookup:\n          type: boolean\n          x-dcl-go-name: ReverseLookup\n          description: The presence of this field indicates that this is a managed\n            reverse lookup zone and Cloud DNS resolves reverse lookup queries using\n            automatically configured records for VPC resources. This only applies\n            to networks listed under private_visibility_config.\n          x-kubernetes-immutable: true\n        visibility:\n          type: string\n          x-dcl-go-name: Visibility\n          x-dcl-go-type: ManagedZoneVisibilityEnum\n          description: 'The zone''s visibility: public zones are exposed to the Internet,\n            while private zones are visible only to Virtual Private Cloud resources.\n            Possible values: private, public'\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n          enum:\n          - private\n          - public\n")
+
+// 12040 bytes
+// MD5: 168fd070c36a255ee7313dce6e001e5f
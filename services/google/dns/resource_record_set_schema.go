@@ -0,0 +1,173 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dns
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLResourceRecordSetSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Dns/ResourceRecordSet",
+			Description: "The Dns ResourceRecordSet resource",
+			StructName:  "ResourceRecordSet",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a ResourceRecordSet",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "resourceRecordSet",
+						Required:    true,
+						Description: "A full instance of a ResourceRecordSet",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a ResourceRecordSet",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "resourceRecordSet",
+						Required:    true,
+						Description: "A full instance of a ResourceRecordSet",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a ResourceRecordSet",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "resourceRecordSet",
+						Required:    true,
+						Description: "A full instance of a ResourceRecordSet",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all ResourceRecordSet",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "managedZone",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many ResourceRecordSet",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "managedZone",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"ResourceRecordSet": &dcl.Component{
+					Title:           "ResourceRecordSet",
+					ID:              "projects/{{project}}/managedZones/{{managed_zone}}/rrsets/{{dns_name}}/{{dns_type}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"dnsName",
+							"dnsType",
+							"managedZone",
+							"project",
+						},
+						Properties: map[string]*dcl.Property{
+							"dnsName": &dcl.Property{
+								Type:        "string",
+								GoName:      "DnsName",
+								Description: "For example, www.example.com.",
+								Immutable:   true,
+							},
+							"dnsType": &dcl.Property{
+								Type:        "string",
+								GoName:      "DnsType",
+								Description: "The identifier of a supported record type. See the list of Supported DNS record types.",
+								Immutable:   true,
+							},
+							"managedZone": &dcl.Property{
+								Type:        "string",
+								GoName:      "ManagedZone",
+								Description: "Identifies the managed zone addressed by this request.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Dns/ManagedZone",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "Identifies the project addressed by this request.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"target": &dcl.Property{
+								Type:        "array",
+								GoName:      "Target",
+								Description: "As defined in RFC 1035 (section 5) and RFC 1034 (section 3.6.1) -- see examples.",
+								SendEmpty:   true,
+								ListType:    "list",
+								Items: &dcl.Property{
+									Type:   "string",
+									GoType: "string",
+								},
+							},
+							"ttl": &dcl.Property{
+								Type:        "integer",
+								Format:      "int64",
+								GoName:      "Ttl",
+								Description: "Number of seconds that this ResourceRecordSet can be cached by resolvers.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package dns -var YAML_resource_record_set blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/dns/resource_record_set.yaml
+
+package dns
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/dns/resource_record_set.yaml
+var YAML_resource_record_set = []byte("info:\n  title: Dns/ResourceRecordSet\n  description: The Dns ResourceRecordSet resource\n  x-dcl-struct-name: ResourceRecordSet\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a ResourceRecordSet\n    parameters:\n    - name: resourceRecordSet\n      required: true\n      description: A full instance of a ResourceRecordSet\n  apply:\n    description: The function used to apply information about a ResourceRecordSet\n    parameters:\n    - name: resourceRecordSet\n      required: true\n      description: A full instance of a ResourceRecordSet\n  delete:\n    description: The function used to delete a ResourceRecordSet\n    parameters:\n    - name: resourceRecordSet\n      required: true\n      description: A full instance of a ResourceRecordSet\n  deleteAll:\n    description: The function used to delete all ResourceRecordSet\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: managedZone\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many ResourceRecordSet\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: managedZone\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    ResourceRecordSet:\n      title: ResourceRecordSet\n      x-dcl-id: projects/{{project}}/managedZones/{{managed_zone}}/rrsets/{{dns_name}}/{{dns_type}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - dnsName\n      - dnsType\n      - managedZone\n      - project\n      properties:\n        dnsName:\n          type: string\n          x-dcl-go-name: DnsName\n          description: For example, www.example.com.\n          x-kubernetes-immutable: true\n        dnsType:\n          type: string\n          x-dcl-go-name: DnsType\n          description: The identifier of a supported record type. See the list of\n            Supported DNS record types.\n          x-kubernetes-immutable: true\n        managedZone:\n          type: string\n          x-dcl-go-name: ManagedZone\n          description: Identifies the managed zone addressed by this request.\n          x-dcl-references:\n          - resource: Dns/ManagedZone\n            field: name\n            parent: true\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: Identifies the project addressed by this request.\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        target:\n          type: array\n          x-dcl-go-name: Target\n          description: As defined in RFC 1035 (section 5) and RFC 1034 (section 3.6.1)\n            -- see examples.\n          x-dcl-send-empty: true\n          x-dcl-list-type: list\n          items:\n            type: string\n            x-dcl-go-type: string\n        ttl:\n          type: integer\n          format: int64\n          x-dcl-go-name: Ttl\n          description: Number of seconds that this ResourceRecordSet can be cached\n            by resolvers.\n")
+
+// 3286 bytes
+// MD5: a6a47f0ad8653dc81cc26720d8dbb66b
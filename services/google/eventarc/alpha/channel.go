@@ -114,8 +114,12 @@ func (l *ChannelList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ChannelList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ChannelList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Channel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -132,6 +136,7 @@ func (l *ChannelList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListChannel(ctx context.Context, project, location string) (*ChannelList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Channel{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -139,7 +144,7 @@ func (c *Client) ListChannel(ctx context.Context, project, location string) (*Ch
 			Timeout:   10000000000,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Channel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListChannelWithMaxResults(ctx, project, location, ChannelMaxPage)
@@ -147,7 +152,11 @@ func (c *Client) ListChannel(ctx context.Context, project, location string) (*Ch
 }
 
 func (c *Client) ListChannelWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*ChannelList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListChannelWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListChannelWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*ChannelList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Channel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -155,7 +164,7 @@ func (c *Client) ListChannelWithMaxResults(ctx context.Context, project, locatio
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listChannel(ctx, r, "", pageSize)
+	items, token, err := c.listChannel(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -169,6 +178,7 @@ func (c *Client) ListChannelWithMaxResults(ctx context.Context, project, locatio
 
 func (c *Client) GetChannel(ctx context.Context, r *Channel) (*Channel, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Channel{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -176,7 +186,7 @@ func (c *Client) GetChannel(ctx context.Context, r *Channel) (*Channel, error) {
 			Timeout:   10000000000,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Channel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -192,7 +202,7 @@ func (c *Client) GetChannel(ctx context.Context, r *Channel) (*Channel, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalChannel(b, c, r)
 	if err != nil {
@@ -218,6 +228,7 @@ func (c *Client) GetChannel(ctx context.Context, r *Channel) (*Channel, error) {
 
 func (c *Client) DeleteChannel(ctx context.Context, r *Channel) error {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Channel{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -225,7 +236,7 @@ func (c *Client) DeleteChannel(ctx context.Context, r *Channel) error {
 			Timeout:   10000000000,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Channel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -233,7 +244,13 @@ func (c *Client) DeleteChannel(ctx context.Context, r *Channel) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Channel...")
 	deleteOp := deleteChannelOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteChannelAsync(ctx context.Context, r *Channel) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteChannel(ctx, r)
+	})
 }
 
 // DeleteAllChannel deletes all resources that the filter functions returns true on.
@@ -261,10 +278,11 @@ func (c *Client) DeleteAllChannel(ctx context.Context, project, location string,
 }
 
 func (c *Client) ApplyChannel(ctx context.Context, rawDesired *Channel, opts ...dcl.ApplyOption) (*Channel, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Channel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Channel{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -289,6 +307,38 @@ func (c *Client) ApplyChannel(ctx context.Context, rawDesired *Channel, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyChannelAsync(ctx context.Context, rawDesired *Channel, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyChannel(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffChannel returns the field-level differences between rawDesired and the
+// live Channel without modifying it. If the Channel does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffChannel(ctx context.Context, rawDesired *Channel, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Channel{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Channel{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractChannelFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.channelDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Channel %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyChannelHelper(c *Client, ctx context.Context, rawDesired *Channel, opts ...dcl.ApplyOption) (*Channel, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyChannel...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -297,6 +347,9 @@ func applyChannelHelper(c *Client, ctx context.Context, rawDesired *Channel, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractChannelFields(rawDesired); err != nil {
 		return nil, err
@@ -355,7 +408,7 @@ func applyChannelHelper(c *Client, ctx context.Context, rawDesired *Channel, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
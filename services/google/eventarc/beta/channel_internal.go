@@ -380,7 +380,7 @@ func (c *Client) channelDiffsForRawDesired(ctx context.Context, rawDesired *Chan
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Channel resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Channel resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Channel resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Channel resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -394,6 +394,12 @@ func (c *Client) channelDiffsForRawDesired(ctx context.Context, rawDesired *Chan
 	if err := extractChannelFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeChannelInitialState(rawInitial, rawDesired)
@@ -411,7 +417,7 @@ func (c *Client) channelDiffsForRawDesired(ctx context.Context, rawDesired *Chan
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffChannel(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeChannelInitialState(rawInitial, rawDesired *Channel) (*Channel, error) {
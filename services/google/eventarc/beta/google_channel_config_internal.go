@@ -203,7 +203,7 @@ func (c *Client) googleChannelConfigDiffsForRawDesired(ctx context.Context, rawD
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a GoogleChannelConfig resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve GoogleChannelConfig resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve GoogleChannelConfig resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that GoogleChannelConfig resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -217,6 +217,12 @@ func (c *Client) googleChannelConfigDiffsForRawDesired(ctx context.Context, rawD
 	if err := extractGoogleChannelConfigFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeGoogleChannelConfigInitialState(rawInitial, rawDesired)
@@ -234,7 +240,7 @@ func (c *Client) googleChannelConfigDiffsForRawDesired(ctx context.Context, rawD
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffGoogleChannelConfig(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeGoogleChannelConfigInitialState(rawInitial, rawDesired *GoogleChannelConfig) (*GoogleChannelConfig, error) {
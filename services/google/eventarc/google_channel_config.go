@@ -71,7 +71,8 @@ type GoogleChannelConfigList struct {
 
 func (c *Client) GetGoogleChannelConfig(ctx context.Context, r *GoogleChannelConfig) (*GoogleChannelConfig, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GoogleChannelConfig{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GoogleChannelConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -87,7 +88,7 @@ func (c *Client) GetGoogleChannelConfig(ctx context.Context, r *GoogleChannelCon
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalGoogleChannelConfig(b, c, r)
 	if err != nil {
@@ -112,7 +113,8 @@ func (c *Client) GetGoogleChannelConfig(ctx context.Context, r *GoogleChannelCon
 
 func (c *Client) DeleteGoogleChannelConfig(ctx context.Context, r *GoogleChannelConfig) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GoogleChannelConfig{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GoogleChannelConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -120,14 +122,21 @@ func (c *Client) DeleteGoogleChannelConfig(ctx context.Context, r *GoogleChannel
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting GoogleChannelConfig...")
 	deleteOp := deleteGoogleChannelConfigOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteGoogleChannelConfigAsync(ctx context.Context, r *GoogleChannelConfig) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteGoogleChannelConfig(ctx, r)
+	})
 }
 
 func (c *Client) ApplyGoogleChannelConfig(ctx context.Context, rawDesired *GoogleChannelConfig, opts ...dcl.ApplyOption) (*GoogleChannelConfig, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GoogleChannelConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GoogleChannelConfig{}).Describe())
 	var resultNewState *GoogleChannelConfig
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyGoogleChannelConfigHelper(c, ctx, rawDesired, opts...)
@@ -145,6 +154,38 @@ func (c *Client) ApplyGoogleChannelConfig(ctx context.Context, rawDesired *Googl
 	return resultNewState, err
 }
 
+func (c *Client) ApplyGoogleChannelConfigAsync(ctx context.Context, rawDesired *GoogleChannelConfig, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyGoogleChannelConfig(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffGoogleChannelConfig returns the field-level differences between rawDesired and the
+// live GoogleChannelConfig without modifying it. If the GoogleChannelConfig does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffGoogleChannelConfig(ctx context.Context, rawDesired *GoogleChannelConfig, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GoogleChannelConfig{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GoogleChannelConfig{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractGoogleChannelConfigFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.googleChannelConfigDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("GoogleChannelConfig %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyGoogleChannelConfigHelper(c *Client, ctx context.Context, rawDesired *GoogleChannelConfig, opts ...dcl.ApplyOption) (*GoogleChannelConfig, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyGoogleChannelConfig...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -153,6 +194,9 @@ func applyGoogleChannelConfigHelper(c *Client, ctx context.Context, rawDesired *
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractGoogleChannelConfigFields(rawDesired); err != nil {
 		return nil, err
@@ -197,7 +241,7 @@ func applyGoogleChannelConfigHelper(c *Client, ctx context.Context, rawDesired *
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.PhaseUpdate, err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -407,8 +407,12 @@ func (l *TriggerList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *TriggerList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *TriggerList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Trigger{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -425,7 +429,8 @@ func (l *TriggerList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListTrigger(ctx context.Context, project, location string) (*TriggerList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Trigger{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Trigger{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListTriggerWithMaxResults(ctx, project, location, TriggerMaxPage)
@@ -433,7 +438,11 @@ func (c *Client) ListTrigger(ctx context.Context, project, location string) (*Tr
 }
 
 func (c *Client) ListTriggerWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*TriggerList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListTriggerWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListTriggerWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*TriggerList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Trigger{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -441,7 +450,7 @@ func (c *Client) ListTriggerWithMaxResults(ctx context.Context, project, locatio
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listTrigger(ctx, r, "", pageSize)
+	items, token, err := c.listTrigger(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -455,7 +464,8 @@ func (c *Client) ListTriggerWithMaxResults(ctx context.Context, project, locatio
 
 func (c *Client) GetTrigger(ctx context.Context, r *Trigger) (*Trigger, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Trigger{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Trigger{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -471,7 +481,7 @@ func (c *Client) GetTrigger(ctx context.Context, r *Trigger) (*Trigger, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalTrigger(b, c, r)
 	if err != nil {
@@ -497,7 +507,8 @@ func (c *Client) GetTrigger(ctx context.Context, r *Trigger) (*Trigger, error) {
 
 func (c *Client) DeleteTrigger(ctx context.Context, r *Trigger) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Trigger{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Trigger{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -505,7 +516,13 @@ func (c *Client) DeleteTrigger(ctx context.Context, r *Trigger) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Trigger...")
 	deleteOp := deleteTriggerOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteTriggerAsync(ctx context.Context, r *Trigger) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteTrigger(ctx, r)
+	})
 }
 
 // DeleteAllTrigger deletes all resources that the filter functions returns true on.
@@ -533,10 +550,11 @@ func (c *Client) DeleteAllTrigger(ctx context.Context, project, location string,
 }
 
 func (c *Client) ApplyTrigger(ctx context.Context, rawDesired *Trigger, opts ...dcl.ApplyOption) (*Trigger, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Trigger{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Trigger{}).Describe())
 	var resultNewState *Trigger
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyTriggerHelper(c, ctx, rawDesired, opts...)
@@ -554,6 +572,38 @@ func (c *Client) ApplyTrigger(ctx context.Context, rawDesired *Trigger, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyTriggerAsync(ctx context.Context, rawDesired *Trigger, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyTrigger(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffTrigger returns the field-level differences between rawDesired and the
+// live Trigger without modifying it. If the Trigger does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffTrigger(ctx context.Context, rawDesired *Trigger, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Trigger{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Trigger{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractTriggerFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.triggerDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Trigger %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyTriggerHelper(c *Client, ctx context.Context, rawDesired *Trigger, opts ...dcl.ApplyOption) (*Trigger, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyTrigger...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -562,6 +612,9 @@ func applyTriggerHelper(c *Client, ctx context.Context, rawDesired *Trigger, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractTriggerFields(rawDesired); err != nil {
 		return nil, err
@@ -620,7 +673,7 @@ func applyTriggerHelper(c *Client, ctx context.Context, rawDesired *Trigger, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -634,6 +687,8 @@ func applyTriggerDiff(c *Client, ctx context.Context, desired *Trigger, rawDesir
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -464,6 +464,14 @@ func (c *Client) getTriggerRaw(ctx context.Context, r *Trigger) ([]byte, error)
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Trigger) withDefaultLabels(c *dcl.Config, current map[string]string) *Trigger {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) triggerDiffsForRawDesired(ctx context.Context, rawDesired *Trigger, opts ...dcl.ApplyOption) (initial, desired *Trigger, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -481,10 +489,16 @@ func (c *Client) triggerDiffsForRawDesired(ctx context.Context, rawDesired *Trig
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetTrigger(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Trigger resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Trigger resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Trigger resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Trigger resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -498,6 +512,12 @@ func (c *Client) triggerDiffsForRawDesired(ctx context.Context, rawDesired *Trig
 	if err := extractTriggerFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeTriggerInitialState(rawInitial, rawDesired)
@@ -515,7 +535,7 @@ func (c *Client) triggerDiffsForRawDesired(ctx context.Context, rawDesired *Trig
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffTrigger(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeTriggerInitialState(rawInitial, rawDesired *Trigger) (*Trigger, error) {
@@ -1535,7 +1555,7 @@ func diffTrigger(c *Client, desired, actual *Trigger, opts ...dcl.ApplyOption) (
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateTriggerUpdateTriggerOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateTriggerUpdateTriggerOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
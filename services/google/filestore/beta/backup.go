@@ -145,8 +145,12 @@ func (l *BackupList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *BackupList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *BackupList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Backup{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -163,7 +167,8 @@ func (l *BackupList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListBackup(ctx context.Context, project, location string) (*BackupList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Backup{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Backup{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListBackupWithMaxResults(ctx, project, location, BackupMaxPage)
@@ -171,7 +176,11 @@ func (c *Client) ListBackup(ctx context.Context, project, location string) (*Bac
 }
 
 func (c *Client) ListBackupWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*BackupList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListBackupWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListBackupWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*BackupList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Backup{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -179,7 +188,7 @@ func (c *Client) ListBackupWithMaxResults(ctx context.Context, project, location
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listBackup(ctx, r, "", pageSize)
+	items, token, err := c.listBackup(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -193,7 +202,8 @@ func (c *Client) ListBackupWithMaxResults(ctx context.Context, project, location
 
 func (c *Client) GetBackup(ctx context.Context, r *Backup) (*Backup, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Backup{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Backup{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -209,7 +219,7 @@ func (c *Client) GetBackup(ctx context.Context, r *Backup) (*Backup, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalBackup(b, c, r)
 	if err != nil {
@@ -235,7 +245,8 @@ func (c *Client) GetBackup(ctx context.Context, r *Backup) (*Backup, error) {
 
 func (c *Client) DeleteBackup(ctx context.Context, r *Backup) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Backup{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Backup{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -243,7 +254,13 @@ func (c *Client) DeleteBackup(ctx context.Context, r *Backup) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Backup...")
 	deleteOp := deleteBackupOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteBackupAsync(ctx context.Context, r *Backup) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteBackup(ctx, r)
+	})
 }
 
 // DeleteAllBackup deletes all resources that the filter functions returns true on.
@@ -271,10 +288,11 @@ func (c *Client) DeleteAllBackup(ctx context.Context, project, location string,
 }
 
 func (c *Client) ApplyBackup(ctx context.Context, rawDesired *Backup, opts ...dcl.ApplyOption) (*Backup, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Backup{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Backup{}).Describe())
 	var resultNewState *Backup
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyBackupHelper(c, ctx, rawDesired, opts...)
@@ -292,6 +310,38 @@ func (c *Client) ApplyBackup(ctx context.Context, rawDesired *Backup, opts ...dc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyBackupAsync(ctx context.Context, rawDesired *Backup, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyBackup(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffBackup returns the field-level differences between rawDesired and the
+// live Backup without modifying it. If the Backup does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffBackup(ctx context.Context, rawDesired *Backup, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Backup{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Backup{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractBackupFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.backupDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Backup %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyBackupHelper(c *Client, ctx context.Context, rawDesired *Backup, opts ...dcl.ApplyOption) (*Backup, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyBackup...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -300,6 +350,9 @@ func applyBackupHelper(c *Client, ctx context.Context, rawDesired *Backup, opts
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractBackupFields(rawDesired); err != nil {
 		return nil, err
@@ -358,7 +411,7 @@ func applyBackupHelper(c *Client, ctx context.Context, rawDesired *Backup, opts
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -372,6 +425,8 @@ func applyBackupDiff(c *Client, ctx context.Context, desired *Backup, rawDesired
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -367,6 +367,14 @@ func (c *Client) getBackupRaw(ctx context.Context, r *Backup) ([]byte, error) {
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Backup) withDefaultLabels(c *dcl.Config, current map[string]string) *Backup {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) backupDiffsForRawDesired(ctx context.Context, rawDesired *Backup, opts ...dcl.ApplyOption) (initial, desired *Backup, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -384,10 +392,16 @@ func (c *Client) backupDiffsForRawDesired(ctx context.Context, rawDesired *Backu
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetBackup(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Backup resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Backup resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Backup resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Backup resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -401,6 +415,12 @@ func (c *Client) backupDiffsForRawDesired(ctx context.Context, rawDesired *Backu
 	if err := extractBackupFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeBackupInitialState(rawInitial, rawDesired)
@@ -418,7 +438,7 @@ func (c *Client) backupDiffsForRawDesired(ctx context.Context, rawDesired *Backu
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffBackup(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeBackupInitialState(rawInitial, rawDesired *Backup) (*Backup, error) {
@@ -596,7 +616,7 @@ func diffBackup(c *Client, desired, actual *Backup, opts ...dcl.ApplyOption) ([]
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateBackupUpdateBackupOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateBackupUpdateBackupOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
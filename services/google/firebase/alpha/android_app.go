@@ -79,8 +79,12 @@ func (l *AndroidAppList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *AndroidAppList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *AndroidAppList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AndroidApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -97,7 +101,8 @@ func (l *AndroidAppList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListAndroidApp(ctx context.Context, project string) (*AndroidAppList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AndroidApp{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AndroidApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListAndroidAppWithMaxResults(ctx, project, AndroidAppMaxPage)
@@ -105,14 +110,18 @@ func (c *Client) ListAndroidApp(ctx context.Context, project string) (*AndroidAp
 }
 
 func (c *Client) ListAndroidAppWithMaxResults(ctx context.Context, project string, pageSize int32) (*AndroidAppList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListAndroidAppWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListAndroidAppWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*AndroidAppList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AndroidApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &AndroidApp{
 		Project: &project,
 	}
-	items, token, err := c.listAndroidApp(ctx, r, "", pageSize)
+	items, token, err := c.listAndroidApp(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +135,8 @@ func (c *Client) ListAndroidAppWithMaxResults(ctx context.Context, project strin
 
 func (c *Client) GetAndroidApp(ctx context.Context, r *AndroidApp) (*AndroidApp, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AndroidApp{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AndroidApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -142,7 +152,7 @@ func (c *Client) GetAndroidApp(ctx context.Context, r *AndroidApp) (*AndroidApp,
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalAndroidApp(b, c, r)
 	if err != nil {
@@ -167,7 +177,8 @@ func (c *Client) GetAndroidApp(ctx context.Context, r *AndroidApp) (*AndroidApp,
 
 func (c *Client) DeleteAndroidApp(ctx context.Context, r *AndroidApp) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AndroidApp{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AndroidApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -175,7 +186,13 @@ func (c *Client) DeleteAndroidApp(ctx context.Context, r *AndroidApp) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting AndroidApp...")
 	deleteOp := deleteAndroidAppOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteAndroidAppAsync(ctx context.Context, r *AndroidApp) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteAndroidApp(ctx, r)
+	})
 }
 
 // DeleteAllAndroidApp deletes all resources that the filter functions returns true on.
@@ -203,10 +220,11 @@ func (c *Client) DeleteAllAndroidApp(ctx context.Context, project string, filter
 }
 
 func (c *Client) ApplyAndroidApp(ctx context.Context, rawDesired *AndroidApp, opts ...dcl.ApplyOption) (*AndroidApp, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AndroidApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AndroidApp{}).Describe())
 	var resultNewState *AndroidApp
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyAndroidAppHelper(c, ctx, rawDesired, opts...)
@@ -224,6 +242,38 @@ func (c *Client) ApplyAndroidApp(ctx context.Context, rawDesired *AndroidApp, op
 	return resultNewState, err
 }
 
+func (c *Client) ApplyAndroidAppAsync(ctx context.Context, rawDesired *AndroidApp, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyAndroidApp(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffAndroidApp returns the field-level differences between rawDesired and the
+// live AndroidApp without modifying it. If the AndroidApp does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffAndroidApp(ctx context.Context, rawDesired *AndroidApp, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AndroidApp{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AndroidApp{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractAndroidAppFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.androidAppDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("AndroidApp %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyAndroidAppHelper(c *Client, ctx context.Context, rawDesired *AndroidApp, opts ...dcl.ApplyOption) (*AndroidApp, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyAndroidApp...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -232,6 +282,9 @@ func applyAndroidAppHelper(c *Client, ctx context.Context, rawDesired *AndroidAp
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractAndroidAppFields(rawDesired); err != nil {
 		return nil, err
@@ -290,7 +343,7 @@ func applyAndroidAppHelper(c *Client, ctx context.Context, rawDesired *AndroidAp
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -391,7 +391,7 @@ func (c *Client) appleAppDiffsForRawDesired(ctx context.Context, rawDesired *App
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a AppleApp resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve AppleApp resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve AppleApp resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that AppleApp resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -405,6 +405,12 @@ func (c *Client) appleAppDiffsForRawDesired(ctx context.Context, rawDesired *App
 	if err := extractAppleAppFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeAppleAppInitialState(rawInitial, rawDesired)
@@ -422,7 +428,7 @@ func (c *Client) appleAppDiffsForRawDesired(ctx context.Context, rawDesired *App
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffAppleApp(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeAppleAppInitialState(rawInitial, rawDesired *AppleApp) (*AppleApp, error) {
@@ -281,6 +281,14 @@ func (c *Client) getFirebaseProjectRaw(ctx context.Context, r *FirebaseProject)
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose annotations are merged with the labels set with
+// dcl.WithDefaultLabels and the current annotations, which are nil if the resource does not exist.
+func (r *FirebaseProject) withDefaultLabels(c *dcl.Config, current map[string]string) *FirebaseProject {
+	nr := *r
+	nr.Annotations = dcl.MergeDefaultLabels(c, r.Annotations, current)
+	return &nr
+}
+
 func (c *Client) firebaseProjectDiffsForRawDesired(ctx context.Context, rawDesired *FirebaseProject, opts ...dcl.ApplyOption) (initial, desired *FirebaseProject, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -298,10 +306,16 @@ func (c *Client) firebaseProjectDiffsForRawDesired(ctx context.Context, rawDesir
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetFirebaseProject(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Annotations)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a FirebaseProject resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve FirebaseProject resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve FirebaseProject resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that FirebaseProject resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -315,6 +329,12 @@ func (c *Client) firebaseProjectDiffsForRawDesired(ctx context.Context, rawDesir
 	if err := extractFirebaseProjectFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeFirebaseProjectInitialState(rawInitial, rawDesired)
@@ -332,7 +352,7 @@ func (c *Client) firebaseProjectDiffsForRawDesired(ctx context.Context, rawDesir
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffFirebaseProject(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeFirebaseProjectInitialState(rawInitial, rawDesired *FirebaseProject) (*FirebaseProject, error) {
@@ -592,7 +612,7 @@ func diffFirebaseProject(c *Client, desired, actual *FirebaseProject, opts ...dc
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Annotations, actual.Annotations, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateFirebaseProjectUpdateFirebaseProjectOperation")}, fn.AddNest("Annotations")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Annotations, actual.Annotations, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateFirebaseProjectUpdateFirebaseProjectOperation")}, fn.AddNest("Annotations")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -81,8 +81,12 @@ func (l *WebAppList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *WebAppList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *WebAppList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WebApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -99,7 +103,8 @@ func (l *WebAppList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListWebApp(ctx context.Context, project string) (*WebAppList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WebApp{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WebApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListWebAppWithMaxResults(ctx, project, WebAppMaxPage)
@@ -107,14 +112,18 @@ func (c *Client) ListWebApp(ctx context.Context, project string) (*WebAppList, e
 }
 
 func (c *Client) ListWebAppWithMaxResults(ctx context.Context, project string, pageSize int32) (*WebAppList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListWebAppWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListWebAppWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*WebAppList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WebApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &WebApp{
 		Project: &project,
 	}
-	items, token, err := c.listWebApp(ctx, r, "", pageSize)
+	items, token, err := c.listWebApp(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +137,8 @@ func (c *Client) ListWebAppWithMaxResults(ctx context.Context, project string, p
 
 func (c *Client) GetWebApp(ctx context.Context, r *WebApp) (*WebApp, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WebApp{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WebApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -144,7 +154,7 @@ func (c *Client) GetWebApp(ctx context.Context, r *WebApp) (*WebApp, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalWebApp(b, c, r)
 	if err != nil {
@@ -169,7 +179,8 @@ func (c *Client) GetWebApp(ctx context.Context, r *WebApp) (*WebApp, error) {
 
 func (c *Client) DeleteWebApp(ctx context.Context, r *WebApp) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WebApp{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WebApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -177,7 +188,13 @@ func (c *Client) DeleteWebApp(ctx context.Context, r *WebApp) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting WebApp...")
 	deleteOp := deleteWebAppOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteWebAppAsync(ctx context.Context, r *WebApp) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteWebApp(ctx, r)
+	})
 }
 
 // DeleteAllWebApp deletes all resources that the filter functions returns true on.
@@ -205,10 +222,11 @@ func (c *Client) DeleteAllWebApp(ctx context.Context, project string, filter fun
 }
 
 func (c *Client) ApplyWebApp(ctx context.Context, rawDesired *WebApp, opts ...dcl.ApplyOption) (*WebApp, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WebApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WebApp{}).Describe())
 	var resultNewState *WebApp
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyWebAppHelper(c, ctx, rawDesired, opts...)
@@ -226,6 +244,38 @@ func (c *Client) ApplyWebApp(ctx context.Context, rawDesired *WebApp, opts ...dc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyWebAppAsync(ctx context.Context, rawDesired *WebApp, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyWebApp(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffWebApp returns the field-level differences between rawDesired and the
+// live WebApp without modifying it. If the WebApp does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffWebApp(ctx context.Context, rawDesired *WebApp, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WebApp{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WebApp{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractWebAppFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.webAppDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("WebApp %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyWebAppHelper(c *Client, ctx context.Context, rawDesired *WebApp, opts ...dcl.ApplyOption) (*WebApp, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyWebApp...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -234,6 +284,9 @@ func applyWebAppHelper(c *Client, ctx context.Context, rawDesired *WebApp, opts
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractWebAppFields(rawDesired); err != nil {
 		return nil, err
@@ -292,7 +345,7 @@ func applyWebAppHelper(c *Client, ctx context.Context, rawDesired *WebApp, opts
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -380,7 +380,7 @@ func (c *Client) webAppDiffsForRawDesired(ctx context.Context, rawDesired *WebAp
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a WebApp resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve WebApp resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve WebApp resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that WebApp resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -394,6 +394,12 @@ func (c *Client) webAppDiffsForRawDesired(ctx context.Context, rawDesired *WebAp
 	if err := extractWebAppFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeWebAppInitialState(rawInitial, rawDesired)
@@ -411,7 +417,7 @@ func (c *Client) webAppDiffsForRawDesired(ctx context.Context, rawDesired *WebAp
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffWebApp(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeWebAppInitialState(rawInitial, rawDesired *WebApp) (*WebApp, error) {
@@ -380,7 +380,7 @@ func (c *Client) androidAppDiffsForRawDesired(ctx context.Context, rawDesired *A
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a AndroidApp resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve AndroidApp resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve AndroidApp resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that AndroidApp resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -394,6 +394,12 @@ func (c *Client) androidAppDiffsForRawDesired(ctx context.Context, rawDesired *A
 	if err := extractAndroidAppFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeAndroidAppInitialState(rawInitial, rawDesired)
@@ -411,7 +417,7 @@ func (c *Client) androidAppDiffsForRawDesired(ctx context.Context, rawDesired *A
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffAndroidApp(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeAndroidAppInitialState(rawInitial, rawDesired *AndroidApp) (*AndroidApp, error) {
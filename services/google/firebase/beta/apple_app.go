@@ -83,8 +83,12 @@ func (l *AppleAppList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *AppleAppList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *AppleAppList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AppleApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -101,7 +105,8 @@ func (l *AppleAppList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListAppleApp(ctx context.Context, project string) (*AppleAppList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AppleApp{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AppleApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListAppleAppWithMaxResults(ctx, project, AppleAppMaxPage)
@@ -109,14 +114,18 @@ func (c *Client) ListAppleApp(ctx context.Context, project string) (*AppleAppLis
 }
 
 func (c *Client) ListAppleAppWithMaxResults(ctx context.Context, project string, pageSize int32) (*AppleAppList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListAppleAppWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListAppleAppWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*AppleAppList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AppleApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &AppleApp{
 		Project: &project,
 	}
-	items, token, err := c.listAppleApp(ctx, r, "", pageSize)
+	items, token, err := c.listAppleApp(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +139,8 @@ func (c *Client) ListAppleAppWithMaxResults(ctx context.Context, project string,
 
 func (c *Client) GetAppleApp(ctx context.Context, r *AppleApp) (*AppleApp, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AppleApp{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AppleApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -146,7 +156,7 @@ func (c *Client) GetAppleApp(ctx context.Context, r *AppleApp) (*AppleApp, error
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalAppleApp(b, c, r)
 	if err != nil {
@@ -171,7 +181,8 @@ func (c *Client) GetAppleApp(ctx context.Context, r *AppleApp) (*AppleApp, error
 
 func (c *Client) DeleteAppleApp(ctx context.Context, r *AppleApp) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AppleApp{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AppleApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -179,7 +190,13 @@ func (c *Client) DeleteAppleApp(ctx context.Context, r *AppleApp) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting AppleApp...")
 	deleteOp := deleteAppleAppOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteAppleAppAsync(ctx context.Context, r *AppleApp) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteAppleApp(ctx, r)
+	})
 }
 
 // DeleteAllAppleApp deletes all resources that the filter functions returns true on.
@@ -207,10 +224,11 @@ func (c *Client) DeleteAllAppleApp(ctx context.Context, project string, filter f
 }
 
 func (c *Client) ApplyAppleApp(ctx context.Context, rawDesired *AppleApp, opts ...dcl.ApplyOption) (*AppleApp, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AppleApp{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AppleApp{}).Describe())
 	var resultNewState *AppleApp
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyAppleAppHelper(c, ctx, rawDesired, opts...)
@@ -228,6 +246,38 @@ func (c *Client) ApplyAppleApp(ctx context.Context, rawDesired *AppleApp, opts .
 	return resultNewState, err
 }
 
+func (c *Client) ApplyAppleAppAsync(ctx context.Context, rawDesired *AppleApp, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyAppleApp(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffAppleApp returns the field-level differences between rawDesired and the
+// live AppleApp without modifying it. If the AppleApp does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffAppleApp(ctx context.Context, rawDesired *AppleApp, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AppleApp{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AppleApp{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractAppleAppFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.appleAppDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("AppleApp %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyAppleAppHelper(c *Client, ctx context.Context, rawDesired *AppleApp, opts ...dcl.ApplyOption) (*AppleApp, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyAppleApp...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -236,6 +286,9 @@ func applyAppleAppHelper(c *Client, ctx context.Context, rawDesired *AppleApp, o
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractAppleAppFields(rawDesired); err != nil {
 		return nil, err
@@ -294,7 +347,7 @@ func applyAppleAppHelper(c *Client, ctx context.Context, rawDesired *AppleApp, o
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -163,8 +163,12 @@ func (l *FirebaseProjectList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *FirebaseProjectList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *FirebaseProjectList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirebaseProject{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -181,7 +185,8 @@ func (l *FirebaseProjectList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListFirebaseProject(ctx context.Context) (*FirebaseProjectList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirebaseProject{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirebaseProject{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListFirebaseProjectWithMaxResults(ctx, FirebaseProjectMaxPage)
@@ -189,12 +194,16 @@ func (c *Client) ListFirebaseProject(ctx context.Context) (*FirebaseProjectList,
 }
 
 func (c *Client) ListFirebaseProjectWithMaxResults(ctx context.Context, pageSize int32) (*FirebaseProjectList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListFirebaseProjectWithPageToken(ctx, pageSize, "")
+}
+
+func (c *Client) ListFirebaseProjectWithPageToken(ctx context.Context, pageSize int32, pageToken string) (*FirebaseProjectList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirebaseProject{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &FirebaseProject{}
-	items, token, err := c.listFirebaseProject(ctx, r, "", pageSize)
+	items, token, err := c.listFirebaseProject(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +217,8 @@ func (c *Client) ListFirebaseProjectWithMaxResults(ctx context.Context, pageSize
 
 func (c *Client) GetFirebaseProject(ctx context.Context, r *FirebaseProject) (*FirebaseProject, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirebaseProject{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirebaseProject{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -224,7 +234,7 @@ func (c *Client) GetFirebaseProject(ctx context.Context, r *FirebaseProject) (*F
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalFirebaseProject(b, c, r)
 	if err != nil {
@@ -247,10 +257,11 @@ func (c *Client) GetFirebaseProject(ctx context.Context, r *FirebaseProject) (*F
 }
 
 func (c *Client) ApplyFirebaseProject(ctx context.Context, rawDesired *FirebaseProject, opts ...dcl.ApplyOption) (*FirebaseProject, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirebaseProject{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirebaseProject{}).Describe())
 	var resultNewState *FirebaseProject
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyFirebaseProjectHelper(c, ctx, rawDesired, opts...)
@@ -268,6 +279,38 @@ func (c *Client) ApplyFirebaseProject(ctx context.Context, rawDesired *FirebaseP
 	return resultNewState, err
 }
 
+func (c *Client) ApplyFirebaseProjectAsync(ctx context.Context, rawDesired *FirebaseProject, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyFirebaseProject(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffFirebaseProject returns the field-level differences between rawDesired and the
+// live FirebaseProject without modifying it. If the FirebaseProject does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffFirebaseProject(ctx context.Context, rawDesired *FirebaseProject, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FirebaseProject{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FirebaseProject{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractFirebaseProjectFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.firebaseProjectDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("FirebaseProject %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyFirebaseProjectHelper(c *Client, ctx context.Context, rawDesired *FirebaseProject, opts ...dcl.ApplyOption) (*FirebaseProject, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyFirebaseProject...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -276,6 +319,9 @@ func applyFirebaseProjectHelper(c *Client, ctx context.Context, rawDesired *Fire
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractFirebaseProjectFields(rawDesired); err != nil {
 		return nil, err
@@ -334,7 +380,7 @@ func applyFirebaseProjectHelper(c *Client, ctx context.Context, rawDesired *Fire
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -348,6 +394,8 @@ func applyFirebaseProjectDiff(c *Client, ctx context.Context, desired *FirebaseP
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Annotations)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
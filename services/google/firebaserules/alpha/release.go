@@ -77,8 +77,12 @@ func (l *ReleaseList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ReleaseList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ReleaseList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Release{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -95,7 +99,8 @@ func (l *ReleaseList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListRelease(ctx context.Context, project string) (*ReleaseList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Release{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Release{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListReleaseWithMaxResults(ctx, project, ReleaseMaxPage)
@@ -103,14 +108,18 @@ func (c *Client) ListRelease(ctx context.Context, project string) (*ReleaseList,
 }
 
 func (c *Client) ListReleaseWithMaxResults(ctx context.Context, project string, pageSize int32) (*ReleaseList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListReleaseWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListReleaseWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*ReleaseList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Release{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Release{
 		Project: &project,
 	}
-	items, token, err := c.listRelease(ctx, r, "", pageSize)
+	items, token, err := c.listRelease(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +133,8 @@ func (c *Client) ListReleaseWithMaxResults(ctx context.Context, project string,
 
 func (c *Client) GetRelease(ctx context.Context, r *Release) (*Release, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Release{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Release{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -140,7 +150,7 @@ func (c *Client) GetRelease(ctx context.Context, r *Release) (*Release, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalRelease(b, c, r)
 	if err != nil {
@@ -165,7 +175,8 @@ func (c *Client) GetRelease(ctx context.Context, r *Release) (*Release, error) {
 
 func (c *Client) DeleteRelease(ctx context.Context, r *Release) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Release{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Release{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -173,7 +184,13 @@ func (c *Client) DeleteRelease(ctx context.Context, r *Release) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Release...")
 	deleteOp := deleteReleaseOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteReleaseAsync(ctx context.Context, r *Release) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteRelease(ctx, r)
+	})
 }
 
 // DeleteAllRelease deletes all resources that the filter functions returns true on.
@@ -201,10 +218,11 @@ func (c *Client) DeleteAllRelease(ctx context.Context, project string, filter fu
 }
 
 func (c *Client) ApplyRelease(ctx context.Context, rawDesired *Release, opts ...dcl.ApplyOption) (*Release, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Release{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Release{}).Describe())
 	var resultNewState *Release
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyReleaseHelper(c, ctx, rawDesired, opts...)
@@ -222,6 +240,38 @@ func (c *Client) ApplyRelease(ctx context.Context, rawDesired *Release, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyReleaseAsync(ctx context.Context, rawDesired *Release, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyRelease(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffRelease returns the field-level differences between rawDesired and the
+// live Release without modifying it. If the Release does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffRelease(ctx context.Context, rawDesired *Release, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Release{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Release{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractReleaseFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.releaseDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Release %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyReleaseHelper(c *Client, ctx context.Context, rawDesired *Release, opts ...dcl.ApplyOption) (*Release, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyRelease...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -230,6 +280,9 @@ func applyReleaseHelper(c *Client, ctx context.Context, rawDesired *Release, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractReleaseFields(rawDesired); err != nil {
 		return nil, err
@@ -288,7 +341,7 @@ func applyReleaseHelper(c *Client, ctx context.Context, rawDesired *Release, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
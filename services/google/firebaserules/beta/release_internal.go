@@ -332,7 +332,7 @@ func (c *Client) releaseDiffsForRawDesired(ctx context.Context, rawDesired *Rele
 	if rawInitial == nil {
 		if !dcl.IsNotFoundOrCode(err, 400) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Release resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Release resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Release resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Release resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -346,6 +346,12 @@ func (c *Client) releaseDiffsForRawDesired(ctx context.Context, rawDesired *Rele
 	if err := extractReleaseFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeReleaseInitialState(rawInitial, rawDesired)
@@ -363,7 +369,7 @@ func (c *Client) releaseDiffsForRawDesired(ctx context.Context, rawDesired *Rele
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffRelease(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeReleaseInitialState(rawInitial, rawDesired *Release) (*Release, error) {
@@ -304,7 +304,7 @@ func (c *Client) rulesetDiffsForRawDesired(ctx context.Context, rawDesired *Rule
 	if rawInitial == nil {
 		if !dcl.IsNotFoundOrCode(err, 400) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Ruleset resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Ruleset resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Ruleset resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Ruleset resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -318,6 +318,12 @@ func (c *Client) rulesetDiffsForRawDesired(ctx context.Context, rawDesired *Rule
 	if err := extractRulesetFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeRulesetInitialState(rawInitial, rawDesired)
@@ -335,7 +341,7 @@ func (c *Client) rulesetDiffsForRawDesired(ctx context.Context, rawDesired *Rule
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffRuleset(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeRulesetInitialState(rawInitial, rawDesired *Ruleset) (*Ruleset, error) {
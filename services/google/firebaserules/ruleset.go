@@ -251,8 +251,12 @@ func (l *RulesetList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *RulesetList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *RulesetList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Ruleset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -269,7 +273,8 @@ func (l *RulesetList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListRuleset(ctx context.Context, project string) (*RulesetList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Ruleset{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Ruleset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListRulesetWithMaxResults(ctx, project, RulesetMaxPage)
@@ -277,14 +282,18 @@ func (c *Client) ListRuleset(ctx context.Context, project string) (*RulesetList,
 }
 
 func (c *Client) ListRulesetWithMaxResults(ctx context.Context, project string, pageSize int32) (*RulesetList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListRulesetWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListRulesetWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*RulesetList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Ruleset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Ruleset{
 		Project: &project,
 	}
-	items, token, err := c.listRuleset(ctx, r, "", pageSize)
+	items, token, err := c.listRuleset(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -298,7 +307,8 @@ func (c *Client) ListRulesetWithMaxResults(ctx context.Context, project string,
 
 func (c *Client) GetRuleset(ctx context.Context, r *Ruleset) (*Ruleset, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Ruleset{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Ruleset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -314,7 +324,7 @@ func (c *Client) GetRuleset(ctx context.Context, r *Ruleset) (*Ruleset, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalRuleset(b, c, r)
 	if err != nil {
@@ -339,7 +349,8 @@ func (c *Client) GetRuleset(ctx context.Context, r *Ruleset) (*Ruleset, error) {
 
 func (c *Client) DeleteRuleset(ctx context.Context, r *Ruleset) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Ruleset{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Ruleset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -347,7 +358,13 @@ func (c *Client) DeleteRuleset(ctx context.Context, r *Ruleset) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Ruleset...")
 	deleteOp := deleteRulesetOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteRulesetAsync(ctx context.Context, r *Ruleset) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteRuleset(ctx, r)
+	})
 }
 
 // DeleteAllRuleset deletes all resources that the filter functions returns true on.
@@ -375,10 +392,11 @@ func (c *Client) DeleteAllRuleset(ctx context.Context, project string, filter fu
 }
 
 func (c *Client) ApplyRuleset(ctx context.Context, rawDesired *Ruleset, opts ...dcl.ApplyOption) (*Ruleset, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Ruleset{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Ruleset{}).Describe())
 	var resultNewState *Ruleset
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyRulesetHelper(c, ctx, rawDesired, opts...)
@@ -396,6 +414,38 @@ func (c *Client) ApplyRuleset(ctx context.Context, rawDesired *Ruleset, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyRulesetAsync(ctx context.Context, rawDesired *Ruleset, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyRuleset(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffRuleset returns the field-level differences between rawDesired and the
+// live Ruleset without modifying it. If the Ruleset does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffRuleset(ctx context.Context, rawDesired *Ruleset, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Ruleset{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Ruleset{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractRulesetFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.rulesetDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Ruleset %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyRulesetHelper(c *Client, ctx context.Context, rawDesired *Ruleset, opts ...dcl.ApplyOption) (*Ruleset, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyRuleset...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -404,6 +454,9 @@ func applyRulesetHelper(c *Client, ctx context.Context, rawDesired *Ruleset, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractRulesetFields(rawDesired); err != nil {
 		return nil, err
@@ -462,7 +515,7 @@ func applyRulesetHelper(c *Client, ctx context.Context, rawDesired *Ruleset, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -367,6 +367,14 @@ func (c *Client) getRealmRaw(ctx context.Context, r *Realm) ([]byte, error) {
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Realm) withDefaultLabels(c *dcl.Config, current map[string]string) *Realm {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) realmDiffsForRawDesired(ctx context.Context, rawDesired *Realm, opts ...dcl.ApplyOption) (initial, desired *Realm, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -384,10 +392,16 @@ func (c *Client) realmDiffsForRawDesired(ctx context.Context, rawDesired *Realm,
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetRealm(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Realm resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Realm resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Realm resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Realm resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -401,6 +415,12 @@ func (c *Client) realmDiffsForRawDesired(ctx context.Context, rawDesired *Realm,
 	if err := extractRealmFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeRealmInitialState(rawInitial, rawDesired)
@@ -418,7 +438,7 @@ func (c *Client) realmDiffsForRawDesired(ctx context.Context, rawDesired *Realm,
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffRealm(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeRealmInitialState(rawInitial, rawDesired *Realm) (*Realm, error) {
@@ -558,7 +578,7 @@ func diffRealm(c *Client, desired, actual *Realm, opts ...dcl.ApplyOption) ([]*d
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateRealmUpdateOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateRealmUpdateOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -81,8 +81,12 @@ func (l *RealmList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *RealmList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *RealmList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Realm{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -99,7 +103,8 @@ func (l *RealmList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListRealm(ctx context.Context, project, location string) (*RealmList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Realm{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Realm{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListRealmWithMaxResults(ctx, project, location, RealmMaxPage)
@@ -107,7 +112,11 @@ func (c *Client) ListRealm(ctx context.Context, project, location string) (*Real
 }
 
 func (c *Client) ListRealmWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*RealmList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListRealmWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListRealmWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*RealmList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Realm{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -115,7 +124,7 @@ func (c *Client) ListRealmWithMaxResults(ctx context.Context, project, location
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listRealm(ctx, r, "", pageSize)
+	items, token, err := c.listRealm(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +138,8 @@ func (c *Client) ListRealmWithMaxResults(ctx context.Context, project, location
 
 func (c *Client) GetRealm(ctx context.Context, r *Realm) (*Realm, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Realm{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Realm{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -145,7 +155,7 @@ func (c *Client) GetRealm(ctx context.Context, r *Realm) (*Realm, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalRealm(b, c, r)
 	if err != nil {
@@ -171,7 +181,8 @@ func (c *Client) GetRealm(ctx context.Context, r *Realm) (*Realm, error) {
 
 func (c *Client) DeleteRealm(ctx context.Context, r *Realm) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Realm{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Realm{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -179,7 +190,13 @@ func (c *Client) DeleteRealm(ctx context.Context, r *Realm) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Realm...")
 	deleteOp := deleteRealmOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteRealmAsync(ctx context.Context, r *Realm) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteRealm(ctx, r)
+	})
 }
 
 // DeleteAllRealm deletes all resources that the filter functions returns true on.
@@ -207,10 +224,11 @@ func (c *Client) DeleteAllRealm(ctx context.Context, project, location string, f
 }
 
 func (c *Client) ApplyRealm(ctx context.Context, rawDesired *Realm, opts ...dcl.ApplyOption) (*Realm, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Realm{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Realm{}).Describe())
 	var resultNewState *Realm
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyRealmHelper(c, ctx, rawDesired, opts...)
@@ -228,6 +246,38 @@ func (c *Client) ApplyRealm(ctx context.Context, rawDesired *Realm, opts ...dcl.
 	return resultNewState, err
 }
 
+func (c *Client) ApplyRealmAsync(ctx context.Context, rawDesired *Realm, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyRealm(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffRealm returns the field-level differences between rawDesired and the
+// live Realm without modifying it. If the Realm does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffRealm(ctx context.Context, rawDesired *Realm, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Realm{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Realm{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractRealmFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.realmDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Realm %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyRealmHelper(c *Client, ctx context.Context, rawDesired *Realm, opts ...dcl.ApplyOption) (*Realm, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyRealm...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -236,6 +286,9 @@ func applyRealmHelper(c *Client, ctx context.Context, rawDesired *Realm, opts ..
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractRealmFields(rawDesired); err != nil {
 		return nil, err
@@ -294,7 +347,7 @@ func applyRealmHelper(c *Client, ctx context.Context, rawDesired *Realm, opts ..
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -308,6 +361,8 @@ func applyRealmDiff(c *Client, ctx context.Context, desired *Realm, rawDesired *
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
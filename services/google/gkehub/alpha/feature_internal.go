@@ -353,6 +353,14 @@ func (c *Client) getFeatureRaw(ctx context.Context, r *Feature) ([]byte, error)
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Feature) withDefaultLabels(c *dcl.Config, current map[string]string) *Feature {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) featureDiffsForRawDesired(ctx context.Context, rawDesired *Feature, opts ...dcl.ApplyOption) (initial, desired *Feature, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -370,10 +378,16 @@ func (c *Client) featureDiffsForRawDesired(ctx context.Context, rawDesired *Feat
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetFeature(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Feature resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Feature resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Feature resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Feature resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -387,6 +401,12 @@ func (c *Client) featureDiffsForRawDesired(ctx context.Context, rawDesired *Feat
 	if err := extractFeatureFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeFeatureInitialState(rawInitial, rawDesired)
@@ -404,7 +424,7 @@ func (c *Client) featureDiffsForRawDesired(ctx context.Context, rawDesired *Feat
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffFeature(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeFeatureInitialState(rawInitial, rawDesired *Feature) (*Feature, error) {
@@ -1646,7 +1666,7 @@ func diffFeature(c *Client, desired, actual *Feature, opts ...dcl.ApplyOption) (
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateFeatureUpdateFeatureOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateFeatureUpdateFeatureOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -77,6 +77,7 @@ type FleetList struct {
 
 func (c *Client) GetFleet(ctx context.Context, r *Fleet) (*Fleet, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Fleet{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -84,7 +85,7 @@ func (c *Client) GetFleet(ctx context.Context, r *Fleet) (*Fleet, error) {
 			Timeout:   70000000000,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Fleet{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -100,7 +101,7 @@ func (c *Client) GetFleet(ctx context.Context, r *Fleet) (*Fleet, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalFleet(b, c, r)
 	if err != nil {
@@ -126,6 +127,7 @@ func (c *Client) GetFleet(ctx context.Context, r *Fleet) (*Fleet, error) {
 
 func (c *Client) DeleteFleet(ctx context.Context, r *Fleet) error {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Fleet{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -133,7 +135,7 @@ func (c *Client) DeleteFleet(ctx context.Context, r *Fleet) error {
 			Timeout:   70000000000,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Fleet{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -141,14 +143,21 @@ func (c *Client) DeleteFleet(ctx context.Context, r *Fleet) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Fleet...")
 	deleteOp := deleteFleetOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteFleetAsync(ctx context.Context, r *Fleet) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteFleet(ctx, r)
+	})
 }
 
 func (c *Client) ApplyFleet(ctx context.Context, rawDesired *Fleet, opts ...dcl.ApplyOption) (*Fleet, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Fleet{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Fleet{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -173,6 +182,38 @@ func (c *Client) ApplyFleet(ctx context.Context, rawDesired *Fleet, opts ...dcl.
 	return resultNewState, err
 }
 
+func (c *Client) ApplyFleetAsync(ctx context.Context, rawDesired *Fleet, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyFleet(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffFleet returns the field-level differences between rawDesired and the
+// live Fleet without modifying it. If the Fleet does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffFleet(ctx context.Context, rawDesired *Fleet, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Fleet{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Fleet{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractFleetFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.fleetDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Fleet %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyFleetHelper(c *Client, ctx context.Context, rawDesired *Fleet, opts ...dcl.ApplyOption) (*Fleet, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyFleet...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -181,6 +222,9 @@ func applyFleetHelper(c *Client, ctx context.Context, rawDesired *Fleet, opts ..
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractFleetFields(rawDesired); err != nil {
 		return nil, err
@@ -239,7 +283,7 @@ func applyFleetHelper(c *Client, ctx context.Context, rawDesired *Fleet, opts ..
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -309,7 +309,7 @@ func (c *Client) fleetDiffsForRawDesired(ctx context.Context, rawDesired *Fleet,
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Fleet resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Fleet resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Fleet resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Fleet resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -323,6 +323,12 @@ func (c *Client) fleetDiffsForRawDesired(ctx context.Context, rawDesired *Fleet,
 	if err := extractFleetFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeFleetInitialState(rawInitial, rawDesired)
@@ -340,7 +346,7 @@ func (c *Client) fleetDiffsForRawDesired(ctx context.Context, rawDesired *Fleet,
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffFleet(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeFleetInitialState(rawInitial, rawDesired *Fleet) (*Fleet, error) {
@@ -283,6 +283,11 @@ func (l *FeatureMembershipList) HasNext() bool {
 	return false
 }
 
+// NextPageToken returns an empty string because a feature membership list never has a next page.
+func (l *FeatureMembershipList) NextPageToken() string {
+	return ""
+}
+
 // Next returns nil because it will never be called.
 func (l *FeatureMembershipList) Next(_ context.Context, _ *Client) error {
 	return nil
@@ -380,8 +380,12 @@ func (l *FeatureList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *FeatureList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *FeatureList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Feature{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -398,7 +402,8 @@ func (l *FeatureList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListFeature(ctx context.Context, project, location string) (*FeatureList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Feature{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Feature{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListFeatureWithMaxResults(ctx, project, location, FeatureMaxPage)
@@ -406,7 +411,11 @@ func (c *Client) ListFeature(ctx context.Context, project, location string) (*Fe
 }
 
 func (c *Client) ListFeatureWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*FeatureList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListFeatureWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListFeatureWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*FeatureList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Feature{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -414,7 +423,7 @@ func (c *Client) ListFeatureWithMaxResults(ctx context.Context, project, locatio
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listFeature(ctx, r, "", pageSize)
+	items, token, err := c.listFeature(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -428,7 +437,8 @@ func (c *Client) ListFeatureWithMaxResults(ctx context.Context, project, locatio
 
 func (c *Client) GetFeature(ctx context.Context, r *Feature) (*Feature, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Feature{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Feature{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -444,7 +454,7 @@ func (c *Client) GetFeature(ctx context.Context, r *Feature) (*Feature, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalFeature(b, c, r)
 	if err != nil {
@@ -470,7 +480,8 @@ func (c *Client) GetFeature(ctx context.Context, r *Feature) (*Feature, error) {
 
 func (c *Client) DeleteFeature(ctx context.Context, r *Feature) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Feature{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Feature{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -478,7 +489,13 @@ func (c *Client) DeleteFeature(ctx context.Context, r *Feature) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Feature...")
 	deleteOp := deleteFeatureOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteFeatureAsync(ctx context.Context, r *Feature) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteFeature(ctx, r)
+	})
 }
 
 // DeleteAllFeature deletes all resources that the filter functions returns true on.
@@ -506,10 +523,11 @@ func (c *Client) DeleteAllFeature(ctx context.Context, project, location string,
 }
 
 func (c *Client) ApplyFeature(ctx context.Context, rawDesired *Feature, opts ...dcl.ApplyOption) (*Feature, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Feature{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Feature{}).Describe())
 	var resultNewState *Feature
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyFeatureHelper(c, ctx, rawDesired, opts...)
@@ -527,6 +545,38 @@ func (c *Client) ApplyFeature(ctx context.Context, rawDesired *Feature, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyFeatureAsync(ctx context.Context, rawDesired *Feature, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyFeature(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffFeature returns the field-level differences between rawDesired and the
+// live Feature without modifying it. If the Feature does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffFeature(ctx context.Context, rawDesired *Feature, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Feature{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Feature{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractFeatureFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.featureDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Feature %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyFeatureHelper(c *Client, ctx context.Context, rawDesired *Feature, opts ...dcl.ApplyOption) (*Feature, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyFeature...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -535,6 +585,9 @@ func applyFeatureHelper(c *Client, ctx context.Context, rawDesired *Feature, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractFeatureFields(rawDesired); err != nil {
 		return nil, err
@@ -593,7 +646,7 @@ func applyFeatureHelper(c *Client, ctx context.Context, rawDesired *Feature, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -607,6 +660,8 @@ func applyFeatureDiff(c *Client, ctx context.Context, desired *Feature, rawDesir
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -655,7 +655,8 @@ type FeatureMembershipList struct {
 
 func (c *Client) DeleteFeatureMembership(ctx context.Context, r *FeatureMembership) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FeatureMembership{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FeatureMembership{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -663,7 +664,13 @@ func (c *Client) DeleteFeatureMembership(ctx context.Context, r *FeatureMembersh
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting FeatureMembership...")
 	deleteOp := deleteFeatureMembershipOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteFeatureMembershipAsync(ctx context.Context, r *FeatureMembership) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteFeatureMembership(ctx, r)
+	})
 }
 
 // DeleteAllFeatureMembership deletes all resources that the filter functions returns true on.
@@ -691,10 +698,11 @@ func (c *Client) DeleteAllFeatureMembership(ctx context.Context, project, locati
 }
 
 func (c *Client) ApplyFeatureMembership(ctx context.Context, rawDesired *FeatureMembership, opts ...dcl.ApplyOption) (*FeatureMembership, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FeatureMembership{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FeatureMembership{}).Describe())
 	var resultNewState *FeatureMembership
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyFeatureMembershipHelper(c, ctx, rawDesired, opts...)
@@ -712,6 +720,38 @@ func (c *Client) ApplyFeatureMembership(ctx context.Context, rawDesired *Feature
 	return resultNewState, err
 }
 
+func (c *Client) ApplyFeatureMembershipAsync(ctx context.Context, rawDesired *FeatureMembership, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyFeatureMembership(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffFeatureMembership returns the field-level differences between rawDesired and the
+// live FeatureMembership without modifying it. If the FeatureMembership does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffFeatureMembership(ctx context.Context, rawDesired *FeatureMembership, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&FeatureMembership{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&FeatureMembership{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractFeatureMembershipFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.featureMembershipDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("FeatureMembership %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyFeatureMembershipHelper(c *Client, ctx context.Context, rawDesired *FeatureMembership, opts ...dcl.ApplyOption) (*FeatureMembership, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyFeatureMembership...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -720,6 +760,9 @@ func applyFeatureMembershipHelper(c *Client, ctx context.Context, rawDesired *Fe
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractFeatureMembershipFields(rawDesired); err != nil {
 		return nil, err
@@ -778,7 +821,7 @@ func applyFeatureMembershipHelper(c *Client, ctx context.Context, rawDesired *Fe
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -254,7 +254,7 @@ func (c *Client) featureMembershipDiffsForRawDesired(ctx context.Context, rawDes
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a FeatureMembership resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve FeatureMembership resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve FeatureMembership resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that FeatureMembership resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -268,6 +268,12 @@ func (c *Client) featureMembershipDiffsForRawDesired(ctx context.Context, rawDes
 	if err := extractFeatureMembershipFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeFeatureMembershipInitialState(rawInitial, rawDesired)
@@ -285,7 +291,7 @@ func (c *Client) featureMembershipDiffsForRawDesired(ctx context.Context, rawDes
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffFeatureMembership(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeFeatureMembershipInitialState(rawInitial, rawDesired *FeatureMembership) (*FeatureMembership, error) {
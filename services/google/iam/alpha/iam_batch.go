@@ -0,0 +1,113 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package alpha
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+// PolicyChanges is a set of changes to the IAM policy of a single resource which are
+// applied together in one policy write. The Resource fields of the individual members
+// and bindings are ignored.
+type PolicyChanges struct {
+	Resource ResourceWithPolicy
+
+	// SetBindings are set authoritatively on their role and condition.
+	SetBindings []*Binding
+	// DeleteBindings are removed by role and condition; their members are ignored.
+	DeleteBindings []*Binding
+	// AddMembers are added to the binding for their role and condition.
+	AddMembers []*Member
+	// DeleteMembers are removed from the binding for their role and condition.
+	DeleteMembers []*Member
+	// SetAuditConfigs replace the audit config of their service.
+	SetAuditConfigs []*AuditConfig
+	// DeleteAuditConfigs lists the services whose audit configs are removed.
+	DeleteAuditConfigs []string
+}
+
+// ApplyPolicyChanges applies all of the given changes to the resource's policy with a
+// single read-modify-write cycle, which is retried if the policy is changed concurrently.
+// Bindings are set and deleted first, then members are added and deleted, then audit
+// configs are set and deleted. If the changes leave the policy as it is, it is not written.
+func (c *Client) ApplyPolicyChanges(ctx context.Context, changes *PolicyChanges) (*Policy, error) {
+	ctx = context.WithValue(ctx, dcl.APIRequestIDKey, dcl.CreateAPIRequestID())
+	if changes.Resource == nil {
+		return nil, fmt.Errorf("policy changes must specify a resource")
+	}
+	return c.modifyPolicy(ctx, changes.Resource, func(p *Policy) (bool, error) {
+		return changes.apply(p), nil
+	})
+}
+
+// apply applies the changes to p and returns true if p was modified.
+func (changes *PolicyChanges) apply(p *Policy) bool {
+	changed := false
+	for _, b := range changes.SetBindings {
+		p.setBinding(b)
+		changed = true
+	}
+	for _, b := range changes.DeleteBindings {
+		changed = p.removeBinding(b) || changed
+	}
+	for _, m := range changes.AddMembers {
+		changed = p.addMember(m) || changed
+	}
+	for _, m := range changes.DeleteMembers {
+		changed = p.removeMember(m) || changed
+	}
+	for _, ac := range changes.SetAuditConfigs {
+		p.setAuditConfig(ac)
+		changed = true
+	}
+	for _, service := range changes.DeleteAuditConfigs {
+		changed = p.removeAuditConfig(service) || changed
+	}
+	return changed
+}
+
+// setAuditConfig replaces the audit config for the service of ac, or adds ac if the
+// service has none.
+func (p *Policy) setAuditConfig(ac *AuditConfig) {
+	if p.AuditConfigs == nil {
+		p.AuditConfigs = []AuditConfig{}
+	}
+	for i, e := range p.AuditConfigs {
+		if dcl.StringEquals(e.Service, ac.Service) {
+			p.AuditConfigs[i] = *ac
+			return
+		}
+	}
+	p.AuditConfigs = append(p.AuditConfigs, *ac)
+}
+
+// removeAuditConfig removes the audit config of the given service. It returns false if
+// the service has no audit config.
+func (p *Policy) removeAuditConfig(service string) bool {
+	// A non-nil empty list is written, so that the last audit config can be removed.
+	acs := []AuditConfig{}
+	for _, e := range p.AuditConfigs {
+		if dcl.ValueOrEmptyString(e.Service) != service {
+			acs = append(acs, e)
+		}
+	}
+	if len(acs) == len(p.AuditConfigs) {
+		return false
+	}
+	p.AuditConfigs = acs
+	return true
+}
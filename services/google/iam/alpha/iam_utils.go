@@ -78,7 +78,8 @@ func canonicalizeServiceAccountName(m, n interface{}) bool {
 
 func (c *Client) GetWorkloadIdentityPool(ctx context.Context, r *WorkloadIdentityPool) (*WorkloadIdentityPool, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, r.Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	b, err := c.getWorkloadIdentityPoolRaw(ctx, r)
@@ -89,7 +90,7 @@ func (c *Client) GetWorkloadIdentityPool(ctx context.Context, r *WorkloadIdentit
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalWorkloadIdentityPool(b, c, r)
 	if err != nil {
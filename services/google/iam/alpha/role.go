@@ -169,8 +169,12 @@ func (l *RoleList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *RoleList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *RoleList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Role{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -187,7 +191,8 @@ func (l *RoleList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListRole(ctx context.Context, parent string) (*RoleList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Role{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Role{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListRoleWithMaxResults(ctx, parent, RoleMaxPage)
@@ -195,14 +200,18 @@ func (c *Client) ListRole(ctx context.Context, parent string) (*RoleList, error)
 }
 
 func (c *Client) ListRoleWithMaxResults(ctx context.Context, parent string, pageSize int32) (*RoleList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListRoleWithPageToken(ctx, parent, pageSize, "")
+}
+
+func (c *Client) ListRoleWithPageToken(ctx context.Context, parent string, pageSize int32, pageToken string) (*RoleList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Role{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Role{
 		Parent: &parent,
 	}
-	items, token, err := c.listRole(ctx, r, "", pageSize)
+	items, token, err := c.listRole(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -216,7 +225,8 @@ func (c *Client) ListRoleWithMaxResults(ctx context.Context, parent string, page
 
 func (c *Client) GetRole(ctx context.Context, r *Role) (*Role, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Role{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Role{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -232,7 +242,7 @@ func (c *Client) GetRole(ctx context.Context, r *Role) (*Role, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalRole(b, c, r)
 	if err != nil {
@@ -257,7 +267,8 @@ func (c *Client) GetRole(ctx context.Context, r *Role) (*Role, error) {
 
 func (c *Client) DeleteRole(ctx context.Context, r *Role) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Role{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Role{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -265,7 +276,13 @@ func (c *Client) DeleteRole(ctx context.Context, r *Role) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Role...")
 	deleteOp := deleteRoleOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteRoleAsync(ctx context.Context, r *Role) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteRole(ctx, r)
+	})
 }
 
 // DeleteAllRole deletes all resources that the filter functions returns true on.
@@ -293,10 +310,11 @@ func (c *Client) DeleteAllRole(ctx context.Context, parent string, filter func(*
 }
 
 func (c *Client) ApplyRole(ctx context.Context, rawDesired *Role, opts ...dcl.ApplyOption) (*Role, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Role{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Role{}).Describe())
 	var resultNewState *Role
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyRoleHelper(c, ctx, rawDesired, opts...)
@@ -314,6 +332,38 @@ func (c *Client) ApplyRole(ctx context.Context, rawDesired *Role, opts ...dcl.Ap
 	return resultNewState, err
 }
 
+func (c *Client) ApplyRoleAsync(ctx context.Context, rawDesired *Role, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyRole(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffRole returns the field-level differences between rawDesired and the
+// live Role without modifying it. If the Role does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffRole(ctx context.Context, rawDesired *Role, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Role{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Role{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractRoleFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.roleDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Role %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyRoleHelper(c *Client, ctx context.Context, rawDesired *Role, opts ...dcl.ApplyOption) (*Role, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyRole...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -322,6 +372,9 @@ func applyRoleHelper(c *Client, ctx context.Context, rawDesired *Role, opts ...d
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractRoleFields(rawDesired); err != nil {
 		return nil, err
@@ -380,7 +433,7 @@ func applyRoleHelper(c *Client, ctx context.Context, rawDesired *Role, opts ...d
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -340,7 +340,7 @@ func (c *Client) roleDiffsForRawDesired(ctx context.Context, rawDesired *Role, o
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Role resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Role resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Role resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Role resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -354,6 +354,12 @@ func (c *Client) roleDiffsForRawDesired(ctx context.Context, rawDesired *Role, o
 	if err := extractRoleFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeRoleInitialState(rawInitial, rawDesired)
@@ -371,7 +377,7 @@ func (c *Client) roleDiffsForRawDesired(ctx context.Context, rawDesired *Role, o
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffRole(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeRoleInitialState(rawInitial, rawDesired *Role) (*Role, error) {
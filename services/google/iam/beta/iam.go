@@ -20,7 +20,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"reflect"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"google.golang.org/api/googleapi"
 	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
@@ -36,10 +39,12 @@ type ResourceWithPolicy interface {
 
 // Policy is the core resource of an IAM policy.
 type Policy struct {
-	Bindings []Binding          `json:"bindings"`
-	Etag     *string            `json:"etag"`
-	Version  *int               `json:"version"`
-	Resource ResourceWithPolicy `json:"resource"`
+	Bindings []Binding `json:"bindings"`
+	// AuditConfigs are only written if they are non-nil.
+	AuditConfigs []AuditConfig      `json:"auditConfigs,omitempty"`
+	Etag         *string            `json:"etag"`
+	Version      *int               `json:"version"`
+	Resource     ResourceWithPolicy `json:"resource"`
 }
 
 // Binding maps a single role to all of its members.
@@ -58,14 +63,51 @@ type Condition struct {
 	Expression  *string `json:"expression"`
 }
 
-// Member maps a single IAM member to one of its roles.
+// AuditConfig specifies the audit logging configuration of a single service.
+type AuditConfig struct {
+	Service         *string          `json:"service"`
+	AuditLogConfigs []AuditLogConfig `json:"auditLogConfigs"`
+}
+
+// AuditLogConfig enables one type of audit logging, except for the exempted members.
+type AuditLogConfig struct {
+	LogType         *string  `json:"logType"`
+	ExemptedMembers []string `json:"exemptedMembers,omitempty"`
+}
+
+// Member maps a single IAM member to one of its roles, optionally under a condition.
 type Member struct {
-	Role     *string            `json:"role"`
-	Member   *string            `json:"member"`
-	Resource ResourceWithPolicy `json:"resource"`
+	Role      *string            `json:"role"`
+	Member    *string            `json:"member"`
+	Condition *Condition         `json:"condition,omitempty"`
+	Resource  ResourceWithPolicy `json:"resource"`
+}
+
+// conditionalPolicyVersion is the lowest policy version which supports conditional role bindings.
+const conditionalPolicyVersion = 3
+
+// conditionsEqual returns true if a and b describe the same IAM condition.
+// A nil condition only matches another nil condition.
+func conditionsEqual(a, b *Condition) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return dcl.ValueOrEmptyString(a.Expression) == dcl.ValueOrEmptyString(b.Expression) &&
+		dcl.ValueOrEmptyString(a.Title) == dcl.ValueOrEmptyString(b.Title) &&
+		dcl.ValueOrEmptyString(a.Description) == dcl.ValueOrEmptyString(b.Description)
+}
+
+// hasConditions returns true if any binding of the policy has a condition.
+func (p *Policy) hasConditions() bool {
+	for _, b := range p.Bindings {
+		if b.Condition != nil {
+			return true
+		}
+	}
+	return false
 }
 
-// Encode encodes the bindings, tag, and version of an IAM policy.
+// Encode encodes the bindings, audit configs, tag, and version of an IAM policy.
 func (p *Policy) Encode() (map[string]interface{}, error) {
 	m := make(map[string]interface{})
 	var bindings []map[string]interface{}
@@ -77,6 +119,9 @@ func (p *Policy) Encode() (map[string]interface{}, error) {
 		bindings = append(bindings, bb)
 	}
 	m["bindings"] = bindings
+	if p.AuditConfigs != nil {
+		m["auditConfigs"] = p.AuditConfigs
+	}
 	m["etag"] = p.Etag
 	m["version"] = p.Version
 	return map[string]interface{}{"policy": m}, nil
@@ -93,12 +138,16 @@ func (b *Binding) Encode() (map[string]interface{}, error) {
 	return m, nil
 }
 
-// Encode encodes the role and member of a single IAM member.
+// Encode encodes the role, member, and condition of a single IAM member.
 func (m *Member) Encode() (map[string]interface{}, error) {
-	return map[string]interface{}{
+	e := map[string]interface{}{
 		"role":   m.Role,
 		"member": m.Member,
-	}, nil
+	}
+	if m.Condition != nil {
+		e["condition"] = m.Condition
+	}
+	return e, nil
 }
 
 // GetPolicy returns the policy for the given resource.
@@ -108,7 +157,36 @@ func (c *Client) GetPolicy(ctx context.Context, r ResourceWithPolicy) (*Policy,
 	if err != nil {
 		return nil, err
 	}
-	resp, err := dcl.SendRequest(ctx, c.Config, v, u, body, c.Config.RetryProvider)
+	return c.sendGetPolicy(ctx, r, u, v, body)
+}
+
+// readPolicy returns the policy of r for the binding and member helpers, which may write it
+// back. It always requests version 3, whatever version the resource requests by default, so
+// that conditional role bindings are returned with their conditions. It fails if the policy
+// still holds bindings whose conditions were left out, since writing it back would erase them.
+func (c *Client) readPolicy(ctx context.Context, r ResourceWithPolicy) (*Policy, error) {
+	u, v, body, err := r.GetPolicy(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+	u, body, err = requestPolicyVersion(u, v, body, conditionalPolicyVersion)
+	if err != nil {
+		return nil, err
+	}
+	p, err := c.sendGetPolicy(ctx, r, u, v, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkConditions(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) sendGetPolicy(ctx context.Context, r ResourceWithPolicy, u, v string, body *bytes.Buffer) (*Policy, error) {
+	ctx = contextWithResource(ctx, r)
+	// Some APIs read policies with POST, which would otherwise be reported as a mutation.
+	resp, err := dcl.SendRequest(dcl.ContextWithCallKind(ctx, dcl.CallRead), c.Config, v, u, body, c.Config.RetryProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -125,21 +203,140 @@ func (c *Client) GetPolicy(ctx context.Context, r ResourceWithPolicy) (*Policy,
 	return p, nil
 }
 
-// SetPolicy sets the policy for the given resource.
+// requestedPolicyVersionParams are the query parameters with which GET policy reads request a
+// policy version.
+var requestedPolicyVersionParams = []string{"options.requestedPolicyVersion", "optionsRequestedPolicyVersion"}
+
+// requestPolicyVersion rewrites the policy read built by a resource's GetPolicy so that it
+// requests the given policy version, in the query parameter or request body options it
+// already uses, or in the standard ones if it uses neither.
+func requestPolicyVersion(u, verb string, body *bytes.Buffer, version int) (string, *bytes.Buffer, error) {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "", nil, err
+	}
+	q := parsed.Query()
+	for _, param := range requestedPolicyVersionParams {
+		if _, ok := q[param]; ok {
+			q.Set(param, strconv.Itoa(version))
+			parsed.RawQuery = q.Encode()
+			return parsed.String(), body, nil
+		}
+	}
+	if verb == "GET" {
+		q.Set(requestedPolicyVersionParams[0], strconv.Itoa(version))
+		parsed.RawQuery = q.Encode()
+		return parsed.String(), body, nil
+	}
+	m := map[string]interface{}{}
+	if body != nil && body.Len() > 0 {
+		if err := json.Unmarshal(body.Bytes(), &m); err != nil {
+			return "", nil, fmt.Errorf("cannot set the requested policy version of %s: %w", u, err)
+		}
+	}
+	options, _ := m["options"].(map[string]interface{})
+	if options == nil {
+		options = map[string]interface{}{}
+	}
+	options["requestedPolicyVersion"] = version
+	m["options"] = options
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "", nil, err
+	}
+	return u, bytes.NewBuffer(b), nil
+}
+
+// strippedConditionRoleMarker is part of the role that APIs report for a conditional binding
+// in a policy read with a version that cannot represent its condition, e.g.
+// "roles/viewer_withcond_29ac2bce7a1f3b2d".
+const strippedConditionRoleMarker = "_withcond_"
+
+// checkConditions returns an error if the policy has bindings that the client cannot
+// represent: conditional bindings returned without their conditions, or a policy version
+// newer than the client understands.
+func (p *Policy) checkConditions() error {
+	if p.Version != nil && *p.Version > conditionalPolicyVersion {
+		return fmt.Errorf("IAM policy version %d is not supported, the latest supported version is %d", *p.Version, conditionalPolicyVersion)
+	}
+	for _, b := range p.Bindings {
+		if strings.Contains(dcl.ValueOrEmptyString(b.Role), strippedConditionRoleMarker) {
+			return fmt.Errorf("IAM policy binding for role %q was returned without its condition; refusing to modify the policy, which would remove the condition", dcl.ValueOrEmptyString(b.Role))
+		}
+	}
+	return nil
+}
+
+// SetPolicy sets the policy for the given resource. It is retried with the latest etag if the
+// policy is changed concurrently.
 func (c *Client) SetPolicy(ctx context.Context, p *Policy) (*Policy, error) {
 	ctx = context.WithValue(ctx, dcl.APIRequestIDKey, dcl.CreateAPIRequestID())
-	currentPolicy, err := c.GetPolicy(ctx, p.Resource)
-	if err != nil {
-		return nil, err
+	return c.modifyPolicy(ctx, p.Resource, func(current *Policy) (bool, error) {
+		current.Bindings = p.Bindings
+		current.AuditConfigs = p.AuditConfigs
+		return true, nil
+	})
+}
+
+// modifyPolicy reads the policy of r, applies modify to it, and writes it back using the etag
+// that was read. modify returns false if the policy does not need to be written. If the write
+// fails because the policy was changed concurrently, the whole read-modify-write cycle is
+// retried with backoff so that modify is applied to the latest policy.
+func (c *Client) modifyPolicy(ctx context.Context, r ResourceWithPolicy, modify func(p *Policy) (bool, error)) (*Policy, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	defer cancel()
+
+	var result *Policy
+	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		p, err := c.readPolicy(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		changed, err := modify(p)
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			result = p
+			return nil, nil
+		}
+		newP, err := c.SetPolicyWithEtag(ctx, p)
+		if err != nil {
+			if isEtagConflict(err) {
+				c.Config.Logger.InfoWithContextf(ctx, "IAM policy was modified concurrently, retrying: %v", err)
+				return &dcl.RetryDetails{}, dcl.OperationNotDone{Err: err}
+			}
+			return nil, err
+		}
+		result = newP
+		return nil, nil
+	}, c.Config.RetryProvider)
+	return result, err
+}
+
+// contextWithResource records the resource whose policy is read or written in ctx, if
+// the resource describes itself.
+func contextWithResource(ctx context.Context, r ResourceWithPolicy) context.Context {
+	if d, ok := r.(interface{ Describe() dcl.ServiceTypeVersion }); ok {
+		return dcl.ContextWithServiceTypeVersion(ctx, d.Describe())
 	}
-	p.Etag = currentPolicy.Etag
-	return c.SetPolicyWithEtag(ctx, p)
+	return ctx
+}
+
+// isEtagConflict returns true if err reports that a policy was written with a stale etag.
+// Most APIs return 409 ABORTED, storage returns 412 PRECONDITION FAILED.
+func isEtagConflict(err error) bool {
+	return dcl.IsConflictError(err) || dcl.HasCode(err, 412)
 }
 
 // SetPolicyWithEtag sets the policy for the given resource using the etag contained in the Policy.
 func (c *Client) SetPolicyWithEtag(ctx context.Context, p *Policy) (*Policy, error) {
 	ctx = context.WithValue(ctx, dcl.APIRequestIDKey, dcl.CreateAPIRequestID())
 	versionPtr := p.Resource.IAMPolicyVersion()
+	if versionPtr < conditionalPolicyVersion && p.hasConditions() {
+		// Conditional role bindings are rejected unless the policy is written as version 3.
+		versionPtr = conditionalPolicyVersion
+	}
 	p.Version = &versionPtr
 	verb := p.Resource.SetPolicyVerb()
 	m, err := p.Encode()
@@ -153,12 +350,19 @@ func (c *Client) SetPolicyWithEtag(ctx context.Context, p *Policy) (*Policy, err
 			return nil, fmt.Errorf("no policy found in map: %v", m)
 		}
 		m = policyMap
+	} else if p.AuditConfigs != nil {
+		// Audit configs are ignored unless they are named in the update mask.
+		m["updateMask"] = "bindings,etag,auditConfigs"
 	}
 	b, err := json.Marshal(m)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := dcl.SendRequest(ctx, c.Config, verb, p.Resource.SetPolicyURL(c.Config.BasePath), bytes.NewBuffer(b), c.Config.RetryProvider)
+	ctx = contextWithResource(ctx, p.Resource)
+	// A write with a stale etag fails the same way however often it is sent, so a 412 is
+	// returned at once, for modifyPolicy to read the policy again.
+	cfg := c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{412: {Retryable: false}}))
+	resp, err := dcl.SendRequest(ctx, cfg, verb, p.Resource.SetPolicyURL(c.Config.BasePath), bytes.NewBuffer(b), c.Config.RetryProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -172,71 +376,56 @@ func (c *Client) SetPolicyWithEtag(ctx context.Context, p *Policy) (*Policy, err
 	return newP, err
 }
 
-// SetBinding sets one binding, authoritatively on the role, for the given resource.
+// SetBinding sets one binding, authoritatively on the role and condition, for the given resource.
 func (c *Client) SetBinding(ctx context.Context, b *Binding) (*Policy, error) {
 	ctx = context.WithValue(ctx, dcl.APIRequestIDKey, dcl.CreateAPIRequestID())
-	p, err := c.GetPolicy(ctx, b.Resource)
-	if err != nil {
-		return nil, err
-	}
-	roleExists := false
-	for i, eb := range p.Bindings {
-		if dcl.StringEquals(eb.Role, b.Role) && reflect.DeepEqual(eb.Condition, b.Condition) {
-			p.Bindings[i].Members = b.Members
-			roleExists = true
-			break
-		}
-	}
-	if !roleExists {
-		p.Bindings = append(p.Bindings, *b)
-	}
-	return c.SetPolicy(ctx, p)
+	return c.modifyPolicy(ctx, b.Resource, func(p *Policy) (bool, error) {
+		p.setBinding(b)
+		return true, nil
+	})
 }
 
-// GetBinding returns the binding for the given role, or nil if there is no such binding.
+// GetBinding returns the unconditional binding for the given role, or nil if there is no such binding.
 func (c *Client) GetBinding(ctx context.Context, r ResourceWithPolicy, role string) (*Binding, error) {
+	return c.GetConditionalBinding(ctx, r, role, nil)
+}
+
+// GetConditionalBinding returns the binding for the given role and condition, or nil if there is no
+// such binding. A nil condition matches only the unconditional binding for the role.
+func (c *Client) GetConditionalBinding(ctx context.Context, r ResourceWithPolicy, role string, condition *Condition) (*Binding, error) {
 	ctx = context.WithValue(ctx, dcl.APIRequestIDKey, dcl.CreateAPIRequestID())
-	p, err := c.GetPolicy(ctx, r)
+	p, err := c.readPolicy(ctx, r)
 	if err != nil {
 		return nil, err
 	}
 	for _, eb := range p.Bindings {
-		if dcl.StringEquals(eb.Role, &role) {
+		if dcl.StringEquals(eb.Role, &role) && conditionsEqual(eb.Condition, condition) {
 			return &eb, nil
 		}
 	}
 	return nil, nil
 }
 
-// SetMember adds a member to the binding for its role if not already present.
+// SetMember adds a member to the binding for its role and condition if not already present.
 func (c *Client) SetMember(ctx context.Context, m *Member) (*Policy, error) {
 	ctx = context.WithValue(ctx, dcl.APIRequestIDKey, dcl.CreateAPIRequestID())
-	b, err := c.GetBinding(ctx, m.Resource, dcl.ValueOrEmptyString(m.Role))
-	if err != nil {
-		return nil, err
-	}
-	if b == nil {
-		b = &Binding{Role: m.Role}
-	}
-	containsMember := false
-	for _, em := range b.Members {
-		if dcl.StringEquals(&em, m.Member) {
-			containsMember = true
-			break
-		}
-	}
-	if !containsMember {
-		b.Members = append(b.Members, dcl.ValueOrEmptyString(m.Member))
-	}
-	b.Resource = m.Resource
-	return c.SetBinding(ctx, b)
+	return c.modifyPolicy(ctx, m.Resource, func(p *Policy) (bool, error) {
+		return p.addMember(m), nil
+	})
 }
 
-// GetMember returns a Member struct if the role/member pair exists on the resource's policy,
-// or nil if they do not.
+// GetMember returns a Member struct if the role/member pair exists unconditionally on the
+// resource's policy, or nil if it does not.
 func (c *Client) GetMember(ctx context.Context, r ResourceWithPolicy, role, member string) (*Member, error) {
+	return c.GetConditionalMember(ctx, r, role, member, nil)
+}
+
+// GetConditionalMember returns a Member struct if the role/member pair exists under the given
+// condition on the resource's policy, or nil if it does not. A nil condition matches only the
+// unconditional binding for the role.
+func (c *Client) GetConditionalMember(ctx context.Context, r ResourceWithPolicy, role, member string, condition *Condition) (*Member, error) {
 	ctx = context.WithValue(ctx, dcl.APIRequestIDKey, dcl.CreateAPIRequestID())
-	b, err := c.GetBinding(ctx, r, role)
+	b, err := c.GetConditionalBinding(ctx, r, role, condition)
 	if err != nil {
 		return nil, err
 	}
@@ -249,9 +438,10 @@ func (c *Client) GetMember(ctx context.Context, r ResourceWithPolicy, role, memb
 	for _, em := range b.Members {
 		if em == member {
 			return &Member{
-				Role:     &role,
-				Member:   &member,
-				Resource: r,
+				Role:      &role,
+				Member:    &member,
+				Condition: b.Condition,
+				Resource:  r,
 			}, nil
 		}
 	}
@@ -265,7 +455,7 @@ func (c *Client) GetMember(ctx context.Context, r ResourceWithPolicy, role, memb
 func (c *Client) ApplyBinding(ctx context.Context, binding *Binding, opts ...dcl.ApplyOption) (*Binding, error) {
 	ctx = context.WithValue(ctx, dcl.APIRequestIDKey, dcl.CreateAPIRequestID())
 	lp := dcl.FetchLifecycleParams(opts)
-	exists, err := c.GetBinding(ctx, binding.Resource, dcl.ValueOrEmptyString(binding.Role))
+	exists, err := c.GetConditionalBinding(ctx, binding.Resource, dcl.ValueOrEmptyString(binding.Role), binding.Condition)
 	if exists != nil && dcl.HasLifecycleParam(lp, dcl.BlockAcquire) {
 		return exists, dcl.ApplyInfeasibleError{
 			Message: fmt.Sprintf("Resource already exists - apply blocked by lifecycle params: %#v.", exists),
@@ -282,21 +472,12 @@ func (c *Client) ApplyBinding(ctx context.Context, binding *Binding, opts ...dcl
 	return binding, nil
 }
 
-// DeleteBinding deletes a binding from its specified resource.
+// DeleteBinding deletes the binding with the same role and condition from its specified resource.
 func (c *Client) DeleteBinding(ctx context.Context, binding *Binding) error {
 	ctx = context.WithValue(ctx, dcl.APIRequestIDKey, dcl.CreateAPIRequestID())
-	policy, err := c.GetPolicy(ctx, binding.Resource)
-	if err != nil {
-		return err
-	}
-	var bindings []Binding
-	for _, b := range policy.Bindings {
-		if !dcl.StringEquals(b.Role, binding.Role) {
-			bindings = append(bindings, b)
-		}
-	}
-	policy.Bindings = bindings
-	_, err = c.SetPolicy(ctx, policy)
+	_, err := c.modifyPolicy(ctx, binding.Resource, func(p *Policy) (bool, error) {
+		return p.removeBinding(binding), nil
+	})
 	return err
 }
 
@@ -307,7 +488,7 @@ func (c *Client) ApplyMember(ctx context.Context, member *Member, opts ...dcl.Ap
 	lp := dcl.FetchLifecycleParams(opts)
 	role := dcl.ValueOrEmptyString(member.Role)
 	memberString := dcl.ValueOrEmptyString(member.Member)
-	exists, err := c.GetMember(ctx, member.Resource, role, memberString)
+	exists, err := c.GetConditionalMember(ctx, member.Resource, role, memberString, member.Condition)
 	if exists != nil && dcl.HasLifecycleParam(lp, dcl.BlockAcquire) {
 		return exists, dcl.ApplyInfeasibleError{
 			Message: fmt.Sprintf("Resource already exists - apply blocked by lifecycle params: %#v.", exists),
@@ -322,7 +503,7 @@ func (c *Client) ApplyMember(ctx context.Context, member *Member, opts ...dcl.Ap
 	if err != nil {
 		return nil, err
 	}
-	exists, err = c.GetMember(ctx, member.Resource, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member))
+	exists, err = c.GetConditionalMember(ctx, member.Resource, role, memberString, member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -332,32 +513,95 @@ func (c *Client) ApplyMember(ctx context.Context, member *Member, opts ...dcl.Ap
 		}
 	}
 	return &Member{
-		Resource: member.Resource,
-		Role:     exists.Role,
-		Member:   exists.Member,
+		Resource:  member.Resource,
+		Role:      exists.Role,
+		Member:    exists.Member,
+		Condition: exists.Condition,
 	}, nil
 }
 
-// DeleteMember deletes a member from its specified binding.
+// DeleteMember deletes a member from the binding for its role and condition.
 func (c *Client) DeleteMember(ctx context.Context, member *Member) error {
 	ctx = context.WithValue(ctx, dcl.APIRequestIDKey, dcl.CreateAPIRequestID())
-	binding, err := c.GetBinding(ctx, member.Resource, dcl.ValueOrEmptyString(member.Role))
-	if err != nil {
-		return err
+	_, err := c.modifyPolicy(ctx, member.Resource, func(p *Policy) (bool, error) {
+		return p.removeMember(member), nil
+	})
+	return err
+}
+
+// setBinding replaces the members of the binding with the same role and condition as b,
+// or adds b if there is no such binding.
+func (p *Policy) setBinding(b *Binding) {
+	for i, eb := range p.Bindings {
+		if dcl.StringEquals(eb.Role, b.Role) && conditionsEqual(eb.Condition, b.Condition) {
+			p.Bindings[i].Members = b.Members
+			return
+		}
 	}
-	if binding == nil {
-		return nil
+	p.Bindings = append(p.Bindings, Binding{Role: b.Role, Members: b.Members, Condition: b.Condition})
+}
+
+// removeBinding removes the binding with the same role and condition as b. It returns
+// false if there is no such binding.
+func (p *Policy) removeBinding(b *Binding) bool {
+	var bindings []Binding
+	for _, eb := range p.Bindings {
+		if !dcl.StringEquals(eb.Role, b.Role) || !conditionsEqual(eb.Condition, b.Condition) {
+			bindings = append(bindings, eb)
+		}
 	}
-	var members []string
-	for _, m := range binding.Members {
-		if !dcl.StringEquals(&m, member.Member) {
-			members = append(members, m)
+	removed := len(bindings) != len(p.Bindings)
+	p.Bindings = bindings
+	return removed
+}
+
+// addMember adds m to the binding for its role and condition, creating the binding if
+// necessary. It returns false if the member is already present.
+func (p *Policy) addMember(m *Member) bool {
+	for i, eb := range p.Bindings {
+		if !dcl.StringEquals(eb.Role, m.Role) || !conditionsEqual(eb.Condition, m.Condition) {
+			continue
 		}
+		for _, em := range eb.Members {
+			if dcl.StringEquals(&em, m.Member) {
+				return false
+			}
+		}
+		p.Bindings[i].Members = append(eb.Members, dcl.ValueOrEmptyString(m.Member))
+		return true
+	}
+	p.Bindings = append(p.Bindings, Binding{
+		Role:      m.Role,
+		Members:   []string{dcl.ValueOrEmptyString(m.Member)},
+		Condition: m.Condition,
+	})
+	return true
+}
+
+// removeMember removes m from the binding for its role and condition, and removes the
+// binding if it has no members left. It returns false if the member is not present.
+func (p *Policy) removeMember(m *Member) bool {
+	for i, eb := range p.Bindings {
+		if !dcl.StringEquals(eb.Role, m.Role) || !conditionsEqual(eb.Condition, m.Condition) {
+			continue
+		}
+		var members []string
+		for _, em := range eb.Members {
+			if !dcl.StringEquals(&em, m.Member) {
+				members = append(members, em)
+			}
+		}
+		if len(members) == len(eb.Members) {
+			return false
+		}
+		if len(members) == 0 {
+			p.Bindings = append(p.Bindings[:i], p.Bindings[i+1:]...)
+		} else {
+			p.Bindings[i].Members = members
+		}
+		return true
 	}
-	binding.Members = members
-	binding.Resource = member.Resource
-	_, err = c.SetBinding(ctx, binding)
-	return err
+	return false
 }
 
 func (p *Policy) String() string {
@@ -0,0 +1,373 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package beta
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func condition(expression string) *Condition {
+	return &Condition{Title: dcl.String("title"), Expression: dcl.String(expression)}
+}
+
+func version(v int) *int {
+	return &v
+}
+
+func TestConditionsEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *Condition
+		want bool
+	}{
+		{name: "both nil", want: true},
+		{name: "nil and condition", b: condition("true")},
+		{name: "condition and nil", a: condition("true")},
+		{name: "same", a: condition("true"), b: condition("true"), want: true},
+		{name: "different expression", a: condition("true"), b: condition("false")},
+		{
+			name: "different title",
+			a:    condition("true"),
+			b:    &Condition{Title: dcl.String("other"), Expression: dcl.String("true")},
+		},
+		{
+			name: "unset and empty description",
+			a:    condition("true"),
+			b:    &Condition{Title: dcl.String("title"), Expression: dcl.String("true"), Description: dcl.String("")},
+			want: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := conditionsEqual(tc.a, tc.b); got != tc.want {
+				t.Errorf("conditionsEqual() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSetBinding(t *testing.T) {
+	tests := []struct {
+		name     string
+		bindings []Binding
+		b        *Binding
+		want     []Binding
+	}{
+		{
+			name: "adds new role",
+			b:    &Binding{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}},
+			want: []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}}},
+		},
+		{
+			name:     "replaces members of unconditional binding",
+			bindings: []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}}},
+			b:        &Binding{Role: dcl.String("roles/viewer"), Members: []string{"user:b"}},
+			want:     []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:b"}}},
+		},
+		{
+			name:     "keeps unconditional binding when adding conditional one",
+			bindings: []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}}},
+			b:        &Binding{Role: dcl.String("roles/viewer"), Members: []string{"user:b"}, Condition: condition("true")},
+			want: []Binding{
+				{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}},
+				{Role: dcl.String("roles/viewer"), Members: []string{"user:b"}, Condition: condition("true")},
+			},
+		},
+		{
+			name: "replaces only binding with same condition",
+			bindings: []Binding{
+				{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}, Condition: condition("true")},
+				{Role: dcl.String("roles/viewer"), Members: []string{"user:b"}, Condition: condition("false")},
+			},
+			b: &Binding{Role: dcl.String("roles/viewer"), Members: []string{"user:c"}, Condition: condition("false")},
+			want: []Binding{
+				{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}, Condition: condition("true")},
+				{Role: dcl.String("roles/viewer"), Members: []string{"user:c"}, Condition: condition("false")},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &Policy{Bindings: tc.bindings}
+			p.setBinding(tc.b)
+			if diff := cmp.Diff(tc.want, p.Bindings); diff != "" {
+				t.Errorf("setBinding() bindings diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveBinding(t *testing.T) {
+	bindings := []Binding{
+		{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}},
+		{Role: dcl.String("roles/viewer"), Members: []string{"user:b"}, Condition: condition("true")},
+	}
+	tests := []struct {
+		name        string
+		b           *Binding
+		want        []Binding
+		wantRemoved bool
+	}{
+		{
+			name:        "unconditional",
+			b:           &Binding{Role: dcl.String("roles/viewer")},
+			want:        bindings[1:],
+			wantRemoved: true,
+		},
+		{
+			name:        "conditional",
+			b:           &Binding{Role: dcl.String("roles/viewer"), Condition: condition("true")},
+			want:        bindings[:1],
+			wantRemoved: true,
+		},
+		{
+			name: "different condition",
+			b:    &Binding{Role: dcl.String("roles/viewer"), Condition: condition("false")},
+			want: bindings,
+		},
+		{
+			name: "different role",
+			b:    &Binding{Role: dcl.String("roles/editor")},
+			want: bindings,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &Policy{Bindings: append([]Binding(nil), bindings...)}
+			if got := p.removeBinding(tc.b); got != tc.wantRemoved {
+				t.Errorf("removeBinding() = %v, want %v", got, tc.wantRemoved)
+			}
+			if diff := cmp.Diff(tc.want, p.Bindings); diff != "" {
+				t.Errorf("removeBinding() bindings diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddMember(t *testing.T) {
+	tests := []struct {
+		name      string
+		bindings  []Binding
+		m         *Member
+		want      []Binding
+		wantAdded bool
+	}{
+		{
+			name:      "creates binding",
+			m:         &Member{Role: dcl.String("roles/viewer"), Member: dcl.String("user:a")},
+			want:      []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}}},
+			wantAdded: true,
+		},
+		{
+			name:      "appends to binding",
+			bindings:  []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}}},
+			m:         &Member{Role: dcl.String("roles/viewer"), Member: dcl.String("user:b")},
+			want:      []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a", "user:b"}}},
+			wantAdded: true,
+		},
+		{
+			name:     "already present",
+			bindings: []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}}},
+			m:        &Member{Role: dcl.String("roles/viewer"), Member: dcl.String("user:a")},
+			want:     []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}}},
+		},
+		{
+			name:     "present without condition",
+			bindings: []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}}},
+			m:        &Member{Role: dcl.String("roles/viewer"), Member: dcl.String("user:a"), Condition: condition("true")},
+			want: []Binding{
+				{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}},
+				{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}, Condition: condition("true")},
+			},
+			wantAdded: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &Policy{Bindings: tc.bindings}
+			if got := p.addMember(tc.m); got != tc.wantAdded {
+				t.Errorf("addMember() = %v, want %v", got, tc.wantAdded)
+			}
+			if diff := cmp.Diff(tc.want, p.Bindings); diff != "" {
+				t.Errorf("addMember() bindings diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveMember(t *testing.T) {
+	tests := []struct {
+		name        string
+		bindings    []Binding
+		m           *Member
+		want        []Binding
+		wantRemoved bool
+	}{
+		{
+			name:        "removes member",
+			bindings:    []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a", "user:b"}}},
+			m:           &Member{Role: dcl.String("roles/viewer"), Member: dcl.String("user:a")},
+			want:        []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:b"}}},
+			wantRemoved: true,
+		},
+		{
+			name: "removes empty binding",
+			bindings: []Binding{
+				{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}, Condition: condition("true")},
+				{Role: dcl.String("roles/editor"), Members: []string{"user:a"}},
+			},
+			m:           &Member{Role: dcl.String("roles/viewer"), Member: dcl.String("user:a"), Condition: condition("true")},
+			want:        []Binding{{Role: dcl.String("roles/editor"), Members: []string{"user:a"}}},
+			wantRemoved: true,
+		},
+		{
+			name:     "member under other condition",
+			bindings: []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}, Condition: condition("true")}},
+			m:        &Member{Role: dcl.String("roles/viewer"), Member: dcl.String("user:a")},
+			want:     []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}, Condition: condition("true")}},
+		},
+		{
+			name:     "absent member",
+			bindings: []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}}},
+			m:        &Member{Role: dcl.String("roles/viewer"), Member: dcl.String("user:b")},
+			want:     []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &Policy{Bindings: tc.bindings}
+			if got := p.removeMember(tc.m); got != tc.wantRemoved {
+				t.Errorf("removeMember() = %v, want %v", got, tc.wantRemoved)
+			}
+			if diff := cmp.Diff(tc.want, p.Bindings, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("removeMember() bindings diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRequestPolicyVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		verb     string
+		body     string
+		wantURL  string
+		wantBody string
+	}{
+		{
+			name:    "dotted query parameter",
+			url:     "https://compute.googleapis.com/compute/v1/projects/p/zones/z/instances/i/getIamPolicy?options.requestedPolicyVersion=0",
+			verb:    "GET",
+			wantURL: "https://compute.googleapis.com/compute/v1/projects/p/zones/z/instances/i/getIamPolicy?options.requestedPolicyVersion=3",
+		},
+		{
+			name:    "camel case query parameter",
+			url:     "https://compute.googleapis.com/compute/v1/projects/p/global/images/i/getIamPolicy?optionsRequestedPolicyVersion=1",
+			verb:    "GET",
+			wantURL: "https://compute.googleapis.com/compute/v1/projects/p/global/images/i/getIamPolicy?optionsRequestedPolicyVersion=3",
+		},
+		{
+			name:    "GET without parameter",
+			url:     "https://binaryauthorization.googleapis.com/v1/projects/p/policy:getIamPolicy",
+			verb:    "GET",
+			wantURL: "https://binaryauthorization.googleapis.com/v1/projects/p/policy:getIamPolicy?options.requestedPolicyVersion=3",
+		},
+		{
+			name:     "POST body",
+			url:      "https://dataproc.googleapis.com/v1/projects/p/regions/r/clusters/c:getIamPolicy",
+			verb:     "POST",
+			body:     `{"options":{"requestedPolicyVersion": 1}}`,
+			wantURL:  "https://dataproc.googleapis.com/v1/projects/p/regions/r/clusters/c:getIamPolicy",
+			wantBody: `{"options":{"requestedPolicyVersion":3}}`,
+		},
+		{
+			name:     "POST without body",
+			url:      "https://pubsub.googleapis.com/v1/projects/p/topics/t:getIamPolicy",
+			verb:     "POST",
+			wantURL:  "https://pubsub.googleapis.com/v1/projects/p/topics/t:getIamPolicy",
+			wantBody: `{"options":{"requestedPolicyVersion":3}}`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var body *bytes.Buffer
+			if tc.body != "" {
+				body = bytes.NewBufferString(tc.body)
+			}
+			u, b, err := requestPolicyVersion(tc.url, tc.verb, body, conditionalPolicyVersion)
+			if err != nil {
+				t.Fatalf("requestPolicyVersion() returned error: %v", err)
+			}
+			if u != tc.wantURL {
+				t.Errorf("requestPolicyVersion() url = %q, want %q", u, tc.wantURL)
+			}
+			var gotBody string
+			if b != nil {
+				gotBody = b.String()
+			}
+			if gotBody != tc.wantBody {
+				t.Errorf("requestPolicyVersion() body = %q, want %q", gotBody, tc.wantBody)
+			}
+		})
+	}
+}
+
+func TestCheckConditions(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *Policy
+		wantErr bool
+	}{
+		{
+			name: "unconditional bindings",
+			policy: &Policy{
+				Version:  version(1),
+				Bindings: []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}}},
+			},
+		},
+		{
+			name: "conditional bindings",
+			policy: &Policy{
+				Version:  version(3),
+				Bindings: []Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:a"}, Condition: condition("true")}},
+			},
+		},
+		{
+			name: "stripped condition",
+			policy: &Policy{
+				Version:  version(1),
+				Bindings: []Binding{{Role: dcl.String("roles/viewer_withcond_29ac2bce7a1f3b2d"), Members: []string{"user:a"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported version",
+			policy:  &Policy{Version: version(4)},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.policy.checkConditions(); (err != nil) != tc.wantErr {
+				t.Errorf("checkConditions() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -177,8 +177,12 @@ func (l *ServiceAccountList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ServiceAccountList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ServiceAccountList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAccount{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -195,7 +199,8 @@ func (l *ServiceAccountList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListServiceAccount(ctx context.Context, project string) (*ServiceAccountList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceAccount{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAccount{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListServiceAccountWithMaxResults(ctx, project, ServiceAccountMaxPage)
@@ -203,14 +208,18 @@ func (c *Client) ListServiceAccount(ctx context.Context, project string) (*Servi
 }
 
 func (c *Client) ListServiceAccountWithMaxResults(ctx context.Context, project string, pageSize int32) (*ServiceAccountList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListServiceAccountWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListServiceAccountWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*ServiceAccountList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAccount{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &ServiceAccount{
 		Project: &project,
 	}
-	items, token, err := c.listServiceAccount(ctx, r, "", pageSize)
+	items, token, err := c.listServiceAccount(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +233,8 @@ func (c *Client) ListServiceAccountWithMaxResults(ctx context.Context, project s
 
 func (c *Client) GetServiceAccount(ctx context.Context, r *ServiceAccount) (*ServiceAccount, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceAccount{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAccount{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -240,7 +250,7 @@ func (c *Client) GetServiceAccount(ctx context.Context, r *ServiceAccount) (*Ser
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalServiceAccount(b, c, r)
 	if err != nil {
@@ -265,7 +275,8 @@ func (c *Client) GetServiceAccount(ctx context.Context, r *ServiceAccount) (*Ser
 
 func (c *Client) DeleteServiceAccount(ctx context.Context, r *ServiceAccount) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceAccount{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAccount{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -273,7 +284,13 @@ func (c *Client) DeleteServiceAccount(ctx context.Context, r *ServiceAccount) er
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting ServiceAccount...")
 	deleteOp := deleteServiceAccountOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteServiceAccountAsync(ctx context.Context, r *ServiceAccount) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteServiceAccount(ctx, r)
+	})
 }
 
 // DeleteAllServiceAccount deletes all resources that the filter functions returns true on.
@@ -301,10 +318,11 @@ func (c *Client) DeleteAllServiceAccount(ctx context.Context, project string, fi
 }
 
 func (c *Client) ApplyServiceAccount(ctx context.Context, rawDesired *ServiceAccount, opts ...dcl.ApplyOption) (*ServiceAccount, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAccount{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceAccount{}).Describe())
 	var resultNewState *ServiceAccount
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyServiceAccountHelper(c, ctx, rawDesired, opts...)
@@ -322,6 +340,38 @@ func (c *Client) ApplyServiceAccount(ctx context.Context, rawDesired *ServiceAcc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyServiceAccountAsync(ctx context.Context, rawDesired *ServiceAccount, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyServiceAccount(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffServiceAccount returns the field-level differences between rawDesired and the
+// live ServiceAccount without modifying it. If the ServiceAccount does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffServiceAccount(ctx context.Context, rawDesired *ServiceAccount, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceAccount{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceAccount{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractServiceAccountFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.serviceAccountDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("ServiceAccount %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyServiceAccountHelper(c *Client, ctx context.Context, rawDesired *ServiceAccount, opts ...dcl.ApplyOption) (*ServiceAccount, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyServiceAccount...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -330,6 +380,9 @@ func applyServiceAccountHelper(c *Client, ctx context.Context, rawDesired *Servi
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractServiceAccountFields(rawDesired); err != nil {
 		return nil, err
@@ -388,7 +441,7 @@ func applyServiceAccountHelper(c *Client, ctx context.Context, rawDesired *Servi
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
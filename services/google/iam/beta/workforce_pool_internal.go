@@ -386,7 +386,7 @@ func (c *Client) workforcePoolDiffsForRawDesired(ctx context.Context, rawDesired
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a WorkforcePool resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve WorkforcePool resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve WorkforcePool resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that WorkforcePool resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -400,6 +400,12 @@ func (c *Client) workforcePoolDiffsForRawDesired(ctx context.Context, rawDesired
 	if err := extractWorkforcePoolFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeWorkforcePoolInitialState(rawInitial, rawDesired)
@@ -417,7 +423,7 @@ func (c *Client) workforcePoolDiffsForRawDesired(ctx context.Context, rawDesired
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffWorkforcePool(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeWorkforcePoolInitialState(rawInitial, rawDesired *WorkforcePool) (*WorkforcePool, error) {
@@ -105,8 +105,12 @@ func (l *WorkloadIdentityPoolList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *WorkloadIdentityPoolList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *WorkloadIdentityPoolList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -123,7 +127,8 @@ func (l *WorkloadIdentityPoolList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListWorkloadIdentityPool(ctx context.Context, project, location string) (*WorkloadIdentityPoolList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkloadIdentityPool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListWorkloadIdentityPoolWithMaxResults(ctx, project, location, WorkloadIdentityPoolMaxPage)
@@ -131,7 +136,11 @@ func (c *Client) ListWorkloadIdentityPool(ctx context.Context, project, location
 }
 
 func (c *Client) ListWorkloadIdentityPoolWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*WorkloadIdentityPoolList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListWorkloadIdentityPoolWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListWorkloadIdentityPoolWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*WorkloadIdentityPoolList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -139,7 +148,7 @@ func (c *Client) ListWorkloadIdentityPoolWithMaxResults(ctx context.Context, pro
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listWorkloadIdentityPool(ctx, r, "", pageSize)
+	items, token, err := c.listWorkloadIdentityPool(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +162,8 @@ func (c *Client) ListWorkloadIdentityPoolWithMaxResults(ctx context.Context, pro
 
 func (c *Client) DeleteWorkloadIdentityPool(ctx context.Context, r *WorkloadIdentityPool) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkloadIdentityPool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -161,7 +171,13 @@ func (c *Client) DeleteWorkloadIdentityPool(ctx context.Context, r *WorkloadIden
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting WorkloadIdentityPool...")
 	deleteOp := deleteWorkloadIdentityPoolOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteWorkloadIdentityPoolAsync(ctx context.Context, r *WorkloadIdentityPool) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteWorkloadIdentityPool(ctx, r)
+	})
 }
 
 // DeleteAllWorkloadIdentityPool deletes all resources that the filter functions returns true on.
@@ -189,10 +205,11 @@ func (c *Client) DeleteAllWorkloadIdentityPool(ctx context.Context, project, loc
 }
 
 func (c *Client) ApplyWorkloadIdentityPool(ctx context.Context, rawDesired *WorkloadIdentityPool, opts ...dcl.ApplyOption) (*WorkloadIdentityPool, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkloadIdentityPool{}).Describe())
 	var resultNewState *WorkloadIdentityPool
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyWorkloadIdentityPoolHelper(c, ctx, rawDesired, opts...)
@@ -210,6 +227,38 @@ func (c *Client) ApplyWorkloadIdentityPool(ctx context.Context, rawDesired *Work
 	return resultNewState, err
 }
 
+func (c *Client) ApplyWorkloadIdentityPoolAsync(ctx context.Context, rawDesired *WorkloadIdentityPool, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyWorkloadIdentityPool(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffWorkloadIdentityPool returns the field-level differences between rawDesired and the
+// live WorkloadIdentityPool without modifying it. If the WorkloadIdentityPool does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffWorkloadIdentityPool(ctx context.Context, rawDesired *WorkloadIdentityPool, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPool{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkloadIdentityPool{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractWorkloadIdentityPoolFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.workloadIdentityPoolDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("WorkloadIdentityPool %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyWorkloadIdentityPoolHelper(c *Client, ctx context.Context, rawDesired *WorkloadIdentityPool, opts ...dcl.ApplyOption) (*WorkloadIdentityPool, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyWorkloadIdentityPool...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -218,6 +267,9 @@ func applyWorkloadIdentityPoolHelper(c *Client, ctx context.Context, rawDesired
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractWorkloadIdentityPoolFields(rawDesired); err != nil {
 		return nil, err
@@ -276,7 +328,7 @@ func applyWorkloadIdentityPoolHelper(c *Client, ctx context.Context, rawDesired
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
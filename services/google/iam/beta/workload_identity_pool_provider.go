@@ -216,8 +216,12 @@ func (l *WorkloadIdentityPoolProviderList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *WorkloadIdentityPoolProviderList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *WorkloadIdentityPoolProviderList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPoolProvider{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -234,7 +238,8 @@ func (l *WorkloadIdentityPoolProviderList) Next(ctx context.Context, c *Client)
 
 func (c *Client) ListWorkloadIdentityPoolProvider(ctx context.Context, project, location, workloadIdentityPool string) (*WorkloadIdentityPoolProviderList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkloadIdentityPoolProvider{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPoolProvider{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListWorkloadIdentityPoolProviderWithMaxResults(ctx, project, location, workloadIdentityPool, WorkloadIdentityPoolProviderMaxPage)
@@ -242,7 +247,11 @@ func (c *Client) ListWorkloadIdentityPoolProvider(ctx context.Context, project,
 }
 
 func (c *Client) ListWorkloadIdentityPoolProviderWithMaxResults(ctx context.Context, project, location, workloadIdentityPool string, pageSize int32) (*WorkloadIdentityPoolProviderList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListWorkloadIdentityPoolProviderWithPageToken(ctx, project, location, workloadIdentityPool, pageSize, "")
+}
+
+func (c *Client) ListWorkloadIdentityPoolProviderWithPageToken(ctx context.Context, project, location, workloadIdentityPool string, pageSize int32, pageToken string) (*WorkloadIdentityPoolProviderList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPoolProvider{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -251,7 +260,7 @@ func (c *Client) ListWorkloadIdentityPoolProviderWithMaxResults(ctx context.Cont
 		Location:             &location,
 		WorkloadIdentityPool: &workloadIdentityPool,
 	}
-	items, token, err := c.listWorkloadIdentityPoolProvider(ctx, r, "", pageSize)
+	items, token, err := c.listWorkloadIdentityPoolProvider(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -265,7 +274,8 @@ func (c *Client) ListWorkloadIdentityPoolProviderWithMaxResults(ctx context.Cont
 
 func (c *Client) GetWorkloadIdentityPoolProvider(ctx context.Context, r *WorkloadIdentityPoolProvider) (*WorkloadIdentityPoolProvider, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkloadIdentityPoolProvider{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPoolProvider{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -281,7 +291,7 @@ func (c *Client) GetWorkloadIdentityPoolProvider(ctx context.Context, r *Workloa
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalWorkloadIdentityPoolProvider(b, c, r)
 	if err != nil {
@@ -308,7 +318,8 @@ func (c *Client) GetWorkloadIdentityPoolProvider(ctx context.Context, r *Workloa
 
 func (c *Client) DeleteWorkloadIdentityPoolProvider(ctx context.Context, r *WorkloadIdentityPoolProvider) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkloadIdentityPoolProvider{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPoolProvider{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -316,7 +327,13 @@ func (c *Client) DeleteWorkloadIdentityPoolProvider(ctx context.Context, r *Work
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting WorkloadIdentityPoolProvider...")
 	deleteOp := deleteWorkloadIdentityPoolProviderOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteWorkloadIdentityPoolProviderAsync(ctx context.Context, r *WorkloadIdentityPoolProvider) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteWorkloadIdentityPoolProvider(ctx, r)
+	})
 }
 
 // DeleteAllWorkloadIdentityPoolProvider deletes all resources that the filter functions returns true on.
@@ -344,10 +361,11 @@ func (c *Client) DeleteAllWorkloadIdentityPoolProvider(ctx context.Context, proj
 }
 
 func (c *Client) ApplyWorkloadIdentityPoolProvider(ctx context.Context, rawDesired *WorkloadIdentityPoolProvider, opts ...dcl.ApplyOption) (*WorkloadIdentityPoolProvider, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPoolProvider{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkloadIdentityPoolProvider{}).Describe())
 	var resultNewState *WorkloadIdentityPoolProvider
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyWorkloadIdentityPoolProviderHelper(c, ctx, rawDesired, opts...)
@@ -365,6 +383,38 @@ func (c *Client) ApplyWorkloadIdentityPoolProvider(ctx context.Context, rawDesir
 	return resultNewState, err
 }
 
+func (c *Client) ApplyWorkloadIdentityPoolProviderAsync(ctx context.Context, rawDesired *WorkloadIdentityPoolProvider, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyWorkloadIdentityPoolProvider(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffWorkloadIdentityPoolProvider returns the field-level differences between rawDesired and the
+// live WorkloadIdentityPoolProvider without modifying it. If the WorkloadIdentityPoolProvider does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffWorkloadIdentityPoolProvider(ctx context.Context, rawDesired *WorkloadIdentityPoolProvider, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkloadIdentityPoolProvider{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkloadIdentityPoolProvider{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractWorkloadIdentityPoolProviderFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.workloadIdentityPoolProviderDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("WorkloadIdentityPoolProvider %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyWorkloadIdentityPoolProviderHelper(c *Client, ctx context.Context, rawDesired *WorkloadIdentityPoolProvider, opts ...dcl.ApplyOption) (*WorkloadIdentityPoolProvider, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyWorkloadIdentityPoolProvider...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -373,6 +423,9 @@ func applyWorkloadIdentityPoolProviderHelper(c *Client, ctx context.Context, raw
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractWorkloadIdentityPoolProviderFields(rawDesired); err != nil {
 		return nil, err
@@ -431,7 +484,7 @@ func applyWorkloadIdentityPoolProviderHelper(c *Client, ctx context.Context, raw
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -0,0 +1,100 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package iam
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyChangesApply(t *testing.T) {
+	viewer := func(members ...string) Binding {
+		return Binding{Role: dcl.String("roles/viewer"), Members: members}
+	}
+	dataRead := func(service string) AuditConfig {
+		return AuditConfig{Service: dcl.String(service), AuditLogConfigs: []AuditLogConfig{{LogType: dcl.String("DATA_READ")}}}
+	}
+	tests := []struct {
+		name         string
+		policy       Policy
+		changes      PolicyChanges
+		want         Policy
+		wantModified bool
+	}{
+		{
+			name:   "members are added after bindings are set",
+			policy: Policy{Bindings: []Binding{viewer("user:a")}},
+			changes: PolicyChanges{
+				SetBindings: []*Binding{{Role: dcl.String("roles/viewer"), Members: []string{"user:b"}}},
+				AddMembers:  []*Member{{Role: dcl.String("roles/viewer"), Member: dcl.String("user:c")}},
+			},
+			want:         Policy{Bindings: []Binding{viewer("user:b", "user:c")}},
+			wantModified: true,
+		},
+		{
+			name:   "members are added after bindings are deleted",
+			policy: Policy{Bindings: []Binding{viewer("user:a")}},
+			changes: PolicyChanges{
+				DeleteBindings: []*Binding{{Role: dcl.String("roles/viewer")}},
+				AddMembers:     []*Member{{Role: dcl.String("roles/viewer"), Member: dcl.String("user:b")}},
+			},
+			want:         Policy{Bindings: []Binding{viewer("user:b")}},
+			wantModified: true,
+		},
+		{
+			name:   "members are deleted after they are added",
+			policy: Policy{Bindings: []Binding{viewer("user:a")}},
+			changes: PolicyChanges{
+				AddMembers:    []*Member{{Role: dcl.String("roles/viewer"), Member: dcl.String("user:b")}},
+				DeleteMembers: []*Member{{Role: dcl.String("roles/viewer"), Member: dcl.String("user:b")}},
+			},
+			want:         Policy{Bindings: []Binding{viewer("user:a")}},
+			wantModified: true,
+		},
+		{
+			name:   "audit configs are deleted after they are set",
+			policy: Policy{AuditConfigs: []AuditConfig{dataRead("allServices")}},
+			changes: PolicyChanges{
+				SetAuditConfigs:    []*AuditConfig{{Service: dcl.String("storage.googleapis.com")}, {Service: dcl.String("allServices")}},
+				DeleteAuditConfigs: []string{"storage.googleapis.com"},
+			},
+			want:         Policy{AuditConfigs: []AuditConfig{{Service: dcl.String("allServices")}}},
+			wantModified: true,
+		},
+		{
+			name:   "unchanged policy",
+			policy: Policy{Bindings: []Binding{viewer("user:a")}, AuditConfigs: []AuditConfig{dataRead("allServices")}},
+			changes: PolicyChanges{
+				DeleteBindings:     []*Binding{{Role: dcl.String("roles/editor")}},
+				AddMembers:         []*Member{{Role: dcl.String("roles/viewer"), Member: dcl.String("user:a")}},
+				DeleteMembers:      []*Member{{Role: dcl.String("roles/viewer"), Member: dcl.String("user:b")}},
+				DeleteAuditConfigs: []string{"storage.googleapis.com"},
+			},
+			want: Policy{Bindings: []Binding{viewer("user:a")}, AuditConfigs: []AuditConfig{dataRead("allServices")}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := tc.policy
+			if got := tc.changes.apply(&p); got != tc.wantModified {
+				t.Errorf("apply() = %v, want %v", got, tc.wantModified)
+			}
+			if diff := cmp.Diff(tc.want, p); diff != "" {
+				t.Errorf("apply() policy diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -368,7 +368,7 @@ func (c *Client) serviceAccountDiffsForRawDesired(ctx context.Context, rawDesire
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a ServiceAccount resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve ServiceAccount resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve ServiceAccount resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that ServiceAccount resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -382,6 +382,12 @@ func (c *Client) serviceAccountDiffsForRawDesired(ctx context.Context, rawDesire
 	if err := extractServiceAccountFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeServiceAccountInitialState(rawInitial, rawDesired)
@@ -399,7 +405,7 @@ func (c *Client) serviceAccountDiffsForRawDesired(ctx context.Context, rawDesire
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffServiceAccount(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeServiceAccountInitialState(rawInitial, rawDesired *ServiceAccount) (*ServiceAccount, error) {
@@ -111,8 +111,12 @@ func (l *WorkforcePoolList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *WorkforcePoolList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *WorkforcePoolList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -129,7 +133,8 @@ func (l *WorkforcePoolList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListWorkforcePool(ctx context.Context, location, parent string) (*WorkforcePoolList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkforcePool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListWorkforcePoolWithMaxResults(ctx, location, parent, WorkforcePoolMaxPage)
@@ -137,7 +142,11 @@ func (c *Client) ListWorkforcePool(ctx context.Context, location, parent string)
 }
 
 func (c *Client) ListWorkforcePoolWithMaxResults(ctx context.Context, location, parent string, pageSize int32) (*WorkforcePoolList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListWorkforcePoolWithPageToken(ctx, location, parent, pageSize, "")
+}
+
+func (c *Client) ListWorkforcePoolWithPageToken(ctx context.Context, location, parent string, pageSize int32, pageToken string) (*WorkforcePoolList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -145,7 +154,7 @@ func (c *Client) ListWorkforcePoolWithMaxResults(ctx context.Context, location,
 		Location: &location,
 		Parent:   &parent,
 	}
-	items, token, err := c.listWorkforcePool(ctx, r, "", pageSize)
+	items, token, err := c.listWorkforcePool(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +168,8 @@ func (c *Client) ListWorkforcePoolWithMaxResults(ctx context.Context, location,
 
 func (c *Client) GetWorkforcePool(ctx context.Context, r *WorkforcePool) (*WorkforcePool, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkforcePool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -175,7 +185,7 @@ func (c *Client) GetWorkforcePool(ctx context.Context, r *WorkforcePool) (*Workf
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalWorkforcePool(b, c, r)
 	if err != nil {
@@ -200,7 +210,8 @@ func (c *Client) GetWorkforcePool(ctx context.Context, r *WorkforcePool) (*Workf
 
 func (c *Client) DeleteWorkforcePool(ctx context.Context, r *WorkforcePool) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkforcePool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -208,7 +219,13 @@ func (c *Client) DeleteWorkforcePool(ctx context.Context, r *WorkforcePool) erro
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting WorkforcePool...")
 	deleteOp := deleteWorkforcePoolOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteWorkforcePoolAsync(ctx context.Context, r *WorkforcePool) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteWorkforcePool(ctx, r)
+	})
 }
 
 // DeleteAllWorkforcePool deletes all resources that the filter functions returns true on.
@@ -236,10 +253,11 @@ func (c *Client) DeleteAllWorkforcePool(ctx context.Context, location, parent st
 }
 
 func (c *Client) ApplyWorkforcePool(ctx context.Context, rawDesired *WorkforcePool, opts ...dcl.ApplyOption) (*WorkforcePool, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkforcePool{}).Describe())
 	var resultNewState *WorkforcePool
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyWorkforcePoolHelper(c, ctx, rawDesired, opts...)
@@ -257,6 +275,38 @@ func (c *Client) ApplyWorkforcePool(ctx context.Context, rawDesired *WorkforcePo
 	return resultNewState, err
 }
 
+func (c *Client) ApplyWorkforcePoolAsync(ctx context.Context, rawDesired *WorkforcePool, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyWorkforcePool(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffWorkforcePool returns the field-level differences between rawDesired and the
+// live WorkforcePool without modifying it. If the WorkforcePool does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffWorkforcePool(ctx context.Context, rawDesired *WorkforcePool, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePool{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkforcePool{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractWorkforcePoolFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.workforcePoolDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("WorkforcePool %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyWorkforcePoolHelper(c *Client, ctx context.Context, rawDesired *WorkforcePool, opts ...dcl.ApplyOption) (*WorkforcePool, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyWorkforcePool...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -265,6 +315,9 @@ func applyWorkforcePoolHelper(c *Client, ctx context.Context, rawDesired *Workfo
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractWorkforcePoolFields(rawDesired); err != nil {
 		return nil, err
@@ -323,7 +376,7 @@ func applyWorkforcePoolHelper(c *Client, ctx context.Context, rawDesired *Workfo
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -211,8 +211,12 @@ func (l *WorkforcePoolProviderList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *WorkforcePoolProviderList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *WorkforcePoolProviderList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePoolProvider{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -229,7 +233,8 @@ func (l *WorkforcePoolProviderList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListWorkforcePoolProvider(ctx context.Context, location, workforcePool string) (*WorkforcePoolProviderList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkforcePoolProvider{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePoolProvider{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListWorkforcePoolProviderWithMaxResults(ctx, location, workforcePool, WorkforcePoolProviderMaxPage)
@@ -237,7 +242,11 @@ func (c *Client) ListWorkforcePoolProvider(ctx context.Context, location, workfo
 }
 
 func (c *Client) ListWorkforcePoolProviderWithMaxResults(ctx context.Context, location, workforcePool string, pageSize int32) (*WorkforcePoolProviderList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListWorkforcePoolProviderWithPageToken(ctx, location, workforcePool, pageSize, "")
+}
+
+func (c *Client) ListWorkforcePoolProviderWithPageToken(ctx context.Context, location, workforcePool string, pageSize int32, pageToken string) (*WorkforcePoolProviderList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePoolProvider{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -245,7 +254,7 @@ func (c *Client) ListWorkforcePoolProviderWithMaxResults(ctx context.Context, lo
 		Location:      &location,
 		WorkforcePool: &workforcePool,
 	}
-	items, token, err := c.listWorkforcePoolProvider(ctx, r, "", pageSize)
+	items, token, err := c.listWorkforcePoolProvider(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -259,7 +268,8 @@ func (c *Client) ListWorkforcePoolProviderWithMaxResults(ctx context.Context, lo
 
 func (c *Client) GetWorkforcePoolProvider(ctx context.Context, r *WorkforcePoolProvider) (*WorkforcePoolProvider, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkforcePoolProvider{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePoolProvider{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -275,7 +285,7 @@ func (c *Client) GetWorkforcePoolProvider(ctx context.Context, r *WorkforcePoolP
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalWorkforcePoolProvider(b, c, r)
 	if err != nil {
@@ -301,7 +311,8 @@ func (c *Client) GetWorkforcePoolProvider(ctx context.Context, r *WorkforcePoolP
 
 func (c *Client) DeleteWorkforcePoolProvider(ctx context.Context, r *WorkforcePoolProvider) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkforcePoolProvider{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePoolProvider{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -309,7 +320,13 @@ func (c *Client) DeleteWorkforcePoolProvider(ctx context.Context, r *WorkforcePo
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting WorkforcePoolProvider...")
 	deleteOp := deleteWorkforcePoolProviderOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteWorkforcePoolProviderAsync(ctx context.Context, r *WorkforcePoolProvider) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteWorkforcePoolProvider(ctx, r)
+	})
 }
 
 // DeleteAllWorkforcePoolProvider deletes all resources that the filter functions returns true on.
@@ -337,10 +354,11 @@ func (c *Client) DeleteAllWorkforcePoolProvider(ctx context.Context, location, w
 }
 
 func (c *Client) ApplyWorkforcePoolProvider(ctx context.Context, rawDesired *WorkforcePoolProvider, opts ...dcl.ApplyOption) (*WorkforcePoolProvider, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePoolProvider{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkforcePoolProvider{}).Describe())
 	var resultNewState *WorkforcePoolProvider
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyWorkforcePoolProviderHelper(c, ctx, rawDesired, opts...)
@@ -358,6 +376,38 @@ func (c *Client) ApplyWorkforcePoolProvider(ctx context.Context, rawDesired *Wor
 	return resultNewState, err
 }
 
+func (c *Client) ApplyWorkforcePoolProviderAsync(ctx context.Context, rawDesired *WorkforcePoolProvider, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyWorkforcePoolProvider(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffWorkforcePoolProvider returns the field-level differences between rawDesired and the
+// live WorkforcePoolProvider without modifying it. If the WorkforcePoolProvider does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffWorkforcePoolProvider(ctx context.Context, rawDesired *WorkforcePoolProvider, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&WorkforcePoolProvider{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&WorkforcePoolProvider{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractWorkforcePoolProviderFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.workforcePoolProviderDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("WorkforcePoolProvider %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyWorkforcePoolProviderHelper(c *Client, ctx context.Context, rawDesired *WorkforcePoolProvider, opts ...dcl.ApplyOption) (*WorkforcePoolProvider, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyWorkforcePoolProvider...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -366,6 +416,9 @@ func applyWorkforcePoolProviderHelper(c *Client, ctx context.Context, rawDesired
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractWorkforcePoolProviderFields(rawDesired); err != nil {
 		return nil, err
@@ -424,7 +477,7 @@ func applyWorkforcePoolProviderHelper(c *Client, ctx context.Context, rawDesired
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
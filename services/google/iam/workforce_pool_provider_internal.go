@@ -407,7 +407,7 @@ func (c *Client) workforcePoolProviderDiffsForRawDesired(ctx context.Context, ra
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a WorkforcePoolProvider resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve WorkforcePoolProvider resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve WorkforcePoolProvider resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that WorkforcePoolProvider resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -421,6 +421,12 @@ func (c *Client) workforcePoolProviderDiffsForRawDesired(ctx context.Context, ra
 	if err := extractWorkforcePoolProviderFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeWorkforcePoolProviderInitialState(rawInitial, rawDesired)
@@ -438,7 +444,7 @@ func (c *Client) workforcePoolProviderDiffsForRawDesired(ctx context.Context, ra
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffWorkforcePoolProvider(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeWorkforcePoolProviderInitialState(rawInitial, rawDesired *WorkforcePoolProvider) (*WorkforcePoolProvider, error) {
@@ -341,7 +341,7 @@ func (c *Client) workloadIdentityPoolDiffsForRawDesired(ctx context.Context, raw
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a WorkloadIdentityPool resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve WorkloadIdentityPool resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve WorkloadIdentityPool resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that WorkloadIdentityPool resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -355,6 +355,12 @@ func (c *Client) workloadIdentityPoolDiffsForRawDesired(ctx context.Context, raw
 	if err := extractWorkloadIdentityPoolFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeWorkloadIdentityPoolInitialState(rawInitial, rawDesired)
@@ -372,7 +378,7 @@ func (c *Client) workloadIdentityPoolDiffsForRawDesired(ctx context.Context, raw
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffWorkloadIdentityPool(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeWorkloadIdentityPoolInitialState(rawInitial, rawDesired *WorkloadIdentityPool) (*WorkloadIdentityPool, error) {
@@ -409,7 +409,7 @@ func (c *Client) workloadIdentityPoolProviderDiffsForRawDesired(ctx context.Cont
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a WorkloadIdentityPoolProvider resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve WorkloadIdentityPoolProvider resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve WorkloadIdentityPoolProvider resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that WorkloadIdentityPoolProvider resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -423,6 +423,12 @@ func (c *Client) workloadIdentityPoolProviderDiffsForRawDesired(ctx context.Cont
 	if err := extractWorkloadIdentityPoolProviderFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeWorkloadIdentityPoolProviderInitialState(rawInitial, rawDesired)
@@ -440,7 +446,7 @@ func (c *Client) workloadIdentityPoolProviderDiffsForRawDesired(ctx context.Cont
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffWorkloadIdentityPoolProvider(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeWorkloadIdentityPoolProviderInitialState(rawInitial, rawDesired *WorkloadIdentityPoolProvider) (*WorkloadIdentityPoolProvider, error) {
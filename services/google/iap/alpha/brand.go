@@ -75,8 +75,12 @@ func (l *BrandList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *BrandList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *BrandList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Brand{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -93,7 +97,8 @@ func (l *BrandList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListBrand(ctx context.Context, project string) (*BrandList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Brand{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Brand{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListBrandWithMaxResults(ctx, project, BrandMaxPage)
@@ -101,14 +106,18 @@ func (c *Client) ListBrand(ctx context.Context, project string) (*BrandList, err
 }
 
 func (c *Client) ListBrandWithMaxResults(ctx context.Context, project string, pageSize int32) (*BrandList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListBrandWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListBrandWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*BrandList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Brand{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Brand{
 		Project: &project,
 	}
-	items, token, err := c.listBrand(ctx, r, "", pageSize)
+	items, token, err := c.listBrand(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +131,8 @@ func (c *Client) ListBrandWithMaxResults(ctx context.Context, project string, pa
 
 func (c *Client) GetBrand(ctx context.Context, r *Brand) (*Brand, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Brand{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Brand{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -138,7 +148,7 @@ func (c *Client) GetBrand(ctx context.Context, r *Brand) (*Brand, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalBrand(b, c, r)
 	if err != nil {
@@ -162,10 +172,11 @@ func (c *Client) GetBrand(ctx context.Context, r *Brand) (*Brand, error) {
 }
 
 func (c *Client) ApplyBrand(ctx context.Context, rawDesired *Brand, opts ...dcl.ApplyOption) (*Brand, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Brand{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Brand{}).Describe())
 	var resultNewState *Brand
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyBrandHelper(c, ctx, rawDesired, opts...)
@@ -183,6 +194,38 @@ func (c *Client) ApplyBrand(ctx context.Context, rawDesired *Brand, opts ...dcl.
 	return resultNewState, err
 }
 
+func (c *Client) ApplyBrandAsync(ctx context.Context, rawDesired *Brand, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyBrand(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffBrand returns the field-level differences between rawDesired and the
+// live Brand without modifying it. If the Brand does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffBrand(ctx context.Context, rawDesired *Brand, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Brand{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Brand{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractBrandFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.brandDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Brand %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyBrandHelper(c *Client, ctx context.Context, rawDesired *Brand, opts ...dcl.ApplyOption) (*Brand, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyBrand...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -191,6 +234,9 @@ func applyBrandHelper(c *Client, ctx context.Context, rawDesired *Brand, opts ..
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractBrandFields(rawDesired); err != nil {
 		return nil, err
@@ -249,7 +295,7 @@ func applyBrandHelper(c *Client, ctx context.Context, rawDesired *Brand, opts ..
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
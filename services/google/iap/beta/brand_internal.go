@@ -215,7 +215,7 @@ func (c *Client) brandDiffsForRawDesired(ctx context.Context, rawDesired *Brand,
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Brand resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Brand resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Brand resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Brand resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -229,6 +229,12 @@ func (c *Client) brandDiffsForRawDesired(ctx context.Context, rawDesired *Brand,
 	if err := extractBrandFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeBrandInitialState(rawInitial, rawDesired)
@@ -246,7 +252,7 @@ func (c *Client) brandDiffsForRawDesired(ctx context.Context, rawDesired *Brand,
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffBrand(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeBrandInitialState(rawInitial, rawDesired *Brand) (*Brand, error) {
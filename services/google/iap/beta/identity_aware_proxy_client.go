@@ -75,8 +75,12 @@ func (l *IdentityAwareProxyClientList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *IdentityAwareProxyClientList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *IdentityAwareProxyClientList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&IdentityAwareProxyClient{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -93,7 +97,8 @@ func (l *IdentityAwareProxyClientList) Next(ctx context.Context, c *Client) erro
 
 func (c *Client) ListIdentityAwareProxyClient(ctx context.Context, project, brand string) (*IdentityAwareProxyClientList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&IdentityAwareProxyClient{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&IdentityAwareProxyClient{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListIdentityAwareProxyClientWithMaxResults(ctx, project, brand, IdentityAwareProxyClientMaxPage)
@@ -101,7 +106,11 @@ func (c *Client) ListIdentityAwareProxyClient(ctx context.Context, project, bran
 }
 
 func (c *Client) ListIdentityAwareProxyClientWithMaxResults(ctx context.Context, project, brand string, pageSize int32) (*IdentityAwareProxyClientList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListIdentityAwareProxyClientWithPageToken(ctx, project, brand, pageSize, "")
+}
+
+func (c *Client) ListIdentityAwareProxyClientWithPageToken(ctx context.Context, project, brand string, pageSize int32, pageToken string) (*IdentityAwareProxyClientList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&IdentityAwareProxyClient{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -109,7 +118,7 @@ func (c *Client) ListIdentityAwareProxyClientWithMaxResults(ctx context.Context,
 		Project: &project,
 		Brand:   &brand,
 	}
-	items, token, err := c.listIdentityAwareProxyClient(ctx, r, "", pageSize)
+	items, token, err := c.listIdentityAwareProxyClient(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +132,8 @@ func (c *Client) ListIdentityAwareProxyClientWithMaxResults(ctx context.Context,
 
 func (c *Client) GetIdentityAwareProxyClient(ctx context.Context, r *IdentityAwareProxyClient) (*IdentityAwareProxyClient, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&IdentityAwareProxyClient{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&IdentityAwareProxyClient{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -139,7 +149,7 @@ func (c *Client) GetIdentityAwareProxyClient(ctx context.Context, r *IdentityAwa
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalIdentityAwareProxyClient(b, c, r)
 	if err != nil {
@@ -165,7 +175,8 @@ func (c *Client) GetIdentityAwareProxyClient(ctx context.Context, r *IdentityAwa
 
 func (c *Client) DeleteIdentityAwareProxyClient(ctx context.Context, r *IdentityAwareProxyClient) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&IdentityAwareProxyClient{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&IdentityAwareProxyClient{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -173,7 +184,13 @@ func (c *Client) DeleteIdentityAwareProxyClient(ctx context.Context, r *Identity
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting IdentityAwareProxyClient...")
 	deleteOp := deleteIdentityAwareProxyClientOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteIdentityAwareProxyClientAsync(ctx context.Context, r *IdentityAwareProxyClient) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteIdentityAwareProxyClient(ctx, r)
+	})
 }
 
 // DeleteAllIdentityAwareProxyClient deletes all resources that the filter functions returns true on.
@@ -201,10 +218,11 @@ func (c *Client) DeleteAllIdentityAwareProxyClient(ctx context.Context, project,
 }
 
 func (c *Client) ApplyIdentityAwareProxyClient(ctx context.Context, rawDesired *IdentityAwareProxyClient, opts ...dcl.ApplyOption) (*IdentityAwareProxyClient, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&IdentityAwareProxyClient{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&IdentityAwareProxyClient{}).Describe())
 	var resultNewState *IdentityAwareProxyClient
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyIdentityAwareProxyClientHelper(c, ctx, rawDesired, opts...)
@@ -222,6 +240,38 @@ func (c *Client) ApplyIdentityAwareProxyClient(ctx context.Context, rawDesired *
 	return resultNewState, err
 }
 
+func (c *Client) ApplyIdentityAwareProxyClientAsync(ctx context.Context, rawDesired *IdentityAwareProxyClient, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyIdentityAwareProxyClient(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffIdentityAwareProxyClient returns the field-level differences between rawDesired and the
+// live IdentityAwareProxyClient without modifying it. If the IdentityAwareProxyClient does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffIdentityAwareProxyClient(ctx context.Context, rawDesired *IdentityAwareProxyClient, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&IdentityAwareProxyClient{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&IdentityAwareProxyClient{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractIdentityAwareProxyClientFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.identityAwareProxyClientDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("IdentityAwareProxyClient %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyIdentityAwareProxyClientHelper(c *Client, ctx context.Context, rawDesired *IdentityAwareProxyClient, opts ...dcl.ApplyOption) (*IdentityAwareProxyClient, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyIdentityAwareProxyClient...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -230,6 +280,9 @@ func applyIdentityAwareProxyClientHelper(c *Client, ctx context.Context, rawDesi
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractIdentityAwareProxyClientFields(rawDesired); err != nil {
 		return nil, err
@@ -288,7 +341,7 @@ func applyIdentityAwareProxyClientHelper(c *Client, ctx context.Context, rawDesi
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -296,7 +296,7 @@ func (c *Client) identityAwareProxyClientDiffsForRawDesired(ctx context.Context,
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a IdentityAwareProxyClient resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve IdentityAwareProxyClient resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve IdentityAwareProxyClient resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that IdentityAwareProxyClient resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -310,6 +310,12 @@ func (c *Client) identityAwareProxyClientDiffsForRawDesired(ctx context.Context,
 	if err := extractIdentityAwareProxyClientFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeIdentityAwareProxyClientInitialState(rawInitial, rawDesired)
@@ -327,7 +333,7 @@ func (c *Client) identityAwareProxyClientDiffsForRawDesired(ctx context.Context,
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffIdentityAwareProxyClient(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeIdentityAwareProxyClientInitialState(rawInitial, rawDesired *IdentityAwareProxyClient) (*IdentityAwareProxyClient, error) {
@@ -409,7 +409,7 @@ func (c *Client) configDiffsForRawDesired(ctx context.Context, rawDesired *Confi
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Config resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Config resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Config resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Config resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -423,6 +423,12 @@ func (c *Client) configDiffsForRawDesired(ctx context.Context, rawDesired *Confi
 	if err := extractConfigFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeConfigInitialState(rawInitial, rawDesired)
@@ -440,7 +446,7 @@ func (c *Client) configDiffsForRawDesired(ctx context.Context, rawDesired *Confi
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffConfig(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeConfigInitialState(rawInitial, rawDesired *Config) (*Config, error) {
@@ -135,8 +135,12 @@ func (l *OAuthIdpConfigList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *OAuthIdpConfigList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *OAuthIdpConfigList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OAuthIdpConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -153,7 +157,8 @@ func (l *OAuthIdpConfigList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListOAuthIdpConfig(ctx context.Context, project string) (*OAuthIdpConfigList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&OAuthIdpConfig{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OAuthIdpConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListOAuthIdpConfigWithMaxResults(ctx, project, OAuthIdpConfigMaxPage)
@@ -161,14 +166,18 @@ func (c *Client) ListOAuthIdpConfig(ctx context.Context, project string) (*OAuth
 }
 
 func (c *Client) ListOAuthIdpConfigWithMaxResults(ctx context.Context, project string, pageSize int32) (*OAuthIdpConfigList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListOAuthIdpConfigWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListOAuthIdpConfigWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*OAuthIdpConfigList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OAuthIdpConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &OAuthIdpConfig{
 		Project: &project,
 	}
-	items, token, err := c.listOAuthIdpConfig(ctx, r, "", pageSize)
+	items, token, err := c.listOAuthIdpConfig(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +191,8 @@ func (c *Client) ListOAuthIdpConfigWithMaxResults(ctx context.Context, project s
 
 func (c *Client) GetOAuthIdpConfig(ctx context.Context, r *OAuthIdpConfig) (*OAuthIdpConfig, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&OAuthIdpConfig{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OAuthIdpConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -198,7 +208,7 @@ func (c *Client) GetOAuthIdpConfig(ctx context.Context, r *OAuthIdpConfig) (*OAu
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalOAuthIdpConfig(b, c, r)
 	if err != nil {
@@ -223,7 +233,8 @@ func (c *Client) GetOAuthIdpConfig(ctx context.Context, r *OAuthIdpConfig) (*OAu
 
 func (c *Client) DeleteOAuthIdpConfig(ctx context.Context, r *OAuthIdpConfig) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&OAuthIdpConfig{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OAuthIdpConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -231,7 +242,13 @@ func (c *Client) DeleteOAuthIdpConfig(ctx context.Context, r *OAuthIdpConfig) er
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting OAuthIdpConfig...")
 	deleteOp := deleteOAuthIdpConfigOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteOAuthIdpConfigAsync(ctx context.Context, r *OAuthIdpConfig) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteOAuthIdpConfig(ctx, r)
+	})
 }
 
 // DeleteAllOAuthIdpConfig deletes all resources that the filter functions returns true on.
@@ -259,10 +276,11 @@ func (c *Client) DeleteAllOAuthIdpConfig(ctx context.Context, project string, fi
 }
 
 func (c *Client) ApplyOAuthIdpConfig(ctx context.Context, rawDesired *OAuthIdpConfig, opts ...dcl.ApplyOption) (*OAuthIdpConfig, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OAuthIdpConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&OAuthIdpConfig{}).Describe())
 	var resultNewState *OAuthIdpConfig
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyOAuthIdpConfigHelper(c, ctx, rawDesired, opts...)
@@ -280,6 +298,38 @@ func (c *Client) ApplyOAuthIdpConfig(ctx context.Context, rawDesired *OAuthIdpCo
 	return resultNewState, err
 }
 
+func (c *Client) ApplyOAuthIdpConfigAsync(ctx context.Context, rawDesired *OAuthIdpConfig, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyOAuthIdpConfig(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffOAuthIdpConfig returns the field-level differences between rawDesired and the
+// live OAuthIdpConfig without modifying it. If the OAuthIdpConfig does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffOAuthIdpConfig(ctx context.Context, rawDesired *OAuthIdpConfig, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OAuthIdpConfig{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&OAuthIdpConfig{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractOAuthIdpConfigFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.oAuthIdpConfigDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("OAuthIdpConfig %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyOAuthIdpConfigHelper(c *Client, ctx context.Context, rawDesired *OAuthIdpConfig, opts ...dcl.ApplyOption) (*OAuthIdpConfig, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyOAuthIdpConfig...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -288,6 +338,9 @@ func applyOAuthIdpConfigHelper(c *Client, ctx context.Context, rawDesired *OAuth
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractOAuthIdpConfigFields(rawDesired); err != nil {
 		return nil, err
@@ -346,7 +399,7 @@ func applyOAuthIdpConfigHelper(c *Client, ctx context.Context, rawDesired *OAuth
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -188,8 +188,12 @@ func (l *TenantList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *TenantList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *TenantList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Tenant{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -206,7 +210,8 @@ func (l *TenantList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListTenant(ctx context.Context, project string) (*TenantList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Tenant{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Tenant{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListTenantWithMaxResults(ctx, project, TenantMaxPage)
@@ -214,14 +219,18 @@ func (c *Client) ListTenant(ctx context.Context, project string) (*TenantList, e
 }
 
 func (c *Client) ListTenantWithMaxResults(ctx context.Context, project string, pageSize int32) (*TenantList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListTenantWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListTenantWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*TenantList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Tenant{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Tenant{
 		Project: &project,
 	}
-	items, token, err := c.listTenant(ctx, r, "", pageSize)
+	items, token, err := c.listTenant(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +244,8 @@ func (c *Client) ListTenantWithMaxResults(ctx context.Context, project string, p
 
 func (c *Client) GetTenant(ctx context.Context, r *Tenant) (*Tenant, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Tenant{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Tenant{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -251,7 +261,7 @@ func (c *Client) GetTenant(ctx context.Context, r *Tenant) (*Tenant, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalTenant(b, c, r)
 	if err != nil {
@@ -276,7 +286,8 @@ func (c *Client) GetTenant(ctx context.Context, r *Tenant) (*Tenant, error) {
 
 func (c *Client) DeleteTenant(ctx context.Context, r *Tenant) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Tenant{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Tenant{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -284,7 +295,13 @@ func (c *Client) DeleteTenant(ctx context.Context, r *Tenant) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Tenant...")
 	deleteOp := deleteTenantOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteTenantAsync(ctx context.Context, r *Tenant) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteTenant(ctx, r)
+	})
 }
 
 // DeleteAllTenant deletes all resources that the filter functions returns true on.
@@ -312,10 +329,11 @@ func (c *Client) DeleteAllTenant(ctx context.Context, project string, filter fun
 }
 
 func (c *Client) ApplyTenant(ctx context.Context, rawDesired *Tenant, opts ...dcl.ApplyOption) (*Tenant, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Tenant{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Tenant{}).Describe())
 	var resultNewState *Tenant
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyTenantHelper(c, ctx, rawDesired, opts...)
@@ -333,6 +351,38 @@ func (c *Client) ApplyTenant(ctx context.Context, rawDesired *Tenant, opts ...dc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyTenantAsync(ctx context.Context, rawDesired *Tenant, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyTenant(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffTenant returns the field-level differences between rawDesired and the
+// live Tenant without modifying it. If the Tenant does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffTenant(ctx context.Context, rawDesired *Tenant, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Tenant{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Tenant{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractTenantFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.tenantDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Tenant %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyTenantHelper(c *Client, ctx context.Context, rawDesired *Tenant, opts ...dcl.ApplyOption) (*Tenant, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyTenant...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -341,6 +391,9 @@ func applyTenantHelper(c *Client, ctx context.Context, rawDesired *Tenant, opts
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractTenantFields(rawDesired); err != nil {
 		return nil, err
@@ -399,7 +452,7 @@ func applyTenantHelper(c *Client, ctx context.Context, rawDesired *Tenant, opts
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
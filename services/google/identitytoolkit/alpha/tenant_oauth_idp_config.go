@@ -137,8 +137,12 @@ func (l *TenantOAuthIdpConfigList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *TenantOAuthIdpConfigList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *TenantOAuthIdpConfigList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TenantOAuthIdpConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -155,7 +159,8 @@ func (l *TenantOAuthIdpConfigList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListTenantOAuthIdpConfig(ctx context.Context, project, tenant string) (*TenantOAuthIdpConfigList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TenantOAuthIdpConfig{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TenantOAuthIdpConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListTenantOAuthIdpConfigWithMaxResults(ctx, project, tenant, TenantOAuthIdpConfigMaxPage)
@@ -163,7 +168,11 @@ func (c *Client) ListTenantOAuthIdpConfig(ctx context.Context, project, tenant s
 }
 
 func (c *Client) ListTenantOAuthIdpConfigWithMaxResults(ctx context.Context, project, tenant string, pageSize int32) (*TenantOAuthIdpConfigList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListTenantOAuthIdpConfigWithPageToken(ctx, project, tenant, pageSize, "")
+}
+
+func (c *Client) ListTenantOAuthIdpConfigWithPageToken(ctx context.Context, project, tenant string, pageSize int32, pageToken string) (*TenantOAuthIdpConfigList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TenantOAuthIdpConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -171,7 +180,7 @@ func (c *Client) ListTenantOAuthIdpConfigWithMaxResults(ctx context.Context, pro
 		Project: &project,
 		Tenant:  &tenant,
 	}
-	items, token, err := c.listTenantOAuthIdpConfig(ctx, r, "", pageSize)
+	items, token, err := c.listTenantOAuthIdpConfig(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -185,7 +194,8 @@ func (c *Client) ListTenantOAuthIdpConfigWithMaxResults(ctx context.Context, pro
 
 func (c *Client) GetTenantOAuthIdpConfig(ctx context.Context, r *TenantOAuthIdpConfig) (*TenantOAuthIdpConfig, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TenantOAuthIdpConfig{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TenantOAuthIdpConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -201,7 +211,7 @@ func (c *Client) GetTenantOAuthIdpConfig(ctx context.Context, r *TenantOAuthIdpC
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalTenantOAuthIdpConfig(b, c, r)
 	if err != nil {
@@ -227,7 +237,8 @@ func (c *Client) GetTenantOAuthIdpConfig(ctx context.Context, r *TenantOAuthIdpC
 
 func (c *Client) DeleteTenantOAuthIdpConfig(ctx context.Context, r *TenantOAuthIdpConfig) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TenantOAuthIdpConfig{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TenantOAuthIdpConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -235,7 +246,13 @@ func (c *Client) DeleteTenantOAuthIdpConfig(ctx context.Context, r *TenantOAuthI
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting TenantOAuthIdpConfig...")
 	deleteOp := deleteTenantOAuthIdpConfigOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteTenantOAuthIdpConfigAsync(ctx context.Context, r *TenantOAuthIdpConfig) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteTenantOAuthIdpConfig(ctx, r)
+	})
 }
 
 // DeleteAllTenantOAuthIdpConfig deletes all resources that the filter functions returns true on.
@@ -263,10 +280,11 @@ func (c *Client) DeleteAllTenantOAuthIdpConfig(ctx context.Context, project, ten
 }
 
 func (c *Client) ApplyTenantOAuthIdpConfig(ctx context.Context, rawDesired *TenantOAuthIdpConfig, opts ...dcl.ApplyOption) (*TenantOAuthIdpConfig, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TenantOAuthIdpConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TenantOAuthIdpConfig{}).Describe())
 	var resultNewState *TenantOAuthIdpConfig
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyTenantOAuthIdpConfigHelper(c, ctx, rawDesired, opts...)
@@ -284,6 +302,38 @@ func (c *Client) ApplyTenantOAuthIdpConfig(ctx context.Context, rawDesired *Tena
 	return resultNewState, err
 }
 
+func (c *Client) ApplyTenantOAuthIdpConfigAsync(ctx context.Context, rawDesired *TenantOAuthIdpConfig, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyTenantOAuthIdpConfig(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffTenantOAuthIdpConfig returns the field-level differences between rawDesired and the
+// live TenantOAuthIdpConfig without modifying it. If the TenantOAuthIdpConfig does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffTenantOAuthIdpConfig(ctx context.Context, rawDesired *TenantOAuthIdpConfig, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TenantOAuthIdpConfig{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TenantOAuthIdpConfig{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractTenantOAuthIdpConfigFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.tenantOAuthIdpConfigDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("TenantOAuthIdpConfig %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyTenantOAuthIdpConfigHelper(c *Client, ctx context.Context, rawDesired *TenantOAuthIdpConfig, opts ...dcl.ApplyOption) (*TenantOAuthIdpConfig, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyTenantOAuthIdpConfig...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -292,6 +342,9 @@ func applyTenantOAuthIdpConfigHelper(c *Client, ctx context.Context, rawDesired
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractTenantOAuthIdpConfigFields(rawDesired); err != nil {
 		return nil, err
@@ -350,7 +403,7 @@ func applyTenantOAuthIdpConfigHelper(c *Client, ctx context.Context, rawDesired
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -1773,7 +1773,8 @@ type ConfigList struct {
 
 func (c *Client) GetConfig(ctx context.Context, r *Config) (*Config, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Config{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Config{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -1789,7 +1790,7 @@ func (c *Client) GetConfig(ctx context.Context, r *Config) (*Config, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalConfig(b, c, r)
 	if err != nil {
@@ -1812,10 +1813,11 @@ func (c *Client) GetConfig(ctx context.Context, r *Config) (*Config, error) {
 }
 
 func (c *Client) ApplyConfig(ctx context.Context, rawDesired *Config, opts ...dcl.ApplyOption) (*Config, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Config{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Config{}).Describe())
 	var resultNewState *Config
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyConfigHelper(c, ctx, rawDesired, opts...)
@@ -1833,6 +1835,38 @@ func (c *Client) ApplyConfig(ctx context.Context, rawDesired *Config, opts ...dc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyConfigAsync(ctx context.Context, rawDesired *Config, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyConfig(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffConfig returns the field-level differences between rawDesired and the
+// live Config without modifying it. If the Config does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffConfig(ctx context.Context, rawDesired *Config, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Config{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Config{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractConfigFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.configDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Config %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyConfigHelper(c *Client, ctx context.Context, rawDesired *Config, opts ...dcl.ApplyOption) (*Config, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyConfig...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -1841,6 +1875,9 @@ func applyConfigHelper(c *Client, ctx context.Context, rawDesired *Config, opts
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractConfigFields(rawDesired); err != nil {
 		return nil, err
@@ -1885,7 +1922,7 @@ func applyConfigHelper(c *Client, ctx context.Context, rawDesired *Config, opts
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.PhaseUpdate, err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -370,7 +370,7 @@ func (c *Client) oAuthIdpConfigDiffsForRawDesired(ctx context.Context, rawDesire
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a OAuthIdpConfig resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve OAuthIdpConfig resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve OAuthIdpConfig resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that OAuthIdpConfig resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -384,6 +384,12 @@ func (c *Client) oAuthIdpConfigDiffsForRawDesired(ctx context.Context, rawDesire
 	if err := extractOAuthIdpConfigFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeOAuthIdpConfigInitialState(rawInitial, rawDesired)
@@ -401,7 +407,7 @@ func (c *Client) oAuthIdpConfigDiffsForRawDesired(ctx context.Context, rawDesire
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffOAuthIdpConfig(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeOAuthIdpConfigInitialState(rawInitial, rawDesired *OAuthIdpConfig) (*OAuthIdpConfig, error) {
@@ -378,7 +378,7 @@ func (c *Client) tenantDiffsForRawDesired(ctx context.Context, rawDesired *Tenan
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Tenant resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Tenant resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Tenant resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Tenant resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -392,6 +392,12 @@ func (c *Client) tenantDiffsForRawDesired(ctx context.Context, rawDesired *Tenan
 	if err := extractTenantFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeTenantInitialState(rawInitial, rawDesired)
@@ -409,7 +415,7 @@ func (c *Client) tenantDiffsForRawDesired(ctx context.Context, rawDesired *Tenan
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffTenant(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeTenantInitialState(rawInitial, rawDesired *Tenant) (*Tenant, error) {
@@ -378,7 +378,7 @@ func (c *Client) tenantOAuthIdpConfigDiffsForRawDesired(ctx context.Context, raw
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a TenantOAuthIdpConfig resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve TenantOAuthIdpConfig resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve TenantOAuthIdpConfig resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that TenantOAuthIdpConfig resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -392,6 +392,12 @@ func (c *Client) tenantOAuthIdpConfigDiffsForRawDesired(ctx context.Context, raw
 	if err := extractTenantOAuthIdpConfigFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeTenantOAuthIdpConfigInitialState(rawInitial, rawDesired)
@@ -409,7 +415,7 @@ func (c *Client) tenantOAuthIdpConfigDiffsForRawDesired(ctx context.Context, raw
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffTenantOAuthIdpConfig(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeTenantOAuthIdpConfigInitialState(rawInitial, rawDesired *TenantOAuthIdpConfig) (*TenantOAuthIdpConfig, error) {
@@ -576,8 +576,12 @@ func (l *LogMetricList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *LogMetricList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *LogMetricList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogMetric{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -594,7 +598,8 @@ func (l *LogMetricList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListLogMetric(ctx context.Context, project string) (*LogMetricList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogMetric{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogMetric{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListLogMetricWithMaxResults(ctx, project, LogMetricMaxPage)
@@ -602,14 +607,18 @@ func (c *Client) ListLogMetric(ctx context.Context, project string) (*LogMetricL
 }
 
 func (c *Client) ListLogMetricWithMaxResults(ctx context.Context, project string, pageSize int32) (*LogMetricList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListLogMetricWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListLogMetricWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*LogMetricList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogMetric{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &LogMetric{
 		Project: &project,
 	}
-	items, token, err := c.listLogMetric(ctx, r, "", pageSize)
+	items, token, err := c.listLogMetric(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -623,7 +632,8 @@ func (c *Client) ListLogMetricWithMaxResults(ctx context.Context, project string
 
 func (c *Client) GetLogMetric(ctx context.Context, r *LogMetric) (*LogMetric, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogMetric{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogMetric{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -639,7 +649,7 @@ func (c *Client) GetLogMetric(ctx context.Context, r *LogMetric) (*LogMetric, er
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalLogMetric(b, c, r)
 	if err != nil {
@@ -664,7 +674,8 @@ func (c *Client) GetLogMetric(ctx context.Context, r *LogMetric) (*LogMetric, er
 
 func (c *Client) DeleteLogMetric(ctx context.Context, r *LogMetric) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogMetric{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogMetric{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -672,7 +683,13 @@ func (c *Client) DeleteLogMetric(ctx context.Context, r *LogMetric) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting LogMetric...")
 	deleteOp := deleteLogMetricOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteLogMetricAsync(ctx context.Context, r *LogMetric) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteLogMetric(ctx, r)
+	})
 }
 
 // DeleteAllLogMetric deletes all resources that the filter functions returns true on.
@@ -700,10 +717,11 @@ func (c *Client) DeleteAllLogMetric(ctx context.Context, project string, filter
 }
 
 func (c *Client) ApplyLogMetric(ctx context.Context, rawDesired *LogMetric, opts ...dcl.ApplyOption) (*LogMetric, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogMetric{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogMetric{}).Describe())
 	var resultNewState *LogMetric
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyLogMetricHelper(c, ctx, rawDesired, opts...)
@@ -721,6 +739,38 @@ func (c *Client) ApplyLogMetric(ctx context.Context, rawDesired *LogMetric, opts
 	return resultNewState, err
 }
 
+func (c *Client) ApplyLogMetricAsync(ctx context.Context, rawDesired *LogMetric, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyLogMetric(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffLogMetric returns the field-level differences between rawDesired and the
+// live LogMetric without modifying it. If the LogMetric does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffLogMetric(ctx context.Context, rawDesired *LogMetric, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogMetric{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogMetric{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractLogMetricFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.logMetricDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("LogMetric %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyLogMetricHelper(c *Client, ctx context.Context, rawDesired *LogMetric, opts ...dcl.ApplyOption) (*LogMetric, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyLogMetric...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -729,6 +779,9 @@ func applyLogMetricHelper(c *Client, ctx context.Context, rawDesired *LogMetric,
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractLogMetricFields(rawDesired); err != nil {
 		return nil, err
@@ -787,7 +840,7 @@ func applyLogMetricHelper(c *Client, ctx context.Context, rawDesired *LogMetric,
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
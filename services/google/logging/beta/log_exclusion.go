@@ -79,8 +79,12 @@ func (l *LogExclusionList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *LogExclusionList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *LogExclusionList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogExclusion{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -97,7 +101,8 @@ func (l *LogExclusionList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListLogExclusion(ctx context.Context, parent string) (*LogExclusionList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogExclusion{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogExclusion{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListLogExclusionWithMaxResults(ctx, parent, LogExclusionMaxPage)
@@ -105,14 +110,18 @@ func (c *Client) ListLogExclusion(ctx context.Context, parent string) (*LogExclu
 }
 
 func (c *Client) ListLogExclusionWithMaxResults(ctx context.Context, parent string, pageSize int32) (*LogExclusionList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListLogExclusionWithPageToken(ctx, parent, pageSize, "")
+}
+
+func (c *Client) ListLogExclusionWithPageToken(ctx context.Context, parent string, pageSize int32, pageToken string) (*LogExclusionList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogExclusion{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &LogExclusion{
 		Parent: &parent,
 	}
-	items, token, err := c.listLogExclusion(ctx, r, "", pageSize)
+	items, token, err := c.listLogExclusion(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +135,8 @@ func (c *Client) ListLogExclusionWithMaxResults(ctx context.Context, parent stri
 
 func (c *Client) GetLogExclusion(ctx context.Context, r *LogExclusion) (*LogExclusion, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogExclusion{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogExclusion{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -142,7 +152,7 @@ func (c *Client) GetLogExclusion(ctx context.Context, r *LogExclusion) (*LogExcl
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalLogExclusion(b, c, r)
 	if err != nil {
@@ -167,7 +177,8 @@ func (c *Client) GetLogExclusion(ctx context.Context, r *LogExclusion) (*LogExcl
 
 func (c *Client) DeleteLogExclusion(ctx context.Context, r *LogExclusion) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogExclusion{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogExclusion{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -175,7 +186,13 @@ func (c *Client) DeleteLogExclusion(ctx context.Context, r *LogExclusion) error
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting LogExclusion...")
 	deleteOp := deleteLogExclusionOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteLogExclusionAsync(ctx context.Context, r *LogExclusion) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteLogExclusion(ctx, r)
+	})
 }
 
 // DeleteAllLogExclusion deletes all resources that the filter functions returns true on.
@@ -203,10 +220,11 @@ func (c *Client) DeleteAllLogExclusion(ctx context.Context, parent string, filte
 }
 
 func (c *Client) ApplyLogExclusion(ctx context.Context, rawDesired *LogExclusion, opts ...dcl.ApplyOption) (*LogExclusion, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogExclusion{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogExclusion{}).Describe())
 	var resultNewState *LogExclusion
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyLogExclusionHelper(c, ctx, rawDesired, opts...)
@@ -224,6 +242,38 @@ func (c *Client) ApplyLogExclusion(ctx context.Context, rawDesired *LogExclusion
 	return resultNewState, err
 }
 
+func (c *Client) ApplyLogExclusionAsync(ctx context.Context, rawDesired *LogExclusion, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyLogExclusion(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffLogExclusion returns the field-level differences between rawDesired and the
+// live LogExclusion without modifying it. If the LogExclusion does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffLogExclusion(ctx context.Context, rawDesired *LogExclusion, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogExclusion{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogExclusion{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractLogExclusionFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.logExclusionDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("LogExclusion %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyLogExclusionHelper(c *Client, ctx context.Context, rawDesired *LogExclusion, opts ...dcl.ApplyOption) (*LogExclusion, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyLogExclusion...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -232,6 +282,9 @@ func applyLogExclusionHelper(c *Client, ctx context.Context, rawDesired *LogExcl
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractLogExclusionFields(rawDesired); err != nil {
 		return nil, err
@@ -290,7 +343,7 @@ func applyLogExclusionHelper(c *Client, ctx context.Context, rawDesired *LogExcl
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
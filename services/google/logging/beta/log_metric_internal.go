@@ -418,7 +418,7 @@ func (c *Client) logMetricDiffsForRawDesired(ctx context.Context, rawDesired *Lo
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a LogMetric resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve LogMetric resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve LogMetric resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that LogMetric resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -432,6 +432,12 @@ func (c *Client) logMetricDiffsForRawDesired(ctx context.Context, rawDesired *Lo
 	if err := extractLogMetricFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeLogMetricInitialState(rawInitial, rawDesired)
@@ -449,7 +455,7 @@ func (c *Client) logMetricDiffsForRawDesired(ctx context.Context, rawDesired *Lo
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffLogMetric(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeLogMetricInitialState(rawInitial, rawDesired *LogMetric) (*LogMetric, error) {
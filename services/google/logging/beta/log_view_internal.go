@@ -358,7 +358,7 @@ func (c *Client) logViewDiffsForRawDesired(ctx context.Context, rawDesired *LogV
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a LogView resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve LogView resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve LogView resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that LogView resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -372,6 +372,12 @@ func (c *Client) logViewDiffsForRawDesired(ctx context.Context, rawDesired *LogV
 	if err := extractLogViewFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeLogViewInitialState(rawInitial, rawDesired)
@@ -389,7 +395,7 @@ func (c *Client) logViewDiffsForRawDesired(ctx context.Context, rawDesired *LogV
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffLogView(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeLogViewInitialState(rawInitial, rawDesired *LogView) (*LogView, error) {
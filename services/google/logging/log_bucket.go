@@ -110,8 +110,12 @@ func (l *LogBucketList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *LogBucketList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *LogBucketList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogBucket{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -128,7 +132,8 @@ func (l *LogBucketList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListLogBucket(ctx context.Context, location, parent string) (*LogBucketList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogBucket{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogBucket{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListLogBucketWithMaxResults(ctx, location, parent, LogBucketMaxPage)
@@ -136,7 +141,11 @@ func (c *Client) ListLogBucket(ctx context.Context, location, parent string) (*L
 }
 
 func (c *Client) ListLogBucketWithMaxResults(ctx context.Context, location, parent string, pageSize int32) (*LogBucketList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListLogBucketWithPageToken(ctx, location, parent, pageSize, "")
+}
+
+func (c *Client) ListLogBucketWithPageToken(ctx context.Context, location, parent string, pageSize int32, pageToken string) (*LogBucketList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogBucket{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -144,7 +153,7 @@ func (c *Client) ListLogBucketWithMaxResults(ctx context.Context, location, pare
 		Location: &location,
 		Parent:   &parent,
 	}
-	items, token, err := c.listLogBucket(ctx, r, "", pageSize)
+	items, token, err := c.listLogBucket(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +167,8 @@ func (c *Client) ListLogBucketWithMaxResults(ctx context.Context, location, pare
 
 func (c *Client) GetLogBucket(ctx context.Context, r *LogBucket) (*LogBucket, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogBucket{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogBucket{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -174,7 +184,7 @@ func (c *Client) GetLogBucket(ctx context.Context, r *LogBucket) (*LogBucket, er
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalLogBucket(b, c, r)
 	if err != nil {
@@ -200,7 +210,8 @@ func (c *Client) GetLogBucket(ctx context.Context, r *LogBucket) (*LogBucket, er
 
 func (c *Client) DeleteLogBucket(ctx context.Context, r *LogBucket) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogBucket{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogBucket{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -208,7 +219,13 @@ func (c *Client) DeleteLogBucket(ctx context.Context, r *LogBucket) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting LogBucket...")
 	deleteOp := deleteLogBucketOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteLogBucketAsync(ctx context.Context, r *LogBucket) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteLogBucket(ctx, r)
+	})
 }
 
 // DeleteAllLogBucket deletes all resources that the filter functions returns true on.
@@ -236,10 +253,11 @@ func (c *Client) DeleteAllLogBucket(ctx context.Context, location, parent string
 }
 
 func (c *Client) ApplyLogBucket(ctx context.Context, rawDesired *LogBucket, opts ...dcl.ApplyOption) (*LogBucket, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogBucket{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogBucket{}).Describe())
 	var resultNewState *LogBucket
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyLogBucketHelper(c, ctx, rawDesired, opts...)
@@ -257,6 +275,38 @@ func (c *Client) ApplyLogBucket(ctx context.Context, rawDesired *LogBucket, opts
 	return resultNewState, err
 }
 
+func (c *Client) ApplyLogBucketAsync(ctx context.Context, rawDesired *LogBucket, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyLogBucket(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffLogBucket returns the field-level differences between rawDesired and the
+// live LogBucket without modifying it. If the LogBucket does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffLogBucket(ctx context.Context, rawDesired *LogBucket, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogBucket{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogBucket{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractLogBucketFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.logBucketDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("LogBucket %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyLogBucketHelper(c *Client, ctx context.Context, rawDesired *LogBucket, opts ...dcl.ApplyOption) (*LogBucket, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyLogBucket...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -265,6 +315,9 @@ func applyLogBucketHelper(c *Client, ctx context.Context, rawDesired *LogBucket,
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractLogBucketFields(rawDesired); err != nil {
 		return nil, err
@@ -323,7 +376,7 @@ func applyLogBucketHelper(c *Client, ctx context.Context, rawDesired *LogBucket,
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -319,7 +319,7 @@ func (c *Client) logBucketDiffsForRawDesired(ctx context.Context, rawDesired *Lo
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a LogBucket resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve LogBucket resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve LogBucket resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that LogBucket resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -333,6 +333,12 @@ func (c *Client) logBucketDiffsForRawDesired(ctx context.Context, rawDesired *Lo
 	if err := extractLogBucketFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeLogBucketInitialState(rawInitial, rawDesired)
@@ -350,7 +356,7 @@ func (c *Client) logBucketDiffsForRawDesired(ctx context.Context, rawDesired *Lo
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffLogBucket(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeLogBucketInitialState(rawInitial, rawDesired *LogBucket) (*LogBucket, error) {
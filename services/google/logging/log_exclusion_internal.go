@@ -350,7 +350,7 @@ func (c *Client) logExclusionDiffsForRawDesired(ctx context.Context, rawDesired
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a LogExclusion resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve LogExclusion resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve LogExclusion resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that LogExclusion resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -364,6 +364,12 @@ func (c *Client) logExclusionDiffsForRawDesired(ctx context.Context, rawDesired
 	if err := extractLogExclusionFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeLogExclusionInitialState(rawInitial, rawDesired)
@@ -381,7 +387,7 @@ func (c *Client) logExclusionDiffsForRawDesired(ctx context.Context, rawDesired
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffLogExclusion(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeLogExclusionInitialState(rawInitial, rawDesired *LogExclusion) (*LogExclusion, error) {
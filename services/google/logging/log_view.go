@@ -81,8 +81,12 @@ func (l *LogViewList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *LogViewList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *LogViewList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogView{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -99,7 +103,8 @@ func (l *LogViewList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListLogView(ctx context.Context, location, bucket, parent string) (*LogViewList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogView{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogView{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListLogViewWithMaxResults(ctx, location, bucket, parent, LogViewMaxPage)
@@ -107,7 +112,11 @@ func (c *Client) ListLogView(ctx context.Context, location, bucket, parent strin
 }
 
 func (c *Client) ListLogViewWithMaxResults(ctx context.Context, location, bucket, parent string, pageSize int32) (*LogViewList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListLogViewWithPageToken(ctx, location, bucket, parent, pageSize, "")
+}
+
+func (c *Client) ListLogViewWithPageToken(ctx context.Context, location, bucket, parent string, pageSize int32, pageToken string) (*LogViewList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogView{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -116,7 +125,7 @@ func (c *Client) ListLogViewWithMaxResults(ctx context.Context, location, bucket
 		Bucket:   &bucket,
 		Parent:   &parent,
 	}
-	items, token, err := c.listLogView(ctx, r, "", pageSize)
+	items, token, err := c.listLogView(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +139,8 @@ func (c *Client) ListLogViewWithMaxResults(ctx context.Context, location, bucket
 
 func (c *Client) GetLogView(ctx context.Context, r *LogView) (*LogView, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogView{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogView{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -146,7 +156,7 @@ func (c *Client) GetLogView(ctx context.Context, r *LogView) (*LogView, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalLogView(b, c, r)
 	if err != nil {
@@ -173,7 +183,8 @@ func (c *Client) GetLogView(ctx context.Context, r *LogView) (*LogView, error) {
 
 func (c *Client) DeleteLogView(ctx context.Context, r *LogView) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogView{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogView{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -181,7 +192,13 @@ func (c *Client) DeleteLogView(ctx context.Context, r *LogView) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting LogView...")
 	deleteOp := deleteLogViewOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteLogViewAsync(ctx context.Context, r *LogView) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteLogView(ctx, r)
+	})
 }
 
 // DeleteAllLogView deletes all resources that the filter functions returns true on.
@@ -209,10 +226,11 @@ func (c *Client) DeleteAllLogView(ctx context.Context, location, bucket, parent
 }
 
 func (c *Client) ApplyLogView(ctx context.Context, rawDesired *LogView, opts ...dcl.ApplyOption) (*LogView, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogView{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogView{}).Describe())
 	var resultNewState *LogView
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyLogViewHelper(c, ctx, rawDesired, opts...)
@@ -230,6 +248,38 @@ func (c *Client) ApplyLogView(ctx context.Context, rawDesired *LogView, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyLogViewAsync(ctx context.Context, rawDesired *LogView, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyLogView(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffLogView returns the field-level differences between rawDesired and the
+// live LogView without modifying it. If the LogView does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffLogView(ctx context.Context, rawDesired *LogView, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&LogView{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&LogView{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractLogViewFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.logViewDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("LogView %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyLogViewHelper(c *Client, ctx context.Context, rawDesired *LogView, opts ...dcl.ApplyOption) (*LogView, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyLogView...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -238,6 +288,9 @@ func applyLogViewHelper(c *Client, ctx context.Context, rawDesired *LogView, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractLogViewFields(rawDesired); err != nil {
 		return nil, err
@@ -296,7 +349,7 @@ func applyLogViewHelper(c *Client, ctx context.Context, rawDesired *LogView, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -102,7 +102,7 @@ func (c *Client) metricsScopeDiffsForRawDesired(ctx context.Context, rawDesired
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a MetricsScope resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve MetricsScope resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve MetricsScope resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that MetricsScope resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -116,6 +116,12 @@ func (c *Client) metricsScopeDiffsForRawDesired(ctx context.Context, rawDesired
 	if err := extractMetricsScopeFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeMetricsScopeInitialState(rawInitial, rawDesired)
@@ -133,7 +139,7 @@ func (c *Client) metricsScopeDiffsForRawDesired(ctx context.Context, rawDesired
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffMetricsScope(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeMetricsScopeInitialState(rawInitial, rawDesired *MetricsScope) (*MetricsScope, error) {
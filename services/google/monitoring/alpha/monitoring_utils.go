@@ -71,7 +71,7 @@ func canonicalizeMetricDescriptorLabelsValueType(m, n interface{}) bool {
 
 // GetMonitoredProject is a custom method because projects are returned as project numbers instead of project ids.
 func (c *Client) GetMonitoredProject(ctx context.Context, r *MonitoredProject) (*MonitoredProject, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MonitoredProject{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	b, err := c.getMonitoredProjectRaw(ctx, r)
@@ -82,7 +82,7 @@ func (c *Client) GetMonitoredProject(ctx context.Context, r *MonitoredProject) (
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalMonitoredProject(b, c, r)
 	if err != nil {
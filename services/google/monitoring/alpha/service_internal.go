@@ -342,6 +342,14 @@ func (c *Client) getServiceRaw(ctx context.Context, r *Service) ([]byte, error)
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose user labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current user labels, which are nil if the resource does not exist.
+func (r *Service) withDefaultLabels(c *dcl.Config, current map[string]string) *Service {
+	nr := *r
+	nr.UserLabels = dcl.MergeDefaultLabels(c, r.UserLabels, current)
+	return &nr
+}
+
 func (c *Client) serviceDiffsForRawDesired(ctx context.Context, rawDesired *Service, opts ...dcl.ApplyOption) (initial, desired *Service, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -359,10 +367,16 @@ func (c *Client) serviceDiffsForRawDesired(ctx context.Context, rawDesired *Serv
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetService(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.UserLabels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Service resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Service resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Service resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Service resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -376,6 +390,12 @@ func (c *Client) serviceDiffsForRawDesired(ctx context.Context, rawDesired *Serv
 	if err := extractServiceFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeServiceInitialState(rawInitial, rawDesired)
@@ -393,7 +413,7 @@ func (c *Client) serviceDiffsForRawDesired(ctx context.Context, rawDesired *Serv
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffService(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeServiceInitialState(rawInitial, rawDesired *Service) (*Service, error) {
@@ -755,7 +775,7 @@ func diffService(c *Client, desired, actual *Service, opts ...dcl.ApplyOption) (
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.UserLabels, actual.UserLabels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateServiceUpdateServiceOperation")}, fn.AddNest("UserLabels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.UserLabels, actual.UserLabels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateServiceUpdateServiceOperation")}, fn.AddNest("UserLabels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -272,7 +272,7 @@ func (c *Client) monitoredProjectDiffsForRawDesired(ctx context.Context, rawDesi
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a MonitoredProject resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve MonitoredProject resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve MonitoredProject resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that MonitoredProject resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -286,6 +286,12 @@ func (c *Client) monitoredProjectDiffsForRawDesired(ctx context.Context, rawDesi
 	if err := extractMonitoredProjectFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeMonitoredProjectInitialState(rawInitial, rawDesired)
@@ -303,7 +309,7 @@ func (c *Client) monitoredProjectDiffsForRawDesired(ctx context.Context, rawDesi
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffMonitoredProject(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeMonitoredProjectInitialState(rawInitial, rawDesired *MonitoredProject) (*MonitoredProject, error) {
@@ -1478,8 +1478,12 @@ func (l *ServiceLevelObjectiveList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ServiceLevelObjectiveList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ServiceLevelObjectiveList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceLevelObjective{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -1496,7 +1500,8 @@ func (l *ServiceLevelObjectiveList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListServiceLevelObjective(ctx context.Context, project, service string) (*ServiceLevelObjectiveList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceLevelObjective{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceLevelObjective{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListServiceLevelObjectiveWithMaxResults(ctx, project, service, ServiceLevelObjectiveMaxPage)
@@ -1504,7 +1509,11 @@ func (c *Client) ListServiceLevelObjective(ctx context.Context, project, service
 }
 
 func (c *Client) ListServiceLevelObjectiveWithMaxResults(ctx context.Context, project, service string, pageSize int32) (*ServiceLevelObjectiveList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListServiceLevelObjectiveWithPageToken(ctx, project, service, pageSize, "")
+}
+
+func (c *Client) ListServiceLevelObjectiveWithPageToken(ctx context.Context, project, service string, pageSize int32, pageToken string) (*ServiceLevelObjectiveList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceLevelObjective{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -1512,7 +1521,7 @@ func (c *Client) ListServiceLevelObjectiveWithMaxResults(ctx context.Context, pr
 		Project: &project,
 		Service: &service,
 	}
-	items, token, err := c.listServiceLevelObjective(ctx, r, "", pageSize)
+	items, token, err := c.listServiceLevelObjective(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -1526,7 +1535,8 @@ func (c *Client) ListServiceLevelObjectiveWithMaxResults(ctx context.Context, pr
 
 func (c *Client) GetServiceLevelObjective(ctx context.Context, r *ServiceLevelObjective) (*ServiceLevelObjective, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceLevelObjective{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceLevelObjective{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -1542,7 +1552,7 @@ func (c *Client) GetServiceLevelObjective(ctx context.Context, r *ServiceLevelOb
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalServiceLevelObjective(b, c, r)
 	if err != nil {
@@ -1568,7 +1578,8 @@ func (c *Client) GetServiceLevelObjective(ctx context.Context, r *ServiceLevelOb
 
 func (c *Client) DeleteServiceLevelObjective(ctx context.Context, r *ServiceLevelObjective) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceLevelObjective{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceLevelObjective{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -1576,7 +1587,13 @@ func (c *Client) DeleteServiceLevelObjective(ctx context.Context, r *ServiceLeve
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting ServiceLevelObjective...")
 	deleteOp := deleteServiceLevelObjectiveOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteServiceLevelObjectiveAsync(ctx context.Context, r *ServiceLevelObjective) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteServiceLevelObjective(ctx, r)
+	})
 }
 
 // DeleteAllServiceLevelObjective deletes all resources that the filter functions returns true on.
@@ -1604,10 +1621,11 @@ func (c *Client) DeleteAllServiceLevelObjective(ctx context.Context, project, se
 }
 
 func (c *Client) ApplyServiceLevelObjective(ctx context.Context, rawDesired *ServiceLevelObjective, opts ...dcl.ApplyOption) (*ServiceLevelObjective, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceLevelObjective{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceLevelObjective{}).Describe())
 	var resultNewState *ServiceLevelObjective
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyServiceLevelObjectiveHelper(c, ctx, rawDesired, opts...)
@@ -1625,6 +1643,38 @@ func (c *Client) ApplyServiceLevelObjective(ctx context.Context, rawDesired *Ser
 	return resultNewState, err
 }
 
+func (c *Client) ApplyServiceLevelObjectiveAsync(ctx context.Context, rawDesired *ServiceLevelObjective, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyServiceLevelObjective(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffServiceLevelObjective returns the field-level differences between rawDesired and the
+// live ServiceLevelObjective without modifying it. If the ServiceLevelObjective does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffServiceLevelObjective(ctx context.Context, rawDesired *ServiceLevelObjective, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceLevelObjective{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceLevelObjective{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractServiceLevelObjectiveFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.serviceLevelObjectiveDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("ServiceLevelObjective %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyServiceLevelObjectiveHelper(c *Client, ctx context.Context, rawDesired *ServiceLevelObjective, opts ...dcl.ApplyOption) (*ServiceLevelObjective, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyServiceLevelObjective...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -1633,6 +1683,9 @@ func applyServiceLevelObjectiveHelper(c *Client, ctx context.Context, rawDesired
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractServiceLevelObjectiveFields(rawDesired); err != nil {
 		return nil, err
@@ -1691,7 +1744,7 @@ func applyServiceLevelObjectiveHelper(c *Client, ctx context.Context, rawDesired
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -1705,6 +1758,8 @@ func applyServiceLevelObjectiveDiff(c *Client, ctx context.Context, desired *Ser
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.UserLabels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -512,8 +512,12 @@ func (l *UptimeCheckConfigList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *UptimeCheckConfigList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *UptimeCheckConfigList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&UptimeCheckConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -530,7 +534,8 @@ func (l *UptimeCheckConfigList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListUptimeCheckConfig(ctx context.Context, project string) (*UptimeCheckConfigList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&UptimeCheckConfig{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&UptimeCheckConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListUptimeCheckConfigWithMaxResults(ctx, project, UptimeCheckConfigMaxPage)
@@ -538,14 +543,18 @@ func (c *Client) ListUptimeCheckConfig(ctx context.Context, project string) (*Up
 }
 
 func (c *Client) ListUptimeCheckConfigWithMaxResults(ctx context.Context, project string, pageSize int32) (*UptimeCheckConfigList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListUptimeCheckConfigWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListUptimeCheckConfigWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*UptimeCheckConfigList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&UptimeCheckConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &UptimeCheckConfig{
 		Project: &project,
 	}
-	items, token, err := c.listUptimeCheckConfig(ctx, r, "", pageSize)
+	items, token, err := c.listUptimeCheckConfig(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -559,7 +568,8 @@ func (c *Client) ListUptimeCheckConfigWithMaxResults(ctx context.Context, projec
 
 func (c *Client) GetUptimeCheckConfig(ctx context.Context, r *UptimeCheckConfig) (*UptimeCheckConfig, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&UptimeCheckConfig{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&UptimeCheckConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -575,7 +585,7 @@ func (c *Client) GetUptimeCheckConfig(ctx context.Context, r *UptimeCheckConfig)
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalUptimeCheckConfig(b, c, r)
 	if err != nil {
@@ -603,7 +613,8 @@ func (c *Client) GetUptimeCheckConfig(ctx context.Context, r *UptimeCheckConfig)
 
 func (c *Client) DeleteUptimeCheckConfig(ctx context.Context, r *UptimeCheckConfig) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&UptimeCheckConfig{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&UptimeCheckConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -611,7 +622,13 @@ func (c *Client) DeleteUptimeCheckConfig(ctx context.Context, r *UptimeCheckConf
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting UptimeCheckConfig...")
 	deleteOp := deleteUptimeCheckConfigOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteUptimeCheckConfigAsync(ctx context.Context, r *UptimeCheckConfig) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteUptimeCheckConfig(ctx, r)
+	})
 }
 
 // DeleteAllUptimeCheckConfig deletes all resources that the filter functions returns true on.
@@ -639,10 +656,11 @@ func (c *Client) DeleteAllUptimeCheckConfig(ctx context.Context, project string,
 }
 
 func (c *Client) ApplyUptimeCheckConfig(ctx context.Context, rawDesired *UptimeCheckConfig, opts ...dcl.ApplyOption) (*UptimeCheckConfig, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&UptimeCheckConfig{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&UptimeCheckConfig{}).Describe())
 	var resultNewState *UptimeCheckConfig
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyUptimeCheckConfigHelper(c, ctx, rawDesired, opts...)
@@ -660,6 +678,38 @@ func (c *Client) ApplyUptimeCheckConfig(ctx context.Context, rawDesired *UptimeC
 	return resultNewState, err
 }
 
+func (c *Client) ApplyUptimeCheckConfigAsync(ctx context.Context, rawDesired *UptimeCheckConfig, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyUptimeCheckConfig(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffUptimeCheckConfig returns the field-level differences between rawDesired and the
+// live UptimeCheckConfig without modifying it. If the UptimeCheckConfig does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffUptimeCheckConfig(ctx context.Context, rawDesired *UptimeCheckConfig, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&UptimeCheckConfig{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&UptimeCheckConfig{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractUptimeCheckConfigFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.uptimeCheckConfigDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("UptimeCheckConfig %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyUptimeCheckConfigHelper(c *Client, ctx context.Context, rawDesired *UptimeCheckConfig, opts ...dcl.ApplyOption) (*UptimeCheckConfig, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyUptimeCheckConfig...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -668,6 +718,9 @@ func applyUptimeCheckConfigHelper(c *Client, ctx context.Context, rawDesired *Up
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractUptimeCheckConfigFields(rawDesired); err != nil {
 		return nil, err
@@ -726,7 +779,7 @@ func applyUptimeCheckConfigHelper(c *Client, ctx context.Context, rawDesired *Up
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
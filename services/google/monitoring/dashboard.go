@@ -12569,8 +12569,12 @@ func (l *DashboardList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *DashboardList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *DashboardList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dashboard{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -12587,7 +12591,8 @@ func (l *DashboardList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListDashboard(ctx context.Context, project string) (*DashboardList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Dashboard{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dashboard{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListDashboardWithMaxResults(ctx, project, DashboardMaxPage)
@@ -12595,14 +12600,18 @@ func (c *Client) ListDashboard(ctx context.Context, project string) (*DashboardL
 }
 
 func (c *Client) ListDashboardWithMaxResults(ctx context.Context, project string, pageSize int32) (*DashboardList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListDashboardWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListDashboardWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*DashboardList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dashboard{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Dashboard{
 		Project: &project,
 	}
-	items, token, err := c.listDashboard(ctx, r, "", pageSize)
+	items, token, err := c.listDashboard(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -12616,7 +12625,8 @@ func (c *Client) ListDashboardWithMaxResults(ctx context.Context, project string
 
 func (c *Client) GetDashboard(ctx context.Context, r *Dashboard) (*Dashboard, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Dashboard{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dashboard{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -12632,7 +12642,7 @@ func (c *Client) GetDashboard(ctx context.Context, r *Dashboard) (*Dashboard, er
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalDashboard(b, c, r)
 	if err != nil {
@@ -12657,7 +12667,8 @@ func (c *Client) GetDashboard(ctx context.Context, r *Dashboard) (*Dashboard, er
 
 func (c *Client) DeleteDashboard(ctx context.Context, r *Dashboard) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Dashboard{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dashboard{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -12665,7 +12676,13 @@ func (c *Client) DeleteDashboard(ctx context.Context, r *Dashboard) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Dashboard...")
 	deleteOp := deleteDashboardOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteDashboardAsync(ctx context.Context, r *Dashboard) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteDashboard(ctx, r)
+	})
 }
 
 // DeleteAllDashboard deletes all resources that the filter functions returns true on.
@@ -12693,10 +12710,11 @@ func (c *Client) DeleteAllDashboard(ctx context.Context, project string, filter
 }
 
 func (c *Client) ApplyDashboard(ctx context.Context, rawDesired *Dashboard, opts ...dcl.ApplyOption) (*Dashboard, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dashboard{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Dashboard{}).Describe())
 	var resultNewState *Dashboard
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyDashboardHelper(c, ctx, rawDesired, opts...)
@@ -12714,6 +12732,38 @@ func (c *Client) ApplyDashboard(ctx context.Context, rawDesired *Dashboard, opts
 	return resultNewState, err
 }
 
+func (c *Client) ApplyDashboardAsync(ctx context.Context, rawDesired *Dashboard, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyDashboard(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffDashboard returns the field-level differences between rawDesired and the
+// live Dashboard without modifying it. If the Dashboard does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffDashboard(ctx context.Context, rawDesired *Dashboard, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Dashboard{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Dashboard{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractDashboardFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.dashboardDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Dashboard %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyDashboardHelper(c *Client, ctx context.Context, rawDesired *Dashboard, opts ...dcl.ApplyOption) (*Dashboard, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyDashboard...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -12722,6 +12772,9 @@ func applyDashboardHelper(c *Client, ctx context.Context, rawDesired *Dashboard,
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractDashboardFields(rawDesired); err != nil {
 		return nil, err
@@ -12780,7 +12833,7 @@ func applyDashboardHelper(c *Client, ctx context.Context, rawDesired *Dashboard,
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
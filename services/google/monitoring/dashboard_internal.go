@@ -1709,7 +1709,7 @@ func (c *Client) dashboardDiffsForRawDesired(ctx context.Context, rawDesired *Da
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Dashboard resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Dashboard resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Dashboard resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Dashboard resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -1723,6 +1723,12 @@ func (c *Client) dashboardDiffsForRawDesired(ctx context.Context, rawDesired *Da
 	if err := extractDashboardFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeDashboardInitialState(rawInitial, rawDesired)
@@ -1740,7 +1746,7 @@ func (c *Client) dashboardDiffsForRawDesired(ctx context.Context, rawDesired *Da
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffDashboard(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeDashboardInitialState(rawInitial, rawDesired *Dashboard) (*Dashboard, error) {
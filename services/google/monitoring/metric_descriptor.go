@@ -330,8 +330,12 @@ func (l *MetricDescriptorList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *MetricDescriptorList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *MetricDescriptorList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MetricDescriptor{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -348,7 +352,8 @@ func (l *MetricDescriptorList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListMetricDescriptor(ctx context.Context, project string) (*MetricDescriptorList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&MetricDescriptor{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MetricDescriptor{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListMetricDescriptorWithMaxResults(ctx, project, MetricDescriptorMaxPage)
@@ -356,14 +361,18 @@ func (c *Client) ListMetricDescriptor(ctx context.Context, project string) (*Met
 }
 
 func (c *Client) ListMetricDescriptorWithMaxResults(ctx context.Context, project string, pageSize int32) (*MetricDescriptorList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListMetricDescriptorWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListMetricDescriptorWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*MetricDescriptorList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MetricDescriptor{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &MetricDescriptor{
 		Project: &project,
 	}
-	items, token, err := c.listMetricDescriptor(ctx, r, "", pageSize)
+	items, token, err := c.listMetricDescriptor(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -377,7 +386,8 @@ func (c *Client) ListMetricDescriptorWithMaxResults(ctx context.Context, project
 
 func (c *Client) GetMetricDescriptor(ctx context.Context, r *MetricDescriptor) (*MetricDescriptor, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&MetricDescriptor{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MetricDescriptor{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -393,7 +403,7 @@ func (c *Client) GetMetricDescriptor(ctx context.Context, r *MetricDescriptor) (
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalMetricDescriptor(b, c, r)
 	if err != nil {
@@ -418,7 +428,8 @@ func (c *Client) GetMetricDescriptor(ctx context.Context, r *MetricDescriptor) (
 
 func (c *Client) DeleteMetricDescriptor(ctx context.Context, r *MetricDescriptor) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&MetricDescriptor{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MetricDescriptor{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -426,7 +437,13 @@ func (c *Client) DeleteMetricDescriptor(ctx context.Context, r *MetricDescriptor
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting MetricDescriptor...")
 	deleteOp := deleteMetricDescriptorOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteMetricDescriptorAsync(ctx context.Context, r *MetricDescriptor) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteMetricDescriptor(ctx, r)
+	})
 }
 
 // DeleteAllMetricDescriptor deletes all resources that the filter functions returns true on.
@@ -454,10 +471,11 @@ func (c *Client) DeleteAllMetricDescriptor(ctx context.Context, project string,
 }
 
 func (c *Client) ApplyMetricDescriptor(ctx context.Context, rawDesired *MetricDescriptor, opts ...dcl.ApplyOption) (*MetricDescriptor, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MetricDescriptor{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&MetricDescriptor{}).Describe())
 	var resultNewState *MetricDescriptor
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyMetricDescriptorHelper(c, ctx, rawDesired, opts...)
@@ -475,6 +493,38 @@ func (c *Client) ApplyMetricDescriptor(ctx context.Context, rawDesired *MetricDe
 	return resultNewState, err
 }
 
+func (c *Client) ApplyMetricDescriptorAsync(ctx context.Context, rawDesired *MetricDescriptor, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyMetricDescriptor(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffMetricDescriptor returns the field-level differences between rawDesired and the
+// live MetricDescriptor without modifying it. If the MetricDescriptor does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffMetricDescriptor(ctx context.Context, rawDesired *MetricDescriptor, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MetricDescriptor{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&MetricDescriptor{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractMetricDescriptorFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.metricDescriptorDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("MetricDescriptor %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyMetricDescriptorHelper(c *Client, ctx context.Context, rawDesired *MetricDescriptor, opts ...dcl.ApplyOption) (*MetricDescriptor, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyMetricDescriptor...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -483,6 +533,9 @@ func applyMetricDescriptorHelper(c *Client, ctx context.Context, rawDesired *Met
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractMetricDescriptorFields(rawDesired); err != nil {
 		return nil, err
@@ -541,7 +594,7 @@ func applyMetricDescriptorHelper(c *Client, ctx context.Context, rawDesired *Met
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -321,7 +321,7 @@ func (c *Client) metricDescriptorDiffsForRawDesired(ctx context.Context, rawDesi
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a MetricDescriptor resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve MetricDescriptor resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve MetricDescriptor resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that MetricDescriptor resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -335,6 +335,12 @@ func (c *Client) metricDescriptorDiffsForRawDesired(ctx context.Context, rawDesi
 	if err := extractMetricDescriptorFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeMetricDescriptorInitialState(rawInitial, rawDesired)
@@ -352,7 +358,7 @@ func (c *Client) metricDescriptorDiffsForRawDesired(ctx context.Context, rawDesi
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffMetricDescriptor(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeMetricDescriptorInitialState(rawInitial, rawDesired *MetricDescriptor) (*MetricDescriptor, error) {
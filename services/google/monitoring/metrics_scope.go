@@ -120,7 +120,8 @@ type MetricsScopeList struct {
 
 func (c *Client) GetMetricsScope(ctx context.Context, r *MetricsScope) (*MetricsScope, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&MetricsScope{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MetricsScope{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -136,7 +137,7 @@ func (c *Client) GetMetricsScope(ctx context.Context, r *MetricsScope) (*Metrics
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalMetricsScope(b, c, r)
 	if err != nil {
@@ -159,10 +160,11 @@ func (c *Client) GetMetricsScope(ctx context.Context, r *MetricsScope) (*Metrics
 }
 
 func (c *Client) ApplyMetricsScope(ctx context.Context, rawDesired *MetricsScope, opts ...dcl.ApplyOption) (*MetricsScope, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MetricsScope{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&MetricsScope{}).Describe())
 	var resultNewState *MetricsScope
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyMetricsScopeHelper(c, ctx, rawDesired, opts...)
@@ -180,6 +182,38 @@ func (c *Client) ApplyMetricsScope(ctx context.Context, rawDesired *MetricsScope
 	return resultNewState, err
 }
 
+func (c *Client) ApplyMetricsScopeAsync(ctx context.Context, rawDesired *MetricsScope, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyMetricsScope(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffMetricsScope returns the field-level differences between rawDesired and the
+// live MetricsScope without modifying it. If the MetricsScope does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffMetricsScope(ctx context.Context, rawDesired *MetricsScope, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MetricsScope{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&MetricsScope{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractMetricsScopeFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.metricsScopeDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("MetricsScope %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyMetricsScopeHelper(c *Client, ctx context.Context, rawDesired *MetricsScope, opts ...dcl.ApplyOption) (*MetricsScope, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyMetricsScope...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -188,6 +222,9 @@ func applyMetricsScopeHelper(c *Client, ctx context.Context, rawDesired *Metrics
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractMetricsScopeFields(rawDesired); err != nil {
 		return nil, err
@@ -232,7 +269,7 @@ func applyMetricsScopeHelper(c *Client, ctx context.Context, rawDesired *Metrics
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.PhaseUpdate, err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
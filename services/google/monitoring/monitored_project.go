@@ -70,8 +70,12 @@ func (l *MonitoredProjectList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *MonitoredProjectList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *MonitoredProjectList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MonitoredProject{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -88,6 +92,7 @@ func (l *MonitoredProjectList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListMonitoredProject(ctx context.Context, metricsScope string) (*MonitoredProjectList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&MonitoredProject{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -95,7 +100,7 @@ func (c *Client) ListMonitoredProject(ctx context.Context, metricsScope string)
 			Timeout:   120000000000,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MonitoredProject{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListMonitoredProjectWithMaxResults(ctx, metricsScope, MonitoredProjectMaxPage)
@@ -103,14 +108,18 @@ func (c *Client) ListMonitoredProject(ctx context.Context, metricsScope string)
 }
 
 func (c *Client) ListMonitoredProjectWithMaxResults(ctx context.Context, metricsScope string, pageSize int32) (*MonitoredProjectList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListMonitoredProjectWithPageToken(ctx, metricsScope, pageSize, "")
+}
+
+func (c *Client) ListMonitoredProjectWithPageToken(ctx context.Context, metricsScope string, pageSize int32, pageToken string) (*MonitoredProjectList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MonitoredProject{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &MonitoredProject{
 		MetricsScope: &metricsScope,
 	}
-	items, token, err := c.listMonitoredProject(ctx, r, "", pageSize)
+	items, token, err := c.listMonitoredProject(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +133,7 @@ func (c *Client) ListMonitoredProjectWithMaxResults(ctx context.Context, metrics
 
 func (c *Client) DeleteMonitoredProject(ctx context.Context, r *MonitoredProject) error {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&MonitoredProject{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -131,7 +141,7 @@ func (c *Client) DeleteMonitoredProject(ctx context.Context, r *MonitoredProject
 			Timeout:   120000000000,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MonitoredProject{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -139,7 +149,13 @@ func (c *Client) DeleteMonitoredProject(ctx context.Context, r *MonitoredProject
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting MonitoredProject...")
 	deleteOp := deleteMonitoredProjectOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteMonitoredProjectAsync(ctx context.Context, r *MonitoredProject) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteMonitoredProject(ctx, r)
+	})
 }
 
 // DeleteAllMonitoredProject deletes all resources that the filter functions returns true on.
@@ -167,10 +183,11 @@ func (c *Client) DeleteAllMonitoredProject(ctx context.Context, metricsScope str
 }
 
 func (c *Client) ApplyMonitoredProject(ctx context.Context, rawDesired *MonitoredProject, opts ...dcl.ApplyOption) (*MonitoredProject, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MonitoredProject{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&MonitoredProject{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -195,6 +212,38 @@ func (c *Client) ApplyMonitoredProject(ctx context.Context, rawDesired *Monitore
 	return resultNewState, err
 }
 
+func (c *Client) ApplyMonitoredProjectAsync(ctx context.Context, rawDesired *MonitoredProject, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyMonitoredProject(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffMonitoredProject returns the field-level differences between rawDesired and the
+// live MonitoredProject without modifying it. If the MonitoredProject does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffMonitoredProject(ctx context.Context, rawDesired *MonitoredProject, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&MonitoredProject{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&MonitoredProject{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractMonitoredProjectFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.monitoredProjectDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("MonitoredProject %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyMonitoredProjectHelper(c *Client, ctx context.Context, rawDesired *MonitoredProject, opts ...dcl.ApplyOption) (*MonitoredProject, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyMonitoredProject...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -203,6 +252,9 @@ func applyMonitoredProjectHelper(c *Client, ctx context.Context, rawDesired *Mon
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractMonitoredProjectFields(rawDesired); err != nil {
 		return nil, err
@@ -261,7 +313,7 @@ func applyMonitoredProjectHelper(c *Client, ctx context.Context, rawDesired *Mon
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -110,8 +110,12 @@ func (l *NotificationChannelList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *NotificationChannelList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *NotificationChannelList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NotificationChannel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -128,7 +132,8 @@ func (l *NotificationChannelList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListNotificationChannel(ctx context.Context, project string) (*NotificationChannelList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NotificationChannel{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NotificationChannel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListNotificationChannelWithMaxResults(ctx, project, NotificationChannelMaxPage)
@@ -136,14 +141,18 @@ func (c *Client) ListNotificationChannel(ctx context.Context, project string) (*
 }
 
 func (c *Client) ListNotificationChannelWithMaxResults(ctx context.Context, project string, pageSize int32) (*NotificationChannelList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListNotificationChannelWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListNotificationChannelWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*NotificationChannelList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NotificationChannel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &NotificationChannel{
 		Project: &project,
 	}
-	items, token, err := c.listNotificationChannel(ctx, r, "", pageSize)
+	items, token, err := c.listNotificationChannel(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +166,8 @@ func (c *Client) ListNotificationChannelWithMaxResults(ctx context.Context, proj
 
 func (c *Client) GetNotificationChannel(ctx context.Context, r *NotificationChannel) (*NotificationChannel, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NotificationChannel{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NotificationChannel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -173,7 +183,7 @@ func (c *Client) GetNotificationChannel(ctx context.Context, r *NotificationChan
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalNotificationChannel(b, c, r)
 	if err != nil {
@@ -201,7 +211,8 @@ func (c *Client) GetNotificationChannel(ctx context.Context, r *NotificationChan
 
 func (c *Client) DeleteNotificationChannel(ctx context.Context, r *NotificationChannel) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NotificationChannel{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NotificationChannel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -209,7 +220,13 @@ func (c *Client) DeleteNotificationChannel(ctx context.Context, r *NotificationC
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting NotificationChannel...")
 	deleteOp := deleteNotificationChannelOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteNotificationChannelAsync(ctx context.Context, r *NotificationChannel) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteNotificationChannel(ctx, r)
+	})
 }
 
 // DeleteAllNotificationChannel deletes all resources that the filter functions returns true on.
@@ -237,10 +254,11 @@ func (c *Client) DeleteAllNotificationChannel(ctx context.Context, project strin
 }
 
 func (c *Client) ApplyNotificationChannel(ctx context.Context, rawDesired *NotificationChannel, opts ...dcl.ApplyOption) (*NotificationChannel, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NotificationChannel{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NotificationChannel{}).Describe())
 	var resultNewState *NotificationChannel
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyNotificationChannelHelper(c, ctx, rawDesired, opts...)
@@ -258,6 +276,38 @@ func (c *Client) ApplyNotificationChannel(ctx context.Context, rawDesired *Notif
 	return resultNewState, err
 }
 
+func (c *Client) ApplyNotificationChannelAsync(ctx context.Context, rawDesired *NotificationChannel, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyNotificationChannel(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffNotificationChannel returns the field-level differences between rawDesired and the
+// live NotificationChannel without modifying it. If the NotificationChannel does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffNotificationChannel(ctx context.Context, rawDesired *NotificationChannel, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&NotificationChannel{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&NotificationChannel{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractNotificationChannelFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.notificationChannelDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("NotificationChannel %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyNotificationChannelHelper(c *Client, ctx context.Context, rawDesired *NotificationChannel, opts ...dcl.ApplyOption) (*NotificationChannel, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyNotificationChannel...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -266,6 +316,9 @@ func applyNotificationChannelHelper(c *Client, ctx context.Context, rawDesired *
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractNotificationChannelFields(rawDesired); err != nil {
 		return nil, err
@@ -324,7 +377,7 @@ func applyNotificationChannelHelper(c *Client, ctx context.Context, rawDesired *
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -372,7 +372,7 @@ func (c *Client) notificationChannelDiffsForRawDesired(ctx context.Context, rawD
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a NotificationChannel resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve NotificationChannel resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve NotificationChannel resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that NotificationChannel resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -386,6 +386,12 @@ func (c *Client) notificationChannelDiffsForRawDesired(ctx context.Context, rawD
 	if err := extractNotificationChannelFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeNotificationChannelInitialState(rawInitial, rawDesired)
@@ -403,7 +409,7 @@ func (c *Client) notificationChannelDiffsForRawDesired(ctx context.Context, rawD
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffNotificationChannel(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeNotificationChannelInitialState(rawInitial, rawDesired *NotificationChannel) (*NotificationChannel, error) {
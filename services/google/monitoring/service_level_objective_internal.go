@@ -562,6 +562,14 @@ func (c *Client) getServiceLevelObjectiveRaw(ctx context.Context, r *ServiceLeve
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose user labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current user labels, which are nil if the resource does not exist.
+func (r *ServiceLevelObjective) withDefaultLabels(c *dcl.Config, current map[string]string) *ServiceLevelObjective {
+	nr := *r
+	nr.UserLabels = dcl.MergeDefaultLabels(c, r.UserLabels, current)
+	return &nr
+}
+
 func (c *Client) serviceLevelObjectiveDiffsForRawDesired(ctx context.Context, rawDesired *ServiceLevelObjective, opts ...dcl.ApplyOption) (initial, desired *ServiceLevelObjective, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -579,10 +587,16 @@ func (c *Client) serviceLevelObjectiveDiffsForRawDesired(ctx context.Context, ra
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetServiceLevelObjective(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.UserLabels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a ServiceLevelObjective resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve ServiceLevelObjective resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve ServiceLevelObjective resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that ServiceLevelObjective resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -596,6 +610,12 @@ func (c *Client) serviceLevelObjectiveDiffsForRawDesired(ctx context.Context, ra
 	if err := extractServiceLevelObjectiveFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeServiceLevelObjectiveInitialState(rawInitial, rawDesired)
@@ -613,7 +633,7 @@ func (c *Client) serviceLevelObjectiveDiffsForRawDesired(ctx context.Context, ra
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffServiceLevelObjective(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeServiceLevelObjectiveInitialState(rawInitial, rawDesired *ServiceLevelObjective) (*ServiceLevelObjective, error) {
@@ -4042,7 +4062,7 @@ func diffServiceLevelObjective(c *Client, desired, actual *ServiceLevelObjective
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.UserLabels, actual.UserLabels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateServiceLevelObjectiveUpdateServiceLevelObjectiveOperation")}, fn.AddNest("UserLabels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.UserLabels, actual.UserLabels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateServiceLevelObjectiveUpdateServiceLevelObjectiveOperation")}, fn.AddNest("UserLabels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -316,8 +316,12 @@ func (l *SpokeList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *SpokeList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *SpokeList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Spoke{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -334,7 +338,8 @@ func (l *SpokeList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListSpoke(ctx context.Context, project, location string) (*SpokeList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Spoke{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Spoke{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListSpokeWithMaxResults(ctx, project, location, SpokeMaxPage)
@@ -342,7 +347,11 @@ func (c *Client) ListSpoke(ctx context.Context, project, location string) (*Spok
 }
 
 func (c *Client) ListSpokeWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*SpokeList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListSpokeWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListSpokeWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*SpokeList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Spoke{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -350,7 +359,7 @@ func (c *Client) ListSpokeWithMaxResults(ctx context.Context, project, location
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listSpoke(ctx, r, "", pageSize)
+	items, token, err := c.listSpoke(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -364,7 +373,8 @@ func (c *Client) ListSpokeWithMaxResults(ctx context.Context, project, location
 
 func (c *Client) GetSpoke(ctx context.Context, r *Spoke) (*Spoke, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Spoke{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Spoke{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -380,7 +390,7 @@ func (c *Client) GetSpoke(ctx context.Context, r *Spoke) (*Spoke, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalSpoke(b, c, r)
 	if err != nil {
@@ -406,7 +416,8 @@ func (c *Client) GetSpoke(ctx context.Context, r *Spoke) (*Spoke, error) {
 
 func (c *Client) DeleteSpoke(ctx context.Context, r *Spoke) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Spoke{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Spoke{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -414,7 +425,13 @@ func (c *Client) DeleteSpoke(ctx context.Context, r *Spoke) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Spoke...")
 	deleteOp := deleteSpokeOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteSpokeAsync(ctx context.Context, r *Spoke) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteSpoke(ctx, r)
+	})
 }
 
 // DeleteAllSpoke deletes all resources that the filter functions returns true on.
@@ -442,10 +459,11 @@ func (c *Client) DeleteAllSpoke(ctx context.Context, project, location string, f
 }
 
 func (c *Client) ApplySpoke(ctx context.Context, rawDesired *Spoke, opts ...dcl.ApplyOption) (*Spoke, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Spoke{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Spoke{}).Describe())
 	var resultNewState *Spoke
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applySpokeHelper(c, ctx, rawDesired, opts...)
@@ -463,6 +481,38 @@ func (c *Client) ApplySpoke(ctx context.Context, rawDesired *Spoke, opts ...dcl.
 	return resultNewState, err
 }
 
+func (c *Client) ApplySpokeAsync(ctx context.Context, rawDesired *Spoke, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplySpoke(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffSpoke returns the field-level differences between rawDesired and the
+// live Spoke without modifying it. If the Spoke does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffSpoke(ctx context.Context, rawDesired *Spoke, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Spoke{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Spoke{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractSpokeFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.spokeDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Spoke %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applySpokeHelper(c *Client, ctx context.Context, rawDesired *Spoke, opts ...dcl.ApplyOption) (*Spoke, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplySpoke...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -471,6 +521,9 @@ func applySpokeHelper(c *Client, ctx context.Context, rawDesired *Spoke, opts ..
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractSpokeFields(rawDesired); err != nil {
 		return nil, err
@@ -529,7 +582,7 @@ func applySpokeHelper(c *Client, ctx context.Context, rawDesired *Spoke, opts ..
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -543,6 +596,8 @@ func applySpokeDiff(c *Client, ctx context.Context, desired *Spoke, rawDesired *
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -158,8 +158,12 @@ func (l *HubList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *HubList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *HubList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Hub{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -176,7 +180,8 @@ func (l *HubList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListHub(ctx context.Context, project string) (*HubList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Hub{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Hub{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListHubWithMaxResults(ctx, project, HubMaxPage)
@@ -184,14 +189,18 @@ func (c *Client) ListHub(ctx context.Context, project string) (*HubList, error)
 }
 
 func (c *Client) ListHubWithMaxResults(ctx context.Context, project string, pageSize int32) (*HubList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListHubWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListHubWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*HubList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Hub{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Hub{
 		Project: &project,
 	}
-	items, token, err := c.listHub(ctx, r, "", pageSize)
+	items, token, err := c.listHub(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +214,8 @@ func (c *Client) ListHubWithMaxResults(ctx context.Context, project string, page
 
 func (c *Client) GetHub(ctx context.Context, r *Hub) (*Hub, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Hub{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Hub{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -221,7 +231,7 @@ func (c *Client) GetHub(ctx context.Context, r *Hub) (*Hub, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalHub(b, c, r)
 	if err != nil {
@@ -246,7 +256,8 @@ func (c *Client) GetHub(ctx context.Context, r *Hub) (*Hub, error) {
 
 func (c *Client) DeleteHub(ctx context.Context, r *Hub) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Hub{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Hub{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -254,7 +265,13 @@ func (c *Client) DeleteHub(ctx context.Context, r *Hub) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Hub...")
 	deleteOp := deleteHubOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteHubAsync(ctx context.Context, r *Hub) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteHub(ctx, r)
+	})
 }
 
 // DeleteAllHub deletes all resources that the filter functions returns true on.
@@ -282,10 +299,11 @@ func (c *Client) DeleteAllHub(ctx context.Context, project string, filter func(*
 }
 
 func (c *Client) ApplyHub(ctx context.Context, rawDesired *Hub, opts ...dcl.ApplyOption) (*Hub, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Hub{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Hub{}).Describe())
 	var resultNewState *Hub
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyHubHelper(c, ctx, rawDesired, opts...)
@@ -303,6 +321,38 @@ func (c *Client) ApplyHub(ctx context.Context, rawDesired *Hub, opts ...dcl.Appl
 	return resultNewState, err
 }
 
+func (c *Client) ApplyHubAsync(ctx context.Context, rawDesired *Hub, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyHub(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffHub returns the field-level differences between rawDesired and the
+// live Hub without modifying it. If the Hub does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffHub(ctx context.Context, rawDesired *Hub, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Hub{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Hub{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractHubFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.hubDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Hub %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyHubHelper(c *Client, ctx context.Context, rawDesired *Hub, opts ...dcl.ApplyOption) (*Hub, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyHub...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -311,6 +361,9 @@ func applyHubHelper(c *Client, ctx context.Context, rawDesired *Hub, opts ...dcl
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractHubFields(rawDesired); err != nil {
 		return nil, err
@@ -369,7 +422,7 @@ func applyHubHelper(c *Client, ctx context.Context, rawDesired *Hub, opts ...dcl
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -383,6 +436,8 @@ func applyHubDiff(c *Client, ctx context.Context, desired *Hub, rawDesired *Hub,
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
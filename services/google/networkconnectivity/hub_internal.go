@@ -356,6 +356,14 @@ func (c *Client) getHubRaw(ctx context.Context, r *Hub) ([]byte, error) {
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Hub) withDefaultLabels(c *dcl.Config, current map[string]string) *Hub {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) hubDiffsForRawDesired(ctx context.Context, rawDesired *Hub, opts ...dcl.ApplyOption) (initial, desired *Hub, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -373,10 +381,16 @@ func (c *Client) hubDiffsForRawDesired(ctx context.Context, rawDesired *Hub, opt
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetHub(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Hub resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Hub resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Hub resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Hub resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -390,6 +404,12 @@ func (c *Client) hubDiffsForRawDesired(ctx context.Context, rawDesired *Hub, opt
 	if err := extractHubFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeHubInitialState(rawInitial, rawDesired)
@@ -407,7 +427,7 @@ func (c *Client) hubDiffsForRawDesired(ctx context.Context, rawDesired *Hub, opt
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffHub(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeHubInitialState(rawInitial, rawDesired *Hub) (*Hub, error) {
@@ -664,7 +684,7 @@ func diffHub(c *Client, desired, actual *Hub, opts ...dcl.ApplyOption) ([]*dcl.F
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateHubUpdateHubOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateHubUpdateHubOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
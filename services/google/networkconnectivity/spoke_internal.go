@@ -412,6 +412,14 @@ func (c *Client) getSpokeRaw(ctx context.Context, r *Spoke) ([]byte, error) {
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Spoke) withDefaultLabels(c *dcl.Config, current map[string]string) *Spoke {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) spokeDiffsForRawDesired(ctx context.Context, rawDesired *Spoke, opts ...dcl.ApplyOption) (initial, desired *Spoke, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -429,10 +437,16 @@ func (c *Client) spokeDiffsForRawDesired(ctx context.Context, rawDesired *Spoke,
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetSpoke(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Spoke resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Spoke resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Spoke resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Spoke resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -446,6 +460,12 @@ func (c *Client) spokeDiffsForRawDesired(ctx context.Context, rawDesired *Spoke,
 	if err := extractSpokeFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeSpokeInitialState(rawInitial, rawDesired)
@@ -463,7 +483,7 @@ func (c *Client) spokeDiffsForRawDesired(ctx context.Context, rawDesired *Spoke,
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffSpoke(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeSpokeInitialState(rawInitial, rawDesired *Spoke) (*Spoke, error) {
@@ -1175,7 +1195,7 @@ func diffSpoke(c *Client, desired, actual *Spoke, opts ...dcl.ApplyOption) ([]*d
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateSpokeUpdateSpokeOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateSpokeUpdateSpokeOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -425,8 +425,12 @@ func (l *ClientTlsPolicyList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ClientTlsPolicyList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ClientTlsPolicyList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ClientTlsPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -443,7 +447,8 @@ func (l *ClientTlsPolicyList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListClientTlsPolicy(ctx context.Context, project, location string) (*ClientTlsPolicyList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ClientTlsPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ClientTlsPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListClientTlsPolicyWithMaxResults(ctx, project, location, ClientTlsPolicyMaxPage)
@@ -451,7 +456,11 @@ func (c *Client) ListClientTlsPolicy(ctx context.Context, project, location stri
 }
 
 func (c *Client) ListClientTlsPolicyWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*ClientTlsPolicyList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListClientTlsPolicyWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListClientTlsPolicyWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*ClientTlsPolicyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ClientTlsPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -459,7 +468,7 @@ func (c *Client) ListClientTlsPolicyWithMaxResults(ctx context.Context, project,
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listClientTlsPolicy(ctx, r, "", pageSize)
+	items, token, err := c.listClientTlsPolicy(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -473,7 +482,8 @@ func (c *Client) ListClientTlsPolicyWithMaxResults(ctx context.Context, project,
 
 func (c *Client) GetClientTlsPolicy(ctx context.Context, r *ClientTlsPolicy) (*ClientTlsPolicy, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ClientTlsPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ClientTlsPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -489,7 +499,7 @@ func (c *Client) GetClientTlsPolicy(ctx context.Context, r *ClientTlsPolicy) (*C
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalClientTlsPolicy(b, c, r)
 	if err != nil {
@@ -515,7 +525,8 @@ func (c *Client) GetClientTlsPolicy(ctx context.Context, r *ClientTlsPolicy) (*C
 
 func (c *Client) DeleteClientTlsPolicy(ctx context.Context, r *ClientTlsPolicy) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ClientTlsPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ClientTlsPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -523,7 +534,13 @@ func (c *Client) DeleteClientTlsPolicy(ctx context.Context, r *ClientTlsPolicy)
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting ClientTlsPolicy...")
 	deleteOp := deleteClientTlsPolicyOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteClientTlsPolicyAsync(ctx context.Context, r *ClientTlsPolicy) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteClientTlsPolicy(ctx, r)
+	})
 }
 
 // DeleteAllClientTlsPolicy deletes all resources that the filter functions returns true on.
@@ -551,10 +568,11 @@ func (c *Client) DeleteAllClientTlsPolicy(ctx context.Context, project, location
 }
 
 func (c *Client) ApplyClientTlsPolicy(ctx context.Context, rawDesired *ClientTlsPolicy, opts ...dcl.ApplyOption) (*ClientTlsPolicy, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ClientTlsPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ClientTlsPolicy{}).Describe())
 	var resultNewState *ClientTlsPolicy
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyClientTlsPolicyHelper(c, ctx, rawDesired, opts...)
@@ -572,6 +590,38 @@ func (c *Client) ApplyClientTlsPolicy(ctx context.Context, rawDesired *ClientTls
 	return resultNewState, err
 }
 
+func (c *Client) ApplyClientTlsPolicyAsync(ctx context.Context, rawDesired *ClientTlsPolicy, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyClientTlsPolicy(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffClientTlsPolicy returns the field-level differences between rawDesired and the
+// live ClientTlsPolicy without modifying it. If the ClientTlsPolicy does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffClientTlsPolicy(ctx context.Context, rawDesired *ClientTlsPolicy, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ClientTlsPolicy{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ClientTlsPolicy{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractClientTlsPolicyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.clientTlsPolicyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("ClientTlsPolicy %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyClientTlsPolicyHelper(c *Client, ctx context.Context, rawDesired *ClientTlsPolicy, opts ...dcl.ApplyOption) (*ClientTlsPolicy, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyClientTlsPolicy...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -580,6 +630,9 @@ func applyClientTlsPolicyHelper(c *Client, ctx context.Context, rawDesired *Clie
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractClientTlsPolicyFields(rawDesired); err != nil {
 		return nil, err
@@ -638,7 +691,7 @@ func applyClientTlsPolicyHelper(c *Client, ctx context.Context, rawDesired *Clie
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -652,6 +705,8 @@ func applyClientTlsPolicyDiff(c *Client, ctx context.Context, desired *ClientTls
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
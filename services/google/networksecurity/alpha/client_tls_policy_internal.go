@@ -472,6 +472,14 @@ func (c *Client) getClientTlsPolicyRaw(ctx context.Context, r *ClientTlsPolicy)
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *ClientTlsPolicy) withDefaultLabels(c *dcl.Config, current map[string]string) *ClientTlsPolicy {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) clientTlsPolicyDiffsForRawDesired(ctx context.Context, rawDesired *ClientTlsPolicy, opts ...dcl.ApplyOption) (initial, desired *ClientTlsPolicy, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -489,10 +497,16 @@ func (c *Client) clientTlsPolicyDiffsForRawDesired(ctx context.Context, rawDesir
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetClientTlsPolicy(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a ClientTlsPolicy resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve ClientTlsPolicy resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve ClientTlsPolicy resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that ClientTlsPolicy resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -506,6 +520,12 @@ func (c *Client) clientTlsPolicyDiffsForRawDesired(ctx context.Context, rawDesir
 	if err := extractClientTlsPolicyFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeClientTlsPolicyInitialState(rawInitial, rawDesired)
@@ -523,7 +543,7 @@ func (c *Client) clientTlsPolicyDiffsForRawDesired(ctx context.Context, rawDesir
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffClientTlsPolicy(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeClientTlsPolicyInitialState(rawInitial, rawDesired *ClientTlsPolicy) (*ClientTlsPolicy, error) {
@@ -1566,7 +1586,7 @@ func diffClientTlsPolicy(c *Client, desired, actual *ClientTlsPolicy, opts ...dc
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateClientTlsPolicyUpdateClientTlsPolicyOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateClientTlsPolicyUpdateClientTlsPolicyOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
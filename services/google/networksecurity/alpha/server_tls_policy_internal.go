@@ -483,6 +483,14 @@ func (c *Client) getServerTlsPolicyRaw(ctx context.Context, r *ServerTlsPolicy)
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *ServerTlsPolicy) withDefaultLabels(c *dcl.Config, current map[string]string) *ServerTlsPolicy {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) serverTlsPolicyDiffsForRawDesired(ctx context.Context, rawDesired *ServerTlsPolicy, opts ...dcl.ApplyOption) (initial, desired *ServerTlsPolicy, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -500,10 +508,16 @@ func (c *Client) serverTlsPolicyDiffsForRawDesired(ctx context.Context, rawDesir
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetServerTlsPolicy(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a ServerTlsPolicy resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve ServerTlsPolicy resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve ServerTlsPolicy resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that ServerTlsPolicy resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -517,6 +531,12 @@ func (c *Client) serverTlsPolicyDiffsForRawDesired(ctx context.Context, rawDesir
 	if err := extractServerTlsPolicyFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeServerTlsPolicyInitialState(rawInitial, rawDesired)
@@ -534,7 +554,7 @@ func (c *Client) serverTlsPolicyDiffsForRawDesired(ctx context.Context, rawDesir
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffServerTlsPolicy(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeServerTlsPolicyInitialState(rawInitial, rawDesired *ServerTlsPolicy) (*ServerTlsPolicy, error) {
@@ -1687,7 +1707,7 @@ func diffServerTlsPolicy(c *Client, desired, actual *ServerTlsPolicy, opts ...dc
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateServerTlsPolicyUpdateServerTlsPolicyOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateServerTlsPolicyUpdateServerTlsPolicyOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -315,8 +315,12 @@ func (l *AuthorizationPolicyList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *AuthorizationPolicyList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *AuthorizationPolicyList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AuthorizationPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -333,7 +337,8 @@ func (l *AuthorizationPolicyList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListAuthorizationPolicy(ctx context.Context, project, location string) (*AuthorizationPolicyList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AuthorizationPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AuthorizationPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListAuthorizationPolicyWithMaxResults(ctx, project, location, AuthorizationPolicyMaxPage)
@@ -341,7 +346,11 @@ func (c *Client) ListAuthorizationPolicy(ctx context.Context, project, location
 }
 
 func (c *Client) ListAuthorizationPolicyWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*AuthorizationPolicyList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListAuthorizationPolicyWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListAuthorizationPolicyWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*AuthorizationPolicyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AuthorizationPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -349,7 +358,7 @@ func (c *Client) ListAuthorizationPolicyWithMaxResults(ctx context.Context, proj
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listAuthorizationPolicy(ctx, r, "", pageSize)
+	items, token, err := c.listAuthorizationPolicy(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -363,7 +372,8 @@ func (c *Client) ListAuthorizationPolicyWithMaxResults(ctx context.Context, proj
 
 func (c *Client) GetAuthorizationPolicy(ctx context.Context, r *AuthorizationPolicy) (*AuthorizationPolicy, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AuthorizationPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AuthorizationPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -379,7 +389,7 @@ func (c *Client) GetAuthorizationPolicy(ctx context.Context, r *AuthorizationPol
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalAuthorizationPolicy(b, c, r)
 	if err != nil {
@@ -405,7 +415,8 @@ func (c *Client) GetAuthorizationPolicy(ctx context.Context, r *AuthorizationPol
 
 func (c *Client) DeleteAuthorizationPolicy(ctx context.Context, r *AuthorizationPolicy) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AuthorizationPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AuthorizationPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -413,7 +424,13 @@ func (c *Client) DeleteAuthorizationPolicy(ctx context.Context, r *Authorization
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting AuthorizationPolicy...")
 	deleteOp := deleteAuthorizationPolicyOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteAuthorizationPolicyAsync(ctx context.Context, r *AuthorizationPolicy) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteAuthorizationPolicy(ctx, r)
+	})
 }
 
 // DeleteAllAuthorizationPolicy deletes all resources that the filter functions returns true on.
@@ -441,10 +458,11 @@ func (c *Client) DeleteAllAuthorizationPolicy(ctx context.Context, project, loca
 }
 
 func (c *Client) ApplyAuthorizationPolicy(ctx context.Context, rawDesired *AuthorizationPolicy, opts ...dcl.ApplyOption) (*AuthorizationPolicy, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AuthorizationPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AuthorizationPolicy{}).Describe())
 	var resultNewState *AuthorizationPolicy
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyAuthorizationPolicyHelper(c, ctx, rawDesired, opts...)
@@ -462,6 +480,38 @@ func (c *Client) ApplyAuthorizationPolicy(ctx context.Context, rawDesired *Autho
 	return resultNewState, err
 }
 
+func (c *Client) ApplyAuthorizationPolicyAsync(ctx context.Context, rawDesired *AuthorizationPolicy, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyAuthorizationPolicy(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffAuthorizationPolicy returns the field-level differences between rawDesired and the
+// live AuthorizationPolicy without modifying it. If the AuthorizationPolicy does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffAuthorizationPolicy(ctx context.Context, rawDesired *AuthorizationPolicy, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&AuthorizationPolicy{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&AuthorizationPolicy{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractAuthorizationPolicyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.authorizationPolicyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("AuthorizationPolicy %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyAuthorizationPolicyHelper(c *Client, ctx context.Context, rawDesired *AuthorizationPolicy, opts ...dcl.ApplyOption) (*AuthorizationPolicy, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyAuthorizationPolicy...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -470,6 +520,9 @@ func applyAuthorizationPolicyHelper(c *Client, ctx context.Context, rawDesired *
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractAuthorizationPolicyFields(rawDesired); err != nil {
 		return nil, err
@@ -528,7 +581,7 @@ func applyAuthorizationPolicyHelper(c *Client, ctx context.Context, rawDesired *
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -542,6 +595,8 @@ func applyAuthorizationPolicyDiff(c *Client, ctx context.Context, desired *Autho
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -416,8 +416,12 @@ func (l *ServerTlsPolicyList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ServerTlsPolicyList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ServerTlsPolicyList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServerTlsPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -434,7 +438,8 @@ func (l *ServerTlsPolicyList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListServerTlsPolicy(ctx context.Context, project, location string) (*ServerTlsPolicyList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServerTlsPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServerTlsPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListServerTlsPolicyWithMaxResults(ctx, project, location, ServerTlsPolicyMaxPage)
@@ -442,7 +447,11 @@ func (c *Client) ListServerTlsPolicy(ctx context.Context, project, location stri
 }
 
 func (c *Client) ListServerTlsPolicyWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*ServerTlsPolicyList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListServerTlsPolicyWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListServerTlsPolicyWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*ServerTlsPolicyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServerTlsPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -450,7 +459,7 @@ func (c *Client) ListServerTlsPolicyWithMaxResults(ctx context.Context, project,
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listServerTlsPolicy(ctx, r, "", pageSize)
+	items, token, err := c.listServerTlsPolicy(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -464,7 +473,8 @@ func (c *Client) ListServerTlsPolicyWithMaxResults(ctx context.Context, project,
 
 func (c *Client) GetServerTlsPolicy(ctx context.Context, r *ServerTlsPolicy) (*ServerTlsPolicy, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServerTlsPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServerTlsPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -480,7 +490,7 @@ func (c *Client) GetServerTlsPolicy(ctx context.Context, r *ServerTlsPolicy) (*S
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalServerTlsPolicy(b, c, r)
 	if err != nil {
@@ -506,7 +516,8 @@ func (c *Client) GetServerTlsPolicy(ctx context.Context, r *ServerTlsPolicy) (*S
 
 func (c *Client) DeleteServerTlsPolicy(ctx context.Context, r *ServerTlsPolicy) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServerTlsPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServerTlsPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -514,7 +525,13 @@ func (c *Client) DeleteServerTlsPolicy(ctx context.Context, r *ServerTlsPolicy)
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting ServerTlsPolicy...")
 	deleteOp := deleteServerTlsPolicyOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteServerTlsPolicyAsync(ctx context.Context, r *ServerTlsPolicy) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteServerTlsPolicy(ctx, r)
+	})
 }
 
 // DeleteAllServerTlsPolicy deletes all resources that the filter functions returns true on.
@@ -542,10 +559,11 @@ func (c *Client) DeleteAllServerTlsPolicy(ctx context.Context, project, location
 }
 
 func (c *Client) ApplyServerTlsPolicy(ctx context.Context, rawDesired *ServerTlsPolicy, opts ...dcl.ApplyOption) (*ServerTlsPolicy, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServerTlsPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServerTlsPolicy{}).Describe())
 	var resultNewState *ServerTlsPolicy
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyServerTlsPolicyHelper(c, ctx, rawDesired, opts...)
@@ -563,6 +581,38 @@ func (c *Client) ApplyServerTlsPolicy(ctx context.Context, rawDesired *ServerTls
 	return resultNewState, err
 }
 
+func (c *Client) ApplyServerTlsPolicyAsync(ctx context.Context, rawDesired *ServerTlsPolicy, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyServerTlsPolicy(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffServerTlsPolicy returns the field-level differences between rawDesired and the
+// live ServerTlsPolicy without modifying it. If the ServerTlsPolicy does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffServerTlsPolicy(ctx context.Context, rawDesired *ServerTlsPolicy, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServerTlsPolicy{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServerTlsPolicy{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractServerTlsPolicyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.serverTlsPolicyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("ServerTlsPolicy %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyServerTlsPolicyHelper(c *Client, ctx context.Context, rawDesired *ServerTlsPolicy, opts ...dcl.ApplyOption) (*ServerTlsPolicy, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyServerTlsPolicy...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -571,6 +621,9 @@ func applyServerTlsPolicyHelper(c *Client, ctx context.Context, rawDesired *Serv
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractServerTlsPolicyFields(rawDesired); err != nil {
 		return nil, err
@@ -629,7 +682,7 @@ func applyServerTlsPolicyHelper(c *Client, ctx context.Context, rawDesired *Serv
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -643,6 +696,8 @@ func applyServerTlsPolicyDiff(c *Client, ctx context.Context, desired *ServerTls
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
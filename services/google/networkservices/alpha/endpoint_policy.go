@@ -337,8 +337,12 @@ func (l *EndpointPolicyList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *EndpointPolicyList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *EndpointPolicyList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EndpointPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -355,7 +359,8 @@ func (l *EndpointPolicyList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListEndpointPolicy(ctx context.Context, project, location string) (*EndpointPolicyList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&EndpointPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EndpointPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListEndpointPolicyWithMaxResults(ctx, project, location, EndpointPolicyMaxPage)
@@ -363,7 +368,11 @@ func (c *Client) ListEndpointPolicy(ctx context.Context, project, location strin
 }
 
 func (c *Client) ListEndpointPolicyWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*EndpointPolicyList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListEndpointPolicyWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListEndpointPolicyWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*EndpointPolicyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EndpointPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -371,7 +380,7 @@ func (c *Client) ListEndpointPolicyWithMaxResults(ctx context.Context, project,
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listEndpointPolicy(ctx, r, "", pageSize)
+	items, token, err := c.listEndpointPolicy(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -385,7 +394,8 @@ func (c *Client) ListEndpointPolicyWithMaxResults(ctx context.Context, project,
 
 func (c *Client) GetEndpointPolicy(ctx context.Context, r *EndpointPolicy) (*EndpointPolicy, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&EndpointPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EndpointPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -401,7 +411,7 @@ func (c *Client) GetEndpointPolicy(ctx context.Context, r *EndpointPolicy) (*End
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalEndpointPolicy(b, c, r)
 	if err != nil {
@@ -427,7 +437,8 @@ func (c *Client) GetEndpointPolicy(ctx context.Context, r *EndpointPolicy) (*End
 
 func (c *Client) DeleteEndpointPolicy(ctx context.Context, r *EndpointPolicy) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&EndpointPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EndpointPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -435,7 +446,13 @@ func (c *Client) DeleteEndpointPolicy(ctx context.Context, r *EndpointPolicy) er
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting EndpointPolicy...")
 	deleteOp := deleteEndpointPolicyOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteEndpointPolicyAsync(ctx context.Context, r *EndpointPolicy) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteEndpointPolicy(ctx, r)
+	})
 }
 
 // DeleteAllEndpointPolicy deletes all resources that the filter functions returns true on.
@@ -463,10 +480,11 @@ func (c *Client) DeleteAllEndpointPolicy(ctx context.Context, project, location
 }
 
 func (c *Client) ApplyEndpointPolicy(ctx context.Context, rawDesired *EndpointPolicy, opts ...dcl.ApplyOption) (*EndpointPolicy, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EndpointPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&EndpointPolicy{}).Describe())
 	var resultNewState *EndpointPolicy
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyEndpointPolicyHelper(c, ctx, rawDesired, opts...)
@@ -484,6 +502,38 @@ func (c *Client) ApplyEndpointPolicy(ctx context.Context, rawDesired *EndpointPo
 	return resultNewState, err
 }
 
+func (c *Client) ApplyEndpointPolicyAsync(ctx context.Context, rawDesired *EndpointPolicy, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyEndpointPolicy(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffEndpointPolicy returns the field-level differences between rawDesired and the
+// live EndpointPolicy without modifying it. If the EndpointPolicy does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffEndpointPolicy(ctx context.Context, rawDesired *EndpointPolicy, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&EndpointPolicy{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&EndpointPolicy{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractEndpointPolicyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.endpointPolicyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("EndpointPolicy %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyEndpointPolicyHelper(c *Client, ctx context.Context, rawDesired *EndpointPolicy, opts ...dcl.ApplyOption) (*EndpointPolicy, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyEndpointPolicy...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -492,6 +542,9 @@ func applyEndpointPolicyHelper(c *Client, ctx context.Context, rawDesired *Endpo
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractEndpointPolicyFields(rawDesired); err != nil {
 		return nil, err
@@ -550,7 +603,7 @@ func applyEndpointPolicyHelper(c *Client, ctx context.Context, rawDesired *Endpo
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -564,6 +617,8 @@ func applyEndpointPolicyDiff(c *Client, ctx context.Context, desired *EndpointPo
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
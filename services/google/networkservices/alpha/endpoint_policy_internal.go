@@ -417,6 +417,14 @@ func (c *Client) getEndpointPolicyRaw(ctx context.Context, r *EndpointPolicy) ([
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *EndpointPolicy) withDefaultLabels(c *dcl.Config, current map[string]string) *EndpointPolicy {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) endpointPolicyDiffsForRawDesired(ctx context.Context, rawDesired *EndpointPolicy, opts ...dcl.ApplyOption) (initial, desired *EndpointPolicy, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -434,10 +442,16 @@ func (c *Client) endpointPolicyDiffsForRawDesired(ctx context.Context, rawDesire
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetEndpointPolicy(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a EndpointPolicy resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve EndpointPolicy resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve EndpointPolicy resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that EndpointPolicy resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -451,6 +465,12 @@ func (c *Client) endpointPolicyDiffsForRawDesired(ctx context.Context, rawDesire
 	if err := extractEndpointPolicyFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeEndpointPolicyInitialState(rawInitial, rawDesired)
@@ -468,7 +488,7 @@ func (c *Client) endpointPolicyDiffsForRawDesired(ctx context.Context, rawDesire
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffEndpointPolicy(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeEndpointPolicyInitialState(rawInitial, rawDesired *EndpointPolicy) (*EndpointPolicy, error) {
@@ -1123,7 +1143,7 @@ func diffEndpointPolicy(c *Client, desired, actual *EndpointPolicy, opts ...dcl.
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateEndpointPolicyUpdateEndpointPolicyOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateEndpointPolicyUpdateEndpointPolicyOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -118,8 +118,12 @@ func (l *GatewayList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *GatewayList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *GatewayList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Gateway{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -136,7 +140,8 @@ func (l *GatewayList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListGateway(ctx context.Context, project, location string) (*GatewayList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Gateway{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Gateway{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListGatewayWithMaxResults(ctx, project, location, GatewayMaxPage)
@@ -144,7 +149,11 @@ func (c *Client) ListGateway(ctx context.Context, project, location string) (*Ga
 }
 
 func (c *Client) ListGatewayWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*GatewayList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListGatewayWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListGatewayWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*GatewayList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Gateway{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -152,7 +161,7 @@ func (c *Client) ListGatewayWithMaxResults(ctx context.Context, project, locatio
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listGateway(ctx, r, "", pageSize)
+	items, token, err := c.listGateway(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +175,8 @@ func (c *Client) ListGatewayWithMaxResults(ctx context.Context, project, locatio
 
 func (c *Client) GetGateway(ctx context.Context, r *Gateway) (*Gateway, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Gateway{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Gateway{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -182,7 +192,7 @@ func (c *Client) GetGateway(ctx context.Context, r *Gateway) (*Gateway, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalGateway(b, c, r)
 	if err != nil {
@@ -208,7 +218,8 @@ func (c *Client) GetGateway(ctx context.Context, r *Gateway) (*Gateway, error) {
 
 func (c *Client) DeleteGateway(ctx context.Context, r *Gateway) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Gateway{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Gateway{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -216,7 +227,13 @@ func (c *Client) DeleteGateway(ctx context.Context, r *Gateway) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Gateway...")
 	deleteOp := deleteGatewayOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteGatewayAsync(ctx context.Context, r *Gateway) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteGateway(ctx, r)
+	})
 }
 
 // DeleteAllGateway deletes all resources that the filter functions returns true on.
@@ -244,10 +261,11 @@ func (c *Client) DeleteAllGateway(ctx context.Context, project, location string,
 }
 
 func (c *Client) ApplyGateway(ctx context.Context, rawDesired *Gateway, opts ...dcl.ApplyOption) (*Gateway, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Gateway{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Gateway{}).Describe())
 	var resultNewState *Gateway
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyGatewayHelper(c, ctx, rawDesired, opts...)
@@ -265,6 +283,38 @@ func (c *Client) ApplyGateway(ctx context.Context, rawDesired *Gateway, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyGatewayAsync(ctx context.Context, rawDesired *Gateway, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyGateway(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffGateway returns the field-level differences between rawDesired and the
+// live Gateway without modifying it. If the Gateway does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffGateway(ctx context.Context, rawDesired *Gateway, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Gateway{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Gateway{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractGatewayFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.gatewayDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Gateway %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyGatewayHelper(c *Client, ctx context.Context, rawDesired *Gateway, opts ...dcl.ApplyOption) (*Gateway, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyGateway...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -273,6 +323,9 @@ func applyGatewayHelper(c *Client, ctx context.Context, rawDesired *Gateway, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractGatewayFields(rawDesired); err != nil {
 		return nil, err
@@ -331,7 +384,7 @@ func applyGatewayHelper(c *Client, ctx context.Context, rawDesired *Gateway, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -345,6 +398,8 @@ func applyGatewayDiff(c *Client, ctx context.Context, desired *Gateway, rawDesir
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -493,6 +493,14 @@ func (c *Client) getGrpcRouteRaw(ctx context.Context, r *GrpcRoute) ([]byte, err
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *GrpcRoute) withDefaultLabels(c *dcl.Config, current map[string]string) *GrpcRoute {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) grpcRouteDiffsForRawDesired(ctx context.Context, rawDesired *GrpcRoute, opts ...dcl.ApplyOption) (initial, desired *GrpcRoute, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -510,10 +518,16 @@ func (c *Client) grpcRouteDiffsForRawDesired(ctx context.Context, rawDesired *Gr
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetGrpcRoute(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a GrpcRoute resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve GrpcRoute resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve GrpcRoute resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that GrpcRoute resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -527,6 +541,12 @@ func (c *Client) grpcRouteDiffsForRawDesired(ctx context.Context, rawDesired *Gr
 	if err := extractGrpcRouteFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeGrpcRouteInitialState(rawInitial, rawDesired)
@@ -544,7 +564,7 @@ func (c *Client) grpcRouteDiffsForRawDesired(ctx context.Context, rawDesired *Gr
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffGrpcRoute(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeGrpcRouteInitialState(rawInitial, rawDesired *GrpcRoute) (*GrpcRoute, error) {
@@ -2019,7 +2039,7 @@ func diffGrpcRoute(c *Client, desired, actual *GrpcRoute, opts ...dcl.ApplyOptio
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateGrpcRouteUpdateGrpcRouteOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateGrpcRouteUpdateGrpcRouteOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -1099,8 +1099,12 @@ func (l *HttpRouteList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *HttpRouteList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *HttpRouteList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HttpRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -1117,7 +1121,8 @@ func (l *HttpRouteList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListHttpRoute(ctx context.Context, project, location string) (*HttpRouteList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&HttpRoute{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HttpRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListHttpRouteWithMaxResults(ctx, project, location, HttpRouteMaxPage)
@@ -1125,7 +1130,11 @@ func (c *Client) ListHttpRoute(ctx context.Context, project, location string) (*
 }
 
 func (c *Client) ListHttpRouteWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*HttpRouteList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListHttpRouteWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListHttpRouteWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*HttpRouteList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HttpRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -1133,7 +1142,7 @@ func (c *Client) ListHttpRouteWithMaxResults(ctx context.Context, project, locat
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listHttpRoute(ctx, r, "", pageSize)
+	items, token, err := c.listHttpRoute(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -1147,7 +1156,8 @@ func (c *Client) ListHttpRouteWithMaxResults(ctx context.Context, project, locat
 
 func (c *Client) GetHttpRoute(ctx context.Context, r *HttpRoute) (*HttpRoute, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&HttpRoute{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HttpRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -1163,7 +1173,7 @@ func (c *Client) GetHttpRoute(ctx context.Context, r *HttpRoute) (*HttpRoute, er
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalHttpRoute(b, c, r)
 	if err != nil {
@@ -1189,7 +1199,8 @@ func (c *Client) GetHttpRoute(ctx context.Context, r *HttpRoute) (*HttpRoute, er
 
 func (c *Client) DeleteHttpRoute(ctx context.Context, r *HttpRoute) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&HttpRoute{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HttpRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -1197,7 +1208,13 @@ func (c *Client) DeleteHttpRoute(ctx context.Context, r *HttpRoute) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting HttpRoute...")
 	deleteOp := deleteHttpRouteOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteHttpRouteAsync(ctx context.Context, r *HttpRoute) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteHttpRoute(ctx, r)
+	})
 }
 
 // DeleteAllHttpRoute deletes all resources that the filter functions returns true on.
@@ -1225,10 +1242,11 @@ func (c *Client) DeleteAllHttpRoute(ctx context.Context, project, location strin
 }
 
 func (c *Client) ApplyHttpRoute(ctx context.Context, rawDesired *HttpRoute, opts ...dcl.ApplyOption) (*HttpRoute, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HttpRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&HttpRoute{}).Describe())
 	var resultNewState *HttpRoute
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyHttpRouteHelper(c, ctx, rawDesired, opts...)
@@ -1246,6 +1264,38 @@ func (c *Client) ApplyHttpRoute(ctx context.Context, rawDesired *HttpRoute, opts
 	return resultNewState, err
 }
 
+func (c *Client) ApplyHttpRouteAsync(ctx context.Context, rawDesired *HttpRoute, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyHttpRoute(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffHttpRoute returns the field-level differences between rawDesired and the
+// live HttpRoute without modifying it. If the HttpRoute does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffHttpRoute(ctx context.Context, rawDesired *HttpRoute, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HttpRoute{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&HttpRoute{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractHttpRouteFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.httpRouteDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("HttpRoute %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyHttpRouteHelper(c *Client, ctx context.Context, rawDesired *HttpRoute, opts ...dcl.ApplyOption) (*HttpRoute, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyHttpRoute...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -1254,6 +1304,9 @@ func applyHttpRouteHelper(c *Client, ctx context.Context, rawDesired *HttpRoute,
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractHttpRouteFields(rawDesired); err != nil {
 		return nil, err
@@ -1312,7 +1365,7 @@ func applyHttpRouteHelper(c *Client, ctx context.Context, rawDesired *HttpRoute,
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -1326,6 +1379,8 @@ func applyHttpRouteDiff(c *Client, ctx context.Context, desired *HttpRoute, rawD
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -83,8 +83,12 @@ func (l *MeshList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *MeshList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *MeshList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Mesh{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -101,7 +105,8 @@ func (l *MeshList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListMesh(ctx context.Context, project, location string) (*MeshList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Mesh{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Mesh{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListMeshWithMaxResults(ctx, project, location, MeshMaxPage)
@@ -109,7 +114,11 @@ func (c *Client) ListMesh(ctx context.Context, project, location string) (*MeshL
 }
 
 func (c *Client) ListMeshWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*MeshList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListMeshWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListMeshWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*MeshList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Mesh{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -117,7 +126,7 @@ func (c *Client) ListMeshWithMaxResults(ctx context.Context, project, location s
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listMesh(ctx, r, "", pageSize)
+	items, token, err := c.listMesh(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +140,8 @@ func (c *Client) ListMeshWithMaxResults(ctx context.Context, project, location s
 
 func (c *Client) GetMesh(ctx context.Context, r *Mesh) (*Mesh, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Mesh{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Mesh{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -147,7 +157,7 @@ func (c *Client) GetMesh(ctx context.Context, r *Mesh) (*Mesh, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalMesh(b, c, r)
 	if err != nil {
@@ -173,7 +183,8 @@ func (c *Client) GetMesh(ctx context.Context, r *Mesh) (*Mesh, error) {
 
 func (c *Client) DeleteMesh(ctx context.Context, r *Mesh) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Mesh{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Mesh{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -181,7 +192,13 @@ func (c *Client) DeleteMesh(ctx context.Context, r *Mesh) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Mesh...")
 	deleteOp := deleteMeshOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteMeshAsync(ctx context.Context, r *Mesh) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteMesh(ctx, r)
+	})
 }
 
 // DeleteAllMesh deletes all resources that the filter functions returns true on.
@@ -209,10 +226,11 @@ func (c *Client) DeleteAllMesh(ctx context.Context, project, location string, fi
 }
 
 func (c *Client) ApplyMesh(ctx context.Context, rawDesired *Mesh, opts ...dcl.ApplyOption) (*Mesh, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Mesh{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Mesh{}).Describe())
 	var resultNewState *Mesh
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyMeshHelper(c, ctx, rawDesired, opts...)
@@ -230,6 +248,38 @@ func (c *Client) ApplyMesh(ctx context.Context, rawDesired *Mesh, opts ...dcl.Ap
 	return resultNewState, err
 }
 
+func (c *Client) ApplyMeshAsync(ctx context.Context, rawDesired *Mesh, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyMesh(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffMesh returns the field-level differences between rawDesired and the
+// live Mesh without modifying it. If the Mesh does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffMesh(ctx context.Context, rawDesired *Mesh, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Mesh{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Mesh{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractMeshFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.meshDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Mesh %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyMeshHelper(c *Client, ctx context.Context, rawDesired *Mesh, opts ...dcl.ApplyOption) (*Mesh, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyMesh...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -238,6 +288,9 @@ func applyMeshHelper(c *Client, ctx context.Context, rawDesired *Mesh, opts ...d
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractMeshFields(rawDesired); err != nil {
 		return nil, err
@@ -296,7 +349,7 @@ func applyMeshHelper(c *Client, ctx context.Context, rawDesired *Mesh, opts ...d
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -310,6 +363,8 @@ func applyMeshDiff(c *Client, ctx context.Context, desired *Mesh, rawDesired *Me
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -81,8 +81,12 @@ func (l *ServiceBindingList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ServiceBindingList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ServiceBindingList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceBinding{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -99,7 +103,8 @@ func (l *ServiceBindingList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListServiceBinding(ctx context.Context, project, location string) (*ServiceBindingList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceBinding{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceBinding{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListServiceBindingWithMaxResults(ctx, project, location, ServiceBindingMaxPage)
@@ -107,7 +112,11 @@ func (c *Client) ListServiceBinding(ctx context.Context, project, location strin
 }
 
 func (c *Client) ListServiceBindingWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*ServiceBindingList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListServiceBindingWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListServiceBindingWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*ServiceBindingList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceBinding{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -115,7 +124,7 @@ func (c *Client) ListServiceBindingWithMaxResults(ctx context.Context, project,
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listServiceBinding(ctx, r, "", pageSize)
+	items, token, err := c.listServiceBinding(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +138,8 @@ func (c *Client) ListServiceBindingWithMaxResults(ctx context.Context, project,
 
 func (c *Client) GetServiceBinding(ctx context.Context, r *ServiceBinding) (*ServiceBinding, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceBinding{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceBinding{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -145,7 +155,7 @@ func (c *Client) GetServiceBinding(ctx context.Context, r *ServiceBinding) (*Ser
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalServiceBinding(b, c, r)
 	if err != nil {
@@ -171,7 +181,8 @@ func (c *Client) GetServiceBinding(ctx context.Context, r *ServiceBinding) (*Ser
 
 func (c *Client) DeleteServiceBinding(ctx context.Context, r *ServiceBinding) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceBinding{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceBinding{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -179,7 +190,13 @@ func (c *Client) DeleteServiceBinding(ctx context.Context, r *ServiceBinding) er
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting ServiceBinding...")
 	deleteOp := deleteServiceBindingOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteServiceBindingAsync(ctx context.Context, r *ServiceBinding) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteServiceBinding(ctx, r)
+	})
 }
 
 // DeleteAllServiceBinding deletes all resources that the filter functions returns true on.
@@ -207,10 +224,11 @@ func (c *Client) DeleteAllServiceBinding(ctx context.Context, project, location
 }
 
 func (c *Client) ApplyServiceBinding(ctx context.Context, rawDesired *ServiceBinding, opts ...dcl.ApplyOption) (*ServiceBinding, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceBinding{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceBinding{}).Describe())
 	var resultNewState *ServiceBinding
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyServiceBindingHelper(c, ctx, rawDesired, opts...)
@@ -228,6 +246,38 @@ func (c *Client) ApplyServiceBinding(ctx context.Context, rawDesired *ServiceBin
 	return resultNewState, err
 }
 
+func (c *Client) ApplyServiceBindingAsync(ctx context.Context, rawDesired *ServiceBinding, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyServiceBinding(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffServiceBinding returns the field-level differences between rawDesired and the
+// live ServiceBinding without modifying it. If the ServiceBinding does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffServiceBinding(ctx context.Context, rawDesired *ServiceBinding, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ServiceBinding{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ServiceBinding{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractServiceBindingFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.serviceBindingDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("ServiceBinding %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyServiceBindingHelper(c *Client, ctx context.Context, rawDesired *ServiceBinding, opts ...dcl.ApplyOption) (*ServiceBinding, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyServiceBinding...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -236,6 +286,9 @@ func applyServiceBindingHelper(c *Client, ctx context.Context, rawDesired *Servi
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractServiceBindingFields(rawDesired); err != nil {
 		return nil, err
@@ -294,7 +347,7 @@ func applyServiceBindingHelper(c *Client, ctx context.Context, rawDesired *Servi
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -308,6 +361,8 @@ func applyServiceBindingDiff(c *Client, ctx context.Context, desired *ServiceBin
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
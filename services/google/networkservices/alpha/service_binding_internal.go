@@ -284,6 +284,14 @@ func (c *Client) getServiceBindingRaw(ctx context.Context, r *ServiceBinding) ([
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *ServiceBinding) withDefaultLabels(c *dcl.Config, current map[string]string) *ServiceBinding {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) serviceBindingDiffsForRawDesired(ctx context.Context, rawDesired *ServiceBinding, opts ...dcl.ApplyOption) (initial, desired *ServiceBinding, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -301,10 +309,16 @@ func (c *Client) serviceBindingDiffsForRawDesired(ctx context.Context, rawDesire
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetServiceBinding(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a ServiceBinding resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve ServiceBinding resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve ServiceBinding resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that ServiceBinding resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -318,6 +332,12 @@ func (c *Client) serviceBindingDiffsForRawDesired(ctx context.Context, rawDesire
 	if err := extractServiceBindingFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeServiceBindingInitialState(rawInitial, rawDesired)
@@ -335,7 +355,7 @@ func (c *Client) serviceBindingDiffsForRawDesired(ctx context.Context, rawDesire
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffServiceBinding(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeServiceBindingInitialState(rawInitial, rawDesired *ServiceBinding) (*ServiceBinding, error) {
@@ -493,7 +513,7 @@ func diffServiceBinding(c *Client, desired, actual *ServiceBinding, opts ...dcl.
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -285,8 +285,12 @@ func (l *TcpRouteList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *TcpRouteList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *TcpRouteList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TcpRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -303,7 +307,8 @@ func (l *TcpRouteList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListTcpRoute(ctx context.Context, project, location string) (*TcpRouteList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TcpRoute{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TcpRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListTcpRouteWithMaxResults(ctx, project, location, TcpRouteMaxPage)
@@ -311,7 +316,11 @@ func (c *Client) ListTcpRoute(ctx context.Context, project, location string) (*T
 }
 
 func (c *Client) ListTcpRouteWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*TcpRouteList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListTcpRouteWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListTcpRouteWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*TcpRouteList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TcpRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -319,7 +328,7 @@ func (c *Client) ListTcpRouteWithMaxResults(ctx context.Context, project, locati
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listTcpRoute(ctx, r, "", pageSize)
+	items, token, err := c.listTcpRoute(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -333,7 +342,8 @@ func (c *Client) ListTcpRouteWithMaxResults(ctx context.Context, project, locati
 
 func (c *Client) GetTcpRoute(ctx context.Context, r *TcpRoute) (*TcpRoute, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TcpRoute{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TcpRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -349,7 +359,7 @@ func (c *Client) GetTcpRoute(ctx context.Context, r *TcpRoute) (*TcpRoute, error
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalTcpRoute(b, c, r)
 	if err != nil {
@@ -375,7 +385,8 @@ func (c *Client) GetTcpRoute(ctx context.Context, r *TcpRoute) (*TcpRoute, error
 
 func (c *Client) DeleteTcpRoute(ctx context.Context, r *TcpRoute) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TcpRoute{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TcpRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -383,7 +394,13 @@ func (c *Client) DeleteTcpRoute(ctx context.Context, r *TcpRoute) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting TcpRoute...")
 	deleteOp := deleteTcpRouteOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteTcpRouteAsync(ctx context.Context, r *TcpRoute) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteTcpRoute(ctx, r)
+	})
 }
 
 // DeleteAllTcpRoute deletes all resources that the filter functions returns true on.
@@ -411,10 +428,11 @@ func (c *Client) DeleteAllTcpRoute(ctx context.Context, project, location string
 }
 
 func (c *Client) ApplyTcpRoute(ctx context.Context, rawDesired *TcpRoute, opts ...dcl.ApplyOption) (*TcpRoute, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TcpRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TcpRoute{}).Describe())
 	var resultNewState *TcpRoute
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyTcpRouteHelper(c, ctx, rawDesired, opts...)
@@ -432,6 +450,38 @@ func (c *Client) ApplyTcpRoute(ctx context.Context, rawDesired *TcpRoute, opts .
 	return resultNewState, err
 }
 
+func (c *Client) ApplyTcpRouteAsync(ctx context.Context, rawDesired *TcpRoute, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyTcpRoute(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffTcpRoute returns the field-level differences between rawDesired and the
+// live TcpRoute without modifying it. If the TcpRoute does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffTcpRoute(ctx context.Context, rawDesired *TcpRoute, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TcpRoute{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TcpRoute{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractTcpRouteFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.tcpRouteDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("TcpRoute %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyTcpRouteHelper(c *Client, ctx context.Context, rawDesired *TcpRoute, opts ...dcl.ApplyOption) (*TcpRoute, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyTcpRoute...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -440,6 +490,9 @@ func applyTcpRouteHelper(c *Client, ctx context.Context, rawDesired *TcpRoute, o
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractTcpRouteFields(rawDesired); err != nil {
 		return nil, err
@@ -498,7 +551,7 @@ func applyTcpRouteHelper(c *Client, ctx context.Context, rawDesired *TcpRoute, o
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -512,6 +565,8 @@ func applyTcpRouteDiff(c *Client, ctx context.Context, desired *TcpRoute, rawDes
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -428,7 +428,7 @@ func (c *Client) tlsRouteDiffsForRawDesired(ctx context.Context, rawDesired *Tls
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a TlsRoute resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve TlsRoute resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve TlsRoute resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that TlsRoute resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -442,6 +442,12 @@ func (c *Client) tlsRouteDiffsForRawDesired(ctx context.Context, rawDesired *Tls
 	if err := extractTlsRouteFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeTlsRouteInitialState(rawInitial, rawDesired)
@@ -459,7 +465,7 @@ func (c *Client) tlsRouteDiffsForRawDesired(ctx context.Context, rawDesired *Tls
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffTlsRoute(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeTlsRouteInitialState(rawInitial, rawDesired *TlsRoute) (*TlsRoute, error) {
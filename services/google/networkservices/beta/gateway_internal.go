@@ -383,6 +383,14 @@ func (c *Client) getGatewayRaw(ctx context.Context, r *Gateway) ([]byte, error)
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Gateway) withDefaultLabels(c *dcl.Config, current map[string]string) *Gateway {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) gatewayDiffsForRawDesired(ctx context.Context, rawDesired *Gateway, opts ...dcl.ApplyOption) (initial, desired *Gateway, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -400,10 +408,16 @@ func (c *Client) gatewayDiffsForRawDesired(ctx context.Context, rawDesired *Gate
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetGateway(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Gateway resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Gateway resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Gateway resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Gateway resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -417,6 +431,12 @@ func (c *Client) gatewayDiffsForRawDesired(ctx context.Context, rawDesired *Gate
 	if err := extractGatewayFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeGatewayInitialState(rawInitial, rawDesired)
@@ -434,7 +454,7 @@ func (c *Client) gatewayDiffsForRawDesired(ctx context.Context, rawDesired *Gate
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffGateway(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeGatewayInitialState(rawInitial, rawDesired *Gateway) (*Gateway, error) {
@@ -634,7 +654,7 @@ func diffGateway(c *Client, desired, actual *Gateway, opts ...dcl.ApplyOption) (
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateGatewayUpdateGatewayOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateGatewayUpdateGatewayOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
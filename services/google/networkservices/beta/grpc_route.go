@@ -650,8 +650,12 @@ func (l *GrpcRouteList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *GrpcRouteList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *GrpcRouteList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GrpcRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -668,7 +672,8 @@ func (l *GrpcRouteList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListGrpcRoute(ctx context.Context, project, location string) (*GrpcRouteList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GrpcRoute{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GrpcRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListGrpcRouteWithMaxResults(ctx, project, location, GrpcRouteMaxPage)
@@ -676,7 +681,11 @@ func (c *Client) ListGrpcRoute(ctx context.Context, project, location string) (*
 }
 
 func (c *Client) ListGrpcRouteWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*GrpcRouteList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListGrpcRouteWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListGrpcRouteWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*GrpcRouteList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GrpcRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -684,7 +693,7 @@ func (c *Client) ListGrpcRouteWithMaxResults(ctx context.Context, project, locat
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listGrpcRoute(ctx, r, "", pageSize)
+	items, token, err := c.listGrpcRoute(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -698,7 +707,8 @@ func (c *Client) ListGrpcRouteWithMaxResults(ctx context.Context, project, locat
 
 func (c *Client) GetGrpcRoute(ctx context.Context, r *GrpcRoute) (*GrpcRoute, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GrpcRoute{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GrpcRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -714,7 +724,7 @@ func (c *Client) GetGrpcRoute(ctx context.Context, r *GrpcRoute) (*GrpcRoute, er
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalGrpcRoute(b, c, r)
 	if err != nil {
@@ -740,7 +750,8 @@ func (c *Client) GetGrpcRoute(ctx context.Context, r *GrpcRoute) (*GrpcRoute, er
 
 func (c *Client) DeleteGrpcRoute(ctx context.Context, r *GrpcRoute) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GrpcRoute{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GrpcRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -748,7 +759,13 @@ func (c *Client) DeleteGrpcRoute(ctx context.Context, r *GrpcRoute) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting GrpcRoute...")
 	deleteOp := deleteGrpcRouteOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteGrpcRouteAsync(ctx context.Context, r *GrpcRoute) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteGrpcRoute(ctx, r)
+	})
 }
 
 // DeleteAllGrpcRoute deletes all resources that the filter functions returns true on.
@@ -776,10 +793,11 @@ func (c *Client) DeleteAllGrpcRoute(ctx context.Context, project, location strin
 }
 
 func (c *Client) ApplyGrpcRoute(ctx context.Context, rawDesired *GrpcRoute, opts ...dcl.ApplyOption) (*GrpcRoute, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GrpcRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GrpcRoute{}).Describe())
 	var resultNewState *GrpcRoute
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyGrpcRouteHelper(c, ctx, rawDesired, opts...)
@@ -797,6 +815,38 @@ func (c *Client) ApplyGrpcRoute(ctx context.Context, rawDesired *GrpcRoute, opts
 	return resultNewState, err
 }
 
+func (c *Client) ApplyGrpcRouteAsync(ctx context.Context, rawDesired *GrpcRoute, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyGrpcRoute(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffGrpcRoute returns the field-level differences between rawDesired and the
+// live GrpcRoute without modifying it. If the GrpcRoute does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffGrpcRoute(ctx context.Context, rawDesired *GrpcRoute, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GrpcRoute{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GrpcRoute{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractGrpcRouteFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.grpcRouteDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("GrpcRoute %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyGrpcRouteHelper(c *Client, ctx context.Context, rawDesired *GrpcRoute, opts ...dcl.ApplyOption) (*GrpcRoute, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyGrpcRoute...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -805,6 +855,9 @@ func applyGrpcRouteHelper(c *Client, ctx context.Context, rawDesired *GrpcRoute,
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractGrpcRouteFields(rawDesired); err != nil {
 		return nil, err
@@ -863,7 +916,7 @@ func applyGrpcRouteHelper(c *Client, ctx context.Context, rawDesired *GrpcRoute,
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -877,6 +930,8 @@ func applyGrpcRouteDiff(c *Client, ctx context.Context, desired *GrpcRoute, rawD
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
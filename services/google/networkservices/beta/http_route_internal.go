@@ -516,6 +516,14 @@ func (c *Client) getHttpRouteRaw(ctx context.Context, r *HttpRoute) ([]byte, err
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *HttpRoute) withDefaultLabels(c *dcl.Config, current map[string]string) *HttpRoute {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) httpRouteDiffsForRawDesired(ctx context.Context, rawDesired *HttpRoute, opts ...dcl.ApplyOption) (initial, desired *HttpRoute, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -533,10 +541,16 @@ func (c *Client) httpRouteDiffsForRawDesired(ctx context.Context, rawDesired *Ht
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetHttpRoute(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a HttpRoute resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve HttpRoute resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve HttpRoute resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that HttpRoute resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -550,6 +564,12 @@ func (c *Client) httpRouteDiffsForRawDesired(ctx context.Context, rawDesired *Ht
 	if err := extractHttpRouteFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeHttpRouteInitialState(rawInitial, rawDesired)
@@ -567,7 +587,7 @@ func (c *Client) httpRouteDiffsForRawDesired(ctx context.Context, rawDesired *Ht
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffHttpRoute(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeHttpRouteInitialState(rawInitial, rawDesired *HttpRoute) (*HttpRoute, error) {
@@ -3242,7 +3262,7 @@ func diffHttpRoute(c *Client, desired, actual *HttpRoute, opts ...dcl.ApplyOptio
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateHttpRouteUpdateHttpRouteOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateHttpRouteUpdateHttpRouteOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
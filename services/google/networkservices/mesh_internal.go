@@ -371,6 +371,14 @@ func (c *Client) getMeshRaw(ctx context.Context, r *Mesh) ([]byte, error) {
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Mesh) withDefaultLabels(c *dcl.Config, current map[string]string) *Mesh {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) meshDiffsForRawDesired(ctx context.Context, rawDesired *Mesh, opts ...dcl.ApplyOption) (initial, desired *Mesh, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -388,10 +396,16 @@ func (c *Client) meshDiffsForRawDesired(ctx context.Context, rawDesired *Mesh, o
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetMesh(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Mesh resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Mesh resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Mesh resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Mesh resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -405,6 +419,12 @@ func (c *Client) meshDiffsForRawDesired(ctx context.Context, rawDesired *Mesh, o
 	if err := extractMeshFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeMeshInitialState(rawInitial, rawDesired)
@@ -422,7 +442,7 @@ func (c *Client) meshDiffsForRawDesired(ctx context.Context, rawDesired *Mesh, o
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffMesh(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeMeshInitialState(rawInitial, rawDesired *Mesh) (*Mesh, error) {
@@ -574,7 +594,7 @@ func diffMesh(c *Client, desired, actual *Mesh, opts ...dcl.ApplyOption) ([]*dcl
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateMeshUpdateMeshOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateMeshUpdateMeshOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -411,6 +411,14 @@ func (c *Client) getTcpRouteRaw(ctx context.Context, r *TcpRoute) ([]byte, error
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *TcpRoute) withDefaultLabels(c *dcl.Config, current map[string]string) *TcpRoute {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) tcpRouteDiffsForRawDesired(ctx context.Context, rawDesired *TcpRoute, opts ...dcl.ApplyOption) (initial, desired *TcpRoute, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -428,10 +436,16 @@ func (c *Client) tcpRouteDiffsForRawDesired(ctx context.Context, rawDesired *Tcp
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetTcpRoute(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a TcpRoute resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve TcpRoute resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve TcpRoute resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that TcpRoute resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -445,6 +459,12 @@ func (c *Client) tcpRouteDiffsForRawDesired(ctx context.Context, rawDesired *Tcp
 	if err := extractTcpRouteFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeTcpRouteInitialState(rawInitial, rawDesired)
@@ -462,7 +482,7 @@ func (c *Client) tcpRouteDiffsForRawDesired(ctx context.Context, rawDesired *Tcp
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffTcpRoute(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeTcpRouteInitialState(rawInitial, rawDesired *TcpRoute) (*TcpRoute, error) {
@@ -1133,7 +1153,7 @@ func diffTcpRoute(c *Client, desired, actual *TcpRoute, opts ...dcl.ApplyOption)
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateTcpRouteUpdateTcpRouteOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateTcpRouteUpdateTcpRouteOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -280,8 +280,12 @@ func (l *TlsRouteList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *TlsRouteList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *TlsRouteList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TlsRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -298,7 +302,8 @@ func (l *TlsRouteList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListTlsRoute(ctx context.Context, project, location string) (*TlsRouteList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TlsRoute{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TlsRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListTlsRouteWithMaxResults(ctx, project, location, TlsRouteMaxPage)
@@ -306,7 +311,11 @@ func (c *Client) ListTlsRoute(ctx context.Context, project, location string) (*T
 }
 
 func (c *Client) ListTlsRouteWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*TlsRouteList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListTlsRouteWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListTlsRouteWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*TlsRouteList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TlsRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -314,7 +323,7 @@ func (c *Client) ListTlsRouteWithMaxResults(ctx context.Context, project, locati
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listTlsRoute(ctx, r, "", pageSize)
+	items, token, err := c.listTlsRoute(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -328,7 +337,8 @@ func (c *Client) ListTlsRouteWithMaxResults(ctx context.Context, project, locati
 
 func (c *Client) GetTlsRoute(ctx context.Context, r *TlsRoute) (*TlsRoute, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TlsRoute{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TlsRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -344,7 +354,7 @@ func (c *Client) GetTlsRoute(ctx context.Context, r *TlsRoute) (*TlsRoute, error
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalTlsRoute(b, c, r)
 	if err != nil {
@@ -370,7 +380,8 @@ func (c *Client) GetTlsRoute(ctx context.Context, r *TlsRoute) (*TlsRoute, error
 
 func (c *Client) DeleteTlsRoute(ctx context.Context, r *TlsRoute) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TlsRoute{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TlsRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -378,7 +389,13 @@ func (c *Client) DeleteTlsRoute(ctx context.Context, r *TlsRoute) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting TlsRoute...")
 	deleteOp := deleteTlsRouteOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteTlsRouteAsync(ctx context.Context, r *TlsRoute) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteTlsRoute(ctx, r)
+	})
 }
 
 // DeleteAllTlsRoute deletes all resources that the filter functions returns true on.
@@ -406,10 +423,11 @@ func (c *Client) DeleteAllTlsRoute(ctx context.Context, project, location string
 }
 
 func (c *Client) ApplyTlsRoute(ctx context.Context, rawDesired *TlsRoute, opts ...dcl.ApplyOption) (*TlsRoute, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TlsRoute{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TlsRoute{}).Describe())
 	var resultNewState *TlsRoute
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyTlsRouteHelper(c, ctx, rawDesired, opts...)
@@ -427,6 +445,38 @@ func (c *Client) ApplyTlsRoute(ctx context.Context, rawDesired *TlsRoute, opts .
 	return resultNewState, err
 }
 
+func (c *Client) ApplyTlsRouteAsync(ctx context.Context, rawDesired *TlsRoute, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyTlsRoute(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffTlsRoute returns the field-level differences between rawDesired and the
+// live TlsRoute without modifying it. If the TlsRoute does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffTlsRoute(ctx context.Context, rawDesired *TlsRoute, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&TlsRoute{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&TlsRoute{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractTlsRouteFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.tlsRouteDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("TlsRoute %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyTlsRouteHelper(c *Client, ctx context.Context, rawDesired *TlsRoute, opts ...dcl.ApplyOption) (*TlsRoute, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyTlsRoute...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -435,6 +485,9 @@ func applyTlsRouteHelper(c *Client, ctx context.Context, rawDesired *TlsRoute, o
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractTlsRouteFields(rawDesired); err != nil {
 		return nil, err
@@ -493,7 +546,7 @@ func applyTlsRouteHelper(c *Client, ctx context.Context, rawDesired *TlsRoute, o
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
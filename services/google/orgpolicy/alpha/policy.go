@@ -293,8 +293,12 @@ func (l *PolicyList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *PolicyList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *PolicyList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Policy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -311,6 +315,7 @@ func (l *PolicyList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListPolicy(ctx context.Context, parent string) (*PolicyList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Policy{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -318,7 +323,7 @@ func (c *Client) ListPolicy(ctx context.Context, parent string) (*PolicyList, er
 			Timeout:   0,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Policy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListPolicyWithMaxResults(ctx, parent, PolicyMaxPage)
@@ -326,14 +331,18 @@ func (c *Client) ListPolicy(ctx context.Context, parent string) (*PolicyList, er
 }
 
 func (c *Client) ListPolicyWithMaxResults(ctx context.Context, parent string, pageSize int32) (*PolicyList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListPolicyWithPageToken(ctx, parent, pageSize, "")
+}
+
+func (c *Client) ListPolicyWithPageToken(ctx context.Context, parent string, pageSize int32, pageToken string) (*PolicyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Policy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Policy{
 		Parent: &parent,
 	}
-	items, token, err := c.listPolicy(ctx, r, "", pageSize)
+	items, token, err := c.listPolicy(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -347,6 +356,7 @@ func (c *Client) ListPolicyWithMaxResults(ctx context.Context, parent string, pa
 
 func (c *Client) GetPolicy(ctx context.Context, r *Policy) (*Policy, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Policy{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -354,7 +364,7 @@ func (c *Client) GetPolicy(ctx context.Context, r *Policy) (*Policy, error) {
 			Timeout:   0,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Policy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -370,7 +380,7 @@ func (c *Client) GetPolicy(ctx context.Context, r *Policy) (*Policy, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalPolicy(b, c, r)
 	if err != nil {
@@ -395,6 +405,7 @@ func (c *Client) GetPolicy(ctx context.Context, r *Policy) (*Policy, error) {
 
 func (c *Client) DeletePolicy(ctx context.Context, r *Policy) error {
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Policy{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -402,7 +413,7 @@ func (c *Client) DeletePolicy(ctx context.Context, r *Policy) error {
 			Timeout:   0,
 		},
 	})))
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Policy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -410,7 +421,13 @@ func (c *Client) DeletePolicy(ctx context.Context, r *Policy) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Policy...")
 	deleteOp := deletePolicyOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeletePolicyAsync(ctx context.Context, r *Policy) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeletePolicy(ctx, r)
+	})
 }
 
 // DeleteAllPolicy deletes all resources that the filter functions returns true on.
@@ -438,10 +455,11 @@ func (c *Client) DeleteAllPolicy(ctx context.Context, parent string, filter func
 }
 
 func (c *Client) ApplyPolicy(ctx context.Context, rawDesired *Policy, opts ...dcl.ApplyOption) (*Policy, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Policy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Policy{}).Describe())
 	c = NewClient(c.Config.Clone(dcl.WithCodeRetryability(map[int]dcl.Retryability{
 		403: dcl.Retryability{
 			Retryable: true,
@@ -466,6 +484,38 @@ func (c *Client) ApplyPolicy(ctx context.Context, rawDesired *Policy, opts ...dc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyPolicyAsync(ctx context.Context, rawDesired *Policy, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyPolicy(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffPolicy returns the field-level differences between rawDesired and the
+// live Policy without modifying it. If the Policy does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffPolicy(ctx context.Context, rawDesired *Policy, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Policy{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Policy{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractPolicyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.policyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Policy %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyPolicyHelper(c *Client, ctx context.Context, rawDesired *Policy, opts ...dcl.ApplyOption) (*Policy, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyPolicy...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -474,6 +524,9 @@ func applyPolicyHelper(c *Client, ctx context.Context, rawDesired *Policy, opts
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractPolicyFields(rawDesired); err != nil {
 		return nil, err
@@ -532,7 +585,7 @@ func applyPolicyHelper(c *Client, ctx context.Context, rawDesired *Policy, opts
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
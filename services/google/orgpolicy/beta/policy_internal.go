@@ -356,7 +356,7 @@ func (c *Client) policyDiffsForRawDesired(ctx context.Context, rawDesired *Polic
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Policy resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Policy resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Policy resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Policy resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -370,6 +370,12 @@ func (c *Client) policyDiffsForRawDesired(ctx context.Context, rawDesired *Polic
 	if err := extractPolicyFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizePolicyInitialState(rawInitial, rawDesired)
@@ -387,7 +393,7 @@ func (c *Client) policyDiffsForRawDesired(ctx context.Context, rawDesired *Polic
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffPolicy(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizePolicyInitialState(rawInitial, rawDesired *Policy) (*Policy, error) {
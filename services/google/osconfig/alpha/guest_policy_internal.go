@@ -602,7 +602,7 @@ func (c *Client) guestPolicyDiffsForRawDesired(ctx context.Context, rawDesired *
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a GuestPolicy resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve GuestPolicy resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve GuestPolicy resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that GuestPolicy resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -616,6 +616,12 @@ func (c *Client) guestPolicyDiffsForRawDesired(ctx context.Context, rawDesired *
 	if err := extractGuestPolicyFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeGuestPolicyInitialState(rawInitial, rawDesired)
@@ -633,7 +639,7 @@ func (c *Client) guestPolicyDiffsForRawDesired(ctx context.Context, rawDesired *
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffGuestPolicy(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeGuestPolicyInitialState(rawInitial, rawDesired *GuestPolicy) (*GuestPolicy, error) {
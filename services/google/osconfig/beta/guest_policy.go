@@ -1844,8 +1844,12 @@ func (l *GuestPolicyList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *GuestPolicyList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *GuestPolicyList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GuestPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -1862,7 +1866,8 @@ func (l *GuestPolicyList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListGuestPolicy(ctx context.Context, project string) (*GuestPolicyList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GuestPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GuestPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListGuestPolicyWithMaxResults(ctx, project, GuestPolicyMaxPage)
@@ -1870,14 +1875,18 @@ func (c *Client) ListGuestPolicy(ctx context.Context, project string) (*GuestPol
 }
 
 func (c *Client) ListGuestPolicyWithMaxResults(ctx context.Context, project string, pageSize int32) (*GuestPolicyList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListGuestPolicyWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListGuestPolicyWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*GuestPolicyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GuestPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &GuestPolicy{
 		Project: &project,
 	}
-	items, token, err := c.listGuestPolicy(ctx, r, "", pageSize)
+	items, token, err := c.listGuestPolicy(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -1891,7 +1900,8 @@ func (c *Client) ListGuestPolicyWithMaxResults(ctx context.Context, project stri
 
 func (c *Client) GetGuestPolicy(ctx context.Context, r *GuestPolicy) (*GuestPolicy, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GuestPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GuestPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -1907,7 +1917,7 @@ func (c *Client) GetGuestPolicy(ctx context.Context, r *GuestPolicy) (*GuestPoli
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalGuestPolicy(b, c, r)
 	if err != nil {
@@ -1932,7 +1942,8 @@ func (c *Client) GetGuestPolicy(ctx context.Context, r *GuestPolicy) (*GuestPoli
 
 func (c *Client) DeleteGuestPolicy(ctx context.Context, r *GuestPolicy) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GuestPolicy{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GuestPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -1940,7 +1951,13 @@ func (c *Client) DeleteGuestPolicy(ctx context.Context, r *GuestPolicy) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting GuestPolicy...")
 	deleteOp := deleteGuestPolicyOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteGuestPolicyAsync(ctx context.Context, r *GuestPolicy) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteGuestPolicy(ctx, r)
+	})
 }
 
 // DeleteAllGuestPolicy deletes all resources that the filter functions returns true on.
@@ -1968,10 +1985,11 @@ func (c *Client) DeleteAllGuestPolicy(ctx context.Context, project string, filte
 }
 
 func (c *Client) ApplyGuestPolicy(ctx context.Context, rawDesired *GuestPolicy, opts ...dcl.ApplyOption) (*GuestPolicy, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GuestPolicy{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GuestPolicy{}).Describe())
 	var resultNewState *GuestPolicy
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyGuestPolicyHelper(c, ctx, rawDesired, opts...)
@@ -1989,6 +2007,38 @@ func (c *Client) ApplyGuestPolicy(ctx context.Context, rawDesired *GuestPolicy,
 	return resultNewState, err
 }
 
+func (c *Client) ApplyGuestPolicyAsync(ctx context.Context, rawDesired *GuestPolicy, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyGuestPolicy(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffGuestPolicy returns the field-level differences between rawDesired and the
+// live GuestPolicy without modifying it. If the GuestPolicy does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffGuestPolicy(ctx context.Context, rawDesired *GuestPolicy, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&GuestPolicy{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&GuestPolicy{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractGuestPolicyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.guestPolicyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("GuestPolicy %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyGuestPolicyHelper(c *Client, ctx context.Context, rawDesired *GuestPolicy, opts ...dcl.ApplyOption) (*GuestPolicy, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyGuestPolicy...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -1997,6 +2047,9 @@ func applyGuestPolicyHelper(c *Client, ctx context.Context, rawDesired *GuestPol
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractGuestPolicyFields(rawDesired); err != nil {
 		return nil, err
@@ -2055,7 +2108,7 @@ func applyGuestPolicyHelper(c *Client, ctx context.Context, rawDesired *GuestPol
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
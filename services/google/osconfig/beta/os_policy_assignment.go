@@ -2625,8 +2625,12 @@ func (l *OSPolicyAssignmentList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *OSPolicyAssignmentList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *OSPolicyAssignmentList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OSPolicyAssignment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -2643,7 +2647,8 @@ func (l *OSPolicyAssignmentList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListOSPolicyAssignment(ctx context.Context, project, location string) (*OSPolicyAssignmentList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&OSPolicyAssignment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OSPolicyAssignment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListOSPolicyAssignmentWithMaxResults(ctx, project, location, OSPolicyAssignmentMaxPage)
@@ -2651,7 +2656,11 @@ func (c *Client) ListOSPolicyAssignment(ctx context.Context, project, location s
 }
 
 func (c *Client) ListOSPolicyAssignmentWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*OSPolicyAssignmentList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListOSPolicyAssignmentWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListOSPolicyAssignmentWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*OSPolicyAssignmentList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OSPolicyAssignment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -2659,7 +2668,7 @@ func (c *Client) ListOSPolicyAssignmentWithMaxResults(ctx context.Context, proje
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listOSPolicyAssignment(ctx, r, "", pageSize)
+	items, token, err := c.listOSPolicyAssignment(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -2673,7 +2682,8 @@ func (c *Client) ListOSPolicyAssignmentWithMaxResults(ctx context.Context, proje
 
 func (c *Client) GetOSPolicyAssignment(ctx context.Context, r *OSPolicyAssignment) (*OSPolicyAssignment, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&OSPolicyAssignment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OSPolicyAssignment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -2689,7 +2699,7 @@ func (c *Client) GetOSPolicyAssignment(ctx context.Context, r *OSPolicyAssignmen
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalOSPolicyAssignment(b, c, r)
 	if err != nil {
@@ -2715,7 +2725,8 @@ func (c *Client) GetOSPolicyAssignment(ctx context.Context, r *OSPolicyAssignmen
 
 func (c *Client) DeleteOSPolicyAssignment(ctx context.Context, r *OSPolicyAssignment) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&OSPolicyAssignment{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OSPolicyAssignment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -2723,7 +2734,13 @@ func (c *Client) DeleteOSPolicyAssignment(ctx context.Context, r *OSPolicyAssign
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting OSPolicyAssignment...")
 	deleteOp := deleteOSPolicyAssignmentOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteOSPolicyAssignmentAsync(ctx context.Context, r *OSPolicyAssignment) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteOSPolicyAssignment(ctx, r)
+	})
 }
 
 // DeleteAllOSPolicyAssignment deletes all resources that the filter functions returns true on.
@@ -2751,10 +2768,11 @@ func (c *Client) DeleteAllOSPolicyAssignment(ctx context.Context, project, locat
 }
 
 func (c *Client) ApplyOSPolicyAssignment(ctx context.Context, rawDesired *OSPolicyAssignment, opts ...dcl.ApplyOption) (*OSPolicyAssignment, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OSPolicyAssignment{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&OSPolicyAssignment{}).Describe())
 	var resultNewState *OSPolicyAssignment
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyOSPolicyAssignmentHelper(c, ctx, rawDesired, opts...)
@@ -2772,6 +2790,38 @@ func (c *Client) ApplyOSPolicyAssignment(ctx context.Context, rawDesired *OSPoli
 	return resultNewState, err
 }
 
+func (c *Client) ApplyOSPolicyAssignmentAsync(ctx context.Context, rawDesired *OSPolicyAssignment, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyOSPolicyAssignment(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffOSPolicyAssignment returns the field-level differences between rawDesired and the
+// live OSPolicyAssignment without modifying it. If the OSPolicyAssignment does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffOSPolicyAssignment(ctx context.Context, rawDesired *OSPolicyAssignment, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&OSPolicyAssignment{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&OSPolicyAssignment{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractOSPolicyAssignmentFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.oSPolicyAssignmentDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("OSPolicyAssignment %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyOSPolicyAssignmentHelper(c *Client, ctx context.Context, rawDesired *OSPolicyAssignment, opts ...dcl.ApplyOption) (*OSPolicyAssignment, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyOSPolicyAssignment...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -2780,6 +2830,9 @@ func applyOSPolicyAssignmentHelper(c *Client, ctx context.Context, rawDesired *O
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractOSPolicyAssignmentFields(rawDesired); err != nil {
 		return nil, err
@@ -2838,7 +2891,7 @@ func applyOSPolicyAssignmentHelper(c *Client, ctx context.Context, rawDesired *O
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -795,7 +795,7 @@ func (c *Client) oSPolicyAssignmentDiffsForRawDesired(ctx context.Context, rawDe
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a OSPolicyAssignment resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve OSPolicyAssignment resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve OSPolicyAssignment resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that OSPolicyAssignment resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -809,6 +809,12 @@ func (c *Client) oSPolicyAssignmentDiffsForRawDesired(ctx context.Context, rawDe
 	if err := extractOSPolicyAssignmentFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeOSPolicyAssignmentInitialState(rawInitial, rawDesired)
@@ -826,7 +832,7 @@ func (c *Client) oSPolicyAssignmentDiffsForRawDesired(ctx context.Context, rawDe
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffOSPolicyAssignment(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeOSPolicyAssignmentInitialState(rawInitial, rawDesired *OSPolicyAssignment) (*OSPolicyAssignment, error) {
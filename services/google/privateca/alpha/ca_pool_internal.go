@@ -527,6 +527,14 @@ func (c *Client) getCaPoolRaw(ctx context.Context, r *CaPool) ([]byte, error) {
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *CaPool) withDefaultLabels(c *dcl.Config, current map[string]string) *CaPool {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) caPoolDiffsForRawDesired(ctx context.Context, rawDesired *CaPool, opts ...dcl.ApplyOption) (initial, desired *CaPool, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -544,10 +552,16 @@ func (c *Client) caPoolDiffsForRawDesired(ctx context.Context, rawDesired *CaPoo
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetCaPool(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a CaPool resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve CaPool resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve CaPool resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that CaPool resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -561,6 +575,12 @@ func (c *Client) caPoolDiffsForRawDesired(ctx context.Context, rawDesired *CaPoo
 	if err := extractCaPoolFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeCaPoolInitialState(rawInitial, rawDesired)
@@ -578,7 +598,7 @@ func (c *Client) caPoolDiffsForRawDesired(ctx context.Context, rawDesired *CaPoo
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffCaPool(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeCaPoolInitialState(rawInitial, rawDesired *CaPool) (*CaPool, error) {
@@ -3097,7 +3117,7 @@ func diffCaPool(c *Client, desired, actual *CaPool, opts ...dcl.ApplyOption) ([]
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateCaPoolUpdateCaPoolOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateCaPoolUpdateCaPoolOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
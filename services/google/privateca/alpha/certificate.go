@@ -2036,8 +2036,12 @@ func (l *CertificateList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *CertificateList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *CertificateList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Certificate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -2054,7 +2058,8 @@ func (l *CertificateList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListCertificate(ctx context.Context, project, location, caPool string) (*CertificateList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Certificate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Certificate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListCertificateWithMaxResults(ctx, project, location, caPool, CertificateMaxPage)
@@ -2062,7 +2067,11 @@ func (c *Client) ListCertificate(ctx context.Context, project, location, caPool
 }
 
 func (c *Client) ListCertificateWithMaxResults(ctx context.Context, project, location, caPool string, pageSize int32) (*CertificateList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListCertificateWithPageToken(ctx, project, location, caPool, pageSize, "")
+}
+
+func (c *Client) ListCertificateWithPageToken(ctx context.Context, project, location, caPool string, pageSize int32, pageToken string) (*CertificateList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Certificate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -2071,7 +2080,7 @@ func (c *Client) ListCertificateWithMaxResults(ctx context.Context, project, loc
 		Location: &location,
 		CaPool:   &caPool,
 	}
-	items, token, err := c.listCertificate(ctx, r, "", pageSize)
+	items, token, err := c.listCertificate(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -2085,7 +2094,8 @@ func (c *Client) ListCertificateWithMaxResults(ctx context.Context, project, loc
 
 func (c *Client) GetCertificate(ctx context.Context, r *Certificate) (*Certificate, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Certificate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Certificate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -2101,7 +2111,7 @@ func (c *Client) GetCertificate(ctx context.Context, r *Certificate) (*Certifica
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalCertificate(b, c, r)
 	if err != nil {
@@ -2128,7 +2138,8 @@ func (c *Client) GetCertificate(ctx context.Context, r *Certificate) (*Certifica
 
 func (c *Client) DeleteCertificate(ctx context.Context, r *Certificate) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Certificate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Certificate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -2136,7 +2147,13 @@ func (c *Client) DeleteCertificate(ctx context.Context, r *Certificate) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Certificate...")
 	deleteOp := deleteCertificateOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteCertificateAsync(ctx context.Context, r *Certificate) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteCertificate(ctx, r)
+	})
 }
 
 // DeleteAllCertificate deletes all resources that the filter functions returns true on.
@@ -2164,10 +2181,11 @@ func (c *Client) DeleteAllCertificate(ctx context.Context, project, location, ca
 }
 
 func (c *Client) ApplyCertificate(ctx context.Context, rawDesired *Certificate, opts ...dcl.ApplyOption) (*Certificate, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Certificate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Certificate{}).Describe())
 	var resultNewState *Certificate
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyCertificateHelper(c, ctx, rawDesired, opts...)
@@ -2185,6 +2203,38 @@ func (c *Client) ApplyCertificate(ctx context.Context, rawDesired *Certificate,
 	return resultNewState, err
 }
 
+func (c *Client) ApplyCertificateAsync(ctx context.Context, rawDesired *Certificate, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyCertificate(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffCertificate returns the field-level differences between rawDesired and the
+// live Certificate without modifying it. If the Certificate does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffCertificate(ctx context.Context, rawDesired *Certificate, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Certificate{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Certificate{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractCertificateFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.certificateDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Certificate %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyCertificateHelper(c *Client, ctx context.Context, rawDesired *Certificate, opts ...dcl.ApplyOption) (*Certificate, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyCertificate...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -2193,6 +2243,9 @@ func applyCertificateHelper(c *Client, ctx context.Context, rawDesired *Certific
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractCertificateFields(rawDesired); err != nil {
 		return nil, err
@@ -2251,7 +2304,7 @@ func applyCertificateHelper(c *Client, ctx context.Context, rawDesired *Certific
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -2265,6 +2318,8 @@ func applyCertificateDiff(c *Client, ctx context.Context, desired *Certificate,
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -2333,8 +2333,12 @@ func (l *CertificateAuthorityList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *CertificateAuthorityList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *CertificateAuthorityList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateAuthority{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -2351,7 +2355,8 @@ func (l *CertificateAuthorityList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListCertificateAuthority(ctx context.Context, project, location, caPool string) (*CertificateAuthorityList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CertificateAuthority{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateAuthority{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListCertificateAuthorityWithMaxResults(ctx, project, location, caPool, CertificateAuthorityMaxPage)
@@ -2359,7 +2364,11 @@ func (c *Client) ListCertificateAuthority(ctx context.Context, project, location
 }
 
 func (c *Client) ListCertificateAuthorityWithMaxResults(ctx context.Context, project, location, caPool string, pageSize int32) (*CertificateAuthorityList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListCertificateAuthorityWithPageToken(ctx, project, location, caPool, pageSize, "")
+}
+
+func (c *Client) ListCertificateAuthorityWithPageToken(ctx context.Context, project, location, caPool string, pageSize int32, pageToken string) (*CertificateAuthorityList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateAuthority{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -2368,7 +2377,7 @@ func (c *Client) ListCertificateAuthorityWithMaxResults(ctx context.Context, pro
 		Location: &location,
 		CaPool:   &caPool,
 	}
-	items, token, err := c.listCertificateAuthority(ctx, r, "", pageSize)
+	items, token, err := c.listCertificateAuthority(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -2382,7 +2391,8 @@ func (c *Client) ListCertificateAuthorityWithMaxResults(ctx context.Context, pro
 
 func (c *Client) GetCertificateAuthority(ctx context.Context, r *CertificateAuthority) (*CertificateAuthority, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CertificateAuthority{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateAuthority{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -2398,7 +2408,7 @@ func (c *Client) GetCertificateAuthority(ctx context.Context, r *CertificateAuth
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalCertificateAuthority(b, c, r)
 	if err != nil {
@@ -2425,7 +2435,8 @@ func (c *Client) GetCertificateAuthority(ctx context.Context, r *CertificateAuth
 
 func (c *Client) DeleteCertificateAuthority(ctx context.Context, r *CertificateAuthority) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CertificateAuthority{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateAuthority{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -2433,7 +2444,13 @@ func (c *Client) DeleteCertificateAuthority(ctx context.Context, r *CertificateA
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting CertificateAuthority...")
 	deleteOp := deleteCertificateAuthorityOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteCertificateAuthorityAsync(ctx context.Context, r *CertificateAuthority) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteCertificateAuthority(ctx, r)
+	})
 }
 
 // DeleteAllCertificateAuthority deletes all resources that the filter functions returns true on.
@@ -2461,10 +2478,11 @@ func (c *Client) DeleteAllCertificateAuthority(ctx context.Context, project, loc
 }
 
 func (c *Client) ApplyCertificateAuthority(ctx context.Context, rawDesired *CertificateAuthority, opts ...dcl.ApplyOption) (*CertificateAuthority, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateAuthority{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CertificateAuthority{}).Describe())
 	var resultNewState *CertificateAuthority
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyCertificateAuthorityHelper(c, ctx, rawDesired, opts...)
@@ -2482,6 +2500,38 @@ func (c *Client) ApplyCertificateAuthority(ctx context.Context, rawDesired *Cert
 	return resultNewState, err
 }
 
+func (c *Client) ApplyCertificateAuthorityAsync(ctx context.Context, rawDesired *CertificateAuthority, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyCertificateAuthority(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffCertificateAuthority returns the field-level differences between rawDesired and the
+// live CertificateAuthority without modifying it. If the CertificateAuthority does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffCertificateAuthority(ctx context.Context, rawDesired *CertificateAuthority, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateAuthority{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CertificateAuthority{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractCertificateAuthorityFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.certificateAuthorityDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("CertificateAuthority %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyCertificateAuthorityHelper(c *Client, ctx context.Context, rawDesired *CertificateAuthority, opts ...dcl.ApplyOption) (*CertificateAuthority, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyCertificateAuthority...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -2490,6 +2540,9 @@ func applyCertificateAuthorityHelper(c *Client, ctx context.Context, rawDesired
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractCertificateAuthorityFields(rawDesired); err != nil {
 		return nil, err
@@ -2548,7 +2601,7 @@ func applyCertificateAuthorityHelper(c *Client, ctx context.Context, rawDesired
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -2562,6 +2615,8 @@ func applyCertificateAuthorityDiff(c *Client, ctx context.Context, desired *Cert
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
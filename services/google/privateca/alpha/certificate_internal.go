@@ -593,6 +593,14 @@ func (c *Client) getCertificateRaw(ctx context.Context, r *Certificate) ([]byte,
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Certificate) withDefaultLabels(c *dcl.Config, current map[string]string) *Certificate {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) certificateDiffsForRawDesired(ctx context.Context, rawDesired *Certificate, opts ...dcl.ApplyOption) (initial, desired *Certificate, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -610,10 +618,16 @@ func (c *Client) certificateDiffsForRawDesired(ctx context.Context, rawDesired *
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetCertificate(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Certificate resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Certificate resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Certificate resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Certificate resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -627,6 +641,12 @@ func (c *Client) certificateDiffsForRawDesired(ctx context.Context, rawDesired *
 	if err := extractCertificateFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeCertificateInitialState(rawInitial, rawDesired)
@@ -644,7 +664,7 @@ func (c *Client) certificateDiffsForRawDesired(ctx context.Context, rawDesired *
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffCertificate(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeCertificateInitialState(rawInitial, rawDesired *Certificate) (*Certificate, error) {
@@ -5375,7 +5395,7 @@ func diffCertificate(c *Client, desired, actual *Certificate, opts ...dcl.ApplyO
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateCertificateUpdateCertificateOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateCertificateUpdateCertificateOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
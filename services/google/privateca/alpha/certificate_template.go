@@ -796,8 +796,12 @@ func (l *CertificateTemplateList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *CertificateTemplateList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *CertificateTemplateList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -814,7 +818,8 @@ func (l *CertificateTemplateList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListCertificateTemplate(ctx context.Context, project, location string) (*CertificateTemplateList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CertificateTemplate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListCertificateTemplateWithMaxResults(ctx, project, location, CertificateTemplateMaxPage)
@@ -822,7 +827,11 @@ func (c *Client) ListCertificateTemplate(ctx context.Context, project, location
 }
 
 func (c *Client) ListCertificateTemplateWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*CertificateTemplateList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListCertificateTemplateWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListCertificateTemplateWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*CertificateTemplateList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -830,7 +839,7 @@ func (c *Client) ListCertificateTemplateWithMaxResults(ctx context.Context, proj
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listCertificateTemplate(ctx, r, "", pageSize)
+	items, token, err := c.listCertificateTemplate(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -844,7 +853,8 @@ func (c *Client) ListCertificateTemplateWithMaxResults(ctx context.Context, proj
 
 func (c *Client) GetCertificateTemplate(ctx context.Context, r *CertificateTemplate) (*CertificateTemplate, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CertificateTemplate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -860,7 +870,7 @@ func (c *Client) GetCertificateTemplate(ctx context.Context, r *CertificateTempl
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalCertificateTemplate(b, c, r)
 	if err != nil {
@@ -886,7 +896,8 @@ func (c *Client) GetCertificateTemplate(ctx context.Context, r *CertificateTempl
 
 func (c *Client) DeleteCertificateTemplate(ctx context.Context, r *CertificateTemplate) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CertificateTemplate{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -894,7 +905,13 @@ func (c *Client) DeleteCertificateTemplate(ctx context.Context, r *CertificateTe
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting CertificateTemplate...")
 	deleteOp := deleteCertificateTemplateOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteCertificateTemplateAsync(ctx context.Context, r *CertificateTemplate) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteCertificateTemplate(ctx, r)
+	})
 }
 
 // DeleteAllCertificateTemplate deletes all resources that the filter functions returns true on.
@@ -922,10 +939,11 @@ func (c *Client) DeleteAllCertificateTemplate(ctx context.Context, project, loca
 }
 
 func (c *Client) ApplyCertificateTemplate(ctx context.Context, rawDesired *CertificateTemplate, opts ...dcl.ApplyOption) (*CertificateTemplate, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateTemplate{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CertificateTemplate{}).Describe())
 	var resultNewState *CertificateTemplate
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyCertificateTemplateHelper(c, ctx, rawDesired, opts...)
@@ -943,6 +961,38 @@ func (c *Client) ApplyCertificateTemplate(ctx context.Context, rawDesired *Certi
 	return resultNewState, err
 }
 
+func (c *Client) ApplyCertificateTemplateAsync(ctx context.Context, rawDesired *CertificateTemplate, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyCertificateTemplate(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffCertificateTemplate returns the field-level differences between rawDesired and the
+// live CertificateTemplate without modifying it. If the CertificateTemplate does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffCertificateTemplate(ctx context.Context, rawDesired *CertificateTemplate, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CertificateTemplate{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CertificateTemplate{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractCertificateTemplateFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.certificateTemplateDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("CertificateTemplate %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyCertificateTemplateHelper(c *Client, ctx context.Context, rawDesired *CertificateTemplate, opts ...dcl.ApplyOption) (*CertificateTemplate, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyCertificateTemplate...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -951,6 +1001,9 @@ func applyCertificateTemplateHelper(c *Client, ctx context.Context, rawDesired *
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractCertificateTemplateFields(rawDesired); err != nil {
 		return nil, err
@@ -1009,7 +1062,7 @@ func applyCertificateTemplateHelper(c *Client, ctx context.Context, rawDesired *
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -1023,6 +1076,8 @@ func applyCertificateTemplateDiff(c *Client, ctx context.Context, desired *Certi
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -716,6 +716,14 @@ func (c *Client) getCertificateAuthorityRaw(ctx context.Context, r *CertificateA
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *CertificateAuthority) withDefaultLabels(c *dcl.Config, current map[string]string) *CertificateAuthority {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) certificateAuthorityDiffsForRawDesired(ctx context.Context, rawDesired *CertificateAuthority, opts ...dcl.ApplyOption) (initial, desired *CertificateAuthority, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -733,10 +741,16 @@ func (c *Client) certificateAuthorityDiffsForRawDesired(ctx context.Context, raw
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetCertificateAuthority(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a CertificateAuthority resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve CertificateAuthority resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve CertificateAuthority resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that CertificateAuthority resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -750,6 +764,12 @@ func (c *Client) certificateAuthorityDiffsForRawDesired(ctx context.Context, raw
 	if err := extractCertificateAuthorityFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeCertificateAuthorityInitialState(rawInitial, rawDesired)
@@ -767,7 +787,7 @@ func (c *Client) certificateAuthorityDiffsForRawDesired(ctx context.Context, raw
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffCertificateAuthority(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeCertificateAuthorityInitialState(rawInitial, rawDesired *CertificateAuthority) (*CertificateAuthority, error) {
@@ -6071,7 +6091,7 @@ func diffCertificateAuthority(c *Client, desired, actual *CertificateAuthority,
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateCertificateAuthorityUpdateCertificateAuthorityOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateCertificateAuthorityUpdateCertificateAuthorityOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
@@ -1147,8 +1147,12 @@ func (l *CaPoolList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *CaPoolList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *CaPoolList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CaPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -1165,7 +1169,8 @@ func (l *CaPoolList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListCaPool(ctx context.Context, project, location string) (*CaPoolList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CaPool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CaPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListCaPoolWithMaxResults(ctx, project, location, CaPoolMaxPage)
@@ -1173,7 +1178,11 @@ func (c *Client) ListCaPool(ctx context.Context, project, location string) (*CaP
 }
 
 func (c *Client) ListCaPoolWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*CaPoolList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListCaPoolWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListCaPoolWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*CaPoolList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CaPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -1181,7 +1190,7 @@ func (c *Client) ListCaPoolWithMaxResults(ctx context.Context, project, location
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listCaPool(ctx, r, "", pageSize)
+	items, token, err := c.listCaPool(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -1195,7 +1204,8 @@ func (c *Client) ListCaPoolWithMaxResults(ctx context.Context, project, location
 
 func (c *Client) GetCaPool(ctx context.Context, r *CaPool) (*CaPool, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CaPool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CaPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -1211,7 +1221,7 @@ func (c *Client) GetCaPool(ctx context.Context, r *CaPool) (*CaPool, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalCaPool(b, c, r)
 	if err != nil {
@@ -1237,7 +1247,8 @@ func (c *Client) GetCaPool(ctx context.Context, r *CaPool) (*CaPool, error) {
 
 func (c *Client) DeleteCaPool(ctx context.Context, r *CaPool) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CaPool{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CaPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -1245,7 +1256,13 @@ func (c *Client) DeleteCaPool(ctx context.Context, r *CaPool) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting CaPool...")
 	deleteOp := deleteCaPoolOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteCaPoolAsync(ctx context.Context, r *CaPool) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteCaPool(ctx, r)
+	})
 }
 
 // DeleteAllCaPool deletes all resources that the filter functions returns true on.
@@ -1273,10 +1290,11 @@ func (c *Client) DeleteAllCaPool(ctx context.Context, project, location string,
 }
 
 func (c *Client) ApplyCaPool(ctx context.Context, rawDesired *CaPool, opts ...dcl.ApplyOption) (*CaPool, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CaPool{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CaPool{}).Describe())
 	var resultNewState *CaPool
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyCaPoolHelper(c, ctx, rawDesired, opts...)
@@ -1294,6 +1312,38 @@ func (c *Client) ApplyCaPool(ctx context.Context, rawDesired *CaPool, opts ...dc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyCaPoolAsync(ctx context.Context, rawDesired *CaPool, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyCaPool(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffCaPool returns the field-level differences between rawDesired and the
+// live CaPool without modifying it. If the CaPool does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffCaPool(ctx context.Context, rawDesired *CaPool, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&CaPool{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&CaPool{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractCaPoolFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.caPoolDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("CaPool %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyCaPoolHelper(c *Client, ctx context.Context, rawDesired *CaPool, opts ...dcl.ApplyOption) (*CaPool, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyCaPool...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -1302,6 +1352,9 @@ func applyCaPoolHelper(c *Client, ctx context.Context, rawDesired *CaPool, opts
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractCaPoolFields(rawDesired); err != nil {
 		return nil, err
@@ -1360,7 +1413,7 @@ func applyCaPoolHelper(c *Client, ctx context.Context, rawDesired *CaPool, opts
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -1374,6 +1427,8 @@ func applyCaPoolDiff(c *Client, ctx context.Context, desired *CaPool, rawDesired
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
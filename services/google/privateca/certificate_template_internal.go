@@ -486,6 +486,14 @@ func (c *Client) getCertificateTemplateRaw(ctx context.Context, r *CertificateTe
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *CertificateTemplate) withDefaultLabels(c *dcl.Config, current map[string]string) *CertificateTemplate {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) certificateTemplateDiffsForRawDesired(ctx context.Context, rawDesired *CertificateTemplate, opts ...dcl.ApplyOption) (initial, desired *CertificateTemplate, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -503,10 +511,16 @@ func (c *Client) certificateTemplateDiffsForRawDesired(ctx context.Context, rawD
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetCertificateTemplate(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a CertificateTemplate resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve CertificateTemplate resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve CertificateTemplate resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that CertificateTemplate resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -520,6 +534,12 @@ func (c *Client) certificateTemplateDiffsForRawDesired(ctx context.Context, rawD
 	if err := extractCertificateTemplateFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeCertificateTemplateInitialState(rawInitial, rawDesired)
@@ -537,7 +557,7 @@ func (c *Client) certificateTemplateDiffsForRawDesired(ctx context.Context, rawD
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffCertificateTemplate(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeCertificateTemplateInitialState(rawInitial, rawDesired *CertificateTemplate) (*CertificateTemplate, error) {
@@ -2365,7 +2385,7 @@ func diffCertificateTemplate(c *Client, desired, actual *CertificateTemplate, op
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateCertificateTemplateUpdateCertificateTemplateOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateCertificateTemplateUpdateCertificateTemplateOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
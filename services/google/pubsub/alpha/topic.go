@@ -123,8 +123,12 @@ func (l *TopicList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *TopicList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *TopicList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Topic{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -141,7 +145,8 @@ func (l *TopicList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListTopic(ctx context.Context, project string) (*TopicList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Topic{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Topic{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListTopicWithMaxResults(ctx, project, TopicMaxPage)
@@ -149,14 +154,18 @@ func (c *Client) ListTopic(ctx context.Context, project string) (*TopicList, err
 }
 
 func (c *Client) ListTopicWithMaxResults(ctx context.Context, project string, pageSize int32) (*TopicList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListTopicWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListTopicWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*TopicList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Topic{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Topic{
 		Project: &project,
 	}
-	items, token, err := c.listTopic(ctx, r, "", pageSize)
+	items, token, err := c.listTopic(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +179,8 @@ func (c *Client) ListTopicWithMaxResults(ctx context.Context, project string, pa
 
 func (c *Client) GetTopic(ctx context.Context, r *Topic) (*Topic, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Topic{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Topic{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -186,7 +196,7 @@ func (c *Client) GetTopic(ctx context.Context, r *Topic) (*Topic, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalTopic(b, c, r)
 	if err != nil {
@@ -211,7 +221,8 @@ func (c *Client) GetTopic(ctx context.Context, r *Topic) (*Topic, error) {
 
 func (c *Client) DeleteTopic(ctx context.Context, r *Topic) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Topic{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Topic{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -219,7 +230,13 @@ func (c *Client) DeleteTopic(ctx context.Context, r *Topic) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Topic...")
 	deleteOp := deleteTopicOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteTopicAsync(ctx context.Context, r *Topic) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteTopic(ctx, r)
+	})
 }
 
 // DeleteAllTopic deletes all resources that the filter functions returns true on.
@@ -247,10 +264,11 @@ func (c *Client) DeleteAllTopic(ctx context.Context, project string, filter func
 }
 
 func (c *Client) ApplyTopic(ctx context.Context, rawDesired *Topic, opts ...dcl.ApplyOption) (*Topic, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Topic{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Topic{}).Describe())
 	var resultNewState *Topic
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyTopicHelper(c, ctx, rawDesired, opts...)
@@ -268,6 +286,38 @@ func (c *Client) ApplyTopic(ctx context.Context, rawDesired *Topic, opts ...dcl.
 	return resultNewState, err
 }
 
+func (c *Client) ApplyTopicAsync(ctx context.Context, rawDesired *Topic, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyTopic(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffTopic returns the field-level differences between rawDesired and the
+// live Topic without modifying it. If the Topic does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffTopic(ctx context.Context, rawDesired *Topic, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Topic{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Topic{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractTopicFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.topicDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Topic %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyTopicHelper(c *Client, ctx context.Context, rawDesired *Topic, opts ...dcl.ApplyOption) (*Topic, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyTopic...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -276,6 +326,9 @@ func applyTopicHelper(c *Client, ctx context.Context, rawDesired *Topic, opts ..
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractTopicFields(rawDesired); err != nil {
 		return nil, err
@@ -334,7 +387,7 @@ func applyTopicHelper(c *Client, ctx context.Context, rawDesired *Topic, opts ..
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -348,6 +401,8 @@ func applyTopicDiff(c *Client, ctx context.Context, desired *Topic, rawDesired *
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
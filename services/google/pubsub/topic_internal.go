@@ -369,6 +369,14 @@ func (c *Client) getTopicRaw(ctx context.Context, r *Topic) ([]byte, error) {
 	return b, nil
 }
 
+// withDefaultLabels returns a copy of r whose labels are merged with the labels set with
+// dcl.WithDefaultLabels and the current labels, which are nil if the resource does not exist.
+func (r *Topic) withDefaultLabels(c *dcl.Config, current map[string]string) *Topic {
+	nr := *r
+	nr.Labels = dcl.MergeDefaultLabels(c, r.Labels, current)
+	return &nr
+}
+
 func (c *Client) topicDiffsForRawDesired(ctx context.Context, rawDesired *Topic, opts ...dcl.ApplyOption) (initial, desired *Topic, diffs []*dcl.FieldDiff, err error) {
 	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
 	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
@@ -386,10 +394,16 @@ func (c *Client) topicDiffsForRawDesired(ctx context.Context, rawDesired *Topic,
 
 	// 1.2: Retrieval of raw initial state from API
 	rawInitial, err := c.GetTopic(ctx, fetchState)
+	// Merge the labels set with dcl.WithDefaultLabels into a copy of the desired state.
+	if rawInitial != nil {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, rawInitial.Labels)
+	} else {
+		rawDesired = rawDesired.withDefaultLabels(c.Config, nil)
+	}
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Topic resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Topic resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Topic resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Topic resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -403,6 +417,12 @@ func (c *Client) topicDiffsForRawDesired(ctx context.Context, rawDesired *Topic,
 	if err := extractTopicFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeTopicInitialState(rawInitial, rawDesired)
@@ -420,7 +440,7 @@ func (c *Client) topicDiffsForRawDesired(ctx context.Context, rawDesired *Topic,
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffTopic(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeTopicInitialState(rawInitial, rawDesired *Topic) (*Topic, error) {
@@ -646,7 +666,7 @@ func diffTopic(c *Client, desired, actual *Topic, opts ...dcl.ApplyOption) ([]*d
 		newDiffs = append(newDiffs, ds...)
 	}
 
-	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateTopicUpdateOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{IgnoredPrefixes: dcl.ServerLabelPrefixes, OperationSelector: dcl.TriggersOperation("updateTopicUpdateOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
 		if err != nil {
 			return nil, err
 		}
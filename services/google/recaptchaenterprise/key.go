@@ -371,8 +371,12 @@ func (l *KeyList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *KeyList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *KeyList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -389,7 +393,8 @@ func (l *KeyList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListKey(ctx context.Context, project string) (*KeyList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Key{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListKeyWithMaxResults(ctx, project, KeyMaxPage)
@@ -397,14 +402,18 @@ func (c *Client) ListKey(ctx context.Context, project string) (*KeyList, error)
 }
 
 func (c *Client) ListKeyWithMaxResults(ctx context.Context, project string, pageSize int32) (*KeyList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListKeyWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListKeyWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*KeyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Key{
 		Project: &project,
 	}
-	items, token, err := c.listKey(ctx, r, "", pageSize)
+	items, token, err := c.listKey(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -418,7 +427,8 @@ func (c *Client) ListKeyWithMaxResults(ctx context.Context, project string, page
 
 func (c *Client) GetKey(ctx context.Context, r *Key) (*Key, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Key{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -434,7 +444,7 @@ func (c *Client) GetKey(ctx context.Context, r *Key) (*Key, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalKey(b, c, r)
 	if err != nil {
@@ -459,7 +469,8 @@ func (c *Client) GetKey(ctx context.Context, r *Key) (*Key, error) {
 
 func (c *Client) DeleteKey(ctx context.Context, r *Key) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Key{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -467,7 +478,13 @@ func (c *Client) DeleteKey(ctx context.Context, r *Key) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Key...")
 	deleteOp := deleteKeyOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteKeyAsync(ctx context.Context, r *Key) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteKey(ctx, r)
+	})
 }
 
 // DeleteAllKey deletes all resources that the filter functions returns true on.
@@ -495,10 +512,11 @@ func (c *Client) DeleteAllKey(ctx context.Context, project string, filter func(*
 }
 
 func (c *Client) ApplyKey(ctx context.Context, rawDesired *Key, opts ...dcl.ApplyOption) (*Key, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Key{}).Describe())
 	var resultNewState *Key
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyKeyHelper(c, ctx, rawDesired, opts...)
@@ -516,6 +534,38 @@ func (c *Client) ApplyKey(ctx context.Context, rawDesired *Key, opts ...dcl.Appl
 	return resultNewState, err
 }
 
+func (c *Client) ApplyKeyAsync(ctx context.Context, rawDesired *Key, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyKey(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffKey returns the field-level differences between rawDesired and the
+// live Key without modifying it. If the Key does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffKey(ctx context.Context, rawDesired *Key, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Key{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Key{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractKeyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.keyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Key %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyKeyHelper(c *Client, ctx context.Context, rawDesired *Key, opts ...dcl.ApplyOption) (*Key, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyKey...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -524,6 +574,9 @@ func applyKeyHelper(c *Client, ctx context.Context, rawDesired *Key, opts ...dcl
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired.withDefaultLabels(c.Config, nil), opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractKeyFields(rawDesired); err != nil {
 		return nil, err
@@ -582,7 +635,7 @@ func applyKeyHelper(c *Client, ctx context.Context, rawDesired *Key, opts ...dcl
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -596,6 +649,8 @@ func applyKeyDiff(c *Client, ctx context.Context, desired *Key, rawDesired *Key,
 	if err != nil {
 		return nil, err
 	}
+	// Compare against the desired state with the labels set with dcl.WithDefaultLabels.
+	rawDesired = rawDesired.withDefaultLabels(c.Config, rawNew.Labels)
 	// Get additional values from the first response.
 	// These values should be merged into the newState above.
 	if len(ops) > 0 {
@@ -0,0 +1,876 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package beta
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"google.golang.org/api/googleapi"
+)
+
+type Instance struct {
+	Name                   *string                            `json:"name"`
+	DisplayName            *string                            `json:"displayName"`
+	Labels                 map[string]string                  `json:"labels"`
+	LocationId             *string                            `json:"locationId"`
+	AlternativeLocationId  *string                            `json:"alternativeLocationId"`
+	RedisVersion           *string                            `json:"redisVersion"`
+	ReservedIPRange        *string                            `json:"reservedIpRange"`
+	Host                   *string                            `json:"host"`
+	Port                   *int64                             `json:"port"`
+	CurrentLocationId      *string                            `json:"currentLocationId"`
+	CreateTime             *string                            `json:"createTime"`
+	State                  *InstanceStateEnum                 `json:"state"`
+	StatusMessage          *string                            `json:"statusMessage"`
+	RedisConfigs           map[string]string                  `json:"redisConfigs"`
+	Tier                   *InstanceTierEnum                  `json:"tier"`
+	MemorySizeGb           *int64                             `json:"memorySizeGb"`
+	AuthorizedNetwork      *string                            `json:"authorizedNetwork"`
+	PersistenceIamIdentity *string                            `json:"persistenceIamIdentity"`
+	ConnectMode            *InstanceConnectModeEnum           `json:"connectMode"`
+	AuthEnabled            *bool                              `json:"authEnabled"`
+	ServerCaCerts          []InstanceServerCaCerts            `json:"serverCaCerts"`
+	TransitEncryptionMode  *InstanceTransitEncryptionModeEnum `json:"transitEncryptionMode"`
+	MaintenancePolicy      *InstanceMaintenancePolicy         `json:"maintenancePolicy"`
+	MaintenanceSchedule    *InstanceMaintenanceSchedule       `json:"maintenanceSchedule"`
+	Project                *string                            `json:"project"`
+	Location               *string                            `json:"location"`
+}
+
+func (r *Instance) String() string {
+	return dcl.SprintResource(r)
+}
+
+// The enum InstanceConnectModeEnum.
+type InstanceConnectModeEnum string
+
+// InstanceConnectModeEnumRef returns a *InstanceConnectModeEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceConnectModeEnumRef(s string) *InstanceConnectModeEnum {
+	v := InstanceConnectModeEnum(s)
+	return &v
+}
+
+func (v InstanceConnectModeEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"CONNECT_MODE_UNSPECIFIED", "DIRECT_PEERING", "PRIVATE_SERVICE_ACCESS"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceConnectModeEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum.
+type InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum string
+
+// InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnumRef returns a *InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnumRef(s string) *InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum {
+	v := InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum(s)
+	return &v
+}
+
+func (v InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"DAY_OF_WEEK_UNSPECIFIED", "MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum InstanceStateEnum.
+type InstanceStateEnum string
+
+// InstanceStateEnumRef returns a *InstanceStateEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceStateEnumRef(s string) *InstanceStateEnum {
+	v := InstanceStateEnum(s)
+	return &v
+}
+
+func (v InstanceStateEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"STATE_UNSPECIFIED", "CREATING", "READY", "UPDATING", "DELETING", "REPAIRING", "PERFORMING_MAINTENANCE", "IMPORTING", "FAILING_OVER"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceStateEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum InstanceTierEnum.
+type InstanceTierEnum string
+
+// InstanceTierEnumRef returns a *InstanceTierEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceTierEnumRef(s string) *InstanceTierEnum {
+	v := InstanceTierEnum(s)
+	return &v
+}
+
+func (v InstanceTierEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"TIER_UNSPECIFIED", "BASIC", "STANDARD_HA"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceTierEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum InstanceTransitEncryptionModeEnum.
+type InstanceTransitEncryptionModeEnum string
+
+// InstanceTransitEncryptionModeEnumRef returns a *InstanceTransitEncryptionModeEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceTransitEncryptionModeEnumRef(s string) *InstanceTransitEncryptionModeEnum {
+	v := InstanceTransitEncryptionModeEnum(s)
+	return &v
+}
+
+func (v InstanceTransitEncryptionModeEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"TRANSIT_ENCRYPTION_MODE_UNSPECIFIED", "SERVER_AUTHENTICATION", "DISABLED"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceTransitEncryptionModeEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+type InstanceServerCaCerts struct {
+	empty           bool    `json:"-"`
+	SerialNumber    *string `json:"serialNumber"`
+	Cert            *string `json:"cert"`
+	CreateTime      *string `json:"createTime"`
+	ExpireTime      *string `json:"expireTime"`
+	Sha1Fingerprint *string `json:"sha1Fingerprint"`
+}
+
+type jsonInstanceServerCaCerts InstanceServerCaCerts
+
+func (r *InstanceServerCaCerts) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceServerCaCerts
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceServerCaCerts
+	} else {
+
+		r.SerialNumber = res.SerialNumber
+
+		r.Cert = res.Cert
+
+		r.CreateTime = res.CreateTime
+
+		r.ExpireTime = res.ExpireTime
+
+		r.Sha1Fingerprint = res.Sha1Fingerprint
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceServerCaCerts is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceServerCaCerts *InstanceServerCaCerts = &InstanceServerCaCerts{empty: true}
+
+func (r *InstanceServerCaCerts) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceServerCaCerts) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceServerCaCerts) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceMaintenancePolicy struct {
+	empty                   bool                                               `json:"-"`
+	CreateTime              *string                                            `json:"createTime"`
+	UpdateTime              *string                                            `json:"updateTime"`
+	Description             *string                                            `json:"description"`
+	WeeklyMaintenanceWindow []InstanceMaintenancePolicyWeeklyMaintenanceWindow `json:"weeklyMaintenanceWindow"`
+}
+
+type jsonInstanceMaintenancePolicy InstanceMaintenancePolicy
+
+func (r *InstanceMaintenancePolicy) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceMaintenancePolicy
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceMaintenancePolicy
+	} else {
+
+		r.CreateTime = res.CreateTime
+
+		r.UpdateTime = res.UpdateTime
+
+		r.Description = res.Description
+
+		r.WeeklyMaintenanceWindow = res.WeeklyMaintenanceWindow
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceMaintenancePolicy is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceMaintenancePolicy *InstanceMaintenancePolicy = &InstanceMaintenancePolicy{empty: true}
+
+func (r *InstanceMaintenancePolicy) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceMaintenancePolicy) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceMaintenancePolicy) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceMaintenancePolicyWeeklyMaintenanceWindow struct {
+	empty     bool                                                       `json:"-"`
+	Day       *InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum   `json:"day"`
+	StartTime *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime `json:"startTime"`
+	Duration  *string                                                    `json:"duration"`
+}
+
+type jsonInstanceMaintenancePolicyWeeklyMaintenanceWindow InstanceMaintenancePolicyWeeklyMaintenanceWindow
+
+func (r *InstanceMaintenancePolicyWeeklyMaintenanceWindow) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceMaintenancePolicyWeeklyMaintenanceWindow
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceMaintenancePolicyWeeklyMaintenanceWindow
+	} else {
+
+		r.Day = res.Day
+
+		r.StartTime = res.StartTime
+
+		r.Duration = res.Duration
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceMaintenancePolicyWeeklyMaintenanceWindow is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceMaintenancePolicyWeeklyMaintenanceWindow *InstanceMaintenancePolicyWeeklyMaintenanceWindow = &InstanceMaintenancePolicyWeeklyMaintenanceWindow{empty: true}
+
+func (r *InstanceMaintenancePolicyWeeklyMaintenanceWindow) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceMaintenancePolicyWeeklyMaintenanceWindow) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceMaintenancePolicyWeeklyMaintenanceWindow) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime struct {
+	empty   bool   `json:"-"`
+	Hours   *int64 `json:"hours"`
+	Minutes *int64 `json:"minutes"`
+	Seconds *int64 `json:"seconds"`
+	Nanos   *int64 `json:"nanos"`
+}
+
+type jsonInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime
+
+func (r *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime
+	} else {
+
+		r.Hours = res.Hours
+
+		r.Minutes = res.Minutes
+
+		r.Seconds = res.Seconds
+
+		r.Nanos = res.Nanos
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime = &InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime{empty: true}
+
+func (r *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceMaintenanceSchedule struct {
+	empty                bool    `json:"-"`
+	StartTime            *string `json:"startTime"`
+	EndTime              *string `json:"endTime"`
+	CanReschedule        *bool   `json:"canReschedule"`
+	ScheduleDeadlineTime *string `json:"scheduleDeadlineTime"`
+}
+
+type jsonInstanceMaintenanceSchedule InstanceMaintenanceSchedule
+
+func (r *InstanceMaintenanceSchedule) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceMaintenanceSchedule
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceMaintenanceSchedule
+	} else {
+
+		r.StartTime = res.StartTime
+
+		r.EndTime = res.EndTime
+
+		r.CanReschedule = res.CanReschedule
+
+		r.ScheduleDeadlineTime = res.ScheduleDeadlineTime
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceMaintenanceSchedule is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceMaintenanceSchedule *InstanceMaintenanceSchedule = &InstanceMaintenanceSchedule{empty: true}
+
+func (r *InstanceMaintenanceSchedule) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceMaintenanceSchedule) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceMaintenanceSchedule) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+// Describe returns a simple description of this resource to ensure that automated tools
+// can identify it.
+func (r *Instance) Describe() dcl.ServiceTypeVersion {
+	return dcl.ServiceTypeVersion{
+		Service: "redis",
+		Type:    "Instance",
+		Version: "beta",
+	}
+}
+
+func (r *Instance) ID() (string, error) {
+	if err := extractInstanceFields(r); err != nil {
+		return "", err
+	}
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"name":                     dcl.ValueOrEmptyString(nr.Name),
+		"display_name":             dcl.ValueOrEmptyString(nr.DisplayName),
+		"labels":                   dcl.ValueOrEmptyString(nr.Labels),
+		"location_id":              dcl.ValueOrEmptyString(nr.LocationId),
+		"alternative_location_id":  dcl.ValueOrEmptyString(nr.AlternativeLocationId),
+		"redis_version":            dcl.ValueOrEmptyString(nr.RedisVersion),
+		"reserved_ip_range":        dcl.ValueOrEmptyString(nr.ReservedIPRange),
+		"host":                     dcl.ValueOrEmptyString(nr.Host),
+		"port":                     dcl.ValueOrEmptyString(nr.Port),
+		"current_location_id":      dcl.ValueOrEmptyString(nr.CurrentLocationId),
+		"create_time":              dcl.ValueOrEmptyString(nr.CreateTime),
+		"state":                    dcl.ValueOrEmptyString(nr.State),
+		"status_message":           dcl.ValueOrEmptyString(nr.StatusMessage),
+		"redis_configs":            dcl.ValueOrEmptyString(nr.RedisConfigs),
+		"tier":                     dcl.ValueOrEmptyString(nr.Tier),
+		"memory_size_gb":           dcl.ValueOrEmptyString(nr.MemorySizeGb),
+		"authorized_network":       dcl.ValueOrEmptyString(nr.AuthorizedNetwork),
+		"persistence_iam_identity": dcl.ValueOrEmptyString(nr.PersistenceIamIdentity),
+		"connect_mode":             dcl.ValueOrEmptyString(nr.ConnectMode),
+		"auth_enabled":             dcl.ValueOrEmptyString(nr.AuthEnabled),
+		"server_ca_certs":          dcl.ValueOrEmptyString(nr.ServerCaCerts),
+		"transit_encryption_mode":  dcl.ValueOrEmptyString(nr.TransitEncryptionMode),
+		"maintenance_policy":       dcl.ValueOrEmptyString(nr.MaintenancePolicy),
+		"maintenance_schedule":     dcl.ValueOrEmptyString(nr.MaintenanceSchedule),
+		"project":                  dcl.ValueOrEmptyString(nr.Project),
+		"location":                 dcl.ValueOrEmptyString(nr.Location),
+	}
+	return dcl.Nprintf("projects/{{project}}/locations/{{location}}/instances/{{name}}", params), nil
+}
+
+const InstanceMaxPage = -1
+
+type InstanceList struct {
+	Items []*Instance
+
+	nextToken string
+
+	pageSize int32
+
+	resource *Instance
+}
+
+func (l *InstanceList) HasNext() bool {
+	return l.nextToken != ""
+}
+
+func (l *InstanceList) NextPageToken() string {
+	return l.nextToken
+}
+
+func (l *InstanceList) Next(ctx context.Context, c *Client) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if !l.HasNext() {
+		return fmt.Errorf("no next page")
+	}
+	items, token, err := c.listInstance(ctx, l.resource, l.nextToken, l.pageSize)
+	if err != nil {
+		return err
+	}
+	l.Items = items
+	l.nextToken = token
+	return err
+}
+
+func (c *Client) ListInstance(ctx context.Context, project, location string) (*InstanceList, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	return c.ListInstanceWithMaxResults(ctx, project, location, InstanceMaxPage)
+
+}
+
+func (c *Client) ListInstanceWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*InstanceList, error) {
+	return c.ListInstanceWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListInstanceWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*InstanceList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// Create a resource object so that we can use proper url normalization methods.
+	r := &Instance{
+		Project:  &project,
+		Location: &location,
+	}
+	items, token, err := c.listInstance(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &InstanceList{
+		Items:     items,
+		nextToken: token,
+		pageSize:  pageSize,
+		resource:  r,
+	}, nil
+}
+
+func (c *Client) GetInstance(ctx context.Context, r *Instance) (*Instance, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// This is *purposefully* supressing errors.
+	// This function is used with url-normalized values + not URL normalized values.
+	// URL Normalized values will throw unintentional errors, since those values are not of the proper parent form.
+	extractInstanceFields(r)
+
+	b, err := c.getInstanceRaw(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			return nil, &googleapi.Error{
+				Code:    404,
+				Message: err.Error(),
+			}
+		}
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
+	}
+	result, err := unmarshalInstance(b, c, r)
+	if err != nil {
+		return nil, err
+	}
+	result.Project = r.Project
+	result.Location = r.Location
+	result.Name = r.Name
+
+	c.Config.Logger.InfoWithContextf(ctx, "Retrieved raw result state: %v", result)
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with specified state: %v", r)
+	result, err = canonicalizeInstanceNewState(c, result, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := postReadExtractInstanceFields(result); err != nil {
+		return result, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created result state: %v", result)
+
+	return result, nil
+}
+
+func (c *Client) DeleteInstance(ctx context.Context, r *Instance) error {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if r == nil {
+		return fmt.Errorf("Instance resource is nil")
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Deleting Instance...")
+	deleteOp := deleteInstanceOperation{}
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteInstanceAsync(ctx context.Context, r *Instance) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteInstance(ctx, r)
+	})
+}
+
+// DeleteAllInstance deletes all resources that the filter functions returns true on.
+func (c *Client) DeleteAllInstance(ctx context.Context, project, location string, filter func(*Instance) bool) error {
+	listObj, err := c.ListInstance(ctx, project, location)
+	if err != nil {
+		return err
+	}
+
+	err = c.deleteAllInstance(ctx, filter, listObj.Items)
+	if err != nil {
+		return err
+	}
+	for listObj.HasNext() {
+		err = listObj.Next(ctx, c)
+		if err != nil {
+			return nil
+		}
+		err = c.deleteAllInstance(ctx, filter, listObj.Items)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) ApplyInstance(ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) (*Instance, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	var resultNewState *Instance
+	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		newState, err := applyInstanceHelper(c, ctx, rawDesired, opts...)
+		resultNewState = newState
+		if err != nil {
+			// If the error is 409, there is conflict in resource update.
+			// Here we want to apply changes based on latest state.
+			if dcl.IsConflictError(err) {
+				return &dcl.RetryDetails{}, dcl.OperationNotDone{Err: err}
+			}
+			return nil, err
+		}
+		return nil, nil
+	}, c.Config.RetryProvider)
+	return resultNewState, err
+}
+
+func (c *Client) ApplyInstanceAsync(ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyInstance(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffInstance returns the field-level differences between rawDesired and the
+// live Instance without modifying it. If the Instance does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffInstance(ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractInstanceFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.instanceDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Instance %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
+func applyInstanceHelper(c *Client, ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) (*Instance, error) {
+	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyInstance...")
+	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
+
+	// 1.1: Validation of user-specified fields in desired state.
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
+
+	if err := extractInstanceFields(rawDesired); err != nil {
+		return nil, err
+	}
+
+	initial, desired, fieldDiffs, err := c.instanceDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a diff: %w", err)
+	}
+
+	diffs, err := convertFieldDiffsToInstanceDiffs(c.Config, fieldDiffs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(magic-modules-eng): 2.2 Feasibility check (all updates are feasible so far).
+
+	// 2.3: Lifecycle Directive Check
+	var create bool
+	lp := dcl.FetchLifecycleParams(opts)
+	if initial == nil {
+		if dcl.HasLifecycleParam(lp, dcl.BlockCreation) {
+			return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Creation blocked by lifecycle params: %#v.", desired)}
+		}
+		create = true
+	} else if dcl.HasLifecycleParam(lp, dcl.BlockAcquire) {
+		return nil, dcl.ApplyInfeasibleError{
+			Message: fmt.Sprintf("Resource already exists - apply blocked by lifecycle params: %#v.", initial),
+		}
+	} else {
+		for _, d := range diffs {
+			if d.RequiresRecreate {
+				return nil, dcl.ApplyInfeasibleError{
+					Message: fmt.Sprintf("infeasible update: (%v) would require recreation", d),
+				}
+			}
+			if dcl.HasLifecycleParam(lp, dcl.BlockModification) {
+				return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Modification blocked, diff (%v) unresolvable.", d)}
+			}
+		}
+	}
+
+	// 2.4 Imperative Request Planning
+	var ops []instanceApiOperation
+	if create {
+		ops = append(ops, &createInstanceOperation{})
+	} else {
+		for _, d := range diffs {
+			ops = append(ops, d.UpdateOp)
+		}
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created plan: %#v", ops)
+
+	// 2.5 Request Actuation
+	for _, op := range ops {
+		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
+		if err := op.do(ctx, desired, c); err != nil {
+			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
+		}
+		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
+	}
+	return applyInstanceDiff(c, ctx, desired, rawDesired, ops, opts...)
+}
+
+func applyInstanceDiff(c *Client, ctx context.Context, desired *Instance, rawDesired *Instance, ops []instanceApiOperation, opts ...dcl.ApplyOption) (*Instance, error) {
+	// 3.1, 3.2a Retrieval of raw new state & canonicalization with desired state
+	c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state...")
+	rawNew, err := c.GetInstance(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	// Get additional values from the first response.
+	// These values should be merged into the newState above.
+	if len(ops) > 0 {
+		lastOp := ops[len(ops)-1]
+		if o, ok := lastOp.(*createInstanceOperation); ok {
+			if r, hasR := o.FirstResponse(); hasR {
+
+				c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state from operation...")
+
+				fullResp, err := unmarshalMapInstance(r, c, rawDesired)
+				if err != nil {
+					return nil, err
+				}
+
+				rawNew, err = canonicalizeInstanceNewState(c, rawNew, fullResp)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with raw desired state: %v", rawDesired)
+	// 3.2b Canonicalization of raw new state using raw desired state
+	newState, err := canonicalizeInstanceNewState(c, rawNew, rawDesired)
+	if err != nil {
+		return rawNew, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created canonical new state: %v", newState)
+	// 3.3 Comparison of the new state and raw desired state.
+	// TODO(magic-modules-eng): EVENTUALLY_CONSISTENT_UPDATE
+	newDesired, err := canonicalizeInstanceDesiredState(rawDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if err := postReadExtractInstanceFields(newState); err != nil {
+		return newState, err
+	}
+
+	// Need to ensure any transformations made here match acceptably in differ.
+	if err := postReadExtractInstanceFields(newDesired); err != nil {
+		return newState, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Diffing using canonicalized desired state: %v", newDesired)
+	newDiffs, err := diffInstance(c, newDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if len(newDiffs) == 0 {
+		c.Config.Logger.InfoWithContext(ctx, "No diffs found. Apply was successful.")
+	} else {
+		c.Config.Logger.InfoWithContextf(ctx, "Found diffs: %v", newDiffs)
+		diffMessages := make([]string, len(newDiffs))
+		for i, d := range newDiffs {
+			diffMessages[i] = fmt.Sprintf("%v", d)
+		}
+		return newState, dcl.DiffAfterApplyError{Diffs: diffMessages}
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Done Apply.")
+	return newState, nil
+}
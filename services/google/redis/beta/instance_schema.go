@@ -0,0 +1,497 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package beta
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLInstanceSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Redis/Instance",
+			Description: "The Redis Instance resource",
+			StructName:  "Instance",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "instance",
+						Required:    true,
+						Description: "A full instance of a Instance",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "instance",
+						Required:    true,
+						Description: "A full instance of a Instance",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "instance",
+						Required:    true,
+						Description: "A full instance of a Instance",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "location",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "location",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"Instance": &dcl.Component{
+					Title:           "Instance",
+					ID:              "projects/{{project}}/locations/{{location}}/instances/{{name}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"name",
+							"tier",
+							"memorySizeGb",
+							"project",
+							"location",
+						},
+						Properties: map[string]*dcl.Property{
+							"alternativeLocationId": &dcl.Property{
+								Type:          "string",
+								GoName:        "AlternativeLocationId",
+								Description:   "Optional. Only applicable to STANDARD_HA tier which protects the instance against zonal failures by provisioning it across two zones. If provided, it must be a different zone from the one provided in location_id.",
+								Immutable:     true,
+								ServerDefault: true,
+							},
+							"authEnabled": &dcl.Property{
+								Type:        "boolean",
+								GoName:      "AuthEnabled",
+								Description: "Optional. Indicates whether OSS Redis AUTH is enabled for the instance. If set to \"true\" AUTH is enabled on the instance. Default value is \"false\" meaning AUTH is disabled.",
+							},
+							"authorizedNetwork": &dcl.Property{
+								Type:          "string",
+								GoName:        "AuthorizedNetwork",
+								Description:   "Optional. The full name of the Google Compute Engine [network](https://cloud.google.com/vpc/docs/vpc) to which the instance is connected. If left unspecified, the `default` network will be used.",
+								Immutable:     true,
+								ServerDefault: true,
+							},
+							"connectMode": &dcl.Property{
+								Type:          "string",
+								GoName:        "ConnectMode",
+								GoType:        "InstanceConnectModeEnum",
+								Description:   "Optional. The network connect mode of the Redis instance. If not provided, the connect mode defaults to DIRECT_PEERING. Possible values: CONNECT_MODE_UNSPECIFIED, DIRECT_PEERING, PRIVATE_SERVICE_ACCESS",
+								Immutable:     true,
+								ServerDefault: true,
+								Enum: []string{
+									"CONNECT_MODE_UNSPECIFIED",
+									"DIRECT_PEERING",
+									"PRIVATE_SERVICE_ACCESS",
+								},
+							},
+							"createTime": &dcl.Property{
+								Type:        "string",
+								GoName:      "CreateTime",
+								ReadOnly:    true,
+								Description: "Output only. The time the instance was created.",
+								Immutable:   true,
+							},
+							"currentLocationId": &dcl.Property{
+								Type:        "string",
+								GoName:      "CurrentLocationId",
+								ReadOnly:    true,
+								Description: "Output only. The current zone where the Redis endpoint is placed. For Basic Tier instances, this will always be the same as the location_id provided by the user at creation time. For Standard Tier instances, this can be either location_id or alternative_location_id and can change after a failover event.",
+								Immutable:   true,
+							},
+							"displayName": &dcl.Property{
+								Type:        "string",
+								GoName:      "DisplayName",
+								Description: "An arbitrary and optional user-provided name for the instance.",
+							},
+							"host": &dcl.Property{
+								Type:        "string",
+								GoName:      "Host",
+								ReadOnly:    true,
+								Description: "Output only. Hostname or IP address of the exposed Redis endpoint used by clients to connect to the service.",
+								Immutable:   true,
+							},
+							"labels": &dcl.Property{
+								Type: "object",
+								AdditionalProperties: &dcl.Property{
+									Type: "string",
+								},
+								GoName:      "Labels",
+								Description: "Resource labels to represent user provided metadata",
+							},
+							"location": &dcl.Property{
+								Type:        "string",
+								GoName:      "Location",
+								Description: "The location for the resource",
+							},
+							"locationId": &dcl.Property{
+								Type:          "string",
+								GoName:        "LocationId",
+								Description:   "Optional. The zone where the instance will be provisioned. If not provided, the service will choose a zone for the instance. For STANDARD_HA tier, instances will be created across two zones for protection against zonal failures. If alternative_location_id is also provided, it must be different from location_id.",
+								Immutable:     true,
+								ServerDefault: true,
+							},
+							"maintenancePolicy": &dcl.Property{
+								Type:        "object",
+								GoName:      "MaintenancePolicy",
+								GoType:      "InstanceMaintenancePolicy",
+								Description: "Optional. The maintenance policy for the instance. If not provided, maintenance events can be performed at any time.",
+								Properties: map[string]*dcl.Property{
+									"createTime": &dcl.Property{
+										Type:        "string",
+										GoName:      "CreateTime",
+										ReadOnly:    true,
+										Description: "Output only. The time when the policy was created.",
+										Immutable:   true,
+									},
+									"description": &dcl.Property{
+										Type:        "string",
+										GoName:      "Description",
+										Description: "Optional. Description of what this policy is for. Create/Update methods return INVALID_ARGUMENT if the length is greater than 512.",
+									},
+									"updateTime": &dcl.Property{
+										Type:        "string",
+										GoName:      "UpdateTime",
+										ReadOnly:    true,
+										Description: "Output only. The time when the policy was last updated.",
+										Immutable:   true,
+									},
+									"weeklyMaintenanceWindow": &dcl.Property{
+										Type:        "array",
+										GoName:      "WeeklyMaintenanceWindow",
+										Description: "Optional. Maintenance window that is applied to resources covered by this policy. Minimum 1. For the current version, the maximum number of weekly_window is expected to be one.",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "object",
+											GoType: "InstanceMaintenancePolicyWeeklyMaintenanceWindow",
+											Required: []string{
+												"day",
+												"startTime",
+											},
+											Properties: map[string]*dcl.Property{
+												"day": &dcl.Property{
+													Type:        "string",
+													GoName:      "Day",
+													GoType:      "InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum",
+													Description: "Required. The day of week that maintenance updates occur. Possible values: DAY_OF_WEEK_UNSPECIFIED, MONDAY, TUESDAY, WEDNESDAY, THURSDAY, FRIDAY, SATURDAY, SUNDAY",
+													Enum: []string{
+														"DAY_OF_WEEK_UNSPECIFIED",
+														"MONDAY",
+														"TUESDAY",
+														"WEDNESDAY",
+														"THURSDAY",
+														"FRIDAY",
+														"SATURDAY",
+														"SUNDAY",
+													},
+												},
+												"duration": &dcl.Property{
+													Type:        "string",
+													GoName:      "Duration",
+													ReadOnly:    true,
+													Description: "Output only. Duration of the maintenance window. The current window is fixed at 1 hour.",
+													Immutable:   true,
+												},
+												"startTime": &dcl.Property{
+													Type:        "object",
+													GoName:      "StartTime",
+													GoType:      "InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime",
+													Description: "Required. Start time of the window in UTC time.",
+													Properties: map[string]*dcl.Property{
+														"hours": &dcl.Property{
+															Type:        "integer",
+															Format:      "int64",
+															GoName:      "Hours",
+															Description: "Hours of day in 24 hour format. Should be from 0 to 23. An API may choose to allow the value \"24:00:00\" for scenarios like business closing time.",
+														},
+														"minutes": &dcl.Property{
+															Type:        "integer",
+															Format:      "int64",
+															GoName:      "Minutes",
+															Description: "Minutes of hour of day. Must be from 0 to 59.",
+														},
+														"nanos": &dcl.Property{
+															Type:        "integer",
+															Format:      "int64",
+															GoName:      "Nanos",
+															Description: "Fractions of seconds in nanoseconds. Must be from 0 to 999,999,999.",
+														},
+														"seconds": &dcl.Property{
+															Type:        "integer",
+															Format:      "int64",
+															GoName:      "Seconds",
+															Description: "Seconds of minutes of the time. Must normally be from 0 to 59. An API may allow the value 60 if it allows leap-seconds.",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"maintenanceSchedule": &dcl.Property{
+								Type:        "object",
+								GoName:      "MaintenanceSchedule",
+								GoType:      "InstanceMaintenanceSchedule",
+								ReadOnly:    true,
+								Description: "Output only. Date and time of upcoming maintenance events which have been scheduled.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"canReschedule": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "CanReschedule",
+										ReadOnly:    true,
+										Description: "If the scheduled maintenance can be rescheduled, default is true.",
+										Immutable:   true,
+									},
+									"endTime": &dcl.Property{
+										Type:        "string",
+										GoName:      "EndTime",
+										ReadOnly:    true,
+										Description: "Output only. The end time of any upcoming scheduled maintenance for this instance.",
+										Immutable:   true,
+									},
+									"scheduleDeadlineTime": &dcl.Property{
+										Type:        "string",
+										GoName:      "ScheduleDeadlineTime",
+										ReadOnly:    true,
+										Description: "Output only. The deadline that the maintenance schedule start time can not go beyond, including reschedule.",
+										Immutable:   true,
+									},
+									"startTime": &dcl.Property{
+										Type:        "string",
+										GoName:      "StartTime",
+										ReadOnly:    true,
+										Description: "Output only. The start time of any upcoming scheduled maintenance for this instance.",
+										Immutable:   true,
+									},
+								},
+							},
+							"memorySizeGb": &dcl.Property{
+								Type:        "integer",
+								Format:      "int64",
+								GoName:      "MemorySizeGb",
+								Description: "Required. Redis memory size in GiB. Resizing a STANDARD_HA instance is performed without downtime; resizing a BASIC instance flushes its data.",
+							},
+							"name": &dcl.Property{
+								Type:        "string",
+								GoName:      "Name",
+								Description: "Required. Unique name of the resource in this scope including project and location using the form: `projects/{project_id}/locations/{location_id}/instances/{instance_id}` Note: Redis instances are managed and addressed at regional level so location_id here refers to a GCP region; however, users may choose which specific zone (or collection of zones for cross-zone instances) an instance should be provisioned in. Refer to location_id and alternative_location_id fields for more details.",
+								Immutable:   true,
+							},
+							"persistenceIamIdentity": &dcl.Property{
+								Type:        "string",
+								GoName:      "PersistenceIamIdentity",
+								ReadOnly:    true,
+								Description: "Output only. Cloud IAM identity used by import / export operations to transfer data to/from Cloud Storage. Format is \"serviceAccount:\". The value may change over time for a given instance so should be checked before each import/export operation.",
+								Immutable:   true,
+							},
+							"port": &dcl.Property{
+								Type:        "integer",
+								Format:      "int64",
+								GoName:      "Port",
+								ReadOnly:    true,
+								Description: "Output only. The port number of the exposed Redis endpoint.",
+								Immutable:   true,
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "The project for the resource",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"redisConfigs": &dcl.Property{
+								Type: "object",
+								AdditionalProperties: &dcl.Property{
+									Type: "string",
+								},
+								GoName:      "RedisConfigs",
+								Description: "Optional. Redis configuration parameters, according to http://redis.io/topics/config. Currently, the only supported parameters are: Redis version 3.2 and newer: * maxmemory-policy * notify-keyspace-events Redis version 4.0 and newer: * activedefrag * lfu-decay-time * lfu-log-factor * maxmemory-gb Redis version 5.0 and newer: * stream-node-max-bytes * stream-node-max-entries",
+							},
+							"redisVersion": &dcl.Property{
+								Type:          "string",
+								GoName:        "RedisVersion",
+								Description:   "Optional. The version of Redis software. If not provided, latest supported version will be used. Currently, the supported values are: * `REDIS_3_2` for Redis 3.2 compatibility * `REDIS_4_0` for Redis 4.0 compatibility (default) * `REDIS_5_0` for Redis 5.0 compatibility * `REDIS_6_X` for Redis 6.x compatibility. Changing the version upgrades the instance in place; downgrades are not supported by the service.",
+								ServerDefault: true,
+							},
+							"reservedIpRange": &dcl.Property{
+								Type:          "string",
+								GoName:        "ReservedIPRange",
+								Description:   "Optional. For DIRECT_PEERING mode, the CIDR range of internal addresses that are reserved for this instance. Range must be unique and non-overlapping with existing subnets in an authorized network. For PRIVATE_SERVICE_ACCESS mode, the name of one allocated IP address ranges associated with this private service access connection. If not provided, the service will choose an unused /29 block, for example, 10.0.0.0/29 or 192.168.0.0/29.",
+								Immutable:     true,
+								ServerDefault: true,
+							},
+							"serverCaCerts": &dcl.Property{
+								Type:        "array",
+								GoName:      "ServerCaCerts",
+								ReadOnly:    true,
+								Description: "Output only. List of server CA certificates for the instance.",
+								Immutable:   true,
+								SendEmpty:   true,
+								ListType:    "list",
+								Items: &dcl.Property{
+									Type:   "object",
+									GoType: "InstanceServerCaCerts",
+									Properties: map[string]*dcl.Property{
+										"cert": &dcl.Property{
+											Type:        "string",
+											GoName:      "Cert",
+											ReadOnly:    true,
+											Description: "PEM representation.",
+											Immutable:   true,
+										},
+										"createTime": &dcl.Property{
+											Type:        "string",
+											GoName:      "CreateTime",
+											ReadOnly:    true,
+											Description: "The time when the certificate was created in [RFC 3339](https://tools.ietf.org/html/rfc3339) format, for example `2020-05-18T00:00:00.094Z`.",
+											Immutable:   true,
+										},
+										"expireTime": &dcl.Property{
+											Type:        "string",
+											GoName:      "ExpireTime",
+											ReadOnly:    true,
+											Description: "The time when the certificate expires in [RFC 3339](https://tools.ietf.org/html/rfc3339) format, for example `2020-05-18T00:00:00.094Z`.",
+											Immutable:   true,
+										},
+										"serialNumber": &dcl.Property{
+											Type:        "string",
+											GoName:      "SerialNumber",
+											ReadOnly:    true,
+											Description: "Serial number, as extracted from the certificate.",
+											Immutable:   true,
+										},
+										"sha1Fingerprint": &dcl.Property{
+											Type:        "string",
+											GoName:      "Sha1Fingerprint",
+											ReadOnly:    true,
+											Description: "Sha1 Fingerprint of the certificate.",
+											Immutable:   true,
+										},
+									},
+								},
+							},
+							"state": &dcl.Property{
+								Type:        "string",
+								GoName:      "State",
+								GoType:      "InstanceStateEnum",
+								ReadOnly:    true,
+								Description: "Output only. The current state of this instance. Possible values: STATE_UNSPECIFIED, CREATING, READY, UPDATING, DELETING, REPAIRING, PERFORMING_MAINTENANCE, IMPORTING, FAILING_OVER",
+								Immutable:   true,
+								Enum: []string{
+									"STATE_UNSPECIFIED",
+									"CREATING",
+									"READY",
+									"UPDATING",
+									"DELETING",
+									"REPAIRING",
+									"PERFORMING_MAINTENANCE",
+									"IMPORTING",
+									"FAILING_OVER",
+								},
+							},
+							"statusMessage": &dcl.Property{
+								Type:        "string",
+								GoName:      "StatusMessage",
+								ReadOnly:    true,
+								Description: "Output only. Additional information about the current status of this instance, if available.",
+								Immutable:   true,
+							},
+							"tier": &dcl.Property{
+								Type:        "string",
+								GoName:      "Tier",
+								GoType:      "InstanceTierEnum",
+								Description: "Required. The service tier of the instance. The tier cannot be changed on an existing instance; changing it requires the instance to be recreated. Possible values: TIER_UNSPECIFIED, BASIC, STANDARD_HA",
+								Immutable:   true,
+								Enum: []string{
+									"TIER_UNSPECIFIED",
+									"BASIC",
+									"STANDARD_HA",
+								},
+							},
+							"transitEncryptionMode": &dcl.Property{
+								Type:          "string",
+								GoName:        "TransitEncryptionMode",
+								GoType:        "InstanceTransitEncryptionModeEnum",
+								Description:   "Optional. The TLS mode of the Redis instance. If not provided, TLS is disabled for the instance. Possible values: TRANSIT_ENCRYPTION_MODE_UNSPECIFIED, SERVER_AUTHENTICATION, DISABLED",
+								Immutable:     true,
+								ServerDefault: true,
+								Enum: []string{
+									"TRANSIT_ENCRYPTION_MODE_UNSPECIFIED",
+									"SERVER_AUTHENTICATION",
+									"DISABLED",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
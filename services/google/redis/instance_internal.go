@@ -0,0 +1,3137 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package redis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl/operations"
+)
+
+func (r *Instance) validate() error {
+
+	if err := dcl.Required(r, "name"); err != nil {
+		return err
+	}
+	if err := dcl.Required(r, "tier"); err != nil {
+		return err
+	}
+	if err := dcl.Required(r, "memorySizeGb"); err != nil {
+		return err
+	}
+	if err := dcl.RequiredParameter(r.Project, "Project"); err != nil {
+		return err
+	}
+	if err := dcl.RequiredParameter(r.Location, "Location"); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(r.MaintenancePolicy) {
+		if err := r.MaintenancePolicy.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.MaintenanceSchedule) {
+		if err := r.MaintenanceSchedule.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *InstanceServerCaCerts) validate() error {
+	return nil
+}
+func (r *InstanceMaintenancePolicy) validate() error {
+	return nil
+}
+func (r *InstanceMaintenancePolicyWeeklyMaintenanceWindow) validate() error {
+	if err := dcl.Required(r, "day"); err != nil {
+		return err
+	}
+	if err := dcl.Required(r, "startTime"); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(r.StartTime) {
+		if err := r.StartTime.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime) validate() error {
+	return nil
+}
+func (r *InstanceMaintenanceSchedule) validate() error {
+	return nil
+}
+func (r *Instance) basePath() string {
+	params := map[string]interface{}{}
+	return dcl.Nprintf("https://redis.googleapis.com/v1/", params)
+}
+
+func (r *Instance) getURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project":  dcl.ValueOrEmptyString(nr.Project),
+		"location": dcl.ValueOrEmptyString(nr.Location),
+		"name":     dcl.ValueOrEmptyString(nr.Name),
+	}
+	return dcl.URL("projects/{{project}}/locations/{{location}}/instances/{{name}}", nr.basePath(), userBasePath, params), nil
+}
+
+func (r *Instance) listURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project":  dcl.ValueOrEmptyString(nr.Project),
+		"location": dcl.ValueOrEmptyString(nr.Location),
+	}
+	return dcl.URL("projects/{{project}}/locations/{{location}}/instances", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *Instance) createURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project":  dcl.ValueOrEmptyString(nr.Project),
+		"location": dcl.ValueOrEmptyString(nr.Location),
+		"name":     dcl.ValueOrEmptyString(nr.Name),
+	}
+	return dcl.URL("projects/{{project}}/locations/{{location}}/instances?instanceId={{name}}", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *Instance) deleteURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project":  dcl.ValueOrEmptyString(nr.Project),
+		"location": dcl.ValueOrEmptyString(nr.Location),
+		"name":     dcl.ValueOrEmptyString(nr.Name),
+	}
+	return dcl.URL("projects/{{project}}/locations/{{location}}/instances/{{name}}", nr.basePath(), userBasePath, params), nil
+}
+
+// instanceApiOperation represents a mutable operation in the underlying REST
+// API such as Create, Update, or Delete.
+type instanceApiOperation interface {
+	do(context.Context, *Instance, *Client) error
+}
+
+// newUpdateInstanceUpdateInstanceRequest creates a request for an
+// Instance resource's UpdateInstance update type by filling in the update
+// fields based on the intended state of the resource.
+func newUpdateInstanceUpdateInstanceRequest(ctx context.Context, f *Instance, c *Client) (map[string]interface{}, error) {
+	req := map[string]interface{}{}
+	res := f
+	_ = res
+
+	if v := f.DisplayName; !dcl.IsEmptyValueIndirect(v) {
+		req["displayName"] = v
+	}
+	if v := f.Labels; !dcl.IsEmptyValueIndirect(v) {
+		req["labels"] = v
+	}
+	if v := f.RedisConfigs; !dcl.IsEmptyValueIndirect(v) {
+		req["redisConfigs"] = v
+	}
+	if v := f.MemorySizeGb; !dcl.IsEmptyValueIndirect(v) {
+		req["memorySizeGb"] = v
+	}
+	if v := f.AuthEnabled; !dcl.IsEmptyValueIndirect(v) {
+		req["authEnabled"] = v
+	}
+	if v, err := expandInstanceMaintenancePolicy(c, f.MaintenancePolicy, res); err != nil {
+		return nil, fmt.Errorf("error expanding MaintenancePolicy into maintenancePolicy: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		req["maintenancePolicy"] = v
+	}
+	return req, nil
+}
+
+// marshalUpdateInstanceUpdateInstanceRequest converts the update into
+// the final JSON request body.
+func marshalUpdateInstanceUpdateInstanceRequest(c *Client, m map[string]interface{}) ([]byte, error) {
+
+	return json.Marshal(m)
+}
+
+type updateInstanceUpdateInstanceOperation struct {
+	// If the update operation has the REQUIRES_APPLY_OPTIONS trait, this will be populated.
+	// Usually it will be nil - this is to prevent us from accidentally depending on apply
+	// options, which should usually be unnecessary.
+	ApplyOptions []dcl.ApplyOption
+	FieldDiffs   []*dcl.FieldDiff
+}
+
+// do creates a request and sends it to the appropriate URL. In most operations,
+// do will transcribe a subset of the resource into a request object and send a
+// PUT request to a single URL.
+
+func (op *updateInstanceUpdateInstanceOperation) do(ctx context.Context, r *Instance, c *Client) error {
+	_, err := c.GetInstance(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	u, err := r.updateURL(c.Config.BasePath, "UpdateInstance")
+	if err != nil {
+		return err
+	}
+	mask := dcl.TopLevelUpdateMask(op.FieldDiffs)
+	u, err = dcl.AddQueryParams(u, map[string]string{"updateMask": mask})
+	if err != nil {
+		return err
+	}
+
+	req, err := newUpdateInstanceUpdateInstanceRequest(ctx, r, c)
+	if err != nil {
+		return err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created update: %#v", req)
+	body, err := marshalUpdateInstanceUpdateInstanceRequest(c, req)
+	if err != nil {
+		return err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "PATCH", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+
+	var o operations.StandardGCPOperation
+	if err := dcl.ParseResponse(resp.Response, &o); err != nil {
+		return err
+	}
+	err = o.Wait(context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), c.Config, r.basePath(), "GET")
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// newUpdateInstanceUpgradeInstanceRequest creates a request for an
+// Instance resource's UpgradeInstance update type by filling in the update
+// fields based on the intended state of the resource.
+func newUpdateInstanceUpgradeInstanceRequest(ctx context.Context, f *Instance, c *Client) (map[string]interface{}, error) {
+	req := map[string]interface{}{}
+	res := f
+	_ = res
+
+	if v := f.RedisVersion; !dcl.IsEmptyValueIndirect(v) {
+		req["redisVersion"] = v
+	}
+	return req, nil
+}
+
+// marshalUpdateInstanceUpgradeInstanceRequest converts the update into
+// the final JSON request body.
+func marshalUpdateInstanceUpgradeInstanceRequest(c *Client, m map[string]interface{}) ([]byte, error) {
+
+	return json.Marshal(m)
+}
+
+type updateInstanceUpgradeInstanceOperation struct {
+	// If the update operation has the REQUIRES_APPLY_OPTIONS trait, this will be populated.
+	// Usually it will be nil - this is to prevent us from accidentally depending on apply
+	// options, which should usually be unnecessary.
+	ApplyOptions []dcl.ApplyOption
+	FieldDiffs   []*dcl.FieldDiff
+}
+
+// do creates a request and sends it to the appropriate URL. In most operations,
+// do will transcribe a subset of the resource into a request object and send a
+// PUT request to a single URL.
+
+func (op *updateInstanceUpgradeInstanceOperation) do(ctx context.Context, r *Instance, c *Client) error {
+	_, err := c.GetInstance(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	u, err := r.updateURL(c.Config.BasePath, "UpgradeInstance")
+	if err != nil {
+		return err
+	}
+
+	req, err := newUpdateInstanceUpgradeInstanceRequest(ctx, r, c)
+	if err != nil {
+		return err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created update: %#v", req)
+	body, err := marshalUpdateInstanceUpgradeInstanceRequest(c, req)
+	if err != nil {
+		return err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "POST", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+
+	var o operations.StandardGCPOperation
+	if err := dcl.ParseResponse(resp.Response, &o); err != nil {
+		return err
+	}
+	err = o.Wait(context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), c.Config, r.basePath(), "GET")
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) listInstanceRaw(ctx context.Context, r *Instance, pageToken string, pageSize int32) ([]byte, error) {
+	u, err := r.urlNormalized().listURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string)
+	if pageToken != "" {
+		m["pageToken"] = pageToken
+	}
+
+	if pageSize != InstanceMaxPage {
+		m["pageSize"] = fmt.Sprintf("%v", pageSize)
+	}
+
+	u, err = dcl.AddQueryParams(u, m)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	return ioutil.ReadAll(resp.Response.Body)
+}
+
+type listInstanceOperation struct {
+	Instances []map[string]interface{} `json:"instances"`
+	Token     string                   `json:"nextPageToken"`
+}
+
+func (c *Client) listInstance(ctx context.Context, r *Instance, pageToken string, pageSize int32) ([]*Instance, string, error) {
+	b, err := c.listInstanceRaw(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var m listInstanceOperation
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, "", err
+	}
+
+	var l []*Instance
+	for _, v := range m.Instances {
+		res, err := unmarshalMapInstance(v, c, r)
+		if err != nil {
+			return nil, m.Token, err
+		}
+		res.Project = r.Project
+		res.Location = r.Location
+		l = append(l, res)
+	}
+
+	return l, m.Token, nil
+}
+
+func (c *Client) deleteAllInstance(ctx context.Context, f func(*Instance) bool, resources []*Instance) error {
+	var errors []string
+	for _, res := range resources {
+		if f(res) {
+			// We do not want deleteAll to fail on a deletion or else it will stop deleting other resources.
+			err := c.DeleteInstance(ctx, res)
+			if err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("%v", strings.Join(errors, "\n"))
+	} else {
+		return nil
+	}
+}
+
+type deleteInstanceOperation struct{}
+
+func (op *deleteInstanceOperation) do(ctx context.Context, r *Instance, c *Client) error {
+	r, err := c.GetInstance(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			c.Config.Logger.InfoWithContextf(ctx, "Instance not found, returning. Original error: %v", err)
+			return nil
+		}
+		c.Config.Logger.WarningWithContextf(ctx, "GetInstance checking for existence. error: %v", err)
+		return err
+	}
+
+	u, err := r.deleteURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	// Delete should never have a body
+	body := &bytes.Buffer{}
+	resp, err := dcl.SendRequest(ctx, c.Config, "DELETE", u, body, c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+
+	// wait for object to be deleted.
+	var o operations.StandardGCPOperation
+	if err := dcl.ParseResponse(resp.Response, &o); err != nil {
+		return err
+	}
+	if err := o.Wait(context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), c.Config, r.basePath(), "GET"); err != nil {
+		return err
+	}
+
+	// We saw a race condition where for some successful delete operation, the Get calls returned resources for a short duration.
+	// This is the reason we are adding retry to handle that case.
+	retriesRemaining := 10
+	dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		_, err := c.GetInstance(ctx, r)
+		if dcl.IsNotFound(err) {
+			return nil, nil
+		}
+		if retriesRemaining > 0 {
+			retriesRemaining--
+			return &dcl.RetryDetails{}, dcl.OperationNotDone{}
+		}
+		return nil, dcl.NotDeletedError{ExistingResource: r}
+	}, c.Config.RetryProvider)
+	return nil
+}
+
+// Create operations are similar to Update operations, although they do not have
+// specific request objects. The Create request object is the json encoding of
+// the resource, which is modified by res.marshal to form the base request body.
+type createInstanceOperation struct {
+	response map[string]interface{}
+}
+
+func (op *createInstanceOperation) FirstResponse() (map[string]interface{}, bool) {
+	return op.response, len(op.response) > 0
+}
+
+func (op *createInstanceOperation) do(ctx context.Context, r *Instance, c *Client) error {
+	c.Config.Logger.InfoWithContextf(ctx, "Attempting to create %v", r)
+	u, err := r.createURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	req, err := r.marshal(c)
+	if err != nil {
+		return err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "POST", u, bytes.NewBuffer(req), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+	// wait for object to be created.
+	var o operations.StandardGCPOperation
+	if err := dcl.ParseResponse(resp.Response, &o); err != nil {
+		return err
+	}
+	if err := o.Wait(context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), c.Config, r.basePath(), "GET"); err != nil {
+		c.Config.Logger.Warningf("Creation failed after waiting for operation: %v", err)
+		return err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Successfully waited for operation")
+	op.response, _ = o.FirstResponse()
+
+	if _, err := c.GetInstance(ctx, r); err != nil {
+		c.Config.Logger.WarningWithContextf(ctx, "get returned error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) getInstanceRaw(ctx context.Context, r *Instance) ([]byte, error) {
+
+	u, err := r.getURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	b, err := ioutil.ReadAll(resp.Response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (c *Client) instanceDiffsForRawDesired(ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) (initial, desired *Instance, diffs []*dcl.FieldDiff, err error) {
+	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
+	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
+	var fetchState *Instance
+	if sh := dcl.FetchStateHint(opts); sh != nil {
+		if r, ok := sh.(*Instance); !ok {
+			c.Config.Logger.WarningWithContextf(ctx, "Initial state hint was of the wrong type; expected Instance, got %T", sh)
+		} else {
+			fetchState = r
+		}
+	}
+	if fetchState == nil {
+		fetchState = rawDesired
+	}
+
+	// 1.2: Retrieval of raw initial state from API
+	rawInitial, err := c.GetInstance(ctx, fetchState)
+	if rawInitial == nil {
+		if !dcl.IsNotFound(err) {
+			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Instance resource already exists: %s", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Instance resource: %w", err)
+		}
+		c.Config.Logger.InfoWithContext(ctx, "Found that Instance resource did not exist.")
+		// Perform canonicalization to pick up defaults.
+		desired, err = canonicalizeInstanceDesiredState(rawDesired, rawInitial)
+		return nil, desired, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Found initial state for Instance: %v", rawInitial)
+	c.Config.Logger.InfoWithContextf(ctx, "Initial desired state for Instance: %v", rawDesired)
+
+	// The Get call applies postReadExtract and so the result may contain fields that are not part of API version.
+	if err := extractInstanceFields(rawInitial); err != nil {
+		return nil, nil, nil, err
+	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// 1.3: Canonicalize raw initial state into initial state.
+	initial, err = canonicalizeInstanceInitialState(rawInitial, rawDesired)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized initial state for Instance: %v", initial)
+
+	// 1.4: Canonicalize raw desired state into desired state.
+	desired, err = canonicalizeInstanceDesiredState(rawDesired, rawInitial, opts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized desired state for Instance: %v", desired)
+
+	// 2.1: Comparison of initial and desired state.
+	diffs, err = diffInstance(c, desired, initial, opts...)
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
+}
+
+func canonicalizeInstanceInitialState(rawInitial, rawDesired *Instance) (*Instance, error) {
+	// TODO(magic-modules-eng): write canonicalizer once relevant traits are added.
+	return rawInitial, nil
+}
+
+/*
+* Canonicalizers
+*
+* These are responsible for converting either a user-specified config or a
+* GCP API response to a standard format that can be used for difference checking.
+* */
+
+func canonicalizeInstanceDesiredState(rawDesired, rawInitial *Instance, opts ...dcl.ApplyOption) (*Instance, error) {
+
+	if rawInitial == nil {
+		// Since the initial state is empty, the desired state is all we have.
+		// We canonicalize the remaining nested objects with nil to pick up defaults.
+		rawDesired.MaintenancePolicy = canonicalizeInstanceMaintenancePolicy(rawDesired.MaintenancePolicy, nil, opts...)
+		rawDesired.MaintenanceSchedule = canonicalizeInstanceMaintenanceSchedule(rawDesired.MaintenanceSchedule, nil, opts...)
+
+		return rawDesired, nil
+	}
+
+	canonicalDesired := &Instance{}
+	if dcl.PartialSelfLinkToSelfLink(rawDesired.Name, rawInitial.Name) {
+		canonicalDesired.Name = rawInitial.Name
+	} else {
+		canonicalDesired.Name = rawDesired.Name
+	}
+	if dcl.StringCanonicalize(rawDesired.DisplayName, rawInitial.DisplayName) {
+		canonicalDesired.DisplayName = rawInitial.DisplayName
+	} else {
+		canonicalDesired.DisplayName = rawDesired.DisplayName
+	}
+	if dcl.IsZeroValue(rawDesired.Labels) || (dcl.IsEmptyValueIndirect(rawDesired.Labels) && dcl.IsEmptyValueIndirect(rawInitial.Labels)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.Labels = rawInitial.Labels
+	} else {
+		canonicalDesired.Labels = rawDesired.Labels
+	}
+	if dcl.StringCanonicalize(rawDesired.LocationId, rawInitial.LocationId) {
+		canonicalDesired.LocationId = rawInitial.LocationId
+	} else {
+		canonicalDesired.LocationId = rawDesired.LocationId
+	}
+	if dcl.StringCanonicalize(rawDesired.AlternativeLocationId, rawInitial.AlternativeLocationId) {
+		canonicalDesired.AlternativeLocationId = rawInitial.AlternativeLocationId
+	} else {
+		canonicalDesired.AlternativeLocationId = rawDesired.AlternativeLocationId
+	}
+	if dcl.StringCanonicalize(rawDesired.RedisVersion, rawInitial.RedisVersion) {
+		canonicalDesired.RedisVersion = rawInitial.RedisVersion
+	} else {
+		canonicalDesired.RedisVersion = rawDesired.RedisVersion
+	}
+	if dcl.StringCanonicalize(rawDesired.ReservedIPRange, rawInitial.ReservedIPRange) {
+		canonicalDesired.ReservedIPRange = rawInitial.ReservedIPRange
+	} else {
+		canonicalDesired.ReservedIPRange = rawDesired.ReservedIPRange
+	}
+	if dcl.IsZeroValue(rawDesired.RedisConfigs) || (dcl.IsEmptyValueIndirect(rawDesired.RedisConfigs) && dcl.IsEmptyValueIndirect(rawInitial.RedisConfigs)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.RedisConfigs = rawInitial.RedisConfigs
+	} else {
+		canonicalDesired.RedisConfigs = rawDesired.RedisConfigs
+	}
+	if dcl.IsZeroValue(rawDesired.Tier) || (dcl.IsEmptyValueIndirect(rawDesired.Tier) && dcl.IsEmptyValueIndirect(rawInitial.Tier)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.Tier = rawInitial.Tier
+	} else {
+		canonicalDesired.Tier = rawDesired.Tier
+	}
+	if dcl.IsZeroValue(rawDesired.MemorySizeGb) || (dcl.IsEmptyValueIndirect(rawDesired.MemorySizeGb) && dcl.IsEmptyValueIndirect(rawInitial.MemorySizeGb)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.MemorySizeGb = rawInitial.MemorySizeGb
+	} else {
+		canonicalDesired.MemorySizeGb = rawDesired.MemorySizeGb
+	}
+	if dcl.StringCanonicalize(rawDesired.AuthorizedNetwork, rawInitial.AuthorizedNetwork) {
+		canonicalDesired.AuthorizedNetwork = rawInitial.AuthorizedNetwork
+	} else {
+		canonicalDesired.AuthorizedNetwork = rawDesired.AuthorizedNetwork
+	}
+	if dcl.IsZeroValue(rawDesired.ConnectMode) || (dcl.IsEmptyValueIndirect(rawDesired.ConnectMode) && dcl.IsEmptyValueIndirect(rawInitial.ConnectMode)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.ConnectMode = rawInitial.ConnectMode
+	} else {
+		canonicalDesired.ConnectMode = rawDesired.ConnectMode
+	}
+	if dcl.BoolCanonicalize(rawDesired.AuthEnabled, rawInitial.AuthEnabled) {
+		canonicalDesired.AuthEnabled = rawInitial.AuthEnabled
+	} else {
+		canonicalDesired.AuthEnabled = rawDesired.AuthEnabled
+	}
+	if dcl.IsZeroValue(rawDesired.TransitEncryptionMode) || (dcl.IsEmptyValueIndirect(rawDesired.TransitEncryptionMode) && dcl.IsEmptyValueIndirect(rawInitial.TransitEncryptionMode)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.TransitEncryptionMode = rawInitial.TransitEncryptionMode
+	} else {
+		canonicalDesired.TransitEncryptionMode = rawDesired.TransitEncryptionMode
+	}
+	canonicalDesired.MaintenancePolicy = canonicalizeInstanceMaintenancePolicy(rawDesired.MaintenancePolicy, rawInitial.MaintenancePolicy, opts...)
+	if dcl.NameToSelfLink(rawDesired.Project, rawInitial.Project) {
+		canonicalDesired.Project = rawInitial.Project
+	} else {
+		canonicalDesired.Project = rawDesired.Project
+	}
+	if dcl.NameToSelfLink(rawDesired.Location, rawInitial.Location) {
+		canonicalDesired.Location = rawInitial.Location
+	} else {
+		canonicalDesired.Location = rawDesired.Location
+	}
+
+	return canonicalDesired, nil
+}
+
+func canonicalizeInstanceNewState(c *Client, rawNew, rawDesired *Instance) (*Instance, error) {
+
+	if dcl.IsEmptyValueIndirect(rawNew.Name) && dcl.IsEmptyValueIndirect(rawDesired.Name) {
+		rawNew.Name = rawDesired.Name
+	} else {
+		if dcl.PartialSelfLinkToSelfLink(rawDesired.Name, rawNew.Name) {
+			rawNew.Name = rawDesired.Name
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.DisplayName) && dcl.IsEmptyValueIndirect(rawDesired.DisplayName) {
+		rawNew.DisplayName = rawDesired.DisplayName
+	} else {
+		if dcl.StringCanonicalize(rawDesired.DisplayName, rawNew.DisplayName) {
+			rawNew.DisplayName = rawDesired.DisplayName
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Labels) && dcl.IsEmptyValueIndirect(rawDesired.Labels) {
+		rawNew.Labels = rawDesired.Labels
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.LocationId) && dcl.IsEmptyValueIndirect(rawDesired.LocationId) {
+		rawNew.LocationId = rawDesired.LocationId
+	} else {
+		if dcl.StringCanonicalize(rawDesired.LocationId, rawNew.LocationId) {
+			rawNew.LocationId = rawDesired.LocationId
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.AlternativeLocationId) && dcl.IsEmptyValueIndirect(rawDesired.AlternativeLocationId) {
+		rawNew.AlternativeLocationId = rawDesired.AlternativeLocationId
+	} else {
+		if dcl.StringCanonicalize(rawDesired.AlternativeLocationId, rawNew.AlternativeLocationId) {
+			rawNew.AlternativeLocationId = rawDesired.AlternativeLocationId
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.RedisVersion) && dcl.IsEmptyValueIndirect(rawDesired.RedisVersion) {
+		rawNew.RedisVersion = rawDesired.RedisVersion
+	} else {
+		if dcl.StringCanonicalize(rawDesired.RedisVersion, rawNew.RedisVersion) {
+			rawNew.RedisVersion = rawDesired.RedisVersion
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ReservedIPRange) && dcl.IsEmptyValueIndirect(rawDesired.ReservedIPRange) {
+		rawNew.ReservedIPRange = rawDesired.ReservedIPRange
+	} else {
+		if dcl.StringCanonicalize(rawDesired.ReservedIPRange, rawNew.ReservedIPRange) {
+			rawNew.ReservedIPRange = rawDesired.ReservedIPRange
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Host) && dcl.IsEmptyValueIndirect(rawDesired.Host) {
+		rawNew.Host = rawDesired.Host
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Host, rawNew.Host) {
+			rawNew.Host = rawDesired.Host
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Port) && dcl.IsEmptyValueIndirect(rawDesired.Port) {
+		rawNew.Port = rawDesired.Port
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.CurrentLocationId) && dcl.IsEmptyValueIndirect(rawDesired.CurrentLocationId) {
+		rawNew.CurrentLocationId = rawDesired.CurrentLocationId
+	} else {
+		if dcl.StringCanonicalize(rawDesired.CurrentLocationId, rawNew.CurrentLocationId) {
+			rawNew.CurrentLocationId = rawDesired.CurrentLocationId
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.CreateTime) && dcl.IsEmptyValueIndirect(rawDesired.CreateTime) {
+		rawNew.CreateTime = rawDesired.CreateTime
+	} else {
+		if dcl.StringCanonicalize(rawDesired.CreateTime, rawNew.CreateTime) {
+			rawNew.CreateTime = rawDesired.CreateTime
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.State) && dcl.IsEmptyValueIndirect(rawDesired.State) {
+		rawNew.State = rawDesired.State
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.StatusMessage) && dcl.IsEmptyValueIndirect(rawDesired.StatusMessage) {
+		rawNew.StatusMessage = rawDesired.StatusMessage
+	} else {
+		if dcl.StringCanonicalize(rawDesired.StatusMessage, rawNew.StatusMessage) {
+			rawNew.StatusMessage = rawDesired.StatusMessage
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.RedisConfigs) && dcl.IsEmptyValueIndirect(rawDesired.RedisConfigs) {
+		rawNew.RedisConfigs = rawDesired.RedisConfigs
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Tier) && dcl.IsEmptyValueIndirect(rawDesired.Tier) {
+		rawNew.Tier = rawDesired.Tier
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.MemorySizeGb) && dcl.IsEmptyValueIndirect(rawDesired.MemorySizeGb) {
+		rawNew.MemorySizeGb = rawDesired.MemorySizeGb
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.AuthorizedNetwork) && dcl.IsEmptyValueIndirect(rawDesired.AuthorizedNetwork) {
+		rawNew.AuthorizedNetwork = rawDesired.AuthorizedNetwork
+	} else {
+		if dcl.StringCanonicalize(rawDesired.AuthorizedNetwork, rawNew.AuthorizedNetwork) {
+			rawNew.AuthorizedNetwork = rawDesired.AuthorizedNetwork
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.PersistenceIamIdentity) && dcl.IsEmptyValueIndirect(rawDesired.PersistenceIamIdentity) {
+		rawNew.PersistenceIamIdentity = rawDesired.PersistenceIamIdentity
+	} else {
+		if dcl.StringCanonicalize(rawDesired.PersistenceIamIdentity, rawNew.PersistenceIamIdentity) {
+			rawNew.PersistenceIamIdentity = rawDesired.PersistenceIamIdentity
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ConnectMode) && dcl.IsEmptyValueIndirect(rawDesired.ConnectMode) {
+		rawNew.ConnectMode = rawDesired.ConnectMode
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.AuthEnabled) && dcl.IsEmptyValueIndirect(rawDesired.AuthEnabled) {
+		rawNew.AuthEnabled = rawDesired.AuthEnabled
+	} else {
+		if dcl.BoolCanonicalize(rawDesired.AuthEnabled, rawNew.AuthEnabled) {
+			rawNew.AuthEnabled = rawDesired.AuthEnabled
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ServerCaCerts) && dcl.IsEmptyValueIndirect(rawDesired.ServerCaCerts) {
+		rawNew.ServerCaCerts = rawDesired.ServerCaCerts
+	} else {
+		rawNew.ServerCaCerts = canonicalizeNewInstanceServerCaCertsSlice(c, rawDesired.ServerCaCerts, rawNew.ServerCaCerts)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.TransitEncryptionMode) && dcl.IsEmptyValueIndirect(rawDesired.TransitEncryptionMode) {
+		rawNew.TransitEncryptionMode = rawDesired.TransitEncryptionMode
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.MaintenancePolicy) && dcl.IsEmptyValueIndirect(rawDesired.MaintenancePolicy) {
+		rawNew.MaintenancePolicy = rawDesired.MaintenancePolicy
+	} else {
+		rawNew.MaintenancePolicy = canonicalizeNewInstanceMaintenancePolicy(c, rawDesired.MaintenancePolicy, rawNew.MaintenancePolicy)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.MaintenanceSchedule) && dcl.IsEmptyValueIndirect(rawDesired.MaintenanceSchedule) {
+		rawNew.MaintenanceSchedule = rawDesired.MaintenanceSchedule
+	} else {
+		rawNew.MaintenanceSchedule = canonicalizeNewInstanceMaintenanceSchedule(c, rawDesired.MaintenanceSchedule, rawNew.MaintenanceSchedule)
+	}
+
+	rawNew.Project = rawDesired.Project
+
+	rawNew.Location = rawDesired.Location
+
+	return rawNew, nil
+}
+
+func canonicalizeInstanceServerCaCerts(des, initial *InstanceServerCaCerts, opts ...dcl.ApplyOption) *InstanceServerCaCerts {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceServerCaCerts{}
+
+	return cDes
+}
+
+func canonicalizeInstanceServerCaCertsSlice(des, initial []InstanceServerCaCerts, opts ...dcl.ApplyOption) []InstanceServerCaCerts {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceServerCaCerts, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceServerCaCerts(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceServerCaCerts, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceServerCaCerts(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceServerCaCerts(c *Client, des, nw *InstanceServerCaCerts) *InstanceServerCaCerts {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceServerCaCerts while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.SerialNumber, nw.SerialNumber) {
+		nw.SerialNumber = des.SerialNumber
+	}
+	if dcl.StringCanonicalize(des.Cert, nw.Cert) {
+		nw.Cert = des.Cert
+	}
+	if dcl.StringCanonicalize(des.CreateTime, nw.CreateTime) {
+		nw.CreateTime = des.CreateTime
+	}
+	if dcl.StringCanonicalize(des.ExpireTime, nw.ExpireTime) {
+		nw.ExpireTime = des.ExpireTime
+	}
+	if dcl.StringCanonicalize(des.Sha1Fingerprint, nw.Sha1Fingerprint) {
+		nw.Sha1Fingerprint = des.Sha1Fingerprint
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceServerCaCertsSet(c *Client, des, nw []InstanceServerCaCerts) []InstanceServerCaCerts {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceServerCaCerts
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceServerCaCertsNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceServerCaCertsSlice(c *Client, des, nw []InstanceServerCaCerts) []InstanceServerCaCerts {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceServerCaCerts
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceServerCaCerts(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceMaintenancePolicy(des, initial *InstanceMaintenancePolicy, opts ...dcl.ApplyOption) *InstanceMaintenancePolicy {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceMaintenancePolicy{}
+
+	if dcl.StringCanonicalize(des.Description, initial.Description) || dcl.IsZeroValue(des.Description) {
+		cDes.Description = initial.Description
+	} else {
+		cDes.Description = des.Description
+	}
+	cDes.WeeklyMaintenanceWindow = canonicalizeInstanceMaintenancePolicyWeeklyMaintenanceWindowSlice(des.WeeklyMaintenanceWindow, initial.WeeklyMaintenanceWindow, opts...)
+
+	return cDes
+}
+
+func canonicalizeInstanceMaintenancePolicySlice(des, initial []InstanceMaintenancePolicy, opts ...dcl.ApplyOption) []InstanceMaintenancePolicy {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceMaintenancePolicy, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceMaintenancePolicy(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceMaintenancePolicy, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceMaintenancePolicy(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceMaintenancePolicy(c *Client, des, nw *InstanceMaintenancePolicy) *InstanceMaintenancePolicy {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceMaintenancePolicy while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.CreateTime, nw.CreateTime) {
+		nw.CreateTime = des.CreateTime
+	}
+	if dcl.StringCanonicalize(des.UpdateTime, nw.UpdateTime) {
+		nw.UpdateTime = des.UpdateTime
+	}
+	if dcl.StringCanonicalize(des.Description, nw.Description) {
+		nw.Description = des.Description
+	}
+	nw.WeeklyMaintenanceWindow = canonicalizeNewInstanceMaintenancePolicyWeeklyMaintenanceWindowSlice(c, des.WeeklyMaintenanceWindow, nw.WeeklyMaintenanceWindow)
+
+	return nw
+}
+
+func canonicalizeNewInstanceMaintenancePolicySet(c *Client, des, nw []InstanceMaintenancePolicy) []InstanceMaintenancePolicy {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceMaintenancePolicy
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceMaintenancePolicyNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceMaintenancePolicySlice(c *Client, des, nw []InstanceMaintenancePolicy) []InstanceMaintenancePolicy {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceMaintenancePolicy
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceMaintenancePolicy(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceMaintenancePolicyWeeklyMaintenanceWindow(des, initial *InstanceMaintenancePolicyWeeklyMaintenanceWindow, opts ...dcl.ApplyOption) *InstanceMaintenancePolicyWeeklyMaintenanceWindow {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceMaintenancePolicyWeeklyMaintenanceWindow{}
+
+	if dcl.IsZeroValue(des.Day) || (dcl.IsEmptyValueIndirect(des.Day) && dcl.IsEmptyValueIndirect(initial.Day)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Day = initial.Day
+	} else {
+		cDes.Day = des.Day
+	}
+	cDes.StartTime = canonicalizeInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(des.StartTime, initial.StartTime, opts...)
+
+	return cDes
+}
+
+func canonicalizeInstanceMaintenancePolicyWeeklyMaintenanceWindowSlice(des, initial []InstanceMaintenancePolicyWeeklyMaintenanceWindow, opts ...dcl.ApplyOption) []InstanceMaintenancePolicyWeeklyMaintenanceWindow {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceMaintenancePolicyWeeklyMaintenanceWindow, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceMaintenancePolicyWeeklyMaintenanceWindow(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceMaintenancePolicyWeeklyMaintenanceWindow, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceMaintenancePolicyWeeklyMaintenanceWindow(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceMaintenancePolicyWeeklyMaintenanceWindow(c *Client, des, nw *InstanceMaintenancePolicyWeeklyMaintenanceWindow) *InstanceMaintenancePolicyWeeklyMaintenanceWindow {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceMaintenancePolicyWeeklyMaintenanceWindow while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	nw.StartTime = canonicalizeNewInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(c, des.StartTime, nw.StartTime)
+	if dcl.StringCanonicalize(des.Duration, nw.Duration) {
+		nw.Duration = des.Duration
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceMaintenancePolicyWeeklyMaintenanceWindowSet(c *Client, des, nw []InstanceMaintenancePolicyWeeklyMaintenanceWindow) []InstanceMaintenancePolicyWeeklyMaintenanceWindow {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceMaintenancePolicyWeeklyMaintenanceWindow
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceMaintenancePolicyWeeklyMaintenanceWindowNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceMaintenancePolicyWeeklyMaintenanceWindowSlice(c *Client, des, nw []InstanceMaintenancePolicyWeeklyMaintenanceWindow) []InstanceMaintenancePolicyWeeklyMaintenanceWindow {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceMaintenancePolicyWeeklyMaintenanceWindow
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceMaintenancePolicyWeeklyMaintenanceWindow(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(des, initial *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime, opts ...dcl.ApplyOption) *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime{}
+
+	if dcl.IsZeroValue(des.Hours) || (dcl.IsEmptyValueIndirect(des.Hours) && dcl.IsEmptyValueIndirect(initial.Hours)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Hours = initial.Hours
+	} else {
+		cDes.Hours = des.Hours
+	}
+	if dcl.IsZeroValue(des.Minutes) || (dcl.IsEmptyValueIndirect(des.Minutes) && dcl.IsEmptyValueIndirect(initial.Minutes)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Minutes = initial.Minutes
+	} else {
+		cDes.Minutes = des.Minutes
+	}
+	if dcl.IsZeroValue(des.Seconds) || (dcl.IsEmptyValueIndirect(des.Seconds) && dcl.IsEmptyValueIndirect(initial.Seconds)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Seconds = initial.Seconds
+	} else {
+		cDes.Seconds = des.Seconds
+	}
+	if dcl.IsZeroValue(des.Nanos) || (dcl.IsEmptyValueIndirect(des.Nanos) && dcl.IsEmptyValueIndirect(initial.Nanos)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Nanos = initial.Nanos
+	} else {
+		cDes.Nanos = des.Nanos
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeSlice(des, initial []InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime, opts ...dcl.ApplyOption) []InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(c *Client, des, nw *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime) *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeSet(c *Client, des, nw []InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime) []InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeSlice(c *Client, des, nw []InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime) []InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceMaintenanceSchedule(des, initial *InstanceMaintenanceSchedule, opts ...dcl.ApplyOption) *InstanceMaintenanceSchedule {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceMaintenanceSchedule{}
+
+	return cDes
+}
+
+func canonicalizeInstanceMaintenanceScheduleSlice(des, initial []InstanceMaintenanceSchedule, opts ...dcl.ApplyOption) []InstanceMaintenanceSchedule {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceMaintenanceSchedule, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceMaintenanceSchedule(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceMaintenanceSchedule, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceMaintenanceSchedule(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceMaintenanceSchedule(c *Client, des, nw *InstanceMaintenanceSchedule) *InstanceMaintenanceSchedule {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceMaintenanceSchedule while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.StartTime, nw.StartTime) {
+		nw.StartTime = des.StartTime
+	}
+	if dcl.StringCanonicalize(des.EndTime, nw.EndTime) {
+		nw.EndTime = des.EndTime
+	}
+	if dcl.BoolCanonicalize(des.CanReschedule, nw.CanReschedule) {
+		nw.CanReschedule = des.CanReschedule
+	}
+	if dcl.StringCanonicalize(des.ScheduleDeadlineTime, nw.ScheduleDeadlineTime) {
+		nw.ScheduleDeadlineTime = des.ScheduleDeadlineTime
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceMaintenanceScheduleSet(c *Client, des, nw []InstanceMaintenanceSchedule) []InstanceMaintenanceSchedule {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceMaintenanceSchedule
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceMaintenanceScheduleNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceMaintenanceScheduleSlice(c *Client, des, nw []InstanceMaintenanceSchedule) []InstanceMaintenanceSchedule {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceMaintenanceSchedule
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceMaintenanceSchedule(c, &d, &n))
+	}
+
+	return items
+}
+
+// The differ returns a list of diffs, along with a list of operations that should be taken
+// to remedy them. Right now, it does not attempt to consolidate operations - if several
+// fields can be fixed with a patch update, it will perform the patch several times.
+// Diffs on some fields will be ignored if the `desired` state has an empty (nil)
+// value. This empty value indicates that the user does not care about the state for
+// the field. Empty fields on the actual object will cause diffs.
+// TODO(magic-modules-eng): for efficiency in some resources, add batching.
+func diffInstance(c *Client, desired, actual *Instance, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	if desired == nil || actual == nil {
+		return nil, fmt.Errorf("nil resource passed to diff - always a programming error: %#v, %#v", desired, actual)
+	}
+
+	c.Config.Logger.Infof("Diff function called with desired state: %v", desired)
+	c.Config.Logger.Infof("Diff function called with actual state: %v", actual)
+
+	var fn dcl.FieldName
+	var newDiffs []*dcl.FieldDiff
+	// New style diffs.
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DisplayName, actual.DisplayName, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("DisplayName")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Labels, actual.Labels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("Labels")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.LocationId, actual.LocationId, dcl.DiffInfo{ServerDefault: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("LocationId")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.AlternativeLocationId, actual.AlternativeLocationId, dcl.DiffInfo{ServerDefault: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("AlternativeLocationId")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.RedisVersion, actual.RedisVersion, dcl.DiffInfo{ServerDefault: true, OperationSelector: dcl.TriggersOperation("updateInstanceUpgradeInstanceOperation")}, fn.AddNest("RedisVersion")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ReservedIPRange, actual.ReservedIPRange, dcl.DiffInfo{ServerDefault: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ReservedIPRange")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Host, actual.Host, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Host")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Port, actual.Port, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Port")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CurrentLocationId, actual.CurrentLocationId, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("CurrentLocationId")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CreateTime, actual.CreateTime, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("CreateTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.State, actual.State, dcl.DiffInfo{OutputOnly: true, Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("State")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.StatusMessage, actual.StatusMessage, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("StatusMessage")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.RedisConfigs, actual.RedisConfigs, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("RedisConfigs")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Tier, actual.Tier, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Tier")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.MemorySizeGb, actual.MemorySizeGb, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("MemorySizeGb")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.AuthorizedNetwork, actual.AuthorizedNetwork, dcl.DiffInfo{ServerDefault: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("AuthorizedNetwork")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.PersistenceIamIdentity, actual.PersistenceIamIdentity, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("PersistenceIamIdentity")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ConnectMode, actual.ConnectMode, dcl.DiffInfo{ServerDefault: true, Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ConnectMode")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.AuthEnabled, actual.AuthEnabled, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("AuthEnabled")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ServerCaCerts, actual.ServerCaCerts, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareInstanceServerCaCertsNewStyle, EmptyObject: EmptyInstanceServerCaCerts, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ServerCaCerts")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.TransitEncryptionMode, actual.TransitEncryptionMode, dcl.DiffInfo{ServerDefault: true, Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("TransitEncryptionMode")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.MaintenancePolicy, actual.MaintenancePolicy, dcl.DiffInfo{ObjectFunction: compareInstanceMaintenancePolicyNewStyle, EmptyObject: EmptyInstanceMaintenancePolicy, OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("MaintenancePolicy")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.MaintenanceSchedule, actual.MaintenanceSchedule, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareInstanceMaintenanceScheduleNewStyle, EmptyObject: EmptyInstanceMaintenanceSchedule, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("MaintenanceSchedule")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Project, actual.Project, dcl.DiffInfo{Type: "ReferenceType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Project")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Location, actual.Location, dcl.DiffInfo{Type: "ReferenceType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Location")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	return newDiffs, nil
+}
+func compareInstanceServerCaCertsNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceServerCaCerts)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceServerCaCerts)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceServerCaCerts or *InstanceServerCaCerts", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceServerCaCerts)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceServerCaCerts)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceServerCaCerts", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.SerialNumber, actual.SerialNumber, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("SerialNumber")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Cert, actual.Cert, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Cert")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CreateTime, actual.CreateTime, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("CreateTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ExpireTime, actual.ExpireTime, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ExpireTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Sha1Fingerprint, actual.Sha1Fingerprint, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Sha1Fingerprint")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceMaintenancePolicyNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceMaintenancePolicy)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceMaintenancePolicy)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceMaintenancePolicy or *InstanceMaintenancePolicy", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceMaintenancePolicy)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceMaintenancePolicy)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceMaintenancePolicy", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.CreateTime, actual.CreateTime, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("CreateTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.UpdateTime, actual.UpdateTime, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("UpdateTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Description, actual.Description, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("Description")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.WeeklyMaintenanceWindow, actual.WeeklyMaintenanceWindow, dcl.DiffInfo{ObjectFunction: compareInstanceMaintenancePolicyWeeklyMaintenanceWindowNewStyle, EmptyObject: EmptyInstanceMaintenancePolicyWeeklyMaintenanceWindow, OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("WeeklyMaintenanceWindow")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceMaintenancePolicyWeeklyMaintenanceWindowNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceMaintenancePolicyWeeklyMaintenanceWindow)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceMaintenancePolicyWeeklyMaintenanceWindow)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceMaintenancePolicyWeeklyMaintenanceWindow or *InstanceMaintenancePolicyWeeklyMaintenanceWindow", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceMaintenancePolicyWeeklyMaintenanceWindow)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceMaintenancePolicyWeeklyMaintenanceWindow)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceMaintenancePolicyWeeklyMaintenanceWindow", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Day, actual.Day, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("Day")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.StartTime, actual.StartTime, dcl.DiffInfo{ObjectFunction: compareInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeNewStyle, EmptyObject: EmptyInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime, OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("StartTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Duration, actual.Duration, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Duration")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime or *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Hours, actual.Hours, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("Hours")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Minutes, actual.Minutes, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("Minutes")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Seconds, actual.Seconds, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("Seconds")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Nanos, actual.Nanos, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstanceUpdateInstanceOperation")}, fn.AddNest("Nanos")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceMaintenanceScheduleNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceMaintenanceSchedule)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceMaintenanceSchedule)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceMaintenanceSchedule or *InstanceMaintenanceSchedule", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceMaintenanceSchedule)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceMaintenanceSchedule)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceMaintenanceSchedule", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.StartTime, actual.StartTime, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("StartTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.EndTime, actual.EndTime, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("EndTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CanReschedule, actual.CanReschedule, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("CanReschedule")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ScheduleDeadlineTime, actual.ScheduleDeadlineTime, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ScheduleDeadlineTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+// urlNormalized returns a copy of the resource struct with values normalized
+// for URL substitutions. For instance, it converts long-form self-links to
+// short-form so they can be substituted in.
+func (r *Instance) urlNormalized() *Instance {
+	normalized := dcl.Copy(*r).(Instance)
+	normalized.Name = dcl.SelfLinkToName(r.Name)
+	normalized.DisplayName = dcl.SelfLinkToName(r.DisplayName)
+	normalized.LocationId = dcl.SelfLinkToName(r.LocationId)
+	normalized.AlternativeLocationId = dcl.SelfLinkToName(r.AlternativeLocationId)
+	normalized.RedisVersion = dcl.SelfLinkToName(r.RedisVersion)
+	normalized.ReservedIPRange = dcl.SelfLinkToName(r.ReservedIPRange)
+	normalized.Host = dcl.SelfLinkToName(r.Host)
+	normalized.CurrentLocationId = dcl.SelfLinkToName(r.CurrentLocationId)
+	normalized.CreateTime = dcl.SelfLinkToName(r.CreateTime)
+	normalized.StatusMessage = dcl.SelfLinkToName(r.StatusMessage)
+	normalized.AuthorizedNetwork = dcl.SelfLinkToName(r.AuthorizedNetwork)
+	normalized.PersistenceIamIdentity = dcl.SelfLinkToName(r.PersistenceIamIdentity)
+	normalized.Project = dcl.SelfLinkToName(r.Project)
+	normalized.Location = dcl.SelfLinkToName(r.Location)
+	return &normalized
+}
+
+func (r *Instance) updateURL(userBasePath, updateName string) (string, error) {
+	nr := r.urlNormalized()
+	if updateName == "UpdateInstance" {
+		fields := map[string]interface{}{
+			"project":  dcl.ValueOrEmptyString(nr.Project),
+			"location": dcl.ValueOrEmptyString(nr.Location),
+			"name":     dcl.ValueOrEmptyString(nr.Name),
+		}
+		return dcl.URL("projects/{{project}}/locations/{{location}}/instances/{{name}}", nr.basePath(), userBasePath, fields), nil
+
+	}
+	if updateName == "UpgradeInstance" {
+		fields := map[string]interface{}{
+			"project":  dcl.ValueOrEmptyString(nr.Project),
+			"location": dcl.ValueOrEmptyString(nr.Location),
+			"name":     dcl.ValueOrEmptyString(nr.Name),
+		}
+		return dcl.URL("projects/{{project}}/locations/{{location}}/instances/{{name}}:upgrade", nr.basePath(), userBasePath, fields), nil
+
+	}
+
+	return "", fmt.Errorf("unknown update name: %s", updateName)
+}
+
+// marshal encodes the Instance resource into JSON for a Create request, and
+// performs transformations from the resource schema to the API schema if
+// necessary.
+func (r *Instance) marshal(c *Client) ([]byte, error) {
+	m, err := expandInstance(c, r)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling Instance: %w", err)
+	}
+
+	return json.Marshal(m)
+}
+
+// unmarshalInstance decodes JSON responses into the Instance resource schema.
+func unmarshalInstance(b []byte, c *Client, res *Instance) (*Instance, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return unmarshalMapInstance(m, c, res)
+}
+
+func unmarshalMapInstance(m map[string]interface{}, c *Client, res *Instance) (*Instance, error) {
+
+	flattened := flattenInstance(c, m, res)
+	if flattened == nil {
+		return nil, fmt.Errorf("attempted to flatten empty json object")
+	}
+	return flattened, nil
+}
+
+// expandInstance expands Instance into a JSON request object.
+func expandInstance(c *Client, f *Instance) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	res := f
+	_ = res
+	if v, err := dcl.DeriveField("projects/%s/locations/%s/instances/%s", f.Name, dcl.SelfLinkToName(f.Project), dcl.SelfLinkToName(f.Location), dcl.SelfLinkToName(f.Name)); err != nil {
+		return nil, fmt.Errorf("error expanding Name into name: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["name"] = v
+	}
+	if v := f.DisplayName; dcl.ValueShouldBeSent(v) {
+		m["displayName"] = v
+	}
+	if v := f.Labels; dcl.ValueShouldBeSent(v) {
+		m["labels"] = v
+	}
+	if v := f.LocationId; dcl.ValueShouldBeSent(v) {
+		m["locationId"] = v
+	}
+	if v := f.AlternativeLocationId; dcl.ValueShouldBeSent(v) {
+		m["alternativeLocationId"] = v
+	}
+	if v := f.RedisVersion; dcl.ValueShouldBeSent(v) {
+		m["redisVersion"] = v
+	}
+	if v := f.ReservedIPRange; dcl.ValueShouldBeSent(v) {
+		m["reservedIpRange"] = v
+	}
+	if v := f.RedisConfigs; dcl.ValueShouldBeSent(v) {
+		m["redisConfigs"] = v
+	}
+	if v := f.Tier; dcl.ValueShouldBeSent(v) {
+		m["tier"] = v
+	}
+	if v := f.MemorySizeGb; dcl.ValueShouldBeSent(v) {
+		m["memorySizeGb"] = v
+	}
+	if v := f.AuthorizedNetwork; dcl.ValueShouldBeSent(v) {
+		m["authorizedNetwork"] = v
+	}
+	if v := f.ConnectMode; dcl.ValueShouldBeSent(v) {
+		m["connectMode"] = v
+	}
+	if v := f.AuthEnabled; dcl.ValueShouldBeSent(v) {
+		m["authEnabled"] = v
+	}
+	if v := f.TransitEncryptionMode; dcl.ValueShouldBeSent(v) {
+		m["transitEncryptionMode"] = v
+	}
+	if v, err := expandInstanceMaintenancePolicy(c, f.MaintenancePolicy, res); err != nil {
+		return nil, fmt.Errorf("error expanding MaintenancePolicy into maintenancePolicy: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["maintenancePolicy"] = v
+	}
+	if v, err := dcl.EmptyValue(); err != nil {
+		return nil, fmt.Errorf("error expanding Project into project: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["project"] = v
+	}
+	if v, err := dcl.EmptyValue(); err != nil {
+		return nil, fmt.Errorf("error expanding Location into location: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["location"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstance flattens Instance from a JSON request object into the
+// Instance type.
+func flattenInstance(c *Client, i interface{}, res *Instance) *Instance {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if len(m) == 0 {
+		return nil
+	}
+
+	resultRes := &Instance{}
+	resultRes.Name = dcl.FlattenString(m["name"])
+	resultRes.DisplayName = dcl.FlattenString(m["displayName"])
+	resultRes.Labels = dcl.FlattenKeyValuePairs(m["labels"])
+	resultRes.LocationId = dcl.FlattenString(m["locationId"])
+	resultRes.AlternativeLocationId = dcl.FlattenString(m["alternativeLocationId"])
+	resultRes.RedisVersion = dcl.FlattenString(m["redisVersion"])
+	resultRes.ReservedIPRange = dcl.FlattenString(m["reservedIpRange"])
+	resultRes.Host = dcl.FlattenString(m["host"])
+	resultRes.Port = dcl.FlattenInteger(m["port"])
+	resultRes.CurrentLocationId = dcl.FlattenString(m["currentLocationId"])
+	resultRes.CreateTime = dcl.FlattenString(m["createTime"])
+	resultRes.State = flattenInstanceStateEnum(m["state"])
+	resultRes.StatusMessage = dcl.FlattenString(m["statusMessage"])
+	resultRes.RedisConfigs = dcl.FlattenKeyValuePairs(m["redisConfigs"])
+	resultRes.Tier = flattenInstanceTierEnum(m["tier"])
+	resultRes.MemorySizeGb = dcl.FlattenInteger(m["memorySizeGb"])
+	resultRes.AuthorizedNetwork = dcl.FlattenString(m["authorizedNetwork"])
+	resultRes.PersistenceIamIdentity = dcl.FlattenString(m["persistenceIamIdentity"])
+	resultRes.ConnectMode = flattenInstanceConnectModeEnum(m["connectMode"])
+	resultRes.AuthEnabled = dcl.FlattenBool(m["authEnabled"])
+	resultRes.ServerCaCerts = flattenInstanceServerCaCertsSlice(c, m["serverCaCerts"], res)
+	resultRes.TransitEncryptionMode = flattenInstanceTransitEncryptionModeEnum(m["transitEncryptionMode"])
+	resultRes.MaintenancePolicy = flattenInstanceMaintenancePolicy(c, m["maintenancePolicy"], res)
+	resultRes.MaintenanceSchedule = flattenInstanceMaintenanceSchedule(c, m["maintenanceSchedule"], res)
+	resultRes.Project = dcl.FlattenString(m["project"])
+	resultRes.Location = dcl.FlattenString(m["location"])
+
+	return resultRes
+}
+
+// expandInstanceServerCaCertsMap expands the contents of InstanceServerCaCerts into a JSON
+// request object.
+func expandInstanceServerCaCertsMap(c *Client, f map[string]InstanceServerCaCerts, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceServerCaCerts(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceServerCaCertsSlice expands the contents of InstanceServerCaCerts into a JSON
+// request object.
+func expandInstanceServerCaCertsSlice(c *Client, f []InstanceServerCaCerts, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceServerCaCerts(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceServerCaCertsMap flattens the contents of InstanceServerCaCerts from a JSON
+// response object.
+func flattenInstanceServerCaCertsMap(c *Client, i interface{}, res *Instance) map[string]InstanceServerCaCerts {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceServerCaCerts{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceServerCaCerts{}
+	}
+
+	items := make(map[string]InstanceServerCaCerts)
+	for k, item := range a {
+		items[k] = *flattenInstanceServerCaCerts(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceServerCaCertsSlice flattens the contents of InstanceServerCaCerts from a JSON
+// response object.
+func flattenInstanceServerCaCertsSlice(c *Client, i interface{}, res *Instance) []InstanceServerCaCerts {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceServerCaCerts{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceServerCaCerts{}
+	}
+
+	items := make([]InstanceServerCaCerts, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceServerCaCerts(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceServerCaCerts expands an instance of InstanceServerCaCerts into a JSON
+// request object.
+func expandInstanceServerCaCerts(c *Client, f *InstanceServerCaCerts, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenInstanceServerCaCerts flattens an instance of InstanceServerCaCerts from a JSON
+// response object.
+func flattenInstanceServerCaCerts(c *Client, i interface{}, res *Instance) *InstanceServerCaCerts {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceServerCaCerts{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceServerCaCerts
+	}
+	r.SerialNumber = dcl.FlattenString(m["serialNumber"])
+	r.Cert = dcl.FlattenString(m["cert"])
+	r.CreateTime = dcl.FlattenString(m["createTime"])
+	r.ExpireTime = dcl.FlattenString(m["expireTime"])
+	r.Sha1Fingerprint = dcl.FlattenString(m["sha1Fingerprint"])
+
+	return r
+}
+
+// expandInstanceMaintenancePolicyMap expands the contents of InstanceMaintenancePolicy into a JSON
+// request object.
+func expandInstanceMaintenancePolicyMap(c *Client, f map[string]InstanceMaintenancePolicy, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceMaintenancePolicy(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceMaintenancePolicySlice expands the contents of InstanceMaintenancePolicy into a JSON
+// request object.
+func expandInstanceMaintenancePolicySlice(c *Client, f []InstanceMaintenancePolicy, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceMaintenancePolicy(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceMaintenancePolicyMap flattens the contents of InstanceMaintenancePolicy from a JSON
+// response object.
+func flattenInstanceMaintenancePolicyMap(c *Client, i interface{}, res *Instance) map[string]InstanceMaintenancePolicy {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceMaintenancePolicy{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceMaintenancePolicy{}
+	}
+
+	items := make(map[string]InstanceMaintenancePolicy)
+	for k, item := range a {
+		items[k] = *flattenInstanceMaintenancePolicy(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceMaintenancePolicySlice flattens the contents of InstanceMaintenancePolicy from a JSON
+// response object.
+func flattenInstanceMaintenancePolicySlice(c *Client, i interface{}, res *Instance) []InstanceMaintenancePolicy {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceMaintenancePolicy{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceMaintenancePolicy{}
+	}
+
+	items := make([]InstanceMaintenancePolicy, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceMaintenancePolicy(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceMaintenancePolicy expands an instance of InstanceMaintenancePolicy into a JSON
+// request object.
+func expandInstanceMaintenancePolicy(c *Client, f *InstanceMaintenancePolicy, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Description; !dcl.IsEmptyValueIndirect(v) {
+		m["description"] = v
+	}
+	if v, err := expandInstanceMaintenancePolicyWeeklyMaintenanceWindowSlice(c, f.WeeklyMaintenanceWindow, res); err != nil {
+		return nil, fmt.Errorf("error expanding WeeklyMaintenanceWindow into weeklyMaintenanceWindow: %w", err)
+	} else if v != nil {
+		m["weeklyMaintenanceWindow"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceMaintenancePolicy flattens an instance of InstanceMaintenancePolicy from a JSON
+// response object.
+func flattenInstanceMaintenancePolicy(c *Client, i interface{}, res *Instance) *InstanceMaintenancePolicy {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceMaintenancePolicy{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceMaintenancePolicy
+	}
+	r.CreateTime = dcl.FlattenString(m["createTime"])
+	r.UpdateTime = dcl.FlattenString(m["updateTime"])
+	r.Description = dcl.FlattenString(m["description"])
+	r.WeeklyMaintenanceWindow = flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowSlice(c, m["weeklyMaintenanceWindow"], res)
+
+	return r
+}
+
+// expandInstanceMaintenancePolicyWeeklyMaintenanceWindowMap expands the contents of InstanceMaintenancePolicyWeeklyMaintenanceWindow into a JSON
+// request object.
+func expandInstanceMaintenancePolicyWeeklyMaintenanceWindowMap(c *Client, f map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindow, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceMaintenancePolicyWeeklyMaintenanceWindow(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceMaintenancePolicyWeeklyMaintenanceWindowSlice expands the contents of InstanceMaintenancePolicyWeeklyMaintenanceWindow into a JSON
+// request object.
+func expandInstanceMaintenancePolicyWeeklyMaintenanceWindowSlice(c *Client, f []InstanceMaintenancePolicyWeeklyMaintenanceWindow, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceMaintenancePolicyWeeklyMaintenanceWindow(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowMap flattens the contents of InstanceMaintenancePolicyWeeklyMaintenanceWindow from a JSON
+// response object.
+func flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowMap(c *Client, i interface{}, res *Instance) map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindow {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindow{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindow{}
+	}
+
+	items := make(map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindow)
+	for k, item := range a {
+		items[k] = *flattenInstanceMaintenancePolicyWeeklyMaintenanceWindow(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowSlice flattens the contents of InstanceMaintenancePolicyWeeklyMaintenanceWindow from a JSON
+// response object.
+func flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowSlice(c *Client, i interface{}, res *Instance) []InstanceMaintenancePolicyWeeklyMaintenanceWindow {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceMaintenancePolicyWeeklyMaintenanceWindow{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceMaintenancePolicyWeeklyMaintenanceWindow{}
+	}
+
+	items := make([]InstanceMaintenancePolicyWeeklyMaintenanceWindow, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceMaintenancePolicyWeeklyMaintenanceWindow(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceMaintenancePolicyWeeklyMaintenanceWindow expands an instance of InstanceMaintenancePolicyWeeklyMaintenanceWindow into a JSON
+// request object.
+func expandInstanceMaintenancePolicyWeeklyMaintenanceWindow(c *Client, f *InstanceMaintenancePolicyWeeklyMaintenanceWindow, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Day; !dcl.IsEmptyValueIndirect(v) {
+		m["day"] = v
+	}
+	if v, err := expandInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(c, f.StartTime, res); err != nil {
+		return nil, fmt.Errorf("error expanding StartTime into startTime: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["startTime"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceMaintenancePolicyWeeklyMaintenanceWindow flattens an instance of InstanceMaintenancePolicyWeeklyMaintenanceWindow from a JSON
+// response object.
+func flattenInstanceMaintenancePolicyWeeklyMaintenanceWindow(c *Client, i interface{}, res *Instance) *InstanceMaintenancePolicyWeeklyMaintenanceWindow {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceMaintenancePolicyWeeklyMaintenanceWindow{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceMaintenancePolicyWeeklyMaintenanceWindow
+	}
+	r.Day = flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum(m["day"])
+	r.StartTime = flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(c, m["startTime"], res)
+	r.Duration = dcl.FlattenString(m["duration"])
+
+	return r
+}
+
+// expandInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeMap expands the contents of InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime into a JSON
+// request object.
+func expandInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeMap(c *Client, f map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeSlice expands the contents of InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime into a JSON
+// request object.
+func expandInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeSlice(c *Client, f []InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeMap flattens the contents of InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime from a JSON
+// response object.
+func flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeMap(c *Client, i interface{}, res *Instance) map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime{}
+	}
+
+	items := make(map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime)
+	for k, item := range a {
+		items[k] = *flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeSlice flattens the contents of InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime from a JSON
+// response object.
+func flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeSlice(c *Client, i interface{}, res *Instance) []InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime{}
+	}
+
+	items := make([]InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime expands an instance of InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime into a JSON
+// request object.
+func expandInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(c *Client, f *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Hours; !dcl.IsEmptyValueIndirect(v) {
+		m["hours"] = v
+	}
+	if v := f.Minutes; !dcl.IsEmptyValueIndirect(v) {
+		m["minutes"] = v
+	}
+	if v := f.Seconds; !dcl.IsEmptyValueIndirect(v) {
+		m["seconds"] = v
+	}
+	if v := f.Nanos; !dcl.IsEmptyValueIndirect(v) {
+		m["nanos"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime flattens an instance of InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime from a JSON
+// response object.
+func flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime(c *Client, i interface{}, res *Instance) *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime
+	}
+	r.Hours = dcl.FlattenInteger(m["hours"])
+	r.Minutes = dcl.FlattenInteger(m["minutes"])
+	r.Seconds = dcl.FlattenInteger(m["seconds"])
+	r.Nanos = dcl.FlattenInteger(m["nanos"])
+
+	return r
+}
+
+// expandInstanceMaintenanceScheduleMap expands the contents of InstanceMaintenanceSchedule into a JSON
+// request object.
+func expandInstanceMaintenanceScheduleMap(c *Client, f map[string]InstanceMaintenanceSchedule, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceMaintenanceSchedule(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceMaintenanceScheduleSlice expands the contents of InstanceMaintenanceSchedule into a JSON
+// request object.
+func expandInstanceMaintenanceScheduleSlice(c *Client, f []InstanceMaintenanceSchedule, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceMaintenanceSchedule(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceMaintenanceScheduleMap flattens the contents of InstanceMaintenanceSchedule from a JSON
+// response object.
+func flattenInstanceMaintenanceScheduleMap(c *Client, i interface{}, res *Instance) map[string]InstanceMaintenanceSchedule {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceMaintenanceSchedule{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceMaintenanceSchedule{}
+	}
+
+	items := make(map[string]InstanceMaintenanceSchedule)
+	for k, item := range a {
+		items[k] = *flattenInstanceMaintenanceSchedule(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceMaintenanceScheduleSlice flattens the contents of InstanceMaintenanceSchedule from a JSON
+// response object.
+func flattenInstanceMaintenanceScheduleSlice(c *Client, i interface{}, res *Instance) []InstanceMaintenanceSchedule {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceMaintenanceSchedule{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceMaintenanceSchedule{}
+	}
+
+	items := make([]InstanceMaintenanceSchedule, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceMaintenanceSchedule(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceMaintenanceSchedule expands an instance of InstanceMaintenanceSchedule into a JSON
+// request object.
+func expandInstanceMaintenanceSchedule(c *Client, f *InstanceMaintenanceSchedule, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenInstanceMaintenanceSchedule flattens an instance of InstanceMaintenanceSchedule from a JSON
+// response object.
+func flattenInstanceMaintenanceSchedule(c *Client, i interface{}, res *Instance) *InstanceMaintenanceSchedule {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceMaintenanceSchedule{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceMaintenanceSchedule
+	}
+	r.StartTime = dcl.FlattenString(m["startTime"])
+	r.EndTime = dcl.FlattenString(m["endTime"])
+	r.CanReschedule = dcl.FlattenBool(m["canReschedule"])
+	r.ScheduleDeadlineTime = dcl.FlattenString(m["scheduleDeadlineTime"])
+
+	return r
+}
+
+// flattenInstanceConnectModeEnumMap flattens the contents of InstanceConnectModeEnum from a JSON
+// response object.
+func flattenInstanceConnectModeEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceConnectModeEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceConnectModeEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceConnectModeEnum{}
+	}
+
+	items := make(map[string]InstanceConnectModeEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceConnectModeEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceConnectModeEnumSlice flattens the contents of InstanceConnectModeEnum from a JSON
+// response object.
+func flattenInstanceConnectModeEnumSlice(c *Client, i interface{}, res *Instance) []InstanceConnectModeEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceConnectModeEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceConnectModeEnum{}
+	}
+
+	items := make([]InstanceConnectModeEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceConnectModeEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceConnectModeEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceConnectModeEnum with the same value as that string.
+func flattenInstanceConnectModeEnum(i interface{}) *InstanceConnectModeEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceConnectModeEnumRef(s)
+}
+
+// flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnumMap flattens the contents of InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum from a JSON
+// response object.
+func flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum{}
+	}
+
+	items := make(map[string]InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnumSlice flattens the contents of InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum from a JSON
+// response object.
+func flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnumSlice(c *Client, i interface{}, res *Instance) []InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum{}
+	}
+
+	items := make([]InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum with the same value as that string.
+func flattenInstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum(i interface{}) *InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnumRef(s)
+}
+
+// flattenInstanceStateEnumMap flattens the contents of InstanceStateEnum from a JSON
+// response object.
+func flattenInstanceStateEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceStateEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceStateEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceStateEnum{}
+	}
+
+	items := make(map[string]InstanceStateEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceStateEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceStateEnumSlice flattens the contents of InstanceStateEnum from a JSON
+// response object.
+func flattenInstanceStateEnumSlice(c *Client, i interface{}, res *Instance) []InstanceStateEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceStateEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceStateEnum{}
+	}
+
+	items := make([]InstanceStateEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceStateEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceStateEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceStateEnum with the same value as that string.
+func flattenInstanceStateEnum(i interface{}) *InstanceStateEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceStateEnumRef(s)
+}
+
+// flattenInstanceTierEnumMap flattens the contents of InstanceTierEnum from a JSON
+// response object.
+func flattenInstanceTierEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceTierEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceTierEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceTierEnum{}
+	}
+
+	items := make(map[string]InstanceTierEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceTierEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceTierEnumSlice flattens the contents of InstanceTierEnum from a JSON
+// response object.
+func flattenInstanceTierEnumSlice(c *Client, i interface{}, res *Instance) []InstanceTierEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceTierEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceTierEnum{}
+	}
+
+	items := make([]InstanceTierEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceTierEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceTierEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceTierEnum with the same value as that string.
+func flattenInstanceTierEnum(i interface{}) *InstanceTierEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceTierEnumRef(s)
+}
+
+// flattenInstanceTransitEncryptionModeEnumMap flattens the contents of InstanceTransitEncryptionModeEnum from a JSON
+// response object.
+func flattenInstanceTransitEncryptionModeEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceTransitEncryptionModeEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceTransitEncryptionModeEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceTransitEncryptionModeEnum{}
+	}
+
+	items := make(map[string]InstanceTransitEncryptionModeEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceTransitEncryptionModeEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceTransitEncryptionModeEnumSlice flattens the contents of InstanceTransitEncryptionModeEnum from a JSON
+// response object.
+func flattenInstanceTransitEncryptionModeEnumSlice(c *Client, i interface{}, res *Instance) []InstanceTransitEncryptionModeEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceTransitEncryptionModeEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceTransitEncryptionModeEnum{}
+	}
+
+	items := make([]InstanceTransitEncryptionModeEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceTransitEncryptionModeEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceTransitEncryptionModeEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceTransitEncryptionModeEnum with the same value as that string.
+func flattenInstanceTransitEncryptionModeEnum(i interface{}) *InstanceTransitEncryptionModeEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceTransitEncryptionModeEnumRef(s)
+}
+
+// This function returns a matcher that checks whether a serialized resource matches this resource
+// in its parameters (as defined by the fields in a Get, which definitionally define resource
+// identity).  This is useful in extracting the element from a List call.
+func (r *Instance) matcher(c *Client) func([]byte) bool {
+	return func(b []byte) bool {
+		cr, err := unmarshalInstance(b, c, r)
+		if err != nil {
+			c.Config.Logger.Warning("failed to unmarshal provided resource in matcher.")
+			return false
+		}
+		nr := r.urlNormalized()
+		ncr := cr.urlNormalized()
+		c.Config.Logger.Infof("looking for %v\nin %v", nr, ncr)
+
+		if nr.Project == nil && ncr.Project == nil {
+			c.Config.Logger.Info("Both Project fields null - considering equal.")
+		} else if nr.Project == nil || ncr.Project == nil {
+			c.Config.Logger.Info("Only one Project field is null - considering unequal.")
+			return false
+		} else if *nr.Project != *ncr.Project {
+			return false
+		}
+		if nr.Location == nil && ncr.Location == nil {
+			c.Config.Logger.Info("Both Location fields null - considering equal.")
+		} else if nr.Location == nil || ncr.Location == nil {
+			c.Config.Logger.Info("Only one Location field is null - considering unequal.")
+			return false
+		} else if *nr.Location != *ncr.Location {
+			return false
+		}
+		if nr.Name == nil && ncr.Name == nil {
+			c.Config.Logger.Info("Both Name fields null - considering equal.")
+		} else if nr.Name == nil || ncr.Name == nil {
+			c.Config.Logger.Info("Only one Name field is null - considering unequal.")
+			return false
+		} else if *nr.Name != *ncr.Name {
+			return false
+		}
+		return true
+	}
+}
+
+type instanceDiff struct {
+	// The diff should include one or the other of RequiresRecreate or UpdateOp.
+	RequiresRecreate bool
+	UpdateOp         instanceApiOperation
+	FieldName        string // used for error logging
+}
+
+func convertFieldDiffsToInstanceDiffs(config *dcl.Config, fds []*dcl.FieldDiff, opts []dcl.ApplyOption) ([]instanceDiff, error) {
+	opNamesToFieldDiffs := make(map[string][]*dcl.FieldDiff)
+	// Map each operation name to the field diffs associated with it.
+	for _, fd := range fds {
+		for _, ro := range fd.ResultingOperation {
+			if fieldDiffs, ok := opNamesToFieldDiffs[ro]; ok {
+				fieldDiffs = append(fieldDiffs, fd)
+				opNamesToFieldDiffs[ro] = fieldDiffs
+			} else {
+				config.Logger.Infof("%s required due to diff: %v", ro, fd)
+				opNamesToFieldDiffs[ro] = []*dcl.FieldDiff{fd}
+			}
+		}
+	}
+	var diffs []instanceDiff
+	// For each operation name, create a instanceDiff which contains the operation.
+	for opName, fieldDiffs := range opNamesToFieldDiffs {
+		// Use the first field diff's field name for logging required recreate error.
+		diff := instanceDiff{FieldName: fieldDiffs[0].FieldName}
+		if opName == "Recreate" {
+			diff.RequiresRecreate = true
+		} else {
+			apiOp, err := convertOpNameToInstanceApiOperation(opName, fieldDiffs, opts...)
+			if err != nil {
+				return diffs, err
+			}
+			diff.UpdateOp = apiOp
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+func convertOpNameToInstanceApiOperation(opName string, fieldDiffs []*dcl.FieldDiff, opts ...dcl.ApplyOption) (instanceApiOperation, error) {
+	switch opName {
+
+	case "updateInstanceUpdateInstanceOperation":
+		return &updateInstanceUpdateInstanceOperation{FieldDiffs: fieldDiffs}, nil
+
+	case "updateInstanceUpgradeInstanceOperation":
+		return &updateInstanceUpgradeInstanceOperation{FieldDiffs: fieldDiffs}, nil
+
+	default:
+		return nil, fmt.Errorf("no such operation with name: %v", opName)
+	}
+}
+
+func extractInstanceFields(r *Instance) error {
+	vMaintenancePolicy := r.MaintenancePolicy
+	if vMaintenancePolicy == nil {
+		// note: explicitly not the empty object.
+		vMaintenancePolicy = &InstanceMaintenancePolicy{}
+	}
+	if err := extractInstanceMaintenancePolicyFields(r, vMaintenancePolicy); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMaintenancePolicy) {
+		r.MaintenancePolicy = vMaintenancePolicy
+	}
+	vMaintenanceSchedule := r.MaintenanceSchedule
+	if vMaintenanceSchedule == nil {
+		// note: explicitly not the empty object.
+		vMaintenanceSchedule = &InstanceMaintenanceSchedule{}
+	}
+	if err := extractInstanceMaintenanceScheduleFields(r, vMaintenanceSchedule); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMaintenanceSchedule) {
+		r.MaintenanceSchedule = vMaintenanceSchedule
+	}
+	return nil
+}
+func extractInstanceServerCaCertsFields(r *Instance, o *InstanceServerCaCerts) error {
+	return nil
+}
+func extractInstanceMaintenancePolicyFields(r *Instance, o *InstanceMaintenancePolicy) error {
+	return nil
+}
+func extractInstanceMaintenancePolicyWeeklyMaintenanceWindowFields(r *Instance, o *InstanceMaintenancePolicyWeeklyMaintenanceWindow) error {
+	vStartTime := o.StartTime
+	if vStartTime == nil {
+		// note: explicitly not the empty object.
+		vStartTime = &InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime{}
+	}
+	if err := extractInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeFields(r, vStartTime); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vStartTime) {
+		o.StartTime = vStartTime
+	}
+	return nil
+}
+func extractInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeFields(r *Instance, o *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime) error {
+	return nil
+}
+func extractInstanceMaintenanceScheduleFields(r *Instance, o *InstanceMaintenanceSchedule) error {
+	return nil
+}
+
+func postReadExtractInstanceFields(r *Instance) error {
+	vMaintenancePolicy := r.MaintenancePolicy
+	if vMaintenancePolicy == nil {
+		// note: explicitly not the empty object.
+		vMaintenancePolicy = &InstanceMaintenancePolicy{}
+	}
+	if err := postReadExtractInstanceMaintenancePolicyFields(r, vMaintenancePolicy); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMaintenancePolicy) {
+		r.MaintenancePolicy = vMaintenancePolicy
+	}
+	vMaintenanceSchedule := r.MaintenanceSchedule
+	if vMaintenanceSchedule == nil {
+		// note: explicitly not the empty object.
+		vMaintenanceSchedule = &InstanceMaintenanceSchedule{}
+	}
+	if err := postReadExtractInstanceMaintenanceScheduleFields(r, vMaintenanceSchedule); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMaintenanceSchedule) {
+		r.MaintenanceSchedule = vMaintenanceSchedule
+	}
+	return nil
+}
+func postReadExtractInstanceServerCaCertsFields(r *Instance, o *InstanceServerCaCerts) error {
+	return nil
+}
+func postReadExtractInstanceMaintenancePolicyFields(r *Instance, o *InstanceMaintenancePolicy) error {
+	return nil
+}
+func postReadExtractInstanceMaintenancePolicyWeeklyMaintenanceWindowFields(r *Instance, o *InstanceMaintenancePolicyWeeklyMaintenanceWindow) error {
+	vStartTime := o.StartTime
+	if vStartTime == nil {
+		// note: explicitly not the empty object.
+		vStartTime = &InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime{}
+	}
+	if err := postReadExtractInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeFields(r, vStartTime); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vStartTime) {
+		o.StartTime = vStartTime
+	}
+	return nil
+}
+func postReadExtractInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTimeFields(r *Instance, o *InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime) error {
+	return nil
+}
+func postReadExtractInstanceMaintenanceScheduleFields(r *Instance, o *InstanceMaintenanceSchedule) error {
+	return nil
+}
@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package redis -var YAML_instance blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/redis/instance.yaml
+
+package redis
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/redis/instance.yaml
+var YAML_instance = []byte("info:\n  title: Redis/Instance\n  description: The Redis Instance resource\n  x-dcl-struct-name: Instance\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a Instance\n    parameters:\n    - name: instance\n      required: true\n      description: A full instance of a Instance\n  apply:\n    description: The function used to apply information about a Instance\n    parameters:\n    - name: instance\n      required: true\n      description: A full instance of a Instance\n  delete:\n    description: The function used to delete a Instance\n    parameters:\n    - name: instance\n      required: true\n      description: A full instance of a Instance\n  deleteAll:\n    description: The function used to delete all Instance\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: location\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many Instance\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: location\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    Instance:\n      title: Instance\n      x-dcl-id: projects/{{project}}/locations/{{location}}/instances/{{name}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - name\n      - tier\n      - memorySizeGb\n      - project\n      - location\n      properties:\n        alternativeLocationId:\n          type: string\n          x-dcl-go-name: AlternativeLocationId\n          description: Optional. Only applicable to STANDARD_HA tier which protects\n            the instance against zonal failures by provisioning it across two zones.\n            If provided, it must be a different zone from the one provided in location_id.\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n        authEnabled:\n          type: boolean\n          x-dcl-go-name: AuthEnabled\n          description: Optional. Indicates whether OSS Redis AUTH is enabled for the\n            instance. If set to \"true\" AUTH is enabled on the instance. Default value\n            is \"false\" meaning AUTH is disabled.\n        authorizedNetwork:\n          type: string\n          x-dcl-go-name: AuthorizedNetwork\n          description: Optional. The full name of the Google Compute Engine [network](https://cloud.google.com/vpc/docs/vpc)\n            to which the instance is connected. If left unspecified, the `default`\n            network will be used.\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n        connectMode:\n          type: string\n          x-dcl-go-name: ConnectMode\n          x-dcl-go-type: InstanceConnectModeEnum\n          description: 'Optional. The network connect mode of the Redis instance.\n            If not provided, the connect mode defaults to DIRECT_PEERING. Possible\n            values: CONNECT_MODE_UNSPECIFIED, DIRECT_PEERING, PRIVATE_SERVICE_ACCESS'\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n          enum:\n          - CONNECT_MODE_UNSPECIFIED\n          - DIRECT_PEERING\n          - PRIVATE_SERVICE_ACCESS\n        createTime:\n          type: string\n          x-dcl-go-name: CreateTime\n          readOnly: true\n          description: Output only. The time the instance was created.\n          x-kubernetes-immutable: true\n        currentLocationId:\n          type: string\n          x-dcl-go-name: CurrentLocationId\n          readOnly: true\n          description: Output only. The current zone where the Redis endpoint is placed.\n            For Basic Tier instances, this will always be the same as the location_id\n            provided by the user at creation time. For Standard Tier instances, this\n            can be either location_id or alternative_location_id and can change after\n            a failover event.\n          x-kubernetes-immutable: true\n        displayName:\n          type: string\n          x-dcl-go-name: DisplayName\n          description: An arbitrary and optional user-provided name for the instance.\n        host:\n          type: string\n          x-dcl-go-name: Host\n          readOnly: true\n          description: Output only. Hostname or IP address of the exposed Redis endpoint\n            used by clients to connect to the service.\n          x-kubernetes-immutable: true\n        labels:\n          type: object\n          additionalProperties:\n            type: string\n          x-dcl-go-name: Labels\n          description: Resource labels to represent user provided metadata\n        location:\n          type: string\n          x-dcl-go-name: Location\n          description: The location for the resource\n        locationId:\n          type: string\n          x-dcl-go-name: LocationId\n          description: Optional. The zone where the instance will be provisioned.\n            If not provided, the service will choose a zone for the instance. For\n            STANDARD_HA tier, instances will be created across two zones for protection\n            against zonal failures. If alternative_location_id is also provided, it\n            must be different from location_id.\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n        maintenancePolicy:\n          type: object\n          x-dcl-go-name: MaintenancePolicy\n          x-dcl-go-type: InstanceMaintenancePolicy\n          description: Optional. The maintenance policy for the instance. If not provided,\n            maintenance events can be performed at any time.\n          properties:\n            createTime:\n              type: string\n              x-dcl-go-name: CreateTime\n              readOnly: true\n              description: Output only. The time when the policy was created.\n              x-kubernetes-immutable: true\n            description:\n              type: string\n              x-dcl-go-name: Description\n              description: Optional. Description of what this policy is for. Create/Update\n                methods return INVALID_ARGUMENT if the length is greater than 512.\n            updateTime:\n              type: string\n              x-dcl-go-name: UpdateTime\n              readOnly: true\n              description: Output only. The time when the policy was last updated.\n              x-kubernetes-immutable: true\n            weeklyMaintenanceWindow:\n              type: array\n              x-dcl-go-name: WeeklyMaintenanceWindow\n              description: Optional. Maintenance window that is applied to resources\n                covered by this policy. Minimum 1. For the current version, the maximum\n                number of weekly_window is expected to be one.\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: object\n                x-dcl-go-type: InstanceMaintenancePolicyWeeklyMaintenanceWindow\n                required:\n                - day\n                - startTime\n                properties:\n                  day:\n                    type: string\n                    x-dcl-go-name: Day\n                    x-dcl-go-type: InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnum\n                    description: 'Required. The day of week that maintenance updates\n                      occur. Possible values: DAY_OF_WEEK_UNSPECIFIED, MONDAY, TUESDAY,\n                      WEDNESDAY, THURSDAY, FRIDAY, SATURDAY, SUNDAY'\n                    enum:\n                    - DAY_OF_WEEK_UNSPECIFIED\n                    - MONDAY\n                    - TUESDAY\n                    - WEDNESDAY\n                    - THURSDAY\n                    - FRIDAY\n                    - SATURDAY\n                    - SUNDAY\n                  duration:\n                    type: string\n                    x-dcl-go-name: Duration\n                    readOnly: true\n                    description: Output only. Duration of the maintenance window.\n                      The current window is fixed at 1 hour.\n                    x-kubernetes-immutable: true\n                  startTime:\n                    type: object\n                    x-dcl-go-name: StartTime\n                    x-dcl-go-type: InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime\n                    description: Required. Start time of the window in UTC time.\n                    properties:\n                      hours:\n                        type: integer\n                        format: int64\n                        x-dcl-go-name: Hours\n                        description: Hours of day in 24 hour format. Should be from\n                          0 to 23. An API may choose to allow the value \"24:00:00\"\n                          for scenarios like business closing time.\n                      minutes:\n                        type: integer\n                        format: int64\n                        x-dcl-go-name: Minutes\n                        description: Minutes of hour of day. Must be from 0 to 59.\n                      nanos:\n                        type: integer\n                        format: int64\n                        x-dcl-go-name: Nanos\n                        description: Fractions of seconds in nanoseconds. Must be\n                          from 0 to 999,999,999.\n                      seconds:\n                        type: integer\n                        format: int64\n                        x-dcl-go-name: Seconds\n                        description: Seconds of minutes of the time. Must normally\n                          be from 0 to 59. An API may allow the value 60 if it allows\n                          leap-seconds.\n        maintenanceSchedule:\n          type: object\n          x-dcl-go-name: MaintenanceSchedule\n          x-dcl-go-type: InstanceMaintenanceSchedule\n          readOnly: true\n          description: Output only. Date and time of upcoming maintenance events which\n            have been scheduled.\n          x-kubernetes-immutable: true\n          properties:\n            canReschedule:\n              type: boolean\n              x-dcl-go-name: CanReschedule\n              readOnly: true\n              description: If the scheduled maintenance can be rescheduled, default\n                is true.\n              x-kubernetes-immutable: true\n            endTime:\n              type: string\n              x-dcl-go-name: EndTime\n              readOnly: true\n              description: Output only. The end time of any upcoming scheduled maintenance\n                for this instance.\n              x-kubernetes-immutable: true\n            scheduleDeadlineTime:\n              type: string\n              x-dcl-go-name: ScheduleDeadlineTime\n              readOnly: true\n              description: Output only. The deadline that the maintenance schedule\n                start time can not go beyond, including reschedule.\n              x-kubernetes-immutable: true\n            startTime:\n              type: string\n              x-dcl-go-name: StartTime\n              readOnly: true\n              description: Output only. The start time of any upcoming scheduled maintenance\n                for this instance.\n              x-kubernetes-immutable: true\n        memorySizeGb:\n          type: integer\n          format: int64\n          x-dcl-go-name: MemorySizeGb\n          description: Required. Redis memory size in GiB. Resizing a STANDARD_HA\n            instance is performed without downtime; resizing a BASIC instance flushes\n            its data.\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: 'Required. Unique name of the resource in this scope including\n            project and location using the form: `projects/{project_id}/locations/{location_id}/instances/{instance_id}`\n            Note: Redis instances are managed and addressed at regional level so location_id\n            here refers to a GCP region; however, users may choose which specific\n            zone (or collection of zones for cross-zone instances) an instance should\n            be provisioned in. Refer to location_id and alternative_location_id fields\n            for more details.'\n          x-kubernetes-immutable: true\n        persistenceIamIdentity:\n          type: string\n          x-dcl-go-name: PersistenceIamIdentity\n          readOnly: true\n          description: Output only. Cloud IAM identity used by import / export operations\n            to transfer data to/from Cloud Storage. Format is \"serviceAccount:\". The\n            value may change over time for a given instance so should be checked before\n            each import/export operation.\n          x-kubernetes-immutable: true\n        port:\n          type: integer\n          format: int64\n          x-dcl-go-name: Port\n          readOnly: true\n          description: Output only. The port number of the exposed Redis endpoint.\n          x-kubernetes-immutable: true\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: The project for the resource\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        redisConfigs:\n          type: object\n          additionalProperties:\n            type: string\n          x-dcl-go-name: RedisConfigs\n          description: 'Optional. Redis configuration parameters, according to http://redis.io/topics/config.\n            Currently, the only supported parameters are: Redis version 3.2 and newer:\n            * maxmemory-policy * notify-keyspace-events Redis version 4.0 and newer:\n            * activedefrag * lfu-decay-time * lfu-log-factor * maxmemory-gb Redis\n            version 5.0 and newer: * stream-node-max-bytes * stream-node-max-entries'\n        redisVersion:\n          type: string\n          x-dcl-go-name: RedisVersion\n          description: 'Optional. The version of Redis software. If not provided,\n            latest supported version will be used. Currently, the supported values\n            are: * `REDIS_3_2` for Redis 3.2 compatibility * `REDIS_4_0` for Redis\n            4.0 compatibility (default) * `REDIS_5_0` for Redis 5.0 compatibility\n            * `REDIS_6_X` for Redis 6.x compatibility. Changing the version upgrades\n            the instance in place; downgrades are not supported by the service.'\n          x-dcl-server-default: true\n        reservedIpRange:\n          type: string\n          x-dcl-go-name: ReservedIPRange\n          description: Optional. For DIRECT_PEERING mode, the CIDR range of internal\n            addresses that are reserved for this instance. Range must be unique and\n            non-overlapping with existing subnets in an authorized network. For PRIVATE_SERVICE_ACCESS\n            mode, the name of one allocated IP address ranges associated with this\n            private service access connection. If not provided, the service will choose\n            an unused /29 block, for example, 10.0.0.0/29 or 192.168.0.0/29.\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n        serverCaCerts:\n          type: array\n          x-dcl-go-name: ServerCaCerts\n          readOnly: true\n          description: Output only. List of server CA certificates for the instance.\n          x-kubernetes-immutable: true\n          x-dcl-send-empty: true\n          x-dcl-list-type: list\n          items:\n            type: object\n            x-dcl-go-type: InstanceServerCaCerts\n            properties:\n              cert:\n                type: string\n                x-dcl-go-name: Cert\n                readOnly: true\n                description: PEM representation.\n                x-kubernetes-immutable: true\n              createTime:\n                type: string\n                x-dcl-go-name: CreateTime\n                readOnly: true\n                description: The time when the certificate was created in [RFC 3339](https://tools.ietf.org/html/rfc3339)\n                  format, for example `2020-05-18T00:00:00.094Z`.\n                x-kubernetes-immutable: true\n              expireTime:\n                type: string\n                x-dcl-go-name: ExpireTime\n                readOnly: true\n                description: The time when the certificate expires in [RFC 3339](https://tools.ietf.org/html/rfc3339)\n                  format, for example `2020-05-18T00:00:00.094Z`.\n                x-kubernetes-immutable: true\n              serialNumber:\n                type: string\n                x-dcl-go-name: SerialNumber\n                readOnly: true\n                description: Serial number, as extracted from the certificate.\n                x-kubernetes-immutable: true\n              sha1Fingerprint:\n                type: string\n                x-dcl-go-name: Sha1Fingerprint\n                readOnly: true\n                description: Sha1 Fingerprint of the certificate.\n                x-kubernetes-immutable: true\n        state:\n          type: string\n          x-dcl-go-name: State\n          x-dcl-go-type: InstanceStateEnum\n          readOnly: true\n          description: 'Output only. The current state of this instance. Possible\n            values: STATE_UNSPECIFIED, CREATING, READY, UPDATING, DELETING, REPAIRING,\n            PERFORMING_MAINTENANCE, IMPORTING, FAILING_OVER'\n          x-kubernetes-immutable: true\n          enum:\n          - STATE_UNSPECIFIED\n          - CREATING\n          - READY\n          - UPDATING\n          - DELETING\n          - REPAIRING\n          - PERFORMING_MAINTENANCE\n          - IMPORTING\n          - FAILING_OVER\n        statusMessage:\n          type: string\n          x-dcl-go-name: StatusMessage\n          readOnly: true\n          description: Output only. Additional information about the current status\n            of this instance, if available.\n          x-kubernetes-immutable: true\n        tier:\n          type: string\n          x-dcl-go-name: Tier\n          x-dcl-go-type: InstanceTierEnum\n          description: 'Required. The service tier of the instance. The tier cannot\n            be changed on an existing instance; changing it requires the instance\n            to be recreated. Possible values: TIER_UNSPECIFIED, BASIC, STANDARD_HA'\n          x-kubernetes-immutable: true\n          enum:\n          - TIER_UNSPECIFIED\n          - BASIC\n          - STANDARD_HA\n        transitEncryptionMode:\n          type: string\n          x-dcl-go-name: TransitEncryptionMode\n          x-dcl-go-type: InstanceTransitEncryptionModeEnum\n          description: 'Optional. The TLS mode of the Redis instance. If not provided,\n            TLS is disabled for the instance. Possible values: TRANSIT_ENCRYPTION_MODE_UNSPECIFIED,\n            SERVER_AUTHENTICATION, DISABLED'\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n          enum:\n          - TRANSIT_ENCRYPTION_MODE_UNSPECIFIED\n          - SERVER_AUTHENTICATION\n          - DISABLED\n")
+
+// 19010 bytes
+// MD5: c668866ace9392eed0acdf9c3d2b1894
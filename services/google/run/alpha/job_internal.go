@@ -597,7 +597,7 @@ func (c *Client) jobDiffsForRawDesired(ctx context.Context, rawDesired *Job, opt
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Job resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Job resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Job resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Job resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -611,6 +611,12 @@ func (c *Client) jobDiffsForRawDesired(ctx context.Context, rawDesired *Job, opt
 	if err := extractJobFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeJobInitialState(rawInitial, rawDesired)
@@ -628,7 +634,7 @@ func (c *Client) jobDiffsForRawDesired(ctx context.Context, rawDesired *Job, opt
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffJob(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeJobInitialState(rawInitial, rawDesired *Job) (*Job, error) {
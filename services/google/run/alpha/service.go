@@ -1387,8 +1387,12 @@ func (l *ServiceList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ServiceList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ServiceList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Service{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -1405,7 +1409,8 @@ func (l *ServiceList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListService(ctx context.Context, project, location string) (*ServiceList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Service{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Service{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListServiceWithMaxResults(ctx, project, location, ServiceMaxPage)
@@ -1413,7 +1418,11 @@ func (c *Client) ListService(ctx context.Context, project, location string) (*Se
 }
 
 func (c *Client) ListServiceWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*ServiceList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListServiceWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListServiceWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*ServiceList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Service{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -1421,7 +1430,7 @@ func (c *Client) ListServiceWithMaxResults(ctx context.Context, project, locatio
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listService(ctx, r, "", pageSize)
+	items, token, err := c.listService(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -1435,7 +1444,8 @@ func (c *Client) ListServiceWithMaxResults(ctx context.Context, project, locatio
 
 func (c *Client) GetService(ctx context.Context, r *Service) (*Service, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Service{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Service{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -1451,7 +1461,7 @@ func (c *Client) GetService(ctx context.Context, r *Service) (*Service, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalService(b, c, r)
 	if err != nil {
@@ -1477,7 +1487,8 @@ func (c *Client) GetService(ctx context.Context, r *Service) (*Service, error) {
 
 func (c *Client) DeleteService(ctx context.Context, r *Service) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Service{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Service{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -1485,7 +1496,13 @@ func (c *Client) DeleteService(ctx context.Context, r *Service) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Service...")
 	deleteOp := deleteServiceOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteServiceAsync(ctx context.Context, r *Service) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteService(ctx, r)
+	})
 }
 
 // DeleteAllService deletes all resources that the filter functions returns true on.
@@ -1513,10 +1530,11 @@ func (c *Client) DeleteAllService(ctx context.Context, project, location string,
 }
 
 func (c *Client) ApplyService(ctx context.Context, rawDesired *Service, opts ...dcl.ApplyOption) (*Service, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Service{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Service{}).Describe())
 	var resultNewState *Service
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyServiceHelper(c, ctx, rawDesired, opts...)
@@ -1534,6 +1552,38 @@ func (c *Client) ApplyService(ctx context.Context, rawDesired *Service, opts ...
 	return resultNewState, err
 }
 
+func (c *Client) ApplyServiceAsync(ctx context.Context, rawDesired *Service, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyService(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffService returns the field-level differences between rawDesired and the
+// live Service without modifying it. If the Service does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffService(ctx context.Context, rawDesired *Service, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Service{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Service{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractServiceFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.serviceDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Service %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyServiceHelper(c *Client, ctx context.Context, rawDesired *Service, opts ...dcl.ApplyOption) (*Service, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyService...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -1542,6 +1592,9 @@ func applyServiceHelper(c *Client, ctx context.Context, rawDesired *Service, opt
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractServiceFields(rawDesired); err != nil {
 		return nil, err
@@ -1600,7 +1653,7 @@ func applyServiceHelper(c *Client, ctx context.Context, rawDesired *Service, opt
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
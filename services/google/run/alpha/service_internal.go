@@ -54,6 +54,9 @@ func (r *Service) validate() error {
 			return err
 		}
 	}
+	if err := validateServiceTraffic(r.Traffic); err != nil {
+		return err
+	}
 	return nil
 }
 func (r *ServiceBinaryAuthorization) validate() error {
@@ -384,7 +387,7 @@ func (op *updateServiceUpdateServiceOperation) do(ctx context.Context, r *Servic
 		return err
 	}
 
-	return nil
+	return r.waitForServiceReady(ctx, c)
 }
 
 func (c *Client) listServiceRaw(ctx context.Context, r *Service, pageToken string, pageSize int32) ([]byte, error) {
@@ -527,8 +530,8 @@ func (op *createServiceOperation) do(ctx context.Context, r *Service, c *Client)
 	c.Config.Logger.InfoWithContextf(ctx, "Successfully waited for operation")
 	op.response, _ = o.FirstResponse()
 
-	if _, err := c.GetService(ctx, r); err != nil {
-		c.Config.Logger.WarningWithContextf(ctx, "get returned error: %v", err)
+	if err := r.waitForServiceReady(ctx, c); err != nil {
+		c.Config.Logger.WarningWithContextf(ctx, "service did not become ready: %v", err)
 		return err
 	}
 
@@ -574,7 +577,7 @@ func (c *Client) serviceDiffsForRawDesired(ctx context.Context, rawDesired *Serv
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Service resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Service resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Service resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Service resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -588,6 +591,12 @@ func (c *Client) serviceDiffsForRawDesired(ctx context.Context, rawDesired *Serv
 	if err := extractServiceFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeServiceInitialState(rawInitial, rawDesired)
@@ -605,7 +614,7 @@ func (c *Client) serviceDiffsForRawDesired(ctx context.Context, rawDesired *Serv
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffService(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeServiceInitialState(rawInitial, rawDesired *Service) (*Service, error) {
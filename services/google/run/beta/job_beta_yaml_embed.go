@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package beta -var YAML_job blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/run/beta/job.yaml
+
+package beta
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/run/beta/job.yaml
+var YAML_job = []byte("info:\n  title: Run/Job\n  description: The Run Job resource\n  x-dcl-struct-name: Job\n  x-dcl-has-iam: true\npaths:\n  get:\n    description: The function used to get information about a Job\n    parameters:\n    - name: job\n      required: true\n      description: A full instance of a Job\n  apply:\n    description: The function used to apply information about a Job\n    parameters:\n    - name: job\n      required: true\n      description: A full instance of a Job\n  delete:\n    description: The function used to delete a Job\n    parameters:\n    - name: job\n      required: true\n      description: A full instance of a Job\n  deleteAll:\n    description: The function used to delete all Job\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: location\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many Job\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: location\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    Job:\n      title: Job\n      x-dcl-id: projects/{{project}}/locations/{{location}}/jobs/{{name}}\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: true\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - name\n      - template\n      - project\n      - location\n      properties:\n        annotations:\n          type: object\n          additionalProperties:\n            type: string\n          x-dcl-go-name: Annotations\n          description: 'KRM-style annotations for the resource. Unstructured key value\n            map that may be set by external tools to store and arbitrary metadata.\n            They are not queryable and should be preserved when modifying objects.\n            Cloud Run will populate some annotations using ''run.googleapis.com''\n            or ''serving.knative.dev'' namespaces. This field follows Kubernetes annotations''\n            namespacing, limits, and rules. More info: https://kubernetes.io/docs/user-guide/annotations'\n        binaryAuthorization:\n          type: object\n          x-dcl-go-name: BinaryAuthorization\n          x-dcl-go-type: JobBinaryAuthorization\n          description: Settings for the Binary Authorization feature.\n          properties:\n            breakglassJustification:\n              type: string\n              x-dcl-go-name: BreakglassJustification\n              description: If present, indicates to use Breakglass using this justification.\n                If use_default is False, then it must be empty. For more information\n                on breakglass, see https://cloud.google.com/binary-authorization/docs/using-breakglass\n            useDefault:\n              type: boolean\n              x-dcl-go-name: UseDefault\n              description: If True, indicates to use the default project's binary\n                authorization policy. If False, binary authorization will be disabled.\n        client:\n          type: string\n          x-dcl-go-name: Client\n          description: Arbitrary identifier for the API client.\n        clientVersion:\n          type: string\n          x-dcl-go-name: ClientVersion\n          description: Arbitrary version identifier for the API client.\n        conditions:\n          type: array\n          x-dcl-go-name: Conditions\n          readOnly: true\n          description: Output only. The Conditions of all other associated sub-resources.\n            They contain additional diagnostics information in case the Job does not\n            reach its desired state. See comments in `reconciling` for additional\n            information on reconciliation process in Cloud Run.\n          x-kubernetes-immutable: true\n          x-dcl-list-type: list\n          items:\n            type: object\n            x-dcl-go-type: JobConditions\n            properties:\n              executionReason:\n                type: string\n                x-dcl-go-name: ExecutionReason\n                x-dcl-go-type: JobConditionsExecutionReasonEnum\n                description: 'A reason for the execution condition. Possible values:\n                  EXECUTION_REASON_UNDEFINED, JOB_STATUS_SERVICE_POLLING_ERROR, NON_ZERO_EXIT_CODE'\n                x-dcl-conflicts:\n                - reason\n                - revisionReason\n                enum:\n                - EXECUTION_REASON_UNDEFINED\n                - JOB_STATUS_SERVICE_POLLING_ERROR\n                - NON_ZERO_EXIT_CODE\n              lastTransitionTime:\n                type: string\n                format: date-time\n                x-dcl-go-name: LastTransitionTime\n                description: Last time the condition transitioned from one status\n                  to another.\n              message:\n                type: string\n                x-dcl-go-name: Message\n                description: Human readable message indicating details about the current\n                  status.\n              reason:\n                type: string\n                x-dcl-go-name: Reason\n                x-dcl-go-type: JobConditionsReasonEnum\n                description: 'A common (service-level) reason for this condition.\n                  Possible values: COMMON_REASON_UNDEFINED, UNKNOWN, REVISION_FAILED,\n                  PROGRESS_DEADLINE_EXCEEDED, BUILD_STEP_FAILED, CONTAINER_MISSING,\n                  CONTAINER_PERMISSION_DENIED, CONTAINER_IMAGE_UNAUTHORIZED, CONTAINER_IMAGE_AUTHORIZATION_CHECK_FAILED,\n                  ENCRYPTION_KEY_PERMISSION_DENIED, ENCRYPTION_KEY_CHECK_FAILED, SECRETS_ACCESS_CHECK_FAILED,\n                  WAITING_FOR_OPERATION, IMMEDIATE_RETRY, POSTPONED_RETRY'\n                x-dcl-conflicts:\n                - revisionReason\n                - executionReason\n                enum:\n                - COMMON_REASON_UNDEFINED\n                - UNKNOWN\n                - REVISION_FAILED\n                - PROGRESS_DEADLINE_EXCEEDED\n                - BUILD_STEP_FAILED\n                - CONTAINER_MISSING\n                - CONTAINER_PERMISSION_DENIED\n                - CONTAINER_IMAGE_UNAUTHORIZED\n                - CONTAINER_IMAGE_AUTHORIZATION_CHECK_FAILED\n                - ENCRYPTION_KEY_PERMISSION_DENIED\n                - ENCRYPTION_KEY_CHECK_FAILED\n                - SECRETS_ACCESS_CHECK_FAILED\n                - WAITING_FOR_OPERATION\n                - IMMEDIATE_RETRY\n                - POSTPONED_RETRY\n              revisionReason:\n                type: string\n                x-dcl-go-name: RevisionReason\n                x-dcl-go-type: JobConditionsRevisionReasonEnum\n                description: 'A reason for the revision condition. Possible values:\n                  REVISION_REASON_UNDEFINED, PENDING, RESERVE, RETIRED, RETIRING,\n                  RECREATING, HEALTH_CHECK_CONTAINER_ERROR, CUSTOMIZED_PATH_RESPONSE_PENDING,\n                  MIN_INSTANCES_NOT_PROVISIONED, ACTIVE_REVISION_LIMIT_REACHED, NO_DEPLOYMENT,\n                  HEALTH_CHECK_SKIPPED'\n                x-dcl-conflicts:\n                - reason\n                - executionReason\n                enum:\n                - REVISION_REASON_UNDEFINED\n                - PENDING\n                - RESERVE\n                - RETIRED\n                - RETIRING\n                - RECREATING\n                - HEALTH_CHECK_CONTAINER_ERROR\n                - CUSTOMIZED_PATH_RESPONSE_PENDING\n                - MIN_INSTANCES_NOT_PROVISIONED\n                - ACTIVE_REVISION_LIMIT_REACHED\n                - NO_DEPLOYMENT\n                - HEALTH_CHECK_SKIPPED\n              severity:\n                type: string\n                x-dcl-go-name: Severity\n                x-dcl-go-type: JobConditionsSeverityEnum\n                description: 'How to interpret failures of this condition, one of\n                  Error, Warning, Info Possible values: SEVERITY_UNSPECIFIED, ERROR,\n                  WARNING, INFO'\n                enum:\n                - SEVERITY_UNSPECIFIED\n                - ERROR\n                - WARNING\n                - INFO\n              state:\n                type: string\n                x-dcl-go-name: State\n                x-dcl-go-type: JobConditionsStateEnum\n                description: 'State of the condition. Possible values: STATE_UNSPECIFIED,\n                  CONDITION_PENDING, CONDITION_RECONCILING, CONDITION_FAILED, CONDITION_SUCCEEDED'\n                enum:\n                - STATE_UNSPECIFIED\n                - CONDITION_PENDING\n                - CONDITION_RECONCILING\n                - CONDITION_FAILED\n                - CONDITION_SUCCEEDED\n              type:\n                type: string\n                x-dcl-go-name: Type\n                description: 'type is used to communicate the status of the reconciliation\n                  process. See also: https://github.com/knative/serving/blob/main/docs/spec/errors.md#error-conditions-and-reporting\n                  Types common to all resources include: * \"Ready\": True when the\n                  Resource is ready.'\n        createTime:\n          type: string\n          format: date-time\n          x-dcl-go-name: CreateTime\n          readOnly: true\n          description: Output only. The creation time.\n          x-kubernetes-immutable: true\n        creator:\n          type: string\n          x-dcl-go-name: Creator\n          readOnly: true\n          description: Output only. Email address of the authenticated creator.\n          x-kubernetes-immutable: true\n        deleteTime:\n          type: string\n          format: date-time\n          x-dcl-go-name: DeleteTime\n          readOnly: true\n          description: Output only. The deletion time.\n          x-kubernetes-immutable: true\n        etag:\n          type: string\n          x-dcl-go-name: Etag\n          readOnly: true\n          description: Output only. A system-generated fingerprint for this version\n            of the resource. May be used to detect modification conflict during updates.\n          x-kubernetes-immutable: true\n        executionCount:\n          type: integer\n          format: int64\n          x-dcl-go-name: ExecutionCount\n          readOnly: true\n          description: Output only. Number of executions created for this job.\n          x-kubernetes-immutable: true\n        expireTime:\n          type: string\n          format: date-time\n          x-dcl-go-name: ExpireTime\n          readOnly: true\n          description: Output only. For a deleted resource, the time after which it\n            will be permamently deleted.\n          x-kubernetes-immutable: true\n        generation:\n          type: integer\n          format: int64\n          x-dcl-go-name: Generation\n          readOnly: true\n          description: Output only. A number that monotonically increases every time\n            the user modifies the desired state.\n          x-kubernetes-immutable: true\n        labels:\n          type: object\n          additionalProperties:\n            type: string\n          x-dcl-go-name: Labels\n          readOnly: true\n          description: KRM-style labels for the resource. User-provided labels are\n            shared with Google's billing system, so they can be used to filter, or\n            break down billing charges by team, component, environment, state, etc.\n            For more information, visit https://cloud.google.com/resource-manager/docs/creating-managing-labels\n            or https://cloud.google.com/run/docs/configuring/labels Cloud Run will\n            populate some labels with 'run.googleapis.com' or 'serving.knative.dev'\n            namespaces. Those labels are read-only, and user changes will not be preserved.\n          x-kubernetes-immutable: true\n        lastModifier:\n          type: string\n          x-dcl-go-name: LastModifier\n          readOnly: true\n          description: Output only. Email address of the last authenticated modifier.\n          x-kubernetes-immutable: true\n        latestCreatedExecution:\n          type: object\n          x-dcl-go-name: LatestCreatedExecution\n          x-dcl-go-type: JobLatestCreatedExecution\n          readOnly: true\n          description: Output only. Name of the last created execution.\n          properties:\n            createTime:\n              type: string\n              format: date-time\n              x-dcl-go-name: CreateTime\n              description: Creation timestamp of the execution.\n            name:\n              type: string\n              x-dcl-go-name: Name\n              description: Name of the execution.\n              x-dcl-references:\n              - resource: Run/Execution\n                field: selfLink\n                parent: true\n        latestSucceededExecution:\n          type: object\n          x-dcl-go-name: LatestSucceededExecution\n          x-dcl-go-type: JobLatestSucceededExecution\n          readOnly: true\n          description: Output only. Name of the last succeeded execution.\n          properties:\n            createTime:\n              type: string\n              format: date-time\n              x-dcl-go-name: CreateTime\n              description: Creation timestamp of the execution.\n            name:\n              type: string\n              x-dcl-go-name: Name\n              description: Name of the execution.\n              x-dcl-references:\n              - resource: Run/Execution\n                field: selfLink\n                parent: true\n        launchStage:\n          type: string\n          x-dcl-go-name: LaunchStage\n          x-dcl-go-type: JobLaunchStageEnum\n          description: 'The launch stage as defined by [Google Cloud Platform Launch\n            Stages](https://cloud.google.com/terms/launch-stages). Cloud Run supports\n            `ALPHA`, `BETA`, and `GA`. If no value is specified, GA is assumed. Possible\n            values: LAUNCH_STAGE_UNSPECIFIED, UNIMPLEMENTED, PRELAUNCH, EARLY_ACCESS,\n            ALPHA, BETA, GA, DEPRECATED'\n          enum:\n          - LAUNCH_STAGE_UNSPECIFIED\n          - UNIMPLEMENTED\n          - PRELAUNCH\n          - EARLY_ACCESS\n          - ALPHA\n          - BETA\n          - GA\n          - DEPRECATED\n        location:\n          type: string\n          x-dcl-go-name: Location\n          description: The location for the resource\n          x-kubernetes-immutable: true\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: 'The fully qualified name of this Job. Format: projects/{project}/locations/{location}/jobs/{job}'\n        observedGeneration:\n          type: integer\n          format: int64\n          x-dcl-go-name: ObservedGeneration\n          readOnly: true\n          description: Output only. The generation of this Job. See comments in `reconciling`\n            for additional information on reconciliation process in Cloud Run.\n          x-kubernetes-immutable: true\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: The project for the resource\n          x-kubernetes-immutable: true\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        reconciling:\n          type: boolean\n          x-dcl-go-name: Reconciling\n          readOnly: true\n          description: 'Output only. Returns true if the Job is currently being acted\n            upon by the system to bring it into the desired state. When a new Job\n            is created, or an existing one is updated, Cloud Run will asynchronously\n            perform all necessary steps to bring the Job to the desired state. This\n            process is called reconciliation. While reconciliation is in process,\n            `observed_generation` and `latest_succeeded_execution`, will have transient\n            values that might mismatch the intended state: Once reconciliation is\n            over (and this field is false), there are two possible outcomes: reconciliation\n            succeeded and the state matches the Job, or there was an error, and reconciliation\n            failed. This state can be found in `terminal_condition.state`. If reconciliation\n            succeeded, the following fields will match: `observed_generation` and\n            `generation`, `latest_succeeded_execution` and `latest_created_execution`.\n            If reconciliation failed, `observed_generation` and `latest_succeeded_execution`\n            will have the state of the last succeeded execution or empty for newly\n            created Job. Additional information on the failure can be found in `terminal_condition`\n            and `conditions`.'\n          x-kubernetes-immutable: true\n        template:\n          type: object\n          x-dcl-go-name: Template\n          x-dcl-go-type: JobTemplate\n          description: Required. The template used to create executions for this Job.\n          required:\n          - template\n          properties:\n            annotations:\n              type: object\n              additionalProperties:\n                type: string\n              x-dcl-go-name: Annotations\n              description: KRM-style annotations for the resource.\n            labels:\n              type: object\n              additionalProperties:\n                type: string\n              x-dcl-go-name: Labels\n              description: KRM-style labels for the resource.\n            parallelism:\n              type: integer\n              format: int64\n              x-dcl-go-name: Parallelism\n              description: 'Specifies the maximum desired number of tasks the execution\n                should run at any given time. Must be <= task_count. The actual number\n                of tasks running in steady state will be less than this number when\n                ((.spec.task_count - .status.successful) < .spec.parallelism), i.e.\n                when the work left to do is less than max parallelism. More info:\n                https://kubernetes.io/docs/concepts/workloads/controllers/jobs-run-to-completion/'\n              x-dcl-server-default: true\n            taskCount:\n              type: integer\n              format: int64\n              x-dcl-go-name: TaskCount\n              description: 'Specifies the desired number of tasks the execution should\n                run. Setting to 1 means that parallelism is limited to 1 and the success\n                of that task signals the success of the execution. More info: https://kubernetes.io/docs/concepts/workloads/controllers/jobs-run-to-completion/'\n              x-dcl-server-default: true\n            template:\n              type: object\n              x-dcl-go-name: Template\n              x-dcl-go-type: JobTemplateTemplate\n              description: Required. Describes the task(s) that will be created when\n                executing an execution.\n              properties:\n                containers:\n                  type: array\n                  x-dcl-go-name: Containers\n                  description: Holds the single container that defines the unit of\n                    execution for this task.\n                  x-dcl-send-empty: true\n                  x-dcl-list-type: list\n                  items:\n                    type: object\n                    x-dcl-go-type: JobTemplateTemplateContainers\n                    required:\n                    - image\n                    properties:\n                      args:\n                        type: array\n                        x-dcl-go-name: Args\n                        description: 'Arguments to the entrypoint. The docker image''s\n                          CMD is used if this is not provided. Variable references\n                          $(VAR_NAME) are expanded using the container''s environment.\n                          If a variable cannot be resolved, the reference in the input\n                          string will be unchanged. The $(VAR_NAME) syntax can be\n                          escaped with a double $$, ie: $$(VAR_NAME). Escaped references\n                          will never be expanded, regardless of whether the variable\n                          exists or not. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell'\n                        x-dcl-send-empty: true\n                        x-dcl-list-type: list\n                        items:\n                          type: string\n                          x-dcl-go-type: string\n                      command:\n                        type: array\n                        x-dcl-go-name: Command\n                        description: 'Entrypoint array. Not executed within a shell.\n                          The docker image''s ENTRYPOINT is used if this is not provided.\n                          Variable references $(VAR_NAME) are expanded using the container''s\n                          environment. If a variable cannot be resolved, the reference\n                          in the input string will be unchanged. The $(VAR_NAME) syntax\n                          can be escaped with a double $$, ie: $$(VAR_NAME). Escaped\n                          references will never be expanded, regardless of whether\n                          the variable exists or not. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell'\n                        x-dcl-send-empty: true\n                        x-dcl-list-type: list\n                        items:\n                          type: string\n                          x-dcl-go-type: string\n                      env:\n                        type: array\n                        x-dcl-go-name: Env\n                        description: List of environment variables to set in the container.\n                        x-dcl-send-empty: true\n                        x-dcl-list-type: list\n                        items:\n                          type: object\n                          x-dcl-go-type: JobTemplateTemplateContainersEnv\n                          required:\n                          - name\n                          properties:\n                            name:\n                              type: string\n                              x-dcl-go-name: Name\n                              description: Required. Name of the environment variable.\n                                Must be a C_IDENTIFIER, and mnay not exceed 32768\n                                characters.\n                            value:\n                              type: string\n                              x-dcl-go-name: Value\n                              description: 'Variable references $(VAR_NAME) are expanded\n                                using the previous defined environment variables in\n                                the container and any route environment variables.\n                                If a variable cannot be resolved, the reference in\n                                the input string will be unchanged. The $(VAR_NAME)\n                                syntax can be escaped with a double $$, ie: $$(VAR_NAME).\n                                Escaped references will never be expanded, regardless\n                                of whether the variable exists or not. Defaults to\n                                \"\", and the maximum length is 32768 bytes.'\n                              x-dcl-conflicts:\n                              - valueSource\n                            valueSource:\n                              type: object\n                              x-dcl-go-name: ValueSource\n                              x-dcl-go-type: JobTemplateTemplateContainersEnvValueSource\n                              description: Source for the environment variable's value.\n                              x-dcl-conflicts:\n                              - value\n                              properties:\n                                secretKeyRef:\n                                  type: object\n                                  x-dcl-go-name: SecretKeyRef\n                                  x-dcl-go-type: JobTemplateTemplateContainersEnvValueSourceSecretKeyRef\n                                  description: Selects a secret and a specific version\n                                    from Cloud Secret Manager.\n                                  required:\n                                  - secret\n                                  properties:\n                                    secret:\n                                      type: string\n                                      x-dcl-go-name: Secret\n                                      description: 'Required. The name of the secret\n                                        in Cloud Secret Manager. Format: {secret_name}\n                                        if the secret is in the same project. projects/{project}/secrets/{secret_name}\n                                        if the secret is in a different project.'\n                                      x-dcl-references:\n                                      - resource: Secretmanager/Secret\n                                        field: selfLink\n                                    version:\n                                      type: string\n                                      x-dcl-go-name: Version\n                                      description: The Cloud Secret Manager secret\n                                        version. Can be 'latest' for the latest value\n                                        or an integer for a specific version.\n                                      x-dcl-references:\n                                      - resource: Secretmanager/SecretVersion\n                                        field: selfLink\n                      image:\n                        type: string\n                        x-dcl-go-name: Image\n                        description: 'Required. URL of the Container image in Google\n                          Container Registry or Docker More info: https://kubernetes.io/docs/concepts/containers/images'\n                      name:\n                        type: string\n                        x-dcl-go-name: Name\n                        description: Name of the container specified as a DNS_LABEL.\n                      ports:\n                        type: array\n                        x-dcl-go-name: Ports\n                        description: List of ports to expose from the container. Only\n                          a single port can be specified. The specified ports must\n                          be listening on all interfaces (0.0.0.0) within the container\n                          to be accessible. If omitted, a port number will be chosen\n                          and passed to the container through the PORT environment\n                          variable for the container to listen on.\n                        x-dcl-send-empty: true\n                        x-dcl-list-type: list\n                        items:\n                          type: object\n                          x-dcl-go-type: JobTemplateTemplateContainersPorts\n                          properties:\n                            containerPort:\n                              type: integer\n                              format: int64\n                              x-dcl-go-name: ContainerPort\n                              description: Port number the container listens on. This\n                                must be a valid TCP port number, 0 < container_port\n                                < 65536.\n                            name:\n                              type: string\n                              x-dcl-go-name: Name\n                              description: If specified, used to specify which protocol\n                                to use. Allowed values are \"http1\" and \"h2c\".\n                      resources:\n                        type: object\n                        x-dcl-go-name: Resources\n                        x-dcl-go-type: JobTemplateTemplateContainersResources\n                        description: 'Compute Resource requirements by this container.\n                          More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources'\n                        x-dcl-server-default: true\n                        properties:\n                          cpuIdle:\n                            type: boolean\n                            x-dcl-go-name: CpuIdle\n                            description: Determines whether CPU should be throttled\n                              or not outside of requests.\n                            x-dcl-server-default: true\n                          limits:\n                            type: object\n                            additionalProperties:\n                              type: string\n                            x-dcl-go-name: Limits\n                            description: 'Only memory and CPU are supported. Note:\n                              The only supported values for CPU are ''1'', ''2'',\n                              and ''4''. Setting 4 CPU requires at least 2Gi of memory.\n                              The values of the map is string form of the ''quantity''\n                              k8s type: https://github.com/kubernetes/kubernetes/blob/master/staging/src/k8s.io/apimachinery/pkg/api/resource/quantity.go'\n                      volumeMounts:\n                        type: array\n                        x-dcl-go-name: VolumeMounts\n                        description: Volume to mount into the container's filesystem.\n                        x-dcl-send-empty: true\n                        x-dcl-list-type: list\n                        items:\n                          type: object\n                          x-dcl-go-type: JobTemplateTemplateContainersVolumeMounts\n                          required:\n                          - name\n                          - mountPath\n                          properties:\n                            mountPath:\n                              type: string\n                              x-dcl-go-name: MountPath\n                              description: Required. Path within the container at\n                                which the volume should be mounted. Must not contain\n                                ':'. For Cloud SQL volumes, it can be left empty,\n                                or must otherwise be `/cloudsql`. All instances defined\n                                in the Volume will be available as `/cloudsql/[instance]`.\n                                For more information on Cloud SQL volumes, visit https://cloud.google.com/sql/docs/mysql/connect-run\n                            name:\n                              type: string\n                              x-dcl-go-name: Name\n                              description: Required. This must match the Name of a\n                                Volume.\n                encryptionKey:\n                  type: string\n                  x-dcl-go-name: EncryptionKey\n                  description: A reference to a customer managed encryption key (CMEK)\n                    to use to encrypt this container image. For more information,\n                    go to https://cloud.google.com/run/docs/securing/using-cmek\n                  x-dcl-references:\n                  - resource: Cloudkms/CryptoKey\n                    field: selfLink\n                executionEnvironment:\n                  type: string\n                  x-dcl-go-name: ExecutionEnvironment\n                  x-dcl-go-type: JobTemplateTemplateExecutionEnvironmentEnum\n                  description: 'The execution environment being used to host this\n                    Task. Possible values: EXECUTION_ENVIRONMENT_UNSPECIFIED, EXECUTION_ENVIRONMENT_DEFAULT,\n                    EXECUTION_ENVIRONMENT_GEN2'\n                  x-dcl-server-default: true\n                  enum:\n                  - EXECUTION_ENVIRONMENT_UNSPECIFIED\n                  - EXECUTION_ENVIRONMENT_DEFAULT\n                  - EXECUTION_ENVIRONMENT_GEN2\n                maxRetries:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: MaxRetries\n                  description: Number of retries allowed per Task, before marking\n                    this Task failed.\n                  x-dcl-server-default: true\n                serviceAccount:\n                  type: string\n                  x-dcl-go-name: ServiceAccount\n                  description: Email address of the IAM service account associated\n                    with the Task of a Job. The service account represents the identity\n                    of the running task, and determines what permissions the task\n                    has. If not provided, the task will use the project's default\n                    service account.\n                  x-dcl-server-default: true\n                timeout:\n                  type: string\n                  x-dcl-go-name: Timeout\n                  description: Max allowed time duration the Task may be active before\n                    the system will actively try to mark it failed and kill associated\n                    containers. This applies per attempt of a task, meaning each retry\n                    can run for the full timeout.\n                  x-dcl-server-default: true\n                volumes:\n                  type: array\n                  x-dcl-go-name: Volumes\n                  description: A list of Volumes to make available to containers.\n                  x-dcl-send-empty: true\n                  x-dcl-list-type: list\n                  items:\n                    type: object\n                    x-dcl-go-type: JobTemplateTemplateVolumes\n                    required:\n                    - name\n                    properties:\n                      cloudSqlInstance:\n                        type: object\n                        x-dcl-go-name: CloudSqlInstance\n                        x-dcl-go-type: JobTemplateTemplateVolumesCloudSqlInstance\n                        description: For Cloud SQL volumes, contains the specific\n                          instances that should be mounted. Visit https://cloud.google.com/sql/docs/mysql/connect-run\n                          for more information on how to connect Cloud SQL and Cloud\n                          Run.\n                        x-dcl-conflicts:\n                        - secret\n                        properties:\n                          instances:\n                            type: array\n                            x-dcl-go-name: Instances\n                            description: 'The Cloud SQL instance connection names,\n                              as can be found in https://console.cloud.google.com/sql/instances.\n                              Visit https://cloud.google.com/sql/docs/mysql/connect-run\n                              for more information on how to connect Cloud SQL and\n                              Cloud Run. Format: {project}:{location}:{instance}'\n                            x-dcl-send-empty: true\n                            x-dcl-list-type: list\n                            items:\n                              type: string\n                              x-dcl-go-type: string\n                      name:\n                        type: string\n                        x-dcl-go-name: Name\n                        description: Required. Volume's name.\n                      secret:\n                        type: object\n                        x-dcl-go-name: Secret\n                        x-dcl-go-type: JobTemplateTemplateVolumesSecret\n                        description: 'Secret represents a secret that should populate\n                          this volume. More info: https://kubernetes.io/docs/concepts/storage/volumes#secret'\n                        x-dcl-conflicts:\n                        - cloudSqlInstance\n                        required:\n                        - secret\n                        properties:\n                          defaultMode:\n                            type: integer\n                            format: int64\n                            x-dcl-go-name: DefaultMode\n                            description: 'Integer representation of mode bits to use\n                              on created files by default. Must be a value between\n                              0000 and 0777 (octal), defaulting to 0644. Directories\n                              within the path are not affected by this setting. Notes\n                              * Internally, a umask of 0222 will be applied to any\n                              non-zero value. * This is an integer representation\n                              of the mode bits. So, the octal integer value should\n                              look exactly as the chmod numeric notation with a leading\n                              zero. Some examples: for chmod 777 (a=rwx), set to 0777\n                              (octal) or 511 (base-10). For chmod 640 (u=rw,g=r),\n                              set to 0640 (octal) or 416 (base-10). For chmod 755\n                              (u=rwx,g=rx,o=rx), set to 0755 (octal) or 493 (base-10).\n                              * This might be in conflict with other options that\n                              affect the file mode, like fsGroup, and the result can\n                              be other mode bits set. This might be in conflict with\n                              other options that affect the file mode, like fsGroup,\n                              and as a result, other mode bits could be set.'\n                          items:\n                            type: array\n                            x-dcl-go-name: Items\n                            description: If unspecified, the volume will expose a\n                              file whose name is the secret, relative to VolumeMount.mount_path.\n                              If specified, the key will be used as the version to\n                              fetch from Cloud Secret Manager and the path will be\n                              the name of the file exposed in the volume. When items\n                              are defined, they must specify a path and a version.\n                            x-dcl-send-empty: true\n                            x-dcl-list-type: list\n                            items:\n                              type: object\n                              x-dcl-go-type: JobTemplateTemplateVolumesSecretItems\n                              required:\n                              - path\n                              properties:\n                                mode:\n                                  type: integer\n                                  format: int64\n                                  x-dcl-go-name: Mode\n                                  description: 'Integer octal mode bits to use on\n                                    this file, must be a value between 01 and 0777\n                                    (octal). If 0 or not set, the Volume''s default\n                                    mode will be used. Notes * Internally, a umask\n                                    of 0222 will be applied to any non-zero value.\n                                    * This is an integer representation of the mode\n                                    bits. So, the octal integer value should look\n                                    exactly as the chmod numeric notation with a leading\n                                    zero. Some examples: for chmod 777 (a=rwx), set\n                                    to 0777 (octal) or 511 (base-10). For chmod 640\n                                    (u=rw,g=r), set to 0640 (octal) or 416 (base-10).\n                                    For chmod 755 (u=rwx,g=rx,o=rx), set to 0755 (octal)\n                                    or 493 (base-10). * This might be in conflict\n                                    with other options that affect the file mode,\n                                    like fsGroup, and the result can be other mode\n                                    bits set.'\n                                path:\n                                  type: string\n                                  x-dcl-go-name: Path\n                                  description: Required. The relative path of the\n                                    secret in the container.\n                                version:\n                                  type: string\n                                  x-dcl-go-name: Version\n                                  description: The Cloud Secret Manager secret version.\n                                    Can be 'latest' for the latest value or an integer\n                                    for a specific version.\n                          secret:\n                            type: string\n                            x-dcl-go-name: Secret\n                            description: 'Required. The name of the secret in Cloud\n                              Secret Manager. Format: {secret} if the secret is in\n                              the same project. projects/{project}/secrets/{secret}\n                              if the secret is in a different project.'\n                vpcAccess:\n                  type: object\n                  x-dcl-go-name: VPCAccess\n                  x-dcl-go-type: JobTemplateTemplateVPCAccess\n                  description: VPC Access configuration to use for this Task. For\n                    more information, visit https://cloud.google.com/run/docs/configuring/connecting-vpc.\n                  properties:\n                    connector:\n                      type: string\n                      x-dcl-go-name: Connector\n                      description: 'VPC Access connector name. Format: projects/{project}/locations/{location}/connectors/{connector}'\n                      x-dcl-references:\n                      - resource: Vpcaccess/Connector\n                        field: selfLink\n                    egress:\n                      type: string\n                      x-dcl-go-name: Egress\n                      x-dcl-go-type: JobTemplateTemplateVPCAccessEgressEnum\n                      description: 'Traffic VPC egress settings. Possible values:\n                        VPC_EGRESS_UNSPECIFIED, ALL_TRAFFIC, PRIVATE_RANGES_ONLY'\n                      enum:\n                      - VPC_EGRESS_UNSPECIFIED\n                      - ALL_TRAFFIC\n                      - PRIVATE_RANGES_ONLY\n        terminalCondition:\n          type: object\n          x-dcl-go-name: TerminalCondition\n          x-dcl-go-type: JobTerminalCondition\n          readOnly: true\n          description: Output only. The Condition of this Job, containing its readiness\n            status, and detailed error information in case it did not reach the desired\n            state.\n          properties:\n            domainMappingReason:\n              type: string\n              x-dcl-go-name: DomainMappingReason\n              x-dcl-go-type: JobTerminalConditionDomainMappingReasonEnum\n              description: 'A reason for the domain mapping condition. Possible values:\n                DOMAIN_MAPPING_REASON_UNDEFINED, ROUTE_NOT_READY, PERMISSION_DENIED,\n                CERTIFICATE_ALREADY_EXISTS, MAPPING_ALREADY_EXISTS, CERTIFICATE_PENDING,\n                CERTIFICATE_FAILED'\n              x-dcl-conflicts:\n              - reason\n              - internalReason\n              - revisionReason\n              - executionReason\n              enum:\n              - DOMAIN_MAPPING_REASON_UNDEFINED\n              - ROUTE_NOT_READY\n              - PERMISSION_DENIED\n              - CERTIFICATE_ALREADY_EXISTS\n              - MAPPING_ALREADY_EXISTS\n              - CERTIFICATE_PENDING\n              - CERTIFICATE_FAILED\n            executionReason:\n              type: string\n              x-dcl-go-name: ExecutionReason\n              x-dcl-go-type: JobTerminalConditionExecutionReasonEnum\n              description: 'A reason for the execution condition. Possible values:\n                EXECUTION_REASON_UNDEFINED, JOB_STATUS_SERVICE_POLLING_ERROR, NON_ZERO_EXIT_CODE'\n              x-dcl-conflicts:\n              - reason\n              - internalReason\n              - domainMappingReason\n              - revisionReason\n              enum:\n              - EXECUTION_REASON_UNDEFINED\n              - JOB_STATUS_SERVICE_POLLING_ERROR\n              - NON_ZERO_EXIT_CODE\n            internalReason:\n              type: string\n              x-dcl-go-name: InternalReason\n              x-dcl-go-type: JobTerminalConditionInternalReasonEnum\n              description: 'A reason for the internal condition. Possible values:\n                INTERNAL_REASON_UNDEFINED, CONFLICTING_REVISION_NAME, REVISION_MISSING,\n                CONFIGURATION_MISSING, ASSIGNING_TRAFFIC, UPDATING_INGRESS_TRAFFIC_ALLOWED,\n                REVISION_ORG_POLICY_VIOLATION, ENABLING_GCFV2_URI_SUPPORT'\n              x-dcl-conflicts:\n              - reason\n              - domainMappingReason\n              - revisionReason\n              - executionReason\n              enum:\n              - INTERNAL_REASON_UNDEFINED\n              - CONFLICTING_REVISION_NAME\n              - REVISION_MISSING\n              - CONFIGURATION_MISSING\n              - ASSIGNING_TRAFFIC\n              - UPDATING_INGRESS_TRAFFIC_ALLOWED\n              - REVISION_ORG_POLICY_VIOLATION\n              - ENABLING_GCFV2_URI_SUPPORT\n            lastTransitionTime:\n              type: string\n              format: date-time\n              x-dcl-go-name: LastTransitionTime\n              description: Last time the condition transitioned from one status to\n                another.\n            message:\n              type: string\n              x-dcl-go-name: Message\n              description: Human readable message indicating details about the current\n                status.\n            reason:\n              type: string\n              x-dcl-go-name: Reason\n              x-dcl-go-type: JobTerminalConditionReasonEnum\n              description: 'A common (service-level) reason for this condition. Possible\n                values: COMMON_REASON_UNDEFINED, UNKNOWN, ROUTE_MISSING, REVISION_FAILED,\n                PROGRESS_DEADLINE_EXCEEDED, CONTAINER_MISSING, CONTAINER_PERMISSION_DENIED,\n                CONTAINER_IMAGE_UNAUTHORIZED, CONTAINER_IMAGE_AUTHORIZATION_CHECK_FAILED,\n                ENCRYPTION_KEY_PERMISSION_DENIED, ENCRYPTION_KEY_CHECK_FAILED, SECRETS_ACCESS_CHECK_FAILED,\n                WAITING_FOR_OPERATION, IMMEDIATE_RETRY, POSTPONED_RETRY'\n              x-dcl-conflicts:\n              - internalReason\n              - domainMappingReason\n              - revisionReason\n              - executionReason\n              enum:\n              - COMMON_REASON_UNDEFINED\n              - UNKNOWN\n              - ROUTE_MISSING\n              - REVISION_FAILED\n              - PROGRESS_DEADLINE_EXCEEDED\n              - CONTAINER_MISSING\n              - CONTAINER_PERMISSION_DENIED\n              - CONTAINER_IMAGE_UNAUTHORIZED\n              - CONTAINER_IMAGE_AUTHORIZATION_CHECK_FAILED\n              - ENCRYPTION_KEY_PERMISSION_DENIED\n              - ENCRYPTION_KEY_CHECK_FAILED\n              - SECRETS_ACCESS_CHECK_FAILED\n              - WAITING_FOR_OPERATION\n              - IMMEDIATE_RETRY\n              - POSTPONED_RETRY\n            revisionReason:\n              type: string\n              x-dcl-go-name: RevisionReason\n              x-dcl-go-type: JobTerminalConditionRevisionReasonEnum\n              description: 'A reason for the revision condition. Possible values:\n                REVISION_REASON_UNDEFINED, PENDING, RESERVE, RETIRED, RETIRING, RECREATING,\n                HEALTH_CHECK_CONTAINER_ERROR, CUSTOMIZED_PATH_RESPONSE_PENDING, MIN_INSTANCES_NOT_PROVISIONED,\n                ACTIVE_REVISION_LIMIT_REACHED, NO_DEPLOYMENT, HEALTH_CHECK_SKIPPED'\n              x-dcl-conflicts:\n              - reason\n              - internalReason\n              - domainMappingReason\n              - executionReason\n              enum:\n              - REVISION_REASON_UNDEFINED\n              - PENDING\n              - RESERVE\n              - RETIRED\n              - RETIRING\n              - RECREATING\n              - HEALTH_CHECK_CONTAINER_ERROR\n              - CUSTOMIZED_PATH_RESPONSE_PENDING\n              - MIN_INSTANCES_NOT_PROVISIONED\n              - ACTIVE_REVISION_LIMIT_REACHED\n              - NO_DEPLOYMENT\n              - HEALTH_CHECK_SKIPPED\n            severity:\n              type: string\n              x-dcl-go-name: Severity\n              x-dcl-go-type: JobTerminalConditionSeverityEnum\n              description: 'How to interpret failures of this condition, one of Error,\n                Warning, Info Possible values: SEVERITY_UNSPECIFIED, ERROR, WARNING,\n                INFO'\n              enum:\n              - SEVERITY_UNSPECIFIED\n              - ERROR\n              - WARNING\n              - INFO\n            state:\n              type: string\n              x-dcl-go-name: State\n              x-dcl-go-type: JobTerminalConditionStateEnum\n              description: 'State of the condition. Possible values: STATE_UNSPECIFIED,\n                CONDITION_PENDING, CONDITION_RECONCILING, CONDITION_FAILED, CONDITION_SUCCEEDED'\n              enum:\n              - STATE_UNSPECIFIED\n              - CONDITION_PENDING\n              - CONDITION_RECONCILING\n              - CONDITION_FAILED\n              - CONDITION_SUCCEEDED\n            type:\n              type: string\n              x-dcl-go-name: Type\n              description: 'type is used to communicate the status of the reconciliation\n                process. See also: https://github.com/knative/serving/blob/main/docs/spec/errors.md#error-conditions-and-reporting\n                Types common to all resources include: * \"Ready\": True when the Resource\n                is ready.'\n        uid:\n          type: string\n          x-dcl-go-name: Uid\n          readOnly: true\n          description: Output only. Server assigned unique identifier for the Execution.\n            The value is a UUID4 string and guaranteed to remain unchanged until the\n            resource is deleted.\n          x-kubernetes-immutable: true\n        updateTime:\n          type: string\n          format: date-time\n          x-dcl-go-name: UpdateTime\n          readOnly: true\n          description: Output only. The last-modified time.\n          x-kubernetes-immutable: true\n")
+
+// 50308 bytes
+// MD5: 2e313860cfd23939a846073edba1db16
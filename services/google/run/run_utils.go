@@ -0,0 +1,84 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package run contains all of the utils for Cloud Run.
+package run
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+// EncodeServiceCreateRequest properly encodes the create request for a Cloud Run Service.
+func EncodeServiceCreateRequest(m map[string]interface{}) map[string]interface{} {
+	// Create requests involving a master version have to be sent under the "initialMasterVersion" key.
+	if _, ok := m["name"]; ok {
+		delete(m, "name")
+	}
+
+	return m
+}
+
+// validateServiceTraffic checks that a traffic split can be applied: every
+// target of a revision names the revision, tags are unique, and the
+// percentages add up to 100.
+func validateServiceTraffic(traffic []ServiceTraffic) error {
+	if len(traffic) == 0 {
+		return nil
+	}
+	var total int64
+	tags := make(map[string]bool)
+	for _, t := range traffic {
+		if t.Type != nil && *t.Type == ServiceTrafficTypeEnum("TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION") && dcl.ValueOrEmptyString(t.Revision) == "" {
+			return fmt.Errorf("traffic target of type %s must set revision", *t.Type)
+		}
+		if tag := dcl.ValueOrEmptyString(t.Tag); tag != "" {
+			if tags[tag] {
+				return fmt.Errorf("traffic tag %q is used by more than one target", tag)
+			}
+			tags[tag] = true
+		}
+		total += dcl.ValueOrEmptyInt64(t.Percent)
+	}
+	if total != 100 {
+		return fmt.Errorf("traffic percentages must add up to 100, got %d", total)
+	}
+	return nil
+}
+
+// waitForServiceReady waits for the service to finish reconciling after a
+// create or update, i.e. for its latest revision to be ready and to serve the
+// requested traffic. It returns an error if the service failed to reconcile.
+func (r *Service) waitForServiceReady(ctx context.Context, c *Client) error {
+	var nr *Service
+	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		var err error
+		nr, err = c.GetService(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		if dcl.ValueOrEmptyBool(nr.Reconciling) {
+			return &dcl.RetryDetails{}, dcl.OperationNotDone{}
+		}
+		return nil, nil
+	}, c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+	if tc := nr.TerminalCondition; tc != nil && tc.State != nil && *tc.State == ServiceTerminalConditionStateEnum("CONDITION_FAILED") {
+		return fmt.Errorf("service %s failed to become ready: %s", dcl.ValueOrEmptyString(nr.Name), dcl.ValueOrEmptyString(tc.Message))
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package run -var YAML_service blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/run/service.yaml
+
+package run
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/run/service.yaml
+var YAML_service = []byte("info:\n  title: Run/Service\n  description: The Run Service resource\n  x-dcl-struct-name: Service\n  x-dcl-has-iam: true\npaths:\n  get:\n    description: The function used to get information about a Service\n    parameters:\n    - name: service\n      required: true\n      description: A full instance of a Service\n  apply:\n    description: The function used to apply information about a Service\n    parameters:\n    - name: service\n      required: true\n      description: A full instance of a Service\n  delete:\n    description: The function used to delete a Service\n    parameters:\n    - name: service\n      required: true\n      description: A full instance of a Service\n  deleteAll:\n    description: The function used to delete all Service\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: location\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many Service\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: location\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    Service:\n      title: Service\n      x-dcl-id: projects/{{project}}/locations/{{location}}/services/{{name}}\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: true\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - name\n      - template\n      - project\n      - location\n      properties:\n        annotations:\n          type: object\n          additionalProperties:\n            type: string\n          x-dcl-go-name: Annotations\n          description: 'Unstructured key value map that may be set by external tools\n            to store and arbitrary metadata. They are not queryable and should be\n            preserved when modifying objects. Cloud Run will populate some annotations\n            using ''run.googleapis.com'' or ''serving.knative.dev'' namespaces. This\n            field follows Kubernetes annotations'' namespacing, limits, and rules.\n            More info: http://kubernetes.io/docs/user-guide/annotations'\n        binaryAuthorization:\n          type: object\n          x-dcl-go-name: BinaryAuthorization\n          x-dcl-go-type: ServiceBinaryAuthorization\n          description: Settings for the Binary Authorization feature.\n          properties:\n            breakglassJustification:\n              type: string\n              x-dcl-go-name: BreakglassJustification\n              description: If present, indicates to use Breakglass using this justification.\n                For more information on breakglass, see https://cloud.google.com/binary-authorization/docs/using-breakglass\n            useDefault:\n              type: boolean\n              x-dcl-go-name: UseDefault\n              description: If True, indicates to use the default project's binary\n                authorization policy. If False, binary authorization will be disabled\n        client:\n          type: string\n          x-dcl-go-name: Client\n          description: Arbitrary identifier for the API client.\n        clientVersion:\n          type: string\n          x-dcl-go-name: ClientVersion\n          description: Arbitrary version identifier for the API client.\n        createTime:\n          type: string\n          format: date-time\n          x-dcl-go-name: CreateTime\n          readOnly: true\n          description: Output only. The creation time.\n          x-kubernetes-immutable: true\n        creator:\n          type: string\n          x-dcl-go-name: Creator\n          readOnly: true\n          description: Output only. Email address of the authenticated creator.\n          x-kubernetes-immutable: true\n        deleteTime:\n          type: string\n          format: date-time\n          x-dcl-go-name: DeleteTime\n          readOnly: true\n          description: Output only. The deletion time.\n          x-kubernetes-immutable: true\n        description:\n          type: string\n          x-dcl-go-name: Description\n          description: User-provided description of the Service.\n        etag:\n          type: string\n          x-dcl-go-name: Etag\n          readOnly: true\n          description: Output only. A system-generated fingerprint for this version\n            of the resource. May be used to detect modification conflict during updates.\n          x-kubernetes-immutable: true\n        expireTime:\n          type: string\n          format: date-time\n          x-dcl-go-name: ExpireTime\n          readOnly: true\n          description: Output only. For a deleted resource, the time after which it\n            will be permamently deleted.\n          x-kubernetes-immutable: true\n        generation:\n          type: integer\n          format: int64\n          x-dcl-go-name: Generation\n          readOnly: true\n          description: Output only. A number that monotonically increases every time\n            the user modifies the desired state.\n          x-kubernetes-immutable: true\n        ingress:\n          type: string\n          x-dcl-go-name: Ingress\n          x-dcl-go-type: ServiceIngressEnum\n          description: Provides the ingress settings for this Service. On output,\n            returns the currently observed ingress settings, or INGRESS_TRAFFIC_UNSPECIFIED\n            if no revision is active.\n          enum:\n          - INGRESS_TRAFFIC_UNSPECIFIED\n          - INGRESS_TRAFFIC_ALL\n          - INGRESS_TRAFFIC_INTERNAL_ONLY\n          - INGRESS_TRAFFIC_INTERNAL_LOAD_BALANCER\n        labels:\n          type: object\n          additionalProperties:\n            type: string\n          x-dcl-go-name: Labels\n          readOnly: true\n          description: Map of string keys and values that can be used to organize\n            and categorize objects. User-provided labels are shared with Google's\n            billing system, so they can be used to filter, or break down billing charges\n            by team, component, environment, state, etc. For more information, visit\n            https://cloud.google.com/resource-manager/docs/creating-managing-labels\n            or https://cloud.google.com/run/docs/configuring/labels Cloud Run will\n            populate some labels with 'run.googleapis.com' or 'serving.knative.dev'\n            namespaces. Those labels are read-only, and user changes will not be preserved.\n          x-kubernetes-immutable: true\n        lastModifier:\n          type: string\n          x-dcl-go-name: LastModifier\n          readOnly: true\n          description: Output only. Email address of the last authenticated modifier.\n          x-kubernetes-immutable: true\n        latestCreatedRevision:\n          type: string\n          x-dcl-go-name: LatestCreatedRevision\n          readOnly: true\n          description: Output only. Name of the last created revision. See comments\n            in `reconciling` for additional information on reconciliation process\n            in Cloud Run.\n          x-kubernetes-immutable: true\n        latestReadyRevision:\n          type: string\n          x-dcl-go-name: LatestReadyRevision\n          readOnly: true\n          description: Output only. Name of the latest revision that is serving traffic.\n            See comments in `reconciling` for additional information on reconciliation\n            process in Cloud Run.\n          x-kubernetes-immutable: true\n        launchStage:\n          type: string\n          x-dcl-go-name: LaunchStage\n          x-dcl-go-type: ServiceLaunchStageEnum\n          description: 'The launch stage as defined by [Google Cloud Platform Launch\n            Stages](http://cloud.google.com/terms/launch-stages). Cloud Run supports\n            `ALPHA`, `BETA`, and `GA`. If no value is specified, GA is assumed. Possible\n            values: LAUNCH_STAGE_UNSPECIFIED, UNIMPLEMENTED, PRELAUNCH, EARLY_ACCESS,\n            ALPHA, BETA, GA, DEPRECATED'\n          enum:\n          - LAUNCH_STAGE_UNSPECIFIED\n          - UNIMPLEMENTED\n          - PRELAUNCH\n          - EARLY_ACCESS\n          - ALPHA\n          - BETA\n          - GA\n          - DEPRECATED\n        location:\n          type: string\n          x-dcl-go-name: Location\n          description: The location for the resource\n          x-kubernetes-immutable: true\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: 'Output only. The fully qualified name of this Service, composed\n            from CreateServiceRequest.parent and CreateServiceRequest.service_id.\n            Format: projects/{project}/locations/{location}/services/{service_id}'\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: The project for the resource\n          x-kubernetes-immutable: true\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        reconciling:\n          type: boolean\n          x-dcl-go-name: Reconciling\n          readOnly: true\n          description: 'Output only. Returns true if the Service is currently being\n            acted upon by the system to bring it into the desired state. When a new\n            Service is created, or an existing one is updated, Cloud Run will asynchronously\n            perform all necessary steps to bring the Service to the desired serving\n            state. This process is called reconciliation. While reconciliation is\n            in process, `observed_generation`, `latest_ready_revison`, `traffic_statuses`,\n            and `uri` will have transient values that might mismatch the intended\n            state: Once reconciliation is over (and this field is false), there are\n            two possible outcomes: reconciliation succeeded and the serving state\n            matches the Service, or there was an error, and reconciliation failed.\n            This state can be found in `terminal_condition.state`. If reconciliation\n            succeeded, the following fields will match: `traffic` and `traffic_statuses`,\n            `observed_generation` and `generation`, `latest_ready_revision` and `latest_created_revision`.\n            If reconciliation failed, `traffic_statuses`, `observed_generation`, and\n            `latest_ready_revision` will have the state of the last serving revision,\n            or empty for newly created Services. Additional information on the failure\n            can be found in `terminal_condition` and `conditions`.'\n          x-kubernetes-immutable: true\n        template:\n          type: object\n          x-dcl-go-name: Template\n          x-dcl-go-type: ServiceTemplate\n          description: Required. The template used to create revisions for this Service.\n          properties:\n            annotations:\n              type: object\n              additionalProperties:\n                type: string\n              x-dcl-go-name: Annotations\n              description: KRM-style annotations for the resource.\n            containerConcurrency:\n              type: integer\n              format: int64\n              x-dcl-go-name: ContainerConcurrency\n              description: Sets the maximum number of requests that each serving instance\n                can receive.\n              x-dcl-server-default: true\n            containers:\n              type: array\n              x-dcl-go-name: Containers\n              description: Holds the single container that defines the unit of execution\n                for this Revision.\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: object\n                x-dcl-go-type: ServiceTemplateContainers\n                required:\n                - image\n                properties:\n                  args:\n                    type: array\n                    x-dcl-go-name: Args\n                    description: 'Arguments to the entrypoint. The docker image''s\n                      CMD is used if this is not provided. Variable references $(VAR_NAME)\n                      are expanded using the container''s environment. If a variable\n                      cannot be resolved, the reference in the input string will be\n                      unchanged. The $(VAR_NAME) syntax can be escaped with a double\n                      $$, ie: $$(VAR_NAME). Escaped references will never be expanded,\n                      regardless of whether the variable exists or not. More info:\n                      https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell'\n                    x-dcl-send-empty: true\n                    x-dcl-list-type: list\n                    items:\n                      type: string\n                      x-dcl-go-type: string\n                  command:\n                    type: array\n                    x-dcl-go-name: Command\n                    description: 'Entrypoint array. Not executed within a shell. The\n                      docker image''s ENTRYPOINT is used if this is not provided.\n                      Variable references $(VAR_NAME) are expanded using the container''s\n                      environment. If a variable cannot be resolved, the reference\n                      in the input string will be unchanged. The $(VAR_NAME) syntax\n                      can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references\n                      will never be expanded, regardless of whether the variable exists\n                      or not. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell'\n                    x-dcl-send-empty: true\n                    x-dcl-list-type: list\n                    items:\n                      type: string\n                      x-dcl-go-type: string\n                  env:\n                    type: array\n                    x-dcl-go-name: Env\n                    description: List of environment variables to set in the container.\n                    x-dcl-send-empty: true\n                    x-dcl-list-type: list\n                    items:\n                      type: object\n                      x-dcl-go-type: ServiceTemplateContainersEnv\n                      required:\n                      - name\n                      properties:\n                        name:\n                          type: string\n                          x-dcl-go-name: Name\n                          description: Required. Name of the environment variable.\n                            Must be a C_IDENTIFIER, and mnay not exceed 32768 characters.\n                        value:\n                          type: string\n                          x-dcl-go-name: Value\n                          description: 'Variable references $(VAR_NAME) are expanded\n                            using the previous defined environment variables in the\n                            container and any route environment variables. If a variable\n                            cannot be resolved, the reference in the input string\n                            will be unchanged. The $(VAR_NAME) syntax can be escaped\n                            with a double $$, ie: $$(VAR_NAME). Escaped references\n                            will never be expanded, regardless of whether the variable\n                            exists or not. Defaults to \"\", and the maximum length\n                            is 32768 bytes.'\n                          x-dcl-conflicts:\n                          - valueSource\n                        valueSource:\n                          type: object\n                          x-dcl-go-name: ValueSource\n                          x-dcl-go-type: ServiceTemplateContainersEnvValueSource\n                          description: Source for the environment variable's value.\n                          x-dcl-conflicts:\n                          - value\n                          properties:\n                            secretKeyRef:\n                              type: object\n                              x-dcl-go-name: SecretKeyRef\n                              x-dcl-go-type: ServiceTemplateContainersEnvValueSourceSecretKeyRef\n                              description: Selects a secret and a specific version\n                                from Cloud Secret Manager.\n                              required:\n                              - secret\n                              properties:\n                                secret:\n                                  type: string\n                                  x-dcl-go-name: Secret\n                                  description: 'Required. The name of the secret in\n                                    Cloud Secret Manager. Format: {secret_name} if\n                                    the secret is in the same project. projects/{project}/secrets/{secret_name}\n                                    if the secret is in a different project.'\n                                  x-dcl-references:\n                                  - resource: Secretmanager/Secret\n                                    field: name\n                                version:\n                                  type: string\n                                  x-dcl-go-name: Version\n                                  description: The Cloud Secret Manager secret version.\n                                    Can be 'latest' for the latest value or an integer\n                                    for a specific version.\n                                  x-dcl-references:\n                                  - resource: Secretmanager/SecretVersion\n                                    field: name\n                  image:\n                    type: string\n                    x-dcl-go-name: Image\n                    description: 'Required. URL of the Container image in Google Container\n                      Registry or Docker More info: https://kubernetes.io/docs/concepts/containers/images'\n                  name:\n                    type: string\n                    x-dcl-go-name: Name\n                    description: Name of the container specified as a DNS_LABEL.\n                  ports:\n                    type: array\n                    x-dcl-go-name: Ports\n                    description: List of ports to expose from the container. Only\n                      a single port can be specified. The specified ports must be\n                      listening on all interfaces (0.0.0.0) within the container to\n                      be accessible. If omitted, a port number will be chosen and\n                      passed to the container through the PORT environment variable\n                      for the container to listen on.\n                    x-dcl-server-default: true\n                    x-dcl-send-empty: true\n                    x-dcl-list-type: list\n                    items:\n                      type: object\n                      x-dcl-go-type: ServiceTemplateContainersPorts\n                      properties:\n                        containerPort:\n                          type: integer\n                          format: int64\n                          x-dcl-go-name: ContainerPort\n                          description: Port number the container listens on. This\n                            must be a valid TCP port number, 0 < container_port <\n                            65536.\n                        name:\n                          type: string\n                          x-dcl-go-name: Name\n                          description: If specified, used to specify which protocol\n                            to use. Allowed values are \"http1\" and \"h2c\".\n                  resources:\n                    type: object\n                    x-dcl-go-name: Resources\n                    x-dcl-go-type: ServiceTemplateContainersResources\n                    description: 'Compute Resource requirements by this container.\n                      More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources'\n                    x-dcl-server-default: true\n                    properties:\n                      cpuIdle:\n                        type: boolean\n                        x-dcl-go-name: CpuIdle\n                        description: Determines whether CPU should be throttled or\n                          not outside of requests.\n                      limits:\n                        type: object\n                        additionalProperties:\n                          type: string\n                        x-dcl-go-name: Limits\n                        description: 'Only memory and CPU are supported. Note: The\n                          only supported values for CPU are ''1'', ''2'', and ''4''.\n                          Setting 4 CPU requires at least 2Gi of memory. The values\n                          of the map is string form of the ''quantity'' k8s type:\n                          https://github.com/kubernetes/kubernetes/blob/master/staging/src/k8s.io/apimachinery/pkg/api/resource/quantity.go'\n                  volumeMounts:\n                    type: array\n                    x-dcl-go-name: VolumeMounts\n                    description: Volume to mount into the container's filesystem.\n                    x-dcl-send-empty: true\n                    x-dcl-list-type: list\n                    items:\n                      type: object\n                      x-dcl-go-type: ServiceTemplateContainersVolumeMounts\n                      required:\n                      - name\n                      - mountPath\n                      properties:\n                        mountPath:\n                          type: string\n                          x-dcl-go-name: MountPath\n                          description: Required. Path within the container at which\n                            the volume should be mounted. Must not contain ':'. For\n                            Cloud SQL volumes, it can be left empty, or must otherwise\n                            be `/cloudsql`. All instances defined in the Volume will\n                            be available as `/cloudsql/[instance]`. For more information\n                            on Cloud SQL volumes, visit https://cloud.google.com/sql/docs/mysql/connect-run\n                        name:\n                          type: string\n                          x-dcl-go-name: Name\n                          description: Required. This must match the Name of a Volume.\n            executionEnvironment:\n              type: string\n              x-dcl-go-name: ExecutionEnvironment\n              x-dcl-go-type: ServiceTemplateExecutionEnvironmentEnum\n              description: 'The sandbox environment to host this Revision. Possible\n                values: EXECUTION_ENVIRONMENT_UNSPECIFIED, EXECUTION_ENVIRONMENT_GEN1,\n                EXECUTION_ENVIRONMENT_GEN2'\n              enum:\n              - EXECUTION_ENVIRONMENT_UNSPECIFIED\n              - EXECUTION_ENVIRONMENT_GEN1\n              - EXECUTION_ENVIRONMENT_GEN2\n            labels:\n              type: object\n              additionalProperties:\n                type: string\n              x-dcl-go-name: Labels\n              description: KRM-style labels for the resource.\n            revision:\n              type: string\n              x-dcl-go-name: Revision\n              description: The unique name for the revision. If this field is omitted,\n                it will be automatically generated based on the Service name.\n            scaling:\n              type: object\n              x-dcl-go-name: Scaling\n              x-dcl-go-type: ServiceTemplateScaling\n              description: Scaling settings for this Revision.\n              properties:\n                maxInstanceCount:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: MaxInstanceCount\n                  description: Maximum number of serving instances that this resource\n                    should have.\n                minInstanceCount:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: MinInstanceCount\n                  description: Minimum number of serving instances that this resource\n                    should have.\n            serviceAccount:\n              type: string\n              x-dcl-go-name: ServiceAccount\n              description: Email address of the IAM service account associated with\n                the revision of the service. The service account represents the identity\n                of the running revision, and determines what permissions the revision\n                has. If not provided, the revision will use the project's default\n                service account.\n              x-dcl-server-default: true\n              x-dcl-references:\n              - resource: Iam/ServiceAccount\n                field: email\n            timeout:\n              type: string\n              x-dcl-go-name: Timeout\n              description: Max allowed time for an instance to respond to a request.\n              x-dcl-server-default: true\n            volumes:\n              type: array\n              x-dcl-go-name: Volumes\n              description: A list of Volumes to make available to containers.\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: object\n                x-dcl-go-type: ServiceTemplateVolumes\n                required:\n                - name\n                properties:\n                  cloudSqlInstance:\n                    type: object\n                    x-dcl-go-name: CloudSqlInstance\n                    x-dcl-go-type: ServiceTemplateVolumesCloudSqlInstance\n                    description: For Cloud SQL volumes, contains the specific instances\n                      that should be mounted. Visit https://cloud.google.com/sql/docs/mysql/connect-run\n                      for more information on how to connect Cloud SQL and Cloud Run.\n                    x-dcl-conflicts:\n                    - secret\n                    properties:\n                      instances:\n                        type: array\n                        x-dcl-go-name: Instances\n                        description: 'The Cloud SQL instance connection names, as\n                          can be found in https://console.cloud.google.com/sql/instances.\n                          Visit https://cloud.google.com/sql/docs/mysql/connect-run\n                          for more information on how to connect Cloud SQL and Cloud\n                          Run. Format: {project}:{location}:{instance}'\n                        x-dcl-send-empty: true\n                        x-dcl-list-type: list\n                        items:\n                          type: string\n                          x-dcl-go-type: string\n                          x-dcl-references:\n                          - resource: Sql/Instance\n                            field: instanceName\n                  name:\n                    type: string\n                    x-dcl-go-name: Name\n                    description: Required. Volume's name.\n                  secret:\n                    type: object\n                    x-dcl-go-name: Secret\n                    x-dcl-go-type: ServiceTemplateVolumesSecret\n                    description: 'Secret represents a secret that should populate\n                      this volume. More info: https://kubernetes.io/docs/concepts/storage/volumes#secret'\n                    x-dcl-conflicts:\n                    - cloudSqlInstance\n                    required:\n                    - secret\n                    properties:\n                      defaultMode:\n                        type: integer\n                        format: int64\n                        x-dcl-go-name: DefaultMode\n                        description: 'Integer representation of mode bits to use on\n                          created files by default. Must be a value between 0000 and\n                          0777 (octal), defaulting to 0644. Directories within the\n                          path are not affected by this setting. Notes * Internally,\n                          a umask of 0222 will be applied to any non-zero value. *\n                          This is an integer representation of the mode bits. So,\n                          the octal integer value should look exactly as the chmod\n                          numeric notation with a leading zero. Some examples: for\n                          chmod 777 (a=rwx), set to 0777 (octal) or 511 (base-10).\n                          For chmod 640 (u=rw,g=r), set to 0640 (octal) or 416 (base-10).\n                          For chmod 755 (u=rwx,g=rx,o=rx), set to 0755 (octal) or\n                          493 (base-10). * This might be in conflict with other options\n                          that affect the file mode, like fsGroup, and the result\n                          can be other mode bits set. This might be in conflict with\n                          other options that affect the file mode, like fsGroup, and\n                          as a result, other mode bits could be set.'\n                      items:\n                        type: array\n                        x-dcl-go-name: Items\n                        description: If unspecified, the volume will expose a file\n                          whose name is the secret, relative to VolumeMount.mount_path.\n                          If specified, the key will be used as the version to fetch\n                          from Cloud Secret Manager and the path will be the name\n                          of the file exposed in the volume. When items are defined,\n                          they must specify a path and a version.\n                        x-dcl-send-empty: true\n                        x-dcl-list-type: list\n                        items:\n                          type: object\n                          x-dcl-go-type: ServiceTemplateVolumesSecretItems\n                          required:\n                          - path\n                          properties:\n                            mode:\n                              type: integer\n                              format: int64\n                              x-dcl-go-name: Mode\n                              description: 'Integer octal mode bits to use on this\n                                file, must be a value between 01 and 0777 (octal).\n                                If 0 or not set, the Volume''s default mode will be\n                                used. Notes * Internally, a umask of 0222 will be\n                                applied to any non-zero value. * This is an integer\n                                representation of the mode bits. So, the octal integer\n                                value should look exactly as the chmod numeric notation\n                                with a leading zero. Some examples: for chmod 777\n                                (a=rwx), set to 0777 (octal) or 511 (base-10). For\n                                chmod 640 (u=rw,g=r), set to 0640 (octal) or 416 (base-10).\n                                For chmod 755 (u=rwx,g=rx,o=rx), set to 0755 (octal)\n                                or 493 (base-10). * This might be in conflict with\n                                other options that affect the file mode, like fsGroup,\n                                and the result can be other mode bits set.'\n                            path:\n                              type: string\n                              x-dcl-go-name: Path\n                              description: Required. The relative path of the secret\n                                in the container.\n                            version:\n                              type: string\n                              x-dcl-go-name: Version\n                              description: The Cloud Secret Manager secret version.\n                                Can be 'latest' for the latest value or an integer\n                                for a specific version.\n                              x-dcl-references:\n                              - resource: Secretmanager/SecretVersion\n                                field: name\n                      secret:\n                        type: string\n                        x-dcl-go-name: Secret\n                        description: 'Required. The name of the secret in Cloud Secret\n                          Manager. Format: {secret} if the secret is in the same project.\n                          projects/{project}/secrets/{secret} if the secret is in\n                          a different project.'\n                        x-dcl-references:\n                        - resource: Secretmanager/Secret\n                          field: name\n            vpcAccess:\n              type: object\n              x-dcl-go-name: VPCAccess\n              x-dcl-go-type: ServiceTemplateVPCAccess\n              description: VPC Access configuration to use for this Revision. For\n                more information, visit https://cloud.google.com/run/docs/configuring/connecting-vpc.\n              properties:\n                connector:\n                  type: string\n                  x-dcl-go-name: Connector\n                  description: 'VPC Access connector name. Format: projects/{project}/locations/{location}/connectors/{connector}'\n                  x-dcl-references:\n                  - resource: Vpcaccess/Connector\n                    field: name\n                egress:\n                  type: string\n                  x-dcl-go-name: Egress\n                  x-dcl-go-type: ServiceTemplateVPCAccessEgressEnum\n                  description: 'Traffic VPC egress settings. Possible values: VPC_EGRESS_UNSPECIFIED,\n                    ALL_TRAFFIC, PRIVATE_RANGES_ONLY'\n                  enum:\n                  - VPC_EGRESS_UNSPECIFIED\n                  - ALL_TRAFFIC\n                  - PRIVATE_RANGES_ONLY\n        terminalCondition:\n          type: object\n          x-dcl-go-name: TerminalCondition\n          x-dcl-go-type: ServiceTerminalCondition\n          readOnly: true\n          description: Output only. The Condition of this Service, containing its\n            readiness status, and detailed error information in case it did not reach\n            a serving state. See comments in `reconciling` for additional information\n            on reconciliation process in Cloud Run.\n          properties:\n            jobReason:\n              type: string\n              x-dcl-go-name: JobReason\n              x-dcl-go-type: ServiceTerminalConditionJobReasonEnum\n              description: 'A reason for the job condition. Possible values: JOB_REASON_UNDEFINED,\n                JOB_STATUS_SERVICE_POLLING_ERROR'\n              x-dcl-conflicts:\n              - reason\n              - revisionReason\n              enum:\n              - JOB_REASON_UNDEFINED\n              - JOB_STATUS_SERVICE_POLLING_ERROR\n            lastTransitionTime:\n              type: string\n              format: date-time\n              x-dcl-go-name: LastTransitionTime\n              description: Last time the condition transitioned from one status to\n                another.\n            message:\n              type: string\n              x-dcl-go-name: Message\n              description: Human readable message indicating details about the current\n                status.\n            reason:\n              type: string\n              x-dcl-go-name: Reason\n              x-dcl-go-type: ServiceTerminalConditionReasonEnum\n              description: 'A common (service-level) reason for this condition. Possible\n                values: COMMON_REASON_UNDEFINED, UNKNOWN, REVISION_FAILED, PROGRESS_DEADLINE_EXCEEDED,\n                CONTAINER_MISSING, CONTAINER_PERMISSION_DENIED, CONTAINER_IMAGE_UNAUTHORIZED,\n                CONTAINER_IMAGE_AUTHORIZATION_CHECK_FAILED, ENCRYPTION_KEY_PERMISSION_DENIED,\n                ENCRYPTION_KEY_CHECK_FAILED, SECRETS_ACCESS_CHECK_FAILED, WAITING_FOR_OPERATION,\n                IMMEDIATE_RETRY, POSTPONED_RETRY, INTERNAL'\n              x-dcl-conflicts:\n              - revisionReason\n              - jobReason\n              enum:\n              - COMMON_REASON_UNDEFINED\n              - UNKNOWN\n              - REVISION_FAILED\n              - PROGRESS_DEADLINE_EXCEEDED\n              - CONTAINER_MISSING\n              - CONTAINER_PERMISSION_DENIED\n              - CONTAINER_IMAGE_UNAUTHORIZED\n              - CONTAINER_IMAGE_AUTHORIZATION_CHECK_FAILED\n              - ENCRYPTION_KEY_PERMISSION_DENIED\n              - ENCRYPTION_KEY_CHECK_FAILED\n              - SECRETS_ACCESS_CHECK_FAILED\n              - WAITING_FOR_OPERATION\n              - IMMEDIATE_RETRY\n              - POSTPONED_RETRY\n              - INTERNAL\n            revisionReason:\n              type: string\n              x-dcl-go-name: RevisionReason\n              x-dcl-go-type: ServiceTerminalConditionRevisionReasonEnum\n              description: 'A reason for the revision condition. Possible values:\n                REVISION_REASON_UNDEFINED, PENDING, RESERVE, RETIRED, RETIRING, RECREATING,\n                HEALTH_CHECK_CONTAINER_ERROR, CUSTOMIZED_PATH_RESPONSE_PENDING, MIN_INSTANCES_NOT_PROVISIONED,\n                ACTIVE_REVISION_LIMIT_REACHED, NO_DEPLOYMENT'\n              x-dcl-conflicts:\n              - reason\n              - jobReason\n              enum:\n              - REVISION_REASON_UNDEFINED\n              - PENDING\n              - RESERVE\n              - RETIRED\n              - RETIRING\n              - RECREATING\n              - HEALTH_CHECK_CONTAINER_ERROR\n              - CUSTOMIZED_PATH_RESPONSE_PENDING\n              - MIN_INSTANCES_NOT_PROVISIONED\n              - ACTIVE_REVISION_LIMIT_REACHED\n              - NO_DEPLOYMENT\n            severity:\n              type: string\n              x-dcl-go-name: Severity\n              x-dcl-go-type: ServiceTerminalConditionSeverityEnum\n              description: 'How to interpret failures of this condition, one of Error,\n                Warning, Info Possible values: SEVERITY_UNSPECIFIED, ERROR, WARNING,\n                INFO'\n              enum:\n              - SEVERITY_UNSPECIFIED\n              - ERROR\n              - WARNING\n              - INFO\n            state:\n              type: string\n              x-dcl-go-name: State\n              x-dcl-go-type: ServiceTerminalConditionStateEnum\n              description: 'State of the condition. Possible values: STATE_UNSPECIFIED,\n                CONDITION_PENDING, CONDITION_RECONCILING, CONDITION_FAILED, CONDITION_SUCCEEDED'\n              enum:\n              - STATE_UNSPECIFIED\n              - CONDITION_PENDING\n              - CONDITION_RECONCILING\n              - CONDITION_FAILED\n              - CONDITION_SUCCEEDED\n            type:\n              type: string\n              x-dcl-go-name: Type\n              description: 'type is used to communicate the status of the reconciliation\n                process. See also: https://github.com/knative/serving/blob/main/docs/spec/errors.md#error-conditions-and-reporting\n                Types common to all resources include: * \"Ready\": True when the Resource\n                is ready.'\n        traffic:\n          type: array\n          x-dcl-go-name: Traffic\n          description: Specifies how to distribute traffic over a collection of Revisions\n            belonging to the Service. If traffic is empty or not provided, defaults\n            to 100% traffic to the latest `Ready` Revision.\n          x-dcl-send-empty: true\n          x-dcl-list-type: list\n          items:\n            type: object\n            x-dcl-go-type: ServiceTraffic\n            properties:\n              percent:\n                type: integer\n                format: int64\n                x-dcl-go-name: Percent\n                description: Specifies percent of the traffic to this Revision. This\n                  defaults to zero if unspecified. Cloud Run currently requires 100\n                  percent for a single TrafficTarget entry.\n              revision:\n                type: string\n                x-dcl-go-name: Revision\n                description: Revision to which to send this portion of traffic, if\n                  traffic allocation is by revision.\n              tag:\n                type: string\n                x-dcl-go-name: Tag\n                description: Indicates a string to be part of the URI to exclusively\n                  reference this target.\n              type:\n                type: string\n                x-dcl-go-name: Type\n                x-dcl-go-type: ServiceTrafficTypeEnum\n                description: 'The allocation type for this traffic target. Possible\n                  values: TRAFFIC_TARGET_ALLOCATION_TYPE_UNSPECIFIED, TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST,\n                  TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION'\n                enum:\n                - TRAFFIC_TARGET_ALLOCATION_TYPE_UNSPECIFIED\n                - TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST\n                - TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION\n        trafficStatuses:\n          type: array\n          x-dcl-go-name: TrafficStatuses\n          readOnly: true\n          description: Output only. Detailed status information for corresponding\n            traffic targets. See comments in `reconciling` for additional information\n            on reconciliation process in Cloud Run.\n          x-kubernetes-immutable: true\n          x-dcl-list-type: list\n          items:\n            type: object\n            x-dcl-go-type: ServiceTrafficStatuses\n            properties:\n              percent:\n                type: integer\n                format: int64\n                x-dcl-go-name: Percent\n                description: Specifies percent of the traffic to this Revision.\n              revision:\n                type: string\n                x-dcl-go-name: Revision\n                description: Revision to which this traffic is sent.\n              tag:\n                type: string\n                x-dcl-go-name: Tag\n                description: Indicates the string used in the URI to exclusively reference\n                  this target.\n              type:\n                type: string\n                x-dcl-go-name: Type\n                x-dcl-go-type: ServiceTrafficStatusesTypeEnum\n                description: 'The allocation type for this traffic target. Possible\n                  values: TRAFFIC_TARGET_ALLOCATION_TYPE_UNSPECIFIED, TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST,\n                  TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION'\n                enum:\n                - TRAFFIC_TARGET_ALLOCATION_TYPE_UNSPECIFIED\n                - TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST\n                - TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION\n              uri:\n                type: string\n                x-dcl-go-name: Uri\n                description: Displays the target URI.\n        uid:\n          type: string\n          x-dcl-go-name: Uid\n          readOnly: true\n          description: Output only. Server assigned unique identifier for the trigger.\n            The value is a UUID4 string and guaranteed to remain unchanged until the\n            resource is deleted.\n          x-kubernetes-immutable: true\n        updateTime:\n          type: string\n          format: date-time\n          x-dcl-go-name: UpdateTime\n          readOnly: true\n          description: Output only. The last-modified time.\n          x-kubernetes-immutable: true\n        uri:\n          type: string\n          x-dcl-go-name: Uri\n          readOnly: true\n          description: Output only. The main URI in which this Service is serving\n            traffic.\n          x-kubernetes-immutable: true\n")
+
+// 43544 bytes
+// MD5: 93244368703fe35619ee8f51c155c879
@@ -0,0 +1,688 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package spanner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func (r *Database) validate() error {
+
+	if err := dcl.Required(r, "name"); err != nil {
+		return err
+	}
+	if err := dcl.RequiredParameter(r.Instance, "Instance"); err != nil {
+		return err
+	}
+	if err := dcl.RequiredParameter(r.Project, "Project"); err != nil {
+		return err
+	}
+	return nil
+}
+func (r *Database) basePath() string {
+	params := map[string]interface{}{}
+	return dcl.Nprintf("https://spanner.googleapis.com/v1/", params)
+}
+
+func (r *Database) getURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project":  dcl.ValueOrEmptyString(nr.Project),
+		"instance": dcl.ValueOrEmptyString(nr.Instance),
+		"name":     dcl.ValueOrEmptyString(nr.Name),
+	}
+	return dcl.URL("projects/{{project}}/instances/{{instance}}/databases/{{name}}", nr.basePath(), userBasePath, params), nil
+}
+
+func (r *Database) listURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project":  dcl.ValueOrEmptyString(nr.Project),
+		"instance": dcl.ValueOrEmptyString(nr.Instance),
+	}
+	return dcl.URL("projects/{{project}}/instances/{{instance}}/databases", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *Database) createURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project":  dcl.ValueOrEmptyString(nr.Project),
+		"instance": dcl.ValueOrEmptyString(nr.Instance),
+	}
+	return dcl.URL("projects/{{project}}/instances/{{instance}}/databases", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *Database) deleteURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project":  dcl.ValueOrEmptyString(nr.Project),
+		"instance": dcl.ValueOrEmptyString(nr.Instance),
+		"name":     dcl.ValueOrEmptyString(nr.Name),
+	}
+	return dcl.URL("projects/{{project}}/instances/{{instance}}/databases/{{name}}", nr.basePath(), userBasePath, params), nil
+}
+
+// databaseApiOperation represents a mutable operation in the underlying REST
+// API such as Create, Update, or Delete.
+type databaseApiOperation interface {
+	do(context.Context, *Database, *Client) error
+}
+
+// newUpdateDatabaseUpdateDdlRequest creates a request for an
+// Database resource's UpdateDdl update type by filling in the update
+// fields based on the intended state of the resource.
+func newUpdateDatabaseUpdateDdlRequest(ctx context.Context, f *Database, c *Client) (map[string]interface{}, error) {
+	req := map[string]interface{}{}
+	res := f
+	_ = res
+
+	if v := f.Ddl; !dcl.IsEmptyValueIndirect(v) {
+		req["ddl"] = v
+	}
+	return req, nil
+}
+
+// marshalUpdateDatabaseUpdateDdlRequest converts the update into
+// the final JSON request body.
+func marshalUpdateDatabaseUpdateDdlRequest(c *Client, m map[string]interface{}) ([]byte, error) {
+
+	return json.Marshal(m)
+}
+
+type updateDatabaseUpdateDdlOperation struct {
+	// If the update operation has the REQUIRES_APPLY_OPTIONS trait, this will be populated.
+	// Usually it will be nil - this is to prevent us from accidentally depending on apply
+	// options, which should usually be unnecessary.
+	ApplyOptions []dcl.ApplyOption
+	FieldDiffs   []*dcl.FieldDiff
+}
+
+func (c *Client) listDatabaseRaw(ctx context.Context, r *Database, pageToken string, pageSize int32) ([]byte, error) {
+	u, err := r.urlNormalized().listURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string)
+	if pageToken != "" {
+		m["pageToken"] = pageToken
+	}
+
+	if pageSize != DatabaseMaxPage {
+		m["pageSize"] = fmt.Sprintf("%v", pageSize)
+	}
+
+	u, err = dcl.AddQueryParams(u, m)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	return ioutil.ReadAll(resp.Response.Body)
+}
+
+type listDatabaseOperation struct {
+	Databases []map[string]interface{} `json:"databases"`
+	Token     string                   `json:"nextPageToken"`
+}
+
+func (c *Client) listDatabase(ctx context.Context, r *Database, pageToken string, pageSize int32) ([]*Database, string, error) {
+	b, err := c.listDatabaseRaw(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var m listDatabaseOperation
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, "", err
+	}
+
+	var l []*Database
+	for _, v := range m.Databases {
+		res, err := unmarshalMapDatabase(v, c, r)
+		if err != nil {
+			return nil, m.Token, err
+		}
+		res.Project = r.Project
+		res.Instance = r.Instance
+		l = append(l, res)
+	}
+
+	return l, m.Token, nil
+}
+
+func (c *Client) deleteAllDatabase(ctx context.Context, f func(*Database) bool, resources []*Database) error {
+	var errors []string
+	for _, res := range resources {
+		if f(res) {
+			// We do not want deleteAll to fail on a deletion or else it will stop deleting other resources.
+			err := c.DeleteDatabase(ctx, res)
+			if err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("%v", strings.Join(errors, "\n"))
+	} else {
+		return nil
+	}
+}
+
+type deleteDatabaseOperation struct{}
+
+func (op *deleteDatabaseOperation) do(ctx context.Context, r *Database, c *Client) error {
+	r, err := c.GetDatabase(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			c.Config.Logger.InfoWithContextf(ctx, "Database not found, returning. Original error: %v", err)
+			return nil
+		}
+		c.Config.Logger.WarningWithContextf(ctx, "GetDatabase checking for existence. error: %v", err)
+		return err
+	}
+
+	u, err := r.deleteURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	// Delete should never have a body
+	body := &bytes.Buffer{}
+	_, err = dcl.SendRequest(ctx, c.Config, "DELETE", u, body, c.Config.RetryProvider)
+	if err != nil {
+		return fmt.Errorf("failed to delete Database: %w", err)
+	}
+
+	// We saw a race condition where for some successful delete operation, the Get calls returned resources for a short duration.
+	// This is the reason we are adding retry to handle that case.
+	retriesRemaining := 10
+	dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		_, err := c.GetDatabase(ctx, r)
+		if dcl.IsNotFound(err) {
+			return nil, nil
+		}
+		if retriesRemaining > 0 {
+			retriesRemaining--
+			return &dcl.RetryDetails{}, dcl.OperationNotDone{}
+		}
+		return nil, dcl.NotDeletedError{ExistingResource: r}
+	}, c.Config.RetryProvider)
+	return nil
+}
+
+// Create operations are similar to Update operations, although they do not have
+// specific request objects. The Create request object is the json encoding of
+// the resource, which is modified by res.marshal to form the base request body.
+type createDatabaseOperation struct {
+	response map[string]interface{}
+}
+
+func (op *createDatabaseOperation) FirstResponse() (map[string]interface{}, bool) {
+	return op.response, len(op.response) > 0
+}
+
+func (c *Client) databaseDiffsForRawDesired(ctx context.Context, rawDesired *Database, opts ...dcl.ApplyOption) (initial, desired *Database, diffs []*dcl.FieldDiff, err error) {
+	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
+	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
+	var fetchState *Database
+	if sh := dcl.FetchStateHint(opts); sh != nil {
+		if r, ok := sh.(*Database); !ok {
+			c.Config.Logger.WarningWithContextf(ctx, "Initial state hint was of the wrong type; expected Database, got %T", sh)
+		} else {
+			fetchState = r
+		}
+	}
+	if fetchState == nil {
+		fetchState = rawDesired
+	}
+
+	// 1.2: Retrieval of raw initial state from API
+	rawInitial, err := c.GetDatabase(ctx, fetchState)
+	if rawInitial == nil {
+		if !dcl.IsNotFound(err) {
+			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Database resource already exists: %s", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Database resource: %w", err)
+		}
+		c.Config.Logger.InfoWithContext(ctx, "Found that Database resource did not exist.")
+		// Perform canonicalization to pick up defaults.
+		desired, err = canonicalizeDatabaseDesiredState(rawDesired, rawInitial)
+		return nil, desired, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Found initial state for Database: %v", rawInitial)
+	c.Config.Logger.InfoWithContextf(ctx, "Initial desired state for Database: %v", rawDesired)
+
+	// The Get call applies postReadExtract and so the result may contain fields that are not part of API version.
+	if err := extractDatabaseFields(rawInitial); err != nil {
+		return nil, nil, nil, err
+	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// 1.3: Canonicalize raw initial state into initial state.
+	initial, err = canonicalizeDatabaseInitialState(rawInitial, rawDesired)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized initial state for Database: %v", initial)
+
+	// 1.4: Canonicalize raw desired state into desired state.
+	desired, err = canonicalizeDatabaseDesiredState(rawDesired, rawInitial, opts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized desired state for Database: %v", desired)
+
+	// 2.1: Comparison of initial and desired state.
+	diffs, err = diffDatabase(c, desired, initial, opts...)
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
+}
+
+func canonicalizeDatabaseInitialState(rawInitial, rawDesired *Database) (*Database, error) {
+	// TODO(magic-modules-eng): write canonicalizer once relevant traits are added.
+	return rawInitial, nil
+}
+
+/*
+* Canonicalizers
+*
+* These are responsible for converting either a user-specified config or a
+* GCP API response to a standard format that can be used for difference checking.
+* */
+
+func canonicalizeDatabaseDesiredState(rawDesired, rawInitial *Database, opts ...dcl.ApplyOption) (*Database, error) {
+
+	if rawInitial == nil {
+		// Since the initial state is empty, the desired state is all we have.
+		// We canonicalize the remaining nested objects with nil to pick up defaults.
+
+		return rawDesired, nil
+	}
+
+	canonicalDesired := &Database{}
+	if dcl.PartialSelfLinkToSelfLink(rawDesired.Name, rawInitial.Name) {
+		canonicalDesired.Name = rawInitial.Name
+	} else {
+		canonicalDesired.Name = rawDesired.Name
+	}
+	if dcl.NameToSelfLink(rawDesired.Instance, rawInitial.Instance) {
+		canonicalDesired.Instance = rawInitial.Instance
+	} else {
+		canonicalDesired.Instance = rawDesired.Instance
+	}
+	if dcl.NameToSelfLink(rawDesired.Project, rawInitial.Project) {
+		canonicalDesired.Project = rawInitial.Project
+	} else {
+		canonicalDesired.Project = rawDesired.Project
+	}
+	if dcl.StringArrayCanonicalize(rawDesired.Ddl, rawInitial.Ddl) {
+		canonicalDesired.Ddl = rawInitial.Ddl
+	} else {
+		canonicalDesired.Ddl = rawDesired.Ddl
+	}
+
+	return canonicalDesired, nil
+}
+
+func canonicalizeDatabaseNewState(c *Client, rawNew, rawDesired *Database) (*Database, error) {
+
+	if dcl.IsEmptyValueIndirect(rawNew.Name) && dcl.IsEmptyValueIndirect(rawDesired.Name) {
+		rawNew.Name = rawDesired.Name
+	} else {
+		if dcl.PartialSelfLinkToSelfLink(rawDesired.Name, rawNew.Name) {
+			rawNew.Name = rawDesired.Name
+		}
+	}
+
+	rawNew.Instance = rawDesired.Instance
+
+	if dcl.IsEmptyValueIndirect(rawNew.State) && dcl.IsEmptyValueIndirect(rawDesired.State) {
+		rawNew.State = rawDesired.State
+	} else {
+	}
+
+	rawNew.Project = rawDesired.Project
+
+	rawNew.Ddl = canonicalizeDatabaseDdl(rawDesired.Ddl, rawNew)
+
+	return rawNew, nil
+}
+
+// The differ returns a list of diffs, along with a list of operations that should be taken
+// to remedy them. Right now, it does not attempt to consolidate operations - if several
+// fields can be fixed with a patch update, it will perform the patch several times.
+// Diffs on some fields will be ignored if the `desired` state has an empty (nil)
+// value. This empty value indicates that the user does not care about the state for
+// the field. Empty fields on the actual object will cause diffs.
+// TODO(magic-modules-eng): for efficiency in some resources, add batching.
+func diffDatabase(c *Client, desired, actual *Database, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	if desired == nil || actual == nil {
+		return nil, fmt.Errorf("nil resource passed to diff - always a programming error: %#v, %#v", desired, actual)
+	}
+
+	c.Config.Logger.Infof("Diff function called with desired state: %v", desired)
+	c.Config.Logger.Infof("Diff function called with actual state: %v", actual)
+
+	var fn dcl.FieldName
+	var newDiffs []*dcl.FieldDiff
+	// New style diffs.
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Instance, actual.Instance, dcl.DiffInfo{Type: "ReferenceType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Instance")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.State, actual.State, dcl.DiffInfo{OutputOnly: true, Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("State")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Project, actual.Project, dcl.DiffInfo{Type: "ReferenceType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Project")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Ddl, actual.Ddl, dcl.DiffInfo{CustomDiff: databaseDdlEqual, OperationSelector: databaseDdlOperations()}, fn.AddNest("Ddl")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	return newDiffs, nil
+}
+
+// urlNormalized returns a copy of the resource struct with values normalized
+// for URL substitutions. For instance, it converts long-form self-links to
+// short-form so they can be substituted in.
+func (r *Database) urlNormalized() *Database {
+	normalized := dcl.Copy(*r).(Database)
+	normalized.Name = dcl.SelfLinkToName(r.Name)
+	normalized.Instance = dcl.SelfLinkToName(r.Instance)
+	normalized.Project = dcl.SelfLinkToName(r.Project)
+	return &normalized
+}
+
+func (r *Database) updateURL(userBasePath, updateName string) (string, error) {
+	nr := r.urlNormalized()
+	if updateName == "UpdateDdl" {
+		fields := map[string]interface{}{
+			"project":  dcl.ValueOrEmptyString(nr.Project),
+			"instance": dcl.ValueOrEmptyString(nr.Instance),
+			"name":     dcl.ValueOrEmptyString(nr.Name),
+		}
+		return dcl.URL("projects/{{project}}/instances/{{instance}}/databases/{{name}}/ddl", nr.basePath(), userBasePath, fields), nil
+
+	}
+
+	return "", fmt.Errorf("unknown update name: %s", updateName)
+}
+
+// marshal encodes the Database resource into JSON for a Create request, and
+// performs transformations from the resource schema to the API schema if
+// necessary.
+func (r *Database) marshal(c *Client) ([]byte, error) {
+	m, err := expandDatabase(c, r)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling Database: %w", err)
+	}
+
+	return json.Marshal(m)
+}
+
+// unmarshalDatabase decodes JSON responses into the Database resource schema.
+func unmarshalDatabase(b []byte, c *Client, res *Database) (*Database, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return unmarshalMapDatabase(m, c, res)
+}
+
+func unmarshalMapDatabase(m map[string]interface{}, c *Client, res *Database) (*Database, error) {
+
+	flattened := flattenDatabase(c, m, res)
+	if flattened == nil {
+		return nil, fmt.Errorf("attempted to flatten empty json object")
+	}
+	return flattened, nil
+}
+
+// expandDatabase expands Database into a JSON request object.
+func expandDatabase(c *Client, f *Database) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	res := f
+	_ = res
+	if v, err := dcl.DeriveField("projects/%s/instances/%s/databases/%s", f.Name, dcl.SelfLinkToName(f.Project), dcl.SelfLinkToName(f.Instance), dcl.SelfLinkToName(f.Name)); err != nil {
+		return nil, fmt.Errorf("error expanding Name into name: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["name"] = v
+	}
+	if v, err := dcl.EmptyValue(); err != nil {
+		return nil, fmt.Errorf("error expanding Instance into instance: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["instance"] = v
+	}
+	if v, err := dcl.EmptyValue(); err != nil {
+		return nil, fmt.Errorf("error expanding Project into project: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["project"] = v
+	}
+	if v := f.Ddl; dcl.ValueShouldBeSent(v) {
+		m["ddl"] = v
+	}
+
+	return m, nil
+}
+
+// flattenDatabase flattens Database from a JSON request object into the
+// Database type.
+func flattenDatabase(c *Client, i interface{}, res *Database) *Database {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if len(m) == 0 {
+		return nil
+	}
+
+	resultRes := &Database{}
+	resultRes.Name = dcl.FlattenString(m["name"])
+	resultRes.Instance = dcl.FlattenString(m["instance"])
+	resultRes.State = flattenDatabaseStateEnum(m["state"])
+	resultRes.Project = dcl.FlattenString(m["project"])
+	resultRes.Ddl = dcl.FlattenStringSlice(m["ddl"])
+
+	return resultRes
+}
+
+// flattenDatabaseStateEnumMap flattens the contents of DatabaseStateEnum from a JSON
+// response object.
+func flattenDatabaseStateEnumMap(c *Client, i interface{}, res *Database) map[string]DatabaseStateEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]DatabaseStateEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]DatabaseStateEnum{}
+	}
+
+	items := make(map[string]DatabaseStateEnum)
+	for k, item := range a {
+		items[k] = *flattenDatabaseStateEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenDatabaseStateEnumSlice flattens the contents of DatabaseStateEnum from a JSON
+// response object.
+func flattenDatabaseStateEnumSlice(c *Client, i interface{}, res *Database) []DatabaseStateEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []DatabaseStateEnum{}
+	}
+
+	if len(a) == 0 {
+		return []DatabaseStateEnum{}
+	}
+
+	items := make([]DatabaseStateEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenDatabaseStateEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenDatabaseStateEnum asserts that an interface is a string, and returns a
+// pointer to a *DatabaseStateEnum with the same value as that string.
+func flattenDatabaseStateEnum(i interface{}) *DatabaseStateEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return DatabaseStateEnumRef(s)
+}
+
+// This function returns a matcher that checks whether a serialized resource matches this resource
+// in its parameters (as defined by the fields in a Get, which definitionally define resource
+// identity).  This is useful in extracting the element from a List call.
+func (r *Database) matcher(c *Client) func([]byte) bool {
+	return func(b []byte) bool {
+		cr, err := unmarshalDatabase(b, c, r)
+		if err != nil {
+			c.Config.Logger.Warning("failed to unmarshal provided resource in matcher.")
+			return false
+		}
+		nr := r.urlNormalized()
+		ncr := cr.urlNormalized()
+		c.Config.Logger.Infof("looking for %v\nin %v", nr, ncr)
+
+		if nr.Project == nil && ncr.Project == nil {
+			c.Config.Logger.Info("Both Project fields null - considering equal.")
+		} else if nr.Project == nil || ncr.Project == nil {
+			c.Config.Logger.Info("Only one Project field is null - considering unequal.")
+			return false
+		} else if *nr.Project != *ncr.Project {
+			return false
+		}
+		if nr.Instance == nil && ncr.Instance == nil {
+			c.Config.Logger.Info("Both Instance fields null - considering equal.")
+		} else if nr.Instance == nil || ncr.Instance == nil {
+			c.Config.Logger.Info("Only one Instance field is null - considering unequal.")
+			return false
+		} else if *nr.Instance != *ncr.Instance {
+			return false
+		}
+		if nr.Name == nil && ncr.Name == nil {
+			c.Config.Logger.Info("Both Name fields null - considering equal.")
+		} else if nr.Name == nil || ncr.Name == nil {
+			c.Config.Logger.Info("Only one Name field is null - considering unequal.")
+			return false
+		} else if *nr.Name != *ncr.Name {
+			return false
+		}
+		return true
+	}
+}
+
+type databaseDiff struct {
+	// The diff should include one or the other of RequiresRecreate or UpdateOp.
+	RequiresRecreate bool
+	UpdateOp         databaseApiOperation
+	FieldName        string // used for error logging
+}
+
+func convertFieldDiffsToDatabaseDiffs(config *dcl.Config, fds []*dcl.FieldDiff, opts []dcl.ApplyOption) ([]databaseDiff, error) {
+	opNamesToFieldDiffs := make(map[string][]*dcl.FieldDiff)
+	// Map each operation name to the field diffs associated with it.
+	for _, fd := range fds {
+		for _, ro := range fd.ResultingOperation {
+			if fieldDiffs, ok := opNamesToFieldDiffs[ro]; ok {
+				fieldDiffs = append(fieldDiffs, fd)
+				opNamesToFieldDiffs[ro] = fieldDiffs
+			} else {
+				config.Logger.Infof("%s required due to diff: %v", ro, fd)
+				opNamesToFieldDiffs[ro] = []*dcl.FieldDiff{fd}
+			}
+		}
+	}
+	var diffs []databaseDiff
+	// For each operation name, create a databaseDiff which contains the operation.
+	for opName, fieldDiffs := range opNamesToFieldDiffs {
+		// Use the first field diff's field name for logging required recreate error.
+		diff := databaseDiff{FieldName: fieldDiffs[0].FieldName}
+		if opName == "Recreate" {
+			diff.RequiresRecreate = true
+		} else {
+			apiOp, err := convertOpNameToDatabaseApiOperation(opName, fieldDiffs, opts...)
+			if err != nil {
+				return diffs, err
+			}
+			diff.UpdateOp = apiOp
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+func convertOpNameToDatabaseApiOperation(opName string, fieldDiffs []*dcl.FieldDiff, opts ...dcl.ApplyOption) (databaseApiOperation, error) {
+	switch opName {
+
+	case "updateDatabaseUpdateDdlOperation":
+		return &updateDatabaseUpdateDdlOperation{FieldDiffs: fieldDiffs}, nil
+
+	default:
+		return nil, fmt.Errorf("no such operation with name: %v", opName)
+	}
+}
+
+func extractDatabaseFields(r *Database) error {
+	return nil
+}
+
+func postReadExtractDatabaseFields(r *Database) error {
+	return nil
+}
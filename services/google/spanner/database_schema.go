@@ -0,0 +1,172 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package spanner
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLDatabaseSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Spanner/Database",
+			Description: "The Spanner Database resource",
+			StructName:  "Database",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a Database",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "database",
+						Required:    true,
+						Description: "A full instance of a Database",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a Database",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "database",
+						Required:    true,
+						Description: "A full instance of a Database",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a Database",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "database",
+						Required:    true,
+						Description: "A full instance of a Database",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all Database",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "instance",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many Database",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "instance",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"Database": &dcl.Component{
+					Title:           "Database",
+					ID:              "projects/{{project}}/instances/{{instance}}/databases/{{name}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"name",
+							"instance",
+							"project",
+						},
+						Properties: map[string]*dcl.Property{
+							"ddl": &dcl.Property{
+								Type:        "array",
+								GoName:      "Ddl",
+								Description: "An optional list of DDL statements to run inside the newly created database. Statements can create tables, indexes, etc. These statements execute atomically with the creation of the database: if there is an error in any statement, the database is not created. Statements appended to the end of the list are applied to an existing database through an update. The database is never recreated for a change to this list: any other change fails, and the list must then be updated to the schema of the database.",
+								SendEmpty:   true,
+								ListType:    "list",
+								Items: &dcl.Property{
+									Type:   "string",
+									GoType: "string",
+								},
+							},
+							"instance": &dcl.Property{
+								Type:        "string",
+								GoName:      "Instance",
+								Description: "The instance to create the database on.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Spanner/Instance",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"name": &dcl.Property{
+								Type:        "string",
+								GoName:      "Name",
+								Description: "A unique identifier for the database, which cannot be changed after the instance is created. Values are of the form [a-z][-a-z0-9]*[a-z0-9].",
+								Immutable:   true,
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "The project for the resource",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"state": &dcl.Property{
+								Type:        "string",
+								GoName:      "State",
+								GoType:      "DatabaseStateEnum",
+								ReadOnly:    true,
+								Description: "Output only. The current database state. Possible values: CREATING, READY",
+								Immutable:   true,
+								Enum: []string{
+									"CREATING",
+									"READY",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package spanner -var YAML_database blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/spanner/database.yaml
+
+package spanner
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/spanner/database.yaml
+var YAML_database = []byte("info:\n  title: Spanner/Database\n  description: The Spanner Database resource\n  x-dcl-struct-name: Database\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a Database\n    parameters:\n    - name: database\n      required: true\n      description: A full instance of a Database\n  apply:\n    description: The function used to apply information about a Database\n    parameters:\n    - name: database\n      required: true\n      description: A full instance of a Database\n  delete:\n    description: The function used to delete a Database\n    parameters:\n    - name: database\n      required: true\n      description: A full instance of a Database\n  deleteAll:\n    description: The function used to delete all Database\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: instance\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many Database\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: instance\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    Database:\n      title: Database\n      x-dcl-id: projects/{{project}}/instances/{{instance}}/databases/{{name}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - name\n      - instance\n      - project\n      properties:\n        ddl:\n          type: array\n          x-dcl-go-name: Ddl\n          description: 'An optional list of DDL statements to run inside the newly\n            created database. Statements can create tables, indexes, etc. These statements\n            execute atomically with the creation of the database: if there is an error\n            in any statement, the database is not created. Statements appended to\n            the end of the list are applied to an existing database through an update.\n            The database is never recreated for a change to this list: any other change\n            fails, and the list must then be updated to the schema of the database.'\n          x-dcl-send-empty: true\n          x-dcl-list-type: list\n          items:\n            type: string\n            x-dcl-go-type: string\n        instance:\n          type: string\n          x-dcl-go-name: Instance\n          description: The instance to create the database on.\n          x-dcl-references:\n          - resource: Spanner/Instance\n            field: name\n            parent: true\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: A unique identifier for the database, which cannot be changed\n            after the instance is created. Values are of the form [a-z][-a-z0-9]*[a-z0-9].\n          x-kubernetes-immutable: true\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: The project for the resource\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        state:\n          type: string\n          x-dcl-go-name: State\n          x-dcl-go-type: DatabaseStateEnum\n          readOnly: true\n          description: 'Output only. The current database state. Possible values:\n            CREATING, READY'\n          x-kubernetes-immutable: true\n          enum:\n          - CREATING\n          - READY\n")
+
+// 3567 bytes
+// MD5: 5b953c1031f4c89e56bc3bdcb2d41736
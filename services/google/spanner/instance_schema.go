@@ -0,0 +1,161 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package spanner
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLInstanceSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Spanner/Instance",
+			Description: "The Spanner Instance resource",
+			StructName:  "Instance",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "instance",
+						Required:    true,
+						Description: "A full instance of a Instance",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "instance",
+						Required:    true,
+						Description: "A full instance of a Instance",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "instance",
+						Required:    true,
+						Description: "A full instance of a Instance",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"Instance": &dcl.Component{
+					Title:           "Instance",
+					ID:              "projects/{{project}}/instances/{{name}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"name",
+							"config",
+							"displayName",
+							"project",
+						},
+						Properties: map[string]*dcl.Property{
+							"config": &dcl.Property{
+								Type:        "string",
+								GoName:      "Config",
+								Description: "The name of the instance's configuration (similar but not quite the same as a region) which defines the geographic placement and replication of your databases in this instance. It determines where your data is stored. Values are typically of the form `regional-europe-west1` , `us-central` etc. In order to obtain a valid list please consult the [Configuration section of the docs](https://cloud.google.com/spanner/docs/instances).",
+								Immutable:   true,
+							},
+							"displayName": &dcl.Property{
+								Type:        "string",
+								GoName:      "DisplayName",
+								Description: "The descriptive name for this instance as it appears in UIs. Must be unique per project and between 4 and 30 characters in length.",
+							},
+							"labels": &dcl.Property{
+								Type: "object",
+								AdditionalProperties: &dcl.Property{
+									Type: "string",
+								},
+								GoName:      "Labels",
+								Description: "An object containing a list of \"key\": value pairs. Example: { \"name\": \"wrench\", \"mass\": \"1.3kg\", \"count\": \"3\" }.",
+							},
+							"name": &dcl.Property{
+								Type:        "string",
+								GoName:      "Name",
+								Description: "A unique identifier for the instance, which cannot be changed after the instance is created. The name must be between 6 and 30 characters in length.",
+								Immutable:   true,
+							},
+							"nodeCount": &dcl.Property{
+								Type:        "integer",
+								Format:      "int64",
+								GoName:      "NodeCount",
+								Description: "The number of nodes allocated to this instance.",
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "The project for the resource",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"state": &dcl.Property{
+								Type:        "string",
+								GoName:      "State",
+								GoType:      "InstanceStateEnum",
+								ReadOnly:    true,
+								Description: "Output only. The current instance state. Possible values: CREATING, READY",
+								Immutable:   true,
+								Enum: []string{
+									"CREATING",
+									"READY",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
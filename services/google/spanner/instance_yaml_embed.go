@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package spanner -var YAML_instance blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/spanner/instance.yaml
+
+package spanner
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/spanner/instance.yaml
+var YAML_instance = []byte("info:\n  title: Spanner/Instance\n  description: The Spanner Instance resource\n  x-dcl-struct-name: Instance\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a Instance\n    parameters:\n    - name: instance\n      required: true\n      description: A full instance of a Instance\n  apply:\n    description: The function used to apply information about a Instance\n    parameters:\n    - name: instance\n      required: true\n      description: A full instance of a Instance\n  delete:\n    description: The function used to delete a Instance\n    parameters:\n    - name: instance\n      required: true\n      description: A full instance of a Instance\n  deleteAll:\n    description: The function used to delete all Instance\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many Instance\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    Instance:\n      title: Instance\n      x-dcl-id: projects/{{project}}/instances/{{name}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - name\n      - config\n      - displayName\n      - project\n      properties:\n        config:\n          type: string\n          x-dcl-go-name: Config\n          description: The name of the instance's configuration (similar but not quite\n            the same as a region) which defines the geographic placement and replication\n            of your databases in this instance. It determines where your data is stored.\n            Values are typically of the form `regional-europe-west1` , `us-central`\n            etc. In order to obtain a valid list please consult the [Configuration\n            section of the docs](https://cloud.google.com/spanner/docs/instances).\n          x-kubernetes-immutable: true\n        displayName:\n          type: string\n          x-dcl-go-name: DisplayName\n          description: The descriptive name for this instance as it appears in UIs.\n            Must be unique per project and between 4 and 30 characters in length.\n        labels:\n          type: object\n          additionalProperties:\n            type: string\n          x-dcl-go-name: Labels\n          description: 'An object containing a list of \"key\": value pairs. Example:\n            { \"name\": \"wrench\", \"mass\": \"1.3kg\", \"count\": \"3\" }.'\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: A unique identifier for the instance, which cannot be changed\n            after the instance is created. The name must be between 6 and 30 characters\n            in length.\n          x-kubernetes-immutable: true\n        nodeCount:\n          type: integer\n          format: int64\n          x-dcl-go-name: NodeCount\n          description: The number of nodes allocated to this instance.\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: The project for the resource\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        state:\n          type: string\n          x-dcl-go-name: State\n          x-dcl-go-type: InstanceStateEnum\n          readOnly: true\n          description: 'Output only. The current instance state. Possible values:\n            CREATING, READY'\n          x-kubernetes-immutable: true\n          enum:\n          - CREATING\n          - READY\n")
+
+// 3689 bytes
+// MD5: 4f382a67688212b7d88d0a04a32bffff
@@ -0,0 +1,358 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package spanner contains handwritten support code for the spanner service.
+package spanner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl/operations"
+)
+
+// waitForOperation waits on the long-running operation returned in resp and
+// returns its response, if any.
+func waitForOperation(ctx context.Context, c *Client, resp *dcl.RetryDetails, basePath string) (map[string]interface{}, error) {
+	var o operations.StandardGCPOperation
+	if err := dcl.ParseResponse(resp.Response, &o); err != nil {
+		return nil, err
+	}
+	if err := o.Wait(context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), c.Config, basePath, "GET"); err != nil {
+		return nil, err
+	}
+	r, _ := o.FirstResponse()
+	return r, nil
+}
+
+// do creates the instance. The create request wraps the instance alongside
+// its ID rather than sending the resource as the request body.
+func (op *createInstanceOperation) do(ctx context.Context, r *Instance, c *Client) error {
+	c.Config.Logger.InfoWithContextf(ctx, "Attempting to create %v", r)
+	u, err := r.createURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	m, err := expandInstance(c, r)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"instanceId": dcl.ValueOrEmptyString(dcl.SelfLinkToName(r.Name)),
+		"instance":   m,
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "POST", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+	op.response, err = waitForOperation(ctx, c, resp, r.basePath())
+	if err != nil {
+		c.Config.Logger.Warningf("Creation failed after waiting for operation: %v", err)
+		return err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Successfully waited for operation")
+
+	if _, err := c.GetInstance(ctx, r); err != nil {
+		c.Config.Logger.WarningWithContextf(ctx, "get returned error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// do updates the instance. Like create, the update request wraps the
+// instance, together with a mask of the fields being changed.
+func (op *updateInstanceUpdateInstanceOperation) do(ctx context.Context, r *Instance, c *Client) error {
+	_, err := c.GetInstance(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	u, err := r.updateURL(c.Config.BasePath, "UpdateInstance")
+	if err != nil {
+		return err
+	}
+
+	req, err := newUpdateInstanceUpdateInstanceRequest(ctx, r, c)
+	if err != nil {
+		return err
+	}
+	req["name"] = fmt.Sprintf("projects/%s/instances/%s", dcl.ValueOrEmptyString(dcl.SelfLinkToName(r.Project)), dcl.ValueOrEmptyString(dcl.SelfLinkToName(r.Name)))
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created update: %#v", req)
+	body, err := marshalUpdateInstanceUpdateInstanceRequest(c, map[string]interface{}{
+		"instance":  req,
+		"fieldMask": dcl.TopLevelUpdateMask(op.FieldDiffs),
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "PATCH", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+	_, err = waitForOperation(ctx, c, resp, r.basePath())
+	return err
+}
+
+// do creates the database, applying its DDL statements as part of the same
+// request so that the database is only created if they all succeed.
+func (op *createDatabaseOperation) do(ctx context.Context, r *Database, c *Client) error {
+	c.Config.Logger.InfoWithContextf(ctx, "Attempting to create %v", r)
+	u, err := r.createURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	req := map[string]interface{}{
+		"createStatement": fmt.Sprintf("CREATE DATABASE `%s`", dcl.ValueOrEmptyString(dcl.SelfLinkToName(r.Name))),
+	}
+	if len(r.Ddl) > 0 {
+		req["extraStatements"] = r.Ddl
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "POST", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+	op.response, err = waitForOperation(ctx, c, resp, r.basePath())
+	if err != nil {
+		c.Config.Logger.Warningf("Creation failed after waiting for operation: %v", err)
+		return err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Successfully waited for operation")
+
+	if _, err := c.GetDatabase(ctx, r); err != nil {
+		c.Config.Logger.WarningWithContextf(ctx, "get returned error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// do applies the statements in the desired DDL which follow those already
+// applied to the database. The DDL the API returns for a database is its
+// resulting schema, so the desired DDL must begin with the statements of that
+// schema; if it does not, do returns an error rather than changing the
+// database.
+func (op *updateDatabaseUpdateDdlOperation) do(ctx context.Context, r *Database, c *Client) error {
+	actual, err := c.GetDatabase(ctx, r)
+	if err != nil {
+		return err
+	}
+	if !ddlHasPrefix(r.Ddl, actual.Ddl) {
+		return fmt.Errorf("cannot update DDL of Database %q: the statements of its schema %q do not begin the desired statements %q; only statements appended to the end can be applied, and the database is not recreated", dcl.ValueOrEmptyString(r.Name), actual.Ddl, r.Ddl)
+	}
+	statements := r.Ddl[len(actual.Ddl):]
+	if len(statements) == 0 {
+		return nil
+	}
+
+	u, err := r.updateURL(c.Config.BasePath, "UpdateDdl")
+	if err != nil {
+		return err
+	}
+	req := map[string]interface{}{"statements": statements}
+	c.Config.Logger.InfoWithContextf(ctx, "Created update: %#v", req)
+	body, err := marshalUpdateDatabaseUpdateDdlRequest(c, req)
+	if err != nil {
+		return err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "PATCH", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+	_, err = waitForOperation(ctx, c, resp, r.basePath())
+	return err
+}
+
+// getDatabaseRaw reads the database along with its DDL, which the API serves
+// separately from the database itself.
+func (c *Client) getDatabaseRaw(ctx context.Context, r *Database) ([]byte, error) {
+	u, err := r.getURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	b, err := ioutil.ReadAll(resp.Response.Body)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	ddlResp, err := dcl.SendRequest(ctx, c.Config, "GET", u+"/ddl", &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	ddl, err := dcl.ResponseBodyAsJSON(ddlResp)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding DDL response body into JSON: %w", err)
+	}
+	m["ddl"] = ddl["statements"]
+	return json.Marshal(m)
+}
+
+// ddlKeywords are the keywords and type names of Spanner DDL, which the API may
+// return in a different case than they were written. Identifiers are returned as
+// written.
+var ddlKeywords = map[string]bool{}
+
+func init() {
+	for _, k := range strings.Fields(`
+		ACTION ADD ALL ALTER AND ANY ARRAY AS ASC BETWEEN BY CASCADE CASE CAST CHANGE
+		CHECK COLUMN CONSTRAINT CREATE CROSS DATABASE DEFAULT DELETE DELETION DESC
+		DISTINCT DROP ELSE END ENFORCED EXISTS FALSE FOR FOREIGN FROM FULL GRANT GROUP
+		HAVING IF IN INDEX INNER INTERLEAVE INVOKER IS JOIN KEY LEFT LIKE LIMIT MAX
+		NO NOT NULL NULL_FILTERED OF ON OPTIONS OR ORDER OUTER PARENT POLICY PRIMARY
+		REFERENCES REVOKE RIGHT ROLE ROW SECURITY SELECT SET SQL STORED STORING STREAM
+		STRUCT TABLE THEN TO TRUE UNIQUE UNNEST USING VIEW WHEN WHERE WITH
+		BOOL BYTES DATE FLOAT32 FLOAT64 INT64 JSON NUMERIC STRING TIMESTAMP
+	`) {
+		ddlKeywords[k] = true
+	}
+}
+
+// ddlTokens splits a DDL statement into its tokens: quoted literals and
+// identifiers, words, and single characters of punctuation. Whitespace is
+// dropped.
+func ddlTokens(s string) []string {
+	var tokens []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		j := i + 1
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i = j
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			quote := s[i : i+1]
+			if strings.HasPrefix(s[i:], strings.Repeat(quote, 3)) {
+				quote = strings.Repeat(quote, 3)
+			}
+			j = i + len(quote)
+			for j < len(s) && !strings.HasPrefix(s[j:], quote) {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			j += len(quote)
+			if j > len(s) {
+				j = len(s)
+			}
+		case isDdlWordByte(c):
+			for j < len(s) && isDdlWordByte(s[j]) {
+				j++
+			}
+		}
+		tokens = append(tokens, s[i:j])
+		i = j
+	}
+	return tokens
+}
+
+// isDdlPunctuation returns whether the token t is written without whitespace
+// around it in a normalized statement.
+func isDdlPunctuation(t string) bool {
+	return len(t) == 1 && strings.Contains("(),=<>", t)
+}
+
+func isDdlWordByte(c byte) bool {
+	return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// normalizeDdlStatement reduces a DDL statement to a form which ignores the
+// formatting differences between statements as written and as returned by
+// the API: the case of keywords, whitespace, and a trailing comma in a list.
+// Identifiers and literals are kept as they are.
+func normalizeDdlStatement(s string) string {
+	tokens := ddlTokens(strings.TrimSuffix(strings.TrimSpace(s), ";"))
+	var b strings.Builder
+	prev := ""
+	for i, t := range tokens {
+		if t == "," && i+1 < len(tokens) && tokens[i+1] == ")" {
+			continue
+		}
+		if u := strings.ToUpper(t); ddlKeywords[u] {
+			t = u
+		}
+		if prev != "" && !isDdlPunctuation(prev) && !isDdlPunctuation(t) {
+			b.WriteByte(' ')
+		}
+		b.WriteString(t)
+		prev = t
+	}
+	return b.String()
+}
+
+// ddlHasPrefix returns whether the statements in prefix begin the statements
+// in ddl.
+func ddlHasPrefix(ddl, prefix []string) bool {
+	if len(prefix) > len(ddl) {
+		return false
+	}
+	for i, s := range prefix {
+		if normalizeDdlStatement(s) != normalizeDdlStatement(ddl[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func databaseDdlEqual(d, a interface{}) bool {
+	desired, _ := d.([]string)
+	actual, _ := a.([]string)
+	return len(desired) == len(actual) && ddlHasPrefix(desired, actual)
+}
+
+// canonicalizeDatabaseDdl keeps the desired DDL statements if the API returned
+// equivalent ones.
+func canonicalizeDatabaseDdl(des []string, nw *Database) []string {
+	if len(des) == len(nw.Ddl) && ddlHasPrefix(des, nw.Ddl) {
+		return des
+	}
+	return nw.Ddl
+}
+
+// databaseDdlOperations applies statements added to the end of the DDL to the
+// existing database. The database is never recreated for a change to its DDL:
+// any other change fails when the update compares the DDL to the database's.
+func databaseDdlOperations() func(fd *dcl.FieldDiff) []string {
+	return func(fd *dcl.FieldDiff) []string {
+		desired, _ := fd.Desired.([]string)
+		actual, _ := fd.Actual.([]string)
+		if !ddlHasPrefix(desired, actual) {
+			fd.Message = "DDL statements were changed or removed; only statements appended to the end can be applied to an existing database"
+		}
+		return []string{"updateDatabaseUpdateDdlOperation"}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package spanner
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNormalizeDdlStatement(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  string
+		equal bool
+	}{
+		{
+			name:  "keyword case",
+			a:     "create table Singers (SingerId int64 not null) primary key (SingerId)",
+			b:     "CREATE TABLE Singers (SingerId INT64 NOT NULL) PRIMARY KEY (SingerId)",
+			equal: true,
+		},
+		{
+			name:  "whitespace",
+			a:     "CREATE TABLE Singers (\n  SingerId INT64 NOT NULL,\n  Name STRING(MAX),\n) PRIMARY KEY(SingerId);",
+			b:     "CREATE TABLE Singers ( SingerId INT64 NOT NULL, Name STRING ( MAX ) ) PRIMARY KEY (SingerId)",
+			equal: true,
+		},
+		{
+			name: "identifier case",
+			a:    "CREATE TABLE singers (SingerId INT64) PRIMARY KEY (SingerId)",
+			b:    "CREATE TABLE Singers (SingerId INT64) PRIMARY KEY (SingerId)",
+		},
+		{
+			name: "quoted identifier",
+			a:    "CREATE TABLE `order` (Id INT64) PRIMARY KEY (Id)",
+			b:    "CREATE TABLE `ORDER` (Id INT64) PRIMARY KEY (Id)",
+		},
+		{
+			name: "string literal case",
+			a:    "ALTER TABLE Singers ADD COLUMN Country STRING(MAX) DEFAULT ('us')",
+			b:    "ALTER TABLE Singers ADD COLUMN Country STRING(MAX) DEFAULT ('US')",
+		},
+		{
+			name: "string literal whitespace",
+			a:    "ALTER TABLE Singers ADD COLUMN Greeting STRING(MAX) DEFAULT ('hello  world')",
+			b:    "ALTER TABLE Singers ADD COLUMN Greeting STRING(MAX) DEFAULT ('hello world')",
+		},
+		{
+			name:  "keywords around a literal",
+			a:     "alter table Singers add column Greeting string(max) default ('a (b) c')",
+			b:     "ALTER TABLE Singers ADD COLUMN Greeting STRING(MAX) DEFAULT ( 'a (b) c' )",
+			equal: true,
+		},
+		{
+			name: "column name",
+			a:    "CREATE INDEX SingersByName ON Singers(FirstName)",
+			b:    "CREATE INDEX SingersByName ON Singers(LastName)",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b := normalizeDdlStatement(tc.a), normalizeDdlStatement(tc.b)
+			if (a == b) != tc.equal {
+				t.Errorf("normalizeDdlStatement(%q) = %q, normalizeDdlStatement(%q) = %q, want equal %v", tc.a, a, tc.b, b, tc.equal)
+			}
+		})
+	}
+}
+
+func TestDdlHasPrefix(t *testing.T) {
+	const (
+		createSingers = "CREATE TABLE Singers (SingerId INT64 NOT NULL) PRIMARY KEY (SingerId)"
+		createAlbums  = "CREATE TABLE Albums (AlbumId INT64 NOT NULL) PRIMARY KEY (AlbumId)"
+		createIndex   = "CREATE INDEX AlbumsById ON Albums(AlbumId)"
+	)
+	tests := []struct {
+		name   string
+		ddl    []string
+		prefix []string
+		want   bool
+	}{
+		{
+			name: "empty prefix",
+			ddl:  []string{createSingers},
+			want: true,
+		},
+		{
+			name:   "same statements",
+			ddl:    []string{createSingers, createAlbums},
+			prefix: []string{createSingers, createAlbums},
+			want:   true,
+		},
+		{
+			name:   "appended statements",
+			ddl:    []string{createSingers, createAlbums, createIndex},
+			prefix: []string{createSingers},
+			want:   true,
+		},
+		{
+			name:   "formatted differently",
+			ddl:    []string{"create table Singers (\n  SingerId int64 not null,\n) primary key (SingerId);", createAlbums},
+			prefix: []string{createSingers},
+			want:   true,
+		},
+		{
+			name:   "removed statement",
+			ddl:    []string{createAlbums},
+			prefix: []string{createSingers, createAlbums},
+		},
+		{
+			name:   "reordered statements",
+			ddl:    []string{createAlbums, createSingers},
+			prefix: []string{createSingers, createAlbums},
+		},
+		{
+			name:   "changed statement",
+			ddl:    []string{"CREATE TABLE Singers (SingerId STRING(36) NOT NULL) PRIMARY KEY (SingerId)", createAlbums},
+			prefix: []string{createSingers},
+		},
+		{
+			name:   "renamed table",
+			ddl:    []string{"CREATE TABLE singers (SingerId INT64 NOT NULL) PRIMARY KEY (SingerId)"},
+			prefix: []string{createSingers},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ddlHasPrefix(tc.ddl, tc.prefix); got != tc.want {
+				t.Errorf("ddlHasPrefix(%q, %q) = %v, want %v", tc.ddl, tc.prefix, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDatabaseDdlOperations(t *testing.T) {
+	tests := []struct {
+		name        string
+		desired     []string
+		actual      []string
+		wantMessage bool
+	}{
+		{
+			name:    "appended statement",
+			desired: []string{"CREATE TABLE A (Id INT64) PRIMARY KEY (Id)", "CREATE TABLE B (Id INT64) PRIMARY KEY (Id)"},
+			actual:  []string{"CREATE TABLE A (Id INT64) PRIMARY KEY (Id)"},
+		},
+		{
+			name:        "changed statement",
+			desired:     []string{"CREATE TABLE A (Id STRING(36)) PRIMARY KEY (Id)"},
+			actual:      []string{"CREATE TABLE A (Id INT64) PRIMARY KEY (Id)"},
+			wantMessage: true,
+		},
+		{
+			name:        "removed statement",
+			desired:     []string{"CREATE TABLE A (Id INT64) PRIMARY KEY (Id)"},
+			actual:      []string{"CREATE TABLE A (Id INT64) PRIMARY KEY (Id)", "CREATE TABLE B (Id INT64) PRIMARY KEY (Id)"},
+			wantMessage: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fd := &dcl.FieldDiff{FieldName: "Ddl", Desired: tc.desired, Actual: tc.actual}
+			// The database is never recreated for a change to its DDL.
+			if diff := cmp.Diff([]string{"updateDatabaseUpdateDdlOperation"}, databaseDdlOperations()(fd)); diff != "" {
+				t.Errorf("databaseDdlOperations() diff (-want +got):\n%s", diff)
+			}
+			if got := fd.Message != ""; got != tc.wantMessage {
+				t.Errorf("databaseDdlOperations() set message %q, want message %v", fd.Message, tc.wantMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package beta -var YAML_database blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/sql/beta/database.yaml
+
+package beta
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/sql/beta/database.yaml
+var YAML_database = []byte("info:\n  title: Sql/Database\n  description: The Sql Database resource\n  x-dcl-struct-name: Database\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a Database\n    parameters:\n    - name: database\n      required: true\n      description: A full instance of a Database\n  apply:\n    description: The function used to apply information about a Database\n    parameters:\n    - name: database\n      required: true\n      description: A full instance of a Database\n  delete:\n    description: The function used to delete a Database\n    parameters:\n    - name: database\n      required: true\n      description: A full instance of a Database\n  deleteAll:\n    description: The function used to delete all Database\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: instance\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many Database\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: instance\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    Database:\n      title: Database\n      x-dcl-id: projects/{{project}}/instances/{{instance}}/databases/{{name}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - instance\n      - name\n      - project\n      properties:\n        charset:\n          type: string\n          x-dcl-go-name: Charset\n          description: The charset value. See MySQL's [Supported Character Sets and\n            Collations](https://dev.mysql.com/doc/refman/5.7/en/charset-charsets.html)\n            and Postgres' [Character Set Support](https://www.postgresql.org/docs/9.6/static/multibyte.html)\n            for more details and supported values. Postgres databases only support\n            a value of `UTF8` at creation time.\n        collation:\n          type: string\n          x-dcl-go-name: Collation\n          description: The collation value. See MySQL's [Supported Character Sets\n            and Collations](https://dev.mysql.com/doc/refman/5.7/en/charset-charsets.html)\n            and Postgres' [Collation Support](https://www.postgresql.org/docs/9.6/static/collation.html)\n            for more details and supported values. Postgres databases only support\n            a value of `en_US.UTF8` at creation time.\n        instance:\n          type: string\n          x-dcl-go-name: Instance\n          description: The name of the Cloud SQL instance. This does not include the\n            project ID.\n          x-dcl-references:\n          - resource: Sql/Instance\n            field: name\n            parent: true\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: The name of the database in the Cloud SQL instance. This does\n            not include the project ID or instance name.\n          x-kubernetes-immutable: true\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: The project for the resource\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        selfLink:\n          type: string\n          x-dcl-go-name: SelfLink\n          readOnly: true\n          description: Output only. The URI of this resource.\n          x-kubernetes-immutable: true\n")
+
+// 3594 bytes
+// MD5: 957f4a90b9b89341640920568e8d77fc
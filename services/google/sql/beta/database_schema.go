@@ -0,0 +1,166 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package beta
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLDatabaseSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Sql/Database",
+			Description: "The Sql Database resource",
+			StructName:  "Database",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a Database",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "database",
+						Required:    true,
+						Description: "A full instance of a Database",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a Database",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "database",
+						Required:    true,
+						Description: "A full instance of a Database",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a Database",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "database",
+						Required:    true,
+						Description: "A full instance of a Database",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all Database",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "instance",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many Database",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "instance",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"Database": &dcl.Component{
+					Title:           "Database",
+					ID:              "projects/{{project}}/instances/{{instance}}/databases/{{name}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"instance",
+							"name",
+							"project",
+						},
+						Properties: map[string]*dcl.Property{
+							"charset": &dcl.Property{
+								Type:        "string",
+								GoName:      "Charset",
+								Description: "The charset value. See MySQL's [Supported Character Sets and Collations](https://dev.mysql.com/doc/refman/5.7/en/charset-charsets.html) and Postgres' [Character Set Support](https://www.postgresql.org/docs/9.6/static/multibyte.html) for more details and supported values. Postgres databases only support a value of `UTF8` at creation time.",
+							},
+							"collation": &dcl.Property{
+								Type:        "string",
+								GoName:      "Collation",
+								Description: "The collation value. See MySQL's [Supported Character Sets and Collations](https://dev.mysql.com/doc/refman/5.7/en/charset-charsets.html) and Postgres' [Collation Support](https://www.postgresql.org/docs/9.6/static/collation.html) for more details and supported values. Postgres databases only support a value of `en_US.UTF8` at creation time.",
+							},
+							"instance": &dcl.Property{
+								Type:        "string",
+								GoName:      "Instance",
+								Description: "The name of the Cloud SQL instance. This does not include the project ID.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Sql/Instance",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"name": &dcl.Property{
+								Type:        "string",
+								GoName:      "Name",
+								Description: "The name of the database in the Cloud SQL instance. This does not include the project ID or instance name.",
+								Immutable:   true,
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "The project for the resource",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"selfLink": &dcl.Property{
+								Type:        "string",
+								GoName:      "SelfLink",
+								ReadOnly:    true,
+								Description: "Output only. The URI of this resource.",
+								Immutable:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
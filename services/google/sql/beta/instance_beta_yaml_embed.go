@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package beta -var YAML_instance blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/sql/beta/instance.yaml
+
+package beta
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/sql/beta/instance.yaml
+var YAML_instance = []byte("info:\n  title: Sql/Instance\n  description: The Sql Instance resource\n  x-dcl-struct-name: Instance\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a Instance\n    parameters:\n    - name: instance\n      required: true\n      description: A full instance of a Instance\n  apply:\n    description: The function used to apply information about a Instance\n    parameters:\n    - name: instance\n      required: true\n      description: A full instance of a Instance\n  delete:\n    description: The function used to delete a Instance\n    parameters:\n    - name: instance\n      required: true\n      description: A full instance of a Instance\n  deleteAll:\n    description: The function used to delete all Instance\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many Instance\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    Instance:\n      title: Instance\n      x-dcl-id: projects/{{project}}/instances/{{name}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - name\n      - project\n      properties:\n        backendType:\n          type: string\n          x-dcl-go-name: BackendType\n          x-dcl-go-type: InstanceBackendTypeEnum\n          description: 'The backend type. `SECOND_GEN`: Cloud SQL database instance.\n            `EXTERNAL`: A database server that is not managed by Google. This property\n            is read-only; use the `tier` property in the `settings` object to determine\n            the database type. Possible values: SQL_BACKEND_TYPE_UNSPECIFIED, FIRST_GEN,\n            SECOND_GEN, EXTERNAL'\n          x-dcl-server-default: true\n          enum:\n          - SQL_BACKEND_TYPE_UNSPECIFIED\n          - FIRST_GEN\n          - SECOND_GEN\n          - EXTERNAL\n        connectionName:\n          type: string\n          x-dcl-go-name: ConnectionName\n          readOnly: true\n          description: Output only. Connection name of the Cloud SQL instance used\n            in connection strings.\n          x-kubernetes-immutable: true\n        currentDiskSize:\n          type: object\n          x-dcl-go-name: CurrentDiskSize\n          x-dcl-go-type: InstanceCurrentDiskSize\n          readOnly: true\n          description: Output only. The current disk usage of the instance in bytes.\n            This property has been deprecated. Use the \"cloudsql.googleapis.com/database/disk/bytes_used\"\n            metric in Cloud Monitoring API instead.\n          x-kubernetes-immutable: true\n          properties:\n            value:\n              type: integer\n              format: int64\n              x-dcl-go-name: Value\n              readOnly: true\n              description: The value of the field.\n              x-kubernetes-immutable: true\n        databaseVersion:\n          type: string\n          x-dcl-go-name: DatabaseVersion\n          x-dcl-go-type: InstanceDatabaseVersionEnum\n          description: 'The database engine type and version. The `databaseVersion`\n            field cannot be changed after instance creation. Possible values: SQL_DATABASE_VERSION_UNSPECIFIED,\n            MYSQL_5_6, MYSQL_5_7, MYSQL_8_0, SQLSERVER_2017_STANDARD, SQLSERVER_2017_ENTERPRISE,\n            SQLSERVER_2017_EXPRESS, SQLSERVER_2017_WEB, POSTGRES_9_6, POSTGRES_10,\n            POSTGRES_11, POSTGRES_12'\n          x-kubernetes-immutable: true\n          enum:\n          - SQL_DATABASE_VERSION_UNSPECIFIED\n          - MYSQL_5_6\n          - MYSQL_5_7\n          - MYSQL_8_0\n          - SQLSERVER_2017_STANDARD\n          - SQLSERVER_2017_ENTERPRISE\n          - SQLSERVER_2017_EXPRESS\n          - SQLSERVER_2017_WEB\n          - POSTGRES_9_6\n          - POSTGRES_10\n          - POSTGRES_11\n          - POSTGRES_12\n        diskEncryptionConfiguration:\n          type: object\n          x-dcl-go-name: DiskEncryptionConfiguration\n          x-dcl-go-type: InstanceDiskEncryptionConfiguration\n          description: Disk encryption configuration specific to an instance. Applies\n            only to Second Generation instances.\n          x-kubernetes-immutable: true\n          properties:\n            kind:\n              type: string\n              x-dcl-go-name: Kind\n              description: This is always `sql#diskEncryptionConfiguration`.\n              x-kubernetes-immutable: true\n            kmsKeyName:\n              type: string\n              x-dcl-go-name: KmsKeyName\n              description: Resource name of KMS key for disk encryption\n              x-kubernetes-immutable: true\n        diskEncryptionStatus:\n          type: object\n          x-dcl-go-name: DiskEncryptionStatus\n          x-dcl-go-type: InstanceDiskEncryptionStatus\n          readOnly: true\n          description: Output only. Disk encryption status specific to an instance.\n            Applies only to Second Generation instances.\n          x-kubernetes-immutable: true\n          properties:\n            kind:\n              type: string\n              x-dcl-go-name: Kind\n              readOnly: true\n              description: This is always `sql#diskEncryptionStatus`.\n              x-kubernetes-immutable: true\n            kmsKeyVersionName:\n              type: string\n              x-dcl-go-name: KmsKeyVersionName\n              readOnly: true\n              description: KMS key version used to encrypt the Cloud SQL instance\n                resource\n              x-kubernetes-immutable: true\n        etag:\n          type: string\n          x-dcl-go-name: Etag\n          readOnly: true\n          description: Output only. This field is deprecated and will be removed from\n            a future version of the API. Use the `settings.settingsVersion` field\n            instead.\n          x-kubernetes-immutable: true\n        failoverReplica:\n          type: object\n          x-dcl-go-name: FailoverReplica\n          x-dcl-go-type: InstanceFailoverReplica\n          description: The name and status of the failover replica. This property\n            is applicable only to Second Generation instances.\n          properties:\n            available:\n              type: boolean\n              x-dcl-go-name: Available\n              readOnly: true\n              description: Output only. The availability status of the failover replica.\n                A false status indicates that the failover replica is out of sync.\n                The primary instance can only failover to the failover replica when\n                the status is true.\n              x-kubernetes-immutable: true\n            failoverInstance:\n              type: object\n              x-dcl-go-name: FailoverInstance\n              x-dcl-go-type: InstanceFailoverReplicaFailoverInstance\n              description: A reference to the failover replica. If specified at instance\n                creation, a failover replica is created for the instance. Currently,\n                the failover replica can only be created in the same region as the\n                primary instance.\n              properties:\n                name:\n                  type: string\n                  x-dcl-go-name: Name\n                  description: The name of the failover replica instance. This does\n                    not include the project ID.\n                region:\n                  type: string\n                  x-dcl-go-name: Region\n                  description: The region of the failover replica instance.\n            name:\n              type: string\n              x-dcl-go-name: Name\n              description: The name of the failover replica. If specified at instance\n                creation, a failover replica is created for the instance. The name\n                doesn't include the project ID. This property is applicable only to\n                Second Generation instances.\n        gceZone:\n          type: string\n          x-dcl-go-name: GceZone\n          readOnly: true\n          description: Output only. The Compute Engine zone that the instance is currently\n            serving from. This value could be different from the zone that was specified\n            when the instance was created if the instance has failed over to its secondary\n            zone.\n          x-kubernetes-immutable: true\n        instanceType:\n          type: string\n          x-dcl-go-name: InstanceType\n          x-dcl-go-type: InstanceInstanceTypeEnum\n          description: 'The instance type. This can be one of the following: `CLOUD_SQL_INSTANCE`:\n            A Cloud SQL instance that is not replicating from a primary instance.\n            `ON_PREMISES_INSTANCE`: An instance running on the customer''s premises.\n            `READ_REPLICA_INSTANCE`: A Cloud SQL instance configured as a read-replica.\n            Possible values: SQL_INSTANCE_TYPE_UNSPECIFIED, CLOUD_SQL_INSTANCE, ON_PREMISES_INSTANCE,\n            READ_REPLICA_INSTANCE, READ_REPLICA_POOL_INSTANCE'\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n          enum:\n          - SQL_INSTANCE_TYPE_UNSPECIFIED\n          - CLOUD_SQL_INSTANCE\n          - ON_PREMISES_INSTANCE\n          - READ_REPLICA_INSTANCE\n          - READ_REPLICA_POOL_INSTANCE\n        instanceUid:\n          type: string\n          x-dcl-go-name: InstanceUid\n          readOnly: true\n          description: Output only. The unique identifier of the instance.\n          x-kubernetes-immutable: true\n        ipAddresses:\n          type: array\n          x-dcl-go-name: IPAddresses\n          readOnly: true\n          description: Output only. The assigned IP addresses for the instance.\n          x-kubernetes-immutable: true\n          x-dcl-send-empty: true\n          x-dcl-list-type: list\n          items:\n            type: object\n            x-dcl-go-type: InstanceIPAddresses\n            properties:\n              ipAddress:\n                type: string\n                x-dcl-go-name: IPAddress\n                readOnly: true\n                description: The IP address assigned.\n                x-kubernetes-immutable: true\n              timeToRetire:\n                type: object\n                x-dcl-go-name: TimeToRetire\n                x-dcl-go-type: InstanceIPAddressesTimeToRetire\n                readOnly: true\n                description: The due time for this IP to be retired. This field is\n                  only available when the IP is scheduled to be retired.\n                x-kubernetes-immutable: true\n                properties:\n                  nanos:\n                    type: integer\n                    format: int64\n                    x-dcl-go-name: Nanos\n                    readOnly: true\n                    description: Non-negative fractions of a second at nanosecond\n                      resolution. Negative second values with fractions must still\n                      have non-negative nanos values that count forward in time. Must\n                      be from 0 to 999,999,999 inclusive.\n                    x-kubernetes-immutable: true\n                  seconds:\n                    type: integer\n                    format: int64\n                    x-dcl-go-name: Seconds\n                    readOnly: true\n                    description: Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z.\n                      Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.\n                    x-kubernetes-immutable: true\n              type:\n                type: string\n                x-dcl-go-name: Type\n                x-dcl-go-type: InstanceIPAddressesTypeEnum\n                readOnly: true\n                description: 'The type of this IP address. A `PRIMARY` address is\n                  a public address that can accept incoming connections. A `PRIVATE`\n                  address is a private address that can accept incoming connections.\n                  An `OUTGOING` address is the source address of connections originating\n                  from the instance, if supported. Possible values: SQL_IP_ADDRESS_TYPE_UNSPECIFIED,\n                  PRIMARY, OUTGOING, PRIVATE, MIGRATED_1ST_GEN'\n                x-kubernetes-immutable: true\n                enum:\n                - SQL_IP_ADDRESS_TYPE_UNSPECIFIED\n                - PRIMARY\n                - OUTGOING\n                - PRIVATE\n                - MIGRATED_1ST_GEN\n        ipv6Address:\n          type: string\n          x-dcl-go-name: IPv6Address\n          readOnly: true\n          description: Output only. The IPv6 address assigned to the instance. (Deprecated)\n            This property was applicable only to First Generation instances.\n          x-kubernetes-immutable: true\n        masterInstance:\n          type: object\n          x-dcl-go-name: MasterInstance\n          x-dcl-go-type: InstanceMasterInstance\n          description: The instance which will act as primary in the replication setup.\n          x-kubernetes-immutable: true\n          properties:\n            name:\n              type: string\n              x-dcl-go-name: Name\n              description: The name of the primary instance. This does not include\n                the project ID.\n              x-kubernetes-immutable: true\n            region:\n              type: string\n              x-dcl-go-name: Region\n              description: The region of the primary instance.\n              x-kubernetes-immutable: true\n        masterInstanceName:\n          type: string\n          x-dcl-go-name: MasterInstanceName\n          description: The name of the instance which will act as primary in the replication\n            setup.\n          x-kubernetes-immutable: true\n        maxDiskSize:\n          type: object\n          x-dcl-go-name: MaxDiskSize\n          x-dcl-go-type: InstanceMaxDiskSize\n          description: The maximum disk size of the instance in bytes.\n          properties:\n            value:\n              type: integer\n              format: int64\n              x-dcl-go-name: Value\n              description: The value of the field.\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: Name of the Cloud SQL instance. This does not include the project\n            ID.\n          x-kubernetes-immutable: true\n        onPremisesConfiguration:\n          type: object\n          x-dcl-go-name: OnPremisesConfiguration\n          x-dcl-go-type: InstanceOnPremisesConfiguration\n          description: Configuration specific to on-premises instances.\n          properties:\n            caCertificate:\n              type: string\n              x-dcl-go-name: CaCertificate\n              description: PEM representation of the trusted CA's x509 certificate.\n            clientCertificate:\n              type: string\n              x-dcl-go-name: ClientCertificate\n              description: PEM representation of the replica's x509 certificate.\n            clientKey:\n              type: string\n              x-dcl-go-name: ClientKey\n              description: PEM representation of the replica's private key. The corresponding\n                public key is encoded in the client's certificate.\n              x-dcl-sensitive: true\n              x-dcl-mutable-unreadable: true\n            database:\n              type: string\n              x-dcl-go-name: Database\n              description: The database to replicate from the on-premises instance.\n            dumpFilePath:\n              type: string\n              x-dcl-go-name: DumpFilePath\n              description: The dump file to create the Cloud SQL replica.\n            hostPort:\n              type: string\n              x-dcl-go-name: HostPort\n              description: The host and port of the on-premises instance in host:port\n                format\n            kind:\n              type: string\n              x-dcl-go-name: Kind\n              description: This is always `sql#onPremisesConfiguration`.\n            password:\n              type: string\n              x-dcl-go-name: Password\n              description: The password for connecting to on-premises instance.\n              x-dcl-sensitive: true\n              x-dcl-mutable-unreadable: true\n            replicatedDatabases:\n              type: array\n              x-dcl-go-name: ReplicatedDatabases\n              description: The list of databases to replicate from the on-premises\n                instance.\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: string\n                x-dcl-go-type: string\n            username:\n              type: string\n              x-dcl-go-name: Username\n              description: The username for connecting to on-premises instance.\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: The project for the resource\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        region:\n          type: string\n          x-dcl-go-name: Region\n          description: The geographical region. Defaults to `us-central` or `us-central1`\n            depending on the instance type (First Generation or Second Generation).\n            The region cannot be changed after instance creation.\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n        replicaConfiguration:\n          type: object\n          x-dcl-go-name: ReplicaConfiguration\n          x-dcl-go-type: InstanceReplicaConfiguration\n          description: Configuration specific to failover replicas and read replicas.\n          properties:\n            failoverTarget:\n              type: boolean\n              x-dcl-go-name: FailoverTarget\n              description: Specifies if the replica is the failover target. If the\n                field is set to `true` the replica will be designated as a failover\n                replica. In case the primary instance fails, the replica instance\n                will be promoted as the new primary instance. Only one replica can\n                be specified as failover target, and the replica has to be in different\n                zone with the primary instance.\n            kind:\n              type: string\n              x-dcl-go-name: Kind\n              description: This is always `sql#replicaConfiguration`.\n            mysqlReplicaConfiguration:\n              type: object\n              x-dcl-go-name: MysqlReplicaConfiguration\n              x-dcl-go-type: InstanceReplicaConfigurationMysqlReplicaConfiguration\n              description: MySQL specific configuration when replicating from a MySQL\n                on-premises primary instance. Replication configuration information\n                such as the username, password, certificates, and keys are not stored\n                in the instance metadata. The configuration information is used only\n                to set up the replication connection and is stored by MySQL in a file\n                named `master.info` in the data directory.\n              properties:\n                caCertificate:\n                  type: string\n                  x-dcl-go-name: CaCertificate\n                  description: PEM representation of the trusted CA's x509 certificate.\n                clientCertificate:\n                  type: string\n                  x-dcl-go-name: ClientCertificate\n                  description: PEM representation of the replica's x509 certificate.\n                clientKey:\n                  type: string\n                  x-dcl-go-name: ClientKey\n                  description: PEM representation of the replica's private key. The\n                    corresponding public key is encoded in the client's certificate.\n                  x-dcl-sensitive: true\n                  x-dcl-mutable-unreadable: true\n                connectRetryInterval:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: ConnectRetryInterval\n                  description: Seconds to wait between connect retries. MySQL's default\n                    is 60 seconds.\n                dumpFilePath:\n                  type: string\n                  x-dcl-go-name: DumpFilePath\n                  description: Path to a SQL dump file in Google Cloud Storage from\n                    which the replica instance is to be created. The URI is in the\n                    form gs://bucketName/fileName. Compressed gzip files (.gz) are\n                    also supported. Dumps have the binlog co-ordinates from which\n                    replication begins. This can be accomplished by setting --master-data\n                    to 1 when using mysqldump.\n                kind:\n                  type: string\n                  x-dcl-go-name: Kind\n                  description: This is always `sql#mysqlReplicaConfiguration`.\n                masterHeartbeatPeriod:\n                  type: object\n                  x-dcl-go-name: MasterHeartbeatPeriod\n                  x-dcl-go-type: InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod\n                  description: Interval in milliseconds between replication heartbeats.\n                  properties:\n                    value:\n                      type: integer\n                      format: int64\n                      x-dcl-go-name: Value\n                      description: The value of the field.\n                password:\n                  type: string\n                  x-dcl-go-name: Password\n                  description: The password for the replication connection.\n                  x-dcl-sensitive: true\n                  x-dcl-mutable-unreadable: true\n                sslCipher:\n                  type: string\n                  x-dcl-go-name: SslCipher\n                  description: A list of permissible ciphers to use for SSL encryption.\n                username:\n                  type: string\n                  x-dcl-go-name: Username\n                  description: The username for the replication connection.\n                verifyServerCertificate:\n                  type: boolean\n                  x-dcl-go-name: VerifyServerCertificate\n                  description: Whether or not to check the primary instance's Common\n                    Name value in the certificate that it sends during the SSL handshake.\n            replicaPoolConfiguration:\n              type: object\n              x-dcl-go-name: ReplicaPoolConfiguration\n              x-dcl-go-type: InstanceReplicaConfigurationReplicaPoolConfiguration\n              description: Configuration specific to read replica pools.\n              properties:\n                autoscalingPoolConfiguration:\n                  type: object\n                  x-dcl-go-name: AutoscalingPoolConfiguration\n                  x-dcl-go-type: InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration\n                  description: Configuration for an autoscaling pool of read replicas.\n                  properties:\n                    kind:\n                      type: string\n                      x-dcl-go-name: Kind\n                      description: This is always `sql#autoscalingPoolConfiguration`.\n                    maxReplicaCount:\n                      type: integer\n                      format: int64\n                      x-dcl-go-name: MaxReplicaCount\n                      description: The maximum number of read replicas in the pool.\n                    minReplicaCount:\n                      type: integer\n                      format: int64\n                      x-dcl-go-name: MinReplicaCount\n                      description: The minimum number of read replicas in the pool.\n                    targetCpuUtil:\n                      type: number\n                      format: double\n                      x-dcl-go-name: TargetCpuUtil\n                      description: The target CPU utilization of the pool, as a fraction\n                        between 0 and 1.\n                exposeReplicaIp:\n                  type: boolean\n                  x-dcl-go-name: ExposeReplicaIP\n                  description: Whether the IP addresses of the individual read replicas\n                    are exposed.\n                kind:\n                  type: string\n                  x-dcl-go-name: Kind\n                  description: This is always `sql#replicaPoolConfiguration`.\n                replicaCount:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: ReplicaCount\n                  description: The number of read replicas in the pool.\n                staticPoolConfiguration:\n                  type: object\n                  x-dcl-go-name: StaticPoolConfiguration\n                  x-dcl-go-type: InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration\n                  description: Configuration for a static pool of read replicas.\n                  properties:\n                    exposeReplicaIp:\n                      type: boolean\n                      x-dcl-go-name: ExposeReplicaIP\n                      description: Whether the IP addresses of the individual read\n                        replicas are exposed.\n                    kind:\n                      type: string\n                      x-dcl-go-name: Kind\n                      description: This is always `sql#staticPoolConfiguration`.\n                    replicaCount:\n                      type: integer\n                      format: int64\n                      x-dcl-go-name: ReplicaCount\n                      description: The number of read replicas in the pool.\n        replicaInstances:\n          type: array\n          x-dcl-go-name: ReplicaInstances\n          readOnly: true\n          description: Output only. The replicas of the instance.\n          x-kubernetes-immutable: true\n          x-dcl-send-empty: true\n          x-dcl-list-type: list\n          items:\n            type: object\n            x-dcl-go-type: InstanceReplicaInstances\n            properties:\n              name:\n                type: string\n                x-dcl-go-name: Name\n                readOnly: true\n                description: The name of the replica instance. This does not include\n                  the project ID.\n                x-kubernetes-immutable: true\n              region:\n                type: string\n                x-dcl-go-name: Region\n                readOnly: true\n                description: The region of the replica instance.\n                x-kubernetes-immutable: true\n        rootPassword:\n          type: string\n          x-dcl-go-name: RootPassword\n          description: Initial root password. Use only on creation.\n          x-kubernetes-immutable: true\n          x-dcl-sensitive: true\n          x-dcl-mutable-unreadable: true\n        scheduledMaintenance:\n          type: object\n          x-dcl-go-name: ScheduledMaintenance\n          x-dcl-go-type: InstanceScheduledMaintenance\n          readOnly: true\n          description: Output only. The start time of any upcoming scheduled maintenance\n            for this instance.\n          x-kubernetes-immutable: true\n          properties:\n            canDefer:\n              type: boolean\n              x-dcl-go-name: CanDefer\n              readOnly: true\n              description: Whether the scheduled maintenance can be deferred.\n              x-kubernetes-immutable: true\n            canReschedule:\n              type: boolean\n              x-dcl-go-name: CanReschedule\n              readOnly: true\n              description: Whether the scheduled maintenance can be rescheduled.\n              x-kubernetes-immutable: true\n            startTime:\n              type: object\n              x-dcl-go-name: StartTime\n              x-dcl-go-type: InstanceScheduledMaintenanceStartTime\n              readOnly: true\n              description: The start time of any upcoming scheduled maintenance for\n                this instance.\n              x-kubernetes-immutable: true\n              properties:\n                nanos:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: Nanos\n                  readOnly: true\n                  description: Non-negative fractions of a second at nanosecond resolution.\n                    Negative second values with fractions must still have non-negative\n                    nanos values that count forward in time. Must be from 0 to 999,999,999\n                    inclusive.\n                  x-kubernetes-immutable: true\n                seconds:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: Seconds\n                  readOnly: true\n                  description: Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z.\n                    Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.\n                  x-kubernetes-immutable: true\n        serverCaCert:\n          type: object\n          x-dcl-go-name: ServerCaCert\n          x-dcl-go-type: InstanceServerCaCert\n          readOnly: true\n          description: Output only. SSL configuration.\n          x-kubernetes-immutable: true\n          properties:\n            cert:\n              type: string\n              x-dcl-go-name: Cert\n              readOnly: true\n              description: PEM representation.\n              x-kubernetes-immutable: true\n            certSerialNumber:\n              type: string\n              x-dcl-go-name: CertSerialNumber\n              readOnly: true\n              description: Serial number, as extracted from the certificate.\n              x-kubernetes-immutable: true\n            commonName:\n              type: string\n              x-dcl-go-name: CommonName\n              readOnly: true\n              description: User supplied name. Constrained to [a-zA-Z.-_ ]+.\n              x-kubernetes-immutable: true\n            createTime:\n              type: string\n              x-dcl-go-name: CreateTime\n              readOnly: true\n              description: The time when the certificate was created in RFC 3339 format,\n                for example `2012-11-15T16:19:00.094Z`.\n              x-kubernetes-immutable: true\n            expirationTime:\n              type: string\n              x-dcl-go-name: ExpirationTime\n              readOnly: true\n              description: The time when the certificate expires in RFC 3339 format,\n                for example `2012-11-15T16:19:00.094Z`.\n              x-kubernetes-immutable: true\n            instance:\n              type: string\n              x-dcl-go-name: Instance\n              readOnly: true\n              description: Name of the database instance.\n              x-kubernetes-immutable: true\n            kind:\n              type: string\n              x-dcl-go-name: Kind\n              readOnly: true\n              description: This is always `sql#sslCert`.\n              x-kubernetes-immutable: true\n            sha1Fingerprint:\n              type: string\n              x-dcl-go-name: Sha1Fingerprint\n              readOnly: true\n              description: Sha1 Fingerprint.\n              x-kubernetes-immutable: true\n        serviceAccountEmailAddress:\n          type: string\n          x-dcl-go-name: ServiceAccountEmailAddress\n          readOnly: true\n          description: Output only. The service account email address assigned to\n            the instance. This property is applicable only to Second Generation instances.\n          x-kubernetes-immutable: true\n        settings:\n          type: object\n          x-dcl-go-name: Settings\n          x-dcl-go-type: InstanceSettings\n          description: The user settings.\n          required:\n          - tier\n          properties:\n            activationPolicy:\n              type: string\n              x-dcl-go-name: ActivationPolicy\n              x-dcl-go-type: InstanceSettingsActivationPolicyEnum\n              description: 'The activation policy specifies when the instance is activated;\n                it is applicable only when the instance state is `RUNNABLE`. Valid\n                values: `ALWAYS`: The instance is on, and remains so even in the absence\n                of connection requests. `NEVER`: The instance is off; it is not activated,\n                even if a connection request arrives. Possible values: SQL_ACTIVATION_POLICY_UNSPECIFIED,\n                ALWAYS, NEVER, ON_DEMAND'\n              enum:\n              - SQL_ACTIVATION_POLICY_UNSPECIFIED\n              - ALWAYS\n              - NEVER\n              - ON_DEMAND\n            activeDirectoryConfig:\n              type: object\n              x-dcl-go-name: ActiveDirectoryConfig\n              x-dcl-go-type: InstanceSettingsActiveDirectoryConfig\n              description: Active Directory configuration, relevant only for Cloud\n                SQL for SQL Server.\n              properties:\n                domain:\n                  type: string\n                  x-dcl-go-name: Domain\n                  description: The name of the domain (e.g., mydomain.com).\n                kind:\n                  type: string\n                  x-dcl-go-name: Kind\n                  description: This is always sql#activeDirectoryConfig.\n            authorizedGaeApplications:\n              type: array\n              x-dcl-go-name: AuthorizedGaeApplications\n              description: The App Engine app IDs that can access this instance. (Deprecated)\n                Applied to First Generation instances only.\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: string\n                x-dcl-go-type: string\n            availabilityType:\n              type: string\n              x-dcl-go-name: AvailabilityType\n              x-dcl-go-type: InstanceSettingsAvailabilityTypeEnum\n              description: 'Availability type. Potential values: `ZONAL`: The instance\n                serves data from only one zone. Outages in that zone affect data accessibility.\n                `REGIONAL`: The instance can serve data from more than one zone in\n                a region (it is highly available). For more information, see [Overview\n                of the High Availability Configuration](https://cloud.google.com/sql/docs/mysql/high-availability).\n                Possible values: SQL_AVAILABILITY_TYPE_UNSPECIFIED, ZONAL, REGIONAL'\n              enum:\n              - SQL_AVAILABILITY_TYPE_UNSPECIFIED\n              - ZONAL\n              - REGIONAL\n            backupConfiguration:\n              type: object\n              x-dcl-go-name: BackupConfiguration\n              x-dcl-go-type: InstanceSettingsBackupConfiguration\n              description: The daily backup configuration for the instance.\n              properties:\n                backupRetentionSettings:\n                  type: object\n                  x-dcl-go-name: BackupRetentionSettings\n                  x-dcl-go-type: InstanceSettingsBackupConfigurationBackupRetentionSettings\n                  description: Backup retention settings.\n                  properties:\n                    retainedBackups:\n                      type: integer\n                      format: int64\n                      x-dcl-go-name: RetainedBackups\n                      description: Depending on the value of retention_unit, this\n                        is used to determine if a backup needs to be deleted. If retention_unit\n                        is 'COUNT', we will retain this many backups.\n                    retentionUnit:\n                      type: string\n                      x-dcl-go-name: RetentionUnit\n                      x-dcl-go-type: InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum\n                      description: 'The unit that ''retained_backups'' represents.\n                        Possible values: RETENTION_UNIT_UNSPECIFIED, COUNT'\n                      enum:\n                      - RETENTION_UNIT_UNSPECIFIED\n                      - COUNT\n                binaryLogEnabled:\n                  type: boolean\n                  x-dcl-go-name: BinaryLogEnabled\n                  description: (MySQL only) Whether binary log is enabled. If backup\n                    configuration is disabled, binarylog must be disabled as well.\n                enabled:\n                  type: boolean\n                  x-dcl-go-name: Enabled\n                  description: Whether this configuration is enabled.\n                kind:\n                  type: string\n                  x-dcl-go-name: Kind\n                  description: This is always `sql#backupConfiguration`.\n                location:\n                  type: string\n                  x-dcl-go-name: Location\n                  description: Location of the backup\n                startTime:\n                  type: string\n                  x-dcl-go-name: StartTime\n                  description: Start time for the daily backup configuration in UTC\n                    timezone in the 24 hour format - `HH:MM`.\n                transactionLogRetentionDays:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: TransactionLogRetentionDays\n                  description: The number of days of transaction logs we retain for\n                    point in time restore, from 1-7.\n            collation:\n              type: string\n              x-dcl-go-name: Collation\n              description: The name of server Instance collation.\n            crashSafeReplicationEnabled:\n              type: boolean\n              x-dcl-go-name: CrashSafeReplicationEnabled\n              description: Configuration specific to read replica instances. Indicates\n                whether database flags for crash-safe replication are enabled. This\n                property was only applicable to First Generation instances.\n            dataDiskSizeGb:\n              type: object\n              x-dcl-go-name: DataDiskSizeGb\n              x-dcl-go-type: InstanceSettingsDataDiskSizeGb\n              description: The size of data disk, in GB. The data disk size minimum\n                is 10GB.\n              properties:\n                value:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: Value\n                  description: The value of the field.\n            dataDiskType:\n              type: string\n              x-dcl-go-name: DataDiskType\n              x-dcl-go-type: InstanceSettingsDataDiskTypeEnum\n              description: 'The type of data disk: `PD_SSD` (default) or `PD_HDD`.\n                Not used for First Generation instances. Possible values: SQL_DATA_DISK_TYPE_UNSPECIFIED,\n                PD_SSD, PD_HDD, OBSOLETE_LOCAL_SSD'\n              enum:\n              - SQL_DATA_DISK_TYPE_UNSPECIFIED\n              - PD_SSD\n              - PD_HDD\n              - OBSOLETE_LOCAL_SSD\n            databaseFlags:\n              type: array\n              x-dcl-go-name: DatabaseFlags\n              description: The database flags passed to the instance at startup.\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: object\n                x-dcl-go-type: InstanceSettingsDatabaseFlags\n                properties:\n                  name:\n                    type: string\n                    x-dcl-go-name: Name\n                    description: The name of the flag. These flags are passed at instance\n                      startup, so include both server options and system variables.\n                      Flags are specified with underscores, not hyphens. For more\n                      information, see [Configuring Database Flags](https://cloud.google.com/sql/docs/mysql/flags)\n                      in the Cloud SQL documentation.\n                  value:\n                    type: string\n                    x-dcl-go-name: Value\n                    description: The value of the flag. Boolean flags are set to `on`\n                      for true and `off` for false. This field must be omitted if\n                      the flag doesn't take a value.\n            databaseReplicationEnabled:\n              type: boolean\n              x-dcl-go-name: DatabaseReplicationEnabled\n              description: Configuration specific to read replica instances. Indicates\n                whether replication is enabled or not.\n            denyMaintenancePeriods:\n              type: array\n              x-dcl-go-name: DenyMaintenancePeriods\n              description: Deny maintenance periods\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: object\n                x-dcl-go-type: InstanceSettingsDenyMaintenancePeriods\n                properties:\n                  endDate:\n                    type: string\n                    x-dcl-go-name: EndDate\n                    description: '\"deny maintenance period\" end date. If the year\n                      of the end date is empty, the year of the start date also must\n                      be empty. In this case, it means the deny maintenance period\n                      recurs every year. The date is in format yyyy-mm-dd i.e., 2020-11-01,\n                      or mm-dd, i.e., 11-01'\n                  startDate:\n                    type: string\n                    x-dcl-go-name: StartDate\n                    description: '\"deny maintenance period\" start date. If the year\n                      of the start date is empty, the year of the end date also must\n                      be empty. In this case, it means the deny maintenance period\n                      recurs every year. The date is in format yyyy-mm-dd i.e., 2020-11-01,\n                      or mm-dd, i.e., 11-01'\n                  time:\n                    type: string\n                    x-dcl-go-name: Time\n                    description: 'Time in UTC when the \"deny maintenance period\" starts\n                      on start_date and ends on end_date. The time is in format: HH:mm:SS,\n                      i.e., 00:00:00'\n            insightsConfig:\n              type: object\n              x-dcl-go-name: InsightsConfig\n              x-dcl-go-type: InstanceSettingsInsightsConfig\n              description: Insights configuration, for now relevant only for Postgres.\n              properties:\n                queryInsightsEnabled:\n                  type: boolean\n                  x-dcl-go-name: QueryInsightsEnabled\n                  description: Whether Query Insights feature is enabled.\n                queryStringLength:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: QueryStringLength\n                  description: 'Maximum query length stored in bytes. Default value:\n                    1024 bytes. Range: 256-4500 bytes. Query length more than this\n                    field value will be truncated to this value. When unset, query\n                    length will be the default value. Changing query length will restart\n                    the database.'\n                recordApplicationTags:\n                  type: boolean\n                  x-dcl-go-name: RecordApplicationTags\n                  description: Whether Query Insights will record application tags\n                    from query when enabled.\n                recordClientAddress:\n                  type: boolean\n                  x-dcl-go-name: RecordClientAddress\n                  description: Whether Query Insights will record client address when\n                    enabled.\n            ipConfiguration:\n              type: object\n              x-dcl-go-name: IPConfiguration\n              x-dcl-go-type: InstanceSettingsIPConfiguration\n              description: The settings for IP Management. This allows to enable or\n                disable the instance IP and manage which external networks can connect\n                to the instance. The IPv4 address cannot be disabled for Second Generation\n                instances.\n              properties:\n                authorizedNetworks:\n                  type: array\n                  x-dcl-go-name: AuthorizedNetworks\n                  description: 'The list of external networks that are allowed to\n                    connect to the instance using the IP. In ''CIDR'' notation, also\n                    known as ''slash'' notation (for example: `192.168.100.0/24`).'\n                  x-dcl-send-empty: true\n                  x-dcl-list-type: list\n                  items:\n                    type: object\n                    x-dcl-go-type: InstanceSettingsIPConfigurationAuthorizedNetworks\n                    properties:\n                      expirationTime:\n                        type: string\n                        x-dcl-go-name: ExpirationTime\n                        description: The time when this access control entry expires\n                          in RFC 3339 format, for example `2012-11-15T16:19:00.094Z`.\n                      kind:\n                        type: string\n                        x-dcl-go-name: Kind\n                        description: This is always `sql#aclEntry`.\n                      name:\n                        type: string\n                        x-dcl-go-name: Name\n                        description: Optional. A label to identify this entry.\n                      value:\n                        type: string\n                        x-dcl-go-name: Value\n                        description: The allowlisted value for the access control\n                          list.\n                ipv4Enabled:\n                  type: boolean\n                  x-dcl-go-name: IPv4Enabled\n                  description: Whether the instance is assigned a public IP address\n                    or not.\n                privateNetwork:\n                  type: string\n                  x-dcl-go-name: PrivateNetwork\n                  description: The resource link for the VPC network from which the\n                    Cloud SQL instance is accessible for private IP. For example,\n                    `/projects/myProject/global/networks/default`. This setting can\n                    be updated, but it cannot be removed after it is set.\n                requireSsl:\n                  type: boolean\n                  x-dcl-go-name: RequireSsl\n                  description: Whether SSL connections over IP are enforced or not.\n            kind:\n              type: string\n              x-dcl-go-name: Kind\n              description: This is always `sql#settings`.\n            locationPreference:\n              type: object\n              x-dcl-go-name: LocationPreference\n              x-dcl-go-type: InstanceSettingsLocationPreference\n              description: The location preference settings. This allows the instance\n                to be located as near as possible to either an App Engine app or Compute\n                Engine zone for better performance. App Engine co-location was only\n                applicable to First Generation instances.\n              properties:\n                kind:\n                  type: string\n                  x-dcl-go-name: Kind\n                  description: This is always `sql#locationPreference`.\n                zone:\n                  type: string\n                  x-dcl-go-name: Zone\n                  description: 'The preferred Compute Engine zone (for example: us-central1-a,\n                    us-central1-b, etc.).'\n            maintenanceWindow:\n              type: object\n              x-dcl-go-name: MaintenanceWindow\n              x-dcl-go-type: InstanceSettingsMaintenanceWindow\n              description: The maintenance window for this instance. This specifies\n                when the instance can be restarted for maintenance purposes.\n              properties:\n                day:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: Day\n                  description: day of week (1-7), starting on Monday.\n                hour:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: Hour\n                  description: hour of day - 0 to 23.\n                kind:\n                  type: string\n                  x-dcl-go-name: Kind\n                  description: This is always `sql#maintenanceWindow`.\n                updateTrack:\n                  type: string\n                  x-dcl-go-name: UpdateTrack\n                  x-dcl-go-type: InstanceSettingsMaintenanceWindowUpdateTrackEnum\n                  description: 'Maintenance timing setting: `canary` (Earlier) or\n                    `stable` (Later). [Learn more](https://cloud.google.com/sql/docs/mysql/instance-settings#maintenance-timing-2ndgen).\n                    Possible values: SQL_UPDATE_TRACK_UNSPECIFIED, canary, stable'\n                  enum:\n                  - SQL_UPDATE_TRACK_UNSPECIFIED\n                  - canary\n                  - stable\n            pricingPlan:\n              type: string\n              x-dcl-go-name: PricingPlan\n              x-dcl-go-type: InstanceSettingsPricingPlanEnum\n              description: 'The pricing plan for this instance. This can be either\n                `PER_USE` or `PACKAGE`. Only `PER_USE` is supported for Second Generation\n                instances. Possible values: SQL_PRICING_PLAN_UNSPECIFIED, PACKAGE,\n                PER_USE'\n              enum:\n              - SQL_PRICING_PLAN_UNSPECIFIED\n              - PACKAGE\n              - PER_USE\n            replicationType:\n              type: string\n              x-dcl-go-name: ReplicationType\n              x-dcl-go-type: InstanceSettingsReplicationTypeEnum\n              description: 'The type of replication this instance uses. This can be\n                either `ASYNCHRONOUS` or `SYNCHRONOUS`. (Deprecated) This property\n                was only applicable to First Generation instances. Possible values:\n                SQL_REPLICATION_TYPE_UNSPECIFIED, SYNCHRONOUS, ASYNCHRONOUS'\n              enum:\n              - SQL_REPLICATION_TYPE_UNSPECIFIED\n              - SYNCHRONOUS\n              - ASYNCHRONOUS\n            settingsVersion:\n              type: object\n              x-dcl-go-name: SettingsVersion\n              x-dcl-go-type: InstanceSettingsSettingsVersion\n              readOnly: true\n              description: Output only. The version of instance settings. This is\n                a required field for update method to make sure concurrent updates\n                are handled properly. During update, use the most recent settingsVersion\n                value for this instance and do not try to update this value.\n              x-kubernetes-immutable: true\n              properties:\n                value:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: Value\n                  readOnly: true\n                  description: The value of the field.\n                  x-kubernetes-immutable: true\n            storageAutoResize:\n              type: boolean\n              x-dcl-go-name: StorageAutoResize\n              description: Configuration to increase storage size automatically. The\n                default value is true.\n            storageAutoResizeLimit:\n              type: object\n              x-dcl-go-name: StorageAutoResizeLimit\n              x-dcl-go-type: InstanceSettingsStorageAutoResizeLimit\n              description: The maximum size to which storage capacity can be automatically\n                increased. The default value is 0, which specifies that there is no\n                limit.\n              properties:\n                value:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: Value\n                  description: The value of the field.\n            tier:\n              type: string\n              x-dcl-go-name: Tier\n              description: The tier (or machine type) for this instance, for example\n                `db-custom-1-3840`.\n            userLabels:\n              type: object\n              additionalProperties:\n                type: string\n              x-dcl-go-name: UserLabels\n              description: User-provided labels, represented as a dictionary where\n                each label is a single key value pair.\n        state:\n          type: string\n          x-dcl-go-name: State\n          readOnly: true\n          description: 'Output only. The current serving state of the Cloud SQL instance.\n            This can be one of the following: `RUNNABLE`, `SUSPENDED`, `PENDING_DELETE`,\n            `PENDING_CREATE`, `MAINTENANCE` or `FAILED`.'\n          x-kubernetes-immutable: true\n        suspensionReason:\n          type: array\n          x-dcl-go-name: SuspensionReason\n          readOnly: true\n          description: Output only. If the instance state is SUSPENDED, the reason\n            for the suspension.\n          x-kubernetes-immutable: true\n          x-dcl-send-empty: true\n          x-dcl-list-type: list\n          items:\n            type: string\n            x-dcl-go-type: string\n")
+
+// 53041 bytes
+// MD5: f0de1dea80e1d76450d2fe13e80e2d04
@@ -0,0 +1,13110 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package beta
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl/operations"
+)
+
+func (r *Instance) validate() error {
+
+	if err := dcl.Required(r, "name"); err != nil {
+		return err
+	}
+	if err := dcl.RequiredParameter(r.Project, "Project"); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(r.MaxDiskSize) {
+		if err := r.MaxDiskSize.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.CurrentDiskSize) {
+		if err := r.CurrentDiskSize.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.DiskEncryptionConfiguration) {
+		if err := r.DiskEncryptionConfiguration.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.FailoverReplica) {
+		if err := r.FailoverReplica.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.MasterInstance) {
+		if err := r.MasterInstance.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.ReplicaConfiguration) {
+		if err := r.ReplicaConfiguration.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.ScheduledMaintenance) {
+		if err := r.ScheduledMaintenance.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.Settings) {
+		if err := r.Settings.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.ServerCaCert) {
+		if err := r.ServerCaCert.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.OnPremisesConfiguration) {
+		if err := r.OnPremisesConfiguration.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.DiskEncryptionStatus) {
+		if err := r.DiskEncryptionStatus.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *InstanceMaxDiskSize) validate() error {
+	return nil
+}
+func (r *InstanceCurrentDiskSize) validate() error {
+	return nil
+}
+func (r *InstanceDiskEncryptionConfiguration) validate() error {
+	return nil
+}
+func (r *InstanceFailoverReplica) validate() error {
+	if !dcl.IsEmptyValueIndirect(r.FailoverInstance) {
+		if err := r.FailoverInstance.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *InstanceFailoverReplicaFailoverInstance) validate() error {
+	return nil
+}
+func (r *InstanceIPAddresses) validate() error {
+	if !dcl.IsEmptyValueIndirect(r.TimeToRetire) {
+		if err := r.TimeToRetire.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *InstanceIPAddressesTimeToRetire) validate() error {
+	return nil
+}
+func (r *InstanceMasterInstance) validate() error {
+	return nil
+}
+func (r *InstanceReplicaConfiguration) validate() error {
+	if !dcl.IsEmptyValueIndirect(r.MysqlReplicaConfiguration) {
+		if err := r.MysqlReplicaConfiguration.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.ReplicaPoolConfiguration) {
+		if err := r.ReplicaPoolConfiguration.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *InstanceReplicaConfigurationMysqlReplicaConfiguration) validate() error {
+	if !dcl.IsEmptyValueIndirect(r.MasterHeartbeatPeriod) {
+		if err := r.MasterHeartbeatPeriod.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod) validate() error {
+	return nil
+}
+func (r *InstanceReplicaConfigurationReplicaPoolConfiguration) validate() error {
+	if !dcl.IsEmptyValueIndirect(r.StaticPoolConfiguration) {
+		if err := r.StaticPoolConfiguration.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.AutoscalingPoolConfiguration) {
+		if err := r.AutoscalingPoolConfiguration.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration) validate() error {
+	return nil
+}
+func (r *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration) validate() error {
+	return nil
+}
+func (r *InstanceScheduledMaintenance) validate() error {
+	if !dcl.IsEmptyValueIndirect(r.StartTime) {
+		if err := r.StartTime.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *InstanceScheduledMaintenanceStartTime) validate() error {
+	return nil
+}
+func (r *InstanceSettings) validate() error {
+	if err := dcl.Required(r, "tier"); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(r.SettingsVersion) {
+		if err := r.SettingsVersion.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.StorageAutoResizeLimit) {
+		if err := r.StorageAutoResizeLimit.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.IPConfiguration) {
+		if err := r.IPConfiguration.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.LocationPreference) {
+		if err := r.LocationPreference.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.MaintenanceWindow) {
+		if err := r.MaintenanceWindow.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.BackupConfiguration) {
+		if err := r.BackupConfiguration.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.DataDiskSizeGb) {
+		if err := r.DataDiskSizeGb.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.ActiveDirectoryConfig) {
+		if err := r.ActiveDirectoryConfig.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.InsightsConfig) {
+		if err := r.InsightsConfig.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *InstanceSettingsSettingsVersion) validate() error {
+	return nil
+}
+func (r *InstanceSettingsStorageAutoResizeLimit) validate() error {
+	return nil
+}
+func (r *InstanceSettingsIPConfiguration) validate() error {
+	return nil
+}
+func (r *InstanceSettingsIPConfigurationAuthorizedNetworks) validate() error {
+	return nil
+}
+func (r *InstanceSettingsLocationPreference) validate() error {
+	return nil
+}
+func (r *InstanceSettingsDatabaseFlags) validate() error {
+	return nil
+}
+func (r *InstanceSettingsMaintenanceWindow) validate() error {
+	return nil
+}
+func (r *InstanceSettingsBackupConfiguration) validate() error {
+	if !dcl.IsEmptyValueIndirect(r.BackupRetentionSettings) {
+		if err := r.BackupRetentionSettings.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *InstanceSettingsBackupConfigurationBackupRetentionSettings) validate() error {
+	return nil
+}
+func (r *InstanceSettingsDataDiskSizeGb) validate() error {
+	return nil
+}
+func (r *InstanceSettingsActiveDirectoryConfig) validate() error {
+	return nil
+}
+func (r *InstanceSettingsDenyMaintenancePeriods) validate() error {
+	return nil
+}
+func (r *InstanceSettingsInsightsConfig) validate() error {
+	return nil
+}
+func (r *InstanceReplicaInstances) validate() error {
+	return nil
+}
+func (r *InstanceServerCaCert) validate() error {
+	return nil
+}
+func (r *InstanceOnPremisesConfiguration) validate() error {
+	return nil
+}
+func (r *InstanceDiskEncryptionStatus) validate() error {
+	return nil
+}
+func (r *Instance) basePath() string {
+	params := map[string]interface{}{}
+	return dcl.Nprintf("https://sqladmin.googleapis.com/sql/v1beta4/", params)
+}
+
+func (r *Instance) getURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+		"name":    dcl.ValueOrEmptyString(nr.Name),
+	}
+	return dcl.URL("projects/{{project}}/instances/{{name}}", nr.basePath(), userBasePath, params), nil
+}
+
+func (r *Instance) listURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+	}
+	return dcl.URL("projects/{{project}}/instances", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *Instance) createURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+	}
+	return dcl.URL("projects/{{project}}/instances", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *Instance) deleteURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+		"name":    dcl.ValueOrEmptyString(nr.Name),
+	}
+	return dcl.URL("projects/{{project}}/instances/{{name}}", nr.basePath(), userBasePath, params), nil
+}
+
+// instanceApiOperation represents a mutable operation in the underlying REST
+// API such as Create, Update, or Delete.
+type instanceApiOperation interface {
+	do(context.Context, *Instance, *Client) error
+}
+
+// newUpdateInstancePatchRequest creates a request for an
+// Instance resource's Patch update type by filling in the update
+// fields based on the intended state of the resource.
+func newUpdateInstancePatchRequest(ctx context.Context, f *Instance, c *Client) (map[string]interface{}, error) {
+	req := map[string]interface{}{}
+	res := f
+	_ = res
+
+	if v := f.BackendType; !dcl.IsEmptyValueIndirect(v) {
+		req["backendType"] = v
+	}
+	if v, err := expandSqlInstanceMaxDiskSize(c, f.MaxDiskSize, res); err != nil {
+		return nil, fmt.Errorf("error expanding MaxDiskSize into maxDiskSize: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		req["maxDiskSize"] = v
+	}
+	if v, err := expandInstanceFailoverReplica(c, f.FailoverReplica, res); err != nil {
+		return nil, fmt.Errorf("error expanding FailoverReplica into failoverReplica: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		req["failoverReplica"] = v
+	}
+	if v, err := expandInstanceReplicaConfiguration(c, f.ReplicaConfiguration, res); err != nil {
+		return nil, fmt.Errorf("error expanding ReplicaConfiguration into replicaConfiguration: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		req["replicaConfiguration"] = v
+	}
+	if v, err := expandInstanceSettings(c, f.Settings, res); err != nil {
+		return nil, fmt.Errorf("error expanding Settings into settings: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		req["settings"] = v
+	}
+	if v, err := expandInstanceOnPremisesConfiguration(c, f.OnPremisesConfiguration, res); err != nil {
+		return nil, fmt.Errorf("error expanding OnPremisesConfiguration into onPremisesConfiguration: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		req["onPremisesConfiguration"] = v
+	}
+	return req, nil
+}
+
+// marshalUpdateInstancePatchRequest converts the update into
+// the final JSON request body.
+func marshalUpdateInstancePatchRequest(c *Client, m map[string]interface{}) ([]byte, error) {
+
+	return json.Marshal(m)
+}
+
+type updateInstancePatchOperation struct {
+	// If the update operation has the REQUIRES_APPLY_OPTIONS trait, this will be populated.
+	// Usually it will be nil - this is to prevent us from accidentally depending on apply
+	// options, which should usually be unnecessary.
+	ApplyOptions []dcl.ApplyOption
+	FieldDiffs   []*dcl.FieldDiff
+}
+
+// do creates a request and sends it to the appropriate URL. In most operations,
+// do will transcribe a subset of the resource into a request object and send a
+// PUT request to a single URL.
+
+func (op *updateInstancePatchOperation) do(ctx context.Context, r *Instance, c *Client) error {
+	_, err := c.GetInstance(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	u, err := r.updateURL(c.Config.BasePath, "Patch")
+	if err != nil {
+		return err
+	}
+
+	req, err := newUpdateInstancePatchRequest(ctx, r, c)
+	if err != nil {
+		return err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created update: %#v", req)
+	body, err := marshalUpdateInstancePatchRequest(c, req)
+	if err != nil {
+		return err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "PATCH", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+
+	var o operations.SQLOperation
+	if err := dcl.ParseResponse(resp.Response, &o); err != nil {
+		return err
+	}
+	err = o.Wait(context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), c.Config, r.basePath(), "GET")
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) listInstanceRaw(ctx context.Context, r *Instance, pageToken string, pageSize int32) ([]byte, error) {
+	u, err := r.urlNormalized().listURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string)
+	if pageToken != "" {
+		m["pageToken"] = pageToken
+	}
+
+	if pageSize != InstanceMaxPage {
+		m["pageSize"] = fmt.Sprintf("%v", pageSize)
+	}
+
+	u, err = dcl.AddQueryParams(u, m)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	return ioutil.ReadAll(resp.Response.Body)
+}
+
+type listInstanceOperation struct {
+	Items []map[string]interface{} `json:"items"`
+	Token string                   `json:"nextPageToken"`
+}
+
+func (c *Client) listInstance(ctx context.Context, r *Instance, pageToken string, pageSize int32) ([]*Instance, string, error) {
+	b, err := c.listInstanceRaw(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var m listInstanceOperation
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, "", err
+	}
+
+	var l []*Instance
+	for _, v := range m.Items {
+		res, err := unmarshalMapInstance(v, c, r)
+		if err != nil {
+			return nil, m.Token, err
+		}
+		res.Project = r.Project
+		l = append(l, res)
+	}
+
+	return l, m.Token, nil
+}
+
+func (c *Client) deleteAllInstance(ctx context.Context, f func(*Instance) bool, resources []*Instance) error {
+	var errors []string
+	for _, res := range resources {
+		if f(res) {
+			// We do not want deleteAll to fail on a deletion or else it will stop deleting other resources.
+			err := c.DeleteInstance(ctx, res)
+			if err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("%v", strings.Join(errors, "\n"))
+	} else {
+		return nil
+	}
+}
+
+type deleteInstanceOperation struct{}
+
+func (op *deleteInstanceOperation) do(ctx context.Context, r *Instance, c *Client) error {
+	r, err := c.GetInstance(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			c.Config.Logger.InfoWithContextf(ctx, "Instance not found, returning. Original error: %v", err)
+			return nil
+		}
+		c.Config.Logger.WarningWithContextf(ctx, "GetInstance checking for existence. error: %v", err)
+		return err
+	}
+
+	u, err := r.deleteURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	// Delete should never have a body
+	body := &bytes.Buffer{}
+	resp, err := dcl.SendRequest(ctx, c.Config, "DELETE", u, body, c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+
+	// wait for object to be deleted.
+	var o operations.SQLOperation
+	if err := dcl.ParseResponse(resp.Response, &o); err != nil {
+		return err
+	}
+	if err := o.Wait(context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), c.Config, r.basePath(), "GET"); err != nil {
+		return err
+	}
+
+	// We saw a race condition where for some successful delete operation, the Get calls returned resources for a short duration.
+	// This is the reason we are adding retry to handle that case.
+	retriesRemaining := 10
+	dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		_, err := c.GetInstance(ctx, r)
+		if dcl.IsNotFound(err) {
+			return nil, nil
+		}
+		if retriesRemaining > 0 {
+			retriesRemaining--
+			return &dcl.RetryDetails{}, dcl.OperationNotDone{}
+		}
+		return nil, dcl.NotDeletedError{ExistingResource: r}
+	}, c.Config.RetryProvider)
+	return nil
+}
+
+// Create operations are similar to Update operations, although they do not have
+// specific request objects. The Create request object is the json encoding of
+// the resource, which is modified by res.marshal to form the base request body.
+type createInstanceOperation struct {
+	response map[string]interface{}
+}
+
+func (op *createInstanceOperation) FirstResponse() (map[string]interface{}, bool) {
+	return op.response, len(op.response) > 0
+}
+
+func (op *createInstanceOperation) do(ctx context.Context, r *Instance, c *Client) error {
+	c.Config.Logger.InfoWithContextf(ctx, "Attempting to create %v", r)
+	u, err := r.createURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	req, err := r.marshal(c)
+	if err != nil {
+		return err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "POST", u, bytes.NewBuffer(req), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+	// wait for object to be created.
+	var o operations.SQLOperation
+	if err := dcl.ParseResponse(resp.Response, &o); err != nil {
+		return err
+	}
+	if err := o.Wait(context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), c.Config, r.basePath(), "GET"); err != nil {
+		c.Config.Logger.Warningf("Creation failed after waiting for operation: %v", err)
+		return err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Successfully waited for operation")
+	op.response, _ = o.FirstResponse()
+
+	if _, err := c.GetInstance(ctx, r); err != nil {
+		c.Config.Logger.WarningWithContextf(ctx, "get returned error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) getInstanceRaw(ctx context.Context, r *Instance) ([]byte, error) {
+
+	u, err := r.getURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	b, err := ioutil.ReadAll(resp.Response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (c *Client) instanceDiffsForRawDesired(ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) (initial, desired *Instance, diffs []*dcl.FieldDiff, err error) {
+	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
+	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
+	var fetchState *Instance
+	if sh := dcl.FetchStateHint(opts); sh != nil {
+		if r, ok := sh.(*Instance); !ok {
+			c.Config.Logger.WarningWithContextf(ctx, "Initial state hint was of the wrong type; expected Instance, got %T", sh)
+		} else {
+			fetchState = r
+		}
+	}
+	if fetchState == nil {
+		fetchState = rawDesired
+	}
+
+	// 1.2: Retrieval of raw initial state from API
+	rawInitial, err := c.GetInstance(ctx, fetchState)
+	if rawInitial == nil {
+		if !dcl.IsNotFound(err) {
+			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Instance resource already exists: %s", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Instance resource: %w", err)
+		}
+		c.Config.Logger.InfoWithContext(ctx, "Found that Instance resource did not exist.")
+		// Perform canonicalization to pick up defaults.
+		desired, err = canonicalizeInstanceDesiredState(rawDesired, rawInitial)
+		return nil, desired, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Found initial state for Instance: %v", rawInitial)
+	c.Config.Logger.InfoWithContextf(ctx, "Initial desired state for Instance: %v", rawDesired)
+
+	// The Get call applies postReadExtract and so the result may contain fields that are not part of API version.
+	if err := extractInstanceFields(rawInitial); err != nil {
+		return nil, nil, nil, err
+	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// 1.3: Canonicalize raw initial state into initial state.
+	initial, err = canonicalizeInstanceInitialState(rawInitial, rawDesired)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized initial state for Instance: %v", initial)
+
+	// 1.4: Canonicalize raw desired state into desired state.
+	desired, err = canonicalizeInstanceDesiredState(rawDesired, rawInitial, opts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized desired state for Instance: %v", desired)
+
+	// 2.1: Comparison of initial and desired state.
+	diffs, err = diffInstance(c, desired, initial, opts...)
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
+}
+
+func canonicalizeInstanceInitialState(rawInitial, rawDesired *Instance) (*Instance, error) {
+	// TODO(magic-modules-eng): write canonicalizer once relevant traits are added.
+	return rawInitial, nil
+}
+
+/*
+* Canonicalizers
+*
+* These are responsible for converting either a user-specified config or a
+* GCP API response to a standard format that can be used for difference checking.
+* */
+
+func canonicalizeInstanceDesiredState(rawDesired, rawInitial *Instance, opts ...dcl.ApplyOption) (*Instance, error) {
+
+	if rawInitial == nil {
+		// Since the initial state is empty, the desired state is all we have.
+		// We canonicalize the remaining nested objects with nil to pick up defaults.
+		rawDesired.MaxDiskSize = canonicalizeInstanceMaxDiskSize(rawDesired.MaxDiskSize, nil, opts...)
+		rawDesired.CurrentDiskSize = canonicalizeInstanceCurrentDiskSize(rawDesired.CurrentDiskSize, nil, opts...)
+		rawDesired.DiskEncryptionConfiguration = canonicalizeInstanceDiskEncryptionConfiguration(rawDesired.DiskEncryptionConfiguration, nil, opts...)
+		rawDesired.FailoverReplica = canonicalizeInstanceFailoverReplica(rawDesired.FailoverReplica, nil, opts...)
+		rawDesired.MasterInstance = canonicalizeInstanceMasterInstance(rawDesired.MasterInstance, nil, opts...)
+		rawDesired.ReplicaConfiguration = canonicalizeInstanceReplicaConfiguration(rawDesired.ReplicaConfiguration, nil, opts...)
+		rawDesired.ScheduledMaintenance = canonicalizeInstanceScheduledMaintenance(rawDesired.ScheduledMaintenance, nil, opts...)
+		rawDesired.Settings = canonicalizeInstanceSettings(rawDesired.Settings, nil, opts...)
+		rawDesired.ServerCaCert = canonicalizeInstanceServerCaCert(rawDesired.ServerCaCert, nil, opts...)
+		rawDesired.OnPremisesConfiguration = canonicalizeInstanceOnPremisesConfiguration(rawDesired.OnPremisesConfiguration, nil, opts...)
+		rawDesired.DiskEncryptionStatus = canonicalizeInstanceDiskEncryptionStatus(rawDesired.DiskEncryptionStatus, nil, opts...)
+
+		return rawDesired, nil
+	}
+
+	canonicalDesired := &Instance{}
+	if dcl.IsZeroValue(rawDesired.BackendType) || (dcl.IsEmptyValueIndirect(rawDesired.BackendType) && dcl.IsEmptyValueIndirect(rawInitial.BackendType)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.BackendType = rawInitial.BackendType
+	} else {
+		canonicalDesired.BackendType = rawDesired.BackendType
+	}
+	if dcl.IsZeroValue(rawDesired.DatabaseVersion) || (dcl.IsEmptyValueIndirect(rawDesired.DatabaseVersion) && dcl.IsEmptyValueIndirect(rawInitial.DatabaseVersion)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.DatabaseVersion = rawInitial.DatabaseVersion
+	} else {
+		canonicalDesired.DatabaseVersion = rawDesired.DatabaseVersion
+	}
+	if dcl.IsZeroValue(rawDesired.InstanceType) || (dcl.IsEmptyValueIndirect(rawDesired.InstanceType) && dcl.IsEmptyValueIndirect(rawInitial.InstanceType)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.InstanceType = rawInitial.InstanceType
+	} else {
+		canonicalDesired.InstanceType = rawDesired.InstanceType
+	}
+	if dcl.StringCanonicalize(rawDesired.MasterInstanceName, rawInitial.MasterInstanceName) {
+		canonicalDesired.MasterInstanceName = rawInitial.MasterInstanceName
+	} else {
+		canonicalDesired.MasterInstanceName = rawDesired.MasterInstanceName
+	}
+	canonicalDesired.MaxDiskSize = canonicalizeInstanceMaxDiskSize(rawDesired.MaxDiskSize, rawInitial.MaxDiskSize, opts...)
+	if dcl.StringCanonicalize(rawDesired.Name, rawInitial.Name) {
+		canonicalDesired.Name = rawInitial.Name
+	} else {
+		canonicalDesired.Name = rawDesired.Name
+	}
+	if dcl.NameToSelfLink(rawDesired.Project, rawInitial.Project) {
+		canonicalDesired.Project = rawInitial.Project
+	} else {
+		canonicalDesired.Project = rawDesired.Project
+	}
+	if dcl.StringCanonicalize(rawDesired.Region, rawInitial.Region) {
+		canonicalDesired.Region = rawInitial.Region
+	} else {
+		canonicalDesired.Region = rawDesired.Region
+	}
+	if dcl.StringCanonicalize(rawDesired.RootPassword, rawInitial.RootPassword) {
+		canonicalDesired.RootPassword = rawInitial.RootPassword
+	} else {
+		canonicalDesired.RootPassword = rawDesired.RootPassword
+	}
+	canonicalDesired.DiskEncryptionConfiguration = canonicalizeInstanceDiskEncryptionConfiguration(rawDesired.DiskEncryptionConfiguration, rawInitial.DiskEncryptionConfiguration, opts...)
+	canonicalDesired.FailoverReplica = canonicalizeInstanceFailoverReplica(rawDesired.FailoverReplica, rawInitial.FailoverReplica, opts...)
+	canonicalDesired.MasterInstance = canonicalizeInstanceMasterInstance(rawDesired.MasterInstance, rawInitial.MasterInstance, opts...)
+	canonicalDesired.ReplicaConfiguration = canonicalizeInstanceReplicaConfiguration(rawDesired.ReplicaConfiguration, rawInitial.ReplicaConfiguration, opts...)
+	canonicalDesired.Settings = canonicalizeInstanceSettings(rawDesired.Settings, rawInitial.Settings, opts...)
+	canonicalDesired.OnPremisesConfiguration = canonicalizeInstanceOnPremisesConfiguration(rawDesired.OnPremisesConfiguration, rawInitial.OnPremisesConfiguration, opts...)
+
+	return canonicalDesired, nil
+}
+
+func canonicalizeInstanceNewState(c *Client, rawNew, rawDesired *Instance) (*Instance, error) {
+
+	if dcl.IsEmptyValueIndirect(rawNew.BackendType) && dcl.IsEmptyValueIndirect(rawDesired.BackendType) {
+		rawNew.BackendType = rawDesired.BackendType
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ConnectionName) && dcl.IsEmptyValueIndirect(rawDesired.ConnectionName) {
+		rawNew.ConnectionName = rawDesired.ConnectionName
+	} else {
+		if dcl.StringCanonicalize(rawDesired.ConnectionName, rawNew.ConnectionName) {
+			rawNew.ConnectionName = rawDesired.ConnectionName
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.DatabaseVersion) && dcl.IsEmptyValueIndirect(rawDesired.DatabaseVersion) {
+		rawNew.DatabaseVersion = rawDesired.DatabaseVersion
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Etag) && dcl.IsEmptyValueIndirect(rawDesired.Etag) {
+		rawNew.Etag = rawDesired.Etag
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Etag, rawNew.Etag) {
+			rawNew.Etag = rawDesired.Etag
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.GceZone) && dcl.IsEmptyValueIndirect(rawDesired.GceZone) {
+		rawNew.GceZone = rawDesired.GceZone
+	} else {
+		if dcl.StringCanonicalize(rawDesired.GceZone, rawNew.GceZone) {
+			rawNew.GceZone = rawDesired.GceZone
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.InstanceType) && dcl.IsEmptyValueIndirect(rawDesired.InstanceType) {
+		rawNew.InstanceType = rawDesired.InstanceType
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.MasterInstanceName) && dcl.IsEmptyValueIndirect(rawDesired.MasterInstanceName) {
+		rawNew.MasterInstanceName = rawDesired.MasterInstanceName
+	} else {
+		if dcl.StringCanonicalize(rawDesired.MasterInstanceName, rawNew.MasterInstanceName) {
+			rawNew.MasterInstanceName = rawDesired.MasterInstanceName
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.MaxDiskSize) && dcl.IsEmptyValueIndirect(rawDesired.MaxDiskSize) {
+		rawNew.MaxDiskSize = rawDesired.MaxDiskSize
+	} else {
+		rawNew.MaxDiskSize = canonicalizeNewInstanceMaxDiskSize(c, rawDesired.MaxDiskSize, rawNew.MaxDiskSize)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Name) && dcl.IsEmptyValueIndirect(rawDesired.Name) {
+		rawNew.Name = rawDesired.Name
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Name, rawNew.Name) {
+			rawNew.Name = rawDesired.Name
+		}
+	}
+
+	rawNew.Project = rawDesired.Project
+
+	if dcl.IsEmptyValueIndirect(rawNew.Region) && dcl.IsEmptyValueIndirect(rawDesired.Region) {
+		rawNew.Region = rawDesired.Region
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Region, rawNew.Region) {
+			rawNew.Region = rawDesired.Region
+		}
+	}
+
+	rawNew.RootPassword = rawDesired.RootPassword
+
+	if dcl.IsEmptyValueIndirect(rawNew.CurrentDiskSize) && dcl.IsEmptyValueIndirect(rawDesired.CurrentDiskSize) {
+		rawNew.CurrentDiskSize = rawDesired.CurrentDiskSize
+	} else {
+		rawNew.CurrentDiskSize = canonicalizeNewInstanceCurrentDiskSize(c, rawDesired.CurrentDiskSize, rawNew.CurrentDiskSize)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.DiskEncryptionConfiguration) && dcl.IsEmptyValueIndirect(rawDesired.DiskEncryptionConfiguration) {
+		rawNew.DiskEncryptionConfiguration = rawDesired.DiskEncryptionConfiguration
+	} else {
+		rawNew.DiskEncryptionConfiguration = canonicalizeNewInstanceDiskEncryptionConfiguration(c, rawDesired.DiskEncryptionConfiguration, rawNew.DiskEncryptionConfiguration)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.FailoverReplica) && dcl.IsEmptyValueIndirect(rawDesired.FailoverReplica) {
+		rawNew.FailoverReplica = rawDesired.FailoverReplica
+	} else {
+		rawNew.FailoverReplica = canonicalizeNewInstanceFailoverReplica(c, rawDesired.FailoverReplica, rawNew.FailoverReplica)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.IPAddresses) && dcl.IsEmptyValueIndirect(rawDesired.IPAddresses) {
+		rawNew.IPAddresses = rawDesired.IPAddresses
+	} else {
+		rawNew.IPAddresses = canonicalizeNewInstanceIPAddressesSlice(c, rawDesired.IPAddresses, rawNew.IPAddresses)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.MasterInstance) && dcl.IsEmptyValueIndirect(rawDesired.MasterInstance) {
+		rawNew.MasterInstance = rawDesired.MasterInstance
+	} else {
+		rawNew.MasterInstance = canonicalizeNewInstanceMasterInstance(c, rawDesired.MasterInstance, rawNew.MasterInstance)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ReplicaConfiguration) && dcl.IsEmptyValueIndirect(rawDesired.ReplicaConfiguration) {
+		rawNew.ReplicaConfiguration = rawDesired.ReplicaConfiguration
+	} else {
+		rawNew.ReplicaConfiguration = canonicalizeNewInstanceReplicaConfiguration(c, rawDesired.ReplicaConfiguration, rawNew.ReplicaConfiguration)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ScheduledMaintenance) && dcl.IsEmptyValueIndirect(rawDesired.ScheduledMaintenance) {
+		rawNew.ScheduledMaintenance = rawDesired.ScheduledMaintenance
+	} else {
+		rawNew.ScheduledMaintenance = canonicalizeNewInstanceScheduledMaintenance(c, rawDesired.ScheduledMaintenance, rawNew.ScheduledMaintenance)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Settings) && dcl.IsEmptyValueIndirect(rawDesired.Settings) {
+		rawNew.Settings = rawDesired.Settings
+	} else {
+		rawNew.Settings = canonicalizeNewInstanceSettings(c, rawDesired.Settings, rawNew.Settings)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.State) && dcl.IsEmptyValueIndirect(rawDesired.State) {
+		rawNew.State = rawDesired.State
+	} else {
+		if dcl.StringCanonicalize(rawDesired.State, rawNew.State) {
+			rawNew.State = rawDesired.State
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ReplicaInstances) && dcl.IsEmptyValueIndirect(rawDesired.ReplicaInstances) {
+		rawNew.ReplicaInstances = rawDesired.ReplicaInstances
+	} else {
+		rawNew.ReplicaInstances = canonicalizeNewInstanceReplicaInstancesSlice(c, rawDesired.ReplicaInstances, rawNew.ReplicaInstances)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ServerCaCert) && dcl.IsEmptyValueIndirect(rawDesired.ServerCaCert) {
+		rawNew.ServerCaCert = rawDesired.ServerCaCert
+	} else {
+		rawNew.ServerCaCert = canonicalizeNewInstanceServerCaCert(c, rawDesired.ServerCaCert, rawNew.ServerCaCert)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.IPv6Address) && dcl.IsEmptyValueIndirect(rawDesired.IPv6Address) {
+		rawNew.IPv6Address = rawDesired.IPv6Address
+	} else {
+		if dcl.StringCanonicalize(rawDesired.IPv6Address, rawNew.IPv6Address) {
+			rawNew.IPv6Address = rawDesired.IPv6Address
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ServiceAccountEmailAddress) && dcl.IsEmptyValueIndirect(rawDesired.ServiceAccountEmailAddress) {
+		rawNew.ServiceAccountEmailAddress = rawDesired.ServiceAccountEmailAddress
+	} else {
+		if dcl.StringCanonicalize(rawDesired.ServiceAccountEmailAddress, rawNew.ServiceAccountEmailAddress) {
+			rawNew.ServiceAccountEmailAddress = rawDesired.ServiceAccountEmailAddress
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.OnPremisesConfiguration) && dcl.IsEmptyValueIndirect(rawDesired.OnPremisesConfiguration) {
+		rawNew.OnPremisesConfiguration = rawDesired.OnPremisesConfiguration
+	} else {
+		rawNew.OnPremisesConfiguration = canonicalizeNewInstanceOnPremisesConfiguration(c, rawDesired.OnPremisesConfiguration, rawNew.OnPremisesConfiguration)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.SuspensionReason) && dcl.IsEmptyValueIndirect(rawDesired.SuspensionReason) {
+		rawNew.SuspensionReason = rawDesired.SuspensionReason
+	} else {
+		if dcl.StringArrayCanonicalize(rawDesired.SuspensionReason, rawNew.SuspensionReason) {
+			rawNew.SuspensionReason = rawDesired.SuspensionReason
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.DiskEncryptionStatus) && dcl.IsEmptyValueIndirect(rawDesired.DiskEncryptionStatus) {
+		rawNew.DiskEncryptionStatus = rawDesired.DiskEncryptionStatus
+	} else {
+		rawNew.DiskEncryptionStatus = canonicalizeNewInstanceDiskEncryptionStatus(c, rawDesired.DiskEncryptionStatus, rawNew.DiskEncryptionStatus)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.InstanceUid) && dcl.IsEmptyValueIndirect(rawDesired.InstanceUid) {
+		rawNew.InstanceUid = rawDesired.InstanceUid
+	} else {
+		if dcl.StringCanonicalize(rawDesired.InstanceUid, rawNew.InstanceUid) {
+			rawNew.InstanceUid = rawDesired.InstanceUid
+		}
+	}
+
+	return rawNew, nil
+}
+
+func canonicalizeInstanceMaxDiskSize(des, initial *InstanceMaxDiskSize, opts ...dcl.ApplyOption) *InstanceMaxDiskSize {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceMaxDiskSize{}
+
+	if dcl.IsZeroValue(des.Value) || (dcl.IsEmptyValueIndirect(des.Value) && dcl.IsEmptyValueIndirect(initial.Value)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Value = initial.Value
+	} else {
+		cDes.Value = des.Value
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceMaxDiskSizeSlice(des, initial []InstanceMaxDiskSize, opts ...dcl.ApplyOption) []InstanceMaxDiskSize {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceMaxDiskSize, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceMaxDiskSize(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceMaxDiskSize, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceMaxDiskSize(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceMaxDiskSize(c *Client, des, nw *InstanceMaxDiskSize) *InstanceMaxDiskSize {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceMaxDiskSize while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceMaxDiskSizeSet(c *Client, des, nw []InstanceMaxDiskSize) []InstanceMaxDiskSize {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceMaxDiskSize
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceMaxDiskSizeNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceMaxDiskSizeSlice(c *Client, des, nw []InstanceMaxDiskSize) []InstanceMaxDiskSize {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceMaxDiskSize
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceMaxDiskSize(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceCurrentDiskSize(des, initial *InstanceCurrentDiskSize, opts ...dcl.ApplyOption) *InstanceCurrentDiskSize {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceCurrentDiskSize{}
+
+	return cDes
+}
+
+func canonicalizeInstanceCurrentDiskSizeSlice(des, initial []InstanceCurrentDiskSize, opts ...dcl.ApplyOption) []InstanceCurrentDiskSize {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceCurrentDiskSize, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceCurrentDiskSize(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceCurrentDiskSize, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceCurrentDiskSize(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceCurrentDiskSize(c *Client, des, nw *InstanceCurrentDiskSize) *InstanceCurrentDiskSize {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceCurrentDiskSize while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceCurrentDiskSizeSet(c *Client, des, nw []InstanceCurrentDiskSize) []InstanceCurrentDiskSize {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceCurrentDiskSize
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceCurrentDiskSizeNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceCurrentDiskSizeSlice(c *Client, des, nw []InstanceCurrentDiskSize) []InstanceCurrentDiskSize {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceCurrentDiskSize
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceCurrentDiskSize(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceDiskEncryptionConfiguration(des, initial *InstanceDiskEncryptionConfiguration, opts ...dcl.ApplyOption) *InstanceDiskEncryptionConfiguration {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceDiskEncryptionConfiguration{}
+
+	if dcl.StringCanonicalize(des.KmsKeyName, initial.KmsKeyName) || dcl.IsZeroValue(des.KmsKeyName) {
+		cDes.KmsKeyName = initial.KmsKeyName
+	} else {
+		cDes.KmsKeyName = des.KmsKeyName
+	}
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceDiskEncryptionConfigurationSlice(des, initial []InstanceDiskEncryptionConfiguration, opts ...dcl.ApplyOption) []InstanceDiskEncryptionConfiguration {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceDiskEncryptionConfiguration, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceDiskEncryptionConfiguration(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceDiskEncryptionConfiguration, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceDiskEncryptionConfiguration(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceDiskEncryptionConfiguration(c *Client, des, nw *InstanceDiskEncryptionConfiguration) *InstanceDiskEncryptionConfiguration {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceDiskEncryptionConfiguration while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.KmsKeyName, nw.KmsKeyName) {
+		nw.KmsKeyName = des.KmsKeyName
+	}
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceDiskEncryptionConfigurationSet(c *Client, des, nw []InstanceDiskEncryptionConfiguration) []InstanceDiskEncryptionConfiguration {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceDiskEncryptionConfiguration
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceDiskEncryptionConfigurationNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceDiskEncryptionConfigurationSlice(c *Client, des, nw []InstanceDiskEncryptionConfiguration) []InstanceDiskEncryptionConfiguration {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceDiskEncryptionConfiguration
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceDiskEncryptionConfiguration(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceFailoverReplica(des, initial *InstanceFailoverReplica, opts ...dcl.ApplyOption) *InstanceFailoverReplica {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceFailoverReplica{}
+
+	if dcl.StringCanonicalize(des.Name, initial.Name) || dcl.IsZeroValue(des.Name) {
+		cDes.Name = initial.Name
+	} else {
+		cDes.Name = des.Name
+	}
+	cDes.FailoverInstance = canonicalizeInstanceFailoverReplicaFailoverInstance(des.FailoverInstance, initial.FailoverInstance, opts...)
+
+	return cDes
+}
+
+func canonicalizeInstanceFailoverReplicaSlice(des, initial []InstanceFailoverReplica, opts ...dcl.ApplyOption) []InstanceFailoverReplica {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceFailoverReplica, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceFailoverReplica(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceFailoverReplica, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceFailoverReplica(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceFailoverReplica(c *Client, des, nw *InstanceFailoverReplica) *InstanceFailoverReplica {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceFailoverReplica while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Name, nw.Name) {
+		nw.Name = des.Name
+	}
+	if dcl.BoolCanonicalize(des.Available, nw.Available) {
+		nw.Available = des.Available
+	}
+	nw.FailoverInstance = canonicalizeNewInstanceFailoverReplicaFailoverInstance(c, des.FailoverInstance, nw.FailoverInstance)
+
+	return nw
+}
+
+func canonicalizeNewInstanceFailoverReplicaSet(c *Client, des, nw []InstanceFailoverReplica) []InstanceFailoverReplica {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceFailoverReplica
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceFailoverReplicaNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceFailoverReplicaSlice(c *Client, des, nw []InstanceFailoverReplica) []InstanceFailoverReplica {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceFailoverReplica
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceFailoverReplica(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceFailoverReplicaFailoverInstance(des, initial *InstanceFailoverReplicaFailoverInstance, opts ...dcl.ApplyOption) *InstanceFailoverReplicaFailoverInstance {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceFailoverReplicaFailoverInstance{}
+
+	if dcl.StringCanonicalize(des.Name, initial.Name) || dcl.IsZeroValue(des.Name) {
+		cDes.Name = initial.Name
+	} else {
+		cDes.Name = des.Name
+	}
+	if dcl.StringCanonicalize(des.Region, initial.Region) || dcl.IsZeroValue(des.Region) {
+		cDes.Region = initial.Region
+	} else {
+		cDes.Region = des.Region
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceFailoverReplicaFailoverInstanceSlice(des, initial []InstanceFailoverReplicaFailoverInstance, opts ...dcl.ApplyOption) []InstanceFailoverReplicaFailoverInstance {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceFailoverReplicaFailoverInstance, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceFailoverReplicaFailoverInstance(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceFailoverReplicaFailoverInstance, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceFailoverReplicaFailoverInstance(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceFailoverReplicaFailoverInstance(c *Client, des, nw *InstanceFailoverReplicaFailoverInstance) *InstanceFailoverReplicaFailoverInstance {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceFailoverReplicaFailoverInstance while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Name, nw.Name) {
+		nw.Name = des.Name
+	}
+	if dcl.StringCanonicalize(des.Region, nw.Region) {
+		nw.Region = des.Region
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceFailoverReplicaFailoverInstanceSet(c *Client, des, nw []InstanceFailoverReplicaFailoverInstance) []InstanceFailoverReplicaFailoverInstance {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceFailoverReplicaFailoverInstance
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceFailoverReplicaFailoverInstanceNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceFailoverReplicaFailoverInstanceSlice(c *Client, des, nw []InstanceFailoverReplicaFailoverInstance) []InstanceFailoverReplicaFailoverInstance {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceFailoverReplicaFailoverInstance
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceFailoverReplicaFailoverInstance(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceIPAddresses(des, initial *InstanceIPAddresses, opts ...dcl.ApplyOption) *InstanceIPAddresses {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceIPAddresses{}
+
+	return cDes
+}
+
+func canonicalizeInstanceIPAddressesSlice(des, initial []InstanceIPAddresses, opts ...dcl.ApplyOption) []InstanceIPAddresses {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceIPAddresses, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceIPAddresses(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceIPAddresses, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceIPAddresses(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceIPAddresses(c *Client, des, nw *InstanceIPAddresses) *InstanceIPAddresses {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceIPAddresses while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.IPAddress, nw.IPAddress) {
+		nw.IPAddress = des.IPAddress
+	}
+	nw.TimeToRetire = canonicalizeNewInstanceIPAddressesTimeToRetire(c, des.TimeToRetire, nw.TimeToRetire)
+
+	return nw
+}
+
+func canonicalizeNewInstanceIPAddressesSet(c *Client, des, nw []InstanceIPAddresses) []InstanceIPAddresses {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceIPAddresses
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceIPAddressesNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceIPAddressesSlice(c *Client, des, nw []InstanceIPAddresses) []InstanceIPAddresses {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceIPAddresses
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceIPAddresses(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceIPAddressesTimeToRetire(des, initial *InstanceIPAddressesTimeToRetire, opts ...dcl.ApplyOption) *InstanceIPAddressesTimeToRetire {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceIPAddressesTimeToRetire{}
+
+	return cDes
+}
+
+func canonicalizeInstanceIPAddressesTimeToRetireSlice(des, initial []InstanceIPAddressesTimeToRetire, opts ...dcl.ApplyOption) []InstanceIPAddressesTimeToRetire {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceIPAddressesTimeToRetire, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceIPAddressesTimeToRetire(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceIPAddressesTimeToRetire, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceIPAddressesTimeToRetire(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceIPAddressesTimeToRetire(c *Client, des, nw *InstanceIPAddressesTimeToRetire) *InstanceIPAddressesTimeToRetire {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceIPAddressesTimeToRetire while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceIPAddressesTimeToRetireSet(c *Client, des, nw []InstanceIPAddressesTimeToRetire) []InstanceIPAddressesTimeToRetire {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceIPAddressesTimeToRetire
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceIPAddressesTimeToRetireNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceIPAddressesTimeToRetireSlice(c *Client, des, nw []InstanceIPAddressesTimeToRetire) []InstanceIPAddressesTimeToRetire {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceIPAddressesTimeToRetire
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceIPAddressesTimeToRetire(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceMasterInstance(des, initial *InstanceMasterInstance, opts ...dcl.ApplyOption) *InstanceMasterInstance {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceMasterInstance{}
+
+	if dcl.StringCanonicalize(des.Name, initial.Name) || dcl.IsZeroValue(des.Name) {
+		cDes.Name = initial.Name
+	} else {
+		cDes.Name = des.Name
+	}
+	if dcl.StringCanonicalize(des.Region, initial.Region) || dcl.IsZeroValue(des.Region) {
+		cDes.Region = initial.Region
+	} else {
+		cDes.Region = des.Region
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceMasterInstanceSlice(des, initial []InstanceMasterInstance, opts ...dcl.ApplyOption) []InstanceMasterInstance {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceMasterInstance, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceMasterInstance(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceMasterInstance, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceMasterInstance(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceMasterInstance(c *Client, des, nw *InstanceMasterInstance) *InstanceMasterInstance {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceMasterInstance while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Name, nw.Name) {
+		nw.Name = des.Name
+	}
+	if dcl.StringCanonicalize(des.Region, nw.Region) {
+		nw.Region = des.Region
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceMasterInstanceSet(c *Client, des, nw []InstanceMasterInstance) []InstanceMasterInstance {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceMasterInstance
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceMasterInstanceNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceMasterInstanceSlice(c *Client, des, nw []InstanceMasterInstance) []InstanceMasterInstance {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceMasterInstance
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceMasterInstance(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceReplicaConfiguration(des, initial *InstanceReplicaConfiguration, opts ...dcl.ApplyOption) *InstanceReplicaConfiguration {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceReplicaConfiguration{}
+
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+	cDes.MysqlReplicaConfiguration = canonicalizeInstanceReplicaConfigurationMysqlReplicaConfiguration(des.MysqlReplicaConfiguration, initial.MysqlReplicaConfiguration, opts...)
+	if dcl.BoolCanonicalize(des.FailoverTarget, initial.FailoverTarget) || dcl.IsZeroValue(des.FailoverTarget) {
+		cDes.FailoverTarget = initial.FailoverTarget
+	} else {
+		cDes.FailoverTarget = des.FailoverTarget
+	}
+	cDes.ReplicaPoolConfiguration = canonicalizeInstanceReplicaConfigurationReplicaPoolConfiguration(des.ReplicaPoolConfiguration, initial.ReplicaPoolConfiguration, opts...)
+
+	return cDes
+}
+
+func canonicalizeInstanceReplicaConfigurationSlice(des, initial []InstanceReplicaConfiguration, opts ...dcl.ApplyOption) []InstanceReplicaConfiguration {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceReplicaConfiguration, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceReplicaConfiguration(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceReplicaConfiguration, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceReplicaConfiguration(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceReplicaConfiguration(c *Client, des, nw *InstanceReplicaConfiguration) *InstanceReplicaConfiguration {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceReplicaConfiguration while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+	nw.MysqlReplicaConfiguration = canonicalizeNewInstanceReplicaConfigurationMysqlReplicaConfiguration(c, des.MysqlReplicaConfiguration, nw.MysqlReplicaConfiguration)
+	if dcl.BoolCanonicalize(des.FailoverTarget, nw.FailoverTarget) {
+		nw.FailoverTarget = des.FailoverTarget
+	}
+	nw.ReplicaPoolConfiguration = canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfiguration(c, des.ReplicaPoolConfiguration, nw.ReplicaPoolConfiguration)
+
+	return nw
+}
+
+func canonicalizeNewInstanceReplicaConfigurationSet(c *Client, des, nw []InstanceReplicaConfiguration) []InstanceReplicaConfiguration {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceReplicaConfiguration
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceReplicaConfigurationNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceReplicaConfigurationSlice(c *Client, des, nw []InstanceReplicaConfiguration) []InstanceReplicaConfiguration {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceReplicaConfiguration
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceReplicaConfiguration(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceReplicaConfigurationMysqlReplicaConfiguration(des, initial *InstanceReplicaConfigurationMysqlReplicaConfiguration, opts ...dcl.ApplyOption) *InstanceReplicaConfigurationMysqlReplicaConfiguration {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceReplicaConfigurationMysqlReplicaConfiguration{}
+
+	if dcl.StringCanonicalize(des.DumpFilePath, initial.DumpFilePath) || dcl.IsZeroValue(des.DumpFilePath) {
+		cDes.DumpFilePath = initial.DumpFilePath
+	} else {
+		cDes.DumpFilePath = des.DumpFilePath
+	}
+	if dcl.StringCanonicalize(des.Username, initial.Username) || dcl.IsZeroValue(des.Username) {
+		cDes.Username = initial.Username
+	} else {
+		cDes.Username = des.Username
+	}
+	if dcl.StringCanonicalize(des.Password, initial.Password) || dcl.IsZeroValue(des.Password) {
+		cDes.Password = initial.Password
+	} else {
+		cDes.Password = des.Password
+	}
+	if dcl.IsZeroValue(des.ConnectRetryInterval) || (dcl.IsEmptyValueIndirect(des.ConnectRetryInterval) && dcl.IsEmptyValueIndirect(initial.ConnectRetryInterval)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.ConnectRetryInterval = initial.ConnectRetryInterval
+	} else {
+		cDes.ConnectRetryInterval = des.ConnectRetryInterval
+	}
+	cDes.MasterHeartbeatPeriod = canonicalizeInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(des.MasterHeartbeatPeriod, initial.MasterHeartbeatPeriod, opts...)
+	if dcl.StringCanonicalize(des.CaCertificate, initial.CaCertificate) || dcl.IsZeroValue(des.CaCertificate) {
+		cDes.CaCertificate = initial.CaCertificate
+	} else {
+		cDes.CaCertificate = des.CaCertificate
+	}
+	if dcl.StringCanonicalize(des.ClientCertificate, initial.ClientCertificate) || dcl.IsZeroValue(des.ClientCertificate) {
+		cDes.ClientCertificate = initial.ClientCertificate
+	} else {
+		cDes.ClientCertificate = des.ClientCertificate
+	}
+	if dcl.StringCanonicalize(des.ClientKey, initial.ClientKey) || dcl.IsZeroValue(des.ClientKey) {
+		cDes.ClientKey = initial.ClientKey
+	} else {
+		cDes.ClientKey = des.ClientKey
+	}
+	if dcl.StringCanonicalize(des.SslCipher, initial.SslCipher) || dcl.IsZeroValue(des.SslCipher) {
+		cDes.SslCipher = initial.SslCipher
+	} else {
+		cDes.SslCipher = des.SslCipher
+	}
+	if dcl.BoolCanonicalize(des.VerifyServerCertificate, initial.VerifyServerCertificate) || dcl.IsZeroValue(des.VerifyServerCertificate) {
+		cDes.VerifyServerCertificate = initial.VerifyServerCertificate
+	} else {
+		cDes.VerifyServerCertificate = des.VerifyServerCertificate
+	}
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceReplicaConfigurationMysqlReplicaConfigurationSlice(des, initial []InstanceReplicaConfigurationMysqlReplicaConfiguration, opts ...dcl.ApplyOption) []InstanceReplicaConfigurationMysqlReplicaConfiguration {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceReplicaConfigurationMysqlReplicaConfiguration, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceReplicaConfigurationMysqlReplicaConfiguration(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceReplicaConfigurationMysqlReplicaConfiguration, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceReplicaConfigurationMysqlReplicaConfiguration(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceReplicaConfigurationMysqlReplicaConfiguration(c *Client, des, nw *InstanceReplicaConfigurationMysqlReplicaConfiguration) *InstanceReplicaConfigurationMysqlReplicaConfiguration {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceReplicaConfigurationMysqlReplicaConfiguration while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.DumpFilePath, nw.DumpFilePath) {
+		nw.DumpFilePath = des.DumpFilePath
+	}
+	if dcl.StringCanonicalize(des.Username, nw.Username) {
+		nw.Username = des.Username
+	}
+	nw.Password = des.Password
+	nw.MasterHeartbeatPeriod = canonicalizeNewInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(c, des.MasterHeartbeatPeriod, nw.MasterHeartbeatPeriod)
+	if dcl.StringCanonicalize(des.CaCertificate, nw.CaCertificate) {
+		nw.CaCertificate = des.CaCertificate
+	}
+	if dcl.StringCanonicalize(des.ClientCertificate, nw.ClientCertificate) {
+		nw.ClientCertificate = des.ClientCertificate
+	}
+	nw.ClientKey = des.ClientKey
+	if dcl.StringCanonicalize(des.SslCipher, nw.SslCipher) {
+		nw.SslCipher = des.SslCipher
+	}
+	if dcl.BoolCanonicalize(des.VerifyServerCertificate, nw.VerifyServerCertificate) {
+		nw.VerifyServerCertificate = des.VerifyServerCertificate
+	}
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceReplicaConfigurationMysqlReplicaConfigurationSet(c *Client, des, nw []InstanceReplicaConfigurationMysqlReplicaConfiguration) []InstanceReplicaConfigurationMysqlReplicaConfiguration {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceReplicaConfigurationMysqlReplicaConfiguration
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceReplicaConfigurationMysqlReplicaConfigurationNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceReplicaConfigurationMysqlReplicaConfigurationSlice(c *Client, des, nw []InstanceReplicaConfigurationMysqlReplicaConfiguration) []InstanceReplicaConfigurationMysqlReplicaConfiguration {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceReplicaConfigurationMysqlReplicaConfiguration
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceReplicaConfigurationMysqlReplicaConfiguration(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(des, initial *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod, opts ...dcl.ApplyOption) *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod{}
+
+	if dcl.IsZeroValue(des.Value) || (dcl.IsEmptyValueIndirect(des.Value) && dcl.IsEmptyValueIndirect(initial.Value)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Value = initial.Value
+	} else {
+		cDes.Value = des.Value
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodSlice(des, initial []InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod, opts ...dcl.ApplyOption) []InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(c *Client, des, nw *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod) *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodSet(c *Client, des, nw []InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod) []InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodSlice(c *Client, des, nw []InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod) []InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceReplicaConfigurationReplicaPoolConfiguration(des, initial *InstanceReplicaConfigurationReplicaPoolConfiguration, opts ...dcl.ApplyOption) *InstanceReplicaConfigurationReplicaPoolConfiguration {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceReplicaConfigurationReplicaPoolConfiguration{}
+
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+	cDes.StaticPoolConfiguration = canonicalizeInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(des.StaticPoolConfiguration, initial.StaticPoolConfiguration, opts...)
+	cDes.AutoscalingPoolConfiguration = canonicalizeInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(des.AutoscalingPoolConfiguration, initial.AutoscalingPoolConfiguration, opts...)
+	if dcl.IsZeroValue(des.ReplicaCount) || (dcl.IsEmptyValueIndirect(des.ReplicaCount) && dcl.IsEmptyValueIndirect(initial.ReplicaCount)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.ReplicaCount = initial.ReplicaCount
+	} else {
+		cDes.ReplicaCount = des.ReplicaCount
+	}
+	if dcl.BoolCanonicalize(des.ExposeReplicaIP, initial.ExposeReplicaIP) || dcl.IsZeroValue(des.ExposeReplicaIP) {
+		cDes.ExposeReplicaIP = initial.ExposeReplicaIP
+	} else {
+		cDes.ExposeReplicaIP = des.ExposeReplicaIP
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceReplicaConfigurationReplicaPoolConfigurationSlice(des, initial []InstanceReplicaConfigurationReplicaPoolConfiguration, opts ...dcl.ApplyOption) []InstanceReplicaConfigurationReplicaPoolConfiguration {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceReplicaConfigurationReplicaPoolConfiguration, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceReplicaConfigurationReplicaPoolConfiguration(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceReplicaConfigurationReplicaPoolConfiguration, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceReplicaConfigurationReplicaPoolConfiguration(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfiguration(c *Client, des, nw *InstanceReplicaConfigurationReplicaPoolConfiguration) *InstanceReplicaConfigurationReplicaPoolConfiguration {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceReplicaConfigurationReplicaPoolConfiguration while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+	nw.StaticPoolConfiguration = canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(c, des.StaticPoolConfiguration, nw.StaticPoolConfiguration)
+	nw.AutoscalingPoolConfiguration = canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(c, des.AutoscalingPoolConfiguration, nw.AutoscalingPoolConfiguration)
+	if dcl.BoolCanonicalize(des.ExposeReplicaIP, nw.ExposeReplicaIP) {
+		nw.ExposeReplicaIP = des.ExposeReplicaIP
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfigurationSet(c *Client, des, nw []InstanceReplicaConfigurationReplicaPoolConfiguration) []InstanceReplicaConfigurationReplicaPoolConfiguration {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceReplicaConfigurationReplicaPoolConfiguration
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceReplicaConfigurationReplicaPoolConfigurationNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfigurationSlice(c *Client, des, nw []InstanceReplicaConfigurationReplicaPoolConfiguration) []InstanceReplicaConfigurationReplicaPoolConfiguration {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceReplicaConfigurationReplicaPoolConfiguration
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfiguration(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(des, initial *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration, opts ...dcl.ApplyOption) *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration{}
+
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+	if dcl.IsZeroValue(des.ReplicaCount) || (dcl.IsEmptyValueIndirect(des.ReplicaCount) && dcl.IsEmptyValueIndirect(initial.ReplicaCount)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.ReplicaCount = initial.ReplicaCount
+	} else {
+		cDes.ReplicaCount = des.ReplicaCount
+	}
+	if dcl.BoolCanonicalize(des.ExposeReplicaIP, initial.ExposeReplicaIP) || dcl.IsZeroValue(des.ExposeReplicaIP) {
+		cDes.ExposeReplicaIP = initial.ExposeReplicaIP
+	} else {
+		cDes.ExposeReplicaIP = des.ExposeReplicaIP
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationSlice(des, initial []InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration, opts ...dcl.ApplyOption) []InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(c *Client, des, nw *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration) *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+	if dcl.BoolCanonicalize(des.ExposeReplicaIP, nw.ExposeReplicaIP) {
+		nw.ExposeReplicaIP = des.ExposeReplicaIP
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationSet(c *Client, des, nw []InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration) []InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationSlice(c *Client, des, nw []InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration) []InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(des, initial *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration, opts ...dcl.ApplyOption) *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration{}
+
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+	if dcl.IsZeroValue(des.MinReplicaCount) || (dcl.IsEmptyValueIndirect(des.MinReplicaCount) && dcl.IsEmptyValueIndirect(initial.MinReplicaCount)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.MinReplicaCount = initial.MinReplicaCount
+	} else {
+		cDes.MinReplicaCount = des.MinReplicaCount
+	}
+	if dcl.IsZeroValue(des.MaxReplicaCount) || (dcl.IsEmptyValueIndirect(des.MaxReplicaCount) && dcl.IsEmptyValueIndirect(initial.MaxReplicaCount)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.MaxReplicaCount = initial.MaxReplicaCount
+	} else {
+		cDes.MaxReplicaCount = des.MaxReplicaCount
+	}
+	if dcl.IsZeroValue(des.TargetCpuUtil) || (dcl.IsEmptyValueIndirect(des.TargetCpuUtil) && dcl.IsEmptyValueIndirect(initial.TargetCpuUtil)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.TargetCpuUtil = initial.TargetCpuUtil
+	} else {
+		cDes.TargetCpuUtil = des.TargetCpuUtil
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationSlice(des, initial []InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration, opts ...dcl.ApplyOption) []InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(c *Client, des, nw *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration) *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationSet(c *Client, des, nw []InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration) []InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationSlice(c *Client, des, nw []InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration) []InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceScheduledMaintenance(des, initial *InstanceScheduledMaintenance, opts ...dcl.ApplyOption) *InstanceScheduledMaintenance {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceScheduledMaintenance{}
+
+	return cDes
+}
+
+func canonicalizeInstanceScheduledMaintenanceSlice(des, initial []InstanceScheduledMaintenance, opts ...dcl.ApplyOption) []InstanceScheduledMaintenance {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceScheduledMaintenance, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceScheduledMaintenance(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceScheduledMaintenance, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceScheduledMaintenance(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceScheduledMaintenance(c *Client, des, nw *InstanceScheduledMaintenance) *InstanceScheduledMaintenance {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceScheduledMaintenance while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	nw.StartTime = canonicalizeNewInstanceScheduledMaintenanceStartTime(c, des.StartTime, nw.StartTime)
+	if dcl.BoolCanonicalize(des.CanDefer, nw.CanDefer) {
+		nw.CanDefer = des.CanDefer
+	}
+	if dcl.BoolCanonicalize(des.CanReschedule, nw.CanReschedule) {
+		nw.CanReschedule = des.CanReschedule
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceScheduledMaintenanceSet(c *Client, des, nw []InstanceScheduledMaintenance) []InstanceScheduledMaintenance {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceScheduledMaintenance
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceScheduledMaintenanceNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceScheduledMaintenanceSlice(c *Client, des, nw []InstanceScheduledMaintenance) []InstanceScheduledMaintenance {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceScheduledMaintenance
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceScheduledMaintenance(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceScheduledMaintenanceStartTime(des, initial *InstanceScheduledMaintenanceStartTime, opts ...dcl.ApplyOption) *InstanceScheduledMaintenanceStartTime {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceScheduledMaintenanceStartTime{}
+
+	return cDes
+}
+
+func canonicalizeInstanceScheduledMaintenanceStartTimeSlice(des, initial []InstanceScheduledMaintenanceStartTime, opts ...dcl.ApplyOption) []InstanceScheduledMaintenanceStartTime {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceScheduledMaintenanceStartTime, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceScheduledMaintenanceStartTime(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceScheduledMaintenanceStartTime, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceScheduledMaintenanceStartTime(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceScheduledMaintenanceStartTime(c *Client, des, nw *InstanceScheduledMaintenanceStartTime) *InstanceScheduledMaintenanceStartTime {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceScheduledMaintenanceStartTime while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceScheduledMaintenanceStartTimeSet(c *Client, des, nw []InstanceScheduledMaintenanceStartTime) []InstanceScheduledMaintenanceStartTime {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceScheduledMaintenanceStartTime
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceScheduledMaintenanceStartTimeNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceScheduledMaintenanceStartTimeSlice(c *Client, des, nw []InstanceScheduledMaintenanceStartTime) []InstanceScheduledMaintenanceStartTime {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceScheduledMaintenanceStartTime
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceScheduledMaintenanceStartTime(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettings(des, initial *InstanceSettings, opts ...dcl.ApplyOption) *InstanceSettings {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettings{}
+
+	if dcl.StringArrayCanonicalize(des.AuthorizedGaeApplications, initial.AuthorizedGaeApplications) {
+		cDes.AuthorizedGaeApplications = initial.AuthorizedGaeApplications
+	} else {
+		cDes.AuthorizedGaeApplications = des.AuthorizedGaeApplications
+	}
+	if dcl.StringCanonicalize(des.Tier, initial.Tier) || dcl.IsZeroValue(des.Tier) {
+		cDes.Tier = initial.Tier
+	} else {
+		cDes.Tier = des.Tier
+	}
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+	if dcl.IsZeroValue(des.AvailabilityType) || (dcl.IsEmptyValueIndirect(des.AvailabilityType) && dcl.IsEmptyValueIndirect(initial.AvailabilityType)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.AvailabilityType = initial.AvailabilityType
+	} else {
+		cDes.AvailabilityType = des.AvailabilityType
+	}
+	if dcl.IsZeroValue(des.PricingPlan) || (dcl.IsEmptyValueIndirect(des.PricingPlan) && dcl.IsEmptyValueIndirect(initial.PricingPlan)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.PricingPlan = initial.PricingPlan
+	} else {
+		cDes.PricingPlan = des.PricingPlan
+	}
+	if dcl.IsZeroValue(des.ReplicationType) || (dcl.IsEmptyValueIndirect(des.ReplicationType) && dcl.IsEmptyValueIndirect(initial.ReplicationType)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.ReplicationType = initial.ReplicationType
+	} else {
+		cDes.ReplicationType = des.ReplicationType
+	}
+	if dcl.IsZeroValue(des.ActivationPolicy) || (dcl.IsEmptyValueIndirect(des.ActivationPolicy) && dcl.IsEmptyValueIndirect(initial.ActivationPolicy)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.ActivationPolicy = initial.ActivationPolicy
+	} else {
+		cDes.ActivationPolicy = des.ActivationPolicy
+	}
+	if dcl.BoolCanonicalize(des.StorageAutoResize, initial.StorageAutoResize) || dcl.IsZeroValue(des.StorageAutoResize) {
+		cDes.StorageAutoResize = initial.StorageAutoResize
+	} else {
+		cDes.StorageAutoResize = des.StorageAutoResize
+	}
+	if dcl.IsZeroValue(des.DataDiskType) || (dcl.IsEmptyValueIndirect(des.DataDiskType) && dcl.IsEmptyValueIndirect(initial.DataDiskType)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.DataDiskType = initial.DataDiskType
+	} else {
+		cDes.DataDiskType = des.DataDiskType
+	}
+	if dcl.BoolCanonicalize(des.DatabaseReplicationEnabled, initial.DatabaseReplicationEnabled) || dcl.IsZeroValue(des.DatabaseReplicationEnabled) {
+		cDes.DatabaseReplicationEnabled = initial.DatabaseReplicationEnabled
+	} else {
+		cDes.DatabaseReplicationEnabled = des.DatabaseReplicationEnabled
+	}
+	if dcl.BoolCanonicalize(des.CrashSafeReplicationEnabled, initial.CrashSafeReplicationEnabled) || dcl.IsZeroValue(des.CrashSafeReplicationEnabled) {
+		cDes.CrashSafeReplicationEnabled = initial.CrashSafeReplicationEnabled
+	} else {
+		cDes.CrashSafeReplicationEnabled = des.CrashSafeReplicationEnabled
+	}
+	if dcl.IsZeroValue(des.UserLabels) || (dcl.IsEmptyValueIndirect(des.UserLabels) && dcl.IsEmptyValueIndirect(initial.UserLabels)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.UserLabels = initial.UserLabels
+	} else {
+		cDes.UserLabels = des.UserLabels
+	}
+	cDes.StorageAutoResizeLimit = canonicalizeInstanceSettingsStorageAutoResizeLimit(des.StorageAutoResizeLimit, initial.StorageAutoResizeLimit, opts...)
+	cDes.IPConfiguration = canonicalizeInstanceSettingsIPConfiguration(des.IPConfiguration, initial.IPConfiguration, opts...)
+	cDes.LocationPreference = canonicalizeInstanceSettingsLocationPreference(des.LocationPreference, initial.LocationPreference, opts...)
+	cDes.DatabaseFlags = canonicalizeInstanceSettingsDatabaseFlagsSlice(des.DatabaseFlags, initial.DatabaseFlags, opts...)
+	cDes.MaintenanceWindow = canonicalizeInstanceSettingsMaintenanceWindow(des.MaintenanceWindow, initial.MaintenanceWindow, opts...)
+	cDes.BackupConfiguration = canonicalizeInstanceSettingsBackupConfiguration(des.BackupConfiguration, initial.BackupConfiguration, opts...)
+	cDes.DataDiskSizeGb = canonicalizeInstanceSettingsDataDiskSizeGb(des.DataDiskSizeGb, initial.DataDiskSizeGb, opts...)
+	cDes.ActiveDirectoryConfig = canonicalizeInstanceSettingsActiveDirectoryConfig(des.ActiveDirectoryConfig, initial.ActiveDirectoryConfig, opts...)
+	if dcl.StringCanonicalize(des.Collation, initial.Collation) || dcl.IsZeroValue(des.Collation) {
+		cDes.Collation = initial.Collation
+	} else {
+		cDes.Collation = des.Collation
+	}
+	cDes.DenyMaintenancePeriods = canonicalizeInstanceSettingsDenyMaintenancePeriodsSlice(des.DenyMaintenancePeriods, initial.DenyMaintenancePeriods, opts...)
+	cDes.InsightsConfig = canonicalizeInstanceSettingsInsightsConfig(des.InsightsConfig, initial.InsightsConfig, opts...)
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsSlice(des, initial []InstanceSettings, opts ...dcl.ApplyOption) []InstanceSettings {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettings, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettings(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettings, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettings(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettings(c *Client, des, nw *InstanceSettings) *InstanceSettings {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettings while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringArrayCanonicalize(des.AuthorizedGaeApplications, nw.AuthorizedGaeApplications) {
+		nw.AuthorizedGaeApplications = des.AuthorizedGaeApplications
+	}
+	if dcl.StringCanonicalize(des.Tier, nw.Tier) {
+		nw.Tier = des.Tier
+	}
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+	if dcl.BoolCanonicalize(des.StorageAutoResize, nw.StorageAutoResize) {
+		nw.StorageAutoResize = des.StorageAutoResize
+	}
+	if dcl.BoolCanonicalize(des.DatabaseReplicationEnabled, nw.DatabaseReplicationEnabled) {
+		nw.DatabaseReplicationEnabled = des.DatabaseReplicationEnabled
+	}
+	if dcl.BoolCanonicalize(des.CrashSafeReplicationEnabled, nw.CrashSafeReplicationEnabled) {
+		nw.CrashSafeReplicationEnabled = des.CrashSafeReplicationEnabled
+	}
+	nw.SettingsVersion = canonicalizeNewInstanceSettingsSettingsVersion(c, des.SettingsVersion, nw.SettingsVersion)
+	nw.StorageAutoResizeLimit = canonicalizeNewInstanceSettingsStorageAutoResizeLimit(c, des.StorageAutoResizeLimit, nw.StorageAutoResizeLimit)
+	nw.IPConfiguration = canonicalizeNewInstanceSettingsIPConfiguration(c, des.IPConfiguration, nw.IPConfiguration)
+	nw.LocationPreference = canonicalizeNewInstanceSettingsLocationPreference(c, des.LocationPreference, nw.LocationPreference)
+	nw.DatabaseFlags = canonicalizeNewInstanceSettingsDatabaseFlagsSlice(c, des.DatabaseFlags, nw.DatabaseFlags)
+	nw.MaintenanceWindow = canonicalizeNewInstanceSettingsMaintenanceWindow(c, des.MaintenanceWindow, nw.MaintenanceWindow)
+	nw.BackupConfiguration = canonicalizeNewInstanceSettingsBackupConfiguration(c, des.BackupConfiguration, nw.BackupConfiguration)
+	nw.DataDiskSizeGb = canonicalizeNewInstanceSettingsDataDiskSizeGb(c, des.DataDiskSizeGb, nw.DataDiskSizeGb)
+	nw.ActiveDirectoryConfig = canonicalizeNewInstanceSettingsActiveDirectoryConfig(c, des.ActiveDirectoryConfig, nw.ActiveDirectoryConfig)
+	if dcl.StringCanonicalize(des.Collation, nw.Collation) {
+		nw.Collation = des.Collation
+	}
+	nw.DenyMaintenancePeriods = canonicalizeNewInstanceSettingsDenyMaintenancePeriodsSlice(c, des.DenyMaintenancePeriods, nw.DenyMaintenancePeriods)
+	nw.InsightsConfig = canonicalizeNewInstanceSettingsInsightsConfig(c, des.InsightsConfig, nw.InsightsConfig)
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsSet(c *Client, des, nw []InstanceSettings) []InstanceSettings {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettings
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsSlice(c *Client, des, nw []InstanceSettings) []InstanceSettings {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettings
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettings(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsSettingsVersion(des, initial *InstanceSettingsSettingsVersion, opts ...dcl.ApplyOption) *InstanceSettingsSettingsVersion {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsSettingsVersion{}
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsSettingsVersionSlice(des, initial []InstanceSettingsSettingsVersion, opts ...dcl.ApplyOption) []InstanceSettingsSettingsVersion {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsSettingsVersion, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsSettingsVersion(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsSettingsVersion, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsSettingsVersion(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsSettingsVersion(c *Client, des, nw *InstanceSettingsSettingsVersion) *InstanceSettingsSettingsVersion {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsSettingsVersion while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsSettingsVersionSet(c *Client, des, nw []InstanceSettingsSettingsVersion) []InstanceSettingsSettingsVersion {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsSettingsVersion
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsSettingsVersionNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsSettingsVersionSlice(c *Client, des, nw []InstanceSettingsSettingsVersion) []InstanceSettingsSettingsVersion {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsSettingsVersion
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsSettingsVersion(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsStorageAutoResizeLimit(des, initial *InstanceSettingsStorageAutoResizeLimit, opts ...dcl.ApplyOption) *InstanceSettingsStorageAutoResizeLimit {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsStorageAutoResizeLimit{}
+
+	if dcl.IsZeroValue(des.Value) || (dcl.IsEmptyValueIndirect(des.Value) && dcl.IsEmptyValueIndirect(initial.Value)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Value = initial.Value
+	} else {
+		cDes.Value = des.Value
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsStorageAutoResizeLimitSlice(des, initial []InstanceSettingsStorageAutoResizeLimit, opts ...dcl.ApplyOption) []InstanceSettingsStorageAutoResizeLimit {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsStorageAutoResizeLimit, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsStorageAutoResizeLimit(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsStorageAutoResizeLimit, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsStorageAutoResizeLimit(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsStorageAutoResizeLimit(c *Client, des, nw *InstanceSettingsStorageAutoResizeLimit) *InstanceSettingsStorageAutoResizeLimit {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsStorageAutoResizeLimit while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsStorageAutoResizeLimitSet(c *Client, des, nw []InstanceSettingsStorageAutoResizeLimit) []InstanceSettingsStorageAutoResizeLimit {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsStorageAutoResizeLimit
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsStorageAutoResizeLimitNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsStorageAutoResizeLimitSlice(c *Client, des, nw []InstanceSettingsStorageAutoResizeLimit) []InstanceSettingsStorageAutoResizeLimit {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsStorageAutoResizeLimit
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsStorageAutoResizeLimit(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsIPConfiguration(des, initial *InstanceSettingsIPConfiguration, opts ...dcl.ApplyOption) *InstanceSettingsIPConfiguration {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsIPConfiguration{}
+
+	if dcl.BoolCanonicalize(des.IPv4Enabled, initial.IPv4Enabled) || dcl.IsZeroValue(des.IPv4Enabled) {
+		cDes.IPv4Enabled = initial.IPv4Enabled
+	} else {
+		cDes.IPv4Enabled = des.IPv4Enabled
+	}
+	if dcl.StringCanonicalize(des.PrivateNetwork, initial.PrivateNetwork) || dcl.IsZeroValue(des.PrivateNetwork) {
+		cDes.PrivateNetwork = initial.PrivateNetwork
+	} else {
+		cDes.PrivateNetwork = des.PrivateNetwork
+	}
+	if dcl.BoolCanonicalize(des.RequireSsl, initial.RequireSsl) || dcl.IsZeroValue(des.RequireSsl) {
+		cDes.RequireSsl = initial.RequireSsl
+	} else {
+		cDes.RequireSsl = des.RequireSsl
+	}
+	cDes.AuthorizedNetworks = canonicalizeInstanceSettingsIPConfigurationAuthorizedNetworksSlice(des.AuthorizedNetworks, initial.AuthorizedNetworks, opts...)
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsIPConfigurationSlice(des, initial []InstanceSettingsIPConfiguration, opts ...dcl.ApplyOption) []InstanceSettingsIPConfiguration {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsIPConfiguration, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsIPConfiguration(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsIPConfiguration, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsIPConfiguration(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsIPConfiguration(c *Client, des, nw *InstanceSettingsIPConfiguration) *InstanceSettingsIPConfiguration {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsIPConfiguration while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.BoolCanonicalize(des.IPv4Enabled, nw.IPv4Enabled) {
+		nw.IPv4Enabled = des.IPv4Enabled
+	}
+	if dcl.StringCanonicalize(des.PrivateNetwork, nw.PrivateNetwork) {
+		nw.PrivateNetwork = des.PrivateNetwork
+	}
+	if dcl.BoolCanonicalize(des.RequireSsl, nw.RequireSsl) {
+		nw.RequireSsl = des.RequireSsl
+	}
+	nw.AuthorizedNetworks = canonicalizeNewInstanceSettingsIPConfigurationAuthorizedNetworksSlice(c, des.AuthorizedNetworks, nw.AuthorizedNetworks)
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsIPConfigurationSet(c *Client, des, nw []InstanceSettingsIPConfiguration) []InstanceSettingsIPConfiguration {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsIPConfiguration
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsIPConfigurationNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsIPConfigurationSlice(c *Client, des, nw []InstanceSettingsIPConfiguration) []InstanceSettingsIPConfiguration {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsIPConfiguration
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsIPConfiguration(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsIPConfigurationAuthorizedNetworks(des, initial *InstanceSettingsIPConfigurationAuthorizedNetworks, opts ...dcl.ApplyOption) *InstanceSettingsIPConfigurationAuthorizedNetworks {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsIPConfigurationAuthorizedNetworks{}
+
+	if dcl.StringCanonicalize(des.Value, initial.Value) || dcl.IsZeroValue(des.Value) {
+		cDes.Value = initial.Value
+	} else {
+		cDes.Value = des.Value
+	}
+	if dcl.StringCanonicalize(des.ExpirationTime, initial.ExpirationTime) || dcl.IsZeroValue(des.ExpirationTime) {
+		cDes.ExpirationTime = initial.ExpirationTime
+	} else {
+		cDes.ExpirationTime = des.ExpirationTime
+	}
+	if dcl.StringCanonicalize(des.Name, initial.Name) || dcl.IsZeroValue(des.Name) {
+		cDes.Name = initial.Name
+	} else {
+		cDes.Name = des.Name
+	}
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsIPConfigurationAuthorizedNetworksSlice(des, initial []InstanceSettingsIPConfigurationAuthorizedNetworks, opts ...dcl.ApplyOption) []InstanceSettingsIPConfigurationAuthorizedNetworks {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsIPConfigurationAuthorizedNetworks, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsIPConfigurationAuthorizedNetworks(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsIPConfigurationAuthorizedNetworks, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsIPConfigurationAuthorizedNetworks(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsIPConfigurationAuthorizedNetworks(c *Client, des, nw *InstanceSettingsIPConfigurationAuthorizedNetworks) *InstanceSettingsIPConfigurationAuthorizedNetworks {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsIPConfigurationAuthorizedNetworks while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Value, nw.Value) {
+		nw.Value = des.Value
+	}
+	if dcl.StringCanonicalize(des.ExpirationTime, nw.ExpirationTime) {
+		nw.ExpirationTime = des.ExpirationTime
+	}
+	if dcl.StringCanonicalize(des.Name, nw.Name) {
+		nw.Name = des.Name
+	}
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsIPConfigurationAuthorizedNetworksSet(c *Client, des, nw []InstanceSettingsIPConfigurationAuthorizedNetworks) []InstanceSettingsIPConfigurationAuthorizedNetworks {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsIPConfigurationAuthorizedNetworks
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsIPConfigurationAuthorizedNetworksNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsIPConfigurationAuthorizedNetworksSlice(c *Client, des, nw []InstanceSettingsIPConfigurationAuthorizedNetworks) []InstanceSettingsIPConfigurationAuthorizedNetworks {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsIPConfigurationAuthorizedNetworks
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsIPConfigurationAuthorizedNetworks(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsLocationPreference(des, initial *InstanceSettingsLocationPreference, opts ...dcl.ApplyOption) *InstanceSettingsLocationPreference {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsLocationPreference{}
+
+	if dcl.StringCanonicalize(des.Zone, initial.Zone) || dcl.IsZeroValue(des.Zone) {
+		cDes.Zone = initial.Zone
+	} else {
+		cDes.Zone = des.Zone
+	}
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsLocationPreferenceSlice(des, initial []InstanceSettingsLocationPreference, opts ...dcl.ApplyOption) []InstanceSettingsLocationPreference {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsLocationPreference, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsLocationPreference(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsLocationPreference, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsLocationPreference(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsLocationPreference(c *Client, des, nw *InstanceSettingsLocationPreference) *InstanceSettingsLocationPreference {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsLocationPreference while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Zone, nw.Zone) {
+		nw.Zone = des.Zone
+	}
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsLocationPreferenceSet(c *Client, des, nw []InstanceSettingsLocationPreference) []InstanceSettingsLocationPreference {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsLocationPreference
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsLocationPreferenceNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsLocationPreferenceSlice(c *Client, des, nw []InstanceSettingsLocationPreference) []InstanceSettingsLocationPreference {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsLocationPreference
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsLocationPreference(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsDatabaseFlags(des, initial *InstanceSettingsDatabaseFlags, opts ...dcl.ApplyOption) *InstanceSettingsDatabaseFlags {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsDatabaseFlags{}
+
+	if dcl.StringCanonicalize(des.Name, initial.Name) || dcl.IsZeroValue(des.Name) {
+		cDes.Name = initial.Name
+	} else {
+		cDes.Name = des.Name
+	}
+	if dcl.StringCanonicalize(des.Value, initial.Value) || dcl.IsZeroValue(des.Value) {
+		cDes.Value = initial.Value
+	} else {
+		cDes.Value = des.Value
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsDatabaseFlagsSlice(des, initial []InstanceSettingsDatabaseFlags, opts ...dcl.ApplyOption) []InstanceSettingsDatabaseFlags {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsDatabaseFlags, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsDatabaseFlags(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsDatabaseFlags, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsDatabaseFlags(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsDatabaseFlags(c *Client, des, nw *InstanceSettingsDatabaseFlags) *InstanceSettingsDatabaseFlags {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsDatabaseFlags while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Name, nw.Name) {
+		nw.Name = des.Name
+	}
+	if dcl.StringCanonicalize(des.Value, nw.Value) {
+		nw.Value = des.Value
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsDatabaseFlagsSet(c *Client, des, nw []InstanceSettingsDatabaseFlags) []InstanceSettingsDatabaseFlags {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsDatabaseFlags
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsDatabaseFlagsNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsDatabaseFlagsSlice(c *Client, des, nw []InstanceSettingsDatabaseFlags) []InstanceSettingsDatabaseFlags {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsDatabaseFlags
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsDatabaseFlags(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsMaintenanceWindow(des, initial *InstanceSettingsMaintenanceWindow, opts ...dcl.ApplyOption) *InstanceSettingsMaintenanceWindow {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsMaintenanceWindow{}
+
+	if dcl.IsZeroValue(des.Hour) || (dcl.IsEmptyValueIndirect(des.Hour) && dcl.IsEmptyValueIndirect(initial.Hour)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Hour = initial.Hour
+	} else {
+		cDes.Hour = des.Hour
+	}
+	if dcl.IsZeroValue(des.Day) || (dcl.IsEmptyValueIndirect(des.Day) && dcl.IsEmptyValueIndirect(initial.Day)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Day = initial.Day
+	} else {
+		cDes.Day = des.Day
+	}
+	if dcl.IsZeroValue(des.UpdateTrack) || (dcl.IsEmptyValueIndirect(des.UpdateTrack) && dcl.IsEmptyValueIndirect(initial.UpdateTrack)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.UpdateTrack = initial.UpdateTrack
+	} else {
+		cDes.UpdateTrack = des.UpdateTrack
+	}
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsMaintenanceWindowSlice(des, initial []InstanceSettingsMaintenanceWindow, opts ...dcl.ApplyOption) []InstanceSettingsMaintenanceWindow {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsMaintenanceWindow, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsMaintenanceWindow(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsMaintenanceWindow, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsMaintenanceWindow(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsMaintenanceWindow(c *Client, des, nw *InstanceSettingsMaintenanceWindow) *InstanceSettingsMaintenanceWindow {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsMaintenanceWindow while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsMaintenanceWindowSet(c *Client, des, nw []InstanceSettingsMaintenanceWindow) []InstanceSettingsMaintenanceWindow {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsMaintenanceWindow
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsMaintenanceWindowNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsMaintenanceWindowSlice(c *Client, des, nw []InstanceSettingsMaintenanceWindow) []InstanceSettingsMaintenanceWindow {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsMaintenanceWindow
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsMaintenanceWindow(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsBackupConfiguration(des, initial *InstanceSettingsBackupConfiguration, opts ...dcl.ApplyOption) *InstanceSettingsBackupConfiguration {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsBackupConfiguration{}
+
+	if dcl.StringCanonicalize(des.StartTime, initial.StartTime) || dcl.IsZeroValue(des.StartTime) {
+		cDes.StartTime = initial.StartTime
+	} else {
+		cDes.StartTime = des.StartTime
+	}
+	if dcl.BoolCanonicalize(des.Enabled, initial.Enabled) || dcl.IsZeroValue(des.Enabled) {
+		cDes.Enabled = initial.Enabled
+	} else {
+		cDes.Enabled = des.Enabled
+	}
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+	if dcl.BoolCanonicalize(des.BinaryLogEnabled, initial.BinaryLogEnabled) || dcl.IsZeroValue(des.BinaryLogEnabled) {
+		cDes.BinaryLogEnabled = initial.BinaryLogEnabled
+	} else {
+		cDes.BinaryLogEnabled = des.BinaryLogEnabled
+	}
+	if dcl.StringCanonicalize(des.Location, initial.Location) || dcl.IsZeroValue(des.Location) {
+		cDes.Location = initial.Location
+	} else {
+		cDes.Location = des.Location
+	}
+	cDes.BackupRetentionSettings = canonicalizeInstanceSettingsBackupConfigurationBackupRetentionSettings(des.BackupRetentionSettings, initial.BackupRetentionSettings, opts...)
+	if dcl.IsZeroValue(des.TransactionLogRetentionDays) || (dcl.IsEmptyValueIndirect(des.TransactionLogRetentionDays) && dcl.IsEmptyValueIndirect(initial.TransactionLogRetentionDays)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.TransactionLogRetentionDays = initial.TransactionLogRetentionDays
+	} else {
+		cDes.TransactionLogRetentionDays = des.TransactionLogRetentionDays
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsBackupConfigurationSlice(des, initial []InstanceSettingsBackupConfiguration, opts ...dcl.ApplyOption) []InstanceSettingsBackupConfiguration {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsBackupConfiguration, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsBackupConfiguration(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsBackupConfiguration, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsBackupConfiguration(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsBackupConfiguration(c *Client, des, nw *InstanceSettingsBackupConfiguration) *InstanceSettingsBackupConfiguration {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsBackupConfiguration while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.StartTime, nw.StartTime) {
+		nw.StartTime = des.StartTime
+	}
+	if dcl.BoolCanonicalize(des.Enabled, nw.Enabled) {
+		nw.Enabled = des.Enabled
+	}
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+	if dcl.BoolCanonicalize(des.BinaryLogEnabled, nw.BinaryLogEnabled) {
+		nw.BinaryLogEnabled = des.BinaryLogEnabled
+	}
+	if dcl.StringCanonicalize(des.Location, nw.Location) {
+		nw.Location = des.Location
+	}
+	nw.BackupRetentionSettings = canonicalizeNewInstanceSettingsBackupConfigurationBackupRetentionSettings(c, des.BackupRetentionSettings, nw.BackupRetentionSettings)
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsBackupConfigurationSet(c *Client, des, nw []InstanceSettingsBackupConfiguration) []InstanceSettingsBackupConfiguration {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsBackupConfiguration
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsBackupConfigurationNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsBackupConfigurationSlice(c *Client, des, nw []InstanceSettingsBackupConfiguration) []InstanceSettingsBackupConfiguration {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsBackupConfiguration
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsBackupConfiguration(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsBackupConfigurationBackupRetentionSettings(des, initial *InstanceSettingsBackupConfigurationBackupRetentionSettings, opts ...dcl.ApplyOption) *InstanceSettingsBackupConfigurationBackupRetentionSettings {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsBackupConfigurationBackupRetentionSettings{}
+
+	if dcl.IsZeroValue(des.RetentionUnit) || (dcl.IsEmptyValueIndirect(des.RetentionUnit) && dcl.IsEmptyValueIndirect(initial.RetentionUnit)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.RetentionUnit = initial.RetentionUnit
+	} else {
+		cDes.RetentionUnit = des.RetentionUnit
+	}
+	if dcl.IsZeroValue(des.RetainedBackups) || (dcl.IsEmptyValueIndirect(des.RetainedBackups) && dcl.IsEmptyValueIndirect(initial.RetainedBackups)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.RetainedBackups = initial.RetainedBackups
+	} else {
+		cDes.RetainedBackups = des.RetainedBackups
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsBackupConfigurationBackupRetentionSettingsSlice(des, initial []InstanceSettingsBackupConfigurationBackupRetentionSettings, opts ...dcl.ApplyOption) []InstanceSettingsBackupConfigurationBackupRetentionSettings {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsBackupConfigurationBackupRetentionSettings, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsBackupConfigurationBackupRetentionSettings(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsBackupConfigurationBackupRetentionSettings, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsBackupConfigurationBackupRetentionSettings(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsBackupConfigurationBackupRetentionSettings(c *Client, des, nw *InstanceSettingsBackupConfigurationBackupRetentionSettings) *InstanceSettingsBackupConfigurationBackupRetentionSettings {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsBackupConfigurationBackupRetentionSettings while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsBackupConfigurationBackupRetentionSettingsSet(c *Client, des, nw []InstanceSettingsBackupConfigurationBackupRetentionSettings) []InstanceSettingsBackupConfigurationBackupRetentionSettings {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsBackupConfigurationBackupRetentionSettings
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsBackupConfigurationBackupRetentionSettingsNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsBackupConfigurationBackupRetentionSettingsSlice(c *Client, des, nw []InstanceSettingsBackupConfigurationBackupRetentionSettings) []InstanceSettingsBackupConfigurationBackupRetentionSettings {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsBackupConfigurationBackupRetentionSettings
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsBackupConfigurationBackupRetentionSettings(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsDataDiskSizeGb(des, initial *InstanceSettingsDataDiskSizeGb, opts ...dcl.ApplyOption) *InstanceSettingsDataDiskSizeGb {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsDataDiskSizeGb{}
+
+	if dcl.IsZeroValue(des.Value) || (dcl.IsEmptyValueIndirect(des.Value) && dcl.IsEmptyValueIndirect(initial.Value)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.Value = initial.Value
+	} else {
+		cDes.Value = des.Value
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsDataDiskSizeGbSlice(des, initial []InstanceSettingsDataDiskSizeGb, opts ...dcl.ApplyOption) []InstanceSettingsDataDiskSizeGb {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsDataDiskSizeGb, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsDataDiskSizeGb(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsDataDiskSizeGb, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsDataDiskSizeGb(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsDataDiskSizeGb(c *Client, des, nw *InstanceSettingsDataDiskSizeGb) *InstanceSettingsDataDiskSizeGb {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsDataDiskSizeGb while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsDataDiskSizeGbSet(c *Client, des, nw []InstanceSettingsDataDiskSizeGb) []InstanceSettingsDataDiskSizeGb {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsDataDiskSizeGb
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsDataDiskSizeGbNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsDataDiskSizeGbSlice(c *Client, des, nw []InstanceSettingsDataDiskSizeGb) []InstanceSettingsDataDiskSizeGb {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsDataDiskSizeGb
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsDataDiskSizeGb(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsActiveDirectoryConfig(des, initial *InstanceSettingsActiveDirectoryConfig, opts ...dcl.ApplyOption) *InstanceSettingsActiveDirectoryConfig {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsActiveDirectoryConfig{}
+
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+	if dcl.StringCanonicalize(des.Domain, initial.Domain) || dcl.IsZeroValue(des.Domain) {
+		cDes.Domain = initial.Domain
+	} else {
+		cDes.Domain = des.Domain
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsActiveDirectoryConfigSlice(des, initial []InstanceSettingsActiveDirectoryConfig, opts ...dcl.ApplyOption) []InstanceSettingsActiveDirectoryConfig {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsActiveDirectoryConfig, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsActiveDirectoryConfig(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsActiveDirectoryConfig, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsActiveDirectoryConfig(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsActiveDirectoryConfig(c *Client, des, nw *InstanceSettingsActiveDirectoryConfig) *InstanceSettingsActiveDirectoryConfig {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsActiveDirectoryConfig while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+	if dcl.StringCanonicalize(des.Domain, nw.Domain) {
+		nw.Domain = des.Domain
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsActiveDirectoryConfigSet(c *Client, des, nw []InstanceSettingsActiveDirectoryConfig) []InstanceSettingsActiveDirectoryConfig {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsActiveDirectoryConfig
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsActiveDirectoryConfigNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsActiveDirectoryConfigSlice(c *Client, des, nw []InstanceSettingsActiveDirectoryConfig) []InstanceSettingsActiveDirectoryConfig {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsActiveDirectoryConfig
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsActiveDirectoryConfig(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsDenyMaintenancePeriods(des, initial *InstanceSettingsDenyMaintenancePeriods, opts ...dcl.ApplyOption) *InstanceSettingsDenyMaintenancePeriods {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsDenyMaintenancePeriods{}
+
+	if dcl.StringCanonicalize(des.StartDate, initial.StartDate) || dcl.IsZeroValue(des.StartDate) {
+		cDes.StartDate = initial.StartDate
+	} else {
+		cDes.StartDate = des.StartDate
+	}
+	if dcl.StringCanonicalize(des.EndDate, initial.EndDate) || dcl.IsZeroValue(des.EndDate) {
+		cDes.EndDate = initial.EndDate
+	} else {
+		cDes.EndDate = des.EndDate
+	}
+	if dcl.StringCanonicalize(des.Time, initial.Time) || dcl.IsZeroValue(des.Time) {
+		cDes.Time = initial.Time
+	} else {
+		cDes.Time = des.Time
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsDenyMaintenancePeriodsSlice(des, initial []InstanceSettingsDenyMaintenancePeriods, opts ...dcl.ApplyOption) []InstanceSettingsDenyMaintenancePeriods {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsDenyMaintenancePeriods, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsDenyMaintenancePeriods(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsDenyMaintenancePeriods, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsDenyMaintenancePeriods(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsDenyMaintenancePeriods(c *Client, des, nw *InstanceSettingsDenyMaintenancePeriods) *InstanceSettingsDenyMaintenancePeriods {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsDenyMaintenancePeriods while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.StartDate, nw.StartDate) {
+		nw.StartDate = des.StartDate
+	}
+	if dcl.StringCanonicalize(des.EndDate, nw.EndDate) {
+		nw.EndDate = des.EndDate
+	}
+	if dcl.StringCanonicalize(des.Time, nw.Time) {
+		nw.Time = des.Time
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsDenyMaintenancePeriodsSet(c *Client, des, nw []InstanceSettingsDenyMaintenancePeriods) []InstanceSettingsDenyMaintenancePeriods {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsDenyMaintenancePeriods
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsDenyMaintenancePeriodsNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsDenyMaintenancePeriodsSlice(c *Client, des, nw []InstanceSettingsDenyMaintenancePeriods) []InstanceSettingsDenyMaintenancePeriods {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsDenyMaintenancePeriods
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsDenyMaintenancePeriods(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceSettingsInsightsConfig(des, initial *InstanceSettingsInsightsConfig, opts ...dcl.ApplyOption) *InstanceSettingsInsightsConfig {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceSettingsInsightsConfig{}
+
+	if dcl.BoolCanonicalize(des.QueryInsightsEnabled, initial.QueryInsightsEnabled) || dcl.IsZeroValue(des.QueryInsightsEnabled) {
+		cDes.QueryInsightsEnabled = initial.QueryInsightsEnabled
+	} else {
+		cDes.QueryInsightsEnabled = des.QueryInsightsEnabled
+	}
+	if dcl.BoolCanonicalize(des.RecordClientAddress, initial.RecordClientAddress) || dcl.IsZeroValue(des.RecordClientAddress) {
+		cDes.RecordClientAddress = initial.RecordClientAddress
+	} else {
+		cDes.RecordClientAddress = des.RecordClientAddress
+	}
+	if dcl.BoolCanonicalize(des.RecordApplicationTags, initial.RecordApplicationTags) || dcl.IsZeroValue(des.RecordApplicationTags) {
+		cDes.RecordApplicationTags = initial.RecordApplicationTags
+	} else {
+		cDes.RecordApplicationTags = des.RecordApplicationTags
+	}
+	if dcl.IsZeroValue(des.QueryStringLength) || (dcl.IsEmptyValueIndirect(des.QueryStringLength) && dcl.IsEmptyValueIndirect(initial.QueryStringLength)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		cDes.QueryStringLength = initial.QueryStringLength
+	} else {
+		cDes.QueryStringLength = des.QueryStringLength
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceSettingsInsightsConfigSlice(des, initial []InstanceSettingsInsightsConfig, opts ...dcl.ApplyOption) []InstanceSettingsInsightsConfig {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceSettingsInsightsConfig, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceSettingsInsightsConfig(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceSettingsInsightsConfig, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceSettingsInsightsConfig(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceSettingsInsightsConfig(c *Client, des, nw *InstanceSettingsInsightsConfig) *InstanceSettingsInsightsConfig {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceSettingsInsightsConfig while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.BoolCanonicalize(des.QueryInsightsEnabled, nw.QueryInsightsEnabled) {
+		nw.QueryInsightsEnabled = des.QueryInsightsEnabled
+	}
+	if dcl.BoolCanonicalize(des.RecordClientAddress, nw.RecordClientAddress) {
+		nw.RecordClientAddress = des.RecordClientAddress
+	}
+	if dcl.BoolCanonicalize(des.RecordApplicationTags, nw.RecordApplicationTags) {
+		nw.RecordApplicationTags = des.RecordApplicationTags
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceSettingsInsightsConfigSet(c *Client, des, nw []InstanceSettingsInsightsConfig) []InstanceSettingsInsightsConfig {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceSettingsInsightsConfig
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceSettingsInsightsConfigNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceSettingsInsightsConfigSlice(c *Client, des, nw []InstanceSettingsInsightsConfig) []InstanceSettingsInsightsConfig {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceSettingsInsightsConfig
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceSettingsInsightsConfig(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceReplicaInstances(des, initial *InstanceReplicaInstances, opts ...dcl.ApplyOption) *InstanceReplicaInstances {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceReplicaInstances{}
+
+	return cDes
+}
+
+func canonicalizeInstanceReplicaInstancesSlice(des, initial []InstanceReplicaInstances, opts ...dcl.ApplyOption) []InstanceReplicaInstances {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceReplicaInstances, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceReplicaInstances(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceReplicaInstances, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceReplicaInstances(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceReplicaInstances(c *Client, des, nw *InstanceReplicaInstances) *InstanceReplicaInstances {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceReplicaInstances while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Name, nw.Name) {
+		nw.Name = des.Name
+	}
+	if dcl.StringCanonicalize(des.Region, nw.Region) {
+		nw.Region = des.Region
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceReplicaInstancesSet(c *Client, des, nw []InstanceReplicaInstances) []InstanceReplicaInstances {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceReplicaInstances
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceReplicaInstancesNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceReplicaInstancesSlice(c *Client, des, nw []InstanceReplicaInstances) []InstanceReplicaInstances {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceReplicaInstances
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceReplicaInstances(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceServerCaCert(des, initial *InstanceServerCaCert, opts ...dcl.ApplyOption) *InstanceServerCaCert {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceServerCaCert{}
+
+	return cDes
+}
+
+func canonicalizeInstanceServerCaCertSlice(des, initial []InstanceServerCaCert, opts ...dcl.ApplyOption) []InstanceServerCaCert {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceServerCaCert, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceServerCaCert(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceServerCaCert, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceServerCaCert(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceServerCaCert(c *Client, des, nw *InstanceServerCaCert) *InstanceServerCaCert {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceServerCaCert while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+	if dcl.StringCanonicalize(des.CertSerialNumber, nw.CertSerialNumber) {
+		nw.CertSerialNumber = des.CertSerialNumber
+	}
+	if dcl.StringCanonicalize(des.Cert, nw.Cert) {
+		nw.Cert = des.Cert
+	}
+	if dcl.StringCanonicalize(des.CreateTime, nw.CreateTime) {
+		nw.CreateTime = des.CreateTime
+	}
+	if dcl.StringCanonicalize(des.CommonName, nw.CommonName) {
+		nw.CommonName = des.CommonName
+	}
+	if dcl.StringCanonicalize(des.ExpirationTime, nw.ExpirationTime) {
+		nw.ExpirationTime = des.ExpirationTime
+	}
+	if dcl.StringCanonicalize(des.Sha1Fingerprint, nw.Sha1Fingerprint) {
+		nw.Sha1Fingerprint = des.Sha1Fingerprint
+	}
+	if dcl.StringCanonicalize(des.Instance, nw.Instance) {
+		nw.Instance = des.Instance
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceServerCaCertSet(c *Client, des, nw []InstanceServerCaCert) []InstanceServerCaCert {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceServerCaCert
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceServerCaCertNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceServerCaCertSlice(c *Client, des, nw []InstanceServerCaCert) []InstanceServerCaCert {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceServerCaCert
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceServerCaCert(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceOnPremisesConfiguration(des, initial *InstanceOnPremisesConfiguration, opts ...dcl.ApplyOption) *InstanceOnPremisesConfiguration {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceOnPremisesConfiguration{}
+
+	if dcl.StringCanonicalize(des.HostPort, initial.HostPort) || dcl.IsZeroValue(des.HostPort) {
+		cDes.HostPort = initial.HostPort
+	} else {
+		cDes.HostPort = des.HostPort
+	}
+	if dcl.StringCanonicalize(des.Kind, initial.Kind) || dcl.IsZeroValue(des.Kind) {
+		cDes.Kind = initial.Kind
+	} else {
+		cDes.Kind = des.Kind
+	}
+	if dcl.StringCanonicalize(des.Username, initial.Username) || dcl.IsZeroValue(des.Username) {
+		cDes.Username = initial.Username
+	} else {
+		cDes.Username = des.Username
+	}
+	if dcl.StringCanonicalize(des.Password, initial.Password) || dcl.IsZeroValue(des.Password) {
+		cDes.Password = initial.Password
+	} else {
+		cDes.Password = des.Password
+	}
+	if dcl.StringCanonicalize(des.CaCertificate, initial.CaCertificate) || dcl.IsZeroValue(des.CaCertificate) {
+		cDes.CaCertificate = initial.CaCertificate
+	} else {
+		cDes.CaCertificate = des.CaCertificate
+	}
+	if dcl.StringCanonicalize(des.ClientCertificate, initial.ClientCertificate) || dcl.IsZeroValue(des.ClientCertificate) {
+		cDes.ClientCertificate = initial.ClientCertificate
+	} else {
+		cDes.ClientCertificate = des.ClientCertificate
+	}
+	if dcl.StringCanonicalize(des.ClientKey, initial.ClientKey) || dcl.IsZeroValue(des.ClientKey) {
+		cDes.ClientKey = initial.ClientKey
+	} else {
+		cDes.ClientKey = des.ClientKey
+	}
+	if dcl.StringCanonicalize(des.DumpFilePath, initial.DumpFilePath) || dcl.IsZeroValue(des.DumpFilePath) {
+		cDes.DumpFilePath = initial.DumpFilePath
+	} else {
+		cDes.DumpFilePath = des.DumpFilePath
+	}
+	if dcl.StringCanonicalize(des.Database, initial.Database) || dcl.IsZeroValue(des.Database) {
+		cDes.Database = initial.Database
+	} else {
+		cDes.Database = des.Database
+	}
+	if dcl.StringArrayCanonicalize(des.ReplicatedDatabases, initial.ReplicatedDatabases) {
+		cDes.ReplicatedDatabases = initial.ReplicatedDatabases
+	} else {
+		cDes.ReplicatedDatabases = des.ReplicatedDatabases
+	}
+
+	return cDes
+}
+
+func canonicalizeInstanceOnPremisesConfigurationSlice(des, initial []InstanceOnPremisesConfiguration, opts ...dcl.ApplyOption) []InstanceOnPremisesConfiguration {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceOnPremisesConfiguration, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceOnPremisesConfiguration(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceOnPremisesConfiguration, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceOnPremisesConfiguration(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceOnPremisesConfiguration(c *Client, des, nw *InstanceOnPremisesConfiguration) *InstanceOnPremisesConfiguration {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceOnPremisesConfiguration while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.HostPort, nw.HostPort) {
+		nw.HostPort = des.HostPort
+	}
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+	if dcl.StringCanonicalize(des.Username, nw.Username) {
+		nw.Username = des.Username
+	}
+	nw.Password = des.Password
+	if dcl.StringCanonicalize(des.CaCertificate, nw.CaCertificate) {
+		nw.CaCertificate = des.CaCertificate
+	}
+	if dcl.StringCanonicalize(des.ClientCertificate, nw.ClientCertificate) {
+		nw.ClientCertificate = des.ClientCertificate
+	}
+	nw.ClientKey = des.ClientKey
+	if dcl.StringCanonicalize(des.DumpFilePath, nw.DumpFilePath) {
+		nw.DumpFilePath = des.DumpFilePath
+	}
+	if dcl.StringCanonicalize(des.Database, nw.Database) {
+		nw.Database = des.Database
+	}
+	if dcl.StringArrayCanonicalize(des.ReplicatedDatabases, nw.ReplicatedDatabases) {
+		nw.ReplicatedDatabases = des.ReplicatedDatabases
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceOnPremisesConfigurationSet(c *Client, des, nw []InstanceOnPremisesConfiguration) []InstanceOnPremisesConfiguration {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceOnPremisesConfiguration
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceOnPremisesConfigurationNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceOnPremisesConfigurationSlice(c *Client, des, nw []InstanceOnPremisesConfiguration) []InstanceOnPremisesConfiguration {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceOnPremisesConfiguration
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceOnPremisesConfiguration(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeInstanceDiskEncryptionStatus(des, initial *InstanceDiskEncryptionStatus, opts ...dcl.ApplyOption) *InstanceDiskEncryptionStatus {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &InstanceDiskEncryptionStatus{}
+
+	return cDes
+}
+
+func canonicalizeInstanceDiskEncryptionStatusSlice(des, initial []InstanceDiskEncryptionStatus, opts ...dcl.ApplyOption) []InstanceDiskEncryptionStatus {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]InstanceDiskEncryptionStatus, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeInstanceDiskEncryptionStatus(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]InstanceDiskEncryptionStatus, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeInstanceDiskEncryptionStatus(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewInstanceDiskEncryptionStatus(c *Client, des, nw *InstanceDiskEncryptionStatus) *InstanceDiskEncryptionStatus {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for InstanceDiskEncryptionStatus while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.KmsKeyVersionName, nw.KmsKeyVersionName) {
+		nw.KmsKeyVersionName = des.KmsKeyVersionName
+	}
+	if dcl.StringCanonicalize(des.Kind, nw.Kind) {
+		nw.Kind = des.Kind
+	}
+
+	return nw
+}
+
+func canonicalizeNewInstanceDiskEncryptionStatusSet(c *Client, des, nw []InstanceDiskEncryptionStatus) []InstanceDiskEncryptionStatus {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []InstanceDiskEncryptionStatus
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareInstanceDiskEncryptionStatusNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewInstanceDiskEncryptionStatusSlice(c *Client, des, nw []InstanceDiskEncryptionStatus) []InstanceDiskEncryptionStatus {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []InstanceDiskEncryptionStatus
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewInstanceDiskEncryptionStatus(c, &d, &n))
+	}
+
+	return items
+}
+
+// The differ returns a list of diffs, along with a list of operations that should be taken
+// to remedy them. Right now, it does not attempt to consolidate operations - if several
+// fields can be fixed with a patch update, it will perform the patch several times.
+// Diffs on some fields will be ignored if the `desired` state has an empty (nil)
+// value. This empty value indicates that the user does not care about the state for
+// the field. Empty fields on the actual object will cause diffs.
+// TODO(magic-modules-eng): for efficiency in some resources, add batching.
+func diffInstance(c *Client, desired, actual *Instance, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	if desired == nil || actual == nil {
+		return nil, fmt.Errorf("nil resource passed to diff - always a programming error: %#v, %#v", desired, actual)
+	}
+
+	c.Config.Logger.Infof("Diff function called with desired state: %v", desired)
+	c.Config.Logger.Infof("Diff function called with actual state: %v", actual)
+
+	var fn dcl.FieldName
+	var newDiffs []*dcl.FieldDiff
+	// New style diffs.
+	if ds, err := dcl.Diff(desired.BackendType, actual.BackendType, dcl.DiffInfo{ServerDefault: true, Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("BackendType")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ConnectionName, actual.ConnectionName, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ConnectionName")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DatabaseVersion, actual.DatabaseVersion, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("DatabaseVersion")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Etag, actual.Etag, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Etag")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.GceZone, actual.GceZone, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("GceZone")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.InstanceType, actual.InstanceType, dcl.DiffInfo{ServerDefault: true, Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("InstanceType")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.MasterInstanceName, actual.MasterInstanceName, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("MasterInstanceName")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.MaxDiskSize, actual.MaxDiskSize, dcl.DiffInfo{ObjectFunction: compareInstanceMaxDiskSizeNewStyle, EmptyObject: EmptyInstanceMaxDiskSize, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("MaxDiskSize")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Project, actual.Project, dcl.DiffInfo{Type: "ReferenceType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Project")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Region, actual.Region, dcl.DiffInfo{ServerDefault: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Region")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.RootPassword, actual.RootPassword, dcl.DiffInfo{Ignore: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("RootPassword")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CurrentDiskSize, actual.CurrentDiskSize, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareInstanceCurrentDiskSizeNewStyle, EmptyObject: EmptyInstanceCurrentDiskSize, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("CurrentDiskSize")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DiskEncryptionConfiguration, actual.DiskEncryptionConfiguration, dcl.DiffInfo{ObjectFunction: compareInstanceDiskEncryptionConfigurationNewStyle, EmptyObject: EmptyInstanceDiskEncryptionConfiguration, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("DiskEncryptionConfiguration")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.FailoverReplica, actual.FailoverReplica, dcl.DiffInfo{ObjectFunction: compareInstanceFailoverReplicaNewStyle, EmptyObject: EmptyInstanceFailoverReplica, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("FailoverReplica")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.IPAddresses, actual.IPAddresses, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareInstanceIPAddressesNewStyle, EmptyObject: EmptyInstanceIPAddresses, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("IPAddresses")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.MasterInstance, actual.MasterInstance, dcl.DiffInfo{ObjectFunction: compareInstanceMasterInstanceNewStyle, EmptyObject: EmptyInstanceMasterInstance, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("MasterInstance")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ReplicaConfiguration, actual.ReplicaConfiguration, dcl.DiffInfo{ObjectFunction: compareInstanceReplicaConfigurationNewStyle, EmptyObject: EmptyInstanceReplicaConfiguration, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ReplicaConfiguration")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ScheduledMaintenance, actual.ScheduledMaintenance, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareInstanceScheduledMaintenanceNewStyle, EmptyObject: EmptyInstanceScheduledMaintenance, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ScheduledMaintenance")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Settings, actual.Settings, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsNewStyle, EmptyObject: EmptyInstanceSettings, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Settings")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.State, actual.State, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("State")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ReplicaInstances, actual.ReplicaInstances, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareInstanceReplicaInstancesNewStyle, EmptyObject: EmptyInstanceReplicaInstances, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ReplicaInstances")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ServerCaCert, actual.ServerCaCert, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareInstanceServerCaCertNewStyle, EmptyObject: EmptyInstanceServerCaCert, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ServerCaCert")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.IPv6Address, actual.IPv6Address, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("IPv6Address")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ServiceAccountEmailAddress, actual.ServiceAccountEmailAddress, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ServiceAccountEmailAddress")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.OnPremisesConfiguration, actual.OnPremisesConfiguration, dcl.DiffInfo{ObjectFunction: compareInstanceOnPremisesConfigurationNewStyle, EmptyObject: EmptyInstanceOnPremisesConfiguration, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("OnPremisesConfiguration")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.SuspensionReason, actual.SuspensionReason, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("SuspensionReason")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DiskEncryptionStatus, actual.DiskEncryptionStatus, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareInstanceDiskEncryptionStatusNewStyle, EmptyObject: EmptyInstanceDiskEncryptionStatus, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("DiskEncryptionStatus")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.InstanceUid, actual.InstanceUid, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("InstanceUid")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	return newDiffs, nil
+}
+func compareInstanceMaxDiskSizeNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceMaxDiskSize)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceMaxDiskSize)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceMaxDiskSize or *InstanceMaxDiskSize", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceMaxDiskSize)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceMaxDiskSize)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceMaxDiskSize", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Value, actual.Value, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Value")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceCurrentDiskSizeNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceCurrentDiskSize)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceCurrentDiskSize)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceCurrentDiskSize or *InstanceCurrentDiskSize", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceCurrentDiskSize)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceCurrentDiskSize)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceCurrentDiskSize", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Value, actual.Value, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Value")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceDiskEncryptionConfigurationNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceDiskEncryptionConfiguration)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceDiskEncryptionConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceDiskEncryptionConfiguration or *InstanceDiskEncryptionConfiguration", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceDiskEncryptionConfiguration)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceDiskEncryptionConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceDiskEncryptionConfiguration", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.KmsKeyName, actual.KmsKeyName, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("KmsKeyName")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceFailoverReplicaNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceFailoverReplica)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceFailoverReplica)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceFailoverReplica or *InstanceFailoverReplica", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceFailoverReplica)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceFailoverReplica)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceFailoverReplica", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Available, actual.Available, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Available")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.FailoverInstance, actual.FailoverInstance, dcl.DiffInfo{ObjectFunction: compareInstanceFailoverReplicaFailoverInstanceNewStyle, EmptyObject: EmptyInstanceFailoverReplicaFailoverInstance, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("FailoverInstance")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceFailoverReplicaFailoverInstanceNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceFailoverReplicaFailoverInstance)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceFailoverReplicaFailoverInstance)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceFailoverReplicaFailoverInstance or *InstanceFailoverReplicaFailoverInstance", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceFailoverReplicaFailoverInstance)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceFailoverReplicaFailoverInstance)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceFailoverReplicaFailoverInstance", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Region, actual.Region, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Region")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceIPAddressesNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceIPAddresses)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceIPAddresses)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceIPAddresses or *InstanceIPAddresses", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceIPAddresses)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceIPAddresses)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceIPAddresses", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Type, actual.Type, dcl.DiffInfo{OutputOnly: true, Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Type")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.IPAddress, actual.IPAddress, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("IPAddress")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.TimeToRetire, actual.TimeToRetire, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareInstanceIPAddressesTimeToRetireNewStyle, EmptyObject: EmptyInstanceIPAddressesTimeToRetire, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("TimeToRetire")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceIPAddressesTimeToRetireNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceIPAddressesTimeToRetire)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceIPAddressesTimeToRetire)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceIPAddressesTimeToRetire or *InstanceIPAddressesTimeToRetire", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceIPAddressesTimeToRetire)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceIPAddressesTimeToRetire)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceIPAddressesTimeToRetire", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Seconds, actual.Seconds, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Seconds")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Nanos, actual.Nanos, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Nanos")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceMasterInstanceNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceMasterInstance)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceMasterInstance)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceMasterInstance or *InstanceMasterInstance", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceMasterInstance)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceMasterInstance)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceMasterInstance", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Region, actual.Region, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Region")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceReplicaConfigurationNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceReplicaConfiguration)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceReplicaConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaConfiguration or *InstanceReplicaConfiguration", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceReplicaConfiguration)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceReplicaConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaConfiguration", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.MysqlReplicaConfiguration, actual.MysqlReplicaConfiguration, dcl.DiffInfo{ObjectFunction: compareInstanceReplicaConfigurationMysqlReplicaConfigurationNewStyle, EmptyObject: EmptyInstanceReplicaConfigurationMysqlReplicaConfiguration, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("MysqlReplicaConfiguration")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.FailoverTarget, actual.FailoverTarget, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("FailoverTarget")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ReplicaPoolConfiguration, actual.ReplicaPoolConfiguration, dcl.DiffInfo{ObjectFunction: compareInstanceReplicaConfigurationReplicaPoolConfigurationNewStyle, EmptyObject: EmptyInstanceReplicaConfigurationReplicaPoolConfiguration, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ReplicaPoolConfiguration")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceReplicaConfigurationMysqlReplicaConfigurationNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceReplicaConfigurationMysqlReplicaConfiguration)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceReplicaConfigurationMysqlReplicaConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaConfigurationMysqlReplicaConfiguration or *InstanceReplicaConfigurationMysqlReplicaConfiguration", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceReplicaConfigurationMysqlReplicaConfiguration)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceReplicaConfigurationMysqlReplicaConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaConfigurationMysqlReplicaConfiguration", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.DumpFilePath, actual.DumpFilePath, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("DumpFilePath")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Username, actual.Username, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Username")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Password, actual.Password, dcl.DiffInfo{Ignore: true, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Password")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ConnectRetryInterval, actual.ConnectRetryInterval, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ConnectRetryInterval")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.MasterHeartbeatPeriod, actual.MasterHeartbeatPeriod, dcl.DiffInfo{ObjectFunction: compareInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodNewStyle, EmptyObject: EmptyInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("MasterHeartbeatPeriod")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CaCertificate, actual.CaCertificate, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("CaCertificate")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ClientCertificate, actual.ClientCertificate, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ClientCertificate")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ClientKey, actual.ClientKey, dcl.DiffInfo{Ignore: true, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ClientKey")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.SslCipher, actual.SslCipher, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("SslCipher")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.VerifyServerCertificate, actual.VerifyServerCertificate, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("VerifyServerCertificate")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod or *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Value, actual.Value, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Value")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceReplicaConfigurationReplicaPoolConfigurationNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceReplicaConfigurationReplicaPoolConfiguration)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceReplicaConfigurationReplicaPoolConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaConfigurationReplicaPoolConfiguration or *InstanceReplicaConfigurationReplicaPoolConfiguration", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceReplicaConfigurationReplicaPoolConfiguration)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceReplicaConfigurationReplicaPoolConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaConfigurationReplicaPoolConfiguration", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.StaticPoolConfiguration, actual.StaticPoolConfiguration, dcl.DiffInfo{ObjectFunction: compareInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationNewStyle, EmptyObject: EmptyInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("StaticPoolConfiguration")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.AutoscalingPoolConfiguration, actual.AutoscalingPoolConfiguration, dcl.DiffInfo{ObjectFunction: compareInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationNewStyle, EmptyObject: EmptyInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("AutoscalingPoolConfiguration")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ReplicaCount, actual.ReplicaCount, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ReplicaCount")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ExposeReplicaIP, actual.ExposeReplicaIP, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ExposeReplicaIP")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration or *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ReplicaCount, actual.ReplicaCount, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ReplicaCount")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ExposeReplicaIP, actual.ExposeReplicaIP, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ExposeReplicaIP")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration or *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.MinReplicaCount, actual.MinReplicaCount, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("MinReplicaCount")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.MaxReplicaCount, actual.MaxReplicaCount, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("MaxReplicaCount")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.TargetCpuUtil, actual.TargetCpuUtil, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("TargetCpuUtil")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceScheduledMaintenanceNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceScheduledMaintenance)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceScheduledMaintenance)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceScheduledMaintenance or *InstanceScheduledMaintenance", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceScheduledMaintenance)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceScheduledMaintenance)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceScheduledMaintenance", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.StartTime, actual.StartTime, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareInstanceScheduledMaintenanceStartTimeNewStyle, EmptyObject: EmptyInstanceScheduledMaintenanceStartTime, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("StartTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CanDefer, actual.CanDefer, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("CanDefer")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CanReschedule, actual.CanReschedule, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("CanReschedule")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceScheduledMaintenanceStartTimeNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceScheduledMaintenanceStartTime)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceScheduledMaintenanceStartTime)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceScheduledMaintenanceStartTime or *InstanceScheduledMaintenanceStartTime", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceScheduledMaintenanceStartTime)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceScheduledMaintenanceStartTime)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceScheduledMaintenanceStartTime", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Seconds, actual.Seconds, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Seconds")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Nanos, actual.Nanos, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Nanos")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettings)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettings)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettings or *InstanceSettings", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettings)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettings)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettings", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.AuthorizedGaeApplications, actual.AuthorizedGaeApplications, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("AuthorizedGaeApplications")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Tier, actual.Tier, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Tier")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.AvailabilityType, actual.AvailabilityType, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("AvailabilityType")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.PricingPlan, actual.PricingPlan, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("PricingPlan")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ReplicationType, actual.ReplicationType, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ReplicationType")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ActivationPolicy, actual.ActivationPolicy, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ActivationPolicy")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.StorageAutoResize, actual.StorageAutoResize, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("StorageAutoResize")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DataDiskType, actual.DataDiskType, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("DataDiskType")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DatabaseReplicationEnabled, actual.DatabaseReplicationEnabled, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("DatabaseReplicationEnabled")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CrashSafeReplicationEnabled, actual.CrashSafeReplicationEnabled, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("CrashSafeReplicationEnabled")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.SettingsVersion, actual.SettingsVersion, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareInstanceSettingsSettingsVersionNewStyle, EmptyObject: EmptyInstanceSettingsSettingsVersion, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("SettingsVersion")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.UserLabels, actual.UserLabels, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("UserLabels")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.StorageAutoResizeLimit, actual.StorageAutoResizeLimit, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsStorageAutoResizeLimitNewStyle, EmptyObject: EmptyInstanceSettingsStorageAutoResizeLimit, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("StorageAutoResizeLimit")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.IPConfiguration, actual.IPConfiguration, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsIPConfigurationNewStyle, EmptyObject: EmptyInstanceSettingsIPConfiguration, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("IPConfiguration")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.LocationPreference, actual.LocationPreference, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsLocationPreferenceNewStyle, EmptyObject: EmptyInstanceSettingsLocationPreference, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("LocationPreference")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DatabaseFlags, actual.DatabaseFlags, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsDatabaseFlagsNewStyle, EmptyObject: EmptyInstanceSettingsDatabaseFlags, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("DatabaseFlags")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.MaintenanceWindow, actual.MaintenanceWindow, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsMaintenanceWindowNewStyle, EmptyObject: EmptyInstanceSettingsMaintenanceWindow, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("MaintenanceWindow")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.BackupConfiguration, actual.BackupConfiguration, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsBackupConfigurationNewStyle, EmptyObject: EmptyInstanceSettingsBackupConfiguration, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("BackupConfiguration")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DataDiskSizeGb, actual.DataDiskSizeGb, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsDataDiskSizeGbNewStyle, EmptyObject: EmptyInstanceSettingsDataDiskSizeGb, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("DataDiskSizeGb")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ActiveDirectoryConfig, actual.ActiveDirectoryConfig, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsActiveDirectoryConfigNewStyle, EmptyObject: EmptyInstanceSettingsActiveDirectoryConfig, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ActiveDirectoryConfig")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Collation, actual.Collation, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Collation")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DenyMaintenancePeriods, actual.DenyMaintenancePeriods, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsDenyMaintenancePeriodsNewStyle, EmptyObject: EmptyInstanceSettingsDenyMaintenancePeriods, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("DenyMaintenancePeriods")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.InsightsConfig, actual.InsightsConfig, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsInsightsConfigNewStyle, EmptyObject: EmptyInstanceSettingsInsightsConfig, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("InsightsConfig")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsSettingsVersionNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsSettingsVersion)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsSettingsVersion)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsSettingsVersion or *InstanceSettingsSettingsVersion", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsSettingsVersion)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsSettingsVersion)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsSettingsVersion", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Value, actual.Value, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Value")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsStorageAutoResizeLimitNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsStorageAutoResizeLimit)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsStorageAutoResizeLimit)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsStorageAutoResizeLimit or *InstanceSettingsStorageAutoResizeLimit", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsStorageAutoResizeLimit)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsStorageAutoResizeLimit)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsStorageAutoResizeLimit", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Value, actual.Value, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Value")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsIPConfigurationNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsIPConfiguration)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsIPConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsIPConfiguration or *InstanceSettingsIPConfiguration", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsIPConfiguration)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsIPConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsIPConfiguration", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.IPv4Enabled, actual.IPv4Enabled, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("IPv4Enabled")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.PrivateNetwork, actual.PrivateNetwork, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("PrivateNetwork")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.RequireSsl, actual.RequireSsl, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("RequireSsl")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.AuthorizedNetworks, actual.AuthorizedNetworks, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsIPConfigurationAuthorizedNetworksNewStyle, EmptyObject: EmptyInstanceSettingsIPConfigurationAuthorizedNetworks, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("AuthorizedNetworks")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsIPConfigurationAuthorizedNetworksNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsIPConfigurationAuthorizedNetworks)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsIPConfigurationAuthorizedNetworks)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsIPConfigurationAuthorizedNetworks or *InstanceSettingsIPConfigurationAuthorizedNetworks", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsIPConfigurationAuthorizedNetworks)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsIPConfigurationAuthorizedNetworks)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsIPConfigurationAuthorizedNetworks", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Value, actual.Value, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Value")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ExpirationTime, actual.ExpirationTime, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ExpirationTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsLocationPreferenceNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsLocationPreference)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsLocationPreference)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsLocationPreference or *InstanceSettingsLocationPreference", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsLocationPreference)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsLocationPreference)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsLocationPreference", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Zone, actual.Zone, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Zone")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsDatabaseFlagsNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsDatabaseFlags)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsDatabaseFlags)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsDatabaseFlags or *InstanceSettingsDatabaseFlags", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsDatabaseFlags)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsDatabaseFlags)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsDatabaseFlags", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Value, actual.Value, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Value")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsMaintenanceWindowNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsMaintenanceWindow)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsMaintenanceWindow)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsMaintenanceWindow or *InstanceSettingsMaintenanceWindow", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsMaintenanceWindow)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsMaintenanceWindow)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsMaintenanceWindow", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Hour, actual.Hour, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Hour")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Day, actual.Day, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Day")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.UpdateTrack, actual.UpdateTrack, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("UpdateTrack")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsBackupConfigurationNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsBackupConfiguration)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsBackupConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsBackupConfiguration or *InstanceSettingsBackupConfiguration", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsBackupConfiguration)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsBackupConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsBackupConfiguration", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.StartTime, actual.StartTime, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("StartTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Enabled, actual.Enabled, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Enabled")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.BinaryLogEnabled, actual.BinaryLogEnabled, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("BinaryLogEnabled")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Location, actual.Location, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Location")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.BackupRetentionSettings, actual.BackupRetentionSettings, dcl.DiffInfo{ObjectFunction: compareInstanceSettingsBackupConfigurationBackupRetentionSettingsNewStyle, EmptyObject: EmptyInstanceSettingsBackupConfigurationBackupRetentionSettings, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("BackupRetentionSettings")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.TransactionLogRetentionDays, actual.TransactionLogRetentionDays, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("TransactionLogRetentionDays")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsBackupConfigurationBackupRetentionSettingsNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsBackupConfigurationBackupRetentionSettings)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsBackupConfigurationBackupRetentionSettings)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsBackupConfigurationBackupRetentionSettings or *InstanceSettingsBackupConfigurationBackupRetentionSettings", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsBackupConfigurationBackupRetentionSettings)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsBackupConfigurationBackupRetentionSettings)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsBackupConfigurationBackupRetentionSettings", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.RetentionUnit, actual.RetentionUnit, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("RetentionUnit")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.RetainedBackups, actual.RetainedBackups, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("RetainedBackups")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsDataDiskSizeGbNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsDataDiskSizeGb)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsDataDiskSizeGb)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsDataDiskSizeGb or *InstanceSettingsDataDiskSizeGb", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsDataDiskSizeGb)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsDataDiskSizeGb)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsDataDiskSizeGb", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Value, actual.Value, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Value")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsActiveDirectoryConfigNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsActiveDirectoryConfig)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsActiveDirectoryConfig)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsActiveDirectoryConfig or *InstanceSettingsActiveDirectoryConfig", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsActiveDirectoryConfig)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsActiveDirectoryConfig)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsActiveDirectoryConfig", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Domain, actual.Domain, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Domain")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsDenyMaintenancePeriodsNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsDenyMaintenancePeriods)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsDenyMaintenancePeriods)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsDenyMaintenancePeriods or *InstanceSettingsDenyMaintenancePeriods", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsDenyMaintenancePeriods)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsDenyMaintenancePeriods)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsDenyMaintenancePeriods", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.StartDate, actual.StartDate, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("StartDate")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.EndDate, actual.EndDate, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("EndDate")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Time, actual.Time, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Time")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceSettingsInsightsConfigNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceSettingsInsightsConfig)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceSettingsInsightsConfig)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsInsightsConfig or *InstanceSettingsInsightsConfig", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceSettingsInsightsConfig)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceSettingsInsightsConfig)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceSettingsInsightsConfig", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.QueryInsightsEnabled, actual.QueryInsightsEnabled, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("QueryInsightsEnabled")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.RecordClientAddress, actual.RecordClientAddress, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("RecordClientAddress")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.RecordApplicationTags, actual.RecordApplicationTags, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("RecordApplicationTags")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.QueryStringLength, actual.QueryStringLength, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("QueryStringLength")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceReplicaInstancesNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceReplicaInstances)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceReplicaInstances)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaInstances or *InstanceReplicaInstances", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceReplicaInstances)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceReplicaInstances)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceReplicaInstances", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Region, actual.Region, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Region")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceServerCaCertNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceServerCaCert)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceServerCaCert)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceServerCaCert or *InstanceServerCaCert", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceServerCaCert)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceServerCaCert)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceServerCaCert", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CertSerialNumber, actual.CertSerialNumber, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("CertSerialNumber")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Cert, actual.Cert, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Cert")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CreateTime, actual.CreateTime, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("CreateTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CommonName, actual.CommonName, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("CommonName")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ExpirationTime, actual.ExpirationTime, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ExpirationTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Sha1Fingerprint, actual.Sha1Fingerprint, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Sha1Fingerprint")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Instance, actual.Instance, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Instance")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceOnPremisesConfigurationNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceOnPremisesConfiguration)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceOnPremisesConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceOnPremisesConfiguration or *InstanceOnPremisesConfiguration", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceOnPremisesConfiguration)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceOnPremisesConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceOnPremisesConfiguration", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.HostPort, actual.HostPort, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("HostPort")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Username, actual.Username, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Username")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Password, actual.Password, dcl.DiffInfo{Ignore: true, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Password")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CaCertificate, actual.CaCertificate, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("CaCertificate")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ClientCertificate, actual.ClientCertificate, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ClientCertificate")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ClientKey, actual.ClientKey, dcl.DiffInfo{Ignore: true, OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ClientKey")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.DumpFilePath, actual.DumpFilePath, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("DumpFilePath")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Database, actual.Database, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("Database")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ReplicatedDatabases, actual.ReplicatedDatabases, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateInstancePatchOperation")}, fn.AddNest("ReplicatedDatabases")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareInstanceDiskEncryptionStatusNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*InstanceDiskEncryptionStatus)
+	if !ok {
+		desiredNotPointer, ok := d.(InstanceDiskEncryptionStatus)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceDiskEncryptionStatus or *InstanceDiskEncryptionStatus", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*InstanceDiskEncryptionStatus)
+	if !ok {
+		actualNotPointer, ok := a.(InstanceDiskEncryptionStatus)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a InstanceDiskEncryptionStatus", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.KmsKeyVersionName, actual.KmsKeyVersionName, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("KmsKeyVersionName")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Kind, actual.Kind, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Kind")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+// urlNormalized returns a copy of the resource struct with values normalized
+// for URL substitutions. For instance, it converts long-form self-links to
+// short-form so they can be substituted in.
+func (r *Instance) urlNormalized() *Instance {
+	normalized := dcl.Copy(*r).(Instance)
+	normalized.ConnectionName = dcl.SelfLinkToName(r.ConnectionName)
+	normalized.Etag = dcl.SelfLinkToName(r.Etag)
+	normalized.GceZone = dcl.SelfLinkToName(r.GceZone)
+	normalized.MasterInstanceName = dcl.SelfLinkToName(r.MasterInstanceName)
+	normalized.Name = dcl.SelfLinkToName(r.Name)
+	normalized.Project = dcl.SelfLinkToName(r.Project)
+	normalized.Region = dcl.SelfLinkToName(r.Region)
+	normalized.RootPassword = dcl.SelfLinkToName(r.RootPassword)
+	normalized.State = dcl.SelfLinkToName(r.State)
+	normalized.IPv6Address = dcl.SelfLinkToName(r.IPv6Address)
+	normalized.ServiceAccountEmailAddress = dcl.SelfLinkToName(r.ServiceAccountEmailAddress)
+	normalized.InstanceUid = dcl.SelfLinkToName(r.InstanceUid)
+	return &normalized
+}
+
+func (r *Instance) updateURL(userBasePath, updateName string) (string, error) {
+	nr := r.urlNormalized()
+	if updateName == "Patch" {
+		fields := map[string]interface{}{
+			"project": dcl.ValueOrEmptyString(nr.Project),
+			"name":    dcl.ValueOrEmptyString(nr.Name),
+		}
+		return dcl.URL("projects/{{project}}/instances/{{name}}", nr.basePath(), userBasePath, fields), nil
+
+	}
+
+	return "", fmt.Errorf("unknown update name: %s", updateName)
+}
+
+// marshal encodes the Instance resource into JSON for a Create request, and
+// performs transformations from the resource schema to the API schema if
+// necessary.
+func (r *Instance) marshal(c *Client) ([]byte, error) {
+	m, err := expandInstance(c, r)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling Instance: %w", err)
+	}
+
+	return json.Marshal(m)
+}
+
+// unmarshalInstance decodes JSON responses into the Instance resource schema.
+func unmarshalInstance(b []byte, c *Client, res *Instance) (*Instance, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return unmarshalMapInstance(m, c, res)
+}
+
+func unmarshalMapInstance(m map[string]interface{}, c *Client, res *Instance) (*Instance, error) {
+
+	flattened := flattenInstance(c, m, res)
+	if flattened == nil {
+		return nil, fmt.Errorf("attempted to flatten empty json object")
+	}
+	return flattened, nil
+}
+
+// expandInstance expands Instance into a JSON request object.
+func expandInstance(c *Client, f *Instance) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	res := f
+	_ = res
+	if v := f.BackendType; dcl.ValueShouldBeSent(v) {
+		m["backendType"] = v
+	}
+	if v := f.DatabaseVersion; dcl.ValueShouldBeSent(v) {
+		m["databaseVersion"] = v
+	}
+	if v := f.InstanceType; dcl.ValueShouldBeSent(v) {
+		m["instanceType"] = v
+	}
+	if v := f.MasterInstanceName; dcl.ValueShouldBeSent(v) {
+		m["masterInstanceName"] = v
+	}
+	if v, err := expandSqlInstanceMaxDiskSize(c, f.MaxDiskSize, res); err != nil {
+		return nil, fmt.Errorf("error expanding MaxDiskSize into maxDiskSize: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["maxDiskSize"] = v
+	}
+	if v := f.Name; dcl.ValueShouldBeSent(v) {
+		m["name"] = v
+	}
+	if v, err := dcl.EmptyValue(); err != nil {
+		return nil, fmt.Errorf("error expanding Project into project: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["project"] = v
+	}
+	if v := f.Region; dcl.ValueShouldBeSent(v) {
+		m["region"] = v
+	}
+	if v := f.RootPassword; dcl.ValueShouldBeSent(v) {
+		m["rootPassword"] = v
+	}
+	if v, err := expandInstanceDiskEncryptionConfiguration(c, f.DiskEncryptionConfiguration, res); err != nil {
+		return nil, fmt.Errorf("error expanding DiskEncryptionConfiguration into diskEncryptionConfiguration: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["diskEncryptionConfiguration"] = v
+	}
+	if v, err := expandInstanceFailoverReplica(c, f.FailoverReplica, res); err != nil {
+		return nil, fmt.Errorf("error expanding FailoverReplica into failoverReplica: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["failoverReplica"] = v
+	}
+	if v, err := expandInstanceMasterInstance(c, f.MasterInstance, res); err != nil {
+		return nil, fmt.Errorf("error expanding MasterInstance into masterInstance: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["masterInstance"] = v
+	}
+	if v, err := expandInstanceReplicaConfiguration(c, f.ReplicaConfiguration, res); err != nil {
+		return nil, fmt.Errorf("error expanding ReplicaConfiguration into replicaConfiguration: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["replicaConfiguration"] = v
+	}
+	if v, err := expandInstanceSettings(c, f.Settings, res); err != nil {
+		return nil, fmt.Errorf("error expanding Settings into settings: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["settings"] = v
+	}
+	if v, err := expandInstanceOnPremisesConfiguration(c, f.OnPremisesConfiguration, res); err != nil {
+		return nil, fmt.Errorf("error expanding OnPremisesConfiguration into onPremisesConfiguration: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["onPremisesConfiguration"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstance flattens Instance from a JSON request object into the
+// Instance type.
+func flattenInstance(c *Client, i interface{}, res *Instance) *Instance {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if len(m) == 0 {
+		return nil
+	}
+
+	resultRes := &Instance{}
+	resultRes.BackendType = flattenInstanceBackendTypeEnum(m["backendType"])
+	resultRes.ConnectionName = dcl.FlattenString(m["connectionName"])
+	resultRes.DatabaseVersion = flattenInstanceDatabaseVersionEnum(m["databaseVersion"])
+	resultRes.Etag = dcl.FlattenString(m["etag"])
+	resultRes.GceZone = dcl.FlattenString(m["gceZone"])
+	resultRes.InstanceType = flattenInstanceInstanceTypeEnum(m["instanceType"])
+	resultRes.MasterInstanceName = dcl.FlattenString(m["masterInstanceName"])
+	resultRes.MaxDiskSize = flattenSqlInstanceMaxDiskSize(c, m["maxDiskSize"], res)
+	resultRes.Name = dcl.FlattenString(m["name"])
+	resultRes.Project = dcl.FlattenString(m["project"])
+	resultRes.Region = dcl.FlattenString(m["region"])
+	resultRes.RootPassword = dcl.FlattenSecretValue(m["rootPassword"])
+	resultRes.CurrentDiskSize = flattenSqlInstanceCurrentDiskSize(c, m["currentDiskSize"], res)
+	resultRes.DiskEncryptionConfiguration = flattenInstanceDiskEncryptionConfiguration(c, m["diskEncryptionConfiguration"], res)
+	resultRes.FailoverReplica = flattenInstanceFailoverReplica(c, m["failoverReplica"], res)
+	resultRes.IPAddresses = flattenInstanceIPAddressesSlice(c, m["ipAddresses"], res)
+	resultRes.MasterInstance = flattenInstanceMasterInstance(c, m["masterInstance"], res)
+	resultRes.ReplicaConfiguration = flattenInstanceReplicaConfiguration(c, m["replicaConfiguration"], res)
+	resultRes.ScheduledMaintenance = flattenInstanceScheduledMaintenance(c, m["scheduledMaintenance"], res)
+	resultRes.Settings = flattenInstanceSettings(c, m["settings"], res)
+	resultRes.State = dcl.FlattenString(m["state"])
+	resultRes.ReplicaInstances = flattenInstanceReplicaInstancesSlice(c, m["replicaInstances"], res)
+	resultRes.ServerCaCert = flattenInstanceServerCaCert(c, m["serverCaCert"], res)
+	resultRes.IPv6Address = dcl.FlattenString(m["ipv6Address"])
+	resultRes.ServiceAccountEmailAddress = dcl.FlattenString(m["serviceAccountEmailAddress"])
+	resultRes.OnPremisesConfiguration = flattenInstanceOnPremisesConfiguration(c, m["onPremisesConfiguration"], res)
+	resultRes.SuspensionReason = dcl.FlattenStringSlice(m["suspensionReason"])
+	resultRes.DiskEncryptionStatus = flattenInstanceDiskEncryptionStatus(c, m["diskEncryptionStatus"], res)
+	resultRes.InstanceUid = dcl.FlattenString(m["instanceUid"])
+
+	return resultRes
+}
+
+// expandInstanceMaxDiskSizeMap expands the contents of InstanceMaxDiskSize into a JSON
+// request object.
+func expandInstanceMaxDiskSizeMap(c *Client, f map[string]InstanceMaxDiskSize, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceMaxDiskSize(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceMaxDiskSizeSlice expands the contents of InstanceMaxDiskSize into a JSON
+// request object.
+func expandInstanceMaxDiskSizeSlice(c *Client, f []InstanceMaxDiskSize, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceMaxDiskSize(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceMaxDiskSizeMap flattens the contents of InstanceMaxDiskSize from a JSON
+// response object.
+func flattenInstanceMaxDiskSizeMap(c *Client, i interface{}, res *Instance) map[string]InstanceMaxDiskSize {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceMaxDiskSize{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceMaxDiskSize{}
+	}
+
+	items := make(map[string]InstanceMaxDiskSize)
+	for k, item := range a {
+		items[k] = *flattenInstanceMaxDiskSize(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceMaxDiskSizeSlice flattens the contents of InstanceMaxDiskSize from a JSON
+// response object.
+func flattenInstanceMaxDiskSizeSlice(c *Client, i interface{}, res *Instance) []InstanceMaxDiskSize {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceMaxDiskSize{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceMaxDiskSize{}
+	}
+
+	items := make([]InstanceMaxDiskSize, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceMaxDiskSize(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceMaxDiskSize expands an instance of InstanceMaxDiskSize into a JSON
+// request object.
+func expandInstanceMaxDiskSize(c *Client, f *InstanceMaxDiskSize, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Value; !dcl.IsEmptyValueIndirect(v) {
+		m["value"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceMaxDiskSize flattens an instance of InstanceMaxDiskSize from a JSON
+// response object.
+func flattenInstanceMaxDiskSize(c *Client, i interface{}, res *Instance) *InstanceMaxDiskSize {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceMaxDiskSize{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceMaxDiskSize
+	}
+	r.Value = dcl.FlattenInteger(m["value"])
+
+	return r
+}
+
+// expandInstanceCurrentDiskSizeMap expands the contents of InstanceCurrentDiskSize into a JSON
+// request object.
+func expandInstanceCurrentDiskSizeMap(c *Client, f map[string]InstanceCurrentDiskSize, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceCurrentDiskSize(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceCurrentDiskSizeSlice expands the contents of InstanceCurrentDiskSize into a JSON
+// request object.
+func expandInstanceCurrentDiskSizeSlice(c *Client, f []InstanceCurrentDiskSize, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceCurrentDiskSize(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceCurrentDiskSizeMap flattens the contents of InstanceCurrentDiskSize from a JSON
+// response object.
+func flattenInstanceCurrentDiskSizeMap(c *Client, i interface{}, res *Instance) map[string]InstanceCurrentDiskSize {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceCurrentDiskSize{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceCurrentDiskSize{}
+	}
+
+	items := make(map[string]InstanceCurrentDiskSize)
+	for k, item := range a {
+		items[k] = *flattenInstanceCurrentDiskSize(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceCurrentDiskSizeSlice flattens the contents of InstanceCurrentDiskSize from a JSON
+// response object.
+func flattenInstanceCurrentDiskSizeSlice(c *Client, i interface{}, res *Instance) []InstanceCurrentDiskSize {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceCurrentDiskSize{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceCurrentDiskSize{}
+	}
+
+	items := make([]InstanceCurrentDiskSize, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceCurrentDiskSize(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceCurrentDiskSize expands an instance of InstanceCurrentDiskSize into a JSON
+// request object.
+func expandInstanceCurrentDiskSize(c *Client, f *InstanceCurrentDiskSize, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenInstanceCurrentDiskSize flattens an instance of InstanceCurrentDiskSize from a JSON
+// response object.
+func flattenInstanceCurrentDiskSize(c *Client, i interface{}, res *Instance) *InstanceCurrentDiskSize {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceCurrentDiskSize{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceCurrentDiskSize
+	}
+	r.Value = dcl.FlattenInteger(m["value"])
+
+	return r
+}
+
+// expandInstanceDiskEncryptionConfigurationMap expands the contents of InstanceDiskEncryptionConfiguration into a JSON
+// request object.
+func expandInstanceDiskEncryptionConfigurationMap(c *Client, f map[string]InstanceDiskEncryptionConfiguration, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceDiskEncryptionConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceDiskEncryptionConfigurationSlice expands the contents of InstanceDiskEncryptionConfiguration into a JSON
+// request object.
+func expandInstanceDiskEncryptionConfigurationSlice(c *Client, f []InstanceDiskEncryptionConfiguration, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceDiskEncryptionConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceDiskEncryptionConfigurationMap flattens the contents of InstanceDiskEncryptionConfiguration from a JSON
+// response object.
+func flattenInstanceDiskEncryptionConfigurationMap(c *Client, i interface{}, res *Instance) map[string]InstanceDiskEncryptionConfiguration {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceDiskEncryptionConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceDiskEncryptionConfiguration{}
+	}
+
+	items := make(map[string]InstanceDiskEncryptionConfiguration)
+	for k, item := range a {
+		items[k] = *flattenInstanceDiskEncryptionConfiguration(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceDiskEncryptionConfigurationSlice flattens the contents of InstanceDiskEncryptionConfiguration from a JSON
+// response object.
+func flattenInstanceDiskEncryptionConfigurationSlice(c *Client, i interface{}, res *Instance) []InstanceDiskEncryptionConfiguration {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceDiskEncryptionConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceDiskEncryptionConfiguration{}
+	}
+
+	items := make([]InstanceDiskEncryptionConfiguration, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceDiskEncryptionConfiguration(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceDiskEncryptionConfiguration expands an instance of InstanceDiskEncryptionConfiguration into a JSON
+// request object.
+func expandInstanceDiskEncryptionConfiguration(c *Client, f *InstanceDiskEncryptionConfiguration, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.KmsKeyName; !dcl.IsEmptyValueIndirect(v) {
+		m["kmsKeyName"] = v
+	}
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceDiskEncryptionConfiguration flattens an instance of InstanceDiskEncryptionConfiguration from a JSON
+// response object.
+func flattenInstanceDiskEncryptionConfiguration(c *Client, i interface{}, res *Instance) *InstanceDiskEncryptionConfiguration {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceDiskEncryptionConfiguration{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceDiskEncryptionConfiguration
+	}
+	r.KmsKeyName = dcl.FlattenString(m["kmsKeyName"])
+	r.Kind = dcl.FlattenString(m["kind"])
+
+	return r
+}
+
+// expandInstanceFailoverReplicaMap expands the contents of InstanceFailoverReplica into a JSON
+// request object.
+func expandInstanceFailoverReplicaMap(c *Client, f map[string]InstanceFailoverReplica, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceFailoverReplica(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceFailoverReplicaSlice expands the contents of InstanceFailoverReplica into a JSON
+// request object.
+func expandInstanceFailoverReplicaSlice(c *Client, f []InstanceFailoverReplica, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceFailoverReplica(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceFailoverReplicaMap flattens the contents of InstanceFailoverReplica from a JSON
+// response object.
+func flattenInstanceFailoverReplicaMap(c *Client, i interface{}, res *Instance) map[string]InstanceFailoverReplica {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceFailoverReplica{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceFailoverReplica{}
+	}
+
+	items := make(map[string]InstanceFailoverReplica)
+	for k, item := range a {
+		items[k] = *flattenInstanceFailoverReplica(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceFailoverReplicaSlice flattens the contents of InstanceFailoverReplica from a JSON
+// response object.
+func flattenInstanceFailoverReplicaSlice(c *Client, i interface{}, res *Instance) []InstanceFailoverReplica {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceFailoverReplica{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceFailoverReplica{}
+	}
+
+	items := make([]InstanceFailoverReplica, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceFailoverReplica(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceFailoverReplica expands an instance of InstanceFailoverReplica into a JSON
+// request object.
+func expandInstanceFailoverReplica(c *Client, f *InstanceFailoverReplica, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Name; !dcl.IsEmptyValueIndirect(v) {
+		m["name"] = v
+	}
+	if v, err := expandInstanceFailoverReplicaFailoverInstance(c, f.FailoverInstance, res); err != nil {
+		return nil, fmt.Errorf("error expanding FailoverInstance into failoverInstance: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["failoverInstance"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceFailoverReplica flattens an instance of InstanceFailoverReplica from a JSON
+// response object.
+func flattenInstanceFailoverReplica(c *Client, i interface{}, res *Instance) *InstanceFailoverReplica {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceFailoverReplica{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceFailoverReplica
+	}
+	r.Name = dcl.FlattenString(m["name"])
+	r.Available = dcl.FlattenBool(m["available"])
+	r.FailoverInstance = flattenInstanceFailoverReplicaFailoverInstance(c, m["failoverInstance"], res)
+
+	return r
+}
+
+// expandInstanceFailoverReplicaFailoverInstanceMap expands the contents of InstanceFailoverReplicaFailoverInstance into a JSON
+// request object.
+func expandInstanceFailoverReplicaFailoverInstanceMap(c *Client, f map[string]InstanceFailoverReplicaFailoverInstance, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceFailoverReplicaFailoverInstance(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceFailoverReplicaFailoverInstanceSlice expands the contents of InstanceFailoverReplicaFailoverInstance into a JSON
+// request object.
+func expandInstanceFailoverReplicaFailoverInstanceSlice(c *Client, f []InstanceFailoverReplicaFailoverInstance, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceFailoverReplicaFailoverInstance(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceFailoverReplicaFailoverInstanceMap flattens the contents of InstanceFailoverReplicaFailoverInstance from a JSON
+// response object.
+func flattenInstanceFailoverReplicaFailoverInstanceMap(c *Client, i interface{}, res *Instance) map[string]InstanceFailoverReplicaFailoverInstance {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceFailoverReplicaFailoverInstance{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceFailoverReplicaFailoverInstance{}
+	}
+
+	items := make(map[string]InstanceFailoverReplicaFailoverInstance)
+	for k, item := range a {
+		items[k] = *flattenInstanceFailoverReplicaFailoverInstance(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceFailoverReplicaFailoverInstanceSlice flattens the contents of InstanceFailoverReplicaFailoverInstance from a JSON
+// response object.
+func flattenInstanceFailoverReplicaFailoverInstanceSlice(c *Client, i interface{}, res *Instance) []InstanceFailoverReplicaFailoverInstance {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceFailoverReplicaFailoverInstance{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceFailoverReplicaFailoverInstance{}
+	}
+
+	items := make([]InstanceFailoverReplicaFailoverInstance, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceFailoverReplicaFailoverInstance(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceFailoverReplicaFailoverInstance expands an instance of InstanceFailoverReplicaFailoverInstance into a JSON
+// request object.
+func expandInstanceFailoverReplicaFailoverInstance(c *Client, f *InstanceFailoverReplicaFailoverInstance, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Name; !dcl.IsEmptyValueIndirect(v) {
+		m["name"] = v
+	}
+	if v := f.Region; !dcl.IsEmptyValueIndirect(v) {
+		m["region"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceFailoverReplicaFailoverInstance flattens an instance of InstanceFailoverReplicaFailoverInstance from a JSON
+// response object.
+func flattenInstanceFailoverReplicaFailoverInstance(c *Client, i interface{}, res *Instance) *InstanceFailoverReplicaFailoverInstance {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceFailoverReplicaFailoverInstance{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceFailoverReplicaFailoverInstance
+	}
+	r.Name = dcl.FlattenString(m["name"])
+	r.Region = dcl.FlattenString(m["region"])
+
+	return r
+}
+
+// expandInstanceIPAddressesMap expands the contents of InstanceIPAddresses into a JSON
+// request object.
+func expandInstanceIPAddressesMap(c *Client, f map[string]InstanceIPAddresses, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceIPAddresses(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceIPAddressesSlice expands the contents of InstanceIPAddresses into a JSON
+// request object.
+func expandInstanceIPAddressesSlice(c *Client, f []InstanceIPAddresses, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceIPAddresses(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceIPAddressesMap flattens the contents of InstanceIPAddresses from a JSON
+// response object.
+func flattenInstanceIPAddressesMap(c *Client, i interface{}, res *Instance) map[string]InstanceIPAddresses {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceIPAddresses{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceIPAddresses{}
+	}
+
+	items := make(map[string]InstanceIPAddresses)
+	for k, item := range a {
+		items[k] = *flattenInstanceIPAddresses(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceIPAddressesSlice flattens the contents of InstanceIPAddresses from a JSON
+// response object.
+func flattenInstanceIPAddressesSlice(c *Client, i interface{}, res *Instance) []InstanceIPAddresses {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceIPAddresses{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceIPAddresses{}
+	}
+
+	items := make([]InstanceIPAddresses, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceIPAddresses(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceIPAddresses expands an instance of InstanceIPAddresses into a JSON
+// request object.
+func expandInstanceIPAddresses(c *Client, f *InstanceIPAddresses, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenInstanceIPAddresses flattens an instance of InstanceIPAddresses from a JSON
+// response object.
+func flattenInstanceIPAddresses(c *Client, i interface{}, res *Instance) *InstanceIPAddresses {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceIPAddresses{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceIPAddresses
+	}
+	r.Type = flattenInstanceIPAddressesTypeEnum(m["type"])
+	r.IPAddress = dcl.FlattenString(m["ipAddress"])
+	r.TimeToRetire = flattenSqlInstanceIPAddressesTimeToRetire(c, m["timeToRetire"], res)
+
+	return r
+}
+
+// expandInstanceIPAddressesTimeToRetireMap expands the contents of InstanceIPAddressesTimeToRetire into a JSON
+// request object.
+func expandInstanceIPAddressesTimeToRetireMap(c *Client, f map[string]InstanceIPAddressesTimeToRetire, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceIPAddressesTimeToRetire(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceIPAddressesTimeToRetireSlice expands the contents of InstanceIPAddressesTimeToRetire into a JSON
+// request object.
+func expandInstanceIPAddressesTimeToRetireSlice(c *Client, f []InstanceIPAddressesTimeToRetire, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceIPAddressesTimeToRetire(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceIPAddressesTimeToRetireMap flattens the contents of InstanceIPAddressesTimeToRetire from a JSON
+// response object.
+func flattenInstanceIPAddressesTimeToRetireMap(c *Client, i interface{}, res *Instance) map[string]InstanceIPAddressesTimeToRetire {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceIPAddressesTimeToRetire{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceIPAddressesTimeToRetire{}
+	}
+
+	items := make(map[string]InstanceIPAddressesTimeToRetire)
+	for k, item := range a {
+		items[k] = *flattenInstanceIPAddressesTimeToRetire(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceIPAddressesTimeToRetireSlice flattens the contents of InstanceIPAddressesTimeToRetire from a JSON
+// response object.
+func flattenInstanceIPAddressesTimeToRetireSlice(c *Client, i interface{}, res *Instance) []InstanceIPAddressesTimeToRetire {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceIPAddressesTimeToRetire{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceIPAddressesTimeToRetire{}
+	}
+
+	items := make([]InstanceIPAddressesTimeToRetire, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceIPAddressesTimeToRetire(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceIPAddressesTimeToRetire expands an instance of InstanceIPAddressesTimeToRetire into a JSON
+// request object.
+func expandInstanceIPAddressesTimeToRetire(c *Client, f *InstanceIPAddressesTimeToRetire, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenInstanceIPAddressesTimeToRetire flattens an instance of InstanceIPAddressesTimeToRetire from a JSON
+// response object.
+func flattenInstanceIPAddressesTimeToRetire(c *Client, i interface{}, res *Instance) *InstanceIPAddressesTimeToRetire {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceIPAddressesTimeToRetire{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceIPAddressesTimeToRetire
+	}
+	r.Seconds = dcl.FlattenInteger(m["seconds"])
+	r.Nanos = dcl.FlattenInteger(m["nanos"])
+
+	return r
+}
+
+// expandInstanceMasterInstanceMap expands the contents of InstanceMasterInstance into a JSON
+// request object.
+func expandInstanceMasterInstanceMap(c *Client, f map[string]InstanceMasterInstance, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceMasterInstance(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceMasterInstanceSlice expands the contents of InstanceMasterInstance into a JSON
+// request object.
+func expandInstanceMasterInstanceSlice(c *Client, f []InstanceMasterInstance, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceMasterInstance(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceMasterInstanceMap flattens the contents of InstanceMasterInstance from a JSON
+// response object.
+func flattenInstanceMasterInstanceMap(c *Client, i interface{}, res *Instance) map[string]InstanceMasterInstance {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceMasterInstance{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceMasterInstance{}
+	}
+
+	items := make(map[string]InstanceMasterInstance)
+	for k, item := range a {
+		items[k] = *flattenInstanceMasterInstance(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceMasterInstanceSlice flattens the contents of InstanceMasterInstance from a JSON
+// response object.
+func flattenInstanceMasterInstanceSlice(c *Client, i interface{}, res *Instance) []InstanceMasterInstance {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceMasterInstance{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceMasterInstance{}
+	}
+
+	items := make([]InstanceMasterInstance, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceMasterInstance(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceMasterInstance expands an instance of InstanceMasterInstance into a JSON
+// request object.
+func expandInstanceMasterInstance(c *Client, f *InstanceMasterInstance, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Name; !dcl.IsEmptyValueIndirect(v) {
+		m["name"] = v
+	}
+	if v := f.Region; !dcl.IsEmptyValueIndirect(v) {
+		m["region"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceMasterInstance flattens an instance of InstanceMasterInstance from a JSON
+// response object.
+func flattenInstanceMasterInstance(c *Client, i interface{}, res *Instance) *InstanceMasterInstance {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceMasterInstance{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceMasterInstance
+	}
+	r.Name = dcl.FlattenString(m["name"])
+	r.Region = dcl.FlattenString(m["region"])
+
+	return r
+}
+
+// expandInstanceReplicaConfigurationMap expands the contents of InstanceReplicaConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationMap(c *Client, f map[string]InstanceReplicaConfiguration, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceReplicaConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceReplicaConfigurationSlice expands the contents of InstanceReplicaConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationSlice(c *Client, f []InstanceReplicaConfiguration, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceReplicaConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceReplicaConfigurationMap flattens the contents of InstanceReplicaConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationMap(c *Client, i interface{}, res *Instance) map[string]InstanceReplicaConfiguration {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceReplicaConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceReplicaConfiguration{}
+	}
+
+	items := make(map[string]InstanceReplicaConfiguration)
+	for k, item := range a {
+		items[k] = *flattenInstanceReplicaConfiguration(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceReplicaConfigurationSlice flattens the contents of InstanceReplicaConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationSlice(c *Client, i interface{}, res *Instance) []InstanceReplicaConfiguration {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceReplicaConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceReplicaConfiguration{}
+	}
+
+	items := make([]InstanceReplicaConfiguration, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceReplicaConfiguration(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceReplicaConfiguration expands an instance of InstanceReplicaConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfiguration(c *Client, f *InstanceReplicaConfiguration, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+	if v, err := expandInstanceReplicaConfigurationMysqlReplicaConfiguration(c, f.MysqlReplicaConfiguration, res); err != nil {
+		return nil, fmt.Errorf("error expanding MysqlReplicaConfiguration into mysqlReplicaConfiguration: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["mysqlReplicaConfiguration"] = v
+	}
+	if v := f.FailoverTarget; !dcl.IsEmptyValueIndirect(v) {
+		m["failoverTarget"] = v
+	}
+	if v, err := expandInstanceReplicaConfigurationReplicaPoolConfiguration(c, f.ReplicaPoolConfiguration, res); err != nil {
+		return nil, fmt.Errorf("error expanding ReplicaPoolConfiguration into replicaPoolConfiguration: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["replicaPoolConfiguration"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceReplicaConfiguration flattens an instance of InstanceReplicaConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfiguration(c *Client, i interface{}, res *Instance) *InstanceReplicaConfiguration {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceReplicaConfiguration{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceReplicaConfiguration
+	}
+	r.Kind = dcl.FlattenString(m["kind"])
+	r.MysqlReplicaConfiguration = flattenInstanceReplicaConfigurationMysqlReplicaConfiguration(c, m["mysqlReplicaConfiguration"], res)
+	r.FailoverTarget = dcl.FlattenBool(m["failoverTarget"])
+	r.ReplicaPoolConfiguration = flattenInstanceReplicaConfigurationReplicaPoolConfiguration(c, m["replicaPoolConfiguration"], res)
+
+	return r
+}
+
+// expandInstanceReplicaConfigurationMysqlReplicaConfigurationMap expands the contents of InstanceReplicaConfigurationMysqlReplicaConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationMysqlReplicaConfigurationMap(c *Client, f map[string]InstanceReplicaConfigurationMysqlReplicaConfiguration, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceReplicaConfigurationMysqlReplicaConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceReplicaConfigurationMysqlReplicaConfigurationSlice expands the contents of InstanceReplicaConfigurationMysqlReplicaConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationMysqlReplicaConfigurationSlice(c *Client, f []InstanceReplicaConfigurationMysqlReplicaConfiguration, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceReplicaConfigurationMysqlReplicaConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceReplicaConfigurationMysqlReplicaConfigurationMap flattens the contents of InstanceReplicaConfigurationMysqlReplicaConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationMysqlReplicaConfigurationMap(c *Client, i interface{}, res *Instance) map[string]InstanceReplicaConfigurationMysqlReplicaConfiguration {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceReplicaConfigurationMysqlReplicaConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceReplicaConfigurationMysqlReplicaConfiguration{}
+	}
+
+	items := make(map[string]InstanceReplicaConfigurationMysqlReplicaConfiguration)
+	for k, item := range a {
+		items[k] = *flattenInstanceReplicaConfigurationMysqlReplicaConfiguration(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceReplicaConfigurationMysqlReplicaConfigurationSlice flattens the contents of InstanceReplicaConfigurationMysqlReplicaConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationMysqlReplicaConfigurationSlice(c *Client, i interface{}, res *Instance) []InstanceReplicaConfigurationMysqlReplicaConfiguration {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceReplicaConfigurationMysqlReplicaConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceReplicaConfigurationMysqlReplicaConfiguration{}
+	}
+
+	items := make([]InstanceReplicaConfigurationMysqlReplicaConfiguration, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceReplicaConfigurationMysqlReplicaConfiguration(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceReplicaConfigurationMysqlReplicaConfiguration expands an instance of InstanceReplicaConfigurationMysqlReplicaConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationMysqlReplicaConfiguration(c *Client, f *InstanceReplicaConfigurationMysqlReplicaConfiguration, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.DumpFilePath; !dcl.IsEmptyValueIndirect(v) {
+		m["dumpFilePath"] = v
+	}
+	if v := f.Username; !dcl.IsEmptyValueIndirect(v) {
+		m["username"] = v
+	}
+	if v := f.Password; !dcl.IsEmptyValueIndirect(v) {
+		m["password"] = v
+	}
+	if v := f.ConnectRetryInterval; !dcl.IsEmptyValueIndirect(v) {
+		m["connectRetryInterval"] = v
+	}
+	if v, err := expandSqlInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(c, f.MasterHeartbeatPeriod, res); err != nil {
+		return nil, fmt.Errorf("error expanding MasterHeartbeatPeriod into masterHeartbeatPeriod: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["masterHeartbeatPeriod"] = v
+	}
+	if v := f.CaCertificate; !dcl.IsEmptyValueIndirect(v) {
+		m["caCertificate"] = v
+	}
+	if v := f.ClientCertificate; !dcl.IsEmptyValueIndirect(v) {
+		m["clientCertificate"] = v
+	}
+	if v := f.ClientKey; !dcl.IsEmptyValueIndirect(v) {
+		m["clientKey"] = v
+	}
+	if v := f.SslCipher; !dcl.IsEmptyValueIndirect(v) {
+		m["sslCipher"] = v
+	}
+	if v := f.VerifyServerCertificate; !dcl.IsEmptyValueIndirect(v) {
+		m["verifyServerCertificate"] = v
+	}
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceReplicaConfigurationMysqlReplicaConfiguration flattens an instance of InstanceReplicaConfigurationMysqlReplicaConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationMysqlReplicaConfiguration(c *Client, i interface{}, res *Instance) *InstanceReplicaConfigurationMysqlReplicaConfiguration {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceReplicaConfigurationMysqlReplicaConfiguration{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceReplicaConfigurationMysqlReplicaConfiguration
+	}
+	r.DumpFilePath = dcl.FlattenString(m["dumpFilePath"])
+	r.Username = dcl.FlattenString(m["username"])
+	r.Password = dcl.FlattenString(m["password"])
+	r.ConnectRetryInterval = dcl.FlattenInteger(m["connectRetryInterval"])
+	r.MasterHeartbeatPeriod = flattenSqlInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(c, m["masterHeartbeatPeriod"], res)
+	r.CaCertificate = dcl.FlattenString(m["caCertificate"])
+	r.ClientCertificate = dcl.FlattenString(m["clientCertificate"])
+	r.ClientKey = dcl.FlattenString(m["clientKey"])
+	r.SslCipher = dcl.FlattenString(m["sslCipher"])
+	r.VerifyServerCertificate = dcl.FlattenBool(m["verifyServerCertificate"])
+	r.Kind = dcl.FlattenString(m["kind"])
+
+	return r
+}
+
+// expandInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodMap expands the contents of InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod into a JSON
+// request object.
+func expandInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodMap(c *Client, f map[string]InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodSlice expands the contents of InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod into a JSON
+// request object.
+func expandInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodSlice(c *Client, f []InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodMap flattens the contents of InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodMap(c *Client, i interface{}, res *Instance) map[string]InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod{}
+	}
+
+	items := make(map[string]InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod)
+	for k, item := range a {
+		items[k] = *flattenInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodSlice flattens the contents of InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodSlice(c *Client, i interface{}, res *Instance) []InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod{}
+	}
+
+	items := make([]InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod expands an instance of InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod into a JSON
+// request object.
+func expandInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(c *Client, f *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Value; !dcl.IsEmptyValueIndirect(v) {
+		m["value"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod flattens an instance of InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(c *Client, i interface{}, res *Instance) *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod
+	}
+	r.Value = dcl.FlattenInteger(m["value"])
+
+	return r
+}
+
+// expandInstanceReplicaConfigurationReplicaPoolConfigurationMap expands the contents of InstanceReplicaConfigurationReplicaPoolConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationReplicaPoolConfigurationMap(c *Client, f map[string]InstanceReplicaConfigurationReplicaPoolConfiguration, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceReplicaConfigurationReplicaPoolConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceReplicaConfigurationReplicaPoolConfigurationSlice expands the contents of InstanceReplicaConfigurationReplicaPoolConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationReplicaPoolConfigurationSlice(c *Client, f []InstanceReplicaConfigurationReplicaPoolConfiguration, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceReplicaConfigurationReplicaPoolConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceReplicaConfigurationReplicaPoolConfigurationMap flattens the contents of InstanceReplicaConfigurationReplicaPoolConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationReplicaPoolConfigurationMap(c *Client, i interface{}, res *Instance) map[string]InstanceReplicaConfigurationReplicaPoolConfiguration {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceReplicaConfigurationReplicaPoolConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceReplicaConfigurationReplicaPoolConfiguration{}
+	}
+
+	items := make(map[string]InstanceReplicaConfigurationReplicaPoolConfiguration)
+	for k, item := range a {
+		items[k] = *flattenInstanceReplicaConfigurationReplicaPoolConfiguration(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceReplicaConfigurationReplicaPoolConfigurationSlice flattens the contents of InstanceReplicaConfigurationReplicaPoolConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationReplicaPoolConfigurationSlice(c *Client, i interface{}, res *Instance) []InstanceReplicaConfigurationReplicaPoolConfiguration {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceReplicaConfigurationReplicaPoolConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceReplicaConfigurationReplicaPoolConfiguration{}
+	}
+
+	items := make([]InstanceReplicaConfigurationReplicaPoolConfiguration, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceReplicaConfigurationReplicaPoolConfiguration(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceReplicaConfigurationReplicaPoolConfiguration expands an instance of InstanceReplicaConfigurationReplicaPoolConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationReplicaPoolConfiguration(c *Client, f *InstanceReplicaConfigurationReplicaPoolConfiguration, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+	if v, err := expandInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(c, f.StaticPoolConfiguration, res); err != nil {
+		return nil, fmt.Errorf("error expanding StaticPoolConfiguration into staticPoolConfiguration: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["staticPoolConfiguration"] = v
+	}
+	if v, err := expandInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(c, f.AutoscalingPoolConfiguration, res); err != nil {
+		return nil, fmt.Errorf("error expanding AutoscalingPoolConfiguration into autoscalingPoolConfiguration: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["autoscalingPoolConfiguration"] = v
+	}
+	if v := f.ReplicaCount; !dcl.IsEmptyValueIndirect(v) {
+		m["replicaCount"] = v
+	}
+	if v := f.ExposeReplicaIP; !dcl.IsEmptyValueIndirect(v) {
+		m["exposeReplicaIp"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceReplicaConfigurationReplicaPoolConfiguration flattens an instance of InstanceReplicaConfigurationReplicaPoolConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationReplicaPoolConfiguration(c *Client, i interface{}, res *Instance) *InstanceReplicaConfigurationReplicaPoolConfiguration {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceReplicaConfigurationReplicaPoolConfiguration{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceReplicaConfigurationReplicaPoolConfiguration
+	}
+	r.Kind = dcl.FlattenString(m["kind"])
+	r.StaticPoolConfiguration = flattenInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(c, m["staticPoolConfiguration"], res)
+	r.AutoscalingPoolConfiguration = flattenInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(c, m["autoscalingPoolConfiguration"], res)
+	r.ReplicaCount = dcl.FlattenInteger(m["replicaCount"])
+	r.ExposeReplicaIP = dcl.FlattenBool(m["exposeReplicaIp"])
+
+	return r
+}
+
+// expandInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationMap expands the contents of InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationMap(c *Client, f map[string]InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationSlice expands the contents of InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationSlice(c *Client, f []InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationMap flattens the contents of InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationMap(c *Client, i interface{}, res *Instance) map[string]InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration{}
+	}
+
+	items := make(map[string]InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration)
+	for k, item := range a {
+		items[k] = *flattenInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationSlice flattens the contents of InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationSlice(c *Client, i interface{}, res *Instance) []InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration{}
+	}
+
+	items := make([]InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration expands an instance of InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(c *Client, f *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+	if v := f.ReplicaCount; !dcl.IsEmptyValueIndirect(v) {
+		m["replicaCount"] = v
+	}
+	if v := f.ExposeReplicaIP; !dcl.IsEmptyValueIndirect(v) {
+		m["exposeReplicaIp"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration flattens an instance of InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration(c *Client, i interface{}, res *Instance) *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration
+	}
+	r.Kind = dcl.FlattenString(m["kind"])
+	r.ReplicaCount = dcl.FlattenInteger(m["replicaCount"])
+	r.ExposeReplicaIP = dcl.FlattenBool(m["exposeReplicaIp"])
+
+	return r
+}
+
+// expandInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationMap expands the contents of InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationMap(c *Client, f map[string]InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationSlice expands the contents of InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationSlice(c *Client, f []InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationMap flattens the contents of InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationMap(c *Client, i interface{}, res *Instance) map[string]InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration{}
+	}
+
+	items := make(map[string]InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration)
+	for k, item := range a {
+		items[k] = *flattenInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationSlice flattens the contents of InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationSlice(c *Client, i interface{}, res *Instance) []InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration{}
+	}
+
+	items := make([]InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration expands an instance of InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration into a JSON
+// request object.
+func expandInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(c *Client, f *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+	if v := f.MinReplicaCount; !dcl.IsEmptyValueIndirect(v) {
+		m["minReplicaCount"] = v
+	}
+	if v := f.MaxReplicaCount; !dcl.IsEmptyValueIndirect(v) {
+		m["maxReplicaCount"] = v
+	}
+	if v := f.TargetCpuUtil; !dcl.IsEmptyValueIndirect(v) {
+		m["targetCpuUtil"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration flattens an instance of InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration from a JSON
+// response object.
+func flattenInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration(c *Client, i interface{}, res *Instance) *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration
+	}
+	r.Kind = dcl.FlattenString(m["kind"])
+	r.MinReplicaCount = dcl.FlattenInteger(m["minReplicaCount"])
+	r.MaxReplicaCount = dcl.FlattenInteger(m["maxReplicaCount"])
+	r.TargetCpuUtil = dcl.FlattenDouble(m["targetCpuUtil"])
+
+	return r
+}
+
+// expandInstanceScheduledMaintenanceMap expands the contents of InstanceScheduledMaintenance into a JSON
+// request object.
+func expandInstanceScheduledMaintenanceMap(c *Client, f map[string]InstanceScheduledMaintenance, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceScheduledMaintenance(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceScheduledMaintenanceSlice expands the contents of InstanceScheduledMaintenance into a JSON
+// request object.
+func expandInstanceScheduledMaintenanceSlice(c *Client, f []InstanceScheduledMaintenance, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceScheduledMaintenance(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceScheduledMaintenanceMap flattens the contents of InstanceScheduledMaintenance from a JSON
+// response object.
+func flattenInstanceScheduledMaintenanceMap(c *Client, i interface{}, res *Instance) map[string]InstanceScheduledMaintenance {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceScheduledMaintenance{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceScheduledMaintenance{}
+	}
+
+	items := make(map[string]InstanceScheduledMaintenance)
+	for k, item := range a {
+		items[k] = *flattenInstanceScheduledMaintenance(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceScheduledMaintenanceSlice flattens the contents of InstanceScheduledMaintenance from a JSON
+// response object.
+func flattenInstanceScheduledMaintenanceSlice(c *Client, i interface{}, res *Instance) []InstanceScheduledMaintenance {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceScheduledMaintenance{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceScheduledMaintenance{}
+	}
+
+	items := make([]InstanceScheduledMaintenance, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceScheduledMaintenance(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceScheduledMaintenance expands an instance of InstanceScheduledMaintenance into a JSON
+// request object.
+func expandInstanceScheduledMaintenance(c *Client, f *InstanceScheduledMaintenance, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenInstanceScheduledMaintenance flattens an instance of InstanceScheduledMaintenance from a JSON
+// response object.
+func flattenInstanceScheduledMaintenance(c *Client, i interface{}, res *Instance) *InstanceScheduledMaintenance {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceScheduledMaintenance{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceScheduledMaintenance
+	}
+	r.StartTime = flattenSqlInstanceScheduledMaintenanceStartTime(c, m["startTime"], res)
+	r.CanDefer = dcl.FlattenBool(m["canDefer"])
+	r.CanReschedule = dcl.FlattenBool(m["canReschedule"])
+
+	return r
+}
+
+// expandInstanceScheduledMaintenanceStartTimeMap expands the contents of InstanceScheduledMaintenanceStartTime into a JSON
+// request object.
+func expandInstanceScheduledMaintenanceStartTimeMap(c *Client, f map[string]InstanceScheduledMaintenanceStartTime, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceScheduledMaintenanceStartTime(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceScheduledMaintenanceStartTimeSlice expands the contents of InstanceScheduledMaintenanceStartTime into a JSON
+// request object.
+func expandInstanceScheduledMaintenanceStartTimeSlice(c *Client, f []InstanceScheduledMaintenanceStartTime, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceScheduledMaintenanceStartTime(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceScheduledMaintenanceStartTimeMap flattens the contents of InstanceScheduledMaintenanceStartTime from a JSON
+// response object.
+func flattenInstanceScheduledMaintenanceStartTimeMap(c *Client, i interface{}, res *Instance) map[string]InstanceScheduledMaintenanceStartTime {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceScheduledMaintenanceStartTime{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceScheduledMaintenanceStartTime{}
+	}
+
+	items := make(map[string]InstanceScheduledMaintenanceStartTime)
+	for k, item := range a {
+		items[k] = *flattenInstanceScheduledMaintenanceStartTime(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceScheduledMaintenanceStartTimeSlice flattens the contents of InstanceScheduledMaintenanceStartTime from a JSON
+// response object.
+func flattenInstanceScheduledMaintenanceStartTimeSlice(c *Client, i interface{}, res *Instance) []InstanceScheduledMaintenanceStartTime {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceScheduledMaintenanceStartTime{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceScheduledMaintenanceStartTime{}
+	}
+
+	items := make([]InstanceScheduledMaintenanceStartTime, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceScheduledMaintenanceStartTime(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceScheduledMaintenanceStartTime expands an instance of InstanceScheduledMaintenanceStartTime into a JSON
+// request object.
+func expandInstanceScheduledMaintenanceStartTime(c *Client, f *InstanceScheduledMaintenanceStartTime, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenInstanceScheduledMaintenanceStartTime flattens an instance of InstanceScheduledMaintenanceStartTime from a JSON
+// response object.
+func flattenInstanceScheduledMaintenanceStartTime(c *Client, i interface{}, res *Instance) *InstanceScheduledMaintenanceStartTime {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceScheduledMaintenanceStartTime{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceScheduledMaintenanceStartTime
+	}
+	r.Seconds = dcl.FlattenInteger(m["seconds"])
+	r.Nanos = dcl.FlattenInteger(m["nanos"])
+
+	return r
+}
+
+// expandInstanceSettingsMap expands the contents of InstanceSettings into a JSON
+// request object.
+func expandInstanceSettingsMap(c *Client, f map[string]InstanceSettings, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettings(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsSlice expands the contents of InstanceSettings into a JSON
+// request object.
+func expandInstanceSettingsSlice(c *Client, f []InstanceSettings, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettings(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsMap flattens the contents of InstanceSettings from a JSON
+// response object.
+func flattenInstanceSettingsMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettings {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettings{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettings{}
+	}
+
+	items := make(map[string]InstanceSettings)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettings(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsSlice flattens the contents of InstanceSettings from a JSON
+// response object.
+func flattenInstanceSettingsSlice(c *Client, i interface{}, res *Instance) []InstanceSettings {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettings{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettings{}
+	}
+
+	items := make([]InstanceSettings, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettings(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettings expands an instance of InstanceSettings into a JSON
+// request object.
+func expandInstanceSettings(c *Client, f *InstanceSettings, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.AuthorizedGaeApplications; v != nil {
+		m["authorizedGaeApplications"] = v
+	}
+	if v := f.Tier; !dcl.IsEmptyValueIndirect(v) {
+		m["tier"] = v
+	}
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+	if v := f.AvailabilityType; !dcl.IsEmptyValueIndirect(v) {
+		m["availabilityType"] = v
+	}
+	if v := f.PricingPlan; !dcl.IsEmptyValueIndirect(v) {
+		m["pricingPlan"] = v
+	}
+	if v := f.ReplicationType; !dcl.IsEmptyValueIndirect(v) {
+		m["replicationType"] = v
+	}
+	if v := f.ActivationPolicy; !dcl.IsEmptyValueIndirect(v) {
+		m["activationPolicy"] = v
+	}
+	if v := f.StorageAutoResize; !dcl.IsEmptyValueIndirect(v) {
+		m["storageAutoResize"] = v
+	}
+	if v := f.DataDiskType; !dcl.IsEmptyValueIndirect(v) {
+		m["dataDiskType"] = v
+	}
+	if v := f.DatabaseReplicationEnabled; !dcl.IsEmptyValueIndirect(v) {
+		m["databaseReplicationEnabled"] = v
+	}
+	if v := f.CrashSafeReplicationEnabled; !dcl.IsEmptyValueIndirect(v) {
+		m["crashSafeReplicationEnabled"] = v
+	}
+	if v := f.UserLabels; !dcl.IsEmptyValueIndirect(v) {
+		m["userLabels"] = v
+	}
+	if v, err := expandSqlInstanceSettingsStorageAutoResizeLimit(c, f.StorageAutoResizeLimit, res); err != nil {
+		return nil, fmt.Errorf("error expanding StorageAutoResizeLimit into storageAutoResizeLimit: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["storageAutoResizeLimit"] = v
+	}
+	if v, err := expandInstanceSettingsIPConfiguration(c, f.IPConfiguration, res); err != nil {
+		return nil, fmt.Errorf("error expanding IPConfiguration into ipConfiguration: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["ipConfiguration"] = v
+	}
+	if v, err := expandInstanceSettingsLocationPreference(c, f.LocationPreference, res); err != nil {
+		return nil, fmt.Errorf("error expanding LocationPreference into locationPreference: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["locationPreference"] = v
+	}
+	if v, err := expandInstanceSettingsDatabaseFlagsSlice(c, f.DatabaseFlags, res); err != nil {
+		return nil, fmt.Errorf("error expanding DatabaseFlags into databaseFlags: %w", err)
+	} else if v != nil {
+		m["databaseFlags"] = v
+	}
+	if v, err := expandInstanceSettingsMaintenanceWindow(c, f.MaintenanceWindow, res); err != nil {
+		return nil, fmt.Errorf("error expanding MaintenanceWindow into maintenanceWindow: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["maintenanceWindow"] = v
+	}
+	if v, err := expandInstanceSettingsBackupConfiguration(c, f.BackupConfiguration, res); err != nil {
+		return nil, fmt.Errorf("error expanding BackupConfiguration into backupConfiguration: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["backupConfiguration"] = v
+	}
+	if v, err := expandSqlInstanceSettingsDataDiskSizeGb(c, f.DataDiskSizeGb, res); err != nil {
+		return nil, fmt.Errorf("error expanding DataDiskSizeGb into dataDiskSizeGb: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["dataDiskSizeGb"] = v
+	}
+	if v, err := expandInstanceSettingsActiveDirectoryConfig(c, f.ActiveDirectoryConfig, res); err != nil {
+		return nil, fmt.Errorf("error expanding ActiveDirectoryConfig into activeDirectoryConfig: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["activeDirectoryConfig"] = v
+	}
+	if v := f.Collation; !dcl.IsEmptyValueIndirect(v) {
+		m["collation"] = v
+	}
+	if v, err := expandInstanceSettingsDenyMaintenancePeriodsSlice(c, f.DenyMaintenancePeriods, res); err != nil {
+		return nil, fmt.Errorf("error expanding DenyMaintenancePeriods into denyMaintenancePeriods: %w", err)
+	} else if v != nil {
+		m["denyMaintenancePeriods"] = v
+	}
+	if v, err := expandInstanceSettingsInsightsConfig(c, f.InsightsConfig, res); err != nil {
+		return nil, fmt.Errorf("error expanding InsightsConfig into insightsConfig: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["insightsConfig"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettings flattens an instance of InstanceSettings from a JSON
+// response object.
+func flattenInstanceSettings(c *Client, i interface{}, res *Instance) *InstanceSettings {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettings{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettings
+	}
+	r.AuthorizedGaeApplications = dcl.FlattenStringSlice(m["authorizedGaeApplications"])
+	r.Tier = dcl.FlattenString(m["tier"])
+	r.Kind = dcl.FlattenString(m["kind"])
+	r.AvailabilityType = flattenInstanceSettingsAvailabilityTypeEnum(m["availabilityType"])
+	r.PricingPlan = flattenInstanceSettingsPricingPlanEnum(m["pricingPlan"])
+	r.ReplicationType = flattenInstanceSettingsReplicationTypeEnum(m["replicationType"])
+	r.ActivationPolicy = flattenInstanceSettingsActivationPolicyEnum(m["activationPolicy"])
+	r.StorageAutoResize = dcl.FlattenBool(m["storageAutoResize"])
+	r.DataDiskType = flattenInstanceSettingsDataDiskTypeEnum(m["dataDiskType"])
+	r.DatabaseReplicationEnabled = dcl.FlattenBool(m["databaseReplicationEnabled"])
+	r.CrashSafeReplicationEnabled = dcl.FlattenBool(m["crashSafeReplicationEnabled"])
+	r.SettingsVersion = flattenSqlInstanceSettingsSettingsVersion(c, m["settingsVersion"], res)
+	r.UserLabels = dcl.FlattenKeyValuePairs(m["userLabels"])
+	r.StorageAutoResizeLimit = flattenSqlInstanceSettingsStorageAutoResizeLimit(c, m["storageAutoResizeLimit"], res)
+	r.IPConfiguration = flattenInstanceSettingsIPConfiguration(c, m["ipConfiguration"], res)
+	r.LocationPreference = flattenInstanceSettingsLocationPreference(c, m["locationPreference"], res)
+	r.DatabaseFlags = flattenInstanceSettingsDatabaseFlagsSlice(c, m["databaseFlags"], res)
+	r.MaintenanceWindow = flattenInstanceSettingsMaintenanceWindow(c, m["maintenanceWindow"], res)
+	r.BackupConfiguration = flattenInstanceSettingsBackupConfiguration(c, m["backupConfiguration"], res)
+	r.DataDiskSizeGb = flattenSqlInstanceSettingsDataDiskSizeGb(c, m["dataDiskSizeGb"], res)
+	r.ActiveDirectoryConfig = flattenInstanceSettingsActiveDirectoryConfig(c, m["activeDirectoryConfig"], res)
+	r.Collation = dcl.FlattenString(m["collation"])
+	r.DenyMaintenancePeriods = flattenInstanceSettingsDenyMaintenancePeriodsSlice(c, m["denyMaintenancePeriods"], res)
+	r.InsightsConfig = flattenInstanceSettingsInsightsConfig(c, m["insightsConfig"], res)
+
+	return r
+}
+
+// expandInstanceSettingsSettingsVersionMap expands the contents of InstanceSettingsSettingsVersion into a JSON
+// request object.
+func expandInstanceSettingsSettingsVersionMap(c *Client, f map[string]InstanceSettingsSettingsVersion, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsSettingsVersion(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsSettingsVersionSlice expands the contents of InstanceSettingsSettingsVersion into a JSON
+// request object.
+func expandInstanceSettingsSettingsVersionSlice(c *Client, f []InstanceSettingsSettingsVersion, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsSettingsVersion(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsSettingsVersionMap flattens the contents of InstanceSettingsSettingsVersion from a JSON
+// response object.
+func flattenInstanceSettingsSettingsVersionMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsSettingsVersion {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsSettingsVersion{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsSettingsVersion{}
+	}
+
+	items := make(map[string]InstanceSettingsSettingsVersion)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsSettingsVersion(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsSettingsVersionSlice flattens the contents of InstanceSettingsSettingsVersion from a JSON
+// response object.
+func flattenInstanceSettingsSettingsVersionSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsSettingsVersion {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsSettingsVersion{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsSettingsVersion{}
+	}
+
+	items := make([]InstanceSettingsSettingsVersion, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsSettingsVersion(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsSettingsVersion expands an instance of InstanceSettingsSettingsVersion into a JSON
+// request object.
+func expandInstanceSettingsSettingsVersion(c *Client, f *InstanceSettingsSettingsVersion, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenInstanceSettingsSettingsVersion flattens an instance of InstanceSettingsSettingsVersion from a JSON
+// response object.
+func flattenInstanceSettingsSettingsVersion(c *Client, i interface{}, res *Instance) *InstanceSettingsSettingsVersion {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsSettingsVersion{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsSettingsVersion
+	}
+	r.Value = dcl.FlattenInteger(m["value"])
+
+	return r
+}
+
+// expandInstanceSettingsStorageAutoResizeLimitMap expands the contents of InstanceSettingsStorageAutoResizeLimit into a JSON
+// request object.
+func expandInstanceSettingsStorageAutoResizeLimitMap(c *Client, f map[string]InstanceSettingsStorageAutoResizeLimit, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsStorageAutoResizeLimit(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsStorageAutoResizeLimitSlice expands the contents of InstanceSettingsStorageAutoResizeLimit into a JSON
+// request object.
+func expandInstanceSettingsStorageAutoResizeLimitSlice(c *Client, f []InstanceSettingsStorageAutoResizeLimit, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsStorageAutoResizeLimit(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsStorageAutoResizeLimitMap flattens the contents of InstanceSettingsStorageAutoResizeLimit from a JSON
+// response object.
+func flattenInstanceSettingsStorageAutoResizeLimitMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsStorageAutoResizeLimit {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsStorageAutoResizeLimit{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsStorageAutoResizeLimit{}
+	}
+
+	items := make(map[string]InstanceSettingsStorageAutoResizeLimit)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsStorageAutoResizeLimit(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsStorageAutoResizeLimitSlice flattens the contents of InstanceSettingsStorageAutoResizeLimit from a JSON
+// response object.
+func flattenInstanceSettingsStorageAutoResizeLimitSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsStorageAutoResizeLimit {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsStorageAutoResizeLimit{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsStorageAutoResizeLimit{}
+	}
+
+	items := make([]InstanceSettingsStorageAutoResizeLimit, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsStorageAutoResizeLimit(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsStorageAutoResizeLimit expands an instance of InstanceSettingsStorageAutoResizeLimit into a JSON
+// request object.
+func expandInstanceSettingsStorageAutoResizeLimit(c *Client, f *InstanceSettingsStorageAutoResizeLimit, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Value; !dcl.IsEmptyValueIndirect(v) {
+		m["value"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettingsStorageAutoResizeLimit flattens an instance of InstanceSettingsStorageAutoResizeLimit from a JSON
+// response object.
+func flattenInstanceSettingsStorageAutoResizeLimit(c *Client, i interface{}, res *Instance) *InstanceSettingsStorageAutoResizeLimit {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsStorageAutoResizeLimit{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsStorageAutoResizeLimit
+	}
+	r.Value = dcl.FlattenInteger(m["value"])
+
+	return r
+}
+
+// expandInstanceSettingsIPConfigurationMap expands the contents of InstanceSettingsIPConfiguration into a JSON
+// request object.
+func expandInstanceSettingsIPConfigurationMap(c *Client, f map[string]InstanceSettingsIPConfiguration, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsIPConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsIPConfigurationSlice expands the contents of InstanceSettingsIPConfiguration into a JSON
+// request object.
+func expandInstanceSettingsIPConfigurationSlice(c *Client, f []InstanceSettingsIPConfiguration, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsIPConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsIPConfigurationMap flattens the contents of InstanceSettingsIPConfiguration from a JSON
+// response object.
+func flattenInstanceSettingsIPConfigurationMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsIPConfiguration {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsIPConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsIPConfiguration{}
+	}
+
+	items := make(map[string]InstanceSettingsIPConfiguration)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsIPConfiguration(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsIPConfigurationSlice flattens the contents of InstanceSettingsIPConfiguration from a JSON
+// response object.
+func flattenInstanceSettingsIPConfigurationSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsIPConfiguration {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsIPConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsIPConfiguration{}
+	}
+
+	items := make([]InstanceSettingsIPConfiguration, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsIPConfiguration(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsIPConfiguration expands an instance of InstanceSettingsIPConfiguration into a JSON
+// request object.
+func expandInstanceSettingsIPConfiguration(c *Client, f *InstanceSettingsIPConfiguration, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.IPv4Enabled; !dcl.IsEmptyValueIndirect(v) {
+		m["ipv4Enabled"] = v
+	}
+	if v := f.PrivateNetwork; !dcl.IsEmptyValueIndirect(v) {
+		m["privateNetwork"] = v
+	}
+	if v := f.RequireSsl; !dcl.IsEmptyValueIndirect(v) {
+		m["requireSsl"] = v
+	}
+	if v, err := expandInstanceSettingsIPConfigurationAuthorizedNetworksSlice(c, f.AuthorizedNetworks, res); err != nil {
+		return nil, fmt.Errorf("error expanding AuthorizedNetworks into authorizedNetworks: %w", err)
+	} else if v != nil {
+		m["authorizedNetworks"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettingsIPConfiguration flattens an instance of InstanceSettingsIPConfiguration from a JSON
+// response object.
+func flattenInstanceSettingsIPConfiguration(c *Client, i interface{}, res *Instance) *InstanceSettingsIPConfiguration {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsIPConfiguration{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsIPConfiguration
+	}
+	r.IPv4Enabled = dcl.FlattenBool(m["ipv4Enabled"])
+	r.PrivateNetwork = dcl.FlattenString(m["privateNetwork"])
+	r.RequireSsl = dcl.FlattenBool(m["requireSsl"])
+	r.AuthorizedNetworks = flattenInstanceSettingsIPConfigurationAuthorizedNetworksSlice(c, m["authorizedNetworks"], res)
+
+	return r
+}
+
+// expandInstanceSettingsIPConfigurationAuthorizedNetworksMap expands the contents of InstanceSettingsIPConfigurationAuthorizedNetworks into a JSON
+// request object.
+func expandInstanceSettingsIPConfigurationAuthorizedNetworksMap(c *Client, f map[string]InstanceSettingsIPConfigurationAuthorizedNetworks, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsIPConfigurationAuthorizedNetworks(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsIPConfigurationAuthorizedNetworksSlice expands the contents of InstanceSettingsIPConfigurationAuthorizedNetworks into a JSON
+// request object.
+func expandInstanceSettingsIPConfigurationAuthorizedNetworksSlice(c *Client, f []InstanceSettingsIPConfigurationAuthorizedNetworks, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsIPConfigurationAuthorizedNetworks(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsIPConfigurationAuthorizedNetworksMap flattens the contents of InstanceSettingsIPConfigurationAuthorizedNetworks from a JSON
+// response object.
+func flattenInstanceSettingsIPConfigurationAuthorizedNetworksMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsIPConfigurationAuthorizedNetworks {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsIPConfigurationAuthorizedNetworks{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsIPConfigurationAuthorizedNetworks{}
+	}
+
+	items := make(map[string]InstanceSettingsIPConfigurationAuthorizedNetworks)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsIPConfigurationAuthorizedNetworks(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsIPConfigurationAuthorizedNetworksSlice flattens the contents of InstanceSettingsIPConfigurationAuthorizedNetworks from a JSON
+// response object.
+func flattenInstanceSettingsIPConfigurationAuthorizedNetworksSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsIPConfigurationAuthorizedNetworks {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsIPConfigurationAuthorizedNetworks{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsIPConfigurationAuthorizedNetworks{}
+	}
+
+	items := make([]InstanceSettingsIPConfigurationAuthorizedNetworks, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsIPConfigurationAuthorizedNetworks(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsIPConfigurationAuthorizedNetworks expands an instance of InstanceSettingsIPConfigurationAuthorizedNetworks into a JSON
+// request object.
+func expandInstanceSettingsIPConfigurationAuthorizedNetworks(c *Client, f *InstanceSettingsIPConfigurationAuthorizedNetworks, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Value; !dcl.IsEmptyValueIndirect(v) {
+		m["value"] = v
+	}
+	if v := f.ExpirationTime; !dcl.IsEmptyValueIndirect(v) {
+		m["expirationTime"] = v
+	}
+	if v := f.Name; !dcl.IsEmptyValueIndirect(v) {
+		m["name"] = v
+	}
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettingsIPConfigurationAuthorizedNetworks flattens an instance of InstanceSettingsIPConfigurationAuthorizedNetworks from a JSON
+// response object.
+func flattenInstanceSettingsIPConfigurationAuthorizedNetworks(c *Client, i interface{}, res *Instance) *InstanceSettingsIPConfigurationAuthorizedNetworks {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsIPConfigurationAuthorizedNetworks{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsIPConfigurationAuthorizedNetworks
+	}
+	r.Value = dcl.FlattenString(m["value"])
+	r.ExpirationTime = dcl.FlattenString(m["expirationTime"])
+	r.Name = dcl.FlattenString(m["name"])
+	r.Kind = dcl.FlattenString(m["kind"])
+
+	return r
+}
+
+// expandInstanceSettingsLocationPreferenceMap expands the contents of InstanceSettingsLocationPreference into a JSON
+// request object.
+func expandInstanceSettingsLocationPreferenceMap(c *Client, f map[string]InstanceSettingsLocationPreference, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsLocationPreference(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsLocationPreferenceSlice expands the contents of InstanceSettingsLocationPreference into a JSON
+// request object.
+func expandInstanceSettingsLocationPreferenceSlice(c *Client, f []InstanceSettingsLocationPreference, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsLocationPreference(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsLocationPreferenceMap flattens the contents of InstanceSettingsLocationPreference from a JSON
+// response object.
+func flattenInstanceSettingsLocationPreferenceMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsLocationPreference {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsLocationPreference{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsLocationPreference{}
+	}
+
+	items := make(map[string]InstanceSettingsLocationPreference)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsLocationPreference(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsLocationPreferenceSlice flattens the contents of InstanceSettingsLocationPreference from a JSON
+// response object.
+func flattenInstanceSettingsLocationPreferenceSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsLocationPreference {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsLocationPreference{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsLocationPreference{}
+	}
+
+	items := make([]InstanceSettingsLocationPreference, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsLocationPreference(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsLocationPreference expands an instance of InstanceSettingsLocationPreference into a JSON
+// request object.
+func expandInstanceSettingsLocationPreference(c *Client, f *InstanceSettingsLocationPreference, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Zone; !dcl.IsEmptyValueIndirect(v) {
+		m["zone"] = v
+	}
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettingsLocationPreference flattens an instance of InstanceSettingsLocationPreference from a JSON
+// response object.
+func flattenInstanceSettingsLocationPreference(c *Client, i interface{}, res *Instance) *InstanceSettingsLocationPreference {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsLocationPreference{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsLocationPreference
+	}
+	r.Zone = dcl.FlattenString(m["zone"])
+	r.Kind = dcl.FlattenString(m["kind"])
+
+	return r
+}
+
+// expandInstanceSettingsDatabaseFlagsMap expands the contents of InstanceSettingsDatabaseFlags into a JSON
+// request object.
+func expandInstanceSettingsDatabaseFlagsMap(c *Client, f map[string]InstanceSettingsDatabaseFlags, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsDatabaseFlags(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsDatabaseFlagsSlice expands the contents of InstanceSettingsDatabaseFlags into a JSON
+// request object.
+func expandInstanceSettingsDatabaseFlagsSlice(c *Client, f []InstanceSettingsDatabaseFlags, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsDatabaseFlags(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsDatabaseFlagsMap flattens the contents of InstanceSettingsDatabaseFlags from a JSON
+// response object.
+func flattenInstanceSettingsDatabaseFlagsMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsDatabaseFlags {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsDatabaseFlags{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsDatabaseFlags{}
+	}
+
+	items := make(map[string]InstanceSettingsDatabaseFlags)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsDatabaseFlags(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsDatabaseFlagsSlice flattens the contents of InstanceSettingsDatabaseFlags from a JSON
+// response object.
+func flattenInstanceSettingsDatabaseFlagsSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsDatabaseFlags {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsDatabaseFlags{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsDatabaseFlags{}
+	}
+
+	items := make([]InstanceSettingsDatabaseFlags, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsDatabaseFlags(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsDatabaseFlags expands an instance of InstanceSettingsDatabaseFlags into a JSON
+// request object.
+func expandInstanceSettingsDatabaseFlags(c *Client, f *InstanceSettingsDatabaseFlags, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Name; !dcl.IsEmptyValueIndirect(v) {
+		m["name"] = v
+	}
+	if v := f.Value; !dcl.IsEmptyValueIndirect(v) {
+		m["value"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettingsDatabaseFlags flattens an instance of InstanceSettingsDatabaseFlags from a JSON
+// response object.
+func flattenInstanceSettingsDatabaseFlags(c *Client, i interface{}, res *Instance) *InstanceSettingsDatabaseFlags {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsDatabaseFlags{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsDatabaseFlags
+	}
+	r.Name = dcl.FlattenString(m["name"])
+	r.Value = dcl.FlattenString(m["value"])
+
+	return r
+}
+
+// expandInstanceSettingsMaintenanceWindowMap expands the contents of InstanceSettingsMaintenanceWindow into a JSON
+// request object.
+func expandInstanceSettingsMaintenanceWindowMap(c *Client, f map[string]InstanceSettingsMaintenanceWindow, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsMaintenanceWindow(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsMaintenanceWindowSlice expands the contents of InstanceSettingsMaintenanceWindow into a JSON
+// request object.
+func expandInstanceSettingsMaintenanceWindowSlice(c *Client, f []InstanceSettingsMaintenanceWindow, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsMaintenanceWindow(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsMaintenanceWindowMap flattens the contents of InstanceSettingsMaintenanceWindow from a JSON
+// response object.
+func flattenInstanceSettingsMaintenanceWindowMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsMaintenanceWindow {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsMaintenanceWindow{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsMaintenanceWindow{}
+	}
+
+	items := make(map[string]InstanceSettingsMaintenanceWindow)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsMaintenanceWindow(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsMaintenanceWindowSlice flattens the contents of InstanceSettingsMaintenanceWindow from a JSON
+// response object.
+func flattenInstanceSettingsMaintenanceWindowSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsMaintenanceWindow {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsMaintenanceWindow{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsMaintenanceWindow{}
+	}
+
+	items := make([]InstanceSettingsMaintenanceWindow, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsMaintenanceWindow(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsMaintenanceWindow expands an instance of InstanceSettingsMaintenanceWindow into a JSON
+// request object.
+func expandInstanceSettingsMaintenanceWindow(c *Client, f *InstanceSettingsMaintenanceWindow, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Hour; !dcl.IsEmptyValueIndirect(v) {
+		m["hour"] = v
+	}
+	if v := f.Day; !dcl.IsEmptyValueIndirect(v) {
+		m["day"] = v
+	}
+	if v := f.UpdateTrack; !dcl.IsEmptyValueIndirect(v) {
+		m["updateTrack"] = v
+	}
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettingsMaintenanceWindow flattens an instance of InstanceSettingsMaintenanceWindow from a JSON
+// response object.
+func flattenInstanceSettingsMaintenanceWindow(c *Client, i interface{}, res *Instance) *InstanceSettingsMaintenanceWindow {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsMaintenanceWindow{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsMaintenanceWindow
+	}
+	r.Hour = dcl.FlattenInteger(m["hour"])
+	r.Day = dcl.FlattenInteger(m["day"])
+	r.UpdateTrack = flattenInstanceSettingsMaintenanceWindowUpdateTrackEnum(m["updateTrack"])
+	r.Kind = dcl.FlattenString(m["kind"])
+
+	return r
+}
+
+// expandInstanceSettingsBackupConfigurationMap expands the contents of InstanceSettingsBackupConfiguration into a JSON
+// request object.
+func expandInstanceSettingsBackupConfigurationMap(c *Client, f map[string]InstanceSettingsBackupConfiguration, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsBackupConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsBackupConfigurationSlice expands the contents of InstanceSettingsBackupConfiguration into a JSON
+// request object.
+func expandInstanceSettingsBackupConfigurationSlice(c *Client, f []InstanceSettingsBackupConfiguration, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsBackupConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsBackupConfigurationMap flattens the contents of InstanceSettingsBackupConfiguration from a JSON
+// response object.
+func flattenInstanceSettingsBackupConfigurationMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsBackupConfiguration {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsBackupConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsBackupConfiguration{}
+	}
+
+	items := make(map[string]InstanceSettingsBackupConfiguration)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsBackupConfiguration(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsBackupConfigurationSlice flattens the contents of InstanceSettingsBackupConfiguration from a JSON
+// response object.
+func flattenInstanceSettingsBackupConfigurationSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsBackupConfiguration {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsBackupConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsBackupConfiguration{}
+	}
+
+	items := make([]InstanceSettingsBackupConfiguration, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsBackupConfiguration(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsBackupConfiguration expands an instance of InstanceSettingsBackupConfiguration into a JSON
+// request object.
+func expandInstanceSettingsBackupConfiguration(c *Client, f *InstanceSettingsBackupConfiguration, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.StartTime; !dcl.IsEmptyValueIndirect(v) {
+		m["startTime"] = v
+	}
+	if v := f.Enabled; !dcl.IsEmptyValueIndirect(v) {
+		m["enabled"] = v
+	}
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+	if v := f.BinaryLogEnabled; !dcl.IsEmptyValueIndirect(v) {
+		m["binaryLogEnabled"] = v
+	}
+	if v := f.Location; !dcl.IsEmptyValueIndirect(v) {
+		m["location"] = v
+	}
+	if v, err := expandInstanceSettingsBackupConfigurationBackupRetentionSettings(c, f.BackupRetentionSettings, res); err != nil {
+		return nil, fmt.Errorf("error expanding BackupRetentionSettings into backupRetentionSettings: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["backupRetentionSettings"] = v
+	}
+	if v := f.TransactionLogRetentionDays; !dcl.IsEmptyValueIndirect(v) {
+		m["transactionLogRetentionDays"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettingsBackupConfiguration flattens an instance of InstanceSettingsBackupConfiguration from a JSON
+// response object.
+func flattenInstanceSettingsBackupConfiguration(c *Client, i interface{}, res *Instance) *InstanceSettingsBackupConfiguration {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsBackupConfiguration{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsBackupConfiguration
+	}
+	r.StartTime = dcl.FlattenString(m["startTime"])
+	r.Enabled = dcl.FlattenBool(m["enabled"])
+	r.Kind = dcl.FlattenString(m["kind"])
+	r.BinaryLogEnabled = dcl.FlattenBool(m["binaryLogEnabled"])
+	r.Location = dcl.FlattenString(m["location"])
+	r.BackupRetentionSettings = flattenInstanceSettingsBackupConfigurationBackupRetentionSettings(c, m["backupRetentionSettings"], res)
+	r.TransactionLogRetentionDays = dcl.FlattenInteger(m["transactionLogRetentionDays"])
+
+	return r
+}
+
+// expandInstanceSettingsBackupConfigurationBackupRetentionSettingsMap expands the contents of InstanceSettingsBackupConfigurationBackupRetentionSettings into a JSON
+// request object.
+func expandInstanceSettingsBackupConfigurationBackupRetentionSettingsMap(c *Client, f map[string]InstanceSettingsBackupConfigurationBackupRetentionSettings, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsBackupConfigurationBackupRetentionSettings(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsBackupConfigurationBackupRetentionSettingsSlice expands the contents of InstanceSettingsBackupConfigurationBackupRetentionSettings into a JSON
+// request object.
+func expandInstanceSettingsBackupConfigurationBackupRetentionSettingsSlice(c *Client, f []InstanceSettingsBackupConfigurationBackupRetentionSettings, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsBackupConfigurationBackupRetentionSettings(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsMap flattens the contents of InstanceSettingsBackupConfigurationBackupRetentionSettings from a JSON
+// response object.
+func flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsBackupConfigurationBackupRetentionSettings {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsBackupConfigurationBackupRetentionSettings{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsBackupConfigurationBackupRetentionSettings{}
+	}
+
+	items := make(map[string]InstanceSettingsBackupConfigurationBackupRetentionSettings)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsBackupConfigurationBackupRetentionSettings(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsSlice flattens the contents of InstanceSettingsBackupConfigurationBackupRetentionSettings from a JSON
+// response object.
+func flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsBackupConfigurationBackupRetentionSettings {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsBackupConfigurationBackupRetentionSettings{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsBackupConfigurationBackupRetentionSettings{}
+	}
+
+	items := make([]InstanceSettingsBackupConfigurationBackupRetentionSettings, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsBackupConfigurationBackupRetentionSettings(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsBackupConfigurationBackupRetentionSettings expands an instance of InstanceSettingsBackupConfigurationBackupRetentionSettings into a JSON
+// request object.
+func expandInstanceSettingsBackupConfigurationBackupRetentionSettings(c *Client, f *InstanceSettingsBackupConfigurationBackupRetentionSettings, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.RetentionUnit; !dcl.IsEmptyValueIndirect(v) {
+		m["retentionUnit"] = v
+	}
+	if v := f.RetainedBackups; !dcl.IsEmptyValueIndirect(v) {
+		m["retainedBackups"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettingsBackupConfigurationBackupRetentionSettings flattens an instance of InstanceSettingsBackupConfigurationBackupRetentionSettings from a JSON
+// response object.
+func flattenInstanceSettingsBackupConfigurationBackupRetentionSettings(c *Client, i interface{}, res *Instance) *InstanceSettingsBackupConfigurationBackupRetentionSettings {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsBackupConfigurationBackupRetentionSettings{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsBackupConfigurationBackupRetentionSettings
+	}
+	r.RetentionUnit = flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum(m["retentionUnit"])
+	r.RetainedBackups = dcl.FlattenInteger(m["retainedBackups"])
+
+	return r
+}
+
+// expandInstanceSettingsDataDiskSizeGbMap expands the contents of InstanceSettingsDataDiskSizeGb into a JSON
+// request object.
+func expandInstanceSettingsDataDiskSizeGbMap(c *Client, f map[string]InstanceSettingsDataDiskSizeGb, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsDataDiskSizeGb(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsDataDiskSizeGbSlice expands the contents of InstanceSettingsDataDiskSizeGb into a JSON
+// request object.
+func expandInstanceSettingsDataDiskSizeGbSlice(c *Client, f []InstanceSettingsDataDiskSizeGb, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsDataDiskSizeGb(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsDataDiskSizeGbMap flattens the contents of InstanceSettingsDataDiskSizeGb from a JSON
+// response object.
+func flattenInstanceSettingsDataDiskSizeGbMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsDataDiskSizeGb {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsDataDiskSizeGb{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsDataDiskSizeGb{}
+	}
+
+	items := make(map[string]InstanceSettingsDataDiskSizeGb)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsDataDiskSizeGb(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsDataDiskSizeGbSlice flattens the contents of InstanceSettingsDataDiskSizeGb from a JSON
+// response object.
+func flattenInstanceSettingsDataDiskSizeGbSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsDataDiskSizeGb {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsDataDiskSizeGb{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsDataDiskSizeGb{}
+	}
+
+	items := make([]InstanceSettingsDataDiskSizeGb, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsDataDiskSizeGb(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsDataDiskSizeGb expands an instance of InstanceSettingsDataDiskSizeGb into a JSON
+// request object.
+func expandInstanceSettingsDataDiskSizeGb(c *Client, f *InstanceSettingsDataDiskSizeGb, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Value; !dcl.IsEmptyValueIndirect(v) {
+		m["value"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettingsDataDiskSizeGb flattens an instance of InstanceSettingsDataDiskSizeGb from a JSON
+// response object.
+func flattenInstanceSettingsDataDiskSizeGb(c *Client, i interface{}, res *Instance) *InstanceSettingsDataDiskSizeGb {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsDataDiskSizeGb{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsDataDiskSizeGb
+	}
+	r.Value = dcl.FlattenInteger(m["value"])
+
+	return r
+}
+
+// expandInstanceSettingsActiveDirectoryConfigMap expands the contents of InstanceSettingsActiveDirectoryConfig into a JSON
+// request object.
+func expandInstanceSettingsActiveDirectoryConfigMap(c *Client, f map[string]InstanceSettingsActiveDirectoryConfig, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsActiveDirectoryConfig(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsActiveDirectoryConfigSlice expands the contents of InstanceSettingsActiveDirectoryConfig into a JSON
+// request object.
+func expandInstanceSettingsActiveDirectoryConfigSlice(c *Client, f []InstanceSettingsActiveDirectoryConfig, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsActiveDirectoryConfig(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsActiveDirectoryConfigMap flattens the contents of InstanceSettingsActiveDirectoryConfig from a JSON
+// response object.
+func flattenInstanceSettingsActiveDirectoryConfigMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsActiveDirectoryConfig {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsActiveDirectoryConfig{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsActiveDirectoryConfig{}
+	}
+
+	items := make(map[string]InstanceSettingsActiveDirectoryConfig)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsActiveDirectoryConfig(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsActiveDirectoryConfigSlice flattens the contents of InstanceSettingsActiveDirectoryConfig from a JSON
+// response object.
+func flattenInstanceSettingsActiveDirectoryConfigSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsActiveDirectoryConfig {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsActiveDirectoryConfig{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsActiveDirectoryConfig{}
+	}
+
+	items := make([]InstanceSettingsActiveDirectoryConfig, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsActiveDirectoryConfig(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsActiveDirectoryConfig expands an instance of InstanceSettingsActiveDirectoryConfig into a JSON
+// request object.
+func expandInstanceSettingsActiveDirectoryConfig(c *Client, f *InstanceSettingsActiveDirectoryConfig, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+	if v := f.Domain; !dcl.IsEmptyValueIndirect(v) {
+		m["domain"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettingsActiveDirectoryConfig flattens an instance of InstanceSettingsActiveDirectoryConfig from a JSON
+// response object.
+func flattenInstanceSettingsActiveDirectoryConfig(c *Client, i interface{}, res *Instance) *InstanceSettingsActiveDirectoryConfig {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsActiveDirectoryConfig{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsActiveDirectoryConfig
+	}
+	r.Kind = dcl.FlattenString(m["kind"])
+	r.Domain = dcl.FlattenString(m["domain"])
+
+	return r
+}
+
+// expandInstanceSettingsDenyMaintenancePeriodsMap expands the contents of InstanceSettingsDenyMaintenancePeriods into a JSON
+// request object.
+func expandInstanceSettingsDenyMaintenancePeriodsMap(c *Client, f map[string]InstanceSettingsDenyMaintenancePeriods, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsDenyMaintenancePeriods(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsDenyMaintenancePeriodsSlice expands the contents of InstanceSettingsDenyMaintenancePeriods into a JSON
+// request object.
+func expandInstanceSettingsDenyMaintenancePeriodsSlice(c *Client, f []InstanceSettingsDenyMaintenancePeriods, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsDenyMaintenancePeriods(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsDenyMaintenancePeriodsMap flattens the contents of InstanceSettingsDenyMaintenancePeriods from a JSON
+// response object.
+func flattenInstanceSettingsDenyMaintenancePeriodsMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsDenyMaintenancePeriods {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsDenyMaintenancePeriods{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsDenyMaintenancePeriods{}
+	}
+
+	items := make(map[string]InstanceSettingsDenyMaintenancePeriods)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsDenyMaintenancePeriods(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsDenyMaintenancePeriodsSlice flattens the contents of InstanceSettingsDenyMaintenancePeriods from a JSON
+// response object.
+func flattenInstanceSettingsDenyMaintenancePeriodsSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsDenyMaintenancePeriods {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsDenyMaintenancePeriods{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsDenyMaintenancePeriods{}
+	}
+
+	items := make([]InstanceSettingsDenyMaintenancePeriods, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsDenyMaintenancePeriods(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsDenyMaintenancePeriods expands an instance of InstanceSettingsDenyMaintenancePeriods into a JSON
+// request object.
+func expandInstanceSettingsDenyMaintenancePeriods(c *Client, f *InstanceSettingsDenyMaintenancePeriods, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.StartDate; !dcl.IsEmptyValueIndirect(v) {
+		m["startDate"] = v
+	}
+	if v := f.EndDate; !dcl.IsEmptyValueIndirect(v) {
+		m["endDate"] = v
+	}
+	if v := f.Time; !dcl.IsEmptyValueIndirect(v) {
+		m["time"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettingsDenyMaintenancePeriods flattens an instance of InstanceSettingsDenyMaintenancePeriods from a JSON
+// response object.
+func flattenInstanceSettingsDenyMaintenancePeriods(c *Client, i interface{}, res *Instance) *InstanceSettingsDenyMaintenancePeriods {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsDenyMaintenancePeriods{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsDenyMaintenancePeriods
+	}
+	r.StartDate = dcl.FlattenString(m["startDate"])
+	r.EndDate = dcl.FlattenString(m["endDate"])
+	r.Time = dcl.FlattenString(m["time"])
+
+	return r
+}
+
+// expandInstanceSettingsInsightsConfigMap expands the contents of InstanceSettingsInsightsConfig into a JSON
+// request object.
+func expandInstanceSettingsInsightsConfigMap(c *Client, f map[string]InstanceSettingsInsightsConfig, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceSettingsInsightsConfig(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceSettingsInsightsConfigSlice expands the contents of InstanceSettingsInsightsConfig into a JSON
+// request object.
+func expandInstanceSettingsInsightsConfigSlice(c *Client, f []InstanceSettingsInsightsConfig, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceSettingsInsightsConfig(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceSettingsInsightsConfigMap flattens the contents of InstanceSettingsInsightsConfig from a JSON
+// response object.
+func flattenInstanceSettingsInsightsConfigMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsInsightsConfig {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsInsightsConfig{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsInsightsConfig{}
+	}
+
+	items := make(map[string]InstanceSettingsInsightsConfig)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsInsightsConfig(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsInsightsConfigSlice flattens the contents of InstanceSettingsInsightsConfig from a JSON
+// response object.
+func flattenInstanceSettingsInsightsConfigSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsInsightsConfig {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsInsightsConfig{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsInsightsConfig{}
+	}
+
+	items := make([]InstanceSettingsInsightsConfig, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsInsightsConfig(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceSettingsInsightsConfig expands an instance of InstanceSettingsInsightsConfig into a JSON
+// request object.
+func expandInstanceSettingsInsightsConfig(c *Client, f *InstanceSettingsInsightsConfig, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.QueryInsightsEnabled; !dcl.IsEmptyValueIndirect(v) {
+		m["queryInsightsEnabled"] = v
+	}
+	if v := f.RecordClientAddress; !dcl.IsEmptyValueIndirect(v) {
+		m["recordClientAddress"] = v
+	}
+	if v := f.RecordApplicationTags; !dcl.IsEmptyValueIndirect(v) {
+		m["recordApplicationTags"] = v
+	}
+	if v := f.QueryStringLength; !dcl.IsEmptyValueIndirect(v) {
+		m["queryStringLength"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceSettingsInsightsConfig flattens an instance of InstanceSettingsInsightsConfig from a JSON
+// response object.
+func flattenInstanceSettingsInsightsConfig(c *Client, i interface{}, res *Instance) *InstanceSettingsInsightsConfig {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceSettingsInsightsConfig{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceSettingsInsightsConfig
+	}
+	r.QueryInsightsEnabled = dcl.FlattenBool(m["queryInsightsEnabled"])
+	r.RecordClientAddress = dcl.FlattenBool(m["recordClientAddress"])
+	r.RecordApplicationTags = dcl.FlattenBool(m["recordApplicationTags"])
+	r.QueryStringLength = dcl.FlattenInteger(m["queryStringLength"])
+
+	return r
+}
+
+// expandInstanceReplicaInstancesMap expands the contents of InstanceReplicaInstances into a JSON
+// request object.
+func expandInstanceReplicaInstancesMap(c *Client, f map[string]InstanceReplicaInstances, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceReplicaInstances(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceReplicaInstancesSlice expands the contents of InstanceReplicaInstances into a JSON
+// request object.
+func expandInstanceReplicaInstancesSlice(c *Client, f []InstanceReplicaInstances, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceReplicaInstances(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceReplicaInstancesMap flattens the contents of InstanceReplicaInstances from a JSON
+// response object.
+func flattenInstanceReplicaInstancesMap(c *Client, i interface{}, res *Instance) map[string]InstanceReplicaInstances {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceReplicaInstances{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceReplicaInstances{}
+	}
+
+	items := make(map[string]InstanceReplicaInstances)
+	for k, item := range a {
+		items[k] = *flattenInstanceReplicaInstances(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceReplicaInstancesSlice flattens the contents of InstanceReplicaInstances from a JSON
+// response object.
+func flattenInstanceReplicaInstancesSlice(c *Client, i interface{}, res *Instance) []InstanceReplicaInstances {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceReplicaInstances{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceReplicaInstances{}
+	}
+
+	items := make([]InstanceReplicaInstances, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceReplicaInstances(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceReplicaInstances expands an instance of InstanceReplicaInstances into a JSON
+// request object.
+func expandInstanceReplicaInstances(c *Client, f *InstanceReplicaInstances, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenInstanceReplicaInstances flattens an instance of InstanceReplicaInstances from a JSON
+// response object.
+func flattenInstanceReplicaInstances(c *Client, i interface{}, res *Instance) *InstanceReplicaInstances {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceReplicaInstances{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceReplicaInstances
+	}
+	r.Name = dcl.FlattenString(m["name"])
+	r.Region = dcl.FlattenString(m["region"])
+
+	return r
+}
+
+// expandInstanceServerCaCertMap expands the contents of InstanceServerCaCert into a JSON
+// request object.
+func expandInstanceServerCaCertMap(c *Client, f map[string]InstanceServerCaCert, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceServerCaCert(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceServerCaCertSlice expands the contents of InstanceServerCaCert into a JSON
+// request object.
+func expandInstanceServerCaCertSlice(c *Client, f []InstanceServerCaCert, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceServerCaCert(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceServerCaCertMap flattens the contents of InstanceServerCaCert from a JSON
+// response object.
+func flattenInstanceServerCaCertMap(c *Client, i interface{}, res *Instance) map[string]InstanceServerCaCert {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceServerCaCert{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceServerCaCert{}
+	}
+
+	items := make(map[string]InstanceServerCaCert)
+	for k, item := range a {
+		items[k] = *flattenInstanceServerCaCert(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceServerCaCertSlice flattens the contents of InstanceServerCaCert from a JSON
+// response object.
+func flattenInstanceServerCaCertSlice(c *Client, i interface{}, res *Instance) []InstanceServerCaCert {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceServerCaCert{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceServerCaCert{}
+	}
+
+	items := make([]InstanceServerCaCert, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceServerCaCert(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceServerCaCert expands an instance of InstanceServerCaCert into a JSON
+// request object.
+func expandInstanceServerCaCert(c *Client, f *InstanceServerCaCert, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenInstanceServerCaCert flattens an instance of InstanceServerCaCert from a JSON
+// response object.
+func flattenInstanceServerCaCert(c *Client, i interface{}, res *Instance) *InstanceServerCaCert {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceServerCaCert{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceServerCaCert
+	}
+	r.Kind = dcl.FlattenString(m["kind"])
+	r.CertSerialNumber = dcl.FlattenString(m["certSerialNumber"])
+	r.Cert = dcl.FlattenString(m["cert"])
+	r.CreateTime = dcl.FlattenString(m["createTime"])
+	r.CommonName = dcl.FlattenString(m["commonName"])
+	r.ExpirationTime = dcl.FlattenString(m["expirationTime"])
+	r.Sha1Fingerprint = dcl.FlattenString(m["sha1Fingerprint"])
+	r.Instance = dcl.FlattenString(m["instance"])
+
+	return r
+}
+
+// expandInstanceOnPremisesConfigurationMap expands the contents of InstanceOnPremisesConfiguration into a JSON
+// request object.
+func expandInstanceOnPremisesConfigurationMap(c *Client, f map[string]InstanceOnPremisesConfiguration, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceOnPremisesConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceOnPremisesConfigurationSlice expands the contents of InstanceOnPremisesConfiguration into a JSON
+// request object.
+func expandInstanceOnPremisesConfigurationSlice(c *Client, f []InstanceOnPremisesConfiguration, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceOnPremisesConfiguration(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceOnPremisesConfigurationMap flattens the contents of InstanceOnPremisesConfiguration from a JSON
+// response object.
+func flattenInstanceOnPremisesConfigurationMap(c *Client, i interface{}, res *Instance) map[string]InstanceOnPremisesConfiguration {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceOnPremisesConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceOnPremisesConfiguration{}
+	}
+
+	items := make(map[string]InstanceOnPremisesConfiguration)
+	for k, item := range a {
+		items[k] = *flattenInstanceOnPremisesConfiguration(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceOnPremisesConfigurationSlice flattens the contents of InstanceOnPremisesConfiguration from a JSON
+// response object.
+func flattenInstanceOnPremisesConfigurationSlice(c *Client, i interface{}, res *Instance) []InstanceOnPremisesConfiguration {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceOnPremisesConfiguration{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceOnPremisesConfiguration{}
+	}
+
+	items := make([]InstanceOnPremisesConfiguration, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceOnPremisesConfiguration(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceOnPremisesConfiguration expands an instance of InstanceOnPremisesConfiguration into a JSON
+// request object.
+func expandInstanceOnPremisesConfiguration(c *Client, f *InstanceOnPremisesConfiguration, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.HostPort; !dcl.IsEmptyValueIndirect(v) {
+		m["hostPort"] = v
+	}
+	if v := f.Kind; !dcl.IsEmptyValueIndirect(v) {
+		m["kind"] = v
+	}
+	if v := f.Username; !dcl.IsEmptyValueIndirect(v) {
+		m["username"] = v
+	}
+	if v := f.Password; !dcl.IsEmptyValueIndirect(v) {
+		m["password"] = v
+	}
+	if v := f.CaCertificate; !dcl.IsEmptyValueIndirect(v) {
+		m["caCertificate"] = v
+	}
+	if v := f.ClientCertificate; !dcl.IsEmptyValueIndirect(v) {
+		m["clientCertificate"] = v
+	}
+	if v := f.ClientKey; !dcl.IsEmptyValueIndirect(v) {
+		m["clientKey"] = v
+	}
+	if v := f.DumpFilePath; !dcl.IsEmptyValueIndirect(v) {
+		m["dumpFilePath"] = v
+	}
+	if v := f.Database; !dcl.IsEmptyValueIndirect(v) {
+		m["database"] = v
+	}
+	if v := f.ReplicatedDatabases; v != nil {
+		m["replicatedDatabases"] = v
+	}
+
+	return m, nil
+}
+
+// flattenInstanceOnPremisesConfiguration flattens an instance of InstanceOnPremisesConfiguration from a JSON
+// response object.
+func flattenInstanceOnPremisesConfiguration(c *Client, i interface{}, res *Instance) *InstanceOnPremisesConfiguration {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceOnPremisesConfiguration{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceOnPremisesConfiguration
+	}
+	r.HostPort = dcl.FlattenString(m["hostPort"])
+	r.Kind = dcl.FlattenString(m["kind"])
+	r.Username = dcl.FlattenString(m["username"])
+	r.Password = dcl.FlattenString(m["password"])
+	r.CaCertificate = dcl.FlattenString(m["caCertificate"])
+	r.ClientCertificate = dcl.FlattenString(m["clientCertificate"])
+	r.ClientKey = dcl.FlattenString(m["clientKey"])
+	r.DumpFilePath = dcl.FlattenString(m["dumpFilePath"])
+	r.Database = dcl.FlattenString(m["database"])
+	r.ReplicatedDatabases = dcl.FlattenStringSlice(m["replicatedDatabases"])
+
+	return r
+}
+
+// expandInstanceDiskEncryptionStatusMap expands the contents of InstanceDiskEncryptionStatus into a JSON
+// request object.
+func expandInstanceDiskEncryptionStatusMap(c *Client, f map[string]InstanceDiskEncryptionStatus, res *Instance) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandInstanceDiskEncryptionStatus(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandInstanceDiskEncryptionStatusSlice expands the contents of InstanceDiskEncryptionStatus into a JSON
+// request object.
+func expandInstanceDiskEncryptionStatusSlice(c *Client, f []InstanceDiskEncryptionStatus, res *Instance) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandInstanceDiskEncryptionStatus(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenInstanceDiskEncryptionStatusMap flattens the contents of InstanceDiskEncryptionStatus from a JSON
+// response object.
+func flattenInstanceDiskEncryptionStatusMap(c *Client, i interface{}, res *Instance) map[string]InstanceDiskEncryptionStatus {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceDiskEncryptionStatus{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceDiskEncryptionStatus{}
+	}
+
+	items := make(map[string]InstanceDiskEncryptionStatus)
+	for k, item := range a {
+		items[k] = *flattenInstanceDiskEncryptionStatus(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenInstanceDiskEncryptionStatusSlice flattens the contents of InstanceDiskEncryptionStatus from a JSON
+// response object.
+func flattenInstanceDiskEncryptionStatusSlice(c *Client, i interface{}, res *Instance) []InstanceDiskEncryptionStatus {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceDiskEncryptionStatus{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceDiskEncryptionStatus{}
+	}
+
+	items := make([]InstanceDiskEncryptionStatus, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceDiskEncryptionStatus(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandInstanceDiskEncryptionStatus expands an instance of InstanceDiskEncryptionStatus into a JSON
+// request object.
+func expandInstanceDiskEncryptionStatus(c *Client, f *InstanceDiskEncryptionStatus, res *Instance) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenInstanceDiskEncryptionStatus flattens an instance of InstanceDiskEncryptionStatus from a JSON
+// response object.
+func flattenInstanceDiskEncryptionStatus(c *Client, i interface{}, res *Instance) *InstanceDiskEncryptionStatus {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &InstanceDiskEncryptionStatus{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyInstanceDiskEncryptionStatus
+	}
+	r.KmsKeyVersionName = dcl.FlattenString(m["kmsKeyVersionName"])
+	r.Kind = dcl.FlattenString(m["kind"])
+
+	return r
+}
+
+// flattenInstanceBackendTypeEnumMap flattens the contents of InstanceBackendTypeEnum from a JSON
+// response object.
+func flattenInstanceBackendTypeEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceBackendTypeEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceBackendTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceBackendTypeEnum{}
+	}
+
+	items := make(map[string]InstanceBackendTypeEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceBackendTypeEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceBackendTypeEnumSlice flattens the contents of InstanceBackendTypeEnum from a JSON
+// response object.
+func flattenInstanceBackendTypeEnumSlice(c *Client, i interface{}, res *Instance) []InstanceBackendTypeEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceBackendTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceBackendTypeEnum{}
+	}
+
+	items := make([]InstanceBackendTypeEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceBackendTypeEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceBackendTypeEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceBackendTypeEnum with the same value as that string.
+func flattenInstanceBackendTypeEnum(i interface{}) *InstanceBackendTypeEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceBackendTypeEnumRef(s)
+}
+
+// flattenInstanceDatabaseVersionEnumMap flattens the contents of InstanceDatabaseVersionEnum from a JSON
+// response object.
+func flattenInstanceDatabaseVersionEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceDatabaseVersionEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceDatabaseVersionEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceDatabaseVersionEnum{}
+	}
+
+	items := make(map[string]InstanceDatabaseVersionEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceDatabaseVersionEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceDatabaseVersionEnumSlice flattens the contents of InstanceDatabaseVersionEnum from a JSON
+// response object.
+func flattenInstanceDatabaseVersionEnumSlice(c *Client, i interface{}, res *Instance) []InstanceDatabaseVersionEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceDatabaseVersionEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceDatabaseVersionEnum{}
+	}
+
+	items := make([]InstanceDatabaseVersionEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceDatabaseVersionEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceDatabaseVersionEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceDatabaseVersionEnum with the same value as that string.
+func flattenInstanceDatabaseVersionEnum(i interface{}) *InstanceDatabaseVersionEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceDatabaseVersionEnumRef(s)
+}
+
+// flattenInstanceIPAddressesTypeEnumMap flattens the contents of InstanceIPAddressesTypeEnum from a JSON
+// response object.
+func flattenInstanceIPAddressesTypeEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceIPAddressesTypeEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceIPAddressesTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceIPAddressesTypeEnum{}
+	}
+
+	items := make(map[string]InstanceIPAddressesTypeEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceIPAddressesTypeEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceIPAddressesTypeEnumSlice flattens the contents of InstanceIPAddressesTypeEnum from a JSON
+// response object.
+func flattenInstanceIPAddressesTypeEnumSlice(c *Client, i interface{}, res *Instance) []InstanceIPAddressesTypeEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceIPAddressesTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceIPAddressesTypeEnum{}
+	}
+
+	items := make([]InstanceIPAddressesTypeEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceIPAddressesTypeEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceIPAddressesTypeEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceIPAddressesTypeEnum with the same value as that string.
+func flattenInstanceIPAddressesTypeEnum(i interface{}) *InstanceIPAddressesTypeEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceIPAddressesTypeEnumRef(s)
+}
+
+// flattenInstanceInstanceTypeEnumMap flattens the contents of InstanceInstanceTypeEnum from a JSON
+// response object.
+func flattenInstanceInstanceTypeEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceInstanceTypeEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceInstanceTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceInstanceTypeEnum{}
+	}
+
+	items := make(map[string]InstanceInstanceTypeEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceInstanceTypeEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceInstanceTypeEnumSlice flattens the contents of InstanceInstanceTypeEnum from a JSON
+// response object.
+func flattenInstanceInstanceTypeEnumSlice(c *Client, i interface{}, res *Instance) []InstanceInstanceTypeEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceInstanceTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceInstanceTypeEnum{}
+	}
+
+	items := make([]InstanceInstanceTypeEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceInstanceTypeEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceInstanceTypeEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceInstanceTypeEnum with the same value as that string.
+func flattenInstanceInstanceTypeEnum(i interface{}) *InstanceInstanceTypeEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceInstanceTypeEnumRef(s)
+}
+
+// flattenInstanceSettingsActivationPolicyEnumMap flattens the contents of InstanceSettingsActivationPolicyEnum from a JSON
+// response object.
+func flattenInstanceSettingsActivationPolicyEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsActivationPolicyEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsActivationPolicyEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsActivationPolicyEnum{}
+	}
+
+	items := make(map[string]InstanceSettingsActivationPolicyEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsActivationPolicyEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsActivationPolicyEnumSlice flattens the contents of InstanceSettingsActivationPolicyEnum from a JSON
+// response object.
+func flattenInstanceSettingsActivationPolicyEnumSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsActivationPolicyEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsActivationPolicyEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsActivationPolicyEnum{}
+	}
+
+	items := make([]InstanceSettingsActivationPolicyEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsActivationPolicyEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsActivationPolicyEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceSettingsActivationPolicyEnum with the same value as that string.
+func flattenInstanceSettingsActivationPolicyEnum(i interface{}) *InstanceSettingsActivationPolicyEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceSettingsActivationPolicyEnumRef(s)
+}
+
+// flattenInstanceSettingsAvailabilityTypeEnumMap flattens the contents of InstanceSettingsAvailabilityTypeEnum from a JSON
+// response object.
+func flattenInstanceSettingsAvailabilityTypeEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsAvailabilityTypeEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsAvailabilityTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsAvailabilityTypeEnum{}
+	}
+
+	items := make(map[string]InstanceSettingsAvailabilityTypeEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsAvailabilityTypeEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsAvailabilityTypeEnumSlice flattens the contents of InstanceSettingsAvailabilityTypeEnum from a JSON
+// response object.
+func flattenInstanceSettingsAvailabilityTypeEnumSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsAvailabilityTypeEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsAvailabilityTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsAvailabilityTypeEnum{}
+	}
+
+	items := make([]InstanceSettingsAvailabilityTypeEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsAvailabilityTypeEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsAvailabilityTypeEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceSettingsAvailabilityTypeEnum with the same value as that string.
+func flattenInstanceSettingsAvailabilityTypeEnum(i interface{}) *InstanceSettingsAvailabilityTypeEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceSettingsAvailabilityTypeEnumRef(s)
+}
+
+// flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnumMap flattens the contents of InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum from a JSON
+// response object.
+func flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum{}
+	}
+
+	items := make(map[string]InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnumSlice flattens the contents of InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum from a JSON
+// response object.
+func flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnumSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum{}
+	}
+
+	items := make([]InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum with the same value as that string.
+func flattenInstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum(i interface{}) *InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnumRef(s)
+}
+
+// flattenInstanceSettingsDataDiskTypeEnumMap flattens the contents of InstanceSettingsDataDiskTypeEnum from a JSON
+// response object.
+func flattenInstanceSettingsDataDiskTypeEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsDataDiskTypeEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsDataDiskTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsDataDiskTypeEnum{}
+	}
+
+	items := make(map[string]InstanceSettingsDataDiskTypeEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsDataDiskTypeEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsDataDiskTypeEnumSlice flattens the contents of InstanceSettingsDataDiskTypeEnum from a JSON
+// response object.
+func flattenInstanceSettingsDataDiskTypeEnumSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsDataDiskTypeEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsDataDiskTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsDataDiskTypeEnum{}
+	}
+
+	items := make([]InstanceSettingsDataDiskTypeEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsDataDiskTypeEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsDataDiskTypeEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceSettingsDataDiskTypeEnum with the same value as that string.
+func flattenInstanceSettingsDataDiskTypeEnum(i interface{}) *InstanceSettingsDataDiskTypeEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceSettingsDataDiskTypeEnumRef(s)
+}
+
+// flattenInstanceSettingsMaintenanceWindowUpdateTrackEnumMap flattens the contents of InstanceSettingsMaintenanceWindowUpdateTrackEnum from a JSON
+// response object.
+func flattenInstanceSettingsMaintenanceWindowUpdateTrackEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsMaintenanceWindowUpdateTrackEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsMaintenanceWindowUpdateTrackEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsMaintenanceWindowUpdateTrackEnum{}
+	}
+
+	items := make(map[string]InstanceSettingsMaintenanceWindowUpdateTrackEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsMaintenanceWindowUpdateTrackEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsMaintenanceWindowUpdateTrackEnumSlice flattens the contents of InstanceSettingsMaintenanceWindowUpdateTrackEnum from a JSON
+// response object.
+func flattenInstanceSettingsMaintenanceWindowUpdateTrackEnumSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsMaintenanceWindowUpdateTrackEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsMaintenanceWindowUpdateTrackEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsMaintenanceWindowUpdateTrackEnum{}
+	}
+
+	items := make([]InstanceSettingsMaintenanceWindowUpdateTrackEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsMaintenanceWindowUpdateTrackEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsMaintenanceWindowUpdateTrackEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceSettingsMaintenanceWindowUpdateTrackEnum with the same value as that string.
+func flattenInstanceSettingsMaintenanceWindowUpdateTrackEnum(i interface{}) *InstanceSettingsMaintenanceWindowUpdateTrackEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceSettingsMaintenanceWindowUpdateTrackEnumRef(s)
+}
+
+// flattenInstanceSettingsPricingPlanEnumMap flattens the contents of InstanceSettingsPricingPlanEnum from a JSON
+// response object.
+func flattenInstanceSettingsPricingPlanEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsPricingPlanEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsPricingPlanEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsPricingPlanEnum{}
+	}
+
+	items := make(map[string]InstanceSettingsPricingPlanEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsPricingPlanEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsPricingPlanEnumSlice flattens the contents of InstanceSettingsPricingPlanEnum from a JSON
+// response object.
+func flattenInstanceSettingsPricingPlanEnumSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsPricingPlanEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsPricingPlanEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsPricingPlanEnum{}
+	}
+
+	items := make([]InstanceSettingsPricingPlanEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsPricingPlanEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsPricingPlanEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceSettingsPricingPlanEnum with the same value as that string.
+func flattenInstanceSettingsPricingPlanEnum(i interface{}) *InstanceSettingsPricingPlanEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceSettingsPricingPlanEnumRef(s)
+}
+
+// flattenInstanceSettingsReplicationTypeEnumMap flattens the contents of InstanceSettingsReplicationTypeEnum from a JSON
+// response object.
+func flattenInstanceSettingsReplicationTypeEnumMap(c *Client, i interface{}, res *Instance) map[string]InstanceSettingsReplicationTypeEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]InstanceSettingsReplicationTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]InstanceSettingsReplicationTypeEnum{}
+	}
+
+	items := make(map[string]InstanceSettingsReplicationTypeEnum)
+	for k, item := range a {
+		items[k] = *flattenInstanceSettingsReplicationTypeEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsReplicationTypeEnumSlice flattens the contents of InstanceSettingsReplicationTypeEnum from a JSON
+// response object.
+func flattenInstanceSettingsReplicationTypeEnumSlice(c *Client, i interface{}, res *Instance) []InstanceSettingsReplicationTypeEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []InstanceSettingsReplicationTypeEnum{}
+	}
+
+	if len(a) == 0 {
+		return []InstanceSettingsReplicationTypeEnum{}
+	}
+
+	items := make([]InstanceSettingsReplicationTypeEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenInstanceSettingsReplicationTypeEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenInstanceSettingsReplicationTypeEnum asserts that an interface is a string, and returns a
+// pointer to a *InstanceSettingsReplicationTypeEnum with the same value as that string.
+func flattenInstanceSettingsReplicationTypeEnum(i interface{}) *InstanceSettingsReplicationTypeEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return InstanceSettingsReplicationTypeEnumRef(s)
+}
+
+// This function returns a matcher that checks whether a serialized resource matches this resource
+// in its parameters (as defined by the fields in a Get, which definitionally define resource
+// identity).  This is useful in extracting the element from a List call.
+func (r *Instance) matcher(c *Client) func([]byte) bool {
+	return func(b []byte) bool {
+		cr, err := unmarshalInstance(b, c, r)
+		if err != nil {
+			c.Config.Logger.Warning("failed to unmarshal provided resource in matcher.")
+			return false
+		}
+		nr := r.urlNormalized()
+		ncr := cr.urlNormalized()
+		c.Config.Logger.Infof("looking for %v\nin %v", nr, ncr)
+
+		if nr.Project == nil && ncr.Project == nil {
+			c.Config.Logger.Info("Both Project fields null - considering equal.")
+		} else if nr.Project == nil || ncr.Project == nil {
+			c.Config.Logger.Info("Only one Project field is null - considering unequal.")
+			return false
+		} else if *nr.Project != *ncr.Project {
+			return false
+		}
+		if nr.Name == nil && ncr.Name == nil {
+			c.Config.Logger.Info("Both Name fields null - considering equal.")
+		} else if nr.Name == nil || ncr.Name == nil {
+			c.Config.Logger.Info("Only one Name field is null - considering unequal.")
+			return false
+		} else if *nr.Name != *ncr.Name {
+			return false
+		}
+		return true
+	}
+}
+
+type instanceDiff struct {
+	// The diff should include one or the other of RequiresRecreate or UpdateOp.
+	RequiresRecreate bool
+	UpdateOp         instanceApiOperation
+	FieldName        string // used for error logging
+}
+
+func convertFieldDiffsToInstanceDiffs(config *dcl.Config, fds []*dcl.FieldDiff, opts []dcl.ApplyOption) ([]instanceDiff, error) {
+	opNamesToFieldDiffs := make(map[string][]*dcl.FieldDiff)
+	// Map each operation name to the field diffs associated with it.
+	for _, fd := range fds {
+		for _, ro := range fd.ResultingOperation {
+			if fieldDiffs, ok := opNamesToFieldDiffs[ro]; ok {
+				fieldDiffs = append(fieldDiffs, fd)
+				opNamesToFieldDiffs[ro] = fieldDiffs
+			} else {
+				config.Logger.Infof("%s required due to diff: %v", ro, fd)
+				opNamesToFieldDiffs[ro] = []*dcl.FieldDiff{fd}
+			}
+		}
+	}
+	var diffs []instanceDiff
+	// For each operation name, create a instanceDiff which contains the operation.
+	for opName, fieldDiffs := range opNamesToFieldDiffs {
+		// Use the first field diff's field name for logging required recreate error.
+		diff := instanceDiff{FieldName: fieldDiffs[0].FieldName}
+		if opName == "Recreate" {
+			diff.RequiresRecreate = true
+		} else {
+			apiOp, err := convertOpNameToInstanceApiOperation(opName, fieldDiffs, opts...)
+			if err != nil {
+				return diffs, err
+			}
+			diff.UpdateOp = apiOp
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+func convertOpNameToInstanceApiOperation(opName string, fieldDiffs []*dcl.FieldDiff, opts ...dcl.ApplyOption) (instanceApiOperation, error) {
+	switch opName {
+
+	case "updateInstancePatchOperation":
+		return &updateInstancePatchOperation{FieldDiffs: fieldDiffs}, nil
+
+	default:
+		return nil, fmt.Errorf("no such operation with name: %v", opName)
+	}
+}
+
+func extractInstanceFields(r *Instance) error {
+	vMaxDiskSize := r.MaxDiskSize
+	if vMaxDiskSize == nil {
+		// note: explicitly not the empty object.
+		vMaxDiskSize = &InstanceMaxDiskSize{}
+	}
+	if err := extractInstanceMaxDiskSizeFields(r, vMaxDiskSize); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMaxDiskSize) {
+		r.MaxDiskSize = vMaxDiskSize
+	}
+	vCurrentDiskSize := r.CurrentDiskSize
+	if vCurrentDiskSize == nil {
+		// note: explicitly not the empty object.
+		vCurrentDiskSize = &InstanceCurrentDiskSize{}
+	}
+	if err := extractInstanceCurrentDiskSizeFields(r, vCurrentDiskSize); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vCurrentDiskSize) {
+		r.CurrentDiskSize = vCurrentDiskSize
+	}
+	vDiskEncryptionConfiguration := r.DiskEncryptionConfiguration
+	if vDiskEncryptionConfiguration == nil {
+		// note: explicitly not the empty object.
+		vDiskEncryptionConfiguration = &InstanceDiskEncryptionConfiguration{}
+	}
+	if err := extractInstanceDiskEncryptionConfigurationFields(r, vDiskEncryptionConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vDiskEncryptionConfiguration) {
+		r.DiskEncryptionConfiguration = vDiskEncryptionConfiguration
+	}
+	vFailoverReplica := r.FailoverReplica
+	if vFailoverReplica == nil {
+		// note: explicitly not the empty object.
+		vFailoverReplica = &InstanceFailoverReplica{}
+	}
+	if err := extractInstanceFailoverReplicaFields(r, vFailoverReplica); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vFailoverReplica) {
+		r.FailoverReplica = vFailoverReplica
+	}
+	vMasterInstance := r.MasterInstance
+	if vMasterInstance == nil {
+		// note: explicitly not the empty object.
+		vMasterInstance = &InstanceMasterInstance{}
+	}
+	if err := extractInstanceMasterInstanceFields(r, vMasterInstance); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMasterInstance) {
+		r.MasterInstance = vMasterInstance
+	}
+	vReplicaConfiguration := r.ReplicaConfiguration
+	if vReplicaConfiguration == nil {
+		// note: explicitly not the empty object.
+		vReplicaConfiguration = &InstanceReplicaConfiguration{}
+	}
+	if err := extractInstanceReplicaConfigurationFields(r, vReplicaConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vReplicaConfiguration) {
+		r.ReplicaConfiguration = vReplicaConfiguration
+	}
+	vScheduledMaintenance := r.ScheduledMaintenance
+	if vScheduledMaintenance == nil {
+		// note: explicitly not the empty object.
+		vScheduledMaintenance = &InstanceScheduledMaintenance{}
+	}
+	if err := extractInstanceScheduledMaintenanceFields(r, vScheduledMaintenance); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vScheduledMaintenance) {
+		r.ScheduledMaintenance = vScheduledMaintenance
+	}
+	vSettings := r.Settings
+	if vSettings == nil {
+		// note: explicitly not the empty object.
+		vSettings = &InstanceSettings{}
+	}
+	if err := extractInstanceSettingsFields(r, vSettings); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vSettings) {
+		r.Settings = vSettings
+	}
+	vServerCaCert := r.ServerCaCert
+	if vServerCaCert == nil {
+		// note: explicitly not the empty object.
+		vServerCaCert = &InstanceServerCaCert{}
+	}
+	if err := extractInstanceServerCaCertFields(r, vServerCaCert); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vServerCaCert) {
+		r.ServerCaCert = vServerCaCert
+	}
+	vOnPremisesConfiguration := r.OnPremisesConfiguration
+	if vOnPremisesConfiguration == nil {
+		// note: explicitly not the empty object.
+		vOnPremisesConfiguration = &InstanceOnPremisesConfiguration{}
+	}
+	if err := extractInstanceOnPremisesConfigurationFields(r, vOnPremisesConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vOnPremisesConfiguration) {
+		r.OnPremisesConfiguration = vOnPremisesConfiguration
+	}
+	vDiskEncryptionStatus := r.DiskEncryptionStatus
+	if vDiskEncryptionStatus == nil {
+		// note: explicitly not the empty object.
+		vDiskEncryptionStatus = &InstanceDiskEncryptionStatus{}
+	}
+	if err := extractInstanceDiskEncryptionStatusFields(r, vDiskEncryptionStatus); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vDiskEncryptionStatus) {
+		r.DiskEncryptionStatus = vDiskEncryptionStatus
+	}
+	return nil
+}
+func extractInstanceMaxDiskSizeFields(r *Instance, o *InstanceMaxDiskSize) error {
+	return nil
+}
+func extractInstanceCurrentDiskSizeFields(r *Instance, o *InstanceCurrentDiskSize) error {
+	return nil
+}
+func extractInstanceDiskEncryptionConfigurationFields(r *Instance, o *InstanceDiskEncryptionConfiguration) error {
+	return nil
+}
+func extractInstanceFailoverReplicaFields(r *Instance, o *InstanceFailoverReplica) error {
+	vFailoverInstance := o.FailoverInstance
+	if vFailoverInstance == nil {
+		// note: explicitly not the empty object.
+		vFailoverInstance = &InstanceFailoverReplicaFailoverInstance{}
+	}
+	if err := extractInstanceFailoverReplicaFailoverInstanceFields(r, vFailoverInstance); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vFailoverInstance) {
+		o.FailoverInstance = vFailoverInstance
+	}
+	return nil
+}
+func extractInstanceFailoverReplicaFailoverInstanceFields(r *Instance, o *InstanceFailoverReplicaFailoverInstance) error {
+	return nil
+}
+func extractInstanceIPAddressesFields(r *Instance, o *InstanceIPAddresses) error {
+	vTimeToRetire := o.TimeToRetire
+	if vTimeToRetire == nil {
+		// note: explicitly not the empty object.
+		vTimeToRetire = &InstanceIPAddressesTimeToRetire{}
+	}
+	if err := extractInstanceIPAddressesTimeToRetireFields(r, vTimeToRetire); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vTimeToRetire) {
+		o.TimeToRetire = vTimeToRetire
+	}
+	return nil
+}
+func extractInstanceIPAddressesTimeToRetireFields(r *Instance, o *InstanceIPAddressesTimeToRetire) error {
+	return nil
+}
+func extractInstanceMasterInstanceFields(r *Instance, o *InstanceMasterInstance) error {
+	return nil
+}
+func extractInstanceReplicaConfigurationFields(r *Instance, o *InstanceReplicaConfiguration) error {
+	vMysqlReplicaConfiguration := o.MysqlReplicaConfiguration
+	if vMysqlReplicaConfiguration == nil {
+		// note: explicitly not the empty object.
+		vMysqlReplicaConfiguration = &InstanceReplicaConfigurationMysqlReplicaConfiguration{}
+	}
+	if err := extractInstanceReplicaConfigurationMysqlReplicaConfigurationFields(r, vMysqlReplicaConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMysqlReplicaConfiguration) {
+		o.MysqlReplicaConfiguration = vMysqlReplicaConfiguration
+	}
+	vReplicaPoolConfiguration := o.ReplicaPoolConfiguration
+	if vReplicaPoolConfiguration == nil {
+		// note: explicitly not the empty object.
+		vReplicaPoolConfiguration = &InstanceReplicaConfigurationReplicaPoolConfiguration{}
+	}
+	if err := extractInstanceReplicaConfigurationReplicaPoolConfigurationFields(r, vReplicaPoolConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vReplicaPoolConfiguration) {
+		o.ReplicaPoolConfiguration = vReplicaPoolConfiguration
+	}
+	return nil
+}
+func extractInstanceReplicaConfigurationMysqlReplicaConfigurationFields(r *Instance, o *InstanceReplicaConfigurationMysqlReplicaConfiguration) error {
+	vMasterHeartbeatPeriod := o.MasterHeartbeatPeriod
+	if vMasterHeartbeatPeriod == nil {
+		// note: explicitly not the empty object.
+		vMasterHeartbeatPeriod = &InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod{}
+	}
+	if err := extractInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodFields(r, vMasterHeartbeatPeriod); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMasterHeartbeatPeriod) {
+		o.MasterHeartbeatPeriod = vMasterHeartbeatPeriod
+	}
+	return nil
+}
+func extractInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodFields(r *Instance, o *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod) error {
+	return nil
+}
+func extractInstanceReplicaConfigurationReplicaPoolConfigurationFields(r *Instance, o *InstanceReplicaConfigurationReplicaPoolConfiguration) error {
+	vStaticPoolConfiguration := o.StaticPoolConfiguration
+	if vStaticPoolConfiguration == nil {
+		// note: explicitly not the empty object.
+		vStaticPoolConfiguration = &InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration{}
+	}
+	if err := extractInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationFields(r, vStaticPoolConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vStaticPoolConfiguration) {
+		o.StaticPoolConfiguration = vStaticPoolConfiguration
+	}
+	vAutoscalingPoolConfiguration := o.AutoscalingPoolConfiguration
+	if vAutoscalingPoolConfiguration == nil {
+		// note: explicitly not the empty object.
+		vAutoscalingPoolConfiguration = &InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration{}
+	}
+	if err := extractInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationFields(r, vAutoscalingPoolConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vAutoscalingPoolConfiguration) {
+		o.AutoscalingPoolConfiguration = vAutoscalingPoolConfiguration
+	}
+	return nil
+}
+func extractInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationFields(r *Instance, o *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration) error {
+	return nil
+}
+func extractInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationFields(r *Instance, o *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration) error {
+	return nil
+}
+func extractInstanceScheduledMaintenanceFields(r *Instance, o *InstanceScheduledMaintenance) error {
+	vStartTime := o.StartTime
+	if vStartTime == nil {
+		// note: explicitly not the empty object.
+		vStartTime = &InstanceScheduledMaintenanceStartTime{}
+	}
+	if err := extractInstanceScheduledMaintenanceStartTimeFields(r, vStartTime); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vStartTime) {
+		o.StartTime = vStartTime
+	}
+	return nil
+}
+func extractInstanceScheduledMaintenanceStartTimeFields(r *Instance, o *InstanceScheduledMaintenanceStartTime) error {
+	return nil
+}
+func extractInstanceSettingsFields(r *Instance, o *InstanceSettings) error {
+	vSettingsVersion := o.SettingsVersion
+	if vSettingsVersion == nil {
+		// note: explicitly not the empty object.
+		vSettingsVersion = &InstanceSettingsSettingsVersion{}
+	}
+	if err := extractInstanceSettingsSettingsVersionFields(r, vSettingsVersion); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vSettingsVersion) {
+		o.SettingsVersion = vSettingsVersion
+	}
+	vStorageAutoResizeLimit := o.StorageAutoResizeLimit
+	if vStorageAutoResizeLimit == nil {
+		// note: explicitly not the empty object.
+		vStorageAutoResizeLimit = &InstanceSettingsStorageAutoResizeLimit{}
+	}
+	if err := extractInstanceSettingsStorageAutoResizeLimitFields(r, vStorageAutoResizeLimit); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vStorageAutoResizeLimit) {
+		o.StorageAutoResizeLimit = vStorageAutoResizeLimit
+	}
+	vIPConfiguration := o.IPConfiguration
+	if vIPConfiguration == nil {
+		// note: explicitly not the empty object.
+		vIPConfiguration = &InstanceSettingsIPConfiguration{}
+	}
+	if err := extractInstanceSettingsIPConfigurationFields(r, vIPConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vIPConfiguration) {
+		o.IPConfiguration = vIPConfiguration
+	}
+	vLocationPreference := o.LocationPreference
+	if vLocationPreference == nil {
+		// note: explicitly not the empty object.
+		vLocationPreference = &InstanceSettingsLocationPreference{}
+	}
+	if err := extractInstanceSettingsLocationPreferenceFields(r, vLocationPreference); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vLocationPreference) {
+		o.LocationPreference = vLocationPreference
+	}
+	vMaintenanceWindow := o.MaintenanceWindow
+	if vMaintenanceWindow == nil {
+		// note: explicitly not the empty object.
+		vMaintenanceWindow = &InstanceSettingsMaintenanceWindow{}
+	}
+	if err := extractInstanceSettingsMaintenanceWindowFields(r, vMaintenanceWindow); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMaintenanceWindow) {
+		o.MaintenanceWindow = vMaintenanceWindow
+	}
+	vBackupConfiguration := o.BackupConfiguration
+	if vBackupConfiguration == nil {
+		// note: explicitly not the empty object.
+		vBackupConfiguration = &InstanceSettingsBackupConfiguration{}
+	}
+	if err := extractInstanceSettingsBackupConfigurationFields(r, vBackupConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vBackupConfiguration) {
+		o.BackupConfiguration = vBackupConfiguration
+	}
+	vDataDiskSizeGb := o.DataDiskSizeGb
+	if vDataDiskSizeGb == nil {
+		// note: explicitly not the empty object.
+		vDataDiskSizeGb = &InstanceSettingsDataDiskSizeGb{}
+	}
+	if err := extractInstanceSettingsDataDiskSizeGbFields(r, vDataDiskSizeGb); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vDataDiskSizeGb) {
+		o.DataDiskSizeGb = vDataDiskSizeGb
+	}
+	vActiveDirectoryConfig := o.ActiveDirectoryConfig
+	if vActiveDirectoryConfig == nil {
+		// note: explicitly not the empty object.
+		vActiveDirectoryConfig = &InstanceSettingsActiveDirectoryConfig{}
+	}
+	if err := extractInstanceSettingsActiveDirectoryConfigFields(r, vActiveDirectoryConfig); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vActiveDirectoryConfig) {
+		o.ActiveDirectoryConfig = vActiveDirectoryConfig
+	}
+	vInsightsConfig := o.InsightsConfig
+	if vInsightsConfig == nil {
+		// note: explicitly not the empty object.
+		vInsightsConfig = &InstanceSettingsInsightsConfig{}
+	}
+	if err := extractInstanceSettingsInsightsConfigFields(r, vInsightsConfig); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vInsightsConfig) {
+		o.InsightsConfig = vInsightsConfig
+	}
+	return nil
+}
+func extractInstanceSettingsSettingsVersionFields(r *Instance, o *InstanceSettingsSettingsVersion) error {
+	return nil
+}
+func extractInstanceSettingsStorageAutoResizeLimitFields(r *Instance, o *InstanceSettingsStorageAutoResizeLimit) error {
+	return nil
+}
+func extractInstanceSettingsIPConfigurationFields(r *Instance, o *InstanceSettingsIPConfiguration) error {
+	return nil
+}
+func extractInstanceSettingsIPConfigurationAuthorizedNetworksFields(r *Instance, o *InstanceSettingsIPConfigurationAuthorizedNetworks) error {
+	return nil
+}
+func extractInstanceSettingsLocationPreferenceFields(r *Instance, o *InstanceSettingsLocationPreference) error {
+	return nil
+}
+func extractInstanceSettingsDatabaseFlagsFields(r *Instance, o *InstanceSettingsDatabaseFlags) error {
+	return nil
+}
+func extractInstanceSettingsMaintenanceWindowFields(r *Instance, o *InstanceSettingsMaintenanceWindow) error {
+	return nil
+}
+func extractInstanceSettingsBackupConfigurationFields(r *Instance, o *InstanceSettingsBackupConfiguration) error {
+	vBackupRetentionSettings := o.BackupRetentionSettings
+	if vBackupRetentionSettings == nil {
+		// note: explicitly not the empty object.
+		vBackupRetentionSettings = &InstanceSettingsBackupConfigurationBackupRetentionSettings{}
+	}
+	if err := extractInstanceSettingsBackupConfigurationBackupRetentionSettingsFields(r, vBackupRetentionSettings); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vBackupRetentionSettings) {
+		o.BackupRetentionSettings = vBackupRetentionSettings
+	}
+	return nil
+}
+func extractInstanceSettingsBackupConfigurationBackupRetentionSettingsFields(r *Instance, o *InstanceSettingsBackupConfigurationBackupRetentionSettings) error {
+	return nil
+}
+func extractInstanceSettingsDataDiskSizeGbFields(r *Instance, o *InstanceSettingsDataDiskSizeGb) error {
+	return nil
+}
+func extractInstanceSettingsActiveDirectoryConfigFields(r *Instance, o *InstanceSettingsActiveDirectoryConfig) error {
+	return nil
+}
+func extractInstanceSettingsDenyMaintenancePeriodsFields(r *Instance, o *InstanceSettingsDenyMaintenancePeriods) error {
+	return nil
+}
+func extractInstanceSettingsInsightsConfigFields(r *Instance, o *InstanceSettingsInsightsConfig) error {
+	return nil
+}
+func extractInstanceReplicaInstancesFields(r *Instance, o *InstanceReplicaInstances) error {
+	return nil
+}
+func extractInstanceServerCaCertFields(r *Instance, o *InstanceServerCaCert) error {
+	return nil
+}
+func extractInstanceOnPremisesConfigurationFields(r *Instance, o *InstanceOnPremisesConfiguration) error {
+	return nil
+}
+func extractInstanceDiskEncryptionStatusFields(r *Instance, o *InstanceDiskEncryptionStatus) error {
+	return nil
+}
+
+func postReadExtractInstanceFields(r *Instance) error {
+	vMaxDiskSize := r.MaxDiskSize
+	if vMaxDiskSize == nil {
+		// note: explicitly not the empty object.
+		vMaxDiskSize = &InstanceMaxDiskSize{}
+	}
+	if err := postReadExtractInstanceMaxDiskSizeFields(r, vMaxDiskSize); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMaxDiskSize) {
+		r.MaxDiskSize = vMaxDiskSize
+	}
+	vCurrentDiskSize := r.CurrentDiskSize
+	if vCurrentDiskSize == nil {
+		// note: explicitly not the empty object.
+		vCurrentDiskSize = &InstanceCurrentDiskSize{}
+	}
+	if err := postReadExtractInstanceCurrentDiskSizeFields(r, vCurrentDiskSize); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vCurrentDiskSize) {
+		r.CurrentDiskSize = vCurrentDiskSize
+	}
+	vDiskEncryptionConfiguration := r.DiskEncryptionConfiguration
+	if vDiskEncryptionConfiguration == nil {
+		// note: explicitly not the empty object.
+		vDiskEncryptionConfiguration = &InstanceDiskEncryptionConfiguration{}
+	}
+	if err := postReadExtractInstanceDiskEncryptionConfigurationFields(r, vDiskEncryptionConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vDiskEncryptionConfiguration) {
+		r.DiskEncryptionConfiguration = vDiskEncryptionConfiguration
+	}
+	vFailoverReplica := r.FailoverReplica
+	if vFailoverReplica == nil {
+		// note: explicitly not the empty object.
+		vFailoverReplica = &InstanceFailoverReplica{}
+	}
+	if err := postReadExtractInstanceFailoverReplicaFields(r, vFailoverReplica); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vFailoverReplica) {
+		r.FailoverReplica = vFailoverReplica
+	}
+	vMasterInstance := r.MasterInstance
+	if vMasterInstance == nil {
+		// note: explicitly not the empty object.
+		vMasterInstance = &InstanceMasterInstance{}
+	}
+	if err := postReadExtractInstanceMasterInstanceFields(r, vMasterInstance); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMasterInstance) {
+		r.MasterInstance = vMasterInstance
+	}
+	vReplicaConfiguration := r.ReplicaConfiguration
+	if vReplicaConfiguration == nil {
+		// note: explicitly not the empty object.
+		vReplicaConfiguration = &InstanceReplicaConfiguration{}
+	}
+	if err := postReadExtractInstanceReplicaConfigurationFields(r, vReplicaConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vReplicaConfiguration) {
+		r.ReplicaConfiguration = vReplicaConfiguration
+	}
+	vScheduledMaintenance := r.ScheduledMaintenance
+	if vScheduledMaintenance == nil {
+		// note: explicitly not the empty object.
+		vScheduledMaintenance = &InstanceScheduledMaintenance{}
+	}
+	if err := postReadExtractInstanceScheduledMaintenanceFields(r, vScheduledMaintenance); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vScheduledMaintenance) {
+		r.ScheduledMaintenance = vScheduledMaintenance
+	}
+	vSettings := r.Settings
+	if vSettings == nil {
+		// note: explicitly not the empty object.
+		vSettings = &InstanceSettings{}
+	}
+	if err := postReadExtractInstanceSettingsFields(r, vSettings); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vSettings) {
+		r.Settings = vSettings
+	}
+	vServerCaCert := r.ServerCaCert
+	if vServerCaCert == nil {
+		// note: explicitly not the empty object.
+		vServerCaCert = &InstanceServerCaCert{}
+	}
+	if err := postReadExtractInstanceServerCaCertFields(r, vServerCaCert); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vServerCaCert) {
+		r.ServerCaCert = vServerCaCert
+	}
+	vOnPremisesConfiguration := r.OnPremisesConfiguration
+	if vOnPremisesConfiguration == nil {
+		// note: explicitly not the empty object.
+		vOnPremisesConfiguration = &InstanceOnPremisesConfiguration{}
+	}
+	if err := postReadExtractInstanceOnPremisesConfigurationFields(r, vOnPremisesConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vOnPremisesConfiguration) {
+		r.OnPremisesConfiguration = vOnPremisesConfiguration
+	}
+	vDiskEncryptionStatus := r.DiskEncryptionStatus
+	if vDiskEncryptionStatus == nil {
+		// note: explicitly not the empty object.
+		vDiskEncryptionStatus = &InstanceDiskEncryptionStatus{}
+	}
+	if err := postReadExtractInstanceDiskEncryptionStatusFields(r, vDiskEncryptionStatus); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vDiskEncryptionStatus) {
+		r.DiskEncryptionStatus = vDiskEncryptionStatus
+	}
+	return nil
+}
+func postReadExtractInstanceMaxDiskSizeFields(r *Instance, o *InstanceMaxDiskSize) error {
+	return nil
+}
+func postReadExtractInstanceCurrentDiskSizeFields(r *Instance, o *InstanceCurrentDiskSize) error {
+	return nil
+}
+func postReadExtractInstanceDiskEncryptionConfigurationFields(r *Instance, o *InstanceDiskEncryptionConfiguration) error {
+	return nil
+}
+func postReadExtractInstanceFailoverReplicaFields(r *Instance, o *InstanceFailoverReplica) error {
+	vFailoverInstance := o.FailoverInstance
+	if vFailoverInstance == nil {
+		// note: explicitly not the empty object.
+		vFailoverInstance = &InstanceFailoverReplicaFailoverInstance{}
+	}
+	if err := postReadExtractInstanceFailoverReplicaFailoverInstanceFields(r, vFailoverInstance); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vFailoverInstance) {
+		o.FailoverInstance = vFailoverInstance
+	}
+	return nil
+}
+func postReadExtractInstanceFailoverReplicaFailoverInstanceFields(r *Instance, o *InstanceFailoverReplicaFailoverInstance) error {
+	return nil
+}
+func postReadExtractInstanceIPAddressesFields(r *Instance, o *InstanceIPAddresses) error {
+	vTimeToRetire := o.TimeToRetire
+	if vTimeToRetire == nil {
+		// note: explicitly not the empty object.
+		vTimeToRetire = &InstanceIPAddressesTimeToRetire{}
+	}
+	if err := postReadExtractInstanceIPAddressesTimeToRetireFields(r, vTimeToRetire); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vTimeToRetire) {
+		o.TimeToRetire = vTimeToRetire
+	}
+	return nil
+}
+func postReadExtractInstanceIPAddressesTimeToRetireFields(r *Instance, o *InstanceIPAddressesTimeToRetire) error {
+	return nil
+}
+func postReadExtractInstanceMasterInstanceFields(r *Instance, o *InstanceMasterInstance) error {
+	return nil
+}
+func postReadExtractInstanceReplicaConfigurationFields(r *Instance, o *InstanceReplicaConfiguration) error {
+	vMysqlReplicaConfiguration := o.MysqlReplicaConfiguration
+	if vMysqlReplicaConfiguration == nil {
+		// note: explicitly not the empty object.
+		vMysqlReplicaConfiguration = &InstanceReplicaConfigurationMysqlReplicaConfiguration{}
+	}
+	if err := postReadExtractInstanceReplicaConfigurationMysqlReplicaConfigurationFields(r, vMysqlReplicaConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMysqlReplicaConfiguration) {
+		o.MysqlReplicaConfiguration = vMysqlReplicaConfiguration
+	}
+	vReplicaPoolConfiguration := o.ReplicaPoolConfiguration
+	if vReplicaPoolConfiguration == nil {
+		// note: explicitly not the empty object.
+		vReplicaPoolConfiguration = &InstanceReplicaConfigurationReplicaPoolConfiguration{}
+	}
+	if err := postReadExtractInstanceReplicaConfigurationReplicaPoolConfigurationFields(r, vReplicaPoolConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vReplicaPoolConfiguration) {
+		o.ReplicaPoolConfiguration = vReplicaPoolConfiguration
+	}
+	return nil
+}
+func postReadExtractInstanceReplicaConfigurationMysqlReplicaConfigurationFields(r *Instance, o *InstanceReplicaConfigurationMysqlReplicaConfiguration) error {
+	vMasterHeartbeatPeriod := o.MasterHeartbeatPeriod
+	if vMasterHeartbeatPeriod == nil {
+		// note: explicitly not the empty object.
+		vMasterHeartbeatPeriod = &InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod{}
+	}
+	if err := postReadExtractInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodFields(r, vMasterHeartbeatPeriod); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMasterHeartbeatPeriod) {
+		o.MasterHeartbeatPeriod = vMasterHeartbeatPeriod
+	}
+	return nil
+}
+func postReadExtractInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriodFields(r *Instance, o *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod) error {
+	return nil
+}
+func postReadExtractInstanceReplicaConfigurationReplicaPoolConfigurationFields(r *Instance, o *InstanceReplicaConfigurationReplicaPoolConfiguration) error {
+	vStaticPoolConfiguration := o.StaticPoolConfiguration
+	if vStaticPoolConfiguration == nil {
+		// note: explicitly not the empty object.
+		vStaticPoolConfiguration = &InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration{}
+	}
+	if err := postReadExtractInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationFields(r, vStaticPoolConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vStaticPoolConfiguration) {
+		o.StaticPoolConfiguration = vStaticPoolConfiguration
+	}
+	vAutoscalingPoolConfiguration := o.AutoscalingPoolConfiguration
+	if vAutoscalingPoolConfiguration == nil {
+		// note: explicitly not the empty object.
+		vAutoscalingPoolConfiguration = &InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration{}
+	}
+	if err := postReadExtractInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationFields(r, vAutoscalingPoolConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vAutoscalingPoolConfiguration) {
+		o.AutoscalingPoolConfiguration = vAutoscalingPoolConfiguration
+	}
+	return nil
+}
+func postReadExtractInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfigurationFields(r *Instance, o *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration) error {
+	return nil
+}
+func postReadExtractInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfigurationFields(r *Instance, o *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration) error {
+	return nil
+}
+func postReadExtractInstanceScheduledMaintenanceFields(r *Instance, o *InstanceScheduledMaintenance) error {
+	vStartTime := o.StartTime
+	if vStartTime == nil {
+		// note: explicitly not the empty object.
+		vStartTime = &InstanceScheduledMaintenanceStartTime{}
+	}
+	if err := postReadExtractInstanceScheduledMaintenanceStartTimeFields(r, vStartTime); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vStartTime) {
+		o.StartTime = vStartTime
+	}
+	return nil
+}
+func postReadExtractInstanceScheduledMaintenanceStartTimeFields(r *Instance, o *InstanceScheduledMaintenanceStartTime) error {
+	return nil
+}
+func postReadExtractInstanceSettingsFields(r *Instance, o *InstanceSettings) error {
+	vSettingsVersion := o.SettingsVersion
+	if vSettingsVersion == nil {
+		// note: explicitly not the empty object.
+		vSettingsVersion = &InstanceSettingsSettingsVersion{}
+	}
+	if err := postReadExtractInstanceSettingsSettingsVersionFields(r, vSettingsVersion); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vSettingsVersion) {
+		o.SettingsVersion = vSettingsVersion
+	}
+	vStorageAutoResizeLimit := o.StorageAutoResizeLimit
+	if vStorageAutoResizeLimit == nil {
+		// note: explicitly not the empty object.
+		vStorageAutoResizeLimit = &InstanceSettingsStorageAutoResizeLimit{}
+	}
+	if err := postReadExtractInstanceSettingsStorageAutoResizeLimitFields(r, vStorageAutoResizeLimit); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vStorageAutoResizeLimit) {
+		o.StorageAutoResizeLimit = vStorageAutoResizeLimit
+	}
+	vIPConfiguration := o.IPConfiguration
+	if vIPConfiguration == nil {
+		// note: explicitly not the empty object.
+		vIPConfiguration = &InstanceSettingsIPConfiguration{}
+	}
+	if err := postReadExtractInstanceSettingsIPConfigurationFields(r, vIPConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vIPConfiguration) {
+		o.IPConfiguration = vIPConfiguration
+	}
+	vLocationPreference := o.LocationPreference
+	if vLocationPreference == nil {
+		// note: explicitly not the empty object.
+		vLocationPreference = &InstanceSettingsLocationPreference{}
+	}
+	if err := postReadExtractInstanceSettingsLocationPreferenceFields(r, vLocationPreference); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vLocationPreference) {
+		o.LocationPreference = vLocationPreference
+	}
+	vMaintenanceWindow := o.MaintenanceWindow
+	if vMaintenanceWindow == nil {
+		// note: explicitly not the empty object.
+		vMaintenanceWindow = &InstanceSettingsMaintenanceWindow{}
+	}
+	if err := postReadExtractInstanceSettingsMaintenanceWindowFields(r, vMaintenanceWindow); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vMaintenanceWindow) {
+		o.MaintenanceWindow = vMaintenanceWindow
+	}
+	vBackupConfiguration := o.BackupConfiguration
+	if vBackupConfiguration == nil {
+		// note: explicitly not the empty object.
+		vBackupConfiguration = &InstanceSettingsBackupConfiguration{}
+	}
+	if err := postReadExtractInstanceSettingsBackupConfigurationFields(r, vBackupConfiguration); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vBackupConfiguration) {
+		o.BackupConfiguration = vBackupConfiguration
+	}
+	vDataDiskSizeGb := o.DataDiskSizeGb
+	if vDataDiskSizeGb == nil {
+		// note: explicitly not the empty object.
+		vDataDiskSizeGb = &InstanceSettingsDataDiskSizeGb{}
+	}
+	if err := postReadExtractInstanceSettingsDataDiskSizeGbFields(r, vDataDiskSizeGb); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vDataDiskSizeGb) {
+		o.DataDiskSizeGb = vDataDiskSizeGb
+	}
+	vActiveDirectoryConfig := o.ActiveDirectoryConfig
+	if vActiveDirectoryConfig == nil {
+		// note: explicitly not the empty object.
+		vActiveDirectoryConfig = &InstanceSettingsActiveDirectoryConfig{}
+	}
+	if err := postReadExtractInstanceSettingsActiveDirectoryConfigFields(r, vActiveDirectoryConfig); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vActiveDirectoryConfig) {
+		o.ActiveDirectoryConfig = vActiveDirectoryConfig
+	}
+	vInsightsConfig := o.InsightsConfig
+	if vInsightsConfig == nil {
+		// note: explicitly not the empty object.
+		vInsightsConfig = &InstanceSettingsInsightsConfig{}
+	}
+	if err := postReadExtractInstanceSettingsInsightsConfigFields(r, vInsightsConfig); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vInsightsConfig) {
+		o.InsightsConfig = vInsightsConfig
+	}
+	return nil
+}
+func postReadExtractInstanceSettingsSettingsVersionFields(r *Instance, o *InstanceSettingsSettingsVersion) error {
+	return nil
+}
+func postReadExtractInstanceSettingsStorageAutoResizeLimitFields(r *Instance, o *InstanceSettingsStorageAutoResizeLimit) error {
+	return nil
+}
+func postReadExtractInstanceSettingsIPConfigurationFields(r *Instance, o *InstanceSettingsIPConfiguration) error {
+	return nil
+}
+func postReadExtractInstanceSettingsIPConfigurationAuthorizedNetworksFields(r *Instance, o *InstanceSettingsIPConfigurationAuthorizedNetworks) error {
+	return nil
+}
+func postReadExtractInstanceSettingsLocationPreferenceFields(r *Instance, o *InstanceSettingsLocationPreference) error {
+	return nil
+}
+func postReadExtractInstanceSettingsDatabaseFlagsFields(r *Instance, o *InstanceSettingsDatabaseFlags) error {
+	return nil
+}
+func postReadExtractInstanceSettingsMaintenanceWindowFields(r *Instance, o *InstanceSettingsMaintenanceWindow) error {
+	return nil
+}
+func postReadExtractInstanceSettingsBackupConfigurationFields(r *Instance, o *InstanceSettingsBackupConfiguration) error {
+	vBackupRetentionSettings := o.BackupRetentionSettings
+	if vBackupRetentionSettings == nil {
+		// note: explicitly not the empty object.
+		vBackupRetentionSettings = &InstanceSettingsBackupConfigurationBackupRetentionSettings{}
+	}
+	if err := postReadExtractInstanceSettingsBackupConfigurationBackupRetentionSettingsFields(r, vBackupRetentionSettings); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vBackupRetentionSettings) {
+		o.BackupRetentionSettings = vBackupRetentionSettings
+	}
+	return nil
+}
+func postReadExtractInstanceSettingsBackupConfigurationBackupRetentionSettingsFields(r *Instance, o *InstanceSettingsBackupConfigurationBackupRetentionSettings) error {
+	return nil
+}
+func postReadExtractInstanceSettingsDataDiskSizeGbFields(r *Instance, o *InstanceSettingsDataDiskSizeGb) error {
+	return nil
+}
+func postReadExtractInstanceSettingsActiveDirectoryConfigFields(r *Instance, o *InstanceSettingsActiveDirectoryConfig) error {
+	return nil
+}
+func postReadExtractInstanceSettingsDenyMaintenancePeriodsFields(r *Instance, o *InstanceSettingsDenyMaintenancePeriods) error {
+	return nil
+}
+func postReadExtractInstanceSettingsInsightsConfigFields(r *Instance, o *InstanceSettingsInsightsConfig) error {
+	return nil
+}
+func postReadExtractInstanceReplicaInstancesFields(r *Instance, o *InstanceReplicaInstances) error {
+	return nil
+}
+func postReadExtractInstanceServerCaCertFields(r *Instance, o *InstanceServerCaCert) error {
+	return nil
+}
+func postReadExtractInstanceOnPremisesConfigurationFields(r *Instance, o *InstanceOnPremisesConfiguration) error {
+	return nil
+}
+func postReadExtractInstanceDiskEncryptionStatusFields(r *Instance, o *InstanceDiskEncryptionStatus) error {
+	return nil
+}
@@ -0,0 +1,1274 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package beta
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLInstanceSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Sql/Instance",
+			Description: "The Sql Instance resource",
+			StructName:  "Instance",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "instance",
+						Required:    true,
+						Description: "A full instance of a Instance",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "instance",
+						Required:    true,
+						Description: "A full instance of a Instance",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "instance",
+						Required:    true,
+						Description: "A full instance of a Instance",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"Instance": &dcl.Component{
+					Title:           "Instance",
+					ID:              "projects/{{project}}/instances/{{name}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"name",
+							"project",
+						},
+						Properties: map[string]*dcl.Property{
+							"backendType": &dcl.Property{
+								Type:          "string",
+								GoName:        "BackendType",
+								GoType:        "InstanceBackendTypeEnum",
+								Description:   "The backend type. `SECOND_GEN`: Cloud SQL database instance. `EXTERNAL`: A database server that is not managed by Google. This property is read-only; use the `tier` property in the `settings` object to determine the database type. Possible values: SQL_BACKEND_TYPE_UNSPECIFIED, FIRST_GEN, SECOND_GEN, EXTERNAL",
+								ServerDefault: true,
+								Enum: []string{
+									"SQL_BACKEND_TYPE_UNSPECIFIED",
+									"FIRST_GEN",
+									"SECOND_GEN",
+									"EXTERNAL",
+								},
+							},
+							"connectionName": &dcl.Property{
+								Type:        "string",
+								GoName:      "ConnectionName",
+								ReadOnly:    true,
+								Description: "Output only. Connection name of the Cloud SQL instance used in connection strings.",
+								Immutable:   true,
+							},
+							"currentDiskSize": &dcl.Property{
+								Type:        "object",
+								GoName:      "CurrentDiskSize",
+								GoType:      "InstanceCurrentDiskSize",
+								ReadOnly:    true,
+								Description: "Output only. The current disk usage of the instance in bytes. This property has been deprecated. Use the \"cloudsql.googleapis.com/database/disk/bytes_used\" metric in Cloud Monitoring API instead.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"value": &dcl.Property{
+										Type:        "integer",
+										Format:      "int64",
+										GoName:      "Value",
+										ReadOnly:    true,
+										Description: "The value of the field.",
+										Immutable:   true,
+									},
+								},
+							},
+							"databaseVersion": &dcl.Property{
+								Type:        "string",
+								GoName:      "DatabaseVersion",
+								GoType:      "InstanceDatabaseVersionEnum",
+								Description: "The database engine type and version. The `databaseVersion` field cannot be changed after instance creation. Possible values: SQL_DATABASE_VERSION_UNSPECIFIED, MYSQL_5_6, MYSQL_5_7, MYSQL_8_0, SQLSERVER_2017_STANDARD, SQLSERVER_2017_ENTERPRISE, SQLSERVER_2017_EXPRESS, SQLSERVER_2017_WEB, POSTGRES_9_6, POSTGRES_10, POSTGRES_11, POSTGRES_12",
+								Immutable:   true,
+								Enum: []string{
+									"SQL_DATABASE_VERSION_UNSPECIFIED",
+									"MYSQL_5_6",
+									"MYSQL_5_7",
+									"MYSQL_8_0",
+									"SQLSERVER_2017_STANDARD",
+									"SQLSERVER_2017_ENTERPRISE",
+									"SQLSERVER_2017_EXPRESS",
+									"SQLSERVER_2017_WEB",
+									"POSTGRES_9_6",
+									"POSTGRES_10",
+									"POSTGRES_11",
+									"POSTGRES_12",
+								},
+							},
+							"diskEncryptionConfiguration": &dcl.Property{
+								Type:        "object",
+								GoName:      "DiskEncryptionConfiguration",
+								GoType:      "InstanceDiskEncryptionConfiguration",
+								Description: "Disk encryption configuration specific to an instance. Applies only to Second Generation instances.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"kind": &dcl.Property{
+										Type:        "string",
+										GoName:      "Kind",
+										Description: "This is always `sql#diskEncryptionConfiguration`.",
+										Immutable:   true,
+									},
+									"kmsKeyName": &dcl.Property{
+										Type:        "string",
+										GoName:      "KmsKeyName",
+										Description: "Resource name of KMS key for disk encryption",
+										Immutable:   true,
+									},
+								},
+							},
+							"diskEncryptionStatus": &dcl.Property{
+								Type:        "object",
+								GoName:      "DiskEncryptionStatus",
+								GoType:      "InstanceDiskEncryptionStatus",
+								ReadOnly:    true,
+								Description: "Output only. Disk encryption status specific to an instance. Applies only to Second Generation instances.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"kind": &dcl.Property{
+										Type:        "string",
+										GoName:      "Kind",
+										ReadOnly:    true,
+										Description: "This is always `sql#diskEncryptionStatus`.",
+										Immutable:   true,
+									},
+									"kmsKeyVersionName": &dcl.Property{
+										Type:        "string",
+										GoName:      "KmsKeyVersionName",
+										ReadOnly:    true,
+										Description: "KMS key version used to encrypt the Cloud SQL instance resource",
+										Immutable:   true,
+									},
+								},
+							},
+							"etag": &dcl.Property{
+								Type:        "string",
+								GoName:      "Etag",
+								ReadOnly:    true,
+								Description: "Output only. This field is deprecated and will be removed from a future version of the API. Use the `settings.settingsVersion` field instead.",
+								Immutable:   true,
+							},
+							"failoverReplica": &dcl.Property{
+								Type:        "object",
+								GoName:      "FailoverReplica",
+								GoType:      "InstanceFailoverReplica",
+								Description: "The name and status of the failover replica. This property is applicable only to Second Generation instances.",
+								Properties: map[string]*dcl.Property{
+									"available": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "Available",
+										ReadOnly:    true,
+										Description: "Output only. The availability status of the failover replica. A false status indicates that the failover replica is out of sync. The primary instance can only failover to the failover replica when the status is true.",
+										Immutable:   true,
+									},
+									"failoverInstance": &dcl.Property{
+										Type:        "object",
+										GoName:      "FailoverInstance",
+										GoType:      "InstanceFailoverReplicaFailoverInstance",
+										Description: "A reference to the failover replica. If specified at instance creation, a failover replica is created for the instance. Currently, the failover replica can only be created in the same region as the primary instance.",
+										Properties: map[string]*dcl.Property{
+											"name": &dcl.Property{
+												Type:        "string",
+												GoName:      "Name",
+												Description: "The name of the failover replica instance. This does not include the project ID.",
+											},
+											"region": &dcl.Property{
+												Type:        "string",
+												GoName:      "Region",
+												Description: "The region of the failover replica instance.",
+											},
+										},
+									},
+									"name": &dcl.Property{
+										Type:        "string",
+										GoName:      "Name",
+										Description: "The name of the failover replica. If specified at instance creation, a failover replica is created for the instance. The name doesn't include the project ID. This property is applicable only to Second Generation instances.",
+									},
+								},
+							},
+							"gceZone": &dcl.Property{
+								Type:        "string",
+								GoName:      "GceZone",
+								ReadOnly:    true,
+								Description: "Output only. The Compute Engine zone that the instance is currently serving from. This value could be different from the zone that was specified when the instance was created if the instance has failed over to its secondary zone.",
+								Immutable:   true,
+							},
+							"instanceType": &dcl.Property{
+								Type:          "string",
+								GoName:        "InstanceType",
+								GoType:        "InstanceInstanceTypeEnum",
+								Description:   "The instance type. This can be one of the following: `CLOUD_SQL_INSTANCE`: A Cloud SQL instance that is not replicating from a primary instance. `ON_PREMISES_INSTANCE`: An instance running on the customer's premises. `READ_REPLICA_INSTANCE`: A Cloud SQL instance configured as a read-replica. Possible values: SQL_INSTANCE_TYPE_UNSPECIFIED, CLOUD_SQL_INSTANCE, ON_PREMISES_INSTANCE, READ_REPLICA_INSTANCE, READ_REPLICA_POOL_INSTANCE",
+								Immutable:     true,
+								ServerDefault: true,
+								Enum: []string{
+									"SQL_INSTANCE_TYPE_UNSPECIFIED",
+									"CLOUD_SQL_INSTANCE",
+									"ON_PREMISES_INSTANCE",
+									"READ_REPLICA_INSTANCE",
+									"READ_REPLICA_POOL_INSTANCE",
+								},
+							},
+							"instanceUid": &dcl.Property{
+								Type:        "string",
+								GoName:      "InstanceUid",
+								ReadOnly:    true,
+								Description: "Output only. The unique identifier of the instance.",
+								Immutable:   true,
+							},
+							"ipAddresses": &dcl.Property{
+								Type:        "array",
+								GoName:      "IPAddresses",
+								ReadOnly:    true,
+								Description: "Output only. The assigned IP addresses for the instance.",
+								Immutable:   true,
+								SendEmpty:   true,
+								ListType:    "list",
+								Items: &dcl.Property{
+									Type:   "object",
+									GoType: "InstanceIPAddresses",
+									Properties: map[string]*dcl.Property{
+										"ipAddress": &dcl.Property{
+											Type:        "string",
+											GoName:      "IPAddress",
+											ReadOnly:    true,
+											Description: "The IP address assigned.",
+											Immutable:   true,
+										},
+										"timeToRetire": &dcl.Property{
+											Type:        "object",
+											GoName:      "TimeToRetire",
+											GoType:      "InstanceIPAddressesTimeToRetire",
+											ReadOnly:    true,
+											Description: "The due time for this IP to be retired. This field is only available when the IP is scheduled to be retired.",
+											Immutable:   true,
+											Properties: map[string]*dcl.Property{
+												"nanos": &dcl.Property{
+													Type:        "integer",
+													Format:      "int64",
+													GoName:      "Nanos",
+													ReadOnly:    true,
+													Description: "Non-negative fractions of a second at nanosecond resolution. Negative second values with fractions must still have non-negative nanos values that count forward in time. Must be from 0 to 999,999,999 inclusive.",
+													Immutable:   true,
+												},
+												"seconds": &dcl.Property{
+													Type:        "integer",
+													Format:      "int64",
+													GoName:      "Seconds",
+													ReadOnly:    true,
+													Description: "Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.",
+													Immutable:   true,
+												},
+											},
+										},
+										"type": &dcl.Property{
+											Type:        "string",
+											GoName:      "Type",
+											GoType:      "InstanceIPAddressesTypeEnum",
+											ReadOnly:    true,
+											Description: "The type of this IP address. A `PRIMARY` address is a public address that can accept incoming connections. A `PRIVATE` address is a private address that can accept incoming connections. An `OUTGOING` address is the source address of connections originating from the instance, if supported. Possible values: SQL_IP_ADDRESS_TYPE_UNSPECIFIED, PRIMARY, OUTGOING, PRIVATE, MIGRATED_1ST_GEN",
+											Immutable:   true,
+											Enum: []string{
+												"SQL_IP_ADDRESS_TYPE_UNSPECIFIED",
+												"PRIMARY",
+												"OUTGOING",
+												"PRIVATE",
+												"MIGRATED_1ST_GEN",
+											},
+										},
+									},
+								},
+							},
+							"ipv6Address": &dcl.Property{
+								Type:        "string",
+								GoName:      "IPv6Address",
+								ReadOnly:    true,
+								Description: "Output only. The IPv6 address assigned to the instance. (Deprecated) This property was applicable only to First Generation instances.",
+								Immutable:   true,
+							},
+							"masterInstance": &dcl.Property{
+								Type:        "object",
+								GoName:      "MasterInstance",
+								GoType:      "InstanceMasterInstance",
+								Description: "The instance which will act as primary in the replication setup.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"name": &dcl.Property{
+										Type:        "string",
+										GoName:      "Name",
+										Description: "The name of the primary instance. This does not include the project ID.",
+										Immutable:   true,
+									},
+									"region": &dcl.Property{
+										Type:        "string",
+										GoName:      "Region",
+										Description: "The region of the primary instance.",
+										Immutable:   true,
+									},
+								},
+							},
+							"masterInstanceName": &dcl.Property{
+								Type:        "string",
+								GoName:      "MasterInstanceName",
+								Description: "The name of the instance which will act as primary in the replication setup.",
+								Immutable:   true,
+							},
+							"maxDiskSize": &dcl.Property{
+								Type:        "object",
+								GoName:      "MaxDiskSize",
+								GoType:      "InstanceMaxDiskSize",
+								Description: "The maximum disk size of the instance in bytes.",
+								Properties: map[string]*dcl.Property{
+									"value": &dcl.Property{
+										Type:        "integer",
+										Format:      "int64",
+										GoName:      "Value",
+										Description: "The value of the field.",
+									},
+								},
+							},
+							"name": &dcl.Property{
+								Type:        "string",
+								GoName:      "Name",
+								Description: "Name of the Cloud SQL instance. This does not include the project ID.",
+								Immutable:   true,
+							},
+							"onPremisesConfiguration": &dcl.Property{
+								Type:        "object",
+								GoName:      "OnPremisesConfiguration",
+								GoType:      "InstanceOnPremisesConfiguration",
+								Description: "Configuration specific to on-premises instances.",
+								Properties: map[string]*dcl.Property{
+									"caCertificate": &dcl.Property{
+										Type:        "string",
+										GoName:      "CaCertificate",
+										Description: "PEM representation of the trusted CA's x509 certificate.",
+									},
+									"clientCertificate": &dcl.Property{
+										Type:        "string",
+										GoName:      "ClientCertificate",
+										Description: "PEM representation of the replica's x509 certificate.",
+									},
+									"clientKey": &dcl.Property{
+										Type:        "string",
+										GoName:      "ClientKey",
+										Description: "PEM representation of the replica's private key. The corresponding public key is encoded in the client's certificate.",
+										Sensitive:   true,
+										Unreadable:  true,
+									},
+									"database": &dcl.Property{
+										Type:        "string",
+										GoName:      "Database",
+										Description: "The database to replicate from the on-premises instance.",
+									},
+									"dumpFilePath": &dcl.Property{
+										Type:        "string",
+										GoName:      "DumpFilePath",
+										Description: "The dump file to create the Cloud SQL replica.",
+									},
+									"hostPort": &dcl.Property{
+										Type:        "string",
+										GoName:      "HostPort",
+										Description: "The host and port of the on-premises instance in host:port format",
+									},
+									"kind": &dcl.Property{
+										Type:        "string",
+										GoName:      "Kind",
+										Description: "This is always `sql#onPremisesConfiguration`.",
+									},
+									"password": &dcl.Property{
+										Type:        "string",
+										GoName:      "Password",
+										Description: "The password for connecting to on-premises instance.",
+										Sensitive:   true,
+										Unreadable:  true,
+									},
+									"replicatedDatabases": &dcl.Property{
+										Type:        "array",
+										GoName:      "ReplicatedDatabases",
+										Description: "The list of databases to replicate from the on-premises instance.",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "string",
+											GoType: "string",
+										},
+									},
+									"username": &dcl.Property{
+										Type:        "string",
+										GoName:      "Username",
+										Description: "The username for connecting to on-premises instance.",
+									},
+								},
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "The project for the resource",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"region": &dcl.Property{
+								Type:          "string",
+								GoName:        "Region",
+								Description:   "The geographical region. Defaults to `us-central` or `us-central1` depending on the instance type (First Generation or Second Generation). The region cannot be changed after instance creation.",
+								Immutable:     true,
+								ServerDefault: true,
+							},
+							"replicaConfiguration": &dcl.Property{
+								Type:        "object",
+								GoName:      "ReplicaConfiguration",
+								GoType:      "InstanceReplicaConfiguration",
+								Description: "Configuration specific to failover replicas and read replicas.",
+								Properties: map[string]*dcl.Property{
+									"failoverTarget": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "FailoverTarget",
+										Description: "Specifies if the replica is the failover target. If the field is set to `true` the replica will be designated as a failover replica. In case the primary instance fails, the replica instance will be promoted as the new primary instance. Only one replica can be specified as failover target, and the replica has to be in different zone with the primary instance.",
+									},
+									"kind": &dcl.Property{
+										Type:        "string",
+										GoName:      "Kind",
+										Description: "This is always `sql#replicaConfiguration`.",
+									},
+									"mysqlReplicaConfiguration": &dcl.Property{
+										Type:        "object",
+										GoName:      "MysqlReplicaConfiguration",
+										GoType:      "InstanceReplicaConfigurationMysqlReplicaConfiguration",
+										Description: "MySQL specific configuration when replicating from a MySQL on-premises primary instance. Replication configuration information such as the username, password, certificates, and keys are not stored in the instance metadata. The configuration information is used only to set up the replication connection and is stored by MySQL in a file named `master.info` in the data directory.",
+										Properties: map[string]*dcl.Property{
+											"caCertificate": &dcl.Property{
+												Type:        "string",
+												GoName:      "CaCertificate",
+												Description: "PEM representation of the trusted CA's x509 certificate.",
+											},
+											"clientCertificate": &dcl.Property{
+												Type:        "string",
+												GoName:      "ClientCertificate",
+												Description: "PEM representation of the replica's x509 certificate.",
+											},
+											"clientKey": &dcl.Property{
+												Type:        "string",
+												GoName:      "ClientKey",
+												Description: "PEM representation of the replica's private key. The corresponding public key is encoded in the client's certificate.",
+												Sensitive:   true,
+												Unreadable:  true,
+											},
+											"connectRetryInterval": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "ConnectRetryInterval",
+												Description: "Seconds to wait between connect retries. MySQL's default is 60 seconds.",
+											},
+											"dumpFilePath": &dcl.Property{
+												Type:        "string",
+												GoName:      "DumpFilePath",
+												Description: "Path to a SQL dump file in Google Cloud Storage from which the replica instance is to be created. The URI is in the form gs://bucketName/fileName. Compressed gzip files (.gz) are also supported. Dumps have the binlog co-ordinates from which replication begins. This can be accomplished by setting --master-data to 1 when using mysqldump.",
+											},
+											"kind": &dcl.Property{
+												Type:        "string",
+												GoName:      "Kind",
+												Description: "This is always `sql#mysqlReplicaConfiguration`.",
+											},
+											"masterHeartbeatPeriod": &dcl.Property{
+												Type:        "object",
+												GoName:      "MasterHeartbeatPeriod",
+												GoType:      "InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod",
+												Description: "Interval in milliseconds between replication heartbeats.",
+												Properties: map[string]*dcl.Property{
+													"value": &dcl.Property{
+														Type:        "integer",
+														Format:      "int64",
+														GoName:      "Value",
+														Description: "The value of the field.",
+													},
+												},
+											},
+											"password": &dcl.Property{
+												Type:        "string",
+												GoName:      "Password",
+												Description: "The password for the replication connection.",
+												Sensitive:   true,
+												Unreadable:  true,
+											},
+											"sslCipher": &dcl.Property{
+												Type:        "string",
+												GoName:      "SslCipher",
+												Description: "A list of permissible ciphers to use for SSL encryption.",
+											},
+											"username": &dcl.Property{
+												Type:        "string",
+												GoName:      "Username",
+												Description: "The username for the replication connection.",
+											},
+											"verifyServerCertificate": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "VerifyServerCertificate",
+												Description: "Whether or not to check the primary instance's Common Name value in the certificate that it sends during the SSL handshake.",
+											},
+										},
+									},
+									"replicaPoolConfiguration": &dcl.Property{
+										Type:        "object",
+										GoName:      "ReplicaPoolConfiguration",
+										GoType:      "InstanceReplicaConfigurationReplicaPoolConfiguration",
+										Description: "Configuration specific to read replica pools.",
+										Properties: map[string]*dcl.Property{
+											"autoscalingPoolConfiguration": &dcl.Property{
+												Type:        "object",
+												GoName:      "AutoscalingPoolConfiguration",
+												GoType:      "InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration",
+												Description: "Configuration for an autoscaling pool of read replicas.",
+												Properties: map[string]*dcl.Property{
+													"kind": &dcl.Property{
+														Type:        "string",
+														GoName:      "Kind",
+														Description: "This is always `sql#autoscalingPoolConfiguration`.",
+													},
+													"maxReplicaCount": &dcl.Property{
+														Type:        "integer",
+														Format:      "int64",
+														GoName:      "MaxReplicaCount",
+														Description: "The maximum number of read replicas in the pool.",
+													},
+													"minReplicaCount": &dcl.Property{
+														Type:        "integer",
+														Format:      "int64",
+														GoName:      "MinReplicaCount",
+														Description: "The minimum number of read replicas in the pool.",
+													},
+													"targetCpuUtil": &dcl.Property{
+														Type:        "number",
+														Format:      "double",
+														GoName:      "TargetCpuUtil",
+														Description: "The target CPU utilization of the pool, as a fraction between 0 and 1.",
+													},
+												},
+											},
+											"exposeReplicaIp": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "ExposeReplicaIP",
+												Description: "Whether the IP addresses of the individual read replicas are exposed.",
+											},
+											"kind": &dcl.Property{
+												Type:        "string",
+												GoName:      "Kind",
+												Description: "This is always `sql#replicaPoolConfiguration`.",
+											},
+											"replicaCount": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "ReplicaCount",
+												Description: "The number of read replicas in the pool.",
+											},
+											"staticPoolConfiguration": &dcl.Property{
+												Type:        "object",
+												GoName:      "StaticPoolConfiguration",
+												GoType:      "InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration",
+												Description: "Configuration for a static pool of read replicas.",
+												Properties: map[string]*dcl.Property{
+													"exposeReplicaIp": &dcl.Property{
+														Type:        "boolean",
+														GoName:      "ExposeReplicaIP",
+														Description: "Whether the IP addresses of the individual read replicas are exposed.",
+													},
+													"kind": &dcl.Property{
+														Type:        "string",
+														GoName:      "Kind",
+														Description: "This is always `sql#staticPoolConfiguration`.",
+													},
+													"replicaCount": &dcl.Property{
+														Type:        "integer",
+														Format:      "int64",
+														GoName:      "ReplicaCount",
+														Description: "The number of read replicas in the pool.",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"replicaInstances": &dcl.Property{
+								Type:        "array",
+								GoName:      "ReplicaInstances",
+								ReadOnly:    true,
+								Description: "Output only. The replicas of the instance.",
+								Immutable:   true,
+								SendEmpty:   true,
+								ListType:    "list",
+								Items: &dcl.Property{
+									Type:   "object",
+									GoType: "InstanceReplicaInstances",
+									Properties: map[string]*dcl.Property{
+										"name": &dcl.Property{
+											Type:        "string",
+											GoName:      "Name",
+											ReadOnly:    true,
+											Description: "The name of the replica instance. This does not include the project ID.",
+											Immutable:   true,
+										},
+										"region": &dcl.Property{
+											Type:        "string",
+											GoName:      "Region",
+											ReadOnly:    true,
+											Description: "The region of the replica instance.",
+											Immutable:   true,
+										},
+									},
+								},
+							},
+							"rootPassword": &dcl.Property{
+								Type:        "string",
+								GoName:      "RootPassword",
+								Description: "Initial root password. Use only on creation.",
+								Immutable:   true,
+								Sensitive:   true,
+								Unreadable:  true,
+							},
+							"scheduledMaintenance": &dcl.Property{
+								Type:        "object",
+								GoName:      "ScheduledMaintenance",
+								GoType:      "InstanceScheduledMaintenance",
+								ReadOnly:    true,
+								Description: "Output only. The start time of any upcoming scheduled maintenance for this instance.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"canDefer": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "CanDefer",
+										ReadOnly:    true,
+										Description: "Whether the scheduled maintenance can be deferred.",
+										Immutable:   true,
+									},
+									"canReschedule": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "CanReschedule",
+										ReadOnly:    true,
+										Description: "Whether the scheduled maintenance can be rescheduled.",
+										Immutable:   true,
+									},
+									"startTime": &dcl.Property{
+										Type:        "object",
+										GoName:      "StartTime",
+										GoType:      "InstanceScheduledMaintenanceStartTime",
+										ReadOnly:    true,
+										Description: "The start time of any upcoming scheduled maintenance for this instance.",
+										Immutable:   true,
+										Properties: map[string]*dcl.Property{
+											"nanos": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "Nanos",
+												ReadOnly:    true,
+												Description: "Non-negative fractions of a second at nanosecond resolution. Negative second values with fractions must still have non-negative nanos values that count forward in time. Must be from 0 to 999,999,999 inclusive.",
+												Immutable:   true,
+											},
+											"seconds": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "Seconds",
+												ReadOnly:    true,
+												Description: "Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.",
+												Immutable:   true,
+											},
+										},
+									},
+								},
+							},
+							"serverCaCert": &dcl.Property{
+								Type:        "object",
+								GoName:      "ServerCaCert",
+								GoType:      "InstanceServerCaCert",
+								ReadOnly:    true,
+								Description: "Output only. SSL configuration.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"cert": &dcl.Property{
+										Type:        "string",
+										GoName:      "Cert",
+										ReadOnly:    true,
+										Description: "PEM representation.",
+										Immutable:   true,
+									},
+									"certSerialNumber": &dcl.Property{
+										Type:        "string",
+										GoName:      "CertSerialNumber",
+										ReadOnly:    true,
+										Description: "Serial number, as extracted from the certificate.",
+										Immutable:   true,
+									},
+									"commonName": &dcl.Property{
+										Type:        "string",
+										GoName:      "CommonName",
+										ReadOnly:    true,
+										Description: "User supplied name. Constrained to [a-zA-Z.-_ ]+.",
+										Immutable:   true,
+									},
+									"createTime": &dcl.Property{
+										Type:        "string",
+										GoName:      "CreateTime",
+										ReadOnly:    true,
+										Description: "The time when the certificate was created in RFC 3339 format, for example `2012-11-15T16:19:00.094Z`.",
+										Immutable:   true,
+									},
+									"expirationTime": &dcl.Property{
+										Type:        "string",
+										GoName:      "ExpirationTime",
+										ReadOnly:    true,
+										Description: "The time when the certificate expires in RFC 3339 format, for example `2012-11-15T16:19:00.094Z`.",
+										Immutable:   true,
+									},
+									"instance": &dcl.Property{
+										Type:        "string",
+										GoName:      "Instance",
+										ReadOnly:    true,
+										Description: "Name of the database instance.",
+										Immutable:   true,
+									},
+									"kind": &dcl.Property{
+										Type:        "string",
+										GoName:      "Kind",
+										ReadOnly:    true,
+										Description: "This is always `sql#sslCert`.",
+										Immutable:   true,
+									},
+									"sha1Fingerprint": &dcl.Property{
+										Type:        "string",
+										GoName:      "Sha1Fingerprint",
+										ReadOnly:    true,
+										Description: "Sha1 Fingerprint.",
+										Immutable:   true,
+									},
+								},
+							},
+							"serviceAccountEmailAddress": &dcl.Property{
+								Type:        "string",
+								GoName:      "ServiceAccountEmailAddress",
+								ReadOnly:    true,
+								Description: "Output only. The service account email address assigned to the instance. This property is applicable only to Second Generation instances.",
+								Immutable:   true,
+							},
+							"settings": &dcl.Property{
+								Type:        "object",
+								GoName:      "Settings",
+								GoType:      "InstanceSettings",
+								Description: "The user settings.",
+								Required: []string{
+									"tier",
+								},
+								Properties: map[string]*dcl.Property{
+									"activationPolicy": &dcl.Property{
+										Type:        "string",
+										GoName:      "ActivationPolicy",
+										GoType:      "InstanceSettingsActivationPolicyEnum",
+										Description: "The activation policy specifies when the instance is activated; it is applicable only when the instance state is `RUNNABLE`. Valid values: `ALWAYS`: The instance is on, and remains so even in the absence of connection requests. `NEVER`: The instance is off; it is not activated, even if a connection request arrives. Possible values: SQL_ACTIVATION_POLICY_UNSPECIFIED, ALWAYS, NEVER, ON_DEMAND",
+										Enum: []string{
+											"SQL_ACTIVATION_POLICY_UNSPECIFIED",
+											"ALWAYS",
+											"NEVER",
+											"ON_DEMAND",
+										},
+									},
+									"activeDirectoryConfig": &dcl.Property{
+										Type:        "object",
+										GoName:      "ActiveDirectoryConfig",
+										GoType:      "InstanceSettingsActiveDirectoryConfig",
+										Description: "Active Directory configuration, relevant only for Cloud SQL for SQL Server.",
+										Properties: map[string]*dcl.Property{
+											"domain": &dcl.Property{
+												Type:        "string",
+												GoName:      "Domain",
+												Description: "The name of the domain (e.g., mydomain.com).",
+											},
+											"kind": &dcl.Property{
+												Type:        "string",
+												GoName:      "Kind",
+												Description: "This is always sql#activeDirectoryConfig.",
+											},
+										},
+									},
+									"authorizedGaeApplications": &dcl.Property{
+										Type:        "array",
+										GoName:      "AuthorizedGaeApplications",
+										Description: "The App Engine app IDs that can access this instance. (Deprecated) Applied to First Generation instances only.",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "string",
+											GoType: "string",
+										},
+									},
+									"availabilityType": &dcl.Property{
+										Type:        "string",
+										GoName:      "AvailabilityType",
+										GoType:      "InstanceSettingsAvailabilityTypeEnum",
+										Description: "Availability type. Potential values: `ZONAL`: The instance serves data from only one zone. Outages in that zone affect data accessibility. `REGIONAL`: The instance can serve data from more than one zone in a region (it is highly available). For more information, see [Overview of the High Availability Configuration](https://cloud.google.com/sql/docs/mysql/high-availability). Possible values: SQL_AVAILABILITY_TYPE_UNSPECIFIED, ZONAL, REGIONAL",
+										Enum: []string{
+											"SQL_AVAILABILITY_TYPE_UNSPECIFIED",
+											"ZONAL",
+											"REGIONAL",
+										},
+									},
+									"backupConfiguration": &dcl.Property{
+										Type:        "object",
+										GoName:      "BackupConfiguration",
+										GoType:      "InstanceSettingsBackupConfiguration",
+										Description: "The daily backup configuration for the instance.",
+										Properties: map[string]*dcl.Property{
+											"backupRetentionSettings": &dcl.Property{
+												Type:        "object",
+												GoName:      "BackupRetentionSettings",
+												GoType:      "InstanceSettingsBackupConfigurationBackupRetentionSettings",
+												Description: "Backup retention settings.",
+												Properties: map[string]*dcl.Property{
+													"retainedBackups": &dcl.Property{
+														Type:        "integer",
+														Format:      "int64",
+														GoName:      "RetainedBackups",
+														Description: "Depending on the value of retention_unit, this is used to determine if a backup needs to be deleted. If retention_unit is 'COUNT', we will retain this many backups.",
+													},
+													"retentionUnit": &dcl.Property{
+														Type:        "string",
+														GoName:      "RetentionUnit",
+														GoType:      "InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnum",
+														Description: "The unit that 'retained_backups' represents. Possible values: RETENTION_UNIT_UNSPECIFIED, COUNT",
+														Enum: []string{
+															"RETENTION_UNIT_UNSPECIFIED",
+															"COUNT",
+														},
+													},
+												},
+											},
+											"binaryLogEnabled": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "BinaryLogEnabled",
+												Description: "(MySQL only) Whether binary log is enabled. If backup configuration is disabled, binarylog must be disabled as well.",
+											},
+											"enabled": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "Enabled",
+												Description: "Whether this configuration is enabled.",
+											},
+											"kind": &dcl.Property{
+												Type:        "string",
+												GoName:      "Kind",
+												Description: "This is always `sql#backupConfiguration`.",
+											},
+											"location": &dcl.Property{
+												Type:        "string",
+												GoName:      "Location",
+												Description: "Location of the backup",
+											},
+											"startTime": &dcl.Property{
+												Type:        "string",
+												GoName:      "StartTime",
+												Description: "Start time for the daily backup configuration in UTC timezone in the 24 hour format - `HH:MM`.",
+											},
+											"transactionLogRetentionDays": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "TransactionLogRetentionDays",
+												Description: "The number of days of transaction logs we retain for point in time restore, from 1-7.",
+											},
+										},
+									},
+									"collation": &dcl.Property{
+										Type:        "string",
+										GoName:      "Collation",
+										Description: "The name of server Instance collation.",
+									},
+									"crashSafeReplicationEnabled": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "CrashSafeReplicationEnabled",
+										Description: "Configuration specific to read replica instances. Indicates whether database flags for crash-safe replication are enabled. This property was only applicable to First Generation instances.",
+									},
+									"dataDiskSizeGb": &dcl.Property{
+										Type:        "object",
+										GoName:      "DataDiskSizeGb",
+										GoType:      "InstanceSettingsDataDiskSizeGb",
+										Description: "The size of data disk, in GB. The data disk size minimum is 10GB.",
+										Properties: map[string]*dcl.Property{
+											"value": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "Value",
+												Description: "The value of the field.",
+											},
+										},
+									},
+									"dataDiskType": &dcl.Property{
+										Type:        "string",
+										GoName:      "DataDiskType",
+										GoType:      "InstanceSettingsDataDiskTypeEnum",
+										Description: "The type of data disk: `PD_SSD` (default) or `PD_HDD`. Not used for First Generation instances. Possible values: SQL_DATA_DISK_TYPE_UNSPECIFIED, PD_SSD, PD_HDD, OBSOLETE_LOCAL_SSD",
+										Enum: []string{
+											"SQL_DATA_DISK_TYPE_UNSPECIFIED",
+											"PD_SSD",
+											"PD_HDD",
+											"OBSOLETE_LOCAL_SSD",
+										},
+									},
+									"databaseFlags": &dcl.Property{
+										Type:        "array",
+										GoName:      "DatabaseFlags",
+										Description: "The database flags passed to the instance at startup.",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "object",
+											GoType: "InstanceSettingsDatabaseFlags",
+											Properties: map[string]*dcl.Property{
+												"name": &dcl.Property{
+													Type:        "string",
+													GoName:      "Name",
+													Description: "The name of the flag. These flags are passed at instance startup, so include both server options and system variables. Flags are specified with underscores, not hyphens. For more information, see [Configuring Database Flags](https://cloud.google.com/sql/docs/mysql/flags) in the Cloud SQL documentation.",
+												},
+												"value": &dcl.Property{
+													Type:        "string",
+													GoName:      "Value",
+													Description: "The value of the flag. Boolean flags are set to `on` for true and `off` for false. This field must be omitted if the flag doesn't take a value.",
+												},
+											},
+										},
+									},
+									"databaseReplicationEnabled": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "DatabaseReplicationEnabled",
+										Description: "Configuration specific to read replica instances. Indicates whether replication is enabled or not.",
+									},
+									"denyMaintenancePeriods": &dcl.Property{
+										Type:        "array",
+										GoName:      "DenyMaintenancePeriods",
+										Description: "Deny maintenance periods",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "object",
+											GoType: "InstanceSettingsDenyMaintenancePeriods",
+											Properties: map[string]*dcl.Property{
+												"endDate": &dcl.Property{
+													Type:        "string",
+													GoName:      "EndDate",
+													Description: "\"deny maintenance period\" end date. If the year of the end date is empty, the year of the start date also must be empty. In this case, it means the deny maintenance period recurs every year. The date is in format yyyy-mm-dd i.e., 2020-11-01, or mm-dd, i.e., 11-01",
+												},
+												"startDate": &dcl.Property{
+													Type:        "string",
+													GoName:      "StartDate",
+													Description: "\"deny maintenance period\" start date. If the year of the start date is empty, the year of the end date also must be empty. In this case, it means the deny maintenance period recurs every year. The date is in format yyyy-mm-dd i.e., 2020-11-01, or mm-dd, i.e., 11-01",
+												},
+												"time": &dcl.Property{
+													Type:        "string",
+													GoName:      "Time",
+													Description: "Time in UTC when the \"deny maintenance period\" starts on start_date and ends on end_date. The time is in format: HH:mm:SS, i.e., 00:00:00",
+												},
+											},
+										},
+									},
+									"insightsConfig": &dcl.Property{
+										Type:        "object",
+										GoName:      "InsightsConfig",
+										GoType:      "InstanceSettingsInsightsConfig",
+										Description: "Insights configuration, for now relevant only for Postgres.",
+										Properties: map[string]*dcl.Property{
+											"queryInsightsEnabled": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "QueryInsightsEnabled",
+												Description: "Whether Query Insights feature is enabled.",
+											},
+											"queryStringLength": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "QueryStringLength",
+												Description: "Maximum query length stored in bytes. Default value: 1024 bytes. Range: 256-4500 bytes. Query length more than this field value will be truncated to this value. When unset, query length will be the default value. Changing query length will restart the database.",
+											},
+											"recordApplicationTags": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "RecordApplicationTags",
+												Description: "Whether Query Insights will record application tags from query when enabled.",
+											},
+											"recordClientAddress": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "RecordClientAddress",
+												Description: "Whether Query Insights will record client address when enabled.",
+											},
+										},
+									},
+									"ipConfiguration": &dcl.Property{
+										Type:        "object",
+										GoName:      "IPConfiguration",
+										GoType:      "InstanceSettingsIPConfiguration",
+										Description: "The settings for IP Management. This allows to enable or disable the instance IP and manage which external networks can connect to the instance. The IPv4 address cannot be disabled for Second Generation instances.",
+										Properties: map[string]*dcl.Property{
+											"authorizedNetworks": &dcl.Property{
+												Type:        "array",
+												GoName:      "AuthorizedNetworks",
+												Description: "The list of external networks that are allowed to connect to the instance using the IP. In 'CIDR' notation, also known as 'slash' notation (for example: `192.168.100.0/24`).",
+												SendEmpty:   true,
+												ListType:    "list",
+												Items: &dcl.Property{
+													Type:   "object",
+													GoType: "InstanceSettingsIPConfigurationAuthorizedNetworks",
+													Properties: map[string]*dcl.Property{
+														"expirationTime": &dcl.Property{
+															Type:        "string",
+															GoName:      "ExpirationTime",
+															Description: "The time when this access control entry expires in RFC 3339 format, for example `2012-11-15T16:19:00.094Z`.",
+														},
+														"kind": &dcl.Property{
+															Type:        "string",
+															GoName:      "Kind",
+															Description: "This is always `sql#aclEntry`.",
+														},
+														"name": &dcl.Property{
+															Type:        "string",
+															GoName:      "Name",
+															Description: "Optional. A label to identify this entry.",
+														},
+														"value": &dcl.Property{
+															Type:        "string",
+															GoName:      "Value",
+															Description: "The allowlisted value for the access control list.",
+														},
+													},
+												},
+											},
+											"ipv4Enabled": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "IPv4Enabled",
+												Description: "Whether the instance is assigned a public IP address or not.",
+											},
+											"privateNetwork": &dcl.Property{
+												Type:        "string",
+												GoName:      "PrivateNetwork",
+												Description: "The resource link for the VPC network from which the Cloud SQL instance is accessible for private IP. For example, `/projects/myProject/global/networks/default`. This setting can be updated, but it cannot be removed after it is set.",
+											},
+											"requireSsl": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "RequireSsl",
+												Description: "Whether SSL connections over IP are enforced or not.",
+											},
+										},
+									},
+									"kind": &dcl.Property{
+										Type:        "string",
+										GoName:      "Kind",
+										Description: "This is always `sql#settings`.",
+									},
+									"locationPreference": &dcl.Property{
+										Type:        "object",
+										GoName:      "LocationPreference",
+										GoType:      "InstanceSettingsLocationPreference",
+										Description: "The location preference settings. This allows the instance to be located as near as possible to either an App Engine app or Compute Engine zone for better performance. App Engine co-location was only applicable to First Generation instances.",
+										Properties: map[string]*dcl.Property{
+											"kind": &dcl.Property{
+												Type:        "string",
+												GoName:      "Kind",
+												Description: "This is always `sql#locationPreference`.",
+											},
+											"zone": &dcl.Property{
+												Type:        "string",
+												GoName:      "Zone",
+												Description: "The preferred Compute Engine zone (for example: us-central1-a, us-central1-b, etc.).",
+											},
+										},
+									},
+									"maintenanceWindow": &dcl.Property{
+										Type:        "object",
+										GoName:      "MaintenanceWindow",
+										GoType:      "InstanceSettingsMaintenanceWindow",
+										Description: "The maintenance window for this instance. This specifies when the instance can be restarted for maintenance purposes.",
+										Properties: map[string]*dcl.Property{
+											"day": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "Day",
+												Description: "day of week (1-7), starting on Monday.",
+											},
+											"hour": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "Hour",
+												Description: "hour of day - 0 to 23.",
+											},
+											"kind": &dcl.Property{
+												Type:        "string",
+												GoName:      "Kind",
+												Description: "This is always `sql#maintenanceWindow`.",
+											},
+											"updateTrack": &dcl.Property{
+												Type:        "string",
+												GoName:      "UpdateTrack",
+												GoType:      "InstanceSettingsMaintenanceWindowUpdateTrackEnum",
+												Description: "Maintenance timing setting: `canary` (Earlier) or `stable` (Later). [Learn more](https://cloud.google.com/sql/docs/mysql/instance-settings#maintenance-timing-2ndgen). Possible values: SQL_UPDATE_TRACK_UNSPECIFIED, canary, stable",
+												Enum: []string{
+													"SQL_UPDATE_TRACK_UNSPECIFIED",
+													"canary",
+													"stable",
+												},
+											},
+										},
+									},
+									"pricingPlan": &dcl.Property{
+										Type:        "string",
+										GoName:      "PricingPlan",
+										GoType:      "InstanceSettingsPricingPlanEnum",
+										Description: "The pricing plan for this instance. This can be either `PER_USE` or `PACKAGE`. Only `PER_USE` is supported for Second Generation instances. Possible values: SQL_PRICING_PLAN_UNSPECIFIED, PACKAGE, PER_USE",
+										Enum: []string{
+											"SQL_PRICING_PLAN_UNSPECIFIED",
+											"PACKAGE",
+											"PER_USE",
+										},
+									},
+									"replicationType": &dcl.Property{
+										Type:        "string",
+										GoName:      "ReplicationType",
+										GoType:      "InstanceSettingsReplicationTypeEnum",
+										Description: "The type of replication this instance uses. This can be either `ASYNCHRONOUS` or `SYNCHRONOUS`. (Deprecated) This property was only applicable to First Generation instances. Possible values: SQL_REPLICATION_TYPE_UNSPECIFIED, SYNCHRONOUS, ASYNCHRONOUS",
+										Enum: []string{
+											"SQL_REPLICATION_TYPE_UNSPECIFIED",
+											"SYNCHRONOUS",
+											"ASYNCHRONOUS",
+										},
+									},
+									"settingsVersion": &dcl.Property{
+										Type:        "object",
+										GoName:      "SettingsVersion",
+										GoType:      "InstanceSettingsSettingsVersion",
+										ReadOnly:    true,
+										Description: "Output only. The version of instance settings. This is a required field for update method to make sure concurrent updates are handled properly. During update, use the most recent settingsVersion value for this instance and do not try to update this value.",
+										Immutable:   true,
+										Properties: map[string]*dcl.Property{
+											"value": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "Value",
+												ReadOnly:    true,
+												Description: "The value of the field.",
+												Immutable:   true,
+											},
+										},
+									},
+									"storageAutoResize": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "StorageAutoResize",
+										Description: "Configuration to increase storage size automatically. The default value is true.",
+									},
+									"storageAutoResizeLimit": &dcl.Property{
+										Type:        "object",
+										GoName:      "StorageAutoResizeLimit",
+										GoType:      "InstanceSettingsStorageAutoResizeLimit",
+										Description: "The maximum size to which storage capacity can be automatically increased. The default value is 0, which specifies that there is no limit.",
+										Properties: map[string]*dcl.Property{
+											"value": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "Value",
+												Description: "The value of the field.",
+											},
+										},
+									},
+									"tier": &dcl.Property{
+										Type:        "string",
+										GoName:      "Tier",
+										Description: "The tier (or machine type) for this instance, for example `db-custom-1-3840`.",
+									},
+									"userLabels": &dcl.Property{
+										Type: "object",
+										AdditionalProperties: &dcl.Property{
+											Type: "string",
+										},
+										GoName:      "UserLabels",
+										Description: "User-provided labels, represented as a dictionary where each label is a single key value pair.",
+									},
+								},
+							},
+							"state": &dcl.Property{
+								Type:        "string",
+								GoName:      "State",
+								ReadOnly:    true,
+								Description: "Output only. The current serving state of the Cloud SQL instance. This can be one of the following: `RUNNABLE`, `SUSPENDED`, `PENDING_DELETE`, `PENDING_CREATE`, `MAINTENANCE` or `FAILED`.",
+								Immutable:   true,
+							},
+							"suspensionReason": &dcl.Property{
+								Type:        "array",
+								GoName:      "SuspensionReason",
+								ReadOnly:    true,
+								Description: "Output only. If the instance state is SUSPENDED, the reason for the suspension.",
+								Immutable:   true,
+								SendEmpty:   true,
+								ListType:    "list",
+								Items: &dcl.Property{
+									Type:   "string",
+									GoType: "string",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
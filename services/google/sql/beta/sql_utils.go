@@ -0,0 +1,151 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package beta contains handwritten support code for the sql service.
+package beta
+
+import (
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+// expandSqlInstanceMaxDiskSize expands InstanceMaxDiskSize into the bare int64
+// that the API expects in place of the wrapper message.
+func expandSqlInstanceMaxDiskSize(_ *Client, f *InstanceMaxDiskSize, _ *Instance) (interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+	return f.Value, nil
+}
+
+// flattenSqlInstanceMaxDiskSize flattens the bare int64 returned by the API
+// into InstanceMaxDiskSize.
+func flattenSqlInstanceMaxDiskSize(_ *Client, i interface{}, _ *Instance) *InstanceMaxDiskSize {
+	v := dcl.FlattenInteger(i)
+	if v == nil {
+		return nil
+	}
+	return &InstanceMaxDiskSize{Value: v}
+}
+
+// flattenSqlInstanceCurrentDiskSize flattens the bare int64 returned by the API
+// into InstanceCurrentDiskSize.
+func flattenSqlInstanceCurrentDiskSize(_ *Client, i interface{}, _ *Instance) *InstanceCurrentDiskSize {
+	v := dcl.FlattenInteger(i)
+	if v == nil {
+		return nil
+	}
+	return &InstanceCurrentDiskSize{Value: v}
+}
+
+// flattenSqlInstanceIPAddressesTimeToRetire flattens the RFC 3339 timestamp returned by
+// the API into InstanceIPAddressesTimeToRetire.
+func flattenSqlInstanceIPAddressesTimeToRetire(_ *Client, i interface{}, _ *Instance) *InstanceIPAddressesTimeToRetire {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil
+	}
+	return &InstanceIPAddressesTimeToRetire{
+		Seconds: dcl.Int64(t.Unix()),
+		Nanos:   dcl.Int64(int64(t.Nanosecond())),
+	}
+}
+
+// expandSqlInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod expands InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod into the bare int64
+// that the API expects in place of the wrapper message.
+func expandSqlInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(_ *Client, f *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod, _ *Instance) (interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+	return f.Value, nil
+}
+
+// flattenSqlInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod flattens the bare int64 returned by the API
+// into InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod.
+func flattenSqlInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod(_ *Client, i interface{}, _ *Instance) *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod {
+	v := dcl.FlattenInteger(i)
+	if v == nil {
+		return nil
+	}
+	return &InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod{Value: v}
+}
+
+// flattenSqlInstanceScheduledMaintenanceStartTime flattens the RFC 3339 timestamp returned by
+// the API into InstanceScheduledMaintenanceStartTime.
+func flattenSqlInstanceScheduledMaintenanceStartTime(_ *Client, i interface{}, _ *Instance) *InstanceScheduledMaintenanceStartTime {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil
+	}
+	return &InstanceScheduledMaintenanceStartTime{
+		Seconds: dcl.Int64(t.Unix()),
+		Nanos:   dcl.Int64(int64(t.Nanosecond())),
+	}
+}
+
+// flattenSqlInstanceSettingsSettingsVersion flattens the bare int64 returned by the API
+// into InstanceSettingsSettingsVersion.
+func flattenSqlInstanceSettingsSettingsVersion(_ *Client, i interface{}, _ *Instance) *InstanceSettingsSettingsVersion {
+	v := dcl.FlattenInteger(i)
+	if v == nil {
+		return nil
+	}
+	return &InstanceSettingsSettingsVersion{Value: v}
+}
+
+// expandSqlInstanceSettingsStorageAutoResizeLimit expands InstanceSettingsStorageAutoResizeLimit into the bare int64
+// that the API expects in place of the wrapper message.
+func expandSqlInstanceSettingsStorageAutoResizeLimit(_ *Client, f *InstanceSettingsStorageAutoResizeLimit, _ *Instance) (interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+	return f.Value, nil
+}
+
+// flattenSqlInstanceSettingsStorageAutoResizeLimit flattens the bare int64 returned by the API
+// into InstanceSettingsStorageAutoResizeLimit.
+func flattenSqlInstanceSettingsStorageAutoResizeLimit(_ *Client, i interface{}, _ *Instance) *InstanceSettingsStorageAutoResizeLimit {
+	v := dcl.FlattenInteger(i)
+	if v == nil {
+		return nil
+	}
+	return &InstanceSettingsStorageAutoResizeLimit{Value: v}
+}
+
+// expandSqlInstanceSettingsDataDiskSizeGb expands InstanceSettingsDataDiskSizeGb into the bare int64
+// that the API expects in place of the wrapper message.
+func expandSqlInstanceSettingsDataDiskSizeGb(_ *Client, f *InstanceSettingsDataDiskSizeGb, _ *Instance) (interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+	return f.Value, nil
+}
+
+// flattenSqlInstanceSettingsDataDiskSizeGb flattens the bare int64 returned by the API
+// into InstanceSettingsDataDiskSizeGb.
+func flattenSqlInstanceSettingsDataDiskSizeGb(_ *Client, i interface{}, _ *Instance) *InstanceSettingsDataDiskSizeGb {
+	v := dcl.FlattenInteger(i)
+	if v == nil {
+		return nil
+	}
+	return &InstanceSettingsDataDiskSizeGb{Value: v}
+}
@@ -0,0 +1,184 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package beta
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLSslCertSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Sql/SslCert",
+			Description: "The Sql SslCert resource",
+			StructName:  "SslCert",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a SslCert",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "sslCert",
+						Required:    true,
+						Description: "A full instance of a SslCert",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a SslCert",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "sslCert",
+						Required:    true,
+						Description: "A full instance of a SslCert",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a SslCert",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "sslCert",
+						Required:    true,
+						Description: "A full instance of a SslCert",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all SslCert",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "instance",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many SslCert",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "instance",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"SslCert": &dcl.Component{
+					Title:           "SslCert",
+					ID:              "projects/{{project}}/instances/{{instance}}/sslCerts/{{name}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"commonName",
+							"instance",
+							"project",
+						},
+						Properties: map[string]*dcl.Property{
+							"cert": &dcl.Property{
+								Type:        "string",
+								GoName:      "Cert",
+								ReadOnly:    true,
+								Description: "Output only. PEM representation.",
+								Immutable:   true,
+							},
+							"certSerialNumber": &dcl.Property{
+								Type:        "string",
+								GoName:      "CertSerialNumber",
+								ReadOnly:    true,
+								Description: "Output only. Serial number, as extracted from the certificate.",
+								Immutable:   true,
+							},
+							"commonName": &dcl.Property{
+								Type:        "string",
+								GoName:      "CommonName",
+								Description: "User supplied name. Constrained to [a-zA-Z.-_ ]+.",
+								Immutable:   true,
+							},
+							"createTime": &dcl.Property{
+								Type:        "string",
+								GoName:      "CreateTime",
+								ReadOnly:    true,
+								Description: "Output only. The time when the certificate was created in RFC 3339 format, for example `2012-11-15T16:19:00.094Z`.",
+								Immutable:   true,
+							},
+							"expirationTime": &dcl.Property{
+								Type:        "string",
+								GoName:      "ExpirationTime",
+								ReadOnly:    true,
+								Description: "Output only. The time when the certificate expires in RFC 3339 format, for example `2012-11-15T16:19:00.094Z`.",
+								Immutable:   true,
+							},
+							"instance": &dcl.Property{
+								Type:        "string",
+								GoName:      "Instance",
+								Description: "The name of the Cloud SQL instance. This does not include the project ID.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Sql/Instance",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"name": &dcl.Property{
+								Type:                     "string",
+								GoName:                   "Name",
+								Description:              "Output only. The SHA1 fingerprint of the certificate.",
+								Immutable:                true,
+								ServerGeneratedParameter: true,
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "The project for the resource",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package beta -var YAML_user blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/sql/beta/user.yaml
+
+package beta
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/sql/beta/user.yaml
+var YAML_user = []byte("info:\n  title: Sql/User\n  description: The Sql User resource\n  x-dcl-struct-name: User\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a User\n    parameters:\n    - name: user\n      required: true\n      description: A full instance of a User\n  apply:\n    description: The function used to apply information about a User\n    parameters:\n    - name: user\n      required: true\n      description: A full instance of a User\n  delete:\n    description: The function used to delete a User\n    parameters:\n    - name: user\n      required: true\n      description: A full instance of a User\n  deleteAll:\n    description: The function used to delete all User\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: instance\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many User\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: instance\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    User:\n      title: User\n      x-dcl-id: projects/{{project}}/instances/{{instance}}/users/{{name}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - name\n      - project\n      - instance\n      properties:\n        etag:\n          type: string\n          x-dcl-go-name: Etag\n          readOnly: true\n          description: Output only. This field is deprecated and will be removed from\n            a future version of the API.\n          x-kubernetes-immutable: true\n        host:\n          type: string\n          x-dcl-go-name: Host\n          description: The host name from which the user can connect. For `insert`\n            operations, host defaults to an empty string. For `update` operations,\n            host is specified as part of the request URL. The host name cannot be\n            updated after insertion.\n          x-kubernetes-immutable: true\n        instance:\n          type: string\n          x-dcl-go-name: Instance\n          description: The name of the Cloud SQL instance. This does not include the\n            project ID.\n          x-dcl-references:\n          - resource: Sql/Instance\n            field: name\n            parent: true\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: The name of the user in the Cloud SQL instance.\n          x-kubernetes-immutable: true\n        password:\n          type: string\n          x-dcl-go-name: Password\n          description: The password for the user.\n          x-dcl-sensitive: true\n          x-dcl-mutable-unreadable: true\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: The project for the resource\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        sqlserverUserDetails:\n          type: object\n          x-dcl-go-name: SqlserverUserDetails\n          x-dcl-go-type: UserSqlserverUserDetails\n          description: SQL Server specific user details.\n          properties:\n            disabled:\n              type: boolean\n              x-dcl-go-name: Disabled\n              description: If the user has been disabled\n            serverRoles:\n              type: array\n              x-dcl-go-name: ServerRoles\n              description: The server roles for this user\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: string\n                x-dcl-go-type: string\n        type:\n          type: string\n          x-dcl-go-name: Type\n          x-dcl-go-type: UserTypeEnum\n          description: 'The user type. It determines the method to authenticate the\n            user during login. The default is the database''s built-in user type.\n            Possible values: NATIVE, CLOUD_IAM_USER, CLOUD_IAM_SERVICE_ACCOUNT'\n          x-kubernetes-immutable: true\n          enum:\n          - NATIVE\n          - CLOUD_IAM_USER\n          - CLOUD_IAM_SERVICE_ACCOUNT\n")
+
+// 4299 bytes
+// MD5: b71df8a1c988e677cd016bed6bcf36af
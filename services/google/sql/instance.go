@@ -0,0 +1,1548 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package sql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"google.golang.org/api/googleapi"
+)
+
+type Instance struct {
+	BackendType                 *InstanceBackendTypeEnum             `json:"backendType"`
+	ConnectionName              *string                              `json:"connectionName"`
+	DatabaseVersion             *InstanceDatabaseVersionEnum         `json:"databaseVersion"`
+	Etag                        *string                              `json:"etag"`
+	GceZone                     *string                              `json:"gceZone"`
+	InstanceType                *InstanceInstanceTypeEnum            `json:"instanceType"`
+	MasterInstanceName          *string                              `json:"masterInstanceName"`
+	MaxDiskSize                 *InstanceMaxDiskSize                 `json:"maxDiskSize"`
+	Name                        *string                              `json:"name"`
+	Project                     *string                              `json:"project"`
+	Region                      *string                              `json:"region"`
+	RootPassword                *string                              `json:"rootPassword"`
+	CurrentDiskSize             *InstanceCurrentDiskSize             `json:"currentDiskSize"`
+	DiskEncryptionConfiguration *InstanceDiskEncryptionConfiguration `json:"diskEncryptionConfiguration"`
+	FailoverReplica             *InstanceFailoverReplica             `json:"failoverReplica"`
+	IPAddresses                 []InstanceIPAddresses                `json:"ipAddresses"`
+	MasterInstance              *InstanceMasterInstance              `json:"masterInstance"`
+	ReplicaConfiguration        *InstanceReplicaConfiguration        `json:"replicaConfiguration"`
+	ScheduledMaintenance        *InstanceScheduledMaintenance        `json:"scheduledMaintenance"`
+	Settings                    *InstanceSettings                    `json:"settings"`
+}
+
+func (r *Instance) String() string {
+	return dcl.SprintResource(r)
+}
+
+// The enum InstanceBackendTypeEnum.
+type InstanceBackendTypeEnum string
+
+// InstanceBackendTypeEnumRef returns a *InstanceBackendTypeEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceBackendTypeEnumRef(s string) *InstanceBackendTypeEnum {
+	v := InstanceBackendTypeEnum(s)
+	return &v
+}
+
+func (v InstanceBackendTypeEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"SQL_BACKEND_TYPE_UNSPECIFIED", "FIRST_GEN", "SECOND_GEN", "EXTERNAL"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceBackendTypeEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum InstanceDatabaseVersionEnum.
+type InstanceDatabaseVersionEnum string
+
+// InstanceDatabaseVersionEnumRef returns a *InstanceDatabaseVersionEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceDatabaseVersionEnumRef(s string) *InstanceDatabaseVersionEnum {
+	v := InstanceDatabaseVersionEnum(s)
+	return &v
+}
+
+func (v InstanceDatabaseVersionEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"SQL_DATABASE_VERSION_UNSPECIFIED", "OBSOLETE_MYSQL_5_0", "MYSQL_5_1", "MYSQL_5_5", "MARIADB_10_0", "MYSQL_5_6", "MYSQL_5_7", "OBSOLETE_POSTGRES_9_5", "SQLSERVER_ENTERPRISE_2016", "POSTGRES_9_6", "POSTGRES_11", "SQLSERVER_2017_STANDARD", "SQLSERVER_2016_STANDARD", "MIMIR", "SQLSERVER_2017_ENTERPRISE", "SQLSERVER_2017_EXPRESS", "SQLSERVER_2017_WEB", "POSTGRES_TIER_1", "POSTGRES_10", "POSTGRES_12", "MYSQL_8_0", "AGBALA"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceDatabaseVersionEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum InstanceIPAddressesTypeEnum.
+type InstanceIPAddressesTypeEnum string
+
+// InstanceIPAddressesTypeEnumRef returns a *InstanceIPAddressesTypeEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceIPAddressesTypeEnumRef(s string) *InstanceIPAddressesTypeEnum {
+	v := InstanceIPAddressesTypeEnum(s)
+	return &v
+}
+
+func (v InstanceIPAddressesTypeEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"SQL_IP_ADDRESS_TYPE_UNSPECIFIED", "PRIMARY", "OUTGOING", "PRIVATE", "MIGRATED_1ST_GEN"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceIPAddressesTypeEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum InstanceInstanceTypeEnum.
+type InstanceInstanceTypeEnum string
+
+// InstanceInstanceTypeEnumRef returns a *InstanceInstanceTypeEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceInstanceTypeEnumRef(s string) *InstanceInstanceTypeEnum {
+	v := InstanceInstanceTypeEnum(s)
+	return &v
+}
+
+func (v InstanceInstanceTypeEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"SQL_INSTANCE_TYPE_UNSPECIFIED", "CLOUD_SQL_INSTANCE", "ON_PREMISES_INSTANCE", "READ_REPLICA_INSTANCE", "READ_REPLICA_POOL_INSTANCE"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceInstanceTypeEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum InstanceSettingsActivationPolicyEnum.
+type InstanceSettingsActivationPolicyEnum string
+
+// InstanceSettingsActivationPolicyEnumRef returns a *InstanceSettingsActivationPolicyEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceSettingsActivationPolicyEnumRef(s string) *InstanceSettingsActivationPolicyEnum {
+	v := InstanceSettingsActivationPolicyEnum(s)
+	return &v
+}
+
+func (v InstanceSettingsActivationPolicyEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"SQL_ACTIVATION_POLICY_UNSPECIFIED", "ALWAYS", "NEVER", "ON_DEMAND"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceSettingsActivationPolicyEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum InstanceSettingsAvailabilityTypeEnum.
+type InstanceSettingsAvailabilityTypeEnum string
+
+// InstanceSettingsAvailabilityTypeEnumRef returns a *InstanceSettingsAvailabilityTypeEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceSettingsAvailabilityTypeEnumRef(s string) *InstanceSettingsAvailabilityTypeEnum {
+	v := InstanceSettingsAvailabilityTypeEnum(s)
+	return &v
+}
+
+func (v InstanceSettingsAvailabilityTypeEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"SQL_AVAILABILITY_TYPE_UNSPECIFIED", "ZONAL", "REGIONAL"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceSettingsAvailabilityTypeEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum InstanceSettingsDataDiskTypeEnum.
+type InstanceSettingsDataDiskTypeEnum string
+
+// InstanceSettingsDataDiskTypeEnumRef returns a *InstanceSettingsDataDiskTypeEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceSettingsDataDiskTypeEnumRef(s string) *InstanceSettingsDataDiskTypeEnum {
+	v := InstanceSettingsDataDiskTypeEnum(s)
+	return &v
+}
+
+func (v InstanceSettingsDataDiskTypeEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"SQL_DATA_DISK_TYPE_UNSPECIFIED", "PD_SSD", "PD_HDD", "OBSOLETE_LOCAL_SSD"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceSettingsDataDiskTypeEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum InstanceSettingsPricingPlanEnum.
+type InstanceSettingsPricingPlanEnum string
+
+// InstanceSettingsPricingPlanEnumRef returns a *InstanceSettingsPricingPlanEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceSettingsPricingPlanEnumRef(s string) *InstanceSettingsPricingPlanEnum {
+	v := InstanceSettingsPricingPlanEnum(s)
+	return &v
+}
+
+func (v InstanceSettingsPricingPlanEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"SQL_PRICING_PLAN_UNSPECIFIED", "PACKAGE", "PER_USE"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceSettingsPricingPlanEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum InstanceSettingsReplicationTypeEnum.
+type InstanceSettingsReplicationTypeEnum string
+
+// InstanceSettingsReplicationTypeEnumRef returns a *InstanceSettingsReplicationTypeEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func InstanceSettingsReplicationTypeEnumRef(s string) *InstanceSettingsReplicationTypeEnum {
+	v := InstanceSettingsReplicationTypeEnum(s)
+	return &v
+}
+
+func (v InstanceSettingsReplicationTypeEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"SQL_REPLICATION_TYPE_UNSPECIFIED", "SYNCHRONOUS", "ASYNCHRONOUS"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "InstanceSettingsReplicationTypeEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+type InstanceMaxDiskSize struct {
+	empty bool   `json:"-"`
+	Value *int64 `json:"value"`
+}
+
+type jsonInstanceMaxDiskSize InstanceMaxDiskSize
+
+func (r *InstanceMaxDiskSize) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceMaxDiskSize
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceMaxDiskSize
+	} else {
+
+		r.Value = res.Value
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceMaxDiskSize is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceMaxDiskSize *InstanceMaxDiskSize = &InstanceMaxDiskSize{empty: true}
+
+func (r *InstanceMaxDiskSize) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceMaxDiskSize) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceMaxDiskSize) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceCurrentDiskSize struct {
+	empty bool   `json:"-"`
+	Value *int64 `json:"value"`
+}
+
+type jsonInstanceCurrentDiskSize InstanceCurrentDiskSize
+
+func (r *InstanceCurrentDiskSize) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceCurrentDiskSize
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceCurrentDiskSize
+	} else {
+
+		r.Value = res.Value
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceCurrentDiskSize is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceCurrentDiskSize *InstanceCurrentDiskSize = &InstanceCurrentDiskSize{empty: true}
+
+func (r *InstanceCurrentDiskSize) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceCurrentDiskSize) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceCurrentDiskSize) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceDiskEncryptionConfiguration struct {
+	empty      bool    `json:"-"`
+	KmsKeyName *string `json:"kmsKeyName"`
+	Kind       *string `json:"kind"`
+}
+
+type jsonInstanceDiskEncryptionConfiguration InstanceDiskEncryptionConfiguration
+
+func (r *InstanceDiskEncryptionConfiguration) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceDiskEncryptionConfiguration
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceDiskEncryptionConfiguration
+	} else {
+
+		r.KmsKeyName = res.KmsKeyName
+
+		r.Kind = res.Kind
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceDiskEncryptionConfiguration is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceDiskEncryptionConfiguration *InstanceDiskEncryptionConfiguration = &InstanceDiskEncryptionConfiguration{empty: true}
+
+func (r *InstanceDiskEncryptionConfiguration) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceDiskEncryptionConfiguration) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceDiskEncryptionConfiguration) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceFailoverReplica struct {
+	empty     bool    `json:"-"`
+	Name      *string `json:"name"`
+	Available *bool   `json:"available"`
+}
+
+type jsonInstanceFailoverReplica InstanceFailoverReplica
+
+func (r *InstanceFailoverReplica) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceFailoverReplica
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceFailoverReplica
+	} else {
+
+		r.Name = res.Name
+
+		r.Available = res.Available
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceFailoverReplica is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceFailoverReplica *InstanceFailoverReplica = &InstanceFailoverReplica{empty: true}
+
+func (r *InstanceFailoverReplica) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceFailoverReplica) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceFailoverReplica) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceIPAddresses struct {
+	empty        bool                             `json:"-"`
+	Type         *InstanceIPAddressesTypeEnum     `json:"type"`
+	IPAddress    *string                          `json:"ipAddress"`
+	TimeToRetire *InstanceIPAddressesTimeToRetire `json:"timeToRetire"`
+}
+
+type jsonInstanceIPAddresses InstanceIPAddresses
+
+func (r *InstanceIPAddresses) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceIPAddresses
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceIPAddresses
+	} else {
+
+		r.Type = res.Type
+
+		r.IPAddress = res.IPAddress
+
+		r.TimeToRetire = res.TimeToRetire
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceIPAddresses is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceIPAddresses *InstanceIPAddresses = &InstanceIPAddresses{empty: true}
+
+func (r *InstanceIPAddresses) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceIPAddresses) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceIPAddresses) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceIPAddressesTimeToRetire struct {
+	empty   bool   `json:"-"`
+	Seconds *int64 `json:"seconds"`
+	Nanos   *int64 `json:"nanos"`
+}
+
+type jsonInstanceIPAddressesTimeToRetire InstanceIPAddressesTimeToRetire
+
+func (r *InstanceIPAddressesTimeToRetire) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceIPAddressesTimeToRetire
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceIPAddressesTimeToRetire
+	} else {
+
+		r.Seconds = res.Seconds
+
+		r.Nanos = res.Nanos
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceIPAddressesTimeToRetire is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceIPAddressesTimeToRetire *InstanceIPAddressesTimeToRetire = &InstanceIPAddressesTimeToRetire{empty: true}
+
+func (r *InstanceIPAddressesTimeToRetire) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceIPAddressesTimeToRetire) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceIPAddressesTimeToRetire) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceMasterInstance struct {
+	empty  bool    `json:"-"`
+	Name   *string `json:"name"`
+	Region *string `json:"region"`
+}
+
+type jsonInstanceMasterInstance InstanceMasterInstance
+
+func (r *InstanceMasterInstance) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceMasterInstance
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceMasterInstance
+	} else {
+
+		r.Name = res.Name
+
+		r.Region = res.Region
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceMasterInstance is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceMasterInstance *InstanceMasterInstance = &InstanceMasterInstance{empty: true}
+
+func (r *InstanceMasterInstance) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceMasterInstance) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceMasterInstance) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceReplicaConfiguration struct {
+	empty                     bool                                                   `json:"-"`
+	Kind                      *string                                                `json:"kind"`
+	MysqlReplicaConfiguration *InstanceReplicaConfigurationMysqlReplicaConfiguration `json:"mysqlReplicaConfiguration"`
+	FailoverTarget            *bool                                                  `json:"failoverTarget"`
+	ReplicaPoolConfiguration  *InstanceReplicaConfigurationReplicaPoolConfiguration  `json:"replicaPoolConfiguration"`
+}
+
+type jsonInstanceReplicaConfiguration InstanceReplicaConfiguration
+
+func (r *InstanceReplicaConfiguration) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceReplicaConfiguration
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceReplicaConfiguration
+	} else {
+
+		r.Kind = res.Kind
+
+		r.MysqlReplicaConfiguration = res.MysqlReplicaConfiguration
+
+		r.FailoverTarget = res.FailoverTarget
+
+		r.ReplicaPoolConfiguration = res.ReplicaPoolConfiguration
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceReplicaConfiguration is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceReplicaConfiguration *InstanceReplicaConfiguration = &InstanceReplicaConfiguration{empty: true}
+
+func (r *InstanceReplicaConfiguration) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceReplicaConfiguration) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceReplicaConfiguration) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceReplicaConfigurationMysqlReplicaConfiguration struct {
+	empty                   bool                                                                        `json:"-"`
+	DumpFilePath            *string                                                                     `json:"dumpFilePath"`
+	Username                *string                                                                     `json:"username"`
+	Password                *string                                                                     `json:"password"`
+	ConnectRetryInterval    *int64                                                                      `json:"connectRetryInterval"`
+	MasterHeartbeatPeriod   *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod `json:"masterHeartbeatPeriod"`
+	CaCertificate           *string                                                                     `json:"caCertificate"`
+	ClientCertificate       *string                                                                     `json:"clientCertificate"`
+	ClientKey               *string                                                                     `json:"clientKey"`
+	SslCipher               *string                                                                     `json:"sslCipher"`
+	VerifyServerCertificate *bool                                                                       `json:"verifyServerCertificate"`
+	Kind                    *string                                                                     `json:"kind"`
+}
+
+type jsonInstanceReplicaConfigurationMysqlReplicaConfiguration InstanceReplicaConfigurationMysqlReplicaConfiguration
+
+func (r *InstanceReplicaConfigurationMysqlReplicaConfiguration) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceReplicaConfigurationMysqlReplicaConfiguration
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceReplicaConfigurationMysqlReplicaConfiguration
+	} else {
+
+		r.DumpFilePath = res.DumpFilePath
+
+		r.Username = res.Username
+
+		r.Password = res.Password
+
+		r.ConnectRetryInterval = res.ConnectRetryInterval
+
+		r.MasterHeartbeatPeriod = res.MasterHeartbeatPeriod
+
+		r.CaCertificate = res.CaCertificate
+
+		r.ClientCertificate = res.ClientCertificate
+
+		r.ClientKey = res.ClientKey
+
+		r.SslCipher = res.SslCipher
+
+		r.VerifyServerCertificate = res.VerifyServerCertificate
+
+		r.Kind = res.Kind
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceReplicaConfigurationMysqlReplicaConfiguration is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceReplicaConfigurationMysqlReplicaConfiguration *InstanceReplicaConfigurationMysqlReplicaConfiguration = &InstanceReplicaConfigurationMysqlReplicaConfiguration{empty: true}
+
+func (r *InstanceReplicaConfigurationMysqlReplicaConfiguration) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceReplicaConfigurationMysqlReplicaConfiguration) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceReplicaConfigurationMysqlReplicaConfiguration) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod struct {
+	empty bool   `json:"-"`
+	Value *int64 `json:"value"`
+}
+
+type jsonInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod
+
+func (r *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod
+	} else {
+
+		r.Value = res.Value
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod = &InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod{empty: true}
+
+func (r *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceReplicaConfigurationReplicaPoolConfiguration struct {
+	empty                        bool                                                                              `json:"-"`
+	Kind                         *string                                                                           `json:"kind"`
+	StaticPoolConfiguration      *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration      `json:"staticPoolConfiguration"`
+	AutoscalingPoolConfiguration *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration `json:"autoscalingPoolConfiguration"`
+}
+
+type jsonInstanceReplicaConfigurationReplicaPoolConfiguration InstanceReplicaConfigurationReplicaPoolConfiguration
+
+func (r *InstanceReplicaConfigurationReplicaPoolConfiguration) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceReplicaConfigurationReplicaPoolConfiguration
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceReplicaConfigurationReplicaPoolConfiguration
+	} else {
+
+		r.Kind = res.Kind
+
+		r.StaticPoolConfiguration = res.StaticPoolConfiguration
+
+		r.AutoscalingPoolConfiguration = res.AutoscalingPoolConfiguration
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceReplicaConfigurationReplicaPoolConfiguration is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceReplicaConfigurationReplicaPoolConfiguration *InstanceReplicaConfigurationReplicaPoolConfiguration = &InstanceReplicaConfigurationReplicaPoolConfiguration{empty: true}
+
+func (r *InstanceReplicaConfigurationReplicaPoolConfiguration) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceReplicaConfigurationReplicaPoolConfiguration) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceReplicaConfigurationReplicaPoolConfiguration) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration struct {
+	empty           bool    `json:"-"`
+	Kind            *string `json:"kind"`
+	ReplicaCount    *int64  `json:"replicaCount"`
+	ExposeReplicaIP *bool   `json:"exposeReplicaIp"`
+}
+
+type jsonInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration
+
+func (r *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration
+	} else {
+
+		r.Kind = res.Kind
+
+		r.ReplicaCount = res.ReplicaCount
+
+		r.ExposeReplicaIP = res.ExposeReplicaIP
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration = &InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration{empty: true}
+
+func (r *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration struct {
+	empty           bool     `json:"-"`
+	Kind            *string  `json:"kind"`
+	MinReplicaCount *int64   `json:"minReplicaCount"`
+	MaxReplicaCount *int64   `json:"maxReplicaCount"`
+	TargetCpuUtil   *float64 `json:"targetCpuUtil"`
+}
+
+type jsonInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration
+
+func (r *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration
+	} else {
+
+		r.Kind = res.Kind
+
+		r.MinReplicaCount = res.MinReplicaCount
+
+		r.MaxReplicaCount = res.MaxReplicaCount
+
+		r.TargetCpuUtil = res.TargetCpuUtil
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration = &InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration{empty: true}
+
+func (r *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceScheduledMaintenance struct {
+	empty         bool                                   `json:"-"`
+	StartTime     *InstanceScheduledMaintenanceStartTime `json:"startTime"`
+	CanDefer      *bool                                  `json:"canDefer"`
+	CanReschedule *bool                                  `json:"canReschedule"`
+}
+
+type jsonInstanceScheduledMaintenance InstanceScheduledMaintenance
+
+func (r *InstanceScheduledMaintenance) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceScheduledMaintenance
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceScheduledMaintenance
+	} else {
+
+		r.StartTime = res.StartTime
+
+		r.CanDefer = res.CanDefer
+
+		r.CanReschedule = res.CanReschedule
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceScheduledMaintenance is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceScheduledMaintenance *InstanceScheduledMaintenance = &InstanceScheduledMaintenance{empty: true}
+
+func (r *InstanceScheduledMaintenance) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceScheduledMaintenance) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceScheduledMaintenance) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceScheduledMaintenanceStartTime struct {
+	empty   bool   `json:"-"`
+	Seconds *int64 `json:"seconds"`
+	Nanos   *int64 `json:"nanos"`
+}
+
+type jsonInstanceScheduledMaintenanceStartTime InstanceScheduledMaintenanceStartTime
+
+func (r *InstanceScheduledMaintenanceStartTime) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceScheduledMaintenanceStartTime
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceScheduledMaintenanceStartTime
+	} else {
+
+		r.Seconds = res.Seconds
+
+		r.Nanos = res.Nanos
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceScheduledMaintenanceStartTime is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceScheduledMaintenanceStartTime *InstanceScheduledMaintenanceStartTime = &InstanceScheduledMaintenanceStartTime{empty: true}
+
+func (r *InstanceScheduledMaintenanceStartTime) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceScheduledMaintenanceStartTime) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceScheduledMaintenanceStartTime) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type InstanceSettings struct {
+	empty                       bool                                  `json:"-"`
+	AuthorizedGaeApplications   []string                              `json:"authorizedGaeApplications"`
+	Tier                        *string                               `json:"tier"`
+	Kind                        *string                               `json:"kind"`
+	AvailabilityType            *InstanceSettingsAvailabilityTypeEnum `json:"availabilityType"`
+	PricingPlan                 *InstanceSettingsPricingPlanEnum      `json:"pricingPlan"`
+	ReplicationType             *InstanceSettingsReplicationTypeEnum  `json:"replicationType"`
+	ActivationPolicy            *InstanceSettingsActivationPolicyEnum `json:"activationPolicy"`
+	StorageAutoResize           *bool                                 `json:"storageAutoResize"`
+	DataDiskType                *InstanceSettingsDataDiskTypeEnum     `json:"dataDiskType"`
+	DatabaseReplicationEnabled  *bool                                 `json:"databaseReplicationEnabled"`
+	CrashSafeReplicationEnabled *bool                                 `json:"crashSafeReplicationEnabled"`
+}
+
+type jsonInstanceSettings InstanceSettings
+
+func (r *InstanceSettings) UnmarshalJSON(data []byte) error {
+	var res jsonInstanceSettings
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyInstanceSettings
+	} else {
+
+		r.AuthorizedGaeApplications = res.AuthorizedGaeApplications
+
+		r.Tier = res.Tier
+
+		r.Kind = res.Kind
+
+		r.AvailabilityType = res.AvailabilityType
+
+		r.PricingPlan = res.PricingPlan
+
+		r.ReplicationType = res.ReplicationType
+
+		r.ActivationPolicy = res.ActivationPolicy
+
+		r.StorageAutoResize = res.StorageAutoResize
+
+		r.DataDiskType = res.DataDiskType
+
+		r.DatabaseReplicationEnabled = res.DatabaseReplicationEnabled
+
+		r.CrashSafeReplicationEnabled = res.CrashSafeReplicationEnabled
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this InstanceSettings is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyInstanceSettings *InstanceSettings = &InstanceSettings{empty: true}
+
+func (r *InstanceSettings) Empty() bool {
+	return r.empty
+}
+
+func (r *InstanceSettings) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *InstanceSettings) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+// Describe returns a simple description of this resource to ensure that automated tools
+// can identify it.
+func (r *Instance) Describe() dcl.ServiceTypeVersion {
+	return dcl.ServiceTypeVersion{
+		Service: "sql",
+		Type:    "Instance",
+		Version: "sql",
+	}
+}
+
+func (r *Instance) ID() (string, error) {
+	if err := extractInstanceFields(r); err != nil {
+		return "", err
+	}
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"backend_type":                  dcl.ValueOrEmptyString(nr.BackendType),
+		"connection_name":               dcl.ValueOrEmptyString(nr.ConnectionName),
+		"database_version":              dcl.ValueOrEmptyString(nr.DatabaseVersion),
+		"etag":                          dcl.ValueOrEmptyString(nr.Etag),
+		"gce_zone":                      dcl.ValueOrEmptyString(nr.GceZone),
+		"instance_type":                 dcl.ValueOrEmptyString(nr.InstanceType),
+		"master_instance_name":          dcl.ValueOrEmptyString(nr.MasterInstanceName),
+		"max_disk_size":                 dcl.ValueOrEmptyString(nr.MaxDiskSize),
+		"name":                          dcl.ValueOrEmptyString(nr.Name),
+		"project":                       dcl.ValueOrEmptyString(nr.Project),
+		"region":                        dcl.ValueOrEmptyString(nr.Region),
+		"root_password":                 dcl.ValueOrEmptyString(nr.RootPassword),
+		"current_disk_size":             dcl.ValueOrEmptyString(nr.CurrentDiskSize),
+		"disk_encryption_configuration": dcl.ValueOrEmptyString(nr.DiskEncryptionConfiguration),
+		"failover_replica":              dcl.ValueOrEmptyString(nr.FailoverReplica),
+		"ip_addresses":                  dcl.ValueOrEmptyString(nr.IPAddresses),
+		"master_instance":               dcl.ValueOrEmptyString(nr.MasterInstance),
+		"replica_configuration":         dcl.ValueOrEmptyString(nr.ReplicaConfiguration),
+		"scheduled_maintenance":         dcl.ValueOrEmptyString(nr.ScheduledMaintenance),
+		"settings":                      dcl.ValueOrEmptyString(nr.Settings),
+	}
+	return dcl.Nprintf("projects/{{project}}/instances/{{name}}", params), nil
+}
+
+const InstanceMaxPage = -1
+
+type InstanceList struct {
+	Items []*Instance
+
+	nextToken string
+
+	pageSize int32
+
+	resource *Instance
+}
+
+func (l *InstanceList) HasNext() bool {
+	return l.nextToken != ""
+}
+
+func (l *InstanceList) NextPageToken() string {
+	return l.nextToken
+}
+
+func (l *InstanceList) Next(ctx context.Context, c *Client) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if !l.HasNext() {
+		return fmt.Errorf("no next page")
+	}
+	items, token, err := c.listInstance(ctx, l.resource, l.nextToken, l.pageSize)
+	if err != nil {
+		return err
+	}
+	l.Items = items
+	l.nextToken = token
+	return err
+}
+
+func (c *Client) ListInstance(ctx context.Context, project string) (*InstanceList, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	return c.ListInstanceWithMaxResults(ctx, project, InstanceMaxPage)
+
+}
+
+func (c *Client) ListInstanceWithMaxResults(ctx context.Context, project string, pageSize int32) (*InstanceList, error) {
+	return c.ListInstanceWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListInstanceWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*InstanceList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// Create a resource object so that we can use proper url normalization methods.
+	r := &Instance{
+		Project: &project,
+	}
+	items, token, err := c.listInstance(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &InstanceList{
+		Items:     items,
+		nextToken: token,
+		pageSize:  pageSize,
+		resource:  r,
+	}, nil
+}
+
+func (c *Client) GetInstance(ctx context.Context, r *Instance) (*Instance, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// This is *purposefully* supressing errors.
+	// This function is used with url-normalized values + not URL normalized values.
+	// URL Normalized values will throw unintentional errors, since those values are not of the proper parent form.
+	extractInstanceFields(r)
+
+	b, err := c.getInstanceRaw(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			return nil, &googleapi.Error{
+				Code:    404,
+				Message: err.Error(),
+			}
+		}
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
+	}
+	result, err := unmarshalInstance(b, c, r)
+	if err != nil {
+		return nil, err
+	}
+	result.Project = r.Project
+	result.Name = r.Name
+
+	c.Config.Logger.InfoWithContextf(ctx, "Retrieved raw result state: %v", result)
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with specified state: %v", r)
+	result, err = canonicalizeInstanceNewState(c, result, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := postReadExtractInstanceFields(result); err != nil {
+		return result, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created result state: %v", result)
+
+	return result, nil
+}
+
+func (c *Client) DeleteInstance(ctx context.Context, r *Instance) error {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if r == nil {
+		return fmt.Errorf("Instance resource is nil")
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Deleting Instance...")
+	deleteOp := deleteInstanceOperation{}
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteInstanceAsync(ctx context.Context, r *Instance) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteInstance(ctx, r)
+	})
+}
+
+// DeleteAllInstance deletes all resources that the filter functions returns true on.
+func (c *Client) DeleteAllInstance(ctx context.Context, project string, filter func(*Instance) bool) error {
+	listObj, err := c.ListInstance(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	err = c.deleteAllInstance(ctx, filter, listObj.Items)
+	if err != nil {
+		return err
+	}
+	for listObj.HasNext() {
+		err = listObj.Next(ctx, c)
+		if err != nil {
+			return nil
+		}
+		err = c.deleteAllInstance(ctx, filter, listObj.Items)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) ApplyInstance(ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) (*Instance, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	var resultNewState *Instance
+	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		newState, err := applyInstanceHelper(c, ctx, rawDesired, opts...)
+		resultNewState = newState
+		if err != nil {
+			// If the error is 409, there is conflict in resource update.
+			// Here we want to apply changes based on latest state.
+			if dcl.IsConflictError(err) {
+				return &dcl.RetryDetails{}, dcl.OperationNotDone{Err: err}
+			}
+			return nil, err
+		}
+		return nil, nil
+	}, c.Config.RetryProvider)
+	return resultNewState, err
+}
+
+func (c *Client) ApplyInstanceAsync(ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyInstance(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffInstance returns the field-level differences between rawDesired and the
+// live Instance without modifying it. If the Instance does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffInstance(ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Instance{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Instance{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractInstanceFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.instanceDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Instance %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
+func applyInstanceHelper(c *Client, ctx context.Context, rawDesired *Instance, opts ...dcl.ApplyOption) (*Instance, error) {
+	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyInstance...")
+	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
+
+	// 1.1: Validation of user-specified fields in desired state.
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
+
+	if err := extractInstanceFields(rawDesired); err != nil {
+		return nil, err
+	}
+
+	initial, desired, fieldDiffs, err := c.instanceDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a diff: %w", err)
+	}
+
+	diffs, err := convertFieldDiffsToInstanceDiffs(c.Config, fieldDiffs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(magic-modules-eng): 2.2 Feasibility check (all updates are feasible so far).
+
+	// 2.3: Lifecycle Directive Check
+	var create bool
+	lp := dcl.FetchLifecycleParams(opts)
+	if initial == nil {
+		if dcl.HasLifecycleParam(lp, dcl.BlockCreation) {
+			return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Creation blocked by lifecycle params: %#v.", desired)}
+		}
+		create = true
+	} else if dcl.HasLifecycleParam(lp, dcl.BlockAcquire) {
+		return nil, dcl.ApplyInfeasibleError{
+			Message: fmt.Sprintf("Resource already exists - apply blocked by lifecycle params: %#v.", initial),
+		}
+	} else {
+		for _, d := range diffs {
+			if d.RequiresRecreate {
+				return nil, dcl.ApplyInfeasibleError{
+					Message: fmt.Sprintf("infeasible update: (%v) would require recreation", d),
+				}
+			}
+			if dcl.HasLifecycleParam(lp, dcl.BlockModification) {
+				return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Modification blocked, diff (%v) unresolvable.", d)}
+			}
+		}
+	}
+
+	// 2.4 Imperative Request Planning
+	var ops []instanceApiOperation
+	if create {
+		ops = append(ops, &createInstanceOperation{})
+	} else {
+		for _, d := range diffs {
+			ops = append(ops, d.UpdateOp)
+		}
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created plan: %#v", ops)
+
+	// 2.5 Request Actuation
+	for _, op := range ops {
+		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
+		if err := op.do(ctx, desired, c); err != nil {
+			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
+		}
+		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
+	}
+	return applyInstanceDiff(c, ctx, desired, rawDesired, ops, opts...)
+}
+
+func applyInstanceDiff(c *Client, ctx context.Context, desired *Instance, rawDesired *Instance, ops []instanceApiOperation, opts ...dcl.ApplyOption) (*Instance, error) {
+	// 3.1, 3.2a Retrieval of raw new state & canonicalization with desired state
+	c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state...")
+	rawNew, err := c.GetInstance(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	// Get additional values from the first response.
+	// These values should be merged into the newState above.
+	if len(ops) > 0 {
+		lastOp := ops[len(ops)-1]
+		if o, ok := lastOp.(*createInstanceOperation); ok {
+			if r, hasR := o.FirstResponse(); hasR {
+
+				c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state from operation...")
+
+				fullResp, err := unmarshalMapInstance(r, c, rawDesired)
+				if err != nil {
+					return nil, err
+				}
+
+				rawNew, err = canonicalizeInstanceNewState(c, rawNew, fullResp)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with raw desired state: %v", rawDesired)
+	// 3.2b Canonicalization of raw new state using raw desired state
+	newState, err := canonicalizeInstanceNewState(c, rawNew, rawDesired)
+	if err != nil {
+		return rawNew, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created canonical new state: %v", newState)
+	// 3.3 Comparison of the new state and raw desired state.
+	// TODO(magic-modules-eng): EVENTUALLY_CONSISTENT_UPDATE
+	newDesired, err := canonicalizeInstanceDesiredState(rawDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if err := postReadExtractInstanceFields(newState); err != nil {
+		return newState, err
+	}
+
+	// Need to ensure any transformations made here match acceptably in differ.
+	if err := postReadExtractInstanceFields(newDesired); err != nil {
+		return newState, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Diffing using canonicalized desired state: %v", newDesired)
+	newDiffs, err := diffInstance(c, newDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if len(newDiffs) == 0 {
+		c.Config.Logger.InfoWithContext(ctx, "No diffs found. Apply was successful.")
+	} else {
+		c.Config.Logger.InfoWithContextf(ctx, "Found diffs: %v", newDiffs)
+		diffMessages := make([]string, len(newDiffs))
+		for i, d := range newDiffs {
+			diffMessages[i] = fmt.Sprintf("%v", d)
+		}
+		return newState, dcl.DiffAfterApplyError{Diffs: diffMessages}
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Done Apply.")
+	return newState, nil
+}
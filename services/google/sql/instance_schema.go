@@ -0,0 +1,697 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package sql
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLInstanceSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Sql/Instance",
+			Description: "The Sql Instance resource",
+			StructName:  "Instance",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "instance",
+						Required:    true,
+						Description: "A full instance of a Instance",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "instance",
+						Required:    true,
+						Description: "A full instance of a Instance",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "instance",
+						Required:    true,
+						Description: "A full instance of a Instance",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many Instance",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"Instance": &dcl.Component{
+					Title:           "Instance",
+					ID:              "projects/{{project}}/instances/{{name}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"name",
+							"project",
+						},
+						Properties: map[string]*dcl.Property{
+							"backendType": &dcl.Property{
+								Type:          "string",
+								GoName:        "BackendType",
+								GoType:        "InstanceBackendTypeEnum",
+								Description:   "The backend type. `SECOND_GEN`: Cloud SQL database instance. `EXTERNAL`: A database server that is not managed by Google. This property is read-only; use the `tier` property in the `settings` object to determine the database type. Possible values: SQL_BACKEND_TYPE_UNSPECIFIED, FIRST_GEN, SECOND_GEN, EXTERNAL",
+								ServerDefault: true,
+								Enum: []string{
+									"SQL_BACKEND_TYPE_UNSPECIFIED",
+									"FIRST_GEN",
+									"SECOND_GEN",
+									"EXTERNAL",
+								},
+							},
+							"connectionName": &dcl.Property{
+								Type:        "string",
+								GoName:      "ConnectionName",
+								ReadOnly:    true,
+								Description: "Output only. Connection name of the Cloud SQL instance used in connection strings.",
+								Immutable:   true,
+							},
+							"currentDiskSize": &dcl.Property{
+								Type:        "object",
+								GoName:      "CurrentDiskSize",
+								GoType:      "InstanceCurrentDiskSize",
+								ReadOnly:    true,
+								Description: "Output only. The current disk usage of the instance in bytes. This property has been deprecated. Use the \"cloudsql.googleapis.com/database/disk/bytes_used\" metric in Cloud Monitoring API instead.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"value": &dcl.Property{
+										Type:        "integer",
+										Format:      "int64",
+										GoName:      "Value",
+										ReadOnly:    true,
+										Description: "The value of the field.",
+										Immutable:   true,
+									},
+								},
+							},
+							"databaseVersion": &dcl.Property{
+								Type:        "string",
+								GoName:      "DatabaseVersion",
+								GoType:      "InstanceDatabaseVersionEnum",
+								Description: "The database engine type and version. The `databaseVersion` field cannot be changed after instance creation. Possible values: SQL_DATABASE_VERSION_UNSPECIFIED, OBSOLETE_MYSQL_5_0, MYSQL_5_1, MYSQL_5_5, MARIADB_10_0, MYSQL_5_6, MYSQL_5_7, OBSOLETE_POSTGRES_9_5, SQLSERVER_ENTERPRISE_2016, POSTGRES_9_6, POSTGRES_11, SQLSERVER_2017_STANDARD, SQLSERVER_2016_STANDARD, MIMIR, SQLSERVER_2017_ENTERPRISE, SQLSERVER_2017_EXPRESS, SQLSERVER_2017_WEB, POSTGRES_TIER_1, POSTGRES_10, POSTGRES_12, MYSQL_8_0, AGBALA",
+								Immutable:   true,
+								Enum: []string{
+									"SQL_DATABASE_VERSION_UNSPECIFIED",
+									"OBSOLETE_MYSQL_5_0",
+									"MYSQL_5_1",
+									"MYSQL_5_5",
+									"MARIADB_10_0",
+									"MYSQL_5_6",
+									"MYSQL_5_7",
+									"OBSOLETE_POSTGRES_9_5",
+									"SQLSERVER_ENTERPRISE_2016",
+									"POSTGRES_9_6",
+									"POSTGRES_11",
+									"SQLSERVER_2017_STANDARD",
+									"SQLSERVER_2016_STANDARD",
+									"MIMIR",
+									"SQLSERVER_2017_ENTERPRISE",
+									"SQLSERVER_2017_EXPRESS",
+									"SQLSERVER_2017_WEB",
+									"POSTGRES_TIER_1",
+									"POSTGRES_10",
+									"POSTGRES_12",
+									"MYSQL_8_0",
+									"AGBALA",
+								},
+							},
+							"diskEncryptionConfiguration": &dcl.Property{
+								Type:        "object",
+								GoName:      "DiskEncryptionConfiguration",
+								GoType:      "InstanceDiskEncryptionConfiguration",
+								Description: "Disk encryption configuration specific to an instance. Applies only to Second Generation instances.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"kind": &dcl.Property{
+										Type:        "string",
+										GoName:      "Kind",
+										Description: "This is always `sql#diskEncryptionConfiguration`.",
+										Immutable:   true,
+									},
+									"kmsKeyName": &dcl.Property{
+										Type:        "string",
+										GoName:      "KmsKeyName",
+										Description: "Resource name of KMS key for disk encryption",
+										Immutable:   true,
+									},
+								},
+							},
+							"etag": &dcl.Property{
+								Type:        "string",
+								GoName:      "Etag",
+								ReadOnly:    true,
+								Description: "Output only. This field is deprecated and will be removed from a future version of the API. Use the `settings.settingsVersion` field instead.",
+								Immutable:   true,
+							},
+							"failoverReplica": &dcl.Property{
+								Type:        "object",
+								GoName:      "FailoverReplica",
+								GoType:      "InstanceFailoverReplica",
+								Description: "The name and status of the failover replica. This property is applicable only to Second Generation instances.",
+								Properties: map[string]*dcl.Property{
+									"available": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "Available",
+										ReadOnly:    true,
+										Description: "Output only. The availability status of the failover replica. A false status indicates that the failover replica is out of sync. The primary instance can only failover to the failover replica when the status is true.",
+										Immutable:   true,
+									},
+									"name": &dcl.Property{
+										Type:        "string",
+										GoName:      "Name",
+										Description: "The name of the failover replica. If specified at instance creation, a failover replica is created for the instance. The name doesn't include the project ID. This property is applicable only to Second Generation instances.",
+									},
+								},
+							},
+							"gceZone": &dcl.Property{
+								Type:        "string",
+								GoName:      "GceZone",
+								ReadOnly:    true,
+								Description: "Output only. The Compute Engine zone that the instance is currently serving from. This value could be different from the zone that was specified when the instance was created if the instance has failed over to its secondary zone.",
+								Immutable:   true,
+							},
+							"instanceType": &dcl.Property{
+								Type:          "string",
+								GoName:        "InstanceType",
+								GoType:        "InstanceInstanceTypeEnum",
+								Description:   "The instance type. This can be one of the following: `CLOUD_SQL_INSTANCE`: A Cloud SQL instance that is not replicating from a primary instance. `ON_PREMISES_INSTANCE`: An instance running on the customer's premises. `READ_REPLICA_INSTANCE`: A Cloud SQL instance configured as a read-replica. Possible values: SQL_INSTANCE_TYPE_UNSPECIFIED, CLOUD_SQL_INSTANCE, ON_PREMISES_INSTANCE, READ_REPLICA_INSTANCE, READ_REPLICA_POOL_INSTANCE",
+								Immutable:     true,
+								ServerDefault: true,
+								Enum: []string{
+									"SQL_INSTANCE_TYPE_UNSPECIFIED",
+									"CLOUD_SQL_INSTANCE",
+									"ON_PREMISES_INSTANCE",
+									"READ_REPLICA_INSTANCE",
+									"READ_REPLICA_POOL_INSTANCE",
+								},
+							},
+							"ipAddresses": &dcl.Property{
+								Type:        "array",
+								GoName:      "IPAddresses",
+								ReadOnly:    true,
+								Description: "Output only. The assigned IP addresses for the instance.",
+								Immutable:   true,
+								SendEmpty:   true,
+								ListType:    "list",
+								Items: &dcl.Property{
+									Type:   "object",
+									GoType: "InstanceIPAddresses",
+									Properties: map[string]*dcl.Property{
+										"ipAddress": &dcl.Property{
+											Type:        "string",
+											GoName:      "IPAddress",
+											ReadOnly:    true,
+											Description: "The IP address assigned.",
+											Immutable:   true,
+										},
+										"timeToRetire": &dcl.Property{
+											Type:        "object",
+											GoName:      "TimeToRetire",
+											GoType:      "InstanceIPAddressesTimeToRetire",
+											ReadOnly:    true,
+											Description: "The due time for this IP to be retired. This field is only available when the IP is scheduled to be retired.",
+											Immutable:   true,
+											Properties: map[string]*dcl.Property{
+												"nanos": &dcl.Property{
+													Type:        "integer",
+													Format:      "int64",
+													GoName:      "Nanos",
+													ReadOnly:    true,
+													Description: "Non-negative fractions of a second at nanosecond resolution. Negative second values with fractions must still have non-negative nanos values that count forward in time. Must be from 0 to 999,999,999 inclusive.",
+													Immutable:   true,
+												},
+												"seconds": &dcl.Property{
+													Type:        "integer",
+													Format:      "int64",
+													GoName:      "Seconds",
+													ReadOnly:    true,
+													Description: "Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.",
+													Immutable:   true,
+												},
+											},
+										},
+										"type": &dcl.Property{
+											Type:        "string",
+											GoName:      "Type",
+											GoType:      "InstanceIPAddressesTypeEnum",
+											ReadOnly:    true,
+											Description: "The type of this IP address. A `PRIMARY` address is a public address that can accept incoming connections. A `PRIVATE` address is a private address that can accept incoming connections. An `OUTGOING` address is the source address of connections originating from the instance, if supported. Possible values: SQL_IP_ADDRESS_TYPE_UNSPECIFIED, PRIMARY, OUTGOING, PRIVATE, MIGRATED_1ST_GEN",
+											Immutable:   true,
+											Enum: []string{
+												"SQL_IP_ADDRESS_TYPE_UNSPECIFIED",
+												"PRIMARY",
+												"OUTGOING",
+												"PRIVATE",
+												"MIGRATED_1ST_GEN",
+											},
+										},
+									},
+								},
+							},
+							"masterInstance": &dcl.Property{
+								Type:        "object",
+								GoName:      "MasterInstance",
+								GoType:      "InstanceMasterInstance",
+								Description: "The instance which will act as primary in the replication setup.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"name": &dcl.Property{
+										Type:        "string",
+										GoName:      "Name",
+										Description: "The name of the primary instance. This does not include the project ID.",
+										Immutable:   true,
+									},
+									"region": &dcl.Property{
+										Type:        "string",
+										GoName:      "Region",
+										Description: "The region of the primary instance.",
+										Immutable:   true,
+									},
+								},
+							},
+							"masterInstanceName": &dcl.Property{
+								Type:        "string",
+								GoName:      "MasterInstanceName",
+								Description: "The name of the instance which will act as primary in the replication setup.",
+								Immutable:   true,
+							},
+							"maxDiskSize": &dcl.Property{
+								Type:        "object",
+								GoName:      "MaxDiskSize",
+								GoType:      "InstanceMaxDiskSize",
+								Description: "The maximum disk size of the instance in bytes.",
+								Properties: map[string]*dcl.Property{
+									"value": &dcl.Property{
+										Type:        "integer",
+										Format:      "int64",
+										GoName:      "Value",
+										Description: "The value of the field.",
+									},
+								},
+							},
+							"name": &dcl.Property{
+								Type:        "string",
+								GoName:      "Name",
+								Description: "Name of the Cloud SQL instance. This does not include the project ID.",
+								Immutable:   true,
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "The project for the resource",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"region": &dcl.Property{
+								Type:          "string",
+								GoName:        "Region",
+								Description:   "The geographical region. Defaults to `us-central` or `us-central1` depending on the instance type (First Generation or Second Generation). The region cannot be changed after instance creation.",
+								Immutable:     true,
+								ServerDefault: true,
+							},
+							"replicaConfiguration": &dcl.Property{
+								Type:        "object",
+								GoName:      "ReplicaConfiguration",
+								GoType:      "InstanceReplicaConfiguration",
+								Description: "Configuration specific to failover replicas and read replicas.",
+								Properties: map[string]*dcl.Property{
+									"failoverTarget": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "FailoverTarget",
+										Description: "Specifies if the replica is the failover target. If the field is set to `true` the replica will be designated as a failover replica. In case the primary instance fails, the replica instance will be promoted as the new primary instance. Only one replica can be specified as failover target, and the replica has to be in different zone with the primary instance.",
+									},
+									"kind": &dcl.Property{
+										Type:        "string",
+										GoName:      "Kind",
+										Description: "This is always `sql#replicaConfiguration`.",
+									},
+									"mysqlReplicaConfiguration": &dcl.Property{
+										Type:        "object",
+										GoName:      "MysqlReplicaConfiguration",
+										GoType:      "InstanceReplicaConfigurationMysqlReplicaConfiguration",
+										Description: "MySQL specific configuration when replicating from a MySQL on-premises primary instance. Replication configuration information such as the username, password, certificates, and keys are not stored in the instance metadata. The configuration information is used only to set up the replication connection and is stored by MySQL in a file named `master.info` in the data directory.",
+										Properties: map[string]*dcl.Property{
+											"caCertificate": &dcl.Property{
+												Type:        "string",
+												GoName:      "CaCertificate",
+												Description: "PEM representation of the trusted CA's x509 certificate.",
+											},
+											"clientCertificate": &dcl.Property{
+												Type:        "string",
+												GoName:      "ClientCertificate",
+												Description: "PEM representation of the replica's x509 certificate.",
+											},
+											"clientKey": &dcl.Property{
+												Type:        "string",
+												GoName:      "ClientKey",
+												Description: "PEM representation of the replica's private key. The corresponding public key is encoded in the client's certificate.",
+												Sensitive:   true,
+												Unreadable:  true,
+											},
+											"connectRetryInterval": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "ConnectRetryInterval",
+												Description: "Seconds to wait between connect retries. MySQL's default is 60 seconds.",
+											},
+											"dumpFilePath": &dcl.Property{
+												Type:        "string",
+												GoName:      "DumpFilePath",
+												Description: "Path to a SQL dump file in Google Cloud Storage from which the replica instance is to be created. The URI is in the form gs://bucketName/fileName. Compressed gzip files (.gz) are also supported. Dumps have the binlog co-ordinates from which replication begins. This can be accomplished by setting --master-data to 1 when using mysqldump.",
+											},
+											"kind": &dcl.Property{
+												Type:        "string",
+												GoName:      "Kind",
+												Description: "This is always `sql#mysqlReplicaConfiguration`.",
+											},
+											"masterHeartbeatPeriod": &dcl.Property{
+												Type:        "object",
+												GoName:      "MasterHeartbeatPeriod",
+												GoType:      "InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod",
+												Description: "Interval in milliseconds between replication heartbeats.",
+												Properties: map[string]*dcl.Property{
+													"value": &dcl.Property{
+														Type:        "integer",
+														Format:      "int64",
+														GoName:      "Value",
+														Description: "The value of the field.",
+													},
+												},
+											},
+											"password": &dcl.Property{
+												Type:        "string",
+												GoName:      "Password",
+												Description: "The password for the replication connection.",
+												Sensitive:   true,
+												Unreadable:  true,
+											},
+											"sslCipher": &dcl.Property{
+												Type:        "string",
+												GoName:      "SslCipher",
+												Description: "A list of permissible ciphers to use for SSL encryption.",
+											},
+											"username": &dcl.Property{
+												Type:        "string",
+												GoName:      "Username",
+												Description: "The username for the replication connection.",
+											},
+											"verifyServerCertificate": &dcl.Property{
+												Type:        "boolean",
+												GoName:      "VerifyServerCertificate",
+												Description: "Whether or not to check the primary instance's Common Name value in the certificate that it sends during the SSL handshake.",
+											},
+										},
+									},
+									"replicaPoolConfiguration": &dcl.Property{
+										Type:        "object",
+										GoName:      "ReplicaPoolConfiguration",
+										GoType:      "InstanceReplicaConfigurationReplicaPoolConfiguration",
+										Description: "Configuration specific to read replica pools.",
+										Properties: map[string]*dcl.Property{
+											"autoscalingPoolConfiguration": &dcl.Property{
+												Type:        "object",
+												GoName:      "AutoscalingPoolConfiguration",
+												GoType:      "InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration",
+												Description: "Configuration for an autoscaling pool of read replicas.",
+												Properties: map[string]*dcl.Property{
+													"kind": &dcl.Property{
+														Type:        "string",
+														GoName:      "Kind",
+														Description: "This is always `sql#autoscalingPoolConfiguration`.",
+													},
+													"maxReplicaCount": &dcl.Property{
+														Type:        "integer",
+														Format:      "int64",
+														GoName:      "MaxReplicaCount",
+														Description: "The maximum number of read replicas in the pool.",
+													},
+													"minReplicaCount": &dcl.Property{
+														Type:        "integer",
+														Format:      "int64",
+														GoName:      "MinReplicaCount",
+														Description: "The minimum number of read replicas in the pool.",
+													},
+													"targetCpuUtil": &dcl.Property{
+														Type:        "number",
+														Format:      "double",
+														GoName:      "TargetCpuUtil",
+														Description: "The target CPU utilization of the pool, as a fraction between 0 and 1.",
+													},
+												},
+											},
+											"kind": &dcl.Property{
+												Type:        "string",
+												GoName:      "Kind",
+												Description: "This is always `sql#replicaPoolConfiguration`.",
+											},
+											"staticPoolConfiguration": &dcl.Property{
+												Type:        "object",
+												GoName:      "StaticPoolConfiguration",
+												GoType:      "InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration",
+												Description: "Configuration for a static pool of read replicas.",
+												Properties: map[string]*dcl.Property{
+													"exposeReplicaIp": &dcl.Property{
+														Type:        "boolean",
+														GoName:      "ExposeReplicaIP",
+														Description: "Whether the IP addresses of the individual read replicas are exposed.",
+													},
+													"kind": &dcl.Property{
+														Type:        "string",
+														GoName:      "Kind",
+														Description: "This is always `sql#staticPoolConfiguration`.",
+													},
+													"replicaCount": &dcl.Property{
+														Type:        "integer",
+														Format:      "int64",
+														GoName:      "ReplicaCount",
+														Description: "The number of read replicas in the pool.",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"rootPassword": &dcl.Property{
+								Type:        "string",
+								GoName:      "RootPassword",
+								Description: "Initial root password. Use only on creation.",
+								Immutable:   true,
+								Sensitive:   true,
+								Unreadable:  true,
+							},
+							"scheduledMaintenance": &dcl.Property{
+								Type:        "object",
+								GoName:      "ScheduledMaintenance",
+								GoType:      "InstanceScheduledMaintenance",
+								ReadOnly:    true,
+								Description: "Output only. The start time of any upcoming scheduled maintenance for this instance.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"canDefer": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "CanDefer",
+										ReadOnly:    true,
+										Description: "Whether the scheduled maintenance can be deferred.",
+										Immutable:   true,
+									},
+									"canReschedule": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "CanReschedule",
+										ReadOnly:    true,
+										Description: "Whether the scheduled maintenance can be rescheduled.",
+										Immutable:   true,
+									},
+									"startTime": &dcl.Property{
+										Type:        "object",
+										GoName:      "StartTime",
+										GoType:      "InstanceScheduledMaintenanceStartTime",
+										ReadOnly:    true,
+										Description: "The start time of any upcoming scheduled maintenance for this instance.",
+										Immutable:   true,
+										Properties: map[string]*dcl.Property{
+											"nanos": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "Nanos",
+												ReadOnly:    true,
+												Description: "Non-negative fractions of a second at nanosecond resolution. Negative second values with fractions must still have non-negative nanos values that count forward in time. Must be from 0 to 999,999,999 inclusive.",
+												Immutable:   true,
+											},
+											"seconds": &dcl.Property{
+												Type:        "integer",
+												Format:      "int64",
+												GoName:      "Seconds",
+												ReadOnly:    true,
+												Description: "Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.",
+												Immutable:   true,
+											},
+										},
+									},
+								},
+							},
+							"settings": &dcl.Property{
+								Type:        "object",
+								GoName:      "Settings",
+								GoType:      "InstanceSettings",
+								Description: "The user settings.",
+								Required: []string{
+									"tier",
+								},
+								Properties: map[string]*dcl.Property{
+									"activationPolicy": &dcl.Property{
+										Type:        "string",
+										GoName:      "ActivationPolicy",
+										GoType:      "InstanceSettingsActivationPolicyEnum",
+										Description: "The activation policy specifies when the instance is activated; it is applicable only when the instance state is `RUNNABLE`. Valid values: `ALWAYS`: The instance is on, and remains so even in the absence of connection requests. `NEVER`: The instance is off; it is not activated, even if a connection request arrives. Possible values: SQL_ACTIVATION_POLICY_UNSPECIFIED, ALWAYS, NEVER, ON_DEMAND",
+										Enum: []string{
+											"SQL_ACTIVATION_POLICY_UNSPECIFIED",
+											"ALWAYS",
+											"NEVER",
+											"ON_DEMAND",
+										},
+									},
+									"authorizedGaeApplications": &dcl.Property{
+										Type:        "array",
+										GoName:      "AuthorizedGaeApplications",
+										Description: "The App Engine app IDs that can access this instance. (Deprecated) Applied to First Generation instances only.",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "string",
+											GoType: "string",
+										},
+									},
+									"availabilityType": &dcl.Property{
+										Type:        "string",
+										GoName:      "AvailabilityType",
+										GoType:      "InstanceSettingsAvailabilityTypeEnum",
+										Description: "Availability type. Potential values: `ZONAL`: The instance serves data from only one zone. Outages in that zone affect data accessibility. `REGIONAL`: The instance can serve data from more than one zone in a region (it is highly available). For more information, see [Overview of the High Availability Configuration](https://cloud.google.com/sql/docs/mysql/high-availability). Possible values: SQL_AVAILABILITY_TYPE_UNSPECIFIED, ZONAL, REGIONAL",
+										Enum: []string{
+											"SQL_AVAILABILITY_TYPE_UNSPECIFIED",
+											"ZONAL",
+											"REGIONAL",
+										},
+									},
+									"crashSafeReplicationEnabled": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "CrashSafeReplicationEnabled",
+										Description: "Configuration specific to read replica instances. Indicates whether database flags for crash-safe replication are enabled. This property was only applicable to First Generation instances.",
+									},
+									"dataDiskType": &dcl.Property{
+										Type:        "string",
+										GoName:      "DataDiskType",
+										GoType:      "InstanceSettingsDataDiskTypeEnum",
+										Description: "The type of data disk: `PD_SSD` (default) or `PD_HDD`. Not used for First Generation instances. Possible values: SQL_DATA_DISK_TYPE_UNSPECIFIED, PD_SSD, PD_HDD, OBSOLETE_LOCAL_SSD",
+										Enum: []string{
+											"SQL_DATA_DISK_TYPE_UNSPECIFIED",
+											"PD_SSD",
+											"PD_HDD",
+											"OBSOLETE_LOCAL_SSD",
+										},
+									},
+									"databaseReplicationEnabled": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "DatabaseReplicationEnabled",
+										Description: "Configuration specific to read replica instances. Indicates whether replication is enabled or not.",
+									},
+									"kind": &dcl.Property{
+										Type:        "string",
+										GoName:      "Kind",
+										Description: "This is always `sql#settings`.",
+									},
+									"pricingPlan": &dcl.Property{
+										Type:        "string",
+										GoName:      "PricingPlan",
+										GoType:      "InstanceSettingsPricingPlanEnum",
+										Description: "The pricing plan for this instance. This can be either `PER_USE` or `PACKAGE`. Only `PER_USE` is supported for Second Generation instances. Possible values: SQL_PRICING_PLAN_UNSPECIFIED, PACKAGE, PER_USE",
+										Enum: []string{
+											"SQL_PRICING_PLAN_UNSPECIFIED",
+											"PACKAGE",
+											"PER_USE",
+										},
+									},
+									"replicationType": &dcl.Property{
+										Type:        "string",
+										GoName:      "ReplicationType",
+										GoType:      "InstanceSettingsReplicationTypeEnum",
+										Description: "The type of replication this instance uses. This can be either `ASYNCHRONOUS` or `SYNCHRONOUS`. (Deprecated) This property was only applicable to First Generation instances. Possible values: SQL_REPLICATION_TYPE_UNSPECIFIED, SYNCHRONOUS, ASYNCHRONOUS",
+										Enum: []string{
+											"SQL_REPLICATION_TYPE_UNSPECIFIED",
+											"SYNCHRONOUS",
+											"ASYNCHRONOUS",
+										},
+									},
+									"storageAutoResize": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "StorageAutoResize",
+										Description: "Configuration to increase storage size automatically. The default value is true.",
+									},
+									"tier": &dcl.Property{
+										Type:        "string",
+										GoName:      "Tier",
+										Description: "The tier (or machine type) for this instance, for example `db-custom-1-3840`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
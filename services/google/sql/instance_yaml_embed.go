@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package sql -var YAML_instance blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/sql/instance.yaml
+
+package sql
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/sql/instance.yaml
+var YAML_instance = []byte("info:\n  title: Sql/Instance\n  description: The Sql Instance resource\n  x-dcl-struct-name: Instance\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a Instance\n    parameters:\n    - name: instance\n      required: true\n      description: A full instance of a Instance\n  apply:\n    description: The function used to apply information about a Instance\n    parameters:\n    - name: instance\n      required: true\n      description: A full instance of a Instance\n  delete:\n    description: The function used to delete a Instance\n    parameters:\n    - name: instance\n      required: true\n      description: A full instance of a Instance\n  deleteAll:\n    description: The function used to delete all Instance\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many Instance\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    Instance:\n      title: Instance\n      x-dcl-id: projects/{{project}}/instances/{{name}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - name\n      - project\n      properties:\n        backendType:\n          type: string\n          x-dcl-go-name: BackendType\n          x-dcl-go-type: InstanceBackendTypeEnum\n          description: 'The backend type. `SECOND_GEN`: Cloud SQL database instance.\n            `EXTERNAL`: A database server that is not managed by Google. This property\n            is read-only; use the `tier` property in the `settings` object to determine\n            the database type. Possible values: SQL_BACKEND_TYPE_UNSPECIFIED, FIRST_GEN,\n            SECOND_GEN, EXTERNAL'\n          x-dcl-server-default: true\n          enum:\n          - SQL_BACKEND_TYPE_UNSPECIFIED\n          - FIRST_GEN\n          - SECOND_GEN\n          - EXTERNAL\n        connectionName:\n          type: string\n          x-dcl-go-name: ConnectionName\n          readOnly: true\n          description: Output only. Connection name of the Cloud SQL instance used\n            in connection strings.\n          x-kubernetes-immutable: true\n        currentDiskSize:\n          type: object\n          x-dcl-go-name: CurrentDiskSize\n          x-dcl-go-type: InstanceCurrentDiskSize\n          readOnly: true\n          description: Output only. The current disk usage of the instance in bytes.\n            This property has been deprecated. Use the \"cloudsql.googleapis.com/database/disk/bytes_used\"\n            metric in Cloud Monitoring API instead.\n          x-kubernetes-immutable: true\n          properties:\n            value:\n              type: integer\n              format: int64\n              x-dcl-go-name: Value\n              readOnly: true\n              description: The value of the field.\n              x-kubernetes-immutable: true\n        databaseVersion:\n          type: string\n          x-dcl-go-name: DatabaseVersion\n          x-dcl-go-type: InstanceDatabaseVersionEnum\n          description: 'The database engine type and version. The `databaseVersion`\n            field cannot be changed after instance creation. Possible values: SQL_DATABASE_VERSION_UNSPECIFIED,\n            OBSOLETE_MYSQL_5_0, MYSQL_5_1, MYSQL_5_5, MARIADB_10_0, MYSQL_5_6, MYSQL_5_7,\n            OBSOLETE_POSTGRES_9_5, SQLSERVER_ENTERPRISE_2016, POSTGRES_9_6, POSTGRES_11,\n            SQLSERVER_2017_STANDARD, SQLSERVER_2016_STANDARD, MIMIR, SQLSERVER_2017_ENTERPRISE,\n            SQLSERVER_2017_EXPRESS, SQLSERVER_2017_WEB, POSTGRES_TIER_1, POSTGRES_10,\n            POSTGRES_12, MYSQL_8_0, AGBALA'\n          x-kubernetes-immutable: true\n          enum:\n          - SQL_DATABASE_VERSION_UNSPECIFIED\n          - OBSOLETE_MYSQL_5_0\n          - MYSQL_5_1\n          - MYSQL_5_5\n          - MARIADB_10_0\n          - MYSQL_5_6\n          - MYSQL_5_7\n          - OBSOLETE_POSTGRES_9_5\n          - SQLSERVER_ENTERPRISE_2016\n          - POSTGRES_9_6\n          - POSTGRES_11\n          - SQLSERVER_2017_STANDARD\n          - SQLSERVER_2016_STANDARD\n          - MIMIR\n          - SQLSERVER_2017_ENTERPRISE\n          - SQLSERVER_2017_EXPRESS\n          - SQLSERVER_2017_WEB\n          - POSTGRES_TIER_1\n          - POSTGRES_10\n          - POSTGRES_12\n          - MYSQL_8_0\n          - AGBALA\n        diskEncryptionConfiguration:\n          type: object\n          x-dcl-go-name: DiskEncryptionConfiguration\n          x-dcl-go-type: InstanceDiskEncryptionConfiguration\n          description: Disk encryption configuration specific to an instance. Applies\n            only to Second Generation instances.\n          x-kubernetes-immutable: true\n          properties:\n            kind:\n              type: string\n              x-dcl-go-name: Kind\n              description: This is always `sql#diskEncryptionConfiguration`.\n              x-kubernetes-immutable: true\n            kmsKeyName:\n              type: string\n              x-dcl-go-name: KmsKeyName\n              description: Resource name of KMS key for disk encryption\n              x-kubernetes-immutable: true\n        etag:\n          type: string\n          x-dcl-go-name: Etag\n          readOnly: true\n          description: Output only. This field is deprecated and will be removed from\n            a future version of the API. Use the `settings.settingsVersion` field\n            instead.\n          x-kubernetes-immutable: true\n        failoverReplica:\n          type: object\n          x-dcl-go-name: FailoverReplica\n          x-dcl-go-type: InstanceFailoverReplica\n          description: The name and status of the failover replica. This property\n            is applicable only to Second Generation instances.\n          properties:\n            available:\n              type: boolean\n              x-dcl-go-name: Available\n              readOnly: true\n              description: Output only. The availability status of the failover replica.\n                A false status indicates that the failover replica is out of sync.\n                The primary instance can only failover to the failover replica when\n                the status is true.\n              x-kubernetes-immutable: true\n            name:\n              type: string\n              x-dcl-go-name: Name\n              description: The name of the failover replica. If specified at instance\n                creation, a failover replica is created for the instance. The name\n                doesn't include the project ID. This property is applicable only to\n                Second Generation instances.\n        gceZone:\n          type: string\n          x-dcl-go-name: GceZone\n          readOnly: true\n          description: Output only. The Compute Engine zone that the instance is currently\n            serving from. This value could be different from the zone that was specified\n            when the instance was created if the instance has failed over to its secondary\n            zone.\n          x-kubernetes-immutable: true\n        instanceType:\n          type: string\n          x-dcl-go-name: InstanceType\n          x-dcl-go-type: InstanceInstanceTypeEnum\n          description: 'The instance type. This can be one of the following: `CLOUD_SQL_INSTANCE`:\n            A Cloud SQL instance that is not replicating from a primary instance.\n            `ON_PREMISES_INSTANCE`: An instance running on the customer''s premises.\n            `READ_REPLICA_INSTANCE`: A Cloud SQL instance configured as a read-replica.\n            Possible values: SQL_INSTANCE_TYPE_UNSPECIFIED, CLOUD_SQL_INSTANCE, ON_PREMISES_INSTANCE,\n            READ_REPLICA_INSTANCE, READ_REPLICA_POOL_INSTANCE'\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n          enum:\n          - SQL_INSTANCE_TYPE_UNSPECIFIED\n          - CLOUD_SQL_INSTANCE\n          - ON_PREMISES_INSTANCE\n          - READ_REPLICA_INSTANCE\n          - READ_REPLICA_POOL_INSTANCE\n        ipAddresses:\n          type: array\n          x-dcl-go-name: IPAddresses\n          readOnly: true\n          description: Output only. The assigned IP addresses for the instance.\n          x-kubernetes-immutable: true\n          x-dcl-send-empty: true\n          x-dcl-list-type: list\n          items:\n            type: object\n            x-dcl-go-type: InstanceIPAddresses\n            properties:\n              ipAddress:\n                type: string\n                x-dcl-go-name: IPAddress\n                readOnly: true\n                description: The IP address assigned.\n                x-kubernetes-immutable: true\n              timeToRetire:\n                type: object\n                x-dcl-go-name: TimeToRetire\n                x-dcl-go-type: InstanceIPAddressesTimeToRetire\n                readOnly: true\n                description: The due time for this IP to be retired. This field is\n                  only available when the IP is scheduled to be retired.\n                x-kubernetes-immutable: true\n                properties:\n                  nanos:\n                    type: integer\n                    format: int64\n                    x-dcl-go-name: Nanos\n                    readOnly: true\n                    description: Non-negative fractions of a second at nanosecond\n                      resolution. Negative second values with fractions must still\n                      have non-negative nanos values that count forward in time. Must\n                      be from 0 to 999,999,999 inclusive.\n                    x-kubernetes-immutable: true\n                  seconds:\n                    type: integer\n                    format: int64\n                    x-dcl-go-name: Seconds\n                    readOnly: true\n                    description: Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z.\n                      Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.\n                    x-kubernetes-immutable: true\n              type:\n                type: string\n                x-dcl-go-name: Type\n                x-dcl-go-type: InstanceIPAddressesTypeEnum\n                readOnly: true\n                description: 'The type of this IP address. A `PRIMARY` address is\n                  a public address that can accept incoming connections. A `PRIVATE`\n                  address is a private address that can accept incoming connections.\n                  An `OUTGOING` address is the source address of connections originating\n                  from the instance, if supported. Possible values: SQL_IP_ADDRESS_TYPE_UNSPECIFIED,\n                  PRIMARY, OUTGOING, PRIVATE, MIGRATED_1ST_GEN'\n                x-kubernetes-immutable: true\n                enum:\n                - SQL_IP_ADDRESS_TYPE_UNSPECIFIED\n                - PRIMARY\n                - OUTGOING\n                - PRIVATE\n                - MIGRATED_1ST_GEN\n        masterInstance:\n          type: object\n          x-dcl-go-name: MasterInstance\n          x-dcl-go-type: InstanceMasterInstance\n          description: The instance which will act as primary in the replication setup.\n          x-kubernetes-immutable: true\n          properties:\n            name:\n              type: string\n              x-dcl-go-name: Name\n              description: The name of the primary instance. This does not include\n                the project ID.\n              x-kubernetes-immutable: true\n            region:\n              type: string\n              x-dcl-go-name: Region\n              description: The region of the primary instance.\n              x-kubernetes-immutable: true\n        masterInstanceName:\n          type: string\n          x-dcl-go-name: MasterInstanceName\n          description: The name of the instance which will act as primary in the replication\n            setup.\n          x-kubernetes-immutable: true\n        maxDiskSize:\n          type: object\n          x-dcl-go-name: MaxDiskSize\n          x-dcl-go-type: InstanceMaxDiskSize\n          description: The maximum disk size of the instance in bytes.\n          properties:\n            value:\n              type: integer\n              format: int64\n              x-dcl-go-name: Value\n              description: The value of the field.\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: Name of the Cloud SQL instance. This does not include the project\n            ID.\n          x-kubernetes-immutable: true\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: The project for the resource\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        region:\n          type: string\n          x-dcl-go-name: Region\n          description: The geographical region. Defaults to `us-central` or `us-central1`\n            depending on the instance type (First Generation or Second Generation).\n            The region cannot be changed after instance creation.\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n        replicaConfiguration:\n          type: object\n          x-dcl-go-name: ReplicaConfiguration\n          x-dcl-go-type: InstanceReplicaConfiguration\n          description: Configuration specific to failover replicas and read replicas.\n          properties:\n            failoverTarget:\n              type: boolean\n              x-dcl-go-name: FailoverTarget\n              description: Specifies if the replica is the failover target. If the\n                field is set to `true` the replica will be designated as a failover\n                replica. In case the primary instance fails, the replica instance\n                will be promoted as the new primary instance. Only one replica can\n                be specified as failover target, and the replica has to be in different\n                zone with the primary instance.\n            kind:\n              type: string\n              x-dcl-go-name: Kind\n              description: This is always `sql#replicaConfiguration`.\n            mysqlReplicaConfiguration:\n              type: object\n              x-dcl-go-name: MysqlReplicaConfiguration\n              x-dcl-go-type: InstanceReplicaConfigurationMysqlReplicaConfiguration\n              description: MySQL specific configuration when replicating from a MySQL\n                on-premises primary instance. Replication configuration information\n                such as the username, password, certificates, and keys are not stored\n                in the instance metadata. The configuration information is used only\n                to set up the replication connection and is stored by MySQL in a file\n                named `master.info` in the data directory.\n              properties:\n                caCertificate:\n                  type: string\n                  x-dcl-go-name: CaCertificate\n                  description: PEM representation of the trusted CA's x509 certificate.\n                clientCertificate:\n                  type: string\n                  x-dcl-go-name: ClientCertificate\n                  description: PEM representation of the replica's x509 certificate.\n                clientKey:\n                  type: string\n                  x-dcl-go-name: ClientKey\n                  description: PEM representation of the replica's private key. The\n                    corresponding public key is encoded in the client's certificate.\n                  x-dcl-sensitive: true\n                  x-dcl-mutable-unreadable: true\n                connectRetryInterval:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: ConnectRetryInterval\n                  description: Seconds to wait between connect retries. MySQL's default\n                    is 60 seconds.\n                dumpFilePath:\n                  type: string\n                  x-dcl-go-name: DumpFilePath\n                  description: Path to a SQL dump file in Google Cloud Storage from\n                    which the replica instance is to be created. The URI is in the\n                    form gs://bucketName/fileName. Compressed gzip files (.gz) are\n                    also supported. Dumps have the binlog co-ordinates from which\n                    replication begins. This can be accomplished by setting --master-data\n                    to 1 when using mysqldump.\n                kind:\n                  type: string\n                  x-dcl-go-name: Kind\n                  description: This is always `sql#mysqlReplicaConfiguration`.\n                masterHeartbeatPeriod:\n                  type: object\n                  x-dcl-go-name: MasterHeartbeatPeriod\n                  x-dcl-go-type: InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod\n                  description: Interval in milliseconds between replication heartbeats.\n                  properties:\n                    value:\n                      type: integer\n                      format: int64\n                      x-dcl-go-name: Value\n                      description: The value of the field.\n                password:\n                  type: string\n                  x-dcl-go-name: Password\n                  description: The password for the replication connection.\n                  x-dcl-sensitive: true\n                  x-dcl-mutable-unreadable: true\n                sslCipher:\n                  type: string\n                  x-dcl-go-name: SslCipher\n                  description: A list of permissible ciphers to use for SSL encryption.\n                username:\n                  type: string\n                  x-dcl-go-name: Username\n                  description: The username for the replication connection.\n                verifyServerCertificate:\n                  type: boolean\n                  x-dcl-go-name: VerifyServerCertificate\n                  description: Whether or not to check the primary instance's Common\n                    Name value in the certificate that it sends during the SSL handshake.\n            replicaPoolConfiguration:\n              type: object\n              x-dcl-go-name: ReplicaPoolConfiguration\n              x-dcl-go-type: InstanceReplicaConfigurationReplicaPoolConfiguration\n              description: Configuration specific to read replica pools.\n              properties:\n                autoscalingPoolConfiguration:\n                  type: object\n                  x-dcl-go-name: AutoscalingPoolConfiguration\n                  x-dcl-go-type: InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration\n                  description: Configuration for an autoscaling pool of read replicas.\n                  properties:\n                    kind:\n                      type: string\n                      x-dcl-go-name: Kind\n                      description: This is always `sql#autoscalingPoolConfiguration`.\n                    maxReplicaCount:\n                      type: integer\n                      format: int64\n                      x-dcl-go-name: MaxReplicaCount\n                      description: The maximum number of read replicas in the pool.\n                    minReplicaCount:\n                      type: integer\n                      format: int64\n                      x-dcl-go-name: MinReplicaCount\n                      description: The minimum number of read replicas in the pool.\n                    targetCpuUtil:\n                      type: number\n                      format: double\n                      x-dcl-go-name: TargetCpuUtil\n                      description: The target CPU utilization of the pool, as a fraction\n                        between 0 and 1.\n                kind:\n                  type: string\n                  x-dcl-go-name: Kind\n                  description: This is always `sql#replicaPoolConfiguration`.\n                staticPoolConfiguration:\n                  type: object\n                  x-dcl-go-name: StaticPoolConfiguration\n                  x-dcl-go-type: InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration\n                  description: Configuration for a static pool of read replicas.\n                  properties:\n                    exposeReplicaIp:\n                      type: boolean\n                      x-dcl-go-name: ExposeReplicaIP\n                      description: Whether the IP addresses of the individual read\n                        replicas are exposed.\n                    kind:\n                      type: string\n                      x-dcl-go-name: Kind\n                      description: This is always `sql#staticPoolConfiguration`.\n                    replicaCount:\n                      type: integer\n                      format: int64\n                      x-dcl-go-name: ReplicaCount\n                      description: The number of read replicas in the pool.\n        rootPassword:\n          type: string\n          x-dcl-go-name: RootPassword\n          description: Initial root password. Use only on creation.\n          x-kubernetes-immutable: true\n          x-dcl-sensitive: true\n          x-dcl-mutable-unreadable: true\n        scheduledMaintenance:\n          type: object\n          x-dcl-go-name: ScheduledMaintenance\n          x-dcl-go-type: InstanceScheduledMaintenance\n          readOnly: true\n          description: Output only. The start time of any upcoming scheduled maintenance\n            for this instance.\n          x-kubernetes-immutable: true\n          properties:\n            canDefer:\n              type: boolean\n              x-dcl-go-name: CanDefer\n              readOnly: true\n              description: Whether the scheduled maintenance can be deferred.\n              x-kubernetes-immutable: true\n            canReschedule:\n              type: boolean\n              x-dcl-go-name: CanReschedule\n              readOnly: true\n              description: Whether the scheduled maintenance can be rescheduled.\n              x-kubernetes-immutable: true\n            startTime:\n              type: object\n              x-dcl-go-name: StartTime\n              x-dcl-go-type: InstanceScheduledMaintenanceStartTime\n              readOnly: true\n              description: The start time of any upcoming scheduled maintenance for\n                this instance.\n              x-kubernetes-immutable: true\n              properties:\n                nanos:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: Nanos\n                  readOnly: true\n                  description: Non-negative fractions of a second at nanosecond resolution.\n                    Negative second values with fractions must still have non-negative\n                    nanos values that count forward in time. Must be from 0 to 999,999,999\n                    inclusive.\n                  x-kubernetes-immutable: true\n                seconds:\n                  type: integer\n                  format: int64\n                  x-dcl-go-name: Seconds\n                  readOnly: true\n                  description: Represents seconds of UTC time since Unix epoch 1970-01-01T00:00:00Z.\n                    Must be from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59Z inclusive.\n                  x-kubernetes-immutable: true\n        settings:\n          type: object\n          x-dcl-go-name: Settings\n          x-dcl-go-type: InstanceSettings\n          description: The user settings.\n          required:\n          - tier\n          properties:\n            activationPolicy:\n              type: string\n              x-dcl-go-name: ActivationPolicy\n              x-dcl-go-type: InstanceSettingsActivationPolicyEnum\n              description: 'The activation policy specifies when the instance is activated;\n                it is applicable only when the instance state is `RUNNABLE`. Valid\n                values: `ALWAYS`: The instance is on, and remains so even in the absence\n                of connection requests. `NEVER`: The instance is off; it is not activated,\n                even if a connection request arrives. Possible values: SQL_ACTIVATION_POLICY_UNSPECIFIED,\n                ALWAYS, NEVER, ON_DEMAND'\n              enum:\n              - SQL_ACTIVATION_POLICY_UNSPECIFIED\n              - ALWAYS\n              - NEVER\n              - ON_DEMAND\n            authorizedGaeApplications:\n              type: array\n              x-dcl-go-name: AuthorizedGaeApplications\n              description: The App Engine app IDs that can access this instance. (Deprecated)\n                Applied to First Generation instances only.\n              x-dcl-send-empty: true\n              x-dcl-list-type: list\n              items:\n                type: string\n                x-dcl-go-type: string\n            availabilityType:\n              type: string\n              x-dcl-go-name: AvailabilityType\n              x-dcl-go-type: InstanceSettingsAvailabilityTypeEnum\n              description: 'Availability type. Potential values: `ZONAL`: The instance\n                serves data from only one zone. Outages in that zone affect data accessibility.\n                `REGIONAL`: The instance can serve data from more than one zone in\n                a region (it is highly available). For more information, see [Overview\n                of the High Availability Configuration](https://cloud.google.com/sql/docs/mysql/high-availability).\n                Possible values: SQL_AVAILABILITY_TYPE_UNSPECIFIED, ZONAL, REGIONAL'\n              enum:\n              - SQL_AVAILABILITY_TYPE_UNSPECIFIED\n              - ZONAL\n              - REGIONAL\n            crashSafeReplicationEnabled:\n              type: boolean\n              x-dcl-go-name: CrashSafeReplicationEnabled\n              description: Configuration specific to read replica instances. Indicates\n                whether database flags for crash-safe replication are enabled. This\n                property was only applicable to First Generation instances.\n            dataDiskType:\n              type: string\n              x-dcl-go-name: DataDiskType\n              x-dcl-go-type: InstanceSettingsDataDiskTypeEnum\n              description: 'The type of data disk: `PD_SSD` (default) or `PD_HDD`.\n                Not used for First Generation instances. Possible values: SQL_DATA_DISK_TYPE_UNSPECIFIED,\n                PD_SSD, PD_HDD, OBSOLETE_LOCAL_SSD'\n              enum:\n              - SQL_DATA_DISK_TYPE_UNSPECIFIED\n              - PD_SSD\n              - PD_HDD\n              - OBSOLETE_LOCAL_SSD\n            databaseReplicationEnabled:\n              type: boolean\n              x-dcl-go-name: DatabaseReplicationEnabled\n              description: Configuration specific to read replica instances. Indicates\n                whether replication is enabled or not.\n            kind:\n              type: string\n              x-dcl-go-name: Kind\n              description: This is always `sql#settings`.\n            pricingPlan:\n              type: string\n              x-dcl-go-name: PricingPlan\n              x-dcl-go-type: InstanceSettingsPricingPlanEnum\n              description: 'The pricing plan for this instance. This can be either\n                `PER_USE` or `PACKAGE`. Only `PER_USE` is supported for Second Generation\n                instances. Possible values: SQL_PRICING_PLAN_UNSPECIFIED, PACKAGE,\n                PER_USE'\n              enum:\n              - SQL_PRICING_PLAN_UNSPECIFIED\n              - PACKAGE\n              - PER_USE\n            replicationType:\n              type: string\n              x-dcl-go-name: ReplicationType\n              x-dcl-go-type: InstanceSettingsReplicationTypeEnum\n              description: 'The type of replication this instance uses. This can be\n                either `ASYNCHRONOUS` or `SYNCHRONOUS`. (Deprecated) This property\n                was only applicable to First Generation instances. Possible values:\n                SQL_REPLICATION_TYPE_UNSPECIFIED, SYNCHRONOUS, ASYNCHRONOUS'\n              enum:\n              - SQL_REPLICATION_TYPE_UNSPECIFIED\n              - SYNCHRONOUS\n              - ASYNCHRONOUS\n            storageAutoResize:\n              type: boolean\n              x-dcl-go-name: StorageAutoResize\n              description: Configuration to increase storage size automatically. The\n                default value is true.\n            tier:\n              type: string\n              x-dcl-go-name: Tier\n              description: The tier (or machine type) for this instance, for example\n                `db-custom-1-3840`.\n")
+
+// 28563 bytes
+// MD5: b2c39e75b87a0bbab0240c21515f3b6b
@@ -0,0 +1,506 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package sql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"google.golang.org/api/googleapi"
+)
+
+type User struct {
+	Name                 *string                   `json:"name"`
+	Password             *string                   `json:"password"`
+	Project              *string                   `json:"project"`
+	Instance             *string                   `json:"instance"`
+	SqlserverUserDetails *UserSqlserverUserDetails `json:"sqlserverUserDetails"`
+	Type                 *UserTypeEnum             `json:"type"`
+	Etag                 *string                   `json:"etag"`
+	Host                 *string                   `json:"host"`
+}
+
+func (r *User) String() string {
+	return dcl.SprintResource(r)
+}
+
+// The enum UserTypeEnum.
+type UserTypeEnum string
+
+// UserTypeEnumRef returns a *UserTypeEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func UserTypeEnumRef(s string) *UserTypeEnum {
+	v := UserTypeEnum(s)
+	return &v
+}
+
+func (v UserTypeEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"NATIVE", "CLOUD_IAM_USER", "CLOUD_IAM_SERVICE_ACCOUNT"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "UserTypeEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+type UserSqlserverUserDetails struct {
+	empty       bool     `json:"-"`
+	Disabled    *bool    `json:"disabled"`
+	ServerRoles []string `json:"serverRoles"`
+}
+
+type jsonUserSqlserverUserDetails UserSqlserverUserDetails
+
+func (r *UserSqlserverUserDetails) UnmarshalJSON(data []byte) error {
+	var res jsonUserSqlserverUserDetails
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyUserSqlserverUserDetails
+	} else {
+
+		r.Disabled = res.Disabled
+
+		r.ServerRoles = res.ServerRoles
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this UserSqlserverUserDetails is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyUserSqlserverUserDetails *UserSqlserverUserDetails = &UserSqlserverUserDetails{empty: true}
+
+func (r *UserSqlserverUserDetails) Empty() bool {
+	return r.empty
+}
+
+func (r *UserSqlserverUserDetails) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *UserSqlserverUserDetails) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+// Describe returns a simple description of this resource to ensure that automated tools
+// can identify it.
+func (r *User) Describe() dcl.ServiceTypeVersion {
+	return dcl.ServiceTypeVersion{
+		Service: "sql",
+		Type:    "User",
+		Version: "sql",
+	}
+}
+
+func (r *User) ID() (string, error) {
+	if err := extractUserFields(r); err != nil {
+		return "", err
+	}
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"name":                   dcl.ValueOrEmptyString(nr.Name),
+		"password":               dcl.ValueOrEmptyString(nr.Password),
+		"project":                dcl.ValueOrEmptyString(nr.Project),
+		"instance":               dcl.ValueOrEmptyString(nr.Instance),
+		"sqlserver_user_details": dcl.ValueOrEmptyString(nr.SqlserverUserDetails),
+		"type":                   dcl.ValueOrEmptyString(nr.Type),
+		"etag":                   dcl.ValueOrEmptyString(nr.Etag),
+		"host":                   dcl.ValueOrEmptyString(nr.Host),
+	}
+	return dcl.Nprintf("projects/{{project}}/instances/{{instance}}/users/{{name}}", params), nil
+}
+
+const UserMaxPage = -1
+
+type UserList struct {
+	Items []*User
+
+	nextToken string
+
+	pageSize int32
+
+	resource *User
+}
+
+func (l *UserList) HasNext() bool {
+	return l.nextToken != ""
+}
+
+func (l *UserList) NextPageToken() string {
+	return l.nextToken
+}
+
+func (l *UserList) Next(ctx context.Context, c *Client) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&User{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if !l.HasNext() {
+		return fmt.Errorf("no next page")
+	}
+	items, token, err := c.listUser(ctx, l.resource, l.nextToken, l.pageSize)
+	if err != nil {
+		return err
+	}
+	l.Items = items
+	l.nextToken = token
+	return err
+}
+
+func (c *Client) ListUser(ctx context.Context, project, instance string) (*UserList, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&User{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&User{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	return c.ListUserWithMaxResults(ctx, project, instance, UserMaxPage)
+
+}
+
+func (c *Client) ListUserWithMaxResults(ctx context.Context, project, instance string, pageSize int32) (*UserList, error) {
+	return c.ListUserWithPageToken(ctx, project, instance, pageSize, "")
+}
+
+func (c *Client) ListUserWithPageToken(ctx context.Context, project, instance string, pageSize int32, pageToken string) (*UserList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&User{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// Create a resource object so that we can use proper url normalization methods.
+	r := &User{
+		Project:  &project,
+		Instance: &instance,
+	}
+	items, token, err := c.listUser(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &UserList{
+		Items:     items,
+		nextToken: token,
+		pageSize:  pageSize,
+		resource:  r,
+	}, nil
+}
+
+func (c *Client) GetUser(ctx context.Context, r *User) (*User, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&User{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&User{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// This is *purposefully* supressing errors.
+	// This function is used with url-normalized values + not URL normalized values.
+	// URL Normalized values will throw unintentional errors, since those values are not of the proper parent form.
+	extractUserFields(r)
+
+	b, err := c.getUserRaw(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			return nil, &googleapi.Error{
+				Code:    404,
+				Message: err.Error(),
+			}
+		}
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
+	}
+	result, err := unmarshalUser(b, c, r)
+	if err != nil {
+		return nil, err
+	}
+	result.Project = r.Project
+	result.Instance = r.Instance
+	result.Name = r.Name
+
+	c.Config.Logger.InfoWithContextf(ctx, "Retrieved raw result state: %v", result)
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with specified state: %v", r)
+	result, err = canonicalizeUserNewState(c, result, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := postReadExtractUserFields(result); err != nil {
+		return result, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created result state: %v", result)
+
+	return result, nil
+}
+
+func (c *Client) DeleteUser(ctx context.Context, r *User) error {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&User{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&User{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if r == nil {
+		return fmt.Errorf("User resource is nil")
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Deleting User...")
+	deleteOp := deleteUserOperation{}
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteUserAsync(ctx context.Context, r *User) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteUser(ctx, r)
+	})
+}
+
+// DeleteAllUser deletes all resources that the filter functions returns true on.
+func (c *Client) DeleteAllUser(ctx context.Context, project, instance string, filter func(*User) bool) error {
+	listObj, err := c.ListUser(ctx, project, instance)
+	if err != nil {
+		return err
+	}
+
+	err = c.deleteAllUser(ctx, filter, listObj.Items)
+	if err != nil {
+		return err
+	}
+	for listObj.HasNext() {
+		err = listObj.Next(ctx, c)
+		if err != nil {
+			return nil
+		}
+		err = c.deleteAllUser(ctx, filter, listObj.Items)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) ApplyUser(ctx context.Context, rawDesired *User, opts ...dcl.ApplyOption) (*User, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&User{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&User{}).Describe())
+	var resultNewState *User
+	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		newState, err := applyUserHelper(c, ctx, rawDesired, opts...)
+		resultNewState = newState
+		if err != nil {
+			// If the error is 409, there is conflict in resource update.
+			// Here we want to apply changes based on latest state.
+			if dcl.IsConflictError(err) {
+				return &dcl.RetryDetails{}, dcl.OperationNotDone{Err: err}
+			}
+			return nil, err
+		}
+		return nil, nil
+	}, c.Config.RetryProvider)
+	return resultNewState, err
+}
+
+func (c *Client) ApplyUserAsync(ctx context.Context, rawDesired *User, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyUser(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffUser returns the field-level differences between rawDesired and the
+// live User without modifying it. If the User does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffUser(ctx context.Context, rawDesired *User, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&User{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&User{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractUserFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.userDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("User %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
+func applyUserHelper(c *Client, ctx context.Context, rawDesired *User, opts ...dcl.ApplyOption) (*User, error) {
+	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyUser...")
+	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
+
+	// 1.1: Validation of user-specified fields in desired state.
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
+
+	if err := extractUserFields(rawDesired); err != nil {
+		return nil, err
+	}
+
+	initial, desired, fieldDiffs, err := c.userDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a diff: %w", err)
+	}
+
+	diffs, err := convertFieldDiffsToUserDiffs(c.Config, fieldDiffs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(magic-modules-eng): 2.2 Feasibility check (all updates are feasible so far).
+
+	// 2.3: Lifecycle Directive Check
+	var create bool
+	lp := dcl.FetchLifecycleParams(opts)
+	if initial == nil {
+		if dcl.HasLifecycleParam(lp, dcl.BlockCreation) {
+			return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Creation blocked by lifecycle params: %#v.", desired)}
+		}
+		create = true
+	} else if dcl.HasLifecycleParam(lp, dcl.BlockAcquire) {
+		return nil, dcl.ApplyInfeasibleError{
+			Message: fmt.Sprintf("Resource already exists - apply blocked by lifecycle params: %#v.", initial),
+		}
+	} else {
+		for _, d := range diffs {
+			if d.RequiresRecreate {
+				return nil, dcl.ApplyInfeasibleError{
+					Message: fmt.Sprintf("infeasible update: (%v) would require recreation", d),
+				}
+			}
+			if dcl.HasLifecycleParam(lp, dcl.BlockModification) {
+				return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Modification blocked, diff (%v) unresolvable.", d)}
+			}
+		}
+	}
+
+	// 2.4 Imperative Request Planning
+	var ops []userApiOperation
+	if create {
+		ops = append(ops, &createUserOperation{})
+	} else {
+		for _, d := range diffs {
+			ops = append(ops, d.UpdateOp)
+		}
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created plan: %#v", ops)
+
+	// 2.5 Request Actuation
+	for _, op := range ops {
+		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
+		if err := op.do(ctx, desired, c); err != nil {
+			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
+		}
+		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
+	}
+	return applyUserDiff(c, ctx, desired, rawDesired, ops, opts...)
+}
+
+func applyUserDiff(c *Client, ctx context.Context, desired *User, rawDesired *User, ops []userApiOperation, opts ...dcl.ApplyOption) (*User, error) {
+	// 3.1, 3.2a Retrieval of raw new state & canonicalization with desired state
+	c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state...")
+	rawNew, err := c.GetUser(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	// Get additional values from the first response.
+	// These values should be merged into the newState above.
+	if len(ops) > 0 {
+		lastOp := ops[len(ops)-1]
+		if o, ok := lastOp.(*createUserOperation); ok {
+			if r, hasR := o.FirstResponse(); hasR {
+
+				c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state from operation...")
+
+				fullResp, err := unmarshalMapUser(r, c, rawDesired)
+				if err != nil {
+					return nil, err
+				}
+
+				rawNew, err = canonicalizeUserNewState(c, rawNew, fullResp)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with raw desired state: %v", rawDesired)
+	// 3.2b Canonicalization of raw new state using raw desired state
+	newState, err := canonicalizeUserNewState(c, rawNew, rawDesired)
+	if err != nil {
+		return rawNew, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created canonical new state: %v", newState)
+	// 3.3 Comparison of the new state and raw desired state.
+	// TODO(magic-modules-eng): EVENTUALLY_CONSISTENT_UPDATE
+	newDesired, err := canonicalizeUserDesiredState(rawDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if err := postReadExtractUserFields(newState); err != nil {
+		return newState, err
+	}
+
+	// Need to ensure any transformations made here match acceptably in differ.
+	if err := postReadExtractUserFields(newDesired); err != nil {
+		return newState, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Diffing using canonicalized desired state: %v", newDesired)
+	newDiffs, err := diffUser(c, newDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if len(newDiffs) == 0 {
+		c.Config.Logger.InfoWithContext(ctx, "No diffs found. Apply was successful.")
+	} else {
+		c.Config.Logger.InfoWithContextf(ctx, "Found diffs: %v", newDiffs)
+		diffMessages := make([]string, len(newDiffs))
+		for i, d := range newDiffs {
+			diffMessages[i] = fmt.Sprintf("%v", d)
+		}
+		return newState, dcl.DiffAfterApplyError{Diffs: diffMessages}
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Done Apply.")
+	return newState, nil
+}
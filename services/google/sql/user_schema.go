@@ -0,0 +1,205 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package sql
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLUserSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Sql/User",
+			Description: "The Sql User resource",
+			StructName:  "User",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a User",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "user",
+						Required:    true,
+						Description: "A full instance of a User",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a User",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "user",
+						Required:    true,
+						Description: "A full instance of a User",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a User",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "user",
+						Required:    true,
+						Description: "A full instance of a User",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all User",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "instance",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many User",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "instance",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"User": &dcl.Component{
+					Title:           "User",
+					ID:              "projects/{{project}}/instances/{{instance}}/users/{{name}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"name",
+							"project",
+							"instance",
+						},
+						Properties: map[string]*dcl.Property{
+							"etag": &dcl.Property{
+								Type:        "string",
+								GoName:      "Etag",
+								ReadOnly:    true,
+								Description: "Output only. This field is deprecated and will be removed from a future version of the API.",
+								Immutable:   true,
+							},
+							"host": &dcl.Property{
+								Type:        "string",
+								GoName:      "Host",
+								Description: "The host name from which the user can connect. For `insert` operations, host defaults to an empty string. For `update` operations, host is specified as part of the request URL. The host name cannot be updated after insertion.",
+								Immutable:   true,
+							},
+							"instance": &dcl.Property{
+								Type:        "string",
+								GoName:      "Instance",
+								Description: "The name of the Cloud SQL instance. This does not include the project ID.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Sql/Instance",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"name": &dcl.Property{
+								Type:        "string",
+								GoName:      "Name",
+								Description: "The name of the user in the Cloud SQL instance.",
+								Immutable:   true,
+							},
+							"password": &dcl.Property{
+								Type:        "string",
+								GoName:      "Password",
+								Description: "The password for the user.",
+								Sensitive:   true,
+								Unreadable:  true,
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "The project for the resource",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"sqlserverUserDetails": &dcl.Property{
+								Type:        "object",
+								GoName:      "SqlserverUserDetails",
+								GoType:      "UserSqlserverUserDetails",
+								Description: "SQL Server specific user details.",
+								Properties: map[string]*dcl.Property{
+									"disabled": &dcl.Property{
+										Type:        "boolean",
+										GoName:      "Disabled",
+										Description: "If the user has been disabled",
+									},
+									"serverRoles": &dcl.Property{
+										Type:        "array",
+										GoName:      "ServerRoles",
+										Description: "The server roles for this user",
+										SendEmpty:   true,
+										ListType:    "list",
+										Items: &dcl.Property{
+											Type:   "string",
+											GoType: "string",
+										},
+									},
+								},
+							},
+							"type": &dcl.Property{
+								Type:        "string",
+								GoName:      "Type",
+								GoType:      "UserTypeEnum",
+								Description: "The user type. It determines the method to authenticate the user during login. The default is the database's built-in user type. Possible values: NATIVE, CLOUD_IAM_USER, CLOUD_IAM_SERVICE_ACCOUNT",
+								Immutable:   true,
+								Enum: []string{
+									"NATIVE",
+									"CLOUD_IAM_USER",
+									"CLOUD_IAM_SERVICE_ACCOUNT",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -568,8 +568,12 @@ func (l *BucketList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *BucketList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *BucketList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Bucket{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -586,7 +590,8 @@ func (l *BucketList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListBucket(ctx context.Context, project string) (*BucketList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Bucket{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Bucket{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListBucketWithMaxResults(ctx, project, BucketMaxPage)
@@ -594,14 +599,18 @@ func (c *Client) ListBucket(ctx context.Context, project string) (*BucketList, e
 }
 
 func (c *Client) ListBucketWithMaxResults(ctx context.Context, project string, pageSize int32) (*BucketList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListBucketWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListBucketWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*BucketList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Bucket{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
 	r := &Bucket{
 		Project: &project,
 	}
-	items, token, err := c.listBucket(ctx, r, "", pageSize)
+	items, token, err := c.listBucket(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -615,7 +624,8 @@ func (c *Client) ListBucketWithMaxResults(ctx context.Context, project string, p
 
 func (c *Client) GetBucket(ctx context.Context, r *Bucket) (*Bucket, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Bucket{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Bucket{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -631,7 +641,7 @@ func (c *Client) GetBucket(ctx context.Context, r *Bucket) (*Bucket, error) {
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalBucket(b, c, r)
 	if err != nil {
@@ -656,7 +666,8 @@ func (c *Client) GetBucket(ctx context.Context, r *Bucket) (*Bucket, error) {
 
 func (c *Client) DeleteBucket(ctx context.Context, r *Bucket) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Bucket{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Bucket{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -664,7 +675,13 @@ func (c *Client) DeleteBucket(ctx context.Context, r *Bucket) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Bucket...")
 	deleteOp := deleteBucketOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteBucketAsync(ctx context.Context, r *Bucket) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteBucket(ctx, r)
+	})
 }
 
 // DeleteAllBucket deletes all resources that the filter functions returns true on.
@@ -692,10 +709,11 @@ func (c *Client) DeleteAllBucket(ctx context.Context, project string, filter fun
 }
 
 func (c *Client) ApplyBucket(ctx context.Context, rawDesired *Bucket, opts ...dcl.ApplyOption) (*Bucket, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Bucket{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Bucket{}).Describe())
 	var resultNewState *Bucket
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyBucketHelper(c, ctx, rawDesired, opts...)
@@ -713,6 +731,38 @@ func (c *Client) ApplyBucket(ctx context.Context, rawDesired *Bucket, opts ...dc
 	return resultNewState, err
 }
 
+func (c *Client) ApplyBucketAsync(ctx context.Context, rawDesired *Bucket, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyBucket(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffBucket returns the field-level differences between rawDesired and the
+// live Bucket without modifying it. If the Bucket does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffBucket(ctx context.Context, rawDesired *Bucket, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Bucket{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Bucket{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractBucketFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.bucketDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Bucket %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyBucketHelper(c *Client, ctx context.Context, rawDesired *Bucket, opts ...dcl.ApplyOption) (*Bucket, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyBucket...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -721,6 +771,9 @@ func applyBucketHelper(c *Client, ctx context.Context, rawDesired *Bucket, opts
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractBucketFields(rawDesired); err != nil {
 		return nil, err
@@ -779,7 +832,7 @@ func applyBucketHelper(c *Client, ctx context.Context, rawDesired *Bucket, opts
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -445,7 +445,7 @@ func (c *Client) bucketDiffsForRawDesired(ctx context.Context, rawDesired *Bucke
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Bucket resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Bucket resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Bucket resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Bucket resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -459,6 +459,12 @@ func (c *Client) bucketDiffsForRawDesired(ctx context.Context, rawDesired *Bucke
 	if err := extractBucketFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeBucketInitialState(rawInitial, rawDesired)
@@ -476,7 +482,7 @@ func (c *Client) bucketDiffsForRawDesired(ctx context.Context, rawDesired *Bucke
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffBucket(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeBucketInitialState(rawInitial, rawDesired *Bucket) (*Bucket, error) {
@@ -0,0 +1,533 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"google.golang.org/api/googleapi"
+)
+
+type DefaultObjectAccessControl struct {
+	Project     *string                                `json:"project"`
+	Bucket      *string                                `json:"bucket"`
+	Domain      *string                                `json:"domain"`
+	Email       *string                                `json:"email"`
+	Entity      *string                                `json:"entity"`
+	EntityId    *string                                `json:"entityId"`
+	ProjectTeam *DefaultObjectAccessControlProjectTeam `json:"projectTeam"`
+	Role        *DefaultObjectAccessControlRoleEnum    `json:"role"`
+}
+
+func (r *DefaultObjectAccessControl) String() string {
+	return dcl.SprintResource(r)
+}
+
+// The enum DefaultObjectAccessControlProjectTeamTeamEnum.
+type DefaultObjectAccessControlProjectTeamTeamEnum string
+
+// DefaultObjectAccessControlProjectTeamTeamEnumRef returns a *DefaultObjectAccessControlProjectTeamTeamEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func DefaultObjectAccessControlProjectTeamTeamEnumRef(s string) *DefaultObjectAccessControlProjectTeamTeamEnum {
+	v := DefaultObjectAccessControlProjectTeamTeamEnum(s)
+	return &v
+}
+
+func (v DefaultObjectAccessControlProjectTeamTeamEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"editors", "owners", "viewers"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "DefaultObjectAccessControlProjectTeamTeamEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum DefaultObjectAccessControlRoleEnum.
+type DefaultObjectAccessControlRoleEnum string
+
+// DefaultObjectAccessControlRoleEnumRef returns a *DefaultObjectAccessControlRoleEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func DefaultObjectAccessControlRoleEnumRef(s string) *DefaultObjectAccessControlRoleEnum {
+	v := DefaultObjectAccessControlRoleEnum(s)
+	return &v
+}
+
+func (v DefaultObjectAccessControlRoleEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"OWNER", "READER"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "DefaultObjectAccessControlRoleEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+type DefaultObjectAccessControlProjectTeam struct {
+	empty         bool                                           `json:"-"`
+	ProjectNumber *string                                        `json:"projectNumber"`
+	Team          *DefaultObjectAccessControlProjectTeamTeamEnum `json:"team"`
+}
+
+type jsonDefaultObjectAccessControlProjectTeam DefaultObjectAccessControlProjectTeam
+
+func (r *DefaultObjectAccessControlProjectTeam) UnmarshalJSON(data []byte) error {
+	var res jsonDefaultObjectAccessControlProjectTeam
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyDefaultObjectAccessControlProjectTeam
+	} else {
+
+		r.ProjectNumber = res.ProjectNumber
+
+		r.Team = res.Team
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this DefaultObjectAccessControlProjectTeam is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyDefaultObjectAccessControlProjectTeam *DefaultObjectAccessControlProjectTeam = &DefaultObjectAccessControlProjectTeam{empty: true}
+
+func (r *DefaultObjectAccessControlProjectTeam) Empty() bool {
+	return r.empty
+}
+
+func (r *DefaultObjectAccessControlProjectTeam) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *DefaultObjectAccessControlProjectTeam) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+// Describe returns a simple description of this resource to ensure that automated tools
+// can identify it.
+func (r *DefaultObjectAccessControl) Describe() dcl.ServiceTypeVersion {
+	return dcl.ServiceTypeVersion{
+		Service: "storage",
+		Type:    "DefaultObjectAccessControl",
+		Version: "storage",
+	}
+}
+
+func (r *DefaultObjectAccessControl) ID() (string, error) {
+	if err := extractDefaultObjectAccessControlFields(r); err != nil {
+		return "", err
+	}
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project":      dcl.ValueOrEmptyString(nr.Project),
+		"bucket":       dcl.ValueOrEmptyString(nr.Bucket),
+		"domain":       dcl.ValueOrEmptyString(nr.Domain),
+		"email":        dcl.ValueOrEmptyString(nr.Email),
+		"entity":       dcl.ValueOrEmptyString(nr.Entity),
+		"entity_id":    dcl.ValueOrEmptyString(nr.EntityId),
+		"project_team": dcl.ValueOrEmptyString(nr.ProjectTeam),
+		"role":         dcl.ValueOrEmptyString(nr.Role),
+	}
+	return dcl.Nprintf("b/{{bucket}}/defaultObjectAcl/{{entity}}?userProject={{project}}", params), nil
+}
+
+const DefaultObjectAccessControlMaxPage = -1
+
+type DefaultObjectAccessControlList struct {
+	Items []*DefaultObjectAccessControl
+
+	nextToken string
+
+	pageSize int32
+
+	resource *DefaultObjectAccessControl
+}
+
+func (l *DefaultObjectAccessControlList) HasNext() bool {
+	return l.nextToken != ""
+}
+
+func (l *DefaultObjectAccessControlList) NextPageToken() string {
+	return l.nextToken
+}
+
+func (l *DefaultObjectAccessControlList) Next(ctx context.Context, c *Client) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DefaultObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if !l.HasNext() {
+		return fmt.Errorf("no next page")
+	}
+	items, token, err := c.listDefaultObjectAccessControl(ctx, l.resource, l.nextToken, l.pageSize)
+	if err != nil {
+		return err
+	}
+	l.Items = items
+	l.nextToken = token
+	return err
+}
+
+func (c *Client) ListDefaultObjectAccessControl(ctx context.Context, project, bucket string) (*DefaultObjectAccessControlList, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DefaultObjectAccessControl{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DefaultObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	return c.ListDefaultObjectAccessControlWithMaxResults(ctx, project, bucket, DefaultObjectAccessControlMaxPage)
+
+}
+
+func (c *Client) ListDefaultObjectAccessControlWithMaxResults(ctx context.Context, project, bucket string, pageSize int32) (*DefaultObjectAccessControlList, error) {
+	return c.ListDefaultObjectAccessControlWithPageToken(ctx, project, bucket, pageSize, "")
+}
+
+func (c *Client) ListDefaultObjectAccessControlWithPageToken(ctx context.Context, project, bucket string, pageSize int32, pageToken string) (*DefaultObjectAccessControlList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DefaultObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// Create a resource object so that we can use proper url normalization methods.
+	r := &DefaultObjectAccessControl{
+		Project: &project,
+		Bucket:  &bucket,
+	}
+	items, token, err := c.listDefaultObjectAccessControl(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &DefaultObjectAccessControlList{
+		Items:     items,
+		nextToken: token,
+		pageSize:  pageSize,
+		resource:  r,
+	}, nil
+}
+
+func (c *Client) GetDefaultObjectAccessControl(ctx context.Context, r *DefaultObjectAccessControl) (*DefaultObjectAccessControl, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DefaultObjectAccessControl{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DefaultObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// This is *purposefully* supressing errors.
+	// This function is used with url-normalized values + not URL normalized values.
+	// URL Normalized values will throw unintentional errors, since those values are not of the proper parent form.
+	extractDefaultObjectAccessControlFields(r)
+
+	b, err := c.getDefaultObjectAccessControlRaw(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			return nil, &googleapi.Error{
+				Code:    404,
+				Message: err.Error(),
+			}
+		}
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
+	}
+	result, err := unmarshalDefaultObjectAccessControl(b, c, r)
+	if err != nil {
+		return nil, err
+	}
+	result.Project = r.Project
+	result.Bucket = r.Bucket
+	result.Entity = r.Entity
+
+	c.Config.Logger.InfoWithContextf(ctx, "Retrieved raw result state: %v", result)
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with specified state: %v", r)
+	result, err = canonicalizeDefaultObjectAccessControlNewState(c, result, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := postReadExtractDefaultObjectAccessControlFields(result); err != nil {
+		return result, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created result state: %v", result)
+
+	return result, nil
+}
+
+func (c *Client) DeleteDefaultObjectAccessControl(ctx context.Context, r *DefaultObjectAccessControl) error {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DefaultObjectAccessControl{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DefaultObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if r == nil {
+		return fmt.Errorf("DefaultObjectAccessControl resource is nil")
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Deleting DefaultObjectAccessControl...")
+	deleteOp := deleteDefaultObjectAccessControlOperation{}
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteDefaultObjectAccessControlAsync(ctx context.Context, r *DefaultObjectAccessControl) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteDefaultObjectAccessControl(ctx, r)
+	})
+}
+
+// DeleteAllDefaultObjectAccessControl deletes all resources that the filter functions returns true on.
+func (c *Client) DeleteAllDefaultObjectAccessControl(ctx context.Context, project, bucket string, filter func(*DefaultObjectAccessControl) bool) error {
+	listObj, err := c.ListDefaultObjectAccessControl(ctx, project, bucket)
+	if err != nil {
+		return err
+	}
+
+	err = c.deleteAllDefaultObjectAccessControl(ctx, filter, listObj.Items)
+	if err != nil {
+		return err
+	}
+	for listObj.HasNext() {
+		err = listObj.Next(ctx, c)
+		if err != nil {
+			return nil
+		}
+		err = c.deleteAllDefaultObjectAccessControl(ctx, filter, listObj.Items)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) ApplyDefaultObjectAccessControl(ctx context.Context, rawDesired *DefaultObjectAccessControl, opts ...dcl.ApplyOption) (*DefaultObjectAccessControl, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DefaultObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DefaultObjectAccessControl{}).Describe())
+	var resultNewState *DefaultObjectAccessControl
+	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		newState, err := applyDefaultObjectAccessControlHelper(c, ctx, rawDesired, opts...)
+		resultNewState = newState
+		if err != nil {
+			// If the error is 409, there is conflict in resource update.
+			// Here we want to apply changes based on latest state.
+			if dcl.IsConflictError(err) {
+				return &dcl.RetryDetails{}, dcl.OperationNotDone{Err: err}
+			}
+			return nil, err
+		}
+		return nil, nil
+	}, c.Config.RetryProvider)
+	return resultNewState, err
+}
+
+func (c *Client) ApplyDefaultObjectAccessControlAsync(ctx context.Context, rawDesired *DefaultObjectAccessControl, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyDefaultObjectAccessControl(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffDefaultObjectAccessControl returns the field-level differences between rawDesired and the
+// live DefaultObjectAccessControl without modifying it. If the DefaultObjectAccessControl does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffDefaultObjectAccessControl(ctx context.Context, rawDesired *DefaultObjectAccessControl, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&DefaultObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&DefaultObjectAccessControl{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractDefaultObjectAccessControlFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.defaultObjectAccessControlDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("DefaultObjectAccessControl %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
+func applyDefaultObjectAccessControlHelper(c *Client, ctx context.Context, rawDesired *DefaultObjectAccessControl, opts ...dcl.ApplyOption) (*DefaultObjectAccessControl, error) {
+	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyDefaultObjectAccessControl...")
+	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
+
+	// 1.1: Validation of user-specified fields in desired state.
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
+
+	if err := extractDefaultObjectAccessControlFields(rawDesired); err != nil {
+		return nil, err
+	}
+
+	initial, desired, fieldDiffs, err := c.defaultObjectAccessControlDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a diff: %w", err)
+	}
+
+	diffs, err := convertFieldDiffsToDefaultObjectAccessControlDiffs(c.Config, fieldDiffs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(magic-modules-eng): 2.2 Feasibility check (all updates are feasible so far).
+
+	// 2.3: Lifecycle Directive Check
+	var create bool
+	lp := dcl.FetchLifecycleParams(opts)
+	if initial == nil {
+		if dcl.HasLifecycleParam(lp, dcl.BlockCreation) {
+			return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Creation blocked by lifecycle params: %#v.", desired)}
+		}
+		create = true
+	} else if dcl.HasLifecycleParam(lp, dcl.BlockAcquire) {
+		return nil, dcl.ApplyInfeasibleError{
+			Message: fmt.Sprintf("Resource already exists - apply blocked by lifecycle params: %#v.", initial),
+		}
+	} else {
+		for _, d := range diffs {
+			if d.RequiresRecreate {
+				return nil, dcl.ApplyInfeasibleError{
+					Message: fmt.Sprintf("infeasible update: (%v) would require recreation", d),
+				}
+			}
+			if dcl.HasLifecycleParam(lp, dcl.BlockModification) {
+				return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Modification blocked, diff (%v) unresolvable.", d)}
+			}
+		}
+	}
+
+	// 2.4 Imperative Request Planning
+	var ops []defaultObjectAccessControlApiOperation
+	if create {
+		ops = append(ops, &createDefaultObjectAccessControlOperation{})
+	} else {
+		for _, d := range diffs {
+			ops = append(ops, d.UpdateOp)
+		}
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created plan: %#v", ops)
+
+	// 2.5 Request Actuation
+	for _, op := range ops {
+		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
+		if err := op.do(ctx, desired, c); err != nil {
+			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
+		}
+		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
+	}
+	return applyDefaultObjectAccessControlDiff(c, ctx, desired, rawDesired, ops, opts...)
+}
+
+func applyDefaultObjectAccessControlDiff(c *Client, ctx context.Context, desired *DefaultObjectAccessControl, rawDesired *DefaultObjectAccessControl, ops []defaultObjectAccessControlApiOperation, opts ...dcl.ApplyOption) (*DefaultObjectAccessControl, error) {
+	// 3.1, 3.2a Retrieval of raw new state & canonicalization with desired state
+	c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state...")
+	rawNew, err := c.GetDefaultObjectAccessControl(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	// Get additional values from the first response.
+	// These values should be merged into the newState above.
+	if len(ops) > 0 {
+		lastOp := ops[len(ops)-1]
+		if o, ok := lastOp.(*createDefaultObjectAccessControlOperation); ok {
+			if r, hasR := o.FirstResponse(); hasR {
+
+				c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state from operation...")
+
+				fullResp, err := unmarshalMapDefaultObjectAccessControl(r, c, rawDesired)
+				if err != nil {
+					return nil, err
+				}
+
+				rawNew, err = canonicalizeDefaultObjectAccessControlNewState(c, rawNew, fullResp)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with raw desired state: %v", rawDesired)
+	// 3.2b Canonicalization of raw new state using raw desired state
+	newState, err := canonicalizeDefaultObjectAccessControlNewState(c, rawNew, rawDesired)
+	if err != nil {
+		return rawNew, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created canonical new state: %v", newState)
+	// 3.3 Comparison of the new state and raw desired state.
+	// TODO(magic-modules-eng): EVENTUALLY_CONSISTENT_UPDATE
+	newDesired, err := canonicalizeDefaultObjectAccessControlDesiredState(rawDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if err := postReadExtractDefaultObjectAccessControlFields(newState); err != nil {
+		return newState, err
+	}
+
+	// Need to ensure any transformations made here match acceptably in differ.
+	if err := postReadExtractDefaultObjectAccessControlFields(newDesired); err != nil {
+		return newState, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Diffing using canonicalized desired state: %v", newDesired)
+	newDiffs, err := diffDefaultObjectAccessControl(c, newDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if len(newDiffs) == 0 {
+		c.Config.Logger.InfoWithContext(ctx, "No diffs found. Apply was successful.")
+	} else {
+		c.Config.Logger.InfoWithContextf(ctx, "Found diffs: %v", newDiffs)
+		diffMessages := make([]string, len(newDiffs))
+		for i, d := range newDiffs {
+			diffMessages[i] = fmt.Sprintf("%v", d)
+		}
+		return newState, dcl.DiffAfterApplyError{Diffs: diffMessages}
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Done Apply.")
+	return newState, nil
+}
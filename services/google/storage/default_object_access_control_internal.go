@@ -0,0 +1,1171 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func (r *DefaultObjectAccessControl) validate() error {
+
+	if err := dcl.Required(r, "entity"); err != nil {
+		return err
+	}
+	if err := dcl.Required(r, "role"); err != nil {
+		return err
+	}
+	if err := dcl.RequiredParameter(r.Project, "Project"); err != nil {
+		return err
+	}
+	if err := dcl.RequiredParameter(r.Bucket, "Bucket"); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(r.ProjectTeam) {
+		if err := r.ProjectTeam.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *DefaultObjectAccessControlProjectTeam) validate() error {
+	return nil
+}
+func (r *DefaultObjectAccessControl) basePath() string {
+	params := map[string]interface{}{}
+	return dcl.Nprintf("https://www.googleapis.com/storage/v1/", params)
+}
+
+func (r *DefaultObjectAccessControl) getURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"bucket":  dcl.ValueOrEmptyString(nr.Bucket),
+		"entity":  dcl.ValueOrEmptyString(nr.Entity),
+		"project": dcl.ValueOrEmptyString(nr.Project),
+	}
+	return dcl.URL("b/{{bucket}}/defaultObjectAcl/{{entity}}?userProject={{project}}", nr.basePath(), userBasePath, params), nil
+}
+
+func (r *DefaultObjectAccessControl) listURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"bucket":  dcl.ValueOrEmptyString(nr.Bucket),
+		"project": dcl.ValueOrEmptyString(nr.Project),
+	}
+	return dcl.URL("b/{{bucket}}/defaultObjectAcl?userProject={{project}}", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *DefaultObjectAccessControl) createURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"bucket":  dcl.ValueOrEmptyString(nr.Bucket),
+		"project": dcl.ValueOrEmptyString(nr.Project),
+	}
+	return dcl.URL("b/{{bucket}}/defaultObjectAcl?userProject={{project}}", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *DefaultObjectAccessControl) deleteURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"bucket":  dcl.ValueOrEmptyString(nr.Bucket),
+		"entity":  dcl.ValueOrEmptyString(nr.Entity),
+		"project": dcl.ValueOrEmptyString(nr.Project),
+	}
+	return dcl.URL("b/{{bucket}}/defaultObjectAcl/{{entity}}?userProject={{project}}", nr.basePath(), userBasePath, params), nil
+}
+
+// defaultObjectAccessControlApiOperation represents a mutable operation in the underlying REST
+// API such as Create, Update, or Delete.
+type defaultObjectAccessControlApiOperation interface {
+	do(context.Context, *DefaultObjectAccessControl, *Client) error
+}
+
+// newUpdateDefaultObjectAccessControlPatchDefaultObjectAccessControlRequest creates a request for an
+// DefaultObjectAccessControl resource's PatchDefaultObjectAccessControl update type by filling in the update
+// fields based on the intended state of the resource.
+func newUpdateDefaultObjectAccessControlPatchDefaultObjectAccessControlRequest(ctx context.Context, f *DefaultObjectAccessControl, c *Client) (map[string]interface{}, error) {
+	req := map[string]interface{}{}
+	res := f
+	_ = res
+
+	if v := f.Role; !dcl.IsEmptyValueIndirect(v) {
+		req["role"] = v
+	}
+	return req, nil
+}
+
+// marshalUpdateDefaultObjectAccessControlPatchDefaultObjectAccessControlRequest converts the update into
+// the final JSON request body.
+func marshalUpdateDefaultObjectAccessControlPatchDefaultObjectAccessControlRequest(c *Client, m map[string]interface{}) ([]byte, error) {
+
+	return json.Marshal(m)
+}
+
+type updateDefaultObjectAccessControlPatchDefaultObjectAccessControlOperation struct {
+	// If the update operation has the REQUIRES_APPLY_OPTIONS trait, this will be populated.
+	// Usually it will be nil - this is to prevent us from accidentally depending on apply
+	// options, which should usually be unnecessary.
+	ApplyOptions []dcl.ApplyOption
+	FieldDiffs   []*dcl.FieldDiff
+}
+
+// do creates a request and sends it to the appropriate URL. In most operations,
+// do will transcribe a subset of the resource into a request object and send a
+// PUT request to a single URL.
+
+func (op *updateDefaultObjectAccessControlPatchDefaultObjectAccessControlOperation) do(ctx context.Context, r *DefaultObjectAccessControl, c *Client) error {
+	_, err := c.GetDefaultObjectAccessControl(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	u, err := r.updateURL(c.Config.BasePath, "PatchDefaultObjectAccessControl")
+	if err != nil {
+		return err
+	}
+
+	req, err := newUpdateDefaultObjectAccessControlPatchDefaultObjectAccessControlRequest(ctx, r, c)
+	if err != nil {
+		return err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created update: %#v", req)
+	body, err := marshalUpdateDefaultObjectAccessControlPatchDefaultObjectAccessControlRequest(c, req)
+	if err != nil {
+		return err
+	}
+	_, err = dcl.SendRequest(ctx, c.Config, "PATCH", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) listDefaultObjectAccessControlRaw(ctx context.Context, r *DefaultObjectAccessControl, pageToken string, pageSize int32) ([]byte, error) {
+	u, err := r.urlNormalized().listURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string)
+	if pageToken != "" {
+		m["pageToken"] = pageToken
+	}
+
+	if pageSize != DefaultObjectAccessControlMaxPage {
+		m["pageSize"] = fmt.Sprintf("%v", pageSize)
+	}
+
+	u, err = dcl.AddQueryParams(u, m)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	return ioutil.ReadAll(resp.Response.Body)
+}
+
+type listDefaultObjectAccessControlOperation struct {
+	Items []map[string]interface{} `json:"items"`
+	Token string                   `json:"nextPageToken"`
+}
+
+func (c *Client) listDefaultObjectAccessControl(ctx context.Context, r *DefaultObjectAccessControl, pageToken string, pageSize int32) ([]*DefaultObjectAccessControl, string, error) {
+	b, err := c.listDefaultObjectAccessControlRaw(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var m listDefaultObjectAccessControlOperation
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, "", err
+	}
+
+	var l []*DefaultObjectAccessControl
+	for _, v := range m.Items {
+		res, err := unmarshalMapDefaultObjectAccessControl(v, c, r)
+		if err != nil {
+			return nil, m.Token, err
+		}
+		res.Project = r.Project
+		res.Bucket = r.Bucket
+		l = append(l, res)
+	}
+
+	return l, m.Token, nil
+}
+
+func (c *Client) deleteAllDefaultObjectAccessControl(ctx context.Context, f func(*DefaultObjectAccessControl) bool, resources []*DefaultObjectAccessControl) error {
+	var errors []string
+	for _, res := range resources {
+		if f(res) {
+			// We do not want deleteAll to fail on a deletion or else it will stop deleting other resources.
+			err := c.DeleteDefaultObjectAccessControl(ctx, res)
+			if err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("%v", strings.Join(errors, "\n"))
+	} else {
+		return nil
+	}
+}
+
+type deleteDefaultObjectAccessControlOperation struct{}
+
+func (op *deleteDefaultObjectAccessControlOperation) do(ctx context.Context, r *DefaultObjectAccessControl, c *Client) error {
+	r, err := c.GetDefaultObjectAccessControl(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			c.Config.Logger.InfoWithContextf(ctx, "DefaultObjectAccessControl not found, returning. Original error: %v", err)
+			return nil
+		}
+		c.Config.Logger.WarningWithContextf(ctx, "GetDefaultObjectAccessControl checking for existence. error: %v", err)
+		return err
+	}
+
+	u, err := r.deleteURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	// Delete should never have a body
+	body := &bytes.Buffer{}
+	_, err = dcl.SendRequest(ctx, c.Config, "DELETE", u, body, c.Config.RetryProvider)
+	if err != nil {
+		return fmt.Errorf("failed to delete DefaultObjectAccessControl: %w", err)
+	}
+
+	// We saw a race condition where for some successful delete operation, the Get calls returned resources for a short duration.
+	// This is the reason we are adding retry to handle that case.
+	retriesRemaining := 10
+	dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		_, err := c.GetDefaultObjectAccessControl(ctx, r)
+		if dcl.IsNotFound(err) {
+			return nil, nil
+		}
+		if retriesRemaining > 0 {
+			retriesRemaining--
+			return &dcl.RetryDetails{}, dcl.OperationNotDone{}
+		}
+		return nil, dcl.NotDeletedError{ExistingResource: r}
+	}, c.Config.RetryProvider)
+	return nil
+}
+
+// Create operations are similar to Update operations, although they do not have
+// specific request objects. The Create request object is the json encoding of
+// the resource, which is modified by res.marshal to form the base request body.
+type createDefaultObjectAccessControlOperation struct {
+	response map[string]interface{}
+}
+
+func (op *createDefaultObjectAccessControlOperation) FirstResponse() (map[string]interface{}, bool) {
+	return op.response, len(op.response) > 0
+}
+
+func (op *createDefaultObjectAccessControlOperation) do(ctx context.Context, r *DefaultObjectAccessControl, c *Client) error {
+	c.Config.Logger.InfoWithContextf(ctx, "Attempting to create %v", r)
+	u, err := r.createURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	req, err := r.marshal(c)
+	if err != nil {
+		return err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "POST", u, bytes.NewBuffer(req), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+
+	o, err := dcl.ResponseBodyAsJSON(resp)
+	if err != nil {
+		return fmt.Errorf("error decoding response body into JSON: %w", err)
+	}
+	op.response = o
+
+	if _, err := c.GetDefaultObjectAccessControl(ctx, r); err != nil {
+		c.Config.Logger.WarningWithContextf(ctx, "get returned error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) getDefaultObjectAccessControlRaw(ctx context.Context, r *DefaultObjectAccessControl) ([]byte, error) {
+
+	u, err := r.getURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	b, err := ioutil.ReadAll(resp.Response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (c *Client) defaultObjectAccessControlDiffsForRawDesired(ctx context.Context, rawDesired *DefaultObjectAccessControl, opts ...dcl.ApplyOption) (initial, desired *DefaultObjectAccessControl, diffs []*dcl.FieldDiff, err error) {
+	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
+	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
+	var fetchState *DefaultObjectAccessControl
+	if sh := dcl.FetchStateHint(opts); sh != nil {
+		if r, ok := sh.(*DefaultObjectAccessControl); !ok {
+			c.Config.Logger.WarningWithContextf(ctx, "Initial state hint was of the wrong type; expected DefaultObjectAccessControl, got %T", sh)
+		} else {
+			fetchState = r
+		}
+	}
+	if fetchState == nil {
+		fetchState = rawDesired
+	}
+
+	// 1.2: Retrieval of raw initial state from API
+	rawInitial, err := c.GetDefaultObjectAccessControl(ctx, fetchState)
+	if rawInitial == nil {
+		if !dcl.IsNotFound(err) {
+			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a DefaultObjectAccessControl resource already exists: %s", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve DefaultObjectAccessControl resource: %w", err)
+		}
+		c.Config.Logger.InfoWithContext(ctx, "Found that DefaultObjectAccessControl resource did not exist.")
+		// Perform canonicalization to pick up defaults.
+		desired, err = canonicalizeDefaultObjectAccessControlDesiredState(rawDesired, rawInitial)
+		return nil, desired, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Found initial state for DefaultObjectAccessControl: %v", rawInitial)
+	c.Config.Logger.InfoWithContextf(ctx, "Initial desired state for DefaultObjectAccessControl: %v", rawDesired)
+
+	// The Get call applies postReadExtract and so the result may contain fields that are not part of API version.
+	if err := extractDefaultObjectAccessControlFields(rawInitial); err != nil {
+		return nil, nil, nil, err
+	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// 1.3: Canonicalize raw initial state into initial state.
+	initial, err = canonicalizeDefaultObjectAccessControlInitialState(rawInitial, rawDesired)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized initial state for DefaultObjectAccessControl: %v", initial)
+
+	// 1.4: Canonicalize raw desired state into desired state.
+	desired, err = canonicalizeDefaultObjectAccessControlDesiredState(rawDesired, rawInitial, opts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized desired state for DefaultObjectAccessControl: %v", desired)
+
+	// 2.1: Comparison of initial and desired state.
+	diffs, err = diffDefaultObjectAccessControl(c, desired, initial, opts...)
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
+}
+
+func canonicalizeDefaultObjectAccessControlInitialState(rawInitial, rawDesired *DefaultObjectAccessControl) (*DefaultObjectAccessControl, error) {
+	// TODO(magic-modules-eng): write canonicalizer once relevant traits are added.
+	return rawInitial, nil
+}
+
+/*
+* Canonicalizers
+*
+* These are responsible for converting either a user-specified config or a
+* GCP API response to a standard format that can be used for difference checking.
+* */
+
+func canonicalizeDefaultObjectAccessControlDesiredState(rawDesired, rawInitial *DefaultObjectAccessControl, opts ...dcl.ApplyOption) (*DefaultObjectAccessControl, error) {
+
+	if rawInitial == nil {
+		// Since the initial state is empty, the desired state is all we have.
+		// We canonicalize the remaining nested objects with nil to pick up defaults.
+		rawDesired.ProjectTeam = canonicalizeDefaultObjectAccessControlProjectTeam(rawDesired.ProjectTeam, nil, opts...)
+
+		return rawDesired, nil
+	}
+
+	canonicalDesired := &DefaultObjectAccessControl{}
+	if dcl.NameToSelfLink(rawDesired.Project, rawInitial.Project) {
+		canonicalDesired.Project = rawInitial.Project
+	} else {
+		canonicalDesired.Project = rawDesired.Project
+	}
+	if dcl.NameToSelfLink(rawDesired.Bucket, rawInitial.Bucket) {
+		canonicalDesired.Bucket = rawInitial.Bucket
+	} else {
+		canonicalDesired.Bucket = rawDesired.Bucket
+	}
+	if dcl.StringCanonicalize(rawDesired.Entity, rawInitial.Entity) {
+		canonicalDesired.Entity = rawInitial.Entity
+	} else {
+		canonicalDesired.Entity = rawDesired.Entity
+	}
+	if dcl.IsZeroValue(rawDesired.Role) || (dcl.IsEmptyValueIndirect(rawDesired.Role) && dcl.IsEmptyValueIndirect(rawInitial.Role)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.Role = rawInitial.Role
+	} else {
+		canonicalDesired.Role = rawDesired.Role
+	}
+
+	return canonicalDesired, nil
+}
+
+func canonicalizeDefaultObjectAccessControlNewState(c *Client, rawNew, rawDesired *DefaultObjectAccessControl) (*DefaultObjectAccessControl, error) {
+
+	rawNew.Project = rawDesired.Project
+
+	rawNew.Bucket = rawDesired.Bucket
+
+	if dcl.IsEmptyValueIndirect(rawNew.Domain) && dcl.IsEmptyValueIndirect(rawDesired.Domain) {
+		rawNew.Domain = rawDesired.Domain
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Domain, rawNew.Domain) {
+			rawNew.Domain = rawDesired.Domain
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Email) && dcl.IsEmptyValueIndirect(rawDesired.Email) {
+		rawNew.Email = rawDesired.Email
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Email, rawNew.Email) {
+			rawNew.Email = rawDesired.Email
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Entity) && dcl.IsEmptyValueIndirect(rawDesired.Entity) {
+		rawNew.Entity = rawDesired.Entity
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Entity, rawNew.Entity) {
+			rawNew.Entity = rawDesired.Entity
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.EntityId) && dcl.IsEmptyValueIndirect(rawDesired.EntityId) {
+		rawNew.EntityId = rawDesired.EntityId
+	} else {
+		if dcl.StringCanonicalize(rawDesired.EntityId, rawNew.EntityId) {
+			rawNew.EntityId = rawDesired.EntityId
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ProjectTeam) && dcl.IsEmptyValueIndirect(rawDesired.ProjectTeam) {
+		rawNew.ProjectTeam = rawDesired.ProjectTeam
+	} else {
+		rawNew.ProjectTeam = canonicalizeNewDefaultObjectAccessControlProjectTeam(c, rawDesired.ProjectTeam, rawNew.ProjectTeam)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Role) && dcl.IsEmptyValueIndirect(rawDesired.Role) {
+		rawNew.Role = rawDesired.Role
+	} else {
+	}
+
+	return rawNew, nil
+}
+
+func canonicalizeDefaultObjectAccessControlProjectTeam(des, initial *DefaultObjectAccessControlProjectTeam, opts ...dcl.ApplyOption) *DefaultObjectAccessControlProjectTeam {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &DefaultObjectAccessControlProjectTeam{}
+
+	return cDes
+}
+
+func canonicalizeDefaultObjectAccessControlProjectTeamSlice(des, initial []DefaultObjectAccessControlProjectTeam, opts ...dcl.ApplyOption) []DefaultObjectAccessControlProjectTeam {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]DefaultObjectAccessControlProjectTeam, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeDefaultObjectAccessControlProjectTeam(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]DefaultObjectAccessControlProjectTeam, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeDefaultObjectAccessControlProjectTeam(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewDefaultObjectAccessControlProjectTeam(c *Client, des, nw *DefaultObjectAccessControlProjectTeam) *DefaultObjectAccessControlProjectTeam {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for DefaultObjectAccessControlProjectTeam while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.ProjectNumber, nw.ProjectNumber) {
+		nw.ProjectNumber = des.ProjectNumber
+	}
+
+	return nw
+}
+
+func canonicalizeNewDefaultObjectAccessControlProjectTeamSet(c *Client, des, nw []DefaultObjectAccessControlProjectTeam) []DefaultObjectAccessControlProjectTeam {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []DefaultObjectAccessControlProjectTeam
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareDefaultObjectAccessControlProjectTeamNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewDefaultObjectAccessControlProjectTeamSlice(c *Client, des, nw []DefaultObjectAccessControlProjectTeam) []DefaultObjectAccessControlProjectTeam {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []DefaultObjectAccessControlProjectTeam
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewDefaultObjectAccessControlProjectTeam(c, &d, &n))
+	}
+
+	return items
+}
+
+// The differ returns a list of diffs, along with a list of operations that should be taken
+// to remedy them. Right now, it does not attempt to consolidate operations - if several
+// fields can be fixed with a patch update, it will perform the patch several times.
+// Diffs on some fields will be ignored if the `desired` state has an empty (nil)
+// value. This empty value indicates that the user does not care about the state for
+// the field. Empty fields on the actual object will cause diffs.
+// TODO(magic-modules-eng): for efficiency in some resources, add batching.
+func diffDefaultObjectAccessControl(c *Client, desired, actual *DefaultObjectAccessControl, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	if desired == nil || actual == nil {
+		return nil, fmt.Errorf("nil resource passed to diff - always a programming error: %#v, %#v", desired, actual)
+	}
+
+	c.Config.Logger.Infof("Diff function called with desired state: %v", desired)
+	c.Config.Logger.Infof("Diff function called with actual state: %v", actual)
+
+	var fn dcl.FieldName
+	var newDiffs []*dcl.FieldDiff
+	// New style diffs.
+	if ds, err := dcl.Diff(desired.Project, actual.Project, dcl.DiffInfo{Type: "ReferenceType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Project")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Bucket, actual.Bucket, dcl.DiffInfo{Type: "ReferenceType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Bucket")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Domain, actual.Domain, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Domain")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Email, actual.Email, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Email")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Entity, actual.Entity, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Entity")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.EntityId, actual.EntityId, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("EntityId")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ProjectTeam, actual.ProjectTeam, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareDefaultObjectAccessControlProjectTeamNewStyle, EmptyObject: EmptyDefaultObjectAccessControlProjectTeam, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ProjectTeam")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Role, actual.Role, dcl.DiffInfo{Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateDefaultObjectAccessControlPatchDefaultObjectAccessControlOperation")}, fn.AddNest("Role")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	return newDiffs, nil
+}
+func compareDefaultObjectAccessControlProjectTeamNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*DefaultObjectAccessControlProjectTeam)
+	if !ok {
+		desiredNotPointer, ok := d.(DefaultObjectAccessControlProjectTeam)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a DefaultObjectAccessControlProjectTeam or *DefaultObjectAccessControlProjectTeam", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*DefaultObjectAccessControlProjectTeam)
+	if !ok {
+		actualNotPointer, ok := a.(DefaultObjectAccessControlProjectTeam)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a DefaultObjectAccessControlProjectTeam", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.ProjectNumber, actual.ProjectNumber, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ProjectNumber")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Team, actual.Team, dcl.DiffInfo{OutputOnly: true, Type: "EnumType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Team")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+// urlNormalized returns a copy of the resource struct with values normalized
+// for URL substitutions. For instance, it converts long-form self-links to
+// short-form so they can be substituted in.
+func (r *DefaultObjectAccessControl) urlNormalized() *DefaultObjectAccessControl {
+	normalized := dcl.Copy(*r).(DefaultObjectAccessControl)
+	normalized.Project = dcl.SelfLinkToName(r.Project)
+	normalized.Bucket = dcl.SelfLinkToName(r.Bucket)
+	normalized.Domain = dcl.SelfLinkToName(r.Domain)
+	normalized.Email = dcl.SelfLinkToName(r.Email)
+	normalized.Entity = dcl.SelfLinkToName(r.Entity)
+	normalized.EntityId = dcl.SelfLinkToName(r.EntityId)
+	return &normalized
+}
+
+func (r *DefaultObjectAccessControl) updateURL(userBasePath, updateName string) (string, error) {
+	nr := r.urlNormalized()
+	if updateName == "PatchDefaultObjectAccessControl" {
+		fields := map[string]interface{}{
+			"bucket":  dcl.ValueOrEmptyString(nr.Bucket),
+			"entity":  dcl.ValueOrEmptyString(nr.Entity),
+			"project": dcl.ValueOrEmptyString(nr.Project),
+		}
+		return dcl.URL("b/{{bucket}}/defaultObjectAcl/{{entity}}?userProject={{project}}", nr.basePath(), userBasePath, fields), nil
+
+	}
+
+	return "", fmt.Errorf("unknown update name: %s", updateName)
+}
+
+// marshal encodes the DefaultObjectAccessControl resource into JSON for a Create request, and
+// performs transformations from the resource schema to the API schema if
+// necessary.
+func (r *DefaultObjectAccessControl) marshal(c *Client) ([]byte, error) {
+	m, err := expandDefaultObjectAccessControl(c, r)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling DefaultObjectAccessControl: %w", err)
+	}
+
+	return json.Marshal(m)
+}
+
+// unmarshalDefaultObjectAccessControl decodes JSON responses into the DefaultObjectAccessControl resource schema.
+func unmarshalDefaultObjectAccessControl(b []byte, c *Client, res *DefaultObjectAccessControl) (*DefaultObjectAccessControl, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return unmarshalMapDefaultObjectAccessControl(m, c, res)
+}
+
+func unmarshalMapDefaultObjectAccessControl(m map[string]interface{}, c *Client, res *DefaultObjectAccessControl) (*DefaultObjectAccessControl, error) {
+
+	flattened := flattenDefaultObjectAccessControl(c, m, res)
+	if flattened == nil {
+		return nil, fmt.Errorf("attempted to flatten empty json object")
+	}
+	return flattened, nil
+}
+
+// expandDefaultObjectAccessControl expands DefaultObjectAccessControl into a JSON request object.
+func expandDefaultObjectAccessControl(c *Client, f *DefaultObjectAccessControl) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	res := f
+	_ = res
+	if v, err := dcl.EmptyValue(); err != nil {
+		return nil, fmt.Errorf("error expanding Project into project: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["project"] = v
+	}
+	if v, err := dcl.EmptyValue(); err != nil {
+		return nil, fmt.Errorf("error expanding Bucket into bucket: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["bucket"] = v
+	}
+	if v := f.Entity; dcl.ValueShouldBeSent(v) {
+		m["entity"] = v
+	}
+	if v := f.Role; dcl.ValueShouldBeSent(v) {
+		m["role"] = v
+	}
+
+	return m, nil
+}
+
+// flattenDefaultObjectAccessControl flattens DefaultObjectAccessControl from a JSON request object into the
+// DefaultObjectAccessControl type.
+func flattenDefaultObjectAccessControl(c *Client, i interface{}, res *DefaultObjectAccessControl) *DefaultObjectAccessControl {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if len(m) == 0 {
+		return nil
+	}
+
+	resultRes := &DefaultObjectAccessControl{}
+	resultRes.Project = dcl.FlattenString(m["project"])
+	resultRes.Bucket = dcl.FlattenString(m["bucket"])
+	resultRes.Domain = dcl.FlattenString(m["domain"])
+	resultRes.Email = dcl.FlattenString(m["email"])
+	resultRes.Entity = dcl.FlattenString(m["entity"])
+	resultRes.EntityId = dcl.FlattenString(m["entityId"])
+	resultRes.ProjectTeam = flattenDefaultObjectAccessControlProjectTeam(c, m["projectTeam"], res)
+	resultRes.Role = flattenDefaultObjectAccessControlRoleEnum(m["role"])
+
+	return resultRes
+}
+
+// expandDefaultObjectAccessControlProjectTeamMap expands the contents of DefaultObjectAccessControlProjectTeam into a JSON
+// request object.
+func expandDefaultObjectAccessControlProjectTeamMap(c *Client, f map[string]DefaultObjectAccessControlProjectTeam, res *DefaultObjectAccessControl) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandDefaultObjectAccessControlProjectTeam(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandDefaultObjectAccessControlProjectTeamSlice expands the contents of DefaultObjectAccessControlProjectTeam into a JSON
+// request object.
+func expandDefaultObjectAccessControlProjectTeamSlice(c *Client, f []DefaultObjectAccessControlProjectTeam, res *DefaultObjectAccessControl) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandDefaultObjectAccessControlProjectTeam(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenDefaultObjectAccessControlProjectTeamMap flattens the contents of DefaultObjectAccessControlProjectTeam from a JSON
+// response object.
+func flattenDefaultObjectAccessControlProjectTeamMap(c *Client, i interface{}, res *DefaultObjectAccessControl) map[string]DefaultObjectAccessControlProjectTeam {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]DefaultObjectAccessControlProjectTeam{}
+	}
+
+	if len(a) == 0 {
+		return map[string]DefaultObjectAccessControlProjectTeam{}
+	}
+
+	items := make(map[string]DefaultObjectAccessControlProjectTeam)
+	for k, item := range a {
+		items[k] = *flattenDefaultObjectAccessControlProjectTeam(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenDefaultObjectAccessControlProjectTeamSlice flattens the contents of DefaultObjectAccessControlProjectTeam from a JSON
+// response object.
+func flattenDefaultObjectAccessControlProjectTeamSlice(c *Client, i interface{}, res *DefaultObjectAccessControl) []DefaultObjectAccessControlProjectTeam {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []DefaultObjectAccessControlProjectTeam{}
+	}
+
+	if len(a) == 0 {
+		return []DefaultObjectAccessControlProjectTeam{}
+	}
+
+	items := make([]DefaultObjectAccessControlProjectTeam, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenDefaultObjectAccessControlProjectTeam(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandDefaultObjectAccessControlProjectTeam expands an instance of DefaultObjectAccessControlProjectTeam into a JSON
+// request object.
+func expandDefaultObjectAccessControlProjectTeam(c *Client, f *DefaultObjectAccessControlProjectTeam, res *DefaultObjectAccessControl) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenDefaultObjectAccessControlProjectTeam flattens an instance of DefaultObjectAccessControlProjectTeam from a JSON
+// response object.
+func flattenDefaultObjectAccessControlProjectTeam(c *Client, i interface{}, res *DefaultObjectAccessControl) *DefaultObjectAccessControlProjectTeam {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &DefaultObjectAccessControlProjectTeam{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyDefaultObjectAccessControlProjectTeam
+	}
+	r.ProjectNumber = dcl.FlattenString(m["projectNumber"])
+	r.Team = flattenDefaultObjectAccessControlProjectTeamTeamEnum(m["team"])
+
+	return r
+}
+
+// flattenDefaultObjectAccessControlProjectTeamTeamEnumMap flattens the contents of DefaultObjectAccessControlProjectTeamTeamEnum from a JSON
+// response object.
+func flattenDefaultObjectAccessControlProjectTeamTeamEnumMap(c *Client, i interface{}, res *DefaultObjectAccessControl) map[string]DefaultObjectAccessControlProjectTeamTeamEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]DefaultObjectAccessControlProjectTeamTeamEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]DefaultObjectAccessControlProjectTeamTeamEnum{}
+	}
+
+	items := make(map[string]DefaultObjectAccessControlProjectTeamTeamEnum)
+	for k, item := range a {
+		items[k] = *flattenDefaultObjectAccessControlProjectTeamTeamEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenDefaultObjectAccessControlProjectTeamTeamEnumSlice flattens the contents of DefaultObjectAccessControlProjectTeamTeamEnum from a JSON
+// response object.
+func flattenDefaultObjectAccessControlProjectTeamTeamEnumSlice(c *Client, i interface{}, res *DefaultObjectAccessControl) []DefaultObjectAccessControlProjectTeamTeamEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []DefaultObjectAccessControlProjectTeamTeamEnum{}
+	}
+
+	if len(a) == 0 {
+		return []DefaultObjectAccessControlProjectTeamTeamEnum{}
+	}
+
+	items := make([]DefaultObjectAccessControlProjectTeamTeamEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenDefaultObjectAccessControlProjectTeamTeamEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenDefaultObjectAccessControlProjectTeamTeamEnum asserts that an interface is a string, and returns a
+// pointer to a *DefaultObjectAccessControlProjectTeamTeamEnum with the same value as that string.
+func flattenDefaultObjectAccessControlProjectTeamTeamEnum(i interface{}) *DefaultObjectAccessControlProjectTeamTeamEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return DefaultObjectAccessControlProjectTeamTeamEnumRef(s)
+}
+
+// flattenDefaultObjectAccessControlRoleEnumMap flattens the contents of DefaultObjectAccessControlRoleEnum from a JSON
+// response object.
+func flattenDefaultObjectAccessControlRoleEnumMap(c *Client, i interface{}, res *DefaultObjectAccessControl) map[string]DefaultObjectAccessControlRoleEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]DefaultObjectAccessControlRoleEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]DefaultObjectAccessControlRoleEnum{}
+	}
+
+	items := make(map[string]DefaultObjectAccessControlRoleEnum)
+	for k, item := range a {
+		items[k] = *flattenDefaultObjectAccessControlRoleEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenDefaultObjectAccessControlRoleEnumSlice flattens the contents of DefaultObjectAccessControlRoleEnum from a JSON
+// response object.
+func flattenDefaultObjectAccessControlRoleEnumSlice(c *Client, i interface{}, res *DefaultObjectAccessControl) []DefaultObjectAccessControlRoleEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []DefaultObjectAccessControlRoleEnum{}
+	}
+
+	if len(a) == 0 {
+		return []DefaultObjectAccessControlRoleEnum{}
+	}
+
+	items := make([]DefaultObjectAccessControlRoleEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenDefaultObjectAccessControlRoleEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenDefaultObjectAccessControlRoleEnum asserts that an interface is a string, and returns a
+// pointer to a *DefaultObjectAccessControlRoleEnum with the same value as that string.
+func flattenDefaultObjectAccessControlRoleEnum(i interface{}) *DefaultObjectAccessControlRoleEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return DefaultObjectAccessControlRoleEnumRef(s)
+}
+
+// This function returns a matcher that checks whether a serialized resource matches this resource
+// in its parameters (as defined by the fields in a Get, which definitionally define resource
+// identity).  This is useful in extracting the element from a List call.
+func (r *DefaultObjectAccessControl) matcher(c *Client) func([]byte) bool {
+	return func(b []byte) bool {
+		cr, err := unmarshalDefaultObjectAccessControl(b, c, r)
+		if err != nil {
+			c.Config.Logger.Warning("failed to unmarshal provided resource in matcher.")
+			return false
+		}
+		nr := r.urlNormalized()
+		ncr := cr.urlNormalized()
+		c.Config.Logger.Infof("looking for %v\nin %v", nr, ncr)
+
+		if nr.Project == nil && ncr.Project == nil {
+			c.Config.Logger.Info("Both Project fields null - considering equal.")
+		} else if nr.Project == nil || ncr.Project == nil {
+			c.Config.Logger.Info("Only one Project field is null - considering unequal.")
+			return false
+		} else if *nr.Project != *ncr.Project {
+			return false
+		}
+		if nr.Bucket == nil && ncr.Bucket == nil {
+			c.Config.Logger.Info("Both Bucket fields null - considering equal.")
+		} else if nr.Bucket == nil || ncr.Bucket == nil {
+			c.Config.Logger.Info("Only one Bucket field is null - considering unequal.")
+			return false
+		} else if *nr.Bucket != *ncr.Bucket {
+			return false
+		}
+		if nr.Entity == nil && ncr.Entity == nil {
+			c.Config.Logger.Info("Both Entity fields null - considering equal.")
+		} else if nr.Entity == nil || ncr.Entity == nil {
+			c.Config.Logger.Info("Only one Entity field is null - considering unequal.")
+			return false
+		} else if *nr.Entity != *ncr.Entity {
+			return false
+		}
+		return true
+	}
+}
+
+type defaultObjectAccessControlDiff struct {
+	// The diff should include one or the other of RequiresRecreate or UpdateOp.
+	RequiresRecreate bool
+	UpdateOp         defaultObjectAccessControlApiOperation
+	FieldName        string // used for error logging
+}
+
+func convertFieldDiffsToDefaultObjectAccessControlDiffs(config *dcl.Config, fds []*dcl.FieldDiff, opts []dcl.ApplyOption) ([]defaultObjectAccessControlDiff, error) {
+	opNamesToFieldDiffs := make(map[string][]*dcl.FieldDiff)
+	// Map each operation name to the field diffs associated with it.
+	for _, fd := range fds {
+		for _, ro := range fd.ResultingOperation {
+			if fieldDiffs, ok := opNamesToFieldDiffs[ro]; ok {
+				fieldDiffs = append(fieldDiffs, fd)
+				opNamesToFieldDiffs[ro] = fieldDiffs
+			} else {
+				config.Logger.Infof("%s required due to diff: %v", ro, fd)
+				opNamesToFieldDiffs[ro] = []*dcl.FieldDiff{fd}
+			}
+		}
+	}
+	var diffs []defaultObjectAccessControlDiff
+	// For each operation name, create a defaultObjectAccessControlDiff which contains the operation.
+	for opName, fieldDiffs := range opNamesToFieldDiffs {
+		// Use the first field diff's field name for logging required recreate error.
+		diff := defaultObjectAccessControlDiff{FieldName: fieldDiffs[0].FieldName}
+		if opName == "Recreate" {
+			diff.RequiresRecreate = true
+		} else {
+			apiOp, err := convertOpNameToDefaultObjectAccessControlApiOperation(opName, fieldDiffs, opts...)
+			if err != nil {
+				return diffs, err
+			}
+			diff.UpdateOp = apiOp
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+func convertOpNameToDefaultObjectAccessControlApiOperation(opName string, fieldDiffs []*dcl.FieldDiff, opts ...dcl.ApplyOption) (defaultObjectAccessControlApiOperation, error) {
+	switch opName {
+
+	case "updateDefaultObjectAccessControlPatchDefaultObjectAccessControlOperation":
+		return &updateDefaultObjectAccessControlPatchDefaultObjectAccessControlOperation{FieldDiffs: fieldDiffs}, nil
+
+	default:
+		return nil, fmt.Errorf("no such operation with name: %v", opName)
+	}
+}
+
+func extractDefaultObjectAccessControlFields(r *DefaultObjectAccessControl) error {
+	vProjectTeam := r.ProjectTeam
+	if vProjectTeam == nil {
+		// note: explicitly not the empty object.
+		vProjectTeam = &DefaultObjectAccessControlProjectTeam{}
+	}
+	if err := extractDefaultObjectAccessControlProjectTeamFields(r, vProjectTeam); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vProjectTeam) {
+		r.ProjectTeam = vProjectTeam
+	}
+	return nil
+}
+func extractDefaultObjectAccessControlProjectTeamFields(r *DefaultObjectAccessControl, o *DefaultObjectAccessControlProjectTeam) error {
+	return nil
+}
+
+func postReadExtractDefaultObjectAccessControlFields(r *DefaultObjectAccessControl) error {
+	vProjectTeam := r.ProjectTeam
+	if vProjectTeam == nil {
+		// note: explicitly not the empty object.
+		vProjectTeam = &DefaultObjectAccessControlProjectTeam{}
+	}
+	if err := postReadExtractDefaultObjectAccessControlProjectTeamFields(r, vProjectTeam); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vProjectTeam) {
+		r.ProjectTeam = vProjectTeam
+	}
+	return nil
+}
+func postReadExtractDefaultObjectAccessControlProjectTeamFields(r *DefaultObjectAccessControl, o *DefaultObjectAccessControlProjectTeam) error {
+	return nil
+}
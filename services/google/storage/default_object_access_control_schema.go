@@ -0,0 +1,211 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLDefaultObjectAccessControlSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Storage/DefaultObjectAccessControl",
+			Description: "The Storage DefaultObjectAccessControl resource",
+			StructName:  "DefaultObjectAccessControl",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a DefaultObjectAccessControl",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "defaultObjectAccessControl",
+						Required:    true,
+						Description: "A full instance of a DefaultObjectAccessControl",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a DefaultObjectAccessControl",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "defaultObjectAccessControl",
+						Required:    true,
+						Description: "A full instance of a DefaultObjectAccessControl",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a DefaultObjectAccessControl",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "defaultObjectAccessControl",
+						Required:    true,
+						Description: "A full instance of a DefaultObjectAccessControl",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all DefaultObjectAccessControl",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "bucket",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many DefaultObjectAccessControl",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+					dcl.PathParameters{
+						Name:     "bucket",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"DefaultObjectAccessControl": &dcl.Component{
+					Title:           "DefaultObjectAccessControl",
+					ID:              "b/{{bucket}}/defaultObjectAcl/{{entity}}?userProject={{project}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"project",
+							"bucket",
+							"entity",
+							"role",
+						},
+						Properties: map[string]*dcl.Property{
+							"bucket": &dcl.Property{
+								Type:        "string",
+								GoName:      "Bucket",
+								Description: "The name of the bucket.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Storage/Bucket",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"domain": &dcl.Property{
+								Type:        "string",
+								GoName:      "Domain",
+								ReadOnly:    true,
+								Description: "The domain associated with the entity, if any.",
+								Immutable:   true,
+							},
+							"email": &dcl.Property{
+								Type:        "string",
+								GoName:      "Email",
+								ReadOnly:    true,
+								Description: "The email address associated with the entity, if any.",
+								Immutable:   true,
+							},
+							"entity": &dcl.Property{
+								Type:        "string",
+								GoName:      "Entity",
+								Description: "The entity holding the permission, in one of the following forms: user-{{userId}}, user-{{email}}, group-{{groupId}}, group-{{email}}, domain-{{domain}}, project-{{team-projectId}}, allUsers, allAuthenticatedUsers. Examples: The user liz@example.com would be user-liz@example.com. The group example@googlegroups.com would be group-example@googlegroups.com. To refer to all members of the Google Apps for Business domain example.com, the entity would be domain-example.com.",
+								Immutable:   true,
+							},
+							"entityId": &dcl.Property{
+								Type:        "string",
+								GoName:      "EntityId",
+								ReadOnly:    true,
+								Description: "The ID for the entity, if any.",
+								Immutable:   true,
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "The project ID of the project containing the bucket.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"projectTeam": &dcl.Property{
+								Type:        "object",
+								GoName:      "ProjectTeam",
+								GoType:      "DefaultObjectAccessControlProjectTeam",
+								ReadOnly:    true,
+								Description: "The project team associated with the entity, if any.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"projectNumber": &dcl.Property{
+										Type:        "string",
+										GoName:      "ProjectNumber",
+										ReadOnly:    true,
+										Description: "The project number.",
+										Immutable:   true,
+									},
+									"team": &dcl.Property{
+										Type:        "string",
+										GoName:      "Team",
+										GoType:      "DefaultObjectAccessControlProjectTeamTeamEnum",
+										ReadOnly:    true,
+										Description: "The team. Possible values: editors, owners, viewers",
+										Immutable:   true,
+										Enum: []string{
+											"editors",
+											"owners",
+											"viewers",
+										},
+									},
+								},
+							},
+							"role": &dcl.Property{
+								Type:        "string",
+								GoName:      "Role",
+								GoType:      "DefaultObjectAccessControlRoleEnum",
+								Description: "The access permission for the entity. Possible values: OWNER, READER",
+								Enum: []string{
+									"OWNER",
+									"READER",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
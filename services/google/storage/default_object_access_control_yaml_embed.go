@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package storage -var YAML_default_object_access_control blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/storage/default_object_access_control.yaml
+
+package storage
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/storage/default_object_access_control.yaml
+var YAML_default_object_access_control = []byte("info:\n  title: Storage/DefaultObjectAccessControl\n  description: The Storage DefaultObjectAccessControl resource\n  x-dcl-struct-name: DefaultObjectAccessControl\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a DefaultObjectAccessControl\n    parameters:\n    - name: defaultObjectAccessControl\n      required: true\n      description: A full instance of a DefaultObjectAccessControl\n  apply:\n    description: The function used to apply information about a DefaultObjectAccessControl\n    parameters:\n    - name: defaultObjectAccessControl\n      required: true\n      description: A full instance of a DefaultObjectAccessControl\n  delete:\n    description: The function used to delete a DefaultObjectAccessControl\n    parameters:\n    - name: defaultObjectAccessControl\n      required: true\n      description: A full instance of a DefaultObjectAccessControl\n  deleteAll:\n    description: The function used to delete all DefaultObjectAccessControl\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: bucket\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many DefaultObjectAccessControl\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n    - name: bucket\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    DefaultObjectAccessControl:\n      title: DefaultObjectAccessControl\n      x-dcl-id: b/{{bucket}}/defaultObjectAcl/{{entity}}?userProject={{project}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - project\n      - bucket\n      - entity\n      - role\n      properties:\n        bucket:\n          type: string\n          x-dcl-go-name: Bucket\n          description: The name of the bucket.\n          x-dcl-references:\n          - resource: Storage/Bucket\n            field: name\n            parent: true\n        domain:\n          type: string\n          x-dcl-go-name: Domain\n          readOnly: true\n          description: The domain associated with the entity, if any.\n          x-kubernetes-immutable: true\n        email:\n          type: string\n          x-dcl-go-name: Email\n          readOnly: true\n          description: The email address associated with the entity, if any.\n          x-kubernetes-immutable: true\n        entity:\n          type: string\n          x-dcl-go-name: Entity\n          description: 'The entity holding the permission, in one of the following\n            forms: user-{{userId}}, user-{{email}}, group-{{groupId}}, group-{{email}},\n            domain-{{domain}}, project-{{team-projectId}}, allUsers, allAuthenticatedUsers.\n            Examples: The user liz@example.com would be user-liz@example.com. The\n            group example@googlegroups.com would be group-example@googlegroups.com.\n            To refer to all members of the Google Apps for Business domain example.com,\n            the entity would be domain-example.com.'\n          x-kubernetes-immutable: true\n        entityId:\n          type: string\n          x-dcl-go-name: EntityId\n          readOnly: true\n          description: The ID for the entity, if any.\n          x-kubernetes-immutable: true\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: The project ID of the project containing the bucket.\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        projectTeam:\n          type: object\n          x-dcl-go-name: ProjectTeam\n          x-dcl-go-type: DefaultObjectAccessControlProjectTeam\n          readOnly: true\n          description: The project team associated with the entity, if any.\n          x-kubernetes-immutable: true\n          properties:\n            projectNumber:\n              type: string\n              x-dcl-go-name: ProjectNumber\n              readOnly: true\n              description: The project number.\n              x-kubernetes-immutable: true\n            team:\n              type: string\n              x-dcl-go-name: Team\n              x-dcl-go-type: DefaultObjectAccessControlProjectTeamTeamEnum\n              readOnly: true\n              description: 'The team. Possible values: editors, owners, viewers'\n              x-kubernetes-immutable: true\n              enum:\n              - editors\n              - owners\n              - viewers\n        role:\n          type: string\n          x-dcl-go-name: Role\n          x-dcl-go-type: DefaultObjectAccessControlRoleEnum\n          description: 'The access permission for the entity. Possible values: OWNER,\n            READER'\n          enum:\n          - OWNER\n          - READER\n")
+
+// 4930 bytes
+// MD5: 186c5beb34b78fb9003001d9e6f62ce7
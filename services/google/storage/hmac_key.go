@@ -0,0 +1,451 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"google.golang.org/api/googleapi"
+)
+
+type HmacKey struct {
+	Name                *string           `json:"name"`
+	TimeCreated         *string           `json:"timeCreated"`
+	Updated             *string           `json:"updated"`
+	Secret              *string           `json:"secret"`
+	State               *HmacKeyStateEnum `json:"state"`
+	Project             *string           `json:"project"`
+	ServiceAccountEmail *string           `json:"serviceAccountEmail"`
+}
+
+func (r *HmacKey) String() string {
+	return dcl.SprintResource(r)
+}
+
+// The enum HmacKeyStateEnum.
+type HmacKeyStateEnum string
+
+// HmacKeyStateEnumRef returns a *HmacKeyStateEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func HmacKeyStateEnumRef(s string) *HmacKeyStateEnum {
+	v := HmacKeyStateEnum(s)
+	return &v
+}
+
+func (v HmacKeyStateEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"ACTIVE", "INACTIVE", "DELETED"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "HmacKeyStateEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// Describe returns a simple description of this resource to ensure that automated tools
+// can identify it.
+func (r *HmacKey) Describe() dcl.ServiceTypeVersion {
+	return dcl.ServiceTypeVersion{
+		Service: "storage",
+		Type:    "HmacKey",
+		Version: "storage",
+	}
+}
+
+func (r *HmacKey) ID() (string, error) {
+	if err := extractHmacKeyFields(r); err != nil {
+		return "", err
+	}
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"name":                  dcl.ValueOrEmptyString(nr.Name),
+		"time_created":          dcl.ValueOrEmptyString(nr.TimeCreated),
+		"updated":               dcl.ValueOrEmptyString(nr.Updated),
+		"secret":                dcl.ValueOrEmptyString(nr.Secret),
+		"state":                 dcl.ValueOrEmptyString(nr.State),
+		"project":               dcl.ValueOrEmptyString(nr.Project),
+		"service_account_email": dcl.ValueOrEmptyString(nr.ServiceAccountEmail),
+	}
+	return dcl.Nprintf("projects/{{project}}/hmacKeys/{{name}}", params), nil
+}
+
+const HmacKeyMaxPage = -1
+
+type HmacKeyList struct {
+	Items []*HmacKey
+
+	nextToken string
+
+	pageSize int32
+
+	resource *HmacKey
+}
+
+func (l *HmacKeyList) HasNext() bool {
+	return l.nextToken != ""
+}
+
+func (l *HmacKeyList) NextPageToken() string {
+	return l.nextToken
+}
+
+func (l *HmacKeyList) Next(ctx context.Context, c *Client) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HmacKey{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if !l.HasNext() {
+		return fmt.Errorf("no next page")
+	}
+	items, token, err := c.listHmacKey(ctx, l.resource, l.nextToken, l.pageSize)
+	if err != nil {
+		return err
+	}
+	l.Items = items
+	l.nextToken = token
+	return err
+}
+
+func (c *Client) ListHmacKey(ctx context.Context, project string) (*HmacKeyList, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&HmacKey{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HmacKey{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	return c.ListHmacKeyWithMaxResults(ctx, project, HmacKeyMaxPage)
+
+}
+
+func (c *Client) ListHmacKeyWithMaxResults(ctx context.Context, project string, pageSize int32) (*HmacKeyList, error) {
+	return c.ListHmacKeyWithPageToken(ctx, project, pageSize, "")
+}
+
+func (c *Client) ListHmacKeyWithPageToken(ctx context.Context, project string, pageSize int32, pageToken string) (*HmacKeyList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HmacKey{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// Create a resource object so that we can use proper url normalization methods.
+	r := &HmacKey{
+		Project: &project,
+	}
+	items, token, err := c.listHmacKey(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &HmacKeyList{
+		Items:     items,
+		nextToken: token,
+		pageSize:  pageSize,
+		resource:  r,
+	}, nil
+}
+
+func (c *Client) GetHmacKey(ctx context.Context, r *HmacKey) (*HmacKey, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&HmacKey{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HmacKey{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// This is *purposefully* supressing errors.
+	// This function is used with url-normalized values + not URL normalized values.
+	// URL Normalized values will throw unintentional errors, since those values are not of the proper parent form.
+	extractHmacKeyFields(r)
+
+	b, err := c.getHmacKeyRaw(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			return nil, &googleapi.Error{
+				Code:    404,
+				Message: err.Error(),
+			}
+		}
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
+	}
+	result, err := unmarshalHmacKey(b, c, r)
+	if err != nil {
+		return nil, err
+	}
+	result.Project = r.Project
+	result.Name = r.Name
+
+	c.Config.Logger.InfoWithContextf(ctx, "Retrieved raw result state: %v", result)
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with specified state: %v", r)
+	result, err = canonicalizeHmacKeyNewState(c, result, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := postReadExtractHmacKeyFields(result); err != nil {
+		return result, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created result state: %v", result)
+
+	return result, nil
+}
+
+func (c *Client) DeleteHmacKey(ctx context.Context, r *HmacKey) error {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&HmacKey{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HmacKey{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if r == nil {
+		return fmt.Errorf("HmacKey resource is nil")
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Deleting HmacKey...")
+	deleteOp := deleteHmacKeyOperation{}
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteHmacKeyAsync(ctx context.Context, r *HmacKey) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteHmacKey(ctx, r)
+	})
+}
+
+// DeleteAllHmacKey deletes all resources that the filter functions returns true on.
+func (c *Client) DeleteAllHmacKey(ctx context.Context, project string, filter func(*HmacKey) bool) error {
+	listObj, err := c.ListHmacKey(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	err = c.deleteAllHmacKey(ctx, filter, listObj.Items)
+	if err != nil {
+		return err
+	}
+	for listObj.HasNext() {
+		err = listObj.Next(ctx, c)
+		if err != nil {
+			return nil
+		}
+		err = c.deleteAllHmacKey(ctx, filter, listObj.Items)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) ApplyHmacKey(ctx context.Context, rawDesired *HmacKey, opts ...dcl.ApplyOption) (*HmacKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HmacKey{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&HmacKey{}).Describe())
+	var resultNewState *HmacKey
+	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		newState, err := applyHmacKeyHelper(c, ctx, rawDesired, opts...)
+		resultNewState = newState
+		if err != nil {
+			// If the error is 409, there is conflict in resource update.
+			// Here we want to apply changes based on latest state.
+			if dcl.IsConflictError(err) {
+				return &dcl.RetryDetails{}, dcl.OperationNotDone{Err: err}
+			}
+			return nil, err
+		}
+		return nil, nil
+	}, c.Config.RetryProvider)
+	return resultNewState, err
+}
+
+func (c *Client) ApplyHmacKeyAsync(ctx context.Context, rawDesired *HmacKey, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyHmacKey(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffHmacKey returns the field-level differences between rawDesired and the
+// live HmacKey without modifying it. If the HmacKey does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffHmacKey(ctx context.Context, rawDesired *HmacKey, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&HmacKey{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&HmacKey{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractHmacKeyFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.hmacKeyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("HmacKey %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
+func applyHmacKeyHelper(c *Client, ctx context.Context, rawDesired *HmacKey, opts ...dcl.ApplyOption) (*HmacKey, error) {
+	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyHmacKey...")
+	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
+
+	// 1.1: Validation of user-specified fields in desired state.
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
+
+	if err := extractHmacKeyFields(rawDesired); err != nil {
+		return nil, err
+	}
+
+	initial, desired, fieldDiffs, err := c.hmacKeyDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a diff: %w", err)
+	}
+
+	diffs, err := convertFieldDiffsToHmacKeyDiffs(c.Config, fieldDiffs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(magic-modules-eng): 2.2 Feasibility check (all updates are feasible so far).
+
+	// 2.3: Lifecycle Directive Check
+	var create bool
+	lp := dcl.FetchLifecycleParams(opts)
+	if initial == nil {
+		if dcl.HasLifecycleParam(lp, dcl.BlockCreation) {
+			return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Creation blocked by lifecycle params: %#v.", desired)}
+		}
+		create = true
+	} else if dcl.HasLifecycleParam(lp, dcl.BlockAcquire) {
+		return nil, dcl.ApplyInfeasibleError{
+			Message: fmt.Sprintf("Resource already exists - apply blocked by lifecycle params: %#v.", initial),
+		}
+	} else {
+		for _, d := range diffs {
+			if d.RequiresRecreate {
+				return nil, dcl.ApplyInfeasibleError{
+					Message: fmt.Sprintf("infeasible update: (%v) would require recreation", d),
+				}
+			}
+			if dcl.HasLifecycleParam(lp, dcl.BlockModification) {
+				return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Modification blocked, diff (%v) unresolvable.", d)}
+			}
+		}
+	}
+
+	// 2.4 Imperative Request Planning
+	var ops []hmacKeyApiOperation
+	if create {
+		ops = append(ops, &createHmacKeyOperation{})
+	} else {
+		for _, d := range diffs {
+			ops = append(ops, d.UpdateOp)
+		}
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created plan: %#v", ops)
+
+	// 2.5 Request Actuation
+	for _, op := range ops {
+		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
+		if err := op.do(ctx, desired, c); err != nil {
+			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
+		}
+		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
+	}
+	return applyHmacKeyDiff(c, ctx, desired, rawDesired, ops, opts...)
+}
+
+func applyHmacKeyDiff(c *Client, ctx context.Context, desired *HmacKey, rawDesired *HmacKey, ops []hmacKeyApiOperation, opts ...dcl.ApplyOption) (*HmacKey, error) {
+	// 3.1, 3.2a Retrieval of raw new state & canonicalization with desired state
+	c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state...")
+	rawNew, err := c.GetHmacKey(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	// Get additional values from the first response.
+	// These values should be merged into the newState above.
+	if len(ops) > 0 {
+		lastOp := ops[len(ops)-1]
+		if o, ok := lastOp.(*createHmacKeyOperation); ok {
+			if r, hasR := o.FirstResponse(); hasR {
+
+				c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state from operation...")
+
+				fullResp, err := unmarshalMapHmacKey(r, c, rawDesired)
+				if err != nil {
+					return nil, err
+				}
+
+				rawNew, err = canonicalizeHmacKeyNewState(c, rawNew, fullResp)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with raw desired state: %v", rawDesired)
+	// 3.2b Canonicalization of raw new state using raw desired state
+	newState, err := canonicalizeHmacKeyNewState(c, rawNew, rawDesired)
+	if err != nil {
+		return rawNew, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created canonical new state: %v", newState)
+	// 3.3 Comparison of the new state and raw desired state.
+	// TODO(magic-modules-eng): EVENTUALLY_CONSISTENT_UPDATE
+	newDesired, err := canonicalizeHmacKeyDesiredState(rawDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if err := postReadExtractHmacKeyFields(newState); err != nil {
+		return newState, err
+	}
+
+	// Need to ensure any transformations made here match acceptably in differ.
+	if err := postReadExtractHmacKeyFields(newDesired); err != nil {
+		return newState, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Diffing using canonicalized desired state: %v", newDesired)
+	newDiffs, err := diffHmacKey(c, newDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if len(newDiffs) == 0 {
+		c.Config.Logger.InfoWithContext(ctx, "No diffs found. Apply was successful.")
+	} else {
+		c.Config.Logger.InfoWithContextf(ctx, "Found diffs: %v", newDiffs)
+		diffMessages := make([]string, len(newDiffs))
+		for i, d := range newDiffs {
+			diffMessages[i] = fmt.Sprintf("%v", d)
+		}
+		return newState, dcl.DiffAfterApplyError{Diffs: diffMessages}
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Done Apply.")
+	return newState, nil
+}
@@ -0,0 +1,709 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func (r *HmacKey) validate() error {
+
+	if err := dcl.Required(r, "serviceAccountEmail"); err != nil {
+		return err
+	}
+	if err := dcl.RequiredParameter(r.Project, "Project"); err != nil {
+		return err
+	}
+	return nil
+}
+func (r *HmacKey) basePath() string {
+	params := map[string]interface{}{}
+	return dcl.Nprintf("https://www.googleapis.com/storage/v1/", params)
+}
+
+func (r *HmacKey) getURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+		"name":    dcl.ValueOrEmptyString(nr.Name),
+	}
+	return dcl.URL("projects/{{project}}/hmacKeys/{{name}}", nr.basePath(), userBasePath, params), nil
+}
+
+func (r *HmacKey) listURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+	}
+	return dcl.URL("projects/{{project}}/hmacKeys", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *HmacKey) createURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project":             dcl.ValueOrEmptyString(nr.Project),
+		"serviceAccountEmail": dcl.ValueOrEmptyString(nr.ServiceAccountEmail),
+	}
+	return dcl.URL("projects/{{project}}/hmacKeys?serviceAccountEmail={{serviceAccountEmail}}", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *HmacKey) deleteURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project": dcl.ValueOrEmptyString(nr.Project),
+		"name":    dcl.ValueOrEmptyString(nr.Name),
+	}
+	return dcl.URL("projects/{{project}}/hmacKeys/{{name}}", nr.basePath(), userBasePath, params), nil
+}
+
+// hmacKeyApiOperation represents a mutable operation in the underlying REST
+// API such as Create, Update, or Delete.
+type hmacKeyApiOperation interface {
+	do(context.Context, *HmacKey, *Client) error
+}
+
+// newUpdateHmacKeyUpdateHmacKeyRequest creates a request for an
+// HmacKey resource's UpdateHmacKey update type by filling in the update
+// fields based on the intended state of the resource.
+func newUpdateHmacKeyUpdateHmacKeyRequest(ctx context.Context, f *HmacKey, c *Client) (map[string]interface{}, error) {
+	req := map[string]interface{}{}
+	res := f
+	_ = res
+
+	if v := f.Name; !dcl.IsEmptyValueIndirect(v) {
+		req["accessId"] = v
+	}
+	if v := f.State; !dcl.IsEmptyValueIndirect(v) {
+		req["state"] = v
+	}
+	return req, nil
+}
+
+// marshalUpdateHmacKeyUpdateHmacKeyRequest converts the update into
+// the final JSON request body.
+func marshalUpdateHmacKeyUpdateHmacKeyRequest(c *Client, m map[string]interface{}) ([]byte, error) {
+
+	return json.Marshal(m)
+}
+
+type updateHmacKeyUpdateHmacKeyOperation struct {
+	// If the update operation has the REQUIRES_APPLY_OPTIONS trait, this will be populated.
+	// Usually it will be nil - this is to prevent us from accidentally depending on apply
+	// options, which should usually be unnecessary.
+	ApplyOptions []dcl.ApplyOption
+	FieldDiffs   []*dcl.FieldDiff
+}
+
+// do creates a request and sends it to the appropriate URL. In most operations,
+// do will transcribe a subset of the resource into a request object and send a
+// PUT request to a single URL.
+
+func (op *updateHmacKeyUpdateHmacKeyOperation) do(ctx context.Context, r *HmacKey, c *Client) error {
+	_, err := c.GetHmacKey(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	u, err := r.updateURL(c.Config.BasePath, "UpdateHmacKey")
+	if err != nil {
+		return err
+	}
+
+	req, err := newUpdateHmacKeyUpdateHmacKeyRequest(ctx, r, c)
+	if err != nil {
+		return err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created update: %#v", req)
+	body, err := marshalUpdateHmacKeyUpdateHmacKeyRequest(c, req)
+	if err != nil {
+		return err
+	}
+	_, err = dcl.SendRequest(ctx, c.Config, "PUT", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) listHmacKeyRaw(ctx context.Context, r *HmacKey, pageToken string, pageSize int32) ([]byte, error) {
+	u, err := r.urlNormalized().listURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string)
+	if pageToken != "" {
+		m["pageToken"] = pageToken
+	}
+
+	if pageSize != HmacKeyMaxPage {
+		m["pageSize"] = fmt.Sprintf("%v", pageSize)
+	}
+
+	u, err = dcl.AddQueryParams(u, m)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	return ioutil.ReadAll(resp.Response.Body)
+}
+
+type listHmacKeyOperation struct {
+	Items []map[string]interface{} `json:"items"`
+	Token string                   `json:"nextPageToken"`
+}
+
+func (c *Client) listHmacKey(ctx context.Context, r *HmacKey, pageToken string, pageSize int32) ([]*HmacKey, string, error) {
+	b, err := c.listHmacKeyRaw(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var m listHmacKeyOperation
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, "", err
+	}
+
+	var l []*HmacKey
+	for _, v := range m.Items {
+		res, err := unmarshalMapHmacKey(v, c, r)
+		if err != nil {
+			return nil, m.Token, err
+		}
+		res.Project = r.Project
+		l = append(l, res)
+	}
+
+	return l, m.Token, nil
+}
+
+func (c *Client) deleteAllHmacKey(ctx context.Context, f func(*HmacKey) bool, resources []*HmacKey) error {
+	var errors []string
+	for _, res := range resources {
+		if f(res) {
+			// We do not want deleteAll to fail on a deletion or else it will stop deleting other resources.
+			err := c.DeleteHmacKey(ctx, res)
+			if err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("%v", strings.Join(errors, "\n"))
+	} else {
+		return nil
+	}
+}
+
+type deleteHmacKeyOperation struct{}
+
+// Create operations are similar to Update operations, although they do not have
+// specific request objects. The Create request object is the json encoding of
+// the resource, which is modified by res.marshal to form the base request body.
+type createHmacKeyOperation struct {
+	response map[string]interface{}
+}
+
+func (op *createHmacKeyOperation) FirstResponse() (map[string]interface{}, bool) {
+	return op.response, len(op.response) > 0
+}
+
+func (c *Client) hmacKeyDiffsForRawDesired(ctx context.Context, rawDesired *HmacKey, opts ...dcl.ApplyOption) (initial, desired *HmacKey, diffs []*dcl.FieldDiff, err error) {
+	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
+	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
+	var fetchState *HmacKey
+	if sh := dcl.FetchStateHint(opts); sh != nil {
+		if r, ok := sh.(*HmacKey); !ok {
+			c.Config.Logger.WarningWithContextf(ctx, "Initial state hint was of the wrong type; expected HmacKey, got %T", sh)
+		} else {
+			fetchState = r
+		}
+	}
+	if fetchState == nil {
+		fetchState = rawDesired
+	}
+
+	if fetchState.Name == nil {
+		// We cannot perform a get because of lack of information. We have to assume
+		// that this is being created for the first time.
+		desired, err := canonicalizeHmacKeyDesiredState(rawDesired, nil)
+		return nil, desired, nil, err
+	}
+	// 1.2: Retrieval of raw initial state from API
+	rawInitial, err := c.GetHmacKey(ctx, fetchState)
+	if rawInitial == nil {
+		if !dcl.IsNotFound(err) {
+			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a HmacKey resource already exists: %s", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve HmacKey resource: %w", err)
+		}
+		c.Config.Logger.InfoWithContext(ctx, "Found that HmacKey resource did not exist.")
+		// Perform canonicalization to pick up defaults.
+		desired, err = canonicalizeHmacKeyDesiredState(rawDesired, rawInitial)
+		return nil, desired, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Found initial state for HmacKey: %v", rawInitial)
+	c.Config.Logger.InfoWithContextf(ctx, "Initial desired state for HmacKey: %v", rawDesired)
+
+	// The Get call applies postReadExtract and so the result may contain fields that are not part of API version.
+	if err := extractHmacKeyFields(rawInitial); err != nil {
+		return nil, nil, nil, err
+	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// 1.3: Canonicalize raw initial state into initial state.
+	initial, err = canonicalizeHmacKeyInitialState(rawInitial, rawDesired)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized initial state for HmacKey: %v", initial)
+
+	// 1.4: Canonicalize raw desired state into desired state.
+	desired, err = canonicalizeHmacKeyDesiredState(rawDesired, rawInitial, opts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized desired state for HmacKey: %v", desired)
+
+	// 2.1: Comparison of initial and desired state.
+	diffs, err = diffHmacKey(c, desired, initial, opts...)
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
+}
+
+func canonicalizeHmacKeyInitialState(rawInitial, rawDesired *HmacKey) (*HmacKey, error) {
+	// TODO(magic-modules-eng): write canonicalizer once relevant traits are added.
+	return rawInitial, nil
+}
+
+/*
+* Canonicalizers
+*
+* These are responsible for converting either a user-specified config or a
+* GCP API response to a standard format that can be used for difference checking.
+* */
+
+func canonicalizeHmacKeyDesiredState(rawDesired, rawInitial *HmacKey, opts ...dcl.ApplyOption) (*HmacKey, error) {
+
+	if rawInitial == nil {
+		// Since the initial state is empty, the desired state is all we have.
+		// We canonicalize the remaining nested objects with nil to pick up defaults.
+
+		return rawDesired, nil
+	}
+
+	canonicalDesired := &HmacKey{}
+	if dcl.IsZeroValue(rawDesired.Name) || (dcl.IsEmptyValueIndirect(rawDesired.Name) && dcl.IsEmptyValueIndirect(rawInitial.Name)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.Name = rawInitial.Name
+	} else {
+		canonicalDesired.Name = rawDesired.Name
+	}
+	if dcl.IsZeroValue(rawDesired.State) || (dcl.IsEmptyValueIndirect(rawDesired.State) && dcl.IsEmptyValueIndirect(rawInitial.State)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.State = rawInitial.State
+	} else {
+		canonicalDesired.State = rawDesired.State
+	}
+	if dcl.NameToSelfLink(rawDesired.Project, rawInitial.Project) {
+		canonicalDesired.Project = rawInitial.Project
+	} else {
+		canonicalDesired.Project = rawDesired.Project
+	}
+	if dcl.StringCanonicalize(rawDesired.ServiceAccountEmail, rawInitial.ServiceAccountEmail) {
+		canonicalDesired.ServiceAccountEmail = rawInitial.ServiceAccountEmail
+	} else {
+		canonicalDesired.ServiceAccountEmail = rawDesired.ServiceAccountEmail
+	}
+
+	return canonicalDesired, nil
+}
+
+func canonicalizeHmacKeyNewState(c *Client, rawNew, rawDesired *HmacKey) (*HmacKey, error) {
+
+	if dcl.IsEmptyValueIndirect(rawNew.Name) && dcl.IsEmptyValueIndirect(rawDesired.Name) {
+		rawNew.Name = rawDesired.Name
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.TimeCreated) && dcl.IsEmptyValueIndirect(rawDesired.TimeCreated) {
+		rawNew.TimeCreated = rawDesired.TimeCreated
+	} else {
+		if dcl.StringCanonicalize(rawDesired.TimeCreated, rawNew.TimeCreated) {
+			rawNew.TimeCreated = rawDesired.TimeCreated
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Updated) && dcl.IsEmptyValueIndirect(rawDesired.Updated) {
+		rawNew.Updated = rawDesired.Updated
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Updated, rawNew.Updated) {
+			rawNew.Updated = rawDesired.Updated
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Secret) {
+		rawNew.Secret = rawDesired.Secret
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.State) && dcl.IsEmptyValueIndirect(rawDesired.State) {
+		rawNew.State = rawDesired.State
+	} else {
+	}
+
+	rawNew.Project = rawDesired.Project
+
+	if dcl.IsEmptyValueIndirect(rawNew.ServiceAccountEmail) && dcl.IsEmptyValueIndirect(rawDesired.ServiceAccountEmail) {
+		rawNew.ServiceAccountEmail = rawDesired.ServiceAccountEmail
+	} else {
+		if dcl.StringCanonicalize(rawDesired.ServiceAccountEmail, rawNew.ServiceAccountEmail) {
+			rawNew.ServiceAccountEmail = rawDesired.ServiceAccountEmail
+		}
+	}
+
+	return rawNew, nil
+}
+
+// The differ returns a list of diffs, along with a list of operations that should be taken
+// to remedy them. Right now, it does not attempt to consolidate operations - if several
+// fields can be fixed with a patch update, it will perform the patch several times.
+// Diffs on some fields will be ignored if the `desired` state has an empty (nil)
+// value. This empty value indicates that the user does not care about the state for
+// the field. Empty fields on the actual object will cause diffs.
+// TODO(magic-modules-eng): for efficiency in some resources, add batching.
+func diffHmacKey(c *Client, desired, actual *HmacKey, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	if desired == nil || actual == nil {
+		return nil, fmt.Errorf("nil resource passed to diff - always a programming error: %#v, %#v", desired, actual)
+	}
+
+	c.Config.Logger.Infof("Diff function called with desired state: %v", desired)
+	c.Config.Logger.Infof("Diff function called with actual state: %v", actual)
+
+	var fn dcl.FieldName
+	var newDiffs []*dcl.FieldDiff
+	// New style diffs.
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateHmacKeyUpdateHmacKeyOperation")}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.TimeCreated, actual.TimeCreated, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("TimeCreated")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Updated, actual.Updated, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Updated")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Secret, actual.Secret, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Secret")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.State, actual.State, dcl.DiffInfo{ServerDefault: true, Type: "EnumType", OperationSelector: dcl.TriggersOperation("updateHmacKeyUpdateHmacKeyOperation")}, fn.AddNest("State")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Project, actual.Project, dcl.DiffInfo{Type: "ReferenceType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Project")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ServiceAccountEmail, actual.ServiceAccountEmail, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ServiceAccountEmail")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	return newDiffs, nil
+}
+
+// urlNormalized returns a copy of the resource struct with values normalized
+// for URL substitutions. For instance, it converts long-form self-links to
+// short-form so they can be substituted in.
+func (r *HmacKey) urlNormalized() *HmacKey {
+	normalized := dcl.Copy(*r).(HmacKey)
+	normalized.Name = dcl.SelfLinkToName(r.Name)
+	normalized.TimeCreated = dcl.SelfLinkToName(r.TimeCreated)
+	normalized.Updated = dcl.SelfLinkToName(r.Updated)
+	normalized.Secret = dcl.SelfLinkToName(r.Secret)
+	normalized.Project = dcl.SelfLinkToName(r.Project)
+	normalized.ServiceAccountEmail = dcl.SelfLinkToName(r.ServiceAccountEmail)
+	return &normalized
+}
+
+func (r *HmacKey) updateURL(userBasePath, updateName string) (string, error) {
+	nr := r.urlNormalized()
+	if updateName == "UpdateHmacKey" {
+		fields := map[string]interface{}{
+			"project": dcl.ValueOrEmptyString(nr.Project),
+			"name":    dcl.ValueOrEmptyString(nr.Name),
+		}
+		return dcl.URL("projects/{{project}}/hmacKeys/{{name}}", nr.basePath(), userBasePath, fields), nil
+
+	}
+
+	return "", fmt.Errorf("unknown update name: %s", updateName)
+}
+
+// marshal encodes the HmacKey resource into JSON for a Create request, and
+// performs transformations from the resource schema to the API schema if
+// necessary.
+func (r *HmacKey) marshal(c *Client) ([]byte, error) {
+	m, err := expandHmacKey(c, r)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling HmacKey: %w", err)
+	}
+
+	return json.Marshal(m)
+}
+
+// unmarshalHmacKey decodes JSON responses into the HmacKey resource schema.
+func unmarshalHmacKey(b []byte, c *Client, res *HmacKey) (*HmacKey, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return unmarshalMapHmacKey(m, c, res)
+}
+
+func unmarshalMapHmacKey(m map[string]interface{}, c *Client, res *HmacKey) (*HmacKey, error) {
+
+	flattened := flattenHmacKey(c, m, res)
+	if flattened == nil {
+		return nil, fmt.Errorf("attempted to flatten empty json object")
+	}
+	return flattened, nil
+}
+
+// expandHmacKey expands HmacKey into a JSON request object.
+func expandHmacKey(c *Client, f *HmacKey) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	res := f
+	_ = res
+	if v := f.State; dcl.ValueShouldBeSent(v) {
+		m["state"] = v
+	}
+	if v, err := dcl.EmptyValue(); err != nil {
+		return nil, fmt.Errorf("error expanding Project into project: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["project"] = v
+	}
+	if v := f.ServiceAccountEmail; dcl.ValueShouldBeSent(v) {
+		m["serviceAccountEmail"] = v
+	}
+
+	return m, nil
+}
+
+// flattenHmacKey flattens HmacKey from a JSON request object into the
+// HmacKey type.
+func flattenHmacKey(c *Client, i interface{}, res *HmacKey) *HmacKey {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if len(m) == 0 {
+		return nil
+	}
+
+	resultRes := &HmacKey{}
+	resultRes.Name = dcl.FlattenString(m["accessId"])
+	resultRes.TimeCreated = dcl.FlattenString(m["timeCreated"])
+	resultRes.Updated = dcl.FlattenString(m["updated"])
+	resultRes.Secret = dcl.FlattenString(m["secret"])
+	resultRes.State = flattenHmacKeyStateEnum(m["state"])
+	resultRes.Project = dcl.FlattenString(m["project"])
+	resultRes.ServiceAccountEmail = dcl.FlattenString(m["serviceAccountEmail"])
+
+	return resultRes
+}
+
+// flattenHmacKeyStateEnumMap flattens the contents of HmacKeyStateEnum from a JSON
+// response object.
+func flattenHmacKeyStateEnumMap(c *Client, i interface{}, res *HmacKey) map[string]HmacKeyStateEnum {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]HmacKeyStateEnum{}
+	}
+
+	if len(a) == 0 {
+		return map[string]HmacKeyStateEnum{}
+	}
+
+	items := make(map[string]HmacKeyStateEnum)
+	for k, item := range a {
+		items[k] = *flattenHmacKeyStateEnum(item.(interface{}))
+	}
+
+	return items
+}
+
+// flattenHmacKeyStateEnumSlice flattens the contents of HmacKeyStateEnum from a JSON
+// response object.
+func flattenHmacKeyStateEnumSlice(c *Client, i interface{}, res *HmacKey) []HmacKeyStateEnum {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []HmacKeyStateEnum{}
+	}
+
+	if len(a) == 0 {
+		return []HmacKeyStateEnum{}
+	}
+
+	items := make([]HmacKeyStateEnum, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenHmacKeyStateEnum(item.(interface{})))
+	}
+
+	return items
+}
+
+// flattenHmacKeyStateEnum asserts that an interface is a string, and returns a
+// pointer to a *HmacKeyStateEnum with the same value as that string.
+func flattenHmacKeyStateEnum(i interface{}) *HmacKeyStateEnum {
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+
+	return HmacKeyStateEnumRef(s)
+}
+
+// This function returns a matcher that checks whether a serialized resource matches this resource
+// in its parameters (as defined by the fields in a Get, which definitionally define resource
+// identity).  This is useful in extracting the element from a List call.
+func (r *HmacKey) matcher(c *Client) func([]byte) bool {
+	return func(b []byte) bool {
+		cr, err := unmarshalHmacKey(b, c, r)
+		if err != nil {
+			c.Config.Logger.Warning("failed to unmarshal provided resource in matcher.")
+			return false
+		}
+		nr := r.urlNormalized()
+		ncr := cr.urlNormalized()
+		c.Config.Logger.Infof("looking for %v\nin %v", nr, ncr)
+
+		if nr.Project == nil && ncr.Project == nil {
+			c.Config.Logger.Info("Both Project fields null - considering equal.")
+		} else if nr.Project == nil || ncr.Project == nil {
+			c.Config.Logger.Info("Only one Project field is null - considering unequal.")
+			return false
+		} else if *nr.Project != *ncr.Project {
+			return false
+		}
+		if nr.Name == nil && ncr.Name == nil {
+			c.Config.Logger.Info("Both Name fields null - considering equal.")
+		} else if nr.Name == nil || ncr.Name == nil {
+			c.Config.Logger.Info("Only one Name field is null - considering unequal.")
+			return false
+		} else if *nr.Name != *ncr.Name {
+			return false
+		}
+		return true
+	}
+}
+
+type hmacKeyDiff struct {
+	// The diff should include one or the other of RequiresRecreate or UpdateOp.
+	RequiresRecreate bool
+	UpdateOp         hmacKeyApiOperation
+	FieldName        string // used for error logging
+}
+
+func convertFieldDiffsToHmacKeyDiffs(config *dcl.Config, fds []*dcl.FieldDiff, opts []dcl.ApplyOption) ([]hmacKeyDiff, error) {
+	opNamesToFieldDiffs := make(map[string][]*dcl.FieldDiff)
+	// Map each operation name to the field diffs associated with it.
+	for _, fd := range fds {
+		for _, ro := range fd.ResultingOperation {
+			if fieldDiffs, ok := opNamesToFieldDiffs[ro]; ok {
+				fieldDiffs = append(fieldDiffs, fd)
+				opNamesToFieldDiffs[ro] = fieldDiffs
+			} else {
+				config.Logger.Infof("%s required due to diff: %v", ro, fd)
+				opNamesToFieldDiffs[ro] = []*dcl.FieldDiff{fd}
+			}
+		}
+	}
+	var diffs []hmacKeyDiff
+	// For each operation name, create a hmacKeyDiff which contains the operation.
+	for opName, fieldDiffs := range opNamesToFieldDiffs {
+		// Use the first field diff's field name for logging required recreate error.
+		diff := hmacKeyDiff{FieldName: fieldDiffs[0].FieldName}
+		if opName == "Recreate" {
+			diff.RequiresRecreate = true
+		} else {
+			apiOp, err := convertOpNameToHmacKeyApiOperation(opName, fieldDiffs, opts...)
+			if err != nil {
+				return diffs, err
+			}
+			diff.UpdateOp = apiOp
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+func convertOpNameToHmacKeyApiOperation(opName string, fieldDiffs []*dcl.FieldDiff, opts ...dcl.ApplyOption) (hmacKeyApiOperation, error) {
+	switch opName {
+
+	case "updateHmacKeyUpdateHmacKeyOperation":
+		return &updateHmacKeyUpdateHmacKeyOperation{FieldDiffs: fieldDiffs}, nil
+
+	default:
+		return nil, fmt.Errorf("no such operation with name: %v", opName)
+	}
+}
+
+func extractHmacKeyFields(r *HmacKey) error {
+	return nil
+}
+
+func postReadExtractHmacKeyFields(r *HmacKey) error {
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLHmacKeySchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Storage/HmacKey",
+			Description: "The Storage HmacKey resource",
+			StructName:  "HmacKey",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a HmacKey",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "hmacKey",
+						Required:    true,
+						Description: "A full instance of a HmacKey",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a HmacKey",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "hmacKey",
+						Required:    true,
+						Description: "A full instance of a HmacKey",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a HmacKey",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "hmacKey",
+						Required:    true,
+						Description: "A full instance of a HmacKey",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all HmacKey",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many HmacKey",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "project",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"HmacKey": &dcl.Component{
+					Title:           "HmacKey",
+					ID:              "projects/{{project}}/hmacKeys/{{name}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"project",
+							"serviceAccountEmail",
+						},
+						Properties: map[string]*dcl.Property{
+							"name": &dcl.Property{
+								Type:                     "string",
+								GoName:                   "Name",
+								Description:              "The ID of the HMAC key, including the Project ID and the Access ID.",
+								ServerGeneratedParameter: true,
+							},
+							"project": &dcl.Property{
+								Type:        "string",
+								GoName:      "Project",
+								Description: "Project ID owning the service account to which the key authenticates.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Cloudresourcemanager/Project",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"secret": &dcl.Property{
+								Type:        "string",
+								GoName:      "Secret",
+								ReadOnly:    true,
+								Description: "HMAC secret key material. This is only returned by the service when the key is created, and is otherwise unavailable.",
+								Immutable:   true,
+								Sensitive:   true,
+							},
+							"serviceAccountEmail": &dcl.Property{
+								Type:        "string",
+								GoName:      "ServiceAccountEmail",
+								Description: "The email address of the key's associated service account.",
+								Immutable:   true,
+							},
+							"state": &dcl.Property{
+								Type:          "string",
+								GoName:        "State",
+								GoType:        "HmacKeyStateEnum",
+								Description:   "The state of the key. Possible values: ACTIVE, INACTIVE, DELETED",
+								ServerDefault: true,
+								Enum: []string{
+									"ACTIVE",
+									"INACTIVE",
+									"DELETED",
+								},
+							},
+							"timeCreated": &dcl.Property{
+								Type:        "string",
+								GoName:      "TimeCreated",
+								ReadOnly:    true,
+								Description: "The creation time of the HMAC key in RFC 3339 format.",
+								Immutable:   true,
+							},
+							"updated": &dcl.Property{
+								Type:        "string",
+								GoName:      "Updated",
+								ReadOnly:    true,
+								Description: "The last modification time of the HMAC key metadata in RFC 3339 format.",
+								Immutable:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package storage -var YAML_hmac_key blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/storage/hmac_key.yaml
+
+package storage
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/storage/hmac_key.yaml
+var YAML_hmac_key = []byte("info:\n  title: Storage/HmacKey\n  description: The Storage HmacKey resource\n  x-dcl-struct-name: HmacKey\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a HmacKey\n    parameters:\n    - name: hmacKey\n      required: true\n      description: A full instance of a HmacKey\n  apply:\n    description: The function used to apply information about a HmacKey\n    parameters:\n    - name: hmacKey\n      required: true\n      description: A full instance of a HmacKey\n  delete:\n    description: The function used to delete a HmacKey\n    parameters:\n    - name: hmacKey\n      required: true\n      description: A full instance of a HmacKey\n  deleteAll:\n    description: The function used to delete all HmacKey\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many HmacKey\n    parameters:\n    - name: project\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    HmacKey:\n      title: HmacKey\n      x-dcl-id: projects/{{project}}/hmacKeys/{{name}}\n      x-dcl-uses-state-hint: true\n      x-dcl-parent-container: project\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - project\n      - serviceAccountEmail\n      properties:\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: The ID of the HMAC key, including the Project ID and the Access\n            ID.\n          x-dcl-server-generated-parameter: true\n        project:\n          type: string\n          x-dcl-go-name: Project\n          description: Project ID owning the service account to which the key authenticates.\n          x-dcl-references:\n          - resource: Cloudresourcemanager/Project\n            field: name\n            parent: true\n        secret:\n          type: string\n          x-dcl-go-name: Secret\n          readOnly: true\n          description: HMAC secret key material. This is only returned by the service\n            when the key is created, and is otherwise unavailable.\n          x-kubernetes-immutable: true\n          x-dcl-sensitive: true\n        serviceAccountEmail:\n          type: string\n          x-dcl-go-name: ServiceAccountEmail\n          description: The email address of the key's associated service account.\n          x-kubernetes-immutable: true\n        state:\n          type: string\n          x-dcl-go-name: State\n          x-dcl-go-type: HmacKeyStateEnum\n          description: 'The state of the key. Possible values: ACTIVE, INACTIVE, DELETED'\n          x-dcl-server-default: true\n          enum:\n          - ACTIVE\n          - INACTIVE\n          - DELETED\n        timeCreated:\n          type: string\n          x-dcl-go-name: TimeCreated\n          readOnly: true\n          description: The creation time of the HMAC key in RFC 3339 format.\n          x-kubernetes-immutable: true\n        updated:\n          type: string\n          x-dcl-go-name: Updated\n          readOnly: true\n          description: The last modification time of the HMAC key metadata in RFC\n            3339 format.\n          x-kubernetes-immutable: true\n")
+
+// 3263 bytes
+// MD5: 898e77403c44cbe94f3031830ddc8aff
@@ -0,0 +1,567 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"google.golang.org/api/googleapi"
+)
+
+type Object struct {
+	Name                    *string                   `json:"name"`
+	Bucket                  *string                   `json:"bucket"`
+	Generation              *int64                    `json:"generation"`
+	Metageneration          *int64                    `json:"metageneration"`
+	Id                      *string                   `json:"id"`
+	SelfLink                *string                   `json:"selfLink"`
+	ContentType             *string                   `json:"contentType"`
+	TimeCreated             *string                   `json:"timeCreated"`
+	Updated                 *string                   `json:"updated"`
+	CustomTime              *string                   `json:"customTime"`
+	TimeDeleted             *string                   `json:"timeDeleted"`
+	TemporaryHold           *bool                     `json:"temporaryHold"`
+	EventBasedHold          *bool                     `json:"eventBasedHold"`
+	RetentionExpirationTime *string                   `json:"retentionExpirationTime"`
+	StorageClass            *string                   `json:"storageClass"`
+	TimeStorageClassUpdated *string                   `json:"timeStorageClassUpdated"`
+	Size                    *int64                    `json:"size"`
+	Md5Hash                 *string                   `json:"md5Hash"`
+	MediaLink               *string                   `json:"mediaLink"`
+	Metadata                map[string]string         `json:"metadata"`
+	Owner                   *ObjectOwner              `json:"owner"`
+	Crc32c                  *string                   `json:"crc32c"`
+	ComponentCount          *int64                    `json:"componentCount"`
+	Etag                    *string                   `json:"etag"`
+	CustomerEncryption      *ObjectCustomerEncryption `json:"customerEncryption"`
+	KmsKeyName              *string                   `json:"kmsKeyName"`
+	Content                 *string                   `json:"content"`
+}
+
+func (r *Object) String() string {
+	return dcl.SprintResource(r)
+}
+
+type ObjectOwner struct {
+	empty    bool    `json:"-"`
+	Entity   *string `json:"entity"`
+	EntityId *string `json:"entityId"`
+}
+
+type jsonObjectOwner ObjectOwner
+
+func (r *ObjectOwner) UnmarshalJSON(data []byte) error {
+	var res jsonObjectOwner
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyObjectOwner
+	} else {
+
+		r.Entity = res.Entity
+
+		r.EntityId = res.EntityId
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this ObjectOwner is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyObjectOwner *ObjectOwner = &ObjectOwner{empty: true}
+
+func (r *ObjectOwner) Empty() bool {
+	return r.empty
+}
+
+func (r *ObjectOwner) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *ObjectOwner) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+type ObjectCustomerEncryption struct {
+	empty               bool    `json:"-"`
+	EncryptionAlgorithm *string `json:"encryptionAlgorithm"`
+	KeySha256           *string `json:"keySha256"`
+	Key                 *string `json:"key"`
+}
+
+type jsonObjectCustomerEncryption ObjectCustomerEncryption
+
+func (r *ObjectCustomerEncryption) UnmarshalJSON(data []byte) error {
+	var res jsonObjectCustomerEncryption
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyObjectCustomerEncryption
+	} else {
+
+		r.EncryptionAlgorithm = res.EncryptionAlgorithm
+
+		r.KeySha256 = res.KeySha256
+
+		r.Key = res.Key
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this ObjectCustomerEncryption is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyObjectCustomerEncryption *ObjectCustomerEncryption = &ObjectCustomerEncryption{empty: true}
+
+func (r *ObjectCustomerEncryption) Empty() bool {
+	return r.empty
+}
+
+func (r *ObjectCustomerEncryption) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *ObjectCustomerEncryption) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+// Describe returns a simple description of this resource to ensure that automated tools
+// can identify it.
+func (r *Object) Describe() dcl.ServiceTypeVersion {
+	return dcl.ServiceTypeVersion{
+		Service: "storage",
+		Type:    "Object",
+		Version: "storage",
+	}
+}
+
+func (r *Object) ID() (string, error) {
+	if err := extractObjectFields(r); err != nil {
+		return "", err
+	}
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"name":                       dcl.ValueOrEmptyString(nr.Name),
+		"bucket":                     dcl.ValueOrEmptyString(nr.Bucket),
+		"generation":                 dcl.ValueOrEmptyString(nr.Generation),
+		"metageneration":             dcl.ValueOrEmptyString(nr.Metageneration),
+		"id":                         dcl.ValueOrEmptyString(nr.Id),
+		"self_link":                  dcl.ValueOrEmptyString(nr.SelfLink),
+		"content_type":               dcl.ValueOrEmptyString(nr.ContentType),
+		"time_created":               dcl.ValueOrEmptyString(nr.TimeCreated),
+		"updated":                    dcl.ValueOrEmptyString(nr.Updated),
+		"custom_time":                dcl.ValueOrEmptyString(nr.CustomTime),
+		"time_deleted":               dcl.ValueOrEmptyString(nr.TimeDeleted),
+		"temporary_hold":             dcl.ValueOrEmptyString(nr.TemporaryHold),
+		"event_based_hold":           dcl.ValueOrEmptyString(nr.EventBasedHold),
+		"retention_expiration_time":  dcl.ValueOrEmptyString(nr.RetentionExpirationTime),
+		"storage_class":              dcl.ValueOrEmptyString(nr.StorageClass),
+		"time_storage_class_updated": dcl.ValueOrEmptyString(nr.TimeStorageClassUpdated),
+		"size":                       dcl.ValueOrEmptyString(nr.Size),
+		"md5_hash":                   dcl.ValueOrEmptyString(nr.Md5Hash),
+		"media_link":                 dcl.ValueOrEmptyString(nr.MediaLink),
+		"metadata":                   dcl.ValueOrEmptyString(nr.Metadata),
+		"owner":                      dcl.ValueOrEmptyString(nr.Owner),
+		"crc32c":                     dcl.ValueOrEmptyString(nr.Crc32c),
+		"component_count":            dcl.ValueOrEmptyString(nr.ComponentCount),
+		"etag":                       dcl.ValueOrEmptyString(nr.Etag),
+		"customer_encryption":        dcl.ValueOrEmptyString(nr.CustomerEncryption),
+		"kms_key_name":               dcl.ValueOrEmptyString(nr.KmsKeyName),
+		"content":                    dcl.ValueOrEmptyString(nr.Content),
+	}
+	return dcl.Nprintf("b/{{bucket}}/o/{{name}}", params), nil
+}
+
+const ObjectMaxPage = -1
+
+type ObjectList struct {
+	Items []*Object
+
+	nextToken string
+
+	pageSize int32
+
+	resource *Object
+}
+
+func (l *ObjectList) HasNext() bool {
+	return l.nextToken != ""
+}
+
+func (l *ObjectList) NextPageToken() string {
+	return l.nextToken
+}
+
+func (l *ObjectList) Next(ctx context.Context, c *Client) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Object{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if !l.HasNext() {
+		return fmt.Errorf("no next page")
+	}
+	items, token, err := c.listObject(ctx, l.resource, l.nextToken, l.pageSize)
+	if err != nil {
+		return err
+	}
+	l.Items = items
+	l.nextToken = token
+	return err
+}
+
+func (c *Client) ListObject(ctx context.Context, bucket string) (*ObjectList, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Object{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Object{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	return c.ListObjectWithMaxResults(ctx, bucket, ObjectMaxPage)
+
+}
+
+func (c *Client) ListObjectWithMaxResults(ctx context.Context, bucket string, pageSize int32) (*ObjectList, error) {
+	return c.ListObjectWithPageToken(ctx, bucket, pageSize, "")
+}
+
+func (c *Client) ListObjectWithPageToken(ctx context.Context, bucket string, pageSize int32, pageToken string) (*ObjectList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Object{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// Create a resource object so that we can use proper url normalization methods.
+	r := &Object{
+		Bucket: &bucket,
+	}
+	items, token, err := c.listObject(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectList{
+		Items:     items,
+		nextToken: token,
+		pageSize:  pageSize,
+		resource:  r,
+	}, nil
+}
+
+func (c *Client) GetObject(ctx context.Context, r *Object) (*Object, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Object{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Object{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// This is *purposefully* supressing errors.
+	// This function is used with url-normalized values + not URL normalized values.
+	// URL Normalized values will throw unintentional errors, since those values are not of the proper parent form.
+	extractObjectFields(r)
+
+	b, err := c.getObjectRaw(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			return nil, &googleapi.Error{
+				Code:    404,
+				Message: err.Error(),
+			}
+		}
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
+	}
+	result, err := unmarshalObject(b, c, r)
+	if err != nil {
+		return nil, err
+	}
+	result.Bucket = r.Bucket
+	result.Name = r.Name
+
+	c.Config.Logger.InfoWithContextf(ctx, "Retrieved raw result state: %v", result)
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with specified state: %v", r)
+	result, err = canonicalizeObjectNewState(c, result, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := postReadExtractObjectFields(result); err != nil {
+		return result, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created result state: %v", result)
+
+	return result, nil
+}
+
+func (c *Client) DeleteObject(ctx context.Context, r *Object) error {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Object{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Object{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if r == nil {
+		return fmt.Errorf("Object resource is nil")
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Deleting Object...")
+	deleteOp := deleteObjectOperation{}
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteObjectAsync(ctx context.Context, r *Object) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteObject(ctx, r)
+	})
+}
+
+// DeleteAllObject deletes all resources that the filter functions returns true on.
+func (c *Client) DeleteAllObject(ctx context.Context, bucket string, filter func(*Object) bool) error {
+	listObj, err := c.ListObject(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	err = c.deleteAllObject(ctx, filter, listObj.Items)
+	if err != nil {
+		return err
+	}
+	for listObj.HasNext() {
+		err = listObj.Next(ctx, c)
+		if err != nil {
+			return nil
+		}
+		err = c.deleteAllObject(ctx, filter, listObj.Items)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) ApplyObject(ctx context.Context, rawDesired *Object, opts ...dcl.ApplyOption) (*Object, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Object{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Object{}).Describe())
+	var resultNewState *Object
+	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		newState, err := applyObjectHelper(c, ctx, rawDesired, opts...)
+		resultNewState = newState
+		if err != nil {
+			// If the error is 409, there is conflict in resource update.
+			// Here we want to apply changes based on latest state.
+			if dcl.IsConflictError(err) {
+				return &dcl.RetryDetails{}, dcl.OperationNotDone{Err: err}
+			}
+			return nil, err
+		}
+		return nil, nil
+	}, c.Config.RetryProvider)
+	return resultNewState, err
+}
+
+func (c *Client) ApplyObjectAsync(ctx context.Context, rawDesired *Object, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyObject(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffObject returns the field-level differences between rawDesired and the
+// live Object without modifying it. If the Object does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffObject(ctx context.Context, rawDesired *Object, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Object{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Object{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractObjectFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.objectDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Object %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
+func applyObjectHelper(c *Client, ctx context.Context, rawDesired *Object, opts ...dcl.ApplyOption) (*Object, error) {
+	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyObject...")
+	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
+
+	// 1.1: Validation of user-specified fields in desired state.
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
+
+	if err := extractObjectFields(rawDesired); err != nil {
+		return nil, err
+	}
+
+	initial, desired, fieldDiffs, err := c.objectDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a diff: %w", err)
+	}
+
+	diffs, err := convertFieldDiffsToObjectDiffs(c.Config, fieldDiffs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(magic-modules-eng): 2.2 Feasibility check (all updates are feasible so far).
+
+	// 2.3: Lifecycle Directive Check
+	var create bool
+	lp := dcl.FetchLifecycleParams(opts)
+	if initial == nil {
+		if dcl.HasLifecycleParam(lp, dcl.BlockCreation) {
+			return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Creation blocked by lifecycle params: %#v.", desired)}
+		}
+		create = true
+	} else if dcl.HasLifecycleParam(lp, dcl.BlockAcquire) {
+		return nil, dcl.ApplyInfeasibleError{
+			Message: fmt.Sprintf("Resource already exists - apply blocked by lifecycle params: %#v.", initial),
+		}
+	} else {
+		for _, d := range diffs {
+			if d.RequiresRecreate {
+				return nil, dcl.ApplyInfeasibleError{
+					Message: fmt.Sprintf("infeasible update: (%v) would require recreation", d),
+				}
+			}
+			if dcl.HasLifecycleParam(lp, dcl.BlockModification) {
+				return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Modification blocked, diff (%v) unresolvable.", d)}
+			}
+		}
+	}
+
+	// 2.4 Imperative Request Planning
+	var ops []objectApiOperation
+	if create {
+		ops = append(ops, &createObjectOperation{})
+	} else {
+		for _, d := range diffs {
+			ops = append(ops, d.UpdateOp)
+		}
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created plan: %#v", ops)
+
+	// 2.5 Request Actuation
+	for _, op := range ops {
+		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
+		if err := op.do(ctx, desired, c); err != nil {
+			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
+		}
+		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
+	}
+	return applyObjectDiff(c, ctx, desired, rawDesired, ops, opts...)
+}
+
+func applyObjectDiff(c *Client, ctx context.Context, desired *Object, rawDesired *Object, ops []objectApiOperation, opts ...dcl.ApplyOption) (*Object, error) {
+	// 3.1, 3.2a Retrieval of raw new state & canonicalization with desired state
+	c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state...")
+	rawNew, err := c.GetObject(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	// Get additional values from the first response.
+	// These values should be merged into the newState above.
+	if len(ops) > 0 {
+		lastOp := ops[len(ops)-1]
+		if o, ok := lastOp.(*createObjectOperation); ok {
+			if r, hasR := o.FirstResponse(); hasR {
+
+				c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state from operation...")
+
+				fullResp, err := unmarshalMapObject(r, c, rawDesired)
+				if err != nil {
+					return nil, err
+				}
+
+				rawNew, err = canonicalizeObjectNewState(c, rawNew, fullResp)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with raw desired state: %v", rawDesired)
+	// 3.2b Canonicalization of raw new state using raw desired state
+	newState, err := canonicalizeObjectNewState(c, rawNew, rawDesired)
+	if err != nil {
+		return rawNew, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created canonical new state: %v", newState)
+	// 3.3 Comparison of the new state and raw desired state.
+	// TODO(magic-modules-eng): EVENTUALLY_CONSISTENT_UPDATE
+	newDesired, err := canonicalizeObjectDesiredState(rawDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if err := postReadExtractObjectFields(newState); err != nil {
+		return newState, err
+	}
+
+	// Need to ensure any transformations made here match acceptably in differ.
+	if err := postReadExtractObjectFields(newDesired); err != nil {
+		return newState, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Diffing using canonicalized desired state: %v", newDesired)
+	newDiffs, err := diffObject(c, newDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if len(newDiffs) == 0 {
+		c.Config.Logger.InfoWithContext(ctx, "No diffs found. Apply was successful.")
+	} else {
+		c.Config.Logger.InfoWithContextf(ctx, "Found diffs: %v", newDiffs)
+		diffMessages := make([]string, len(newDiffs))
+		for i, d := range newDiffs {
+			diffMessages[i] = fmt.Sprintf("%v", d)
+		}
+		return newState, dcl.DiffAfterApplyError{Diffs: diffMessages}
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Done Apply.")
+	return newState, nil
+}
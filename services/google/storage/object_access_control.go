@@ -0,0 +1,541 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"google.golang.org/api/googleapi"
+)
+
+type ObjectAccessControl struct {
+	Project     *string                         `json:"project"`
+	Bucket      *string                         `json:"bucket"`
+	Domain      *string                         `json:"domain"`
+	Email       *string                         `json:"email"`
+	Entity      *string                         `json:"entity"`
+	EntityId    *string                         `json:"entityId"`
+	ProjectTeam *ObjectAccessControlProjectTeam `json:"projectTeam"`
+	Role        *ObjectAccessControlRoleEnum    `json:"role"`
+	Id          *string                         `json:"id"`
+	Object      *string                         `json:"object"`
+	Generation  *int64                          `json:"generation"`
+}
+
+func (r *ObjectAccessControl) String() string {
+	return dcl.SprintResource(r)
+}
+
+// The enum ObjectAccessControlProjectTeamTeamEnum.
+type ObjectAccessControlProjectTeamTeamEnum string
+
+// ObjectAccessControlProjectTeamTeamEnumRef returns a *ObjectAccessControlProjectTeamTeamEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func ObjectAccessControlProjectTeamTeamEnumRef(s string) *ObjectAccessControlProjectTeamTeamEnum {
+	v := ObjectAccessControlProjectTeamTeamEnum(s)
+	return &v
+}
+
+func (v ObjectAccessControlProjectTeamTeamEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"editors", "owners", "viewers"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "ObjectAccessControlProjectTeamTeamEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+// The enum ObjectAccessControlRoleEnum.
+type ObjectAccessControlRoleEnum string
+
+// ObjectAccessControlRoleEnumRef returns a *ObjectAccessControlRoleEnum with the value of string s
+// If the empty string is provided, nil is returned.
+func ObjectAccessControlRoleEnumRef(s string) *ObjectAccessControlRoleEnum {
+	v := ObjectAccessControlRoleEnum(s)
+	return &v
+}
+
+func (v ObjectAccessControlRoleEnum) Validate() error {
+	if string(v) == "" {
+		// Empty enum is okay.
+		return nil
+	}
+	for _, s := range []string{"OWNER", "READER"} {
+		if string(v) == s {
+			return nil
+		}
+	}
+	return &dcl.EnumInvalidError{
+		Enum:  "ObjectAccessControlRoleEnum",
+		Value: string(v),
+		Valid: []string{},
+	}
+}
+
+type ObjectAccessControlProjectTeam struct {
+	empty         bool                                    `json:"-"`
+	ProjectNumber *string                                 `json:"projectNumber"`
+	Team          *ObjectAccessControlProjectTeamTeamEnum `json:"team"`
+}
+
+type jsonObjectAccessControlProjectTeam ObjectAccessControlProjectTeam
+
+func (r *ObjectAccessControlProjectTeam) UnmarshalJSON(data []byte) error {
+	var res jsonObjectAccessControlProjectTeam
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+
+	if len(m) == 0 {
+		*r = *EmptyObjectAccessControlProjectTeam
+	} else {
+
+		r.ProjectNumber = res.ProjectNumber
+
+		r.Team = res.Team
+
+	}
+	return nil
+}
+
+// This object is used to assert a desired state where this ObjectAccessControlProjectTeam is
+// empty. Go lacks global const objects, but this object should be treated
+// as one. Modifying this object will have undesirable results.
+var EmptyObjectAccessControlProjectTeam *ObjectAccessControlProjectTeam = &ObjectAccessControlProjectTeam{empty: true}
+
+func (r *ObjectAccessControlProjectTeam) Empty() bool {
+	return r.empty
+}
+
+func (r *ObjectAccessControlProjectTeam) String() string {
+	return dcl.SprintResource(r)
+}
+
+func (r *ObjectAccessControlProjectTeam) HashCode() string {
+	// Placeholder for a more complex hash method that handles ordering, etc
+	// Hash resource body for easy comparison later
+	hash := sha256.New().Sum([]byte(r.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+// Describe returns a simple description of this resource to ensure that automated tools
+// can identify it.
+func (r *ObjectAccessControl) Describe() dcl.ServiceTypeVersion {
+	return dcl.ServiceTypeVersion{
+		Service: "storage",
+		Type:    "ObjectAccessControl",
+		Version: "storage",
+	}
+}
+
+func (r *ObjectAccessControl) ID() (string, error) {
+	if err := extractObjectAccessControlFields(r); err != nil {
+		return "", err
+	}
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"project":      dcl.ValueOrEmptyString(nr.Project),
+		"bucket":       dcl.ValueOrEmptyString(nr.Bucket),
+		"domain":       dcl.ValueOrEmptyString(nr.Domain),
+		"email":        dcl.ValueOrEmptyString(nr.Email),
+		"entity":       dcl.ValueOrEmptyString(nr.Entity),
+		"entity_id":    dcl.ValueOrEmptyString(nr.EntityId),
+		"project_team": dcl.ValueOrEmptyString(nr.ProjectTeam),
+		"role":         dcl.ValueOrEmptyString(nr.Role),
+		"id":           dcl.ValueOrEmptyString(nr.Id),
+		"object":       dcl.ValueOrEmptyString(nr.Object),
+		"generation":   dcl.ValueOrEmptyString(nr.Generation),
+	}
+	return dcl.Nprintf("b/{{bucket}}/o/{{object}}/acl/{{entity}}?userProject={{project}}", params), nil
+}
+
+const ObjectAccessControlMaxPage = -1
+
+type ObjectAccessControlList struct {
+	Items []*ObjectAccessControl
+
+	nextToken string
+
+	pageSize int32
+
+	resource *ObjectAccessControl
+}
+
+func (l *ObjectAccessControlList) HasNext() bool {
+	return l.nextToken != ""
+}
+
+func (l *ObjectAccessControlList) NextPageToken() string {
+	return l.nextToken
+}
+
+func (l *ObjectAccessControlList) Next(ctx context.Context, c *Client) error {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if !l.HasNext() {
+		return fmt.Errorf("no next page")
+	}
+	items, token, err := c.listObjectAccessControl(ctx, l.resource, l.nextToken, l.pageSize)
+	if err != nil {
+		return err
+	}
+	l.Items = items
+	l.nextToken = token
+	return err
+}
+
+func (c *Client) ListObjectAccessControl(ctx context.Context, project, bucket, object string) (*ObjectAccessControlList, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ObjectAccessControl{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	return c.ListObjectAccessControlWithMaxResults(ctx, project, bucket, object, ObjectAccessControlMaxPage)
+
+}
+
+func (c *Client) ListObjectAccessControlWithMaxResults(ctx context.Context, project, bucket, object string, pageSize int32) (*ObjectAccessControlList, error) {
+	return c.ListObjectAccessControlWithPageToken(ctx, project, bucket, object, pageSize, "")
+}
+
+func (c *Client) ListObjectAccessControlWithPageToken(ctx context.Context, project, bucket, object string, pageSize int32, pageToken string) (*ObjectAccessControlList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// Create a resource object so that we can use proper url normalization methods.
+	r := &ObjectAccessControl{
+		Project: &project,
+		Bucket:  &bucket,
+		Object:  &object,
+	}
+	items, token, err := c.listObjectAccessControl(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectAccessControlList{
+		Items:     items,
+		nextToken: token,
+		pageSize:  pageSize,
+		resource:  r,
+	}, nil
+}
+
+func (c *Client) GetObjectAccessControl(ctx context.Context, r *ObjectAccessControl) (*ObjectAccessControl, error) {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ObjectAccessControl{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	// This is *purposefully* supressing errors.
+	// This function is used with url-normalized values + not URL normalized values.
+	// URL Normalized values will throw unintentional errors, since those values are not of the proper parent form.
+	extractObjectAccessControlFields(r)
+
+	b, err := c.getObjectAccessControlRaw(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			return nil, &googleapi.Error{
+				Code:    404,
+				Message: err.Error(),
+			}
+		}
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
+	}
+	result, err := unmarshalObjectAccessControl(b, c, r)
+	if err != nil {
+		return nil, err
+	}
+	result.Project = r.Project
+	result.Bucket = r.Bucket
+	result.Object = r.Object
+	result.Entity = r.Entity
+
+	c.Config.Logger.InfoWithContextf(ctx, "Retrieved raw result state: %v", result)
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with specified state: %v", r)
+	result, err = canonicalizeObjectAccessControlNewState(c, result, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := postReadExtractObjectAccessControlFields(result); err != nil {
+		return result, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created result state: %v", result)
+
+	return result, nil
+}
+
+func (c *Client) DeleteObjectAccessControl(ctx context.Context, r *ObjectAccessControl) error {
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ObjectAccessControl{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	if r == nil {
+		return fmt.Errorf("ObjectAccessControl resource is nil")
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Deleting ObjectAccessControl...")
+	deleteOp := deleteObjectAccessControlOperation{}
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteObjectAccessControlAsync(ctx context.Context, r *ObjectAccessControl) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteObjectAccessControl(ctx, r)
+	})
+}
+
+// DeleteAllObjectAccessControl deletes all resources that the filter functions returns true on.
+func (c *Client) DeleteAllObjectAccessControl(ctx context.Context, project, bucket, object string, filter func(*ObjectAccessControl) bool) error {
+	listObj, err := c.ListObjectAccessControl(ctx, project, bucket, object)
+	if err != nil {
+		return err
+	}
+
+	err = c.deleteAllObjectAccessControl(ctx, filter, listObj.Items)
+	if err != nil {
+		return err
+	}
+	for listObj.HasNext() {
+		err = listObj.Next(ctx, c)
+		if err != nil {
+			return nil
+		}
+		err = c.deleteAllObjectAccessControl(ctx, filter, listObj.Items)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) ApplyObjectAccessControl(ctx context.Context, rawDesired *ObjectAccessControl, opts ...dcl.ApplyOption) (*ObjectAccessControl, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ObjectAccessControl{}).Describe())
+	var resultNewState *ObjectAccessControl
+	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		newState, err := applyObjectAccessControlHelper(c, ctx, rawDesired, opts...)
+		resultNewState = newState
+		if err != nil {
+			// If the error is 409, there is conflict in resource update.
+			// Here we want to apply changes based on latest state.
+			if dcl.IsConflictError(err) {
+				return &dcl.RetryDetails{}, dcl.OperationNotDone{Err: err}
+			}
+			return nil, err
+		}
+		return nil, nil
+	}, c.Config.RetryProvider)
+	return resultNewState, err
+}
+
+func (c *Client) ApplyObjectAccessControlAsync(ctx context.Context, rawDesired *ObjectAccessControl, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyObjectAccessControl(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffObjectAccessControl returns the field-level differences between rawDesired and the
+// live ObjectAccessControl without modifying it. If the ObjectAccessControl does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffObjectAccessControl(ctx context.Context, rawDesired *ObjectAccessControl, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&ObjectAccessControl{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&ObjectAccessControl{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractObjectAccessControlFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.objectAccessControlDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("ObjectAccessControl %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
+func applyObjectAccessControlHelper(c *Client, ctx context.Context, rawDesired *ObjectAccessControl, opts ...dcl.ApplyOption) (*ObjectAccessControl, error) {
+	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyObjectAccessControl...")
+	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
+
+	// 1.1: Validation of user-specified fields in desired state.
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
+
+	if err := extractObjectAccessControlFields(rawDesired); err != nil {
+		return nil, err
+	}
+
+	initial, desired, fieldDiffs, err := c.objectAccessControlDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a diff: %w", err)
+	}
+
+	diffs, err := convertFieldDiffsToObjectAccessControlDiffs(c.Config, fieldDiffs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO(magic-modules-eng): 2.2 Feasibility check (all updates are feasible so far).
+
+	// 2.3: Lifecycle Directive Check
+	var create bool
+	lp := dcl.FetchLifecycleParams(opts)
+	if initial == nil {
+		if dcl.HasLifecycleParam(lp, dcl.BlockCreation) {
+			return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Creation blocked by lifecycle params: %#v.", desired)}
+		}
+		create = true
+	} else if dcl.HasLifecycleParam(lp, dcl.BlockAcquire) {
+		return nil, dcl.ApplyInfeasibleError{
+			Message: fmt.Sprintf("Resource already exists - apply blocked by lifecycle params: %#v.", initial),
+		}
+	} else {
+		for _, d := range diffs {
+			if d.RequiresRecreate {
+				return nil, dcl.ApplyInfeasibleError{
+					Message: fmt.Sprintf("infeasible update: (%v) would require recreation", d),
+				}
+			}
+			if dcl.HasLifecycleParam(lp, dcl.BlockModification) {
+				return nil, dcl.ApplyInfeasibleError{Message: fmt.Sprintf("Modification blocked, diff (%v) unresolvable.", d)}
+			}
+		}
+	}
+
+	// 2.4 Imperative Request Planning
+	var ops []objectAccessControlApiOperation
+	if create {
+		ops = append(ops, &createObjectAccessControlOperation{})
+	} else {
+		for _, d := range diffs {
+			ops = append(ops, d.UpdateOp)
+		}
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Created plan: %#v", ops)
+
+	// 2.5 Request Actuation
+	for _, op := range ops {
+		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
+		if err := op.do(ctx, desired, c); err != nil {
+			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
+		}
+		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
+	}
+	return applyObjectAccessControlDiff(c, ctx, desired, rawDesired, ops, opts...)
+}
+
+func applyObjectAccessControlDiff(c *Client, ctx context.Context, desired *ObjectAccessControl, rawDesired *ObjectAccessControl, ops []objectAccessControlApiOperation, opts ...dcl.ApplyOption) (*ObjectAccessControl, error) {
+	// 3.1, 3.2a Retrieval of raw new state & canonicalization with desired state
+	c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state...")
+	rawNew, err := c.GetObjectAccessControl(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	// Get additional values from the first response.
+	// These values should be merged into the newState above.
+	if len(ops) > 0 {
+		lastOp := ops[len(ops)-1]
+		if o, ok := lastOp.(*createObjectAccessControlOperation); ok {
+			if r, hasR := o.FirstResponse(); hasR {
+
+				c.Config.Logger.InfoWithContext(ctx, "Retrieving raw new state from operation...")
+
+				fullResp, err := unmarshalMapObjectAccessControl(r, c, rawDesired)
+				if err != nil {
+					return nil, err
+				}
+
+				rawNew, err = canonicalizeObjectAccessControlNewState(c, rawNew, fullResp)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalizing with raw desired state: %v", rawDesired)
+	// 3.2b Canonicalization of raw new state using raw desired state
+	newState, err := canonicalizeObjectAccessControlNewState(c, rawNew, rawDesired)
+	if err != nil {
+		return rawNew, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created canonical new state: %v", newState)
+	// 3.3 Comparison of the new state and raw desired state.
+	// TODO(magic-modules-eng): EVENTUALLY_CONSISTENT_UPDATE
+	newDesired, err := canonicalizeObjectAccessControlDesiredState(rawDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if err := postReadExtractObjectAccessControlFields(newState); err != nil {
+		return newState, err
+	}
+
+	// Need to ensure any transformations made here match acceptably in differ.
+	if err := postReadExtractObjectAccessControlFields(newDesired); err != nil {
+		return newState, err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Diffing using canonicalized desired state: %v", newDesired)
+	newDiffs, err := diffObjectAccessControl(c, newDesired, newState)
+	if err != nil {
+		return newState, err
+	}
+
+	if len(newDiffs) == 0 {
+		c.Config.Logger.InfoWithContext(ctx, "No diffs found. Apply was successful.")
+	} else {
+		c.Config.Logger.InfoWithContextf(ctx, "Found diffs: %v", newDiffs)
+		diffMessages := make([]string, len(newDiffs))
+		for i, d := range newDiffs {
+			diffMessages[i] = fmt.Sprintf("%v", d)
+		}
+		return newState, dcl.DiffAfterApplyError{Diffs: diffMessages}
+	}
+	c.Config.Logger.InfoWithContext(ctx, "Done Apply.")
+	return newState, nil
+}
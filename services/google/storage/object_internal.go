@@ -0,0 +1,1663 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func (r *Object) validate() error {
+
+	if err := dcl.Required(r, "name"); err != nil {
+		return err
+	}
+	if err := dcl.RequiredParameter(r.Bucket, "Bucket"); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(r.Owner) {
+		if err := r.Owner.validate(); err != nil {
+			return err
+		}
+	}
+	if !dcl.IsEmptyValueIndirect(r.CustomerEncryption) {
+		if err := r.CustomerEncryption.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (r *ObjectOwner) validate() error {
+	return nil
+}
+func (r *ObjectCustomerEncryption) validate() error {
+	return nil
+}
+func (r *Object) basePath() string {
+	params := map[string]interface{}{}
+	return dcl.Nprintf("https://www.googleapis.com/storage/v1/", params)
+}
+
+func (r *Object) getURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"bucket": dcl.ValueOrEmptyString(nr.Bucket),
+		"name":   url.PathEscape(dcl.ValueOrEmptyString(nr.Name)),
+	}
+	return dcl.URL("b/{{bucket}}/o/{{name}}", nr.basePath(), userBasePath, params), nil
+}
+
+func (r *Object) listURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"bucket": dcl.ValueOrEmptyString(nr.Bucket),
+	}
+	return dcl.URL("b/{{bucket}}/o", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *Object) createURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"bucket": dcl.ValueOrEmptyString(nr.Bucket),
+	}
+	return dcl.URL("b/{{bucket}}/o", nr.basePath(), userBasePath, params), nil
+
+}
+
+func (r *Object) deleteURL(userBasePath string) (string, error) {
+	nr := r.urlNormalized()
+	params := map[string]interface{}{
+		"bucket": dcl.ValueOrEmptyString(nr.Bucket),
+		"name":   url.PathEscape(dcl.ValueOrEmptyString(nr.Name)),
+	}
+	return dcl.URL("b/{{bucket}}/o/{{name}}", nr.basePath(), userBasePath, params), nil
+}
+
+// objectApiOperation represents a mutable operation in the underlying REST
+// API such as Create, Update, or Delete.
+type objectApiOperation interface {
+	do(context.Context, *Object, *Client) error
+}
+
+// newUpdateObjectPatchObjectRequest creates a request for an
+// Object resource's PatchObject update type by filling in the update
+// fields based on the intended state of the resource.
+func newUpdateObjectPatchObjectRequest(ctx context.Context, f *Object, c *Client) (map[string]interface{}, error) {
+	req := map[string]interface{}{}
+	res := f
+	_ = res
+
+	if v := f.ContentType; !dcl.IsEmptyValueIndirect(v) {
+		req["contentType"] = v
+	}
+	if v := f.CustomTime; !dcl.IsEmptyValueIndirect(v) {
+		req["customTime"] = v
+	}
+	if v := f.TemporaryHold; !dcl.IsEmptyValueIndirect(v) {
+		req["temporaryHold"] = v
+	}
+	if v := f.EventBasedHold; !dcl.IsEmptyValueIndirect(v) {
+		req["eventBasedHold"] = v
+	}
+	if v := f.Metadata; !dcl.IsEmptyValueIndirect(v) {
+		req["metadata"] = v
+	}
+	return req, nil
+}
+
+// marshalUpdateObjectPatchObjectRequest converts the update into
+// the final JSON request body.
+func marshalUpdateObjectPatchObjectRequest(c *Client, m map[string]interface{}) ([]byte, error) {
+
+	return json.Marshal(m)
+}
+
+type updateObjectPatchObjectOperation struct {
+	// If the update operation has the REQUIRES_APPLY_OPTIONS trait, this will be populated.
+	// Usually it will be nil - this is to prevent us from accidentally depending on apply
+	// options, which should usually be unnecessary.
+	ApplyOptions []dcl.ApplyOption
+	FieldDiffs   []*dcl.FieldDiff
+}
+
+func (c *Client) listObjectRaw(ctx context.Context, r *Object, pageToken string, pageSize int32) ([]byte, error) {
+	u, err := r.urlNormalized().listURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string)
+	if pageToken != "" {
+		m["pageToken"] = pageToken
+	}
+
+	if pageSize != ObjectMaxPage {
+		m["pageSize"] = fmt.Sprintf("%v", pageSize)
+	}
+
+	u, err = dcl.AddQueryParams(u, m)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	return ioutil.ReadAll(resp.Response.Body)
+}
+
+type listObjectOperation struct {
+	Items []map[string]interface{} `json:"items"`
+	Token string                   `json:"nextPageToken"`
+}
+
+func (c *Client) listObject(ctx context.Context, r *Object, pageToken string, pageSize int32) ([]*Object, string, error) {
+	b, err := c.listObjectRaw(ctx, r, pageToken, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var m listObjectOperation
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, "", err
+	}
+
+	var l []*Object
+	for _, v := range m.Items {
+		res, err := unmarshalMapObject(v, c, r)
+		if err != nil {
+			return nil, m.Token, err
+		}
+		res.Bucket = r.Bucket
+		l = append(l, res)
+	}
+
+	return l, m.Token, nil
+}
+
+func (c *Client) deleteAllObject(ctx context.Context, f func(*Object) bool, resources []*Object) error {
+	var errors []string
+	for _, res := range resources {
+		if f(res) {
+			// We do not want deleteAll to fail on a deletion or else it will stop deleting other resources.
+			err := c.DeleteObject(ctx, res)
+			if err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("%v", strings.Join(errors, "\n"))
+	} else {
+		return nil
+	}
+}
+
+type deleteObjectOperation struct{}
+
+func (op *deleteObjectOperation) do(ctx context.Context, r *Object, c *Client) error {
+	r, err := c.GetObject(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			c.Config.Logger.InfoWithContextf(ctx, "Object not found, returning. Original error: %v", err)
+			return nil
+		}
+		c.Config.Logger.WarningWithContextf(ctx, "GetObject checking for existence. error: %v", err)
+		return err
+	}
+
+	u, err := r.deleteURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	// Delete should never have a body
+	body := &bytes.Buffer{}
+	_, err = dcl.SendRequest(ctx, c.Config, "DELETE", u, body, c.Config.RetryProvider)
+	if err != nil {
+		return fmt.Errorf("failed to delete Object: %w", err)
+	}
+
+	// We saw a race condition where for some successful delete operation, the Get calls returned resources for a short duration.
+	// This is the reason we are adding retry to handle that case.
+	retriesRemaining := 10
+	dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
+		_, err := c.GetObject(ctx, r)
+		if dcl.IsNotFound(err) {
+			return nil, nil
+		}
+		if retriesRemaining > 0 {
+			retriesRemaining--
+			return &dcl.RetryDetails{}, dcl.OperationNotDone{}
+		}
+		return nil, dcl.NotDeletedError{ExistingResource: r}
+	}, c.Config.RetryProvider)
+	return nil
+}
+
+// Create operations are similar to Update operations, although they do not have
+// specific request objects. The Create request object is the json encoding of
+// the resource, which is modified by res.marshal to form the base request body.
+type createObjectOperation struct {
+	response map[string]interface{}
+}
+
+func (op *createObjectOperation) FirstResponse() (map[string]interface{}, bool) {
+	return op.response, len(op.response) > 0
+}
+
+func (c *Client) objectDiffsForRawDesired(ctx context.Context, rawDesired *Object, opts ...dcl.ApplyOption) (initial, desired *Object, diffs []*dcl.FieldDiff, err error) {
+	c.Config.Logger.InfoWithContext(ctx, "Fetching initial state...")
+	// First, let us see if the user provided a state hint.  If they did, we will start fetching based on that.
+	var fetchState *Object
+	if sh := dcl.FetchStateHint(opts); sh != nil {
+		if r, ok := sh.(*Object); !ok {
+			c.Config.Logger.WarningWithContextf(ctx, "Initial state hint was of the wrong type; expected Object, got %T", sh)
+		} else {
+			fetchState = r
+		}
+	}
+	if fetchState == nil {
+		fetchState = rawDesired
+	}
+
+	// 1.2: Retrieval of raw initial state from API
+	rawInitial, err := c.GetObject(ctx, fetchState)
+	if rawInitial == nil {
+		if !dcl.IsNotFound(err) {
+			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Object resource already exists: %s", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Object resource: %w", err)
+		}
+		c.Config.Logger.InfoWithContext(ctx, "Found that Object resource did not exist.")
+		// Perform canonicalization to pick up defaults.
+		desired, err = canonicalizeObjectDesiredState(rawDesired, rawInitial)
+		return nil, desired, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Found initial state for Object: %v", rawInitial)
+	c.Config.Logger.InfoWithContextf(ctx, "Initial desired state for Object: %v", rawDesired)
+
+	// The Get call applies postReadExtract and so the result may contain fields that are not part of API version.
+	if err := extractObjectFields(rawInitial); err != nil {
+		return nil, nil, nil, err
+	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// 1.3: Canonicalize raw initial state into initial state.
+	initial, err = canonicalizeObjectInitialState(rawInitial, rawDesired)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized initial state for Object: %v", initial)
+
+	// 1.4: Canonicalize raw desired state into desired state.
+	desired, err = canonicalizeObjectDesiredState(rawDesired, rawInitial, opts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Config.Logger.InfoWithContextf(ctx, "Canonicalized desired state for Object: %v", desired)
+
+	// 2.1: Comparison of initial and desired state.
+	diffs, err = diffObject(c, desired, initial, opts...)
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
+}
+
+func canonicalizeObjectInitialState(rawInitial, rawDesired *Object) (*Object, error) {
+	// TODO(magic-modules-eng): write canonicalizer once relevant traits are added.
+	return rawInitial, nil
+}
+
+/*
+* Canonicalizers
+*
+* These are responsible for converting either a user-specified config or a
+* GCP API response to a standard format that can be used for difference checking.
+* */
+
+func canonicalizeObjectDesiredState(rawDesired, rawInitial *Object, opts ...dcl.ApplyOption) (*Object, error) {
+
+	if rawInitial == nil {
+		// Since the initial state is empty, the desired state is all we have.
+		// We canonicalize the remaining nested objects with nil to pick up defaults.
+		rawDesired.Owner = canonicalizeObjectOwner(rawDesired.Owner, nil, opts...)
+		rawDesired.CustomerEncryption = canonicalizeObjectCustomerEncryption(rawDesired.CustomerEncryption, nil, opts...)
+
+		return rawDesired, nil
+	}
+
+	canonicalDesired := &Object{}
+	if dcl.StringCanonicalize(rawDesired.Name, rawInitial.Name) {
+		canonicalDesired.Name = rawInitial.Name
+	} else {
+		canonicalDesired.Name = rawDesired.Name
+	}
+	if dcl.NameToSelfLink(rawDesired.Bucket, rawInitial.Bucket) {
+		canonicalDesired.Bucket = rawInitial.Bucket
+	} else {
+		canonicalDesired.Bucket = rawDesired.Bucket
+	}
+	if dcl.StringCanonicalize(rawDesired.ContentType, rawInitial.ContentType) {
+		canonicalDesired.ContentType = rawInitial.ContentType
+	} else {
+		canonicalDesired.ContentType = rawDesired.ContentType
+	}
+	if dcl.StringCanonicalize(rawDesired.CustomTime, rawInitial.CustomTime) {
+		canonicalDesired.CustomTime = rawInitial.CustomTime
+	} else {
+		canonicalDesired.CustomTime = rawDesired.CustomTime
+	}
+	if dcl.BoolCanonicalize(rawDesired.TemporaryHold, rawInitial.TemporaryHold) {
+		canonicalDesired.TemporaryHold = rawInitial.TemporaryHold
+	} else {
+		canonicalDesired.TemporaryHold = rawDesired.TemporaryHold
+	}
+	if dcl.BoolCanonicalize(rawDesired.EventBasedHold, rawInitial.EventBasedHold) {
+		canonicalDesired.EventBasedHold = rawInitial.EventBasedHold
+	} else {
+		canonicalDesired.EventBasedHold = rawDesired.EventBasedHold
+	}
+	if dcl.StringCanonicalize(rawDesired.StorageClass, rawInitial.StorageClass) {
+		canonicalDesired.StorageClass = rawInitial.StorageClass
+	} else {
+		canonicalDesired.StorageClass = rawDesired.StorageClass
+	}
+	if dcl.IsZeroValue(rawDesired.Metadata) || (dcl.IsEmptyValueIndirect(rawDesired.Metadata) && dcl.IsEmptyValueIndirect(rawInitial.Metadata)) {
+		// Desired and initial values are equivalent, so set canonical desired value to initial value.
+		canonicalDesired.Metadata = rawInitial.Metadata
+	} else {
+		canonicalDesired.Metadata = rawDesired.Metadata
+	}
+	canonicalDesired.CustomerEncryption = canonicalizeObjectCustomerEncryption(rawDesired.CustomerEncryption, rawInitial.CustomerEncryption, opts...)
+	if dcl.StringCanonicalize(rawDesired.KmsKeyName, rawInitial.KmsKeyName) {
+		canonicalDesired.KmsKeyName = rawInitial.KmsKeyName
+	} else {
+		canonicalDesired.KmsKeyName = rawDesired.KmsKeyName
+	}
+	if dcl.StringCanonicalize(rawDesired.Content, rawInitial.Content) {
+		canonicalDesired.Content = rawInitial.Content
+	} else {
+		canonicalDesired.Content = rawDesired.Content
+	}
+
+	return canonicalDesired, nil
+}
+
+func canonicalizeObjectNewState(c *Client, rawNew, rawDesired *Object) (*Object, error) {
+
+	if dcl.IsEmptyValueIndirect(rawNew.Name) && dcl.IsEmptyValueIndirect(rawDesired.Name) {
+		rawNew.Name = rawDesired.Name
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Name, rawNew.Name) {
+			rawNew.Name = rawDesired.Name
+		}
+	}
+
+	rawNew.Bucket = rawDesired.Bucket
+
+	if dcl.IsEmptyValueIndirect(rawNew.Generation) && dcl.IsEmptyValueIndirect(rawDesired.Generation) {
+		rawNew.Generation = rawDesired.Generation
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Metageneration) && dcl.IsEmptyValueIndirect(rawDesired.Metageneration) {
+		rawNew.Metageneration = rawDesired.Metageneration
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Id) && dcl.IsEmptyValueIndirect(rawDesired.Id) {
+		rawNew.Id = rawDesired.Id
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Id, rawNew.Id) {
+			rawNew.Id = rawDesired.Id
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.SelfLink) && dcl.IsEmptyValueIndirect(rawDesired.SelfLink) {
+		rawNew.SelfLink = rawDesired.SelfLink
+	} else {
+		if dcl.StringCanonicalize(rawDesired.SelfLink, rawNew.SelfLink) {
+			rawNew.SelfLink = rawDesired.SelfLink
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ContentType) && dcl.IsEmptyValueIndirect(rawDesired.ContentType) {
+		rawNew.ContentType = rawDesired.ContentType
+	} else {
+		if dcl.StringCanonicalize(rawDesired.ContentType, rawNew.ContentType) {
+			rawNew.ContentType = rawDesired.ContentType
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.TimeCreated) && dcl.IsEmptyValueIndirect(rawDesired.TimeCreated) {
+		rawNew.TimeCreated = rawDesired.TimeCreated
+	} else {
+		if dcl.StringCanonicalize(rawDesired.TimeCreated, rawNew.TimeCreated) {
+			rawNew.TimeCreated = rawDesired.TimeCreated
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Updated) && dcl.IsEmptyValueIndirect(rawDesired.Updated) {
+		rawNew.Updated = rawDesired.Updated
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Updated, rawNew.Updated) {
+			rawNew.Updated = rawDesired.Updated
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.CustomTime) && dcl.IsEmptyValueIndirect(rawDesired.CustomTime) {
+		rawNew.CustomTime = rawDesired.CustomTime
+	} else {
+		if dcl.StringCanonicalize(rawDesired.CustomTime, rawNew.CustomTime) {
+			rawNew.CustomTime = rawDesired.CustomTime
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.TimeDeleted) && dcl.IsEmptyValueIndirect(rawDesired.TimeDeleted) {
+		rawNew.TimeDeleted = rawDesired.TimeDeleted
+	} else {
+		if dcl.StringCanonicalize(rawDesired.TimeDeleted, rawNew.TimeDeleted) {
+			rawNew.TimeDeleted = rawDesired.TimeDeleted
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.TemporaryHold) && dcl.IsEmptyValueIndirect(rawDesired.TemporaryHold) {
+		rawNew.TemporaryHold = rawDesired.TemporaryHold
+	} else {
+		if dcl.BoolCanonicalize(rawDesired.TemporaryHold, rawNew.TemporaryHold) {
+			rawNew.TemporaryHold = rawDesired.TemporaryHold
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.EventBasedHold) && dcl.IsEmptyValueIndirect(rawDesired.EventBasedHold) {
+		rawNew.EventBasedHold = rawDesired.EventBasedHold
+	} else {
+		if dcl.BoolCanonicalize(rawDesired.EventBasedHold, rawNew.EventBasedHold) {
+			rawNew.EventBasedHold = rawDesired.EventBasedHold
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.RetentionExpirationTime) && dcl.IsEmptyValueIndirect(rawDesired.RetentionExpirationTime) {
+		rawNew.RetentionExpirationTime = rawDesired.RetentionExpirationTime
+	} else {
+		if dcl.StringCanonicalize(rawDesired.RetentionExpirationTime, rawNew.RetentionExpirationTime) {
+			rawNew.RetentionExpirationTime = rawDesired.RetentionExpirationTime
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.StorageClass) && dcl.IsEmptyValueIndirect(rawDesired.StorageClass) {
+		rawNew.StorageClass = rawDesired.StorageClass
+	} else {
+		if dcl.StringCanonicalize(rawDesired.StorageClass, rawNew.StorageClass) {
+			rawNew.StorageClass = rawDesired.StorageClass
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.TimeStorageClassUpdated) && dcl.IsEmptyValueIndirect(rawDesired.TimeStorageClassUpdated) {
+		rawNew.TimeStorageClassUpdated = rawDesired.TimeStorageClassUpdated
+	} else {
+		if dcl.StringCanonicalize(rawDesired.TimeStorageClassUpdated, rawNew.TimeStorageClassUpdated) {
+			rawNew.TimeStorageClassUpdated = rawDesired.TimeStorageClassUpdated
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Size) && dcl.IsEmptyValueIndirect(rawDesired.Size) {
+		rawNew.Size = rawDesired.Size
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Md5Hash) && dcl.IsEmptyValueIndirect(rawDesired.Md5Hash) {
+		rawNew.Md5Hash = rawDesired.Md5Hash
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Md5Hash, rawNew.Md5Hash) {
+			rawNew.Md5Hash = rawDesired.Md5Hash
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.MediaLink) && dcl.IsEmptyValueIndirect(rawDesired.MediaLink) {
+		rawNew.MediaLink = rawDesired.MediaLink
+	} else {
+		if dcl.StringCanonicalize(rawDesired.MediaLink, rawNew.MediaLink) {
+			rawNew.MediaLink = rawDesired.MediaLink
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Metadata) && dcl.IsEmptyValueIndirect(rawDesired.Metadata) {
+		rawNew.Metadata = rawDesired.Metadata
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Owner) && dcl.IsEmptyValueIndirect(rawDesired.Owner) {
+		rawNew.Owner = rawDesired.Owner
+	} else {
+		rawNew.Owner = canonicalizeNewObjectOwner(c, rawDesired.Owner, rawNew.Owner)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Crc32c) && dcl.IsEmptyValueIndirect(rawDesired.Crc32c) {
+		rawNew.Crc32c = rawDesired.Crc32c
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Crc32c, rawNew.Crc32c) {
+			rawNew.Crc32c = rawDesired.Crc32c
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.ComponentCount) && dcl.IsEmptyValueIndirect(rawDesired.ComponentCount) {
+		rawNew.ComponentCount = rawDesired.ComponentCount
+	} else {
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.Etag) && dcl.IsEmptyValueIndirect(rawDesired.Etag) {
+		rawNew.Etag = rawDesired.Etag
+	} else {
+		if dcl.StringCanonicalize(rawDesired.Etag, rawNew.Etag) {
+			rawNew.Etag = rawDesired.Etag
+		}
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.CustomerEncryption) && dcl.IsEmptyValueIndirect(rawDesired.CustomerEncryption) {
+		rawNew.CustomerEncryption = rawDesired.CustomerEncryption
+	} else {
+		rawNew.CustomerEncryption = canonicalizeNewObjectCustomerEncryption(c, rawDesired.CustomerEncryption, rawNew.CustomerEncryption)
+	}
+
+	if dcl.IsEmptyValueIndirect(rawNew.KmsKeyName) && dcl.IsEmptyValueIndirect(rawDesired.KmsKeyName) {
+		rawNew.KmsKeyName = rawDesired.KmsKeyName
+	} else {
+		if dcl.StringCanonicalize(rawDesired.KmsKeyName, rawNew.KmsKeyName) {
+			rawNew.KmsKeyName = rawDesired.KmsKeyName
+		}
+	}
+
+	rawNew.Content = canonicalizeObjectContent(rawDesired.Content, rawNew)
+
+	return rawNew, nil
+}
+
+func canonicalizeObjectOwner(des, initial *ObjectOwner, opts ...dcl.ApplyOption) *ObjectOwner {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &ObjectOwner{}
+
+	return cDes
+}
+
+func canonicalizeObjectOwnerSlice(des, initial []ObjectOwner, opts ...dcl.ApplyOption) []ObjectOwner {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]ObjectOwner, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeObjectOwner(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]ObjectOwner, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeObjectOwner(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewObjectOwner(c *Client, des, nw *ObjectOwner) *ObjectOwner {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for ObjectOwner while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.Entity, nw.Entity) {
+		nw.Entity = des.Entity
+	}
+	if dcl.StringCanonicalize(des.EntityId, nw.EntityId) {
+		nw.EntityId = des.EntityId
+	}
+
+	return nw
+}
+
+func canonicalizeNewObjectOwnerSet(c *Client, des, nw []ObjectOwner) []ObjectOwner {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []ObjectOwner
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareObjectOwnerNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewObjectOwnerSlice(c *Client, des, nw []ObjectOwner) []ObjectOwner {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []ObjectOwner
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewObjectOwner(c, &d, &n))
+	}
+
+	return items
+}
+
+func canonicalizeObjectCustomerEncryption(des, initial *ObjectCustomerEncryption, opts ...dcl.ApplyOption) *ObjectCustomerEncryption {
+	if des == nil {
+		return initial
+	}
+	if des.empty {
+		return des
+	}
+
+	if initial == nil {
+		return des
+	}
+
+	cDes := &ObjectCustomerEncryption{}
+
+	if dcl.StringCanonicalize(des.EncryptionAlgorithm, initial.EncryptionAlgorithm) || dcl.IsZeroValue(des.EncryptionAlgorithm) {
+		cDes.EncryptionAlgorithm = initial.EncryptionAlgorithm
+	} else {
+		cDes.EncryptionAlgorithm = des.EncryptionAlgorithm
+	}
+	if dcl.StringCanonicalize(des.KeySha256, initial.KeySha256) || dcl.IsZeroValue(des.KeySha256) {
+		cDes.KeySha256 = initial.KeySha256
+	} else {
+		cDes.KeySha256 = des.KeySha256
+	}
+	if dcl.StringCanonicalize(des.Key, initial.Key) || dcl.IsZeroValue(des.Key) {
+		cDes.Key = initial.Key
+	} else {
+		cDes.Key = des.Key
+	}
+
+	return cDes
+}
+
+func canonicalizeObjectCustomerEncryptionSlice(des, initial []ObjectCustomerEncryption, opts ...dcl.ApplyOption) []ObjectCustomerEncryption {
+	if dcl.IsEmptyValueIndirect(des) {
+		return initial
+	}
+
+	if len(des) != len(initial) {
+
+		items := make([]ObjectCustomerEncryption, 0, len(des))
+		for _, d := range des {
+			cd := canonicalizeObjectCustomerEncryption(&d, nil, opts...)
+			if cd != nil {
+				items = append(items, *cd)
+			}
+		}
+		return items
+	}
+
+	items := make([]ObjectCustomerEncryption, 0, len(des))
+	for i, d := range des {
+		cd := canonicalizeObjectCustomerEncryption(&d, &initial[i], opts...)
+		if cd != nil {
+			items = append(items, *cd)
+		}
+	}
+	return items
+
+}
+
+func canonicalizeNewObjectCustomerEncryption(c *Client, des, nw *ObjectCustomerEncryption) *ObjectCustomerEncryption {
+
+	if des == nil {
+		return nw
+	}
+
+	if nw == nil {
+		if dcl.IsEmptyValueIndirect(des) {
+			c.Config.Logger.Info("Found explicitly empty value for ObjectCustomerEncryption while comparing non-nil desired to nil actual.  Returning desired object.")
+			return des
+		}
+		return nil
+	}
+
+	if dcl.StringCanonicalize(des.EncryptionAlgorithm, nw.EncryptionAlgorithm) {
+		nw.EncryptionAlgorithm = des.EncryptionAlgorithm
+	}
+	if dcl.StringCanonicalize(des.KeySha256, nw.KeySha256) {
+		nw.KeySha256 = des.KeySha256
+	}
+	nw.Key = des.Key
+
+	return nw
+}
+
+func canonicalizeNewObjectCustomerEncryptionSet(c *Client, des, nw []ObjectCustomerEncryption) []ObjectCustomerEncryption {
+	if des == nil {
+		return nw
+	}
+	var reorderedNew []ObjectCustomerEncryption
+	for _, d := range des {
+		matchedNew := -1
+		for idx, n := range nw {
+			if diffs, _ := compareObjectCustomerEncryptionNewStyle(&d, &n, dcl.FieldName{}); len(diffs) == 0 {
+				matchedNew = idx
+				break
+			}
+		}
+		if matchedNew != -1 {
+			reorderedNew = append(reorderedNew, nw[matchedNew])
+			nw = append(nw[:matchedNew], nw[matchedNew+1:]...)
+		}
+	}
+	reorderedNew = append(reorderedNew, nw...)
+
+	return reorderedNew
+}
+
+func canonicalizeNewObjectCustomerEncryptionSlice(c *Client, des, nw []ObjectCustomerEncryption) []ObjectCustomerEncryption {
+	if des == nil {
+		return nw
+	}
+
+	// Lengths are unequal. A diff will occur later, so we shouldn't canonicalize.
+	// Return the original array.
+	if len(des) != len(nw) {
+		return nw
+	}
+
+	var items []ObjectCustomerEncryption
+	for i, d := range des {
+		n := nw[i]
+		items = append(items, *canonicalizeNewObjectCustomerEncryption(c, &d, &n))
+	}
+
+	return items
+}
+
+// The differ returns a list of diffs, along with a list of operations that should be taken
+// to remedy them. Right now, it does not attempt to consolidate operations - if several
+// fields can be fixed with a patch update, it will perform the patch several times.
+// Diffs on some fields will be ignored if the `desired` state has an empty (nil)
+// value. This empty value indicates that the user does not care about the state for
+// the field. Empty fields on the actual object will cause diffs.
+// TODO(magic-modules-eng): for efficiency in some resources, add batching.
+func diffObject(c *Client, desired, actual *Object, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	if desired == nil || actual == nil {
+		return nil, fmt.Errorf("nil resource passed to diff - always a programming error: %#v, %#v", desired, actual)
+	}
+
+	c.Config.Logger.Infof("Diff function called with desired state: %v", desired)
+	c.Config.Logger.Infof("Diff function called with actual state: %v", actual)
+
+	var fn dcl.FieldName
+	var newDiffs []*dcl.FieldDiff
+	// New style diffs.
+	if ds, err := dcl.Diff(desired.Name, actual.Name, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Name")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Bucket, actual.Bucket, dcl.DiffInfo{Type: "ReferenceType", OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Bucket")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Generation, actual.Generation, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Generation")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Metageneration, actual.Metageneration, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Metageneration")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Id, actual.Id, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Id")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.SelfLink, actual.SelfLink, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("SelfLink")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ContentType, actual.ContentType, dcl.DiffInfo{ServerDefault: true, OperationSelector: dcl.TriggersOperation("updateObjectPatchObjectOperation")}, fn.AddNest("ContentType")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.TimeCreated, actual.TimeCreated, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("TimeCreated")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Updated, actual.Updated, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Updated")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CustomTime, actual.CustomTime, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateObjectPatchObjectOperation")}, fn.AddNest("CustomTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.TimeDeleted, actual.TimeDeleted, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("TimeDeleted")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.TemporaryHold, actual.TemporaryHold, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateObjectPatchObjectOperation")}, fn.AddNest("TemporaryHold")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.EventBasedHold, actual.EventBasedHold, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateObjectPatchObjectOperation")}, fn.AddNest("EventBasedHold")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.RetentionExpirationTime, actual.RetentionExpirationTime, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("RetentionExpirationTime")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.StorageClass, actual.StorageClass, dcl.DiffInfo{ServerDefault: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("StorageClass")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.TimeStorageClassUpdated, actual.TimeStorageClassUpdated, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("TimeStorageClassUpdated")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Size, actual.Size, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Size")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Md5Hash, actual.Md5Hash, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Md5Hash")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.MediaLink, actual.MediaLink, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("MediaLink")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Metadata, actual.Metadata, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateObjectPatchObjectOperation")}, fn.AddNest("Metadata")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Owner, actual.Owner, dcl.DiffInfo{OutputOnly: true, ObjectFunction: compareObjectOwnerNewStyle, EmptyObject: EmptyObjectOwner, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Owner")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Crc32c, actual.Crc32c, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Crc32c")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.ComponentCount, actual.ComponentCount, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("ComponentCount")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Etag, actual.Etag, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Etag")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.CustomerEncryption, actual.CustomerEncryption, dcl.DiffInfo{ObjectFunction: compareObjectCustomerEncryptionNewStyle, EmptyObject: EmptyObjectCustomerEncryption, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("CustomerEncryption")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.KmsKeyName, actual.KmsKeyName, dcl.DiffInfo{ServerDefault: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("KmsKeyName")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Content, actual.Content, dcl.DiffInfo{OperationSelector: dcl.TriggersOperation("updateObjectPatchObjectOperation")}, fn.AddNest("Content")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		newDiffs = append(newDiffs, ds...)
+	}
+
+	return newDiffs, nil
+}
+func compareObjectOwnerNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*ObjectOwner)
+	if !ok {
+		desiredNotPointer, ok := d.(ObjectOwner)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ObjectOwner or *ObjectOwner", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*ObjectOwner)
+	if !ok {
+		actualNotPointer, ok := a.(ObjectOwner)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ObjectOwner", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.Entity, actual.Entity, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Entity")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.EntityId, actual.EntityId, dcl.DiffInfo{OutputOnly: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("EntityId")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+func compareObjectCustomerEncryptionNewStyle(d, a interface{}, fn dcl.FieldName) ([]*dcl.FieldDiff, error) {
+	var diffs []*dcl.FieldDiff
+
+	desired, ok := d.(*ObjectCustomerEncryption)
+	if !ok {
+		desiredNotPointer, ok := d.(ObjectCustomerEncryption)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ObjectCustomerEncryption or *ObjectCustomerEncryption", d)
+		}
+		desired = &desiredNotPointer
+	}
+	actual, ok := a.(*ObjectCustomerEncryption)
+	if !ok {
+		actualNotPointer, ok := a.(ObjectCustomerEncryption)
+		if !ok {
+			return nil, fmt.Errorf("obj %v is not a ObjectCustomerEncryption", a)
+		}
+		actual = &actualNotPointer
+	}
+
+	if ds, err := dcl.Diff(desired.EncryptionAlgorithm, actual.EncryptionAlgorithm, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("EncryptionAlgorithm")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.KeySha256, actual.KeySha256, dcl.DiffInfo{OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("KeySha256")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+
+	if ds, err := dcl.Diff(desired.Key, actual.Key, dcl.DiffInfo{Ignore: true, OperationSelector: dcl.RequiresRecreate()}, fn.AddNest("Key")); len(ds) != 0 || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, ds...)
+	}
+	return diffs, nil
+}
+
+// urlNormalized returns a copy of the resource struct with values normalized
+// for URL substitutions. For instance, it converts long-form self-links to
+// short-form so they can be substituted in.
+func (r *Object) urlNormalized() *Object {
+	normalized := dcl.Copy(*r).(Object)
+	normalized.Bucket = dcl.SelfLinkToName(r.Bucket)
+	normalized.Id = dcl.SelfLinkToName(r.Id)
+	normalized.SelfLink = dcl.SelfLinkToName(r.SelfLink)
+	normalized.ContentType = dcl.SelfLinkToName(r.ContentType)
+	normalized.TimeCreated = dcl.SelfLinkToName(r.TimeCreated)
+	normalized.Updated = dcl.SelfLinkToName(r.Updated)
+	normalized.CustomTime = dcl.SelfLinkToName(r.CustomTime)
+	normalized.TimeDeleted = dcl.SelfLinkToName(r.TimeDeleted)
+	normalized.RetentionExpirationTime = dcl.SelfLinkToName(r.RetentionExpirationTime)
+	normalized.StorageClass = dcl.SelfLinkToName(r.StorageClass)
+	normalized.TimeStorageClassUpdated = dcl.SelfLinkToName(r.TimeStorageClassUpdated)
+	normalized.Md5Hash = dcl.SelfLinkToName(r.Md5Hash)
+	normalized.MediaLink = dcl.SelfLinkToName(r.MediaLink)
+	normalized.Crc32c = dcl.SelfLinkToName(r.Crc32c)
+	normalized.Etag = dcl.SelfLinkToName(r.Etag)
+	normalized.KmsKeyName = dcl.SelfLinkToName(r.KmsKeyName)
+	normalized.Content = dcl.SelfLinkToName(r.Content)
+	return &normalized
+}
+
+func (r *Object) updateURL(userBasePath, updateName string) (string, error) {
+	nr := r.urlNormalized()
+	if updateName == "PatchObject" {
+		fields := map[string]interface{}{
+			"bucket": dcl.ValueOrEmptyString(nr.Bucket),
+			"name":   url.PathEscape(dcl.ValueOrEmptyString(nr.Name)),
+		}
+		return dcl.URL("b/{{bucket}}/o/{{name}}", nr.basePath(), userBasePath, fields), nil
+
+	}
+
+	return "", fmt.Errorf("unknown update name: %s", updateName)
+}
+
+// marshal encodes the Object resource into JSON for a Create request, and
+// performs transformations from the resource schema to the API schema if
+// necessary.
+func (r *Object) marshal(c *Client) ([]byte, error) {
+	m, err := expandObject(c, r)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling Object: %w", err)
+	}
+
+	return json.Marshal(m)
+}
+
+// unmarshalObject decodes JSON responses into the Object resource schema.
+func unmarshalObject(b []byte, c *Client, res *Object) (*Object, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return unmarshalMapObject(m, c, res)
+}
+
+func unmarshalMapObject(m map[string]interface{}, c *Client, res *Object) (*Object, error) {
+
+	flattened := flattenObject(c, m, res)
+	if flattened == nil {
+		return nil, fmt.Errorf("attempted to flatten empty json object")
+	}
+	return flattened, nil
+}
+
+// expandObject expands Object into a JSON request object.
+func expandObject(c *Client, f *Object) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	res := f
+	_ = res
+	if v := f.Name; dcl.ValueShouldBeSent(v) {
+		m["name"] = v
+	}
+	if v, err := dcl.EmptyValue(); err != nil {
+		return nil, fmt.Errorf("error expanding Bucket into bucket: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["bucket"] = v
+	}
+	if v := f.ContentType; dcl.ValueShouldBeSent(v) {
+		m["contentType"] = v
+	}
+	if v := f.CustomTime; dcl.ValueShouldBeSent(v) {
+		m["customTime"] = v
+	}
+	if v := f.TemporaryHold; dcl.ValueShouldBeSent(v) {
+		m["temporaryHold"] = v
+	}
+	if v := f.EventBasedHold; dcl.ValueShouldBeSent(v) {
+		m["eventBasedHold"] = v
+	}
+	if v := f.StorageClass; dcl.ValueShouldBeSent(v) {
+		m["storageClass"] = v
+	}
+	if v := f.Metadata; dcl.ValueShouldBeSent(v) {
+		m["metadata"] = v
+	}
+	if v, err := expandObjectCustomerEncryption(c, f.CustomerEncryption, res); err != nil {
+		return nil, fmt.Errorf("error expanding CustomerEncryption into customerEncryption: %w", err)
+	} else if !dcl.IsEmptyValueIndirect(v) {
+		m["customerEncryption"] = v
+	}
+	if v := f.KmsKeyName; dcl.ValueShouldBeSent(v) {
+		m["kmsKeyName"] = v
+	}
+
+	return m, nil
+}
+
+// flattenObject flattens Object from a JSON request object into the
+// Object type.
+func flattenObject(c *Client, i interface{}, res *Object) *Object {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if len(m) == 0 {
+		return nil
+	}
+
+	resultRes := &Object{}
+	resultRes.Name = dcl.FlattenString(m["name"])
+	resultRes.Bucket = dcl.FlattenString(m["bucket"])
+	resultRes.Generation = dcl.FlattenInteger(m["generation"])
+	resultRes.Metageneration = dcl.FlattenInteger(m["metageneration"])
+	resultRes.Id = dcl.FlattenString(m["id"])
+	resultRes.SelfLink = dcl.FlattenString(m["selfLink"])
+	resultRes.ContentType = dcl.FlattenString(m["contentType"])
+	resultRes.TimeCreated = dcl.FlattenString(m["timeCreated"])
+	resultRes.Updated = dcl.FlattenString(m["updated"])
+	resultRes.CustomTime = dcl.FlattenString(m["customTime"])
+	resultRes.TimeDeleted = dcl.FlattenString(m["timeDeleted"])
+	resultRes.TemporaryHold = dcl.FlattenBool(m["temporaryHold"])
+	resultRes.EventBasedHold = dcl.FlattenBool(m["eventBasedHold"])
+	resultRes.RetentionExpirationTime = dcl.FlattenString(m["retentionExpirationTime"])
+	resultRes.StorageClass = dcl.FlattenString(m["storageClass"])
+	resultRes.TimeStorageClassUpdated = dcl.FlattenString(m["timeStorageClassUpdated"])
+	resultRes.Size = dcl.FlattenInteger(m["size"])
+	resultRes.Md5Hash = dcl.FlattenString(m["md5Hash"])
+	resultRes.MediaLink = dcl.FlattenString(m["mediaLink"])
+	resultRes.Metadata = dcl.FlattenKeyValuePairs(m["metadata"])
+	resultRes.Owner = flattenObjectOwner(c, m["owner"], res)
+	resultRes.Crc32c = dcl.FlattenString(m["crc32c"])
+	resultRes.ComponentCount = dcl.FlattenInteger(m["componentCount"])
+	resultRes.Etag = dcl.FlattenString(m["etag"])
+	resultRes.CustomerEncryption = flattenObjectCustomerEncryption(c, m["customerEncryption"], res)
+	resultRes.KmsKeyName = dcl.FlattenString(m["kmsKeyName"])
+
+	return resultRes
+}
+
+// expandObjectOwnerMap expands the contents of ObjectOwner into a JSON
+// request object.
+func expandObjectOwnerMap(c *Client, f map[string]ObjectOwner, res *Object) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandObjectOwner(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandObjectOwnerSlice expands the contents of ObjectOwner into a JSON
+// request object.
+func expandObjectOwnerSlice(c *Client, f []ObjectOwner, res *Object) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandObjectOwner(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenObjectOwnerMap flattens the contents of ObjectOwner from a JSON
+// response object.
+func flattenObjectOwnerMap(c *Client, i interface{}, res *Object) map[string]ObjectOwner {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ObjectOwner{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ObjectOwner{}
+	}
+
+	items := make(map[string]ObjectOwner)
+	for k, item := range a {
+		items[k] = *flattenObjectOwner(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenObjectOwnerSlice flattens the contents of ObjectOwner from a JSON
+// response object.
+func flattenObjectOwnerSlice(c *Client, i interface{}, res *Object) []ObjectOwner {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ObjectOwner{}
+	}
+
+	if len(a) == 0 {
+		return []ObjectOwner{}
+	}
+
+	items := make([]ObjectOwner, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenObjectOwner(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandObjectOwner expands an instance of ObjectOwner into a JSON
+// request object.
+func expandObjectOwner(c *Client, f *ObjectOwner, res *Object) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+
+	return m, nil
+}
+
+// flattenObjectOwner flattens an instance of ObjectOwner from a JSON
+// response object.
+func flattenObjectOwner(c *Client, i interface{}, res *Object) *ObjectOwner {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &ObjectOwner{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyObjectOwner
+	}
+	r.Entity = dcl.FlattenString(m["entity"])
+	r.EntityId = dcl.FlattenString(m["entityId"])
+
+	return r
+}
+
+// expandObjectCustomerEncryptionMap expands the contents of ObjectCustomerEncryption into a JSON
+// request object.
+func expandObjectCustomerEncryptionMap(c *Client, f map[string]ObjectCustomerEncryption, res *Object) (map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := make(map[string]interface{})
+	for k, item := range f {
+		i, err := expandObjectCustomerEncryption(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+		if i != nil {
+			items[k] = i
+		}
+	}
+
+	return items, nil
+}
+
+// expandObjectCustomerEncryptionSlice expands the contents of ObjectCustomerEncryption into a JSON
+// request object.
+func expandObjectCustomerEncryptionSlice(c *Client, f []ObjectCustomerEncryption, res *Object) ([]map[string]interface{}, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, item := range f {
+		i, err := expandObjectCustomerEncryption(c, &item, res)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+// flattenObjectCustomerEncryptionMap flattens the contents of ObjectCustomerEncryption from a JSON
+// response object.
+func flattenObjectCustomerEncryptionMap(c *Client, i interface{}, res *Object) map[string]ObjectCustomerEncryption {
+	a, ok := i.(map[string]interface{})
+	if !ok {
+		return map[string]ObjectCustomerEncryption{}
+	}
+
+	if len(a) == 0 {
+		return map[string]ObjectCustomerEncryption{}
+	}
+
+	items := make(map[string]ObjectCustomerEncryption)
+	for k, item := range a {
+		items[k] = *flattenObjectCustomerEncryption(c, item.(map[string]interface{}), res)
+	}
+
+	return items
+}
+
+// flattenObjectCustomerEncryptionSlice flattens the contents of ObjectCustomerEncryption from a JSON
+// response object.
+func flattenObjectCustomerEncryptionSlice(c *Client, i interface{}, res *Object) []ObjectCustomerEncryption {
+	a, ok := i.([]interface{})
+	if !ok {
+		return []ObjectCustomerEncryption{}
+	}
+
+	if len(a) == 0 {
+		return []ObjectCustomerEncryption{}
+	}
+
+	items := make([]ObjectCustomerEncryption, 0, len(a))
+	for _, item := range a {
+		items = append(items, *flattenObjectCustomerEncryption(c, item.(map[string]interface{}), res))
+	}
+
+	return items
+}
+
+// expandObjectCustomerEncryption expands an instance of ObjectCustomerEncryption into a JSON
+// request object.
+func expandObjectCustomerEncryption(c *Client, f *ObjectCustomerEncryption, res *Object) (map[string]interface{}, error) {
+	if dcl.IsEmptyValueIndirect(f) {
+		return nil, nil
+	}
+
+	m := make(map[string]interface{})
+	if v := f.EncryptionAlgorithm; !dcl.IsEmptyValueIndirect(v) {
+		m["encryptionAlgorithm"] = v
+	}
+	if v := f.KeySha256; !dcl.IsEmptyValueIndirect(v) {
+		m["keySha256"] = v
+	}
+	if v := f.Key; !dcl.IsEmptyValueIndirect(v) {
+		m["key"] = v
+	}
+
+	return m, nil
+}
+
+// flattenObjectCustomerEncryption flattens an instance of ObjectCustomerEncryption from a JSON
+// response object.
+func flattenObjectCustomerEncryption(c *Client, i interface{}, res *Object) *ObjectCustomerEncryption {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	r := &ObjectCustomerEncryption{}
+
+	if dcl.IsEmptyValueIndirect(i) {
+		return EmptyObjectCustomerEncryption
+	}
+	r.EncryptionAlgorithm = dcl.FlattenString(m["encryptionAlgorithm"])
+	r.KeySha256 = dcl.FlattenString(m["keySha256"])
+	r.Key = dcl.FlattenString(m["key"])
+
+	return r
+}
+
+// This function returns a matcher that checks whether a serialized resource matches this resource
+// in its parameters (as defined by the fields in a Get, which definitionally define resource
+// identity).  This is useful in extracting the element from a List call.
+func (r *Object) matcher(c *Client) func([]byte) bool {
+	return func(b []byte) bool {
+		cr, err := unmarshalObject(b, c, r)
+		if err != nil {
+			c.Config.Logger.Warning("failed to unmarshal provided resource in matcher.")
+			return false
+		}
+		nr := r.urlNormalized()
+		ncr := cr.urlNormalized()
+		c.Config.Logger.Infof("looking for %v\nin %v", nr, ncr)
+
+		if nr.Bucket == nil && ncr.Bucket == nil {
+			c.Config.Logger.Info("Both Bucket fields null - considering equal.")
+		} else if nr.Bucket == nil || ncr.Bucket == nil {
+			c.Config.Logger.Info("Only one Bucket field is null - considering unequal.")
+			return false
+		} else if *nr.Bucket != *ncr.Bucket {
+			return false
+		}
+		if nr.Name == nil && ncr.Name == nil {
+			c.Config.Logger.Info("Both Name fields null - considering equal.")
+		} else if nr.Name == nil || ncr.Name == nil {
+			c.Config.Logger.Info("Only one Name field is null - considering unequal.")
+			return false
+		} else if *nr.Name != *ncr.Name {
+			return false
+		}
+		return true
+	}
+}
+
+type objectDiff struct {
+	// The diff should include one or the other of RequiresRecreate or UpdateOp.
+	RequiresRecreate bool
+	UpdateOp         objectApiOperation
+	FieldName        string // used for error logging
+}
+
+func convertFieldDiffsToObjectDiffs(config *dcl.Config, fds []*dcl.FieldDiff, opts []dcl.ApplyOption) ([]objectDiff, error) {
+	opNamesToFieldDiffs := make(map[string][]*dcl.FieldDiff)
+	// Map each operation name to the field diffs associated with it.
+	for _, fd := range fds {
+		for _, ro := range fd.ResultingOperation {
+			if fieldDiffs, ok := opNamesToFieldDiffs[ro]; ok {
+				fieldDiffs = append(fieldDiffs, fd)
+				opNamesToFieldDiffs[ro] = fieldDiffs
+			} else {
+				config.Logger.Infof("%s required due to diff: %v", ro, fd)
+				opNamesToFieldDiffs[ro] = []*dcl.FieldDiff{fd}
+			}
+		}
+	}
+	var diffs []objectDiff
+	// For each operation name, create a objectDiff which contains the operation.
+	for opName, fieldDiffs := range opNamesToFieldDiffs {
+		// Use the first field diff's field name for logging required recreate error.
+		diff := objectDiff{FieldName: fieldDiffs[0].FieldName}
+		if opName == "Recreate" {
+			diff.RequiresRecreate = true
+		} else {
+			apiOp, err := convertOpNameToObjectApiOperation(opName, fieldDiffs, opts...)
+			if err != nil {
+				return diffs, err
+			}
+			diff.UpdateOp = apiOp
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+func convertOpNameToObjectApiOperation(opName string, fieldDiffs []*dcl.FieldDiff, opts ...dcl.ApplyOption) (objectApiOperation, error) {
+	switch opName {
+
+	case "updateObjectPatchObjectOperation":
+		return &updateObjectPatchObjectOperation{FieldDiffs: fieldDiffs}, nil
+
+	default:
+		return nil, fmt.Errorf("no such operation with name: %v", opName)
+	}
+}
+
+func extractObjectFields(r *Object) error {
+	vOwner := r.Owner
+	if vOwner == nil {
+		// note: explicitly not the empty object.
+		vOwner = &ObjectOwner{}
+	}
+	if err := extractObjectOwnerFields(r, vOwner); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vOwner) {
+		r.Owner = vOwner
+	}
+	vCustomerEncryption := r.CustomerEncryption
+	if vCustomerEncryption == nil {
+		// note: explicitly not the empty object.
+		vCustomerEncryption = &ObjectCustomerEncryption{}
+	}
+	if err := extractObjectCustomerEncryptionFields(r, vCustomerEncryption); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vCustomerEncryption) {
+		r.CustomerEncryption = vCustomerEncryption
+	}
+	return nil
+}
+func extractObjectOwnerFields(r *Object, o *ObjectOwner) error {
+	return nil
+}
+func extractObjectCustomerEncryptionFields(r *Object, o *ObjectCustomerEncryption) error {
+	return nil
+}
+
+func postReadExtractObjectFields(r *Object) error {
+	vOwner := r.Owner
+	if vOwner == nil {
+		// note: explicitly not the empty object.
+		vOwner = &ObjectOwner{}
+	}
+	if err := postReadExtractObjectOwnerFields(r, vOwner); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vOwner) {
+		r.Owner = vOwner
+	}
+	vCustomerEncryption := r.CustomerEncryption
+	if vCustomerEncryption == nil {
+		// note: explicitly not the empty object.
+		vCustomerEncryption = &ObjectCustomerEncryption{}
+	}
+	if err := postReadExtractObjectCustomerEncryptionFields(r, vCustomerEncryption); err != nil {
+		return err
+	}
+	if !dcl.IsEmptyValueIndirect(vCustomerEncryption) {
+		r.CustomerEncryption = vCustomerEncryption
+	}
+	return nil
+}
+func postReadExtractObjectOwnerFields(r *Object, o *ObjectOwner) error {
+	return nil
+}
+func postReadExtractObjectCustomerEncryptionFields(r *Object, o *ObjectCustomerEncryption) error {
+	return nil
+}
@@ -0,0 +1,332 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+func DCLObjectSchema() *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{
+			Title:       "Storage/Object",
+			Description: "The Storage Object resource",
+			StructName:  "Object",
+		},
+		Paths: &dcl.Paths{
+			Get: &dcl.Path{
+				Description: "The function used to get information about a Object",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "object",
+						Required:    true,
+						Description: "A full instance of a Object",
+					},
+				},
+			},
+			Apply: &dcl.Path{
+				Description: "The function used to apply information about a Object",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "object",
+						Required:    true,
+						Description: "A full instance of a Object",
+					},
+				},
+			},
+			Delete: &dcl.Path{
+				Description: "The function used to delete a Object",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:        "object",
+						Required:    true,
+						Description: "A full instance of a Object",
+					},
+				},
+			},
+			DeleteAll: &dcl.Path{
+				Description: "The function used to delete all Object",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "bucket",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+			List: &dcl.Path{
+				Description: "The function used to list information about many Object",
+				Parameters: []dcl.PathParameters{
+					dcl.PathParameters{
+						Name:     "bucket",
+						Required: true,
+						Schema: &dcl.PathParametersSchema{
+							Type: "string",
+						},
+					},
+				},
+			},
+		},
+		Components: &dcl.Components{
+			Schemas: map[string]*dcl.Component{
+				"Object": &dcl.Component{
+					Title:           "Object",
+					ID:              "b/{{bucket}}/o/{{name}}",
+					UsesStateHint:   true,
+					ParentContainer: "project",
+					HasCreate:       true,
+					SchemaProperty: dcl.Property{
+						Type: "object",
+						Required: []string{
+							"name",
+							"bucket",
+						},
+						Properties: map[string]*dcl.Property{
+							"bucket": &dcl.Property{
+								Type:        "string",
+								GoName:      "Bucket",
+								Description: "The name of the bucket containing this object.",
+								ResourceReferences: []*dcl.PropertyResourceReference{
+									&dcl.PropertyResourceReference{
+										Resource: "Storage/Bucket",
+										Field:    "name",
+										Parent:   true,
+									},
+								},
+							},
+							"componentCount": &dcl.Property{
+								Type:        "integer",
+								Format:      "int64",
+								GoName:      "ComponentCount",
+								ReadOnly:    true,
+								Description: "Number of underlying components that make up this object. Components are accumulated by compose operations.",
+								Immutable:   true,
+							},
+							"content": &dcl.Property{
+								Type:        "string",
+								GoName:      "Content",
+								Description: "The data of the object. Content is never read back from the service; instead it is compared against the object's MD5 hash (or CRC32c checksum, when no MD5 hash is available), and the object is re-uploaded when they differ.",
+							},
+							"contentType": &dcl.Property{
+								Type:          "string",
+								GoName:        "ContentType",
+								Description:   "Content-Type of the object data. If an object is stored without a Content-Type, it is served as application/octet-stream.",
+								ServerDefault: true,
+							},
+							"crc32c": &dcl.Property{
+								Type:        "string",
+								GoName:      "Crc32c",
+								ReadOnly:    true,
+								Description: "CRC32c checksum, as described in RFC 4960, Appendix B; encoded using base64 in big-endian byte order. For more information about using the CRC32c checksum, see Hashes and ETags: Best Practices.",
+								Immutable:   true,
+							},
+							"customTime": &dcl.Property{
+								Type:        "string",
+								GoName:      "CustomTime",
+								Description: "A timestamp in RFC 3339 format specified by the user for an object.",
+							},
+							"customerEncryption": &dcl.Property{
+								Type:        "object",
+								GoName:      "CustomerEncryption",
+								GoType:      "ObjectCustomerEncryption",
+								Description: "Metadata of customer-supplied encryption key, if the object is encrypted by such a key.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"encryptionAlgorithm": &dcl.Property{
+										Type:        "string",
+										GoName:      "EncryptionAlgorithm",
+										Description: "The encryption algorithm.",
+										Immutable:   true,
+									},
+									"key": &dcl.Property{
+										Type:        "string",
+										GoName:      "Key",
+										Description: "The base64-encoded AES-256 encryption key. The key is sent with each request for the object and is never returned by the service.",
+										Immutable:   true,
+										Sensitive:   true,
+										Unreadable:  true,
+									},
+									"keySha256": &dcl.Property{
+										Type:        "string",
+										GoName:      "KeySha256",
+										Description: "SHA256 hash value of the encryption key.",
+										Immutable:   true,
+									},
+								},
+							},
+							"etag": &dcl.Property{
+								Type:        "string",
+								GoName:      "Etag",
+								ReadOnly:    true,
+								Description: "HTTP 1.1 Entity tag for the object.",
+								Immutable:   true,
+							},
+							"eventBasedHold": &dcl.Property{
+								Type:        "boolean",
+								GoName:      "EventBasedHold",
+								Description: "Whether or not the object is subject to an event-based hold.",
+							},
+							"generation": &dcl.Property{
+								Type:        "integer",
+								Format:      "int64",
+								GoName:      "Generation",
+								ReadOnly:    true,
+								Description: "The content generation of this object. Used for object versioning.",
+								Immutable:   true,
+							},
+							"id": &dcl.Property{
+								Type:        "string",
+								GoName:      "Id",
+								ReadOnly:    true,
+								Description: "The ID of the object, including the bucket name, object name, and generation number.",
+								Immutable:   true,
+							},
+							"kmsKeyName": &dcl.Property{
+								Type:          "string",
+								GoName:        "KmsKeyName",
+								Description:   "Not currently supported. Specifying the parameter causes the request to fail with status code 400 - Bad Request.",
+								Immutable:     true,
+								ServerDefault: true,
+							},
+							"md5Hash": &dcl.Property{
+								Type:        "string",
+								GoName:      "Md5Hash",
+								ReadOnly:    true,
+								Description: "MD5 hash of the data; encoded using base64. For more information about using the MD5 hash, see Hashes and ETags: Best Practices.",
+								Immutable:   true,
+							},
+							"mediaLink": &dcl.Property{
+								Type:        "string",
+								GoName:      "MediaLink",
+								ReadOnly:    true,
+								Description: "Media download link.",
+								Immutable:   true,
+							},
+							"metadata": &dcl.Property{
+								Type: "object",
+								AdditionalProperties: &dcl.Property{
+									Type: "string",
+								},
+								GoName:      "Metadata",
+								Description: "User-provided metadata, in key/value pairs.",
+							},
+							"metageneration": &dcl.Property{
+								Type:        "integer",
+								Format:      "int64",
+								GoName:      "Metageneration",
+								ReadOnly:    true,
+								Description: "The version of the metadata for this object at this generation. Used for preconditions and for detecting changes in metadata. A metageneration number is only meaningful in the context of a particular generation of a particular object.",
+								Immutable:   true,
+							},
+							"name": &dcl.Property{
+								Type:        "string",
+								GoName:      "Name",
+								Description: "The name of the object. Required if not specified by URL parameter.",
+								Immutable:   true,
+							},
+							"owner": &dcl.Property{
+								Type:        "object",
+								GoName:      "Owner",
+								GoType:      "ObjectOwner",
+								ReadOnly:    true,
+								Description: "The owner of the object. This will always be the uploader of the object.",
+								Immutable:   true,
+								Properties: map[string]*dcl.Property{
+									"entity": &dcl.Property{
+										Type:        "string",
+										GoName:      "Entity",
+										ReadOnly:    true,
+										Description: "The entity, in the form user-userId.",
+										Immutable:   true,
+									},
+									"entityId": &dcl.Property{
+										Type:        "string",
+										GoName:      "EntityId",
+										ReadOnly:    true,
+										Description: "The ID for the entity.",
+										Immutable:   true,
+									},
+								},
+							},
+							"retentionExpirationTime": &dcl.Property{
+								Type:        "string",
+								GoName:      "RetentionExpirationTime",
+								ReadOnly:    true,
+								Description: "A server-determined value that specifies the earliest time that the object's retention period expires. This value is in RFC 3339 format. Note 1: This field is not provided for objects with an active event-based hold, since retention expiration is unknown until the hold is removed. Note 2: This value can be provided even when temporary hold is set (so that the user can reason about policy without having to first unset the temporary hold).",
+								Immutable:   true,
+							},
+							"selfLink": &dcl.Property{
+								Type:        "string",
+								GoName:      "SelfLink",
+								ReadOnly:    true,
+								Description: "The link to this object.",
+								Immutable:   true,
+							},
+							"size": &dcl.Property{
+								Type:        "integer",
+								Format:      "int64",
+								GoName:      "Size",
+								ReadOnly:    true,
+								Description: "Content-Length of the data in bytes.",
+								Immutable:   true,
+							},
+							"storageClass": &dcl.Property{
+								Type:          "string",
+								GoName:        "StorageClass",
+								Description:   "Storage class of the object.",
+								Immutable:     true,
+								ServerDefault: true,
+							},
+							"temporaryHold": &dcl.Property{
+								Type:        "boolean",
+								GoName:      "TemporaryHold",
+								Description: "Whether or not the object is subject to a temporary hold.",
+							},
+							"timeCreated": &dcl.Property{
+								Type:        "string",
+								GoName:      "TimeCreated",
+								ReadOnly:    true,
+								Description: "The creation time of the object in RFC 3339 format.",
+								Immutable:   true,
+							},
+							"timeDeleted": &dcl.Property{
+								Type:        "string",
+								GoName:      "TimeDeleted",
+								ReadOnly:    true,
+								Description: "The time at which the object became noncurrent in RFC 3339 format. Will be returned if and only if this version of the object has been deleted.",
+								Immutable:   true,
+							},
+							"timeStorageClassUpdated": &dcl.Property{
+								Type:        "string",
+								GoName:      "TimeStorageClassUpdated",
+								ReadOnly:    true,
+								Description: "The time at which the object's storage class was last changed. When the object is initially created, it will be set to timeCreated.",
+								Immutable:   true,
+							},
+							"updated": &dcl.Property{
+								Type:        "string",
+								GoName:      "Updated",
+								ReadOnly:    true,
+								Description: "The modification time of the object metadata in RFC 3339 format. Set initially to object creation time and then updated whenever any metadata of the object changes. This includes changes made by a requester, such as modifying custom metadata, as well as changes made by Cloud Storage on behalf of a requester, such as changing the storage class based on an Object Lifecycle Configuration.",
+								Immutable:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// GENERATED BY gen_go_data.go
+// gen_go_data -package storage -var YAML_object blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/storage/object.yaml
+
+package storage
+
+// blaze-out/k8-fastbuild/genfiles/cloud/graphite/mmv2/services/google/storage/object.yaml
+var YAML_object = []byte("info:\n  title: Storage/Object\n  description: The Storage Object resource\n  x-dcl-struct-name: Object\n  x-dcl-has-iam: false\npaths:\n  get:\n    description: The function used to get information about a Object\n    parameters:\n    - name: object\n      required: true\n      description: A full instance of a Object\n  apply:\n    description: The function used to apply information about a Object\n    parameters:\n    - name: object\n      required: true\n      description: A full instance of a Object\n  delete:\n    description: The function used to delete a Object\n    parameters:\n    - name: object\n      required: true\n      description: A full instance of a Object\n  deleteAll:\n    description: The function used to delete all Object\n    parameters:\n    - name: bucket\n      required: true\n      schema:\n        type: string\n  list:\n    description: The function used to list information about many Object\n    parameters:\n    - name: bucket\n      required: true\n      schema:\n        type: string\ncomponents:\n  schemas:\n    Object:\n      title: Object\n      x-dcl-id: b/{{bucket}}/o/{{name}}\n      x-dcl-uses-state-hint: true\n      x-dcl-has-create: true\n      x-dcl-has-iam: false\n      x-dcl-read-timeout: 0\n      x-dcl-apply-timeout: 0\n      x-dcl-delete-timeout: 0\n      type: object\n      required:\n      - name\n      - bucket\n      properties:\n        bucket:\n          type: string\n          x-dcl-go-name: Bucket\n          description: The name of the bucket containing this object.\n          x-dcl-references:\n          - resource: Storage/Bucket\n            field: name\n            parent: true\n        componentCount:\n          type: integer\n          format: int64\n          x-dcl-go-name: ComponentCount\n          readOnly: true\n          description: Number of underlying components that make up this object. Components\n            are accumulated by compose operations.\n          x-kubernetes-immutable: true\n        content:\n          type: string\n          x-dcl-go-name: Content\n          description: The data of the object. Content is never read back from the\n            service; instead it is compared against the object's MD5 hash (or CRC32c\n            checksum, when no MD5 hash is available), and the object is re-uploaded\n            when they differ.\n        contentType:\n          type: string\n          x-dcl-go-name: ContentType\n          description: Content-Type of the object data. If an object is stored without\n            a Content-Type, it is served as application/octet-stream.\n          x-dcl-server-default: true\n        crc32c:\n          type: string\n          x-dcl-go-name: Crc32c\n          readOnly: true\n          description: 'CRC32c checksum, as described in RFC 4960, Appendix B; encoded\n            using base64 in big-endian byte order. For more information about using\n            the CRC32c checksum, see Hashes and ETags: Best Practices.'\n          x-kubernetes-immutable: true\n        customTime:\n          type: string\n          x-dcl-go-name: CustomTime\n          description: A timestamp in RFC 3339 format specified by the user for an\n            object.\n        customerEncryption:\n          type: object\n          x-dcl-go-name: CustomerEncryption\n          x-dcl-go-type: ObjectCustomerEncryption\n          description: Metadata of customer-supplied encryption key, if the object\n            is encrypted by such a key.\n          x-kubernetes-immutable: true\n          properties:\n            encryptionAlgorithm:\n              type: string\n              x-dcl-go-name: EncryptionAlgorithm\n              description: The encryption algorithm.\n              x-kubernetes-immutable: true\n            key:\n              type: string\n              x-dcl-go-name: Key\n              description: The base64-encoded AES-256 encryption key. The key is sent\n                with each request for the object and is never returned by the service.\n              x-kubernetes-immutable: true\n              x-dcl-sensitive: true\n              x-dcl-mutable-unreadable: true\n            keySha256:\n              type: string\n              x-dcl-go-name: KeySha256\n              description: SHA256 hash value of the encryption key.\n              x-kubernetes-immutable: true\n        etag:\n          type: string\n          x-dcl-go-name: Etag\n          readOnly: true\n          description: HTTP 1.1 Entity tag for the object.\n          x-kubernetes-immutable: true\n        eventBasedHold:\n          type: boolean\n          x-dcl-go-name: EventBasedHold\n          description: Whether or not the object is subject to an event-based hold.\n        generation:\n          type: integer\n          format: int64\n          x-dcl-go-name: Generation\n          readOnly: true\n          description: The content generation of this object. Used for object versioning.\n          x-kubernetes-immutable: true\n        id:\n          type: string\n          x-dcl-go-name: Id\n          readOnly: true\n          description: The ID of the object, including the bucket name, object name,\n            and generation number.\n          x-kubernetes-immutable: true\n        kmsKeyName:\n          type: string\n          x-dcl-go-name: KmsKeyName\n          description: Not currently supported. Specifying the parameter causes the\n            request to fail with status code 400 - Bad Request.\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n        md5Hash:\n          type: string\n          x-dcl-go-name: Md5Hash\n          readOnly: true\n          description: 'MD5 hash of the data; encoded using base64. For more information\n            about using the MD5 hash, see Hashes and ETags: Best Practices.'\n          x-kubernetes-immutable: true\n        mediaLink:\n          type: string\n          x-dcl-go-name: MediaLink\n          readOnly: true\n          description: Media download link.\n          x-kubernetes-immutable: true\n        metadata:\n          type: object\n          additionalProperties:\n            type: string\n          x-dcl-go-name: Metadata\n          description: User-provided metadata, in key/value pairs.\n        metageneration:\n          type: integer\n          format: int64\n          x-dcl-go-name: Metageneration\n          readOnly: true\n          description: The version of the metadata for this object at this generation.\n            Used for preconditions and for detecting changes in metadata. A metageneration\n            number is only meaningful in the context of a particular generation of\n            a particular object.\n          x-kubernetes-immutable: true\n        name:\n          type: string\n          x-dcl-go-name: Name\n          description: The name of the object. Required if not specified by URL parameter.\n          x-kubernetes-immutable: true\n        owner:\n          type: object\n          x-dcl-go-name: Owner\n          x-dcl-go-type: ObjectOwner\n          readOnly: true\n          description: The owner of the object. This will always be the uploader of\n            the object.\n          x-kubernetes-immutable: true\n          properties:\n            entity:\n              type: string\n              x-dcl-go-name: Entity\n              readOnly: true\n              description: The entity, in the form user-userId.\n              x-kubernetes-immutable: true\n            entityId:\n              type: string\n              x-dcl-go-name: EntityId\n              readOnly: true\n              description: The ID for the entity.\n              x-kubernetes-immutable: true\n        retentionExpirationTime:\n          type: string\n          x-dcl-go-name: RetentionExpirationTime\n          readOnly: true\n          description: 'A server-determined value that specifies the earliest time\n            that the object''s retention period expires. This value is in RFC 3339\n            format. Note 1: This field is not provided for objects with an active\n            event-based hold, since retention expiration is unknown until the hold\n            is removed. Note 2: This value can be provided even when temporary hold\n            is set (so that the user can reason about policy without having to first\n            unset the temporary hold).'\n          x-kubernetes-immutable: true\n        selfLink:\n          type: string\n          x-dcl-go-name: SelfLink\n          readOnly: true\n          description: The link to this object.\n          x-kubernetes-immutable: true\n        size:\n          type: integer\n          format: int64\n          x-dcl-go-name: Size\n          readOnly: true\n          description: Content-Length of the data in bytes.\n          x-kubernetes-immutable: true\n        storageClass:\n          type: string\n          x-dcl-go-name: StorageClass\n          description: Storage class of the object.\n          x-kubernetes-immutable: true\n          x-dcl-server-default: true\n        temporaryHold:\n          type: boolean\n          x-dcl-go-name: TemporaryHold\n          description: Whether or not the object is subject to a temporary hold.\n        timeCreated:\n          type: string\n          x-dcl-go-name: TimeCreated\n          readOnly: true\n          description: The creation time of the object in RFC 3339 format.\n          x-kubernetes-immutable: true\n        timeDeleted:\n          type: string\n          x-dcl-go-name: TimeDeleted\n          readOnly: true\n          description: The time at which the object became noncurrent in RFC 3339\n            format. Will be returned if and only if this version of the object has\n            been deleted.\n          x-kubernetes-immutable: true\n        timeStorageClassUpdated:\n          type: string\n          x-dcl-go-name: TimeStorageClassUpdated\n          readOnly: true\n          description: The time at which the object's storage class was last changed.\n            When the object is initially created, it will be set to timeCreated.\n          x-kubernetes-immutable: true\n        updated:\n          type: string\n          x-dcl-go-name: Updated\n          readOnly: true\n          description: The modification time of the object metadata in RFC 3339 format.\n            Set initially to object creation time and then updated whenever any metadata\n            of the object changes. This includes changes made by a requester, such\n            as modifying custom metadata, as well as changes made by Cloud Storage\n            on behalf of a requester, such as changing the storage class based on\n            an Object Lifecycle Configuration.\n          x-kubernetes-immutable: true\n")
+
+// 10460 bytes
+// MD5: 5d856fb153a995ebab97524e02a7cb20
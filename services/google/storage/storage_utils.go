@@ -15,7 +15,22 @@
 package storage
 
 import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
 )
 
 // expandStorageBucketLifecycleWithState expands the with_state enum into the
@@ -52,3 +67,351 @@ func flattenStorageBucketLifecycleWithState(_ *Client, v interface{}, _ *Bucket)
 	// b is false
 	return BucketLifecycleRuleConditionWithStateEnumRef("ARCHIVED")
 }
+
+// SetContentFromBytes sets the content of the object to b.
+func (r *Object) SetContentFromBytes(b []byte) {
+	r.Content = dcl.String(string(b))
+}
+
+// SetContentFromFile sets the content of the object to the contents of the
+// file at path.
+func (r *Object) SetContentFromFile(path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading object content from %q: %w", path, err)
+	}
+	r.SetContentFromBytes(b)
+	return nil
+}
+
+// SetContentFromReader sets the content of the object to everything read from
+// rd until EOF.
+func (r *Object) SetContentFromReader(rd io.Reader) error {
+	b, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return fmt.Errorf("error reading object content: %w", err)
+	}
+	r.SetContentFromBytes(b)
+	return nil
+}
+
+// canonicalizeObjectContent returns the desired content if it hashes to the
+// MD5 hash (or, for composite objects which have none, the CRC32c checksum)
+// reported for the object, and nil otherwise. This avoids downloading the
+// object to compare its content.
+func canonicalizeObjectContent(des *string, nw *Object) *string {
+	if des == nil {
+		return nil
+	}
+	b := []byte(*des)
+	if nw.Md5Hash != nil {
+		sum := md5.Sum(b)
+		if base64.StdEncoding.EncodeToString(sum[:]) == *nw.Md5Hash {
+			return des
+		}
+		return nil
+	}
+	if nw.Crc32c != nil {
+		sum := make([]byte, 4)
+		binary.BigEndian.PutUint32(sum, crc32.Checksum(b, crc32.MakeTable(crc32.Castagnoli)))
+		if base64.StdEncoding.EncodeToString(sum) == *nw.Crc32c {
+			return des
+		}
+	}
+	return nil
+}
+
+// encryptionConfig returns the client's config, extended with the
+// customer-supplied encryption key headers if the object has a key. Requests
+// with the key headers must not be logged, so the returned context suppresses
+// request logging when they are set.
+func (r *Object) encryptionConfig(ctx context.Context, c *Client) (context.Context, *dcl.Config, error) {
+	if r.CustomerEncryption == nil || dcl.ValueOrEmptyString(r.CustomerEncryption.Key) == "" {
+		return ctx, c.Config, nil
+	}
+	key := *r.CustomerEncryption.Key
+	keySha256 := dcl.ValueOrEmptyString(r.CustomerEncryption.KeySha256)
+	if keySha256 == "" {
+		k, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error decoding customer-supplied encryption key: %w", err)
+		}
+		sum := sha256.Sum256(k)
+		keySha256 = base64.StdEncoding.EncodeToString(sum[:])
+	}
+	algorithm := dcl.ValueOrEmptyString(r.CustomerEncryption.EncryptionAlgorithm)
+	if algorithm == "" {
+		algorithm = "AES256"
+	}
+	cfg := c.Config.Clone(
+		dcl.WithHeader("x-goog-encryption-algorithm", algorithm),
+		dcl.WithHeader("x-goog-encryption-key", key),
+		dcl.WithHeader("x-goog-encryption-key-sha256", keySha256),
+	)
+	return context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), cfg, nil
+}
+
+// upload creates the object, or replaces its content, with a multipart upload
+// of its metadata and content.
+func (r *Object) upload(ctx context.Context, c *Client) (map[string]interface{}, error) {
+	u, err := r.createURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+	// Media is uploaded through the /upload variant of the JSON API path.
+	u = strings.Replace(u, "/storage/v1/", "/upload/storage/v1/", 1)
+	u, err = dcl.AddQueryParams(u, map[string]string{"uploadType": "multipart"})
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := expandObject(c, r)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling Object: %w", err)
+	}
+	// The encryption key is sent in headers, never in the object metadata.
+	delete(m, "customerEncryption")
+	metadata, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Type", "application/json; charset=UTF-8")
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(metadata); err != nil {
+		return nil, err
+	}
+	contentType := dcl.ValueOrEmptyString(r.ContentType)
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	h = make(textproto.MIMEHeader)
+	h.Set("Content-Type", contentType)
+	part, err = w.CreatePart(h)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(part, dcl.ValueOrEmptyString(r.Content)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	ctx, cfg, err := r.encryptionConfig(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	cfg = cfg.Clone(dcl.WithContentType("multipart/related; boundary=" + w.Boundary()))
+	// The request holds the whole content of the object, so it is not logged.
+	ctx = context.WithValue(ctx, dcl.DoNotLogRequestsKey, true)
+	resp, err := dcl.SendRequest(ctx, cfg, "POST", u, body, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	o, err := dcl.ResponseBodyAsJSON(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding response body into JSON: %w", err)
+	}
+	return o, nil
+}
+
+func (op *createObjectOperation) do(ctx context.Context, r *Object, c *Client) error {
+	// The object is not logged in full, since it holds the content and the
+	// customer-supplied encryption key.
+	c.Config.Logger.InfoWithContextf(ctx, "Attempting to create Object %q in Bucket %q", dcl.ValueOrEmptyString(r.Name), dcl.ValueOrEmptyString(r.Bucket))
+	o, err := r.upload(ctx, c)
+	if err != nil {
+		return err
+	}
+	op.response = o
+
+	if _, err := c.GetObject(ctx, r); err != nil {
+		c.Config.Logger.WarningWithContextf(ctx, "get returned error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// do patches the object's metadata, unless its content has changed, in which
+// case the whole object is uploaded again.
+func (op *updateObjectPatchObjectOperation) do(ctx context.Context, r *Object, c *Client) error {
+	_, err := c.GetObject(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range op.FieldDiffs {
+		if d.FieldName == "Content" {
+			c.Config.Logger.InfoWithContext(ctx, "Object content changed, uploading again")
+			_, err := r.upload(ctx, c)
+			return err
+		}
+	}
+
+	u, err := r.updateURL(c.Config.BasePath, "PatchObject")
+	if err != nil {
+		return err
+	}
+
+	req, err := newUpdateObjectPatchObjectRequest(ctx, r, c)
+	if err != nil {
+		return err
+	}
+
+	c.Config.Logger.InfoWithContextf(ctx, "Created update: %#v", req)
+	body, err := marshalUpdateObjectPatchObjectRequest(c, req)
+	if err != nil {
+		return err
+	}
+	_, err = dcl.SendRequest(ctx, c.Config, "PATCH", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	return err
+}
+
+func (c *Client) getObjectRaw(ctx context.Context, r *Object) ([]byte, error) {
+	u, err := r.getURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+	// The hashes of an object encrypted with a customer-supplied key are only
+	// returned when the key is supplied.
+	ctx, cfg, err := r.encryptionConfig(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, cfg, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	return ioutil.ReadAll(resp.Response.Body)
+}
+
+// setState moves the HMAC key into the given state.
+func (r *HmacKey) setState(ctx context.Context, c *Client, state HmacKeyStateEnum) error {
+	u, err := r.updateURL(c.Config.BasePath, "UpdateHmacKey")
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]interface{}{"state": state})
+	if err != nil {
+		return err
+	}
+	_, err = dcl.SendRequest(ctx, c.Config, "PUT", u, bytes.NewBuffer(body), c.Config.RetryProvider)
+	return err
+}
+
+func (op *createHmacKeyOperation) do(ctx context.Context, r *HmacKey, c *Client) error {
+	c.Config.Logger.InfoWithContextf(ctx, "Attempting to create %v", r)
+	u, err := r.createURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+	if r.Name != nil {
+		// Allowing creation to continue with Name set could result in a HmacKey with the wrong Name.
+		return fmt.Errorf("server-generated parameter Name was specified by user as %v, should be unspecified", dcl.ValueOrEmptyString(r.Name))
+	}
+	// The response holds the secret of the key, so it is not logged.
+	resp, err := dcl.SendRequest(context.WithValue(ctx, dcl.DoNotLogRequestsKey, true), c.Config, "POST", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return err
+	}
+	o, err := dcl.ResponseBodyAsJSON(resp)
+	if err != nil {
+		return fmt.Errorf("error decoding response body into JSON: %w", err)
+	}
+	m, ok := o["metadata"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("no metadata in HmacKey create response")
+	}
+	// The secret is only ever returned here, so it is surfaced through the
+	// first response alongside the key's metadata.
+	m["secret"] = o["secret"]
+	op.response = m
+
+	// Include Name in URL substitution for initial GET request.
+	r.Name = dcl.SelfLinkToName(dcl.FlattenString(m["accessId"]))
+
+	// Keys are always created active.
+	if r.State != nil && *r.State != HmacKeyStateEnum("ACTIVE") {
+		if err := r.setState(ctx, c, *r.State); err != nil {
+			return err
+		}
+	}
+
+	if _, err := c.GetHmacKey(ctx, r); err != nil {
+		c.Config.Logger.WarningWithContextf(ctx, "get returned error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// do deletes the HMAC key, deactivating it first since only inactive keys
+// can be deleted.
+func (op *deleteHmacKeyOperation) do(ctx context.Context, r *HmacKey, c *Client) error {
+	r, err := c.GetHmacKey(ctx, r)
+	if err != nil {
+		if dcl.IsNotFound(err) {
+			c.Config.Logger.InfoWithContextf(ctx, "HmacKey not found, returning. Original error: %v", err)
+			return nil
+		}
+		c.Config.Logger.WarningWithContextf(ctx, "GetHmacKey checking for existence. error: %v", err)
+		return err
+	}
+
+	if r.State != nil && *r.State == HmacKeyStateEnum("ACTIVE") {
+		if err := r.setState(ctx, c, HmacKeyStateEnum("INACTIVE")); err != nil {
+			return fmt.Errorf("failed to deactivate HmacKey: %w", err)
+		}
+	}
+
+	u, err := r.deleteURL(c.Config.BasePath)
+	if err != nil {
+		return err
+	}
+
+	// Delete should never have a body
+	body := &bytes.Buffer{}
+	_, err = dcl.SendRequest(ctx, c.Config, "DELETE", u, body, c.Config.RetryProvider)
+	if err != nil {
+		return fmt.Errorf("failed to delete HmacKey: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) getHmacKeyRaw(ctx context.Context, r *HmacKey) ([]byte, error) {
+	u, err := r.getURL(c.Config.BasePath)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := dcl.SendRequest(ctx, c.Config, "GET", u, &bytes.Buffer{}, c.Config.RetryProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Response.Body.Close()
+	b, err := ioutil.ReadAll(resp.Response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleted keys remain readable for a while, but no longer exist as far as
+	// callers are concerned.
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if state, ok := m["state"].(string); ok && state == "DELETED" {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("HmacKey %s has been deleted", dcl.ValueOrEmptyString(r.Name))}
+	}
+
+	return b, nil
+}
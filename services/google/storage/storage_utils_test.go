@@ -0,0 +1,146 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package storage contains handwritten support code for the storage service.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+// recordingLogger records every line logged through it.
+type recordingLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *recordingLogger) record(s string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, s)
+}
+
+func (l *recordingLogger) Fatal(args ...interface{}) { l.record(fmt.Sprint(args...)) }
+func (l *recordingLogger) Fatalf(format string, args ...interface{}) {
+	l.record(fmt.Sprintf(format, args...))
+}
+func (l *recordingLogger) Info(args ...interface{}) { l.record(fmt.Sprint(args...)) }
+func (l *recordingLogger) Infof(format string, args ...interface{}) {
+	l.record(fmt.Sprintf(format, args...))
+}
+func (l *recordingLogger) Warning(args ...interface{}) { l.record(fmt.Sprint(args...)) }
+func (l *recordingLogger) Warningf(format string, args ...interface{}) {
+	l.record(fmt.Sprintf(format, args...))
+}
+
+func TestSecretsAreNotLogged(t *testing.T) {
+	const (
+		hmacSecret = "hmac-secret-value"
+		content    = "object-content-value"
+		// The base64 encoding of a 32 byte AES-256 key.
+		encryptionKey = "ZW5jcnlwdGlvbi1rZXktdmFsdWUtMzItYnl0ZXMhISE="
+	)
+	encryptedObject := func() *Object {
+		return &Object{
+			Bucket:             dcl.String("bucket"),
+			Name:               dcl.String("object"),
+			Content:            dcl.String(content),
+			CustomerEncryption: &ObjectCustomerEncryption{Key: dcl.String(encryptionKey)},
+		}
+	}
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		run     func(ctx context.Context, c *Client) error
+		wantErr bool
+	}{
+		{
+			name: "HMAC key create",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				metadata := `{"accessId":"GOOG1ID","projectId":"project","serviceAccountEmail":"sa@project.iam.gserviceaccount.com","state":"ACTIVE"}`
+				if r.Method == "POST" {
+					fmt.Fprintf(w, `{"metadata":%s,"secret":%q}`, metadata, hmacSecret)
+					return
+				}
+				fmt.Fprint(w, metadata)
+			},
+			run: func(ctx context.Context, c *Client) error {
+				op := &createHmacKeyOperation{}
+				r := &HmacKey{Project: dcl.String("project"), ServiceAccountEmail: dcl.String("sa@project.iam.gserviceaccount.com")}
+				if err := op.do(ctx, r, c); err != nil {
+					return err
+				}
+				if got := op.response["secret"]; got != hmacSecret {
+					return fmt.Errorf("create response secret = %v, want %q", got, hmacSecret)
+				}
+				return nil
+			},
+		},
+		{
+			name: "object upload and read with a customer-supplied key",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"bucket":"bucket","name":"object"}`)
+			},
+			run: func(ctx context.Context, c *Client) error {
+				r := encryptedObject()
+				if _, err := r.upload(ctx, c); err != nil {
+					return err
+				}
+				_, err := c.getObjectRaw(ctx, r)
+				return err
+			},
+		},
+		{
+			name: "failed object upload",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error":{"code":400,"message":"invalid object"}}`)
+			},
+			run: func(ctx context.Context, c *Client) error {
+				_, err := encryptedObject().upload(ctx, c)
+				return err
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(tc.handler)
+			defer srv.Close()
+			l := &recordingLogger{}
+			c := NewClient(dcl.NewConfig(
+				dcl.WithBasePath(srv.URL+"/"),
+				dcl.WithHTTPClient(srv.Client()),
+				dcl.WithLogger(l),
+			))
+			if err := tc.run(context.Background(), c); (err != nil) != tc.wantErr {
+				t.Fatalf("run() error = %v, want error %v", err, tc.wantErr)
+			}
+			for _, line := range l.lines {
+				for _, secret := range []string{hmacSecret, content, encryptionKey} {
+					if strings.Contains(line, secret) {
+						t.Errorf("logged %q:\n%s", secret, line)
+					}
+				}
+			}
+		})
+	}
+}
@@ -524,8 +524,12 @@ func (l *PrivateCloudList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *PrivateCloudList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *PrivateCloudList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PrivateCloud{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -542,7 +546,8 @@ func (l *PrivateCloudList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListPrivateCloud(ctx context.Context, project, location string) (*PrivateCloudList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&PrivateCloud{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PrivateCloud{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListPrivateCloudWithMaxResults(ctx, project, location, PrivateCloudMaxPage)
@@ -550,7 +555,11 @@ func (c *Client) ListPrivateCloud(ctx context.Context, project, location string)
 }
 
 func (c *Client) ListPrivateCloudWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*PrivateCloudList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListPrivateCloudWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListPrivateCloudWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*PrivateCloudList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PrivateCloud{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -558,7 +567,7 @@ func (c *Client) ListPrivateCloudWithMaxResults(ctx context.Context, project, lo
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listPrivateCloud(ctx, r, "", pageSize)
+	items, token, err := c.listPrivateCloud(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -572,7 +581,8 @@ func (c *Client) ListPrivateCloudWithMaxResults(ctx context.Context, project, lo
 
 func (c *Client) GetPrivateCloud(ctx context.Context, r *PrivateCloud) (*PrivateCloud, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&PrivateCloud{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PrivateCloud{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -588,7 +598,7 @@ func (c *Client) GetPrivateCloud(ctx context.Context, r *PrivateCloud) (*Private
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalPrivateCloud(b, c, r)
 	if err != nil {
@@ -614,7 +624,8 @@ func (c *Client) GetPrivateCloud(ctx context.Context, r *PrivateCloud) (*Private
 
 func (c *Client) DeletePrivateCloud(ctx context.Context, r *PrivateCloud) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(7200*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&PrivateCloud{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PrivateCloud{}).Describe(), 7200*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -622,7 +633,13 @@ func (c *Client) DeletePrivateCloud(ctx context.Context, r *PrivateCloud) error
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting PrivateCloud...")
 	deleteOp := deletePrivateCloudOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeletePrivateCloudAsync(ctx context.Context, r *PrivateCloud) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeletePrivateCloud(ctx, r)
+	})
 }
 
 // DeleteAllPrivateCloud deletes all resources that the filter functions returns true on.
@@ -650,10 +667,11 @@ func (c *Client) DeleteAllPrivateCloud(ctx context.Context, project, location st
 }
 
 func (c *Client) ApplyPrivateCloud(ctx context.Context, rawDesired *PrivateCloud, opts ...dcl.ApplyOption) (*PrivateCloud, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(9600*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PrivateCloud{}).Describe(), 9600*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&PrivateCloud{}).Describe())
 	var resultNewState *PrivateCloud
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyPrivateCloudHelper(c, ctx, rawDesired, opts...)
@@ -671,6 +689,38 @@ func (c *Client) ApplyPrivateCloud(ctx context.Context, rawDesired *PrivateCloud
 	return resultNewState, err
 }
 
+func (c *Client) ApplyPrivateCloudAsync(ctx context.Context, rawDesired *PrivateCloud, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyPrivateCloud(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffPrivateCloud returns the field-level differences between rawDesired and the
+// live PrivateCloud without modifying it. If the PrivateCloud does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffPrivateCloud(ctx context.Context, rawDesired *PrivateCloud, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&PrivateCloud{}).Describe(), 9600*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&PrivateCloud{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractPrivateCloudFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.privateCloudDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("PrivateCloud %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyPrivateCloudHelper(c *Client, ctx context.Context, rawDesired *PrivateCloud, opts ...dcl.ApplyOption) (*PrivateCloud, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyPrivateCloud...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -679,6 +729,9 @@ func applyPrivateCloudHelper(c *Client, ctx context.Context, rawDesired *Private
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractPrivateCloudFields(rawDesired); err != nil {
 		return nil, err
@@ -737,7 +790,7 @@ func applyPrivateCloudHelper(c *Client, ctx context.Context, rawDesired *Private
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -475,7 +475,7 @@ func (c *Client) privateCloudDiffsForRawDesired(ctx context.Context, rawDesired
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a PrivateCloud resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve PrivateCloud resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve PrivateCloud resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that PrivateCloud resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -489,6 +489,12 @@ func (c *Client) privateCloudDiffsForRawDesired(ctx context.Context, rawDesired
 	if err := extractPrivateCloudFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizePrivateCloudInitialState(rawInitial, rawDesired)
@@ -506,7 +512,7 @@ func (c *Client) privateCloudDiffsForRawDesired(ctx context.Context, rawDesired
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffPrivateCloud(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizePrivateCloudInitialState(rawInitial, rawDesired *PrivateCloud) (*PrivateCloud, error) {
@@ -219,8 +219,12 @@ func (l *VmwareEngineNetworkList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *VmwareEngineNetworkList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *VmwareEngineNetworkList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VmwareEngineNetwork{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -237,7 +241,8 @@ func (l *VmwareEngineNetworkList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListVmwareEngineNetwork(ctx context.Context, project, location string) (*VmwareEngineNetworkList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VmwareEngineNetwork{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VmwareEngineNetwork{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListVmwareEngineNetworkWithMaxResults(ctx, project, location, VmwareEngineNetworkMaxPage)
@@ -245,7 +250,11 @@ func (c *Client) ListVmwareEngineNetwork(ctx context.Context, project, location
 }
 
 func (c *Client) ListVmwareEngineNetworkWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*VmwareEngineNetworkList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListVmwareEngineNetworkWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListVmwareEngineNetworkWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*VmwareEngineNetworkList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VmwareEngineNetwork{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -253,7 +262,7 @@ func (c *Client) ListVmwareEngineNetworkWithMaxResults(ctx context.Context, proj
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listVmwareEngineNetwork(ctx, r, "", pageSize)
+	items, token, err := c.listVmwareEngineNetwork(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +276,8 @@ func (c *Client) ListVmwareEngineNetworkWithMaxResults(ctx context.Context, proj
 
 func (c *Client) GetVmwareEngineNetwork(ctx context.Context, r *VmwareEngineNetwork) (*VmwareEngineNetwork, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VmwareEngineNetwork{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VmwareEngineNetwork{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -283,7 +293,7 @@ func (c *Client) GetVmwareEngineNetwork(ctx context.Context, r *VmwareEngineNetw
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalVmwareEngineNetwork(b, c, r)
 	if err != nil {
@@ -309,7 +319,8 @@ func (c *Client) GetVmwareEngineNetwork(ctx context.Context, r *VmwareEngineNetw
 
 func (c *Client) DeleteVmwareEngineNetwork(ctx context.Context, r *VmwareEngineNetwork) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VmwareEngineNetwork{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VmwareEngineNetwork{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -317,7 +328,13 @@ func (c *Client) DeleteVmwareEngineNetwork(ctx context.Context, r *VmwareEngineN
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting VmwareEngineNetwork...")
 	deleteOp := deleteVmwareEngineNetworkOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteVmwareEngineNetworkAsync(ctx context.Context, r *VmwareEngineNetwork) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteVmwareEngineNetwork(ctx, r)
+	})
 }
 
 // DeleteAllVmwareEngineNetwork deletes all resources that the filter functions returns true on.
@@ -345,10 +362,11 @@ func (c *Client) DeleteAllVmwareEngineNetwork(ctx context.Context, project, loca
 }
 
 func (c *Client) ApplyVmwareEngineNetwork(ctx context.Context, rawDesired *VmwareEngineNetwork, opts ...dcl.ApplyOption) (*VmwareEngineNetwork, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VmwareEngineNetwork{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VmwareEngineNetwork{}).Describe())
 	var resultNewState *VmwareEngineNetwork
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyVmwareEngineNetworkHelper(c, ctx, rawDesired, opts...)
@@ -366,6 +384,38 @@ func (c *Client) ApplyVmwareEngineNetwork(ctx context.Context, rawDesired *Vmwar
 	return resultNewState, err
 }
 
+func (c *Client) ApplyVmwareEngineNetworkAsync(ctx context.Context, rawDesired *VmwareEngineNetwork, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyVmwareEngineNetwork(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffVmwareEngineNetwork returns the field-level differences between rawDesired and the
+// live VmwareEngineNetwork without modifying it. If the VmwareEngineNetwork does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffVmwareEngineNetwork(ctx context.Context, rawDesired *VmwareEngineNetwork, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&VmwareEngineNetwork{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&VmwareEngineNetwork{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractVmwareEngineNetworkFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.vmwareEngineNetworkDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("VmwareEngineNetwork %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyVmwareEngineNetworkHelper(c *Client, ctx context.Context, rawDesired *VmwareEngineNetwork, opts ...dcl.ApplyOption) (*VmwareEngineNetwork, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyVmwareEngineNetwork...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -374,6 +424,9 @@ func applyVmwareEngineNetworkHelper(c *Client, ctx context.Context, rawDesired *
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractVmwareEngineNetworkFields(rawDesired); err != nil {
 		return nil, err
@@ -432,7 +485,7 @@ func applyVmwareEngineNetworkHelper(c *Client, ctx context.Context, rawDesired *
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
@@ -406,7 +406,7 @@ func (c *Client) vmwareEngineNetworkDiffsForRawDesired(ctx context.Context, rawD
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a VmwareEngineNetwork resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve VmwareEngineNetwork resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve VmwareEngineNetwork resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that VmwareEngineNetwork resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -420,6 +420,12 @@ func (c *Client) vmwareEngineNetworkDiffsForRawDesired(ctx context.Context, rawD
 	if err := extractVmwareEngineNetworkFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeVmwareEngineNetworkInitialState(rawInitial, rawDesired)
@@ -437,7 +443,7 @@ func (c *Client) vmwareEngineNetworkDiffsForRawDesired(ctx context.Context, rawD
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffVmwareEngineNetwork(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeVmwareEngineNetworkInitialState(rawInitial, rawDesired *VmwareEngineNetwork) (*VmwareEngineNetwork, error) {
@@ -169,8 +169,12 @@ func (l *ConnectorList) HasNext() bool {
 	return l.nextToken != ""
 }
 
+func (l *ConnectorList) NextPageToken() string {
+	return l.nextToken
+}
+
 func (l *ConnectorList) Next(ctx context.Context, c *Client) error {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connector{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if !l.HasNext() {
@@ -187,7 +191,8 @@ func (l *ConnectorList) Next(ctx context.Context, c *Client) error {
 
 func (c *Client) ListConnector(ctx context.Context, project, location string) (*ConnectorList, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Connector{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connector{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	return c.ListConnectorWithMaxResults(ctx, project, location, ConnectorMaxPage)
@@ -195,7 +200,11 @@ func (c *Client) ListConnector(ctx context.Context, project, location string) (*
 }
 
 func (c *Client) ListConnectorWithMaxResults(ctx context.Context, project, location string, pageSize int32) (*ConnectorList, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	return c.ListConnectorWithPageToken(ctx, project, location, pageSize, "")
+}
+
+func (c *Client) ListConnectorWithPageToken(ctx context.Context, project, location string, pageSize int32, pageToken string) (*ConnectorList, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connector{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// Create a resource object so that we can use proper url normalization methods.
@@ -203,7 +212,7 @@ func (c *Client) ListConnectorWithMaxResults(ctx context.Context, project, locat
 		Project:  &project,
 		Location: &location,
 	}
-	items, token, err := c.listConnector(ctx, r, "", pageSize)
+	items, token, err := c.listConnector(ctx, r, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -217,7 +226,8 @@ func (c *Client) ListConnectorWithMaxResults(ctx context.Context, project, locat
 
 func (c *Client) GetConnector(ctx context.Context, r *Connector) (*Connector, error) {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Connector{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connector{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	// This is *purposefully* supressing errors.
@@ -233,7 +243,7 @@ func (c *Client) GetConnector(ctx context.Context, r *Connector) (*Connector, er
 				Message: err.Error(),
 			}
 		}
-		return nil, err
+		return nil, dcl.NewResourceError(r, dcl.PhaseRead, err)
 	}
 	result, err := unmarshalConnector(b, c, r)
 	if err != nil {
@@ -259,7 +269,8 @@ func (c *Client) GetConnector(ctx context.Context, r *Connector) (*Connector, er
 
 func (c *Client) DeleteConnector(ctx context.Context, r *Connector) error {
 	ctx = dcl.ContextWithRequestID(ctx)
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Connector{}).Describe())
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connector{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	if r == nil {
@@ -267,7 +278,13 @@ func (c *Client) DeleteConnector(ctx context.Context, r *Connector) error {
 	}
 	c.Config.Logger.InfoWithContext(ctx, "Deleting Connector...")
 	deleteOp := deleteConnectorOperation{}
-	return deleteOp.do(ctx, r, c)
+	return dcl.NewResourceError(r, dcl.PhaseDelete, deleteOp.do(ctx, r, c))
+}
+
+func (c *Client) DeleteConnectorAsync(ctx context.Context, r *Connector) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, r, func(ctx context.Context) error {
+		return c.DeleteConnector(ctx, r)
+	})
 }
 
 // DeleteAllConnector deletes all resources that the filter functions returns true on.
@@ -295,10 +312,11 @@ func (c *Client) DeleteAllConnector(ctx context.Context, project, location strin
 }
 
 func (c *Client) ApplyConnector(ctx context.Context, rawDesired *Connector, opts ...dcl.ApplyOption) (*Connector, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutOr(0*time.Second))
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connector{}).Describe(), 0*time.Second))
 	defer cancel()
 
 	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Connector{}).Describe())
 	var resultNewState *Connector
 	err := dcl.Do(ctx, func(ctx context.Context) (*dcl.RetryDetails, error) {
 		newState, err := applyConnectorHelper(c, ctx, rawDesired, opts...)
@@ -316,6 +334,38 @@ func (c *Client) ApplyConnector(ctx context.Context, rawDesired *Connector, opts
 	return resultNewState, err
 }
 
+func (c *Client) ApplyConnectorAsync(ctx context.Context, rawDesired *Connector, opts ...dcl.ApplyOption) (*dcl.AsyncHandle, error) {
+	return dcl.StartAsync(ctx, c.Config, rawDesired, func(ctx context.Context) error {
+		_, err := c.ApplyConnector(ctx, rawDesired, opts...)
+		return err
+	})
+}
+
+// DiffConnector returns the field-level differences between rawDesired and the
+// live Connector without modifying it. If the Connector does not exist, the returned
+// error satisfies dcl.IsNotFound.
+func (c *Client) DiffConnector(ctx context.Context, rawDesired *Connector, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Config.TimeoutFor((&Connector{}).Describe(), 0*time.Second))
+	defer cancel()
+
+	ctx = dcl.ContextWithRequestID(ctx)
+	ctx = dcl.ContextWithServiceTypeVersion(ctx, (&Connector{}).Describe())
+	if err := rawDesired.validate(); err != nil {
+		return nil, err
+	}
+	if err := extractConnectorFields(rawDesired); err != nil {
+		return nil, err
+	}
+	initial, _, fieldDiffs, err := c.connectorDiffsForRawDesired(ctx, rawDesired, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if initial == nil {
+		return nil, dcl.NotFoundError{Cause: fmt.Errorf("Connector %v does not exist", rawDesired)}
+	}
+	return fieldDiffs, nil
+}
+
 func applyConnectorHelper(c *Client, ctx context.Context, rawDesired *Connector, opts ...dcl.ApplyOption) (*Connector, error) {
 	c.Config.Logger.InfoWithContext(ctx, "Beginning ApplyConnector...")
 	c.Config.Logger.InfoWithContextf(ctx, "User specified desired state: %v", rawDesired)
@@ -324,6 +374,9 @@ func applyConnectorHelper(c *Client, ctx context.Context, rawDesired *Connector,
 	if err := rawDesired.validate(); err != nil {
 		return nil, err
 	}
+	if err := dcl.ValidatePolicies(ctx, c.Config, rawDesired, opts); err != nil {
+		return nil, err
+	}
 
 	if err := extractConnectorFields(rawDesired); err != nil {
 		return nil, err
@@ -382,7 +435,7 @@ func applyConnectorHelper(c *Client, ctx context.Context, rawDesired *Connector,
 		c.Config.Logger.InfoWithContextf(ctx, "Performing operation %T %+v", op, op)
 		if err := op.do(ctx, desired, c); err != nil {
 			c.Config.Logger.InfoWithContextf(ctx, "Failed operation %T %+v: %v", op, op, err)
-			return nil, err
+			return nil, dcl.NewResourceError(desired, dcl.ApplyPhase(create), err)
 		}
 		c.Config.Logger.InfoWithContextf(ctx, "Finished operation %T %+v", op, op)
 	}
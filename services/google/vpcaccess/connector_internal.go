@@ -309,7 +309,7 @@ func (c *Client) connectorDiffsForRawDesired(ctx context.Context, rawDesired *Co
 	if rawInitial == nil {
 		if !dcl.IsNotFound(err) {
 			c.Config.Logger.WarningWithContextf(ctx, "Failed to retrieve whether a Connector resource already exists: %s", err)
-			return nil, nil, nil, fmt.Errorf("failed to retrieve Connector resource: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve Connector resource: %w", err)
 		}
 		c.Config.Logger.InfoWithContext(ctx, "Found that Connector resource did not exist.")
 		// Perform canonicalization to pick up defaults.
@@ -323,6 +323,12 @@ func (c *Client) connectorDiffsForRawDesired(ctx context.Context, rawDesired *Co
 	if err := extractConnectorFields(rawInitial); err != nil {
 		return nil, nil, nil, err
 	}
+	// Fields ignored with dcl.WithIgnoredFieldPaths keep their current values, in a copy of
+	// the desired state.
+	rawDesired, err = dcl.CopyIgnoredFields(rawDesired, rawInitial, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// 1.3: Canonicalize raw initial state into initial state.
 	initial, err = canonicalizeConnectorInitialState(rawInitial, rawDesired)
@@ -340,7 +346,7 @@ func (c *Client) connectorDiffsForRawDesired(ctx context.Context, rawDesired *Co
 
 	// 2.1: Comparison of initial and desired state.
 	diffs, err = diffConnector(c, desired, initial, opts...)
-	return initial, desired, diffs, err
+	return initial, desired, dcl.RemoveIgnoredFieldDiffs(diffs, opts), err
 }
 
 func canonicalizeConnectorInitialState(rawInitial, rawDesired *Connector) (*Connector, error) {
@@ -0,0 +1,374 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package unstructured
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+// DriftStatus describes how a live resource compares to its desired state.
+type DriftStatus string
+
+const (
+	// DriftInSync means the live resource matches its desired state.
+	DriftInSync DriftStatus = "IN_SYNC"
+	// DriftModified means the live resource differs from its desired state.
+	DriftModified DriftStatus = "DRIFTED"
+	// DriftMissing means the desired resource does not exist.
+	DriftMissing DriftStatus = "MISSING"
+	// DriftUnexpected means the live resource exists but is not in the desired set.
+	DriftUnexpected DriftStatus = "UNEXPECTED"
+	// DriftError means the resource could not be compared to its live state.
+	DriftError DriftStatus = "ERROR"
+)
+
+// defaultDriftParallelism is the number of concurrent reads made by ScanDrift
+// when DriftOptions.Parallelism is unset.
+const defaultDriftParallelism = 8
+
+// DriftOptions configures ScanDrift.
+type DriftOptions struct {
+	// Parallelism bounds the number of resources read concurrently.
+	Parallelism int
+	// DetectUnexpected lists the parent of every desired resource and reports
+	// live resources of the same type which are not in the desired set.
+	DetectUnexpected bool
+	// ApplyOptions are passed to every diff.
+	ApplyOptions []dcl.ApplyOption
+}
+
+// ResourceDrift is the result of comparing a single resource to its live state.
+type ResourceDrift struct {
+	STV ServiceTypeVersion
+	// ID is the resource's ID, or empty if it could not be computed.
+	ID     string
+	Status DriftStatus
+	// Diffs holds the field-level differences of a DRIFTED resource.
+	Diffs []*dcl.FieldDiff
+	// Err holds the cause of an ERROR result.
+	Err error
+}
+
+// DriftReport is the result of ScanDrift.
+type DriftReport struct {
+	Resources []*ResourceDrift
+}
+
+// HasDrift returns true if any resource in the report is not IN_SYNC.
+func (r *DriftReport) HasDrift() bool {
+	for _, rd := range r.Resources {
+		if rd.Status != DriftInSync {
+			return true
+		}
+	}
+	return false
+}
+
+// ScanDrift compares every resource in desired to its live state and reports
+// the field-level differences, the desired resources which do not exist, and,
+// if opts.DetectUnexpected is set, the live resources which are not desired.
+// Resources are read concurrently. ScanDrift only ever reads: it never issues
+// a mutating request. Errors reading individual resources are recorded in the
+// report; the returned error is only set if ctx is done.
+func ScanDrift(ctx context.Context, config *dcl.Config, desired []*Resource, opts DriftOptions) (*DriftReport, error) {
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = defaultDriftParallelism
+	}
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+
+	report := &DriftReport{Resources: make([]*ResourceDrift, len(desired))}
+	for i, r := range desired {
+		wg.Add(1)
+		go func(i int, r *Resource) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			report.Resources[i] = scanResource(ctx, config, r, desired, opts.ApplyOptions)
+		}(i, r)
+	}
+
+	var unexpected [][]*ResourceDrift
+	if opts.DetectUnexpected {
+		groups := listGroups(desired)
+		unexpected = make([][]*ResourceDrift, len(groups))
+		for i, g := range groups {
+			wg.Add(1)
+			go func(i int, g *listGroup) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				unexpected[i] = g.scan(ctx, config)
+			}(i, g)
+		}
+	}
+	wg.Wait()
+
+	for _, u := range unexpected {
+		report.Resources = append(report.Resources, u...)
+	}
+	return report, ctx.Err()
+}
+
+// scanResource compares r to its live state.
+func scanResource(ctx context.Context, config *dcl.Config, r *Resource, desired []*Resource, opts []dcl.ApplyOption) *ResourceDrift {
+	rd := &ResourceDrift{STV: r.STV}
+	resolved, err := ResolveReferences(ctx, config, r, desired)
+	if err != nil {
+		rd.Status, rd.Err = DriftError, err
+		return rd
+	}
+	if id, err := ID(resolved); err == nil {
+		rd.ID = id
+	}
+	diffs, err := FieldDiffs(ctx, config, resolved, opts...)
+	switch {
+	case dcl.IsNotFound(err):
+		rd.Status = DriftMissing
+	case err != nil:
+		rd.Status, rd.Err = DriftError, err
+	case len(diffs) > 0:
+		rd.Status, rd.Diffs = DriftModified, diffs
+	default:
+		rd.Status = DriftInSync
+	}
+	return rd
+}
+
+// listGroup is a set of desired resources of one type which share the same
+// list parameters, and so are all returned by a single List call.
+type listGroup struct {
+	parent *Resource
+	ids    map[string]bool
+}
+
+// listGroups partitions the listable resources in desired into listGroups.
+func listGroups(desired []*Resource) []*listGroup {
+	var groups []*listGroup
+	byKey := make(map[string]*listGroup)
+	for _, r := range desired {
+		rr := registration(r)
+		if rr == nil {
+			continue
+		}
+		s := rr.Schema()
+		if s == nil || s.Paths == nil || s.Paths.List == nil {
+			continue
+		}
+		key := []string{r.STV.String()}
+		for _, p := range s.Paths.List.Parameters {
+			v, _ := StringField(r, p.Name)
+			if _, ok := ParseReference(v); ok {
+				// The parent of this resource is not known until it exists.
+				key = nil
+				break
+			}
+			key = append(key, p.Name+"="+v)
+		}
+		if key == nil {
+			continue
+		}
+		g, ok := byKey[strings.Join(key, ",")]
+		if !ok {
+			g = &listGroup{parent: r, ids: make(map[string]bool)}
+			byKey[strings.Join(key, ",")] = g
+			groups = append(groups, g)
+		}
+		if id, err := ID(r); err == nil {
+			g.ids[id] = true
+		}
+	}
+	return groups
+}
+
+// scan lists the parent of the group and reports every live resource which is
+// not part of the group.
+func (g *listGroup) scan(ctx context.Context, config *dcl.Config) []*ResourceDrift {
+	live, err := List(ctx, config, g.parent)
+	if errors.Is(err, ErrNoSuchMethod) {
+		return nil
+	}
+	if err != nil {
+		return []*ResourceDrift{{STV: g.parent.STV, Status: DriftError, Err: fmt.Errorf("failed to list resources: %w", err)}}
+	}
+	var out []*ResourceDrift
+	for _, l := range live {
+		id, err := ID(l)
+		if err != nil || g.ids[id] {
+			continue
+		}
+		out = append(out, &ResourceDrift{STV: l.STV, ID: id, Status: DriftUnexpected})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// driftField is the serialized form of a dcl.FieldDiff.
+type driftField struct {
+	Field   string `json:"field"`
+	Desired string `json:"desired,omitempty"`
+	Actual  string `json:"actual,omitempty"`
+	Message string `json:"message"`
+}
+
+func newDriftField(d *dcl.FieldDiff) driftField {
+	f := driftField{Field: d.FieldName, Message: d.String()}
+	if d.Desired != nil {
+		f.Desired = dcl.SprintResourceCompact(d.Desired)
+	}
+	if d.Actual != nil {
+		f.Actual = dcl.SprintResourceCompact(d.Actual)
+	}
+	return f
+}
+
+// MarshalJSON serializes the drift of a single resource.
+func (rd *ResourceDrift) MarshalJSON() ([]byte, error) {
+	v := struct {
+		Service string       `json:"service"`
+		Type    string       `json:"type"`
+		Version string       `json:"version"`
+		ID      string       `json:"id,omitempty"`
+		Status  DriftStatus  `json:"status"`
+		Diffs   []driftField `json:"diffs,omitempty"`
+		Error   string       `json:"error,omitempty"`
+	}{
+		Service: rd.STV.Service,
+		Type:    rd.STV.Type,
+		Version: rd.STV.Version,
+		ID:      rd.ID,
+		Status:  rd.Status,
+	}
+	for _, d := range rd.Diffs {
+		v.Diffs = append(v.Diffs, newDriftField(d))
+	}
+	if rd.Err != nil {
+		v.Error = rd.Err.Error()
+	}
+	return json.Marshal(v)
+}
+
+// JSON serializes the report as indented JSON.
+func (r *DriftReport) JSON() ([]byte, error) {
+	return json.MarshalIndent(struct {
+		Resources []*ResourceDrift `json:"resources"`
+	}{r.Resources}, "", "  ")
+}
+
+// SARIF rule IDs, one per DriftStatus other than IN_SYNC.
+var driftRules = []struct {
+	status DriftStatus
+	id     string
+	level  string
+	help   string
+}{
+	{DriftModified, "dcl/drifted-field", "warning", "A field of the live resource differs from its desired state."},
+	{DriftMissing, "dcl/missing-resource", "error", "A desired resource does not exist."},
+	{DriftUnexpected, "dcl/unexpected-resource", "warning", "A live resource is not in the desired set."},
+	{DriftError, "dcl/scan-error", "error", "A resource could not be compared to its live state."},
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string                 `json:"ruleId"`
+	Level      string                 `json:"level"`
+	Message    sarifMessage           `json:"message"`
+	Locations  []sarifLocation        `json:"locations"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+// SARIF serializes the report as a SARIF 2.1.0 log with one result per
+// drifted field, missing resource, unexpected resource, or error. Resources
+// are identified by logical locations of the form SERVICE.TYPE.VERSION/ID.
+func (r *DriftReport) SARIF() ([]byte, error) {
+	var rules []map[string]interface{}
+	ruleFor := make(map[DriftStatus]int)
+	for i, dr := range driftRules {
+		ruleFor[dr.status] = i
+		rules = append(rules, map[string]interface{}{
+			"id":                   dr.id,
+			"shortDescription":     sarifMessage{Text: dr.help},
+			"defaultConfiguration": map[string]string{"level": dr.level},
+		})
+	}
+
+	results := []sarifResult{}
+	for _, rd := range r.Resources {
+		if rd.Status == DriftInSync {
+			continue
+		}
+		rule := driftRules[ruleFor[rd.Status]]
+		name := fmt.Sprintf("%s.%s.%s/%s", rd.STV.Service, rd.STV.Type, rd.STV.Version, rd.ID)
+		result := sarifResult{
+			RuleID:    rule.id,
+			Level:     rule.level,
+			Locations: []sarifLocation{{LogicalLocations: []sarifLogicalLocation{{FullyQualifiedName: name, Kind: "resource"}}}},
+		}
+		switch rd.Status {
+		case DriftModified:
+			for _, d := range rd.Diffs {
+				f := newDriftField(d)
+				fr := result
+				fr.Message = sarifMessage{Text: fmt.Sprintf("%s: %s", name, f.Message)}
+				fr.Properties = map[string]interface{}{"field": f.Field, "desired": f.Desired, "actual": f.Actual}
+				results = append(results, fr)
+			}
+			continue
+		case DriftMissing:
+			result.Message = sarifMessage{Text: fmt.Sprintf("%s does not exist", name)}
+		case DriftUnexpected:
+			result.Message = sarifMessage{Text: fmt.Sprintf("%s exists but is not desired", name)}
+		case DriftError:
+			result.Message = sarifMessage{Text: fmt.Sprintf("%s: %v", name, rd.Err)}
+		}
+		results = append(results, result)
+	}
+
+	return json.MarshalIndent(map[string]interface{}{
+		"version": "2.1.0",
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"runs": []interface{}{
+			map[string]interface{}{
+				"tool": map[string]interface{}{
+					"driver": map[string]interface{}{
+						"name":  "dcl-drift",
+						"rules": rules,
+					},
+				},
+				"results": results,
+			},
+		},
+	}, "", "  ")
+}
@@ -0,0 +1,426 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package unstructured
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+// ExportOptions describes the resources enumerated by Export.
+type ExportOptions struct {
+	// Parent is the container to export, in the form "projects/{{project}}",
+	// "folders/{{folder}}", or "organizations/{{organization}}". Exporting a
+	// folder or organization exports every project and folder beneath it, at
+	// any depth.
+	Parent string
+	// Types are the resource types to export.
+	Types []ServiceTypeVersion
+	// Locations are the values used for the location, region, and zone
+	// parameters of list calls.
+	Locations []string
+	// Params supplies the values of any other list parameters, keyed by
+	// parameter name, e.g. {"cluster": {"my-cluster"}}.
+	Params map[string][]string
+}
+
+// projectParentType and folderParentType are the resources listed to enumerate the projects
+// and folders in a folder or organization.
+var (
+	projectParentType = ServiceTypeVersion{Service: "cloudresourcemanager", Type: "Project", Version: "ga"}
+	folderParentType  = ServiceTypeVersion{Service: "cloudresourcemanager", Type: "Folder", Version: "ga"}
+)
+
+// Export lists every resource of the requested types beneath a parent and
+// returns them as resources which can be applied with no diff. See
+// ExportResources for the transformations applied to each listed resource.
+func Export(ctx context.Context, config *dcl.Config, opts ExportOptions) ([]*Resource, error) {
+	projects, folders, err := exportProjects(ctx, config, opts.Parent)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string][]string{
+		"project":  projects,
+		"location": opts.Locations,
+		"region":   opts.Locations,
+		"zone":     opts.Locations,
+		"parent":   append([]string{opts.Parent}, folders...),
+	}
+	for _, p := range projects {
+		if pp := "projects/" + p; pp != opts.Parent {
+			values["parent"] = append(values["parent"], pp)
+		}
+	}
+	for k, v := range opts.Params {
+		values[k] = v
+	}
+
+	var listed []*Resource
+	for _, stv := range opts.Types {
+		rr := registrationForSTV(stv)
+		if rr == nil {
+			return nil, fmt.Errorf("unknown resource type %s", stv.String())
+		}
+		s := rr.Schema()
+		if s == nil || s.Paths == nil || s.Paths.List == nil {
+			return nil, fmt.Errorf("%s: %w", stv.String(), ErrNoSuchMethod)
+		}
+		parents := []*Resource{{STV: stv, Object: map[string]interface{}{}}}
+		for _, param := range s.Paths.List.Parameters {
+			vs, ok := values[param.Name]
+			if !ok || len(vs) == 0 {
+				return nil, fmt.Errorf("%s: no values provided for list parameter %q", stv.String(), param.Name)
+			}
+			var next []*Resource
+			for _, p := range parents {
+				for _, v := range vs {
+					obj := make(map[string]interface{}, len(p.Object)+1)
+					for k, e := range p.Object {
+						obj[k] = e
+					}
+					obj[param.Name] = v
+					next = append(next, &Resource{STV: stv, Object: obj})
+				}
+			}
+			parents = next
+		}
+		for _, p := range parents {
+			rs, err := rr.List(ctx, config, p)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s with parameters %v: %w", stv.String(), p.Object, err)
+			}
+			listed = append(listed, rs...)
+		}
+	}
+	return ExportResources(ctx, config, listed)
+}
+
+// exportProjects returns the IDs of the projects to export for the given parent, and the
+// names of the folders beneath it. The projects of a folder or organization are those in
+// it and in every folder beneath it.
+func exportProjects(ctx context.Context, config *dcl.Config, parent string) ([]string, []string, error) {
+	if strings.HasPrefix(parent, "projects/") {
+		return []string{strings.TrimPrefix(parent, "projects/")}, nil, nil
+	}
+	if !strings.HasPrefix(parent, "folders/") && !strings.HasPrefix(parent, "organizations/") {
+		return nil, nil, fmt.Errorf("parent %q must be a project, folder, or organization", parent)
+	}
+	var projects, folders []string
+	for parents := []string{parent}; len(parents) > 0; parents = parents[1:] {
+		p := parents[0]
+		rs, err := List(ctx, config, &Resource{
+			STV:    projectParentType,
+			Object: map[string]interface{}{"parent": p},
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list projects in %s: %w", p, err)
+		}
+		for _, r := range rs {
+			if id, ok := r.Object["name"].(string); ok {
+				projects = append(projects, id)
+			}
+		}
+		rs, err = List(ctx, config, &Resource{
+			STV:    folderParentType,
+			Object: map[string]interface{}{"parent": p},
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list folders in %s: %w", p, err)
+		}
+		for _, r := range rs {
+			if name, ok := r.Object["name"].(string); ok {
+				folders = append(folders, "folders/"+name)
+				parents = append(parents, "folders/"+name)
+			}
+		}
+	}
+	return projects, folders, nil
+}
+
+// ExportResources converts live resources, as returned by Get or List, into
+// resources which can be applied with no diff. It
+//
+//   - rewrites references between the given resources as {{ref:...}} tokens,
+//     which ResolveReferences replaces at apply time,
+//   - rewrites project numbers to project IDs, and
+//   - removes output-only and server-defaulted fields, except for fields
+//     which identify the resource.
+//
+// The result is ordered so that every resource follows the resources it refers to.
+func ExportResources(ctx context.Context, config *dcl.Config, rs []*Resource) ([]*Resource, error) {
+	e := &exporter{
+		config:   config,
+		projects: make(map[string]string),
+	}
+	for _, r := range rs {
+		s, err := Schema(r)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.STV.String(), err)
+		}
+		id, err := ID(r)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.STV.String(), err)
+		}
+		e.resources = append(e.resources, &exportedResource{
+			live:   r,
+			schema: s,
+			id:     id,
+			out:    &Resource{STV: r.STV, Object: dcl.Copy(r.Object).(map[string]interface{})},
+		})
+	}
+
+	for _, er := range e.resources {
+		c, ok := er.component()
+		if !ok {
+			continue
+		}
+		e.rewriteReferences(er, &c.SchemaProperty, er.out.Object)
+		stripUnexported(&c.SchemaProperty, er.out.Object, er.identityFields(c))
+		e.rewriteProjectNumbers(er.out.Object)
+	}
+	return e.ordered(), nil
+}
+
+type exporter struct {
+	config    *dcl.Config
+	resources []*exportedResource
+	// projects caches the project IDs of project numbers.
+	projects map[string]string
+}
+
+type exportedResource struct {
+	live   *Resource
+	out    *Resource
+	schema *dcl.Schema
+	id     string
+	// deps are the resources this resource refers to.
+	deps []*exportedResource
+}
+
+func (er *exportedResource) component() (*dcl.Component, bool) {
+	if er.schema.Info == nil || er.schema.Components == nil {
+		return nil, false
+	}
+	c, ok := er.schema.Components.Schemas[er.schema.Info.StructName]
+	return c, ok
+}
+
+// idFieldRegex matches the fields named in a resource's x-dcl-id template.
+var idFieldRegex = regexp.MustCompile(`{{\s*(\w+)\s*}}`)
+
+// identityFields returns the top-level fields that must be kept because they identify the resource.
+func (er *exportedResource) identityFields(c *dcl.Component) map[string]bool {
+	fields := make(map[string]bool)
+	for _, f := range c.SchemaProperty.Required {
+		fields[f] = true
+	}
+	for _, m := range idFieldRegex.FindAllStringSubmatch(c.ID, -1) {
+		fields[m[1]] = true
+	}
+	return fields
+}
+
+// stripUnexported removes output-only and server-defaulted fields from obj,
+// keeping the named top-level fields. Objects left empty are removed as well.
+func stripUnexported(p *dcl.Property, obj map[string]interface{}, keep map[string]bool) {
+	for k, v := range obj {
+		sub, ok := p.Properties[k]
+		if !ok {
+			continue
+		}
+		if !keep[k] && (sub.ReadOnly || (sub.ServerDefault && isDefault(sub, v))) {
+			delete(obj, k)
+			continue
+		}
+		switch t := v.(type) {
+		case map[string]interface{}:
+			if sub.TypeEnum() != dcl.ObjectType {
+				continue
+			}
+			stripUnexported(sub, t, nil)
+			if len(t) == 0 && !keep[k] {
+				delete(obj, k)
+			}
+		case []interface{}:
+			if sub.Items == nil {
+				continue
+			}
+			for _, e := range t {
+				if m, ok := e.(map[string]interface{}); ok {
+					stripUnexported(sub.Items, m, nil)
+				}
+			}
+		}
+	}
+}
+
+// isDefault returns true if v may be left unset and defaulted by the server.
+// Server-defaulted fields which declare a default are only removed when they
+// hold that default, since leaving them unset would change the resource.
+func isDefault(p *dcl.Property, v interface{}) bool {
+	if p.Default == nil {
+		return true
+	}
+	return fmt.Sprint(p.Default) == fmt.Sprint(v)
+}
+
+// rewriteReferences replaces values of reference fields in obj which point
+// at another exported resource with a {{ref:...}} token.
+func (e *exporter) rewriteReferences(er *exportedResource, p *dcl.Property, obj map[string]interface{}) {
+	for k, v := range obj {
+		sub, ok := p.Properties[k]
+		if !ok {
+			continue
+		}
+		switch t := v.(type) {
+		case string:
+			if ref, target := e.findReference(sub, t); target != nil && target != er {
+				obj[k] = ref.String()
+				er.deps = append(er.deps, target)
+			}
+		case map[string]interface{}:
+			e.rewriteReferences(er, sub, t)
+		case []interface{}:
+			if sub.Items == nil {
+				continue
+			}
+			for i, item := range t {
+				switch it := item.(type) {
+				case string:
+					if ref, target := e.findReference(sub.Items, it); target != nil && target != er {
+						t[i] = ref.String()
+						er.deps = append(er.deps, target)
+					}
+				case map[string]interface{}:
+					e.rewriteReferences(er, sub.Items, it)
+				}
+			}
+		}
+	}
+}
+
+// findReference returns the exported resource referred to by the value v of a
+// field with schema p, if there is exactly one such resource.
+func (e *exporter) findReference(p *dcl.Property, v string) (*Reference, *exportedResource) {
+	for _, rr := range p.ResourceReferences {
+		if rr.Parent {
+			continue
+		}
+		var exact, partial []*exportedResource
+		for _, er := range e.resources {
+			if er.schema.Info == nil || !strings.EqualFold(er.schema.Info.Title, rr.Resource) {
+				continue
+			}
+			tv, ok := er.live.Object[rr.Field].(string)
+			if !ok || tv == "" {
+				continue
+			}
+			if tv == v {
+				exact = append(exact, er)
+			} else if dcl.PartialSelfLinkToSelfLink(&v, &tv) {
+				partial = append(partial, er)
+			}
+		}
+		matches := exact
+		if len(matches) == 0 {
+			matches = partial
+		}
+		if len(matches) == 1 {
+			return &Reference{STV: matches[0].live.STV, ID: matches[0].id, Field: rr.Field}, matches[0]
+		}
+	}
+	return nil, nil
+}
+
+// projectNumberRegex matches project numbers within a resource name or URL.
+var projectNumberRegex = regexp.MustCompile(`projects/(\d+)(/|$)`)
+
+// rewriteProjectNumbers replaces project numbers in v with project IDs.
+func (e *exporter) rewriteProjectNumbers(obj map[string]interface{}) {
+	for k, v := range obj {
+		obj[k] = e.rewriteProjectNumbersValue(k, v)
+	}
+}
+
+func (e *exporter) rewriteProjectNumbersValue(field string, v interface{}) interface{} {
+	switch t := v.(type) {
+	case string:
+		if field == "project" && isProjectNumber(t) {
+			return e.projectID(t)
+		}
+		return projectNumberRegex.ReplaceAllStringFunc(t, func(m string) string {
+			sub := projectNumberRegex.FindStringSubmatch(m)
+			return "projects/" + e.projectID(sub[1]) + sub[2]
+		})
+	case map[string]interface{}:
+		e.rewriteProjectNumbers(t)
+	case []interface{}:
+		for i, item := range t {
+			t[i] = e.rewriteProjectNumbersValue("", item)
+		}
+	}
+	return v
+}
+
+func isProjectNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// projectID returns the ID of the given project number, or the number if it cannot be found.
+func (e *exporter) projectID(number string) string {
+	if id, ok := e.projects[number]; ok {
+		return id
+	}
+	id := number
+	if p, err := dcl.FetchProjectInfo(e.config, number); err != nil {
+		e.config.Logger.Warningf("Failed to convert project number %s to an ID: %v", number, err)
+	} else if p.ProjectID != "" {
+		id = p.ProjectID
+	}
+	e.projects[number] = id
+	return id
+}
+
+// ordered returns the exported resources, each following the resources it refers to.
+func (e *exporter) ordered() []*Resource {
+	var out []*Resource
+	visited := make(map[*exportedResource]bool)
+	var visit func(er *exportedResource)
+	visit = func(er *exportedResource) {
+		if visited[er] {
+			return
+		}
+		visited[er] = true
+		for _, d := range er.deps {
+			visit(d)
+		}
+		out = append(out, er.out)
+	}
+	for _, er := range e.resources {
+		visit(er)
+	}
+	return out
+}
@@ -0,0 +1,209 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package unstructured
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeRegistration is a registered resource type whose List returns fixed resources.
+// Its other methods are not implemented.
+type fakeRegistration struct {
+	RegisteredResource
+	stv    ServiceTypeVersion
+	schema *dcl.Schema
+	// items are the resources of the type, keyed by the parent they are listed in.
+	items map[string][]map[string]interface{}
+}
+
+func (f *fakeRegistration) STV() ServiceTypeVersion {
+	return f.stv
+}
+
+func (f *fakeRegistration) Schema() *dcl.Schema {
+	return f.schema
+}
+
+func (f *fakeRegistration) ID(r *Resource) (string, error) {
+	return fmt.Sprintf("projects/%v/%s/%v", r.Object["project"], strings.ToLower(f.stv.Type), r.Object["name"]), nil
+}
+
+func (f *fakeRegistration) List(_ context.Context, _ *dcl.Config, r *Resource) ([]*Resource, error) {
+	parent, err := StringField(r, "parent")
+	if err != nil {
+		return nil, err
+	}
+	var rs []*Resource
+	for _, obj := range f.items[parent] {
+		rs = append(rs, &Resource{STV: f.stv, Object: obj})
+	}
+	return rs, nil
+}
+
+// The resource hierarchy exported by the tests: organizations/1 contains project p1 and
+// folders/10, which contains project p2 and folders/11, which contains project p3.
+func init() {
+	Register(&fakeRegistration{
+		stv: projectParentType,
+		items: map[string][]map[string]interface{}{
+			"organizations/1": {{"name": "p1"}},
+			"folders/10":      {{"name": "p2"}},
+			"folders/11":      {{"name": "p3"}},
+		},
+	})
+	Register(&fakeRegistration{
+		stv: folderParentType,
+		items: map[string][]map[string]interface{}{
+			"organizations/1": {{"name": "10"}},
+			"folders/10":      {{"name": "11"}},
+		},
+	})
+	Register(&fakeRegistration{stv: testNetworkType, schema: testSchema("Network", map[string]*dcl.Property{
+		"name":     {Type: "string"},
+		"project":  {Type: "string"},
+		"mtu":      {Type: "integer", Format: "int64"},
+		"selfLink": {Type: "string", ReadOnly: true},
+	})})
+	Register(&fakeRegistration{stv: testSubnetworkType, schema: testSchema("Subnetwork", map[string]*dcl.Property{
+		"name":    {Type: "string"},
+		"project": {Type: "string"},
+		"network": {
+			Type:               "string",
+			ResourceReferences: []*dcl.PropertyResourceReference{{Resource: "Test/Network", Field: "selfLink"}},
+		},
+		"ipCidrRange":    {Type: "string"},
+		"gatewayAddress": {Type: "string", ReadOnly: true},
+	})})
+}
+
+var (
+	testNetworkType    = ServiceTypeVersion{Service: "test", Type: "Network", Version: "ga"}
+	testSubnetworkType = ServiceTypeVersion{Service: "test", Type: "Subnetwork", Version: "ga"}
+)
+
+func testSchema(name string, properties map[string]*dcl.Property) *dcl.Schema {
+	return &dcl.Schema{
+		Info: &dcl.Info{Title: "Test/" + name, StructName: name},
+		Components: &dcl.Components{Schemas: map[string]*dcl.Component{
+			name: {
+				ID:             "projects/{{project}}/" + strings.ToLower(name) + "/{{name}}",
+				SchemaProperty: dcl.Property{Type: "object", Required: []string{"name", "project"}, Properties: properties},
+			},
+		}},
+	}
+}
+
+func TestExportProjects(t *testing.T) {
+	tests := []struct {
+		name         string
+		parent       string
+		wantProjects []string
+		wantFolders  []string
+		wantErr      bool
+	}{
+		{
+			name:         "project",
+			parent:       "projects/p0",
+			wantProjects: []string{"p0"},
+		},
+		{
+			name:         "organization",
+			parent:       "organizations/1",
+			wantProjects: []string{"p1", "p2", "p3"},
+			wantFolders:  []string{"folders/10", "folders/11"},
+		},
+		{
+			name:         "folder",
+			parent:       "folders/10",
+			wantProjects: []string{"p2", "p3"},
+			wantFolders:  []string{"folders/11"},
+		},
+		{
+			name:    "billing account",
+			parent:  "billingAccounts/1",
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			projects, folders, err := exportProjects(context.Background(), dcl.NewConfig(), tc.parent)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("exportProjects(%q) error = %v, want error: %v", tc.parent, err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.wantProjects, projects); diff != "" {
+				t.Errorf("exportProjects(%q) projects diff (-want +got):\n%s", tc.parent, diff)
+			}
+			if diff := cmp.Diff(tc.wantFolders, folders); diff != "" {
+				t.Errorf("exportProjects(%q) folders diff (-want +got):\n%s", tc.parent, diff)
+			}
+		})
+	}
+}
+
+func TestExportManifestRoundTrip(t *testing.T) {
+	live := []*Resource{
+		{STV: testSubnetworkType, Object: map[string]interface{}{
+			"name":           "subnet",
+			"project":        "p1",
+			"network":        "https://www.googleapis.com/compute/v1/projects/p1/global/networks/net",
+			"ipCidrRange":    "10.0.0.0/24",
+			"gatewayAddress": "10.0.0.1",
+		}},
+		{STV: testNetworkType, Object: map[string]interface{}{
+			"name":     "net",
+			"project":  "p1",
+			"mtu":      int64(1460),
+			"selfLink": "https://www.googleapis.com/compute/v1/projects/p1/global/networks/net",
+		}},
+	}
+	want := []*Resource{
+		{STV: testNetworkType, Object: map[string]interface{}{
+			"name":    "net",
+			"project": "p1",
+			"mtu":     int64(1460),
+		}},
+		{STV: testSubnetworkType, Object: map[string]interface{}{
+			"name":        "subnet",
+			"project":     "p1",
+			"network":     "{{ref:test.Network.ga:projects/p1/network/net#selfLink}}",
+			"ipCidrRange": "10.0.0.0/24",
+		}},
+	}
+	exported, err := ExportResources(context.Background(), dcl.NewConfig(), live)
+	if err != nil {
+		t.Fatalf("ExportResources() returned error: %v", err)
+	}
+	if diff := cmp.Diff(want, exported); diff != "" {
+		t.Errorf("ExportResources() diff (-want +got):\n%s", diff)
+	}
+	for _, r := range exported {
+		b, err := MarshalManifest(r)
+		if err != nil {
+			t.Fatalf("MarshalManifest() returned error: %v", err)
+		}
+		got, err := ParseManifest(b)
+		if err != nil {
+			t.Fatalf("ParseManifest() returned error: %v", err)
+		}
+		if diff := cmp.Diff(r, got); diff != "" {
+			t.Errorf("manifest round trip diff (-want +got):\n%s", diff)
+		}
+	}
+}
@@ -367,6 +367,22 @@ func OrganizationHasDiff(ctx context.Context, config *dcl.Config, u *unstructure
 	return false, nil
 }
 
+func OrganizationFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToOrganization(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToOrganization(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffOrganization(ctx, r, opts...)
+}
+
 func DeleteOrganization(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToOrganization(u)
@@ -396,7 +412,7 @@ func (r *Organization) SetPolicyMember(ctx context.Context, config *dcl.Config,
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Organization) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Organization) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -428,6 +444,10 @@ func (r *Organization) HasDiff(ctx context.Context, config *dcl.Config, resource
 	return OrganizationHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Organization) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return OrganizationFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Organization) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteOrganization(ctx, config, resource)
 }
@@ -436,6 +456,14 @@ func (r *Organization) ID(resource *unstructured.Resource) (string, error) {
 	return OrganizationID(resource)
 }
 
+func (r *Organization) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return ListOrganization(ctx, config)
+}
+
+func (r *Organization) Schema() *dcl.Schema {
+	return dclService.DCLOrganizationSchema()
+}
+
 func init() {
 	unstructured.Register(&Organization{})
 }
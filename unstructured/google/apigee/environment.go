@@ -210,6 +210,22 @@ func EnvironmentHasDiff(ctx context.Context, config *dcl.Config, u *unstructured
 	return false, nil
 }
 
+func EnvironmentFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToEnvironment(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToEnvironment(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffEnvironment(ctx, r, opts...)
+}
+
 func DeleteEnvironment(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToEnvironment(u)
@@ -302,13 +318,17 @@ func SetPolicyMemberEnvironment(ctx context.Context, config *dcl.Config, u *unst
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberEnvironment(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberEnvironment(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToEnvironment(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -336,8 +356,8 @@ func (r *Environment) SetPolicyMember(ctx context.Context, config *dcl.Config, r
 	return SetPolicyMemberEnvironment(ctx, config, resource, member)
 }
 
-func (r *Environment) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberEnvironment(ctx, config, resource, role, member)
+func (r *Environment) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberEnvironment(ctx, config, resource, member)
 }
 
 func (r *Environment) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -368,6 +388,10 @@ func (r *Environment) HasDiff(ctx context.Context, config *dcl.Config, resource
 	return EnvironmentHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Environment) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return EnvironmentFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Environment) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteEnvironment(ctx, config, resource)
 }
@@ -376,6 +400,18 @@ func (r *Environment) ID(resource *unstructured.Resource) (string, error) {
 	return EnvironmentID(resource)
 }
 
+func (r *Environment) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	apigeeOrganization, err := unstructured.StringField(resource, "apigeeOrganization")
+	if err != nil {
+		return nil, err
+	}
+	return ListEnvironment(ctx, config, apigeeOrganization)
+}
+
+func (r *Environment) Schema() *dcl.Schema {
+	return dclService.DCLEnvironmentSchema()
+}
+
 func init() {
 	unstructured.Register(&Environment{})
 }
@@ -353,6 +353,22 @@ func KeyHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resourc
 	return false, nil
 }
 
+func KeyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToKey(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToKey(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffKey(ctx, r, opts...)
+}
+
 func DeleteKey(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToKey(u)
@@ -382,7 +398,7 @@ func (r *Key) SetPolicyMember(ctx context.Context, config *dcl.Config, resource
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Key) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Key) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -414,6 +430,10 @@ func (r *Key) HasDiff(ctx context.Context, config *dcl.Config, resource *unstruc
 	return KeyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Key) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return KeyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Key) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteKey(ctx, config, resource)
 }
@@ -422,6 +442,18 @@ func (r *Key) ID(resource *unstructured.Resource) (string, error) {
 	return KeyID(resource)
 }
 
+func (r *Key) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListKey(ctx, config, project)
+}
+
+func (r *Key) Schema() *dcl.Schema {
+	return dclService.DCLKeySchema()
+}
+
 func init() {
 	unstructured.Register(&Key{})
 }
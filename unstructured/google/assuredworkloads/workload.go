@@ -325,6 +325,22 @@ func WorkloadHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Re
 	return false, nil
 }
 
+func WorkloadFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToWorkload(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToWorkload(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffWorkload(ctx, r, opts...)
+}
+
 func DeleteWorkload(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToWorkload(u)
@@ -354,7 +370,7 @@ func (r *Workload) SetPolicyMember(ctx context.Context, config *dcl.Config, reso
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Workload) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Workload) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -386,6 +402,10 @@ func (r *Workload) HasDiff(ctx context.Context, config *dcl.Config, resource *un
 	return WorkloadHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Workload) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return WorkloadFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Workload) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteWorkload(ctx, config, resource)
 }
@@ -394,6 +414,22 @@ func (r *Workload) ID(resource *unstructured.Resource) (string, error) {
 	return WorkloadID(resource)
 }
 
+func (r *Workload) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	organization, err := unstructured.StringField(resource, "organization")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListWorkload(ctx, config, organization, location)
+}
+
+func (r *Workload) Schema() *dcl.Schema {
+	return dclService.DCLWorkloadSchema()
+}
+
 func init() {
 	unstructured.Register(&Workload{})
 }
@@ -449,6 +449,22 @@ func DatasetHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func DatasetFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDataset(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToDataset(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffDataset(ctx, r, opts...)
+}
+
 func DeleteDataset(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToDataset(u)
@@ -478,7 +494,7 @@ func (r *Dataset) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Dataset) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Dataset) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -510,6 +526,10 @@ func (r *Dataset) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return DatasetHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Dataset) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return DatasetFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Dataset) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteDataset(ctx, config, resource)
 }
@@ -518,6 +538,18 @@ func (r *Dataset) ID(resource *unstructured.Resource) (string, error) {
 	return DatasetID(resource)
 }
 
+func (r *Dataset) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListDataset(ctx, config, project)
+}
+
+func (r *Dataset) Schema() *dcl.Schema {
+	return dclService.DCLDatasetSchema()
+}
+
 func init() {
 	unstructured.Register(&Dataset{})
 }
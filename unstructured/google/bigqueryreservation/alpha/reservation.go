@@ -198,6 +198,22 @@ func ReservationHasDiff(ctx context.Context, config *dcl.Config, u *unstructured
 	return false, nil
 }
 
+func ReservationFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToReservation(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToReservation(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffReservation(ctx, r, opts...)
+}
+
 func DeleteReservation(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToReservation(u)
@@ -227,7 +243,7 @@ func (r *Reservation) SetPolicyMember(ctx context.Context, config *dcl.Config, r
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Reservation) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Reservation) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -259,6 +275,10 @@ func (r *Reservation) HasDiff(ctx context.Context, config *dcl.Config, resource
 	return ReservationHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Reservation) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ReservationFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Reservation) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteReservation(ctx, config, resource)
 }
@@ -267,6 +287,22 @@ func (r *Reservation) ID(resource *unstructured.Resource) (string, error) {
 	return ReservationID(resource)
 }
 
+func (r *Reservation) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListReservation(ctx, config, project, location)
+}
+
+func (r *Reservation) Schema() *dcl.Schema {
+	return dclService.DCLReservationSchema()
+}
+
 func init() {
 	unstructured.Register(&Reservation{})
 }
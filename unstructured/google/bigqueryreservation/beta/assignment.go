@@ -188,6 +188,22 @@ func AssignmentHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.
 	return false, nil
 }
 
+func AssignmentFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToAssignment(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToAssignment(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffAssignment(ctx, r, opts...)
+}
+
 func DeleteAssignment(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToAssignment(u)
@@ -217,7 +233,7 @@ func (r *Assignment) SetPolicyMember(ctx context.Context, config *dcl.Config, re
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Assignment) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Assignment) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -249,6 +265,10 @@ func (r *Assignment) HasDiff(ctx context.Context, config *dcl.Config, resource *
 	return AssignmentHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Assignment) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return AssignmentFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Assignment) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteAssignment(ctx, config, resource)
 }
@@ -257,6 +277,26 @@ func (r *Assignment) ID(resource *unstructured.Resource) (string, error) {
 	return AssignmentID(resource)
 }
 
+func (r *Assignment) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	reservation, err := unstructured.StringField(resource, "reservation")
+	if err != nil {
+		return nil, err
+	}
+	return ListAssignment(ctx, config, project, location, reservation)
+}
+
+func (r *Assignment) Schema() *dcl.Schema {
+	return dclService.DCLAssignmentSchema()
+}
+
 func init() {
 	unstructured.Register(&Assignment{})
 }
@@ -544,6 +544,22 @@ func BudgetHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func BudgetFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToBudget(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToBudget(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffBudget(ctx, r, opts...)
+}
+
 func DeleteBudget(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToBudget(u)
@@ -573,7 +589,7 @@ func (r *Budget) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Budget) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Budget) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -605,6 +621,10 @@ func (r *Budget) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return BudgetHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Budget) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return BudgetFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Budget) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteBudget(ctx, config, resource)
 }
@@ -613,6 +633,18 @@ func (r *Budget) ID(resource *unstructured.Resource) (string, error) {
 	return BudgetID(resource)
 }
 
+func (r *Budget) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	billingAccount, err := unstructured.StringField(resource, "billingAccount")
+	if err != nil {
+		return nil, err
+	}
+	return ListBudget(ctx, config, billingAccount)
+}
+
+func (r *Budget) Schema() *dcl.Schema {
+	return dclService.DCLBudgetSchema()
+}
+
 func init() {
 	unstructured.Register(&Budget{})
 }
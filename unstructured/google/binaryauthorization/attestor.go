@@ -268,6 +268,22 @@ func AttestorHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Re
 	return false, nil
 }
 
+func AttestorFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToAttestor(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToAttestor(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffAttestor(ctx, r, opts...)
+}
+
 func DeleteAttestor(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToAttestor(u)
@@ -297,7 +313,7 @@ func (r *Attestor) SetPolicyMember(ctx context.Context, config *dcl.Config, reso
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Attestor) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Attestor) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -329,6 +345,10 @@ func (r *Attestor) HasDiff(ctx context.Context, config *dcl.Config, resource *un
 	return AttestorHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Attestor) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return AttestorFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Attestor) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteAttestor(ctx, config, resource)
 }
@@ -337,6 +357,18 @@ func (r *Attestor) ID(resource *unstructured.Resource) (string, error) {
 	return AttestorID(resource)
 }
 
+func (r *Attestor) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListAttestor(ctx, config, project)
+}
+
+func (r *Attestor) Schema() *dcl.Schema {
+	return dclService.DCLAttestorSchema()
+}
+
 func init() {
 	unstructured.Register(&Attestor{})
 }
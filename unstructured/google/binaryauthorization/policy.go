@@ -464,6 +464,22 @@ func PolicyHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func PolicyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToPolicy(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToPolicy(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffPolicy(ctx, r, opts...)
+}
+
 func DeletePolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	return unstructured.ErrNoSuchMethod
 }
@@ -551,13 +567,17 @@ func SetPolicyMemberPolicy(ctx context.Context, config *dcl.Config, u *unstructu
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToPolicy(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -585,8 +605,8 @@ func (r *Policy) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return SetPolicyMemberPolicy(ctx, config, resource, member)
 }
 
-func (r *Policy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberPolicy(ctx, config, resource, role, member)
+func (r *Policy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberPolicy(ctx, config, resource, member)
 }
 
 func (r *Policy) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -617,6 +637,10 @@ func (r *Policy) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return PolicyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Policy) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return PolicyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Policy) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeletePolicy(ctx, config, resource)
 }
@@ -625,6 +649,14 @@ func (r *Policy) ID(resource *unstructured.Resource) (string, error) {
 	return PolicyID(resource)
 }
 
+func (r *Policy) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Policy) Schema() *dcl.Schema {
+	return dclService.DCLPolicySchema()
+}
+
 func init() {
 	unstructured.Register(&Policy{})
 }
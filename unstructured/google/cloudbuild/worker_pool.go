@@ -300,6 +300,22 @@ func WorkerPoolHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.
 	return false, nil
 }
 
+func WorkerPoolFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToWorkerPool(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToWorkerPool(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffWorkerPool(ctx, r, opts...)
+}
+
 func DeleteWorkerPool(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToWorkerPool(u)
@@ -329,7 +345,7 @@ func (r *WorkerPool) SetPolicyMember(ctx context.Context, config *dcl.Config, re
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *WorkerPool) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *WorkerPool) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -361,6 +377,10 @@ func (r *WorkerPool) HasDiff(ctx context.Context, config *dcl.Config, resource *
 	return WorkerPoolHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *WorkerPool) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return WorkerPoolFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *WorkerPool) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteWorkerPool(ctx, config, resource)
 }
@@ -369,6 +389,22 @@ func (r *WorkerPool) ID(resource *unstructured.Resource) (string, error) {
 	return WorkerPoolID(resource)
 }
 
+func (r *WorkerPool) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListWorkerPool(ctx, config, project, location)
+}
+
+func (r *WorkerPool) Schema() *dcl.Schema {
+	return dclService.DCLWorkerPoolSchema()
+}
+
 func init() {
 	unstructured.Register(&WorkerPool{})
 }
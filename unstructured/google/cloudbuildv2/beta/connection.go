@@ -413,6 +413,22 @@ func ConnectionHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.
 	return false, nil
 }
 
+func ConnectionFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToConnection(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToConnection(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffConnection(ctx, r, opts...)
+}
+
 func DeleteConnection(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToConnection(u)
@@ -442,7 +458,7 @@ func (r *Connection) SetPolicyMember(ctx context.Context, config *dcl.Config, re
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Connection) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Connection) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -474,6 +490,10 @@ func (r *Connection) HasDiff(ctx context.Context, config *dcl.Config, resource *
 	return ConnectionHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Connection) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ConnectionFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Connection) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteConnection(ctx, config, resource)
 }
@@ -482,6 +502,22 @@ func (r *Connection) ID(resource *unstructured.Resource) (string, error) {
 	return ConnectionID(resource)
 }
 
+func (r *Connection) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListConnection(ctx, config, project, location)
+}
+
+func (r *Connection) Schema() *dcl.Schema {
+	return dclService.DCLConnectionSchema()
+}
+
 func init() {
 	unstructured.Register(&Connection{})
 }
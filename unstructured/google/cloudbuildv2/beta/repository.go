@@ -218,6 +218,22 @@ func RepositoryHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.
 	return false, nil
 }
 
+func RepositoryFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToRepository(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToRepository(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffRepository(ctx, r, opts...)
+}
+
 func DeleteRepository(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToRepository(u)
@@ -247,7 +263,7 @@ func (r *Repository) SetPolicyMember(ctx context.Context, config *dcl.Config, re
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Repository) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Repository) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -279,6 +295,10 @@ func (r *Repository) HasDiff(ctx context.Context, config *dcl.Config, resource *
 	return RepositoryHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Repository) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return RepositoryFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Repository) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteRepository(ctx, config, resource)
 }
@@ -287,6 +307,26 @@ func (r *Repository) ID(resource *unstructured.Resource) (string, error) {
 	return RepositoryID(resource)
 }
 
+func (r *Repository) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	connection, err := unstructured.StringField(resource, "connection")
+	if err != nil {
+		return nil, err
+	}
+	return ListRepository(ctx, config, project, location, connection)
+}
+
+func (r *Repository) Schema() *dcl.Schema {
+	return dclService.DCLRepositorySchema()
+}
+
 func init() {
 	unstructured.Register(&Repository{})
 }
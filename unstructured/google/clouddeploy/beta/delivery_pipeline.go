@@ -424,6 +424,22 @@ func DeliveryPipelineHasDiff(ctx context.Context, config *dcl.Config, u *unstruc
 	return false, nil
 }
 
+func DeliveryPipelineFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDeliveryPipeline(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToDeliveryPipeline(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffDeliveryPipeline(ctx, r, opts...)
+}
+
 func DeleteDeliveryPipeline(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToDeliveryPipeline(u)
@@ -453,7 +469,7 @@ func (r *DeliveryPipeline) SetPolicyMember(ctx context.Context, config *dcl.Conf
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *DeliveryPipeline) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *DeliveryPipeline) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -485,6 +501,10 @@ func (r *DeliveryPipeline) HasDiff(ctx context.Context, config *dcl.Config, reso
 	return DeliveryPipelineHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *DeliveryPipeline) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return DeliveryPipelineFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *DeliveryPipeline) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteDeliveryPipeline(ctx, config, resource)
 }
@@ -493,6 +513,22 @@ func (r *DeliveryPipeline) ID(resource *unstructured.Resource) (string, error) {
 	return DeliveryPipelineID(resource)
 }
 
+func (r *DeliveryPipeline) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListDeliveryPipeline(ctx, config, project, location)
+}
+
+func (r *DeliveryPipeline) Schema() *dcl.Schema {
+	return dclService.DCLDeliveryPipelineSchema()
+}
+
 func init() {
 	unstructured.Register(&DeliveryPipeline{})
 }
@@ -405,6 +405,22 @@ func TargetHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func TargetFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToTarget(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToTarget(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffTarget(ctx, r, opts...)
+}
+
 func DeleteTarget(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToTarget(u)
@@ -434,7 +450,7 @@ func (r *Target) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Target) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Target) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -466,6 +482,10 @@ func (r *Target) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return TargetHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Target) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return TargetFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Target) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteTarget(ctx, config, resource)
 }
@@ -474,6 +494,22 @@ func (r *Target) ID(resource *unstructured.Resource) (string, error) {
 	return TargetID(resource)
 }
 
+func (r *Target) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListTarget(ctx, config, project, location)
+}
+
+func (r *Target) Schema() *dcl.Schema {
+	return dclService.DCLTargetSchema()
+}
+
 func init() {
 	unstructured.Register(&Target{})
 }
@@ -443,6 +443,22 @@ func FunctionHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Re
 	return false, nil
 }
 
+func FunctionFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToFunction(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToFunction(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffFunction(ctx, r, opts...)
+}
+
 func DeleteFunction(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToFunction(u)
@@ -535,13 +551,17 @@ func SetPolicyMemberFunction(ctx context.Context, config *dcl.Config, u *unstruc
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberFunction(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberFunction(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToFunction(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -569,8 +589,8 @@ func (r *Function) SetPolicyMember(ctx context.Context, config *dcl.Config, reso
 	return SetPolicyMemberFunction(ctx, config, resource, member)
 }
 
-func (r *Function) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberFunction(ctx, config, resource, role, member)
+func (r *Function) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberFunction(ctx, config, resource, member)
 }
 
 func (r *Function) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -601,6 +621,10 @@ func (r *Function) HasDiff(ctx context.Context, config *dcl.Config, resource *un
 	return FunctionHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Function) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return FunctionFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Function) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteFunction(ctx, config, resource)
 }
@@ -609,6 +633,22 @@ func (r *Function) ID(resource *unstructured.Resource) (string, error) {
 	return FunctionID(resource)
 }
 
+func (r *Function) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	region, err := unstructured.StringField(resource, "region")
+	if err != nil {
+		return nil, err
+	}
+	return ListFunction(ctx, config, project, region)
+}
+
+func (r *Function) Schema() *dcl.Schema {
+	return dclService.DCLFunctionSchema()
+}
+
 func init() {
 	unstructured.Register(&Function{})
 }
@@ -436,6 +436,22 @@ func GroupHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resou
 	return false, nil
 }
 
+func GroupFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToGroup(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToGroup(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffGroup(ctx, r, opts...)
+}
+
 func DeleteGroup(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToGroup(u)
@@ -465,7 +481,7 @@ func (r *Group) SetPolicyMember(ctx context.Context, config *dcl.Config, resourc
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Group) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Group) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -497,6 +513,10 @@ func (r *Group) HasDiff(ctx context.Context, config *dcl.Config, resource *unstr
 	return GroupHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Group) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return GroupFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Group) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteGroup(ctx, config, resource)
 }
@@ -505,6 +525,18 @@ func (r *Group) ID(resource *unstructured.Resource) (string, error) {
 	return GroupID(resource)
 }
 
+func (r *Group) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListGroup(ctx, config, parent)
+}
+
+func (r *Group) Schema() *dcl.Schema {
+	return dclService.DCLGroupSchema()
+}
+
 func init() {
 	unstructured.Register(&Group{})
 }
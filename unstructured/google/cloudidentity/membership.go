@@ -331,6 +331,22 @@ func MembershipHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.
 	return false, nil
 }
 
+func MembershipFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToMembership(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToMembership(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffMembership(ctx, r, opts...)
+}
+
 func DeleteMembership(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToMembership(u)
@@ -360,7 +376,7 @@ func (r *Membership) SetPolicyMember(ctx context.Context, config *dcl.Config, re
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Membership) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Membership) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -392,6 +408,10 @@ func (r *Membership) HasDiff(ctx context.Context, config *dcl.Config, resource *
 	return MembershipHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Membership) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return MembershipFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Membership) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteMembership(ctx, config, resource)
 }
@@ -400,6 +420,18 @@ func (r *Membership) ID(resource *unstructured.Resource) (string, error) {
 	return MembershipID(resource)
 }
 
+func (r *Membership) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	group, err := unstructured.StringField(resource, "group")
+	if err != nil {
+		return nil, err
+	}
+	return ListMembership(ctx, config, group)
+}
+
+func (r *Membership) Schema() *dcl.Schema {
+	return dclService.DCLMembershipSchema()
+}
+
 func init() {
 	unstructured.Register(&Membership{})
 }
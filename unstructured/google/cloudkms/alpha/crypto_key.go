@@ -513,6 +513,22 @@ func CryptoKeyHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.R
 	return false, nil
 }
 
+func CryptoKeyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToCryptoKey(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToCryptoKey(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffCryptoKey(ctx, r, opts...)
+}
+
 func DeleteCryptoKey(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	return unstructured.ErrNoSuchMethod
 }
@@ -600,13 +616,17 @@ func SetPolicyMemberCryptoKey(ctx context.Context, config *dcl.Config, u *unstru
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberCryptoKey(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberCryptoKey(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToCryptoKey(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -634,8 +654,8 @@ func (r *CryptoKey) SetPolicyMember(ctx context.Context, config *dcl.Config, res
 	return SetPolicyMemberCryptoKey(ctx, config, resource, member)
 }
 
-func (r *CryptoKey) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberCryptoKey(ctx, config, resource, role, member)
+func (r *CryptoKey) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberCryptoKey(ctx, config, resource, member)
 }
 
 func (r *CryptoKey) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -666,6 +686,10 @@ func (r *CryptoKey) HasDiff(ctx context.Context, config *dcl.Config, resource *u
 	return CryptoKeyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *CryptoKey) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return CryptoKeyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *CryptoKey) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteCryptoKey(ctx, config, resource)
 }
@@ -674,6 +698,26 @@ func (r *CryptoKey) ID(resource *unstructured.Resource) (string, error) {
 	return CryptoKeyID(resource)
 }
 
+func (r *CryptoKey) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	keyRing, err := unstructured.StringField(resource, "keyRing")
+	if err != nil {
+		return nil, err
+	}
+	return ListCryptoKey(ctx, config, project, location, keyRing)
+}
+
+func (r *CryptoKey) Schema() *dcl.Schema {
+	return dclService.DCLCryptoKeySchema()
+}
+
 func init() {
 	unstructured.Register(&CryptoKey{})
 }
@@ -332,6 +332,22 @@ func EkmConnectionHasDiff(ctx context.Context, config *dcl.Config, u *unstructur
 	return false, nil
 }
 
+func EkmConnectionFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToEkmConnection(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToEkmConnection(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffEkmConnection(ctx, r, opts...)
+}
+
 func DeleteEkmConnection(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	return unstructured.ErrNoSuchMethod
 }
@@ -419,13 +435,17 @@ func SetPolicyMemberEkmConnection(ctx context.Context, config *dcl.Config, u *un
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberEkmConnection(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberEkmConnection(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToEkmConnection(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -453,8 +473,8 @@ func (r *EkmConnection) SetPolicyMember(ctx context.Context, config *dcl.Config,
 	return SetPolicyMemberEkmConnection(ctx, config, resource, member)
 }
 
-func (r *EkmConnection) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberEkmConnection(ctx, config, resource, role, member)
+func (r *EkmConnection) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberEkmConnection(ctx, config, resource, member)
 }
 
 func (r *EkmConnection) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -485,6 +505,10 @@ func (r *EkmConnection) HasDiff(ctx context.Context, config *dcl.Config, resourc
 	return EkmConnectionHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *EkmConnection) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return EkmConnectionFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *EkmConnection) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteEkmConnection(ctx, config, resource)
 }
@@ -493,6 +517,22 @@ func (r *EkmConnection) ID(resource *unstructured.Resource) (string, error) {
 	return EkmConnectionID(resource)
 }
 
+func (r *EkmConnection) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListEkmConnection(ctx, config, project, location)
+}
+
+func (r *EkmConnection) Schema() *dcl.Schema {
+	return dclService.DCLEkmConnectionSchema()
+}
+
 func init() {
 	unstructured.Register(&EkmConnection{})
 }
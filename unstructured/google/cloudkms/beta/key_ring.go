@@ -158,6 +158,22 @@ func KeyRingHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func KeyRingFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToKeyRing(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToKeyRing(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffKeyRing(ctx, r, opts...)
+}
+
 func DeleteKeyRing(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	return unstructured.ErrNoSuchMethod
 }
@@ -182,7 +198,7 @@ func (r *KeyRing) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *KeyRing) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *KeyRing) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -214,6 +230,10 @@ func (r *KeyRing) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return KeyRingHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *KeyRing) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return KeyRingFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *KeyRing) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteKeyRing(ctx, config, resource)
 }
@@ -222,6 +242,22 @@ func (r *KeyRing) ID(resource *unstructured.Resource) (string, error) {
 	return KeyRingID(resource)
 }
 
+func (r *KeyRing) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListKeyRing(ctx, config, project, location)
+}
+
+func (r *KeyRing) Schema() *dcl.Schema {
+	return dclService.DCLKeyRingSchema()
+}
+
 func init() {
 	unstructured.Register(&KeyRing{})
 }
@@ -190,6 +190,22 @@ func ProjectHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func ProjectFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToProject(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToProject(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffProject(ctx, r, opts...)
+}
+
 func DeleteProject(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToProject(u)
@@ -282,13 +298,17 @@ func SetPolicyMemberProject(ctx context.Context, config *dcl.Config, u *unstruct
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberProject(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberProject(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToProject(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -316,8 +336,8 @@ func (r *Project) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return SetPolicyMemberProject(ctx, config, resource, member)
 }
 
-func (r *Project) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberProject(ctx, config, resource, role, member)
+func (r *Project) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberProject(ctx, config, resource, member)
 }
 
 func (r *Project) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -348,6 +368,10 @@ func (r *Project) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return ProjectHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Project) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ProjectFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Project) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteProject(ctx, config, resource)
 }
@@ -356,6 +380,18 @@ func (r *Project) ID(resource *unstructured.Resource) (string, error) {
 	return ProjectID(resource)
 }
 
+func (r *Project) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListProject(ctx, config, parent)
+}
+
+func (r *Project) Schema() *dcl.Schema {
+	return dclService.DCLProjectSchema()
+}
+
 func init() {
 	unstructured.Register(&Project{})
 }
@@ -200,6 +200,22 @@ func FolderHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func FolderFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToFolder(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToFolder(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffFolder(ctx, r, opts...)
+}
+
 func DeleteFolder(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToFolder(u)
@@ -292,13 +308,17 @@ func SetPolicyMemberFolder(ctx context.Context, config *dcl.Config, u *unstructu
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberFolder(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberFolder(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToFolder(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -326,8 +346,8 @@ func (r *Folder) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return SetPolicyMemberFolder(ctx, config, resource, member)
 }
 
-func (r *Folder) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberFolder(ctx, config, resource, role, member)
+func (r *Folder) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberFolder(ctx, config, resource, member)
 }
 
 func (r *Folder) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -358,6 +378,10 @@ func (r *Folder) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return FolderHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Folder) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return FolderFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Folder) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteFolder(ctx, config, resource)
 }
@@ -366,6 +390,18 @@ func (r *Folder) ID(resource *unstructured.Resource) (string, error) {
 	return FolderID(resource)
 }
 
+func (r *Folder) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListFolder(ctx, config, parent)
+}
+
+func (r *Folder) Schema() *dcl.Schema {
+	return dclService.DCLFolderSchema()
+}
+
 func init() {
 	unstructured.Register(&Folder{})
 }
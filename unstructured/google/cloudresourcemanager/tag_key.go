@@ -209,6 +209,22 @@ func TagKeyHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func TagKeyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToTagKey(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToTagKey(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffTagKey(ctx, r, opts...)
+}
+
 func DeleteTagKey(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToTagKey(u)
@@ -301,13 +317,17 @@ func SetPolicyMemberTagKey(ctx context.Context, config *dcl.Config, u *unstructu
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberTagKey(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberTagKey(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToTagKey(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -335,8 +355,8 @@ func (r *TagKey) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return SetPolicyMemberTagKey(ctx, config, resource, member)
 }
 
-func (r *TagKey) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberTagKey(ctx, config, resource, role, member)
+func (r *TagKey) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberTagKey(ctx, config, resource, member)
 }
 
 func (r *TagKey) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -367,6 +387,10 @@ func (r *TagKey) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return TagKeyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *TagKey) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return TagKeyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *TagKey) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteTagKey(ctx, config, resource)
 }
@@ -375,6 +399,14 @@ func (r *TagKey) ID(resource *unstructured.Resource) (string, error) {
 	return TagKeyID(resource)
 }
 
+func (r *TagKey) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *TagKey) Schema() *dcl.Schema {
+	return dclService.DCLTagKeySchema()
+}
+
 func init() {
 	unstructured.Register(&TagKey{})
 }
@@ -179,6 +179,22 @@ func TagValueHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Re
 	return false, nil
 }
 
+func TagValueFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToTagValue(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToTagValue(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffTagValue(ctx, r, opts...)
+}
+
 func DeleteTagValue(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToTagValue(u)
@@ -271,13 +287,17 @@ func SetPolicyMemberTagValue(ctx context.Context, config *dcl.Config, u *unstruc
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberTagValue(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberTagValue(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToTagValue(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -305,8 +325,8 @@ func (r *TagValue) SetPolicyMember(ctx context.Context, config *dcl.Config, reso
 	return SetPolicyMemberTagValue(ctx, config, resource, member)
 }
 
-func (r *TagValue) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberTagValue(ctx, config, resource, role, member)
+func (r *TagValue) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberTagValue(ctx, config, resource, member)
 }
 
 func (r *TagValue) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -337,6 +357,10 @@ func (r *TagValue) HasDiff(ctx context.Context, config *dcl.Config, resource *un
 	return TagValueHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *TagValue) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return TagValueFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *TagValue) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteTagValue(ctx, config, resource)
 }
@@ -345,6 +369,14 @@ func (r *TagValue) ID(resource *unstructured.Resource) (string, error) {
 	return TagValueID(resource)
 }
 
+func (r *TagValue) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *TagValue) Schema() *dcl.Schema {
+	return dclService.DCLTagValueSchema()
+}
+
 func init() {
 	unstructured.Register(&TagValue{})
 }
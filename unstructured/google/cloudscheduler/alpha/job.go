@@ -644,6 +644,22 @@ func JobHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resourc
 	return false, nil
 }
 
+func JobFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToJob(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToJob(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffJob(ctx, r, opts...)
+}
+
 func DeleteJob(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToJob(u)
@@ -673,7 +689,7 @@ func (r *Job) SetPolicyMember(ctx context.Context, config *dcl.Config, resource
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Job) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Job) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -705,6 +721,10 @@ func (r *Job) HasDiff(ctx context.Context, config *dcl.Config, resource *unstruc
 	return JobHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Job) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return JobFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Job) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteJob(ctx, config, resource)
 }
@@ -713,6 +733,22 @@ func (r *Job) ID(resource *unstructured.Resource) (string, error) {
 	return JobID(resource)
 }
 
+func (r *Job) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListJob(ctx, config, project, location)
+}
+
+func (r *Job) Schema() *dcl.Schema {
+	return dclService.DCLJobSchema()
+}
+
 func init() {
 	unstructured.Register(&Job{})
 }
@@ -218,6 +218,22 @@ func FirewallPolicyHasDiff(ctx context.Context, config *dcl.Config, u *unstructu
 	return false, nil
 }
 
+func FirewallPolicyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToFirewallPolicy(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToFirewallPolicy(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffFirewallPolicy(ctx, r, opts...)
+}
+
 func DeleteFirewallPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToFirewallPolicy(u)
@@ -247,7 +263,7 @@ func (r *FirewallPolicy) SetPolicyMember(ctx context.Context, config *dcl.Config
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *FirewallPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *FirewallPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -279,6 +295,10 @@ func (r *FirewallPolicy) HasDiff(ctx context.Context, config *dcl.Config, resour
 	return FirewallPolicyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *FirewallPolicy) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return FirewallPolicyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *FirewallPolicy) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteFirewallPolicy(ctx, config, resource)
 }
@@ -287,6 +307,18 @@ func (r *FirewallPolicy) ID(resource *unstructured.Resource) (string, error) {
 	return FirewallPolicyID(resource)
 }
 
+func (r *FirewallPolicy) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListFirewallPolicy(ctx, config, parent)
+}
+
+func (r *FirewallPolicy) Schema() *dcl.Schema {
+	return dclService.DCLFirewallPolicySchema()
+}
+
 func init() {
 	unstructured.Register(&FirewallPolicy{})
 }
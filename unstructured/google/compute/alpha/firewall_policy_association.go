@@ -158,6 +158,22 @@ func FirewallPolicyAssociationHasDiff(ctx context.Context, config *dcl.Config, u
 	return false, nil
 }
 
+func FirewallPolicyAssociationFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToFirewallPolicyAssociation(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToFirewallPolicyAssociation(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffFirewallPolicyAssociation(ctx, r, opts...)
+}
+
 func DeleteFirewallPolicyAssociation(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToFirewallPolicyAssociation(u)
@@ -187,7 +203,7 @@ func (r *FirewallPolicyAssociation) SetPolicyMember(ctx context.Context, config
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *FirewallPolicyAssociation) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *FirewallPolicyAssociation) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -219,6 +235,10 @@ func (r *FirewallPolicyAssociation) HasDiff(ctx context.Context, config *dcl.Con
 	return FirewallPolicyAssociationHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *FirewallPolicyAssociation) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return FirewallPolicyAssociationFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *FirewallPolicyAssociation) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteFirewallPolicyAssociation(ctx, config, resource)
 }
@@ -227,6 +247,18 @@ func (r *FirewallPolicyAssociation) ID(resource *unstructured.Resource) (string,
 	return FirewallPolicyAssociationID(resource)
 }
 
+func (r *FirewallPolicyAssociation) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	firewallPolicy, err := unstructured.StringField(resource, "firewallPolicy")
+	if err != nil {
+		return nil, err
+	}
+	return ListFirewallPolicyAssociation(ctx, config, firewallPolicy)
+}
+
+func (r *FirewallPolicyAssociation) Schema() *dcl.Schema {
+	return dclService.DCLFirewallPolicyAssociationSchema()
+}
+
 func init() {
 	unstructured.Register(&FirewallPolicyAssociation{})
 }
@@ -337,6 +337,22 @@ func SubnetworkHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.
 	return false, nil
 }
 
+func SubnetworkFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToSubnetwork(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToSubnetwork(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffSubnetwork(ctx, r, opts...)
+}
+
 func DeleteSubnetwork(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToSubnetwork(u)
@@ -366,7 +382,7 @@ func (r *Subnetwork) SetPolicyMember(ctx context.Context, config *dcl.Config, re
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Subnetwork) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Subnetwork) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -398,6 +414,10 @@ func (r *Subnetwork) HasDiff(ctx context.Context, config *dcl.Config, resource *
 	return SubnetworkHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Subnetwork) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return SubnetworkFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Subnetwork) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteSubnetwork(ctx, config, resource)
 }
@@ -406,6 +426,22 @@ func (r *Subnetwork) ID(resource *unstructured.Resource) (string, error) {
 	return SubnetworkID(resource)
 }
 
+func (r *Subnetwork) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	region, err := unstructured.StringField(resource, "region")
+	if err != nil {
+		return nil, err
+	}
+	return ListSubnetwork(ctx, config, project, region)
+}
+
+func (r *Subnetwork) Schema() *dcl.Schema {
+	return dclService.DCLSubnetworkSchema()
+}
+
 func init() {
 	unstructured.Register(&Subnetwork{})
 }
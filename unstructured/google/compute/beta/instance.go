@@ -1003,6 +1003,22 @@ func InstanceHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Re
 	return false, nil
 }
 
+func InstanceFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInstance(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffInstance(ctx, r, opts...)
+}
+
 func DeleteInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToInstance(u)
@@ -1095,13 +1111,17 @@ func SetPolicyMemberInstance(ctx context.Context, config *dcl.Config, u *unstruc
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToInstance(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -1129,8 +1149,8 @@ func (r *Instance) SetPolicyMember(ctx context.Context, config *dcl.Config, reso
 	return SetPolicyMemberInstance(ctx, config, resource, member)
 }
 
-func (r *Instance) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberInstance(ctx, config, resource, role, member)
+func (r *Instance) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberInstance(ctx, config, resource, member)
 }
 
 func (r *Instance) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -1161,6 +1181,10 @@ func (r *Instance) HasDiff(ctx context.Context, config *dcl.Config, resource *un
 	return InstanceHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Instance) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return InstanceFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Instance) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteInstance(ctx, config, resource)
 }
@@ -1169,6 +1193,22 @@ func (r *Instance) ID(resource *unstructured.Resource) (string, error) {
 	return InstanceID(resource)
 }
 
+func (r *Instance) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	zone, err := unstructured.StringField(resource, "zone")
+	if err != nil {
+		return nil, err
+	}
+	return ListInstance(ctx, config, project, zone)
+}
+
+func (r *Instance) Schema() *dcl.Schema {
+	return dclService.DCLInstanceSchema()
+}
+
 func init() {
 	unstructured.Register(&Instance{})
 }
@@ -980,6 +980,22 @@ func InstanceGroupManagerHasDiff(ctx context.Context, config *dcl.Config, u *uns
 	return false, nil
 }
 
+func InstanceGroupManagerFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstanceGroupManager(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInstanceGroupManager(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffInstanceGroupManager(ctx, r, opts...)
+}
+
 func DeleteInstanceGroupManager(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToInstanceGroupManager(u)
@@ -1009,7 +1025,7 @@ func (r *InstanceGroupManager) SetPolicyMember(ctx context.Context, config *dcl.
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *InstanceGroupManager) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *InstanceGroupManager) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -1041,6 +1057,10 @@ func (r *InstanceGroupManager) HasDiff(ctx context.Context, config *dcl.Config,
 	return InstanceGroupManagerHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *InstanceGroupManager) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return InstanceGroupManagerFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *InstanceGroupManager) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteInstanceGroupManager(ctx, config, resource)
 }
@@ -1049,6 +1069,22 @@ func (r *InstanceGroupManager) ID(resource *unstructured.Resource) (string, erro
 	return InstanceGroupManagerID(resource)
 }
 
+func (r *InstanceGroupManager) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListInstanceGroupManager(ctx, config, project, location)
+}
+
+func (r *InstanceGroupManager) Schema() *dcl.Schema {
+	return dclService.DCLInstanceGroupManagerSchema()
+}
+
 func init() {
 	unstructured.Register(&InstanceGroupManager{})
 }
@@ -219,6 +219,22 @@ func NetworkHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func NetworkFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToNetwork(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToNetwork(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffNetwork(ctx, r, opts...)
+}
+
 func DeleteNetwork(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToNetwork(u)
@@ -248,7 +264,7 @@ func (r *Network) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Network) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Network) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -280,6 +296,10 @@ func (r *Network) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return NetworkHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Network) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return NetworkFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Network) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteNetwork(ctx, config, resource)
 }
@@ -288,6 +308,18 @@ func (r *Network) ID(resource *unstructured.Resource) (string, error) {
 	return NetworkID(resource)
 }
 
+func (r *Network) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListNetwork(ctx, config, project)
+}
+
+func (r *Network) Schema() *dcl.Schema {
+	return dclService.DCLNetworkSchema()
+}
+
 func init() {
 	unstructured.Register(&Network{})
 }
@@ -178,6 +178,22 @@ func NetworkFirewallPolicyAssociationHasDiff(ctx context.Context, config *dcl.Co
 	return false, nil
 }
 
+func NetworkFirewallPolicyAssociationFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToNetworkFirewallPolicyAssociation(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToNetworkFirewallPolicyAssociation(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffNetworkFirewallPolicyAssociation(ctx, r, opts...)
+}
+
 func DeleteNetworkFirewallPolicyAssociation(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToNetworkFirewallPolicyAssociation(u)
@@ -207,7 +223,7 @@ func (r *NetworkFirewallPolicyAssociation) SetPolicyMember(ctx context.Context,
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *NetworkFirewallPolicyAssociation) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *NetworkFirewallPolicyAssociation) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -239,6 +255,10 @@ func (r *NetworkFirewallPolicyAssociation) HasDiff(ctx context.Context, config *
 	return NetworkFirewallPolicyAssociationHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *NetworkFirewallPolicyAssociation) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return NetworkFirewallPolicyAssociationFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *NetworkFirewallPolicyAssociation) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteNetworkFirewallPolicyAssociation(ctx, config, resource)
 }
@@ -247,6 +267,26 @@ func (r *NetworkFirewallPolicyAssociation) ID(resource *unstructured.Resource) (
 	return NetworkFirewallPolicyAssociationID(resource)
 }
 
+func (r *NetworkFirewallPolicyAssociation) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	firewallPolicy, err := unstructured.StringField(resource, "firewallPolicy")
+	if err != nil {
+		return nil, err
+	}
+	return ListNetworkFirewallPolicyAssociation(ctx, config, project, location, firewallPolicy)
+}
+
+func (r *NetworkFirewallPolicyAssociation) Schema() *dcl.Schema {
+	return dclService.DCLNetworkFirewallPolicyAssociationSchema()
+}
+
 func init() {
 	unstructured.Register(&NetworkFirewallPolicyAssociation{})
 }
@@ -417,6 +417,22 @@ func NetworkFirewallPolicyRuleHasDiff(ctx context.Context, config *dcl.Config, u
 	return false, nil
 }
 
+func NetworkFirewallPolicyRuleFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToNetworkFirewallPolicyRule(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToNetworkFirewallPolicyRule(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffNetworkFirewallPolicyRule(ctx, r, opts...)
+}
+
 func DeleteNetworkFirewallPolicyRule(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToNetworkFirewallPolicyRule(u)
@@ -446,7 +462,7 @@ func (r *NetworkFirewallPolicyRule) SetPolicyMember(ctx context.Context, config
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *NetworkFirewallPolicyRule) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *NetworkFirewallPolicyRule) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -478,6 +494,10 @@ func (r *NetworkFirewallPolicyRule) HasDiff(ctx context.Context, config *dcl.Con
 	return NetworkFirewallPolicyRuleHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *NetworkFirewallPolicyRule) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return NetworkFirewallPolicyRuleFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *NetworkFirewallPolicyRule) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteNetworkFirewallPolicyRule(ctx, config, resource)
 }
@@ -486,6 +506,26 @@ func (r *NetworkFirewallPolicyRule) ID(resource *unstructured.Resource) (string,
 	return NetworkFirewallPolicyRuleID(resource)
 }
 
+func (r *NetworkFirewallPolicyRule) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	firewallPolicy, err := unstructured.StringField(resource, "firewallPolicy")
+	if err != nil {
+		return nil, err
+	}
+	return ListNetworkFirewallPolicyRule(ctx, config, project, location, firewallPolicy)
+}
+
+func (r *NetworkFirewallPolicyRule) Schema() *dcl.Schema {
+	return dclService.DCLNetworkFirewallPolicyRuleSchema()
+}
+
 func init() {
 	unstructured.Register(&NetworkFirewallPolicyRule{})
 }
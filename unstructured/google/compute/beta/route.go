@@ -342,6 +342,22 @@ func RouteHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resou
 	return false, nil
 }
 
+func RouteFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToRoute(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToRoute(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffRoute(ctx, r, opts...)
+}
+
 func DeleteRoute(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToRoute(u)
@@ -371,7 +387,7 @@ func (r *Route) SetPolicyMember(ctx context.Context, config *dcl.Config, resourc
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Route) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Route) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -403,6 +419,10 @@ func (r *Route) HasDiff(ctx context.Context, config *dcl.Config, resource *unstr
 	return RouteHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Route) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return RouteFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Route) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteRoute(ctx, config, resource)
 }
@@ -411,6 +431,18 @@ func (r *Route) ID(resource *unstructured.Resource) (string, error) {
 	return RouteID(resource)
 }
 
+func (r *Route) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListRoute(ctx, config, project)
+}
+
+func (r *Route) Schema() *dcl.Schema {
+	return dclService.DCLRouteSchema()
+}
+
 func init() {
 	unstructured.Register(&Route{})
 }
@@ -377,6 +377,22 @@ func ServiceAttachmentHasDiff(ctx context.Context, config *dcl.Config, u *unstru
 	return false, nil
 }
 
+func ServiceAttachmentFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToServiceAttachment(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToServiceAttachment(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffServiceAttachment(ctx, r, opts...)
+}
+
 func DeleteServiceAttachment(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToServiceAttachment(u)
@@ -406,7 +422,7 @@ func (r *ServiceAttachment) SetPolicyMember(ctx context.Context, config *dcl.Con
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *ServiceAttachment) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *ServiceAttachment) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -438,6 +454,10 @@ func (r *ServiceAttachment) HasDiff(ctx context.Context, config *dcl.Config, res
 	return ServiceAttachmentHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *ServiceAttachment) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ServiceAttachmentFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *ServiceAttachment) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteServiceAttachment(ctx, config, resource)
 }
@@ -446,6 +466,22 @@ func (r *ServiceAttachment) ID(resource *unstructured.Resource) (string, error)
 	return ServiceAttachmentID(resource)
 }
 
+func (r *ServiceAttachment) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListServiceAttachment(ctx, config, project, location)
+}
+
+func (r *ServiceAttachment) Schema() *dcl.Schema {
+	return dclService.DCLServiceAttachmentSchema()
+}
+
 func init() {
 	unstructured.Register(&ServiceAttachment{})
 }
@@ -327,6 +327,22 @@ func FirewallPolicyRuleHasDiff(ctx context.Context, config *dcl.Config, u *unstr
 	return false, nil
 }
 
+func FirewallPolicyRuleFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToFirewallPolicyRule(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToFirewallPolicyRule(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffFirewallPolicyRule(ctx, r, opts...)
+}
+
 func DeleteFirewallPolicyRule(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToFirewallPolicyRule(u)
@@ -356,7 +372,7 @@ func (r *FirewallPolicyRule) SetPolicyMember(ctx context.Context, config *dcl.Co
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *FirewallPolicyRule) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *FirewallPolicyRule) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -388,6 +404,10 @@ func (r *FirewallPolicyRule) HasDiff(ctx context.Context, config *dcl.Config, re
 	return FirewallPolicyRuleHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *FirewallPolicyRule) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return FirewallPolicyRuleFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *FirewallPolicyRule) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteFirewallPolicyRule(ctx, config, resource)
 }
@@ -396,6 +416,18 @@ func (r *FirewallPolicyRule) ID(resource *unstructured.Resource) (string, error)
 	return FirewallPolicyRuleID(resource)
 }
 
+func (r *FirewallPolicyRule) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	firewallPolicy, err := unstructured.StringField(resource, "firewallPolicy")
+	if err != nil {
+		return nil, err
+	}
+	return ListFirewallPolicyRule(ctx, config, firewallPolicy)
+}
+
+func (r *FirewallPolicyRule) Schema() *dcl.Schema {
+	return dclService.DCLFirewallPolicyRuleSchema()
+}
+
 func init() {
 	unstructured.Register(&FirewallPolicyRule{})
 }
@@ -508,6 +508,22 @@ func ForwardingRuleHasDiff(ctx context.Context, config *dcl.Config, u *unstructu
 	return false, nil
 }
 
+func ForwardingRuleFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToForwardingRule(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToForwardingRule(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffForwardingRule(ctx, r, opts...)
+}
+
 func DeleteForwardingRule(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToForwardingRule(u)
@@ -537,7 +553,7 @@ func (r *ForwardingRule) SetPolicyMember(ctx context.Context, config *dcl.Config
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *ForwardingRule) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *ForwardingRule) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -569,6 +585,10 @@ func (r *ForwardingRule) HasDiff(ctx context.Context, config *dcl.Config, resour
 	return ForwardingRuleHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *ForwardingRule) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ForwardingRuleFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *ForwardingRule) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteForwardingRule(ctx, config, resource)
 }
@@ -577,6 +597,22 @@ func (r *ForwardingRule) ID(resource *unstructured.Resource) (string, error) {
 	return ForwardingRuleID(resource)
 }
 
+func (r *ForwardingRule) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListForwardingRule(ctx, config, project, location)
+}
+
+func (r *ForwardingRule) Schema() *dcl.Schema {
+	return dclService.DCLForwardingRuleSchema()
+}
+
 func init() {
 	unstructured.Register(&ForwardingRule{})
 }
@@ -442,6 +442,22 @@ func InterconnectAttachmentHasDiff(ctx context.Context, config *dcl.Config, u *u
 	return false, nil
 }
 
+func InterconnectAttachmentFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInterconnectAttachment(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInterconnectAttachment(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffInterconnectAttachment(ctx, r, opts...)
+}
+
 func DeleteInterconnectAttachment(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToInterconnectAttachment(u)
@@ -471,7 +487,7 @@ func (r *InterconnectAttachment) SetPolicyMember(ctx context.Context, config *dc
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *InterconnectAttachment) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *InterconnectAttachment) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -503,6 +519,10 @@ func (r *InterconnectAttachment) HasDiff(ctx context.Context, config *dcl.Config
 	return InterconnectAttachmentHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *InterconnectAttachment) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return InterconnectAttachmentFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *InterconnectAttachment) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteInterconnectAttachment(ctx, config, resource)
 }
@@ -511,6 +531,22 @@ func (r *InterconnectAttachment) ID(resource *unstructured.Resource) (string, er
 	return InterconnectAttachmentID(resource)
 }
 
+func (r *InterconnectAttachment) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	region, err := unstructured.StringField(resource, "region")
+	if err != nil {
+		return nil, err
+	}
+	return ListInterconnectAttachment(ctx, config, project, region)
+}
+
+func (r *InterconnectAttachment) Schema() *dcl.Schema {
+	return dclService.DCLInterconnectAttachmentSchema()
+}
+
 func init() {
 	unstructured.Register(&InterconnectAttachment{})
 }
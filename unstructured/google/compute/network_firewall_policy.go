@@ -228,6 +228,22 @@ func NetworkFirewallPolicyHasDiff(ctx context.Context, config *dcl.Config, u *un
 	return false, nil
 }
 
+func NetworkFirewallPolicyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToNetworkFirewallPolicy(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToNetworkFirewallPolicy(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffNetworkFirewallPolicy(ctx, r, opts...)
+}
+
 func DeleteNetworkFirewallPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToNetworkFirewallPolicy(u)
@@ -257,7 +273,7 @@ func (r *NetworkFirewallPolicy) SetPolicyMember(ctx context.Context, config *dcl
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *NetworkFirewallPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *NetworkFirewallPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -289,6 +305,10 @@ func (r *NetworkFirewallPolicy) HasDiff(ctx context.Context, config *dcl.Config,
 	return NetworkFirewallPolicyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *NetworkFirewallPolicy) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return NetworkFirewallPolicyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *NetworkFirewallPolicy) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteNetworkFirewallPolicy(ctx, config, resource)
 }
@@ -297,6 +317,22 @@ func (r *NetworkFirewallPolicy) ID(resource *unstructured.Resource) (string, err
 	return NetworkFirewallPolicyID(resource)
 }
 
+func (r *NetworkFirewallPolicy) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListNetworkFirewallPolicy(ctx, config, project, location)
+}
+
+func (r *NetworkFirewallPolicy) Schema() *dcl.Schema {
+	return dclService.DCLNetworkFirewallPolicySchema()
+}
+
 func init() {
 	unstructured.Register(&NetworkFirewallPolicy{})
 }
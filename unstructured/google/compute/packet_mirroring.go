@@ -426,6 +426,22 @@ func PacketMirroringHasDiff(ctx context.Context, config *dcl.Config, u *unstruct
 	return false, nil
 }
 
+func PacketMirroringFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToPacketMirroring(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToPacketMirroring(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffPacketMirroring(ctx, r, opts...)
+}
+
 func DeletePacketMirroring(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToPacketMirroring(u)
@@ -455,7 +471,7 @@ func (r *PacketMirroring) SetPolicyMember(ctx context.Context, config *dcl.Confi
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *PacketMirroring) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *PacketMirroring) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -487,6 +503,10 @@ func (r *PacketMirroring) HasDiff(ctx context.Context, config *dcl.Config, resou
 	return PacketMirroringHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *PacketMirroring) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return PacketMirroringFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *PacketMirroring) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeletePacketMirroring(ctx, config, resource)
 }
@@ -495,6 +515,22 @@ func (r *PacketMirroring) ID(resource *unstructured.Resource) (string, error) {
 	return PacketMirroringID(resource)
 }
 
+func (r *PacketMirroring) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListPacketMirroring(ctx, config, project, location)
+}
+
+func (r *PacketMirroring) Schema() *dcl.Schema {
+	return dclService.DCLPacketMirroringSchema()
+}
+
 func init() {
 	unstructured.Register(&PacketMirroring{})
 }
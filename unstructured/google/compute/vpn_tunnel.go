@@ -340,6 +340,22 @@ func VpnTunnelHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.R
 	return false, nil
 }
 
+func VpnTunnelFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToVpnTunnel(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToVpnTunnel(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffVpnTunnel(ctx, r, opts...)
+}
+
 func DeleteVpnTunnel(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToVpnTunnel(u)
@@ -369,7 +385,7 @@ func (r *VpnTunnel) SetPolicyMember(ctx context.Context, config *dcl.Config, res
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *VpnTunnel) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *VpnTunnel) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -401,6 +417,10 @@ func (r *VpnTunnel) HasDiff(ctx context.Context, config *dcl.Config, resource *u
 	return VpnTunnelHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *VpnTunnel) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return VpnTunnelFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *VpnTunnel) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteVpnTunnel(ctx, config, resource)
 }
@@ -409,6 +429,22 @@ func (r *VpnTunnel) ID(resource *unstructured.Resource) (string, error) {
 	return VpnTunnelID(resource)
 }
 
+func (r *VpnTunnel) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListVpnTunnel(ctx, config, project, location)
+}
+
+func (r *VpnTunnel) Schema() *dcl.Schema {
+	return dclService.DCLVpnTunnelSchema()
+}
+
 func init() {
 	unstructured.Register(&VpnTunnel{})
 }
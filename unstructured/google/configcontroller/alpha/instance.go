@@ -371,6 +371,22 @@ func InstanceHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Re
 	return false, nil
 }
 
+func InstanceFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInstance(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffInstance(ctx, r, opts...)
+}
+
 func DeleteInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToInstance(u)
@@ -400,7 +416,7 @@ func (r *Instance) SetPolicyMember(ctx context.Context, config *dcl.Config, reso
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Instance) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Instance) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -432,6 +448,10 @@ func (r *Instance) HasDiff(ctx context.Context, config *dcl.Config, resource *un
 	return InstanceHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Instance) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return InstanceFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Instance) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteInstance(ctx, config, resource)
 }
@@ -440,6 +460,22 @@ func (r *Instance) ID(resource *unstructured.Resource) (string, error) {
 	return InstanceID(resource)
 }
 
+func (r *Instance) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListInstance(ctx, config, project, location)
+}
+
+func (r *Instance) Schema() *dcl.Schema {
+	return dclService.DCLInstanceSchema()
+}
+
 func init() {
 	unstructured.Register(&Instance{})
 }
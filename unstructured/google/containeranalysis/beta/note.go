@@ -1151,6 +1151,22 @@ func NoteHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resour
 	return false, nil
 }
 
+func NoteFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToNote(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToNote(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffNote(ctx, r, opts...)
+}
+
 func DeleteNote(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToNote(u)
@@ -1180,7 +1196,7 @@ func (r *Note) SetPolicyMember(ctx context.Context, config *dcl.Config, resource
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Note) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Note) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -1212,6 +1228,10 @@ func (r *Note) HasDiff(ctx context.Context, config *dcl.Config, resource *unstru
 	return NoteHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Note) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return NoteFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Note) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteNote(ctx, config, resource)
 }
@@ -1220,6 +1240,18 @@ func (r *Note) ID(resource *unstructured.Resource) (string, error) {
 	return NoteID(resource)
 }
 
+func (r *Note) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListNote(ctx, config, project)
+}
+
+func (r *Note) Schema() *dcl.Schema {
+	return dclService.DCLNoteSchema()
+}
+
 func init() {
 	unstructured.Register(&Note{})
 }
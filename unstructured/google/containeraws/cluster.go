@@ -742,6 +742,22 @@ func ClusterHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func ClusterFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToCluster(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToCluster(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffCluster(ctx, r, opts...)
+}
+
 func DeleteCluster(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToCluster(u)
@@ -771,7 +787,7 @@ func (r *Cluster) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Cluster) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Cluster) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -803,6 +819,10 @@ func (r *Cluster) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return ClusterHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Cluster) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ClusterFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Cluster) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteCluster(ctx, config, resource)
 }
@@ -811,6 +831,22 @@ func (r *Cluster) ID(resource *unstructured.Resource) (string, error) {
 	return ClusterID(resource)
 }
 
+func (r *Cluster) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListCluster(ctx, config, project, location)
+}
+
+func (r *Cluster) Schema() *dcl.Schema {
+	return dclService.DCLClusterSchema()
+}
+
 func init() {
 	unstructured.Register(&Cluster{})
 }
@@ -198,6 +198,22 @@ func ClientHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func ClientFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToClient(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToClient(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffClient(ctx, r, opts...)
+}
+
 func DeleteClient(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToClient(u)
@@ -227,7 +243,7 @@ func (r *Client) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Client) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Client) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -259,6 +275,10 @@ func (r *Client) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return ClientHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Client) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ClientFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Client) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteClient(ctx, config, resource)
 }
@@ -267,6 +287,22 @@ func (r *Client) ID(resource *unstructured.Resource) (string, error) {
 	return ClientID(resource)
 }
 
+func (r *Client) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListClient(ctx, config, project, location)
+}
+
+func (r *Client) Schema() *dcl.Schema {
+	return dclService.DCLAzureClientSchema()
+}
+
 func init() {
 	unstructured.Register(&Client{})
 }
@@ -444,6 +444,22 @@ func NodePoolHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Re
 	return false, nil
 }
 
+func NodePoolFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToNodePool(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToNodePool(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffNodePool(ctx, r, opts...)
+}
+
 func DeleteNodePool(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToNodePool(u)
@@ -473,7 +489,7 @@ func (r *NodePool) SetPolicyMember(ctx context.Context, config *dcl.Config, reso
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *NodePool) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *NodePool) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -505,6 +521,10 @@ func (r *NodePool) HasDiff(ctx context.Context, config *dcl.Config, resource *un
 	return NodePoolHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *NodePool) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return NodePoolFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *NodePool) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteNodePool(ctx, config, resource)
 }
@@ -513,6 +533,26 @@ func (r *NodePool) ID(resource *unstructured.Resource) (string, error) {
 	return NodePoolID(resource)
 }
 
+func (r *NodePool) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := unstructured.StringField(resource, "cluster")
+	if err != nil {
+		return nil, err
+	}
+	return ListNodePool(ctx, config, project, location, cluster)
+}
+
+func (r *NodePool) Schema() *dcl.Schema {
+	return dclService.DCLNodePoolSchema()
+}
+
 func init() {
 	unstructured.Register(&NodePool{})
 }
@@ -618,6 +618,22 @@ func AssetHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resou
 	return false, nil
 }
 
+func AssetFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToAsset(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToAsset(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffAsset(ctx, r, opts...)
+}
+
 func DeleteAsset(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToAsset(u)
@@ -647,7 +663,7 @@ func (r *Asset) SetPolicyMember(ctx context.Context, config *dcl.Config, resourc
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Asset) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Asset) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -679,6 +695,10 @@ func (r *Asset) HasDiff(ctx context.Context, config *dcl.Config, resource *unstr
 	return AssetHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Asset) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return AssetFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Asset) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteAsset(ctx, config, resource)
 }
@@ -687,6 +707,30 @@ func (r *Asset) ID(resource *unstructured.Resource) (string, error) {
 	return AssetID(resource)
 }
 
+func (r *Asset) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	dataplexZone, err := unstructured.StringField(resource, "dataplexZone")
+	if err != nil {
+		return nil, err
+	}
+	lake, err := unstructured.StringField(resource, "lake")
+	if err != nil {
+		return nil, err
+	}
+	return ListAsset(ctx, config, project, location, dataplexZone, lake)
+}
+
+func (r *Asset) Schema() *dcl.Schema {
+	return dclService.DCLAssetSchema()
+}
+
 func init() {
 	unstructured.Register(&Asset{})
 }
@@ -455,6 +455,22 @@ func ZoneHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resour
 	return false, nil
 }
 
+func ZoneFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToZone(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToZone(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffZone(ctx, r, opts...)
+}
+
 func DeleteZone(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToZone(u)
@@ -484,7 +500,7 @@ func (r *Zone) SetPolicyMember(ctx context.Context, config *dcl.Config, resource
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Zone) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Zone) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -516,6 +532,10 @@ func (r *Zone) HasDiff(ctx context.Context, config *dcl.Config, resource *unstru
 	return ZoneHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Zone) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ZoneFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Zone) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteZone(ctx, config, resource)
 }
@@ -524,6 +544,26 @@ func (r *Zone) ID(resource *unstructured.Resource) (string, error) {
 	return ZoneID(resource)
 }
 
+func (r *Zone) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	lake, err := unstructured.StringField(resource, "lake")
+	if err != nil {
+		return nil, err
+	}
+	return ListZone(ctx, config, project, location, lake)
+}
+
+func (r *Zone) Schema() *dcl.Schema {
+	return dclService.DCLZoneSchema()
+}
+
 func init() {
 	unstructured.Register(&Zone{})
 }
@@ -351,6 +351,22 @@ func LakeHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resour
 	return false, nil
 }
 
+func LakeFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToLake(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToLake(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffLake(ctx, r, opts...)
+}
+
 func DeleteLake(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToLake(u)
@@ -380,7 +396,7 @@ func (r *Lake) SetPolicyMember(ctx context.Context, config *dcl.Config, resource
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Lake) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Lake) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -412,6 +428,10 @@ func (r *Lake) HasDiff(ctx context.Context, config *dcl.Config, resource *unstru
 	return LakeHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Lake) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return LakeFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Lake) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteLake(ctx, config, resource)
 }
@@ -420,6 +440,22 @@ func (r *Lake) ID(resource *unstructured.Resource) (string, error) {
 	return LakeID(resource)
 }
 
+func (r *Lake) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListLake(ctx, config, project, location)
+}
+
+func (r *Lake) Schema() *dcl.Schema {
+	return dclService.DCLLakeSchema()
+}
+
 func init() {
 	unstructured.Register(&Lake{})
 }
@@ -2926,6 +2926,22 @@ func WorkflowTemplateHasDiff(ctx context.Context, config *dcl.Config, u *unstruc
 	return false, nil
 }
 
+func WorkflowTemplateFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToWorkflowTemplate(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToWorkflowTemplate(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffWorkflowTemplate(ctx, r, opts...)
+}
+
 func DeleteWorkflowTemplate(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToWorkflowTemplate(u)
@@ -2955,7 +2971,7 @@ func (r *WorkflowTemplate) SetPolicyMember(ctx context.Context, config *dcl.Conf
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *WorkflowTemplate) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *WorkflowTemplate) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -2987,6 +3003,10 @@ func (r *WorkflowTemplate) HasDiff(ctx context.Context, config *dcl.Config, reso
 	return WorkflowTemplateHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *WorkflowTemplate) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return WorkflowTemplateFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *WorkflowTemplate) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteWorkflowTemplate(ctx, config, resource)
 }
@@ -2995,6 +3015,22 @@ func (r *WorkflowTemplate) ID(resource *unstructured.Resource) (string, error) {
 	return WorkflowTemplateID(resource)
 }
 
+func (r *WorkflowTemplate) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListWorkflowTemplate(ctx, config, project, location)
+}
+
+func (r *WorkflowTemplate) Schema() *dcl.Schema {
+	return dclService.DCLWorkflowTemplateSchema()
+}
+
 func init() {
 	unstructured.Register(&WorkflowTemplate{})
 }
@@ -312,6 +312,22 @@ func AutoscalingPolicyHasDiff(ctx context.Context, config *dcl.Config, u *unstru
 	return false, nil
 }
 
+func AutoscalingPolicyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToAutoscalingPolicy(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToAutoscalingPolicy(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffAutoscalingPolicy(ctx, r, opts...)
+}
+
 func DeleteAutoscalingPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToAutoscalingPolicy(u)
@@ -341,7 +357,7 @@ func (r *AutoscalingPolicy) SetPolicyMember(ctx context.Context, config *dcl.Con
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *AutoscalingPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *AutoscalingPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -373,6 +389,10 @@ func (r *AutoscalingPolicy) HasDiff(ctx context.Context, config *dcl.Config, res
 	return AutoscalingPolicyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *AutoscalingPolicy) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return AutoscalingPolicyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *AutoscalingPolicy) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteAutoscalingPolicy(ctx, config, resource)
 }
@@ -381,6 +401,22 @@ func (r *AutoscalingPolicy) ID(resource *unstructured.Resource) (string, error)
 	return AutoscalingPolicyID(resource)
 }
 
+func (r *AutoscalingPolicy) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListAutoscalingPolicy(ctx, config, project, location)
+}
+
+func (r *AutoscalingPolicy) Schema() *dcl.Schema {
+	return dclService.DCLAutoscalingPolicySchema()
+}
+
 func init() {
 	unstructured.Register(&AutoscalingPolicy{})
 }
@@ -0,0 +1,312 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/datastore"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type Index struct{}
+
+func IndexToUnstructured(r *dclService.Index) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "datastore",
+			Version: "ga",
+			Type:    "Index",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.Ancestor != nil {
+		u.Object["ancestor"] = string(*r.Ancestor)
+	}
+	if r.IndexId != nil {
+		u.Object["indexId"] = *r.IndexId
+	}
+	if r.Kind != nil {
+		u.Object["kind"] = *r.Kind
+	}
+	if r.Project != nil {
+		u.Object["project"] = *r.Project
+	}
+	var rProperties []interface{}
+	for _, rPropertiesVal := range r.Properties {
+		rPropertiesObject := make(map[string]interface{})
+		if rPropertiesVal.Direction != nil {
+			rPropertiesObject["direction"] = string(*rPropertiesVal.Direction)
+		}
+		if rPropertiesVal.Name != nil {
+			rPropertiesObject["name"] = *rPropertiesVal.Name
+		}
+		rProperties = append(rProperties, rPropertiesObject)
+	}
+	u.Object["properties"] = rProperties
+	if r.State != nil {
+		u.Object["state"] = string(*r.State)
+	}
+	return u
+}
+
+func UnstructuredToIndex(u *unstructured.Resource) (*dclService.Index, error) {
+	r := &dclService.Index{}
+	if _, ok := u.Object["ancestor"]; ok {
+		if s, ok := u.Object["ancestor"].(string); ok {
+			r.Ancestor = dclService.IndexAncestorEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.Ancestor: expected string")
+		}
+	}
+	if _, ok := u.Object["indexId"]; ok {
+		if s, ok := u.Object["indexId"].(string); ok {
+			r.IndexId = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.IndexId: expected string")
+		}
+	}
+	if _, ok := u.Object["kind"]; ok {
+		if s, ok := u.Object["kind"].(string); ok {
+			r.Kind = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Kind: expected string")
+		}
+	}
+	if _, ok := u.Object["project"]; ok {
+		if s, ok := u.Object["project"].(string); ok {
+			r.Project = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Project: expected string")
+		}
+	}
+	if _, ok := u.Object["properties"]; ok {
+		if s, ok := u.Object["properties"].([]interface{}); ok {
+			for _, o := range s {
+				if objval, ok := o.(map[string]interface{}); ok {
+					var rProperties dclService.IndexProperties
+					if _, ok := objval["direction"]; ok {
+						if s, ok := objval["direction"].(string); ok {
+							rProperties.Direction = dclService.IndexPropertiesDirectionEnumRef(s)
+						} else {
+							return nil, fmt.Errorf("rProperties.Direction: expected string")
+						}
+					}
+					if _, ok := objval["name"]; ok {
+						if s, ok := objval["name"].(string); ok {
+							rProperties.Name = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("rProperties.Name: expected string")
+						}
+					}
+					r.Properties = append(r.Properties, rProperties)
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.Properties: expected []interface{}")
+		}
+	}
+	if _, ok := u.Object["state"]; ok {
+		if s, ok := u.Object["state"].(string); ok {
+			r.State = dclService.IndexStateEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.State: expected string")
+		}
+	}
+	return r, nil
+}
+
+func GetIndex(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToIndex(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetIndex(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return IndexToUnstructured(r), nil
+}
+
+func ListIndex(ctx context.Context, config *dcl.Config, project string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListIndex(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, IndexToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplyIndex(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToIndex(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToIndex(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplyIndex(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return IndexToUnstructured(r), nil
+}
+
+func IndexHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToIndex(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToIndex(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplyIndex(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func IndexFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToIndex(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToIndex(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffIndex(ctx, r, opts...)
+}
+
+func DeleteIndex(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToIndex(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteIndex(ctx, r)
+}
+
+func IndexID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToIndex(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *Index) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"datastore",
+		"Index",
+		"ga",
+	}
+}
+
+func (r *Index) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Index) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Index) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *Index) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Index) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Index) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Index) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetIndex(ctx, config, resource)
+}
+
+func (r *Index) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplyIndex(ctx, config, resource, opts...)
+}
+
+func (r *Index) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return IndexHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *Index) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return IndexFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *Index) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteIndex(ctx, config, resource)
+}
+
+func (r *Index) ID(resource *unstructured.Resource) (string, error) {
+	return IndexID(resource)
+}
+
+func (r *Index) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListIndex(ctx, config, project)
+}
+
+func (r *Index) Schema() *dcl.Schema {
+	return dclService.DCLIndexSchema()
+}
+
+func init() {
+	unstructured.Register(&Index{})
+}
@@ -890,6 +890,22 @@ func InspectTemplateHasDiff(ctx context.Context, config *dcl.Config, u *unstruct
 	return false, nil
 }
 
+func InspectTemplateFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInspectTemplate(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInspectTemplate(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffInspectTemplate(ctx, r, opts...)
+}
+
 func DeleteInspectTemplate(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToInspectTemplate(u)
@@ -919,7 +935,7 @@ func (r *InspectTemplate) SetPolicyMember(ctx context.Context, config *dcl.Confi
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *InspectTemplate) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *InspectTemplate) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -951,6 +967,10 @@ func (r *InspectTemplate) HasDiff(ctx context.Context, config *dcl.Config, resou
 	return InspectTemplateHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *InspectTemplate) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return InspectTemplateFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *InspectTemplate) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteInspectTemplate(ctx, config, resource)
 }
@@ -959,6 +979,22 @@ func (r *InspectTemplate) ID(resource *unstructured.Resource) (string, error) {
 	return InspectTemplateID(resource)
 }
 
+func (r *InspectTemplate) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListInspectTemplate(ctx, config, location, parent)
+}
+
+func (r *InspectTemplate) Schema() *dcl.Schema {
+	return dclService.DCLInspectTemplateSchema()
+}
+
 func init() {
 	unstructured.Register(&InspectTemplate{})
 }
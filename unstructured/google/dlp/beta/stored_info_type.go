@@ -390,6 +390,22 @@ func StoredInfoTypeHasDiff(ctx context.Context, config *dcl.Config, u *unstructu
 	return false, nil
 }
 
+func StoredInfoTypeFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToStoredInfoType(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToStoredInfoType(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffStoredInfoType(ctx, r, opts...)
+}
+
 func DeleteStoredInfoType(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToStoredInfoType(u)
@@ -419,7 +435,7 @@ func (r *StoredInfoType) SetPolicyMember(ctx context.Context, config *dcl.Config
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *StoredInfoType) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *StoredInfoType) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -451,6 +467,10 @@ func (r *StoredInfoType) HasDiff(ctx context.Context, config *dcl.Config, resour
 	return StoredInfoTypeHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *StoredInfoType) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return StoredInfoTypeFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *StoredInfoType) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteStoredInfoType(ctx, config, resource)
 }
@@ -459,6 +479,22 @@ func (r *StoredInfoType) ID(resource *unstructured.Resource) (string, error) {
 	return StoredInfoTypeID(resource)
 }
 
+func (r *StoredInfoType) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListStoredInfoType(ctx, config, location, parent)
+}
+
+func (r *StoredInfoType) Schema() *dcl.Schema {
+	return dclService.DCLStoredInfoTypeSchema()
+}
+
 func init() {
 	unstructured.Register(&StoredInfoType{})
 }
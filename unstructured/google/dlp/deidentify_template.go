@@ -6062,6 +6062,22 @@ func DeidentifyTemplateHasDiff(ctx context.Context, config *dcl.Config, u *unstr
 	return false, nil
 }
 
+func DeidentifyTemplateFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDeidentifyTemplate(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToDeidentifyTemplate(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffDeidentifyTemplate(ctx, r, opts...)
+}
+
 func DeleteDeidentifyTemplate(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToDeidentifyTemplate(u)
@@ -6091,7 +6107,7 @@ func (r *DeidentifyTemplate) SetPolicyMember(ctx context.Context, config *dcl.Co
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *DeidentifyTemplate) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *DeidentifyTemplate) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -6123,6 +6139,10 @@ func (r *DeidentifyTemplate) HasDiff(ctx context.Context, config *dcl.Config, re
 	return DeidentifyTemplateHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *DeidentifyTemplate) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return DeidentifyTemplateFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *DeidentifyTemplate) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteDeidentifyTemplate(ctx, config, resource)
 }
@@ -6131,6 +6151,22 @@ func (r *DeidentifyTemplate) ID(resource *unstructured.Resource) (string, error)
 	return DeidentifyTemplateID(resource)
 }
 
+func (r *DeidentifyTemplate) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListDeidentifyTemplate(ctx, config, location, parent)
+}
+
+func (r *DeidentifyTemplate) Schema() *dcl.Schema {
+	return dclService.DCLDeidentifyTemplateSchema()
+}
+
 func init() {
 	unstructured.Register(&DeidentifyTemplate{})
 }
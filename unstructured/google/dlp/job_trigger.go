@@ -1942,6 +1942,22 @@ func JobTriggerHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.
 	return false, nil
 }
 
+func JobTriggerFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToJobTrigger(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToJobTrigger(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffJobTrigger(ctx, r, opts...)
+}
+
 func DeleteJobTrigger(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToJobTrigger(u)
@@ -1971,7 +1987,7 @@ func (r *JobTrigger) SetPolicyMember(ctx context.Context, config *dcl.Config, re
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *JobTrigger) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *JobTrigger) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -2003,6 +2019,10 @@ func (r *JobTrigger) HasDiff(ctx context.Context, config *dcl.Config, resource *
 	return JobTriggerHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *JobTrigger) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return JobTriggerFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *JobTrigger) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteJobTrigger(ctx, config, resource)
 }
@@ -2011,6 +2031,22 @@ func (r *JobTrigger) ID(resource *unstructured.Resource) (string, error) {
 	return JobTriggerID(resource)
 }
 
+func (r *JobTrigger) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListJobTrigger(ctx, config, location, parent)
+}
+
+func (r *JobTrigger) Schema() *dcl.Schema {
+	return dclService.DCLJobTriggerSchema()
+}
+
 func init() {
 	unstructured.Register(&JobTrigger{})
 }
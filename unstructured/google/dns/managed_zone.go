@@ -0,0 +1,539 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dns"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type ManagedZone struct{}
+
+func ManagedZoneToUnstructured(r *dclService.ManagedZone) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "dns",
+			Version: "ga",
+			Type:    "ManagedZone",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.Description != nil {
+		u.Object["description"] = *r.Description
+	}
+	if r.DnsName != nil {
+		u.Object["dnsName"] = *r.DnsName
+	}
+	if r.DnssecConfig != nil && r.DnssecConfig != dclService.EmptyManagedZoneDnssecConfig {
+		rDnssecConfig := make(map[string]interface{})
+		var rDnssecConfigDefaultKeySpecs []interface{}
+		for _, rDnssecConfigDefaultKeySpecsVal := range r.DnssecConfig.DefaultKeySpecs {
+			rDnssecConfigDefaultKeySpecsObject := make(map[string]interface{})
+			if rDnssecConfigDefaultKeySpecsVal.Algorithm != nil {
+				rDnssecConfigDefaultKeySpecsObject["algorithm"] = string(*rDnssecConfigDefaultKeySpecsVal.Algorithm)
+			}
+			if rDnssecConfigDefaultKeySpecsVal.KeyLength != nil {
+				rDnssecConfigDefaultKeySpecsObject["keyLength"] = *rDnssecConfigDefaultKeySpecsVal.KeyLength
+			}
+			if rDnssecConfigDefaultKeySpecsVal.KeyType != nil {
+				rDnssecConfigDefaultKeySpecsObject["keyType"] = string(*rDnssecConfigDefaultKeySpecsVal.KeyType)
+			}
+			if rDnssecConfigDefaultKeySpecsVal.Kind != nil {
+				rDnssecConfigDefaultKeySpecsObject["kind"] = *rDnssecConfigDefaultKeySpecsVal.Kind
+			}
+			rDnssecConfigDefaultKeySpecs = append(rDnssecConfigDefaultKeySpecs, rDnssecConfigDefaultKeySpecsObject)
+		}
+		rDnssecConfig["defaultKeySpecs"] = rDnssecConfigDefaultKeySpecs
+		if r.DnssecConfig.Kind != nil {
+			rDnssecConfig["kind"] = *r.DnssecConfig.Kind
+		}
+		if r.DnssecConfig.NonExistence != nil {
+			rDnssecConfig["nonExistence"] = string(*r.DnssecConfig.NonExistence)
+		}
+		if r.DnssecConfig.State != nil {
+			rDnssecConfig["state"] = string(*r.DnssecConfig.State)
+		}
+		u.Object["dnssecConfig"] = rDnssecConfig
+	}
+	if r.ForwardingConfig != nil && r.ForwardingConfig != dclService.EmptyManagedZoneForwardingConfig {
+		rForwardingConfig := make(map[string]interface{})
+		var rForwardingConfigTargetNameServers []interface{}
+		for _, rForwardingConfigTargetNameServersVal := range r.ForwardingConfig.TargetNameServers {
+			rForwardingConfigTargetNameServersObject := make(map[string]interface{})
+			if rForwardingConfigTargetNameServersVal.ForwardingPath != nil {
+				rForwardingConfigTargetNameServersObject["forwardingPath"] = string(*rForwardingConfigTargetNameServersVal.ForwardingPath)
+			}
+			if rForwardingConfigTargetNameServersVal.IPv4Address != nil {
+				rForwardingConfigTargetNameServersObject["ipv4Address"] = *rForwardingConfigTargetNameServersVal.IPv4Address
+			}
+			rForwardingConfigTargetNameServers = append(rForwardingConfigTargetNameServers, rForwardingConfigTargetNameServersObject)
+		}
+		rForwardingConfig["targetNameServers"] = rForwardingConfigTargetNameServers
+		u.Object["forwardingConfig"] = rForwardingConfig
+	}
+	if r.Labels != nil {
+		rLabels := make(map[string]interface{})
+		for k, v := range r.Labels {
+			rLabels[k] = v
+		}
+		u.Object["labels"] = rLabels
+	}
+	if r.Name != nil {
+		u.Object["name"] = *r.Name
+	}
+	var rNameServers []interface{}
+	for _, rNameServersVal := range r.NameServers {
+		rNameServers = append(rNameServers, rNameServersVal)
+	}
+	u.Object["nameServers"] = rNameServers
+	if r.PeeringConfig != nil && r.PeeringConfig != dclService.EmptyManagedZonePeeringConfig {
+		rPeeringConfig := make(map[string]interface{})
+		if r.PeeringConfig.TargetNetwork != nil && r.PeeringConfig.TargetNetwork != dclService.EmptyManagedZonePeeringConfigTargetNetwork {
+			rPeeringConfigTargetNetwork := make(map[string]interface{})
+			if r.PeeringConfig.TargetNetwork.NetworkUrl != nil {
+				rPeeringConfigTargetNetwork["networkUrl"] = *r.PeeringConfig.TargetNetwork.NetworkUrl
+			}
+			rPeeringConfig["targetNetwork"] = rPeeringConfigTargetNetwork
+		}
+		u.Object["peeringConfig"] = rPeeringConfig
+	}
+	if r.PrivateVisibilityConfig != nil && r.PrivateVisibilityConfig != dclService.EmptyManagedZonePrivateVisibilityConfig {
+		rPrivateVisibilityConfig := make(map[string]interface{})
+		var rPrivateVisibilityConfigNetworks []interface{}
+		for _, rPrivateVisibilityConfigNetworksVal := range r.PrivateVisibilityConfig.Networks {
+			rPrivateVisibilityConfigNetworksObject := make(map[string]interface{})
+			if rPrivateVisibilityConfigNetworksVal.NetworkUrl != nil {
+				rPrivateVisibilityConfigNetworksObject["networkUrl"] = *rPrivateVisibilityConfigNetworksVal.NetworkUrl
+			}
+			rPrivateVisibilityConfigNetworks = append(rPrivateVisibilityConfigNetworks, rPrivateVisibilityConfigNetworksObject)
+		}
+		rPrivateVisibilityConfig["networks"] = rPrivateVisibilityConfigNetworks
+		u.Object["privateVisibilityConfig"] = rPrivateVisibilityConfig
+	}
+	if r.Project != nil {
+		u.Object["project"] = *r.Project
+	}
+	if r.ReverseLookup != nil {
+		u.Object["reverseLookup"] = *r.ReverseLookup
+	}
+	if r.Visibility != nil {
+		u.Object["visibility"] = string(*r.Visibility)
+	}
+	return u
+}
+
+func UnstructuredToManagedZone(u *unstructured.Resource) (*dclService.ManagedZone, error) {
+	r := &dclService.ManagedZone{}
+	if _, ok := u.Object["description"]; ok {
+		if s, ok := u.Object["description"].(string); ok {
+			r.Description = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Description: expected string")
+		}
+	}
+	if _, ok := u.Object["dnsName"]; ok {
+		if s, ok := u.Object["dnsName"].(string); ok {
+			r.DnsName = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.DnsName: expected string")
+		}
+	}
+	if _, ok := u.Object["dnssecConfig"]; ok {
+		if rDnssecConfig, ok := u.Object["dnssecConfig"].(map[string]interface{}); ok {
+			r.DnssecConfig = &dclService.ManagedZoneDnssecConfig{}
+			if _, ok := rDnssecConfig["defaultKeySpecs"]; ok {
+				if s, ok := rDnssecConfig["defaultKeySpecs"].([]interface{}); ok {
+					for _, o := range s {
+						if objval, ok := o.(map[string]interface{}); ok {
+							var rDnssecConfigDefaultKeySpecs dclService.ManagedZoneDnssecConfigDefaultKeySpecs
+							if _, ok := objval["algorithm"]; ok {
+								if s, ok := objval["algorithm"].(string); ok {
+									rDnssecConfigDefaultKeySpecs.Algorithm = dclService.ManagedZoneDnssecConfigDefaultKeySpecsAlgorithmEnumRef(s)
+								} else {
+									return nil, fmt.Errorf("rDnssecConfigDefaultKeySpecs.Algorithm: expected string")
+								}
+							}
+							if _, ok := objval["keyLength"]; ok {
+								if i, ok := objval["keyLength"].(int64); ok {
+									rDnssecConfigDefaultKeySpecs.KeyLength = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("rDnssecConfigDefaultKeySpecs.KeyLength: expected int64")
+								}
+							}
+							if _, ok := objval["keyType"]; ok {
+								if s, ok := objval["keyType"].(string); ok {
+									rDnssecConfigDefaultKeySpecs.KeyType = dclService.ManagedZoneDnssecConfigDefaultKeySpecsKeyTypeEnumRef(s)
+								} else {
+									return nil, fmt.Errorf("rDnssecConfigDefaultKeySpecs.KeyType: expected string")
+								}
+							}
+							if _, ok := objval["kind"]; ok {
+								if s, ok := objval["kind"].(string); ok {
+									rDnssecConfigDefaultKeySpecs.Kind = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("rDnssecConfigDefaultKeySpecs.Kind: expected string")
+								}
+							}
+							r.DnssecConfig.DefaultKeySpecs = append(r.DnssecConfig.DefaultKeySpecs, rDnssecConfigDefaultKeySpecs)
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.DnssecConfig.DefaultKeySpecs: expected []interface{}")
+				}
+			}
+			if _, ok := rDnssecConfig["kind"]; ok {
+				if s, ok := rDnssecConfig["kind"].(string); ok {
+					r.DnssecConfig.Kind = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.DnssecConfig.Kind: expected string")
+				}
+			}
+			if _, ok := rDnssecConfig["nonExistence"]; ok {
+				if s, ok := rDnssecConfig["nonExistence"].(string); ok {
+					r.DnssecConfig.NonExistence = dclService.ManagedZoneDnssecConfigNonExistenceEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.DnssecConfig.NonExistence: expected string")
+				}
+			}
+			if _, ok := rDnssecConfig["state"]; ok {
+				if s, ok := rDnssecConfig["state"].(string); ok {
+					r.DnssecConfig.State = dclService.ManagedZoneDnssecConfigStateEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.DnssecConfig.State: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.DnssecConfig: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["forwardingConfig"]; ok {
+		if rForwardingConfig, ok := u.Object["forwardingConfig"].(map[string]interface{}); ok {
+			r.ForwardingConfig = &dclService.ManagedZoneForwardingConfig{}
+			if _, ok := rForwardingConfig["targetNameServers"]; ok {
+				if s, ok := rForwardingConfig["targetNameServers"].([]interface{}); ok {
+					for _, o := range s {
+						if objval, ok := o.(map[string]interface{}); ok {
+							var rForwardingConfigTargetNameServers dclService.ManagedZoneForwardingConfigTargetNameServers
+							if _, ok := objval["forwardingPath"]; ok {
+								if s, ok := objval["forwardingPath"].(string); ok {
+									rForwardingConfigTargetNameServers.ForwardingPath = dclService.ManagedZoneForwardingConfigTargetNameServersForwardingPathEnumRef(s)
+								} else {
+									return nil, fmt.Errorf("rForwardingConfigTargetNameServers.ForwardingPath: expected string")
+								}
+							}
+							if _, ok := objval["ipv4Address"]; ok {
+								if s, ok := objval["ipv4Address"].(string); ok {
+									rForwardingConfigTargetNameServers.IPv4Address = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("rForwardingConfigTargetNameServers.IPv4Address: expected string")
+								}
+							}
+							r.ForwardingConfig.TargetNameServers = append(r.ForwardingConfig.TargetNameServers, rForwardingConfigTargetNameServers)
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.ForwardingConfig.TargetNameServers: expected []interface{}")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.ForwardingConfig: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["labels"]; ok {
+		if rLabels, ok := u.Object["labels"].(map[string]interface{}); ok {
+			m := make(map[string]string)
+			for k, v := range rLabels {
+				if s, ok := v.(string); ok {
+					m[k] = s
+				}
+			}
+			r.Labels = m
+		} else {
+			return nil, fmt.Errorf("r.Labels: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["name"]; ok {
+		if s, ok := u.Object["name"].(string); ok {
+			r.Name = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Name: expected string")
+		}
+	}
+	if _, ok := u.Object["nameServers"]; ok {
+		if s, ok := u.Object["nameServers"].([]interface{}); ok {
+			for _, ss := range s {
+				if strval, ok := ss.(string); ok {
+					r.NameServers = append(r.NameServers, strval)
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.NameServers: expected []interface{}")
+		}
+	}
+	if _, ok := u.Object["peeringConfig"]; ok {
+		if rPeeringConfig, ok := u.Object["peeringConfig"].(map[string]interface{}); ok {
+			r.PeeringConfig = &dclService.ManagedZonePeeringConfig{}
+			if _, ok := rPeeringConfig["targetNetwork"]; ok {
+				if rPeeringConfigTargetNetwork, ok := rPeeringConfig["targetNetwork"].(map[string]interface{}); ok {
+					r.PeeringConfig.TargetNetwork = &dclService.ManagedZonePeeringConfigTargetNetwork{}
+					if _, ok := rPeeringConfigTargetNetwork["networkUrl"]; ok {
+						if s, ok := rPeeringConfigTargetNetwork["networkUrl"].(string); ok {
+							r.PeeringConfig.TargetNetwork.NetworkUrl = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.PeeringConfig.TargetNetwork.NetworkUrl: expected string")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.PeeringConfig.TargetNetwork: expected map[string]interface{}")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.PeeringConfig: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["privateVisibilityConfig"]; ok {
+		if rPrivateVisibilityConfig, ok := u.Object["privateVisibilityConfig"].(map[string]interface{}); ok {
+			r.PrivateVisibilityConfig = &dclService.ManagedZonePrivateVisibilityConfig{}
+			if _, ok := rPrivateVisibilityConfig["networks"]; ok {
+				if s, ok := rPrivateVisibilityConfig["networks"].([]interface{}); ok {
+					for _, o := range s {
+						if objval, ok := o.(map[string]interface{}); ok {
+							var rPrivateVisibilityConfigNetworks dclService.ManagedZonePrivateVisibilityConfigNetworks
+							if _, ok := objval["networkUrl"]; ok {
+								if s, ok := objval["networkUrl"].(string); ok {
+									rPrivateVisibilityConfigNetworks.NetworkUrl = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("rPrivateVisibilityConfigNetworks.NetworkUrl: expected string")
+								}
+							}
+							r.PrivateVisibilityConfig.Networks = append(r.PrivateVisibilityConfig.Networks, rPrivateVisibilityConfigNetworks)
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.PrivateVisibilityConfig.Networks: expected []interface{}")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.PrivateVisibilityConfig: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["project"]; ok {
+		if s, ok := u.Object["project"].(string); ok {
+			r.Project = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Project: expected string")
+		}
+	}
+	if _, ok := u.Object["reverseLookup"]; ok {
+		if b, ok := u.Object["reverseLookup"].(bool); ok {
+			r.ReverseLookup = dcl.Bool(b)
+		} else {
+			return nil, fmt.Errorf("r.ReverseLookup: expected bool")
+		}
+	}
+	if _, ok := u.Object["visibility"]; ok {
+		if s, ok := u.Object["visibility"].(string); ok {
+			r.Visibility = dclService.ManagedZoneVisibilityEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.Visibility: expected string")
+		}
+	}
+	return r, nil
+}
+
+func GetManagedZone(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToManagedZone(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetManagedZone(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return ManagedZoneToUnstructured(r), nil
+}
+
+func ListManagedZone(ctx context.Context, config *dcl.Config, project string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListManagedZone(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, ManagedZoneToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplyManagedZone(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToManagedZone(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToManagedZone(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplyManagedZone(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return ManagedZoneToUnstructured(r), nil
+}
+
+func ManagedZoneHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToManagedZone(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToManagedZone(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplyManagedZone(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func ManagedZoneFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToManagedZone(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToManagedZone(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffManagedZone(ctx, r, opts...)
+}
+
+func DeleteManagedZone(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToManagedZone(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteManagedZone(ctx, r)
+}
+
+func ManagedZoneID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToManagedZone(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *ManagedZone) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"dns",
+		"ManagedZone",
+		"ga",
+	}
+}
+
+func (r *ManagedZone) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ManagedZone) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ManagedZone) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *ManagedZone) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ManagedZone) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ManagedZone) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ManagedZone) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetManagedZone(ctx, config, resource)
+}
+
+func (r *ManagedZone) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplyManagedZone(ctx, config, resource, opts...)
+}
+
+func (r *ManagedZone) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return ManagedZoneHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *ManagedZone) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ManagedZoneFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *ManagedZone) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteManagedZone(ctx, config, resource)
+}
+
+func (r *ManagedZone) ID(resource *unstructured.Resource) (string, error) {
+	return ManagedZoneID(resource)
+}
+
+func (r *ManagedZone) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListManagedZone(ctx, config, project)
+}
+
+func (r *ManagedZone) Schema() *dcl.Schema {
+	return dclService.DCLManagedZoneSchema()
+}
+
+func init() {
+	unstructured.Register(&ManagedZone{})
+}
@@ -0,0 +1,294 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/dns"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type ResourceRecordSet struct{}
+
+func ResourceRecordSetToUnstructured(r *dclService.ResourceRecordSet) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "dns",
+			Version: "ga",
+			Type:    "ResourceRecordSet",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.DnsName != nil {
+		u.Object["dnsName"] = *r.DnsName
+	}
+	if r.DnsType != nil {
+		u.Object["dnsType"] = *r.DnsType
+	}
+	if r.ManagedZone != nil {
+		u.Object["managedZone"] = *r.ManagedZone
+	}
+	if r.Project != nil {
+		u.Object["project"] = *r.Project
+	}
+	var rTarget []interface{}
+	for _, rTargetVal := range r.Target {
+		rTarget = append(rTarget, rTargetVal)
+	}
+	u.Object["target"] = rTarget
+	if r.Ttl != nil {
+		u.Object["ttl"] = *r.Ttl
+	}
+	return u
+}
+
+func UnstructuredToResourceRecordSet(u *unstructured.Resource) (*dclService.ResourceRecordSet, error) {
+	r := &dclService.ResourceRecordSet{}
+	if _, ok := u.Object["dnsName"]; ok {
+		if s, ok := u.Object["dnsName"].(string); ok {
+			r.DnsName = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.DnsName: expected string")
+		}
+	}
+	if _, ok := u.Object["dnsType"]; ok {
+		if s, ok := u.Object["dnsType"].(string); ok {
+			r.DnsType = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.DnsType: expected string")
+		}
+	}
+	if _, ok := u.Object["managedZone"]; ok {
+		if s, ok := u.Object["managedZone"].(string); ok {
+			r.ManagedZone = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.ManagedZone: expected string")
+		}
+	}
+	if _, ok := u.Object["project"]; ok {
+		if s, ok := u.Object["project"].(string); ok {
+			r.Project = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Project: expected string")
+		}
+	}
+	if _, ok := u.Object["target"]; ok {
+		if s, ok := u.Object["target"].([]interface{}); ok {
+			for _, ss := range s {
+				if strval, ok := ss.(string); ok {
+					r.Target = append(r.Target, strval)
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.Target: expected []interface{}")
+		}
+	}
+	if _, ok := u.Object["ttl"]; ok {
+		if i, ok := u.Object["ttl"].(int64); ok {
+			r.Ttl = dcl.Int64(i)
+		} else {
+			return nil, fmt.Errorf("r.Ttl: expected int64")
+		}
+	}
+	return r, nil
+}
+
+func GetResourceRecordSet(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToResourceRecordSet(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetResourceRecordSet(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return ResourceRecordSetToUnstructured(r), nil
+}
+
+func ListResourceRecordSet(ctx context.Context, config *dcl.Config, project string, managedZone string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListResourceRecordSet(ctx, project, managedZone)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, ResourceRecordSetToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplyResourceRecordSet(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToResourceRecordSet(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToResourceRecordSet(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplyResourceRecordSet(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return ResourceRecordSetToUnstructured(r), nil
+}
+
+func ResourceRecordSetHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToResourceRecordSet(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToResourceRecordSet(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplyResourceRecordSet(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func ResourceRecordSetFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToResourceRecordSet(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToResourceRecordSet(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffResourceRecordSet(ctx, r, opts...)
+}
+
+func DeleteResourceRecordSet(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToResourceRecordSet(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteResourceRecordSet(ctx, r)
+}
+
+func ResourceRecordSetID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToResourceRecordSet(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *ResourceRecordSet) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"dns",
+		"ResourceRecordSet",
+		"ga",
+	}
+}
+
+func (r *ResourceRecordSet) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ResourceRecordSet) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ResourceRecordSet) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *ResourceRecordSet) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ResourceRecordSet) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ResourceRecordSet) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ResourceRecordSet) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetResourceRecordSet(ctx, config, resource)
+}
+
+func (r *ResourceRecordSet) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplyResourceRecordSet(ctx, config, resource, opts...)
+}
+
+func (r *ResourceRecordSet) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return ResourceRecordSetHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *ResourceRecordSet) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ResourceRecordSetFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *ResourceRecordSet) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteResourceRecordSet(ctx, config, resource)
+}
+
+func (r *ResourceRecordSet) ID(resource *unstructured.Resource) (string, error) {
+	return ResourceRecordSetID(resource)
+}
+
+func (r *ResourceRecordSet) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	managedZone, err := unstructured.StringField(resource, "managedZone")
+	if err != nil {
+		return nil, err
+	}
+	return ListResourceRecordSet(ctx, config, project, managedZone)
+}
+
+func (r *ResourceRecordSet) Schema() *dcl.Schema {
+	return dclService.DCLResourceRecordSetSchema()
+}
+
+func init() {
+	unstructured.Register(&ResourceRecordSet{})
+}
@@ -228,6 +228,22 @@ func ChannelHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func ChannelFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToChannel(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToChannel(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffChannel(ctx, r, opts...)
+}
+
 func DeleteChannel(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToChannel(u)
@@ -257,7 +273,7 @@ func (r *Channel) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Channel) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Channel) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -289,6 +305,10 @@ func (r *Channel) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return ChannelHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Channel) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ChannelFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Channel) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteChannel(ctx, config, resource)
 }
@@ -297,6 +317,22 @@ func (r *Channel) ID(resource *unstructured.Resource) (string, error) {
 	return ChannelID(resource)
 }
 
+func (r *Channel) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListChannel(ctx, config, project, location)
+}
+
+func (r *Channel) Schema() *dcl.Schema {
+	return dclService.DCLChannelSchema()
+}
+
 func init() {
 	unstructured.Register(&Channel{})
 }
@@ -147,6 +147,22 @@ func GoogleChannelConfigHasDiff(ctx context.Context, config *dcl.Config, u *unst
 	return false, nil
 }
 
+func GoogleChannelConfigFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToGoogleChannelConfig(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToGoogleChannelConfig(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffGoogleChannelConfig(ctx, r, opts...)
+}
+
 func DeleteGoogleChannelConfig(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToGoogleChannelConfig(u)
@@ -176,7 +192,7 @@ func (r *GoogleChannelConfig) SetPolicyMember(ctx context.Context, config *dcl.C
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *GoogleChannelConfig) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *GoogleChannelConfig) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -208,6 +224,10 @@ func (r *GoogleChannelConfig) HasDiff(ctx context.Context, config *dcl.Config, r
 	return GoogleChannelConfigHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *GoogleChannelConfig) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return GoogleChannelConfigFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *GoogleChannelConfig) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteGoogleChannelConfig(ctx, config, resource)
 }
@@ -216,6 +236,14 @@ func (r *GoogleChannelConfig) ID(resource *unstructured.Resource) (string, error
 	return GoogleChannelConfigID(resource)
 }
 
+func (r *GoogleChannelConfig) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *GoogleChannelConfig) Schema() *dcl.Schema {
+	return dclService.DCLGoogleChannelConfigSchema()
+}
+
 func init() {
 	unstructured.Register(&GoogleChannelConfig{})
 }
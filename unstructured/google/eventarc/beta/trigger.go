@@ -471,6 +471,22 @@ func TriggerHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func TriggerFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToTrigger(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToTrigger(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffTrigger(ctx, r, opts...)
+}
+
 func DeleteTrigger(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToTrigger(u)
@@ -500,7 +516,7 @@ func (r *Trigger) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Trigger) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Trigger) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -532,6 +548,10 @@ func (r *Trigger) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return TriggerHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Trigger) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return TriggerFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Trigger) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteTrigger(ctx, config, resource)
 }
@@ -540,6 +560,22 @@ func (r *Trigger) ID(resource *unstructured.Resource) (string, error) {
 	return TriggerID(resource)
 }
 
+func (r *Trigger) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListTrigger(ctx, config, project, location)
+}
+
+func (r *Trigger) Schema() *dcl.Schema {
+	return dclService.DCLTriggerSchema()
+}
+
 func init() {
 	unstructured.Register(&Trigger{})
 }
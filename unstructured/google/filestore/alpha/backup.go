@@ -258,6 +258,22 @@ func BackupHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func BackupFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToBackup(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToBackup(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffBackup(ctx, r, opts...)
+}
+
 func DeleteBackup(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToBackup(u)
@@ -287,7 +303,7 @@ func (r *Backup) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Backup) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Backup) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -319,6 +335,10 @@ func (r *Backup) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return BackupHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Backup) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return BackupFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Backup) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteBackup(ctx, config, resource)
 }
@@ -327,6 +347,22 @@ func (r *Backup) ID(resource *unstructured.Resource) (string, error) {
 	return BackupID(resource)
 }
 
+func (r *Backup) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListBackup(ctx, config, project, location)
+}
+
+func (r *Backup) Schema() *dcl.Schema {
+	return dclService.DCLBackupSchema()
+}
+
 func init() {
 	unstructured.Register(&Backup{})
 }
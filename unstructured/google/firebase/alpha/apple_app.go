@@ -208,6 +208,22 @@ func AppleAppHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Re
 	return false, nil
 }
 
+func AppleAppFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToAppleApp(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToAppleApp(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffAppleApp(ctx, r, opts...)
+}
+
 func DeleteAppleApp(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToAppleApp(u)
@@ -237,7 +253,7 @@ func (r *AppleApp) SetPolicyMember(ctx context.Context, config *dcl.Config, reso
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *AppleApp) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *AppleApp) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -269,6 +285,10 @@ func (r *AppleApp) HasDiff(ctx context.Context, config *dcl.Config, resource *un
 	return AppleAppHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *AppleApp) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return AppleAppFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *AppleApp) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteAppleApp(ctx, config, resource)
 }
@@ -277,6 +297,18 @@ func (r *AppleApp) ID(resource *unstructured.Resource) (string, error) {
 	return AppleAppID(resource)
 }
 
+func (r *AppleApp) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListAppleApp(ctx, config, project)
+}
+
+func (r *AppleApp) Schema() *dcl.Schema {
+	return dclService.DCLAppleAppSchema()
+}
+
 func init() {
 	unstructured.Register(&AppleApp{})
 }
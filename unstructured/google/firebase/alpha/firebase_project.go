@@ -239,6 +239,22 @@ func FirebaseProjectHasDiff(ctx context.Context, config *dcl.Config, u *unstruct
 	return false, nil
 }
 
+func FirebaseProjectFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToFirebaseProject(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToFirebaseProject(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffFirebaseProject(ctx, r, opts...)
+}
+
 func DeleteFirebaseProject(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	return unstructured.ErrNoSuchMethod
 }
@@ -263,7 +279,7 @@ func (r *FirebaseProject) SetPolicyMember(ctx context.Context, config *dcl.Confi
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *FirebaseProject) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *FirebaseProject) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -295,6 +311,10 @@ func (r *FirebaseProject) HasDiff(ctx context.Context, config *dcl.Config, resou
 	return FirebaseProjectHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *FirebaseProject) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return FirebaseProjectFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *FirebaseProject) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteFirebaseProject(ctx, config, resource)
 }
@@ -303,6 +323,14 @@ func (r *FirebaseProject) ID(resource *unstructured.Resource) (string, error) {
 	return FirebaseProjectID(resource)
 }
 
+func (r *FirebaseProject) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return ListFirebaseProject(ctx, config)
+}
+
+func (r *FirebaseProject) Schema() *dcl.Schema {
+	return dclService.DCLFirebaseProjectSchema()
+}
+
 func init() {
 	unstructured.Register(&FirebaseProject{})
 }
@@ -204,6 +204,22 @@ func WebAppHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func WebAppFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToWebApp(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToWebApp(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffWebApp(ctx, r, opts...)
+}
+
 func DeleteWebApp(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToWebApp(u)
@@ -233,7 +249,7 @@ func (r *WebApp) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *WebApp) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *WebApp) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -265,6 +281,10 @@ func (r *WebApp) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return WebAppHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *WebApp) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return WebAppFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *WebApp) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteWebApp(ctx, config, resource)
 }
@@ -273,6 +293,18 @@ func (r *WebApp) ID(resource *unstructured.Resource) (string, error) {
 	return WebAppID(resource)
 }
 
+func (r *WebApp) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListWebApp(ctx, config, project)
+}
+
+func (r *WebApp) Schema() *dcl.Schema {
+	return dclService.DCLWebAppSchema()
+}
+
 func init() {
 	unstructured.Register(&WebApp{})
 }
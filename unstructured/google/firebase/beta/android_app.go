@@ -188,6 +188,22 @@ func AndroidAppHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.
 	return false, nil
 }
 
+func AndroidAppFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToAndroidApp(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToAndroidApp(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffAndroidApp(ctx, r, opts...)
+}
+
 func DeleteAndroidApp(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToAndroidApp(u)
@@ -217,7 +233,7 @@ func (r *AndroidApp) SetPolicyMember(ctx context.Context, config *dcl.Config, re
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *AndroidApp) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *AndroidApp) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -249,6 +265,10 @@ func (r *AndroidApp) HasDiff(ctx context.Context, config *dcl.Config, resource *
 	return AndroidAppHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *AndroidApp) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return AndroidAppFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *AndroidApp) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteAndroidApp(ctx, config, resource)
 }
@@ -257,6 +277,18 @@ func (r *AndroidApp) ID(resource *unstructured.Resource) (string, error) {
 	return AndroidAppID(resource)
 }
 
+func (r *AndroidApp) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListAndroidApp(ctx, config, project)
+}
+
+func (r *AndroidApp) Schema() *dcl.Schema {
+	return dclService.DCLAndroidAppSchema()
+}
+
 func init() {
 	unstructured.Register(&AndroidApp{})
 }
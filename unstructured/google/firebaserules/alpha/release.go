@@ -178,6 +178,22 @@ func ReleaseHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func ReleaseFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToRelease(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToRelease(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffRelease(ctx, r, opts...)
+}
+
 func DeleteRelease(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToRelease(u)
@@ -207,7 +223,7 @@ func (r *Release) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Release) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Release) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -239,6 +255,10 @@ func (r *Release) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return ReleaseHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Release) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ReleaseFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Release) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteRelease(ctx, config, resource)
 }
@@ -247,6 +267,18 @@ func (r *Release) ID(resource *unstructured.Resource) (string, error) {
 	return ReleaseID(resource)
 }
 
+func (r *Release) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListRelease(ctx, config, project)
+}
+
+func (r *Release) Schema() *dcl.Schema {
+	return dclService.DCLReleaseSchema()
+}
+
 func init() {
 	unstructured.Register(&Release{})
 }
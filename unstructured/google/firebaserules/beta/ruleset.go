@@ -244,6 +244,22 @@ func RulesetHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func RulesetFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToRuleset(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToRuleset(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffRuleset(ctx, r, opts...)
+}
+
 func DeleteRuleset(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToRuleset(u)
@@ -273,7 +289,7 @@ func (r *Ruleset) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Ruleset) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Ruleset) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -305,6 +321,10 @@ func (r *Ruleset) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return RulesetHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Ruleset) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return RulesetFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Ruleset) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteRuleset(ctx, config, resource)
 }
@@ -313,6 +333,18 @@ func (r *Ruleset) ID(resource *unstructured.Resource) (string, error) {
 	return RulesetID(resource)
 }
 
+func (r *Ruleset) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListRuleset(ctx, config, project)
+}
+
+func (r *Ruleset) Schema() *dcl.Schema {
+	return dclService.DCLRulesetSchema()
+}
+
 func init() {
 	unstructured.Register(&Ruleset{})
 }
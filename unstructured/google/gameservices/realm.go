@@ -208,6 +208,22 @@ func RealmHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resou
 	return false, nil
 }
 
+func RealmFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToRealm(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToRealm(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffRealm(ctx, r, opts...)
+}
+
 func DeleteRealm(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToRealm(u)
@@ -237,7 +253,7 @@ func (r *Realm) SetPolicyMember(ctx context.Context, config *dcl.Config, resourc
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Realm) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Realm) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -269,6 +285,10 @@ func (r *Realm) HasDiff(ctx context.Context, config *dcl.Config, resource *unstr
 	return RealmHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Realm) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return RealmFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Realm) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteRealm(ctx, config, resource)
 }
@@ -277,6 +297,22 @@ func (r *Realm) ID(resource *unstructured.Resource) (string, error) {
 	return RealmID(resource)
 }
 
+func (r *Realm) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListRealm(ctx, config, project, location)
+}
+
+func (r *Realm) Schema() *dcl.Schema {
+	return dclService.DCLRealmSchema()
+}
+
 func init() {
 	unstructured.Register(&Realm{})
 }
@@ -177,6 +177,22 @@ func FleetHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resou
 	return false, nil
 }
 
+func FleetFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToFleet(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToFleet(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffFleet(ctx, r, opts...)
+}
+
 func DeleteFleet(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToFleet(u)
@@ -206,7 +222,7 @@ func (r *Fleet) SetPolicyMember(ctx context.Context, config *dcl.Config, resourc
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Fleet) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Fleet) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -238,6 +254,10 @@ func (r *Fleet) HasDiff(ctx context.Context, config *dcl.Config, resource *unstr
 	return FleetHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Fleet) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return FleetFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Fleet) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteFleet(ctx, config, resource)
 }
@@ -246,6 +266,14 @@ func (r *Fleet) ID(resource *unstructured.Resource) (string, error) {
 	return FleetID(resource)
 }
 
+func (r *Fleet) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Fleet) Schema() *dcl.Schema {
+	return dclService.DCLFleetSchema()
+}
+
 func init() {
 	unstructured.Register(&Fleet{})
 }
@@ -313,6 +313,22 @@ func FeatureHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func FeatureFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToFeature(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToFeature(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffFeature(ctx, r, opts...)
+}
+
 func DeleteFeature(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToFeature(u)
@@ -342,7 +358,7 @@ func (r *Feature) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Feature) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Feature) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -374,6 +390,10 @@ func (r *Feature) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return FeatureHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Feature) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return FeatureFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Feature) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteFeature(ctx, config, resource)
 }
@@ -382,6 +402,22 @@ func (r *Feature) ID(resource *unstructured.Resource) (string, error) {
 	return FeatureID(resource)
 }
 
+func (r *Feature) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListFeature(ctx, config, project, location)
+}
+
+func (r *Feature) Schema() *dcl.Schema {
+	return dclService.DCLFeatureSchema()
+}
+
 func init() {
 	unstructured.Register(&Feature{})
 }
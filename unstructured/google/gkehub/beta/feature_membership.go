@@ -569,6 +569,22 @@ func FeatureMembershipHasDiff(ctx context.Context, config *dcl.Config, u *unstru
 	return false, nil
 }
 
+func FeatureMembershipFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToFeatureMembership(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToFeatureMembership(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffFeatureMembership(ctx, r, opts...)
+}
+
 func DeleteFeatureMembership(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToFeatureMembership(u)
@@ -598,7 +614,7 @@ func (r *FeatureMembership) SetPolicyMember(ctx context.Context, config *dcl.Con
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *FeatureMembership) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *FeatureMembership) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -630,6 +646,10 @@ func (r *FeatureMembership) HasDiff(ctx context.Context, config *dcl.Config, res
 	return FeatureMembershipHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *FeatureMembership) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return FeatureMembershipFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *FeatureMembership) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteFeatureMembership(ctx, config, resource)
 }
@@ -638,6 +658,26 @@ func (r *FeatureMembership) ID(resource *unstructured.Resource) (string, error)
 	return FeatureMembershipID(resource)
 }
 
+func (r *FeatureMembership) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	feature, err := unstructured.StringField(resource, "feature")
+	if err != nil {
+		return nil, err
+	}
+	return ListFeatureMembership(ctx, config, project, location, feature)
+}
+
+func (r *FeatureMembership) Schema() *dcl.Schema {
+	return dclService.DCLFeatureMembershipSchema()
+}
+
 func init() {
 	unstructured.Register(&FeatureMembership{})
 }
@@ -281,6 +281,22 @@ func RoleHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resour
 	return false, nil
 }
 
+func RoleFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToRole(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToRole(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffRole(ctx, r, opts...)
+}
+
 func DeleteRole(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToRole(u)
@@ -310,7 +326,7 @@ func (r *Role) SetPolicyMember(ctx context.Context, config *dcl.Config, resource
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Role) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Role) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -342,6 +358,10 @@ func (r *Role) HasDiff(ctx context.Context, config *dcl.Config, resource *unstru
 	return RoleHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Role) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return RoleFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Role) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteRole(ctx, config, resource)
 }
@@ -350,6 +370,18 @@ func (r *Role) ID(resource *unstructured.Resource) (string, error) {
 	return RoleID(resource)
 }
 
+func (r *Role) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListRole(ctx, config, parent)
+}
+
+func (r *Role) Schema() *dcl.Schema {
+	return dclService.DCLRoleSchema()
+}
+
 func init() {
 	unstructured.Register(&Role{})
 }
@@ -270,6 +270,22 @@ func WorkforcePoolProviderHasDiff(ctx context.Context, config *dcl.Config, u *un
 	return false, nil
 }
 
+func WorkforcePoolProviderFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToWorkforcePoolProvider(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToWorkforcePoolProvider(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffWorkforcePoolProvider(ctx, r, opts...)
+}
+
 func DeleteWorkforcePoolProvider(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToWorkforcePoolProvider(u)
@@ -299,7 +315,7 @@ func (r *WorkforcePoolProvider) SetPolicyMember(ctx context.Context, config *dcl
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *WorkforcePoolProvider) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *WorkforcePoolProvider) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -331,6 +347,10 @@ func (r *WorkforcePoolProvider) HasDiff(ctx context.Context, config *dcl.Config,
 	return WorkforcePoolProviderHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *WorkforcePoolProvider) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return WorkforcePoolProviderFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *WorkforcePoolProvider) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteWorkforcePoolProvider(ctx, config, resource)
 }
@@ -339,6 +359,22 @@ func (r *WorkforcePoolProvider) ID(resource *unstructured.Resource) (string, err
 	return WorkforcePoolProviderID(resource)
 }
 
+func (r *WorkforcePoolProvider) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	workforcePool, err := unstructured.StringField(resource, "workforcePool")
+	if err != nil {
+		return nil, err
+	}
+	return ListWorkforcePoolProvider(ctx, config, location, workforcePool)
+}
+
+func (r *WorkforcePoolProvider) Schema() *dcl.Schema {
+	return dclService.DCLWorkforcePoolProviderSchema()
+}
+
 func init() {
 	unstructured.Register(&WorkforcePoolProvider{})
 }
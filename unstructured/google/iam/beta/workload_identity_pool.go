@@ -188,6 +188,22 @@ func WorkloadIdentityPoolHasDiff(ctx context.Context, config *dcl.Config, u *uns
 	return false, nil
 }
 
+func WorkloadIdentityPoolFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToWorkloadIdentityPool(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToWorkloadIdentityPool(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffWorkloadIdentityPool(ctx, r, opts...)
+}
+
 func DeleteWorkloadIdentityPool(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToWorkloadIdentityPool(u)
@@ -217,7 +233,7 @@ func (r *WorkloadIdentityPool) SetPolicyMember(ctx context.Context, config *dcl.
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *WorkloadIdentityPool) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *WorkloadIdentityPool) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -249,6 +265,10 @@ func (r *WorkloadIdentityPool) HasDiff(ctx context.Context, config *dcl.Config,
 	return WorkloadIdentityPoolHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *WorkloadIdentityPool) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return WorkloadIdentityPoolFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *WorkloadIdentityPool) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteWorkloadIdentityPool(ctx, config, resource)
 }
@@ -257,6 +277,22 @@ func (r *WorkloadIdentityPool) ID(resource *unstructured.Resource) (string, erro
 	return WorkloadIdentityPoolID(resource)
 }
 
+func (r *WorkloadIdentityPool) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListWorkloadIdentityPool(ctx, config, project, location)
+}
+
+func (r *WorkloadIdentityPool) Schema() *dcl.Schema {
+	return dclService.DCLWorkloadIdentityPoolSchema()
+}
+
 func init() {
 	unstructured.Register(&WorkloadIdentityPool{})
 }
@@ -158,6 +158,12 @@ func PolicyHasDiff(_ context.Context, _ *dcl.Config, _ *unstructured.Resource, _
 	return false, unstructured.ErrNoSuchMethod
 }
 
+// PolicyFieldDiffs is an empty function that will return an error because these types are meant
+// to be handled by the resources that the policies apply to rather than by explicit policy resources.
+func PolicyFieldDiffs(_ context.Context, _ *dcl.Config, _ *unstructured.Resource, _ ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
 // DeletePolicy is an empty function that will return an error because these types are meant
 // to be handled by the resources that the policies apply to rather than by explicit policy resources.
 func DeletePolicy(_ context.Context, _ *dcl.Config, _ *unstructured.Resource) error {
@@ -190,7 +196,7 @@ func PolicySetPolicyWithEtag(_ context.Context, _ *dcl.Config, _ *unstructured.R
 
 // PolicyGetPolicyMember is an empty function that will return an error because these types are meant
 // to be handled by the resources that the policies apply to rather than by explicit policy resources.
-func PolicyGetPolicyMember(_ context.Context, _ *dcl.Config, _ *unstructured.Resource, _, _ string) (*unstructured.Resource, error) {
+func PolicyGetPolicyMember(_ context.Context, _ *dcl.Config, _ *unstructured.Resource, _ *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -206,6 +212,12 @@ func PolicyDeletePolicyMember(_ context.Context, _ *dcl.Config, _ *unstructured.
 	return unstructured.ErrNoSuchMethod
 }
 
+// ListPolicy is an empty function that will return an error because these types are meant
+// to be handled by the resources that the policies apply to rather than by explicit policy resources.
+func ListPolicy(_ context.Context, _ *dcl.Config, _ *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
 // STV returns the ServiceTypeVersion of the IAM Policy resource.
 func (r *Policy) STV() unstructured.ServiceTypeVersion {
 	return unstructured.ServiceTypeVersion{
@@ -230,6 +242,11 @@ func (r *Policy) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return PolicyHasDiff(ctx, config, resource, opts...)
 }
 
+// FieldDiffs calls the empty PolicyFieldDiffs function.
+func (r *Policy) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return PolicyFieldDiffs(ctx, config, resource, opts...)
+}
+
 // Delete calls the empty DeletePolicy function.
 func (r *Policy) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeletePolicy(ctx, config, resource)
@@ -256,8 +273,8 @@ func (r *Policy) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, reso
 }
 
 // GetPolicyMember calls the empty PolicyGetPolicyMember function.
-func (r *Policy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return PolicyGetPolicyMember(ctx, config, resource, role, member)
+func (r *Policy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return PolicyGetPolicyMember(ctx, config, resource, member)
 }
 
 // SetPolicyMember calls the empty PolicySetPolicyMember function.
@@ -270,6 +287,16 @@ func (r *Policy) DeletePolicyMember(ctx context.Context, config *dcl.Config, res
 	return PolicyDeletePolicyMember(ctx, config, resource, member)
 }
 
+// List calls the empty ListPolicy function.
+func (r *Policy) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return ListPolicy(ctx, config, resource)
+}
+
+// Schema returns nil, as IAM Policy has no DCL schema.
+func (r *Policy) Schema() *dcl.Schema {
+	return nil
+}
+
 func init() {
 	unstructured.Register(&Policy{})
 }
@@ -41,6 +41,19 @@ func MemberToUnstructured(r *iamDCL.Member) *unstructured.Resource {
 	if r.Member != nil {
 		u.Object["member"] = string(*r.Member)
 	}
+	if r.Condition != nil {
+		rCondition := make(map[string]interface{})
+		if r.Condition.Title != nil {
+			rCondition["title"] = string(*r.Condition.Title)
+		}
+		if r.Condition.Description != nil {
+			rCondition["description"] = string(*r.Condition.Description)
+		}
+		if r.Condition.Expression != nil {
+			rCondition["expression"] = string(*r.Condition.Expression)
+		}
+		u.Object["condition"] = rCondition
+	}
 	return u
 }
 
@@ -61,6 +74,22 @@ func UnstructuredToMember(u *unstructured.Resource) (*iamDCL.Member, error) {
 		}
 		r.Member = dcl.String(s)
 	}
+	if _, ok := u.Object["condition"]; ok {
+		rCondition, ok := u.Object["condition"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("r.condition: expected map[string]interface{}")
+		}
+		r.Condition = &iamDCL.Condition{}
+		if s, ok := rCondition["title"].(string); ok {
+			r.Condition.Title = dcl.String(s)
+		}
+		if s, ok := rCondition["description"].(string); ok {
+			r.Condition.Description = dcl.String(s)
+		}
+		if s, ok := rCondition["expression"].(string); ok {
+			r.Condition.Expression = dcl.String(s)
+		}
+	}
 	return r, nil
 }
 
@@ -82,6 +111,12 @@ func MemberHasDiff(_ context.Context, _ *dcl.Config, _ *unstructured.Resource, _
 	return false, unstructured.ErrNoSuchMethod
 }
 
+// MemberFieldDiffs is an empty function that will return an error because these types are meant
+// to be handled by the resources that the policies apply to rather than by explicit policy resources.
+func MemberFieldDiffs(_ context.Context, _ *dcl.Config, _ *unstructured.Resource, _ ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
 // DeleteMember is an empty function that will return an error because these types are meant
 // to be handled by the resources that the policies apply to rather than by explicit policy resources.
 func DeleteMember(_ context.Context, _ *dcl.Config, _ *unstructured.Resource) error {
@@ -114,7 +149,7 @@ func MemberSetPolicyWithEtag(_ context.Context, _ *dcl.Config, _ *unstructured.R
 
 // MemberGetPolicyMember is an empty function that will return an error because these types are meant
 // to be handled by the resources that the policies apply to rather than by explicit policy resources.
-func MemberGetPolicyMember(_ context.Context, _ *dcl.Config, _ *unstructured.Resource, _, _ string) (*unstructured.Resource, error) {
+func MemberGetPolicyMember(_ context.Context, _ *dcl.Config, _ *unstructured.Resource, _ *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -130,6 +165,12 @@ func MemberDeletePolicyMember(_ context.Context, _ *dcl.Config, _ *unstructured.
 	return unstructured.ErrNoSuchMethod
 }
 
+// ListMember is an empty function that will return an error because these types are meant
+// to be handled by the resources that the policies apply to rather than by explicit policy resources.
+func ListMember(_ context.Context, _ *dcl.Config, _ *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
 // STV returns the ServiceTypeVersion of the IAM PolicyMember resource.
 func (r *Member) STV() unstructured.ServiceTypeVersion {
 	return unstructured.ServiceTypeVersion{
@@ -154,6 +195,11 @@ func (r *Member) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return MemberHasDiff(ctx, config, resource, opts...)
 }
 
+// FieldDiffs calls the empty MemberFieldDiffs function.
+func (r *Member) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return MemberFieldDiffs(ctx, config, resource, opts...)
+}
+
 // Delete calls the empty DeleteMember function.
 func (r *Member) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteMember(ctx, config, resource)
@@ -180,8 +226,8 @@ func (r *Member) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, reso
 }
 
 // GetPolicyMember calls the empty MemberGetPolicyMember function.
-func (r *Member) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return MemberGetPolicyMember(ctx, config, resource, role, member)
+func (r *Member) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return MemberGetPolicyMember(ctx, config, resource, member)
 }
 
 // SetPolicyMember calls the empty MemberSetPolicyMember function.
@@ -194,6 +240,16 @@ func (r *Member) DeletePolicyMember(ctx context.Context, config *dcl.Config, res
 	return MemberDeletePolicyMember(ctx, config, resource, member)
 }
 
+// List calls the empty ListMember function.
+func (r *Member) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return ListMember(ctx, config, resource)
+}
+
+// Schema returns nil, as IAM Member has no DCL schema.
+func (r *Member) Schema() *dcl.Schema {
+	return nil
+}
+
 func init() {
 	unstructured.Register(&Member{})
 }
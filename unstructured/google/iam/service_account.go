@@ -238,6 +238,22 @@ func ServiceAccountHasDiff(ctx context.Context, config *dcl.Config, u *unstructu
 	return false, nil
 }
 
+func ServiceAccountFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToServiceAccount(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToServiceAccount(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffServiceAccount(ctx, r, opts...)
+}
+
 func DeleteServiceAccount(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToServiceAccount(u)
@@ -330,13 +346,17 @@ func SetPolicyMemberServiceAccount(ctx context.Context, config *dcl.Config, u *u
 	return PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberServiceAccount(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberServiceAccount(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToServiceAccount(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -364,8 +384,8 @@ func (r *ServiceAccount) SetPolicyMember(ctx context.Context, config *dcl.Config
 	return SetPolicyMemberServiceAccount(ctx, config, resource, member)
 }
 
-func (r *ServiceAccount) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberServiceAccount(ctx, config, resource, role, member)
+func (r *ServiceAccount) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberServiceAccount(ctx, config, resource, member)
 }
 
 func (r *ServiceAccount) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -396,6 +416,10 @@ func (r *ServiceAccount) HasDiff(ctx context.Context, config *dcl.Config, resour
 	return ServiceAccountHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *ServiceAccount) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ServiceAccountFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *ServiceAccount) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteServiceAccount(ctx, config, resource)
 }
@@ -404,6 +428,18 @@ func (r *ServiceAccount) ID(resource *unstructured.Resource) (string, error) {
 	return ServiceAccountID(resource)
 }
 
+func (r *ServiceAccount) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListServiceAccount(ctx, config, project)
+}
+
+func (r *ServiceAccount) Schema() *dcl.Schema {
+	return dclService.DCLServiceAccountSchema()
+}
+
 func init() {
 	unstructured.Register(&ServiceAccount{})
 }
@@ -209,6 +209,22 @@ func WorkforcePoolHasDiff(ctx context.Context, config *dcl.Config, u *unstructur
 	return false, nil
 }
 
+func WorkforcePoolFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToWorkforcePool(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToWorkforcePool(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffWorkforcePool(ctx, r, opts...)
+}
+
 func DeleteWorkforcePool(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToWorkforcePool(u)
@@ -301,13 +317,17 @@ func SetPolicyMemberWorkforcePool(ctx context.Context, config *dcl.Config, u *un
 	return PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberWorkforcePool(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberWorkforcePool(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToWorkforcePool(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -335,8 +355,8 @@ func (r *WorkforcePool) SetPolicyMember(ctx context.Context, config *dcl.Config,
 	return SetPolicyMemberWorkforcePool(ctx, config, resource, member)
 }
 
-func (r *WorkforcePool) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberWorkforcePool(ctx, config, resource, role, member)
+func (r *WorkforcePool) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberWorkforcePool(ctx, config, resource, member)
 }
 
 func (r *WorkforcePool) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -367,6 +387,10 @@ func (r *WorkforcePool) HasDiff(ctx context.Context, config *dcl.Config, resourc
 	return WorkforcePoolHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *WorkforcePool) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return WorkforcePoolFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *WorkforcePool) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteWorkforcePool(ctx, config, resource)
 }
@@ -375,6 +399,22 @@ func (r *WorkforcePool) ID(resource *unstructured.Resource) (string, error) {
 	return WorkforcePoolID(resource)
 }
 
+func (r *WorkforcePool) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListWorkforcePool(ctx, config, location, parent)
+}
+
+func (r *WorkforcePool) Schema() *dcl.Schema {
+	return dclService.DCLWorkforcePoolSchema()
+}
+
 func init() {
 	unstructured.Register(&WorkforcePool{})
 }
@@ -302,6 +302,22 @@ func WorkloadIdentityPoolProviderHasDiff(ctx context.Context, config *dcl.Config
 	return false, nil
 }
 
+func WorkloadIdentityPoolProviderFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToWorkloadIdentityPoolProvider(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToWorkloadIdentityPoolProvider(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffWorkloadIdentityPoolProvider(ctx, r, opts...)
+}
+
 func DeleteWorkloadIdentityPoolProvider(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToWorkloadIdentityPoolProvider(u)
@@ -331,7 +347,7 @@ func (r *WorkloadIdentityPoolProvider) SetPolicyMember(ctx context.Context, conf
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *WorkloadIdentityPoolProvider) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *WorkloadIdentityPoolProvider) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -363,6 +379,10 @@ func (r *WorkloadIdentityPoolProvider) HasDiff(ctx context.Context, config *dcl.
 	return WorkloadIdentityPoolProviderHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *WorkloadIdentityPoolProvider) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return WorkloadIdentityPoolProviderFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *WorkloadIdentityPoolProvider) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteWorkloadIdentityPoolProvider(ctx, config, resource)
 }
@@ -371,6 +391,26 @@ func (r *WorkloadIdentityPoolProvider) ID(resource *unstructured.Resource) (stri
 	return WorkloadIdentityPoolProviderID(resource)
 }
 
+func (r *WorkloadIdentityPoolProvider) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	workloadIdentityPool, err := unstructured.StringField(resource, "workloadIdentityPool")
+	if err != nil {
+		return nil, err
+	}
+	return ListWorkloadIdentityPoolProvider(ctx, config, project, location, workloadIdentityPool)
+}
+
+func (r *WorkloadIdentityPoolProvider) Schema() *dcl.Schema {
+	return dclService.DCLWorkloadIdentityPoolProviderSchema()
+}
+
 func init() {
 	unstructured.Register(&WorkloadIdentityPoolProvider{})
 }
@@ -168,6 +168,22 @@ func IdentityAwareProxyClientHasDiff(ctx context.Context, config *dcl.Config, u
 	return false, nil
 }
 
+func IdentityAwareProxyClientFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToIdentityAwareProxyClient(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToIdentityAwareProxyClient(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffIdentityAwareProxyClient(ctx, r, opts...)
+}
+
 func DeleteIdentityAwareProxyClient(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToIdentityAwareProxyClient(u)
@@ -197,7 +213,7 @@ func (r *IdentityAwareProxyClient) SetPolicyMember(ctx context.Context, config *
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *IdentityAwareProxyClient) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *IdentityAwareProxyClient) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -229,6 +245,10 @@ func (r *IdentityAwareProxyClient) HasDiff(ctx context.Context, config *dcl.Conf
 	return IdentityAwareProxyClientHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *IdentityAwareProxyClient) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return IdentityAwareProxyClientFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *IdentityAwareProxyClient) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteIdentityAwareProxyClient(ctx, config, resource)
 }
@@ -237,6 +257,22 @@ func (r *IdentityAwareProxyClient) ID(resource *unstructured.Resource) (string,
 	return IdentityAwareProxyClientID(resource)
 }
 
+func (r *IdentityAwareProxyClient) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	brand, err := unstructured.StringField(resource, "brand")
+	if err != nil {
+		return nil, err
+	}
+	return ListIdentityAwareProxyClient(ctx, config, project, brand)
+}
+
+func (r *IdentityAwareProxyClient) Schema() *dcl.Schema {
+	return dclService.DCLIdentityAwareProxyClientSchema()
+}
+
 func init() {
 	unstructured.Register(&IdentityAwareProxyClient{})
 }
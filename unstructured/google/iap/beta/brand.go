@@ -168,6 +168,22 @@ func BrandHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resou
 	return false, nil
 }
 
+func BrandFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToBrand(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToBrand(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffBrand(ctx, r, opts...)
+}
+
 func DeleteBrand(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	return unstructured.ErrNoSuchMethod
 }
@@ -192,7 +208,7 @@ func (r *Brand) SetPolicyMember(ctx context.Context, config *dcl.Config, resourc
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Brand) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Brand) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -224,6 +240,10 @@ func (r *Brand) HasDiff(ctx context.Context, config *dcl.Config, resource *unstr
 	return BrandHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Brand) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return BrandFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Brand) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteBrand(ctx, config, resource)
 }
@@ -232,6 +252,18 @@ func (r *Brand) ID(resource *unstructured.Resource) (string, error) {
 	return BrandID(resource)
 }
 
+func (r *Brand) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListBrand(ctx, config, project)
+}
+
+func (r *Brand) Schema() *dcl.Schema {
+	return dclService.DCLBrandSchema()
+}
+
 func init() {
 	unstructured.Register(&Brand{})
 }
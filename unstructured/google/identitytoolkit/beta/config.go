@@ -1172,6 +1172,22 @@ func ConfigHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func ConfigFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToConfig(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToConfig(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffConfig(ctx, r, opts...)
+}
+
 func DeleteConfig(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	return unstructured.ErrNoSuchMethod
 }
@@ -1196,7 +1212,7 @@ func (r *Config) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Config) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Config) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -1228,6 +1244,10 @@ func (r *Config) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return ConfigHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Config) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ConfigFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Config) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteConfig(ctx, config, resource)
 }
@@ -1236,6 +1256,14 @@ func (r *Config) ID(resource *unstructured.Resource) (string, error) {
 	return ConfigID(resource)
 }
 
+func (r *Config) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Config) Schema() *dcl.Schema {
+	return dclService.DCLConfigSchema()
+}
+
 func init() {
 	unstructured.Register(&Config{})
 }
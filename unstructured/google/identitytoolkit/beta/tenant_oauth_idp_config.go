@@ -239,6 +239,22 @@ func TenantOAuthIdpConfigHasDiff(ctx context.Context, config *dcl.Config, u *uns
 	return false, nil
 }
 
+func TenantOAuthIdpConfigFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToTenantOAuthIdpConfig(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToTenantOAuthIdpConfig(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffTenantOAuthIdpConfig(ctx, r, opts...)
+}
+
 func DeleteTenantOAuthIdpConfig(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToTenantOAuthIdpConfig(u)
@@ -268,7 +284,7 @@ func (r *TenantOAuthIdpConfig) SetPolicyMember(ctx context.Context, config *dcl.
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *TenantOAuthIdpConfig) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *TenantOAuthIdpConfig) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -300,6 +316,10 @@ func (r *TenantOAuthIdpConfig) HasDiff(ctx context.Context, config *dcl.Config,
 	return TenantOAuthIdpConfigHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *TenantOAuthIdpConfig) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return TenantOAuthIdpConfigFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *TenantOAuthIdpConfig) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteTenantOAuthIdpConfig(ctx, config, resource)
 }
@@ -308,6 +328,22 @@ func (r *TenantOAuthIdpConfig) ID(resource *unstructured.Resource) (string, erro
 	return TenantOAuthIdpConfigID(resource)
 }
 
+func (r *TenantOAuthIdpConfig) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	tenant, err := unstructured.StringField(resource, "tenant")
+	if err != nil {
+		return nil, err
+	}
+	return ListTenantOAuthIdpConfig(ctx, config, project, tenant)
+}
+
+func (r *TenantOAuthIdpConfig) Schema() *dcl.Schema {
+	return dclService.DCLTenantOAuthIdpConfigSchema()
+}
+
 func init() {
 	unstructured.Register(&TenantOAuthIdpConfig{})
 }
@@ -229,6 +229,22 @@ func OAuthIdpConfigHasDiff(ctx context.Context, config *dcl.Config, u *unstructu
 	return false, nil
 }
 
+func OAuthIdpConfigFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToOAuthIdpConfig(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToOAuthIdpConfig(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffOAuthIdpConfig(ctx, r, opts...)
+}
+
 func DeleteOAuthIdpConfig(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToOAuthIdpConfig(u)
@@ -258,7 +274,7 @@ func (r *OAuthIdpConfig) SetPolicyMember(ctx context.Context, config *dcl.Config
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *OAuthIdpConfig) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *OAuthIdpConfig) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -290,6 +306,10 @@ func (r *OAuthIdpConfig) HasDiff(ctx context.Context, config *dcl.Config, resour
 	return OAuthIdpConfigHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *OAuthIdpConfig) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return OAuthIdpConfigFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *OAuthIdpConfig) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteOAuthIdpConfig(ctx, config, resource)
 }
@@ -298,6 +318,18 @@ func (r *OAuthIdpConfig) ID(resource *unstructured.Resource) (string, error) {
 	return OAuthIdpConfigID(resource)
 }
 
+func (r *OAuthIdpConfig) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListOAuthIdpConfig(ctx, config, project)
+}
+
+func (r *OAuthIdpConfig) Schema() *dcl.Schema {
+	return dclService.DCLOAuthIdpConfigSchema()
+}
+
 func init() {
 	unstructured.Register(&OAuthIdpConfig{})
 }
@@ -245,6 +245,22 @@ func TenantHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func TenantFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToTenant(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToTenant(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffTenant(ctx, r, opts...)
+}
+
 func DeleteTenant(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToTenant(u)
@@ -274,7 +290,7 @@ func (r *Tenant) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Tenant) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Tenant) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -306,6 +322,10 @@ func (r *Tenant) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return TenantHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Tenant) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return TenantFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Tenant) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteTenant(ctx, config, resource)
 }
@@ -314,6 +334,18 @@ func (r *Tenant) ID(resource *unstructured.Resource) (string, error) {
 	return TenantID(resource)
 }
 
+func (r *Tenant) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListTenant(ctx, config, project)
+}
+
+func (r *Tenant) Schema() *dcl.Schema {
+	return dclService.DCLTenantSchema()
+}
+
 func init() {
 	unstructured.Register(&Tenant{})
 }
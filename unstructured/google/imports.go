@@ -79,9 +79,11 @@ import (
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/dataproc"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/dataproc/alpha"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/dataproc/beta"
+	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/datastore"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/dlp"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/dlp/alpha"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/dlp/beta"
+	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/dns"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/eventarc"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/eventarc/alpha"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/eventarc/beta"
@@ -136,7 +138,14 @@ import (
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/recaptchaenterprise"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/recaptchaenterprise/alpha"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/recaptchaenterprise/beta"
+	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/run"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/run/alpha"
+	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/run/beta"
+	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/redis"
+	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/redis/beta"
+	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/spanner"
+	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/sql"
+	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/sql/beta"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/storage"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/storage/alpha"
 	_ "github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured/google/storage/beta"
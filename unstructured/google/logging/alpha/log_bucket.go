@@ -208,6 +208,22 @@ func LogBucketHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.R
 	return false, nil
 }
 
+func LogBucketFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToLogBucket(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToLogBucket(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffLogBucket(ctx, r, opts...)
+}
+
 func DeleteLogBucket(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToLogBucket(u)
@@ -237,7 +253,7 @@ func (r *LogBucket) SetPolicyMember(ctx context.Context, config *dcl.Config, res
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *LogBucket) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *LogBucket) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -269,6 +285,10 @@ func (r *LogBucket) HasDiff(ctx context.Context, config *dcl.Config, resource *u
 	return LogBucketHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *LogBucket) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return LogBucketFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *LogBucket) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteLogBucket(ctx, config, resource)
 }
@@ -277,6 +297,22 @@ func (r *LogBucket) ID(resource *unstructured.Resource) (string, error) {
 	return LogBucketID(resource)
 }
 
+func (r *LogBucket) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListLogBucket(ctx, config, location, parent)
+}
+
+func (r *LogBucket) Schema() *dcl.Schema {
+	return dclService.DCLLogBucketSchema()
+}
+
 func init() {
 	unstructured.Register(&LogBucket{})
 }
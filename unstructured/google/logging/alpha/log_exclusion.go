@@ -188,6 +188,22 @@ func LogExclusionHasDiff(ctx context.Context, config *dcl.Config, u *unstructure
 	return false, nil
 }
 
+func LogExclusionFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToLogExclusion(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToLogExclusion(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffLogExclusion(ctx, r, opts...)
+}
+
 func DeleteLogExclusion(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToLogExclusion(u)
@@ -217,7 +233,7 @@ func (r *LogExclusion) SetPolicyMember(ctx context.Context, config *dcl.Config,
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *LogExclusion) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *LogExclusion) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -249,6 +265,10 @@ func (r *LogExclusion) HasDiff(ctx context.Context, config *dcl.Config, resource
 	return LogExclusionHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *LogExclusion) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return LogExclusionFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *LogExclusion) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteLogExclusion(ctx, config, resource)
 }
@@ -257,6 +277,18 @@ func (r *LogExclusion) ID(resource *unstructured.Resource) (string, error) {
 	return LogExclusionID(resource)
 }
 
+func (r *LogExclusion) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListLogExclusion(ctx, config, parent)
+}
+
+func (r *LogExclusion) Schema() *dcl.Schema {
+	return dclService.DCLLogExclusionSchema()
+}
+
 func init() {
 	unstructured.Register(&LogExclusion{})
 }
@@ -198,6 +198,22 @@ func LogViewHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func LogViewFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToLogView(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToLogView(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffLogView(ctx, r, opts...)
+}
+
 func DeleteLogView(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToLogView(u)
@@ -227,7 +243,7 @@ func (r *LogView) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *LogView) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *LogView) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -259,6 +275,10 @@ func (r *LogView) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return LogViewHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *LogView) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return LogViewFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *LogView) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteLogView(ctx, config, resource)
 }
@@ -267,6 +287,26 @@ func (r *LogView) ID(resource *unstructured.Resource) (string, error) {
 	return LogViewID(resource)
 }
 
+func (r *LogView) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := unstructured.StringField(resource, "bucket")
+	if err != nil {
+		return nil, err
+	}
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListLogView(ctx, config, location, bucket, parent)
+}
+
+func (r *LogView) Schema() *dcl.Schema {
+	return dclService.DCLLogViewSchema()
+}
+
 func init() {
 	unstructured.Register(&LogView{})
 }
@@ -524,6 +524,22 @@ func LogMetricHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.R
 	return false, nil
 }
 
+func LogMetricFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToLogMetric(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToLogMetric(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffLogMetric(ctx, r, opts...)
+}
+
 func DeleteLogMetric(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToLogMetric(u)
@@ -553,7 +569,7 @@ func (r *LogMetric) SetPolicyMember(ctx context.Context, config *dcl.Config, res
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *LogMetric) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *LogMetric) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -585,6 +601,10 @@ func (r *LogMetric) HasDiff(ctx context.Context, config *dcl.Config, resource *u
 	return LogMetricHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *LogMetric) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return LogMetricFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *LogMetric) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteLogMetric(ctx, config, resource)
 }
@@ -593,6 +613,18 @@ func (r *LogMetric) ID(resource *unstructured.Resource) (string, error) {
 	return LogMetricID(resource)
 }
 
+func (r *LogMetric) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListLogMetric(ctx, config, project)
+}
+
+func (r *LogMetric) Schema() *dcl.Schema {
+	return dclService.DCLLogMetricSchema()
+}
+
 func init() {
 	unstructured.Register(&LogMetric{})
 }
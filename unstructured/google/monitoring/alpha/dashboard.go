@@ -5897,6 +5897,22 @@ func DashboardHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.R
 	return false, nil
 }
 
+func DashboardFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDashboard(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToDashboard(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffDashboard(ctx, r, opts...)
+}
+
 func DeleteDashboard(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToDashboard(u)
@@ -5926,7 +5942,7 @@ func (r *Dashboard) SetPolicyMember(ctx context.Context, config *dcl.Config, res
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Dashboard) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Dashboard) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -5958,6 +5974,10 @@ func (r *Dashboard) HasDiff(ctx context.Context, config *dcl.Config, resource *u
 	return DashboardHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Dashboard) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return DashboardFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Dashboard) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteDashboard(ctx, config, resource)
 }
@@ -5966,6 +5986,18 @@ func (r *Dashboard) ID(resource *unstructured.Resource) (string, error) {
 	return DashboardID(resource)
 }
 
+func (r *Dashboard) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListDashboard(ctx, config, project)
+}
+
+func (r *Dashboard) Schema() *dcl.Schema {
+	return dclService.DCLDashboardSchema()
+}
+
 func init() {
 	unstructured.Register(&Dashboard{})
 }
@@ -228,6 +228,22 @@ func NotificationChannelHasDiff(ctx context.Context, config *dcl.Config, u *unst
 	return false, nil
 }
 
+func NotificationChannelFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToNotificationChannel(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToNotificationChannel(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffNotificationChannel(ctx, r, opts...)
+}
+
 func DeleteNotificationChannel(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToNotificationChannel(u)
@@ -257,7 +273,7 @@ func (r *NotificationChannel) SetPolicyMember(ctx context.Context, config *dcl.C
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *NotificationChannel) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *NotificationChannel) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -289,6 +305,10 @@ func (r *NotificationChannel) HasDiff(ctx context.Context, config *dcl.Config, r
 	return NotificationChannelHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *NotificationChannel) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return NotificationChannelFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *NotificationChannel) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteNotificationChannel(ctx, config, resource)
 }
@@ -297,6 +317,18 @@ func (r *NotificationChannel) ID(resource *unstructured.Resource) (string, error
 	return NotificationChannelID(resource)
 }
 
+func (r *NotificationChannel) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListNotificationChannel(ctx, config, project)
+}
+
+func (r *NotificationChannel) Schema() *dcl.Schema {
+	return dclService.DCLNotificationChannelSchema()
+}
+
 func init() {
 	unstructured.Register(&NotificationChannel{})
 }
@@ -313,6 +313,22 @@ func MetricDescriptorHasDiff(ctx context.Context, config *dcl.Config, u *unstruc
 	return false, nil
 }
 
+func MetricDescriptorFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToMetricDescriptor(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToMetricDescriptor(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffMetricDescriptor(ctx, r, opts...)
+}
+
 func DeleteMetricDescriptor(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToMetricDescriptor(u)
@@ -342,7 +358,7 @@ func (r *MetricDescriptor) SetPolicyMember(ctx context.Context, config *dcl.Conf
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *MetricDescriptor) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *MetricDescriptor) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -374,6 +390,10 @@ func (r *MetricDescriptor) HasDiff(ctx context.Context, config *dcl.Config, reso
 	return MetricDescriptorHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *MetricDescriptor) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return MetricDescriptorFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *MetricDescriptor) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteMetricDescriptor(ctx, config, resource)
 }
@@ -382,6 +402,18 @@ func (r *MetricDescriptor) ID(resource *unstructured.Resource) (string, error) {
 	return MetricDescriptorID(resource)
 }
 
+func (r *MetricDescriptor) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListMetricDescriptor(ctx, config, project)
+}
+
+func (r *MetricDescriptor) Schema() *dcl.Schema {
+	return dclService.DCLMetricDescriptorSchema()
+}
+
 func init() {
 	unstructured.Register(&MetricDescriptor{})
 }
@@ -165,6 +165,22 @@ func MetricsScopeHasDiff(ctx context.Context, config *dcl.Config, u *unstructure
 	return false, nil
 }
 
+func MetricsScopeFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToMetricsScope(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToMetricsScope(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffMetricsScope(ctx, r, opts...)
+}
+
 func DeleteMetricsScope(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	return unstructured.ErrNoSuchMethod
 }
@@ -189,7 +205,7 @@ func (r *MetricsScope) SetPolicyMember(ctx context.Context, config *dcl.Config,
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *MetricsScope) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *MetricsScope) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -221,6 +237,10 @@ func (r *MetricsScope) HasDiff(ctx context.Context, config *dcl.Config, resource
 	return MetricsScopeHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *MetricsScope) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return MetricsScopeFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *MetricsScope) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteMetricsScope(ctx, config, resource)
 }
@@ -229,6 +249,14 @@ func (r *MetricsScope) ID(resource *unstructured.Resource) (string, error) {
 	return MetricsScopeID(resource)
 }
 
+func (r *MetricsScope) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *MetricsScope) Schema() *dcl.Schema {
+	return dclService.DCLMetricsScopeSchema()
+}
+
 func init() {
 	unstructured.Register(&MetricsScope{})
 }
@@ -148,6 +148,22 @@ func MonitoredProjectHasDiff(ctx context.Context, config *dcl.Config, u *unstruc
 	return false, nil
 }
 
+func MonitoredProjectFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToMonitoredProject(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToMonitoredProject(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffMonitoredProject(ctx, r, opts...)
+}
+
 func DeleteMonitoredProject(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToMonitoredProject(u)
@@ -177,7 +193,7 @@ func (r *MonitoredProject) SetPolicyMember(ctx context.Context, config *dcl.Conf
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *MonitoredProject) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *MonitoredProject) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -209,6 +225,10 @@ func (r *MonitoredProject) HasDiff(ctx context.Context, config *dcl.Config, reso
 	return MonitoredProjectHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *MonitoredProject) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return MonitoredProjectFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *MonitoredProject) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteMonitoredProject(ctx, config, resource)
 }
@@ -217,6 +237,18 @@ func (r *MonitoredProject) ID(resource *unstructured.Resource) (string, error) {
 	return MonitoredProjectID(resource)
 }
 
+func (r *MonitoredProject) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	metricsScope, err := unstructured.StringField(resource, "metricsScope")
+	if err != nil {
+		return nil, err
+	}
+	return ListMonitoredProject(ctx, config, metricsScope)
+}
+
+func (r *MonitoredProject) Schema() *dcl.Schema {
+	return dclService.DCLMonitoredProjectSchema()
+}
+
 func init() {
 	unstructured.Register(&MonitoredProject{})
 }
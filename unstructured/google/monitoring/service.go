@@ -189,6 +189,22 @@ func ServiceHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func ServiceFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToService(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToService(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffService(ctx, r, opts...)
+}
+
 func DeleteService(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToService(u)
@@ -218,7 +234,7 @@ func (r *Service) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Service) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Service) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -250,6 +266,10 @@ func (r *Service) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return ServiceHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Service) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ServiceFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Service) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteService(ctx, config, resource)
 }
@@ -258,6 +278,18 @@ func (r *Service) ID(resource *unstructured.Resource) (string, error) {
 	return ServiceID(resource)
 }
 
+func (r *Service) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListService(ctx, config, project)
+}
+
+func (r *Service) Schema() *dcl.Schema {
+	return dclService.DCLServiceSchema()
+}
+
 func init() {
 	unstructured.Register(&Service{})
 }
@@ -899,6 +899,22 @@ func ServiceLevelObjectiveHasDiff(ctx context.Context, config *dcl.Config, u *un
 	return false, nil
 }
 
+func ServiceLevelObjectiveFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToServiceLevelObjective(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToServiceLevelObjective(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffServiceLevelObjective(ctx, r, opts...)
+}
+
 func DeleteServiceLevelObjective(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToServiceLevelObjective(u)
@@ -928,7 +944,7 @@ func (r *ServiceLevelObjective) SetPolicyMember(ctx context.Context, config *dcl
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *ServiceLevelObjective) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *ServiceLevelObjective) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -960,6 +976,10 @@ func (r *ServiceLevelObjective) HasDiff(ctx context.Context, config *dcl.Config,
 	return ServiceLevelObjectiveHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *ServiceLevelObjective) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ServiceLevelObjectiveFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *ServiceLevelObjective) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteServiceLevelObjective(ctx, config, resource)
 }
@@ -968,6 +988,22 @@ func (r *ServiceLevelObjective) ID(resource *unstructured.Resource) (string, err
 	return ServiceLevelObjectiveID(resource)
 }
 
+func (r *ServiceLevelObjective) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	service, err := unstructured.StringField(resource, "service")
+	if err != nil {
+		return nil, err
+	}
+	return ListServiceLevelObjective(ctx, config, project, service)
+}
+
+func (r *ServiceLevelObjective) Schema() *dcl.Schema {
+	return dclService.DCLServiceLevelObjectiveSchema()
+}
+
 func init() {
 	unstructured.Register(&ServiceLevelObjective{})
 }
@@ -457,6 +457,22 @@ func UptimeCheckConfigHasDiff(ctx context.Context, config *dcl.Config, u *unstru
 	return false, nil
 }
 
+func UptimeCheckConfigFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToUptimeCheckConfig(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToUptimeCheckConfig(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffUptimeCheckConfig(ctx, r, opts...)
+}
+
 func DeleteUptimeCheckConfig(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToUptimeCheckConfig(u)
@@ -486,7 +502,7 @@ func (r *UptimeCheckConfig) SetPolicyMember(ctx context.Context, config *dcl.Con
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *UptimeCheckConfig) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *UptimeCheckConfig) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -518,6 +534,10 @@ func (r *UptimeCheckConfig) HasDiff(ctx context.Context, config *dcl.Config, res
 	return UptimeCheckConfigHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *UptimeCheckConfig) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return UptimeCheckConfigFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *UptimeCheckConfig) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteUptimeCheckConfig(ctx, config, resource)
 }
@@ -526,6 +546,18 @@ func (r *UptimeCheckConfig) ID(resource *unstructured.Resource) (string, error)
 	return UptimeCheckConfigID(resource)
 }
 
+func (r *UptimeCheckConfig) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListUptimeCheckConfig(ctx, config, project)
+}
+
+func (r *UptimeCheckConfig) Schema() *dcl.Schema {
+	return dclService.DCLUptimeCheckConfigSchema()
+}
+
 func init() {
 	unstructured.Register(&UptimeCheckConfig{})
 }
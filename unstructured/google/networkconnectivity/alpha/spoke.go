@@ -361,6 +361,22 @@ func SpokeHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resou
 	return false, nil
 }
 
+func SpokeFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToSpoke(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToSpoke(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffSpoke(ctx, r, opts...)
+}
+
 func DeleteSpoke(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToSpoke(u)
@@ -390,7 +406,7 @@ func (r *Spoke) SetPolicyMember(ctx context.Context, config *dcl.Config, resourc
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Spoke) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Spoke) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -422,6 +438,10 @@ func (r *Spoke) HasDiff(ctx context.Context, config *dcl.Config, resource *unstr
 	return SpokeHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Spoke) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return SpokeFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Spoke) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteSpoke(ctx, config, resource)
 }
@@ -430,6 +450,22 @@ func (r *Spoke) ID(resource *unstructured.Resource) (string, error) {
 	return SpokeID(resource)
 }
 
+func (r *Spoke) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListSpoke(ctx, config, project, location)
+}
+
+func (r *Spoke) Schema() *dcl.Schema {
+	return dclService.DCLSpokeSchema()
+}
+
 func init() {
 	unstructured.Register(&Spoke{})
 }
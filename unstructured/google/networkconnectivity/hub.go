@@ -236,6 +236,22 @@ func HubHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resourc
 	return false, nil
 }
 
+func HubFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToHub(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToHub(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffHub(ctx, r, opts...)
+}
+
 func DeleteHub(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToHub(u)
@@ -265,7 +281,7 @@ func (r *Hub) SetPolicyMember(ctx context.Context, config *dcl.Config, resource
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Hub) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Hub) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -297,6 +313,10 @@ func (r *Hub) HasDiff(ctx context.Context, config *dcl.Config, resource *unstruc
 	return HubHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Hub) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return HubFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Hub) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteHub(ctx, config, resource)
 }
@@ -305,6 +325,18 @@ func (r *Hub) ID(resource *unstructured.Resource) (string, error) {
 	return HubID(resource)
 }
 
+func (r *Hub) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListHub(ctx, config, project)
+}
+
+func (r *Hub) Schema() *dcl.Schema {
+	return dclService.DCLHubSchema()
+}
+
 func init() {
 	unstructured.Register(&Hub{})
 }
@@ -375,6 +375,22 @@ func AuthorizationPolicyHasDiff(ctx context.Context, config *dcl.Config, u *unst
 	return false, nil
 }
 
+func AuthorizationPolicyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToAuthorizationPolicy(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToAuthorizationPolicy(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffAuthorizationPolicy(ctx, r, opts...)
+}
+
 func DeleteAuthorizationPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToAuthorizationPolicy(u)
@@ -467,13 +483,17 @@ func SetPolicyMemberAuthorizationPolicy(ctx context.Context, config *dcl.Config,
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberAuthorizationPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberAuthorizationPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToAuthorizationPolicy(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -501,8 +521,8 @@ func (r *AuthorizationPolicy) SetPolicyMember(ctx context.Context, config *dcl.C
 	return SetPolicyMemberAuthorizationPolicy(ctx, config, resource, member)
 }
 
-func (r *AuthorizationPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberAuthorizationPolicy(ctx, config, resource, role, member)
+func (r *AuthorizationPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberAuthorizationPolicy(ctx, config, resource, member)
 }
 
 func (r *AuthorizationPolicy) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -533,6 +553,10 @@ func (r *AuthorizationPolicy) HasDiff(ctx context.Context, config *dcl.Config, r
 	return AuthorizationPolicyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *AuthorizationPolicy) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return AuthorizationPolicyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *AuthorizationPolicy) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteAuthorizationPolicy(ctx, config, resource)
 }
@@ -541,6 +565,22 @@ func (r *AuthorizationPolicy) ID(resource *unstructured.Resource) (string, error
 	return AuthorizationPolicyID(resource)
 }
 
+func (r *AuthorizationPolicy) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListAuthorizationPolicy(ctx, config, project, location)
+}
+
+func (r *AuthorizationPolicy) Schema() *dcl.Schema {
+	return dclService.DCLAuthorizationPolicySchema()
+}
+
 func init() {
 	unstructured.Register(&AuthorizationPolicy{})
 }
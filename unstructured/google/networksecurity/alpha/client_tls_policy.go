@@ -364,6 +364,22 @@ func ClientTlsPolicyHasDiff(ctx context.Context, config *dcl.Config, u *unstruct
 	return false, nil
 }
 
+func ClientTlsPolicyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToClientTlsPolicy(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToClientTlsPolicy(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffClientTlsPolicy(ctx, r, opts...)
+}
+
 func DeleteClientTlsPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToClientTlsPolicy(u)
@@ -456,13 +472,17 @@ func SetPolicyMemberClientTlsPolicy(ctx context.Context, config *dcl.Config, u *
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberClientTlsPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberClientTlsPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToClientTlsPolicy(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -490,8 +510,8 @@ func (r *ClientTlsPolicy) SetPolicyMember(ctx context.Context, config *dcl.Confi
 	return SetPolicyMemberClientTlsPolicy(ctx, config, resource, member)
 }
 
-func (r *ClientTlsPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberClientTlsPolicy(ctx, config, resource, role, member)
+func (r *ClientTlsPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberClientTlsPolicy(ctx, config, resource, member)
 }
 
 func (r *ClientTlsPolicy) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -522,6 +542,10 @@ func (r *ClientTlsPolicy) HasDiff(ctx context.Context, config *dcl.Config, resou
 	return ClientTlsPolicyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *ClientTlsPolicy) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ClientTlsPolicyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *ClientTlsPolicy) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteClientTlsPolicy(ctx, config, resource)
 }
@@ -530,6 +554,22 @@ func (r *ClientTlsPolicy) ID(resource *unstructured.Resource) (string, error) {
 	return ClientTlsPolicyID(resource)
 }
 
+func (r *ClientTlsPolicy) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListClientTlsPolicy(ctx, config, project, location)
+}
+
+func (r *ClientTlsPolicy) Schema() *dcl.Schema {
+	return dclService.DCLClientTlsPolicySchema()
+}
+
 func init() {
 	unstructured.Register(&ClientTlsPolicy{})
 }
@@ -375,6 +375,22 @@ func ServerTlsPolicyHasDiff(ctx context.Context, config *dcl.Config, u *unstruct
 	return false, nil
 }
 
+func ServerTlsPolicyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToServerTlsPolicy(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToServerTlsPolicy(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffServerTlsPolicy(ctx, r, opts...)
+}
+
 func DeleteServerTlsPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToServerTlsPolicy(u)
@@ -467,13 +483,17 @@ func SetPolicyMemberServerTlsPolicy(ctx context.Context, config *dcl.Config, u *
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberServerTlsPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberServerTlsPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToServerTlsPolicy(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -501,8 +521,8 @@ func (r *ServerTlsPolicy) SetPolicyMember(ctx context.Context, config *dcl.Confi
 	return SetPolicyMemberServerTlsPolicy(ctx, config, resource, member)
 }
 
-func (r *ServerTlsPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberServerTlsPolicy(ctx, config, resource, role, member)
+func (r *ServerTlsPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberServerTlsPolicy(ctx, config, resource, member)
 }
 
 func (r *ServerTlsPolicy) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -533,6 +553,10 @@ func (r *ServerTlsPolicy) HasDiff(ctx context.Context, config *dcl.Config, resou
 	return ServerTlsPolicyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *ServerTlsPolicy) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ServerTlsPolicyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *ServerTlsPolicy) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteServerTlsPolicy(ctx, config, resource)
 }
@@ -541,6 +565,22 @@ func (r *ServerTlsPolicy) ID(resource *unstructured.Resource) (string, error) {
 	return ServerTlsPolicyID(resource)
 }
 
+func (r *ServerTlsPolicy) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListServerTlsPolicy(ctx, config, project, location)
+}
+
+func (r *ServerTlsPolicy) Schema() *dcl.Schema {
+	return dclService.DCLServerTlsPolicySchema()
+}
+
 func init() {
 	unstructured.Register(&ServerTlsPolicy{})
 }
@@ -335,6 +335,22 @@ func EndpointPolicyHasDiff(ctx context.Context, config *dcl.Config, u *unstructu
 	return false, nil
 }
 
+func EndpointPolicyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToEndpointPolicy(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToEndpointPolicy(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffEndpointPolicy(ctx, r, opts...)
+}
+
 func DeleteEndpointPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToEndpointPolicy(u)
@@ -364,7 +380,7 @@ func (r *EndpointPolicy) SetPolicyMember(ctx context.Context, config *dcl.Config
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *EndpointPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *EndpointPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -396,6 +412,10 @@ func (r *EndpointPolicy) HasDiff(ctx context.Context, config *dcl.Config, resour
 	return EndpointPolicyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *EndpointPolicy) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return EndpointPolicyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *EndpointPolicy) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteEndpointPolicy(ctx, config, resource)
 }
@@ -404,6 +424,22 @@ func (r *EndpointPolicy) ID(resource *unstructured.Resource) (string, error) {
 	return EndpointPolicyID(resource)
 }
 
+func (r *EndpointPolicy) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListEndpointPolicy(ctx, config, project, location)
+}
+
+func (r *EndpointPolicy) Schema() *dcl.Schema {
+	return dclService.DCLEndpointPolicySchema()
+}
+
 func init() {
 	unstructured.Register(&EndpointPolicy{})
 }
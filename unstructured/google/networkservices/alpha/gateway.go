@@ -270,6 +270,22 @@ func GatewayHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func GatewayFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToGateway(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToGateway(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffGateway(ctx, r, opts...)
+}
+
 func DeleteGateway(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToGateway(u)
@@ -299,7 +315,7 @@ func (r *Gateway) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Gateway) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Gateway) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -331,6 +347,10 @@ func (r *Gateway) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return GatewayHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Gateway) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return GatewayFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Gateway) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteGateway(ctx, config, resource)
 }
@@ -339,6 +359,22 @@ func (r *Gateway) ID(resource *unstructured.Resource) (string, error) {
 	return GatewayID(resource)
 }
 
+func (r *Gateway) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListGateway(ctx, config, project, location)
+}
+
+func (r *Gateway) Schema() *dcl.Schema {
+	return dclService.DCLGatewaySchema()
+}
+
 func init() {
 	unstructured.Register(&Gateway{})
 }
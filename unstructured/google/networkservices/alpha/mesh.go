@@ -218,6 +218,22 @@ func MeshHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resour
 	return false, nil
 }
 
+func MeshFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToMesh(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToMesh(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffMesh(ctx, r, opts...)
+}
+
 func DeleteMesh(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToMesh(u)
@@ -247,7 +263,7 @@ func (r *Mesh) SetPolicyMember(ctx context.Context, config *dcl.Config, resource
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Mesh) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Mesh) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -279,6 +295,10 @@ func (r *Mesh) HasDiff(ctx context.Context, config *dcl.Config, resource *unstru
 	return MeshHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Mesh) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return MeshFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Mesh) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteMesh(ctx, config, resource)
 }
@@ -287,6 +307,22 @@ func (r *Mesh) ID(resource *unstructured.Resource) (string, error) {
 	return MeshID(resource)
 }
 
+func (r *Mesh) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListMesh(ctx, config, project, location)
+}
+
+func (r *Mesh) Schema() *dcl.Schema {
+	return dclService.DCLMeshSchema()
+}
+
 func init() {
 	unstructured.Register(&Mesh{})
 }
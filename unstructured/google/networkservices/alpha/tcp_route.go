@@ -355,6 +355,22 @@ func TcpRouteHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Re
 	return false, nil
 }
 
+func TcpRouteFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToTcpRoute(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToTcpRoute(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffTcpRoute(ctx, r, opts...)
+}
+
 func DeleteTcpRoute(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToTcpRoute(u)
@@ -384,7 +400,7 @@ func (r *TcpRoute) SetPolicyMember(ctx context.Context, config *dcl.Config, reso
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *TcpRoute) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *TcpRoute) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -416,6 +432,10 @@ func (r *TcpRoute) HasDiff(ctx context.Context, config *dcl.Config, resource *un
 	return TcpRouteHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *TcpRoute) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return TcpRouteFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *TcpRoute) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteTcpRoute(ctx, config, resource)
 }
@@ -424,6 +444,22 @@ func (r *TcpRoute) ID(resource *unstructured.Resource) (string, error) {
 	return TcpRouteID(resource)
 }
 
+func (r *TcpRoute) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListTcpRoute(ctx, config, project, location)
+}
+
+func (r *TcpRoute) Schema() *dcl.Schema {
+	return dclService.DCLTcpRouteSchema()
+}
+
 func init() {
 	unstructured.Register(&TcpRoute{})
 }
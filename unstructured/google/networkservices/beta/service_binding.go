@@ -208,6 +208,22 @@ func ServiceBindingHasDiff(ctx context.Context, config *dcl.Config, u *unstructu
 	return false, nil
 }
 
+func ServiceBindingFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToServiceBinding(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToServiceBinding(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffServiceBinding(ctx, r, opts...)
+}
+
 func DeleteServiceBinding(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToServiceBinding(u)
@@ -237,7 +253,7 @@ func (r *ServiceBinding) SetPolicyMember(ctx context.Context, config *dcl.Config
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *ServiceBinding) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *ServiceBinding) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -269,6 +285,10 @@ func (r *ServiceBinding) HasDiff(ctx context.Context, config *dcl.Config, resour
 	return ServiceBindingHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *ServiceBinding) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ServiceBindingFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *ServiceBinding) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteServiceBinding(ctx, config, resource)
 }
@@ -277,6 +297,22 @@ func (r *ServiceBinding) ID(resource *unstructured.Resource) (string, error) {
 	return ServiceBindingID(resource)
 }
 
+func (r *ServiceBinding) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListServiceBinding(ctx, config, project, location)
+}
+
+func (r *ServiceBinding) Schema() *dcl.Schema {
+	return dclService.DCLServiceBindingSchema()
+}
+
 func init() {
 	unstructured.Register(&ServiceBinding{})
 }
@@ -560,6 +560,22 @@ func GrpcRouteHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.R
 	return false, nil
 }
 
+func GrpcRouteFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToGrpcRoute(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToGrpcRoute(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffGrpcRoute(ctx, r, opts...)
+}
+
 func DeleteGrpcRoute(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToGrpcRoute(u)
@@ -589,7 +605,7 @@ func (r *GrpcRoute) SetPolicyMember(ctx context.Context, config *dcl.Config, res
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *GrpcRoute) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *GrpcRoute) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -621,6 +637,10 @@ func (r *GrpcRoute) HasDiff(ctx context.Context, config *dcl.Config, resource *u
 	return GrpcRouteHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *GrpcRoute) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return GrpcRouteFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *GrpcRoute) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteGrpcRoute(ctx, config, resource)
 }
@@ -629,6 +649,22 @@ func (r *GrpcRoute) ID(resource *unstructured.Resource) (string, error) {
 	return GrpcRouteID(resource)
 }
 
+func (r *GrpcRoute) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListGrpcRoute(ctx, config, project, location)
+}
+
+func (r *GrpcRoute) Schema() *dcl.Schema {
+	return dclService.DCLGrpcRouteSchema()
+}
+
 func init() {
 	unstructured.Register(&GrpcRoute{})
 }
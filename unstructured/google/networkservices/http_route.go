@@ -1097,6 +1097,22 @@ func HttpRouteHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.R
 	return false, nil
 }
 
+func HttpRouteFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToHttpRoute(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToHttpRoute(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffHttpRoute(ctx, r, opts...)
+}
+
 func DeleteHttpRoute(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToHttpRoute(u)
@@ -1126,7 +1142,7 @@ func (r *HttpRoute) SetPolicyMember(ctx context.Context, config *dcl.Config, res
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *HttpRoute) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *HttpRoute) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -1158,6 +1174,10 @@ func (r *HttpRoute) HasDiff(ctx context.Context, config *dcl.Config, resource *u
 	return HttpRouteHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *HttpRoute) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return HttpRouteFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *HttpRoute) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteHttpRoute(ctx, config, resource)
 }
@@ -1166,6 +1186,22 @@ func (r *HttpRoute) ID(resource *unstructured.Resource) (string, error) {
 	return HttpRouteID(resource)
 }
 
+func (r *HttpRoute) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListHttpRoute(ctx, config, project, location)
+}
+
+func (r *HttpRoute) Schema() *dcl.Schema {
+	return dclService.DCLHttpRouteSchema()
+}
+
 func init() {
 	unstructured.Register(&HttpRoute{})
 }
@@ -337,6 +337,22 @@ func TlsRouteHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Re
 	return false, nil
 }
 
+func TlsRouteFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToTlsRoute(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToTlsRoute(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffTlsRoute(ctx, r, opts...)
+}
+
 func DeleteTlsRoute(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToTlsRoute(u)
@@ -366,7 +382,7 @@ func (r *TlsRoute) SetPolicyMember(ctx context.Context, config *dcl.Config, reso
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *TlsRoute) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *TlsRoute) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -398,6 +414,10 @@ func (r *TlsRoute) HasDiff(ctx context.Context, config *dcl.Config, resource *un
 	return TlsRouteHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *TlsRoute) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return TlsRouteFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *TlsRoute) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteTlsRoute(ctx, config, resource)
 }
@@ -406,6 +426,22 @@ func (r *TlsRoute) ID(resource *unstructured.Resource) (string, error) {
 	return TlsRouteID(resource)
 }
 
+func (r *TlsRoute) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListTlsRoute(ctx, config, project, location)
+}
+
+func (r *TlsRoute) Schema() *dcl.Schema {
+	return dclService.DCLTlsRouteSchema()
+}
+
 func init() {
 	unstructured.Register(&TlsRoute{})
 }
@@ -331,6 +331,22 @@ func PolicyHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func PolicyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToPolicy(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToPolicy(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffPolicy(ctx, r, opts...)
+}
+
 func DeletePolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToPolicy(u)
@@ -360,7 +376,7 @@ func (r *Policy) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Policy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Policy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -392,6 +408,10 @@ func (r *Policy) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return PolicyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Policy) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return PolicyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Policy) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeletePolicy(ctx, config, resource)
 }
@@ -400,6 +420,18 @@ func (r *Policy) ID(resource *unstructured.Resource) (string, error) {
 	return PolicyID(resource)
 }
 
+func (r *Policy) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	parent, err := unstructured.StringField(resource, "parent")
+	if err != nil {
+		return nil, err
+	}
+	return ListPolicy(ctx, config, parent)
+}
+
+func (r *Policy) Schema() *dcl.Schema {
+	return dclService.DCLPolicySchema()
+}
+
 func init() {
 	unstructured.Register(&Policy{})
 }
@@ -1389,6 +1389,22 @@ func GuestPolicyHasDiff(ctx context.Context, config *dcl.Config, u *unstructured
 	return false, nil
 }
 
+func GuestPolicyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToGuestPolicy(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToGuestPolicy(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffGuestPolicy(ctx, r, opts...)
+}
+
 func DeleteGuestPolicy(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToGuestPolicy(u)
@@ -1418,7 +1434,7 @@ func (r *GuestPolicy) SetPolicyMember(ctx context.Context, config *dcl.Config, r
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *GuestPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *GuestPolicy) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -1450,6 +1466,10 @@ func (r *GuestPolicy) HasDiff(ctx context.Context, config *dcl.Config, resource
 	return GuestPolicyHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *GuestPolicy) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return GuestPolicyFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *GuestPolicy) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteGuestPolicy(ctx, config, resource)
 }
@@ -1458,6 +1478,18 @@ func (r *GuestPolicy) ID(resource *unstructured.Resource) (string, error) {
 	return GuestPolicyID(resource)
 }
 
+func (r *GuestPolicy) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListGuestPolicy(ctx, config, project)
+}
+
+func (r *GuestPolicy) Schema() *dcl.Schema {
+	return dclService.DCLGuestPolicySchema()
+}
+
 func init() {
 	unstructured.Register(&GuestPolicy{})
 }
@@ -1768,6 +1768,22 @@ func OSPolicyAssignmentHasDiff(ctx context.Context, config *dcl.Config, u *unstr
 	return false, nil
 }
 
+func OSPolicyAssignmentFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToOSPolicyAssignment(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToOSPolicyAssignment(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffOSPolicyAssignment(ctx, r, opts...)
+}
+
 func DeleteOSPolicyAssignment(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToOSPolicyAssignment(u)
@@ -1797,7 +1813,7 @@ func (r *OSPolicyAssignment) SetPolicyMember(ctx context.Context, config *dcl.Co
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *OSPolicyAssignment) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *OSPolicyAssignment) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -1829,6 +1845,10 @@ func (r *OSPolicyAssignment) HasDiff(ctx context.Context, config *dcl.Config, re
 	return OSPolicyAssignmentHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *OSPolicyAssignment) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return OSPolicyAssignmentFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *OSPolicyAssignment) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteOSPolicyAssignment(ctx, config, resource)
 }
@@ -1837,6 +1857,22 @@ func (r *OSPolicyAssignment) ID(resource *unstructured.Resource) (string, error)
 	return OSPolicyAssignmentID(resource)
 }
 
+func (r *OSPolicyAssignment) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListOSPolicyAssignment(ctx, config, project, location)
+}
+
+func (r *OSPolicyAssignment) Schema() *dcl.Schema {
+	return dclService.DCLOSPolicyAssignmentSchema()
+}
+
 func init() {
 	unstructured.Register(&OSPolicyAssignment{})
 }
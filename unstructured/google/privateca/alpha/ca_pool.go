@@ -848,6 +848,22 @@ func CaPoolHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func CaPoolFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToCaPool(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToCaPool(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffCaPool(ctx, r, opts...)
+}
+
 func DeleteCaPool(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToCaPool(u)
@@ -877,7 +893,7 @@ func (r *CaPool) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *CaPool) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *CaPool) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -909,6 +925,10 @@ func (r *CaPool) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return CaPoolHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *CaPool) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return CaPoolFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *CaPool) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteCaPool(ctx, config, resource)
 }
@@ -917,6 +937,22 @@ func (r *CaPool) ID(resource *unstructured.Resource) (string, error) {
 	return CaPoolID(resource)
 }
 
+func (r *CaPool) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListCaPool(ctx, config, project, location)
+}
+
+func (r *CaPool) Schema() *dcl.Schema {
+	return dclService.DCLCaPoolSchema()
+}
+
 func init() {
 	unstructured.Register(&CaPool{})
 }
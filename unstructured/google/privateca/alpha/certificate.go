@@ -1721,6 +1721,22 @@ func CertificateHasDiff(ctx context.Context, config *dcl.Config, u *unstructured
 	return false, nil
 }
 
+func CertificateFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToCertificate(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToCertificate(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffCertificate(ctx, r, opts...)
+}
+
 func DeleteCertificate(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToCertificate(u)
@@ -1750,7 +1766,7 @@ func (r *Certificate) SetPolicyMember(ctx context.Context, config *dcl.Config, r
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Certificate) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Certificate) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -1782,6 +1798,10 @@ func (r *Certificate) HasDiff(ctx context.Context, config *dcl.Config, resource
 	return CertificateHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Certificate) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return CertificateFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Certificate) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteCertificate(ctx, config, resource)
 }
@@ -1790,6 +1810,26 @@ func (r *Certificate) ID(resource *unstructured.Resource) (string, error) {
 	return CertificateID(resource)
 }
 
+func (r *Certificate) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	caPool, err := unstructured.StringField(resource, "caPool")
+	if err != nil {
+		return nil, err
+	}
+	return ListCertificate(ctx, config, project, location, caPool)
+}
+
+func (r *Certificate) Schema() *dcl.Schema {
+	return dclService.DCLCertificateSchema()
+}
+
 func init() {
 	unstructured.Register(&Certificate{})
 }
@@ -715,6 +715,22 @@ func CertificateTemplateHasDiff(ctx context.Context, config *dcl.Config, u *unst
 	return false, nil
 }
 
+func CertificateTemplateFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToCertificateTemplate(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToCertificateTemplate(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffCertificateTemplate(ctx, r, opts...)
+}
+
 func DeleteCertificateTemplate(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToCertificateTemplate(u)
@@ -744,7 +760,7 @@ func (r *CertificateTemplate) SetPolicyMember(ctx context.Context, config *dcl.C
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *CertificateTemplate) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *CertificateTemplate) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -776,6 +792,10 @@ func (r *CertificateTemplate) HasDiff(ctx context.Context, config *dcl.Config, r
 	return CertificateTemplateHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *CertificateTemplate) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return CertificateTemplateFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *CertificateTemplate) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteCertificateTemplate(ctx, config, resource)
 }
@@ -784,6 +804,22 @@ func (r *CertificateTemplate) ID(resource *unstructured.Resource) (string, error
 	return CertificateTemplateID(resource)
 }
 
+func (r *CertificateTemplate) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListCertificateTemplate(ctx, config, project, location)
+}
+
+func (r *CertificateTemplate) Schema() *dcl.Schema {
+	return dclService.DCLCertificateTemplateSchema()
+}
+
 func init() {
 	unstructured.Register(&CertificateTemplate{})
 }
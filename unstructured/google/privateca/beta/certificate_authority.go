@@ -1858,6 +1858,22 @@ func CertificateAuthorityHasDiff(ctx context.Context, config *dcl.Config, u *uns
 	return false, nil
 }
 
+func CertificateAuthorityFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToCertificateAuthority(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToCertificateAuthority(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffCertificateAuthority(ctx, r, opts...)
+}
+
 func DeleteCertificateAuthority(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToCertificateAuthority(u)
@@ -1887,7 +1903,7 @@ func (r *CertificateAuthority) SetPolicyMember(ctx context.Context, config *dcl.
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *CertificateAuthority) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *CertificateAuthority) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -1919,6 +1935,10 @@ func (r *CertificateAuthority) HasDiff(ctx context.Context, config *dcl.Config,
 	return CertificateAuthorityHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *CertificateAuthority) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return CertificateAuthorityFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *CertificateAuthority) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteCertificateAuthority(ctx, config, resource)
 }
@@ -1927,6 +1947,26 @@ func (r *CertificateAuthority) ID(resource *unstructured.Resource) (string, erro
 	return CertificateAuthorityID(resource)
 }
 
+func (r *CertificateAuthority) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	caPool, err := unstructured.StringField(resource, "caPool")
+	if err != nil {
+		return nil, err
+	}
+	return ListCertificateAuthority(ctx, config, project, location, caPool)
+}
+
+func (r *CertificateAuthority) Schema() *dcl.Schema {
+	return dclService.DCLCertificateAuthoritySchema()
+}
+
 func init() {
 	unstructured.Register(&CertificateAuthority{})
 }
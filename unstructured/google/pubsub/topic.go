@@ -195,6 +195,22 @@ func TopicHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resou
 	return false, nil
 }
 
+func TopicFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToTopic(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToTopic(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffTopic(ctx, r, opts...)
+}
+
 func DeleteTopic(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToTopic(u)
@@ -224,7 +240,7 @@ func (r *Topic) SetPolicyMember(ctx context.Context, config *dcl.Config, resourc
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Topic) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Topic) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -256,6 +272,10 @@ func (r *Topic) HasDiff(ctx context.Context, config *dcl.Config, resource *unstr
 	return TopicHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Topic) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return TopicFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Topic) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteTopic(ctx, config, resource)
 }
@@ -264,6 +284,18 @@ func (r *Topic) ID(resource *unstructured.Resource) (string, error) {
 	return TopicID(resource)
 }
 
+func (r *Topic) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListTopic(ctx, config, project)
+}
+
+func (r *Topic) Schema() *dcl.Schema {
+	return dclService.DCLTopicSchema()
+}
+
 func init() {
 	unstructured.Register(&Topic{})
 }
@@ -0,0 +1,727 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package redis
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/redis/beta"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type Instance struct{}
+
+func InstanceToUnstructured(r *dclService.Instance) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "redis",
+			Version: "beta",
+			Type:    "Instance",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.AlternativeLocationId != nil {
+		u.Object["alternativeLocationId"] = *r.AlternativeLocationId
+	}
+	if r.AuthEnabled != nil {
+		u.Object["authEnabled"] = *r.AuthEnabled
+	}
+	if r.AuthorizedNetwork != nil {
+		u.Object["authorizedNetwork"] = *r.AuthorizedNetwork
+	}
+	if r.ConnectMode != nil {
+		u.Object["connectMode"] = string(*r.ConnectMode)
+	}
+	if r.CreateTime != nil {
+		u.Object["createTime"] = *r.CreateTime
+	}
+	if r.CurrentLocationId != nil {
+		u.Object["currentLocationId"] = *r.CurrentLocationId
+	}
+	if r.DisplayName != nil {
+		u.Object["displayName"] = *r.DisplayName
+	}
+	if r.Host != nil {
+		u.Object["host"] = *r.Host
+	}
+	if r.Labels != nil {
+		rLabels := make(map[string]interface{})
+		for k, v := range r.Labels {
+			rLabels[k] = v
+		}
+		u.Object["labels"] = rLabels
+	}
+	if r.Location != nil {
+		u.Object["location"] = *r.Location
+	}
+	if r.LocationId != nil {
+		u.Object["locationId"] = *r.LocationId
+	}
+	if r.MaintenancePolicy != nil && r.MaintenancePolicy != dclService.EmptyInstanceMaintenancePolicy {
+		rMaintenancePolicy := make(map[string]interface{})
+		if r.MaintenancePolicy.CreateTime != nil {
+			rMaintenancePolicy["createTime"] = *r.MaintenancePolicy.CreateTime
+		}
+		if r.MaintenancePolicy.Description != nil {
+			rMaintenancePolicy["description"] = *r.MaintenancePolicy.Description
+		}
+		if r.MaintenancePolicy.UpdateTime != nil {
+			rMaintenancePolicy["updateTime"] = *r.MaintenancePolicy.UpdateTime
+		}
+		var rMaintenancePolicyWeeklyMaintenanceWindow []interface{}
+		for _, rMaintenancePolicyWeeklyMaintenanceWindowVal := range r.MaintenancePolicy.WeeklyMaintenanceWindow {
+			rMaintenancePolicyWeeklyMaintenanceWindowObject := make(map[string]interface{})
+			if rMaintenancePolicyWeeklyMaintenanceWindowVal.Day != nil {
+				rMaintenancePolicyWeeklyMaintenanceWindowObject["day"] = string(*rMaintenancePolicyWeeklyMaintenanceWindowVal.Day)
+			}
+			if rMaintenancePolicyWeeklyMaintenanceWindowVal.Duration != nil {
+				rMaintenancePolicyWeeklyMaintenanceWindowObject["duration"] = *rMaintenancePolicyWeeklyMaintenanceWindowVal.Duration
+			}
+			if rMaintenancePolicyWeeklyMaintenanceWindowVal.StartTime != nil && rMaintenancePolicyWeeklyMaintenanceWindowVal.StartTime != dclService.EmptyInstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime {
+				rMaintenancePolicyWeeklyMaintenanceWindowValStartTime := make(map[string]interface{})
+				if rMaintenancePolicyWeeklyMaintenanceWindowVal.StartTime.Hours != nil {
+					rMaintenancePolicyWeeklyMaintenanceWindowValStartTime["hours"] = *rMaintenancePolicyWeeklyMaintenanceWindowVal.StartTime.Hours
+				}
+				if rMaintenancePolicyWeeklyMaintenanceWindowVal.StartTime.Minutes != nil {
+					rMaintenancePolicyWeeklyMaintenanceWindowValStartTime["minutes"] = *rMaintenancePolicyWeeklyMaintenanceWindowVal.StartTime.Minutes
+				}
+				if rMaintenancePolicyWeeklyMaintenanceWindowVal.StartTime.Nanos != nil {
+					rMaintenancePolicyWeeklyMaintenanceWindowValStartTime["nanos"] = *rMaintenancePolicyWeeklyMaintenanceWindowVal.StartTime.Nanos
+				}
+				if rMaintenancePolicyWeeklyMaintenanceWindowVal.StartTime.Seconds != nil {
+					rMaintenancePolicyWeeklyMaintenanceWindowValStartTime["seconds"] = *rMaintenancePolicyWeeklyMaintenanceWindowVal.StartTime.Seconds
+				}
+				rMaintenancePolicyWeeklyMaintenanceWindowObject["startTime"] = rMaintenancePolicyWeeklyMaintenanceWindowValStartTime
+			}
+			rMaintenancePolicyWeeklyMaintenanceWindow = append(rMaintenancePolicyWeeklyMaintenanceWindow, rMaintenancePolicyWeeklyMaintenanceWindowObject)
+		}
+		rMaintenancePolicy["weeklyMaintenanceWindow"] = rMaintenancePolicyWeeklyMaintenanceWindow
+		u.Object["maintenancePolicy"] = rMaintenancePolicy
+	}
+	if r.MaintenanceSchedule != nil && r.MaintenanceSchedule != dclService.EmptyInstanceMaintenanceSchedule {
+		rMaintenanceSchedule := make(map[string]interface{})
+		if r.MaintenanceSchedule.CanReschedule != nil {
+			rMaintenanceSchedule["canReschedule"] = *r.MaintenanceSchedule.CanReschedule
+		}
+		if r.MaintenanceSchedule.EndTime != nil {
+			rMaintenanceSchedule["endTime"] = *r.MaintenanceSchedule.EndTime
+		}
+		if r.MaintenanceSchedule.ScheduleDeadlineTime != nil {
+			rMaintenanceSchedule["scheduleDeadlineTime"] = *r.MaintenanceSchedule.ScheduleDeadlineTime
+		}
+		if r.MaintenanceSchedule.StartTime != nil {
+			rMaintenanceSchedule["startTime"] = *r.MaintenanceSchedule.StartTime
+		}
+		u.Object["maintenanceSchedule"] = rMaintenanceSchedule
+	}
+	if r.MemorySizeGb != nil {
+		u.Object["memorySizeGb"] = *r.MemorySizeGb
+	}
+	if r.Name != nil {
+		u.Object["name"] = *r.Name
+	}
+	if r.PersistenceIamIdentity != nil {
+		u.Object["persistenceIamIdentity"] = *r.PersistenceIamIdentity
+	}
+	if r.Port != nil {
+		u.Object["port"] = *r.Port
+	}
+	if r.Project != nil {
+		u.Object["project"] = *r.Project
+	}
+	if r.RedisConfigs != nil {
+		rRedisConfigs := make(map[string]interface{})
+		for k, v := range r.RedisConfigs {
+			rRedisConfigs[k] = v
+		}
+		u.Object["redisConfigs"] = rRedisConfigs
+	}
+	if r.RedisVersion != nil {
+		u.Object["redisVersion"] = *r.RedisVersion
+	}
+	if r.ReservedIPRange != nil {
+		u.Object["reservedIpRange"] = *r.ReservedIPRange
+	}
+	var rServerCaCerts []interface{}
+	for _, rServerCaCertsVal := range r.ServerCaCerts {
+		rServerCaCertsObject := make(map[string]interface{})
+		if rServerCaCertsVal.Cert != nil {
+			rServerCaCertsObject["cert"] = *rServerCaCertsVal.Cert
+		}
+		if rServerCaCertsVal.CreateTime != nil {
+			rServerCaCertsObject["createTime"] = *rServerCaCertsVal.CreateTime
+		}
+		if rServerCaCertsVal.ExpireTime != nil {
+			rServerCaCertsObject["expireTime"] = *rServerCaCertsVal.ExpireTime
+		}
+		if rServerCaCertsVal.SerialNumber != nil {
+			rServerCaCertsObject["serialNumber"] = *rServerCaCertsVal.SerialNumber
+		}
+		if rServerCaCertsVal.Sha1Fingerprint != nil {
+			rServerCaCertsObject["sha1Fingerprint"] = *rServerCaCertsVal.Sha1Fingerprint
+		}
+		rServerCaCerts = append(rServerCaCerts, rServerCaCertsObject)
+	}
+	u.Object["serverCaCerts"] = rServerCaCerts
+	if r.State != nil {
+		u.Object["state"] = string(*r.State)
+	}
+	if r.StatusMessage != nil {
+		u.Object["statusMessage"] = *r.StatusMessage
+	}
+	if r.Tier != nil {
+		u.Object["tier"] = string(*r.Tier)
+	}
+	if r.TransitEncryptionMode != nil {
+		u.Object["transitEncryptionMode"] = string(*r.TransitEncryptionMode)
+	}
+	return u
+}
+
+func UnstructuredToInstance(u *unstructured.Resource) (*dclService.Instance, error) {
+	r := &dclService.Instance{}
+	if _, ok := u.Object["alternativeLocationId"]; ok {
+		if s, ok := u.Object["alternativeLocationId"].(string); ok {
+			r.AlternativeLocationId = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.AlternativeLocationId: expected string")
+		}
+	}
+	if _, ok := u.Object["authEnabled"]; ok {
+		if b, ok := u.Object["authEnabled"].(bool); ok {
+			r.AuthEnabled = dcl.Bool(b)
+		} else {
+			return nil, fmt.Errorf("r.AuthEnabled: expected bool")
+		}
+	}
+	if _, ok := u.Object["authorizedNetwork"]; ok {
+		if s, ok := u.Object["authorizedNetwork"].(string); ok {
+			r.AuthorizedNetwork = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.AuthorizedNetwork: expected string")
+		}
+	}
+	if _, ok := u.Object["connectMode"]; ok {
+		if s, ok := u.Object["connectMode"].(string); ok {
+			r.ConnectMode = dclService.InstanceConnectModeEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.ConnectMode: expected string")
+		}
+	}
+	if _, ok := u.Object["createTime"]; ok {
+		if s, ok := u.Object["createTime"].(string); ok {
+			r.CreateTime = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.CreateTime: expected string")
+		}
+	}
+	if _, ok := u.Object["currentLocationId"]; ok {
+		if s, ok := u.Object["currentLocationId"].(string); ok {
+			r.CurrentLocationId = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.CurrentLocationId: expected string")
+		}
+	}
+	if _, ok := u.Object["displayName"]; ok {
+		if s, ok := u.Object["displayName"].(string); ok {
+			r.DisplayName = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.DisplayName: expected string")
+		}
+	}
+	if _, ok := u.Object["host"]; ok {
+		if s, ok := u.Object["host"].(string); ok {
+			r.Host = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Host: expected string")
+		}
+	}
+	if _, ok := u.Object["labels"]; ok {
+		if rLabels, ok := u.Object["labels"].(map[string]interface{}); ok {
+			m := make(map[string]string)
+			for k, v := range rLabels {
+				if s, ok := v.(string); ok {
+					m[k] = s
+				}
+			}
+			r.Labels = m
+		} else {
+			return nil, fmt.Errorf("r.Labels: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["location"]; ok {
+		if s, ok := u.Object["location"].(string); ok {
+			r.Location = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Location: expected string")
+		}
+	}
+	if _, ok := u.Object["locationId"]; ok {
+		if s, ok := u.Object["locationId"].(string); ok {
+			r.LocationId = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.LocationId: expected string")
+		}
+	}
+	if _, ok := u.Object["maintenancePolicy"]; ok {
+		if rMaintenancePolicy, ok := u.Object["maintenancePolicy"].(map[string]interface{}); ok {
+			r.MaintenancePolicy = &dclService.InstanceMaintenancePolicy{}
+			if _, ok := rMaintenancePolicy["createTime"]; ok {
+				if s, ok := rMaintenancePolicy["createTime"].(string); ok {
+					r.MaintenancePolicy.CreateTime = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.MaintenancePolicy.CreateTime: expected string")
+				}
+			}
+			if _, ok := rMaintenancePolicy["description"]; ok {
+				if s, ok := rMaintenancePolicy["description"].(string); ok {
+					r.MaintenancePolicy.Description = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.MaintenancePolicy.Description: expected string")
+				}
+			}
+			if _, ok := rMaintenancePolicy["updateTime"]; ok {
+				if s, ok := rMaintenancePolicy["updateTime"].(string); ok {
+					r.MaintenancePolicy.UpdateTime = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.MaintenancePolicy.UpdateTime: expected string")
+				}
+			}
+			if _, ok := rMaintenancePolicy["weeklyMaintenanceWindow"]; ok {
+				if s, ok := rMaintenancePolicy["weeklyMaintenanceWindow"].([]interface{}); ok {
+					for _, o := range s {
+						if objval, ok := o.(map[string]interface{}); ok {
+							var rMaintenancePolicyWeeklyMaintenanceWindow dclService.InstanceMaintenancePolicyWeeklyMaintenanceWindow
+							if _, ok := objval["day"]; ok {
+								if s, ok := objval["day"].(string); ok {
+									rMaintenancePolicyWeeklyMaintenanceWindow.Day = dclService.InstanceMaintenancePolicyWeeklyMaintenanceWindowDayEnumRef(s)
+								} else {
+									return nil, fmt.Errorf("rMaintenancePolicyWeeklyMaintenanceWindow.Day: expected string")
+								}
+							}
+							if _, ok := objval["duration"]; ok {
+								if s, ok := objval["duration"].(string); ok {
+									rMaintenancePolicyWeeklyMaintenanceWindow.Duration = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("rMaintenancePolicyWeeklyMaintenanceWindow.Duration: expected string")
+								}
+							}
+							if _, ok := objval["startTime"]; ok {
+								if rMaintenancePolicyWeeklyMaintenanceWindowStartTime, ok := objval["startTime"].(map[string]interface{}); ok {
+									rMaintenancePolicyWeeklyMaintenanceWindow.StartTime = &dclService.InstanceMaintenancePolicyWeeklyMaintenanceWindowStartTime{}
+									if _, ok := rMaintenancePolicyWeeklyMaintenanceWindowStartTime["hours"]; ok {
+										if i, ok := rMaintenancePolicyWeeklyMaintenanceWindowStartTime["hours"].(int64); ok {
+											rMaintenancePolicyWeeklyMaintenanceWindow.StartTime.Hours = dcl.Int64(i)
+										} else {
+											return nil, fmt.Errorf("rMaintenancePolicyWeeklyMaintenanceWindow.StartTime.Hours: expected int64")
+										}
+									}
+									if _, ok := rMaintenancePolicyWeeklyMaintenanceWindowStartTime["minutes"]; ok {
+										if i, ok := rMaintenancePolicyWeeklyMaintenanceWindowStartTime["minutes"].(int64); ok {
+											rMaintenancePolicyWeeklyMaintenanceWindow.StartTime.Minutes = dcl.Int64(i)
+										} else {
+											return nil, fmt.Errorf("rMaintenancePolicyWeeklyMaintenanceWindow.StartTime.Minutes: expected int64")
+										}
+									}
+									if _, ok := rMaintenancePolicyWeeklyMaintenanceWindowStartTime["nanos"]; ok {
+										if i, ok := rMaintenancePolicyWeeklyMaintenanceWindowStartTime["nanos"].(int64); ok {
+											rMaintenancePolicyWeeklyMaintenanceWindow.StartTime.Nanos = dcl.Int64(i)
+										} else {
+											return nil, fmt.Errorf("rMaintenancePolicyWeeklyMaintenanceWindow.StartTime.Nanos: expected int64")
+										}
+									}
+									if _, ok := rMaintenancePolicyWeeklyMaintenanceWindowStartTime["seconds"]; ok {
+										if i, ok := rMaintenancePolicyWeeklyMaintenanceWindowStartTime["seconds"].(int64); ok {
+											rMaintenancePolicyWeeklyMaintenanceWindow.StartTime.Seconds = dcl.Int64(i)
+										} else {
+											return nil, fmt.Errorf("rMaintenancePolicyWeeklyMaintenanceWindow.StartTime.Seconds: expected int64")
+										}
+									}
+								} else {
+									return nil, fmt.Errorf("rMaintenancePolicyWeeklyMaintenanceWindow.StartTime: expected map[string]interface{}")
+								}
+							}
+							r.MaintenancePolicy.WeeklyMaintenanceWindow = append(r.MaintenancePolicy.WeeklyMaintenanceWindow, rMaintenancePolicyWeeklyMaintenanceWindow)
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.MaintenancePolicy.WeeklyMaintenanceWindow: expected []interface{}")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.MaintenancePolicy: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["maintenanceSchedule"]; ok {
+		if rMaintenanceSchedule, ok := u.Object["maintenanceSchedule"].(map[string]interface{}); ok {
+			r.MaintenanceSchedule = &dclService.InstanceMaintenanceSchedule{}
+			if _, ok := rMaintenanceSchedule["canReschedule"]; ok {
+				if b, ok := rMaintenanceSchedule["canReschedule"].(bool); ok {
+					r.MaintenanceSchedule.CanReschedule = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.MaintenanceSchedule.CanReschedule: expected bool")
+				}
+			}
+			if _, ok := rMaintenanceSchedule["endTime"]; ok {
+				if s, ok := rMaintenanceSchedule["endTime"].(string); ok {
+					r.MaintenanceSchedule.EndTime = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.MaintenanceSchedule.EndTime: expected string")
+				}
+			}
+			if _, ok := rMaintenanceSchedule["scheduleDeadlineTime"]; ok {
+				if s, ok := rMaintenanceSchedule["scheduleDeadlineTime"].(string); ok {
+					r.MaintenanceSchedule.ScheduleDeadlineTime = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.MaintenanceSchedule.ScheduleDeadlineTime: expected string")
+				}
+			}
+			if _, ok := rMaintenanceSchedule["startTime"]; ok {
+				if s, ok := rMaintenanceSchedule["startTime"].(string); ok {
+					r.MaintenanceSchedule.StartTime = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.MaintenanceSchedule.StartTime: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.MaintenanceSchedule: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["memorySizeGb"]; ok {
+		if i, ok := u.Object["memorySizeGb"].(int64); ok {
+			r.MemorySizeGb = dcl.Int64(i)
+		} else {
+			return nil, fmt.Errorf("r.MemorySizeGb: expected int64")
+		}
+	}
+	if _, ok := u.Object["name"]; ok {
+		if s, ok := u.Object["name"].(string); ok {
+			r.Name = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Name: expected string")
+		}
+	}
+	if _, ok := u.Object["persistenceIamIdentity"]; ok {
+		if s, ok := u.Object["persistenceIamIdentity"].(string); ok {
+			r.PersistenceIamIdentity = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.PersistenceIamIdentity: expected string")
+		}
+	}
+	if _, ok := u.Object["port"]; ok {
+		if i, ok := u.Object["port"].(int64); ok {
+			r.Port = dcl.Int64(i)
+		} else {
+			return nil, fmt.Errorf("r.Port: expected int64")
+		}
+	}
+	if _, ok := u.Object["project"]; ok {
+		if s, ok := u.Object["project"].(string); ok {
+			r.Project = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Project: expected string")
+		}
+	}
+	if _, ok := u.Object["redisConfigs"]; ok {
+		if rRedisConfigs, ok := u.Object["redisConfigs"].(map[string]interface{}); ok {
+			m := make(map[string]string)
+			for k, v := range rRedisConfigs {
+				if s, ok := v.(string); ok {
+					m[k] = s
+				}
+			}
+			r.RedisConfigs = m
+		} else {
+			return nil, fmt.Errorf("r.RedisConfigs: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["redisVersion"]; ok {
+		if s, ok := u.Object["redisVersion"].(string); ok {
+			r.RedisVersion = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.RedisVersion: expected string")
+		}
+	}
+	if _, ok := u.Object["reservedIpRange"]; ok {
+		if s, ok := u.Object["reservedIpRange"].(string); ok {
+			r.ReservedIPRange = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.ReservedIPRange: expected string")
+		}
+	}
+	if _, ok := u.Object["serverCaCerts"]; ok {
+		if s, ok := u.Object["serverCaCerts"].([]interface{}); ok {
+			for _, o := range s {
+				if objval, ok := o.(map[string]interface{}); ok {
+					var rServerCaCerts dclService.InstanceServerCaCerts
+					if _, ok := objval["cert"]; ok {
+						if s, ok := objval["cert"].(string); ok {
+							rServerCaCerts.Cert = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("rServerCaCerts.Cert: expected string")
+						}
+					}
+					if _, ok := objval["createTime"]; ok {
+						if s, ok := objval["createTime"].(string); ok {
+							rServerCaCerts.CreateTime = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("rServerCaCerts.CreateTime: expected string")
+						}
+					}
+					if _, ok := objval["expireTime"]; ok {
+						if s, ok := objval["expireTime"].(string); ok {
+							rServerCaCerts.ExpireTime = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("rServerCaCerts.ExpireTime: expected string")
+						}
+					}
+					if _, ok := objval["serialNumber"]; ok {
+						if s, ok := objval["serialNumber"].(string); ok {
+							rServerCaCerts.SerialNumber = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("rServerCaCerts.SerialNumber: expected string")
+						}
+					}
+					if _, ok := objval["sha1Fingerprint"]; ok {
+						if s, ok := objval["sha1Fingerprint"].(string); ok {
+							rServerCaCerts.Sha1Fingerprint = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("rServerCaCerts.Sha1Fingerprint: expected string")
+						}
+					}
+					r.ServerCaCerts = append(r.ServerCaCerts, rServerCaCerts)
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.ServerCaCerts: expected []interface{}")
+		}
+	}
+	if _, ok := u.Object["state"]; ok {
+		if s, ok := u.Object["state"].(string); ok {
+			r.State = dclService.InstanceStateEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.State: expected string")
+		}
+	}
+	if _, ok := u.Object["statusMessage"]; ok {
+		if s, ok := u.Object["statusMessage"].(string); ok {
+			r.StatusMessage = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.StatusMessage: expected string")
+		}
+	}
+	if _, ok := u.Object["tier"]; ok {
+		if s, ok := u.Object["tier"].(string); ok {
+			r.Tier = dclService.InstanceTierEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.Tier: expected string")
+		}
+	}
+	if _, ok := u.Object["transitEncryptionMode"]; ok {
+		if s, ok := u.Object["transitEncryptionMode"].(string); ok {
+			r.TransitEncryptionMode = dclService.InstanceTransitEncryptionModeEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.TransitEncryptionMode: expected string")
+		}
+	}
+	return r, nil
+}
+
+func GetInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetInstance(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToUnstructured(r), nil
+}
+
+func ListInstance(ctx context.Context, config *dcl.Config, project string, location string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListInstance(ctx, project, location)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, InstanceToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplyInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInstance(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplyInstance(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToUnstructured(r), nil
+}
+
+func InstanceHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInstance(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplyInstance(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func InstanceFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInstance(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffInstance(ctx, r, opts...)
+}
+
+func DeleteInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteInstance(ctx, r)
+}
+
+func InstanceID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *Instance) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"redis",
+		"Instance",
+		"beta",
+	}
+}
+
+func (r *Instance) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetInstance(ctx, config, resource)
+}
+
+func (r *Instance) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplyInstance(ctx, config, resource, opts...)
+}
+
+func (r *Instance) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return InstanceHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *Instance) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return InstanceFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *Instance) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteInstance(ctx, config, resource)
+}
+
+func (r *Instance) ID(resource *unstructured.Resource) (string, error) {
+	return InstanceID(resource)
+}
+
+func (r *Instance) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListInstance(ctx, config, project, location)
+}
+
+func (r *Instance) Schema() *dcl.Schema {
+	return dclService.DCLInstanceSchema()
+}
+
+func init() {
+	unstructured.Register(&Instance{})
+}
@@ -1206,6 +1206,22 @@ func JobHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resourc
 	return false, nil
 }
 
+func JobFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToJob(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToJob(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffJob(ctx, r, opts...)
+}
+
 func DeleteJob(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToJob(u)
@@ -1298,13 +1314,17 @@ func SetPolicyMemberJob(ctx context.Context, config *dcl.Config, u *unstructured
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberJob(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberJob(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToJob(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -1332,8 +1352,8 @@ func (r *Job) SetPolicyMember(ctx context.Context, config *dcl.Config, resource
 	return SetPolicyMemberJob(ctx, config, resource, member)
 }
 
-func (r *Job) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberJob(ctx, config, resource, role, member)
+func (r *Job) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberJob(ctx, config, resource, member)
 }
 
 func (r *Job) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -1364,6 +1384,10 @@ func (r *Job) HasDiff(ctx context.Context, config *dcl.Config, resource *unstruc
 	return JobHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Job) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return JobFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Job) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteJob(ctx, config, resource)
 }
@@ -1372,6 +1396,22 @@ func (r *Job) ID(resource *unstructured.Resource) (string, error) {
 	return JobID(resource)
 }
 
+func (r *Job) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListJob(ctx, config, project, location)
+}
+
+func (r *Job) Schema() *dcl.Schema {
+	return dclService.DCLJobSchema()
+}
+
 func init() {
 	unstructured.Register(&Job{})
 }
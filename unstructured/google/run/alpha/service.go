@@ -1182,6 +1182,22 @@ func ServiceHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Res
 	return false, nil
 }
 
+func ServiceFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToService(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToService(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffService(ctx, r, opts...)
+}
+
 func DeleteService(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToService(u)
@@ -1274,13 +1290,17 @@ func SetPolicyMemberService(ctx context.Context, config *dcl.Config, u *unstruct
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberService(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberService(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToService(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -1308,8 +1328,8 @@ func (r *Service) SetPolicyMember(ctx context.Context, config *dcl.Config, resou
 	return SetPolicyMemberService(ctx, config, resource, member)
 }
 
-func (r *Service) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberService(ctx, config, resource, role, member)
+func (r *Service) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberService(ctx, config, resource, member)
 }
 
 func (r *Service) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -1340,6 +1360,10 @@ func (r *Service) HasDiff(ctx context.Context, config *dcl.Config, resource *uns
 	return ServiceHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Service) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ServiceFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Service) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteService(ctx, config, resource)
 }
@@ -1348,6 +1372,22 @@ func (r *Service) ID(resource *unstructured.Resource) (string, error) {
 	return ServiceID(resource)
 }
 
+func (r *Service) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListService(ctx, config, project, location)
+}
+
+func (r *Service) Schema() *dcl.Schema {
+	return dclService.DCLServiceSchema()
+}
+
 func init() {
 	unstructured.Register(&Service{})
 }
@@ -0,0 +1,288 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package beta
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql/beta"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type Database struct{}
+
+func DatabaseToUnstructured(r *dclService.Database) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "sql",
+			Version: "beta",
+			Type:    "Database",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.Charset != nil {
+		u.Object["charset"] = *r.Charset
+	}
+	if r.Collation != nil {
+		u.Object["collation"] = *r.Collation
+	}
+	if r.Instance != nil {
+		u.Object["instance"] = *r.Instance
+	}
+	if r.Name != nil {
+		u.Object["name"] = *r.Name
+	}
+	if r.Project != nil {
+		u.Object["project"] = *r.Project
+	}
+	if r.SelfLink != nil {
+		u.Object["selfLink"] = *r.SelfLink
+	}
+	return u
+}
+
+func UnstructuredToDatabase(u *unstructured.Resource) (*dclService.Database, error) {
+	r := &dclService.Database{}
+	if _, ok := u.Object["charset"]; ok {
+		if s, ok := u.Object["charset"].(string); ok {
+			r.Charset = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Charset: expected string")
+		}
+	}
+	if _, ok := u.Object["collation"]; ok {
+		if s, ok := u.Object["collation"].(string); ok {
+			r.Collation = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Collation: expected string")
+		}
+	}
+	if _, ok := u.Object["instance"]; ok {
+		if s, ok := u.Object["instance"].(string); ok {
+			r.Instance = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Instance: expected string")
+		}
+	}
+	if _, ok := u.Object["name"]; ok {
+		if s, ok := u.Object["name"].(string); ok {
+			r.Name = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Name: expected string")
+		}
+	}
+	if _, ok := u.Object["project"]; ok {
+		if s, ok := u.Object["project"].(string); ok {
+			r.Project = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Project: expected string")
+		}
+	}
+	if _, ok := u.Object["selfLink"]; ok {
+		if s, ok := u.Object["selfLink"].(string); ok {
+			r.SelfLink = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.SelfLink: expected string")
+		}
+	}
+	return r, nil
+}
+
+func GetDatabase(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDatabase(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetDatabase(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return DatabaseToUnstructured(r), nil
+}
+
+func ListDatabase(ctx context.Context, config *dcl.Config, project string, instance string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListDatabase(ctx, project, instance)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, DatabaseToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplyDatabase(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDatabase(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToDatabase(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplyDatabase(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return DatabaseToUnstructured(r), nil
+}
+
+func DatabaseHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDatabase(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToDatabase(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplyDatabase(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func DatabaseFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDatabase(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToDatabase(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffDatabase(ctx, r, opts...)
+}
+
+func DeleteDatabase(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDatabase(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteDatabase(ctx, r)
+}
+
+func DatabaseID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToDatabase(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *Database) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"sql",
+		"Database",
+		"beta",
+	}
+}
+
+func (r *Database) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Database) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Database) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *Database) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Database) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Database) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Database) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetDatabase(ctx, config, resource)
+}
+
+func (r *Database) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplyDatabase(ctx, config, resource, opts...)
+}
+
+func (r *Database) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return DatabaseHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *Database) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return DatabaseFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *Database) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteDatabase(ctx, config, resource)
+}
+
+func (r *Database) ID(resource *unstructured.Resource) (string, error) {
+	return DatabaseID(resource)
+}
+
+func (r *Database) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	instance, err := unstructured.StringField(resource, "instance")
+	if err != nil {
+		return nil, err
+	}
+	return ListDatabase(ctx, config, project, instance)
+}
+
+func (r *Database) Schema() *dcl.Schema {
+	return dclService.DCLDatabaseSchema()
+}
+
+func init() {
+	unstructured.Register(&Database{})
+}
@@ -0,0 +1,1931 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package beta
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql/beta"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type Instance struct{}
+
+func InstanceToUnstructured(r *dclService.Instance) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "sql",
+			Version: "beta",
+			Type:    "Instance",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.BackendType != nil {
+		u.Object["backendType"] = string(*r.BackendType)
+	}
+	if r.ConnectionName != nil {
+		u.Object["connectionName"] = *r.ConnectionName
+	}
+	if r.CurrentDiskSize != nil && r.CurrentDiskSize != dclService.EmptyInstanceCurrentDiskSize {
+		rCurrentDiskSize := make(map[string]interface{})
+		if r.CurrentDiskSize.Value != nil {
+			rCurrentDiskSize["value"] = *r.CurrentDiskSize.Value
+		}
+		u.Object["currentDiskSize"] = rCurrentDiskSize
+	}
+	if r.DatabaseVersion != nil {
+		u.Object["databaseVersion"] = string(*r.DatabaseVersion)
+	}
+	if r.DiskEncryptionConfiguration != nil && r.DiskEncryptionConfiguration != dclService.EmptyInstanceDiskEncryptionConfiguration {
+		rDiskEncryptionConfiguration := make(map[string]interface{})
+		if r.DiskEncryptionConfiguration.Kind != nil {
+			rDiskEncryptionConfiguration["kind"] = *r.DiskEncryptionConfiguration.Kind
+		}
+		if r.DiskEncryptionConfiguration.KmsKeyName != nil {
+			rDiskEncryptionConfiguration["kmsKeyName"] = *r.DiskEncryptionConfiguration.KmsKeyName
+		}
+		u.Object["diskEncryptionConfiguration"] = rDiskEncryptionConfiguration
+	}
+	if r.DiskEncryptionStatus != nil && r.DiskEncryptionStatus != dclService.EmptyInstanceDiskEncryptionStatus {
+		rDiskEncryptionStatus := make(map[string]interface{})
+		if r.DiskEncryptionStatus.Kind != nil {
+			rDiskEncryptionStatus["kind"] = *r.DiskEncryptionStatus.Kind
+		}
+		if r.DiskEncryptionStatus.KmsKeyVersionName != nil {
+			rDiskEncryptionStatus["kmsKeyVersionName"] = *r.DiskEncryptionStatus.KmsKeyVersionName
+		}
+		u.Object["diskEncryptionStatus"] = rDiskEncryptionStatus
+	}
+	if r.Etag != nil {
+		u.Object["etag"] = *r.Etag
+	}
+	if r.FailoverReplica != nil && r.FailoverReplica != dclService.EmptyInstanceFailoverReplica {
+		rFailoverReplica := make(map[string]interface{})
+		if r.FailoverReplica.Available != nil {
+			rFailoverReplica["available"] = *r.FailoverReplica.Available
+		}
+		if r.FailoverReplica.FailoverInstance != nil && r.FailoverReplica.FailoverInstance != dclService.EmptyInstanceFailoverReplicaFailoverInstance {
+			rFailoverReplicaFailoverInstance := make(map[string]interface{})
+			if r.FailoverReplica.FailoverInstance.Name != nil {
+				rFailoverReplicaFailoverInstance["name"] = *r.FailoverReplica.FailoverInstance.Name
+			}
+			if r.FailoverReplica.FailoverInstance.Region != nil {
+				rFailoverReplicaFailoverInstance["region"] = *r.FailoverReplica.FailoverInstance.Region
+			}
+			rFailoverReplica["failoverInstance"] = rFailoverReplicaFailoverInstance
+		}
+		if r.FailoverReplica.Name != nil {
+			rFailoverReplica["name"] = *r.FailoverReplica.Name
+		}
+		u.Object["failoverReplica"] = rFailoverReplica
+	}
+	if r.GceZone != nil {
+		u.Object["gceZone"] = *r.GceZone
+	}
+	if r.InstanceType != nil {
+		u.Object["instanceType"] = string(*r.InstanceType)
+	}
+	if r.InstanceUid != nil {
+		u.Object["instanceUid"] = *r.InstanceUid
+	}
+	var rIPAddresses []interface{}
+	for _, rIPAddressesVal := range r.IPAddresses {
+		rIPAddressesObject := make(map[string]interface{})
+		if rIPAddressesVal.IPAddress != nil {
+			rIPAddressesObject["ipAddress"] = *rIPAddressesVal.IPAddress
+		}
+		if rIPAddressesVal.TimeToRetire != nil && rIPAddressesVal.TimeToRetire != dclService.EmptyInstanceIPAddressesTimeToRetire {
+			rIPAddressesValTimeToRetire := make(map[string]interface{})
+			if rIPAddressesVal.TimeToRetire.Nanos != nil {
+				rIPAddressesValTimeToRetire["nanos"] = *rIPAddressesVal.TimeToRetire.Nanos
+			}
+			if rIPAddressesVal.TimeToRetire.Seconds != nil {
+				rIPAddressesValTimeToRetire["seconds"] = *rIPAddressesVal.TimeToRetire.Seconds
+			}
+			rIPAddressesObject["timeToRetire"] = rIPAddressesValTimeToRetire
+		}
+		if rIPAddressesVal.Type != nil {
+			rIPAddressesObject["type"] = string(*rIPAddressesVal.Type)
+		}
+		rIPAddresses = append(rIPAddresses, rIPAddressesObject)
+	}
+	u.Object["ipAddresses"] = rIPAddresses
+	if r.IPv6Address != nil {
+		u.Object["ipv6Address"] = *r.IPv6Address
+	}
+	if r.MasterInstance != nil && r.MasterInstance != dclService.EmptyInstanceMasterInstance {
+		rMasterInstance := make(map[string]interface{})
+		if r.MasterInstance.Name != nil {
+			rMasterInstance["name"] = *r.MasterInstance.Name
+		}
+		if r.MasterInstance.Region != nil {
+			rMasterInstance["region"] = *r.MasterInstance.Region
+		}
+		u.Object["masterInstance"] = rMasterInstance
+	}
+	if r.MasterInstanceName != nil {
+		u.Object["masterInstanceName"] = *r.MasterInstanceName
+	}
+	if r.MaxDiskSize != nil && r.MaxDiskSize != dclService.EmptyInstanceMaxDiskSize {
+		rMaxDiskSize := make(map[string]interface{})
+		if r.MaxDiskSize.Value != nil {
+			rMaxDiskSize["value"] = *r.MaxDiskSize.Value
+		}
+		u.Object["maxDiskSize"] = rMaxDiskSize
+	}
+	if r.Name != nil {
+		u.Object["name"] = *r.Name
+	}
+	if r.OnPremisesConfiguration != nil && r.OnPremisesConfiguration != dclService.EmptyInstanceOnPremisesConfiguration {
+		rOnPremisesConfiguration := make(map[string]interface{})
+		if r.OnPremisesConfiguration.CaCertificate != nil {
+			rOnPremisesConfiguration["caCertificate"] = *r.OnPremisesConfiguration.CaCertificate
+		}
+		if r.OnPremisesConfiguration.ClientCertificate != nil {
+			rOnPremisesConfiguration["clientCertificate"] = *r.OnPremisesConfiguration.ClientCertificate
+		}
+		if r.OnPremisesConfiguration.ClientKey != nil {
+			rOnPremisesConfiguration["clientKey"] = *r.OnPremisesConfiguration.ClientKey
+		}
+		if r.OnPremisesConfiguration.Database != nil {
+			rOnPremisesConfiguration["database"] = *r.OnPremisesConfiguration.Database
+		}
+		if r.OnPremisesConfiguration.DumpFilePath != nil {
+			rOnPremisesConfiguration["dumpFilePath"] = *r.OnPremisesConfiguration.DumpFilePath
+		}
+		if r.OnPremisesConfiguration.HostPort != nil {
+			rOnPremisesConfiguration["hostPort"] = *r.OnPremisesConfiguration.HostPort
+		}
+		if r.OnPremisesConfiguration.Kind != nil {
+			rOnPremisesConfiguration["kind"] = *r.OnPremisesConfiguration.Kind
+		}
+		if r.OnPremisesConfiguration.Password != nil {
+			rOnPremisesConfiguration["password"] = *r.OnPremisesConfiguration.Password
+		}
+		var rOnPremisesConfigurationReplicatedDatabases []interface{}
+		for _, rOnPremisesConfigurationReplicatedDatabasesVal := range r.OnPremisesConfiguration.ReplicatedDatabases {
+			rOnPremisesConfigurationReplicatedDatabases = append(rOnPremisesConfigurationReplicatedDatabases, rOnPremisesConfigurationReplicatedDatabasesVal)
+		}
+		rOnPremisesConfiguration["replicatedDatabases"] = rOnPremisesConfigurationReplicatedDatabases
+		if r.OnPremisesConfiguration.Username != nil {
+			rOnPremisesConfiguration["username"] = *r.OnPremisesConfiguration.Username
+		}
+		u.Object["onPremisesConfiguration"] = rOnPremisesConfiguration
+	}
+	if r.Project != nil {
+		u.Object["project"] = *r.Project
+	}
+	if r.Region != nil {
+		u.Object["region"] = *r.Region
+	}
+	if r.ReplicaConfiguration != nil && r.ReplicaConfiguration != dclService.EmptyInstanceReplicaConfiguration {
+		rReplicaConfiguration := make(map[string]interface{})
+		if r.ReplicaConfiguration.FailoverTarget != nil {
+			rReplicaConfiguration["failoverTarget"] = *r.ReplicaConfiguration.FailoverTarget
+		}
+		if r.ReplicaConfiguration.Kind != nil {
+			rReplicaConfiguration["kind"] = *r.ReplicaConfiguration.Kind
+		}
+		if r.ReplicaConfiguration.MysqlReplicaConfiguration != nil && r.ReplicaConfiguration.MysqlReplicaConfiguration != dclService.EmptyInstanceReplicaConfigurationMysqlReplicaConfiguration {
+			rReplicaConfigurationMysqlReplicaConfiguration := make(map[string]interface{})
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.CaCertificate != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["caCertificate"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.CaCertificate
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientCertificate != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["clientCertificate"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientCertificate
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientKey != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["clientKey"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientKey
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.ConnectRetryInterval != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["connectRetryInterval"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.ConnectRetryInterval
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.DumpFilePath != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["dumpFilePath"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.DumpFilePath
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.Kind != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["kind"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.Kind
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod != nil && r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod != dclService.EmptyInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod {
+				rReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod := make(map[string]interface{})
+				if r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod.Value != nil {
+					rReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod["value"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod.Value
+				}
+				rReplicaConfigurationMysqlReplicaConfiguration["masterHeartbeatPeriod"] = rReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.Password != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["password"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.Password
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.SslCipher != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["sslCipher"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.SslCipher
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.Username != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["username"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.Username
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.VerifyServerCertificate != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["verifyServerCertificate"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.VerifyServerCertificate
+			}
+			rReplicaConfiguration["mysqlReplicaConfiguration"] = rReplicaConfigurationMysqlReplicaConfiguration
+		}
+		if r.ReplicaConfiguration.ReplicaPoolConfiguration != nil && r.ReplicaConfiguration.ReplicaPoolConfiguration != dclService.EmptyInstanceReplicaConfigurationReplicaPoolConfiguration {
+			rReplicaConfigurationReplicaPoolConfiguration := make(map[string]interface{})
+			if r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration != nil && r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration != dclService.EmptyInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration {
+				rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration := make(map[string]interface{})
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.Kind != nil {
+					rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["kind"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.Kind
+				}
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MaxReplicaCount != nil {
+					rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["maxReplicaCount"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MaxReplicaCount
+				}
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MinReplicaCount != nil {
+					rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["minReplicaCount"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MinReplicaCount
+				}
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.TargetCpuUtil != nil {
+					rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["targetCpuUtil"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.TargetCpuUtil
+				}
+				rReplicaConfigurationReplicaPoolConfiguration["autoscalingPoolConfiguration"] = rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration
+			}
+			if r.ReplicaConfiguration.ReplicaPoolConfiguration.ExposeReplicaIP != nil {
+				rReplicaConfigurationReplicaPoolConfiguration["exposeReplicaIp"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.ExposeReplicaIP
+			}
+			if r.ReplicaConfiguration.ReplicaPoolConfiguration.Kind != nil {
+				rReplicaConfigurationReplicaPoolConfiguration["kind"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.Kind
+			}
+			if r.ReplicaConfiguration.ReplicaPoolConfiguration.ReplicaCount != nil {
+				rReplicaConfigurationReplicaPoolConfiguration["replicaCount"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.ReplicaCount
+			}
+			if r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration != nil && r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration != dclService.EmptyInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration {
+				rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration := make(map[string]interface{})
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ExposeReplicaIP != nil {
+					rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["exposeReplicaIp"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ExposeReplicaIP
+				}
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.Kind != nil {
+					rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["kind"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.Kind
+				}
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ReplicaCount != nil {
+					rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["replicaCount"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ReplicaCount
+				}
+				rReplicaConfigurationReplicaPoolConfiguration["staticPoolConfiguration"] = rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration
+			}
+			rReplicaConfiguration["replicaPoolConfiguration"] = rReplicaConfigurationReplicaPoolConfiguration
+		}
+		u.Object["replicaConfiguration"] = rReplicaConfiguration
+	}
+	var rReplicaInstances []interface{}
+	for _, rReplicaInstancesVal := range r.ReplicaInstances {
+		rReplicaInstancesObject := make(map[string]interface{})
+		if rReplicaInstancesVal.Name != nil {
+			rReplicaInstancesObject["name"] = *rReplicaInstancesVal.Name
+		}
+		if rReplicaInstancesVal.Region != nil {
+			rReplicaInstancesObject["region"] = *rReplicaInstancesVal.Region
+		}
+		rReplicaInstances = append(rReplicaInstances, rReplicaInstancesObject)
+	}
+	u.Object["replicaInstances"] = rReplicaInstances
+	if r.RootPassword != nil {
+		u.Object["rootPassword"] = *r.RootPassword
+	}
+	if r.ScheduledMaintenance != nil && r.ScheduledMaintenance != dclService.EmptyInstanceScheduledMaintenance {
+		rScheduledMaintenance := make(map[string]interface{})
+		if r.ScheduledMaintenance.CanDefer != nil {
+			rScheduledMaintenance["canDefer"] = *r.ScheduledMaintenance.CanDefer
+		}
+		if r.ScheduledMaintenance.CanReschedule != nil {
+			rScheduledMaintenance["canReschedule"] = *r.ScheduledMaintenance.CanReschedule
+		}
+		if r.ScheduledMaintenance.StartTime != nil && r.ScheduledMaintenance.StartTime != dclService.EmptyInstanceScheduledMaintenanceStartTime {
+			rScheduledMaintenanceStartTime := make(map[string]interface{})
+			if r.ScheduledMaintenance.StartTime.Nanos != nil {
+				rScheduledMaintenanceStartTime["nanos"] = *r.ScheduledMaintenance.StartTime.Nanos
+			}
+			if r.ScheduledMaintenance.StartTime.Seconds != nil {
+				rScheduledMaintenanceStartTime["seconds"] = *r.ScheduledMaintenance.StartTime.Seconds
+			}
+			rScheduledMaintenance["startTime"] = rScheduledMaintenanceStartTime
+		}
+		u.Object["scheduledMaintenance"] = rScheduledMaintenance
+	}
+	if r.ServerCaCert != nil && r.ServerCaCert != dclService.EmptyInstanceServerCaCert {
+		rServerCaCert := make(map[string]interface{})
+		if r.ServerCaCert.Cert != nil {
+			rServerCaCert["cert"] = *r.ServerCaCert.Cert
+		}
+		if r.ServerCaCert.CertSerialNumber != nil {
+			rServerCaCert["certSerialNumber"] = *r.ServerCaCert.CertSerialNumber
+		}
+		if r.ServerCaCert.CommonName != nil {
+			rServerCaCert["commonName"] = *r.ServerCaCert.CommonName
+		}
+		if r.ServerCaCert.CreateTime != nil {
+			rServerCaCert["createTime"] = *r.ServerCaCert.CreateTime
+		}
+		if r.ServerCaCert.ExpirationTime != nil {
+			rServerCaCert["expirationTime"] = *r.ServerCaCert.ExpirationTime
+		}
+		if r.ServerCaCert.Instance != nil {
+			rServerCaCert["instance"] = *r.ServerCaCert.Instance
+		}
+		if r.ServerCaCert.Kind != nil {
+			rServerCaCert["kind"] = *r.ServerCaCert.Kind
+		}
+		if r.ServerCaCert.Sha1Fingerprint != nil {
+			rServerCaCert["sha1Fingerprint"] = *r.ServerCaCert.Sha1Fingerprint
+		}
+		u.Object["serverCaCert"] = rServerCaCert
+	}
+	if r.ServiceAccountEmailAddress != nil {
+		u.Object["serviceAccountEmailAddress"] = *r.ServiceAccountEmailAddress
+	}
+	if r.Settings != nil && r.Settings != dclService.EmptyInstanceSettings {
+		rSettings := make(map[string]interface{})
+		if r.Settings.ActivationPolicy != nil {
+			rSettings["activationPolicy"] = string(*r.Settings.ActivationPolicy)
+		}
+		if r.Settings.ActiveDirectoryConfig != nil && r.Settings.ActiveDirectoryConfig != dclService.EmptyInstanceSettingsActiveDirectoryConfig {
+			rSettingsActiveDirectoryConfig := make(map[string]interface{})
+			if r.Settings.ActiveDirectoryConfig.Domain != nil {
+				rSettingsActiveDirectoryConfig["domain"] = *r.Settings.ActiveDirectoryConfig.Domain
+			}
+			if r.Settings.ActiveDirectoryConfig.Kind != nil {
+				rSettingsActiveDirectoryConfig["kind"] = *r.Settings.ActiveDirectoryConfig.Kind
+			}
+			rSettings["activeDirectoryConfig"] = rSettingsActiveDirectoryConfig
+		}
+		var rSettingsAuthorizedGaeApplications []interface{}
+		for _, rSettingsAuthorizedGaeApplicationsVal := range r.Settings.AuthorizedGaeApplications {
+			rSettingsAuthorizedGaeApplications = append(rSettingsAuthorizedGaeApplications, rSettingsAuthorizedGaeApplicationsVal)
+		}
+		rSettings["authorizedGaeApplications"] = rSettingsAuthorizedGaeApplications
+		if r.Settings.AvailabilityType != nil {
+			rSettings["availabilityType"] = string(*r.Settings.AvailabilityType)
+		}
+		if r.Settings.BackupConfiguration != nil && r.Settings.BackupConfiguration != dclService.EmptyInstanceSettingsBackupConfiguration {
+			rSettingsBackupConfiguration := make(map[string]interface{})
+			if r.Settings.BackupConfiguration.BackupRetentionSettings != nil && r.Settings.BackupConfiguration.BackupRetentionSettings != dclService.EmptyInstanceSettingsBackupConfigurationBackupRetentionSettings {
+				rSettingsBackupConfigurationBackupRetentionSettings := make(map[string]interface{})
+				if r.Settings.BackupConfiguration.BackupRetentionSettings.RetainedBackups != nil {
+					rSettingsBackupConfigurationBackupRetentionSettings["retainedBackups"] = *r.Settings.BackupConfiguration.BackupRetentionSettings.RetainedBackups
+				}
+				if r.Settings.BackupConfiguration.BackupRetentionSettings.RetentionUnit != nil {
+					rSettingsBackupConfigurationBackupRetentionSettings["retentionUnit"] = string(*r.Settings.BackupConfiguration.BackupRetentionSettings.RetentionUnit)
+				}
+				rSettingsBackupConfiguration["backupRetentionSettings"] = rSettingsBackupConfigurationBackupRetentionSettings
+			}
+			if r.Settings.BackupConfiguration.BinaryLogEnabled != nil {
+				rSettingsBackupConfiguration["binaryLogEnabled"] = *r.Settings.BackupConfiguration.BinaryLogEnabled
+			}
+			if r.Settings.BackupConfiguration.Enabled != nil {
+				rSettingsBackupConfiguration["enabled"] = *r.Settings.BackupConfiguration.Enabled
+			}
+			if r.Settings.BackupConfiguration.Kind != nil {
+				rSettingsBackupConfiguration["kind"] = *r.Settings.BackupConfiguration.Kind
+			}
+			if r.Settings.BackupConfiguration.Location != nil {
+				rSettingsBackupConfiguration["location"] = *r.Settings.BackupConfiguration.Location
+			}
+			if r.Settings.BackupConfiguration.StartTime != nil {
+				rSettingsBackupConfiguration["startTime"] = *r.Settings.BackupConfiguration.StartTime
+			}
+			if r.Settings.BackupConfiguration.TransactionLogRetentionDays != nil {
+				rSettingsBackupConfiguration["transactionLogRetentionDays"] = *r.Settings.BackupConfiguration.TransactionLogRetentionDays
+			}
+			rSettings["backupConfiguration"] = rSettingsBackupConfiguration
+		}
+		if r.Settings.Collation != nil {
+			rSettings["collation"] = *r.Settings.Collation
+		}
+		if r.Settings.CrashSafeReplicationEnabled != nil {
+			rSettings["crashSafeReplicationEnabled"] = *r.Settings.CrashSafeReplicationEnabled
+		}
+		if r.Settings.DataDiskSizeGb != nil && r.Settings.DataDiskSizeGb != dclService.EmptyInstanceSettingsDataDiskSizeGb {
+			rSettingsDataDiskSizeGb := make(map[string]interface{})
+			if r.Settings.DataDiskSizeGb.Value != nil {
+				rSettingsDataDiskSizeGb["value"] = *r.Settings.DataDiskSizeGb.Value
+			}
+			rSettings["dataDiskSizeGb"] = rSettingsDataDiskSizeGb
+		}
+		if r.Settings.DataDiskType != nil {
+			rSettings["dataDiskType"] = string(*r.Settings.DataDiskType)
+		}
+		var rSettingsDatabaseFlags []interface{}
+		for _, rSettingsDatabaseFlagsVal := range r.Settings.DatabaseFlags {
+			rSettingsDatabaseFlagsObject := make(map[string]interface{})
+			if rSettingsDatabaseFlagsVal.Name != nil {
+				rSettingsDatabaseFlagsObject["name"] = *rSettingsDatabaseFlagsVal.Name
+			}
+			if rSettingsDatabaseFlagsVal.Value != nil {
+				rSettingsDatabaseFlagsObject["value"] = *rSettingsDatabaseFlagsVal.Value
+			}
+			rSettingsDatabaseFlags = append(rSettingsDatabaseFlags, rSettingsDatabaseFlagsObject)
+		}
+		rSettings["databaseFlags"] = rSettingsDatabaseFlags
+		if r.Settings.DatabaseReplicationEnabled != nil {
+			rSettings["databaseReplicationEnabled"] = *r.Settings.DatabaseReplicationEnabled
+		}
+		var rSettingsDenyMaintenancePeriods []interface{}
+		for _, rSettingsDenyMaintenancePeriodsVal := range r.Settings.DenyMaintenancePeriods {
+			rSettingsDenyMaintenancePeriodsObject := make(map[string]interface{})
+			if rSettingsDenyMaintenancePeriodsVal.EndDate != nil {
+				rSettingsDenyMaintenancePeriodsObject["endDate"] = *rSettingsDenyMaintenancePeriodsVal.EndDate
+			}
+			if rSettingsDenyMaintenancePeriodsVal.StartDate != nil {
+				rSettingsDenyMaintenancePeriodsObject["startDate"] = *rSettingsDenyMaintenancePeriodsVal.StartDate
+			}
+			if rSettingsDenyMaintenancePeriodsVal.Time != nil {
+				rSettingsDenyMaintenancePeriodsObject["time"] = *rSettingsDenyMaintenancePeriodsVal.Time
+			}
+			rSettingsDenyMaintenancePeriods = append(rSettingsDenyMaintenancePeriods, rSettingsDenyMaintenancePeriodsObject)
+		}
+		rSettings["denyMaintenancePeriods"] = rSettingsDenyMaintenancePeriods
+		if r.Settings.InsightsConfig != nil && r.Settings.InsightsConfig != dclService.EmptyInstanceSettingsInsightsConfig {
+			rSettingsInsightsConfig := make(map[string]interface{})
+			if r.Settings.InsightsConfig.QueryInsightsEnabled != nil {
+				rSettingsInsightsConfig["queryInsightsEnabled"] = *r.Settings.InsightsConfig.QueryInsightsEnabled
+			}
+			if r.Settings.InsightsConfig.QueryStringLength != nil {
+				rSettingsInsightsConfig["queryStringLength"] = *r.Settings.InsightsConfig.QueryStringLength
+			}
+			if r.Settings.InsightsConfig.RecordApplicationTags != nil {
+				rSettingsInsightsConfig["recordApplicationTags"] = *r.Settings.InsightsConfig.RecordApplicationTags
+			}
+			if r.Settings.InsightsConfig.RecordClientAddress != nil {
+				rSettingsInsightsConfig["recordClientAddress"] = *r.Settings.InsightsConfig.RecordClientAddress
+			}
+			rSettings["insightsConfig"] = rSettingsInsightsConfig
+		}
+		if r.Settings.IPConfiguration != nil && r.Settings.IPConfiguration != dclService.EmptyInstanceSettingsIPConfiguration {
+			rSettingsIPConfiguration := make(map[string]interface{})
+			var rSettingsIPConfigurationAuthorizedNetworks []interface{}
+			for _, rSettingsIPConfigurationAuthorizedNetworksVal := range r.Settings.IPConfiguration.AuthorizedNetworks {
+				rSettingsIPConfigurationAuthorizedNetworksObject := make(map[string]interface{})
+				if rSettingsIPConfigurationAuthorizedNetworksVal.ExpirationTime != nil {
+					rSettingsIPConfigurationAuthorizedNetworksObject["expirationTime"] = *rSettingsIPConfigurationAuthorizedNetworksVal.ExpirationTime
+				}
+				if rSettingsIPConfigurationAuthorizedNetworksVal.Kind != nil {
+					rSettingsIPConfigurationAuthorizedNetworksObject["kind"] = *rSettingsIPConfigurationAuthorizedNetworksVal.Kind
+				}
+				if rSettingsIPConfigurationAuthorizedNetworksVal.Name != nil {
+					rSettingsIPConfigurationAuthorizedNetworksObject["name"] = *rSettingsIPConfigurationAuthorizedNetworksVal.Name
+				}
+				if rSettingsIPConfigurationAuthorizedNetworksVal.Value != nil {
+					rSettingsIPConfigurationAuthorizedNetworksObject["value"] = *rSettingsIPConfigurationAuthorizedNetworksVal.Value
+				}
+				rSettingsIPConfigurationAuthorizedNetworks = append(rSettingsIPConfigurationAuthorizedNetworks, rSettingsIPConfigurationAuthorizedNetworksObject)
+			}
+			rSettingsIPConfiguration["authorizedNetworks"] = rSettingsIPConfigurationAuthorizedNetworks
+			if r.Settings.IPConfiguration.IPv4Enabled != nil {
+				rSettingsIPConfiguration["ipv4Enabled"] = *r.Settings.IPConfiguration.IPv4Enabled
+			}
+			if r.Settings.IPConfiguration.PrivateNetwork != nil {
+				rSettingsIPConfiguration["privateNetwork"] = *r.Settings.IPConfiguration.PrivateNetwork
+			}
+			if r.Settings.IPConfiguration.RequireSsl != nil {
+				rSettingsIPConfiguration["requireSsl"] = *r.Settings.IPConfiguration.RequireSsl
+			}
+			rSettings["ipConfiguration"] = rSettingsIPConfiguration
+		}
+		if r.Settings.Kind != nil {
+			rSettings["kind"] = *r.Settings.Kind
+		}
+		if r.Settings.LocationPreference != nil && r.Settings.LocationPreference != dclService.EmptyInstanceSettingsLocationPreference {
+			rSettingsLocationPreference := make(map[string]interface{})
+			if r.Settings.LocationPreference.Kind != nil {
+				rSettingsLocationPreference["kind"] = *r.Settings.LocationPreference.Kind
+			}
+			if r.Settings.LocationPreference.Zone != nil {
+				rSettingsLocationPreference["zone"] = *r.Settings.LocationPreference.Zone
+			}
+			rSettings["locationPreference"] = rSettingsLocationPreference
+		}
+		if r.Settings.MaintenanceWindow != nil && r.Settings.MaintenanceWindow != dclService.EmptyInstanceSettingsMaintenanceWindow {
+			rSettingsMaintenanceWindow := make(map[string]interface{})
+			if r.Settings.MaintenanceWindow.Day != nil {
+				rSettingsMaintenanceWindow["day"] = *r.Settings.MaintenanceWindow.Day
+			}
+			if r.Settings.MaintenanceWindow.Hour != nil {
+				rSettingsMaintenanceWindow["hour"] = *r.Settings.MaintenanceWindow.Hour
+			}
+			if r.Settings.MaintenanceWindow.Kind != nil {
+				rSettingsMaintenanceWindow["kind"] = *r.Settings.MaintenanceWindow.Kind
+			}
+			if r.Settings.MaintenanceWindow.UpdateTrack != nil {
+				rSettingsMaintenanceWindow["updateTrack"] = string(*r.Settings.MaintenanceWindow.UpdateTrack)
+			}
+			rSettings["maintenanceWindow"] = rSettingsMaintenanceWindow
+		}
+		if r.Settings.PricingPlan != nil {
+			rSettings["pricingPlan"] = string(*r.Settings.PricingPlan)
+		}
+		if r.Settings.ReplicationType != nil {
+			rSettings["replicationType"] = string(*r.Settings.ReplicationType)
+		}
+		if r.Settings.SettingsVersion != nil && r.Settings.SettingsVersion != dclService.EmptyInstanceSettingsSettingsVersion {
+			rSettingsSettingsVersion := make(map[string]interface{})
+			if r.Settings.SettingsVersion.Value != nil {
+				rSettingsSettingsVersion["value"] = *r.Settings.SettingsVersion.Value
+			}
+			rSettings["settingsVersion"] = rSettingsSettingsVersion
+		}
+		if r.Settings.StorageAutoResize != nil {
+			rSettings["storageAutoResize"] = *r.Settings.StorageAutoResize
+		}
+		if r.Settings.StorageAutoResizeLimit != nil && r.Settings.StorageAutoResizeLimit != dclService.EmptyInstanceSettingsStorageAutoResizeLimit {
+			rSettingsStorageAutoResizeLimit := make(map[string]interface{})
+			if r.Settings.StorageAutoResizeLimit.Value != nil {
+				rSettingsStorageAutoResizeLimit["value"] = *r.Settings.StorageAutoResizeLimit.Value
+			}
+			rSettings["storageAutoResizeLimit"] = rSettingsStorageAutoResizeLimit
+		}
+		if r.Settings.Tier != nil {
+			rSettings["tier"] = *r.Settings.Tier
+		}
+		if r.Settings.UserLabels != nil {
+			rSettingsUserLabels := make(map[string]interface{})
+			for k, v := range r.Settings.UserLabels {
+				rSettingsUserLabels[k] = v
+			}
+			rSettings["userLabels"] = rSettingsUserLabels
+		}
+		u.Object["settings"] = rSettings
+	}
+	if r.State != nil {
+		u.Object["state"] = *r.State
+	}
+	var rSuspensionReason []interface{}
+	for _, rSuspensionReasonVal := range r.SuspensionReason {
+		rSuspensionReason = append(rSuspensionReason, rSuspensionReasonVal)
+	}
+	u.Object["suspensionReason"] = rSuspensionReason
+	return u
+}
+
+func UnstructuredToInstance(u *unstructured.Resource) (*dclService.Instance, error) {
+	r := &dclService.Instance{}
+	if _, ok := u.Object["backendType"]; ok {
+		if s, ok := u.Object["backendType"].(string); ok {
+			r.BackendType = dclService.InstanceBackendTypeEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.BackendType: expected string")
+		}
+	}
+	if _, ok := u.Object["connectionName"]; ok {
+		if s, ok := u.Object["connectionName"].(string); ok {
+			r.ConnectionName = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.ConnectionName: expected string")
+		}
+	}
+	if _, ok := u.Object["currentDiskSize"]; ok {
+		if rCurrentDiskSize, ok := u.Object["currentDiskSize"].(map[string]interface{}); ok {
+			r.CurrentDiskSize = &dclService.InstanceCurrentDiskSize{}
+			if _, ok := rCurrentDiskSize["value"]; ok {
+				if i, ok := rCurrentDiskSize["value"].(int64); ok {
+					r.CurrentDiskSize.Value = dcl.Int64(i)
+				} else {
+					return nil, fmt.Errorf("r.CurrentDiskSize.Value: expected int64")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.CurrentDiskSize: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["databaseVersion"]; ok {
+		if s, ok := u.Object["databaseVersion"].(string); ok {
+			r.DatabaseVersion = dclService.InstanceDatabaseVersionEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.DatabaseVersion: expected string")
+		}
+	}
+	if _, ok := u.Object["diskEncryptionConfiguration"]; ok {
+		if rDiskEncryptionConfiguration, ok := u.Object["diskEncryptionConfiguration"].(map[string]interface{}); ok {
+			r.DiskEncryptionConfiguration = &dclService.InstanceDiskEncryptionConfiguration{}
+			if _, ok := rDiskEncryptionConfiguration["kind"]; ok {
+				if s, ok := rDiskEncryptionConfiguration["kind"].(string); ok {
+					r.DiskEncryptionConfiguration.Kind = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.DiskEncryptionConfiguration.Kind: expected string")
+				}
+			}
+			if _, ok := rDiskEncryptionConfiguration["kmsKeyName"]; ok {
+				if s, ok := rDiskEncryptionConfiguration["kmsKeyName"].(string); ok {
+					r.DiskEncryptionConfiguration.KmsKeyName = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.DiskEncryptionConfiguration.KmsKeyName: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.DiskEncryptionConfiguration: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["diskEncryptionStatus"]; ok {
+		if rDiskEncryptionStatus, ok := u.Object["diskEncryptionStatus"].(map[string]interface{}); ok {
+			r.DiskEncryptionStatus = &dclService.InstanceDiskEncryptionStatus{}
+			if _, ok := rDiskEncryptionStatus["kind"]; ok {
+				if s, ok := rDiskEncryptionStatus["kind"].(string); ok {
+					r.DiskEncryptionStatus.Kind = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.DiskEncryptionStatus.Kind: expected string")
+				}
+			}
+			if _, ok := rDiskEncryptionStatus["kmsKeyVersionName"]; ok {
+				if s, ok := rDiskEncryptionStatus["kmsKeyVersionName"].(string); ok {
+					r.DiskEncryptionStatus.KmsKeyVersionName = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.DiskEncryptionStatus.KmsKeyVersionName: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.DiskEncryptionStatus: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["etag"]; ok {
+		if s, ok := u.Object["etag"].(string); ok {
+			r.Etag = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Etag: expected string")
+		}
+	}
+	if _, ok := u.Object["failoverReplica"]; ok {
+		if rFailoverReplica, ok := u.Object["failoverReplica"].(map[string]interface{}); ok {
+			r.FailoverReplica = &dclService.InstanceFailoverReplica{}
+			if _, ok := rFailoverReplica["available"]; ok {
+				if b, ok := rFailoverReplica["available"].(bool); ok {
+					r.FailoverReplica.Available = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.FailoverReplica.Available: expected bool")
+				}
+			}
+			if _, ok := rFailoverReplica["failoverInstance"]; ok {
+				if rFailoverReplicaFailoverInstance, ok := rFailoverReplica["failoverInstance"].(map[string]interface{}); ok {
+					r.FailoverReplica.FailoverInstance = &dclService.InstanceFailoverReplicaFailoverInstance{}
+					if _, ok := rFailoverReplicaFailoverInstance["name"]; ok {
+						if s, ok := rFailoverReplicaFailoverInstance["name"].(string); ok {
+							r.FailoverReplica.FailoverInstance.Name = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.FailoverReplica.FailoverInstance.Name: expected string")
+						}
+					}
+					if _, ok := rFailoverReplicaFailoverInstance["region"]; ok {
+						if s, ok := rFailoverReplicaFailoverInstance["region"].(string); ok {
+							r.FailoverReplica.FailoverInstance.Region = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.FailoverReplica.FailoverInstance.Region: expected string")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.FailoverReplica.FailoverInstance: expected map[string]interface{}")
+				}
+			}
+			if _, ok := rFailoverReplica["name"]; ok {
+				if s, ok := rFailoverReplica["name"].(string); ok {
+					r.FailoverReplica.Name = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.FailoverReplica.Name: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.FailoverReplica: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["gceZone"]; ok {
+		if s, ok := u.Object["gceZone"].(string); ok {
+			r.GceZone = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.GceZone: expected string")
+		}
+	}
+	if _, ok := u.Object["instanceType"]; ok {
+		if s, ok := u.Object["instanceType"].(string); ok {
+			r.InstanceType = dclService.InstanceInstanceTypeEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.InstanceType: expected string")
+		}
+	}
+	if _, ok := u.Object["instanceUid"]; ok {
+		if s, ok := u.Object["instanceUid"].(string); ok {
+			r.InstanceUid = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.InstanceUid: expected string")
+		}
+	}
+	if _, ok := u.Object["ipAddresses"]; ok {
+		if s, ok := u.Object["ipAddresses"].([]interface{}); ok {
+			for _, o := range s {
+				if objval, ok := o.(map[string]interface{}); ok {
+					var rIPAddresses dclService.InstanceIPAddresses
+					if _, ok := objval["ipAddress"]; ok {
+						if s, ok := objval["ipAddress"].(string); ok {
+							rIPAddresses.IPAddress = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("rIPAddresses.IPAddress: expected string")
+						}
+					}
+					if _, ok := objval["timeToRetire"]; ok {
+						if rIPAddressesTimeToRetire, ok := objval["timeToRetire"].(map[string]interface{}); ok {
+							rIPAddresses.TimeToRetire = &dclService.InstanceIPAddressesTimeToRetire{}
+							if _, ok := rIPAddressesTimeToRetire["nanos"]; ok {
+								if i, ok := rIPAddressesTimeToRetire["nanos"].(int64); ok {
+									rIPAddresses.TimeToRetire.Nanos = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("rIPAddresses.TimeToRetire.Nanos: expected int64")
+								}
+							}
+							if _, ok := rIPAddressesTimeToRetire["seconds"]; ok {
+								if i, ok := rIPAddressesTimeToRetire["seconds"].(int64); ok {
+									rIPAddresses.TimeToRetire.Seconds = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("rIPAddresses.TimeToRetire.Seconds: expected int64")
+								}
+							}
+						} else {
+							return nil, fmt.Errorf("rIPAddresses.TimeToRetire: expected map[string]interface{}")
+						}
+					}
+					if _, ok := objval["type"]; ok {
+						if s, ok := objval["type"].(string); ok {
+							rIPAddresses.Type = dclService.InstanceIPAddressesTypeEnumRef(s)
+						} else {
+							return nil, fmt.Errorf("rIPAddresses.Type: expected string")
+						}
+					}
+					r.IPAddresses = append(r.IPAddresses, rIPAddresses)
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.IPAddresses: expected []interface{}")
+		}
+	}
+	if _, ok := u.Object["ipv6Address"]; ok {
+		if s, ok := u.Object["ipv6Address"].(string); ok {
+			r.IPv6Address = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.IPv6Address: expected string")
+		}
+	}
+	if _, ok := u.Object["masterInstance"]; ok {
+		if rMasterInstance, ok := u.Object["masterInstance"].(map[string]interface{}); ok {
+			r.MasterInstance = &dclService.InstanceMasterInstance{}
+			if _, ok := rMasterInstance["name"]; ok {
+				if s, ok := rMasterInstance["name"].(string); ok {
+					r.MasterInstance.Name = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.MasterInstance.Name: expected string")
+				}
+			}
+			if _, ok := rMasterInstance["region"]; ok {
+				if s, ok := rMasterInstance["region"].(string); ok {
+					r.MasterInstance.Region = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.MasterInstance.Region: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.MasterInstance: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["masterInstanceName"]; ok {
+		if s, ok := u.Object["masterInstanceName"].(string); ok {
+			r.MasterInstanceName = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.MasterInstanceName: expected string")
+		}
+	}
+	if _, ok := u.Object["maxDiskSize"]; ok {
+		if rMaxDiskSize, ok := u.Object["maxDiskSize"].(map[string]interface{}); ok {
+			r.MaxDiskSize = &dclService.InstanceMaxDiskSize{}
+			if _, ok := rMaxDiskSize["value"]; ok {
+				if i, ok := rMaxDiskSize["value"].(int64); ok {
+					r.MaxDiskSize.Value = dcl.Int64(i)
+				} else {
+					return nil, fmt.Errorf("r.MaxDiskSize.Value: expected int64")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.MaxDiskSize: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["name"]; ok {
+		if s, ok := u.Object["name"].(string); ok {
+			r.Name = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Name: expected string")
+		}
+	}
+	if _, ok := u.Object["onPremisesConfiguration"]; ok {
+		if rOnPremisesConfiguration, ok := u.Object["onPremisesConfiguration"].(map[string]interface{}); ok {
+			r.OnPremisesConfiguration = &dclService.InstanceOnPremisesConfiguration{}
+			if _, ok := rOnPremisesConfiguration["caCertificate"]; ok {
+				if s, ok := rOnPremisesConfiguration["caCertificate"].(string); ok {
+					r.OnPremisesConfiguration.CaCertificate = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.OnPremisesConfiguration.CaCertificate: expected string")
+				}
+			}
+			if _, ok := rOnPremisesConfiguration["clientCertificate"]; ok {
+				if s, ok := rOnPremisesConfiguration["clientCertificate"].(string); ok {
+					r.OnPremisesConfiguration.ClientCertificate = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.OnPremisesConfiguration.ClientCertificate: expected string")
+				}
+			}
+			if _, ok := rOnPremisesConfiguration["clientKey"]; ok {
+				if s, ok := rOnPremisesConfiguration["clientKey"].(string); ok {
+					r.OnPremisesConfiguration.ClientKey = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.OnPremisesConfiguration.ClientKey: expected string")
+				}
+			}
+			if _, ok := rOnPremisesConfiguration["database"]; ok {
+				if s, ok := rOnPremisesConfiguration["database"].(string); ok {
+					r.OnPremisesConfiguration.Database = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.OnPremisesConfiguration.Database: expected string")
+				}
+			}
+			if _, ok := rOnPremisesConfiguration["dumpFilePath"]; ok {
+				if s, ok := rOnPremisesConfiguration["dumpFilePath"].(string); ok {
+					r.OnPremisesConfiguration.DumpFilePath = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.OnPremisesConfiguration.DumpFilePath: expected string")
+				}
+			}
+			if _, ok := rOnPremisesConfiguration["hostPort"]; ok {
+				if s, ok := rOnPremisesConfiguration["hostPort"].(string); ok {
+					r.OnPremisesConfiguration.HostPort = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.OnPremisesConfiguration.HostPort: expected string")
+				}
+			}
+			if _, ok := rOnPremisesConfiguration["kind"]; ok {
+				if s, ok := rOnPremisesConfiguration["kind"].(string); ok {
+					r.OnPremisesConfiguration.Kind = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.OnPremisesConfiguration.Kind: expected string")
+				}
+			}
+			if _, ok := rOnPremisesConfiguration["password"]; ok {
+				if s, ok := rOnPremisesConfiguration["password"].(string); ok {
+					r.OnPremisesConfiguration.Password = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.OnPremisesConfiguration.Password: expected string")
+				}
+			}
+			if _, ok := rOnPremisesConfiguration["replicatedDatabases"]; ok {
+				if s, ok := rOnPremisesConfiguration["replicatedDatabases"].([]interface{}); ok {
+					for _, ss := range s {
+						if strval, ok := ss.(string); ok {
+							r.OnPremisesConfiguration.ReplicatedDatabases = append(r.OnPremisesConfiguration.ReplicatedDatabases, strval)
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.OnPremisesConfiguration.ReplicatedDatabases: expected []interface{}")
+				}
+			}
+			if _, ok := rOnPremisesConfiguration["username"]; ok {
+				if s, ok := rOnPremisesConfiguration["username"].(string); ok {
+					r.OnPremisesConfiguration.Username = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.OnPremisesConfiguration.Username: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.OnPremisesConfiguration: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["project"]; ok {
+		if s, ok := u.Object["project"].(string); ok {
+			r.Project = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Project: expected string")
+		}
+	}
+	if _, ok := u.Object["region"]; ok {
+		if s, ok := u.Object["region"].(string); ok {
+			r.Region = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Region: expected string")
+		}
+	}
+	if _, ok := u.Object["replicaConfiguration"]; ok {
+		if rReplicaConfiguration, ok := u.Object["replicaConfiguration"].(map[string]interface{}); ok {
+			r.ReplicaConfiguration = &dclService.InstanceReplicaConfiguration{}
+			if _, ok := rReplicaConfiguration["failoverTarget"]; ok {
+				if b, ok := rReplicaConfiguration["failoverTarget"].(bool); ok {
+					r.ReplicaConfiguration.FailoverTarget = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.ReplicaConfiguration.FailoverTarget: expected bool")
+				}
+			}
+			if _, ok := rReplicaConfiguration["kind"]; ok {
+				if s, ok := rReplicaConfiguration["kind"].(string); ok {
+					r.ReplicaConfiguration.Kind = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.ReplicaConfiguration.Kind: expected string")
+				}
+			}
+			if _, ok := rReplicaConfiguration["mysqlReplicaConfiguration"]; ok {
+				if rReplicaConfigurationMysqlReplicaConfiguration, ok := rReplicaConfiguration["mysqlReplicaConfiguration"].(map[string]interface{}); ok {
+					r.ReplicaConfiguration.MysqlReplicaConfiguration = &dclService.InstanceReplicaConfigurationMysqlReplicaConfiguration{}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["caCertificate"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["caCertificate"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.CaCertificate = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.CaCertificate: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["clientCertificate"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["clientCertificate"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientCertificate = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientCertificate: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["clientKey"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["clientKey"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientKey = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientKey: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["connectRetryInterval"]; ok {
+						if i, ok := rReplicaConfigurationMysqlReplicaConfiguration["connectRetryInterval"].(int64); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.ConnectRetryInterval = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.ConnectRetryInterval: expected int64")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["dumpFilePath"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["dumpFilePath"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.DumpFilePath = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.DumpFilePath: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["kind"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["kind"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.Kind = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.Kind: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["masterHeartbeatPeriod"]; ok {
+						if rReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod, ok := rReplicaConfigurationMysqlReplicaConfiguration["masterHeartbeatPeriod"].(map[string]interface{}); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod = &dclService.InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod{}
+							if _, ok := rReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod["value"]; ok {
+								if i, ok := rReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod["value"].(int64); ok {
+									r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod.Value = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod.Value: expected int64")
+								}
+							}
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod: expected map[string]interface{}")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["password"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["password"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.Password = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.Password: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["sslCipher"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["sslCipher"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.SslCipher = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.SslCipher: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["username"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["username"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.Username = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.Username: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["verifyServerCertificate"]; ok {
+						if b, ok := rReplicaConfigurationMysqlReplicaConfiguration["verifyServerCertificate"].(bool); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.VerifyServerCertificate = dcl.Bool(b)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.VerifyServerCertificate: expected bool")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration: expected map[string]interface{}")
+				}
+			}
+			if _, ok := rReplicaConfiguration["replicaPoolConfiguration"]; ok {
+				if rReplicaConfigurationReplicaPoolConfiguration, ok := rReplicaConfiguration["replicaPoolConfiguration"].(map[string]interface{}); ok {
+					r.ReplicaConfiguration.ReplicaPoolConfiguration = &dclService.InstanceReplicaConfigurationReplicaPoolConfiguration{}
+					if _, ok := rReplicaConfigurationReplicaPoolConfiguration["autoscalingPoolConfiguration"]; ok {
+						if rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration, ok := rReplicaConfigurationReplicaPoolConfiguration["autoscalingPoolConfiguration"].(map[string]interface{}); ok {
+							r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration = &dclService.InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration{}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["kind"]; ok {
+								if s, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["kind"].(string); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.Kind = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.Kind: expected string")
+								}
+							}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["maxReplicaCount"]; ok {
+								if i, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["maxReplicaCount"].(int64); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MaxReplicaCount = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MaxReplicaCount: expected int64")
+								}
+							}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["minReplicaCount"]; ok {
+								if i, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["minReplicaCount"].(int64); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MinReplicaCount = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MinReplicaCount: expected int64")
+								}
+							}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["targetCpuUtil"]; ok {
+								if f, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["targetCpuUtil"].(float64); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.TargetCpuUtil = dcl.Float64(f)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.TargetCpuUtil: expected float64")
+								}
+							}
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration: expected map[string]interface{}")
+						}
+					}
+					if _, ok := rReplicaConfigurationReplicaPoolConfiguration["exposeReplicaIp"]; ok {
+						if b, ok := rReplicaConfigurationReplicaPoolConfiguration["exposeReplicaIp"].(bool); ok {
+							r.ReplicaConfiguration.ReplicaPoolConfiguration.ExposeReplicaIP = dcl.Bool(b)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.ExposeReplicaIP: expected bool")
+						}
+					}
+					if _, ok := rReplicaConfigurationReplicaPoolConfiguration["kind"]; ok {
+						if s, ok := rReplicaConfigurationReplicaPoolConfiguration["kind"].(string); ok {
+							r.ReplicaConfiguration.ReplicaPoolConfiguration.Kind = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.Kind: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationReplicaPoolConfiguration["replicaCount"]; ok {
+						if i, ok := rReplicaConfigurationReplicaPoolConfiguration["replicaCount"].(int64); ok {
+							r.ReplicaConfiguration.ReplicaPoolConfiguration.ReplicaCount = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.ReplicaCount: expected int64")
+						}
+					}
+					if _, ok := rReplicaConfigurationReplicaPoolConfiguration["staticPoolConfiguration"]; ok {
+						if rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration, ok := rReplicaConfigurationReplicaPoolConfiguration["staticPoolConfiguration"].(map[string]interface{}); ok {
+							r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration = &dclService.InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration{}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["exposeReplicaIp"]; ok {
+								if b, ok := rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["exposeReplicaIp"].(bool); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ExposeReplicaIP = dcl.Bool(b)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ExposeReplicaIP: expected bool")
+								}
+							}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["kind"]; ok {
+								if s, ok := rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["kind"].(string); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.Kind = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.Kind: expected string")
+								}
+							}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["replicaCount"]; ok {
+								if i, ok := rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["replicaCount"].(int64); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ReplicaCount = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ReplicaCount: expected int64")
+								}
+							}
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration: expected map[string]interface{}")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration: expected map[string]interface{}")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.ReplicaConfiguration: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["replicaInstances"]; ok {
+		if s, ok := u.Object["replicaInstances"].([]interface{}); ok {
+			for _, o := range s {
+				if objval, ok := o.(map[string]interface{}); ok {
+					var rReplicaInstances dclService.InstanceReplicaInstances
+					if _, ok := objval["name"]; ok {
+						if s, ok := objval["name"].(string); ok {
+							rReplicaInstances.Name = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("rReplicaInstances.Name: expected string")
+						}
+					}
+					if _, ok := objval["region"]; ok {
+						if s, ok := objval["region"].(string); ok {
+							rReplicaInstances.Region = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("rReplicaInstances.Region: expected string")
+						}
+					}
+					r.ReplicaInstances = append(r.ReplicaInstances, rReplicaInstances)
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.ReplicaInstances: expected []interface{}")
+		}
+	}
+	if _, ok := u.Object["rootPassword"]; ok {
+		if s, ok := u.Object["rootPassword"].(string); ok {
+			r.RootPassword = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.RootPassword: expected string")
+		}
+	}
+	if _, ok := u.Object["scheduledMaintenance"]; ok {
+		if rScheduledMaintenance, ok := u.Object["scheduledMaintenance"].(map[string]interface{}); ok {
+			r.ScheduledMaintenance = &dclService.InstanceScheduledMaintenance{}
+			if _, ok := rScheduledMaintenance["canDefer"]; ok {
+				if b, ok := rScheduledMaintenance["canDefer"].(bool); ok {
+					r.ScheduledMaintenance.CanDefer = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.ScheduledMaintenance.CanDefer: expected bool")
+				}
+			}
+			if _, ok := rScheduledMaintenance["canReschedule"]; ok {
+				if b, ok := rScheduledMaintenance["canReschedule"].(bool); ok {
+					r.ScheduledMaintenance.CanReschedule = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.ScheduledMaintenance.CanReschedule: expected bool")
+				}
+			}
+			if _, ok := rScheduledMaintenance["startTime"]; ok {
+				if rScheduledMaintenanceStartTime, ok := rScheduledMaintenance["startTime"].(map[string]interface{}); ok {
+					r.ScheduledMaintenance.StartTime = &dclService.InstanceScheduledMaintenanceStartTime{}
+					if _, ok := rScheduledMaintenanceStartTime["nanos"]; ok {
+						if i, ok := rScheduledMaintenanceStartTime["nanos"].(int64); ok {
+							r.ScheduledMaintenance.StartTime.Nanos = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.ScheduledMaintenance.StartTime.Nanos: expected int64")
+						}
+					}
+					if _, ok := rScheduledMaintenanceStartTime["seconds"]; ok {
+						if i, ok := rScheduledMaintenanceStartTime["seconds"].(int64); ok {
+							r.ScheduledMaintenance.StartTime.Seconds = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.ScheduledMaintenance.StartTime.Seconds: expected int64")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.ScheduledMaintenance.StartTime: expected map[string]interface{}")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.ScheduledMaintenance: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["serverCaCert"]; ok {
+		if rServerCaCert, ok := u.Object["serverCaCert"].(map[string]interface{}); ok {
+			r.ServerCaCert = &dclService.InstanceServerCaCert{}
+			if _, ok := rServerCaCert["cert"]; ok {
+				if s, ok := rServerCaCert["cert"].(string); ok {
+					r.ServerCaCert.Cert = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.ServerCaCert.Cert: expected string")
+				}
+			}
+			if _, ok := rServerCaCert["certSerialNumber"]; ok {
+				if s, ok := rServerCaCert["certSerialNumber"].(string); ok {
+					r.ServerCaCert.CertSerialNumber = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.ServerCaCert.CertSerialNumber: expected string")
+				}
+			}
+			if _, ok := rServerCaCert["commonName"]; ok {
+				if s, ok := rServerCaCert["commonName"].(string); ok {
+					r.ServerCaCert.CommonName = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.ServerCaCert.CommonName: expected string")
+				}
+			}
+			if _, ok := rServerCaCert["createTime"]; ok {
+				if s, ok := rServerCaCert["createTime"].(string); ok {
+					r.ServerCaCert.CreateTime = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.ServerCaCert.CreateTime: expected string")
+				}
+			}
+			if _, ok := rServerCaCert["expirationTime"]; ok {
+				if s, ok := rServerCaCert["expirationTime"].(string); ok {
+					r.ServerCaCert.ExpirationTime = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.ServerCaCert.ExpirationTime: expected string")
+				}
+			}
+			if _, ok := rServerCaCert["instance"]; ok {
+				if s, ok := rServerCaCert["instance"].(string); ok {
+					r.ServerCaCert.Instance = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.ServerCaCert.Instance: expected string")
+				}
+			}
+			if _, ok := rServerCaCert["kind"]; ok {
+				if s, ok := rServerCaCert["kind"].(string); ok {
+					r.ServerCaCert.Kind = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.ServerCaCert.Kind: expected string")
+				}
+			}
+			if _, ok := rServerCaCert["sha1Fingerprint"]; ok {
+				if s, ok := rServerCaCert["sha1Fingerprint"].(string); ok {
+					r.ServerCaCert.Sha1Fingerprint = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.ServerCaCert.Sha1Fingerprint: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.ServerCaCert: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["serviceAccountEmailAddress"]; ok {
+		if s, ok := u.Object["serviceAccountEmailAddress"].(string); ok {
+			r.ServiceAccountEmailAddress = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.ServiceAccountEmailAddress: expected string")
+		}
+	}
+	if _, ok := u.Object["settings"]; ok {
+		if rSettings, ok := u.Object["settings"].(map[string]interface{}); ok {
+			r.Settings = &dclService.InstanceSettings{}
+			if _, ok := rSettings["activationPolicy"]; ok {
+				if s, ok := rSettings["activationPolicy"].(string); ok {
+					r.Settings.ActivationPolicy = dclService.InstanceSettingsActivationPolicyEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.ActivationPolicy: expected string")
+				}
+			}
+			if _, ok := rSettings["activeDirectoryConfig"]; ok {
+				if rSettingsActiveDirectoryConfig, ok := rSettings["activeDirectoryConfig"].(map[string]interface{}); ok {
+					r.Settings.ActiveDirectoryConfig = &dclService.InstanceSettingsActiveDirectoryConfig{}
+					if _, ok := rSettingsActiveDirectoryConfig["domain"]; ok {
+						if s, ok := rSettingsActiveDirectoryConfig["domain"].(string); ok {
+							r.Settings.ActiveDirectoryConfig.Domain = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.Settings.ActiveDirectoryConfig.Domain: expected string")
+						}
+					}
+					if _, ok := rSettingsActiveDirectoryConfig["kind"]; ok {
+						if s, ok := rSettingsActiveDirectoryConfig["kind"].(string); ok {
+							r.Settings.ActiveDirectoryConfig.Kind = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.Settings.ActiveDirectoryConfig.Kind: expected string")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.ActiveDirectoryConfig: expected map[string]interface{}")
+				}
+			}
+			if _, ok := rSettings["authorizedGaeApplications"]; ok {
+				if s, ok := rSettings["authorizedGaeApplications"].([]interface{}); ok {
+					for _, ss := range s {
+						if strval, ok := ss.(string); ok {
+							r.Settings.AuthorizedGaeApplications = append(r.Settings.AuthorizedGaeApplications, strval)
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.AuthorizedGaeApplications: expected []interface{}")
+				}
+			}
+			if _, ok := rSettings["availabilityType"]; ok {
+				if s, ok := rSettings["availabilityType"].(string); ok {
+					r.Settings.AvailabilityType = dclService.InstanceSettingsAvailabilityTypeEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.AvailabilityType: expected string")
+				}
+			}
+			if _, ok := rSettings["backupConfiguration"]; ok {
+				if rSettingsBackupConfiguration, ok := rSettings["backupConfiguration"].(map[string]interface{}); ok {
+					r.Settings.BackupConfiguration = &dclService.InstanceSettingsBackupConfiguration{}
+					if _, ok := rSettingsBackupConfiguration["backupRetentionSettings"]; ok {
+						if rSettingsBackupConfigurationBackupRetentionSettings, ok := rSettingsBackupConfiguration["backupRetentionSettings"].(map[string]interface{}); ok {
+							r.Settings.BackupConfiguration.BackupRetentionSettings = &dclService.InstanceSettingsBackupConfigurationBackupRetentionSettings{}
+							if _, ok := rSettingsBackupConfigurationBackupRetentionSettings["retainedBackups"]; ok {
+								if i, ok := rSettingsBackupConfigurationBackupRetentionSettings["retainedBackups"].(int64); ok {
+									r.Settings.BackupConfiguration.BackupRetentionSettings.RetainedBackups = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("r.Settings.BackupConfiguration.BackupRetentionSettings.RetainedBackups: expected int64")
+								}
+							}
+							if _, ok := rSettingsBackupConfigurationBackupRetentionSettings["retentionUnit"]; ok {
+								if s, ok := rSettingsBackupConfigurationBackupRetentionSettings["retentionUnit"].(string); ok {
+									r.Settings.BackupConfiguration.BackupRetentionSettings.RetentionUnit = dclService.InstanceSettingsBackupConfigurationBackupRetentionSettingsRetentionUnitEnumRef(s)
+								} else {
+									return nil, fmt.Errorf("r.Settings.BackupConfiguration.BackupRetentionSettings.RetentionUnit: expected string")
+								}
+							}
+						} else {
+							return nil, fmt.Errorf("r.Settings.BackupConfiguration.BackupRetentionSettings: expected map[string]interface{}")
+						}
+					}
+					if _, ok := rSettingsBackupConfiguration["binaryLogEnabled"]; ok {
+						if b, ok := rSettingsBackupConfiguration["binaryLogEnabled"].(bool); ok {
+							r.Settings.BackupConfiguration.BinaryLogEnabled = dcl.Bool(b)
+						} else {
+							return nil, fmt.Errorf("r.Settings.BackupConfiguration.BinaryLogEnabled: expected bool")
+						}
+					}
+					if _, ok := rSettingsBackupConfiguration["enabled"]; ok {
+						if b, ok := rSettingsBackupConfiguration["enabled"].(bool); ok {
+							r.Settings.BackupConfiguration.Enabled = dcl.Bool(b)
+						} else {
+							return nil, fmt.Errorf("r.Settings.BackupConfiguration.Enabled: expected bool")
+						}
+					}
+					if _, ok := rSettingsBackupConfiguration["kind"]; ok {
+						if s, ok := rSettingsBackupConfiguration["kind"].(string); ok {
+							r.Settings.BackupConfiguration.Kind = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.Settings.BackupConfiguration.Kind: expected string")
+						}
+					}
+					if _, ok := rSettingsBackupConfiguration["location"]; ok {
+						if s, ok := rSettingsBackupConfiguration["location"].(string); ok {
+							r.Settings.BackupConfiguration.Location = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.Settings.BackupConfiguration.Location: expected string")
+						}
+					}
+					if _, ok := rSettingsBackupConfiguration["startTime"]; ok {
+						if s, ok := rSettingsBackupConfiguration["startTime"].(string); ok {
+							r.Settings.BackupConfiguration.StartTime = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.Settings.BackupConfiguration.StartTime: expected string")
+						}
+					}
+					if _, ok := rSettingsBackupConfiguration["transactionLogRetentionDays"]; ok {
+						if i, ok := rSettingsBackupConfiguration["transactionLogRetentionDays"].(int64); ok {
+							r.Settings.BackupConfiguration.TransactionLogRetentionDays = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.Settings.BackupConfiguration.TransactionLogRetentionDays: expected int64")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.BackupConfiguration: expected map[string]interface{}")
+				}
+			}
+			if _, ok := rSettings["collation"]; ok {
+				if s, ok := rSettings["collation"].(string); ok {
+					r.Settings.Collation = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.Collation: expected string")
+				}
+			}
+			if _, ok := rSettings["crashSafeReplicationEnabled"]; ok {
+				if b, ok := rSettings["crashSafeReplicationEnabled"].(bool); ok {
+					r.Settings.CrashSafeReplicationEnabled = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.Settings.CrashSafeReplicationEnabled: expected bool")
+				}
+			}
+			if _, ok := rSettings["dataDiskSizeGb"]; ok {
+				if rSettingsDataDiskSizeGb, ok := rSettings["dataDiskSizeGb"].(map[string]interface{}); ok {
+					r.Settings.DataDiskSizeGb = &dclService.InstanceSettingsDataDiskSizeGb{}
+					if _, ok := rSettingsDataDiskSizeGb["value"]; ok {
+						if i, ok := rSettingsDataDiskSizeGb["value"].(int64); ok {
+							r.Settings.DataDiskSizeGb.Value = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.Settings.DataDiskSizeGb.Value: expected int64")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.DataDiskSizeGb: expected map[string]interface{}")
+				}
+			}
+			if _, ok := rSettings["dataDiskType"]; ok {
+				if s, ok := rSettings["dataDiskType"].(string); ok {
+					r.Settings.DataDiskType = dclService.InstanceSettingsDataDiskTypeEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.DataDiskType: expected string")
+				}
+			}
+			if _, ok := rSettings["databaseFlags"]; ok {
+				if s, ok := rSettings["databaseFlags"].([]interface{}); ok {
+					for _, o := range s {
+						if objval, ok := o.(map[string]interface{}); ok {
+							var rSettingsDatabaseFlags dclService.InstanceSettingsDatabaseFlags
+							if _, ok := objval["name"]; ok {
+								if s, ok := objval["name"].(string); ok {
+									rSettingsDatabaseFlags.Name = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("rSettingsDatabaseFlags.Name: expected string")
+								}
+							}
+							if _, ok := objval["value"]; ok {
+								if s, ok := objval["value"].(string); ok {
+									rSettingsDatabaseFlags.Value = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("rSettingsDatabaseFlags.Value: expected string")
+								}
+							}
+							r.Settings.DatabaseFlags = append(r.Settings.DatabaseFlags, rSettingsDatabaseFlags)
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.DatabaseFlags: expected []interface{}")
+				}
+			}
+			if _, ok := rSettings["databaseReplicationEnabled"]; ok {
+				if b, ok := rSettings["databaseReplicationEnabled"].(bool); ok {
+					r.Settings.DatabaseReplicationEnabled = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.Settings.DatabaseReplicationEnabled: expected bool")
+				}
+			}
+			if _, ok := rSettings["denyMaintenancePeriods"]; ok {
+				if s, ok := rSettings["denyMaintenancePeriods"].([]interface{}); ok {
+					for _, o := range s {
+						if objval, ok := o.(map[string]interface{}); ok {
+							var rSettingsDenyMaintenancePeriods dclService.InstanceSettingsDenyMaintenancePeriods
+							if _, ok := objval["endDate"]; ok {
+								if s, ok := objval["endDate"].(string); ok {
+									rSettingsDenyMaintenancePeriods.EndDate = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("rSettingsDenyMaintenancePeriods.EndDate: expected string")
+								}
+							}
+							if _, ok := objval["startDate"]; ok {
+								if s, ok := objval["startDate"].(string); ok {
+									rSettingsDenyMaintenancePeriods.StartDate = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("rSettingsDenyMaintenancePeriods.StartDate: expected string")
+								}
+							}
+							if _, ok := objval["time"]; ok {
+								if s, ok := objval["time"].(string); ok {
+									rSettingsDenyMaintenancePeriods.Time = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("rSettingsDenyMaintenancePeriods.Time: expected string")
+								}
+							}
+							r.Settings.DenyMaintenancePeriods = append(r.Settings.DenyMaintenancePeriods, rSettingsDenyMaintenancePeriods)
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.DenyMaintenancePeriods: expected []interface{}")
+				}
+			}
+			if _, ok := rSettings["insightsConfig"]; ok {
+				if rSettingsInsightsConfig, ok := rSettings["insightsConfig"].(map[string]interface{}); ok {
+					r.Settings.InsightsConfig = &dclService.InstanceSettingsInsightsConfig{}
+					if _, ok := rSettingsInsightsConfig["queryInsightsEnabled"]; ok {
+						if b, ok := rSettingsInsightsConfig["queryInsightsEnabled"].(bool); ok {
+							r.Settings.InsightsConfig.QueryInsightsEnabled = dcl.Bool(b)
+						} else {
+							return nil, fmt.Errorf("r.Settings.InsightsConfig.QueryInsightsEnabled: expected bool")
+						}
+					}
+					if _, ok := rSettingsInsightsConfig["queryStringLength"]; ok {
+						if i, ok := rSettingsInsightsConfig["queryStringLength"].(int64); ok {
+							r.Settings.InsightsConfig.QueryStringLength = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.Settings.InsightsConfig.QueryStringLength: expected int64")
+						}
+					}
+					if _, ok := rSettingsInsightsConfig["recordApplicationTags"]; ok {
+						if b, ok := rSettingsInsightsConfig["recordApplicationTags"].(bool); ok {
+							r.Settings.InsightsConfig.RecordApplicationTags = dcl.Bool(b)
+						} else {
+							return nil, fmt.Errorf("r.Settings.InsightsConfig.RecordApplicationTags: expected bool")
+						}
+					}
+					if _, ok := rSettingsInsightsConfig["recordClientAddress"]; ok {
+						if b, ok := rSettingsInsightsConfig["recordClientAddress"].(bool); ok {
+							r.Settings.InsightsConfig.RecordClientAddress = dcl.Bool(b)
+						} else {
+							return nil, fmt.Errorf("r.Settings.InsightsConfig.RecordClientAddress: expected bool")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.InsightsConfig: expected map[string]interface{}")
+				}
+			}
+			if _, ok := rSettings["ipConfiguration"]; ok {
+				if rSettingsIPConfiguration, ok := rSettings["ipConfiguration"].(map[string]interface{}); ok {
+					r.Settings.IPConfiguration = &dclService.InstanceSettingsIPConfiguration{}
+					if _, ok := rSettingsIPConfiguration["authorizedNetworks"]; ok {
+						if s, ok := rSettingsIPConfiguration["authorizedNetworks"].([]interface{}); ok {
+							for _, o := range s {
+								if objval, ok := o.(map[string]interface{}); ok {
+									var rSettingsIPConfigurationAuthorizedNetworks dclService.InstanceSettingsIPConfigurationAuthorizedNetworks
+									if _, ok := objval["expirationTime"]; ok {
+										if s, ok := objval["expirationTime"].(string); ok {
+											rSettingsIPConfigurationAuthorizedNetworks.ExpirationTime = dcl.String(s)
+										} else {
+											return nil, fmt.Errorf("rSettingsIPConfigurationAuthorizedNetworks.ExpirationTime: expected string")
+										}
+									}
+									if _, ok := objval["kind"]; ok {
+										if s, ok := objval["kind"].(string); ok {
+											rSettingsIPConfigurationAuthorizedNetworks.Kind = dcl.String(s)
+										} else {
+											return nil, fmt.Errorf("rSettingsIPConfigurationAuthorizedNetworks.Kind: expected string")
+										}
+									}
+									if _, ok := objval["name"]; ok {
+										if s, ok := objval["name"].(string); ok {
+											rSettingsIPConfigurationAuthorizedNetworks.Name = dcl.String(s)
+										} else {
+											return nil, fmt.Errorf("rSettingsIPConfigurationAuthorizedNetworks.Name: expected string")
+										}
+									}
+									if _, ok := objval["value"]; ok {
+										if s, ok := objval["value"].(string); ok {
+											rSettingsIPConfigurationAuthorizedNetworks.Value = dcl.String(s)
+										} else {
+											return nil, fmt.Errorf("rSettingsIPConfigurationAuthorizedNetworks.Value: expected string")
+										}
+									}
+									r.Settings.IPConfiguration.AuthorizedNetworks = append(r.Settings.IPConfiguration.AuthorizedNetworks, rSettingsIPConfigurationAuthorizedNetworks)
+								}
+							}
+						} else {
+							return nil, fmt.Errorf("r.Settings.IPConfiguration.AuthorizedNetworks: expected []interface{}")
+						}
+					}
+					if _, ok := rSettingsIPConfiguration["ipv4Enabled"]; ok {
+						if b, ok := rSettingsIPConfiguration["ipv4Enabled"].(bool); ok {
+							r.Settings.IPConfiguration.IPv4Enabled = dcl.Bool(b)
+						} else {
+							return nil, fmt.Errorf("r.Settings.IPConfiguration.IPv4Enabled: expected bool")
+						}
+					}
+					if _, ok := rSettingsIPConfiguration["privateNetwork"]; ok {
+						if s, ok := rSettingsIPConfiguration["privateNetwork"].(string); ok {
+							r.Settings.IPConfiguration.PrivateNetwork = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.Settings.IPConfiguration.PrivateNetwork: expected string")
+						}
+					}
+					if _, ok := rSettingsIPConfiguration["requireSsl"]; ok {
+						if b, ok := rSettingsIPConfiguration["requireSsl"].(bool); ok {
+							r.Settings.IPConfiguration.RequireSsl = dcl.Bool(b)
+						} else {
+							return nil, fmt.Errorf("r.Settings.IPConfiguration.RequireSsl: expected bool")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.IPConfiguration: expected map[string]interface{}")
+				}
+			}
+			if _, ok := rSettings["kind"]; ok {
+				if s, ok := rSettings["kind"].(string); ok {
+					r.Settings.Kind = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.Kind: expected string")
+				}
+			}
+			if _, ok := rSettings["locationPreference"]; ok {
+				if rSettingsLocationPreference, ok := rSettings["locationPreference"].(map[string]interface{}); ok {
+					r.Settings.LocationPreference = &dclService.InstanceSettingsLocationPreference{}
+					if _, ok := rSettingsLocationPreference["kind"]; ok {
+						if s, ok := rSettingsLocationPreference["kind"].(string); ok {
+							r.Settings.LocationPreference.Kind = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.Settings.LocationPreference.Kind: expected string")
+						}
+					}
+					if _, ok := rSettingsLocationPreference["zone"]; ok {
+						if s, ok := rSettingsLocationPreference["zone"].(string); ok {
+							r.Settings.LocationPreference.Zone = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.Settings.LocationPreference.Zone: expected string")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.LocationPreference: expected map[string]interface{}")
+				}
+			}
+			if _, ok := rSettings["maintenanceWindow"]; ok {
+				if rSettingsMaintenanceWindow, ok := rSettings["maintenanceWindow"].(map[string]interface{}); ok {
+					r.Settings.MaintenanceWindow = &dclService.InstanceSettingsMaintenanceWindow{}
+					if _, ok := rSettingsMaintenanceWindow["day"]; ok {
+						if i, ok := rSettingsMaintenanceWindow["day"].(int64); ok {
+							r.Settings.MaintenanceWindow.Day = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.Settings.MaintenanceWindow.Day: expected int64")
+						}
+					}
+					if _, ok := rSettingsMaintenanceWindow["hour"]; ok {
+						if i, ok := rSettingsMaintenanceWindow["hour"].(int64); ok {
+							r.Settings.MaintenanceWindow.Hour = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.Settings.MaintenanceWindow.Hour: expected int64")
+						}
+					}
+					if _, ok := rSettingsMaintenanceWindow["kind"]; ok {
+						if s, ok := rSettingsMaintenanceWindow["kind"].(string); ok {
+							r.Settings.MaintenanceWindow.Kind = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.Settings.MaintenanceWindow.Kind: expected string")
+						}
+					}
+					if _, ok := rSettingsMaintenanceWindow["updateTrack"]; ok {
+						if s, ok := rSettingsMaintenanceWindow["updateTrack"].(string); ok {
+							r.Settings.MaintenanceWindow.UpdateTrack = dclService.InstanceSettingsMaintenanceWindowUpdateTrackEnumRef(s)
+						} else {
+							return nil, fmt.Errorf("r.Settings.MaintenanceWindow.UpdateTrack: expected string")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.MaintenanceWindow: expected map[string]interface{}")
+				}
+			}
+			if _, ok := rSettings["pricingPlan"]; ok {
+				if s, ok := rSettings["pricingPlan"].(string); ok {
+					r.Settings.PricingPlan = dclService.InstanceSettingsPricingPlanEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.PricingPlan: expected string")
+				}
+			}
+			if _, ok := rSettings["replicationType"]; ok {
+				if s, ok := rSettings["replicationType"].(string); ok {
+					r.Settings.ReplicationType = dclService.InstanceSettingsReplicationTypeEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.ReplicationType: expected string")
+				}
+			}
+			if _, ok := rSettings["settingsVersion"]; ok {
+				if rSettingsSettingsVersion, ok := rSettings["settingsVersion"].(map[string]interface{}); ok {
+					r.Settings.SettingsVersion = &dclService.InstanceSettingsSettingsVersion{}
+					if _, ok := rSettingsSettingsVersion["value"]; ok {
+						if i, ok := rSettingsSettingsVersion["value"].(int64); ok {
+							r.Settings.SettingsVersion.Value = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.Settings.SettingsVersion.Value: expected int64")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.SettingsVersion: expected map[string]interface{}")
+				}
+			}
+			if _, ok := rSettings["storageAutoResize"]; ok {
+				if b, ok := rSettings["storageAutoResize"].(bool); ok {
+					r.Settings.StorageAutoResize = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.Settings.StorageAutoResize: expected bool")
+				}
+			}
+			if _, ok := rSettings["storageAutoResizeLimit"]; ok {
+				if rSettingsStorageAutoResizeLimit, ok := rSettings["storageAutoResizeLimit"].(map[string]interface{}); ok {
+					r.Settings.StorageAutoResizeLimit = &dclService.InstanceSettingsStorageAutoResizeLimit{}
+					if _, ok := rSettingsStorageAutoResizeLimit["value"]; ok {
+						if i, ok := rSettingsStorageAutoResizeLimit["value"].(int64); ok {
+							r.Settings.StorageAutoResizeLimit.Value = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.Settings.StorageAutoResizeLimit.Value: expected int64")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.StorageAutoResizeLimit: expected map[string]interface{}")
+				}
+			}
+			if _, ok := rSettings["tier"]; ok {
+				if s, ok := rSettings["tier"].(string); ok {
+					r.Settings.Tier = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.Tier: expected string")
+				}
+			}
+			if _, ok := rSettings["userLabels"]; ok {
+				if rSettingsUserLabels, ok := rSettings["userLabels"].(map[string]interface{}); ok {
+					m := make(map[string]string)
+					for k, v := range rSettingsUserLabels {
+						if s, ok := v.(string); ok {
+							m[k] = s
+						}
+					}
+					r.Settings.UserLabels = m
+				} else {
+					return nil, fmt.Errorf("r.Settings.UserLabels: expected map[string]interface{}")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.Settings: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["state"]; ok {
+		if s, ok := u.Object["state"].(string); ok {
+			r.State = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.State: expected string")
+		}
+	}
+	if _, ok := u.Object["suspensionReason"]; ok {
+		if s, ok := u.Object["suspensionReason"].([]interface{}); ok {
+			for _, ss := range s {
+				if strval, ok := ss.(string); ok {
+					r.SuspensionReason = append(r.SuspensionReason, strval)
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.SuspensionReason: expected []interface{}")
+		}
+	}
+	return r, nil
+}
+
+func GetInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetInstance(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToUnstructured(r), nil
+}
+
+func ListInstance(ctx context.Context, config *dcl.Config, project string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListInstance(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, InstanceToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplyInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInstance(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplyInstance(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToUnstructured(r), nil
+}
+
+func InstanceHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInstance(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplyInstance(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func InstanceFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInstance(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffInstance(ctx, r, opts...)
+}
+
+func DeleteInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteInstance(ctx, r)
+}
+
+func InstanceID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *Instance) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"sql",
+		"Instance",
+		"beta",
+	}
+}
+
+func (r *Instance) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetInstance(ctx, config, resource)
+}
+
+func (r *Instance) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplyInstance(ctx, config, resource, opts...)
+}
+
+func (r *Instance) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return InstanceHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *Instance) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return InstanceFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *Instance) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteInstance(ctx, config, resource)
+}
+
+func (r *Instance) ID(resource *unstructured.Resource) (string, error) {
+	return InstanceID(resource)
+}
+
+func (r *Instance) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListInstance(ctx, config, project)
+}
+
+func (r *Instance) Schema() *dcl.Schema {
+	return dclService.DCLInstanceSchema()
+}
+
+func init() {
+	unstructured.Register(&Instance{})
+}
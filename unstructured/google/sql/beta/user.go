@@ -0,0 +1,335 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package beta
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql/beta"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type User struct{}
+
+func UserToUnstructured(r *dclService.User) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "sql",
+			Version: "beta",
+			Type:    "User",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.Etag != nil {
+		u.Object["etag"] = *r.Etag
+	}
+	if r.Host != nil {
+		u.Object["host"] = *r.Host
+	}
+	if r.Instance != nil {
+		u.Object["instance"] = *r.Instance
+	}
+	if r.Name != nil {
+		u.Object["name"] = *r.Name
+	}
+	if r.Password != nil {
+		u.Object["password"] = *r.Password
+	}
+	if r.Project != nil {
+		u.Object["project"] = *r.Project
+	}
+	if r.SqlserverUserDetails != nil && r.SqlserverUserDetails != dclService.EmptyUserSqlserverUserDetails {
+		rSqlserverUserDetails := make(map[string]interface{})
+		if r.SqlserverUserDetails.Disabled != nil {
+			rSqlserverUserDetails["disabled"] = *r.SqlserverUserDetails.Disabled
+		}
+		var rSqlserverUserDetailsServerRoles []interface{}
+		for _, rSqlserverUserDetailsServerRolesVal := range r.SqlserverUserDetails.ServerRoles {
+			rSqlserverUserDetailsServerRoles = append(rSqlserverUserDetailsServerRoles, rSqlserverUserDetailsServerRolesVal)
+		}
+		rSqlserverUserDetails["serverRoles"] = rSqlserverUserDetailsServerRoles
+		u.Object["sqlserverUserDetails"] = rSqlserverUserDetails
+	}
+	if r.Type != nil {
+		u.Object["type"] = string(*r.Type)
+	}
+	return u
+}
+
+func UnstructuredToUser(u *unstructured.Resource) (*dclService.User, error) {
+	r := &dclService.User{}
+	if _, ok := u.Object["etag"]; ok {
+		if s, ok := u.Object["etag"].(string); ok {
+			r.Etag = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Etag: expected string")
+		}
+	}
+	if _, ok := u.Object["host"]; ok {
+		if s, ok := u.Object["host"].(string); ok {
+			r.Host = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Host: expected string")
+		}
+	}
+	if _, ok := u.Object["instance"]; ok {
+		if s, ok := u.Object["instance"].(string); ok {
+			r.Instance = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Instance: expected string")
+		}
+	}
+	if _, ok := u.Object["name"]; ok {
+		if s, ok := u.Object["name"].(string); ok {
+			r.Name = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Name: expected string")
+		}
+	}
+	if _, ok := u.Object["password"]; ok {
+		if s, ok := u.Object["password"].(string); ok {
+			r.Password = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Password: expected string")
+		}
+	}
+	if _, ok := u.Object["project"]; ok {
+		if s, ok := u.Object["project"].(string); ok {
+			r.Project = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Project: expected string")
+		}
+	}
+	if _, ok := u.Object["sqlserverUserDetails"]; ok {
+		if rSqlserverUserDetails, ok := u.Object["sqlserverUserDetails"].(map[string]interface{}); ok {
+			r.SqlserverUserDetails = &dclService.UserSqlserverUserDetails{}
+			if _, ok := rSqlserverUserDetails["disabled"]; ok {
+				if b, ok := rSqlserverUserDetails["disabled"].(bool); ok {
+					r.SqlserverUserDetails.Disabled = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.SqlserverUserDetails.Disabled: expected bool")
+				}
+			}
+			if _, ok := rSqlserverUserDetails["serverRoles"]; ok {
+				if s, ok := rSqlserverUserDetails["serverRoles"].([]interface{}); ok {
+					for _, ss := range s {
+						if strval, ok := ss.(string); ok {
+							r.SqlserverUserDetails.ServerRoles = append(r.SqlserverUserDetails.ServerRoles, strval)
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.SqlserverUserDetails.ServerRoles: expected []interface{}")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.SqlserverUserDetails: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["type"]; ok {
+		if s, ok := u.Object["type"].(string); ok {
+			r.Type = dclService.UserTypeEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.Type: expected string")
+		}
+	}
+	return r, nil
+}
+
+func GetUser(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToUser(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetUser(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return UserToUnstructured(r), nil
+}
+
+func ListUser(ctx context.Context, config *dcl.Config, project string, instance string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListUser(ctx, project, instance)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, UserToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplyUser(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToUser(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToUser(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplyUser(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return UserToUnstructured(r), nil
+}
+
+func UserHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToUser(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToUser(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplyUser(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func UserFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToUser(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToUser(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffUser(ctx, r, opts...)
+}
+
+func DeleteUser(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToUser(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteUser(ctx, r)
+}
+
+func UserID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToUser(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *User) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"sql",
+		"User",
+		"beta",
+	}
+}
+
+func (r *User) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *User) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *User) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *User) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *User) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *User) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *User) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetUser(ctx, config, resource)
+}
+
+func (r *User) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplyUser(ctx, config, resource, opts...)
+}
+
+func (r *User) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return UserHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *User) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return UserFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *User) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteUser(ctx, config, resource)
+}
+
+func (r *User) ID(resource *unstructured.Resource) (string, error) {
+	return UserID(resource)
+}
+
+func (r *User) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	instance, err := unstructured.StringField(resource, "instance")
+	if err != nil {
+		return nil, err
+	}
+	return ListUser(ctx, config, project, instance)
+}
+
+func (r *User) Schema() *dcl.Schema {
+	return dclService.DCLUserSchema()
+}
+
+func init() {
+	unstructured.Register(&User{})
+}
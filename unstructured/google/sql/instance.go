@@ -0,0 +1,1003 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package sql
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type Instance struct{}
+
+func InstanceToUnstructured(r *dclService.Instance) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "sql",
+			Version: "ga",
+			Type:    "Instance",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.BackendType != nil {
+		u.Object["backendType"] = string(*r.BackendType)
+	}
+	if r.ConnectionName != nil {
+		u.Object["connectionName"] = *r.ConnectionName
+	}
+	if r.CurrentDiskSize != nil && r.CurrentDiskSize != dclService.EmptyInstanceCurrentDiskSize {
+		rCurrentDiskSize := make(map[string]interface{})
+		if r.CurrentDiskSize.Value != nil {
+			rCurrentDiskSize["value"] = *r.CurrentDiskSize.Value
+		}
+		u.Object["currentDiskSize"] = rCurrentDiskSize
+	}
+	if r.DatabaseVersion != nil {
+		u.Object["databaseVersion"] = string(*r.DatabaseVersion)
+	}
+	if r.DiskEncryptionConfiguration != nil && r.DiskEncryptionConfiguration != dclService.EmptyInstanceDiskEncryptionConfiguration {
+		rDiskEncryptionConfiguration := make(map[string]interface{})
+		if r.DiskEncryptionConfiguration.Kind != nil {
+			rDiskEncryptionConfiguration["kind"] = *r.DiskEncryptionConfiguration.Kind
+		}
+		if r.DiskEncryptionConfiguration.KmsKeyName != nil {
+			rDiskEncryptionConfiguration["kmsKeyName"] = *r.DiskEncryptionConfiguration.KmsKeyName
+		}
+		u.Object["diskEncryptionConfiguration"] = rDiskEncryptionConfiguration
+	}
+	if r.Etag != nil {
+		u.Object["etag"] = *r.Etag
+	}
+	if r.FailoverReplica != nil && r.FailoverReplica != dclService.EmptyInstanceFailoverReplica {
+		rFailoverReplica := make(map[string]interface{})
+		if r.FailoverReplica.Available != nil {
+			rFailoverReplica["available"] = *r.FailoverReplica.Available
+		}
+		if r.FailoverReplica.Name != nil {
+			rFailoverReplica["name"] = *r.FailoverReplica.Name
+		}
+		u.Object["failoverReplica"] = rFailoverReplica
+	}
+	if r.GceZone != nil {
+		u.Object["gceZone"] = *r.GceZone
+	}
+	if r.InstanceType != nil {
+		u.Object["instanceType"] = string(*r.InstanceType)
+	}
+	var rIPAddresses []interface{}
+	for _, rIPAddressesVal := range r.IPAddresses {
+		rIPAddressesObject := make(map[string]interface{})
+		if rIPAddressesVal.IPAddress != nil {
+			rIPAddressesObject["ipAddress"] = *rIPAddressesVal.IPAddress
+		}
+		if rIPAddressesVal.TimeToRetire != nil && rIPAddressesVal.TimeToRetire != dclService.EmptyInstanceIPAddressesTimeToRetire {
+			rIPAddressesValTimeToRetire := make(map[string]interface{})
+			if rIPAddressesVal.TimeToRetire.Nanos != nil {
+				rIPAddressesValTimeToRetire["nanos"] = *rIPAddressesVal.TimeToRetire.Nanos
+			}
+			if rIPAddressesVal.TimeToRetire.Seconds != nil {
+				rIPAddressesValTimeToRetire["seconds"] = *rIPAddressesVal.TimeToRetire.Seconds
+			}
+			rIPAddressesObject["timeToRetire"] = rIPAddressesValTimeToRetire
+		}
+		if rIPAddressesVal.Type != nil {
+			rIPAddressesObject["type"] = string(*rIPAddressesVal.Type)
+		}
+		rIPAddresses = append(rIPAddresses, rIPAddressesObject)
+	}
+	u.Object["ipAddresses"] = rIPAddresses
+	if r.MasterInstance != nil && r.MasterInstance != dclService.EmptyInstanceMasterInstance {
+		rMasterInstance := make(map[string]interface{})
+		if r.MasterInstance.Name != nil {
+			rMasterInstance["name"] = *r.MasterInstance.Name
+		}
+		if r.MasterInstance.Region != nil {
+			rMasterInstance["region"] = *r.MasterInstance.Region
+		}
+		u.Object["masterInstance"] = rMasterInstance
+	}
+	if r.MasterInstanceName != nil {
+		u.Object["masterInstanceName"] = *r.MasterInstanceName
+	}
+	if r.MaxDiskSize != nil && r.MaxDiskSize != dclService.EmptyInstanceMaxDiskSize {
+		rMaxDiskSize := make(map[string]interface{})
+		if r.MaxDiskSize.Value != nil {
+			rMaxDiskSize["value"] = *r.MaxDiskSize.Value
+		}
+		u.Object["maxDiskSize"] = rMaxDiskSize
+	}
+	if r.Name != nil {
+		u.Object["name"] = *r.Name
+	}
+	if r.Project != nil {
+		u.Object["project"] = *r.Project
+	}
+	if r.Region != nil {
+		u.Object["region"] = *r.Region
+	}
+	if r.ReplicaConfiguration != nil && r.ReplicaConfiguration != dclService.EmptyInstanceReplicaConfiguration {
+		rReplicaConfiguration := make(map[string]interface{})
+		if r.ReplicaConfiguration.FailoverTarget != nil {
+			rReplicaConfiguration["failoverTarget"] = *r.ReplicaConfiguration.FailoverTarget
+		}
+		if r.ReplicaConfiguration.Kind != nil {
+			rReplicaConfiguration["kind"] = *r.ReplicaConfiguration.Kind
+		}
+		if r.ReplicaConfiguration.MysqlReplicaConfiguration != nil && r.ReplicaConfiguration.MysqlReplicaConfiguration != dclService.EmptyInstanceReplicaConfigurationMysqlReplicaConfiguration {
+			rReplicaConfigurationMysqlReplicaConfiguration := make(map[string]interface{})
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.CaCertificate != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["caCertificate"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.CaCertificate
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientCertificate != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["clientCertificate"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientCertificate
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientKey != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["clientKey"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientKey
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.ConnectRetryInterval != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["connectRetryInterval"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.ConnectRetryInterval
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.DumpFilePath != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["dumpFilePath"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.DumpFilePath
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.Kind != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["kind"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.Kind
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod != nil && r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod != dclService.EmptyInstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod {
+				rReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod := make(map[string]interface{})
+				if r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod.Value != nil {
+					rReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod["value"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod.Value
+				}
+				rReplicaConfigurationMysqlReplicaConfiguration["masterHeartbeatPeriod"] = rReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.Password != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["password"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.Password
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.SslCipher != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["sslCipher"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.SslCipher
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.Username != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["username"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.Username
+			}
+			if r.ReplicaConfiguration.MysqlReplicaConfiguration.VerifyServerCertificate != nil {
+				rReplicaConfigurationMysqlReplicaConfiguration["verifyServerCertificate"] = *r.ReplicaConfiguration.MysqlReplicaConfiguration.VerifyServerCertificate
+			}
+			rReplicaConfiguration["mysqlReplicaConfiguration"] = rReplicaConfigurationMysqlReplicaConfiguration
+		}
+		if r.ReplicaConfiguration.ReplicaPoolConfiguration != nil && r.ReplicaConfiguration.ReplicaPoolConfiguration != dclService.EmptyInstanceReplicaConfigurationReplicaPoolConfiguration {
+			rReplicaConfigurationReplicaPoolConfiguration := make(map[string]interface{})
+			if r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration != nil && r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration != dclService.EmptyInstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration {
+				rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration := make(map[string]interface{})
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.Kind != nil {
+					rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["kind"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.Kind
+				}
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MaxReplicaCount != nil {
+					rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["maxReplicaCount"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MaxReplicaCount
+				}
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MinReplicaCount != nil {
+					rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["minReplicaCount"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MinReplicaCount
+				}
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.TargetCpuUtil != nil {
+					rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["targetCpuUtil"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.TargetCpuUtil
+				}
+				rReplicaConfigurationReplicaPoolConfiguration["autoscalingPoolConfiguration"] = rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration
+			}
+			if r.ReplicaConfiguration.ReplicaPoolConfiguration.Kind != nil {
+				rReplicaConfigurationReplicaPoolConfiguration["kind"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.Kind
+			}
+			if r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration != nil && r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration != dclService.EmptyInstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration {
+				rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration := make(map[string]interface{})
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ExposeReplicaIP != nil {
+					rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["exposeReplicaIp"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ExposeReplicaIP
+				}
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.Kind != nil {
+					rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["kind"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.Kind
+				}
+				if r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ReplicaCount != nil {
+					rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["replicaCount"] = *r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ReplicaCount
+				}
+				rReplicaConfigurationReplicaPoolConfiguration["staticPoolConfiguration"] = rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration
+			}
+			rReplicaConfiguration["replicaPoolConfiguration"] = rReplicaConfigurationReplicaPoolConfiguration
+		}
+		u.Object["replicaConfiguration"] = rReplicaConfiguration
+	}
+	if r.RootPassword != nil {
+		u.Object["rootPassword"] = *r.RootPassword
+	}
+	if r.ScheduledMaintenance != nil && r.ScheduledMaintenance != dclService.EmptyInstanceScheduledMaintenance {
+		rScheduledMaintenance := make(map[string]interface{})
+		if r.ScheduledMaintenance.CanDefer != nil {
+			rScheduledMaintenance["canDefer"] = *r.ScheduledMaintenance.CanDefer
+		}
+		if r.ScheduledMaintenance.CanReschedule != nil {
+			rScheduledMaintenance["canReschedule"] = *r.ScheduledMaintenance.CanReschedule
+		}
+		if r.ScheduledMaintenance.StartTime != nil && r.ScheduledMaintenance.StartTime != dclService.EmptyInstanceScheduledMaintenanceStartTime {
+			rScheduledMaintenanceStartTime := make(map[string]interface{})
+			if r.ScheduledMaintenance.StartTime.Nanos != nil {
+				rScheduledMaintenanceStartTime["nanos"] = *r.ScheduledMaintenance.StartTime.Nanos
+			}
+			if r.ScheduledMaintenance.StartTime.Seconds != nil {
+				rScheduledMaintenanceStartTime["seconds"] = *r.ScheduledMaintenance.StartTime.Seconds
+			}
+			rScheduledMaintenance["startTime"] = rScheduledMaintenanceStartTime
+		}
+		u.Object["scheduledMaintenance"] = rScheduledMaintenance
+	}
+	if r.Settings != nil && r.Settings != dclService.EmptyInstanceSettings {
+		rSettings := make(map[string]interface{})
+		if r.Settings.ActivationPolicy != nil {
+			rSettings["activationPolicy"] = string(*r.Settings.ActivationPolicy)
+		}
+		var rSettingsAuthorizedGaeApplications []interface{}
+		for _, rSettingsAuthorizedGaeApplicationsVal := range r.Settings.AuthorizedGaeApplications {
+			rSettingsAuthorizedGaeApplications = append(rSettingsAuthorizedGaeApplications, rSettingsAuthorizedGaeApplicationsVal)
+		}
+		rSettings["authorizedGaeApplications"] = rSettingsAuthorizedGaeApplications
+		if r.Settings.AvailabilityType != nil {
+			rSettings["availabilityType"] = string(*r.Settings.AvailabilityType)
+		}
+		if r.Settings.CrashSafeReplicationEnabled != nil {
+			rSettings["crashSafeReplicationEnabled"] = *r.Settings.CrashSafeReplicationEnabled
+		}
+		if r.Settings.DataDiskType != nil {
+			rSettings["dataDiskType"] = string(*r.Settings.DataDiskType)
+		}
+		if r.Settings.DatabaseReplicationEnabled != nil {
+			rSettings["databaseReplicationEnabled"] = *r.Settings.DatabaseReplicationEnabled
+		}
+		if r.Settings.Kind != nil {
+			rSettings["kind"] = *r.Settings.Kind
+		}
+		if r.Settings.PricingPlan != nil {
+			rSettings["pricingPlan"] = string(*r.Settings.PricingPlan)
+		}
+		if r.Settings.ReplicationType != nil {
+			rSettings["replicationType"] = string(*r.Settings.ReplicationType)
+		}
+		if r.Settings.StorageAutoResize != nil {
+			rSettings["storageAutoResize"] = *r.Settings.StorageAutoResize
+		}
+		if r.Settings.Tier != nil {
+			rSettings["tier"] = *r.Settings.Tier
+		}
+		u.Object["settings"] = rSettings
+	}
+	return u
+}
+
+func UnstructuredToInstance(u *unstructured.Resource) (*dclService.Instance, error) {
+	r := &dclService.Instance{}
+	if _, ok := u.Object["backendType"]; ok {
+		if s, ok := u.Object["backendType"].(string); ok {
+			r.BackendType = dclService.InstanceBackendTypeEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.BackendType: expected string")
+		}
+	}
+	if _, ok := u.Object["connectionName"]; ok {
+		if s, ok := u.Object["connectionName"].(string); ok {
+			r.ConnectionName = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.ConnectionName: expected string")
+		}
+	}
+	if _, ok := u.Object["currentDiskSize"]; ok {
+		if rCurrentDiskSize, ok := u.Object["currentDiskSize"].(map[string]interface{}); ok {
+			r.CurrentDiskSize = &dclService.InstanceCurrentDiskSize{}
+			if _, ok := rCurrentDiskSize["value"]; ok {
+				if i, ok := rCurrentDiskSize["value"].(int64); ok {
+					r.CurrentDiskSize.Value = dcl.Int64(i)
+				} else {
+					return nil, fmt.Errorf("r.CurrentDiskSize.Value: expected int64")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.CurrentDiskSize: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["databaseVersion"]; ok {
+		if s, ok := u.Object["databaseVersion"].(string); ok {
+			r.DatabaseVersion = dclService.InstanceDatabaseVersionEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.DatabaseVersion: expected string")
+		}
+	}
+	if _, ok := u.Object["diskEncryptionConfiguration"]; ok {
+		if rDiskEncryptionConfiguration, ok := u.Object["diskEncryptionConfiguration"].(map[string]interface{}); ok {
+			r.DiskEncryptionConfiguration = &dclService.InstanceDiskEncryptionConfiguration{}
+			if _, ok := rDiskEncryptionConfiguration["kind"]; ok {
+				if s, ok := rDiskEncryptionConfiguration["kind"].(string); ok {
+					r.DiskEncryptionConfiguration.Kind = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.DiskEncryptionConfiguration.Kind: expected string")
+				}
+			}
+			if _, ok := rDiskEncryptionConfiguration["kmsKeyName"]; ok {
+				if s, ok := rDiskEncryptionConfiguration["kmsKeyName"].(string); ok {
+					r.DiskEncryptionConfiguration.KmsKeyName = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.DiskEncryptionConfiguration.KmsKeyName: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.DiskEncryptionConfiguration: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["etag"]; ok {
+		if s, ok := u.Object["etag"].(string); ok {
+			r.Etag = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Etag: expected string")
+		}
+	}
+	if _, ok := u.Object["failoverReplica"]; ok {
+		if rFailoverReplica, ok := u.Object["failoverReplica"].(map[string]interface{}); ok {
+			r.FailoverReplica = &dclService.InstanceFailoverReplica{}
+			if _, ok := rFailoverReplica["available"]; ok {
+				if b, ok := rFailoverReplica["available"].(bool); ok {
+					r.FailoverReplica.Available = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.FailoverReplica.Available: expected bool")
+				}
+			}
+			if _, ok := rFailoverReplica["name"]; ok {
+				if s, ok := rFailoverReplica["name"].(string); ok {
+					r.FailoverReplica.Name = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.FailoverReplica.Name: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.FailoverReplica: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["gceZone"]; ok {
+		if s, ok := u.Object["gceZone"].(string); ok {
+			r.GceZone = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.GceZone: expected string")
+		}
+	}
+	if _, ok := u.Object["instanceType"]; ok {
+		if s, ok := u.Object["instanceType"].(string); ok {
+			r.InstanceType = dclService.InstanceInstanceTypeEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.InstanceType: expected string")
+		}
+	}
+	if _, ok := u.Object["ipAddresses"]; ok {
+		if s, ok := u.Object["ipAddresses"].([]interface{}); ok {
+			for _, o := range s {
+				if objval, ok := o.(map[string]interface{}); ok {
+					var rIPAddresses dclService.InstanceIPAddresses
+					if _, ok := objval["ipAddress"]; ok {
+						if s, ok := objval["ipAddress"].(string); ok {
+							rIPAddresses.IPAddress = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("rIPAddresses.IPAddress: expected string")
+						}
+					}
+					if _, ok := objval["timeToRetire"]; ok {
+						if rIPAddressesTimeToRetire, ok := objval["timeToRetire"].(map[string]interface{}); ok {
+							rIPAddresses.TimeToRetire = &dclService.InstanceIPAddressesTimeToRetire{}
+							if _, ok := rIPAddressesTimeToRetire["nanos"]; ok {
+								if i, ok := rIPAddressesTimeToRetire["nanos"].(int64); ok {
+									rIPAddresses.TimeToRetire.Nanos = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("rIPAddresses.TimeToRetire.Nanos: expected int64")
+								}
+							}
+							if _, ok := rIPAddressesTimeToRetire["seconds"]; ok {
+								if i, ok := rIPAddressesTimeToRetire["seconds"].(int64); ok {
+									rIPAddresses.TimeToRetire.Seconds = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("rIPAddresses.TimeToRetire.Seconds: expected int64")
+								}
+							}
+						} else {
+							return nil, fmt.Errorf("rIPAddresses.TimeToRetire: expected map[string]interface{}")
+						}
+					}
+					if _, ok := objval["type"]; ok {
+						if s, ok := objval["type"].(string); ok {
+							rIPAddresses.Type = dclService.InstanceIPAddressesTypeEnumRef(s)
+						} else {
+							return nil, fmt.Errorf("rIPAddresses.Type: expected string")
+						}
+					}
+					r.IPAddresses = append(r.IPAddresses, rIPAddresses)
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.IPAddresses: expected []interface{}")
+		}
+	}
+	if _, ok := u.Object["masterInstance"]; ok {
+		if rMasterInstance, ok := u.Object["masterInstance"].(map[string]interface{}); ok {
+			r.MasterInstance = &dclService.InstanceMasterInstance{}
+			if _, ok := rMasterInstance["name"]; ok {
+				if s, ok := rMasterInstance["name"].(string); ok {
+					r.MasterInstance.Name = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.MasterInstance.Name: expected string")
+				}
+			}
+			if _, ok := rMasterInstance["region"]; ok {
+				if s, ok := rMasterInstance["region"].(string); ok {
+					r.MasterInstance.Region = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.MasterInstance.Region: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.MasterInstance: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["masterInstanceName"]; ok {
+		if s, ok := u.Object["masterInstanceName"].(string); ok {
+			r.MasterInstanceName = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.MasterInstanceName: expected string")
+		}
+	}
+	if _, ok := u.Object["maxDiskSize"]; ok {
+		if rMaxDiskSize, ok := u.Object["maxDiskSize"].(map[string]interface{}); ok {
+			r.MaxDiskSize = &dclService.InstanceMaxDiskSize{}
+			if _, ok := rMaxDiskSize["value"]; ok {
+				if i, ok := rMaxDiskSize["value"].(int64); ok {
+					r.MaxDiskSize.Value = dcl.Int64(i)
+				} else {
+					return nil, fmt.Errorf("r.MaxDiskSize.Value: expected int64")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.MaxDiskSize: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["name"]; ok {
+		if s, ok := u.Object["name"].(string); ok {
+			r.Name = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Name: expected string")
+		}
+	}
+	if _, ok := u.Object["project"]; ok {
+		if s, ok := u.Object["project"].(string); ok {
+			r.Project = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Project: expected string")
+		}
+	}
+	if _, ok := u.Object["region"]; ok {
+		if s, ok := u.Object["region"].(string); ok {
+			r.Region = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Region: expected string")
+		}
+	}
+	if _, ok := u.Object["replicaConfiguration"]; ok {
+		if rReplicaConfiguration, ok := u.Object["replicaConfiguration"].(map[string]interface{}); ok {
+			r.ReplicaConfiguration = &dclService.InstanceReplicaConfiguration{}
+			if _, ok := rReplicaConfiguration["failoverTarget"]; ok {
+				if b, ok := rReplicaConfiguration["failoverTarget"].(bool); ok {
+					r.ReplicaConfiguration.FailoverTarget = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.ReplicaConfiguration.FailoverTarget: expected bool")
+				}
+			}
+			if _, ok := rReplicaConfiguration["kind"]; ok {
+				if s, ok := rReplicaConfiguration["kind"].(string); ok {
+					r.ReplicaConfiguration.Kind = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.ReplicaConfiguration.Kind: expected string")
+				}
+			}
+			if _, ok := rReplicaConfiguration["mysqlReplicaConfiguration"]; ok {
+				if rReplicaConfigurationMysqlReplicaConfiguration, ok := rReplicaConfiguration["mysqlReplicaConfiguration"].(map[string]interface{}); ok {
+					r.ReplicaConfiguration.MysqlReplicaConfiguration = &dclService.InstanceReplicaConfigurationMysqlReplicaConfiguration{}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["caCertificate"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["caCertificate"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.CaCertificate = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.CaCertificate: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["clientCertificate"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["clientCertificate"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientCertificate = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientCertificate: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["clientKey"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["clientKey"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientKey = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.ClientKey: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["connectRetryInterval"]; ok {
+						if i, ok := rReplicaConfigurationMysqlReplicaConfiguration["connectRetryInterval"].(int64); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.ConnectRetryInterval = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.ConnectRetryInterval: expected int64")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["dumpFilePath"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["dumpFilePath"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.DumpFilePath = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.DumpFilePath: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["kind"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["kind"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.Kind = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.Kind: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["masterHeartbeatPeriod"]; ok {
+						if rReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod, ok := rReplicaConfigurationMysqlReplicaConfiguration["masterHeartbeatPeriod"].(map[string]interface{}); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod = &dclService.InstanceReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod{}
+							if _, ok := rReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod["value"]; ok {
+								if i, ok := rReplicaConfigurationMysqlReplicaConfigurationMasterHeartbeatPeriod["value"].(int64); ok {
+									r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod.Value = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod.Value: expected int64")
+								}
+							}
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.MasterHeartbeatPeriod: expected map[string]interface{}")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["password"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["password"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.Password = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.Password: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["sslCipher"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["sslCipher"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.SslCipher = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.SslCipher: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["username"]; ok {
+						if s, ok := rReplicaConfigurationMysqlReplicaConfiguration["username"].(string); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.Username = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.Username: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationMysqlReplicaConfiguration["verifyServerCertificate"]; ok {
+						if b, ok := rReplicaConfigurationMysqlReplicaConfiguration["verifyServerCertificate"].(bool); ok {
+							r.ReplicaConfiguration.MysqlReplicaConfiguration.VerifyServerCertificate = dcl.Bool(b)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration.VerifyServerCertificate: expected bool")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.ReplicaConfiguration.MysqlReplicaConfiguration: expected map[string]interface{}")
+				}
+			}
+			if _, ok := rReplicaConfiguration["replicaPoolConfiguration"]; ok {
+				if rReplicaConfigurationReplicaPoolConfiguration, ok := rReplicaConfiguration["replicaPoolConfiguration"].(map[string]interface{}); ok {
+					r.ReplicaConfiguration.ReplicaPoolConfiguration = &dclService.InstanceReplicaConfigurationReplicaPoolConfiguration{}
+					if _, ok := rReplicaConfigurationReplicaPoolConfiguration["autoscalingPoolConfiguration"]; ok {
+						if rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration, ok := rReplicaConfigurationReplicaPoolConfiguration["autoscalingPoolConfiguration"].(map[string]interface{}); ok {
+							r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration = &dclService.InstanceReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration{}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["kind"]; ok {
+								if s, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["kind"].(string); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.Kind = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.Kind: expected string")
+								}
+							}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["maxReplicaCount"]; ok {
+								if i, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["maxReplicaCount"].(int64); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MaxReplicaCount = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MaxReplicaCount: expected int64")
+								}
+							}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["minReplicaCount"]; ok {
+								if i, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["minReplicaCount"].(int64); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MinReplicaCount = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.MinReplicaCount: expected int64")
+								}
+							}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["targetCpuUtil"]; ok {
+								if f, ok := rReplicaConfigurationReplicaPoolConfigurationAutoscalingPoolConfiguration["targetCpuUtil"].(float64); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.TargetCpuUtil = dcl.Float64(f)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration.TargetCpuUtil: expected float64")
+								}
+							}
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.AutoscalingPoolConfiguration: expected map[string]interface{}")
+						}
+					}
+					if _, ok := rReplicaConfigurationReplicaPoolConfiguration["kind"]; ok {
+						if s, ok := rReplicaConfigurationReplicaPoolConfiguration["kind"].(string); ok {
+							r.ReplicaConfiguration.ReplicaPoolConfiguration.Kind = dcl.String(s)
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.Kind: expected string")
+						}
+					}
+					if _, ok := rReplicaConfigurationReplicaPoolConfiguration["staticPoolConfiguration"]; ok {
+						if rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration, ok := rReplicaConfigurationReplicaPoolConfiguration["staticPoolConfiguration"].(map[string]interface{}); ok {
+							r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration = &dclService.InstanceReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration{}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["exposeReplicaIp"]; ok {
+								if b, ok := rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["exposeReplicaIp"].(bool); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ExposeReplicaIP = dcl.Bool(b)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ExposeReplicaIP: expected bool")
+								}
+							}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["kind"]; ok {
+								if s, ok := rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["kind"].(string); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.Kind = dcl.String(s)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.Kind: expected string")
+								}
+							}
+							if _, ok := rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["replicaCount"]; ok {
+								if i, ok := rReplicaConfigurationReplicaPoolConfigurationStaticPoolConfiguration["replicaCount"].(int64); ok {
+									r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ReplicaCount = dcl.Int64(i)
+								} else {
+									return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration.ReplicaCount: expected int64")
+								}
+							}
+						} else {
+							return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration.StaticPoolConfiguration: expected map[string]interface{}")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.ReplicaConfiguration.ReplicaPoolConfiguration: expected map[string]interface{}")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.ReplicaConfiguration: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["rootPassword"]; ok {
+		if s, ok := u.Object["rootPassword"].(string); ok {
+			r.RootPassword = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.RootPassword: expected string")
+		}
+	}
+	if _, ok := u.Object["scheduledMaintenance"]; ok {
+		if rScheduledMaintenance, ok := u.Object["scheduledMaintenance"].(map[string]interface{}); ok {
+			r.ScheduledMaintenance = &dclService.InstanceScheduledMaintenance{}
+			if _, ok := rScheduledMaintenance["canDefer"]; ok {
+				if b, ok := rScheduledMaintenance["canDefer"].(bool); ok {
+					r.ScheduledMaintenance.CanDefer = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.ScheduledMaintenance.CanDefer: expected bool")
+				}
+			}
+			if _, ok := rScheduledMaintenance["canReschedule"]; ok {
+				if b, ok := rScheduledMaintenance["canReschedule"].(bool); ok {
+					r.ScheduledMaintenance.CanReschedule = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.ScheduledMaintenance.CanReschedule: expected bool")
+				}
+			}
+			if _, ok := rScheduledMaintenance["startTime"]; ok {
+				if rScheduledMaintenanceStartTime, ok := rScheduledMaintenance["startTime"].(map[string]interface{}); ok {
+					r.ScheduledMaintenance.StartTime = &dclService.InstanceScheduledMaintenanceStartTime{}
+					if _, ok := rScheduledMaintenanceStartTime["nanos"]; ok {
+						if i, ok := rScheduledMaintenanceStartTime["nanos"].(int64); ok {
+							r.ScheduledMaintenance.StartTime.Nanos = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.ScheduledMaintenance.StartTime.Nanos: expected int64")
+						}
+					}
+					if _, ok := rScheduledMaintenanceStartTime["seconds"]; ok {
+						if i, ok := rScheduledMaintenanceStartTime["seconds"].(int64); ok {
+							r.ScheduledMaintenance.StartTime.Seconds = dcl.Int64(i)
+						} else {
+							return nil, fmt.Errorf("r.ScheduledMaintenance.StartTime.Seconds: expected int64")
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.ScheduledMaintenance.StartTime: expected map[string]interface{}")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.ScheduledMaintenance: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["settings"]; ok {
+		if rSettings, ok := u.Object["settings"].(map[string]interface{}); ok {
+			r.Settings = &dclService.InstanceSettings{}
+			if _, ok := rSettings["activationPolicy"]; ok {
+				if s, ok := rSettings["activationPolicy"].(string); ok {
+					r.Settings.ActivationPolicy = dclService.InstanceSettingsActivationPolicyEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.ActivationPolicy: expected string")
+				}
+			}
+			if _, ok := rSettings["authorizedGaeApplications"]; ok {
+				if s, ok := rSettings["authorizedGaeApplications"].([]interface{}); ok {
+					for _, ss := range s {
+						if strval, ok := ss.(string); ok {
+							r.Settings.AuthorizedGaeApplications = append(r.Settings.AuthorizedGaeApplications, strval)
+						}
+					}
+				} else {
+					return nil, fmt.Errorf("r.Settings.AuthorizedGaeApplications: expected []interface{}")
+				}
+			}
+			if _, ok := rSettings["availabilityType"]; ok {
+				if s, ok := rSettings["availabilityType"].(string); ok {
+					r.Settings.AvailabilityType = dclService.InstanceSettingsAvailabilityTypeEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.AvailabilityType: expected string")
+				}
+			}
+			if _, ok := rSettings["crashSafeReplicationEnabled"]; ok {
+				if b, ok := rSettings["crashSafeReplicationEnabled"].(bool); ok {
+					r.Settings.CrashSafeReplicationEnabled = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.Settings.CrashSafeReplicationEnabled: expected bool")
+				}
+			}
+			if _, ok := rSettings["dataDiskType"]; ok {
+				if s, ok := rSettings["dataDiskType"].(string); ok {
+					r.Settings.DataDiskType = dclService.InstanceSettingsDataDiskTypeEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.DataDiskType: expected string")
+				}
+			}
+			if _, ok := rSettings["databaseReplicationEnabled"]; ok {
+				if b, ok := rSettings["databaseReplicationEnabled"].(bool); ok {
+					r.Settings.DatabaseReplicationEnabled = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.Settings.DatabaseReplicationEnabled: expected bool")
+				}
+			}
+			if _, ok := rSettings["kind"]; ok {
+				if s, ok := rSettings["kind"].(string); ok {
+					r.Settings.Kind = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.Kind: expected string")
+				}
+			}
+			if _, ok := rSettings["pricingPlan"]; ok {
+				if s, ok := rSettings["pricingPlan"].(string); ok {
+					r.Settings.PricingPlan = dclService.InstanceSettingsPricingPlanEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.PricingPlan: expected string")
+				}
+			}
+			if _, ok := rSettings["replicationType"]; ok {
+				if s, ok := rSettings["replicationType"].(string); ok {
+					r.Settings.ReplicationType = dclService.InstanceSettingsReplicationTypeEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.ReplicationType: expected string")
+				}
+			}
+			if _, ok := rSettings["storageAutoResize"]; ok {
+				if b, ok := rSettings["storageAutoResize"].(bool); ok {
+					r.Settings.StorageAutoResize = dcl.Bool(b)
+				} else {
+					return nil, fmt.Errorf("r.Settings.StorageAutoResize: expected bool")
+				}
+			}
+			if _, ok := rSettings["tier"]; ok {
+				if s, ok := rSettings["tier"].(string); ok {
+					r.Settings.Tier = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.Settings.Tier: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.Settings: expected map[string]interface{}")
+		}
+	}
+	return r, nil
+}
+
+func GetInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetInstance(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToUnstructured(r), nil
+}
+
+func ListInstance(ctx context.Context, config *dcl.Config, project string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListInstance(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, InstanceToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplyInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInstance(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplyInstance(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return InstanceToUnstructured(r), nil
+}
+
+func InstanceHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInstance(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplyInstance(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func InstanceFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToInstance(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffInstance(ctx, r, opts...)
+}
+
+func DeleteInstance(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteInstance(ctx, r)
+}
+
+func InstanceID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToInstance(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *Instance) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"sql",
+		"Instance",
+		"ga",
+	}
+}
+
+func (r *Instance) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Instance) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetInstance(ctx, config, resource)
+}
+
+func (r *Instance) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplyInstance(ctx, config, resource, opts...)
+}
+
+func (r *Instance) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return InstanceHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *Instance) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return InstanceFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *Instance) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteInstance(ctx, config, resource)
+}
+
+func (r *Instance) ID(resource *unstructured.Resource) (string, error) {
+	return InstanceID(resource)
+}
+
+func (r *Instance) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListInstance(ctx, config, project)
+}
+
+func (r *Instance) Schema() *dcl.Schema {
+	return dclService.DCLInstanceSchema()
+}
+
+func init() {
+	unstructured.Register(&Instance{})
+}
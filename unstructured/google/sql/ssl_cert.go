@@ -0,0 +1,308 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package sql
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/sql"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type SslCert struct{}
+
+func SslCertToUnstructured(r *dclService.SslCert) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "sql",
+			Version: "ga",
+			Type:    "SslCert",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.Cert != nil {
+		u.Object["cert"] = *r.Cert
+	}
+	if r.CertSerialNumber != nil {
+		u.Object["certSerialNumber"] = *r.CertSerialNumber
+	}
+	if r.CommonName != nil {
+		u.Object["commonName"] = *r.CommonName
+	}
+	if r.CreateTime != nil {
+		u.Object["createTime"] = *r.CreateTime
+	}
+	if r.ExpirationTime != nil {
+		u.Object["expirationTime"] = *r.ExpirationTime
+	}
+	if r.Instance != nil {
+		u.Object["instance"] = *r.Instance
+	}
+	if r.Name != nil {
+		u.Object["name"] = *r.Name
+	}
+	if r.Project != nil {
+		u.Object["project"] = *r.Project
+	}
+	return u
+}
+
+func UnstructuredToSslCert(u *unstructured.Resource) (*dclService.SslCert, error) {
+	r := &dclService.SslCert{}
+	if _, ok := u.Object["cert"]; ok {
+		if s, ok := u.Object["cert"].(string); ok {
+			r.Cert = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Cert: expected string")
+		}
+	}
+	if _, ok := u.Object["certSerialNumber"]; ok {
+		if s, ok := u.Object["certSerialNumber"].(string); ok {
+			r.CertSerialNumber = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.CertSerialNumber: expected string")
+		}
+	}
+	if _, ok := u.Object["commonName"]; ok {
+		if s, ok := u.Object["commonName"].(string); ok {
+			r.CommonName = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.CommonName: expected string")
+		}
+	}
+	if _, ok := u.Object["createTime"]; ok {
+		if s, ok := u.Object["createTime"].(string); ok {
+			r.CreateTime = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.CreateTime: expected string")
+		}
+	}
+	if _, ok := u.Object["expirationTime"]; ok {
+		if s, ok := u.Object["expirationTime"].(string); ok {
+			r.ExpirationTime = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.ExpirationTime: expected string")
+		}
+	}
+	if _, ok := u.Object["instance"]; ok {
+		if s, ok := u.Object["instance"].(string); ok {
+			r.Instance = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Instance: expected string")
+		}
+	}
+	if _, ok := u.Object["name"]; ok {
+		if s, ok := u.Object["name"].(string); ok {
+			r.Name = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Name: expected string")
+		}
+	}
+	if _, ok := u.Object["project"]; ok {
+		if s, ok := u.Object["project"].(string); ok {
+			r.Project = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Project: expected string")
+		}
+	}
+	return r, nil
+}
+
+func GetSslCert(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToSslCert(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetSslCert(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return SslCertToUnstructured(r), nil
+}
+
+func ListSslCert(ctx context.Context, config *dcl.Config, project string, instance string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListSslCert(ctx, project, instance)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, SslCertToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplySslCert(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToSslCert(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToSslCert(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplySslCert(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return SslCertToUnstructured(r), nil
+}
+
+func SslCertHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToSslCert(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToSslCert(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplySslCert(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func SslCertFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToSslCert(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToSslCert(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffSslCert(ctx, r, opts...)
+}
+
+func DeleteSslCert(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToSslCert(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteSslCert(ctx, r)
+}
+
+func SslCertID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToSslCert(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *SslCert) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"sql",
+		"SslCert",
+		"ga",
+	}
+}
+
+func (r *SslCert) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *SslCert) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *SslCert) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *SslCert) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *SslCert) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *SslCert) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *SslCert) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetSslCert(ctx, config, resource)
+}
+
+func (r *SslCert) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplySslCert(ctx, config, resource, opts...)
+}
+
+func (r *SslCert) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return SslCertHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *SslCert) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return SslCertFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *SslCert) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteSslCert(ctx, config, resource)
+}
+
+func (r *SslCert) ID(resource *unstructured.Resource) (string, error) {
+	return SslCertID(resource)
+}
+
+func (r *SslCert) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	instance, err := unstructured.StringField(resource, "instance")
+	if err != nil {
+		return nil, err
+	}
+	return ListSslCert(ctx, config, project, instance)
+}
+
+func (r *SslCert) Schema() *dcl.Schema {
+	return dclService.DCLSslCertSchema()
+}
+
+func init() {
+	unstructured.Register(&SslCert{})
+}
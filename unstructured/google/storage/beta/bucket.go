@@ -446,6 +446,22 @@ func BucketHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Reso
 	return false, nil
 }
 
+func BucketFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToBucket(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToBucket(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffBucket(ctx, r, opts...)
+}
+
 func DeleteBucket(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToBucket(u)
@@ -538,13 +554,17 @@ func SetPolicyMemberBucket(ctx context.Context, config *dcl.Config, u *unstructu
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberBucket(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberBucket(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToBucket(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -572,8 +592,8 @@ func (r *Bucket) SetPolicyMember(ctx context.Context, config *dcl.Config, resour
 	return SetPolicyMemberBucket(ctx, config, resource, member)
 }
 
-func (r *Bucket) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberBucket(ctx, config, resource, role, member)
+func (r *Bucket) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberBucket(ctx, config, resource, member)
 }
 
 func (r *Bucket) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -604,6 +624,10 @@ func (r *Bucket) HasDiff(ctx context.Context, config *dcl.Config, resource *unst
 	return BucketHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Bucket) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return BucketFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Bucket) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteBucket(ctx, config, resource)
 }
@@ -612,6 +636,18 @@ func (r *Bucket) ID(resource *unstructured.Resource) (string, error) {
 	return BucketID(resource)
 }
 
+func (r *Bucket) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListBucket(ctx, config, project)
+}
+
+func (r *Bucket) Schema() *dcl.Schema {
+	return dclService.DCLBucketSchema()
+}
+
 func init() {
 	unstructured.Register(&Bucket{})
 }
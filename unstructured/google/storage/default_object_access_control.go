@@ -0,0 +1,329 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type DefaultObjectAccessControl struct{}
+
+func DefaultObjectAccessControlToUnstructured(r *dclService.DefaultObjectAccessControl) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "storage",
+			Version: "ga",
+			Type:    "DefaultObjectAccessControl",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.Bucket != nil {
+		u.Object["bucket"] = *r.Bucket
+	}
+	if r.Domain != nil {
+		u.Object["domain"] = *r.Domain
+	}
+	if r.Email != nil {
+		u.Object["email"] = *r.Email
+	}
+	if r.Entity != nil {
+		u.Object["entity"] = *r.Entity
+	}
+	if r.EntityId != nil {
+		u.Object["entityId"] = *r.EntityId
+	}
+	if r.Project != nil {
+		u.Object["project"] = *r.Project
+	}
+	if r.ProjectTeam != nil && r.ProjectTeam != dclService.EmptyDefaultObjectAccessControlProjectTeam {
+		rProjectTeam := make(map[string]interface{})
+		if r.ProjectTeam.ProjectNumber != nil {
+			rProjectTeam["projectNumber"] = *r.ProjectTeam.ProjectNumber
+		}
+		if r.ProjectTeam.Team != nil {
+			rProjectTeam["team"] = string(*r.ProjectTeam.Team)
+		}
+		u.Object["projectTeam"] = rProjectTeam
+	}
+	if r.Role != nil {
+		u.Object["role"] = string(*r.Role)
+	}
+	return u
+}
+
+func UnstructuredToDefaultObjectAccessControl(u *unstructured.Resource) (*dclService.DefaultObjectAccessControl, error) {
+	r := &dclService.DefaultObjectAccessControl{}
+	if _, ok := u.Object["bucket"]; ok {
+		if s, ok := u.Object["bucket"].(string); ok {
+			r.Bucket = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Bucket: expected string")
+		}
+	}
+	if _, ok := u.Object["domain"]; ok {
+		if s, ok := u.Object["domain"].(string); ok {
+			r.Domain = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Domain: expected string")
+		}
+	}
+	if _, ok := u.Object["email"]; ok {
+		if s, ok := u.Object["email"].(string); ok {
+			r.Email = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Email: expected string")
+		}
+	}
+	if _, ok := u.Object["entity"]; ok {
+		if s, ok := u.Object["entity"].(string); ok {
+			r.Entity = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Entity: expected string")
+		}
+	}
+	if _, ok := u.Object["entityId"]; ok {
+		if s, ok := u.Object["entityId"].(string); ok {
+			r.EntityId = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.EntityId: expected string")
+		}
+	}
+	if _, ok := u.Object["project"]; ok {
+		if s, ok := u.Object["project"].(string); ok {
+			r.Project = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Project: expected string")
+		}
+	}
+	if _, ok := u.Object["projectTeam"]; ok {
+		if rProjectTeam, ok := u.Object["projectTeam"].(map[string]interface{}); ok {
+			r.ProjectTeam = &dclService.DefaultObjectAccessControlProjectTeam{}
+			if _, ok := rProjectTeam["projectNumber"]; ok {
+				if s, ok := rProjectTeam["projectNumber"].(string); ok {
+					r.ProjectTeam.ProjectNumber = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.ProjectTeam.ProjectNumber: expected string")
+				}
+			}
+			if _, ok := rProjectTeam["team"]; ok {
+				if s, ok := rProjectTeam["team"].(string); ok {
+					r.ProjectTeam.Team = dclService.DefaultObjectAccessControlProjectTeamTeamEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.ProjectTeam.Team: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.ProjectTeam: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["role"]; ok {
+		if s, ok := u.Object["role"].(string); ok {
+			r.Role = dclService.DefaultObjectAccessControlRoleEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.Role: expected string")
+		}
+	}
+	return r, nil
+}
+
+func GetDefaultObjectAccessControl(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDefaultObjectAccessControl(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetDefaultObjectAccessControl(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return DefaultObjectAccessControlToUnstructured(r), nil
+}
+
+func ListDefaultObjectAccessControl(ctx context.Context, config *dcl.Config, project string, bucket string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListDefaultObjectAccessControl(ctx, project, bucket)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, DefaultObjectAccessControlToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplyDefaultObjectAccessControl(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDefaultObjectAccessControl(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToDefaultObjectAccessControl(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplyDefaultObjectAccessControl(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return DefaultObjectAccessControlToUnstructured(r), nil
+}
+
+func DefaultObjectAccessControlHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDefaultObjectAccessControl(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToDefaultObjectAccessControl(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplyDefaultObjectAccessControl(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func DefaultObjectAccessControlFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDefaultObjectAccessControl(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToDefaultObjectAccessControl(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffDefaultObjectAccessControl(ctx, r, opts...)
+}
+
+func DeleteDefaultObjectAccessControl(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToDefaultObjectAccessControl(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteDefaultObjectAccessControl(ctx, r)
+}
+
+func DefaultObjectAccessControlID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToDefaultObjectAccessControl(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *DefaultObjectAccessControl) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"storage",
+		"DefaultObjectAccessControl",
+		"ga",
+	}
+}
+
+func (r *DefaultObjectAccessControl) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *DefaultObjectAccessControl) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *DefaultObjectAccessControl) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *DefaultObjectAccessControl) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *DefaultObjectAccessControl) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *DefaultObjectAccessControl) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *DefaultObjectAccessControl) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetDefaultObjectAccessControl(ctx, config, resource)
+}
+
+func (r *DefaultObjectAccessControl) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplyDefaultObjectAccessControl(ctx, config, resource, opts...)
+}
+
+func (r *DefaultObjectAccessControl) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return DefaultObjectAccessControlHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *DefaultObjectAccessControl) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return DefaultObjectAccessControlFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *DefaultObjectAccessControl) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteDefaultObjectAccessControl(ctx, config, resource)
+}
+
+func (r *DefaultObjectAccessControl) ID(resource *unstructured.Resource) (string, error) {
+	return DefaultObjectAccessControlID(resource)
+}
+
+func (r *DefaultObjectAccessControl) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := unstructured.StringField(resource, "bucket")
+	if err != nil {
+		return nil, err
+	}
+	return ListDefaultObjectAccessControl(ctx, config, project, bucket)
+}
+
+func (r *DefaultObjectAccessControl) Schema() *dcl.Schema {
+	return dclService.DCLDefaultObjectAccessControlSchema()
+}
+
+func init() {
+	unstructured.Register(&DefaultObjectAccessControl{})
+}
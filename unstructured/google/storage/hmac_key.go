@@ -0,0 +1,294 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type HmacKey struct{}
+
+func HmacKeyToUnstructured(r *dclService.HmacKey) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "storage",
+			Version: "ga",
+			Type:    "HmacKey",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.Name != nil {
+		u.Object["name"] = *r.Name
+	}
+	if r.Project != nil {
+		u.Object["project"] = *r.Project
+	}
+	if r.Secret != nil {
+		u.Object["secret"] = *r.Secret
+	}
+	if r.ServiceAccountEmail != nil {
+		u.Object["serviceAccountEmail"] = *r.ServiceAccountEmail
+	}
+	if r.State != nil {
+		u.Object["state"] = string(*r.State)
+	}
+	if r.TimeCreated != nil {
+		u.Object["timeCreated"] = *r.TimeCreated
+	}
+	if r.Updated != nil {
+		u.Object["updated"] = *r.Updated
+	}
+	return u
+}
+
+func UnstructuredToHmacKey(u *unstructured.Resource) (*dclService.HmacKey, error) {
+	r := &dclService.HmacKey{}
+	if _, ok := u.Object["name"]; ok {
+		if s, ok := u.Object["name"].(string); ok {
+			r.Name = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Name: expected string")
+		}
+	}
+	if _, ok := u.Object["project"]; ok {
+		if s, ok := u.Object["project"].(string); ok {
+			r.Project = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Project: expected string")
+		}
+	}
+	if _, ok := u.Object["secret"]; ok {
+		if s, ok := u.Object["secret"].(string); ok {
+			r.Secret = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Secret: expected string")
+		}
+	}
+	if _, ok := u.Object["serviceAccountEmail"]; ok {
+		if s, ok := u.Object["serviceAccountEmail"].(string); ok {
+			r.ServiceAccountEmail = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.ServiceAccountEmail: expected string")
+		}
+	}
+	if _, ok := u.Object["state"]; ok {
+		if s, ok := u.Object["state"].(string); ok {
+			r.State = dclService.HmacKeyStateEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.State: expected string")
+		}
+	}
+	if _, ok := u.Object["timeCreated"]; ok {
+		if s, ok := u.Object["timeCreated"].(string); ok {
+			r.TimeCreated = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.TimeCreated: expected string")
+		}
+	}
+	if _, ok := u.Object["updated"]; ok {
+		if s, ok := u.Object["updated"].(string); ok {
+			r.Updated = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Updated: expected string")
+		}
+	}
+	return r, nil
+}
+
+func GetHmacKey(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToHmacKey(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetHmacKey(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return HmacKeyToUnstructured(r), nil
+}
+
+func ListHmacKey(ctx context.Context, config *dcl.Config, project string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListHmacKey(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, HmacKeyToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplyHmacKey(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToHmacKey(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToHmacKey(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplyHmacKey(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return HmacKeyToUnstructured(r), nil
+}
+
+func HmacKeyHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToHmacKey(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToHmacKey(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplyHmacKey(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func HmacKeyFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToHmacKey(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToHmacKey(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffHmacKey(ctx, r, opts...)
+}
+
+func DeleteHmacKey(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToHmacKey(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteHmacKey(ctx, r)
+}
+
+func HmacKeyID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToHmacKey(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *HmacKey) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"storage",
+		"HmacKey",
+		"ga",
+	}
+}
+
+func (r *HmacKey) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *HmacKey) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *HmacKey) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *HmacKey) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *HmacKey) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *HmacKey) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *HmacKey) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetHmacKey(ctx, config, resource)
+}
+
+func (r *HmacKey) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplyHmacKey(ctx, config, resource, opts...)
+}
+
+func (r *HmacKey) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return HmacKeyHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *HmacKey) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return HmacKeyFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *HmacKey) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteHmacKey(ctx, config, resource)
+}
+
+func (r *HmacKey) ID(resource *unstructured.Resource) (string, error) {
+	return HmacKeyID(resource)
+}
+
+func (r *HmacKey) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	return ListHmacKey(ctx, config, project)
+}
+
+func (r *HmacKey) Schema() *dcl.Schema {
+	return dclService.DCLHmacKeySchema()
+}
+
+func init() {
+	unstructured.Register(&HmacKey{})
+}
@@ -0,0 +1,556 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type Object struct{}
+
+func ObjectToUnstructured(r *dclService.Object) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "storage",
+			Version: "ga",
+			Type:    "Object",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.Bucket != nil {
+		u.Object["bucket"] = *r.Bucket
+	}
+	if r.ComponentCount != nil {
+		u.Object["componentCount"] = *r.ComponentCount
+	}
+	if r.Content != nil {
+		u.Object["content"] = *r.Content
+	}
+	if r.ContentType != nil {
+		u.Object["contentType"] = *r.ContentType
+	}
+	if r.Crc32c != nil {
+		u.Object["crc32c"] = *r.Crc32c
+	}
+	if r.CustomTime != nil {
+		u.Object["customTime"] = *r.CustomTime
+	}
+	if r.CustomerEncryption != nil && r.CustomerEncryption != dclService.EmptyObjectCustomerEncryption {
+		rCustomerEncryption := make(map[string]interface{})
+		if r.CustomerEncryption.EncryptionAlgorithm != nil {
+			rCustomerEncryption["encryptionAlgorithm"] = *r.CustomerEncryption.EncryptionAlgorithm
+		}
+		if r.CustomerEncryption.Key != nil {
+			rCustomerEncryption["key"] = *r.CustomerEncryption.Key
+		}
+		if r.CustomerEncryption.KeySha256 != nil {
+			rCustomerEncryption["keySha256"] = *r.CustomerEncryption.KeySha256
+		}
+		u.Object["customerEncryption"] = rCustomerEncryption
+	}
+	if r.Etag != nil {
+		u.Object["etag"] = *r.Etag
+	}
+	if r.EventBasedHold != nil {
+		u.Object["eventBasedHold"] = *r.EventBasedHold
+	}
+	if r.Generation != nil {
+		u.Object["generation"] = *r.Generation
+	}
+	if r.Id != nil {
+		u.Object["id"] = *r.Id
+	}
+	if r.KmsKeyName != nil {
+		u.Object["kmsKeyName"] = *r.KmsKeyName
+	}
+	if r.Md5Hash != nil {
+		u.Object["md5Hash"] = *r.Md5Hash
+	}
+	if r.MediaLink != nil {
+		u.Object["mediaLink"] = *r.MediaLink
+	}
+	if r.Metadata != nil {
+		rMetadata := make(map[string]interface{})
+		for k, v := range r.Metadata {
+			rMetadata[k] = v
+		}
+		u.Object["metadata"] = rMetadata
+	}
+	if r.Metageneration != nil {
+		u.Object["metageneration"] = *r.Metageneration
+	}
+	if r.Name != nil {
+		u.Object["name"] = *r.Name
+	}
+	if r.Owner != nil && r.Owner != dclService.EmptyObjectOwner {
+		rOwner := make(map[string]interface{})
+		if r.Owner.Entity != nil {
+			rOwner["entity"] = *r.Owner.Entity
+		}
+		if r.Owner.EntityId != nil {
+			rOwner["entityId"] = *r.Owner.EntityId
+		}
+		u.Object["owner"] = rOwner
+	}
+	if r.RetentionExpirationTime != nil {
+		u.Object["retentionExpirationTime"] = *r.RetentionExpirationTime
+	}
+	if r.SelfLink != nil {
+		u.Object["selfLink"] = *r.SelfLink
+	}
+	if r.Size != nil {
+		u.Object["size"] = *r.Size
+	}
+	if r.StorageClass != nil {
+		u.Object["storageClass"] = *r.StorageClass
+	}
+	if r.TemporaryHold != nil {
+		u.Object["temporaryHold"] = *r.TemporaryHold
+	}
+	if r.TimeCreated != nil {
+		u.Object["timeCreated"] = *r.TimeCreated
+	}
+	if r.TimeDeleted != nil {
+		u.Object["timeDeleted"] = *r.TimeDeleted
+	}
+	if r.TimeStorageClassUpdated != nil {
+		u.Object["timeStorageClassUpdated"] = *r.TimeStorageClassUpdated
+	}
+	if r.Updated != nil {
+		u.Object["updated"] = *r.Updated
+	}
+	return u
+}
+
+func UnstructuredToObject(u *unstructured.Resource) (*dclService.Object, error) {
+	r := &dclService.Object{}
+	if _, ok := u.Object["bucket"]; ok {
+		if s, ok := u.Object["bucket"].(string); ok {
+			r.Bucket = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Bucket: expected string")
+		}
+	}
+	if _, ok := u.Object["componentCount"]; ok {
+		if i, ok := u.Object["componentCount"].(int64); ok {
+			r.ComponentCount = dcl.Int64(i)
+		} else {
+			return nil, fmt.Errorf("r.ComponentCount: expected int64")
+		}
+	}
+	if _, ok := u.Object["content"]; ok {
+		if s, ok := u.Object["content"].(string); ok {
+			r.Content = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Content: expected string")
+		}
+	}
+	if _, ok := u.Object["contentType"]; ok {
+		if s, ok := u.Object["contentType"].(string); ok {
+			r.ContentType = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.ContentType: expected string")
+		}
+	}
+	if _, ok := u.Object["crc32c"]; ok {
+		if s, ok := u.Object["crc32c"].(string); ok {
+			r.Crc32c = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Crc32c: expected string")
+		}
+	}
+	if _, ok := u.Object["customTime"]; ok {
+		if s, ok := u.Object["customTime"].(string); ok {
+			r.CustomTime = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.CustomTime: expected string")
+		}
+	}
+	if _, ok := u.Object["customerEncryption"]; ok {
+		if rCustomerEncryption, ok := u.Object["customerEncryption"].(map[string]interface{}); ok {
+			r.CustomerEncryption = &dclService.ObjectCustomerEncryption{}
+			if _, ok := rCustomerEncryption["encryptionAlgorithm"]; ok {
+				if s, ok := rCustomerEncryption["encryptionAlgorithm"].(string); ok {
+					r.CustomerEncryption.EncryptionAlgorithm = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.CustomerEncryption.EncryptionAlgorithm: expected string")
+				}
+			}
+			if _, ok := rCustomerEncryption["key"]; ok {
+				if s, ok := rCustomerEncryption["key"].(string); ok {
+					r.CustomerEncryption.Key = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.CustomerEncryption.Key: expected string")
+				}
+			}
+			if _, ok := rCustomerEncryption["keySha256"]; ok {
+				if s, ok := rCustomerEncryption["keySha256"].(string); ok {
+					r.CustomerEncryption.KeySha256 = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.CustomerEncryption.KeySha256: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.CustomerEncryption: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["etag"]; ok {
+		if s, ok := u.Object["etag"].(string); ok {
+			r.Etag = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Etag: expected string")
+		}
+	}
+	if _, ok := u.Object["eventBasedHold"]; ok {
+		if b, ok := u.Object["eventBasedHold"].(bool); ok {
+			r.EventBasedHold = dcl.Bool(b)
+		} else {
+			return nil, fmt.Errorf("r.EventBasedHold: expected bool")
+		}
+	}
+	if _, ok := u.Object["generation"]; ok {
+		if i, ok := u.Object["generation"].(int64); ok {
+			r.Generation = dcl.Int64(i)
+		} else {
+			return nil, fmt.Errorf("r.Generation: expected int64")
+		}
+	}
+	if _, ok := u.Object["id"]; ok {
+		if s, ok := u.Object["id"].(string); ok {
+			r.Id = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Id: expected string")
+		}
+	}
+	if _, ok := u.Object["kmsKeyName"]; ok {
+		if s, ok := u.Object["kmsKeyName"].(string); ok {
+			r.KmsKeyName = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.KmsKeyName: expected string")
+		}
+	}
+	if _, ok := u.Object["md5Hash"]; ok {
+		if s, ok := u.Object["md5Hash"].(string); ok {
+			r.Md5Hash = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Md5Hash: expected string")
+		}
+	}
+	if _, ok := u.Object["mediaLink"]; ok {
+		if s, ok := u.Object["mediaLink"].(string); ok {
+			r.MediaLink = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.MediaLink: expected string")
+		}
+	}
+	if _, ok := u.Object["metadata"]; ok {
+		if rMetadata, ok := u.Object["metadata"].(map[string]interface{}); ok {
+			m := make(map[string]string)
+			for k, v := range rMetadata {
+				if s, ok := v.(string); ok {
+					m[k] = s
+				}
+			}
+			r.Metadata = m
+		} else {
+			return nil, fmt.Errorf("r.Metadata: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["metageneration"]; ok {
+		if i, ok := u.Object["metageneration"].(int64); ok {
+			r.Metageneration = dcl.Int64(i)
+		} else {
+			return nil, fmt.Errorf("r.Metageneration: expected int64")
+		}
+	}
+	if _, ok := u.Object["name"]; ok {
+		if s, ok := u.Object["name"].(string); ok {
+			r.Name = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Name: expected string")
+		}
+	}
+	if _, ok := u.Object["owner"]; ok {
+		if rOwner, ok := u.Object["owner"].(map[string]interface{}); ok {
+			r.Owner = &dclService.ObjectOwner{}
+			if _, ok := rOwner["entity"]; ok {
+				if s, ok := rOwner["entity"].(string); ok {
+					r.Owner.Entity = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.Owner.Entity: expected string")
+				}
+			}
+			if _, ok := rOwner["entityId"]; ok {
+				if s, ok := rOwner["entityId"].(string); ok {
+					r.Owner.EntityId = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.Owner.EntityId: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.Owner: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["retentionExpirationTime"]; ok {
+		if s, ok := u.Object["retentionExpirationTime"].(string); ok {
+			r.RetentionExpirationTime = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.RetentionExpirationTime: expected string")
+		}
+	}
+	if _, ok := u.Object["selfLink"]; ok {
+		if s, ok := u.Object["selfLink"].(string); ok {
+			r.SelfLink = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.SelfLink: expected string")
+		}
+	}
+	if _, ok := u.Object["size"]; ok {
+		if i, ok := u.Object["size"].(int64); ok {
+			r.Size = dcl.Int64(i)
+		} else {
+			return nil, fmt.Errorf("r.Size: expected int64")
+		}
+	}
+	if _, ok := u.Object["storageClass"]; ok {
+		if s, ok := u.Object["storageClass"].(string); ok {
+			r.StorageClass = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.StorageClass: expected string")
+		}
+	}
+	if _, ok := u.Object["temporaryHold"]; ok {
+		if b, ok := u.Object["temporaryHold"].(bool); ok {
+			r.TemporaryHold = dcl.Bool(b)
+		} else {
+			return nil, fmt.Errorf("r.TemporaryHold: expected bool")
+		}
+	}
+	if _, ok := u.Object["timeCreated"]; ok {
+		if s, ok := u.Object["timeCreated"].(string); ok {
+			r.TimeCreated = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.TimeCreated: expected string")
+		}
+	}
+	if _, ok := u.Object["timeDeleted"]; ok {
+		if s, ok := u.Object["timeDeleted"].(string); ok {
+			r.TimeDeleted = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.TimeDeleted: expected string")
+		}
+	}
+	if _, ok := u.Object["timeStorageClassUpdated"]; ok {
+		if s, ok := u.Object["timeStorageClassUpdated"].(string); ok {
+			r.TimeStorageClassUpdated = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.TimeStorageClassUpdated: expected string")
+		}
+	}
+	if _, ok := u.Object["updated"]; ok {
+		if s, ok := u.Object["updated"].(string); ok {
+			r.Updated = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Updated: expected string")
+		}
+	}
+	return r, nil
+}
+
+func GetObject(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToObject(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetObject(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return ObjectToUnstructured(r), nil
+}
+
+func ListObject(ctx context.Context, config *dcl.Config, bucket string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListObject(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, ObjectToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplyObject(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToObject(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToObject(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplyObject(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return ObjectToUnstructured(r), nil
+}
+
+func ObjectHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToObject(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToObject(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplyObject(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func ObjectFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToObject(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToObject(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffObject(ctx, r, opts...)
+}
+
+func DeleteObject(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToObject(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteObject(ctx, r)
+}
+
+func ObjectID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToObject(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *Object) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"storage",
+		"Object",
+		"ga",
+	}
+}
+
+func (r *Object) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Object) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Object) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *Object) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Object) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Object) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *Object) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetObject(ctx, config, resource)
+}
+
+func (r *Object) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplyObject(ctx, config, resource, opts...)
+}
+
+func (r *Object) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return ObjectHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *Object) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ObjectFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *Object) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteObject(ctx, config, resource)
+}
+
+func (r *Object) ID(resource *unstructured.Resource) (string, error) {
+	return ObjectID(resource)
+}
+
+func (r *Object) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	bucket, err := unstructured.StringField(resource, "bucket")
+	if err != nil {
+		return nil, err
+	}
+	return ListObject(ctx, config, bucket)
+}
+
+func (r *Object) Schema() *dcl.Schema {
+	return dclService.DCLObjectSchema()
+}
+
+func init() {
+	unstructured.Register(&Object{})
+}
@@ -0,0 +1,363 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+	dclService "github.com/GoogleCloudPlatform/declarative-resource-client-library/services/google/storage"
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/unstructured"
+)
+
+type ObjectAccessControl struct{}
+
+func ObjectAccessControlToUnstructured(r *dclService.ObjectAccessControl) *unstructured.Resource {
+	u := &unstructured.Resource{
+		STV: unstructured.ServiceTypeVersion{
+			Service: "storage",
+			Version: "ga",
+			Type:    "ObjectAccessControl",
+		},
+		Object: make(map[string]interface{}),
+	}
+	if r.Bucket != nil {
+		u.Object["bucket"] = *r.Bucket
+	}
+	if r.Domain != nil {
+		u.Object["domain"] = *r.Domain
+	}
+	if r.Email != nil {
+		u.Object["email"] = *r.Email
+	}
+	if r.Entity != nil {
+		u.Object["entity"] = *r.Entity
+	}
+	if r.EntityId != nil {
+		u.Object["entityId"] = *r.EntityId
+	}
+	if r.Generation != nil {
+		u.Object["generation"] = *r.Generation
+	}
+	if r.Id != nil {
+		u.Object["id"] = *r.Id
+	}
+	if r.Object != nil {
+		u.Object["object"] = *r.Object
+	}
+	if r.Project != nil {
+		u.Object["project"] = *r.Project
+	}
+	if r.ProjectTeam != nil && r.ProjectTeam != dclService.EmptyObjectAccessControlProjectTeam {
+		rProjectTeam := make(map[string]interface{})
+		if r.ProjectTeam.ProjectNumber != nil {
+			rProjectTeam["projectNumber"] = *r.ProjectTeam.ProjectNumber
+		}
+		if r.ProjectTeam.Team != nil {
+			rProjectTeam["team"] = string(*r.ProjectTeam.Team)
+		}
+		u.Object["projectTeam"] = rProjectTeam
+	}
+	if r.Role != nil {
+		u.Object["role"] = string(*r.Role)
+	}
+	return u
+}
+
+func UnstructuredToObjectAccessControl(u *unstructured.Resource) (*dclService.ObjectAccessControl, error) {
+	r := &dclService.ObjectAccessControl{}
+	if _, ok := u.Object["bucket"]; ok {
+		if s, ok := u.Object["bucket"].(string); ok {
+			r.Bucket = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Bucket: expected string")
+		}
+	}
+	if _, ok := u.Object["domain"]; ok {
+		if s, ok := u.Object["domain"].(string); ok {
+			r.Domain = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Domain: expected string")
+		}
+	}
+	if _, ok := u.Object["email"]; ok {
+		if s, ok := u.Object["email"].(string); ok {
+			r.Email = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Email: expected string")
+		}
+	}
+	if _, ok := u.Object["entity"]; ok {
+		if s, ok := u.Object["entity"].(string); ok {
+			r.Entity = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Entity: expected string")
+		}
+	}
+	if _, ok := u.Object["entityId"]; ok {
+		if s, ok := u.Object["entityId"].(string); ok {
+			r.EntityId = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.EntityId: expected string")
+		}
+	}
+	if _, ok := u.Object["generation"]; ok {
+		if i, ok := u.Object["generation"].(int64); ok {
+			r.Generation = dcl.Int64(i)
+		} else {
+			return nil, fmt.Errorf("r.Generation: expected int64")
+		}
+	}
+	if _, ok := u.Object["id"]; ok {
+		if s, ok := u.Object["id"].(string); ok {
+			r.Id = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Id: expected string")
+		}
+	}
+	if _, ok := u.Object["object"]; ok {
+		if s, ok := u.Object["object"].(string); ok {
+			r.Object = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Object: expected string")
+		}
+	}
+	if _, ok := u.Object["project"]; ok {
+		if s, ok := u.Object["project"].(string); ok {
+			r.Project = dcl.String(s)
+		} else {
+			return nil, fmt.Errorf("r.Project: expected string")
+		}
+	}
+	if _, ok := u.Object["projectTeam"]; ok {
+		if rProjectTeam, ok := u.Object["projectTeam"].(map[string]interface{}); ok {
+			r.ProjectTeam = &dclService.ObjectAccessControlProjectTeam{}
+			if _, ok := rProjectTeam["projectNumber"]; ok {
+				if s, ok := rProjectTeam["projectNumber"].(string); ok {
+					r.ProjectTeam.ProjectNumber = dcl.String(s)
+				} else {
+					return nil, fmt.Errorf("r.ProjectTeam.ProjectNumber: expected string")
+				}
+			}
+			if _, ok := rProjectTeam["team"]; ok {
+				if s, ok := rProjectTeam["team"].(string); ok {
+					r.ProjectTeam.Team = dclService.ObjectAccessControlProjectTeamTeamEnumRef(s)
+				} else {
+					return nil, fmt.Errorf("r.ProjectTeam.Team: expected string")
+				}
+			}
+		} else {
+			return nil, fmt.Errorf("r.ProjectTeam: expected map[string]interface{}")
+		}
+	}
+	if _, ok := u.Object["role"]; ok {
+		if s, ok := u.Object["role"].(string); ok {
+			r.Role = dclService.ObjectAccessControlRoleEnumRef(s)
+		} else {
+			return nil, fmt.Errorf("r.Role: expected string")
+		}
+	}
+	return r, nil
+}
+
+func GetObjectAccessControl(ctx context.Context, config *dcl.Config, u *unstructured.Resource) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToObjectAccessControl(u)
+	if err != nil {
+		return nil, err
+	}
+	r, err = c.GetObjectAccessControl(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return ObjectAccessControlToUnstructured(r), nil
+}
+
+func ListObjectAccessControl(ctx context.Context, config *dcl.Config, project string, bucket string, object string) ([]*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	l, err := c.ListObjectAccessControl(ctx, project, bucket, object)
+	if err != nil {
+		return nil, err
+	}
+	var resources []*unstructured.Resource
+	for {
+		for _, r := range l.Items {
+			resources = append(resources, ObjectAccessControlToUnstructured(r))
+		}
+		if !l.HasNext() {
+			break
+		}
+		if err := l.Next(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+func ApplyObjectAccessControl(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToObjectAccessControl(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToObjectAccessControl(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	r, err = c.ApplyObjectAccessControl(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return ObjectAccessControlToUnstructured(r), nil
+}
+
+func ObjectAccessControlHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToObjectAccessControl(u)
+	if err != nil {
+		return false, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToObjectAccessControl(ush)
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	opts = append(opts, dcl.WithLifecycleParam(dcl.BlockDestruction), dcl.WithLifecycleParam(dcl.BlockCreation), dcl.WithLifecycleParam(dcl.BlockModification))
+	_, err = c.ApplyObjectAccessControl(ctx, r, opts...)
+	if err != nil {
+		if _, ok := err.(dcl.ApplyInfeasibleError); ok {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func ObjectAccessControlFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToObjectAccessControl(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToObjectAccessControl(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffObjectAccessControl(ctx, r, opts...)
+}
+
+func DeleteObjectAccessControl(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToObjectAccessControl(u)
+	if err != nil {
+		return err
+	}
+	return c.DeleteObjectAccessControl(ctx, r)
+}
+
+func ObjectAccessControlID(u *unstructured.Resource) (string, error) {
+	r, err := UnstructuredToObjectAccessControl(u)
+	if err != nil {
+		return "", err
+	}
+	return r.ID()
+}
+
+func (r *ObjectAccessControl) STV() unstructured.ServiceTypeVersion {
+	return unstructured.ServiceTypeVersion{
+		"storage",
+		"ObjectAccessControl",
+		"ga",
+	}
+}
+
+func (r *ObjectAccessControl) SetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ObjectAccessControl) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ObjectAccessControl) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
+	return unstructured.ErrNoSuchMethod
+}
+
+func (r *ObjectAccessControl) SetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ObjectAccessControl) SetPolicyWithEtag(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, policy *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ObjectAccessControl) GetPolicy(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return nil, unstructured.ErrNoSuchMethod
+}
+
+func (r *ObjectAccessControl) Get(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetObjectAccessControl(ctx, config, resource)
+}
+
+func (r *ObjectAccessControl) Apply(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (*unstructured.Resource, error) {
+	return ApplyObjectAccessControl(ctx, config, resource, opts...)
+}
+
+func (r *ObjectAccessControl) HasDiff(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) (bool, error) {
+	return ObjectAccessControlHasDiff(ctx, config, resource, opts...)
+}
+
+func (r *ObjectAccessControl) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ObjectAccessControlFieldDiffs(ctx, config, resource, opts...)
+}
+
+func (r *ObjectAccessControl) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
+	return DeleteObjectAccessControl(ctx, config, resource)
+}
+
+func (r *ObjectAccessControl) ID(resource *unstructured.Resource) (string, error) {
+	return ObjectAccessControlID(resource)
+}
+
+func (r *ObjectAccessControl) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := unstructured.StringField(resource, "bucket")
+	if err != nil {
+		return nil, err
+	}
+	object, err := unstructured.StringField(resource, "object")
+	if err != nil {
+		return nil, err
+	}
+	return ListObjectAccessControl(ctx, config, project, bucket, object)
+}
+
+func (r *ObjectAccessControl) Schema() *dcl.Schema {
+	return dclService.DCLObjectAccessControlSchema()
+}
+
+func init() {
+	unstructured.Register(&ObjectAccessControl{})
+}
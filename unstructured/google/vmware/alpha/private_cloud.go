@@ -489,6 +489,22 @@ func PrivateCloudHasDiff(ctx context.Context, config *dcl.Config, u *unstructure
 	return false, nil
 }
 
+func PrivateCloudFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToPrivateCloud(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToPrivateCloud(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffPrivateCloud(ctx, r, opts...)
+}
+
 func DeletePrivateCloud(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToPrivateCloud(u)
@@ -581,13 +597,17 @@ func SetPolicyMemberPrivateCloud(ctx context.Context, config *dcl.Config, u *uns
 	return iamUnstruct.PolicyToUnstructured(policy), nil
 }
 
-func GetPolicyMemberPrivateCloud(ctx context.Context, config *dcl.Config, u *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func GetPolicyMemberPrivateCloud(ctx context.Context, config *dcl.Config, u *unstructured.Resource, m *unstructured.Resource) (*unstructured.Resource, error) {
 	r, err := UnstructuredToPrivateCloud(u)
 	if err != nil {
 		return nil, err
 	}
+	member, err := iamUnstruct.UnstructuredToMember(m)
+	if err != nil {
+		return nil, err
+	}
 	iamClient := iam.NewClient(config)
-	policyMember, err := iamClient.GetMember(ctx, r, role, member)
+	policyMember, err := iamClient.GetConditionalMember(ctx, r, dcl.ValueOrEmptyString(member.Role), dcl.ValueOrEmptyString(member.Member), member.Condition)
 	if err != nil {
 		return nil, err
 	}
@@ -615,8 +635,8 @@ func (r *PrivateCloud) SetPolicyMember(ctx context.Context, config *dcl.Config,
 	return SetPolicyMemberPrivateCloud(ctx, config, resource, member)
 }
 
-func (r *PrivateCloud) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
-	return GetPolicyMemberPrivateCloud(ctx, config, resource, role, member)
+func (r *PrivateCloud) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
+	return GetPolicyMemberPrivateCloud(ctx, config, resource, member)
 }
 
 func (r *PrivateCloud) DeletePolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) error {
@@ -647,6 +667,10 @@ func (r *PrivateCloud) HasDiff(ctx context.Context, config *dcl.Config, resource
 	return PrivateCloudHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *PrivateCloud) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return PrivateCloudFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *PrivateCloud) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeletePrivateCloud(ctx, config, resource)
 }
@@ -655,6 +679,22 @@ func (r *PrivateCloud) ID(resource *unstructured.Resource) (string, error) {
 	return PrivateCloudID(resource)
 }
 
+func (r *PrivateCloud) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListPrivateCloud(ctx, config, project, location)
+}
+
+func (r *PrivateCloud) Schema() *dcl.Schema {
+	return dclService.DCLPrivateCloudSchema()
+}
+
 func init() {
 	unstructured.Register(&PrivateCloud{})
 }
@@ -256,6 +256,22 @@ func VmwareEngineNetworkHasDiff(ctx context.Context, config *dcl.Config, u *unst
 	return false, nil
 }
 
+func VmwareEngineNetworkFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToVmwareEngineNetwork(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToVmwareEngineNetwork(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffVmwareEngineNetwork(ctx, r, opts...)
+}
+
 func DeleteVmwareEngineNetwork(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToVmwareEngineNetwork(u)
@@ -285,7 +301,7 @@ func (r *VmwareEngineNetwork) SetPolicyMember(ctx context.Context, config *dcl.C
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *VmwareEngineNetwork) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *VmwareEngineNetwork) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -317,6 +333,10 @@ func (r *VmwareEngineNetwork) HasDiff(ctx context.Context, config *dcl.Config, r
 	return VmwareEngineNetworkHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *VmwareEngineNetwork) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return VmwareEngineNetworkFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *VmwareEngineNetwork) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteVmwareEngineNetwork(ctx, config, resource)
 }
@@ -325,6 +345,22 @@ func (r *VmwareEngineNetwork) ID(resource *unstructured.Resource) (string, error
 	return VmwareEngineNetworkID(resource)
 }
 
+func (r *VmwareEngineNetwork) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListVmwareEngineNetwork(ctx, config, project, location)
+}
+
+func (r *VmwareEngineNetwork) Schema() *dcl.Schema {
+	return dclService.DCLVmwareEngineNetworkSchema()
+}
+
 func init() {
 	unstructured.Register(&VmwareEngineNetwork{})
 }
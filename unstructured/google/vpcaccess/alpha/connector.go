@@ -275,6 +275,22 @@ func ConnectorHasDiff(ctx context.Context, config *dcl.Config, u *unstructured.R
 	return false, nil
 }
 
+func ConnectorFieldDiffs(ctx context.Context, config *dcl.Config, u *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	c := dclService.NewClient(config)
+	r, err := UnstructuredToConnector(u)
+	if err != nil {
+		return nil, err
+	}
+	if ush := unstructured.FetchStateHint(opts); ush != nil {
+		sh, err := UnstructuredToConnector(ush)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, dcl.WithStateHint(sh))
+	}
+	return c.DiffConnector(ctx, r, opts...)
+}
+
 func DeleteConnector(ctx context.Context, config *dcl.Config, u *unstructured.Resource) error {
 	c := dclService.NewClient(config)
 	r, err := UnstructuredToConnector(u)
@@ -304,7 +320,7 @@ func (r *Connector) SetPolicyMember(ctx context.Context, config *dcl.Config, res
 	return nil, unstructured.ErrNoSuchMethod
 }
 
-func (r *Connector) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, role, member string) (*unstructured.Resource, error) {
+func (r *Connector) GetPolicyMember(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, member *unstructured.Resource) (*unstructured.Resource, error) {
 	return nil, unstructured.ErrNoSuchMethod
 }
 
@@ -336,6 +352,10 @@ func (r *Connector) HasDiff(ctx context.Context, config *dcl.Config, resource *u
 	return ConnectorHasDiff(ctx, config, resource, opts...)
 }
 
+func (r *Connector) FieldDiffs(ctx context.Context, config *dcl.Config, resource *unstructured.Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	return ConnectorFieldDiffs(ctx, config, resource, opts...)
+}
+
 func (r *Connector) Delete(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) error {
 	return DeleteConnector(ctx, config, resource)
 }
@@ -344,6 +364,22 @@ func (r *Connector) ID(resource *unstructured.Resource) (string, error) {
 	return ConnectorID(resource)
 }
 
+func (r *Connector) List(ctx context.Context, config *dcl.Config, resource *unstructured.Resource) ([]*unstructured.Resource, error) {
+	project, err := unstructured.StringField(resource, "project")
+	if err != nil {
+		return nil, err
+	}
+	location, err := unstructured.StringField(resource, "location")
+	if err != nil {
+		return nil, err
+	}
+	return ListConnector(ctx, config, project, location)
+}
+
+func (r *Connector) Schema() *dcl.Schema {
+	return dclService.DCLConnectorSchema()
+}
+
 func init() {
 	unstructured.Register(&Connector{})
 }
@@ -0,0 +1,145 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package unstructured
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+// Manifest is the serialized form of a Resource: a ServiceTypeVersion header
+// followed by the resource's object, e.g.
+//
+//	{"service": "storage", "type": "Bucket", "version": "ga", "object": {"name": "my-bucket", ...}}
+type Manifest struct {
+	Service string                 `json:"service"`
+	Type    string                 `json:"type"`
+	Version string                 `json:"version"`
+	Object  map[string]interface{} `json:"object"`
+}
+
+// ParseManifest reads a single JSON manifest into a Resource. Numbers are
+// converted to int64 or float64 according to the resource's schema, so the
+// result can be passed directly to Get, Apply, HasDiff, Delete, or List.
+func ParseManifest(b []byte) (*Resource, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var m Manifest
+	if err := d.Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	return m.Resource()
+}
+
+// Resource returns the Resource described by this manifest.
+func (m *Manifest) Resource() (*Resource, error) {
+	if m.Service == "" || m.Type == "" || m.Version == "" {
+		return nil, fmt.Errorf("manifest must set service, type, and version, got %q, %q, %q", m.Service, m.Type, m.Version)
+	}
+	r := &Resource{
+		STV: ServiceTypeVersion{
+			Service: m.Service,
+			Type:    m.Type,
+			Version: m.Version,
+		},
+		Object: m.Object,
+	}
+	if r.Object == nil {
+		r.Object = make(map[string]interface{})
+	}
+	var p *dcl.Property
+	if s, err := Schema(r); err == nil {
+		p = resourceProperty(s)
+	}
+	for k, v := range r.Object {
+		r.Object[k] = normalizeNumbers(subproperty(p, k), v)
+	}
+	return r, nil
+}
+
+// ResourceToManifest returns the manifest describing r.
+func ResourceToManifest(r *Resource) *Manifest {
+	return &Manifest{
+		Service: r.STV.Service,
+		Type:    r.STV.Type,
+		Version: r.STV.Version,
+		Object:  r.Object,
+	}
+}
+
+// MarshalManifest serializes r as an indented JSON manifest.
+func MarshalManifest(r *Resource) ([]byte, error) {
+	return json.MarshalIndent(ResourceToManifest(r), "", "  ")
+}
+
+// resourceProperty returns the top-level property of the resource described by s.
+func resourceProperty(s *dcl.Schema) *dcl.Property {
+	if s.Components == nil {
+		return nil
+	}
+	if s.Info != nil {
+		if c, ok := s.Components.Schemas[s.Info.StructName]; ok {
+			return &c.SchemaProperty
+		}
+	}
+	return nil
+}
+
+// subproperty returns the schema of the named subproperty of p, or nil if it is unknown.
+func subproperty(p *dcl.Property, name string) *dcl.Property {
+	if p == nil {
+		return nil
+	}
+	if sub, ok := p.Properties[name]; ok {
+		return sub
+	}
+	return p.AdditionalProperties
+}
+
+// normalizeNumbers converts every json.Number in v into the Go type the
+// unstructured converters expect for it: int64 for integer fields and float64
+// for number fields. When no schema is available the number's own
+// representation decides.
+func normalizeNumbers(p *dcl.Property, v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if p != nil && p.TypeEnum() == dcl.DoubleType {
+			f, _ := t.Float64()
+			return f
+		}
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = normalizeNumbers(subproperty(p, k), e)
+		}
+		return t
+	case []interface{}:
+		var items *dcl.Property
+		if p != nil {
+			items = p.Items
+		}
+		for i, e := range t {
+			t[i] = normalizeNumbers(items, e)
+		}
+		return t
+	}
+	return v
+}
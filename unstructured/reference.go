@@ -0,0 +1,119 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+// 
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// 
+//     http://www.apache.org/licenses/LICENSE-2.0
+// 
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package unstructured
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/GoogleCloudPlatform/declarative-resource-client-library/dcl"
+)
+
+// Reference points from a field of one resource to a field of another
+// resource, identified by its type and ID. It is serialized as
+// {{ref:SERVICE.TYPE.VERSION:ID#FIELD}}, e.g.
+// {{ref:compute.Network.ga:projects/my-project/global/networks/default#selfLink}}.
+type Reference struct {
+	STV   ServiceTypeVersion
+	ID    string
+	Field string
+}
+
+var referenceRegex = regexp.MustCompile(`{{ref:([^.:]+)\.([^.:]+)\.([^.:]+):([^#]+)#(\w+)}}`)
+
+// String returns the serialized form of the reference.
+func (r *Reference) String() string {
+	return fmt.Sprintf("{{ref:%s.%s.%s:%s#%s}}", r.STV.Service, r.STV.Type, r.STV.Version, r.ID, r.Field)
+}
+
+// ParseReference parses a serialized reference. It returns false if s is not a reference.
+func ParseReference(s string) (*Reference, bool) {
+	m := referenceRegex.FindStringSubmatch(s)
+	if m == nil || m[0] != s {
+		return nil, false
+	}
+	return &Reference{
+		STV: ServiceTypeVersion{
+			Service: m[1],
+			Type:    m[2],
+			Version: m[3],
+		},
+		ID:    m[4],
+		Field: m[5],
+	}, true
+}
+
+// ResolveReferences returns a copy of r in which every {{ref:...}} token is
+// replaced by the value of the referenced field. Referenced resources are
+// looked up in rs by type and ID. The referenced field is read from rs if it
+// is set there, and otherwise from the live resource, so referenced resources
+// must be applied before the resources which refer to them.
+func ResolveReferences(ctx context.Context, config *dcl.Config, r *Resource, rs []*Resource) (*Resource, error) {
+	out := &Resource{STV: r.STV, Object: dcl.Copy(r.Object).(map[string]interface{})}
+	var resolveErr error
+	var resolve func(v interface{}) interface{}
+	resolve = func(v interface{}) interface{} {
+		switch t := v.(type) {
+		case string:
+			ref, ok := ParseReference(t)
+			if !ok {
+				return t
+			}
+			val, err := ref.resolve(ctx, config, rs)
+			if err != nil && resolveErr == nil {
+				resolveErr = err
+			}
+			return val
+		case map[string]interface{}:
+			for k, e := range t {
+				t[k] = resolve(e)
+			}
+		case []interface{}:
+			for i, e := range t {
+				t[i] = resolve(e)
+			}
+		}
+		return v
+	}
+	resolve(out.Object)
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+	return out, nil
+}
+
+// resolve returns the value of the referenced field.
+func (r *Reference) resolve(ctx context.Context, config *dcl.Config, rs []*Resource) (string, error) {
+	for _, target := range rs {
+		if !target.STV.Equals(r.STV) {
+			continue
+		}
+		if id, err := ID(target); err != nil || id != r.ID {
+			continue
+		}
+		if v, ok := target.Object[r.Field].(string); ok && v != "" {
+			return v, nil
+		}
+		live, err := Get(ctx, config, target)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve %s: %w", r.String(), err)
+		}
+		if v, ok := live.Object[r.Field].(string); ok && v != "" {
+			return v, nil
+		}
+		return "", fmt.Errorf("failed to resolve %s: field %q is unset", r.String(), r.Field)
+	}
+	return "", fmt.Errorf("failed to resolve %s: no such resource", r.String())
+}
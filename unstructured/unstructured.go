@@ -73,6 +73,9 @@ type RegisteredResource interface {
 	// HasDiff provides an indirection for the type-specific HasDiff call.
 	HasDiff(ctx context.Context, config *dcl.Config, r *Resource, opts ...dcl.ApplyOption) (bool, error)
 
+	// FieldDiffs provides an indirection for the type-specific Diff call.
+	FieldDiffs(ctx context.Context, config *dcl.Config, r *Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error)
+
 	// Delete provides an indirection for the type-specific Delete call.
 	Delete(ctx context.Context, config *dcl.Config, r *Resource) error
 
@@ -86,7 +89,7 @@ type RegisteredResource interface {
 	SetPolicyWithEtag(ctx context.Context, config *dcl.Config, r *Resource, p *Resource) (*Resource, error)
 
 	// GetPolicyMember provides an indirection for the type-specific GetPolicyMember call.
-	GetPolicyMember(ctx context.Context, config *dcl.Config, r *Resource, role, member string) (*Resource, error)
+	GetPolicyMember(ctx context.Context, config *dcl.Config, r *Resource, m *Resource) (*Resource, error)
 
 	// SetPolicyMember provides an indirection for the type-specific SetPolicyMember call.
 	SetPolicyMember(ctx context.Context, config *dcl.Config, r *Resource, m *Resource) (*Resource, error)
@@ -96,6 +99,13 @@ type RegisteredResource interface {
 
 	// ID returns a string uniquely identifying this resource.
 	ID(r *Resource) (string, error)
+
+	// List provides an indirection for the type-specific List call. The parent
+	// parameters of the list call (e.g. project, location) are read from r.
+	List(ctx context.Context, config *dcl.Config, r *Resource) ([]*Resource, error)
+
+	// Schema returns the DCL schema for this resource, or nil if there is none.
+	Schema() *dcl.Schema
 }
 
 // Equals compares two ServiceTypeVersion structures.
@@ -141,10 +151,14 @@ func Register(rr RegisteredResource) {
 }
 
 func registration(r *Resource) RegisteredResource {
+	return registrationForSTV(r.STV)
+}
+
+func registrationForSTV(stv ServiceTypeVersion) RegisteredResource {
 	registrationMutex.RLock()
 	defer registrationMutex.RUnlock()
 	for _, rr := range registrations {
-		if rr.STV().Equals(r.STV) {
+		if rr.STV().Equals(stv) {
 			return rr
 		}
 	}
@@ -181,6 +195,17 @@ func HasDiff(ctx context.Context, config *dcl.Config, r *Resource, opts ...dcl.A
 	return rr.HasDiff(ctx, config, r, opts...)
 }
 
+// FieldDiffs returns the field-level differences between the provided resource
+// config and the live resource without modifying it. If the live resource does
+// not exist, the returned error satisfies dcl.IsNotFound.
+func FieldDiffs(ctx context.Context, config *dcl.Config, r *Resource, opts ...dcl.ApplyOption) ([]*dcl.FieldDiff, error) {
+	rr := registration(r)
+	if rr == nil {
+		return nil, fmt.Errorf("unknown resource type %s", r.STV.String())
+	}
+	return rr.FieldDiffs(ctx, config, r, opts...)
+}
+
 // Delete deletes the provided resource.
 func Delete(ctx context.Context, config *dcl.Config, r *Resource) error {
 	rr := registration(r)
@@ -217,13 +242,31 @@ func SetPolicyWithEtag(ctx context.Context, config *dcl.Config, r *Resource, p *
 	return rr.SetPolicyWithEtag(ctx, config, r, p)
 }
 
-// GetPolicyMember gets the IAMPolicyMember for the provided resource.
+// GetPolicyMember gets the unconditional IAMPolicyMember for the provided resource.
 func GetPolicyMember(ctx context.Context, config *dcl.Config, r *Resource, role, member string) (*Resource, error) {
+	m := &Resource{
+		STV: ServiceTypeVersion{
+			Service: "iam",
+			Type:    "PolicyMember",
+			Version: "ga",
+		},
+		Object: map[string]interface{}{
+			"role":   role,
+			"member": member,
+		},
+	}
+	return GetConditionalPolicyMember(ctx, config, r, m)
+}
+
+// GetConditionalPolicyMember gets the IAMPolicyMember described by m for the provided
+// resource. The member is only found if it is bound to its role under the same condition
+// as m, or unconditionally if m has no condition.
+func GetConditionalPolicyMember(ctx context.Context, config *dcl.Config, r *Resource, m *Resource) (*Resource, error) {
 	rr := registration(r)
 	if rr == nil {
 		return nil, fmt.Errorf("unknown resource type %s", r.STV.String())
 	}
-	return rr.GetPolicyMember(ctx, config, r, role, member)
+	return rr.GetPolicyMember(ctx, config, r, m)
 }
 
 // SetPolicyMember sets the IAMPolicyMember for the provided resource.
@@ -244,6 +287,43 @@ func DeletePolicyMember(ctx context.Context, config *dcl.Config, r *Resource, m
 	return rr.DeletePolicyMember(ctx, config, r, m)
 }
 
+// List returns all resources of the provided resource's type which share its
+// parent, e.g. all buckets in the project named in r.
+func List(ctx context.Context, config *dcl.Config, r *Resource) ([]*Resource, error) {
+	rr := registration(r)
+	if rr == nil {
+		return nil, fmt.Errorf("unknown resource type %s", r.STV.String())
+	}
+	return rr.List(ctx, config, r)
+}
+
+// Schema returns the DCL schema for the provided resource's type.
+func Schema(r *Resource) (*dcl.Schema, error) {
+	rr := registration(r)
+	if rr == nil {
+		return nil, fmt.Errorf("unknown resource type %s", r.STV.String())
+	}
+	s := rr.Schema()
+	if s == nil {
+		return nil, ErrNoSuchMethod
+	}
+	return s, nil
+}
+
+// StringField returns the string value of the named top-level field of r.
+// It is used to read the parent parameters of a List call.
+func StringField(r *Resource, field string) (string, error) {
+	v, ok := r.Object[field]
+	if !ok {
+		return "", fmt.Errorf("%s: required field %q is unset", r.STV.String(), field)
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%s: field %q: expected string, got %T", r.STV.String(), field, v)
+	}
+	return s, nil
+}
+
 // ID returns a unique ID for the provided resource.
 func ID(r *Resource) (string, error) {
 	rr := registration(r)